@@ -54,49 +54,100 @@ func init() {
 }
 
 var fileDescriptor_cf5ca5e0c737570d = []byte{
-	// 661 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x18, 0xc7, 0x7d, 0xcb, 0x6f, 0x38, 0xfd, 0xf8, 0x23, 0x83, 0x2a, 0xd1, 0xe1, 0x40, 0xb0, 0x3b,
-	0x6a, 0x91, 0x8a, 0x68, 0x41, 0x34, 0x4d, 0x4b, 0x2a, 0xd1, 0x20, 0x70, 0x10, 0x48, 0x2c, 0xe8,
-	0xe2, 0x3c, 0x4d, 0xac, 0x3a, 0x39, 0x73, 0x77, 0x4e, 0xe9, 0x04, 0x23, 0x12, 0x12, 0x82, 0x15,
-	0x09, 0x31, 0xb0, 0x30, 0xf0, 0x1a, 0x90, 0xd8, 0x18, 0x33, 0x76, 0x24, 0xce, 0xc2, 0xd8, 0x97,
-	0x80, 0x82, 0x73, 0x8e, 0x93, 0x1e, 0xe5, 0xec, 0x74, 0xb3, 0xa5, 0xfb, 0x7c, 0x9f, 0xcf, 0x9d,
-	0xf4, 0x3c, 0x77, 0x78, 0x49, 0x42, 0x27, 0x64, 0x9c, 0x06, 0x25, 0x01, 0xbc, 0x07, 0xbc, 0x44,
-	0x43, 0xbf, 0x44, 0x9b, 0x1d, 0xbf, 0x3b, 0xfa, 0xf7, 0x3d, 0x28, 0xf5, 0x96, 0x4a, 0xe3, 0x4f,
-	0x27, 0xe4, 0x4c, 0x32, 0xfb, 0x9a, 0x42, 0x9c, 0x04, 0x71, 0x68, 0xe8, 0x3b, 0x59, 0xc4, 0xe9,
-	0x2d, 0x2d, 0xae, 0x9a, 0xe4, 0x72, 0x78, 0x1e, 0x81, 0x90, 0xcf, 0x38, 0x88, 0x90, 0x75, 0xc5,
-	0xb8, 0xc0, 0xf2, 0xa7, 0x05, 0xfc, 0x7f, 0x79, 0xb4, 0xb4, 0x9e, 0x2c, 0xb5, 0x3f, 0x22, 0x7c,
-	0x71, 0x13, 0x84, 0xc7, 0xfd, 0x06, 0xd4, 0x22, 0x49, 0x1b, 0x01, 0xd4, 0x25, 0x95, 0x60, 0xaf,
-	0x3b, 0x06, 0x2e, 0x8e, 0x0e, 0x75, 0x93, 0xd2, 0x8b, 0xe5, 0x39, 0x12, 0x12, 0xe9, 0xab, 0x96,
-	0xfd, 0x01, 0xe1, 0x0b, 0x6a, 0xc9, 0xb6, 0x2f, 0x24, 0xe3, 0x07, 0xdb, 0x4c, 0x48, 0xfb, 0x4e,
-	0xae, 0xf0, 0x0c, 0xa9, 0xec, 0xd6, 0x8b, 0x07, 0xa4, 0x72, 0x2f, 0x31, 0xae, 0x04, 0x4c, 0x40,
-	0xbd, 0x4d, 0x79, 0xd3, 0x5e, 0x31, 0x4a, 0x9c, 0x00, 0xca, 0xe4, 0x46, 0x6e, 0x2e, 0x2b, 0xe0,
-	0x42, 0x87, 0xf5, 0xe0, 0x11, 0x15, 0x7b, 0x86, 0x02, 0x13, 0x20, 0x9f, 0x40, 0x96, 0x4b, 0x05,
-	0xbe, 0x23, 0x7c, 0xa5, 0x0a, 0xf2, 0x09, 0xe3, 0x7b, 0xbb, 0x01, 0xdb, 0xdf, 0x7a, 0x01, 0x5e,
-	0x24, 0x7d, 0xd6, 0x75, 0xe9, 0xfe, 0xf8, 0xc8, 0x1e, 0x2f, 0xdb, 0x3b, 0x46, 0xf9, 0xff, 0x8a,
-	0x51, 0xb6, 0xb5, 0x53, 0x4a, 0x4b, 0xf7, 0xf0, 0x19, 0xe1, 0x85, 0x2a, 0x48, 0x17, 0xc2, 0xc0,
-	0xf7, 0xe8, 0x68, 0x61, 0x0d, 0x84, 0xa0, 0x2d, 0x10, 0xf6, 0x86, 0x69, 0x2d, 0x0d, 0xac, 0x7c,
-	0x2b, 0x73, 0x65, 0xa4, 0x96, 0xdf, 0x10, 0xbe, 0x5c, 0x05, 0x79, 0x9f, 0x76, 0x40, 0x84, 0xd4,
-	0x03, 0x9d, 0xee, 0x3d, 0xd3, 0x52, 0x27, 0xa5, 0x28, 0xef, 0x9d, 0xd3, 0x09, 0x4b, 0x37, 0xf0,
-	0x15, 0xe1, 0x4b, 0x55, 0x90, 0x9b, 0x3b, 0x0f, 0x75, 0xea, 0x5b, 0xa6, 0xd5, 0xf4, 0xbc, 0x92,
-	0xbe, 0x3b, 0x6f, 0x4c, 0xaa, 0xfb, 0x1a, 0xe1, 0x33, 0x2e, 0xd0, 0x30, 0x0c, 0x0e, 0xb6, 0x7a,
-	0xd0, 0x95, 0xc2, 0xbe, 0x69, 0xd8, 0x26, 0x19, 0x46, 0x69, 0xad, 0x16, 0x41, 0xa7, 0x66, 0x60,
-	0xb9, 0xd9, 0xac, 0x03, 0xe5, 0x5e, 0xbb, 0x2c, 0x25, 0xf7, 0x1b, 0x91, 0x04, 0x61, 0x38, 0x03,
-	0x35, 0x64, 0xbe, 0x19, 0xa8, 0x0d, 0x98, 0xea, 0x9e, 0x64, 0x34, 0x1c, 0xf3, 0xdb, 0xc8, 0x31,
-	0x57, 0xfe, 0xa6, 0x58, 0x99, 0x2b, 0x63, 0xea, 0x08, 0xab, 0x20, 0x0b, 0x1e, 0xa1, 0x86, 0xcc,
-	0x77, 0x84, 0xda, 0x80, 0x54, 0xee, 0x2d, 0xc2, 0xe7, 0xd4, 0x45, 0x53, 0x09, 0x22, 0x21, 0x81,
-	0xdb, 0x6b, 0xb9, 0xae, 0xa7, 0x31, 0xa5, 0xa4, 0x6e, 0x15, 0x83, 0x53, 0xa1, 0x37, 0x08, 0x9f,
-	0x4d, 0x7a, 0x24, 0xed, 0xcf, 0xd5, 0x1c, 0x8d, 0x35, 0xdb, 0x94, 0x6b, 0x85, 0xd8, 0xd4, 0xe6,
-	0x3d, 0xc2, 0xe7, 0x1f, 0x44, 0xbc, 0x05, 0x59, 0x1f, 0xb3, 0x2d, 0xce, 0x62, 0xca, 0xe8, 0x76,
-	0x41, 0x7a, 0xca, 0xa9, 0x06, 0x85, 0x9c, 0x66, 0xb1, 0x7c, 0x4e, 0xc7, 0xe9, 0xd4, 0x69, 0xf4,
-	0x94, 0x73, 0x61, 0x97, 0x83, 0x68, 0xab, 0xab, 0x6f, 0x74, 0x5b, 0x0b, 0xc3, 0xa7, 0x9c, 0x0e,
-	0xcd, 0xf7, 0x94, 0xd3, 0x27, 0xcc, 0x4c, 0x0a, 0x01, 0xdd, 0x66, 0x66, 0xf2, 0x26, 0x86, 0xa6,
-	0x93, 0x42, 0x07, 0xe7, 0x9d, 0x14, 0xfa, 0x0c, 0x65, 0xb9, 0x11, 0xf4, 0x07, 0xc4, 0x3a, 0x1c,
-	0x10, 0xeb, 0x68, 0x40, 0xd0, 0xab, 0x98, 0xa0, 0x2f, 0x31, 0x41, 0x3f, 0x62, 0x82, 0xfa, 0x31,
-	0x41, 0x3f, 0x63, 0x82, 0x7e, 0xc5, 0xc4, 0x3a, 0x8a, 0x09, 0x7a, 0x37, 0x24, 0x56, 0x7f, 0x48,
-	0xac, 0xc3, 0x21, 0xb1, 0x9e, 0xae, 0xb4, 0xd8, 0xa4, 0xbc, 0xcf, 0x4e, 0x78, 0x99, 0xaf, 0x65,
-	0xff, 0x1b, 0xff, 0xfd, 0x79, 0x96, 0x5f, 0xff, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xd3, 0x19, 0x50,
-	0xae, 0x2c, 0x0c, 0x00, 0x00,
+	// 1474 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x9a, 0x3f, 0x8c, 0x1b, 0xc5,
+	0x17, 0xc7, 0x3d, 0xcd, 0xaf, 0x58, 0xfd, 0xf8, 0xb7, 0xfc, 0x53, 0x02, 0x2c, 0x28, 0x34, 0x54,
+	0xbe, 0x24, 0x48, 0x41, 0x5c, 0xfe, 0x9e, 0x7d, 0x97, 0xf3, 0x25, 0xe7, 0xe4, 0xe2, 0x0d, 0x41,
+	0xa2, 0x41, 0xe3, 0xdd, 0x77, 0xe7, 0x51, 0xd6, 0x3b, 0xcb, 0xcc, 0xac, 0xc3, 0x55, 0x50, 0x22,
+	0x21, 0x21, 0x90, 0x90, 0x90, 0x90, 0x90, 0x90, 0x90, 0x10, 0x05, 0x05, 0x42, 0x42, 0xa2, 0x01,
+	0x89, 0x0a, 0xca, 0x94, 0x29, 0x89, 0xd3, 0x50, 0xa6, 0xa6, 0x42, 0xeb, 0xf5, 0xec, 0x79, 0xd6,
+	0xb3, 0xbe, 0x99, 0xb5, 0xbb, 0x5c, 0x3c, 0x9f, 0xef, 0x7e, 0xf7, 0xcd, 0x9b, 0x79, 0x33, 0xcf,
+	0x76, 0xce, 0x08, 0x18, 0x26, 0x94, 0xe1, 0x68, 0x8d, 0x03, 0x1b, 0x01, 0x5b, 0xc3, 0x09, 0x59,
+	0xc3, 0xe1, 0x90, 0xc4, 0xd9, 0xdf, 0x24, 0x80, 0xb5, 0xd1, 0x99, 0xb5, 0xe9, 0x3f, 0x9b, 0x09,
+	0xa3, 0x82, 0xba, 0xaf, 0x4b, 0xa4, 0x99, 0x23, 0x4d, 0x9c, 0x90, 0xe6, 0x2c, 0xd2, 0x1c, 0x9d,
+	0x39, 0xb9, 0x6e, 0xa2, 0xcb, 0xe0, 0x83, 0x14, 0xb8, 0x78, 0x9f, 0x01, 0x4f, 0x68, 0xcc, 0xa7,
+	0x0f, 0x38, 0xfb, 0xef, 0x96, 0xf3, 0xff, 0x8d, 0x6c, 0xa8, 0x9f, 0x0f, 0x75, 0xbf, 0x41, 0xce,
+	0x73, 0x9b, 0xc0, 0x03, 0x46, 0xfa, 0xd0, 0x4d, 0x05, 0xee, 0x47, 0xe0, 0x0b, 0x2c, 0xc0, 0xbd,
+	0xd2, 0x34, 0xf0, 0xd2, 0xd4, 0xa1, 0xbd, 0xfc, 0xd1, 0x27, 0x37, 0x96, 0x50, 0xc8, 0x4d, 0x9f,
+	0x6a, 0xb8, 0x5f, 0x23, 0xe7, 0x59, 0x39, 0xa4, 0x43, 0xb8, 0xa0, 0xec, 0xb0, 0x43, 0xb9, 0x70,
+	0x2f, 0x5b, 0x89, 0xcf, 0x90, 0xd2, 0xdd, 0x95, 0xfa, 0x02, 0x85, 0xb9, 0x8f, 0x1c, 0xa7, 0x1d,
+	0x51, 0x0e, 0xfe, 0x00, 0xb3, 0xd0, 0x3d, 0x67, 0xa4, 0x78, 0x04, 0x48, 0x27, 0x6f, 0x59, 0x73,
+	0xb3, 0x06, 0x7a, 0x30, 0xa4, 0x23, 0xb8, 0x8d, 0xf9, 0x5d, 0x43, 0x03, 0x47, 0x80, 0x9d, 0x81,
+	0x59, 0xae, 0x30, 0xf0, 0x07, 0x72, 0x5e, 0xdb, 0x06, 0xf1, 0x2e, 0x65, 0x77, 0xf7, 0x23, 0x7a,
+	0x6f, 0xeb, 0x43, 0x08, 0x52, 0x41, 0x68, 0xdc, 0xc3, 0xf7, 0xa6, 0x21, 0xbb, 0x73, 0xd6, 0xdd,
+	0x35, 0xd2, 0x3f, 0x4e, 0x46, 0xba, 0xed, 0xae, 0x48, 0xad, 0x78, 0x87, 0x3f, 0x91, 0x73, 0x4a,
+	0x37, 0x7c, 0x3a, 0xb6, 0x07, 0x23, 0x60, 0x1c, 0xdc, 0x1b, 0xb5, 0x9f, 0xab, 0x0a, 0xc9, 0xf7,
+	0xb8, 0xb9, 0x32, 0xbd, 0xe2, 0x4d, 0xbe, 0x43, 0xce, 0x0b, 0xdb, 0x20, 0x7a, 0x90, 0x44, 0x24,
+	0xc0, 0xd9, 0xd0, 0x2e, 0x70, 0x8e, 0x0f, 0x80, 0xbb, 0x2d, 0xd3, 0xa7, 0x69, 0x60, 0xe9, 0xb8,
+	0xbd, 0x94, 0x46, 0xe1, 0xf2, 0x27, 0xe4, 0x9c, 0xf0, 0x05, 0x03, 0x3c, 0xd4, 0x19, 0xdd, 0x32,
+	0x7a, 0x48, 0x25, 0x2f, 0xbd, 0x5e, 0x5d, 0x56, 0x46, 0xda, 0x7d, 0x03, 0x9d, 0x46, 0xee, 0x6f,
+	0xc8, 0xf1, 0xf2, 0xb1, 0x55, 0x93, 0xe1, 0x5e, 0xb3, 0x78, 0x60, 0xf5, 0x8c, 0xe6, 0xe6, 0xaf,
+	0xaf, 0x44, 0x4b, 0xbe, 0xc1, 0x69, 0xe4, 0xfe, 0x8e, 0x9c, 0x57, 0xb7, 0x41, 0xdc, 0xc0, 0x43,
+	0xe0, 0x09, 0x0e, 0x40, 0x17, 0xf8, 0xeb, 0xa6, 0xb3, 0xbb, 0x48, 0x45, 0xbe, 0xc1, 0xee, 0x6a,
+	0xc4, 0x8a, 0x9c, 0xf9, 0x11, 0x39, 0x27, 0xb6, 0x41, 0x6c, 0xee, 0xde, 0xaa, 0x9f, 0x33, 0x95,
+	0xbc, 0x5d, 0xce, 0x2c, 0x90, 0x29, 0xec, 0x7e, 0x82, 0x9c, 0x27, 0x7a, 0x80, 0x93, 0x24, 0x3a,
+	0xdc, 0x1a, 0x41, 0x2c, 0xb8, 0xfb, 0xb6, 0xe1, 0x1e, 0x3b, 0xc3, 0x48, 0x5b, 0xeb, 0x75, 0x50,
+	0xa5, 0x80, 0x6e, 0x84, 0xa1, 0x0f, 0x98, 0x05, 0x83, 0x0d, 0x21, 0x18, 0xe9, 0xa7, 0x02, 0xb8,
+	0x61, 0x01, 0xd5, 0x90, 0x76, 0x05, 0x54, 0x2b, 0xa0, 0x6c, 0x58, 0x79, 0x5d, 0x99, 0xf3, 0xd7,
+	0xb2, 0x28, 0x4a, 0x55, 0x16, 0xdb, 0x4b, 0x69, 0x28, 0x21, 0xdc, 0x06, 0x51, 0x33, 0x84, 0x1a,
+	0xd2, 0x2e, 0x84, 0x5a, 0x81, 0xc2, 0xdc, 0x67, 0xc8, 0x79, 0x4a, 0x9e, 0x52, 0xda, 0x51, 0xca,
+	0x05, 0x30, 0xf7, 0xbc, 0xd5, 0xd9, 0x66, 0x4a, 0x49, 0x53, 0x17, 0xea, 0xc1, 0x85, 0xa1, 0x4f,
+	0x91, 0xf3, 0x64, 0xbe, 0x46, 0x8a, 0xf5, 0xb9, 0x6e, 0xb1, 0xb0, 0xca, 0x8b, 0xf2, 0x7c, 0x2d,
+	0xb6, 0x70, 0xf3, 0x05, 0x72, 0x9e, 0xde, 0x4b, 0xd9, 0x01, 0xcc, 0xfa, 0x31, 0x7b, 0xc5, 0x32,
+	0x26, 0x1d, 0x5d, 0xac, 0x49, 0x2b, 0x9e, 0xba, 0x50, 0xcb, 0x53, 0x17, 0x96, 0xf1, 0xd4, 0x85,
+	0x4a, 0x4f, 0xd9, 0x3d, 0xa0, 0x07, 0xfb, 0x0c, 0xf8, 0x40, 0x56, 0x94, 0xec, 0xa8, 0xc7, 0x0d,
+	0xef, 0x01, 0x3a, 0xd4, 0xee, 0x1e, 0xa0, 0x57, 0x28, 0xed, 0x14, 0x1c, 0xe2, 0x70, 0x66, 0xe7,
+	0xcd, 0x1d, 0x9a, 0xee, 0x14, 0x3a, 0xd8, 0x76, 0xa7, 0xd0, 0x6b, 0x14, 0x2e, 0xbf, 0x45, 0xce,
+	0xf3, 0x79, 0x21, 0x86, 0x6e, 0x1a, 0x09, 0x72, 0x33, 0x01, 0x36, 0x19, 0xe8, 0x9a, 0x05, 0x41,
+	0xcb, 0x4a, 0x8f, 0xad, 0x65, 0x24, 0x0a, 0x8b, 0xbf, 0x20, 0xe7, 0xe5, 0x5d, 0xc2, 0x8f, 0x0a,
+	0xef, 0x55, 0x4c, 0x22, 0x3a, 0x02, 0x26, 0x0f, 0x32, 0x1d, 0xa3, 0xc7, 0x2c, 0x92, 0x90, 0x86,
+	0x77, 0x56, 0xa0, 0x54, 0xf8, 0xfe, 0x12, 0x39, 0xcf, 0x74, 0x70, 0x1c, 0x66, 0x9f, 0x16, 0xc3,
+	0x5d, 0xb3, 0xbc, 0x9f, 0xe3, 0xa4, 0xc3, 0x4b, 0x75, 0xf1, 0xc2, 0xd6, 0xcf, 0xc8, 0x79, 0xa9,
+	0x07, 0x01, 0x65, 0xe1, 0x6c, 0xe6, 0x76, 0x00, 0x33, 0xd1, 0x07, 0x2c, 0xdc, 0x6d, 0xc3, 0xc4,
+	0xaa, 0x54, 0x90, 0x56, 0x3b, 0xcb, 0x0b, 0x29, 0xb1, 0x54, 0x8f, 0xe9, 0xbb, 0xf8, 0xc0, 0x30,
+	0x96, 0x73, 0x9c, 0x5d, 0x2c, 0x35, 0xb8, 0xb2, 0xc6, 0xdb, 0x74, 0x98, 0xe0, 0xa0, 0xb8, 0xf3,
+	0xc8, 0xa4, 0x34, 0xcb, 0x7d, 0x3d, 0x6c, 0xb7, 0xc6, 0xab, 0x34, 0x94, 0x19, 0xcf, 0x72, 0xd6,
+	0x17, 0x38, 0x82, 0xb9, 0xd3, 0x37, 0x37, 0x9c, 0xf1, 0x05, 0x0a, 0x76, 0x33, 0xbe, 0x50, 0x48,
+	0x29, 0x39, 0x59, 0x8d, 0x3c, 0x8c, 0xf1, 0x90, 0x04, 0x6d, 0x1a, 0xef, 0x93, 0x03, 0xc3, 0x92,
+	0x53, 0xc6, 0xec, 0x4a, 0xce, 0x3c, 0xad, 0x78, 0xf2, 0xeb, 0x79, 0xf2, 0x97, 0xf2, 0xe4, 0x57,
+	0x7b, 0xca, 0x56, 0x46, 0x16, 0x51, 0xd5, 0xd4, 0x45, 0xe3, 0x99, 0xd0, 0xba, 0xba, 0x54, 0x17,
+	0x57, 0xaa, 0x73, 0xf6, 0xf9, 0xed, 0x01, 0xa3, 0x42, 0x44, 0x10, 0xb6, 0x71, 0x14, 0x01, 0x33,
+	0xad, 0xce, 0x3a, 0xd4, 0xae, 0x3a, 0xeb, 0x15, 0x94, 0x35, 0x21, 0x4f, 0x84, 0xd9, 0xa6, 0x73,
+	0x2b, 0x85, 0x14, 0xf6, 0x30, 0x13, 0xc4, 0x66, 0x4d, 0x2c, 0x50, 0xb0, 0x5b, 0x13, 0x0b, 0x85,
+	0x0a, 0xd3, 0x5f, 0x21, 0xc7, 0xf5, 0x41, 0x74, 0x31, 0x89, 0x05, 0xc4, 0x38, 0x0e, 0x60, 0x27,
+	0xde, 0xa7, 0xee, 0x25, 0xd3, 0x1c, 0x2a, 0x81, 0xd2, 0xe2, 0xe5, 0xda, 0xbc, 0xd2, 0x55, 0x7b,
+	0x27, 0x09, 0xb1, 0x98, 0x2c, 0x6a, 0x60, 0xad, 0x94, 0x44, 0xe1, 0x4e, 0x38, 0xd9, 0x9a, 0x04,
+	0xe9, 0x93, 0x88, 0x88, 0x43, 0xc3, 0xae, 0xda, 0x71, 0x32, 0x76, 0x5d, 0xb5, 0xe3, 0xd5, 0x8a,
+	0x77, 0xf8, 0x15, 0x39, 0xaf, 0x4c, 0x9b, 0x57, 0x15, 0x2f, 0xb0, 0x63, 0xd3, 0x00, 0x5b, 0xec,
+	0xfe, 0xda, 0x2a, 0xa4, 0x94, 0x1b, 0x8c, 0x3f, 0x48, 0x45, 0x48, 0xef, 0xc5, 0x39, 0x60, 0x78,
+	0x83, 0x51, 0x21, 0xbb, 0x1b, 0x4c, 0x99, 0x2d, 0xdc, 0x7c, 0x8f, 0x9c, 0x17, 0x77, 0x32, 0x7e,
+	0xbe, 0x11, 0xe8, 0x9a, 0x95, 0xb4, 0x0a, 0x5a, 0xfa, 0xdb, 0x5c, 0x4e, 0x44, 0x09, 0x5b, 0x9b,
+	0x01, 0x16, 0xe0, 0x07, 0x03, 0x08, 0xd3, 0x08, 0x0c, 0xc3, 0xa6, 0x42, 0x76, 0x61, 0x2b, 0xb3,
+	0x4a, 0x75, 0x91, 0xfb, 0x40, 0xe1, 0xc7, 0xee, 0x6e, 0x5b, 0x76, 0x74, 0xb1, 0x26, 0xad, 0x44,
+	0x28, 0x5f, 0x42, 0x96, 0x11, 0x52, 0x21, 0xbb, 0x08, 0x95, 0x59, 0xa5, 0x49, 0xb5, 0x87, 0x45,
+	0x30, 0x28, 0xcc, 0x98, 0x35, 0xa9, 0x14, 0xc6, 0xae, 0x49, 0x55, 0x42, 0x95, 0xc0, 0x6c, 0x42,
+	0x04, 0xd6, 0x81, 0x51, 0x21, 0xbb, 0xc0, 0x94, 0x59, 0x25, 0x30, 0x93, 0x63, 0xd5, 0xf4, 0x23,
+	0xd3, 0xee, 0x9d, 0xc2, 0xd8, 0x05, 0xa6, 0x84, 0x2a, 0x47, 0x62, 0x5f, 0x60, 0x26, 0x7a, 0xc0,
+	0x41, 0xb4, 0x70, 0xd8, 0x22, 0x31, 0x66, 0x87, 0xd7, 0x68, 0xdf, 0xf0, 0x48, 0xac, 0x87, 0xed,
+	0x8e, 0xc4, 0x55, 0x1a, 0xe5, 0x96, 0xcf, 0x64, 0xc8, 0x1e, 0x25, 0x59, 0xbf, 0x73, 0xdd, 0xfc,
+	0x36, 0x50, 0x40, 0xd6, 0x2d, 0x1f, 0x85, 0x55, 0x3a, 0x62, 0x3d, 0xe8, 0xe3, 0x28, 0xab, 0xac,
+	0x93, 0x6f, 0xcc, 0xb8, 0x61, 0x47, 0xac, 0x44, 0xd9, 0x75, 0xc4, 0xe6, 0x60, 0x25, 0x9f, 0xb2,
+	0x26, 0x5e, 0xf6, 0xff, 0xd9, 0xf7, 0x9b, 0xa6, 0xf9, 0xa4, 0x30, 0x76, 0xf9, 0x54, 0x42, 0xcb,
+	0x33, 0x95, 0x7d, 0x8f, 0xb9, 0xc7, 0xe8, 0x3e, 0x31, 0x5e, 0x68, 0x2a, 0x64, 0x3d, 0x53, 0x0a,
+	0x5b, 0xea, 0x5d, 0x42, 0xd2, 0x01, 0x1c, 0x89, 0x41, 0x7b, 0x00, 0xc1, 0x5d, 0xe3, 0xde, 0xa5,
+	0x42, 0xd9, 0xf6, 0x2e, 0x4b, 0xb0, 0x52, 0x6b, 0x4b, 0x9d, 0xcd, 0x2e, 0x08, 0x1c, 0x62, 0x81,
+	0x0d, 0x6b, 0x6d, 0x05, 0x6d, 0x57, 0x6b, 0x2b, 0x45, 0x94, 0x46, 0x53, 0xbe, 0xb1, 0x97, 0x6d,
+	0x6e, 0x58, 0x14, 0x85, 0x0a, 0x93, 0xad, 0x65, 0x24, 0xb4, 0x77, 0x82, 0x3b, 0x84, 0x4f, 0x8f,
+	0x59, 0x7b, 0x8c, 0x06, 0xc0, 0x39, 0x65, 0x96, 0x77, 0x02, 0x8d, 0x42, 0xbd, 0x3b, 0x81, 0x56,
+	0xa8, 0x94, 0x91, 0x11, 0x08, 0x38, 0xea, 0x31, 0xd9, 0x54, 0x93, 0xb9, 0x0e, 0xd3, 0x85, 0x7a,
+	0xb0, 0x36, 0x23, 0x8b, 0xcf, 0x6f, 0xa5, 0x54, 0x60, 0x6e, 0x99, 0x91, 0x25, 0xba, 0x5e, 0x46,
+	0xce, 0x89, 0x68, 0x32, 0xb2, 0x6c, 0xd3, 0x26, 0x23, 0x2b, 0x4c, 0xb6, 0x96, 0x91, 0x90, 0x16,
+	0x5b, 0xd1, 0xfd, 0x87, 0x5e, 0xe3, 0xc1, 0x43, 0xaf, 0xf1, 0xf8, 0xa1, 0x87, 0x3e, 0x1e, 0x7b,
+	0xe8, 0x87, 0xb1, 0x87, 0xfe, 0x1a, 0x7b, 0xe8, 0xfe, 0xd8, 0x43, 0x7f, 0x8f, 0x3d, 0xf4, 0xcf,
+	0xd8, 0x6b, 0x3c, 0x1e, 0x7b, 0xe8, 0xf3, 0x47, 0x5e, 0xe3, 0xfe, 0x23, 0xaf, 0xf1, 0xe0, 0x91,
+	0xd7, 0x78, 0xef, 0xdc, 0x01, 0x3d, 0x7a, 0x3a, 0xa1, 0x0b, 0x7e, 0x74, 0x73, 0x7e, 0xf6, 0xef,
+	0xfe, 0xff, 0x26, 0xbf, 0xb8, 0x79, 0xf3, 0xbf, 0x01, 0x00, 0xed, 0x0f, 0x0d, 0x88, 0x07, 0x24,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -122,8 +173,21 @@ type AdminServiceClient interface {
 	// StartEventId defines the beginning of the event to fetch. The first event is inclusive.
 	// EndEventId and EndEventVersion defines the end of the event to fetch. The end event is exclusive.
 	GetWorkflowExecutionRawHistoryV2(ctx context.Context, in *GetWorkflowExecutionRawHistoryV2Request, opts ...grpc.CallOption) (*GetWorkflowExecutionRawHistoryV2Response, error)
+	// Returns the history of specified workflow execution with the most recent events first.
+	// Events can be optionally filtered by type, in which case pages may contain fewer events than requested.
+	// The number of events scanned by a single call is bounded, so a page can even be empty while the
+	// next page token is set, callers keep paging until the token is empty.
+	GetWorkflowExecutionHistoryReverse(ctx context.Context, in *GetWorkflowExecutionHistoryReverseRequest, opts ...grpc.CallOption) (*GetWorkflowExecutionHistoryReverseResponse, error)
 	// GetReplicationMessages returns new replication tasks since the read level provided in the token.
 	GetReplicationMessages(ctx context.Context, in *GetReplicationMessagesRequest, opts ...grpc.CallOption) (*GetReplicationMessagesResponse, error)
+	// StreamReplicationMessages pushes replication tasks of a single shard to a remote cluster as soon as they are available.
+	// Every request acks processed tasks and grants the server credit to send the next batch.
+	// Shard is identified by the replication-shard-id header of the stream.
+	StreamReplicationMessages(ctx context.Context, opts ...grpc.CallOption) (AdminService_StreamReplicationMessagesClient, error)
+	// StreamWorkflowExecutionHistory streams history events of a workflow execution as they are appended, starting
+	// with the events already in history. The stream ends after the close event of the workflow is sent.
+	// Events are sent at the rate allowed for the stream, a slow receiver holds back reading of history.
+	StreamWorkflowExecutionHistory(ctx context.Context, in *StreamWorkflowExecutionHistoryRequest, opts ...grpc.CallOption) (AdminService_StreamWorkflowExecutionHistoryClient, error)
 	// GetNamespaceReplicationMessages returns new namespace replication tasks since last retrieved task Id.
 	GetNamespaceReplicationMessages(ctx context.Context, in *GetNamespaceReplicationMessagesRequest, opts ...grpc.CallOption) (*GetNamespaceReplicationMessagesResponse, error)
 	// GetDLQReplicationMessages return replication messages based on DLQ info.
@@ -150,6 +214,105 @@ type AdminServiceClient interface {
 	RefreshWorkflowTasks(ctx context.Context, in *RefreshWorkflowTasksRequest, opts ...grpc.CallOption) (*RefreshWorkflowTasksResponse, error)
 	// ResendReplicationTasks requests replication tasks from remote cluster and apply tasks to current cluster.
 	ResendReplicationTasks(ctx context.Context, in *ResendReplicationTasksRequest, opts ...grpc.CallOption) (*ResendReplicationTasksResponse, error)
+	// ExecuteMultiOperation starts a workflow execution if it is not running and delivers the signals to it atomically.
+	// This gives update-with-start semantics without racing a separate signal against the start.
+	ExecuteMultiOperation(ctx context.Context, in *ExecuteMultiOperationRequest, opts ...grpc.CallOption) (*ExecuteMultiOperationResponse, error)
+	// ListNamespaceFailoverHistory returns the most recent failovers of the namespace.
+	ListNamespaceFailoverHistory(ctx context.Context, in *ListNamespaceFailoverHistoryRequest, opts ...grpc.CallOption) (*ListNamespaceFailoverHistoryResponse, error)
+	// HandoverNamespace gracefully fails over namespace to target cluster: namespace is made read-only on the
+	// current active cluster, replication to target cluster is drained, then target cluster becomes active.
+	HandoverNamespace(ctx context.Context, in *HandoverNamespaceRequest, opts ...grpc.CallOption) (*HandoverNamespaceResponse, error)
+	// RecordWorkflowTaskHeartbeat extends start to close timeout of a workflow task which takes long to process
+	// (e.g. replay of large history). It is exposed here since public workflow service has no equivalent API yet.
+	RecordWorkflowTaskHeartbeat(ctx context.Context, in *RecordWorkflowTaskHeartbeatRequest, opts ...grpc.CallOption) (*RecordWorkflowTaskHeartbeatResponse, error)
+	// GetReplicationLag returns replication progress, lag and DLQ depth of all history shards towards remote clusters.
+	GetReplicationLag(ctx context.Context, in *GetReplicationLagRequest, opts ...grpc.CallOption) (*GetReplicationLagResponse, error)
+	// CompactWorkflowHistory rewrites the history event batches of a workflow into fewer, larger batches,
+	// which speeds up reading history of executions with many tiny event batches (e.g. caused by signal storms).
+	CompactWorkflowHistory(ctx context.Context, in *CompactWorkflowHistoryRequest, opts ...grpc.CallOption) (*CompactWorkflowHistoryResponse, error)
+	// ListStaleWorkflowExecutions lists open workflow executions of a namespace which were started longer than
+	// the given duration ago. Results are read from the visibility store and paged.
+	ListStaleWorkflowExecutions(ctx context.Context, in *ListStaleWorkflowExecutionsRequest, opts ...grpc.CallOption) (*ListStaleWorkflowExecutionsResponse, error)
+	// GetDynamicConfig returns dynamic config values of a key stored in the database.
+	GetDynamicConfig(ctx context.Context, in *GetDynamicConfigRequest, opts ...grpc.CallOption) (*GetDynamicConfigResponse, error)
+	// SetDynamicConfig replaces dynamic config values of a key stored in the database.
+	// Values stored in the database take precedence over the values from the dynamic config file.
+	SetDynamicConfig(ctx context.Context, in *SetDynamicConfigRequest, opts ...grpc.CallOption) (*SetDynamicConfigResponse, error)
+	// ListDynamicConfig returns all dynamic config values stored in the database.
+	ListDynamicConfig(ctx context.Context, in *ListDynamicConfigRequest, opts ...grpc.CallOption) (*ListDynamicConfigResponse, error)
+	// ListThrottledCallers returns the namespaces and identities currently being throttled
+	// by the frontend, history and persistence rate limiters of the cluster.
+	ListThrottledCallers(ctx context.Context, in *ListThrottledCallersRequest, opts ...grpc.CallOption) (*ListThrottledCallersResponse, error)
+	// DescribeTaskQueuePartitions returns pollers, approximate backlog, read and ack levels of every partition
+	// of a task queue.
+	DescribeTaskQueuePartitions(ctx context.Context, in *DescribeTaskQueuePartitionsRequest, opts ...grpc.CallOption) (*DescribeTaskQueuePartitionsResponse, error)
+	// SetMaintenanceInfo sets the planned maintenance message which the frontend returns
+	// to clients in response headers. Empty message clears it.
+	SetMaintenanceInfo(ctx context.Context, in *SetMaintenanceInfoRequest, opts ...grpc.CallOption) (*SetMaintenanceInfoResponse, error)
+	// UpdateWorkerBuildIdCompatibility updates the compatible worker build id sets of a task queue. Workflow tasks
+	// are only dispatched to workers with a build id compatible with the one which processed the workflow so far.
+	UpdateWorkerBuildIdCompatibility(ctx context.Context, in *UpdateWorkerBuildIdCompatibilityRequest, opts ...grpc.CallOption) (*UpdateWorkerBuildIdCompatibilityResponse, error)
+	// GetWorkerBuildIdCompatibility returns the compatible worker build id sets of a task queue.
+	GetWorkerBuildIdCompatibility(ctx context.Context, in *GetWorkerBuildIdCompatibilityRequest, opts ...grpc.CallOption) (*GetWorkerBuildIdCompatibilityResponse, error)
+	// ShutdownWorker notifies the server that a worker is shutting down. The sticky task queue of the worker
+	// is evicted, so workflows which are sticky to the worker are moved to other workers right away.
+	ShutdownWorker(ctx context.Context, in *ShutdownWorkerRequest, opts ...grpc.CallOption) (*ShutdownWorkerResponse, error)
+	// ImportWorkflowExecution creates a workflow execution from the history and mutable state of the execution
+	// in another cluster. Events are applied with the replication semantics, i.e. the failover versions and
+	// history branches of the source cluster are kept. Once the last event of the mutable state snapshot is imported,
+	// the state which isn't derived from history, e.g. the activity heartbeat details and attempts, is synced
+	// from the snapshot the same way as for replicated activities.
+	ImportWorkflowExecution(ctx context.Context, in *ImportWorkflowExecutionRequest, opts ...grpc.CallOption) (*ImportWorkflowExecutionResponse, error)
+	// CreateSchedule creates a schedule which takes its action, i.e. starts a workflow, at the times of its spec.
+	CreateSchedule(ctx context.Context, in *CreateScheduleRequest, opts ...grpc.CallOption) (*CreateScheduleResponse, error)
+	// DescribeSchedule returns the schedule and its current state.
+	DescribeSchedule(ctx context.Context, in *DescribeScheduleRequest, opts ...grpc.CallOption) (*DescribeScheduleResponse, error)
+	// UpdateSchedule replaces the schedule.
+	UpdateSchedule(ctx context.Context, in *UpdateScheduleRequest, opts ...grpc.CallOption) (*UpdateScheduleResponse, error)
+	// PatchSchedule makes one-off changes to a schedule: trigger, backfill, pause and unpause.
+	PatchSchedule(ctx context.Context, in *PatchScheduleRequest, opts ...grpc.CallOption) (*PatchScheduleResponse, error)
+	// DeleteSchedule deletes the schedule. Workflows started by the schedule are not affected.
+	DeleteSchedule(ctx context.Context, in *DeleteScheduleRequest, opts ...grpc.CallOption) (*DeleteScheduleResponse, error)
+	// ListSchedules lists the schedules of a namespace.
+	ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*ListSchedulesResponse, error)
+	// StartResetBadBinaryJob starts a batch job in the worker service which resets all executions of a namespace
+	// that ran the given bad binary checksum to the workflow task before the binary first completed a workflow task.
+	// The progress of the job is tracked like any other batch job.
+	StartResetBadBinaryJob(ctx context.Context, in *StartResetBadBinaryJobRequest, opts ...grpc.CallOption) (*StartResetBadBinaryJobResponse, error)
+	// GetResetPoints returns the auto-reset points of a workflow execution, i.e. the first workflow task completed
+	// by each build id, so that a reset target can be chosen without parsing the history.
+	GetResetPoints(ctx context.Context, in *GetResetPointsRequest, opts ...grpc.CallOption) (*GetResetPointsResponse, error)
+	// RebalanceShards evicts history shards from the hosts which hold them although the membership ring assigns
+	// them to another host, starting with the hosts owning the most shards. Evicted shards are acquired by the
+	// host the membership ring assigns them to.
+	RebalanceShards(ctx context.Context, in *RebalanceShardsRequest, opts ...grpc.CallOption) (*RebalanceShardsResponse, error)
+	// GetShardStats returns owner host, ack levels, task backlog, lock contention and visibility lag of every history shard.
+	GetShardStats(ctx context.Context, in *GetShardStatsRequest, opts ...grpc.CallOption) (*GetShardStatsResponse, error)
+	// GetHostProfile captures a pprof profile of a frontend, history or matching host.
+	GetHostProfile(ctx context.Context, in *GetHostProfileRequest, opts ...grpc.CallOption) (*GetHostProfileResponse, error)
+	// DeepHealthCheck verifies persistence reads and writes, Elasticsearch connectivity, membership ring consistency
+	// and gRPC reachability of every frontend, history and matching host.
+	DeepHealthCheck(ctx context.Context, in *DeepHealthCheckRequest, opts ...grpc.CallOption) (*DeepHealthCheckResponse, error)
+	// DescribeClusterMetadata returns the persisted failover version increment, cluster list and search attributes
+	// metadata, along with the version of the cluster metadata to pass to UpdateClusterMetadata.
+	DescribeClusterMetadata(ctx context.Context, in *DescribeClusterMetadataRequest, opts ...grpc.CallOption) (*DescribeClusterMetadataResponse, error)
+	// UpdateClusterMetadata updates the failover version increment, cluster list and search attributes metadata
+	// in a single write, which is rejected if the cluster metadata was updated since the version of the request.
+	// Changes to the failover version increment and clusters take effect when the services are restarted.
+	UpdateClusterMetadata(ctx context.Context, in *UpdateClusterMetadataRequest, opts ...grpc.CallOption) (*UpdateClusterMetadataResponse, error)
+	// DescribeVisibilityProcessor returns queue depth, in-flight bulks, pending acks and last commit error
+	// of the Elasticsearch bulk processors of history hosts, and optionally flushes them to debug visibility indexing stalls.
+	DescribeVisibilityProcessor(ctx context.Context, in *DescribeVisibilityProcessorRequest, opts ...grpc.CallOption) (*DescribeVisibilityProcessorResponse, error)
+	// DeleteNamespace marks a namespace as deleted, so that it doesn't accept new workflows anymore, and starts
+	// a job in the worker service which deletes all workflows, task queues and finally the namespace itself
+	// in the current cluster. Progress of the job is reported as heartbeat details of its activity.
+	// Data in archival stores is not deleted, the archival URIs of the namespace are reported in the progress.
+	DeleteNamespace(ctx context.Context, in *DeleteNamespaceRequest, opts ...grpc.CallOption) (*DeleteNamespaceResponse, error)
+	// DescribeNamespaceQuotas returns the quotas stored in metadata of a namespace.
+	DescribeNamespaceQuotas(ctx context.Context, in *DescribeNamespaceQuotasRequest, opts ...grpc.CallOption) (*DescribeNamespaceQuotasResponse, error)
+	// UpdateNamespaceQuotas replaces the quotas of a namespace, they are replicated to remote clusters
+	// of a global namespace.
+	UpdateNamespaceQuotas(ctx context.Context, in *UpdateNamespaceQuotasRequest, opts ...grpc.CallOption) (*UpdateNamespaceQuotasResponse, error)
 }
 
 type adminServiceClient struct {
@@ -205,6 +368,15 @@ func (c *adminServiceClient) GetWorkflowExecutionRawHistoryV2(ctx context.Contex
 	return out, nil
 }
 
+func (c *adminServiceClient) GetWorkflowExecutionHistoryReverse(ctx context.Context, in *GetWorkflowExecutionHistoryReverseRequest, opts ...grpc.CallOption) (*GetWorkflowExecutionHistoryReverseResponse, error) {
+	out := new(GetWorkflowExecutionHistoryReverseResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/GetWorkflowExecutionHistoryReverse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) GetReplicationMessages(ctx context.Context, in *GetReplicationMessagesRequest, opts ...grpc.CallOption) (*GetReplicationMessagesResponse, error) {
 	out := new(GetReplicationMessagesResponse)
 	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/GetReplicationMessages", in, out, opts...)
@@ -214,6 +386,69 @@ func (c *adminServiceClient) GetReplicationMessages(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *adminServiceClient) StreamReplicationMessages(ctx context.Context, opts ...grpc.CallOption) (AdminService_StreamReplicationMessagesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AdminService_serviceDesc.Streams[0], "/temporal.server.api.adminservice.v1.AdminService/StreamReplicationMessages", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &adminServiceStreamReplicationMessagesClient{stream}
+	return x, nil
+}
+
+type AdminService_StreamReplicationMessagesClient interface {
+	Send(*StreamReplicationMessagesRequest) error
+	Recv() (*StreamReplicationMessagesResponse, error)
+	grpc.ClientStream
+}
+
+type adminServiceStreamReplicationMessagesClient struct {
+	grpc.ClientStream
+}
+
+func (x *adminServiceStreamReplicationMessagesClient) Send(m *StreamReplicationMessagesRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *adminServiceStreamReplicationMessagesClient) Recv() (*StreamReplicationMessagesResponse, error) {
+	m := new(StreamReplicationMessagesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *adminServiceClient) StreamWorkflowExecutionHistory(ctx context.Context, in *StreamWorkflowExecutionHistoryRequest, opts ...grpc.CallOption) (AdminService_StreamWorkflowExecutionHistoryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AdminService_serviceDesc.Streams[1], "/temporal.server.api.adminservice.v1.AdminService/StreamWorkflowExecutionHistory", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &adminServiceStreamWorkflowExecutionHistoryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AdminService_StreamWorkflowExecutionHistoryClient interface {
+	Recv() (*StreamWorkflowExecutionHistoryResponse, error)
+	grpc.ClientStream
+}
+
+type adminServiceStreamWorkflowExecutionHistoryClient struct {
+	grpc.ClientStream
+}
+
+func (x *adminServiceStreamWorkflowExecutionHistoryClient) Recv() (*StreamWorkflowExecutionHistoryResponse, error) {
+	m := new(StreamWorkflowExecutionHistoryResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *adminServiceClient) GetNamespaceReplicationMessages(ctx context.Context, in *GetNamespaceReplicationMessagesRequest, opts ...grpc.CallOption) (*GetNamespaceReplicationMessagesResponse, error) {
 	out := new(GetNamespaceReplicationMessagesResponse)
 	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/GetNamespaceReplicationMessages", in, out, opts...)
@@ -322,6 +557,321 @@ func (c *adminServiceClient) ResendReplicationTasks(ctx context.Context, in *Res
 	return out, nil
 }
 
+func (c *adminServiceClient) ExecuteMultiOperation(ctx context.Context, in *ExecuteMultiOperationRequest, opts ...grpc.CallOption) (*ExecuteMultiOperationResponse, error) {
+	out := new(ExecuteMultiOperationResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ExecuteMultiOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListNamespaceFailoverHistory(ctx context.Context, in *ListNamespaceFailoverHistoryRequest, opts ...grpc.CallOption) (*ListNamespaceFailoverHistoryResponse, error) {
+	out := new(ListNamespaceFailoverHistoryResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ListNamespaceFailoverHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) HandoverNamespace(ctx context.Context, in *HandoverNamespaceRequest, opts ...grpc.CallOption) (*HandoverNamespaceResponse, error) {
+	out := new(HandoverNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/HandoverNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RecordWorkflowTaskHeartbeat(ctx context.Context, in *RecordWorkflowTaskHeartbeatRequest, opts ...grpc.CallOption) (*RecordWorkflowTaskHeartbeatResponse, error) {
+	out := new(RecordWorkflowTaskHeartbeatResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/RecordWorkflowTaskHeartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetReplicationLag(ctx context.Context, in *GetReplicationLagRequest, opts ...grpc.CallOption) (*GetReplicationLagResponse, error) {
+	out := new(GetReplicationLagResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/GetReplicationLag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CompactWorkflowHistory(ctx context.Context, in *CompactWorkflowHistoryRequest, opts ...grpc.CallOption) (*CompactWorkflowHistoryResponse, error) {
+	out := new(CompactWorkflowHistoryResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/CompactWorkflowHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListStaleWorkflowExecutions(ctx context.Context, in *ListStaleWorkflowExecutionsRequest, opts ...grpc.CallOption) (*ListStaleWorkflowExecutionsResponse, error) {
+	out := new(ListStaleWorkflowExecutionsResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ListStaleWorkflowExecutions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetDynamicConfig(ctx context.Context, in *GetDynamicConfigRequest, opts ...grpc.CallOption) (*GetDynamicConfigResponse, error) {
+	out := new(GetDynamicConfigResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/GetDynamicConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetDynamicConfig(ctx context.Context, in *SetDynamicConfigRequest, opts ...grpc.CallOption) (*SetDynamicConfigResponse, error) {
+	out := new(SetDynamicConfigResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/SetDynamicConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListDynamicConfig(ctx context.Context, in *ListDynamicConfigRequest, opts ...grpc.CallOption) (*ListDynamicConfigResponse, error) {
+	out := new(ListDynamicConfigResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ListDynamicConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListThrottledCallers(ctx context.Context, in *ListThrottledCallersRequest, opts ...grpc.CallOption) (*ListThrottledCallersResponse, error) {
+	out := new(ListThrottledCallersResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ListThrottledCallers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeTaskQueuePartitions(ctx context.Context, in *DescribeTaskQueuePartitionsRequest, opts ...grpc.CallOption) (*DescribeTaskQueuePartitionsResponse, error) {
+	out := new(DescribeTaskQueuePartitionsResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DescribeTaskQueuePartitions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetMaintenanceInfo(ctx context.Context, in *SetMaintenanceInfoRequest, opts ...grpc.CallOption) (*SetMaintenanceInfoResponse, error) {
+	out := new(SetMaintenanceInfoResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/SetMaintenanceInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateWorkerBuildIdCompatibility(ctx context.Context, in *UpdateWorkerBuildIdCompatibilityRequest, opts ...grpc.CallOption) (*UpdateWorkerBuildIdCompatibilityResponse, error) {
+	out := new(UpdateWorkerBuildIdCompatibilityResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/UpdateWorkerBuildIdCompatibility", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetWorkerBuildIdCompatibility(ctx context.Context, in *GetWorkerBuildIdCompatibilityRequest, opts ...grpc.CallOption) (*GetWorkerBuildIdCompatibilityResponse, error) {
+	out := new(GetWorkerBuildIdCompatibilityResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/GetWorkerBuildIdCompatibility", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ShutdownWorker(ctx context.Context, in *ShutdownWorkerRequest, opts ...grpc.CallOption) (*ShutdownWorkerResponse, error) {
+	out := new(ShutdownWorkerResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ShutdownWorker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ImportWorkflowExecution(ctx context.Context, in *ImportWorkflowExecutionRequest, opts ...grpc.CallOption) (*ImportWorkflowExecutionResponse, error) {
+	out := new(ImportWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ImportWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreateSchedule(ctx context.Context, in *CreateScheduleRequest, opts ...grpc.CallOption) (*CreateScheduleResponse, error) {
+	out := new(CreateScheduleResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/CreateSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeSchedule(ctx context.Context, in *DescribeScheduleRequest, opts ...grpc.CallOption) (*DescribeScheduleResponse, error) {
+	out := new(DescribeScheduleResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DescribeSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateSchedule(ctx context.Context, in *UpdateScheduleRequest, opts ...grpc.CallOption) (*UpdateScheduleResponse, error) {
+	out := new(UpdateScheduleResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/UpdateSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) PatchSchedule(ctx context.Context, in *PatchScheduleRequest, opts ...grpc.CallOption) (*PatchScheduleResponse, error) {
+	out := new(PatchScheduleResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/PatchSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteSchedule(ctx context.Context, in *DeleteScheduleRequest, opts ...grpc.CallOption) (*DeleteScheduleResponse, error) {
+	out := new(DeleteScheduleResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DeleteSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*ListSchedulesResponse, error) {
+	out := new(ListSchedulesResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ListSchedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) StartResetBadBinaryJob(ctx context.Context, in *StartResetBadBinaryJobRequest, opts ...grpc.CallOption) (*StartResetBadBinaryJobResponse, error) {
+	out := new(StartResetBadBinaryJobResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/StartResetBadBinaryJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetResetPoints(ctx context.Context, in *GetResetPointsRequest, opts ...grpc.CallOption) (*GetResetPointsResponse, error) {
+	out := new(GetResetPointsResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/GetResetPoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RebalanceShards(ctx context.Context, in *RebalanceShardsRequest, opts ...grpc.CallOption) (*RebalanceShardsResponse, error) {
+	out := new(RebalanceShardsResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/RebalanceShards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetShardStats(ctx context.Context, in *GetShardStatsRequest, opts ...grpc.CallOption) (*GetShardStatsResponse, error) {
+	out := new(GetShardStatsResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/GetShardStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetHostProfile(ctx context.Context, in *GetHostProfileRequest, opts ...grpc.CallOption) (*GetHostProfileResponse, error) {
+	out := new(GetHostProfileResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/GetHostProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeepHealthCheck(ctx context.Context, in *DeepHealthCheckRequest, opts ...grpc.CallOption) (*DeepHealthCheckResponse, error) {
+	out := new(DeepHealthCheckResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DeepHealthCheck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeClusterMetadata(ctx context.Context, in *DescribeClusterMetadataRequest, opts ...grpc.CallOption) (*DescribeClusterMetadataResponse, error) {
+	out := new(DescribeClusterMetadataResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DescribeClusterMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateClusterMetadata(ctx context.Context, in *UpdateClusterMetadataRequest, opts ...grpc.CallOption) (*UpdateClusterMetadataResponse, error) {
+	out := new(UpdateClusterMetadataResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/UpdateClusterMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeVisibilityProcessor(ctx context.Context, in *DescribeVisibilityProcessorRequest, opts ...grpc.CallOption) (*DescribeVisibilityProcessorResponse, error) {
+	out := new(DescribeVisibilityProcessorResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DescribeVisibilityProcessor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteNamespace(ctx context.Context, in *DeleteNamespaceRequest, opts ...grpc.CallOption) (*DeleteNamespaceResponse, error) {
+	out := new(DeleteNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DeleteNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeNamespaceQuotas(ctx context.Context, in *DescribeNamespaceQuotasRequest, opts ...grpc.CallOption) (*DescribeNamespaceQuotasResponse, error) {
+	out := new(DescribeNamespaceQuotasResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DescribeNamespaceQuotas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateNamespaceQuotas(ctx context.Context, in *UpdateNamespaceQuotasRequest, opts ...grpc.CallOption) (*UpdateNamespaceQuotasResponse, error) {
+	out := new(UpdateNamespaceQuotasResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/UpdateNamespaceQuotas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 type AdminServiceServer interface {
 	// DescribeWorkflowExecution returns information about the internal states of workflow execution.
@@ -335,8 +885,21 @@ type AdminServiceServer interface {
 	// StartEventId defines the beginning of the event to fetch. The first event is inclusive.
 	// EndEventId and EndEventVersion defines the end of the event to fetch. The end event is exclusive.
 	GetWorkflowExecutionRawHistoryV2(context.Context, *GetWorkflowExecutionRawHistoryV2Request) (*GetWorkflowExecutionRawHistoryV2Response, error)
+	// Returns the history of specified workflow execution with the most recent events first.
+	// Events can be optionally filtered by type, in which case pages may contain fewer events than requested.
+	// The number of events scanned by a single call is bounded, so a page can even be empty while the
+	// next page token is set, callers keep paging until the token is empty.
+	GetWorkflowExecutionHistoryReverse(context.Context, *GetWorkflowExecutionHistoryReverseRequest) (*GetWorkflowExecutionHistoryReverseResponse, error)
 	// GetReplicationMessages returns new replication tasks since the read level provided in the token.
 	GetReplicationMessages(context.Context, *GetReplicationMessagesRequest) (*GetReplicationMessagesResponse, error)
+	// StreamReplicationMessages pushes replication tasks of a single shard to a remote cluster as soon as they are available.
+	// Every request acks processed tasks and grants the server credit to send the next batch.
+	// Shard is identified by the replication-shard-id header of the stream.
+	StreamReplicationMessages(AdminService_StreamReplicationMessagesServer) error
+	// StreamWorkflowExecutionHistory streams history events of a workflow execution as they are appended, starting
+	// with the events already in history. The stream ends after the close event of the workflow is sent.
+	// Events are sent at the rate allowed for the stream, a slow receiver holds back reading of history.
+	StreamWorkflowExecutionHistory(*StreamWorkflowExecutionHistoryRequest, AdminService_StreamWorkflowExecutionHistoryServer) error
 	// GetNamespaceReplicationMessages returns new namespace replication tasks since last retrieved task Id.
 	GetNamespaceReplicationMessages(context.Context, *GetNamespaceReplicationMessagesRequest) (*GetNamespaceReplicationMessagesResponse, error)
 	// GetDLQReplicationMessages return replication messages based on DLQ info.
@@ -363,6 +926,105 @@ type AdminServiceServer interface {
 	RefreshWorkflowTasks(context.Context, *RefreshWorkflowTasksRequest) (*RefreshWorkflowTasksResponse, error)
 	// ResendReplicationTasks requests replication tasks from remote cluster and apply tasks to current cluster.
 	ResendReplicationTasks(context.Context, *ResendReplicationTasksRequest) (*ResendReplicationTasksResponse, error)
+	// ExecuteMultiOperation starts a workflow execution if it is not running and delivers the signals to it atomically.
+	// This gives update-with-start semantics without racing a separate signal against the start.
+	ExecuteMultiOperation(context.Context, *ExecuteMultiOperationRequest) (*ExecuteMultiOperationResponse, error)
+	// ListNamespaceFailoverHistory returns the most recent failovers of the namespace.
+	ListNamespaceFailoverHistory(context.Context, *ListNamespaceFailoverHistoryRequest) (*ListNamespaceFailoverHistoryResponse, error)
+	// HandoverNamespace gracefully fails over namespace to target cluster: namespace is made read-only on the
+	// current active cluster, replication to target cluster is drained, then target cluster becomes active.
+	HandoverNamespace(context.Context, *HandoverNamespaceRequest) (*HandoverNamespaceResponse, error)
+	// RecordWorkflowTaskHeartbeat extends start to close timeout of a workflow task which takes long to process
+	// (e.g. replay of large history). It is exposed here since public workflow service has no equivalent API yet.
+	RecordWorkflowTaskHeartbeat(context.Context, *RecordWorkflowTaskHeartbeatRequest) (*RecordWorkflowTaskHeartbeatResponse, error)
+	// GetReplicationLag returns replication progress, lag and DLQ depth of all history shards towards remote clusters.
+	GetReplicationLag(context.Context, *GetReplicationLagRequest) (*GetReplicationLagResponse, error)
+	// CompactWorkflowHistory rewrites the history event batches of a workflow into fewer, larger batches,
+	// which speeds up reading history of executions with many tiny event batches (e.g. caused by signal storms).
+	CompactWorkflowHistory(context.Context, *CompactWorkflowHistoryRequest) (*CompactWorkflowHistoryResponse, error)
+	// ListStaleWorkflowExecutions lists open workflow executions of a namespace which were started longer than
+	// the given duration ago. Results are read from the visibility store and paged.
+	ListStaleWorkflowExecutions(context.Context, *ListStaleWorkflowExecutionsRequest) (*ListStaleWorkflowExecutionsResponse, error)
+	// GetDynamicConfig returns dynamic config values of a key stored in the database.
+	GetDynamicConfig(context.Context, *GetDynamicConfigRequest) (*GetDynamicConfigResponse, error)
+	// SetDynamicConfig replaces dynamic config values of a key stored in the database.
+	// Values stored in the database take precedence over the values from the dynamic config file.
+	SetDynamicConfig(context.Context, *SetDynamicConfigRequest) (*SetDynamicConfigResponse, error)
+	// ListDynamicConfig returns all dynamic config values stored in the database.
+	ListDynamicConfig(context.Context, *ListDynamicConfigRequest) (*ListDynamicConfigResponse, error)
+	// ListThrottledCallers returns the namespaces and identities currently being throttled
+	// by the frontend, history and persistence rate limiters of the cluster.
+	ListThrottledCallers(context.Context, *ListThrottledCallersRequest) (*ListThrottledCallersResponse, error)
+	// DescribeTaskQueuePartitions returns pollers, approximate backlog, read and ack levels of every partition
+	// of a task queue.
+	DescribeTaskQueuePartitions(context.Context, *DescribeTaskQueuePartitionsRequest) (*DescribeTaskQueuePartitionsResponse, error)
+	// SetMaintenanceInfo sets the planned maintenance message which the frontend returns
+	// to clients in response headers. Empty message clears it.
+	SetMaintenanceInfo(context.Context, *SetMaintenanceInfoRequest) (*SetMaintenanceInfoResponse, error)
+	// UpdateWorkerBuildIdCompatibility updates the compatible worker build id sets of a task queue. Workflow tasks
+	// are only dispatched to workers with a build id compatible with the one which processed the workflow so far.
+	UpdateWorkerBuildIdCompatibility(context.Context, *UpdateWorkerBuildIdCompatibilityRequest) (*UpdateWorkerBuildIdCompatibilityResponse, error)
+	// GetWorkerBuildIdCompatibility returns the compatible worker build id sets of a task queue.
+	GetWorkerBuildIdCompatibility(context.Context, *GetWorkerBuildIdCompatibilityRequest) (*GetWorkerBuildIdCompatibilityResponse, error)
+	// ShutdownWorker notifies the server that a worker is shutting down. The sticky task queue of the worker
+	// is evicted, so workflows which are sticky to the worker are moved to other workers right away.
+	ShutdownWorker(context.Context, *ShutdownWorkerRequest) (*ShutdownWorkerResponse, error)
+	// ImportWorkflowExecution creates a workflow execution from the history and mutable state of the execution
+	// in another cluster. Events are applied with the replication semantics, i.e. the failover versions and
+	// history branches of the source cluster are kept. Once the last event of the mutable state snapshot is imported,
+	// the state which isn't derived from history, e.g. the activity heartbeat details and attempts, is synced
+	// from the snapshot the same way as for replicated activities.
+	ImportWorkflowExecution(context.Context, *ImportWorkflowExecutionRequest) (*ImportWorkflowExecutionResponse, error)
+	// CreateSchedule creates a schedule which takes its action, i.e. starts a workflow, at the times of its spec.
+	CreateSchedule(context.Context, *CreateScheduleRequest) (*CreateScheduleResponse, error)
+	// DescribeSchedule returns the schedule and its current state.
+	DescribeSchedule(context.Context, *DescribeScheduleRequest) (*DescribeScheduleResponse, error)
+	// UpdateSchedule replaces the schedule.
+	UpdateSchedule(context.Context, *UpdateScheduleRequest) (*UpdateScheduleResponse, error)
+	// PatchSchedule makes one-off changes to a schedule: trigger, backfill, pause and unpause.
+	PatchSchedule(context.Context, *PatchScheduleRequest) (*PatchScheduleResponse, error)
+	// DeleteSchedule deletes the schedule. Workflows started by the schedule are not affected.
+	DeleteSchedule(context.Context, *DeleteScheduleRequest) (*DeleteScheduleResponse, error)
+	// ListSchedules lists the schedules of a namespace.
+	ListSchedules(context.Context, *ListSchedulesRequest) (*ListSchedulesResponse, error)
+	// StartResetBadBinaryJob starts a batch job in the worker service which resets all executions of a namespace
+	// that ran the given bad binary checksum to the workflow task before the binary first completed a workflow task.
+	// The progress of the job is tracked like any other batch job.
+	StartResetBadBinaryJob(context.Context, *StartResetBadBinaryJobRequest) (*StartResetBadBinaryJobResponse, error)
+	// GetResetPoints returns the auto-reset points of a workflow execution, i.e. the first workflow task completed
+	// by each build id, so that a reset target can be chosen without parsing the history.
+	GetResetPoints(context.Context, *GetResetPointsRequest) (*GetResetPointsResponse, error)
+	// RebalanceShards evicts history shards from the hosts which hold them although the membership ring assigns
+	// them to another host, starting with the hosts owning the most shards. Evicted shards are acquired by the
+	// host the membership ring assigns them to.
+	RebalanceShards(context.Context, *RebalanceShardsRequest) (*RebalanceShardsResponse, error)
+	// GetShardStats returns owner host, ack levels, task backlog, lock contention and visibility lag of every history shard.
+	GetShardStats(context.Context, *GetShardStatsRequest) (*GetShardStatsResponse, error)
+	// GetHostProfile captures a pprof profile of a frontend, history or matching host.
+	GetHostProfile(context.Context, *GetHostProfileRequest) (*GetHostProfileResponse, error)
+	// DeepHealthCheck verifies persistence reads and writes, Elasticsearch connectivity, membership ring consistency
+	// and gRPC reachability of every frontend, history and matching host.
+	DeepHealthCheck(context.Context, *DeepHealthCheckRequest) (*DeepHealthCheckResponse, error)
+	// DescribeClusterMetadata returns the persisted failover version increment, cluster list and search attributes
+	// metadata, along with the version of the cluster metadata to pass to UpdateClusterMetadata.
+	DescribeClusterMetadata(context.Context, *DescribeClusterMetadataRequest) (*DescribeClusterMetadataResponse, error)
+	// UpdateClusterMetadata updates the failover version increment, cluster list and search attributes metadata
+	// in a single write, which is rejected if the cluster metadata was updated since the version of the request.
+	// Changes to the failover version increment and clusters take effect when the services are restarted.
+	UpdateClusterMetadata(context.Context, *UpdateClusterMetadataRequest) (*UpdateClusterMetadataResponse, error)
+	// DescribeVisibilityProcessor returns queue depth, in-flight bulks, pending acks and last commit error
+	// of the Elasticsearch bulk processors of history hosts, and optionally flushes them to debug visibility indexing stalls.
+	DescribeVisibilityProcessor(context.Context, *DescribeVisibilityProcessorRequest) (*DescribeVisibilityProcessorResponse, error)
+	// DeleteNamespace marks a namespace as deleted, so that it doesn't accept new workflows anymore, and starts
+	// a job in the worker service which deletes all workflows, task queues and finally the namespace itself
+	// in the current cluster. Progress of the job is reported as heartbeat details of its activity.
+	// Data in archival stores is not deleted, the archival URIs of the namespace are reported in the progress.
+	DeleteNamespace(context.Context, *DeleteNamespaceRequest) (*DeleteNamespaceResponse, error)
+	// DescribeNamespaceQuotas returns the quotas stored in metadata of a namespace.
+	DescribeNamespaceQuotas(context.Context, *DescribeNamespaceQuotasRequest) (*DescribeNamespaceQuotasResponse, error)
+	// UpdateNamespaceQuotas replaces the quotas of a namespace, they are replicated to remote clusters
+	// of a global namespace.
+	UpdateNamespaceQuotas(context.Context, *UpdateNamespaceQuotasRequest) (*UpdateNamespaceQuotasResponse, error)
 }
 
 // UnimplementedAdminServiceServer can be embedded to have forward compatible implementations.
@@ -384,9 +1046,18 @@ func (*UnimplementedAdminServiceServer) RemoveTask(ctx context.Context, req *Rem
 func (*UnimplementedAdminServiceServer) GetWorkflowExecutionRawHistoryV2(ctx context.Context, req *GetWorkflowExecutionRawHistoryV2Request) (*GetWorkflowExecutionRawHistoryV2Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowExecutionRawHistoryV2 not implemented")
 }
+func (*UnimplementedAdminServiceServer) GetWorkflowExecutionHistoryReverse(ctx context.Context, req *GetWorkflowExecutionHistoryReverseRequest) (*GetWorkflowExecutionHistoryReverseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowExecutionHistoryReverse not implemented")
+}
 func (*UnimplementedAdminServiceServer) GetReplicationMessages(ctx context.Context, req *GetReplicationMessagesRequest) (*GetReplicationMessagesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetReplicationMessages not implemented")
 }
+func (*UnimplementedAdminServiceServer) StreamReplicationMessages(srv AdminService_StreamReplicationMessagesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamReplicationMessages not implemented")
+}
+func (*UnimplementedAdminServiceServer) StreamWorkflowExecutionHistory(req *StreamWorkflowExecutionHistoryRequest, srv AdminService_StreamWorkflowExecutionHistoryServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamWorkflowExecutionHistory not implemented")
+}
 func (*UnimplementedAdminServiceServer) GetNamespaceReplicationMessages(ctx context.Context, req *GetNamespaceReplicationMessagesRequest) (*GetNamespaceReplicationMessagesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNamespaceReplicationMessages not implemented")
 }
@@ -408,346 +1079,1146 @@ func (*UnimplementedAdminServiceServer) GetSearchAttributes(ctx context.Context,
 func (*UnimplementedAdminServiceServer) DescribeCluster(ctx context.Context, req *DescribeClusterRequest) (*DescribeClusterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeCluster not implemented")
 }
-func (*UnimplementedAdminServiceServer) GetDLQMessages(ctx context.Context, req *GetDLQMessagesRequest) (*GetDLQMessagesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDLQMessages not implemented")
+func (*UnimplementedAdminServiceServer) GetDLQMessages(ctx context.Context, req *GetDLQMessagesRequest) (*GetDLQMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDLQMessages not implemented")
+}
+func (*UnimplementedAdminServiceServer) PurgeDLQMessages(ctx context.Context, req *PurgeDLQMessagesRequest) (*PurgeDLQMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeDLQMessages not implemented")
+}
+func (*UnimplementedAdminServiceServer) MergeDLQMessages(ctx context.Context, req *MergeDLQMessagesRequest) (*MergeDLQMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeDLQMessages not implemented")
+}
+func (*UnimplementedAdminServiceServer) RefreshWorkflowTasks(ctx context.Context, req *RefreshWorkflowTasksRequest) (*RefreshWorkflowTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshWorkflowTasks not implemented")
+}
+func (*UnimplementedAdminServiceServer) ResendReplicationTasks(ctx context.Context, req *ResendReplicationTasksRequest) (*ResendReplicationTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResendReplicationTasks not implemented")
+}
+func (*UnimplementedAdminServiceServer) ExecuteMultiOperation(ctx context.Context, req *ExecuteMultiOperationRequest) (*ExecuteMultiOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteMultiOperation not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListNamespaceFailoverHistory(ctx context.Context, req *ListNamespaceFailoverHistoryRequest) (*ListNamespaceFailoverHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNamespaceFailoverHistory not implemented")
+}
+func (*UnimplementedAdminServiceServer) HandoverNamespace(ctx context.Context, req *HandoverNamespaceRequest) (*HandoverNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandoverNamespace not implemented")
+}
+func (*UnimplementedAdminServiceServer) RecordWorkflowTaskHeartbeat(ctx context.Context, req *RecordWorkflowTaskHeartbeatRequest) (*RecordWorkflowTaskHeartbeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordWorkflowTaskHeartbeat not implemented")
+}
+func (*UnimplementedAdminServiceServer) GetReplicationLag(ctx context.Context, req *GetReplicationLagRequest) (*GetReplicationLagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReplicationLag not implemented")
+}
+func (*UnimplementedAdminServiceServer) CompactWorkflowHistory(ctx context.Context, req *CompactWorkflowHistoryRequest) (*CompactWorkflowHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompactWorkflowHistory not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListStaleWorkflowExecutions(ctx context.Context, req *ListStaleWorkflowExecutionsRequest) (*ListStaleWorkflowExecutionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStaleWorkflowExecutions not implemented")
+}
+func (*UnimplementedAdminServiceServer) GetDynamicConfig(ctx context.Context, req *GetDynamicConfigRequest) (*GetDynamicConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDynamicConfig not implemented")
+}
+func (*UnimplementedAdminServiceServer) SetDynamicConfig(ctx context.Context, req *SetDynamicConfigRequest) (*SetDynamicConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDynamicConfig not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListDynamicConfig(ctx context.Context, req *ListDynamicConfigRequest) (*ListDynamicConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDynamicConfig not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListThrottledCallers(ctx context.Context, req *ListThrottledCallersRequest) (*ListThrottledCallersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListThrottledCallers not implemented")
+}
+func (*UnimplementedAdminServiceServer) DescribeTaskQueuePartitions(ctx context.Context, req *DescribeTaskQueuePartitionsRequest) (*DescribeTaskQueuePartitionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeTaskQueuePartitions not implemented")
+}
+func (*UnimplementedAdminServiceServer) SetMaintenanceInfo(ctx context.Context, req *SetMaintenanceInfoRequest) (*SetMaintenanceInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMaintenanceInfo not implemented")
+}
+func (*UnimplementedAdminServiceServer) UpdateWorkerBuildIdCompatibility(ctx context.Context, req *UpdateWorkerBuildIdCompatibilityRequest) (*UpdateWorkerBuildIdCompatibilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkerBuildIdCompatibility not implemented")
+}
+func (*UnimplementedAdminServiceServer) GetWorkerBuildIdCompatibility(ctx context.Context, req *GetWorkerBuildIdCompatibilityRequest) (*GetWorkerBuildIdCompatibilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerBuildIdCompatibility not implemented")
+}
+func (*UnimplementedAdminServiceServer) ShutdownWorker(ctx context.Context, req *ShutdownWorkerRequest) (*ShutdownWorkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShutdownWorker not implemented")
+}
+func (*UnimplementedAdminServiceServer) ImportWorkflowExecution(ctx context.Context, req *ImportWorkflowExecutionRequest) (*ImportWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportWorkflowExecution not implemented")
+}
+func (*UnimplementedAdminServiceServer) CreateSchedule(ctx context.Context, req *CreateScheduleRequest) (*CreateScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSchedule not implemented")
+}
+func (*UnimplementedAdminServiceServer) DescribeSchedule(ctx context.Context, req *DescribeScheduleRequest) (*DescribeScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeSchedule not implemented")
+}
+func (*UnimplementedAdminServiceServer) UpdateSchedule(ctx context.Context, req *UpdateScheduleRequest) (*UpdateScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSchedule not implemented")
+}
+func (*UnimplementedAdminServiceServer) PatchSchedule(ctx context.Context, req *PatchScheduleRequest) (*PatchScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchSchedule not implemented")
+}
+func (*UnimplementedAdminServiceServer) DeleteSchedule(ctx context.Context, req *DeleteScheduleRequest) (*DeleteScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSchedule not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListSchedules(ctx context.Context, req *ListSchedulesRequest) (*ListSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSchedules not implemented")
+}
+func (*UnimplementedAdminServiceServer) StartResetBadBinaryJob(ctx context.Context, req *StartResetBadBinaryJobRequest) (*StartResetBadBinaryJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartResetBadBinaryJob not implemented")
+}
+func (*UnimplementedAdminServiceServer) GetResetPoints(ctx context.Context, req *GetResetPointsRequest) (*GetResetPointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResetPoints not implemented")
+}
+func (*UnimplementedAdminServiceServer) RebalanceShards(ctx context.Context, req *RebalanceShardsRequest) (*RebalanceShardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebalanceShards not implemented")
+}
+func (*UnimplementedAdminServiceServer) GetShardStats(ctx context.Context, req *GetShardStatsRequest) (*GetShardStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShardStats not implemented")
+}
+func (*UnimplementedAdminServiceServer) GetHostProfile(ctx context.Context, req *GetHostProfileRequest) (*GetHostProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHostProfile not implemented")
+}
+func (*UnimplementedAdminServiceServer) DeepHealthCheck(ctx context.Context, req *DeepHealthCheckRequest) (*DeepHealthCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeepHealthCheck not implemented")
+}
+func (*UnimplementedAdminServiceServer) DescribeClusterMetadata(ctx context.Context, req *DescribeClusterMetadataRequest) (*DescribeClusterMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeClusterMetadata not implemented")
+}
+func (*UnimplementedAdminServiceServer) UpdateClusterMetadata(ctx context.Context, req *UpdateClusterMetadataRequest) (*UpdateClusterMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClusterMetadata not implemented")
+}
+func (*UnimplementedAdminServiceServer) DescribeVisibilityProcessor(ctx context.Context, req *DescribeVisibilityProcessorRequest) (*DescribeVisibilityProcessorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeVisibilityProcessor not implemented")
+}
+func (*UnimplementedAdminServiceServer) DeleteNamespace(ctx context.Context, req *DeleteNamespaceRequest) (*DeleteNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNamespace not implemented")
+}
+func (*UnimplementedAdminServiceServer) DescribeNamespaceQuotas(ctx context.Context, req *DescribeNamespaceQuotasRequest) (*DescribeNamespaceQuotasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeNamespaceQuotas not implemented")
+}
+func (*UnimplementedAdminServiceServer) UpdateNamespaceQuotas(ctx context.Context, req *UpdateNamespaceQuotasRequest) (*UpdateNamespaceQuotasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNamespaceQuotas not implemented")
+}
+
+func RegisterAdminServiceServer(s *grpc.Server, srv AdminServiceServer) {
+	s.RegisterService(&_AdminService_serviceDesc, srv)
+}
+
+func _AdminService_DescribeMutableState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeMutableStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeMutableState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeMutableState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeMutableState(ctx, req.(*DescribeMutableStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeHistoryHost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeHistoryHostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeHistoryHost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeHistoryHost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeHistoryHost(ctx, req.(*DescribeHistoryHostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CloseShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseShardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CloseShard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/CloseShard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CloseShard(ctx, req.(*CloseShardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RemoveTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RemoveTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/RemoveTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RemoveTask(ctx, req.(*RemoveTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetWorkflowExecutionRawHistoryV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkflowExecutionRawHistoryV2Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetWorkflowExecutionRawHistoryV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetWorkflowExecutionRawHistoryV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetWorkflowExecutionRawHistoryV2(ctx, req.(*GetWorkflowExecutionRawHistoryV2Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetWorkflowExecutionHistoryReverse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkflowExecutionHistoryReverseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetWorkflowExecutionHistoryReverse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetWorkflowExecutionHistoryReverse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetWorkflowExecutionHistoryReverse(ctx, req.(*GetWorkflowExecutionHistoryReverseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetReplicationMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReplicationMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetReplicationMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetReplicationMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetReplicationMessages(ctx, req.(*GetReplicationMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_StreamReplicationMessages_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AdminServiceServer).StreamReplicationMessages(&adminServiceStreamReplicationMessagesServer{stream})
+}
+
+type AdminService_StreamReplicationMessagesServer interface {
+	Send(*StreamReplicationMessagesResponse) error
+	Recv() (*StreamReplicationMessagesRequest, error)
+	grpc.ServerStream
+}
+
+type adminServiceStreamReplicationMessagesServer struct {
+	grpc.ServerStream
+}
+
+func (x *adminServiceStreamReplicationMessagesServer) Send(m *StreamReplicationMessagesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *adminServiceStreamReplicationMessagesServer) Recv() (*StreamReplicationMessagesRequest, error) {
+	m := new(StreamReplicationMessagesRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _AdminService_StreamWorkflowExecutionHistory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamWorkflowExecutionHistoryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AdminServiceServer).StreamWorkflowExecutionHistory(m, &adminServiceStreamWorkflowExecutionHistoryServer{stream})
+}
+
+type AdminService_StreamWorkflowExecutionHistoryServer interface {
+	Send(*StreamWorkflowExecutionHistoryResponse) error
+	grpc.ServerStream
+}
+
+type adminServiceStreamWorkflowExecutionHistoryServer struct {
+	grpc.ServerStream
+}
+
+func (x *adminServiceStreamWorkflowExecutionHistoryServer) Send(m *StreamWorkflowExecutionHistoryResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AdminService_GetNamespaceReplicationMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNamespaceReplicationMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetNamespaceReplicationMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetNamespaceReplicationMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetNamespaceReplicationMessages(ctx, req.(*GetNamespaceReplicationMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetDLQReplicationMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDLQReplicationMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetDLQReplicationMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetDLQReplicationMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetDLQReplicationMessages(ctx, req.(*GetDLQReplicationMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ReapplyEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReapplyEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ReapplyEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ReapplyEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ReapplyEvents(ctx, req.(*ReapplyEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AddSearchAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSearchAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AddSearchAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/AddSearchAttributes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AddSearchAttributes(ctx, req.(*AddSearchAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RemoveSearchAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveSearchAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RemoveSearchAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/RemoveSearchAttributes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RemoveSearchAttributes(ctx, req.(*RemoveSearchAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetSearchAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSearchAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetSearchAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetSearchAttributes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetSearchAttributes(ctx, req.(*GetSearchAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeCluster(ctx, req.(*DescribeClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetDLQMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDLQMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetDLQMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetDLQMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetDLQMessages(ctx, req.(*GetDLQMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_PurgeDLQMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeDLQMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PurgeDLQMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/PurgeDLQMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PurgeDLQMessages(ctx, req.(*PurgeDLQMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_MergeDLQMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeDLQMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).MergeDLQMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/MergeDLQMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).MergeDLQMessages(ctx, req.(*MergeDLQMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RefreshWorkflowTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshWorkflowTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RefreshWorkflowTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/RefreshWorkflowTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RefreshWorkflowTasks(ctx, req.(*RefreshWorkflowTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ResendReplicationTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResendReplicationTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ResendReplicationTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ResendReplicationTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ResendReplicationTasks(ctx, req.(*ResendReplicationTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ExecuteMultiOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteMultiOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ExecuteMultiOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ExecuteMultiOperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ExecuteMultiOperation(ctx, req.(*ExecuteMultiOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListNamespaceFailoverHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNamespaceFailoverHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListNamespaceFailoverHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ListNamespaceFailoverHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListNamespaceFailoverHistory(ctx, req.(*ListNamespaceFailoverHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_HandoverNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandoverNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).HandoverNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/HandoverNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).HandoverNamespace(ctx, req.(*HandoverNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RecordWorkflowTaskHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordWorkflowTaskHeartbeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RecordWorkflowTaskHeartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/RecordWorkflowTaskHeartbeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RecordWorkflowTaskHeartbeat(ctx, req.(*RecordWorkflowTaskHeartbeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetReplicationLag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReplicationLagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetReplicationLag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetReplicationLag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetReplicationLag(ctx, req.(*GetReplicationLagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CompactWorkflowHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompactWorkflowHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CompactWorkflowHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/CompactWorkflowHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CompactWorkflowHistory(ctx, req.(*CompactWorkflowHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListStaleWorkflowExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStaleWorkflowExecutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListStaleWorkflowExecutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ListStaleWorkflowExecutions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListStaleWorkflowExecutions(ctx, req.(*ListStaleWorkflowExecutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetDynamicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDynamicConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetDynamicConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetDynamicConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetDynamicConfig(ctx, req.(*GetDynamicConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetDynamicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDynamicConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetDynamicConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/SetDynamicConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetDynamicConfig(ctx, req.(*SetDynamicConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListDynamicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDynamicConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListDynamicConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ListDynamicConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListDynamicConfig(ctx, req.(*ListDynamicConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListThrottledCallers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListThrottledCallersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListThrottledCallers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ListThrottledCallers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListThrottledCallers(ctx, req.(*ListThrottledCallersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeTaskQueuePartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeTaskQueuePartitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeTaskQueuePartitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeTaskQueuePartitions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeTaskQueuePartitions(ctx, req.(*DescribeTaskQueuePartitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAdminServiceServer) PurgeDLQMessages(ctx context.Context, req *PurgeDLQMessagesRequest) (*PurgeDLQMessagesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PurgeDLQMessages not implemented")
+
+func _AdminService_SetMaintenanceInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMaintenanceInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetMaintenanceInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/SetMaintenanceInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetMaintenanceInfo(ctx, req.(*SetMaintenanceInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAdminServiceServer) MergeDLQMessages(ctx context.Context, req *MergeDLQMessagesRequest) (*MergeDLQMessagesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MergeDLQMessages not implemented")
+
+func _AdminService_UpdateWorkerBuildIdCompatibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkerBuildIdCompatibilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateWorkerBuildIdCompatibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/UpdateWorkerBuildIdCompatibility",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateWorkerBuildIdCompatibility(ctx, req.(*UpdateWorkerBuildIdCompatibilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAdminServiceServer) RefreshWorkflowTasks(ctx context.Context, req *RefreshWorkflowTasksRequest) (*RefreshWorkflowTasksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RefreshWorkflowTasks not implemented")
+
+func _AdminService_GetWorkerBuildIdCompatibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkerBuildIdCompatibilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetWorkerBuildIdCompatibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetWorkerBuildIdCompatibility",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetWorkerBuildIdCompatibility(ctx, req.(*GetWorkerBuildIdCompatibilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAdminServiceServer) ResendReplicationTasks(ctx context.Context, req *ResendReplicationTasksRequest) (*ResendReplicationTasksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ResendReplicationTasks not implemented")
+
+func _AdminService_ShutdownWorker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShutdownWorkerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ShutdownWorker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ShutdownWorker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ShutdownWorker(ctx, req.(*ShutdownWorkerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterAdminServiceServer(s *grpc.Server, srv AdminServiceServer) {
-	s.RegisterService(&_AdminService_serviceDesc, srv)
+func _AdminService_ImportWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ImportWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ImportWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ImportWorkflowExecution(ctx, req.(*ImportWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_DescribeMutableState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DescribeMutableStateRequest)
+func _AdminService_CreateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScheduleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).DescribeMutableState(ctx, in)
+		return srv.(AdminServiceServer).CreateSchedule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeMutableState",
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/CreateSchedule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).DescribeMutableState(ctx, req.(*DescribeMutableStateRequest))
+		return srv.(AdminServiceServer).CreateSchedule(ctx, req.(*CreateScheduleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_DescribeHistoryHost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DescribeHistoryHostRequest)
+func _AdminService_DescribeSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeScheduleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).DescribeHistoryHost(ctx, in)
+		return srv.(AdminServiceServer).DescribeSchedule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeHistoryHost",
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeSchedule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).DescribeHistoryHost(ctx, req.(*DescribeHistoryHostRequest))
+		return srv.(AdminServiceServer).DescribeSchedule(ctx, req.(*DescribeScheduleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_CloseShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CloseShardRequest)
+func _AdminService_UpdateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateScheduleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).CloseShard(ctx, in)
+		return srv.(AdminServiceServer).UpdateSchedule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/CloseShard",
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/UpdateSchedule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).CloseShard(ctx, req.(*CloseShardRequest))
+		return srv.(AdminServiceServer).UpdateSchedule(ctx, req.(*UpdateScheduleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_RemoveTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveTaskRequest)
+func _AdminService_PatchSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchScheduleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).RemoveTask(ctx, in)
+		return srv.(AdminServiceServer).PatchSchedule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/RemoveTask",
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/PatchSchedule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).RemoveTask(ctx, req.(*RemoveTaskRequest))
+		return srv.(AdminServiceServer).PatchSchedule(ctx, req.(*PatchScheduleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_GetWorkflowExecutionRawHistoryV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetWorkflowExecutionRawHistoryV2Request)
+func _AdminService_DeleteSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteScheduleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).GetWorkflowExecutionRawHistoryV2(ctx, in)
+		return srv.(AdminServiceServer).DeleteSchedule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetWorkflowExecutionRawHistoryV2",
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DeleteSchedule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).GetWorkflowExecutionRawHistoryV2(ctx, req.(*GetWorkflowExecutionRawHistoryV2Request))
+		return srv.(AdminServiceServer).DeleteSchedule(ctx, req.(*DeleteScheduleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_GetReplicationMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetReplicationMessagesRequest)
+func _AdminService_ListSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSchedulesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).GetReplicationMessages(ctx, in)
+		return srv.(AdminServiceServer).ListSchedules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetReplicationMessages",
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ListSchedules",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).GetReplicationMessages(ctx, req.(*GetReplicationMessagesRequest))
+		return srv.(AdminServiceServer).ListSchedules(ctx, req.(*ListSchedulesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_GetNamespaceReplicationMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetNamespaceReplicationMessagesRequest)
+func _AdminService_StartResetBadBinaryJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartResetBadBinaryJobRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).GetNamespaceReplicationMessages(ctx, in)
+		return srv.(AdminServiceServer).StartResetBadBinaryJob(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetNamespaceReplicationMessages",
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/StartResetBadBinaryJob",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).GetNamespaceReplicationMessages(ctx, req.(*GetNamespaceReplicationMessagesRequest))
+		return srv.(AdminServiceServer).StartResetBadBinaryJob(ctx, req.(*StartResetBadBinaryJobRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_GetDLQReplicationMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDLQReplicationMessagesRequest)
+func _AdminService_GetResetPoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResetPointsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).GetDLQReplicationMessages(ctx, in)
+		return srv.(AdminServiceServer).GetResetPoints(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetDLQReplicationMessages",
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetResetPoints",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).GetDLQReplicationMessages(ctx, req.(*GetDLQReplicationMessagesRequest))
+		return srv.(AdminServiceServer).GetResetPoints(ctx, req.(*GetResetPointsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_ReapplyEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReapplyEventsRequest)
+func _AdminService_RebalanceShards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebalanceShardsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).ReapplyEvents(ctx, in)
+		return srv.(AdminServiceServer).RebalanceShards(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ReapplyEvents",
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/RebalanceShards",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).ReapplyEvents(ctx, req.(*ReapplyEventsRequest))
+		return srv.(AdminServiceServer).RebalanceShards(ctx, req.(*RebalanceShardsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_AddSearchAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddSearchAttributesRequest)
+func _AdminService_GetShardStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShardStatsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).AddSearchAttributes(ctx, in)
+		return srv.(AdminServiceServer).GetShardStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/AddSearchAttributes",
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetShardStats",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).AddSearchAttributes(ctx, req.(*AddSearchAttributesRequest))
+		return srv.(AdminServiceServer).GetShardStats(ctx, req.(*GetShardStatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_RemoveSearchAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveSearchAttributesRequest)
+func _AdminService_GetHostProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHostProfileRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).RemoveSearchAttributes(ctx, in)
+		return srv.(AdminServiceServer).GetHostProfile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/RemoveSearchAttributes",
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetHostProfile",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).RemoveSearchAttributes(ctx, req.(*RemoveSearchAttributesRequest))
+		return srv.(AdminServiceServer).GetHostProfile(ctx, req.(*GetHostProfileRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_GetSearchAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSearchAttributesRequest)
+func _AdminService_DeepHealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeepHealthCheckRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).GetSearchAttributes(ctx, in)
+		return srv.(AdminServiceServer).DeepHealthCheck(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetSearchAttributes",
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DeepHealthCheck",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).GetSearchAttributes(ctx, req.(*GetSearchAttributesRequest))
+		return srv.(AdminServiceServer).DeepHealthCheck(ctx, req.(*DeepHealthCheckRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_DescribeCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DescribeClusterRequest)
+func _AdminService_DescribeClusterMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeClusterMetadataRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).DescribeCluster(ctx, in)
+		return srv.(AdminServiceServer).DescribeClusterMetadata(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeCluster",
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeClusterMetadata",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).DescribeCluster(ctx, req.(*DescribeClusterRequest))
+		return srv.(AdminServiceServer).DescribeClusterMetadata(ctx, req.(*DescribeClusterMetadataRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_GetDLQMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDLQMessagesRequest)
+func _AdminService_UpdateClusterMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateClusterMetadataRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).GetDLQMessages(ctx, in)
+		return srv.(AdminServiceServer).UpdateClusterMetadata(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetDLQMessages",
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/UpdateClusterMetadata",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).GetDLQMessages(ctx, req.(*GetDLQMessagesRequest))
+		return srv.(AdminServiceServer).UpdateClusterMetadata(ctx, req.(*UpdateClusterMetadataRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_PurgeDLQMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PurgeDLQMessagesRequest)
+func _AdminService_DescribeVisibilityProcessor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeVisibilityProcessorRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).PurgeDLQMessages(ctx, in)
+		return srv.(AdminServiceServer).DescribeVisibilityProcessor(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/PurgeDLQMessages",
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeVisibilityProcessor",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).PurgeDLQMessages(ctx, req.(*PurgeDLQMessagesRequest))
+		return srv.(AdminServiceServer).DescribeVisibilityProcessor(ctx, req.(*DescribeVisibilityProcessorRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_MergeDLQMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MergeDLQMessagesRequest)
+func _AdminService_DeleteNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNamespaceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).MergeDLQMessages(ctx, in)
+		return srv.(AdminServiceServer).DeleteNamespace(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/MergeDLQMessages",
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DeleteNamespace",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).MergeDLQMessages(ctx, req.(*MergeDLQMessagesRequest))
+		return srv.(AdminServiceServer).DeleteNamespace(ctx, req.(*DeleteNamespaceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_RefreshWorkflowTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RefreshWorkflowTasksRequest)
+func _AdminService_DescribeNamespaceQuotas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeNamespaceQuotasRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).RefreshWorkflowTasks(ctx, in)
+		return srv.(AdminServiceServer).DescribeNamespaceQuotas(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/RefreshWorkflowTasks",
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeNamespaceQuotas",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).RefreshWorkflowTasks(ctx, req.(*RefreshWorkflowTasksRequest))
+		return srv.(AdminServiceServer).DescribeNamespaceQuotas(ctx, req.(*DescribeNamespaceQuotasRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_ResendReplicationTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResendReplicationTasksRequest)
+func _AdminService_UpdateNamespaceQuotas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNamespaceQuotasRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).ResendReplicationTasks(ctx, in)
+		return srv.(AdminServiceServer).UpdateNamespaceQuotas(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ResendReplicationTasks",
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/UpdateNamespaceQuotas",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).ResendReplicationTasks(ctx, req.(*ResendReplicationTasksRequest))
+		return srv.(AdminServiceServer).UpdateNamespaceQuotas(ctx, req.(*UpdateNamespaceQuotasRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -776,6 +2247,10 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetWorkflowExecutionRawHistoryV2",
 			Handler:    _AdminService_GetWorkflowExecutionRawHistoryV2_Handler,
 		},
+		{
+			MethodName: "GetWorkflowExecutionHistoryReverse",
+			Handler:    _AdminService_GetWorkflowExecutionHistoryReverse_Handler,
+		},
 		{
 			MethodName: "GetReplicationMessages",
 			Handler:    _AdminService_GetReplicationMessages_Handler,
@@ -828,7 +2303,159 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ResendReplicationTasks",
 			Handler:    _AdminService_ResendReplicationTasks_Handler,
 		},
+		{
+			MethodName: "ExecuteMultiOperation",
+			Handler:    _AdminService_ExecuteMultiOperation_Handler,
+		},
+		{
+			MethodName: "ListNamespaceFailoverHistory",
+			Handler:    _AdminService_ListNamespaceFailoverHistory_Handler,
+		},
+		{
+			MethodName: "HandoverNamespace",
+			Handler:    _AdminService_HandoverNamespace_Handler,
+		},
+		{
+			MethodName: "RecordWorkflowTaskHeartbeat",
+			Handler:    _AdminService_RecordWorkflowTaskHeartbeat_Handler,
+		},
+		{
+			MethodName: "GetReplicationLag",
+			Handler:    _AdminService_GetReplicationLag_Handler,
+		},
+		{
+			MethodName: "CompactWorkflowHistory",
+			Handler:    _AdminService_CompactWorkflowHistory_Handler,
+		},
+		{
+			MethodName: "ListStaleWorkflowExecutions",
+			Handler:    _AdminService_ListStaleWorkflowExecutions_Handler,
+		},
+		{
+			MethodName: "GetDynamicConfig",
+			Handler:    _AdminService_GetDynamicConfig_Handler,
+		},
+		{
+			MethodName: "SetDynamicConfig",
+			Handler:    _AdminService_SetDynamicConfig_Handler,
+		},
+		{
+			MethodName: "ListDynamicConfig",
+			Handler:    _AdminService_ListDynamicConfig_Handler,
+		},
+		{
+			MethodName: "ListThrottledCallers",
+			Handler:    _AdminService_ListThrottledCallers_Handler,
+		},
+		{
+			MethodName: "DescribeTaskQueuePartitions",
+			Handler:    _AdminService_DescribeTaskQueuePartitions_Handler,
+		},
+		{
+			MethodName: "SetMaintenanceInfo",
+			Handler:    _AdminService_SetMaintenanceInfo_Handler,
+		},
+		{
+			MethodName: "UpdateWorkerBuildIdCompatibility",
+			Handler:    _AdminService_UpdateWorkerBuildIdCompatibility_Handler,
+		},
+		{
+			MethodName: "GetWorkerBuildIdCompatibility",
+			Handler:    _AdminService_GetWorkerBuildIdCompatibility_Handler,
+		},
+		{
+			MethodName: "ShutdownWorker",
+			Handler:    _AdminService_ShutdownWorker_Handler,
+		},
+		{
+			MethodName: "ImportWorkflowExecution",
+			Handler:    _AdminService_ImportWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "CreateSchedule",
+			Handler:    _AdminService_CreateSchedule_Handler,
+		},
+		{
+			MethodName: "DescribeSchedule",
+			Handler:    _AdminService_DescribeSchedule_Handler,
+		},
+		{
+			MethodName: "UpdateSchedule",
+			Handler:    _AdminService_UpdateSchedule_Handler,
+		},
+		{
+			MethodName: "PatchSchedule",
+			Handler:    _AdminService_PatchSchedule_Handler,
+		},
+		{
+			MethodName: "DeleteSchedule",
+			Handler:    _AdminService_DeleteSchedule_Handler,
+		},
+		{
+			MethodName: "ListSchedules",
+			Handler:    _AdminService_ListSchedules_Handler,
+		},
+		{
+			MethodName: "StartResetBadBinaryJob",
+			Handler:    _AdminService_StartResetBadBinaryJob_Handler,
+		},
+		{
+			MethodName: "GetResetPoints",
+			Handler:    _AdminService_GetResetPoints_Handler,
+		},
+		{
+			MethodName: "RebalanceShards",
+			Handler:    _AdminService_RebalanceShards_Handler,
+		},
+		{
+			MethodName: "GetShardStats",
+			Handler:    _AdminService_GetShardStats_Handler,
+		},
+		{
+			MethodName: "GetHostProfile",
+			Handler:    _AdminService_GetHostProfile_Handler,
+		},
+		{
+			MethodName: "DeepHealthCheck",
+			Handler:    _AdminService_DeepHealthCheck_Handler,
+		},
+		{
+			MethodName: "DescribeClusterMetadata",
+			Handler:    _AdminService_DescribeClusterMetadata_Handler,
+		},
+		{
+			MethodName: "UpdateClusterMetadata",
+			Handler:    _AdminService_UpdateClusterMetadata_Handler,
+		},
+		{
+			MethodName: "DescribeVisibilityProcessor",
+			Handler:    _AdminService_DescribeVisibilityProcessor_Handler,
+		},
+		{
+			MethodName: "DeleteNamespace",
+			Handler:    _AdminService_DeleteNamespace_Handler,
+		},
+		{
+			MethodName: "DescribeNamespaceQuotas",
+			Handler:    _AdminService_DescribeNamespaceQuotas_Handler,
+		},
+		{
+			MethodName: "UpdateNamespaceQuotas",
+			Handler:    _AdminService_UpdateNamespaceQuotas_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamReplicationMessages",
+			Handler:       _AdminService_StreamReplicationMessages_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "StreamWorkflowExecutionHistory",
+			Handler:       _AdminService_StreamWorkflowExecutionHistory_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "temporal/server/api/adminservice/v1/service.proto",
 }
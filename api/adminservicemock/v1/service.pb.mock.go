@@ -35,6 +35,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	adminservice "go.temporal.io/server/api/adminservice/v1"
 	grpc "google.golang.org/grpc"
+	metadata "google.golang.org/grpc/metadata"
 )
 
 // MockAdminServiceClient is a mock of AdminServiceClient interface.
@@ -100,6 +101,106 @@ func (mr *MockAdminServiceClientMockRecorder) CloseShard(ctx, in interface{}, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseShard", reflect.TypeOf((*MockAdminServiceClient)(nil).CloseShard), varargs...)
 }
 
+// CompactWorkflowHistory mocks base method.
+func (m *MockAdminServiceClient) CompactWorkflowHistory(ctx context.Context, in *adminservice.CompactWorkflowHistoryRequest, opts ...grpc.CallOption) (*adminservice.CompactWorkflowHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CompactWorkflowHistory", varargs...)
+	ret0, _ := ret[0].(*adminservice.CompactWorkflowHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompactWorkflowHistory indicates an expected call of CompactWorkflowHistory.
+func (mr *MockAdminServiceClientMockRecorder) CompactWorkflowHistory(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompactWorkflowHistory", reflect.TypeOf((*MockAdminServiceClient)(nil).CompactWorkflowHistory), varargs...)
+}
+
+// CreateSchedule mocks base method.
+func (m *MockAdminServiceClient) CreateSchedule(ctx context.Context, in *adminservice.CreateScheduleRequest, opts ...grpc.CallOption) (*adminservice.CreateScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateSchedule", varargs...)
+	ret0, _ := ret[0].(*adminservice.CreateScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSchedule indicates an expected call of CreateSchedule.
+func (mr *MockAdminServiceClientMockRecorder) CreateSchedule(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSchedule", reflect.TypeOf((*MockAdminServiceClient)(nil).CreateSchedule), varargs...)
+}
+
+// DeepHealthCheck mocks base method.
+func (m *MockAdminServiceClient) DeepHealthCheck(ctx context.Context, in *adminservice.DeepHealthCheckRequest, opts ...grpc.CallOption) (*adminservice.DeepHealthCheckResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeepHealthCheck", varargs...)
+	ret0, _ := ret[0].(*adminservice.DeepHealthCheckResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeepHealthCheck indicates an expected call of DeepHealthCheck.
+func (mr *MockAdminServiceClientMockRecorder) DeepHealthCheck(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeepHealthCheck", reflect.TypeOf((*MockAdminServiceClient)(nil).DeepHealthCheck), varargs...)
+}
+
+// DeleteNamespace mocks base method.
+func (m *MockAdminServiceClient) DeleteNamespace(ctx context.Context, in *adminservice.DeleteNamespaceRequest, opts ...grpc.CallOption) (*adminservice.DeleteNamespaceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteNamespace", varargs...)
+	ret0, _ := ret[0].(*adminservice.DeleteNamespaceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNamespace indicates an expected call of DeleteNamespace.
+func (mr *MockAdminServiceClientMockRecorder) DeleteNamespace(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNamespace", reflect.TypeOf((*MockAdminServiceClient)(nil).DeleteNamespace), varargs...)
+}
+
+// DeleteSchedule mocks base method.
+func (m *MockAdminServiceClient) DeleteSchedule(ctx context.Context, in *adminservice.DeleteScheduleRequest, opts ...grpc.CallOption) (*adminservice.DeleteScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteSchedule", varargs...)
+	ret0, _ := ret[0].(*adminservice.DeleteScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSchedule indicates an expected call of DeleteSchedule.
+func (mr *MockAdminServiceClientMockRecorder) DeleteSchedule(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSchedule", reflect.TypeOf((*MockAdminServiceClient)(nil).DeleteSchedule), varargs...)
+}
+
 // DescribeCluster mocks base method.
 func (m *MockAdminServiceClient) DescribeCluster(ctx context.Context, in *adminservice.DescribeClusterRequest, opts ...grpc.CallOption) (*adminservice.DescribeClusterResponse, error) {
 	m.ctrl.T.Helper()
@@ -120,6 +221,26 @@ func (mr *MockAdminServiceClientMockRecorder) DescribeCluster(ctx, in interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeCluster", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeCluster), varargs...)
 }
 
+// DescribeClusterMetadata mocks base method.
+func (m *MockAdminServiceClient) DescribeClusterMetadata(ctx context.Context, in *adminservice.DescribeClusterMetadataRequest, opts ...grpc.CallOption) (*adminservice.DescribeClusterMetadataResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeClusterMetadata", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeClusterMetadataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeClusterMetadata indicates an expected call of DescribeClusterMetadata.
+func (mr *MockAdminServiceClientMockRecorder) DescribeClusterMetadata(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeClusterMetadata", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeClusterMetadata), varargs...)
+}
+
 // DescribeHistoryHost mocks base method.
 func (m *MockAdminServiceClient) DescribeHistoryHost(ctx context.Context, in *adminservice.DescribeHistoryHostRequest, opts ...grpc.CallOption) (*adminservice.DescribeHistoryHostResponse, error) {
 	m.ctrl.T.Helper()
@@ -160,6 +281,106 @@ func (mr *MockAdminServiceClientMockRecorder) DescribeMutableState(ctx, in inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeMutableState), varargs...)
 }
 
+// DescribeNamespaceQuotas mocks base method.
+func (m *MockAdminServiceClient) DescribeNamespaceQuotas(ctx context.Context, in *adminservice.DescribeNamespaceQuotasRequest, opts ...grpc.CallOption) (*adminservice.DescribeNamespaceQuotasResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeNamespaceQuotas", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeNamespaceQuotasResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeNamespaceQuotas indicates an expected call of DescribeNamespaceQuotas.
+func (mr *MockAdminServiceClientMockRecorder) DescribeNamespaceQuotas(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeNamespaceQuotas", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeNamespaceQuotas), varargs...)
+}
+
+// DescribeSchedule mocks base method.
+func (m *MockAdminServiceClient) DescribeSchedule(ctx context.Context, in *adminservice.DescribeScheduleRequest, opts ...grpc.CallOption) (*adminservice.DescribeScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeSchedule", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeSchedule indicates an expected call of DescribeSchedule.
+func (mr *MockAdminServiceClientMockRecorder) DescribeSchedule(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSchedule", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeSchedule), varargs...)
+}
+
+// DescribeTaskQueuePartitions mocks base method.
+func (m *MockAdminServiceClient) DescribeTaskQueuePartitions(ctx context.Context, in *adminservice.DescribeTaskQueuePartitionsRequest, opts ...grpc.CallOption) (*adminservice.DescribeTaskQueuePartitionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeTaskQueuePartitions", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeTaskQueuePartitionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTaskQueuePartitions indicates an expected call of DescribeTaskQueuePartitions.
+func (mr *MockAdminServiceClientMockRecorder) DescribeTaskQueuePartitions(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueuePartitions", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeTaskQueuePartitions), varargs...)
+}
+
+// DescribeVisibilityProcessor mocks base method.
+func (m *MockAdminServiceClient) DescribeVisibilityProcessor(ctx context.Context, in *adminservice.DescribeVisibilityProcessorRequest, opts ...grpc.CallOption) (*adminservice.DescribeVisibilityProcessorResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeVisibilityProcessor", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeVisibilityProcessorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeVisibilityProcessor indicates an expected call of DescribeVisibilityProcessor.
+func (mr *MockAdminServiceClientMockRecorder) DescribeVisibilityProcessor(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVisibilityProcessor", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeVisibilityProcessor), varargs...)
+}
+
+// ExecuteMultiOperation mocks base method.
+func (m *MockAdminServiceClient) ExecuteMultiOperation(ctx context.Context, in *adminservice.ExecuteMultiOperationRequest, opts ...grpc.CallOption) (*adminservice.ExecuteMultiOperationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExecuteMultiOperation", varargs...)
+	ret0, _ := ret[0].(*adminservice.ExecuteMultiOperationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteMultiOperation indicates an expected call of ExecuteMultiOperation.
+func (mr *MockAdminServiceClientMockRecorder) ExecuteMultiOperation(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteMultiOperation", reflect.TypeOf((*MockAdminServiceClient)(nil).ExecuteMultiOperation), varargs...)
+}
+
 // GetDLQMessages mocks base method.
 func (m *MockAdminServiceClient) GetDLQMessages(ctx context.Context, in *adminservice.GetDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.GetDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -200,6 +421,46 @@ func (mr *MockAdminServiceClientMockRecorder) GetDLQReplicationMessages(ctx, in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQReplicationMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).GetDLQReplicationMessages), varargs...)
 }
 
+// GetDynamicConfig mocks base method.
+func (m *MockAdminServiceClient) GetDynamicConfig(ctx context.Context, in *adminservice.GetDynamicConfigRequest, opts ...grpc.CallOption) (*adminservice.GetDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDynamicConfig", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDynamicConfig indicates an expected call of GetDynamicConfig.
+func (mr *MockAdminServiceClientMockRecorder) GetDynamicConfig(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicConfig", reflect.TypeOf((*MockAdminServiceClient)(nil).GetDynamicConfig), varargs...)
+}
+
+// GetHostProfile mocks base method.
+func (m *MockAdminServiceClient) GetHostProfile(ctx context.Context, in *adminservice.GetHostProfileRequest, opts ...grpc.CallOption) (*adminservice.GetHostProfileResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetHostProfile", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetHostProfileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHostProfile indicates an expected call of GetHostProfile.
+func (mr *MockAdminServiceClientMockRecorder) GetHostProfile(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostProfile", reflect.TypeOf((*MockAdminServiceClient)(nil).GetHostProfile), varargs...)
+}
+
 // GetNamespaceReplicationMessages mocks base method.
 func (m *MockAdminServiceClient) GetNamespaceReplicationMessages(ctx context.Context, in *adminservice.GetNamespaceReplicationMessagesRequest, opts ...grpc.CallOption) (*adminservice.GetNamespaceReplicationMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -220,6 +481,26 @@ func (mr *MockAdminServiceClientMockRecorder) GetNamespaceReplicationMessages(ct
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceReplicationMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).GetNamespaceReplicationMessages), varargs...)
 }
 
+// GetReplicationLag mocks base method.
+func (m *MockAdminServiceClient) GetReplicationLag(ctx context.Context, in *adminservice.GetReplicationLagRequest, opts ...grpc.CallOption) (*adminservice.GetReplicationLagResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetReplicationLag", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetReplicationLagResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationLag indicates an expected call of GetReplicationLag.
+func (mr *MockAdminServiceClientMockRecorder) GetReplicationLag(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationLag", reflect.TypeOf((*MockAdminServiceClient)(nil).GetReplicationLag), varargs...)
+}
+
 // GetReplicationMessages mocks base method.
 func (m *MockAdminServiceClient) GetReplicationMessages(ctx context.Context, in *adminservice.GetReplicationMessagesRequest, opts ...grpc.CallOption) (*adminservice.GetReplicationMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -240,6 +521,26 @@ func (mr *MockAdminServiceClientMockRecorder) GetReplicationMessages(ctx, in int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).GetReplicationMessages), varargs...)
 }
 
+// GetResetPoints mocks base method.
+func (m *MockAdminServiceClient) GetResetPoints(ctx context.Context, in *adminservice.GetResetPointsRequest, opts ...grpc.CallOption) (*adminservice.GetResetPointsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetResetPoints", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetResetPointsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResetPoints indicates an expected call of GetResetPoints.
+func (mr *MockAdminServiceClientMockRecorder) GetResetPoints(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResetPoints", reflect.TypeOf((*MockAdminServiceClient)(nil).GetResetPoints), varargs...)
+}
+
 // GetSearchAttributes mocks base method.
 func (m *MockAdminServiceClient) GetSearchAttributes(ctx context.Context, in *adminservice.GetSearchAttributesRequest, opts ...grpc.CallOption) (*adminservice.GetSearchAttributesResponse, error) {
 	m.ctrl.T.Helper()
@@ -260,164 +561,884 @@ func (mr *MockAdminServiceClientMockRecorder) GetSearchAttributes(ctx, in interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchAttributes", reflect.TypeOf((*MockAdminServiceClient)(nil).GetSearchAttributes), varargs...)
 }
 
-// GetWorkflowExecutionRawHistoryV2 mocks base method.
-func (m *MockAdminServiceClient) GetWorkflowExecutionRawHistoryV2(ctx context.Context, in *adminservice.GetWorkflowExecutionRawHistoryV2Request, opts ...grpc.CallOption) (*adminservice.GetWorkflowExecutionRawHistoryV2Response, error) {
+// GetShardStats mocks base method.
+func (m *MockAdminServiceClient) GetShardStats(ctx context.Context, in *adminservice.GetShardStatsRequest, opts ...grpc.CallOption) (*adminservice.GetShardStatsResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetWorkflowExecutionRawHistoryV2", varargs...)
-	ret0, _ := ret[0].(*adminservice.GetWorkflowExecutionRawHistoryV2Response)
+	ret := m.ctrl.Call(m, "GetShardStats", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetShardStatsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetWorkflowExecutionRawHistoryV2 indicates an expected call of GetWorkflowExecutionRawHistoryV2.
-func (mr *MockAdminServiceClientMockRecorder) GetWorkflowExecutionRawHistoryV2(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// GetShardStats indicates an expected call of GetShardStats.
+func (mr *MockAdminServiceClientMockRecorder) GetShardStats(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionRawHistoryV2", reflect.TypeOf((*MockAdminServiceClient)(nil).GetWorkflowExecutionRawHistoryV2), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShardStats", reflect.TypeOf((*MockAdminServiceClient)(nil).GetShardStats), varargs...)
 }
 
-// MergeDLQMessages mocks base method.
-func (m *MockAdminServiceClient) MergeDLQMessages(ctx context.Context, in *adminservice.MergeDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.MergeDLQMessagesResponse, error) {
+// GetWorkerBuildIdCompatibility mocks base method.
+func (m *MockAdminServiceClient) GetWorkerBuildIdCompatibility(ctx context.Context, in *adminservice.GetWorkerBuildIdCompatibilityRequest, opts ...grpc.CallOption) (*adminservice.GetWorkerBuildIdCompatibilityResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "MergeDLQMessages", varargs...)
-	ret0, _ := ret[0].(*adminservice.MergeDLQMessagesResponse)
+	ret := m.ctrl.Call(m, "GetWorkerBuildIdCompatibility", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetWorkerBuildIdCompatibilityResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// MergeDLQMessages indicates an expected call of MergeDLQMessages.
-func (mr *MockAdminServiceClientMockRecorder) MergeDLQMessages(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// GetWorkerBuildIdCompatibility indicates an expected call of GetWorkerBuildIdCompatibility.
+func (mr *MockAdminServiceClientMockRecorder) GetWorkerBuildIdCompatibility(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).MergeDLQMessages), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkerBuildIdCompatibility", reflect.TypeOf((*MockAdminServiceClient)(nil).GetWorkerBuildIdCompatibility), varargs...)
 }
 
-// PurgeDLQMessages mocks base method.
-func (m *MockAdminServiceClient) PurgeDLQMessages(ctx context.Context, in *adminservice.PurgeDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.PurgeDLQMessagesResponse, error) {
+// GetWorkflowExecutionHistoryReverse mocks base method.
+func (m *MockAdminServiceClient) GetWorkflowExecutionHistoryReverse(ctx context.Context, in *adminservice.GetWorkflowExecutionHistoryReverseRequest, opts ...grpc.CallOption) (*adminservice.GetWorkflowExecutionHistoryReverseResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "PurgeDLQMessages", varargs...)
-	ret0, _ := ret[0].(*adminservice.PurgeDLQMessagesResponse)
+	ret := m.ctrl.Call(m, "GetWorkflowExecutionHistoryReverse", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetWorkflowExecutionHistoryReverseResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// PurgeDLQMessages indicates an expected call of PurgeDLQMessages.
-func (mr *MockAdminServiceClientMockRecorder) PurgeDLQMessages(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// GetWorkflowExecutionHistoryReverse indicates an expected call of GetWorkflowExecutionHistoryReverse.
+func (mr *MockAdminServiceClientMockRecorder) GetWorkflowExecutionHistoryReverse(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDLQMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).PurgeDLQMessages), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionHistoryReverse", reflect.TypeOf((*MockAdminServiceClient)(nil).GetWorkflowExecutionHistoryReverse), varargs...)
 }
 
-// ReapplyEvents mocks base method.
-func (m *MockAdminServiceClient) ReapplyEvents(ctx context.Context, in *adminservice.ReapplyEventsRequest, opts ...grpc.CallOption) (*adminservice.ReapplyEventsResponse, error) {
+// GetWorkflowExecutionRawHistoryV2 mocks base method.
+func (m *MockAdminServiceClient) GetWorkflowExecutionRawHistoryV2(ctx context.Context, in *adminservice.GetWorkflowExecutionRawHistoryV2Request, opts ...grpc.CallOption) (*adminservice.GetWorkflowExecutionRawHistoryV2Response, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ReapplyEvents", varargs...)
-	ret0, _ := ret[0].(*adminservice.ReapplyEventsResponse)
+	ret := m.ctrl.Call(m, "GetWorkflowExecutionRawHistoryV2", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetWorkflowExecutionRawHistoryV2Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ReapplyEvents indicates an expected call of ReapplyEvents.
-func (mr *MockAdminServiceClientMockRecorder) ReapplyEvents(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// GetWorkflowExecutionRawHistoryV2 indicates an expected call of GetWorkflowExecutionRawHistoryV2.
+func (mr *MockAdminServiceClientMockRecorder) GetWorkflowExecutionRawHistoryV2(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReapplyEvents", reflect.TypeOf((*MockAdminServiceClient)(nil).ReapplyEvents), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionRawHistoryV2", reflect.TypeOf((*MockAdminServiceClient)(nil).GetWorkflowExecutionRawHistoryV2), varargs...)
 }
 
-// RefreshWorkflowTasks mocks base method.
-func (m *MockAdminServiceClient) RefreshWorkflowTasks(ctx context.Context, in *adminservice.RefreshWorkflowTasksRequest, opts ...grpc.CallOption) (*adminservice.RefreshWorkflowTasksResponse, error) {
+// HandoverNamespace mocks base method.
+func (m *MockAdminServiceClient) HandoverNamespace(ctx context.Context, in *adminservice.HandoverNamespaceRequest, opts ...grpc.CallOption) (*adminservice.HandoverNamespaceResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "RefreshWorkflowTasks", varargs...)
-	ret0, _ := ret[0].(*adminservice.RefreshWorkflowTasksResponse)
+	ret := m.ctrl.Call(m, "HandoverNamespace", varargs...)
+	ret0, _ := ret[0].(*adminservice.HandoverNamespaceResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RefreshWorkflowTasks indicates an expected call of RefreshWorkflowTasks.
-func (mr *MockAdminServiceClientMockRecorder) RefreshWorkflowTasks(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// HandoverNamespace indicates an expected call of HandoverNamespace.
+func (mr *MockAdminServiceClientMockRecorder) HandoverNamespace(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshWorkflowTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).RefreshWorkflowTasks), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandoverNamespace", reflect.TypeOf((*MockAdminServiceClient)(nil).HandoverNamespace), varargs...)
 }
 
-// RemoveSearchAttributes mocks base method.
-func (m *MockAdminServiceClient) RemoveSearchAttributes(ctx context.Context, in *adminservice.RemoveSearchAttributesRequest, opts ...grpc.CallOption) (*adminservice.RemoveSearchAttributesResponse, error) {
+// ImportWorkflowExecution mocks base method.
+func (m *MockAdminServiceClient) ImportWorkflowExecution(ctx context.Context, in *adminservice.ImportWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.ImportWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "RemoveSearchAttributes", varargs...)
-	ret0, _ := ret[0].(*adminservice.RemoveSearchAttributesResponse)
+	ret := m.ctrl.Call(m, "ImportWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.ImportWorkflowExecutionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RemoveSearchAttributes indicates an expected call of RemoveSearchAttributes.
-func (mr *MockAdminServiceClientMockRecorder) RemoveSearchAttributes(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// ImportWorkflowExecution indicates an expected call of ImportWorkflowExecution.
+func (mr *MockAdminServiceClientMockRecorder) ImportWorkflowExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSearchAttributes", reflect.TypeOf((*MockAdminServiceClient)(nil).RemoveSearchAttributes), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).ImportWorkflowExecution), varargs...)
 }
 
-// RemoveTask mocks base method.
-func (m *MockAdminServiceClient) RemoveTask(ctx context.Context, in *adminservice.RemoveTaskRequest, opts ...grpc.CallOption) (*adminservice.RemoveTaskResponse, error) {
+// ListDynamicConfig mocks base method.
+func (m *MockAdminServiceClient) ListDynamicConfig(ctx context.Context, in *adminservice.ListDynamicConfigRequest, opts ...grpc.CallOption) (*adminservice.ListDynamicConfigResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "RemoveTask", varargs...)
-	ret0, _ := ret[0].(*adminservice.RemoveTaskResponse)
+	ret := m.ctrl.Call(m, "ListDynamicConfig", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListDynamicConfigResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RemoveTask indicates an expected call of RemoveTask.
-func (mr *MockAdminServiceClientMockRecorder) RemoveTask(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// ListDynamicConfig indicates an expected call of ListDynamicConfig.
+func (mr *MockAdminServiceClientMockRecorder) ListDynamicConfig(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTask", reflect.TypeOf((*MockAdminServiceClient)(nil).RemoveTask), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfig", reflect.TypeOf((*MockAdminServiceClient)(nil).ListDynamicConfig), varargs...)
 }
 
-// ResendReplicationTasks mocks base method.
-func (m *MockAdminServiceClient) ResendReplicationTasks(ctx context.Context, in *adminservice.ResendReplicationTasksRequest, opts ...grpc.CallOption) (*adminservice.ResendReplicationTasksResponse, error) {
+// ListNamespaceFailoverHistory mocks base method.
+func (m *MockAdminServiceClient) ListNamespaceFailoverHistory(ctx context.Context, in *adminservice.ListNamespaceFailoverHistoryRequest, opts ...grpc.CallOption) (*adminservice.ListNamespaceFailoverHistoryResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ResendReplicationTasks", varargs...)
-	ret0, _ := ret[0].(*adminservice.ResendReplicationTasksResponse)
+	ret := m.ctrl.Call(m, "ListNamespaceFailoverHistory", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListNamespaceFailoverHistoryResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ResendReplicationTasks indicates an expected call of ResendReplicationTasks.
+// ListNamespaceFailoverHistory indicates an expected call of ListNamespaceFailoverHistory.
+func (mr *MockAdminServiceClientMockRecorder) ListNamespaceFailoverHistory(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNamespaceFailoverHistory", reflect.TypeOf((*MockAdminServiceClient)(nil).ListNamespaceFailoverHistory), varargs...)
+}
+
+// ListSchedules mocks base method.
+func (m *MockAdminServiceClient) ListSchedules(ctx context.Context, in *adminservice.ListSchedulesRequest, opts ...grpc.CallOption) (*adminservice.ListSchedulesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSchedules", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListSchedulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSchedules indicates an expected call of ListSchedules.
+func (mr *MockAdminServiceClientMockRecorder) ListSchedules(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSchedules", reflect.TypeOf((*MockAdminServiceClient)(nil).ListSchedules), varargs...)
+}
+
+// ListStaleWorkflowExecutions mocks base method.
+func (m *MockAdminServiceClient) ListStaleWorkflowExecutions(ctx context.Context, in *adminservice.ListStaleWorkflowExecutionsRequest, opts ...grpc.CallOption) (*adminservice.ListStaleWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStaleWorkflowExecutions", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListStaleWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStaleWorkflowExecutions indicates an expected call of ListStaleWorkflowExecutions.
+func (mr *MockAdminServiceClientMockRecorder) ListStaleWorkflowExecutions(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStaleWorkflowExecutions", reflect.TypeOf((*MockAdminServiceClient)(nil).ListStaleWorkflowExecutions), varargs...)
+}
+
+// ListThrottledCallers mocks base method.
+func (m *MockAdminServiceClient) ListThrottledCallers(ctx context.Context, in *adminservice.ListThrottledCallersRequest, opts ...grpc.CallOption) (*adminservice.ListThrottledCallersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListThrottledCallers", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListThrottledCallersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListThrottledCallers indicates an expected call of ListThrottledCallers.
+func (mr *MockAdminServiceClientMockRecorder) ListThrottledCallers(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListThrottledCallers", reflect.TypeOf((*MockAdminServiceClient)(nil).ListThrottledCallers), varargs...)
+}
+
+// MergeDLQMessages mocks base method.
+func (m *MockAdminServiceClient) MergeDLQMessages(ctx context.Context, in *adminservice.MergeDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.MergeDLQMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MergeDLQMessages", varargs...)
+	ret0, _ := ret[0].(*adminservice.MergeDLQMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeDLQMessages indicates an expected call of MergeDLQMessages.
+func (mr *MockAdminServiceClientMockRecorder) MergeDLQMessages(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).MergeDLQMessages), varargs...)
+}
+
+// PatchSchedule mocks base method.
+func (m *MockAdminServiceClient) PatchSchedule(ctx context.Context, in *adminservice.PatchScheduleRequest, opts ...grpc.CallOption) (*adminservice.PatchScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PatchSchedule", varargs...)
+	ret0, _ := ret[0].(*adminservice.PatchScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PatchSchedule indicates an expected call of PatchSchedule.
+func (mr *MockAdminServiceClientMockRecorder) PatchSchedule(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchSchedule", reflect.TypeOf((*MockAdminServiceClient)(nil).PatchSchedule), varargs...)
+}
+
+// PurgeDLQMessages mocks base method.
+func (m *MockAdminServiceClient) PurgeDLQMessages(ctx context.Context, in *adminservice.PurgeDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.PurgeDLQMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PurgeDLQMessages", varargs...)
+	ret0, _ := ret[0].(*adminservice.PurgeDLQMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDLQMessages indicates an expected call of PurgeDLQMessages.
+func (mr *MockAdminServiceClientMockRecorder) PurgeDLQMessages(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDLQMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).PurgeDLQMessages), varargs...)
+}
+
+// ReapplyEvents mocks base method.
+func (m *MockAdminServiceClient) ReapplyEvents(ctx context.Context, in *adminservice.ReapplyEventsRequest, opts ...grpc.CallOption) (*adminservice.ReapplyEventsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReapplyEvents", varargs...)
+	ret0, _ := ret[0].(*adminservice.ReapplyEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReapplyEvents indicates an expected call of ReapplyEvents.
+func (mr *MockAdminServiceClientMockRecorder) ReapplyEvents(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReapplyEvents", reflect.TypeOf((*MockAdminServiceClient)(nil).ReapplyEvents), varargs...)
+}
+
+// RebalanceShards mocks base method.
+func (m *MockAdminServiceClient) RebalanceShards(ctx context.Context, in *adminservice.RebalanceShardsRequest, opts ...grpc.CallOption) (*adminservice.RebalanceShardsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RebalanceShards", varargs...)
+	ret0, _ := ret[0].(*adminservice.RebalanceShardsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RebalanceShards indicates an expected call of RebalanceShards.
+func (mr *MockAdminServiceClientMockRecorder) RebalanceShards(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebalanceShards", reflect.TypeOf((*MockAdminServiceClient)(nil).RebalanceShards), varargs...)
+}
+
+// RecordWorkflowTaskHeartbeat mocks base method.
+func (m *MockAdminServiceClient) RecordWorkflowTaskHeartbeat(ctx context.Context, in *adminservice.RecordWorkflowTaskHeartbeatRequest, opts ...grpc.CallOption) (*adminservice.RecordWorkflowTaskHeartbeatResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RecordWorkflowTaskHeartbeat", varargs...)
+	ret0, _ := ret[0].(*adminservice.RecordWorkflowTaskHeartbeatResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordWorkflowTaskHeartbeat indicates an expected call of RecordWorkflowTaskHeartbeat.
+func (mr *MockAdminServiceClientMockRecorder) RecordWorkflowTaskHeartbeat(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordWorkflowTaskHeartbeat", reflect.TypeOf((*MockAdminServiceClient)(nil).RecordWorkflowTaskHeartbeat), varargs...)
+}
+
+// RefreshWorkflowTasks mocks base method.
+func (m *MockAdminServiceClient) RefreshWorkflowTasks(ctx context.Context, in *adminservice.RefreshWorkflowTasksRequest, opts ...grpc.CallOption) (*adminservice.RefreshWorkflowTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RefreshWorkflowTasks", varargs...)
+	ret0, _ := ret[0].(*adminservice.RefreshWorkflowTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshWorkflowTasks indicates an expected call of RefreshWorkflowTasks.
+func (mr *MockAdminServiceClientMockRecorder) RefreshWorkflowTasks(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshWorkflowTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).RefreshWorkflowTasks), varargs...)
+}
+
+// RemoveSearchAttributes mocks base method.
+func (m *MockAdminServiceClient) RemoveSearchAttributes(ctx context.Context, in *adminservice.RemoveSearchAttributesRequest, opts ...grpc.CallOption) (*adminservice.RemoveSearchAttributesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveSearchAttributes", varargs...)
+	ret0, _ := ret[0].(*adminservice.RemoveSearchAttributesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveSearchAttributes indicates an expected call of RemoveSearchAttributes.
+func (mr *MockAdminServiceClientMockRecorder) RemoveSearchAttributes(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSearchAttributes", reflect.TypeOf((*MockAdminServiceClient)(nil).RemoveSearchAttributes), varargs...)
+}
+
+// RemoveTask mocks base method.
+func (m *MockAdminServiceClient) RemoveTask(ctx context.Context, in *adminservice.RemoveTaskRequest, opts ...grpc.CallOption) (*adminservice.RemoveTaskResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveTask", varargs...)
+	ret0, _ := ret[0].(*adminservice.RemoveTaskResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveTask indicates an expected call of RemoveTask.
+func (mr *MockAdminServiceClientMockRecorder) RemoveTask(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTask", reflect.TypeOf((*MockAdminServiceClient)(nil).RemoveTask), varargs...)
+}
+
+// ResendReplicationTasks mocks base method.
+func (m *MockAdminServiceClient) ResendReplicationTasks(ctx context.Context, in *adminservice.ResendReplicationTasksRequest, opts ...grpc.CallOption) (*adminservice.ResendReplicationTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResendReplicationTasks", varargs...)
+	ret0, _ := ret[0].(*adminservice.ResendReplicationTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResendReplicationTasks indicates an expected call of ResendReplicationTasks.
 func (mr *MockAdminServiceClientMockRecorder) ResendReplicationTasks(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ResendReplicationTasks), varargs...)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ResendReplicationTasks), varargs...)
+}
+
+// SetDynamicConfig mocks base method.
+func (m *MockAdminServiceClient) SetDynamicConfig(ctx context.Context, in *adminservice.SetDynamicConfigRequest, opts ...grpc.CallOption) (*adminservice.SetDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetDynamicConfig", varargs...)
+	ret0, _ := ret[0].(*adminservice.SetDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDynamicConfig indicates an expected call of SetDynamicConfig.
+func (mr *MockAdminServiceClientMockRecorder) SetDynamicConfig(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDynamicConfig", reflect.TypeOf((*MockAdminServiceClient)(nil).SetDynamicConfig), varargs...)
+}
+
+// SetMaintenanceInfo mocks base method.
+func (m *MockAdminServiceClient) SetMaintenanceInfo(ctx context.Context, in *adminservice.SetMaintenanceInfoRequest, opts ...grpc.CallOption) (*adminservice.SetMaintenanceInfoResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetMaintenanceInfo", varargs...)
+	ret0, _ := ret[0].(*adminservice.SetMaintenanceInfoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetMaintenanceInfo indicates an expected call of SetMaintenanceInfo.
+func (mr *MockAdminServiceClientMockRecorder) SetMaintenanceInfo(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMaintenanceInfo", reflect.TypeOf((*MockAdminServiceClient)(nil).SetMaintenanceInfo), varargs...)
+}
+
+// ShutdownWorker mocks base method.
+func (m *MockAdminServiceClient) ShutdownWorker(ctx context.Context, in *adminservice.ShutdownWorkerRequest, opts ...grpc.CallOption) (*adminservice.ShutdownWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ShutdownWorker", varargs...)
+	ret0, _ := ret[0].(*adminservice.ShutdownWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShutdownWorker indicates an expected call of ShutdownWorker.
+func (mr *MockAdminServiceClientMockRecorder) ShutdownWorker(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShutdownWorker", reflect.TypeOf((*MockAdminServiceClient)(nil).ShutdownWorker), varargs...)
+}
+
+// StartResetBadBinaryJob mocks base method.
+func (m *MockAdminServiceClient) StartResetBadBinaryJob(ctx context.Context, in *adminservice.StartResetBadBinaryJobRequest, opts ...grpc.CallOption) (*adminservice.StartResetBadBinaryJobResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartResetBadBinaryJob", varargs...)
+	ret0, _ := ret[0].(*adminservice.StartResetBadBinaryJobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartResetBadBinaryJob indicates an expected call of StartResetBadBinaryJob.
+func (mr *MockAdminServiceClientMockRecorder) StartResetBadBinaryJob(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartResetBadBinaryJob", reflect.TypeOf((*MockAdminServiceClient)(nil).StartResetBadBinaryJob), varargs...)
+}
+
+// StreamReplicationMessages mocks base method.
+func (m *MockAdminServiceClient) StreamReplicationMessages(ctx context.Context, opts ...grpc.CallOption) (adminservice.AdminService_StreamReplicationMessagesClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamReplicationMessages", varargs...)
+	ret0, _ := ret[0].(adminservice.AdminService_StreamReplicationMessagesClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamReplicationMessages indicates an expected call of StreamReplicationMessages.
+func (mr *MockAdminServiceClientMockRecorder) StreamReplicationMessages(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamReplicationMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).StreamReplicationMessages), varargs...)
+}
+
+// StreamWorkflowExecutionHistory mocks base method.
+func (m *MockAdminServiceClient) StreamWorkflowExecutionHistory(ctx context.Context, in *adminservice.StreamWorkflowExecutionHistoryRequest, opts ...grpc.CallOption) (adminservice.AdminService_StreamWorkflowExecutionHistoryClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamWorkflowExecutionHistory", varargs...)
+	ret0, _ := ret[0].(adminservice.AdminService_StreamWorkflowExecutionHistoryClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamWorkflowExecutionHistory indicates an expected call of StreamWorkflowExecutionHistory.
+func (mr *MockAdminServiceClientMockRecorder) StreamWorkflowExecutionHistory(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamWorkflowExecutionHistory", reflect.TypeOf((*MockAdminServiceClient)(nil).StreamWorkflowExecutionHistory), varargs...)
+}
+
+// UpdateClusterMetadata mocks base method.
+func (m *MockAdminServiceClient) UpdateClusterMetadata(ctx context.Context, in *adminservice.UpdateClusterMetadataRequest, opts ...grpc.CallOption) (*adminservice.UpdateClusterMetadataResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateClusterMetadata", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateClusterMetadataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateClusterMetadata indicates an expected call of UpdateClusterMetadata.
+func (mr *MockAdminServiceClientMockRecorder) UpdateClusterMetadata(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClusterMetadata", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateClusterMetadata), varargs...)
+}
+
+// UpdateNamespaceQuotas mocks base method.
+func (m *MockAdminServiceClient) UpdateNamespaceQuotas(ctx context.Context, in *adminservice.UpdateNamespaceQuotasRequest, opts ...grpc.CallOption) (*adminservice.UpdateNamespaceQuotasResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateNamespaceQuotas", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateNamespaceQuotasResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNamespaceQuotas indicates an expected call of UpdateNamespaceQuotas.
+func (mr *MockAdminServiceClientMockRecorder) UpdateNamespaceQuotas(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNamespaceQuotas", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateNamespaceQuotas), varargs...)
+}
+
+// UpdateSchedule mocks base method.
+func (m *MockAdminServiceClient) UpdateSchedule(ctx context.Context, in *adminservice.UpdateScheduleRequest, opts ...grpc.CallOption) (*adminservice.UpdateScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateSchedule", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSchedule indicates an expected call of UpdateSchedule.
+func (mr *MockAdminServiceClientMockRecorder) UpdateSchedule(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSchedule", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateSchedule), varargs...)
+}
+
+// UpdateWorkerBuildIdCompatibility mocks base method.
+func (m *MockAdminServiceClient) UpdateWorkerBuildIdCompatibility(ctx context.Context, in *adminservice.UpdateWorkerBuildIdCompatibilityRequest, opts ...grpc.CallOption) (*adminservice.UpdateWorkerBuildIdCompatibilityResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateWorkerBuildIdCompatibility", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateWorkerBuildIdCompatibilityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkerBuildIdCompatibility indicates an expected call of UpdateWorkerBuildIdCompatibility.
+func (mr *MockAdminServiceClientMockRecorder) UpdateWorkerBuildIdCompatibility(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkerBuildIdCompatibility", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateWorkerBuildIdCompatibility), varargs...)
+}
+
+// MockAdminService_StreamReplicationMessagesClient is a mock of AdminService_StreamReplicationMessagesClient interface.
+type MockAdminService_StreamReplicationMessagesClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminService_StreamReplicationMessagesClientMockRecorder
+}
+
+// MockAdminService_StreamReplicationMessagesClientMockRecorder is the mock recorder for MockAdminService_StreamReplicationMessagesClient.
+type MockAdminService_StreamReplicationMessagesClientMockRecorder struct {
+	mock *MockAdminService_StreamReplicationMessagesClient
+}
+
+// NewMockAdminService_StreamReplicationMessagesClient creates a new mock instance.
+func NewMockAdminService_StreamReplicationMessagesClient(ctrl *gomock.Controller) *MockAdminService_StreamReplicationMessagesClient {
+	mock := &MockAdminService_StreamReplicationMessagesClient{ctrl: ctrl}
+	mock.recorder = &MockAdminService_StreamReplicationMessagesClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminService_StreamReplicationMessagesClient) EXPECT() *MockAdminService_StreamReplicationMessagesClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockAdminService_StreamReplicationMessagesClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockAdminService_StreamReplicationMessagesClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockAdminService_StreamReplicationMessagesClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockAdminService_StreamReplicationMessagesClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockAdminService_StreamReplicationMessagesClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockAdminService_StreamReplicationMessagesClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockAdminService_StreamReplicationMessagesClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockAdminService_StreamReplicationMessagesClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockAdminService_StreamReplicationMessagesClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockAdminService_StreamReplicationMessagesClient) Recv() (*adminservice.StreamReplicationMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*adminservice.StreamReplicationMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockAdminService_StreamReplicationMessagesClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockAdminService_StreamReplicationMessagesClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockAdminService_StreamReplicationMessagesClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockAdminService_StreamReplicationMessagesClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockAdminService_StreamReplicationMessagesClient)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockAdminService_StreamReplicationMessagesClient) Send(arg0 *adminservice.StreamReplicationMessagesRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockAdminService_StreamReplicationMessagesClientMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockAdminService_StreamReplicationMessagesClient)(nil).Send), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockAdminService_StreamReplicationMessagesClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockAdminService_StreamReplicationMessagesClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockAdminService_StreamReplicationMessagesClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockAdminService_StreamReplicationMessagesClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockAdminService_StreamReplicationMessagesClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockAdminService_StreamReplicationMessagesClient)(nil).Trailer))
+}
+
+// MockAdminService_StreamWorkflowExecutionHistoryClient is a mock of AdminService_StreamWorkflowExecutionHistoryClient interface.
+type MockAdminService_StreamWorkflowExecutionHistoryClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminService_StreamWorkflowExecutionHistoryClientMockRecorder
+}
+
+// MockAdminService_StreamWorkflowExecutionHistoryClientMockRecorder is the mock recorder for MockAdminService_StreamWorkflowExecutionHistoryClient.
+type MockAdminService_StreamWorkflowExecutionHistoryClientMockRecorder struct {
+	mock *MockAdminService_StreamWorkflowExecutionHistoryClient
+}
+
+// NewMockAdminService_StreamWorkflowExecutionHistoryClient creates a new mock instance.
+func NewMockAdminService_StreamWorkflowExecutionHistoryClient(ctrl *gomock.Controller) *MockAdminService_StreamWorkflowExecutionHistoryClient {
+	mock := &MockAdminService_StreamWorkflowExecutionHistoryClient{ctrl: ctrl}
+	mock.recorder = &MockAdminService_StreamWorkflowExecutionHistoryClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminService_StreamWorkflowExecutionHistoryClient) EXPECT() *MockAdminService_StreamWorkflowExecutionHistoryClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockAdminService_StreamWorkflowExecutionHistoryClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockAdminService_StreamWorkflowExecutionHistoryClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockAdminService_StreamWorkflowExecutionHistoryClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockAdminService_StreamWorkflowExecutionHistoryClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockAdminService_StreamWorkflowExecutionHistoryClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockAdminService_StreamWorkflowExecutionHistoryClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockAdminService_StreamWorkflowExecutionHistoryClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockAdminService_StreamWorkflowExecutionHistoryClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockAdminService_StreamWorkflowExecutionHistoryClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockAdminService_StreamWorkflowExecutionHistoryClient) Recv() (*adminservice.StreamWorkflowExecutionHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*adminservice.StreamWorkflowExecutionHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockAdminService_StreamWorkflowExecutionHistoryClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockAdminService_StreamWorkflowExecutionHistoryClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockAdminService_StreamWorkflowExecutionHistoryClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockAdminService_StreamWorkflowExecutionHistoryClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockAdminService_StreamWorkflowExecutionHistoryClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockAdminService_StreamWorkflowExecutionHistoryClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockAdminService_StreamWorkflowExecutionHistoryClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockAdminService_StreamWorkflowExecutionHistoryClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockAdminService_StreamWorkflowExecutionHistoryClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockAdminService_StreamWorkflowExecutionHistoryClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockAdminService_StreamWorkflowExecutionHistoryClient)(nil).Trailer))
 }
 
 // MockAdminServiceServer is a mock of AdminServiceServer interface.
@@ -473,6 +1494,81 @@ func (mr *MockAdminServiceServerMockRecorder) CloseShard(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseShard", reflect.TypeOf((*MockAdminServiceServer)(nil).CloseShard), arg0, arg1)
 }
 
+// CompactWorkflowHistory mocks base method.
+func (m *MockAdminServiceServer) CompactWorkflowHistory(arg0 context.Context, arg1 *adminservice.CompactWorkflowHistoryRequest) (*adminservice.CompactWorkflowHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompactWorkflowHistory", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.CompactWorkflowHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompactWorkflowHistory indicates an expected call of CompactWorkflowHistory.
+func (mr *MockAdminServiceServerMockRecorder) CompactWorkflowHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompactWorkflowHistory", reflect.TypeOf((*MockAdminServiceServer)(nil).CompactWorkflowHistory), arg0, arg1)
+}
+
+// CreateSchedule mocks base method.
+func (m *MockAdminServiceServer) CreateSchedule(arg0 context.Context, arg1 *adminservice.CreateScheduleRequest) (*adminservice.CreateScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.CreateScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSchedule indicates an expected call of CreateSchedule.
+func (mr *MockAdminServiceServerMockRecorder) CreateSchedule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSchedule", reflect.TypeOf((*MockAdminServiceServer)(nil).CreateSchedule), arg0, arg1)
+}
+
+// DeepHealthCheck mocks base method.
+func (m *MockAdminServiceServer) DeepHealthCheck(arg0 context.Context, arg1 *adminservice.DeepHealthCheckRequest) (*adminservice.DeepHealthCheckResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeepHealthCheck", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DeepHealthCheckResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeepHealthCheck indicates an expected call of DeepHealthCheck.
+func (mr *MockAdminServiceServerMockRecorder) DeepHealthCheck(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeepHealthCheck", reflect.TypeOf((*MockAdminServiceServer)(nil).DeepHealthCheck), arg0, arg1)
+}
+
+// DeleteNamespace mocks base method.
+func (m *MockAdminServiceServer) DeleteNamespace(arg0 context.Context, arg1 *adminservice.DeleteNamespaceRequest) (*adminservice.DeleteNamespaceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNamespace", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DeleteNamespaceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNamespace indicates an expected call of DeleteNamespace.
+func (mr *MockAdminServiceServerMockRecorder) DeleteNamespace(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNamespace", reflect.TypeOf((*MockAdminServiceServer)(nil).DeleteNamespace), arg0, arg1)
+}
+
+// DeleteSchedule mocks base method.
+func (m *MockAdminServiceServer) DeleteSchedule(arg0 context.Context, arg1 *adminservice.DeleteScheduleRequest) (*adminservice.DeleteScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DeleteScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSchedule indicates an expected call of DeleteSchedule.
+func (mr *MockAdminServiceServerMockRecorder) DeleteSchedule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSchedule", reflect.TypeOf((*MockAdminServiceServer)(nil).DeleteSchedule), arg0, arg1)
+}
+
 // DescribeCluster mocks base method.
 func (m *MockAdminServiceServer) DescribeCluster(arg0 context.Context, arg1 *adminservice.DescribeClusterRequest) (*adminservice.DescribeClusterResponse, error) {
 	m.ctrl.T.Helper()
@@ -488,6 +1584,21 @@ func (mr *MockAdminServiceServerMockRecorder) DescribeCluster(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeCluster", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeCluster), arg0, arg1)
 }
 
+// DescribeClusterMetadata mocks base method.
+func (m *MockAdminServiceServer) DescribeClusterMetadata(arg0 context.Context, arg1 *adminservice.DescribeClusterMetadataRequest) (*adminservice.DescribeClusterMetadataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeClusterMetadata", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeClusterMetadataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeClusterMetadata indicates an expected call of DescribeClusterMetadata.
+func (mr *MockAdminServiceServerMockRecorder) DescribeClusterMetadata(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeClusterMetadata", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeClusterMetadata), arg0, arg1)
+}
+
 // DescribeHistoryHost mocks base method.
 func (m *MockAdminServiceServer) DescribeHistoryHost(arg0 context.Context, arg1 *adminservice.DescribeHistoryHostRequest) (*adminservice.DescribeHistoryHostResponse, error) {
 	m.ctrl.T.Helper()
@@ -515,97 +1626,382 @@ func (m *MockAdminServiceServer) DescribeMutableState(arg0 context.Context, arg1
 // DescribeMutableState indicates an expected call of DescribeMutableState.
 func (mr *MockAdminServiceServerMockRecorder) DescribeMutableState(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeMutableState), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeMutableState), arg0, arg1)
+}
+
+// DescribeNamespaceQuotas mocks base method.
+func (m *MockAdminServiceServer) DescribeNamespaceQuotas(arg0 context.Context, arg1 *adminservice.DescribeNamespaceQuotasRequest) (*adminservice.DescribeNamespaceQuotasResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeNamespaceQuotas", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeNamespaceQuotasResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeNamespaceQuotas indicates an expected call of DescribeNamespaceQuotas.
+func (mr *MockAdminServiceServerMockRecorder) DescribeNamespaceQuotas(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeNamespaceQuotas", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeNamespaceQuotas), arg0, arg1)
+}
+
+// DescribeSchedule mocks base method.
+func (m *MockAdminServiceServer) DescribeSchedule(arg0 context.Context, arg1 *adminservice.DescribeScheduleRequest) (*adminservice.DescribeScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeSchedule indicates an expected call of DescribeSchedule.
+func (mr *MockAdminServiceServerMockRecorder) DescribeSchedule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSchedule", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeSchedule), arg0, arg1)
+}
+
+// DescribeTaskQueuePartitions mocks base method.
+func (m *MockAdminServiceServer) DescribeTaskQueuePartitions(arg0 context.Context, arg1 *adminservice.DescribeTaskQueuePartitionsRequest) (*adminservice.DescribeTaskQueuePartitionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeTaskQueuePartitions", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeTaskQueuePartitionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTaskQueuePartitions indicates an expected call of DescribeTaskQueuePartitions.
+func (mr *MockAdminServiceServerMockRecorder) DescribeTaskQueuePartitions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueuePartitions", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeTaskQueuePartitions), arg0, arg1)
+}
+
+// DescribeVisibilityProcessor mocks base method.
+func (m *MockAdminServiceServer) DescribeVisibilityProcessor(arg0 context.Context, arg1 *adminservice.DescribeVisibilityProcessorRequest) (*adminservice.DescribeVisibilityProcessorResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeVisibilityProcessor", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeVisibilityProcessorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeVisibilityProcessor indicates an expected call of DescribeVisibilityProcessor.
+func (mr *MockAdminServiceServerMockRecorder) DescribeVisibilityProcessor(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVisibilityProcessor", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeVisibilityProcessor), arg0, arg1)
+}
+
+// ExecuteMultiOperation mocks base method.
+func (m *MockAdminServiceServer) ExecuteMultiOperation(arg0 context.Context, arg1 *adminservice.ExecuteMultiOperationRequest) (*adminservice.ExecuteMultiOperationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteMultiOperation", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ExecuteMultiOperationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteMultiOperation indicates an expected call of ExecuteMultiOperation.
+func (mr *MockAdminServiceServerMockRecorder) ExecuteMultiOperation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteMultiOperation", reflect.TypeOf((*MockAdminServiceServer)(nil).ExecuteMultiOperation), arg0, arg1)
+}
+
+// GetDLQMessages mocks base method.
+func (m *MockAdminServiceServer) GetDLQMessages(arg0 context.Context, arg1 *adminservice.GetDLQMessagesRequest) (*adminservice.GetDLQMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDLQMessages", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetDLQMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDLQMessages indicates an expected call of GetDLQMessages.
+func (mr *MockAdminServiceServerMockRecorder) GetDLQMessages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).GetDLQMessages), arg0, arg1)
+}
+
+// GetDLQReplicationMessages mocks base method.
+func (m *MockAdminServiceServer) GetDLQReplicationMessages(arg0 context.Context, arg1 *adminservice.GetDLQReplicationMessagesRequest) (*adminservice.GetDLQReplicationMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDLQReplicationMessages", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetDLQReplicationMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDLQReplicationMessages indicates an expected call of GetDLQReplicationMessages.
+func (mr *MockAdminServiceServerMockRecorder) GetDLQReplicationMessages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQReplicationMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).GetDLQReplicationMessages), arg0, arg1)
+}
+
+// GetDynamicConfig mocks base method.
+func (m *MockAdminServiceServer) GetDynamicConfig(arg0 context.Context, arg1 *adminservice.GetDynamicConfigRequest) (*adminservice.GetDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDynamicConfig", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDynamicConfig indicates an expected call of GetDynamicConfig.
+func (mr *MockAdminServiceServerMockRecorder) GetDynamicConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicConfig", reflect.TypeOf((*MockAdminServiceServer)(nil).GetDynamicConfig), arg0, arg1)
+}
+
+// GetHostProfile mocks base method.
+func (m *MockAdminServiceServer) GetHostProfile(arg0 context.Context, arg1 *adminservice.GetHostProfileRequest) (*adminservice.GetHostProfileResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHostProfile", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetHostProfileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHostProfile indicates an expected call of GetHostProfile.
+func (mr *MockAdminServiceServerMockRecorder) GetHostProfile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostProfile", reflect.TypeOf((*MockAdminServiceServer)(nil).GetHostProfile), arg0, arg1)
+}
+
+// GetNamespaceReplicationMessages mocks base method.
+func (m *MockAdminServiceServer) GetNamespaceReplicationMessages(arg0 context.Context, arg1 *adminservice.GetNamespaceReplicationMessagesRequest) (*adminservice.GetNamespaceReplicationMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNamespaceReplicationMessages", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetNamespaceReplicationMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNamespaceReplicationMessages indicates an expected call of GetNamespaceReplicationMessages.
+func (mr *MockAdminServiceServerMockRecorder) GetNamespaceReplicationMessages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceReplicationMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).GetNamespaceReplicationMessages), arg0, arg1)
+}
+
+// GetReplicationLag mocks base method.
+func (m *MockAdminServiceServer) GetReplicationLag(arg0 context.Context, arg1 *adminservice.GetReplicationLagRequest) (*adminservice.GetReplicationLagResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplicationLag", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetReplicationLagResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationLag indicates an expected call of GetReplicationLag.
+func (mr *MockAdminServiceServerMockRecorder) GetReplicationLag(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationLag", reflect.TypeOf((*MockAdminServiceServer)(nil).GetReplicationLag), arg0, arg1)
+}
+
+// GetReplicationMessages mocks base method.
+func (m *MockAdminServiceServer) GetReplicationMessages(arg0 context.Context, arg1 *adminservice.GetReplicationMessagesRequest) (*adminservice.GetReplicationMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplicationMessages", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetReplicationMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationMessages indicates an expected call of GetReplicationMessages.
+func (mr *MockAdminServiceServerMockRecorder) GetReplicationMessages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).GetReplicationMessages), arg0, arg1)
+}
+
+// GetResetPoints mocks base method.
+func (m *MockAdminServiceServer) GetResetPoints(arg0 context.Context, arg1 *adminservice.GetResetPointsRequest) (*adminservice.GetResetPointsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResetPoints", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetResetPointsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResetPoints indicates an expected call of GetResetPoints.
+func (mr *MockAdminServiceServerMockRecorder) GetResetPoints(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResetPoints", reflect.TypeOf((*MockAdminServiceServer)(nil).GetResetPoints), arg0, arg1)
+}
+
+// GetSearchAttributes mocks base method.
+func (m *MockAdminServiceServer) GetSearchAttributes(arg0 context.Context, arg1 *adminservice.GetSearchAttributesRequest) (*adminservice.GetSearchAttributesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSearchAttributes", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetSearchAttributesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSearchAttributes indicates an expected call of GetSearchAttributes.
+func (mr *MockAdminServiceServerMockRecorder) GetSearchAttributes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchAttributes", reflect.TypeOf((*MockAdminServiceServer)(nil).GetSearchAttributes), arg0, arg1)
+}
+
+// GetShardStats mocks base method.
+func (m *MockAdminServiceServer) GetShardStats(arg0 context.Context, arg1 *adminservice.GetShardStatsRequest) (*adminservice.GetShardStatsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShardStats", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetShardStatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShardStats indicates an expected call of GetShardStats.
+func (mr *MockAdminServiceServerMockRecorder) GetShardStats(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShardStats", reflect.TypeOf((*MockAdminServiceServer)(nil).GetShardStats), arg0, arg1)
+}
+
+// GetWorkerBuildIdCompatibility mocks base method.
+func (m *MockAdminServiceServer) GetWorkerBuildIdCompatibility(arg0 context.Context, arg1 *adminservice.GetWorkerBuildIdCompatibilityRequest) (*adminservice.GetWorkerBuildIdCompatibilityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkerBuildIdCompatibility", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetWorkerBuildIdCompatibilityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkerBuildIdCompatibility indicates an expected call of GetWorkerBuildIdCompatibility.
+func (mr *MockAdminServiceServerMockRecorder) GetWorkerBuildIdCompatibility(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkerBuildIdCompatibility", reflect.TypeOf((*MockAdminServiceServer)(nil).GetWorkerBuildIdCompatibility), arg0, arg1)
+}
+
+// GetWorkflowExecutionHistoryReverse mocks base method.
+func (m *MockAdminServiceServer) GetWorkflowExecutionHistoryReverse(arg0 context.Context, arg1 *adminservice.GetWorkflowExecutionHistoryReverseRequest) (*adminservice.GetWorkflowExecutionHistoryReverseResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkflowExecutionHistoryReverse", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetWorkflowExecutionHistoryReverseResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowExecutionHistoryReverse indicates an expected call of GetWorkflowExecutionHistoryReverse.
+func (mr *MockAdminServiceServerMockRecorder) GetWorkflowExecutionHistoryReverse(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionHistoryReverse", reflect.TypeOf((*MockAdminServiceServer)(nil).GetWorkflowExecutionHistoryReverse), arg0, arg1)
+}
+
+// GetWorkflowExecutionRawHistoryV2 mocks base method.
+func (m *MockAdminServiceServer) GetWorkflowExecutionRawHistoryV2(arg0 context.Context, arg1 *adminservice.GetWorkflowExecutionRawHistoryV2Request) (*adminservice.GetWorkflowExecutionRawHistoryV2Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkflowExecutionRawHistoryV2", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetWorkflowExecutionRawHistoryV2Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowExecutionRawHistoryV2 indicates an expected call of GetWorkflowExecutionRawHistoryV2.
+func (mr *MockAdminServiceServerMockRecorder) GetWorkflowExecutionRawHistoryV2(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionRawHistoryV2", reflect.TypeOf((*MockAdminServiceServer)(nil).GetWorkflowExecutionRawHistoryV2), arg0, arg1)
+}
+
+// HandoverNamespace mocks base method.
+func (m *MockAdminServiceServer) HandoverNamespace(arg0 context.Context, arg1 *adminservice.HandoverNamespaceRequest) (*adminservice.HandoverNamespaceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandoverNamespace", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.HandoverNamespaceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HandoverNamespace indicates an expected call of HandoverNamespace.
+func (mr *MockAdminServiceServerMockRecorder) HandoverNamespace(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandoverNamespace", reflect.TypeOf((*MockAdminServiceServer)(nil).HandoverNamespace), arg0, arg1)
 }
 
-// GetDLQMessages mocks base method.
-func (m *MockAdminServiceServer) GetDLQMessages(arg0 context.Context, arg1 *adminservice.GetDLQMessagesRequest) (*adminservice.GetDLQMessagesResponse, error) {
+// ImportWorkflowExecution mocks base method.
+func (m *MockAdminServiceServer) ImportWorkflowExecution(arg0 context.Context, arg1 *adminservice.ImportWorkflowExecutionRequest) (*adminservice.ImportWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDLQMessages", arg0, arg1)
-	ret0, _ := ret[0].(*adminservice.GetDLQMessagesResponse)
+	ret := m.ctrl.Call(m, "ImportWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ImportWorkflowExecutionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetDLQMessages indicates an expected call of GetDLQMessages.
-func (mr *MockAdminServiceServerMockRecorder) GetDLQMessages(arg0, arg1 interface{}) *gomock.Call {
+// ImportWorkflowExecution indicates an expected call of ImportWorkflowExecution.
+func (mr *MockAdminServiceServerMockRecorder) ImportWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).GetDLQMessages), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).ImportWorkflowExecution), arg0, arg1)
 }
 
-// GetDLQReplicationMessages mocks base method.
-func (m *MockAdminServiceServer) GetDLQReplicationMessages(arg0 context.Context, arg1 *adminservice.GetDLQReplicationMessagesRequest) (*adminservice.GetDLQReplicationMessagesResponse, error) {
+// ListDynamicConfig mocks base method.
+func (m *MockAdminServiceServer) ListDynamicConfig(arg0 context.Context, arg1 *adminservice.ListDynamicConfigRequest) (*adminservice.ListDynamicConfigResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDLQReplicationMessages", arg0, arg1)
-	ret0, _ := ret[0].(*adminservice.GetDLQReplicationMessagesResponse)
+	ret := m.ctrl.Call(m, "ListDynamicConfig", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListDynamicConfigResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetDLQReplicationMessages indicates an expected call of GetDLQReplicationMessages.
-func (mr *MockAdminServiceServerMockRecorder) GetDLQReplicationMessages(arg0, arg1 interface{}) *gomock.Call {
+// ListDynamicConfig indicates an expected call of ListDynamicConfig.
+func (mr *MockAdminServiceServerMockRecorder) ListDynamicConfig(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQReplicationMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).GetDLQReplicationMessages), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfig", reflect.TypeOf((*MockAdminServiceServer)(nil).ListDynamicConfig), arg0, arg1)
 }
 
-// GetNamespaceReplicationMessages mocks base method.
-func (m *MockAdminServiceServer) GetNamespaceReplicationMessages(arg0 context.Context, arg1 *adminservice.GetNamespaceReplicationMessagesRequest) (*adminservice.GetNamespaceReplicationMessagesResponse, error) {
+// ListNamespaceFailoverHistory mocks base method.
+func (m *MockAdminServiceServer) ListNamespaceFailoverHistory(arg0 context.Context, arg1 *adminservice.ListNamespaceFailoverHistoryRequest) (*adminservice.ListNamespaceFailoverHistoryResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetNamespaceReplicationMessages", arg0, arg1)
-	ret0, _ := ret[0].(*adminservice.GetNamespaceReplicationMessagesResponse)
+	ret := m.ctrl.Call(m, "ListNamespaceFailoverHistory", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListNamespaceFailoverHistoryResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetNamespaceReplicationMessages indicates an expected call of GetNamespaceReplicationMessages.
-func (mr *MockAdminServiceServerMockRecorder) GetNamespaceReplicationMessages(arg0, arg1 interface{}) *gomock.Call {
+// ListNamespaceFailoverHistory indicates an expected call of ListNamespaceFailoverHistory.
+func (mr *MockAdminServiceServerMockRecorder) ListNamespaceFailoverHistory(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceReplicationMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).GetNamespaceReplicationMessages), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNamespaceFailoverHistory", reflect.TypeOf((*MockAdminServiceServer)(nil).ListNamespaceFailoverHistory), arg0, arg1)
 }
 
-// GetReplicationMessages mocks base method.
-func (m *MockAdminServiceServer) GetReplicationMessages(arg0 context.Context, arg1 *adminservice.GetReplicationMessagesRequest) (*adminservice.GetReplicationMessagesResponse, error) {
+// ListSchedules mocks base method.
+func (m *MockAdminServiceServer) ListSchedules(arg0 context.Context, arg1 *adminservice.ListSchedulesRequest) (*adminservice.ListSchedulesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetReplicationMessages", arg0, arg1)
-	ret0, _ := ret[0].(*adminservice.GetReplicationMessagesResponse)
+	ret := m.ctrl.Call(m, "ListSchedules", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListSchedulesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetReplicationMessages indicates an expected call of GetReplicationMessages.
-func (mr *MockAdminServiceServerMockRecorder) GetReplicationMessages(arg0, arg1 interface{}) *gomock.Call {
+// ListSchedules indicates an expected call of ListSchedules.
+func (mr *MockAdminServiceServerMockRecorder) ListSchedules(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).GetReplicationMessages), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSchedules", reflect.TypeOf((*MockAdminServiceServer)(nil).ListSchedules), arg0, arg1)
 }
 
-// GetSearchAttributes mocks base method.
-func (m *MockAdminServiceServer) GetSearchAttributes(arg0 context.Context, arg1 *adminservice.GetSearchAttributesRequest) (*adminservice.GetSearchAttributesResponse, error) {
+// ListStaleWorkflowExecutions mocks base method.
+func (m *MockAdminServiceServer) ListStaleWorkflowExecutions(arg0 context.Context, arg1 *adminservice.ListStaleWorkflowExecutionsRequest) (*adminservice.ListStaleWorkflowExecutionsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSearchAttributes", arg0, arg1)
-	ret0, _ := ret[0].(*adminservice.GetSearchAttributesResponse)
+	ret := m.ctrl.Call(m, "ListStaleWorkflowExecutions", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListStaleWorkflowExecutionsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSearchAttributes indicates an expected call of GetSearchAttributes.
-func (mr *MockAdminServiceServerMockRecorder) GetSearchAttributes(arg0, arg1 interface{}) *gomock.Call {
+// ListStaleWorkflowExecutions indicates an expected call of ListStaleWorkflowExecutions.
+func (mr *MockAdminServiceServerMockRecorder) ListStaleWorkflowExecutions(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchAttributes", reflect.TypeOf((*MockAdminServiceServer)(nil).GetSearchAttributes), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStaleWorkflowExecutions", reflect.TypeOf((*MockAdminServiceServer)(nil).ListStaleWorkflowExecutions), arg0, arg1)
 }
 
-// GetWorkflowExecutionRawHistoryV2 mocks base method.
-func (m *MockAdminServiceServer) GetWorkflowExecutionRawHistoryV2(arg0 context.Context, arg1 *adminservice.GetWorkflowExecutionRawHistoryV2Request) (*adminservice.GetWorkflowExecutionRawHistoryV2Response, error) {
+// ListThrottledCallers mocks base method.
+func (m *MockAdminServiceServer) ListThrottledCallers(arg0 context.Context, arg1 *adminservice.ListThrottledCallersRequest) (*adminservice.ListThrottledCallersResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetWorkflowExecutionRawHistoryV2", arg0, arg1)
-	ret0, _ := ret[0].(*adminservice.GetWorkflowExecutionRawHistoryV2Response)
+	ret := m.ctrl.Call(m, "ListThrottledCallers", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListThrottledCallersResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetWorkflowExecutionRawHistoryV2 indicates an expected call of GetWorkflowExecutionRawHistoryV2.
-func (mr *MockAdminServiceServerMockRecorder) GetWorkflowExecutionRawHistoryV2(arg0, arg1 interface{}) *gomock.Call {
+// ListThrottledCallers indicates an expected call of ListThrottledCallers.
+func (mr *MockAdminServiceServerMockRecorder) ListThrottledCallers(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionRawHistoryV2", reflect.TypeOf((*MockAdminServiceServer)(nil).GetWorkflowExecutionRawHistoryV2), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListThrottledCallers", reflect.TypeOf((*MockAdminServiceServer)(nil).ListThrottledCallers), arg0, arg1)
 }
 
 // MergeDLQMessages mocks base method.
@@ -623,6 +2019,21 @@ func (mr *MockAdminServiceServerMockRecorder) MergeDLQMessages(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).MergeDLQMessages), arg0, arg1)
 }
 
+// PatchSchedule mocks base method.
+func (m *MockAdminServiceServer) PatchSchedule(arg0 context.Context, arg1 *adminservice.PatchScheduleRequest) (*adminservice.PatchScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.PatchScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PatchSchedule indicates an expected call of PatchSchedule.
+func (mr *MockAdminServiceServerMockRecorder) PatchSchedule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchSchedule", reflect.TypeOf((*MockAdminServiceServer)(nil).PatchSchedule), arg0, arg1)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockAdminServiceServer) PurgeDLQMessages(arg0 context.Context, arg1 *adminservice.PurgeDLQMessagesRequest) (*adminservice.PurgeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -653,6 +2064,36 @@ func (mr *MockAdminServiceServerMockRecorder) ReapplyEvents(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReapplyEvents", reflect.TypeOf((*MockAdminServiceServer)(nil).ReapplyEvents), arg0, arg1)
 }
 
+// RebalanceShards mocks base method.
+func (m *MockAdminServiceServer) RebalanceShards(arg0 context.Context, arg1 *adminservice.RebalanceShardsRequest) (*adminservice.RebalanceShardsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebalanceShards", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.RebalanceShardsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RebalanceShards indicates an expected call of RebalanceShards.
+func (mr *MockAdminServiceServerMockRecorder) RebalanceShards(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebalanceShards", reflect.TypeOf((*MockAdminServiceServer)(nil).RebalanceShards), arg0, arg1)
+}
+
+// RecordWorkflowTaskHeartbeat mocks base method.
+func (m *MockAdminServiceServer) RecordWorkflowTaskHeartbeat(arg0 context.Context, arg1 *adminservice.RecordWorkflowTaskHeartbeatRequest) (*adminservice.RecordWorkflowTaskHeartbeatResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordWorkflowTaskHeartbeat", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.RecordWorkflowTaskHeartbeatResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordWorkflowTaskHeartbeat indicates an expected call of RecordWorkflowTaskHeartbeat.
+func (mr *MockAdminServiceServerMockRecorder) RecordWorkflowTaskHeartbeat(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordWorkflowTaskHeartbeat", reflect.TypeOf((*MockAdminServiceServer)(nil).RecordWorkflowTaskHeartbeat), arg0, arg1)
+}
+
 // RefreshWorkflowTasks mocks base method.
 func (m *MockAdminServiceServer) RefreshWorkflowTasks(arg0 context.Context, arg1 *adminservice.RefreshWorkflowTasksRequest) (*adminservice.RefreshWorkflowTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -712,3 +2153,404 @@ func (mr *MockAdminServiceServerMockRecorder) ResendReplicationTasks(arg0, arg1
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ResendReplicationTasks), arg0, arg1)
 }
+
+// SetDynamicConfig mocks base method.
+func (m *MockAdminServiceServer) SetDynamicConfig(arg0 context.Context, arg1 *adminservice.SetDynamicConfigRequest) (*adminservice.SetDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDynamicConfig", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.SetDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDynamicConfig indicates an expected call of SetDynamicConfig.
+func (mr *MockAdminServiceServerMockRecorder) SetDynamicConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDynamicConfig", reflect.TypeOf((*MockAdminServiceServer)(nil).SetDynamicConfig), arg0, arg1)
+}
+
+// SetMaintenanceInfo mocks base method.
+func (m *MockAdminServiceServer) SetMaintenanceInfo(arg0 context.Context, arg1 *adminservice.SetMaintenanceInfoRequest) (*adminservice.SetMaintenanceInfoResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMaintenanceInfo", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.SetMaintenanceInfoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetMaintenanceInfo indicates an expected call of SetMaintenanceInfo.
+func (mr *MockAdminServiceServerMockRecorder) SetMaintenanceInfo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMaintenanceInfo", reflect.TypeOf((*MockAdminServiceServer)(nil).SetMaintenanceInfo), arg0, arg1)
+}
+
+// ShutdownWorker mocks base method.
+func (m *MockAdminServiceServer) ShutdownWorker(arg0 context.Context, arg1 *adminservice.ShutdownWorkerRequest) (*adminservice.ShutdownWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShutdownWorker", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ShutdownWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShutdownWorker indicates an expected call of ShutdownWorker.
+func (mr *MockAdminServiceServerMockRecorder) ShutdownWorker(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShutdownWorker", reflect.TypeOf((*MockAdminServiceServer)(nil).ShutdownWorker), arg0, arg1)
+}
+
+// StartResetBadBinaryJob mocks base method.
+func (m *MockAdminServiceServer) StartResetBadBinaryJob(arg0 context.Context, arg1 *adminservice.StartResetBadBinaryJobRequest) (*adminservice.StartResetBadBinaryJobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartResetBadBinaryJob", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.StartResetBadBinaryJobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartResetBadBinaryJob indicates an expected call of StartResetBadBinaryJob.
+func (mr *MockAdminServiceServerMockRecorder) StartResetBadBinaryJob(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartResetBadBinaryJob", reflect.TypeOf((*MockAdminServiceServer)(nil).StartResetBadBinaryJob), arg0, arg1)
+}
+
+// StreamReplicationMessages mocks base method.
+func (m *MockAdminServiceServer) StreamReplicationMessages(arg0 adminservice.AdminService_StreamReplicationMessagesServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamReplicationMessages", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamReplicationMessages indicates an expected call of StreamReplicationMessages.
+func (mr *MockAdminServiceServerMockRecorder) StreamReplicationMessages(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamReplicationMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).StreamReplicationMessages), arg0)
+}
+
+// StreamWorkflowExecutionHistory mocks base method.
+func (m *MockAdminServiceServer) StreamWorkflowExecutionHistory(arg0 *adminservice.StreamWorkflowExecutionHistoryRequest, arg1 adminservice.AdminService_StreamWorkflowExecutionHistoryServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamWorkflowExecutionHistory", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamWorkflowExecutionHistory indicates an expected call of StreamWorkflowExecutionHistory.
+func (mr *MockAdminServiceServerMockRecorder) StreamWorkflowExecutionHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamWorkflowExecutionHistory", reflect.TypeOf((*MockAdminServiceServer)(nil).StreamWorkflowExecutionHistory), arg0, arg1)
+}
+
+// UpdateClusterMetadata mocks base method.
+func (m *MockAdminServiceServer) UpdateClusterMetadata(arg0 context.Context, arg1 *adminservice.UpdateClusterMetadataRequest) (*adminservice.UpdateClusterMetadataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateClusterMetadata", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateClusterMetadataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateClusterMetadata indicates an expected call of UpdateClusterMetadata.
+func (mr *MockAdminServiceServerMockRecorder) UpdateClusterMetadata(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClusterMetadata", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateClusterMetadata), arg0, arg1)
+}
+
+// UpdateNamespaceQuotas mocks base method.
+func (m *MockAdminServiceServer) UpdateNamespaceQuotas(arg0 context.Context, arg1 *adminservice.UpdateNamespaceQuotasRequest) (*adminservice.UpdateNamespaceQuotasResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNamespaceQuotas", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateNamespaceQuotasResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNamespaceQuotas indicates an expected call of UpdateNamespaceQuotas.
+func (mr *MockAdminServiceServerMockRecorder) UpdateNamespaceQuotas(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNamespaceQuotas", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateNamespaceQuotas), arg0, arg1)
+}
+
+// UpdateSchedule mocks base method.
+func (m *MockAdminServiceServer) UpdateSchedule(arg0 context.Context, arg1 *adminservice.UpdateScheduleRequest) (*adminservice.UpdateScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSchedule indicates an expected call of UpdateSchedule.
+func (mr *MockAdminServiceServerMockRecorder) UpdateSchedule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSchedule", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateSchedule), arg0, arg1)
+}
+
+// UpdateWorkerBuildIdCompatibility mocks base method.
+func (m *MockAdminServiceServer) UpdateWorkerBuildIdCompatibility(arg0 context.Context, arg1 *adminservice.UpdateWorkerBuildIdCompatibilityRequest) (*adminservice.UpdateWorkerBuildIdCompatibilityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkerBuildIdCompatibility", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateWorkerBuildIdCompatibilityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkerBuildIdCompatibility indicates an expected call of UpdateWorkerBuildIdCompatibility.
+func (mr *MockAdminServiceServerMockRecorder) UpdateWorkerBuildIdCompatibility(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkerBuildIdCompatibility", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateWorkerBuildIdCompatibility), arg0, arg1)
+}
+
+// MockAdminService_StreamReplicationMessagesServer is a mock of AdminService_StreamReplicationMessagesServer interface.
+type MockAdminService_StreamReplicationMessagesServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminService_StreamReplicationMessagesServerMockRecorder
+}
+
+// MockAdminService_StreamReplicationMessagesServerMockRecorder is the mock recorder for MockAdminService_StreamReplicationMessagesServer.
+type MockAdminService_StreamReplicationMessagesServerMockRecorder struct {
+	mock *MockAdminService_StreamReplicationMessagesServer
+}
+
+// NewMockAdminService_StreamReplicationMessagesServer creates a new mock instance.
+func NewMockAdminService_StreamReplicationMessagesServer(ctrl *gomock.Controller) *MockAdminService_StreamReplicationMessagesServer {
+	mock := &MockAdminService_StreamReplicationMessagesServer{ctrl: ctrl}
+	mock.recorder = &MockAdminService_StreamReplicationMessagesServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminService_StreamReplicationMessagesServer) EXPECT() *MockAdminService_StreamReplicationMessagesServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockAdminService_StreamReplicationMessagesServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockAdminService_StreamReplicationMessagesServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockAdminService_StreamReplicationMessagesServer)(nil).Context))
+}
+
+// Recv mocks base method.
+func (m *MockAdminService_StreamReplicationMessagesServer) Recv() (*adminservice.StreamReplicationMessagesRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*adminservice.StreamReplicationMessagesRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockAdminService_StreamReplicationMessagesServerMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockAdminService_StreamReplicationMessagesServer)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockAdminService_StreamReplicationMessagesServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockAdminService_StreamReplicationMessagesServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockAdminService_StreamReplicationMessagesServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockAdminService_StreamReplicationMessagesServer) Send(arg0 *adminservice.StreamReplicationMessagesResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockAdminService_StreamReplicationMessagesServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockAdminService_StreamReplicationMessagesServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockAdminService_StreamReplicationMessagesServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockAdminService_StreamReplicationMessagesServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockAdminService_StreamReplicationMessagesServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockAdminService_StreamReplicationMessagesServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockAdminService_StreamReplicationMessagesServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockAdminService_StreamReplicationMessagesServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockAdminService_StreamReplicationMessagesServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockAdminService_StreamReplicationMessagesServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockAdminService_StreamReplicationMessagesServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockAdminService_StreamReplicationMessagesServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockAdminService_StreamReplicationMessagesServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockAdminService_StreamReplicationMessagesServer)(nil).SetTrailer), arg0)
+}
+
+// MockAdminService_StreamWorkflowExecutionHistoryServer is a mock of AdminService_StreamWorkflowExecutionHistoryServer interface.
+type MockAdminService_StreamWorkflowExecutionHistoryServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminService_StreamWorkflowExecutionHistoryServerMockRecorder
+}
+
+// MockAdminService_StreamWorkflowExecutionHistoryServerMockRecorder is the mock recorder for MockAdminService_StreamWorkflowExecutionHistoryServer.
+type MockAdminService_StreamWorkflowExecutionHistoryServerMockRecorder struct {
+	mock *MockAdminService_StreamWorkflowExecutionHistoryServer
+}
+
+// NewMockAdminService_StreamWorkflowExecutionHistoryServer creates a new mock instance.
+func NewMockAdminService_StreamWorkflowExecutionHistoryServer(ctrl *gomock.Controller) *MockAdminService_StreamWorkflowExecutionHistoryServer {
+	mock := &MockAdminService_StreamWorkflowExecutionHistoryServer{ctrl: ctrl}
+	mock.recorder = &MockAdminService_StreamWorkflowExecutionHistoryServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminService_StreamWorkflowExecutionHistoryServer) EXPECT() *MockAdminService_StreamWorkflowExecutionHistoryServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockAdminService_StreamWorkflowExecutionHistoryServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockAdminService_StreamWorkflowExecutionHistoryServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockAdminService_StreamWorkflowExecutionHistoryServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockAdminService_StreamWorkflowExecutionHistoryServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockAdminService_StreamWorkflowExecutionHistoryServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockAdminService_StreamWorkflowExecutionHistoryServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockAdminService_StreamWorkflowExecutionHistoryServer) Send(arg0 *adminservice.StreamWorkflowExecutionHistoryResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockAdminService_StreamWorkflowExecutionHistoryServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockAdminService_StreamWorkflowExecutionHistoryServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockAdminService_StreamWorkflowExecutionHistoryServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockAdminService_StreamWorkflowExecutionHistoryServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockAdminService_StreamWorkflowExecutionHistoryServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockAdminService_StreamWorkflowExecutionHistoryServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockAdminService_StreamWorkflowExecutionHistoryServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockAdminService_StreamWorkflowExecutionHistoryServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockAdminService_StreamWorkflowExecutionHistoryServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockAdminService_StreamWorkflowExecutionHistoryServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockAdminService_StreamWorkflowExecutionHistoryServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockAdminService_StreamWorkflowExecutionHistoryServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockAdminService_StreamWorkflowExecutionHistoryServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockAdminService_StreamWorkflowExecutionHistoryServer)(nil).SetTrailer), arg0)
+}
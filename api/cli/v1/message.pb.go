@@ -43,6 +43,7 @@ import (
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	v11 "go.temporal.io/api/common/v1"
 	v12 "go.temporal.io/api/enums/v1"
+	v13 "go.temporal.io/api/namespace/v1"
 	v1 "go.temporal.io/api/workflow/v1"
 )
 
@@ -588,6 +589,75 @@ func (m *AddSearchAttributesResponse) GetAddWorkflowExecutionInfo() *WorkflowExe
 	return nil
 }
 
+// NamespaceExport is the file format of namespace export and import commands.
+type NamespaceExport struct {
+	Info              *v13.NamespaceInfo   `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	Config            *v13.NamespaceConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	IsGlobalNamespace bool                 `protobuf:"varint,3,opt,name=is_global_namespace,json=isGlobalNamespace,proto3" json:"is_global_namespace,omitempty"`
+	// Custom search attributes of the visibility index used by the namespace.
+	SearchAttributes map[string]v12.IndexedValueType `protobuf:"bytes,4,rep,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=temporal.api.enums.v1.IndexedValueType"`
+}
+
+func (m *NamespaceExport) Reset()      { *m = NamespaceExport{} }
+func (*NamespaceExport) ProtoMessage() {}
+func (*NamespaceExport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad471f2cfe5ee207, []int{6}
+}
+func (m *NamespaceExport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespaceExport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NamespaceExport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NamespaceExport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceExport.Merge(m, src)
+}
+func (m *NamespaceExport) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespaceExport) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceExport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceExport proto.InternalMessageInfo
+
+func (m *NamespaceExport) GetInfo() *v13.NamespaceInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+func (m *NamespaceExport) GetConfig() *v13.NamespaceConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (m *NamespaceExport) GetIsGlobalNamespace() bool {
+	if m != nil {
+		return m.IsGlobalNamespace
+	}
+	return false
+}
+
+func (m *NamespaceExport) GetSearchAttributes() map[string]v12.IndexedValueType {
+	if m != nil {
+		return m.SearchAttributes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*DescribeWorkflowExecutionResponse)(nil), "temporal.server.api.cli.v1.DescribeWorkflowExecutionResponse")
 	proto.RegisterType((*WorkflowExecutionInfo)(nil), "temporal.server.api.cli.v1.WorkflowExecutionInfo")
@@ -599,6 +669,8 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "temporal.server.api.cli.v1.AddSearchAttributesResponse.CustomSearchAttributesEntry")
 	proto.RegisterMapType((map[string]string)(nil), "temporal.server.api.cli.v1.AddSearchAttributesResponse.MappingEntry")
 	proto.RegisterMapType((map[string]string)(nil), "temporal.server.api.cli.v1.AddSearchAttributesResponse.SystemSearchAttributesEntry")
+	proto.RegisterType((*NamespaceExport)(nil), "temporal.server.api.cli.v1.NamespaceExport")
+	proto.RegisterMapType((map[string]v12.IndexedValueType)(nil), "temporal.server.api.cli.v1.NamespaceExport.SearchAttributesEntry")
 }
 
 func init() {
@@ -606,87 +678,96 @@ func init() {
 }
 
 var fileDescriptor_ad471f2cfe5ee207 = []byte{
-	// 1279 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xcb, 0x6e, 0xdb, 0x46,
-	0x17, 0x36, 0x63, 0x4b, 0xfe, 0x75, 0x7c, 0x91, 0x3c, 0xb9, 0xfc, 0x84, 0xd3, 0x32, 0x8e, 0x9b,
-	0x14, 0x0e, 0x52, 0x50, 0xb1, 0xd3, 0x45, 0x9a, 0x2e, 0x52, 0xc7, 0xb9, 0x54, 0x40, 0x52, 0xa4,
-	0xb4, 0x81, 0xa0, 0x41, 0x11, 0x62, 0x4c, 0x1e, 0xc9, 0x83, 0xf0, 0x06, 0xce, 0xd0, 0xb1, 0x76,
-	0x79, 0x81, 0x02, 0x79, 0x8c, 0x2e, 0xfa, 0x04, 0x45, 0x1f, 0xa0, 0xcb, 0x2c, 0xb3, 0x6b, 0xa3,
-	0x74, 0xd1, 0x65, 0x1e, 0xa1, 0x98, 0x43, 0x52, 0xb2, 0x2d, 0x59, 0x95, 0xd3, 0x9d, 0xe6, 0x3b,
-	0xe7, 0xfb, 0xe6, 0xf0, 0x9c, 0xf9, 0x86, 0x14, 0xac, 0x29, 0x0c, 0x93, 0x38, 0xe5, 0x41, 0x53,
-	0x62, 0xba, 0x8f, 0x69, 0x93, 0x27, 0xa2, 0xe9, 0x05, 0xa2, 0xb9, 0xbf, 0xde, 0x0c, 0x51, 0x4a,
-	0xde, 0x41, 0x3b, 0x49, 0x63, 0x15, 0xb3, 0xe5, 0x32, 0xd3, 0xce, 0x33, 0x6d, 0x9e, 0x08, 0xdb,
-	0x0b, 0x84, 0xbd, 0xbf, 0xbe, 0x7c, 0xa9, 0x13, 0xc7, 0x9d, 0x00, 0x9b, 0x94, 0xb9, 0x9b, 0xb5,
-	0x9b, 0x4a, 0x84, 0x28, 0x15, 0x0f, 0x93, 0x9c, 0xbc, 0x7c, 0xd9, 0xc7, 0x04, 0x23, 0x1f, 0x23,
-	0x4f, 0xa0, 0x6c, 0x76, 0xe2, 0x4e, 0x4c, 0x38, 0xfd, 0x2a, 0x52, 0xae, 0xf4, 0x2b, 0xa1, 0x12,
-	0xe2, 0x30, 0x8c, 0xa3, 0xa1, 0x2a, 0x8e, 0x65, 0x61, 0x94, 0x85, 0x52, 0x27, 0xbd, 0x8c, 0xd3,
-	0x17, 0xed, 0x20, 0x7e, 0x59, 0x64, 0x7d, 0x7e, 0x24, 0xab, 0x0c, 0x0e, 0xa9, 0xad, 0xfe, 0x32,
-	0x0d, 0x97, 0xef, 0xa1, 0xf4, 0x52, 0xb1, 0x8b, 0x4f, 0x8b, 0xac, 0xfb, 0x07, 0xe8, 0x65, 0x4a,
-	0xc4, 0x91, 0x83, 0x32, 0x89, 0x23, 0x89, 0xec, 0x47, 0x68, 0x60, 0x09, 0xba, 0x5e, 0x1c, 0xb5,
-	0x45, 0xc7, 0x34, 0x56, 0x8c, 0xb5, 0xb9, 0x8d, 0x75, 0xbb, 0xdf, 0x14, 0xdd, 0x8d, 0x7e, 0x15,
-	0xfb, 0xeb, 0xf6, 0x90, 0xdc, 0x16, 0x11, 0x9d, 0x3a, 0x1e, 0x05, 0x98, 0x80, 0xff, 0x97, 0x3c,
-	0x77, 0xb0, 0x8d, 0x88, 0xda, 0xb1, 0x79, 0xe6, 0xf8, 0x26, 0x43, 0x9d, 0x1f, 0xde, 0xa6, 0x15,
-	0xb5, 0x63, 0xe7, 0xfc, 0xcb, 0x51, 0x30, 0x7b, 0x0e, 0x4c, 0x4f, 0x41, 0x44, 0x1d, 0x97, 0x7b,
-	0x4a, 0xec, 0x0b, 0x25, 0x50, 0x9a, 0xd3, 0x2b, 0xd3, 0x6b, 0x73, 0x1b, 0xcd, 0x71, 0xbb, 0x3c,
-	0xc9, 0x59, 0x9b, 0x39, 0xa9, 0x4b, 0x7b, 0x2c, 0x25, 0x47, 0x40, 0x81, 0x92, 0x3d, 0x87, 0x46,
-	0xa9, 0xef, 0xed, 0x89, 0xc0, 0x4f, 0x31, 0x32, 0x67, 0x48, 0xfd, 0xe6, 0xc9, 0x8d, 0x2a, 0xb4,
-	0xb7, 0x34, 0xe1, 0xe8, 0x53, 0xd4, 0x93, 0x43, 0xa1, 0x14, 0xa3, 0xd5, 0x0f, 0x55, 0x38, 0x3f,
-	0xf2, 0x81, 0xd9, 0x43, 0xa8, 0xf5, 0x7b, 0x57, 0xcc, 0xe6, 0xda, 0xd1, 0x2d, 0xf3, 0x03, 0x35,
-	0xb2, 0x65, 0xce, 0x80, 0xcb, 0x6e, 0xc1, 0x8c, 0xea, 0x26, 0x58, 0xb4, 0xfe, 0xca, 0xbf, 0x69,
-	0xec, 0x74, 0x13, 0x74, 0x88, 0xc1, 0xee, 0x00, 0x48, 0xc5, 0x53, 0xe5, 0xea, 0xb3, 0x6f, 0x4e,
-	0x13, 0x7f, 0xd9, 0xce, 0x8d, 0x61, 0x97, 0xc6, 0xb0, 0x77, 0x4a, 0x63, 0xdc, 0x9d, 0x79, 0xfd,
-	0xc7, 0x25, 0xc3, 0xa9, 0x11, 0x47, 0xa3, 0x5a, 0xc0, 0x0b, 0x62, 0x89, 0xb9, 0xc0, 0xcc, 0xa4,
-	0x02, 0xc4, 0x21, 0x81, 0x07, 0x50, 0x95, 0x8a, 0xab, 0x4c, 0x9a, 0x95, 0x15, 0x63, 0x6d, 0x71,
-	0xc3, 0x3e, 0x5a, 0x3d, 0x99, 0x65, 0x64, 0x03, 0xb6, 0x89, 0xe5, 0x14, 0x6c, 0x76, 0x15, 0x16,
-	0xf7, 0x84, 0x54, 0x71, 0xda, 0x75, 0x03, 0x8c, 0x3a, 0x6a, 0xcf, 0xac, 0xae, 0x18, 0x6b, 0xd3,
-	0xce, 0x42, 0x81, 0x3e, 0x22, 0x90, 0xd9, 0x70, 0x36, 0xe1, 0x29, 0x46, 0xca, 0x8d, 0x78, 0x88,
-	0x32, 0xe1, 0x1e, 0xba, 0xc2, 0x37, 0x67, 0x57, 0x8c, 0xb5, 0x9a, 0xb3, 0x94, 0x87, 0xbe, 0x2b,
-	0x23, 0x2d, 0x9f, 0xed, 0x40, 0xa3, 0xc8, 0x1f, 0x8c, 0xea, 0x7f, 0xa7, 0x1d, 0x55, 0x3d, 0x97,
-	0xe8, 0x03, 0xec, 0x21, 0x2c, 0x0e, 0x5c, 0x43, 0x9d, 0xab, 0x4d, 0xd8, 0xb9, 0x85, 0x3e, 0x8f,
-	0xba, 0x77, 0x03, 0x66, 0x42, 0x0c, 0x63, 0x13, 0x88, 0xfe, 0xc9, 0x49, 0x25, 0x3d, 0xc6, 0x30,
-	0x76, 0x28, 0x93, 0xfd, 0x00, 0x4b, 0x12, 0x79, 0xea, 0xed, 0xb9, 0x5c, 0xa9, 0x54, 0xec, 0x66,
-	0x0a, 0xa5, 0x39, 0x47, 0xf4, 0x2f, 0xc6, 0xb9, 0x69, 0x9b, 0x48, 0x9b, 0x7d, 0x8e, 0xd3, 0x90,
-	0xc7, 0x10, 0xf6, 0x3d, 0x2c, 0xf1, 0x4c, 0xc5, 0x6e, 0x8a, 0x12, 0x95, 0x9b, 0xc4, 0x22, 0x52,
-	0xd2, 0x9c, 0x27, 0xe9, 0xab, 0x27, 0x5b, 0xc9, 0xd1, 0xd9, 0x4f, 0x28, 0xd9, 0xa9, 0x6b, 0xfe,
-	0x21, 0x80, 0x7d, 0x09, 0x17, 0xf4, 0x7c, 0xd1, 0x55, 0x29, 0x8f, 0xa4, 0x28, 0x2e, 0xb3, 0x2c,
-	0x52, 0xe6, 0x02, 0x4d, 0xf7, 0x1c, 0x45, 0x77, 0xfa, 0xc1, 0x2d, 0x1d, 0x5b, 0xfd, 0xab, 0x02,
-	0x67, 0x47, 0xb8, 0x9f, 0x5d, 0x82, 0xb9, 0xe2, 0x0a, 0xe9, 0xea, 0xa1, 0x1b, 0x34, 0x74, 0x28,
-	0xa1, 0x96, 0xcf, 0x5a, 0xb0, 0xd0, 0x4f, 0x98, 0xc4, 0x51, 0xa5, 0x3a, 0x39, 0x6a, 0x9e, 0x1f,
-	0x5a, 0xb1, 0x4d, 0xa8, 0x50, 0x6d, 0x64, 0xaa, 0xc5, 0x8d, 0xeb, 0x27, 0x1c, 0xeb, 0x63, 0x65,
-	0xea, 0x43, 0x8d, 0x4e, 0xce, 0x64, 0xd7, 0x61, 0x69, 0x0f, 0x79, 0xaa, 0x76, 0x91, 0x2b, 0xd7,
-	0x47, 0xc5, 0x45, 0x20, 0xc9, 0x62, 0x35, 0xa7, 0xd1, 0x0f, 0xdc, 0xcb, 0x71, 0xf6, 0x04, 0xce,
-	0x06, 0x5c, 0x2a, 0x77, 0xc0, 0xa0, 0x73, 0x55, 0x99, 0xf0, 0x5c, 0x2d, 0x69, 0xf2, 0xb7, 0x25,
-	0x97, 0xce, 0xd6, 0x23, 0x20, 0xd0, 0x25, 0xb3, 0xa3, 0x9f, 0xeb, 0x55, 0x27, 0xd4, 0xab, 0x6b,
-	0xea, 0x76, 0xce, 0x24, 0x35, 0x13, 0x66, 0xb9, 0xd2, 0x3d, 0x50, 0x64, 0xb6, 0x8a, 0x53, 0x2e,
-	0xd9, 0x35, 0x68, 0x84, 0xfc, 0x40, 0x84, 0x59, 0xe8, 0x16, 0x90, 0x24, 0x8b, 0x55, 0x9c, 0x7a,
-	0x81, 0x6f, 0x16, 0xb0, 0xf6, 0x8d, 0xf4, 0xf6, 0xd0, 0xcf, 0x82, 0xb2, 0x9e, 0x89, 0x7d, 0xd3,
-	0xe7, 0x51, 0x35, 0x2d, 0xa8, 0xe3, 0x41, 0x22, 0x52, 0x3e, 0x70, 0x20, 0x4c, 0xa8, 0xb4, 0x38,
-	0x20, 0x16, 0x17, 0xd8, 0x3c, 0xb5, 0xa9, 0xcd, 0x45, 0x90, 0xa5, 0x58, 0x78, 0xe9, 0xb3, 0x71,
-	0x5e, 0x7a, 0x90, 0xa7, 0x3a, 0x73, 0x9a, 0x58, 0x2c, 0xd8, 0x0d, 0x38, 0x47, 0x3a, 0xda, 0x1b,
-	0x98, 0xba, 0xc2, 0xc7, 0x48, 0x09, 0xd5, 0x25, 0x03, 0xd5, 0x1c, 0xa6, 0x63, 0x4f, 0x29, 0xd4,
-	0x2a, 0x22, 0xab, 0xbf, 0x19, 0xd0, 0x38, 0x6e, 0x4b, 0xd6, 0x86, 0x45, 0x11, 0xf9, 0x78, 0x80,
-	0xbe, 0xdb, 0x16, 0x18, 0xf8, 0xd2, 0x34, 0xe8, 0x65, 0x76, 0xe7, 0x34, 0xe6, 0xb6, 0x5b, 0xb9,
-	0xc4, 0x03, 0x52, 0xb8, 0x1f, 0xa9, 0xb4, 0xeb, 0x2c, 0x88, 0xc3, 0xd8, 0xf2, 0x37, 0xc0, 0x86,
-	0x93, 0x58, 0x03, 0xa6, 0x5f, 0x60, 0xb7, 0x70, 0x96, 0xfe, 0xc9, 0xce, 0x41, 0x65, 0x9f, 0x07,
-	0x59, 0x6e, 0xa5, 0x9a, 0x93, 0x2f, 0x6e, 0x9f, 0xb9, 0x65, 0xac, 0xfe, 0x6a, 0xc0, 0x6c, 0xf9,
-	0xf0, 0x26, 0xcc, 0x16, 0x1f, 0x39, 0x05, 0xb7, 0x5c, 0xb2, 0x0b, 0x50, 0x95, 0x71, 0x96, 0x7a,
-	0xa5, 0x40, 0xb1, 0xd2, 0x5e, 0x96, 0x8a, 0x7b, 0x2f, 0xf4, 0xcd, 0xe0, 0xe5, 0x2e, 0xab, 0x39,
-	0x40, 0xd0, 0x8e, 0x46, 0xd8, 0x57, 0x50, 0xf1, 0x78, 0x26, 0xcb, 0x97, 0xd2, 0x44, 0x03, 0xc9,
-	0x19, 0xec, 0x32, 0xcc, 0x17, 0xd3, 0xcc, 0x6f, 0x81, 0x0a, 0x89, 0xcf, 0x15, 0x98, 0xb6, 0xf7,
-	0xea, 0xab, 0x2a, 0x5c, 0xdc, 0xf4, 0xfd, 0xa1, 0x5b, 0xb1, 0xfc, 0xfc, 0xfa, 0x14, 0x80, 0xfa,
-	0x45, 0xaf, 0x99, 0xe2, 0x99, 0x6a, 0x84, 0xe8, 0xb7, 0x0b, 0xfb, 0xc9, 0x00, 0xd3, 0xcb, 0xa4,
-	0x8a, 0x43, 0x77, 0xf8, 0x36, 0x3e, 0x43, 0x03, 0xdb, 0x1e, 0x57, 0xf0, 0x98, 0xad, 0xed, 0x2d,
-	0xd2, 0x3d, 0x1e, 0xce, 0x87, 0x78, 0xc1, 0x1b, 0x19, 0xa4, 0x7a, 0x64, 0x57, 0x2a, 0x1c, 0x55,
-	0xcf, 0xf4, 0x7f, 0xab, 0x67, 0x9b, 0x74, 0x4f, 0xa8, 0x47, 0x8e, 0x0c, 0xb2, 0xe7, 0x30, 0x1b,
-	0xf2, 0x24, 0x11, 0x51, 0xa7, 0xf8, 0x16, 0xbb, 0xf7, 0xb1, 0xbb, 0x3f, 0xce, 0x65, 0xf2, 0xed,
-	0x4a, 0x51, 0x96, 0xc0, 0x45, 0xee, 0xfb, 0xee, 0x49, 0xdf, 0xb0, 0x95, 0x8f, 0xfd, 0x86, 0x35,
-	0xb9, 0xef, 0x8f, 0x8c, 0x2c, 0xb7, 0xe0, 0xe2, 0x98, 0xc1, 0x9c, 0xc6, 0x38, 0x5a, 0x6a, 0x4c,
-	0x4f, 0x4f, 0x25, 0x75, 0x1b, 0xe6, 0x0f, 0x37, 0xe8, 0x34, 0xdc, 0xbb, 0xcf, 0xde, 0xbc, 0xb3,
-	0xa6, 0xde, 0xbe, 0xb3, 0xa6, 0x3e, 0xbc, 0xb3, 0x8c, 0x57, 0x3d, 0xcb, 0xf8, 0xb9, 0x67, 0x19,
-	0xbf, 0xf7, 0x2c, 0xe3, 0x4d, 0xcf, 0x32, 0xfe, 0xec, 0x59, 0xc6, 0xdf, 0x3d, 0x6b, 0xea, 0x43,
-	0xcf, 0x32, 0x5e, 0xbf, 0xb7, 0xa6, 0xde, 0xbc, 0xb7, 0xa6, 0xde, 0xbe, 0xb7, 0xa6, 0x9e, 0x5d,
-	0xe9, 0xc4, 0x83, 0xb6, 0x8a, 0x78, 0xf8, 0x1f, 0xdc, 0xd7, 0x5e, 0x20, 0x76, 0xab, 0x74, 0xfd,
-	0xde, 0xfc, 0x27, 0x00, 0x00, 0xff, 0xff, 0x5c, 0x9e, 0xd8, 0x26, 0xea, 0x0d, 0x00, 0x00,
+	// 1410 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xcb, 0x72, 0x13, 0x47,
+	0x17, 0xf6, 0x20, 0x4b, 0x46, 0xc7, 0x17, 0xc9, 0xcd, 0xe5, 0x9f, 0x32, 0x7f, 0x84, 0x71, 0x20,
+	0x98, 0x90, 0x1a, 0x61, 0x93, 0x05, 0x21, 0x49, 0x11, 0x63, 0x2e, 0x51, 0x15, 0xa4, 0xc8, 0xd8,
+	0x15, 0x2a, 0x54, 0x8a, 0xa9, 0xf6, 0x4c, 0x4b, 0xee, 0x62, 0x6e, 0x35, 0xdd, 0x63, 0xac, 0x1d,
+	0x2f, 0x90, 0x2a, 0x5e, 0x21, 0xbb, 0x2c, 0xf2, 0x04, 0xa9, 0x3c, 0x40, 0x96, 0x2c, 0xd9, 0x25,
+	0x98, 0x2c, 0xb2, 0xe4, 0x11, 0x52, 0x7d, 0xa6, 0x67, 0x64, 0x59, 0x97, 0xc8, 0x64, 0xa7, 0x39,
+	0xe7, 0x7c, 0x5f, 0x9f, 0x3e, 0xa7, 0xbf, 0xee, 0x23, 0x58, 0x95, 0x2c, 0x88, 0xa3, 0x84, 0xfa,
+	0x4d, 0xc1, 0x92, 0x3d, 0x96, 0x34, 0x69, 0xcc, 0x9b, 0xae, 0xcf, 0x9b, 0x7b, 0x6b, 0xcd, 0x80,
+	0x09, 0x41, 0x3b, 0xcc, 0x8a, 0x93, 0x48, 0x46, 0x64, 0x29, 0x8f, 0xb4, 0xb2, 0x48, 0x8b, 0xc6,
+	0xdc, 0x72, 0x7d, 0x6e, 0xed, 0xad, 0x2d, 0x9d, 0xef, 0x44, 0x51, 0xc7, 0x67, 0x4d, 0x8c, 0xdc,
+	0x49, 0xdb, 0x4d, 0xc9, 0x03, 0x26, 0x24, 0x0d, 0xe2, 0x0c, 0xbc, 0x74, 0xc1, 0x63, 0x31, 0x0b,
+	0x3d, 0x16, 0xba, 0x9c, 0x89, 0x66, 0x27, 0xea, 0x44, 0x68, 0xc7, 0x5f, 0x3a, 0xe4, 0x62, 0x91,
+	0x09, 0xa6, 0x10, 0x05, 0x41, 0x14, 0x0e, 0x64, 0x71, 0x24, 0x8a, 0x85, 0x69, 0x20, 0x54, 0xd0,
+	0xf3, 0x28, 0x79, 0xd6, 0xf6, 0xa3, 0xe7, 0x3a, 0x6a, 0x65, 0x78, 0x54, 0x46, 0xaa, 0x63, 0x2e,
+	0xf7, 0xc5, 0x84, 0x34, 0x60, 0x22, 0xa6, 0x2e, 0x1b, 0x5c, 0xf2, 0xa3, 0xbe, 0xc0, 0x7c, 0xa5,
+	0x81, 0xb8, 0x95, 0x5f, 0x4a, 0x70, 0xe1, 0x0e, 0x13, 0x6e, 0xc2, 0x77, 0xd8, 0x63, 0x1d, 0x75,
+	0x77, 0x9f, 0xb9, 0xa9, 0xe4, 0x51, 0x68, 0x33, 0x11, 0x47, 0xa1, 0x60, 0xe4, 0x07, 0xa8, 0xb3,
+	0xdc, 0xe8, 0xb8, 0x51, 0xd8, 0xe6, 0x1d, 0xd3, 0x58, 0x36, 0x56, 0x67, 0xd7, 0xd7, 0xac, 0xa2,
+	0xc2, 0xaa, 0xb4, 0xc5, 0x96, 0xf6, 0xd6, 0xac, 0x01, 0xba, 0x4d, 0x04, 0xda, 0x35, 0xd6, 0x6f,
+	0x20, 0x1c, 0xfe, 0x97, 0xe3, 0x9c, 0xde, 0x32, 0x3c, 0x6c, 0x47, 0xe6, 0x89, 0xa3, 0x8b, 0x0c,
+	0xb4, 0x71, 0x70, 0x99, 0x56, 0xd8, 0x8e, 0xec, 0x33, 0xcf, 0x87, 0x99, 0xc9, 0x53, 0x20, 0xaa,
+	0xa5, 0x3c, 0xec, 0x38, 0xd4, 0x95, 0x7c, 0x8f, 0x4b, 0xce, 0x84, 0x59, 0x5a, 0x2e, 0xad, 0xce,
+	0xae, 0x37, 0xc7, 0xad, 0xf2, 0x28, 0x43, 0x6d, 0x64, 0xa0, 0x2e, 0xae, 0xb1, 0x18, 0xf7, 0x19,
+	0x39, 0x13, 0xe4, 0x29, 0xd4, 0x73, 0x7e, 0x77, 0x97, 0xfb, 0x5e, 0xc2, 0x42, 0x73, 0x1a, 0xd9,
+	0xaf, 0x8f, 0x2e, 0x94, 0xe6, 0xde, 0x54, 0x80, 0xfe, 0x5d, 0xd4, 0xe2, 0x43, 0xae, 0x84, 0x85,
+	0x2b, 0xef, 0x2a, 0x70, 0x66, 0xe8, 0x86, 0xc9, 0x7d, 0xa8, 0x16, 0xb5, 0xd3, 0xbd, 0xb9, 0xd2,
+	0xbf, 0xa4, 0x3e, 0x48, 0xc3, 0x4a, 0x66, 0xf7, 0xb0, 0xe4, 0x06, 0x4c, 0xcb, 0x6e, 0xcc, 0x74,
+	0xe9, 0x2f, 0xfe, 0x1b, 0xc7, 0x76, 0x37, 0x66, 0x36, 0x22, 0xc8, 0x2d, 0x00, 0x21, 0x69, 0x22,
+	0x1d, 0x25, 0x24, 0xb3, 0x84, 0xf8, 0x25, 0x2b, 0x53, 0x99, 0x95, 0xab, 0xcc, 0xda, 0xce, 0x55,
+	0x76, 0x7b, 0xfa, 0xe5, 0x1f, 0xe7, 0x0d, 0xbb, 0x8a, 0x18, 0x65, 0x55, 0x04, 0xae, 0x1f, 0x09,
+	0x96, 0x11, 0x4c, 0x4f, 0x4a, 0x80, 0x18, 0x24, 0xb8, 0x07, 0x15, 0x21, 0xa9, 0x4c, 0x85, 0x59,
+	0x5e, 0x36, 0x56, 0x17, 0xd6, 0xad, 0xfe, 0xec, 0x51, 0x53, 0x43, 0x0b, 0xb0, 0x85, 0x28, 0x5b,
+	0xa3, 0xc9, 0x25, 0x58, 0xd8, 0xe5, 0x42, 0x46, 0x49, 0xd7, 0xf1, 0x59, 0xd8, 0x91, 0xbb, 0x66,
+	0x65, 0xd9, 0x58, 0x2d, 0xd9, 0xf3, 0xda, 0xfa, 0x00, 0x8d, 0xc4, 0x82, 0x53, 0x31, 0x4d, 0x58,
+	0x28, 0x9d, 0x42, 0x89, 0x0e, 0xf7, 0xcc, 0x99, 0x65, 0x63, 0xb5, 0x6a, 0x2f, 0x66, 0xae, 0x6f,
+	0x72, 0x4f, 0xcb, 0x23, 0xdb, 0x50, 0xd7, 0xf1, 0xbd, 0x56, 0x9d, 0x3c, 0x6e, 0xab, 0x6a, 0x19,
+	0x45, 0x61, 0x20, 0xf7, 0x61, 0xa1, 0xa7, 0x1a, 0xac, 0x5c, 0x75, 0xc2, 0xca, 0xcd, 0x17, 0x38,
+	0xac, 0xde, 0x35, 0x98, 0x0e, 0x58, 0x10, 0x99, 0x80, 0xf0, 0xff, 0x8f, 0x4a, 0xe9, 0x21, 0x0b,
+	0x22, 0x1b, 0x23, 0xc9, 0xf7, 0xb0, 0x28, 0x18, 0x4d, 0xdc, 0x5d, 0x87, 0x4a, 0x99, 0xf0, 0x9d,
+	0x54, 0x32, 0x61, 0xce, 0x22, 0xfc, 0x93, 0x71, 0x6a, 0xda, 0x42, 0xd0, 0x46, 0x81, 0xb1, 0xeb,
+	0xe2, 0x88, 0x85, 0x7c, 0x0b, 0x8b, 0x34, 0x95, 0x91, 0x93, 0x30, 0xc1, 0xa4, 0x13, 0x47, 0x3c,
+	0x94, 0xc2, 0x9c, 0x43, 0xea, 0x4b, 0xa3, 0xa5, 0x64, 0xab, 0xe8, 0x47, 0x18, 0x6c, 0xd7, 0x14,
+	0xfe, 0x90, 0x81, 0x7c, 0x0a, 0x67, 0x55, 0x7f, 0x99, 0x23, 0x13, 0x1a, 0x0a, 0xae, 0x2f, 0xb3,
+	0x34, 0x94, 0xe6, 0x3c, 0x76, 0xf7, 0x34, 0x7a, 0xb7, 0x0b, 0xe7, 0xa6, 0xf2, 0xad, 0xfc, 0x55,
+	0x86, 0x53, 0x43, 0xd4, 0x4f, 0xce, 0xc3, 0xac, 0xbe, 0x42, 0xba, 0xaa, 0xe9, 0x06, 0x36, 0x1d,
+	0x72, 0x53, 0xcb, 0x23, 0x2d, 0x98, 0x2f, 0x02, 0x26, 0x51, 0x54, 0xce, 0x8e, 0x8a, 0x9a, 0xa3,
+	0x87, 0xbe, 0xc8, 0x06, 0x94, 0x31, 0x37, 0x14, 0xd5, 0xc2, 0xfa, 0xd5, 0x11, 0xc7, 0xfa, 0x48,
+	0x9a, 0xea, 0x50, 0x33, 0x3b, 0x43, 0x92, 0xab, 0xb0, 0xb8, 0xcb, 0x68, 0x22, 0x77, 0x18, 0x95,
+	0x8e, 0xc7, 0x24, 0xe5, 0xbe, 0x40, 0x89, 0x55, 0xed, 0x7a, 0xe1, 0xb8, 0x93, 0xd9, 0xc9, 0x23,
+	0x38, 0xe5, 0x53, 0x21, 0x9d, 0x1e, 0x02, 0xcf, 0x55, 0x79, 0xc2, 0x73, 0xb5, 0xa8, 0xc0, 0x5f,
+	0xe7, 0x58, 0x3c, 0x5b, 0x0f, 0x00, 0x8d, 0x0e, 0x8a, 0x9d, 0x79, 0x19, 0x5f, 0x65, 0x42, 0xbe,
+	0x9a, 0x82, 0x6e, 0x65, 0x48, 0x64, 0x33, 0x61, 0x86, 0x4a, 0x55, 0x03, 0x89, 0x62, 0x2b, 0xdb,
+	0xf9, 0x27, 0xb9, 0x02, 0xf5, 0x80, 0xee, 0xf3, 0x20, 0x0d, 0x1c, 0x6d, 0x12, 0x28, 0xb1, 0xb2,
+	0x5d, 0xd3, 0xf6, 0x0d, 0x6d, 0x56, 0xba, 0x11, 0xee, 0x2e, 0xf3, 0x52, 0x9f, 0x79, 0xc7, 0xd4,
+	0x4d, 0x81, 0xc3, 0x6c, 0x5a, 0x50, 0x63, 0xfb, 0x31, 0x4f, 0x68, 0x4f, 0x81, 0x30, 0x21, 0xd3,
+	0x42, 0x0f, 0xa8, 0x2f, 0xb0, 0x39, 0x2c, 0x53, 0x9b, 0x72, 0x3f, 0x4d, 0x98, 0xd6, 0xd2, 0x87,
+	0xe3, 0xb4, 0x74, 0x2f, 0x0b, 0xb5, 0x67, 0x15, 0x50, 0x7f, 0x90, 0x6b, 0x70, 0x1a, 0x79, 0x94,
+	0x36, 0x58, 0xe2, 0x70, 0x8f, 0x85, 0x92, 0xcb, 0x2e, 0x0a, 0xa8, 0x6a, 0x13, 0xe5, 0x7b, 0x8c,
+	0xae, 0x96, 0xf6, 0xac, 0xfc, 0x66, 0x40, 0xfd, 0xa8, 0x2c, 0x49, 0x1b, 0x16, 0x78, 0xe8, 0xb1,
+	0x7d, 0xe6, 0x39, 0x6d, 0xce, 0x7c, 0x4f, 0x98, 0x06, 0x3e, 0x66, 0xb7, 0x8e, 0x23, 0x6e, 0xab,
+	0x95, 0x51, 0xdc, 0x43, 0x86, 0xbb, 0xa1, 0x4c, 0xba, 0xf6, 0x3c, 0x3f, 0x6c, 0x5b, 0xfa, 0x0a,
+	0xc8, 0x60, 0x10, 0xa9, 0x43, 0xe9, 0x19, 0xeb, 0x6a, 0x65, 0xa9, 0x9f, 0xe4, 0x34, 0x94, 0xf7,
+	0xa8, 0x9f, 0x66, 0x52, 0xaa, 0xda, 0xd9, 0xc7, 0xcd, 0x13, 0x37, 0x8c, 0x95, 0x5f, 0x0d, 0x98,
+	0xc9, 0x37, 0x6f, 0xc2, 0x8c, 0x1e, 0x72, 0x34, 0x36, 0xff, 0x24, 0x67, 0xa1, 0x22, 0xa2, 0x34,
+	0x71, 0x73, 0x02, 0xfd, 0xa5, 0xb4, 0x2c, 0x24, 0x75, 0x9f, 0xa9, 0x9b, 0xc1, 0xcd, 0x54, 0x56,
+	0xb5, 0x01, 0x4d, 0xdb, 0xca, 0x42, 0x3e, 0x83, 0xb2, 0x4b, 0x53, 0x91, 0x3f, 0x4a, 0x13, 0x35,
+	0x24, 0x43, 0x90, 0x0b, 0x30, 0xa7, 0xbb, 0x99, 0xdd, 0x02, 0x65, 0x24, 0x9f, 0xd5, 0x36, 0x25,
+	0xef, 0x95, 0x17, 0x15, 0x38, 0xb7, 0xe1, 0x79, 0x03, 0xb7, 0x62, 0x3e, 0x7e, 0x7d, 0x00, 0x80,
+	0xf5, 0xc2, 0x67, 0x46, 0xef, 0xa9, 0x8a, 0x16, 0xf5, 0xba, 0x90, 0x1f, 0x0d, 0x30, 0xdd, 0x54,
+	0xc8, 0x28, 0x70, 0x06, 0x6f, 0xe3, 0x13, 0xd8, 0xb0, 0xad, 0x71, 0x09, 0x8f, 0x59, 0xda, 0xda,
+	0x44, 0xde, 0xa3, 0xee, 0xac, 0x89, 0x67, 0xdd, 0xa1, 0x4e, 0xcc, 0x47, 0x74, 0x85, 0x64, 0xc3,
+	0xf2, 0x29, 0xfd, 0xb7, 0x7c, 0xb6, 0x90, 0x77, 0x44, 0x3e, 0x62, 0xa8, 0x93, 0x3c, 0x85, 0x99,
+	0x80, 0xc6, 0x31, 0x0f, 0x3b, 0x7a, 0x16, 0xbb, 0xf3, 0xbe, 0xab, 0x3f, 0xcc, 0x68, 0xb2, 0xe5,
+	0x72, 0x52, 0x12, 0xc3, 0x39, 0xea, 0x79, 0xce, 0xa8, 0x19, 0xb6, 0xfc, 0xbe, 0x33, 0xac, 0x49,
+	0x3d, 0x6f, 0xa8, 0x67, 0xa9, 0x05, 0xe7, 0xc6, 0x34, 0xe6, 0x38, 0xc2, 0x51, 0x54, 0x63, 0x6a,
+	0x7a, 0x2c, 0xaa, 0x9b, 0x30, 0x77, 0xb8, 0x40, 0xc7, 0xd2, 0xef, 0x4f, 0x25, 0xa8, 0x15, 0xa3,
+	0xd2, 0xdd, 0xfd, 0x38, 0x4a, 0x24, 0xf9, 0x02, 0xa6, 0xb1, 0x80, 0xd9, 0x34, 0xbb, 0xda, 0xff,
+	0xe8, 0x15, 0x13, 0x97, 0xaa, 0x5f, 0x6f, 0xc8, 0x52, 0x75, 0x43, 0x14, 0xb9, 0x0d, 0x15, 0xfd,
+	0x4f, 0x25, 0x7b, 0x77, 0x3f, 0x9e, 0x04, 0xaf, 0xff, 0xa2, 0x68, 0xa4, 0x1a, 0xf0, 0xb8, 0x70,
+	0x3a, 0x7e, 0xb4, 0x43, 0xfd, 0xde, 0x8c, 0x87, 0xf7, 0xc3, 0x49, 0x7b, 0x91, 0x8b, 0xfb, 0xe8,
+	0x29, 0xd0, 0x24, 0x1c, 0x36, 0x0f, 0x65, 0x67, 0x6e, 0x63, 0x5c, 0xff, 0x8f, 0xec, 0xdc, 0x1a,
+	0x7e, 0xbe, 0x07, 0x86, 0xa4, 0x25, 0x1f, 0xce, 0x4c, 0xda, 0xb6, 0x2f, 0x0f, 0x97, 0x7e, 0x61,
+	0xfd, 0xf2, 0x88, 0x11, 0x42, 0x5f, 0xc3, 0xdf, 0xa9, 0x50, 0x1c, 0x44, 0x7a, 0x3d, 0xba, 0xfd,
+	0xe4, 0xd5, 0x9b, 0xc6, 0xd4, 0xeb, 0x37, 0x8d, 0xa9, 0x77, 0x6f, 0x1a, 0xc6, 0x8b, 0x83, 0x86,
+	0xf1, 0xf3, 0x41, 0xc3, 0xf8, 0xfd, 0xa0, 0x61, 0xbc, 0x3a, 0x68, 0x18, 0x7f, 0x1e, 0x34, 0x8c,
+	0xbf, 0x0f, 0x1a, 0x53, 0xef, 0x0e, 0x1a, 0xc6, 0xcb, 0xb7, 0x8d, 0xa9, 0x57, 0x6f, 0x1b, 0x53,
+	0xaf, 0xdf, 0x36, 0xa6, 0x9e, 0x5c, 0xec, 0x44, 0xbd, 0xb5, 0x78, 0x34, 0xf8, 0x97, 0xfd, 0x73,
+	0xd7, 0xe7, 0x3b, 0x15, 0x7c, 0x22, 0xaf, 0xff, 0x33, 0x00, 0x33, 0x9d, 0xe9, 0x51, 0xdb, 0x0f,
+	0x00, 0x00,
 }
 
 func (this *DescribeWorkflowExecutionResponse) Equal(that interface{}) bool {
@@ -993,6 +1074,44 @@ func (this *AddSearchAttributesResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *NamespaceExport) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NamespaceExport)
+	if !ok {
+		that2, ok := that.(NamespaceExport)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Info.Equal(that1.Info) {
+		return false
+	}
+	if !this.Config.Equal(that1.Config) {
+		return false
+	}
+	if this.IsGlobalNamespace != that1.IsGlobalNamespace {
+		return false
+	}
+	if len(this.SearchAttributes) != len(that1.SearchAttributes) {
+		return false
+	}
+	for i := range this.SearchAttributes {
+		if this.SearchAttributes[i] != that1.SearchAttributes[i] {
+			return false
+		}
+	}
+	return true
+}
 func (this *DescribeWorkflowExecutionResponse) GoString() string {
 	if this == nil {
 		return "nil"
@@ -1163,6 +1282,35 @@ func (this *AddSearchAttributesResponse) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *NamespaceExport) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&cli.NamespaceExport{")
+	if this.Info != nil {
+		s = append(s, "Info: "+fmt.Sprintf("%#v", this.Info)+",\n")
+	}
+	if this.Config != nil {
+		s = append(s, "Config: "+fmt.Sprintf("%#v", this.Config)+",\n")
+	}
+	s = append(s, "IsGlobalNamespace: "+fmt.Sprintf("%#v", this.IsGlobalNamespace)+",\n")
+	keysForSearchAttributes := make([]string, 0, len(this.SearchAttributes))
+	for k, _ := range this.SearchAttributes {
+		keysForSearchAttributes = append(keysForSearchAttributes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForSearchAttributes)
+	mapStringForSearchAttributes := "map[string]v12.IndexedValueType{"
+	for _, k := range keysForSearchAttributes {
+		mapStringForSearchAttributes += fmt.Sprintf("%#v: %#v,", k, this.SearchAttributes[k])
+	}
+	mapStringForSearchAttributes += "}"
+	if this.SearchAttributes != nil {
+		s = append(s, "SearchAttributes: "+mapStringForSearchAttributes+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringMessage(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -1720,6 +1868,80 @@ func (m *AddSearchAttributesResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *NamespaceExport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamespaceExport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespaceExport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SearchAttributes) > 0 {
+		for k := range m.SearchAttributes {
+			v := m.SearchAttributes[k]
+			baseI := i
+			i = encodeVarintMessage(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintMessage(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintMessage(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.IsGlobalNamespace {
+		i--
+		if m.IsGlobalNamespace {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Config != nil {
+		{
+			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Info != nil {
+		{
+			size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMessage(v)
 	base := offset
@@ -1959,6 +2181,34 @@ func (m *AddSearchAttributesResponse) Size() (n int) {
 	return n
 }
 
+func (m *NamespaceExport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Config != nil {
+		l = m.Config.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.IsGlobalNamespace {
+		n += 2
+	}
+	if len(m.SearchAttributes) > 0 {
+		for k, v := range m.SearchAttributes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovMessage(uint64(len(k))) + 1 + sovMessage(uint64(v))
+			n += mapEntrySize + 1 + sovMessage(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
 func sovMessage(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2109,6 +2359,29 @@ func (this *AddSearchAttributesResponse) String() string {
 	}, "")
 	return s
 }
+func (this *NamespaceExport) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForSearchAttributes := make([]string, 0, len(this.SearchAttributes))
+	for k, _ := range this.SearchAttributes {
+		keysForSearchAttributes = append(keysForSearchAttributes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForSearchAttributes)
+	mapStringForSearchAttributes := "map[string]v12.IndexedValueType{"
+	for _, k := range keysForSearchAttributes {
+		mapStringForSearchAttributes += fmt.Sprintf("%v: %v,", k, this.SearchAttributes[k])
+	}
+	mapStringForSearchAttributes += "}"
+	s := strings.Join([]string{`&NamespaceExport{`,
+		`Info:` + strings.Replace(fmt.Sprintf("%v", this.Info), "NamespaceInfo", "v13.NamespaceInfo", 1) + `,`,
+		`Config:` + strings.Replace(fmt.Sprintf("%v", this.Config), "NamespaceConfig", "v13.NamespaceConfig", 1) + `,`,
+		`IsGlobalNamespace:` + fmt.Sprintf("%v", this.IsGlobalNamespace) + `,`,
+		`SearchAttributes:` + mapStringForSearchAttributes + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringMessage(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -4097,6 +4370,264 @@ func (m *AddSearchAttributesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *NamespaceExport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceExport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceExport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Info == nil {
+				m.Info = &v13.NamespaceInfo{}
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Config == nil {
+				m.Config = &v13.NamespaceConfig{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsGlobalNamespace", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsGlobalNamespace = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SearchAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SearchAttributes == nil {
+				m.SearchAttributes = make(map[string]v12.IndexedValueType)
+			}
+			var mapkey string
+			var mapvalue v12.IndexedValueType
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= v12.IndexedValueType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipMessage(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.SearchAttributes[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMessage(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -28,20 +28,26 @@
 package cluster
 
 import (
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
 	math_bits "math/bits"
 	reflect "reflect"
 	strings "strings"
+	time "time"
 
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -210,10 +216,439 @@ func (m *MembershipInfo) GetRings() []*RingInfo {
 	return nil
 }
 
+type ThrottledCaller struct {
+	// ip:port of the host which throttled the caller.
+	HostAddress string `protobuf:"bytes,1,opt,name=host_address,json=hostAddress,proto3" json:"host_address,omitempty"`
+	Service     string `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	// Rate limiter which rejected the requests, e.g. service_rps, namespace_rps or persistence_qps.
+	Limiter   string `protobuf:"bytes,3,opt,name=limiter,proto3" json:"limiter,omitempty"`
+	Namespace string `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Identity  string `protobuf:"bytes,5,opt,name=identity,proto3" json:"identity,omitempty"`
+	// Rate per second enforced by the limiter at the last rejected request.
+	Rate               float64    `protobuf:"fixed64,6,opt,name=rate,proto3" json:"rate,omitempty"`
+	ThrottledCount     int64      `protobuf:"varint,7,opt,name=throttled_count,json=throttledCount,proto3" json:"throttled_count,omitempty"`
+	FirstThrottledTime *time.Time `protobuf:"bytes,8,opt,name=first_throttled_time,json=firstThrottledTime,proto3,stdtime" json:"first_throttled_time,omitempty"`
+	LastThrottledTime  *time.Time `protobuf:"bytes,9,opt,name=last_throttled_time,json=lastThrottledTime,proto3,stdtime" json:"last_throttled_time,omitempty"`
+}
+
+func (m *ThrottledCaller) Reset()      { *m = ThrottledCaller{} }
+func (*ThrottledCaller) ProtoMessage() {}
+func (*ThrottledCaller) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fcc65697c8eece3a, []int{3}
+}
+func (m *ThrottledCaller) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ThrottledCaller) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ThrottledCaller.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ThrottledCaller) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThrottledCaller.Merge(m, src)
+}
+func (m *ThrottledCaller) XXX_Size() int {
+	return m.Size()
+}
+func (m *ThrottledCaller) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThrottledCaller.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThrottledCaller proto.InternalMessageInfo
+
+func (m *ThrottledCaller) GetHostAddress() string {
+	if m != nil {
+		return m.HostAddress
+	}
+	return ""
+}
+
+func (m *ThrottledCaller) GetService() string {
+	if m != nil {
+		return m.Service
+	}
+	return ""
+}
+
+func (m *ThrottledCaller) GetLimiter() string {
+	if m != nil {
+		return m.Limiter
+	}
+	return ""
+}
+
+func (m *ThrottledCaller) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ThrottledCaller) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+func (m *ThrottledCaller) GetRate() float64 {
+	if m != nil {
+		return m.Rate
+	}
+	return 0
+}
+
+func (m *ThrottledCaller) GetThrottledCount() int64 {
+	if m != nil {
+		return m.ThrottledCount
+	}
+	return 0
+}
+
+func (m *ThrottledCaller) GetFirstThrottledTime() *time.Time {
+	if m != nil {
+		return m.FirstThrottledTime
+	}
+	return nil
+}
+
+func (m *ThrottledCaller) GetLastThrottledTime() *time.Time {
+	if m != nil {
+		return m.LastThrottledTime
+	}
+	return nil
+}
+
+type ShardStats struct {
+	ShardId int32 `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	// ip:port of the history host owning the shard.
+	OwnerHost            string `protobuf:"bytes,2,opt,name=owner_host,json=ownerHost,proto3" json:"owner_host,omitempty"`
+	RangeId              int64  `protobuf:"varint,3,opt,name=range_id,json=rangeId,proto3" json:"range_id,omitempty"`
+	TransferAckLevel     int64  `protobuf:"varint,4,opt,name=transfer_ack_level,json=transferAckLevel,proto3" json:"transfer_ack_level,omitempty"`
+	TransferMaxReadLevel int64  `protobuf:"varint,5,opt,name=transfer_max_read_level,json=transferMaxReadLevel,proto3" json:"transfer_max_read_level,omitempty"`
+	// Number of task ids between the transfer ack level and max read level, an upper bound of pending transfer tasks.
+	TransferBacklog     int64      `protobuf:"varint,6,opt,name=transfer_backlog,json=transferBacklog,proto3" json:"transfer_backlog,omitempty"`
+	TimerAckLevel       *time.Time `protobuf:"bytes,7,opt,name=timer_ack_level,json=timerAckLevel,proto3,stdtime" json:"timer_ack_level,omitempty"`
+	TimerMaxReadLevel   *time.Time `protobuf:"bytes,8,opt,name=timer_max_read_level,json=timerMaxReadLevel,proto3,stdtime" json:"timer_max_read_level,omitempty"`
+	VisibilityAckLevel  int64      `protobuf:"varint,9,opt,name=visibility_ack_level,json=visibilityAckLevel,proto3" json:"visibility_ack_level,omitempty"`
+	ReplicationAckLevel int64      `protobuf:"varint,10,opt,name=replication_ack_level,json=replicationAckLevel,proto3" json:"replication_ack_level,omitempty"`
+	// Last time the shard info was persisted.
+	LastUpdatedTime *time.Time `protobuf:"bytes,11,opt,name=last_updated_time,json=lastUpdatedTime,proto3,stdtime" json:"last_updated_time,omitempty"`
+	// Number of shard lock acquisitions which found the lock held since the shard was acquired by the host.
+	LockContentionCount int64 `protobuf:"varint,12,opt,name=lock_contention_count,json=lockContentionCount,proto3" json:"lock_contention_count,omitempty"`
+	// Time from the close of the last workflow recorded to visibility by the shard until it became searchable.
+	VisibilityLag *time.Duration `protobuf:"bytes,13,opt,name=visibility_lag,json=visibilityLag,proto3,stdduration" json:"visibility_lag,omitempty"`
+}
+
+func (m *ShardStats) Reset()      { *m = ShardStats{} }
+func (*ShardStats) ProtoMessage() {}
+func (*ShardStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fcc65697c8eece3a, []int{4}
+}
+func (m *ShardStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShardStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShardStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ShardStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardStats.Merge(m, src)
+}
+func (m *ShardStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShardStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardStats proto.InternalMessageInfo
+
+func (m *ShardStats) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *ShardStats) GetOwnerHost() string {
+	if m != nil {
+		return m.OwnerHost
+	}
+	return ""
+}
+
+func (m *ShardStats) GetRangeId() int64 {
+	if m != nil {
+		return m.RangeId
+	}
+	return 0
+}
+
+func (m *ShardStats) GetTransferAckLevel() int64 {
+	if m != nil {
+		return m.TransferAckLevel
+	}
+	return 0
+}
+
+func (m *ShardStats) GetTransferMaxReadLevel() int64 {
+	if m != nil {
+		return m.TransferMaxReadLevel
+	}
+	return 0
+}
+
+func (m *ShardStats) GetTransferBacklog() int64 {
+	if m != nil {
+		return m.TransferBacklog
+	}
+	return 0
+}
+
+func (m *ShardStats) GetTimerAckLevel() *time.Time {
+	if m != nil {
+		return m.TimerAckLevel
+	}
+	return nil
+}
+
+func (m *ShardStats) GetTimerMaxReadLevel() *time.Time {
+	if m != nil {
+		return m.TimerMaxReadLevel
+	}
+	return nil
+}
+
+func (m *ShardStats) GetVisibilityAckLevel() int64 {
+	if m != nil {
+		return m.VisibilityAckLevel
+	}
+	return 0
+}
+
+func (m *ShardStats) GetReplicationAckLevel() int64 {
+	if m != nil {
+		return m.ReplicationAckLevel
+	}
+	return 0
+}
+
+func (m *ShardStats) GetLastUpdatedTime() *time.Time {
+	if m != nil {
+		return m.LastUpdatedTime
+	}
+	return nil
+}
+
+func (m *ShardStats) GetLockContentionCount() int64 {
+	if m != nil {
+		return m.LockContentionCount
+	}
+	return 0
+}
+
+func (m *ShardStats) GetVisibilityLag() *time.Duration {
+	if m != nil {
+		return m.VisibilityLag
+	}
+	return nil
+}
+
+type VisibilityProcessorStatus struct {
+	// ip:port of the history host running the processor.
+	HostAddress    string                `protobuf:"bytes,1,opt,name=host_address,json=hostAddress,proto3" json:"host_address,omitempty"`
+	BulkProcessors []*BulkProcessorStats `protobuf:"bytes,2,rep,name=bulk_processors,json=bulkProcessors,proto3" json:"bulk_processors,omitempty"`
+	// Number of bulks sent to Elasticsearch and waiting for the response.
+	InFlightBulks int64 `protobuf:"varint,3,opt,name=in_flight_bulks,json=inFlightBulks,proto3" json:"in_flight_bulks,omitempty"`
+	// Number of requests waiting to be acked to visibility tasks.
+	PendingAcks int64 `protobuf:"varint,4,opt,name=pending_acks,json=pendingAcks,proto3" json:"pending_acks,omitempty"`
+	// Last error of a bulk commit which failed after retries, empty if none.
+	LastCommitError     string     `protobuf:"bytes,5,opt,name=last_commit_error,json=lastCommitError,proto3" json:"last_commit_error,omitempty"`
+	LastCommitErrorTime *time.Time `protobuf:"bytes,6,opt,name=last_commit_error_time,json=lastCommitErrorTime,proto3,stdtime" json:"last_commit_error_time,omitempty"`
+}
+
+func (m *VisibilityProcessorStatus) Reset()      { *m = VisibilityProcessorStatus{} }
+func (*VisibilityProcessorStatus) ProtoMessage() {}
+func (*VisibilityProcessorStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fcc65697c8eece3a, []int{5}
+}
+func (m *VisibilityProcessorStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VisibilityProcessorStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VisibilityProcessorStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VisibilityProcessorStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VisibilityProcessorStatus.Merge(m, src)
+}
+func (m *VisibilityProcessorStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *VisibilityProcessorStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_VisibilityProcessorStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VisibilityProcessorStatus proto.InternalMessageInfo
+
+func (m *VisibilityProcessorStatus) GetHostAddress() string {
+	if m != nil {
+		return m.HostAddress
+	}
+	return ""
+}
+
+func (m *VisibilityProcessorStatus) GetBulkProcessors() []*BulkProcessorStats {
+	if m != nil {
+		return m.BulkProcessors
+	}
+	return nil
+}
+
+func (m *VisibilityProcessorStatus) GetInFlightBulks() int64 {
+	if m != nil {
+		return m.InFlightBulks
+	}
+	return 0
+}
+
+func (m *VisibilityProcessorStatus) GetPendingAcks() int64 {
+	if m != nil {
+		return m.PendingAcks
+	}
+	return 0
+}
+
+func (m *VisibilityProcessorStatus) GetLastCommitError() string {
+	if m != nil {
+		return m.LastCommitError
+	}
+	return ""
+}
+
+func (m *VisibilityProcessorStatus) GetLastCommitErrorTime() *time.Time {
+	if m != nil {
+		return m.LastCommitErrorTime
+	}
+	return nil
+}
+
+type BulkProcessorStats struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Number of requests waiting in the bulk processor workers to be committed.
+	Queued    int64 `protobuf:"varint,2,opt,name=queued,proto3" json:"queued,omitempty"`
+	Flushed   int64 `protobuf:"varint,3,opt,name=flushed,proto3" json:"flushed,omitempty"`
+	Committed int64 `protobuf:"varint,4,opt,name=committed,proto3" json:"committed,omitempty"`
+	Succeeded int64 `protobuf:"varint,5,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed    int64 `protobuf:"varint,6,opt,name=failed,proto3" json:"failed,omitempty"`
+}
+
+func (m *BulkProcessorStats) Reset()      { *m = BulkProcessorStats{} }
+func (*BulkProcessorStats) ProtoMessage() {}
+func (*BulkProcessorStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fcc65697c8eece3a, []int{6}
+}
+func (m *BulkProcessorStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BulkProcessorStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BulkProcessorStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BulkProcessorStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BulkProcessorStats.Merge(m, src)
+}
+func (m *BulkProcessorStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *BulkProcessorStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_BulkProcessorStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BulkProcessorStats proto.InternalMessageInfo
+
+func (m *BulkProcessorStats) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *BulkProcessorStats) GetQueued() int64 {
+	if m != nil {
+		return m.Queued
+	}
+	return 0
+}
+
+func (m *BulkProcessorStats) GetFlushed() int64 {
+	if m != nil {
+		return m.Flushed
+	}
+	return 0
+}
+
+func (m *BulkProcessorStats) GetCommitted() int64 {
+	if m != nil {
+		return m.Committed
+	}
+	return 0
+}
+
+func (m *BulkProcessorStats) GetSucceeded() int64 {
+	if m != nil {
+		return m.Succeeded
+	}
+	return 0
+}
+
+func (m *BulkProcessorStats) GetFailed() int64 {
+	if m != nil {
+		return m.Failed
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*HostInfo)(nil), "temporal.server.api.cluster.v1.HostInfo")
 	proto.RegisterType((*RingInfo)(nil), "temporal.server.api.cluster.v1.RingInfo")
 	proto.RegisterType((*MembershipInfo)(nil), "temporal.server.api.cluster.v1.MembershipInfo")
+	proto.RegisterType((*ThrottledCaller)(nil), "temporal.server.api.cluster.v1.ThrottledCaller")
+	proto.RegisterType((*ShardStats)(nil), "temporal.server.api.cluster.v1.ShardStats")
+	proto.RegisterType((*VisibilityProcessorStatus)(nil), "temporal.server.api.cluster.v1.VisibilityProcessorStatus")
+	proto.RegisterType((*BulkProcessorStats)(nil), "temporal.server.api.cluster.v1.BulkProcessorStats")
 }
 
 func init() {
@@ -221,29 +656,72 @@ func init() {
 }
 
 var fileDescriptor_fcc65697c8eece3a = []byte{
-	// 345 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xbf, 0x4e, 0xf3, 0x30,
-	0x14, 0xc5, 0xe3, 0xf6, 0xeb, 0x47, 0xeb, 0x56, 0x08, 0x3c, 0x55, 0x0c, 0x57, 0xa1, 0x03, 0x8a,
-	0x44, 0xe5, 0xa8, 0x30, 0x22, 0x31, 0x94, 0x05, 0x84, 0x58, 0x32, 0xb2, 0x54, 0x49, 0x30, 0xa9,
-	0xa5, 0x24, 0x8e, 0x6c, 0xb7, 0x12, 0x1b, 0x0b, 0x3b, 0x8f, 0xc1, 0xa3, 0x20, 0xa6, 0x8e, 0x1d,
-	0xa9, 0xb3, 0x30, 0xf6, 0x11, 0x50, 0xf3, 0xa7, 0x2c, 0x08, 0xc1, 0x76, 0xee, 0xc9, 0x39, 0xf1,
-	0xcf, 0xd6, 0xc5, 0x43, 0xcd, 0x92, 0x4c, 0x48, 0x3f, 0x76, 0x15, 0x93, 0x73, 0x26, 0x5d, 0x3f,
-	0xe3, 0x6e, 0x18, 0xcf, 0x94, 0x66, 0xd2, 0x9d, 0x8f, 0xdc, 0x84, 0x29, 0xe5, 0x47, 0x8c, 0x66,
-	0x52, 0x68, 0x41, 0xa0, 0x4e, 0xd3, 0x32, 0x4d, 0xfd, 0x8c, 0xd3, 0x2a, 0x4d, 0xe7, 0xa3, 0xc1,
-	0x11, 0x6e, 0x5f, 0x0a, 0xa5, 0xaf, 0xd2, 0x7b, 0x41, 0x0e, 0x70, 0x9b, 0xdf, 0xb1, 0x54, 0x73,
-	0xfd, 0xd0, 0x47, 0x36, 0x72, 0x3a, 0xde, 0x76, 0x1e, 0x3c, 0x21, 0xdc, 0xf6, 0x78, 0x1a, 0x15,
-	0x41, 0x82, 0xff, 0x49, 0x11, 0xb3, 0x2a, 0x54, 0x68, 0x72, 0x88, 0x7b, 0x09, 0x4b, 0x02, 0x26,
-	0x27, 0xa1, 0x98, 0xa5, 0xba, 0xdf, 0xb0, 0x91, 0xd3, 0xf2, 0xba, 0xa5, 0x77, 0xb1, 0xb1, 0xc8,
-	0x18, 0xef, 0x94, 0xa3, 0xea, 0x37, 0xed, 0xa6, 0xd3, 0x3d, 0x71, 0xe8, 0xcf, 0x74, 0xb4, 0x46,
-	0xf3, 0xea, 0xe2, 0xe0, 0x0d, 0xe1, 0xdd, 0x9b, 0x52, 0x4f, 0x79, 0x56, 0xd0, 0x5c, 0xe3, 0x5e,
-	0x38, 0x93, 0x92, 0xa5, 0x7a, 0x32, 0x15, 0x4a, 0x17, 0x54, 0x7f, 0xf9, 0x77, 0xb7, 0x6a, 0x6f,
-	0x0c, 0x72, 0x8c, 0xf7, 0x25, 0xf3, 0xc3, 0xa9, 0x1f, 0xc4, 0x6c, 0x52, 0xd3, 0x36, 0xec, 0xa6,
-	0xd3, 0xf1, 0xf6, 0xb6, 0x1f, 0x2a, 0x00, 0x72, 0x8e, 0x5b, 0x92, 0xa7, 0xd1, 0xaf, 0xaf, 0x53,
-	0x3f, 0xa0, 0x57, 0xd6, 0xc6, 0xc1, 0x62, 0x05, 0xd6, 0x72, 0x05, 0xd6, 0x7a, 0x05, 0xe8, 0xd1,
-	0x00, 0x7a, 0x31, 0x80, 0x5e, 0x0d, 0xa0, 0x85, 0x01, 0xf4, 0x6e, 0x00, 0x7d, 0x18, 0xb0, 0xd6,
-	0x06, 0xd0, 0x73, 0x0e, 0xd6, 0x22, 0x07, 0x6b, 0x99, 0x83, 0x75, 0x3b, 0x8c, 0xc4, 0xd7, 0x41,
-	0x5c, 0x7c, 0xbf, 0x06, 0x67, 0x95, 0x0c, 0xfe, 0x17, 0x7b, 0x70, 0xfa, 0x19, 0x00, 0x00, 0xff,
-	0xff, 0x4e, 0xa0, 0x30, 0xe6, 0x37, 0x02, 0x00, 0x00,
+	// 1039 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcf, 0x72, 0x1b, 0xc5,
+	0x13, 0xd6, 0x46, 0x96, 0x25, 0x8d, 0x62, 0x2b, 0x1e, 0x3b, 0xf9, 0xc9, 0xae, 0x1f, 0x1b, 0x45,
+	0x87, 0x20, 0xc0, 0x25, 0x11, 0x53, 0x9c, 0xa8, 0xa2, 0xca, 0x32, 0xa4, 0xe2, 0xc2, 0xa9, 0x0a,
+	0x9b, 0x84, 0x03, 0x1c, 0xb6, 0x46, 0xbb, 0xad, 0xd5, 0x94, 0x46, 0x3b, 0x62, 0x66, 0xd6, 0x24,
+	0x37, 0x2e, 0xb9, 0xe7, 0xc8, 0x23, 0x70, 0xe2, 0x11, 0x38, 0x53, 0x9c, 0x7c, 0xcc, 0x0d, 0x2c,
+	0x5f, 0xe0, 0x96, 0x47, 0xa0, 0xe6, 0xcf, 0xae, 0x64, 0x9b, 0x02, 0x71, 0x9b, 0xfe, 0xba, 0xbf,
+	0xde, 0x9e, 0xfe, 0x7a, 0x5a, 0x42, 0xfb, 0x0a, 0xa6, 0x33, 0x2e, 0x08, 0xeb, 0x4b, 0x10, 0xa7,
+	0x20, 0xfa, 0x64, 0x46, 0xfb, 0x11, 0xcb, 0xa4, 0x02, 0xd1, 0x3f, 0x7d, 0xd0, 0x9f, 0x82, 0x94,
+	0x24, 0x81, 0xde, 0x4c, 0x70, 0xc5, 0xb1, 0x9f, 0x47, 0xf7, 0x6c, 0x74, 0x8f, 0xcc, 0x68, 0xcf,
+	0x45, 0xf7, 0x4e, 0x1f, 0xec, 0xf9, 0x09, 0xe7, 0x09, 0x83, 0xbe, 0x89, 0x1e, 0x66, 0xa3, 0x7e,
+	0x9c, 0x09, 0xa2, 0x28, 0x4f, 0x2d, 0x7f, 0xef, 0xee, 0x55, 0xbf, 0xa2, 0x53, 0x90, 0x8a, 0x4c,
+	0x67, 0x2e, 0xe0, 0x5e, 0x0c, 0x33, 0x48, 0x63, 0x48, 0x23, 0x0a, 0xb2, 0x9f, 0xf0, 0x84, 0x1b,
+	0xdc, 0x9c, 0x6c, 0x48, 0xe7, 0x3e, 0xaa, 0x3d, 0xe2, 0x52, 0x1d, 0xa7, 0x23, 0x8e, 0xf7, 0x50,
+	0x8d, 0xc6, 0x90, 0x2a, 0xaa, 0x5e, 0xb6, 0xbc, 0xb6, 0xd7, 0xad, 0x07, 0x85, 0xdd, 0x79, 0xe5,
+	0xa1, 0x5a, 0x40, 0xd3, 0xc4, 0x04, 0x62, 0xb4, 0x26, 0x38, 0x03, 0x17, 0x64, 0xce, 0xf8, 0x1e,
+	0xba, 0x39, 0x85, 0xe9, 0x10, 0x44, 0x18, 0xf1, 0x2c, 0x55, 0xad, 0x1b, 0x6d, 0xaf, 0x5b, 0x09,
+	0x1a, 0x16, 0x3b, 0xd2, 0x10, 0x1e, 0xa0, 0xaa, 0x35, 0x65, 0xab, 0xdc, 0x2e, 0x77, 0x1b, 0x07,
+	0xdd, 0xde, 0x3f, 0x77, 0xa0, 0x97, 0x97, 0x16, 0xe4, 0xc4, 0xce, 0xaf, 0x1e, 0xda, 0x7c, 0x6c,
+	0xcf, 0x63, 0x3a, 0x33, 0xd5, 0x7c, 0x81, 0x6e, 0x46, 0x99, 0x10, 0x90, 0xaa, 0x70, 0xcc, 0xa5,
+	0x32, 0x55, 0xfd, 0x97, 0xdc, 0x0d, 0xc7, 0xd6, 0x00, 0xfe, 0x00, 0x6d, 0x09, 0x20, 0xd1, 0x98,
+	0x0c, 0x19, 0x84, 0x79, 0xb5, 0x37, 0xda, 0xe5, 0x6e, 0x3d, 0xb8, 0x55, 0x38, 0x5c, 0x01, 0xf8,
+	0x53, 0x54, 0x11, 0x34, 0x4d, 0x56, 0xbe, 0x4e, 0xde, 0xc0, 0xc0, 0xd2, 0x3a, 0xaf, 0xca, 0xa8,
+	0xf9, 0x6c, 0x2c, 0xb8, 0x52, 0x0c, 0xe2, 0x23, 0xc2, 0x18, 0x08, 0xdd, 0x47, 0x7d, 0x8b, 0x90,
+	0xc4, 0xb1, 0x00, 0x29, 0x5d, 0x8f, 0x1b, 0x1a, 0x3b, 0xb4, 0x10, 0x6e, 0xa1, 0xaa, 0xce, 0x4f,
+	0x23, 0x30, 0x5d, 0xae, 0x07, 0xb9, 0xa9, 0x3d, 0x8c, 0x4e, 0xa9, 0x02, 0xd1, 0x2a, 0x5b, 0x8f,
+	0x33, 0xf1, 0xff, 0x51, 0x3d, 0x25, 0x53, 0x90, 0x33, 0x12, 0x41, 0x6b, 0xcd, 0xf8, 0x16, 0xc0,
+	0x25, 0xe5, 0x2b, 0x97, 0x95, 0x37, 0x62, 0x13, 0x05, 0xad, 0xf5, 0xb6, 0xd7, 0xf5, 0x02, 0x73,
+	0xc6, 0xef, 0xa2, 0xa6, 0xca, 0xeb, 0x76, 0x7a, 0x57, 0xdb, 0x5e, 0xb7, 0x1c, 0x6c, 0x16, 0xb0,
+	0x95, 0x3c, 0x40, 0x3b, 0x23, 0x2a, 0xa4, 0x0a, 0x17, 0xe1, 0x7a, 0x48, 0x5b, 0x35, 0xa3, 0xd1,
+	0x5e, 0xcf, 0x4e, 0x70, 0x2f, 0x9f, 0xe0, 0xde, 0xb3, 0x7c, 0x82, 0x07, 0x6b, 0xaf, 0x7f, 0xbb,
+	0xeb, 0x05, 0xd8, 0xb0, 0x8b, 0x1e, 0x69, 0x37, 0x7e, 0x82, 0xb6, 0x19, 0xb9, 0x9e, 0xb2, 0xbe,
+	0x62, 0xca, 0x2d, 0x46, 0xae, 0x64, 0xec, 0xfc, 0x5c, 0x41, 0xe8, 0xe9, 0x98, 0x88, 0xf8, 0xa9,
+	0x22, 0x4a, 0xe2, 0x5d, 0x54, 0x93, 0xda, 0x0a, 0x69, 0x6c, 0xda, 0x5f, 0x09, 0xaa, 0xc6, 0x3e,
+	0x8e, 0xf1, 0x3b, 0x08, 0xf1, 0xef, 0x52, 0x10, 0x76, 0xd2, 0x6c, 0xf7, 0xeb, 0x06, 0x31, 0xd3,
+	0xb3, 0x8b, 0x6a, 0x82, 0xa4, 0x09, 0x68, 0x66, 0xd9, 0x34, 0xa4, 0x6a, 0xec, 0xe3, 0x18, 0xef,
+	0x23, 0xac, 0x04, 0x49, 0xe5, 0x08, 0x44, 0x48, 0xa2, 0x49, 0xc8, 0xe0, 0x14, 0x98, 0x51, 0xa2,
+	0x1c, 0xdc, 0xca, 0x3d, 0x87, 0xd1, 0xe4, 0x44, 0xe3, 0xf8, 0x63, 0xf4, 0xbf, 0x22, 0x7a, 0x4a,
+	0x5e, 0x84, 0x02, 0x48, 0xec, 0x28, 0x15, 0x43, 0xd9, 0xc9, 0xdd, 0x8f, 0xc9, 0x8b, 0x00, 0x48,
+	0x6c, 0x69, 0xef, 0xa1, 0x22, 0x55, 0x38, 0x24, 0xd1, 0x84, 0xf1, 0xc4, 0xe8, 0x56, 0x0e, 0x9a,
+	0x39, 0x3e, 0xb0, 0x30, 0x7e, 0x84, 0x9a, 0xba, 0x6d, 0xcb, 0xc5, 0x54, 0x57, 0xec, 0xe0, 0x86,
+	0x21, 0x16, 0xb5, 0x7e, 0x89, 0x76, 0x6c, 0xa6, 0x2b, 0x85, 0xae, 0xaa, 0xf1, 0x96, 0x61, 0x5f,
+	0xba, 0xc7, 0x87, 0x68, 0xe7, 0x94, 0x4a, 0x3a, 0xa4, 0x8c, 0xaa, 0x97, 0x4b, 0x15, 0xd6, 0xcd,
+	0x5d, 0xf0, 0xc2, 0x57, 0x14, 0x71, 0x80, 0x6e, 0x0b, 0x98, 0x31, 0x1a, 0x99, 0x05, 0xb9, 0x44,
+	0x41, 0x86, 0xb2, 0xbd, 0xe4, 0x2c, 0x38, 0x27, 0xc8, 0xcc, 0x42, 0x98, 0xcd, 0x62, 0xa2, 0xf2,
+	0x31, 0x6a, 0xac, 0x58, 0x75, 0x53, 0x53, 0x9f, 0x5b, 0xa6, 0x19, 0xcb, 0x03, 0x74, 0x9b, 0xf1,
+	0x68, 0x12, 0x46, 0x3c, 0x55, 0xfa, 0xe9, 0xf0, 0xd4, 0xbd, 0x8c, 0x9b, 0xb6, 0x02, 0xed, 0x3c,
+	0x2a, 0x7c, 0xf6, 0x79, 0x3c, 0x44, 0x9b, 0x4b, 0xf7, 0x64, 0x24, 0x69, 0x6d, 0x98, 0xcf, 0xef,
+	0x5e, 0xfb, 0xfc, 0x67, 0x6e, 0xf5, 0x0f, 0xd6, 0x7e, 0x30, 0x12, 0x2c, 0x68, 0x27, 0x24, 0xe9,
+	0xfc, 0x79, 0x03, 0xed, 0x7e, 0x55, 0x20, 0x4f, 0x04, 0x8f, 0x40, 0x4a, 0x2e, 0xf4, 0x38, 0x67,
+	0x72, 0x95, 0x95, 0xf2, 0x0d, 0x6a, 0x0e, 0x33, 0x36, 0x09, 0x67, 0x39, 0xd5, 0x2e, 0xbd, 0xc6,
+	0xc1, 0xc1, 0xbf, 0xed, 0xb4, 0x41, 0xc6, 0x26, 0x97, 0x3e, 0x28, 0x83, 0xcd, 0xe1, 0x32, 0x26,
+	0xf1, 0x7d, 0xd4, 0xa4, 0x69, 0x38, 0x62, 0x34, 0x19, 0xab, 0x50, 0xfb, 0xa4, 0x7b, 0x1c, 0x1b,
+	0x34, 0x7d, 0x68, 0x50, 0x9d, 0xc4, 0xd4, 0xa9, 0x7f, 0xae, 0x68, 0x9a, 0x68, 0xfd, 0xa4, 0x7b,
+	0x1c, 0x0d, 0x87, 0x1d, 0x46, 0x13, 0x89, 0xdf, 0x77, 0x92, 0x45, 0x7c, 0x3a, 0xa5, 0x2a, 0x04,
+	0x21, 0xb8, 0x70, 0x1b, 0xcb, 0x08, 0x72, 0x64, 0xf0, 0xcf, 0x35, 0x8c, 0x9f, 0xa3, 0x3b, 0xd7,
+	0x62, 0xad, 0xc6, 0xeb, 0x2b, 0x6a, 0xbc, 0x7d, 0x25, 0xa5, 0x59, 0x16, 0x3f, 0x79, 0x08, 0x5f,
+	0xbf, 0xb4, 0x5e, 0x93, 0x7a, 0x9f, 0xe6, 0xbf, 0x89, 0xfa, 0x8c, 0xef, 0xa0, 0xf5, 0x6f, 0x33,
+	0xc8, 0x20, 0x36, 0x9b, 0xa2, 0x1c, 0x38, 0x4b, 0xaf, 0xe9, 0x11, 0xcb, 0xe4, 0x18, 0x8a, 0x2d,
+	0xe1, 0x4c, 0xbd, 0xa6, 0x6d, 0xb9, 0x0a, 0x62, 0x77, 0xff, 0x05, 0xa0, 0xbd, 0x32, 0x8b, 0x22,
+	0x80, 0x18, 0x62, 0xb7, 0x07, 0x16, 0x80, 0xfe, 0xda, 0x88, 0x50, 0x06, 0xb1, 0x7b, 0xf2, 0xce,
+	0x1a, 0x0c, 0xcf, 0xce, 0xfd, 0xd2, 0x9b, 0x73, 0xbf, 0xf4, 0xf6, 0xdc, 0xf7, 0xbe, 0x9f, 0xfb,
+	0xde, 0x8f, 0x73, 0xdf, 0xfb, 0x65, 0xee, 0x7b, 0x67, 0x73, 0xdf, 0xfb, 0x7d, 0xee, 0x7b, 0x7f,
+	0xcc, 0xfd, 0xd2, 0xdb, 0xb9, 0xef, 0xbd, 0xbe, 0xf0, 0x4b, 0x67, 0x17, 0x7e, 0xe9, 0xcd, 0x85,
+	0x5f, 0xfa, 0x7a, 0x3f, 0xe1, 0x0b, 0xe9, 0x29, 0xff, 0xfb, 0x3f, 0x34, 0x9f, 0xb8, 0xe3, 0x70,
+	0xdd, 0xf4, 0xf0, 0xa3, 0xbf, 0x06, 0x00, 0xbc, 0x08, 0x1e, 0x82, 0x01, 0x09, 0x00, 0x00,
 }
 
 func (this *HostInfo) Equal(that interface{}) bool {
@@ -345,7 +823,228 @@ func (this *MembershipInfo) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *HostInfo) GoString() string {
+func (this *ThrottledCaller) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ThrottledCaller)
+	if !ok {
+		that2, ok := that.(ThrottledCaller)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.HostAddress != that1.HostAddress {
+		return false
+	}
+	if this.Service != that1.Service {
+		return false
+	}
+	if this.Limiter != that1.Limiter {
+		return false
+	}
+	if this.Namespace != that1.Namespace {
+		return false
+	}
+	if this.Identity != that1.Identity {
+		return false
+	}
+	if this.Rate != that1.Rate {
+		return false
+	}
+	if this.ThrottledCount != that1.ThrottledCount {
+		return false
+	}
+	if that1.FirstThrottledTime == nil {
+		if this.FirstThrottledTime != nil {
+			return false
+		}
+	} else if !this.FirstThrottledTime.Equal(*that1.FirstThrottledTime) {
+		return false
+	}
+	if that1.LastThrottledTime == nil {
+		if this.LastThrottledTime != nil {
+			return false
+		}
+	} else if !this.LastThrottledTime.Equal(*that1.LastThrottledTime) {
+		return false
+	}
+	return true
+}
+func (this *ShardStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ShardStats)
+	if !ok {
+		that2, ok := that.(ShardStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ShardId != that1.ShardId {
+		return false
+	}
+	if this.OwnerHost != that1.OwnerHost {
+		return false
+	}
+	if this.RangeId != that1.RangeId {
+		return false
+	}
+	if this.TransferAckLevel != that1.TransferAckLevel {
+		return false
+	}
+	if this.TransferMaxReadLevel != that1.TransferMaxReadLevel {
+		return false
+	}
+	if this.TransferBacklog != that1.TransferBacklog {
+		return false
+	}
+	if that1.TimerAckLevel == nil {
+		if this.TimerAckLevel != nil {
+			return false
+		}
+	} else if !this.TimerAckLevel.Equal(*that1.TimerAckLevel) {
+		return false
+	}
+	if that1.TimerMaxReadLevel == nil {
+		if this.TimerMaxReadLevel != nil {
+			return false
+		}
+	} else if !this.TimerMaxReadLevel.Equal(*that1.TimerMaxReadLevel) {
+		return false
+	}
+	if this.VisibilityAckLevel != that1.VisibilityAckLevel {
+		return false
+	}
+	if this.ReplicationAckLevel != that1.ReplicationAckLevel {
+		return false
+	}
+	if that1.LastUpdatedTime == nil {
+		if this.LastUpdatedTime != nil {
+			return false
+		}
+	} else if !this.LastUpdatedTime.Equal(*that1.LastUpdatedTime) {
+		return false
+	}
+	if this.LockContentionCount != that1.LockContentionCount {
+		return false
+	}
+	if this.VisibilityLag != nil && that1.VisibilityLag != nil {
+		if *this.VisibilityLag != *that1.VisibilityLag {
+			return false
+		}
+	} else if this.VisibilityLag != nil {
+		return false
+	} else if that1.VisibilityLag != nil {
+		return false
+	}
+	return true
+}
+func (this *VisibilityProcessorStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VisibilityProcessorStatus)
+	if !ok {
+		that2, ok := that.(VisibilityProcessorStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.HostAddress != that1.HostAddress {
+		return false
+	}
+	if len(this.BulkProcessors) != len(that1.BulkProcessors) {
+		return false
+	}
+	for i := range this.BulkProcessors {
+		if !this.BulkProcessors[i].Equal(that1.BulkProcessors[i]) {
+			return false
+		}
+	}
+	if this.InFlightBulks != that1.InFlightBulks {
+		return false
+	}
+	if this.PendingAcks != that1.PendingAcks {
+		return false
+	}
+	if this.LastCommitError != that1.LastCommitError {
+		return false
+	}
+	if that1.LastCommitErrorTime == nil {
+		if this.LastCommitErrorTime != nil {
+			return false
+		}
+	} else if !this.LastCommitErrorTime.Equal(*that1.LastCommitErrorTime) {
+		return false
+	}
+	return true
+}
+func (this *BulkProcessorStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BulkProcessorStats)
+	if !ok {
+		that2, ok := that.(BulkProcessorStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Queued != that1.Queued {
+		return false
+	}
+	if this.Flushed != that1.Flushed {
+		return false
+	}
+	if this.Committed != that1.Committed {
+		return false
+	}
+	if this.Succeeded != that1.Succeeded {
+		return false
+	}
+	if this.Failed != that1.Failed {
+		return false
+	}
+	return true
+}
+func (this *HostInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
@@ -385,6 +1084,78 @@ func (this *MembershipInfo) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *ThrottledCaller) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 13)
+	s = append(s, "&cluster.ThrottledCaller{")
+	s = append(s, "HostAddress: "+fmt.Sprintf("%#v", this.HostAddress)+",\n")
+	s = append(s, "Service: "+fmt.Sprintf("%#v", this.Service)+",\n")
+	s = append(s, "Limiter: "+fmt.Sprintf("%#v", this.Limiter)+",\n")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "Rate: "+fmt.Sprintf("%#v", this.Rate)+",\n")
+	s = append(s, "ThrottledCount: "+fmt.Sprintf("%#v", this.ThrottledCount)+",\n")
+	s = append(s, "FirstThrottledTime: "+fmt.Sprintf("%#v", this.FirstThrottledTime)+",\n")
+	s = append(s, "LastThrottledTime: "+fmt.Sprintf("%#v", this.LastThrottledTime)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ShardStats) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 17)
+	s = append(s, "&cluster.ShardStats{")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "OwnerHost: "+fmt.Sprintf("%#v", this.OwnerHost)+",\n")
+	s = append(s, "RangeId: "+fmt.Sprintf("%#v", this.RangeId)+",\n")
+	s = append(s, "TransferAckLevel: "+fmt.Sprintf("%#v", this.TransferAckLevel)+",\n")
+	s = append(s, "TransferMaxReadLevel: "+fmt.Sprintf("%#v", this.TransferMaxReadLevel)+",\n")
+	s = append(s, "TransferBacklog: "+fmt.Sprintf("%#v", this.TransferBacklog)+",\n")
+	s = append(s, "TimerAckLevel: "+fmt.Sprintf("%#v", this.TimerAckLevel)+",\n")
+	s = append(s, "TimerMaxReadLevel: "+fmt.Sprintf("%#v", this.TimerMaxReadLevel)+",\n")
+	s = append(s, "VisibilityAckLevel: "+fmt.Sprintf("%#v", this.VisibilityAckLevel)+",\n")
+	s = append(s, "ReplicationAckLevel: "+fmt.Sprintf("%#v", this.ReplicationAckLevel)+",\n")
+	s = append(s, "LastUpdatedTime: "+fmt.Sprintf("%#v", this.LastUpdatedTime)+",\n")
+	s = append(s, "LockContentionCount: "+fmt.Sprintf("%#v", this.LockContentionCount)+",\n")
+	s = append(s, "VisibilityLag: "+fmt.Sprintf("%#v", this.VisibilityLag)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *VisibilityProcessorStatus) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&cluster.VisibilityProcessorStatus{")
+	s = append(s, "HostAddress: "+fmt.Sprintf("%#v", this.HostAddress)+",\n")
+	if this.BulkProcessors != nil {
+		s = append(s, "BulkProcessors: "+fmt.Sprintf("%#v", this.BulkProcessors)+",\n")
+	}
+	s = append(s, "InFlightBulks: "+fmt.Sprintf("%#v", this.InFlightBulks)+",\n")
+	s = append(s, "PendingAcks: "+fmt.Sprintf("%#v", this.PendingAcks)+",\n")
+	s = append(s, "LastCommitError: "+fmt.Sprintf("%#v", this.LastCommitError)+",\n")
+	s = append(s, "LastCommitErrorTime: "+fmt.Sprintf("%#v", this.LastCommitErrorTime)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BulkProcessorStats) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&cluster.BulkProcessorStats{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Queued: "+fmt.Sprintf("%#v", this.Queued)+",\n")
+	s = append(s, "Flushed: "+fmt.Sprintf("%#v", this.Flushed)+",\n")
+	s = append(s, "Committed: "+fmt.Sprintf("%#v", this.Committed)+",\n")
+	s = append(s, "Succeeded: "+fmt.Sprintf("%#v", this.Succeeded)+",\n")
+	s = append(s, "Failed: "+fmt.Sprintf("%#v", this.Failed)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringMessage(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -530,169 +1301,1711 @@ func (m *MembershipInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMessage(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *HostInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Identity)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
+func (m *ThrottledCaller) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RingInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Role)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	if m.MemberCount != 0 {
-		n += 1 + sovMessage(uint64(m.MemberCount))
-	}
-	if len(m.Members) > 0 {
-		for _, e := range m.Members {
-			l = e.Size()
-			n += 1 + l + sovMessage(uint64(l))
-		}
-	}
-	return n
+func (m *ThrottledCaller) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MembershipInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ThrottledCaller) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CurrentHost != nil {
-		l = m.CurrentHost.Size()
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	if len(m.ReachableMembers) > 0 {
-		for _, s := range m.ReachableMembers {
-			l = len(s)
-			n += 1 + l + sovMessage(uint64(l))
+	if m.LastThrottledTime != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastThrottledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastThrottledTime):])
+		if err2 != nil {
+			return 0, err2
 		}
+		i -= n2
+		i = encodeVarintMessage(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x4a
 	}
-	if len(m.Rings) > 0 {
-		for _, e := range m.Rings {
-			l = e.Size()
-			n += 1 + l + sovMessage(uint64(l))
+	if m.FirstThrottledTime != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.FirstThrottledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.FirstThrottledTime):])
+		if err3 != nil {
+			return 0, err3
 		}
+		i -= n3
+		i = encodeVarintMessage(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x42
 	}
-	return n
+	if m.ThrottledCount != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.ThrottledCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Rate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Rate))))
+		i--
+		dAtA[i] = 0x31
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Limiter) > 0 {
+		i -= len(m.Limiter)
+		copy(dAtA[i:], m.Limiter)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Limiter)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.HostAddress) > 0 {
+		i -= len(m.HostAddress)
+		copy(dAtA[i:], m.HostAddress)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.HostAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovMessage(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *ShardStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozMessage(x uint64) (n int) {
-	return sovMessage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *ShardStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *HostInfo) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ShardStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VisibilityLag != nil {
+		n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.VisibilityLag, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.VisibilityLag):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintMessage(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x6a
 	}
-	s := strings.Join([]string{`&HostInfo{`,
-		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
-		`}`,
-	}, "")
-	return s
+	if m.LockContentionCount != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.LockContentionCount))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.LastUpdatedTime != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUpdatedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpdatedTime):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintMessage(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.ReplicationAckLevel != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.ReplicationAckLevel))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.VisibilityAckLevel != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.VisibilityAckLevel))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.TimerMaxReadLevel != nil {
+		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.TimerMaxReadLevel, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.TimerMaxReadLevel):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintMessage(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.TimerAckLevel != nil {
+		n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.TimerAckLevel, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.TimerAckLevel):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintMessage(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.TransferBacklog != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.TransferBacklog))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TransferMaxReadLevel != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.TransferMaxReadLevel))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TransferAckLevel != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.TransferAckLevel))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RangeId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.RangeId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OwnerHost) > 0 {
+		i -= len(m.OwnerHost)
+		copy(dAtA[i:], m.OwnerHost)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.OwnerHost)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
-func (this *RingInfo) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *VisibilityProcessorStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	repeatedStringForMembers := "[]*HostInfo{"
-	for _, f := range this.Members {
-		repeatedStringForMembers += strings.Replace(f.String(), "HostInfo", "HostInfo", 1) + ","
+	return dAtA[:n], nil
+}
+
+func (m *VisibilityProcessorStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VisibilityProcessorStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastCommitErrorTime != nil {
+		n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastCommitErrorTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastCommitErrorTime):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintMessage(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x32
 	}
-	repeatedStringForMembers += "}"
-	s := strings.Join([]string{`&RingInfo{`,
-		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
-		`MemberCount:` + fmt.Sprintf("%v", this.MemberCount) + `,`,
-		`Members:` + repeatedStringForMembers + `,`,
-		`}`,
-	}, "")
-	return s
+	if len(m.LastCommitError) > 0 {
+		i -= len(m.LastCommitError)
+		copy(dAtA[i:], m.LastCommitError)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.LastCommitError)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.PendingAcks != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.PendingAcks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.InFlightBulks != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.InFlightBulks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BulkProcessors) > 0 {
+		for iNdEx := len(m.BulkProcessors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BulkProcessors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.HostAddress) > 0 {
+		i -= len(m.HostAddress)
+		copy(dAtA[i:], m.HostAddress)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.HostAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
-func (this *MembershipInfo) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *BulkProcessorStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	repeatedStringForRings := "[]*RingInfo{"
-	for _, f := range this.Rings {
-		repeatedStringForRings += strings.Replace(f.String(), "RingInfo", "RingInfo", 1) + ","
+	return dAtA[:n], nil
+}
+
+func (m *BulkProcessorStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BulkProcessorStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Failed != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Failed))
+		i--
+		dAtA[i] = 0x30
 	}
-	repeatedStringForRings += "}"
-	s := strings.Join([]string{`&MembershipInfo{`,
-		`CurrentHost:` + strings.Replace(this.CurrentHost.String(), "HostInfo", "HostInfo", 1) + `,`,
-		`ReachableMembers:` + fmt.Sprintf("%v", this.ReachableMembers) + `,`,
-		`Rings:` + repeatedStringForRings + `,`,
-		`}`,
-	}, "")
-	return s
+	if m.Succeeded != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Succeeded))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Committed != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Committed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Flushed != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Flushed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Queued != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Queued))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
-func valueToStringMessage(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMessage(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	dAtA[offset] = uint8(v)
+	return base
 }
-func (m *HostInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMessage
+func (m *HostInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *RingInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.MemberCount != 0 {
+		n += 1 + sovMessage(uint64(m.MemberCount))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MembershipInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrentHost != nil {
+		l = m.CurrentHost.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if len(m.ReachableMembers) > 0 {
+		for _, s := range m.ReachableMembers {
+			l = len(s)
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if len(m.Rings) > 0 {
+		for _, e := range m.Rings {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ThrottledCaller) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HostAddress)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.Limiter)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Rate != 0 {
+		n += 9
+	}
+	if m.ThrottledCount != 0 {
+		n += 1 + sovMessage(uint64(m.ThrottledCount))
+	}
+	if m.FirstThrottledTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.FirstThrottledTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.LastThrottledTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastThrottledTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *ShardStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovMessage(uint64(m.ShardId))
+	}
+	l = len(m.OwnerHost)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.RangeId != 0 {
+		n += 1 + sovMessage(uint64(m.RangeId))
+	}
+	if m.TransferAckLevel != 0 {
+		n += 1 + sovMessage(uint64(m.TransferAckLevel))
+	}
+	if m.TransferMaxReadLevel != 0 {
+		n += 1 + sovMessage(uint64(m.TransferMaxReadLevel))
+	}
+	if m.TransferBacklog != 0 {
+		n += 1 + sovMessage(uint64(m.TransferBacklog))
+	}
+	if m.TimerAckLevel != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.TimerAckLevel)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.TimerMaxReadLevel != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.TimerMaxReadLevel)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.VisibilityAckLevel != 0 {
+		n += 1 + sovMessage(uint64(m.VisibilityAckLevel))
+	}
+	if m.ReplicationAckLevel != 0 {
+		n += 1 + sovMessage(uint64(m.ReplicationAckLevel))
+	}
+	if m.LastUpdatedTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpdatedTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.LockContentionCount != 0 {
+		n += 1 + sovMessage(uint64(m.LockContentionCount))
+	}
+	if m.VisibilityLag != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.VisibilityLag)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *VisibilityProcessorStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HostAddress)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if len(m.BulkProcessors) > 0 {
+		for _, e := range m.BulkProcessors {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if m.InFlightBulks != 0 {
+		n += 1 + sovMessage(uint64(m.InFlightBulks))
+	}
+	if m.PendingAcks != 0 {
+		n += 1 + sovMessage(uint64(m.PendingAcks))
+	}
+	l = len(m.LastCommitError)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.LastCommitErrorTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastCommitErrorTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *BulkProcessorStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Queued != 0 {
+		n += 1 + sovMessage(uint64(m.Queued))
+	}
+	if m.Flushed != 0 {
+		n += 1 + sovMessage(uint64(m.Flushed))
+	}
+	if m.Committed != 0 {
+		n += 1 + sovMessage(uint64(m.Committed))
+	}
+	if m.Succeeded != 0 {
+		n += 1 + sovMessage(uint64(m.Succeeded))
+	}
+	if m.Failed != 0 {
+		n += 1 + sovMessage(uint64(m.Failed))
+	}
+	return n
+}
+
+func sovMessage(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMessage(x uint64) (n int) {
+	return sovMessage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *HostInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HostInfo{`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RingInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForMembers := "[]*HostInfo{"
+	for _, f := range this.Members {
+		repeatedStringForMembers += strings.Replace(f.String(), "HostInfo", "HostInfo", 1) + ","
+	}
+	repeatedStringForMembers += "}"
+	s := strings.Join([]string{`&RingInfo{`,
+		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
+		`MemberCount:` + fmt.Sprintf("%v", this.MemberCount) + `,`,
+		`Members:` + repeatedStringForMembers + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MembershipInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRings := "[]*RingInfo{"
+	for _, f := range this.Rings {
+		repeatedStringForRings += strings.Replace(f.String(), "RingInfo", "RingInfo", 1) + ","
+	}
+	repeatedStringForRings += "}"
+	s := strings.Join([]string{`&MembershipInfo{`,
+		`CurrentHost:` + strings.Replace(this.CurrentHost.String(), "HostInfo", "HostInfo", 1) + `,`,
+		`ReachableMembers:` + fmt.Sprintf("%v", this.ReachableMembers) + `,`,
+		`Rings:` + repeatedStringForRings + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ThrottledCaller) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ThrottledCaller{`,
+		`HostAddress:` + fmt.Sprintf("%v", this.HostAddress) + `,`,
+		`Service:` + fmt.Sprintf("%v", this.Service) + `,`,
+		`Limiter:` + fmt.Sprintf("%v", this.Limiter) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`Rate:` + fmt.Sprintf("%v", this.Rate) + `,`,
+		`ThrottledCount:` + fmt.Sprintf("%v", this.ThrottledCount) + `,`,
+		`FirstThrottledTime:` + strings.Replace(fmt.Sprintf("%v", this.FirstThrottledTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`LastThrottledTime:` + strings.Replace(fmt.Sprintf("%v", this.LastThrottledTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShardStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShardStats{`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`OwnerHost:` + fmt.Sprintf("%v", this.OwnerHost) + `,`,
+		`RangeId:` + fmt.Sprintf("%v", this.RangeId) + `,`,
+		`TransferAckLevel:` + fmt.Sprintf("%v", this.TransferAckLevel) + `,`,
+		`TransferMaxReadLevel:` + fmt.Sprintf("%v", this.TransferMaxReadLevel) + `,`,
+		`TransferBacklog:` + fmt.Sprintf("%v", this.TransferBacklog) + `,`,
+		`TimerAckLevel:` + strings.Replace(fmt.Sprintf("%v", this.TimerAckLevel), "Timestamp", "types.Timestamp", 1) + `,`,
+		`TimerMaxReadLevel:` + strings.Replace(fmt.Sprintf("%v", this.TimerMaxReadLevel), "Timestamp", "types.Timestamp", 1) + `,`,
+		`VisibilityAckLevel:` + fmt.Sprintf("%v", this.VisibilityAckLevel) + `,`,
+		`ReplicationAckLevel:` + fmt.Sprintf("%v", this.ReplicationAckLevel) + `,`,
+		`LastUpdatedTime:` + strings.Replace(fmt.Sprintf("%v", this.LastUpdatedTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`LockContentionCount:` + fmt.Sprintf("%v", this.LockContentionCount) + `,`,
+		`VisibilityLag:` + strings.Replace(fmt.Sprintf("%v", this.VisibilityLag), "Duration", "types.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VisibilityProcessorStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForBulkProcessors := "[]*BulkProcessorStats{"
+	for _, f := range this.BulkProcessors {
+		repeatedStringForBulkProcessors += strings.Replace(f.String(), "BulkProcessorStats", "BulkProcessorStats", 1) + ","
+	}
+	repeatedStringForBulkProcessors += "}"
+	s := strings.Join([]string{`&VisibilityProcessorStatus{`,
+		`HostAddress:` + fmt.Sprintf("%v", this.HostAddress) + `,`,
+		`BulkProcessors:` + repeatedStringForBulkProcessors + `,`,
+		`InFlightBulks:` + fmt.Sprintf("%v", this.InFlightBulks) + `,`,
+		`PendingAcks:` + fmt.Sprintf("%v", this.PendingAcks) + `,`,
+		`LastCommitError:` + fmt.Sprintf("%v", this.LastCommitError) + `,`,
+		`LastCommitErrorTime:` + strings.Replace(fmt.Sprintf("%v", this.LastCommitErrorTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BulkProcessorStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BulkProcessorStats{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Queued:` + fmt.Sprintf("%v", this.Queued) + `,`,
+		`Flushed:` + fmt.Sprintf("%v", this.Flushed) + `,`,
+		`Committed:` + fmt.Sprintf("%v", this.Committed) + `,`,
+		`Succeeded:` + fmt.Sprintf("%v", this.Succeeded) + `,`,
+		`Failed:` + fmt.Sprintf("%v", this.Failed) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringMessage(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *HostInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RingInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RingInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberCount", wireType)
+			}
+			m.MemberCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemberCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, &HostInfo{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MembershipInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MembershipInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MembershipInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentHost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CurrentHost == nil {
+				m.CurrentHost = &HostInfo{}
+			}
+			if err := m.CurrentHost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReachableMembers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReachableMembers = append(m.ReachableMembers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rings = append(m.Rings, &RingInfo{})
+			if err := m.Rings[len(m.Rings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ThrottledCaller) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ThrottledCaller: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ThrottledCaller: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limiter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Limiter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Rate = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThrottledCount", wireType)
+			}
+			m.ThrottledCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ThrottledCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstThrottledTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FirstThrottledTime == nil {
+				m.FirstThrottledTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.FirstThrottledTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastThrottledTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastThrottledTime == nil {
+				m.LastThrottledTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastThrottledTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShardStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShardStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShardStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerHost", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerHost = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeId", wireType)
+			}
+			m.RangeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RangeId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferAckLevel", wireType)
+			}
+			m.TransferAckLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransferAckLevel |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferMaxReadLevel", wireType)
+			}
+			m.TransferMaxReadLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransferMaxReadLevel |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferBacklog", wireType)
+			}
+			m.TransferBacklog = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransferBacklog |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimerAckLevel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TimerAckLevel == nil {
+				m.TimerAckLevel = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.TimerAckLevel, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimerMaxReadLevel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TimerMaxReadLevel == nil {
+				m.TimerMaxReadLevel = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.TimerMaxReadLevel, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityAckLevel", wireType)
+			}
+			m.VisibilityAckLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VisibilityAckLevel |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationAckLevel", wireType)
+			}
+			m.ReplicationAckLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplicationAckLevel |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdatedTime", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.LastUpdatedTime == nil {
+				m.LastUpdatedTime = new(time.Time)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HostInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HostInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastUpdatedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockContentionCount", wireType)
+			}
+			m.LockContentionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockContentionCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityLag", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -702,23 +3015,27 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Identity = string(dAtA[iNdEx:postIndex])
+			if m.VisibilityLag == nil {
+				m.VisibilityLag = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.VisibilityLag, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -744,7 +3061,7 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RingInfo) Unmarshal(dAtA []byte) error {
+func (m *VisibilityProcessorStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -767,15 +3084,15 @@ func (m *RingInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RingInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: VisibilityProcessorStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VisibilityProcessorStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -803,13 +3120,13 @@ func (m *RingInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Role = string(dAtA[iNdEx:postIndex])
+			m.HostAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemberCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BulkProcessors", wireType)
 			}
-			m.MemberCount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -819,14 +3136,99 @@ func (m *RingInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MemberCount |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BulkProcessors = append(m.BulkProcessors, &BulkProcessorStats{})
+			if err := m.BulkProcessors[len(m.BulkProcessors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InFlightBulks", wireType)
+			}
+			m.InFlightBulks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InFlightBulks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAcks", wireType)
+			}
+			m.PendingAcks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingAcks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCommitError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastCommitError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCommitErrorTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -853,8 +3255,10 @@ func (m *RingInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Members = append(m.Members, &HostInfo{})
-			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LastCommitErrorTime == nil {
+				m.LastCommitErrorTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastCommitErrorTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -882,7 +3286,7 @@ func (m *RingInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MembershipInfo) Unmarshal(dAtA []byte) error {
+func (m *BulkProcessorStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -905,17 +3309,17 @@ func (m *MembershipInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MembershipInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: BulkProcessorStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MembershipInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BulkProcessorStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentHost", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -925,33 +3329,29 @@ func (m *MembershipInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CurrentHost == nil {
-				m.CurrentHost = &HostInfo{}
-			}
-			if err := m.CurrentHost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReachableMembers", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queued", wireType)
 			}
-			var stringLen uint64
+			m.Queued = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -961,29 +3361,35 @@ func (m *MembershipInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Queued |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMessage
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMessage
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flushed", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Flushed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Flushed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ReachableMembers = append(m.ReachableMembers, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rings", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Committed", wireType)
 			}
-			var msglen int
+			m.Committed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -993,26 +3399,49 @@ func (m *MembershipInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Committed |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMessage
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMessage
+			m.Succeeded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Succeeded |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
 			}
-			m.Rings = append(m.Rings, &RingInfo{})
-			if err := m.Rings[len(m.Rings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Failed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
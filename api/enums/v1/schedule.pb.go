@@ -0,0 +1,133 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: temporal/server/api/enums/v1/schedule.proto
+
+package enums
+
+import (
+	fmt "fmt"
+	math "math"
+	strconv "strconv"
+
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ScheduleOverlapPolicy controls what happens when a scheduled action is due while workflows
+// started by earlier actions of the schedule are still running.
+type ScheduleOverlapPolicy int32
+
+const (
+	// Same as SKIP.
+	SCHEDULE_OVERLAP_POLICY_UNSPECIFIED ScheduleOverlapPolicy = 0
+	// Don't start the workflow if one is already running.
+	SCHEDULE_OVERLAP_POLICY_SKIP ScheduleOverlapPolicy = 1
+	// Start the workflow once the running one closes. Only one start is buffered, further
+	// ones are skipped while a start is waiting.
+	SCHEDULE_OVERLAP_POLICY_BUFFER_ONE ScheduleOverlapPolicy = 2
+	// Buffer all starts and run them one after another as the running workflows close.
+	SCHEDULE_OVERLAP_POLICY_BUFFER_ALL ScheduleOverlapPolicy = 3
+	// Cancel the running workflows and start the new one once they have closed.
+	SCHEDULE_OVERLAP_POLICY_CANCEL_OTHER ScheduleOverlapPolicy = 4
+	// Terminate the running workflows and start the new one right after.
+	SCHEDULE_OVERLAP_POLICY_TERMINATE_OTHER ScheduleOverlapPolicy = 5
+	// Start the workflow regardless of the running ones.
+	SCHEDULE_OVERLAP_POLICY_ALLOW_ALL ScheduleOverlapPolicy = 6
+)
+
+var ScheduleOverlapPolicy_name = map[int32]string{
+	0: "Unspecified",
+	1: "Skip",
+	2: "BufferOne",
+	3: "BufferAll",
+	4: "CancelOther",
+	5: "TerminateOther",
+	6: "AllowAll",
+}
+
+var ScheduleOverlapPolicy_value = map[string]int32{
+	"Unspecified":    0,
+	"Skip":           1,
+	"BufferOne":      2,
+	"BufferAll":      3,
+	"CancelOther":    4,
+	"TerminateOther": 5,
+	"AllowAll":       6,
+}
+
+func (ScheduleOverlapPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0c7ac3450fe0d23b, []int{0}
+}
+
+func init() {
+	proto.RegisterEnum("temporal.server.api.enums.v1.ScheduleOverlapPolicy", ScheduleOverlapPolicy_name, ScheduleOverlapPolicy_value)
+}
+
+func init() {
+	proto.RegisterFile("temporal/server/api/enums/v1/schedule.proto", fileDescriptor_0c7ac3450fe0d23b)
+}
+
+var fileDescriptor_0c7ac3450fe0d23b = []byte{
+	// 312 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0xd1, 0x3f, 0x4b, 0xc3, 0x40,
+	0x18, 0xc7, 0xf1, 0xbb, 0xaa, 0x1d, 0x6e, 0x0a, 0x07, 0x6e, 0xe5, 0xc1, 0xff, 0x2d, 0x16, 0x12,
+	0x8a, 0xa3, 0x53, 0x9a, 0x5e, 0x69, 0xf0, 0x4c, 0x42, 0xd2, 0x2a, 0x3a, 0x18, 0x62, 0x3d, 0x34,
+	0x90, 0x7a, 0x21, 0x6d, 0x03, 0x6e, 0xbe, 0x04, 0x5f, 0x86, 0xa3, 0x2f, 0xc3, 0xb1, 0x63, 0x47,
+	0x7b, 0x5d, 0x1c, 0xfb, 0x12, 0x84, 0x58, 0x9d, 0x0c, 0xb8, 0x3d, 0xc3, 0xe7, 0xe1, 0x37, 0x7c,
+	0x49, 0x73, 0x22, 0x46, 0xa9, 0xcc, 0xa2, 0xc4, 0x18, 0x8b, 0x2c, 0x17, 0x99, 0x11, 0xa5, 0xb1,
+	0x21, 0x1e, 0xa7, 0xa3, 0xb1, 0x91, 0xb7, 0x8c, 0xf1, 0xf0, 0x41, 0xdc, 0x4d, 0x13, 0xa1, 0xa7,
+	0x99, 0x9c, 0x48, 0x5a, 0xfb, 0xc1, 0xfa, 0x37, 0xd6, 0xa3, 0x34, 0xd6, 0x0b, 0xac, 0xe7, 0xad,
+	0xe3, 0xb7, 0x0a, 0xd9, 0x0e, 0xd6, 0x0f, 0x6e, 0x2e, 0xb2, 0x24, 0x4a, 0x3d, 0x99, 0xc4, 0xc3,
+	0x27, 0x5a, 0x27, 0xfb, 0x81, 0xd5, 0x63, 0x9d, 0x01, 0x67, 0xa1, 0x7b, 0xc1, 0x7c, 0x6e, 0x7a,
+	0xa1, 0xe7, 0x72, 0xdb, 0xba, 0x0a, 0x07, 0x4e, 0xe0, 0x31, 0xcb, 0xee, 0xda, 0xac, 0xa3, 0x21,
+	0xba, 0x43, 0x6a, 0x65, 0x30, 0x38, 0xb3, 0x3d, 0x0d, 0xd3, 0x23, 0xb2, 0x57, 0x26, 0xda, 0x83,
+	0x6e, 0x97, 0xf9, 0xa1, 0xeb, 0x30, 0xad, 0xf2, 0x0f, 0x67, 0x72, 0xae, 0x6d, 0xd0, 0x06, 0x39,
+	0x28, 0x73, 0x96, 0xe9, 0x58, 0x8c, 0x87, 0x6e, 0xbf, 0xc7, 0x7c, 0x6d, 0x93, 0x36, 0x49, 0xbd,
+	0x4c, 0xf6, 0x99, 0x7f, 0x6e, 0x3b, 0x66, 0x9f, 0xad, 0xf1, 0x16, 0x3d, 0x24, 0xbb, 0x65, 0xd8,
+	0xe4, 0xdc, 0xbd, 0x2c, 0xd6, 0xab, 0xed, 0x9b, 0xd9, 0x02, 0xd0, 0x7c, 0x01, 0x68, 0xb5, 0x00,
+	0xfc, 0xac, 0x00, 0xbf, 0x2a, 0xc0, 0xef, 0x0a, 0xf0, 0x4c, 0x01, 0xfe, 0x50, 0x80, 0x3f, 0x15,
+	0xa0, 0x95, 0x02, 0xfc, 0xb2, 0x04, 0x34, 0x5b, 0x02, 0x9a, 0x2f, 0x01, 0x5d, 0x37, 0xee, 0xa5,
+	0xfe, 0x5b, 0x22, 0x96, 0x7f, 0x95, 0x3b, 0x2d, 0x8e, 0xdb, 0x6a, 0xd1, 0xed, 0xe4, 0x6b, 0x00,
+	0x53, 0x16, 0xd3, 0x32, 0xe6, 0x01, 0x00, 0x00,
+}
+
+func (x ScheduleOverlapPolicy) String() string {
+	s, ok := ScheduleOverlapPolicy_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
@@ -88,18 +88,21 @@ const (
 	WORKFLOW_BACKOFF_TYPE_UNSPECIFIED WorkflowBackoffType = 0
 	WORKFLOW_BACKOFF_TYPE_RETRY       WorkflowBackoffType = 1
 	WORKFLOW_BACKOFF_TYPE_CRON        WorkflowBackoffType = 2
+	WORKFLOW_BACKOFF_TYPE_DELAY_START WorkflowBackoffType = 3
 )
 
 var WorkflowBackoffType_name = map[int32]string{
 	0: "Unspecified",
 	1: "Retry",
 	2: "Cron",
+	3: "DelayStart",
 }
 
 var WorkflowBackoffType_value = map[string]int32{
 	"Unspecified": 0,
 	"Retry":       1,
 	"Cron":        2,
+	"DelayStart":  3,
 }
 
 func (WorkflowBackoffType) EnumDescriptor() ([]byte, []int) {
@@ -116,30 +119,31 @@ func init() {
 }
 
 var fileDescriptor_004b7fefe981a755 = []byte{
-	// 366 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x2e, 0x49, 0xcd, 0x2d,
-	0xc8, 0x2f, 0x4a, 0xcc, 0xd1, 0x2f, 0x4e, 0x2d, 0x2a, 0x4b, 0x2d, 0xd2, 0x4f, 0x2c, 0xc8, 0xd4,
-	0x4f, 0xcd, 0x2b, 0xcd, 0x2d, 0xd6, 0x2f, 0x33, 0xd4, 0x2f, 0xcf, 0x2f, 0xca, 0x4e, 0xcb, 0xc9,
-	0x2f, 0xd7, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x81, 0x29, 0xd6, 0x83, 0x28, 0xd6, 0x4b,
-	0x2c, 0xc8, 0xd4, 0x03, 0x2b, 0xd6, 0x2b, 0x33, 0xd4, 0x5a, 0xc6, 0xc4, 0x25, 0x16, 0x0e, 0xd5,
-	0xe0, 0x5a, 0x91, 0x9a, 0x5c, 0x5a, 0x92, 0x99, 0x9f, 0x17, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0xa4,
-	0xc1, 0xa5, 0x12, 0xee, 0x1f, 0xe4, 0xed, 0xe6, 0xe3, 0x1f, 0x1e, 0xef, 0x1a, 0xe1, 0xea, 0x1c,
-	0x1a, 0xe2, 0xe9, 0xef, 0x17, 0x1f, 0x1c, 0xe2, 0x18, 0xe2, 0x1a, 0x1f, 0xea, 0x17, 0x1c, 0xe0,
-	0xea, 0xec, 0xe9, 0xe6, 0xe9, 0xea, 0x22, 0xc0, 0x20, 0xa4, 0xc2, 0xa5, 0x80, 0x53, 0xa5, 0x73,
-	0x90, 0xab, 0x63, 0x88, 0xab, 0x8b, 0x00, 0x23, 0x5e, 0x55, 0x41, 0xa1, 0x7e, 0x7e, 0x9e, 0x7e,
-	0xee, 0x02, 0x4c, 0x42, 0x6a, 0x5c, 0x4a, 0xb8, 0xcd, 0xf2, 0xf7, 0x0d, 0xf0, 0x71, 0x05, 0x99,
-	0xc6, 0x2c, 0xa4, 0xcc, 0x25, 0x8f, 0x53, 0x5d, 0x94, 0xbf, 0xaf, 0x93, 0xa7, 0xab, 0x00, 0x8b,
-	0x90, 0x22, 0x97, 0x2c, 0x4e, 0x45, 0x61, 0xfe, 0x9e, 0x2e, 0x02, 0xac, 0x04, 0xec, 0x0b, 0x0a,
-	0x0a, 0x0d, 0x00, 0xd9, 0xc7, 0xa6, 0x55, 0xcb, 0x25, 0x0c, 0x0b, 0x27, 0xa7, 0xc4, 0xe4, 0xec,
-	0xfc, 0xb4, 0xb4, 0x90, 0xca, 0x82, 0x54, 0x21, 0x55, 0x2e, 0x45, 0xb8, 0x76, 0x27, 0x47, 0x67,
-	0x6f, 0x7f, 0x37, 0xb7, 0xf8, 0x90, 0xc8, 0x00, 0xf4, 0x10, 0x92, 0xe7, 0x92, 0xc6, 0xae, 0x2c,
-	0xc8, 0x35, 0x24, 0x28, 0x52, 0x80, 0x51, 0x48, 0x8e, 0x4b, 0x0a, 0xbb, 0x02, 0xe7, 0x20, 0x7f,
-	0x3f, 0x01, 0x26, 0xa7, 0xb8, 0x0b, 0x0f, 0xe5, 0x18, 0x6e, 0x3c, 0x94, 0x63, 0xf8, 0xf0, 0x50,
-	0x8e, 0xb1, 0xe1, 0x91, 0x1c, 0xe3, 0x8a, 0x47, 0x72, 0x8c, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78,
-	0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x8b, 0x47, 0x72, 0x0c, 0x1f, 0x1e, 0xc9, 0x31, 0x4e,
-	0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x1a, 0xe9, 0xf9,
-	0x7a, 0xf0, 0xe8, 0xcf, 0xcc, 0xc7, 0x96, 0x5c, 0xac, 0xc1, 0x8c, 0x24, 0x36, 0x70, 0x62, 0x31,
-	0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xfd, 0x0f, 0x05, 0x23, 0x5b, 0x02, 0x00, 0x00,
+	// 376 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0xd2, 0x3d, 0xef, 0xd2, 0x40,
+	0x1c, 0x07, 0xf0, 0x1e, 0x28, 0xc3, 0x4d, 0x97, 0x33, 0x71, 0xf0, 0xe1, 0x10, 0x45, 0x43, 0x30,
+	0x69, 0x43, 0x1c, 0x9d, 0xfa, 0x70, 0x35, 0x0d, 0xd0, 0x6b, 0x8e, 0xab, 0x08, 0x83, 0x4d, 0x25,
+	0xc5, 0x34, 0x3c, 0x5c, 0x53, 0x0a, 0xe8, 0xe6, 0x4b, 0xf0, 0x45, 0x18, 0xe3, 0x4b, 0x71, 0x64,
+	0x64, 0x94, 0xb2, 0x38, 0xf2, 0x12, 0x0c, 0x28, 0x0c, 0x86, 0xfe, 0xff, 0xdb, 0x0d, 0x9f, 0xdf,
+	0xef, 0x7b, 0xf9, 0xe5, 0x0b, 0x5f, 0x66, 0xd1, 0x2c, 0x91, 0x69, 0x38, 0xd5, 0x16, 0x51, 0xba,
+	0x8a, 0x52, 0x2d, 0x4c, 0x62, 0x2d, 0x9a, 0x2f, 0x67, 0x0b, 0x6d, 0xd5, 0xd2, 0xd6, 0x32, 0x9d,
+	0x8c, 0xa7, 0x72, 0xad, 0x26, 0xa9, 0xcc, 0x24, 0x7e, 0x74, 0xc6, 0xea, 0x5f, 0xac, 0x86, 0x49,
+	0xac, 0x9e, 0xb0, 0xba, 0x6a, 0x35, 0xbf, 0x97, 0xe0, 0xfd, 0xfe, 0xbf, 0x01, 0xfa, 0x29, 0x1a,
+	0x2d, 0xb3, 0x58, 0xce, 0x7b, 0x59, 0x98, 0x45, 0xb8, 0x01, 0xeb, 0x7d, 0xc6, 0xdb, 0x76, 0x87,
+	0xf5, 0x03, 0xfa, 0x8e, 0x9a, 0xbe, 0x70, 0x98, 0x1b, 0xf4, 0x84, 0x2e, 0x68, 0xe0, 0xbb, 0x3d,
+	0x8f, 0x9a, 0x8e, 0xed, 0x50, 0x0b, 0x29, 0xb8, 0x0e, 0x9f, 0x14, 0x4a, 0x93, 0x53, 0x5d, 0x50,
+	0x0b, 0x81, 0x1b, 0x15, 0xf7, 0x5d, 0xd7, 0x71, 0xdf, 0xa0, 0x12, 0x7e, 0x01, 0x9f, 0x16, 0xef,
+	0x62, 0x5d, 0xaf, 0x43, 0x8f, 0xdb, 0xca, 0xf8, 0x19, 0xac, 0x16, 0xba, 0x21, 0xeb, 0x1a, 0x0e,
+	0x45, 0x77, 0x70, 0x0d, 0x3e, 0x2e, 0x44, 0x6f, 0x99, 0x63, 0xa1, 0xbb, 0xb7, 0xe4, 0x71, 0xee,
+	0x7b, 0xc7, 0xbc, 0x4a, 0xf3, 0x1b, 0x80, 0xf7, 0xce, 0x87, 0x32, 0xc2, 0xd1, 0x44, 0x8e, 0xc7,
+	0xe2, 0x73, 0x12, 0xe1, 0xe7, 0xb0, 0x76, 0x99, 0x37, 0x74, 0xb3, 0xcd, 0x6c, 0x3b, 0x10, 0x03,
+	0xef, 0xff, 0x13, 0x55, 0xe1, 0xc3, 0xeb, 0x8c, 0x53, 0xc1, 0x07, 0x08, 0x60, 0x02, 0x1f, 0x5c,
+	0x07, 0x26, 0x67, 0x2e, 0x2a, 0x15, 0xe7, 0x58, 0xb4, 0xa3, 0x0f, 0x8e, 0x1f, 0xe6, 0x02, 0x95,
+	0x8d, 0xf7, 0x9b, 0x1d, 0x51, 0xb6, 0x3b, 0xa2, 0x1c, 0x76, 0x04, 0x7c, 0xc9, 0x09, 0xf8, 0x91,
+	0x13, 0xf0, 0x33, 0x27, 0x60, 0x93, 0x13, 0xf0, 0x2b, 0x27, 0xe0, 0x77, 0x4e, 0x94, 0x43, 0x4e,
+	0xc0, 0xd7, 0x3d, 0x51, 0x36, 0x7b, 0xa2, 0x6c, 0xf7, 0x44, 0x19, 0x36, 0x3e, 0x4a, 0xf5, 0x52,
+	0x93, 0x58, 0x5e, 0xab, 0xd5, 0xeb, 0xd3, 0xe3, 0x43, 0xe5, 0x54, 0xaa, 0x57, 0x7f, 0x06, 0x00,
+	0x55, 0x16, 0x44, 0x81, 0x83, 0x02, 0x00, 0x00,
 }
 
 func (x WorkflowExecutionState) String() string {
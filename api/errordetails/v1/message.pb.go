@@ -85,6 +85,41 @@ func (m *TaskAlreadyStartedFailure) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TaskAlreadyStartedFailure proto.InternalMessageInfo
 
+type StickyWorkerUnavailableFailure struct {
+}
+
+func (m *StickyWorkerUnavailableFailure) Reset()      { *m = StickyWorkerUnavailableFailure{} }
+func (*StickyWorkerUnavailableFailure) ProtoMessage() {}
+func (*StickyWorkerUnavailableFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73580c2e9c4cb332, []int{1}
+}
+func (m *StickyWorkerUnavailableFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StickyWorkerUnavailableFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StickyWorkerUnavailableFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StickyWorkerUnavailableFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StickyWorkerUnavailableFailure.Merge(m, src)
+}
+func (m *StickyWorkerUnavailableFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *StickyWorkerUnavailableFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_StickyWorkerUnavailableFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StickyWorkerUnavailableFailure proto.InternalMessageInfo
+
 type CurrentBranchChangedFailure struct {
 	CurrentBranchToken []byte `protobuf:"bytes,1,opt,name=current_branch_token,json=currentBranchToken,proto3" json:"current_branch_token,omitempty"`
 	RequestBranchToken []byte `protobuf:"bytes,2,opt,name=request_branch_token,json=requestBranchToken,proto3" json:"request_branch_token,omitempty"`
@@ -93,7 +128,7 @@ type CurrentBranchChangedFailure struct {
 func (m *CurrentBranchChangedFailure) Reset()      { *m = CurrentBranchChangedFailure{} }
 func (*CurrentBranchChangedFailure) ProtoMessage() {}
 func (*CurrentBranchChangedFailure) Descriptor() ([]byte, []int) {
-	return fileDescriptor_73580c2e9c4cb332, []int{1}
+	return fileDescriptor_73580c2e9c4cb332, []int{2}
 }
 func (m *CurrentBranchChangedFailure) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -144,7 +179,7 @@ type ShardOwnershipLostFailure struct {
 func (m *ShardOwnershipLostFailure) Reset()      { *m = ShardOwnershipLostFailure{} }
 func (*ShardOwnershipLostFailure) ProtoMessage() {}
 func (*ShardOwnershipLostFailure) Descriptor() ([]byte, []int) {
-	return fileDescriptor_73580c2e9c4cb332, []int{2}
+	return fileDescriptor_73580c2e9c4cb332, []int{3}
 }
 func (m *ShardOwnershipLostFailure) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -200,7 +235,7 @@ type RetryReplicationFailure struct {
 func (m *RetryReplicationFailure) Reset()      { *m = RetryReplicationFailure{} }
 func (*RetryReplicationFailure) ProtoMessage() {}
 func (*RetryReplicationFailure) Descriptor() ([]byte, []int) {
-	return fileDescriptor_73580c2e9c4cb332, []int{3}
+	return fileDescriptor_73580c2e9c4cb332, []int{4}
 }
 func (m *RetryReplicationFailure) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -280,6 +315,7 @@ func (m *RetryReplicationFailure) GetEndEventVersion() int64 {
 
 func init() {
 	proto.RegisterType((*TaskAlreadyStartedFailure)(nil), "temporal.server.api.errordetails.v1.TaskAlreadyStartedFailure")
+	proto.RegisterType((*StickyWorkerUnavailableFailure)(nil), "temporal.server.api.errordetails.v1.StickyWorkerUnavailableFailure")
 	proto.RegisterType((*CurrentBranchChangedFailure)(nil), "temporal.server.api.errordetails.v1.CurrentBranchChangedFailure")
 	proto.RegisterType((*ShardOwnershipLostFailure)(nil), "temporal.server.api.errordetails.v1.ShardOwnershipLostFailure")
 	proto.RegisterType((*RetryReplicationFailure)(nil), "temporal.server.api.errordetails.v1.RetryReplicationFailure")
@@ -290,37 +326,38 @@ func init() {
 }
 
 var fileDescriptor_73580c2e9c4cb332 = []byte{
-	// 470 bytes of a gzipped FileDescriptorProto
+	// 488 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0x6b, 0x13, 0x41,
 	0x14, 0xc7, 0x77, 0x52, 0x1b, 0xe9, 0x24, 0x28, 0x5d, 0x15, 0x53, 0x8a, 0x63, 0x1a, 0x3d, 0x14,
-	0x0f, 0x1b, 0x83, 0xe0, 0xc5, 0x93, 0x2d, 0x8a, 0x01, 0x41, 0x48, 0x8b, 0x07, 0x41, 0xc2, 0x74,
-	0xe7, 0x99, 0x1d, 0xb2, 0x99, 0x59, 0xdf, 0xcc, 0x6e, 0xe8, 0x4d, 0xbf, 0x81, 0x47, 0x3f, 0x82,
-	0x1f, 0xc5, 0x63, 0x8e, 0x3d, 0x9a, 0xcd, 0xc5, 0x63, 0x3f, 0x82, 0xec, 0x6c, 0x36, 0x59, 0x3c,
-	0xf4, 0x38, 0xff, 0xff, 0xef, 0xff, 0x7f, 0x8f, 0xe1, 0xd1, 0x81, 0x85, 0x59, 0xa2, 0x91, 0xc7,
-	0x7d, 0x03, 0x98, 0x01, 0xf6, 0x79, 0x22, 0xfb, 0x80, 0xa8, 0x51, 0x80, 0xe5, 0x32, 0x36, 0xfd,
-	0x6c, 0xd0, 0x9f, 0x81, 0x31, 0x7c, 0x02, 0x41, 0x82, 0xda, 0x6a, 0xff, 0x49, 0x15, 0x09, 0xca,
-	0x48, 0xc0, 0x13, 0x19, 0xd4, 0x23, 0x41, 0x36, 0xe8, 0x1d, 0xd2, 0x83, 0x73, 0x6e, 0xa6, 0xaf,
-	0x63, 0x04, 0x2e, 0x2e, 0xcf, 0x2c, 0x47, 0x0b, 0xe2, 0x2d, 0x97, 0x71, 0x8a, 0xd0, 0xfb, 0x4e,
-	0xe8, 0xe1, 0x69, 0x8a, 0x08, 0xca, 0x9e, 0x20, 0x57, 0x61, 0x74, 0x1a, 0x71, 0x35, 0xd9, 0xf8,
-	0xfe, 0x73, 0x7a, 0x3f, 0x2c, 0xed, 0xf1, 0x85, 0xf3, 0xc7, 0x56, 0x4f, 0x41, 0x75, 0x48, 0x97,
-	0x1c, 0xb7, 0x47, 0x7e, 0x58, 0x8f, 0x9e, 0x17, 0x4e, 0x91, 0x40, 0xf8, 0x9a, 0x82, 0xf9, 0x2f,
-	0xd1, 0x28, 0x13, 0x6b, 0xaf, 0x96, 0xe8, 0x7d, 0xa6, 0x07, 0x67, 0x11, 0x47, 0xf1, 0x61, 0xae,
-	0x00, 0x4d, 0x24, 0x93, 0xf7, 0xda, 0xd8, 0x6a, 0x81, 0x47, 0x94, 0xea, 0x42, 0x1f, 0x47, 0xda,
-	0x58, 0x37, 0x76, 0x6f, 0xb4, 0xe7, 0x94, 0x77, 0xda, 0x58, 0xff, 0x88, 0xb6, 0xab, 0xfd, 0x1c,
-	0xd0, 0x70, 0x40, 0x6b, 0xad, 0x15, 0x48, 0xef, 0x67, 0x83, 0x3e, 0x1c, 0x81, 0xc5, 0xcb, 0x11,
-	0x24, 0xb1, 0x0c, 0xb9, 0x95, 0x5a, 0x55, 0xed, 0x47, 0xb4, 0xad, 0xf8, 0x0c, 0x4c, 0xc2, 0x43,
-	0x18, 0x4b, 0xb1, 0xee, 0x6f, 0x6d, 0xb4, 0xa1, 0xf0, 0x1f, 0xd3, 0xd6, 0x5c, 0xe3, 0xf4, 0x4b,
-	0xac, 0xe7, 0x05, 0x51, 0x0e, 0xa0, 0x95, 0x34, 0x14, 0xfe, 0x03, 0xda, 0xc4, 0x54, 0x15, 0xde,
-	0x8e, 0xf3, 0x76, 0x31, 0x55, 0x43, 0xe1, 0x3f, 0xa5, 0x77, 0x4c, 0xf1, 0xd7, 0x63, 0xc8, 0x8a,
-	0xed, 0xa4, 0xe8, 0xdc, 0xea, 0x92, 0xe3, 0x9d, 0x51, 0xdb, 0xa9, 0x6f, 0x0a, 0x71, 0x28, 0xfc,
-	0x80, 0xde, 0xab, 0x53, 0x19, 0xa0, 0x91, 0x5a, 0x75, 0x76, 0x1d, 0xba, 0xbf, 0x45, 0x3f, 0x96,
-	0x86, 0xdf, 0xa5, 0x6d, 0x50, 0x62, 0xdb, 0xd9, 0x74, 0x20, 0x05, 0x25, 0xaa, 0xc6, 0x67, 0x74,
-	0x7f, 0x4b, 0x54, 0x7d, 0xb7, 0x1d, 0x76, 0xb7, 0xc2, 0xd6, 0x6d, 0x27, 0xf1, 0x62, 0xc9, 0xbc,
-	0xab, 0x25, 0xf3, 0xae, 0x97, 0x8c, 0x7c, 0xcb, 0x19, 0xf9, 0x95, 0x33, 0xf2, 0x3b, 0x67, 0x64,
-	0x91, 0x33, 0xf2, 0x27, 0x67, 0xe4, 0x6f, 0xce, 0xbc, 0xeb, 0x9c, 0x91, 0x1f, 0x2b, 0xe6, 0x2d,
-	0x56, 0xcc, 0xbb, 0x5a, 0x31, 0xef, 0xd3, 0xcb, 0x89, 0x0e, 0x36, 0x87, 0x27, 0xf5, 0x0d, 0xe7,
-	0xfa, 0xaa, 0xfe, 0xbe, 0x68, 0xba, 0xa3, 0x7d, 0xf1, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xa9, 0xaa,
-	0xa0, 0x9f, 0xe9, 0x02, 0x00, 0x00,
+	0x0f, 0x1b, 0x83, 0xe0, 0xc5, 0x93, 0x2d, 0x8a, 0x01, 0x41, 0x48, 0xaa, 0x82, 0x20, 0x61, 0xb2,
+	0xf3, 0x4c, 0x86, 0x6c, 0x66, 0xd6, 0x37, 0x93, 0x0d, 0xb9, 0xe9, 0x37, 0xf0, 0xe8, 0x47, 0xf0,
+	0xa3, 0x78, 0xcc, 0xb1, 0x47, 0xb3, 0xb9, 0x78, 0xec, 0x47, 0x90, 0x9d, 0xcd, 0x26, 0x8b, 0x87,
+	0x1e, 0xe7, 0xff, 0xff, 0xfd, 0xff, 0xef, 0xc1, 0x3c, 0xda, 0xb1, 0x30, 0x8d, 0x35, 0xf2, 0xa8,
+	0x6d, 0x00, 0x13, 0xc0, 0x36, 0x8f, 0x65, 0x1b, 0x10, 0x35, 0x0a, 0xb0, 0x5c, 0x46, 0xa6, 0x9d,
+	0x74, 0xda, 0x53, 0x30, 0x86, 0x8f, 0x20, 0x88, 0x51, 0x5b, 0xed, 0x3f, 0x2a, 0x22, 0x41, 0x1e,
+	0x09, 0x78, 0x2c, 0x83, 0x72, 0x24, 0x48, 0x3a, 0xad, 0x63, 0x7a, 0x74, 0xc1, 0xcd, 0xe4, 0x65,
+	0x84, 0xc0, 0xc5, 0xa2, 0x6f, 0x39, 0x5a, 0x10, 0xaf, 0xb9, 0x8c, 0x66, 0x08, 0xad, 0x26, 0x65,
+	0x7d, 0x2b, 0xc3, 0xc9, 0xe2, 0xa3, 0xc6, 0x09, 0xe0, 0x7b, 0xc5, 0x13, 0x2e, 0x23, 0x3e, 0x8c,
+	0xa0, 0x20, 0xbe, 0x13, 0x7a, 0x7c, 0x3e, 0x43, 0x04, 0x65, 0xcf, 0x90, 0xab, 0x70, 0x7c, 0x3e,
+	0xe6, 0x6a, 0xb4, 0x6d, 0xf0, 0x9f, 0xd2, 0xbb, 0x61, 0x6e, 0x0f, 0x86, 0xce, 0x1f, 0x58, 0x3d,
+	0x01, 0xd5, 0x20, 0x4d, 0x72, 0x5a, 0xef, 0xf9, 0x61, 0x39, 0x7a, 0x91, 0x39, 0x59, 0x02, 0xe1,
+	0xeb, 0x0c, 0xcc, 0x7f, 0x89, 0x4a, 0x9e, 0xd8, 0x78, 0xa5, 0x44, 0xeb, 0x33, 0x3d, 0xea, 0x8f,
+	0x39, 0x8a, 0x77, 0x73, 0x05, 0x68, 0xc6, 0x32, 0x7e, 0xab, 0x8d, 0x2d, 0x16, 0x78, 0x40, 0xa9,
+	0xce, 0xf4, 0xc1, 0x58, 0x1b, 0xeb, 0xc6, 0x1e, 0xf4, 0x0e, 0x9c, 0xf2, 0x46, 0x1b, 0xeb, 0x9f,
+	0xd0, 0x7a, 0xb1, 0x9f, 0x03, 0x2a, 0x0e, 0xa8, 0x6d, 0xb4, 0x0c, 0x69, 0xfd, 0xac, 0xd0, 0xfb,
+	0x3d, 0xb0, 0xb8, 0xe8, 0x41, 0x1c, 0xc9, 0x90, 0x5b, 0xa9, 0x55, 0xd1, 0x7e, 0x42, 0xeb, 0x8a,
+	0x4f, 0xc1, 0xc4, 0x3c, 0x84, 0x81, 0x14, 0x9b, 0xfe, 0xda, 0x56, 0xeb, 0x0a, 0xff, 0x21, 0xad,
+	0xcd, 0x35, 0x4e, 0xbe, 0x44, 0x7a, 0x9e, 0x11, 0xf9, 0x00, 0x5a, 0x48, 0x5d, 0xe1, 0xdf, 0xa3,
+	0x55, 0x9c, 0xa9, 0xcc, 0xdb, 0x73, 0xde, 0x3e, 0xce, 0x54, 0x57, 0xf8, 0x8f, 0xe9, 0x2d, 0x93,
+	0xfd, 0xc6, 0x00, 0x92, 0x6c, 0x3b, 0x29, 0x1a, 0x37, 0x9a, 0xe4, 0x74, 0xaf, 0x57, 0x77, 0xea,
+	0xab, 0x4c, 0xec, 0x0a, 0x3f, 0xa0, 0x77, 0xca, 0x54, 0x02, 0x68, 0xa4, 0x56, 0x8d, 0x7d, 0x87,
+	0x1e, 0xee, 0xd0, 0x0f, 0xb9, 0xe1, 0x37, 0x69, 0x1d, 0x94, 0xd8, 0x75, 0x56, 0x1d, 0x48, 0x41,
+	0x89, 0xa2, 0xf1, 0x09, 0x3d, 0xdc, 0x11, 0x45, 0xdf, 0x4d, 0x87, 0xdd, 0x2e, 0xb0, 0x4d, 0xdb,
+	0x59, 0xb4, 0x5c, 0x31, 0xef, 0x72, 0xc5, 0xbc, 0xab, 0x15, 0x23, 0xdf, 0x52, 0x46, 0x7e, 0xa5,
+	0x8c, 0xfc, 0x4e, 0x19, 0x59, 0xa6, 0x8c, 0xfc, 0x49, 0x19, 0xf9, 0x9b, 0x32, 0xef, 0x2a, 0x65,
+	0xe4, 0xc7, 0x9a, 0x79, 0xcb, 0x35, 0xf3, 0x2e, 0xd7, 0xcc, 0xfb, 0xf4, 0x7c, 0xa4, 0x83, 0xed,
+	0x69, 0x4a, 0x7d, 0xcd, 0x41, 0xbf, 0x28, 0xbf, 0x87, 0x55, 0x77, 0xd6, 0xcf, 0xfe, 0x0d, 0x00,
+	0x8a, 0x12, 0x3b, 0x30, 0x0b, 0x03, 0x00, 0x00,
 }
 
 func (this *TaskAlreadyStartedFailure) Equal(that interface{}) bool {
@@ -344,6 +381,27 @@ func (this *TaskAlreadyStartedFailure) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *StickyWorkerUnavailableFailure) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StickyWorkerUnavailableFailure)
+	if !ok {
+		that2, ok := that.(StickyWorkerUnavailableFailure)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 func (this *CurrentBranchChangedFailure) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -449,6 +507,15 @@ func (this *TaskAlreadyStartedFailure) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *StickyWorkerUnavailableFailure) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&errordetails.StickyWorkerUnavailableFailure{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *CurrentBranchChangedFailure) GoString() string {
 	if this == nil {
 		return "nil"
@@ -518,6 +585,29 @@ func (m *TaskAlreadyStartedFailure) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *StickyWorkerUnavailableFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StickyWorkerUnavailableFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StickyWorkerUnavailableFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *CurrentBranchChangedFailure) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -676,6 +766,15 @@ func (m *TaskAlreadyStartedFailure) Size() (n int) {
 	return n
 }
 
+func (m *StickyWorkerUnavailableFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *CurrentBranchChangedFailure) Size() (n int) {
 	if m == nil {
 		return 0
@@ -758,6 +857,15 @@ func (this *TaskAlreadyStartedFailure) String() string {
 	}, "")
 	return s
 }
+func (this *StickyWorkerUnavailableFailure) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StickyWorkerUnavailableFailure{`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *CurrentBranchChangedFailure) String() string {
 	if this == nil {
 		return "nil"
@@ -857,6 +965,59 @@ func (m *TaskAlreadyStartedFailure) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *StickyWorkerUnavailableFailure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StickyWorkerUnavailableFailure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StickyWorkerUnavailableFailure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *CurrentBranchChangedFailure) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -54,72 +54,87 @@ func init() {
 }
 
 var fileDescriptor_655983da427ae822 = []byte{
-	// 1034 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x99, 0xcd, 0x8b, 0x23, 0x45,
-	0x18, 0x87, 0x53, 0x17, 0x0f, 0x85, 0xae, 0xda, 0x8a, 0x1f, 0xa3, 0x36, 0x22, 0x78, 0x4d, 0xd8,
-	0xdd, 0xcb, 0x7e, 0xcc, 0xba, 0xee, 0x64, 0x66, 0x32, 0xb3, 0x3b, 0xa3, 0x4e, 0xb2, 0x28, 0x78,
-	0x91, 0x9a, 0xce, 0xbb, 0x93, 0x62, 0x7a, 0x52, 0x6d, 0x55, 0x25, 0x9a, 0x9b, 0xe0, 0x49, 0x58,
-	0x50, 0x04, 0xc1, 0x93, 0xe0, 0x49, 0x11, 0x04, 0x41, 0x10, 0x04, 0xc1, 0x93, 0xe0, 0x71, 0x8e,
-	0x7b, 0x74, 0x32, 0x17, 0x8f, 0xfb, 0x27, 0x2c, 0x49, 0xa7, 0x6a, 0x52, 0xdd, 0xd5, 0xa1, 0xaa,
-	0x92, 0xdb, 0x6e, 0xa6, 0x7e, 0x4f, 0x3f, 0x5d, 0x5f, 0x6f, 0xa5, 0x82, 0xaf, 0x4a, 0x38, 0xc9,
-	0x18, 0x27, 0x69, 0x43, 0x00, 0x1f, 0x02, 0x6f, 0x90, 0x8c, 0x36, 0x7a, 0x54, 0x48, 0xc6, 0x47,
-	0x93, 0x4f, 0x68, 0x02, 0x8d, 0xe1, 0xe5, 0xc6, 0xec, 0x9f, 0xf5, 0x8c, 0x33, 0xc9, 0xa2, 0xb7,
-	0x55, 0xa8, 0x9e, 0x87, 0xea, 0x24, 0xa3, 0x75, 0x33, 0x54, 0x1f, 0x5e, 0x5e, 0x5b, 0x77, 0x63,
-	0x73, 0xf8, 0x74, 0x00, 0x42, 0x7e, 0xc2, 0x41, 0x64, 0xac, 0x2f, 0x66, 0x0f, 0xb9, 0xf2, 0xb0,
-	0x81, 0x2f, 0xed, 0xe4, 0x8d, 0x3b, 0x79, 0xe3, 0xe8, 0x27, 0x84, 0x5f, 0xea, 0x48, 0xc2, 0xe5,
-	0x47, 0x8c, 0x1f, 0x3f, 0x48, 0xd9, 0x67, 0x5b, 0x9f, 0x43, 0x32, 0x90, 0x94, 0xf5, 0xa3, 0xcd,
-	0xba, 0x93, 0x53, 0xdd, 0x1e, 0x6f, 0xe7, 0x0a, 0x6b, 0x5b, 0x4b, 0x52, 0xf2, 0x17, 0x78, 0xab,
-	0x16, 0x7d, 0x8b, 0xf0, 0xb3, 0x2d, 0x90, 0xfb, 0x03, 0x49, 0x0e, 0x53, 0xe8, 0x48, 0x22, 0x21,
-	0xba, 0xe5, 0x08, 0x2f, 0xe4, 0x94, 0xdb, 0x3b, 0xa1, 0x71, 0x2d, 0xf5, 0x1d, 0xc2, 0xcf, 0x7d,
-	0xc0, 0xd2, 0xd4, 0xb0, 0x72, 0xc5, 0x16, 0x83, 0x4a, 0xeb, 0x76, 0x70, 0x5e, 0x7b, 0xfd, 0x88,
-	0xf0, 0x8b, 0x6d, 0x10, 0x20, 0x3b, 0x92, 0x26, 0xc7, 0xa3, 0xfb, 0x44, 0x1c, 0x1f, 0x0c, 0x60,
-	0x00, 0xd1, 0x86, 0x23, 0xdb, 0x16, 0x56, 0x7e, 0xcd, 0xa5, 0x18, 0xda, 0xf1, 0x37, 0x84, 0x5f,
-	0x6d, 0x43, 0xc2, 0x78, 0x57, 0x0d, 0xfb, 0xa4, 0xd5, 0x74, 0x1e, 0x40, 0x37, 0x6a, 0x39, 0x3f,
-	0xa4, 0x82, 0xa0, 0x6c, 0x77, 0x96, 0x07, 0x59, 0x94, 0xef, 0x24, 0x92, 0x0e, 0xa9, 0x1c, 0x85,
-	0x2b, 0x5b, 0x08, 0x61, 0xca, 0x56, 0x90, 0x56, 0xfe, 0x13, 0xe1, 0xd7, 0xf3, 0xff, 0x1a, 0xef,
-	0xd6, 0x64, 0x27, 0x59, 0x0a, 0x13, 0xeb, 0xbb, 0xee, 0xa3, 0x59, 0x09, 0x51, 0xe2, 0xf7, 0x56,
-	0xc2, 0x2a, 0x74, 0x77, 0xa9, 0xe9, 0x36, 0xa1, 0xa9, 0x57, 0x77, 0x57, 0x10, 0xfc, 0xbb, 0xbb,
-	0x12, 0xa4, 0x95, 0xff, 0x40, 0xf8, 0xb5, 0xf2, 0xb0, 0xec, 0x00, 0xe1, 0xf2, 0x10, 0x88, 0x8c,
-	0x76, 0x83, 0x87, 0x56, 0x33, 0x94, 0xf6, 0xdd, 0x55, 0xa0, 0x6c, 0xf3, 0x64, 0xbe, 0x69, 0xf0,
-	0x3c, 0xb1, 0x42, 0x02, 0xe7, 0x49, 0x05, 0xcb, 0x36, 0x4f, 0xe6, 0x9b, 0x86, 0xcd, 0x93, 0x32,
-	0x21, 0x70, 0x9e, 0xd8, 0x40, 0x85, 0x79, 0x52, 0x7e, 0x3b, 0xd2, 0x4f, 0x60, 0x22, 0xbd, 0xbb,
-	0x44, 0x0f, 0xcd, 0x18, 0xfe, 0xf3, 0x64, 0x01, 0x4a, 0x8b, 0xff, 0x82, 0xf0, 0xcb, 0x1d, 0x7a,
-	0xd4, 0x27, 0x69, 0xf9, 0xc4, 0xe0, 0x5c, 0xeb, 0xed, 0x79, 0x25, 0xbc, 0xbd, 0x2c, 0x46, 0xcb,
-	0xfe, 0x83, 0xf0, 0x9b, 0xb3, 0x56, 0x54, 0xf6, 0x2a, 0xce, 0x39, 0xef, 0xf9, 0x3d, 0xae, 0x12,
-	0xa4, 0xf4, 0xdf, 0x5f, 0x19, 0x4f, 0xbf, 0xc7, 0xaf, 0x08, 0xbf, 0xd2, 0x86, 0x13, 0x36, 0x84,
-	0x3c, 0x64, 0x1c, 0x37, 0xb6, 0x9d, 0xc7, 0xd7, 0x0e, 0x50, 0xde, 0xad, 0xa5, 0x39, 0xda, 0xf7,
-	0x77, 0x84, 0xd7, 0xee, 0x03, 0x3f, 0xa1, 0x7d, 0x22, 0xa1, 0xdc, 0xe3, 0xae, 0x0b, 0xa9, 0x1a,
-	0xa1, 0x9c, 0x77, 0x57, 0x40, 0xd2, 0xd6, 0x93, 0xb3, 0xf0, 0xf4, 0xcc, 0x12, 0x7e, 0x16, 0xb6,
-	0xc7, 0x7d, 0xcf, 0xc2, 0x55, 0x14, 0x6d, 0xfa, 0x37, 0xc2, 0xf1, 0x0c, 0x9a, 0x2f, 0xd1, 0xb2,
-	0xf1, 0x9e, 0xf3, 0xb3, 0x16, 0x61, 0x94, 0xf9, 0xfe, 0x8a, 0x68, 0xc6, 0x01, 0xb5, 0x93, 0xf4,
-	0xa0, 0x3b, 0x48, 0x61, 0xbe, 0xa0, 0x3a, 0x1f, 0x50, 0x6d, 0x61, 0xdf, 0x03, 0xaa, 0x9d, 0xa1,
-	0x1d, 0xff, 0x42, 0xf8, 0x8d, 0xbc, 0x78, 0x36, 0x7b, 0x34, 0xed, 0xea, 0xd7, 0xb8, 0xa8, 0x89,
-	0xf7, 0xbc, 0x4a, 0x70, 0x05, 0x45, 0x59, 0xef, 0xad, 0x06, 0x66, 0x54, 0xc5, 0x4d, 0x10, 0x09,
-	0xa7, 0x87, 0x96, 0x35, 0xe8, 0xba, 0xda, 0x2b, 0x09, 0xbe, 0x55, 0x71, 0x01, 0x48, 0x2b, 0x7f,
-	0x8f, 0xf0, 0xf3, 0x6d, 0xc8, 0x52, 0x9a, 0x10, 0x09, 0x5b, 0x43, 0xe8, 0x4b, 0xf1, 0xe1, 0x95,
-	0xe8, 0xb6, 0x73, 0xc7, 0x14, 0x92, 0x4a, 0xf1, 0xdd, 0x70, 0x80, 0xf1, 0xf5, 0xb3, 0x33, 0xea,
-	0x27, 0x9d, 0x1e, 0xe1, 0xdd, 0xc9, 0x7e, 0x37, 0x10, 0xce, 0x5f, 0x3f, 0x0b, 0x39, 0xdf, 0xaf,
-	0x9f, 0xa5, 0xb8, 0x96, 0xfa, 0x0a, 0xe1, 0xa7, 0x27, 0x7f, 0x55, 0x35, 0x3b, 0xba, 0xe1, 0x81,
-	0x54, 0x21, 0xa5, 0x73, 0x33, 0x28, 0x6b, 0xac, 0x68, 0x35, 0xc6, 0x46, 0x7d, 0xda, 0xf0, 0x9c,
-	0x20, 0xb6, 0xda, 0xd4, 0x5c, 0x8a, 0xa1, 0x1d, 0x7f, 0x40, 0xf8, 0x05, 0xd5, 0x64, 0x76, 0x11,
-	0xb2, 0xc3, 0x84, 0x8c, 0xee, 0x78, 0xe2, 0xe7, 0xb2, 0xca, 0x70, 0x63, 0x19, 0x84, 0x16, 0xfc,
-	0x12, 0x61, 0xdc, 0x4c, 0x99, 0x80, 0xe9, 0x78, 0x47, 0xd7, 0x1c, 0xa1, 0x17, 0x11, 0xa5, 0x73,
-	0x3d, 0x20, 0x69, 0x58, 0xe4, 0x55, 0x7e, 0xba, 0x25, 0x5f, 0xf3, 0x3a, 0x18, 0xcc, 0x6f, 0xc4,
-	0xd7, 0x03, 0x92, 0x46, 0x39, 0x6e, 0x81, 0x54, 0x8b, 0x92, 0xb2, 0xfe, 0x3e, 0x08, 0x41, 0x8e,
-	0x40, 0x38, 0x97, 0x63, 0x7b, 0xdc, 0xb7, 0x1c, 0x57, 0x51, 0x8c, 0x9d, 0xb6, 0x05, 0x72, 0x73,
-	0xef, 0xc0, 0x26, 0xdb, 0x72, 0x7f, 0x8c, 0x9d, 0xe0, 0xbb, 0xd3, 0x2e, 0x00, 0x69, 0xe5, 0x87,
-	0x08, 0x3f, 0x73, 0x30, 0x00, 0x3e, 0x52, 0xdb, 0x71, 0xe4, 0xba, 0xfc, 0x8d, 0x94, 0x52, 0x5b,
-	0x0f, 0x0b, 0x1b, 0x3a, 0x6d, 0x20, 0x59, 0x96, 0x8e, 0xf2, 0xbd, 0xd7, 0x59, 0xc7, 0x48, 0xf9,
-	0xea, 0x14, 0xc2, 0x5a, 0xe7, 0x6b, 0x84, 0x2f, 0xe5, 0xbd, 0xa8, 0x47, 0x71, 0xdd, 0xab, 0xf3,
-	0x8b, 0x43, 0x77, 0x2b, 0x30, 0x6d, 0x5e, 0x34, 0x0e, 0xf8, 0x11, 0xcc, 0x3b, 0x39, 0x5f, 0x34,
-	0x16, 0x82, 0xde, 0x17, 0x8d, 0xa5, 0xbc, 0xe1, 0xb5, 0x0f, 0x81, 0x5e, 0xc5, 0xa0, 0xaf, 0x57,
-	0x39, 0x5f, 0xb8, 0x00, 0x7d, 0xc0, 0x41, 0xf4, 0xe6, 0x4f, 0x77, 0xc2, 0xe3, 0x02, 0xb4, 0x1c,
-	0xf6, 0xbf, 0x00, 0xb5, 0x31, 0x94, 0xe3, 0x46, 0x76, 0x7a, 0x16, 0xd7, 0x1e, 0x9d, 0xc5, 0xb5,
-	0xc7, 0x67, 0x31, 0xfa, 0x62, 0x1c, 0xa3, 0x9f, 0xc7, 0x31, 0xfa, 0x77, 0x1c, 0xa3, 0xd3, 0x71,
-	0x8c, 0xfe, 0x1b, 0xc7, 0xe8, 0xff, 0x71, 0x5c, 0x7b, 0x3c, 0x8e, 0xd1, 0x37, 0xe7, 0x71, 0xed,
-	0xf4, 0x3c, 0xae, 0x3d, 0x3a, 0x8f, 0x6b, 0x1f, 0xdf, 0x38, 0x62, 0x17, 0x8f, 0xa7, 0x6c, 0xe1,
-	0x0f, 0x01, 0x37, 0xcd, 0x4f, 0x0e, 0x9f, 0x9a, 0xfe, 0x0e, 0x70, 0xf5, 0x49, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x3e, 0xd6, 0x58, 0xf3, 0xa3, 0x18, 0x00, 0x00,
+	// 1277 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x99, 0xcd, 0x6b, 0x24, 0xc5,
+	0x1b, 0xc7, 0xa7, 0x2e, 0xbf, 0x43, 0xf1, 0x73, 0xd5, 0xf6, 0x75, 0x57, 0x6d, 0x44, 0x11, 0x3c,
+	0x4d, 0xdc, 0xdd, 0xcb, 0xbe, 0x24, 0xae, 0x9b, 0x49, 0x32, 0xc9, 0x6e, 0xc6, 0x4d, 0x66, 0xc2,
+	0x0a, 0x5e, 0xa4, 0xd2, 0xf3, 0x24, 0x53, 0xa4, 0x33, 0xdd, 0x56, 0xd5, 0x8c, 0xce, 0x4d, 0xf0,
+	0x24, 0x08, 0x8a, 0x20, 0x78, 0x12, 0x04, 0x41, 0x11, 0x04, 0x41, 0x11, 0x04, 0xc1, 0x93, 0xe0,
+	0x49, 0x72, 0xdc, 0xa3, 0x99, 0x5c, 0xbc, 0x08, 0xfb, 0x27, 0xc8, 0x4c, 0x4f, 0x55, 0xa6, 0x7a,
+	0xaa, 0xc7, 0xaa, 0xea, 0xb9, 0xe9, 0x6c, 0x7f, 0x3f, 0xfd, 0xe9, 0xea, 0xa7, 0xeb, 0xa9, 0xaa,
+	0xe0, 0xab, 0x02, 0x8e, 0xd3, 0x84, 0x91, 0x78, 0x89, 0x03, 0xeb, 0x03, 0x5b, 0x22, 0x29, 0x5d,
+	0xea, 0x50, 0x2e, 0x12, 0x36, 0x18, 0xfd, 0x42, 0x23, 0x58, 0xea, 0x5f, 0x5e, 0x9a, 0xfc, 0x67,
+	0x35, 0x65, 0x89, 0x48, 0x82, 0x57, 0x64, 0xa8, 0x9a, 0x85, 0xaa, 0x24, 0xa5, 0x55, 0x3d, 0x54,
+	0xed, 0x5f, 0xbe, 0xb4, 0x6c, 0xc7, 0x66, 0xf0, 0x6e, 0x0f, 0xb8, 0x78, 0x87, 0x01, 0x4f, 0x93,
+	0x2e, 0x9f, 0xdc, 0xe4, 0xca, 0x3f, 0x2b, 0xf8, 0xc2, 0x66, 0x76, 0x71, 0x2b, 0xbb, 0x38, 0xf8,
+	0x06, 0xe1, 0xa7, 0x5b, 0x82, 0x30, 0xf1, 0x56, 0xc2, 0x8e, 0x0e, 0xe2, 0xe4, 0xbd, 0xf5, 0xf7,
+	0x21, 0xea, 0x09, 0x9a, 0x74, 0x83, 0xb5, 0xaa, 0x95, 0x53, 0xd5, 0x1c, 0x6f, 0x66, 0x0a, 0x97,
+	0xd6, 0x4b, 0x52, 0xb2, 0x07, 0x78, 0xa9, 0x12, 0x7c, 0x86, 0xf0, 0xa3, 0x75, 0x10, 0x8d, 0x9e,
+	0x20, 0xfb, 0x31, 0xb4, 0x04, 0x11, 0x10, 0xac, 0x58, 0xc2, 0x73, 0x39, 0xe9, 0xf6, 0xba, 0x6f,
+	0x5c, 0x49, 0x7d, 0x8e, 0xf0, 0x63, 0x3b, 0x49, 0x1c, 0x6b, 0x56, 0xb6, 0xd8, 0x7c, 0x50, 0x6a,
+	0xdd, 0xf2, 0xce, 0x2b, 0xaf, 0xaf, 0x10, 0x7e, 0xb2, 0x09, 0x1c, 0x44, 0x4b, 0xd0, 0xe8, 0x68,
+	0xb0, 0x47, 0xf8, 0xd1, 0x6e, 0x0f, 0x7a, 0x10, 0xac, 0x5a, 0xb2, 0x4d, 0x61, 0xe9, 0x57, 0x2b,
+	0xc5, 0x50, 0x8e, 0x3f, 0x20, 0x7c, 0xb1, 0x09, 0x51, 0xc2, 0xda, 0xf2, 0xb5, 0x8f, 0xae, 0x1a,
+	0xd7, 0x01, 0xb4, 0x83, 0xba, 0xf5, 0x4d, 0x0a, 0x08, 0xd2, 0x76, 0xb3, 0x3c, 0xc8, 0xa0, 0x7c,
+	0x3b, 0x12, 0xb4, 0x4f, 0xc5, 0xc0, 0x5f, 0xd9, 0x40, 0xf0, 0x53, 0x36, 0x82, 0x94, 0xf2, 0x2f,
+	0x08, 0x3f, 0x9f, 0xfd, 0xaf, 0xf6, 0x6c, 0xb5, 0xe4, 0x38, 0x8d, 0x61, 0x64, 0x7d, 0xc7, 0xfe,
+	0x6d, 0x16, 0x42, 0xa4, 0xf8, 0xdd, 0x85, 0xb0, 0x72, 0xc3, 0x3d, 0x73, 0xe9, 0x06, 0xa1, 0xb1,
+	0xd3, 0x70, 0x17, 0x10, 0xdc, 0x87, 0xbb, 0x10, 0xa4, 0x94, 0x7f, 0x46, 0xf8, 0xb9, 0xd9, 0x4a,
+	0xda, 0x04, 0xc2, 0xc4, 0x3e, 0x10, 0x11, 0x6c, 0x79, 0x57, 0xa3, 0x62, 0x48, 0xed, 0x3b, 0x8b,
+	0x40, 0x19, 0xc4, 0xa7, 0xeb, 0xc9, 0x57, 0xdc, 0xc8, 0xf0, 0x13, 0x2f, 0x40, 0x99, 0x0a, 0x7c,
+	0xfa, 0x52, 0xef, 0x02, 0x37, 0x42, 0x3c, 0x0b, 0xbc, 0x80, 0x65, 0x2a, 0xf0, 0xe9, 0x4b, 0xfd,
+	0x0a, 0x7c, 0x96, 0xe0, 0x59, 0xe0, 0x26, 0x50, 0xae, 0x4e, 0x66, 0x9f, 0x8e, 0x74, 0x23, 0x18,
+	0x49, 0x6f, 0x95, 0x18, 0xa1, 0x09, 0xc3, 0xbd, 0x4e, 0xe6, 0xa0, 0x94, 0xf8, 0x77, 0x08, 0x3f,
+	0xd3, 0xa2, 0x87, 0x5d, 0x12, 0xcf, 0x2e, 0x75, 0xac, 0x17, 0x29, 0xe6, 0xbc, 0x14, 0xde, 0x28,
+	0x8b, 0x51, 0xb2, 0xbf, 0x23, 0xfc, 0xe2, 0xe4, 0x2a, 0x2a, 0x3a, 0x05, 0x0b, 0xb4, 0x37, 0xdd,
+	0x6e, 0x57, 0x08, 0x92, 0xfa, 0xf7, 0x16, 0xc6, 0x53, 0xcf, 0xf1, 0x35, 0xc2, 0x4f, 0x65, 0xbf,
+	0x43, 0xa3, 0x17, 0x0b, 0x7a, 0x2f, 0x05, 0x46, 0xc6, 0xf2, 0xb6, 0x8b, 0x08, 0x63, 0x5a, 0x1a,
+	0xaf, 0x95, 0x83, 0x28, 0xcd, 0xef, 0x11, 0x7e, 0xb6, 0x09, 0xc7, 0x49, 0x1f, 0xb2, 0x67, 0xd3,
+	0x96, 0x73, 0x1b, 0xd6, 0x65, 0x68, 0x06, 0x48, 0xd9, 0x7a, 0x69, 0x8e, 0xf2, 0xfd, 0x11, 0xe1,
+	0x4b, 0x7b, 0xc0, 0x8e, 0x69, 0x97, 0x08, 0x98, 0x2d, 0x0c, 0xdb, 0xef, 0xbd, 0x18, 0x21, 0x9d,
+	0xb7, 0x16, 0x40, 0x52, 0xd6, 0xa3, 0xbd, 0xc6, 0x78, 0x4d, 0xe8, 0xbf, 0xd7, 0x30, 0xc7, 0x5d,
+	0xf7, 0x1a, 0x45, 0x14, 0x65, 0xfa, 0x1b, 0xc2, 0xe1, 0x04, 0x9a, 0xcd, 0x24, 0xb3, 0xc6, 0xdb,
+	0xd6, 0xf7, 0x9a, 0x87, 0x91, 0xe6, 0x8d, 0x05, 0xd1, 0xb4, 0x0d, 0x40, 0x2b, 0xea, 0x40, 0xbb,
+	0x17, 0xc3, 0x74, 0xeb, 0xb7, 0xde, 0x00, 0x98, 0xc2, 0xae, 0x1b, 0x00, 0x33, 0x43, 0x39, 0xfe,
+	0x8a, 0xf0, 0x0b, 0x59, 0x8f, 0xaf, 0x75, 0x68, 0xdc, 0x56, 0x8f, 0x71, 0xde, 0xba, 0xef, 0x3a,
+	0xad, 0x14, 0x0a, 0x28, 0xd2, 0x7a, 0x7b, 0x31, 0x30, 0xad, 0x79, 0xaf, 0x01, 0x8f, 0x18, 0xdd,
+	0x37, 0x7c, 0x83, 0xb6, 0x5f, 0x7b, 0x21, 0xc1, 0xb5, 0x79, 0xcf, 0x01, 0x29, 0xe5, 0x2f, 0x10,
+	0x7e, 0xbc, 0x09, 0x69, 0x4c, 0x23, 0x22, 0x60, 0xbd, 0x0f, 0x5d, 0xc1, 0xef, 0x5f, 0x09, 0x6e,
+	0x59, 0x0f, 0x4c, 0x2e, 0x29, 0x15, 0xdf, 0xf0, 0x07, 0x68, 0xdb, 0xfb, 0xd6, 0xa0, 0x1b, 0xb5,
+	0x3a, 0x84, 0xb5, 0x47, 0xf3, 0x5d, 0x8f, 0x5b, 0x6f, 0xef, 0x73, 0x39, 0xd7, 0xed, 0xfd, 0x4c,
+	0x5c, 0x49, 0x7d, 0x84, 0xf0, 0xff, 0x47, 0xff, 0x2a, 0x97, 0x16, 0xc1, 0x0d, 0x07, 0xa4, 0x0c,
+	0x49, 0x9d, 0x9b, 0x5e, 0x59, 0xed, 0x8b, 0x96, 0xef, 0x58, 0xeb, 0x4f, 0xab, 0x8e, 0x05, 0x62,
+	0xea, 0x4d, 0xb5, 0x52, 0x0c, 0xe5, 0xf8, 0x25, 0xc2, 0x4f, 0xc8, 0x4b, 0x26, 0x07, 0x4d, 0x9b,
+	0x09, 0x17, 0xc1, 0x6d, 0x47, 0xfc, 0x54, 0x56, 0x1a, 0xae, 0x96, 0x41, 0x28, 0xc1, 0x0f, 0x11,
+	0xc6, 0xb5, 0x38, 0xe1, 0x30, 0x7e, 0xdf, 0xc1, 0x35, 0x4b, 0xe8, 0x79, 0x44, 0xea, 0x5c, 0xf7,
+	0x48, 0x6a, 0x16, 0x59, 0x97, 0x1f, 0x4f, 0xc9, 0xd7, 0x9c, 0x16, 0x06, 0xd3, 0x13, 0xf1, 0x75,
+	0x8f, 0xa4, 0xd6, 0x8e, 0xeb, 0x20, 0xe4, 0x47, 0x49, 0x93, 0x6e, 0x03, 0x38, 0x27, 0x87, 0xc0,
+	0xad, 0xdb, 0xb1, 0x39, 0xee, 0xda, 0x8e, 0x8b, 0x28, 0xca, 0xf4, 0x27, 0x84, 0x2f, 0xb6, 0x04,
+	0x03, 0x72, 0x6c, 0x92, 0xad, 0x5b, 0x9f, 0x30, 0x16, 0x10, 0x5c, 0x67, 0xda, 0x39, 0x20, 0xa9,
+	0xfc, 0x2a, 0x7a, 0x0d, 0x8d, 0x1b, 0x44, 0x1d, 0xc4, 0xda, 0xf6, 0x6e, 0x19, 0xed, 0x42, 0x82,
+	0xab, 0xf6, 0x1c, 0x90, 0x1a, 0xe9, 0x8f, 0x11, 0x7e, 0x64, 0xb7, 0x07, 0x6c, 0x20, 0xbb, 0x48,
+	0x60, 0x3b, 0x6b, 0x69, 0x29, 0xa9, 0xb6, 0xec, 0x17, 0xd6, 0x74, 0x9a, 0x40, 0xd2, 0x34, 0x1e,
+	0x64, 0x2d, 0xc3, 0x5a, 0x47, 0x4b, 0xb9, 0xea, 0xe4, 0xc2, 0x4a, 0xe7, 0x13, 0x84, 0x2f, 0x64,
+	0xa3, 0xa8, 0xde, 0xe2, 0xb2, 0xd3, 0xe0, 0xe7, 0x5f, 0xdd, 0x8a, 0x67, 0x5a, 0x3f, 0x7f, 0xee,
+	0xb1, 0x43, 0x98, 0x76, 0xb2, 0x3e, 0x7f, 0xce, 0x05, 0x9d, 0xcf, 0x9f, 0x67, 0xf2, 0x9a, 0x57,
+	0x03, 0x3c, 0xbd, 0x1a, 0x50, 0xce, 0xab, 0x01, 0x85, 0x5e, 0xd9, 0xb9, 0xf8, 0x01, 0x03, 0xde,
+	0x99, 0x5e, 0x94, 0x72, 0x87, 0x73, 0xf1, 0xd9, 0xb0, 0xfb, 0xb9, 0xb8, 0x89, 0xa1, 0x39, 0xea,
+	0x53, 0xe2, 0x64, 0x39, 0xb4, 0xea, 0x35, 0x9f, 0xea, 0x6b, 0xa2, 0x5a, 0x29, 0x86, 0x72, 0xfc,
+	0x13, 0xe1, 0x97, 0xeb, 0xd0, 0x05, 0x46, 0x04, 0x6c, 0x13, 0x2e, 0x26, 0xdd, 0x76, 0x2a, 0x92,
+	0x0d, 0xeb, 0xae, 0xf5, 0xed, 0xfe, 0x93, 0x25, 0x9f, 0xa0, 0xb9, 0x48, 0xa4, 0xd6, 0x0c, 0x47,
+	0x8b, 0x7c, 0x12, 0xa9, 0x8d, 0xe1, 0x24, 0x64, 0xdd, 0x0c, 0xcd, 0x71, 0xd7, 0x66, 0x58, 0x44,
+	0xd1, 0xca, 0x63, 0x9b, 0x72, 0xb1, 0xd7, 0x61, 0x89, 0x10, 0x31, 0xb4, 0x6b, 0x24, 0x8e, 0x81,
+	0xd9, 0x97, 0x87, 0x29, 0xec, 0x5a, 0x1e, 0x66, 0x86, 0x36, 0x6f, 0xd7, 0x41, 0xa8, 0x45, 0xb5,
+	0xfd, 0xbc, 0xad, 0xa5, 0x5c, 0xe7, 0xed, 0x5c, 0x38, 0x3f, 0x6f, 0x8f, 0xd6, 0x82, 0x3b, 0x2c,
+	0x39, 0xa0, 0x31, 0xb8, 0xcc, 0xdb, 0x53, 0x31, 0x8f, 0x79, 0x5b, 0x4b, 0x6b, 0xa7, 0xa8, 0x72,
+	0xa5, 0x7a, 0x9f, 0x72, 0xba, 0x4f, 0x63, 0x2a, 0x06, 0x3b, 0x2c, 0x89, 0x80, 0xf3, 0x84, 0x59,
+	0x9f, 0xa2, 0xce, 0x61, 0xb8, 0x9e, 0xa2, 0xce, 0x45, 0x69, 0xa7, 0xa8, 0x6b, 0x10, 0x83, 0xe9,
+	0xd8, 0x69, 0xdd, 0xfa, 0x4e, 0xc6, 0xbc, 0xeb, 0x29, 0x6a, 0x21, 0x46, 0xca, 0xae, 0xa6, 0x27,
+	0xa7, 0x61, 0xe5, 0xc1, 0x69, 0x58, 0x79, 0x78, 0x1a, 0xa2, 0x0f, 0x86, 0x21, 0xfa, 0x76, 0x18,
+	0xa2, 0x3f, 0x86, 0x21, 0x3a, 0x19, 0x86, 0xe8, 0xaf, 0x61, 0x88, 0xfe, 0x1e, 0x86, 0x95, 0x87,
+	0xc3, 0x10, 0x7d, 0x7a, 0x16, 0x56, 0x4e, 0xce, 0xc2, 0xca, 0x83, 0xb3, 0xb0, 0xf2, 0xf6, 0x8d,
+	0xc3, 0xe4, 0xdc, 0x80, 0x26, 0x73, 0xff, 0xd2, 0x7e, 0x53, 0xff, 0x65, 0xff, 0x7f, 0xe3, 0x3f,
+	0xb4, 0x5f, 0xfd, 0x77, 0x00, 0xc7, 0xa0, 0x29, 0x26, 0x04, 0x20, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -170,6 +185,9 @@ type HistoryServiceClient interface {
 	// WorkflowTaskFailedEvent written to the history and a new WorkflowTask created.  This API can be used by client to
 	// either clear sticky task queue or report ny panics during WorkflowTask processing.
 	RespondWorkflowTaskFailed(ctx context.Context, in *RespondWorkflowTaskFailedRequest, opts ...grpc.CallOption) (*RespondWorkflowTaskFailedResponse, error)
+	// RecordWorkflowTaskHeartbeat is called by worker processing long running workflow task (e.g. replay of large history)
+	// to extend start to close timeout of the workflow task without completing it.
+	RecordWorkflowTaskHeartbeat(ctx context.Context, in *RecordWorkflowTaskHeartbeatRequest, opts ...grpc.CallOption) (*RecordWorkflowTaskHeartbeatResponse, error)
 	// RecordActivityTaskHeartbeat is called by application worker while it is processing an ActivityTask.  If worker fails
 	// to heartbeat within 'heartbeatTimeoutSeconds' interval for the ActivityTask, then it will be marked as timedout and
 	// 'ActivityTaskTimedOut' event will be written to the workflow history.  Calling 'RecordActivityTaskHeartbeat' will
@@ -206,6 +224,9 @@ type HistoryServiceClient interface {
 	// and record WorkflowExecutionStarted and WorkflowExecutionSignaled event in case of success.
 	// It will return `WorkflowExecutionAlreadyStartedError` if start workflow failed with given policy.
 	SignalWithStartWorkflowExecution(ctx context.Context, in *SignalWithStartWorkflowExecutionRequest, opts ...grpc.CallOption) (*SignalWithStartWorkflowExecutionResponse, error)
+	// ExecuteMultiOperation starts a workflow execution if it is not running and delivers all signals to it
+	// in the same transaction, so the signals are never observed by a run without them being applied.
+	ExecuteMultiOperation(ctx context.Context, in *ExecuteMultiOperationRequest, opts ...grpc.CallOption) (*ExecuteMultiOperationResponse, error)
 	// RemoveSignalMutableState is used to remove a signal request Id that was previously recorded.  This is currently
 	// used to clean execution info when signal workflow task finished.
 	RemoveSignalMutableState(ctx context.Context, in *RemoveSignalMutableStateRequest, opts ...grpc.CallOption) (*RemoveSignalMutableStateResponse, error)
@@ -246,6 +267,10 @@ type HistoryServiceClient interface {
 	RemoveTask(ctx context.Context, in *RemoveTaskRequest, opts ...grpc.CallOption) (*RemoveTaskResponse, error)
 	// GetReplicationMessages return replication messages based on the read level
 	GetReplicationMessages(ctx context.Context, in *GetReplicationMessagesRequest, opts ...grpc.CallOption) (*GetReplicationMessagesResponse, error)
+	// StreamReplicationMessages pushes replication tasks of a single shard to a remote cluster as soon as they are available.
+	// Every request acks processed tasks and grants the server credit to send the next batch.
+	// Shard is identified by the replication-shard-id header of the stream.
+	StreamReplicationMessages(ctx context.Context, opts ...grpc.CallOption) (HistoryService_StreamReplicationMessagesClient, error)
 	// GetDLQReplicationMessages return replication messages based on dlq info
 	GetDLQReplicationMessages(ctx context.Context, in *GetDLQReplicationMessagesRequest, opts ...grpc.CallOption) (*GetDLQReplicationMessagesResponse, error)
 	// QueryWorkflow returns query result for a specified workflow execution.
@@ -262,6 +287,25 @@ type HistoryServiceClient interface {
 	MergeDLQMessages(ctx context.Context, in *MergeDLQMessagesRequest, opts ...grpc.CallOption) (*MergeDLQMessagesResponse, error)
 	// RefreshWorkflowTasks refreshes all tasks of a workflow.
 	RefreshWorkflowTasks(ctx context.Context, in *RefreshWorkflowTasksRequest, opts ...grpc.CallOption) (*RefreshWorkflowTasksResponse, error)
+	// GetReplicationStatus returns replication progress of requested shards towards remote clusters.
+	GetReplicationStatus(ctx context.Context, in *GetReplicationStatusRequest, opts ...grpc.CallOption) (*GetReplicationStatusResponse, error)
+	// GenerateLastHistoryReplicationTasks generates a replication task for the last history event batch of a workflow.
+	// Remote clusters receiving the task resend the full workflow history, which is used to force-replicate workflows.
+	GenerateLastHistoryReplicationTasks(ctx context.Context, in *GenerateLastHistoryReplicationTasksRequest, opts ...grpc.CallOption) (*GenerateLastHistoryReplicationTasksResponse, error)
+	// CompactWorkflowHistory rewrites the history event batches of a workflow into fewer, larger batches.
+	CompactWorkflowHistory(ctx context.Context, in *CompactWorkflowHistoryRequest, opts ...grpc.CallOption) (*CompactWorkflowHistoryResponse, error)
+	// ListThrottledCallers returns the callers currently being throttled by the rate limiters of a history host.
+	ListThrottledCallers(ctx context.Context, in *ListThrottledCallersRequest, opts ...grpc.CallOption) (*ListThrottledCallersResponse, error)
+	// GetShardStats returns ack levels, backlog and lock contention of the shards owned by a history host.
+	GetShardStats(ctx context.Context, in *GetShardStatsRequest, opts ...grpc.CallOption) (*GetShardStatsResponse, error)
+	// GetHostProfile captures a pprof profile of a history host.
+	GetHostProfile(ctx context.Context, in *GetHostProfileRequest, opts ...grpc.CallOption) (*GetHostProfileResponse, error)
+	// DescribeVisibilityProcessor returns queue depth, in-flight bulks, pending acks and last commit error
+	// of the Elasticsearch bulk processor of a history host, and optionally flushes it.
+	DescribeVisibilityProcessor(ctx context.Context, in *DescribeVisibilityProcessorRequest, opts ...grpc.CallOption) (*DescribeVisibilityProcessorResponse, error)
+	// DeleteWorkflowExecution deletes mutable state, history and visibility record of a workflow execution
+	// regardless of its state. It is used to reclaim resources of deleted namespaces.
+	DeleteWorkflowExecution(ctx context.Context, in *DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*DeleteWorkflowExecutionResponse, error)
 }
 
 type historyServiceClient struct {
@@ -344,6 +388,15 @@ func (c *historyServiceClient) RespondWorkflowTaskFailed(ctx context.Context, in
 	return out, nil
 }
 
+func (c *historyServiceClient) RecordWorkflowTaskHeartbeat(ctx context.Context, in *RecordWorkflowTaskHeartbeatRequest, opts ...grpc.CallOption) (*RecordWorkflowTaskHeartbeatResponse, error) {
+	out := new(RecordWorkflowTaskHeartbeatResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/RecordWorkflowTaskHeartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *historyServiceClient) RecordActivityTaskHeartbeat(ctx context.Context, in *RecordActivityTaskHeartbeatRequest, opts ...grpc.CallOption) (*RecordActivityTaskHeartbeatResponse, error) {
 	out := new(RecordActivityTaskHeartbeatResponse)
 	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/RecordActivityTaskHeartbeat", in, out, opts...)
@@ -398,6 +451,15 @@ func (c *historyServiceClient) SignalWithStartWorkflowExecution(ctx context.Cont
 	return out, nil
 }
 
+func (c *historyServiceClient) ExecuteMultiOperation(ctx context.Context, in *ExecuteMultiOperationRequest, opts ...grpc.CallOption) (*ExecuteMultiOperationResponse, error) {
+	out := new(ExecuteMultiOperationResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/ExecuteMultiOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *historyServiceClient) RemoveSignalMutableState(ctx context.Context, in *RemoveSignalMutableStateRequest, opts ...grpc.CallOption) (*RemoveSignalMutableStateResponse, error) {
 	out := new(RemoveSignalMutableStateResponse)
 	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/RemoveSignalMutableState", in, out, opts...)
@@ -533,6 +595,37 @@ func (c *historyServiceClient) GetReplicationMessages(ctx context.Context, in *G
 	return out, nil
 }
 
+func (c *historyServiceClient) StreamReplicationMessages(ctx context.Context, opts ...grpc.CallOption) (HistoryService_StreamReplicationMessagesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_HistoryService_serviceDesc.Streams[0], "/temporal.server.api.historyservice.v1.HistoryService/StreamReplicationMessages", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &historyServiceStreamReplicationMessagesClient{stream}
+	return x, nil
+}
+
+type HistoryService_StreamReplicationMessagesClient interface {
+	Send(*StreamReplicationMessagesRequest) error
+	Recv() (*StreamReplicationMessagesResponse, error)
+	grpc.ClientStream
+}
+
+type historyServiceStreamReplicationMessagesClient struct {
+	grpc.ClientStream
+}
+
+func (x *historyServiceStreamReplicationMessagesClient) Send(m *StreamReplicationMessagesRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *historyServiceStreamReplicationMessagesClient) Recv() (*StreamReplicationMessagesResponse, error) {
+	m := new(StreamReplicationMessagesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *historyServiceClient) GetDLQReplicationMessages(ctx context.Context, in *GetDLQReplicationMessagesRequest, opts ...grpc.CallOption) (*GetDLQReplicationMessagesResponse, error) {
 	out := new(GetDLQReplicationMessagesResponse)
 	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/GetDLQReplicationMessages", in, out, opts...)
@@ -596,6 +689,78 @@ func (c *historyServiceClient) RefreshWorkflowTasks(ctx context.Context, in *Ref
 	return out, nil
 }
 
+func (c *historyServiceClient) GetReplicationStatus(ctx context.Context, in *GetReplicationStatusRequest, opts ...grpc.CallOption) (*GetReplicationStatusResponse, error) {
+	out := new(GetReplicationStatusResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/GetReplicationStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) GenerateLastHistoryReplicationTasks(ctx context.Context, in *GenerateLastHistoryReplicationTasksRequest, opts ...grpc.CallOption) (*GenerateLastHistoryReplicationTasksResponse, error) {
+	out := new(GenerateLastHistoryReplicationTasksResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/GenerateLastHistoryReplicationTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) CompactWorkflowHistory(ctx context.Context, in *CompactWorkflowHistoryRequest, opts ...grpc.CallOption) (*CompactWorkflowHistoryResponse, error) {
+	out := new(CompactWorkflowHistoryResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/CompactWorkflowHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) ListThrottledCallers(ctx context.Context, in *ListThrottledCallersRequest, opts ...grpc.CallOption) (*ListThrottledCallersResponse, error) {
+	out := new(ListThrottledCallersResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/ListThrottledCallers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) GetShardStats(ctx context.Context, in *GetShardStatsRequest, opts ...grpc.CallOption) (*GetShardStatsResponse, error) {
+	out := new(GetShardStatsResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/GetShardStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) GetHostProfile(ctx context.Context, in *GetHostProfileRequest, opts ...grpc.CallOption) (*GetHostProfileResponse, error) {
+	out := new(GetHostProfileResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/GetHostProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) DescribeVisibilityProcessor(ctx context.Context, in *DescribeVisibilityProcessorRequest, opts ...grpc.CallOption) (*DescribeVisibilityProcessorResponse, error) {
+	out := new(DescribeVisibilityProcessorResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/DescribeVisibilityProcessor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) DeleteWorkflowExecution(ctx context.Context, in *DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*DeleteWorkflowExecutionResponse, error) {
+	out := new(DeleteWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/DeleteWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HistoryServiceServer is the server API for HistoryService service.
 type HistoryServiceServer interface {
 	// StartWorkflowExecution starts a new long running workflow instance.  It will create the instance with
@@ -634,6 +799,9 @@ type HistoryServiceServer interface {
 	// WorkflowTaskFailedEvent written to the history and a new WorkflowTask created.  This API can be used by client to
 	// either clear sticky task queue or report ny panics during WorkflowTask processing.
 	RespondWorkflowTaskFailed(context.Context, *RespondWorkflowTaskFailedRequest) (*RespondWorkflowTaskFailedResponse, error)
+	// RecordWorkflowTaskHeartbeat is called by worker processing long running workflow task (e.g. replay of large history)
+	// to extend start to close timeout of the workflow task without completing it.
+	RecordWorkflowTaskHeartbeat(context.Context, *RecordWorkflowTaskHeartbeatRequest) (*RecordWorkflowTaskHeartbeatResponse, error)
 	// RecordActivityTaskHeartbeat is called by application worker while it is processing an ActivityTask.  If worker fails
 	// to heartbeat within 'heartbeatTimeoutSeconds' interval for the ActivityTask, then it will be marked as timedout and
 	// 'ActivityTaskTimedOut' event will be written to the workflow history.  Calling 'RecordActivityTaskHeartbeat' will
@@ -670,6 +838,9 @@ type HistoryServiceServer interface {
 	// and record WorkflowExecutionStarted and WorkflowExecutionSignaled event in case of success.
 	// It will return `WorkflowExecutionAlreadyStartedError` if start workflow failed with given policy.
 	SignalWithStartWorkflowExecution(context.Context, *SignalWithStartWorkflowExecutionRequest) (*SignalWithStartWorkflowExecutionResponse, error)
+	// ExecuteMultiOperation starts a workflow execution if it is not running and delivers all signals to it
+	// in the same transaction, so the signals are never observed by a run without them being applied.
+	ExecuteMultiOperation(context.Context, *ExecuteMultiOperationRequest) (*ExecuteMultiOperationResponse, error)
 	// RemoveSignalMutableState is used to remove a signal request Id that was previously recorded.  This is currently
 	// used to clean execution info when signal workflow task finished.
 	RemoveSignalMutableState(context.Context, *RemoveSignalMutableStateRequest) (*RemoveSignalMutableStateResponse, error)
@@ -710,6 +881,10 @@ type HistoryServiceServer interface {
 	RemoveTask(context.Context, *RemoveTaskRequest) (*RemoveTaskResponse, error)
 	// GetReplicationMessages return replication messages based on the read level
 	GetReplicationMessages(context.Context, *GetReplicationMessagesRequest) (*GetReplicationMessagesResponse, error)
+	// StreamReplicationMessages pushes replication tasks of a single shard to a remote cluster as soon as they are available.
+	// Every request acks processed tasks and grants the server credit to send the next batch.
+	// Shard is identified by the replication-shard-id header of the stream.
+	StreamReplicationMessages(HistoryService_StreamReplicationMessagesServer) error
 	// GetDLQReplicationMessages return replication messages based on dlq info
 	GetDLQReplicationMessages(context.Context, *GetDLQReplicationMessagesRequest) (*GetDLQReplicationMessagesResponse, error)
 	// QueryWorkflow returns query result for a specified workflow execution.
@@ -726,6 +901,25 @@ type HistoryServiceServer interface {
 	MergeDLQMessages(context.Context, *MergeDLQMessagesRequest) (*MergeDLQMessagesResponse, error)
 	// RefreshWorkflowTasks refreshes all tasks of a workflow.
 	RefreshWorkflowTasks(context.Context, *RefreshWorkflowTasksRequest) (*RefreshWorkflowTasksResponse, error)
+	// GetReplicationStatus returns replication progress of requested shards towards remote clusters.
+	GetReplicationStatus(context.Context, *GetReplicationStatusRequest) (*GetReplicationStatusResponse, error)
+	// GenerateLastHistoryReplicationTasks generates a replication task for the last history event batch of a workflow.
+	// Remote clusters receiving the task resend the full workflow history, which is used to force-replicate workflows.
+	GenerateLastHistoryReplicationTasks(context.Context, *GenerateLastHistoryReplicationTasksRequest) (*GenerateLastHistoryReplicationTasksResponse, error)
+	// CompactWorkflowHistory rewrites the history event batches of a workflow into fewer, larger batches.
+	CompactWorkflowHistory(context.Context, *CompactWorkflowHistoryRequest) (*CompactWorkflowHistoryResponse, error)
+	// ListThrottledCallers returns the callers currently being throttled by the rate limiters of a history host.
+	ListThrottledCallers(context.Context, *ListThrottledCallersRequest) (*ListThrottledCallersResponse, error)
+	// GetShardStats returns ack levels, backlog and lock contention of the shards owned by a history host.
+	GetShardStats(context.Context, *GetShardStatsRequest) (*GetShardStatsResponse, error)
+	// GetHostProfile captures a pprof profile of a history host.
+	GetHostProfile(context.Context, *GetHostProfileRequest) (*GetHostProfileResponse, error)
+	// DescribeVisibilityProcessor returns queue depth, in-flight bulks, pending acks and last commit error
+	// of the Elasticsearch bulk processor of a history host, and optionally flushes it.
+	DescribeVisibilityProcessor(context.Context, *DescribeVisibilityProcessorRequest) (*DescribeVisibilityProcessorResponse, error)
+	// DeleteWorkflowExecution deletes mutable state, history and visibility record of a workflow execution
+	// regardless of its state. It is used to reclaim resources of deleted namespaces.
+	DeleteWorkflowExecution(context.Context, *DeleteWorkflowExecutionRequest) (*DeleteWorkflowExecutionResponse, error)
 }
 
 // UnimplementedHistoryServiceServer can be embedded to have forward compatible implementations.
@@ -756,6 +950,9 @@ func (*UnimplementedHistoryServiceServer) RespondWorkflowTaskCompleted(ctx conte
 func (*UnimplementedHistoryServiceServer) RespondWorkflowTaskFailed(ctx context.Context, req *RespondWorkflowTaskFailedRequest) (*RespondWorkflowTaskFailedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RespondWorkflowTaskFailed not implemented")
 }
+func (*UnimplementedHistoryServiceServer) RecordWorkflowTaskHeartbeat(ctx context.Context, req *RecordWorkflowTaskHeartbeatRequest) (*RecordWorkflowTaskHeartbeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordWorkflowTaskHeartbeat not implemented")
+}
 func (*UnimplementedHistoryServiceServer) RecordActivityTaskHeartbeat(ctx context.Context, req *RecordActivityTaskHeartbeatRequest) (*RecordActivityTaskHeartbeatResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecordActivityTaskHeartbeat not implemented")
 }
@@ -774,6 +971,9 @@ func (*UnimplementedHistoryServiceServer) SignalWorkflowExecution(ctx context.Co
 func (*UnimplementedHistoryServiceServer) SignalWithStartWorkflowExecution(ctx context.Context, req *SignalWithStartWorkflowExecutionRequest) (*SignalWithStartWorkflowExecutionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SignalWithStartWorkflowExecution not implemented")
 }
+func (*UnimplementedHistoryServiceServer) ExecuteMultiOperation(ctx context.Context, req *ExecuteMultiOperationRequest) (*ExecuteMultiOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteMultiOperation not implemented")
+}
 func (*UnimplementedHistoryServiceServer) RemoveSignalMutableState(ctx context.Context, req *RemoveSignalMutableStateRequest) (*RemoveSignalMutableStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveSignalMutableState not implemented")
 }
@@ -819,6 +1019,9 @@ func (*UnimplementedHistoryServiceServer) RemoveTask(ctx context.Context, req *R
 func (*UnimplementedHistoryServiceServer) GetReplicationMessages(ctx context.Context, req *GetReplicationMessagesRequest) (*GetReplicationMessagesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetReplicationMessages not implemented")
 }
+func (*UnimplementedHistoryServiceServer) StreamReplicationMessages(srv HistoryService_StreamReplicationMessagesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamReplicationMessages not implemented")
+}
 func (*UnimplementedHistoryServiceServer) GetDLQReplicationMessages(ctx context.Context, req *GetDLQReplicationMessagesRequest) (*GetDLQReplicationMessagesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDLQReplicationMessages not implemented")
 }
@@ -840,6 +1043,30 @@ func (*UnimplementedHistoryServiceServer) MergeDLQMessages(ctx context.Context,
 func (*UnimplementedHistoryServiceServer) RefreshWorkflowTasks(ctx context.Context, req *RefreshWorkflowTasksRequest) (*RefreshWorkflowTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RefreshWorkflowTasks not implemented")
 }
+func (*UnimplementedHistoryServiceServer) GetReplicationStatus(ctx context.Context, req *GetReplicationStatusRequest) (*GetReplicationStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReplicationStatus not implemented")
+}
+func (*UnimplementedHistoryServiceServer) GenerateLastHistoryReplicationTasks(ctx context.Context, req *GenerateLastHistoryReplicationTasksRequest) (*GenerateLastHistoryReplicationTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateLastHistoryReplicationTasks not implemented")
+}
+func (*UnimplementedHistoryServiceServer) CompactWorkflowHistory(ctx context.Context, req *CompactWorkflowHistoryRequest) (*CompactWorkflowHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompactWorkflowHistory not implemented")
+}
+func (*UnimplementedHistoryServiceServer) ListThrottledCallers(ctx context.Context, req *ListThrottledCallersRequest) (*ListThrottledCallersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListThrottledCallers not implemented")
+}
+func (*UnimplementedHistoryServiceServer) GetShardStats(ctx context.Context, req *GetShardStatsRequest) (*GetShardStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShardStats not implemented")
+}
+func (*UnimplementedHistoryServiceServer) GetHostProfile(ctx context.Context, req *GetHostProfileRequest) (*GetHostProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHostProfile not implemented")
+}
+func (*UnimplementedHistoryServiceServer) DescribeVisibilityProcessor(ctx context.Context, req *DescribeVisibilityProcessorRequest) (*DescribeVisibilityProcessorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeVisibilityProcessor not implemented")
+}
+func (*UnimplementedHistoryServiceServer) DeleteWorkflowExecution(ctx context.Context, req *DeleteWorkflowExecutionRequest) (*DeleteWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkflowExecution not implemented")
+}
 
 func RegisterHistoryServiceServer(s *grpc.Server, srv HistoryServiceServer) {
 	s.RegisterService(&_HistoryService_serviceDesc, srv)
@@ -989,6 +1216,24 @@ func _HistoryService_RespondWorkflowTaskFailed_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_RecordWorkflowTaskHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordWorkflowTaskHeartbeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).RecordWorkflowTaskHeartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/RecordWorkflowTaskHeartbeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).RecordWorkflowTaskHeartbeat(ctx, req.(*RecordWorkflowTaskHeartbeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HistoryService_RecordActivityTaskHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RecordActivityTaskHeartbeatRequest)
 	if err := dec(in); err != nil {
@@ -1097,6 +1342,24 @@ func _HistoryService_SignalWithStartWorkflowExecution_Handler(srv interface{}, c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_ExecuteMultiOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteMultiOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).ExecuteMultiOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/ExecuteMultiOperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).ExecuteMultiOperation(ctx, req.(*ExecuteMultiOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HistoryService_RemoveSignalMutableState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RemoveSignalMutableStateRequest)
 	if err := dec(in); err != nil {
@@ -1367,6 +1630,32 @@ func _HistoryService_GetReplicationMessages_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_StreamReplicationMessages_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(HistoryServiceServer).StreamReplicationMessages(&historyServiceStreamReplicationMessagesServer{stream})
+}
+
+type HistoryService_StreamReplicationMessagesServer interface {
+	Send(*StreamReplicationMessagesResponse) error
+	Recv() (*StreamReplicationMessagesRequest, error)
+	grpc.ServerStream
+}
+
+type historyServiceStreamReplicationMessagesServer struct {
+	grpc.ServerStream
+}
+
+func (x *historyServiceStreamReplicationMessagesServer) Send(m *StreamReplicationMessagesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *historyServiceStreamReplicationMessagesServer) Recv() (*StreamReplicationMessagesRequest, error) {
+	m := new(StreamReplicationMessagesRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _HistoryService_GetDLQReplicationMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetDLQReplicationMessagesRequest)
 	if err := dec(in); err != nil {
@@ -1493,6 +1782,150 @@ func _HistoryService_RefreshWorkflowTasks_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_GetReplicationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReplicationStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).GetReplicationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/GetReplicationStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).GetReplicationStatus(ctx, req.(*GetReplicationStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_GenerateLastHistoryReplicationTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateLastHistoryReplicationTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).GenerateLastHistoryReplicationTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/GenerateLastHistoryReplicationTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).GenerateLastHistoryReplicationTasks(ctx, req.(*GenerateLastHistoryReplicationTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_CompactWorkflowHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompactWorkflowHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).CompactWorkflowHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/CompactWorkflowHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).CompactWorkflowHistory(ctx, req.(*CompactWorkflowHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_ListThrottledCallers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListThrottledCallersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).ListThrottledCallers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/ListThrottledCallers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).ListThrottledCallers(ctx, req.(*ListThrottledCallersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_GetShardStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShardStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).GetShardStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/GetShardStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).GetShardStats(ctx, req.(*GetShardStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_GetHostProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHostProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).GetHostProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/GetHostProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).GetHostProfile(ctx, req.(*GetHostProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_DescribeVisibilityProcessor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeVisibilityProcessorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).DescribeVisibilityProcessor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/DescribeVisibilityProcessor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).DescribeVisibilityProcessor(ctx, req.(*DescribeVisibilityProcessorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_DeleteWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).DeleteWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/DeleteWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).DeleteWorkflowExecution(ctx, req.(*DeleteWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _HistoryService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "temporal.server.api.historyservice.v1.HistoryService",
 	HandlerType: (*HistoryServiceServer)(nil),
@@ -1529,6 +1962,10 @@ var _HistoryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RespondWorkflowTaskFailed",
 			Handler:    _HistoryService_RespondWorkflowTaskFailed_Handler,
 		},
+		{
+			MethodName: "RecordWorkflowTaskHeartbeat",
+			Handler:    _HistoryService_RecordWorkflowTaskHeartbeat_Handler,
+		},
 		{
 			MethodName: "RecordActivityTaskHeartbeat",
 			Handler:    _HistoryService_RecordActivityTaskHeartbeat_Handler,
@@ -1553,6 +1990,10 @@ var _HistoryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SignalWithStartWorkflowExecution",
 			Handler:    _HistoryService_SignalWithStartWorkflowExecution_Handler,
 		},
+		{
+			MethodName: "ExecuteMultiOperation",
+			Handler:    _HistoryService_ExecuteMultiOperation_Handler,
+		},
 		{
 			MethodName: "RemoveSignalMutableState",
 			Handler:    _HistoryService_RemoveSignalMutableState_Handler,
@@ -1641,7 +2082,46 @@ var _HistoryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RefreshWorkflowTasks",
 			Handler:    _HistoryService_RefreshWorkflowTasks_Handler,
 		},
+		{
+			MethodName: "GetReplicationStatus",
+			Handler:    _HistoryService_GetReplicationStatus_Handler,
+		},
+		{
+			MethodName: "GenerateLastHistoryReplicationTasks",
+			Handler:    _HistoryService_GenerateLastHistoryReplicationTasks_Handler,
+		},
+		{
+			MethodName: "CompactWorkflowHistory",
+			Handler:    _HistoryService_CompactWorkflowHistory_Handler,
+		},
+		{
+			MethodName: "ListThrottledCallers",
+			Handler:    _HistoryService_ListThrottledCallers_Handler,
+		},
+		{
+			MethodName: "GetShardStats",
+			Handler:    _HistoryService_GetShardStats_Handler,
+		},
+		{
+			MethodName: "GetHostProfile",
+			Handler:    _HistoryService_GetHostProfile_Handler,
+		},
+		{
+			MethodName: "DescribeVisibilityProcessor",
+			Handler:    _HistoryService_DescribeVisibilityProcessor_Handler,
+		},
+		{
+			MethodName: "DeleteWorkflowExecution",
+			Handler:    _HistoryService_DeleteWorkflowExecution_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamReplicationMessages",
+			Handler:       _HistoryService_StreamReplicationMessages_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "temporal/server/api/historyservice/v1/service.proto",
 }
@@ -35,6 +35,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	historyservice "go.temporal.io/server/api/historyservice/v1"
 	grpc "google.golang.org/grpc"
+	metadata "google.golang.org/grpc/metadata"
 )
 
 // MockHistoryServiceClient is a mock of HistoryServiceClient interface.
@@ -80,6 +81,46 @@ func (mr *MockHistoryServiceClientMockRecorder) CloseShard(ctx, in interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseShard", reflect.TypeOf((*MockHistoryServiceClient)(nil).CloseShard), varargs...)
 }
 
+// CompactWorkflowHistory mocks base method.
+func (m *MockHistoryServiceClient) CompactWorkflowHistory(ctx context.Context, in *historyservice.CompactWorkflowHistoryRequest, opts ...grpc.CallOption) (*historyservice.CompactWorkflowHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CompactWorkflowHistory", varargs...)
+	ret0, _ := ret[0].(*historyservice.CompactWorkflowHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompactWorkflowHistory indicates an expected call of CompactWorkflowHistory.
+func (mr *MockHistoryServiceClientMockRecorder) CompactWorkflowHistory(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompactWorkflowHistory", reflect.TypeOf((*MockHistoryServiceClient)(nil).CompactWorkflowHistory), varargs...)
+}
+
+// DeleteWorkflowExecution mocks base method.
+func (m *MockHistoryServiceClient) DeleteWorkflowExecution(ctx context.Context, in *historyservice.DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.DeleteWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*historyservice.DeleteWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteWorkflowExecution indicates an expected call of DeleteWorkflowExecution.
+func (mr *MockHistoryServiceClientMockRecorder) DeleteWorkflowExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).DeleteWorkflowExecution), varargs...)
+}
+
 // DescribeHistoryHost mocks base method.
 func (m *MockHistoryServiceClient) DescribeHistoryHost(ctx context.Context, in *historyservice.DescribeHistoryHostRequest, opts ...grpc.CallOption) (*historyservice.DescribeHistoryHostResponse, error) {
 	m.ctrl.T.Helper()
@@ -120,6 +161,26 @@ func (mr *MockHistoryServiceClientMockRecorder) DescribeMutableState(ctx, in int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockHistoryServiceClient)(nil).DescribeMutableState), varargs...)
 }
 
+// DescribeVisibilityProcessor mocks base method.
+func (m *MockHistoryServiceClient) DescribeVisibilityProcessor(ctx context.Context, in *historyservice.DescribeVisibilityProcessorRequest, opts ...grpc.CallOption) (*historyservice.DescribeVisibilityProcessorResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeVisibilityProcessor", varargs...)
+	ret0, _ := ret[0].(*historyservice.DescribeVisibilityProcessorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeVisibilityProcessor indicates an expected call of DescribeVisibilityProcessor.
+func (mr *MockHistoryServiceClientMockRecorder) DescribeVisibilityProcessor(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVisibilityProcessor", reflect.TypeOf((*MockHistoryServiceClient)(nil).DescribeVisibilityProcessor), varargs...)
+}
+
 // DescribeWorkflowExecution mocks base method.
 func (m *MockHistoryServiceClient) DescribeWorkflowExecution(ctx context.Context, in *historyservice.DescribeWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.DescribeWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -140,6 +201,46 @@ func (mr *MockHistoryServiceClientMockRecorder) DescribeWorkflowExecution(ctx, i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).DescribeWorkflowExecution), varargs...)
 }
 
+// ExecuteMultiOperation mocks base method.
+func (m *MockHistoryServiceClient) ExecuteMultiOperation(ctx context.Context, in *historyservice.ExecuteMultiOperationRequest, opts ...grpc.CallOption) (*historyservice.ExecuteMultiOperationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExecuteMultiOperation", varargs...)
+	ret0, _ := ret[0].(*historyservice.ExecuteMultiOperationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteMultiOperation indicates an expected call of ExecuteMultiOperation.
+func (mr *MockHistoryServiceClientMockRecorder) ExecuteMultiOperation(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteMultiOperation", reflect.TypeOf((*MockHistoryServiceClient)(nil).ExecuteMultiOperation), varargs...)
+}
+
+// GenerateLastHistoryReplicationTasks mocks base method.
+func (m *MockHistoryServiceClient) GenerateLastHistoryReplicationTasks(ctx context.Context, in *historyservice.GenerateLastHistoryReplicationTasksRequest, opts ...grpc.CallOption) (*historyservice.GenerateLastHistoryReplicationTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GenerateLastHistoryReplicationTasks", varargs...)
+	ret0, _ := ret[0].(*historyservice.GenerateLastHistoryReplicationTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateLastHistoryReplicationTasks indicates an expected call of GenerateLastHistoryReplicationTasks.
+func (mr *MockHistoryServiceClientMockRecorder) GenerateLastHistoryReplicationTasks(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateLastHistoryReplicationTasks", reflect.TypeOf((*MockHistoryServiceClient)(nil).GenerateLastHistoryReplicationTasks), varargs...)
+}
+
 // GetDLQMessages mocks base method.
 func (m *MockHistoryServiceClient) GetDLQMessages(ctx context.Context, in *historyservice.GetDLQMessagesRequest, opts ...grpc.CallOption) (*historyservice.GetDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -180,6 +281,26 @@ func (mr *MockHistoryServiceClientMockRecorder) GetDLQReplicationMessages(ctx, i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQReplicationMessages", reflect.TypeOf((*MockHistoryServiceClient)(nil).GetDLQReplicationMessages), varargs...)
 }
 
+// GetHostProfile mocks base method.
+func (m *MockHistoryServiceClient) GetHostProfile(ctx context.Context, in *historyservice.GetHostProfileRequest, opts ...grpc.CallOption) (*historyservice.GetHostProfileResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetHostProfile", varargs...)
+	ret0, _ := ret[0].(*historyservice.GetHostProfileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHostProfile indicates an expected call of GetHostProfile.
+func (mr *MockHistoryServiceClientMockRecorder) GetHostProfile(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostProfile", reflect.TypeOf((*MockHistoryServiceClient)(nil).GetHostProfile), varargs...)
+}
+
 // GetMutableState mocks base method.
 func (m *MockHistoryServiceClient) GetMutableState(ctx context.Context, in *historyservice.GetMutableStateRequest, opts ...grpc.CallOption) (*historyservice.GetMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -220,6 +341,66 @@ func (mr *MockHistoryServiceClientMockRecorder) GetReplicationMessages(ctx, in i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationMessages", reflect.TypeOf((*MockHistoryServiceClient)(nil).GetReplicationMessages), varargs...)
 }
 
+// GetReplicationStatus mocks base method.
+func (m *MockHistoryServiceClient) GetReplicationStatus(ctx context.Context, in *historyservice.GetReplicationStatusRequest, opts ...grpc.CallOption) (*historyservice.GetReplicationStatusResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetReplicationStatus", varargs...)
+	ret0, _ := ret[0].(*historyservice.GetReplicationStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationStatus indicates an expected call of GetReplicationStatus.
+func (mr *MockHistoryServiceClientMockRecorder) GetReplicationStatus(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationStatus", reflect.TypeOf((*MockHistoryServiceClient)(nil).GetReplicationStatus), varargs...)
+}
+
+// GetShardStats mocks base method.
+func (m *MockHistoryServiceClient) GetShardStats(ctx context.Context, in *historyservice.GetShardStatsRequest, opts ...grpc.CallOption) (*historyservice.GetShardStatsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetShardStats", varargs...)
+	ret0, _ := ret[0].(*historyservice.GetShardStatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShardStats indicates an expected call of GetShardStats.
+func (mr *MockHistoryServiceClientMockRecorder) GetShardStats(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShardStats", reflect.TypeOf((*MockHistoryServiceClient)(nil).GetShardStats), varargs...)
+}
+
+// ListThrottledCallers mocks base method.
+func (m *MockHistoryServiceClient) ListThrottledCallers(ctx context.Context, in *historyservice.ListThrottledCallersRequest, opts ...grpc.CallOption) (*historyservice.ListThrottledCallersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListThrottledCallers", varargs...)
+	ret0, _ := ret[0].(*historyservice.ListThrottledCallersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListThrottledCallers indicates an expected call of ListThrottledCallers.
+func (mr *MockHistoryServiceClientMockRecorder) ListThrottledCallers(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListThrottledCallers", reflect.TypeOf((*MockHistoryServiceClient)(nil).ListThrottledCallers), varargs...)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockHistoryServiceClient) MergeDLQMessages(ctx context.Context, in *historyservice.MergeDLQMessagesRequest, opts ...grpc.CallOption) (*historyservice.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -380,6 +561,26 @@ func (mr *MockHistoryServiceClientMockRecorder) RecordChildExecutionCompleted(ct
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordChildExecutionCompleted", reflect.TypeOf((*MockHistoryServiceClient)(nil).RecordChildExecutionCompleted), varargs...)
 }
 
+// RecordWorkflowTaskHeartbeat mocks base method.
+func (m *MockHistoryServiceClient) RecordWorkflowTaskHeartbeat(ctx context.Context, in *historyservice.RecordWorkflowTaskHeartbeatRequest, opts ...grpc.CallOption) (*historyservice.RecordWorkflowTaskHeartbeatResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RecordWorkflowTaskHeartbeat", varargs...)
+	ret0, _ := ret[0].(*historyservice.RecordWorkflowTaskHeartbeatResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordWorkflowTaskHeartbeat indicates an expected call of RecordWorkflowTaskHeartbeat.
+func (mr *MockHistoryServiceClientMockRecorder) RecordWorkflowTaskHeartbeat(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordWorkflowTaskHeartbeat", reflect.TypeOf((*MockHistoryServiceClient)(nil).RecordWorkflowTaskHeartbeat), varargs...)
+}
+
 // RecordWorkflowTaskStarted mocks base method.
 func (m *MockHistoryServiceClient) RecordWorkflowTaskStarted(ctx context.Context, in *historyservice.RecordWorkflowTaskStartedRequest, opts ...grpc.CallOption) (*historyservice.RecordWorkflowTaskStartedResponse, error) {
 	m.ctrl.T.Helper()
@@ -720,6 +921,26 @@ func (mr *MockHistoryServiceClientMockRecorder) StartWorkflowExecution(ctx, in i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).StartWorkflowExecution), varargs...)
 }
 
+// StreamReplicationMessages mocks base method.
+func (m *MockHistoryServiceClient) StreamReplicationMessages(ctx context.Context, opts ...grpc.CallOption) (historyservice.HistoryService_StreamReplicationMessagesClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamReplicationMessages", varargs...)
+	ret0, _ := ret[0].(historyservice.HistoryService_StreamReplicationMessagesClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamReplicationMessages indicates an expected call of StreamReplicationMessages.
+func (mr *MockHistoryServiceClientMockRecorder) StreamReplicationMessages(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamReplicationMessages", reflect.TypeOf((*MockHistoryServiceClient)(nil).StreamReplicationMessages), varargs...)
+}
+
 // SyncActivity mocks base method.
 func (m *MockHistoryServiceClient) SyncActivity(ctx context.Context, in *historyservice.SyncActivityRequest, opts ...grpc.CallOption) (*historyservice.SyncActivityResponse, error) {
 	m.ctrl.T.Helper()
@@ -780,6 +1001,143 @@ func (mr *MockHistoryServiceClientMockRecorder) TerminateWorkflowExecution(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).TerminateWorkflowExecution), varargs...)
 }
 
+// MockHistoryService_StreamReplicationMessagesClient is a mock of HistoryService_StreamReplicationMessagesClient interface.
+type MockHistoryService_StreamReplicationMessagesClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockHistoryService_StreamReplicationMessagesClientMockRecorder
+}
+
+// MockHistoryService_StreamReplicationMessagesClientMockRecorder is the mock recorder for MockHistoryService_StreamReplicationMessagesClient.
+type MockHistoryService_StreamReplicationMessagesClientMockRecorder struct {
+	mock *MockHistoryService_StreamReplicationMessagesClient
+}
+
+// NewMockHistoryService_StreamReplicationMessagesClient creates a new mock instance.
+func NewMockHistoryService_StreamReplicationMessagesClient(ctrl *gomock.Controller) *MockHistoryService_StreamReplicationMessagesClient {
+	mock := &MockHistoryService_StreamReplicationMessagesClient{ctrl: ctrl}
+	mock.recorder = &MockHistoryService_StreamReplicationMessagesClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHistoryService_StreamReplicationMessagesClient) EXPECT() *MockHistoryService_StreamReplicationMessagesClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockHistoryService_StreamReplicationMessagesClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockHistoryService_StreamReplicationMessagesClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockHistoryService_StreamReplicationMessagesClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockHistoryService_StreamReplicationMessagesClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockHistoryService_StreamReplicationMessagesClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockHistoryService_StreamReplicationMessagesClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockHistoryService_StreamReplicationMessagesClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockHistoryService_StreamReplicationMessagesClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockHistoryService_StreamReplicationMessagesClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockHistoryService_StreamReplicationMessagesClient) Recv() (*historyservice.StreamReplicationMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*historyservice.StreamReplicationMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockHistoryService_StreamReplicationMessagesClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockHistoryService_StreamReplicationMessagesClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockHistoryService_StreamReplicationMessagesClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockHistoryService_StreamReplicationMessagesClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockHistoryService_StreamReplicationMessagesClient)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockHistoryService_StreamReplicationMessagesClient) Send(arg0 *historyservice.StreamReplicationMessagesRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockHistoryService_StreamReplicationMessagesClientMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockHistoryService_StreamReplicationMessagesClient)(nil).Send), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockHistoryService_StreamReplicationMessagesClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockHistoryService_StreamReplicationMessagesClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockHistoryService_StreamReplicationMessagesClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockHistoryService_StreamReplicationMessagesClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockHistoryService_StreamReplicationMessagesClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockHistoryService_StreamReplicationMessagesClient)(nil).Trailer))
+}
+
 // MockHistoryServiceServer is a mock of HistoryServiceServer interface.
 type MockHistoryServiceServer struct {
 	ctrl     *gomock.Controller
@@ -818,6 +1176,36 @@ func (mr *MockHistoryServiceServerMockRecorder) CloseShard(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseShard", reflect.TypeOf((*MockHistoryServiceServer)(nil).CloseShard), arg0, arg1)
 }
 
+// CompactWorkflowHistory mocks base method.
+func (m *MockHistoryServiceServer) CompactWorkflowHistory(arg0 context.Context, arg1 *historyservice.CompactWorkflowHistoryRequest) (*historyservice.CompactWorkflowHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompactWorkflowHistory", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.CompactWorkflowHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompactWorkflowHistory indicates an expected call of CompactWorkflowHistory.
+func (mr *MockHistoryServiceServerMockRecorder) CompactWorkflowHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompactWorkflowHistory", reflect.TypeOf((*MockHistoryServiceServer)(nil).CompactWorkflowHistory), arg0, arg1)
+}
+
+// DeleteWorkflowExecution mocks base method.
+func (m *MockHistoryServiceServer) DeleteWorkflowExecution(arg0 context.Context, arg1 *historyservice.DeleteWorkflowExecutionRequest) (*historyservice.DeleteWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.DeleteWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteWorkflowExecution indicates an expected call of DeleteWorkflowExecution.
+func (mr *MockHistoryServiceServerMockRecorder) DeleteWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).DeleteWorkflowExecution), arg0, arg1)
+}
+
 // DescribeHistoryHost mocks base method.
 func (m *MockHistoryServiceServer) DescribeHistoryHost(arg0 context.Context, arg1 *historyservice.DescribeHistoryHostRequest) (*historyservice.DescribeHistoryHostResponse, error) {
 	m.ctrl.T.Helper()
@@ -848,6 +1236,21 @@ func (mr *MockHistoryServiceServerMockRecorder) DescribeMutableState(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockHistoryServiceServer)(nil).DescribeMutableState), arg0, arg1)
 }
 
+// DescribeVisibilityProcessor mocks base method.
+func (m *MockHistoryServiceServer) DescribeVisibilityProcessor(arg0 context.Context, arg1 *historyservice.DescribeVisibilityProcessorRequest) (*historyservice.DescribeVisibilityProcessorResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeVisibilityProcessor", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.DescribeVisibilityProcessorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeVisibilityProcessor indicates an expected call of DescribeVisibilityProcessor.
+func (mr *MockHistoryServiceServerMockRecorder) DescribeVisibilityProcessor(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVisibilityProcessor", reflect.TypeOf((*MockHistoryServiceServer)(nil).DescribeVisibilityProcessor), arg0, arg1)
+}
+
 // DescribeWorkflowExecution mocks base method.
 func (m *MockHistoryServiceServer) DescribeWorkflowExecution(arg0 context.Context, arg1 *historyservice.DescribeWorkflowExecutionRequest) (*historyservice.DescribeWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -863,6 +1266,36 @@ func (mr *MockHistoryServiceServerMockRecorder) DescribeWorkflowExecution(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).DescribeWorkflowExecution), arg0, arg1)
 }
 
+// ExecuteMultiOperation mocks base method.
+func (m *MockHistoryServiceServer) ExecuteMultiOperation(arg0 context.Context, arg1 *historyservice.ExecuteMultiOperationRequest) (*historyservice.ExecuteMultiOperationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteMultiOperation", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.ExecuteMultiOperationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteMultiOperation indicates an expected call of ExecuteMultiOperation.
+func (mr *MockHistoryServiceServerMockRecorder) ExecuteMultiOperation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteMultiOperation", reflect.TypeOf((*MockHistoryServiceServer)(nil).ExecuteMultiOperation), arg0, arg1)
+}
+
+// GenerateLastHistoryReplicationTasks mocks base method.
+func (m *MockHistoryServiceServer) GenerateLastHistoryReplicationTasks(arg0 context.Context, arg1 *historyservice.GenerateLastHistoryReplicationTasksRequest) (*historyservice.GenerateLastHistoryReplicationTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateLastHistoryReplicationTasks", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.GenerateLastHistoryReplicationTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateLastHistoryReplicationTasks indicates an expected call of GenerateLastHistoryReplicationTasks.
+func (mr *MockHistoryServiceServerMockRecorder) GenerateLastHistoryReplicationTasks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateLastHistoryReplicationTasks", reflect.TypeOf((*MockHistoryServiceServer)(nil).GenerateLastHistoryReplicationTasks), arg0, arg1)
+}
+
 // GetDLQMessages mocks base method.
 func (m *MockHistoryServiceServer) GetDLQMessages(arg0 context.Context, arg1 *historyservice.GetDLQMessagesRequest) (*historyservice.GetDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -893,6 +1326,21 @@ func (mr *MockHistoryServiceServerMockRecorder) GetDLQReplicationMessages(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQReplicationMessages", reflect.TypeOf((*MockHistoryServiceServer)(nil).GetDLQReplicationMessages), arg0, arg1)
 }
 
+// GetHostProfile mocks base method.
+func (m *MockHistoryServiceServer) GetHostProfile(arg0 context.Context, arg1 *historyservice.GetHostProfileRequest) (*historyservice.GetHostProfileResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHostProfile", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.GetHostProfileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHostProfile indicates an expected call of GetHostProfile.
+func (mr *MockHistoryServiceServerMockRecorder) GetHostProfile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostProfile", reflect.TypeOf((*MockHistoryServiceServer)(nil).GetHostProfile), arg0, arg1)
+}
+
 // GetMutableState mocks base method.
 func (m *MockHistoryServiceServer) GetMutableState(arg0 context.Context, arg1 *historyservice.GetMutableStateRequest) (*historyservice.GetMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -923,6 +1371,51 @@ func (mr *MockHistoryServiceServerMockRecorder) GetReplicationMessages(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationMessages", reflect.TypeOf((*MockHistoryServiceServer)(nil).GetReplicationMessages), arg0, arg1)
 }
 
+// GetReplicationStatus mocks base method.
+func (m *MockHistoryServiceServer) GetReplicationStatus(arg0 context.Context, arg1 *historyservice.GetReplicationStatusRequest) (*historyservice.GetReplicationStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplicationStatus", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.GetReplicationStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationStatus indicates an expected call of GetReplicationStatus.
+func (mr *MockHistoryServiceServerMockRecorder) GetReplicationStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationStatus", reflect.TypeOf((*MockHistoryServiceServer)(nil).GetReplicationStatus), arg0, arg1)
+}
+
+// GetShardStats mocks base method.
+func (m *MockHistoryServiceServer) GetShardStats(arg0 context.Context, arg1 *historyservice.GetShardStatsRequest) (*historyservice.GetShardStatsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShardStats", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.GetShardStatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShardStats indicates an expected call of GetShardStats.
+func (mr *MockHistoryServiceServerMockRecorder) GetShardStats(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShardStats", reflect.TypeOf((*MockHistoryServiceServer)(nil).GetShardStats), arg0, arg1)
+}
+
+// ListThrottledCallers mocks base method.
+func (m *MockHistoryServiceServer) ListThrottledCallers(arg0 context.Context, arg1 *historyservice.ListThrottledCallersRequest) (*historyservice.ListThrottledCallersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListThrottledCallers", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.ListThrottledCallersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListThrottledCallers indicates an expected call of ListThrottledCallers.
+func (mr *MockHistoryServiceServerMockRecorder) ListThrottledCallers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListThrottledCallers", reflect.TypeOf((*MockHistoryServiceServer)(nil).ListThrottledCallers), arg0, arg1)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockHistoryServiceServer) MergeDLQMessages(arg0 context.Context, arg1 *historyservice.MergeDLQMessagesRequest) (*historyservice.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1043,6 +1536,21 @@ func (mr *MockHistoryServiceServerMockRecorder) RecordChildExecutionCompleted(ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordChildExecutionCompleted", reflect.TypeOf((*MockHistoryServiceServer)(nil).RecordChildExecutionCompleted), arg0, arg1)
 }
 
+// RecordWorkflowTaskHeartbeat mocks base method.
+func (m *MockHistoryServiceServer) RecordWorkflowTaskHeartbeat(arg0 context.Context, arg1 *historyservice.RecordWorkflowTaskHeartbeatRequest) (*historyservice.RecordWorkflowTaskHeartbeatResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordWorkflowTaskHeartbeat", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.RecordWorkflowTaskHeartbeatResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordWorkflowTaskHeartbeat indicates an expected call of RecordWorkflowTaskHeartbeat.
+func (mr *MockHistoryServiceServerMockRecorder) RecordWorkflowTaskHeartbeat(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordWorkflowTaskHeartbeat", reflect.TypeOf((*MockHistoryServiceServer)(nil).RecordWorkflowTaskHeartbeat), arg0, arg1)
+}
+
 // RecordWorkflowTaskStarted mocks base method.
 func (m *MockHistoryServiceServer) RecordWorkflowTaskStarted(arg0 context.Context, arg1 *historyservice.RecordWorkflowTaskStartedRequest) (*historyservice.RecordWorkflowTaskStartedResponse, error) {
 	m.ctrl.T.Helper()
@@ -1298,6 +1806,20 @@ func (mr *MockHistoryServiceServerMockRecorder) StartWorkflowExecution(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).StartWorkflowExecution), arg0, arg1)
 }
 
+// StreamReplicationMessages mocks base method.
+func (m *MockHistoryServiceServer) StreamReplicationMessages(arg0 historyservice.HistoryService_StreamReplicationMessagesServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamReplicationMessages", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamReplicationMessages indicates an expected call of StreamReplicationMessages.
+func (mr *MockHistoryServiceServerMockRecorder) StreamReplicationMessages(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamReplicationMessages", reflect.TypeOf((*MockHistoryServiceServer)(nil).StreamReplicationMessages), arg0)
+}
+
 // SyncActivity mocks base method.
 func (m *MockHistoryServiceServer) SyncActivity(arg0 context.Context, arg1 *historyservice.SyncActivityRequest) (*historyservice.SyncActivityResponse, error) {
 	m.ctrl.T.Helper()
@@ -1342,3 +1864,137 @@ func (mr *MockHistoryServiceServerMockRecorder) TerminateWorkflowExecution(arg0,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).TerminateWorkflowExecution), arg0, arg1)
 }
+
+// MockHistoryService_StreamReplicationMessagesServer is a mock of HistoryService_StreamReplicationMessagesServer interface.
+type MockHistoryService_StreamReplicationMessagesServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockHistoryService_StreamReplicationMessagesServerMockRecorder
+}
+
+// MockHistoryService_StreamReplicationMessagesServerMockRecorder is the mock recorder for MockHistoryService_StreamReplicationMessagesServer.
+type MockHistoryService_StreamReplicationMessagesServerMockRecorder struct {
+	mock *MockHistoryService_StreamReplicationMessagesServer
+}
+
+// NewMockHistoryService_StreamReplicationMessagesServer creates a new mock instance.
+func NewMockHistoryService_StreamReplicationMessagesServer(ctrl *gomock.Controller) *MockHistoryService_StreamReplicationMessagesServer {
+	mock := &MockHistoryService_StreamReplicationMessagesServer{ctrl: ctrl}
+	mock.recorder = &MockHistoryService_StreamReplicationMessagesServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHistoryService_StreamReplicationMessagesServer) EXPECT() *MockHistoryService_StreamReplicationMessagesServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockHistoryService_StreamReplicationMessagesServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockHistoryService_StreamReplicationMessagesServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockHistoryService_StreamReplicationMessagesServer)(nil).Context))
+}
+
+// Recv mocks base method.
+func (m *MockHistoryService_StreamReplicationMessagesServer) Recv() (*historyservice.StreamReplicationMessagesRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*historyservice.StreamReplicationMessagesRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockHistoryService_StreamReplicationMessagesServerMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockHistoryService_StreamReplicationMessagesServer)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockHistoryService_StreamReplicationMessagesServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockHistoryService_StreamReplicationMessagesServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockHistoryService_StreamReplicationMessagesServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockHistoryService_StreamReplicationMessagesServer) Send(arg0 *historyservice.StreamReplicationMessagesResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockHistoryService_StreamReplicationMessagesServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockHistoryService_StreamReplicationMessagesServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockHistoryService_StreamReplicationMessagesServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockHistoryService_StreamReplicationMessagesServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockHistoryService_StreamReplicationMessagesServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockHistoryService_StreamReplicationMessagesServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockHistoryService_StreamReplicationMessagesServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockHistoryService_StreamReplicationMessagesServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockHistoryService_StreamReplicationMessagesServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockHistoryService_StreamReplicationMessagesServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockHistoryService_StreamReplicationMessagesServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockHistoryService_StreamReplicationMessagesServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockHistoryService_StreamReplicationMessagesServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockHistoryService_StreamReplicationMessagesServer)(nil).SetTrailer), arg0)
+}
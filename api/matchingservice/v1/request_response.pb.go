@@ -49,6 +49,8 @@ import (
 	v1 "go.temporal.io/api/workflowservice/v1"
 	v15 "go.temporal.io/server/api/enums/v1"
 	v13 "go.temporal.io/server/api/history/v1"
+	v18 "go.temporal.io/server/api/persistence/v1"
+	v17 "go.temporal.io/server/api/taskqueue/v1"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -537,6 +539,8 @@ type AddWorkflowTaskRequest struct {
 	ScheduleToStartTimeout *time.Duration `protobuf:"bytes,5,opt,name=schedule_to_start_timeout,json=scheduleToStartTimeout,proto3,stdduration" json:"schedule_to_start_timeout,omitempty"`
 	ForwardedSource        string         `protobuf:"bytes,6,opt,name=forwarded_source,json=forwardedSource,proto3" json:"forwarded_source,omitempty"`
 	Source                 v15.TaskSource `protobuf:"varint,7,opt,name=source,proto3,enum=temporal.server.api.enums.v1.TaskSource" json:"source,omitempty"`
+	// Build id of the worker which completed the most recent workflow task of the workflow, empty if none.
+	WorkerBuildId string `protobuf:"bytes,8,opt,name=worker_build_id,json=workerBuildId,proto3" json:"worker_build_id,omitempty"`
 }
 
 func (m *AddWorkflowTaskRequest) Reset()      { *m = AddWorkflowTaskRequest{} }
@@ -620,6 +624,13 @@ func (m *AddWorkflowTaskRequest) GetSource() v15.TaskSource {
 	return v15.TASK_SOURCE_UNSPECIFIED
 }
 
+func (m *AddWorkflowTaskRequest) GetWorkerBuildId() string {
+	if m != nil {
+		return m.WorkerBuildId
+	}
+	return ""
+}
+
 type AddWorkflowTaskResponse struct {
 }
 
@@ -796,6 +807,11 @@ type QueryWorkflowRequest struct {
 	TaskQueue       *v14.TaskQueue           `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
 	QueryRequest    *v1.QueryWorkflowRequest `protobuf:"bytes,3,opt,name=query_request,json=queryRequest,proto3" json:"query_request,omitempty"`
 	ForwardedSource string                   `protobuf:"bytes,4,opt,name=forwarded_source,json=forwardedSource,proto3" json:"forwarded_source,omitempty"`
+	// Maximum time to wait for a poller of a sticky task queue to pick up the query task,
+	// StickyWorkerUnavailable error is returned when it elapses. Not set means no limit.
+	StickyDispatchTimeout *time.Duration `protobuf:"bytes,5,opt,name=sticky_dispatch_timeout,json=stickyDispatchTimeout,proto3,stdduration" json:"sticky_dispatch_timeout,omitempty"`
+	// Build id of the worker which completed the most recent workflow task of the workflow, empty if none.
+	WorkerBuildId string `protobuf:"bytes,6,opt,name=worker_build_id,json=workerBuildId,proto3" json:"worker_build_id,omitempty"`
 }
 
 func (m *QueryWorkflowRequest) Reset()      { *m = QueryWorkflowRequest{} }
@@ -858,6 +874,20 @@ func (m *QueryWorkflowRequest) GetForwardedSource() string {
 	return ""
 }
 
+func (m *QueryWorkflowRequest) GetStickyDispatchTimeout() *time.Duration {
+	if m != nil {
+		return m.StickyDispatchTimeout
+	}
+	return nil
+}
+
+func (m *QueryWorkflowRequest) GetWorkerBuildId() string {
+	if m != nil {
+		return m.WorkerBuildId
+	}
+	return ""
+}
+
 type QueryWorkflowResponse struct {
 	QueryResult   *v11.Payloads      `protobuf:"bytes,1,opt,name=query_result,json=queryResult,proto3" json:"query_result,omitempty"`
 	QueryRejected *v12.QueryRejected `protobuf:"bytes,2,opt,name=query_rejected,json=queryRejected,proto3" json:"query_rejected,omitempty"`
@@ -1167,6 +1197,10 @@ func (m *DescribeTaskQueueRequest) GetDescRequest() *v1.DescribeTaskQueueRequest
 type DescribeTaskQueueResponse struct {
 	Pollers         []*v14.PollerInfo    `protobuf:"bytes,1,rep,name=pollers,proto3" json:"pollers,omitempty"`
 	TaskQueueStatus *v14.TaskQueueStatus `protobuf:"bytes,2,opt,name=task_queue_status,json=taskQueueStatus,proto3" json:"task_queue_status,omitempty"`
+	// Only set if task queue status is requested.
+	TaskReaderStatus *v17.TaskReaderStatus `protobuf:"bytes,3,opt,name=task_reader_status,json=taskReaderStatus,proto3" json:"task_reader_status,omitempty"`
+	// Only set if task queue status is requested.
+	BacklogStatus *v17.TaskQueueBacklogStatus `protobuf:"bytes,4,opt,name=backlog_status,json=backlogStatus,proto3" json:"backlog_status,omitempty"`
 }
 
 func (m *DescribeTaskQueueResponse) Reset()      { *m = DescribeTaskQueueResponse{} }
@@ -1215,6 +1249,20 @@ func (m *DescribeTaskQueueResponse) GetTaskQueueStatus() *v14.TaskQueueStatus {
 	return nil
 }
 
+func (m *DescribeTaskQueueResponse) GetTaskReaderStatus() *v17.TaskReaderStatus {
+	if m != nil {
+		return m.TaskReaderStatus
+	}
+	return nil
+}
+
+func (m *DescribeTaskQueueResponse) GetBacklogStatus() *v17.TaskQueueBacklogStatus {
+	if m != nil {
+		return m.BacklogStatus
+	}
+	return nil
+}
+
 type ListTaskQueuePartitionsRequest struct {
 	Namespace string         `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	TaskQueue *v14.TaskQueue `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
@@ -1317,309 +1365,1061 @@ func (m *ListTaskQueuePartitionsResponse) GetWorkflowTaskQueuePartitions() []*v1
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*PollWorkflowTaskQueueRequest)(nil), "temporal.server.api.matchingservice.v1.PollWorkflowTaskQueueRequest")
-	proto.RegisterType((*PollWorkflowTaskQueueResponse)(nil), "temporal.server.api.matchingservice.v1.PollWorkflowTaskQueueResponse")
-	proto.RegisterMapType((map[string]*v12.WorkflowQuery)(nil), "temporal.server.api.matchingservice.v1.PollWorkflowTaskQueueResponse.QueriesEntry")
-	proto.RegisterType((*PollActivityTaskQueueRequest)(nil), "temporal.server.api.matchingservice.v1.PollActivityTaskQueueRequest")
-	proto.RegisterType((*PollActivityTaskQueueResponse)(nil), "temporal.server.api.matchingservice.v1.PollActivityTaskQueueResponse")
-	proto.RegisterType((*AddWorkflowTaskRequest)(nil), "temporal.server.api.matchingservice.v1.AddWorkflowTaskRequest")
-	proto.RegisterType((*AddWorkflowTaskResponse)(nil), "temporal.server.api.matchingservice.v1.AddWorkflowTaskResponse")
-	proto.RegisterType((*AddActivityTaskRequest)(nil), "temporal.server.api.matchingservice.v1.AddActivityTaskRequest")
-	proto.RegisterType((*AddActivityTaskResponse)(nil), "temporal.server.api.matchingservice.v1.AddActivityTaskResponse")
-	proto.RegisterType((*QueryWorkflowRequest)(nil), "temporal.server.api.matchingservice.v1.QueryWorkflowRequest")
-	proto.RegisterType((*QueryWorkflowResponse)(nil), "temporal.server.api.matchingservice.v1.QueryWorkflowResponse")
-	proto.RegisterType((*RespondQueryTaskCompletedRequest)(nil), "temporal.server.api.matchingservice.v1.RespondQueryTaskCompletedRequest")
-	proto.RegisterType((*RespondQueryTaskCompletedResponse)(nil), "temporal.server.api.matchingservice.v1.RespondQueryTaskCompletedResponse")
-	proto.RegisterType((*CancelOutstandingPollRequest)(nil), "temporal.server.api.matchingservice.v1.CancelOutstandingPollRequest")
-	proto.RegisterType((*CancelOutstandingPollResponse)(nil), "temporal.server.api.matchingservice.v1.CancelOutstandingPollResponse")
-	proto.RegisterType((*DescribeTaskQueueRequest)(nil), "temporal.server.api.matchingservice.v1.DescribeTaskQueueRequest")
-	proto.RegisterType((*DescribeTaskQueueResponse)(nil), "temporal.server.api.matchingservice.v1.DescribeTaskQueueResponse")
-	proto.RegisterType((*ListTaskQueuePartitionsRequest)(nil), "temporal.server.api.matchingservice.v1.ListTaskQueuePartitionsRequest")
-	proto.RegisterType((*ListTaskQueuePartitionsResponse)(nil), "temporal.server.api.matchingservice.v1.ListTaskQueuePartitionsResponse")
+type DescribeTaskQueuePartitionsRequest struct {
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	// Name of the task queue, partitions are not accepted.
+	TaskQueue     string            `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	TaskQueueType v16.TaskQueueType `protobuf:"varint,3,opt,name=task_queue_type,json=taskQueueType,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_type,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("temporal/server/api/matchingservice/v1/request_response.proto", fileDescriptor_a429a3813476c583)
+func (m *DescribeTaskQueuePartitionsRequest) Reset()      { *m = DescribeTaskQueuePartitionsRequest{} }
+func (*DescribeTaskQueuePartitionsRequest) ProtoMessage() {}
+func (*DescribeTaskQueuePartitionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{18}
+}
+func (m *DescribeTaskQueuePartitionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DescribeTaskQueuePartitionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeTaskQueuePartitionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DescribeTaskQueuePartitionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeTaskQueuePartitionsRequest.Merge(m, src)
+}
+func (m *DescribeTaskQueuePartitionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeTaskQueuePartitionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeTaskQueuePartitionsRequest.DiscardUnknown(m)
 }
 
-var fileDescriptor_a429a3813476c583 = []byte{
-	// 1712 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x18, 0x4f, 0x73, 0xdb, 0x58,
-	0x3d, 0x72, 0x12, 0x27, 0x7e, 0xb2, 0x13, 0x47, 0x0b, 0x59, 0x25, 0x6d, 0x94, 0xd4, 0xbb, 0xec,
-	0x66, 0x99, 0x45, 0x9e, 0x86, 0xd9, 0xce, 0xb6, 0xd0, 0x81, 0x34, 0xcd, 0xb4, 0x86, 0xb6, 0xa4,
-	0x8a, 0x07, 0x98, 0x0e, 0x33, 0xea, 0x8b, 0xf4, 0xe2, 0x88, 0xc8, 0x7a, 0x8e, 0xde, 0x93, 0x53,
-	0x73, 0x62, 0xa6, 0xc3, 0xbd, 0x33, 0x5c, 0x60, 0xf8, 0x02, 0x70, 0x86, 0x0f, 0xc1, 0x81, 0x43,
-	0x8f, 0xbd, 0x41, 0x93, 0x0b, 0x33, 0x5c, 0xca, 0x37, 0x60, 0xde, 0x1f, 0xc9, 0x92, 0x6c, 0x27,
-	0x4e, 0x9a, 0xa1, 0x7b, 0xb3, 0x7e, 0xff, 0xff, 0xff, 0x7e, 0xcf, 0xe0, 0x2e, 0x45, 0xed, 0x0e,
-	0x0e, 0xa1, 0x5f, 0x27, 0x28, 0xec, 0xa2, 0xb0, 0x0e, 0x3b, 0x5e, 0xbd, 0x0d, 0xa9, 0x73, 0xe0,
-	0x05, 0x2d, 0x06, 0xf2, 0x1c, 0x54, 0xef, 0xde, 0xac, 0x87, 0xe8, 0x28, 0x42, 0x84, 0xda, 0x21,
-	0x22, 0x1d, 0x1c, 0x10, 0x64, 0x76, 0x42, 0x4c, 0xb1, 0xf6, 0x59, 0xcc, 0x6e, 0x0a, 0x76, 0x13,
-	0x76, 0x3c, 0x33, 0xc7, 0x6e, 0x76, 0x6f, 0x2e, 0x1b, 0x2d, 0x8c, 0x5b, 0x3e, 0xaa, 0x73, 0xae,
-	0xbd, 0x68, 0xbf, 0xee, 0x46, 0x21, 0xa4, 0x1e, 0x0e, 0x84, 0x9c, 0xe5, 0xd5, 0x3c, 0x9e, 0x7a,
-	0x6d, 0x44, 0x28, 0x6c, 0x77, 0x24, 0xc1, 0x0d, 0x17, 0x75, 0x50, 0xe0, 0xa2, 0xc0, 0xf1, 0x10,
-	0xa9, 0xb7, 0x70, 0x0b, 0x73, 0x38, 0xff, 0x25, 0x49, 0x3e, 0x4d, 0x5c, 0x61, 0x3e, 0x38, 0xb8,
-	0xdd, 0xc6, 0x01, 0x33, 0xbd, 0x8d, 0x08, 0x81, 0x2d, 0x69, 0xf1, 0xf2, 0x67, 0x19, 0x2a, 0x14,
-	0x44, 0x6d, 0xc2, 0x88, 0x28, 0x24, 0x87, 0xf6, 0x51, 0x84, 0xa2, 0x98, 0xee, 0xf3, 0x0c, 0x1d,
-	0x43, 0x73, 0xec, 0xa0, 0xc0, 0x4f, 0x32, 0x84, 0x47, 0x11, 0x0a, 0x7b, 0x83, 0x44, 0x9f, 0x0f,
-	0x0b, 0x73, 0x46, 0xb9, 0x24, 0xfc, 0x72, 0x18, 0xe1, 0x81, 0x47, 0x28, 0x1e, 0x26, 0xf6, 0x56,
-	0x46, 0xf7, 0x31, 0x0e, 0x0f, 0xf7, 0x7d, 0x7c, 0x7c, 0x6e, 0xda, 0x6a, 0xff, 0x51, 0xc0, 0xf5,
-	0x1d, 0xec, 0xfb, 0xbf, 0x90, 0x1c, 0x4d, 0x48, 0x0e, 0x9f, 0x32, 0xf7, 0x2c, 0x41, 0xaf, 0xdd,
-	0x00, 0xe5, 0x00, 0xb6, 0x11, 0xe9, 0x40, 0x07, 0xd9, 0x9e, 0xab, 0x2b, 0x6b, 0xca, 0x7a, 0xc9,
-	0x52, 0x13, 0x58, 0xc3, 0xd5, 0xae, 0x81, 0x52, 0x07, 0xfb, 0x3e, 0x0a, 0x19, 0xbe, 0xc0, 0xf1,
-	0xb3, 0x02, 0xd0, 0x70, 0xb5, 0xe7, 0xa0, 0xcc, 0x7e, 0xdb, 0x52, 0xbf, 0x3e, 0xb9, 0xa6, 0xac,
-	0xab, 0x1b, 0x77, 0xcd, 0xa4, 0x5c, 0x58, 0x9d, 0xe4, 0xec, 0x35, 0xbb, 0x37, 0xcd, 0xb3, 0x8c,
-	0xb2, 0x54, 0x26, 0x32, 0xb6, 0xf0, 0x0b, 0x50, 0xdd, 0xc7, 0xe1, 0x31, 0x0c, 0x5d, 0xe4, 0xda,
-	0x04, 0x47, 0xa1, 0x83, 0xf4, 0x29, 0x6e, 0xc5, 0x7c, 0x02, 0xdf, 0xe5, 0xe0, 0xda, 0xcb, 0x12,
-	0x58, 0x19, 0x21, 0x58, 0x44, 0x45, 0x5b, 0x01, 0x80, 0x17, 0x00, 0xc5, 0x87, 0x28, 0xe0, 0xce,
-	0x96, 0xad, 0x12, 0x83, 0x34, 0x19, 0x40, 0xfb, 0x25, 0xd0, 0x62, 0x5b, 0x6d, 0xf4, 0x02, 0x39,
-	0x11, 0xab, 0x5c, 0xee, 0xb3, 0xba, 0xf1, 0x45, 0xd6, 0x27, 0x51, 0x76, 0xcc, 0x95, 0x58, 0xdb,
-	0x76, 0xcc, 0x60, 0x2d, 0x1c, 0xe7, 0x41, 0x5a, 0x03, 0x54, 0x12, 0xc9, 0xb4, 0xd7, 0x41, 0x32,
-	0x50, 0x9f, 0x9e, 0x27, 0xb4, 0xd9, 0xeb, 0x20, 0xab, 0x7c, 0x9c, 0xfa, 0xd2, 0x6e, 0x83, 0xa5,
-	0x4e, 0x88, 0xba, 0x1e, 0x8e, 0x88, 0x4d, 0x28, 0x0c, 0x29, 0x72, 0x6d, 0xd4, 0x45, 0x01, 0x65,
-	0xf9, 0x61, 0x91, 0x99, 0xb4, 0x16, 0x63, 0x82, 0x5d, 0x81, 0xdf, 0x66, 0xe8, 0x86, 0xab, 0xad,
-	0x83, 0xea, 0x00, 0xc7, 0x34, 0xe7, 0x98, 0x23, 0x59, 0x4a, 0x1d, 0xcc, 0x40, 0xca, 0x6c, 0xa3,
-	0x7a, 0x71, 0x4d, 0x59, 0x9f, 0xb6, 0xe2, 0x4f, 0xad, 0x06, 0x2a, 0x01, 0x7a, 0x41, 0xfb, 0x02,
-	0x66, 0xb8, 0x00, 0x95, 0x01, 0x63, 0xee, 0x2f, 0x81, 0xb6, 0x07, 0x9d, 0x43, 0x1f, 0xb7, 0x6c,
-	0x07, 0x47, 0x01, 0xb5, 0x0f, 0xbc, 0x80, 0xea, 0xb3, 0x9c, 0xb0, 0x2a, 0x31, 0x5b, 0x0c, 0xf1,
-	0xd0, 0x0b, 0xa8, 0xf6, 0x35, 0xd0, 0x09, 0xf5, 0x9c, 0xc3, 0x5e, 0x3f, 0xe6, 0x36, 0x0a, 0xe0,
-	0x9e, 0x8f, 0x5c, 0xbd, 0xb4, 0xa6, 0xac, 0xcf, 0x5a, 0x8b, 0x02, 0x9f, 0x84, 0x73, 0x5b, 0x60,
-	0xb5, 0x3b, 0x60, 0x9a, 0xf7, 0xa1, 0x0e, 0x86, 0x45, 0x93, 0xa3, 0xd2, 0xc1, 0x7c, 0xca, 0x00,
-	0x96, 0x60, 0xd1, 0x5a, 0xa9, 0x5c, 0xf3, 0x9a, 0xf0, 0x82, 0x7d, 0xac, 0xab, 0x5c, 0xd0, 0x6d,
-	0x73, 0xd8, 0xb8, 0x93, 0xdd, 0xc9, 0x24, 0x36, 0x43, 0x18, 0x10, 0x0f, 0x05, 0x34, 0x5d, 0x6a,
-	0x8d, 0x60, 0x1f, 0x5b, 0xd5, 0xe3, 0x1c, 0x44, 0x6b, 0x81, 0x95, 0xc1, 0xa2, 0xb2, 0xfb, 0x73,
-	0x48, 0x2f, 0x0f, 0x33, 0x3e, 0x19, 0x44, 0x5c, 0x5d, 0x52, 0xc8, 0xcb, 0x03, 0xa5, 0x95, 0xe0,
-	0x58, 0x2f, 0xef, 0x85, 0x30, 0x70, 0x0e, 0x64, 0x79, 0xcf, 0xf1, 0xf2, 0x56, 0x05, 0x4c, 0x14,
-	0xf8, 0x03, 0x30, 0x47, 0x9c, 0x03, 0xe4, 0x46, 0x3e, 0x72, 0x6d, 0x36, 0x7a, 0xf5, 0x79, 0xae,
-	0x7c, 0xd9, 0x14, 0x73, 0xd9, 0x8c, 0xe7, 0xb2, 0xd9, 0x8c, 0xe7, 0xf2, 0xbd, 0xa9, 0x57, 0xff,
-	0x5c, 0x55, 0xac, 0x4a, 0xc2, 0xc7, 0x30, 0xda, 0x16, 0x28, 0xc7, 0x95, 0xc4, 0xc5, 0x54, 0xc7,
-	0x14, 0xa3, 0x4a, 0x2e, 0x2e, 0xc4, 0x07, 0x33, 0x2c, 0x17, 0x1e, 0x22, 0xfa, 0xc2, 0xda, 0xe4,
-	0xba, 0xba, 0x61, 0x99, 0xe3, 0xad, 0x19, 0xf3, 0xcc, 0x2e, 0x37, 0x9f, 0x0a, 0xa1, 0xdb, 0x01,
-	0x0d, 0x7b, 0x56, 0xac, 0x62, 0xf9, 0x39, 0x28, 0xa7, 0x11, 0x5a, 0x15, 0x4c, 0x1e, 0xa2, 0x9e,
-	0x9c, 0x78, 0xec, 0x27, 0x2b, 0xa7, 0x2e, 0xf4, 0x23, 0x24, 0x3b, 0x7e, 0xcc, 0x72, 0xe2, 0x2c,
-	0x77, 0x0a, 0x5f, 0x2b, 0x3f, 0x99, 0x9a, 0xad, 0x54, 0xe7, 0x92, 0x99, 0xbb, 0xe9, 0x50, 0xaf,
-	0xeb, 0xd1, 0xde, 0x37, 0x6a, 0xe6, 0x8e, 0x32, 0xea, 0xd2, 0x33, 0xf7, 0x1f, 0xb3, 0x62, 0xe6,
-	0x0e, 0x11, 0xfc, 0xa1, 0x67, 0xee, 0x2a, 0x50, 0xa1, 0xb4, 0x8a, 0x85, 0x71, 0x92, 0x3b, 0x00,
-	0x62, 0x50, 0xc3, 0x65, 0x43, 0x39, 0x21, 0xe0, 0x43, 0x79, 0xea, 0xec, 0xa1, 0x9c, 0xf8, 0xc8,
-	0x87, 0x32, 0x4c, 0x7d, 0x69, 0xb7, 0xc0, 0xb4, 0x17, 0x74, 0x22, 0xca, 0xc7, 0xa9, 0xba, 0xb1,
-	0x36, 0x4a, 0xc4, 0x0e, 0xec, 0xf9, 0x18, 0xba, 0xc4, 0x12, 0xe4, 0x43, 0x1a, 0xb2, 0x78, 0xb9,
-	0x86, 0x7c, 0x06, 0x96, 0x62, 0x80, 0x4d, 0xb1, 0xed, 0xf8, 0x98, 0x20, 0x2e, 0x10, 0x47, 0x94,
-	0x8f, 0x68, 0x75, 0x63, 0x69, 0x40, 0xe6, 0x7d, 0x79, 0x9c, 0xdd, 0x9b, 0xfa, 0x03, 0x13, 0xb9,
-	0x18, 0x4b, 0x68, 0xe2, 0x2d, 0xc6, 0xdf, 0x14, 0xec, 0x03, 0xcd, 0x3e, 0x7b, 0x99, 0x66, 0x6f,
-	0x82, 0x45, 0xfe, 0x39, 0x68, 0x5d, 0x69, 0x3c, 0xeb, 0x3e, 0xe2, 0xec, 0x39, 0xd3, 0x1e, 0x81,
-	0x85, 0x03, 0x04, 0x43, 0xba, 0x87, 0x20, 0x4d, 0x04, 0x82, 0xf1, 0x04, 0x56, 0x13, 0xce, 0x58,
-	0x5a, 0x6a, 0xeb, 0xa9, 0xd9, 0xad, 0x87, 0x80, 0xe1, 0x44, 0x61, 0xc8, 0x56, 0x9e, 0x04, 0xd9,
-	0xb9, 0xbc, 0x95, 0xc7, 0x0c, 0xca, 0x35, 0x29, 0x67, 0x53, 0x88, 0xd9, 0xcd, 0x64, 0xf1, 0x71,
-	0xda, 0x1d, 0x17, 0x51, 0xe8, 0xf9, 0x44, 0xaf, 0x8c, 0x59, 0x52, 0x7d, 0x7f, 0xee, 0x0b, 0xce,
-	0xc1, 0xab, 0x63, 0xee, 0xd2, 0x57, 0xc7, 0xf7, 0x52, 0x6d, 0x9a, 0x4c, 0x2a, 0xbe, 0x3d, 0x4a,
-	0xfd, 0xde, 0x7b, 0x12, 0x23, 0xb4, 0x5b, 0xa0, 0x78, 0x80, 0xa0, 0x8b, 0x42, 0xb9, 0x19, 0x8c,
-	0x51, 0x2a, 0x1f, 0x72, 0x2a, 0x4b, 0x52, 0xd7, 0xfe, 0x3a, 0x09, 0x16, 0x37, 0x5d, 0x37, 0x3d,
-	0xdb, 0x2f, 0x30, 0x36, 0x1f, 0x80, 0xd2, 0x7b, 0x8c, 0x90, 0x3e, 0xaf, 0xb6, 0x25, 0x67, 0x96,
-	0x58, 0xd0, 0x93, 0x17, 0x58, 0xd0, 0x7c, 0xb2, 0x89, 0x7d, 0xbc, 0x0a, 0xd4, 0xa4, 0x25, 0x93,
-	0xd3, 0x0c, 0xc4, 0xa0, 0x86, 0x9b, 0xef, 0x59, 0xd9, 0x1e, 0xb2, 0x88, 0xa7, 0x2f, 0xdc, 0xb3,
-	0xfc, 0xd8, 0x8b, 0x4b, 0x79, 0xd8, 0x08, 0x2f, 0x0e, 0x1d, 0xe1, 0xda, 0x8f, 0x41, 0x51, 0x12,
-	0xb0, 0x39, 0x31, 0xb7, 0xb1, 0x3e, 0x74, 0x0b, 0xf3, 0x47, 0x4c, 0xec, 0xab, 0xe0, 0xb4, 0x24,
-	0x5f, 0x6d, 0x09, 0x7c, 0x3c, 0x90, 0x34, 0x31, 0xfd, 0x6b, 0xa7, 0x22, 0xa1, 0xe9, 0xf5, 0xf0,
-	0x21, 0x12, 0x6a, 0x82, 0x8f, 0x84, 0xad, 0x76, 0x46, 0xa5, 0xd8, 0x09, 0x0b, 0x02, 0xf5, 0x24,
-	0xa5, 0x38, 0x5b, 0x00, 0x53, 0x57, 0x52, 0x00, 0xd3, 0x17, 0x2b, 0x80, 0xe2, 0xd5, 0x17, 0xc0,
-	0xcc, 0x79, 0x05, 0x30, 0xfb, 0x5e, 0x05, 0x90, 0x4d, 0xb2, 0x2c, 0x80, 0xdf, 0x15, 0xc0, 0xb7,
-	0xf8, 0xa5, 0x14, 0xe7, 0xe7, 0x02, 0xe9, 0xcf, 0x66, 0xa1, 0x70, 0xb9, 0x2c, 0x3c, 0x03, 0x15,
-	0x7e, 0xba, 0xe5, 0xee, 0xa5, 0xaf, 0xce, 0xbd, 0x97, 0x86, 0x59, 0x6d, 0x95, 0xb9, 0xac, 0x4b,
-	0x1c, 0x4a, 0x7f, 0x51, 0xc0, 0xb7, 0x73, 0x12, 0xe5, 0x81, 0xb4, 0x05, 0xca, 0xb1, 0x81, 0x24,
-	0xf2, 0x29, 0x0f, 0xc4, 0x38, 0xf3, 0x5e, 0x95, 0xa6, 0x30, 0x26, 0xed, 0xa7, 0x60, 0x2e, 0x16,
-	0xf2, 0x6b, 0xe4, 0x50, 0xe4, 0x9e, 0x73, 0xc4, 0x8a, 0xe3, 0x55, 0xd2, 0x5a, 0x95, 0xa3, 0xf4,
-	0x67, 0xed, 0xf7, 0x05, 0xb0, 0x26, 0xcc, 0x73, 0x39, 0x1d, 0x8b, 0xeb, 0x16, 0x6e, 0x77, 0x7c,
-	0xc4, 0x88, 0xff, 0xcf, 0xf9, 0xfb, 0x18, 0xcc, 0x88, 0xf7, 0x59, 0xdc, 0xae, 0x45, 0xf6, 0xd9,
-	0x70, 0xb5, 0x00, 0x2c, 0x38, 0xb1, 0x51, 0x49, 0x72, 0x45, 0xab, 0x6e, 0x9e, 0x9b, 0xdc, 0xf3,
-	0xdc, 0xb3, 0xaa, 0x4e, 0x0e, 0x52, 0xfb, 0x04, 0xdc, 0x38, 0x83, 0x4b, 0x96, 0xfb, 0x7f, 0x15,
-	0x70, 0x7d, 0x0b, 0x06, 0x0e, 0xf2, 0x7f, 0x16, 0x51, 0x42, 0x61, 0xe0, 0x7a, 0x41, 0x6b, 0x27,
-	0x75, 0x5b, 0x8f, 0x11, 0xb6, 0x47, 0x60, 0xbe, 0x1f, 0x36, 0xb1, 0xb8, 0x0b, 0xbc, 0x31, 0x73,
-	0xb1, 0xcb, 0x74, 0x24, 0x0f, 0x16, 0x5f, 0xdc, 0x15, 0x9a, 0xfe, 0xbc, 0x9a, 0x5d, 0x96, 0x79,
-	0x90, 0x4c, 0x65, 0x1f, 0x24, 0xb5, 0x55, 0xb0, 0x32, 0xc2, 0x65, 0x19, 0x94, 0x3f, 0x29, 0x40,
-	0xbf, 0x8f, 0x88, 0x13, 0x7a, 0x7b, 0xe8, 0x32, 0xcf, 0xa1, 0x5f, 0x81, 0xb2, 0x8b, 0x88, 0x93,
-	0x24, 0xb9, 0x90, 0x7f, 0xa5, 0x8f, 0x48, 0xf2, 0x28, 0x9d, 0x96, 0xca, 0xc4, 0xc5, 0x79, 0xfd,
-	0x9b, 0x02, 0x96, 0x86, 0x50, 0xca, 0xee, 0xfc, 0x11, 0x98, 0x11, 0x8e, 0x12, 0x5d, 0xe1, 0x8f,
-	0xd4, 0xef, 0x9c, 0x11, 0xbb, 0x1d, 0x11, 0x92, 0x60, 0x1f, 0x5b, 0x31, 0x97, 0xf6, 0x73, 0xb0,
-	0x90, 0xca, 0x26, 0xa1, 0x90, 0x46, 0x44, 0x7a, 0xf0, 0xdd, 0x71, 0xd2, 0xb0, 0xcb, 0x39, 0xac,
-	0x79, 0x9a, 0x05, 0xd4, 0x5e, 0x2a, 0xc0, 0x78, 0xe4, 0x11, 0x9a, 0x10, 0xee, 0xc0, 0x90, 0x7a,
-	0x6c, 0x33, 0x90, 0x38, 0xb4, 0xd7, 0x41, 0xa9, 0x7f, 0xab, 0x89, 0xb8, 0xf6, 0x01, 0x57, 0xd2,
-	0x9d, 0xb5, 0x3f, 0x16, 0xc0, 0xea, 0x48, 0x2b, 0x64, 0x08, 0x7f, 0x03, 0x8c, 0xfe, 0x3b, 0xab,
-	0x1f, 0x8a, 0x4e, 0x42, 0x29, 0x23, 0xfb, 0xd5, 0x38, 0xca, 0x13, 0xf9, 0x8f, 0x11, 0x85, 0x2e,
-	0xa4, 0xd0, 0xba, 0x06, 0xf3, 0x6f, 0xcf, 0xbe, 0x0d, 0x4c, 0x77, 0xf6, 0x6f, 0x9e, 0x01, 0xdd,
-	0x85, 0xf7, 0xd2, 0x7d, 0x9c, 0xff, 0x17, 0xa2, 0xaf, 0xfb, 0x5e, 0xf8, 0xfa, 0xad, 0x31, 0xf1,
-	0xe6, 0xad, 0x31, 0xf1, 0xee, 0xad, 0xa1, 0xfc, 0xf6, 0xc4, 0x50, 0xfe, 0x7c, 0x62, 0x28, 0x7f,
-	0x3f, 0x31, 0x94, 0xd7, 0x27, 0x86, 0xf2, 0xaf, 0x13, 0x43, 0xf9, 0xf7, 0x89, 0x31, 0xf1, 0xee,
-	0xc4, 0x50, 0x5e, 0x9d, 0x1a, 0x13, 0xaf, 0x4f, 0x8d, 0x89, 0x37, 0xa7, 0xc6, 0xc4, 0xb3, 0x1f,
-	0xb6, 0x70, 0xdf, 0x16, 0x0f, 0x9f, 0xfd, 0x7f, 0xfd, 0x0f, 0x72, 0xa0, 0xbd, 0x22, 0xbf, 0x13,
-	0xbe, 0xff, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf8, 0x11, 0x60, 0x20, 0xf0, 0x17, 0x00, 0x00,
+var xxx_messageInfo_DescribeTaskQueuePartitionsRequest proto.InternalMessageInfo
+
+func (m *DescribeTaskQueuePartitionsRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
 }
 
-func (this *PollWorkflowTaskQueueRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *DescribeTaskQueuePartitionsRequest) GetTaskQueue() string {
+	if m != nil {
+		return m.TaskQueue
 	}
+	return ""
+}
 
-	that1, ok := that.(*PollWorkflowTaskQueueRequest)
-	if !ok {
-		that2, ok := that.(PollWorkflowTaskQueueRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *DescribeTaskQueuePartitionsRequest) GetTaskQueueType() v16.TaskQueueType {
+	if m != nil {
+		return m.TaskQueueType
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return v16.TASK_QUEUE_TYPE_UNSPECIFIED
+}
+
+type DescribeTaskQueuePartitionsResponse struct {
+	Partitions []*v17.TaskQueuePartitionStatus `protobuf:"bytes,1,rep,name=partitions,proto3" json:"partitions,omitempty"`
+}
+
+func (m *DescribeTaskQueuePartitionsResponse) Reset()      { *m = DescribeTaskQueuePartitionsResponse{} }
+func (*DescribeTaskQueuePartitionsResponse) ProtoMessage() {}
+func (*DescribeTaskQueuePartitionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{19}
+}
+func (m *DescribeTaskQueuePartitionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DescribeTaskQueuePartitionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeTaskQueuePartitionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.NamespaceId != that1.NamespaceId {
-		return false
+}
+func (m *DescribeTaskQueuePartitionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeTaskQueuePartitionsResponse.Merge(m, src)
+}
+func (m *DescribeTaskQueuePartitionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeTaskQueuePartitionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeTaskQueuePartitionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeTaskQueuePartitionsResponse proto.InternalMessageInfo
+
+func (m *DescribeTaskQueuePartitionsResponse) GetPartitions() []*v17.TaskQueuePartitionStatus {
+	if m != nil {
+		return m.Partitions
 	}
-	if this.PollerId != that1.PollerId {
-		return false
+	return nil
+}
+
+type GetTaskQueueUserDataRequest struct {
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	// Name of the task queue or of any of its partitions.
+	TaskQueue string `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	// User data is not returned if its version is not greater than the last known version.
+	LastKnownUserDataVersion int64 `protobuf:"varint,3,opt,name=last_known_user_data_version,json=lastKnownUserDataVersion,proto3" json:"last_known_user_data_version,omitempty"`
+}
+
+func (m *GetTaskQueueUserDataRequest) Reset()      { *m = GetTaskQueueUserDataRequest{} }
+func (*GetTaskQueueUserDataRequest) ProtoMessage() {}
+func (*GetTaskQueueUserDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{20}
+}
+func (m *GetTaskQueueUserDataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTaskQueueUserDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTaskQueueUserDataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if !this.PollRequest.Equal(that1.PollRequest) {
-		return false
+}
+func (m *GetTaskQueueUserDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTaskQueueUserDataRequest.Merge(m, src)
+}
+func (m *GetTaskQueueUserDataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTaskQueueUserDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTaskQueueUserDataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTaskQueueUserDataRequest proto.InternalMessageInfo
+
+func (m *GetTaskQueueUserDataRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
 	}
-	if this.ForwardedSource != that1.ForwardedSource {
-		return false
+	return ""
+}
+
+func (m *GetTaskQueueUserDataRequest) GetTaskQueue() string {
+	if m != nil {
+		return m.TaskQueue
 	}
-	return true
+	return ""
 }
-func (this *PollWorkflowTaskQueueResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *GetTaskQueueUserDataRequest) GetLastKnownUserDataVersion() int64 {
+	if m != nil {
+		return m.LastKnownUserDataVersion
 	}
+	return 0
+}
 
-	that1, ok := that.(*PollWorkflowTaskQueueResponse)
-	if !ok {
-		that2, ok := that.(PollWorkflowTaskQueueResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type GetTaskQueueUserDataResponse struct {
+	UserData *v18.TaskQueueUserData `protobuf:"bytes,1,opt,name=user_data,json=userData,proto3" json:"user_data,omitempty"`
+}
+
+func (m *GetTaskQueueUserDataResponse) Reset()      { *m = GetTaskQueueUserDataResponse{} }
+func (*GetTaskQueueUserDataResponse) ProtoMessage() {}
+func (*GetTaskQueueUserDataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{21}
+}
+func (m *GetTaskQueueUserDataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTaskQueueUserDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTaskQueueUserDataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !bytes.Equal(this.TaskToken, that1.TaskToken) {
-		return false
+}
+func (m *GetTaskQueueUserDataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTaskQueueUserDataResponse.Merge(m, src)
+}
+func (m *GetTaskQueueUserDataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTaskQueueUserDataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTaskQueueUserDataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTaskQueueUserDataResponse proto.InternalMessageInfo
+
+func (m *GetTaskQueueUserDataResponse) GetUserData() *v18.TaskQueueUserData {
+	if m != nil {
+		return m.UserData
 	}
-	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
-		return false
+	return nil
+}
+
+type UpdateTaskQueueUserDataRequest struct {
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	TaskQueue   string `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	// Version of the user data is ignored and incremented by the root partition.
+	UserData *v18.TaskQueueUserData `protobuf:"bytes,3,opt,name=user_data,json=userData,proto3" json:"user_data,omitempty"`
+}
+
+func (m *UpdateTaskQueueUserDataRequest) Reset()      { *m = UpdateTaskQueueUserDataRequest{} }
+func (*UpdateTaskQueueUserDataRequest) ProtoMessage() {}
+func (*UpdateTaskQueueUserDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{22}
+}
+func (m *UpdateTaskQueueUserDataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateTaskQueueUserDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateTaskQueueUserDataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if !this.WorkflowType.Equal(that1.WorkflowType) {
-		return false
+}
+func (m *UpdateTaskQueueUserDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateTaskQueueUserDataRequest.Merge(m, src)
+}
+func (m *UpdateTaskQueueUserDataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateTaskQueueUserDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateTaskQueueUserDataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateTaskQueueUserDataRequest proto.InternalMessageInfo
+
+func (m *UpdateTaskQueueUserDataRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
 	}
-	if this.PreviousStartedEventId != that1.PreviousStartedEventId {
-		return false
+	return ""
+}
+
+func (m *UpdateTaskQueueUserDataRequest) GetTaskQueue() string {
+	if m != nil {
+		return m.TaskQueue
 	}
-	if this.StartedEventId != that1.StartedEventId {
-		return false
+	return ""
+}
+
+func (m *UpdateTaskQueueUserDataRequest) GetUserData() *v18.TaskQueueUserData {
+	if m != nil {
+		return m.UserData
 	}
-	if this.Attempt != that1.Attempt {
-		return false
+	return nil
+}
+
+type UpdateTaskQueueUserDataResponse struct {
+	UserData *v18.TaskQueueUserData `protobuf:"bytes,1,opt,name=user_data,json=userData,proto3" json:"user_data,omitempty"`
+}
+
+func (m *UpdateTaskQueueUserDataResponse) Reset()      { *m = UpdateTaskQueueUserDataResponse{} }
+func (*UpdateTaskQueueUserDataResponse) ProtoMessage() {}
+func (*UpdateTaskQueueUserDataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{23}
+}
+func (m *UpdateTaskQueueUserDataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateTaskQueueUserDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateTaskQueueUserDataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.NextEventId != that1.NextEventId {
-		return false
-	}
-	if this.BacklogCountHint != that1.BacklogCountHint {
-		return false
-	}
-	if this.StickyExecutionEnabled != that1.StickyExecutionEnabled {
-		return false
-	}
-	if !this.Query.Equal(that1.Query) {
-		return false
+}
+func (m *UpdateTaskQueueUserDataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateTaskQueueUserDataResponse.Merge(m, src)
+}
+func (m *UpdateTaskQueueUserDataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateTaskQueueUserDataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateTaskQueueUserDataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateTaskQueueUserDataResponse proto.InternalMessageInfo
+
+func (m *UpdateTaskQueueUserDataResponse) GetUserData() *v18.TaskQueueUserData {
+	if m != nil {
+		return m.UserData
 	}
-	if !this.WorkflowTaskInfo.Equal(that1.WorkflowTaskInfo) {
-		return false
+	return nil
+}
+
+type UpdateWorkerBuildIdCompatibilityRequest struct {
+	NamespaceId string                          `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	TaskQueue   string                          `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	Update      *v17.BuildIdCompatibilityUpdate `protobuf:"bytes,3,opt,name=update,proto3" json:"update,omitempty"`
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest) Reset() {
+	*m = UpdateWorkerBuildIdCompatibilityRequest{}
+}
+func (*UpdateWorkerBuildIdCompatibilityRequest) ProtoMessage() {}
+func (*UpdateWorkerBuildIdCompatibilityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{24}
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateWorkerBuildIdCompatibilityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if !this.WorkflowExecutionTaskQueue.Equal(that1.WorkflowExecutionTaskQueue) {
-		return false
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateWorkerBuildIdCompatibilityRequest.Merge(m, src)
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateWorkerBuildIdCompatibilityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateWorkerBuildIdCompatibilityRequest proto.InternalMessageInfo
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
 	}
-	if !bytes.Equal(this.BranchToken, that1.BranchToken) {
-		return false
+	return ""
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest) GetTaskQueue() string {
+	if m != nil {
+		return m.TaskQueue
 	}
-	if that1.ScheduledTime == nil {
-		if this.ScheduledTime != nil {
-			return false
-		}
-	} else if !this.ScheduledTime.Equal(*that1.ScheduledTime) {
-		return false
+	return ""
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest) GetUpdate() *v17.BuildIdCompatibilityUpdate {
+	if m != nil {
+		return m.Update
 	}
-	if that1.StartedTime == nil {
-		if this.StartedTime != nil {
-			return false
+	return nil
+}
+
+type UpdateWorkerBuildIdCompatibilityResponse struct {
+	VersioningData *v18.VersioningData `protobuf:"bytes,1,opt,name=versioning_data,json=versioningData,proto3" json:"versioning_data,omitempty"`
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityResponse) Reset() {
+	*m = UpdateWorkerBuildIdCompatibilityResponse{}
+}
+func (*UpdateWorkerBuildIdCompatibilityResponse) ProtoMessage() {}
+func (*UpdateWorkerBuildIdCompatibilityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{25}
+}
+func (m *UpdateWorkerBuildIdCompatibilityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateWorkerBuildIdCompatibilityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateWorkerBuildIdCompatibilityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if !this.StartedTime.Equal(*that1.StartedTime) {
-		return false
+		return b[:n], nil
 	}
-	if len(this.Queries) != len(that1.Queries) {
-		return false
+}
+func (m *UpdateWorkerBuildIdCompatibilityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateWorkerBuildIdCompatibilityResponse.Merge(m, src)
+}
+func (m *UpdateWorkerBuildIdCompatibilityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateWorkerBuildIdCompatibilityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateWorkerBuildIdCompatibilityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateWorkerBuildIdCompatibilityResponse proto.InternalMessageInfo
+
+func (m *UpdateWorkerBuildIdCompatibilityResponse) GetVersioningData() *v18.VersioningData {
+	if m != nil {
+		return m.VersioningData
 	}
-	for i := range this.Queries {
-		if !this.Queries[i].Equal(that1.Queries[i]) {
-			return false
+	return nil
+}
+
+type GetWorkerBuildIdCompatibilityRequest struct {
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	TaskQueue   string `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	// Limits the number of returned sets, starting from the default set. 0 means no limit.
+	MaxSets int32 `protobuf:"varint,3,opt,name=max_sets,json=maxSets,proto3" json:"max_sets,omitempty"`
+}
+
+func (m *GetWorkerBuildIdCompatibilityRequest) Reset()      { *m = GetWorkerBuildIdCompatibilityRequest{} }
+func (*GetWorkerBuildIdCompatibilityRequest) ProtoMessage() {}
+func (*GetWorkerBuildIdCompatibilityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{26}
+}
+func (m *GetWorkerBuildIdCompatibilityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetWorkerBuildIdCompatibilityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetWorkerBuildIdCompatibilityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *PollActivityTaskQueueRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *GetWorkerBuildIdCompatibilityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetWorkerBuildIdCompatibilityRequest.Merge(m, src)
+}
+func (m *GetWorkerBuildIdCompatibilityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetWorkerBuildIdCompatibilityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetWorkerBuildIdCompatibilityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetWorkerBuildIdCompatibilityRequest proto.InternalMessageInfo
+
+func (m *GetWorkerBuildIdCompatibilityRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
 	}
+	return ""
+}
 
-	that1, ok := that.(*PollActivityTaskQueueRequest)
-	if !ok {
-		that2, ok := that.(PollActivityTaskQueueRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *GetWorkerBuildIdCompatibilityRequest) GetTaskQueue() string {
+	if m != nil {
+		return m.TaskQueue
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *GetWorkerBuildIdCompatibilityRequest) GetMaxSets() int32 {
+	if m != nil {
+		return m.MaxSets
 	}
-	if this.NamespaceId != that1.NamespaceId {
-		return false
+	return 0
+}
+
+type GetWorkerBuildIdCompatibilityResponse struct {
+	VersioningData *v18.VersioningData `protobuf:"bytes,1,opt,name=versioning_data,json=versioningData,proto3" json:"versioning_data,omitempty"`
+}
+
+func (m *GetWorkerBuildIdCompatibilityResponse) Reset()      { *m = GetWorkerBuildIdCompatibilityResponse{} }
+func (*GetWorkerBuildIdCompatibilityResponse) ProtoMessage() {}
+func (*GetWorkerBuildIdCompatibilityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{27}
+}
+func (m *GetWorkerBuildIdCompatibilityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetWorkerBuildIdCompatibilityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetWorkerBuildIdCompatibilityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.PollerId != that1.PollerId {
-		return false
+}
+func (m *GetWorkerBuildIdCompatibilityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetWorkerBuildIdCompatibilityResponse.Merge(m, src)
+}
+func (m *GetWorkerBuildIdCompatibilityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetWorkerBuildIdCompatibilityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetWorkerBuildIdCompatibilityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetWorkerBuildIdCompatibilityResponse proto.InternalMessageInfo
+
+func (m *GetWorkerBuildIdCompatibilityResponse) GetVersioningData() *v18.VersioningData {
+	if m != nil {
+		return m.VersioningData
 	}
-	if !this.PollRequest.Equal(that1.PollRequest) {
-		return false
+	return nil
+}
+
+type EvictStickyTaskQueueRequest struct {
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	TaskQueue   string `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+}
+
+func (m *EvictStickyTaskQueueRequest) Reset()      { *m = EvictStickyTaskQueueRequest{} }
+func (*EvictStickyTaskQueueRequest) ProtoMessage() {}
+func (*EvictStickyTaskQueueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{28}
+}
+func (m *EvictStickyTaskQueueRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvictStickyTaskQueueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvictStickyTaskQueueRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.ForwardedSource != that1.ForwardedSource {
-		return false
+}
+func (m *EvictStickyTaskQueueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvictStickyTaskQueueRequest.Merge(m, src)
+}
+func (m *EvictStickyTaskQueueRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvictStickyTaskQueueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvictStickyTaskQueueRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvictStickyTaskQueueRequest proto.InternalMessageInfo
+
+func (m *EvictStickyTaskQueueRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
 	}
-	return true
+	return ""
 }
-func (this *PollActivityTaskQueueResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *EvictStickyTaskQueueRequest) GetTaskQueue() string {
+	if m != nil {
+		return m.TaskQueue
 	}
+	return ""
+}
 
-	that1, ok := that.(*PollActivityTaskQueueResponse)
-	if !ok {
-		that2, ok := that.(PollActivityTaskQueueResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type EvictStickyTaskQueueResponse struct {
+}
+
+func (m *EvictStickyTaskQueueResponse) Reset()      { *m = EvictStickyTaskQueueResponse{} }
+func (*EvictStickyTaskQueueResponse) ProtoMessage() {}
+func (*EvictStickyTaskQueueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{29}
+}
+func (m *EvictStickyTaskQueueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvictStickyTaskQueueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvictStickyTaskQueueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *EvictStickyTaskQueueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvictStickyTaskQueueResponse.Merge(m, src)
+}
+func (m *EvictStickyTaskQueueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvictStickyTaskQueueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvictStickyTaskQueueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvictStickyTaskQueueResponse proto.InternalMessageInfo
+
+type GetHostProfileRequest struct {
+	//ip:port
+	HostAddress string         `protobuf:"bytes,1,opt,name=host_address,json=hostAddress,proto3" json:"host_address,omitempty"`
+	Profile     string         `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
+	Duration    *time.Duration `protobuf:"bytes,3,opt,name=duration,proto3,stdduration" json:"duration,omitempty"`
+}
+
+func (m *GetHostProfileRequest) Reset()      { *m = GetHostProfileRequest{} }
+func (*GetHostProfileRequest) ProtoMessage() {}
+func (*GetHostProfileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{30}
+}
+func (m *GetHostProfileRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetHostProfileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetHostProfileRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetHostProfileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetHostProfileRequest.Merge(m, src)
+}
+func (m *GetHostProfileRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetHostProfileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetHostProfileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetHostProfileRequest proto.InternalMessageInfo
+
+func (m *GetHostProfileRequest) GetHostAddress() string {
+	if m != nil {
+		return m.HostAddress
+	}
+	return ""
+}
+
+func (m *GetHostProfileRequest) GetProfile() string {
+	if m != nil {
+		return m.Profile
+	}
+	return ""
+}
+
+func (m *GetHostProfileRequest) GetDuration() *time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return nil
+}
+
+type GetHostProfileResponse struct {
+	Profile []byte `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+}
+
+func (m *GetHostProfileResponse) Reset()      { *m = GetHostProfileResponse{} }
+func (*GetHostProfileResponse) ProtoMessage() {}
+func (*GetHostProfileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{31}
+}
+func (m *GetHostProfileResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetHostProfileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetHostProfileResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetHostProfileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetHostProfileResponse.Merge(m, src)
+}
+func (m *GetHostProfileResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetHostProfileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetHostProfileResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetHostProfileResponse proto.InternalMessageInfo
+
+func (m *GetHostProfileResponse) GetProfile() []byte {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*PollWorkflowTaskQueueRequest)(nil), "temporal.server.api.matchingservice.v1.PollWorkflowTaskQueueRequest")
+	proto.RegisterType((*PollWorkflowTaskQueueResponse)(nil), "temporal.server.api.matchingservice.v1.PollWorkflowTaskQueueResponse")
+	proto.RegisterMapType((map[string]*v12.WorkflowQuery)(nil), "temporal.server.api.matchingservice.v1.PollWorkflowTaskQueueResponse.QueriesEntry")
+	proto.RegisterType((*PollActivityTaskQueueRequest)(nil), "temporal.server.api.matchingservice.v1.PollActivityTaskQueueRequest")
+	proto.RegisterType((*PollActivityTaskQueueResponse)(nil), "temporal.server.api.matchingservice.v1.PollActivityTaskQueueResponse")
+	proto.RegisterType((*AddWorkflowTaskRequest)(nil), "temporal.server.api.matchingservice.v1.AddWorkflowTaskRequest")
+	proto.RegisterType((*AddWorkflowTaskResponse)(nil), "temporal.server.api.matchingservice.v1.AddWorkflowTaskResponse")
+	proto.RegisterType((*AddActivityTaskRequest)(nil), "temporal.server.api.matchingservice.v1.AddActivityTaskRequest")
+	proto.RegisterType((*AddActivityTaskResponse)(nil), "temporal.server.api.matchingservice.v1.AddActivityTaskResponse")
+	proto.RegisterType((*QueryWorkflowRequest)(nil), "temporal.server.api.matchingservice.v1.QueryWorkflowRequest")
+	proto.RegisterType((*QueryWorkflowResponse)(nil), "temporal.server.api.matchingservice.v1.QueryWorkflowResponse")
+	proto.RegisterType((*RespondQueryTaskCompletedRequest)(nil), "temporal.server.api.matchingservice.v1.RespondQueryTaskCompletedRequest")
+	proto.RegisterType((*RespondQueryTaskCompletedResponse)(nil), "temporal.server.api.matchingservice.v1.RespondQueryTaskCompletedResponse")
+	proto.RegisterType((*CancelOutstandingPollRequest)(nil), "temporal.server.api.matchingservice.v1.CancelOutstandingPollRequest")
+	proto.RegisterType((*CancelOutstandingPollResponse)(nil), "temporal.server.api.matchingservice.v1.CancelOutstandingPollResponse")
+	proto.RegisterType((*DescribeTaskQueueRequest)(nil), "temporal.server.api.matchingservice.v1.DescribeTaskQueueRequest")
+	proto.RegisterType((*DescribeTaskQueueResponse)(nil), "temporal.server.api.matchingservice.v1.DescribeTaskQueueResponse")
+	proto.RegisterType((*ListTaskQueuePartitionsRequest)(nil), "temporal.server.api.matchingservice.v1.ListTaskQueuePartitionsRequest")
+	proto.RegisterType((*ListTaskQueuePartitionsResponse)(nil), "temporal.server.api.matchingservice.v1.ListTaskQueuePartitionsResponse")
+	proto.RegisterType((*DescribeTaskQueuePartitionsRequest)(nil), "temporal.server.api.matchingservice.v1.DescribeTaskQueuePartitionsRequest")
+	proto.RegisterType((*DescribeTaskQueuePartitionsResponse)(nil), "temporal.server.api.matchingservice.v1.DescribeTaskQueuePartitionsResponse")
+	proto.RegisterType((*GetTaskQueueUserDataRequest)(nil), "temporal.server.api.matchingservice.v1.GetTaskQueueUserDataRequest")
+	proto.RegisterType((*GetTaskQueueUserDataResponse)(nil), "temporal.server.api.matchingservice.v1.GetTaskQueueUserDataResponse")
+	proto.RegisterType((*UpdateTaskQueueUserDataRequest)(nil), "temporal.server.api.matchingservice.v1.UpdateTaskQueueUserDataRequest")
+	proto.RegisterType((*UpdateTaskQueueUserDataResponse)(nil), "temporal.server.api.matchingservice.v1.UpdateTaskQueueUserDataResponse")
+	proto.RegisterType((*UpdateWorkerBuildIdCompatibilityRequest)(nil), "temporal.server.api.matchingservice.v1.UpdateWorkerBuildIdCompatibilityRequest")
+	proto.RegisterType((*UpdateWorkerBuildIdCompatibilityResponse)(nil), "temporal.server.api.matchingservice.v1.UpdateWorkerBuildIdCompatibilityResponse")
+	proto.RegisterType((*GetWorkerBuildIdCompatibilityRequest)(nil), "temporal.server.api.matchingservice.v1.GetWorkerBuildIdCompatibilityRequest")
+	proto.RegisterType((*GetWorkerBuildIdCompatibilityResponse)(nil), "temporal.server.api.matchingservice.v1.GetWorkerBuildIdCompatibilityResponse")
+	proto.RegisterType((*EvictStickyTaskQueueRequest)(nil), "temporal.server.api.matchingservice.v1.EvictStickyTaskQueueRequest")
+	proto.RegisterType((*EvictStickyTaskQueueResponse)(nil), "temporal.server.api.matchingservice.v1.EvictStickyTaskQueueResponse")
+	proto.RegisterType((*GetHostProfileRequest)(nil), "temporal.server.api.matchingservice.v1.GetHostProfileRequest")
+	proto.RegisterType((*GetHostProfileResponse)(nil), "temporal.server.api.matchingservice.v1.GetHostProfileResponse")
+}
+
+func init() {
+	proto.RegisterFile("temporal/server/api/matchingservice/v1/request_response.proto", fileDescriptor_a429a3813476c583)
+}
+
+var fileDescriptor_a429a3813476c583 = []byte{
+	// 2191 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x4f, 0x70, 0xdc, 0x56,
+	0x19, 0xb7, 0xbc, 0xfe, 0xb3, 0xfb, 0xed, 0xfa, 0x9f, 0x4a, 0x1c, 0xd9, 0xb1, 0x65, 0x47, 0x49,
+	0x13, 0x97, 0x29, 0xeb, 0x89, 0x99, 0x64, 0xd2, 0xb4, 0x05, 0x12, 0x27, 0xe3, 0x98, 0xa6, 0xc5,
+	0x91, 0xdd, 0x84, 0x09, 0xcc, 0x28, 0xcf, 0xd2, 0xf3, 0x5a, 0x58, 0x2b, 0x6d, 0xf4, 0x9e, 0xd6,
+	0x31, 0x27, 0x98, 0x32, 0x70, 0x2d, 0xc3, 0x05, 0x86, 0x03, 0x57, 0xb8, 0x33, 0xdc, 0xb9, 0x71,
+	0xe0, 0x90, 0x63, 0x67, 0x38, 0x40, 0x9c, 0x0b, 0x33, 0x5c, 0xca, 0x89, 0x2b, 0xf3, 0xfe, 0x48,
+	0x2b, 0xed, 0x6a, 0xff, 0xd8, 0x75, 0xd3, 0xde, 0x56, 0xdf, 0xfb, 0xbe, 0xdf, 0xf7, 0xff, 0x7b,
+	0x9f, 0x64, 0xc3, 0xfb, 0x14, 0xd7, 0x1b, 0x41, 0x88, 0xbc, 0x55, 0x82, 0xc3, 0x26, 0x0e, 0x57,
+	0x51, 0xc3, 0x5d, 0xad, 0x23, 0x6a, 0xef, 0xbb, 0x7e, 0x8d, 0x91, 0x5c, 0x1b, 0xaf, 0x36, 0xaf,
+	0xad, 0x86, 0xf8, 0x59, 0x84, 0x09, 0xb5, 0x42, 0x4c, 0x1a, 0x81, 0x4f, 0x70, 0xb5, 0x11, 0x06,
+	0x34, 0x50, 0xaf, 0xc4, 0xe2, 0x55, 0x21, 0x5e, 0x45, 0x0d, 0xb7, 0xda, 0x26, 0x5e, 0x6d, 0x5e,
+	0x9b, 0xd7, 0x6b, 0x41, 0x50, 0xf3, 0xf0, 0x2a, 0x97, 0xda, 0x8d, 0xf6, 0x56, 0x9d, 0x28, 0x44,
+	0xd4, 0x0d, 0x7c, 0x81, 0x33, 0xbf, 0xd4, 0x7e, 0x4e, 0xdd, 0x3a, 0x26, 0x14, 0xd5, 0x1b, 0x92,
+	0xe1, 0xa2, 0x83, 0x1b, 0xd8, 0x77, 0xb0, 0x6f, 0xbb, 0x98, 0xac, 0xd6, 0x82, 0x5a, 0xc0, 0xe9,
+	0xfc, 0x97, 0x64, 0xb9, 0x9c, 0xb8, 0xc2, 0x7c, 0xb0, 0x83, 0x7a, 0x3d, 0xf0, 0x99, 0xe9, 0x75,
+	0x4c, 0x08, 0xaa, 0x49, 0x8b, 0xe7, 0xaf, 0x64, 0xb8, 0xb0, 0x1f, 0xd5, 0x09, 0x63, 0xa2, 0x88,
+	0x1c, 0x58, 0xcf, 0x22, 0x1c, 0xc5, 0x7c, 0x57, 0x33, 0x7c, 0xec, 0x98, 0x9f, 0x76, 0x02, 0x5e,
+	0xca, 0x30, 0x3e, 0x8b, 0x70, 0x78, 0xd4, 0xc9, 0x74, 0x35, 0x2f, 0xcc, 0x19, 0xe5, 0x92, 0xf1,
+	0xed, 0x3c, 0xc6, 0x7d, 0x97, 0xd0, 0x20, 0x0f, 0xb6, 0x9a, 0xc7, 0xdd, 0xc0, 0x21, 0x71, 0x09,
+	0xc5, 0xbe, 0x8d, 0x63, 0x70, 0xd2, 0x8b, 0xbf, 0x87, 0x6f, 0x37, 0x32, 0xbe, 0x1d, 0x06, 0xe1,
+	0xc1, 0x9e, 0x17, 0x1c, 0xf6, 0x2d, 0x0b, 0xe3, 0x3f, 0x0a, 0x2c, 0x6c, 0x05, 0x9e, 0xf7, 0x58,
+	0x4a, 0xec, 0x20, 0x72, 0xf0, 0x90, 0xa9, 0x30, 0x05, 0xbf, 0x7a, 0x11, 0x2a, 0x3e, 0xaa, 0x63,
+	0xd2, 0x40, 0x36, 0xb6, 0x5c, 0x47, 0x53, 0x96, 0x95, 0x95, 0x92, 0x59, 0x4e, 0x68, 0x9b, 0x8e,
+	0x7a, 0x01, 0x4a, 0x8d, 0xc0, 0xf3, 0x70, 0xc8, 0xce, 0x87, 0xf9, 0x79, 0x51, 0x10, 0x36, 0x1d,
+	0xf5, 0x29, 0x54, 0xd8, 0x6f, 0x4b, 0xea, 0xd7, 0x0a, 0xcb, 0xca, 0x4a, 0x79, 0xed, 0xfd, 0xc4,
+	0x3f, 0x5e, 0x87, 0x6d, 0xf6, 0x56, 0x9b, 0xd7, 0xaa, 0xbd, 0x8c, 0x32, 0xcb, 0x0c, 0x32, 0xb6,
+	0xf0, 0x2d, 0x98, 0xde, 0x0b, 0xc2, 0x43, 0x14, 0x3a, 0xd8, 0xb1, 0x48, 0x10, 0x85, 0x36, 0xd6,
+	0x46, 0xb8, 0x15, 0x53, 0x09, 0x7d, 0x9b, 0x93, 0x8d, 0x4f, 0x4a, 0xb0, 0xd8, 0x05, 0x58, 0x44,
+	0x45, 0x5d, 0x04, 0xe0, 0x05, 0x46, 0x83, 0x03, 0xec, 0x73, 0x67, 0x2b, 0x66, 0x89, 0x51, 0x76,
+	0x18, 0x41, 0xfd, 0x21, 0xa8, 0xb1, 0xad, 0x16, 0x7e, 0x8e, 0xed, 0x88, 0x75, 0x06, 0xf7, 0xb9,
+	0xbc, 0xf6, 0x56, 0xd6, 0x27, 0x51, 0xd6, 0xcc, 0x95, 0x58, 0xdb, 0xbd, 0x58, 0xc0, 0x9c, 0x39,
+	0x6c, 0x27, 0xa9, 0x9b, 0x30, 0x91, 0x20, 0xd3, 0xa3, 0x06, 0x96, 0x81, 0xba, 0xdc, 0x0f, 0x74,
+	0xe7, 0xa8, 0x81, 0xcd, 0xca, 0x61, 0xea, 0x49, 0x7d, 0x07, 0xe6, 0x1a, 0x21, 0x6e, 0xba, 0x41,
+	0x44, 0x2c, 0x42, 0x51, 0x48, 0xb1, 0x63, 0xe1, 0x26, 0xf6, 0x29, 0xcb, 0x0f, 0x8b, 0x4c, 0xc1,
+	0x9c, 0x8d, 0x19, 0xb6, 0xc5, 0xf9, 0x3d, 0x76, 0xbc, 0xe9, 0xa8, 0x2b, 0x30, 0xdd, 0x21, 0x31,
+	0xca, 0x25, 0x26, 0x49, 0x96, 0x53, 0x83, 0x71, 0x44, 0x99, 0x6d, 0x54, 0x1b, 0x5b, 0x56, 0x56,
+	0x46, 0xcd, 0xf8, 0x51, 0x35, 0x60, 0xc2, 0xc7, 0xcf, 0x69, 0x0b, 0x60, 0x9c, 0x03, 0x94, 0x19,
+	0x31, 0x96, 0x7e, 0x1b, 0xd4, 0x5d, 0x64, 0x1f, 0x78, 0x41, 0xcd, 0xb2, 0x83, 0xc8, 0xa7, 0xd6,
+	0xbe, 0xeb, 0x53, 0xad, 0xc8, 0x19, 0xa7, 0xe5, 0xc9, 0x3a, 0x3b, 0xb8, 0xef, 0xfa, 0x54, 0xbd,
+	0x09, 0x1a, 0xa1, 0xae, 0x7d, 0x70, 0xd4, 0x8a, 0xb9, 0x85, 0x7d, 0xb4, 0xeb, 0x61, 0x47, 0x2b,
+	0x2d, 0x2b, 0x2b, 0x45, 0x73, 0x56, 0x9c, 0x27, 0xe1, 0xbc, 0x27, 0x4e, 0xd5, 0x5b, 0x30, 0xca,
+	0xfb, 0x5c, 0x83, 0xbc, 0x68, 0xf2, 0xa3, 0x74, 0x30, 0x1f, 0x32, 0x82, 0x29, 0x44, 0xd4, 0x5a,
+	0x2a, 0xd7, 0xbc, 0x26, 0x5c, 0x7f, 0x2f, 0xd0, 0xca, 0x1c, 0xe8, 0x9d, 0x6a, 0xde, 0x38, 0x95,
+	0xdd, 0xcf, 0x10, 0x77, 0x42, 0xe4, 0x13, 0x17, 0xfb, 0x34, 0x5d, 0x6a, 0x9b, 0xfe, 0x5e, 0x60,
+	0x4e, 0x1f, 0xb6, 0x51, 0xd4, 0x1a, 0x2c, 0x76, 0x16, 0x95, 0xd5, 0x9a, 0x73, 0x5a, 0x25, 0xcf,
+	0xf8, 0x64, 0x18, 0x70, 0x75, 0x49, 0x21, 0xcf, 0x77, 0x94, 0x56, 0x72, 0xc6, 0x7a, 0x79, 0x37,
+	0x44, 0xbe, 0xbd, 0x2f, 0xcb, 0x7b, 0x92, 0x97, 0x77, 0x59, 0xd0, 0x44, 0x81, 0x6f, 0xc0, 0x24,
+	0xb1, 0xf7, 0xb1, 0x13, 0x79, 0xd8, 0xb1, 0xd8, 0x68, 0xd7, 0xa6, 0xb8, 0xf2, 0xf9, 0xaa, 0x98,
+	0xfb, 0xd5, 0x78, 0xee, 0x57, 0x77, 0xe2, 0xb9, 0x7f, 0x67, 0xe4, 0xd3, 0x7f, 0x2e, 0x29, 0xe6,
+	0x44, 0x22, 0xc7, 0x4e, 0xd4, 0x75, 0xa8, 0xc4, 0x95, 0xc4, 0x61, 0xa6, 0x07, 0x84, 0x29, 0x4b,
+	0x29, 0x0e, 0xe2, 0xc1, 0x38, 0xcb, 0x85, 0x8b, 0x89, 0x36, 0xb3, 0x5c, 0x58, 0x29, 0xaf, 0x99,
+	0xd5, 0xc1, 0xae, 0xb1, 0x6a, 0xcf, 0x2e, 0xaf, 0x3e, 0x14, 0xa0, 0xf7, 0x7c, 0x1a, 0x1e, 0x99,
+	0xb1, 0x8a, 0xf9, 0xa7, 0x50, 0x49, 0x1f, 0xa8, 0xd3, 0x50, 0x38, 0xc0, 0x47, 0x72, 0xe2, 0xb1,
+	0x9f, 0xac, 0x9c, 0x9a, 0xc8, 0x8b, 0xb0, 0x36, 0x9c, 0x97, 0x91, 0x6e, 0xe5, 0xc4, 0x45, 0x6e,
+	0x0d, 0xdf, 0x54, 0xbe, 0x3f, 0x52, 0x9c, 0x98, 0x9e, 0x4c, 0x66, 0xee, 0x6d, 0x9b, 0xba, 0x4d,
+	0x97, 0x1e, 0x7d, 0xad, 0x66, 0x6e, 0x37, 0xa3, 0x4e, 0x3d, 0x73, 0xff, 0x5e, 0x84, 0xc5, 0x2e,
+	0xc0, 0x5f, 0xf5, 0xcc, 0x5d, 0x82, 0x32, 0x92, 0x56, 0xb1, 0x30, 0x16, 0xb8, 0x03, 0x10, 0x93,
+	0x36, 0x1d, 0x36, 0x94, 0x13, 0x06, 0x3e, 0x94, 0x47, 0x7a, 0x0f, 0xe5, 0xc4, 0x47, 0x3e, 0x94,
+	0x51, 0xea, 0x49, 0xbd, 0x01, 0xa3, 0xae, 0xdf, 0x88, 0x28, 0x1f, 0xa7, 0xe5, 0xb5, 0xe5, 0x6e,
+	0x10, 0x5b, 0xe8, 0xc8, 0x0b, 0x90, 0x43, 0x4c, 0xc1, 0x9e, 0xd3, 0x90, 0x63, 0xa7, 0x6b, 0xc8,
+	0x27, 0x30, 0x17, 0x13, 0x2c, 0x1a, 0x58, 0xb6, 0x17, 0x10, 0xcc, 0x01, 0x83, 0x88, 0xf2, 0x11,
+	0x5d, 0x5e, 0x9b, 0xeb, 0xc0, 0xbc, 0x2b, 0x97, 0xbf, 0x3b, 0x23, 0xbf, 0x65, 0x90, 0xb3, 0x31,
+	0xc2, 0x4e, 0xb0, 0xce, 0xe4, 0x77, 0x84, 0x78, 0x47, 0xb3, 0x17, 0x4f, 0xd3, 0xec, 0x3b, 0x30,
+	0xcb, 0x1f, 0x3b, 0xad, 0x2b, 0x0d, 0x66, 0xdd, 0x1b, 0x5c, 0xbc, 0xcd, 0xb4, 0x07, 0x30, 0xb3,
+	0x8f, 0x51, 0x48, 0x77, 0x31, 0xa2, 0x09, 0x20, 0x0c, 0x06, 0x38, 0x9d, 0x48, 0xc6, 0x68, 0xa9,
+	0x5b, 0xaf, 0x9c, 0xbd, 0xf5, 0x30, 0xe8, 0x76, 0x14, 0x86, 0xec, 0xca, 0x93, 0x24, 0xab, 0x2d,
+	0x6f, 0x95, 0x01, 0x83, 0x72, 0x41, 0xe2, 0xdc, 0x16, 0x30, 0xdb, 0x99, 0x2c, 0x7e, 0x98, 0x76,
+	0xc7, 0xc1, 0x14, 0xb9, 0x1e, 0xd1, 0x26, 0x06, 0x2c, 0xa9, 0x96, 0x3f, 0x77, 0x85, 0x64, 0xe7,
+	0xd6, 0x31, 0x79, 0xea, 0xad, 0xe3, 0x5b, 0xa9, 0x36, 0x4d, 0x26, 0x15, 0xbf, 0x3d, 0x4a, 0xad,
+	0xde, 0xfb, 0x28, 0x3e, 0x50, 0x6f, 0xc0, 0xd8, 0x3e, 0x46, 0x0e, 0x0e, 0xe5, 0xcd, 0xa0, 0x77,
+	0x53, 0x79, 0x9f, 0x73, 0x99, 0x92, 0xdb, 0xf8, 0x47, 0x01, 0x66, 0x6f, 0x3b, 0x4e, 0x7a, 0xb6,
+	0x9f, 0x60, 0x6c, 0x6e, 0x40, 0xe9, 0x0b, 0x8c, 0x90, 0x96, 0xac, 0xba, 0x2e, 0x67, 0x96, 0xb8,
+	0xa0, 0x0b, 0x27, 0xb8, 0xa0, 0x4b, 0x34, 0xfe, 0xc9, 0xe6, 0x4f, 0xd2, 0x92, 0xc9, 0x6a, 0x06,
+	0x31, 0x69, 0xd3, 0x69, 0xef, 0x59, 0xd9, 0x1e, 0xb2, 0x88, 0x47, 0x4f, 0xdc, 0xb3, 0x7c, 0xd9,
+	0x8b, 0x4b, 0x39, 0x6f, 0x84, 0x8f, 0xe5, 0x8e, 0x70, 0xf5, 0x7b, 0x30, 0x26, 0x19, 0xd8, 0x9c,
+	0x98, 0x5c, 0x5b, 0xc9, 0xbd, 0x85, 0xf9, 0x4b, 0x52, 0xec, 0xab, 0x90, 0x34, 0xa5, 0x9c, 0x7a,
+	0x05, 0xa6, 0x58, 0x09, 0xe0, 0xd0, 0xda, 0x8d, 0x5c, 0xcf, 0x61, 0xde, 0x16, 0xb9, 0xae, 0x09,
+	0x41, 0xbe, 0xc3, 0xa8, 0x9b, 0x8e, 0x31, 0x07, 0xe7, 0x3b, 0x92, 0x2b, 0x6e, 0x09, 0xe3, 0x95,
+	0x48, 0x7c, 0xfa, 0x1a, 0xf9, 0x2a, 0x12, 0x5f, 0x85, 0x37, 0x84, 0x4f, 0x56, 0x46, 0xa5, 0xb8,
+	0x3b, 0x66, 0xc4, 0xd1, 0x47, 0x29, 0xc5, 0xd9, 0x42, 0x19, 0x39, 0x93, 0x42, 0x19, 0x3d, 0x59,
+	0xa1, 0x8c, 0x9d, 0x7d, 0xa1, 0x8c, 0xf7, 0x2b, 0x94, 0xe2, 0xe9, 0x0a, 0x45, 0x16, 0x40, 0x36,
+	0xc9, 0xb2, 0x00, 0x7e, 0x59, 0x80, 0x6f, 0xf0, 0x8d, 0x2a, 0xce, 0xcf, 0x09, 0xd2, 0x9f, 0xcd,
+	0xc2, 0xf0, 0xe9, 0xb2, 0xf0, 0x04, 0x26, 0xf8, 0x8a, 0xd7, 0xb6, 0x57, 0x5d, 0xef, 0xbb, 0x57,
+	0xe5, 0x59, 0x6d, 0x56, 0x38, 0xd6, 0xc9, 0x17, 0x2a, 0xf5, 0x31, 0x9c, 0x97, 0x6f, 0x43, 0x8e,
+	0x4b, 0x1a, 0x6c, 0xf5, 0x3d, 0xe9, 0x48, 0x38, 0x27, 0xe4, 0xef, 0x4a, 0xf1, 0x38, 0xd1, 0x39,
+	0x4d, 0x3a, 0x96, 0xd7, 0xa4, 0x7f, 0x52, 0xe0, 0x5c, 0x9b, 0x4b, 0x72, 0x93, 0x5b, 0x87, 0x4a,
+	0x1c, 0x21, 0x12, 0x79, 0x54, 0x53, 0x06, 0xbc, 0x98, 0xca, 0x32, 0x16, 0x4c, 0x48, 0xfd, 0x00,
+	0x26, 0x63, 0x90, 0x9f, 0x60, 0x9b, 0x62, 0xa7, 0xcf, 0xb6, 0x2d, 0xb6, 0x6c, 0xc9, 0x6b, 0x4e,
+	0x3c, 0x4b, 0x3f, 0x1a, 0xbf, 0x19, 0x86, 0x65, 0x61, 0x9e, 0xc3, 0xf9, 0x58, 0x62, 0xd7, 0x83,
+	0x7a, 0xc3, 0xc3, 0x8c, 0xf9, 0x35, 0x17, 0xd0, 0x79, 0x18, 0xe7, 0x20, 0xc9, 0xbc, 0x18, 0x63,
+	0x8f, 0x9b, 0x8e, 0xea, 0xc3, 0x8c, 0x1d, 0x1b, 0x95, 0x54, 0x97, 0x98, 0x15, 0xb7, 0xfb, 0x56,
+	0x57, 0x3f, 0xf7, 0xcc, 0x69, 0xbb, 0x8d, 0x62, 0x5c, 0x82, 0x8b, 0x3d, 0xa4, 0x64, 0xbf, 0xfd,
+	0x57, 0x81, 0x85, 0x75, 0xe4, 0xdb, 0xd8, 0xfb, 0x41, 0x44, 0x09, 0x45, 0xbe, 0xe3, 0xfa, 0xb5,
+	0xad, 0xd4, 0x4b, 0xc0, 0x00, 0x61, 0x7b, 0x00, 0x53, 0xad, 0xb0, 0x89, 0x0d, 0x63, 0x98, 0x4f,
+	0x86, 0xb6, 0xd8, 0x65, 0x46, 0x02, 0x0f, 0x16, 0xdf, 0x30, 0x26, 0x68, 0xfa, 0xf1, 0x6c, 0x2e,
+	0xdd, 0xcc, 0x9b, 0xd3, 0x48, 0xf6, 0xcd, 0xc9, 0x58, 0x82, 0xc5, 0x2e, 0x2e, 0xcb, 0xa0, 0xfc,
+	0x5e, 0x01, 0xed, 0x2e, 0x26, 0x76, 0xe8, 0xee, 0xe2, 0xd3, 0xbc, 0xb7, 0xfd, 0x18, 0x2a, 0x0e,
+	0x26, 0x76, 0x92, 0xe4, 0xe1, 0xf6, 0xcf, 0x09, 0x5d, 0x92, 0xdc, 0x4d, 0xa7, 0x59, 0x66, 0x70,
+	0x71, 0x5e, 0xff, 0x37, 0x0c, 0x73, 0x39, 0x9c, 0xb2, 0x3b, 0xbf, 0x0b, 0xe3, 0xc2, 0x51, 0xa2,
+	0x29, 0xfc, 0x6d, 0xfa, 0xcd, 0x1e, 0xb1, 0xdb, 0x12, 0x21, 0x61, 0x5f, 0x2c, 0x62, 0x29, 0xf5,
+	0x11, 0xcc, 0xa4, 0xb2, 0x49, 0x28, 0xa2, 0x11, 0x91, 0x1e, 0x7c, 0x73, 0x90, 0x34, 0x6c, 0x73,
+	0x09, 0x73, 0x8a, 0x66, 0x09, 0xea, 0x53, 0x50, 0x39, 0x6e, 0xc8, 0x57, 0xbc, 0x18, 0x58, 0xe4,
+	0x77, 0x2d, 0xf7, 0x0a, 0xe9, 0xc0, 0x37, 0xb9, 0xa8, 0x54, 0x30, 0x4d, 0xdb, 0x28, 0xaa, 0x05,
+	0x93, 0xf1, 0xf7, 0x26, 0x89, 0x2e, 0xba, 0xeb, 0xe6, 0x60, 0xe8, 0xdc, 0xd8, 0x3b, 0x02, 0x40,
+	0xea, 0x98, 0xd8, 0x4d, 0x3f, 0x1a, 0x9f, 0x28, 0xa0, 0x3f, 0x70, 0x09, 0x4d, 0xb8, 0xb7, 0x50,
+	0x48, 0x5d, 0x36, 0x73, 0x49, 0x5c, 0x1d, 0x0b, 0x50, 0x6a, 0xed, 0xc5, 0xa2, 0x34, 0x5a, 0x84,
+	0x33, 0x19, 0x30, 0xc6, 0xef, 0x86, 0x61, 0xa9, 0xab, 0x15, 0xb2, 0x0a, 0x7e, 0x0a, 0x7a, 0xeb,
+	0x9d, 0xb6, 0x95, 0xcd, 0x46, 0xc2, 0x29, 0x8b, 0xe3, 0xfa, 0x20, 0xca, 0x13, 0xfc, 0x0f, 0x31,
+	0x45, 0x0e, 0xa2, 0xc8, 0xbc, 0x80, 0xda, 0xdf, 0xf3, 0x5b, 0x36, 0x30, 0xdd, 0xd9, 0x4f, 0x6a,
+	0x1d, 0xba, 0x87, 0xbf, 0x90, 0xee, 0xc3, 0xf6, 0x2f, 0x3e, 0x2d, 0xdd, 0xc6, 0x9f, 0x15, 0x30,
+	0x3a, 0x7a, 0xa3, 0x33, 0x4b, 0x03, 0xf4, 0xf0, 0x62, 0x47, 0xaa, 0x4a, 0xe9, 0x01, 0x93, 0x33,
+	0xf3, 0x0a, 0xa7, 0x9e, 0x79, 0xc6, 0xcf, 0x15, 0xb8, 0xd4, 0xd3, 0x6c, 0x99, 0xd6, 0x27, 0x00,
+	0x1d, 0x29, 0xbc, 0x75, 0x82, 0xea, 0x4e, 0x20, 0x65, 0x7d, 0xa7, 0xd0, 0x8c, 0x3f, 0x28, 0x70,
+	0x61, 0x03, 0xb7, 0xaa, 0xea, 0x63, 0x82, 0xc3, 0xbb, 0x2c, 0xe0, 0x67, 0x16, 0xb3, 0xef, 0xc0,
+	0x82, 0x87, 0x08, 0xb5, 0x0e, 0xfc, 0xe0, 0xd0, 0xb7, 0x22, 0x82, 0x43, 0x8b, 0x65, 0xd4, 0x6a,
+	0xe2, 0x90, 0xb0, 0x8d, 0xbd, 0xc0, 0x37, 0x5e, 0x8d, 0xf1, 0x7c, 0xc0, 0x58, 0x62, 0x0b, 0x1e,
+	0x89, 0x73, 0x23, 0x84, 0x85, 0x7c, 0x03, 0x65, 0x74, 0x4c, 0x28, 0x25, 0xa0, 0x72, 0x2b, 0xb9,
+	0x9e, 0x1b, 0x9c, 0xd4, 0x9f, 0x64, 0x32, 0xe1, 0x49, 0x10, 0x8b, 0x91, 0xfc, 0x65, 0xfc, 0x45,
+	0x01, 0xfd, 0xe3, 0x86, 0x83, 0x28, 0xfe, 0x12, 0x03, 0x93, 0x31, 0xbc, 0x70, 0x36, 0x86, 0x47,
+	0xb0, 0xd4, 0xd5, 0xee, 0x2f, 0x31, 0x5e, 0x7f, 0x55, 0xe0, 0xaa, 0xd0, 0xfb, 0x38, 0xbd, 0x4e,
+	0xb2, 0xc5, 0x03, 0x51, 0x77, 0xd7, 0xf5, 0x5c, 0x7a, 0x74, 0x76, 0x81, 0xdb, 0x81, 0xb1, 0x88,
+	0x2b, 0x93, 0x51, 0x7b, 0xaf, 0x7f, 0x2f, 0xe4, 0x19, 0x24, 0x0c, 0x36, 0x25, 0x96, 0xf1, 0x2b,
+	0x05, 0x56, 0xfa, 0xfb, 0x20, 0x83, 0xf8, 0x23, 0x98, 0x92, 0xf5, 0xeb, 0xfa, 0xb5, 0x74, 0x28,
+	0xd7, 0x06, 0x09, 0xe5, 0xa3, 0x44, 0x94, 0xc7, 0x71, 0xb2, 0x99, 0x79, 0x66, 0x17, 0xce, 0xe5,
+	0x0d, 0x4c, 0x5f, 0x47, 0x28, 0xe7, 0xa0, 0x58, 0x47, 0xcf, 0x2d, 0x82, 0xa9, 0xb8, 0x94, 0x47,
+	0xcd, 0xf1, 0x3a, 0x7a, 0xbe, 0x8d, 0x29, 0x31, 0x7e, 0xa1, 0xc0, 0x9b, 0x7d, 0xac, 0x78, 0x1d,
+	0xc1, 0xb0, 0xe0, 0xc2, 0xbd, 0xa6, 0x6b, 0xd3, 0x6d, 0xfe, 0x5e, 0x73, 0x9a, 0xbd, 0xac, 0x77,
+	0x08, 0x0c, 0x1d, 0x16, 0xf2, 0x15, 0xc8, 0xb5, 0xf0, 0xd7, 0x0a, 0x9c, 0xdb, 0xc0, 0xf4, 0x7e,
+	0x40, 0xe8, 0x56, 0x18, 0xec, 0xb9, 0x5e, 0x5a, 0xf7, 0x7e, 0x40, 0xa8, 0x85, 0x1c, 0x27, 0xc4,
+	0x84, 0xc4, 0xba, 0x19, 0xed, 0xb6, 0x20, 0xb1, 0x8f, 0x8a, 0x0d, 0x21, 0x24, 0x15, 0xc7, 0x8f,
+	0xea, 0xbb, 0x50, 0x8c, 0xff, 0xfc, 0xae, 0x15, 0x06, 0x7b, 0xb7, 0x4b, 0x04, 0x8c, 0x35, 0x98,
+	0x6d, 0x37, 0x49, 0xe6, 0x22, 0xa5, 0x50, 0x7c, 0x6d, 0x8f, 0x1f, 0xef, 0x84, 0x2f, 0x5e, 0xea,
+	0x43, 0x9f, 0xbd, 0xd4, 0x87, 0x3e, 0x7f, 0xa9, 0x2b, 0x3f, 0x3b, 0xd6, 0x95, 0x3f, 0x1e, 0xeb,
+	0xca, 0xdf, 0x8e, 0x75, 0xe5, 0xc5, 0xb1, 0xae, 0xfc, 0xeb, 0x58, 0x57, 0xfe, 0x7d, 0xac, 0x0f,
+	0x7d, 0x7e, 0xac, 0x2b, 0x9f, 0xbe, 0xd2, 0x87, 0x5e, 0xbc, 0xd2, 0x87, 0x3e, 0x7b, 0xa5, 0x0f,
+	0x3d, 0x79, 0xaf, 0x16, 0xb4, 0x92, 0xe8, 0x06, 0xbd, 0xff, 0x41, 0xe1, 0xdd, 0x36, 0xd2, 0xee,
+	0x18, 0x77, 0xe5, 0xdb, 0xff, 0x1f, 0x00, 0x41, 0xcd, 0xe8, 0xd4, 0xe1, 0x20, 0x00, 0x00,
+}
+
+func (this *PollWorkflowTaskQueueRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PollWorkflowTaskQueueRequest)
+	if !ok {
+		that2, ok := that.(PollWorkflowTaskQueueRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if this.PollerId != that1.PollerId {
+		return false
+	}
+	if !this.PollRequest.Equal(that1.PollRequest) {
+		return false
+	}
+	if this.ForwardedSource != that1.ForwardedSource {
+		return false
+	}
+	return true
+}
+func (this *PollWorkflowTaskQueueResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PollWorkflowTaskQueueResponse)
+	if !ok {
+		that2, ok := that.(PollWorkflowTaskQueueResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.TaskToken, that1.TaskToken) {
+		return false
+	}
+	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
+		return false
+	}
+	if !this.WorkflowType.Equal(that1.WorkflowType) {
+		return false
+	}
+	if this.PreviousStartedEventId != that1.PreviousStartedEventId {
+		return false
+	}
+	if this.StartedEventId != that1.StartedEventId {
+		return false
+	}
+	if this.Attempt != that1.Attempt {
+		return false
+	}
+	if this.NextEventId != that1.NextEventId {
+		return false
+	}
+	if this.BacklogCountHint != that1.BacklogCountHint {
+		return false
+	}
+	if this.StickyExecutionEnabled != that1.StickyExecutionEnabled {
+		return false
+	}
+	if !this.Query.Equal(that1.Query) {
+		return false
+	}
+	if !this.WorkflowTaskInfo.Equal(that1.WorkflowTaskInfo) {
+		return false
+	}
+	if !this.WorkflowExecutionTaskQueue.Equal(that1.WorkflowExecutionTaskQueue) {
+		return false
+	}
+	if !bytes.Equal(this.BranchToken, that1.BranchToken) {
+		return false
+	}
+	if that1.ScheduledTime == nil {
+		if this.ScheduledTime != nil {
+			return false
+		}
+	} else if !this.ScheduledTime.Equal(*that1.ScheduledTime) {
+		return false
+	}
+	if that1.StartedTime == nil {
+		if this.StartedTime != nil {
+			return false
+		}
+	} else if !this.StartedTime.Equal(*that1.StartedTime) {
+		return false
+	}
+	if len(this.Queries) != len(that1.Queries) {
+		return false
+	}
+	for i := range this.Queries {
+		if !this.Queries[i].Equal(that1.Queries[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *PollActivityTaskQueueRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PollActivityTaskQueueRequest)
+	if !ok {
+		that2, ok := that.(PollActivityTaskQueueRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if this.PollerId != that1.PollerId {
+		return false
+	}
+	if !this.PollRequest.Equal(that1.PollRequest) {
+		return false
+	}
+	if this.ForwardedSource != that1.ForwardedSource {
+		return false
+	}
+	return true
+}
+func (this *PollActivityTaskQueueResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PollActivityTaskQueueResponse)
+	if !ok {
+		that2, ok := that.(PollActivityTaskQueueResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
 	if !bytes.Equal(this.TaskToken, that1.TaskToken) {
 		return false
@@ -1747,6 +2547,9 @@ func (this *AddWorkflowTaskRequest) Equal(that interface{}) bool {
 	if this.Source != that1.Source {
 		return false
 	}
+	if this.WorkerBuildId != that1.WorkerBuildId {
+		return false
+	}
 	return true
 }
 func (this *AddWorkflowTaskResponse) Equal(that interface{}) bool {
@@ -1873,6 +2676,18 @@ func (this *QueryWorkflowRequest) Equal(that interface{}) bool {
 	if this.ForwardedSource != that1.ForwardedSource {
 		return false
 	}
+	if this.StickyDispatchTimeout != nil && that1.StickyDispatchTimeout != nil {
+		if *this.StickyDispatchTimeout != *that1.StickyDispatchTimeout {
+			return false
+		}
+	} else if this.StickyDispatchTimeout != nil {
+		return false
+	} else if that1.StickyDispatchTimeout != nil {
+		return false
+	}
+	if this.WorkerBuildId != that1.WorkerBuildId {
+		return false
+	}
 	return true
 }
 func (this *QueryWorkflowResponse) Equal(that interface{}) bool {
@@ -2067,9 +2882,15 @@ func (this *DescribeTaskQueueResponse) Equal(that interface{}) bool {
 	if !this.TaskQueueStatus.Equal(that1.TaskQueueStatus) {
 		return false
 	}
-	return true
-}
-func (this *ListTaskQueuePartitionsRequest) Equal(that interface{}) bool {
+	if !this.TaskReaderStatus.Equal(that1.TaskReaderStatus) {
+		return false
+	}
+	if !this.BacklogStatus.Equal(that1.BacklogStatus) {
+		return false
+	}
+	return true
+}
+func (this *ListTaskQueuePartitionsRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
@@ -2133,642 +2954,913 @@ func (this *ListTaskQueuePartitionsResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *PollWorkflowTaskQueueRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *DescribeTaskQueuePartitionsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&matchingservice.PollWorkflowTaskQueueRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	s = append(s, "PollerId: "+fmt.Sprintf("%#v", this.PollerId)+",\n")
-	if this.PollRequest != nil {
-		s = append(s, "PollRequest: "+fmt.Sprintf("%#v", this.PollRequest)+",\n")
+
+	that1, ok := that.(*DescribeTaskQueuePartitionsRequest)
+	if !ok {
+		that2, ok := that.(DescribeTaskQueuePartitionsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "ForwardedSource: "+fmt.Sprintf("%#v", this.ForwardedSource)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *PollWorkflowTaskQueueResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 20)
-	s = append(s, "&matchingservice.PollWorkflowTaskQueueResponse{")
-	s = append(s, "TaskToken: "+fmt.Sprintf("%#v", this.TaskToken)+",\n")
-	if this.WorkflowExecution != nil {
-		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
+	if this.NamespaceId != that1.NamespaceId {
+		return false
 	}
-	if this.WorkflowType != nil {
-		s = append(s, "WorkflowType: "+fmt.Sprintf("%#v", this.WorkflowType)+",\n")
+	if this.TaskQueue != that1.TaskQueue {
+		return false
 	}
-	s = append(s, "PreviousStartedEventId: "+fmt.Sprintf("%#v", this.PreviousStartedEventId)+",\n")
-	s = append(s, "StartedEventId: "+fmt.Sprintf("%#v", this.StartedEventId)+",\n")
-	s = append(s, "Attempt: "+fmt.Sprintf("%#v", this.Attempt)+",\n")
-	s = append(s, "NextEventId: "+fmt.Sprintf("%#v", this.NextEventId)+",\n")
-	s = append(s, "BacklogCountHint: "+fmt.Sprintf("%#v", this.BacklogCountHint)+",\n")
-	s = append(s, "StickyExecutionEnabled: "+fmt.Sprintf("%#v", this.StickyExecutionEnabled)+",\n")
-	if this.Query != nil {
-		s = append(s, "Query: "+fmt.Sprintf("%#v", this.Query)+",\n")
+	if this.TaskQueueType != that1.TaskQueueType {
+		return false
 	}
-	if this.WorkflowTaskInfo != nil {
-		s = append(s, "WorkflowTaskInfo: "+fmt.Sprintf("%#v", this.WorkflowTaskInfo)+",\n")
+	return true
+}
+func (this *DescribeTaskQueuePartitionsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if this.WorkflowExecutionTaskQueue != nil {
-		s = append(s, "WorkflowExecutionTaskQueue: "+fmt.Sprintf("%#v", this.WorkflowExecutionTaskQueue)+",\n")
+
+	that1, ok := that.(*DescribeTaskQueuePartitionsResponse)
+	if !ok {
+		that2, ok := that.(DescribeTaskQueuePartitionsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "BranchToken: "+fmt.Sprintf("%#v", this.BranchToken)+",\n")
-	s = append(s, "ScheduledTime: "+fmt.Sprintf("%#v", this.ScheduledTime)+",\n")
-	s = append(s, "StartedTime: "+fmt.Sprintf("%#v", this.StartedTime)+",\n")
-	keysForQueries := make([]string, 0, len(this.Queries))
-	for k, _ := range this.Queries {
-		keysForQueries = append(keysForQueries, k)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForQueries)
-	mapStringForQueries := "map[string]*v12.WorkflowQuery{"
-	for _, k := range keysForQueries {
-		mapStringForQueries += fmt.Sprintf("%#v: %#v,", k, this.Queries[k])
+	if len(this.Partitions) != len(that1.Partitions) {
+		return false
 	}
-	mapStringForQueries += "}"
-	if this.Queries != nil {
-		s = append(s, "Queries: "+mapStringForQueries+",\n")
+	for i := range this.Partitions {
+		if !this.Partitions[i].Equal(that1.Partitions[i]) {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *PollActivityTaskQueueRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *GetTaskQueueUserDataRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&matchingservice.PollActivityTaskQueueRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	s = append(s, "PollerId: "+fmt.Sprintf("%#v", this.PollerId)+",\n")
-	if this.PollRequest != nil {
-		s = append(s, "PollRequest: "+fmt.Sprintf("%#v", this.PollRequest)+",\n")
+
+	that1, ok := that.(*GetTaskQueueUserDataRequest)
+	if !ok {
+		that2, ok := that.(GetTaskQueueUserDataRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "ForwardedSource: "+fmt.Sprintf("%#v", this.ForwardedSource)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *PollActivityTaskQueueResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 20)
-	s = append(s, "&matchingservice.PollActivityTaskQueueResponse{")
-	s = append(s, "TaskToken: "+fmt.Sprintf("%#v", this.TaskToken)+",\n")
-	if this.WorkflowExecution != nil {
-		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
+	if this.NamespaceId != that1.NamespaceId {
+		return false
 	}
-	s = append(s, "ActivityId: "+fmt.Sprintf("%#v", this.ActivityId)+",\n")
-	if this.ActivityType != nil {
-		s = append(s, "ActivityType: "+fmt.Sprintf("%#v", this.ActivityType)+",\n")
+	if this.TaskQueue != that1.TaskQueue {
+		return false
 	}
-	if this.Input != nil {
-		s = append(s, "Input: "+fmt.Sprintf("%#v", this.Input)+",\n")
+	if this.LastKnownUserDataVersion != that1.LastKnownUserDataVersion {
+		return false
 	}
-	s = append(s, "ScheduledTime: "+fmt.Sprintf("%#v", this.ScheduledTime)+",\n")
-	s = append(s, "ScheduleToCloseTimeout: "+fmt.Sprintf("%#v", this.ScheduleToCloseTimeout)+",\n")
-	s = append(s, "StartedTime: "+fmt.Sprintf("%#v", this.StartedTime)+",\n")
-	s = append(s, "StartToCloseTimeout: "+fmt.Sprintf("%#v", this.StartToCloseTimeout)+",\n")
-	s = append(s, "HeartbeatTimeout: "+fmt.Sprintf("%#v", this.HeartbeatTimeout)+",\n")
-	s = append(s, "Attempt: "+fmt.Sprintf("%#v", this.Attempt)+",\n")
-	s = append(s, "CurrentAttemptScheduledTime: "+fmt.Sprintf("%#v", this.CurrentAttemptScheduledTime)+",\n")
-	if this.HeartbeatDetails != nil {
-		s = append(s, "HeartbeatDetails: "+fmt.Sprintf("%#v", this.HeartbeatDetails)+",\n")
+	return true
+}
+func (this *GetTaskQueueUserDataResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if this.WorkflowType != nil {
-		s = append(s, "WorkflowType: "+fmt.Sprintf("%#v", this.WorkflowType)+",\n")
+
+	that1, ok := that.(*GetTaskQueueUserDataResponse)
+	if !ok {
+		that2, ok := that.(GetTaskQueueUserDataResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "WorkflowNamespace: "+fmt.Sprintf("%#v", this.WorkflowNamespace)+",\n")
-	if this.Header != nil {
-		s = append(s, "Header: "+fmt.Sprintf("%#v", this.Header)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if !this.UserData.Equal(that1.UserData) {
+		return false
+	}
+	return true
 }
-func (this *AddWorkflowTaskRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *UpdateTaskQueueUserDataRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 11)
-	s = append(s, "&matchingservice.AddWorkflowTaskRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.Execution != nil {
-		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+
+	that1, ok := that.(*UpdateTaskQueueUserDataRequest)
+	if !ok {
+		that2, ok := that.(UpdateTaskQueueUserDataRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.TaskQueue != nil {
-		s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "ScheduleId: "+fmt.Sprintf("%#v", this.ScheduleId)+",\n")
-	s = append(s, "ScheduleToStartTimeout: "+fmt.Sprintf("%#v", this.ScheduleToStartTimeout)+",\n")
-	s = append(s, "ForwardedSource: "+fmt.Sprintf("%#v", this.ForwardedSource)+",\n")
-	s = append(s, "Source: "+fmt.Sprintf("%#v", this.Source)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *AddWorkflowTaskResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.NamespaceId != that1.NamespaceId {
+		return false
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&matchingservice.AddWorkflowTaskResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if this.TaskQueue != that1.TaskQueue {
+		return false
+	}
+	if !this.UserData.Equal(that1.UserData) {
+		return false
+	}
+	return true
 }
-func (this *AddActivityTaskRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *UpdateTaskQueueUserDataResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 12)
-	s = append(s, "&matchingservice.AddActivityTaskRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.Execution != nil {
-		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+
+	that1, ok := that.(*UpdateTaskQueueUserDataResponse)
+	if !ok {
+		that2, ok := that.(UpdateTaskQueueUserDataResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "SourceNamespaceId: "+fmt.Sprintf("%#v", this.SourceNamespaceId)+",\n")
-	if this.TaskQueue != nil {
-		s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "ScheduleId: "+fmt.Sprintf("%#v", this.ScheduleId)+",\n")
-	s = append(s, "ScheduleToStartTimeout: "+fmt.Sprintf("%#v", this.ScheduleToStartTimeout)+",\n")
-	s = append(s, "ForwardedSource: "+fmt.Sprintf("%#v", this.ForwardedSource)+",\n")
-	s = append(s, "Source: "+fmt.Sprintf("%#v", this.Source)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *AddActivityTaskResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if !this.UserData.Equal(that1.UserData) {
+		return false
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&matchingservice.AddActivityTaskResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *QueryWorkflowRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *UpdateWorkerBuildIdCompatibilityRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&matchingservice.QueryWorkflowRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.TaskQueue != nil {
-		s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+
+	that1, ok := that.(*UpdateWorkerBuildIdCompatibilityRequest)
+	if !ok {
+		that2, ok := that.(UpdateWorkerBuildIdCompatibilityRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.QueryRequest != nil {
-		s = append(s, "QueryRequest: "+fmt.Sprintf("%#v", this.QueryRequest)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "ForwardedSource: "+fmt.Sprintf("%#v", this.ForwardedSource)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if this.TaskQueue != that1.TaskQueue {
+		return false
+	}
+	if !this.Update.Equal(that1.Update) {
+		return false
+	}
+	return true
 }
-func (this *QueryWorkflowResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *UpdateWorkerBuildIdCompatibilityResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&matchingservice.QueryWorkflowResponse{")
-	if this.QueryResult != nil {
-		s = append(s, "QueryResult: "+fmt.Sprintf("%#v", this.QueryResult)+",\n")
+
+	that1, ok := that.(*UpdateWorkerBuildIdCompatibilityResponse)
+	if !ok {
+		that2, ok := that.(UpdateWorkerBuildIdCompatibilityResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.QueryRejected != nil {
-		s = append(s, "QueryRejected: "+fmt.Sprintf("%#v", this.QueryRejected)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if !this.VersioningData.Equal(that1.VersioningData) {
+		return false
+	}
+	return true
 }
-func (this *RespondQueryTaskCompletedRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *GetWorkerBuildIdCompatibilityRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&matchingservice.RespondQueryTaskCompletedRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.TaskQueue != nil {
-		s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+
+	that1, ok := that.(*GetWorkerBuildIdCompatibilityRequest)
+	if !ok {
+		that2, ok := that.(GetWorkerBuildIdCompatibilityRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "TaskId: "+fmt.Sprintf("%#v", this.TaskId)+",\n")
-	if this.CompletedRequest != nil {
-		s = append(s, "CompletedRequest: "+fmt.Sprintf("%#v", this.CompletedRequest)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if this.TaskQueue != that1.TaskQueue {
+		return false
+	}
+	if this.MaxSets != that1.MaxSets {
+		return false
+	}
+	return true
 }
-func (this *RespondQueryTaskCompletedResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *GetWorkerBuildIdCompatibilityResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&matchingservice.RespondQueryTaskCompletedResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
+
+	that1, ok := that.(*GetWorkerBuildIdCompatibilityResponse)
+	if !ok {
+		that2, ok := that.(GetWorkerBuildIdCompatibilityResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.VersioningData.Equal(that1.VersioningData) {
+		return false
+	}
+	return true
 }
-func (this *CancelOutstandingPollRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *EvictStickyTaskQueueRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&matchingservice.CancelOutstandingPollRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	s = append(s, "TaskQueueType: "+fmt.Sprintf("%#v", this.TaskQueueType)+",\n")
-	if this.TaskQueue != nil {
-		s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+
+	that1, ok := that.(*EvictStickyTaskQueueRequest)
+	if !ok {
+		that2, ok := that.(EvictStickyTaskQueueRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "PollerId: "+fmt.Sprintf("%#v", this.PollerId)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if this.TaskQueue != that1.TaskQueue {
+		return false
+	}
+	return true
 }
-func (this *CancelOutstandingPollResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *EvictStickyTaskQueueResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&matchingservice.CancelOutstandingPollResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
+
+	that1, ok := that.(*EvictStickyTaskQueueResponse)
+	if !ok {
+		that2, ok := that.(EvictStickyTaskQueueResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
-func (this *DescribeTaskQueueRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *GetHostProfileRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&matchingservice.DescribeTaskQueueRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.DescRequest != nil {
-		s = append(s, "DescRequest: "+fmt.Sprintf("%#v", this.DescRequest)+",\n")
+
+	that1, ok := that.(*GetHostProfileRequest)
+	if !ok {
+		that2, ok := that.(GetHostProfileRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.HostAddress != that1.HostAddress {
+		return false
+	}
+	if this.Profile != that1.Profile {
+		return false
+	}
+	if this.Duration != nil && that1.Duration != nil {
+		if *this.Duration != *that1.Duration {
+			return false
+		}
+	} else if this.Duration != nil {
+		return false
+	} else if that1.Duration != nil {
+		return false
+	}
+	return true
 }
-func (this *DescribeTaskQueueResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *GetHostProfileResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&matchingservice.DescribeTaskQueueResponse{")
-	if this.Pollers != nil {
-		s = append(s, "Pollers: "+fmt.Sprintf("%#v", this.Pollers)+",\n")
+
+	that1, ok := that.(*GetHostProfileResponse)
+	if !ok {
+		that2, ok := that.(GetHostProfileResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.TaskQueueStatus != nil {
-		s = append(s, "TaskQueueStatus: "+fmt.Sprintf("%#v", this.TaskQueueStatus)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if !bytes.Equal(this.Profile, that1.Profile) {
+		return false
+	}
+	return true
 }
-func (this *ListTaskQueuePartitionsRequest) GoString() string {
+func (this *PollWorkflowTaskQueueRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&matchingservice.ListTaskQueuePartitionsRequest{")
-	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
-	if this.TaskQueue != nil {
-		s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	s := make([]string, 0, 8)
+	s = append(s, "&matchingservice.PollWorkflowTaskQueueRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "PollerId: "+fmt.Sprintf("%#v", this.PollerId)+",\n")
+	if this.PollRequest != nil {
+		s = append(s, "PollRequest: "+fmt.Sprintf("%#v", this.PollRequest)+",\n")
 	}
+	s = append(s, "ForwardedSource: "+fmt.Sprintf("%#v", this.ForwardedSource)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *ListTaskQueuePartitionsResponse) GoString() string {
+func (this *PollWorkflowTaskQueueResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&matchingservice.ListTaskQueuePartitionsResponse{")
-	if this.ActivityTaskQueuePartitions != nil {
-		s = append(s, "ActivityTaskQueuePartitions: "+fmt.Sprintf("%#v", this.ActivityTaskQueuePartitions)+",\n")
-	}
-	if this.WorkflowTaskQueuePartitions != nil {
-		s = append(s, "WorkflowTaskQueuePartitions: "+fmt.Sprintf("%#v", this.WorkflowTaskQueuePartitions)+",\n")
+	s := make([]string, 0, 20)
+	s = append(s, "&matchingservice.PollWorkflowTaskQueueResponse{")
+	s = append(s, "TaskToken: "+fmt.Sprintf("%#v", this.TaskToken)+",\n")
+	if this.WorkflowExecution != nil {
+		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
+	}
+	if this.WorkflowType != nil {
+		s = append(s, "WorkflowType: "+fmt.Sprintf("%#v", this.WorkflowType)+",\n")
+	}
+	s = append(s, "PreviousStartedEventId: "+fmt.Sprintf("%#v", this.PreviousStartedEventId)+",\n")
+	s = append(s, "StartedEventId: "+fmt.Sprintf("%#v", this.StartedEventId)+",\n")
+	s = append(s, "Attempt: "+fmt.Sprintf("%#v", this.Attempt)+",\n")
+	s = append(s, "NextEventId: "+fmt.Sprintf("%#v", this.NextEventId)+",\n")
+	s = append(s, "BacklogCountHint: "+fmt.Sprintf("%#v", this.BacklogCountHint)+",\n")
+	s = append(s, "StickyExecutionEnabled: "+fmt.Sprintf("%#v", this.StickyExecutionEnabled)+",\n")
+	if this.Query != nil {
+		s = append(s, "Query: "+fmt.Sprintf("%#v", this.Query)+",\n")
+	}
+	if this.WorkflowTaskInfo != nil {
+		s = append(s, "WorkflowTaskInfo: "+fmt.Sprintf("%#v", this.WorkflowTaskInfo)+",\n")
+	}
+	if this.WorkflowExecutionTaskQueue != nil {
+		s = append(s, "WorkflowExecutionTaskQueue: "+fmt.Sprintf("%#v", this.WorkflowExecutionTaskQueue)+",\n")
+	}
+	s = append(s, "BranchToken: "+fmt.Sprintf("%#v", this.BranchToken)+",\n")
+	s = append(s, "ScheduledTime: "+fmt.Sprintf("%#v", this.ScheduledTime)+",\n")
+	s = append(s, "StartedTime: "+fmt.Sprintf("%#v", this.StartedTime)+",\n")
+	keysForQueries := make([]string, 0, len(this.Queries))
+	for k, _ := range this.Queries {
+		keysForQueries = append(keysForQueries, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForQueries)
+	mapStringForQueries := "map[string]*v12.WorkflowQuery{"
+	for _, k := range keysForQueries {
+		mapStringForQueries += fmt.Sprintf("%#v: %#v,", k, this.Queries[k])
+	}
+	mapStringForQueries += "}"
+	if this.Queries != nil {
+		s = append(s, "Queries: "+mapStringForQueries+",\n")
 	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func valueToGoStringRequestResponse(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
+func (this *PollActivityTaskQueueRequest) GoString() string {
+	if this == nil {
 		return "nil"
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
-}
-func (m *PollWorkflowTaskQueueRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	s := make([]string, 0, 8)
+	s = append(s, "&matchingservice.PollActivityTaskQueueRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "PollerId: "+fmt.Sprintf("%#v", this.PollerId)+",\n")
+	if this.PollRequest != nil {
+		s = append(s, "PollRequest: "+fmt.Sprintf("%#v", this.PollRequest)+",\n")
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PollWorkflowTaskQueueRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	s = append(s, "ForwardedSource: "+fmt.Sprintf("%#v", this.ForwardedSource)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *PollWorkflowTaskQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ForwardedSource) > 0 {
-		i -= len(m.ForwardedSource)
-		copy(dAtA[i:], m.ForwardedSource)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ForwardedSource)))
-		i--
-		dAtA[i] = 0x22
+func (this *PollActivityTaskQueueResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.PollRequest != nil {
-		{
-			size, err := m.PollRequest.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	s := make([]string, 0, 20)
+	s = append(s, "&matchingservice.PollActivityTaskQueueResponse{")
+	s = append(s, "TaskToken: "+fmt.Sprintf("%#v", this.TaskToken)+",\n")
+	if this.WorkflowExecution != nil {
+		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
 	}
-	if len(m.PollerId) > 0 {
-		i -= len(m.PollerId)
-		copy(dAtA[i:], m.PollerId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.PollerId)))
-		i--
-		dAtA[i] = 0x12
+	s = append(s, "ActivityId: "+fmt.Sprintf("%#v", this.ActivityId)+",\n")
+	if this.ActivityType != nil {
+		s = append(s, "ActivityType: "+fmt.Sprintf("%#v", this.ActivityType)+",\n")
 	}
-	if len(m.NamespaceId) > 0 {
-		i -= len(m.NamespaceId)
-		copy(dAtA[i:], m.NamespaceId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
-		i--
-		dAtA[i] = 0xa
+	if this.Input != nil {
+		s = append(s, "Input: "+fmt.Sprintf("%#v", this.Input)+",\n")
 	}
-	return len(dAtA) - i, nil
+	s = append(s, "ScheduledTime: "+fmt.Sprintf("%#v", this.ScheduledTime)+",\n")
+	s = append(s, "ScheduleToCloseTimeout: "+fmt.Sprintf("%#v", this.ScheduleToCloseTimeout)+",\n")
+	s = append(s, "StartedTime: "+fmt.Sprintf("%#v", this.StartedTime)+",\n")
+	s = append(s, "StartToCloseTimeout: "+fmt.Sprintf("%#v", this.StartToCloseTimeout)+",\n")
+	s = append(s, "HeartbeatTimeout: "+fmt.Sprintf("%#v", this.HeartbeatTimeout)+",\n")
+	s = append(s, "Attempt: "+fmt.Sprintf("%#v", this.Attempt)+",\n")
+	s = append(s, "CurrentAttemptScheduledTime: "+fmt.Sprintf("%#v", this.CurrentAttemptScheduledTime)+",\n")
+	if this.HeartbeatDetails != nil {
+		s = append(s, "HeartbeatDetails: "+fmt.Sprintf("%#v", this.HeartbeatDetails)+",\n")
+	}
+	if this.WorkflowType != nil {
+		s = append(s, "WorkflowType: "+fmt.Sprintf("%#v", this.WorkflowType)+",\n")
+	}
+	s = append(s, "WorkflowNamespace: "+fmt.Sprintf("%#v", this.WorkflowNamespace)+",\n")
+	if this.Header != nil {
+		s = append(s, "Header: "+fmt.Sprintf("%#v", this.Header)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *PollWorkflowTaskQueueResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *AddWorkflowTaskRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 12)
+	s = append(s, "&matchingservice.AddWorkflowTaskRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+	}
+	if this.TaskQueue != nil {
+		s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	}
+	s = append(s, "ScheduleId: "+fmt.Sprintf("%#v", this.ScheduleId)+",\n")
+	s = append(s, "ScheduleToStartTimeout: "+fmt.Sprintf("%#v", this.ScheduleToStartTimeout)+",\n")
+	s = append(s, "ForwardedSource: "+fmt.Sprintf("%#v", this.ForwardedSource)+",\n")
+	s = append(s, "Source: "+fmt.Sprintf("%#v", this.Source)+",\n")
+	s = append(s, "WorkerBuildId: "+fmt.Sprintf("%#v", this.WorkerBuildId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *PollWorkflowTaskQueueResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *AddWorkflowTaskResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&matchingservice.AddWorkflowTaskResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *PollWorkflowTaskQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Queries) > 0 {
-		for k := range m.Queries {
-			v := m.Queries[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintRequestResponse(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x8a
-		}
+func (this *AddActivityTaskRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.StartedTime != nil {
-		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
-		if err3 != nil {
-			return 0, err3
-		}
-		i -= n3
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n3))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
+	s := make([]string, 0, 12)
+	s = append(s, "&matchingservice.AddActivityTaskRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
 	}
-	if m.ScheduledTime != nil {
-		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
-		if err4 != nil {
-			return 0, err4
-		}
-		i -= n4
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n4))
-		i--
-		dAtA[i] = 0x7a
+	s = append(s, "SourceNamespaceId: "+fmt.Sprintf("%#v", this.SourceNamespaceId)+",\n")
+	if this.TaskQueue != nil {
+		s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
 	}
-	if len(m.BranchToken) > 0 {
-		i -= len(m.BranchToken)
-		copy(dAtA[i:], m.BranchToken)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.BranchToken)))
-		i--
-		dAtA[i] = 0x72
+	s = append(s, "ScheduleId: "+fmt.Sprintf("%#v", this.ScheduleId)+",\n")
+	s = append(s, "ScheduleToStartTimeout: "+fmt.Sprintf("%#v", this.ScheduleToStartTimeout)+",\n")
+	s = append(s, "ForwardedSource: "+fmt.Sprintf("%#v", this.ForwardedSource)+",\n")
+	s = append(s, "Source: "+fmt.Sprintf("%#v", this.Source)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AddActivityTaskResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.WorkflowExecutionTaskQueue != nil {
-		{
-			size, err := m.WorkflowExecutionTaskQueue.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x62
+	s := make([]string, 0, 4)
+	s = append(s, "&matchingservice.AddActivityTaskResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *QueryWorkflowRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.WorkflowTaskInfo != nil {
-		{
-			size, err := m.WorkflowTaskInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x5a
+	s := make([]string, 0, 10)
+	s = append(s, "&matchingservice.QueryWorkflowRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.TaskQueue != nil {
+		s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
 	}
-	if m.Query != nil {
-		{
-			size, err := m.Query.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x52
+	if this.QueryRequest != nil {
+		s = append(s, "QueryRequest: "+fmt.Sprintf("%#v", this.QueryRequest)+",\n")
 	}
-	if m.StickyExecutionEnabled {
-		i--
-		if m.StickyExecutionEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x48
+	s = append(s, "ForwardedSource: "+fmt.Sprintf("%#v", this.ForwardedSource)+",\n")
+	s = append(s, "StickyDispatchTimeout: "+fmt.Sprintf("%#v", this.StickyDispatchTimeout)+",\n")
+	s = append(s, "WorkerBuildId: "+fmt.Sprintf("%#v", this.WorkerBuildId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *QueryWorkflowResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.BacklogCountHint != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.BacklogCountHint))
-		i--
-		dAtA[i] = 0x40
+	s := make([]string, 0, 6)
+	s = append(s, "&matchingservice.QueryWorkflowResponse{")
+	if this.QueryResult != nil {
+		s = append(s, "QueryResult: "+fmt.Sprintf("%#v", this.QueryResult)+",\n")
 	}
-	if m.NextEventId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.NextEventId))
-		i--
-		dAtA[i] = 0x38
+	if this.QueryRejected != nil {
+		s = append(s, "QueryRejected: "+fmt.Sprintf("%#v", this.QueryRejected)+",\n")
 	}
-	if m.Attempt != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Attempt))
-		i--
-		dAtA[i] = 0x30
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RespondQueryTaskCompletedRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.StartedEventId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.StartedEventId))
-		i--
-		dAtA[i] = 0x28
+	s := make([]string, 0, 8)
+	s = append(s, "&matchingservice.RespondQueryTaskCompletedRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.TaskQueue != nil {
+		s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
 	}
-	if m.PreviousStartedEventId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.PreviousStartedEventId))
-		i--
-		dAtA[i] = 0x20
+	s = append(s, "TaskId: "+fmt.Sprintf("%#v", this.TaskId)+",\n")
+	if this.CompletedRequest != nil {
+		s = append(s, "CompletedRequest: "+fmt.Sprintf("%#v", this.CompletedRequest)+",\n")
 	}
-	if m.WorkflowType != nil {
-		{
-			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RespondQueryTaskCompletedResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.WorkflowExecution != nil {
-		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	s := make([]string, 0, 4)
+	s = append(s, "&matchingservice.RespondQueryTaskCompletedResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CancelOutstandingPollRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.TaskToken) > 0 {
-		i -= len(m.TaskToken)
-		copy(dAtA[i:], m.TaskToken)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskToken)))
-		i--
-		dAtA[i] = 0xa
+	s := make([]string, 0, 8)
+	s = append(s, "&matchingservice.CancelOutstandingPollRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "TaskQueueType: "+fmt.Sprintf("%#v", this.TaskQueueType)+",\n")
+	if this.TaskQueue != nil {
+		s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
 	}
-	return len(dAtA) - i, nil
+	s = append(s, "PollerId: "+fmt.Sprintf("%#v", this.PollerId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *PollActivityTaskQueueRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *CancelOutstandingPollResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 4)
+	s = append(s, "&matchingservice.CancelOutstandingPollResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *PollActivityTaskQueueRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *DescribeTaskQueueRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&matchingservice.DescribeTaskQueueRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.DescRequest != nil {
+		s = append(s, "DescRequest: "+fmt.Sprintf("%#v", this.DescRequest)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *PollActivityTaskQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ForwardedSource) > 0 {
-		i -= len(m.ForwardedSource)
-		copy(dAtA[i:], m.ForwardedSource)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ForwardedSource)))
-		i--
-		dAtA[i] = 0x22
+func (this *DescribeTaskQueueResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.PollRequest != nil {
-		{
-			size, err := m.PollRequest.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	s := make([]string, 0, 8)
+	s = append(s, "&matchingservice.DescribeTaskQueueResponse{")
+	if this.Pollers != nil {
+		s = append(s, "Pollers: "+fmt.Sprintf("%#v", this.Pollers)+",\n")
 	}
-	if len(m.PollerId) > 0 {
-		i -= len(m.PollerId)
-		copy(dAtA[i:], m.PollerId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.PollerId)))
-		i--
-		dAtA[i] = 0x12
+	if this.TaskQueueStatus != nil {
+		s = append(s, "TaskQueueStatus: "+fmt.Sprintf("%#v", this.TaskQueueStatus)+",\n")
 	}
-	if len(m.NamespaceId) > 0 {
-		i -= len(m.NamespaceId)
-		copy(dAtA[i:], m.NamespaceId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
-		i--
-		dAtA[i] = 0xa
+	if this.TaskReaderStatus != nil {
+		s = append(s, "TaskReaderStatus: "+fmt.Sprintf("%#v", this.TaskReaderStatus)+",\n")
 	}
-	return len(dAtA) - i, nil
+	if this.BacklogStatus != nil {
+		s = append(s, "BacklogStatus: "+fmt.Sprintf("%#v", this.BacklogStatus)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *PollActivityTaskQueueResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ListTaskQueuePartitionsRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 6)
+	s = append(s, "&matchingservice.ListTaskQueuePartitionsRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	if this.TaskQueue != nil {
+		s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *PollActivityTaskQueueResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *ListTaskQueuePartitionsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&matchingservice.ListTaskQueuePartitionsResponse{")
+	if this.ActivityTaskQueuePartitions != nil {
+		s = append(s, "ActivityTaskQueuePartitions: "+fmt.Sprintf("%#v", this.ActivityTaskQueuePartitions)+",\n")
+	}
+	if this.WorkflowTaskQueuePartitions != nil {
+		s = append(s, "WorkflowTaskQueuePartitions: "+fmt.Sprintf("%#v", this.WorkflowTaskQueuePartitions)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DescribeTaskQueuePartitionsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&matchingservice.DescribeTaskQueuePartitionsRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	s = append(s, "TaskQueueType: "+fmt.Sprintf("%#v", this.TaskQueueType)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DescribeTaskQueuePartitionsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&matchingservice.DescribeTaskQueuePartitionsResponse{")
+	if this.Partitions != nil {
+		s = append(s, "Partitions: "+fmt.Sprintf("%#v", this.Partitions)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetTaskQueueUserDataRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&matchingservice.GetTaskQueueUserDataRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	s = append(s, "LastKnownUserDataVersion: "+fmt.Sprintf("%#v", this.LastKnownUserDataVersion)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetTaskQueueUserDataResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&matchingservice.GetTaskQueueUserDataResponse{")
+	if this.UserData != nil {
+		s = append(s, "UserData: "+fmt.Sprintf("%#v", this.UserData)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UpdateTaskQueueUserDataRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&matchingservice.UpdateTaskQueueUserDataRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	if this.UserData != nil {
+		s = append(s, "UserData: "+fmt.Sprintf("%#v", this.UserData)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UpdateTaskQueueUserDataResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&matchingservice.UpdateTaskQueueUserDataResponse{")
+	if this.UserData != nil {
+		s = append(s, "UserData: "+fmt.Sprintf("%#v", this.UserData)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UpdateWorkerBuildIdCompatibilityRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&matchingservice.UpdateWorkerBuildIdCompatibilityRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	if this.Update != nil {
+		s = append(s, "Update: "+fmt.Sprintf("%#v", this.Update)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UpdateWorkerBuildIdCompatibilityResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&matchingservice.UpdateWorkerBuildIdCompatibilityResponse{")
+	if this.VersioningData != nil {
+		s = append(s, "VersioningData: "+fmt.Sprintf("%#v", this.VersioningData)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetWorkerBuildIdCompatibilityRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&matchingservice.GetWorkerBuildIdCompatibilityRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	s = append(s, "MaxSets: "+fmt.Sprintf("%#v", this.MaxSets)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetWorkerBuildIdCompatibilityResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&matchingservice.GetWorkerBuildIdCompatibilityResponse{")
+	if this.VersioningData != nil {
+		s = append(s, "VersioningData: "+fmt.Sprintf("%#v", this.VersioningData)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EvictStickyTaskQueueRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&matchingservice.EvictStickyTaskQueueRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EvictStickyTaskQueueResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&matchingservice.EvictStickyTaskQueueResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetHostProfileRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&matchingservice.GetHostProfileRequest{")
+	s = append(s, "HostAddress: "+fmt.Sprintf("%#v", this.HostAddress)+",\n")
+	s = append(s, "Profile: "+fmt.Sprintf("%#v", this.Profile)+",\n")
+	s = append(s, "Duration: "+fmt.Sprintf("%#v", this.Duration)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetHostProfileResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&matchingservice.GetHostProfileResponse{")
+	s = append(s, "Profile: "+fmt.Sprintf("%#v", this.Profile)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringRequestResponse(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *PollWorkflowTaskQueueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
-func (m *PollActivityTaskQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PollWorkflowTaskQueueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PollWorkflowTaskQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
+	if len(m.ForwardedSource) > 0 {
+		i -= len(m.ForwardedSource)
+		copy(dAtA[i:], m.ForwardedSource)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ForwardedSource)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PollRequest != nil {
 		{
-			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.PollRequest.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2776,20 +3868,105 @@ func (m *PollActivityTaskQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PollerId) > 0 {
+		i -= len(m.PollerId)
+		copy(dAtA[i:], m.PollerId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.PollerId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PollWorkflowTaskQueueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PollWorkflowTaskQueueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PollWorkflowTaskQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Queries) > 0 {
+		for k := range m.Queries {
+			v := m.Queries[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.StartedTime != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n3))
+		i--
 		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0x82
 	}
-	if len(m.WorkflowNamespace) > 0 {
-		i -= len(m.WorkflowNamespace)
-		copy(dAtA[i:], m.WorkflowNamespace)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.WorkflowNamespace)))
+	if m.ScheduledTime != nil {
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n4))
 		i--
 		dAtA[i] = 0x7a
 	}
-	if m.WorkflowType != nil {
+	if len(m.BranchToken) > 0 {
+		i -= len(m.BranchToken)
+		copy(dAtA[i:], m.BranchToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.BranchToken)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.WorkflowExecutionTaskQueue != nil {
 		{
-			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.WorkflowExecutionTaskQueue.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2797,11 +3974,11 @@ func (m *PollActivityTaskQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x72
+		dAtA[i] = 0x62
 	}
-	if m.HeartbeatDetails != nil {
+	if m.WorkflowTaskInfo != nil {
 		{
-			size, err := m.HeartbeatDetails.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.WorkflowTaskInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2809,10 +3986,212 @@ func (m *PollActivityTaskQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x6a
+		dAtA[i] = 0x5a
 	}
-	if m.CurrentAttemptScheduledTime != nil {
-		n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CurrentAttemptScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CurrentAttemptScheduledTime):])
+	if m.Query != nil {
+		{
+			size, err := m.Query.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.StickyExecutionEnabled {
+		i--
+		if m.StickyExecutionEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.BacklogCountHint != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.BacklogCountHint))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.NextEventId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.NextEventId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartedEventId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.StartedEventId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PreviousStartedEventId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.PreviousStartedEventId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WorkflowType != nil {
+		{
+			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TaskToken) > 0 {
+		i -= len(m.TaskToken)
+		copy(dAtA[i:], m.TaskToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskToken)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PollActivityTaskQueueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PollActivityTaskQueueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PollActivityTaskQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ForwardedSource) > 0 {
+		i -= len(m.ForwardedSource)
+		copy(dAtA[i:], m.ForwardedSource)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ForwardedSource)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PollRequest != nil {
+		{
+			size, err := m.PollRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PollerId) > 0 {
+		i -= len(m.PollerId)
+		copy(dAtA[i:], m.PollerId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.PollerId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PollActivityTaskQueueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PollActivityTaskQueueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PollActivityTaskQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.WorkflowNamespace) > 0 {
+		i -= len(m.WorkflowNamespace)
+		copy(dAtA[i:], m.WorkflowNamespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.WorkflowNamespace)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.WorkflowType != nil {
+		{
+			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.HeartbeatDetails != nil {
+		{
+			size, err := m.HeartbeatDetails.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.CurrentAttemptScheduledTime != nil {
+		n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CurrentAttemptScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CurrentAttemptScheduledTime):])
 		if err14 != nil {
 			return 0, err14
 		}
@@ -2949,6 +4328,13 @@ func (m *AddWorkflowTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.WorkerBuildId) > 0 {
+		i -= len(m.WorkerBuildId)
+		copy(dAtA[i:], m.WorkerBuildId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.WorkerBuildId)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.Source != 0 {
 		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Source))
 		i--
@@ -3164,6 +4550,23 @@ func (m *QueryWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.WorkerBuildId) > 0 {
+		i -= len(m.WorkerBuildId)
+		copy(dAtA[i:], m.WorkerBuildId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.WorkerBuildId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.StickyDispatchTimeout != nil {
+		n29, err29 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StickyDispatchTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StickyDispatchTimeout):])
+		if err29 != nil {
+			return 0, err29
+		}
+		i -= n29
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n29))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.ForwardedSource) > 0 {
 		i -= len(m.ForwardedSource)
 		copy(dAtA[i:], m.ForwardedSource)
@@ -3475,6 +4878,30 @@ func (m *DescribeTaskQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.BacklogStatus != nil {
+		{
+			size, err := m.BacklogStatus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TaskReaderStatus != nil {
+		{
+			size, err := m.TaskReaderStatus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.TaskQueueStatus != nil {
 		{
 			size, err := m.TaskQueueStatus.MarshalToSizedBuffer(dAtA[:i])
@@ -3597,417 +5024,556 @@ func (m *ListTaskQueuePartitionsResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintRequestResponse(dAtA []byte, offset int, v uint64) int {
-	offset -= sovRequestResponse(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *DescribeTaskQueuePartitionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *PollWorkflowTaskQueueRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.PollerId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.PollRequest != nil {
-		l = m.PollRequest.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.ForwardedSource)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	return n
+
+func (m *DescribeTaskQueuePartitionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PollWorkflowTaskQueueResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DescribeTaskQueuePartitionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.TaskToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.WorkflowType != nil {
-		l = m.WorkflowType.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.PreviousStartedEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.PreviousStartedEventId))
-	}
-	if m.StartedEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.StartedEventId))
-	}
-	if m.Attempt != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Attempt))
+	if m.TaskQueueType != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.TaskQueueType))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.NextEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.NextEventId))
+	if len(m.TaskQueue) > 0 {
+		i -= len(m.TaskQueue)
+		copy(dAtA[i:], m.TaskQueue)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskQueue)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.BacklogCountHint != 0 {
-		n += 1 + sovRequestResponse(uint64(m.BacklogCountHint))
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.StickyExecutionEnabled {
-		n += 2
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeTaskQueuePartitionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Query != nil {
-		l = m.Query.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *DescribeTaskQueuePartitionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeTaskQueuePartitionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Partitions) > 0 {
+		for iNdEx := len(m.Partitions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Partitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	if m.WorkflowTaskInfo != nil {
-		l = m.WorkflowTaskInfo.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTaskQueueUserDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.WorkflowExecutionTaskQueue != nil {
-		l = m.WorkflowExecutionTaskQueue.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *GetTaskQueueUserDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTaskQueueUserDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastKnownUserDataVersion != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.LastKnownUserDataVersion))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.BranchToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.TaskQueue) > 0 {
+		i -= len(m.TaskQueue)
+		copy(dAtA[i:], m.TaskQueue)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskQueue)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.ScheduledTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime)
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.StartedTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime)
-		n += 2 + l + sovRequestResponse(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTaskQueueUserDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Queries) > 0 {
-		for k, v := range m.Queries {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovRequestResponse(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *GetTaskQueueUserDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTaskQueueUserDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UserData != nil {
+		{
+			size, err := m.UserData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			mapEntrySize := 1 + len(k) + sovRequestResponse(uint64(len(k))) + l
-			n += mapEntrySize + 2 + sovRequestResponse(uint64(mapEntrySize))
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PollActivityTaskQueueRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpdateTaskQueueUserDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateTaskQueueUserDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateTaskQueueUserDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.PollerId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.UserData != nil {
+		{
+			size, err := m.UserData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.PollRequest != nil {
-		l = m.PollRequest.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.TaskQueue) > 0 {
+		i -= len(m.TaskQueue)
+		copy(dAtA[i:], m.TaskQueue)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskQueue)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ForwardedSource)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PollActivityTaskQueueResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpdateTaskQueueUserDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateTaskQueueUserDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateTaskQueueUserDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.TaskToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.UserData != nil {
+		{
+			size, err := m.UserData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.ActivityId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.ActivityType != nil {
-		l = m.ActivityType.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.Input != nil {
-		l = m.Input.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.ScheduledTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime)
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.ScheduleToCloseTimeout != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToCloseTimeout)
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.StartedTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime)
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.StartToCloseTimeout != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StartToCloseTimeout)
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.HeartbeatTimeout != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.HeartbeatTimeout)
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.Attempt != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Attempt))
-	}
-	if m.CurrentAttemptScheduledTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CurrentAttemptScheduledTime)
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.HeartbeatDetails != nil {
-		l = m.HeartbeatDetails.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.WorkflowType != nil {
-		l = m.WorkflowType.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.WorkflowNamespace)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 2 + l + sovRequestResponse(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AddWorkflowTaskRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpdateWorkerBuildIdCompatibilityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.Execution != nil {
-		l = m.Execution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.TaskQueue != nil {
-		l = m.TaskQueue.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.ScheduleId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ScheduleId))
-	}
-	if m.ScheduleToStartTimeout != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout)
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.Update != nil {
+		{
+			size, err := m.Update.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ForwardedSource)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.TaskQueue) > 0 {
+		i -= len(m.TaskQueue)
+		copy(dAtA[i:], m.TaskQueue)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskQueue)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Source != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Source))
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AddWorkflowTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpdateWorkerBuildIdCompatibilityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AddActivityTaskRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *UpdateWorkerBuildIdCompatibilityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.Execution != nil {
-		l = m.Execution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.SourceNamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.TaskQueue != nil {
-		l = m.TaskQueue.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.ScheduleId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ScheduleId))
-	}
-	if m.ScheduleToStartTimeout != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout)
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.ForwardedSource)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.Source != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Source))
+	if m.VersioningData != nil {
+		{
+			size, err := m.VersioningData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AddActivityTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetWorkerBuildIdCompatibilityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryWorkflowRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetWorkerBuildIdCompatibilityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetWorkerBuildIdCompatibilityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.TaskQueue != nil {
-		l = m.TaskQueue.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.MaxSets != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.MaxSets))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.QueryRequest != nil {
-		l = m.QueryRequest.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.TaskQueue) > 0 {
+		i -= len(m.TaskQueue)
+		copy(dAtA[i:], m.TaskQueue)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskQueue)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ForwardedSource)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryWorkflowResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.QueryResult != nil {
-		l = m.QueryResult.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.QueryRejected != nil {
-		l = m.QueryRejected.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+func (m *GetWorkerBuildIdCompatibilityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RespondQueryTaskCompletedRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetWorkerBuildIdCompatibilityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetWorkerBuildIdCompatibilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.VersioningData != nil {
+		{
+			size, err := m.VersioningData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.TaskQueue != nil {
-		l = m.TaskQueue.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *EvictStickyTaskQueueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.TaskId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *EvictStickyTaskQueueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EvictStickyTaskQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TaskQueue) > 0 {
+		i -= len(m.TaskQueue)
+		copy(dAtA[i:], m.TaskQueue)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskQueue)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.CompletedRequest != nil {
-		l = m.CompletedRequest.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RespondQueryTaskCompletedResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EvictStickyTaskQueueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EvictStickyTaskQueueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EvictStickyTaskQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CancelOutstandingPollRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetHostProfileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetHostProfileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetHostProfileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.TaskQueueType != 0 {
-		n += 1 + sovRequestResponse(uint64(m.TaskQueueType))
+	if m.Duration != nil {
+		n48, err48 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Duration):])
+		if err48 != nil {
+			return 0, err48
+		}
+		i -= n48
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n48))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.TaskQueue != nil {
-		l = m.TaskQueue.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.Profile) > 0 {
+		i -= len(m.Profile)
+		copy(dAtA[i:], m.Profile)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Profile)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.PollerId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.HostAddress) > 0 {
+		i -= len(m.HostAddress)
+		copy(dAtA[i:], m.HostAddress)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.HostAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CancelOutstandingPollResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetHostProfileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetHostProfileResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetHostProfileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.Profile) > 0 {
+		i -= len(m.Profile)
+		copy(dAtA[i:], m.Profile)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Profile)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DescribeTaskQueueRequest) Size() (n int) {
+func encodeVarintRequestResponse(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRequestResponse(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PollWorkflowTaskQueueRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -4017,382 +5583,2497 @@ func (m *DescribeTaskQueueRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.DescRequest != nil {
-		l = m.DescRequest.Size()
+	l = len(m.PollerId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.PollRequest != nil {
+		l = m.PollRequest.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.ForwardedSource)
+	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
 	return n
 }
 
-func (m *DescribeTaskQueueResponse) Size() (n int) {
+func (m *PollWorkflowTaskQueueResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Pollers) > 0 {
-		for _, e := range m.Pollers {
-			l = e.Size()
-			n += 1 + l + sovRequestResponse(uint64(l))
-		}
+	l = len(m.TaskToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.TaskQueueStatus != nil {
-		l = m.TaskQueueStatus.Size()
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.WorkflowType != nil {
+		l = m.WorkflowType.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.PreviousStartedEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.PreviousStartedEventId))
+	}
+	if m.StartedEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.StartedEventId))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Attempt))
+	}
+	if m.NextEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.NextEventId))
+	}
+	if m.BacklogCountHint != 0 {
+		n += 1 + sovRequestResponse(uint64(m.BacklogCountHint))
+	}
+	if m.StickyExecutionEnabled {
+		n += 2
+	}
+	if m.Query != nil {
+		l = m.Query.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.WorkflowTaskInfo != nil {
+		l = m.WorkflowTaskInfo.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.WorkflowExecutionTaskQueue != nil {
+		l = m.WorkflowExecutionTaskQueue.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.BranchToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.ScheduledTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime)
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.StartedTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime)
+		n += 2 + l + sovRequestResponse(uint64(l))
+	}
+	if len(m.Queries) > 0 {
+		for k, v := range m.Queries {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovRequestResponse(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovRequestResponse(uint64(len(k))) + l
+			n += mapEntrySize + 2 + sovRequestResponse(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
-func (m *ListTaskQueuePartitionsRequest) Size() (n int) {
+func (m *PollActivityTaskQueueRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Namespace)
+	l = len(m.NamespaceId)
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.TaskQueue != nil {
-		l = m.TaskQueue.Size()
+	l = len(m.PollerId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.PollRequest != nil {
+		l = m.PollRequest.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.ForwardedSource)
+	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
 	return n
 }
 
-func (m *ListTaskQueuePartitionsResponse) Size() (n int) {
+func (m *PollActivityTaskQueueResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.ActivityTaskQueuePartitions) > 0 {
-		for _, e := range m.ActivityTaskQueuePartitions {
-			l = e.Size()
-			n += 1 + l + sovRequestResponse(uint64(l))
-		}
+	l = len(m.TaskToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if len(m.WorkflowTaskQueuePartitions) > 0 {
-		for _, e := range m.WorkflowTaskQueuePartitions {
-			l = e.Size()
-			n += 1 + l + sovRequestResponse(uint64(l))
-		}
-	}
-	return n
-}
-
-func sovRequestResponse(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozRequestResponse(x uint64) (n int) {
-	return sovRequestResponse(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *PollWorkflowTaskQueueRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&PollWorkflowTaskQueueRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`PollerId:` + fmt.Sprintf("%v", this.PollerId) + `,`,
-		`PollRequest:` + strings.Replace(fmt.Sprintf("%v", this.PollRequest), "PollWorkflowTaskQueueRequest", "v1.PollWorkflowTaskQueueRequest", 1) + `,`,
-		`ForwardedSource:` + fmt.Sprintf("%v", this.ForwardedSource) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PollWorkflowTaskQueueResponse) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.ActivityId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	keysForQueries := make([]string, 0, len(this.Queries))
-	for k, _ := range this.Queries {
-		keysForQueries = append(keysForQueries, k)
+	if m.ActivityType != nil {
+		l = m.ActivityType.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForQueries)
-	mapStringForQueries := "map[string]*v12.WorkflowQuery{"
-	for _, k := range keysForQueries {
-		mapStringForQueries += fmt.Sprintf("%v: %v,", k, this.Queries[k])
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	mapStringForQueries += "}"
-	s := strings.Join([]string{`&PollWorkflowTaskQueueResponse{`,
-		`TaskToken:` + fmt.Sprintf("%v", this.TaskToken) + `,`,
-		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v11.WorkflowExecution", 1) + `,`,
-		`WorkflowType:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowType), "WorkflowType", "v11.WorkflowType", 1) + `,`,
-		`PreviousStartedEventId:` + fmt.Sprintf("%v", this.PreviousStartedEventId) + `,`,
-		`StartedEventId:` + fmt.Sprintf("%v", this.StartedEventId) + `,`,
-		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
-		`NextEventId:` + fmt.Sprintf("%v", this.NextEventId) + `,`,
-		`BacklogCountHint:` + fmt.Sprintf("%v", this.BacklogCountHint) + `,`,
-		`StickyExecutionEnabled:` + fmt.Sprintf("%v", this.StickyExecutionEnabled) + `,`,
-		`Query:` + strings.Replace(fmt.Sprintf("%v", this.Query), "WorkflowQuery", "v12.WorkflowQuery", 1) + `,`,
-		`WorkflowTaskInfo:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowTaskInfo), "TransientWorkflowTaskInfo", "v13.TransientWorkflowTaskInfo", 1) + `,`,
-		`WorkflowExecutionTaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecutionTaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
-		`BranchToken:` + fmt.Sprintf("%v", this.BranchToken) + `,`,
-		`ScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`StartedTime:` + strings.Replace(fmt.Sprintf("%v", this.StartedTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`Queries:` + mapStringForQueries + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PollActivityTaskQueueRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.ScheduledTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime)
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&PollActivityTaskQueueRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`PollerId:` + fmt.Sprintf("%v", this.PollerId) + `,`,
-		`PollRequest:` + strings.Replace(fmt.Sprintf("%v", this.PollRequest), "PollActivityTaskQueueRequest", "v1.PollActivityTaskQueueRequest", 1) + `,`,
-		`ForwardedSource:` + fmt.Sprintf("%v", this.ForwardedSource) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PollActivityTaskQueueResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.ScheduleToCloseTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToCloseTimeout)
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&PollActivityTaskQueueResponse{`,
-		`TaskToken:` + fmt.Sprintf("%v", this.TaskToken) + `,`,
-		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v11.WorkflowExecution", 1) + `,`,
-		`ActivityId:` + fmt.Sprintf("%v", this.ActivityId) + `,`,
-		`ActivityType:` + strings.Replace(fmt.Sprintf("%v", this.ActivityType), "ActivityType", "v11.ActivityType", 1) + `,`,
-		`Input:` + strings.Replace(fmt.Sprintf("%v", this.Input), "Payloads", "v11.Payloads", 1) + `,`,
-		`ScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`ScheduleToCloseTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ScheduleToCloseTimeout), "Duration", "types.Duration", 1) + `,`,
-		`StartedTime:` + strings.Replace(fmt.Sprintf("%v", this.StartedTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`StartToCloseTimeout:` + strings.Replace(fmt.Sprintf("%v", this.StartToCloseTimeout), "Duration", "types.Duration", 1) + `,`,
-		`HeartbeatTimeout:` + strings.Replace(fmt.Sprintf("%v", this.HeartbeatTimeout), "Duration", "types.Duration", 1) + `,`,
-		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
-		`CurrentAttemptScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.CurrentAttemptScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`HeartbeatDetails:` + strings.Replace(fmt.Sprintf("%v", this.HeartbeatDetails), "Payloads", "v11.Payloads", 1) + `,`,
-		`WorkflowType:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowType), "WorkflowType", "v11.WorkflowType", 1) + `,`,
-		`WorkflowNamespace:` + fmt.Sprintf("%v", this.WorkflowNamespace) + `,`,
-		`Header:` + strings.Replace(fmt.Sprintf("%v", this.Header), "Header", "v11.Header", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *AddWorkflowTaskRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.StartedTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime)
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&AddWorkflowTaskRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v11.WorkflowExecution", 1) + `,`,
-		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
-		`ScheduleId:` + fmt.Sprintf("%v", this.ScheduleId) + `,`,
-		`ScheduleToStartTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ScheduleToStartTimeout), "Duration", "types.Duration", 1) + `,`,
-		`ForwardedSource:` + fmt.Sprintf("%v", this.ForwardedSource) + `,`,
-		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *AddWorkflowTaskResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.StartToCloseTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StartToCloseTimeout)
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&AddWorkflowTaskResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *AddActivityTaskRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.HeartbeatTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.HeartbeatTimeout)
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&AddActivityTaskRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v11.WorkflowExecution", 1) + `,`,
-		`SourceNamespaceId:` + fmt.Sprintf("%v", this.SourceNamespaceId) + `,`,
-		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
-		`ScheduleId:` + fmt.Sprintf("%v", this.ScheduleId) + `,`,
-		`ScheduleToStartTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ScheduleToStartTimeout), "Duration", "types.Duration", 1) + `,`,
-		`ForwardedSource:` + fmt.Sprintf("%v", this.ForwardedSource) + `,`,
-		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *AddActivityTaskResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.Attempt != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Attempt))
 	}
-	s := strings.Join([]string{`&AddActivityTaskResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *QueryWorkflowRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.CurrentAttemptScheduledTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CurrentAttemptScheduledTime)
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&QueryWorkflowRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
-		`QueryRequest:` + strings.Replace(fmt.Sprintf("%v", this.QueryRequest), "QueryWorkflowRequest", "v1.QueryWorkflowRequest", 1) + `,`,
-		`ForwardedSource:` + fmt.Sprintf("%v", this.ForwardedSource) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *QueryWorkflowResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.HeartbeatDetails != nil {
+		l = m.HeartbeatDetails.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&QueryWorkflowResponse{`,
-		`QueryResult:` + strings.Replace(fmt.Sprintf("%v", this.QueryResult), "Payloads", "v11.Payloads", 1) + `,`,
-		`QueryRejected:` + strings.Replace(fmt.Sprintf("%v", this.QueryRejected), "QueryRejected", "v12.QueryRejected", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RespondQueryTaskCompletedRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.WorkflowType != nil {
+		l = m.WorkflowType.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&RespondQueryTaskCompletedRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
-		`TaskId:` + fmt.Sprintf("%v", this.TaskId) + `,`,
-		`CompletedRequest:` + strings.Replace(fmt.Sprintf("%v", this.CompletedRequest), "RespondQueryTaskCompletedRequest", "v1.RespondQueryTaskCompletedRequest", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RespondQueryTaskCompletedResponse) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.WorkflowNamespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&RespondQueryTaskCompletedResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *CancelOutstandingPollRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 2 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&CancelOutstandingPollRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`TaskQueueType:` + fmt.Sprintf("%v", this.TaskQueueType) + `,`,
-		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
-		`PollerId:` + fmt.Sprintf("%v", this.PollerId) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *CancelOutstandingPollResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *AddWorkflowTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&CancelOutstandingPollResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *DescribeTaskQueueRequest) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&DescribeTaskQueueRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`DescRequest:` + strings.Replace(fmt.Sprintf("%v", this.DescRequest), "DescribeTaskQueueRequest", "v1.DescribeTaskQueueRequest", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.TaskQueue != nil {
+		l = m.TaskQueue.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.ScheduleId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ScheduleId))
+	}
+	if m.ScheduleToStartTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.ForwardedSource)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Source != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Source))
+	}
+	l = len(m.WorkerBuildId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
 }
-func (this *DescribeTaskQueueResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *AddWorkflowTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForPollers := "[]*PollerInfo{"
-	for _, f := range this.Pollers {
-		repeatedStringForPollers += strings.Replace(fmt.Sprintf("%v", f), "PollerInfo", "v14.PollerInfo", 1) + ","
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AddActivityTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForPollers += "}"
-	s := strings.Join([]string{`&DescribeTaskQueueResponse{`,
-		`Pollers:` + repeatedStringForPollers + `,`,
-		`TaskQueueStatus:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueueStatus), "TaskQueueStatus", "v14.TaskQueueStatus", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.SourceNamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.TaskQueue != nil {
+		l = m.TaskQueue.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.ScheduleId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ScheduleId))
+	}
+	if m.ScheduleToStartTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.ForwardedSource)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Source != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Source))
+	}
+	return n
 }
-func (this *ListTaskQueuePartitionsRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *AddActivityTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ListTaskQueuePartitionsRequest{`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	return n
 }
-func (this *ListTaskQueuePartitionsResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *QueryWorkflowRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForActivityTaskQueuePartitions := "[]*TaskQueuePartitionMetadata{"
-	for _, f := range this.ActivityTaskQueuePartitions {
-		repeatedStringForActivityTaskQueuePartitions += strings.Replace(fmt.Sprintf("%v", f), "TaskQueuePartitionMetadata", "v14.TaskQueuePartitionMetadata", 1) + ","
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	repeatedStringForActivityTaskQueuePartitions += "}"
-	repeatedStringForWorkflowTaskQueuePartitions := "[]*TaskQueuePartitionMetadata{"
-	for _, f := range this.WorkflowTaskQueuePartitions {
-		repeatedStringForWorkflowTaskQueuePartitions += strings.Replace(fmt.Sprintf("%v", f), "TaskQueuePartitionMetadata", "v14.TaskQueuePartitionMetadata", 1) + ","
+	if m.TaskQueue != nil {
+		l = m.TaskQueue.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	repeatedStringForWorkflowTaskQueuePartitions += "}"
-	s := strings.Join([]string{`&ListTaskQueuePartitionsResponse{`,
-		`ActivityTaskQueuePartitions:` + repeatedStringForActivityTaskQueuePartitions + `,`,
-		`WorkflowTaskQueuePartitions:` + repeatedStringForWorkflowTaskQueuePartitions + `,`,
-		`}`,
-	}, "")
-	return s
+	if m.QueryRequest != nil {
+		l = m.QueryRequest.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.ForwardedSource)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.StickyDispatchTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StickyDispatchTimeout)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.WorkerBuildId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
 }
-func valueToStringRequestResponse(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+func (m *QueryWorkflowResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	var l int
+	_ = l
+	if m.QueryResult != nil {
+		l = m.QueryResult.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.QueryRejected != nil {
+		l = m.QueryRejected.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
 }
-func (m *PollWorkflowTaskQueueRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
+
+func (m *RespondQueryTaskCompletedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.TaskQueue != nil {
+		l = m.TaskQueue.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.CompletedRequest != nil {
+		l = m.CompletedRequest.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *RespondQueryTaskCompletedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CancelOutstandingPollRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.TaskQueueType != 0 {
+		n += 1 + sovRequestResponse(uint64(m.TaskQueueType))
+	}
+	if m.TaskQueue != nil {
+		l = m.TaskQueue.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.PollerId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *CancelOutstandingPollResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DescribeTaskQueueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.DescRequest != nil {
+		l = m.DescRequest.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *DescribeTaskQueueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pollers) > 0 {
+		for _, e := range m.Pollers {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	if m.TaskQueueStatus != nil {
+		l = m.TaskQueueStatus.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.TaskReaderStatus != nil {
+		l = m.TaskReaderStatus.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.BacklogStatus != nil {
+		l = m.BacklogStatus.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *ListTaskQueuePartitionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.TaskQueue != nil {
+		l = m.TaskQueue.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *ListTaskQueuePartitionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ActivityTaskQueuePartitions) > 0 {
+		for _, e := range m.ActivityTaskQueuePartitions {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	if len(m.WorkflowTaskQueuePartitions) > 0 {
+		for _, e := range m.WorkflowTaskQueuePartitions {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DescribeTaskQueuePartitionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.TaskQueue)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.TaskQueueType != 0 {
+		n += 1 + sovRequestResponse(uint64(m.TaskQueueType))
+	}
+	return n
+}
+
+func (m *DescribeTaskQueuePartitionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Partitions) > 0 {
+		for _, e := range m.Partitions {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetTaskQueueUserDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.TaskQueue)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.LastKnownUserDataVersion != 0 {
+		n += 1 + sovRequestResponse(uint64(m.LastKnownUserDataVersion))
+	}
+	return n
+}
+
+func (m *GetTaskQueueUserDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UserData != nil {
+		l = m.UserData.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateTaskQueueUserDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.TaskQueue)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.UserData != nil {
+		l = m.UserData.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateTaskQueueUserDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UserData != nil {
+		l = m.UserData.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.TaskQueue)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Update != nil {
+		l = m.Update.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VersioningData != nil {
+		l = m.VersioningData.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *GetWorkerBuildIdCompatibilityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.TaskQueue)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.MaxSets != 0 {
+		n += 1 + sovRequestResponse(uint64(m.MaxSets))
+	}
+	return n
+}
+
+func (m *GetWorkerBuildIdCompatibilityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VersioningData != nil {
+		l = m.VersioningData.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *EvictStickyTaskQueueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.TaskQueue)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *EvictStickyTaskQueueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetHostProfileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HostAddress)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Profile)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Duration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Duration)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *GetHostProfileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Profile)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func sovRequestResponse(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRequestResponse(x uint64) (n int) {
+	return sovRequestResponse(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *PollWorkflowTaskQueueRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PollWorkflowTaskQueueRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`PollerId:` + fmt.Sprintf("%v", this.PollerId) + `,`,
+		`PollRequest:` + strings.Replace(fmt.Sprintf("%v", this.PollRequest), "PollWorkflowTaskQueueRequest", "v1.PollWorkflowTaskQueueRequest", 1) + `,`,
+		`ForwardedSource:` + fmt.Sprintf("%v", this.ForwardedSource) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PollWorkflowTaskQueueResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForQueries := make([]string, 0, len(this.Queries))
+	for k, _ := range this.Queries {
+		keysForQueries = append(keysForQueries, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForQueries)
+	mapStringForQueries := "map[string]*v12.WorkflowQuery{"
+	for _, k := range keysForQueries {
+		mapStringForQueries += fmt.Sprintf("%v: %v,", k, this.Queries[k])
+	}
+	mapStringForQueries += "}"
+	s := strings.Join([]string{`&PollWorkflowTaskQueueResponse{`,
+		`TaskToken:` + fmt.Sprintf("%v", this.TaskToken) + `,`,
+		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v11.WorkflowExecution", 1) + `,`,
+		`WorkflowType:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowType), "WorkflowType", "v11.WorkflowType", 1) + `,`,
+		`PreviousStartedEventId:` + fmt.Sprintf("%v", this.PreviousStartedEventId) + `,`,
+		`StartedEventId:` + fmt.Sprintf("%v", this.StartedEventId) + `,`,
+		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
+		`NextEventId:` + fmt.Sprintf("%v", this.NextEventId) + `,`,
+		`BacklogCountHint:` + fmt.Sprintf("%v", this.BacklogCountHint) + `,`,
+		`StickyExecutionEnabled:` + fmt.Sprintf("%v", this.StickyExecutionEnabled) + `,`,
+		`Query:` + strings.Replace(fmt.Sprintf("%v", this.Query), "WorkflowQuery", "v12.WorkflowQuery", 1) + `,`,
+		`WorkflowTaskInfo:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowTaskInfo), "TransientWorkflowTaskInfo", "v13.TransientWorkflowTaskInfo", 1) + `,`,
+		`WorkflowExecutionTaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecutionTaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
+		`BranchToken:` + fmt.Sprintf("%v", this.BranchToken) + `,`,
+		`ScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`StartedTime:` + strings.Replace(fmt.Sprintf("%v", this.StartedTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Queries:` + mapStringForQueries + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PollActivityTaskQueueRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PollActivityTaskQueueRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`PollerId:` + fmt.Sprintf("%v", this.PollerId) + `,`,
+		`PollRequest:` + strings.Replace(fmt.Sprintf("%v", this.PollRequest), "PollActivityTaskQueueRequest", "v1.PollActivityTaskQueueRequest", 1) + `,`,
+		`ForwardedSource:` + fmt.Sprintf("%v", this.ForwardedSource) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PollActivityTaskQueueResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PollActivityTaskQueueResponse{`,
+		`TaskToken:` + fmt.Sprintf("%v", this.TaskToken) + `,`,
+		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v11.WorkflowExecution", 1) + `,`,
+		`ActivityId:` + fmt.Sprintf("%v", this.ActivityId) + `,`,
+		`ActivityType:` + strings.Replace(fmt.Sprintf("%v", this.ActivityType), "ActivityType", "v11.ActivityType", 1) + `,`,
+		`Input:` + strings.Replace(fmt.Sprintf("%v", this.Input), "Payloads", "v11.Payloads", 1) + `,`,
+		`ScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`ScheduleToCloseTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ScheduleToCloseTimeout), "Duration", "types.Duration", 1) + `,`,
+		`StartedTime:` + strings.Replace(fmt.Sprintf("%v", this.StartedTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`StartToCloseTimeout:` + strings.Replace(fmt.Sprintf("%v", this.StartToCloseTimeout), "Duration", "types.Duration", 1) + `,`,
+		`HeartbeatTimeout:` + strings.Replace(fmt.Sprintf("%v", this.HeartbeatTimeout), "Duration", "types.Duration", 1) + `,`,
+		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
+		`CurrentAttemptScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.CurrentAttemptScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`HeartbeatDetails:` + strings.Replace(fmt.Sprintf("%v", this.HeartbeatDetails), "Payloads", "v11.Payloads", 1) + `,`,
+		`WorkflowType:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowType), "WorkflowType", "v11.WorkflowType", 1) + `,`,
+		`WorkflowNamespace:` + fmt.Sprintf("%v", this.WorkflowNamespace) + `,`,
+		`Header:` + strings.Replace(fmt.Sprintf("%v", this.Header), "Header", "v11.Header", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddWorkflowTaskRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddWorkflowTaskRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v11.WorkflowExecution", 1) + `,`,
+		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
+		`ScheduleId:` + fmt.Sprintf("%v", this.ScheduleId) + `,`,
+		`ScheduleToStartTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ScheduleToStartTimeout), "Duration", "types.Duration", 1) + `,`,
+		`ForwardedSource:` + fmt.Sprintf("%v", this.ForwardedSource) + `,`,
+		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
+		`WorkerBuildId:` + fmt.Sprintf("%v", this.WorkerBuildId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddWorkflowTaskResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddWorkflowTaskResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddActivityTaskRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddActivityTaskRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v11.WorkflowExecution", 1) + `,`,
+		`SourceNamespaceId:` + fmt.Sprintf("%v", this.SourceNamespaceId) + `,`,
+		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
+		`ScheduleId:` + fmt.Sprintf("%v", this.ScheduleId) + `,`,
+		`ScheduleToStartTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ScheduleToStartTimeout), "Duration", "types.Duration", 1) + `,`,
+		`ForwardedSource:` + fmt.Sprintf("%v", this.ForwardedSource) + `,`,
+		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddActivityTaskResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddActivityTaskResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueryWorkflowRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QueryWorkflowRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
+		`QueryRequest:` + strings.Replace(fmt.Sprintf("%v", this.QueryRequest), "QueryWorkflowRequest", "v1.QueryWorkflowRequest", 1) + `,`,
+		`ForwardedSource:` + fmt.Sprintf("%v", this.ForwardedSource) + `,`,
+		`StickyDispatchTimeout:` + strings.Replace(fmt.Sprintf("%v", this.StickyDispatchTimeout), "Duration", "types.Duration", 1) + `,`,
+		`WorkerBuildId:` + fmt.Sprintf("%v", this.WorkerBuildId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueryWorkflowResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QueryWorkflowResponse{`,
+		`QueryResult:` + strings.Replace(fmt.Sprintf("%v", this.QueryResult), "Payloads", "v11.Payloads", 1) + `,`,
+		`QueryRejected:` + strings.Replace(fmt.Sprintf("%v", this.QueryRejected), "QueryRejected", "v12.QueryRejected", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RespondQueryTaskCompletedRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RespondQueryTaskCompletedRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
+		`TaskId:` + fmt.Sprintf("%v", this.TaskId) + `,`,
+		`CompletedRequest:` + strings.Replace(fmt.Sprintf("%v", this.CompletedRequest), "RespondQueryTaskCompletedRequest", "v1.RespondQueryTaskCompletedRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RespondQueryTaskCompletedResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RespondQueryTaskCompletedResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CancelOutstandingPollRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CancelOutstandingPollRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TaskQueueType:` + fmt.Sprintf("%v", this.TaskQueueType) + `,`,
+		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
+		`PollerId:` + fmt.Sprintf("%v", this.PollerId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CancelOutstandingPollResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CancelOutstandingPollResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeTaskQueueRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DescribeTaskQueueRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`DescRequest:` + strings.Replace(fmt.Sprintf("%v", this.DescRequest), "DescribeTaskQueueRequest", "v1.DescribeTaskQueueRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeTaskQueueResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPollers := "[]*PollerInfo{"
+	for _, f := range this.Pollers {
+		repeatedStringForPollers += strings.Replace(fmt.Sprintf("%v", f), "PollerInfo", "v14.PollerInfo", 1) + ","
+	}
+	repeatedStringForPollers += "}"
+	s := strings.Join([]string{`&DescribeTaskQueueResponse{`,
+		`Pollers:` + repeatedStringForPollers + `,`,
+		`TaskQueueStatus:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueueStatus), "TaskQueueStatus", "v14.TaskQueueStatus", 1) + `,`,
+		`TaskReaderStatus:` + strings.Replace(fmt.Sprintf("%v", this.TaskReaderStatus), "TaskReaderStatus", "v17.TaskReaderStatus", 1) + `,`,
+		`BacklogStatus:` + strings.Replace(fmt.Sprintf("%v", this.BacklogStatus), "TaskQueueBacklogStatus", "v17.TaskQueueBacklogStatus", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListTaskQueuePartitionsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListTaskQueuePartitionsRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListTaskQueuePartitionsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForActivityTaskQueuePartitions := "[]*TaskQueuePartitionMetadata{"
+	for _, f := range this.ActivityTaskQueuePartitions {
+		repeatedStringForActivityTaskQueuePartitions += strings.Replace(fmt.Sprintf("%v", f), "TaskQueuePartitionMetadata", "v14.TaskQueuePartitionMetadata", 1) + ","
+	}
+	repeatedStringForActivityTaskQueuePartitions += "}"
+	repeatedStringForWorkflowTaskQueuePartitions := "[]*TaskQueuePartitionMetadata{"
+	for _, f := range this.WorkflowTaskQueuePartitions {
+		repeatedStringForWorkflowTaskQueuePartitions += strings.Replace(fmt.Sprintf("%v", f), "TaskQueuePartitionMetadata", "v14.TaskQueuePartitionMetadata", 1) + ","
+	}
+	repeatedStringForWorkflowTaskQueuePartitions += "}"
+	s := strings.Join([]string{`&ListTaskQueuePartitionsResponse{`,
+		`ActivityTaskQueuePartitions:` + repeatedStringForActivityTaskQueuePartitions + `,`,
+		`WorkflowTaskQueuePartitions:` + repeatedStringForWorkflowTaskQueuePartitions + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeTaskQueuePartitionsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DescribeTaskQueuePartitionsRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
+		`TaskQueueType:` + fmt.Sprintf("%v", this.TaskQueueType) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeTaskQueuePartitionsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPartitions := "[]*TaskQueuePartitionStatus{"
+	for _, f := range this.Partitions {
+		repeatedStringForPartitions += strings.Replace(fmt.Sprintf("%v", f), "TaskQueuePartitionStatus", "v17.TaskQueuePartitionStatus", 1) + ","
+	}
+	repeatedStringForPartitions += "}"
+	s := strings.Join([]string{`&DescribeTaskQueuePartitionsResponse{`,
+		`Partitions:` + repeatedStringForPartitions + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetTaskQueueUserDataRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetTaskQueueUserDataRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
+		`LastKnownUserDataVersion:` + fmt.Sprintf("%v", this.LastKnownUserDataVersion) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetTaskQueueUserDataResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetTaskQueueUserDataResponse{`,
+		`UserData:` + strings.Replace(fmt.Sprintf("%v", this.UserData), "TaskQueueUserData", "v18.TaskQueueUserData", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateTaskQueueUserDataRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateTaskQueueUserDataRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
+		`UserData:` + strings.Replace(fmt.Sprintf("%v", this.UserData), "TaskQueueUserData", "v18.TaskQueueUserData", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateTaskQueueUserDataResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateTaskQueueUserDataResponse{`,
+		`UserData:` + strings.Replace(fmt.Sprintf("%v", this.UserData), "TaskQueueUserData", "v18.TaskQueueUserData", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateWorkerBuildIdCompatibilityRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateWorkerBuildIdCompatibilityRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
+		`Update:` + strings.Replace(fmt.Sprintf("%v", this.Update), "BuildIdCompatibilityUpdate", "v17.BuildIdCompatibilityUpdate", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateWorkerBuildIdCompatibilityResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateWorkerBuildIdCompatibilityResponse{`,
+		`VersioningData:` + strings.Replace(fmt.Sprintf("%v", this.VersioningData), "VersioningData", "v18.VersioningData", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetWorkerBuildIdCompatibilityRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetWorkerBuildIdCompatibilityRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
+		`MaxSets:` + fmt.Sprintf("%v", this.MaxSets) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetWorkerBuildIdCompatibilityResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetWorkerBuildIdCompatibilityResponse{`,
+		`VersioningData:` + strings.Replace(fmt.Sprintf("%v", this.VersioningData), "VersioningData", "v18.VersioningData", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EvictStickyTaskQueueRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EvictStickyTaskQueueRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EvictStickyTaskQueueResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EvictStickyTaskQueueResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetHostProfileRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetHostProfileRequest{`,
+		`HostAddress:` + fmt.Sprintf("%v", this.HostAddress) + `,`,
+		`Profile:` + fmt.Sprintf("%v", this.Profile) + `,`,
+		`Duration:` + strings.Replace(fmt.Sprintf("%v", this.Duration), "Duration", "types.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetHostProfileResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetHostProfileResponse{`,
+		`Profile:` + fmt.Sprintf("%v", this.Profile) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringRequestResponse(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *PollWorkflowTaskQueueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PollWorkflowTaskQueueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PollWorkflowTaskQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PollerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PollRequest == nil {
+				m.PollRequest = &v1.PollWorkflowTaskQueueRequest{}
+			}
+			if err := m.PollRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedSource", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardedSource = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PollWorkflowTaskQueueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PollWorkflowTaskQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskToken = append(m.TaskToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.TaskToken == nil {
+				m.TaskToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v11.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowType == nil {
+				m.WorkflowType = &v11.WorkflowType{}
+			}
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStartedEventId", wireType)
+			}
+			m.PreviousStartedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousStartedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedEventId", wireType)
+			}
+			m.StartedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
+			}
+			m.NextEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BacklogCountHint", wireType)
+			}
+			m.BacklogCountHint = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BacklogCountHint |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StickyExecutionEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StickyExecutionEnabled = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Query == nil {
+				m.Query = &v12.WorkflowQuery{}
+			}
+			if err := m.Query.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTaskInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowTaskInfo == nil {
+				m.WorkflowTaskInfo = &v13.TransientWorkflowTaskInfo{}
+			}
+			if err := m.WorkflowTaskInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionTaskQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecutionTaskQueue == nil {
+				m.WorkflowExecutionTaskQueue = &v14.TaskQueue{}
+			}
+			if err := m.WorkflowExecutionTaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BranchToken = append(m.BranchToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.BranchToken == nil {
+				m.BranchToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledTime == nil {
+				m.ScheduledTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartedTime == nil {
+				m.StartedTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Queries == nil {
+				m.Queries = make(map[string]*v12.WorkflowQuery)
+			}
+			var mapkey string
+			var mapvalue *v12.WorkflowQuery
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v12.WorkflowQuery{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRequestResponse(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Queries[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PollActivityTaskQueueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PollActivityTaskQueueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PollActivityTaskQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PollerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PollRequest == nil {
+				m.PollRequest = &v1.PollActivityTaskQueueRequest{}
+			}
+			if err := m.PollRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedSource", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardedSource = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PollActivityTaskQueueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PollActivityTaskQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskToken = append(m.TaskToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.TaskToken == nil {
+				m.TaskToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v11.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActivityType == nil {
+				m.ActivityType = &v11.ActivityType{}
+			}
+			if err := m.ActivityType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Input == nil {
+				m.Input = &v11.Payloads{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledTime == nil {
+				m.ScheduledTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToCloseTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduleToCloseTimeout == nil {
+				m.ScheduleToCloseTimeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ScheduleToCloseTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartedTime == nil {
+				m.StartedTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartToCloseTimeout", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.StartToCloseTimeout == nil {
+				m.StartToCloseTimeout = new(time.Duration)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PollWorkflowTaskQueueRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PollWorkflowTaskQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.StartToCloseTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatTimeout", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -4402,29 +8083,52 @@ func (m *PollWorkflowTaskQueueRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			if m.HeartbeatTimeout == nil {
+				m.HeartbeatTimeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.HeartbeatTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PollerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentAttemptScheduledTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -4434,27 +8138,31 @@ func (m *PollWorkflowTaskQueueRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PollerId = string(dAtA[iNdEx:postIndex])
+			if m.CurrentAttemptScheduledTime == nil {
+				m.CurrentAttemptScheduledTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CurrentAttemptScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PollRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatDetails", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4481,16 +8189,52 @@ func (m *PollWorkflowTaskQueueRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PollRequest == nil {
-				m.PollRequest = &v1.PollWorkflowTaskQueueRequest{}
+			if m.HeartbeatDetails == nil {
+				m.HeartbeatDetails = &v11.Payloads{}
 			}
-			if err := m.PollRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HeartbeatDetails.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedSource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowType == nil {
+				m.WorkflowType = &v11.WorkflowType{}
+			}
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowNamespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4518,7 +8262,43 @@ func (m *PollWorkflowTaskQueueRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ForwardedSource = string(dAtA[iNdEx:postIndex])
+			m.WorkflowNamespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &v11.Header{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4544,7 +8324,7 @@ func (m *PollWorkflowTaskQueueRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
+func (m *AddWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4567,17 +8347,17 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PollWorkflowTaskQueueResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddWorkflowTaskRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PollWorkflowTaskQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddWorkflowTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -4587,29 +8367,27 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskToken = append(m.TaskToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.TaskToken == nil {
-				m.TaskToken = []byte{}
-			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4636,16 +8414,16 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v11.WorkflowExecution{}
+			if m.Execution == nil {
+				m.Execution = &v11.WorkflowExecution{}
 			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4672,18 +8450,18 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowType == nil {
-				m.WorkflowType = &v11.WorkflowType{}
+			if m.TaskQueue == nil {
+				m.TaskQueue = &v14.TaskQueue{}
 			}
-			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStartedEventId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
 			}
-			m.PreviousStartedEventId = 0
+			m.ScheduleId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -4693,16 +8471,16 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PreviousStartedEventId |= int64(b&0x7F) << shift
+				m.ScheduleId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedEventId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToStartTimeout", wireType)
 			}
-			m.StartedEventId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -4712,16 +8490,33 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartedEventId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduleToStartTimeout == nil {
+				m.ScheduleToStartTimeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ScheduleToStartTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedSource", wireType)
 			}
-			m.Attempt = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -4731,16 +8526,29 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Attempt |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardedSource = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			m.NextEventId = 0
+			m.Source = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -4750,16 +8558,16 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextEventId |= int64(b&0x7F) << shift
+				m.Source |= v15.TaskSource(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BacklogCountHint", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerBuildId", wireType)
 			}
-			m.BacklogCountHint = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -4769,16 +8577,135 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BacklogCountHint |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StickyExecutionEnabled", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkerBuildId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddWorkflowTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddWorkflowTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddWorkflowTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddActivityTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddActivityTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -4788,15 +8715,27 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.StickyExecutionEnabled = bool(v != 0)
-		case 10:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4823,18 +8762,18 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Query == nil {
-				m.Query = &v12.WorkflowQuery{}
+			if m.Execution == nil {
+				m.Execution = &v11.WorkflowExecution{}
 			}
-			if err := m.Query.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTaskInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceNamespaceId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -4844,31 +8783,27 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowTaskInfo == nil {
-				m.WorkflowTaskInfo = &v13.TransientWorkflowTaskInfo{}
-			}
-			if err := m.WorkflowTaskInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SourceNamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionTaskQueue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4895,18 +8830,18 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecutionTaskQueue == nil {
-				m.WorkflowExecutionTaskQueue = &v14.TaskQueue{}
+			if m.TaskQueue == nil {
+				m.TaskQueue = &v14.TaskQueue{}
 			}
-			if err := m.WorkflowExecutionTaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BranchToken", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
 			}
-			var byteLen int
+			m.ScheduleId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -4916,29 +8851,14 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.ScheduleId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BranchToken = append(m.BranchToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.BranchToken == nil {
-				m.BranchToken = []byte{}
-			}
-			iNdEx = postIndex
-		case 15:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToStartTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4965,18 +8885,18 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScheduledTime == nil {
-				m.ScheduledTime = new(time.Time)
+			if m.ScheduleToStartTimeout == nil {
+				m.ScheduleToStartTimeout = new(time.Duration)
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ScheduleToStartTimeout, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedSource", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -4986,33 +8906,29 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartedTime == nil {
-				m.StartedTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartedTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ForwardedSource = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var msglen int
+			m.Source = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -5022,121 +8938,64 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Source |= v15.TaskSource(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Queries == nil {
-				m.Queries = make(map[string]*v12.WorkflowQuery)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddActivityTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
 			}
-			var mapkey string
-			var mapvalue *v12.WorkflowQuery
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRequestResponse
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &v12.WorkflowQuery{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipRequestResponse(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Queries[mapkey] = mapvalue
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddActivityTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddActivityTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -5161,7 +9020,7 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PollActivityTaskQueueRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5180,21 +9039,89 @@ func (m *PollActivityTaskQueueRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PollActivityTaskQueueRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PollActivityTaskQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWorkflowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskQueue == nil {
+				m.TaskQueue = &v14.TaskQueue{}
+			}
+			if err := m.TaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryRequest", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -5204,27 +9131,31 @@ func (m *PollActivityTaskQueueRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			if m.QueryRequest == nil {
+				m.QueryRequest = &v1.QueryWorkflowRequest{}
+			}
+			if err := m.QueryRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PollerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedSource", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5252,11 +9183,11 @@ func (m *PollActivityTaskQueueRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PollerId = string(dAtA[iNdEx:postIndex])
+			m.ForwardedSource = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PollRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StickyDispatchTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5283,16 +9214,16 @@ func (m *PollActivityTaskQueueRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PollRequest == nil {
-				m.PollRequest = &v1.PollActivityTaskQueueRequest{}
+			if m.StickyDispatchTimeout == nil {
+				m.StickyDispatchTimeout = new(time.Duration)
 			}
-			if err := m.PollRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.StickyDispatchTimeout, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedSource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerBuildId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5320,7 +9251,7 @@ func (m *PollActivityTaskQueueRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ForwardedSource = string(dAtA[iNdEx:postIndex])
+			m.WorkerBuildId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5346,7 +9277,7 @@ func (m *PollActivityTaskQueueRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5369,17 +9300,17 @@ func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PollActivityTaskQueueResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWorkflowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PollActivityTaskQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWorkflowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryResult", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -5389,29 +9320,31 @@ func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskToken = append(m.TaskToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.TaskToken == nil {
-				m.TaskToken = []byte{}
+			if m.QueryResult == nil {
+				m.QueryResult = &v11.Payloads{}
+			}
+			if err := m.QueryResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryRejected", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5438,16 +9371,69 @@ func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v11.WorkflowExecution{}
+			if m.QueryRejected == nil {
+				m.QueryRejected = &v12.QueryRejected{}
 			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.QueryRejected.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondQueryTaskCompletedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondQueryTaskCompletedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondQueryTaskCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivityId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5475,11 +9461,11 @@ func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActivityId = string(dAtA[iNdEx:postIndex])
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivityType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5506,18 +9492,18 @@ func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ActivityType == nil {
-				m.ActivityType = &v11.ActivityType{}
+			if m.TaskQueue == nil {
+				m.TaskQueue = &v14.TaskQueue{}
 			}
-			if err := m.ActivityType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -5527,31 +9513,27 @@ func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Input == nil {
-				m.Input = &v11.Payloads{}
-			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5578,54 +9560,124 @@ func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScheduledTime == nil {
-				m.ScheduledTime = new(time.Time)
+			if m.CompletedRequest == nil {
+				m.CompletedRequest = &v1.RespondQueryTaskCompletedRequest{}
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CompletedRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToCloseTimeout", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondQueryTaskCompletedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondQueryTaskCompletedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondQueryTaskCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScheduleToCloseTimeout == nil {
-				m.ScheduleToCloseTimeout = new(time.Duration)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelOutstandingPollRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ScheduleToCloseTimeout, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 8:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelOutstandingPollRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelOutstandingPollRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -5635,33 +9687,29 @@ func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartedTime == nil {
-				m.StartedTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartedTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartToCloseTimeout", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueueType", wireType)
 			}
-			var msglen int
+			m.TaskQueueType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -5671,31 +9719,14 @@ func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TaskQueueType |= v16.TaskQueueType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.StartToCloseTimeout == nil {
-				m.StartToCloseTimeout = new(time.Duration)
-			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.StartToCloseTimeout, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5722,37 +9753,18 @@ func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HeartbeatTimeout == nil {
-				m.HeartbeatTimeout = new(time.Duration)
+			if m.TaskQueue == nil {
+				m.TaskQueue = &v14.TaskQueue{}
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.HeartbeatTimeout, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
-			}
-			m.Attempt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Attempt |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 12:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentAttemptScheduledTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PollerId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -5762,103 +9774,133 @@ func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CurrentAttemptScheduledTime == nil {
-				m.CurrentAttemptScheduledTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CurrentAttemptScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PollerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatDetails", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HeartbeatDetails == nil {
-				m.HeartbeatDetails = &v11.Payloads{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelOutstandingPollResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
 			}
-			if err := m.HeartbeatDetails.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelOutstandingPollResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelOutstandingPollResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeTaskQueueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowType == nil {
-				m.WorkflowType = &v11.WorkflowType{}
-			}
-			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 15:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeTaskQueueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeTaskQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowNamespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5886,11 +9928,11 @@ func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkflowNamespace = string(dAtA[iNdEx:postIndex])
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DescRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5917,10 +9959,10 @@ func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &v11.Header{}
+			if m.DescRequest == nil {
+				m.DescRequest = &v1.DescribeTaskQueueRequest{}
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DescRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5948,7 +9990,7 @@ func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
+func (m *DescribeTaskQueueResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5971,17 +10013,17 @@ func (m *AddWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddWorkflowTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeTaskQueueResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddWorkflowTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeTaskQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pollers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -5991,27 +10033,29 @@ func (m *AddWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			m.Pollers = append(m.Pollers, &v14.PollerInfo{})
+			if err := m.Pollers[len(m.Pollers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueueStatus", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6038,16 +10082,16 @@ func (m *AddWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Execution == nil {
-				m.Execution = &v11.WorkflowExecution{}
+			if m.TaskQueueStatus == nil {
+				m.TaskQueueStatus = &v14.TaskQueueStatus{}
 			}
-			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TaskQueueStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskReaderStatus", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6074,35 +10118,16 @@ func (m *AddWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TaskQueue == nil {
-				m.TaskQueue = &v14.TaskQueue{}
+			if m.TaskReaderStatus == nil {
+				m.TaskReaderStatus = &v17.TaskReaderStatus{}
 			}
-			if err := m.TaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TaskReaderStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
-			}
-			m.ScheduleId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ScheduleId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToStartTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BacklogStatus", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6129,16 +10154,69 @@ func (m *AddWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScheduleToStartTimeout == nil {
-				m.ScheduleToStartTimeout = new(time.Duration)
+			if m.BacklogStatus == nil {
+				m.BacklogStatus = &v17.TaskQueueBacklogStatus{}
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ScheduleToStartTimeout, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BacklogStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListTaskQueuePartitionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListTaskQueuePartitionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListTaskQueuePartitionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedSource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6166,13 +10244,13 @@ func (m *AddWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ForwardedSource = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
 			}
-			m.Source = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -6182,11 +10260,28 @@ func (m *AddWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Source |= v15.TaskSource(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskQueue == nil {
+				m.TaskQueue = &v14.TaskQueue{}
+			}
+			if err := m.TaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -6211,7 +10306,7 @@ func (m *AddWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddWorkflowTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *ListTaskQueuePartitionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6221,25 +10316,93 @@ func (m *AddWorkflowTaskResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowRequestResponse
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListTaskQueuePartitionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListTaskQueuePartitionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityTaskQueuePartitions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivityTaskQueuePartitions = append(m.ActivityTaskQueuePartitions, &v14.TaskQueuePartitionMetadata{})
+			if err := m.ActivityTaskQueuePartitions[len(m.ActivityTaskQueuePartitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTaskQueuePartitions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.WorkflowTaskQueuePartitions = append(m.WorkflowTaskQueuePartitions, &v14.TaskQueuePartitionMetadata{})
+			if err := m.WorkflowTaskQueuePartitions[len(m.WorkflowTaskQueuePartitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AddWorkflowTaskResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddWorkflowTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -6264,7 +10427,7 @@ func (m *AddWorkflowTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
+func (m *DescribeTaskQueuePartitionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6287,10 +10450,10 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddActivityTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeTaskQueuePartitionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddActivityTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeTaskQueuePartitionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6327,9 +10490,9 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -6339,33 +10502,29 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Execution == nil {
-				m.Execution = &v11.WorkflowExecution{}
-			}
-			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TaskQueue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceNamespaceId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueueType", wireType)
 			}
-			var stringLen uint64
+			m.TaskQueueType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -6375,27 +10534,67 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TaskQueueType |= v16.TaskQueueType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceNamespaceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeTaskQueuePartitionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeTaskQueuePartitionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeTaskQueuePartitionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6422,37 +10621,69 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TaskQueue == nil {
-				m.TaskQueue = &v14.TaskQueue{}
-			}
-			if err := m.TaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Partitions = append(m.Partitions, &v17.TaskQueuePartitionStatus{})
+			if err := m.Partitions[len(m.Partitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.ScheduleId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ScheduleId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-		case 6:
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTaskQueueUserDataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTaskQueueUserDataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTaskQueueUserDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToStartTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -6462,31 +10693,27 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScheduleToStartTimeout == nil {
-				m.ScheduleToStartTimeout = new(time.Duration)
-			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ScheduleToStartTimeout, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedSource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6514,13 +10741,13 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ForwardedSource = string(dAtA[iNdEx:postIndex])
+			m.TaskQueue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastKnownUserDataVersion", wireType)
 			}
-			m.Source = 0
+			m.LastKnownUserDataVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -6530,7 +10757,7 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Source |= v15.TaskSource(b&0x7F) << shift
+				m.LastKnownUserDataVersion |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6559,7 +10786,7 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddActivityTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *GetTaskQueueUserDataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6569,25 +10796,61 @@ func (m *AddActivityTaskResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowRequestResponse
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTaskQueueUserDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTaskQueueUserDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.UserData == nil {
+				m.UserData = &v18.TaskQueueUserData{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AddActivityTaskResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddActivityTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.UserData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -6612,7 +10875,7 @@ func (m *AddActivityTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
+func (m *UpdateTaskQueueUserDataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6635,10 +10898,10 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWorkflowRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateTaskQueueUserDataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateTaskQueueUserDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6677,7 +10940,7 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -6687,31 +10950,27 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TaskQueue == nil {
-				m.TaskQueue = &v14.TaskQueue{}
-			}
-			if err := m.TaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TaskQueue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserData", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6738,45 +10997,13 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.QueryRequest == nil {
-				m.QueryRequest = &v1.QueryWorkflowRequest{}
+			if m.UserData == nil {
+				m.UserData = &v18.TaskQueueUserData{}
 			}
-			if err := m.QueryRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UserData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedSource", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ForwardedSource = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -6801,7 +11028,7 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
+func (m *UpdateTaskQueueUserDataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6824,51 +11051,15 @@ func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWorkflowResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateTaskQueueUserDataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWorkflowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateTaskQueueUserDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryResult", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.QueryResult == nil {
-				m.QueryResult = &v11.Payloads{}
-			}
-			if err := m.QueryResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryRejected", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserData", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6895,10 +11086,10 @@ func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.QueryRejected == nil {
-				m.QueryRejected = &v12.QueryRejected{}
+			if m.UserData == nil {
+				m.UserData = &v18.TaskQueueUserData{}
 			}
-			if err := m.QueryRejected.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UserData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6926,7 +11117,7 @@ func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondQueryTaskCompletedRequest) Unmarshal(dAtA []byte) error {
+func (m *UpdateWorkerBuildIdCompatibilityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6949,10 +11140,10 @@ func (m *RespondQueryTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondQueryTaskCompletedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateWorkerBuildIdCompatibilityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondQueryTaskCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateWorkerBuildIdCompatibilityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6991,42 +11182,6 @@ func (m *RespondQueryTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TaskQueue == nil {
-				m.TaskQueue = &v14.TaskQueue{}
-			}
-			if err := m.TaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
-			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
@@ -7053,11 +11208,11 @@ func (m *RespondQueryTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskId = string(dAtA[iNdEx:postIndex])
+			m.TaskQueue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletedRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7084,10 +11239,10 @@ func (m *RespondQueryTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CompletedRequest == nil {
-				m.CompletedRequest = &v1.RespondQueryTaskCompletedRequest{}
+			if m.Update == nil {
+				m.Update = &v17.BuildIdCompatibilityUpdate{}
 			}
-			if err := m.CompletedRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Update.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7115,7 +11270,7 @@ func (m *RespondQueryTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondQueryTaskCompletedResponse) Unmarshal(dAtA []byte) error {
+func (m *UpdateWorkerBuildIdCompatibilityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7138,12 +11293,48 @@ func (m *RespondQueryTaskCompletedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondQueryTaskCompletedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateWorkerBuildIdCompatibilityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondQueryTaskCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateWorkerBuildIdCompatibilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersioningData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VersioningData == nil {
+				m.VersioningData = &v18.VersioningData{}
+			}
+			if err := m.VersioningData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -7168,7 +11359,7 @@ func (m *RespondQueryTaskCompletedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CancelOutstandingPollRequest) Unmarshal(dAtA []byte) error {
+func (m *GetWorkerBuildIdCompatibilityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7191,68 +11382,17 @@ func (m *CancelOutstandingPollRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CancelOutstandingPollRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetWorkerBuildIdCompatibilityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CancelOutstandingPollRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetWorkerBuildIdCompatibilityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueueType", wireType)
-			}
-			m.TaskQueueType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TaskQueueType |= v16.TaskQueueType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -7262,31 +11402,27 @@ func (m *CancelOutstandingPollRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TaskQueue == nil {
-				m.TaskQueue = &v14.TaskQueue{}
-			}
-			if err := m.TaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PollerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7314,8 +11450,27 @@ func (m *CancelOutstandingPollRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PollerId = string(dAtA[iNdEx:postIndex])
+			m.TaskQueue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSets", wireType)
+			}
+			m.MaxSets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSets |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -7340,7 +11495,7 @@ func (m *CancelOutstandingPollRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CancelOutstandingPollResponse) Unmarshal(dAtA []byte) error {
+func (m *GetWorkerBuildIdCompatibilityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7363,12 +11518,48 @@ func (m *CancelOutstandingPollResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CancelOutstandingPollResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetWorkerBuildIdCompatibilityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CancelOutstandingPollResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetWorkerBuildIdCompatibilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersioningData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VersioningData == nil {
+				m.VersioningData = &v18.VersioningData{}
+			}
+			if err := m.VersioningData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -7393,7 +11584,7 @@ func (m *CancelOutstandingPollResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeTaskQueueRequest) Unmarshal(dAtA []byte) error {
+func (m *EvictStickyTaskQueueRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7416,10 +11607,10 @@ func (m *DescribeTaskQueueRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeTaskQueueRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EvictStickyTaskQueueRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeTaskQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EvictStickyTaskQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7456,9 +11647,9 @@ func (m *DescribeTaskQueueRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DescRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -7468,27 +11659,23 @@ func (m *DescribeTaskQueueRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DescRequest == nil {
-				m.DescRequest = &v1.DescribeTaskQueueRequest{}
-			}
-			if err := m.DescRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TaskQueue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7514,7 +11701,7 @@ func (m *DescribeTaskQueueRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeTaskQueueResponse) Unmarshal(dAtA []byte) error {
+func (m *EvictStickyTaskQueueResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7537,82 +11724,12 @@ func (m *DescribeTaskQueueResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeTaskQueueResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EvictStickyTaskQueueResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeTaskQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EvictStickyTaskQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pollers", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Pollers = append(m.Pollers, &v14.PollerInfo{})
-			if err := m.Pollers[len(m.Pollers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueueStatus", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TaskQueueStatus == nil {
-				m.TaskQueueStatus = &v14.TaskQueueStatus{}
-			}
-			if err := m.TaskQueueStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -7637,7 +11754,7 @@ func (m *DescribeTaskQueueResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListTaskQueuePartitionsRequest) Unmarshal(dAtA []byte) error {
+func (m *GetHostProfileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7660,15 +11777,15 @@ func (m *ListTaskQueuePartitionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListTaskQueuePartitionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetHostProfileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListTaskQueuePartitionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetHostProfileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7696,11 +11813,43 @@ func (m *ListTaskQueuePartitionsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.HostAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Profile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7727,10 +11876,10 @@ func (m *ListTaskQueuePartitionsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TaskQueue == nil {
-				m.TaskQueue = &v14.TaskQueue{}
+			if m.Duration == nil {
+				m.Duration = new(time.Duration)
 			}
-			if err := m.TaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Duration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7758,7 +11907,7 @@ func (m *ListTaskQueuePartitionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListTaskQueuePartitionsResponse) Unmarshal(dAtA []byte) error {
+func (m *GetHostProfileResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7781,51 +11930,17 @@ func (m *ListTaskQueuePartitionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListTaskQueuePartitionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetHostProfileResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListTaskQueuePartitionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetHostProfileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivityTaskQueuePartitions", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ActivityTaskQueuePartitions = append(m.ActivityTaskQueuePartitions, &v14.TaskQueuePartitionMetadata{})
-			if err := m.ActivityTaskQueuePartitions[len(m.ActivityTaskQueuePartitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTaskQueuePartitions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -7835,24 +11950,24 @@ func (m *ListTaskQueuePartitionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkflowTaskQueuePartitions = append(m.WorkflowTaskQueuePartitions, &v14.TaskQueuePartitionMetadata{})
-			if err := m.WorkflowTaskQueuePartitions[len(m.WorkflowTaskQueuePartitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Profile = append(m.Profile[:0], dAtA[iNdEx:postIndex]...)
+			if m.Profile == nil {
+				m.Profile = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -54,36 +54,47 @@ func init() {
 }
 
 var fileDescriptor_1a5c83076e651916 = []byte{
-	// 456 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xbf, 0x6e, 0x1a, 0x31,
-	0x1c, 0x80, 0xcf, 0x4b, 0x07, 0x57, 0x15, 0xea, 0x49, 0x55, 0x55, 0x06, 0x0f, 0x1d, 0x3a, 0xde,
-	0x89, 0xb6, 0x5b, 0xa1, 0x2d, 0x85, 0xfe, 0x93, 0x5a, 0x15, 0xda, 0x4a, 0x95, 0xba, 0x54, 0xe6,
-	0xce, 0xa5, 0x16, 0xc7, 0xf9, 0x6a, 0xfb, 0x2e, 0x62, 0xcb, 0x13, 0x44, 0x19, 0x32, 0xe5, 0x01,
-	0xa2, 0x0c, 0x99, 0xf2, 0x14, 0x19, 0x32, 0x30, 0x32, 0x86, 0x63, 0xc9, 0xc8, 0x23, 0x44, 0x70,
-	0xf8, 0x80, 0x03, 0x22, 0x73, 0x64, 0x03, 0xe3, 0xef, 0xf3, 0x67, 0x89, 0x9f, 0x0c, 0x5f, 0x4a,
-	0xd2, 0x0d, 0x18, 0xc7, 0x9e, 0x2d, 0x08, 0x8f, 0x08, 0xb7, 0x71, 0x40, 0xed, 0x2e, 0x96, 0xce,
-	0x3f, 0xea, 0xb7, 0x27, 0x4b, 0xd4, 0x21, 0x76, 0x54, 0xb2, 0x67, 0x1f, 0xad, 0x80, 0x33, 0xc9,
-	0xcc, 0x67, 0x8a, 0xb2, 0x12, 0xca, 0xc2, 0x01, 0xb5, 0x32, 0x94, 0x15, 0x95, 0x8a, 0x15, 0x4d,
-	0x3b, 0x27, 0xff, 0x43, 0x22, 0xe4, 0x1f, 0x4e, 0x44, 0xc0, 0x7c, 0x31, 0x3b, 0xe6, 0xf9, 0xe5,
-	0x7d, 0x58, 0xf8, 0x3a, 0xdb, 0xfd, 0x23, 0xd9, 0x6d, 0x9e, 0x00, 0xf8, 0xa8, 0xc1, 0x3c, 0xef,
-	0x17, 0xe3, 0x9d, 0xbf, 0x1e, 0xdb, 0xfb, 0x89, 0x45, 0xa7, 0x19, 0x92, 0x90, 0x98, 0x75, 0x4b,
-	0xaf, 0xca, 0x5a, 0x8b, 0x7f, 0x4f, 0x12, 0x8a, 0xef, 0x77, 0xb4, 0x24, 0x17, 0x78, 0x6a, 0xa4,
-	0xa1, 0x55, 0x47, 0xd2, 0x88, 0xca, 0x5e, 0xce, 0xd0, 0x15, 0x3c, 0x57, 0xe8, 0x1a, 0x4b, 0x1a,
-	0x7a, 0x04, 0x60, 0xa1, 0xea, 0xba, 0x8b, 0x77, 0x31, 0x5f, 0xeb, 0xca, 0x33, 0xa0, 0x8a, 0x7b,
-	0x93, 0x9b, 0xcf, 0x66, 0x2d, 0x96, 0x6f, 0x95, 0xb5, 0x08, 0xe6, 0xc9, 0x5a, 0xe6, 0xd3, 0xac,
-	0x03, 0x00, 0x1f, 0x34, 0x43, 0xc2, 0x7b, 0x2a, 0xdb, 0x2c, 0xeb, 0x4a, 0x97, 0x30, 0x95, 0x54,
-	0xc9, 0x49, 0xa7, 0x41, 0xe7, 0x00, 0x3e, 0x49, 0xbe, 0xba, 0xd3, 0x2d, 0x93, 0xde, 0x1a, 0xeb,
-	0x06, 0x1e, 0x91, 0xc4, 0x35, 0x3f, 0xe9, 0xea, 0x37, 0x2a, 0x54, 0xe8, 0xe7, 0x3b, 0x30, 0x2d,
-	0x0d, 0x47, 0x0d, 0xfb, 0x0e, 0xf1, 0xbe, 0x85, 0x52, 0x48, 0xec, 0xbb, 0xd4, 0x6f, 0x4f, 0xfe,
-	0xa8, 0xfa, 0xc3, 0xb1, 0x16, 0xdf, 0x7a, 0x38, 0x36, 0x58, 0xd2, 0xd0, 0x63, 0x00, 0x1f, 0xd6,
-	0x89, 0x70, 0x38, 0x6d, 0x91, 0xf9, 0x04, 0xbf, 0xd5, 0xd5, 0xaf, 0xa0, 0x2a, 0xb0, 0xba, 0x83,
-	0x21, 0x8d, 0x3b, 0x03, 0xf0, 0xf1, 0x17, 0x2a, 0x64, 0xfa, 0x5b, 0x03, 0x73, 0x49, 0x25, 0x65,
-	0xbe, 0x30, 0x3f, 0xe8, 0x1e, 0xb0, 0x41, 0xa0, 0x42, 0x3f, 0xee, 0xec, 0x51, 0xb9, 0xef, 0x78,
-	0x7f, 0x88, 0x8c, 0xc1, 0x10, 0x19, 0xe3, 0x21, 0x02, 0xfb, 0x31, 0x02, 0xa7, 0x31, 0x02, 0x17,
-	0x31, 0x02, 0xfd, 0x18, 0x81, 0xab, 0x18, 0x81, 0xeb, 0x18, 0x19, 0xe3, 0x18, 0x81, 0xc3, 0x11,
-	0x32, 0xfa, 0x23, 0x64, 0x0c, 0x46, 0xc8, 0xf8, 0x5d, 0x6e, 0xb3, 0x79, 0x02, 0x65, 0xb7, 0xbf,
-	0x24, 0xaf, 0x32, 0x4b, 0xad, 0x7b, 0xd3, 0x97, 0xe4, 0xc5, 0x4d, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x04, 0x9e, 0xce, 0x0a, 0xe8, 0x06, 0x00, 0x00,
+	// 627 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xb1, 0x6f, 0xd3, 0x4e,
+	0x14, 0xc7, 0x7d, 0xcb, 0x6f, 0x38, 0xe9, 0x47, 0x85, 0x45, 0x85, 0x28, 0xe2, 0x84, 0x18, 0x18,
+	0x1d, 0x15, 0xd8, 0x68, 0x0b, 0x69, 0x52, 0xd2, 0x42, 0xab, 0xa6, 0x94, 0x0a, 0x89, 0x05, 0x5d,
+	0xec, 0xd7, 0x70, 0x8a, 0xe3, 0x33, 0xe7, 0x73, 0x50, 0x36, 0xfe, 0x02, 0x04, 0x12, 0x13, 0x1b,
+	0x42, 0x42, 0x0c, 0x4c, 0x4c, 0x4c, 0xac, 0x30, 0x66, 0xec, 0x48, 0x9c, 0x85, 0xb1, 0x7f, 0x02,
+	0x4a, 0x1d, 0x5f, 0x9c, 0xc4, 0x2e, 0xb6, 0x93, 0x2d, 0x71, 0xee, 0xfb, 0x79, 0x9f, 0xe7, 0xbc,
+	0x3b, 0x1d, 0xbe, 0x23, 0xa1, 0xed, 0x72, 0x41, 0xed, 0x92, 0x07, 0xa2, 0x03, 0xa2, 0x44, 0x5d,
+	0x56, 0x6a, 0x53, 0x69, 0xbe, 0x60, 0x4e, 0x73, 0xf8, 0x88, 0x99, 0x50, 0xea, 0xac, 0x96, 0x46,
+	0x1f, 0x0d, 0x57, 0x70, 0xc9, 0xf5, 0x9b, 0x51, 0xca, 0x08, 0x53, 0x06, 0x75, 0x99, 0x31, 0x95,
+	0x32, 0x3a, 0xab, 0x2b, 0xeb, 0x19, 0xe9, 0x02, 0x5e, 0xfa, 0xe0, 0xc9, 0xe7, 0x02, 0x3c, 0x97,
+	0x3b, 0xde, 0xa8, 0xcc, 0xad, 0x8f, 0xcb, 0x78, 0x69, 0x6f, 0xb4, 0xfa, 0x30, 0x5c, 0xad, 0x7f,
+	0x46, 0x78, 0xb9, 0xce, 0x6d, 0xfb, 0x29, 0x17, 0xad, 0x63, 0x9b, 0xbf, 0x7a, 0x42, 0xbd, 0xd6,
+	0x81, 0x0f, 0x3e, 0xe8, 0x55, 0x23, 0x9b, 0x95, 0x91, 0x18, 0x7f, 0x1c, 0x2a, 0xac, 0x6c, 0xcd,
+	0x49, 0x09, 0x1b, 0xb8, 0xa1, 0x29, 0xd1, 0xb2, 0x29, 0x59, 0x87, 0xc9, 0x6e, 0x41, 0xd1, 0x99,
+	0x78, 0x21, 0xd1, 0x04, 0x8a, 0x12, 0x7d, 0x8f, 0xf0, 0x52, 0xd9, 0xb2, 0xe2, 0xbd, 0xe8, 0x1b,
+	0x59, 0xe1, 0x53, 0xc1, 0x48, 0xee, 0x5e, 0xe1, 0xfc, 0xb4, 0x56, 0xdc, 0x3c, 0x97, 0x56, 0x3c,
+	0x58, 0x44, 0x6b, 0x32, 0xaf, 0xb4, 0xde, 0x20, 0xfc, 0xff, 0x81, 0x0f, 0xa2, 0x1b, 0x69, 0xeb,
+	0x6b, 0x59, 0xa1, 0x13, 0xb1, 0x48, 0x69, 0xbd, 0x60, 0x5a, 0x09, 0x7d, 0x43, 0xf8, 0x4a, 0xf8,
+	0xd5, 0x3a, 0x5b, 0x32, 0xf4, 0xad, 0xf0, 0xb6, 0x6b, 0x83, 0x04, 0x4b, 0xdf, 0xce, 0x8a, 0x4f,
+	0x45, 0x44, 0xa2, 0x3b, 0x0b, 0x20, 0x4d, 0x6c, 0x8e, 0x0a, 0x75, 0x4c, 0xb0, 0xf7, 0x7d, 0xe9,
+	0x49, 0xea, 0x58, 0xcc, 0x69, 0x0e, 0x07, 0x35, 0xfb, 0xe6, 0x48, 0x8c, 0xe7, 0xde, 0x1c, 0x29,
+	0x14, 0x25, 0xfa, 0x01, 0xe1, 0x8b, 0x55, 0xf0, 0x4c, 0xc1, 0x1a, 0x30, 0xde, 0xc1, 0xf7, 0xb3,
+	0xe2, 0x67, 0xa2, 0x91, 0x60, 0x79, 0x0e, 0x82, 0x92, 0xfb, 0x8a, 0xf0, 0xe5, 0x5d, 0xe6, 0x49,
+	0xf5, 0x5b, 0x9d, 0x0a, 0xc9, 0x24, 0xe3, 0x8e, 0xa7, 0x3f, 0xc8, 0x5a, 0x20, 0x05, 0x10, 0x89,
+	0xd6, 0xe6, 0xe6, 0x28, 0xdd, 0xef, 0x08, 0x5f, 0x9d, 0x69, 0x27, 0xa6, 0xfc, 0xb0, 0xf0, 0x3b,
+	0x99, 0xd5, 0x7e, 0xb4, 0x10, 0x96, 0x52, 0xff, 0x84, 0xf0, 0xa5, 0x1a, 0x8c, 0xfb, 0x3b, 0xf2,
+	0x40, 0x54, 0xa9, 0xa4, 0x7a, 0x25, 0x6b, 0x9d, 0xa4, 0x74, 0x24, 0x5b, 0x9d, 0x0f, 0x32, 0x31,
+	0x0f, 0x47, 0xae, 0x45, 0x25, 0xcc, 0x8a, 0x66, 0x9e, 0x87, 0x14, 0x40, 0xee, 0x79, 0x48, 0xe5,
+	0x28, 0xdd, 0x9f, 0x08, 0x5f, 0x0f, 0x57, 0x0d, 0x8f, 0x35, 0x10, 0x9b, 0x3e, 0xb3, 0xad, 0x1d,
+	0x6b, 0x78, 0x5e, 0x50, 0xc9, 0x1a, 0xcc, 0x66, 0xb2, 0xab, 0xef, 0xe7, 0xab, 0x97, 0x4e, 0x8a,
+	0x1a, 0xa8, 0x2f, 0x0e, 0xa8, 0x3a, 0xf9, 0x81, 0xf0, 0xb5, 0x1a, 0xc8, 0x73, 0xda, 0xd8, 0xcd,
+	0xf1, 0x17, 0xff, 0xbb, 0x87, 0xbd, 0x05, 0xd1, 0x26, 0xe6, 0x7b, 0xab, 0xc3, 0x4c, 0x79, 0x28,
+	0x99, 0xd9, 0x8a, 0xdd, 0x55, 0x32, 0xcf, 0x77, 0x52, 0x3a, 0xf7, 0x7c, 0x27, 0x43, 0x94, 0xe5,
+	0x3b, 0x84, 0x2f, 0xd4, 0x40, 0x6e, 0x73, 0x4f, 0xd6, 0x05, 0x3f, 0x66, 0x36, 0xe8, 0xeb, 0x39,
+	0xde, 0x44, 0x2c, 0x17, 0x99, 0x6d, 0x14, 0x8d, 0x47, 0x4e, 0x9b, 0xa2, 0xd7, 0x27, 0xda, 0x49,
+	0x9f, 0x68, 0xa7, 0x7d, 0x82, 0x5e, 0x07, 0x04, 0x7d, 0x09, 0x08, 0xfa, 0x15, 0x10, 0xd4, 0x0b,
+	0x08, 0xfa, 0x1d, 0x10, 0xf4, 0x27, 0x20, 0xda, 0x69, 0x40, 0xd0, 0xdb, 0x01, 0xd1, 0x7a, 0x03,
+	0xa2, 0x9d, 0x0c, 0x88, 0xf6, 0x6c, 0xad, 0xc9, 0xc7, 0x95, 0x19, 0x3f, 0xff, 0x7a, 0x7c, 0x77,
+	0xea, 0x51, 0xe3, 0xbf, 0xb3, 0xeb, 0xf1, 0xed, 0xbf, 0x03, 0x00, 0x6f, 0x5b, 0x8b, 0xad, 0xbd,
+	0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -127,6 +138,24 @@ type MatchingServiceClient interface {
 	DescribeTaskQueue(ctx context.Context, in *DescribeTaskQueueRequest, opts ...grpc.CallOption) (*DescribeTaskQueueResponse, error)
 	// ListTaskQueuePartitions returns a map of partitionKey and hostAddress for a task queue.
 	ListTaskQueuePartitions(ctx context.Context, in *ListTaskQueuePartitionsRequest, opts ...grpc.CallOption) (*ListTaskQueuePartitionsResponse, error)
+	// DescribeTaskQueuePartitions returns pollers, backlog and task reader status of every partition of a task queue.
+	DescribeTaskQueuePartitions(ctx context.Context, in *DescribeTaskQueuePartitionsRequest, opts ...grpc.CallOption) (*DescribeTaskQueuePartitionsResponse, error)
+	// GetTaskQueueUserData returns the user data of a task queue, which is owned by the root partition
+	// of its workflow task queue. Other partitions call this API to propagate the user data.
+	GetTaskQueueUserData(ctx context.Context, in *GetTaskQueueUserDataRequest, opts ...grpc.CallOption) (*GetTaskQueueUserDataResponse, error)
+	// UpdateTaskQueueUserData replaces the user data of a task queue and increments its version.
+	UpdateTaskQueueUserData(ctx context.Context, in *UpdateTaskQueueUserDataRequest, opts ...grpc.CallOption) (*UpdateTaskQueueUserDataResponse, error)
+	// UpdateWorkerBuildIdCompatibility updates the compatible worker build id sets of a task queue,
+	// which workflow tasks of the task queue are routed to.
+	UpdateWorkerBuildIdCompatibility(ctx context.Context, in *UpdateWorkerBuildIdCompatibilityRequest, opts ...grpc.CallOption) (*UpdateWorkerBuildIdCompatibilityResponse, error)
+	// GetWorkerBuildIdCompatibility returns the compatible worker build id sets of a task queue.
+	GetWorkerBuildIdCompatibility(ctx context.Context, in *GetWorkerBuildIdCompatibilityRequest, opts ...grpc.CallOption) (*GetWorkerBuildIdCompatibilityResponse, error)
+	// EvictStickyTaskQueue invalidates the sticky task queue of a worker which is shutting down. Workflow tasks
+	// and queries are no longer dispatched to the sticky task queue, so they fall back to the normal task queue
+	// right away instead of waiting for the sticky schedule to start timeout.
+	EvictStickyTaskQueue(ctx context.Context, in *EvictStickyTaskQueueRequest, opts ...grpc.CallOption) (*EvictStickyTaskQueueResponse, error)
+	// GetHostProfile captures a pprof profile of a matching host.
+	GetHostProfile(ctx context.Context, in *GetHostProfileRequest, opts ...grpc.CallOption) (*GetHostProfileResponse, error)
 }
 
 type matchingServiceClient struct {
@@ -218,6 +247,69 @@ func (c *matchingServiceClient) ListTaskQueuePartitions(ctx context.Context, in
 	return out, nil
 }
 
+func (c *matchingServiceClient) DescribeTaskQueuePartitions(ctx context.Context, in *DescribeTaskQueuePartitionsRequest, opts ...grpc.CallOption) (*DescribeTaskQueuePartitionsResponse, error) {
+	out := new(DescribeTaskQueuePartitionsResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.matchingservice.v1.MatchingService/DescribeTaskQueuePartitions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) GetTaskQueueUserData(ctx context.Context, in *GetTaskQueueUserDataRequest, opts ...grpc.CallOption) (*GetTaskQueueUserDataResponse, error) {
+	out := new(GetTaskQueueUserDataResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.matchingservice.v1.MatchingService/GetTaskQueueUserData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) UpdateTaskQueueUserData(ctx context.Context, in *UpdateTaskQueueUserDataRequest, opts ...grpc.CallOption) (*UpdateTaskQueueUserDataResponse, error) {
+	out := new(UpdateTaskQueueUserDataResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.matchingservice.v1.MatchingService/UpdateTaskQueueUserData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) UpdateWorkerBuildIdCompatibility(ctx context.Context, in *UpdateWorkerBuildIdCompatibilityRequest, opts ...grpc.CallOption) (*UpdateWorkerBuildIdCompatibilityResponse, error) {
+	out := new(UpdateWorkerBuildIdCompatibilityResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.matchingservice.v1.MatchingService/UpdateWorkerBuildIdCompatibility", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) GetWorkerBuildIdCompatibility(ctx context.Context, in *GetWorkerBuildIdCompatibilityRequest, opts ...grpc.CallOption) (*GetWorkerBuildIdCompatibilityResponse, error) {
+	out := new(GetWorkerBuildIdCompatibilityResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.matchingservice.v1.MatchingService/GetWorkerBuildIdCompatibility", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) EvictStickyTaskQueue(ctx context.Context, in *EvictStickyTaskQueueRequest, opts ...grpc.CallOption) (*EvictStickyTaskQueueResponse, error) {
+	out := new(EvictStickyTaskQueueResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.matchingservice.v1.MatchingService/EvictStickyTaskQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) GetHostProfile(ctx context.Context, in *GetHostProfileRequest, opts ...grpc.CallOption) (*GetHostProfileResponse, error) {
+	out := new(GetHostProfileResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.matchingservice.v1.MatchingService/GetHostProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MatchingServiceServer is the server API for MatchingService service.
 type MatchingServiceServer interface {
 	// PollWorkflowTaskQueue is called by frontend to process WorkflowTask from a specific task queue.  A
@@ -249,6 +341,24 @@ type MatchingServiceServer interface {
 	DescribeTaskQueue(context.Context, *DescribeTaskQueueRequest) (*DescribeTaskQueueResponse, error)
 	// ListTaskQueuePartitions returns a map of partitionKey and hostAddress for a task queue.
 	ListTaskQueuePartitions(context.Context, *ListTaskQueuePartitionsRequest) (*ListTaskQueuePartitionsResponse, error)
+	// DescribeTaskQueuePartitions returns pollers, backlog and task reader status of every partition of a task queue.
+	DescribeTaskQueuePartitions(context.Context, *DescribeTaskQueuePartitionsRequest) (*DescribeTaskQueuePartitionsResponse, error)
+	// GetTaskQueueUserData returns the user data of a task queue, which is owned by the root partition
+	// of its workflow task queue. Other partitions call this API to propagate the user data.
+	GetTaskQueueUserData(context.Context, *GetTaskQueueUserDataRequest) (*GetTaskQueueUserDataResponse, error)
+	// UpdateTaskQueueUserData replaces the user data of a task queue and increments its version.
+	UpdateTaskQueueUserData(context.Context, *UpdateTaskQueueUserDataRequest) (*UpdateTaskQueueUserDataResponse, error)
+	// UpdateWorkerBuildIdCompatibility updates the compatible worker build id sets of a task queue,
+	// which workflow tasks of the task queue are routed to.
+	UpdateWorkerBuildIdCompatibility(context.Context, *UpdateWorkerBuildIdCompatibilityRequest) (*UpdateWorkerBuildIdCompatibilityResponse, error)
+	// GetWorkerBuildIdCompatibility returns the compatible worker build id sets of a task queue.
+	GetWorkerBuildIdCompatibility(context.Context, *GetWorkerBuildIdCompatibilityRequest) (*GetWorkerBuildIdCompatibilityResponse, error)
+	// EvictStickyTaskQueue invalidates the sticky task queue of a worker which is shutting down. Workflow tasks
+	// and queries are no longer dispatched to the sticky task queue, so they fall back to the normal task queue
+	// right away instead of waiting for the sticky schedule to start timeout.
+	EvictStickyTaskQueue(context.Context, *EvictStickyTaskQueueRequest) (*EvictStickyTaskQueueResponse, error)
+	// GetHostProfile captures a pprof profile of a matching host.
+	GetHostProfile(context.Context, *GetHostProfileRequest) (*GetHostProfileResponse, error)
 }
 
 // UnimplementedMatchingServiceServer can be embedded to have forward compatible implementations.
@@ -282,6 +392,27 @@ func (*UnimplementedMatchingServiceServer) DescribeTaskQueue(ctx context.Context
 func (*UnimplementedMatchingServiceServer) ListTaskQueuePartitions(ctx context.Context, req *ListTaskQueuePartitionsRequest) (*ListTaskQueuePartitionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTaskQueuePartitions not implemented")
 }
+func (*UnimplementedMatchingServiceServer) DescribeTaskQueuePartitions(ctx context.Context, req *DescribeTaskQueuePartitionsRequest) (*DescribeTaskQueuePartitionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeTaskQueuePartitions not implemented")
+}
+func (*UnimplementedMatchingServiceServer) GetTaskQueueUserData(ctx context.Context, req *GetTaskQueueUserDataRequest) (*GetTaskQueueUserDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskQueueUserData not implemented")
+}
+func (*UnimplementedMatchingServiceServer) UpdateTaskQueueUserData(ctx context.Context, req *UpdateTaskQueueUserDataRequest) (*UpdateTaskQueueUserDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTaskQueueUserData not implemented")
+}
+func (*UnimplementedMatchingServiceServer) UpdateWorkerBuildIdCompatibility(ctx context.Context, req *UpdateWorkerBuildIdCompatibilityRequest) (*UpdateWorkerBuildIdCompatibilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkerBuildIdCompatibility not implemented")
+}
+func (*UnimplementedMatchingServiceServer) GetWorkerBuildIdCompatibility(ctx context.Context, req *GetWorkerBuildIdCompatibilityRequest) (*GetWorkerBuildIdCompatibilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerBuildIdCompatibility not implemented")
+}
+func (*UnimplementedMatchingServiceServer) EvictStickyTaskQueue(ctx context.Context, req *EvictStickyTaskQueueRequest) (*EvictStickyTaskQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvictStickyTaskQueue not implemented")
+}
+func (*UnimplementedMatchingServiceServer) GetHostProfile(ctx context.Context, req *GetHostProfileRequest) (*GetHostProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHostProfile not implemented")
+}
 
 func RegisterMatchingServiceServer(s *grpc.Server, srv MatchingServiceServer) {
 	s.RegisterService(&_MatchingService_serviceDesc, srv)
@@ -449,6 +580,132 @@ func _MatchingService_ListTaskQueuePartitions_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MatchingService_DescribeTaskQueuePartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeTaskQueuePartitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).DescribeTaskQueuePartitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.matchingservice.v1.MatchingService/DescribeTaskQueuePartitions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).DescribeTaskQueuePartitions(ctx, req.(*DescribeTaskQueuePartitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_GetTaskQueueUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskQueueUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).GetTaskQueueUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.matchingservice.v1.MatchingService/GetTaskQueueUserData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).GetTaskQueueUserData(ctx, req.(*GetTaskQueueUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_UpdateTaskQueueUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaskQueueUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).UpdateTaskQueueUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.matchingservice.v1.MatchingService/UpdateTaskQueueUserData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).UpdateTaskQueueUserData(ctx, req.(*UpdateTaskQueueUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_UpdateWorkerBuildIdCompatibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkerBuildIdCompatibilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).UpdateWorkerBuildIdCompatibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.matchingservice.v1.MatchingService/UpdateWorkerBuildIdCompatibility",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).UpdateWorkerBuildIdCompatibility(ctx, req.(*UpdateWorkerBuildIdCompatibilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_GetWorkerBuildIdCompatibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkerBuildIdCompatibilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).GetWorkerBuildIdCompatibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.matchingservice.v1.MatchingService/GetWorkerBuildIdCompatibility",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).GetWorkerBuildIdCompatibility(ctx, req.(*GetWorkerBuildIdCompatibilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_EvictStickyTaskQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvictStickyTaskQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).EvictStickyTaskQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.matchingservice.v1.MatchingService/EvictStickyTaskQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).EvictStickyTaskQueue(ctx, req.(*EvictStickyTaskQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_GetHostProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHostProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).GetHostProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.matchingservice.v1.MatchingService/GetHostProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).GetHostProfile(ctx, req.(*GetHostProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _MatchingService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "temporal.server.api.matchingservice.v1.MatchingService",
 	HandlerType: (*MatchingServiceServer)(nil),
@@ -489,6 +746,34 @@ var _MatchingService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListTaskQueuePartitions",
 			Handler:    _MatchingService_ListTaskQueuePartitions_Handler,
 		},
+		{
+			MethodName: "DescribeTaskQueuePartitions",
+			Handler:    _MatchingService_DescribeTaskQueuePartitions_Handler,
+		},
+		{
+			MethodName: "GetTaskQueueUserData",
+			Handler:    _MatchingService_GetTaskQueueUserData_Handler,
+		},
+		{
+			MethodName: "UpdateTaskQueueUserData",
+			Handler:    _MatchingService_UpdateTaskQueueUserData_Handler,
+		},
+		{
+			MethodName: "UpdateWorkerBuildIdCompatibility",
+			Handler:    _MatchingService_UpdateWorkerBuildIdCompatibility_Handler,
+		},
+		{
+			MethodName: "GetWorkerBuildIdCompatibility",
+			Handler:    _MatchingService_GetWorkerBuildIdCompatibility_Handler,
+		},
+		{
+			MethodName: "EvictStickyTaskQueue",
+			Handler:    _MatchingService_EvictStickyTaskQueue_Handler,
+		},
+		{
+			MethodName: "GetHostProfile",
+			Handler:    _MatchingService_GetHostProfile_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "temporal/server/api/matchingservice/v1/service.proto",
@@ -34,9 +34,13 @@ import (
 	math_bits "math/bits"
 	reflect "reflect"
 	strings "strings"
+	time "time"
 
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	v11 "go.temporal.io/api/enums/v1"
 	v1 "go.temporal.io/api/version/v1"
 )
@@ -45,6 +49,7 @@ import (
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -59,6 +64,13 @@ type ClusterMetadata struct {
 	ClusterId             string                            `protobuf:"bytes,3,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
 	VersionInfo           *v1.VersionInfo                   `protobuf:"bytes,4,opt,name=version_info,json=versionInfo,proto3" json:"version_info,omitempty"`
 	IndexSearchAttributes map[string]*IndexSearchAttributes `protobuf:"bytes,5,rep,name=index_search_attributes,json=indexSearchAttributes,proto3" json:"index_search_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Dynamic config values set through admin API by dynamic config key.
+	DynamicConfig map[string]*DynamicConfigValues `protobuf:"bytes,6,rep,name=dynamic_config,json=dynamicConfig,proto3" json:"dynamic_config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Planned maintenance announced to the clients of the cluster.
+	MaintenanceInfo *MaintenanceInfo `protobuf:"bytes,7,opt,name=maintenance_info,json=maintenanceInfo,proto3" json:"maintenance_info,omitempty"`
+	// Zero if the cluster metadata was saved before failover version increment was persisted.
+	FailoverVersionIncrement int64                          `protobuf:"varint,8,opt,name=failover_version_increment,json=failoverVersionIncrement,proto3" json:"failover_version_increment,omitempty"`
+	ClusterInformation       map[string]*ClusterInformation `protobuf:"bytes,9,rep,name=cluster_information,json=clusterInformation,proto3" json:"cluster_information,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *ClusterMetadata) Reset()      { *m = ClusterMetadata{} }
@@ -128,14 +140,162 @@ func (m *ClusterMetadata) GetIndexSearchAttributes() map[string]*IndexSearchAttr
 	return nil
 }
 
+func (m *ClusterMetadata) GetDynamicConfig() map[string]*DynamicConfigValues {
+	if m != nil {
+		return m.DynamicConfig
+	}
+	return nil
+}
+
+func (m *ClusterMetadata) GetMaintenanceInfo() *MaintenanceInfo {
+	if m != nil {
+		return m.MaintenanceInfo
+	}
+	return nil
+}
+
+func (m *ClusterMetadata) GetFailoverVersionIncrement() int64 {
+	if m != nil {
+		return m.FailoverVersionIncrement
+	}
+	return 0
+}
+
+func (m *ClusterMetadata) GetClusterInformation() map[string]*ClusterInformation {
+	if m != nil {
+		return m.ClusterInformation
+	}
+	return nil
+}
+
+type ClusterInformation struct {
+	Enabled                bool   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	InitialFailoverVersion int64  `protobuf:"varint,2,opt,name=initial_failover_version,json=initialFailoverVersion,proto3" json:"initial_failover_version,omitempty"`
+	RpcAddress             string `protobuf:"bytes,3,opt,name=rpc_address,json=rpcAddress,proto3" json:"rpc_address,omitempty"`
+}
+
+func (m *ClusterInformation) Reset()      { *m = ClusterInformation{} }
+func (*ClusterInformation) ProtoMessage() {}
+func (*ClusterInformation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f4771d63f405884, []int{1}
+}
+func (m *ClusterInformation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterInformation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterInformation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClusterInformation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterInformation.Merge(m, src)
+}
+func (m *ClusterInformation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterInformation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterInformation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterInformation proto.InternalMessageInfo
+
+func (m *ClusterInformation) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *ClusterInformation) GetInitialFailoverVersion() int64 {
+	if m != nil {
+		return m.InitialFailoverVersion
+	}
+	return 0
+}
+
+func (m *ClusterInformation) GetRpcAddress() string {
+	if m != nil {
+		return m.RpcAddress
+	}
+	return ""
+}
+
+type MaintenanceInfo struct {
+	Message        string       `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Severity       v11.Severity `protobuf:"varint,2,opt,name=severity,proto3,enum=temporal.api.enums.v1.Severity" json:"severity,omitempty"`
+	LastUpdateTime *time.Time   `protobuf:"bytes,3,opt,name=last_update_time,json=lastUpdateTime,proto3,stdtime" json:"last_update_time,omitempty"`
+}
+
+func (m *MaintenanceInfo) Reset()      { *m = MaintenanceInfo{} }
+func (*MaintenanceInfo) ProtoMessage() {}
+func (*MaintenanceInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f4771d63f405884, []int{2}
+}
+func (m *MaintenanceInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaintenanceInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaintenanceInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MaintenanceInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceInfo.Merge(m, src)
+}
+func (m *MaintenanceInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaintenanceInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenanceInfo proto.InternalMessageInfo
+
+func (m *MaintenanceInfo) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *MaintenanceInfo) GetSeverity() v11.Severity {
+	if m != nil {
+		return m.Severity
+	}
+	return v11.SEVERITY_UNSPECIFIED
+}
+
+func (m *MaintenanceInfo) GetLastUpdateTime() *time.Time {
+	if m != nil {
+		return m.LastUpdateTime
+	}
+	return nil
+}
+
 type IndexSearchAttributes struct {
 	CustomSearchAttributes map[string]v11.IndexedValueType `protobuf:"bytes,1,rep,name=custom_search_attributes,json=customSearchAttributes,proto3" json:"custom_search_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=temporal.api.enums.v1.IndexedValueType"`
+	// Used to resolve conflicts between search attributes replicated from other clusters.
+	LastUpdateTime *time.Time `protobuf:"bytes,2,opt,name=last_update_time,json=lastUpdateTime,proto3,stdtime" json:"last_update_time,omitempty"`
 }
 
 func (m *IndexSearchAttributes) Reset()      { *m = IndexSearchAttributes{} }
 func (*IndexSearchAttributes) ProtoMessage() {}
 func (*IndexSearchAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1f4771d63f405884, []int{1}
+	return fileDescriptor_1f4771d63f405884, []int{3}
 }
 func (m *IndexSearchAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -171,11 +331,204 @@ func (m *IndexSearchAttributes) GetCustomSearchAttributes() map[string]v11.Index
 	return nil
 }
 
+func (m *IndexSearchAttributes) GetLastUpdateTime() *time.Time {
+	if m != nil {
+		return m.LastUpdateTime
+	}
+	return nil
+}
+
+type DynamicConfigValues struct {
+	Values         []*DynamicConfigValue `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	LastUpdateTime *time.Time            `protobuf:"bytes,2,opt,name=last_update_time,json=lastUpdateTime,proto3,stdtime" json:"last_update_time,omitempty"`
+}
+
+func (m *DynamicConfigValues) Reset()      { *m = DynamicConfigValues{} }
+func (*DynamicConfigValues) ProtoMessage() {}
+func (*DynamicConfigValues) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f4771d63f405884, []int{4}
+}
+func (m *DynamicConfigValues) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicConfigValues) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicConfigValues.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DynamicConfigValues) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicConfigValues.Merge(m, src)
+}
+func (m *DynamicConfigValues) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicConfigValues) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicConfigValues.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicConfigValues proto.InternalMessageInfo
+
+func (m *DynamicConfigValues) GetValues() []*DynamicConfigValue {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func (m *DynamicConfigValues) GetLastUpdateTime() *time.Time {
+	if m != nil {
+		return m.LastUpdateTime
+	}
+	return nil
+}
+
+type DynamicConfigValue struct {
+	// JSON encoded value.
+	Value       string                    `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Constraints *DynamicConfigConstraints `protobuf:"bytes,2,opt,name=constraints,proto3" json:"constraints,omitempty"`
+}
+
+func (m *DynamicConfigValue) Reset()      { *m = DynamicConfigValue{} }
+func (*DynamicConfigValue) ProtoMessage() {}
+func (*DynamicConfigValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f4771d63f405884, []int{5}
+}
+func (m *DynamicConfigValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicConfigValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicConfigValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DynamicConfigValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicConfigValue.Merge(m, src)
+}
+func (m *DynamicConfigValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicConfigValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicConfigValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicConfigValue proto.InternalMessageInfo
+
+func (m *DynamicConfigValue) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *DynamicConfigValue) GetConstraints() *DynamicConfigConstraints {
+	if m != nil {
+		return m.Constraints
+	}
+	return nil
+}
+
+// Empty fields are not used as constraints.
+type DynamicConfigConstraints struct {
+	Namespace     string            `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	NamespaceId   string            `protobuf:"bytes,2,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	TaskQueueName string            `protobuf:"bytes,3,opt,name=task_queue_name,json=taskQueueName,proto3" json:"task_queue_name,omitempty"`
+	TaskType      v11.TaskQueueType `protobuf:"varint,4,opt,name=task_type,json=taskType,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_type,omitempty"`
+	ShardId       int32             `protobuf:"varint,5,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+}
+
+func (m *DynamicConfigConstraints) Reset()      { *m = DynamicConfigConstraints{} }
+func (*DynamicConfigConstraints) ProtoMessage() {}
+func (*DynamicConfigConstraints) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f4771d63f405884, []int{6}
+}
+func (m *DynamicConfigConstraints) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicConfigConstraints) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicConfigConstraints.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DynamicConfigConstraints) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicConfigConstraints.Merge(m, src)
+}
+func (m *DynamicConfigConstraints) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicConfigConstraints) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicConfigConstraints.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicConfigConstraints proto.InternalMessageInfo
+
+func (m *DynamicConfigConstraints) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *DynamicConfigConstraints) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *DynamicConfigConstraints) GetTaskQueueName() string {
+	if m != nil {
+		return m.TaskQueueName
+	}
+	return ""
+}
+
+func (m *DynamicConfigConstraints) GetTaskType() v11.TaskQueueType {
+	if m != nil {
+		return m.TaskType
+	}
+	return v11.TASK_QUEUE_TYPE_UNSPECIFIED
+}
+
+func (m *DynamicConfigConstraints) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ClusterMetadata)(nil), "temporal.server.api.persistence.v1.ClusterMetadata")
+	proto.RegisterMapType((map[string]*ClusterInformation)(nil), "temporal.server.api.persistence.v1.ClusterMetadata.ClusterInformationEntry")
+	proto.RegisterMapType((map[string]*DynamicConfigValues)(nil), "temporal.server.api.persistence.v1.ClusterMetadata.DynamicConfigEntry")
 	proto.RegisterMapType((map[string]*IndexSearchAttributes)(nil), "temporal.server.api.persistence.v1.ClusterMetadata.IndexSearchAttributesEntry")
+	proto.RegisterType((*ClusterInformation)(nil), "temporal.server.api.persistence.v1.ClusterInformation")
+	proto.RegisterType((*MaintenanceInfo)(nil), "temporal.server.api.persistence.v1.MaintenanceInfo")
 	proto.RegisterType((*IndexSearchAttributes)(nil), "temporal.server.api.persistence.v1.IndexSearchAttributes")
 	proto.RegisterMapType((map[string]v11.IndexedValueType)(nil), "temporal.server.api.persistence.v1.IndexSearchAttributes.CustomSearchAttributesEntry")
+	proto.RegisterType((*DynamicConfigValues)(nil), "temporal.server.api.persistence.v1.DynamicConfigValues")
+	proto.RegisterType((*DynamicConfigValue)(nil), "temporal.server.api.persistence.v1.DynamicConfigValue")
+	proto.RegisterType((*DynamicConfigConstraints)(nil), "temporal.server.api.persistence.v1.DynamicConfigConstraints")
 }
 
 func init() {
@@ -183,39 +536,72 @@ func init() {
 }
 
 var fileDescriptor_1f4771d63f405884 = []byte{
-	// 511 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x4f, 0x8b, 0xd3, 0x40,
-	0x18, 0xc6, 0x33, 0xad, 0x15, 0x76, 0xba, 0xf8, 0x27, 0xb2, 0x1a, 0x2a, 0x0e, 0xb5, 0x28, 0xf6,
-	0x34, 0xa5, 0xd5, 0x83, 0xab, 0x78, 0xd0, 0x22, 0xd2, 0x83, 0x2b, 0x64, 0x75, 0x0f, 0x5e, 0xc2,
-	0x6c, 0xf2, 0xee, 0x76, 0xb4, 0x99, 0x09, 0x33, 0x93, 0x60, 0x6f, 0x82, 0xe0, 0x55, 0xbf, 0x80,
-	0x77, 0x3f, 0x8a, 0xc7, 0x1e, 0x17, 0xbc, 0xd8, 0xf4, 0xe2, 0x71, 0x3f, 0x82, 0xe4, 0x4f, 0xbb,
-	0x5d, 0xc9, 0xaa, 0x78, 0xcb, 0xcc, 0xfb, 0x3c, 0xcf, 0x3c, 0xfc, 0xc8, 0x8b, 0xb7, 0x0d, 0x84,
-	0x91, 0x54, 0x6c, 0xd2, 0xd3, 0xa0, 0x12, 0x50, 0x3d, 0x16, 0xf1, 0x5e, 0x04, 0x4a, 0x73, 0x6d,
-	0x40, 0xf8, 0xd0, 0x4b, 0xfa, 0x3d, 0x7f, 0x12, 0x6b, 0x03, 0xca, 0x0b, 0xc1, 0xb0, 0x80, 0x19,
-	0x46, 0x23, 0x25, 0x8d, 0xb4, 0x3b, 0x4b, 0x2b, 0x2d, 0xac, 0x94, 0x45, 0x9c, 0xae, 0x59, 0x69,
-	0xd2, 0x6f, 0xad, 0x34, 0x79, 0x2e, 0x88, 0x38, 0xd4, 0x79, 0xa2, 0x0c, 0x43, 0x29, 0x8a, 0x9c,
-	0xd6, 0xed, 0x53, 0x9a, 0x24, 0x0b, 0x90, 0x22, 0x53, 0x85, 0xa0, 0x35, 0x3b, 0x84, 0x42, 0xd6,
-	0xf9, 0x5e, 0xc7, 0x17, 0x87, 0x45, 0x93, 0xe7, 0x65, 0x11, 0xfb, 0x26, 0xde, 0x5c, 0x96, 0x13,
-	0x2c, 0x04, 0x07, 0xb5, 0x51, 0x77, 0xc3, 0x6d, 0x96, 0x77, 0x3b, 0x2c, 0x04, 0x9b, 0xe2, 0x2b,
-	0x63, 0xae, 0x8d, 0x54, 0x53, 0x4f, 0x8f, 0x99, 0x0a, 0x3c, 0x5f, 0xc6, 0xc2, 0x38, 0xb5, 0x36,
-	0xea, 0x36, 0xdc, 0xcb, 0xe5, 0x68, 0x37, 0x9b, 0x0c, 0xb3, 0x81, 0x7d, 0x03, 0xe3, 0x65, 0x24,
-	0x0f, 0x9c, 0x7a, 0x1e, 0xb8, 0x51, 0xde, 0x8c, 0x02, 0xfb, 0x19, 0xde, 0x2c, 0x1b, 0x7a, 0x5c,
-	0x1c, 0x48, 0xe7, 0x5c, 0x1b, 0x75, 0x9b, 0x83, 0x5b, 0x74, 0xc5, 0x22, 0x83, 0x50, 0x2a, 0x68,
-	0xd2, 0xa7, 0x7b, 0xc5, 0xe7, 0x48, 0x1c, 0x48, 0xb7, 0x99, 0x9c, 0x1c, 0xec, 0x8f, 0x08, 0x5f,
-	0xe3, 0x22, 0x80, 0x77, 0x9e, 0x06, 0xa6, 0xfc, 0xb1, 0xc7, 0x8c, 0x51, 0x7c, 0x3f, 0x36, 0xa0,
-	0x9d, 0x46, 0xbb, 0xde, 0x6d, 0x0e, 0x76, 0xe8, 0xdf, 0x01, 0xd3, 0xdf, 0x88, 0xd0, 0x51, 0x16,
-	0xb9, 0x9b, 0x27, 0x3e, 0x5e, 0x05, 0x3e, 0x15, 0x46, 0x4d, 0xdd, 0x2d, 0x5e, 0x35, 0x6b, 0x7d,
-	0x40, 0xb8, 0x75, 0xb6, 0xcb, 0xbe, 0x84, 0xeb, 0x6f, 0x61, 0x5a, 0x92, 0xcd, 0x3e, 0xed, 0x17,
-	0xb8, 0x91, 0xb0, 0x49, 0x0c, 0x39, 0xc3, 0xe6, 0x60, 0xfb, 0x5f, 0x6a, 0x56, 0x3e, 0xe0, 0x16,
-	0x39, 0x0f, 0x6a, 0xf7, 0x51, 0xe7, 0x4b, 0x0d, 0x6f, 0x55, 0x8a, 0xec, 0x4f, 0x08, 0x3b, 0x7e,
-	0xac, 0x8d, 0x0c, 0x2b, 0x48, 0xa1, 0x9c, 0xd4, 0xab, 0xff, 0xae, 0x40, 0x87, 0x79, 0x72, 0x35,
-	0xb0, 0xab, 0x7e, 0xe5, 0xb0, 0xa5, 0xf0, 0xf5, 0x3f, 0xd8, 0x2a, 0x88, 0x3d, 0x5a, 0x27, 0x76,
-	0x61, 0x70, 0xe7, 0xf4, 0xdf, 0x92, 0x6f, 0xc5, 0xaa, 0x21, 0x04, 0x7b, 0x99, 0xf4, 0xe5, 0x34,
-	0x82, 0x35, 0x3e, 0x4f, 0xde, 0xcc, 0xe6, 0xc4, 0x3a, 0x9a, 0x13, 0xeb, 0x78, 0x4e, 0xd0, 0xfb,
-	0x94, 0xa0, 0xaf, 0x29, 0x41, 0xdf, 0x52, 0x82, 0x66, 0x29, 0x41, 0x3f, 0x52, 0x82, 0x7e, 0xa6,
-	0xc4, 0x3a, 0x4e, 0x09, 0xfa, 0xbc, 0x20, 0xd6, 0x6c, 0x41, 0xac, 0xa3, 0x05, 0xb1, 0x5e, 0xdf,
-	0x3b, 0x94, 0x27, 0x6f, 0x71, 0x79, 0xf6, 0x8e, 0x3f, 0x5c, 0x3b, 0xee, 0x9f, 0xcf, 0x17, 0xee,
-	0xee, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5b, 0x1c, 0x47, 0x38, 0x1c, 0x04, 0x00, 0x00,
+	// 1035 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0x24, 0x4d, 0xe2, 0x8c, 0xdb, 0x24, 0x4c, 0x68, 0xbb, 0x18, 0xd8, 0xa4, 0x56, 0x29,
+	0x39, 0xad, 0x15, 0x17, 0x95, 0x96, 0x96, 0x43, 0x1a, 0x28, 0x32, 0x90, 0x20, 0x36, 0x69, 0x0f,
+	0x1c, 0xba, 0x9a, 0xec, 0x3e, 0x3b, 0x43, 0xbd, 0x33, 0xcb, 0xce, 0xac, 0x85, 0x25, 0x90, 0x90,
+	0x90, 0x90, 0xe0, 0x42, 0x3f, 0x06, 0x57, 0x24, 0x3e, 0x04, 0xc7, 0x1c, 0x7b, 0xa3, 0x71, 0x2e,
+	0x70, 0xeb, 0x47, 0x40, 0x3b, 0x3b, 0xeb, 0x3f, 0xc9, 0x1a, 0x52, 0xab, 0xb7, 0x9d, 0xf7, 0xe7,
+	0xf7, 0x7b, 0xf3, 0x9b, 0x37, 0xb3, 0x0f, 0xdf, 0x51, 0x10, 0x46, 0x22, 0xa6, 0x9d, 0xba, 0x84,
+	0xb8, 0x0b, 0x71, 0x9d, 0x46, 0xac, 0x1e, 0x41, 0x2c, 0x99, 0x54, 0xc0, 0x7d, 0xa8, 0x77, 0x37,
+	0xeb, 0x7e, 0x27, 0x91, 0x0a, 0x62, 0x2f, 0x04, 0x45, 0x03, 0xaa, 0xa8, 0x13, 0xc5, 0x42, 0x09,
+	0x52, 0xcb, 0x53, 0x9d, 0x2c, 0xd5, 0xa1, 0x11, 0x73, 0x46, 0x52, 0x9d, 0xee, 0x66, 0x75, 0xad,
+	0x2d, 0x44, 0xbb, 0x03, 0x75, 0x9d, 0x71, 0x90, 0xb4, 0xea, 0x8a, 0x85, 0x20, 0x15, 0x0d, 0xa3,
+	0x0c, 0xa4, 0x7a, 0x2d, 0x80, 0x08, 0x78, 0x00, 0xdc, 0x67, 0x20, 0xeb, 0x6d, 0xd1, 0x16, 0xda,
+	0xae, 0xbf, 0x4c, 0xc8, 0x80, 0x47, 0xd7, 0x06, 0x3c, 0x09, 0xa5, 0xae, 0x4a, 0x84, 0xa1, 0xe0,
+	0x26, 0xe6, 0x46, 0x71, 0x8c, 0xa2, 0xf2, 0x89, 0xf7, 0x4d, 0x02, 0x09, 0x98, 0xb8, 0x77, 0xc6,
+	0xe2, 0xba, 0x69, 0xb1, 0x82, 0xa7, 0x91, 0x21, 0x48, 0x49, 0xdb, 0x26, 0xac, 0xf6, 0xbc, 0x8c,
+	0x97, 0xb7, 0xb3, 0x5d, 0xef, 0x98, 0x4d, 0x93, 0x6b, 0xf8, 0x62, 0x2e, 0x04, 0xa7, 0x21, 0x58,
+	0x68, 0x1d, 0x6d, 0x2c, 0xba, 0x15, 0x63, 0xdb, 0xa5, 0x21, 0x10, 0x07, 0xaf, 0x1e, 0x32, 0xa9,
+	0x44, 0xdc, 0xf3, 0xe4, 0x21, 0x8d, 0x03, 0xcf, 0x17, 0x09, 0x57, 0xd6, 0xcc, 0x3a, 0xda, 0x98,
+	0x73, 0x5f, 0x33, 0xae, 0xbd, 0xd4, 0xb3, 0x9d, 0x3a, 0xc8, 0xdb, 0x18, 0xe7, 0x90, 0x2c, 0xb0,
+	0x66, 0x35, 0xe0, 0xa2, 0xb1, 0x34, 0x03, 0xf2, 0x09, 0xbe, 0x68, 0x2a, 0xf4, 0x18, 0x6f, 0x09,
+	0xeb, 0xc2, 0x3a, 0xda, 0xa8, 0x34, 0xae, 0x3b, 0x03, 0xdd, 0x53, 0xc1, 0x4d, 0x84, 0xd3, 0xdd,
+	0x74, 0x1e, 0x65, 0x9f, 0x4d, 0xde, 0x12, 0x6e, 0xa5, 0x3b, 0x5c, 0x90, 0x9f, 0x10, 0xbe, 0xca,
+	0x78, 0x00, 0xdf, 0x7a, 0x12, 0x68, 0xec, 0x1f, 0x7a, 0x54, 0xa9, 0x98, 0x1d, 0x24, 0x0a, 0xa4,
+	0x35, 0xb7, 0x3e, 0xbb, 0x51, 0x69, 0xec, 0x3a, 0xff, 0x7f, 0x98, 0xce, 0x29, 0x45, 0x9c, 0x66,
+	0x0a, 0xb9, 0xa7, 0x11, 0xb7, 0x06, 0x80, 0x1f, 0x73, 0x15, 0xf7, 0xdc, 0xcb, 0xac, 0xc8, 0x47,
+	0x42, 0xbc, 0x14, 0xf4, 0x38, 0x0d, 0x99, 0xef, 0xf9, 0x82, 0xb7, 0x58, 0xdb, 0x9a, 0xd7, 0xf4,
+	0x0f, 0xa6, 0xa1, 0xff, 0x28, 0x43, 0xda, 0xd6, 0x40, 0x19, 0xed, 0xa5, 0x60, 0xd4, 0x46, 0x1e,
+	0xe3, 0x95, 0x90, 0x32, 0xae, 0x80, 0x53, 0xee, 0x43, 0x26, 0xe2, 0x82, 0x16, 0xf1, 0xe6, 0x79,
+	0x08, 0x77, 0x86, 0xb9, 0x5a, 0xd3, 0xe5, 0x70, 0xdc, 0x40, 0xee, 0xe1, 0x6a, 0x8b, 0xb2, 0x8e,
+	0xe8, 0x42, 0xec, 0x0d, 0x4f, 0xca, 0x8f, 0x21, 0x04, 0xae, 0xac, 0xf2, 0x3a, 0xda, 0x98, 0x75,
+	0xad, 0x3c, 0x62, 0x70, 0x3a, 0xc6, 0x4f, 0xbe, 0xc3, 0xab, 0x83, 0xd3, 0xe7, 0x2d, 0x11, 0x87,
+	0x54, 0x31, 0xc1, 0xad, 0x45, 0xad, 0xc8, 0x67, 0xd3, 0x28, 0x62, 0xd6, 0xcd, 0x21, 0x5a, 0x26,
+	0x0b, 0xf1, 0xcf, 0x38, 0xaa, 0x3f, 0x22, 0x5c, 0x9d, 0x7c, 0x80, 0x64, 0x05, 0xcf, 0x3e, 0x81,
+	0x9e, 0x69, 0xf2, 0xf4, 0x93, 0x7c, 0x81, 0xe7, 0xba, 0xb4, 0x93, 0x80, 0x6e, 0xe7, 0x4a, 0xe3,
+	0xce, 0x79, 0x0a, 0x2c, 0x24, 0x70, 0x33, 0x9c, 0x0f, 0x66, 0x6e, 0xa3, 0x6a, 0x0f, 0x93, 0xb3,
+	0xc7, 0x58, 0x40, 0xbe, 0x33, 0x4e, 0xfe, 0xfe, 0x79, 0xc8, 0xc7, 0x80, 0x1f, 0xa5, 0xd9, 0x63,
+	0xd4, 0xdf, 0xe3, 0xab, 0x13, 0xf4, 0x2a, 0xe0, 0xff, 0x7c, 0x9c, 0xff, 0xd6, 0x4b, 0x9c, 0xce,
+	0x08, 0xfa, 0x08, 0x7d, 0xed, 0x67, 0x84, 0xc9, 0xd9, 0x08, 0x62, 0xe1, 0x05, 0xe0, 0xf4, 0xa0,
+	0x03, 0x81, 0xa6, 0x2f, 0xbb, 0xf9, 0x92, 0xdc, 0xc6, 0x16, 0xe3, 0x4c, 0x31, 0xda, 0xf1, 0x4e,
+	0x37, 0x9d, 0xae, 0x6a, 0xd6, 0xbd, 0x62, 0xfc, 0x0f, 0xc6, 0x3b, 0x8e, 0xac, 0xe1, 0x4a, 0x1c,
+	0xf9, 0x1e, 0x0d, 0x82, 0x18, 0xa4, 0x34, 0xef, 0x0c, 0x8e, 0x23, 0x7f, 0x2b, 0xb3, 0xd4, 0xfe,
+	0x40, 0x78, 0xf9, 0x54, 0xb3, 0xa7, 0x85, 0x98, 0x37, 0xd1, 0xe8, 0x90, 0x2f, 0xc9, 0x5d, 0x5c,
+	0x96, 0xd0, 0x85, 0x98, 0xa9, 0x9e, 0x26, 0x5e, 0x6a, 0xac, 0x8d, 0x3f, 0x49, 0xfa, 0xf9, 0x4d,
+	0x15, 0xd8, 0x33, 0x61, 0xee, 0x20, 0x81, 0x7c, 0x8a, 0x57, 0x3a, 0x54, 0x2a, 0x2f, 0x89, 0x02,
+	0xaa, 0xc0, 0x4b, 0x7f, 0x07, 0xba, 0xa0, 0x4a, 0xa3, 0xea, 0x64, 0xff, 0x0a, 0x27, 0xff, 0x57,
+	0x38, 0xfb, 0xf9, 0xbf, 0xe2, 0xfe, 0x85, 0xa7, 0x7f, 0xad, 0x21, 0x77, 0x29, 0xcd, 0x7c, 0xa8,
+	0x13, 0x53, 0x57, 0xed, 0x9f, 0x19, 0x7c, 0xb9, 0xb0, 0xc3, 0xc8, 0xaf, 0x08, 0x5b, 0x7e, 0x22,
+	0x95, 0x08, 0x0b, 0x5e, 0x3c, 0xa4, 0x2f, 0xd8, 0xc3, 0xa9, 0xfb, 0xd7, 0xd9, 0xd6, 0xc8, 0xc5,
+	0x0f, 0xdf, 0x15, 0xbf, 0xd0, 0x59, 0xb8, 0xef, 0x99, 0xe9, 0xf6, 0x5d, 0x8d, 0xf1, 0x9b, 0xff,
+	0x51, 0x42, 0x41, 0xf7, 0x7e, 0x38, 0xda, 0xbd, 0x4b, 0x8d, 0x77, 0x27, 0x1c, 0x97, 0xde, 0x2d,
+	0x04, 0xfa, 0xaa, 0xec, 0xf7, 0x22, 0x18, 0x6d, 0xd7, 0xdf, 0x11, 0x5e, 0x2d, 0xb8, 0x50, 0x64,
+	0x17, 0xcf, 0xeb, 0xa0, 0x5c, 0xd6, 0x5b, 0xd3, 0xdd, 0x4c, 0xd7, 0xa0, 0xbc, 0x4a, 0x9d, 0x6a,
+	0xbf, 0x20, 0x4c, 0xce, 0x52, 0x91, 0xd7, 0x73, 0x35, 0x32, 0x85, 0xb2, 0x05, 0x79, 0x8c, 0x2b,
+	0xbe, 0xe0, 0x52, 0xc5, 0xe9, 0x3d, 0x90, 0x86, 0xf3, 0xde, 0x4b, 0xef, 0x66, 0x7b, 0x88, 0xe1,
+	0x8e, 0x02, 0xd6, 0x8e, 0x11, 0xb6, 0x26, 0x45, 0x92, 0xb7, 0xf0, 0x22, 0xa7, 0x21, 0xc8, 0x88,
+	0xfa, 0x79, 0x59, 0x43, 0x43, 0x3a, 0x79, 0x0c, 0x16, 0xe9, 0xa0, 0x30, 0x93, 0x4d, 0x1e, 0x03,
+	0x5b, 0x33, 0x20, 0x37, 0xf0, 0xf2, 0x70, 0xd6, 0xc9, 0xe6, 0x93, 0xec, 0x9a, 0x5f, 0x4a, 0xcd,
+	0x5f, 0xa6, 0x56, 0x3d, 0xa1, 0x6c, 0xe1, 0x45, 0x1d, 0xa7, 0x7a, 0x11, 0xe8, 0x79, 0x62, 0xa9,
+	0x71, 0x7d, 0x42, 0x37, 0xec, 0xe7, 0x89, 0xba, 0x15, 0xca, 0x69, 0x5a, 0xfa, 0x45, 0xde, 0xc0,
+	0xe5, 0x6c, 0xb8, 0x61, 0x81, 0x35, 0xa7, 0x27, 0x9b, 0x05, 0xbd, 0x6e, 0x06, 0xf7, 0xbf, 0x3e,
+	0x3a, 0xb6, 0x4b, 0xcf, 0x8e, 0xed, 0xd2, 0x8b, 0x63, 0x1b, 0xfd, 0xd0, 0xb7, 0xd1, 0x6f, 0x7d,
+	0x1b, 0xfd, 0xd9, 0xb7, 0xd1, 0x51, 0xdf, 0x46, 0xcf, 0xfb, 0x36, 0xfa, 0xbb, 0x6f, 0x97, 0x5e,
+	0xf4, 0x6d, 0xf4, 0xf4, 0xc4, 0x2e, 0x1d, 0x9d, 0xd8, 0xa5, 0x67, 0x27, 0x76, 0xe9, 0xab, 0xf7,
+	0xda, 0x62, 0x58, 0x02, 0x13, 0x93, 0x07, 0xd1, 0xbb, 0x23, 0xcb, 0x83, 0x79, 0xdd, 0x06, 0x37,
+	0xff, 0x1d, 0x00, 0xc0, 0x37, 0xb1, 0x37, 0xc1, 0x0a, 0x00, 0x00,
 }
 
 func (this *ClusterMetadata) Equal(that interface{}) bool {
@@ -257,16 +643,38 @@ func (this *ClusterMetadata) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.DynamicConfig) != len(that1.DynamicConfig) {
+		return false
+	}
+	for i := range this.DynamicConfig {
+		if !this.DynamicConfig[i].Equal(that1.DynamicConfig[i]) {
+			return false
+		}
+	}
+	if !this.MaintenanceInfo.Equal(that1.MaintenanceInfo) {
+		return false
+	}
+	if this.FailoverVersionIncrement != that1.FailoverVersionIncrement {
+		return false
+	}
+	if len(this.ClusterInformation) != len(that1.ClusterInformation) {
+		return false
+	}
+	for i := range this.ClusterInformation {
+		if !this.ClusterInformation[i].Equal(that1.ClusterInformation[i]) {
+			return false
+		}
+	}
 	return true
 }
-func (this *IndexSearchAttributes) Equal(that interface{}) bool {
+func (this *ClusterInformation) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*IndexSearchAttributes)
+	that1, ok := that.(*ClusterInformation)
 	if !ok {
-		that2, ok := that.(IndexSearchAttributes)
+		that2, ok := that.(ClusterInformation)
 		if ok {
 			that1 = &that2
 		} else {
@@ -278,33 +686,203 @@ func (this *IndexSearchAttributes) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.CustomSearchAttributes) != len(that1.CustomSearchAttributes) {
+	if this.Enabled != that1.Enabled {
 		return false
 	}
-	for i := range this.CustomSearchAttributes {
-		if this.CustomSearchAttributes[i] != that1.CustomSearchAttributes[i] {
-			return false
-		}
+	if this.InitialFailoverVersion != that1.InitialFailoverVersion {
+		return false
+	}
+	if this.RpcAddress != that1.RpcAddress {
+		return false
 	}
 	return true
 }
-func (this *ClusterMetadata) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *MaintenanceInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 9)
-	s = append(s, "&persistence.ClusterMetadata{")
-	s = append(s, "ClusterName: "+fmt.Sprintf("%#v", this.ClusterName)+",\n")
-	s = append(s, "HistoryShardCount: "+fmt.Sprintf("%#v", this.HistoryShardCount)+",\n")
-	s = append(s, "ClusterId: "+fmt.Sprintf("%#v", this.ClusterId)+",\n")
-	if this.VersionInfo != nil {
-		s = append(s, "VersionInfo: "+fmt.Sprintf("%#v", this.VersionInfo)+",\n")
+
+	that1, ok := that.(*MaintenanceInfo)
+	if !ok {
+		that2, ok := that.(MaintenanceInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	keysForIndexSearchAttributes := make([]string, 0, len(this.IndexSearchAttributes))
-	for k, _ := range this.IndexSearchAttributes {
-		keysForIndexSearchAttributes = append(keysForIndexSearchAttributes, k)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForIndexSearchAttributes)
+	if this.Message != that1.Message {
+		return false
+	}
+	if this.Severity != that1.Severity {
+		return false
+	}
+	if that1.LastUpdateTime == nil {
+		if this.LastUpdateTime != nil {
+			return false
+		}
+	} else if !this.LastUpdateTime.Equal(*that1.LastUpdateTime) {
+		return false
+	}
+	return true
+}
+func (this *IndexSearchAttributes) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*IndexSearchAttributes)
+	if !ok {
+		that2, ok := that.(IndexSearchAttributes)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.CustomSearchAttributes) != len(that1.CustomSearchAttributes) {
+		return false
+	}
+	for i := range this.CustomSearchAttributes {
+		if this.CustomSearchAttributes[i] != that1.CustomSearchAttributes[i] {
+			return false
+		}
+	}
+	if that1.LastUpdateTime == nil {
+		if this.LastUpdateTime != nil {
+			return false
+		}
+	} else if !this.LastUpdateTime.Equal(*that1.LastUpdateTime) {
+		return false
+	}
+	return true
+}
+func (this *DynamicConfigValues) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DynamicConfigValues)
+	if !ok {
+		that2, ok := that.(DynamicConfigValues)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Values) != len(that1.Values) {
+		return false
+	}
+	for i := range this.Values {
+		if !this.Values[i].Equal(that1.Values[i]) {
+			return false
+		}
+	}
+	if that1.LastUpdateTime == nil {
+		if this.LastUpdateTime != nil {
+			return false
+		}
+	} else if !this.LastUpdateTime.Equal(*that1.LastUpdateTime) {
+		return false
+	}
+	return true
+}
+func (this *DynamicConfigValue) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DynamicConfigValue)
+	if !ok {
+		that2, ok := that.(DynamicConfigValue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	if !this.Constraints.Equal(that1.Constraints) {
+		return false
+	}
+	return true
+}
+func (this *DynamicConfigConstraints) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DynamicConfigConstraints)
+	if !ok {
+		that2, ok := that.(DynamicConfigConstraints)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Namespace != that1.Namespace {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if this.TaskQueueName != that1.TaskQueueName {
+		return false
+	}
+	if this.TaskType != that1.TaskType {
+		return false
+	}
+	if this.ShardId != that1.ShardId {
+		return false
+	}
+	return true
+}
+func (this *ClusterMetadata) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 13)
+	s = append(s, "&persistence.ClusterMetadata{")
+	s = append(s, "ClusterName: "+fmt.Sprintf("%#v", this.ClusterName)+",\n")
+	s = append(s, "HistoryShardCount: "+fmt.Sprintf("%#v", this.HistoryShardCount)+",\n")
+	s = append(s, "ClusterId: "+fmt.Sprintf("%#v", this.ClusterId)+",\n")
+	if this.VersionInfo != nil {
+		s = append(s, "VersionInfo: "+fmt.Sprintf("%#v", this.VersionInfo)+",\n")
+	}
+	keysForIndexSearchAttributes := make([]string, 0, len(this.IndexSearchAttributes))
+	for k, _ := range this.IndexSearchAttributes {
+		keysForIndexSearchAttributes = append(keysForIndexSearchAttributes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForIndexSearchAttributes)
 	mapStringForIndexSearchAttributes := "map[string]*IndexSearchAttributes{"
 	for _, k := range keysForIndexSearchAttributes {
 		mapStringForIndexSearchAttributes += fmt.Sprintf("%#v: %#v,", k, this.IndexSearchAttributes[k])
@@ -313,6 +891,60 @@ func (this *ClusterMetadata) GoString() string {
 	if this.IndexSearchAttributes != nil {
 		s = append(s, "IndexSearchAttributes: "+mapStringForIndexSearchAttributes+",\n")
 	}
+	keysForDynamicConfig := make([]string, 0, len(this.DynamicConfig))
+	for k, _ := range this.DynamicConfig {
+		keysForDynamicConfig = append(keysForDynamicConfig, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForDynamicConfig)
+	mapStringForDynamicConfig := "map[string]*DynamicConfigValues{"
+	for _, k := range keysForDynamicConfig {
+		mapStringForDynamicConfig += fmt.Sprintf("%#v: %#v,", k, this.DynamicConfig[k])
+	}
+	mapStringForDynamicConfig += "}"
+	if this.DynamicConfig != nil {
+		s = append(s, "DynamicConfig: "+mapStringForDynamicConfig+",\n")
+	}
+	if this.MaintenanceInfo != nil {
+		s = append(s, "MaintenanceInfo: "+fmt.Sprintf("%#v", this.MaintenanceInfo)+",\n")
+	}
+	s = append(s, "FailoverVersionIncrement: "+fmt.Sprintf("%#v", this.FailoverVersionIncrement)+",\n")
+	keysForClusterInformation := make([]string, 0, len(this.ClusterInformation))
+	for k, _ := range this.ClusterInformation {
+		keysForClusterInformation = append(keysForClusterInformation, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForClusterInformation)
+	mapStringForClusterInformation := "map[string]*ClusterInformation{"
+	for _, k := range keysForClusterInformation {
+		mapStringForClusterInformation += fmt.Sprintf("%#v: %#v,", k, this.ClusterInformation[k])
+	}
+	mapStringForClusterInformation += "}"
+	if this.ClusterInformation != nil {
+		s = append(s, "ClusterInformation: "+mapStringForClusterInformation+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ClusterInformation) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&persistence.ClusterInformation{")
+	s = append(s, "Enabled: "+fmt.Sprintf("%#v", this.Enabled)+",\n")
+	s = append(s, "InitialFailoverVersion: "+fmt.Sprintf("%#v", this.InitialFailoverVersion)+",\n")
+	s = append(s, "RpcAddress: "+fmt.Sprintf("%#v", this.RpcAddress)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MaintenanceInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&persistence.MaintenanceInfo{")
+	s = append(s, "Message: "+fmt.Sprintf("%#v", this.Message)+",\n")
+	s = append(s, "Severity: "+fmt.Sprintf("%#v", this.Severity)+",\n")
+	s = append(s, "LastUpdateTime: "+fmt.Sprintf("%#v", this.LastUpdateTime)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -320,7 +952,7 @@ func (this *IndexSearchAttributes) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 5)
+	s := make([]string, 0, 6)
 	s = append(s, "&persistence.IndexSearchAttributes{")
 	keysForCustomSearchAttributes := make([]string, 0, len(this.CustomSearchAttributes))
 	for k, _ := range this.CustomSearchAttributes {
@@ -335,6 +967,47 @@ func (this *IndexSearchAttributes) GoString() string {
 	if this.CustomSearchAttributes != nil {
 		s = append(s, "CustomSearchAttributes: "+mapStringForCustomSearchAttributes+",\n")
 	}
+	s = append(s, "LastUpdateTime: "+fmt.Sprintf("%#v", this.LastUpdateTime)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DynamicConfigValues) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&persistence.DynamicConfigValues{")
+	if this.Values != nil {
+		s = append(s, "Values: "+fmt.Sprintf("%#v", this.Values)+",\n")
+	}
+	s = append(s, "LastUpdateTime: "+fmt.Sprintf("%#v", this.LastUpdateTime)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DynamicConfigValue) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&persistence.DynamicConfigValue{")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	if this.Constraints != nil {
+		s = append(s, "Constraints: "+fmt.Sprintf("%#v", this.Constraints)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DynamicConfigConstraints) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&persistence.DynamicConfigConstraints{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "TaskQueueName: "+fmt.Sprintf("%#v", this.TaskQueueName)+",\n")
+	s = append(s, "TaskType: "+fmt.Sprintf("%#v", this.TaskType)+",\n")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -366,6 +1039,75 @@ func (m *ClusterMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ClusterInformation) > 0 {
+		for k := range m.ClusterInformation {
+			v := m.ClusterInformation[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintClusterMetadata(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintClusterMetadata(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintClusterMetadata(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.FailoverVersionIncrement != 0 {
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(m.FailoverVersionIncrement))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaintenanceInfo != nil {
+		{
+			size, err := m.MaintenanceInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintClusterMetadata(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.DynamicConfig) > 0 {
+		for k := range m.DynamicConfig {
+			v := m.DynamicConfig[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintClusterMetadata(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintClusterMetadata(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintClusterMetadata(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.IndexSearchAttributes) > 0 {
 		for k := range m.IndexSearchAttributes {
 			v := m.IndexSearchAttributes[k]
@@ -426,7 +1168,7 @@ func (m *ClusterMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *IndexSearchAttributes) Marshal() (dAtA []byte, err error) {
+func (m *ClusterInformation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -436,37 +1178,280 @@ func (m *IndexSearchAttributes) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *IndexSearchAttributes) MarshalTo(dAtA []byte) (int, error) {
+func (m *ClusterInformation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *IndexSearchAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ClusterInformation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.CustomSearchAttributes) > 0 {
-		for k := range m.CustomSearchAttributes {
-			v := m.CustomSearchAttributes[k]
-			baseI := i
-			i = encodeVarintClusterMetadata(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintClusterMetadata(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintClusterMetadata(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.RpcAddress) > 0 {
+		i -= len(m.RpcAddress)
+		copy(dAtA[i:], m.RpcAddress)
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(len(m.RpcAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintClusterMetadata(dAtA []byte, offset int, v uint64) int {
+	if m.InitialFailoverVersion != 0 {
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(m.InitialFailoverVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MaintenanceInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaintenanceInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaintenanceInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastUpdateTime != nil {
+		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpdateTime):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Severity != 0 {
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(m.Severity))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexSearchAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexSearchAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexSearchAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastUpdateTime != nil {
+		n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpdateTime):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CustomSearchAttributes) > 0 {
+		for k := range m.CustomSearchAttributes {
+			v := m.CustomSearchAttributes[k]
+			baseI := i
+			i = encodeVarintClusterMetadata(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintClusterMetadata(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintClusterMetadata(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DynamicConfigValues) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicConfigValues) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicConfigValues) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastUpdateTime != nil {
+		n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpdateTime):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Values[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClusterMetadata(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DynamicConfigValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicConfigValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicConfigValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Constraints != nil {
+		{
+			size, err := m.Constraints.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintClusterMetadata(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DynamicConfigConstraints) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicConfigConstraints) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicConfigConstraints) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TaskType != 0 {
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(m.TaskType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TaskQueueName) > 0 {
+		i -= len(m.TaskQueueName)
+		copy(dAtA[i:], m.TaskQueueName)
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(len(m.TaskQueueName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintClusterMetadata(dAtA []byte, offset int, v uint64) int {
 	offset -= sovClusterMetadata(v)
 	base := offset
 	for v >= 1<<7 {
@@ -507,89 +1492,932 @@ func (m *ClusterMetadata) Size() (n int) {
 				l = v.Size()
 				l += 1 + sovClusterMetadata(uint64(l))
 			}
-			mapEntrySize := 1 + len(k) + sovClusterMetadata(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovClusterMetadata(uint64(mapEntrySize))
-		}
-	}
-	return n
-}
-
-func (m *IndexSearchAttributes) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.CustomSearchAttributes) > 0 {
-		for k, v := range m.CustomSearchAttributes {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovClusterMetadata(uint64(len(k))) + 1 + sovClusterMetadata(uint64(v))
-			n += mapEntrySize + 1 + sovClusterMetadata(uint64(mapEntrySize))
+			mapEntrySize := 1 + len(k) + sovClusterMetadata(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovClusterMetadata(uint64(mapEntrySize))
+		}
+	}
+	if len(m.DynamicConfig) > 0 {
+		for k, v := range m.DynamicConfig {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovClusterMetadata(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovClusterMetadata(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovClusterMetadata(uint64(mapEntrySize))
+		}
+	}
+	if m.MaintenanceInfo != nil {
+		l = m.MaintenanceInfo.Size()
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	if m.FailoverVersionIncrement != 0 {
+		n += 1 + sovClusterMetadata(uint64(m.FailoverVersionIncrement))
+	}
+	if len(m.ClusterInformation) > 0 {
+		for k, v := range m.ClusterInformation {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovClusterMetadata(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovClusterMetadata(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovClusterMetadata(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *ClusterInformation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.InitialFailoverVersion != 0 {
+		n += 1 + sovClusterMetadata(uint64(m.InitialFailoverVersion))
+	}
+	l = len(m.RpcAddress)
+	if l > 0 {
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	return n
+}
+
+func (m *MaintenanceInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	if m.Severity != 0 {
+		n += 1 + sovClusterMetadata(uint64(m.Severity))
+	}
+	if m.LastUpdateTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpdateTime)
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	return n
+}
+
+func (m *IndexSearchAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CustomSearchAttributes) > 0 {
+		for k, v := range m.CustomSearchAttributes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovClusterMetadata(uint64(len(k))) + 1 + sovClusterMetadata(uint64(v))
+			n += mapEntrySize + 1 + sovClusterMetadata(uint64(mapEntrySize))
+		}
+	}
+	if m.LastUpdateTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpdateTime)
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	return n
+}
+
+func (m *DynamicConfigValues) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			l = e.Size()
+			n += 1 + l + sovClusterMetadata(uint64(l))
+		}
+	}
+	if m.LastUpdateTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpdateTime)
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	return n
+}
+
+func (m *DynamicConfigValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	if m.Constraints != nil {
+		l = m.Constraints.Size()
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	return n
+}
+
+func (m *DynamicConfigConstraints) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	l = len(m.TaskQueueName)
+	if l > 0 {
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	if m.TaskType != 0 {
+		n += 1 + sovClusterMetadata(uint64(m.TaskType))
+	}
+	if m.ShardId != 0 {
+		n += 1 + sovClusterMetadata(uint64(m.ShardId))
+	}
+	return n
+}
+
+func sovClusterMetadata(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozClusterMetadata(x uint64) (n int) {
+	return sovClusterMetadata(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ClusterMetadata) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForIndexSearchAttributes := make([]string, 0, len(this.IndexSearchAttributes))
+	for k, _ := range this.IndexSearchAttributes {
+		keysForIndexSearchAttributes = append(keysForIndexSearchAttributes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForIndexSearchAttributes)
+	mapStringForIndexSearchAttributes := "map[string]*IndexSearchAttributes{"
+	for _, k := range keysForIndexSearchAttributes {
+		mapStringForIndexSearchAttributes += fmt.Sprintf("%v: %v,", k, this.IndexSearchAttributes[k])
+	}
+	mapStringForIndexSearchAttributes += "}"
+	keysForDynamicConfig := make([]string, 0, len(this.DynamicConfig))
+	for k, _ := range this.DynamicConfig {
+		keysForDynamicConfig = append(keysForDynamicConfig, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForDynamicConfig)
+	mapStringForDynamicConfig := "map[string]*DynamicConfigValues{"
+	for _, k := range keysForDynamicConfig {
+		mapStringForDynamicConfig += fmt.Sprintf("%v: %v,", k, this.DynamicConfig[k])
+	}
+	mapStringForDynamicConfig += "}"
+	keysForClusterInformation := make([]string, 0, len(this.ClusterInformation))
+	for k, _ := range this.ClusterInformation {
+		keysForClusterInformation = append(keysForClusterInformation, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForClusterInformation)
+	mapStringForClusterInformation := "map[string]*ClusterInformation{"
+	for _, k := range keysForClusterInformation {
+		mapStringForClusterInformation += fmt.Sprintf("%v: %v,", k, this.ClusterInformation[k])
+	}
+	mapStringForClusterInformation += "}"
+	s := strings.Join([]string{`&ClusterMetadata{`,
+		`ClusterName:` + fmt.Sprintf("%v", this.ClusterName) + `,`,
+		`HistoryShardCount:` + fmt.Sprintf("%v", this.HistoryShardCount) + `,`,
+		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
+		`VersionInfo:` + strings.Replace(fmt.Sprintf("%v", this.VersionInfo), "VersionInfo", "v1.VersionInfo", 1) + `,`,
+		`IndexSearchAttributes:` + mapStringForIndexSearchAttributes + `,`,
+		`DynamicConfig:` + mapStringForDynamicConfig + `,`,
+		`MaintenanceInfo:` + strings.Replace(this.MaintenanceInfo.String(), "MaintenanceInfo", "MaintenanceInfo", 1) + `,`,
+		`FailoverVersionIncrement:` + fmt.Sprintf("%v", this.FailoverVersionIncrement) + `,`,
+		`ClusterInformation:` + mapStringForClusterInformation + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterInformation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClusterInformation{`,
+		`Enabled:` + fmt.Sprintf("%v", this.Enabled) + `,`,
+		`InitialFailoverVersion:` + fmt.Sprintf("%v", this.InitialFailoverVersion) + `,`,
+		`RpcAddress:` + fmt.Sprintf("%v", this.RpcAddress) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MaintenanceInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MaintenanceInfo{`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`Severity:` + fmt.Sprintf("%v", this.Severity) + `,`,
+		`LastUpdateTime:` + strings.Replace(fmt.Sprintf("%v", this.LastUpdateTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *IndexSearchAttributes) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForCustomSearchAttributes := make([]string, 0, len(this.CustomSearchAttributes))
+	for k, _ := range this.CustomSearchAttributes {
+		keysForCustomSearchAttributes = append(keysForCustomSearchAttributes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForCustomSearchAttributes)
+	mapStringForCustomSearchAttributes := "map[string]v11.IndexedValueType{"
+	for _, k := range keysForCustomSearchAttributes {
+		mapStringForCustomSearchAttributes += fmt.Sprintf("%v: %v,", k, this.CustomSearchAttributes[k])
+	}
+	mapStringForCustomSearchAttributes += "}"
+	s := strings.Join([]string{`&IndexSearchAttributes{`,
+		`CustomSearchAttributes:` + mapStringForCustomSearchAttributes + `,`,
+		`LastUpdateTime:` + strings.Replace(fmt.Sprintf("%v", this.LastUpdateTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DynamicConfigValues) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForValues := "[]*DynamicConfigValue{"
+	for _, f := range this.Values {
+		repeatedStringForValues += strings.Replace(f.String(), "DynamicConfigValue", "DynamicConfigValue", 1) + ","
+	}
+	repeatedStringForValues += "}"
+	s := strings.Join([]string{`&DynamicConfigValues{`,
+		`Values:` + repeatedStringForValues + `,`,
+		`LastUpdateTime:` + strings.Replace(fmt.Sprintf("%v", this.LastUpdateTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DynamicConfigValue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DynamicConfigValue{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Constraints:` + strings.Replace(this.Constraints.String(), "DynamicConfigConstraints", "DynamicConfigConstraints", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DynamicConfigConstraints) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DynamicConfigConstraints{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TaskQueueName:` + fmt.Sprintf("%v", this.TaskQueueName) + `,`,
+		`TaskType:` + fmt.Sprintf("%v", this.TaskType) + `,`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringClusterMetadata(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ClusterMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClusterMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryShardCount", wireType)
+			}
+			m.HistoryShardCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryShardCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VersionInfo == nil {
+				m.VersionInfo = &v1.VersionInfo{}
+			}
+			if err := m.VersionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexSearchAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IndexSearchAttributes == nil {
+				m.IndexSearchAttributes = make(map[string]*IndexSearchAttributes)
+			}
+			var mapkey string
+			var mapvalue *IndexSearchAttributes
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClusterMetadata
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowClusterMetadata
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthClusterMetadata
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthClusterMetadata
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowClusterMetadata
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthClusterMetadata
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthClusterMetadata
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &IndexSearchAttributes{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipClusterMetadata(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthClusterMetadata
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.IndexSearchAttributes[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DynamicConfig == nil {
+				m.DynamicConfig = make(map[string]*DynamicConfigValues)
+			}
+			var mapkey string
+			var mapvalue *DynamicConfigValues
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClusterMetadata
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowClusterMetadata
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthClusterMetadata
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthClusterMetadata
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowClusterMetadata
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthClusterMetadata
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthClusterMetadata
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &DynamicConfigValues{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipClusterMetadata(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthClusterMetadata
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.DynamicConfig[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaintenanceInfo == nil {
+				m.MaintenanceInfo = &MaintenanceInfo{}
+			}
+			if err := m.MaintenanceInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailoverVersionIncrement", wireType)
+			}
+			m.FailoverVersionIncrement = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailoverVersionIncrement |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterInformation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClusterInformation == nil {
+				m.ClusterInformation = make(map[string]*ClusterInformation)
+			}
+			var mapkey string
+			var mapvalue *ClusterInformation
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClusterMetadata
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowClusterMetadata
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthClusterMetadata
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthClusterMetadata
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowClusterMetadata
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthClusterMetadata
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthClusterMetadata
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &ClusterInformation{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipClusterMetadata(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthClusterMetadata
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ClusterInformation[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClusterMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovClusterMetadata(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozClusterMetadata(x uint64) (n int) {
-	return sovClusterMetadata(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *ClusterMetadata) String() string {
-	if this == nil {
-		return "nil"
-	}
-	keysForIndexSearchAttributes := make([]string, 0, len(this.IndexSearchAttributes))
-	for k, _ := range this.IndexSearchAttributes {
-		keysForIndexSearchAttributes = append(keysForIndexSearchAttributes, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForIndexSearchAttributes)
-	mapStringForIndexSearchAttributes := "map[string]*IndexSearchAttributes{"
-	for _, k := range keysForIndexSearchAttributes {
-		mapStringForIndexSearchAttributes += fmt.Sprintf("%v: %v,", k, this.IndexSearchAttributes[k])
-	}
-	mapStringForIndexSearchAttributes += "}"
-	s := strings.Join([]string{`&ClusterMetadata{`,
-		`ClusterName:` + fmt.Sprintf("%v", this.ClusterName) + `,`,
-		`HistoryShardCount:` + fmt.Sprintf("%v", this.HistoryShardCount) + `,`,
-		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
-		`VersionInfo:` + strings.Replace(fmt.Sprintf("%v", this.VersionInfo), "VersionInfo", "v1.VersionInfo", 1) + `,`,
-		`IndexSearchAttributes:` + mapStringForIndexSearchAttributes + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *IndexSearchAttributes) String() string {
-	if this == nil {
-		return "nil"
-	}
-	keysForCustomSearchAttributes := make([]string, 0, len(this.CustomSearchAttributes))
-	for k, _ := range this.CustomSearchAttributes {
-		keysForCustomSearchAttributes = append(keysForCustomSearchAttributes, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForCustomSearchAttributes)
-	mapStringForCustomSearchAttributes := "map[string]v11.IndexedValueType{"
-	for _, k := range keysForCustomSearchAttributes {
-		mapStringForCustomSearchAttributes += fmt.Sprintf("%v: %v,", k, this.CustomSearchAttributes[k])
-	}
-	mapStringForCustomSearchAttributes += "}"
-	s := strings.Join([]string{`&IndexSearchAttributes{`,
-		`CustomSearchAttributes:` + mapStringForCustomSearchAttributes + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringClusterMetadata(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *ClusterMetadata) Unmarshal(dAtA []byte) error {
+func (m *ClusterInformation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -612,17 +2440,17 @@ func (m *ClusterMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterInformation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterInformation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClusterMetadata
@@ -632,29 +2460,17 @@ func (m *ClusterMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthClusterMetadata
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthClusterMetadata
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClusterName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Enabled = bool(v != 0)
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoryShardCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialFailoverVersion", wireType)
 			}
-			m.HistoryShardCount = 0
+			m.InitialFailoverVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClusterMetadata
@@ -664,14 +2480,14 @@ func (m *ClusterMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HistoryShardCount |= int32(b&0x7F) << shift
+				m.InitialFailoverVersion |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RpcAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -699,11 +2515,115 @@ func (m *ClusterMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			m.RpcAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClusterMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MaintenanceInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClusterMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaintenanceInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaintenanceInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Severity", wireType)
+			}
+			m.Severity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Severity |= v11.Severity(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -727,19 +2647,72 @@ func (m *ClusterMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthClusterMetadata
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastUpdateTime == nil {
+				m.LastUpdateTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastUpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClusterMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VersionInfo == nil {
-				m.VersionInfo = &v1.VersionInfo{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndexSearchAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClusterMetadata
 			}
-			if err := m.VersionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexSearchAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexSearchAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexSearchAttributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomSearchAttributes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -766,11 +2739,11 @@ func (m *ClusterMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.IndexSearchAttributes == nil {
-				m.IndexSearchAttributes = make(map[string]*IndexSearchAttributes)
+			if m.CustomSearchAttributes == nil {
+				m.CustomSearchAttributes = make(map[string]v11.IndexedValueType)
 			}
 			var mapkey string
-			var mapvalue *IndexSearchAttributes
+			var mapvalue v11.IndexedValueType
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -819,7 +2792,6 @@ func (m *ClusterMetadata) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowClusterMetadata
@@ -829,26 +2801,11 @@ func (m *ClusterMetadata) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
+						mapvalue |= v11.IndexedValueType(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthClusterMetadata
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthClusterMetadata
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &IndexSearchAttributes{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipClusterMetadata(dAtA[iNdEx:])
@@ -864,7 +2821,287 @@ func (m *ClusterMetadata) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.IndexSearchAttributes[mapkey] = mapvalue
+			m.CustomSearchAttributes[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastUpdateTime == nil {
+				m.LastUpdateTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastUpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClusterMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DynamicConfigValues) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClusterMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DynamicConfigValues: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DynamicConfigValues: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, &DynamicConfigValue{})
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastUpdateTime == nil {
+				m.LastUpdateTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastUpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClusterMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DynamicConfigValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClusterMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DynamicConfigValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DynamicConfigValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constraints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Constraints == nil {
+				m.Constraints = &DynamicConfigConstraints{}
+			}
+			if err := m.Constraints.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -890,7 +3127,7 @@ func (m *ClusterMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IndexSearchAttributes) Unmarshal(dAtA []byte) error {
+func (m *DynamicConfigConstraints) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -913,17 +3150,17 @@ func (m *IndexSearchAttributes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IndexSearchAttributes: wiretype end group for non-group")
+			return fmt.Errorf("proto: DynamicConfigConstraints: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IndexSearchAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DynamicConfigConstraints: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CustomSearchAttributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClusterMetadata
@@ -933,105 +3170,126 @@ func (m *IndexSearchAttributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthClusterMetadata
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthClusterMetadata
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CustomSearchAttributes == nil {
-				m.CustomSearchAttributes = make(map[string]v11.IndexedValueType)
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			var mapkey string
-			var mapvalue v11.IndexedValueType
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowClusterMetadata
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowClusterMetadata
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthClusterMetadata
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthClusterMetadata
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowClusterMetadata
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= v11.IndexedValueType(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipClusterMetadata(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthClusterMetadata
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.CustomSearchAttributes[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueueName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskQueueName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
+			}
+			m.TaskType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskType |= v11.TaskQueueType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClusterMetadata(dAtA[iNdEx:])
@@ -272,6 +272,8 @@ type WorkflowExecutionInfo struct {
 	LastFirstEventTxnId  int64      `protobuf:"varint,58,opt,name=last_first_event_txn_id,json=lastFirstEventTxnId,proto3" json:"last_first_event_txn_id,omitempty"`
 	StateTransitionCount int64      `protobuf:"varint,59,opt,name=state_transition_count,json=stateTransitionCount,proto3" json:"state_transition_count,omitempty"`
 	ExecutionTime        *time.Time `protobuf:"bytes,60,opt,name=execution_time,json=executionTime,proto3,stdtime" json:"execution_time,omitempty"`
+	// Last time worker heartbeated in flight workflow task, start to close timeout is counted from this time.
+	WorkflowTaskLastHeartbeatTime *time.Time `protobuf:"bytes,61,opt,name=workflow_task_last_heartbeat_time,json=workflowTaskLastHeartbeatTime,proto3,stdtime" json:"workflow_task_last_heartbeat_time,omitempty"`
 }
 
 func (m *WorkflowExecutionInfo) Reset()      { *m = WorkflowExecutionInfo{} }
@@ -678,6 +680,13 @@ func (m *WorkflowExecutionInfo) GetExecutionTime() *time.Time {
 	return nil
 }
 
+func (m *WorkflowExecutionInfo) GetWorkflowTaskLastHeartbeatTime() *time.Time {
+	if m != nil {
+		return m.WorkflowTaskLastHeartbeatTime
+	}
+	return nil
+}
+
 type ExecutionStats struct {
 	HistorySize int64 `protobuf:"varint,1,opt,name=history_size,json=historySize,proto3" json:"history_size,omitempty"`
 }
@@ -804,6 +813,8 @@ type TransferTaskInfo struct {
 	Version                 int64        `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`
 	TaskId                  int64        `protobuf:"varint,12,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 	VisibilityTime          *time.Time   `protobuf:"bytes,13,opt,name=visibility_time,json=visibilityTime,proto3,stdtime" json:"visibility_time,omitempty"`
+	// W3C trace context of the request which created the task.
+	TraceContext map[string]string `protobuf:"bytes,15,rep,name=trace_context,json=traceContext,proto3" json:"trace_context,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *TransferTaskInfo) Reset()      { *m = TransferTaskInfo{} }
@@ -929,6 +940,13 @@ func (m *TransferTaskInfo) GetVisibilityTime() *time.Time {
 	return nil
 }
 
+func (m *TransferTaskInfo) GetTraceContext() map[string]string {
+	if m != nil {
+		return m.TraceContext
+	}
+	return nil
+}
+
 // replication column
 type ReplicationTaskInfo struct {
 	NamespaceId       string       `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
@@ -1062,6 +1080,8 @@ type VisibilityTaskInfo struct {
 	Version        int64        `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
 	TaskId         int64        `protobuf:"varint,6,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 	VisibilityTime *time.Time   `protobuf:"bytes,7,opt,name=visibility_time,json=visibilityTime,proto3,stdtime" json:"visibility_time,omitempty"`
+	// Backfill tasks are generated by task refresh and are indexed with lower priority than live updates.
+	Backfill bool `protobuf:"varint,8,opt,name=backfill,proto3" json:"backfill,omitempty"`
 }
 
 func (m *VisibilityTaskInfo) Reset()      { *m = VisibilityTaskInfo{} }
@@ -1145,6 +1165,13 @@ func (m *VisibilityTaskInfo) GetVisibilityTime() *time.Time {
 	return nil
 }
 
+func (m *VisibilityTaskInfo) GetBackfill() bool {
+	if m != nil {
+		return m.Backfill
+	}
+	return false
+}
+
 // timer column
 type TimerTaskInfo struct {
 	NamespaceId         string                  `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
@@ -1309,6 +1336,8 @@ type ActivityInfo struct {
 	ScheduleId                  int64          `protobuf:"varint,30,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
 	LastHeartbeatDetails        *v12.Payloads  `protobuf:"bytes,31,opt,name=last_heartbeat_details,json=lastHeartbeatDetails,proto3" json:"last_heartbeat_details,omitempty"`
 	LastHeartbeatUpdateTime     *time.Time     `protobuf:"bytes,32,opt,name=last_heartbeat_update_time,json=lastHeartbeatUpdateTime,proto3,stdtime" json:"last_heartbeat_update_time,omitempty"`
+	// Time the last failed attempt completed, set when the activity is scheduled for a retry.
+	LastAttemptCompleteTime *time.Time `protobuf:"bytes,33,opt,name=last_attempt_complete_time,json=lastAttemptCompleteTime,proto3,stdtime" json:"last_attempt_complete_time,omitempty"`
 }
 
 func (m *ActivityInfo) Reset()      { *m = ActivityInfo{} }
@@ -1567,6 +1596,13 @@ func (m *ActivityInfo) GetLastHeartbeatUpdateTime() *time.Time {
 	return nil
 }
 
+func (m *ActivityInfo) GetLastAttemptCompleteTime() *time.Time {
+	if m != nil {
+		return m.LastAttemptCompleteTime
+	}
+	return nil
+}
+
 // timer_map column
 type TimerInfo struct {
 	Version    int64      `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
@@ -2008,6 +2044,7 @@ func init() {
 	proto.RegisterType((*ExecutionStats)(nil), "temporal.server.api.persistence.v1.ExecutionStats")
 	proto.RegisterType((*WorkflowExecutionState)(nil), "temporal.server.api.persistence.v1.WorkflowExecutionState")
 	proto.RegisterType((*TransferTaskInfo)(nil), "temporal.server.api.persistence.v1.TransferTaskInfo")
+	proto.RegisterMapType((map[string]string)(nil), "temporal.server.api.persistence.v1.TransferTaskInfo.TraceContextEntry")
 	proto.RegisterType((*ReplicationTaskInfo)(nil), "temporal.server.api.persistence.v1.ReplicationTaskInfo")
 	proto.RegisterType((*VisibilityTaskInfo)(nil), "temporal.server.api.persistence.v1.VisibilityTaskInfo")
 	proto.RegisterType((*TimerTaskInfo)(nil), "temporal.server.api.persistence.v1.TimerTaskInfo")
@@ -2024,208 +2061,214 @@ func init() {
 }
 
 var fileDescriptor_67a714d0e7ba9f37 = []byte{
-	// 3213 bytes of a gzipped FileDescriptorProto
+	// 3310 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3a, 0xcd, 0x73, 0xdb, 0xc6,
-	0xf5, 0x86, 0x45, 0x49, 0xe4, 0x23, 0x45, 0x51, 0xd0, 0x17, 0x24, 0xcb, 0x94, 0xcc, 0xd8, 0x89,
-	0x9c, 0x38, 0x94, 0x2d, 0x3b, 0xdf, 0x99, 0xf9, 0x8d, 0x2d, 0xdb, 0x09, 0x39, 0x89, 0xe3, 0x40,
-	0x4a, 0x9c, 0xc9, 0x6f, 0x32, 0x1c, 0x08, 0x58, 0x4a, 0xa8, 0x40, 0x80, 0x06, 0x40, 0xda, 0xcc,
-	0xf4, 0x90, 0x43, 0xa7, 0xb9, 0xe6, 0xd8, 0x99, 0x9e, 0x7a, 0xeb, 0xb9, 0x33, 0xf9, 0x03, 0x3a,
-	0xbd, 0xf4, 0x98, 0x63, 0x4e, 0x6d, 0xe3, 0x5c, 0x7a, 0xc9, 0x34, 0x7f, 0x42, 0x67, 0xdf, 0xee,
-	0x02, 0x0b, 0x10, 0x92, 0x21, 0x37, 0x3e, 0xe4, 0x06, 0xec, 0xfb, 0xd8, 0xb7, 0x6f, 0xdf, 0x37,
-	0x00, 0xd7, 0x43, 0xd2, 0xeb, 0x7b, 0xbe, 0xe1, 0x6c, 0x05, 0xc4, 0x1f, 0x12, 0x7f, 0xcb, 0xe8,
-	0xdb, 0x5b, 0x7d, 0xe2, 0x07, 0x76, 0x10, 0x12, 0xd7, 0x24, 0x5b, 0xc3, 0x6b, 0x5b, 0xe4, 0x31,
-	0x31, 0x07, 0xa1, 0xed, 0xb9, 0x41, 0xb3, 0xef, 0x7b, 0xa1, 0xa7, 0x36, 0x04, 0x51, 0x93, 0x11,
-	0x35, 0x8d, 0xbe, 0xdd, 0x94, 0x88, 0x9a, 0xc3, 0x6b, 0xab, 0xf5, 0x03, 0xcf, 0x3b, 0x70, 0xc8,
-	0x16, 0x52, 0xec, 0x0f, 0xba, 0x5b, 0xd6, 0xc0, 0x37, 0x28, 0x13, 0xc6, 0x63, 0x75, 0x3d, 0x0d,
-	0x0f, 0xed, 0x1e, 0x09, 0x42, 0xa3, 0xd7, 0xe7, 0x08, 0x17, 0x2c, 0xd2, 0x27, 0xae, 0x45, 0x5c,
-	0xd3, 0x26, 0xc1, 0xd6, 0x81, 0x77, 0xe0, 0xe1, 0x3a, 0x3e, 0x71, 0x94, 0x8b, 0x91, 0xf0, 0x54,
-	0x6a, 0xd3, 0xeb, 0xf5, 0x3c, 0x97, 0x0a, 0xdc, 0x23, 0x41, 0x60, 0x1c, 0x90, 0x4c, 0x2c, 0xe2,
-	0x0e, 0x7a, 0x01, 0x45, 0x7a, 0xe4, 0xf9, 0x47, 0x5d, 0xc7, 0x7b, 0xc4, 0xb1, 0x2e, 0x25, 0xb0,
-	0xba, 0x86, 0xed, 0x0c, 0x7c, 0x32, 0xce, 0x2c, 0x89, 0x76, 0x68, 0x07, 0xa1, 0xe7, 0x8f, 0xc6,
-	0xd1, 0x5e, 0x4c, 0xa0, 0x89, 0xad, 0xc6, 0xf1, 0x2e, 0x67, 0xa9, 0x3f, 0x12, 0x91, 0x9d, 0x88,
-	0xa3, 0xbe, 0x72, 0x22, 0x6a, 0xea, 0x34, 0x2f, 0x9d, 0x88, 0x1c, 0x1a, 0xc1, 0x11, 0x47, 0xbc,
-	0x92, 0x85, 0x78, 0xdc, 0xb1, 0x1a, 0xff, 0x00, 0x28, 0xed, 0x1e, 0x1a, 0xbe, 0xd5, 0x72, 0xbb,
-	0x9e, 0xba, 0x02, 0xc5, 0x80, 0xbe, 0x74, 0x6c, 0x4b, 0x53, 0x36, 0x94, 0xcd, 0x49, 0x7d, 0x1a,
-	0xdf, 0x5b, 0x16, 0x05, 0xf9, 0x86, 0x7b, 0x40, 0x28, 0xe8, 0xec, 0x86, 0xb2, 0x39, 0xa1, 0x4f,
-	0xe3, 0x7b, 0xcb, 0x52, 0x17, 0x60, 0xd2, 0x7b, 0xe4, 0x12, 0x5f, 0x9b, 0xd8, 0x50, 0x36, 0x4b,
-	0x3a, 0x7b, 0x51, 0xb7, 0x61, 0xd1, 0x27, 0x7d, 0xc7, 0x36, 0xd1, 0x46, 0x3a, 0x86, 0x79, 0xd4,
-	0x71, 0xc8, 0x90, 0x38, 0x5a, 0x01, 0xa9, 0xe7, 0x25, 0xe0, 0x4d, 0xf3, 0xe8, 0x03, 0x0a, 0x52,
-	0xaf, 0x80, 0x1a, 0xfa, 0x86, 0x1b, 0x74, 0x89, 0x2f, 0x11, 0x4c, 0x22, 0x41, 0x4d, 0x40, 0x64,
-	0xec, 0x20, 0xf4, 0x1c, 0xe2, 0x76, 0x02, 0xdb, 0x35, 0x49, 0xc7, 0x27, 0x2e, 0x79, 0xa4, 0x4d,
-	0xa1, 0xdc, 0x35, 0x06, 0xd9, 0xa5, 0x00, 0x9d, 0xae, 0xab, 0x37, 0xa1, 0x3c, 0xe8, 0x5b, 0x46,
-	0x48, 0x3a, 0xd4, 0x2e, 0xb5, 0xe9, 0x0d, 0x65, 0xb3, 0xbc, 0xbd, 0xda, 0x64, 0x46, 0xdb, 0x14,
-	0x46, 0xdb, 0xdc, 0x13, 0x46, 0x7b, 0xab, 0xf0, 0xcd, 0x3f, 0xd7, 0x15, 0x1d, 0x18, 0x11, 0x5d,
-	0x56, 0x3f, 0x86, 0x05, 0x4a, 0x2b, 0xc9, 0xc6, 0x78, 0x15, 0x73, 0xf2, 0x9a, 0x43, 0x6a, 0x21,
-	0x3f, 0xb2, 0xbc, 0x0d, 0x75, 0xd7, 0xe8, 0x91, 0xa0, 0x6f, 0x98, 0xa4, 0xe3, 0x7a, 0xa1, 0xdd,
-	0x15, 0x0a, 0x1b, 0x52, 0xef, 0xf3, 0x5c, 0xad, 0x84, 0xa7, 0x5f, 0x8b, 0xb0, 0xee, 0x49, 0x48,
-	0x9f, 0x32, 0x1c, 0xf5, 0x6b, 0x05, 0x56, 0x4d, 0x67, 0x10, 0x84, 0xc4, 0xef, 0x64, 0x28, 0x10,
-	0x36, 0x26, 0x36, 0xcb, 0xdb, 0xed, 0xe6, 0xd3, 0x9d, 0xbc, 0x19, 0xd9, 0x42, 0x73, 0x87, 0xf1,
-	0xdb, 0x4b, 0x69, 0xfd, 0x8e, 0x1b, 0xfa, 0x23, 0x7d, 0xd9, 0xcc, 0x86, 0xaa, 0xbf, 0x53, 0x60,
-	0x39, 0x92, 0x24, 0xa9, 0x2b, 0xad, 0x8c, 0x62, 0xbc, 0xf7, 0x6c, 0x62, 0xc8, 0x9a, 0x43, 0x19,
-	0xb8, 0x4e, 0x17, 0xcc, 0x0c, 0x04, 0xf5, 0xf7, 0x0a, 0xac, 0x08, 0x31, 0x64, 0x2b, 0x64, 0x82,
-	0x54, 0xfe, 0x07, 0x7d, 0xe8, 0x31, 0xb7, 0x0c, 0x7d, 0xa4, 0xa1, 0x54, 0x1f, 0x2b, 0xb2, 0x00,
-	0x96, 0xf3, 0x50, 0xd2, 0xc8, 0x0c, 0x0a, 0xd2, 0x3a, 0x9d, 0x20, 0xd2, 0x1e, 0xb7, 0x9d, 0x87,
-	0xc9, 0x7b, 0x59, 0xf2, 0x33, 0x81, 0xea, 0x55, 0x58, 0x18, 0xda, 0x81, 0xbd, 0x6f, 0x3b, 0x76,
-	0x38, 0x92, 0x04, 0xa8, 0xa2, 0x71, 0xa9, 0x31, 0x4c, 0x50, 0xac, 0xb6, 0x61, 0xed, 0x24, 0x0b,
-	0x50, 0x6b, 0x30, 0x71, 0x44, 0x46, 0x18, 0x25, 0x4a, 0x3a, 0x7d, 0xa4, 0x61, 0x60, 0x68, 0x38,
-	0x03, 0xc2, 0xc3, 0x03, 0x7b, 0x79, 0xfb, 0xec, 0x9b, 0xca, 0xaa, 0x09, 0x2b, 0xc7, 0x5e, 0x63,
-	0x06, 0xa3, 0xab, 0x32, 0xa3, 0x13, 0xfd, 0x4a, 0xde, 0x24, 0x16, 0x38, 0xf3, 0x8a, 0x4e, 0x25,
-	0x70, 0x0b, 0xce, 0x9d, 0xa0, 0xe5, 0xd3, 0xb0, 0x6a, 0xfc, 0xb4, 0x06, 0x8b, 0x0f, 0x78, 0x28,
-	0xbf, 0x23, 0xd2, 0x2e, 0x06, 0xdb, 0x0b, 0x50, 0x89, 0x5d, 0x9f, 0x07, 0xdc, 0x92, 0x5e, 0x8e,
-	0xd6, 0x5a, 0x96, 0xba, 0x0e, 0x65, 0x91, 0x06, 0x44, 0xdc, 0x2d, 0xe9, 0x20, 0x96, 0x5a, 0x96,
-	0xda, 0x84, 0xf9, 0xbe, 0xe1, 0x13, 0x37, 0xec, 0x24, 0x58, 0xb1, 0x40, 0x3c, 0xc7, 0x40, 0xf7,
-	0x24, 0x86, 0x57, 0x40, 0xe5, 0xf8, 0x32, 0xdf, 0x02, 0xa2, 0xd7, 0x18, 0xe4, 0x41, 0xcc, 0xbd,
-	0x01, 0x33, 0x1c, 0xdb, 0x1f, 0xb8, 0x14, 0x71, 0x92, 0x89, 0xc8, 0x16, 0xf5, 0x81, 0xdb, 0xb2,
-	0xe8, 0x29, 0x6c, 0xd7, 0x0e, 0x6d, 0x23, 0x24, 0x98, 0x36, 0xa6, 0x50, 0x01, 0xe5, 0x68, 0xad,
-	0x65, 0xa9, 0x6f, 0xc1, 0x8a, 0xe9, 0xf5, 0xfa, 0x0e, 0x41, 0x0f, 0x20, 0x43, 0xca, 0x70, 0xdf,
-	0x08, 0xcd, 0x43, 0x8a, 0x3f, 0x8d, 0xf8, 0x4b, 0x31, 0xc2, 0x1d, 0x0a, 0xbf, 0x45, 0xc1, 0x2d,
-	0x4b, 0xbd, 0x0f, 0xb5, 0x34, 0x29, 0x8f, 0xb6, 0x97, 0x62, 0xa7, 0xa1, 0xde, 0xc2, 0x13, 0x1c,
-	0xf5, 0x94, 0xf7, 0xd9, 0x23, 0xf2, 0xd1, 0x67, 0x53, 0x8c, 0xd5, 0xf3, 0x00, 0x34, 0x59, 0x76,
-	0x1e, 0x0e, 0xc8, 0x80, 0x60, 0x70, 0x2d, 0xe9, 0x25, 0xba, 0xf2, 0x31, 0x5d, 0xa0, 0x0a, 0x8a,
-	0x34, 0x13, 0x8e, 0xfa, 0x04, 0xf5, 0xaa, 0x01, 0x53, 0x90, 0x80, 0xec, 0x8d, 0xfa, 0x84, 0x6a,
-	0x55, 0xfd, 0x02, 0x56, 0x23, 0xec, 0xa8, 0xa6, 0xc2, 0xb8, 0xe7, 0x0d, 0x42, 0xad, 0x8c, 0x82,
-	0xae, 0x8c, 0x99, 0xef, 0x6d, 0x5e, 0x37, 0xdd, 0x2a, 0xfc, 0x81, 0x46, 0x30, 0xed, 0x51, 0xda,
-	0x3c, 0xf6, 0x18, 0x03, 0x9a, 0x6f, 0x22, 0xf6, 0xf4, 0x06, 0x04, 0xe3, 0x4a, 0x3e, 0xc6, 0xd1,
-	0x49, 0xf4, 0x41, 0xc4, 0x72, 0x1f, 0xce, 0x5b, 0xa4, 0x6b, 0x0c, 0x1c, 0xc9, 0x02, 0x50, 0x1f,
-	0x82, 0xf7, 0x4c, 0x3e, 0xde, 0xab, 0x9c, 0x8b, 0xb0, 0x96, 0x3d, 0x23, 0x38, 0x12, 0x7b, 0xbc,
-	0x00, 0x33, 0x41, 0x68, 0xf8, 0x61, 0x94, 0xc2, 0x58, 0x94, 0xa9, 0xe0, 0xa2, 0x48, 0x59, 0xaf,
-	0x80, 0xea, 0x18, 0x41, 0xc8, 0xcd, 0x01, 0x45, 0xb0, 0x2d, 0x6d, 0x0e, 0x31, 0x67, 0x29, 0x04,
-	0xaf, 0x8b, 0xb2, 0x6d, 0x59, 0xea, 0xab, 0x30, 0x8f, 0xc8, 0x5d, 0xdb, 0x8f, 0x48, 0x6c, 0x4b,
-	0x53, 0x59, 0x61, 0x40, 0x41, 0x77, 0x29, 0x04, 0x49, 0x5a, 0x96, 0xfa, 0x2e, 0x9c, 0x43, 0xf4,
-	0xe4, 0x09, 0x99, 0x4c, 0xb6, 0xa5, 0xcd, 0x23, 0xd9, 0x32, 0x45, 0x91, 0xc5, 0xdf, 0xa5, 0xf0,
-	0x96, 0xa5, 0xfe, 0x1f, 0x00, 0x43, 0xc5, 0xdc, 0xbe, 0x90, 0x33, 0xb7, 0x97, 0x90, 0x06, 0x73,
-	0x7a, 0x1b, 0x50, 0xa4, 0x8e, 0x5c, 0x6e, 0x2c, 0xe6, 0x64, 0x53, 0xa5, 0x94, 0x9f, 0xc4, 0x25,
-	0xc7, 0x36, 0x2c, 0x26, 0x4f, 0x21, 0x74, 0xba, 0xc4, 0xaa, 0xa8, 0x47, 0xd2, 0x01, 0x84, 0x6a,
-	0xdf, 0x82, 0x95, 0xd4, 0xc9, 0xcd, 0x43, 0x62, 0x0d, 0x1c, 0x0c, 0x0d, 0xcb, 0xcc, 0xdf, 0x64,
-	0xba, 0x5d, 0x0e, 0x6e, 0x59, 0xea, 0x1b, 0xa0, 0x65, 0x28, 0x8d, 0x79, 0xb6, 0x86, 0x94, 0x8b,
-	0x8f, 0xd2, 0x2a, 0x43, 0x1f, 0xdf, 0x4d, 0xcb, 0x29, 0xec, 0x69, 0x25, 0x9f, 0x3d, 0x25, 0x0e,
-	0x22, 0x0c, 0x69, 0xec, 0xf0, 0x46, 0x48, 0x9d, 0x3e, 0xd4, 0x56, 0xb1, 0xc6, 0x4b, 0xd0, 0xdc,
-	0x64, 0xa0, 0x84, 0x4b, 0x26, 0x4e, 0x80, 0xd7, 0x70, 0x2e, 0xe7, 0x35, 0x2c, 0x67, 0x9c, 0x12,
-	0xef, 0xc3, 0x80, 0xb5, 0x6c, 0xdd, 0xf2, 0x0d, 0xd6, 0x72, 0x6e, 0xb0, 0x92, 0x75, 0x01, 0x6c,
-	0x8b, 0xcb, 0x50, 0x33, 0x0d, 0xd7, 0x24, 0x4e, 0xc7, 0x27, 0x0f, 0x07, 0x24, 0x08, 0x89, 0xa5,
-	0x9d, 0xdf, 0x50, 0x36, 0x8b, 0xfa, 0x2c, 0x5b, 0xd7, 0xc5, 0xb2, 0xea, 0xc3, 0xa5, 0xa4, 0x34,
-	0x9e, 0x6f, 0x1f, 0xd8, 0xae, 0xe1, 0xa4, 0xc5, 0xaa, 0xe7, 0x14, 0xeb, 0x82, 0x2c, 0xd6, 0x47,
-	0x9c, 0x59, 0x52, 0xbc, 0x31, 0x13, 0xe1, 0x52, 0x52, 0x13, 0x59, 0xc7, 0x38, 0x99, 0x30, 0x11,
-	0x2e, 0x6c, 0xcb, 0x52, 0x5f, 0x86, 0xb9, 0xe4, 0xb9, 0x28, 0xc5, 0x06, 0x52, 0x24, 0x0f, 0xc6,
-	0x70, 0x83, 0xd0, 0x36, 0x8f, 0x46, 0x1d, 0x29, 0x58, 0x5f, 0x60, 0xb8, 0x0c, 0xb0, 0x17, 0x85,
-	0xec, 0x03, 0xd8, 0xe0, 0xb8, 0x91, 0x9d, 0x87, 0x5e, 0x27, 0x76, 0x61, 0x6a, 0x85, 0x8d, 0x7c,
-	0x56, 0xb8, 0xc6, 0x18, 0x89, 0x03, 0xef, 0x79, 0xbb, 0xc2, 0xa9, 0xa9, 0x39, 0x6a, 0x30, 0x2d,
-	0x0c, 0xf0, 0x05, 0xd6, 0x1c, 0xf1, 0x57, 0xf5, 0x13, 0x58, 0xf2, 0x49, 0xe8, 0x8f, 0x3a, 0x2c,
-	0xed, 0x39, 0x1d, 0xdb, 0x0d, 0x89, 0x3f, 0x34, 0x1c, 0xed, 0x62, 0xbe, 0x8d, 0x17, 0x90, 0xbc,
-	0xc5, 0xa8, 0x5b, 0x9c, 0x38, 0x66, 0xdb, 0x33, 0x1e, 0xdb, 0xbd, 0x41, 0x2f, 0x66, 0x7b, 0xe9,
-	0x34, 0x6c, 0x3f, 0x64, 0xd4, 0x11, 0xdb, 0x1b, 0x69, 0xb6, 0xfc, 0x18, 0x81, 0xf6, 0x22, 0x1e,
-	0x2b, 0x41, 0xc5, 0xfd, 0x2a, 0x50, 0xdf, 0xa6, 0x85, 0x2c, 0xa5, 0xda, 0x37, 0xcc, 0x23, 0xaf,
-	0xdb, 0xed, 0x98, 0x1e, 0xe9, 0x76, 0x6d, 0xd3, 0xa6, 0x39, 0xf9, 0xa5, 0x0d, 0x65, 0x53, 0xd1,
-	0x97, 0x11, 0xe1, 0x16, 0x83, 0xef, 0xc4, 0x60, 0xb5, 0x07, 0x8d, 0x8c, 0x3c, 0x49, 0x1e, 0xf7,
-	0x6d, 0x26, 0x2e, 0x33, 0xd2, 0xcd, 0x9c, 0x46, 0xba, 0x3e, 0x96, 0x30, 0xef, 0x44, 0x9c, 0x78,
-	0x53, 0xb5, 0xce, 0x44, 0x75, 0x3d, 0xb7, 0x83, 0x4f, 0xc6, 0xbe, 0x43, 0x3a, 0xc4, 0xf7, 0x3d,
-	0x1f, 0xb3, 0x7a, 0xa0, 0x5d, 0xde, 0x98, 0xd8, 0x2c, 0xe9, 0xe7, 0x10, 0x78, 0xcf, 0x73, 0x75,
-	0x81, 0x74, 0x87, 0xe2, 0xd0, 0xfc, 0x1e, 0xa8, 0x9b, 0x50, 0x3b, 0x34, 0x02, 0x46, 0xdf, 0xe9,
-	0x7b, 0x8e, 0x6d, 0x8e, 0xb4, 0x97, 0xd1, 0x0f, 0xab, 0x87, 0x46, 0x80, 0x14, 0xf7, 0x71, 0x95,
-	0x26, 0x3c, 0xd3, 0xf7, 0xdc, 0xc8, 0xfe, 0xb4, 0x57, 0xd0, 0x52, 0x2b, 0x74, 0x51, 0xd8, 0x12,
-	0x2d, 0x94, 0x02, 0xfb, 0x80, 0xfa, 0xa6, 0xe9, 0x0d, 0xdc, 0x50, 0x6b, 0xb2, 0x42, 0x89, 0xad,
-	0xed, 0xd0, 0x25, 0xf5, 0x12, 0x54, 0x78, 0x1d, 0xd3, 0x09, 0xec, 0x2f, 0x89, 0xb6, 0x45, 0x51,
-	0x6e, 0x9d, 0xd5, 0x14, 0xbd, 0xcc, 0xd7, 0x77, 0xed, 0x2f, 0x69, 0x1b, 0x3a, 0x67, 0x0c, 0x42,
-	0xaf, 0xe3, 0x93, 0x80, 0x84, 0x9d, 0xbe, 0x67, 0xbb, 0x61, 0xa0, 0x5d, 0xcf, 0xaa, 0x8a, 0xa2,
-	0x19, 0xc2, 0xf0, 0x5a, 0x53, 0xa7, 0xd8, 0xf7, 0x11, 0x59, 0x9f, 0xa5, 0xf4, 0xd2, 0x82, 0xfa,
-	0x5b, 0x98, 0x0b, 0x88, 0xe1, 0x9b, 0x87, 0xd4, 0x16, 0x7c, 0x7b, 0x7f, 0x10, 0x92, 0x40, 0xbb,
-	0x81, 0xdd, 0xc9, 0x47, 0x79, 0xba, 0x93, 0xcc, 0x0a, 0xb7, 0xb9, 0x8b, 0x2c, 0x6f, 0x46, 0x1c,
-	0x59, 0x8f, 0x52, 0x0b, 0x52, 0xcb, 0xea, 0x03, 0x28, 0xf4, 0x48, 0xcf, 0xd3, 0x5e, 0xc3, 0x0d,
-	0x77, 0x9e, 0x7d, 0xc3, 0x0f, 0x49, 0xcf, 0x63, 0x9b, 0x20, 0x43, 0xf5, 0x0b, 0x98, 0xe3, 0xf9,
-	0xb2, 0xc3, 0x14, 0x68, 0x93, 0x40, 0x7b, 0x1d, 0x35, 0x75, 0x35, 0x73, 0x17, 0xa9, 0x8c, 0xe4,
-	0xd9, 0xf4, 0x7d, 0x41, 0xa7, 0xd7, 0x86, 0xa9, 0x15, 0xf5, 0x3a, 0x2c, 0xf1, 0x8a, 0x24, 0xb2,
-	0x69, 0x5e, 0x28, 0xbf, 0x81, 0x06, 0x30, 0x8f, 0xd0, 0x48, 0x44, 0x56, 0x30, 0xff, 0x3f, 0xcc,
-	0xc6, 0xe8, 0x41, 0x68, 0x84, 0x81, 0xf6, 0x26, 0x4a, 0xb4, 0x9d, 0xe7, 0xdc, 0x11, 0xb3, 0x5d,
-	0x4a, 0xa9, 0x57, 0x49, 0xe2, 0x3d, 0x91, 0x9e, 0xa8, 0x28, 0x69, 0x17, 0x7b, 0xeb, 0xb4, 0xe9,
-	0x49, 0x1f, 0xa4, 0x9d, 0xeb, 0x06, 0x2c, 0x8f, 0xd5, 0x62, 0xe1, 0x63, 0x3c, 0xf5, 0xdb, 0xac,
-	0x26, 0x49, 0xd6, 0x63, 0x7b, 0x8f, 0xe9, 0xa9, 0x6f, 0xc0, 0x12, 0x3d, 0x2b, 0x61, 0xe3, 0x09,
-	0x1b, 0x25, 0x62, 0x7e, 0xf0, 0x0e, 0x12, 0x2d, 0x20, 0x74, 0x2f, 0x02, 0x32, 0x87, 0x78, 0x0f,
-	0xaa, 0xc9, 0xb2, 0x5a, 0x7b, 0x37, 0xe7, 0x01, 0x66, 0x88, 0x5c, 0x4c, 0xaf, 0x5a, 0xb0, 0x98,
-	0x69, 0x8c, 0x19, 0xad, 0xdc, 0x6b, 0xc9, 0xee, 0x73, 0x3d, 0xe9, 0x51, 0x7c, 0x80, 0x37, 0xbc,
-	0xd6, 0xbc, 0x6f, 0x8c, 0x1c, 0xcf, 0xb0, 0xe4, 0xb6, 0xf1, 0x33, 0x28, 0x45, 0x16, 0xf8, 0x8b,
-	0x72, 0x6e, 0x17, 0x8a, 0xb3, 0xb5, 0x5a, 0xbb, 0x50, 0xac, 0xd5, 0xe6, 0xda, 0x85, 0xe2, 0x95,
-	0xda, 0xab, 0xed, 0x42, 0xf1, 0xd5, 0x5a, 0xb3, 0x5d, 0x28, 0x5e, 0xad, 0x5d, 0x6b, 0x17, 0x8a,
-	0xd7, 0x6a, 0xdb, 0xed, 0x42, 0x71, 0xbb, 0x76, 0xbd, 0x71, 0x1d, 0xaa, 0x49, 0x1b, 0xa1, 0x81,
-	0x27, 0x11, 0x55, 0x14, 0x16, 0x78, 0xa4, 0x88, 0xd2, 0xf8, 0x8f, 0x02, 0x4b, 0x63, 0x1e, 0x45,
-	0xa9, 0x09, 0x66, 0x6d, 0x9f, 0xd0, 0x9b, 0x93, 0xb2, 0xb6, 0xc2, 0xb3, 0x36, 0x02, 0xe2, 0xac,
-	0xbd, 0x08, 0x53, 0xdc, 0xfe, 0x59, 0xa7, 0x3a, 0xe9, 0xa3, 0xc5, 0xb7, 0x61, 0x12, 0x6f, 0x17,
-	0xdb, 0xd2, 0xea, 0xf6, 0x8d, 0x4c, 0x3b, 0xc7, 0x51, 0x66, 0xa6, 0x67, 0xa3, 0x1c, 0x3a, 0x63,
-	0xa1, 0xde, 0x85, 0x29, 0xfa, 0x30, 0x08, 0xb0, 0x69, 0xad, 0x6e, 0x37, 0x93, 0x4a, 0x3c, 0x99,
-	0xcb, 0x20, 0xd0, 0x39, 0x75, 0xe3, 0xdb, 0x02, 0xd4, 0xc4, 0x60, 0x03, 0x9b, 0x8c, 0x5f, 0xaa,
-	0x23, 0x8f, 0x75, 0x30, 0x21, 0xeb, 0x60, 0x07, 0x4a, 0xac, 0x2c, 0x1e, 0xf5, 0x09, 0x17, 0xfd,
-	0xc5, 0x93, 0xf5, 0x80, 0x85, 0xf0, 0xa8, 0x4f, 0xf4, 0x62, 0xc8, 0x9f, 0x68, 0xb7, 0x1f, 0x1a,
-	0xfe, 0x01, 0x49, 0x75, 0xfb, 0xac, 0x2b, 0x9f, 0x63, 0xa0, 0x54, 0xb7, 0xcf, 0xf1, 0x65, 0x99,
-	0xa7, 0x58, 0x33, 0xcb, 0x20, 0xc9, 0x6e, 0x9f, 0x63, 0xf3, 0x03, 0x4c, 0xb3, 0xe3, 0xb3, 0x45,
-	0x16, 0xbc, 0x92, 0xdd, 0x73, 0x31, 0xdd, 0x3d, 0xbf, 0x03, 0xab, 0x9c, 0x85, 0x79, 0x68, 0x3b,
-	0x56, 0xbc, 0xad, 0xe7, 0x3a, 0x23, 0x6c, 0xb6, 0x8b, 0xfa, 0x32, 0xc3, 0xd8, 0xa1, 0x08, 0x62,
-	0xf7, 0x8f, 0x5c, 0x67, 0x44, 0x55, 0x2b, 0x37, 0x2a, 0x80, 0x66, 0x0a, 0x41, 0xdc, 0x9c, 0x68,
-	0x30, 0x2d, 0xba, 0x9f, 0x32, 0x9b, 0x40, 0xf3, 0x57, 0x75, 0x19, 0xa6, 0x45, 0x07, 0x59, 0x41,
-	0xc8, 0x54, 0xc8, 0x1a, 0xc7, 0x16, 0xcc, 0x4a, 0x73, 0x2f, 0x8c, 0x20, 0x33, 0x79, 0x3b, 0xb1,
-	0x98, 0x90, 0x82, 0xda, 0x85, 0x62, 0xb5, 0x36, 0xdb, 0xf8, 0xeb, 0x04, 0xcc, 0x4b, 0xa3, 0xa1,
-	0x5f, 0x8d, 0xe9, 0x48, 0xba, 0x9b, 0x4c, 0xea, 0xee, 0x22, 0x54, 0x53, 0x6d, 0x35, 0x1b, 0xe1,
-	0x54, 0xba, 0x72, 0x4b, 0xdd, 0x80, 0x19, 0x97, 0x3c, 0x96, 0x90, 0xd8, 0xdc, 0xa6, 0x4c, 0x17,
-	0x05, 0x0e, 0xad, 0x70, 0xa2, 0xb6, 0xc3, 0xb6, 0xd0, 0x3c, 0x68, 0x85, 0x23, 0xd6, 0x18, 0xca,
-	0xbe, 0x6f, 0xb8, 0xe6, 0x61, 0x27, 0xf4, 0x8e, 0x08, 0xbb, 0xc7, 0x8a, 0x5e, 0x66, 0x6b, 0x7b,
-	0x74, 0x49, 0xdd, 0x82, 0x05, 0x97, 0xb0, 0xec, 0x95, 0x40, 0x9d, 0x41, 0xd4, 0x39, 0x97, 0xd0,
-	0x9c, 0x74, 0x4b, 0x22, 0x90, 0x2e, 0x7f, 0x56, 0xbe, 0xfc, 0x76, 0xa1, 0x58, 0xaa, 0x41, 0xbb,
-	0x50, 0x84, 0x5a, 0xb9, 0x5d, 0x28, 0x56, 0x6a, 0x33, 0xfc, 0x0e, 0xff, 0x72, 0x16, 0xd4, 0x4f,
-	0xe3, 0xcb, 0xfd, 0xf5, 0x5f, 0xa1, 0xa4, 0x81, 0xa9, 0xa7, 0x99, 0xff, 0xf4, 0xb3, 0x99, 0x7f,
-	0xe3, 0x4f, 0x05, 0x98, 0xc1, 0xe9, 0xed, 0xaf, 0x46, 0x5f, 0x77, 0xa0, 0xc2, 0xdb, 0x3f, 0xc6,
-	0x67, 0x12, 0xf9, 0x34, 0x8e, 0x49, 0x18, 0xbc, 0xc9, 0x43, 0x1e, 0xe5, 0x30, 0x7e, 0x51, 0x89,
-	0x34, 0x84, 0x10, 0xad, 0x0f, 0xf2, 0x9b, 0x42, 0x7e, 0xd7, 0xf2, 0x65, 0x33, 0xde, 0x14, 0x21,
-	0xfb, 0x68, 0x6e, 0x21, 0x2d, 0xca, 0xb7, 0x3b, 0x9d, 0xbc, 0xdd, 0xcb, 0x50, 0x8b, 0xe2, 0xa2,
-	0xe8, 0x3f, 0x8b, 0xd8, 0xa8, 0xcd, 0x8a, 0x75, 0x31, 0xfc, 0x58, 0x81, 0x62, 0xe4, 0xa0, 0xec,
-	0xbb, 0xd1, 0x34, 0xe1, 0xce, 0x29, 0xd9, 0x08, 0x3c, 0xcd, 0x46, 0xca, 0xcf, 0x68, 0x23, 0x7f,
-	0x9c, 0x85, 0xca, 0x4d, 0x33, 0xb4, 0x87, 0x76, 0x38, 0x42, 0x13, 0x91, 0x0e, 0xa5, 0x24, 0x0f,
-	0xf5, 0x06, 0x68, 0x71, 0xac, 0x48, 0x8d, 0x84, 0xd9, 0x0c, 0x7d, 0x31, 0x82, 0x27, 0x26, 0xc2,
-	0xf7, 0x60, 0x36, 0x45, 0x88, 0xa6, 0x93, 0x7b, 0x20, 0x5c, 0x4d, 0xb2, 0xa5, 0x25, 0x66, 0x6a,
-	0x56, 0x52, 0xc8, 0x5b, 0x62, 0x06, 0x89, 0xb9, 0xc8, 0x79, 0x3e, 0x36, 0x64, 0xb1, 0x8f, 0x79,
-	0x68, 0x29, 0x88, 0x06, 0x64, 0x6d, 0x3e, 0x14, 0x8d, 0xa4, 0x9e, 0x3a, 0x8d, 0xd4, 0x15, 0x4e,
-	0xcb, 0x64, 0xde, 0x81, 0x4a, 0x62, 0xaa, 0x95, 0xd7, 0xa7, 0xcb, 0x81, 0x34, 0xc9, 0x5a, 0x87,
-	0xb2, 0xc1, 0xef, 0x4a, 0x04, 0xeb, 0x92, 0x0e, 0x62, 0x89, 0xe5, 0x7a, 0xa9, 0xe4, 0xe3, 0x93,
-	0x72, 0x3f, 0x2a, 0xf6, 0x3e, 0x87, 0x95, 0xe3, 0xe7, 0x2d, 0x90, 0x6f, 0x3e, 0xb1, 0x14, 0x64,
-	0x4f, 0x5a, 0x52, 0xbc, 0x4d, 0xc7, 0x0b, 0xc8, 0x69, 0xc7, 0xea, 0x12, 0xef, 0x1d, 0x4a, 0x2f,
-	0x78, 0xef, 0x61, 0x27, 0x42, 0x65, 0x4d, 0x33, 0xce, 0x39, 0x56, 0x9f, 0x67, 0x93, 0xde, 0x24,
-	0xd7, 0x0f, 0x60, 0xee, 0x90, 0x18, 0x7e, 0xb8, 0x4f, 0x8c, 0xf0, 0xb4, 0xb3, 0xf4, 0x5a, 0x44,
-	0x29, 0xb8, 0x65, 0x8d, 0x00, 0xab, 0xd9, 0x23, 0xc0, 0xcc, 0xa9, 0x1a, 0xcb, 0x83, 0x59, 0x53,
-	0x35, 0xf6, 0x4d, 0x56, 0x0c, 0x46, 0x69, 0x1d, 0x5d, 0x63, 0xa1, 0x24, 0x14, 0xb1, 0x9d, 0x15,
-	0xca, 0xf2, 0xb0, 0x6b, 0x2e, 0x39, 0xec, 0x4a, 0xd6, 0x80, 0x6a, 0xba, 0x06, 0xa4, 0xe1, 0x2a,
-	0xf2, 0x03, 0xe2, 0x86, 0x76, 0x38, 0xc2, 0x89, 0x3b, 0x4e, 0xee, 0xb8, 0x37, 0xb0, 0xe5, 0xcc,
-	0x09, 0xcb, 0x42, 0xe6, 0x84, 0xe5, 0xf8, 0x01, 0xdb, 0xe2, 0xf3, 0x19, 0xb0, 0x2d, 0x3d, 0x9f,
-	0x01, 0xdb, 0xf2, 0x09, 0x03, 0xb6, 0x3d, 0x58, 0x64, 0x54, 0xe9, 0xa6, 0x5d, 0xcb, 0xe9, 0xde,
-	0xf3, 0x48, 0x9e, 0x6a, 0xd7, 0x4f, 0x1c, 0xdb, 0xad, 0x9c, 0x3c, 0xb6, 0xcb, 0x31, 0x47, 0x5b,
-	0x7d, 0xfa, 0x1c, 0xed, 0x1e, 0xa8, 0x8c, 0x0b, 0x1b, 0x1b, 0xb0, 0xff, 0x70, 0xf8, 0x24, 0x7e,
-	0x23, 0x19, 0xfe, 0x38, 0x90, 0x86, 0xbf, 0xbb, 0xec, 0x51, 0xaf, 0x21, 0xed, 0x07, 0x46, 0x10,
-	0xf2, 0x15, 0xda, 0x64, 0x48, 0xfc, 0x68, 0x2e, 0x25, 0x7e, 0x6c, 0x6a, 0x6b, 0x68, 0x6a, 0xcb,
-	0x11, 0xd5, 0x03, 0x84, 0x47, 0x26, 0x97, 0x2e, 0x5a, 0xce, 0x67, 0x16, 0x2d, 0x72, 0x1f, 0x52,
-	0x1f, 0xeb, 0x43, 0x3e, 0x85, 0x25, 0xdc, 0x3a, 0x76, 0x78, 0x8b, 0x84, 0x86, 0xed, 0x04, 0x38,
-	0xff, 0x1e, 0x3b, 0xd4, 0x58, 0x63, 0x1f, 0xe8, 0x0b, 0x94, 0xfe, 0x7d, 0x41, 0x7e, 0x9b, 0x51,
-	0xab, 0x5f, 0xc0, 0x6a, 0x8a, 0xaf, 0xfc, 0x05, 0x69, 0x23, 0xef, 0xa7, 0x8b, 0x04, 0xef, 0xf8,
-	0x53, 0x52, 0xe3, 0x6f, 0x0a, 0x94, 0xb0, 0x82, 0x7b, 0x4a, 0x6a, 0x4e, 0x26, 0xb2, 0xb3, 0xe9,
-	0x44, 0x76, 0x13, 0xca, 0x68, 0xa0, 0xbc, 0x56, 0x98, 0xc8, 0xfb, 0x1f, 0x0d, 0x23, 0x12, 0xa9,
-	0x47, 0x8e, 0x40, 0xec, 0x87, 0x20, 0x0c, 0x2a, 0x3c, 0xf8, 0xac, 0x40, 0x91, 0x05, 0xaa, 0xa8,
-	0xbb, 0x9d, 0xc6, 0xf7, 0x96, 0xd5, 0xf8, 0xa9, 0x00, 0x2a, 0xf6, 0x8e, 0xc9, 0x8f, 0xe9, 0x27,
-	0x56, 0x1a, 0xf1, 0x07, 0xea, 0xec, 0x4a, 0x23, 0x82, 0x27, 0x2a, 0x8d, 0xa4, 0x1e, 0x26, 0xd2,
-	0x7a, 0xb8, 0x07, 0xb3, 0x29, 0xbe, 0xbc, 0x72, 0xc8, 0x5b, 0x88, 0x24, 0x77, 0xa5, 0xcd, 0xbd,
-	0xd8, 0x4e, 0xae, 0x99, 0x79, 0x73, 0xcf, 0x41, 0x52, 0xbb, 0x7e, 0x11, 0xaa, 0x02, 0x9f, 0x97,
-	0xd0, 0xac, 0xb1, 0x17, 0xa5, 0x81, 0xce, 0x67, 0x2f, 0xa9, 0xb2, 0x63, 0xfa, 0xd9, 0xcb, 0x8e,
-	0xcc, 0x51, 0x50, 0x31, 0x7b, 0x14, 0xb4, 0x06, 0xa5, 0xc8, 0xa7, 0x44, 0xed, 0x10, 0x2d, 0x9c,
-	0xf2, 0x2b, 0xfb, 0x67, 0xd1, 0x4f, 0x0e, 0x2c, 0x5f, 0xf3, 0x4c, 0x51, 0xc6, 0xfa, 0x7b, 0xf3,
-	0x98, 0x7a, 0xfe, 0x3e, 0x52, 0x60, 0x8e, 0x66, 0x39, 0x44, 0xfc, 0x0e, 0x21, 0x2d, 0x8d, 0xfd,
-	0xbc, 0x50, 0x19, 0xfb, 0x79, 0xa1, 0xf1, 0xad, 0x02, 0x73, 0xfc, 0x58, 0x3b, 0x98, 0x4e, 0x9f,
-	0x97, 0xb9, 0x65, 0x26, 0xf2, 0x89, 0xec, 0xcf, 0x63, 0x69, 0xb9, 0x0b, 0xe3, 0x72, 0x7f, 0x7d,
-	0x16, 0x60, 0x17, 0xbf, 0x2d, 0x3c, 0x47, 0xff, 0x18, 0x93, 0x54, 0xaa, 0x0f, 0x55, 0x28, 0xe0,
-	0xad, 0xb2, 0x9f, 0x4b, 0xf0, 0x59, 0x7d, 0x1d, 0x26, 0x6d, 0xb7, 0x3f, 0x08, 0xd1, 0xaa, 0xf3,
-	0x04, 0x4a, 0x86, 0x4e, 0xa5, 0x37, 0x3d, 0x37, 0xf4, 0x3d, 0x87, 0x1b, 0xb9, 0x78, 0x1d, 0xd3,
-	0xc4, 0xf4, 0xb8, 0x26, 0xbe, 0x52, 0xa0, 0xb8, 0x73, 0x48, 0xcc, 0xa3, 0x60, 0xd0, 0x4b, 0xeb,
-	0x61, 0x32, 0xd6, 0xc3, 0x6d, 0x98, 0xea, 0x3a, 0xc6, 0xd0, 0xf3, 0xf1, 0xd4, 0xd5, 0xed, 0x2b,
-	0x27, 0x37, 0x76, 0x82, 0xe3, 0x5d, 0xa4, 0xd1, 0x39, 0x6d, 0xfc, 0x23, 0xd0, 0x04, 0x8e, 0x2b,
-	0xd8, 0xcb, 0xad, 0xdf, 0x7c, 0xf7, 0x43, 0xfd, 0xcc, 0xf7, 0x3f, 0xd4, 0xcf, 0xfc, 0xfc, 0x43,
-	0x5d, 0xf9, 0xea, 0x49, 0x5d, 0xf9, 0xf3, 0x93, 0xba, 0xf2, 0xf7, 0x27, 0x75, 0xe5, 0xbb, 0x27,
-	0x75, 0xe5, 0x5f, 0x4f, 0xea, 0xca, 0xbf, 0x9f, 0xd4, 0xcf, 0xfc, 0xfc, 0xa4, 0xae, 0x7c, 0xf3,
-	0x63, 0xfd, 0xcc, 0x77, 0x3f, 0xd6, 0xcf, 0x7c, 0xff, 0x63, 0xfd, 0xcc, 0xe7, 0x37, 0x0e, 0xbc,
-	0x58, 0x06, 0xdb, 0x3b, 0xfe, 0x7f, 0xde, 0x77, 0xa4, 0xd7, 0xfd, 0x29, 0x0c, 0xc1, 0xd7, 0xff,
-	0x1b, 0x00, 0x00, 0xff, 0xff, 0x26, 0xbc, 0x14, 0x15, 0x08, 0x2c, 0x00, 0x00,
+	0xf5, 0xa6, 0x45, 0x49, 0xe4, 0x23, 0x45, 0x51, 0xd0, 0x17, 0x24, 0xdb, 0x94, 0xcc, 0xd8, 0x89,
+	0x9c, 0x38, 0x94, 0x2d, 0x3b, 0xdf, 0xf9, 0x4d, 0xc6, 0x96, 0xed, 0x84, 0x9c, 0xc4, 0x71, 0x20,
+	0x25, 0xce, 0xe4, 0x37, 0x19, 0x0e, 0x04, 0x2c, 0x25, 0x44, 0x20, 0x40, 0x03, 0x0b, 0xc9, 0xcc,
+	0xf4, 0x90, 0x43, 0xa7, 0xb9, 0xe6, 0xd8, 0x6b, 0x6f, 0xfd, 0x07, 0xfa, 0x07, 0x74, 0x7a, 0xe9,
+	0xa9, 0x93, 0x63, 0x7a, 0x69, 0x1b, 0xf9, 0xd2, 0x4b, 0xa7, 0x39, 0xf7, 0xd4, 0xd9, 0xb7, 0xbb,
+	0xe0, 0x02, 0x84, 0x64, 0xca, 0x8d, 0x0f, 0xb9, 0x01, 0xef, 0x0b, 0x6f, 0x1f, 0xde, 0x37, 0x00,
+	0x37, 0x28, 0xe9, 0xf6, 0xfc, 0xc0, 0x74, 0xd7, 0x43, 0x12, 0x1c, 0x90, 0x60, 0xdd, 0xec, 0x39,
+	0xeb, 0x3d, 0x12, 0x84, 0x4e, 0x48, 0x89, 0x67, 0x91, 0xf5, 0x83, 0xeb, 0xeb, 0xe4, 0x31, 0xb1,
+	0x22, 0xea, 0xf8, 0x5e, 0xd8, 0xe8, 0x05, 0x3e, 0xf5, 0xb5, 0xba, 0x64, 0x6a, 0x70, 0xa6, 0x86,
+	0xd9, 0x73, 0x1a, 0x0a, 0x53, 0xe3, 0xe0, 0xfa, 0x72, 0x6d, 0xd7, 0xf7, 0x77, 0x5d, 0xb2, 0x8e,
+	0x1c, 0x3b, 0x51, 0x67, 0xdd, 0x8e, 0x02, 0x93, 0x09, 0xe1, 0x32, 0x96, 0x57, 0xd2, 0x78, 0xea,
+	0x74, 0x49, 0x48, 0xcd, 0x6e, 0x4f, 0x10, 0x5c, 0xb4, 0x49, 0x8f, 0x78, 0x36, 0xf1, 0x2c, 0x87,
+	0x84, 0xeb, 0xbb, 0xfe, 0xae, 0x8f, 0x70, 0xbc, 0x12, 0x24, 0x97, 0x62, 0xe5, 0x99, 0xd6, 0x96,
+	0xdf, 0xed, 0xfa, 0x1e, 0x53, 0xb8, 0x4b, 0xc2, 0xd0, 0xdc, 0x25, 0x99, 0x54, 0xc4, 0x8b, 0xba,
+	0x21, 0x23, 0x3a, 0xf4, 0x83, 0xfd, 0x8e, 0xeb, 0x1f, 0x0a, 0xaa, 0xcb, 0x09, 0xaa, 0x8e, 0xe9,
+	0xb8, 0x51, 0x40, 0x86, 0x85, 0x25, 0xc9, 0xf6, 0x9c, 0x90, 0xfa, 0x41, 0x7f, 0x98, 0xec, 0xc5,
+	0x04, 0x99, 0x7c, 0xd4, 0x30, 0xdd, 0x95, 0x2c, 0xf3, 0xc7, 0x2a, 0xf2, 0x13, 0x09, 0xd2, 0x57,
+	0x4e, 0x24, 0x4d, 0x9d, 0xe6, 0xa5, 0x13, 0x89, 0xa9, 0x19, 0xee, 0x0b, 0xc2, 0xab, 0x59, 0x84,
+	0xc7, 0x1d, 0xab, 0xfe, 0x37, 0x80, 0xe2, 0xd6, 0x9e, 0x19, 0xd8, 0x4d, 0xaf, 0xe3, 0x6b, 0x4b,
+	0x50, 0x08, 0xd9, 0x4d, 0xdb, 0xb1, 0xf5, 0xdc, 0x6a, 0x6e, 0x6d, 0xdc, 0x98, 0xc4, 0xfb, 0xa6,
+	0xcd, 0x50, 0x81, 0xe9, 0xed, 0x12, 0x86, 0x3a, 0xbb, 0x9a, 0x5b, 0x1b, 0x33, 0x26, 0xf1, 0xbe,
+	0x69, 0x6b, 0x73, 0x30, 0xee, 0x1f, 0x7a, 0x24, 0xd0, 0xc7, 0x56, 0x73, 0x6b, 0x45, 0x83, 0xdf,
+	0x68, 0x1b, 0x30, 0x1f, 0x90, 0x9e, 0xeb, 0x58, 0xe8, 0x23, 0x6d, 0xd3, 0xda, 0x6f, 0xbb, 0xe4,
+	0x80, 0xb8, 0x7a, 0x1e, 0xb9, 0x67, 0x15, 0xe4, 0x2d, 0x6b, 0xff, 0x43, 0x86, 0xd2, 0xae, 0x82,
+	0x46, 0x03, 0xd3, 0x0b, 0x3b, 0x24, 0x50, 0x18, 0xc6, 0x91, 0xa1, 0x2a, 0x31, 0x2a, 0x75, 0x48,
+	0x7d, 0x97, 0x78, 0xed, 0xd0, 0xf1, 0x2c, 0xd2, 0x0e, 0x88, 0x47, 0x0e, 0xf5, 0x09, 0xd4, 0xbb,
+	0xca, 0x31, 0x5b, 0x0c, 0x61, 0x30, 0xb8, 0x76, 0x0b, 0x4a, 0x51, 0xcf, 0x36, 0x29, 0x69, 0x33,
+	0xbf, 0xd4, 0x27, 0x57, 0x73, 0x6b, 0xa5, 0x8d, 0xe5, 0x06, 0x77, 0xda, 0x86, 0x74, 0xda, 0xc6,
+	0xb6, 0x74, 0xda, 0xdb, 0xf9, 0xef, 0xfe, 0xbe, 0x92, 0x33, 0x80, 0x33, 0x31, 0xb0, 0xf6, 0x09,
+	0xcc, 0x31, 0x5e, 0x45, 0x37, 0x2e, 0xab, 0x30, 0xa2, 0xac, 0x19, 0xe4, 0x96, 0xfa, 0xa3, 0xc8,
+	0x3b, 0x50, 0xf3, 0xcc, 0x2e, 0x09, 0x7b, 0xa6, 0x45, 0xda, 0x9e, 0x4f, 0x9d, 0x8e, 0x34, 0xd8,
+	0x01, 0x8b, 0x3e, 0xdf, 0xd3, 0x8b, 0x78, 0xfa, 0xf3, 0x31, 0xd5, 0x7d, 0x85, 0xe8, 0x33, 0x4e,
+	0xa3, 0x7d, 0x9b, 0x83, 0x65, 0xcb, 0x8d, 0x42, 0x4a, 0x82, 0x76, 0x86, 0x01, 0x61, 0x75, 0x6c,
+	0xad, 0xb4, 0xd1, 0x6a, 0x3c, 0x3d, 0xc8, 0x1b, 0xb1, 0x2f, 0x34, 0x36, 0xb9, 0xbc, 0xed, 0x94,
+	0xd5, 0xef, 0x7a, 0x34, 0xe8, 0x1b, 0x8b, 0x56, 0x36, 0x56, 0xfb, 0x75, 0x0e, 0x16, 0x63, 0x4d,
+	0x92, 0xb6, 0xd2, 0x4b, 0xa8, 0xc6, 0xfb, 0xcf, 0xa6, 0x86, 0xd3, 0x4d, 0xe9, 0x20, 0x6c, 0x3a,
+	0x67, 0x65, 0x10, 0x68, 0xbf, 0xc9, 0xc1, 0x92, 0x54, 0x43, 0xf5, 0x42, 0xae, 0x48, 0xf9, 0x7f,
+	0xb0, 0x87, 0x31, 0x90, 0x96, 0x61, 0x8f, 0x34, 0x96, 0xd9, 0x63, 0x49, 0x55, 0xc0, 0x76, 0x1f,
+	0x29, 0x16, 0x99, 0x42, 0x45, 0x9a, 0xa7, 0x53, 0x44, 0x79, 0xc6, 0x1d, 0xf7, 0x51, 0xf2, 0xbd,
+	0x2c, 0x04, 0x99, 0x48, 0xed, 0x1a, 0xcc, 0x1d, 0x38, 0xa1, 0xb3, 0xe3, 0xb8, 0x0e, 0xed, 0x2b,
+	0x0a, 0x54, 0xd0, 0xb9, 0xb4, 0x01, 0x4e, 0x72, 0x2c, 0xb7, 0xe0, 0xfc, 0x49, 0x1e, 0xa0, 0x55,
+	0x61, 0x6c, 0x9f, 0xf4, 0x31, 0x4b, 0x14, 0x0d, 0x76, 0xc9, 0xd2, 0xc0, 0x81, 0xe9, 0x46, 0x44,
+	0xa4, 0x07, 0x7e, 0xf3, 0xf6, 0xd9, 0x37, 0x73, 0xcb, 0x16, 0x2c, 0x1d, 0xfb, 0x1a, 0x33, 0x04,
+	0x5d, 0x53, 0x05, 0x9d, 0x18, 0x57, 0xea, 0x43, 0x06, 0x0a, 0x67, 0xbe, 0xa2, 0x53, 0x29, 0xdc,
+	0x84, 0x73, 0x27, 0x58, 0xf9, 0x34, 0xa2, 0xea, 0x7f, 0xbd, 0x00, 0xf3, 0x0f, 0x45, 0x2a, 0xbf,
+	0x2b, 0xcb, 0x2e, 0x26, 0xdb, 0x8b, 0x50, 0x1e, 0x84, 0xbe, 0x48, 0xb8, 0x45, 0xa3, 0x14, 0xc3,
+	0x9a, 0xb6, 0xb6, 0x02, 0x25, 0x59, 0x06, 0x64, 0xde, 0x2d, 0x1a, 0x20, 0x41, 0x4d, 0x5b, 0x6b,
+	0xc0, 0x6c, 0xcf, 0x0c, 0x88, 0x47, 0xdb, 0x09, 0x51, 0x3c, 0x11, 0xcf, 0x70, 0xd4, 0x7d, 0x45,
+	0xe0, 0x55, 0xd0, 0x04, 0xbd, 0x2a, 0x37, 0x8f, 0xe4, 0x55, 0x8e, 0x79, 0x38, 0x90, 0x5e, 0x87,
+	0x29, 0x41, 0x1d, 0x44, 0x1e, 0x23, 0x1c, 0xe7, 0x2a, 0x72, 0xa0, 0x11, 0x79, 0x4d, 0x9b, 0x9d,
+	0xc2, 0xf1, 0x1c, 0xea, 0x98, 0x94, 0x60, 0xd9, 0x98, 0x40, 0x03, 0x94, 0x62, 0x58, 0xd3, 0xd6,
+	0xde, 0x82, 0x25, 0xcb, 0xef, 0xf6, 0x5c, 0x82, 0x11, 0x40, 0x0e, 0x98, 0xc0, 0x1d, 0x93, 0x5a,
+	0x7b, 0x8c, 0x7e, 0x12, 0xe9, 0x17, 0x06, 0x04, 0x77, 0x19, 0xfe, 0x36, 0x43, 0x37, 0x6d, 0xed,
+	0x01, 0x54, 0xd3, 0xac, 0x22, 0xdb, 0x5e, 0x1e, 0x04, 0x0d, 0x8b, 0x16, 0x51, 0xe0, 0x58, 0xa4,
+	0x7c, 0xc0, 0x2f, 0x51, 0x8e, 0x31, 0x9d, 0x12, 0xac, 0x5d, 0x00, 0x60, 0xc5, 0xb2, 0xfd, 0x28,
+	0x22, 0x11, 0xc1, 0xe4, 0x5a, 0x34, 0x8a, 0x0c, 0xf2, 0x09, 0x03, 0x30, 0x03, 0xc5, 0x96, 0xa1,
+	0xfd, 0x1e, 0x41, 0xbb, 0xea, 0xc0, 0x0d, 0x24, 0x31, 0xdb, 0xfd, 0x1e, 0x61, 0x56, 0xd5, 0xbe,
+	0x84, 0xe5, 0x98, 0x3a, 0xee, 0xa9, 0x30, 0xef, 0xf9, 0x11, 0xd5, 0x4b, 0xa8, 0xe8, 0xd2, 0x90,
+	0xfb, 0xde, 0x11, 0x7d, 0xd3, 0xed, 0xfc, 0x6f, 0x59, 0x06, 0xd3, 0x0f, 0xd3, 0xee, 0xb1, 0xcd,
+	0x05, 0xb0, 0x7a, 0x13, 0x8b, 0x0f, 0xa2, 0x81, 0xe0, 0xf2, 0x68, 0x82, 0xe3, 0x93, 0x18, 0x51,
+	0x2c, 0x72, 0x07, 0x2e, 0xd8, 0xa4, 0x63, 0x46, 0xae, 0xe2, 0x01, 0x68, 0x0f, 0x29, 0x7b, 0x6a,
+	0x34, 0xd9, 0xcb, 0x42, 0x8a, 0xf4, 0x96, 0x6d, 0x33, 0xdc, 0x97, 0xcf, 0x78, 0x01, 0xa6, 0x42,
+	0x6a, 0x06, 0x34, 0x2e, 0x61, 0x3c, 0xcb, 0x94, 0x11, 0x28, 0x4b, 0xd6, 0x2b, 0xa0, 0xb9, 0x66,
+	0x48, 0x85, 0x3b, 0xa0, 0x0a, 0x8e, 0xad, 0xcf, 0x20, 0xe5, 0x34, 0xc3, 0xe0, 0xeb, 0x62, 0x62,
+	0x9b, 0xb6, 0xf6, 0x2a, 0xcc, 0x22, 0x71, 0xc7, 0x09, 0x62, 0x16, 0xc7, 0xd6, 0x35, 0xde, 0x18,
+	0x30, 0xd4, 0x3d, 0x27, 0x10, 0x2c, 0x4d, 0x5b, 0x7b, 0x17, 0xce, 0x21, 0x79, 0xf2, 0x84, 0x5c,
+	0x27, 0xc7, 0xd6, 0x67, 0x91, 0x6d, 0x91, 0x91, 0xa8, 0xea, 0x6f, 0x31, 0x7c, 0xd3, 0xd6, 0xde,
+	0x03, 0xe0, 0xa4, 0x58, 0xdb, 0xe7, 0x46, 0xac, 0xed, 0x45, 0xe4, 0x61, 0x50, 0xad, 0x05, 0xa8,
+	0x52, 0x5b, 0x6d, 0x37, 0xe6, 0x47, 0x14, 0x53, 0x61, 0x9c, 0x9f, 0x0e, 0x5a, 0x8e, 0x0d, 0x98,
+	0x4f, 0x9e, 0x42, 0xda, 0x74, 0x81, 0x77, 0x51, 0x87, 0xca, 0x01, 0xa4, 0x69, 0xdf, 0x82, 0xa5,
+	0xd4, 0xc9, 0xad, 0x3d, 0x62, 0x47, 0x2e, 0xa6, 0x86, 0x45, 0x1e, 0x6f, 0x2a, 0xdf, 0x96, 0x40,
+	0x37, 0x6d, 0xed, 0x0d, 0xd0, 0x33, 0x8c, 0xc6, 0x23, 0x5b, 0x47, 0xce, 0xf9, 0xc3, 0xb4, 0xc9,
+	0x30, 0xc6, 0xb7, 0xd2, 0x7a, 0x4a, 0x7f, 0x5a, 0x1a, 0xcd, 0x9f, 0x12, 0x07, 0x91, 0x8e, 0x34,
+	0x74, 0x78, 0x93, 0xb2, 0xa0, 0xa7, 0xfa, 0x32, 0xf6, 0x78, 0x09, 0x9e, 0x5b, 0x1c, 0x95, 0x08,
+	0xc9, 0xc4, 0x09, 0xf0, 0x35, 0x9c, 0x1b, 0xf1, 0x35, 0x2c, 0x66, 0x9c, 0x12, 0xdf, 0x87, 0x09,
+	0xe7, 0xb3, 0x6d, 0x2b, 0x1e, 0x70, 0x7e, 0xc4, 0x07, 0x2c, 0x65, 0xbd, 0x00, 0xfe, 0x88, 0x2b,
+	0x50, 0xb5, 0x4c, 0xcf, 0x22, 0x6e, 0x3b, 0x20, 0x8f, 0x22, 0x12, 0x52, 0x62, 0xeb, 0x17, 0x56,
+	0x73, 0x6b, 0x05, 0x63, 0x9a, 0xc3, 0x0d, 0x09, 0xd6, 0x02, 0xb8, 0x9c, 0xd4, 0xc6, 0x0f, 0x9c,
+	0x5d, 0xc7, 0x33, 0xdd, 0xb4, 0x5a, 0xb5, 0x11, 0xd5, 0xba, 0xa8, 0xaa, 0xf5, 0xb1, 0x10, 0x96,
+	0x54, 0x6f, 0xc8, 0x45, 0x84, 0x96, 0xcc, 0x45, 0x56, 0x30, 0x4f, 0x26, 0x5c, 0x44, 0x28, 0xdb,
+	0xb4, 0xb5, 0x97, 0x61, 0x26, 0x79, 0x2e, 0xc6, 0xb1, 0x8a, 0x1c, 0xc9, 0x83, 0x71, 0xda, 0x90,
+	0x3a, 0xd6, 0x7e, 0xbf, 0xad, 0x24, 0xeb, 0x8b, 0x9c, 0x96, 0x23, 0xb6, 0xe3, 0x94, 0xbd, 0x0b,
+	0xab, 0x82, 0x36, 0xf6, 0x73, 0xea, 0xb7, 0x07, 0x21, 0xcc, 0xbc, 0xb0, 0x3e, 0x9a, 0x17, 0x9e,
+	0xe7, 0x82, 0xe4, 0x81, 0xb7, 0xfd, 0x2d, 0x19, 0xd4, 0xcc, 0x1d, 0x75, 0x98, 0x94, 0x0e, 0xf8,
+	0x02, 0x1f, 0x8e, 0xc4, 0xad, 0xf6, 0x29, 0x2c, 0x04, 0x84, 0x06, 0xfd, 0x36, 0x2f, 0x7b, 0x6e,
+	0xdb, 0xf1, 0x28, 0x09, 0x0e, 0x4c, 0x57, 0xbf, 0x34, 0xda, 0x83, 0xe7, 0x90, 0xbd, 0xc9, 0xb9,
+	0x9b, 0x82, 0x79, 0x20, 0xb6, 0x6b, 0x3e, 0x76, 0xba, 0x51, 0x77, 0x20, 0xf6, 0xf2, 0x69, 0xc4,
+	0x7e, 0xc4, 0xb9, 0x63, 0xb1, 0x37, 0xd3, 0x62, 0xc5, 0x31, 0x42, 0xfd, 0x45, 0x3c, 0x56, 0x82,
+	0x4b, 0xc4, 0x55, 0xa8, 0xbd, 0x0d, 0x4b, 0x9c, 0x6b, 0xc7, 0xb4, 0xf6, 0xfd, 0x4e, 0xa7, 0x6d,
+	0xf9, 0xa4, 0xd3, 0x71, 0x2c, 0x87, 0xd5, 0xe4, 0x97, 0x56, 0x73, 0x6b, 0x39, 0x63, 0x11, 0x09,
+	0x6e, 0x73, 0xfc, 0xe6, 0x00, 0xad, 0x75, 0xa1, 0x9e, 0x51, 0x27, 0xc9, 0xe3, 0x9e, 0xc3, 0xd5,
+	0xe5, 0x4e, 0xba, 0x36, 0xa2, 0x93, 0xae, 0x0c, 0x15, 0xcc, 0xbb, 0xb1, 0x24, 0x31, 0x54, 0xad,
+	0x70, 0x55, 0x3d, 0xdf, 0x6b, 0xe3, 0x95, 0xb9, 0xe3, 0x92, 0x36, 0x09, 0x02, 0x3f, 0xc0, 0xaa,
+	0x1e, 0xea, 0x57, 0x56, 0xc7, 0xd6, 0x8a, 0xc6, 0x39, 0x44, 0xde, 0xf7, 0x3d, 0x43, 0x12, 0xdd,
+	0x65, 0x34, 0xac, 0xbe, 0x87, 0xda, 0x1a, 0x54, 0xf7, 0xcc, 0x90, 0xf3, 0xb7, 0x7b, 0xbe, 0xeb,
+	0x58, 0x7d, 0xfd, 0x65, 0x8c, 0xc3, 0xca, 0x9e, 0x19, 0x22, 0xc7, 0x03, 0x84, 0xb2, 0x82, 0x67,
+	0x05, 0xbe, 0x17, 0xfb, 0x9f, 0xfe, 0x0a, 0x7a, 0x6a, 0x99, 0x01, 0xa5, 0x2f, 0xb1, 0x46, 0x29,
+	0x74, 0x76, 0x59, 0x6c, 0x5a, 0x7e, 0xe4, 0x51, 0xbd, 0xc1, 0x1b, 0x25, 0x0e, 0xdb, 0x64, 0x20,
+	0xed, 0x32, 0x94, 0x45, 0x1f, 0xd3, 0x0e, 0x9d, 0xaf, 0x89, 0xbe, 0xce, 0x48, 0x6e, 0x9f, 0xd5,
+	0x73, 0x46, 0x49, 0xc0, 0xb7, 0x9c, 0xaf, 0xd9, 0x18, 0x3a, 0x63, 0x46, 0xd4, 0x6f, 0x07, 0x24,
+	0x24, 0xb4, 0xdd, 0xf3, 0x1d, 0x8f, 0x86, 0xfa, 0x8d, 0xac, 0xae, 0x28, 0xde, 0x21, 0x1c, 0x5c,
+	0x6f, 0x18, 0x8c, 0xfa, 0x01, 0x12, 0x1b, 0xd3, 0x8c, 0x5f, 0x01, 0x68, 0xbf, 0x82, 0x99, 0x90,
+	0x98, 0x81, 0xb5, 0xc7, 0x7c, 0x21, 0x70, 0x76, 0x22, 0x4a, 0x42, 0xfd, 0x26, 0x4e, 0x27, 0x1f,
+	0x8f, 0x32, 0x9d, 0x64, 0x76, 0xb8, 0x8d, 0x2d, 0x14, 0x79, 0x2b, 0x96, 0xc8, 0x67, 0x94, 0x6a,
+	0x98, 0x02, 0x6b, 0x0f, 0x21, 0xdf, 0x25, 0x5d, 0x5f, 0x7f, 0x0d, 0x1f, 0xb8, 0xf9, 0xec, 0x0f,
+	0xfc, 0x88, 0x74, 0x7d, 0xfe, 0x10, 0x14, 0xa8, 0x7d, 0x09, 0x33, 0xa2, 0x5e, 0xb6, 0xb9, 0x01,
+	0x1d, 0x12, 0xea, 0xaf, 0xa3, 0xa5, 0xae, 0x65, 0x3e, 0x45, 0x69, 0x23, 0x45, 0x35, 0xfd, 0x40,
+	0xf2, 0x19, 0xd5, 0x83, 0x14, 0x44, 0xbb, 0x01, 0x0b, 0xa2, 0x23, 0x89, 0x7d, 0x5a, 0x34, 0xca,
+	0x6f, 0xa0, 0x03, 0xcc, 0x22, 0x36, 0x56, 0x91, 0x37, 0xcc, 0xff, 0x0f, 0xd3, 0x03, 0xf2, 0x90,
+	0x9a, 0x34, 0xd4, 0xdf, 0x44, 0x8d, 0x36, 0x46, 0x39, 0x77, 0x2c, 0x6c, 0x8b, 0x71, 0x1a, 0x15,
+	0x92, 0xb8, 0x4f, 0x94, 0xa7, 0x20, 0x1a, 0x0e, 0xb1, 0xb7, 0x4e, 0x5b, 0x9e, 0x8c, 0x28, 0x1d,
+	0x5c, 0x37, 0x61, 0x71, 0xa8, 0x17, 0xa3, 0x8f, 0xf1, 0xd4, 0x6f, 0xf3, 0x9e, 0x24, 0xd9, 0x8f,
+	0x6d, 0x3f, 0x66, 0xa7, 0xbe, 0x09, 0x0b, 0xec, 0xac, 0x84, 0xaf, 0x27, 0x1c, 0xd4, 0x88, 0xc7,
+	0xc1, 0x3b, 0xc8, 0x34, 0x87, 0xd8, 0xed, 0x18, 0xc9, 0x03, 0xe2, 0x7d, 0xa8, 0x24, 0xdb, 0x6a,
+	0xfd, 0xdd, 0x11, 0x0f, 0x30, 0x45, 0xd4, 0x66, 0x5a, 0xfb, 0x0a, 0x2e, 0x26, 0x8b, 0x16, 0x1e,
+	0x61, 0x8f, 0x98, 0x01, 0xdd, 0x21, 0xa6, 0x68, 0xf5, 0xfe, 0x6f, 0x44, 0xd9, 0x17, 0xd4, 0xfa,
+	0xf6, 0xa1, 0x19, 0xd2, 0x0f, 0xa4, 0x1c, 0x46, 0xb9, 0x6c, 0xc3, 0x7c, 0xa6, 0xe3, 0x67, 0x8c,
+	0x8d, 0xaf, 0x25, 0x27, 0xdd, 0x95, 0x64, 0xf4, 0x8a, 0x65, 0xe1, 0xc1, 0xf5, 0xc6, 0x03, 0xb3,
+	0xef, 0xfa, 0xa6, 0xad, 0x8e, 0xa8, 0x9f, 0x43, 0x31, 0xf6, 0xf6, 0x9f, 0x55, 0x72, 0x2b, 0x5f,
+	0x98, 0xae, 0x56, 0x5b, 0xf9, 0x42, 0xb5, 0x3a, 0xd3, 0xca, 0x17, 0xae, 0x56, 0x5f, 0x6d, 0xe5,
+	0x0b, 0xaf, 0x56, 0x1b, 0xad, 0x7c, 0xe1, 0x5a, 0xf5, 0x7a, 0x2b, 0x5f, 0xb8, 0x5e, 0xdd, 0x68,
+	0xe5, 0x0b, 0x1b, 0xd5, 0x1b, 0xf5, 0x1b, 0x50, 0x49, 0xfa, 0x23, 0x4b, 0x72, 0x89, 0x0c, 0x96,
+	0xe3, 0x49, 0x4e, 0xc9, 0x5e, 0xf5, 0x7f, 0xe7, 0x60, 0x61, 0x28, 0x7a, 0x19, 0x37, 0xc1, 0x0e,
+	0x21, 0x20, 0xcc, 0x4b, 0x94, 0x0e, 0x21, 0x27, 0x3a, 0x04, 0x44, 0x0c, 0x3a, 0x84, 0x79, 0x98,
+	0x10, 0xb1, 0xc6, 0xa7, 0xe2, 0xf1, 0x00, 0xa3, 0xab, 0x05, 0xe3, 0xe8, 0x49, 0x38, 0x02, 0x57,
+	0x36, 0x6e, 0x66, 0xc6, 0x14, 0xae, 0x4d, 0x33, 0xb3, 0x08, 0xea, 0x61, 0x70, 0x11, 0xda, 0x3d,
+	0x98, 0x60, 0x17, 0x51, 0x88, 0x03, 0x72, 0x65, 0xa3, 0x91, 0x34, 0xe2, 0xc9, 0x52, 0xa2, 0xd0,
+	0x10, 0xdc, 0xf5, 0x27, 0xe3, 0x50, 0x95, 0x4b, 0x14, 0x1c, 0x68, 0x7e, 0xae, 0xe9, 0x7f, 0x60,
+	0x83, 0x31, 0xd5, 0x06, 0x9b, 0x50, 0xe4, 0x2d, 0x78, 0xbf, 0x47, 0x84, 0xea, 0x2f, 0x9e, 0x6c,
+	0x07, 0x6c, 0xba, 0xfb, 0x3d, 0x62, 0x14, 0xa8, 0xb8, 0x62, 0x9b, 0x05, 0x6a, 0x06, 0xbb, 0x24,
+	0xb5, 0x59, 0xe0, 0x1b, 0x80, 0x19, 0x8e, 0x4a, 0x6d, 0x16, 0x04, 0xbd, 0xaa, 0xf3, 0x04, 0x1f,
+	0x9c, 0x39, 0x26, 0xb9, 0x59, 0x10, 0xd4, 0xe2, 0x00, 0x93, 0xfc, 0xf8, 0x1c, 0xc8, 0x13, 0x65,
+	0x72, 0x52, 0x2f, 0xa4, 0x27, 0xf5, 0x77, 0x60, 0x59, 0x88, 0xb0, 0xf6, 0x1c, 0xd7, 0x1e, 0x3c,
+	0xd6, 0xf7, 0xdc, 0x3e, 0x0e, 0xf6, 0x05, 0x63, 0x91, 0x53, 0x6c, 0x32, 0x02, 0xf9, 0xf4, 0x8f,
+	0x3d, 0xb7, 0xcf, 0x4c, 0xab, 0x0e, 0x45, 0x80, 0x6e, 0x0a, 0xe1, 0x60, 0x10, 0xd2, 0x61, 0x52,
+	0x4e, 0x5a, 0x25, 0x44, 0xca, 0x5b, 0x6d, 0x11, 0x26, 0xe5, 0xb4, 0x5a, 0x46, 0xcc, 0x04, 0xe5,
+	0x43, 0x6a, 0x13, 0xa6, 0x95, 0x1d, 0x1b, 0x66, 0x94, 0xa9, 0x51, 0xa7, 0xbe, 0x01, 0x23, 0x43,
+	0x69, 0xfb, 0x30, 0x45, 0x03, 0x66, 0x71, 0xcb, 0xf7, 0x28, 0x79, 0x4c, 0xf5, 0x69, 0xac, 0x8c,
+	0xf7, 0x46, 0xa9, 0x10, 0x69, 0x4f, 0x63, 0x00, 0x8b, 0x6c, 0x72, 0x41, 0xbc, 0x38, 0x96, 0xa9,
+	0x02, 0x5a, 0x7e, 0x0f, 0x66, 0x86, 0x48, 0x9e, 0xb6, 0xe2, 0x2a, 0x26, 0x13, 0x46, 0xa5, 0x3a,
+	0x5d, 0xff, 0xe3, 0x18, 0xcc, 0x2a, 0x4b, 0xb3, 0x5f, 0x8c, 0xa3, 0x2b, 0x6f, 0x7a, 0x3c, 0xf9,
+	0xa6, 0x2f, 0x41, 0x25, 0xb5, 0x70, 0xe0, 0xcb, 0xad, 0x72, 0x47, 0x5d, 0x36, 0xd4, 0x61, 0xca,
+	0x23, 0x8f, 0x15, 0x22, 0xbe, 0xd1, 0x2a, 0x31, 0xa0, 0xa4, 0x61, 0xbd, 0x5f, 0x3c, 0x90, 0x39,
+	0xb6, 0x5e, 0x10, 0xbd, 0x9f, 0x84, 0x71, 0x92, 0x9d, 0xc0, 0xf4, 0xac, 0xbd, 0x36, 0xf5, 0xf7,
+	0x09, 0xf7, 0xba, 0xb2, 0x51, 0xe2, 0xb0, 0x6d, 0x06, 0xd2, 0xd6, 0x61, 0xce, 0x23, 0xbc, 0xae,
+	0x27, 0x48, 0xa7, 0x90, 0x74, 0xc6, 0x23, 0xac, 0x5a, 0xdf, 0x56, 0x18, 0x14, 0x57, 0x9d, 0x56,
+	0x5d, 0xb5, 0x95, 0x2f, 0x14, 0xab, 0xd0, 0xca, 0x17, 0xa0, 0x5a, 0x6a, 0xe5, 0x0b, 0xe5, 0xea,
+	0x94, 0x78, 0x87, 0x7f, 0x39, 0x0b, 0xda, 0x67, 0x03, 0x57, 0xfc, 0xe5, 0xbf, 0x42, 0xc5, 0x02,
+	0x13, 0x4f, 0x0b, 0xd6, 0xc9, 0x67, 0x0c, 0xd6, 0x65, 0x28, 0xb0, 0x91, 0xa8, 0xe3, 0xb8, 0x2e,
+	0xbe, 0xd8, 0x82, 0x11, 0xdf, 0xd7, 0x7f, 0x97, 0x87, 0x29, 0x46, 0xf4, 0xcb, 0xc9, 0xfb, 0x77,
+	0xa1, 0x2c, 0x86, 0x66, 0x2e, 0x67, 0x1c, 0xe5, 0xd4, 0x8f, 0x29, 0x7d, 0x62, 0x34, 0x46, 0x19,
+	0x25, 0x3a, 0xb8, 0xd1, 0x88, 0xb2, 0xba, 0x91, 0x03, 0x23, 0xca, 0x9b, 0x40, 0x79, 0xd7, 0x47,
+	0xab, 0xcb, 0x62, 0x94, 0x44, 0xf1, 0xb3, 0x87, 0xc3, 0x40, 0xf5, 0xcd, 0x4f, 0x26, 0xdf, 0xfc,
+	0x15, 0xa8, 0xc6, 0x19, 0x5e, 0x4e, 0xed, 0x05, 0x1c, 0x6f, 0xa7, 0x25, 0x5c, 0xae, 0x8c, 0x96,
+	0xa0, 0x10, 0x07, 0x2f, 0xff, 0xda, 0x36, 0x49, 0x44, 0xe0, 0x2a, 0xfe, 0x03, 0x4f, 0xf3, 0x9f,
+	0xd2, 0xb3, 0xf9, 0x4f, 0xfd, 0x3f, 0xd3, 0x50, 0xbe, 0x65, 0x51, 0xe7, 0xc0, 0xa1, 0x7d, 0x74,
+	0x11, 0xe5, 0x50, 0xb9, 0xe4, 0xa1, 0xde, 0x00, 0x7d, 0x90, 0x47, 0x52, 0x8b, 0x74, 0xfe, 0xe5,
+	0x61, 0x3e, 0xc6, 0x27, 0xf6, 0xe8, 0xf7, 0x61, 0x3a, 0xc5, 0xa8, 0x8f, 0x65, 0x0d, 0x8c, 0xc7,
+	0xad, 0xd1, 0x2b, 0x49, 0xb1, 0xac, 0x31, 0x4f, 0x6d, 0x98, 0xf2, 0xa3, 0x36, 0xe6, 0x61, 0x62,
+	0x9b, 0x74, 0x41, 0x2c, 0x5b, 0x79, 0x5e, 0xe4, 0xd1, 0x5b, 0x0c, 0xe3, 0xb5, 0x62, 0x4b, 0xac,
+	0x92, 0x63, 0xad, 0x27, 0x4e, 0xa3, 0x75, 0x59, 0xf0, 0x72, 0x9d, 0x37, 0xa1, 0x9c, 0xd8, 0x05,
+	0x8e, 0x1a, 0xef, 0xa5, 0x50, 0xd9, 0xff, 0xad, 0x40, 0xc9, 0x14, 0xef, 0x4a, 0x26, 0xf2, 0xa2,
+	0x01, 0x12, 0xc4, 0xbb, 0x16, 0xa5, 0x79, 0x15, 0xdf, 0x17, 0x82, 0xb8, 0x6d, 0xfd, 0x02, 0x96,
+	0x8e, 0xdf, 0x52, 0xc1, 0x68, 0x5b, 0x9d, 0x85, 0x30, 0x7b, 0x3f, 0x95, 0x92, 0x6d, 0xb9, 0x7e,
+	0x48, 0x4e, 0xfb, 0x31, 0x42, 0x91, 0xbd, 0xc9, 0xf8, 0xa5, 0xec, 0x6d, 0x58, 0x10, 0xba, 0xa6,
+	0x05, 0x8f, 0xf8, 0x31, 0x62, 0x16, 0xd9, 0x53, 0x52, 0x3f, 0x84, 0x99, 0xe4, 0x0c, 0x76, 0x8a,
+	0x2f, 0x10, 0xd5, 0x3d, 0x75, 0xea, 0x62, 0xd2, 0xb2, 0x16, 0xa7, 0x95, 0xec, 0xc5, 0x69, 0xe6,
+	0x2e, 0x92, 0xd7, 0xc8, 0xac, 0x5d, 0x24, 0xff, 0x92, 0x2d, 0xd7, 0xc9, 0x6c, 0x22, 0xa8, 0xf2,
+	0x54, 0x42, 0x65, 0x6e, 0xe7, 0x2d, 0xbf, 0xba, 0x22, 0x9c, 0x49, 0xae, 0x08, 0x93, 0xdd, 0xac,
+	0x96, 0xee, 0x66, 0x59, 0xba, 0x8a, 0xe3, 0x80, 0x78, 0xd4, 0xa1, 0x7d, 0x7d, 0x56, 0xee, 0x3b,
+	0x45, 0x34, 0x70, 0x70, 0xe6, 0x5e, 0x6a, 0x2e, 0x73, 0x2f, 0x75, 0xfc, 0x5a, 0x72, 0xfe, 0xf9,
+	0xac, 0x25, 0x17, 0x9e, 0xcf, 0x5a, 0x72, 0xf1, 0x84, 0xb5, 0xe4, 0x36, 0xcc, 0x73, 0xae, 0xf4,
+	0xaa, 0x43, 0x1f, 0x31, 0xbc, 0x67, 0x91, 0x3d, 0xb5, 0xe4, 0x38, 0x71, 0xd9, 0xb9, 0x74, 0xf2,
+	0xb2, 0x73, 0x84, 0xed, 0xe3, 0xf2, 0xd3, 0xb7, 0x8f, 0xf7, 0x41, 0xe3, 0x52, 0xf8, 0xb2, 0x85,
+	0xff, 0xbd, 0x24, 0xbe, 0x5f, 0xac, 0x26, 0xd3, 0x9f, 0x40, 0xb2, 0xf4, 0x77, 0x8f, 0x5f, 0x1a,
+	0x55, 0xe4, 0x65, 0xbb, 0x09, 0x01, 0x61, 0xe3, 0x92, 0x22, 0x8f, 0xd5, 0x52, 0x12, 0x0c, 0x5c,
+	0xed, 0x3c, 0xba, 0xda, 0x62, 0xcc, 0xf5, 0x10, 0xf1, 0xb1, 0xcb, 0xa5, 0x9b, 0x96, 0x0b, 0x99,
+	0x4d, 0x8b, 0x3a, 0x51, 0xd5, 0x86, 0x26, 0xaa, 0xcf, 0x60, 0x21, 0xb5, 0x74, 0xb1, 0x09, 0x35,
+	0x1d, 0x37, 0xd4, 0x57, 0xb2, 0x0e, 0x35, 0xb4, 0xa2, 0x08, 0x8d, 0x39, 0x57, 0xdd, 0xb5, 0xdc,
+	0xe1, 0xdc, 0xec, 0x83, 0x4f, 0x4a, 0xae, 0xfa, 0xdd, 0x6d, 0x75, 0xd4, 0x0f, 0x3e, 0x09, 0xd9,
+	0xca, 0x07, 0x38, 0x29, 0x5e, 0x38, 0x63, 0x5b, 0x7c, 0x4f, 0x16, 0xe2, 0x2f, 0x9e, 0x46, 0xbc,
+	0xf0, 0xd9, 0x4d, 0x21, 0x01, 0x8b, 0xff, 0x9f, 0x72, 0x50, 0x64, 0x17, 0xc1, 0x53, 0x2a, 0x7f,
+	0xb2, 0x4e, 0x9e, 0x4d, 0xd7, 0xc9, 0x5b, 0x50, 0x42, 0xff, 0x17, 0xad, 0xc8, 0xd8, 0x88, 0x6a,
+	0x01, 0x67, 0x92, 0x95, 0x4d, 0x4d, 0x70, 0xfc, 0x2f, 0x2d, 0xa0, 0x83, 0xdc, 0xb6, 0x04, 0x05,
+	0x9e, 0x07, 0xe3, 0x35, 0xc0, 0x24, 0xde, 0x37, 0xed, 0xfa, 0xbf, 0xf2, 0xa0, 0xe1, 0x90, 0x9d,
+	0xfc, 0xc3, 0xe1, 0xc4, 0x46, 0x66, 0xf0, 0xd7, 0x40, 0x76, 0x23, 0x13, 0xe3, 0x13, 0x8d, 0x4c,
+	0xd2, 0x0e, 0x63, 0x69, 0x3b, 0xdc, 0x87, 0xe9, 0x94, 0x5c, 0x3d, 0x7f, 0x9a, 0x8e, 0xa1, 0x92,
+	0x7c, 0x2a, 0xdb, 0x82, 0xc8, 0xc7, 0xa9, 0x2d, 0xb9, 0xd8, 0x82, 0x08, 0x94, 0xb2, 0xd7, 0xb8,
+	0x04, 0x15, 0x49, 0x2f, 0x3a, 0x74, 0xbe, 0x01, 0x91, 0x9d, 0x87, 0x11, 0x79, 0x59, 0x5d, 0xcd,
+	0xe4, 0xb3, 0x77, 0x35, 0x99, 0x3b, 0xb3, 0x42, 0xf6, 0xce, 0xec, 0x3c, 0x14, 0xe3, 0x90, 0x95,
+	0xad, 0x49, 0x0c, 0x38, 0xe5, 0xaf, 0x0f, 0x9f, 0xc7, 0x7f, 0x9e, 0xf0, 0x76, 0x40, 0x14, 0xa2,
+	0x12, 0xb6, 0xf7, 0x6b, 0xc7, 0x8c, 0x0b, 0x0f, 0x90, 0x03, 0x5b, 0x00, 0x5e, 0xa2, 0xe4, 0x3f,
+	0x2a, 0x0a, 0x68, 0xe8, 0x8f, 0x92, 0xf2, 0xd0, 0x1f, 0x25, 0xf5, 0x3f, 0xe4, 0x60, 0x46, 0x1c,
+	0x6b, 0x13, 0xab, 0xf5, 0xf3, 0x72, 0xb7, 0xcc, 0x3e, 0x61, 0x2c, 0xfb, 0x9b, 0x65, 0x5a, 0xef,
+	0xfc, 0xb0, 0xde, 0xdf, 0x9e, 0x05, 0xd8, 0xc2, 0x0f, 0x3e, 0xcf, 0x31, 0x3e, 0x86, 0x34, 0x55,
+	0xda, 0x4f, 0x0d, 0xf2, 0xf8, 0x56, 0xf9, 0x1f, 0x3f, 0x78, 0xad, 0xbd, 0x0e, 0xe3, 0x8e, 0xd7,
+	0x8b, 0xa8, 0x3e, 0x3e, 0x62, 0x1e, 0xe6, 0xe4, 0x4c, 0x7b, 0xb6, 0x9e, 0x0a, 0x7c, 0x57, 0x38,
+	0xb9, 0xbc, 0x1d, 0xb2, 0xc4, 0xe4, 0xb0, 0x25, 0xbe, 0xc9, 0x41, 0x61, 0x73, 0x8f, 0x58, 0xfb,
+	0x61, 0xd4, 0x4d, 0xdb, 0x61, 0x7c, 0x60, 0x87, 0x3b, 0x30, 0xd1, 0x71, 0xcd, 0x03, 0x3f, 0xc0,
+	0x53, 0x57, 0x36, 0xae, 0x9e, 0x3c, 0x37, 0x4a, 0x89, 0xf7, 0x90, 0xc7, 0x10, 0xbc, 0x83, 0xd5,
+	0xd5, 0x18, 0x6e, 0x4a, 0xf8, 0xcd, 0xed, 0xaf, 0xbe, 0xff, 0xb1, 0x76, 0xe6, 0x87, 0x1f, 0x6b,
+	0x67, 0x7e, 0xfa, 0xb1, 0x96, 0xfb, 0xe6, 0xa8, 0x96, 0xfb, 0xfd, 0x51, 0x2d, 0xf7, 0xe7, 0xa3,
+	0x5a, 0xee, 0xfb, 0xa3, 0x5a, 0xee, 0x1f, 0x47, 0xb5, 0xdc, 0x3f, 0x8f, 0x6a, 0x67, 0x7e, 0x3a,
+	0xaa, 0xe5, 0xbe, 0x7b, 0x52, 0x3b, 0xf3, 0xfd, 0x93, 0xda, 0x99, 0x1f, 0x9e, 0xd4, 0xce, 0x7c,
+	0x71, 0x73, 0xd7, 0x1f, 0xe8, 0xe0, 0xf8, 0xc7, 0xff, 0x64, 0xfd, 0x8e, 0x72, 0xbb, 0x33, 0x81,
+	0x29, 0xf8, 0xc6, 0x7f, 0x07, 0x00, 0x53, 0x46, 0x81, 0x7b, 0x9d, 0x2d, 0x00, 0x00,
 }
 
 func (this *ShardInfo) Equal(that interface{}) bool {
@@ -2586,6 +2629,13 @@ func (this *WorkflowExecutionInfo) Equal(that interface{}) bool {
 	} else if !this.ExecutionTime.Equal(*that1.ExecutionTime) {
 		return false
 	}
+	if that1.WorkflowTaskLastHeartbeatTime == nil {
+		if this.WorkflowTaskLastHeartbeatTime != nil {
+			return false
+		}
+	} else if !this.WorkflowTaskLastHeartbeatTime.Equal(*that1.WorkflowTaskLastHeartbeatTime) {
+		return false
+	}
 	return true
 }
 func (this *ExecutionStats) Equal(that interface{}) bool {
@@ -2707,6 +2757,14 @@ func (this *TransferTaskInfo) Equal(that interface{}) bool {
 	} else if !this.VisibilityTime.Equal(*that1.VisibilityTime) {
 		return false
 	}
+	if len(this.TraceContext) != len(that1.TraceContext) {
+		return false
+	}
+	for i := range this.TraceContext {
+		if this.TraceContext[i] != that1.TraceContext[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *ReplicationTaskInfo) Equal(that interface{}) bool {
@@ -2807,6 +2865,9 @@ func (this *VisibilityTaskInfo) Equal(that interface{}) bool {
 	} else if !this.VisibilityTime.Equal(*that1.VisibilityTime) {
 		return false
 	}
+	if this.Backfill != that1.Backfill {
+		return false
+	}
 	return true
 }
 func (this *TimerTaskInfo) Equal(that interface{}) bool {
@@ -3039,6 +3100,13 @@ func (this *ActivityInfo) Equal(that interface{}) bool {
 	} else if !this.LastHeartbeatUpdateTime.Equal(*that1.LastHeartbeatUpdateTime) {
 		return false
 	}
+	if that1.LastAttemptCompleteTime == nil {
+		if this.LastAttemptCompleteTime != nil {
+			return false
+		}
+	} else if !this.LastAttemptCompleteTime.Equal(*that1.LastAttemptCompleteTime) {
+		return false
+	}
 	return true
 }
 func (this *TimerInfo) Equal(that interface{}) bool {
@@ -3318,7 +3386,7 @@ func (this *WorkflowExecutionInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 57)
+	s := make([]string, 0, 58)
 	s = append(s, "&persistence.WorkflowExecutionInfo{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
@@ -3405,6 +3473,7 @@ func (this *WorkflowExecutionInfo) GoString() string {
 	s = append(s, "LastFirstEventTxnId: "+fmt.Sprintf("%#v", this.LastFirstEventTxnId)+",\n")
 	s = append(s, "StateTransitionCount: "+fmt.Sprintf("%#v", this.StateTransitionCount)+",\n")
 	s = append(s, "ExecutionTime: "+fmt.Sprintf("%#v", this.ExecutionTime)+",\n")
+	s = append(s, "WorkflowTaskLastHeartbeatTime: "+fmt.Sprintf("%#v", this.WorkflowTaskLastHeartbeatTime)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -3435,7 +3504,7 @@ func (this *TransferTaskInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 17)
+	s := make([]string, 0, 18)
 	s = append(s, "&persistence.TransferTaskInfo{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
@@ -3450,6 +3519,19 @@ func (this *TransferTaskInfo) GoString() string {
 	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
 	s = append(s, "TaskId: "+fmt.Sprintf("%#v", this.TaskId)+",\n")
 	s = append(s, "VisibilityTime: "+fmt.Sprintf("%#v", this.VisibilityTime)+",\n")
+	keysForTraceContext := make([]string, 0, len(this.TraceContext))
+	for k, _ := range this.TraceContext {
+		keysForTraceContext = append(keysForTraceContext, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForTraceContext)
+	mapStringForTraceContext := "map[string]string{"
+	for _, k := range keysForTraceContext {
+		mapStringForTraceContext += fmt.Sprintf("%#v: %#v,", k, this.TraceContext[k])
+	}
+	mapStringForTraceContext += "}"
+	if this.TraceContext != nil {
+		s = append(s, "TraceContext: "+mapStringForTraceContext+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -3477,7 +3559,7 @@ func (this *VisibilityTaskInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 11)
+	s := make([]string, 0, 12)
 	s = append(s, "&persistence.VisibilityTaskInfo{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
@@ -3486,6 +3568,7 @@ func (this *VisibilityTaskInfo) GoString() string {
 	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
 	s = append(s, "TaskId: "+fmt.Sprintf("%#v", this.TaskId)+",\n")
 	s = append(s, "VisibilityTime: "+fmt.Sprintf("%#v", this.VisibilityTime)+",\n")
+	s = append(s, "Backfill: "+fmt.Sprintf("%#v", this.Backfill)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -3513,7 +3596,7 @@ func (this *ActivityInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 36)
+	s := make([]string, 0, 37)
 	s = append(s, "&persistence.ActivityInfo{")
 	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
 	s = append(s, "ScheduledEventBatchId: "+fmt.Sprintf("%#v", this.ScheduledEventBatchId)+",\n")
@@ -3555,6 +3638,7 @@ func (this *ActivityInfo) GoString() string {
 		s = append(s, "LastHeartbeatDetails: "+fmt.Sprintf("%#v", this.LastHeartbeatDetails)+",\n")
 	}
 	s = append(s, "LastHeartbeatUpdateTime: "+fmt.Sprintf("%#v", this.LastHeartbeatUpdateTime)+",\n")
+	s = append(s, "LastAttemptCompleteTime: "+fmt.Sprintf("%#v", this.LastAttemptCompleteTime)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -3828,8 +3912,8 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.ExecutionTime != nil {
-		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExecutionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExecutionTime):])
+	if m.WorkflowTaskLastHeartbeatTime != nil {
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskLastHeartbeatTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskLastHeartbeatTime):])
 		if err4 != nil {
 			return 0, err4
 		}
@@ -3838,6 +3922,18 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x3
 		i--
+		dAtA[i] = 0xea
+	}
+	if m.ExecutionTime != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExecutionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExecutionTime):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintExecutions(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x3
+		i--
 		dAtA[i] = 0xe2
 	}
 	if m.StateTransitionCount != 0 {
@@ -3855,12 +3951,12 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xd0
 	}
 	if m.WorkflowRunExpirationTime != nil {
-		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowRunExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowRunExpirationTime):])
-		if err5 != nil {
-			return 0, err5
+		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowRunExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowRunExpirationTime):])
+		if err6 != nil {
+			return 0, err6
 		}
-		i -= n5
-		i = encodeVarintExecutions(dAtA, i, uint64(n5))
+		i -= n6
+		i = encodeVarintExecutions(dAtA, i, uint64(n6))
 		i--
 		dAtA[i] = 0x3
 		i--
@@ -4020,12 +4116,12 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if m.WorkflowExecutionExpirationTime != nil {
-		n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowExecutionExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowExecutionExpirationTime):])
-		if err11 != nil {
-			return 0, err11
+		n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowExecutionExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowExecutionExpirationTime):])
+		if err12 != nil {
+			return 0, err12
 		}
-		i -= n11
-		i = encodeVarintExecutions(dAtA, i, uint64(n11))
+		i -= n12
+		i = encodeVarintExecutions(dAtA, i, uint64(n12))
 		i--
 		dAtA[i] = 0x2
 		i--
@@ -4047,24 +4143,24 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xb0
 	}
 	if m.RetryMaximumInterval != nil {
-		n12, err12 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryMaximumInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryMaximumInterval):])
-		if err12 != nil {
-			return 0, err12
+		n13, err13 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryMaximumInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryMaximumInterval):])
+		if err13 != nil {
+			return 0, err13
 		}
-		i -= n12
-		i = encodeVarintExecutions(dAtA, i, uint64(n12))
+		i -= n13
+		i = encodeVarintExecutions(dAtA, i, uint64(n13))
 		i--
 		dAtA[i] = 0x2
 		i--
 		dAtA[i] = 0xaa
 	}
 	if m.RetryInitialInterval != nil {
-		n13, err13 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryInitialInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryInitialInterval):])
-		if err13 != nil {
-			return 0, err13
+		n14, err14 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryInitialInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryInitialInterval):])
+		if err14 != nil {
+			return 0, err14
 		}
-		i -= n13
-		i = encodeVarintExecutions(dAtA, i, uint64(n13))
+		i -= n14
+		i = encodeVarintExecutions(dAtA, i, uint64(n14))
 		i--
 		dAtA[i] = 0x2
 		i--
@@ -4078,12 +4174,12 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x98
 	}
 	if m.StickyScheduleToStartTimeout != nil {
-		n14, err14 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StickyScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StickyScheduleToStartTimeout):])
-		if err14 != nil {
-			return 0, err14
+		n15, err15 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StickyScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StickyScheduleToStartTimeout):])
+		if err15 != nil {
+			return 0, err15
 		}
-		i -= n14
-		i = encodeVarintExecutions(dAtA, i, uint64(n14))
+		i -= n15
+		i = encodeVarintExecutions(dAtA, i, uint64(n15))
 		i--
 		dAtA[i] = 0x2
 		i--
@@ -4117,12 +4213,12 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xfa
 	}
 	if m.WorkflowTaskOriginalScheduledTime != nil {
-		n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskOriginalScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskOriginalScheduledTime):])
-		if err15 != nil {
-			return 0, err15
+		n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskOriginalScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskOriginalScheduledTime):])
+		if err16 != nil {
+			return 0, err16
 		}
-		i -= n15
-		i = encodeVarintExecutions(dAtA, i, uint64(n15))
+		i -= n16
+		i = encodeVarintExecutions(dAtA, i, uint64(n16))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -4141,24 +4237,24 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xe8
 	}
 	if m.WorkflowTaskScheduledTime != nil {
-		n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskScheduledTime):])
-		if err16 != nil {
-			return 0, err16
+		n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskScheduledTime):])
+		if err17 != nil {
+			return 0, err17
 		}
-		i -= n16
-		i = encodeVarintExecutions(dAtA, i, uint64(n16))
+		i -= n17
+		i = encodeVarintExecutions(dAtA, i, uint64(n17))
 		i--
 		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0xe2
 	}
 	if m.WorkflowTaskStartedTime != nil {
-		n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskStartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskStartedTime):])
-		if err17 != nil {
-			return 0, err17
+		n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskStartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskStartedTime):])
+		if err18 != nil {
+			return 0, err18
 		}
-		i -= n17
-		i = encodeVarintExecutions(dAtA, i, uint64(n17))
+		i -= n18
+		i = encodeVarintExecutions(dAtA, i, uint64(n18))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -4172,12 +4268,12 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xd0
 	}
 	if m.WorkflowTaskTimeout != nil {
-		n18, err18 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowTaskTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowTaskTimeout):])
-		if err18 != nil {
-			return 0, err18
+		n19, err19 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowTaskTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowTaskTimeout):])
+		if err19 != nil {
+			return 0, err19
 		}
-		i -= n18
-		i = encodeVarintExecutions(dAtA, i, uint64(n18))
+		i -= n19
+		i = encodeVarintExecutions(dAtA, i, uint64(n19))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -4205,24 +4301,24 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xb0
 	}
 	if m.LastUpdateTime != nil {
-		n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpdateTime):])
-		if err19 != nil {
-			return 0, err19
+		n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpdateTime):])
+		if err20 != nil {
+			return 0, err20
 		}
-		i -= n19
-		i = encodeVarintExecutions(dAtA, i, uint64(n19))
+		i -= n20
+		i = encodeVarintExecutions(dAtA, i, uint64(n20))
 		i--
 		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0xaa
 	}
 	if m.StartTime != nil {
-		n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
-		if err20 != nil {
-			return 0, err20
+		n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
+		if err21 != nil {
+			return 0, err21
 		}
-		i -= n20
-		i = encodeVarintExecutions(dAtA, i, uint64(n20))
+		i -= n21
+		i = encodeVarintExecutions(dAtA, i, uint64(n21))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -4255,33 +4351,33 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x70
 	}
 	if m.DefaultWorkflowTaskTimeout != nil {
-		n21, err21 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.DefaultWorkflowTaskTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.DefaultWorkflowTaskTimeout):])
-		if err21 != nil {
-			return 0, err21
-		}
-		i -= n21
-		i = encodeVarintExecutions(dAtA, i, uint64(n21))
-		i--
-		dAtA[i] = 0x6a
-	}
-	if m.WorkflowRunTimeout != nil {
-		n22, err22 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowRunTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowRunTimeout):])
+		n22, err22 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.DefaultWorkflowTaskTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.DefaultWorkflowTaskTimeout):])
 		if err22 != nil {
 			return 0, err22
 		}
 		i -= n22
 		i = encodeVarintExecutions(dAtA, i, uint64(n22))
 		i--
-		dAtA[i] = 0x62
+		dAtA[i] = 0x6a
 	}
-	if m.WorkflowExecutionTimeout != nil {
-		n23, err23 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowExecutionTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowExecutionTimeout):])
+	if m.WorkflowRunTimeout != nil {
+		n23, err23 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowRunTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowRunTimeout):])
 		if err23 != nil {
 			return 0, err23
 		}
 		i -= n23
 		i = encodeVarintExecutions(dAtA, i, uint64(n23))
 		i--
+		dAtA[i] = 0x62
+	}
+	if m.WorkflowExecutionTimeout != nil {
+		n24, err24 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowExecutionTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowExecutionTimeout):])
+		if err24 != nil {
+			return 0, err24
+		}
+		i -= n24
+		i = encodeVarintExecutions(dAtA, i, uint64(n24))
+		i--
 		dAtA[i] = 0x5a
 	}
 	if len(m.WorkflowTypeName) > 0 {
@@ -4453,13 +4549,32 @@ func (m *TransferTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TraceContext) > 0 {
+		for k := range m.TraceContext {
+			v := m.TraceContext[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintExecutions(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintExecutions(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintExecutions(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
 	if m.VisibilityTime != nil {
-		n25, err25 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
-		if err25 != nil {
-			return 0, err25
+		n26, err26 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err26 != nil {
+			return 0, err26
 		}
-		i -= n25
-		i = encodeVarintExecutions(dAtA, i, uint64(n25))
+		i -= n26
+		i = encodeVarintExecutions(dAtA, i, uint64(n26))
 		i--
 		dAtA[i] = 0x6a
 	}
@@ -4653,13 +4768,23 @@ func (m *VisibilityTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Backfill {
+		i--
+		if m.Backfill {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.VisibilityTime != nil {
-		n26, err26 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
-		if err26 != nil {
-			return 0, err26
+		n27, err27 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err27 != nil {
+			return 0, err27
 		}
-		i -= n26
-		i = encodeVarintExecutions(dAtA, i, uint64(n26))
+		i -= n27
+		i = encodeVarintExecutions(dAtA, i, uint64(n27))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -4723,12 +4848,12 @@ func (m *TimerTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.VisibilityTime != nil {
-		n27, err27 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
-		if err27 != nil {
-			return 0, err27
+		n28, err28 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err28 != nil {
+			return 0, err28
 		}
-		i -= n27
-		i = encodeVarintExecutions(dAtA, i, uint64(n27))
+		i -= n28
+		i = encodeVarintExecutions(dAtA, i, uint64(n28))
 		i--
 		dAtA[i] = 0x5a
 	}
@@ -4811,13 +4936,25 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastAttemptCompleteTime != nil {
+		n29, err29 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastAttemptCompleteTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastAttemptCompleteTime):])
+		if err29 != nil {
+			return 0, err29
+		}
+		i -= n29
+		i = encodeVarintExecutions(dAtA, i, uint64(n29))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x8a
+	}
 	if m.LastHeartbeatUpdateTime != nil {
-		n28, err28 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatUpdateTime):])
-		if err28 != nil {
-			return 0, err28
+		n30, err30 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatUpdateTime):])
+		if err30 != nil {
+			return 0, err30
 		}
-		i -= n28
-		i = encodeVarintExecutions(dAtA, i, uint64(n28))
+		i -= n30
+		i = encodeVarintExecutions(dAtA, i, uint64(n30))
 		i--
 		dAtA[i] = 0x2
 		i--
@@ -4896,12 +5033,12 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xc9
 	}
 	if m.RetryExpirationTime != nil {
-		n31, err31 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.RetryExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.RetryExpirationTime):])
-		if err31 != nil {
-			return 0, err31
+		n33, err33 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.RetryExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.RetryExpirationTime):])
+		if err33 != nil {
+			return 0, err33
 		}
-		i -= n31
-		i = encodeVarintExecutions(dAtA, i, uint64(n31))
+		i -= n33
+		i = encodeVarintExecutions(dAtA, i, uint64(n33))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -4915,24 +5052,24 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xb8
 	}
 	if m.RetryMaximumInterval != nil {
-		n32, err32 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryMaximumInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryMaximumInterval):])
-		if err32 != nil {
-			return 0, err32
+		n34, err34 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryMaximumInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryMaximumInterval):])
+		if err34 != nil {
+			return 0, err34
 		}
-		i -= n32
-		i = encodeVarintExecutions(dAtA, i, uint64(n32))
+		i -= n34
+		i = encodeVarintExecutions(dAtA, i, uint64(n34))
 		i--
 		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0xb2
 	}
 	if m.RetryInitialInterval != nil {
-		n33, err33 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryInitialInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryInitialInterval):])
-		if err33 != nil {
-			return 0, err33
+		n35, err35 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryInitialInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryInitialInterval):])
+		if err35 != nil {
+			return 0, err35
 		}
-		i -= n33
-		i = encodeVarintExecutions(dAtA, i, uint64(n33))
+		i -= n35
+		i = encodeVarintExecutions(dAtA, i, uint64(n35))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -4998,42 +5135,42 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x70
 	}
 	if m.HeartbeatTimeout != nil {
-		n34, err34 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.HeartbeatTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.HeartbeatTimeout):])
-		if err34 != nil {
-			return 0, err34
+		n36, err36 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.HeartbeatTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.HeartbeatTimeout):])
+		if err36 != nil {
+			return 0, err36
 		}
-		i -= n34
-		i = encodeVarintExecutions(dAtA, i, uint64(n34))
+		i -= n36
+		i = encodeVarintExecutions(dAtA, i, uint64(n36))
 		i--
 		dAtA[i] = 0x6a
 	}
 	if m.StartToCloseTimeout != nil {
-		n35, err35 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StartToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StartToCloseTimeout):])
-		if err35 != nil {
-			return 0, err35
+		n37, err37 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StartToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StartToCloseTimeout):])
+		if err37 != nil {
+			return 0, err37
 		}
-		i -= n35
-		i = encodeVarintExecutions(dAtA, i, uint64(n35))
+		i -= n37
+		i = encodeVarintExecutions(dAtA, i, uint64(n37))
 		i--
 		dAtA[i] = 0x62
 	}
 	if m.ScheduleToCloseTimeout != nil {
-		n36, err36 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToCloseTimeout):])
-		if err36 != nil {
-			return 0, err36
+		n38, err38 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToCloseTimeout):])
+		if err38 != nil {
+			return 0, err38
 		}
-		i -= n36
-		i = encodeVarintExecutions(dAtA, i, uint64(n36))
+		i -= n38
+		i = encodeVarintExecutions(dAtA, i, uint64(n38))
 		i--
 		dAtA[i] = 0x5a
 	}
 	if m.ScheduleToStartTimeout != nil {
-		n37, err37 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout):])
-		if err37 != nil {
-			return 0, err37
+		n39, err39 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout):])
+		if err39 != nil {
+			return 0, err39
 		}
-		i -= n37
-		i = encodeVarintExecutions(dAtA, i, uint64(n37))
+		i -= n39
+		i = encodeVarintExecutions(dAtA, i, uint64(n39))
 		i--
 		dAtA[i] = 0x52
 	}
@@ -5052,12 +5189,12 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x42
 	}
 	if m.StartedTime != nil {
-		n38, err38 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
-		if err38 != nil {
-			return 0, err38
+		n40, err40 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
+		if err40 != nil {
+			return 0, err40
 		}
-		i -= n38
-		i = encodeVarintExecutions(dAtA, i, uint64(n38))
+		i -= n40
+		i = encodeVarintExecutions(dAtA, i, uint64(n40))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -5079,12 +5216,12 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x28
 	}
 	if m.ScheduledTime != nil {
-		n40, err40 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
-		if err40 != nil {
-			return 0, err40
+		n42, err42 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
+		if err42 != nil {
+			return 0, err42
 		}
-		i -= n40
-		i = encodeVarintExecutions(dAtA, i, uint64(n40))
+		i -= n42
+		i = encodeVarintExecutions(dAtA, i, uint64(n42))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -5146,12 +5283,12 @@ func (m *TimerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x20
 	}
 	if m.ExpiryTime != nil {
-		n42, err42 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
-		if err42 != nil {
-			return 0, err42
+		n44, err44 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
+		if err44 != nil {
+			return 0, err44
 		}
-		i -= n42
-		i = encodeVarintExecutions(dAtA, i, uint64(n42))
+		i -= n44
+		i = encodeVarintExecutions(dAtA, i, uint64(n44))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -5740,6 +5877,10 @@ func (m *WorkflowExecutionInfo) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExecutionTime)
 		n += 2 + l + sovExecutions(uint64(l))
 	}
+	if m.WorkflowTaskLastHeartbeatTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskLastHeartbeatTime)
+		n += 2 + l + sovExecutions(uint64(l))
+	}
 	return n
 }
 
@@ -5831,6 +5972,14 @@ func (m *TransferTaskInfo) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime)
 		n += 1 + l + sovExecutions(uint64(l))
 	}
+	if len(m.TraceContext) > 0 {
+		for k, v := range m.TraceContext {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovExecutions(uint64(len(k))) + 1 + len(v) + sovExecutions(uint64(len(v)))
+			n += mapEntrySize + 1 + sovExecutions(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -5912,6 +6061,9 @@ func (m *VisibilityTaskInfo) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime)
 		n += 1 + l + sovExecutions(uint64(l))
 	}
+	if m.Backfill {
+		n += 2
+	}
 	return n
 }
 
@@ -6086,6 +6238,10 @@ func (m *ActivityInfo) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatUpdateTime)
 		n += 2 + l + sovExecutions(uint64(l))
 	}
+	if m.LastAttemptCompleteTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastAttemptCompleteTime)
+		n += 2 + l + sovExecutions(uint64(l))
+	}
 	return n
 }
 
@@ -6389,6 +6545,7 @@ func (this *WorkflowExecutionInfo) String() string {
 		`LastFirstEventTxnId:` + fmt.Sprintf("%v", this.LastFirstEventTxnId) + `,`,
 		`StateTransitionCount:` + fmt.Sprintf("%v", this.StateTransitionCount) + `,`,
 		`ExecutionTime:` + strings.Replace(fmt.Sprintf("%v", this.ExecutionTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`WorkflowTaskLastHeartbeatTime:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowTaskLastHeartbeatTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -6420,6 +6577,16 @@ func (this *TransferTaskInfo) String() string {
 	if this == nil {
 		return "nil"
 	}
+	keysForTraceContext := make([]string, 0, len(this.TraceContext))
+	for k, _ := range this.TraceContext {
+		keysForTraceContext = append(keysForTraceContext, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForTraceContext)
+	mapStringForTraceContext := "map[string]string{"
+	for _, k := range keysForTraceContext {
+		mapStringForTraceContext += fmt.Sprintf("%v: %v,", k, this.TraceContext[k])
+	}
+	mapStringForTraceContext += "}"
 	s := strings.Join([]string{`&TransferTaskInfo{`,
 		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
 		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
@@ -6434,6 +6601,7 @@ func (this *TransferTaskInfo) String() string {
 		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
 		`TaskId:` + fmt.Sprintf("%v", this.TaskId) + `,`,
 		`VisibilityTime:` + strings.Replace(fmt.Sprintf("%v", this.VisibilityTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`TraceContext:` + mapStringForTraceContext + `,`,
 		`}`,
 	}, "")
 	return s
@@ -6470,6 +6638,7 @@ func (this *VisibilityTaskInfo) String() string {
 		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
 		`TaskId:` + fmt.Sprintf("%v", this.TaskId) + `,`,
 		`VisibilityTime:` + strings.Replace(fmt.Sprintf("%v", this.VisibilityTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Backfill:` + fmt.Sprintf("%v", this.Backfill) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -6531,6 +6700,7 @@ func (this *ActivityInfo) String() string {
 		`ScheduleId:` + fmt.Sprintf("%v", this.ScheduleId) + `,`,
 		`LastHeartbeatDetails:` + strings.Replace(fmt.Sprintf("%v", this.LastHeartbeatDetails), "Payloads", "v12.Payloads", 1) + `,`,
 		`LastHeartbeatUpdateTime:` + strings.Replace(fmt.Sprintf("%v", this.LastHeartbeatUpdateTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`LastAttemptCompleteTime:` + strings.Replace(fmt.Sprintf("%v", this.LastAttemptCompleteTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -9118,6 +9288,42 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 61:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTaskLastHeartbeatTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowTaskLastHeartbeatTime == nil {
+				m.WorkflowTaskLastHeartbeatTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.WorkflowTaskLastHeartbeatTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExecutions(dAtA[iNdEx:])
@@ -9754,6 +9960,133 @@ func (m *TransferTaskInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceContext", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TraceContext == nil {
+				m.TraceContext = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowExecutions
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowExecutions
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowExecutions
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipExecutions(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.TraceContext[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExecutions(dAtA[iNdEx:])
@@ -10327,6 +10660,26 @@ func (m *VisibilityTaskInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backfill", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Backfill = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExecutions(dAtA[iNdEx:])
@@ -11629,6 +11982,42 @@ func (m *ActivityInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAttemptCompleteTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastAttemptCompleteTime == nil {
+				m.LastAttemptCompleteTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastAttemptCompleteTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExecutions(dAtA[iNdEx:])
@@ -43,6 +43,7 @@ import (
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	v1 "go.temporal.io/api/enums/v1"
 	v11 "go.temporal.io/api/namespace/v1"
+	v12 "go.temporal.io/server/api/enums/v1"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -66,6 +67,8 @@ type NamespaceDetail struct {
 	FailoverNotificationVersion int64                       `protobuf:"varint,5,opt,name=failover_notification_version,json=failoverNotificationVersion,proto3" json:"failover_notification_version,omitempty"`
 	FailoverVersion             int64                       `protobuf:"varint,6,opt,name=failover_version,json=failoverVersion,proto3" json:"failover_version,omitempty"`
 	FailoverEndTime             *time.Time                  `protobuf:"bytes,7,opt,name=failover_end_time,json=failoverEndTime,proto3,stdtime" json:"failover_end_time,omitempty"`
+	// Most recent failovers of the namespace, oldest first.
+	FailoverHistory []*NamespaceFailoverEvent `protobuf:"bytes,8,rep,name=failover_history,json=failoverHistory,proto3" json:"failover_history,omitempty"`
 }
 
 func (m *NamespaceDetail) Reset()      { *m = NamespaceDetail{} }
@@ -149,6 +152,13 @@ func (m *NamespaceDetail) GetFailoverEndTime() *time.Time {
 	return nil
 }
 
+func (m *NamespaceDetail) GetFailoverHistory() []*NamespaceFailoverEvent {
+	if m != nil {
+		return m.FailoverHistory
+	}
+	return nil
+}
+
 type NamespaceInfo struct {
 	Id          string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	State       v1.NamespaceState `protobuf:"varint,2,opt,name=state,proto3,enum=temporal.api.enums.v1.NamespaceState" json:"state,omitempty"`
@@ -240,6 +250,7 @@ type NamespaceConfig struct {
 	HistoryArchivalUri      string           `protobuf:"bytes,5,opt,name=history_archival_uri,json=historyArchivalUri,proto3" json:"history_archival_uri,omitempty"`
 	VisibilityArchivalState v1.ArchivalState `protobuf:"varint,6,opt,name=visibility_archival_state,json=visibilityArchivalState,proto3,enum=temporal.api.enums.v1.ArchivalState" json:"visibility_archival_state,omitempty"`
 	VisibilityArchivalUri   string           `protobuf:"bytes,7,opt,name=visibility_archival_uri,json=visibilityArchivalUri,proto3" json:"visibility_archival_uri,omitempty"`
+	Quotas                  *NamespaceQuotas `protobuf:"bytes,8,opt,name=quotas,proto3" json:"quotas,omitempty"`
 }
 
 func (m *NamespaceConfig) Reset()      { *m = NamespaceConfig{} }
@@ -323,15 +334,90 @@ func (m *NamespaceConfig) GetVisibilityArchivalUri() string {
 	return ""
 }
 
+func (m *NamespaceConfig) GetQuotas() *NamespaceQuotas {
+	if m != nil {
+		return m.Quotas
+	}
+	return nil
+}
+
+// Quotas of a namespace, they take precedence over the dynamic config limits of the namespace.
+// Zero value of a field means the limit is not set.
+type NamespaceQuotas struct {
+	// Maximum number of workflows started by StartWorkflowExecution and SignalWithStartWorkflowExecution
+	// per second in the cluster.
+	MaxStartWorkflowRps int32 `protobuf:"varint,1,opt,name=max_start_workflow_rps,json=maxStartWorkflowRps,proto3" json:"max_start_workflow_rps,omitempty"`
+	// Maximum number of concurrently open workflow executions.
+	MaxPendingWorkflows int64 `protobuf:"varint,2,opt,name=max_pending_workflows,json=maxPendingWorkflows,proto3" json:"max_pending_workflows,omitempty"`
+	// Maximum number of API requests per second in the cluster.
+	MaxActionsPerSecond int32 `protobuf:"varint,3,opt,name=max_actions_per_second,json=maxActionsPerSecond,proto3" json:"max_actions_per_second,omitempty"`
+}
+
+func (m *NamespaceQuotas) Reset()      { *m = NamespaceQuotas{} }
+func (*NamespaceQuotas) ProtoMessage() {}
+func (*NamespaceQuotas) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0486d93c2107d6bc, []int{3}
+}
+func (m *NamespaceQuotas) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespaceQuotas) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NamespaceQuotas.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NamespaceQuotas) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceQuotas.Merge(m, src)
+}
+func (m *NamespaceQuotas) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespaceQuotas) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceQuotas.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceQuotas proto.InternalMessageInfo
+
+func (m *NamespaceQuotas) GetMaxStartWorkflowRps() int32 {
+	if m != nil {
+		return m.MaxStartWorkflowRps
+	}
+	return 0
+}
+
+func (m *NamespaceQuotas) GetMaxPendingWorkflows() int64 {
+	if m != nil {
+		return m.MaxPendingWorkflows
+	}
+	return 0
+}
+
+func (m *NamespaceQuotas) GetMaxActionsPerSecond() int32 {
+	if m != nil {
+		return m.MaxActionsPerSecond
+	}
+	return 0
+}
+
 type NamespaceReplicationConfig struct {
-	ActiveClusterName string   `protobuf:"bytes,1,opt,name=active_cluster_name,json=activeClusterName,proto3" json:"active_cluster_name,omitempty"`
-	Clusters          []string `protobuf:"bytes,2,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	ActiveClusterName string                        `protobuf:"bytes,1,opt,name=active_cluster_name,json=activeClusterName,proto3" json:"active_cluster_name,omitempty"`
+	Clusters          []string                      `protobuf:"bytes,2,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	State             v12.NamespaceReplicationState `protobuf:"varint,3,opt,name=state,proto3,enum=temporal.server.api.enums.v1.NamespaceReplicationState" json:"state,omitempty"`
+	// Cluster which becomes active once handover is completed, set only in handover state.
+	HandoverClusterName string `protobuf:"bytes,4,opt,name=handover_cluster_name,json=handoverClusterName,proto3" json:"handover_cluster_name,omitempty"`
 }
 
 func (m *NamespaceReplicationConfig) Reset()      { *m = NamespaceReplicationConfig{} }
 func (*NamespaceReplicationConfig) ProtoMessage() {}
 func (*NamespaceReplicationConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0486d93c2107d6bc, []int{3}
+	return fileDescriptor_0486d93c2107d6bc, []int{4}
 }
 func (m *NamespaceReplicationConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -374,12 +460,104 @@ func (m *NamespaceReplicationConfig) GetClusters() []string {
 	return nil
 }
 
+func (m *NamespaceReplicationConfig) GetState() v12.NamespaceReplicationState {
+	if m != nil {
+		return m.State
+	}
+	return v12.NAMESPACE_REPLICATION_STATE_UNSPECIFIED
+}
+
+func (m *NamespaceReplicationConfig) GetHandoverClusterName() string {
+	if m != nil {
+		return m.HandoverClusterName
+	}
+	return ""
+}
+
+type NamespaceFailoverEvent struct {
+	FailoverTime    *time.Time `protobuf:"bytes,1,opt,name=failover_time,json=failoverTime,proto3,stdtime" json:"failover_time,omitempty"`
+	FromCluster     string     `protobuf:"bytes,2,opt,name=from_cluster,json=fromCluster,proto3" json:"from_cluster,omitempty"`
+	ToCluster       string     `protobuf:"bytes,3,opt,name=to_cluster,json=toCluster,proto3" json:"to_cluster,omitempty"`
+	FailoverVersion int64      `protobuf:"varint,4,opt,name=failover_version,json=failoverVersion,proto3" json:"failover_version,omitempty"`
+	// Identity of the caller which requested the failover, empty if the failover was replicated from remote cluster.
+	Identity string `protobuf:"bytes,5,opt,name=identity,proto3" json:"identity,omitempty"`
+}
+
+func (m *NamespaceFailoverEvent) Reset()      { *m = NamespaceFailoverEvent{} }
+func (*NamespaceFailoverEvent) ProtoMessage() {}
+func (*NamespaceFailoverEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0486d93c2107d6bc, []int{5}
+}
+func (m *NamespaceFailoverEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespaceFailoverEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NamespaceFailoverEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NamespaceFailoverEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceFailoverEvent.Merge(m, src)
+}
+func (m *NamespaceFailoverEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespaceFailoverEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceFailoverEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceFailoverEvent proto.InternalMessageInfo
+
+func (m *NamespaceFailoverEvent) GetFailoverTime() *time.Time {
+	if m != nil {
+		return m.FailoverTime
+	}
+	return nil
+}
+
+func (m *NamespaceFailoverEvent) GetFromCluster() string {
+	if m != nil {
+		return m.FromCluster
+	}
+	return ""
+}
+
+func (m *NamespaceFailoverEvent) GetToCluster() string {
+	if m != nil {
+		return m.ToCluster
+	}
+	return ""
+}
+
+func (m *NamespaceFailoverEvent) GetFailoverVersion() int64 {
+	if m != nil {
+		return m.FailoverVersion
+	}
+	return 0
+}
+
+func (m *NamespaceFailoverEvent) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*NamespaceDetail)(nil), "temporal.server.api.persistence.v1.NamespaceDetail")
 	proto.RegisterType((*NamespaceInfo)(nil), "temporal.server.api.persistence.v1.NamespaceInfo")
 	proto.RegisterMapType((map[string]string)(nil), "temporal.server.api.persistence.v1.NamespaceInfo.DataEntry")
 	proto.RegisterType((*NamespaceConfig)(nil), "temporal.server.api.persistence.v1.NamespaceConfig")
+	proto.RegisterType((*NamespaceQuotas)(nil), "temporal.server.api.persistence.v1.NamespaceQuotas")
 	proto.RegisterType((*NamespaceReplicationConfig)(nil), "temporal.server.api.persistence.v1.NamespaceReplicationConfig")
+	proto.RegisterType((*NamespaceFailoverEvent)(nil), "temporal.server.api.persistence.v1.NamespaceFailoverEvent")
 }
 
 func init() {
@@ -387,58 +565,74 @@ func init() {
 }
 
 var fileDescriptor_0486d93c2107d6bc = []byte{
-	// 815 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x41, 0x73, 0xe3, 0x34,
-	0x18, 0x8d, 0x93, 0x34, 0x8b, 0x15, 0x36, 0xdd, 0x8a, 0xc2, 0x66, 0xc3, 0xe0, 0x0d, 0x19, 0xca,
-	0x86, 0x8b, 0x4d, 0x5a, 0x06, 0x18, 0x3a, 0x30, 0x43, 0xda, 0x1e, 0x3a, 0x30, 0x65, 0xc6, 0x50,
-	0x0e, 0xbd, 0x18, 0xc5, 0x56, 0x52, 0x51, 0x47, 0xf2, 0x48, 0x8a, 0x99, 0xde, 0xf8, 0x09, 0x3d,
-	0xf2, 0x13, 0x38, 0xf3, 0x2b, 0x38, 0xf6, 0xd8, 0x1b, 0x34, 0xbd, 0x70, 0xe0, 0xd0, 0x23, 0x47,
-	0xc6, 0x92, 0x6c, 0x27, 0x0d, 0x1d, 0x26, 0x37, 0xeb, 0xd3, 0x7b, 0x4f, 0x9f, 0xbe, 0xf7, 0xa2,
-	0x80, 0x3d, 0x89, 0xa7, 0x09, 0xe3, 0x28, 0xf6, 0x04, 0xe6, 0x29, 0xe6, 0x1e, 0x4a, 0x88, 0x97,
-	0x60, 0x2e, 0x88, 0x90, 0x98, 0x86, 0xd8, 0x4b, 0x07, 0x1e, 0x45, 0x53, 0x2c, 0x12, 0x14, 0x62,
-	0xe1, 0x26, 0x9c, 0x49, 0x06, 0x7b, 0x39, 0xc9, 0xd5, 0x24, 0x17, 0x25, 0xc4, 0x5d, 0x20, 0xb9,
-	0xe9, 0xa0, 0xe3, 0x4c, 0x18, 0x9b, 0xc4, 0xd8, 0x53, 0x8c, 0xd1, 0x6c, 0xec, 0x45, 0x33, 0x8e,
-	0x24, 0x61, 0x54, 0x6b, 0x74, 0x5e, 0x3e, 0xdc, 0x97, 0x64, 0x8a, 0x85, 0x44, 0xd3, 0xc4, 0x00,
-	0xde, 0x8d, 0x70, 0x82, 0x69, 0x84, 0x69, 0x48, 0xb0, 0xf0, 0x26, 0x6c, 0xc2, 0x54, 0x5d, 0x7d,
-	0x19, 0xc8, 0x4e, 0xd1, 0x7c, 0xd6, 0x35, 0xa6, 0xb3, 0xa9, 0x58, 0xea, 0xd7, 0xc0, 0x5e, 0x2d,
-	0xc1, 0x8a, 0xdd, 0x0c, 0x3a, 0xc5, 0x42, 0xa0, 0x89, 0x01, 0xf6, 0xfe, 0xa9, 0x81, 0xcd, 0x93,
-	0x7c, 0xfb, 0x10, 0x4b, 0x44, 0x62, 0x78, 0x04, 0xea, 0x84, 0x8e, 0x59, 0xdb, 0xea, 0x5a, 0xfd,
-	0xe6, 0xee, 0xc0, 0xfd, 0xff, 0xab, 0xbb, 0x85, 0xc4, 0x31, 0x1d, 0x33, 0x5f, 0xd1, 0xe1, 0x57,
-	0xa0, 0x11, 0x32, 0x3a, 0x26, 0x93, 0x76, 0x55, 0x09, 0xed, 0xad, 0x25, 0x74, 0xa0, 0xa8, 0xbe,
-	0x91, 0x80, 0x53, 0x00, 0x39, 0x4e, 0x62, 0x12, 0xaa, 0x81, 0x06, 0x46, 0xb8, 0xa6, 0x84, 0xbf,
-	0x58, 0x4b, 0xd8, 0x2f, 0x65, 0xcc, 0x19, 0x5b, 0xfc, 0x61, 0x09, 0xee, 0x80, 0x96, 0x3e, 0x22,
-	0x48, 0x33, 0x19, 0x46, 0xdb, 0xf5, 0xae, 0xd5, 0xaf, 0xf9, 0x4f, 0x75, 0xf5, 0x7b, 0x5d, 0x84,
-	0x43, 0xf0, 0xce, 0x18, 0x91, 0x98, 0xa5, 0x98, 0x07, 0x94, 0x49, 0x32, 0xce, 0xfb, 0xcb, 0x59,
-	0x1b, 0x8a, 0xf5, 0x76, 0x0e, 0x3a, 0x59, 0xc0, 0xe4, 0x1a, 0x1f, 0x80, 0x67, 0x85, 0x46, 0x4e,
-	0x6b, 0x28, 0xda, 0x66, 0x5e, 0xcf, 0xa1, 0x5f, 0x83, 0xad, 0x02, 0x8a, 0x69, 0x14, 0x64, 0xf9,
-	0x69, 0x3f, 0x51, 0x33, 0xe8, 0xb8, 0x3a, 0x5c, 0x6e, 0x1e, 0x2e, 0xf7, 0xbb, 0x3c, 0x5c, 0xc3,
-	0xfa, 0xd5, 0x1f, 0x2f, 0xad, 0x52, 0xed, 0x88, 0x46, 0xd9, 0x5e, 0xef, 0xb7, 0x2a, 0x78, 0xba,
-	0xe4, 0x1b, 0x6c, 0x81, 0x2a, 0x89, 0x94, 0xed, 0xb6, 0x5f, 0x25, 0x11, 0xdc, 0x07, 0x1b, 0x42,
-	0x22, 0x89, 0x95, 0x81, 0xad, 0xdd, 0x9d, 0x72, 0xce, 0xd9, 0x80, 0x55, 0xf8, 0x96, 0x46, 0xfb,
-	0x6d, 0x06, 0xf6, 0x35, 0x07, 0x42, 0x50, 0xcf, 0x72, 0xa7, 0x3c, 0xb2, 0x7d, 0xf5, 0x0d, 0xbb,
-	0xa0, 0x19, 0x61, 0x11, 0x72, 0x92, 0xc8, 0x7c, 0xa6, 0xb6, 0xbf, 0x58, 0x82, 0xdb, 0x60, 0x83,
-	0xfd, 0x44, 0x31, 0x57, 0x93, 0xb3, 0x7d, 0xbd, 0x80, 0xdf, 0x80, 0x7a, 0x84, 0x24, 0x6a, 0x37,
-	0xba, 0xb5, 0x7e, 0x73, 0x77, 0x7f, 0xed, 0x44, 0xba, 0x87, 0x48, 0xa2, 0x23, 0x2a, 0xf9, 0xa5,
-	0xaf, 0x84, 0x3a, 0x9f, 0x00, 0xbb, 0x28, 0xc1, 0x67, 0xa0, 0x76, 0x81, 0x2f, 0xcd, 0xbd, 0xb3,
-	0xcf, 0xac, 0x8b, 0x14, 0xc5, 0x33, 0x7d, 0x71, 0xdb, 0xd7, 0x8b, 0xcf, 0xaa, 0x9f, 0x5a, 0xbd,
-	0xbf, 0x17, 0x7f, 0x2f, 0x26, 0x2c, 0x9f, 0x03, 0x9b, 0x63, 0x89, 0xa9, 0xba, 0x93, 0xfe, 0xd1,
-	0xbc, 0x58, 0xb1, 0xe3, 0xd0, 0xbc, 0x05, 0xc3, 0xfa, 0x2f, 0x99, 0x1b, 0x25, 0x03, 0xbe, 0x02,
-	0x9b, 0x88, 0x87, 0xe7, 0x24, 0x45, 0x71, 0x30, 0x9a, 0x85, 0x17, 0x58, 0x9a, 0x63, 0x5b, 0x79,
-	0x79, 0xa8, 0xaa, 0xf0, 0x18, 0xbc, 0x3e, 0x42, 0x51, 0x30, 0x22, 0x14, 0x71, 0x82, 0x85, 0x49,
-	0xff, 0xfb, 0xcb, 0xae, 0x94, 0x2f, 0x41, 0x3a, 0x70, 0x87, 0x28, 0x1a, 0x1a, 0xb4, 0xdf, 0x1c,
-	0x95, 0x0b, 0x78, 0x06, 0xde, 0x3a, 0x27, 0x42, 0x32, 0x7e, 0x19, 0x14, 0x67, 0x6b, 0xab, 0xeb,
-	0xca, 0xea, 0xf7, 0x1e, 0xb1, 0xfa, 0x4b, 0x03, 0xd6, 0x4e, 0x6f, 0x1b, 0x8d, 0xa5, 0x2a, 0xfc,
-	0x10, 0x6c, 0xaf, 0x68, 0xcf, 0x38, 0x31, 0x8e, 0xc2, 0x07, 0x9c, 0x53, 0x4e, 0xe0, 0x0f, 0xe0,
-	0x45, 0x4a, 0x04, 0x19, 0x91, 0x98, 0xc8, 0x95, 0x86, 0x1a, 0x6b, 0x34, 0xf4, 0xbc, 0x94, 0x59,
-	0xee, 0xe9, 0x63, 0xf0, 0xfc, 0xbf, 0x4e, 0xc8, 0xda, 0x7a, 0xa2, 0xda, 0x7a, 0x73, 0x95, 0x79,
-	0xca, 0x49, 0xef, 0x1c, 0x74, 0x1e, 0x7f, 0x38, 0xa0, 0x0b, 0xde, 0x40, 0xa1, 0x24, 0x29, 0x0e,
-	0xc2, 0x78, 0x26, 0x64, 0xf6, 0x08, 0x64, 0x89, 0xd7, 0x41, 0xda, 0xd2, 0x5b, 0x07, 0x7a, 0x27,
-	0x53, 0x81, 0x1d, 0xf0, 0x9a, 0x01, 0x8a, 0x76, 0xb5, 0x5b, 0xeb, 0xdb, 0x7e, 0xb1, 0x1e, 0xfe,
-	0x78, 0x7d, 0xeb, 0x54, 0x6e, 0x6e, 0x9d, 0xca, 0xfd, 0xad, 0x63, 0xfd, 0x3c, 0x77, 0xac, 0x5f,
-	0xe7, 0x8e, 0xf5, 0xfb, 0xdc, 0xb1, 0xae, 0xe7, 0x8e, 0xf5, 0xe7, 0xdc, 0xb1, 0xfe, 0x9a, 0x3b,
-	0x95, 0xfb, 0xb9, 0x63, 0x5d, 0xdd, 0x39, 0x95, 0xeb, 0x3b, 0xa7, 0x72, 0x73, 0xe7, 0x54, 0xce,
-	0x3e, 0x9a, 0xb0, 0x72, 0x30, 0x84, 0x3d, 0xfe, 0x8f, 0xb6, 0xbf, 0xb0, 0x1c, 0x35, 0x54, 0x2a,
-	0xf7, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xb6, 0x1e, 0x04, 0xeb, 0x0a, 0x07, 0x00, 0x00,
+	// 1059 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4d, 0x6f, 0x1b, 0x37,
+	0x13, 0xd6, 0x5a, 0xb2, 0x62, 0xd1, 0xb1, 0x1d, 0xd3, 0x1f, 0x51, 0xf4, 0x22, 0x1b, 0x47, 0x78,
+	0xdd, 0xb8, 0x40, 0xb1, 0xaa, 0xed, 0xa2, 0x29, 0x62, 0xb4, 0x40, 0x14, 0xbb, 0x68, 0x90, 0x36,
+	0x4d, 0xd7, 0xfd, 0x00, 0x72, 0xd9, 0x52, 0xbb, 0x94, 0xcc, 0x5a, 0x22, 0xb7, 0x24, 0xb5, 0x8e,
+	0x6f, 0xfd, 0x09, 0x39, 0xf6, 0x27, 0xf4, 0x56, 0xa0, 0xb7, 0xfe, 0x83, 0x1e, 0x7d, 0xcc, 0x2d,
+	0xb5, 0x7c, 0x09, 0xd0, 0x4b, 0x7e, 0x42, 0xc1, 0xaf, 0x95, 0x64, 0xcb, 0x68, 0xdd, 0x9b, 0x38,
+	0x33, 0xcf, 0x33, 0x0f, 0x67, 0x86, 0xb3, 0x02, 0xdb, 0x12, 0xf7, 0x52, 0xc6, 0x51, 0xb7, 0x21,
+	0x30, 0xcf, 0x30, 0x6f, 0xa0, 0x94, 0x34, 0x52, 0xcc, 0x05, 0x11, 0x12, 0xd3, 0x18, 0x37, 0xb2,
+	0xcd, 0x06, 0x45, 0x3d, 0x2c, 0x52, 0x14, 0x63, 0x11, 0xa4, 0x9c, 0x49, 0x06, 0xeb, 0x0e, 0x14,
+	0x18, 0x50, 0x80, 0x52, 0x12, 0x8c, 0x80, 0x82, 0x6c, 0xb3, 0xe6, 0x77, 0x18, 0xeb, 0x74, 0x71,
+	0x43, 0x23, 0x5a, 0xfd, 0x76, 0x23, 0xe9, 0x73, 0x24, 0x09, 0xa3, 0x86, 0xa3, 0x76, 0xe7, 0xbc,
+	0x5f, 0x92, 0x1e, 0x16, 0x12, 0xf5, 0x52, 0x1b, 0x70, 0x37, 0xc1, 0x29, 0xa6, 0x09, 0xa6, 0x31,
+	0xc1, 0xa2, 0xd1, 0x61, 0x1d, 0xa6, 0xed, 0xfa, 0x97, 0x0d, 0x59, 0xcf, 0xc5, 0x2b, 0xd5, 0x98,
+	0xf6, 0x7b, 0x62, 0x4c, 0xaf, 0x0d, 0xbb, 0x37, 0x16, 0x96, 0x7b, 0x55, 0x68, 0x0f, 0x0b, 0x81,
+	0x3a, 0x2e, 0xf0, 0xbd, 0x49, 0xc5, 0xb8, 0x8c, 0xb6, 0xfe, 0xba, 0x04, 0x16, 0x9e, 0x3a, 0xdb,
+	0x2e, 0x96, 0x88, 0x74, 0xe1, 0x1e, 0x28, 0x11, 0xda, 0x66, 0x55, 0x6f, 0xcd, 0xdb, 0x98, 0xdd,
+	0xda, 0x0c, 0xfe, 0xb9, 0x50, 0x41, 0x4e, 0xf1, 0x98, 0xb6, 0x59, 0xa8, 0xe1, 0xf0, 0x09, 0x28,
+	0xc7, 0x8c, 0xb6, 0x49, 0xa7, 0x3a, 0xa5, 0x89, 0xb6, 0xaf, 0x44, 0xf4, 0x48, 0x43, 0x43, 0x4b,
+	0x01, 0x7b, 0x00, 0x72, 0x9c, 0x76, 0x49, 0xac, 0xcb, 0x1f, 0x59, 0xe2, 0xa2, 0x26, 0xfe, 0xe4,
+	0x4a, 0xc4, 0xe1, 0x90, 0xc6, 0xe6, 0x58, 0xe4, 0xe7, 0x4d, 0x70, 0x1d, 0xcc, 0x9b, 0x14, 0x51,
+	0xa6, 0x68, 0x18, 0xad, 0x96, 0xd6, 0xbc, 0x8d, 0x62, 0x38, 0x67, 0xac, 0xdf, 0x1a, 0x23, 0x6c,
+	0x82, 0xdb, 0x6d, 0x44, 0xba, 0x2c, 0xc3, 0x3c, 0xa2, 0x4c, 0x92, 0xb6, 0xd3, 0xe7, 0x50, 0xd3,
+	0x1a, 0xf5, 0x3f, 0x17, 0xf4, 0x74, 0x24, 0xc6, 0x71, 0xbc, 0x0b, 0x6e, 0xe4, 0x1c, 0x0e, 0x56,
+	0xd6, 0xb0, 0x05, 0x67, 0x77, 0xa1, 0x9f, 0x83, 0xc5, 0x3c, 0x14, 0xd3, 0x24, 0x52, 0xd3, 0x56,
+	0xbd, 0xa6, 0x6b, 0x50, 0x0b, 0xcc, 0x28, 0x06, 0x6e, 0x14, 0x83, 0xaf, 0xdd, 0x28, 0x36, 0x4b,
+	0x2f, 0x5f, 0xdf, 0xf1, 0x86, 0x6c, 0x7b, 0x34, 0x51, 0x3e, 0x88, 0x47, 0x12, 0x1f, 0x10, 0x21,
+	0x19, 0x3f, 0xae, 0xce, 0xac, 0x15, 0x37, 0x66, 0xb7, 0x1e, 0x5c, 0xa9, 0xa0, 0x9f, 0x3a, 0xde,
+	0x0c, 0x53, 0x39, 0x4c, 0xf3, 0x99, 0xa1, 0xac, 0xff, 0x36, 0x05, 0xe6, 0xc6, 0xc6, 0x03, 0xce,
+	0x83, 0x29, 0x92, 0xe8, 0xe9, 0xaa, 0x84, 0x53, 0x24, 0x81, 0x3b, 0x60, 0x5a, 0x48, 0x24, 0xb1,
+	0x9e, 0x93, 0xf9, 0xad, 0xf5, 0x61, 0x76, 0x95, 0x56, 0x8f, 0xee, 0x58, 0xc2, 0x7d, 0x15, 0x1c,
+	0x1a, 0x0c, 0x84, 0xa0, 0xa4, 0x66, 0x5a, 0x8f, 0x42, 0x25, 0xd4, 0xbf, 0xe1, 0x1a, 0x98, 0x4d,
+	0xb0, 0x88, 0x39, 0x49, 0xa5, 0x6b, 0x5d, 0x25, 0x1c, 0x35, 0xc1, 0x65, 0x30, 0xcd, 0x8e, 0x28,
+	0xe6, 0xba, 0x41, 0x95, 0xd0, 0x1c, 0xe0, 0x97, 0xa0, 0x94, 0x20, 0x89, 0xaa, 0x65, 0x5d, 0x85,
+	0x9d, 0x2b, 0x0f, 0x7e, 0xb0, 0x8b, 0x24, 0xda, 0xa3, 0x92, 0x1f, 0x87, 0x9a, 0xa8, 0x76, 0x1f,
+	0x54, 0x72, 0x13, 0xbc, 0x01, 0x8a, 0x87, 0xf8, 0xd8, 0xde, 0x5b, 0xfd, 0x54, 0x2a, 0x32, 0xd4,
+	0xed, 0x9b, 0x8b, 0x57, 0x42, 0x73, 0x78, 0x30, 0xf5, 0x91, 0x57, 0xff, 0x7d, 0xf4, 0x59, 0xda,
+	0x99, 0xfc, 0x18, 0x54, 0x38, 0x96, 0x98, 0xea, 0x3b, 0x99, 0xb7, 0x79, 0xeb, 0x42, 0xd7, 0x77,
+	0xed, 0x82, 0x6a, 0x96, 0x7e, 0x56, 0x4d, 0x1f, 0x22, 0xe0, 0x3d, 0xb0, 0x80, 0x78, 0x7c, 0x40,
+	0x32, 0xd4, 0x8d, 0x5a, 0xfd, 0xf8, 0x10, 0x4b, 0x9b, 0x76, 0xde, 0x99, 0x9b, 0xda, 0x0a, 0x1f,
+	0x83, 0xeb, 0x2d, 0x94, 0x44, 0x2d, 0x42, 0x11, 0x27, 0x58, 0xd8, 0x47, 0xf6, 0xce, 0x78, 0x57,
+	0x86, 0x7b, 0x24, 0xdb, 0x0c, 0x9a, 0x28, 0x69, 0xda, 0xe8, 0x70, 0xb6, 0x35, 0x3c, 0xc0, 0xe7,
+	0x60, 0xd5, 0x4e, 0x56, 0x94, 0xe7, 0x36, 0xad, 0x2e, 0xe9, 0x56, 0xff, 0xff, 0x92, 0x56, 0x3f,
+	0xb4, 0xc1, 0xa6, 0xd3, 0xcb, 0x96, 0x63, 0xcc, 0x0a, 0xdf, 0x07, 0xcb, 0x17, 0xb8, 0xfb, 0x9c,
+	0xd8, 0x8e, 0xc2, 0x73, 0x98, 0x6f, 0x38, 0x81, 0xdf, 0x83, 0x5b, 0x19, 0x11, 0xa4, 0x45, 0xba,
+	0x44, 0x5e, 0x10, 0x54, 0xbe, 0x82, 0xa0, 0x9b, 0x43, 0x9a, 0x71, 0x4d, 0x1f, 0x82, 0x9b, 0x93,
+	0x32, 0x28, 0x59, 0xd7, 0xb4, 0xac, 0x95, 0x8b, 0x48, 0xa5, 0xec, 0x09, 0x28, 0xff, 0xd8, 0x67,
+	0x12, 0x89, 0xea, 0xcc, 0x7f, 0x58, 0x95, 0x5f, 0x69, 0x68, 0x68, 0x29, 0xea, 0xbf, 0x7a, 0x60,
+	0xe1, 0x9c, 0x0f, 0x6e, 0x83, 0xd5, 0x1e, 0x7a, 0xa1, 0xae, 0xca, 0x65, 0x74, 0xc4, 0xf8, 0x61,
+	0xbb, 0xcb, 0x8e, 0x22, 0x9e, 0x0a, 0x3d, 0x48, 0xd3, 0xe1, 0x52, 0x0f, 0xbd, 0xd8, 0x57, 0xce,
+	0xef, 0xac, 0x2f, 0x4c, 0x05, 0xdc, 0x02, 0x2b, 0x0a, 0xa4, 0x3e, 0x60, 0x84, 0x76, 0x72, 0x98,
+	0xd0, 0x73, 0x53, 0xd4, 0x98, 0x67, 0xc6, 0xe7, 0x50, 0x79, 0x22, 0x14, 0xab, 0x99, 0x13, 0x51,
+	0x8a, 0x79, 0x24, 0x70, 0xcc, 0x68, 0x52, 0x2d, 0xe6, 0x89, 0x1e, 0x1a, 0xe7, 0x33, 0xcc, 0xf7,
+	0xb5, 0xab, 0xfe, 0x97, 0x07, 0x6a, 0x97, 0xef, 0x67, 0x18, 0x80, 0x25, 0xc5, 0x97, 0xe1, 0x28,
+	0xee, 0xf6, 0x85, 0x54, 0xbb, 0x56, 0xbd, 0x78, 0xf3, 0x90, 0x16, 0x8d, 0xeb, 0x91, 0xf1, 0x28,
+	0x16, 0x58, 0x03, 0x33, 0x36, 0x50, 0x49, 0x2d, 0x6e, 0x54, 0xc2, 0xfc, 0x0c, 0xbf, 0x70, 0xbb,
+	0xa6, 0xa8, 0xfb, 0x7d, 0x7f, 0x62, 0xa1, 0x2f, 0xae, 0x9c, 0x11, 0x51, 0x63, 0xdb, 0x67, 0x0b,
+	0xac, 0x1c, 0x20, 0x9a, 0xe8, 0x1d, 0x3a, 0x26, 0xce, 0xec, 0x9c, 0x25, 0xe7, 0x1c, 0x91, 0x57,
+	0x7f, 0xe3, 0x81, 0xd5, 0xc9, 0xcb, 0x13, 0xee, 0x81, 0xb9, 0x7c, 0x25, 0x4b, 0x62, 0xef, 0xf8,
+	0x6f, 0x96, 0xfb, 0x75, 0x07, 0x53, 0x0e, 0x78, 0x17, 0x5c, 0x6f, 0x73, 0xd6, 0x73, 0x8a, 0xec,
+	0x3b, 0x9f, 0x55, 0x36, 0x2b, 0x04, 0xde, 0x06, 0x40, 0xb2, 0x3c, 0xc0, 0x2c, 0xcf, 0x8a, 0x64,
+	0xce, 0x3d, 0xe9, 0xa3, 0x54, 0x9a, 0xfc, 0x51, 0xaa, 0x81, 0x19, 0x92, 0xa8, 0x1d, 0x23, 0x8f,
+	0xed, 0xdb, 0xcb, 0xcf, 0xcd, 0x1f, 0x4e, 0x4e, 0xfd, 0xc2, 0xab, 0x53, 0xbf, 0xf0, 0xf6, 0xd4,
+	0xf7, 0x7e, 0x1a, 0xf8, 0xde, 0x2f, 0x03, 0xdf, 0xfb, 0x63, 0xe0, 0x7b, 0x27, 0x03, 0xdf, 0xfb,
+	0x73, 0xe0, 0x7b, 0x6f, 0x06, 0x7e, 0xe1, 0xed, 0xc0, 0xf7, 0x5e, 0x9e, 0xf9, 0x85, 0x93, 0x33,
+	0xbf, 0xf0, 0xea, 0xcc, 0x2f, 0x3c, 0xff, 0xa0, 0xc3, 0x86, 0x6d, 0x21, 0xec, 0xf2, 0x3f, 0x75,
+	0x3b, 0x23, 0xc7, 0x56, 0x59, 0x17, 0x67, 0xfb, 0xef, 0x01, 0x00, 0x45, 0xa0, 0xcc, 0x93, 0x0d,
+	0x0a, 0x00, 0x00,
 }
 
 func (this *NamespaceDetail) Equal(that interface{}) bool {
@@ -485,6 +679,14 @@ func (this *NamespaceDetail) Equal(that interface{}) bool {
 	} else if !this.FailoverEndTime.Equal(*that1.FailoverEndTime) {
 		return false
 	}
+	if len(this.FailoverHistory) != len(that1.FailoverHistory) {
+		return false
+	}
+	for i := range this.FailoverHistory {
+		if !this.FailoverHistory[i].Equal(that1.FailoverHistory[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *NamespaceInfo) Equal(that interface{}) bool {
@@ -577,6 +779,39 @@ func (this *NamespaceConfig) Equal(that interface{}) bool {
 	if this.VisibilityArchivalUri != that1.VisibilityArchivalUri {
 		return false
 	}
+	if !this.Quotas.Equal(that1.Quotas) {
+		return false
+	}
+	return true
+}
+func (this *NamespaceQuotas) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NamespaceQuotas)
+	if !ok {
+		that2, ok := that.(NamespaceQuotas)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxStartWorkflowRps != that1.MaxStartWorkflowRps {
+		return false
+	}
+	if this.MaxPendingWorkflows != that1.MaxPendingWorkflows {
+		return false
+	}
+	if this.MaxActionsPerSecond != that1.MaxActionsPerSecond {
+		return false
+	}
 	return true
 }
 func (this *NamespaceReplicationConfig) Equal(that interface{}) bool {
@@ -609,13 +844,59 @@ func (this *NamespaceReplicationConfig) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.State != that1.State {
+		return false
+	}
+	if this.HandoverClusterName != that1.HandoverClusterName {
+		return false
+	}
+	return true
+}
+func (this *NamespaceFailoverEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NamespaceFailoverEvent)
+	if !ok {
+		that2, ok := that.(NamespaceFailoverEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.FailoverTime == nil {
+		if this.FailoverTime != nil {
+			return false
+		}
+	} else if !this.FailoverTime.Equal(*that1.FailoverTime) {
+		return false
+	}
+	if this.FromCluster != that1.FromCluster {
+		return false
+	}
+	if this.ToCluster != that1.ToCluster {
+		return false
+	}
+	if this.FailoverVersion != that1.FailoverVersion {
+		return false
+	}
+	if this.Identity != that1.Identity {
+		return false
+	}
 	return true
 }
 func (this *NamespaceDetail) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 11)
+	s := make([]string, 0, 12)
 	s = append(s, "&persistence.NamespaceDetail{")
 	if this.Info != nil {
 		s = append(s, "Info: "+fmt.Sprintf("%#v", this.Info)+",\n")
@@ -630,6 +911,9 @@ func (this *NamespaceDetail) GoString() string {
 	s = append(s, "FailoverNotificationVersion: "+fmt.Sprintf("%#v", this.FailoverNotificationVersion)+",\n")
 	s = append(s, "FailoverVersion: "+fmt.Sprintf("%#v", this.FailoverVersion)+",\n")
 	s = append(s, "FailoverEndTime: "+fmt.Sprintf("%#v", this.FailoverEndTime)+",\n")
+	if this.FailoverHistory != nil {
+		s = append(s, "FailoverHistory: "+fmt.Sprintf("%#v", this.FailoverHistory)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -664,7 +948,7 @@ func (this *NamespaceConfig) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 11)
+	s := make([]string, 0, 12)
 	s = append(s, "&persistence.NamespaceConfig{")
 	s = append(s, "Retention: "+fmt.Sprintf("%#v", this.Retention)+",\n")
 	s = append(s, "ArchivalBucket: "+fmt.Sprintf("%#v", this.ArchivalBucket)+",\n")
@@ -675,6 +959,21 @@ func (this *NamespaceConfig) GoString() string {
 	s = append(s, "HistoryArchivalUri: "+fmt.Sprintf("%#v", this.HistoryArchivalUri)+",\n")
 	s = append(s, "VisibilityArchivalState: "+fmt.Sprintf("%#v", this.VisibilityArchivalState)+",\n")
 	s = append(s, "VisibilityArchivalUri: "+fmt.Sprintf("%#v", this.VisibilityArchivalUri)+",\n")
+	if this.Quotas != nil {
+		s = append(s, "Quotas: "+fmt.Sprintf("%#v", this.Quotas)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *NamespaceQuotas) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&persistence.NamespaceQuotas{")
+	s = append(s, "MaxStartWorkflowRps: "+fmt.Sprintf("%#v", this.MaxStartWorkflowRps)+",\n")
+	s = append(s, "MaxPendingWorkflows: "+fmt.Sprintf("%#v", this.MaxPendingWorkflows)+",\n")
+	s = append(s, "MaxActionsPerSecond: "+fmt.Sprintf("%#v", this.MaxActionsPerSecond)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -682,10 +981,26 @@ func (this *NamespaceReplicationConfig) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 8)
 	s = append(s, "&persistence.NamespaceReplicationConfig{")
 	s = append(s, "ActiveClusterName: "+fmt.Sprintf("%#v", this.ActiveClusterName)+",\n")
 	s = append(s, "Clusters: "+fmt.Sprintf("%#v", this.Clusters)+",\n")
+	s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
+	s = append(s, "HandoverClusterName: "+fmt.Sprintf("%#v", this.HandoverClusterName)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *NamespaceFailoverEvent) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&persistence.NamespaceFailoverEvent{")
+	s = append(s, "FailoverTime: "+fmt.Sprintf("%#v", this.FailoverTime)+",\n")
+	s = append(s, "FromCluster: "+fmt.Sprintf("%#v", this.FromCluster)+",\n")
+	s = append(s, "ToCluster: "+fmt.Sprintf("%#v", this.ToCluster)+",\n")
+	s = append(s, "FailoverVersion: "+fmt.Sprintf("%#v", this.FailoverVersion)+",\n")
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -717,6 +1032,20 @@ func (m *NamespaceDetail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FailoverHistory) > 0 {
+		for iNdEx := len(m.FailoverHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailoverHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNamespaces(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.FailoverEndTime != nil {
 		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.FailoverEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.FailoverEndTime):])
 		if err1 != nil {
@@ -876,6 +1205,18 @@ func (m *NamespaceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Quotas != nil {
+		{
+			size, err := m.Quotas.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNamespaces(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.VisibilityArchivalUri) > 0 {
 		i -= len(m.VisibilityArchivalUri)
 		copy(dAtA[i:], m.VisibilityArchivalUri)
@@ -920,18 +1261,56 @@ func (m *NamespaceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 	}
 	if m.Retention != nil {
-		n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Retention, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Retention):])
-		if err6 != nil {
-			return 0, err6
+		n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Retention, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Retention):])
+		if err7 != nil {
+			return 0, err7
 		}
-		i -= n6
-		i = encodeVarintNamespaces(dAtA, i, uint64(n6))
+		i -= n7
+		i = encodeVarintNamespaces(dAtA, i, uint64(n7))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
+func (m *NamespaceQuotas) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamespaceQuotas) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespaceQuotas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxActionsPerSecond != 0 {
+		i = encodeVarintNamespaces(dAtA, i, uint64(m.MaxActionsPerSecond))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxPendingWorkflows != 0 {
+		i = encodeVarintNamespaces(dAtA, i, uint64(m.MaxPendingWorkflows))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxStartWorkflowRps != 0 {
+		i = encodeVarintNamespaces(dAtA, i, uint64(m.MaxStartWorkflowRps))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *NamespaceReplicationConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -952,6 +1331,18 @@ func (m *NamespaceReplicationConfig) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if len(m.HandoverClusterName) > 0 {
+		i -= len(m.HandoverClusterName)
+		copy(dAtA[i:], m.HandoverClusterName)
+		i = encodeVarintNamespaces(dAtA, i, uint64(len(m.HandoverClusterName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.State != 0 {
+		i = encodeVarintNamespaces(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Clusters) > 0 {
 		for iNdEx := len(m.Clusters) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Clusters[iNdEx])
@@ -971,22 +1362,81 @@ func (m *NamespaceReplicationConfig) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintNamespaces(dAtA []byte, offset int, v uint64) int {
-	offset -= sovNamespaces(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *NamespaceFailoverEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *NamespaceDetail) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+
+func (m *NamespaceFailoverEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespaceFailoverEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintNamespaces(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.FailoverVersion != 0 {
+		i = encodeVarintNamespaces(dAtA, i, uint64(m.FailoverVersion))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ToCluster) > 0 {
+		i -= len(m.ToCluster)
+		copy(dAtA[i:], m.ToCluster)
+		i = encodeVarintNamespaces(dAtA, i, uint64(len(m.ToCluster)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FromCluster) > 0 {
+		i -= len(m.FromCluster)
+		copy(dAtA[i:], m.FromCluster)
+		i = encodeVarintNamespaces(dAtA, i, uint64(len(m.FromCluster)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.FailoverTime != nil {
+		n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.FailoverTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.FailoverTime):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintNamespaces(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintNamespaces(dAtA []byte, offset int, v uint64) int {
+	offset -= sovNamespaces(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *NamespaceDetail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	if m.Info != nil {
 		l = m.Info.Size()
@@ -1013,6 +1463,12 @@ func (m *NamespaceDetail) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.FailoverEndTime)
 		n += 1 + l + sovNamespaces(uint64(l))
 	}
+	if len(m.FailoverHistory) > 0 {
+		for _, e := range m.FailoverHistory {
+			l = e.Size()
+			n += 1 + l + sovNamespaces(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1084,6 +1540,28 @@ func (m *NamespaceConfig) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovNamespaces(uint64(l))
 	}
+	if m.Quotas != nil {
+		l = m.Quotas.Size()
+		n += 1 + l + sovNamespaces(uint64(l))
+	}
+	return n
+}
+
+func (m *NamespaceQuotas) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxStartWorkflowRps != 0 {
+		n += 1 + sovNamespaces(uint64(m.MaxStartWorkflowRps))
+	}
+	if m.MaxPendingWorkflows != 0 {
+		n += 1 + sovNamespaces(uint64(m.MaxPendingWorkflows))
+	}
+	if m.MaxActionsPerSecond != 0 {
+		n += 1 + sovNamespaces(uint64(m.MaxActionsPerSecond))
+	}
 	return n
 }
 
@@ -1103,6 +1581,41 @@ func (m *NamespaceReplicationConfig) Size() (n int) {
 			n += 1 + l + sovNamespaces(uint64(l))
 		}
 	}
+	if m.State != 0 {
+		n += 1 + sovNamespaces(uint64(m.State))
+	}
+	l = len(m.HandoverClusterName)
+	if l > 0 {
+		n += 1 + l + sovNamespaces(uint64(l))
+	}
+	return n
+}
+
+func (m *NamespaceFailoverEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FailoverTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.FailoverTime)
+		n += 1 + l + sovNamespaces(uint64(l))
+	}
+	l = len(m.FromCluster)
+	if l > 0 {
+		n += 1 + l + sovNamespaces(uint64(l))
+	}
+	l = len(m.ToCluster)
+	if l > 0 {
+		n += 1 + l + sovNamespaces(uint64(l))
+	}
+	if m.FailoverVersion != 0 {
+		n += 1 + sovNamespaces(uint64(m.FailoverVersion))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovNamespaces(uint64(l))
+	}
 	return n
 }
 
@@ -1116,6 +1629,11 @@ func (this *NamespaceDetail) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForFailoverHistory := "[]*NamespaceFailoverEvent{"
+	for _, f := range this.FailoverHistory {
+		repeatedStringForFailoverHistory += strings.Replace(f.String(), "NamespaceFailoverEvent", "NamespaceFailoverEvent", 1) + ","
+	}
+	repeatedStringForFailoverHistory += "}"
 	s := strings.Join([]string{`&NamespaceDetail{`,
 		`Info:` + strings.Replace(this.Info.String(), "NamespaceInfo", "NamespaceInfo", 1) + `,`,
 		`Config:` + strings.Replace(this.Config.String(), "NamespaceConfig", "NamespaceConfig", 1) + `,`,
@@ -1124,6 +1642,7 @@ func (this *NamespaceDetail) String() string {
 		`FailoverNotificationVersion:` + fmt.Sprintf("%v", this.FailoverNotificationVersion) + `,`,
 		`FailoverVersion:` + fmt.Sprintf("%v", this.FailoverVersion) + `,`,
 		`FailoverEndTime:` + strings.Replace(fmt.Sprintf("%v", this.FailoverEndTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`FailoverHistory:` + repeatedStringForFailoverHistory + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1165,6 +1684,19 @@ func (this *NamespaceConfig) String() string {
 		`HistoryArchivalUri:` + fmt.Sprintf("%v", this.HistoryArchivalUri) + `,`,
 		`VisibilityArchivalState:` + fmt.Sprintf("%v", this.VisibilityArchivalState) + `,`,
 		`VisibilityArchivalUri:` + fmt.Sprintf("%v", this.VisibilityArchivalUri) + `,`,
+		`Quotas:` + strings.Replace(this.Quotas.String(), "NamespaceQuotas", "NamespaceQuotas", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NamespaceQuotas) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NamespaceQuotas{`,
+		`MaxStartWorkflowRps:` + fmt.Sprintf("%v", this.MaxStartWorkflowRps) + `,`,
+		`MaxPendingWorkflows:` + fmt.Sprintf("%v", this.MaxPendingWorkflows) + `,`,
+		`MaxActionsPerSecond:` + fmt.Sprintf("%v", this.MaxActionsPerSecond) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1176,6 +1708,22 @@ func (this *NamespaceReplicationConfig) String() string {
 	s := strings.Join([]string{`&NamespaceReplicationConfig{`,
 		`ActiveClusterName:` + fmt.Sprintf("%v", this.ActiveClusterName) + `,`,
 		`Clusters:` + fmt.Sprintf("%v", this.Clusters) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`HandoverClusterName:` + fmt.Sprintf("%v", this.HandoverClusterName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NamespaceFailoverEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NamespaceFailoverEvent{`,
+		`FailoverTime:` + strings.Replace(fmt.Sprintf("%v", this.FailoverTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`FromCluster:` + fmt.Sprintf("%v", this.FromCluster) + `,`,
+		`ToCluster:` + fmt.Sprintf("%v", this.ToCluster) + `,`,
+		`FailoverVersion:` + fmt.Sprintf("%v", this.FailoverVersion) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1418,6 +1966,40 @@ func (m *NamespaceDetail) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailoverHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaces
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailoverHistory = append(m.FailoverHistory, &NamespaceFailoverEvent{})
+			if err := m.FailoverHistory[len(m.FailoverHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNamespaces(dAtA[iNdEx:])
@@ -2004,6 +2586,152 @@ func (m *NamespaceConfig) Unmarshal(dAtA []byte) error {
 			}
 			m.VisibilityArchivalUri = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quotas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaces
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Quotas == nil {
+				m.Quotas = &NamespaceQuotas{}
+			}
+			if err := m.Quotas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNamespaces(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespaceQuotas) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNamespaces
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceQuotas: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceQuotas: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStartWorkflowRps", wireType)
+			}
+			m.MaxStartWorkflowRps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaces
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStartWorkflowRps |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPendingWorkflows", wireType)
+			}
+			m.MaxPendingWorkflows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaces
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPendingWorkflows |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxActionsPerSecond", wireType)
+			}
+			m.MaxActionsPerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaces
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxActionsPerSecond |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNamespaces(dAtA[iNdEx:])
@@ -2121,6 +2849,261 @@ func (m *NamespaceReplicationConfig) Unmarshal(dAtA []byte) error {
 			}
 			m.Clusters = append(m.Clusters, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaces
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= v12.NamespaceReplicationState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HandoverClusterName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaces
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HandoverClusterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNamespaces(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespaceFailoverEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNamespaces
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceFailoverEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceFailoverEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailoverTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaces
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FailoverTime == nil {
+				m.FailoverTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.FailoverTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromCluster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaces
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromCluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToCluster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaces
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToCluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailoverVersion", wireType)
+			}
+			m.FailoverVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaces
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailoverVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaces
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNamespaces(dAtA[iNdEx:])
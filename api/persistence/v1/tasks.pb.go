@@ -28,6 +28,7 @@
 package persistence
 
 import (
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
@@ -38,6 +39,7 @@ import (
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	v1 "go.temporal.io/api/enums/v1"
@@ -114,6 +116,8 @@ type TaskInfo struct {
 	ScheduleId  int64      `protobuf:"varint,4,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
 	CreateTime  *time.Time `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3,stdtime" json:"create_time,omitempty"`
 	ExpiryTime  *time.Time `protobuf:"bytes,6,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time,omitempty"`
+	// W3C trace context of the request which added the task.
+	TraceContext map[string]string `protobuf:"bytes,7,rep,name=trace_context,json=traceContext,proto3" json:"trace_context,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *TaskInfo) Reset()      { *m = TaskInfo{} }
@@ -190,6 +194,13 @@ func (m *TaskInfo) GetExpiryTime() *time.Time {
 	return nil
 }
 
+func (m *TaskInfo) GetTraceContext() map[string]string {
+	if m != nil {
+		return m.TraceContext
+	}
+	return nil
+}
+
 // task_queue column
 type TaskQueueInfo struct {
 	NamespaceId    string           `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
@@ -199,6 +210,9 @@ type TaskQueueInfo struct {
 	AckLevel       int64            `protobuf:"varint,5,opt,name=ack_level,json=ackLevel,proto3" json:"ack_level,omitempty"`
 	ExpiryTime     *time.Time       `protobuf:"bytes,6,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time,omitempty"`
 	LastUpdateTime *time.Time       `protobuf:"bytes,7,opt,name=last_update_time,json=lastUpdateTime,proto3,stdtime" json:"last_update_time,omitempty"`
+	// User data is only persisted in the root partition of the workflow task queue,
+	// all other partitions of the task queue fetch it from there.
+	UserData *TaskQueueUserData `protobuf:"bytes,8,opt,name=user_data,json=userData,proto3" json:"user_data,omitempty"`
 }
 
 func (m *TaskQueueInfo) Reset()      { *m = TaskQueueInfo{} }
@@ -282,10 +296,252 @@ func (m *TaskQueueInfo) GetLastUpdateTime() *time.Time {
 	return nil
 }
 
+func (m *TaskQueueInfo) GetUserData() *TaskQueueUserData {
+	if m != nil {
+		return m.UserData
+	}
+	return nil
+}
+
+// user data attached to a task queue, e.g. by routing features
+type TaskQueueUserData struct {
+	// Incremented on every update of the user data.
+	Version        int64      `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	LastUpdateTime *time.Time `protobuf:"bytes,2,opt,name=last_update_time,json=lastUpdateTime,proto3,stdtime" json:"last_update_time,omitempty"`
+	// Worker build ids which can process tasks of the task queue.
+	VersioningRules []*TaskQueueVersioningRule `protobuf:"bytes,3,rep,name=versioning_rules,json=versioningRules,proto3" json:"versioning_rules,omitempty"`
+	// Maximum number of tasks per second dispatched from the task queue, 0 means no limit.
+	MaxTasksPerSecond float64           `protobuf:"fixed64,4,opt,name=max_tasks_per_second,json=maxTasksPerSecond,proto3" json:"max_tasks_per_second,omitempty"`
+	Metadata          map[string]string `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Compatible sets of worker build ids which workflow tasks of the task queue are routed to.
+	VersioningData *VersioningData `protobuf:"bytes,6,opt,name=versioning_data,json=versioningData,proto3" json:"versioning_data,omitempty"`
+}
+
+func (m *TaskQueueUserData) Reset()      { *m = TaskQueueUserData{} }
+func (*TaskQueueUserData) ProtoMessage() {}
+func (*TaskQueueUserData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c734e3b35cf986, []int{3}
+}
+func (m *TaskQueueUserData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskQueueUserData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskQueueUserData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskQueueUserData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskQueueUserData.Merge(m, src)
+}
+func (m *TaskQueueUserData) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskQueueUserData) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskQueueUserData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskQueueUserData proto.InternalMessageInfo
+
+func (m *TaskQueueUserData) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *TaskQueueUserData) GetLastUpdateTime() *time.Time {
+	if m != nil {
+		return m.LastUpdateTime
+	}
+	return nil
+}
+
+func (m *TaskQueueUserData) GetVersioningRules() []*TaskQueueVersioningRule {
+	if m != nil {
+		return m.VersioningRules
+	}
+	return nil
+}
+
+func (m *TaskQueueUserData) GetMaxTasksPerSecond() float64 {
+	if m != nil {
+		return m.MaxTasksPerSecond
+	}
+	return 0
+}
+
+func (m *TaskQueueUserData) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *TaskQueueUserData) GetVersioningData() *VersioningData {
+	if m != nil {
+		return m.VersioningData
+	}
+	return nil
+}
+
+// sets of mutually compatible worker build ids of a task queue
+type VersioningData struct {
+	// Ordered by increasing precedence, the last set is the default set which new workflows are routed to.
+	VersionSets []*CompatibleVersionSet `protobuf:"bytes,1,rep,name=version_sets,json=versionSets,proto3" json:"version_sets,omitempty"`
+}
+
+func (m *VersioningData) Reset()      { *m = VersioningData{} }
+func (*VersioningData) ProtoMessage() {}
+func (*VersioningData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c734e3b35cf986, []int{4}
+}
+func (m *VersioningData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VersioningData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VersioningData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VersioningData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersioningData.Merge(m, src)
+}
+func (m *VersioningData) XXX_Size() int {
+	return m.Size()
+}
+func (m *VersioningData) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersioningData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VersioningData proto.InternalMessageInfo
+
+func (m *VersioningData) GetVersionSets() []*CompatibleVersionSet {
+	if m != nil {
+		return m.VersionSets
+	}
+	return nil
+}
+
+type CompatibleVersionSet struct {
+	// Ordered by increasing precedence, the last build id is the default build id of the set.
+	BuildIds []string `protobuf:"bytes,1,rep,name=build_ids,json=buildIds,proto3" json:"build_ids,omitempty"`
+}
+
+func (m *CompatibleVersionSet) Reset()      { *m = CompatibleVersionSet{} }
+func (*CompatibleVersionSet) ProtoMessage() {}
+func (*CompatibleVersionSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c734e3b35cf986, []int{5}
+}
+func (m *CompatibleVersionSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompatibleVersionSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompatibleVersionSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompatibleVersionSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompatibleVersionSet.Merge(m, src)
+}
+func (m *CompatibleVersionSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompatibleVersionSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompatibleVersionSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompatibleVersionSet proto.InternalMessageInfo
+
+func (m *CompatibleVersionSet) GetBuildIds() []string {
+	if m != nil {
+		return m.BuildIds
+	}
+	return nil
+}
+
+type TaskQueueVersioningRule struct {
+	BuildId string `protobuf:"bytes,1,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
+	// Percentage of the tasks routed to workers with the build id.
+	Percentage int32 `protobuf:"varint,2,opt,name=percentage,proto3" json:"percentage,omitempty"`
+}
+
+func (m *TaskQueueVersioningRule) Reset()      { *m = TaskQueueVersioningRule{} }
+func (*TaskQueueVersioningRule) ProtoMessage() {}
+func (*TaskQueueVersioningRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c734e3b35cf986, []int{6}
+}
+func (m *TaskQueueVersioningRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskQueueVersioningRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskQueueVersioningRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskQueueVersioningRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskQueueVersioningRule.Merge(m, src)
+}
+func (m *TaskQueueVersioningRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskQueueVersioningRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskQueueVersioningRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskQueueVersioningRule proto.InternalMessageInfo
+
+func (m *TaskQueueVersioningRule) GetBuildId() string {
+	if m != nil {
+		return m.BuildId
+	}
+	return ""
+}
+
+func (m *TaskQueueVersioningRule) GetPercentage() int32 {
+	if m != nil {
+		return m.Percentage
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*AllocatedTaskInfo)(nil), "temporal.server.api.persistence.v1.AllocatedTaskInfo")
 	proto.RegisterType((*TaskInfo)(nil), "temporal.server.api.persistence.v1.TaskInfo")
+	proto.RegisterMapType((map[string]string)(nil), "temporal.server.api.persistence.v1.TaskInfo.TraceContextEntry")
 	proto.RegisterType((*TaskQueueInfo)(nil), "temporal.server.api.persistence.v1.TaskQueueInfo")
+	proto.RegisterType((*TaskQueueUserData)(nil), "temporal.server.api.persistence.v1.TaskQueueUserData")
+	proto.RegisterMapType((map[string]string)(nil), "temporal.server.api.persistence.v1.TaskQueueUserData.MetadataEntry")
+	proto.RegisterType((*VersioningData)(nil), "temporal.server.api.persistence.v1.VersioningData")
+	proto.RegisterType((*CompatibleVersionSet)(nil), "temporal.server.api.persistence.v1.CompatibleVersionSet")
+	proto.RegisterType((*TaskQueueVersioningRule)(nil), "temporal.server.api.persistence.v1.TaskQueueVersioningRule")
 }
 
 func init() {
@@ -293,41 +549,62 @@ func init() {
 }
 
 var fileDescriptor_f9c734e3b35cf986 = []byte{
-	// 541 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xbf, 0x6e, 0x13, 0x4b,
-	0x14, 0xc6, 0x77, 0x63, 0xc7, 0xb1, 0xc7, 0xf7, 0x46, 0x30, 0x12, 0xc2, 0x32, 0xd2, 0x38, 0xb1,
-	0x10, 0x4a, 0x81, 0x66, 0x95, 0x40, 0x81, 0x44, 0x83, 0xd3, 0x19, 0x68, 0x58, 0x99, 0x86, 0xc6,
-	0x9a, 0xec, 0x1c, 0x9b, 0x65, 0xd7, 0x33, 0xc3, 0xce, 0xac, 0x83, 0x3b, 0x1e, 0x80, 0x22, 0x8f,
-	0xc1, 0xa3, 0x50, 0xba, 0x4c, 0x07, 0x5e, 0x37, 0x74, 0xe4, 0x11, 0xd0, 0xcc, 0x66, 0x9d, 0x34,
-	0x08, 0x17, 0x74, 0x73, 0xfe, 0x7c, 0xdf, 0x39, 0xfe, 0x1d, 0x2f, 0xa2, 0x06, 0x66, 0x4a, 0x66,
-	0x2c, 0x0d, 0x34, 0x64, 0x73, 0xc8, 0x02, 0xa6, 0xe2, 0x40, 0x41, 0xa6, 0x63, 0x6d, 0x40, 0x44,
-	0x10, 0xcc, 0x8f, 0x03, 0xc3, 0x74, 0xa2, 0xa9, 0xca, 0xa4, 0x91, 0xb8, 0x5f, 0xf5, 0xd3, 0xb2,
-	0x9f, 0x32, 0x15, 0xd3, 0x5b, 0xfd, 0x74, 0x7e, 0xdc, 0xed, 0x4d, 0xa5, 0x9c, 0xa6, 0x10, 0x38,
-	0xc5, 0x59, 0x3e, 0x09, 0x4c, 0x3c, 0x03, 0x6d, 0xd8, 0x4c, 0x95, 0x26, 0xdd, 0x43, 0x0e, 0x0a,
-	0x04, 0x07, 0x11, 0xc5, 0xa0, 0x83, 0xa9, 0x9c, 0x4a, 0x97, 0x77, 0xaf, 0xeb, 0x96, 0x47, 0x9b,
-	0xbd, 0xec, 0x42, 0x20, 0xf2, 0x99, 0xae, 0x56, 0x19, 0x7f, 0xcc, 0x21, 0x87, 0xb2, 0xaf, 0x2f,
-	0xd0, 0xdd, 0x41, 0x9a, 0xca, 0x88, 0x19, 0xe0, 0x23, 0xa6, 0x93, 0xa1, 0x98, 0x48, 0xfc, 0x02,
-	0xd5, 0x39, 0x33, 0xac, 0xe3, 0x1f, 0xf8, 0x47, 0xed, 0x93, 0xc7, 0xf4, 0xef, 0x3b, 0xd3, 0x4a,
-	0x1b, 0x3a, 0x25, 0xbe, 0x8f, 0xf6, 0xdc, 0xa8, 0x98, 0x77, 0x76, 0x0e, 0xfc, 0xa3, 0x5a, 0xd8,
-	0xb0, 0xe1, 0x90, 0xf7, 0xbf, 0xec, 0xa0, 0xe6, 0x66, 0xce, 0x21, 0xfa, 0x4f, 0xb0, 0x19, 0x68,
-	0xc5, 0x22, 0xb0, 0xad, 0x76, 0x5e, 0x2b, 0x6c, 0x6f, 0x72, 0x43, 0x8e, 0x7b, 0xa8, 0x7d, 0x2e,
-	0xb3, 0x64, 0x92, 0xca, 0xf3, 0xca, 0xac, 0x15, 0xa2, 0x2a, 0x35, 0xe4, 0xf8, 0x1e, 0x6a, 0x64,
-	0xb9, 0xb0, 0xb5, 0x9a, 0xab, 0xed, 0x66, 0xb9, 0x28, 0x75, 0x3a, 0x7a, 0x0f, 0x3c, 0x4f, 0x9d,
-	0x73, 0xdd, 0x2d, 0x81, 0xaa, 0xd4, 0x90, 0xe3, 0x01, 0x6a, 0x47, 0x19, 0x30, 0x03, 0x63, 0x4b,
-	0xb7, 0xb3, 0xeb, 0x7e, 0x6a, 0x97, 0x96, 0xe8, 0x69, 0x85, 0x9e, 0x8e, 0x2a, 0xf4, 0xa7, 0xf5,
-	0x8b, 0xef, 0x3d, 0x3f, 0x44, 0xa5, 0xc8, 0xa6, 0xad, 0x05, 0x7c, 0x52, 0x71, 0xb6, 0x28, 0x2d,
-	0x1a, 0xdb, 0x5a, 0x94, 0x22, 0x9b, 0xee, 0xff, 0xda, 0x41, 0xff, 0x5b, 0x1c, 0x6f, 0xec, 0x49,
-	0xb6, 0x65, 0x82, 0x51, 0xdd, 0x86, 0xd7, 0x30, 0xdc, 0x1b, 0x0f, 0x50, 0xcb, 0x01, 0x37, 0x0b,
-	0x05, 0x8e, 0xc4, 0xfe, 0xc9, 0xc3, 0x9b, 0xbb, 0xd9, 0x83, 0xb9, 0xff, 0x40, 0x75, 0x2a, 0x37,
-	0x6f, 0xb4, 0x50, 0x10, 0x36, 0xad, 0xcc, 0xbe, 0xf0, 0x33, 0x54, 0x4f, 0x62, 0x51, 0xb2, 0xda,
-	0x42, 0xfd, 0x2a, 0x16, 0x3c, 0x74, 0x0a, 0xfc, 0x00, 0xb5, 0x58, 0x94, 0x8c, 0x53, 0x98, 0x43,
-	0xea, 0x48, 0xd6, 0xc2, 0x26, 0x8b, 0x92, 0xd7, 0x36, 0xfe, 0x07, 0x94, 0xf0, 0x4b, 0x74, 0x27,
-	0x65, 0xda, 0x8c, 0x73, 0xc5, 0x37, 0x07, 0xdb, 0xdb, 0xd2, 0x67, 0xdf, 0x2a, 0xdf, 0x3a, 0xa1,
-	0x2d, 0x9d, 0x7e, 0x58, 0xae, 0x88, 0x77, 0xb9, 0x22, 0xde, 0xd5, 0x8a, 0xf8, 0x9f, 0x0b, 0xe2,
-	0x7f, 0x2d, 0x88, 0xff, 0xad, 0x20, 0xfe, 0xb2, 0x20, 0xfe, 0x8f, 0x82, 0xf8, 0x3f, 0x0b, 0xe2,
-	0x5d, 0x15, 0xc4, 0xbf, 0x58, 0x13, 0x6f, 0xb9, 0x26, 0xde, 0xe5, 0x9a, 0x78, 0xef, 0x9e, 0x4e,
-	0xe5, 0x0d, 0x8f, 0x58, 0xfe, 0xf9, 0x63, 0x7f, 0x7e, 0x2b, 0x3c, 0x6b, 0xb8, 0xad, 0x9e, 0xfc,
-	0x0e, 0x00, 0x00, 0xff, 0xff, 0xd1, 0xd6, 0x4b, 0x05, 0x25, 0x04, 0x00, 0x00,
+	// 867 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x6f, 0xe3, 0x54,
+	0x10, 0x8f, 0xd7, 0x49, 0x93, 0x4c, 0xda, 0xd2, 0x3e, 0x15, 0x6d, 0x08, 0x92, 0xdb, 0x8d, 0x10,
+	0xea, 0x01, 0xd9, 0xda, 0x2e, 0x48, 0x15, 0x95, 0x80, 0x6e, 0xe1, 0x10, 0xfe, 0x48, 0xf0, 0x36,
+	0xcb, 0x81, 0x3d, 0x58, 0xaf, 0xf6, 0x34, 0x98, 0xd8, 0x7e, 0xe6, 0xbd, 0x67, 0x6f, 0x73, 0xe3,
+	0x0b, 0x20, 0xed, 0xc7, 0xe0, 0xcc, 0x57, 0xe0, 0xc2, 0xb1, 0xc7, 0xbd, 0x41, 0xd3, 0x0b, 0xc7,
+	0xfd, 0x08, 0xe8, 0x3d, 0xdb, 0x69, 0xaa, 0xdd, 0x15, 0xe9, 0x8a, 0xdb, 0xcc, 0x78, 0x7e, 0xbf,
+	0x99, 0xdf, 0xcc, 0xbc, 0x04, 0x5c, 0x85, 0x49, 0xc6, 0x05, 0x8b, 0x3d, 0x89, 0xa2, 0x40, 0xe1,
+	0xb1, 0x2c, 0xf2, 0x32, 0x14, 0x32, 0x92, 0x0a, 0xd3, 0x00, 0xbd, 0xe2, 0xbe, 0xa7, 0x98, 0x9c,
+	0x4a, 0x37, 0x13, 0x5c, 0x71, 0x32, 0xac, 0xf3, 0xdd, 0x32, 0xdf, 0x65, 0x59, 0xe4, 0x2e, 0xe5,
+	0xbb, 0xc5, 0xfd, 0xc1, 0xee, 0x84, 0xf3, 0x49, 0x8c, 0x9e, 0x41, 0x9c, 0xe6, 0x67, 0x9e, 0x8a,
+	0x12, 0x94, 0x8a, 0x25, 0x59, 0x49, 0x32, 0xb8, 0x17, 0x62, 0x86, 0x69, 0x88, 0x69, 0x10, 0xa1,
+	0xf4, 0x26, 0x7c, 0xc2, 0x4d, 0xdc, 0x58, 0x55, 0xca, 0xfb, 0x8b, 0xbe, 0x74, 0x43, 0x98, 0xe6,
+	0x89, 0xac, 0x5b, 0xf1, 0x7f, 0xce, 0x31, 0xc7, 0x32, 0x6f, 0x98, 0xc2, 0xf6, 0x71, 0x1c, 0xf3,
+	0x80, 0x29, 0x0c, 0xc7, 0x4c, 0x4e, 0x47, 0xe9, 0x19, 0x27, 0x9f, 0x41, 0x33, 0x64, 0x8a, 0xf5,
+	0xad, 0x3d, 0x6b, 0xbf, 0x77, 0xf0, 0x81, 0xfb, 0xdf, 0x3d, 0xbb, 0x35, 0x96, 0x1a, 0x24, 0xb9,
+	0x0b, 0x6d, 0x53, 0x2a, 0x0a, 0xfb, 0x77, 0xf6, 0xac, 0x7d, 0x9b, 0xae, 0x69, 0x77, 0x14, 0x0e,
+	0x7f, 0xb7, 0xa1, 0xb3, 0xa8, 0x73, 0x0f, 0xd6, 0x53, 0x96, 0xa0, 0xcc, 0x58, 0x80, 0x3a, 0x55,
+	0xd7, 0xeb, 0xd2, 0xde, 0x22, 0x36, 0x0a, 0xc9, 0x2e, 0xf4, 0x9e, 0x72, 0x31, 0x3d, 0x8b, 0xf9,
+	0xd3, 0x9a, 0xac, 0x4b, 0xa1, 0x0e, 0x8d, 0x42, 0xf2, 0x36, 0xac, 0x89, 0x3c, 0xd5, 0xdf, 0x6c,
+	0xf3, 0xad, 0x25, 0xf2, 0xb4, 0xc4, 0xc9, 0xe0, 0x47, 0x0c, 0xf3, 0xd8, 0x30, 0x37, 0x4d, 0x13,
+	0x50, 0x87, 0x46, 0x21, 0x39, 0x86, 0x5e, 0x20, 0x90, 0x29, 0xf4, 0xf5, 0x74, 0xfb, 0x2d, 0x23,
+	0x75, 0xe0, 0x96, 0xa3, 0x77, 0xeb, 0xd1, 0xbb, 0xe3, 0x7a, 0xf4, 0x0f, 0x9b, 0xcf, 0xfe, 0xda,
+	0xb5, 0x28, 0x94, 0x20, 0x1d, 0xd6, 0x14, 0x78, 0x9e, 0x45, 0x62, 0x56, 0x52, 0xac, 0xad, 0x4a,
+	0x51, 0x82, 0x0c, 0x45, 0x00, 0x1b, 0x4a, 0x68, 0xf5, 0x01, 0x4f, 0x15, 0x9e, 0xab, 0x7e, 0x7b,
+	0xcf, 0xde, 0xef, 0x1d, 0x7c, 0x72, 0x9b, 0x91, 0xbb, 0x63, 0xcd, 0x70, 0x52, 0x12, 0x7c, 0x91,
+	0x2a, 0x31, 0xa3, 0xeb, 0x6a, 0x29, 0x34, 0xf8, 0x14, 0xb6, 0x5f, 0x4a, 0x21, 0x5b, 0x60, 0x4f,
+	0x71, 0x56, 0x8d, 0x5c, 0x9b, 0x64, 0x07, 0x5a, 0x05, 0x8b, 0x73, 0xac, 0x86, 0x5c, 0x3a, 0x1f,
+	0xdf, 0x39, 0xb4, 0x86, 0x7f, 0xd8, 0xb0, 0xa1, 0xab, 0x7d, 0xa7, 0x0f, 0x67, 0xd5, 0xcd, 0x11,
+	0x68, 0x6a, 0xb7, 0x62, 0x33, 0x36, 0x39, 0x86, 0xae, 0x39, 0x0b, 0x35, 0xcb, 0xd0, 0xec, 0x6b,
+	0xf3, 0xe0, 0xbd, 0x6b, 0xa9, 0x5a, 0xa3, 0xb9, 0xd4, 0x5a, 0x9d, 0xa9, 0x37, 0x9e, 0x65, 0x48,
+	0x3b, 0x1a, 0xa6, 0x2d, 0x72, 0x08, 0xcd, 0x69, 0x94, 0x96, 0x1b, 0x5d, 0x01, 0xfd, 0x55, 0x94,
+	0x86, 0xd4, 0x20, 0xc8, 0xbb, 0xd0, 0x65, 0xc1, 0xd4, 0x8f, 0xb1, 0xc0, 0xd8, 0xec, 0xdb, 0xa6,
+	0x1d, 0x16, 0x4c, 0xbf, 0xd6, 0xfe, 0xff, 0xb1, 0xcb, 0x2f, 0x61, 0x2b, 0x66, 0x52, 0xf9, 0x79,
+	0x16, 0x2e, 0xce, 0xaa, 0xbd, 0x22, 0xcf, 0xa6, 0x46, 0x3e, 0x36, 0x40, 0xc3, 0x45, 0xa1, 0x9b,
+	0x4b, 0x14, 0xbe, 0x79, 0x86, 0x1d, 0x43, 0xf2, 0xd1, 0xaa, 0x37, 0x61, 0x74, 0x3f, 0x96, 0x28,
+	0x3e, 0x67, 0x8a, 0xd1, 0x4e, 0x5e, 0x59, 0xc3, 0x5f, 0x9b, 0xb0, 0xfd, 0xd2, 0x77, 0xd2, 0x87,
+	0x76, 0xa1, 0x39, 0x78, 0x6a, 0x96, 0x68, 0xd3, 0xda, 0x7d, 0xa5, 0x9e, 0x3b, 0x6f, 0xa8, 0xe7,
+	0x0c, 0xb6, 0x2a, 0xda, 0x28, 0x9d, 0xf8, 0x22, 0x8f, 0x51, 0xf6, 0x6d, 0x73, 0xea, 0x47, 0xb7,
+	0x92, 0xf5, 0xfd, 0x82, 0x84, 0xe6, 0x31, 0xd2, 0xb7, 0x8a, 0x1b, 0xbe, 0x24, 0x1e, 0xec, 0x24,
+	0xec, 0xdc, 0x37, 0xbf, 0xb8, 0x7e, 0x86, 0xc2, 0x97, 0x18, 0xf0, 0xea, 0x5a, 0x2c, 0xba, 0x9d,
+	0xb0, 0x73, 0x4d, 0x25, 0xbf, 0x45, 0xf1, 0xc8, 0x7c, 0x20, 0x3e, 0x74, 0x12, 0x54, 0xcc, 0xcc,
+	0xb9, 0x65, 0x1a, 0x3a, 0x79, 0xa3, 0x39, 0xbb, 0xdf, 0x54, 0x2c, 0xe5, 0x03, 0x5c, 0x90, 0x92,
+	0x27, 0xb0, 0xd4, 0x64, 0xb9, 0xcf, 0xf2, 0xb8, 0x0e, 0x56, 0xa9, 0x73, 0xad, 0xd7, 0x2c, 0x73,
+	0xb3, 0xb8, 0xe1, 0x0f, 0x8e, 0x60, 0xe3, 0x46, 0xdd, 0x5b, 0xbd, 0xea, 0x04, 0x36, 0x6f, 0xd2,
+	0x93, 0x27, 0xb0, 0x5e, 0x15, 0xf0, 0x25, 0x2a, 0xd9, 0xb7, 0xcc, 0x40, 0x0e, 0x57, 0x69, 0xf4,
+	0x84, 0x27, 0x19, 0x53, 0xd1, 0x69, 0x5c, 0xaf, 0xe8, 0x11, 0x2a, 0xda, 0x2b, 0x16, 0xb6, 0x1c,
+	0x3e, 0x80, 0x9d, 0x57, 0x25, 0xe9, 0x67, 0x79, 0x9a, 0x47, 0x71, 0xe8, 0x47, 0x61, 0x59, 0xb1,
+	0x4b, 0x3b, 0x26, 0x30, 0x0a, 0xe5, 0x70, 0x0c, 0x77, 0x5f, 0xb3, 0x7b, 0xf2, 0x0e, 0x74, 0x6a,
+	0x5c, 0xa5, 0xb7, 0x5d, 0xc1, 0x88, 0x03, 0x90, 0xa1, 0x08, 0x30, 0x55, 0x6c, 0x52, 0x0a, 0x6f,
+	0xd1, 0xa5, 0xc8, 0xc3, 0x9f, 0x2e, 0x2e, 0x9d, 0xc6, 0xf3, 0x4b, 0xa7, 0xf1, 0xe2, 0xd2, 0xb1,
+	0x7e, 0x99, 0x3b, 0xd6, 0x6f, 0x73, 0xc7, 0xfa, 0x73, 0xee, 0x58, 0x17, 0x73, 0xc7, 0xfa, 0x7b,
+	0xee, 0x58, 0xff, 0xcc, 0x9d, 0xc6, 0x8b, 0xb9, 0x63, 0x3d, 0xbb, 0x72, 0x1a, 0x17, 0x57, 0x4e,
+	0xe3, 0xf9, 0x95, 0xd3, 0xf8, 0xe1, 0xc3, 0x09, 0xbf, 0x9e, 0x44, 0xc4, 0x5f, 0xff, 0x87, 0x7f,
+	0xb4, 0xe4, 0x9e, 0xae, 0x99, 0x37, 0xf2, 0xe0, 0xdf, 0x01, 0x00, 0x6d, 0x19, 0xac, 0x95, 0x29,
+	0x08, 0x00, 0x00,
 }
 
 func (this *AllocatedTaskInfo) Equal(that interface{}) bool {
@@ -402,6 +679,14 @@ func (this *TaskInfo) Equal(that interface{}) bool {
 	} else if !this.ExpiryTime.Equal(*that1.ExpiryTime) {
 		return false
 	}
+	if len(this.TraceContext) != len(that1.TraceContext) {
+		return false
+	}
+	for i := range this.TraceContext {
+		if this.TraceContext[i] != that1.TraceContext[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *TaskQueueInfo) Equal(that interface{}) bool {
@@ -452,98 +737,319 @@ func (this *TaskQueueInfo) Equal(that interface{}) bool {
 	} else if !this.LastUpdateTime.Equal(*that1.LastUpdateTime) {
 		return false
 	}
+	if !this.UserData.Equal(that1.UserData) {
+		return false
+	}
 	return true
 }
-func (this *AllocatedTaskInfo) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 6)
-	s = append(s, "&persistence.AllocatedTaskInfo{")
-	if this.Data != nil {
-		s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+func (this *TaskQueueUserData) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s = append(s, "TaskId: "+fmt.Sprintf("%#v", this.TaskId)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *TaskInfo) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*TaskQueueUserData)
+	if !ok {
+		that2, ok := that.(TaskQueueUserData)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 10)
-	s = append(s, "&persistence.TaskInfo{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
-	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
-	s = append(s, "ScheduleId: "+fmt.Sprintf("%#v", this.ScheduleId)+",\n")
-	s = append(s, "CreateTime: "+fmt.Sprintf("%#v", this.CreateTime)+",\n")
-	s = append(s, "ExpiryTime: "+fmt.Sprintf("%#v", this.ExpiryTime)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *TaskQueueInfo) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 11)
-	s = append(s, "&persistence.TaskQueueInfo{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
-	s = append(s, "TaskType: "+fmt.Sprintf("%#v", this.TaskType)+",\n")
-	s = append(s, "Kind: "+fmt.Sprintf("%#v", this.Kind)+",\n")
-	s = append(s, "AckLevel: "+fmt.Sprintf("%#v", this.AckLevel)+",\n")
-	s = append(s, "ExpiryTime: "+fmt.Sprintf("%#v", this.ExpiryTime)+",\n")
-	s = append(s, "LastUpdateTime: "+fmt.Sprintf("%#v", this.LastUpdateTime)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func valueToGoStringTasks(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	if this.Version != that1.Version {
+		return false
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
-}
-func (m *AllocatedTaskInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1.LastUpdateTime == nil {
+		if this.LastUpdateTime != nil {
+			return false
+		}
+	} else if !this.LastUpdateTime.Equal(*that1.LastUpdateTime) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AllocatedTaskInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *AllocatedTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TaskId != 0 {
-		i = encodeVarintTasks(dAtA, i, uint64(m.TaskId))
-		i--
-		dAtA[i] = 0x10
+	if len(this.VersioningRules) != len(that1.VersioningRules) {
+		return false
 	}
-	if m.Data != nil {
-		{
-			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTasks(dAtA, i, uint64(size))
+	for i := range this.VersioningRules {
+		if !this.VersioningRules[i].Equal(that1.VersioningRules[i]) {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
+	if this.MaxTasksPerSecond != that1.MaxTasksPerSecond {
+		return false
+	}
+	if len(this.Metadata) != len(that1.Metadata) {
+		return false
+	}
+	for i := range this.Metadata {
+		if this.Metadata[i] != that1.Metadata[i] {
+			return false
+		}
+	}
+	if !this.VersioningData.Equal(that1.VersioningData) {
+		return false
+	}
+	return true
+}
+func (this *VersioningData) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VersioningData)
+	if !ok {
+		that2, ok := that.(VersioningData)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.VersionSets) != len(that1.VersionSets) {
+		return false
+	}
+	for i := range this.VersionSets {
+		if !this.VersionSets[i].Equal(that1.VersionSets[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *CompatibleVersionSet) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CompatibleVersionSet)
+	if !ok {
+		that2, ok := that.(CompatibleVersionSet)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.BuildIds) != len(that1.BuildIds) {
+		return false
+	}
+	for i := range this.BuildIds {
+		if this.BuildIds[i] != that1.BuildIds[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *TaskQueueVersioningRule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TaskQueueVersioningRule)
+	if !ok {
+		that2, ok := that.(TaskQueueVersioningRule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BuildId != that1.BuildId {
+		return false
+	}
+	if this.Percentage != that1.Percentage {
+		return false
+	}
+	return true
+}
+func (this *AllocatedTaskInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&persistence.AllocatedTaskInfo{")
+	if this.Data != nil {
+		s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	}
+	s = append(s, "TaskId: "+fmt.Sprintf("%#v", this.TaskId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TaskInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&persistence.TaskInfo{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
+	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
+	s = append(s, "ScheduleId: "+fmt.Sprintf("%#v", this.ScheduleId)+",\n")
+	s = append(s, "CreateTime: "+fmt.Sprintf("%#v", this.CreateTime)+",\n")
+	s = append(s, "ExpiryTime: "+fmt.Sprintf("%#v", this.ExpiryTime)+",\n")
+	keysForTraceContext := make([]string, 0, len(this.TraceContext))
+	for k, _ := range this.TraceContext {
+		keysForTraceContext = append(keysForTraceContext, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForTraceContext)
+	mapStringForTraceContext := "map[string]string{"
+	for _, k := range keysForTraceContext {
+		mapStringForTraceContext += fmt.Sprintf("%#v: %#v,", k, this.TraceContext[k])
+	}
+	mapStringForTraceContext += "}"
+	if this.TraceContext != nil {
+		s = append(s, "TraceContext: "+mapStringForTraceContext+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TaskQueueInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&persistence.TaskQueueInfo{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "TaskType: "+fmt.Sprintf("%#v", this.TaskType)+",\n")
+	s = append(s, "Kind: "+fmt.Sprintf("%#v", this.Kind)+",\n")
+	s = append(s, "AckLevel: "+fmt.Sprintf("%#v", this.AckLevel)+",\n")
+	s = append(s, "ExpiryTime: "+fmt.Sprintf("%#v", this.ExpiryTime)+",\n")
+	s = append(s, "LastUpdateTime: "+fmt.Sprintf("%#v", this.LastUpdateTime)+",\n")
+	if this.UserData != nil {
+		s = append(s, "UserData: "+fmt.Sprintf("%#v", this.UserData)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TaskQueueUserData) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&persistence.TaskQueueUserData{")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "LastUpdateTime: "+fmt.Sprintf("%#v", this.LastUpdateTime)+",\n")
+	if this.VersioningRules != nil {
+		s = append(s, "VersioningRules: "+fmt.Sprintf("%#v", this.VersioningRules)+",\n")
+	}
+	s = append(s, "MaxTasksPerSecond: "+fmt.Sprintf("%#v", this.MaxTasksPerSecond)+",\n")
+	keysForMetadata := make([]string, 0, len(this.Metadata))
+	for k, _ := range this.Metadata {
+		keysForMetadata = append(keysForMetadata, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForMetadata)
+	mapStringForMetadata := "map[string]string{"
+	for _, k := range keysForMetadata {
+		mapStringForMetadata += fmt.Sprintf("%#v: %#v,", k, this.Metadata[k])
+	}
+	mapStringForMetadata += "}"
+	if this.Metadata != nil {
+		s = append(s, "Metadata: "+mapStringForMetadata+",\n")
+	}
+	if this.VersioningData != nil {
+		s = append(s, "VersioningData: "+fmt.Sprintf("%#v", this.VersioningData)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *VersioningData) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&persistence.VersioningData{")
+	if this.VersionSets != nil {
+		s = append(s, "VersionSets: "+fmt.Sprintf("%#v", this.VersionSets)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CompatibleVersionSet) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&persistence.CompatibleVersionSet{")
+	s = append(s, "BuildIds: "+fmt.Sprintf("%#v", this.BuildIds)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TaskQueueVersioningRule) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&persistence.TaskQueueVersioningRule{")
+	s = append(s, "BuildId: "+fmt.Sprintf("%#v", this.BuildId)+",\n")
+	s = append(s, "Percentage: "+fmt.Sprintf("%#v", this.Percentage)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringTasks(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *AllocatedTaskInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllocatedTaskInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllocatedTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TaskId != 0 {
+		i = encodeVarintTasks(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Data != nil {
+		{
+			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTasks(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *TaskInfo) Marshal() (dAtA []byte, err error) {
@@ -566,6 +1072,25 @@ func (m *TaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TraceContext) > 0 {
+		for k := range m.TraceContext {
+			v := m.TraceContext[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintTasks(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintTasks(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintTasks(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.ExpiryTime != nil {
 		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
 		if err2 != nil {
@@ -635,24 +1160,36 @@ func (m *TaskQueueInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.LastUpdateTime != nil {
-		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpdateTime):])
-		if err4 != nil {
-			return 0, err4
+	if m.UserData != nil {
+		{
+			size, err := m.UserData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTasks(dAtA, i, uint64(size))
 		}
-		i -= n4
-		i = encodeVarintTasks(dAtA, i, uint64(n4))
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x42
 	}
-	if m.ExpiryTime != nil {
-		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
+	if m.LastUpdateTime != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpdateTime):])
 		if err5 != nil {
 			return 0, err5
 		}
 		i -= n5
 		i = encodeVarintTasks(dAtA, i, uint64(n5))
 		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ExpiryTime != nil {
+		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintTasks(dAtA, i, uint64(n6))
+		i--
 		dAtA[i] = 0x32
 	}
 	if m.AckLevel != 0 {
@@ -687,35 +1224,228 @@ func (m *TaskQueueInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTasks(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTasks(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TaskQueueUserData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *AllocatedTaskInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Data != nil {
-		l = m.Data.Size()
-		n += 1 + l + sovTasks(uint64(l))
-	}
-	if m.TaskId != 0 {
-		n += 1 + sovTasks(uint64(m.TaskId))
-	}
-	return n
+
+func (m *TaskQueueUserData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TaskInfo) Size() (n int) {
-	if m == nil {
+func (m *TaskQueueUserData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VersioningData != nil {
+		{
+			size, err := m.VersioningData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTasks(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Metadata) > 0 {
+		for k := range m.Metadata {
+			v := m.Metadata[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintTasks(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintTasks(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintTasks(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.MaxTasksPerSecond != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.MaxTasksPerSecond))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if len(m.VersioningRules) > 0 {
+		for iNdEx := len(m.VersioningRules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VersioningRules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTasks(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.LastUpdateTime != nil {
+		n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpdateTime):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintTasks(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Version != 0 {
+		i = encodeVarintTasks(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VersioningData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VersioningData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VersioningData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VersionSets) > 0 {
+		for iNdEx := len(m.VersionSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VersionSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTasks(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CompatibleVersionSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompatibleVersionSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompatibleVersionSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BuildIds) > 0 {
+		for iNdEx := len(m.BuildIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BuildIds[iNdEx])
+			copy(dAtA[i:], m.BuildIds[iNdEx])
+			i = encodeVarintTasks(dAtA, i, uint64(len(m.BuildIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskQueueVersioningRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskQueueVersioningRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskQueueVersioningRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Percentage != 0 {
+		i = encodeVarintTasks(dAtA, i, uint64(m.Percentage))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BuildId) > 0 {
+		i -= len(m.BuildId)
+		copy(dAtA[i:], m.BuildId)
+		i = encodeVarintTasks(dAtA, i, uint64(len(m.BuildId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTasks(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTasks(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AllocatedTaskInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovTasks(uint64(l))
+	}
+	if m.TaskId != 0 {
+		n += 1 + sovTasks(uint64(m.TaskId))
+	}
+	return n
+}
+
+func (m *TaskInfo) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -743,6 +1473,14 @@ func (m *TaskInfo) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime)
 		n += 1 + l + sovTasks(uint64(l))
 	}
+	if len(m.TraceContext) > 0 {
+		for k, v := range m.TraceContext {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovTasks(uint64(len(k))) + 1 + len(v) + sovTasks(uint64(len(v)))
+			n += mapEntrySize + 1 + sovTasks(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -777,6 +1515,93 @@ func (m *TaskQueueInfo) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpdateTime)
 		n += 1 + l + sovTasks(uint64(l))
 	}
+	if m.UserData != nil {
+		l = m.UserData.Size()
+		n += 1 + l + sovTasks(uint64(l))
+	}
+	return n
+}
+
+func (m *TaskQueueUserData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovTasks(uint64(m.Version))
+	}
+	if m.LastUpdateTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpdateTime)
+		n += 1 + l + sovTasks(uint64(l))
+	}
+	if len(m.VersioningRules) > 0 {
+		for _, e := range m.VersioningRules {
+			l = e.Size()
+			n += 1 + l + sovTasks(uint64(l))
+		}
+	}
+	if m.MaxTasksPerSecond != 0 {
+		n += 9
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovTasks(uint64(len(k))) + 1 + len(v) + sovTasks(uint64(len(v)))
+			n += mapEntrySize + 1 + sovTasks(uint64(mapEntrySize))
+		}
+	}
+	if m.VersioningData != nil {
+		l = m.VersioningData.Size()
+		n += 1 + l + sovTasks(uint64(l))
+	}
+	return n
+}
+
+func (m *VersioningData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VersionSets) > 0 {
+		for _, e := range m.VersionSets {
+			l = e.Size()
+			n += 1 + l + sovTasks(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CompatibleVersionSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BuildIds) > 0 {
+		for _, s := range m.BuildIds {
+			l = len(s)
+			n += 1 + l + sovTasks(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TaskQueueVersioningRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BuildId)
+	if l > 0 {
+		n += 1 + l + sovTasks(uint64(l))
+	}
+	if m.Percentage != 0 {
+		n += 1 + sovTasks(uint64(m.Percentage))
+	}
 	return n
 }
 
@@ -801,6 +1626,16 @@ func (this *TaskInfo) String() string {
 	if this == nil {
 		return "nil"
 	}
+	keysForTraceContext := make([]string, 0, len(this.TraceContext))
+	for k, _ := range this.TraceContext {
+		keysForTraceContext = append(keysForTraceContext, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForTraceContext)
+	mapStringForTraceContext := "map[string]string{"
+	for _, k := range keysForTraceContext {
+		mapStringForTraceContext += fmt.Sprintf("%v: %v,", k, this.TraceContext[k])
+	}
+	mapStringForTraceContext += "}"
 	s := strings.Join([]string{`&TaskInfo{`,
 		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
 		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
@@ -808,6 +1643,7 @@ func (this *TaskInfo) String() string {
 		`ScheduleId:` + fmt.Sprintf("%v", this.ScheduleId) + `,`,
 		`CreateTime:` + strings.Replace(fmt.Sprintf("%v", this.CreateTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`ExpiryTime:` + strings.Replace(fmt.Sprintf("%v", this.ExpiryTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`TraceContext:` + mapStringForTraceContext + `,`,
 		`}`,
 	}, "")
 	return s
@@ -816,27 +1652,569 @@ func (this *TaskQueueInfo) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&TaskQueueInfo{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`TaskType:` + fmt.Sprintf("%v", this.TaskType) + `,`,
-		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
-		`AckLevel:` + fmt.Sprintf("%v", this.AckLevel) + `,`,
-		`ExpiryTime:` + strings.Replace(fmt.Sprintf("%v", this.ExpiryTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`LastUpdateTime:` + strings.Replace(fmt.Sprintf("%v", this.LastUpdateTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringTasks(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	s := strings.Join([]string{`&TaskQueueInfo{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`TaskType:` + fmt.Sprintf("%v", this.TaskType) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`AckLevel:` + fmt.Sprintf("%v", this.AckLevel) + `,`,
+		`ExpiryTime:` + strings.Replace(fmt.Sprintf("%v", this.ExpiryTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`LastUpdateTime:` + strings.Replace(fmt.Sprintf("%v", this.LastUpdateTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`UserData:` + strings.Replace(this.UserData.String(), "TaskQueueUserData", "TaskQueueUserData", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TaskQueueUserData) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForVersioningRules := "[]*TaskQueueVersioningRule{"
+	for _, f := range this.VersioningRules {
+		repeatedStringForVersioningRules += strings.Replace(f.String(), "TaskQueueVersioningRule", "TaskQueueVersioningRule", 1) + ","
+	}
+	repeatedStringForVersioningRules += "}"
+	keysForMetadata := make([]string, 0, len(this.Metadata))
+	for k, _ := range this.Metadata {
+		keysForMetadata = append(keysForMetadata, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForMetadata)
+	mapStringForMetadata := "map[string]string{"
+	for _, k := range keysForMetadata {
+		mapStringForMetadata += fmt.Sprintf("%v: %v,", k, this.Metadata[k])
+	}
+	mapStringForMetadata += "}"
+	s := strings.Join([]string{`&TaskQueueUserData{`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`LastUpdateTime:` + strings.Replace(fmt.Sprintf("%v", this.LastUpdateTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`VersioningRules:` + repeatedStringForVersioningRules + `,`,
+		`MaxTasksPerSecond:` + fmt.Sprintf("%v", this.MaxTasksPerSecond) + `,`,
+		`Metadata:` + mapStringForMetadata + `,`,
+		`VersioningData:` + strings.Replace(this.VersioningData.String(), "VersioningData", "VersioningData", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VersioningData) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForVersionSets := "[]*CompatibleVersionSet{"
+	for _, f := range this.VersionSets {
+		repeatedStringForVersionSets += strings.Replace(f.String(), "CompatibleVersionSet", "CompatibleVersionSet", 1) + ","
+	}
+	repeatedStringForVersionSets += "}"
+	s := strings.Join([]string{`&VersioningData{`,
+		`VersionSets:` + repeatedStringForVersionSets + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CompatibleVersionSet) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CompatibleVersionSet{`,
+		`BuildIds:` + fmt.Sprintf("%v", this.BuildIds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TaskQueueVersioningRule) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TaskQueueVersioningRule{`,
+		`BuildId:` + fmt.Sprintf("%v", this.BuildId) + `,`,
+		`Percentage:` + fmt.Sprintf("%v", this.Percentage) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringTasks(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *AllocatedTaskInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTasks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllocatedTaskInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllocatedTaskInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTasks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = &TaskInfo{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTasks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTasks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTasks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTasks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTasks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			m.ScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduleId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTasks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreateTime == nil {
+				m.CreateTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CreateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTasks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiryTime == nil {
+				m.ExpiryTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceContext", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTasks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TraceContext == nil {
+				m.TraceContext = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTasks
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTasks
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTasks
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthTasks
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTasks
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthTasks
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthTasks
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTasks(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthTasks
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.TraceContext[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTasks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *AllocatedTaskInfo) Unmarshal(dAtA []byte) error {
+func (m *TaskQueueInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -859,15 +2237,136 @@ func (m *AllocatedTaskInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllocatedTaskInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: TaskQueueInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllocatedTaskInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TaskQueueInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTasks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTasks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
+			}
+			m.TaskType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskType |= v1.TaskQueueType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= v1.TaskQueueKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckLevel", wireType)
+			}
+			m.AckLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AckLevel |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -894,18 +2393,18 @@ func (m *AllocatedTaskInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Data == nil {
-				m.Data = &TaskInfo{}
+			if m.ExpiryTime == nil {
+				m.ExpiryTime = new(time.Time)
 			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
 			}
-			m.TaskId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTasks
@@ -915,11 +2414,64 @@ func (m *AllocatedTaskInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTasks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastUpdateTime == nil {
+				m.LastUpdateTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastUpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTasks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UserData == nil {
+				m.UserData = &TaskQueueUserData{}
+			}
+			if err := m.UserData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTasks(dAtA[iNdEx:])
@@ -944,7 +2496,7 @@ func (m *AllocatedTaskInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TaskInfo) Unmarshal(dAtA []byte) error {
+func (m *TaskQueueUserData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -967,17 +2519,17 @@ func (m *TaskInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TaskInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: TaskQueueUserData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TaskQueueUserData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var stringLen uint64
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTasks
@@ -987,29 +2539,16 @@ func (m *TaskInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Version |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTasks
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTasks
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTasks
@@ -1019,29 +2558,33 @@ func (m *TaskInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTasks
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTasks
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastUpdateTime == nil {
+				m.LastUpdateTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastUpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.WorkflowId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersioningRules", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTasks
@@ -1051,46 +2594,40 @@ func (m *TaskInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTasks
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTasks
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RunId = string(dAtA[iNdEx:postIndex])
+			m.VersioningRules = append(m.VersioningRules, &TaskQueueVersioningRule{})
+			if err := m.VersioningRules[len(m.VersioningRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTasksPerSecond", wireType)
 			}
-			m.ScheduleId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTasks
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ScheduleId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.MaxTasksPerSecond = float64(math.Float64frombits(v))
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1117,16 +2654,107 @@ func (m *TaskInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreateTime == nil {
-				m.CreateTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CreateTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTasks
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTasks
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTasks
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthTasks
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTasks
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthTasks
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthTasks
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTasks(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthTasks
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersioningData", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1153,10 +2781,10 @@ func (m *TaskInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExpiryTime == nil {
-				m.ExpiryTime = new(time.Time)
+			if m.VersioningData == nil {
+				m.VersioningData = &VersioningData{}
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VersioningData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1184,7 +2812,7 @@ func (m *TaskInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TaskQueueInfo) Unmarshal(dAtA []byte) error {
+func (m *VersioningData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1207,17 +2835,17 @@ func (m *TaskQueueInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TaskQueueInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: VersioningData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskQueueInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VersioningData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionSets", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTasks
@@ -1227,27 +2855,82 @@ func (m *TaskQueueInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTasks
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTasks
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			m.VersionSets = append(m.VersionSets, &CompatibleVersionSet{})
+			if err := m.VersionSets[len(m.VersionSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTasks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompatibleVersionSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTasks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompatibleVersionSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompatibleVersionSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1275,70 +2958,66 @@ func (m *TaskQueueInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.BuildIds = append(m.BuildIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTasks(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.TaskType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTasks
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TaskType |= v1.TaskQueueType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthTasks
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTasks
 			}
-			m.Kind = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTasks
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Kind |= v1.TaskQueueKind(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AckLevel", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskQueueVersioningRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTasks
 			}
-			m.AckLevel = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTasks
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AckLevel |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskQueueVersioningRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskQueueVersioningRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTasks
@@ -1348,33 +3027,29 @@ func (m *TaskQueueInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTasks
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTasks
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExpiryTime == nil {
-				m.ExpiryTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BuildId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
 			}
-			var msglen int
+			m.Percentage = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTasks
@@ -1384,28 +3059,11 @@ func (m *TaskQueueInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Percentage |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTasks
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTasks
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LastUpdateTime == nil {
-				m.LastUpdateTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastUpdateTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTasks(dAtA[iNdEx:])
@@ -38,15 +38,18 @@ import (
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
-	v14 "go.temporal.io/api/common/v1"
-	v15 "go.temporal.io/api/failure/v1"
-	v13 "go.temporal.io/api/history/v1"
+	v16 "go.temporal.io/api/common/v1"
+	v14 "go.temporal.io/api/enums/v1"
+	v17 "go.temporal.io/api/failure/v1"
+	v15 "go.temporal.io/api/history/v1"
 	v11 "go.temporal.io/api/namespace/v1"
 	v12 "go.temporal.io/api/replication/v1"
 	v1 "go.temporal.io/server/api/enums/v1"
-	v16 "go.temporal.io/server/api/history/v1"
+	v18 "go.temporal.io/server/api/history/v1"
+	v13 "go.temporal.io/server/api/persistence/v1"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -71,6 +74,7 @@ type ReplicationTask struct {
 	//	*ReplicationTask_SyncActivityTaskAttributes
 	//	*ReplicationTask_HistoryMetadataTaskAttributes
 	//	*ReplicationTask_HistoryTaskV2Attributes
+	//	*ReplicationTask_SearchAttributesTaskAttributes
 	Attributes isReplicationTask_Attributes `protobuf_oneof:"attributes"`
 }
 
@@ -131,13 +135,17 @@ type ReplicationTask_HistoryMetadataTaskAttributes struct {
 type ReplicationTask_HistoryTaskV2Attributes struct {
 	HistoryTaskV2Attributes *HistoryTaskV2Attributes `protobuf:"bytes,8,opt,name=history_task_v2_attributes,json=historyTaskV2Attributes,proto3,oneof" json:"history_task_v2_attributes,omitempty"`
 }
+type ReplicationTask_SearchAttributesTaskAttributes struct {
+	SearchAttributesTaskAttributes *SearchAttributesTaskAttributes `protobuf:"bytes,9,opt,name=search_attributes_task_attributes,json=searchAttributesTaskAttributes,proto3,oneof" json:"search_attributes_task_attributes,omitempty"`
+}
 
-func (*ReplicationTask_NamespaceTaskAttributes) isReplicationTask_Attributes()       {}
-func (*ReplicationTask_HistoryTaskAttributes) isReplicationTask_Attributes()         {}
-func (*ReplicationTask_SyncShardStatusTaskAttributes) isReplicationTask_Attributes() {}
-func (*ReplicationTask_SyncActivityTaskAttributes) isReplicationTask_Attributes()    {}
-func (*ReplicationTask_HistoryMetadataTaskAttributes) isReplicationTask_Attributes() {}
-func (*ReplicationTask_HistoryTaskV2Attributes) isReplicationTask_Attributes()       {}
+func (*ReplicationTask_NamespaceTaskAttributes) isReplicationTask_Attributes()        {}
+func (*ReplicationTask_HistoryTaskAttributes) isReplicationTask_Attributes()          {}
+func (*ReplicationTask_SyncShardStatusTaskAttributes) isReplicationTask_Attributes()  {}
+func (*ReplicationTask_SyncActivityTaskAttributes) isReplicationTask_Attributes()     {}
+func (*ReplicationTask_HistoryMetadataTaskAttributes) isReplicationTask_Attributes()  {}
+func (*ReplicationTask_HistoryTaskV2Attributes) isReplicationTask_Attributes()        {}
+func (*ReplicationTask_SearchAttributesTaskAttributes) isReplicationTask_Attributes() {}
 
 func (m *ReplicationTask) GetAttributes() isReplicationTask_Attributes {
 	if m != nil {
@@ -202,6 +210,13 @@ func (m *ReplicationTask) GetHistoryTaskV2Attributes() *HistoryTaskV2Attributes
 	return nil
 }
 
+func (m *ReplicationTask) GetSearchAttributesTaskAttributes() *SearchAttributesTaskAttributes {
+	if x, ok := m.GetAttributes().(*ReplicationTask_SearchAttributesTaskAttributes); ok {
+		return x.SearchAttributesTaskAttributes
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ReplicationTask) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -211,6 +226,7 @@ func (*ReplicationTask) XXX_OneofWrappers() []interface{} {
 		(*ReplicationTask_SyncActivityTaskAttributes)(nil),
 		(*ReplicationTask_HistoryMetadataTaskAttributes)(nil),
 		(*ReplicationTask_HistoryTaskV2Attributes)(nil),
+		(*ReplicationTask_SearchAttributesTaskAttributes)(nil),
 	}
 }
 
@@ -503,6 +519,7 @@ type NamespaceTaskAttributes struct {
 	ReplicationConfig  *v12.NamespaceReplicationConfig `protobuf:"bytes,5,opt,name=replication_config,json=replicationConfig,proto3" json:"replication_config,omitempty"`
 	ConfigVersion      int64                           `protobuf:"varint,6,opt,name=config_version,json=configVersion,proto3" json:"config_version,omitempty"`
 	FailoverVersion    int64                           `protobuf:"varint,7,opt,name=failover_version,json=failoverVersion,proto3" json:"failover_version,omitempty"`
+	Quotas             *v13.NamespaceQuotas            `protobuf:"bytes,8,opt,name=quotas,proto3" json:"quotas,omitempty"`
 }
 
 func (m *NamespaceTaskAttributes) Reset()      { *m = NamespaceTaskAttributes{} }
@@ -586,6 +603,72 @@ func (m *NamespaceTaskAttributes) GetFailoverVersion() int64 {
 	return 0
 }
 
+func (m *NamespaceTaskAttributes) GetQuotas() *v13.NamespaceQuotas {
+	if m != nil {
+		return m.Quotas
+	}
+	return nil
+}
+
+type SearchAttributesTaskAttributes struct {
+	IndexName              string                          `protobuf:"bytes,1,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	CustomSearchAttributes map[string]v14.IndexedValueType `protobuf:"bytes,2,rep,name=custom_search_attributes,json=customSearchAttributes,proto3" json:"custom_search_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=temporal.api.enums.v1.IndexedValueType"`
+	LastUpdateTime         *time.Time                      `protobuf:"bytes,3,opt,name=last_update_time,json=lastUpdateTime,proto3,stdtime" json:"last_update_time,omitempty"`
+}
+
+func (m *SearchAttributesTaskAttributes) Reset()      { *m = SearchAttributesTaskAttributes{} }
+func (*SearchAttributesTaskAttributes) ProtoMessage() {}
+func (*SearchAttributesTaskAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edd9fae2af6b0532, []int{6}
+}
+func (m *SearchAttributesTaskAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SearchAttributesTaskAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SearchAttributesTaskAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SearchAttributesTaskAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchAttributesTaskAttributes.Merge(m, src)
+}
+func (m *SearchAttributesTaskAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *SearchAttributesTaskAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchAttributesTaskAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchAttributesTaskAttributes proto.InternalMessageInfo
+
+func (m *SearchAttributesTaskAttributes) GetIndexName() string {
+	if m != nil {
+		return m.IndexName
+	}
+	return ""
+}
+
+func (m *SearchAttributesTaskAttributes) GetCustomSearchAttributes() map[string]v14.IndexedValueType {
+	if m != nil {
+		return m.CustomSearchAttributes
+	}
+	return nil
+}
+
+func (m *SearchAttributesTaskAttributes) GetLastUpdateTime() *time.Time {
+	if m != nil {
+		return m.LastUpdateTime
+	}
+	return nil
+}
+
 type HistoryTaskAttributes struct {
 	TargetClusters []string     `protobuf:"bytes,1,rep,name=target_clusters,json=targetClusters,proto3" json:"target_clusters,omitempty"`
 	NamespaceId    string       `protobuf:"bytes,2,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
@@ -594,14 +677,14 @@ type HistoryTaskAttributes struct {
 	FirstEventId   int64        `protobuf:"varint,5,opt,name=first_event_id,json=firstEventId,proto3" json:"first_event_id,omitempty"`
 	NextEventId    int64        `protobuf:"varint,6,opt,name=next_event_id,json=nextEventId,proto3" json:"next_event_id,omitempty"`
 	Version        int64        `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
-	History        *v13.History `protobuf:"bytes,9,opt,name=history,proto3" json:"history,omitempty"`
-	NewRunHistory  *v13.History `protobuf:"bytes,10,opt,name=new_run_history,json=newRunHistory,proto3" json:"new_run_history,omitempty"`
+	History        *v15.History `protobuf:"bytes,9,opt,name=history,proto3" json:"history,omitempty"`
+	NewRunHistory  *v15.History `protobuf:"bytes,10,opt,name=new_run_history,json=newRunHistory,proto3" json:"new_run_history,omitempty"`
 }
 
 func (m *HistoryTaskAttributes) Reset()      { *m = HistoryTaskAttributes{} }
 func (*HistoryTaskAttributes) ProtoMessage() {}
 func (*HistoryTaskAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edd9fae2af6b0532, []int{6}
+	return fileDescriptor_edd9fae2af6b0532, []int{7}
 }
 func (m *HistoryTaskAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -679,14 +762,14 @@ func (m *HistoryTaskAttributes) GetVersion() int64 {
 	return 0
 }
 
-func (m *HistoryTaskAttributes) GetHistory() *v13.History {
+func (m *HistoryTaskAttributes) GetHistory() *v15.History {
 	if m != nil {
 		return m.History
 	}
 	return nil
 }
 
-func (m *HistoryTaskAttributes) GetNewRunHistory() *v13.History {
+func (m *HistoryTaskAttributes) GetNewRunHistory() *v15.History {
 	if m != nil {
 		return m.NewRunHistory
 	}
@@ -706,7 +789,7 @@ type HistoryMetadataTaskAttributes struct {
 func (m *HistoryMetadataTaskAttributes) Reset()      { *m = HistoryMetadataTaskAttributes{} }
 func (*HistoryMetadataTaskAttributes) ProtoMessage() {}
 func (*HistoryMetadataTaskAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edd9fae2af6b0532, []int{7}
+	return fileDescriptor_edd9fae2af6b0532, []int{8}
 }
 func (m *HistoryMetadataTaskAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -793,7 +876,7 @@ type SyncShardStatusTaskAttributes struct {
 func (m *SyncShardStatusTaskAttributes) Reset()      { *m = SyncShardStatusTaskAttributes{} }
 func (*SyncShardStatusTaskAttributes) ProtoMessage() {}
 func (*SyncShardStatusTaskAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edd9fae2af6b0532, []int{8}
+	return fileDescriptor_edd9fae2af6b0532, []int{9}
 }
 func (m *SyncShardStatusTaskAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -853,17 +936,17 @@ type SyncActivityTaskAttributes struct {
 	StartedId          int64               `protobuf:"varint,7,opt,name=started_id,json=startedId,proto3" json:"started_id,omitempty"`
 	StartedTime        *time.Time          `protobuf:"bytes,8,opt,name=started_time,json=startedTime,proto3,stdtime" json:"started_time,omitempty"`
 	LastHeartbeatTime  *time.Time          `protobuf:"bytes,9,opt,name=last_heartbeat_time,json=lastHeartbeatTime,proto3,stdtime" json:"last_heartbeat_time,omitempty"`
-	Details            *v14.Payloads       `protobuf:"bytes,10,opt,name=details,proto3" json:"details,omitempty"`
+	Details            *v16.Payloads       `protobuf:"bytes,10,opt,name=details,proto3" json:"details,omitempty"`
 	Attempt            int32               `protobuf:"varint,11,opt,name=attempt,proto3" json:"attempt,omitempty"`
-	LastFailure        *v15.Failure        `protobuf:"bytes,12,opt,name=last_failure,json=lastFailure,proto3" json:"last_failure,omitempty"`
+	LastFailure        *v17.Failure        `protobuf:"bytes,12,opt,name=last_failure,json=lastFailure,proto3" json:"last_failure,omitempty"`
 	LastWorkerIdentity string              `protobuf:"bytes,13,opt,name=last_worker_identity,json=lastWorkerIdentity,proto3" json:"last_worker_identity,omitempty"`
-	VersionHistory     *v16.VersionHistory `protobuf:"bytes,14,opt,name=version_history,json=versionHistory,proto3" json:"version_history,omitempty"`
+	VersionHistory     *v18.VersionHistory `protobuf:"bytes,14,opt,name=version_history,json=versionHistory,proto3" json:"version_history,omitempty"`
 }
 
 func (m *SyncActivityTaskAttributes) Reset()      { *m = SyncActivityTaskAttributes{} }
 func (*SyncActivityTaskAttributes) ProtoMessage() {}
 func (*SyncActivityTaskAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edd9fae2af6b0532, []int{9}
+	return fileDescriptor_edd9fae2af6b0532, []int{10}
 }
 func (m *SyncActivityTaskAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -955,7 +1038,7 @@ func (m *SyncActivityTaskAttributes) GetLastHeartbeatTime() *time.Time {
 	return nil
 }
 
-func (m *SyncActivityTaskAttributes) GetDetails() *v14.Payloads {
+func (m *SyncActivityTaskAttributes) GetDetails() *v16.Payloads {
 	if m != nil {
 		return m.Details
 	}
@@ -969,7 +1052,7 @@ func (m *SyncActivityTaskAttributes) GetAttempt() int32 {
 	return 0
 }
 
-func (m *SyncActivityTaskAttributes) GetLastFailure() *v15.Failure {
+func (m *SyncActivityTaskAttributes) GetLastFailure() *v17.Failure {
 	if m != nil {
 		return m.LastFailure
 	}
@@ -983,7 +1066,7 @@ func (m *SyncActivityTaskAttributes) GetLastWorkerIdentity() string {
 	return ""
 }
 
-func (m *SyncActivityTaskAttributes) GetVersionHistory() *v16.VersionHistory {
+func (m *SyncActivityTaskAttributes) GetVersionHistory() *v18.VersionHistory {
 	if m != nil {
 		return m.VersionHistory
 	}
@@ -996,16 +1079,16 @@ type HistoryTaskV2Attributes struct {
 	NamespaceId         string                    `protobuf:"bytes,2,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	WorkflowId          string                    `protobuf:"bytes,3,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
 	RunId               string                    `protobuf:"bytes,4,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
-	VersionHistoryItems []*v16.VersionHistoryItem `protobuf:"bytes,5,rep,name=version_history_items,json=versionHistoryItems,proto3" json:"version_history_items,omitempty"`
-	Events              *v14.DataBlob             `protobuf:"bytes,6,opt,name=events,proto3" json:"events,omitempty"`
+	VersionHistoryItems []*v18.VersionHistoryItem `protobuf:"bytes,5,rep,name=version_history_items,json=versionHistoryItems,proto3" json:"version_history_items,omitempty"`
+	Events              *v16.DataBlob             `protobuf:"bytes,6,opt,name=events,proto3" json:"events,omitempty"`
 	// New run events does not need version history since there is no prior events.
-	NewRunEvents *v14.DataBlob `protobuf:"bytes,7,opt,name=new_run_events,json=newRunEvents,proto3" json:"new_run_events,omitempty"`
+	NewRunEvents *v16.DataBlob `protobuf:"bytes,7,opt,name=new_run_events,json=newRunEvents,proto3" json:"new_run_events,omitempty"`
 }
 
 func (m *HistoryTaskV2Attributes) Reset()      { *m = HistoryTaskV2Attributes{} }
 func (*HistoryTaskV2Attributes) ProtoMessage() {}
 func (*HistoryTaskV2Attributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edd9fae2af6b0532, []int{10}
+	return fileDescriptor_edd9fae2af6b0532, []int{11}
 }
 func (m *HistoryTaskV2Attributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1062,21 +1145,21 @@ func (m *HistoryTaskV2Attributes) GetRunId() string {
 	return ""
 }
 
-func (m *HistoryTaskV2Attributes) GetVersionHistoryItems() []*v16.VersionHistoryItem {
+func (m *HistoryTaskV2Attributes) GetVersionHistoryItems() []*v18.VersionHistoryItem {
 	if m != nil {
 		return m.VersionHistoryItems
 	}
 	return nil
 }
 
-func (m *HistoryTaskV2Attributes) GetEvents() *v14.DataBlob {
+func (m *HistoryTaskV2Attributes) GetEvents() *v16.DataBlob {
 	if m != nil {
 		return m.Events
 	}
 	return nil
 }
 
-func (m *HistoryTaskV2Attributes) GetNewRunEvents() *v14.DataBlob {
+func (m *HistoryTaskV2Attributes) GetNewRunEvents() *v16.DataBlob {
 	if m != nil {
 		return m.NewRunEvents
 	}
@@ -1090,6 +1173,8 @@ func init() {
 	proto.RegisterType((*ReplicationMessages)(nil), "temporal.server.api.replication.v1.ReplicationMessages")
 	proto.RegisterType((*ReplicationTaskInfo)(nil), "temporal.server.api.replication.v1.ReplicationTaskInfo")
 	proto.RegisterType((*NamespaceTaskAttributes)(nil), "temporal.server.api.replication.v1.NamespaceTaskAttributes")
+	proto.RegisterType((*SearchAttributesTaskAttributes)(nil), "temporal.server.api.replication.v1.SearchAttributesTaskAttributes")
+	proto.RegisterMapType((map[string]v14.IndexedValueType)(nil), "temporal.server.api.replication.v1.SearchAttributesTaskAttributes.CustomSearchAttributesEntry")
 	proto.RegisterType((*HistoryTaskAttributes)(nil), "temporal.server.api.replication.v1.HistoryTaskAttributes")
 	proto.RegisterType((*HistoryMetadataTaskAttributes)(nil), "temporal.server.api.replication.v1.HistoryMetadataTaskAttributes")
 	proto.RegisterType((*SyncShardStatusTaskAttributes)(nil), "temporal.server.api.replication.v1.SyncShardStatusTaskAttributes")
@@ -1102,101 +1187,114 @@ func init() {
 }
 
 var fileDescriptor_edd9fae2af6b0532 = []byte{
-	// 1503 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x3b, 0x6f, 0x1b, 0xc7,
-	0x16, 0xd6, 0xf2, 0xcd, 0xe1, 0xd3, 0xa3, 0xab, 0x2b, 0x8a, 0x80, 0x68, 0x89, 0xb0, 0xaf, 0xe5,
-	0x8b, 0x60, 0x69, 0x51, 0x45, 0x62, 0x3b, 0x08, 0x20, 0x39, 0x71, 0x44, 0x01, 0x76, 0x8c, 0xb5,
-	0x60, 0x03, 0x69, 0x36, 0x23, 0xee, 0x90, 0x5c, 0x88, 0xdc, 0x25, 0x66, 0x86, 0x54, 0x98, 0x2a,
-	0x40, 0x8a, 0x34, 0x09, 0xe0, 0xff, 0xe0, 0x20, 0x48, 0x95, 0xdf, 0xe1, 0xd2, 0x4d, 0x00, 0xa7,
-	0x4a, 0x4c, 0x37, 0x29, 0xdd, 0xa5, 0x0d, 0xe6, 0xb1, 0xe4, 0x2e, 0x97, 0xa4, 0x19, 0x07, 0xae,
-	0xd2, 0x71, 0xce, 0xe3, 0x3b, 0x33, 0x67, 0xce, 0x39, 0xdf, 0x2c, 0xc1, 0x0d, 0x86, 0x7b, 0x7d,
-	0x97, 0xa0, 0x6e, 0x8d, 0x62, 0x32, 0xc4, 0xa4, 0x86, 0xfa, 0x76, 0x8d, 0xe0, 0x7e, 0xd7, 0x6e,
-	0x22, 0x66, 0xbb, 0x4e, 0x6d, 0xb8, 0x5f, 0xeb, 0x61, 0x4a, 0x51, 0x1b, 0xeb, 0x7d, 0xe2, 0x32,
-	0x17, 0x56, 0x3d, 0x0f, 0x5d, 0x7a, 0xe8, 0xa8, 0x6f, 0xeb, 0x3e, 0x0f, 0x7d, 0xb8, 0x5f, 0xbe,
-	0xdc, 0x76, 0xdd, 0x76, 0x17, 0xd7, 0x84, 0xc7, 0xd9, 0xa0, 0x55, 0x63, 0x76, 0x0f, 0x53, 0x86,
-	0x7a, 0x7d, 0x09, 0x52, 0xde, 0xb5, 0x70, 0x1f, 0x3b, 0x16, 0x76, 0x9a, 0x36, 0xa6, 0xb5, 0xb6,
-	0xdb, 0x76, 0x85, 0x5c, 0xfc, 0x52, 0x26, 0xfa, 0xbc, 0x9d, 0x61, 0x67, 0xd0, 0xa3, 0x7c, 0x4f,
-	0xfe, 0x80, 0xd2, 0xfe, 0xda, 0x52, 0x7b, 0x86, 0xe8, 0xb9, 0x32, 0x7c, 0x6f, 0x9e, 0x61, 0xc7,
-	0xa6, 0xcc, 0x25, 0xa3, 0xd0, 0x71, 0xcb, 0x57, 0x26, 0xd6, 0xdc, 0xac, 0xe9, 0xf6, 0x7a, 0x73,
-	0x92, 0xe2, 0x0b, 0xce, 0xad, 0x1c, 0xd4, 0xc3, 0xb4, 0x8f, 0x9a, 0x38, 0x6c, 0x78, 0x3d, 0x60,
-	0xb8, 0x2c, 0xd1, 0xe5, 0xab, 0x01, 0xd3, 0x85, 0x1b, 0x0c, 0x9a, 0xb5, 0x90, 0xdd, 0x1d, 0x90,
-	0x70, 0xe0, 0xea, 0x8f, 0x49, 0x50, 0x30, 0xa6, 0xe1, 0x4e, 0x11, 0x3d, 0x87, 0xf7, 0x41, 0x9a,
-	0xe7, 0xc5, 0x64, 0xa3, 0x3e, 0x2e, 0x69, 0x3b, 0xda, 0x5e, 0xbe, 0xbe, 0xaf, 0xcf, 0xbb, 0x5e,
-	0x91, 0x46, 0x7d, 0xb8, 0xaf, 0xcf, 0x20, 0x9c, 0x8e, 0xfa, 0xd8, 0x48, 0x31, 0xf5, 0x0b, 0x5e,
-	0x01, 0x79, 0xea, 0x0e, 0x48, 0x13, 0x9b, 0x02, 0xd6, 0xb6, 0x4a, 0x91, 0x1d, 0x6d, 0x2f, 0x6a,
-	0x64, 0xa5, 0x94, 0x7b, 0x34, 0x2c, 0x38, 0x02, 0x5b, 0x93, 0x04, 0x49, 0x43, 0xc4, 0x18, 0xb1,
-	0xcf, 0x06, 0x0c, 0xd3, 0x52, 0x74, 0x47, 0xdb, 0xcb, 0xd4, 0x6f, 0xeb, 0x6f, 0x2e, 0x32, 0xfd,
-	0xbe, 0x07, 0xc2, 0x71, 0x0f, 0x27, 0x10, 0xc7, 0x6b, 0xc6, 0xa6, 0x33, 0x5f, 0x05, 0x29, 0xd8,
-	0x54, 0x79, 0x0c, 0x05, 0x8e, 0x89, 0xc0, 0x37, 0x57, 0x09, 0x7c, 0x2c, 0x21, 0x42, 0x61, 0x37,
-	0x3a, 0xf3, 0x14, 0xf0, 0x7b, 0x0d, 0xec, 0xd2, 0x91, 0xd3, 0x34, 0x69, 0x07, 0x11, 0xcb, 0xa4,
-	0x0c, 0xb1, 0x01, 0x0d, 0xc5, 0x8f, 0x8b, 0xf8, 0x87, 0xab, 0xc4, 0x7f, 0x38, 0x72, 0x9a, 0x0f,
-	0x39, 0xd6, 0x43, 0x01, 0x15, 0xda, 0xc7, 0x36, 0x5d, 0x66, 0x00, 0xbf, 0xd1, 0x80, 0xb0, 0x30,
-	0x51, 0x93, 0xd9, 0x43, 0x9b, 0x85, 0x73, 0x91, 0x10, 0x7b, 0xf9, 0x68, 0xd5, 0xbd, 0x1c, 0x2a,
-	0x9c, 0xd0, 0x46, 0xca, 0x74, 0xa1, 0x16, 0x7e, 0xa7, 0x81, 0x1d, 0xef, 0x2e, 0x7a, 0x98, 0x21,
-	0x0b, 0x31, 0x14, 0xda, 0x48, 0x72, 0xf5, 0xa4, 0xa8, 0x4b, 0xb9, 0xa7, 0xa0, 0xc2, 0x49, 0xe9,
-	0x2c, 0x33, 0x80, 0x5f, 0x81, 0x72, 0xa0, 0x32, 0x86, 0x75, 0xff, 0x3e, 0x52, 0xab, 0x57, 0xa5,
-	0xaf, 0x38, 0x1e, 0xd5, 0x83, 0x55, 0xd9, 0x99, 0xaf, 0x3a, 0xca, 0x02, 0x30, 0x8d, 0x55, 0x7d,
-	0xaa, 0x81, 0xa2, 0xbf, 0xcd, 0xdc, 0x73, 0xec, 0xc0, 0x2d, 0x90, 0x92, 0xd5, 0x63, 0x5b, 0xa2,
-	0x51, 0xe3, 0x46, 0x52, 0xac, 0x1b, 0x16, 0xbc, 0x09, 0xb6, 0xba, 0x88, 0x32, 0x93, 0x60, 0x46,
-	0x6c, 0x3c, 0xc4, 0x96, 0xa9, 0x1a, 0x7f, 0xda, 0x7f, 0xff, 0xe5, 0x06, 0x86, 0xa7, 0xbf, 0x27,
-	0xd5, 0x3e, 0xd7, 0x3e, 0x71, 0x9b, 0x98, 0xd2, 0xa0, 0x6b, 0x74, 0xea, 0xfa, 0xc0, 0xd3, 0x4f,
-	0x5c, 0xab, 0xa7, 0xa0, 0x30, 0x53, 0x86, 0xf0, 0x10, 0x64, 0xbc, 0xda, 0xb6, 0x7b, 0x72, 0x9e,
-	0x64, 0xea, 0x65, 0x5d, 0x52, 0x81, 0xee, 0x51, 0x81, 0x7e, 0xea, 0x51, 0xc1, 0x51, 0xec, 0xc9,
-	0x6f, 0x97, 0x35, 0x03, 0x48, 0x27, 0x2e, 0xae, 0xfe, 0x1c, 0x01, 0xeb, 0xbe, 0xb3, 0xab, 0x70,
-	0x14, 0x7e, 0x01, 0x2e, 0xf9, 0xd2, 0x2c, 0x6e, 0x88, 0x96, 0xb4, 0x9d, 0xe8, 0x5e, 0xa6, 0x7e,
-	0xb0, 0xca, 0xa5, 0xcc, 0x8c, 0x2d, 0xa3, 0x48, 0x82, 0x02, 0xfa, 0x4f, 0xb2, 0xb8, 0x05, 0x52,
-	0x1d, 0x44, 0xcd, 0x9e, 0x4b, 0xb0, 0x48, 0x5a, 0xca, 0x48, 0x76, 0x10, 0xbd, 0xe7, 0x12, 0x0c,
-	0x4d, 0x70, 0x29, 0xd4, 0xf9, 0x6a, 0xd2, 0x1c, 0xbc, 0x45, 0xa7, 0x1b, 0x85, 0x99, 0xce, 0xae,
-	0xfe, 0x12, 0x4c, 0x98, 0x98, 0xb0, 0x4e, 0xcb, 0x85, 0xbb, 0x20, 0x3b, 0x9d, 0xb1, 0xaa, 0x66,
-	0xd2, 0x46, 0x66, 0x22, 0x6b, 0x58, 0xf0, 0x32, 0xc8, 0x5c, 0xb8, 0xe4, 0xbc, 0xd5, 0x75, 0x2f,
-	0xbc, 0x33, 0xa6, 0x0d, 0xe0, 0x89, 0x1a, 0x16, 0xdc, 0x00, 0x09, 0x32, 0x70, 0xbc, 0x52, 0x48,
-	0x1b, 0x71, 0x32, 0x70, 0x1a, 0x16, 0xbc, 0xe3, 0x27, 0x8d, 0x98, 0x20, 0x8d, 0xff, 0x2d, 0x27,
-	0x8d, 0x39, 0x4c, 0xb1, 0x09, 0x92, 0x1e, 0x45, 0xc4, 0x45, 0x72, 0x13, 0x4c, 0x92, 0x43, 0x09,
-	0x24, 0x87, 0x98, 0x50, 0xdb, 0x75, 0xc4, 0x14, 0x8a, 0x1a, 0xde, 0x92, 0x93, 0x4b, 0xcb, 0x26,
-	0x94, 0x99, 0x78, 0x88, 0x1d, 0xc6, 0x3d, 0x93, 0x92, 0x5c, 0x84, 0xf4, 0x13, 0x2e, 0x6c, 0x58,
-	0xb0, 0x0a, 0x72, 0x0e, 0xfe, 0xd2, 0x67, 0x94, 0x12, 0x46, 0x19, 0x2e, 0xf4, 0x6c, 0x76, 0x41,
-	0x96, 0x36, 0x3b, 0xd8, 0x1a, 0x74, 0xb1, 0x68, 0xa8, 0xb4, 0x34, 0x99, 0xc8, 0x1a, 0x56, 0xf5,
-	0x59, 0x14, 0x6c, 0x2e, 0xe0, 0x17, 0x88, 0xc0, 0xfa, 0x34, 0xb7, 0x6e, 0x1f, 0x13, 0x91, 0x7a,
-	0xc5, 0x9f, 0x37, 0x96, 0xa7, 0x62, 0x82, 0xf9, 0x99, 0xe7, 0x67, 0x40, 0x27, 0x24, 0x83, 0x79,
-	0x10, 0x99, 0x5c, 0x49, 0xc4, 0xb6, 0xe0, 0x87, 0x20, 0x66, 0x3b, 0x2d, 0x57, 0xb1, 0xe3, 0xde,
-	0x34, 0x06, 0x07, 0x9f, 0xf8, 0x07, 0x02, 0xf0, 0x32, 0x30, 0x84, 0x17, 0x3c, 0x02, 0x89, 0xa6,
-	0xeb, 0xb4, 0xec, 0xb6, 0x2a, 0xbd, 0xff, 0xaf, 0xe2, 0x7f, 0x47, 0x78, 0x18, 0xca, 0x13, 0xb6,
-	0x00, 0xf4, 0x77, 0xa0, 0xc2, 0x93, 0xa4, 0xf5, 0x7e, 0x10, 0x6f, 0x11, 0x4d, 0xfb, 0xea, 0x54,
-	0x81, 0xfb, 0x9b, 0x5a, 0x8a, 0xe0, 0x55, 0x90, 0x97, 0xd8, 0x66, 0xb0, 0x0c, 0x72, 0x52, 0xfa,
-	0x48, 0x15, 0xc3, 0x75, 0x50, 0xe4, 0x2f, 0x1d, 0x77, 0x88, 0xc9, 0xc4, 0x50, 0x96, 0x43, 0xc1,
-	0x93, 0x2b, 0xd3, 0xea, 0xd3, 0x28, 0xd8, 0x98, 0xcb, 0xd8, 0xf0, 0x1a, 0x28, 0x30, 0x44, 0xda,
-	0x98, 0x99, 0xcd, 0xee, 0x80, 0x32, 0x4c, 0xe4, 0x4c, 0x49, 0x1b, 0x79, 0x29, 0xbe, 0xa3, 0xa4,
-	0xa1, 0x6e, 0x8a, 0xbc, 0xb1, 0x9b, 0xa2, 0x4b, 0xba, 0x29, 0xe6, 0xef, 0xa6, 0x70, 0x55, 0xc7,
-	0x57, 0xa9, 0xea, 0x44, 0xb8, 0xaa, 0x7d, 0x9d, 0x93, 0x0c, 0x76, 0xce, 0x2d, 0x90, 0x54, 0xd4,
-	0x23, 0x4a, 0x3d, 0x53, 0xdf, 0x09, 0x5e, 0x98, 0x52, 0xfa, 0xd8, 0xcb, 0xf0, 0x1c, 0xe0, 0x31,
-	0x28, 0x38, 0xf8, 0xc2, 0xe4, 0x5b, 0xf7, 0x30, 0xc0, 0x8a, 0x18, 0x39, 0x07, 0x5f, 0x18, 0x03,
-	0x47, 0x2d, 0x4f, 0x62, 0xa9, 0x54, 0x31, 0x7d, 0x12, 0x4b, 0x65, 0x8a, 0xd9, 0x93, 0x58, 0x2a,
-	0x5b, 0xcc, 0x9d, 0xc4, 0x52, 0xb9, 0x62, 0xfe, 0x24, 0x96, 0xca, 0x17, 0x0b, 0xd5, 0x6f, 0x23,
-	0x60, 0x7b, 0x29, 0x85, 0xff, 0x5b, 0x6e, 0xab, 0xfa, 0x83, 0x06, 0xb6, 0x97, 0xbe, 0xf0, 0x78,
-	0x8f, 0xa8, 0x67, 0xb6, 0xca, 0x84, 0x1a, 0xef, 0x39, 0x29, 0x55, 0x89, 0x08, 0xbc, 0x19, 0x22,
-	0xc1, 0x37, 0xc3, 0x0c, 0x55, 0x47, 0xdf, 0x82, 0xaa, 0x7f, 0x8d, 0x83, 0xf2, 0xe2, 0xc7, 0xdf,
-	0xbb, 0x24, 0x20, 0x5f, 0xea, 0x62, 0xc1, 0x42, 0x9f, 0x1d, 0xec, 0xf1, 0xd0, 0x60, 0x87, 0x9f,
-	0x82, 0xfc, 0xd4, 0x44, 0x1c, 0x3e, 0xb1, 0xe2, 0xe1, 0x73, 0x13, 0x3f, 0xae, 0x81, 0xdb, 0x80,
-	0x67, 0x83, 0x30, 0x19, 0x49, 0xde, 0x61, 0x5a, 0x49, 0x04, 0x4b, 0x66, 0x3d, 0xb5, 0x88, 0x92,
-	0x5a, 0x31, 0x4a, 0x46, 0x79, 0x89, 0x18, 0x0f, 0xc0, 0xba, 0x78, 0x94, 0x74, 0x30, 0x22, 0xec,
-	0x0c, 0x23, 0x26, 0xb1, 0xd2, 0x2b, 0x62, 0x5d, 0xe2, 0xce, 0xc7, 0x9e, 0xaf, 0x40, 0xbc, 0x05,
-	0x92, 0x16, 0x66, 0xc8, 0xee, 0xd2, 0xf9, 0x6d, 0x2c, 0xbf, 0x6f, 0x79, 0x17, 0x3f, 0x40, 0xa3,
-	0xae, 0x8b, 0x2c, 0x6a, 0x78, 0x0e, 0x3c, 0xef, 0x88, 0x71, 0x6b, 0x56, 0xca, 0xc8, 0x72, 0x52,
-	0x4b, 0x7e, 0x58, 0xb1, 0x4f, 0xf5, 0xf1, 0x59, 0xca, 0xce, 0x83, 0x56, 0x4a, 0x8e, 0x7d, 0x57,
-	0xfe, 0x34, 0x32, 0xdc, 0x4b, 0x2d, 0xe0, 0x0d, 0xf0, 0x1f, 0x01, 0xc2, 0x0b, 0x00, 0x13, 0xd3,
-	0xb6, 0xb0, 0xc3, 0x6c, 0x36, 0x2a, 0xe5, 0xc4, 0xdd, 0x43, 0xae, 0x7b, 0x2c, 0x54, 0x0d, 0xa5,
-	0x81, 0x8f, 0x41, 0x41, 0xdd, 0xfc, 0x64, 0x36, 0xe5, 0x45, 0x64, 0x7d, 0x2e, 0x09, 0xfb, 0x46,
-	0x94, 0xe2, 0x06, 0x6f, 0x52, 0xe5, 0x87, 0x81, 0x75, 0xf5, 0xcf, 0x08, 0xd8, 0x5c, 0xf0, 0x8e,
-	0xf7, 0xbf, 0x5c, 0xb4, 0xc0, 0xcb, 0xe5, 0x1d, 0x8e, 0x9d, 0x16, 0xd8, 0x98, 0x39, 0xa8, 0x69,
-	0x33, 0xdc, 0xe3, 0x1f, 0x8d, 0xfc, 0x09, 0x5c, 0xff, 0x7b, 0xc7, 0x6d, 0x30, 0xdc, 0x33, 0xd6,
-	0x87, 0x21, 0x19, 0x85, 0x1f, 0x80, 0x84, 0x98, 0x59, 0xde, 0x17, 0xe0, 0xc2, 0xe2, 0xf8, 0x18,
-	0x31, 0x74, 0xd4, 0x75, 0xcf, 0x0c, 0x65, 0x0f, 0xef, 0x82, 0xbc, 0x47, 0x13, 0x0a, 0x21, 0xb9,
-	0x22, 0x42, 0x56, 0xb2, 0x84, 0x98, 0x8b, 0xf4, 0xc8, 0x7e, 0xfe, 0xb2, 0xb2, 0xf6, 0xe2, 0x65,
-	0x65, 0xed, 0xf5, 0xcb, 0x8a, 0xf6, 0xf5, 0xb8, 0xa2, 0xfd, 0x34, 0xae, 0x68, 0xcf, 0xc6, 0x15,
-	0xed, 0xf9, 0xb8, 0xa2, 0xfd, 0x3e, 0xae, 0x68, 0x7f, 0x8c, 0x2b, 0x6b, 0xaf, 0xc7, 0x15, 0xed,
-	0xc9, 0xab, 0xca, 0xda, 0xf3, 0x57, 0x95, 0xb5, 0x17, 0xaf, 0x2a, 0x6b, 0x9f, 0x1f, 0xb4, 0xdd,
-	0x69, 0x1c, 0xdb, 0x5d, 0xfc, 0x57, 0xd6, 0x6d, 0x82, 0xfb, 0x6a, 0x75, 0x96, 0x10, 0x7d, 0x73,
-	0xf0, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x00, 0xb4, 0x0f, 0x2d, 0x02, 0x13, 0x00, 0x00,
+	// 1712 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0xcd, 0x73, 0x23, 0x47,
+	0x15, 0xf7, 0xe8, 0x5b, 0x4f, 0x9f, 0x6e, 0xe3, 0x58, 0x16, 0x65, 0xad, 0xad, 0x4a, 0x58, 0x87,
+	0xa2, 0x46, 0x6b, 0xf9, 0x40, 0xb2, 0x01, 0xaa, 0x6c, 0x93, 0x60, 0x99, 0xda, 0xb0, 0xcc, 0x9a,
+	0x4d, 0x15, 0x07, 0x86, 0xf6, 0x4c, 0x4b, 0x9a, 0xb2, 0x34, 0x23, 0xba, 0x5b, 0x72, 0xc4, 0x89,
+	0x2a, 0x0e, 0x5c, 0xa0, 0xc8, 0x85, 0x2a, 0x8a, 0x6b, 0x38, 0x70, 0xe2, 0xcc, 0x9f, 0xc0, 0x71,
+	0x2f, 0x54, 0x85, 0x13, 0xac, 0xf7, 0xc2, 0x31, 0x37, 0xae, 0x54, 0xf7, 0xf4, 0x48, 0x33, 0x1a,
+	0x49, 0x11, 0x49, 0xe5, 0x94, 0xdb, 0xcc, 0xfb, 0xf8, 0xbd, 0xee, 0xd7, 0xaf, 0x7f, 0xef, 0xcd,
+	0xc0, 0x23, 0x4e, 0x86, 0x23, 0x8f, 0xe2, 0x41, 0x8b, 0x11, 0x3a, 0x21, 0xb4, 0x85, 0x47, 0x4e,
+	0x8b, 0x92, 0xd1, 0xc0, 0xb1, 0x30, 0x77, 0x3c, 0xb7, 0x35, 0x39, 0x69, 0x0d, 0x09, 0x63, 0xb8,
+	0x47, 0xf4, 0x11, 0xf5, 0xb8, 0x87, 0x9a, 0x81, 0x87, 0xee, 0x7b, 0xe8, 0x78, 0xe4, 0xe8, 0x21,
+	0x0f, 0x7d, 0x72, 0x52, 0x7f, 0xd0, 0xf3, 0xbc, 0xde, 0x80, 0xb4, 0xa4, 0xc7, 0xcd, 0xb8, 0xdb,
+	0xe2, 0xce, 0x90, 0x30, 0x8e, 0x87, 0x23, 0x1f, 0xa4, 0x7e, 0x64, 0x93, 0x11, 0x71, 0x6d, 0xe2,
+	0x5a, 0x0e, 0x61, 0xad, 0x9e, 0xd7, 0xf3, 0xa4, 0x5c, 0x3e, 0x29, 0x13, 0x7d, 0xd9, 0xca, 0x88,
+	0x3b, 0x1e, 0x32, 0xb1, 0xa6, 0x70, 0x40, 0xdf, 0xfe, 0xe1, 0x5a, 0x7b, 0x8e, 0xd9, 0xad, 0x32,
+	0xfc, 0xd6, 0x32, 0xc3, 0xbe, 0xc3, 0xb8, 0x47, 0xa7, 0xb1, 0xed, 0xd6, 0x4f, 0x97, 0x59, 0x8f,
+	0x08, 0x65, 0x0e, 0xe3, 0xc4, 0xb5, 0x88, 0xf0, 0x70, 0xf1, 0x90, 0xb0, 0x11, 0xb6, 0x08, 0x53,
+	0x4e, 0xaf, 0xcf, 0x9c, 0x84, 0xb5, 0xe5, 0x0d, 0x87, 0x4b, 0x32, 0x59, 0x6f, 0x46, 0xac, 0x66,
+	0x4b, 0xf5, 0xcd, 0x63, 0xbb, 0x12, 0x36, 0xb3, 0x40, 0x71, 0xb0, 0x37, 0x23, 0x86, 0xeb, 0x4e,
+	0xb0, 0xfe, 0x46, 0xc4, 0x74, 0xe5, 0xce, 0xa3, 0x66, 0x5d, 0xec, 0x0c, 0xc6, 0x34, 0x1e, 0xb8,
+	0xf9, 0xb7, 0x1c, 0x54, 0x8c, 0x79, 0xb8, 0x6b, 0xcc, 0x6e, 0xd1, 0xfb, 0x90, 0x17, 0x09, 0x37,
+	0xf9, 0x74, 0x44, 0x6a, 0xda, 0xa1, 0x76, 0x5c, 0x6e, 0x9f, 0xe8, 0xcb, 0xea, 0x46, 0x6e, 0x5a,
+	0x9f, 0x9c, 0xe8, 0x0b, 0x08, 0xd7, 0xd3, 0x11, 0x31, 0x72, 0x5c, 0x3d, 0xa1, 0xd7, 0xa1, 0xcc,
+	0xbc, 0x31, 0xb5, 0x88, 0x29, 0x61, 0x1d, 0xbb, 0x96, 0x38, 0xd4, 0x8e, 0x93, 0x46, 0xd1, 0x97,
+	0x0a, 0x8f, 0x8e, 0x8d, 0xa6, 0xb0, 0x3f, 0x4b, 0x90, 0x6f, 0x88, 0x39, 0xa7, 0xce, 0xcd, 0x98,
+	0x13, 0x56, 0x4b, 0x1e, 0x6a, 0xc7, 0x85, 0xf6, 0x3b, 0xfa, 0x67, 0x57, 0xaf, 0xfe, 0x7e, 0x00,
+	0x22, 0x70, 0xcf, 0x66, 0x10, 0x97, 0x5b, 0xc6, 0x9e, 0xbb, 0x5c, 0x85, 0x18, 0xec, 0xa9, 0x3c,
+	0xc6, 0x02, 0xa7, 0x64, 0xe0, 0xb7, 0x37, 0x09, 0x7c, 0xe9, 0x43, 0xc4, 0xc2, 0xee, 0xf6, 0x97,
+	0x29, 0xd0, 0xef, 0x34, 0x38, 0x62, 0x53, 0xd7, 0x32, 0x59, 0x1f, 0x53, 0xdb, 0x64, 0x1c, 0xf3,
+	0x31, 0x8b, 0xc5, 0x4f, 0xcb, 0xf8, 0x67, 0x9b, 0xc4, 0x7f, 0x36, 0x75, 0xad, 0x67, 0x02, 0xeb,
+	0x99, 0x84, 0x8a, 0xad, 0xe3, 0x80, 0xad, 0x33, 0x40, 0xbf, 0xd6, 0x40, 0x5a, 0x98, 0xd8, 0xe2,
+	0xce, 0xc4, 0xe1, 0xf1, 0x5c, 0x64, 0xe4, 0x5a, 0xbe, 0xb7, 0xe9, 0x5a, 0xce, 0x14, 0x4e, 0x6c,
+	0x21, 0x75, 0xb6, 0x52, 0x8b, 0x7e, 0xab, 0xc1, 0x61, 0x70, 0x16, 0x43, 0xc2, 0xb1, 0x8d, 0x39,
+	0x8e, 0x2d, 0x24, 0xbb, 0x79, 0x52, 0xd4, 0xa1, 0x3c, 0x51, 0x50, 0xf1, 0xa4, 0xf4, 0xd7, 0x19,
+	0xa0, 0x5f, 0x42, 0x3d, 0x52, 0x19, 0x93, 0x76, 0x78, 0x1d, 0xb9, 0xcd, 0xab, 0x32, 0x54, 0x1c,
+	0xcf, 0xdb, 0xd1, 0xaa, 0xec, 0x2f, 0x57, 0xa1, 0xdf, 0x8b, 0x02, 0x21, 0x98, 0x5a, 0xfd, 0x50,
+	0xcc, 0x58, 0x2e, 0xf2, 0x72, 0x0d, 0xe7, 0x1b, 0x1d, 0x8a, 0x04, 0x9b, 0x47, 0x88, 0x25, 0xa3,
+	0xc1, 0xd6, 0x5a, 0x9c, 0x17, 0x01, 0xe6, 0x91, 0x9b, 0x1f, 0x6b, 0x50, 0x0d, 0x5f, 0x7c, 0xef,
+	0x96, 0xb8, 0x68, 0x1f, 0x72, 0x7e, 0x3d, 0x3b, 0xb6, 0xa4, 0x8e, 0xb4, 0x91, 0x95, 0xef, 0x1d,
+	0x1b, 0xbd, 0x0d, 0xfb, 0x03, 0xcc, 0xb8, 0x49, 0x09, 0xa7, 0x0e, 0x99, 0x10, 0xdb, 0x54, 0x54,
+	0x34, 0x67, 0x84, 0xd7, 0x84, 0x81, 0x11, 0xe8, 0x9f, 0xf8, 0xea, 0x90, 0xeb, 0x88, 0x7a, 0x16,
+	0x61, 0x2c, 0xea, 0x9a, 0x9c, 0xbb, 0x3e, 0x0d, 0xf4, 0x33, 0xd7, 0xe6, 0x35, 0x54, 0x16, 0x2e,
+	0x06, 0x3a, 0x83, 0x42, 0x70, 0xdb, 0x9c, 0xa1, 0xcf, 0x70, 0x85, 0x76, 0x5d, 0xf7, 0xbb, 0x9e,
+	0x1e, 0x74, 0x3d, 0xfd, 0x3a, 0xe8, 0x7a, 0xe7, 0xa9, 0x8f, 0xfe, 0xf5, 0x40, 0x33, 0xc0, 0x77,
+	0x12, 0xe2, 0xe6, 0x5f, 0x13, 0xb0, 0x13, 0xda, 0xbb, 0x0a, 0xc7, 0xd0, 0xcf, 0x61, 0x3b, 0x94,
+	0x74, 0x79, 0x58, 0xac, 0xa6, 0x1d, 0x26, 0x8f, 0x0b, 0xed, 0xd3, 0x4d, 0x8e, 0x68, 0x81, 0x48,
+	0x8d, 0x2a, 0x8d, 0x0a, 0xd8, 0x17, 0xc9, 0xe2, 0x3e, 0xe4, 0xfa, 0x98, 0x99, 0x43, 0x8f, 0x12,
+	0x99, 0xb4, 0x9c, 0x91, 0xed, 0x63, 0xf6, 0xc4, 0xa3, 0x04, 0x99, 0xb0, 0x1d, 0xe3, 0x22, 0xc5,
+	0x7d, 0xa7, 0x9f, 0x83, 0x7b, 0x8c, 0xca, 0x02, 0xd7, 0x34, 0xff, 0x11, 0x4d, 0x98, 0xe4, 0x7c,
+	0xb7, 0xeb, 0xa1, 0x23, 0x28, 0xce, 0x59, 0x5f, 0xd5, 0x4c, 0xde, 0x28, 0xcc, 0x64, 0x1d, 0x1b,
+	0x3d, 0x80, 0xc2, 0x9d, 0x47, 0x6f, 0xbb, 0x03, 0xef, 0x2e, 0xd8, 0x63, 0xde, 0x80, 0x40, 0xd4,
+	0xb1, 0xd1, 0x2e, 0x64, 0xe8, 0xd8, 0x0d, 0x4a, 0x21, 0x6f, 0xa4, 0xe9, 0xd8, 0xed, 0xd8, 0xe8,
+	0x22, 0xdc, 0xc6, 0x52, 0xb2, 0x8d, 0x7d, 0x63, 0x7d, 0x1b, 0x5b, 0xd2, 0xbb, 0xf6, 0x20, 0x1b,
+	0x34, 0xad, 0xb4, 0x4c, 0x6e, 0x86, 0xfb, 0xed, 0xaa, 0x06, 0xd9, 0x09, 0xa1, 0xcc, 0xf1, 0x5c,
+	0xc9, 0x8b, 0x49, 0x23, 0x78, 0x15, 0xed, 0xae, 0xeb, 0x50, 0xc6, 0x4d, 0x32, 0x21, 0x2e, 0x17,
+	0x9e, 0x59, 0xbf, 0xdd, 0x49, 0xe9, 0xbb, 0x42, 0xd8, 0xb1, 0x51, 0x13, 0x4a, 0x2e, 0xf9, 0x30,
+	0x64, 0x94, 0x93, 0x46, 0x05, 0x21, 0x0c, 0x6c, 0x8e, 0xa0, 0xc8, 0xac, 0x3e, 0xb1, 0xc7, 0x03,
+	0x22, 0x2f, 0x54, 0xde, 0x37, 0x99, 0xc9, 0x3a, 0x76, 0xf3, 0x0f, 0x29, 0xd8, 0x5b, 0xd1, 0xf1,
+	0x10, 0x86, 0x9d, 0x79, 0x6e, 0xbd, 0x11, 0xa1, 0x32, 0xf5, 0xaa, 0xa3, 0x3f, 0x5a, 0x9f, 0x8a,
+	0x19, 0xe6, 0x8f, 0x02, 0x3f, 0x03, 0xb9, 0x31, 0x19, 0x2a, 0x43, 0x62, 0x76, 0x24, 0x09, 0xc7,
+	0x46, 0xdf, 0x81, 0x94, 0xe3, 0x76, 0x3d, 0xd5, 0xaf, 0x8f, 0xe7, 0x31, 0x04, 0xf8, 0xcc, 0x3f,
+	0x12, 0x40, 0x94, 0x81, 0x21, 0xbd, 0xd0, 0x39, 0x64, 0x2c, 0xcf, 0xed, 0x3a, 0x3d, 0x55, 0x7a,
+	0xdf, 0xdc, 0xc4, 0xff, 0x42, 0x7a, 0x18, 0xca, 0x13, 0x75, 0x01, 0x85, 0x6f, 0xa0, 0xc2, 0xf3,
+	0xdb, 0xe8, 0xb7, 0xa3, 0x78, 0xab, 0x06, 0x87, 0x50, 0x9d, 0x2a, 0xf0, 0x6d, 0xba, 0x28, 0x42,
+	0x6f, 0x40, 0xd9, 0xc7, 0x36, 0xa3, 0x65, 0x50, 0xf2, 0xa5, 0xcf, 0x55, 0x31, 0xbc, 0x09, 0x55,
+	0x31, 0x7b, 0x79, 0x13, 0x42, 0x67, 0x86, 0x7e, 0x39, 0x54, 0x02, 0x79, 0x60, 0xfa, 0x43, 0xc8,
+	0xfc, 0x62, 0xec, 0x71, 0x1c, 0xf4, 0x95, 0xe5, 0x17, 0x2f, 0x34, 0xbc, 0x46, 0x16, 0xfd, 0x63,
+	0xe9, 0x6a, 0x28, 0x88, 0xe6, 0x9f, 0x92, 0xd0, 0x58, 0xcf, 0xf7, 0xe8, 0x00, 0xc0, 0x71, 0x6d,
+	0xf2, 0xa1, 0x29, 0xf2, 0xaa, 0x2e, 0x5e, 0x5e, 0x4a, 0x04, 0x28, 0xfa, 0xa3, 0x06, 0x35, 0x6b,
+	0xcc, 0xb8, 0x37, 0x34, 0x63, 0x5d, 0xa8, 0x96, 0x90, 0x94, 0xf6, 0xb3, 0x2f, 0xde, 0x75, 0xf4,
+	0x0b, 0x19, 0x62, 0xd1, 0xe8, 0x5d, 0x97, 0xd3, 0xa9, 0xf1, 0x9a, 0xb5, 0x54, 0x89, 0xae, 0xa0,
+	0x2a, 0x39, 0x70, 0x3c, 0xb2, 0x31, 0x27, 0x3e, 0x8b, 0x27, 0x37, 0x64, 0xf1, 0xb2, 0xf0, 0xfc,
+	0x89, 0x74, 0x14, 0xaa, 0x3a, 0x85, 0xaf, 0xaf, 0x59, 0x02, 0xaa, 0x42, 0xf2, 0x96, 0x4c, 0x55,
+	0x76, 0xc4, 0x23, 0xfa, 0x2e, 0xa4, 0x27, 0x78, 0x30, 0x26, 0xb2, 0xea, 0xcb, 0xed, 0x87, 0xd1,
+	0x9a, 0x9a, 0x5d, 0xa0, 0x8e, 0x48, 0x24, 0xb1, 0x9f, 0x0b, 0x53, 0xc9, 0x29, 0xbe, 0xd7, 0xe3,
+	0xc4, 0x5b, 0x5a, 0xf3, 0xe3, 0x24, 0xec, 0x2e, 0x9d, 0x16, 0xd1, 0x43, 0xa8, 0x70, 0x4c, 0x7b,
+	0x84, 0x9b, 0xd6, 0x60, 0xcc, 0x38, 0xa1, 0x7e, 0xf7, 0xc8, 0x1b, 0x65, 0x5f, 0x7c, 0xa1, 0xa4,
+	0x31, 0xde, 0x4c, 0x7c, 0x26, 0x6f, 0x26, 0xd7, 0xf0, 0x66, 0x2a, 0xcc, 0x9b, 0x71, 0xfe, 0x4a,
+	0x6f, 0xc2, 0x5f, 0x99, 0x38, 0x7f, 0x85, 0x38, 0x32, 0x1b, 0xe5, 0xc8, 0xc7, 0x90, 0x55, 0x63,
+	0x8f, 0x1a, 0x60, 0x0e, 0xa3, 0x69, 0x54, 0xca, 0xd0, 0xe4, 0x64, 0x04, 0x0e, 0xe8, 0x12, 0x2a,
+	0x2e, 0xb9, 0x33, 0xc5, 0xd2, 0x03, 0x0c, 0xd8, 0x10, 0xa3, 0xe4, 0x92, 0x3b, 0x63, 0xec, 0xaa,
+	0xd7, 0xab, 0x54, 0x2e, 0x57, 0xcd, 0x5f, 0xa5, 0x72, 0x85, 0x6a, 0xf1, 0x2a, 0x95, 0x2b, 0x56,
+	0x4b, 0x57, 0xa9, 0x5c, 0xa9, 0x5a, 0xbe, 0x4a, 0xe5, 0xca, 0xd5, 0x4a, 0xf3, 0x37, 0x09, 0x38,
+	0x58, 0x3b, 0x3e, 0x7e, 0x55, 0x4e, 0xab, 0xf9, 0x67, 0x0d, 0x0e, 0xd6, 0x7e, 0x5d, 0x08, 0x36,
+	0x54, 0x9f, 0x78, 0x2a, 0x13, 0xea, 0xc6, 0x94, 0x7c, 0xa9, 0x4a, 0x44, 0x64, 0x3a, 0x4c, 0x44,
+	0xa7, 0xc3, 0x85, 0xa1, 0x2c, 0xf9, 0x39, 0x86, 0xb2, 0x7f, 0xa6, 0xa1, 0xbe, 0xfa, 0xc3, 0xe3,
+	0xcb, 0x1c, 0x35, 0x42, 0xa9, 0x4b, 0x45, 0x0b, 0x7d, 0xb1, 0x85, 0xa7, 0x63, 0x2d, 0x1c, 0xfd,
+	0x00, 0xca, 0x73, 0x13, 0xb9, 0xf9, 0xcc, 0x86, 0x9b, 0x2f, 0xcd, 0xfc, 0x84, 0x46, 0x10, 0x3a,
+	0xe3, 0x98, 0x72, 0x3f, 0x92, 0x7f, 0x86, 0x79, 0x25, 0x91, 0xf3, 0x50, 0x31, 0x50, 0xcb, 0x28,
+	0xb9, 0x0d, 0xa3, 0x14, 0x94, 0x97, 0x8c, 0xf1, 0x14, 0x76, 0x24, 0xf5, 0xf6, 0x09, 0xa6, 0xfc,
+	0x86, 0x60, 0xee, 0x63, 0xe5, 0x37, 0xc4, 0xda, 0x16, 0xce, 0x97, 0x81, 0xaf, 0x44, 0x7c, 0x0c,
+	0x59, 0x9b, 0x70, 0xec, 0x0c, 0xd8, 0xf2, 0x6b, 0xac, 0x7e, 0xa8, 0x4c, 0x4e, 0xf4, 0xa7, 0x78,
+	0x3a, 0xf0, 0xb0, 0xcd, 0x8c, 0xc0, 0x41, 0xe4, 0x1d, 0x73, 0x61, 0xcd, 0x6b, 0x05, 0xbf, 0x9c,
+	0xd4, 0xab, 0xd8, 0xac, 0x5c, 0xa7, 0xfa, 0xf1, 0x51, 0x2b, 0x2e, 0x83, 0x56, 0x4a, 0x81, 0xfd,
+	0x9e, 0xff, 0x68, 0x14, 0x84, 0x97, 0x7a, 0x41, 0x8f, 0xe0, 0x6b, 0x12, 0x44, 0x14, 0x00, 0xa1,
+	0xa6, 0x63, 0x13, 0x97, 0x3b, 0x7c, 0x5a, 0x2b, 0xc9, 0xb3, 0x47, 0x42, 0xf7, 0x81, 0x54, 0x75,
+	0x94, 0x06, 0x7d, 0x00, 0x15, 0x75, 0xf2, 0x33, 0x6e, 0x2a, 0xcb, 0xc8, 0xfa, 0xd2, 0x56, 0x19,
+	0xa2, 0x28, 0x35, 0x05, 0x04, 0x4c, 0x55, 0x9e, 0x44, 0xde, 0x9b, 0xff, 0x4d, 0xc0, 0xde, 0x8a,
+	0x6f, 0xc8, 0xf0, 0x8c, 0xaa, 0x45, 0x66, 0xd4, 0x2f, 0x91, 0x76, 0xba, 0xb0, 0xbb, 0xb0, 0x51,
+	0xd3, 0xe1, 0x64, 0x28, 0x7e, 0x58, 0x88, 0xc9, 0xa0, 0xfd, 0xff, 0x6d, 0xb7, 0xc3, 0xc9, 0xd0,
+	0xd8, 0x99, 0xc4, 0x64, 0x0c, 0xbd, 0x05, 0x19, 0xc9, 0x59, 0xc1, 0xdf, 0x87, 0x95, 0xc5, 0xf1,
+	0x7d, 0xcc, 0xf1, 0xf9, 0xc0, 0xbb, 0x31, 0x94, 0x3d, 0x7a, 0x0f, 0xca, 0x41, 0x9b, 0x50, 0x08,
+	0xd9, 0x0d, 0x11, 0x8a, 0x7e, 0x97, 0x90, 0xbc, 0xc8, 0xce, 0x9d, 0x17, 0x2f, 0x1b, 0x5b, 0x9f,
+	0xbc, 0x6c, 0x6c, 0x7d, 0xfa, 0xb2, 0xa1, 0xfd, 0xea, 0xbe, 0xa1, 0xfd, 0xe5, 0xbe, 0xa1, 0xfd,
+	0xfd, 0xbe, 0xa1, 0xbd, 0xb8, 0x6f, 0x68, 0xff, 0xbe, 0x6f, 0x68, 0xff, 0xb9, 0x6f, 0x6c, 0x7d,
+	0x7a, 0xdf, 0xd0, 0x3e, 0x7a, 0xd5, 0xd8, 0x7a, 0xf1, 0xaa, 0xb1, 0xf5, 0xc9, 0xab, 0xc6, 0xd6,
+	0x4f, 0x4f, 0x7b, 0xde, 0x3c, 0x8e, 0xe3, 0xad, 0xfe, 0x3f, 0xfb, 0x0e, 0x25, 0x23, 0xf5, 0x76,
+	0x93, 0x91, 0xf7, 0xe6, 0xf4, 0x7f, 0x03, 0x00, 0x46, 0x36, 0x7c, 0xb2, 0xd7, 0x15, 0x00, 0x00,
 }
 
 func (this *ReplicationTask) Equal(that interface{}) bool {
@@ -1379,6 +1477,30 @@ func (this *ReplicationTask_HistoryTaskV2Attributes) Equal(that interface{}) boo
 	}
 	return true
 }
+func (this *ReplicationTask_SearchAttributesTaskAttributes) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReplicationTask_SearchAttributesTaskAttributes)
+	if !ok {
+		that2, ok := that.(ReplicationTask_SearchAttributesTaskAttributes)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.SearchAttributesTaskAttributes.Equal(that1.SearchAttributesTaskAttributes) {
+		return false
+	}
+	return true
+}
 func (this *ReplicationToken) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1563,6 +1685,48 @@ func (this *NamespaceTaskAttributes) Equal(that interface{}) bool {
 	if this.FailoverVersion != that1.FailoverVersion {
 		return false
 	}
+	if !this.Quotas.Equal(that1.Quotas) {
+		return false
+	}
+	return true
+}
+func (this *SearchAttributesTaskAttributes) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SearchAttributesTaskAttributes)
+	if !ok {
+		that2, ok := that.(SearchAttributesTaskAttributes)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.IndexName != that1.IndexName {
+		return false
+	}
+	if len(this.CustomSearchAttributes) != len(that1.CustomSearchAttributes) {
+		return false
+	}
+	for i := range this.CustomSearchAttributes {
+		if this.CustomSearchAttributes[i] != that1.CustomSearchAttributes[i] {
+			return false
+		}
+	}
+	if that1.LastUpdateTime == nil {
+		if this.LastUpdateTime != nil {
+			return false
+		}
+	} else if !this.LastUpdateTime.Equal(*that1.LastUpdateTime) {
+		return false
+	}
 	return true
 }
 func (this *HistoryTaskAttributes) Equal(that interface{}) bool {
@@ -1825,7 +1989,7 @@ func (this *ReplicationTask) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 12)
+	s := make([]string, 0, 13)
 	s = append(s, "&repication.ReplicationTask{")
 	s = append(s, "TaskType: "+fmt.Sprintf("%#v", this.TaskType)+",\n")
 	s = append(s, "SourceTaskId: "+fmt.Sprintf("%#v", this.SourceTaskId)+",\n")
@@ -1883,6 +2047,14 @@ func (this *ReplicationTask_HistoryTaskV2Attributes) GoString() string {
 		`HistoryTaskV2Attributes:` + fmt.Sprintf("%#v", this.HistoryTaskV2Attributes) + `}`}, ", ")
 	return s
 }
+func (this *ReplicationTask_SearchAttributesTaskAttributes) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&repication.ReplicationTask_SearchAttributesTaskAttributes{` +
+		`SearchAttributesTaskAttributes:` + fmt.Sprintf("%#v", this.SearchAttributesTaskAttributes) + `}`}, ", ")
+	return s
+}
 func (this *ReplicationToken) GoString() string {
 	if this == nil {
 		return "nil"
@@ -1944,7 +2116,7 @@ func (this *NamespaceTaskAttributes) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 11)
+	s := make([]string, 0, 12)
 	s = append(s, "&repication.NamespaceTaskAttributes{")
 	s = append(s, "NamespaceOperation: "+fmt.Sprintf("%#v", this.NamespaceOperation)+",\n")
 	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
@@ -1959,6 +2131,33 @@ func (this *NamespaceTaskAttributes) GoString() string {
 	}
 	s = append(s, "ConfigVersion: "+fmt.Sprintf("%#v", this.ConfigVersion)+",\n")
 	s = append(s, "FailoverVersion: "+fmt.Sprintf("%#v", this.FailoverVersion)+",\n")
+	if this.Quotas != nil {
+		s = append(s, "Quotas: "+fmt.Sprintf("%#v", this.Quotas)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SearchAttributesTaskAttributes) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&repication.SearchAttributesTaskAttributes{")
+	s = append(s, "IndexName: "+fmt.Sprintf("%#v", this.IndexName)+",\n")
+	keysForCustomSearchAttributes := make([]string, 0, len(this.CustomSearchAttributes))
+	for k, _ := range this.CustomSearchAttributes {
+		keysForCustomSearchAttributes = append(keysForCustomSearchAttributes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForCustomSearchAttributes)
+	mapStringForCustomSearchAttributes := "map[string]v14.IndexedValueType{"
+	for _, k := range keysForCustomSearchAttributes {
+		mapStringForCustomSearchAttributes += fmt.Sprintf("%#v: %#v,", k, this.CustomSearchAttributes[k])
+	}
+	mapStringForCustomSearchAttributes += "}"
+	if this.CustomSearchAttributes != nil {
+		s = append(s, "CustomSearchAttributes: "+mapStringForCustomSearchAttributes+",\n")
+	}
+	s = append(s, "LastUpdateTime: "+fmt.Sprintf("%#v", this.LastUpdateTime)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2239,6 +2438,27 @@ func (m *ReplicationTask_HistoryTaskV2Attributes) MarshalToSizedBuffer(dAtA []by
 	}
 	return len(dAtA) - i, nil
 }
+func (m *ReplicationTask_SearchAttributesTaskAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationTask_SearchAttributesTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SearchAttributesTaskAttributes != nil {
+		{
+			size, err := m.SearchAttributesTaskAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *ReplicationToken) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2298,12 +2518,12 @@ func (m *SyncShardStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.StatusTime != nil {
-		n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StatusTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StatusTime):])
-		if err7 != nil {
-			return 0, err7
+		n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StatusTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StatusTime):])
+		if err8 != nil {
+			return 0, err8
 		}
-		i -= n7
-		i = encodeVarintMessage(dAtA, i, uint64(n7))
+		i -= n8
+		i = encodeVarintMessage(dAtA, i, uint64(n8))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -2468,6 +2688,18 @@ func (m *NamespaceTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Quotas != nil {
+		{
+			size, err := m.Quotas.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.FailoverVersion != 0 {
 		i = encodeVarintMessage(dAtA, i, uint64(m.FailoverVersion))
 		i--
@@ -2529,6 +2761,63 @@ func (m *NamespaceTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *SearchAttributesTaskAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchAttributesTaskAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SearchAttributesTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastUpdateTime != nil {
+		n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpdateTime):])
+		if err14 != nil {
+			return 0, err14
+		}
+		i -= n14
+		i = encodeVarintMessage(dAtA, i, uint64(n14))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CustomSearchAttributes) > 0 {
+		for k := range m.CustomSearchAttributes {
+			v := m.CustomSearchAttributes[k]
+			baseI := i
+			i = encodeVarintMessage(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintMessage(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintMessage(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.IndexName) > 0 {
+		i -= len(m.IndexName)
+		copy(dAtA[i:], m.IndexName)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.IndexName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *HistoryTaskAttributes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2710,12 +2999,12 @@ func (m *SyncShardStatusTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int,
 	var l int
 	_ = l
 	if m.StatusTime != nil {
-		n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StatusTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StatusTime):])
-		if err14 != nil {
-			return 0, err14
+		n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StatusTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StatusTime):])
+		if err17 != nil {
+			return 0, err17
 		}
-		i -= n14
-		i = encodeVarintMessage(dAtA, i, uint64(n14))
+		i -= n17
+		i = encodeVarintMessage(dAtA, i, uint64(n17))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -2803,22 +3092,22 @@ func (m *SyncActivityTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, err
 		dAtA[i] = 0x52
 	}
 	if m.LastHeartbeatTime != nil {
-		n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatTime):])
-		if err18 != nil {
-			return 0, err18
+		n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatTime):])
+		if err21 != nil {
+			return 0, err21
 		}
-		i -= n18
-		i = encodeVarintMessage(dAtA, i, uint64(n18))
+		i -= n21
+		i = encodeVarintMessage(dAtA, i, uint64(n21))
 		i--
 		dAtA[i] = 0x4a
 	}
 	if m.StartedTime != nil {
-		n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
-		if err19 != nil {
-			return 0, err19
+		n22, err22 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
+		if err22 != nil {
+			return 0, err22
 		}
-		i -= n19
-		i = encodeVarintMessage(dAtA, i, uint64(n19))
+		i -= n22
+		i = encodeVarintMessage(dAtA, i, uint64(n22))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -2828,12 +3117,12 @@ func (m *SyncActivityTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, err
 		dAtA[i] = 0x38
 	}
 	if m.ScheduledTime != nil {
-		n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
-		if err20 != nil {
-			return 0, err20
+		n23, err23 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
+		if err23 != nil {
+			return 0, err23
 		}
-		i -= n20
-		i = encodeVarintMessage(dAtA, i, uint64(n20))
+		i -= n23
+		i = encodeVarintMessage(dAtA, i, uint64(n23))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -3059,6 +3348,18 @@ func (m *ReplicationTask_HistoryTaskV2Attributes) Size() (n int) {
 	}
 	return n
 }
+func (m *ReplicationTask_SearchAttributesTaskAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SearchAttributesTaskAttributes != nil {
+		l = m.SearchAttributesTaskAttributes.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
 func (m *ReplicationToken) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3185,6 +3486,35 @@ func (m *NamespaceTaskAttributes) Size() (n int) {
 	if m.FailoverVersion != 0 {
 		n += 1 + sovMessage(uint64(m.FailoverVersion))
 	}
+	if m.Quotas != nil {
+		l = m.Quotas.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *SearchAttributesTaskAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IndexName)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if len(m.CustomSearchAttributes) > 0 {
+		for k, v := range m.CustomSearchAttributes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovMessage(uint64(len(k))) + 1 + sovMessage(uint64(v))
+			n += mapEntrySize + 1 + sovMessage(uint64(mapEntrySize))
+		}
+	}
+	if m.LastUpdateTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpdateTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
 	return n
 }
 
@@ -3465,6 +3795,16 @@ func (this *ReplicationTask_HistoryTaskV2Attributes) String() string {
 	}, "")
 	return s
 }
+func (this *ReplicationTask_SearchAttributesTaskAttributes) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReplicationTask_SearchAttributesTaskAttributes{`,
+		`SearchAttributesTaskAttributes:` + strings.Replace(fmt.Sprintf("%v", this.SearchAttributesTaskAttributes), "SearchAttributesTaskAttributes", "SearchAttributesTaskAttributes", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ReplicationToken) String() string {
 	if this == nil {
 		return "nil"
@@ -3535,6 +3875,29 @@ func (this *NamespaceTaskAttributes) String() string {
 		`ReplicationConfig:` + strings.Replace(fmt.Sprintf("%v", this.ReplicationConfig), "NamespaceReplicationConfig", "v12.NamespaceReplicationConfig", 1) + `,`,
 		`ConfigVersion:` + fmt.Sprintf("%v", this.ConfigVersion) + `,`,
 		`FailoverVersion:` + fmt.Sprintf("%v", this.FailoverVersion) + `,`,
+		`Quotas:` + strings.Replace(fmt.Sprintf("%v", this.Quotas), "NamespaceQuotas", "v13.NamespaceQuotas", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SearchAttributesTaskAttributes) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForCustomSearchAttributes := make([]string, 0, len(this.CustomSearchAttributes))
+	for k, _ := range this.CustomSearchAttributes {
+		keysForCustomSearchAttributes = append(keysForCustomSearchAttributes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForCustomSearchAttributes)
+	mapStringForCustomSearchAttributes := "map[string]v14.IndexedValueType{"
+	for _, k := range keysForCustomSearchAttributes {
+		mapStringForCustomSearchAttributes += fmt.Sprintf("%v: %v,", k, this.CustomSearchAttributes[k])
+	}
+	mapStringForCustomSearchAttributes += "}"
+	s := strings.Join([]string{`&SearchAttributesTaskAttributes{`,
+		`IndexName:` + fmt.Sprintf("%v", this.IndexName) + `,`,
+		`CustomSearchAttributes:` + mapStringForCustomSearchAttributes + `,`,
+		`LastUpdateTime:` + strings.Replace(fmt.Sprintf("%v", this.LastUpdateTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3551,8 +3914,8 @@ func (this *HistoryTaskAttributes) String() string {
 		`FirstEventId:` + fmt.Sprintf("%v", this.FirstEventId) + `,`,
 		`NextEventId:` + fmt.Sprintf("%v", this.NextEventId) + `,`,
 		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
-		`History:` + strings.Replace(fmt.Sprintf("%v", this.History), "History", "v13.History", 1) + `,`,
-		`NewRunHistory:` + strings.Replace(fmt.Sprintf("%v", this.NewRunHistory), "History", "v13.History", 1) + `,`,
+		`History:` + strings.Replace(fmt.Sprintf("%v", this.History), "History", "v15.History", 1) + `,`,
+		`NewRunHistory:` + strings.Replace(fmt.Sprintf("%v", this.NewRunHistory), "History", "v15.History", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3599,11 +3962,11 @@ func (this *SyncActivityTaskAttributes) String() string {
 		`StartedId:` + fmt.Sprintf("%v", this.StartedId) + `,`,
 		`StartedTime:` + strings.Replace(fmt.Sprintf("%v", this.StartedTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`LastHeartbeatTime:` + strings.Replace(fmt.Sprintf("%v", this.LastHeartbeatTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`Details:` + strings.Replace(fmt.Sprintf("%v", this.Details), "Payloads", "v14.Payloads", 1) + `,`,
+		`Details:` + strings.Replace(fmt.Sprintf("%v", this.Details), "Payloads", "v16.Payloads", 1) + `,`,
 		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
-		`LastFailure:` + strings.Replace(fmt.Sprintf("%v", this.LastFailure), "Failure", "v15.Failure", 1) + `,`,
+		`LastFailure:` + strings.Replace(fmt.Sprintf("%v", this.LastFailure), "Failure", "v17.Failure", 1) + `,`,
 		`LastWorkerIdentity:` + fmt.Sprintf("%v", this.LastWorkerIdentity) + `,`,
-		`VersionHistory:` + strings.Replace(fmt.Sprintf("%v", this.VersionHistory), "VersionHistory", "v16.VersionHistory", 1) + `,`,
+		`VersionHistory:` + strings.Replace(fmt.Sprintf("%v", this.VersionHistory), "VersionHistory", "v18.VersionHistory", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3614,7 +3977,7 @@ func (this *HistoryTaskV2Attributes) String() string {
 	}
 	repeatedStringForVersionHistoryItems := "[]*VersionHistoryItem{"
 	for _, f := range this.VersionHistoryItems {
-		repeatedStringForVersionHistoryItems += strings.Replace(fmt.Sprintf("%v", f), "VersionHistoryItem", "v16.VersionHistoryItem", 1) + ","
+		repeatedStringForVersionHistoryItems += strings.Replace(fmt.Sprintf("%v", f), "VersionHistoryItem", "v18.VersionHistoryItem", 1) + ","
 	}
 	repeatedStringForVersionHistoryItems += "}"
 	s := strings.Join([]string{`&HistoryTaskV2Attributes{`,
@@ -3623,8 +3986,8 @@ func (this *HistoryTaskV2Attributes) String() string {
 		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
 		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
 		`VersionHistoryItems:` + repeatedStringForVersionHistoryItems + `,`,
-		`Events:` + strings.Replace(fmt.Sprintf("%v", this.Events), "DataBlob", "v14.DataBlob", 1) + `,`,
-		`NewRunEvents:` + strings.Replace(fmt.Sprintf("%v", this.NewRunEvents), "DataBlob", "v14.DataBlob", 1) + `,`,
+		`Events:` + strings.Replace(fmt.Sprintf("%v", this.Events), "DataBlob", "v16.DataBlob", 1) + `,`,
+		`NewRunEvents:` + strings.Replace(fmt.Sprintf("%v", this.NewRunEvents), "DataBlob", "v16.DataBlob", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3914,6 +4277,41 @@ func (m *ReplicationTask) Unmarshal(dAtA []byte) error {
 			}
 			m.Attributes = &ReplicationTask_HistoryTaskV2Attributes{v}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SearchAttributesTaskAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SearchAttributesTaskAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &ReplicationTask_SearchAttributesTaskAttributes{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -4788,6 +5186,276 @@ func (m *NamespaceTaskAttributes) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quotas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Quotas == nil {
+				m.Quotas = &v13.NamespaceQuotas{}
+			}
+			if err := m.Quotas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SearchAttributesTaskAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SearchAttributesTaskAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SearchAttributesTaskAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomSearchAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CustomSearchAttributes == nil {
+				m.CustomSearchAttributes = make(map[string]v14.IndexedValueType)
+			}
+			var mapkey string
+			var mapvalue v14.IndexedValueType
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= v14.IndexedValueType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipMessage(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.CustomSearchAttributes[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastUpdateTime == nil {
+				m.LastUpdateTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastUpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -5056,7 +5724,7 @@ func (m *HistoryTaskAttributes) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.History == nil {
-				m.History = &v13.History{}
+				m.History = &v15.History{}
 			}
 			if err := m.History.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5092,7 +5760,7 @@ func (m *HistoryTaskAttributes) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.NewRunHistory == nil {
-				m.NewRunHistory = &v13.History{}
+				m.NewRunHistory = &v15.History{}
 			}
 			if err := m.NewRunHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5820,7 +6488,7 @@ func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Details == nil {
-				m.Details = &v14.Payloads{}
+				m.Details = &v16.Payloads{}
 			}
 			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5875,7 +6543,7 @@ func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.LastFailure == nil {
-				m.LastFailure = &v15.Failure{}
+				m.LastFailure = &v17.Failure{}
 			}
 			if err := m.LastFailure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5943,7 +6611,7 @@ func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.VersionHistory == nil {
-				m.VersionHistory = &v16.VersionHistory{}
+				m.VersionHistory = &v18.VersionHistory{}
 			}
 			if err := m.VersionHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -6146,7 +6814,7 @@ func (m *HistoryTaskV2Attributes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VersionHistoryItems = append(m.VersionHistoryItems, &v16.VersionHistoryItem{})
+			m.VersionHistoryItems = append(m.VersionHistoryItems, &v18.VersionHistoryItem{})
 			if err := m.VersionHistoryItems[len(m.VersionHistoryItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -6181,7 +6849,7 @@ func (m *HistoryTaskV2Attributes) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Events == nil {
-				m.Events = &v14.DataBlob{}
+				m.Events = &v16.DataBlob{}
 			}
 			if err := m.Events.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -6217,7 +6885,7 @@ func (m *HistoryTaskV2Attributes) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.NewRunEvents == nil {
-				m.NewRunEvents = &v14.DataBlob{}
+				m.NewRunEvents = &v16.DataBlob{}
 			}
 			if err := m.NewRunEvents.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -37,6 +37,7 @@ import (
 	strings "strings"
 
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 	v1 "go.temporal.io/server/api/history/v1"
 )
 
@@ -249,6 +250,82 @@ func (m *RawHistoryContinuation) GetVersionHistories() *v1.VersionHistories {
 	return nil
 }
 
+type HistoryReverseContinuation struct {
+	Namespace  string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	WorkflowId string `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	RunId      string `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// Exclusive upper bound of the events to be returned by the next page.
+	NextEventId int64  `protobuf:"varint,4,opt,name=next_event_id,json=nextEventId,proto3" json:"next_event_id,omitempty"`
+	BranchToken []byte `protobuf:"bytes,5,opt,name=branch_token,json=branchToken,proto3" json:"branch_token,omitempty"`
+}
+
+func (m *HistoryReverseContinuation) Reset()      { *m = HistoryReverseContinuation{} }
+func (*HistoryReverseContinuation) ProtoMessage() {}
+func (*HistoryReverseContinuation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_020fff7d28118bec, []int{2}
+}
+func (m *HistoryReverseContinuation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoryReverseContinuation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoryReverseContinuation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HistoryReverseContinuation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryReverseContinuation.Merge(m, src)
+}
+func (m *HistoryReverseContinuation) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistoryReverseContinuation) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryReverseContinuation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoryReverseContinuation proto.InternalMessageInfo
+
+func (m *HistoryReverseContinuation) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *HistoryReverseContinuation) GetWorkflowId() string {
+	if m != nil {
+		return m.WorkflowId
+	}
+	return ""
+}
+
+func (m *HistoryReverseContinuation) GetRunId() string {
+	if m != nil {
+		return m.RunId
+	}
+	return ""
+}
+
+func (m *HistoryReverseContinuation) GetNextEventId() int64 {
+	if m != nil {
+		return m.NextEventId
+	}
+	return 0
+}
+
+func (m *HistoryReverseContinuation) GetBranchToken() []byte {
+	if m != nil {
+		return m.BranchToken
+	}
+	return nil
+}
+
 type Task struct {
 	NamespaceId     string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	WorkflowId      string `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
@@ -258,12 +335,14 @@ type Task struct {
 	ActivityId      string `protobuf:"bytes,6,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
 	WorkflowType    string `protobuf:"bytes,7,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
 	ActivityType    string `protobuf:"bytes,8,opt,name=activity_type,json=activityType,proto3" json:"activity_type,omitempty"`
+	// W3C trace context of the dispatch of the task to the worker.
+	TraceContext map[string]string `protobuf:"bytes,9,rep,name=trace_context,json=traceContext,proto3" json:"trace_context,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *Task) Reset()      { *m = Task{} }
 func (*Task) ProtoMessage() {}
 func (*Task) Descriptor() ([]byte, []int) {
-	return fileDescriptor_020fff7d28118bec, []int{2}
+	return fileDescriptor_020fff7d28118bec, []int{3}
 }
 func (m *Task) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -348,6 +427,13 @@ func (m *Task) GetActivityType() string {
 	return ""
 }
 
+func (m *Task) GetTraceContext() map[string]string {
+	if m != nil {
+		return m.TraceContext
+	}
+	return nil
+}
+
 type QueryTask struct {
 	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	TaskQueue   string `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
@@ -357,7 +443,7 @@ type QueryTask struct {
 func (m *QueryTask) Reset()      { *m = QueryTask{} }
 func (*QueryTask) ProtoMessage() {}
 func (*QueryTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_020fff7d28118bec, []int{3}
+	return fileDescriptor_020fff7d28118bec, []int{4}
 }
 func (m *QueryTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -410,7 +496,9 @@ func (m *QueryTask) GetTaskId() string {
 func init() {
 	proto.RegisterType((*HistoryContinuation)(nil), "temporal.server.api.token.v1.HistoryContinuation")
 	proto.RegisterType((*RawHistoryContinuation)(nil), "temporal.server.api.token.v1.RawHistoryContinuation")
+	proto.RegisterType((*HistoryReverseContinuation)(nil), "temporal.server.api.token.v1.HistoryReverseContinuation")
 	proto.RegisterType((*Task)(nil), "temporal.server.api.token.v1.Task")
+	proto.RegisterMapType((map[string]string)(nil), "temporal.server.api.token.v1.Task.TraceContextEntry")
 	proto.RegisterType((*QueryTask)(nil), "temporal.server.api.token.v1.QueryTask")
 }
 
@@ -419,49 +507,55 @@ func init() {
 }
 
 var fileDescriptor_020fff7d28118bec = []byte{
-	// 665 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x41, 0x6f, 0xd3, 0x3c,
-	0x1c, 0xc6, 0x9b, 0x75, 0xeb, 0x9a, 0x7f, 0xbb, 0x77, 0x6d, 0xa6, 0xbd, 0xab, 0xd0, 0xc8, 0x4a,
-	0xe1, 0x50, 0x06, 0x4a, 0x19, 0x9c, 0x10, 0x27, 0x40, 0x48, 0x0b, 0xb7, 0x45, 0x15, 0x48, 0x48,
-	0x10, 0x79, 0x8d, 0xbb, 0x59, 0xdd, 0x9c, 0xcc, 0x76, 0x32, 0x7a, 0xe3, 0x23, 0x70, 0xe4, 0xc4,
-	0x99, 0x8f, 0xc2, 0x71, 0xc7, 0x1d, 0x59, 0x76, 0xe1, 0xc6, 0x3e, 0x02, 0xb2, 0x13, 0x27, 0xd5,
-	0x28, 0x02, 0x71, 0x6b, 0x9f, 0xff, 0x93, 0xc7, 0xf6, 0xf3, 0x93, 0x0d, 0xdb, 0x02, 0x1f, 0x47,
-	0x21, 0x43, 0x47, 0x03, 0x8e, 0x59, 0x82, 0xd9, 0x00, 0x45, 0x64, 0x20, 0xc2, 0x09, 0xa6, 0x83,
-	0x64, 0x67, 0x70, 0x8c, 0x39, 0x47, 0x07, 0xd8, 0x89, 0x58, 0x28, 0x42, 0x6b, 0x53, 0x7b, 0x9d,
-	0xcc, 0xeb, 0xa0, 0x88, 0x38, 0xca, 0xeb, 0x24, 0x3b, 0x37, 0xee, 0xcf, 0x4b, 0x3a, 0x24, 0x5c,
-	0x84, 0x6c, 0xfa, 0x4b, 0x56, 0xef, 0xc7, 0x02, 0xac, 0xed, 0x66, 0xc3, 0xe7, 0x21, 0x15, 0x84,
-	0xc6, 0x48, 0x90, 0x90, 0x5a, 0xeb, 0x50, 0x63, 0x31, 0xf5, 0x49, 0xd0, 0x31, 0xba, 0x46, 0xdf,
-	0xf4, 0x96, 0x58, 0x4c, 0xdd, 0xc0, 0xba, 0x03, 0xff, 0x8d, 0x09, 0xe3, 0xc2, 0xc7, 0x09, 0xa6,
-	0x42, 0x8e, 0x17, 0xba, 0x46, 0xbf, 0xea, 0x35, 0x95, 0xfa, 0x42, 0x8a, 0x6e, 0x60, 0xf5, 0x60,
-	0x85, 0xe2, 0xf7, 0x33, 0xa6, 0xaa, 0x32, 0x35, 0xa4, 0xa8, 0x3d, 0x0e, 0xac, 0x11, 0xee, 0x9f,
-	0x86, 0x6c, 0x32, 0x3e, 0x0a, 0x4f, 0x7d, 0x16, 0x53, 0x4a, 0xe8, 0x41, 0x67, 0xa9, 0x6b, 0xf4,
-	0xeb, 0x5e, 0x9b, 0xf0, 0xd7, 0xf9, 0xc4, 0xcb, 0x06, 0xd6, 0x3d, 0x68, 0x47, 0x98, 0x71, 0xc2,
-	0x05, 0xa6, 0x23, 0xec, 0xab, 0xe3, 0x76, 0x6a, 0x5d, 0xa3, 0xdf, 0xf4, 0x5a, 0x33, 0x83, 0xa1,
-	0xd4, 0xad, 0x13, 0xd8, 0x10, 0x0c, 0x51, 0x4e, 0xe4, 0xfa, 0xc5, 0x1a, 0x02, 0xf1, 0x49, 0x67,
-	0xb9, 0x6b, 0xf4, 0x1b, 0x0f, 0x1f, 0x3b, 0xf3, 0x3a, 0xcc, 0x5b, 0x72, 0x92, 0x1d, 0x67, 0xa8,
-	0x3f, 0xd7, 0xfb, 0x18, 0x22, 0x3e, 0x71, 0xe9, 0x38, 0xf4, 0xd6, 0xc5, 0xbc, 0x91, 0x75, 0x0b,
-	0x9a, 0xfb, 0x0c, 0xd1, 0xd1, 0x61, 0xbe, 0xb5, 0xba, 0xda, 0x5a, 0x23, 0xd3, 0xd4, 0xae, 0x5e,
-	0x2e, 0xd6, 0xcd, 0x16, 0xf4, 0x3e, 0x57, 0xe1, 0x7f, 0x0f, 0x9d, 0xce, 0x2b, 0x7d, 0x13, 0x4c,
-	0x8a, 0x8e, 0x31, 0x8f, 0xd0, 0x08, 0xe7, 0xbd, 0x97, 0x82, 0xb5, 0x05, 0x8d, 0xe2, 0x28, 0x79,
-	0xf1, 0xa6, 0x07, 0x5a, 0x72, 0x83, 0x19, 0x66, 0xd5, 0x6b, 0xcc, 0xb8, 0x40, 0x6c, 0x06, 0xc7,
-	0x62, 0xc6, 0x4c, 0xa9, 0x33, 0x3c, 0x66, 0x5d, 0x89, 0xac, 0x34, 0xa4, 0x8a, 0x47, 0xd5, 0x6b,
-	0x97, 0xd6, 0x57, 0xd9, 0xc0, 0xea, 0x42, 0x13, 0xd3, 0xa0, 0xcc, 0xac, 0x29, 0x23, 0x60, 0x1a,
-	0xe8, 0xc4, 0x6d, 0x68, 0x97, 0x0e, 0x9d, 0xb7, 0xac, 0x6c, 0xab, 0xda, 0xa6, 0xd3, 0xe6, 0xd2,
-	0xad, 0xff, 0x86, 0xee, 0x5b, 0x68, 0xe7, 0x71, 0x7e, 0x46, 0x8c, 0x60, 0xde, 0x31, 0x15, 0xd7,
-	0x07, 0x7f, 0xe2, 0x9a, 0x2f, 0xb8, 0xab, 0xbf, 0xf3, 0x5a, 0xc9, 0x35, 0xa5, 0xf7, 0x69, 0x01,
-	0x16, 0x35, 0xd2, 0xa2, 0xfd, 0xf2, 0x26, 0x34, 0x0a, 0xcd, 0x0d, 0xfe, 0x99, 0xc9, 0x16, 0x34,
-	0xf8, 0xe8, 0x10, 0x07, 0xf1, 0x11, 0x2e, 0x81, 0x80, 0x96, 0xdc, 0xc0, 0xba, 0x0b, 0xad, 0xc2,
-	0x80, 0x84, 0x3c, 0x94, 0x50, 0x2c, 0x96, 0xbc, 0x55, 0xad, 0x3f, 0xcd, 0x64, 0x99, 0x85, 0x46,
-	0x82, 0x24, 0x44, 0x4c, 0x35, 0x08, 0xd3, 0x03, 0x2d, 0xb9, 0x81, 0x75, 0x1b, 0x56, 0xca, 0x3b,
-	0x30, 0x8d, 0xb0, 0x82, 0x60, 0x7a, 0x4d, 0x2d, 0x0e, 0xa7, 0x11, 0x96, 0xa6, 0x22, 0x45, 0x99,
-	0xea, 0x99, 0x49, 0x8b, 0xd2, 0xd4, 0x1b, 0x83, 0xb9, 0x17, 0x63, 0x36, 0xfd, 0xdb, 0x7a, 0x6e,
-	0x02, 0xc8, 0x4b, 0xe7, 0x9f, 0xc4, 0x38, 0xc6, 0x79, 0x3b, 0xa6, 0x54, 0xf6, 0xa4, 0x60, 0x6d,
-	0xc0, 0xb2, 0x1a, 0x17, 0xed, 0xd4, 0xe4, 0x5f, 0x37, 0x78, 0xf6, 0xee, 0xec, 0xc2, 0xae, 0x9c,
-	0x5f, 0xd8, 0x95, 0xab, 0x0b, 0xdb, 0xf8, 0x90, 0xda, 0xc6, 0x97, 0xd4, 0x36, 0xbe, 0xa6, 0xb6,
-	0x71, 0x96, 0xda, 0xc6, 0xb7, 0xd4, 0x36, 0xbe, 0xa7, 0x76, 0xe5, 0x2a, 0xb5, 0x8d, 0x8f, 0x97,
-	0x76, 0xe5, 0xec, 0xd2, 0xae, 0x9c, 0x5f, 0xda, 0x95, 0x37, 0xfd, 0x83, 0xb0, 0xc4, 0x4f, 0xc2,
-	0x79, 0x2f, 0xe9, 0x13, 0xf5, 0x63, 0xbf, 0xa6, 0x1e, 0xbf, 0x47, 0x3f, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0x82, 0x84, 0xbc, 0x27, 0x76, 0x05, 0x00, 0x00,
+	// 766 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcd, 0x6e, 0xeb, 0x44,
+	0x18, 0x8d, 0xeb, 0x24, 0x8d, 0xbf, 0xa4, 0x34, 0x71, 0x29, 0x8d, 0xaa, 0xe2, 0x86, 0xc0, 0x22,
+	0x14, 0xe4, 0xd0, 0xc2, 0x82, 0x9f, 0x05, 0x02, 0x84, 0x54, 0xb3, 0xab, 0x15, 0x81, 0x40, 0x82,
+	0x68, 0x1a, 0x4f, 0xda, 0x51, 0xd2, 0xb1, 0x3b, 0x33, 0x76, 0x9b, 0x1d, 0x8f, 0xc0, 0x13, 0xb0,
+	0xe6, 0x01, 0xd8, 0xf0, 0x06, 0x2c, 0xbb, 0xec, 0xf2, 0x36, 0xdd, 0xdc, 0xdd, 0xed, 0x23, 0x5c,
+	0xcd, 0xd8, 0x63, 0x47, 0x4d, 0xee, 0x8f, 0xee, 0xe2, 0xee, 0xec, 0xf3, 0x1d, 0x9f, 0xf9, 0x7c,
+	0xce, 0x37, 0x33, 0x70, 0x20, 0xf0, 0x45, 0x14, 0x32, 0x34, 0xed, 0x73, 0xcc, 0x12, 0xcc, 0xfa,
+	0x28, 0x22, 0x7d, 0x11, 0x4e, 0x30, 0xed, 0x27, 0x87, 0xfd, 0x0b, 0xcc, 0x39, 0x3a, 0xc3, 0x6e,
+	0xc4, 0x42, 0x11, 0xda, 0x7b, 0x9a, 0xeb, 0xa6, 0x5c, 0x17, 0x45, 0xc4, 0x55, 0x5c, 0x37, 0x39,
+	0xdc, 0xfd, 0x74, 0x95, 0xd2, 0x39, 0xe1, 0x22, 0x64, 0xb3, 0x25, 0xad, 0xee, 0xb3, 0x35, 0xd8,
+	0x3a, 0x4e, 0x8b, 0x3f, 0x84, 0x54, 0x10, 0x1a, 0x23, 0x41, 0x42, 0x6a, 0x6f, 0x43, 0x95, 0xc5,
+	0x74, 0x48, 0x82, 0xb6, 0xd1, 0x31, 0x7a, 0x96, 0x5f, 0x61, 0x31, 0xf5, 0x02, 0xfb, 0x23, 0x78,
+	0x67, 0x4c, 0x18, 0x17, 0x43, 0x9c, 0x60, 0x2a, 0x64, 0x79, 0xad, 0x63, 0xf4, 0x4c, 0xbf, 0xa1,
+	0xd0, 0x1f, 0x25, 0xe8, 0x05, 0x76, 0x17, 0x36, 0x28, 0xbe, 0x5e, 0x20, 0x99, 0x8a, 0x54, 0x97,
+	0xa0, 0xe6, 0xb8, 0xb0, 0x45, 0xf8, 0xf0, 0x2a, 0x64, 0x93, 0xf1, 0x34, 0xbc, 0x1a, 0xb2, 0x98,
+	0x52, 0x42, 0xcf, 0xda, 0x95, 0x8e, 0xd1, 0xab, 0xf9, 0x2d, 0xc2, 0x7f, 0xc9, 0x2a, 0x7e, 0x5a,
+	0xb0, 0x3f, 0x81, 0x56, 0x84, 0x19, 0x27, 0x5c, 0x60, 0x3a, 0xc2, 0x43, 0xf5, 0xbb, 0xed, 0x6a,
+	0xc7, 0xe8, 0x35, 0xfc, 0xe6, 0x42, 0x61, 0x20, 0x71, 0xfb, 0x12, 0x76, 0x04, 0x43, 0x94, 0x13,
+	0xb9, 0x7e, 0xbe, 0x86, 0x40, 0x7c, 0xd2, 0x5e, 0xef, 0x18, 0xbd, 0xfa, 0xd1, 0x57, 0xee, 0x2a,
+	0x0f, 0x33, 0x97, 0xdc, 0xe4, 0xd0, 0x1d, 0xe8, 0xcf, 0x75, 0x1f, 0x03, 0xc4, 0x27, 0x1e, 0x1d,
+	0x87, 0xfe, 0xb6, 0x58, 0x55, 0xb2, 0x3f, 0x80, 0xc6, 0x29, 0x43, 0x74, 0x74, 0x9e, 0xb5, 0x56,
+	0x53, 0xad, 0xd5, 0x53, 0x4c, 0x75, 0xf5, 0x53, 0xb9, 0x66, 0x35, 0xa1, 0xfb, 0xb7, 0x09, 0xef,
+	0xf9, 0xe8, 0x6a, 0x95, 0xe9, 0x7b, 0x60, 0x51, 0x74, 0x81, 0x79, 0x84, 0x46, 0x38, 0xf3, 0xbd,
+	0x00, 0xec, 0x7d, 0xa8, 0xe7, 0xbf, 0x92, 0x19, 0x6f, 0xf9, 0xa0, 0x21, 0x2f, 0x58, 0xc8, 0xcc,
+	0x7c, 0x94, 0x19, 0x17, 0x88, 0x2d, 0xc4, 0x51, 0x4e, 0x33, 0x53, 0xe8, 0x42, 0x1e, 0x8b, 0xac,
+	0x44, 0x5a, 0x1a, 0x52, 0x95, 0x87, 0xe9, 0xb7, 0x0a, 0xea, 0xcf, 0x69, 0xc1, 0xee, 0x40, 0x03,
+	0xd3, 0xa0, 0xd0, 0xac, 0x2a, 0x22, 0x60, 0x1a, 0x68, 0xc5, 0x03, 0x68, 0x15, 0x0c, 0xad, 0xb7,
+	0xae, 0x68, 0x9b, 0x9a, 0xa6, 0xd5, 0x56, 0xa6, 0x5b, 0x7b, 0x41, 0xba, 0xbf, 0x43, 0x2b, 0x93,
+	0x1b, 0xa6, 0x89, 0x11, 0xcc, 0xdb, 0x96, 0xca, 0xf5, 0xb3, 0x57, 0xe5, 0x9a, 0x2d, 0x78, 0xac,
+	0xbf, 0xf3, 0x9b, 0xc9, 0x23, 0xa4, 0xfb, 0x9f, 0x01, 0xbb, 0x59, 0x3a, 0x3e, 0x96, 0x55, 0xfc,
+	0x16, 0x42, 0x5a, 0xda, 0x32, 0xe5, 0xe5, 0x2d, 0xf3, 0x78, 0xc4, 0x2a, 0x4b, 0x23, 0xd6, 0xfd,
+	0xd7, 0x84, 0xb2, 0x1e, 0xc7, 0xbc, 0xa9, 0x62, 0x17, 0xd7, 0x73, 0xcc, 0x0b, 0xde, 0xb8, 0xd5,
+	0x7d, 0xa8, 0xf3, 0xd1, 0x39, 0x0e, 0xe2, 0x29, 0x2e, 0x1a, 0x05, 0x0d, 0x79, 0x81, 0xfd, 0x31,
+	0x34, 0x73, 0x02, 0x12, 0x32, 0x10, 0xa1, 0x7a, 0xad, 0xf8, 0x9b, 0x1a, 0xff, 0x2e, 0x85, 0xa5,
+	0x16, 0x1a, 0x09, 0x92, 0x10, 0x31, 0xd3, 0x43, 0x64, 0xf9, 0xa0, 0x21, 0x2f, 0xb0, 0x3f, 0x84,
+	0x8d, 0x62, 0xff, 0xce, 0x22, 0xac, 0x06, 0xc8, 0xf2, 0x1b, 0x1a, 0x1c, 0xcc, 0x22, 0x2c, 0x49,
+	0xb9, 0x8a, 0x22, 0xd5, 0x52, 0x92, 0x06, 0x15, 0xe9, 0x57, 0xd8, 0x10, 0x4c, 0xba, 0x31, 0x0a,
+	0xa9, 0xc0, 0xd7, 0xa2, 0x6d, 0x75, 0xcc, 0x5e, 0xfd, 0xe8, 0x0b, 0xf7, 0x65, 0xa7, 0xa9, 0x2b,
+	0xcd, 0x94, 0x87, 0xc1, 0x48, 0x0d, 0x81, 0x0c, 0x83, 0x0a, 0x36, 0xf3, 0x1b, 0x62, 0x01, 0xda,
+	0xfd, 0x16, 0x5a, 0x4b, 0x14, 0xbb, 0x09, 0xe6, 0x04, 0xcf, 0x32, 0xe3, 0xe5, 0xa3, 0xfd, 0x2e,
+	0x54, 0x12, 0x34, 0x8d, 0x71, 0x66, 0x75, 0xfa, 0xf2, 0xf5, 0xda, 0x97, 0x46, 0x77, 0x0c, 0xd6,
+	0x49, 0x8c, 0xd9, 0xec, 0x75, 0xa3, 0x7b, 0x1f, 0x40, 0x1e, 0x66, 0xc3, 0xcb, 0x18, 0xe7, 0x72,
+	0x96, 0x44, 0x4e, 0x24, 0x60, 0xef, 0xc0, 0xba, 0x2a, 0xe7, 0xc9, 0x55, 0xe5, 0xab, 0x17, 0x7c,
+	0xff, 0xc7, 0xcd, 0x9d, 0x53, 0xba, 0xbd, 0x73, 0x4a, 0x0f, 0x77, 0x8e, 0xf1, 0xe7, 0xdc, 0x31,
+	0xfe, 0x99, 0x3b, 0xc6, 0xff, 0x73, 0xc7, 0xb8, 0x99, 0x3b, 0xc6, 0x93, 0xb9, 0x63, 0x3c, 0x9d,
+	0x3b, 0xa5, 0x87, 0xb9, 0x63, 0xfc, 0x75, 0xef, 0x94, 0x6e, 0xee, 0x9d, 0xd2, 0xed, 0xbd, 0x53,
+	0xfa, 0xad, 0x77, 0x16, 0x16, 0x26, 0x91, 0x70, 0xd5, 0x0d, 0xf5, 0x8d, 0x7a, 0x38, 0xad, 0xaa,
+	0x4b, 0xe5, 0xf3, 0xe7, 0x03, 0x00, 0xb7, 0xfd, 0x7a, 0xc6, 0xce, 0x06, 0x00, 0x00,
 }
 
 func (this *HistoryContinuation) Equal(that interface{}) bool {
@@ -554,6 +648,42 @@ func (this *RawHistoryContinuation) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *HistoryReverseContinuation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HistoryReverseContinuation)
+	if !ok {
+		that2, ok := that.(HistoryReverseContinuation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Namespace != that1.Namespace {
+		return false
+	}
+	if this.WorkflowId != that1.WorkflowId {
+		return false
+	}
+	if this.RunId != that1.RunId {
+		return false
+	}
+	if this.NextEventId != that1.NextEventId {
+		return false
+	}
+	if !bytes.Equal(this.BranchToken, that1.BranchToken) {
+		return false
+	}
+	return true
+}
 func (this *Task) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -597,6 +727,14 @@ func (this *Task) Equal(that interface{}) bool {
 	if this.ActivityType != that1.ActivityType {
 		return false
 	}
+	if len(this.TraceContext) != len(that1.TraceContext) {
+		return false
+	}
+	for i := range this.TraceContext {
+		if this.TraceContext[i] != that1.TraceContext[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *QueryTask) Equal(that interface{}) bool {
@@ -667,11 +805,25 @@ func (this *RawHistoryContinuation) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *HistoryReverseContinuation) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&token.HistoryReverseContinuation{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
+	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
+	s = append(s, "NextEventId: "+fmt.Sprintf("%#v", this.NextEventId)+",\n")
+	s = append(s, "BranchToken: "+fmt.Sprintf("%#v", this.BranchToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *Task) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 12)
+	s := make([]string, 0, 13)
 	s = append(s, "&token.Task{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
@@ -681,6 +833,19 @@ func (this *Task) GoString() string {
 	s = append(s, "ActivityId: "+fmt.Sprintf("%#v", this.ActivityId)+",\n")
 	s = append(s, "WorkflowType: "+fmt.Sprintf("%#v", this.WorkflowType)+",\n")
 	s = append(s, "ActivityType: "+fmt.Sprintf("%#v", this.ActivityType)+",\n")
+	keysForTraceContext := make([]string, 0, len(this.TraceContext))
+	for k, _ := range this.TraceContext {
+		keysForTraceContext = append(keysForTraceContext, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForTraceContext)
+	mapStringForTraceContext := "map[string]string{"
+	for _, k := range keysForTraceContext {
+		mapStringForTraceContext += fmt.Sprintf("%#v: %#v,", k, this.TraceContext[k])
+	}
+	mapStringForTraceContext += "}"
+	if this.TraceContext != nil {
+		s = append(s, "TraceContext: "+mapStringForTraceContext+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -863,6 +1028,62 @@ func (m *RawHistoryContinuation) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *HistoryReverseContinuation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoryReverseContinuation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryReverseContinuation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BranchToken) > 0 {
+		i -= len(m.BranchToken)
+		copy(dAtA[i:], m.BranchToken)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.BranchToken)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.NextEventId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.NextEventId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.RunId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.WorkflowId) > 0 {
+		i -= len(m.WorkflowId)
+		copy(dAtA[i:], m.WorkflowId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.WorkflowId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Task) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -883,6 +1104,25 @@ func (m *Task) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TraceContext) > 0 {
+		for k := range m.TraceContext {
+			v := m.TraceContext[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintMessage(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintMessage(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintMessage(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.ActivityType) > 0 {
 		i -= len(m.ActivityType)
 		copy(dAtA[i:], m.ActivityType)
@@ -1068,6 +1308,34 @@ func (m *RawHistoryContinuation) Size() (n int) {
 	return n
 }
 
+func (m *HistoryReverseContinuation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.NextEventId != 0 {
+		n += 1 + sovMessage(uint64(m.NextEventId))
+	}
+	l = len(m.BranchToken)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
 func (m *Task) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1104,6 +1372,14 @@ func (m *Task) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMessage(uint64(l))
 	}
+	if len(m.TraceContext) > 0 {
+		for k, v := range m.TraceContext {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovMessage(uint64(len(k))) + 1 + len(v) + sovMessage(uint64(len(v)))
+			n += mapEntrySize + 1 + sovMessage(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -1168,10 +1444,34 @@ func (this *RawHistoryContinuation) String() string {
 	}, "")
 	return s
 }
+func (this *HistoryReverseContinuation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HistoryReverseContinuation{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
+		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
+		`NextEventId:` + fmt.Sprintf("%v", this.NextEventId) + `,`,
+		`BranchToken:` + fmt.Sprintf("%v", this.BranchToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Task) String() string {
 	if this == nil {
 		return "nil"
 	}
+	keysForTraceContext := make([]string, 0, len(this.TraceContext))
+	for k, _ := range this.TraceContext {
+		keysForTraceContext = append(keysForTraceContext, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForTraceContext)
+	mapStringForTraceContext := "map[string]string{"
+	for _, k := range keysForTraceContext {
+		mapStringForTraceContext += fmt.Sprintf("%v: %v,", k, this.TraceContext[k])
+	}
+	mapStringForTraceContext += "}"
 	s := strings.Join([]string{`&Task{`,
 		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
 		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
@@ -1181,6 +1481,7 @@ func (this *Task) String() string {
 		`ActivityId:` + fmt.Sprintf("%v", this.ActivityId) + `,`,
 		`WorkflowType:` + fmt.Sprintf("%v", this.WorkflowType) + `,`,
 		`ActivityType:` + fmt.Sprintf("%v", this.ActivityType) + `,`,
+		`TraceContext:` + mapStringForTraceContext + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1747,6 +2048,208 @@ func (m *RawHistoryContinuation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *HistoryReverseContinuation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoryReverseContinuation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoryReverseContinuation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
+			}
+			m.NextEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BranchToken = append(m.BranchToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.BranchToken == nil {
+				m.BranchToken = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Task) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2006,6 +2509,133 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 			}
 			m.ActivityType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceContext", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TraceContext == nil {
+				m.TraceContext = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthMessage
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipMessage(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.TraceContext[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -34,15 +34,21 @@ import (
 	math_bits "math/bits"
 	reflect "reflect"
 	strings "strings"
+	time "time"
 
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	v1 "go.temporal.io/api/common/v1"
+	v11 "go.temporal.io/api/failure/v1"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -117,8 +123,159 @@ func (m *ParentExecutionInfo) GetInitiatedId() int64 {
 	return 0
 }
 
+// PendingActivityRetryInfo describes the heartbeat and retry state of a pending activity.
+type PendingActivityRetryInfo struct {
+	ActivityId string `protobuf:"bytes,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	ScheduleId int64  `protobuf:"varint,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Attempt    int32  `protobuf:"varint,3,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	// Zero means unlimited attempts.
+	MaximumAttempts         int32          `protobuf:"varint,4,opt,name=maximum_attempts,json=maximumAttempts,proto3" json:"maximum_attempts,omitempty"`
+	LastHeartbeatTime       *time.Time     `protobuf:"bytes,5,opt,name=last_heartbeat_time,json=lastHeartbeatTime,proto3,stdtime" json:"last_heartbeat_time,omitempty"`
+	HeartbeatDetails        *v1.Payloads   `protobuf:"bytes,6,opt,name=heartbeat_details,json=heartbeatDetails,proto3" json:"heartbeat_details,omitempty"`
+	HeartbeatTimeout        *time.Duration `protobuf:"bytes,7,opt,name=heartbeat_timeout,json=heartbeatTimeout,proto3,stdduration" json:"heartbeat_timeout,omitempty"`
+	LastStartedTime         *time.Time     `protobuf:"bytes,8,opt,name=last_started_time,json=lastStartedTime,proto3,stdtime" json:"last_started_time,omitempty"`
+	LastAttemptCompleteTime *time.Time     `protobuf:"bytes,9,opt,name=last_attempt_complete_time,json=lastAttemptCompleteTime,proto3,stdtime" json:"last_attempt_complete_time,omitempty"`
+	// Set while the activity is backing off before its next attempt.
+	NextAttemptScheduleTime *time.Time     `protobuf:"bytes,10,opt,name=next_attempt_schedule_time,json=nextAttemptScheduleTime,proto3,stdtime" json:"next_attempt_schedule_time,omitempty"`
+	CurrentRetryInterval    *time.Duration `protobuf:"bytes,11,opt,name=current_retry_interval,json=currentRetryInterval,proto3,stdduration" json:"current_retry_interval,omitempty"`
+	ExpirationTime          *time.Time     `protobuf:"bytes,12,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time,omitempty"`
+	LastFailure             *v11.Failure   `protobuf:"bytes,13,opt,name=last_failure,json=lastFailure,proto3" json:"last_failure,omitempty"`
+	LastWorkerIdentity      string         `protobuf:"bytes,14,opt,name=last_worker_identity,json=lastWorkerIdentity,proto3" json:"last_worker_identity,omitempty"`
+}
+
+func (m *PendingActivityRetryInfo) Reset()      { *m = PendingActivityRetryInfo{} }
+func (*PendingActivityRetryInfo) ProtoMessage() {}
+func (*PendingActivityRetryInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4f1ca48d03c9ded, []int{1}
+}
+func (m *PendingActivityRetryInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingActivityRetryInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingActivityRetryInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingActivityRetryInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingActivityRetryInfo.Merge(m, src)
+}
+func (m *PendingActivityRetryInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingActivityRetryInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingActivityRetryInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingActivityRetryInfo proto.InternalMessageInfo
+
+func (m *PendingActivityRetryInfo) GetActivityId() string {
+	if m != nil {
+		return m.ActivityId
+	}
+	return ""
+}
+
+func (m *PendingActivityRetryInfo) GetScheduleId() int64 {
+	if m != nil {
+		return m.ScheduleId
+	}
+	return 0
+}
+
+func (m *PendingActivityRetryInfo) GetAttempt() int32 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
+func (m *PendingActivityRetryInfo) GetMaximumAttempts() int32 {
+	if m != nil {
+		return m.MaximumAttempts
+	}
+	return 0
+}
+
+func (m *PendingActivityRetryInfo) GetLastHeartbeatTime() *time.Time {
+	if m != nil {
+		return m.LastHeartbeatTime
+	}
+	return nil
+}
+
+func (m *PendingActivityRetryInfo) GetHeartbeatDetails() *v1.Payloads {
+	if m != nil {
+		return m.HeartbeatDetails
+	}
+	return nil
+}
+
+func (m *PendingActivityRetryInfo) GetHeartbeatTimeout() *time.Duration {
+	if m != nil {
+		return m.HeartbeatTimeout
+	}
+	return nil
+}
+
+func (m *PendingActivityRetryInfo) GetLastStartedTime() *time.Time {
+	if m != nil {
+		return m.LastStartedTime
+	}
+	return nil
+}
+
+func (m *PendingActivityRetryInfo) GetLastAttemptCompleteTime() *time.Time {
+	if m != nil {
+		return m.LastAttemptCompleteTime
+	}
+	return nil
+}
+
+func (m *PendingActivityRetryInfo) GetNextAttemptScheduleTime() *time.Time {
+	if m != nil {
+		return m.NextAttemptScheduleTime
+	}
+	return nil
+}
+
+func (m *PendingActivityRetryInfo) GetCurrentRetryInterval() *time.Duration {
+	if m != nil {
+		return m.CurrentRetryInterval
+	}
+	return nil
+}
+
+func (m *PendingActivityRetryInfo) GetExpirationTime() *time.Time {
+	if m != nil {
+		return m.ExpirationTime
+	}
+	return nil
+}
+
+func (m *PendingActivityRetryInfo) GetLastFailure() *v11.Failure {
+	if m != nil {
+		return m.LastFailure
+	}
+	return nil
+}
+
+func (m *PendingActivityRetryInfo) GetLastWorkerIdentity() string {
+	if m != nil {
+		return m.LastWorkerIdentity
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ParentExecutionInfo)(nil), "temporal.server.api.workflow.v1.ParentExecutionInfo")
+	proto.RegisterType((*PendingActivityRetryInfo)(nil), "temporal.server.api.workflow.v1.PendingActivityRetryInfo")
 }
 
 func init() {
@@ -126,26 +283,52 @@ func init() {
 }
 
 var fileDescriptor_c4f1ca48d03c9ded = []byte{
-	// 298 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0x31, 0x4e, 0xc3, 0x30,
-	0x18, 0x85, 0xfd, 0x53, 0x84, 0xd4, 0x94, 0x29, 0x2c, 0x15, 0x42, 0x3f, 0x05, 0x31, 0x94, 0x01,
-	0x47, 0x85, 0x91, 0x0d, 0x09, 0xa1, 0x6c, 0x28, 0x0b, 0x12, 0x0b, 0x32, 0x89, 0x5b, 0x59, 0x34,
-	0x76, 0xe4, 0x98, 0x94, 0x91, 0x23, 0x70, 0x0c, 0xce, 0xc1, 0xc4, 0x98, 0xb1, 0x23, 0x71, 0x16,
-	0xc6, 0x1e, 0x01, 0xa5, 0x25, 0xce, 0x80, 0xd8, 0xec, 0xe7, 0xe7, 0x4f, 0x9f, 0x9e, 0x77, 0x66,
-	0x78, 0x9a, 0x29, 0xcd, 0xe6, 0x41, 0xce, 0x75, 0xc1, 0x75, 0xc0, 0x32, 0x11, 0x2c, 0x94, 0x7e,
-	0x9a, 0xce, 0xd5, 0x22, 0x28, 0x26, 0x41, 0xca, 0xf3, 0x9c, 0xcd, 0x38, 0xcd, 0xb4, 0x32, 0xca,
-	0x3f, 0x6c, 0xeb, 0x74, 0x53, 0xa7, 0x2c, 0x13, 0xb4, 0xad, 0xd3, 0x62, 0xb2, 0x7f, 0xe2, 0x78,
-	0x0d, 0x28, 0x56, 0x69, 0xaa, 0xe4, 0x1f, 0xcc, 0xf1, 0x07, 0x78, 0x7b, 0xb7, 0x4c, 0x73, 0x69,
-	0xae, 0x5f, 0x78, 0xfc, 0x6c, 0x84, 0x92, 0xa1, 0x9c, 0x2a, 0xff, 0xc8, 0xdb, 0x95, 0x2c, 0xe5,
-	0x79, 0xc6, 0x62, 0xfe, 0x20, 0x92, 0x21, 0x8c, 0x60, 0xdc, 0x8f, 0x06, 0x2e, 0x0b, 0x13, 0xff,
-	0xc0, 0xeb, 0xbb, 0xeb, 0x70, 0x6b, 0xfd, 0xde, 0x05, 0xfe, 0x8d, 0xd7, 0xe7, 0x2d, 0x71, 0xd8,
-	0x1b, 0xc1, 0x78, 0x70, 0x7e, 0x4a, 0x9d, 0x73, 0x23, 0xbb, 0x51, 0xa2, 0xc5, 0x84, 0xde, 0xfd,
-	0x6a, 0x3b, 0x85, 0xa8, 0xfb, 0xdb, 0x98, 0x08, 0x29, 0x8c, 0x60, 0x86, 0x27, 0x8d, 0xc9, 0xf6,
-	0x08, 0xc6, 0xbd, 0x68, 0xe0, 0xb2, 0x30, 0xb9, 0x4a, 0xca, 0x0a, 0xc9, 0xb2, 0x42, 0xb2, 0xaa,
-	0x10, 0x5e, 0x2d, 0xc2, 0xbb, 0x45, 0xf8, 0xb4, 0x08, 0xa5, 0x45, 0xf8, 0xb2, 0x08, 0xdf, 0x16,
-	0xc9, 0xca, 0x22, 0xbc, 0xd5, 0x48, 0xca, 0x1a, 0xc9, 0xb2, 0x46, 0x72, 0x4f, 0x67, 0xaa, 0x13,
-	0x12, 0xea, 0x9f, 0xd9, 0x2f, 0xdb, 0xf3, 0xe3, 0xce, 0x7a, 0xb1, 0x8b, 0x9f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xd4, 0x7b, 0x8c, 0xc8, 0xa9, 0x01, 0x00, 0x00,
+	// 720 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcb, 0x6e, 0xd3, 0x4c,
+	0x14, 0xc7, 0xe3, 0x5e, 0xbf, 0x4c, 0xfa, 0xf5, 0xe2, 0x56, 0x60, 0x22, 0xe4, 0xa4, 0x15, 0x48,
+	0xed, 0x02, 0x9b, 0xc2, 0x92, 0x55, 0x2f, 0x5c, 0x22, 0x81, 0x14, 0xb9, 0xa0, 0x4a, 0x48, 0xc8,
+	0x9a, 0x7a, 0x4e, 0xd2, 0x11, 0xb6, 0xc7, 0x1a, 0x8f, 0xd3, 0x64, 0xc7, 0x23, 0x74, 0xc9, 0x23,
+	0xf0, 0x02, 0xbc, 0x00, 0x2b, 0x96, 0x5d, 0x76, 0x07, 0x4d, 0x37, 0x2c, 0xfb, 0x08, 0x68, 0xc6,
+	0x63, 0x9b, 0x52, 0x2a, 0x65, 0xe7, 0x39, 0xe7, 0xfc, 0x7f, 0xfa, 0x9f, 0x33, 0xc7, 0x83, 0x1e,
+	0x09, 0x88, 0x12, 0xc6, 0x71, 0xe8, 0xa6, 0xc0, 0x07, 0xc0, 0x5d, 0x9c, 0x50, 0xf7, 0x84, 0xf1,
+	0x8f, 0xbd, 0x90, 0x9d, 0xb8, 0x83, 0x6d, 0x37, 0x82, 0x34, 0xc5, 0x7d, 0x70, 0x12, 0xce, 0x04,
+	0x33, 0x5b, 0x45, 0xb9, 0x93, 0x97, 0x3b, 0x38, 0xa1, 0x4e, 0x51, 0xee, 0x0c, 0xb6, 0x9b, 0x76,
+	0x9f, 0xb1, 0x7e, 0x08, 0xae, 0x2a, 0x3f, 0xca, 0x7a, 0x2e, 0xc9, 0x38, 0x16, 0x94, 0xc5, 0x39,
+	0xa0, 0xd9, 0xfa, 0x3b, 0x2f, 0x68, 0x04, 0xa9, 0xc0, 0x51, 0xa2, 0x0b, 0xd6, 0x09, 0x24, 0x10,
+	0x13, 0x88, 0x03, 0x0a, 0xa9, 0xdb, 0x67, 0x7d, 0xa6, 0xe2, 0xea, 0x4b, 0x97, 0x3c, 0x28, 0x3d,
+	0x4b, 0xb3, 0x01, 0x8b, 0x22, 0x16, 0xdf, 0xb0, 0xda, 0x7c, 0x78, 0xad, 0xaa, 0x87, 0x69, 0x98,
+	0x71, 0xb8, 0x51, 0xb6, 0xf1, 0xcd, 0x40, 0xab, 0x5d, 0xcc, 0x21, 0x16, 0xcf, 0x87, 0x10, 0x64,
+	0xd2, 0x6a, 0x27, 0xee, 0x31, 0x73, 0x1d, 0x2d, 0xc4, 0x38, 0x82, 0x34, 0xc1, 0x01, 0xf8, 0x94,
+	0x58, 0x46, 0xdb, 0xd8, 0xac, 0x7b, 0x8d, 0x32, 0xd6, 0x21, 0xe6, 0x7d, 0x54, 0x2f, 0x8f, 0xd6,
+	0x94, 0xca, 0x57, 0x01, 0xf3, 0x25, 0xaa, 0x43, 0x41, 0xb4, 0xa6, 0xdb, 0xc6, 0x66, 0xe3, 0xc9,
+	0x96, 0x53, 0x8e, 0x4f, 0xce, 0x2d, 0x77, 0xee, 0x0c, 0xb6, 0x9d, 0x43, 0x3d, 0xc1, 0xd2, 0x82,
+	0x57, 0x69, 0xa5, 0x13, 0x1a, 0x53, 0x41, 0xb1, 0x00, 0x22, 0x9d, 0xcc, 0xb4, 0x8d, 0xcd, 0x69,
+	0xaf, 0x51, 0xc6, 0x3a, 0x64, 0xe3, 0xeb, 0x3c, 0xb2, 0xba, 0x10, 0x13, 0x1a, 0xf7, 0x77, 0x02,
+	0x41, 0x07, 0x54, 0x8c, 0x3c, 0x10, 0x7c, 0xa4, 0x3a, 0x69, 0xa1, 0x06, 0xd6, 0xc1, 0xaa, 0x11,
+	0x54, 0x84, 0x3a, 0x44, 0x16, 0xa4, 0xc1, 0x31, 0x90, 0x2c, 0x54, 0x9d, 0x4e, 0x29, 0x3e, 0x2a,
+	0x42, 0x1d, 0x62, 0x5a, 0x68, 0x1e, 0x0b, 0x69, 0x5d, 0xa8, 0x46, 0x66, 0xbd, 0xe2, 0x68, 0x6e,
+	0xa1, 0xe5, 0x08, 0x0f, 0x69, 0x94, 0x45, 0xbe, 0x0e, 0xa5, 0xca, 0xdf, 0xac, 0xb7, 0xa4, 0xe3,
+	0x3b, 0x3a, 0x6c, 0x76, 0xd1, 0x6a, 0x88, 0x53, 0xe1, 0x1f, 0x03, 0xe6, 0xe2, 0x08, 0xb0, 0xf0,
+	0xe5, 0xd5, 0x5b, 0xb3, 0x6a, 0x32, 0x4d, 0x27, 0xdf, 0x0b, 0xa7, 0xd8, 0x0b, 0xe7, 0x6d, 0xb1,
+	0x17, 0xbb, 0x33, 0xa7, 0x3f, 0x5a, 0x86, 0xb7, 0x22, 0xc5, 0xaf, 0x0a, 0xad, 0xcc, 0x9a, 0x6f,
+	0xd0, 0x4a, 0x05, 0x23, 0x20, 0x30, 0x0d, 0x53, 0x6b, 0x4e, 0xf1, 0xda, 0xb7, 0x4d, 0xba, 0x8b,
+	0x47, 0x21, 0xc3, 0x24, 0xf5, 0x96, 0x4b, 0xe9, 0x7e, 0xae, 0x34, 0x5f, 0xa3, 0x95, 0xeb, 0xde,
+	0x58, 0x26, 0xac, 0x79, 0x85, 0xbb, 0x77, 0xc3, 0xde, 0xbe, 0x5e, 0xeb, 0xdd, 0x99, 0xcf, 0xd2,
+	0xdd, 0xf2, 0xf1, 0x9f, 0xce, 0x58, 0x26, 0x24, 0x4d, 0xb5, 0x9b, 0x0a, 0xcc, 0xe5, 0xc5, 0xa9,
+	0x66, 0xff, 0x9b, 0xb0, 0xd9, 0x25, 0x29, 0x3d, 0xc8, 0x95, 0xaa, 0xd5, 0x0f, 0xa8, 0xa9, 0x68,
+	0x7a, 0xc8, 0x7e, 0xc0, 0xa2, 0x24, 0x04, 0x01, 0x39, 0xb6, 0x3e, 0x21, 0xf6, 0xae, 0x64, 0xe8,
+	0x0b, 0xd9, 0xd3, 0x84, 0x02, 0x1f, 0xc3, 0xb0, 0xc2, 0x97, 0xeb, 0xa0, 0xf0, 0x68, 0x52, 0xbc,
+	0x64, 0x68, 0xfc, 0x81, 0x26, 0x28, 0xfc, 0x3b, 0x74, 0x27, 0xc8, 0xb8, 0xfc, 0xc7, 0x7c, 0x2e,
+	0xd7, 0xd2, 0xa7, 0xb1, 0x00, 0x3e, 0xc0, 0xa1, 0xd5, 0x98, 0x6c, 0xbc, 0x6b, 0x5a, 0xae, 0x97,
+	0x3a, 0x17, 0x9b, 0x1d, 0xb4, 0x04, 0xc3, 0x84, 0xe6, 0x95, 0xb9, 0xd5, 0x85, 0x09, 0xad, 0x2e,
+	0x56, 0x42, 0xe5, 0x70, 0x0f, 0x2d, 0xa8, 0xf9, 0xea, 0x67, 0xc2, 0xfa, 0xff, 0x5f, 0x5b, 0xa4,
+	0x93, 0x72, 0x8d, 0x5e, 0xe4, 0x9f, 0x5e, 0x43, 0xaa, 0xf4, 0xc1, 0x7c, 0x8c, 0xd6, 0x14, 0x44,
+	0xbe, 0x87, 0xc0, 0x7d, 0x4a, 0x20, 0x16, 0x54, 0x8c, 0xac, 0x45, 0xf5, 0xc7, 0x99, 0x32, 0x77,
+	0xa8, 0x52, 0x1d, 0x9d, 0xd9, 0x25, 0x67, 0x17, 0x76, 0xed, 0xfc, 0xc2, 0xae, 0x5d, 0x5d, 0xd8,
+	0xc6, 0xa7, 0xb1, 0x6d, 0x7c, 0x19, 0xdb, 0xc6, 0xf7, 0xb1, 0x6d, 0x9c, 0x8d, 0x6d, 0xe3, 0xe7,
+	0xd8, 0x36, 0x7e, 0x8d, 0xed, 0xda, 0xd5, 0xd8, 0x36, 0x4e, 0x2f, 0xed, 0xda, 0xd9, 0xa5, 0x5d,
+	0x3b, 0xbf, 0xb4, 0x6b, 0xef, 0x9d, 0x3e, 0xab, 0x8c, 0x51, 0x76, 0xcb, 0xcb, 0xfd, 0xac, 0xf8,
+	0x3e, 0x9a, 0x53, 0x63, 0x78, 0xfa, 0x7b, 0x00, 0x57, 0xa8, 0x6b, 0xa4, 0xec, 0x05, 0x00, 0x00,
 }
 
 func (this *ParentExecutionInfo) Equal(that interface{}) bool {
@@ -181,6 +364,101 @@ func (this *ParentExecutionInfo) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *PendingActivityRetryInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PendingActivityRetryInfo)
+	if !ok {
+		that2, ok := that.(PendingActivityRetryInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ActivityId != that1.ActivityId {
+		return false
+	}
+	if this.ScheduleId != that1.ScheduleId {
+		return false
+	}
+	if this.Attempt != that1.Attempt {
+		return false
+	}
+	if this.MaximumAttempts != that1.MaximumAttempts {
+		return false
+	}
+	if that1.LastHeartbeatTime == nil {
+		if this.LastHeartbeatTime != nil {
+			return false
+		}
+	} else if !this.LastHeartbeatTime.Equal(*that1.LastHeartbeatTime) {
+		return false
+	}
+	if !this.HeartbeatDetails.Equal(that1.HeartbeatDetails) {
+		return false
+	}
+	if this.HeartbeatTimeout != nil && that1.HeartbeatTimeout != nil {
+		if *this.HeartbeatTimeout != *that1.HeartbeatTimeout {
+			return false
+		}
+	} else if this.HeartbeatTimeout != nil {
+		return false
+	} else if that1.HeartbeatTimeout != nil {
+		return false
+	}
+	if that1.LastStartedTime == nil {
+		if this.LastStartedTime != nil {
+			return false
+		}
+	} else if !this.LastStartedTime.Equal(*that1.LastStartedTime) {
+		return false
+	}
+	if that1.LastAttemptCompleteTime == nil {
+		if this.LastAttemptCompleteTime != nil {
+			return false
+		}
+	} else if !this.LastAttemptCompleteTime.Equal(*that1.LastAttemptCompleteTime) {
+		return false
+	}
+	if that1.NextAttemptScheduleTime == nil {
+		if this.NextAttemptScheduleTime != nil {
+			return false
+		}
+	} else if !this.NextAttemptScheduleTime.Equal(*that1.NextAttemptScheduleTime) {
+		return false
+	}
+	if this.CurrentRetryInterval != nil && that1.CurrentRetryInterval != nil {
+		if *this.CurrentRetryInterval != *that1.CurrentRetryInterval {
+			return false
+		}
+	} else if this.CurrentRetryInterval != nil {
+		return false
+	} else if that1.CurrentRetryInterval != nil {
+		return false
+	}
+	if that1.ExpirationTime == nil {
+		if this.ExpirationTime != nil {
+			return false
+		}
+	} else if !this.ExpirationTime.Equal(*that1.ExpirationTime) {
+		return false
+	}
+	if !this.LastFailure.Equal(that1.LastFailure) {
+		return false
+	}
+	if this.LastWorkerIdentity != that1.LastWorkerIdentity {
+		return false
+	}
+	return true
+}
 func (this *ParentExecutionInfo) GoString() string {
 	if this == nil {
 		return "nil"
@@ -196,6 +474,33 @@ func (this *ParentExecutionInfo) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *PendingActivityRetryInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 18)
+	s = append(s, "&workflow.PendingActivityRetryInfo{")
+	s = append(s, "ActivityId: "+fmt.Sprintf("%#v", this.ActivityId)+",\n")
+	s = append(s, "ScheduleId: "+fmt.Sprintf("%#v", this.ScheduleId)+",\n")
+	s = append(s, "Attempt: "+fmt.Sprintf("%#v", this.Attempt)+",\n")
+	s = append(s, "MaximumAttempts: "+fmt.Sprintf("%#v", this.MaximumAttempts)+",\n")
+	s = append(s, "LastHeartbeatTime: "+fmt.Sprintf("%#v", this.LastHeartbeatTime)+",\n")
+	if this.HeartbeatDetails != nil {
+		s = append(s, "HeartbeatDetails: "+fmt.Sprintf("%#v", this.HeartbeatDetails)+",\n")
+	}
+	s = append(s, "HeartbeatTimeout: "+fmt.Sprintf("%#v", this.HeartbeatTimeout)+",\n")
+	s = append(s, "LastStartedTime: "+fmt.Sprintf("%#v", this.LastStartedTime)+",\n")
+	s = append(s, "LastAttemptCompleteTime: "+fmt.Sprintf("%#v", this.LastAttemptCompleteTime)+",\n")
+	s = append(s, "NextAttemptScheduleTime: "+fmt.Sprintf("%#v", this.NextAttemptScheduleTime)+",\n")
+	s = append(s, "CurrentRetryInterval: "+fmt.Sprintf("%#v", this.CurrentRetryInterval)+",\n")
+	s = append(s, "ExpirationTime: "+fmt.Sprintf("%#v", this.ExpirationTime)+",\n")
+	if this.LastFailure != nil {
+		s = append(s, "LastFailure: "+fmt.Sprintf("%#v", this.LastFailure)+",\n")
+	}
+	s = append(s, "LastWorkerIdentity: "+fmt.Sprintf("%#v", this.LastWorkerIdentity)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringMessage(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -258,6 +563,152 @@ func (m *ParentExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PendingActivityRetryInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingActivityRetryInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingActivityRetryInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LastWorkerIdentity) > 0 {
+		i -= len(m.LastWorkerIdentity)
+		copy(dAtA[i:], m.LastWorkerIdentity)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.LastWorkerIdentity)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.LastFailure != nil {
+		{
+			size, err := m.LastFailure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.ExpirationTime != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpirationTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintMessage(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.CurrentRetryInterval != nil {
+		n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.CurrentRetryInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.CurrentRetryInterval):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintMessage(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.NextAttemptScheduleTime != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.NextAttemptScheduleTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.NextAttemptScheduleTime):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintMessage(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.LastAttemptCompleteTime != nil {
+		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastAttemptCompleteTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastAttemptCompleteTime):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintMessage(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.LastStartedTime != nil {
+		n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastStartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastStartedTime):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintMessage(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.HeartbeatTimeout != nil {
+		n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.HeartbeatTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.HeartbeatTimeout):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintMessage(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.HeartbeatDetails != nil {
+		{
+			size, err := m.HeartbeatDetails.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.LastHeartbeatTime != nil {
+		n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatTime):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintMessage(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MaximumAttempts != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.MaximumAttempts))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ScheduleId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.ScheduleId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ActivityId) > 0 {
+		i -= len(m.ActivityId)
+		copy(dAtA[i:], m.ActivityId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.ActivityId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMessage(v)
 	base := offset
@@ -293,29 +744,114 @@ func (m *ParentExecutionInfo) Size() (n int) {
 	return n
 }
 
-func sovMessage(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMessage(x uint64) (n int) {
-	return sovMessage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *ParentExecutionInfo) String() string {
-	if this == nil {
-		return "nil"
+func (m *PendingActivityRetryInfo) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ParentExecutionInfo{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
-		`InitiatedId:` + fmt.Sprintf("%v", this.InitiatedId) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringMessage(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.ActivityId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.ScheduleId != 0 {
+		n += 1 + sovMessage(uint64(m.ScheduleId))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovMessage(uint64(m.Attempt))
+	}
+	if m.MaximumAttempts != 0 {
+		n += 1 + sovMessage(uint64(m.MaximumAttempts))
+	}
+	if m.LastHeartbeatTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.HeartbeatDetails != nil {
+		l = m.HeartbeatDetails.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.HeartbeatTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.HeartbeatTimeout)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.LastStartedTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastStartedTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.LastAttemptCompleteTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastAttemptCompleteTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.NextAttemptScheduleTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.NextAttemptScheduleTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.CurrentRetryInterval != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.CurrentRetryInterval)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.ExpirationTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpirationTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.LastFailure != nil {
+		l = m.LastFailure.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.LastWorkerIdentity)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func sovMessage(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMessage(x uint64) (n int) {
+	return sovMessage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ParentExecutionInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ParentExecutionInfo{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
+		`InitiatedId:` + fmt.Sprintf("%v", this.InitiatedId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PendingActivityRetryInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PendingActivityRetryInfo{`,
+		`ActivityId:` + fmt.Sprintf("%v", this.ActivityId) + `,`,
+		`ScheduleId:` + fmt.Sprintf("%v", this.ScheduleId) + `,`,
+		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
+		`MaximumAttempts:` + fmt.Sprintf("%v", this.MaximumAttempts) + `,`,
+		`LastHeartbeatTime:` + strings.Replace(fmt.Sprintf("%v", this.LastHeartbeatTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`HeartbeatDetails:` + strings.Replace(fmt.Sprintf("%v", this.HeartbeatDetails), "Payloads", "v1.Payloads", 1) + `,`,
+		`HeartbeatTimeout:` + strings.Replace(fmt.Sprintf("%v", this.HeartbeatTimeout), "Duration", "types.Duration", 1) + `,`,
+		`LastStartedTime:` + strings.Replace(fmt.Sprintf("%v", this.LastStartedTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`LastAttemptCompleteTime:` + strings.Replace(fmt.Sprintf("%v", this.LastAttemptCompleteTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`NextAttemptScheduleTime:` + strings.Replace(fmt.Sprintf("%v", this.NextAttemptScheduleTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`CurrentRetryInterval:` + strings.Replace(fmt.Sprintf("%v", this.CurrentRetryInterval), "Duration", "types.Duration", 1) + `,`,
+		`ExpirationTime:` + strings.Replace(fmt.Sprintf("%v", this.ExpirationTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`LastFailure:` + strings.Replace(fmt.Sprintf("%v", this.LastFailure), "Failure", "v11.Failure", 1) + `,`,
+		`LastWorkerIdentity:` + fmt.Sprintf("%v", this.LastWorkerIdentity) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringMessage(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
 	}
 	pv := reflect.Indirect(rv).Interface()
 	return fmt.Sprintf("*%v", pv)
@@ -492,6 +1028,504 @@ func (m *ParentExecutionInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PendingActivityRetryInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingActivityRetryInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingActivityRetryInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			m.ScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduleId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumAttempts", wireType)
+			}
+			m.MaximumAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaximumAttempts |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeartbeatTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastHeartbeatTime == nil {
+				m.LastHeartbeatTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastHeartbeatTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatDetails", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HeartbeatDetails == nil {
+				m.HeartbeatDetails = &v1.Payloads{}
+			}
+			if err := m.HeartbeatDetails.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HeartbeatTimeout == nil {
+				m.HeartbeatTimeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.HeartbeatTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastStartedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastStartedTime == nil {
+				m.LastStartedTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastStartedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAttemptCompleteTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastAttemptCompleteTime == nil {
+				m.LastAttemptCompleteTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastAttemptCompleteTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextAttemptScheduleTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextAttemptScheduleTime == nil {
+				m.NextAttemptScheduleTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.NextAttemptScheduleTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentRetryInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CurrentRetryInterval == nil {
+				m.CurrentRetryInterval = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.CurrentRetryInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationTime == nil {
+				m.ExpirationTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFailure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastFailure == nil {
+				m.LastFailure = &v11.Failure{}
+			}
+			if err := m.LastFailure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastWorkerIdentity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastWorkerIdentity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMessage(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -175,6 +175,20 @@ func (c *clientImpl) GetWorkflowExecutionRawHistoryV2(
 	return client.GetWorkflowExecutionRawHistoryV2(ctx, request, opts...)
 }
 
+func (c *clientImpl) GetWorkflowExecutionHistoryReverse(
+	ctx context.Context,
+	request *adminservice.GetWorkflowExecutionHistoryReverseRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetWorkflowExecutionHistoryReverseResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.GetWorkflowExecutionHistoryReverse(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeCluster(
 	ctx context.Context,
 	request *adminservice.DescribeClusterRequest,
@@ -203,6 +217,31 @@ func (c *clientImpl) GetReplicationMessages(
 	return client.GetReplicationMessages(ctx, request, opts...)
 }
 
+func (c *clientImpl) StreamReplicationMessages(
+	ctx context.Context,
+	opts ...grpc.CallOption,
+) (adminservice.AdminService_StreamReplicationMessagesClient, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	// stream is long lived and bound to the caller context, no timeout is applied
+	return client.StreamReplicationMessages(ctx, opts...)
+}
+
+func (c *clientImpl) StreamWorkflowExecutionHistory(
+	ctx context.Context,
+	request *adminservice.StreamWorkflowExecutionHistoryRequest,
+	opts ...grpc.CallOption,
+) (adminservice.AdminService_StreamWorkflowExecutionHistoryClient, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	// stream is long lived and bound to the caller context, no timeout is applied
+	return client.StreamWorkflowExecutionHistory(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetNamespaceReplicationMessages(
 	ctx context.Context,
 	request *adminservice.GetNamespaceReplicationMessagesRequest,
@@ -316,6 +355,496 @@ func (c *clientImpl) ResendReplicationTasks(
 	return client.ResendReplicationTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) ExecuteMultiOperation(
+	ctx context.Context,
+	request *adminservice.ExecuteMultiOperationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ExecuteMultiOperationResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ExecuteMultiOperation(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListNamespaceFailoverHistory(
+	ctx context.Context,
+	request *adminservice.ListNamespaceFailoverHistoryRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListNamespaceFailoverHistoryResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ListNamespaceFailoverHistory(ctx, request, opts...)
+}
+
+func (c *clientImpl) HandoverNamespace(
+	ctx context.Context,
+	request *adminservice.HandoverNamespaceRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.HandoverNamespaceResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContextWithLargeTimeout(ctx)
+	defer cancel()
+	return client.HandoverNamespace(ctx, request, opts...)
+}
+
+func (c *clientImpl) RecordWorkflowTaskHeartbeat(
+	ctx context.Context,
+	request *adminservice.RecordWorkflowTaskHeartbeatRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RecordWorkflowTaskHeartbeatResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.RecordWorkflowTaskHeartbeat(ctx, request, opts...)
+}
+
+func (c *clientImpl) GetReplicationLag(
+	ctx context.Context,
+	request *adminservice.GetReplicationLagRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetReplicationLagResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContextWithLargeTimeout(ctx)
+	defer cancel()
+	return client.GetReplicationLag(ctx, request, opts...)
+}
+
+func (c *clientImpl) CompactWorkflowHistory(
+	ctx context.Context,
+	request *adminservice.CompactWorkflowHistoryRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CompactWorkflowHistoryResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContextWithLargeTimeout(ctx)
+	defer cancel()
+	return client.CompactWorkflowHistory(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListStaleWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.ListStaleWorkflowExecutionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListStaleWorkflowExecutionsResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContextWithLargeTimeout(ctx)
+	defer cancel()
+	return client.ListStaleWorkflowExecutions(ctx, request, opts...)
+}
+
+func (c *clientImpl) GetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetDynamicConfigResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.GetDynamicConfig(ctx, request, opts...)
+}
+
+func (c *clientImpl) SetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.SetDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetDynamicConfigResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.SetDynamicConfig(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListDynamicConfig(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListDynamicConfigResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ListDynamicConfig(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListThrottledCallers(
+	ctx context.Context,
+	request *adminservice.ListThrottledCallersRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListThrottledCallersResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ListThrottledCallers(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeTaskQueuePartitions(
+	ctx context.Context,
+	request *adminservice.DescribeTaskQueuePartitionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeTaskQueuePartitionsResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DescribeTaskQueuePartitions(ctx, request, opts...)
+}
+
+func (c *clientImpl) SetMaintenanceInfo(
+	ctx context.Context,
+	request *adminservice.SetMaintenanceInfoRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetMaintenanceInfoResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.SetMaintenanceInfo(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateWorkerBuildIdCompatibility(
+	ctx context.Context,
+	request *adminservice.UpdateWorkerBuildIdCompatibilityRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateWorkerBuildIdCompatibilityResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UpdateWorkerBuildIdCompatibility(ctx, request, opts...)
+}
+
+func (c *clientImpl) GetWorkerBuildIdCompatibility(
+	ctx context.Context,
+	request *adminservice.GetWorkerBuildIdCompatibilityRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetWorkerBuildIdCompatibilityResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.GetWorkerBuildIdCompatibility(ctx, request, opts...)
+}
+
+func (c *clientImpl) ShutdownWorker(
+	ctx context.Context,
+	request *adminservice.ShutdownWorkerRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ShutdownWorkerResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ShutdownWorker(ctx, request, opts...)
+}
+
+func (c *clientImpl) ImportWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ImportWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ImportWorkflowExecutionResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ImportWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListSchedules(
+	ctx context.Context,
+	request *adminservice.ListSchedulesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListSchedulesResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ListSchedules(ctx, request, opts...)
+}
+
+func (c *clientImpl) StartResetBadBinaryJob(
+	ctx context.Context,
+	request *adminservice.StartResetBadBinaryJobRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartResetBadBinaryJobResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.StartResetBadBinaryJob(ctx, request, opts...)
+}
+
+func (c *clientImpl) GetResetPoints(
+	ctx context.Context,
+	request *adminservice.GetResetPointsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetResetPointsResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.GetResetPoints(ctx, request, opts...)
+}
+
+func (c *clientImpl) RebalanceShards(
+	ctx context.Context,
+	request *adminservice.RebalanceShardsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RebalanceShardsResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.RebalanceShards(ctx, request, opts...)
+}
+
+func (c *clientImpl) GetShardStats(
+	ctx context.Context,
+	request *adminservice.GetShardStatsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetShardStatsResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.GetShardStats(ctx, request, opts...)
+}
+
+func (c *clientImpl) GetHostProfile(
+	ctx context.Context,
+	request *adminservice.GetHostProfileRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetHostProfileResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContextWithLargeTimeout(ctx)
+	defer cancel()
+	return client.GetHostProfile(ctx, request, opts...)
+}
+
+func (c *clientImpl) DeepHealthCheck(
+	ctx context.Context,
+	request *adminservice.DeepHealthCheckRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeepHealthCheckResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DeepHealthCheck(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeClusterMetadata(
+	ctx context.Context,
+	request *adminservice.DescribeClusterMetadataRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeClusterMetadataResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DescribeClusterMetadata(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateClusterMetadata(
+	ctx context.Context,
+	request *adminservice.UpdateClusterMetadataRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateClusterMetadataResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UpdateClusterMetadata(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeVisibilityProcessor(
+	ctx context.Context,
+	request *adminservice.DescribeVisibilityProcessorRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeVisibilityProcessorResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DescribeVisibilityProcessor(ctx, request, opts...)
+}
+
+func (c *clientImpl) DeleteNamespace(
+	ctx context.Context,
+	request *adminservice.DeleteNamespaceRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteNamespaceResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DeleteNamespace(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeNamespaceQuotas(
+	ctx context.Context,
+	request *adminservice.DescribeNamespaceQuotasRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeNamespaceQuotasResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DescribeNamespaceQuotas(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateNamespaceQuotas(
+	ctx context.Context,
+	request *adminservice.UpdateNamespaceQuotasRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateNamespaceQuotasResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UpdateNamespaceQuotas(ctx, request, opts...)
+}
+
+func (c *clientImpl) DeleteSchedule(
+	ctx context.Context,
+	request *adminservice.DeleteScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteScheduleResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DeleteSchedule(ctx, request, opts...)
+}
+
+func (c *clientImpl) PatchSchedule(
+	ctx context.Context,
+	request *adminservice.PatchScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PatchScheduleResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.PatchSchedule(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateSchedule(
+	ctx context.Context,
+	request *adminservice.UpdateScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateScheduleResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UpdateSchedule(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeSchedule(
+	ctx context.Context,
+	request *adminservice.DescribeScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeScheduleResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DescribeSchedule(ctx, request, opts...)
+}
+
+func (c *clientImpl) CreateSchedule(
+	ctx context.Context,
+	request *adminservice.CreateScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CreateScheduleResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.CreateSchedule(ctx, request, opts...)
+}
+
 func (c *clientImpl) createContext(parent context.Context) (context.Context, context.CancelFunc) {
 	return context.WithTimeout(parent, c.timeout)
 }
@@ -192,6 +192,24 @@ func (c *metricClient) GetWorkflowExecutionRawHistoryV2(
 	return resp, err
 }
 
+func (c *metricClient) GetWorkflowExecutionHistoryReverse(
+	ctx context.Context,
+	request *adminservice.GetWorkflowExecutionHistoryReverseRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetWorkflowExecutionHistoryReverseResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientGetWorkflowExecutionHistoryReverseScope, metrics.ClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientGetWorkflowExecutionHistoryReverseScope, metrics.ClientLatency)
+	resp, err := c.client.GetWorkflowExecutionHistoryReverse(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientGetWorkflowExecutionHistoryReverseScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
 func (c *metricClient) DescribeCluster(
 	ctx context.Context,
 	request *adminservice.DescribeClusterRequest,
@@ -227,6 +245,35 @@ func (c *metricClient) GetReplicationMessages(
 	return resp, err
 }
 
+func (c *metricClient) StreamReplicationMessages(
+	ctx context.Context,
+	opts ...grpc.CallOption,
+) (adminservice.AdminService_StreamReplicationMessagesClient, error) {
+	c.metricsClient.IncCounter(metrics.AdminClientStreamReplicationMessagesScope, metrics.ClientRequests)
+
+	stream, err := c.client.StreamReplicationMessages(ctx, opts...)
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientStreamReplicationMessagesScope, metrics.ClientFailures)
+	}
+	return stream, err
+}
+
+func (c *metricClient) StreamWorkflowExecutionHistory(
+	ctx context.Context,
+	request *adminservice.StreamWorkflowExecutionHistoryRequest,
+	opts ...grpc.CallOption,
+) (adminservice.AdminService_StreamWorkflowExecutionHistoryClient, error) {
+	c.metricsClient.IncCounter(metrics.AdminClientStreamWorkflowExecutionHistoryScope, metrics.ClientRequests)
+
+	stream, err := c.client.StreamWorkflowExecutionHistory(ctx, request, opts...)
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientStreamWorkflowExecutionHistoryScope, metrics.ClientFailures)
+	}
+	return stream, err
+}
+
 func (c *metricClient) GetNamespaceReplicationMessages(
 	ctx context.Context,
 	request *adminservice.GetNamespaceReplicationMessagesRequest,
@@ -362,3 +409,598 @@ func (c *metricClient) ResendReplicationTasks(
 	}
 	return resp, err
 }
+
+func (c *metricClient) ExecuteMultiOperation(
+	ctx context.Context,
+	request *adminservice.ExecuteMultiOperationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ExecuteMultiOperationResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientExecuteMultiOperationScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientExecuteMultiOperationScope, metrics.ClientLatency)
+	resp, err := c.client.ExecuteMultiOperation(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientExecuteMultiOperationScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ListNamespaceFailoverHistory(
+	ctx context.Context,
+	request *adminservice.ListNamespaceFailoverHistoryRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListNamespaceFailoverHistoryResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientListNamespaceFailoverHistoryScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientListNamespaceFailoverHistoryScope, metrics.ClientLatency)
+	resp, err := c.client.ListNamespaceFailoverHistory(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientListNamespaceFailoverHistoryScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) HandoverNamespace(
+	ctx context.Context,
+	request *adminservice.HandoverNamespaceRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.HandoverNamespaceResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientHandoverNamespaceScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientHandoverNamespaceScope, metrics.ClientLatency)
+	resp, err := c.client.HandoverNamespace(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientHandoverNamespaceScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) RecordWorkflowTaskHeartbeat(
+	ctx context.Context,
+	request *adminservice.RecordWorkflowTaskHeartbeatRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RecordWorkflowTaskHeartbeatResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientRecordWorkflowTaskHeartbeatScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientRecordWorkflowTaskHeartbeatScope, metrics.ClientLatency)
+	resp, err := c.client.RecordWorkflowTaskHeartbeat(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientRecordWorkflowTaskHeartbeatScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) GetReplicationLag(
+	ctx context.Context,
+	request *adminservice.GetReplicationLagRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetReplicationLagResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientGetReplicationLagScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientGetReplicationLagScope, metrics.ClientLatency)
+	resp, err := c.client.GetReplicationLag(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientGetReplicationLagScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) CompactWorkflowHistory(
+	ctx context.Context,
+	request *adminservice.CompactWorkflowHistoryRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CompactWorkflowHistoryResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientCompactWorkflowHistoryScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientCompactWorkflowHistoryScope, metrics.ClientLatency)
+	resp, err := c.client.CompactWorkflowHistory(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientCompactWorkflowHistoryScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ListStaleWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.ListStaleWorkflowExecutionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListStaleWorkflowExecutionsResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientListStaleWorkflowExecutionsScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientListStaleWorkflowExecutionsScope, metrics.ClientLatency)
+	resp, err := c.client.ListStaleWorkflowExecutions(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientListStaleWorkflowExecutionsScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) GetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetDynamicConfigResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientGetDynamicConfigScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientGetDynamicConfigScope, metrics.ClientLatency)
+	resp, err := c.client.GetDynamicConfig(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientGetDynamicConfigScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) SetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.SetDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetDynamicConfigResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientSetDynamicConfigScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientSetDynamicConfigScope, metrics.ClientLatency)
+	resp, err := c.client.SetDynamicConfig(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientSetDynamicConfigScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ListDynamicConfig(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListDynamicConfigResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientListDynamicConfigScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientListDynamicConfigScope, metrics.ClientLatency)
+	resp, err := c.client.ListDynamicConfig(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientListDynamicConfigScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ListThrottledCallers(
+	ctx context.Context,
+	request *adminservice.ListThrottledCallersRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListThrottledCallersResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientListThrottledCallersScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientListThrottledCallersScope, metrics.ClientLatency)
+	resp, err := c.client.ListThrottledCallers(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientListThrottledCallersScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DescribeTaskQueuePartitions(
+	ctx context.Context,
+	request *adminservice.DescribeTaskQueuePartitionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeTaskQueuePartitionsResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDescribeTaskQueuePartitionsScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDescribeTaskQueuePartitionsScope, metrics.ClientLatency)
+	resp, err := c.client.DescribeTaskQueuePartitions(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDescribeTaskQueuePartitionsScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) SetMaintenanceInfo(
+	ctx context.Context,
+	request *adminservice.SetMaintenanceInfoRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetMaintenanceInfoResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientSetMaintenanceInfoScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientSetMaintenanceInfoScope, metrics.ClientLatency)
+	resp, err := c.client.SetMaintenanceInfo(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientSetMaintenanceInfoScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) UpdateWorkerBuildIdCompatibility(
+	ctx context.Context,
+	request *adminservice.UpdateWorkerBuildIdCompatibilityRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateWorkerBuildIdCompatibilityResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientUpdateWorkerBuildIdCompatibilityScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientUpdateWorkerBuildIdCompatibilityScope, metrics.ClientLatency)
+	resp, err := c.client.UpdateWorkerBuildIdCompatibility(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientUpdateWorkerBuildIdCompatibilityScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) GetWorkerBuildIdCompatibility(
+	ctx context.Context,
+	request *adminservice.GetWorkerBuildIdCompatibilityRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetWorkerBuildIdCompatibilityResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientGetWorkerBuildIdCompatibilityScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientGetWorkerBuildIdCompatibilityScope, metrics.ClientLatency)
+	resp, err := c.client.GetWorkerBuildIdCompatibility(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientGetWorkerBuildIdCompatibilityScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ShutdownWorker(
+	ctx context.Context,
+	request *adminservice.ShutdownWorkerRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ShutdownWorkerResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientShutdownWorkerScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientShutdownWorkerScope, metrics.ClientLatency)
+	resp, err := c.client.ShutdownWorker(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientShutdownWorkerScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ImportWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ImportWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ImportWorkflowExecutionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientImportWorkflowExecutionScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientImportWorkflowExecutionScope, metrics.ClientLatency)
+	resp, err := c.client.ImportWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientImportWorkflowExecutionScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ListSchedules(
+	ctx context.Context,
+	request *adminservice.ListSchedulesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListSchedulesResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientListSchedulesScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientListSchedulesScope, metrics.ClientLatency)
+	resp, err := c.client.ListSchedules(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientListSchedulesScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) StartResetBadBinaryJob(
+	ctx context.Context,
+	request *adminservice.StartResetBadBinaryJobRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartResetBadBinaryJobResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientStartResetBadBinaryJobScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientStartResetBadBinaryJobScope, metrics.ClientLatency)
+	resp, err := c.client.StartResetBadBinaryJob(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientStartResetBadBinaryJobScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) GetResetPoints(
+	ctx context.Context,
+	request *adminservice.GetResetPointsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetResetPointsResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientGetResetPointsScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientGetResetPointsScope, metrics.ClientLatency)
+	resp, err := c.client.GetResetPoints(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientGetResetPointsScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) RebalanceShards(
+	ctx context.Context,
+	request *adminservice.RebalanceShardsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RebalanceShardsResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientRebalanceShardsScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientRebalanceShardsScope, metrics.ClientLatency)
+	resp, err := c.client.RebalanceShards(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientRebalanceShardsScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) GetShardStats(
+	ctx context.Context,
+	request *adminservice.GetShardStatsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetShardStatsResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientGetShardStatsScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientGetShardStatsScope, metrics.ClientLatency)
+	resp, err := c.client.GetShardStats(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientGetShardStatsScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) GetHostProfile(
+	ctx context.Context,
+	request *adminservice.GetHostProfileRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetHostProfileResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientGetHostProfileScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientGetHostProfileScope, metrics.ClientLatency)
+	resp, err := c.client.GetHostProfile(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientGetHostProfileScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DeepHealthCheck(
+	ctx context.Context,
+	request *adminservice.DeepHealthCheckRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeepHealthCheckResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDeepHealthCheckScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDeepHealthCheckScope, metrics.ClientLatency)
+	resp, err := c.client.DeepHealthCheck(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDeepHealthCheckScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DescribeClusterMetadata(
+	ctx context.Context,
+	request *adminservice.DescribeClusterMetadataRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeClusterMetadataResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDescribeClusterMetadataScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDescribeClusterMetadataScope, metrics.ClientLatency)
+	resp, err := c.client.DescribeClusterMetadata(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDescribeClusterMetadataScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) UpdateClusterMetadata(
+	ctx context.Context,
+	request *adminservice.UpdateClusterMetadataRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateClusterMetadataResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientUpdateClusterMetadataScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientUpdateClusterMetadataScope, metrics.ClientLatency)
+	resp, err := c.client.UpdateClusterMetadata(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientUpdateClusterMetadataScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DescribeVisibilityProcessor(
+	ctx context.Context,
+	request *adminservice.DescribeVisibilityProcessorRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeVisibilityProcessorResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDescribeVisibilityProcessorScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDescribeVisibilityProcessorScope, metrics.ClientLatency)
+	resp, err := c.client.DescribeVisibilityProcessor(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDescribeVisibilityProcessorScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DeleteNamespace(
+	ctx context.Context,
+	request *adminservice.DeleteNamespaceRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteNamespaceResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDeleteNamespaceScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDeleteNamespaceScope, metrics.ClientLatency)
+	resp, err := c.client.DeleteNamespace(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDeleteNamespaceScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DescribeNamespaceQuotas(
+	ctx context.Context,
+	request *adminservice.DescribeNamespaceQuotasRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeNamespaceQuotasResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDescribeNamespaceQuotasScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDescribeNamespaceQuotasScope, metrics.ClientLatency)
+	resp, err := c.client.DescribeNamespaceQuotas(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDescribeNamespaceQuotasScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) UpdateNamespaceQuotas(
+	ctx context.Context,
+	request *adminservice.UpdateNamespaceQuotasRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateNamespaceQuotasResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientUpdateNamespaceQuotasScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientUpdateNamespaceQuotasScope, metrics.ClientLatency)
+	resp, err := c.client.UpdateNamespaceQuotas(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientUpdateNamespaceQuotasScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DeleteSchedule(
+	ctx context.Context,
+	request *adminservice.DeleteScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteScheduleResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDeleteScheduleScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDeleteScheduleScope, metrics.ClientLatency)
+	resp, err := c.client.DeleteSchedule(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDeleteScheduleScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) PatchSchedule(
+	ctx context.Context,
+	request *adminservice.PatchScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PatchScheduleResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientPatchScheduleScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientPatchScheduleScope, metrics.ClientLatency)
+	resp, err := c.client.PatchSchedule(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientPatchScheduleScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) UpdateSchedule(
+	ctx context.Context,
+	request *adminservice.UpdateScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateScheduleResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientUpdateScheduleScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientUpdateScheduleScope, metrics.ClientLatency)
+	resp, err := c.client.UpdateSchedule(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientUpdateScheduleScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DescribeSchedule(
+	ctx context.Context,
+	request *adminservice.DescribeScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeScheduleResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDescribeScheduleScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDescribeScheduleScope, metrics.ClientLatency)
+	resp, err := c.client.DescribeSchedule(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDescribeScheduleScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) CreateSchedule(
+	ctx context.Context,
+	request *adminservice.CreateScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CreateScheduleResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientCreateScheduleScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientCreateScheduleScope, metrics.ClientLatency)
+	resp, err := c.client.CreateSchedule(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientCreateScheduleScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
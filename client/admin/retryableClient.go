@@ -178,6 +178,22 @@ func (c *retryableClient) GetWorkflowExecutionRawHistoryV2(
 	return resp, err
 }
 
+func (c *retryableClient) GetWorkflowExecutionHistoryReverse(
+	ctx context.Context,
+	request *adminservice.GetWorkflowExecutionHistoryReverseRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetWorkflowExecutionHistoryReverseResponse, error) {
+
+	var resp *adminservice.GetWorkflowExecutionHistoryReverseResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetWorkflowExecutionHistoryReverse(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeCluster(
 	ctx context.Context,
 	request *adminservice.DescribeClusterRequest,
@@ -209,6 +225,35 @@ func (c *retryableClient) GetReplicationMessages(
 	return resp, err
 }
 
+func (c *retryableClient) StreamReplicationMessages(
+	ctx context.Context,
+	opts ...grpc.CallOption,
+) (adminservice.AdminService_StreamReplicationMessagesClient, error) {
+	var stream adminservice.AdminService_StreamReplicationMessagesClient
+	op := func() error {
+		var err error
+		stream, err = c.client.StreamReplicationMessages(ctx, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return stream, err
+}
+
+func (c *retryableClient) StreamWorkflowExecutionHistory(
+	ctx context.Context,
+	request *adminservice.StreamWorkflowExecutionHistoryRequest,
+	opts ...grpc.CallOption,
+) (adminservice.AdminService_StreamWorkflowExecutionHistoryClient, error) {
+	var stream adminservice.AdminService_StreamWorkflowExecutionHistoryClient
+	op := func() error {
+		var err error
+		stream, err = c.client.StreamWorkflowExecutionHistory(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return stream, err
+}
+
 func (c *retryableClient) GetNamespaceReplicationMessages(
 	ctx context.Context,
 	request *adminservice.GetNamespaceReplicationMessagesRequest,
@@ -333,3 +378,563 @@ func (c *retryableClient) ResendReplicationTasks(
 	err := backoff.Retry(op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) ExecuteMultiOperation(
+	ctx context.Context,
+	request *adminservice.ExecuteMultiOperationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ExecuteMultiOperationResponse, error) {
+
+	var resp *adminservice.ExecuteMultiOperationResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ExecuteMultiOperation(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ListNamespaceFailoverHistory(
+	ctx context.Context,
+	request *adminservice.ListNamespaceFailoverHistoryRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListNamespaceFailoverHistoryResponse, error) {
+
+	var resp *adminservice.ListNamespaceFailoverHistoryResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListNamespaceFailoverHistory(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) HandoverNamespace(
+	ctx context.Context,
+	request *adminservice.HandoverNamespaceRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.HandoverNamespaceResponse, error) {
+
+	var resp *adminservice.HandoverNamespaceResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.HandoverNamespace(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) RecordWorkflowTaskHeartbeat(
+	ctx context.Context,
+	request *adminservice.RecordWorkflowTaskHeartbeatRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RecordWorkflowTaskHeartbeatResponse, error) {
+
+	var resp *adminservice.RecordWorkflowTaskHeartbeatResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.RecordWorkflowTaskHeartbeat(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) GetReplicationLag(
+	ctx context.Context,
+	request *adminservice.GetReplicationLagRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetReplicationLagResponse, error) {
+
+	var resp *adminservice.GetReplicationLagResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetReplicationLag(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) CompactWorkflowHistory(
+	ctx context.Context,
+	request *adminservice.CompactWorkflowHistoryRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CompactWorkflowHistoryResponse, error) {
+
+	var resp *adminservice.CompactWorkflowHistoryResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.CompactWorkflowHistory(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ListStaleWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.ListStaleWorkflowExecutionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListStaleWorkflowExecutionsResponse, error) {
+
+	var resp *adminservice.ListStaleWorkflowExecutionsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListStaleWorkflowExecutions(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) GetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetDynamicConfigResponse, error) {
+
+	var resp *adminservice.GetDynamicConfigResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetDynamicConfig(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) SetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.SetDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetDynamicConfigResponse, error) {
+
+	var resp *adminservice.SetDynamicConfigResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.SetDynamicConfig(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ListDynamicConfig(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListDynamicConfigResponse, error) {
+
+	var resp *adminservice.ListDynamicConfigResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListDynamicConfig(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ListThrottledCallers(
+	ctx context.Context,
+	request *adminservice.ListThrottledCallersRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListThrottledCallersResponse, error) {
+
+	var resp *adminservice.ListThrottledCallersResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListThrottledCallers(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeTaskQueuePartitions(
+	ctx context.Context,
+	request *adminservice.DescribeTaskQueuePartitionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeTaskQueuePartitionsResponse, error) {
+
+	var resp *adminservice.DescribeTaskQueuePartitionsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeTaskQueuePartitions(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) SetMaintenanceInfo(
+	ctx context.Context,
+	request *adminservice.SetMaintenanceInfoRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetMaintenanceInfoResponse, error) {
+
+	var resp *adminservice.SetMaintenanceInfoResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.SetMaintenanceInfo(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateWorkerBuildIdCompatibility(
+	ctx context.Context,
+	request *adminservice.UpdateWorkerBuildIdCompatibilityRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateWorkerBuildIdCompatibilityResponse, error) {
+
+	var resp *adminservice.UpdateWorkerBuildIdCompatibilityResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UpdateWorkerBuildIdCompatibility(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) GetWorkerBuildIdCompatibility(
+	ctx context.Context,
+	request *adminservice.GetWorkerBuildIdCompatibilityRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetWorkerBuildIdCompatibilityResponse, error) {
+
+	var resp *adminservice.GetWorkerBuildIdCompatibilityResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetWorkerBuildIdCompatibility(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ShutdownWorker(
+	ctx context.Context,
+	request *adminservice.ShutdownWorkerRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ShutdownWorkerResponse, error) {
+
+	var resp *adminservice.ShutdownWorkerResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ShutdownWorker(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ImportWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ImportWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ImportWorkflowExecutionResponse, error) {
+
+	var resp *adminservice.ImportWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ImportWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ListSchedules(
+	ctx context.Context,
+	request *adminservice.ListSchedulesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListSchedulesResponse, error) {
+
+	var resp *adminservice.ListSchedulesResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListSchedules(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) StartResetBadBinaryJob(
+	ctx context.Context,
+	request *adminservice.StartResetBadBinaryJobRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartResetBadBinaryJobResponse, error) {
+
+	var resp *adminservice.StartResetBadBinaryJobResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.StartResetBadBinaryJob(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) GetResetPoints(
+	ctx context.Context,
+	request *adminservice.GetResetPointsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetResetPointsResponse, error) {
+
+	var resp *adminservice.GetResetPointsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetResetPoints(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) RebalanceShards(
+	ctx context.Context,
+	request *adminservice.RebalanceShardsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RebalanceShardsResponse, error) {
+
+	var resp *adminservice.RebalanceShardsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.RebalanceShards(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) GetShardStats(
+	ctx context.Context,
+	request *adminservice.GetShardStatsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetShardStatsResponse, error) {
+
+	var resp *adminservice.GetShardStatsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetShardStats(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) GetHostProfile(
+	ctx context.Context,
+	request *adminservice.GetHostProfileRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetHostProfileResponse, error) {
+
+	var resp *adminservice.GetHostProfileResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetHostProfile(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DeepHealthCheck(
+	ctx context.Context,
+	request *adminservice.DeepHealthCheckRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeepHealthCheckResponse, error) {
+
+	var resp *adminservice.DeepHealthCheckResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DeepHealthCheck(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeClusterMetadata(
+	ctx context.Context,
+	request *adminservice.DescribeClusterMetadataRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeClusterMetadataResponse, error) {
+
+	var resp *adminservice.DescribeClusterMetadataResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeClusterMetadata(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateClusterMetadata(
+	ctx context.Context,
+	request *adminservice.UpdateClusterMetadataRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateClusterMetadataResponse, error) {
+
+	var resp *adminservice.UpdateClusterMetadataResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UpdateClusterMetadata(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeVisibilityProcessor(
+	ctx context.Context,
+	request *adminservice.DescribeVisibilityProcessorRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeVisibilityProcessorResponse, error) {
+
+	var resp *adminservice.DescribeVisibilityProcessorResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeVisibilityProcessor(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DeleteNamespace(
+	ctx context.Context,
+	request *adminservice.DeleteNamespaceRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteNamespaceResponse, error) {
+
+	var resp *adminservice.DeleteNamespaceResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DeleteNamespace(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeNamespaceQuotas(
+	ctx context.Context,
+	request *adminservice.DescribeNamespaceQuotasRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeNamespaceQuotasResponse, error) {
+
+	var resp *adminservice.DescribeNamespaceQuotasResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeNamespaceQuotas(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateNamespaceQuotas(
+	ctx context.Context,
+	request *adminservice.UpdateNamespaceQuotasRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateNamespaceQuotasResponse, error) {
+
+	var resp *adminservice.UpdateNamespaceQuotasResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UpdateNamespaceQuotas(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DeleteSchedule(
+	ctx context.Context,
+	request *adminservice.DeleteScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteScheduleResponse, error) {
+
+	var resp *adminservice.DeleteScheduleResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DeleteSchedule(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) PatchSchedule(
+	ctx context.Context,
+	request *adminservice.PatchScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PatchScheduleResponse, error) {
+
+	var resp *adminservice.PatchScheduleResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.PatchSchedule(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateSchedule(
+	ctx context.Context,
+	request *adminservice.UpdateScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateScheduleResponse, error) {
+
+	var resp *adminservice.UpdateScheduleResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UpdateSchedule(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeSchedule(
+	ctx context.Context,
+	request *adminservice.DescribeScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeScheduleResponse, error) {
+
+	var resp *adminservice.DescribeScheduleResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeSchedule(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) CreateSchedule(
+	ctx context.Context,
+	request *adminservice.CreateScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CreateScheduleResponse, error) {
+
+	var resp *adminservice.CreateScheduleResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.CreateSchedule(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
@@ -0,0 +1,318 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -copyright_file ../../LICENSE -package $GOPACKAGE -source $GOFILE -destination client_mock.go
+
+// Package adminclient provides a typed client for the operations of the AdminService which are used by
+// automation around a Temporal cluster. Unlike the raw gRPC stubs, the client retries transient errors,
+// converts gRPC status errors to service errors and attaches authorization headers to every call.
+package adminclient
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"time"
+
+	"github.com/gogo/status"
+	enumspb "go.temporal.io/api/enums/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/client/admin"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/rpc"
+	"go.temporal.io/server/common/serviceerror"
+)
+
+const (
+	// DefaultTimeout is the default timeout of a single call to AdminService, including retries
+	DefaultTimeout = admin.DefaultTimeout
+)
+
+var (
+	errHostPortNotSet = errors.New("host port is not set")
+)
+
+type (
+	// Client is a typed client for AdminService operations
+	Client interface {
+		// DescribeShard returns the history host which owns the shard and the state of its shard controller.
+		DescribeShard(ctx context.Context, shardID int32) (*adminservice.DescribeHistoryHostResponse, error)
+		// CloseShard closes the shard on the history host which owns it, the shard is reacquired on next access.
+		CloseShard(ctx context.Context, shardID int32) error
+
+		// GetDLQMessages returns a page of messages of the DLQ up to and including lastMessageID.
+		GetDLQMessages(ctx context.Context, dlq DLQ, lastMessageID int64, pageSize int32, pageToken []byte) (*adminservice.GetDLQMessagesResponse, error)
+		// PurgeDLQMessages deletes messages of the DLQ up to and including lastMessageID.
+		PurgeDLQMessages(ctx context.Context, dlq DLQ, lastMessageID int64) error
+		// MergeDLQMessages applies a page of messages of the DLQ up to and including lastMessageID
+		// and returns the token of the next page, which is empty when all messages are merged.
+		MergeDLQMessages(ctx context.Context, dlq DLQ, lastMessageID int64, pageSize int32, pageToken []byte) ([]byte, error)
+
+		// GetSearchAttributes returns search attributes of the visibility index, empty index name means default index.
+		GetSearchAttributes(ctx context.Context, indexName string) (*adminservice.GetSearchAttributesResponse, error)
+		// AddSearchAttributes adds custom search attributes to the visibility index, empty index name means default index.
+		AddSearchAttributes(ctx context.Context, indexName string, searchAttributes map[string]enumspb.IndexedValueType) error
+		// RemoveSearchAttributes removes custom search attributes from the visibility index, empty index name means default index.
+		RemoveSearchAttributes(ctx context.Context, indexName string, searchAttributes []string) error
+
+		// Close closes the connection to the cluster.
+		Close() error
+	}
+
+	// HeadersProvider returns headers, e.g. authorization token, which are attached to every call
+	HeadersProvider interface {
+		GetHeaders(ctx context.Context) (map[string]string, error)
+	}
+
+	// Options are the options of the client
+	Options struct {
+		// HostPort of the frontend service, required.
+		HostPort string
+		// TLS configuration of the connection, connection is not secured if not set.
+		TLS *tls.Config
+		// HeadersProvider provides authorization headers attached to every call, optional.
+		HeadersProvider HeadersProvider
+		// RetryPolicy used to retry transient errors, common.CreateAdminServiceRetryPolicy() is used if not set.
+		RetryPolicy backoff.RetryPolicy
+		// Timeout of a single call including retries, used if context of the call has no deadline.
+		// DefaultTimeout is used if not set.
+		Timeout time.Duration
+	}
+
+	// DLQ identifies a dead letter queue
+	DLQ struct {
+		Type enumsspb.DeadLetterQueueType
+		// SourceCluster is the cluster replication tasks of replication DLQ were received from.
+		SourceCluster string
+		// ShardID is the shard of replication DLQ.
+		ShardID int32
+	}
+
+	clientImpl struct {
+		client     adminservice.AdminServiceClient
+		connection *grpc.ClientConn
+		timeout    time.Duration
+	}
+)
+
+var _ Client = (*clientImpl)(nil)
+
+// NewClient creates a new typed AdminService client connected to the frontend service
+func NewClient(options Options) (Client, error) {
+	if options.HostPort == "" {
+		return nil, errHostPortNotSet
+	}
+
+	grpcSecurityOptions := grpc.WithInsecure()
+	if options.TLS != nil {
+		grpcSecurityOptions = grpc.WithTransportCredentials(credentials.NewTLS(options.TLS))
+	}
+	var interceptors []grpc.UnaryClientInterceptor
+	if options.HeadersProvider != nil {
+		interceptors = append(interceptors, headersProviderInterceptor(options.HeadersProvider))
+	}
+	interceptors = append(interceptors, errorInterceptor)
+	connection, err := grpc.Dial(
+		options.HostPort,
+		grpcSecurityOptions,
+		grpc.WithChainUnaryInterceptor(interceptors...),
+		grpc.WithDefaultServiceConfig(rpc.DefaultServiceConfig),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	retryPolicy := options.RetryPolicy
+	if retryPolicy == nil {
+		retryPolicy = common.CreateAdminServiceRetryPolicy()
+	}
+	return newClient(
+		admin.NewRetryableClient(adminservice.NewAdminServiceClient(connection), retryPolicy, common.IsWhitelistServiceTransientError),
+		connection,
+		options.Timeout,
+	), nil
+}
+
+func newClient(
+	client adminservice.AdminServiceClient,
+	connection *grpc.ClientConn,
+	timeout time.Duration,
+) *clientImpl {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	return &clientImpl{
+		client:     client,
+		connection: connection,
+		timeout:    timeout,
+	}
+}
+
+func (c *clientImpl) DescribeShard(ctx context.Context, shardID int32) (*adminservice.DescribeHistoryHostResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeHistoryHost(ctx, &adminservice.DescribeHistoryHostRequest{
+		ShardId: shardID,
+	})
+}
+
+func (c *clientImpl) CloseShard(ctx context.Context, shardID int32) error {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	_, err := c.client.CloseShard(ctx, &adminservice.CloseShardRequest{
+		ShardId: shardID,
+	})
+	return err
+}
+
+func (c *clientImpl) GetDLQMessages(
+	ctx context.Context,
+	dlq DLQ,
+	lastMessageID int64,
+	pageSize int32,
+	pageToken []byte,
+) (*adminservice.GetDLQMessagesResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.GetDLQMessages(ctx, &adminservice.GetDLQMessagesRequest{
+		Type:                  dlq.Type,
+		ShardId:               dlq.ShardID,
+		SourceCluster:         dlq.SourceCluster,
+		InclusiveEndMessageId: lastMessageID,
+		MaximumPageSize:       pageSize,
+		NextPageToken:         pageToken,
+	})
+}
+
+func (c *clientImpl) PurgeDLQMessages(ctx context.Context, dlq DLQ, lastMessageID int64) error {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	_, err := c.client.PurgeDLQMessages(ctx, &adminservice.PurgeDLQMessagesRequest{
+		Type:                  dlq.Type,
+		ShardId:               dlq.ShardID,
+		SourceCluster:         dlq.SourceCluster,
+		InclusiveEndMessageId: lastMessageID,
+	})
+	return err
+}
+
+func (c *clientImpl) MergeDLQMessages(
+	ctx context.Context,
+	dlq DLQ,
+	lastMessageID int64,
+	pageSize int32,
+	pageToken []byte,
+) ([]byte, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	resp, err := c.client.MergeDLQMessages(ctx, &adminservice.MergeDLQMessagesRequest{
+		Type:                  dlq.Type,
+		ShardId:               dlq.ShardID,
+		SourceCluster:         dlq.SourceCluster,
+		InclusiveEndMessageId: lastMessageID,
+		MaximumPageSize:       pageSize,
+		NextPageToken:         pageToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetNextPageToken(), nil
+}
+
+func (c *clientImpl) GetSearchAttributes(ctx context.Context, indexName string) (*adminservice.GetSearchAttributesResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.GetSearchAttributes(ctx, &adminservice.GetSearchAttributesRequest{
+		IndexName: indexName,
+	})
+}
+
+func (c *clientImpl) AddSearchAttributes(
+	ctx context.Context,
+	indexName string,
+	searchAttributes map[string]enumspb.IndexedValueType,
+) error {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	_, err := c.client.AddSearchAttributes(ctx, &adminservice.AddSearchAttributesRequest{
+		SearchAttributes: searchAttributes,
+		IndexName:        indexName,
+	})
+	return err
+}
+
+func (c *clientImpl) RemoveSearchAttributes(ctx context.Context, indexName string, searchAttributes []string) error {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	_, err := c.client.RemoveSearchAttributes(ctx, &adminservice.RemoveSearchAttributesRequest{
+		SearchAttributes: searchAttributes,
+		IndexName:        indexName,
+	})
+	return err
+}
+
+func (c *clientImpl) Close() error {
+	if c.connection == nil {
+		return nil
+	}
+	return c.connection.Close()
+}
+
+func (c *clientImpl) createContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, c.timeout)
+}
+
+func errorInterceptor(
+	ctx context.Context,
+	method string,
+	req, reply interface{},
+	cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	return serviceerror.FromStatus(status.Convert(err))
+}
+
+func headersProviderInterceptor(headersProvider HeadersProvider) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		headers, err := headersProvider.GetHeaders(ctx)
+		if err != nil {
+			return err
+		}
+		for k, v := range headers {
+			ctx = metadata.AppendToOutgoingContext(ctx, k, v)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
@@ -0,0 +1,229 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: client.go
+
+// Package adminclient is a generated GoMock package.
+package adminclient
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	v1 "go.temporal.io/api/enums/v1"
+	v10 "go.temporal.io/server/api/adminservice/v1"
+)
+
+// MockClient is a mock of Client interface.
+type MockClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockClientMockRecorder
+}
+
+// MockClientMockRecorder is the mock recorder for MockClient.
+type MockClientMockRecorder struct {
+	mock *MockClient
+}
+
+// NewMockClient creates a new mock instance.
+func NewMockClient(ctrl *gomock.Controller) *MockClient {
+	mock := &MockClient{ctrl: ctrl}
+	mock.recorder = &MockClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClient) EXPECT() *MockClientMockRecorder {
+	return m.recorder
+}
+
+// AddSearchAttributes mocks base method.
+func (m *MockClient) AddSearchAttributes(ctx context.Context, indexName string, searchAttributes map[string]v1.IndexedValueType) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSearchAttributes", ctx, indexName, searchAttributes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddSearchAttributes indicates an expected call of AddSearchAttributes.
+func (mr *MockClientMockRecorder) AddSearchAttributes(ctx, indexName, searchAttributes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSearchAttributes", reflect.TypeOf((*MockClient)(nil).AddSearchAttributes), ctx, indexName, searchAttributes)
+}
+
+// Close mocks base method.
+func (m *MockClient) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockClientMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockClient)(nil).Close))
+}
+
+// CloseShard mocks base method.
+func (m *MockClient) CloseShard(ctx context.Context, shardID int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseShard", ctx, shardID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseShard indicates an expected call of CloseShard.
+func (mr *MockClientMockRecorder) CloseShard(ctx, shardID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseShard", reflect.TypeOf((*MockClient)(nil).CloseShard), ctx, shardID)
+}
+
+// DescribeShard mocks base method.
+func (m *MockClient) DescribeShard(ctx context.Context, shardID int32) (*v10.DescribeHistoryHostResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeShard", ctx, shardID)
+	ret0, _ := ret[0].(*v10.DescribeHistoryHostResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeShard indicates an expected call of DescribeShard.
+func (mr *MockClientMockRecorder) DescribeShard(ctx, shardID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeShard", reflect.TypeOf((*MockClient)(nil).DescribeShard), ctx, shardID)
+}
+
+// GetDLQMessages mocks base method.
+func (m *MockClient) GetDLQMessages(ctx context.Context, dlq DLQ, lastMessageID int64, pageSize int32, pageToken []byte) (*v10.GetDLQMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDLQMessages", ctx, dlq, lastMessageID, pageSize, pageToken)
+	ret0, _ := ret[0].(*v10.GetDLQMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDLQMessages indicates an expected call of GetDLQMessages.
+func (mr *MockClientMockRecorder) GetDLQMessages(ctx, dlq, lastMessageID, pageSize, pageToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQMessages", reflect.TypeOf((*MockClient)(nil).GetDLQMessages), ctx, dlq, lastMessageID, pageSize, pageToken)
+}
+
+// GetSearchAttributes mocks base method.
+func (m *MockClient) GetSearchAttributes(ctx context.Context, indexName string) (*v10.GetSearchAttributesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSearchAttributes", ctx, indexName)
+	ret0, _ := ret[0].(*v10.GetSearchAttributesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSearchAttributes indicates an expected call of GetSearchAttributes.
+func (mr *MockClientMockRecorder) GetSearchAttributes(ctx, indexName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchAttributes", reflect.TypeOf((*MockClient)(nil).GetSearchAttributes), ctx, indexName)
+}
+
+// MergeDLQMessages mocks base method.
+func (m *MockClient) MergeDLQMessages(ctx context.Context, dlq DLQ, lastMessageID int64, pageSize int32, pageToken []byte) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeDLQMessages", ctx, dlq, lastMessageID, pageSize, pageToken)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeDLQMessages indicates an expected call of MergeDLQMessages.
+func (mr *MockClientMockRecorder) MergeDLQMessages(ctx, dlq, lastMessageID, pageSize, pageToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockClient)(nil).MergeDLQMessages), ctx, dlq, lastMessageID, pageSize, pageToken)
+}
+
+// PurgeDLQMessages mocks base method.
+func (m *MockClient) PurgeDLQMessages(ctx context.Context, dlq DLQ, lastMessageID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDLQMessages", ctx, dlq, lastMessageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeDLQMessages indicates an expected call of PurgeDLQMessages.
+func (mr *MockClientMockRecorder) PurgeDLQMessages(ctx, dlq, lastMessageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDLQMessages", reflect.TypeOf((*MockClient)(nil).PurgeDLQMessages), ctx, dlq, lastMessageID)
+}
+
+// RemoveSearchAttributes mocks base method.
+func (m *MockClient) RemoveSearchAttributes(ctx context.Context, indexName string, searchAttributes []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveSearchAttributes", ctx, indexName, searchAttributes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveSearchAttributes indicates an expected call of RemoveSearchAttributes.
+func (mr *MockClientMockRecorder) RemoveSearchAttributes(ctx, indexName, searchAttributes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSearchAttributes", reflect.TypeOf((*MockClient)(nil).RemoveSearchAttributes), ctx, indexName, searchAttributes)
+}
+
+// MockHeadersProvider is a mock of HeadersProvider interface.
+type MockHeadersProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockHeadersProviderMockRecorder
+}
+
+// MockHeadersProviderMockRecorder is the mock recorder for MockHeadersProvider.
+type MockHeadersProviderMockRecorder struct {
+	mock *MockHeadersProvider
+}
+
+// NewMockHeadersProvider creates a new mock instance.
+func NewMockHeadersProvider(ctrl *gomock.Controller) *MockHeadersProvider {
+	mock := &MockHeadersProvider{ctrl: ctrl}
+	mock.recorder = &MockHeadersProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHeadersProvider) EXPECT() *MockHeadersProviderMockRecorder {
+	return m.recorder
+}
+
+// GetHeaders mocks base method.
+func (m *MockHeadersProvider) GetHeaders(ctx context.Context) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHeaders", ctx)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHeaders indicates an expected call of GetHeaders.
+func (mr *MockHeadersProviderMockRecorder) GetHeaders(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHeaders", reflect.TypeOf((*MockHeadersProvider)(nil).GetHeaders), ctx)
+}
@@ -0,0 +1,167 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package adminclient
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/rpc"
+)
+
+type (
+	clientSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		server  *grpc.Server
+		handler *testHandler
+		client  Client
+	}
+
+	testHandler struct {
+		adminservice.UnimplementedAdminServiceServer
+
+		closeShardErrors []error
+		closeShardCalls  int
+		authorization    []string
+		mergeRequest     *adminservice.MergeDLQMessagesRequest
+	}
+
+	testHeadersProvider struct{}
+)
+
+func TestClientSuite(t *testing.T) {
+	s := new(clientSuite)
+	suite.Run(t, s)
+}
+
+func (s *clientSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	s.NoError(err)
+	s.handler = &testHandler{}
+	s.server = grpc.NewServer(grpc.UnaryInterceptor(rpc.ServiceErrorInterceptor))
+	adminservice.RegisterAdminServiceServer(s.server, s.handler)
+	go func() { _ = s.server.Serve(listener) }()
+
+	retryPolicy := backoff.NewExponentialRetryPolicy(time.Millisecond)
+	retryPolicy.SetMaximumAttempts(2)
+	s.client, err = NewClient(Options{
+		HostPort:        listener.Addr().String(),
+		HeadersProvider: testHeadersProvider{},
+		RetryPolicy:     retryPolicy,
+	})
+	s.NoError(err)
+}
+
+func (s *clientSuite) TearDownTest() {
+	s.NoError(s.client.Close())
+	s.server.Stop()
+}
+
+func (s *clientSuite) TestNewClient_HostPortNotSet() {
+	_, err := NewClient(Options{})
+	s.Equal(errHostPortNotSet, err)
+}
+
+func (s *clientSuite) TestCloseShard_RetryTransientError() {
+	s.handler.closeShardErrors = []error{serviceerror.NewUnavailable("unavailable"), nil}
+	s.NoError(s.client.CloseShard(context.Background(), 1))
+	s.Equal(2, s.handler.closeShardCalls)
+	s.Equal([]string{"Bearer token"}, s.handler.authorization)
+}
+
+func (s *clientSuite) TestCloseShard_NonRetryableError() {
+	s.handler.closeShardErrors = []error{serviceerror.NewInvalidArgument("invalid shard")}
+	err := s.client.CloseShard(context.Background(), 1)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+	s.Equal(1, s.handler.closeShardCalls)
+}
+
+func (s *clientSuite) TestMergeDLQMessages() {
+	dlq := DLQ{
+		Type:          enumsspb.DEAD_LETTER_QUEUE_TYPE_REPLICATION,
+		SourceCluster: "cluster-a",
+		ShardID:       3,
+	}
+	token, err := s.client.MergeDLQMessages(context.Background(), dlq, 100, 10, []byte("token"))
+	s.NoError(err)
+	s.Equal([]byte("next-token"), token)
+	s.Equal(&adminservice.MergeDLQMessagesRequest{
+		Type:                  enumsspb.DEAD_LETTER_QUEUE_TYPE_REPLICATION,
+		ShardId:               3,
+		SourceCluster:         "cluster-a",
+		InclusiveEndMessageId: 100,
+		MaximumPageSize:       10,
+		NextPageToken:         []byte("token"),
+	}, s.handler.mergeRequest)
+}
+
+func (s *clientSuite) TestGetSearchAttributes() {
+	resp, err := s.client.GetSearchAttributes(context.Background(), "")
+	s.NoError(err)
+	s.Equal(map[string]enumspb.IndexedValueType{"CustomKeywordField": enumspb.INDEXED_VALUE_TYPE_KEYWORD}, resp.GetCustomAttributes())
+}
+
+func (h *testHandler) CloseShard(ctx context.Context, _ *adminservice.CloseShardRequest) (*adminservice.CloseShardResponse, error) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		h.authorization = md.Get("authorization")
+	}
+	err := h.closeShardErrors[h.closeShardCalls]
+	h.closeShardCalls++
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.CloseShardResponse{}, nil
+}
+
+func (h *testHandler) MergeDLQMessages(_ context.Context, request *adminservice.MergeDLQMessagesRequest) (*adminservice.MergeDLQMessagesResponse, error) {
+	h.mergeRequest = request
+	return &adminservice.MergeDLQMessagesResponse{NextPageToken: []byte("next-token")}, nil
+}
+
+func (h *testHandler) GetSearchAttributes(context.Context, *adminservice.GetSearchAttributesRequest) (*adminservice.GetSearchAttributesResponse, error) {
+	return &adminservice.GetSearchAttributesResponse{
+		CustomAttributes: map[string]enumspb.IndexedValueType{"CustomKeywordField": enumspb.INDEXED_VALUE_TYPE_KEYWORD},
+	}, nil
+}
+
+func (testHeadersProvider) GetHeaders(context.Context) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer token"}, nil
+}
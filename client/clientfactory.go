@@ -196,7 +196,7 @@ func (cf *rpcClientFactory) NewFrontendClientWithTimeout(
 	}
 
 	clientProvider := func(clientKey string) (interface{}, error) {
-		connection := cf.rpcFactory.CreateFrontendGRPCConnection(rpcAddress)
+		connection := cf.rpcFactory.CreateRemoteFrontendGRPCConnection(rpcAddress)
 		return workflowservice.NewWorkflowServiceClient(connection), nil
 	}
 
@@ -217,7 +217,7 @@ func (cf *rpcClientFactory) NewAdminClientWithTimeout(
 	}
 
 	clientProvider := func(clientKey string) (interface{}, error) {
-		connection := cf.rpcFactory.CreateFrontendGRPCConnection(rpcAddress)
+		connection := cf.rpcFactory.CreateRemoteFrontendGRPCConnection(rpcAddress)
 		return adminservice.NewAdminServiceClient(connection), nil
 	}
 
@@ -36,6 +36,7 @@ import (
 	replicationspb "go.temporal.io/server/api/replication/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
@@ -217,7 +218,13 @@ func (c *clientImpl) CloseShard(
 
 	var err error
 	var client historyservice.HistoryServiceClient
-	if request.ShardId != 0 {
+	if len(request.GetHostAddress()) > 0 {
+		ret, err := c.clients.GetClientForClientKey(request.GetHostAddress())
+		if err != nil {
+			return nil, err
+		}
+		client = ret.(historyservice.HistoryServiceClient)
+	} else if request.ShardId != 0 {
 		client, err = c.getClientForShardID(request.GetShardId())
 		if err != nil {
 			return nil, err
@@ -412,6 +419,34 @@ func (c *clientImpl) RespondWorkflowTaskFailed(
 
 }
 
+func (c *clientImpl) RecordWorkflowTaskHeartbeat(
+	ctx context.Context,
+	request *historyservice.RecordWorkflowTaskHeartbeatRequest,
+	opts ...grpc.CallOption) (*historyservice.RecordWorkflowTaskHeartbeatResponse, error) {
+	taskToken, err := c.tokenSerializer.Deserialize(request.TaskToken)
+	if err != nil {
+		return nil, err
+	}
+	client, err := c.getClientForWorkflowID(request.NamespaceId, taskToken.GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
+
+	var response *historyservice.RecordWorkflowTaskHeartbeatResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.RecordWorkflowTaskHeartbeat(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) RespondActivityTaskCompleted(
 	ctx context.Context,
 	request *historyservice.RespondActivityTaskCompletedRequest,
@@ -600,6 +635,30 @@ func (c *clientImpl) SignalWithStartWorkflowExecution(
 	return response, err
 }
 
+func (c *clientImpl) ExecuteMultiOperation(
+	ctx context.Context,
+	request *historyservice.ExecuteMultiOperationRequest,
+	opts ...grpc.CallOption) (*historyservice.ExecuteMultiOperationResponse, error) {
+	client, err := c.getClientForWorkflowID(request.NamespaceId, request.StartRequest.GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
+
+	var response *historyservice.ExecuteMultiOperationResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.ExecuteMultiOperation(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, err
+}
+
 func (c *clientImpl) RemoveSignalMutableState(
 	ctx context.Context,
 	request *historyservice.RemoveSignalMutableStateRequest,
@@ -890,6 +949,21 @@ func (c *clientImpl) GetReplicationMessages(
 	return response, err
 }
 
+func (c *clientImpl) StreamReplicationMessages(
+	ctx context.Context,
+	opts ...grpc.CallOption,
+) (historyservice.HistoryService_StreamReplicationMessagesClient, error) {
+	shardID, ok := headers.GetOutgoingReplicationShardID(ctx)
+	if !ok {
+		return nil, serviceerror.NewInvalidArgument("Replication stream shard ID is not set.")
+	}
+	client, err := c.getClientForShardID(shardID)
+	if err != nil {
+		return nil, err
+	}
+	return client.StreamReplicationMessages(ctx, opts...)
+}
+
 func (c *clientImpl) GetDLQReplicationMessages(
 	ctx context.Context,
 	request *historyservice.GetDLQReplicationMessagesRequest,
@@ -997,6 +1071,195 @@ func (c *clientImpl) RefreshWorkflowTasks(
 	return response, nil
 }
 
+func (c *clientImpl) GetReplicationStatus(
+	ctx context.Context,
+	request *historyservice.GetReplicationStatusRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.GetReplicationStatusResponse, error) {
+	requestsByClient := make(map[historyservice.HistoryServiceClient]*historyservice.GetReplicationStatusRequest)
+
+	for _, shardID := range request.GetShardIds() {
+		client, err := c.getClientForShardID(shardID)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := requestsByClient[client]; !ok {
+			requestsByClient[client] = &historyservice.GetReplicationStatusRequest{
+				RemoteClusters:  request.RemoteClusters,
+				IncludeDlqDepth: request.IncludeDlqDepth,
+			}
+		}
+
+		req := requestsByClient[client]
+		req.ShardIds = append(req.ShardIds, shardID)
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(len(requestsByClient))
+	respChan := make(chan *historyservice.GetReplicationStatusResponse, len(requestsByClient))
+	errChan := make(chan error, len(requestsByClient))
+	for client, req := range requestsByClient {
+		go func(client historyservice.HistoryServiceClient, request *historyservice.GetReplicationStatusRequest) {
+			defer wg.Done()
+
+			ctx, cancel := c.createContext(ctx)
+			defer cancel()
+			resp, err := client.GetReplicationStatus(ctx, request, opts...)
+			if err != nil {
+				errChan <- err
+				return
+			}
+			respChan <- resp
+		}(client, req)
+	}
+
+	wg.Wait()
+	close(respChan)
+	close(errChan)
+
+	if len(errChan) > 0 {
+		return nil, <-errChan
+	}
+	response := &historyservice.GetReplicationStatusResponse{}
+	for resp := range respChan {
+		response.Shards = append(response.Shards, resp.Shards...)
+	}
+	return response, nil
+}
+
+func (c *clientImpl) GenerateLastHistoryReplicationTasks(
+	ctx context.Context,
+	request *historyservice.GenerateLastHistoryReplicationTasksRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.GenerateLastHistoryReplicationTasksResponse, error) {
+	client, err := c.getClientForWorkflowID(request.NamespaceId, request.GetExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
+	var response *historyservice.GenerateLastHistoryReplicationTasksResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.GenerateLastHistoryReplicationTasks(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) CompactWorkflowHistory(
+	ctx context.Context,
+	request *historyservice.CompactWorkflowHistoryRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.CompactWorkflowHistoryResponse, error) {
+	client, err := c.getClientForWorkflowID(request.NamespaceId, request.GetRequest().GetExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
+	var response *historyservice.CompactWorkflowHistoryResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.CompactWorkflowHistory(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) ListThrottledCallers(
+	ctx context.Context,
+	request *historyservice.ListThrottledCallersRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ListThrottledCallersResponse, error) {
+	ret, err := c.clients.GetClientForClientKey(request.GetHostAddress())
+	if err != nil {
+		return nil, err
+	}
+	client := ret.(historyservice.HistoryServiceClient)
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ListThrottledCallers(ctx, request, opts...)
+}
+
+func (c *clientImpl) GetShardStats(
+	ctx context.Context,
+	request *historyservice.GetShardStatsRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.GetShardStatsResponse, error) {
+	ret, err := c.clients.GetClientForClientKey(request.GetHostAddress())
+	if err != nil {
+		return nil, err
+	}
+	client := ret.(historyservice.HistoryServiceClient)
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.GetShardStats(ctx, request, opts...)
+}
+
+func (c *clientImpl) GetHostProfile(
+	ctx context.Context,
+	request *historyservice.GetHostProfileRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.GetHostProfileResponse, error) {
+	ret, err := c.clients.GetClientForClientKey(request.GetHostAddress())
+	if err != nil {
+		return nil, err
+	}
+	client := ret.(historyservice.HistoryServiceClient)
+	// cpu profiles are sampled for the requested duration which may exceed the default timeout,
+	// so only the deadline of the caller applies
+	return client.GetHostProfile(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeVisibilityProcessor(
+	ctx context.Context,
+	request *historyservice.DescribeVisibilityProcessorRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DescribeVisibilityProcessorResponse, error) {
+	ret, err := c.clients.GetClientForClientKey(request.GetHostAddress())
+	if err != nil {
+		return nil, err
+	}
+	client := ret.(historyservice.HistoryServiceClient)
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DescribeVisibilityProcessor(ctx, request, opts...)
+}
+
+func (c *clientImpl) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.DeleteWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DeleteWorkflowExecutionResponse, error) {
+	client, err := c.getClientForWorkflowID(request.NamespaceId, request.GetWorkflowExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
+	var response *historyservice.DeleteWorkflowExecutionResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.DeleteWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) createContext(parent context.Context) (context.Context, context.CancelFunc) {
 	return context.WithTimeout(parent, c.timeout)
 }
@@ -237,6 +237,23 @@ func (c *metricClient) RespondWorkflowTaskFailed(
 	return resp, err
 }
 
+func (c *metricClient) RecordWorkflowTaskHeartbeat(
+	context context.Context,
+	request *historyservice.RecordWorkflowTaskHeartbeatRequest,
+	opts ...grpc.CallOption) (*historyservice.RecordWorkflowTaskHeartbeatResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientRecordWorkflowTaskHeartbeatScope, metrics.ClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientRecordWorkflowTaskHeartbeatScope, metrics.ClientLatency)
+	resp, err := c.client.RecordWorkflowTaskHeartbeat(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientRecordWorkflowTaskHeartbeatScope, metrics.ClientFailures)
+	}
+
+	return resp, err
+}
+
 func (c *metricClient) RespondActivityTaskCompleted(
 	context context.Context,
 	request *historyservice.RespondActivityTaskCompletedRequest,
@@ -356,6 +373,23 @@ func (c *metricClient) SignalWithStartWorkflowExecution(
 	return resp, err
 }
 
+func (c *metricClient) ExecuteMultiOperation(
+	context context.Context,
+	request *historyservice.ExecuteMultiOperationRequest,
+	opts ...grpc.CallOption) (*historyservice.ExecuteMultiOperationResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientExecuteMultiOperationScope, metrics.ClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientExecuteMultiOperationScope, metrics.ClientLatency)
+	resp, err := c.client.ExecuteMultiOperation(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientExecuteMultiOperationScope, metrics.ClientFailures)
+	}
+
+	return resp, err
+}
+
 func (c *metricClient) RemoveSignalMutableState(
 	context context.Context,
 	request *historyservice.RemoveSignalMutableStateRequest,
@@ -509,6 +543,20 @@ func (c *metricClient) GetReplicationMessages(
 	return resp, err
 }
 
+func (c *metricClient) StreamReplicationMessages(
+	context context.Context,
+	opts ...grpc.CallOption) (historyservice.HistoryService_StreamReplicationMessagesClient, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientStreamReplicationMessagesScope, metrics.ClientRequests)
+
+	stream, err := c.client.StreamReplicationMessages(context, opts...)
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientStreamReplicationMessagesScope, metrics.ClientFailures)
+	}
+
+	return stream, err
+}
+
 func (c *metricClient) GetDLQReplicationMessages(
 	context context.Context,
 	request *historyservice.GetDLQReplicationMessagesRequest,
@@ -626,3 +674,139 @@ func (c *metricClient) RefreshWorkflowTasks(
 	}
 	return resp, err
 }
+
+func (c *metricClient) GetReplicationStatus(
+	ctx context.Context,
+	request *historyservice.GetReplicationStatusRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.GetReplicationStatusResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientGetReplicationStatusScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientGetReplicationStatusScope, metrics.ClientLatency)
+	resp, err := c.client.GetReplicationStatus(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientGetReplicationStatusScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) GenerateLastHistoryReplicationTasks(
+	ctx context.Context,
+	request *historyservice.GenerateLastHistoryReplicationTasksRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.GenerateLastHistoryReplicationTasksResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientGenerateLastHistoryReplicationTasksScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientGenerateLastHistoryReplicationTasksScope, metrics.ClientLatency)
+	resp, err := c.client.GenerateLastHistoryReplicationTasks(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientGenerateLastHistoryReplicationTasksScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) CompactWorkflowHistory(
+	ctx context.Context,
+	request *historyservice.CompactWorkflowHistoryRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.CompactWorkflowHistoryResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientCompactWorkflowHistoryScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientCompactWorkflowHistoryScope, metrics.ClientLatency)
+	resp, err := c.client.CompactWorkflowHistory(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientCompactWorkflowHistoryScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ListThrottledCallers(
+	ctx context.Context,
+	request *historyservice.ListThrottledCallersRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ListThrottledCallersResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientListThrottledCallersScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientListThrottledCallersScope, metrics.ClientLatency)
+	resp, err := c.client.ListThrottledCallers(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientListThrottledCallersScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) GetShardStats(
+	ctx context.Context,
+	request *historyservice.GetShardStatsRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.GetShardStatsResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientGetShardStatsScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientGetShardStatsScope, metrics.ClientLatency)
+	resp, err := c.client.GetShardStats(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientGetShardStatsScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) GetHostProfile(
+	ctx context.Context,
+	request *historyservice.GetHostProfileRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.GetHostProfileResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientGetHostProfileScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientGetHostProfileScope, metrics.ClientLatency)
+	resp, err := c.client.GetHostProfile(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientGetHostProfileScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DescribeVisibilityProcessor(
+	ctx context.Context,
+	request *historyservice.DescribeVisibilityProcessorRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DescribeVisibilityProcessorResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientDescribeVisibilityProcessorScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientDescribeVisibilityProcessorScope, metrics.ClientLatency)
+	resp, err := c.client.DescribeVisibilityProcessor(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientDescribeVisibilityProcessorScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.DeleteWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DeleteWorkflowExecutionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientDeleteWorkflowExecutionScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientDeleteWorkflowExecutionScope, metrics.ClientLatency)
+	resp, err := c.client.DeleteWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientDeleteWorkflowExecutionScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
@@ -258,6 +258,22 @@ func (c *retryableClient) RespondWorkflowTaskFailed(
 	return resp, err
 }
 
+func (c *retryableClient) RecordWorkflowTaskHeartbeat(
+	ctx context.Context,
+	request *historyservice.RecordWorkflowTaskHeartbeatRequest,
+	opts ...grpc.CallOption) (*historyservice.RecordWorkflowTaskHeartbeatResponse, error) {
+
+	var resp *historyservice.RecordWorkflowTaskHeartbeatResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.RecordWorkflowTaskHeartbeat(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) RespondActivityTaskCompleted(
 	ctx context.Context,
 	request *historyservice.RespondActivityTaskCompletedRequest,
@@ -370,6 +386,22 @@ func (c *retryableClient) SignalWithStartWorkflowExecution(
 	return resp, err
 }
 
+func (c *retryableClient) ExecuteMultiOperation(
+	ctx context.Context,
+	request *historyservice.ExecuteMultiOperationRequest,
+	opts ...grpc.CallOption) (*historyservice.ExecuteMultiOperationResponse, error) {
+
+	var resp *historyservice.ExecuteMultiOperationResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ExecuteMultiOperation(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) RemoveSignalMutableState(
 	ctx context.Context,
 	request *historyservice.RemoveSignalMutableStateRequest,
@@ -513,6 +545,20 @@ func (c *retryableClient) GetReplicationMessages(
 	return resp, err
 }
 
+func (c *retryableClient) StreamReplicationMessages(
+	ctx context.Context,
+	opts ...grpc.CallOption) (historyservice.HistoryService_StreamReplicationMessagesClient, error) {
+	var stream historyservice.HistoryService_StreamReplicationMessagesClient
+	op := func() error {
+		var err error
+		stream, err = c.client.StreamReplicationMessages(ctx, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return stream, err
+}
+
 func (c *retryableClient) GetDLQReplicationMessages(
 	ctx context.Context,
 	request *historyservice.GetDLQReplicationMessagesRequest,
@@ -626,3 +672,139 @@ func (c *retryableClient) RefreshWorkflowTasks(
 	err := backoff.Retry(op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) GetReplicationStatus(
+	ctx context.Context,
+	request *historyservice.GetReplicationStatusRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.GetReplicationStatusResponse, error) {
+
+	var resp *historyservice.GetReplicationStatusResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetReplicationStatus(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) GenerateLastHistoryReplicationTasks(
+	ctx context.Context,
+	request *historyservice.GenerateLastHistoryReplicationTasksRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.GenerateLastHistoryReplicationTasksResponse, error) {
+
+	var resp *historyservice.GenerateLastHistoryReplicationTasksResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GenerateLastHistoryReplicationTasks(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) CompactWorkflowHistory(
+	ctx context.Context,
+	request *historyservice.CompactWorkflowHistoryRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.CompactWorkflowHistoryResponse, error) {
+
+	var resp *historyservice.CompactWorkflowHistoryResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.CompactWorkflowHistory(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ListThrottledCallers(
+	ctx context.Context,
+	request *historyservice.ListThrottledCallersRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ListThrottledCallersResponse, error) {
+
+	var resp *historyservice.ListThrottledCallersResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListThrottledCallers(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) GetShardStats(
+	ctx context.Context,
+	request *historyservice.GetShardStatsRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.GetShardStatsResponse, error) {
+
+	var resp *historyservice.GetShardStatsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetShardStats(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) GetHostProfile(
+	ctx context.Context,
+	request *historyservice.GetHostProfileRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.GetHostProfileResponse, error) {
+
+	var resp *historyservice.GetHostProfileResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetHostProfile(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeVisibilityProcessor(
+	ctx context.Context,
+	request *historyservice.DescribeVisibilityProcessorRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DescribeVisibilityProcessorResponse, error) {
+
+	var resp *historyservice.DescribeVisibilityProcessorResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeVisibilityProcessor(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.DeleteWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DeleteWorkflowExecutionResponse, error) {
+
+	var resp *historyservice.DeleteWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DeleteWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
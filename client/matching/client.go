@@ -203,6 +203,76 @@ func (c *clientImpl) ListTaskQueuePartitions(ctx context.Context, request *match
 	return client.ListTaskQueuePartitions(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribeTaskQueuePartitions(ctx context.Context, request *matchingservice.DescribeTaskQueuePartitionsRequest, opts ...grpc.CallOption) (*matchingservice.DescribeTaskQueuePartitionsResponse, error) {
+	client, err := c.getClientForTaskqueue(request.GetTaskQueue())
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DescribeTaskQueuePartitions(ctx, request, opts...)
+}
+
+func (c *clientImpl) GetTaskQueueUserData(ctx context.Context, request *matchingservice.GetTaskQueueUserDataRequest, opts ...grpc.CallOption) (*matchingservice.GetTaskQueueUserDataResponse, error) {
+	client, err := c.getClientForTaskqueue(request.GetTaskQueue())
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.GetTaskQueueUserData(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateTaskQueueUserData(ctx context.Context, request *matchingservice.UpdateTaskQueueUserDataRequest, opts ...grpc.CallOption) (*matchingservice.UpdateTaskQueueUserDataResponse, error) {
+	client, err := c.getClientForTaskqueue(request.GetTaskQueue())
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UpdateTaskQueueUserData(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateWorkerBuildIdCompatibility(ctx context.Context, request *matchingservice.UpdateWorkerBuildIdCompatibilityRequest, opts ...grpc.CallOption) (*matchingservice.UpdateWorkerBuildIdCompatibilityResponse, error) {
+	client, err := c.getClientForTaskqueue(request.GetTaskQueue())
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UpdateWorkerBuildIdCompatibility(ctx, request, opts...)
+}
+
+func (c *clientImpl) GetWorkerBuildIdCompatibility(ctx context.Context, request *matchingservice.GetWorkerBuildIdCompatibilityRequest, opts ...grpc.CallOption) (*matchingservice.GetWorkerBuildIdCompatibilityResponse, error) {
+	client, err := c.getClientForTaskqueue(request.GetTaskQueue())
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.GetWorkerBuildIdCompatibility(ctx, request, opts...)
+}
+
+func (c *clientImpl) EvictStickyTaskQueue(ctx context.Context, request *matchingservice.EvictStickyTaskQueueRequest, opts ...grpc.CallOption) (*matchingservice.EvictStickyTaskQueueResponse, error) {
+	client, err := c.getClientForTaskqueue(request.GetTaskQueue())
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.EvictStickyTaskQueue(ctx, request, opts...)
+}
+
+func (c *clientImpl) GetHostProfile(ctx context.Context, request *matchingservice.GetHostProfileRequest, opts ...grpc.CallOption) (*matchingservice.GetHostProfileResponse, error) {
+	ret, err := c.clients.GetClientForClientKey(request.GetHostAddress())
+	if err != nil {
+		return nil, err
+	}
+	client := ret.(matchingservice.MatchingServiceClient)
+	// cpu profiles are sampled for the requested duration, so only the deadline of the caller applies
+	return client.GetHostProfile(ctx, request, opts...)
+}
+
 func (c *clientImpl) createContext(parent context.Context) (context.Context, context.CancelFunc) {
 	return context.WithTimeout(parent, c.timeout)
 }
@@ -246,6 +246,132 @@ func (c *metricClient) ListTaskQueuePartitions(
 	return resp, err
 }
 
+func (c *metricClient) DescribeTaskQueuePartitions(
+	ctx context.Context,
+	request *matchingservice.DescribeTaskQueuePartitionsRequest,
+	opts ...grpc.CallOption) (*matchingservice.DescribeTaskQueuePartitionsResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.MatchingClientDescribeTaskQueuePartitionsScope, metrics.ClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientDescribeTaskQueuePartitionsScope, metrics.ClientLatency)
+	resp, err := c.client.DescribeTaskQueuePartitions(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientDescribeTaskQueuePartitionsScope, metrics.ClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) GetTaskQueueUserData(
+	ctx context.Context,
+	request *matchingservice.GetTaskQueueUserDataRequest,
+	opts ...grpc.CallOption) (*matchingservice.GetTaskQueueUserDataResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.MatchingClientGetTaskQueueUserDataScope, metrics.ClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientGetTaskQueueUserDataScope, metrics.ClientLatency)
+	resp, err := c.client.GetTaskQueueUserData(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientGetTaskQueueUserDataScope, metrics.ClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) UpdateTaskQueueUserData(
+	ctx context.Context,
+	request *matchingservice.UpdateTaskQueueUserDataRequest,
+	opts ...grpc.CallOption) (*matchingservice.UpdateTaskQueueUserDataResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.MatchingClientUpdateTaskQueueUserDataScope, metrics.ClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientUpdateTaskQueueUserDataScope, metrics.ClientLatency)
+	resp, err := c.client.UpdateTaskQueueUserData(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientUpdateTaskQueueUserDataScope, metrics.ClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) UpdateWorkerBuildIdCompatibility(
+	ctx context.Context,
+	request *matchingservice.UpdateWorkerBuildIdCompatibilityRequest,
+	opts ...grpc.CallOption) (*matchingservice.UpdateWorkerBuildIdCompatibilityResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.MatchingClientUpdateWorkerBuildIdCompatibilityScope, metrics.ClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientUpdateWorkerBuildIdCompatibilityScope, metrics.ClientLatency)
+	resp, err := c.client.UpdateWorkerBuildIdCompatibility(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientUpdateWorkerBuildIdCompatibilityScope, metrics.ClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) GetWorkerBuildIdCompatibility(
+	ctx context.Context,
+	request *matchingservice.GetWorkerBuildIdCompatibilityRequest,
+	opts ...grpc.CallOption) (*matchingservice.GetWorkerBuildIdCompatibilityResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.MatchingClientGetWorkerBuildIdCompatibilityScope, metrics.ClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientGetWorkerBuildIdCompatibilityScope, metrics.ClientLatency)
+	resp, err := c.client.GetWorkerBuildIdCompatibility(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientGetWorkerBuildIdCompatibilityScope, metrics.ClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) EvictStickyTaskQueue(
+	ctx context.Context,
+	request *matchingservice.EvictStickyTaskQueueRequest,
+	opts ...grpc.CallOption) (*matchingservice.EvictStickyTaskQueueResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.MatchingClientEvictStickyTaskQueueScope, metrics.ClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientEvictStickyTaskQueueScope, metrics.ClientLatency)
+	resp, err := c.client.EvictStickyTaskQueue(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientEvictStickyTaskQueueScope, metrics.ClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) GetHostProfile(
+	ctx context.Context,
+	request *matchingservice.GetHostProfileRequest,
+	opts ...grpc.CallOption) (*matchingservice.GetHostProfileResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.MatchingClientGetHostProfileScope, metrics.ClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientGetHostProfileScope, metrics.ClientLatency)
+	resp, err := c.client.GetHostProfile(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientGetHostProfileScope, metrics.ClientFailures)
+	}
+
+	return resp, err
+}
+
 func (c *metricClient) emitForwardedSourceStats(scope int, forwardedFrom string, taskQueue *taskqueuepb.TaskQueue) {
 	if taskQueue == nil {
 		return
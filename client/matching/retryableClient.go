@@ -193,3 +193,115 @@ func (c *retryableClient) ListTaskQueuePartitions(
 	err := backoff.Retry(op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) DescribeTaskQueuePartitions(
+	ctx context.Context,
+	request *matchingservice.DescribeTaskQueuePartitionsRequest,
+	opts ...grpc.CallOption) (*matchingservice.DescribeTaskQueuePartitionsResponse, error) {
+
+	var resp *matchingservice.DescribeTaskQueuePartitionsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeTaskQueuePartitions(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) GetTaskQueueUserData(
+	ctx context.Context,
+	request *matchingservice.GetTaskQueueUserDataRequest,
+	opts ...grpc.CallOption) (*matchingservice.GetTaskQueueUserDataResponse, error) {
+
+	var resp *matchingservice.GetTaskQueueUserDataResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetTaskQueueUserData(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateTaskQueueUserData(
+	ctx context.Context,
+	request *matchingservice.UpdateTaskQueueUserDataRequest,
+	opts ...grpc.CallOption) (*matchingservice.UpdateTaskQueueUserDataResponse, error) {
+
+	var resp *matchingservice.UpdateTaskQueueUserDataResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UpdateTaskQueueUserData(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateWorkerBuildIdCompatibility(
+	ctx context.Context,
+	request *matchingservice.UpdateWorkerBuildIdCompatibilityRequest,
+	opts ...grpc.CallOption) (*matchingservice.UpdateWorkerBuildIdCompatibilityResponse, error) {
+
+	var resp *matchingservice.UpdateWorkerBuildIdCompatibilityResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UpdateWorkerBuildIdCompatibility(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) GetWorkerBuildIdCompatibility(
+	ctx context.Context,
+	request *matchingservice.GetWorkerBuildIdCompatibilityRequest,
+	opts ...grpc.CallOption) (*matchingservice.GetWorkerBuildIdCompatibilityResponse, error) {
+
+	var resp *matchingservice.GetWorkerBuildIdCompatibilityResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetWorkerBuildIdCompatibility(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) EvictStickyTaskQueue(
+	ctx context.Context,
+	request *matchingservice.EvictStickyTaskQueueRequest,
+	opts ...grpc.CallOption) (*matchingservice.EvictStickyTaskQueueResponse, error) {
+
+	var resp *matchingservice.EvictStickyTaskQueueResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.EvictStickyTaskQueue(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) GetHostProfile(
+	ctx context.Context,
+	request *matchingservice.GetHostProfileRequest,
+	opts ...grpc.CallOption) (*matchingservice.GetHostProfileResponse, error) {
+
+	var resp *matchingservice.GetHostProfileResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetHostProfile(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
@@ -38,7 +38,6 @@ import (
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/headers"
 	tlog "go.temporal.io/server/common/log"
-	"go.temporal.io/server/common/log/tag"
 	_ "go.temporal.io/server/common/persistence/sql/sqlplugin/mysql"      // needed to load mysql plugin
 	_ "go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql" // needed to load postgresql plugin
 	"go.temporal.io/server/temporal"
@@ -131,10 +130,15 @@ func buildCLI() *cli.App {
 
 				logger := tlog.NewZapLogger(tlog.BuildZapLogger(cfg.Log))
 
-				dynamicConfigClient, err := dynamicconfig.NewFileBasedClient(&cfg.DynamicConfigClient, logger, temporal.InterruptCh())
-				if err != nil {
-					logger.Info("Unable to create file based dynamic config client, use no-op config client instead.", tag.Error(err))
+				var dynamicConfigClient dynamicconfig.Client
+				if cfg.DynamicConfigClient.Filepath == "" {
+					logger.Warn("Dynamic config file is not configured, use default values.")
 					dynamicConfigClient = dynamicconfig.NewNoopClient()
+				} else {
+					dynamicConfigClient, err = dynamicconfig.NewFileBasedClient(&cfg.DynamicConfigClient, logger, temporal.InterruptCh())
+					if err != nil {
+						return cli.Exit(fmt.Sprintf("Unable to create dynamic config client: %v.", err), 1)
+					}
 				}
 
 				authorizer, err := authorization.GetAuthorizerFromConfig(
@@ -145,6 +149,11 @@ func buildCLI() *cli.App {
 				if err != nil {
 					return cli.Exit(fmt.Sprintf("Unable to instantiate claim mapper: %v.", err), 1)
 				}
+
+				payloadRedactor, err := authorization.GetPayloadRedactorFromConfig(&cfg.Global.Authorization)
+				if err != nil {
+					return cli.Exit(fmt.Sprintf("Unable to instantiate payload redactor: %v.", err), 1)
+				}
 				s := temporal.NewServer(
 					temporal.ForServices(services),
 					temporal.WithConfig(cfg),
@@ -155,6 +164,7 @@ func buildCLI() *cli.App {
 					temporal.WithClaimMapper(func(cfg *config.Config) authorization.ClaimMapper {
 						return claimMapper
 					}),
+					temporal.WithPayloadRedactor(payloadRedactor),
 				)
 
 				err = s.Start()
@@ -0,0 +1,147 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.temporal.io/server/common/config"
+)
+
+const (
+	kafkaRESTContentType    = "application/vnd.kafka.json.v2+json"
+	kafkaRESTAcceptType     = "application/vnd.kafka.v2+json"
+	kafkaRESTDefaultTimeout = 10 * time.Second
+	kafkaRESTMaxErrorLength = 1024
+)
+
+type (
+	kafkaRESTSink struct {
+		topicURL   string
+		headers    map[string]string
+		httpClient *http.Client
+	}
+
+	kafkaRESTRecord struct {
+		Key   string  `json:"key"`
+		Value *Record `json:"value"`
+	}
+
+	kafkaRESTProduceRequest struct {
+		Records []kafkaRESTRecord `json:"records"`
+	}
+
+	kafkaRESTProduceResponse struct {
+		Offsets []struct {
+			ErrorCode *int32  `json:"error_code"`
+			Error     *string `json:"error"`
+		} `json:"offsets"`
+	}
+)
+
+var _ Sink = (*kafkaRESTSink)(nil)
+
+// NewKafkaRESTSink creates a sink which publishes records to Kafka topic through Kafka REST proxy.
+// Records are keyed by namespace, so records of the same namespace are published to the same partition.
+func NewKafkaRESTSink(cfg *config.KafkaREST) Sink {
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = kafkaRESTDefaultTimeout
+	}
+	return &kafkaRESTSink{
+		topicURL:   fmt.Sprintf("%s/topics/%s", strings.TrimSuffix(cfg.URL, "/"), url.PathEscape(cfg.Topic)),
+		headers:    cfg.Headers,
+		httpClient: &http.Client{Timeout: timeout},
+	}
+}
+
+func (s *kafkaRESTSink) Publish(ctx context.Context, records []*Record) error {
+	produceRequest := kafkaRESTProduceRequest{
+		Records: make([]kafkaRESTRecord, 0, len(records)),
+	}
+	for _, record := range records {
+		produceRequest.Records = append(produceRequest.Records, kafkaRESTRecord{
+			Key:   record.Namespace,
+			Value: record,
+		})
+	}
+	body, err := json.Marshal(produceRequest)
+	if err != nil {
+		return &NonRetryableError{Message: fmt.Sprintf("unable to encode records: %v", err)}
+	}
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, s.topicURL, bytes.NewReader(body))
+	if err != nil {
+		return &NonRetryableError{Message: fmt.Sprintf("unable to create request: %v", err)}
+	}
+	for name, value := range s.headers {
+		httpRequest.Header.Set(name, value)
+	}
+	httpRequest.Header.Set("Content-Type", kafkaRESTContentType)
+	httpRequest.Header.Set("Accept", kafkaRESTAcceptType)
+
+	resp, err := s.httpClient.Do(httpRequest)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := ioutil.ReadAll(io.LimitReader(resp.Body, kafkaRESTMaxErrorLength))
+		err := fmt.Errorf("kafka rest proxy responded with status %v: %s", resp.StatusCode, respBody)
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError {
+			return err
+		}
+		return &NonRetryableError{Message: err.Error()}
+	}
+
+	var produceResponse kafkaRESTProduceResponse
+	if err := json.NewDecoder(resp.Body).Decode(&produceResponse); err != nil {
+		return fmt.Errorf("unable to decode kafka rest proxy response: %v", err)
+	}
+	for _, offset := range produceResponse.Offsets {
+		if offset.ErrorCode != nil {
+			message := ""
+			if offset.Error != nil {
+				message = *offset.Error
+			}
+			return fmt.Errorf("kafka rest proxy failed to publish record with error code %v: %v", *offset.ErrorCode, message)
+		}
+	}
+	return nil
+}
+
+func (s *kafkaRESTSink) Close() error {
+	return nil
+}
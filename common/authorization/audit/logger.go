@@ -0,0 +1,276 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -copyright_file ../../../LICENSE -package $GOPACKAGE -source $GOFILE -destination logger_mock.go
+
+package audit
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+)
+
+const (
+	defaultBufferSize = 10000
+	batchSize         = 100
+	flushInterval     = time.Second
+
+	publishRetryInitialInterval    = 100 * time.Millisecond
+	publishRetryMaxInterval        = 10 * time.Second
+	publishRetryExpirationInterval = time.Minute
+	shutdownPublishTimeout         = 5 * time.Second
+)
+
+type (
+	// Logger asynchronously writes audit records to the sink
+	Logger interface {
+		common.Daemon
+		// Log adds record to the buffer, record is dropped if buffer is full.
+		// Allowed records are sampled, denied records are always logged.
+		Log(record *Record)
+	}
+
+	loggerImpl struct {
+		status       int32
+		sink         Sink
+		samplingRate dynamicconfig.FloatPropertyFnWithNamespaceFilter
+		retryPolicy  backoff.RetryPolicy
+		metricsScope metrics.Scope
+		logger       log.Logger
+
+		recordsCh  chan *Record
+		ctx        context.Context
+		cancel     context.CancelFunc
+		shutdownWG sync.WaitGroup
+	}
+
+	noopLogger struct{}
+)
+
+var _ Logger = (*loggerImpl)(nil)
+var _ Logger = (*noopLogger)(nil)
+
+// NewLogger creates audit logger from config, nil config disables audit logging
+func NewLogger(
+	cfg *config.AuditLog,
+	samplingRate dynamicconfig.FloatPropertyFnWithNamespaceFilter,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) (Logger, error) {
+	if cfg == nil {
+		return NewNoopLogger(), nil
+	}
+
+	var sink Sink
+	switch cfg.Sink {
+	case config.AuditLogSinkStdout:
+		sink = NewStdoutSink()
+	case config.AuditLogSinkFile:
+		fileSink, err := NewFileSink(cfg.File)
+		if err != nil {
+			return nil, err
+		}
+		sink = fileSink
+	case config.AuditLogSinkKafka:
+		sink = NewKafkaRESTSink(cfg.Kafka)
+	default:
+		return nil, fmt.Errorf("unknown audit log sink: %q", cfg.Sink)
+	}
+	return newLogger(cfg, sink, samplingRate, metricsClient, logger), nil
+}
+
+// NewNoopLogger creates audit logger which discards all records
+func NewNoopLogger() Logger {
+	return &noopLogger{}
+}
+
+func newLogger(
+	cfg *config.AuditLog,
+	sink Sink,
+	samplingRate dynamicconfig.FloatPropertyFnWithNamespaceFilter,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) *loggerImpl {
+	bufferSize := cfg.BufferSize
+	if bufferSize <= 0 {
+		bufferSize = defaultBufferSize
+	}
+
+	retryPolicy := backoff.NewExponentialRetryPolicy(publishRetryInitialInterval)
+	retryPolicy.SetMaximumInterval(publishRetryMaxInterval)
+	retryPolicy.SetExpirationInterval(publishRetryExpirationInterval)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &loggerImpl{
+		status:       common.DaemonStatusInitialized,
+		sink:         sink,
+		samplingRate: samplingRate,
+		retryPolicy:  retryPolicy,
+		metricsScope: metricsClient.Scope(metrics.AuthorizationAuditLogScope),
+		logger:       logger,
+		recordsCh:    make(chan *Record, bufferSize),
+		ctx:          ctx,
+		cancel:       cancel,
+	}
+}
+
+func (l *loggerImpl) Start() {
+	if !atomic.CompareAndSwapInt32(&l.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+
+	l.shutdownWG.Add(1)
+	go l.publishLoop()
+	l.logger.Info("Audit logger started.")
+}
+
+func (l *loggerImpl) Stop() {
+	if !atomic.CompareAndSwapInt32(&l.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+
+	l.cancel()
+	if success := common.AwaitWaitGroup(&l.shutdownWG, 2*shutdownPublishTimeout); !success {
+		l.logger.Warn("Audit logger timed out on shutdown.")
+	}
+	if err := l.sink.Close(); err != nil {
+		l.logger.Warn("Unable to close audit log sink.", tag.Error(err))
+	}
+	l.logger.Info("Audit logger stopped.")
+}
+
+func (l *loggerImpl) Log(record *Record) {
+	if record.Decision == DecisionAllow && !l.sampled(record.Namespace) {
+		return
+	}
+
+	select {
+	case l.recordsCh <- record:
+	default:
+		l.metricsScope.IncCounter(metrics.AuditLogDropped)
+	}
+}
+
+func (l *loggerImpl) sampled(namespace string) bool {
+	if l.samplingRate == nil {
+		return true
+	}
+	rate := l.samplingRate(namespace)
+	return rate >= 1 || rand.Float64() < rate
+}
+
+func (l *loggerImpl) publishLoop() {
+	defer l.shutdownWG.Done()
+
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*Record, 0, batchSize)
+	for {
+		select {
+		case <-l.ctx.Done():
+			l.drain(batch)
+			return
+		case record := <-l.recordsCh:
+			batch = append(batch, record)
+			if len(batch) >= batchSize {
+				l.publish(l.ctx, batch)
+				batch = make([]*Record, 0, batchSize)
+			}
+		case <-ticker.C:
+			if len(batch) > 0 {
+				l.publish(l.ctx, batch)
+				batch = make([]*Record, 0, batchSize)
+			}
+		}
+	}
+}
+
+// drain publishes buffered records once without retries
+func (l *loggerImpl) drain(batch []*Record) {
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownPublishTimeout)
+	defer cancel()
+
+	for {
+		select {
+		case record := <-l.recordsCh:
+			batch = append(batch, record)
+			if len(batch) < batchSize {
+				continue
+			}
+		default:
+		}
+
+		if len(batch) == 0 {
+			return
+		}
+		if err := l.publishOnce(ctx, batch); err != nil {
+			l.metricsScope.AddCounter(metrics.AuditLogDropped, int64(len(batch)))
+		}
+		if ctx.Err() != nil {
+			l.metricsScope.AddCounter(metrics.AuditLogDropped, int64(len(l.recordsCh)))
+			return
+		}
+		batch = make([]*Record, 0, batchSize)
+	}
+}
+
+func (l *loggerImpl) publish(ctx context.Context, batch []*Record) {
+	op := func() error {
+		return l.publishOnce(ctx, batch)
+	}
+	isRetryable := func(err error) bool {
+		_, nonRetryable := err.(*NonRetryableError)
+		return !nonRetryable && ctx.Err() == nil
+	}
+	if err := backoff.Retry(op, l.retryPolicy, isRetryable); err != nil {
+		l.metricsScope.AddCounter(metrics.AuditLogDropped, int64(len(batch)))
+		l.logger.Error("Unable to write audit records.", tag.Number(int64(len(batch))), tag.Error(err))
+	}
+}
+
+func (l *loggerImpl) publishOnce(ctx context.Context, batch []*Record) error {
+	if err := l.sink.Publish(ctx, batch); err != nil {
+		l.metricsScope.IncCounter(metrics.AuditLogFailures)
+		return err
+	}
+	l.metricsScope.AddCounter(metrics.AuditLogRecords, int64(len(batch)))
+	return nil
+}
+
+func (n *noopLogger) Start()             {}
+func (n *noopLogger) Stop()              {}
+func (n *noopLogger) Log(record *Record) {}
@@ -0,0 +1,139 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+)
+
+type (
+	loggerSuite struct {
+		suite.Suite
+
+		controller *gomock.Controller
+		mockSink   *MockSink
+	}
+)
+
+func TestLoggerSuite(t *testing.T) {
+	suite.Run(t, new(loggerSuite))
+}
+
+func (s *loggerSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.mockSink = NewMockSink(s.controller)
+}
+
+func (s *loggerSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *loggerSuite) newLogger(cfg *config.AuditLog, samplingRate float64) *loggerImpl {
+	l := newLogger(
+		cfg,
+		s.mockSink,
+		func(namespace string) float64 { return samplingRate },
+		metrics.NewNoopMetricsClient(),
+		log.NewNoopLogger(),
+	)
+	retryPolicy := backoff.NewExponentialRetryPolicy(time.Millisecond)
+	retryPolicy.SetMaximumAttempts(2)
+	l.retryPolicy = retryPolicy
+	return l
+}
+
+func (s *loggerSuite) TestLog_FlushedOnShutdown() {
+	l := s.newLogger(&config.AuditLog{}, 1)
+	published := make(chan []*Record, 1)
+	s.mockSink.EXPECT().Publish(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, records []*Record) error {
+		published <- records
+		return nil
+	})
+	s.mockSink.EXPECT().Close().Return(nil)
+
+	l.Start()
+	l.Log(&Record{API: "api", Decision: DecisionDeny})
+	l.Stop()
+	s.Equal([]*Record{{API: "api", Decision: DecisionDeny}}, <-published)
+}
+
+func (s *loggerSuite) TestLog_Sampling() {
+	l := s.newLogger(&config.AuditLog{}, 0)
+	l.Log(&Record{Decision: DecisionAllow})
+	s.Len(l.recordsCh, 0)
+
+	// denied requests are recorded regardless of sampling rate
+	l.Log(&Record{Decision: DecisionDeny})
+	s.Len(l.recordsCh, 1)
+
+	l = s.newLogger(&config.AuditLog{}, 1)
+	l.Log(&Record{Decision: DecisionAllow})
+	s.Len(l.recordsCh, 1)
+}
+
+func (s *loggerSuite) TestLog_BufferFull() {
+	l := s.newLogger(&config.AuditLog{BufferSize: 1}, 1)
+	l.Log(&Record{Decision: DecisionDeny})
+	l.Log(&Record{Decision: DecisionDeny})
+	s.Len(l.recordsCh, 1)
+}
+
+func (s *loggerSuite) TestPublish_Retry() {
+	l := s.newLogger(&config.AuditLog{}, 1)
+	batch := []*Record{{Decision: DecisionDeny}}
+
+	gomock.InOrder(
+		s.mockSink.EXPECT().Publish(gomock.Any(), batch).Return(errors.New("transient error")),
+		s.mockSink.EXPECT().Publish(gomock.Any(), batch).Return(nil),
+	)
+	l.publish(context.Background(), batch)
+
+	s.mockSink.EXPECT().Publish(gomock.Any(), batch).Return(&NonRetryableError{Message: "bad request"})
+	l.publish(context.Background(), batch)
+}
+
+func (s *loggerSuite) TestNewLogger() {
+	l, err := NewLogger(nil, nil, metrics.NewNoopMetricsClient(), log.NewNoopLogger())
+	s.NoError(err)
+	s.IsType(&noopLogger{}, l)
+
+	_, err = NewLogger(&config.AuditLog{Sink: "unknown"}, nil, metrics.NewNoopMetricsClient(), log.NewNoopLogger())
+	s.Error(err)
+
+	l, err = NewLogger(&config.AuditLog{Sink: config.AuditLogSinkStdout}, nil, metrics.NewNoopMetricsClient(), log.NewNoopLogger())
+	s.NoError(err)
+	s.IsType(&writerSink{}, l.(*loggerImpl).sink)
+}
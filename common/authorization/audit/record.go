@@ -0,0 +1,53 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package audit
+
+import (
+	"time"
+)
+
+const (
+	// DecisionAllow is the decision of request which was allowed
+	DecisionAllow = "allow"
+	// DecisionDeny is the decision of request which failed claim mapping or authorization
+	DecisionDeny = "deny"
+)
+
+type (
+	// Record is the audit record of authorization decision
+	Record struct {
+		Time time.Time `json:"time"`
+		// Caller is the subject of caller's claims
+		Caller string `json:"caller,omitempty"`
+		// TLSSubject is the subject of caller's TLS client certificate
+		TLSSubject string `json:"tlsSubject,omitempty"`
+		Namespace  string `json:"namespace,omitempty"`
+		API        string `json:"api"`
+		Decision   string `json:"decision"`
+		Reason     string `json:"reason,omitempty"`
+		// Enforced is false if the decision was not enforced because of authorization log only mode
+		Enforced bool `json:"enforced"`
+	}
+)
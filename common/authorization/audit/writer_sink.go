@@ -0,0 +1,90 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+const (
+	fileSinkPermissions = 0640
+)
+
+type (
+	// writerSink writes records as JSON lines to the writer
+	writerSink struct {
+		sync.Mutex
+		writer io.Writer
+		closer io.Closer
+	}
+)
+
+var _ Sink = (*writerSink)(nil)
+
+// NewStdoutSink creates a sink which writes records as JSON lines to stdout
+func NewStdoutSink() Sink {
+	return NewWriterSink(os.Stdout)
+}
+
+// NewWriterSink creates a sink which writes records as JSON lines to the writer
+func NewWriterSink(writer io.Writer) Sink {
+	return &writerSink{writer: writer}
+}
+
+// NewFileSink creates a sink which appends records as JSON lines to the file, file is created if it doesn't exist
+func NewFileSink(path string) (Sink, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, fileSinkPermissions)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open audit log file: %w", err)
+	}
+	return &writerSink{writer: file, closer: file}, nil
+}
+
+func (s *writerSink) Publish(_ context.Context, records []*Record) error {
+	s.Lock()
+	defer s.Unlock()
+
+	buf := bufio.NewWriter(s.writer)
+	encoder := json.NewEncoder(buf)
+	for _, record := range records {
+		if err := encoder.Encode(record); err != nil {
+			return &NonRetryableError{Message: fmt.Sprintf("unable to encode record: %v", err)}
+		}
+	}
+	return buf.Flush()
+}
+
+func (s *writerSink) Close() error {
+	if s.closer == nil {
+		return nil
+	}
+	return s.closer.Close()
+}
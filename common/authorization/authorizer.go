@@ -87,6 +87,8 @@ func GetAuthorizerFromConfig(config *config.Authorization) (Authorizer, error) {
 		return NewNoopAuthorizer(), nil
 	case "default":
 		return NewDefaultAuthorizer(), nil
+	case "policy":
+		return NewPolicyAuthorizer(config.Policy)
 	}
 	return nil, fmt.Errorf("unknown authorizer: %s", config.Authorizer)
 }
@@ -76,7 +76,22 @@ func GetClaimMapperFromConfig(config *config.Authorization, logger log.Logger) (
 	case "":
 		return NewNoopClaimMapper(), nil
 	case "default":
+		if len(config.JWTIssuers) > 0 {
+			return NewMultiIssuerJWTClaimMapper(newJWTIssuers(config.JWTIssuers, logger), config, logger), nil
+		}
 		return NewDefaultJWTClaimMapper(NewDefaultTokenKeyProvider(config, logger), config, logger), nil
 	}
 	return nil, fmt.Errorf("unknown claim mapper: %s", config.ClaimMapper)
 }
+
+func newJWTIssuers(configs []config.JWTIssuer, logger log.Logger) []*JWTIssuer {
+	issuers := make([]*JWTIssuer, 0, len(configs))
+	for _, cfg := range configs {
+		issuers = append(issuers, &JWTIssuer{
+			Name:        cfg.Issuer,
+			Audiences:   cfg.Audiences,
+			KeyProvider: NewIssuerTokenKeyProvider(cfg, logger),
+		})
+	}
+	return issuers
+}
@@ -45,6 +45,14 @@ var resultAllow = Result{Decision: DecisionAllow}
 var resultDeny = Result{Decision: DecisionDeny}
 
 func (a *defaultAuthorizer) Authorize(_ context.Context, claims *Claims, target *CallTarget) (Result, error) {
+	// APIs which expose internals of the server processes have no namespace,
+	// so they must be checked before calls with no namespace are let through.
+	if IsAdminOnlyAPI(target.APIName) {
+		if claims != nil && claims.System >= RoleAdmin {
+			return resultAllow, nil
+		}
+		return resultDeny, nil
+	}
 
 	// TODO: This is a temporary workaround to allow calls to system namespace and
 	// calls with no namespace to pass through. When handling of mTLS data is added,
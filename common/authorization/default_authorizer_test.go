@@ -74,6 +74,9 @@ var (
 		APIName:   "/temporal.api.workflowservice.v1.WorkflowService/DescribeNamespace",
 		Namespace: "BAR",
 	}
+	targetGetHostProfile = CallTarget{
+		APIName: "/temporal.server.api.adminservice.v1.AdminService/GetHostProfile",
+	}
 )
 
 type (
@@ -106,6 +109,17 @@ func (s *defaultAuthorizerSuite) TestSystemAdminAuthZ() {
 	s.NoError(err)
 	s.Equal(DecisionAllow, result.Decision)
 }
+func (s *defaultAuthorizerSuite) TestAdminOnlyAPIAuthZ() {
+	result, err := s.authorizer.Authorize(nil, &claimsSystemAdmin, &targetGetHostProfile)
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+	result, err = s.authorizer.Authorize(nil, &claimsSystemWriter, &targetGetHostProfile)
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+	result, err = s.authorizer.Authorize(nil, nil, &targetGetHostProfile)
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+}
 func (s *defaultAuthorizerSuite) TestSystemWriterAuthZ() {
 	result, err := s.authorizer.Authorize(nil, &claimsSystemWriter, &targetFooBar)
 	s.NoError(err)
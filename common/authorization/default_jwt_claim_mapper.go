@@ -33,12 +33,15 @@ import (
 
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
 )
 
 const (
 	defaultPermissionsClaimName = "permissions"
 	authorizationBearer         = "bearer"
 	headerSubject               = "sub"
+	headerIssuer                = "iss"
+	headerAudience              = "aud"
 	permissionScopeSystem       = "system"
 	permissionRead              = "read"
 	permissionWrite             = "write"
@@ -46,22 +49,46 @@ const (
 	permissionAdmin             = "admin"
 )
 
+// JWTIssuer is a trusted issuer of JWT tokens
+type JWTIssuer struct {
+	// Name is the expected value of the "iss" claim
+	Name string
+	// Audiences lists accepted values of the "aud" claim. Empty list disables audience validation.
+	Audiences   []string
+	KeyProvider TokenKeyProvider
+}
+
 // Default claim mapper that gives system level admin permission to everybody
 type defaultJWTClaimMapper struct {
 	keyProvider          TokenKeyProvider
+	issuers              map[string]*JWTIssuer
 	logger               log.Logger
 	permissionsClaimName string
 }
 
 func NewDefaultJWTClaimMapper(provider TokenKeyProvider, cfg *config.Authorization, logger log.Logger) ClaimMapper {
-	claimName := cfg.PermissionsClaimName
-	if claimName == "" {
-		claimName = defaultPermissionsClaimName
+	return &defaultJWTClaimMapper{keyProvider: provider, logger: logger, permissionsClaimName: permissionsClaimName(cfg)}
+}
+
+// NewMultiIssuerJWTClaimMapper creates a claim mapper that validates each token against
+// the keys and audiences of the issuer named in its "iss" claim
+func NewMultiIssuerJWTClaimMapper(issuers []*JWTIssuer, cfg *config.Authorization, logger log.Logger) ClaimMapper {
+	issuerMap := make(map[string]*JWTIssuer, len(issuers))
+	for _, issuer := range issuers {
+		issuerMap[issuer.Name] = issuer
+	}
+	return &defaultJWTClaimMapper{issuers: issuerMap, logger: logger, permissionsClaimName: permissionsClaimName(cfg)}
+}
+
+func permissionsClaimName(cfg *config.Authorization) string {
+	if cfg.PermissionsClaimName == "" {
+		return defaultPermissionsClaimName
 	}
-	return &defaultJWTClaimMapper{keyProvider: provider, logger: logger, permissionsClaimName: claimName}
+	return cfg.PermissionsClaimName
 }
 
 var _ ClaimMapper = (*defaultJWTClaimMapper)(nil)
+var _ metricsClientAware = (*defaultJWTClaimMapper)(nil)
 
 func (a *defaultJWTClaimMapper) GetClaims(authInfo *AuthInfo) (*Claims, error) {
 
@@ -78,7 +105,13 @@ func (a *defaultJWTClaimMapper) GetClaims(authInfo *AuthInfo) (*Claims, error) {
 	if !strings.EqualFold(parts[0], authorizationBearer) {
 		return nil, serviceerror.NewPermissionDenied("unexpected name in authorization token", "")
 	}
-	jwtClaims, err := parseJWTWithAudience(parts[1], a.keyProvider, authInfo.Audience)
+	var jwtClaims jwt.MapClaims
+	var err error
+	if a.issuers != nil {
+		jwtClaims, err = a.parseIssuerJWT(parts[1], authInfo.Audience)
+	} else {
+		jwtClaims, err = parseJWTWithAudience(parts[1], a.keyProvider, authInfo.Audience)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -97,6 +130,58 @@ func (a *defaultJWTClaimMapper) GetClaims(authInfo *AuthInfo) (*Claims, error) {
 	return &claims, nil
 }
 
+func (a *defaultJWTClaimMapper) setMetricsClient(metricsClient metrics.Client) {
+	if provider, ok := a.keyProvider.(metricsClientAware); ok {
+		provider.setMetricsClient(metricsClient)
+	}
+	for _, issuer := range a.issuers {
+		if provider, ok := issuer.KeyProvider.(metricsClientAware); ok {
+			provider.setMetricsClient(metricsClient)
+		}
+	}
+}
+
+// parseIssuerJWT validates the token with the signing keys of its issuer and checks that
+// the token is intended for one of the audiences accepted from that issuer
+func (a *defaultJWTClaimMapper) parseIssuerJWT(tokenString string, audience string) (jwt.MapClaims, error) {
+	var issuer *JWTIssuer
+	claims, err := parseJWTWithKeyFunc(tokenString, audience, func(token *jwt.Token) (TokenKeyProvider, error) {
+		claims, ok := token.Claims.(jwt.MapClaims)
+		if !ok {
+			return nil, fmt.Errorf("malformed token - unexpected claims")
+		}
+		name, ok := claims[headerIssuer].(string)
+		if !ok {
+			return nil, fmt.Errorf("malformed token - no \"iss\" claim")
+		}
+		issuer, ok = a.issuers[name]
+		if !ok {
+			return nil, fmt.Errorf("untrusted token issuer: %s", name)
+		}
+		return issuer.KeyProvider, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(issuer.Audiences) == 0 {
+		return claims, nil
+	}
+	tokenAudiences, err := jwt.ParseClaimStrings(claims[headerAudience])
+	if err != nil {
+		return nil, serviceerror.NewPermissionDenied("malformed \"aud\" claim", "")
+	}
+	for _, tokenAudience := range tokenAudiences {
+		for _, accepted := range issuer.Audiences {
+			if tokenAudience == accepted {
+				return claims, nil
+			}
+		}
+	}
+	return nil, serviceerror.NewPermissionDenied(
+		fmt.Sprintf("token audience is not accepted for issuer %s", issuer.Name), "")
+}
+
 func (a *defaultJWTClaimMapper) extractPermissions(permissions []interface{}, claims *Claims) error {
 	for _, permission := range permissions {
 		p, ok := permission.(string)
@@ -129,6 +214,16 @@ func parseJWT(tokenString string, keyProvider TokenKeyProvider) (jwt.MapClaims,
 }
 
 func parseJWTWithAudience(tokenString string, keyProvider TokenKeyProvider, audience string) (jwt.MapClaims, error) {
+	return parseJWTWithKeyFunc(tokenString, audience, func(*jwt.Token) (TokenKeyProvider, error) {
+		return keyProvider, nil
+	})
+}
+
+func parseJWTWithKeyFunc(
+	tokenString string,
+	audience string,
+	getKeyProvider func(token *jwt.Token) (TokenKeyProvider, error),
+) (jwt.MapClaims, error) {
 
 	var parser *jwt.Parser
 	if strings.TrimSpace(audience) == "" {
@@ -143,6 +238,10 @@ func parseJWTWithAudience(tokenString string, keyProvider TokenKeyProvider, audi
 			return nil, fmt.Errorf("malformed token - no \"kid\" header")
 		}
 		alg := token.Header["alg"].(string)
+		keyProvider, err := getKeyProvider(token)
+		if err != nil {
+			return nil, err
+		}
 		switch token.Method.(type) {
 		case *jwt.SigningMethodHMAC:
 			return keyProvider.HmacKey(alg, kid)
@@ -241,6 +241,49 @@ func (s *defaultClaimMapperSuite) TestIgnoreAudience() {
 	s.NoError(err)
 }
 
+func (s *defaultClaimMapperSuite) TestMultiIssuer() {
+	otherIssuerKeys := newTokenGenerator()
+	claimMapper := NewMultiIssuerJWTClaimMapper([]*JWTIssuer{
+		{Name: "test", Audiences: []string{"test-audience"}, KeyProvider: s.tokenGenerator},
+		{Name: "other", KeyProvider: otherIssuerKeys},
+	}, s.config, s.logger)
+
+	tokenString, err := s.tokenGenerator.generateRSAToken(testSubject, permissionsAdmin, errorTestOptionNoError)
+	s.NoError(err)
+	claims, err := claimMapper.GetClaims(&AuthInfo{AuthToken: AddBearer(tokenString)})
+	s.NoError(err)
+	s.Equal(testSubject, claims.Subject)
+	s.Equal(RoleAdmin, claims.System)
+
+	// token claims to be issued by "test" but is signed with keys of another issuer
+	tokenString, err = otherIssuerKeys.generateRSAToken(testSubject, permissionsAdmin, errorTestOptionNoError)
+	s.NoError(err)
+	_, err = claimMapper.GetClaims(&AuthInfo{AuthToken: AddBearer(tokenString)})
+	s.Error(err)
+}
+
+func (s *defaultClaimMapperSuite) TestMultiIssuerUntrustedIssuer() {
+	claimMapper := NewMultiIssuerJWTClaimMapper([]*JWTIssuer{
+		{Name: "other", KeyProvider: s.tokenGenerator},
+	}, s.config, s.logger)
+
+	tokenString, err := s.tokenGenerator.generateRSAToken(testSubject, permissionsAdmin, errorTestOptionNoError)
+	s.NoError(err)
+	_, err = claimMapper.GetClaims(&AuthInfo{AuthToken: AddBearer(tokenString)})
+	s.Error(err)
+}
+
+func (s *defaultClaimMapperSuite) TestMultiIssuerAudienceNotAccepted() {
+	claimMapper := NewMultiIssuerJWTClaimMapper([]*JWTIssuer{
+		{Name: "test", Audiences: []string{"machine-audience"}, KeyProvider: s.tokenGenerator},
+	}, s.config, s.logger)
+
+	tokenString, err := s.tokenGenerator.generateECDSAToken(testSubject, permissionsAdmin, errorTestOptionNoError)
+	s.NoError(err)
+	_, err = claimMapper.GetClaims(&AuthInfo{AuthToken: AddBearer(tokenString)})
+	s.Error(err)
+}
+
 func (s *defaultClaimMapperSuite) testGetClaimMapperFromConfig(name string, valid bool, cmType reflect.Type) {
 
 	cfg := config.Authorization{}
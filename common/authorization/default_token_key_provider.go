@@ -32,6 +32,7 @@ import (
 	"net/http"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"gopkg.in/square/go-jose.v2"
@@ -39,23 +40,51 @@ import (
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+)
+
+const (
+	// minimal interval between key refreshes triggered by tokens signed with an unknown key
+	minOnDemandRefreshInterval = 30 * time.Second
+	keySourceRequestTimeout    = 10 * time.Second
 )
 
 // Default token key provider
 type defaultTokenKeyProvider struct {
-	config   config.JWTKeyProvider
-	rsaKeys  map[string]*rsa.PublicKey
-	ecKeys   map[string]*ecdsa.PublicKey
-	keysLock sync.RWMutex
-	ticker   *time.Ticker
-	logger   log.Logger
-	stop     chan bool
+	config     config.JWTKeyProvider
+	issuer     string
+	rsaKeys    map[string]*rsa.PublicKey
+	ecKeys     map[string]*ecdsa.PublicKey
+	keysLock   sync.RWMutex
+	httpClient *http.Client
+	ticker     *time.Ticker
+	logger     log.Logger
+	stop       chan bool
+
+	refreshLock sync.Mutex
+	lastRefresh time.Time
+	metrics     atomic.Value // metrics.Client
 }
 
 var _ TokenKeyProvider = (*defaultTokenKeyProvider)(nil)
 
 func NewDefaultTokenKeyProvider(cfg *config.Authorization, logger log.Logger) *defaultTokenKeyProvider {
-	provider := defaultTokenKeyProvider{config: cfg.JWTKeyProvider, logger: logger}
+	return newTokenKeyProvider(cfg.JWTKeyProvider, "", logger)
+}
+
+// NewIssuerTokenKeyProvider creates a token key provider for keys of a single JWT issuer
+func NewIssuerTokenKeyProvider(cfg config.JWTIssuer, logger log.Logger) *defaultTokenKeyProvider {
+	return newTokenKeyProvider(cfg.KeyProvider, cfg.Issuer, log.With(logger, tag.NewStringTag("token-issuer", cfg.Issuer)))
+}
+
+func newTokenKeyProvider(cfg config.JWTKeyProvider, issuer string, logger log.Logger) *defaultTokenKeyProvider {
+	provider := defaultTokenKeyProvider{
+		config:     cfg,
+		issuer:     issuer,
+		logger:     logger,
+		httpClient: &http.Client{Timeout: keySourceRequestTimeout},
+	}
+	provider.metrics.Store(metrics.NewNoopMetricsClient())
 	provider.initialize()
 	return &provider
 }
@@ -64,7 +93,7 @@ func (a *defaultTokenKeyProvider) initialize() {
 	a.rsaKeys = make(map[string]*rsa.PublicKey)
 	a.ecKeys = make(map[string]*ecdsa.PublicKey)
 	if len(a.config.KeySourceURIs) > 0 {
-		err := a.updateKeys()
+		err := a.refreshKeys()
 		if err != nil {
 			a.logger.Error("error during initial retrieval of token keys: ", tag.Error(err))
 		}
@@ -77,19 +106,33 @@ func (a *defaultTokenKeyProvider) initialize() {
 }
 
 func (a *defaultTokenKeyProvider) Close() {
+	if a.ticker == nil {
+		return
+	}
 	a.ticker.Stop()
 	a.stop <- true
 	close(a.stop)
 }
 
+// setMetricsClient switches key refresh metrics to the client of the hosting service
+func (a *defaultTokenKeyProvider) setMetricsClient(metricsClient metrics.Client) {
+	a.metrics.Store(metricsClient)
+}
+
+func (a *defaultTokenKeyProvider) metricsScope() metrics.Scope {
+	return a.metrics.Load().(metrics.Client).
+		Scope(metrics.AuthorizationTokenKeyProviderScope, metrics.TokenIssuerTag(a.issuer))
+}
+
 func (a *defaultTokenKeyProvider) RsaKey(alg string, kid string) (*rsa.PublicKey, error) {
 	if !strings.EqualFold(alg, "rs256") {
 		return nil, fmt.Errorf("unexpected signing algorithm: %s", alg)
 	}
 
-	a.keysLock.RLock()
-	key, found := a.rsaKeys[kid]
-	a.keysLock.RUnlock()
+	key, found := a.rsaKey(kid)
+	if !found && a.refreshOnKeyNotFound() {
+		key, found = a.rsaKey(kid)
+	}
 	if !found {
 		return nil, fmt.Errorf("RSA key not found for key ID: %s", kid)
 	}
@@ -101,24 +144,60 @@ func (a *defaultTokenKeyProvider) EcdsaKey(alg string, kid string) (*ecdsa.Publi
 		return nil, fmt.Errorf("unexpected signing algorithm: %s", alg)
 	}
 
-	a.keysLock.RLock()
-	key, found := a.ecKeys[kid]
-	a.keysLock.RUnlock()
+	key, found := a.ecdsaKey(kid)
+	if !found && a.refreshOnKeyNotFound() {
+		key, found = a.ecdsaKey(kid)
+	}
 	if !found {
 		return nil, fmt.Errorf("ECDSA key not found for key ID: %s", kid)
 	}
 	return key, nil
 }
 
+func (a *defaultTokenKeyProvider) rsaKey(kid string) (*rsa.PublicKey, bool) {
+	a.keysLock.RLock()
+	defer a.keysLock.RUnlock()
+	key, found := a.rsaKeys[kid]
+	return key, found
+}
+
+func (a *defaultTokenKeyProvider) ecdsaKey(kid string) (*ecdsa.PublicKey, bool) {
+	a.keysLock.RLock()
+	defer a.keysLock.RUnlock()
+	key, found := a.ecKeys[kid]
+	return key, found
+}
+
+// refreshOnKeyNotFound picks up keys rotated in since the last scheduled refresh.
+// Returns true if keys were refreshed.
+func (a *defaultTokenKeyProvider) refreshOnKeyNotFound() bool {
+	a.metricsScope().IncCounter(metrics.TokenKeyNotFound)
+	if len(a.config.KeySourceURIs) == 0 {
+		return false
+	}
+
+	a.refreshLock.Lock()
+	defer a.refreshLock.Unlock()
+	if time.Since(a.lastRefresh) < minOnDemandRefreshInterval {
+		return false
+	}
+
+	if err := a.refreshKeysLocked(); err != nil {
+		a.logger.Error("error while refreshing token keys: ", tag.Error(err))
+		return false
+	}
+	return true
+}
+
 func (a *defaultTokenKeyProvider) timerCallback() {
 	for {
 		select {
 		case <-a.stop:
-			break
+			return
 		case <-a.ticker.C:
 		}
 		if len(a.config.KeySourceURIs) > 0 {
-			err := a.updateKeys()
+			err := a.refreshKeys()
 			if err != nil {
 				a.logger.Error("error while refreshing token keys: ", tag.Error(err))
 			}
@@ -126,6 +205,27 @@ func (a *defaultTokenKeyProvider) timerCallback() {
 	}
 }
 
+// refreshKeys retrieves keys from the key sources, serializing concurrent refreshes
+func (a *defaultTokenKeyProvider) refreshKeys() error {
+	a.refreshLock.Lock()
+	defer a.refreshLock.Unlock()
+	return a.refreshKeysLocked()
+}
+
+func (a *defaultTokenKeyProvider) refreshKeysLocked() error {
+	scope := a.metricsScope()
+	scope.IncCounter(metrics.TokenKeyRefreshRequests)
+	sw := scope.StartTimer(metrics.TokenKeyRefreshLatency)
+	defer sw.Stop()
+
+	a.lastRefresh = time.Now()
+	if err := a.updateKeys(); err != nil {
+		scope.IncCounter(metrics.TokenKeyRefreshFailures)
+		return err
+	}
+	return nil
+}
+
 func (a *defaultTokenKeyProvider) updateKeys() error {
 	if len(a.config.KeySourceURIs) == 0 {
 		return fmt.Errorf("no URIs configured for retrieving token keys")
@@ -142,23 +242,61 @@ func (a *defaultTokenKeyProvider) updateKeys() error {
 	}
 	// swap old keys with the new ones
 	a.keysLock.Lock()
+	rotated := countRotatedKeys(keyIDs(a.rsaKeys, a.ecKeys), keyIDs(rsaKeys, ecKeys))
 	a.rsaKeys = rsaKeys
 	a.ecKeys = ecKeys
 	a.keysLock.Unlock()
+
+	scope := a.metricsScope()
+	scope.UpdateGauge(metrics.TokenKeyCount, float64(len(rsaKeys)+len(ecKeys)))
+	if rotated > 0 {
+		scope.AddCounter(metrics.TokenKeyRotations, int64(rotated))
+		a.logger.Info("token keys rotated", tag.Counter(rotated))
+	}
 	return nil
 }
 
+// countRotatedKeys returns the number of key IDs added or removed between two key sets
+func countRotatedKeys(oldIDs map[string]struct{}, newIDs map[string]struct{}) int {
+	rotated := 0
+	for kid := range newIDs {
+		if _, ok := oldIDs[kid]; !ok {
+			rotated++
+		}
+	}
+	for kid := range oldIDs {
+		if _, ok := newIDs[kid]; !ok {
+			rotated++
+		}
+	}
+	return rotated
+}
+
+func keyIDs(rsaKeys map[string]*rsa.PublicKey, ecKeys map[string]*ecdsa.PublicKey) map[string]struct{} {
+	ids := make(map[string]struct{}, len(rsaKeys)+len(ecKeys))
+	for kid := range rsaKeys {
+		ids["rsa/"+kid] = struct{}{}
+	}
+	for kid := range ecKeys {
+		ids["ec/"+kid] = struct{}{}
+	}
+	return ids
+}
+
 func (a *defaultTokenKeyProvider) updateKeysFromURI(
 	uri string,
 	rsaKeys map[string]*rsa.PublicKey,
 	ecKeys map[string]*ecdsa.PublicKey,
 ) error {
 
-	resp, err := http.Get(uri)
+	resp, err := a.httpClient.Get(uri)
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d while retrieving token keys from %s", resp.StatusCode, uri)
+	}
 
 	jwks := jose.JSONWebKeySet{}
 	err = json.NewDecoder(resp.Body).Decode(&jwks)
@@ -0,0 +1,121 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/square/go-jose.v2"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+)
+
+type (
+	tokenKeyProviderSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		tokenGenerator *tokenGenerator
+		keysLock       sync.Mutex
+		keys           jose.JSONWebKeySet
+		server         *httptest.Server
+	}
+)
+
+func TestTokenKeyProviderSuite(t *testing.T) {
+	s := new(tokenKeyProviderSuite)
+	suite.Run(t, s)
+}
+
+func (s *tokenKeyProviderSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.tokenGenerator = newTokenGenerator()
+	s.setKeys("key-1")
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.keysLock.Lock()
+		defer s.keysLock.Unlock()
+		_ = json.NewEncoder(w).Encode(s.keys)
+	}))
+}
+
+func (s *tokenKeyProviderSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *tokenKeyProviderSuite) setKeys(rsaKeyID string) {
+	s.keysLock.Lock()
+	defer s.keysLock.Unlock()
+	s.keys = jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+		{Key: s.tokenGenerator.rsaPublicKey, KeyID: rsaKeyID, Algorithm: "RS256", Use: "sig"},
+		{Key: s.tokenGenerator.ecdsaPublicKey, KeyID: "ec-key", Algorithm: "ES256", Use: "sig"},
+	}}
+}
+
+func (s *tokenKeyProviderSuite) TestKeysRetrieved() {
+	provider := NewIssuerTokenKeyProvider(config.JWTIssuer{
+		Issuer:      "test",
+		KeyProvider: config.JWTKeyProvider{KeySourceURIs: []string{s.server.URL}},
+	}, log.NewNoopLogger())
+	defer provider.Close()
+
+	rsaKey, err := provider.RsaKey("RS256", "key-1")
+	s.NoError(err)
+	s.Equal(s.tokenGenerator.rsaPublicKey, rsaKey)
+	ecKey, err := provider.EcdsaKey("ES256", "ec-key")
+	s.NoError(err)
+	s.Equal(s.tokenGenerator.ecdsaPublicKey.X, ecKey.X)
+
+	_, err = provider.RsaKey("RS512", "key-1")
+	s.Error(err)
+}
+
+func (s *tokenKeyProviderSuite) TestRefreshOnUnknownKey() {
+	provider := NewIssuerTokenKeyProvider(config.JWTIssuer{
+		Issuer:      "test",
+		KeyProvider: config.JWTKeyProvider{KeySourceURIs: []string{s.server.URL}},
+	}, log.NewNoopLogger())
+	defer provider.Close()
+
+	s.setKeys("key-2")
+	// refresh was just made during initialization
+	_, err := provider.RsaKey("RS256", "key-2")
+	s.Error(err)
+
+	provider.refreshLock.Lock()
+	provider.lastRefresh = time.Now().Add(-minOnDemandRefreshInterval)
+	provider.refreshLock.Unlock()
+	_, err = provider.RsaKey("RS256", "key-2")
+	s.NoError(err)
+	_, err = provider.RsaKey("RS256", "key-1")
+	s.Error(err)
+}
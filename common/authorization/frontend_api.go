@@ -24,6 +24,12 @@
 
 package authorization
 
+import (
+	"strings"
+)
+
+const adminServicePrefix = "/temporal.server.api.adminservice.v1.AdminService/"
+
 var readOnlyNamespaceAPI = map[string]struct{}{
 	"DescribeNamespace":              {},
 	"GetWorkflowExecutionHistory":    {},
@@ -45,6 +51,32 @@ var readOnlyGlobalAPI = map[string]struct{}{
 	"GetClusterInfo":      {},
 }
 
+var workerAPI = map[string]struct{}{
+	"PollWorkflowTaskQueue":            {},
+	"PollActivityTaskQueue":            {},
+	"RespondWorkflowTaskCompleted":     {},
+	"RespondWorkflowTaskFailed":        {},
+	"RespondQueryTaskCompleted":        {},
+	"RecordActivityTaskHeartbeat":      {},
+	"RecordActivityTaskHeartbeatById":  {},
+	"RespondActivityTaskCompleted":     {},
+	"RespondActivityTaskCompletedById": {},
+	"RespondActivityTaskFailed":        {},
+	"RespondActivityTaskFailedById":    {},
+	"RespondActivityTaskCanceled":      {},
+	"RespondActivityTaskCanceledById":  {},
+	"ResetStickyTaskQueue":             {},
+}
+
+// adminOnlyAPI are the admin service APIs which expose internals of the server processes or change cluster-wide settings
+var adminOnlyAPI = map[string]struct{}{
+	"DeleteNamespace":             {},
+	"DescribeVisibilityProcessor": {},
+	"GetHostProfile":              {},
+	"UpdateClusterMetadata":       {},
+	"UpdateNamespaceQuotas":       {},
+}
+
 func IsReadOnlyNamespaceAPI(api string) bool {
 	_, found := readOnlyNamespaceAPI[api]
 	return found
@@ -54,3 +86,19 @@ func IsReadOnlyGlobalAPI(api string) bool {
 	_, found := readOnlyGlobalAPI[api]
 	return found
 }
+
+func IsWorkerAPI(api string) bool {
+	_, found := workerAPI[api]
+	return found
+}
+
+// IsPrivilegedAPI returns true if the full method name is an API of admin service
+func IsPrivilegedAPI(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, adminServicePrefix)
+}
+
+// IsAdminOnlyAPI returns true if the full method name is an admin service API which requires the system admin role
+func IsAdminOnlyAPI(fullMethod string) bool {
+	_, found := adminOnlyAPI[ApiName(fullMethod)]
+	return found && IsPrivilegedAPI(fullMethod)
+}
@@ -28,6 +28,8 @@ import (
 	"context"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"fmt"
+	"time"
 
 	"go.temporal.io/api/serviceerror"
 	"google.golang.org/grpc"
@@ -35,6 +37,8 @@ import (
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
 
+	"go.temporal.io/server/common/authorization/audit"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
@@ -50,6 +54,12 @@ type (
 	JWTAudienceMapper interface {
 		Audience(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo) string
 	}
+
+	// metricsClientAware is implemented by claim mappers and key providers that emit metrics
+	// once the metrics client of the hosting service is available
+	metricsClientAware interface {
+		setMetricsClient(metricsClient metrics.Client)
+	}
 )
 
 const (
@@ -70,10 +80,45 @@ func (a *interceptor) Interceptor(
 	handler grpc.UnaryHandler,
 ) (interface{}, error) {
 
+	ctx, err := a.authorizeRequest(ctx, req, info)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamInterceptor authorizes stream when it is opened. Since no message is received at that point,
+// authorizer is called with nil request.
+func (a *interceptor) StreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+
+	ctx, err := a.authorizeRequest(ss.Context(), nil, &grpc.UnaryServerInfo{FullMethod: info.FullMethod})
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authorizedServerStream{ServerStream: ss, ctx: ctx})
+}
+
+func (a *interceptor) authorizeRequest(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+) (context.Context, error) {
+
 	var claims *Claims
+	var tlsSubject *pkix.Name
+
+	var namespace string
+	requestWithNamespace, ok := req.(hasNamespace)
+	if ok {
+		namespace = requestWithNamespace.GetNamespace()
+	}
 
 	if a.claimMapper != nil && a.authorizer != nil {
-		var tlsSubject *pkix.Name
 		var authHeaders []string
 		var authExtraHeaders []string
 		var tlsConnection *credentials.TLSInfo
@@ -111,8 +156,15 @@ func (a *interceptor) Interceptor(
 			}
 			mappedClaims, err := a.claimMapper.GetClaims(&authInfo)
 			if err != nil {
+				logOnly := a.isLogOnly(namespace)
+				a.audit(nil, tlsSubject, namespace, info.FullMethod, audit.DecisionDeny, err.Error(), !logOnly)
+				if logOnly {
+					a.getMetricsScope(metrics.AuthorizationScope, namespace).IncCounter(metrics.ServiceErrUnauthorizedLogOnlyCounter)
+					a.logWouldReject(namespace, info.FullMethod, err)
+					return ctx, nil
+				}
 				a.logAuthError(err)
-				return nil, errUnauthorized // return a generic error to the caller without disclosing details
+				return ctx, errUnauthorized // return a generic error to the caller without disclosing details
 			}
 			claims = mappedClaims
 			ctx = context.WithValue(ctx, MappedClaims, mappedClaims)
@@ -123,33 +175,46 @@ func (a *interceptor) Interceptor(
 	}
 
 	if a.authorizer != nil {
-		var namespace string
-		requestWithNamespace, ok := req.(hasNamespace)
-		if ok {
-			namespace = requestWithNamespace.GetNamespace()
-		}
-
 		scope := a.getMetricsScope(metrics.AuthorizationScope, namespace)
 		result, err := a.authorize(ctx, claims, &CallTarget{
 			Namespace: namespace,
 			APIName:   info.FullMethod,
 			Request:   req,
 		}, scope)
+		if err != nil || result.Decision != DecisionAllow {
+			logOnly := a.isLogOnly(namespace)
+			reason := result.Reason
+			if err != nil {
+				reason = err.Error()
+			}
+			a.audit(claims, tlsSubject, namespace, info.FullMethod, audit.DecisionDeny, reason, !logOnly)
+			if logOnly {
+				scope.IncCounter(metrics.ServiceErrUnauthorizedLogOnlyCounter)
+				if err == nil {
+					err = fmt.Errorf("request denied: %s", result.Reason)
+				}
+				a.logWouldReject(namespace, info.FullMethod, err)
+				return ctx, nil
+			}
+		}
 		if err != nil {
 			scope.IncCounter(metrics.ServiceErrAuthorizeFailedCounter)
 			a.logAuthError(err)
-			return nil, errUnauthorized // return a generic error to the caller without disclosing details
+			return ctx, errUnauthorized // return a generic error to the caller without disclosing details
 		}
 		if result.Decision != DecisionAllow {
 			scope.IncCounter(metrics.ServiceErrUnauthorizedCounter)
 			// if a reason is included in the result, include it in the error message
 			if result.Reason != "" {
-				return nil, serviceerror.NewPermissionDenied(RequestUnauthorized, result.Reason)
+				return ctx, serviceerror.NewPermissionDenied(RequestUnauthorized, result.Reason)
 			}
-			return nil, errUnauthorized // return a generic error to the caller without disclosing details
+			return ctx, errUnauthorized // return a generic error to the caller without disclosing details
+		}
+		if IsPrivilegedAPI(info.FullMethod) {
+			a.audit(claims, tlsSubject, namespace, info.FullMethod, audit.DecisionAllow, result.Reason, true)
 		}
 	}
-	return handler(ctx, req)
+	return ctx, nil
 }
 
 func (a *interceptor) authorize(ctx context.Context, claims *Claims, callTarget *CallTarget, scope metrics.Scope) (Result, error) {
@@ -162,31 +227,115 @@ func (a *interceptor) logAuthError(err error) {
 	a.logger.Error("Authorization error", tag.Error(err))
 }
 
+// isLogOnly returns true if unauthorized requests to the namespace should be logged instead of rejected
+func (a *interceptor) isLogOnly(namespace string) bool {
+	return a.logOnly != nil && a.logOnly(namespace)
+}
+
+func (a *interceptor) logWouldReject(namespace string, apiName string, err error) {
+	a.logger.Warn("Authorization log only mode, request would have been rejected",
+		tag.WorkflowNamespace(namespace), tag.Operation(apiName), tag.Error(err))
+}
+
+// audit records authorization decision in audit log
+func (a *interceptor) audit(
+	claims *Claims,
+	tlsSubject *pkix.Name,
+	namespace string,
+	apiName string,
+	decision string,
+	reason string,
+	enforced bool,
+) {
+	if a.auditLogger == nil {
+		return
+	}
+
+	record := &audit.Record{
+		Time:      time.Now().UTC(),
+		Namespace: namespace,
+		API:       apiName,
+		Decision:  decision,
+		Reason:    reason,
+		Enforced:  enforced,
+	}
+	if claims != nil {
+		record.Caller = claims.Subject
+	}
+	if tlsSubject != nil {
+		record.TLSSubject = tlsSubject.String()
+	}
+	a.auditLogger.Log(record)
+}
+
+// authorizedServerStream overrides context of server stream with the one enriched by authorization
+type authorizedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authorizedServerStream) Context() context.Context {
+	return s.ctx
+}
+
 type interceptor struct {
 	authorizer     Authorizer
 	claimMapper    ClaimMapper
 	metricsClient  metrics.Client
 	logger         log.Logger
 	audienceGetter JWTAudienceMapper
+	logOnly        dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	auditLogger    audit.Logger
 }
 
-// NewAuthorizationInterceptor creates an authorization interceptor and return a func that points to its Interceptor method
+// NewAuthorizationInterceptor creates an authorization interceptor and return a func that points to its Interceptor method.
+// When logOnly returns true for a namespace, requests failing claim mapping or authorization are logged but not rejected;
+// nil logOnly enforces authorization for all namespaces.
+// Denied requests and allowed requests to privileged APIs are recorded by auditLogger, nil auditLogger disables auditing.
 func NewAuthorizationInterceptor(
 	claimMapper ClaimMapper,
 	authorizer Authorizer,
 	metrics metrics.Client,
 	logger log.Logger,
 	audienceGetter JWTAudienceMapper,
+	logOnly dynamicconfig.BoolPropertyFnWithNamespaceFilter,
+	auditLogger audit.Logger,
 ) grpc.UnaryServerInterceptor {
+	if cm, ok := claimMapper.(metricsClientAware); ok {
+		cm.setMetricsClient(metrics)
+	}
 	return (&interceptor{
 		claimMapper:    claimMapper,
 		authorizer:     authorizer,
 		metricsClient:  metrics,
 		logger:         logger,
 		audienceGetter: audienceGetter,
+		logOnly:        logOnly,
+		auditLogger:    auditLogger,
 	}).Interceptor
 }
 
+// NewAuthorizationStreamInterceptor creates an authorization interceptor and return a func that points to its StreamInterceptor method
+func NewAuthorizationStreamInterceptor(
+	claimMapper ClaimMapper,
+	authorizer Authorizer,
+	metrics metrics.Client,
+	logger log.Logger,
+	audienceGetter JWTAudienceMapper,
+	logOnly dynamicconfig.BoolPropertyFnWithNamespaceFilter,
+	auditLogger audit.Logger,
+) grpc.StreamServerInterceptor {
+	return (&interceptor{
+		claimMapper:    claimMapper,
+		authorizer:     authorizer,
+		metricsClient:  metrics,
+		logger:         logger,
+		audienceGetter: audienceGetter,
+		logOnly:        logOnly,
+		auditLogger:    auditLogger,
+	}).StreamInterceptor
+}
+
 // getMetricsScope return metrics scope with namespace tag
 func (a *interceptor) getMetricsScope(
 	scope int,
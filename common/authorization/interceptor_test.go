@@ -34,7 +34,10 @@ import (
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/api/workflowservicemock/v1"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/common/authorization/audit"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 )
@@ -67,6 +70,8 @@ type (
 		interceptor         grpc.UnaryServerInterceptor
 		handler             grpc.UnaryHandler
 		mockClaimMapper     *MockClaimMapper
+		mockAuditLogger     *audit.MockLogger
+		logOnly             bool
 	}
 )
 
@@ -89,12 +94,16 @@ func (s *authorizerInterceptorSuite) SetupTest() {
 	s.mockMetricsScope.EXPECT().Tagged(metrics.NamespaceTag(testNamespace)).Return(s.mockMetricsScope)
 	s.mockMetricsScope.EXPECT().StartTimer(metrics.ServiceAuthorizationLatency).Return(s.mockStopwatch)
 	s.mockClaimMapper = NewMockClaimMapper(s.controller)
+	s.mockAuditLogger = audit.NewMockLogger(s.controller)
+	s.logOnly = false
 	s.interceptor = NewAuthorizationInterceptor(
 		s.mockClaimMapper,
 		s.mockAuthorizer,
 		s.mockMetricsClient,
 		log.NewNoopLogger(),
-		nil)
+		nil,
+		func(namespace string) bool { return s.logOnly },
+		s.mockAuditLogger)
 	s.handler = func(ctx context.Context, req interface{}) (interface{}, error) { return true, nil }
 }
 
@@ -122,8 +131,9 @@ func (s *authorizerInterceptorSuite) TestIsAuthorizedWithNamespace() {
 
 func (s *authorizerInterceptorSuite) TestIsUnauthorized() {
 	s.mockAuthorizer.EXPECT().Authorize(ctx, nil, describeNamespaceTarget).
-		Return(Result{Decision: DecisionDeny}, nil)
+		Return(Result{Decision: DecisionDeny, Reason: "no permission"}, nil)
 	s.mockMetricsScope.EXPECT().IncCounter(metrics.ServiceErrUnauthorizedCounter)
+	s.expectAudit(audit.DecisionDeny, "no permission", true)
 
 	res, err := s.interceptor(ctx, describeNamespaceRequest, describeNamespaceInfo, s.handler)
 	s.Nil(res)
@@ -134,8 +144,153 @@ func (s *authorizerInterceptorSuite) TestAuthorizationFailed() {
 	s.mockAuthorizer.EXPECT().Authorize(ctx, nil, describeNamespaceTarget).
 		Return(Result{Decision: DecisionDeny}, errUnauthorized)
 	s.mockMetricsScope.EXPECT().IncCounter(metrics.ServiceErrAuthorizeFailedCounter)
+	s.expectAudit(audit.DecisionDeny, errUnauthorized.Error(), true)
 
 	res, err := s.interceptor(ctx, describeNamespaceRequest, describeNamespaceInfo, s.handler)
 	s.Nil(res)
 	s.Error(err)
 }
+
+func (s *authorizerInterceptorSuite) TestIsUnauthorized_LogOnly() {
+	s.logOnly = true
+	s.mockAuthorizer.EXPECT().Authorize(ctx, nil, describeNamespaceTarget).
+		Return(Result{Decision: DecisionDeny}, nil)
+	s.mockMetricsScope.EXPECT().IncCounter(metrics.ServiceErrUnauthorizedLogOnlyCounter)
+	s.expectAudit(audit.DecisionDeny, "", false)
+
+	res, err := s.interceptor(ctx, describeNamespaceRequest, describeNamespaceInfo, s.handler)
+	s.True(res.(bool))
+	s.NoError(err)
+}
+
+func (s *authorizerInterceptorSuite) TestAuthorizationFailed_LogOnly() {
+	s.logOnly = true
+	s.mockAuthorizer.EXPECT().Authorize(ctx, nil, describeNamespaceTarget).
+		Return(Result{Decision: DecisionDeny}, errUnauthorized)
+	s.mockMetricsScope.EXPECT().IncCounter(metrics.ServiceErrUnauthorizedLogOnlyCounter)
+	s.expectAudit(audit.DecisionDeny, errUnauthorized.Error(), false)
+
+	res, err := s.interceptor(ctx, describeNamespaceRequest, describeNamespaceInfo, s.handler)
+	s.True(res.(bool))
+	s.NoError(err)
+}
+
+func (s *authorizerInterceptorSuite) TestPrivilegedAPIAudited() {
+	apiName := "/temporal.server.api.adminservice.v1.AdminService/DescribeMutableState"
+	request := &adminservice.DescribeMutableStateRequest{Namespace: testNamespace}
+	s.mockAuthorizer.EXPECT().Authorize(ctx, nil, &CallTarget{Namespace: testNamespace, Request: request, APIName: apiName}).
+		Return(Result{Decision: DecisionAllow}, nil)
+	s.mockAuditLogger.EXPECT().Log(gomock.Any()).Do(func(record *audit.Record) {
+		s.Equal(apiName, record.API)
+		s.Equal(audit.DecisionAllow, record.Decision)
+		s.True(record.Enforced)
+	})
+
+	res, err := s.interceptor(ctx, request, &grpc.UnaryServerInfo{FullMethod: apiName}, s.handler)
+	s.True(res.(bool))
+	s.NoError(err)
+}
+
+func (s *authorizerInterceptorSuite) expectAudit(decision string, reason string, enforced bool) {
+	s.mockAuditLogger.EXPECT().Log(gomock.Any()).Do(func(record *audit.Record) {
+		s.Equal(testNamespace, record.Namespace)
+		s.Equal(describeNamespaceInfo.FullMethod, record.API)
+		s.Equal(decision, record.Decision)
+		s.Equal(reason, record.Reason)
+		s.Equal(enforced, record.Enforced)
+	})
+}
+
+func TestClaimMappingFailed(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	mockClaimMapper := NewMockClaimMapper(controller)
+	mockMetricsClient := metrics.NewMockClient(controller)
+	mockMetricsScope := metrics.NewMockScope(controller)
+	logOnly := false
+	interceptor := NewAuthorizationInterceptor(
+		mockClaimMapper,
+		NewMockAuthorizer(controller),
+		mockMetricsClient,
+		log.NewNoopLogger(),
+		nil,
+		func(namespace string) bool { return logOnly },
+		nil)
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return true, nil }
+	authCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer invalid"))
+	mockClaimMapper.EXPECT().GetClaims(gomock.Any()).Return(nil, errUnauthorized).Times(2)
+
+	res, err := interceptor(authCtx, describeNamespaceRequest, describeNamespaceInfo, handler)
+	require.Nil(t, res)
+	require.Equal(t, errUnauthorized, err)
+
+	logOnly = true
+	mockMetricsClient.EXPECT().Scope(metrics.AuthorizationScope).Return(mockMetricsScope)
+	mockMetricsScope.EXPECT().Tagged(metrics.NamespaceTag(testNamespace)).Return(mockMetricsScope)
+	mockMetricsScope.EXPECT().IncCounter(metrics.ServiceErrUnauthorizedLogOnlyCounter)
+	res, err = interceptor(authCtx, describeNamespaceRequest, describeNamespaceInfo, handler)
+	require.True(t, res.(bool))
+	require.NoError(t, err)
+}
+
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestStreamInterceptor(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	apiName := "/temporal.server.api.adminservice.v1.AdminService/StreamReplicationMessages"
+	streamInfo := &grpc.StreamServerInfo{FullMethod: apiName, IsClientStream: true, IsServerStream: true}
+	streamTarget := &CallTarget{APIName: apiName}
+
+	mockAuthorizer := NewMockAuthorizer(controller)
+	mockMetricsClient := metrics.NewMockClient(controller)
+	mockMetricsScope := metrics.NewMockScope(controller)
+	mockStopwatch := metrics.NewMockStopwatch(controller)
+	mockMetricsClient.EXPECT().Scope(metrics.AuthorizationScope).Return(mockMetricsScope).Times(2)
+	mockMetricsScope.EXPECT().Tagged(metrics.NamespaceUnknownTag()).Return(mockMetricsScope).Times(2)
+	mockMetricsScope.EXPECT().StartTimer(metrics.ServiceAuthorizationLatency).Return(mockStopwatch).Times(2)
+	mockStopwatch.EXPECT().Stop().Times(2)
+	mockAuditLogger := audit.NewMockLogger(controller)
+
+	interceptor := NewAuthorizationStreamInterceptor(
+		NewMockClaimMapper(controller),
+		mockAuthorizer,
+		mockMetricsClient,
+		log.NewNoopLogger(),
+		nil,
+		nil,
+		mockAuditLogger)
+
+	handlerCalled := false
+	handler := func(srv interface{}, stream grpc.ServerStream) error {
+		handlerCalled = true
+		return nil
+	}
+	stream := &testServerStream{ctx: ctx}
+
+	mockAuthorizer.EXPECT().Authorize(ctx, nil, streamTarget).Return(Result{Decision: DecisionAllow}, nil)
+	mockAuditLogger.EXPECT().Log(gomock.Any()).Do(func(record *audit.Record) {
+		require.Equal(t, audit.DecisionAllow, record.Decision)
+	})
+	require.NoError(t, interceptor(nil, stream, streamInfo, handler))
+	require.True(t, handlerCalled)
+
+	handlerCalled = false
+	mockAuthorizer.EXPECT().Authorize(ctx, nil, streamTarget).Return(Result{Decision: DecisionDeny}, nil)
+	mockMetricsScope.EXPECT().IncCounter(metrics.ServiceErrUnauthorizedCounter)
+	mockAuditLogger.EXPECT().Log(gomock.Any()).Do(func(record *audit.Record) {
+		require.Equal(t, audit.DecisionDeny, record.Decision)
+	})
+	require.Error(t, interceptor(nil, stream, streamInfo, handler))
+	require.False(t, handlerCalled)
+}
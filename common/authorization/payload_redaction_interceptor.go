@@ -0,0 +1,169 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+
+	commonpb "go.temporal.io/api/common/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+type (
+	// PayloadRedactionInterceptor redacts payloads of histories and visibility records returned by the frontend.
+	// It has to run after the authorization interceptor which puts claims of the caller into the context.
+	PayloadRedactionInterceptor struct {
+		redactor   PayloadRedactor
+		serializer serialization.Serializer
+	}
+
+	hasExecutions interface {
+		GetExecutions() []*workflowpb.WorkflowExecutionInfo
+	}
+
+	// redactedServerStream redacts messages sent on the stream, namespace is taken from the received request
+	redactedServerStream struct {
+		grpc.ServerStream
+		interceptor *PayloadRedactionInterceptor
+		namespace   string
+	}
+)
+
+var _ grpc.UnaryServerInterceptor = (*PayloadRedactionInterceptor)(nil).Intercept
+var _ grpc.StreamServerInterceptor = (*PayloadRedactionInterceptor)(nil).StreamIntercept
+
+// NewPayloadRedactionInterceptor creates a new PayloadRedactionInterceptor
+func NewPayloadRedactionInterceptor(redactor PayloadRedactor) *PayloadRedactionInterceptor {
+	return &PayloadRedactionInterceptor{
+		redactor:   redactor,
+		serializer: serialization.NewSerializer(),
+	}
+}
+
+func (i *PayloadRedactionInterceptor) Intercept(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var namespace string
+	if requestWithNamespace, ok := req.(hasNamespace); ok {
+		namespace = requestWithNamespace.GetNamespace()
+	}
+	if err := i.redact(ctx, namespace, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (i *PayloadRedactionInterceptor) StreamIntercept(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+
+	return handler(srv, &redactedServerStream{ServerStream: ss, interceptor: i})
+}
+
+func (i *PayloadRedactionInterceptor) redact(
+	ctx context.Context,
+	namespace string,
+	resp interface{},
+) error {
+
+	claims, _ := ctx.Value(MappedClaims).(*Claims)
+	switch resp := resp.(type) {
+	case *workflowservice.GetWorkflowExecutionHistoryResponse:
+		if err := i.redactor.RedactHistoryEvents(ctx, claims, namespace, resp.GetHistory().GetEvents()); err != nil {
+			return err
+		}
+		for idx, blob := range resp.GetRawHistory() {
+			redactedBlob, err := i.redactRawHistory(ctx, claims, namespace, blob)
+			if err != nil {
+				return err
+			}
+			resp.RawHistory[idx] = redactedBlob
+		}
+		return nil
+	case *adminservice.GetWorkflowExecutionHistoryReverseResponse:
+		return i.redactor.RedactHistoryEvents(ctx, claims, namespace, resp.GetHistory().GetEvents())
+	case *adminservice.StreamWorkflowExecutionHistoryResponse:
+		return i.redactor.RedactHistoryEvents(ctx, claims, namespace, resp.GetHistory().GetEvents())
+	case *workflowservice.DescribeWorkflowExecutionResponse:
+		if resp.GetWorkflowExecutionInfo() == nil {
+			return nil
+		}
+		return i.redactor.RedactWorkflowExecutionInfos(ctx, claims, namespace, []*workflowpb.WorkflowExecutionInfo{resp.GetWorkflowExecutionInfo()})
+	case hasExecutions:
+		return i.redactor.RedactWorkflowExecutionInfos(ctx, claims, namespace, resp.GetExecutions())
+	}
+	return nil
+}
+
+func (i *PayloadRedactionInterceptor) redactRawHistory(
+	ctx context.Context,
+	claims *Claims,
+	namespace string,
+	blob *commonpb.DataBlob,
+) (*commonpb.DataBlob, error) {
+
+	events, err := i.serializer.DeserializeEvents(blob)
+	if err != nil {
+		return nil, err
+	}
+	if err := i.redactor.RedactHistoryEvents(ctx, claims, namespace, events); err != nil {
+		return nil, err
+	}
+	return i.serializer.SerializeEvents(events, blob.GetEncodingType())
+}
+
+func (s *redactedServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if requestWithNamespace, ok := m.(hasNamespace); ok {
+		s.namespace = requestWithNamespace.GetNamespace()
+	}
+	return nil
+}
+
+func (s *redactedServerStream) SendMsg(m interface{}) error {
+	if err := s.interceptor.redact(s.Context(), s.namespace, m); err != nil {
+		return err
+	}
+	return s.ServerStream.SendMsg(m)
+}
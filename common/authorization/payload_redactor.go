@@ -0,0 +1,166 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	commonpb "go.temporal.io/api/common/v1"
+	historypb "go.temporal.io/api/history/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/sdk/converter"
+
+	"go.temporal.io/server/common/config"
+)
+
+type (
+	// PayloadRedactor strips or masks payloads of histories and visibility records returned by the frontend
+	// for callers which are not privileged to see them. Payloads are redacted in place.
+	PayloadRedactor interface {
+		RedactHistoryEvents(ctx context.Context, caller *Claims, namespace string, events []*historypb.HistoryEvent) error
+		RedactWorkflowExecutionInfos(ctx context.Context, caller *Claims, namespace string, executions []*workflowpb.WorkflowExecutionInfo) error
+	}
+
+	noopPayloadRedactor struct{}
+
+	defaultPayloadRedactor struct {
+		privilegedRole Role
+	}
+)
+
+var _ PayloadRedactor = (*noopPayloadRedactor)(nil)
+var _ PayloadRedactor = (*defaultPayloadRedactor)(nil)
+
+var payloadType = reflect.TypeOf((*commonpb.Payload)(nil))
+
+// NewNoopPayloadRedactor creates a payload redactor which returns all payloads as is
+func NewNoopPayloadRedactor() PayloadRedactor {
+	return &noopPayloadRedactor{}
+}
+
+// NewDefaultPayloadRedactor creates a payload redactor which masks payloads for callers that
+// have neither the privileged role within the system nor within the namespace
+func NewDefaultPayloadRedactor(privilegedRole Role) PayloadRedactor {
+	return &defaultPayloadRedactor{privilegedRole: privilegedRole}
+}
+
+func GetPayloadRedactorFromConfig(config *config.Authorization) (PayloadRedactor, error) {
+
+	switch strings.ToLower(config.PayloadRedactor) {
+	case "":
+		return NewNoopPayloadRedactor(), nil
+	case "default":
+		return NewDefaultPayloadRedactor(RoleWriter), nil
+	}
+	return nil, fmt.Errorf("unknown payload redactor: %s", config.PayloadRedactor)
+}
+
+func (r *noopPayloadRedactor) RedactHistoryEvents(_ context.Context, _ *Claims, _ string, _ []*historypb.HistoryEvent) error {
+	return nil
+}
+
+func (r *noopPayloadRedactor) RedactWorkflowExecutionInfos(_ context.Context, _ *Claims, _ string, _ []*workflowpb.WorkflowExecutionInfo) error {
+	return nil
+}
+
+func (r *defaultPayloadRedactor) RedactHistoryEvents(_ context.Context, caller *Claims, namespace string, events []*historypb.HistoryEvent) error {
+	if r.isPrivileged(caller, namespace) {
+		return nil
+	}
+	for _, event := range events {
+		MaskPayloads(event)
+	}
+	return nil
+}
+
+// RedactWorkflowExecutionInfos masks memo of visibility records, search attributes are left as is
+// since anyone allowed to list workflows can query them anyway.
+func (r *defaultPayloadRedactor) RedactWorkflowExecutionInfos(_ context.Context, caller *Claims, namespace string, executions []*workflowpb.WorkflowExecutionInfo) error {
+	if r.isPrivileged(caller, namespace) {
+		return nil
+	}
+	for _, execution := range executions {
+		MaskPayloads(execution.GetMemo())
+	}
+	return nil
+}
+
+func (r *defaultPayloadRedactor) isPrivileged(caller *Claims, namespace string) bool {
+	if caller == nil {
+		return false
+	}
+	if caller.System >= r.privilegedRole {
+		return true
+	}
+	return caller.Namespaces[strings.ToLower(namespace)] >= r.privilegedRole
+}
+
+// MaskPayloads replaces every payload reachable from the message with a nil payload, so SDKs and
+// the UI still decode masked values rather than failing on data they cannot interpret.
+func MaskPayloads(message interface{}) {
+	maskPayloads(reflect.ValueOf(message))
+}
+
+func maskPayloads(value reflect.Value) {
+	switch value.Kind() {
+	case reflect.Ptr:
+		if value.IsNil() {
+			return
+		}
+		if value.Type() == payloadType {
+			payload := value.Interface().(*commonpb.Payload)
+			payload.Metadata = map[string][]byte{converter.MetadataEncoding: []byte(converter.MetadataEncodingNil)}
+			payload.Data = nil
+			return
+		}
+		maskPayloads(value.Elem())
+	case reflect.Interface:
+		// oneof fields, e.g. attributes of history events
+		if !value.IsNil() {
+			maskPayloads(value.Elem())
+		}
+	case reflect.Struct:
+		for i := 0; i < value.NumField(); i++ {
+			if value.Type().Field(i).PkgPath == "" {
+				maskPayloads(value.Field(i))
+			}
+		}
+	case reflect.Slice:
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			return
+		}
+		for i := 0; i < value.Len(); i++ {
+			maskPayloads(value.Index(i))
+		}
+	case reflect.Map:
+		iter := value.MapRange()
+		for iter.Next() {
+			maskPayloads(iter.Value())
+		}
+	}
+}
@@ -0,0 +1,168 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/converter"
+	"google.golang.org/grpc"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+type (
+	payloadRedactorSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		redactor PayloadRedactor
+	}
+)
+
+func TestPayloadRedactorSuite(t *testing.T) {
+	s := new(payloadRedactorSuite)
+	suite.Run(t, s)
+}
+
+func (s *payloadRedactorSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.redactor = NewDefaultPayloadRedactor(RoleWriter)
+}
+
+func (s *payloadRedactorSuite) TestGetPayloadRedactorFromConfig() {
+	redactor, err := GetPayloadRedactorFromConfig(&config.Authorization{})
+	s.NoError(err)
+	s.IsType(&noopPayloadRedactor{}, redactor)
+
+	redactor, err = GetPayloadRedactorFromConfig(&config.Authorization{PayloadRedactor: "Default"})
+	s.NoError(err)
+	s.IsType(&defaultPayloadRedactor{}, redactor)
+
+	_, err = GetPayloadRedactorFromConfig(&config.Authorization{PayloadRedactor: "unknown"})
+	s.Error(err)
+}
+
+func (s *payloadRedactorSuite) TestRedactHistoryEvents_NotPrivileged() {
+	events := s.newHistoryEvents()
+	err := s.redactor.RedactHistoryEvents(context.Background(), &claimsSystemUndefinedNamespaceReader, "BAR", events)
+	s.NoError(err)
+	s.assertMasked(events[0].GetWorkflowExecutionStartedEventAttributes().GetInput().GetPayloads()[0])
+	s.assertMasked(events[0].GetWorkflowExecutionStartedEventAttributes().GetMemo().GetFields()["key"])
+	s.assertMasked(events[1].GetActivityTaskCompletedEventAttributes().GetResult().GetPayloads()[0])
+
+	events = s.newHistoryEvents()
+	err = s.redactor.RedactHistoryEvents(context.Background(), nil, "bar", events)
+	s.NoError(err)
+	s.assertMasked(events[1].GetActivityTaskCompletedEventAttributes().GetResult().GetPayloads()[0])
+}
+
+func (s *payloadRedactorSuite) TestRedactHistoryEvents_Privileged() {
+	for _, claims := range []*Claims{
+		&claimsSystemWriter,
+		{Namespaces: map[string]Role{"bar": RoleWriter}},
+	} {
+		events := s.newHistoryEvents()
+		err := s.redactor.RedactHistoryEvents(context.Background(), claims, "bar", events)
+		s.NoError(err)
+		s.Equal(s.newHistoryEvents(), events)
+	}
+}
+
+func (s *payloadRedactorSuite) TestRedactWorkflowExecutionInfos() {
+	executions := []*workflowpb.WorkflowExecutionInfo{{
+		Memo:             &commonpb.Memo{Fields: map[string]*commonpb.Payload{"key": payload.EncodeString("memo")}},
+		SearchAttributes: &commonpb.SearchAttributes{IndexedFields: map[string]*commonpb.Payload{"CustomKeywordField": payload.EncodeString("keyword")}},
+	}}
+	err := s.redactor.RedactWorkflowExecutionInfos(context.Background(), &claimsSystemReader, "bar", executions)
+	s.NoError(err)
+	s.assertMasked(executions[0].GetMemo().GetFields()["key"])
+	s.Equal(payload.EncodeString("keyword"), executions[0].GetSearchAttributes().GetIndexedFields()["CustomKeywordField"])
+}
+
+func (s *payloadRedactorSuite) TestInterceptor_RawHistory() {
+	serializer := serialization.NewSerializer()
+	blob, err := serializer.SerializeEvents(s.newHistoryEvents(), enumspb.ENCODING_TYPE_PROTO3)
+	s.NoError(err)
+
+	interceptor := NewPayloadRedactionInterceptor(s.redactor)
+	ctx := context.WithValue(context.Background(), MappedClaims, &claimsSystemReader)
+	resp, err := interceptor.Intercept(
+		ctx,
+		&workflowservice.GetWorkflowExecutionHistoryRequest{Namespace: "bar"},
+		&grpc.UnaryServerInfo{},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return &workflowservice.GetWorkflowExecutionHistoryResponse{RawHistory: []*commonpb.DataBlob{blob}}, nil
+		},
+	)
+	s.NoError(err)
+
+	rawHistory := resp.(*workflowservice.GetWorkflowExecutionHistoryResponse).GetRawHistory()
+	s.Len(rawHistory, 1)
+	s.Equal(enumspb.ENCODING_TYPE_PROTO3, rawHistory[0].GetEncodingType())
+	events, err := serializer.DeserializeEvents(rawHistory[0])
+	s.NoError(err)
+	s.Len(events, 2)
+	s.assertMasked(events[1].GetActivityTaskCompletedEventAttributes().GetResult().GetPayloads()[0])
+}
+
+func (s *payloadRedactorSuite) newHistoryEvents() []*historypb.HistoryEvent {
+	return []*historypb.HistoryEvent{
+		{
+			EventId:   1,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+				WorkflowType: &commonpb.WorkflowType{Name: "workflow-type"},
+				Input:        payloads.EncodeString("input"),
+				Memo:         &commonpb.Memo{Fields: map[string]*commonpb.Payload{"key": payload.EncodeString("memo")}},
+			}},
+		},
+		{
+			EventId:   2,
+			EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_COMPLETED,
+			Attributes: &historypb.HistoryEvent_ActivityTaskCompletedEventAttributes{ActivityTaskCompletedEventAttributes: &historypb.ActivityTaskCompletedEventAttributes{
+				Result:           payloads.EncodeString("result"),
+				ScheduledEventId: 1,
+			}},
+		},
+	}
+}
+
+func (s *payloadRedactorSuite) assertMasked(p *commonpb.Payload) {
+	s.NotNil(p)
+	s.Nil(p.GetData())
+	s.Equal(converter.MetadataEncodingNil, string(p.GetMetadata()[converter.MetadataEncoding]))
+}
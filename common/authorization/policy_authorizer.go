@@ -0,0 +1,189 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.temporal.io/server/common/config"
+)
+
+const (
+	// APIGroupRead contains read only APIs
+	APIGroupRead = "read"
+	// APIGroupWrite contains all APIs of workflow service which are not read only
+	APIGroupWrite = "write"
+	// APIGroupWorker contains APIs used by workers to poll and complete tasks
+	APIGroupWorker = "worker"
+	// APIGroupAdmin contains APIs of admin service
+	APIGroupAdmin = "admin"
+
+	policyWildcard = "*"
+)
+
+type (
+	policyAuthorizer struct {
+		rules []*policyRule
+	}
+
+	policyRule struct {
+		role       Role
+		namespaces map[string]struct{}
+		apiGroups  map[string]struct{}
+		apis       map[string]struct{}
+	}
+)
+
+var _ Authorizer = (*policyAuthorizer)(nil)
+
+// NewPolicyAuthorizer creates an authorizer which allows requests according to the declarative policy
+func NewPolicyAuthorizer(policy *config.AuthorizationPolicy) (Authorizer, error) {
+	if policy == nil {
+		return nil, errors.New("policy authorizer: missing policy")
+	}
+
+	rules := make([]*policyRule, 0, len(policy.Rules))
+	for i, ruleConfig := range policy.Rules {
+		rule, err := newPolicyRule(ruleConfig)
+		if err != nil {
+			return nil, fmt.Errorf("policy authorizer: rule %d: %w", i, err)
+		}
+		rules = append(rules, rule)
+	}
+	return &policyAuthorizer{rules: rules}, nil
+}
+
+func newPolicyRule(ruleConfig config.AuthorizationPolicyRule) (*policyRule, error) {
+	rule := &policyRule{
+		namespaces: make(map[string]struct{}, len(ruleConfig.Namespaces)),
+		apiGroups:  make(map[string]struct{}, len(ruleConfig.APIGroups)),
+		apis:       make(map[string]struct{}, len(ruleConfig.APIs)),
+	}
+
+	if ruleConfig.Role != "" {
+		rule.role = roleFromName(ruleConfig.Role)
+		if rule.role == RoleUndefined {
+			return nil, fmt.Errorf("unknown role: %q", ruleConfig.Role)
+		}
+	}
+	if len(ruleConfig.Namespaces) == 0 {
+		return nil, errors.New("no namespaces")
+	}
+	for _, namespace := range ruleConfig.Namespaces {
+		rule.namespaces[strings.ToLower(namespace)] = struct{}{}
+	}
+	if len(ruleConfig.APIGroups) == 0 && len(ruleConfig.APIs) == 0 {
+		return nil, errors.New("no api groups or apis")
+	}
+	for _, apiGroup := range ruleConfig.APIGroups {
+		switch apiGroup {
+		case APIGroupRead, APIGroupWrite, APIGroupWorker, APIGroupAdmin, policyWildcard:
+			rule.apiGroups[apiGroup] = struct{}{}
+		default:
+			return nil, fmt.Errorf("unknown api group: %q", apiGroup)
+		}
+	}
+	for _, api := range ruleConfig.APIs {
+		rule.apis[api] = struct{}{}
+	}
+	return rule, nil
+}
+
+func roleFromName(name string) Role {
+	switch strings.ToLower(name) {
+	case "worker":
+		return RoleWorker
+	case "reader":
+		return RoleReader
+	case "writer":
+		return RoleWriter
+	case "admin":
+		return RoleAdmin
+	}
+	return RoleUndefined
+}
+
+func (a *policyAuthorizer) Authorize(_ context.Context, claims *Claims, target *CallTarget) (Result, error) {
+	namespace := strings.ToLower(target.Namespace)
+	role := RoleUndefined
+	if claims != nil {
+		role = claims.System
+		if namespace != "" {
+			role |= claims.Namespaces[namespace]
+		}
+	}
+
+	for _, rule := range a.rules {
+		if rule.allows(role, namespace, target.APIName) {
+			return resultAllow, nil
+		}
+	}
+	return resultDeny, nil
+}
+
+func (r *policyRule) allows(role Role, namespace string, fullMethod string) bool {
+	if role < r.role {
+		return false
+	}
+	if _, ok := r.namespaces[policyWildcard]; !ok {
+		if _, ok := r.namespaces[namespace]; !ok {
+			return false
+		}
+	}
+
+	api := ApiName(fullMethod)
+	if _, ok := r.apis[api]; ok {
+		return true
+	}
+	if _, ok := r.apis[fullMethod]; ok {
+		return true
+	}
+	for apiGroup := range r.apiGroups {
+		if isInAPIGroup(apiGroup, fullMethod, api) {
+			return true
+		}
+	}
+	return false
+}
+
+func isInAPIGroup(apiGroup string, fullMethod string, api string) bool {
+	privileged := IsPrivilegedAPI(fullMethod)
+	switch apiGroup {
+	case policyWildcard:
+		return true
+	case APIGroupAdmin:
+		return privileged
+	case APIGroupRead:
+		return !privileged && (IsReadOnlyNamespaceAPI(api) || IsReadOnlyGlobalAPI(api))
+	case APIGroupWorker:
+		return !privileged && IsWorkerAPI(api)
+	case APIGroupWrite:
+		return !privileged && !IsReadOnlyNamespaceAPI(api) && !IsReadOnlyGlobalAPI(api)
+	}
+	return false
+}
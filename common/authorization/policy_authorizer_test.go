@@ -0,0 +1,213 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/config"
+)
+
+const (
+	workflowServicePrefix = "/temporal.api.workflowservice.v1.WorkflowService/"
+)
+
+type (
+	policyAuthorizerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		authorizer Authorizer
+	}
+)
+
+func TestPolicyAuthorizerSuite(t *testing.T) {
+	suite.Run(t, new(policyAuthorizerSuite))
+}
+
+func (s *policyAuthorizerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	var err error
+	s.authorizer, err = NewPolicyAuthorizer(&config.AuthorizationPolicy{
+		Rules: []config.AuthorizationPolicyRule{
+			{Namespaces: []string{"temporal-system"}, APIGroups: []string{"*"}},
+			{Role: "reader", Namespaces: []string{"*"}, APIGroups: []string{APIGroupRead}},
+			{Role: "worker", Namespaces: []string{"*"}, APIGroups: []string{APIGroupWorker}},
+			{Role: "writer", Namespaces: []string{"orders"}, APIGroups: []string{APIGroupWrite}},
+			{Role: "writer", Namespaces: []string{"payments"}, APIs: []string{"SignalWorkflowExecution"}},
+			{Role: "admin", Namespaces: []string{""}, APIGroups: []string{APIGroupAdmin}},
+		},
+	})
+	s.NoError(err)
+}
+
+func (s *policyAuthorizerSuite) TestAuthorize() {
+	testCases := []struct {
+		name      string
+		claims    *Claims
+		namespace string
+		api       string
+		allowed   bool
+	}{
+		{
+			name:      "system namespace without claims",
+			namespace: "temporal-system",
+			api:       workflowServicePrefix + "StartWorkflowExecution",
+			allowed:   true,
+		},
+		{
+			name:      "no claims",
+			namespace: "orders",
+			api:       workflowServicePrefix + "DescribeNamespace",
+		},
+		{
+			name:      "namespace reader reads",
+			claims:    &Claims{Namespaces: map[string]Role{"orders": RoleReader}},
+			namespace: "orders",
+			api:       workflowServicePrefix + "DescribeWorkflowExecution",
+			allowed:   true,
+		},
+		{
+			name:      "namespace reader writes",
+			claims:    &Claims{Namespaces: map[string]Role{"orders": RoleReader}},
+			namespace: "orders",
+			api:       workflowServicePrefix + "StartWorkflowExecution",
+		},
+		{
+			name:      "namespace reader reads other namespace",
+			claims:    &Claims{Namespaces: map[string]Role{"orders": RoleReader}},
+			namespace: "payments",
+			api:       workflowServicePrefix + "DescribeWorkflowExecution",
+		},
+		{
+			name:      "system reader reads any namespace",
+			claims:    &Claims{System: RoleReader},
+			namespace: "payments",
+			api:       workflowServicePrefix + "DescribeWorkflowExecution",
+			allowed:   true,
+		},
+		{
+			name:      "worker polls",
+			claims:    &Claims{Namespaces: map[string]Role{"payments": RoleWorker}},
+			namespace: "payments",
+			api:       workflowServicePrefix + "PollActivityTaskQueue",
+			allowed:   true,
+		},
+		{
+			name:      "worker reads",
+			claims:    &Claims{Namespaces: map[string]Role{"payments": RoleWorker}},
+			namespace: "payments",
+			api:       workflowServicePrefix + "DescribeWorkflowExecution",
+		},
+		{
+			name:      "writer writes",
+			claims:    &Claims{Namespaces: map[string]Role{"orders": RoleWriter}},
+			namespace: "Orders",
+			api:       workflowServicePrefix + "StartWorkflowExecution",
+			allowed:   true,
+		},
+		{
+			name:      "writer signals in namespace with api rule",
+			claims:    &Claims{Namespaces: map[string]Role{"payments": RoleWriter}},
+			namespace: "payments",
+			api:       workflowServicePrefix + "SignalWorkflowExecution",
+			allowed:   true,
+		},
+		{
+			name:      "writer starts in namespace with api rule",
+			claims:    &Claims{Namespaces: map[string]Role{"payments": RoleWriter}},
+			namespace: "payments",
+			api:       workflowServicePrefix + "StartWorkflowExecution",
+		},
+		{
+			name:    "system admin calls admin api",
+			claims:  &Claims{System: RoleAdmin},
+			api:     adminServicePrefix + "DescribeCluster",
+			allowed: true,
+		},
+		{
+			name:   "system writer calls admin api",
+			claims: &Claims{System: RoleWriter},
+			api:    adminServicePrefix + "DescribeCluster",
+		},
+		{
+			name:      "namespace admin calls admin api",
+			claims:    &Claims{Namespaces: map[string]Role{"orders": RoleAdmin}},
+			namespace: "orders",
+			api:       adminServicePrefix + "DescribeMutableState",
+		},
+	}
+
+	for _, tc := range testCases {
+		result, err := s.authorizer.Authorize(context.Background(), tc.claims, &CallTarget{
+			Namespace: tc.namespace,
+			APIName:   tc.api,
+		})
+		s.NoError(err, tc.name)
+		if tc.allowed {
+			s.Equal(DecisionAllow, result.Decision, tc.name)
+		} else {
+			s.Equal(DecisionDeny, result.Decision, tc.name)
+		}
+	}
+}
+
+func (s *policyAuthorizerSuite) TestInvalidPolicy() {
+	testCases := []*config.AuthorizationPolicy{
+		nil,
+		{Rules: []config.AuthorizationPolicyRule{{Role: "owner", Namespaces: []string{"*"}, APIGroups: []string{"*"}}}},
+		{Rules: []config.AuthorizationPolicyRule{{Role: "reader", APIGroups: []string{"*"}}}},
+		{Rules: []config.AuthorizationPolicyRule{{Role: "reader", Namespaces: []string{"*"}}}},
+		{Rules: []config.AuthorizationPolicyRule{{Role: "reader", Namespaces: []string{"*"}, APIGroups: []string{"query"}}}},
+	}
+
+	for _, policy := range testCases {
+		authorizer, err := NewPolicyAuthorizer(policy)
+		s.Error(err)
+		s.Nil(authorizer)
+	}
+}
+
+func (s *policyAuthorizerSuite) TestGetAuthorizerFromConfig() {
+	cfg := config.Authorization{
+		Authorizer: "policy",
+		Policy: &config.AuthorizationPolicy{
+			Rules: []config.AuthorizationPolicyRule{{Role: "admin", Namespaces: []string{"*"}, APIGroups: []string{"*"}}},
+		},
+	}
+	authorizer, err := GetAuthorizerFromConfig(&cfg)
+	s.NoError(err)
+	s.Equal(reflect.TypeOf(&policyAuthorizer{}), reflect.TypeOf(authorizer))
+
+	cfg.Policy = nil
+	_, err = GetAuthorizerFromConfig(&cfg)
+	s.Error(err)
+}
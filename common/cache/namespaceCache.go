@@ -39,6 +39,7 @@ import (
 	namespacepb "go.temporal.io/api/namespace/v1"
 	"go.temporal.io/api/serviceerror"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/clock"
@@ -80,6 +81,11 @@ const (
 	namespaceCacheStopped     int32 = 2
 )
 
+var (
+	// ErrNamespaceHandover is returned for requests mutating workflows of the namespace which is in handover state
+	ErrNamespaceHandover = serviceerror.NewUnavailable("Namespace is in handover state, failover is in progress.")
+)
+
 type (
 	// PrepareCallbackFn is function to be called before CallbackFn is called,
 	// it is guaranteed that PrepareCallbackFn and CallbackFn pair will be both called or non will be called
@@ -724,6 +730,12 @@ func (entry *NamespaceCacheEntry) IsNamespaceActive() bool {
 	return entry.clusterMetadata.GetCurrentClusterName() == entry.replicationConfig.ActiveClusterName
 }
 
+// IsNamespaceHandover return whether the namespace is in handover state, i.e. replication is being drained before failover
+func (entry *NamespaceCacheEntry) IsNamespaceHandover() bool {
+	return entry.isGlobalNamespace &&
+		entry.replicationConfig.State == enumsspb.NAMESPACE_REPLICATION_STATE_HANDOVER
+}
+
 // GetReplicationPolicy return the derived workflow replication policy
 func (entry *NamespaceCacheEntry) GetReplicationPolicy() ReplicationPolicy {
 	// frontend guarantee that the clusters always contains the active namespace, so if the # of clusters is 1
@@ -737,6 +749,10 @@ func (entry *NamespaceCacheEntry) GetReplicationPolicy() ReplicationPolicy {
 // GetNamespaceNotActiveErr return err if namespace is not active, nil otherwise
 func (entry *NamespaceCacheEntry) GetNamespaceNotActiveErr() error {
 	if entry.IsNamespaceActive() {
+		if entry.IsNamespaceHandover() {
+			// namespace is active but must not make progress until handover is completed
+			return ErrNamespaceHandover
+		}
 		// namespace is consider active
 		return nil
 	}
@@ -37,6 +37,7 @@ import (
 	namespacepb "go.temporal.io/api/namespace/v1"
 	"go.temporal.io/api/serviceerror"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/log"
@@ -632,6 +633,12 @@ func Test_NamespaceCacheEntry_GetNamespaceNotActiveErr(t *testing.T) {
 
 	require.Nil(t, namespaceEntry.GetNamespaceNotActiveErr())
 
+	// active namespace in handover state must not make progress
+	namespaceEntry.replicationConfig.State = enumsspb.NAMESPACE_REPLICATION_STATE_HANDOVER
+	namespaceEntry.replicationConfig.HandoverClusterName = cluster.TestAlternativeClusterName
+	require.True(t, namespaceEntry.IsNamespaceHandover())
+	require.Equal(t, ErrNamespaceHandover, namespaceEntry.GetNamespaceNotActiveErr())
+
 	// update to become not active
 	namespaceEntry.replicationConfig.ActiveClusterName = cluster.TestAlternativeClusterName
 	err := namespaceEntry.GetNamespaceNotActiveErr()
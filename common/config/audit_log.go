@@ -0,0 +1,76 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"errors"
+	"net/url"
+)
+
+const (
+	// AuditLogSinkStdout writes audit records to stdout as JSON lines
+	AuditLogSinkStdout = "stdout"
+	// AuditLogSinkFile appends audit records to a file as JSON lines
+	AuditLogSinkFile = "file"
+	// AuditLogSinkKafka publishes audit records to Kafka topic through Kafka REST proxy
+	AuditLogSinkKafka = "kafka"
+)
+
+type (
+	// AuditLog is the config for audit logging of denied and privileged requests
+	AuditLog struct {
+		// Sink is where audit records are written to: "stdout", "file" or "kafka"
+		Sink string `yaml:"sink"`
+		// File is the path of the file audit records are appended to, required for "file" sink
+		File string `yaml:"file"`
+		// Kafka contains the config for publishing audit records to Kafka, required for "kafka" sink
+		Kafka *KafkaREST `yaml:"kafka"`
+		// BufferSize is the max number of records buffered, records are dropped if buffer is full
+		BufferSize int `yaml:"bufferSize"`
+	}
+)
+
+func (c *AuditLog) validate() error {
+	switch c.Sink {
+	case AuditLogSinkStdout:
+	case AuditLogSinkFile:
+		if c.File == "" {
+			return errors.New("authorization config: audit log: missing file")
+		}
+	case AuditLogSinkKafka:
+		if c.Kafka == nil {
+			return errors.New("authorization config: audit log: missing kafka config")
+		}
+		if _, err := url.Parse(c.Kafka.URL); err != nil || c.Kafka.URL == "" {
+			return errors.New("authorization config: audit log: invalid kafka url")
+		}
+		if c.Kafka.Topic == "" {
+			return errors.New("authorization config: audit log: missing kafka topic")
+		}
+	default:
+		return errors.New("authorization config: audit log: unknown sink")
+	}
+	return nil
+}
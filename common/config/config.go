@@ -35,6 +35,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/masker"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/tracing"
 )
 
 type (
@@ -101,8 +102,12 @@ type (
 		TLS RootTLS `yaml:"tls"`
 		// Metrics is the metrics subsystem configuration
 		Metrics *metrics.Config `yaml:"metrics"`
+		// Tracing is the configuration for exporting traces to an OpenTelemetry collector
+		Tracing *tracing.Config `yaml:"tracing"`
 		// Settings for authentication and authorization
 		Authorization Authorization `yaml:"authorization"`
+		// PageToken is the configuration for signing and encrypting page tokens
+		PageToken PageToken `yaml:"pageToken"`
 	}
 
 	// RootTLS contains all TLS settings for the Temporal server
@@ -113,10 +118,18 @@ type (
 		Frontend GroupTLS `yaml:"frontend"`
 		// SystemWorker controls TLS setting for System Workers connecting to Frontend.
 		SystemWorker WorkerTLS `yaml:"systemWorker"`
+		// RemoteClusters contains TLS settings for connections to remote clusters, keyed by the
+		// host name of the remote cluster RPC address. Server section supplies the client certificate
+		// presented to the remote cluster, Client section supplies root CAs and server name.
+		// Optional. If a remote cluster host is not present, settings from Frontend are used.
+		RemoteClusters map[string]GroupTLS `yaml:"remoteClusters"`
 		// ExpirationChecks defines settings for periodic checks for expiration of certificates
 		ExpirationChecks CertExpirationValidation `yaml:"expirationChecks"`
 		// Interval between refreshes of certificates loaded from files
 		RefreshInterval time.Duration `yaml:"refreshInterval"`
+		// Interval between checks of certificate, key and CA files for modifications. When a modified file
+		// is detected, certificates and CAs are reloaded without waiting for RefreshInterval. Optional.
+		WatchInterval time.Duration `yaml:"watchInterval"`
 	}
 
 	// GroupTLS contains an instance client and server TLS settings
@@ -222,6 +235,8 @@ type (
 		NumHistoryShards int32 `yaml:"numHistoryShards" validate:"nonzero"`
 		// DataStores contains the configuration for all datastores
 		DataStores map[string]DataStore `yaml:"datastores"`
+		// VisibilityExporter is the optional config for exporting visibility records to external systems
+		VisibilityExporter *VisibilityExporter `yaml:"visibilityExporter"`
 		// VisibilityConfig is config for visibility sampling
 		VisibilityConfig *VisibilityConfig `yaml:"-" json:"-"`
 		// TransactionSizeLimit is the largest allowed transaction size
@@ -255,6 +270,10 @@ type (
 		// ESProcessorAckTimeout is the timeout that store will wait to get ack signal from ES processor.
 		// Should be at least ESProcessorFlushInterval+<time to process request>.
 		ESProcessorAckTimeout dynamicconfig.DurationPropertyFn `yaml:"-" json:"-"`
+		// ESPointInTimeKeepAlive is how long a point in time opened by ScanWorkflowExecutions is kept between pages.
+		ESPointInTimeKeepAlive dynamicconfig.DurationPropertyFn `yaml:"-" json:"-"`
+		// ESParseDocStrictMode fails reads if any field of Elasticsearch document can't be parsed or is unknown.
+		ESParseDocStrictMode dynamicconfig.BoolPropertyFn `yaml:"-" json:"-"`
 	}
 
 	// Cassandra contains configuration to connect to Cassandra cluster
@@ -472,12 +491,23 @@ type (
 
 	Authorization struct {
 		// Signing key provider for validating JWT tokens
-		JWTKeyProvider       JWTKeyProvider `yaml:"jwtKeyProvider"`
-		PermissionsClaimName string         `yaml:"permissionsClaimName"`
+		JWTKeyProvider JWTKeyProvider `yaml:"jwtKeyProvider"`
+		// Trusted JWT issuers, each with its own signing key source and accepted audiences.
+		// When set, tokens are validated against the issuer named in their "iss" claim and
+		// JWTKeyProvider is ignored.
+		JWTIssuers           []JWTIssuer `yaml:"jwtIssuers"`
+		PermissionsClaimName string      `yaml:"permissionsClaimName"`
 		// Empty string for noopAuthorizer or "default" for defaultAuthorizer
 		Authorizer string `yaml:"authorizer"`
 		// Empty string for noopClaimMapper or "default" for defaultJWTClaimMapper
 		ClaimMapper string `yaml:"claimMapper"`
+		// Audit enables audit logging of denied and privileged requests. Optional.
+		Audit *AuditLog `yaml:"audit"`
+		// Policy is the declarative authorization policy, required for "policy" authorizer
+		Policy *AuthorizationPolicy `yaml:"policy"`
+		// Empty string for noopPayloadRedactor or "default" for defaultPayloadRedactor, which masks payloads
+		// of histories and visibility records for callers without writer role
+		PayloadRedactor string `yaml:"payloadRedactor"`
 	}
 
 	// @@@SNIPSTART temporal-common-service-config-jwtkeyprovider
@@ -487,6 +517,37 @@ type (
 		RefreshInterval time.Duration `yaml:"refreshInterval"`
 	}
 	// @@@SNIPEND
+
+	// JWTIssuer contains the config for a trusted JWT issuer
+	JWTIssuer struct {
+		// Issuer is the expected value of the "iss" claim
+		Issuer string `yaml:"issuer"`
+		// Audiences lists accepted values of the "aud" claim. Empty list disables audience validation.
+		Audiences []string `yaml:"audiences"`
+		// KeyProvider is the signing key source for tokens of this issuer
+		KeyProvider JWTKeyProvider `yaml:"keyProvider"`
+	}
+
+	// AuthorizationPolicy maps roles to allowed APIs per namespace.
+	// Request is allowed if any of the rules allows it.
+	AuthorizationPolicy struct {
+		Rules []AuthorizationPolicyRule `yaml:"rules"`
+	}
+
+	// AuthorizationPolicyRule allows callers having the role to call the APIs in the namespaces
+	AuthorizationPolicyRule struct {
+		// Role is the minimal role required: "worker", "reader", "writer" or "admin".
+		// Higher roles include lower ones. Empty role matches any caller, including callers without claims.
+		Role string `yaml:"role"`
+		// Namespaces the rule applies to, "*" matches any namespace and calls not targeting a namespace.
+		// Empty string matches calls not targeting a namespace.
+		Namespaces []string `yaml:"namespaces"`
+		// APIGroups allowed by the rule: "read", "write", "worker", "admin" or "*" for all APIs
+		APIGroups []string `yaml:"apiGroups"`
+		// APIs allowed by the rule in addition to API groups, either API name, i.e. "StartWorkflowExecution",
+		// or full method name, i.e. "/temporal.server.api.adminservice.v1.AdminService/DescribeMutableState"
+		APIs []string `yaml:"apis"`
+	}
 )
 
 // Validate validates this config
@@ -499,6 +560,16 @@ func (c *Config) Validate() error {
 		return err
 	}
 
+	if c.Global.Authorization.Audit != nil {
+		if err := c.Global.Authorization.Audit.validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := c.Global.PageToken.validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
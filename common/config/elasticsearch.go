@@ -27,11 +27,21 @@ package config
 import (
 	"fmt"
 	"net/url"
+	"time"
 )
 
 const (
 	// VisibilityAppName is used to find ES indexName for visibility
 	VisibilityAppName = "visibility"
+
+	// ESAuthTypeBasic authenticates requests with username and password
+	ESAuthTypeBasic = "basic"
+	// ESAuthTypeAPIKey authenticates requests with Elastic API key
+	ESAuthTypeAPIKey = "apiKey"
+	// ESAuthTypeBearer authenticates requests with bearer or service account token
+	ESAuthTypeBearer = "bearer"
+	// ESAuthTypeAWS signs requests with AWS SigV4 (AWS Elasticsearch and OpenSearch Service)
+	ESAuthTypeAWS = "aws"
 )
 
 // Config for connecting to Elasticsearch
@@ -44,6 +54,35 @@ type (
 		Indices           map[string]string         `yaml:"indices"` //nolint:govet
 		LogLevel          string                    `yaml:"logLevel"`
 		AWSRequestSigning ESAWSRequestSigningConfig `yaml:"aws-request-signing"`
+		// AuthType is one of "basic" (default, uses username and password), "apiKey", "bearer" or "aws" (uses aws-request-signing).
+		AuthType string `yaml:"authType"`
+		// APIKey is Elastic API key either base64 encoded or in "id:api_key" form. Used with "apiKey" auth type.
+		APIKey string `yaml:"apiKey"`
+		// Token is bearer or service account token. Used with "bearer" auth type.
+		Token string `yaml:"token"`
+		// EnableSniff enables discovery of cluster nodes. Keep it disabled if cluster is behind a load balancer.
+		EnableSniff bool `yaml:"enableSniff"`
+		// MaxConnsPerHost limits number of connections to every cluster node. Zero means no limit.
+		MaxConnsPerHost int `yaml:"maxConnsPerHost"`
+		// MaxIdleConnsPerHost is number of idle connections kept open to every cluster node. Zero means Go default (2).
+		MaxIdleConnsPerHost int `yaml:"maxIdleConnsPerHost"`
+		// RequestTimeout is timeout of every HTTP request attempt, retries are not included. Zero means no timeout.
+		RequestTimeout time.Duration `yaml:"requestTimeout"`
+		Retry          ESRetryConfig `yaml:"retry"`
+	}
+
+	// ESRetryConfig represents retry policy for failed ES requests
+	ESRetryConfig struct {
+		// MaxRetries is maximum number of retries of a single request.
+		// Zero means default (3), negative value disables retries.
+		MaxRetries int `yaml:"maxRetries"`
+		// InitialInterval is backoff interval before the first retry. Default is 128ms.
+		InitialInterval time.Duration `yaml:"initialInterval"`
+		// MaxInterval is maximum backoff interval between retries. Default is 2s.
+		MaxInterval time.Duration `yaml:"maxInterval"`
+		// StatusCodes are HTTP status codes which are retried along with connection errors.
+		// Default is 429 and 503. Retries by status code are supported by v7 client only.
+		StatusCodes []int `yaml:"statusCodes"`
 	}
 
 	// ESAWSRequestSigningConfig represents configuration for signing ES requests to AWS
@@ -82,6 +121,11 @@ func (cfg *Elasticsearch) GetVisibilityIndex() string {
 	return cfg.Indices[VisibilityAppName]
 }
 
+// AWSRequestSigningEnabled returns true if requests to Elasticsearch must be signed with AWS SigV4.
+func (cfg *Elasticsearch) AWSRequestSigningEnabled() bool {
+	return cfg.AuthType == ESAuthTypeAWS || cfg.AWSRequestSigning.Enabled
+}
+
 func (cfg *Elasticsearch) validate(storeName string) error {
 	if len(cfg.Indices) < 1 {
 		return fmt.Errorf("persistence config: advanced visibility datastore %q: missing indices", storeName)
@@ -90,5 +134,27 @@ func (cfg *Elasticsearch) validate(storeName string) error {
 	if cfg.Indices[VisibilityAppName] == "" {
 		return fmt.Errorf("persistence config: advanced visibility datastore %q: missing %q key", storeName, VisibilityAppName)
 	}
+	switch cfg.AuthType {
+	case "", ESAuthTypeBasic, ESAuthTypeAWS:
+	case ESAuthTypeAPIKey:
+		if cfg.APIKey == "" {
+			return fmt.Errorf("persistence config: advanced visibility datastore %q: apiKey is required for %q auth type", storeName, ESAuthTypeAPIKey)
+		}
+	case ESAuthTypeBearer:
+		if cfg.Token == "" {
+			return fmt.Errorf("persistence config: advanced visibility datastore %q: token is required for %q auth type", storeName, ESAuthTypeBearer)
+		}
+	default:
+		return fmt.Errorf("persistence config: advanced visibility datastore %q: unknown auth type %q", storeName, cfg.AuthType)
+	}
+	if cfg.MaxConnsPerHost < 0 || cfg.MaxIdleConnsPerHost < 0 || cfg.RequestTimeout < 0 {
+		return fmt.Errorf("persistence config: advanced visibility datastore %q: connection settings must not be negative", storeName)
+	}
+	if cfg.Retry.InitialInterval < 0 || cfg.Retry.MaxInterval < 0 {
+		return fmt.Errorf("persistence config: advanced visibility datastore %q: retry intervals must not be negative", storeName)
+	}
+	if cfg.Retry.InitialInterval > 0 && cfg.Retry.MaxInterval > 0 && cfg.Retry.MaxInterval < cfg.Retry.InitialInterval {
+		return fmt.Errorf("persistence config: advanced visibility datastore %q: retry maxInterval is less than initialInterval", storeName)
+	}
 	return nil
 }
@@ -0,0 +1,93 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestElasticsearch_ValidateAuth(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   Elasticsearch
+		wantErr bool
+	}{
+		{
+			name:  "Default",
+			input: Elasticsearch{},
+		},
+		{
+			name:  "API key",
+			input: Elasticsearch{AuthType: ESAuthTypeAPIKey, APIKey: "key"},
+		},
+		{
+			name:    "Missing API key",
+			input:   Elasticsearch{AuthType: ESAuthTypeAPIKey},
+			wantErr: true,
+		},
+		{
+			name:  "Bearer",
+			input: Elasticsearch{AuthType: ESAuthTypeBearer, Token: "token"},
+		},
+		{
+			name:    "Missing token",
+			input:   Elasticsearch{AuthType: ESAuthTypeBearer},
+			wantErr: true,
+		},
+		{
+			name:  "AWS",
+			input: Elasticsearch{AuthType: ESAuthTypeAWS},
+		},
+		{
+			name:    "Unknown",
+			input:   Elasticsearch{AuthType: "kerberos"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := tt.input
+			cfg.Indices = map[string]string{VisibilityAppName: "index"}
+			err := cfg.validate("es")
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestElasticsearch_AWSRequestSigningEnabled(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, (&Elasticsearch{}).AWSRequestSigningEnabled())
+	assert.True(t, (&Elasticsearch{AuthType: ESAuthTypeAWS}).AWSRequestSigningEnabled())
+	assert.True(t, (&Elasticsearch{AWSRequestSigning: ESAWSRequestSigningConfig{Enabled: true}}).AWSRequestSigningEnabled())
+}
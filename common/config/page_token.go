@@ -0,0 +1,66 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"encoding/base64"
+	"errors"
+)
+
+type (
+	// PageToken is the config for protecting page tokens returned to clients. Clusters that forward
+	// requests to each other must share the same keys.
+	PageToken struct {
+		// SigningKey is the base64 encoded HMAC-SHA256 key used to sign page tokens, tokens are not signed if empty
+		SigningKey string `yaml:"signingKey"`
+		// EncryptionKey is the optional base64 encoded AES-128, AES-192 or AES-256 key used to encrypt page tokens,
+		// requires SigningKey
+		EncryptionKey string `yaml:"encryptionKey"`
+	}
+)
+
+func (c *PageToken) validate() error {
+	if c.SigningKey == "" {
+		if c.EncryptionKey != "" {
+			return errors.New("page token config: encryption key requires signing key")
+		}
+		return nil
+	}
+	if key, err := base64.StdEncoding.DecodeString(c.SigningKey); err != nil || len(key) == 0 {
+		return errors.New("page token config: invalid signing key")
+	}
+	if c.EncryptionKey != "" {
+		key, err := base64.StdEncoding.DecodeString(c.EncryptionKey)
+		if err != nil {
+			return errors.New("page token config: invalid encryption key")
+		}
+		switch len(key) {
+		case 16, 24, 32:
+		default:
+			return errors.New("page token config: encryption key must be 16, 24 or 32 bytes")
+		}
+	}
+	return nil
+}
@@ -68,6 +68,12 @@ func (c *Persistence) Validate() error {
 		return err
 	}
 
+	if c.VisibilityExporter != nil {
+		if err := c.VisibilityExporter.validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,71 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"errors"
+	"net/url"
+	"time"
+)
+
+type (
+	// VisibilityExporter is the config for exporting visibility records to external systems
+	// in addition to indexing them in visibility store
+	VisibilityExporter struct {
+		// Kafka contains the config for publishing visibility records to Kafka
+		Kafka *KafkaREST `yaml:"kafka"`
+		// BufferSize is the max number of records buffered for export, records are dropped if buffer is full
+		BufferSize int `yaml:"bufferSize"`
+		// BatchSize is the max number of records published in one request
+		BatchSize int `yaml:"batchSize"`
+		// FlushInterval is the max interval records are buffered before they are published
+		FlushInterval time.Duration `yaml:"flushInterval"`
+	}
+
+	// KafkaREST is the config for publishing records to Kafka topic through Kafka REST proxy (v2 API)
+	KafkaREST struct {
+		// URL is the URL of Kafka REST proxy
+		URL string `yaml:"url"`
+		// Topic is the Kafka topic records are published to
+		Topic string `yaml:"topic"`
+		// Headers are added to every request, i.e. for authorization
+		Headers map[string]string `yaml:"headers"`
+		// Timeout is the timeout of publish request
+		Timeout time.Duration `yaml:"timeout"`
+	}
+)
+
+func (c *VisibilityExporter) validate() error {
+	if c.Kafka == nil {
+		return errors.New("persistence config: visibility exporter: missing kafka config")
+	}
+	if _, err := url.Parse(c.Kafka.URL); err != nil || c.Kafka.URL == "" {
+		return errors.New("persistence config: visibility exporter: invalid kafka url")
+	}
+	if c.Kafka.Topic == "" {
+		return errors.New("persistence config: visibility exporter: missing kafka topic")
+	}
+	return nil
+}
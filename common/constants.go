@@ -121,3 +121,11 @@ const (
 	// AdvancedVisibilityWritingModeDual means write to both normal visibility and advanced visibility store
 	AdvancedVisibilityWritingModeDual = "dual"
 )
+
+// enum for dynamic config BlobSizeAccountingMode
+const (
+	// BlobSizeAccountingModeTotal means blob size limits apply to the total encoded size, including payload metadata
+	BlobSizeAccountingModeTotal = "total"
+	// BlobSizeAccountingModeData means blob size limits apply to the encoded size excluding payload metadata
+	BlobSizeAccountingModeData = "data"
+)
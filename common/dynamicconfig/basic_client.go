@@ -27,10 +27,15 @@ package dynamicconfig
 import (
 	"errors"
 	"fmt"
+	"reflect"
+	"sort"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/primitives/timestamp"
 )
 
@@ -45,14 +50,93 @@ type constrainedValue struct {
 
 type basicClient struct {
 	values atomic.Value // configValueMap
+	logger log.Logger
+
+	subscriptionLock   sync.Mutex
+	nextSubscriptionID int
+	subscriptions      map[int]ChangeCallback
 }
 
-func newBasicClient() *basicClient {
-	bc := &basicClient{}
+// keysByName maps lower case key names to keys
+var keysByName = func() map[string]Key {
+	result := make(map[string]Key, len(Keys))
+	for key, keyName := range Keys {
+		result[strings.ToLower(keyName)] = key
+	}
+	return result
+}()
+
+func newBasicClient(logger log.Logger) *basicClient {
+	bc := &basicClient{
+		logger:        logger,
+		subscriptions: make(map[int]ChangeCallback),
+	}
 	bc.values.Store(configValueMap{})
 	return bc
 }
 
+func (bc *basicClient) Subscribe(callback ChangeCallback) func() {
+	bc.subscriptionLock.Lock()
+	defer bc.subscriptionLock.Unlock()
+
+	id := bc.nextSubscriptionID
+	bc.nextSubscriptionID++
+	bc.subscriptions[id] = callback
+	return func() {
+		bc.subscriptionLock.Lock()
+		defer bc.subscriptionLock.Unlock()
+		delete(bc.subscriptions, id)
+	}
+}
+
+// updateValues replaces all values and notifies subscribers about changed keys.
+// Calls must be serialized by the caller.
+func (bc *basicClient) updateValues(newValues configValueMap) {
+	oldValues := bc.values.Load().(configValueMap)
+	bc.values.Store(newValues)
+
+	changedKeys := getChangedKeys(oldValues, newValues)
+	if len(changedKeys) == 0 {
+		return
+	}
+	for _, key := range changedKeys {
+		bc.logger.Info("Dynamic config value changed", tag.Key(key.String()))
+	}
+
+	bc.subscriptionLock.Lock()
+	callbacks := make([]ChangeCallback, 0, len(bc.subscriptions))
+	for _, callback := range bc.subscriptions {
+		callbacks = append(callbacks, callback)
+	}
+	bc.subscriptionLock.Unlock()
+
+	for _, callback := range callbacks {
+		callback(changedKeys)
+	}
+}
+
+// getChangedKeys returns known keys with different values, sorted by key name.
+func getChangedKeys(oldValues, newValues configValueMap) []Key {
+	var changedKeys []Key
+	for keyName, values := range newValues {
+		if key, ok := keysByName[keyName]; ok && !reflect.DeepEqual(values, oldValues[keyName]) {
+			changedKeys = append(changedKeys, key)
+		}
+	}
+	for keyName := range oldValues {
+		if _, ok := newValues[keyName]; ok {
+			continue
+		}
+		if key, ok := keysByName[keyName]; ok {
+			changedKeys = append(changedKeys, key)
+		}
+	}
+	sort.Slice(changedKeys, func(i, j int) bool {
+		return changedKeys[i].String() < changedKeys[j].String()
+	})
+	return changedKeys
+}
+
 func (bc *basicClient) GetValue(
 	name Key,
 	defaultValue interface{},
@@ -80,6 +80,21 @@ func (c *Collection) logValue(
 	}
 }
 
+// Subscribe registers callback to be called whenever the value of any of the keys changes
+// and returns a function to cancel the subscription.
+func (c *Collection) Subscribe(callback func(), keys ...Key) (unsubscribe func()) {
+	return c.client.Subscribe(func(changedKeys []Key) {
+		for _, changedKey := range changedKeys {
+			for _, key := range keys {
+				if changedKey == key {
+					callback()
+					return
+				}
+			}
+		}
+	})
+}
+
 // PropertyFn is a wrapper to get property from dynamic config
 type PropertyFn func() interface{}
 
@@ -82,6 +82,11 @@ func GetStringPropertyFn(value string) func(opts ...FilterOption) string {
 	return func(...FilterOption) string { return value }
 }
 
+// GetStringPropertyFnWithNamespaceFilter returns value as StringPropertyFnWithNamespaceFilter
+func GetStringPropertyFnWithNamespaceFilter(value string) func(namespace string) string {
+	return func(namespace string) string { return value }
+}
+
 // GetMapPropertyFn returns value as MapPropertyFn
 func GetMapPropertyFn(value map[string]interface{}) func(opts ...FilterOption) map[string]interface{} {
 	return func(...FilterOption) map[string]interface{} { return value }
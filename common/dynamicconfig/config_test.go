@@ -119,6 +119,10 @@ func (mc *inMemoryClient) GetDurationValue(
 	return defaultValue, errors.New("unable to find key")
 }
 
+func (mc *inMemoryClient) Subscribe(callback ChangeCallback) func() {
+	return func() {}
+}
+
 type configSuite struct {
 	suite.Suite
 	client *inMemoryClient
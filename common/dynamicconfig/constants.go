@@ -71,6 +71,9 @@ var Keys = map[Key]string{
 	EnableVisibilitySampling:               "system.enableVisibilitySampling",
 	AdvancedVisibilityWritingMode:          "system.advancedVisibilityWritingMode",
 	EnableReadVisibilityFromES:             "system.enableReadVisibilityFromES",
+	EnableVisibilityExport:                 "system.enableVisibilityExport",
+	VisibilitySinks:                        "system.visibilitySinks",
+	VisibilityBestEffortSinks:              "system.visibilityBestEffortSinks",
 	HistoryArchivalState:                   "system.historyArchivalState",
 	EnableReadFromHistoryArchival:          "system.enableReadFromHistoryArchival",
 	VisibilityArchivalState:                "system.visibilityArchivalState",
@@ -80,21 +83,36 @@ var Keys = map[Key]string{
 	DisallowQuery:                          "system.disallowQuery",
 	EnableBatcher:                          "worker.enableBatcher",
 	EnableParentClosePolicyWorker:          "system.enableParentClosePolicyWorker",
+	EnableCallbackWorker:                   "system.enableCallbackWorker",
+	EnableSchedulerWorker:                  "system.enableSchedulerWorker",
+	CallbackAllowedHosts:                   "system.callbackAllowedHosts",
 	EnableStickyQuery:                      "system.enableStickyQuery",
 	EnablePriorityTaskProcessor:            "system.enablePriorityTaskProcessor",
 	EnableAuthorization:                    "system.enableAuthorization",
 	EnableCrossNamespaceCommands:           "system.enableCrossNamespaceCommands",
+	TracingSampleRatio:                     "system.tracingSampleRatio",
+	NamespaceIsolationGroup:                "system.namespaceIsolationGroup",
 
 	// size limit
-	BlobSizeLimitError:     "limit.blobSize.error",
-	BlobSizeLimitWarn:      "limit.blobSize.warn",
-	MemoSizeLimitError:     "limit.memoSize.error",
-	MemoSizeLimitWarn:      "limit.memoSize.warn",
-	HistorySizeLimitError:  "limit.historySize.error",
-	HistorySizeLimitWarn:   "limit.historySize.warn",
-	HistoryCountLimitError: "limit.historyCount.error",
-	HistoryCountLimitWarn:  "limit.historyCount.warn",
-	MaxIDLengthLimit:       "limit.maxIDLength",
+	BlobSizeLimitError:             "limit.blobSize.error",
+	BlobSizeLimitWarn:              "limit.blobSize.warn",
+	BlobSizeAccountingMode:         "limit.blobSize.accountingMode",
+	MemoSizeLimitError:             "limit.memoSize.error",
+	MemoSizeLimitWarn:              "limit.memoSize.warn",
+	HistorySizeLimitError:          "limit.historySize.error",
+	HistorySizeLimitWarn:           "limit.historySize.warn",
+	HistoryCountLimitError:         "limit.historyCount.error",
+	HistoryCountLimitWarn:          "limit.historyCount.warn",
+	MutableStateSizeLimitError:     "limit.mutableStateSize.error",
+	MutableStateSizeLimitWarn:      "limit.mutableStateSize.warn",
+	StateTransitionCountLimitError: "limit.stateTransitionCount.error",
+	StateTransitionCountLimitWarn:  "limit.stateTransitionCount.warn",
+	MaxIDLengthLimit:               "limit.maxIDLength",
+	OpenWorkflowCountLimit:         "limit.openWorkflowCount",
+
+	NumPendingActivitiesLimitError:      "limit.numPendingActivities.error",
+	NumPendingChildExecutionsLimitError: "limit.numPendingChildExecutions.error",
+	NumPendingSignalsLimitError:         "limit.numPendingSignals.error",
 
 	// frontend settings
 	FrontendPersistenceMaxQPS:             "frontend.persistenceMaxQPS",
@@ -104,14 +122,35 @@ var Keys = map[Key]string{
 	FrontendESVisibilityListMaxQPS:        "frontend.esVisibilityListMaxQPS",
 	FrontendMaxBadBinaries:                "frontend.maxBadBinaries",
 	FrontendESIndexMaxResultWindow:        "frontend.esIndexMaxResultWindow",
+	FrontendESPointInTimeKeepAlive:        "frontend.esPointInTimeKeepAlive",
+	FrontendESParseDocStrictMode:          "frontend.esParseDocStrictMode",
 	FrontendHistoryMaxPageSize:            "frontend.historyMaxPageSize",
 	FrontendRPS:                           "frontend.rps",
 	FrontendMaxNamespaceRPSPerInstance:    "frontend.namespaceRPS",
 	FrontendMaxNamespaceCountPerInstance:  "frontend.namespaceCount",
 	FrontendGlobalNamespaceRPS:            "frontend.globalNamespacerps",
+	FrontendNamespaceWriteRPS:             "frontend.namespaceRPS.write",
+	FrontendNamespaceReadRPS:              "frontend.namespaceRPS.read",
+	FrontendNamespaceVisibilityRPS:        "frontend.namespaceRPS.visibility",
+	FrontendNamespaceLongPollRPS:          "frontend.namespaceRPS.longPoll",
+	FrontendNamespaceBurstRatio:           "frontend.namespaceBurstRatio",
+	FrontendAdaptiveConcurrencyEnabled:    "frontend.adaptiveConcurrencyEnabled",
+	FrontendAdaptiveConcurrencyMinLimit:   "frontend.adaptiveConcurrencyMinLimit",
+	FrontendAdaptiveConcurrencyMaxLimit:   "frontend.adaptiveConcurrencyMaxLimit",
 	FrontendHistoryMgrNumConns:            "frontend.historyMgrNumConns",
 	FrontendShutdownDrainDuration:         "frontend.shutdownDrainDuration",
+	FrontendMaintenanceRefreshInterval:    "frontend.maintenanceRefreshInterval",
+	FrontendNamespaceHandoverDrainTimeout: "frontend.namespaceHandoverDrainTimeout",
+	FrontendAuthorizationLogOnly:          "frontend.authorizationLogOnly",
+	FrontendAuditLogSamplingRate:          "frontend.auditLogSamplingRate",
+	FrontendSLOTrackingEnabled:            "frontend.sloTrackingEnabled",
+	FrontendSLOAvailabilityTarget:         "frontend.sloAvailabilityTarget",
+	FrontendSLOLatencyTarget:              "frontend.sloLatencyTarget",
+	FrontendSLOLatencyTargetOverrides:     "frontend.sloLatencyTargetOverrides",
+	FrontendSLOLatencyObjective:           "frontend.sloLatencyObjective",
 	DisableListVisibilityByFilter:         "frontend.disableListVisibilityByFilter",
+	VisibilityFallbackToPersistence:       "frontend.visibilityFallbackToPersistence",
+	QuarantinedWorkflowIDs:                "frontend.quarantinedWorkflowIDs",
 	FrontendThrottledLogRPS:               "frontend.throttledLogRPS",
 	EnableClientVersionCheck:              "frontend.enableClientVersionCheck",
 	SendRawWorkflowHistory:                "frontend.sendRawWorkflowHistory",
@@ -130,6 +169,8 @@ var Keys = map[Key]string{
 	KeepAliveMaxConnectionAgeGrace:        "frontend.keepAliveMaxConnectionAgeGrace",
 	KeepAliveTime:                         "frontend.keepAliveTime",
 	KeepAliveTimeout:                      "frontend.keepAliveTimeout",
+	FrontendHistoryStreamEventsPerSecond:  "frontend.historyStreamEventsPerSecond",
+	HistoryReverseMaxScannedEvents:        "frontend.historyReverseMaxScannedEvents",
 
 	// matching settings
 	MatchingRPS:                             "matching.rps",
@@ -139,6 +180,7 @@ var Keys = map[Key]string{
 	MatchingGetTasksBatchSize:               "matching.getTasksBatchSize",
 	MatchingLongPollExpirationInterval:      "matching.longPollExpirationInterval",
 	MatchingSyncMatchWaitDuration:           "matching.syncMatchWaitDuration",
+	MatchingSyncMatchPollerWait:             "matching.syncMatchPollerWait",
 	MatchingUpdateAckInterval:               "matching.updateAckInterval",
 	MatchingIdleTaskqueueCheckInterval:      "matching.idleTaskqueueCheckInterval",
 	MaxTaskqueueIdleTime:                    "matching.maxTaskqueueIdleTime",
@@ -154,9 +196,20 @@ var Keys = map[Key]string{
 	MatchingForwarderMaxChildrenPerNode:     "matching.forwarderMaxChildrenPerNode",
 	ResilientSyncMatch:                      "matching.resilientSyncMatch",
 	MatchingShutdownDrainDuration:           "matching.shutdownDrainDuration",
+	MatchingUserDataRefreshInterval:         "matching.userDataRefreshInterval",
+	MatchingVersionCompatibleSetLimit:       "matching.versionCompatibleSetLimitPerQueue",
+	MatchingVersionBuildIdLimit:             "matching.versionBuildIdLimitPerQueue",
+	MatchingEvictStickyQueueOnPollCancel:    "matching.evictStickyQueueOnPollCancel",
+	MatchingStickyQueueEvictionTTL:          "matching.stickyQueueEvictionTTL",
+	MatchingMaxTaskQueueDispatchRate:        "matching.maxTaskQueueDispatchRate",
+	MatchingIsolationGroupBudget:            "matching.isolationGroupBudget",
 
 	// history settings
 	HistoryRPS:                                           "history.rps",
+	HistoryAdaptiveConcurrencyEnabled:                    "history.adaptiveConcurrencyEnabled",
+	HistoryAdaptiveConcurrencyMinLimit:                   "history.adaptiveConcurrencyMinLimit",
+	HistoryAdaptiveConcurrencyMaxLimit:                   "history.adaptiveConcurrencyMaxLimit",
+	HistoryIsolationGroupBudget:                          "history.isolationGroupBudget",
 	HistoryPersistenceMaxQPS:                             "history.persistenceMaxQPS",
 	HistoryPersistenceGlobalMaxQPS:                       "history.persistenceGlobalMaxQPS",
 	HistoryVisibilityOpenMaxQPS:                          "history.historyVisibilityOpenMaxQPS",
@@ -166,21 +219,27 @@ var Keys = map[Key]string{
 	HistoryMaxAutoResetPoints:                            "history.historyMaxAutoResetPoints",
 	HistoryCacheMaxSize:                                  "history.cacheMaxSize",
 	HistoryCacheTTL:                                      "history.cacheTTL",
+	HistoryCacheLoadDeduplication:                        "history.cacheLoadDeduplication",
 	HistoryShutdownDrainDuration:                         "history.shutdownDrainDuration",
 	EventsCacheInitialSize:                               "history.eventsCacheInitialSize",
 	EventsCacheMaxSize:                                   "history.eventsCacheMaxSize",
 	EventsCacheTTL:                                       "history.eventsCacheTTL",
 	AcquireShardInterval:                                 "history.acquireShardInterval",
 	AcquireShardConcurrency:                              "history.acquireShardConcurrency",
+	ShardRejoinGracePeriod:                               "history.shardRejoinGracePeriod",
+	ShardHibernationIdleTimeout:                          "history.shardHibernationIdleTimeout",
 	StandbyClusterDelay:                                  "history.standbyClusterDelay",
 	StandbyTaskMissingEventsResendDelay:                  "history.standbyTaskMissingEventsResendDelay",
 	StandbyTaskMissingEventsDiscardDelay:                 "history.standbyTaskMissingEventsDiscardDelay",
 	TaskProcessRPS:                                       "history.taskProcessRPS",
+	TaskNamespaceTier:                                    "history.taskNamespaceTier",
 	TaskSchedulerType:                                    "history.taskSchedulerType",
 	TaskSchedulerWorkerCount:                             "history.taskSchedulerWorkerCount",
 	TaskSchedulerQueueSize:                               "history.taskSchedulerQueueSize",
 	TaskSchedulerRoundRobinWeights:                       "history.taskSchedulerRoundRobinWeight",
+	EnableTaskWorkerPoolAutoscaling:                      "history.enableTaskWorkerPoolAutoscaling",
 	TimerTaskBatchSize:                                   "history.timerTaskBatchSize",
+	TimerTaskMinWorkerCount:                              "history.timerTaskMinWorkerCount",
 	TimerTaskWorkerCount:                                 "history.timerTaskWorkerCount",
 	TimerTaskMaxRetryCount:                               "history.timerTaskMaxRetryCount",
 	TimerProcessorGetFailureRetryCount:                   "history.timerProcessorGetFailureRetryCount",
@@ -203,6 +262,7 @@ var Keys = map[Key]string{
 	TransferTaskBatchSize:                                "history.transferTaskBatchSize",
 	TransferProcessorFailoverMaxPollRPS:                  "history.transferProcessorFailoverMaxPollRPS",
 	TransferProcessorMaxPollRPS:                          "history.transferProcessorMaxPollRPS",
+	TransferTaskMinWorkerCount:                           "history.transferTaskMinWorkerCount",
 	TransferTaskWorkerCount:                              "history.transferTaskWorkerCount",
 	TransferTaskMaxRetryCount:                            "history.transferTaskMaxRetryCount",
 	TransferProcessorCompleteTransferFailureRetryCount:   "history.transferProcessorCompleteTransferFailureRetryCount",
@@ -221,6 +281,7 @@ var Keys = map[Key]string{
 	VisibilityTaskBatchSize:                                "history.visibilityTaskBatchSize",
 	VisibilityProcessorFailoverMaxPollRPS:                  "history.visibilityProcessorFailoverMaxPollRPS",
 	VisibilityProcessorMaxPollRPS:                          "history.visibilityProcessorMaxPollRPS",
+	VisibilityTaskMinWorkerCount:                           "history.visibilityTaskMinWorkerCount",
 	VisibilityTaskWorkerCount:                              "history.visibilityTaskWorkerCount",
 	VisibilityTaskMaxRetryCount:                            "history.visibilityTaskMaxRetryCount",
 	VisibilityProcessorCompleteTaskFailureRetryCount:       "history.visibilityProcessorCompleteTaskFailureRetryCount",
@@ -251,6 +312,8 @@ var Keys = map[Key]string{
 	ReplicatorProcessorEnablePriorityTaskProcessor:         "history.replicatorProcessorEnablePriorityTaskProcessor",
 	MaximumBufferedEventsBatch:                             "history.maximumBufferedEventsBatch",
 	MaximumSignalsPerExecution:                             "history.maximumSignalsPerExecution",
+	HistoryCompactionMaxBatchEventCount:                    "history.historyCompactionMaxBatchEventCount",
+	HistoryCompactionMaxBatchSize:                          "history.historyCompactionMaxBatchSize",
 	ShardUpdateMinInterval:                                 "history.shardUpdateMinInterval",
 	ShardSyncMinInterval:                                   "history.shardSyncMinInterval",
 	ShardSyncTimerJitterCoefficient:                        "history.shardSyncMinInterval",
@@ -265,6 +328,7 @@ var Keys = map[Key]string{
 	DefaultWorkflowTaskTimeout:                             "history.defaultWorkflowTaskTimeout",
 	ParentClosePolicyThreshold:                             "history.parentClosePolicyThreshold",
 	NumParentClosePolicySystemWorkflows:                    "history.numParentClosePolicySystemWorkflows",
+	NumCallbackSystemWorkflows:                             "history.numCallbackSystemWorkflows",
 	ReplicationTaskFetcherParallelism:                      "history.ReplicationTaskFetcherParallelism",
 	ReplicationTaskFetcherAggregationInterval:              "history.ReplicationTaskFetcherAggregationInterval",
 	ReplicationTaskFetcherTimerJitterCoefficient:           "history.ReplicationTaskFetcherTimerJitterCoefficient",
@@ -281,10 +345,16 @@ var Keys = map[Key]string{
 	ReplicationTaskProcessorStartWaitJitterCoefficient:     "history.ReplicationTaskProcessorStartWaitJitterCoefficient",
 	ReplicationTaskProcessorHostQPS:                        "history.ReplicationTaskProcessorHostQPS",
 	ReplicationTaskProcessorShardQPS:                       "history.ReplicationTaskProcessorShardQPS",
+	ReplicationTaskProcessorEnableStreaming:                "history.ReplicationTaskProcessorEnableStreaming",
+	ReplicationStreamHeartbeatInterval:                     "history.ReplicationStreamHeartbeatInterval",
 	MaxBufferedQueryCount:                                  "history.MaxBufferedQueryCount",
 	MutableStateChecksumGenProbability:                     "history.mutableStateChecksumGenProbability",
 	MutableStateChecksumVerifyProbability:                  "history.mutableStateChecksumVerifyProbability",
 	MutableStateChecksumInvalidateBefore:                   "history.mutableStateChecksumInvalidateBefore",
+	MutableStateIntegrityCheckEnabled:                      "history.mutableStateIntegrityCheckEnabled",
+	EnableWorkflowAuditLog:                                 "history.enableWorkflowAuditLog",
+	StickyQueryDispatchDeadline:                            "history.stickyQueryDispatchDeadline",
+	OpenWorkflowCountRefreshInterval:                       "history.openWorkflowCountRefreshInterval",
 	ReplicationEventsFromCurrentCluster:                    "history.ReplicationEventsFromCurrentCluster",
 	StandbyTaskReReplicationContextTimeout:                 "history.standbyTaskReReplicationContextTimeout",
 	EnableDropStuckTaskByNamespaceID:                       "history.DropStuckTaskByNamespace",
@@ -309,6 +379,8 @@ var Keys = map[Key]string{
 	WorkerESProcessorBulkSize:                       "worker.ESProcessorBulkSize",
 	WorkerESProcessorFlushInterval:                  "worker.ESProcessorFlushInterval",
 	WorkerESProcessorAckTimeout:                     "worker.ESProcessorAckTimeout",
+	WorkerESProcessorBackfillWorkers:                "worker.ESProcessorBackfillWorkers",
+	WorkerESProcessorShutdownDrainTimeout:           "worker.ESProcessorShutdownDrainTimeout",
 	EnableArchivalCompression:                       "worker.EnableArchivalCompression",
 	WorkerHistoryPageSize:                           "worker.WorkerHistoryPageSize",
 	WorkerTargetArchivalBlobSize:                    "worker.WorkerTargetArchivalBlobSize",
@@ -322,6 +394,12 @@ var Keys = map[Key]string{
 	TaskQueueScannerEnabled:                         "worker.taskQueueScannerEnabled",
 	HistoryScannerEnabled:                           "worker.historyScannerEnabled",
 	ExecutionsScannerEnabled:                        "worker.executionsScannerEnabled",
+	EnableSearchAttributeMetrics:                    "worker.enableSearchAttributeMetrics",
+	SearchAttributeMetricsRefreshInterval:           "worker.searchAttributeMetricsRefreshInterval",
+	SearchAttributeMetricsGroupBy:                   "worker.searchAttributeMetricsGroupBy",
+	SearchAttributeMetricsValues:                    "worker.searchAttributeMetricsValues",
+	SearchAttributeMetricsTopN:                      "worker.searchAttributeMetricsTopN",
+	SearchAttributeMetricsSampleSize:                "worker.searchAttributeMetricsSampleSize",
 }
 
 const (
@@ -361,8 +439,22 @@ const (
 	EmitShardDiffLog
 	// EnableReadVisibilityFromES is key for enable read from elastic search
 	EnableReadVisibilityFromES
+	// EnableVisibilityExport is key for enable exporting visibility records of the namespace
+	// when visibility exporter is configured
+	EnableVisibilityExport
+	// VisibilitySinks is key for comma separated list of sinks (es, db, kafka, noop) visibility records
+	// of the namespace are written to, in order. Empty value derives sinks from AdvancedVisibilityWritingMode.
+	VisibilitySinks
+	// VisibilityBestEffortSinks is key for comma separated list of sinks which write failures are ignored
+	VisibilityBestEffortSinks
 	// DisableListVisibilityByFilter is config to disable list open/close workflow using filter
 	DisableListVisibilityByFilter
+	// VisibilityFallbackToPersistence is config to serve list open workflow using execution filter from the current
+	// execution in persistence when the visibility store (i.e. ElasticSearch) is unavailable
+	VisibilityFallbackToPersistence
+	// QuarantinedWorkflowIDs is the map of quarantined workflow IDs of a namespace to the reason of quarantine,
+	// signals and queries to quarantined workflows are rejected
+	QuarantinedWorkflowIDs
 	// HistoryArchivalState is key for the state of history archival
 	HistoryArchivalState
 	// EnableReadFromHistoryArchival is key for enabling reading history from archival store
@@ -384,10 +476,19 @@ const (
 	EnableAuthorization
 	// EnableCrossNamespaceCommands is the key to enable commands for external namespaces
 	EnableCrossNamespaceCommands
+	// TracingSampleRatio is the ratio of traces started by the server which are sampled,
+	// traces started by callers follow the sampling decision of the caller
+	TracingSampleRatio
+	// NamespaceIsolationGroup is the isolation group of a namespace, history task processing and matching dispatch
+	// of each group are limited by the concurrency budget of the group. Namespaces without group are in the default group
+	NamespaceIsolationGroup
 	// BlobSizeLimitError is the per event blob size limit
 	BlobSizeLimitError
 	// BlobSizeLimitWarn is the per event blob size limit for warning
 	BlobSizeLimitWarn
+	// BlobSizeAccountingMode is how blob and memo sizes are measured against their limits,
+	// either "total" or "data" which excludes payload metadata
+	BlobSizeAccountingMode
 	// MemoSizeLimitError is the per event memo size limit
 	MemoSizeLimitError
 	// MemoSizeLimitWarn is the per event memo size limit for warning
@@ -400,10 +501,27 @@ const (
 	HistoryCountLimitError
 	// HistoryCountLimitWarn is the per workflow execution history event count limit for warning
 	HistoryCountLimitWarn
+	// MutableStateSizeLimitError is the per workflow execution mutable state size limit in bytes
+	MutableStateSizeLimitError
+	// MutableStateSizeLimitWarn is the per workflow execution mutable state size limit in bytes for warning
+	MutableStateSizeLimitWarn
+	// StateTransitionCountLimitError is the per workflow execution state transition count limit, 0 means no limit
+	StateTransitionCountLimitError
+	// StateTransitionCountLimitWarn is the per workflow execution state transition count limit for warning, 0 means no limit
+	StateTransitionCountLimitWarn
 
 	// MaxIDLengthLimit is the length limit for various IDs, including: Namespace, TaskQueue, WorkflowID, ActivityID, TimerID,
 	// WorkflowType, ActivityType, SignalName, MarkerName, ErrorReason/FailureReason/CancelCause, Identity, RequestID
 	MaxIDLengthLimit
+	// OpenWorkflowCountLimit is the per namespace limit on concurrently open workflow executions, 0 means no limit
+	OpenWorkflowCountLimit
+	// NumPendingActivitiesLimitError is the per workflow execution limit on pending activities, 0 means no limit
+	NumPendingActivitiesLimitError
+	// NumPendingChildExecutionsLimitError is the per workflow execution limit on pending child workflows, 0 means no limit
+	NumPendingChildExecutionsLimitError
+	// NumPendingSignalsLimitError is the per workflow execution limit on signals to external workflows
+	// which have not been delivered yet, 0 means no limit
+	NumPendingSignalsLimitError
 
 	// key for frontend
 
@@ -419,6 +537,12 @@ const (
 	FrontendESVisibilityListMaxQPS
 	// FrontendESIndexMaxResultWindow is ElasticSearch index setting max_result_window
 	FrontendESIndexMaxResultWindow
+	// FrontendESPointInTimeKeepAlive is how long ElasticSearch keeps a point in time of ScanWorkflowExecutions
+	// between pages. Point in times which are not used for longer are closed by the frontend.
+	FrontendESPointInTimeKeepAlive
+	// FrontendESParseDocStrictMode fails visibility requests if any ElasticSearch document field can't be parsed
+	// or is unknown. Otherwise partially parsed documents are returned with parse errors in memo.
+	FrontendESParseDocStrictMode
 	// FrontendHistoryMaxPageSize is default max size for GetWorkflowExecutionHistory in one page
 	FrontendHistoryMaxPageSize
 	// FrontendRPS is workflow rate limit per second
@@ -429,12 +553,54 @@ const (
 	FrontendMaxNamespaceCountPerInstance
 	// FrontendGlobalNamespaceRPS is workflow namespace rate limit per second for the whole cluster
 	FrontendGlobalNamespaceRPS
+	// FrontendNamespaceWriteRPS is per instance namespace rate limit per second for write APIs,
+	// 0 means only the namespace rate limit applies
+	FrontendNamespaceWriteRPS
+	// FrontendNamespaceReadRPS is per instance namespace rate limit per second for read APIs,
+	// 0 means only the namespace rate limit applies
+	FrontendNamespaceReadRPS
+	// FrontendNamespaceVisibilityRPS is per instance namespace rate limit per second for visibility APIs,
+	// 0 means only the namespace rate limit applies
+	FrontendNamespaceVisibilityRPS
+	// FrontendNamespaceLongPollRPS is per instance namespace rate limit per second for task queue long polls,
+	// 0 means only the namespace rate limit applies
+	FrontendNamespaceLongPollRPS
+	// FrontendNamespaceBurstRatio is the ratio of burst to rate of namespace API group rate limiters
+	FrontendNamespaceBurstRatio
+	// FrontendAdaptiveConcurrencyEnabled enables shedding of requests exceeding the adaptive concurrency limit,
+	// the limit decreases when request latency climbs (i.e. due to persistence degradation)
+	FrontendAdaptiveConcurrencyEnabled
+	// FrontendAdaptiveConcurrencyMinLimit is the lower bound of the adaptive concurrency limit per frontend host
+	FrontendAdaptiveConcurrencyMinLimit
+	// FrontendAdaptiveConcurrencyMaxLimit is the upper bound of the adaptive concurrency limit per frontend host
+	FrontendAdaptiveConcurrencyMaxLimit
 	// FrontendHistoryMgrNumConns is for persistence cluster.NumConns
 	FrontendHistoryMgrNumConns
 	// FrontendThrottledLogRPS is the rate limit on number of log messages emitted per second for throttled logger
 	FrontendThrottledLogRPS
 	// FrontendShutdownDrainDuration is the duration of traffic drain during shutdown
 	FrontendShutdownDrainDuration
+	// FrontendMaintenanceRefreshInterval is how often frontend reloads the maintenance info returned to clients
+	FrontendMaintenanceRefreshInterval
+	// FrontendNamespaceHandoverDrainTimeout is the default time to wait for replication to drain during namespace handover
+	FrontendNamespaceHandoverDrainTimeout
+	// FrontendAuthorizationLogOnly makes frontend log requests failing authorization for a namespace instead of rejecting them,
+	// which allows to roll out authorization rules without impacting callers
+	FrontendAuthorizationLogOnly
+	// FrontendAuditLogSamplingRate is the rate of allowed privileged requests of a namespace recorded in audit log,
+	// denied requests are always recorded
+	FrontendAuditLogSamplingRate
+	// FrontendSLOTrackingEnabled enables computation of per API and namespace SLIs and burn rate metrics
+	FrontendSLOTrackingEnabled
+	// FrontendSLOAvailabilityTarget is the target ratio of requests per API and namespace that do not fail with a server error
+	FrontendSLOAvailabilityTarget
+	// FrontendSLOLatencyTarget is the latency a successful request should complete within to count towards the latency SLO
+	FrontendSLOLatencyTarget
+	// FrontendSLOLatencyTargetOverrides maps API names to latency targets (e.g. "StartWorkflowExecution": "500ms")
+	// overriding FrontendSLOLatencyTarget
+	FrontendSLOLatencyTargetOverrides
+	// FrontendSLOLatencyObjective is the target ratio of successful requests completing within the latency target
+	FrontendSLOLatencyObjective
 	// EnableClientVersionCheck enables client version check for frontend
 	EnableClientVersionCheck
 
@@ -484,6 +650,12 @@ const (
 	// KeepAliveTimeout After having pinged for keepalive check, the server waits for a duration
 	// of Timeout and if no activity is seen even after that the connection is closed.
 	KeepAliveTimeout
+	// FrontendHistoryStreamEventsPerSecond is the rate limit of events sent on a single
+	// StreamWorkflowExecutionHistory stream, 0 means no limit
+	FrontendHistoryStreamEventsPerSecond
+	// HistoryReverseMaxScannedEvents is the max number of events scanned by a single
+	// GetWorkflowExecutionHistoryReverse call before a page is returned
+	HistoryReverseMaxScannedEvents
 
 	// key for matching
 
@@ -501,6 +673,9 @@ const (
 	MatchingLongPollExpirationInterval
 	// MatchingSyncMatchWaitDuration is to wait time for sync match
 	MatchingSyncMatchWaitDuration
+	// MatchingSyncMatchPollerWait is how long sync match waits for a local poller before the task is written to
+	// the backlog. Longer wait reduces backlog writes at the cost of AddTask latency. 0 means no wait.
+	MatchingSyncMatchPollerWait
 	// MatchingUpdateAckInterval is the interval for update ack
 	MatchingUpdateAckInterval
 	// MatchingIdleTaskqueueCheckInterval is the IdleTaskqueueCheckInterval
@@ -531,11 +706,39 @@ const (
 	ResilientSyncMatch
 	// MatchingShutdownDrainDuration is the duration of traffic drain during shutdown
 	MatchingShutdownDrainDuration
+	// MatchingUserDataRefreshInterval is the interval at which task queue partitions fetch the user data from the root partition
+	MatchingUserDataRefreshInterval
+	// MatchingVersionCompatibleSetLimit is the maximum number of compatible worker build id sets of a task queue
+	MatchingVersionCompatibleSetLimit
+	// MatchingVersionBuildIdLimit is the maximum number of worker build ids in all compatible sets of a task queue
+	MatchingVersionBuildIdLimit
+	// MatchingEvictStickyQueueOnPollCancel evicts a sticky task queue when the worker cancels its poll, which happens on worker shutdown
+	MatchingEvictStickyQueueOnPollCancel
+	// MatchingStickyQueueEvictionTTL is how long an evicted sticky task queue keeps rejecting tasks unless it is polled again
+	MatchingStickyQueueEvictionTTL
+	// MatchingMaxTaskQueueDispatchRate is the max rate at which tasks are dispatched from a task queue, summed over
+	// all its partitions and enforced regardless of the rate requested by pollers. Zero means no limit
+	MatchingMaxTaskQueueDispatchRate
+	// MatchingIsolationGroupBudget is the max number of task queues per matching host which concurrently
+	// dispatch backlog tasks, keyed by isolation group. Groups without budget are not limited
+	MatchingIsolationGroupBudget
 
 	// key for history
 
 	// HistoryRPS is request rate per second for each history host
 	HistoryRPS
+	// HistoryAdaptiveConcurrencyEnabled enables shedding of requests exceeding the adaptive concurrency limit,
+	// the limit decreases when request latency climbs (i.e. due to persistence degradation)
+	HistoryAdaptiveConcurrencyEnabled
+	// HistoryAdaptiveConcurrencyMinLimit is the lower bound of the adaptive concurrency limit per history host
+	HistoryAdaptiveConcurrencyMinLimit
+	// HistoryAdaptiveConcurrencyMaxLimit is the upper bound of the adaptive concurrency limit per history host
+	HistoryAdaptiveConcurrencyMaxLimit
+	// HistoryIsolationGroupBudget is the max number of transfer, timer and visibility tasks per history host
+	// which are concurrently processed, keyed by isolation group. Groups without budget are not limited.
+	// The budget doesn't apply to queue processors using the priority task processor
+	// (i.e. TransferProcessorEnablePriorityTaskProcessor), as tasks are scheduled by namespace tier there
+	HistoryIsolationGroupBudget
 	// HistoryPersistenceMaxQPS is the max qps history host can query DB
 	HistoryPersistenceMaxQPS
 	// HistoryPersistenceGlobalMaxQPS is the max qps history cluster can query DB
@@ -552,6 +755,9 @@ const (
 	HistoryCacheMaxSize
 	// HistoryCacheTTL is TTL of history cache
 	HistoryCacheTTL
+	// HistoryCacheLoadDeduplication enables deduplication of concurrent reads of the current run of the same
+	// workflow when history cache is called without run id
+	HistoryCacheLoadDeduplication
 	// HistoryShutdownDrainDuration is the duration of traffic drain during shutdown
 	HistoryShutdownDrainDuration
 	// EventsCacheInitialSize is initial size of events cache
@@ -564,6 +770,12 @@ const (
 	AcquireShardInterval
 	// AcquireShardConcurrency is number of goroutines that can be used to acquire shards in the shard controller.
 	AcquireShardConcurrency
+	// ShardRejoinGracePeriod is the period after a host leaves membership ring during which shard controller
+	// doesn't acquire shards reassigned to it, so the host can rejoin and keep its shards. 0 disables it.
+	ShardRejoinGracePeriod
+	// ShardHibernationIdleTimeout is the period without requests after which a shard with no pending tasks is
+	// released until it is needed again. 0 disables it.
+	ShardHibernationIdleTimeout
 	// StandbyClusterDelay is the artificial delay added to standby cluster's view of active cluster's time
 	StandbyClusterDelay
 	// StandbyTaskMissingEventsResendDelay is the amount of time standby cluster's will wait (if events are missing)
@@ -574,6 +786,9 @@ const (
 	StandbyTaskMissingEventsDiscardDelay
 	// TaskProcessRPS is the task processing rate per second for each namespace
 	TaskProcessRPS
+	// TaskNamespaceTier is the tier (critical, default or batch) of a namespace used by the priority task processor,
+	// tasks of namespaces in different tiers are scheduled according to TaskSchedulerRoundRobinWeights
+	TaskNamespaceTier
 	// TaskSchedulerType is the task scheduler type for priority task processor
 	TaskSchedulerType
 	// TaskSchedulerWorkerCount is the number of workers per shard in task scheduler
@@ -582,9 +797,16 @@ const (
 	TaskSchedulerQueueSize
 	// TaskSchedulerRoundRobinWeights is the priority weight for weighted round robin task scheduler
 	TaskSchedulerRoundRobinWeights
+	// EnableTaskWorkerPoolAutoscaling is the key for enabling autoscaling of the worker pools of timer, transfer
+	// and visibility processors between their min and max worker count, otherwise the pools have max worker count workers
+	EnableTaskWorkerPoolAutoscaling
 	// TimerTaskBatchSize is batch size for timer processor to process tasks
 	TimerTaskBatchSize
-	// TimerTaskWorkerCount is number of task workers for timer processor
+	// TimerTaskMinWorkerCount is the min number of task workers for timer processor,
+	// the worker pool grows up to TimerTaskWorkerCount when tasks are backlogged and persistence latency is healthy,
+	// if EnableTaskWorkerPoolAutoscaling is set
+	TimerTaskMinWorkerCount
+	// TimerTaskWorkerCount is the max number of task workers for timer processor
 	TimerTaskWorkerCount
 	// TimerTaskMaxRetryCount is max retry count for timer processor
 	TimerTaskMaxRetryCount
@@ -628,7 +850,11 @@ const (
 	TransferProcessorFailoverMaxPollRPS
 	// TransferProcessorMaxPollRPS is max poll rate per second for transferQueueProcessor
 	TransferProcessorMaxPollRPS
-	// TransferTaskWorkerCount is number of worker for transferQueueProcessor
+	// TransferTaskMinWorkerCount is the min number of workers for transferQueueProcessor,
+	// the worker pool grows up to TransferTaskWorkerCount when tasks are backlogged and persistence latency is healthy,
+	// if EnableTaskWorkerPoolAutoscaling is set
+	TransferTaskMinWorkerCount
+	// TransferTaskWorkerCount is the max number of workers for transferQueueProcessor
 	TransferTaskWorkerCount
 	// TransferTaskMaxRetryCount is max times of retry for transferQueueProcessor
 	TransferTaskMaxRetryCount
@@ -663,7 +889,11 @@ const (
 	VisibilityProcessorFailoverMaxPollRPS
 	// VisibilityProcessorMaxPollRPS is max poll rate per second for visibilityQueueProcessor
 	VisibilityProcessorMaxPollRPS
-	// VisibilityTaskWorkerCount is number of worker for visibilityQueueProcessor
+	// VisibilityTaskMinWorkerCount is the min number of workers for visibilityQueueProcessor,
+	// the worker pool grows up to VisibilityTaskWorkerCount when tasks are backlogged and persistence latency is healthy,
+	// if EnableTaskWorkerPoolAutoscaling is set
+	VisibilityTaskMinWorkerCount
+	// VisibilityTaskWorkerCount is the max number of workers for visibilityQueueProcessor
 	VisibilityTaskWorkerCount
 	// VisibilityTaskMaxRetryCount is max times of retry for visibilityQueueProcessor
 	VisibilityTaskMaxRetryCount
@@ -722,6 +952,10 @@ const (
 	MaximumBufferedEventsBatch
 	// MaximumSignalsPerExecution is max number of signals supported by single execution
 	MaximumSignalsPerExecution
+	// HistoryCompactionMaxBatchEventCount is max number of events of a history event batch rewritten by history compaction
+	HistoryCompactionMaxBatchEventCount
+	// HistoryCompactionMaxBatchSize is max size in bytes of a history event batch rewritten by history compaction
+	HistoryCompactionMaxBatchSize
 	// ShardUpdateMinInterval is the minimal time interval which the shard info can be updated
 	ShardUpdateMinInterval
 	// ShardSyncMinInterval is the minimal time interval which the shard info should be sync to remote
@@ -751,6 +985,8 @@ const (
 	ParentClosePolicyThreshold
 	// NumParentClosePolicySystemWorkflows is key for number of parentClosePolicy system workflows running in total
 	NumParentClosePolicySystemWorkflows
+	// NumCallbackSystemWorkflows is key for number of completion callback system workflows running in total
+	NumCallbackSystemWorkflows
 
 	// HistoryThrottledLogRPS is the rate limit on number of log messages emitted per second for throttled logger
 	HistoryThrottledLogRPS
@@ -803,6 +1039,12 @@ const (
 	// WorkerESProcessorAckTimeout is the timeout that store will wait to get ack signal from ES processor.
 	// Should be at least WorkerESProcessorFlushInterval+<time to process request>.
 	WorkerESProcessorAckTimeout
+	// WorkerESProcessorBackfillWorkers is num of workers for esProcessor committing backfill requests.
+	// Set to 0 to commit backfill requests together with live requests.
+	WorkerESProcessorBackfillWorkers
+	// WorkerESProcessorShutdownDrainTimeout is the max time esProcessor waits on shutdown for pending requests
+	// to be committed. Requests which are not acked before the deadline are nacked.
+	WorkerESProcessorShutdownDrainTimeout
 	// EnableArchivalCompression indicates whether blobs are compressed before they are archived
 	EnableArchivalCompression
 	// WorkerHistoryPageSize indicates the page size of history fetched from persistence for archival
@@ -815,7 +1057,8 @@ const (
 	WorkerArchivalsPerIteration
 	// WorkerDeterministicConstructionCheckProbability controls the probability of running a deterministic construction check for any given archival
 	WorkerDeterministicConstructionCheckProbability
-	// WorkerBlobIntegrityCheckProbability controls the probability of running an integrity check for any given archival
+	// WorkerBlobIntegrityCheckProbability controls the probability of verifying archived history against primary history
+	// for any given archival, primary history is not deleted if archived history does not match
 	WorkerBlobIntegrityCheckProbability
 	// WorkerTimeLimitPerArchivalIteration controls the time limit of each iteration of archival workflow
 	WorkerTimeLimitPerArchivalIteration
@@ -833,6 +1076,27 @@ const (
 	EnableBatcher
 	// EnableParentClosePolicyWorker decides whether or not enable system workers for processing parent close policy task
 	EnableParentClosePolicyWorker
+	// EnableCallbackWorker decides whether or not enable system workers for delivering workflow completion callbacks
+	EnableCallbackWorker
+	// EnableSchedulerWorker decides whether or not enable system workers for running schedules
+	EnableSchedulerWorker
+	// CallbackAllowedHosts is comma separated list of hosts workflow completion callbacks of the namespace
+	// are allowed to be sent to, "*" allows any host and "*.example.com" allows subdomains, empty disables callbacks
+	CallbackAllowedHosts
+	// EnableSearchAttributeMetrics decides whether or not emit gauges of workflow counts grouped by search attribute
+	EnableSearchAttributeMetrics
+	// SearchAttributeMetricsRefreshInterval is the interval workflow counts grouped by search attribute are refreshed
+	SearchAttributeMetricsRefreshInterval
+	// SearchAttributeMetricsGroupBy is the search attribute workflow counts of the namespace are grouped by,
+	// empty value disables search attribute metrics for the namespace
+	SearchAttributeMetricsGroupBy
+	// SearchAttributeMetricsValues is comma separated list of search attribute values workflow counts are emitted for,
+	// empty value emits counts for top values of sampled open workflows
+	SearchAttributeMetricsValues
+	// SearchAttributeMetricsTopN is the number of top search attribute values workflow counts are emitted for
+	SearchAttributeMetricsTopN
+	// SearchAttributeMetricsSampleSize is the number of open workflows sampled to find top search attribute values
+	SearchAttributeMetricsSampleSize
 	// EnableStickyQuery indicates if sticky query should be enabled per namespace
 	EnableStickyQuery
 
@@ -868,6 +1132,11 @@ const (
 	ReplicationTaskProcessorHostQPS
 	// ReplicationTaskProcessorShardQPS is the qps of task processing rate limiter on shard level
 	ReplicationTaskProcessorShardQPS
+	// ReplicationTaskProcessorEnableStreaming indicates if replication tasks are received over stream pushed by remote cluster
+	// instead of being polled by replication task fetcher
+	ReplicationTaskProcessorEnableStreaming
+	// ReplicationStreamHeartbeatInterval is the max time replication stream waits for new tasks before sending an empty batch
+	ReplicationStreamHeartbeatInterval
 	// EnableConsistentQuery indicates if consistent query is enabled for the cluster
 	MaxBufferedQueryCount
 	// MutableStateChecksumGenProbability is the probability [0-100] that checksum will be generated for mutable state
@@ -876,6 +1145,17 @@ const (
 	MutableStateChecksumVerifyProbability
 	// MutableStateChecksumInvalidateBefore is the epoch timestamp before which all checksums are to be discarded
 	MutableStateChecksumInvalidateBefore
+	// MutableStateIntegrityCheckEnabled indicates whether a mutable state checksum mismatch or an inconsistent
+	// history branch token detected on load fails the load, otherwise it is only logged and counted
+	MutableStateIntegrityCheckEnabled
+	// EnableWorkflowAuditLog indicates whether a structured log record is emitted for every workflow lifecycle transition
+	EnableWorkflowAuditLog
+	// StickyQueryDispatchDeadline is the max time a query waits for the sticky worker to pick it up
+	// before falling back to the normal task queue, zero means waiting for the sticky schedule to start timeout
+	StickyQueryDispatchDeadline
+	// OpenWorkflowCountRefreshInterval is how long a host caches the open workflow count of a namespace
+	// before reloading it from visibility when enforcing OpenWorkflowCountLimit
+	OpenWorkflowCountRefreshInterval
 
 	// ReplicationEventsFromCurrentCluster is a feature flag to allow cross DC replicate events that generated from the current cluster
 	ReplicationEventsFromCurrentCluster
@@ -891,7 +1171,7 @@ const (
 type Filter int
 
 func (f Filter) String() string {
-	if f <= unknownFilter || f > TaskType {
+	if f <= unknownFilter || f >= lastFilterTypeForTest {
 		return filters[unknownFilter]
 	}
 	return filters[f]
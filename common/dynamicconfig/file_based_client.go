@@ -29,6 +29,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
@@ -68,7 +69,7 @@ func NewFileBasedClient(config *FileBasedClientConfig, logger log.Logger, doneCh
 	}
 
 	client := &fileBasedClient{
-		basicClient: newBasicClient(),
+		basicClient: newBasicClient(logger),
 		config:      config,
 		doneCh:      doneCh,
 		logger:      logger,
@@ -99,8 +100,6 @@ func (fc *fileBasedClient) update() error {
 		fc.lastUpdatedTime = time.Now().UTC()
 	}()
 
-	newValues := make(configValueMap)
-
 	info, err := os.Stat(fc.config.Filepath)
 	if err != nil {
 		return fmt.Errorf("failed to get status of dynamic config file: %v", err)
@@ -109,13 +108,15 @@ func (fc *fileBasedClient) update() error {
 		return nil
 	}
 
-	confContent, err := ioutil.ReadFile(fc.config.Filepath)
+	newValues, err := readConfigFile(fc.config.Filepath)
 	if err != nil {
-		return fmt.Errorf("failed to read dynamic config file %v: %v", fc.config.Filepath, err)
+		return err
 	}
-
-	if err = yaml.Unmarshal(confContent, newValues); err != nil {
-		return fmt.Errorf("failed to decode dynamic config %v", err)
+	if err := validateValues(newValues); err != nil {
+		return fmt.Errorf("invalid dynamic config %v, keep previous values: %w", fc.config.Filepath, err)
+	}
+	for _, warning := range deprecatedKeyWarnings(newValues) {
+		fc.logger.Warn("Deprecated dynamic config key", tag.Value(warning))
 	}
 
 	return fc.storeValues(newValues)
@@ -139,11 +140,46 @@ func (fc *fileBasedClient) storeValues(newValues map[string][]*constrainedValue)
 		formattedNewValues[strings.ToLower(key)] = valuesSlice
 	}
 
-	fc.values.Store(formattedNewValues)
+	fc.updateValues(formattedNewValues)
 	fc.logger.Info("Updated dynamic config")
 	return nil
 }
 
+// UnknownKeys returns keys of the dynamic config file which are not defined by this version of the server.
+// Such keys are ignored by the server, usually because they were removed, renamed, or misspelled.
+func UnknownKeys(filepath string) ([]string, error) {
+	values, err := readConfigFile(filepath)
+	if err != nil {
+		return nil, err
+	}
+
+	knownKeys := make(map[string]struct{}, len(Keys))
+	for _, keyName := range Keys {
+		knownKeys[strings.ToLower(keyName)] = struct{}{}
+	}
+	var unknownKeys []string
+	for key := range values {
+		if _, ok := knownKeys[strings.ToLower(key)]; !ok {
+			unknownKeys = append(unknownKeys, key)
+		}
+	}
+	sort.Strings(unknownKeys)
+	return unknownKeys, nil
+}
+
+func readConfigFile(filepath string) (configValueMap, error) {
+	confContent, err := ioutil.ReadFile(filepath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read dynamic config file %v: %v", filepath, err)
+	}
+
+	values := make(configValueMap)
+	if err = yaml.Unmarshal(confContent, values); err != nil {
+		return nil, fmt.Errorf("failed to decode dynamic config %v", err)
+	}
+	return values, nil
+}
+
 func validateConfig(config *FileBasedClientConfig) error {
 	if config == nil {
 		return errors.New("no config found for file based dynamic config client")
@@ -25,6 +25,8 @@
 package dynamicconfig
 
 import (
+	"io/ioutil"
+	"os"
 	"testing"
 	"time"
 
@@ -282,6 +284,81 @@ func (s *fileBasedClientSuite) TestValidateConfig_ShortPollInterval() {
 	s.Error(err)
 }
 
+func (s *fileBasedClientSuite) TestInvalidConfig() {
+	file, err := ioutil.TempFile("", "dynamicconfig")
+	s.NoError(err)
+	defer func() { s.NoError(os.Remove(file.Name())) }()
+	_, err = file.WriteString(`
+frontend.rps:
+  - value: twelve hundred
+`)
+	s.NoError(err)
+	s.NoError(file.Close())
+
+	_, err = NewFileBasedClient(&FileBasedClientConfig{
+		Filepath:     file.Name(),
+		PollInterval: time.Second * 5,
+	}, log.NewNoopLogger(), nil)
+	s.Error(err)
+}
+
+func (s *fileBasedClientSuite) TestUpdate_InvalidConfigKeepsValues() {
+	file, err := ioutil.TempFile("", "dynamicconfig")
+	s.NoError(err)
+	defer func() { s.NoError(os.Remove(file.Name())) }()
+	_, err = file.WriteString(`
+frontend.rps:
+  - value: 1000
+`)
+	s.NoError(err)
+	s.NoError(file.Close())
+
+	doneCh := make(chan interface{})
+	defer close(doneCh)
+	client, err := NewFileBasedClient(&FileBasedClientConfig{
+		Filepath:     file.Name(),
+		PollInterval: time.Second * 5,
+	}, log.NewNoopLogger(), doneCh)
+	s.NoError(err)
+
+	s.NoError(ioutil.WriteFile(file.Name(), []byte(`
+frontend.rps:
+  - value: -1
+`), fileMode))
+	fc := client.(*fileBasedClient)
+	fc.lastUpdatedTime = time.Time{}
+	s.Error(fc.update())
+
+	v, err := client.GetIntValue(FrontendRPS, nil, 1200)
+	s.NoError(err)
+	s.Equal(1000, v)
+}
+
+func (s *fileBasedClientSuite) TestUnknownKeys() {
+	file, err := ioutil.TempFile("", "dynamicconfig")
+	s.NoError(err)
+	defer func() { s.NoError(os.Remove(file.Name())) }()
+	_, err = file.WriteString(`
+history.cacheLoadDeduplication:
+  - value: false
+Frontend.RPS:
+  - value: 10
+history.removedKey:
+  - value: 1
+frontend.misspeledKey:
+  - value: true
+`)
+	s.NoError(err)
+	s.NoError(file.Close())
+
+	unknownKeys, err := UnknownKeys(file.Name())
+	s.NoError(err)
+	s.Equal([]string{"frontend.misspeledKey", "history.removedKey"}, unknownKeys)
+
+	_, err = UnknownKeys("file/does/not/exist.yaml")
+	s.Error(err)
+}
+
 func (s *fileBasedClientSuite) TestMatch() {
 	testCases := []struct {
 		v       *constrainedValue
@@ -30,6 +30,9 @@ import (
 	"time"
 )
 
+// ChangeCallback is called with the keys whose values changed.
+type ChangeCallback func(changedKeys []Key)
+
 // Client allows fetching values from a dynamic configuration system NOTE: This does not have async
 // options right now. In the interest of keeping it minimal, we can add when requirement arises.
 type Client interface {
@@ -46,4 +49,9 @@ type Client interface {
 	GetDurationValue(
 		name Key, filters map[Filter]interface{}, defaultValue time.Duration,
 	) (time.Duration, error)
+
+	// Subscribe registers callback to be called whenever values of any key change and
+	// returns a function to cancel the subscription. Callbacks are called synchronously
+	// by the goroutine updating the values and must not block.
+	Subscribe(callback ChangeCallback) (unsubscribe func())
 }
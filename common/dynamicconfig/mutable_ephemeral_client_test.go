@@ -30,6 +30,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	dconf "go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
 )
 
 func TestMutations(t *testing.T) {
@@ -110,3 +111,40 @@ func composeFilters(fs ...dconf.FilterOption) map[dconf.Filter]interface{} {
 	}
 	return out
 }
+
+func TestSubscribe(t *testing.T) {
+	c := dconf.NewMutableEphemeralClient(dconf.Set(dconf.FrontendMaxBadBinaries, 10))
+	var changes [][]dconf.Key
+	unsubscribe := c.Subscribe(func(changedKeys []dconf.Key) {
+		changes = append(changes, changedKeys)
+	})
+
+	c.MSet(map[dconf.Key]interface{}{
+		dconf.AcquireShardInterval:   time.Second,
+		dconf.FrontendMaxBadBinaries: 10,
+	})
+	require.Equal(t, [][]dconf.Key{{dconf.AcquireShardInterval}}, changes)
+
+	c.Add(dconf.FrontendMaxBadBinaries, 20, dconf.ForNamespace("nsfoo"))
+	require.Equal(t, []dconf.Key{dconf.FrontendMaxBadBinaries}, changes[1])
+
+	unsubscribe()
+	c.Set(dconf.AcquireShardInterval, time.Minute)
+	require.Len(t, changes, 2)
+}
+
+func TestCollectionSubscribe(t *testing.T) {
+	c := dconf.NewMutableEphemeralClient()
+	collection := dconf.NewCollection(c, log.NewNoopLogger())
+	notified := 0
+	unsubscribe := collection.Subscribe(func() { notified++ }, dconf.FrontendMaxBadBinaries, dconf.EnableAuthorization)
+	defer unsubscribe()
+
+	c.Set(dconf.AcquireShardInterval, time.Second)
+	require.Equal(t, 0, notified)
+	c.MSet(map[dconf.Key]interface{}{
+		dconf.FrontendMaxBadBinaries: 10,
+		dconf.EnableAuthorization:    true,
+	})
+	require.Equal(t, 1, notified)
+}
@@ -0,0 +1,337 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+var _ Client = (*persistenceBasedClient)(nil)
+
+type (
+	// PersistenceStore provides dynamic config values stored in the database by dynamic config key.
+	PersistenceStore interface {
+		GetDynamicConfig() (map[string]*persistencespb.DynamicConfigValues, error)
+	}
+
+	// persistenceBasedClient serves values stored in the database and falls back
+	// to another client for keys which have no matching value in the database.
+	persistenceBasedClient struct {
+		*basicClient
+		fallback     Client
+		store        PersistenceStore
+		pollInterval time.Duration
+		lastValues   map[string]*persistencespb.DynamicConfigValues
+		doneCh       <-chan interface{}
+		logger       log.Logger
+	}
+)
+
+// NewPersistenceBasedClient creates a client which serves dynamic config values stored in the database.
+// Values stored in the database take precedence over the values of the fallback client.
+func NewPersistenceBasedClient(
+	store PersistenceStore,
+	fallback Client,
+	pollInterval time.Duration,
+	logger log.Logger,
+	doneCh <-chan interface{},
+) Client {
+	if pollInterval < minPollInterval {
+		pollInterval = minPollInterval
+	}
+
+	client := &persistenceBasedClient{
+		basicClient:  newBasicClient(logger),
+		fallback:     fallback,
+		store:        store,
+		pollInterval: pollInterval,
+		doneCh:       doneCh,
+		logger:       logger,
+	}
+	if err := client.update(); err != nil {
+		client.logger.Warn("Failed to load dynamic config from database", tag.Error(err))
+	}
+	go func() {
+		ticker := time.NewTicker(client.pollInterval)
+		for {
+			select {
+			case <-ticker.C:
+				err := client.update()
+				if err != nil {
+					client.logger.Error("Failed to update dynamic config from database", tag.Error(err))
+				}
+			case <-client.doneCh:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+	return client
+}
+
+func (pc *persistenceBasedClient) update() error {
+	storedValues, err := pc.store.GetDynamicConfig()
+	if err != nil {
+		return err
+	}
+	if dynamicConfigValuesEqual(pc.lastValues, storedValues) {
+		return nil
+	}
+
+	newValues := make(configValueMap, len(storedValues))
+	for key, values := range storedValues {
+		valuesSlice := make([]*constrainedValue, 0, len(values.GetValues()))
+		for _, v := range values.GetValues() {
+			cv, err := newConstrainedValue(v)
+			if err != nil {
+				return fmt.Errorf("invalid value of dynamic config key %v: %w", key, err)
+			}
+			valuesSlice = append(valuesSlice, cv)
+		}
+		newValues[strings.ToLower(key)] = valuesSlice
+	}
+
+	pc.updateValues(newValues)
+	pc.lastValues = storedValues
+	pc.logger.Info("Updated dynamic config from database")
+	return nil
+}
+
+func (pc *persistenceBasedClient) GetValue(
+	name Key,
+	defaultValue interface{},
+) (interface{}, error) {
+	if val, err := pc.basicClient.GetValue(name, defaultValue); err == nil {
+		return val, nil
+	}
+	return pc.fallback.GetValue(name, defaultValue)
+}
+
+func (pc *persistenceBasedClient) GetValueWithFilters(
+	name Key,
+	filters map[Filter]interface{},
+	defaultValue interface{},
+) (interface{}, error) {
+	if val, err := pc.basicClient.GetValueWithFilters(name, filters, defaultValue); err == nil {
+		return val, nil
+	}
+	return pc.fallback.GetValueWithFilters(name, filters, defaultValue)
+}
+
+func (pc *persistenceBasedClient) GetIntValue(
+	name Key,
+	filters map[Filter]interface{},
+	defaultValue int,
+) (int, error) {
+	if val, err := pc.basicClient.GetIntValue(name, filters, defaultValue); err == nil {
+		return val, nil
+	}
+	return pc.fallback.GetIntValue(name, filters, defaultValue)
+}
+
+func (pc *persistenceBasedClient) GetFloatValue(
+	name Key,
+	filters map[Filter]interface{},
+	defaultValue float64,
+) (float64, error) {
+	if val, err := pc.basicClient.GetFloatValue(name, filters, defaultValue); err == nil {
+		return val, nil
+	}
+	return pc.fallback.GetFloatValue(name, filters, defaultValue)
+}
+
+func (pc *persistenceBasedClient) GetBoolValue(
+	name Key,
+	filters map[Filter]interface{},
+	defaultValue bool,
+) (bool, error) {
+	if val, err := pc.basicClient.GetBoolValue(name, filters, defaultValue); err == nil {
+		return val, nil
+	}
+	return pc.fallback.GetBoolValue(name, filters, defaultValue)
+}
+
+func (pc *persistenceBasedClient) GetStringValue(
+	name Key,
+	filters map[Filter]interface{},
+	defaultValue string,
+) (string, error) {
+	if val, err := pc.basicClient.GetStringValue(name, filters, defaultValue); err == nil {
+		return val, nil
+	}
+	return pc.fallback.GetStringValue(name, filters, defaultValue)
+}
+
+func (pc *persistenceBasedClient) GetMapValue(
+	name Key,
+	filters map[Filter]interface{},
+	defaultValue map[string]interface{},
+) (map[string]interface{}, error) {
+	if val, err := pc.basicClient.GetMapValue(name, filters, defaultValue); err == nil {
+		return val, nil
+	}
+	return pc.fallback.GetMapValue(name, filters, defaultValue)
+}
+
+func (pc *persistenceBasedClient) GetDurationValue(
+	name Key,
+	filters map[Filter]interface{},
+	defaultValue time.Duration,
+) (time.Duration, error) {
+	if val, err := pc.basicClient.GetDurationValue(name, filters, defaultValue); err == nil {
+		return val, nil
+	}
+	return pc.fallback.GetDurationValue(name, filters, defaultValue)
+}
+
+// Subscribe notifies callback about changes of values stored in the database and of the fallback client values.
+func (pc *persistenceBasedClient) Subscribe(callback ChangeCallback) func() {
+	unsubscribeStored := pc.basicClient.Subscribe(callback)
+	unsubscribeFallback := pc.fallback.Subscribe(callback)
+	return func() {
+		unsubscribeStored()
+		unsubscribeFallback()
+	}
+}
+
+// ValidateStoredValue validates a dynamic config value before storing it in the database.
+func ValidateStoredValue(name string, value *persistencespb.DynamicConfigValue) error {
+	if !IsKnownKey(name) {
+		return fmt.Errorf("unknown dynamic config key %v", name)
+	}
+	cv, err := newConstrainedValue(value)
+	if err != nil {
+		return err
+	}
+	return validateValue(name, cv)
+}
+
+// IsKnownKey returns true if the dynamic config key name is defined by this version of the server.
+func IsKnownKey(name string) bool {
+	for key, keyName := range Keys {
+		if key != unknownKey && strings.EqualFold(keyName, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func newConstrainedValue(value *persistencespb.DynamicConfigValue) (*constrainedValue, error) {
+	decodedValue, err := decodeStoredValue(value.GetValue())
+	if err != nil {
+		return nil, err
+	}
+
+	constraints := make(map[string]interface{})
+	if c := value.GetConstraints(); c != nil {
+		if c.GetNamespace() != "" {
+			constraints[Namespace.String()] = c.GetNamespace()
+		}
+		if c.GetNamespaceId() != "" {
+			constraints[NamespaceID.String()] = c.GetNamespaceId()
+		}
+		if c.GetTaskQueueName() != "" {
+			constraints[TaskQueueName.String()] = c.GetTaskQueueName()
+		}
+		if c.GetTaskType() != enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+			constraints[TaskType.String()] = enumspb.TaskQueueType_name[int32(c.GetTaskType())]
+		}
+		if c.GetShardId() != 0 {
+			constraints[ShardID.String()] = c.GetShardId()
+		}
+	}
+	return &constrainedValue{
+		Value:       decodedValue,
+		Constraints: constraints,
+	}, nil
+}
+
+// decodeStoredValue decodes a JSON encoded value to the types produced by the file based client:
+// integral numbers are decoded to int and other numbers to float64.
+func decodeStoredValue(value string) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewBufferString(value))
+	decoder.UseNumber()
+	var decodedValue interface{}
+	if err := decoder.Decode(&decodedValue); err != nil {
+		return nil, fmt.Errorf("failed to decode dynamic config value %q: %w", value, err)
+	}
+	if decoder.More() {
+		return nil, errors.New("dynamic config value must be a single JSON value")
+	}
+	return convertJSONNumbers(decodedValue)
+}
+
+func convertJSONNumbers(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case json.Number:
+		if intVal, err := v.Int64(); err == nil {
+			return int(intVal), nil
+		}
+		return v.Float64()
+	case map[string]interface{}:
+		for key, value := range v {
+			convertedValue, err := convertJSONNumbers(value)
+			if err != nil {
+				return nil, err
+			}
+			v[key] = convertedValue
+		}
+		return v, nil
+	case []interface{}:
+		for idx, value := range v {
+			convertedValue, err := convertJSONNumbers(value)
+			if err != nil {
+				return nil, err
+			}
+			v[idx] = convertedValue
+		}
+		return v, nil
+	default:
+		return v, nil
+	}
+}
+
+func dynamicConfigValuesEqual(a, b map[string]*persistencespb.DynamicConfigValues) bool {
+	if a == nil || len(a) != len(b) {
+		return false
+	}
+	for key, values := range a {
+		if !values.Equal(b[key]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,210 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log"
+)
+
+type (
+	persistenceBasedClientSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		store    *testPersistenceStore
+		fallback *MutableEphemeralClient
+		client   *persistenceBasedClient
+		doneCh   chan interface{}
+	}
+
+	testPersistenceStore struct {
+		sync.Mutex
+		values map[string]*persistencespb.DynamicConfigValues
+	}
+)
+
+func TestPersistenceBasedClientSuite(t *testing.T) {
+	s := new(persistenceBasedClientSuite)
+	suite.Run(t, s)
+}
+
+func (s *persistenceBasedClientSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.store = &testPersistenceStore{values: map[string]*persistencespb.DynamicConfigValues{
+		"testGetIntPropertyKey": {Values: []*persistencespb.DynamicConfigValue{
+			{Value: "100"},
+			{Value: "200", Constraints: &persistencespb.DynamicConfigConstraints{Namespace: "samples-namespace"}},
+		}},
+		"TESTGETMAPPROPERTYKEY": {Values: []*persistencespb.DynamicConfigValue{
+			{Value: `{"key1": 1, "key2": 1.5, "key3": ["a", 2]}`},
+		}},
+		"testGetDurationPropertyFilteredByTaskQueueInfoKey": {Values: []*persistencespb.DynamicConfigValue{
+			{Value: `"10s"`, Constraints: &persistencespb.DynamicConfigConstraints{
+				Namespace:     "samples-namespace",
+				TaskQueueName: "samples-tq",
+				TaskType:      enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+			}},
+		}},
+	}}
+	s.fallback = NewMutableEphemeralClient(
+		Set(testGetIntPropertyKey, 1),
+		Set(testGetBoolPropertyKey, true),
+		Set(testGetDurationPropertyFilteredByTaskQueueInfoKey, "1m"),
+	)
+	s.doneCh = make(chan interface{})
+	s.client = NewPersistenceBasedClient(s.store, s.fallback, minPollInterval, log.NewNoopLogger(), s.doneCh).(*persistenceBasedClient)
+}
+
+func (s *persistenceBasedClientSuite) TearDownTest() {
+	close(s.doneCh)
+}
+
+func (s *persistenceBasedClientSuite) TestGetIntValue() {
+	v, err := s.client.GetIntValue(testGetIntPropertyKey, nil, 0)
+	s.NoError(err)
+	s.Equal(100, v)
+
+	v, err = s.client.GetIntValue(testGetIntPropertyKey, map[Filter]interface{}{Namespace: "samples-namespace"}, 0)
+	s.NoError(err)
+	s.Equal(200, v)
+
+	v, err = s.client.GetIntValue(testGetIntPropertyKey, map[Filter]interface{}{Namespace: "other-namespace"}, 0)
+	s.NoError(err)
+	s.Equal(100, v)
+}
+
+func (s *persistenceBasedClientSuite) TestGetMapValue() {
+	v, err := s.client.GetMapValue(testGetMapPropertyKey, nil, nil)
+	s.NoError(err)
+	s.Equal(map[string]interface{}{"key1": 1, "key2": 1.5, "key3": []interface{}{"a", 2}}, v)
+}
+
+func (s *persistenceBasedClientSuite) TestGetDurationValue_Constraints() {
+	filters := map[Filter]interface{}{
+		Namespace:     "samples-namespace",
+		TaskQueueName: "samples-tq",
+		TaskType:      enumspb.TaskQueueType_name[int32(enumspb.TASK_QUEUE_TYPE_ACTIVITY)],
+	}
+	v, err := s.client.GetDurationValue(testGetDurationPropertyFilteredByTaskQueueInfoKey, filters, 0)
+	s.NoError(err)
+	s.Equal(10*time.Second, v)
+
+	// no value in database matches the filters
+	filters[TaskType] = enumspb.TaskQueueType_name[int32(enumspb.TASK_QUEUE_TYPE_WORKFLOW)]
+	v, err = s.client.GetDurationValue(testGetDurationPropertyFilteredByTaskQueueInfoKey, filters, 0)
+	s.NoError(err)
+	s.Equal(time.Minute, v)
+}
+
+func (s *persistenceBasedClientSuite) TestFallback() {
+	v, err := s.client.GetBoolValue(testGetBoolPropertyKey, nil, false)
+	s.NoError(err)
+	s.True(v)
+
+	_, err = s.client.GetStringValue(testGetStringPropertyKey, nil, "")
+	s.Error(err)
+}
+
+func (s *persistenceBasedClientSuite) TestUpdate() {
+	s.store.set("testGetIntPropertyKey", nil)
+	s.store.set("testGetBoolPropertyKey", &persistencespb.DynamicConfigValues{Values: []*persistencespb.DynamicConfigValue{{Value: "false"}}})
+	s.NoError(s.client.update())
+
+	intValue, err := s.client.GetIntValue(testGetIntPropertyKey, nil, 0)
+	s.NoError(err)
+	s.Equal(1, intValue)
+	boolValue, err := s.client.GetBoolValue(testGetBoolPropertyKey, nil, true)
+	s.NoError(err)
+	s.False(boolValue)
+
+	// invalid values keep the previous values
+	s.store.set("testGetBoolPropertyKey", &persistencespb.DynamicConfigValues{Values: []*persistencespb.DynamicConfigValue{{Value: "fals"}}})
+	s.Error(s.client.update())
+	boolValue, err = s.client.GetBoolValue(testGetBoolPropertyKey, nil, true)
+	s.NoError(err)
+	s.False(boolValue)
+}
+
+func (s *persistenceBasedClientSuite) TestSubscribe() {
+	var changes [][]Key
+	unsubscribe := s.client.Subscribe(func(changedKeys []Key) {
+		changes = append(changes, changedKeys)
+	})
+
+	s.store.set("testGetBoolPropertyKey", &persistencespb.DynamicConfigValues{Values: []*persistencespb.DynamicConfigValue{{Value: "false"}}})
+	s.NoError(s.client.update())
+	s.fallback.Set(testGetStringPropertyKey, "value")
+	s.Equal([][]Key{{testGetBoolPropertyKey}, {testGetStringPropertyKey}}, changes)
+
+	unsubscribe()
+	s.fallback.Set(testGetStringPropertyKey, "other value")
+	s.Len(changes, 2)
+}
+
+func (s *persistenceBasedClientSuite) TestValidateStoredValue() {
+	s.NoError(ValidateStoredValue("testgetintpropertykey", &persistencespb.DynamicConfigValue{Value: "1"}))
+	s.Error(ValidateStoredValue("unknownKey", &persistencespb.DynamicConfigValue{Value: "1"}))
+	s.Error(ValidateStoredValue("nonExistingKey", &persistencespb.DynamicConfigValue{Value: "1"}))
+	s.Error(ValidateStoredValue("testGetIntPropertyKey", &persistencespb.DynamicConfigValue{Value: "1 2"}))
+	s.Error(ValidateStoredValue("testGetIntPropertyKey", &persistencespb.DynamicConfigValue{}))
+	s.NoError(ValidateStoredValue("frontend.rps", &persistencespb.DynamicConfigValue{Value: "1200"}))
+	s.Error(ValidateStoredValue("frontend.rps", &persistencespb.DynamicConfigValue{Value: `"1200"`}))
+	s.Error(ValidateStoredValue("frontend.rps", &persistencespb.DynamicConfigValue{
+		Value:       "1200",
+		Constraints: &persistencespb.DynamicConfigConstraints{Namespace: "samples-namespace"},
+	}))
+}
+
+func (ts *testPersistenceStore) GetDynamicConfig() (map[string]*persistencespb.DynamicConfigValues, error) {
+	ts.Lock()
+	defer ts.Unlock()
+
+	values := make(map[string]*persistencespb.DynamicConfigValues, len(ts.values))
+	for key, value := range ts.values {
+		values[key] = value
+	}
+	return values, nil
+}
+
+func (ts *testPersistenceStore) set(key string, values *persistencespb.DynamicConfigValues) {
+	ts.Lock()
+	defer ts.Unlock()
+
+	if values == nil {
+		delete(ts.values, key)
+		return
+	}
+	ts.values[key] = values
+}
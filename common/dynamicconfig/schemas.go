@@ -0,0 +1,389 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+// keySchemas describes the values accepted for each dynamic config key. Type and filters
+// must match the Collection getter the server uses to read the key.
+var keySchemas = map[Key]keySchema{
+	unknownKey: {Type: valueTypeAny},
+
+	// tests keys accept any value so that config/testConfig.yaml can exercise malformed values
+	testGetPropertyKey:                                {Type: valueTypeAny, Filters: allFilters},
+	testCaseInsensitivePropertyKey:                    {Type: valueTypeAny, Filters: allFilters},
+	testGetIntPropertyKey:                             {Type: valueTypeAny, Filters: allFilters},
+	testGetFloat64PropertyKey:                         {Type: valueTypeAny, Filters: allFilters},
+	testGetDurationPropertyKey:                        {Type: valueTypeAny, Filters: allFilters},
+	testGetBoolPropertyKey:                            {Type: valueTypeAny, Filters: allFilters},
+	testGetStringPropertyKey:                          {Type: valueTypeAny, Filters: allFilters},
+	testGetMapPropertyKey:                             {Type: valueTypeAny, Filters: allFilters},
+	testGetIntPropertyFilteredByNamespaceKey:          {Type: valueTypeAny, Filters: allFilters},
+	testGetDurationPropertyFilteredByNamespaceKey:     {Type: valueTypeAny, Filters: allFilters},
+	testGetIntPropertyFilteredByTaskQueueInfoKey:      {Type: valueTypeAny, Filters: allFilters},
+	testGetDurationPropertyFilteredByTaskQueueInfoKey: {Type: valueTypeAny, Filters: allFilters},
+	testGetBoolPropertyFilteredByNamespaceIDKey:       {Type: valueTypeAny, Filters: allFilters},
+	testGetBoolPropertyFilteredByTaskQueueInfoKey:     {Type: valueTypeAny, Filters: allFilters},
+
+	// admin settings
+	// NOTE: admin settings are not guaranteed to be compatible across different versions
+	AdminMatchingNamespaceToPartitionDispatchRate:          {Type: valueTypeFloat, Filters: namespaceFilters, Min: bound(0)},
+	AdminMatchingNamespaceTaskqueueToPartitionDispatchRate: {Type: valueTypeFloat, Filters: taskQueueFilters, Min: bound(0)},
+
+	// TODO remove this dynamic flag in 1.14.x
+	EnableDBRecordVersion: {Type: valueTypeBool},
+
+	// system settings
+	EnableVisibilitySampling:               {Type: valueTypeBool},
+	AdvancedVisibilityWritingMode:          {Type: valueTypeString},
+	EnableReadVisibilityFromES:             {Type: valueTypeBool, Filters: namespaceFilters},
+	EnableVisibilityExport:                 {Type: valueTypeBool, Filters: namespaceFilters},
+	VisibilitySinks:                        {Type: valueTypeString, Filters: namespaceFilters},
+	VisibilityBestEffortSinks:              {Type: valueTypeString, Filters: namespaceFilters},
+	HistoryArchivalState:                   {Type: valueTypeString},
+	EnableReadFromHistoryArchival:          {Type: valueTypeBool},
+	VisibilityArchivalState:                {Type: valueTypeString},
+	EnableReadFromVisibilityArchival:       {Type: valueTypeBool},
+	EnableNamespaceNotActiveAutoForwarding: {Type: valueTypeBool, Filters: namespaceFilters},
+	TransactionSizeLimit:                   {Type: valueTypeInt},
+	DisallowQuery:                          {Type: valueTypeBool, Filters: namespaceFilters},
+	EnableBatcher:                          {Type: valueTypeBool},
+	EnableParentClosePolicyWorker:          {Type: valueTypeBool},
+	EnableCallbackWorker:                   {Type: valueTypeBool},
+	EnableSchedulerWorker:                  {Type: valueTypeBool},
+	CallbackAllowedHosts:                   {Type: valueTypeString, Filters: namespaceFilters},
+	EnableStickyQuery:                      {Type: valueTypeBool, Filters: namespaceFilters},
+	EnablePriorityTaskProcessor:            {Type: valueTypeBool},
+	EnableAuthorization:                    {Type: valueTypeBool},
+	EnableCrossNamespaceCommands:           {Type: valueTypeBool},
+	TracingSampleRatio:                     {Type: valueTypeFloat, Min: bound(0), Max: bound(1)},
+	NamespaceIsolationGroup:                {Type: valueTypeString, Filters: namespaceFilters},
+
+	// size limit
+	BlobSizeLimitError:             {Type: valueTypeInt, Filters: namespaceFilters},
+	BlobSizeLimitWarn:              {Type: valueTypeInt, Filters: namespaceFilters},
+	BlobSizeAccountingMode:         {Type: valueTypeString, Filters: namespaceFilters},
+	MemoSizeLimitError:             {Type: valueTypeInt, Filters: namespaceFilters},
+	MemoSizeLimitWarn:              {Type: valueTypeInt, Filters: namespaceFilters},
+	HistorySizeLimitError:          {Type: valueTypeInt, Filters: namespaceFilters},
+	HistorySizeLimitWarn:           {Type: valueTypeInt, Filters: namespaceFilters},
+	HistoryCountLimitError:         {Type: valueTypeInt, Filters: namespaceFilters},
+	HistoryCountLimitWarn:          {Type: valueTypeInt, Filters: namespaceFilters},
+	MutableStateSizeLimitError:     {Type: valueTypeInt, Filters: namespaceFilters},
+	MutableStateSizeLimitWarn:      {Type: valueTypeInt, Filters: namespaceFilters},
+	StateTransitionCountLimitError: {Type: valueTypeInt, Filters: namespaceFilters},
+	StateTransitionCountLimitWarn:  {Type: valueTypeInt, Filters: namespaceFilters},
+	MaxIDLengthLimit:               {Type: valueTypeInt},
+	OpenWorkflowCountLimit:         {Type: valueTypeInt, Filters: namespaceFilters, Min: bound(0)},
+
+	NumPendingActivitiesLimitError:      {Type: valueTypeInt, Filters: namespaceFilters, Min: bound(0)},
+	NumPendingChildExecutionsLimitError: {Type: valueTypeInt, Filters: namespaceFilters, Min: bound(0)},
+	NumPendingSignalsLimitError:         {Type: valueTypeInt, Filters: namespaceFilters, Min: bound(0)},
+
+	// frontend settings
+	FrontendPersistenceMaxQPS:             {Type: valueTypeInt, Min: bound(0)},
+	FrontendPersistenceGlobalMaxQPS:       {Type: valueTypeInt, Min: bound(0)},
+	FrontendVisibilityMaxPageSize:         {Type: valueTypeInt, Filters: namespaceFilters},
+	FrontendVisibilityListMaxQPS:          {Type: valueTypeInt, Filters: namespaceFilters, Min: bound(0)},
+	FrontendESVisibilityListMaxQPS:        {Type: valueTypeInt, Filters: namespaceFilters, Min: bound(0)},
+	FrontendMaxBadBinaries:                {Type: valueTypeInt, Filters: namespaceFilters},
+	FrontendESIndexMaxResultWindow:        {Type: valueTypeInt},
+	FrontendESPointInTimeKeepAlive:        {Type: valueTypeDuration},
+	FrontendESParseDocStrictMode:          {Type: valueTypeBool},
+	FrontendHistoryMaxPageSize:            {Type: valueTypeInt, Filters: namespaceFilters},
+	FrontendRPS:                           {Type: valueTypeInt, Min: bound(0)},
+	FrontendMaxNamespaceRPSPerInstance:    {Type: valueTypeInt, Filters: namespaceFilters, Min: bound(0)},
+	FrontendMaxNamespaceCountPerInstance:  {Type: valueTypeInt, Filters: namespaceFilters},
+	FrontendGlobalNamespaceRPS:            {Type: valueTypeInt, Filters: namespaceFilters, Min: bound(0)},
+	FrontendNamespaceWriteRPS:             {Type: valueTypeInt, Filters: namespaceFilters, Min: bound(0)},
+	FrontendNamespaceReadRPS:              {Type: valueTypeInt, Filters: namespaceFilters, Min: bound(0)},
+	FrontendNamespaceVisibilityRPS:        {Type: valueTypeInt, Filters: namespaceFilters, Min: bound(0)},
+	FrontendNamespaceLongPollRPS:          {Type: valueTypeInt, Filters: namespaceFilters, Min: bound(0)},
+	FrontendNamespaceBurstRatio:           {Type: valueTypeFloat, Filters: namespaceFilters, Min: bound(0)},
+	FrontendAdaptiveConcurrencyEnabled:    {Type: valueTypeBool},
+	FrontendAdaptiveConcurrencyMinLimit:   {Type: valueTypeInt},
+	FrontendAdaptiveConcurrencyMaxLimit:   {Type: valueTypeInt},
+	FrontendHistoryMgrNumConns:            {Type: valueTypeInt},
+	FrontendShutdownDrainDuration:         {Type: valueTypeDuration},
+	FrontendMaintenanceRefreshInterval:    {Type: valueTypeDuration},
+	FrontendNamespaceHandoverDrainTimeout: {Type: valueTypeDuration},
+	FrontendAuthorizationLogOnly:          {Type: valueTypeBool, Filters: namespaceFilters},
+	FrontendAuditLogSamplingRate:          {Type: valueTypeFloat, Filters: namespaceFilters, Min: bound(0), Max: bound(1)},
+	FrontendSLOTrackingEnabled:            {Type: valueTypeBool},
+	FrontendSLOAvailabilityTarget:         {Type: valueTypeFloat, Filters: namespaceFilters, Min: bound(0), Max: bound(1)},
+	FrontendSLOLatencyTarget:              {Type: valueTypeDuration, Filters: namespaceFilters},
+	FrontendSLOLatencyTargetOverrides:     {Type: valueTypeMap},
+	FrontendSLOLatencyObjective:           {Type: valueTypeFloat, Filters: namespaceFilters, Min: bound(0), Max: bound(1)},
+	DisableListVisibilityByFilter:         {Type: valueTypeBool, Filters: namespaceFilters},
+	VisibilityFallbackToPersistence:       {Type: valueTypeBool, Filters: namespaceFilters},
+	QuarantinedWorkflowIDs:                {Type: valueTypeMap, Filters: namespaceFilters},
+	FrontendThrottledLogRPS:               {Type: valueTypeInt, Min: bound(0)},
+	EnableClientVersionCheck:              {Type: valueTypeBool},
+	SendRawWorkflowHistory:                {Type: valueTypeBool, Filters: namespaceFilters},
+	SearchAttributesNumberOfKeysLimit:     {Type: valueTypeInt, Filters: namespaceFilters},
+	SearchAttributesSizeOfValueLimit:      {Type: valueTypeInt, Filters: namespaceFilters},
+	SearchAttributesTotalSizeLimit:        {Type: valueTypeInt, Filters: namespaceFilters},
+	VisibilityArchivalQueryMaxPageSize:    {Type: valueTypeInt},
+	VisibilityArchivalQueryMaxRangeInDays: {Type: valueTypeInt},
+	VisibilityArchivalQueryMaxQPS:         {Type: valueTypeInt, Min: bound(0)},
+	EnableServerVersionCheck:              {Type: valueTypeBool},
+	EnableTokenNamespaceEnforcement:       {Type: valueTypeBool},
+	KeepAliveMinTime:                      {Type: valueTypeDuration},
+	KeepAlivePermitWithoutStream:          {Type: valueTypeBool},
+	KeepAliveMaxConnectionIdle:            {Type: valueTypeDuration},
+	KeepAliveMaxConnectionAge:             {Type: valueTypeDuration},
+	KeepAliveMaxConnectionAgeGrace:        {Type: valueTypeDuration},
+	KeepAliveTime:                         {Type: valueTypeDuration},
+	KeepAliveTimeout:                      {Type: valueTypeDuration},
+	FrontendHistoryStreamEventsPerSecond:  {Type: valueTypeInt, Filters: namespaceFilters, Min: bound(0)},
+	HistoryReverseMaxScannedEvents:        {Type: valueTypeInt, Filters: namespaceFilters, Min: bound(1)},
+
+	// matching settings
+	MatchingRPS:                             {Type: valueTypeInt, Min: bound(0)},
+	MatchingPersistenceMaxQPS:               {Type: valueTypeInt, Min: bound(0)},
+	MatchingPersistenceGlobalMaxQPS:         {Type: valueTypeInt, Min: bound(0)},
+	MatchingMinTaskThrottlingBurstSize:      {Type: valueTypeInt, Filters: taskQueueFilters},
+	MatchingGetTasksBatchSize:               {Type: valueTypeInt, Filters: taskQueueFilters},
+	MatchingLongPollExpirationInterval:      {Type: valueTypeDuration, Filters: taskQueueFilters},
+	MatchingSyncMatchWaitDuration:           {Type: valueTypeDuration, Filters: taskQueueFilters},
+	MatchingSyncMatchPollerWait:             {Type: valueTypeDuration, Filters: taskQueueFilters},
+	MatchingUpdateAckInterval:               {Type: valueTypeDuration, Filters: taskQueueFilters},
+	MatchingIdleTaskqueueCheckInterval:      {Type: valueTypeDuration, Filters: taskQueueFilters},
+	MaxTaskqueueIdleTime:                    {Type: valueTypeDuration, Filters: taskQueueFilters},
+	MatchingOutstandingTaskAppendsThreshold: {Type: valueTypeInt, Filters: taskQueueFilters},
+	MatchingMaxTaskBatchSize:                {Type: valueTypeInt, Filters: taskQueueFilters},
+	MatchingMaxTaskDeleteBatchSize:          {Type: valueTypeInt, Filters: taskQueueFilters},
+	MatchingThrottledLogRPS:                 {Type: valueTypeInt, Min: bound(0)},
+	MatchingNumTaskqueueWritePartitions:     {Type: valueTypeInt, Filters: taskQueueFilters, Min: bound(1)},
+	MatchingNumTaskqueueReadPartitions:      {Type: valueTypeInt, Filters: taskQueueFilters, Min: bound(1)},
+	MatchingForwarderMaxOutstandingPolls:    {Type: valueTypeInt, Filters: taskQueueFilters},
+	MatchingForwarderMaxOutstandingTasks:    {Type: valueTypeInt, Filters: taskQueueFilters},
+	MatchingForwarderMaxRatePerSecond:       {Type: valueTypeInt, Filters: taskQueueFilters},
+	MatchingForwarderMaxChildrenPerNode:     {Type: valueTypeInt, Filters: taskQueueFilters},
+	ResilientSyncMatch:                      {Type: valueTypeBool},
+	MatchingShutdownDrainDuration:           {Type: valueTypeDuration},
+	MatchingUserDataRefreshInterval:         {Type: valueTypeDuration, Filters: taskQueueFilters},
+	MatchingVersionCompatibleSetLimit:       {Type: valueTypeInt, Filters: namespaceFilters},
+	MatchingVersionBuildIdLimit:             {Type: valueTypeInt, Filters: namespaceFilters},
+	MatchingEvictStickyQueueOnPollCancel:    {Type: valueTypeBool, Filters: namespaceFilters},
+	MatchingStickyQueueEvictionTTL:          {Type: valueTypeDuration},
+	MatchingMaxTaskQueueDispatchRate:        {Type: valueTypeFloat, Filters: taskQueueFilters, Min: bound(0)},
+	MatchingIsolationGroupBudget:            {Type: valueTypeMap},
+
+	// history settings
+	HistoryRPS:                                           {Type: valueTypeInt, Min: bound(0)},
+	HistoryAdaptiveConcurrencyEnabled:                    {Type: valueTypeBool},
+	HistoryAdaptiveConcurrencyMinLimit:                   {Type: valueTypeInt},
+	HistoryAdaptiveConcurrencyMaxLimit:                   {Type: valueTypeInt},
+	HistoryIsolationGroupBudget:                          {Type: valueTypeMap},
+	HistoryPersistenceMaxQPS:                             {Type: valueTypeInt, Min: bound(0)},
+	HistoryPersistenceGlobalMaxQPS:                       {Type: valueTypeInt, Min: bound(0)},
+	HistoryVisibilityOpenMaxQPS:                          {Type: valueTypeInt, Filters: namespaceFilters, Min: bound(0)},
+	HistoryVisibilityClosedMaxQPS:                        {Type: valueTypeInt, Filters: namespaceFilters, Min: bound(0)},
+	HistoryLongPollExpirationInterval:                    {Type: valueTypeDuration, Filters: namespaceFilters},
+	HistoryCacheInitialSize:                              {Type: valueTypeInt},
+	HistoryMaxAutoResetPoints:                            {Type: valueTypeInt, Filters: namespaceFilters},
+	HistoryCacheMaxSize:                                  {Type: valueTypeInt},
+	HistoryCacheTTL:                                      {Type: valueTypeDuration},
+	HistoryCacheLoadDeduplication:                        {Type: valueTypeBool},
+	HistoryShutdownDrainDuration:                         {Type: valueTypeDuration},
+	EventsCacheInitialSize:                               {Type: valueTypeInt},
+	EventsCacheMaxSize:                                   {Type: valueTypeInt},
+	EventsCacheTTL:                                       {Type: valueTypeDuration},
+	AcquireShardInterval:                                 {Type: valueTypeDuration},
+	AcquireShardConcurrency:                              {Type: valueTypeInt},
+	ShardRejoinGracePeriod:                               {Type: valueTypeDuration},
+	ShardHibernationIdleTimeout:                          {Type: valueTypeDuration},
+	StandbyClusterDelay:                                  {Type: valueTypeDuration},
+	StandbyTaskMissingEventsResendDelay:                  {Type: valueTypeDuration},
+	StandbyTaskMissingEventsDiscardDelay:                 {Type: valueTypeDuration},
+	TaskProcessRPS:                                       {Type: valueTypeInt, Filters: namespaceFilters, Min: bound(0)},
+	TaskNamespaceTier:                                    {Type: valueTypeString, Filters: namespaceFilters},
+	TaskSchedulerType:                                    {Type: valueTypeInt},
+	TaskSchedulerWorkerCount:                             {Type: valueTypeInt},
+	TaskSchedulerQueueSize:                               {Type: valueTypeInt},
+	TaskSchedulerRoundRobinWeights:                       {Type: valueTypeMap},
+	EnableTaskWorkerPoolAutoscaling:                      {Type: valueTypeBool},
+	TimerTaskBatchSize:                                   {Type: valueTypeInt},
+	TimerTaskMinWorkerCount:                              {Type: valueTypeInt, Min: bound(1)},
+	TimerTaskWorkerCount:                                 {Type: valueTypeInt, Min: bound(1)},
+	TimerTaskMaxRetryCount:                               {Type: valueTypeInt},
+	TimerProcessorGetFailureRetryCount:                   {Type: valueTypeInt},
+	TimerProcessorCompleteTimerFailureRetryCount:         {Type: valueTypeInt},
+	TimerProcessorUpdateShardTaskCount:                   {Type: valueTypeInt},
+	TimerProcessorUpdateAckInterval:                      {Type: valueTypeDuration},
+	TimerProcessorUpdateAckIntervalJitterCoefficient:     {Type: valueTypeFloat, Min: bound(0), Max: bound(1)},
+	TimerProcessorCompleteTimerInterval:                  {Type: valueTypeDuration},
+	TimerProcessorFailoverMaxPollRPS:                     {Type: valueTypeInt, Min: bound(0)},
+	TimerProcessorMaxPollRPS:                             {Type: valueTypeInt, Min: bound(0)},
+	TimerProcessorMaxPollInterval:                        {Type: valueTypeDuration},
+	TimerProcessorMaxPollIntervalJitterCoefficient:       {Type: valueTypeFloat, Min: bound(0), Max: bound(1)},
+	TimerProcessorRedispatchInterval:                     {Type: valueTypeDuration},
+	TimerProcessorRedispatchIntervalJitterCoefficient:    {Type: valueTypeFloat, Min: bound(0), Max: bound(1)},
+	TimerProcessorMaxRedispatchQueueSize:                 {Type: valueTypeInt},
+	TimerProcessorEnablePriorityTaskProcessor:            {Type: valueTypeBool},
+	TimerProcessorMaxTimeShift:                           {Type: valueTypeDuration},
+	TimerProcessorHistoryArchivalSizeLimit:               {Type: valueTypeInt},
+	TimerProcessorArchivalTimeLimit:                      {Type: valueTypeDuration},
+	TransferTaskBatchSize:                                {Type: valueTypeInt},
+	TransferProcessorFailoverMaxPollRPS:                  {Type: valueTypeInt, Min: bound(0)},
+	TransferProcessorMaxPollRPS:                          {Type: valueTypeInt, Min: bound(0)},
+	TransferTaskMinWorkerCount:                           {Type: valueTypeInt, Min: bound(1)},
+	TransferTaskWorkerCount:                              {Type: valueTypeInt, Min: bound(1)},
+	TransferTaskMaxRetryCount:                            {Type: valueTypeInt},
+	TransferProcessorCompleteTransferFailureRetryCount:   {Type: valueTypeInt},
+	TransferProcessorUpdateShardTaskCount:                {Type: valueTypeInt},
+	TransferProcessorMaxPollInterval:                     {Type: valueTypeDuration},
+	TransferProcessorMaxPollIntervalJitterCoefficient:    {Type: valueTypeFloat, Min: bound(0), Max: bound(1)},
+	TransferProcessorUpdateAckInterval:                   {Type: valueTypeDuration},
+	TransferProcessorUpdateAckIntervalJitterCoefficient:  {Type: valueTypeFloat, Min: bound(0), Max: bound(1)},
+	TransferProcessorCompleteTransferInterval:            {Type: valueTypeDuration},
+	TransferProcessorRedispatchInterval:                  {Type: valueTypeDuration},
+	TransferProcessorRedispatchIntervalJitterCoefficient: {Type: valueTypeFloat, Min: bound(0), Max: bound(1)},
+	TransferProcessorMaxRedispatchQueueSize:              {Type: valueTypeInt},
+	TransferProcessorEnablePriorityTaskProcessor:         {Type: valueTypeBool},
+	TransferProcessorVisibilityArchivalTimeLimit:         {Type: valueTypeDuration},
+
+	VisibilityTaskBatchSize:                                {Type: valueTypeInt},
+	VisibilityProcessorFailoverMaxPollRPS:                  {Type: valueTypeInt, Min: bound(0)},
+	VisibilityProcessorMaxPollRPS:                          {Type: valueTypeInt, Min: bound(0)},
+	VisibilityTaskMinWorkerCount:                           {Type: valueTypeInt, Min: bound(1)},
+	VisibilityTaskWorkerCount:                              {Type: valueTypeInt, Min: bound(1)},
+	VisibilityTaskMaxRetryCount:                            {Type: valueTypeInt},
+	VisibilityProcessorCompleteTaskFailureRetryCount:       {Type: valueTypeInt},
+	VisibilityProcessorUpdateShardTaskCount:                {Type: valueTypeInt},
+	VisibilityProcessorMaxPollInterval:                     {Type: valueTypeDuration},
+	VisibilityProcessorMaxPollIntervalJitterCoefficient:    {Type: valueTypeFloat, Min: bound(0), Max: bound(1)},
+	VisibilityProcessorUpdateAckInterval:                   {Type: valueTypeDuration},
+	VisibilityProcessorUpdateAckIntervalJitterCoefficient:  {Type: valueTypeFloat, Min: bound(0), Max: bound(1)},
+	VisibilityProcessorCompleteTaskInterval:                {Type: valueTypeDuration},
+	VisibilityProcessorRedispatchInterval:                  {Type: valueTypeDuration},
+	VisibilityProcessorRedispatchIntervalJitterCoefficient: {Type: valueTypeFloat, Min: bound(0), Max: bound(1)},
+	VisibilityProcessorMaxRedispatchQueueSize:              {Type: valueTypeInt},
+	VisibilityProcessorEnablePriorityTaskProcessor:         {Type: valueTypeBool},
+	VisibilityProcessorVisibilityArchivalTimeLimit:         {Type: valueTypeDuration},
+
+	ReplicatorTaskBatchSize:                                {Type: valueTypeInt},
+	ReplicatorTaskWorkerCount:                              {Type: valueTypeInt},
+	ReplicatorTaskMaxRetryCount:                            {Type: valueTypeInt},
+	ReplicatorProcessorMaxPollRPS:                          {Type: valueTypeInt, Min: bound(0)},
+	ReplicatorProcessorUpdateShardTaskCount:                {Type: valueTypeInt},
+	ReplicatorProcessorMaxPollInterval:                     {Type: valueTypeDuration},
+	ReplicatorProcessorMaxPollIntervalJitterCoefficient:    {Type: valueTypeFloat, Min: bound(0), Max: bound(1)},
+	ReplicatorProcessorUpdateAckInterval:                   {Type: valueTypeDuration},
+	ReplicatorProcessorUpdateAckIntervalJitterCoefficient:  {Type: valueTypeFloat, Min: bound(0), Max: bound(1)},
+	ReplicatorProcessorRedispatchInterval:                  {Type: valueTypeDuration},
+	ReplicatorProcessorRedispatchIntervalJitterCoefficient: {Type: valueTypeFloat, Min: bound(0), Max: bound(1)},
+	ReplicatorProcessorMaxRedispatchQueueSize:              {Type: valueTypeInt},
+	ReplicatorProcessorEnablePriorityTaskProcessor:         {Type: valueTypeBool},
+	MaximumBufferedEventsBatch:                             {Type: valueTypeInt},
+	MaximumSignalsPerExecution:                             {Type: valueTypeInt, Filters: namespaceFilters},
+	HistoryCompactionMaxBatchEventCount:                    {Type: valueTypeInt, Filters: namespaceFilters},
+	HistoryCompactionMaxBatchSize:                          {Type: valueTypeInt, Filters: namespaceFilters},
+	ShardUpdateMinInterval:                                 {Type: valueTypeDuration},
+	ShardSyncMinInterval:                                   {Type: valueTypeDuration},
+	ShardSyncTimerJitterCoefficient:                        {Type: valueTypeFloat, Min: bound(0), Max: bound(1)},
+	DefaultEventEncoding:                                   {Type: valueTypeString, Filters: namespaceFilters},
+	EnableParentClosePolicy:                                {Type: valueTypeBool, Filters: namespaceFilters},
+	NumArchiveSystemWorkflows:                              {Type: valueTypeInt},
+	ArchiveRequestRPS:                                      {Type: valueTypeInt, Min: bound(0)},
+	EmitShardDiffLog:                                       {Type: valueTypeBool},
+	HistoryThrottledLogRPS:                                 {Type: valueTypeInt, Min: bound(0)},
+	StickyTTL:                                              {Type: valueTypeDuration, Filters: namespaceFilters},
+	WorkflowTaskHeartbeatTimeout:                           {Type: valueTypeDuration, Filters: namespaceFilters},
+	DefaultWorkflowTaskTimeout:                             {Type: valueTypeDuration, Filters: namespaceFilters},
+	ParentClosePolicyThreshold:                             {Type: valueTypeInt, Filters: namespaceFilters},
+	NumParentClosePolicySystemWorkflows:                    {Type: valueTypeInt},
+	NumCallbackSystemWorkflows:                             {Type: valueTypeInt},
+	ReplicationTaskFetcherParallelism:                      {Type: valueTypeInt},
+	ReplicationTaskFetcherAggregationInterval:              {Type: valueTypeDuration},
+	ReplicationTaskFetcherTimerJitterCoefficient:           {Type: valueTypeFloat, Min: bound(0), Max: bound(1)},
+	ReplicationTaskFetcherErrorRetryWait:                   {Type: valueTypeDuration},
+	ReplicationTaskProcessorErrorRetryWait:                 {Type: valueTypeDuration, Filters: shardIDFilters},
+	ReplicationTaskProcessorErrorRetryBackoffCoefficient:   {Type: valueTypeFloat, Filters: shardIDFilters, Min: bound(1)},
+	ReplicationTaskProcessorErrorRetryMaxInterval:          {Type: valueTypeDuration, Filters: shardIDFilters},
+	ReplicationTaskProcessorErrorRetryMaxAttempts:          {Type: valueTypeInt, Filters: shardIDFilters},
+	ReplicationTaskProcessorErrorRetryExpiration:           {Type: valueTypeDuration, Filters: shardIDFilters},
+	ReplicationTaskProcessorNoTaskInitialWait:              {Type: valueTypeDuration, Filters: shardIDFilters},
+	ReplicationTaskProcessorCleanupInterval:                {Type: valueTypeDuration, Filters: shardIDFilters},
+	ReplicationTaskProcessorCleanupJitterCoefficient:       {Type: valueTypeFloat, Filters: shardIDFilters, Min: bound(0), Max: bound(1)},
+	ReplicationTaskProcessorStartWait:                      {Type: valueTypeDuration},
+	ReplicationTaskProcessorStartWaitJitterCoefficient:     {Type: valueTypeFloat, Min: bound(0), Max: bound(1)},
+	ReplicationTaskProcessorHostQPS:                        {Type: valueTypeFloat, Min: bound(0)},
+	ReplicationTaskProcessorShardQPS:                       {Type: valueTypeFloat, Min: bound(0)},
+	ReplicationTaskProcessorEnableStreaming:                {Type: valueTypeBool},
+	ReplicationStreamHeartbeatInterval:                     {Type: valueTypeDuration},
+	MaxBufferedQueryCount:                                  {Type: valueTypeInt},
+	MutableStateChecksumGenProbability:                     {Type: valueTypeInt, Filters: namespaceFilters},
+	MutableStateChecksumVerifyProbability:                  {Type: valueTypeInt, Filters: namespaceFilters},
+	MutableStateChecksumInvalidateBefore:                   {Type: valueTypeFloat},
+	MutableStateIntegrityCheckEnabled:                      {Type: valueTypeBool, Filters: namespaceFilters},
+	EnableWorkflowAuditLog:                                 {Type: valueTypeBool, Filters: namespaceFilters},
+	StickyQueryDispatchDeadline:                            {Type: valueTypeDuration, Filters: namespaceFilters},
+	OpenWorkflowCountRefreshInterval:                       {Type: valueTypeDuration},
+	ReplicationEventsFromCurrentCluster:                    {Type: valueTypeBool, Filters: namespaceFilters},
+	StandbyTaskReReplicationContextTimeout:                 {Type: valueTypeDuration, Filters: namespaceIDFilters},
+	EnableDropStuckTaskByNamespaceID:                       {Type: valueTypeBool, Filters: namespaceIDFilters},
+	SkipReapplicationByNamespaceId:                         {Type: valueTypeBool, Filters: namespaceIDFilters},
+	DefaultActivityRetryPolicy:                             {Type: valueTypeMap, Filters: namespaceFilters},
+	DefaultWorkflowRetryPolicy:                             {Type: valueTypeMap, Filters: namespaceFilters},
+
+	WorkerPersistenceMaxQPS:                         {Type: valueTypeInt, Min: bound(0)},
+	WorkerPersistenceGlobalMaxQPS:                   {Type: valueTypeInt, Min: bound(0)},
+	WorkerReplicatorMetaTaskConcurrency:             {Type: valueTypeInt},
+	WorkerReplicatorTaskConcurrency:                 {Type: valueTypeInt},
+	WorkerReplicatorMessageConcurrency:              {Type: valueTypeInt},
+	WorkerReplicatorActivityBufferRetryCount:        {Type: valueTypeInt},
+	WorkerReplicatorHistoryBufferRetryCount:         {Type: valueTypeInt},
+	WorkerReplicationTaskMaxRetryCount:              {Type: valueTypeInt},
+	WorkerReplicationTaskMaxRetryDuration:           {Type: valueTypeDuration},
+	WorkerReplicationTaskContextDuration:            {Type: valueTypeDuration},
+	WorkerReReplicationContextTimeout:               {Type: valueTypeDuration, Filters: namespaceIDFilters},
+	WorkerIndexerConcurrency:                        {Type: valueTypeInt},
+	WorkerESProcessorNumOfWorkers:                   {Type: valueTypeInt},
+	WorkerESProcessorBulkActions:                    {Type: valueTypeInt},
+	WorkerESProcessorBulkSize:                       {Type: valueTypeInt},
+	WorkerESProcessorFlushInterval:                  {Type: valueTypeDuration},
+	WorkerESProcessorAckTimeout:                     {Type: valueTypeDuration},
+	WorkerESProcessorBackfillWorkers:                {Type: valueTypeInt},
+	WorkerESProcessorShutdownDrainTimeout:           {Type: valueTypeDuration},
+	EnableArchivalCompression:                       {Type: valueTypeBool},
+	WorkerHistoryPageSize:                           {Type: valueTypeInt},
+	WorkerTargetArchivalBlobSize:                    {Type: valueTypeInt},
+	WorkerArchiverConcurrency:                       {Type: valueTypeInt},
+	WorkerArchivalsPerIteration:                     {Type: valueTypeInt},
+	WorkerDeterministicConstructionCheckProbability: {Type: valueTypeFloat, Min: bound(0), Max: bound(1)},
+	WorkerBlobIntegrityCheckProbability:             {Type: valueTypeFloat, Min: bound(0), Max: bound(1)},
+	WorkerTimeLimitPerArchivalIteration:             {Type: valueTypeDuration},
+	WorkerThrottledLogRPS:                           {Type: valueTypeInt, Min: bound(0)},
+	ScannerPersistenceMaxQPS:                        {Type: valueTypeInt, Min: bound(0)},
+	TaskQueueScannerEnabled:                         {Type: valueTypeBool},
+	HistoryScannerEnabled:                           {Type: valueTypeBool},
+	ExecutionsScannerEnabled:                        {Type: valueTypeBool},
+	EnableSearchAttributeMetrics:                    {Type: valueTypeBool},
+	SearchAttributeMetricsRefreshInterval:           {Type: valueTypeDuration},
+	SearchAttributeMetricsGroupBy:                   {Type: valueTypeString, Filters: namespaceFilters},
+	SearchAttributeMetricsValues:                    {Type: valueTypeString, Filters: namespaceFilters},
+	SearchAttributeMetricsTopN:                      {Type: valueTypeInt, Filters: namespaceFilters},
+	SearchAttributeMetricsSampleSize:                {Type: valueTypeInt, Filters: namespaceFilters},
+}
@@ -0,0 +1,295 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.uber.org/multierr"
+
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+type (
+	// valueType is the type of value a dynamic config key accepts
+	valueType int
+
+	// keySchema describes the values and constraints accepted for a dynamic config key
+	keySchema struct {
+		Type    valueType
+		Filters []Filter
+		// Min and Max are inclusive bounds for numeric values, nil means unbounded
+		Min *float64
+		Max *float64
+	}
+)
+
+const (
+	valueTypeAny valueType = iota
+	valueTypeBool
+	valueTypeInt
+	valueTypeFloat
+	valueTypeString
+	valueTypeDuration
+	valueTypeMap
+)
+
+var (
+	// deprecatedKeys are keys which are no longer read by the server, mapped to the reason. They are ignored
+	// with a warning instead of being rejected, so that dynamic config files written for older versions keep working.
+	deprecatedKeys = map[string]string{
+		"history.historyMgrNumConns": "history service uses numConns of the persistence config",
+	}
+
+	namespaceFilters   = []Filter{Namespace}
+	namespaceIDFilters = []Filter{NamespaceID}
+	taskQueueFilters   = []Filter{Namespace, TaskQueueName, TaskType}
+	shardIDFilters     = []Filter{ShardID}
+	allFilters         = []Filter{Namespace, NamespaceID, TaskQueueName, TaskType, ShardID}
+)
+
+func (t valueType) String() string {
+	switch t {
+	case valueTypeBool:
+		return "bool"
+	case valueTypeInt:
+		return "int"
+	case valueTypeFloat:
+		return "float"
+	case valueTypeString:
+		return "string"
+	case valueTypeDuration:
+		return "duration"
+	case valueTypeMap:
+		return "map"
+	default:
+		return "any"
+	}
+}
+
+func bound(v float64) *float64 {
+	return &v
+}
+
+// ValidateFile validates the dynamic config file against the keys known by this version of the server.
+// It checks that every key is known, that values have the type and range expected by the key and that
+// constraints only use filters supported by the key. All problems found are combined into the returned error.
+func ValidateFile(filepath string) error {
+	values, err := readConfigFile(filepath)
+	if err != nil {
+		return err
+	}
+	return validateValues(values)
+}
+
+// DeprecatedKeys returns a warning for each deprecated key of the dynamic config file, such keys are ignored by the server.
+func DeprecatedKeys(filepath string) ([]string, error) {
+	values, err := readConfigFile(filepath)
+	if err != nil {
+		return nil, err
+	}
+	return deprecatedKeyWarnings(values), nil
+}
+
+func deprecatedKeyWarnings(values configValueMap) []string {
+	var warnings []string
+	for keyName := range values {
+		if reason, ok := lookupDeprecatedKey(keyName); ok {
+			warnings = append(warnings, fmt.Sprintf("%v: deprecated key is ignored, %v", keyName, reason))
+		}
+	}
+	sort.Strings(warnings)
+	return warnings
+}
+
+func lookupDeprecatedKey(keyName string) (string, bool) {
+	for deprecatedKey, reason := range deprecatedKeys {
+		if strings.EqualFold(deprecatedKey, keyName) {
+			return reason, true
+		}
+	}
+	return "", false
+}
+
+func validateValues(values configValueMap) error {
+	keyNames := make([]string, 0, len(values))
+	for keyName := range values {
+		keyNames = append(keyNames, keyName)
+	}
+	sort.Strings(keyNames)
+
+	var errs error
+	for _, keyName := range keyNames {
+		if _, ok := lookupDeprecatedKey(keyName); ok {
+			continue
+		}
+		if !IsKnownKey(keyName) {
+			errs = multierr.Append(errs, fmt.Errorf("%v: unknown key", keyName))
+			continue
+		}
+		for _, cv := range values[keyName] {
+			errs = multierr.Append(errs, validateValue(keyName, cv))
+		}
+	}
+	return errs
+}
+
+// validateValue validates a single value of a known dynamic config key.
+func validateValue(keyName string, cv *constrainedValue) error {
+	schema, ok := lookupKeySchema(keyName)
+	if !ok {
+		return fmt.Errorf("%v: unknown key", keyName)
+	}
+	if cv == nil {
+		return fmt.Errorf("%v: missing value", keyName)
+	}
+
+	var errs error
+	if err := schema.validateType(cv.Value); err != nil {
+		errs = multierr.Append(errs, fmt.Errorf("%v: %w", keyName, err))
+	}
+	constraintNames := make([]string, 0, len(cv.Constraints))
+	for name := range cv.Constraints {
+		constraintNames = append(constraintNames, name)
+	}
+	sort.Strings(constraintNames)
+	for _, name := range constraintNames {
+		if err := schema.validateConstraint(name, cv.Constraints[name]); err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("%v: %w", keyName, err))
+		}
+	}
+	return errs
+}
+
+func lookupKeySchema(keyName string) (keySchema, bool) {
+	for key, name := range Keys {
+		if key != unknownKey && strings.EqualFold(name, keyName) {
+			schema, ok := keySchemas[key]
+			return schema, ok
+		}
+	}
+	return keySchema{}, false
+}
+
+func (s keySchema) validateType(value interface{}) error {
+	var number float64
+	switch s.Type {
+	case valueTypeAny:
+		return nil
+	case valueTypeBool:
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("value %v of type %T is not a %v", value, value, s.Type)
+		}
+		return nil
+	case valueTypeString:
+		if _, ok := value.(string); !ok {
+			return fmt.Errorf("value %v of type %T is not a %v", value, value, s.Type)
+		}
+		return nil
+	case valueTypeMap:
+		switch value.(type) {
+		case map[string]interface{}, map[interface{}]interface{}:
+			return nil
+		}
+		return fmt.Errorf("value %v of type %T is not a %v", value, value, s.Type)
+	case valueTypeDuration:
+		switch v := value.(type) {
+		case time.Duration:
+			return nil
+		case string:
+			if _, err := timestamp.ParseDurationDefaultDays(v); err != nil {
+				return fmt.Errorf("value %q is not a %v: %v", v, s.Type, err)
+			}
+			return nil
+		}
+		return fmt.Errorf("value %v of type %T is not a %v", value, value, s.Type)
+	case valueTypeInt:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("value %v of type %T is not an %v", value, value, s.Type)
+		}
+		number = float64(v)
+	case valueTypeFloat:
+		switch v := value.(type) {
+		case float64:
+			number = v
+		case int:
+			number = float64(v)
+		default:
+			return fmt.Errorf("value %v of type %T is not a %v", value, value, s.Type)
+		}
+	}
+
+	if s.Min != nil && number < *s.Min {
+		return fmt.Errorf("value %v is less than minimum %v", value, *s.Min)
+	}
+	if s.Max != nil && number > *s.Max {
+		return fmt.Errorf("value %v is greater than maximum %v", value, *s.Max)
+	}
+	return nil
+}
+
+func (s keySchema) validateConstraint(name string, value interface{}) error {
+	var filter Filter
+	for _, f := range s.Filters {
+		if f.String() == name {
+			filter = f
+			break
+		}
+	}
+	if filter == unknownFilter && len(s.Filters) == 0 {
+		return fmt.Errorf("constraint %v is not supported, key does not support constraints", name)
+	}
+	if filter == unknownFilter {
+		return fmt.Errorf("constraint %v is not supported, supported constraints are %v", name, s.Filters)
+	}
+
+	switch filter {
+	case Namespace, NamespaceID, TaskQueueName:
+		if _, ok := value.(string); !ok {
+			return fmt.Errorf("constraint %v value %v is not a string", name, value)
+		}
+	case TaskType:
+		if v, ok := value.(string); ok {
+			for _, taskType := range enumspb.TaskQueueType_name {
+				if v == taskType {
+					return nil
+				}
+			}
+		}
+		return fmt.Errorf("constraint %v value %v is not a valid task type", name, value)
+	case ShardID:
+		switch value.(type) {
+		case int, int32:
+		default:
+			return fmt.Errorf("constraint %v value %v is not an int", name, value)
+		}
+	}
+	return nil
+}
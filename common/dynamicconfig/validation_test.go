@@ -0,0 +1,179 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/multierr"
+)
+
+type validationSuite struct {
+	suite.Suite
+	*require.Assertions
+}
+
+func TestValidationSuite(t *testing.T) {
+	s := new(validationSuite)
+	suite.Run(t, s)
+}
+
+func (s *validationSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *validationSuite) TestKeySchemaIsDefined() {
+	for key, keyName := range Keys {
+		_, ok := keySchemas[key]
+		s.True(ok, "key %v has no schema", keyName)
+	}
+	s.Len(keySchemas, len(Keys))
+}
+
+func (s *validationSuite) TestValidateValues_Valid() {
+	values := configValueMap{
+		"frontend.rps": {
+			{Value: 1200},
+		},
+		"history.defaultActivityRetryPolicy": {
+			{Value: map[interface{}]interface{}{"MaximumAttempts": 0}},
+			{Value: map[interface{}]interface{}{"MaximumAttempts": 1}, Constraints: map[string]interface{}{"namespace": "samples-namespace"}},
+		},
+		"Matching.NumTaskqueueReadPartitions": {
+			{Value: 8, Constraints: map[string]interface{}{"namespace": "samples-namespace", "taskQueueName": "tq", "taskType": "Activity"}},
+		},
+		"history.ReplicationTaskProcessorErrorRetryWait": {
+			{Value: "2s", Constraints: map[string]interface{}{"shardID": 1}},
+		},
+		"history.ReplicationTaskProcessorErrorRetryBackoffCoefficient": {
+			{Value: 2},
+		},
+		"history.timerProcessorMaxPollIntervalJitterCoefficient": {
+			{Value: 0.15},
+		},
+		"matching.longPollExpirationInterval": {
+			{Value: time.Minute},
+		},
+		"system.enableNamespaceNotActiveAutoForwarding": {
+			{Value: true, Constraints: map[string]interface{}{"namespace": "samples-namespace"}},
+		},
+	}
+	s.NoError(validateValues(values))
+}
+
+func (s *validationSuite) TestValidateValues_Invalid() {
+	values := configValueMap{
+		"frontend.misspeledKey": {
+			{Value: 1},
+		},
+		"frontend.rps": {
+			{Value: "1200"},
+			{Value: -1},
+			{Value: 1200, Constraints: map[string]interface{}{"namespace": "samples-namespace"}},
+		},
+		"history.timerProcessorMaxPollIntervalJitterCoefficient": {
+			{Value: 1.5},
+		},
+		"matching.longPollExpirationInterval": {
+			{Value: 10},
+			{Value: "10 seconds"},
+			{Value: "10s", Constraints: map[string]interface{}{"namespace": 1}},
+		},
+		"matching.numTaskqueueReadPartitions": {
+			{Value: 0},
+			{Value: 2, Constraints: map[string]interface{}{"taskType": "Timer"}},
+			{Value: 2, Constraints: map[string]interface{}{"shardID": 1}},
+		},
+	}
+	err := validateValues(values)
+	s.Error(err)
+	errs := multierr.Errors(err)
+	s.Len(errs, 11)
+	s.EqualError(errs[0], "frontend.misspeledKey: unknown key")
+	s.EqualError(errs[1], "frontend.rps: value 1200 of type string is not an int")
+	s.EqualError(errs[2], "frontend.rps: value -1 is less than minimum 0")
+	s.EqualError(errs[3], "frontend.rps: constraint namespace is not supported, key does not support constraints")
+	s.EqualError(errs[4], "history.timerProcessorMaxPollIntervalJitterCoefficient: value 1.5 is greater than maximum 1")
+	s.EqualError(errs[5], "matching.longPollExpirationInterval: value 10 of type int is not a duration")
+	s.Contains(errs[6].Error(), `matching.longPollExpirationInterval: value "10 seconds" is not a duration`)
+	s.EqualError(errs[7], "matching.longPollExpirationInterval: constraint namespace value 1 is not a string")
+	s.EqualError(errs[8], "matching.numTaskqueueReadPartitions: value 0 is less than minimum 1")
+	s.EqualError(errs[9], "matching.numTaskqueueReadPartitions: constraint taskType value Timer is not a valid task type")
+	s.EqualError(errs[10], "matching.numTaskqueueReadPartitions: constraint shardID is not supported, supported constraints are [namespace taskQueueName taskType]")
+}
+
+func (s *validationSuite) TestValidateFile() {
+	file, err := ioutil.TempFile("", "dynamicconfig")
+	s.NoError(err)
+	defer func() { s.NoError(os.Remove(file.Name())) }()
+	_, err = file.WriteString(`
+frontend.rps:
+  - value: 1200
+matching.longPollExpirationInterval:
+  - value: 1m
+    constraints:
+      namespace: samples-namespace
+history.defaultActivityRetryPolicy:
+  - value:
+      InitialIntervalInSeconds: 1
+      MaximumAttempts: 0
+`)
+	s.NoError(err)
+	s.NoError(file.Close())
+	s.NoError(ValidateFile(file.Name()))
+
+	s.NoError(ValidateFile("../../config/dynamicconfig/development.yaml"))
+	s.NoError(ValidateFile("../../config/dynamicconfig/development_es.yaml"))
+	s.Error(ValidateFile("file/does/not/exist.yaml"))
+}
+
+func (s *validationSuite) TestDeprecatedKeys() {
+	file, err := ioutil.TempFile("", "dynamicconfig")
+	s.NoError(err)
+	defer func() { s.NoError(os.Remove(file.Name())) }()
+	_, err = file.WriteString(`
+frontend.rps:
+  - value: 1200
+history.historyMgrNumConns:
+  - value: 50
+`)
+	s.NoError(err)
+	s.NoError(file.Close())
+
+	// deprecated keys are ignored instead of rejecting the whole file
+	s.NoError(ValidateFile(file.Name()))
+	warnings, err := DeprecatedKeys(file.Name())
+	s.NoError(err)
+	s.Len(warnings, 1)
+	s.Contains(warnings[0], "history.historyMgrNumConns")
+
+	_, err = DeprecatedKeys("file/does/not/exist.yaml")
+	s.Error(err)
+}
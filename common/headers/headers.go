@@ -26,6 +26,8 @@ package headers
 
 import (
 	"context"
+	"strconv"
+	"time"
 
 	"google.golang.org/grpc/metadata"
 )
@@ -34,6 +36,19 @@ const (
 	ClientNameHeaderName              = "client-name"
 	ClientVersionHeaderName           = "client-version"
 	SupportedServerVersionsHeaderName = "supported-server-versions"
+
+	// ReplicationShardIDHeaderName identifies the shard of a replication stream.
+	// It is set when the stream is opened because the stream is routed before the first message is sent.
+	ReplicationShardIDHeaderName = "replication-shard-id"
+
+	// MaintenanceMessageHeaderName and MaintenanceSeverityHeaderName are returned by the frontend
+	// while planned maintenance is announced for the cluster.
+	MaintenanceMessageHeaderName  = "maintenance-message"
+	MaintenanceSeverityHeaderName = "maintenance-severity"
+
+	// StartDelayHeaderName delays the first workflow task of a workflow started by StartWorkflowExecution.
+	// The value is a duration string, for example "1h30m".
+	StartDelayHeaderName = "start-delay"
 )
 
 var (
@@ -112,6 +127,55 @@ func SetVersionsForTests(ctx context.Context, clientVersion, clientName, support
 	}))
 }
 
+// SetReplicationShardID sets the shard ID header of a replication stream on outgoing context.
+func SetReplicationShardID(ctx context.Context, shardID int32) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, ReplicationShardIDHeaderName, strconv.Itoa(int(shardID)))
+}
+
+// GetReplicationShardID returns the shard ID of a replication stream from incoming context.
+func GetReplicationShardID(ctx context.Context) (int32, bool) {
+	return parseShardID(GetValues(ctx, ReplicationShardIDHeaderName)[0])
+}
+
+// GetOutgoingReplicationShardID returns the shard ID of a replication stream from outgoing context.
+func GetOutgoingReplicationShardID(ctx context.Context) (int32, bool) {
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if !ok {
+		return 0, false
+	}
+	return parseShardID(getSingleHeaderValue(md, ReplicationShardIDHeaderName))
+}
+
+// SetStartDelay sets the start delay header on outgoing context.
+func SetStartDelay(ctx context.Context, startDelay time.Duration) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, StartDelayHeaderName, startDelay.String())
+}
+
+// GetStartDelay returns the start delay from incoming context, or zero if the header is not set.
+func GetStartDelay(ctx context.Context) (time.Duration, error) {
+	value := GetValues(ctx, StartDelayHeaderName)[0]
+	if value == "" {
+		return 0, nil
+	}
+	return time.ParseDuration(value)
+}
+
+// PropagateStartDelay copies the start delay header from incoming context to outgoing context.
+func PropagateStartDelay(ctx context.Context) context.Context {
+	if value := GetValues(ctx, StartDelayHeaderName)[0]; value != "" {
+		return metadata.AppendToOutgoingContext(ctx, StartDelayHeaderName, value)
+	}
+	return ctx
+}
+
+func parseShardID(value string) (int32, bool) {
+	shardID, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return 0, false
+	}
+	return int32(shardID), true
+}
+
 func getSingleHeaderValue(md metadata.MD, headerName string) string {
 	values := md.Get(headerName)
 	if len(values) == 0 {
@@ -27,6 +27,7 @@ package headers
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -127,3 +128,38 @@ func (s *HeadersSuite) TestPropagateHeaders_EmptyIncomingContext() {
 	s.Equal("<21.04.16", md.Get(SupportedServerVersionsHeaderName)[0])
 	s.Equal("28.08.14", md.Get(ClientNameHeaderName)[0])
 }
+
+func (s *HeadersSuite) TestReplicationShardID() {
+	ctx := SetReplicationShardID(context.Background(), 17)
+
+	shardID, ok := GetOutgoingReplicationShardID(ctx)
+	s.True(ok)
+	s.Equal(int32(17), shardID)
+
+	md, _ := metadata.FromOutgoingContext(ctx)
+	shardID, ok = GetReplicationShardID(metadata.NewIncomingContext(context.Background(), md))
+	s.True(ok)
+	s.Equal(int32(17), shardID)
+
+	_, ok = GetReplicationShardID(context.Background())
+	s.False(ok)
+}
+
+func (s *HeadersSuite) TestStartDelay() {
+	startDelay, err := GetStartDelay(context.Background())
+	s.NoError(err)
+	s.Zero(startDelay)
+
+	ctx := SetStartDelay(context.Background(), 90*time.Minute)
+	md, _ := metadata.FromOutgoingContext(ctx)
+	incomingCtx := metadata.NewIncomingContext(context.Background(), md)
+	startDelay, err = GetStartDelay(incomingCtx)
+	s.NoError(err)
+	s.Equal(90*time.Minute, startDelay)
+
+	md, _ = metadata.FromOutgoingContext(PropagateStartDelay(incomingCtx))
+	s.Equal([]string{"1h30m0s"}, md.Get(StartDelayHeaderName))
+
+	_, err = GetStartDelay(metadata.NewIncomingContext(context.Background(), metadata.Pairs(StartDelayHeaderName, "soon")))
+	s.Error(err)
+}
@@ -130,6 +130,16 @@ func WorkflowResetNewRunID(runID string) ZapTag {
 	return NewStringTag("wf-reset-new-run-id", runID)
 }
 
+// WorkflowNewRunID returns tag for the run ID of the new run of a workflow, e.g. after continue as new
+func WorkflowNewRunID(runID string) ZapTag {
+	return NewStringTag("wf-new-run-id", runID)
+}
+
+// WorkflowLifecycleEvent returns tag for the history event of a workflow lifecycle transition
+func WorkflowLifecycleEvent(eventType enumspb.EventType) ZapTag {
+	return NewStringTag("wf-lifecycle-event", eventType.String())
+}
+
 // WorkflowBinaryChecksum returns tag for WorkflowBinaryChecksum
 func WorkflowBinaryChecksum(cs string) ZapTag {
 	return NewStringTag("wf-binary-checksum", cs)
@@ -290,6 +300,11 @@ func WorkflowSize(workflowSize int64) ZapTag {
 	return NewInt64("wf-size", workflowSize)
 }
 
+// BlobDataSize returns tag for the size of a blob excluding payload metadata
+func BlobDataSize(blobDataSize int64) ZapTag {
+	return NewInt64("blob-data-size", blobDataSize)
+}
+
 // WorkflowSignalCount returns tag for SignalCount
 func WorkflowSignalCount(signalCount int64) ZapTag {
 	return NewInt64("wf-signal-count", signalCount)
@@ -305,11 +320,21 @@ func WorkflowHistorySizeBytes(historySizeBytes int) ZapTag {
 	return NewInt("wf-history-size-bytes", historySizeBytes)
 }
 
+// WorkflowMutableStateSize returns tag for MutableStateSize
+func WorkflowMutableStateSize(mutableStateSize int) ZapTag {
+	return NewInt("wf-mutable-state-size", mutableStateSize)
+}
+
 // WorkflowEventCount returns tag for EventCount
 func WorkflowEventCount(eventCount int) ZapTag {
 	return NewInt("wf-event-count", eventCount)
 }
 
+// WorkflowStateTransitionCount returns tag for StateTransitionCount
+func WorkflowStateTransitionCount(stateTransitionCount int64) ZapTag {
+	return NewInt64("wf-state-transition-count", stateTransitionCount)
+}
+
 ///////////////////  System tags defined here:  ///////////////////
 // Tags with pre-define values
 
@@ -124,10 +124,16 @@ var (
 	ComponentESVisibilityManager      = component("es-visibility-manager")
 	ComponentArchiver                 = component("archiver")
 	ComponentBatcher                  = component("batcher")
+	ComponentCallbackProcessor        = component("callback-processor")
+	ComponentSearchAttributeMetrics   = component("search-attribute-metrics")
 	ComponentWorker                   = component("worker")
 	ComponentServiceResolver          = component("service-resolver")
 	ComponentMetadataInitializer      = component("metadata-initializer")
 	ComponentAddSearchAttributes      = component("add-search-attributes")
+	ComponentForceReplication         = component("force-replication")
+	ComponentScheduler                = component("scheduler")
+	ComponentDeleteNamespace          = component("delete-namespace")
+	ComponentWorkflowAudit            = component("workflow-audit")
 	VersionChecker                    = component("version-checker")
 )
 
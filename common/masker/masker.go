@@ -33,8 +33,8 @@ import (
 const passwordMask = "******"
 
 var (
-	DefaultFieldNames     = []string{"Password", "KeyData"}
-	DefaultYAMLFieldNames = []string{"password", "keyData"}
+	DefaultFieldNames     = []string{"Password", "KeyData", "SigningKey", "EncryptionKey", "APIKey", "Token"}
+	DefaultYAMLFieldNames = []string{"password", "keyData", "signingKey", "encryptionKey", "apiKey", "token"}
 )
 
 // MaskYaml replace password values with mask and returns copy of the string.
@@ -50,6 +50,8 @@ type (
 		Prometheus *PrometheusConfig `yaml:"prometheus"`
 		// {optional} Config for Prometheus metrics reporter for SDK reported metrics.
 		PrometheusSDK *PrometheusConfig `yaml:"prometheusSDK"`
+		// Opentelemetry is the configuration for exporting metrics to an OpenTelemetry collector
+		Opentelemetry *OpentelemetryConfig `yaml:"opentelemetry"`
 		// Tags is the set of key-value pairs to be reported as part of every metric
 		Tags map[string]string `yaml:"tags"`
 		// Prefix sets the prefix to all outgoing metrics
@@ -71,6 +73,26 @@ type (
 		FlushBytes int `yaml:"flushBytes"`
 	}
 
+	// OpentelemetryConfig contains the config items for exporting metrics to an
+	// OpenTelemetry collector over OTLP/HTTP.
+	OpentelemetryConfig struct {
+		// Endpoint is the host and port of the OTLP/HTTP receiver of the collector.
+		Endpoint string `yaml:"endpoint" validate:"nonzero"`
+		// URLPath is the path metrics are posted to. Defaults to "/v1/metrics".
+		URLPath string `yaml:"urlPath"`
+		// Insecure disables TLS for the connection to the collector.
+		Insecure bool `yaml:"insecure"`
+		// Headers are sent with every export request, e.g. for authentication.
+		Headers map[string]string `yaml:"headers"`
+		// CollectPeriod is the interval metrics are exported at. Defaults to 10 seconds.
+		CollectPeriod time.Duration `yaml:"collectPeriod"`
+		// Timeout is the timeout of a single export. Defaults to 10 seconds.
+		Timeout time.Duration `yaml:"timeout"`
+		// DefaultHistogramBoundaries defines the default histogram bucket
+		// boundaries.
+		DefaultHistogramBoundaries []float64 `yaml:"defaultHistogramBoundaries"`
+	}
+
 	// PrometheusConfig is a new format for config for prometheus metrics.
 	PrometheusConfig struct {
 		// Metric framework: Tally/OpenTelemetry
@@ -83,6 +105,13 @@ type (
 		// HandlerPath if specified will be used instead of using the default
 		// HTTP handler path "/metrics".
 		HandlerPath string `yaml:"handlerPath"`
+		// HistogramBuckets overrides the bucket boundaries of individual histogram
+		// metrics, keyed by metric name. Boundaries are expressed in the unit of the
+		// metric, i.e. milliseconds for latencies and bytes for sizes.
+		HistogramBuckets map[string][]float64 `yaml:"histogramBuckets"`
+		// DefaultHistogramBucketsByUnit overrides the default bucket boundaries of
+		// histogram metrics with the given unit ("1", "ms" or "By").
+		DefaultHistogramBucketsByUnit map[MetricUnit][]float64 `yaml:"defaultHistogramBucketsByUnit"`
 
 		// Configs below are kept for backwards compatibility with previously exposed tally prometheus.Configuration.
 
@@ -194,19 +223,22 @@ var (
 // returns SeverReporter, SDKReporter, error
 func (c *Config) InitMetricReporters(logger log.Logger, customReporter interface{}) (Reporter, Reporter, error) {
 	if customReporter == nil {
+		if c.Opentelemetry != nil {
+			return c.initReportersFromOpentelemetryConfig(logger)
+		}
 		if c.Prometheus != nil && len(c.Prometheus.Framework) > 0 {
 			return c.initReportersFromPrometheusConfig(logger, customReporter)
 		}
 
 		scope := c.NewScope(logger)
-		reporter := newTallyReporter(scope)
+		reporter := newTallyReporter(scope, c.histogramBuckets())
 		return reporter, reporter, nil
 	}
 
 	switch cReporter := customReporter.(type) {
 	case tally.BaseStatsReporter:
 		scope := c.NewCustomReporterScope(logger, cReporter)
-		reporter := newTallyReporter(scope)
+		reporter := newTallyReporter(scope, c.histogramBuckets())
 		return reporter, reporter, nil
 	case Reporter:
 		return cReporter, cReporter, nil
@@ -231,6 +263,24 @@ func (c *Config) initReportersFromPrometheusConfig(logger log.Logger, customRepo
 	return serverReporter, sdkReporter, nil
 }
 
+// initReportersFromOpentelemetryConfig exports server metrics to an OpenTelemetry collector.
+// SDK only supports Tally, so SDK metrics are reported via prometheusSDK if it is configured
+// and dropped otherwise.
+func (c *Config) initReportersFromOpentelemetryConfig(logger log.Logger) (Reporter, Reporter, error) {
+	serverReporter, err := newOpentelemetryCollectorReporter(logger, c.Tags, c.Prefix, c.Opentelemetry)
+	if err != nil {
+		return nil, nil, err
+	}
+	var sdkReporter Reporter = newTallyReporter(tally.NoopScope, nil)
+	if c.PrometheusSDK != nil {
+		sdkReporter, err = c.initReporterFromPrometheusConfig(logger, c.PrometheusSDK)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return serverReporter, sdkReporter, nil
+}
+
 func (c *Config) initReporterFromPrometheusConfig(logger log.Logger, config *PrometheusConfig) (Reporter, error) {
 	switch config.Framework {
 	case FrameworkTally:
@@ -247,7 +297,16 @@ func (c *Config) initReporterFromPrometheusConfig(logger log.Logger, config *Pro
 func (c *Config) newTallyReporterByPrometheusConfig(logger log.Logger, config *PrometheusConfig) Reporter {
 	tallyConfig := c.convertPrometheusConfigToTally(config)
 	tallyScope := c.newPrometheusScope(logger, tallyConfig)
-	return newTallyReporter(tallyScope)
+	return newTallyReporter(tallyScope, newHistogramBuckets(config))
+}
+
+// histogramBuckets returns the histogram bucket overrides configured for the
+// prometheus reporter, if any.
+func (c *Config) histogramBuckets() *histogramBuckets {
+	if c == nil {
+		return nil
+	}
+	return newHistogramBuckets(c.Prometheus)
 }
 
 // NewScope builds a new tally scope for this metrics configuration
@@ -26,6 +26,7 @@ package metrics
 
 import (
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -166,3 +167,30 @@ func (s *MetricsSuite) TestOTCustomReporter() {
 	s.Equal(mockReporter, sdkReporter)
 	s.Nil(err)
 }
+
+func (s *MetricsSuite) TestOpentelemetryCollector() {
+	config := &Config{
+		Opentelemetry: &OpentelemetryConfig{
+			Endpoint: "127.0.0.1:4318",
+			Insecure: true,
+		},
+	}
+	reporter, sdkReporter, err := config.InitMetricReporters(log.NewNoopLogger(), nil)
+	s.NoError(err)
+	s.IsType(&OpentelemetryReporter{}, reporter)
+	s.IsType(&TallyReporter{}, sdkReporter)
+
+	client, err := reporter.NewClient(log.NewNoopLogger(), Frontend)
+	s.NoError(err)
+	client.IncCounter(FrontendStartWorkflowExecutionScope, ServiceRequests)
+	client.RecordHistogramDuration(FrontendStartWorkflowExecutionScope, ServiceLatencyHistogram, time.Second)
+	reporter.Stop(log.NewNoopLogger())
+}
+
+func (s *MetricsSuite) TestOpentelemetryCollector_MissingEndpoint() {
+	config := &Config{
+		Opentelemetry: &OpentelemetryConfig{},
+	}
+	_, _, err := config.InitMetricReporters(log.NewNoopLogger(), nil)
+	s.Error(err)
+}
@@ -36,12 +36,16 @@ type (
 	// MetricType is the type of the metric
 	MetricType int
 
+	// MetricUnit is the unit of the values recorded by a histogram metric
+	MetricUnit string
+
 	// metricDefinition contains the definition for a metric
 	metricDefinition struct {
 		// nolint
 		metricType       MetricType    // metric type
 		metricName       MetricName    // metric name
 		metricRollupName MetricName    // optional. if non-empty, this name must be used for rolled-up version of this metric
+		unit             MetricUnit    // optional. unit of the recorded values, used to pick default histogram buckets
 		buckets          tally.Buckets // buckets if we are emitting histograms
 	}
 
@@ -60,6 +64,14 @@ const (
 	Counter MetricType = iota
 	Timer
 	Gauge
+	Histogram
+)
+
+// MetricUnits which are supported by histograms
+const (
+	Dimensionless MetricUnit = "1"
+	Milliseconds  MetricUnit = "ms"
+	Bytes         MetricUnit = "By"
 )
 
 // Service names for all services that emit metrics.
@@ -84,6 +96,14 @@ const (
 	StatsTypeTagName   = "stats_type"
 	CacheTypeTagName   = "cache_type"
 	FailureTagName     = "failure"
+	TokenIssuerTagName = "token_issuer"
+	SinkTagName        = "sink"
+	SLOWindowTagName   = "slo_window"
+
+	SearchAttributeTagName      = "search_attribute"
+	SearchAttributeValueTagName = "search_attribute_value"
+	DynamicConfigKeyTagName     = "dynamic_config_key"
+	IsolationGroupTagName       = "isolation_group"
 )
 
 // This package should hold all the metrics and tags for temporal
@@ -266,6 +286,10 @@ const (
 	PersistenceListClosedWorkflowExecutionsByWorkflowIDScope
 	// PersistenceListClosedWorkflowExecutionsByStatusScope tracks ListClosedWorkflowExecutionsByStatus calls made by service to persistence layer
 	PersistenceListClosedWorkflowExecutionsByStatusScope
+	// PersistenceListClosedWorkflowExecutionsByTypeAndStatusScope tracks ListClosedWorkflowExecutionsByTypeAndStatus calls made by service to persistence layer
+	PersistenceListClosedWorkflowExecutionsByTypeAndStatusScope
+	// PersistenceCountClosedWorkflowExecutionsByTypeAndStatusScope tracks CountClosedWorkflowExecutionsByTypeAndStatus calls made by service to persistence layer
+	PersistenceCountClosedWorkflowExecutionsByTypeAndStatusScope
 	// PersistenceVisibilityDeleteWorkflowExecutionScope is the metrics scope for persistence.VisibilityManager.DeleteWorkflowExecution
 	PersistenceVisibilityDeleteWorkflowExecutionScope
 	// PersistenceListWorkflowExecutionsScope tracks ListWorkflowExecutions calls made by service to persistence layer
@@ -338,6 +362,8 @@ const (
 	HistoryClientSignalWorkflowExecutionScope
 	// HistoryClientSignalWithStartWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientSignalWithStartWorkflowExecutionScope
+	// HistoryClientExecuteMultiOperationScope tracks RPC calls to history service
+	HistoryClientExecuteMultiOperationScope
 	// HistoryClientRemoveSignalMutableStateScope tracks RPC calls to history service
 	HistoryClientRemoveSignalMutableStateScope
 	// HistoryClientTerminateWorkflowExecutionScope tracks RPC calls to history service
@@ -371,6 +397,26 @@ const (
 	// HistoryClientRefreshWorkflowTasksScope tracks RPC calls to history service
 	HistoryClientRefreshWorkflowTasksScope
 	// MatchingClientPollWorkflowTaskQueueScope tracks RPC calls to matching service
+	// HistoryClientGetReplicationStatusScope tracks RPC calls to history service
+	HistoryClientGetReplicationStatusScope
+	// HistoryClientStreamReplicationMessagesScope tracks RPC calls to history service
+	HistoryClientStreamReplicationMessagesScope
+	// HistoryClientRecordWorkflowTaskHeartbeatScope tracks RPC calls to history service
+	HistoryClientRecordWorkflowTaskHeartbeatScope
+	// HistoryClientGenerateLastHistoryReplicationTasksScope tracks RPC calls to history service
+	HistoryClientGenerateLastHistoryReplicationTasksScope
+	// HistoryClientCompactWorkflowHistoryScope tracks RPC calls to history service
+	HistoryClientCompactWorkflowHistoryScope
+	// HistoryClientListThrottledCallersScope tracks RPC calls to history service
+	HistoryClientListThrottledCallersScope
+	// HistoryClientGetShardStatsScope tracks RPC calls to history service
+	HistoryClientGetShardStatsScope
+	// HistoryClientGetHostProfileScope tracks RPC calls to history service
+	HistoryClientGetHostProfileScope
+	// HistoryClientDescribeVisibilityProcessorScope tracks RPC calls to history service
+	HistoryClientDescribeVisibilityProcessorScope
+	// HistoryClientDeleteWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientDeleteWorkflowExecutionScope
 	MatchingClientPollWorkflowTaskQueueScope
 	// MatchingClientPollActivityTaskQueueScope tracks RPC calls to matching service
 	MatchingClientPollActivityTaskQueueScope
@@ -388,6 +434,20 @@ const (
 	MatchingClientDescribeTaskQueueScope
 	// MatchingClientListTaskQueuePartitionsScope tracks RPC calls to matching service
 	MatchingClientListTaskQueuePartitionsScope
+	// MatchingClientDescribeTaskQueuePartitionsScope tracks RPC calls to matching service
+	MatchingClientDescribeTaskQueuePartitionsScope
+	// MatchingClientGetTaskQueueUserDataScope tracks RPC calls to matching service
+	MatchingClientGetTaskQueueUserDataScope
+	// MatchingClientUpdateTaskQueueUserDataScope tracks RPC calls to matching service
+	MatchingClientUpdateTaskQueueUserDataScope
+	// MatchingClientUpdateWorkerBuildIdCompatibilityScope tracks RPC calls to matching service
+	MatchingClientUpdateWorkerBuildIdCompatibilityScope
+	// MatchingClientGetWorkerBuildIdCompatibilityScope tracks RPC calls to matching service
+	MatchingClientGetWorkerBuildIdCompatibilityScope
+	// MatchingClientEvictStickyTaskQueueScope tracks RPC calls to matching service
+	MatchingClientEvictStickyTaskQueueScope
+	// MatchingClientGetHostProfileScope tracks RPC calls to matching service
+	MatchingClientGetHostProfileScope
 	// FrontendClientDeprecateNamespaceScope tracks RPC calls to frontend service
 	FrontendClientDeprecateNamespaceScope
 	// FrontendClientDescribeNamespaceScope tracks RPC calls to frontend service
@@ -492,6 +552,8 @@ const (
 	AdminClientGetWorkflowExecutionRawHistoryScope
 	// AdminClientGetWorkflowExecutionRawHistoryV2Scope tracks RPC calls to admin service
 	AdminClientGetWorkflowExecutionRawHistoryV2Scope
+	// AdminClientGetWorkflowExecutionHistoryReverseScope tracks RPC calls to admin service
+	AdminClientGetWorkflowExecutionHistoryReverseScope
 	// AdminClientDescribeClusterScope tracks RPC calls to admin service
 	AdminClientDescribeClusterScope
 	// AdminClientGetDLQMessagesScope tracks RPC calls to admin service
@@ -504,7 +566,81 @@ const (
 	AdminClientRefreshWorkflowTasksScope
 	// AdminClientResendReplicationTasksScope tracks RPC calls to admin service
 	AdminClientResendReplicationTasksScope
+	// AdminClientExecuteMultiOperationScope tracks RPC calls to admin service
+	AdminClientExecuteMultiOperationScope
+	// AdminClientListNamespaceFailoverHistoryScope tracks RPC calls to admin service
+	AdminClientListNamespaceFailoverHistoryScope
 	// DCRedirectionDeprecateNamespaceScope tracks RPC calls for dc redirection
+	// AdminClientHandoverNamespaceScope tracks RPC calls to admin service
+	AdminClientHandoverNamespaceScope
+	// AdminClientStreamReplicationMessagesScope tracks RPC calls to admin service
+	AdminClientStreamReplicationMessagesScope
+	// AdminClientStreamWorkflowExecutionHistoryScope tracks RPC calls to admin service
+	AdminClientStreamWorkflowExecutionHistoryScope
+	// AdminClientRecordWorkflowTaskHeartbeatScope tracks RPC calls to admin service
+	AdminClientRecordWorkflowTaskHeartbeatScope
+	// AdminClientGetReplicationLagScope tracks RPC calls to admin service
+	AdminClientGetReplicationLagScope
+	// AdminClientCompactWorkflowHistoryScope tracks RPC calls to admin service
+	AdminClientCompactWorkflowHistoryScope
+	// AdminClientListStaleWorkflowExecutionsScope tracks RPC calls to admin service
+	AdminClientListStaleWorkflowExecutionsScope
+	// AdminClientGetDynamicConfigScope tracks RPC calls to admin service
+	AdminClientGetDynamicConfigScope
+	// AdminClientSetDynamicConfigScope tracks RPC calls to admin service
+	AdminClientSetDynamicConfigScope
+	// AdminClientListDynamicConfigScope tracks RPC calls to admin service
+	AdminClientListDynamicConfigScope
+	// AdminClientListThrottledCallersScope tracks RPC calls to admin service
+	AdminClientListThrottledCallersScope
+	// AdminClientDescribeTaskQueuePartitionsScope tracks RPC calls to admin service
+	AdminClientDescribeTaskQueuePartitionsScope
+	// AdminClientSetMaintenanceInfoScope tracks RPC calls to admin service
+	AdminClientSetMaintenanceInfoScope
+	// AdminClientUpdateWorkerBuildIdCompatibilityScope tracks RPC calls to admin service
+	AdminClientUpdateWorkerBuildIdCompatibilityScope
+	// AdminClientGetWorkerBuildIdCompatibilityScope tracks RPC calls to admin service
+	AdminClientGetWorkerBuildIdCompatibilityScope
+	// AdminClientShutdownWorkerScope tracks RPC calls to admin service
+	AdminClientShutdownWorkerScope
+	// AdminClientImportWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientImportWorkflowExecutionScope
+	// AdminClientCreateScheduleScope tracks RPC calls to admin service
+	AdminClientCreateScheduleScope
+	// AdminClientDescribeScheduleScope tracks RPC calls to admin service
+	AdminClientDescribeScheduleScope
+	// AdminClientUpdateScheduleScope tracks RPC calls to admin service
+	AdminClientUpdateScheduleScope
+	// AdminClientPatchScheduleScope tracks RPC calls to admin service
+	AdminClientPatchScheduleScope
+	// AdminClientDeleteScheduleScope tracks RPC calls to admin service
+	AdminClientDeleteScheduleScope
+	// AdminClientListSchedulesScope tracks RPC calls to admin service
+	AdminClientListSchedulesScope
+	// AdminClientStartResetBadBinaryJobScope tracks RPC calls to admin service
+	AdminClientStartResetBadBinaryJobScope
+	// AdminClientGetResetPointsScope tracks RPC calls to admin service
+	AdminClientGetResetPointsScope
+	// AdminClientRebalanceShardsScope tracks RPC calls to admin service
+	AdminClientRebalanceShardsScope
+	// AdminClientGetShardStatsScope tracks RPC calls to admin service
+	AdminClientGetShardStatsScope
+	// AdminClientGetHostProfileScope tracks RPC calls to admin service
+	AdminClientGetHostProfileScope
+	// AdminClientDeepHealthCheckScope tracks RPC calls to admin service
+	AdminClientDeepHealthCheckScope
+	// AdminClientDescribeClusterMetadataScope tracks RPC calls to admin service
+	AdminClientDescribeClusterMetadataScope
+	// AdminClientUpdateClusterMetadataScope tracks RPC calls to admin service
+	AdminClientUpdateClusterMetadataScope
+	// AdminClientDescribeVisibilityProcessorScope tracks RPC calls to admin service
+	AdminClientDescribeVisibilityProcessorScope
+	// AdminClientDeleteNamespaceScope tracks RPC calls to admin service
+	AdminClientDeleteNamespaceScope
+	// AdminClientDescribeNamespaceQuotasScope tracks RPC calls to admin service
+	AdminClientDescribeNamespaceQuotasScope
+	// AdminClientUpdateNamespaceQuotasScope tracks RPC calls to admin service
+	AdminClientUpdateNamespaceQuotasScope
 	DCRedirectionDeprecateNamespaceScope
 	// DCRedirectionDescribeNamespaceScope tracks RPC calls for dc redirection
 	DCRedirectionDescribeNamespaceScope
@@ -613,6 +749,8 @@ const (
 	PersistenceDeleteHistoryBranchScope
 	// PersistenceTrimHistoryBranchScope tracks TrimHistoryBranch calls made by service to persistence layer
 	PersistenceTrimHistoryBranchScope
+	// PersistenceCompactHistoryBranchScope tracks CompactHistoryBranch calls made by service to persistence layer
+	PersistenceCompactHistoryBranchScope
 	// PersistenceCompleteForkBranchScope tracks CompleteForkBranch calls made by service to persistence layer
 	PersistenceCompleteForkBranchScope
 	// PersistenceGetHistoryTreeScope tracks GetHistoryTree calls made by service to persistence layer
@@ -645,6 +783,10 @@ const (
 	ElasticsearchListClosedWorkflowExecutionsByWorkflowIDScope
 	// ElasticsearchListClosedWorkflowExecutionsByStatusScope tracks ListClosedWorkflowExecutionsByStatus calls made by service to persistence layer
 	ElasticsearchListClosedWorkflowExecutionsByStatusScope
+	// ElasticsearchListClosedWorkflowExecutionsByTypeAndStatusScope tracks ListClosedWorkflowExecutionsByTypeAndStatus calls made by service to persistence layer
+	ElasticsearchListClosedWorkflowExecutionsByTypeAndStatusScope
+	// ElasticsearchCountClosedWorkflowExecutionsByTypeAndStatusScope tracks CountClosedWorkflowExecutionsByTypeAndStatus calls made by service to persistence layer
+	ElasticsearchCountClosedWorkflowExecutionsByTypeAndStatusScope
 	// ElasticsearchListWorkflowExecutionsScope tracks ListWorkflowExecutions calls made by service to persistence layer
 	ElasticsearchListWorkflowExecutionsScope
 	// ElasticsearchScanWorkflowExecutionsScope tracks ScanWorkflowExecutions calls made by service to persistence layer
@@ -657,9 +799,15 @@ const (
 	// ElasticsearchBulkProcessor is scope used by all metric emitted by Elasticsearch bulk processor
 	ElasticsearchBulkProcessor
 
+	// VisibilityExporterScope is scope used by all metrics emitted by visibility exporter
+	VisibilityExporterScope
+
 	// ElasticsearchVisibility is scope used by all Elasticsearch visibility metrics
 	ElasticsearchVisibility
 
+	// VisibilitySinkScope is scope used by all metrics emitted by visibility sink chain
+	VisibilitySinkScope
+
 	// SequentialTaskProcessingScope is used by sequential task processing logic
 	SequentialTaskProcessingScope
 	// ParallelTaskProcessingScope is used by parallel task processing logic
@@ -688,6 +836,9 @@ const (
 	// BlobstoreClientDirectoryExistsScope tracks DirectoryExists calls to blobstore
 	BlobstoreClientDirectoryExistsScope
 
+	// DynamicConfigScope is the scope used by dynamic config
+	DynamicConfigScope
+
 	NumCommonScopes
 )
 
@@ -707,6 +858,8 @@ const (
 	AdminGetWorkflowExecutionRawHistoryScope
 	// AdminGetWorkflowExecutionRawHistoryV2Scope is the metric scope for admin.GetWorkflowExecutionRawHistoryScope
 	AdminGetWorkflowExecutionRawHistoryV2Scope
+	// AdminGetWorkflowExecutionHistoryReverseScope is the metric scope for admin.GetWorkflowExecutionHistoryReverse
+	AdminGetWorkflowExecutionHistoryReverseScope
 	// AdminGetReplicationMessagesScope is the metric scope for admin.GetReplicationMessages
 	AdminGetReplicationMessagesScope
 	// AdminGetNamespaceReplicationMessagesScope is the metric scope for admin.GetNamespaceReplicationMessages
@@ -719,7 +872,81 @@ const (
 	AdminRefreshWorkflowTasksScope
 	// AdminResendReplicationTasksScope is the metric scope for admin.ResendReplicationTasks
 	AdminResendReplicationTasksScope
+	// AdminExecuteMultiOperationScope is the metric scope for admin.ExecuteMultiOperation
+	AdminExecuteMultiOperationScope
+	// AdminListNamespaceFailoverHistoryScope is the metric scope for admin.ListNamespaceFailoverHistory
+	AdminListNamespaceFailoverHistoryScope
 	// AdminRemoveTaskScope is the metric scope for admin.AdminRemoveTaskScope
+	// AdminHandoverNamespaceScope is the metric scope for admin.HandoverNamespace
+	AdminHandoverNamespaceScope
+	// AdminStreamReplicationMessagesScope is the metric scope for admin.StreamReplicationMessages
+	AdminStreamReplicationMessagesScope
+	// AdminStreamWorkflowExecutionHistoryScope is the metric scope for admin.StreamWorkflowExecutionHistory
+	AdminStreamWorkflowExecutionHistoryScope
+	// AdminRecordWorkflowTaskHeartbeatScope is the metric scope for admin.RecordWorkflowTaskHeartbeat
+	AdminRecordWorkflowTaskHeartbeatScope
+	// AdminGetReplicationLagScope is the metric scope for admin.GetReplicationLag
+	AdminGetReplicationLagScope
+	// AdminCompactWorkflowHistoryScope is the metric scope for admin.CompactWorkflowHistory
+	AdminCompactWorkflowHistoryScope
+	// AdminListStaleWorkflowExecutionsScope is the metric scope for admin.ListStaleWorkflowExecutions
+	AdminListStaleWorkflowExecutionsScope
+	// AdminGetDynamicConfigScope is the metric scope for admin.GetDynamicConfig
+	AdminGetDynamicConfigScope
+	// AdminSetDynamicConfigScope is the metric scope for admin.SetDynamicConfig
+	AdminSetDynamicConfigScope
+	// AdminListDynamicConfigScope is the metric scope for admin.ListDynamicConfig
+	AdminListDynamicConfigScope
+	// AdminListThrottledCallersScope is the metric scope for admin.ListThrottledCallers
+	AdminListThrottledCallersScope
+	// AdminDescribeTaskQueuePartitionsScope is the metric scope for admin.DescribeTaskQueuePartitions
+	AdminDescribeTaskQueuePartitionsScope
+	// AdminSetMaintenanceInfoScope is the metric scope for admin.SetMaintenanceInfo
+	AdminSetMaintenanceInfoScope
+	// AdminUpdateWorkerBuildIdCompatibilityScope is the metric scope for admin.UpdateWorkerBuildIdCompatibility
+	AdminUpdateWorkerBuildIdCompatibilityScope
+	// AdminGetWorkerBuildIdCompatibilityScope is the metric scope for admin.GetWorkerBuildIdCompatibility
+	AdminGetWorkerBuildIdCompatibilityScope
+	// AdminShutdownWorkerScope is the metric scope for admin.ShutdownWorker
+	AdminShutdownWorkerScope
+	// AdminImportWorkflowExecutionScope is the metric scope for admin.ImportWorkflowExecution
+	AdminImportWorkflowExecutionScope
+	// AdminCreateScheduleScope is the metric scope for admin.CreateSchedule
+	AdminCreateScheduleScope
+	// AdminDescribeScheduleScope is the metric scope for admin.DescribeSchedule
+	AdminDescribeScheduleScope
+	// AdminUpdateScheduleScope is the metric scope for admin.UpdateSchedule
+	AdminUpdateScheduleScope
+	// AdminPatchScheduleScope is the metric scope for admin.PatchSchedule
+	AdminPatchScheduleScope
+	// AdminDeleteScheduleScope is the metric scope for admin.DeleteSchedule
+	AdminDeleteScheduleScope
+	// AdminListSchedulesScope is the metric scope for admin.ListSchedules
+	AdminListSchedulesScope
+	// AdminStartResetBadBinaryJobScope is the metric scope for admin.StartResetBadBinaryJob
+	AdminStartResetBadBinaryJobScope
+	// AdminGetResetPointsScope is the metric scope for admin.GetResetPoints
+	AdminGetResetPointsScope
+	// AdminRebalanceShardsScope is the metric scope for admin.RebalanceShards
+	AdminRebalanceShardsScope
+	// AdminGetShardStatsScope is the metric scope for admin.GetShardStats
+	AdminGetShardStatsScope
+	// AdminGetHostProfileScope is the metric scope for admin.GetHostProfile
+	AdminGetHostProfileScope
+	// AdminDeepHealthCheckScope is the metric scope for admin.DeepHealthCheck
+	AdminDeepHealthCheckScope
+	// AdminDescribeClusterMetadataScope is the metric scope for admin.DescribeClusterMetadata
+	AdminDescribeClusterMetadataScope
+	// AdminUpdateClusterMetadataScope is the metric scope for admin.UpdateClusterMetadata
+	AdminUpdateClusterMetadataScope
+	// AdminDescribeVisibilityProcessorScope is the metric scope for admin.DescribeVisibilityProcessor
+	AdminDescribeVisibilityProcessorScope
+	// AdminDeleteNamespaceScope is the metric scope for admin.DeleteNamespace
+	AdminDeleteNamespaceScope
+	// AdminDescribeNamespaceQuotasScope is the metric scope for admin.DescribeNamespaceQuotas
+	AdminDescribeNamespaceQuotasScope
+	// AdminUpdateNamespaceQuotasScope is the metric scope for admin.UpdateNamespaceQuotas
+	AdminUpdateNamespaceQuotasScope
 	AdminRemoveTaskScope
 	// AdminCloseShardTaskScope is the metric scope for admin.AdminRemoveTaskScope
 	AdminCloseShardTaskScope
@@ -822,6 +1049,10 @@ const (
 	VersionCheckScope
 	// AuthorizationScope is the scope used by all metric emitted by authorization code
 	AuthorizationScope
+	// AuthorizationTokenKeyProviderScope is the scope used by metrics emitted while refreshing token signing keys
+	AuthorizationTokenKeyProviderScope
+	// AuthorizationAuditLogScope is the scope used by metrics emitted by authorization audit logger
+	AuthorizationAuditLogScope
 
 	NumFrontendScopes
 )
@@ -858,6 +1089,8 @@ const (
 	HistorySignalWorkflowExecutionScope
 	// HistorySignalWithStartWorkflowExecutionScope tracks SignalWithStartWorkflowExecution API calls received by service
 	HistorySignalWithStartWorkflowExecutionScope
+	// HistoryExecuteMultiOperationScope tracks ExecuteMultiOperation API calls received by service
+	HistoryExecuteMultiOperationScope
 	// HistoryRemoveSignalMutableStateScope tracks RemoveSignalMutableState API calls received by service
 	HistoryRemoveSignalMutableStateScope
 	// HistoryTerminateWorkflowExecutionScope tracks TerminateWorkflowExecution API calls received by service
@@ -891,6 +1124,26 @@ const (
 	// HistoryRefreshWorkflowTasksScope is the scope used by refresh workflow tasks API
 	HistoryRefreshWorkflowTasksScope
 	// HistoryHistoryRemoveTaskScope is the scope used by remove task API
+	// HistoryGetReplicationStatusScope is the scope used by get replication status API
+	HistoryGetReplicationStatusScope
+	// HistoryStreamReplicationMessagesScope is the scope used by stream replication messages API
+	HistoryStreamReplicationMessagesScope
+	// HistoryRecordWorkflowTaskHeartbeatScope tracks RecordWorkflowTaskHeartbeat API calls received by service
+	HistoryRecordWorkflowTaskHeartbeatScope
+	// HistoryGenerateLastHistoryReplicationTasksScope is the scope used by generate last history replication tasks API
+	HistoryGenerateLastHistoryReplicationTasksScope
+	// HistoryCompactWorkflowHistoryScope is the scope used by compact workflow history API
+	HistoryCompactWorkflowHistoryScope
+	// HistoryListThrottledCallersScope is the scope used by list throttled callers API
+	HistoryListThrottledCallersScope
+	// HistoryGetShardStatsScope is the scope used by get shard stats API
+	HistoryGetShardStatsScope
+	// HistoryGetHostProfileScope is the scope used by get host profile API
+	HistoryGetHostProfileScope
+	// HistoryDescribeVisibilityProcessorScope is the scope used by describe visibility processor API
+	HistoryDescribeVisibilityProcessorScope
+	// HistoryDeleteWorkflowExecutionScope is the scope used by delete workflow execution API
+	HistoryDeleteWorkflowExecutionScope
 	HistoryHistoryRemoveTaskScope
 	// HistoryCloseShard is the scope used by close shard API
 	HistoryCloseShard
@@ -1026,6 +1279,8 @@ const (
 	HistoryProcessDeleteHistoryEventScope
 	// WorkflowCompletionStatsScope tracks workflow completion updates
 	WorkflowCompletionStatsScope
+	// WorkflowCloseStatsScope is the scope used for emitting stats of closed workflows
+	WorkflowCloseStatsScope
 	// ArchiverClientScope is scope used by all metrics emitted by archiver.Client
 	ArchiverClientScope
 	// ReplicationTaskFetcherScope is scope used by all metrics emitted by ReplicationTaskFetcher
@@ -1060,6 +1315,20 @@ const (
 	MatchingDescribeTaskQueueScope
 	// MatchingListTaskQueuePartitionsScope tracks ListTaskQueuePartitions API calls received by service
 	MatchingListTaskQueuePartitionsScope
+	// MatchingDescribeTaskQueuePartitionsScope tracks DescribeTaskQueuePartitions API calls received by service
+	MatchingDescribeTaskQueuePartitionsScope
+	// MatchingGetTaskQueueUserDataScope tracks GetTaskQueueUserData API calls received by service
+	MatchingGetTaskQueueUserDataScope
+	// MatchingUpdateTaskQueueUserDataScope tracks UpdateTaskQueueUserData API calls received by service
+	MatchingUpdateTaskQueueUserDataScope
+	// MatchingUpdateWorkerBuildIdCompatibilityScope tracks UpdateWorkerBuildIdCompatibility API calls received by service
+	MatchingUpdateWorkerBuildIdCompatibilityScope
+	// MatchingGetWorkerBuildIdCompatibilityScope tracks GetWorkerBuildIdCompatibility API calls received by service
+	MatchingGetWorkerBuildIdCompatibilityScope
+	// MatchingEvictStickyTaskQueueScope tracks EvictStickyTaskQueue API calls received by service
+	MatchingEvictStickyTaskQueueScope
+	// MatchingGetHostProfileScope tracks GetHostProfile API calls received by service
+	MatchingGetHostProfileScope
 
 	NumMatchingScopes
 )
@@ -1086,6 +1355,8 @@ const (
 	ArchiverDeleteHistoryActivityScope
 	// ArchiverUploadHistoryActivityScope is scope used by all metrics emitted by archiver.UploadHistoryActivity
 	ArchiverUploadHistoryActivityScope
+	// ArchiverVerifyHistoryActivityScope is scope used by all metrics emitted by archiver.VerifyHistoryActivity
+	ArchiverVerifyHistoryActivityScope
 	// ArchiverArchiveVisibilityActivityScope is scope used by all metrics emitted by archiver.ArchiveVisibilityActivity
 	ArchiverArchiveVisibilityActivityScope
 	// ArchiverScope is scope used by all metrics emitted by archiver.Archiver
@@ -1104,8 +1375,18 @@ const (
 	HistoryScavengerScope
 	// ParentClosePolicyProcessorScope is scope used by all metrics emitted by worker.ParentClosePolicyProcessor
 	ParentClosePolicyProcessorScope
+	// CallbackProcessorScope is scope used by all metrics emitted by worker.CallbackProcessor
+	CallbackProcessorScope
 	// AddSearchAttributesWorkflowScope is scope used by all metrics emitted by worker.AddSearchAttributesWorkflowScope module
 	AddSearchAttributesWorkflowScope
+	// SearchAttributeMetricsScope is scope used by all metrics emitted by worker.SearchAttributeMetrics module
+	SearchAttributeMetricsScope
+	// ForceReplicationScope is scope used by all metrics emitted by worker.ForceReplication module
+	ForceReplicationScope
+	// SchedulerScope is scope used by all metrics emitted by worker.Scheduler module
+	SchedulerScope
+	// DeleteNamespaceScope is scope used by all metrics emitted by worker.DeleteNamespace module
+	DeleteNamespaceScope
 
 	NumWorkerScopes
 )
@@ -1114,99 +1395,102 @@ const (
 var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 	// common scope Names
 	Common: {
-		UnknownScope:                                             {operation: "Unknown"},
-		PersistenceCreateShardScope:                              {operation: "CreateShard"},
-		PersistenceGetShardScope:                                 {operation: "GetShard"},
-		PersistenceUpdateShardScope:                              {operation: "UpdateShard"},
-		PersistenceCreateWorkflowExecutionScope:                  {operation: "CreateWorkflowExecution"},
-		PersistenceGetWorkflowExecutionScope:                     {operation: "GetWorkflowExecution"},
-		PersistenceUpdateWorkflowExecutionScope:                  {operation: "UpdateWorkflowExecution"},
-		PersistenceConflictResolveWorkflowExecutionScope:         {operation: "ConflictResolveWorkflowExecution"},
-		PersistenceResetWorkflowExecutionScope:                   {operation: "ResetWorkflowExecution"},
-		PersistenceDeleteWorkflowExecutionScope:                  {operation: "DeleteWorkflowExecution"},
-		PersistenceDeleteCurrentWorkflowExecutionScope:           {operation: "DeleteCurrentWorkflowExecution"},
-		PersistenceGetCurrentExecutionScope:                      {operation: "GetCurrentExecution"},
-		PersistenceListConcreteExecutionsScope:                   {operation: "ListConcreteExecutions"},
-		PersistenceAddTasksScope:                                 {operation: "AddTasks"},
-		PersistenceGetTransferTaskScope:                          {operation: "GetTransferTask"},
-		PersistenceGetTransferTasksScope:                         {operation: "GetTransferTasks"},
-		PersistenceCompleteTransferTaskScope:                     {operation: "CompleteTransferTask"},
-		PersistenceRangeCompleteTransferTaskScope:                {operation: "RangeCompleteTransferTask"},
-		PersistenceGetVisibilityTaskScope:                        {operation: "GetVisibilityTask"},
-		PersistenceGetVisibilityTasksScope:                       {operation: "GetVisibilityTasks"},
-		PersistenceCompleteVisibilityTaskScope:                   {operation: "CompleteVisibilityTask"},
-		PersistenceRangeCompleteVisibilityTaskScope:              {operation: "RangeCompleteVisibilityTask"},
-		PersistenceGetReplicationTaskScope:                       {operation: "GetReplicationTask"},
-		PersistenceGetReplicationTasksScope:                      {operation: "GetReplicationTasks"},
-		PersistenceCompleteReplicationTaskScope:                  {operation: "CompleteReplicationTask"},
-		PersistenceRangeCompleteReplicationTaskScope:             {operation: "RangeCompleteReplicationTask"},
-		PersistencePutReplicationTaskToDLQScope:                  {operation: "PutReplicationTaskToDLQ"},
-		PersistenceGetReplicationTasksFromDLQScope:               {operation: "GetReplicationTasksFromDLQ"},
-		PersistenceDeleteReplicationTaskFromDLQScope:             {operation: "DeleteReplicationTaskFromDLQ"},
-		PersistenceRangeDeleteReplicationTaskFromDLQScope:        {operation: "RangeDeleteReplicationTaskFromDLQ"},
-		PersistenceGetTimerTaskScope:                             {operation: "GetTimerTask"},
-		PersistenceGetTimerIndexTasksScope:                       {operation: "GetTimerIndexTasks"},
-		PersistenceCompleteTimerTaskScope:                        {operation: "CompleteTimerTask"},
-		PersistenceRangeCompleteTimerTaskScope:                   {operation: "RangeCompleteTimerTask"},
-		PersistenceCreateTaskScope:                               {operation: "CreateTask"},
-		PersistenceGetTasksScope:                                 {operation: "GetTasks"},
-		PersistenceCompleteTaskScope:                             {operation: "CompleteTask"},
-		PersistenceCompleteTasksLessThanScope:                    {operation: "CompleteTasksLessThan"},
-		PersistenceLeaseTaskQueueScope:                           {operation: "LeaseTaskQueue"},
-		PersistenceUpdateTaskQueueScope:                          {operation: "UpdateTaskQueue"},
-		PersistenceListTaskQueueScope:                            {operation: "ListTaskQueue"},
-		PersistenceDeleteTaskQueueScope:                          {operation: "DeleteTaskQueue"},
-		PersistenceAppendHistoryEventsScope:                      {operation: "AppendHistoryEvents"},
-		PersistenceGetWorkflowExecutionHistoryScope:              {operation: "GetWorkflowExecutionHistory"},
-		PersistenceDeleteWorkflowExecutionHistoryScope:           {operation: "DeleteWorkflowExecutionHistory"},
-		PersistenceInitializeSystemNamespaceScope:                {operation: "InitializeSystemNamespace"},
-		PersistenceCreateNamespaceScope:                          {operation: "CreateNamespace"},
-		PersistenceGetNamespaceScope:                             {operation: "GetNamespace"},
-		PersistenceUpdateNamespaceScope:                          {operation: "UpdateNamespace"},
-		PersistenceDeleteNamespaceScope:                          {operation: "DeleteNamespace"},
-		PersistenceDeleteNamespaceByNameScope:                    {operation: "DeleteNamespaceByName"},
-		PersistenceListNamespaceScope:                            {operation: "ListNamespace"},
-		PersistenceGetMetadataScope:                              {operation: "GetMetadata"},
-		PersistenceRecordWorkflowExecutionStartedScope:           {operation: "RecordWorkflowExecutionStarted"},
-		PersistenceRecordWorkflowExecutionClosedScope:            {operation: "RecordWorkflowExecutionClosed"},
-		PersistenceUpsertWorkflowExecutionScope:                  {operation: "UpsertWorkflowExecution"},
-		PersistenceListOpenWorkflowExecutionsScope:               {operation: "ListOpenWorkflowExecutions"},
-		PersistenceListClosedWorkflowExecutionsScope:             {operation: "ListClosedWorkflowExecutions"},
-		PersistenceListOpenWorkflowExecutionsByTypeScope:         {operation: "ListOpenWorkflowExecutionsByType"},
-		PersistenceListClosedWorkflowExecutionsByTypeScope:       {operation: "ListClosedWorkflowExecutionsByType"},
-		PersistenceListOpenWorkflowExecutionsByWorkflowIDScope:   {operation: "ListOpenWorkflowExecutionsByWorkflowID"},
-		PersistenceListClosedWorkflowExecutionsByWorkflowIDScope: {operation: "ListClosedWorkflowExecutionsByWorkflowID"},
-		PersistenceListClosedWorkflowExecutionsByStatusScope:     {operation: "ListClosedWorkflowExecutionsByStatus"},
-		PersistenceVisibilityDeleteWorkflowExecutionScope:        {operation: "VisibilityDeleteWorkflowExecution"},
-		PersistenceListWorkflowExecutionsScope:                   {operation: "ListWorkflowExecutions"},
-		PersistenceScanWorkflowExecutionsScope:                   {operation: "ScanWorkflowExecutions"},
-		PersistenceCountWorkflowExecutionsScope:                  {operation: "CountWorkflowExecutions"},
-		PersistenceAppendHistoryNodesScope:                       {operation: "AppendHistoryNodes"},
-		PersistenceDeleteHistoryNodesScope:                       {operation: "DeleteHistoryNodes"},
-		PersistenceReadHistoryBranchScope:                        {operation: "ReadHistoryBranch"},
-		PersistenceForkHistoryBranchScope:                        {operation: "ForkHistoryBranch"},
-		PersistenceDeleteHistoryBranchScope:                      {operation: "DeleteHistoryBranch"},
-		PersistenceTrimHistoryBranchScope:                        {operation: "TrimHistoryBranch"},
-		PersistenceCompleteForkBranchScope:                       {operation: "CompleteForkBranch"},
-		PersistenceGetHistoryTreeScope:                           {operation: "GetHistoryTree"},
-		PersistenceGetAllHistoryTreeBranchesScope:                {operation: "GetAllHistoryTreeBranches"},
-		PersistenceEnqueueMessageScope:                           {operation: "EnqueueMessage"},
-		PersistenceEnqueueMessageToDLQScope:                      {operation: "EnqueueMessageToDLQ"},
-		PersistenceReadQueueMessagesScope:                        {operation: "ReadQueueMessages"},
-		PersistenceReadQueueMessagesFromDLQScope:                 {operation: "ReadQueueMessagesFromDLQ"},
-		PersistenceDeleteQueueMessagesScope:                      {operation: "DeleteQueueMessages"},
-		PersistenceDeleteQueueMessageFromDLQScope:                {operation: "DeleteQueueMessageFromDLQ"},
-		PersistenceRangeDeleteMessagesFromDLQScope:               {operation: "RangeDeleteMessagesFromDLQ"},
-		PersistenceUpdateAckLevelScope:                           {operation: "UpdateAckLevel"},
-		PersistenceGetAckLevelScope:                              {operation: "GetAckLevel"},
-		PersistenceUpdateDLQAckLevelScope:                        {operation: "UpdateDLQAckLevel"},
-		PersistenceGetDLQAckLevelScope:                           {operation: "GetDLQAckLevel"},
-		PersistenceNamespaceReplicationQueueScope:                {operation: "NamespaceReplicationQueue"},
-		PersistenceGetClusterMetadataScope:                       {operation: "GetClusterMetadata"},
-		PersistenceSaveClusterMetadataScope:                      {operation: "SaveClusterMetadata"},
-		PersistencePruneClusterMembershipScope:                   {operation: "PruneClusterMembership"},
-		PersistenceGetClusterMembersScope:                        {operation: "GetClusterMembership"},
-		PersistenceUpsertClusterMembershipScope:                  {operation: "UpsertClusterMembership"},
+		UnknownScope:                                                 {operation: "Unknown"},
+		PersistenceCreateShardScope:                                  {operation: "CreateShard"},
+		PersistenceGetShardScope:                                     {operation: "GetShard"},
+		PersistenceUpdateShardScope:                                  {operation: "UpdateShard"},
+		PersistenceCreateWorkflowExecutionScope:                      {operation: "CreateWorkflowExecution"},
+		PersistenceGetWorkflowExecutionScope:                         {operation: "GetWorkflowExecution"},
+		PersistenceUpdateWorkflowExecutionScope:                      {operation: "UpdateWorkflowExecution"},
+		PersistenceConflictResolveWorkflowExecutionScope:             {operation: "ConflictResolveWorkflowExecution"},
+		PersistenceResetWorkflowExecutionScope:                       {operation: "ResetWorkflowExecution"},
+		PersistenceDeleteWorkflowExecutionScope:                      {operation: "DeleteWorkflowExecution"},
+		PersistenceDeleteCurrentWorkflowExecutionScope:               {operation: "DeleteCurrentWorkflowExecution"},
+		PersistenceGetCurrentExecutionScope:                          {operation: "GetCurrentExecution"},
+		PersistenceListConcreteExecutionsScope:                       {operation: "ListConcreteExecutions"},
+		PersistenceAddTasksScope:                                     {operation: "AddTasks"},
+		PersistenceGetTransferTaskScope:                              {operation: "GetTransferTask"},
+		PersistenceGetTransferTasksScope:                             {operation: "GetTransferTasks"},
+		PersistenceCompleteTransferTaskScope:                         {operation: "CompleteTransferTask"},
+		PersistenceRangeCompleteTransferTaskScope:                    {operation: "RangeCompleteTransferTask"},
+		PersistenceGetVisibilityTaskScope:                            {operation: "GetVisibilityTask"},
+		PersistenceGetVisibilityTasksScope:                           {operation: "GetVisibilityTasks"},
+		PersistenceCompleteVisibilityTaskScope:                       {operation: "CompleteVisibilityTask"},
+		PersistenceRangeCompleteVisibilityTaskScope:                  {operation: "RangeCompleteVisibilityTask"},
+		PersistenceGetReplicationTaskScope:                           {operation: "GetReplicationTask"},
+		PersistenceGetReplicationTasksScope:                          {operation: "GetReplicationTasks"},
+		PersistenceCompleteReplicationTaskScope:                      {operation: "CompleteReplicationTask"},
+		PersistenceRangeCompleteReplicationTaskScope:                 {operation: "RangeCompleteReplicationTask"},
+		PersistencePutReplicationTaskToDLQScope:                      {operation: "PutReplicationTaskToDLQ"},
+		PersistenceGetReplicationTasksFromDLQScope:                   {operation: "GetReplicationTasksFromDLQ"},
+		PersistenceDeleteReplicationTaskFromDLQScope:                 {operation: "DeleteReplicationTaskFromDLQ"},
+		PersistenceRangeDeleteReplicationTaskFromDLQScope:            {operation: "RangeDeleteReplicationTaskFromDLQ"},
+		PersistenceGetTimerTaskScope:                                 {operation: "GetTimerTask"},
+		PersistenceGetTimerIndexTasksScope:                           {operation: "GetTimerIndexTasks"},
+		PersistenceCompleteTimerTaskScope:                            {operation: "CompleteTimerTask"},
+		PersistenceRangeCompleteTimerTaskScope:                       {operation: "RangeCompleteTimerTask"},
+		PersistenceCreateTaskScope:                                   {operation: "CreateTask"},
+		PersistenceGetTasksScope:                                     {operation: "GetTasks"},
+		PersistenceCompleteTaskScope:                                 {operation: "CompleteTask"},
+		PersistenceCompleteTasksLessThanScope:                        {operation: "CompleteTasksLessThan"},
+		PersistenceLeaseTaskQueueScope:                               {operation: "LeaseTaskQueue"},
+		PersistenceUpdateTaskQueueScope:                              {operation: "UpdateTaskQueue"},
+		PersistenceListTaskQueueScope:                                {operation: "ListTaskQueue"},
+		PersistenceDeleteTaskQueueScope:                              {operation: "DeleteTaskQueue"},
+		PersistenceAppendHistoryEventsScope:                          {operation: "AppendHistoryEvents"},
+		PersistenceGetWorkflowExecutionHistoryScope:                  {operation: "GetWorkflowExecutionHistory"},
+		PersistenceDeleteWorkflowExecutionHistoryScope:               {operation: "DeleteWorkflowExecutionHistory"},
+		PersistenceInitializeSystemNamespaceScope:                    {operation: "InitializeSystemNamespace"},
+		PersistenceCreateNamespaceScope:                              {operation: "CreateNamespace"},
+		PersistenceGetNamespaceScope:                                 {operation: "GetNamespace"},
+		PersistenceUpdateNamespaceScope:                              {operation: "UpdateNamespace"},
+		PersistenceDeleteNamespaceScope:                              {operation: "DeleteNamespace"},
+		PersistenceDeleteNamespaceByNameScope:                        {operation: "DeleteNamespaceByName"},
+		PersistenceListNamespaceScope:                                {operation: "ListNamespace"},
+		PersistenceGetMetadataScope:                                  {operation: "GetMetadata"},
+		PersistenceRecordWorkflowExecutionStartedScope:               {operation: "RecordWorkflowExecutionStarted"},
+		PersistenceRecordWorkflowExecutionClosedScope:                {operation: "RecordWorkflowExecutionClosed"},
+		PersistenceUpsertWorkflowExecutionScope:                      {operation: "UpsertWorkflowExecution"},
+		PersistenceListOpenWorkflowExecutionsScope:                   {operation: "ListOpenWorkflowExecutions"},
+		PersistenceListClosedWorkflowExecutionsScope:                 {operation: "ListClosedWorkflowExecutions"},
+		PersistenceListOpenWorkflowExecutionsByTypeScope:             {operation: "ListOpenWorkflowExecutionsByType"},
+		PersistenceListClosedWorkflowExecutionsByTypeScope:           {operation: "ListClosedWorkflowExecutionsByType"},
+		PersistenceListOpenWorkflowExecutionsByWorkflowIDScope:       {operation: "ListOpenWorkflowExecutionsByWorkflowID"},
+		PersistenceListClosedWorkflowExecutionsByWorkflowIDScope:     {operation: "ListClosedWorkflowExecutionsByWorkflowID"},
+		PersistenceListClosedWorkflowExecutionsByStatusScope:         {operation: "ListClosedWorkflowExecutionsByStatus"},
+		PersistenceListClosedWorkflowExecutionsByTypeAndStatusScope:  {operation: "ListClosedWorkflowExecutionsByTypeAndStatus"},
+		PersistenceCountClosedWorkflowExecutionsByTypeAndStatusScope: {operation: "CountClosedWorkflowExecutionsByTypeAndStatus"},
+		PersistenceVisibilityDeleteWorkflowExecutionScope:            {operation: "VisibilityDeleteWorkflowExecution"},
+		PersistenceListWorkflowExecutionsScope:                       {operation: "ListWorkflowExecutions"},
+		PersistenceScanWorkflowExecutionsScope:                       {operation: "ScanWorkflowExecutions"},
+		PersistenceCountWorkflowExecutionsScope:                      {operation: "CountWorkflowExecutions"},
+		PersistenceAppendHistoryNodesScope:                           {operation: "AppendHistoryNodes"},
+		PersistenceDeleteHistoryNodesScope:                           {operation: "DeleteHistoryNodes"},
+		PersistenceReadHistoryBranchScope:                            {operation: "ReadHistoryBranch"},
+		PersistenceForkHistoryBranchScope:                            {operation: "ForkHistoryBranch"},
+		PersistenceDeleteHistoryBranchScope:                          {operation: "DeleteHistoryBranch"},
+		PersistenceTrimHistoryBranchScope:                            {operation: "TrimHistoryBranch"},
+		PersistenceCompactHistoryBranchScope:                         {operation: "CompactHistoryBranch"},
+		PersistenceCompleteForkBranchScope:                           {operation: "CompleteForkBranch"},
+		PersistenceGetHistoryTreeScope:                               {operation: "GetHistoryTree"},
+		PersistenceGetAllHistoryTreeBranchesScope:                    {operation: "GetAllHistoryTreeBranches"},
+		PersistenceEnqueueMessageScope:                               {operation: "EnqueueMessage"},
+		PersistenceEnqueueMessageToDLQScope:                          {operation: "EnqueueMessageToDLQ"},
+		PersistenceReadQueueMessagesScope:                            {operation: "ReadQueueMessages"},
+		PersistenceReadQueueMessagesFromDLQScope:                     {operation: "ReadQueueMessagesFromDLQ"},
+		PersistenceDeleteQueueMessagesScope:                          {operation: "DeleteQueueMessages"},
+		PersistenceDeleteQueueMessageFromDLQScope:                    {operation: "DeleteQueueMessageFromDLQ"},
+		PersistenceRangeDeleteMessagesFromDLQScope:                   {operation: "RangeDeleteMessagesFromDLQ"},
+		PersistenceUpdateAckLevelScope:                               {operation: "UpdateAckLevel"},
+		PersistenceGetAckLevelScope:                                  {operation: "GetAckLevel"},
+		PersistenceUpdateDLQAckLevelScope:                            {operation: "UpdateDLQAckLevel"},
+		PersistenceGetDLQAckLevelScope:                               {operation: "GetDLQAckLevel"},
+		PersistenceNamespaceReplicationQueueScope:                    {operation: "NamespaceReplicationQueue"},
+		PersistenceGetClusterMetadataScope:                           {operation: "GetClusterMetadata"},
+		PersistenceSaveClusterMetadataScope:                          {operation: "SaveClusterMetadata"},
+		PersistencePruneClusterMembershipScope:                       {operation: "PruneClusterMembership"},
+		PersistenceGetClusterMembersScope:                            {operation: "GetClusterMembership"},
+		PersistenceUpsertClusterMembershipScope:                      {operation: "UpsertClusterMembership"},
 
 		ClusterMetadataArchivalConfigScope: {operation: "ArchivalConfig"},
 
@@ -1226,6 +1510,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientRequestCancelWorkflowExecutionScope:      {operation: "HistoryClientRequestCancelWorkflowExecution", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientSignalWorkflowExecutionScope:             {operation: "HistoryClientSignalWorkflowExecution", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientSignalWithStartWorkflowExecutionScope:    {operation: "HistoryClientSignalWithStartWorkflowExecution", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientExecuteMultiOperationScope:               {operation: "HistoryClientExecuteMultiOperation", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientRemoveSignalMutableStateScope:            {operation: "HistoryClientRemoveSignalMutableStateScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientTerminateWorkflowExecutionScope:          {operation: "HistoryClientTerminateWorkflowExecution", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientResetWorkflowExecutionScope:              {operation: "HistoryClientResetWorkflowExecution", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
@@ -1242,6 +1527,16 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientPurgeDLQMessagesScope:                    {operation: "HistoryClientPurgeDLQMessagesScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientMergeDLQMessagesScope:                    {operation: "HistoryClientMergeDLQMessagesScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientRefreshWorkflowTasksScope:                {operation: "HistoryClientRefreshWorkflowTasksScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientGetReplicationStatusScope:                {operation: "HistoryClientGetReplicationStatusScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientStreamReplicationMessagesScope:           {operation: "HistoryClientStreamReplicationMessagesScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientRecordWorkflowTaskHeartbeatScope:         {operation: "HistoryClientRecordWorkflowTaskHeartbeat", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientGenerateLastHistoryReplicationTasksScope: {operation: "HistoryClientGenerateLastHistoryReplicationTasks", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientCompactWorkflowHistoryScope:              {operation: "HistoryClientCompactWorkflowHistory", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientListThrottledCallersScope:                {operation: "HistoryClientListThrottledCallers", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientGetShardStatsScope:                       {operation: "HistoryClientGetShardStats", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientGetHostProfileScope:                      {operation: "HistoryClientGetHostProfile", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientDescribeVisibilityProcessorScope:         {operation: "HistoryClientDescribeVisibilityProcessor", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientDeleteWorkflowExecutionScope:             {operation: "HistoryClientDeleteWorkflowExecution", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
 		MatchingClientPollWorkflowTaskQueueScope:              {operation: "MatchingClientPollWorkflowTaskQueue", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientPollActivityTaskQueueScope:              {operation: "MatchingClientPollActivityTaskQueue", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientAddActivityTaskScope:                    {operation: "MatchingClientAddActivityTask", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
@@ -1251,6 +1546,13 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		MatchingClientCancelOutstandingPollScope:              {operation: "MatchingClientCancelOutstandingPoll", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientDescribeTaskQueueScope:                  {operation: "MatchingClientDescribeTaskQueue", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientListTaskQueuePartitionsScope:            {operation: "MatchingClientListTaskQueuePartitions", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
+		MatchingClientDescribeTaskQueuePartitionsScope:        {operation: "MatchingClientDescribeTaskQueuePartitions", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
+		MatchingClientGetTaskQueueUserDataScope:               {operation: "MatchingClientGetTaskQueueUserData", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
+		MatchingClientUpdateTaskQueueUserDataScope:            {operation: "MatchingClientUpdateTaskQueueUserData", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
+		MatchingClientUpdateWorkerBuildIdCompatibilityScope:   {operation: "MatchingClientUpdateWorkerBuildIdCompatibility", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
+		MatchingClientGetWorkerBuildIdCompatibilityScope:      {operation: "MatchingClientGetWorkerBuildIdCompatibility", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
+		MatchingClientEvictStickyTaskQueueScope:               {operation: "MatchingClientEvictStickyTaskQueue", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
+		MatchingClientGetHostProfileScope:                     {operation: "MatchingClientGetHostProfile", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
 		FrontendClientDeprecateNamespaceScope:                 {operation: "FrontendClientDeprecateNamespace", tags: map[string]string{ServiceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientDescribeNamespaceScope:                  {operation: "FrontendClientDescribeNamespace", tags: map[string]string{ServiceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientDescribeTaskQueueScope:                  {operation: "FrontendClientDescribeTaskQueue", tags: map[string]string{ServiceRoleTagName: FrontendRoleTagValue}},
@@ -1302,9 +1604,47 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminClientDescribeWorkflowMutableStateScope:          {operation: "AdminClientDescribeWorkflowMutableState", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientGetWorkflowExecutionRawHistoryScope:        {operation: "AdminClientGetWorkflowExecutionRawHistory", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientGetWorkflowExecutionRawHistoryV2Scope:      {operation: "AdminClientGetWorkflowExecutionRawHistoryV2", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientGetWorkflowExecutionHistoryReverseScope:    {operation: "AdminClientGetWorkflowExecutionHistoryReverse", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientDescribeClusterScope:                       {operation: "AdminClientDescribeCluster", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientRefreshWorkflowTasksScope:                  {operation: "AdminClientRefreshWorkflowTasks", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientResendReplicationTasksScope:                {operation: "AdminClientResendReplicationTasks", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientExecuteMultiOperationScope:                 {operation: "AdminClientExecuteMultiOperation", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientListNamespaceFailoverHistoryScope:          {operation: "AdminClientListNamespaceFailoverHistory", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientHandoverNamespaceScope:                     {operation: "AdminClientHandoverNamespace", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientStreamReplicationMessagesScope:             {operation: "AdminClientStreamReplicationMessages", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientStreamWorkflowExecutionHistoryScope:        {operation: "AdminClientStreamWorkflowExecutionHistory", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientRecordWorkflowTaskHeartbeatScope:           {operation: "AdminClientRecordWorkflowTaskHeartbeat", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientGetReplicationLagScope:                     {operation: "AdminClientGetReplicationLag", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientCompactWorkflowHistoryScope:                {operation: "AdminClientCompactWorkflowHistory", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientListStaleWorkflowExecutionsScope:           {operation: "AdminClientListStaleWorkflowExecutions", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientGetDynamicConfigScope:                      {operation: "AdminClientGetDynamicConfig", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientSetDynamicConfigScope:                      {operation: "AdminClientSetDynamicConfig", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientListDynamicConfigScope:                     {operation: "AdminClientListDynamicConfig", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientListThrottledCallersScope:                  {operation: "AdminClientListThrottledCallers", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientDescribeTaskQueuePartitionsScope:           {operation: "AdminClientDescribeTaskQueuePartitions", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientSetMaintenanceInfoScope:                    {operation: "AdminClientSetMaintenanceInfo", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientUpdateWorkerBuildIdCompatibilityScope:      {operation: "AdminClientUpdateWorkerBuildIdCompatibility", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientGetWorkerBuildIdCompatibilityScope:         {operation: "AdminClientGetWorkerBuildIdCompatibility", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientShutdownWorkerScope:                        {operation: "AdminClientShutdownWorker", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientImportWorkflowExecutionScope:               {operation: "AdminClientImportWorkflowExecution", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientCreateScheduleScope:                        {operation: "AdminClientCreateSchedule", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientDescribeScheduleScope:                      {operation: "AdminClientDescribeSchedule", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientUpdateScheduleScope:                        {operation: "AdminClientUpdateSchedule", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientPatchScheduleScope:                         {operation: "AdminClientPatchSchedule", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientDeleteScheduleScope:                        {operation: "AdminClientDeleteSchedule", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientListSchedulesScope:                         {operation: "AdminClientListSchedules", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientStartResetBadBinaryJobScope:                {operation: "AdminClientStartResetBadBinaryJob", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientGetResetPointsScope:                        {operation: "AdminClientGetResetPoints", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientRebalanceShardsScope:                       {operation: "AdminClientRebalanceShards", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientGetShardStatsScope:                         {operation: "AdminClientGetShardStats", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientGetHostProfileScope:                        {operation: "AdminClientGetHostProfile", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientDeepHealthCheckScope:                       {operation: "AdminClientDeepHealthCheck", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientDescribeClusterMetadataScope:               {operation: "AdminClientDescribeClusterMetadata", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientUpdateClusterMetadataScope:                 {operation: "AdminClientUpdateClusterMetadata", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientDescribeVisibilityProcessorScope:           {operation: "AdminClientDescribeVisibilityProcessor", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientDeleteNamespaceScope:                       {operation: "AdminClientDeleteNamespace", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientDescribeNamespaceQuotasScope:               {operation: "AdminClientDescribeNamespaceQuotas", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientUpdateNamespaceQuotasScope:                 {operation: "AdminClientUpdateNamespaceQuotas", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientCloseShardScope:                            {operation: "AdminClientCloseShard", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientGetDLQMessagesScope:                        {operation: "AdminClientGetDLQMessages", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientPurgeDLQMessagesScope:                      {operation: "AdminClientPurgeDLQMessages", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
@@ -1359,22 +1699,26 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryRereplicationByHistoryMetadataReplicationScope: {operation: "HistoryRereplicationByHistoryMetadataReplication"},
 		HistoryRereplicationByActivityReplicationScope:        {operation: "HistoryRereplicationByActivityReplication"},
 
-		ElasticsearchRecordWorkflowExecutionStartedScope:           {operation: "RecordWorkflowExecutionStarted"},
-		ElasticsearchRecordWorkflowExecutionClosedScope:            {operation: "RecordWorkflowExecutionClosed"},
-		ElasticsearchUpsertWorkflowExecutionScope:                  {operation: "UpsertWorkflowExecution"},
-		ElasticsearchListOpenWorkflowExecutionsScope:               {operation: "ListOpenWorkflowExecutions"},
-		ElasticsearchListClosedWorkflowExecutionsScope:             {operation: "ListClosedWorkflowExecutions"},
-		ElasticsearchListOpenWorkflowExecutionsByTypeScope:         {operation: "ListOpenWorkflowExecutionsByType"},
-		ElasticsearchListClosedWorkflowExecutionsByTypeScope:       {operation: "ListClosedWorkflowExecutionsByType"},
-		ElasticsearchListOpenWorkflowExecutionsByWorkflowIDScope:   {operation: "ListOpenWorkflowExecutionsByWorkflowID"},
-		ElasticsearchListClosedWorkflowExecutionsByWorkflowIDScope: {operation: "ListClosedWorkflowExecutionsByWorkflowID"},
-		ElasticsearchListClosedWorkflowExecutionsByStatusScope:     {operation: "ListClosedWorkflowExecutionsByStatus"},
-		ElasticsearchListWorkflowExecutionsScope:                   {operation: "ListWorkflowExecutions"},
-		ElasticsearchScanWorkflowExecutionsScope:                   {operation: "ScanWorkflowExecutions"},
-		ElasticsearchCountWorkflowExecutionsScope:                  {operation: "CountWorkflowExecutions"},
-		ElasticsearchDeleteWorkflowExecutionsScope:                 {operation: "DeleteWorkflowExecution"},
-		ElasticsearchBulkProcessor:                                 {operation: "ElasticsearchBulkProcessor"},
-		ElasticsearchVisibility:                                    {operation: "ElasticsearchVisibility"},
+		ElasticsearchRecordWorkflowExecutionStartedScope:               {operation: "RecordWorkflowExecutionStarted"},
+		ElasticsearchRecordWorkflowExecutionClosedScope:                {operation: "RecordWorkflowExecutionClosed"},
+		ElasticsearchUpsertWorkflowExecutionScope:                      {operation: "UpsertWorkflowExecution"},
+		ElasticsearchListOpenWorkflowExecutionsScope:                   {operation: "ListOpenWorkflowExecutions"},
+		ElasticsearchListClosedWorkflowExecutionsScope:                 {operation: "ListClosedWorkflowExecutions"},
+		ElasticsearchListOpenWorkflowExecutionsByTypeScope:             {operation: "ListOpenWorkflowExecutionsByType"},
+		ElasticsearchListClosedWorkflowExecutionsByTypeScope:           {operation: "ListClosedWorkflowExecutionsByType"},
+		ElasticsearchListOpenWorkflowExecutionsByWorkflowIDScope:       {operation: "ListOpenWorkflowExecutionsByWorkflowID"},
+		ElasticsearchListClosedWorkflowExecutionsByWorkflowIDScope:     {operation: "ListClosedWorkflowExecutionsByWorkflowID"},
+		ElasticsearchListClosedWorkflowExecutionsByStatusScope:         {operation: "ListClosedWorkflowExecutionsByStatus"},
+		ElasticsearchListClosedWorkflowExecutionsByTypeAndStatusScope:  {operation: "ListClosedWorkflowExecutionsByTypeAndStatus"},
+		ElasticsearchCountClosedWorkflowExecutionsByTypeAndStatusScope: {operation: "CountClosedWorkflowExecutionsByTypeAndStatus"},
+		ElasticsearchListWorkflowExecutionsScope:                       {operation: "ListWorkflowExecutions"},
+		ElasticsearchScanWorkflowExecutionsScope:                       {operation: "ScanWorkflowExecutions"},
+		ElasticsearchCountWorkflowExecutionsScope:                      {operation: "CountWorkflowExecutions"},
+		ElasticsearchDeleteWorkflowExecutionsScope:                     {operation: "DeleteWorkflowExecution"},
+		ElasticsearchBulkProcessor:                                     {operation: "ElasticsearchBulkProcessor"},
+		VisibilityExporterScope:                                        {operation: "VisibilityExporter"},
+		ElasticsearchVisibility:                                        {operation: "ElasticsearchVisibility"},
+		VisibilitySinkScope:                                            {operation: "VisibilitySink"},
 
 		SequentialTaskProcessingScope: {operation: "SequentialTaskProcessing"},
 		ParallelTaskProcessingScope:   {operation: "ParallelTaskProcessing"},
@@ -1389,28 +1733,68 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		BlobstoreClientExistsScope:          {operation: "BlobstoreClientExists", tags: map[string]string{ServiceRoleTagName: BlobstoreRoleTagValue}},
 		BlobstoreClientDeleteScope:          {operation: "BlobstoreClientDelete", tags: map[string]string{ServiceRoleTagName: BlobstoreRoleTagValue}},
 		BlobstoreClientDirectoryExistsScope: {operation: "BlobstoreClientDirectoryExists", tags: map[string]string{ServiceRoleTagName: BlobstoreRoleTagValue}},
+
+		DynamicConfigScope: {operation: "DynamicConfig"},
 	},
 	// Frontend Scope Names
 	Frontend: {
 		// Admin API scope co-locates with with frontend
-		AdminRemoveTaskScope:                       {operation: "AdminRemoveTask"},
-		AdminCloseShardTaskScope:                   {operation: "AdminCloseShardTask"},
-		AdminReadDLQMessagesScope:                  {operation: "AdminReadDLQMessages"},
-		AdminPurgeDLQMessagesScope:                 {operation: "AdminPurgeDLQMessages"},
-		AdminMergeDLQMessagesScope:                 {operation: "AdminMergeDLQMessages"},
-		AdminDescribeHistoryHostScope:              {operation: "DescribeHistoryHost"},
-		AdminAddSearchAttributesScope:              {operation: "AdminAddSearchAttributes"},
-		AdminRemoveSearchAttributesScope:           {operation: "AdminRemoveSearchAttributes"},
-		AdminGetSearchAttributesScope:              {operation: "AdminGetSearchAttributes"},
-		AdminDescribeWorkflowExecutionScope:        {operation: "DescribeWorkflowExecution"},
-		AdminGetWorkflowExecutionRawHistoryScope:   {operation: "GetWorkflowExecutionRawHistory"},
-		AdminGetWorkflowExecutionRawHistoryV2Scope: {operation: "GetWorkflowExecutionRawHistoryV2"},
-		AdminGetReplicationMessagesScope:           {operation: "GetReplicationMessages"},
-		AdminGetNamespaceReplicationMessagesScope:  {operation: "GetNamespaceReplicationMessages"},
-		AdminGetDLQReplicationMessagesScope:        {operation: "AdminGetDLQReplicationMessages"},
-		AdminReapplyEventsScope:                    {operation: "ReapplyEvents"},
-		AdminRefreshWorkflowTasksScope:             {operation: "RefreshWorkflowTasks"},
-		AdminResendReplicationTasksScope:           {operation: "ResendReplicationTasks"},
+		AdminRemoveTaskScope:                         {operation: "AdminRemoveTask"},
+		AdminCloseShardTaskScope:                     {operation: "AdminCloseShardTask"},
+		AdminReadDLQMessagesScope:                    {operation: "AdminReadDLQMessages"},
+		AdminPurgeDLQMessagesScope:                   {operation: "AdminPurgeDLQMessages"},
+		AdminMergeDLQMessagesScope:                   {operation: "AdminMergeDLQMessages"},
+		AdminDescribeHistoryHostScope:                {operation: "DescribeHistoryHost"},
+		AdminAddSearchAttributesScope:                {operation: "AdminAddSearchAttributes"},
+		AdminRemoveSearchAttributesScope:             {operation: "AdminRemoveSearchAttributes"},
+		AdminGetSearchAttributesScope:                {operation: "AdminGetSearchAttributes"},
+		AdminDescribeWorkflowExecutionScope:          {operation: "DescribeWorkflowExecution"},
+		AdminGetWorkflowExecutionRawHistoryScope:     {operation: "GetWorkflowExecutionRawHistory"},
+		AdminGetWorkflowExecutionRawHistoryV2Scope:   {operation: "GetWorkflowExecutionRawHistoryV2"},
+		AdminGetWorkflowExecutionHistoryReverseScope: {operation: "GetWorkflowExecutionHistoryReverse"},
+		AdminGetReplicationMessagesScope:             {operation: "GetReplicationMessages"},
+		AdminGetNamespaceReplicationMessagesScope:    {operation: "GetNamespaceReplicationMessages"},
+		AdminGetDLQReplicationMessagesScope:          {operation: "AdminGetDLQReplicationMessages"},
+		AdminReapplyEventsScope:                      {operation: "ReapplyEvents"},
+		AdminRefreshWorkflowTasksScope:               {operation: "RefreshWorkflowTasks"},
+		AdminResendReplicationTasksScope:             {operation: "ResendReplicationTasks"},
+		AdminExecuteMultiOperationScope:              {operation: "ExecuteMultiOperation"},
+		AdminListNamespaceFailoverHistoryScope:       {operation: "ListNamespaceFailoverHistory"},
+		AdminHandoverNamespaceScope:                  {operation: "HandoverNamespace"},
+		AdminStreamReplicationMessagesScope:          {operation: "StreamReplicationMessages"},
+		AdminStreamWorkflowExecutionHistoryScope:     {operation: "StreamWorkflowExecutionHistory"},
+		AdminRecordWorkflowTaskHeartbeatScope:        {operation: "RecordWorkflowTaskHeartbeat"},
+		AdminGetReplicationLagScope:                  {operation: "GetReplicationLag"},
+		AdminCompactWorkflowHistoryScope:             {operation: "CompactWorkflowHistory"},
+		AdminListStaleWorkflowExecutionsScope:        {operation: "ListStaleWorkflowExecutions"},
+		AdminGetDynamicConfigScope:                   {operation: "GetDynamicConfig"},
+		AdminSetDynamicConfigScope:                   {operation: "SetDynamicConfig"},
+		AdminListDynamicConfigScope:                  {operation: "ListDynamicConfig"},
+		AdminListThrottledCallersScope:               {operation: "ListThrottledCallers"},
+		AdminDescribeTaskQueuePartitionsScope:        {operation: "DescribeTaskQueuePartitions"},
+		AdminSetMaintenanceInfoScope:                 {operation: "SetMaintenanceInfo"},
+		AdminUpdateWorkerBuildIdCompatibilityScope:   {operation: "UpdateWorkerBuildIdCompatibility"},
+		AdminGetWorkerBuildIdCompatibilityScope:      {operation: "GetWorkerBuildIdCompatibility"},
+		AdminShutdownWorkerScope:                     {operation: "ShutdownWorker"},
+		AdminImportWorkflowExecutionScope:            {operation: "ImportWorkflowExecution"},
+		AdminCreateScheduleScope:                     {operation: "CreateSchedule"},
+		AdminDescribeScheduleScope:                   {operation: "DescribeSchedule"},
+		AdminUpdateScheduleScope:                     {operation: "UpdateSchedule"},
+		AdminPatchScheduleScope:                      {operation: "PatchSchedule"},
+		AdminDeleteScheduleScope:                     {operation: "DeleteSchedule"},
+		AdminListSchedulesScope:                      {operation: "ListSchedules"},
+		AdminStartResetBadBinaryJobScope:             {operation: "StartResetBadBinaryJob"},
+		AdminGetResetPointsScope:                     {operation: "GetResetPoints"},
+		AdminRebalanceShardsScope:                    {operation: "RebalanceShards"},
+		AdminGetShardStatsScope:                      {operation: "GetShardStats"},
+		AdminGetHostProfileScope:                     {operation: "GetHostProfile"},
+		AdminDeepHealthCheckScope:                    {operation: "DeepHealthCheck"},
+		AdminDescribeClusterMetadataScope:            {operation: "DescribeClusterMetadata"},
+		AdminUpdateClusterMetadataScope:              {operation: "UpdateClusterMetadata"},
+		AdminDescribeVisibilityProcessorScope:        {operation: "DescribeVisibilityProcessor"},
+		AdminDeleteNamespaceScope:                    {operation: "DeleteNamespace"},
+		AdminDescribeNamespaceQuotasScope:            {operation: "DescribeNamespaceQuotas"},
+		AdminUpdateNamespaceQuotasScope:              {operation: "UpdateNamespaceQuotas"},
 
 		FrontendStartWorkflowExecutionScope:             {operation: "StartWorkflowExecution"},
 		FrontendPollWorkflowTaskQueueScope:              {operation: "PollWorkflowTaskQueue"},
@@ -1455,67 +1839,80 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendGetClusterInfoScope:                     {operation: "GetClusterInfo"},
 		VersionCheckScope:                               {operation: "VersionCheck"},
 		AuthorizationScope:                              {operation: "Authorization"},
+		AuthorizationTokenKeyProviderScope:              {operation: "AuthorizationTokenKeyProvider"},
+		AuthorizationAuditLogScope:                      {operation: "AuthorizationAuditLog"},
 	},
 	// History Scope Names
 	History: {
-		HistoryStartWorkflowExecutionScope:           {operation: "StartWorkflowExecution"},
-		HistoryRecordActivityTaskHeartbeatScope:      {operation: "RecordActivityTaskHeartbeat"},
-		HistoryRespondWorkflowTaskCompletedScope:     {operation: "RespondWorkflowTaskCompleted"},
-		HistoryRespondWorkflowTaskFailedScope:        {operation: "RespondWorkflowTaskFailed"},
-		HistoryRespondActivityTaskCompletedScope:     {operation: "RespondActivityTaskCompleted"},
-		HistoryRespondActivityTaskFailedScope:        {operation: "RespondActivityTaskFailed"},
-		HistoryRespondActivityTaskCanceledScope:      {operation: "RespondActivityTaskCanceled"},
-		HistoryGetMutableStateScope:                  {operation: "GetMutableState"},
-		HistoryPollMutableStateScope:                 {operation: "PollMutableState"},
-		HistoryResetStickyTaskQueueScope:             {operation: "ResetStickyTaskQueueScope"},
-		HistoryDescribeWorkflowExecutionScope:        {operation: "DescribeWorkflowExecution"},
-		HistoryRecordWorkflowTaskStartedScope:        {operation: "RecordWorkflowTaskStarted"},
-		HistoryRecordActivityTaskStartedScope:        {operation: "RecordActivityTaskStarted"},
-		HistorySignalWorkflowExecutionScope:          {operation: "SignalWorkflowExecution"},
-		HistorySignalWithStartWorkflowExecutionScope: {operation: "SignalWithStartWorkflowExecution"},
-		HistoryRemoveSignalMutableStateScope:         {operation: "RemoveSignalMutableState"},
-		HistoryTerminateWorkflowExecutionScope:       {operation: "TerminateWorkflowExecution"},
-		HistoryResetWorkflowExecutionScope:           {operation: "ResetWorkflowExecution"},
-		HistoryQueryWorkflowScope:                    {operation: "QueryWorkflow"},
-		HistoryProcessDeleteHistoryEventScope:        {operation: "ProcessDeleteHistoryEvent"},
-		HistoryScheduleWorkflowTaskScope:             {operation: "ScheduleWorkflowTask"},
-		HistoryRecordChildExecutionCompletedScope:    {operation: "RecordChildExecutionCompleted"},
-		HistoryRequestCancelWorkflowExecutionScope:   {operation: "RequestCancelWorkflowExecution"},
-		HistorySyncShardStatusScope:                  {operation: "SyncShardStatus"},
-		HistorySyncActivityScope:                     {operation: "SyncActivity"},
-		HistoryDescribeMutableStateScope:             {operation: "DescribeMutableState"},
-		HistoryGetReplicationMessagesScope:           {operation: "GetReplicationMessages"},
-		HistoryGetDLQReplicationMessagesScope:        {operation: "GetDLQReplicationMessages"},
-		HistoryReadDLQMessagesScope:                  {operation: "GetDLQMessages"},
-		HistoryPurgeDLQMessagesScope:                 {operation: "PurgeDLQMessages"},
-		HistoryMergeDLQMessagesScope:                 {operation: "MergeDLQMessages"},
-		HistoryShardControllerScope:                  {operation: "ShardController"},
-		HistoryReapplyEventsScope:                    {operation: "EventReapplication"},
-		HistoryRefreshWorkflowTasksScope:             {operation: "RefreshWorkflowTasks"},
-		HistoryHistoryRemoveTaskScope:                {operation: "RemoveTask"},
-		HistoryCloseShard:                            {operation: "CloseShard"},
-		HistoryReplicateEventsV2:                     {operation: "ReplicateEventsV2"},
-		HistoryResetStickyTaskQueue:                  {operation: "ResetStickyTaskQueue"},
-		HistoryReapplyEvents:                         {operation: "ReapplyEvents"},
-		HistoryDescribeHistoryHost:                   {operation: "DescribeHistoryHost"},
-		TaskPriorityAssignerScope:                    {operation: "TaskPriorityAssigner"},
-		TransferQueueProcessorScope:                  {operation: "TransferQueueProcessor"},
-		TransferActiveQueueProcessorScope:            {operation: "TransferActiveQueueProcessor"},
-		TransferStandbyQueueProcessorScope:           {operation: "TransferStandbyQueueProcessor"},
-		TransferActiveTaskActivityScope:              {operation: "TransferActiveTaskActivity"},
-		TransferActiveTaskWorkflowTaskScope:          {operation: "TransferActiveTaskWorkflowTask"},
-		TransferActiveTaskCloseExecutionScope:        {operation: "TransferActiveTaskCloseExecution"},
-		TransferActiveTaskCancelExecutionScope:       {operation: "TransferActiveTaskCancelExecution"},
-		TransferActiveTaskSignalExecutionScope:       {operation: "TransferActiveTaskSignalExecution"},
-		TransferActiveTaskStartChildExecutionScope:   {operation: "TransferActiveTaskStartChildExecution"},
-		TransferActiveTaskResetWorkflowScope:         {operation: "TransferActiveTaskResetWorkflow"},
-		TransferStandbyTaskActivityScope:             {operation: "TransferStandbyTaskActivity"},
-		TransferStandbyTaskWorkflowTaskScope:         {operation: "TransferStandbyTaskWorkflowTask"},
-		TransferStandbyTaskCloseExecutionScope:       {operation: "TransferStandbyTaskCloseExecution"},
-		TransferStandbyTaskCancelExecutionScope:      {operation: "TransferStandbyTaskCancelExecution"},
-		TransferStandbyTaskSignalExecutionScope:      {operation: "TransferStandbyTaskSignalExecution"},
-		TransferStandbyTaskStartChildExecutionScope:  {operation: "TransferStandbyTaskStartChildExecution"},
-		TransferStandbyTaskResetWorkflowScope:        {operation: "TransferStandbyTaskResetWorkflow"},
+		HistoryStartWorkflowExecutionScope:              {operation: "StartWorkflowExecution"},
+		HistoryRecordActivityTaskHeartbeatScope:         {operation: "RecordActivityTaskHeartbeat"},
+		HistoryRespondWorkflowTaskCompletedScope:        {operation: "RespondWorkflowTaskCompleted"},
+		HistoryRespondWorkflowTaskFailedScope:           {operation: "RespondWorkflowTaskFailed"},
+		HistoryRespondActivityTaskCompletedScope:        {operation: "RespondActivityTaskCompleted"},
+		HistoryRespondActivityTaskFailedScope:           {operation: "RespondActivityTaskFailed"},
+		HistoryRespondActivityTaskCanceledScope:         {operation: "RespondActivityTaskCanceled"},
+		HistoryGetMutableStateScope:                     {operation: "GetMutableState"},
+		HistoryPollMutableStateScope:                    {operation: "PollMutableState"},
+		HistoryResetStickyTaskQueueScope:                {operation: "ResetStickyTaskQueueScope"},
+		HistoryDescribeWorkflowExecutionScope:           {operation: "DescribeWorkflowExecution"},
+		HistoryRecordWorkflowTaskStartedScope:           {operation: "RecordWorkflowTaskStarted"},
+		HistoryRecordActivityTaskStartedScope:           {operation: "RecordActivityTaskStarted"},
+		HistorySignalWorkflowExecutionScope:             {operation: "SignalWorkflowExecution"},
+		HistorySignalWithStartWorkflowExecutionScope:    {operation: "SignalWithStartWorkflowExecution"},
+		HistoryExecuteMultiOperationScope:               {operation: "ExecuteMultiOperation"},
+		HistoryRemoveSignalMutableStateScope:            {operation: "RemoveSignalMutableState"},
+		HistoryTerminateWorkflowExecutionScope:          {operation: "TerminateWorkflowExecution"},
+		HistoryResetWorkflowExecutionScope:              {operation: "ResetWorkflowExecution"},
+		HistoryQueryWorkflowScope:                       {operation: "QueryWorkflow"},
+		HistoryProcessDeleteHistoryEventScope:           {operation: "ProcessDeleteHistoryEvent"},
+		HistoryScheduleWorkflowTaskScope:                {operation: "ScheduleWorkflowTask"},
+		HistoryRecordChildExecutionCompletedScope:       {operation: "RecordChildExecutionCompleted"},
+		HistoryRequestCancelWorkflowExecutionScope:      {operation: "RequestCancelWorkflowExecution"},
+		HistorySyncShardStatusScope:                     {operation: "SyncShardStatus"},
+		HistorySyncActivityScope:                        {operation: "SyncActivity"},
+		HistoryDescribeMutableStateScope:                {operation: "DescribeMutableState"},
+		HistoryGetReplicationMessagesScope:              {operation: "GetReplicationMessages"},
+		HistoryGetDLQReplicationMessagesScope:           {operation: "GetDLQReplicationMessages"},
+		HistoryReadDLQMessagesScope:                     {operation: "GetDLQMessages"},
+		HistoryPurgeDLQMessagesScope:                    {operation: "PurgeDLQMessages"},
+		HistoryMergeDLQMessagesScope:                    {operation: "MergeDLQMessages"},
+		HistoryShardControllerScope:                     {operation: "ShardController"},
+		HistoryReapplyEventsScope:                       {operation: "EventReapplication"},
+		HistoryRefreshWorkflowTasksScope:                {operation: "RefreshWorkflowTasks"},
+		HistoryGetReplicationStatusScope:                {operation: "GetReplicationStatus"},
+		HistoryStreamReplicationMessagesScope:           {operation: "StreamReplicationMessages"},
+		HistoryRecordWorkflowTaskHeartbeatScope:         {operation: "RecordWorkflowTaskHeartbeat"},
+		HistoryGenerateLastHistoryReplicationTasksScope: {operation: "GenerateLastHistoryReplicationTasks"},
+		HistoryCompactWorkflowHistoryScope:              {operation: "CompactWorkflowHistory"},
+		HistoryListThrottledCallersScope:                {operation: "ListThrottledCallers"},
+		HistoryGetShardStatsScope:                       {operation: "GetShardStats"},
+		HistoryGetHostProfileScope:                      {operation: "GetHostProfile"},
+		HistoryDescribeVisibilityProcessorScope:         {operation: "DescribeVisibilityProcessor"},
+		HistoryDeleteWorkflowExecutionScope:             {operation: "DeleteWorkflowExecution"},
+		HistoryHistoryRemoveTaskScope:                   {operation: "RemoveTask"},
+		HistoryCloseShard:                               {operation: "CloseShard"},
+		HistoryReplicateEventsV2:                        {operation: "ReplicateEventsV2"},
+		HistoryResetStickyTaskQueue:                     {operation: "ResetStickyTaskQueue"},
+		HistoryReapplyEvents:                            {operation: "ReapplyEvents"},
+		HistoryDescribeHistoryHost:                      {operation: "DescribeHistoryHost"},
+		TaskPriorityAssignerScope:                       {operation: "TaskPriorityAssigner"},
+		TransferQueueProcessorScope:                     {operation: "TransferQueueProcessor"},
+		TransferActiveQueueProcessorScope:               {operation: "TransferActiveQueueProcessor"},
+		TransferStandbyQueueProcessorScope:              {operation: "TransferStandbyQueueProcessor"},
+		TransferActiveTaskActivityScope:                 {operation: "TransferActiveTaskActivity"},
+		TransferActiveTaskWorkflowTaskScope:             {operation: "TransferActiveTaskWorkflowTask"},
+		TransferActiveTaskCloseExecutionScope:           {operation: "TransferActiveTaskCloseExecution"},
+		TransferActiveTaskCancelExecutionScope:          {operation: "TransferActiveTaskCancelExecution"},
+		TransferActiveTaskSignalExecutionScope:          {operation: "TransferActiveTaskSignalExecution"},
+		TransferActiveTaskStartChildExecutionScope:      {operation: "TransferActiveTaskStartChildExecution"},
+		TransferActiveTaskResetWorkflowScope:            {operation: "TransferActiveTaskResetWorkflow"},
+		TransferStandbyTaskActivityScope:                {operation: "TransferStandbyTaskActivity"},
+		TransferStandbyTaskWorkflowTaskScope:            {operation: "TransferStandbyTaskWorkflowTask"},
+		TransferStandbyTaskCloseExecutionScope:          {operation: "TransferStandbyTaskCloseExecution"},
+		TransferStandbyTaskCancelExecutionScope:         {operation: "TransferStandbyTaskCancelExecution"},
+		TransferStandbyTaskSignalExecutionScope:         {operation: "TransferStandbyTaskSignalExecution"},
+		TransferStandbyTaskStartChildExecutionScope:     {operation: "TransferStandbyTaskStartChildExecution"},
+		TransferStandbyTaskResetWorkflowScope:           {operation: "TransferStandbyTaskResetWorkflow"},
 
 		VisibilityQueueProcessorScope:      {operation: "VisibilityQueueProcessor"},
 		VisibilityTaskStartExecutionScope:  {operation: "VisibilityTaskStartExecution"},
@@ -1558,6 +1955,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		SessionSizeStatsScope:                     {operation: "SessionStats", tags: map[string]string{StatsTypeTagName: SizeStatsTypeTagValue}},
 		SessionCountStatsScope:                    {operation: "SessionStats", tags: map[string]string{StatsTypeTagName: CountStatsTypeTagValue}},
 		WorkflowCompletionStatsScope:              {operation: "CompletionStats", tags: map[string]string{StatsTypeTagName: CountStatsTypeTagValue}},
+		WorkflowCloseStatsScope:                   {operation: "CloseStats"},
 		ArchiverClientScope:                       {operation: "ArchiverClient"},
 		ReplicationTaskFetcherScope:               {operation: "ReplicationTaskFetcher"},
 		ReplicationTaskCleanupScope:               {operation: "ReplicationTaskCleanup"},
@@ -1570,16 +1968,23 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 	},
 	// Matching Scope Names
 	Matching: {
-		MatchingPollWorkflowTaskQueueScope:     {operation: "PollWorkflowTaskQueue"},
-		MatchingPollActivityTaskQueueScope:     {operation: "PollActivityTaskQueue"},
-		MatchingAddActivityTaskScope:           {operation: "AddActivityTask"},
-		MatchingAddWorkflowTaskScope:           {operation: "AddWorkflowTask"},
-		MatchingTaskQueueMgrScope:              {operation: "TaskQueueMgr"},
-		MatchingQueryWorkflowScope:             {operation: "QueryWorkflow"},
-		MatchingRespondQueryTaskCompletedScope: {operation: "RespondQueryTaskCompleted"},
-		MatchingCancelOutstandingPollScope:     {operation: "CancelOutstandingPoll"},
-		MatchingDescribeTaskQueueScope:         {operation: "DescribeTaskQueue"},
-		MatchingListTaskQueuePartitionsScope:   {operation: "ListTaskQueuePartitions"},
+		MatchingPollWorkflowTaskQueueScope:            {operation: "PollWorkflowTaskQueue"},
+		MatchingPollActivityTaskQueueScope:            {operation: "PollActivityTaskQueue"},
+		MatchingAddActivityTaskScope:                  {operation: "AddActivityTask"},
+		MatchingAddWorkflowTaskScope:                  {operation: "AddWorkflowTask"},
+		MatchingTaskQueueMgrScope:                     {operation: "TaskQueueMgr"},
+		MatchingQueryWorkflowScope:                    {operation: "QueryWorkflow"},
+		MatchingRespondQueryTaskCompletedScope:        {operation: "RespondQueryTaskCompleted"},
+		MatchingCancelOutstandingPollScope:            {operation: "CancelOutstandingPoll"},
+		MatchingDescribeTaskQueueScope:                {operation: "DescribeTaskQueue"},
+		MatchingListTaskQueuePartitionsScope:          {operation: "ListTaskQueuePartitions"},
+		MatchingDescribeTaskQueuePartitionsScope:      {operation: "DescribeTaskQueuePartitions"},
+		MatchingGetTaskQueueUserDataScope:             {operation: "GetTaskQueueUserData"},
+		MatchingUpdateTaskQueueUserDataScope:          {operation: "UpdateTaskQueueUserData"},
+		MatchingUpdateWorkerBuildIdCompatibilityScope: {operation: "UpdateWorkerBuildIdCompatibility"},
+		MatchingGetWorkerBuildIdCompatibilityScope:    {operation: "GetWorkerBuildIdCompatibility"},
+		MatchingEvictStickyTaskQueueScope:             {operation: "EvictStickyTaskQueue"},
+		MatchingGetHostProfileScope:                   {operation: "GetHostProfile"},
 	},
 	// Worker Scope Names
 	Worker: {
@@ -1593,6 +1998,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		IndexProcessorScope:                    {operation: "IndexProcessor"},
 		ArchiverDeleteHistoryActivityScope:     {operation: "ArchiverDeleteHistoryActivity"},
 		ArchiverUploadHistoryActivityScope:     {operation: "ArchiverUploadHistoryActivity"},
+		ArchiverVerifyHistoryActivityScope:     {operation: "ArchiverVerifyHistoryActivity"},
 		ArchiverArchiveVisibilityActivityScope: {operation: "ArchiverArchiveVisibilityActivity"},
 		ArchiverScope:                          {operation: "Archiver"},
 		ArchiverPumpScope:                      {operation: "ArchiverPump"},
@@ -1602,7 +2008,12 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryScavengerScope:                  {operation: "historyscavenger"},
 		BatcherScope:                           {operation: "batcher"},
 		ParentClosePolicyProcessorScope:        {operation: "ParentClosePolicyProcessor"},
+		CallbackProcessorScope:                 {operation: "CallbackProcessor"},
 		AddSearchAttributesWorkflowScope:       {operation: "AddSearchAttributesWorkflow"},
+		SearchAttributeMetricsScope:            {operation: "SearchAttributeMetrics"},
+		ForceReplicationScope:                  {operation: "ForceReplication"},
+		SchedulerScope:                         {operation: "Scheduler"},
+		DeleteNamespaceScope:                   {operation: "DeleteNamespace"},
 	},
 }
 
@@ -1613,9 +2024,13 @@ const (
 	ServiceCriticalFailures
 	ServiceLatency
 	ServiceLatencyNoUserLatency
+	ServiceLatencyHistogram
 	ServiceErrInvalidArgumentCounter
 	ServiceErrNamespaceNotActiveCounter
 	ServiceErrResourceExhaustedCounter
+	ServiceErrNamespaceRateLimitedCounter
+	ServiceErrConcurrencyLimitedCounter
+	ServiceConcurrencyLimit
 	ServiceErrNotFoundCounter
 	ServiceErrExecutionAlreadyStartedCounter
 	ServiceErrNamespaceAlreadyExistsCounter
@@ -1630,6 +2045,12 @@ const (
 	ServiceErrNonDeterministicCounter
 	ServiceErrUnauthorizedCounter
 	ServiceErrAuthorizeFailedCounter
+	ServiceErrUnauthorizedLogOnlyCounter
+
+	ServiceSLOAvailability
+	ServiceSLOAvailabilityBurnRate
+	ServiceSLOLatency
+	ServiceSLOLatencyBurnRate
 
 	PersistenceRequests
 	PersistenceFailures
@@ -1656,13 +2077,30 @@ const (
 
 	ServiceAuthorizationLatency
 
+	TokenKeyRefreshRequests
+	TokenKeyRefreshFailures
+	TokenKeyRefreshLatency
+	TokenKeyRotations
+	TokenKeyCount
+	TokenKeyNotFound
+
+	AuditLogRecords
+	AuditLogFailures
+	AuditLogDropped
+
 	NamespaceCachePrepareCallbacksLatency
 	NamespaceCacheCallbacksLatency
 
 	StateTransitionCount
+	StateTransitionCountLimitWarnCount
+	StateTransitionCountLimitExceededCount
+	MutableStateSizeLimitWarnCount
+	MutableStateSizeLimitExceededCount
+	HistorySizeLimitExceededCount
 	HistorySize
 	HistoryCount
 	EventBlobSize
+	EventBlobDataSize
 	SearchAttributesSize
 
 	LockRequests
@@ -1750,10 +2188,27 @@ const (
 	ParentClosePolicyProcessorSuccess
 	ParentClosePolicyProcessorFailures
 
+	CallbackProcessorSuccess
+	CallbackProcessorFailures
+	CallbackProcessorDLQ
+	CallbackRejected
+
 	AddSearchAttributesWorkflowSuccessCount
 	AddSearchAttributesWorkflowFailuresCount
 
 	ElasticsearchInvalidSearchAttributeCount
+	ElasticsearchUnknownFieldCount
+	ElasticsearchInvalidDocumentCount
+	ElasticsearchOpenPointInTimeCount
+	ElasticsearchPointInTimeReapedCount
+
+	VisibilitySinkRequests
+	VisibilitySinkFailures
+	VisibilitySinkLatency
+
+	VisibilityPersistenceFallbackCount
+
+	DynamicConfigChangeCount
 
 	NumCommonMetrics // Needs to be last on this list for iota numbering
 )
@@ -1767,11 +2222,19 @@ const (
 	TaskAttemptTimer
 	TaskStandbyRetryCounter
 	TaskNotActiveCounter
+	TaskNamespaceHandoverCounter
 	TaskLimitExceededCounter
+	TaskIsolationGroupThrottledCounter
+	TaskWorkerPoolSize
+	TaskWorkerPoolScaleUpCounter
+	TaskWorkerPoolScaleDownCounter
+	TaskWorkerPoolLatencyBackoffCounter
 	TaskBatchCompleteCounter
 	TaskProcessingLatency
 	TaskQueueLatency
 	TaskRedispatchQueuePendingTasksTimer
+	VisibilityTaskVisibleLatency
+	VisibilityCloseLag
 
 	TransferTaskMissingEventCounter
 
@@ -1832,13 +2295,22 @@ const (
 	SyncShardFromRemoteCounter
 	SyncShardFromRemoteFailure
 	MembershipChangedCounter
+	ShardAcquisitionDeferredCounter
+	ShardMovementAvoidedCounter
+	ShardOwnershipVerifiedCounter
+	ShardOwnershipLostOnRejoinCounter
+	ShardHibernatedCounter
+	ShardReactivatedCounter
 	NumShardsGauge
+	NumHibernatedShardsGauge
 	GetEngineForShardErrorCounter
 	GetEngineForShardLatency
 	RemoveEngineForShardLatency
 	CompleteWorkflowTaskWithStickyEnabledCounter
 	CompleteWorkflowTaskWithStickyDisabledCounter
 	WorkflowTaskHeartbeatTimeoutCounter
+	WorkflowTaskExtendedCounter
+	WorkflowTaskExtendedRunningTime
 	HistoryEventNotificationQueueingLatency
 	HistoryEventNotificationFanoutLatency
 	HistoryEventNotificationInFlightMessageGauge
@@ -1857,6 +2329,7 @@ const (
 	CacheMissCounter
 	AcquireLockFailedCounter
 	WorkflowContextCleared
+	CurrentExecutionLoadDeduplicated
 	MutableStateSize
 	ExecutionInfoSize
 	ActivityInfoSize
@@ -1877,6 +2350,7 @@ const (
 	DeleteRequestCancelInfoCount
 	WorkflowRetryBackoffTimerCount
 	WorkflowCronBackoffTimerCount
+	WorkflowDelayStartBackoffTimerCount
 	WorkflowCleanupDeleteCount
 	WorkflowCleanupArchiveCount
 	WorkflowCleanupNopCount
@@ -1886,6 +2360,11 @@ const (
 	WorkflowFailedCount
 	WorkflowTimeoutCount
 	WorkflowTerminateCount
+	WorkflowExecutionDuration
+	WorkflowCloseHistorySize
+	WorkflowCloseHistoryCount
+	WorkflowCloseStateTransitionCount
+	WorkflowClosePayloadSize
 	ArchiverClientSendSignalCount
 	ArchiverClientSendSignalFailureCount
 	ArchiverClientHistoryRequestCount
@@ -1916,6 +2395,8 @@ const (
 	DirectQueryDispatchClearStickinessLatency
 	DirectQueryDispatchClearStickinessSuccessCount
 	DirectQueryDispatchTimeoutBeforeNonStickyCount
+	DirectQueryDispatchStickyMissCount
+	StickyWorkflowTaskFallbackCount
 	WorkflowTaskQueryLatency
 	ConsistentQueryTimeoutCount
 	QueryBeforeFirstWorkflowTaskCount
@@ -1928,6 +2409,8 @@ const (
 	ReplicationTaskCleanupFailure
 	MutableStateChecksumMismatch
 	MutableStateChecksumInvalidated
+	MutableStateBranchTokenMismatch
+	OpenWorkflowCountLimitExceededCount
 
 	ElasticsearchBulkProcessorRequests
 	ElasticsearchBulkProcessorRetries
@@ -1937,6 +2420,15 @@ const (
 	ElasticsearchBulkProcessorCommitLatency
 	ElasticsearchBulkProcessorWaitLatency
 	ElasticsearchBulkProcessorBulkSize
+	ElasticsearchBulkProcessorBackfills
+	ElasticsearchBulkProcessorDrainNacks
+	ElasticsearchBulkProcessorDrainTimeouts
+	ElasticsearchBulkProcessorDrainLatency
+
+	VisibilityExporterRecords
+	VisibilityExporterFailures
+	VisibilityExporterDropped
+	VisibilityExporterLatency
 
 	NumHistoryMetrics
 )
@@ -1950,8 +2442,10 @@ const (
 	LeaseFailurePerTaskQueueCounter
 	ConditionFailedErrorPerTaskQueueCounter
 	RespondQueryTaskFailedPerTaskQueueCounter
+	StickyQueryDispatchTimeoutPerTaskQueueCounter
 	SyncThrottlePerTaskQueueCounter
 	BufferThrottlePerTaskQueueCounter
+	IsolationGroupThrottlePerTaskQueueCounter
 	SyncMatchLatencyPerTaskQueue
 	AsyncMatchLatencyPerTaskQueue
 	ExpiredTasksPerTaskQueueCounter
@@ -1969,6 +2463,13 @@ const (
 	LocalToRemoteMatchPerTaskQueueCounter
 	RemoteToLocalMatchPerTaskQueueCounter
 	RemoteToRemoteMatchPerTaskQueueCounter
+	SyncMatchPerTaskQueueCounter
+	BacklogWritePerTaskQueueCounter
+	TaskBacklogAgePerTaskQueueGauge
+	TaskAddRatePerTaskQueueGauge
+	TaskDispatchRatePerTaskQueueGauge
+	StickyTaskQueueEvictedCounter
+	StickyTaskQueueEvictedRejectCounter
 
 	NumMatchingMetrics
 )
@@ -1989,10 +2490,15 @@ const (
 	ArchiverHandleVisibilityRequestLatency
 	ArchiverUploadWithRetriesLatency
 	ArchiverDeleteWithRetriesLatency
+	ArchiverVerifyWithRetriesLatency
 	ArchiverUploadFailedAllRetriesCount
 	ArchiverUploadSuccessCount
 	ArchiverDeleteFailedAllRetriesCount
 	ArchiverDeleteSuccessCount
+	ArchiverDeleteSkippedCount
+	ArchiverVerifySuccessCount
+	ArchiverVerifyMismatchCount
+	ArchiverVerifyFailedAllRetriesCount
 	ArchiverHandleVisibilityFailedAllRetiresCount
 	ArchiverHandleVisibilitySuccessCount
 	ArchiverBacklogSizeGauge
@@ -2028,6 +2534,18 @@ const (
 	ScavengerValidationRequestsCount
 	ScavengerValidationFailuresCount
 	AddSearchAttributesFailuresCount
+	SearchAttributeWorkflowCount
+	SearchAttributeMetricsFailuresCount
+	ForceReplicationWorkflowsReplicatedCount
+	ForceReplicationWorkflowsSkippedCount
+	ForceReplicationFailures
+	ScheduleActionSuccess
+	ScheduleActionErrors
+	DeleteNamespaceWorkflowsTerminatedCount
+	DeleteNamespaceWorkflowsDeletedCount
+	DeleteNamespaceWorkflowsSkippedCount
+	DeleteNamespaceTaskQueuesDeletedCount
+	DeleteNamespaceFailures
 
 	NumWorkerMetrics
 )
@@ -2040,9 +2558,13 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ServiceCriticalFailures:                             {metricName: "service_errors_critical", metricType: Counter},
 		ServiceLatency:                                      {metricName: "service_latency", metricType: Timer},
 		ServiceLatencyNoUserLatency:                         {metricName: "service_latency_nouserlatency", metricType: Timer},
+		ServiceLatencyHistogram:                             {metricName: "service_latency_histogram", metricType: Histogram, unit: Milliseconds},
 		ServiceErrInvalidArgumentCounter:                    {metricName: "service_errors_invalid_argument", metricType: Counter},
 		ServiceErrNamespaceNotActiveCounter:                 {metricName: "service_errors_namespace_not_active", metricType: Counter},
 		ServiceErrResourceExhaustedCounter:                  {metricName: "service_errors_resource_exhausted", metricType: Counter},
+		ServiceErrNamespaceRateLimitedCounter:               {metricName: "service_errors_namespace_rate_limited", metricType: Counter},
+		ServiceErrConcurrencyLimitedCounter:                 {metricName: "service_errors_concurrency_limited", metricType: Counter},
+		ServiceConcurrencyLimit:                             {metricName: "service_concurrency_limit", metricType: Gauge},
 		ServiceErrNotFoundCounter:                           {metricName: "service_errors_entity_not_found", metricType: Counter},
 		ServiceErrExecutionAlreadyStartedCounter:            {metricName: "service_errors_execution_already_started", metricType: Counter},
 		ServiceErrNamespaceAlreadyExistsCounter:             {metricName: "service_errors_namespace_already_exists", metricType: Counter},
@@ -2057,6 +2579,11 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ServiceErrNonDeterministicCounter:                   {metricName: "service_errors_nondeterministic", metricType: Counter},
 		ServiceErrUnauthorizedCounter:                       {metricName: "service_errors_unauthorized", metricType: Counter},
 		ServiceErrAuthorizeFailedCounter:                    {metricName: "service_errors_authorize_failed", metricType: Counter},
+		ServiceErrUnauthorizedLogOnlyCounter:                {metricName: "service_errors_unauthorized_log_only", metricType: Counter},
+		ServiceSLOAvailability:                              {metricName: "service_slo_availability", metricType: Gauge},
+		ServiceSLOAvailabilityBurnRate:                      {metricName: "service_slo_availability_burn_rate", metricType: Gauge},
+		ServiceSLOLatency:                                   {metricName: "service_slo_latency", metricType: Gauge},
+		ServiceSLOLatencyBurnRate:                           {metricName: "service_slo_latency_burn_rate", metricType: Gauge},
 		PersistenceRequests:                                 {metricName: "persistence_requests", metricType: Counter},
 		PersistenceFailures:                                 {metricName: "persistence_errors", metricType: Counter},
 		PersistenceLatency:                                  {metricName: "persistence_latency", metricType: Timer},
@@ -2078,12 +2605,27 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ClientRedirectionFailures:                           {metricName: "client_redirection_errors", metricType: Counter},
 		ClientRedirectionLatency:                            {metricName: "client_redirection_latency", metricType: Timer},
 		ServiceAuthorizationLatency:                         {metricName: "service_authorization_latency", metricType: Timer},
+		TokenKeyRefreshRequests:                             {metricName: "token_key_refresh_requests", metricType: Counter},
+		TokenKeyRefreshFailures:                             {metricName: "token_key_refresh_failures", metricType: Counter},
+		TokenKeyRefreshLatency:                              {metricName: "token_key_refresh_latency", metricType: Timer},
+		TokenKeyRotations:                                   {metricName: "token_key_rotations", metricType: Counter},
+		TokenKeyCount:                                       {metricName: "token_keys", metricType: Gauge},
+		TokenKeyNotFound:                                    {metricName: "token_key_not_found", metricType: Counter},
+		AuditLogRecords:                                     {metricName: "audit_log_records", metricType: Counter},
+		AuditLogFailures:                                    {metricName: "audit_log_errors", metricType: Counter},
+		AuditLogDropped:                                     {metricName: "audit_log_dropped", metricType: Counter},
 		NamespaceCachePrepareCallbacksLatency:               {metricName: "namespace_cache_prepare_callbacks_latency", metricType: Timer},
 		NamespaceCacheCallbacksLatency:                      {metricName: "namespace_cache_callbacks_latency", metricType: Timer},
 		StateTransitionCount:                                {metricName: "state_transition_count", metricType: Timer},
+		StateTransitionCountLimitWarnCount:                  {metricName: "state_transition_count_limit_warn", metricType: Counter},
+		StateTransitionCountLimitExceededCount:              {metricName: "state_transition_count_limit_exceeded", metricType: Counter},
+		MutableStateSizeLimitWarnCount:                      {metricName: "mutable_state_size_limit_warn", metricType: Counter},
+		MutableStateSizeLimitExceededCount:                  {metricName: "mutable_state_size_limit_exceeded", metricType: Counter},
+		HistorySizeLimitExceededCount:                       {metricName: "history_size_limit_exceeded", metricType: Counter},
 		HistorySize:                                         {metricName: "history_size", metricType: Timer},
 		HistoryCount:                                        {metricName: "history_count", metricType: Timer},
 		EventBlobSize:                                       {metricName: "event_blob_size", metricType: Timer},
+		EventBlobDataSize:                                   {metricName: "event_blob_data_size", metricType: Timer},
 		SearchAttributesSize:                                {metricName: "search_attributes_size", metricType: Timer},
 		LockRequests:                                        {metricName: "lock_requests", metricType: Counter},
 		LockFailures:                                        {metricName: "lock_failures", metricType: Counter},
@@ -2131,6 +2673,11 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ParentClosePolicyProcessorSuccess:  {metricName: "parent_close_policy_processor_requests", metricType: Counter},
 		ParentClosePolicyProcessorFailures: {metricName: "parent_close_policy_processor_errors", metricType: Counter},
 
+		CallbackProcessorSuccess:  {metricName: "callback_processor_requests", metricType: Counter},
+		CallbackProcessorFailures: {metricName: "callback_processor_errors", metricType: Counter},
+		CallbackProcessorDLQ:      {metricName: "callback_processor_dlq", metricType: Counter},
+		CallbackRejected:          {metricName: "callback_rejected", metricType: Counter},
+
 		AddSearchAttributesWorkflowSuccessCount:  {metricName: "add_search_attributes_workflow_success", metricType: Counter},
 		AddSearchAttributesWorkflowFailuresCount: {metricName: "add_search_attributes_workflow_failure", metricType: Counter},
 
@@ -2201,6 +2748,17 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 			metricName: "service_errors_authorize_failed_per_tl", metricRollupName: "service_errors_authorize_failed", metricType: Counter,
 		},
 		ElasticsearchInvalidSearchAttributeCount: {metricName: "elasticsearch_invalid_search_attribute_counter", metricType: Counter},
+		ElasticsearchUnknownFieldCount:           {metricName: "elasticsearch_unknown_field_counter", metricType: Counter},
+		ElasticsearchInvalidDocumentCount:        {metricName: "elasticsearch_invalid_document_counter", metricType: Counter},
+		ElasticsearchOpenPointInTimeCount:        {metricName: "elasticsearch_open_point_in_time", metricType: Gauge},
+		ElasticsearchPointInTimeReapedCount:      {metricName: "elasticsearch_point_in_time_reaped", metricType: Counter},
+
+		VisibilitySinkRequests: {metricName: "visibility_sink_requests", metricType: Counter},
+		VisibilitySinkFailures: {metricName: "visibility_sink_errors", metricType: Counter},
+		VisibilitySinkLatency:  {metricName: "visibility_sink_latency", metricType: Timer},
+
+		VisibilityPersistenceFallbackCount: {metricName: "visibility_persistence_fallback", metricType: Counter},
+		DynamicConfigChangeCount:           {metricName: "dynamic_config_changes", metricType: Counter},
 	},
 	History: {
 		TaskRequests:                                      {metricName: "task_requests", metricType: Counter},
@@ -2209,10 +2767,18 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		TaskFailures:                                      {metricName: "task_errors", metricType: Counter},
 		TaskDiscarded:                                     {metricName: "task_errors_discarded", metricType: Counter},
 		TaskStandbyRetryCounter:                           {metricName: "task_errors_standby_retry_counter", metricType: Counter},
+		TaskNamespaceHandoverCounter:                      {metricName: "task_errors_namespace_handover", metricType: Counter},
 		TaskNotActiveCounter:                              {metricName: "task_errors_not_active_counter", metricType: Counter},
 		TaskLimitExceededCounter:                          {metricName: "task_errors_limit_exceeded_counter", metricType: Counter},
+		TaskIsolationGroupThrottledCounter:                {metricName: "task_isolation_group_throttled", metricType: Counter},
+		TaskWorkerPoolSize:                                {metricName: "task_worker_pool_size", metricType: Gauge},
+		TaskWorkerPoolScaleUpCounter:                      {metricName: "task_worker_pool_scale_up", metricType: Counter},
+		TaskWorkerPoolScaleDownCounter:                    {metricName: "task_worker_pool_scale_down", metricType: Counter},
+		TaskWorkerPoolLatencyBackoffCounter:               {metricName: "task_worker_pool_latency_backoff", metricType: Counter},
 		TaskProcessingLatency:                             {metricName: "task_latency_processing", metricType: Timer},
 		TaskQueueLatency:                                  {metricName: "task_latency_queue", metricType: Timer},
+		VisibilityTaskVisibleLatency:                      {metricName: "visibility_task_visible_latency", metricType: Timer},
+		VisibilityCloseLag:                                {metricName: "visibility_close_lag", metricType: Timer},
 		TransferTaskMissingEventCounter:                   {metricName: "transfer_task_missing_event_counter", metricType: Counter},
 		TaskBatchCompleteCounter:                          {metricName: "task_batch_complete_counter", metricType: Counter},
 		TaskRedispatchQueuePendingTasksTimer:              {metricName: "task_redispatch_queue_pending_tasks", metricType: Timer},
@@ -2272,13 +2838,22 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		SyncShardFromRemoteCounter:                        {metricName: "syncshard_remote_count", metricType: Counter},
 		SyncShardFromRemoteFailure:                        {metricName: "syncshard_remote_failed", metricType: Counter},
 		MembershipChangedCounter:                          {metricName: "membership_changed_count", metricType: Counter},
+		ShardAcquisitionDeferredCounter:                   {metricName: "shard_acquisition_deferred", metricType: Counter},
+		ShardMovementAvoidedCounter:                       {metricName: "shard_movement_avoided", metricType: Counter},
+		ShardOwnershipVerifiedCounter:                     {metricName: "shard_ownership_verified", metricType: Counter},
+		ShardOwnershipLostOnRejoinCounter:                 {metricName: "shard_ownership_lost_on_rejoin", metricType: Counter},
+		ShardHibernatedCounter:                            {metricName: "shard_hibernated", metricType: Counter},
+		ShardReactivatedCounter:                           {metricName: "shard_reactivated", metricType: Counter},
 		NumShardsGauge:                                    {metricName: "numshards_gauge", metricType: Gauge},
+		NumHibernatedShardsGauge:                          {metricName: "num_hibernated_shards_gauge", metricType: Gauge},
 		GetEngineForShardErrorCounter:                     {metricName: "get_engine_for_shard_errors", metricType: Counter},
 		GetEngineForShardLatency:                          {metricName: "get_engine_for_shard_latency", metricType: Timer},
 		RemoveEngineForShardLatency:                       {metricName: "remove_engine_for_shard_latency", metricType: Timer},
 		CompleteWorkflowTaskWithStickyEnabledCounter:      {metricName: "complete_workflow_task_sticky_enabled_count", metricType: Counter},
 		CompleteWorkflowTaskWithStickyDisabledCounter:     {metricName: "complete_workflow_task_sticky_disabled_count", metricType: Counter},
 		WorkflowTaskHeartbeatTimeoutCounter:               {metricName: "workflow_task_heartbeat_timeout_count", metricType: Counter},
+		WorkflowTaskExtendedCounter:                       {metricName: "workflow_task_extended_count", metricType: Counter},
+		WorkflowTaskExtendedRunningTime:                   {metricName: "workflow_task_extended_running_time", metricType: Timer},
 		HistoryEventNotificationQueueingLatency:           {metricName: "history_event_notification_queueing_latency", metricType: Timer},
 		HistoryEventNotificationFanoutLatency:             {metricName: "history_event_notification_fanout_latency", metricType: Timer},
 		HistoryEventNotificationInFlightMessageGauge:      {metricName: "history_event_notification_inflight_message_gauge", metricType: Gauge},
@@ -2297,6 +2872,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		CacheMissCounter:                                  {metricName: "cache_miss", metricType: Counter},
 		AcquireLockFailedCounter:                          {metricName: "acquire_lock_failed", metricType: Counter},
 		WorkflowContextCleared:                            {metricName: "workflow_context_cleared", metricType: Counter},
+		CurrentExecutionLoadDeduplicated:                  {metricName: "current_execution_load_deduplicated", metricType: Counter},
 		MutableStateSize:                                  {metricName: "mutable_state_size", metricType: Timer},
 		ExecutionInfoSize:                                 {metricName: "execution_info_size", metricType: Timer},
 		ActivityInfoSize:                                  {metricName: "activity_info_size", metricType: Timer},
@@ -2317,6 +2893,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		DeleteRequestCancelInfoCount:                      {metricName: "delete_request_cancel_info", metricType: Timer},
 		WorkflowRetryBackoffTimerCount:                    {metricName: "workflow_retry_backoff_timer", metricType: Counter},
 		WorkflowCronBackoffTimerCount:                     {metricName: "workflow_cron_backoff_timer", metricType: Counter},
+		WorkflowDelayStartBackoffTimerCount:               {metricName: "workflow_delay_start_backoff_timer", metricType: Counter},
 		WorkflowCleanupDeleteCount:                        {metricName: "workflow_cleanup_delete", metricType: Counter},
 		WorkflowCleanupArchiveCount:                       {metricName: "workflow_cleanup_archive", metricType: Counter},
 		WorkflowCleanupNopCount:                           {metricName: "workflow_cleanup_nop", metricType: Counter},
@@ -2326,6 +2903,11 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		WorkflowFailedCount:                               {metricName: "workflow_failed", metricType: Counter},
 		WorkflowTimeoutCount:                              {metricName: "workflow_timeout", metricType: Counter},
 		WorkflowTerminateCount:                            {metricName: "workflow_terminate", metricType: Counter},
+		WorkflowExecutionDuration:                         {metricName: "workflow_execution_duration", metricType: Timer},
+		WorkflowCloseHistorySize:                          {metricName: "workflow_close_history_size", metricType: Timer},
+		WorkflowCloseHistoryCount:                         {metricName: "workflow_close_history_count", metricType: Timer},
+		WorkflowCloseStateTransitionCount:                 {metricName: "workflow_close_state_transition_count", metricType: Timer},
+		WorkflowClosePayloadSize:                          {metricName: "workflow_close_payload_size", metricType: Timer},
 		ArchiverClientSendSignalCount:                     {metricName: "archiver_client_sent_signal", metricType: Counter},
 		ArchiverClientSendSignalFailureCount:              {metricName: "archiver_client_send_signal_error", metricType: Counter},
 		ArchiverClientHistoryRequestCount:                 {metricName: "archiver_client_history_request", metricType: Counter},
@@ -2356,6 +2938,8 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		DirectQueryDispatchClearStickinessLatency:         {metricName: "direct_query_dispatch_clear_stickiness_latency", metricType: Timer},
 		DirectQueryDispatchClearStickinessSuccessCount:    {metricName: "direct_query_dispatch_clear_stickiness_success", metricType: Counter},
 		DirectQueryDispatchTimeoutBeforeNonStickyCount:    {metricName: "direct_query_dispatch_timeout_before_non_sticky", metricType: Counter},
+		DirectQueryDispatchStickyMissCount:                {metricName: "direct_query_dispatch_sticky_miss", metricType: Counter},
+		StickyWorkflowTaskFallbackCount:                   {metricName: "sticky_workflow_task_fallback", metricType: Counter},
 		WorkflowTaskQueryLatency:                          {metricName: "workflow_task_query_latency", metricType: Timer},
 		ConsistentQueryTimeoutCount:                       {metricName: "consistent_query_timeout", metricType: Counter},
 		QueryBeforeFirstWorkflowTaskCount:                 {metricName: "query_before_first_workflow_task", metricType: Counter},
@@ -2368,6 +2952,8 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ReplicationTaskCleanupFailure:                     {metricName: "replication_task_cleanup_failed", metricType: Counter},
 		MutableStateChecksumMismatch:                      {metricName: "mutable_state_checksum_mismatch", metricType: Counter},
 		MutableStateChecksumInvalidated:                   {metricName: "mutable_state_checksum_invalidated", metricType: Counter},
+		MutableStateBranchTokenMismatch:                   {metricName: "mutable_state_branch_token_mismatch", metricType: Counter},
+		OpenWorkflowCountLimitExceededCount:               {metricName: "open_workflow_count_limit_exceeded", metricType: Counter},
 
 		ElasticsearchBulkProcessorRequests:       {metricName: "elasticsearch_bulk_processor_requests"},
 		ElasticsearchBulkProcessorRetries:        {metricName: "elasticsearch_bulk_processor_retries"},
@@ -2377,34 +2963,52 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ElasticsearchBulkProcessorCommitLatency:  {metricName: "elasticsearch_bulk_processor_commit_latency", metricType: Timer},
 		ElasticsearchBulkProcessorWaitLatency:    {metricName: "elasticsearch_bulk_processor_wait_latency", metricType: Timer},
 		ElasticsearchBulkProcessorBulkSize:       {metricName: "elasticsearch_bulk_processor_bulk_size", metricType: Timer},
+		ElasticsearchBulkProcessorBackfills:      {metricName: "elasticsearch_bulk_processor_backfill_requests"},
+		ElasticsearchBulkProcessorDrainNacks:     {metricName: "elasticsearch_bulk_processor_drain_nacks"},
+		ElasticsearchBulkProcessorDrainTimeouts:  {metricName: "elasticsearch_bulk_processor_drain_timeouts"},
+		ElasticsearchBulkProcessorDrainLatency:   {metricName: "elasticsearch_bulk_processor_drain_latency", metricType: Timer},
+
+		VisibilityExporterRecords:  {metricName: "visibility_exporter_records", metricType: Counter},
+		VisibilityExporterFailures: {metricName: "visibility_exporter_errors", metricType: Counter},
+		VisibilityExporterDropped:  {metricName: "visibility_exporter_dropped", metricType: Counter},
+		VisibilityExporterLatency:  {metricName: "visibility_exporter_latency", metricType: Timer},
 	},
 	Matching: {
-		PollSuccessPerTaskQueueCounter:            {metricName: "poll_success_per_tl", metricRollupName: "poll_success"},
-		PollTimeoutPerTaskQueueCounter:            {metricName: "poll_timeouts_per_tl", metricRollupName: "poll_timeouts"},
-		PollSuccessWithSyncPerTaskQueueCounter:    {metricName: "poll_success_sync_per_tl", metricRollupName: "poll_success_sync"},
-		LeaseRequestPerTaskQueueCounter:           {metricName: "lease_requests_per_tl", metricRollupName: "lease_requests"},
-		LeaseFailurePerTaskQueueCounter:           {metricName: "lease_failures_per_tl", metricRollupName: "lease_failures"},
-		ConditionFailedErrorPerTaskQueueCounter:   {metricName: "condition_failed_errors_per_tl", metricRollupName: "condition_failed_errors"},
-		RespondQueryTaskFailedPerTaskQueueCounter: {metricName: "respond_query_failed_per_tl", metricRollupName: "respond_query_failed"},
-		SyncThrottlePerTaskQueueCounter:           {metricName: "sync_throttle_count_per_tl", metricRollupName: "sync_throttle_count"},
-		BufferThrottlePerTaskQueueCounter:         {metricName: "buffer_throttle_count_per_tl", metricRollupName: "buffer_throttle_count"},
-		ExpiredTasksPerTaskQueueCounter:           {metricName: "tasks_expired_per_tl", metricRollupName: "tasks_expired"},
-		ForwardedPerTaskQueueCounter:              {metricName: "forwarded_per_tl"},
-		ForwardTaskCallsPerTaskQueue:              {metricName: "forward_task_calls_per_tl", metricRollupName: "forward_task_calls"},
-		ForwardTaskErrorsPerTaskQueue:             {metricName: "forward_task_errors_per_tl", metricRollupName: "forward_task_errors"},
-		ForwardQueryCallsPerTaskQueue:             {metricName: "forward_query_calls_per_tl", metricRollupName: "forward_query_calls"},
-		ForwardQueryErrorsPerTaskQueue:            {metricName: "forward_query_errors_per_tl", metricRollupName: "forward_query_errors"},
-		ForwardPollCallsPerTaskQueue:              {metricName: "forward_poll_calls_per_tl", metricRollupName: "forward_poll_calls"},
-		ForwardPollErrorsPerTaskQueue:             {metricName: "forward_poll_errors_per_tl", metricRollupName: "forward_poll_errors"},
-		SyncMatchLatencyPerTaskQueue:              {metricName: "syncmatch_latency_per_tl", metricRollupName: "syncmatch_latency", metricType: Timer},
-		AsyncMatchLatencyPerTaskQueue:             {metricName: "asyncmatch_latency_per_tl", metricRollupName: "asyncmatch_latency", metricType: Timer},
-		ForwardTaskLatencyPerTaskQueue:            {metricName: "forward_task_latency_per_tl", metricRollupName: "forward_task_latency"},
-		ForwardQueryLatencyPerTaskQueue:           {metricName: "forward_query_latency_per_tl", metricRollupName: "forward_query_latency"},
-		ForwardPollLatencyPerTaskQueue:            {metricName: "forward_poll_latency_per_tl", metricRollupName: "forward_poll_latency"},
-		LocalToLocalMatchPerTaskQueueCounter:      {metricName: "local_to_local_matches_per_tl", metricRollupName: "local_to_local_matches"},
-		LocalToRemoteMatchPerTaskQueueCounter:     {metricName: "local_to_remote_matches_per_tl", metricRollupName: "local_to_remote_matches"},
-		RemoteToLocalMatchPerTaskQueueCounter:     {metricName: "remote_to_local_matches_per_tl", metricRollupName: "remote_to_local_matches"},
-		RemoteToRemoteMatchPerTaskQueueCounter:    {metricName: "remote_to_remote_matches_per_tl", metricRollupName: "remote_to_remote_matches"},
+		PollSuccessPerTaskQueueCounter:                {metricName: "poll_success_per_tl", metricRollupName: "poll_success"},
+		PollTimeoutPerTaskQueueCounter:                {metricName: "poll_timeouts_per_tl", metricRollupName: "poll_timeouts"},
+		PollSuccessWithSyncPerTaskQueueCounter:        {metricName: "poll_success_sync_per_tl", metricRollupName: "poll_success_sync"},
+		LeaseRequestPerTaskQueueCounter:               {metricName: "lease_requests_per_tl", metricRollupName: "lease_requests"},
+		LeaseFailurePerTaskQueueCounter:               {metricName: "lease_failures_per_tl", metricRollupName: "lease_failures"},
+		ConditionFailedErrorPerTaskQueueCounter:       {metricName: "condition_failed_errors_per_tl", metricRollupName: "condition_failed_errors"},
+		RespondQueryTaskFailedPerTaskQueueCounter:     {metricName: "respond_query_failed_per_tl", metricRollupName: "respond_query_failed"},
+		StickyQueryDispatchTimeoutPerTaskQueueCounter: {metricName: "sticky_query_dispatch_timeout_per_tl", metricRollupName: "sticky_query_dispatch_timeout"},
+		SyncThrottlePerTaskQueueCounter:               {metricName: "sync_throttle_count_per_tl", metricRollupName: "sync_throttle_count"},
+		BufferThrottlePerTaskQueueCounter:             {metricName: "buffer_throttle_count_per_tl", metricRollupName: "buffer_throttle_count"},
+		IsolationGroupThrottlePerTaskQueueCounter:     {metricName: "isolation_group_throttle_count_per_tl", metricRollupName: "isolation_group_throttle_count"},
+		ExpiredTasksPerTaskQueueCounter:               {metricName: "tasks_expired_per_tl", metricRollupName: "tasks_expired"},
+		ForwardedPerTaskQueueCounter:                  {metricName: "forwarded_per_tl"},
+		ForwardTaskCallsPerTaskQueue:                  {metricName: "forward_task_calls_per_tl", metricRollupName: "forward_task_calls"},
+		ForwardTaskErrorsPerTaskQueue:                 {metricName: "forward_task_errors_per_tl", metricRollupName: "forward_task_errors"},
+		ForwardQueryCallsPerTaskQueue:                 {metricName: "forward_query_calls_per_tl", metricRollupName: "forward_query_calls"},
+		ForwardQueryErrorsPerTaskQueue:                {metricName: "forward_query_errors_per_tl", metricRollupName: "forward_query_errors"},
+		ForwardPollCallsPerTaskQueue:                  {metricName: "forward_poll_calls_per_tl", metricRollupName: "forward_poll_calls"},
+		ForwardPollErrorsPerTaskQueue:                 {metricName: "forward_poll_errors_per_tl", metricRollupName: "forward_poll_errors"},
+		SyncMatchLatencyPerTaskQueue:                  {metricName: "syncmatch_latency_per_tl", metricRollupName: "syncmatch_latency", metricType: Timer},
+		AsyncMatchLatencyPerTaskQueue:                 {metricName: "asyncmatch_latency_per_tl", metricRollupName: "asyncmatch_latency", metricType: Timer},
+		ForwardTaskLatencyPerTaskQueue:                {metricName: "forward_task_latency_per_tl", metricRollupName: "forward_task_latency"},
+		ForwardQueryLatencyPerTaskQueue:               {metricName: "forward_query_latency_per_tl", metricRollupName: "forward_query_latency"},
+		ForwardPollLatencyPerTaskQueue:                {metricName: "forward_poll_latency_per_tl", metricRollupName: "forward_poll_latency"},
+		LocalToLocalMatchPerTaskQueueCounter:          {metricName: "local_to_local_matches_per_tl", metricRollupName: "local_to_local_matches"},
+		LocalToRemoteMatchPerTaskQueueCounter:         {metricName: "local_to_remote_matches_per_tl", metricRollupName: "local_to_remote_matches"},
+		RemoteToLocalMatchPerTaskQueueCounter:         {metricName: "remote_to_local_matches_per_tl", metricRollupName: "remote_to_local_matches"},
+		RemoteToRemoteMatchPerTaskQueueCounter:        {metricName: "remote_to_remote_matches_per_tl", metricRollupName: "remote_to_remote_matches"},
+		SyncMatchPerTaskQueueCounter:                  {metricName: "sync_matches_per_tl", metricRollupName: "sync_matches"},
+		BacklogWritePerTaskQueueCounter:               {metricName: "backlog_writes_per_tl", metricRollupName: "backlog_writes"},
+		TaskBacklogAgePerTaskQueueGauge:               {metricName: "task_backlog_age_seconds_per_tl", metricType: Gauge},
+		TaskAddRatePerTaskQueueGauge:                  {metricName: "task_add_rate_per_tl", metricType: Gauge},
+		TaskDispatchRatePerTaskQueueGauge:             {metricName: "task_dispatch_rate_per_tl", metricType: Gauge},
+		StickyTaskQueueEvictedCounter:                 {metricName: "sticky_task_queue_evicted", metricType: Counter},
+		StickyTaskQueueEvictedRejectCounter:           {metricName: "sticky_task_queue_evicted_rejects", metricType: Counter},
 	},
 	Worker: {
 		ReplicatorMessages:                            {metricName: "replicator_messages"},
@@ -2421,10 +3025,15 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ArchiverHandleVisibilityRequestLatency:        {metricName: "archiver_handle_visibility_request_latency"},
 		ArchiverUploadWithRetriesLatency:              {metricName: "archiver_upload_with_retries_latency"},
 		ArchiverDeleteWithRetriesLatency:              {metricName: "archiver_delete_with_retries_latency"},
+		ArchiverVerifyWithRetriesLatency:              {metricName: "archiver_verify_with_retries_latency"},
 		ArchiverUploadFailedAllRetriesCount:           {metricName: "archiver_upload_failed_all_retries"},
 		ArchiverUploadSuccessCount:                    {metricName: "archiver_upload_success"},
 		ArchiverDeleteFailedAllRetriesCount:           {metricName: "archiver_delete_failed_all_retries"},
 		ArchiverDeleteSuccessCount:                    {metricName: "archiver_delete_success"},
+		ArchiverDeleteSkippedCount:                    {metricName: "archiver_delete_skipped"},
+		ArchiverVerifySuccessCount:                    {metricName: "archiver_verify_success"},
+		ArchiverVerifyMismatchCount:                   {metricName: "archiver_verify_mismatch"},
+		ArchiverVerifyFailedAllRetriesCount:           {metricName: "archiver_verify_failed_all_retries"},
 		ArchiverHandleVisibilityFailedAllRetiresCount: {metricName: "archiver_handle_visibility_failed_all_retries"},
 		ArchiverHandleVisibilitySuccessCount:          {metricName: "archiver_handle_visibility_success"},
 		ArchiverBacklogSizeGauge:                      {metricName: "archiver_backlog_size"},
@@ -2460,6 +3069,18 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ScavengerValidationRequestsCount:              {metricName: "scavenger_validation_requests", metricType: Counter},
 		ScavengerValidationFailuresCount:              {metricName: "scavenger_validation_failures", metricType: Counter},
 		AddSearchAttributesFailuresCount:              {metricName: "add_search_attributes_failures", metricType: Counter},
+		SearchAttributeWorkflowCount:                  {metricName: "search_attribute_workflow_count", metricType: Gauge},
+		SearchAttributeMetricsFailuresCount:           {metricName: "search_attribute_metrics_errors", metricType: Counter},
+		ForceReplicationWorkflowsReplicatedCount:      {metricName: "force_replication_workflows_replicated", metricType: Counter},
+		ForceReplicationWorkflowsSkippedCount:         {metricName: "force_replication_workflows_skipped", metricType: Counter},
+		ForceReplicationFailures:                      {metricName: "force_replication_errors", metricType: Counter},
+		ScheduleActionSuccess:                         {metricName: "schedule_action_success", metricType: Counter},
+		ScheduleActionErrors:                          {metricName: "schedule_action_errors", metricType: Counter},
+		DeleteNamespaceWorkflowsTerminatedCount:       {metricName: "delete_namespace_workflows_terminated", metricType: Counter},
+		DeleteNamespaceWorkflowsDeletedCount:          {metricName: "delete_namespace_workflows_deleted", metricType: Counter},
+		DeleteNamespaceWorkflowsSkippedCount:          {metricName: "delete_namespace_workflows_skipped", metricType: Counter},
+		DeleteNamespaceTaskQueuesDeletedCount:         {metricName: "delete_namespace_task_queues_deleted", metricType: Counter},
+		DeleteNamespaceFailures:                       {metricName: "delete_namespace_errors", metricType: Counter},
 	},
 }
 
@@ -0,0 +1,122 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metrics
+
+import (
+	"time"
+
+	"github.com/uber-go/tally"
+)
+
+type (
+	// histogramBuckets resolves the bucket boundaries used by histogram metrics.
+	// Boundaries are expressed in the unit of the metric, i.e. milliseconds for
+	// latencies and bytes for sizes.
+	histogramBuckets struct {
+		byMetric map[string][]float64
+		byUnit   map[MetricUnit][]float64
+	}
+)
+
+const (
+	kb = 1024
+	mb = 1024 * kb
+)
+
+var defaultHistogramBucketsByUnit = map[MetricUnit][]float64{
+	Dimensionless: {
+		1, 2, 5, 10, 20, 50, 100, 200, 500, 1000, 2000, 5000, 10000,
+	},
+	Milliseconds: {
+		1, 2, 5, 10, 20, 50, 100, 200, 500,
+		1000, 2000, 5000, 10000, 20000, 50000, 100000, 200000, 500000, 1000000,
+	},
+	Bytes: {
+		1 * kb, 2 * kb, 4 * kb, 8 * kb, 16 * kb, 32 * kb, 64 * kb, 128 * kb, 256 * kb, 512 * kb,
+		1 * mb, 2 * mb, 4 * mb, 8 * mb, 16 * mb, 32 * mb, 64 * mb,
+	},
+}
+
+func newHistogramBuckets(config *PrometheusConfig) *histogramBuckets {
+	if config == nil {
+		return nil
+	}
+	return &histogramBuckets{
+		byMetric: config.HistogramBuckets,
+		byUnit:   config.DefaultHistogramBucketsByUnit,
+	}
+}
+
+// buckets returns the tally buckets for the given histogram metric: the per
+// metric override first, then the buckets of the metric definition, then the
+// configured default for the unit of the metric and finally the built-in default
+// for the unit.
+func (h *histogramBuckets) buckets(def metricDefinition) tally.Buckets {
+	if h != nil {
+		if boundaries := h.byMetric[def.metricName.String()]; len(boundaries) > 0 {
+			return boundariesToBuckets(def.unit, boundaries)
+		}
+	}
+	if def.buckets != nil {
+		return def.buckets
+	}
+	unit := def.unit
+	if unit == "" {
+		unit = Dimensionless
+	}
+	if h != nil {
+		if boundaries := h.byUnit[unit]; len(boundaries) > 0 {
+			return boundariesToBuckets(unit, boundaries)
+		}
+	}
+	return boundariesToBuckets(unit, defaultHistogramBucketsByUnit[unit])
+}
+
+// boundariesToBuckets converts boundaries to tally buckets. Latency histograms
+// use duration buckets so that reporters (e.g. Prometheus) emit them in their
+// native time unit.
+func boundariesToBuckets(unit MetricUnit, boundaries []float64) tally.Buckets {
+	if unit != Milliseconds {
+		return tally.ValueBuckets(boundaries)
+	}
+	result := make(tally.DurationBuckets, len(boundaries))
+	for i, boundary := range boundaries {
+		result[i] = time.Duration(boundary * float64(time.Millisecond))
+	}
+	return result
+}
+
+// withHistogramBuckets returns a copy of defs with the buckets of all histogram
+// metrics resolved.
+func withHistogramBuckets(defs map[int]metricDefinition, h *histogramBuckets) map[int]metricDefinition {
+	result := make(map[int]metricDefinition, len(defs))
+	for idx, def := range defs {
+		if def.metricType == Histogram {
+			def.buckets = h.buckets(def)
+		}
+		result[idx] = def
+	}
+	return result
+}
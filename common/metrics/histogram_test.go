@@ -0,0 +1,88 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally"
+)
+
+func TestHistogramBuckets(t *testing.T) {
+	latency := metricDefinition{metricName: "latency", metricType: Histogram, unit: Milliseconds}
+	size := metricDefinition{metricName: "size", metricType: Histogram, unit: Bytes}
+	count := metricDefinition{metricName: "count", metricType: Histogram}
+	explicit := metricDefinition{metricName: "explicit", metricType: Histogram, buckets: tally.ValueBuckets{1, 10}}
+
+	var defaults *histogramBuckets
+	assert.Equal(t, tally.DurationBuckets{time.Millisecond, 2 * time.Millisecond}, defaults.buckets(latency).(tally.DurationBuckets)[:2])
+	assert.Equal(t, tally.ValueBuckets(defaultHistogramBucketsByUnit[Bytes]), defaults.buckets(size))
+	assert.Equal(t, tally.ValueBuckets(defaultHistogramBucketsByUnit[Dimensionless]), defaults.buckets(count))
+	assert.Equal(t, tally.ValueBuckets{1, 10}, defaults.buckets(explicit))
+
+	configured := newHistogramBuckets(&PrometheusConfig{
+		HistogramBuckets: map[string][]float64{
+			"latency":  {100, 500},
+			"explicit": {5},
+		},
+		DefaultHistogramBucketsByUnit: map[MetricUnit][]float64{
+			Bytes: {10, 20},
+		},
+	})
+	assert.Equal(t, tally.DurationBuckets{100 * time.Millisecond, 500 * time.Millisecond}, configured.buckets(latency))
+	assert.Equal(t, tally.ValueBuckets{10, 20}, configured.buckets(size))
+	assert.Equal(t, tally.ValueBuckets(defaultHistogramBucketsByUnit[Dimensionless]), configured.buckets(count))
+	assert.Equal(t, tally.ValueBuckets{5}, configured.buckets(explicit))
+}
+
+func TestWithHistogramBuckets(t *testing.T) {
+	original := getMetricDefs(Frontend)
+	defs := withHistogramBuckets(original, nil)
+	for idx, def := range defs {
+		if def.metricType == Histogram {
+			assert.NotNil(t, def.buckets, "histogram %v should have buckets", def.metricName)
+		} else {
+			assert.Equal(t, original[idx].buckets, def.buckets)
+		}
+	}
+	assert.IsType(t, tally.DurationBuckets{}, defs[ServiceLatencyHistogram].buckets)
+}
+
+func TestTallyClientRecordHistogram(t *testing.T) {
+	scope := tally.NewTestScope("", nil)
+	client := newClient(scope, Frontend, newHistogramBuckets(&PrometheusConfig{
+		HistogramBuckets: map[string][]float64{"service_latency_histogram": {10, 100}},
+	}))
+	client.RecordHistogramDuration(FrontendStartWorkflowExecutionScope, ServiceLatencyHistogram, 50*time.Millisecond)
+
+	histograms := scope.Snapshot().Histograms()
+	assert.Len(t, histograms, 1)
+	for _, histogram := range histograms {
+		assert.Equal(t, "service_latency_histogram", histogram.Name())
+		assert.Equal(t, int64(1), histogram.Durations()[100*time.Millisecond])
+	}
+}
@@ -59,6 +59,12 @@ type (
 		// RecordDistribution records and emits a distribution (wrapper on top of timer) for the given
 		// metric name
 		RecordDistribution(scope int, timer int, d int)
+		// RecordHistogramDuration records a duration to the histogram for the given
+		// metric name
+		RecordHistogramDuration(scope int, histogram int, d time.Duration)
+		// RecordHistogramValue records a value to the histogram for the given
+		// metric name
+		RecordHistogramValue(scope int, histogram int, value float64)
 		// UpdateGauge reports Gauge type absolute value metric
 		UpdateGauge(scope int, gauge int, value float64)
 		// Scope returns an internal scope that can be used to add additional
@@ -83,6 +89,12 @@ type (
 		// RecordDistribution records a distribution (wrapper on top of timer) for the given
 		// metric name
 		RecordDistribution(id int, d int)
+		// RecordHistogramDuration records a duration to the histogram for the given
+		// metric name
+		RecordHistogramDuration(histogram int, d time.Duration)
+		// RecordHistogramValue records a value to the histogram for the given
+		// metric name
+		RecordHistogramValue(histogram int, value float64)
 		// UpdateGauge reports Gauge type absolute value metric
 		UpdateGauge(gauge int, value float64)
 		// Tagged returns an internal scope that can be used to add additional
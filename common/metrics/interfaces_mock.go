@@ -142,6 +142,30 @@ func (mr *MockClientMockRecorder) RecordDistribution(scope, timer, d interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordDistribution", reflect.TypeOf((*MockClient)(nil).RecordDistribution), scope, timer, d)
 }
 
+// RecordHistogramDuration mocks base method.
+func (m *MockClient) RecordHistogramDuration(scope, histogram int, d time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordHistogramDuration", scope, histogram, d)
+}
+
+// RecordHistogramDuration indicates an expected call of RecordHistogramDuration.
+func (mr *MockClientMockRecorder) RecordHistogramDuration(scope, histogram, d interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordHistogramDuration", reflect.TypeOf((*MockClient)(nil).RecordHistogramDuration), scope, histogram, d)
+}
+
+// RecordHistogramValue mocks base method.
+func (m *MockClient) RecordHistogramValue(scope, histogram int, value float64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordHistogramValue", scope, histogram, value)
+}
+
+// RecordHistogramValue indicates an expected call of RecordHistogramValue.
+func (mr *MockClientMockRecorder) RecordHistogramValue(scope, histogram, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordHistogramValue", reflect.TypeOf((*MockClient)(nil).RecordHistogramValue), scope, histogram, value)
+}
+
 // RecordTimer mocks base method.
 func (m *MockClient) RecordTimer(scope, timer int, d time.Duration) {
 	m.ctrl.T.Helper()
@@ -272,6 +296,30 @@ func (mr *MockScopeMockRecorder) RecordDistribution(id, d interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordDistribution", reflect.TypeOf((*MockScope)(nil).RecordDistribution), id, d)
 }
 
+// RecordHistogramDuration mocks base method.
+func (m *MockScope) RecordHistogramDuration(histogram int, d time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordHistogramDuration", histogram, d)
+}
+
+// RecordHistogramDuration indicates an expected call of RecordHistogramDuration.
+func (mr *MockScopeMockRecorder) RecordHistogramDuration(histogram, d interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordHistogramDuration", reflect.TypeOf((*MockScope)(nil).RecordHistogramDuration), histogram, d)
+}
+
+// RecordHistogramValue mocks base method.
+func (m *MockScope) RecordHistogramValue(histogram int, value float64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordHistogramValue", histogram, value)
+}
+
+// RecordHistogramValue indicates an expected call of RecordHistogramValue.
+func (mr *MockScopeMockRecorder) RecordHistogramValue(histogram, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordHistogramValue", reflect.TypeOf((*MockScope)(nil).RecordHistogramValue), histogram, value)
+}
+
 // RecordTimer mocks base method.
 func (m *MockScope) RecordTimer(timer int, d time.Duration) {
 	m.ctrl.T.Helper()
@@ -72,6 +72,10 @@ func (n NoopMetricsScope) RecordTimer(timer int, d time.Duration) {}
 
 func (n NoopMetricsScope) RecordDistribution(id int, d int) {}
 
+func (n NoopMetricsScope) RecordHistogramDuration(histogram int, d time.Duration) {}
+
+func (n NoopMetricsScope) RecordHistogramValue(histogram int, value float64) {}
+
 func (n NoopMetricsScope) UpdateGauge(gauge int, value float64) {}
 
 func (n NoopMetricsScope) Tagged(tags ...Tag) Scope {
@@ -94,6 +98,10 @@ func (m NoopMetricsClient) RecordTimer(scope int, timer int, d time.Duration) {}
 
 func (m NoopMetricsClient) RecordDistribution(scope int, timer int, d int) {}
 
+func (m NoopMetricsClient) RecordHistogramDuration(scope int, histogram int, d time.Duration) {}
+
+func (m NoopMetricsClient) RecordHistogramValue(scope int, histogram int, value float64) {}
+
 func (m NoopMetricsClient) UpdateGauge(scope int, gauge int, value float64) {}
 
 func (m NoopMetricsClient) Scope(scope int, tags ...Tag) Scope {
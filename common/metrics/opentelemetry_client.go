@@ -104,6 +104,18 @@ func (m *opentelemetryClient) RecordDistribution(scopeIdx int, timerIdx int, d i
 	m.childScopes[scopeIdx].RecordDistribution(timerIdx, d)
 }
 
+// RecordHistogramDuration records and emits a duration to the histogram
+// for the given metric name
+func (m *opentelemetryClient) RecordHistogramDuration(scopeIdx int, histogramIdx int, d time.Duration) {
+	m.childScopes[scopeIdx].RecordHistogramDuration(histogramIdx, d)
+}
+
+// RecordHistogramValue records and emits a value to the histogram
+// for the given metric name
+func (m *opentelemetryClient) RecordHistogramValue(scopeIdx int, histogramIdx int, value float64) {
+	m.childScopes[scopeIdx].RecordHistogramValue(histogramIdx, value)
+}
+
 // UpdateGauge reports Gauge type metric
 func (m *opentelemetryClient) UpdateGauge(scopeIdx int, gaugeIdx int, value float64) {
 	m.childScopes[scopeIdx].UpdateGauge(gaugeIdx, value)
@@ -26,15 +26,26 @@ package metrics
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"time"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/metric/prometheus"
+	"go.opentelemetry.io/otel/label"
 	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk/metric/controller/push"
+	"go.opentelemetry.io/otel/sdk/metric/processor/basic"
+	"go.opentelemetry.io/otel/sdk/metric/selector/simple"
+	"go.opentelemetry.io/otel/sdk/resource"
 
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics/otlp"
+)
+
+const (
+	opentelemetryServiceName = "temporal"
 )
 
 type (
@@ -47,6 +58,8 @@ type (
 		prefix    string
 		config    *PrometheusConfig
 		server    *http.Server
+		// controller pushes metrics to an OpenTelemetry collector, it is set instead of exporter and server.
+		controller *push.Controller
 	}
 
 	OpentelemetryListener struct {
@@ -90,6 +103,57 @@ func newOpentelemeteryReporter(
 	return reporter, nil
 }
 
+func newOpentelemetryCollectorReporter(
+	logger log.Logger,
+	tags map[string]string,
+	prefix string,
+	config *OpentelemetryConfig,
+) (*OpentelemetryReporter, error) {
+	if config.Endpoint == "" {
+		err := errors.New("opentelemetry endpoint must be specified")
+		logger.Error("Failed to initialize opentelemetry exporter.", tag.Error(err))
+		return nil, err
+	}
+	histogramBoundaries := config.DefaultHistogramBoundaries
+	if len(histogramBoundaries) == 0 {
+		histogramBoundaries = defaultHistogramBoundaries
+	}
+	collectPeriod := config.CollectPeriod
+	if collectPeriod == 0 {
+		collectPeriod = push.DefaultPushPeriod
+	}
+	timeout := config.Timeout
+	if timeout == 0 {
+		timeout = otlp.DefaultTimeout
+	}
+
+	exporter := otlp.NewExporter(otlp.Options{
+		Endpoint: config.Endpoint,
+		URLPath:  config.URLPath,
+		Insecure: config.Insecure,
+		Headers:  config.Headers,
+		Timeout:  timeout,
+	})
+	controller := push.New(
+		basic.New(simple.NewWithHistogramDistribution(histogramBoundaries), exporter),
+		exporter,
+		push.WithPeriod(collectPeriod),
+		push.WithTimeout(timeout),
+		push.WithResource(resource.NewWithAttributes(label.String("service.name", opentelemetryServiceName))),
+	)
+	controller.Start()
+
+	meter := controller.MeterProvider().Meter("temporal")
+	reporter := &OpentelemetryReporter{
+		meter:      meter,
+		meterMust:  metric.Must(meter),
+		tags:       tags,
+		prefix:     prefix,
+		controller: controller,
+	}
+	return reporter, nil
+}
+
 func initPrometheusListener(config *PrometheusConfig, logger log.Logger, exporter *prometheus.Exporter) *http.Server {
 	handlerPath := config.HandlerPath
 	if handlerPath == "" {
@@ -127,6 +191,11 @@ func (r *OpentelemetryReporter) NewClient(logger log.Logger, serviceIdx ServiceI
 }
 
 func (r *OpentelemetryReporter) Stop(logger log.Logger) {
+	if r.controller != nil {
+		// flushes metrics collected since the last export
+		r.controller.Stop()
+		return
+	}
 	ctx, closeCtx := context.WithTimeout(context.Background(), time.Second)
 	defer closeCtx()
 	if err := r.server.Shutdown(ctx); !(err == nil || err == http.ErrServerClosed) {
@@ -166,20 +166,12 @@ func (m *opentelemetryScope) RecordDistribution(id int, d int) {
 	}
 }
 
-// Deprecated: not used
+// RecordHistogramDuration records the duration in seconds. Note that the OpenTelemetry prometheus
+// exporter applies DefaultHistogramBoundaries to all histograms.
 func (m *opentelemetryScope) RecordHistogramDuration(id int, value time.Duration) {
-	def := m.defs[id]
-	ctx := context.Background()
-	m.reporter.GetMeterMust().NewInt64ValueRecorder(def.metricName.String()).Record(ctx, value.Nanoseconds(), m.labels...)
-
-	if !def.metricRollupName.Empty() && (m.rootScope != nil) {
-		m.rootScope.reporter.GetMeterMust().NewInt64ValueRecorder(def.metricRollupName.String()).Record(
-			ctx, value.Nanoseconds(), m.rootScope.labels...,
-		)
-	}
+	m.RecordHistogramValue(id, value.Seconds())
 }
 
-// Deprecated: not used
 func (m *opentelemetryScope) RecordHistogramValue(id int, value float64) {
 	def := m.defs[id]
 	ctx := context.Background()
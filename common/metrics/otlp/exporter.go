@@ -0,0 +1,301 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package otlp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/number"
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+	"go.opentelemetry.io/otel/sdk/export/metric/aggregation"
+)
+
+const (
+	// DefaultURLPath is the default path of the OTLP/HTTP metrics receiver.
+	DefaultURLPath = "/v1/metrics"
+	// DefaultTimeout is the default timeout of a single export request.
+	DefaultTimeout = 10 * time.Second
+)
+
+type (
+	// Options contains the options of the OTLP exporter.
+	Options struct {
+		// Endpoint is the host and port of the OTLP/HTTP receiver of the collector.
+		Endpoint string
+		// URLPath is the path metrics are posted to. Defaults to DefaultURLPath.
+		URLPath string
+		// Insecure disables TLS.
+		Insecure bool
+		// Headers are sent with every export request.
+		Headers map[string]string
+		// Timeout is the timeout of a single export request. Defaults to DefaultTimeout.
+		Timeout time.Duration
+	}
+
+	// Exporter exports metrics to an OpenTelemetry collector using OTLP/HTTP with JSON encoding.
+	Exporter struct {
+		client  *http.Client
+		url     string
+		headers map[string]string
+	}
+)
+
+var _ export.Exporter = (*Exporter)(nil)
+
+// NewExporter creates a new OTLP exporter.
+func NewExporter(options Options) *Exporter {
+	scheme := "https"
+	if options.Insecure {
+		scheme = "http"
+	}
+	urlPath := options.URLPath
+	if urlPath == "" {
+		urlPath = DefaultURLPath
+	}
+	timeout := options.Timeout
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+	return &Exporter{
+		client:  &http.Client{Timeout: timeout},
+		url:     fmt.Sprintf("%s://%s%s", scheme, options.Endpoint, urlPath),
+		headers: options.Headers,
+	}
+}
+
+// ExportKindFor always returns cumulative, which is what OTLP receivers
+// (e.g. the prometheus exporter of the collector) expect by default.
+func (e *Exporter) ExportKindFor(_ *metric.Descriptor, _ aggregation.Kind) export.ExportKind {
+	return export.CumulativeExportKind
+}
+
+// Export posts all records of the checkpoint set to the collector.
+func (e *Exporter) Export(ctx context.Context, checkpointSet export.CheckpointSet) error {
+	request, err := e.transform(checkpointSet)
+	if err != nil {
+		return err
+	}
+	if len(request.ResourceMetrics) == 0 {
+		return nil
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	httpRequest.Header.Set("Content-Type", "application/json")
+	for k, v := range e.headers {
+		httpRequest.Header.Set(k, v)
+	}
+
+	response, err := e.client.Do(httpRequest)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = response.Body.Close() }()
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		message, _ := ioutil.ReadAll(io.LimitReader(response.Body, 1024))
+		return fmt.Errorf("OTLP export to %s failed with status %s: %s", e.url, response.Status, message)
+	}
+	return nil
+}
+
+func (e *Exporter) transform(checkpointSet export.CheckpointSet) (*exportMetricsServiceRequest, error) {
+	request := &exportMetricsServiceRequest{}
+	resources := make(map[label.Distinct]*resourceMetrics)
+	libraries := make(map[label.Distinct]map[string]*instrumentationLibraryMetrics)
+
+	err := checkpointSet.ForEach(e, func(record export.Record) error {
+		m, err := toMetric(record)
+		if err != nil || m == nil {
+			return err
+		}
+
+		key := record.Resource().Equivalent()
+		rm, ok := resources[key]
+		if !ok {
+			rm = &resourceMetrics{Resource: resourceData{Attributes: toKeyValues(record.Resource().Iter())}}
+			resources[key] = rm
+			libraries[key] = make(map[string]*instrumentationLibraryMetrics)
+			request.ResourceMetrics = append(request.ResourceMetrics, rm)
+		}
+
+		libraryName := record.Descriptor().InstrumentationName()
+		ilm, ok := libraries[key][libraryName]
+		if !ok {
+			ilm = &instrumentationLibraryMetrics{InstrumentationLibrary: instrumentationLibrary{Name: libraryName}}
+			libraries[key][libraryName] = ilm
+			rm.InstrumentationLibraryMetrics = append(rm.InstrumentationLibraryMetrics, ilm)
+		}
+		ilm.Metrics = append(ilm.Metrics, m)
+		return nil
+	})
+	return request, err
+}
+
+func toMetric(record export.Record) (*metricData, error) {
+	descriptor := record.Descriptor()
+	labels := record.Labels().Iter()
+	result := &metricData{
+		Name:        descriptor.Name(),
+		Description: descriptor.Description(),
+		Unit:        string(descriptor.Unit()),
+	}
+	start := uint64s(record.StartTime().UnixNano())
+	end := uint64s(record.EndTime().UnixNano())
+
+	switch agg := record.Aggregation().(type) {
+	case aggregation.Histogram:
+		point, err := toHistogramDataPoint(agg, descriptor.NumberKind())
+		if err != nil {
+			return nil, err
+		}
+		point.Attributes = toKeyValues(labels)
+		point.StartTimeUnixNano = start
+		point.TimeUnixNano = end
+		result.Histogram = &histogram{
+			DataPoints:             []*histogramDataPoint{point},
+			AggregationTemporality: aggregationTemporalityCumulative,
+		}
+	case aggregation.Sum:
+		value, err := agg.Sum()
+		if err != nil {
+			return nil, err
+		}
+		point := toNumberDataPoint(value, descriptor.NumberKind())
+		point.Attributes = toKeyValues(labels)
+		point.StartTimeUnixNano = start
+		point.TimeUnixNano = end
+		result.Sum = &sum{
+			DataPoints:             []*numberDataPoint{point},
+			AggregationTemporality: aggregationTemporalityCumulative,
+			IsMonotonic:            descriptor.InstrumentKind().Monotonic(),
+		}
+	case aggregation.LastValue:
+		value, timestamp, err := agg.LastValue()
+		if err == aggregation.ErrNoData {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		point := toNumberDataPoint(value, descriptor.NumberKind())
+		point.Attributes = toKeyValues(labels)
+		point.TimeUnixNano = uint64s(timestamp.UnixNano())
+		result.Gauge = &gauge{DataPoints: []*numberDataPoint{point}}
+	default:
+		// aggregations other than the ones selected by the exporter's
+		// aggregator selector are not supported
+		return nil, nil
+	}
+	return result, nil
+}
+
+func toHistogramDataPoint(agg aggregation.Histogram, kind number.Kind) (*histogramDataPoint, error) {
+	buckets, err := agg.Histogram()
+	if err != nil {
+		return nil, err
+	}
+	count, err := agg.Count()
+	if err != nil {
+		return nil, err
+	}
+	total, err := agg.Sum()
+	if err != nil {
+		return nil, err
+	}
+
+	point := &histogramDataPoint{
+		Count:          uint64s(count),
+		Sum:            total.CoerceToFloat64(kind),
+		BucketCounts:   make([]uint64s, len(buckets.Counts)),
+		ExplicitBounds: buckets.Boundaries,
+	}
+	for i, c := range buckets.Counts {
+		point.BucketCounts[i] = uint64s(c)
+	}
+	return point, nil
+}
+
+func toNumberDataPoint(value number.Number, kind number.Kind) *numberDataPoint {
+	point := &numberDataPoint{}
+	switch kind {
+	case number.Int64Kind:
+		v := int64s(value.AsInt64())
+		point.AsInt = &v
+	default:
+		v := value.CoerceToFloat64(kind)
+		point.AsDouble = &v
+	}
+	return point
+}
+
+func toKeyValues(iter label.Iterator) []keyValue {
+	var result []keyValue
+	for iter.Next() {
+		kv := iter.Label()
+		result = append(result, keyValue{Key: string(kv.Key), Value: toAnyValue(kv.Value)})
+	}
+	return result
+}
+
+func toAnyValue(value label.Value) anyValue {
+	switch value.Type() {
+	case label.BOOL:
+		v := value.AsBool()
+		return anyValue{BoolValue: &v}
+	case label.INT32:
+		v := int64s(value.AsInt32())
+		return anyValue{IntValue: &v}
+	case label.INT64:
+		v := int64s(value.AsInt64())
+		return anyValue{IntValue: &v}
+	case label.UINT32:
+		v := int64s(value.AsUint32())
+		return anyValue{IntValue: &v}
+	case label.FLOAT32:
+		v := float64(value.AsFloat32())
+		return anyValue{DoubleValue: &v}
+	case label.FLOAT64:
+		v := value.AsFloat64()
+		return anyValue{DoubleValue: &v}
+	default:
+		v := value.Emit()
+		return anyValue{StringValue: &v}
+	}
+}
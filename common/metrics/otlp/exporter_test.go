@@ -0,0 +1,148 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package otlp
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk/metric/controller/push"
+	"go.opentelemetry.io/otel/sdk/metric/processor/basic"
+	"go.opentelemetry.io/otel/sdk/metric/selector/simple"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+type exporterSuite struct {
+	suite.Suite
+	server   *httptest.Server
+	requests []*exportMetricsServiceRequest
+	headers  []http.Header
+	status   int
+}
+
+func TestExporterSuite(t *testing.T) {
+	suite.Run(t, new(exporterSuite))
+}
+
+func (s *exporterSuite) SetupTest() {
+	s.requests = nil
+	s.headers = nil
+	s.status = http.StatusOK
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.Equal(DefaultURLPath, r.URL.Path)
+		s.Equal("application/json", r.Header.Get("Content-Type"))
+		request := &exportMetricsServiceRequest{}
+		s.NoError(json.NewDecoder(r.Body).Decode(request))
+		s.requests = append(s.requests, request)
+		s.headers = append(s.headers, r.Header)
+		w.WriteHeader(s.status)
+	}))
+}
+
+func (s *exporterSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *exporterSuite) newExporter() *Exporter {
+	return NewExporter(Options{
+		Endpoint: strings.TrimPrefix(s.server.URL, "http://"),
+		Insecure: true,
+		Headers:  map[string]string{"authorization": "Bearer token"},
+	})
+}
+
+func (s *exporterSuite) TestExport() {
+	exporter := s.newExporter()
+	controller := push.New(
+		basic.New(simple.NewWithHistogramDistribution([]float64{1, 10}), exporter),
+		exporter,
+		push.WithResource(resource.NewWithAttributes(label.String("service.name", "temporal"))),
+	)
+	controller.Start()
+
+	meter := metric.Must(controller.MeterProvider().Meter("temporal"))
+	ctx := context.Background()
+	meter.NewInt64Counter("service_requests").Add(ctx, 3, label.String("operation", "StartWorkflowExecution"))
+	recorder := meter.NewFloat64ValueRecorder("service_latency")
+	recorder.Record(ctx, 0.5)
+	recorder.Record(ctx, 5)
+	recorder.Record(ctx, 50)
+	controller.Stop()
+
+	s.Len(s.requests, 1)
+	s.Equal("Bearer token", s.headers[0].Get("authorization"))
+	request := s.requests[0]
+	s.Len(request.ResourceMetrics, 1)
+	rm := request.ResourceMetrics[0]
+	s.Equal("service.name", rm.Resource.Attributes[0].Key)
+	s.Equal("temporal", *rm.Resource.Attributes[0].Value.StringValue)
+	s.Len(rm.InstrumentationLibraryMetrics, 1)
+	s.Equal("temporal", rm.InstrumentationLibraryMetrics[0].InstrumentationLibrary.Name)
+
+	metrics := make(map[string]*metricData)
+	for _, m := range rm.InstrumentationLibraryMetrics[0].Metrics {
+		metrics[m.Name] = m
+	}
+	s.Len(metrics, 2)
+
+	counter := metrics["service_requests"].Sum
+	s.NotNil(counter)
+	s.True(counter.IsMonotonic)
+	s.Equal(aggregationTemporalityCumulative, counter.AggregationTemporality)
+	s.Equal(int64s(3), *counter.DataPoints[0].AsInt)
+	s.Equal("operation", counter.DataPoints[0].Attributes[0].Key)
+	s.Equal("StartWorkflowExecution", *counter.DataPoints[0].Attributes[0].Value.StringValue)
+
+	histogram := metrics["service_latency"].Histogram
+	s.NotNil(histogram)
+	point := histogram.DataPoints[0]
+	s.Equal(uint64s(3), point.Count)
+	s.Equal(55.5, point.Sum)
+	s.Equal([]float64{1, 10}, point.ExplicitBounds)
+	s.Equal([]uint64s{1, 1, 1}, point.BucketCounts)
+	s.NotZero(point.TimeUnixNano)
+}
+
+func (s *exporterSuite) TestExport_Failure() {
+	s.status = http.StatusBadRequest
+	exporter := s.newExporter()
+	processor := basic.New(simple.NewWithHistogramDistribution(nil), exporter)
+	controller := push.New(processor, exporter)
+	metric.Must(controller.MeterProvider().Meter("temporal")).NewInt64Counter("service_requests").Add(context.Background(), 1)
+	controller.Start()
+	controller.Stop()
+	s.Len(s.requests, 1)
+
+	err := exporter.Export(context.Background(), processor.CheckpointSet())
+	s.Error(err)
+	s.Contains(err.Error(), "400")
+}
@@ -0,0 +1,145 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package otlp
+
+import (
+	"strconv"
+)
+
+// JSON representation of the OTLP ExportMetricsServiceRequest, following the
+// proto3 JSON mapping of opentelemetry-proto.
+type (
+	exportMetricsServiceRequest struct {
+		ResourceMetrics []*resourceMetrics `json:"resourceMetrics"`
+	}
+
+	resourceMetrics struct {
+		Resource                      resourceData                     `json:"resource"`
+		InstrumentationLibraryMetrics []*instrumentationLibraryMetrics `json:"instrumentationLibraryMetrics"`
+	}
+
+	resourceData struct {
+		Attributes []keyValue `json:"attributes,omitempty"`
+	}
+
+	instrumentationLibraryMetrics struct {
+		InstrumentationLibrary instrumentationLibrary `json:"instrumentationLibrary"`
+		Metrics                []*metricData          `json:"metrics"`
+	}
+
+	instrumentationLibrary struct {
+		Name string `json:"name,omitempty"`
+	}
+
+	keyValue struct {
+		Key   string   `json:"key"`
+		Value anyValue `json:"value"`
+	}
+
+	anyValue struct {
+		StringValue *string  `json:"stringValue,omitempty"`
+		BoolValue   *bool    `json:"boolValue,omitempty"`
+		IntValue    *int64s  `json:"intValue,omitempty"`
+		DoubleValue *float64 `json:"doubleValue,omitempty"`
+	}
+
+	metricData struct {
+		Name        string     `json:"name"`
+		Description string     `json:"description,omitempty"`
+		Unit        string     `json:"unit,omitempty"`
+		Gauge       *gauge     `json:"gauge,omitempty"`
+		Sum         *sum       `json:"sum,omitempty"`
+		Histogram   *histogram `json:"histogram,omitempty"`
+	}
+
+	gauge struct {
+		DataPoints []*numberDataPoint `json:"dataPoints"`
+	}
+
+	sum struct {
+		DataPoints             []*numberDataPoint `json:"dataPoints"`
+		AggregationTemporality int                `json:"aggregationTemporality"`
+		IsMonotonic            bool               `json:"isMonotonic"`
+	}
+
+	histogram struct {
+		DataPoints             []*histogramDataPoint `json:"dataPoints"`
+		AggregationTemporality int                   `json:"aggregationTemporality"`
+	}
+
+	numberDataPoint struct {
+		Attributes        []keyValue `json:"attributes,omitempty"`
+		StartTimeUnixNano uint64s    `json:"startTimeUnixNano"`
+		TimeUnixNano      uint64s    `json:"timeUnixNano"`
+		AsInt             *int64s    `json:"asInt,omitempty"`
+		AsDouble          *float64   `json:"asDouble,omitempty"`
+	}
+
+	histogramDataPoint struct {
+		Attributes        []keyValue `json:"attributes,omitempty"`
+		StartTimeUnixNano uint64s    `json:"startTimeUnixNano"`
+		TimeUnixNano      uint64s    `json:"timeUnixNano"`
+		Count             uint64s    `json:"count"`
+		Sum               float64    `json:"sum"`
+		BucketCounts      []uint64s  `json:"bucketCounts"`
+		ExplicitBounds    []float64  `json:"explicitBounds"`
+	}
+
+	// int64s and uint64s are encoded as JSON strings as required by the proto3 JSON mapping
+	int64s  int64
+	uint64s uint64
+)
+
+const (
+	// aggregationTemporalityCumulative is AGGREGATION_TEMPORALITY_CUMULATIVE
+	aggregationTemporalityCumulative = 2
+)
+
+func (v int64s) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(strconv.FormatInt(int64(v), 10))), nil
+}
+
+func (v *int64s) UnmarshalJSON(data []byte) error {
+	parsed, err := strconv.ParseInt(unquote(data), 10, 64)
+	*v = int64s(parsed)
+	return err
+}
+
+func (v uint64s) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(strconv.FormatUint(uint64(v), 10))), nil
+}
+
+func (v *uint64s) UnmarshalJSON(data []byte) error {
+	parsed, err := strconv.ParseUint(unquote(data), 10, 64)
+	*v = uint64s(parsed)
+	return err
+}
+
+func unquote(data []byte) string {
+	if s, err := strconv.Unquote(string(data)); err == nil {
+		return s
+	}
+	return string(data)
+}
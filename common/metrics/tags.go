@@ -96,6 +96,34 @@ type (
 	failureTag struct {
 		value string
 	}
+
+	tokenIssuerTag struct {
+		value string
+	}
+
+	sinkTag struct {
+		value string
+	}
+
+	searchAttributeTag struct {
+		value string
+	}
+
+	searchAttributeValueTag struct {
+		value string
+	}
+
+	sloWindowTag struct {
+		value string
+	}
+
+	dynamicConfigKeyTag struct {
+		value string
+	}
+
+	isolationGroupTag struct {
+		value string
+	}
 )
 
 // NamespaceTag returns a new namespace tag. For timers, this also ensures that we
@@ -306,3 +334,129 @@ func (d failureTag) Key() string {
 func (d failureTag) Value() string {
 	return d.value
 }
+
+// TokenIssuerTag returns a new JWT issuer tag
+func TokenIssuerTag(value string) Tag {
+	if len(value) == 0 {
+		value = unknownValue
+	}
+	return tokenIssuerTag{value}
+}
+
+// Key returns the key of the tag
+func (d tokenIssuerTag) Key() string {
+	return TokenIssuerTagName
+}
+
+// Value returns the value of the tag
+func (d tokenIssuerTag) Value() string {
+	return d.value
+}
+
+// SinkTag returns a new visibility sink tag
+func SinkTag(value string) Tag {
+	if len(value) == 0 {
+		value = unknownValue
+	}
+	return sinkTag{value}
+}
+
+// Key returns the key of the tag
+func (d sinkTag) Key() string {
+	return SinkTagName
+}
+
+// Value returns the value of the tag
+func (d sinkTag) Value() string {
+	return d.value
+}
+
+// SearchAttributeTag returns a new search attribute name tag
+func SearchAttributeTag(value string) Tag {
+	if len(value) == 0 {
+		value = unknownValue
+	}
+	return searchAttributeTag{value}
+}
+
+// Key returns the key of the tag
+func (d searchAttributeTag) Key() string {
+	return SearchAttributeTagName
+}
+
+// Value returns the value of the tag
+func (d searchAttributeTag) Value() string {
+	return d.value
+}
+
+// SearchAttributeValueTag returns a new search attribute value tag
+func SearchAttributeValueTag(value string) Tag {
+	if len(value) == 0 {
+		value = unknownValue
+	}
+	return searchAttributeValueTag{value}
+}
+
+// Key returns the key of the tag
+func (d searchAttributeValueTag) Key() string {
+	return SearchAttributeValueTagName
+}
+
+// Value returns the value of the tag
+func (d searchAttributeValueTag) Value() string {
+	return d.value
+}
+
+// SLOWindowTag returns a new tag of the rolling window an SLI is computed over
+func SLOWindowTag(value string) Tag {
+	if len(value) == 0 {
+		value = unknownValue
+	}
+	return sloWindowTag{value}
+}
+
+// Key returns the key of the tag
+func (d sloWindowTag) Key() string {
+	return SLOWindowTagName
+}
+
+// Value returns the value of the tag
+func (d sloWindowTag) Value() string {
+	return d.value
+}
+
+// DynamicConfigKeyTag returns a new tag of a dynamic config key
+func DynamicConfigKeyTag(value string) Tag {
+	if len(value) == 0 {
+		value = unknownValue
+	}
+	return dynamicConfigKeyTag{value}
+}
+
+// Key returns the key of the tag
+func (d dynamicConfigKeyTag) Key() string {
+	return DynamicConfigKeyTagName
+}
+
+// Value returns the value of the tag
+func (d dynamicConfigKeyTag) Value() string {
+	return d.value
+}
+
+// IsolationGroupTag returns a new tag of the isolation group of a namespace
+func IsolationGroupTag(value string) Tag {
+	if len(value) == 0 {
+		value = unknownValue
+	}
+	return isolationGroupTag{value}
+}
+
+// Key returns the key of the tag
+func (d isolationGroupTag) Key() string {
+	return IsolationGroupTagName
+}
+
+// Value returns the value of the tag
+func (d isolationGroupTag) Value() string {
+	return d.value
+}
@@ -44,11 +44,15 @@ type TallyClient struct {
 // reporter holds the common tags for the service
 // serviceIdx indicates the service type in (InputhostIndex, ... StorageIndex)
 func NewClient(scope tally.Scope, serviceIdx ServiceIdx) Client {
+	return newClient(scope, serviceIdx, nil)
+}
+
+func newClient(scope tally.Scope, serviceIdx ServiceIdx, histogramBuckets *histogramBuckets) Client {
 	totalScopes := len(ScopeDefs[Common]) + len(ScopeDefs[serviceIdx])
 	metricsClient := &TallyClient{
 		parentScope: scope,
 		childScopes: make(map[int]tally.Scope, totalScopes),
-		metricDefs:  getMetricDefs(serviceIdx),
+		metricDefs:  withHistogramBuckets(getMetricDefs(serviceIdx), histogramBuckets),
 		serviceIdx:  serviceIdx,
 	}
 
@@ -110,6 +114,20 @@ func (m *TallyClient) RecordDistribution(scopeIdx int, timerIdx int, d int) {
 	m.childScopes[scopeIdx].Timer(name).Record(dist)
 }
 
+// RecordHistogramDuration records and emits a duration to the histogram
+// for the given metric name
+func (m *TallyClient) RecordHistogramDuration(scopeIdx int, histogramIdx int, d time.Duration) {
+	def := m.metricDefs[histogramIdx]
+	m.childScopes[scopeIdx].Histogram(def.metricName.String(), def.buckets).RecordDuration(d)
+}
+
+// RecordHistogramValue records and emits a value to the histogram
+// for the given metric name
+func (m *TallyClient) RecordHistogramValue(scopeIdx int, histogramIdx int, value float64) {
+	def := m.metricDefs[histogramIdx]
+	m.childScopes[scopeIdx].Histogram(def.metricName.String(), def.buckets).RecordValue(value)
+}
+
 // UpdateGauge reports Gauge type metric
 func (m *TallyClient) UpdateGauge(scopeIdx int, gaugeIdx int, value float64) {
 	name := string(m.metricDefs[gaugeIdx].metricName)
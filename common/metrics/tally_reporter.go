@@ -31,15 +31,19 @@ import (
 
 // TallyReporter is a base class for reporting metrics to Tally.
 type TallyReporter struct {
-	scope tally.Scope
+	scope            tally.Scope
+	histogramBuckets *histogramBuckets
 }
 
-func newTallyReporter(scope tally.Scope) *TallyReporter {
-	return &TallyReporter{scope: scope}
+func newTallyReporter(scope tally.Scope, histogramBuckets *histogramBuckets) *TallyReporter {
+	return &TallyReporter{
+		scope:            scope,
+		histogramBuckets: histogramBuckets,
+	}
 }
 
 func (tr *TallyReporter) NewClient(logger log.Logger, serviceIdx ServiceIdx) (Client, error) {
-	return NewClient(tr.scope, serviceIdx), nil
+	return newClient(tr.scope, serviceIdx, tr.histogramBuckets), nil
 }
 
 func (tr *TallyReporter) GetScope() tally.Scope {
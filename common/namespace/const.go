@@ -38,4 +38,7 @@ const (
 
 	// MaxBadBinaries is the maximal number of bad client binaries stored in a namespace
 	MaxBadBinaries = 10
+
+	// MaxFailoverHistorySize is the maximal number of failover events stored in a namespace
+	MaxFailoverHistorySize = 100
 )
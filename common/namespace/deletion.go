@@ -0,0 +1,95 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package namespace
+
+import (
+	"context"
+
+	enumspb "go.temporal.io/api/enums/v1"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence"
+)
+
+// DeleteNamespace marks namespace as deleted and returns its ID. Deleted namespace doesn't accept new workflows
+// and can't be updated anymore, its data is deleted asynchronously by the worker service of each cluster.
+// Deletion of global namespace is replicated to remote clusters. Deleting a deleted namespace is a noop.
+func (d *HandlerImpl) DeleteNamespace(
+	_ context.Context,
+	name string,
+) (string, error) {
+
+	if name == common.SystemLocalNamespace {
+		return "", errCannotDeleteSystemNamespace
+	}
+
+	// must get the metadata (notificationVersion) first, see UpdateNamespace
+	metadata, err := d.metadataMgr.GetMetadata()
+	if err != nil {
+		return "", err
+	}
+	getResponse, err := d.metadataMgr.GetNamespace(&persistence.GetNamespaceRequest{Name: name})
+	if err != nil {
+		return "", err
+	}
+	namespace := getResponse.Namespace
+	if namespace.Info.State == enumspb.NAMESPACE_STATE_DELETED {
+		return namespace.Info.Id, nil
+	}
+	if getResponse.IsGlobalNamespace && d.clusterMetadata.IsGlobalNamespaceEnabled() && !d.clusterMetadata.IsMasterCluster() {
+		return "", errNotMasterCluster
+	}
+
+	namespace.Info.State = enumspb.NAMESPACE_STATE_DELETED
+	namespace.ConfigVersion++
+	if err := d.metadataMgr.UpdateNamespace(&persistence.UpdateNamespaceRequest{
+		Namespace:           namespace,
+		NotificationVersion: metadata.NotificationVersion,
+	}); err != nil {
+		return "", err
+	}
+
+	if getResponse.IsGlobalNamespace {
+		if err := d.namespaceReplicator.HandleTransmissionTask(
+			enumsspb.NAMESPACE_OPERATION_UPDATE,
+			namespace.Info,
+			namespace.Config,
+			namespace.ReplicationConfig,
+			namespace.ConfigVersion,
+			namespace.FailoverVersion,
+			getResponse.IsGlobalNamespace,
+		); err != nil {
+			return "", err
+		}
+	}
+
+	d.logger.Info("Delete namespace succeeded",
+		tag.WorkflowNamespace(name),
+		tag.WorkflowNamespaceID(namespace.Info.Id),
+	)
+	return namespace.Info.Id, nil
+}
@@ -0,0 +1,143 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package namespace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+)
+
+type (
+	deletionSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller      *gomock.Controller
+		mockMetadataMgr *persistence.MockMetadataManager
+		handler         *HandlerImpl
+	}
+)
+
+func TestDeletionSuite(t *testing.T) {
+	s := new(deletionSuite)
+	suite.Run(t, s)
+}
+
+func (s *deletionSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.mockMetadataMgr = persistence.NewMockMetadataManager(s.controller)
+	s.handler = NewHandler(
+		nil,
+		log.NewNoopLogger(),
+		s.mockMetadataMgr,
+		cluster.NewMetadata(
+			true,
+			int64(10),
+			cluster.TestCurrentClusterName,
+			cluster.TestCurrentClusterName,
+			cluster.TestAllClusterInfo,
+		),
+		nil,
+		nil,
+		nil,
+	)
+}
+
+func (s *deletionSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *deletionSuite) TestDeleteNamespace() {
+	getResponse := s.newGetNamespaceResponse()
+	s.mockMetadataMgr.EXPECT().GetMetadata().Return(&persistence.GetMetadataResponse{NotificationVersion: 5}, nil)
+	s.mockMetadataMgr.EXPECT().GetNamespace(&persistence.GetNamespaceRequest{Name: "test-namespace"}).Return(getResponse, nil)
+	s.mockMetadataMgr.EXPECT().UpdateNamespace(gomock.Any()).DoAndReturn(func(request *persistence.UpdateNamespaceRequest) error {
+		s.Equal(int64(5), request.NotificationVersion)
+		s.Equal(enumspb.NAMESPACE_STATE_DELETED, request.Namespace.Info.State)
+		s.Equal(int64(2), request.Namespace.ConfigVersion)
+		return nil
+	})
+
+	namespaceID, err := s.handler.DeleteNamespace(context.Background(), "test-namespace")
+	s.NoError(err)
+	s.Equal("test-namespace-id", namespaceID)
+
+	// deleting a deleted namespace is idempotent
+	s.mockMetadataMgr.EXPECT().GetMetadata().Return(&persistence.GetMetadataResponse{NotificationVersion: 6}, nil)
+	s.mockMetadataMgr.EXPECT().GetNamespace(gomock.Any()).Return(getResponse, nil)
+	namespaceID, err = s.handler.DeleteNamespace(context.Background(), "test-namespace")
+	s.NoError(err)
+	s.Equal("test-namespace-id", namespaceID)
+}
+
+func (s *deletionSuite) TestDeleteNamespace_SystemNamespace() {
+	_, err := s.handler.DeleteNamespace(context.Background(), common.SystemLocalNamespace)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
+func (s *deletionSuite) TestUpdateNamespace_Deleted() {
+	getResponse := s.newGetNamespaceResponse()
+	getResponse.Namespace.Info.State = enumspb.NAMESPACE_STATE_DELETED
+	s.mockMetadataMgr.EXPECT().GetMetadata().Return(&persistence.GetMetadataResponse{NotificationVersion: 5}, nil)
+	s.mockMetadataMgr.EXPECT().GetNamespace(gomock.Any()).Return(getResponse, nil)
+
+	_, err := s.handler.UpdateNamespace(context.Background(), &workflowservice.UpdateNamespaceRequest{
+		Namespace: "test-namespace",
+	})
+	s.Equal(errNamespaceDeleted, err)
+}
+
+func (s *deletionSuite) newGetNamespaceResponse() *persistence.GetNamespaceResponse {
+	return &persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{
+				Id:    "test-namespace-id",
+				Name:  "test-namespace",
+				State: enumspb.NAMESPACE_STATE_REGISTERED,
+			},
+			Config: &persistencespb.NamespaceConfig{},
+			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters:          []string{cluster.TestCurrentClusterName},
+			},
+			ConfigVersion: 1,
+		},
+		IsGlobalNamespace: false,
+	}
+}
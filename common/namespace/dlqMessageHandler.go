@@ -29,6 +29,7 @@ package namespace
 import (
 	"go.temporal.io/api/serviceerror"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -133,6 +134,16 @@ func (d *dlqMessageHandlerImpl) Merge(
 
 	var ackedMessageID int64
 	for _, message := range messages {
+		if message.GetTaskType() == enumsspb.REPLICATION_TASK_TYPE_SEARCH_ATTRIBUTES_TASK {
+			if err := d.replicationHandler.ExecuteSearchAttributesTask(
+				message.GetSearchAttributesTaskAttributes(),
+			); err != nil {
+				return nil, err
+			}
+			ackedMessageID = message.SourceTaskId
+			continue
+		}
+
 		namespaceTask := message.GetNamespaceTaskAttributes()
 		if namespaceTask == nil {
 			return nil, serviceerror.NewInternal("Encounter non namespace replication task in namespace replication queue.")
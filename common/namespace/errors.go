@@ -36,4 +36,7 @@ var (
 	errCannotDoNamespaceFailoverAndUpdate = serviceerror.NewInvalidArgument("Cannot set active cluster to current cluster when other parameters are set.")
 	errInvalidRetentionPeriod             = serviceerror.NewInvalidArgument("A valid retention period is not set on request.")
 	errInvalidArchivalConfig              = serviceerror.NewInvalidArgument("Invalid to enable archival without specifying a uri.")
+	errNamespaceDeleted                   = serviceerror.NewInvalidArgument("Namespace is deleted, it can't be updated anymore.")
+	errCannotDeleteSystemNamespace        = serviceerror.NewInvalidArgument("Cannot delete system namespace.")
+	errInvalidNamespaceQuotas             = serviceerror.NewInvalidArgument("Namespace quotas must not be negative.")
 )
@@ -0,0 +1,71 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package namespace
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/peer"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/authorization"
+)
+
+// appendFailoverEvent appends failover event to the failover history of the namespace,
+// only the most recent MaxFailoverHistorySize events are kept.
+func appendFailoverEvent(
+	history []*persistencespb.NamespaceFailoverEvent,
+	fromCluster string,
+	toCluster string,
+	failoverVersion int64,
+	identity string,
+) []*persistencespb.NamespaceFailoverEvent {
+
+	failoverTime := time.Now().UTC()
+	history = append(history, &persistencespb.NamespaceFailoverEvent{
+		FailoverTime:    &failoverTime,
+		FromCluster:     fromCluster,
+		ToCluster:       toCluster,
+		FailoverVersion: failoverVersion,
+		Identity:        identity,
+	})
+	if len(history) > MaxFailoverHistorySize {
+		history = history[len(history)-MaxFailoverHistorySize:]
+	}
+	return history
+}
+
+// failoverIdentity returns identity of the caller requesting namespace failover:
+// subject of the authorization claims if present, peer address otherwise.
+func failoverIdentity(ctx context.Context) string {
+	if claims, ok := ctx.Value(authorization.MappedClaims).(*authorization.Claims); ok && claims.Subject != "" {
+		return claims.Subject
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+	return ""
+}
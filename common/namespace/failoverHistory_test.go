@@ -0,0 +1,82 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package namespace
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/peer"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/authorization"
+)
+
+type (
+	failoverHistorySuite struct {
+		suite.Suite
+		*require.Assertions
+	}
+)
+
+func TestFailoverHistorySuite(t *testing.T) {
+	s := new(failoverHistorySuite)
+	suite.Run(t, s)
+}
+
+func (s *failoverHistorySuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *failoverHistorySuite) TestAppendFailoverEvent() {
+	var history []*persistencespb.NamespaceFailoverEvent
+	history = appendFailoverEvent(history, "cluster-a", "cluster-b", 2, "operator")
+	s.Len(history, 1)
+	s.Equal("cluster-a", history[0].GetFromCluster())
+	s.Equal("cluster-b", history[0].GetToCluster())
+	s.Equal(int64(2), history[0].GetFailoverVersion())
+	s.Equal("operator", history[0].GetIdentity())
+	s.NotNil(history[0].GetFailoverTime())
+
+	for i := 0; i < MaxFailoverHistorySize; i++ {
+		history = appendFailoverEvent(history, "cluster-b", "cluster-a", int64(i+3), "")
+	}
+	s.Len(history, MaxFailoverHistorySize)
+	s.Equal(int64(3), history[0].GetFailoverVersion())
+	s.Equal(int64(MaxFailoverHistorySize+2), history[MaxFailoverHistorySize-1].GetFailoverVersion())
+}
+
+func (s *failoverHistorySuite) TestFailoverIdentity() {
+	s.Equal("", failoverIdentity(context.Background()))
+
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 7233}})
+	s.Equal("127.0.0.1:7233", failoverIdentity(ctx))
+
+	ctx = context.WithValue(ctx, authorization.MappedClaims, &authorization.Claims{Subject: "operator"})
+	s.Equal("operator", failoverIdentity(ctx))
+}
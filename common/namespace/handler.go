@@ -87,6 +87,28 @@ type (
 			ctx context.Context,
 			updateRequest *workflowservice.UpdateNamespaceRequest,
 		) (*workflowservice.UpdateNamespaceResponse, error)
+		StartNamespaceHandover(
+			ctx context.Context,
+			name string,
+			handoverClusterName string,
+		) error
+		AbortNamespaceHandover(
+			ctx context.Context,
+			name string,
+		) error
+		DeleteNamespace(
+			ctx context.Context,
+			name string,
+		) (string, error)
+		DescribeNamespaceQuotas(
+			ctx context.Context,
+			name string,
+		) (*persistencespb.NamespaceQuotas, error)
+		UpdateNamespaceQuotas(
+			ctx context.Context,
+			name string,
+			quotas *persistencespb.NamespaceQuotas,
+		) error
 	}
 
 	// HandlerImpl is the namespace operation handler implementation
@@ -394,7 +416,12 @@ func (d *HandlerImpl) UpdateNamespace(
 	configVersion := getResponse.Namespace.ConfigVersion
 	failoverVersion := getResponse.Namespace.FailoverVersion
 	failoverNotificationVersion := getResponse.Namespace.FailoverNotificationVersion
+	failoverHistory := getResponse.Namespace.FailoverHistory
 	isGlobalNamespace := getResponse.IsGlobalNamespace
+	previousActiveClusterName := replicationConfig.ActiveClusterName
+	if info.State == enumspb.NAMESPACE_STATE_DELETED {
+		return nil, errNamespaceDeleted
+	}
 
 	currentHistoryArchivalState := &ArchivalState{
 		State: config.HistoryArchivalState,
@@ -554,6 +581,17 @@ func (d *HandlerImpl) UpdateNamespace(
 				failoverVersion,
 			)
 			failoverNotificationVersion = notificationVersion
+			// failover completes or supersedes pending handover
+			clearHandoverState(replicationConfig)
+			if previousActiveClusterName != replicationConfig.ActiveClusterName {
+				failoverHistory = appendFailoverEvent(
+					failoverHistory,
+					previousActiveClusterName,
+					replicationConfig.ActiveClusterName,
+					failoverVersion,
+					failoverIdentity(ctx),
+				)
+			}
 		}
 
 		updateReq := &persistence.UpdateNamespaceRequest{
@@ -564,6 +602,7 @@ func (d *HandlerImpl) UpdateNamespace(
 				ConfigVersion:               configVersion,
 				FailoverVersion:             failoverVersion,
 				FailoverNotificationVersion: failoverNotificationVersion,
+				FailoverHistory:             failoverHistory,
 			},
 			NotificationVersion: notificationVersion,
 		}
@@ -634,6 +673,7 @@ func (d *HandlerImpl) DeprecateNamespace(
 			ConfigVersion:               getResponse.Namespace.ConfigVersion,
 			FailoverVersion:             getResponse.Namespace.FailoverVersion,
 			FailoverNotificationVersion: getResponse.Namespace.FailoverNotificationVersion,
+			FailoverHistory:             getResponse.Namespace.FailoverHistory,
 		},
 		NotificationVersion: notificationVersion,
 	}
@@ -34,6 +34,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	workflowservice "go.temporal.io/api/workflowservice/v1"
+	persistence "go.temporal.io/server/api/persistence/v1"
 )
 
 // MockHandler is a mock of Handler interface.
@@ -59,6 +60,35 @@ func (m *MockHandler) EXPECT() *MockHandlerMockRecorder {
 	return m.recorder
 }
 
+// AbortNamespaceHandover mocks base method.
+func (m *MockHandler) AbortNamespaceHandover(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AbortNamespaceHandover", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AbortNamespaceHandover indicates an expected call of AbortNamespaceHandover.
+func (mr *MockHandlerMockRecorder) AbortNamespaceHandover(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AbortNamespaceHandover", reflect.TypeOf((*MockHandler)(nil).AbortNamespaceHandover), ctx, name)
+}
+
+// DeleteNamespace mocks base method.
+func (m *MockHandler) DeleteNamespace(ctx context.Context, name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNamespace", ctx, name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNamespace indicates an expected call of DeleteNamespace.
+func (mr *MockHandlerMockRecorder) DeleteNamespace(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNamespace", reflect.TypeOf((*MockHandler)(nil).DeleteNamespace), ctx, name)
+}
+
 // DeprecateNamespace mocks base method.
 func (m *MockHandler) DeprecateNamespace(ctx context.Context, deprecateRequest *workflowservice.DeprecateNamespaceRequest) (*workflowservice.DeprecateNamespaceResponse, error) {
 	m.ctrl.T.Helper()
@@ -89,6 +119,21 @@ func (mr *MockHandlerMockRecorder) DescribeNamespace(ctx, describeRequest interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeNamespace", reflect.TypeOf((*MockHandler)(nil).DescribeNamespace), ctx, describeRequest)
 }
 
+// DescribeNamespaceQuotas mocks base method.
+func (m *MockHandler) DescribeNamespaceQuotas(ctx context.Context, name string) (*persistence.NamespaceQuotas, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeNamespaceQuotas", ctx, name)
+	ret0, _ := ret[0].(*persistence.NamespaceQuotas)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeNamespaceQuotas indicates an expected call of DescribeNamespaceQuotas.
+func (mr *MockHandlerMockRecorder) DescribeNamespaceQuotas(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeNamespaceQuotas", reflect.TypeOf((*MockHandler)(nil).DescribeNamespaceQuotas), ctx, name)
+}
+
 // ListNamespaces mocks base method.
 func (m *MockHandler) ListNamespaces(ctx context.Context, listRequest *workflowservice.ListNamespacesRequest) (*workflowservice.ListNamespacesResponse, error) {
 	m.ctrl.T.Helper()
@@ -119,6 +164,20 @@ func (mr *MockHandlerMockRecorder) RegisterNamespace(ctx, registerRequest interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterNamespace", reflect.TypeOf((*MockHandler)(nil).RegisterNamespace), ctx, registerRequest)
 }
 
+// StartNamespaceHandover mocks base method.
+func (m *MockHandler) StartNamespaceHandover(ctx context.Context, name, handoverClusterName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartNamespaceHandover", ctx, name, handoverClusterName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartNamespaceHandover indicates an expected call of StartNamespaceHandover.
+func (mr *MockHandlerMockRecorder) StartNamespaceHandover(ctx, name, handoverClusterName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartNamespaceHandover", reflect.TypeOf((*MockHandler)(nil).StartNamespaceHandover), ctx, name, handoverClusterName)
+}
+
 // UpdateNamespace mocks base method.
 func (m *MockHandler) UpdateNamespace(ctx context.Context, updateRequest *workflowservice.UpdateNamespaceRequest) (*workflowservice.UpdateNamespaceResponse, error) {
 	m.ctrl.T.Helper()
@@ -133,3 +192,17 @@ func (mr *MockHandlerMockRecorder) UpdateNamespace(ctx, updateRequest interface{
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNamespace", reflect.TypeOf((*MockHandler)(nil).UpdateNamespace), ctx, updateRequest)
 }
+
+// UpdateNamespaceQuotas mocks base method.
+func (m *MockHandler) UpdateNamespaceQuotas(ctx context.Context, name string, quotas *persistence.NamespaceQuotas) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNamespaceQuotas", ctx, name, quotas)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateNamespaceQuotas indicates an expected call of UpdateNamespaceQuotas.
+func (mr *MockHandlerMockRecorder) UpdateNamespaceQuotas(ctx, name, quotas interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNamespaceQuotas", reflect.TypeOf((*MockHandler)(nil).UpdateNamespaceQuotas), ctx, name, quotas)
+}
@@ -0,0 +1,142 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package namespace
+
+import (
+	"context"
+	"fmt"
+
+	"go.temporal.io/api/serviceerror"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence"
+)
+
+// StartNamespaceHandover puts namespace into handover state: namespace stays active in the current cluster
+// but workflows of the namespace can't make progress until handover is completed by failover to handover cluster
+// or aborted. Handover state is local to the current cluster and is not replicated.
+func (d *HandlerImpl) StartNamespaceHandover(
+	_ context.Context,
+	name string,
+	handoverClusterName string,
+) error {
+
+	return d.updateNamespaceReplicationState(name, func(getResponse *persistence.GetNamespaceResponse) (bool, error) {
+		replicationConfig := getResponse.Namespace.ReplicationConfig
+		if !getResponse.IsGlobalNamespace {
+			return false, serviceerror.NewInvalidArgument("Cannot handover local namespace.")
+		}
+		if replicationConfig.ActiveClusterName != d.clusterMetadata.GetCurrentClusterName() {
+			return false, serviceerror.NewInvalidArgument(fmt.Sprintf(
+				"Namespace is not active in current cluster %v, active cluster is %v.",
+				d.clusterMetadata.GetCurrentClusterName(),
+				replicationConfig.ActiveClusterName,
+			))
+		}
+		if handoverClusterName == replicationConfig.ActiveClusterName || !containsCluster(replicationConfig.Clusters, handoverClusterName) {
+			return false, serviceerror.NewInvalidArgument(fmt.Sprintf("Cluster %v is not a standby cluster of the namespace.", handoverClusterName))
+		}
+
+		if replicationConfig.State == enumsspb.NAMESPACE_REPLICATION_STATE_HANDOVER {
+			if replicationConfig.HandoverClusterName != handoverClusterName {
+				return false, serviceerror.NewInvalidArgument(fmt.Sprintf(
+					"Namespace is already in handover to cluster %v.",
+					replicationConfig.HandoverClusterName,
+				))
+			}
+			return false, nil
+		}
+
+		replicationConfig.State = enumsspb.NAMESPACE_REPLICATION_STATE_HANDOVER
+		replicationConfig.HandoverClusterName = handoverClusterName
+		return true, nil
+	})
+}
+
+// AbortNamespaceHandover moves namespace out of handover state without failover.
+func (d *HandlerImpl) AbortNamespaceHandover(
+	_ context.Context,
+	name string,
+) error {
+
+	return d.updateNamespaceReplicationState(name, func(getResponse *persistence.GetNamespaceResponse) (bool, error) {
+		replicationConfig := getResponse.Namespace.ReplicationConfig
+		if replicationConfig.State != enumsspb.NAMESPACE_REPLICATION_STATE_HANDOVER {
+			return false, nil
+		}
+		clearHandoverState(replicationConfig)
+		return true, nil
+	})
+}
+
+func (d *HandlerImpl) updateNamespaceReplicationState(
+	name string,
+	update func(getResponse *persistence.GetNamespaceResponse) (bool, error),
+) error {
+
+	// must get the metadata (notificationVersion) first, see UpdateNamespace
+	metadata, err := d.metadataMgr.GetMetadata()
+	if err != nil {
+		return err
+	}
+	getResponse, err := d.metadataMgr.GetNamespace(&persistence.GetNamespaceRequest{Name: name})
+	if err != nil {
+		return err
+	}
+
+	changed, err := update(getResponse)
+	if err != nil || !changed {
+		return err
+	}
+
+	if err := d.metadataMgr.UpdateNamespace(&persistence.UpdateNamespaceRequest{
+		Namespace:           getResponse.Namespace,
+		NotificationVersion: metadata.NotificationVersion,
+	}); err != nil {
+		return err
+	}
+
+	d.logger.Info("Update namespace replication state succeeded",
+		tag.WorkflowNamespace(name),
+		tag.Value(getResponse.Namespace.ReplicationConfig.State),
+	)
+	return nil
+}
+
+func clearHandoverState(replicationConfig *persistencespb.NamespaceReplicationConfig) {
+	replicationConfig.State = enumsspb.NAMESPACE_REPLICATION_STATE_NORMAL
+	replicationConfig.HandoverClusterName = ""
+}
+
+func containsCluster(clusters []string, clusterName string) bool {
+	for _, c := range clusters {
+		if c == clusterName {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,150 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package namespace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/serviceerror"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+)
+
+type (
+	handoverSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller      *gomock.Controller
+		mockMetadataMgr *persistence.MockMetadataManager
+		handler         *HandlerImpl
+	}
+)
+
+func TestHandoverSuite(t *testing.T) {
+	s := new(handoverSuite)
+	suite.Run(t, s)
+}
+
+func (s *handoverSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.mockMetadataMgr = persistence.NewMockMetadataManager(s.controller)
+	s.handler = NewHandler(
+		nil,
+		log.NewNoopLogger(),
+		s.mockMetadataMgr,
+		cluster.NewMetadata(
+			true,
+			int64(10),
+			cluster.TestCurrentClusterName,
+			cluster.TestCurrentClusterName,
+			cluster.TestAllClusterInfo,
+		),
+		nil,
+		nil,
+		nil,
+	)
+}
+
+func (s *handoverSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *handoverSuite) TestStartNamespaceHandover() {
+	getResponse := s.newGetNamespaceResponse(true)
+	s.mockMetadataMgr.EXPECT().GetMetadata().Return(&persistence.GetMetadataResponse{NotificationVersion: 5}, nil)
+	s.mockMetadataMgr.EXPECT().GetNamespace(&persistence.GetNamespaceRequest{Name: "test-namespace"}).Return(getResponse, nil)
+	s.mockMetadataMgr.EXPECT().UpdateNamespace(gomock.Any()).DoAndReturn(func(request *persistence.UpdateNamespaceRequest) error {
+		s.Equal(int64(5), request.NotificationVersion)
+		s.Equal(enumsspb.NAMESPACE_REPLICATION_STATE_HANDOVER, request.Namespace.ReplicationConfig.State)
+		s.Equal(cluster.TestAlternativeClusterName, request.Namespace.ReplicationConfig.HandoverClusterName)
+		s.Equal(cluster.TestCurrentClusterName, request.Namespace.ReplicationConfig.ActiveClusterName)
+		return nil
+	})
+
+	err := s.handler.StartNamespaceHandover(context.Background(), "test-namespace", cluster.TestAlternativeClusterName)
+	s.NoError(err)
+
+	// handover to the same cluster is idempotent
+	s.mockMetadataMgr.EXPECT().GetMetadata().Return(&persistence.GetMetadataResponse{NotificationVersion: 6}, nil)
+	s.mockMetadataMgr.EXPECT().GetNamespace(gomock.Any()).Return(getResponse, nil)
+	err = s.handler.StartNamespaceHandover(context.Background(), "test-namespace", cluster.TestAlternativeClusterName)
+	s.NoError(err)
+}
+
+func (s *handoverSuite) TestStartNamespaceHandover_InvalidRequest() {
+	s.mockMetadataMgr.EXPECT().GetMetadata().Return(&persistence.GetMetadataResponse{}, nil).Times(3)
+	s.mockMetadataMgr.EXPECT().GetNamespace(gomock.Any()).Return(s.newGetNamespaceResponse(false), nil)
+	err := s.handler.StartNamespaceHandover(context.Background(), "test-namespace", cluster.TestAlternativeClusterName)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	s.mockMetadataMgr.EXPECT().GetNamespace(gomock.Any()).Return(s.newGetNamespaceResponse(true), nil)
+	err = s.handler.StartNamespaceHandover(context.Background(), "test-namespace", cluster.TestCurrentClusterName)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	notActive := s.newGetNamespaceResponse(true)
+	notActive.Namespace.ReplicationConfig.ActiveClusterName = cluster.TestAlternativeClusterName
+	s.mockMetadataMgr.EXPECT().GetNamespace(gomock.Any()).Return(notActive, nil)
+	err = s.handler.StartNamespaceHandover(context.Background(), "test-namespace", cluster.TestAlternativeClusterName)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
+func (s *handoverSuite) TestAbortNamespaceHandover() {
+	getResponse := s.newGetNamespaceResponse(true)
+	getResponse.Namespace.ReplicationConfig.State = enumsspb.NAMESPACE_REPLICATION_STATE_HANDOVER
+	getResponse.Namespace.ReplicationConfig.HandoverClusterName = cluster.TestAlternativeClusterName
+	s.mockMetadataMgr.EXPECT().GetMetadata().Return(&persistence.GetMetadataResponse{NotificationVersion: 5}, nil)
+	s.mockMetadataMgr.EXPECT().GetNamespace(gomock.Any()).Return(getResponse, nil)
+	s.mockMetadataMgr.EXPECT().UpdateNamespace(gomock.Any()).DoAndReturn(func(request *persistence.UpdateNamespaceRequest) error {
+		s.Equal(enumsspb.NAMESPACE_REPLICATION_STATE_NORMAL, request.Namespace.ReplicationConfig.State)
+		s.Empty(request.Namespace.ReplicationConfig.HandoverClusterName)
+		return nil
+	})
+
+	err := s.handler.AbortNamespaceHandover(context.Background(), "test-namespace")
+	s.NoError(err)
+}
+
+func (s *handoverSuite) newGetNamespaceResponse(isGlobalNamespace bool) *persistence.GetNamespaceResponse {
+	return &persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{Name: "test-namespace"},
+			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters:          []string{cluster.TestCurrentClusterName, cluster.TestAlternativeClusterName},
+			},
+		},
+		IsGlobalNamespace: isGlobalNamespace,
+	}
+}
@@ -0,0 +1,115 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package namespace
+
+import (
+	"context"
+
+	enumspb "go.temporal.io/api/enums/v1"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence"
+)
+
+// DescribeNamespaceQuotas returns the quotas stored in namespace metadata, it is never nil.
+func (d *HandlerImpl) DescribeNamespaceQuotas(
+	_ context.Context,
+	name string,
+) (*persistencespb.NamespaceQuotas, error) {
+
+	getResponse, err := d.metadataMgr.GetNamespace(&persistence.GetNamespaceRequest{Name: name})
+	if err != nil {
+		return nil, err
+	}
+	if quotas := getResponse.Namespace.Config.GetQuotas(); quotas != nil {
+		return quotas, nil
+	}
+	return &persistencespb.NamespaceQuotas{}, nil
+}
+
+// UpdateNamespaceQuotas replaces the quotas of namespace. Quotas of a global namespace can only be
+// updated in the master cluster and are replicated to remote clusters like other namespace config.
+// Quotas are never stored as nil, as replication treats missing quotas as unchanged.
+func (d *HandlerImpl) UpdateNamespaceQuotas(
+	_ context.Context,
+	name string,
+	quotas *persistencespb.NamespaceQuotas,
+) error {
+
+	if quotas.GetMaxStartWorkflowRps() < 0 || quotas.GetMaxPendingWorkflows() < 0 || quotas.GetMaxActionsPerSecond() < 0 {
+		return errInvalidNamespaceQuotas
+	}
+	if quotas == nil {
+		quotas = &persistencespb.NamespaceQuotas{}
+	}
+
+	// must get the metadata (notificationVersion) first, see UpdateNamespace
+	metadata, err := d.metadataMgr.GetMetadata()
+	if err != nil {
+		return err
+	}
+	getResponse, err := d.metadataMgr.GetNamespace(&persistence.GetNamespaceRequest{Name: name})
+	if err != nil {
+		return err
+	}
+	namespace := getResponse.Namespace
+	if namespace.Info.State == enumspb.NAMESPACE_STATE_DELETED {
+		return errNamespaceDeleted
+	}
+	if getResponse.IsGlobalNamespace && d.clusterMetadata.IsGlobalNamespaceEnabled() && !d.clusterMetadata.IsMasterCluster() {
+		return errNotMasterCluster
+	}
+
+	namespace.Config.Quotas = quotas
+	namespace.ConfigVersion++
+	if err := d.metadataMgr.UpdateNamespace(&persistence.UpdateNamespaceRequest{
+		Namespace:           namespace,
+		NotificationVersion: metadata.NotificationVersion,
+	}); err != nil {
+		return err
+	}
+
+	if getResponse.IsGlobalNamespace {
+		if err := d.namespaceReplicator.HandleTransmissionTask(
+			enumsspb.NAMESPACE_OPERATION_UPDATE,
+			namespace.Info,
+			namespace.Config,
+			namespace.ReplicationConfig,
+			namespace.ConfigVersion,
+			namespace.FailoverVersion,
+			getResponse.IsGlobalNamespace,
+		); err != nil {
+			return err
+		}
+	}
+
+	d.logger.Info("Update namespace quotas succeeded",
+		tag.WorkflowNamespace(name),
+		tag.Value(quotas),
+	)
+	return nil
+}
@@ -0,0 +1,163 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package namespace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+)
+
+type (
+	quotasSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller      *gomock.Controller
+		mockMetadataMgr *persistence.MockMetadataManager
+		handler         *HandlerImpl
+	}
+)
+
+func TestQuotasSuite(t *testing.T) {
+	s := new(quotasSuite)
+	suite.Run(t, s)
+}
+
+func (s *quotasSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.mockMetadataMgr = persistence.NewMockMetadataManager(s.controller)
+	s.handler = NewHandler(
+		nil,
+		log.NewNoopLogger(),
+		s.mockMetadataMgr,
+		cluster.NewMetadata(
+			true,
+			int64(10),
+			cluster.TestCurrentClusterName,
+			cluster.TestCurrentClusterName,
+			cluster.TestAllClusterInfo,
+		),
+		nil,
+		nil,
+		nil,
+	)
+}
+
+func (s *quotasSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *quotasSuite) TestDescribeNamespaceQuotas() {
+	getResponse := s.newGetNamespaceResponse()
+	s.mockMetadataMgr.EXPECT().GetNamespace(&persistence.GetNamespaceRequest{Name: "test-namespace"}).Return(getResponse, nil)
+	quotas, err := s.handler.DescribeNamespaceQuotas(context.Background(), "test-namespace")
+	s.NoError(err)
+	s.Equal(&persistencespb.NamespaceQuotas{}, quotas)
+
+	getResponse.Namespace.Config.Quotas = &persistencespb.NamespaceQuotas{MaxPendingWorkflows: 100}
+	s.mockMetadataMgr.EXPECT().GetNamespace(gomock.Any()).Return(getResponse, nil)
+	quotas, err = s.handler.DescribeNamespaceQuotas(context.Background(), "test-namespace")
+	s.NoError(err)
+	s.Equal(int64(100), quotas.GetMaxPendingWorkflows())
+}
+
+func (s *quotasSuite) TestUpdateNamespaceQuotas() {
+	quotas := &persistencespb.NamespaceQuotas{
+		MaxStartWorkflowRps: 10,
+		MaxPendingWorkflows: 1000,
+		MaxActionsPerSecond: 50,
+	}
+	s.mockMetadataMgr.EXPECT().GetMetadata().Return(&persistence.GetMetadataResponse{NotificationVersion: 5}, nil)
+	s.mockMetadataMgr.EXPECT().GetNamespace(&persistence.GetNamespaceRequest{Name: "test-namespace"}).Return(s.newGetNamespaceResponse(), nil)
+	s.mockMetadataMgr.EXPECT().UpdateNamespace(gomock.Any()).DoAndReturn(func(request *persistence.UpdateNamespaceRequest) error {
+		s.Equal(int64(5), request.NotificationVersion)
+		s.Equal(quotas, request.Namespace.Config.Quotas)
+		s.Equal(int64(2), request.Namespace.ConfigVersion)
+		return nil
+	})
+
+	err := s.handler.UpdateNamespaceQuotas(context.Background(), "test-namespace", quotas)
+	s.NoError(err)
+}
+
+func (s *quotasSuite) TestUpdateNamespaceQuotas_Clear() {
+	getResponse := s.newGetNamespaceResponse()
+	getResponse.Namespace.Config.Quotas = &persistencespb.NamespaceQuotas{MaxPendingWorkflows: 1000}
+	s.mockMetadataMgr.EXPECT().GetMetadata().Return(&persistence.GetMetadataResponse{NotificationVersion: 5}, nil)
+	s.mockMetadataMgr.EXPECT().GetNamespace(gomock.Any()).Return(getResponse, nil)
+	s.mockMetadataMgr.EXPECT().UpdateNamespace(gomock.Any()).DoAndReturn(func(request *persistence.UpdateNamespaceRequest) error {
+		// cleared quotas are stored as empty, so they are replicated as cleared rather than unchanged
+		s.Equal(&persistencespb.NamespaceQuotas{}, request.Namespace.Config.Quotas)
+		return nil
+	})
+
+	err := s.handler.UpdateNamespaceQuotas(context.Background(), "test-namespace", nil)
+	s.NoError(err)
+}
+
+func (s *quotasSuite) TestUpdateNamespaceQuotas_Negative() {
+	err := s.handler.UpdateNamespaceQuotas(context.Background(), "test-namespace", &persistencespb.NamespaceQuotas{MaxStartWorkflowRps: -1})
+	s.Equal(errInvalidNamespaceQuotas, err)
+}
+
+func (s *quotasSuite) TestUpdateNamespaceQuotas_Deleted() {
+	getResponse := s.newGetNamespaceResponse()
+	getResponse.Namespace.Info.State = enumspb.NAMESPACE_STATE_DELETED
+	s.mockMetadataMgr.EXPECT().GetMetadata().Return(&persistence.GetMetadataResponse{NotificationVersion: 5}, nil)
+	s.mockMetadataMgr.EXPECT().GetNamespace(gomock.Any()).Return(getResponse, nil)
+
+	err := s.handler.UpdateNamespaceQuotas(context.Background(), "test-namespace", &persistencespb.NamespaceQuotas{})
+	s.Equal(errNamespaceDeleted, err)
+}
+
+func (s *quotasSuite) newGetNamespaceResponse() *persistence.GetNamespaceResponse {
+	return &persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{
+				Id:    "test-namespace-id",
+				Name:  "test-namespace",
+				State: enumspb.NAMESPACE_STATE_REGISTERED,
+			},
+			Config: &persistencespb.NamespaceConfig{},
+			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters:          []string{cluster.TestCurrentClusterName},
+			},
+			ConfigVersion: 1,
+		},
+		IsGlobalNamespace: false,
+	}
+}
@@ -35,7 +35,10 @@ import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
 )
 
 var (
@@ -57,6 +60,10 @@ var (
 	ErrInvalidNamespaceFailoverVersion = serviceerror.NewInvalidArgument("invalid namespace failover version attribute")
 	// ErrInvalidNamespaceState is the error to indicate invalid namespace state
 	ErrInvalidNamespaceState = serviceerror.NewInvalidArgument("invalid namespace state attribute")
+	// ErrEmptySearchAttributesReplicationTask is the error to indicate empty search attributes replication task
+	ErrEmptySearchAttributesReplicationTask = serviceerror.NewInvalidArgument("empty search attributes replication task")
+	// ErrInvalidSearchAttributesUpdateTime is the error to indicate empty search attributes last update time attribute
+	ErrInvalidSearchAttributesUpdateTime = serviceerror.NewInvalidArgument("invalid search attributes last update time attribute")
 	// ErrNameUUIDCollision is the error to indicate namespace name / UUID collision
 	ErrNameUUIDCollision = serviceerror.NewInvalidArgument("namespace replication encounter name / UUID collision")
 )
@@ -67,23 +74,27 @@ type (
 	// ReplicationTaskExecutor is the interface which is to execute namespace replication task
 	ReplicationTaskExecutor interface {
 		Execute(task *replicationspb.NamespaceTaskAttributes) error
+		ExecuteSearchAttributesTask(task *replicationspb.SearchAttributesTaskAttributes) error
 	}
 
 	namespaceReplicationTaskExecutorImpl struct {
-		metadataManagerV2 persistence.MetadataManager
-		logger            log.Logger
+		metadataManagerV2       persistence.MetadataManager
+		searchAttributesManager searchattribute.Manager
+		logger                  log.Logger
 	}
 )
 
 // NewReplicationTaskExecutor create a new instance of namespace replicator
 func NewReplicationTaskExecutor(
 	metadataManagerV2 persistence.MetadataManager,
+	searchAttributesManager searchattribute.Manager,
 	logger log.Logger,
 ) ReplicationTaskExecutor {
 
 	return &namespaceReplicationTaskExecutorImpl{
-		metadataManagerV2: metadataManagerV2,
-		logger:            logger,
+		metadataManagerV2:       metadataManagerV2,
+		searchAttributesManager: searchAttributesManager,
+		logger:                  logger,
 	}
 }
 
@@ -103,6 +114,30 @@ func (h *namespaceReplicationTaskExecutorImpl) Execute(task *replicationspb.Name
 	}
 }
 
+// ExecuteSearchAttributesTask handles receiving of the search attributes replication task.
+// Search attributes which are older than the ones known to the current cluster are ignored.
+func (h *namespaceReplicationTaskExecutorImpl) ExecuteSearchAttributesTask(task *replicationspb.SearchAttributesTaskAttributes) error {
+	if task == nil {
+		return ErrEmptySearchAttributesReplicationTask
+	}
+	if task.LastUpdateTime == nil {
+		return ErrInvalidSearchAttributesUpdateTime
+	}
+
+	applied, err := h.searchAttributesManager.ApplyReplicatedSearchAttributes(
+		task.GetIndexName(),
+		task.GetCustomSearchAttributes(),
+		timestamp.TimeValue(task.GetLastUpdateTime()),
+	)
+	if err != nil {
+		return err
+	}
+	if !applied {
+		h.logger.Info("Ignored stale search attributes replication task.", tag.ESIndex(task.GetIndexName()))
+	}
+	return nil
+}
+
 // handleNamespaceCreationReplicationTask handles the namespace creation replication task
 func (h *namespaceReplicationTaskExecutorImpl) handleNamespaceCreationReplicationTask(task *replicationspb.NamespaceTaskAttributes) error {
 	// task already validated
@@ -127,6 +162,7 @@ func (h *namespaceReplicationTaskExecutorImpl) handleNamespaceCreationReplicatio
 				HistoryArchivalUri:      task.Config.GetHistoryArchivalUri(),
 				VisibilityArchivalState: task.Config.GetVisibilityArchivalState(),
 				VisibilityArchivalUri:   task.Config.GetVisibilityArchivalUri(),
+				Quotas:                  task.GetQuotas(),
 			},
 			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
 				ActiveClusterName: task.ReplicationConfig.GetActiveClusterName(),
@@ -224,6 +260,11 @@ func (h *namespaceReplicationTaskExecutorImpl) handleNamespaceUpdateReplicationT
 
 	if resp.Namespace.ConfigVersion < task.GetConfigVersion() {
 		recordUpdated = true
+		// tasks of clusters which predate namespace quotas don't carry them, local quotas are left unchanged
+		quotas := resp.Namespace.Config.GetQuotas()
+		if task.GetQuotas() != nil {
+			quotas = task.GetQuotas()
+		}
 		request.Namespace.Info = &persistencespb.NamespaceInfo{
 			Id:          task.GetId(),
 			Name:        task.Info.GetName(),
@@ -238,6 +279,7 @@ func (h *namespaceReplicationTaskExecutorImpl) handleNamespaceUpdateReplicationT
 			HistoryArchivalUri:      task.Config.GetHistoryArchivalUri(),
 			VisibilityArchivalState: task.Config.GetVisibilityArchivalState(),
 			VisibilityArchivalUri:   task.Config.GetVisibilityArchivalUri(),
+			Quotas:                  quotas,
 		}
 		if task.Config.GetBadBinaries() != nil {
 			request.Namespace.Config.BadBinaries = task.Config.GetBadBinaries()
@@ -247,6 +289,15 @@ func (h *namespaceReplicationTaskExecutorImpl) handleNamespaceUpdateReplicationT
 	}
 	if resp.Namespace.FailoverVersion < task.GetFailoverVersion() {
 		recordUpdated = true
+		if resp.Namespace.ReplicationConfig.ActiveClusterName != task.ReplicationConfig.GetActiveClusterName() {
+			request.Namespace.FailoverHistory = appendFailoverEvent(
+				request.Namespace.FailoverHistory,
+				resp.Namespace.ReplicationConfig.ActiveClusterName,
+				task.ReplicationConfig.GetActiveClusterName(),
+				task.GetFailoverVersion(),
+				"",
+			)
+		}
 		request.Namespace.ReplicationConfig.ActiveClusterName = task.ReplicationConfig.GetActiveClusterName()
 		request.Namespace.FailoverVersion = task.GetFailoverVersion()
 		request.Namespace.FailoverNotificationVersion = notificationVersion
@@ -288,7 +339,7 @@ func (h *namespaceReplicationTaskExecutorImpl) convertClusterReplicationConfigFr
 
 func (h *namespaceReplicationTaskExecutorImpl) validateNamespaceStatus(input enumspb.NamespaceState) error {
 	switch input {
-	case enumspb.NAMESPACE_STATE_REGISTERED, enumspb.NAMESPACE_STATE_DEPRECATED:
+	case enumspb.NAMESPACE_STATE_REGISTERED, enumspb.NAMESPACE_STATE_DEPRECATED, enumspb.NAMESPACE_STATE_DELETED:
 		return nil
 	default:
 		return ErrInvalidNamespaceState
@@ -36,6 +36,7 @@ import (
 	"go.temporal.io/api/serviceerror"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/persistence"
@@ -68,6 +69,7 @@ func (s *namespaceReplicationTaskExecutorSuite) SetupTest() {
 	logger := log.NewTestLogger()
 	s.namespaceReplicator = NewReplicationTaskExecutor(
 		s.MetadataManager,
+		s.SearchAttributesManager,
 		logger,
 	).(*namespaceReplicationTaskExecutorImpl)
 }
@@ -561,6 +563,50 @@ func (s *namespaceReplicationTaskExecutorSuite) TestExecute_UpdateNamespaceTask_
 	s.Equal(notificationVersion, resp.NotificationVersion)
 }
 
+func (s *namespaceReplicationTaskExecutorSuite) TestExecute_UpdateNamespaceTask_MissingQuotas() {
+	id := uuid.New()
+	name := "some random namespace test name"
+	retention := 10 * time.Hour * 24
+	quotas := &persistencespb.NamespaceQuotas{MaxStartWorkflowRps: 10, MaxPendingWorkflows: 1000}
+	newTask := func(operation enumsspb.NamespaceOperation, configVersion int64, quotas *persistencespb.NamespaceQuotas) *replicationspb.NamespaceTaskAttributes {
+		return &replicationspb.NamespaceTaskAttributes{
+			NamespaceOperation: operation,
+			Id:                 id,
+			Info: &namespacepb.NamespaceInfo{
+				Name:  name,
+				State: enumspb.NAMESPACE_STATE_REGISTERED,
+			},
+			Config: &namespacepb.NamespaceConfig{
+				WorkflowExecutionRetentionTtl: &retention,
+			},
+			ReplicationConfig: &replicationpb.NamespaceReplicationConfig{
+				ActiveClusterName: "some random active cluster name",
+				Clusters:          []*replicationpb.ClusterReplicationConfig{{ClusterName: "some random active cluster name"}},
+			},
+			ConfigVersion: configVersion,
+			Quotas:        quotas,
+		}
+	}
+
+	err := s.namespaceReplicator.Execute(newTask(enumsspb.NAMESPACE_OPERATION_CREATE, 0, quotas))
+	s.Nil(err)
+
+	// task of a cluster which predates namespace quotas
+	err = s.namespaceReplicator.Execute(newTask(enumsspb.NAMESPACE_OPERATION_UPDATE, 1, nil))
+	s.Nil(err)
+	resp, err := s.MetadataManager.GetNamespace(&persistence.GetNamespaceRequest{Name: name})
+	s.Nil(err)
+	s.Equal(int64(1), resp.Namespace.ConfigVersion)
+	s.Equal(quotas, resp.Namespace.Config.Quotas)
+
+	err = s.namespaceReplicator.Execute(newTask(enumsspb.NAMESPACE_OPERATION_UPDATE, 2, &persistencespb.NamespaceQuotas{}))
+	s.Nil(err)
+	resp, err = s.MetadataManager.GetNamespace(&persistence.GetNamespaceRequest{Name: name})
+	s.Nil(err)
+	s.Equal(int64(2), resp.Namespace.ConfigVersion)
+	s.Equal(&persistencespb.NamespaceQuotas{}, resp.Namespace.Config.Quotas)
+}
+
 func (s *namespaceReplicationTaskExecutorSuite) TestExecute_UpdateNamespaceTask_NoUpdateConfig_UpdateActiveCluster() {
 	operation := enumsspb.NAMESPACE_OPERATION_CREATE
 	id := uuid.New()
@@ -807,3 +853,38 @@ func (s *namespaceReplicationTaskExecutorSuite) TestExecute_UpdateNamespaceTask_
 	s.Equal(int64(0), resp.Namespace.FailoverNotificationVersion)
 	s.Equal(notificationVersion, resp.NotificationVersion)
 }
+
+func (s *namespaceReplicationTaskExecutorSuite) TestExecuteSearchAttributesTask() {
+	indexName := "test-index-" + uuid.New()
+	lastUpdateTime := time.Now().UTC()
+	task := &replicationspb.SearchAttributesTaskAttributes{
+		IndexName: indexName,
+		CustomSearchAttributes: map[string]enumspb.IndexedValueType{
+			"CustomKeywordField": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		},
+		LastUpdateTime: &lastUpdateTime,
+	}
+	err := s.namespaceReplicator.ExecuteSearchAttributesTask(task)
+	s.NoError(err)
+
+	staleUpdateTime := lastUpdateTime.Add(-time.Minute)
+	staleTask := &replicationspb.SearchAttributesTaskAttributes{
+		IndexName: indexName,
+		CustomSearchAttributes: map[string]enumspb.IndexedValueType{
+			"CustomKeywordField": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+			"CustomIntField":     enumspb.INDEXED_VALUE_TYPE_INT,
+		},
+		LastUpdateTime: &staleUpdateTime,
+	}
+	err = s.namespaceReplicator.ExecuteSearchAttributesTask(staleTask)
+	s.NoError(err)
+
+	searchAttributes, err := s.SearchAttributesManager.GetSearchAttributes(indexName, true)
+	s.NoError(err)
+	s.Equal(task.CustomSearchAttributes, searchAttributes.Custom())
+}
+
+func (s *namespaceReplicationTaskExecutorSuite) TestExecuteSearchAttributesTask_NoUpdateTime() {
+	err := s.namespaceReplicator.ExecuteSearchAttributesTask(&replicationspb.SearchAttributesTaskAttributes{IndexName: "test-index"})
+	s.Equal(ErrInvalidSearchAttributesUpdateTime, err)
+}
@@ -71,3 +71,17 @@ func (mr *MockReplicationTaskExecutorMockRecorder) Execute(task interface{}) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockReplicationTaskExecutor)(nil).Execute), task)
 }
+
+// ExecuteSearchAttributesTask mocks base method.
+func (m *MockReplicationTaskExecutor) ExecuteSearchAttributesTask(task *repication.SearchAttributesTaskAttributes) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteSearchAttributesTask", task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecuteSearchAttributesTask indicates an expected call of ExecuteSearchAttributesTask.
+func (mr *MockReplicationTaskExecutorMockRecorder) ExecuteSearchAttributesTask(task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteSearchAttributesTask", reflect.TypeOf((*MockReplicationTaskExecutor)(nil).ExecuteSearchAttributesTask), task)
+}
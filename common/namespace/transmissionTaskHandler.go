@@ -109,6 +109,7 @@ func (namespaceReplicator *namespaceReplicatorImpl) HandleTransmissionTask(
 			},
 			ConfigVersion:   configVersion,
 			FailoverVersion: failoverVersion,
+			Quotas:          config.GetQuotas(),
 		},
 	}
 
@@ -0,0 +1,171 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package pagetoken
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/config"
+)
+
+const (
+	versionSigned    byte = 1
+	versionEncrypted byte = 2
+)
+
+// ErrInvalidPageToken is returned when a page token was not issued by this cluster or was tampered with
+var ErrInvalidPageToken = serviceerror.NewInvalidArgument("Invalid NextPageToken.")
+
+type (
+	// Codec protects page tokens returned to clients from being forged. Tokens are bound to the namespace
+	// and the API which issued them, so they can't be replayed against another namespace or API.
+	Codec interface {
+		// Encode wraps a page token issued by the server before it is returned to the client
+		Encode(namespaceID string, api string, token []byte) ([]byte, error)
+		// Decode verifies and unwraps a page token received from the client
+		Decode(namespaceID string, api string, token []byte) ([]byte, error)
+	}
+
+	noopCodec struct{}
+
+	hmacCodec struct {
+		signingKey []byte
+		aead       cipher.AEAD
+	}
+)
+
+// NewCodec creates a page token codec from config, tokens are passed through as is if no signing key is set
+func NewCodec(cfg config.PageToken) (Codec, error) {
+	if cfg.SigningKey == "" {
+		return NewNoopCodec(), nil
+	}
+	signingKey, err := base64.StdEncoding.DecodeString(cfg.SigningKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode page token signing key: %w", err)
+	}
+	codec := &hmacCodec{signingKey: signingKey}
+	if cfg.EncryptionKey != "" {
+		encryptionKey, err := base64.StdEncoding.DecodeString(cfg.EncryptionKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode page token encryption key: %w", err)
+		}
+		block, err := aes.NewCipher(encryptionKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create page token cipher: %w", err)
+		}
+		if codec.aead, err = cipher.NewGCM(block); err != nil {
+			return nil, fmt.Errorf("unable to create page token cipher: %w", err)
+		}
+	}
+	return codec, nil
+}
+
+// NewNoopCodec creates a page token codec which passes tokens through as is
+func NewNoopCodec() Codec {
+	return noopCodec{}
+}
+
+func (noopCodec) Encode(_ string, _ string, token []byte) ([]byte, error) {
+	return token, nil
+}
+
+func (noopCodec) Decode(_ string, _ string, token []byte) ([]byte, error) {
+	return token, nil
+}
+
+// Encode returns version || body || HMAC-SHA256(namespace ID || API || version || body), where body is either
+// the token or nonce || AES-GCM sealed token if encryption is enabled. The namespace ID and API are length prefixed.
+func (c *hmacCodec) Encode(namespaceID string, api string, token []byte) ([]byte, error) {
+	if len(token) == 0 {
+		return token, nil
+	}
+
+	var result []byte
+	if c.aead != nil {
+		nonce := make([]byte, c.aead.NonceSize())
+		if _, err := rand.Read(nonce); err != nil {
+			return nil, serviceerror.NewInternal(fmt.Sprintf("unable to generate page token nonce: %v", err))
+		}
+		result = append(result, versionEncrypted)
+		result = append(result, nonce...)
+		result = c.aead.Seal(result, nonce, token, []byte{versionEncrypted})
+	} else {
+		result = append(result, versionSigned)
+		result = append(result, token...)
+	}
+	return append(result, c.sign(namespaceID, api, result)...), nil
+}
+
+func (c *hmacCodec) Decode(namespaceID string, api string, token []byte) ([]byte, error) {
+	if len(token) == 0 {
+		return token, nil
+	}
+	if len(token) < 1+sha256.Size {
+		return nil, ErrInvalidPageToken
+	}
+
+	payload, signature := token[:len(token)-sha256.Size], token[len(token)-sha256.Size:]
+	if !hmac.Equal(signature, c.sign(namespaceID, api, payload)) {
+		return nil, ErrInvalidPageToken
+	}
+
+	switch payload[0] {
+	case versionSigned:
+		return payload[1:], nil
+	case versionEncrypted:
+		if c.aead == nil || len(payload) < 1+c.aead.NonceSize() {
+			return nil, ErrInvalidPageToken
+		}
+		nonce := payload[1 : 1+c.aead.NonceSize()]
+		decrypted, err := c.aead.Open(nil, nonce, payload[1+c.aead.NonceSize():], []byte{versionEncrypted})
+		if err != nil {
+			return nil, ErrInvalidPageToken
+		}
+		return decrypted, nil
+	default:
+		return nil, ErrInvalidPageToken
+	}
+}
+
+func (c *hmacCodec) sign(namespaceID string, api string, payload []byte) []byte {
+	mac := hmac.New(sha256.New, c.signingKey)
+	for _, field := range []string{namespaceID, api} {
+		var length [4]byte
+		binary.BigEndian.PutUint32(length[:], uint32(len(field)))
+		_, _ = mac.Write(length[:])
+		_, _ = mac.Write([]byte(field))
+	}
+	_, _ = mac.Write(payload)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,160 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package pagetoken
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/common/config"
+)
+
+var (
+	testSigningKey    = base64.StdEncoding.EncodeToString([]byte("test-signing-key"))
+	testEncryptionKey = base64.StdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef"))
+	testNamespaceID   = "2d7b7e3f-1a8d-4c0e-9b1f-5c2f4a9e8d10"
+	testAPI           = "ListWorkflowExecutions"
+)
+
+func TestNoopCodec(t *testing.T) {
+	codec, err := NewCodec(config.PageToken{})
+	require.NoError(t, err)
+
+	token := []byte(`{"ScrollID":"abc"}`)
+	encoded, err := codec.Encode(testNamespaceID, testAPI, token)
+	require.NoError(t, err)
+	assert.Equal(t, token, encoded)
+	decoded, err := codec.Decode(testNamespaceID, testAPI, encoded)
+	require.NoError(t, err)
+	assert.Equal(t, token, decoded)
+}
+
+func TestSignedCodec(t *testing.T) {
+	codec, err := NewCodec(config.PageToken{SigningKey: testSigningKey})
+	require.NoError(t, err)
+
+	token := []byte(`{"ScrollID":"abc"}`)
+	encoded, err := codec.Encode(testNamespaceID, testAPI, token)
+	require.NoError(t, err)
+	assert.NotEqual(t, token, encoded)
+	decoded, err := codec.Decode(testNamespaceID, testAPI, encoded)
+	require.NoError(t, err)
+	assert.Equal(t, token, decoded)
+
+	empty, err := codec.Encode(testNamespaceID, testAPI, nil)
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+	empty, err = codec.Decode(testNamespaceID, testAPI, nil)
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+}
+
+func TestEncryptedCodec(t *testing.T) {
+	codec, err := NewCodec(config.PageToken{SigningKey: testSigningKey, EncryptionKey: testEncryptionKey})
+	require.NoError(t, err)
+
+	token := []byte(`{"ScrollID":"abc"}`)
+	encoded, err := codec.Encode(testNamespaceID, testAPI, token)
+	require.NoError(t, err)
+	assert.NotContains(t, string(encoded), "ScrollID")
+	decoded, err := codec.Decode(testNamespaceID, testAPI, encoded)
+	require.NoError(t, err)
+	assert.Equal(t, token, decoded)
+
+	// tokens signed before encryption was enabled are still accepted
+	signedCodec, err := NewCodec(config.PageToken{SigningKey: testSigningKey})
+	require.NoError(t, err)
+	signed, err := signedCodec.Encode(testNamespaceID, testAPI, token)
+	require.NoError(t, err)
+	decoded, err = codec.Decode(testNamespaceID, testAPI, signed)
+	require.NoError(t, err)
+	assert.Equal(t, token, decoded)
+}
+
+func TestDecode_TamperedToken(t *testing.T) {
+	for _, cfg := range []config.PageToken{
+		{SigningKey: testSigningKey},
+		{SigningKey: testSigningKey, EncryptionKey: testEncryptionKey},
+	} {
+		codec, err := NewCodec(cfg)
+		require.NoError(t, err)
+		encoded, err := codec.Encode(testNamespaceID, testAPI, []byte(`{"ScrollID":"abc"}`))
+		require.NoError(t, err)
+
+		for i := range encoded {
+			tampered := append([]byte(nil), encoded...)
+			tampered[i] ^= 0x01
+			_, err = codec.Decode(testNamespaceID, testAPI, tampered)
+			assert.Equal(t, ErrInvalidPageToken, err)
+		}
+
+		_, err = codec.Decode(testNamespaceID, testAPI, []byte(`{"ScrollID":"abc"}`))
+		assert.Equal(t, ErrInvalidPageToken, err)
+		_, err = codec.Decode(testNamespaceID, testAPI, encoded[:len(encoded)-1])
+		assert.Equal(t, ErrInvalidPageToken, err)
+	}
+}
+
+func TestDecode_DifferentKey(t *testing.T) {
+	codec, err := NewCodec(config.PageToken{SigningKey: testSigningKey})
+	require.NoError(t, err)
+	otherCodec, err := NewCodec(config.PageToken{SigningKey: base64.StdEncoding.EncodeToString([]byte("other-signing-key"))})
+	require.NoError(t, err)
+
+	encoded, err := otherCodec.Encode(testNamespaceID, testAPI, []byte(`{"ScrollID":"abc"}`))
+	require.NoError(t, err)
+	_, err = codec.Decode(testNamespaceID, testAPI, encoded)
+	assert.Equal(t, ErrInvalidPageToken, err)
+}
+
+func TestDecode_DifferentNamespaceOrAPI(t *testing.T) {
+	for _, cfg := range []config.PageToken{
+		{SigningKey: testSigningKey},
+		{SigningKey: testSigningKey, EncryptionKey: testEncryptionKey},
+	} {
+		codec, err := NewCodec(cfg)
+		require.NoError(t, err)
+		encoded, err := codec.Encode(testNamespaceID, testAPI, []byte(`{"ScrollID":"abc"}`))
+		require.NoError(t, err)
+
+		_, err = codec.Decode("8f0c6e52-3b7a-4d19-a2e4-7c91d05b6f3a", testAPI, encoded)
+		assert.Equal(t, ErrInvalidPageToken, err)
+		_, err = codec.Decode(testNamespaceID, "ScanWorkflowExecutions", encoded)
+		assert.Equal(t, ErrInvalidPageToken, err)
+		// the fields are length prefixed, so moving bytes between them doesn't produce the same MAC
+		_, err = codec.Decode(testNamespaceID+"L", testAPI[1:], encoded)
+		assert.Equal(t, ErrInvalidPageToken, err)
+	}
+}
+
+func TestNewCodec_InvalidKey(t *testing.T) {
+	_, err := NewCodec(config.PageToken{SigningKey: "not base64!"})
+	assert.Error(t, err)
+	_, err = NewCodec(config.PageToken{SigningKey: testSigningKey, EncryptionKey: base64.StdEncoding.EncodeToString([]byte("short"))})
+	assert.Error(t, err)
+}
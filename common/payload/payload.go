@@ -25,12 +25,16 @@
 package payload
 
 import (
+	"reflect"
+
 	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/sdk/converter"
 )
 
 var (
 	defaultDataConverter = converter.GetDefaultDataConverter()
+
+	payloadType = reflect.TypeOf((*commonpb.Payload)(nil))
 )
 
 func EncodeString(str string) *commonpb.Payload {
@@ -56,3 +60,55 @@ func Decode(p *commonpb.Payload, valuePtr interface{}) error {
 func ToString(p *commonpb.Payload) string {
 	return defaultDataConverter.ToString(p)
 }
+
+// MetadataSize returns the number of encoded bytes taken by the metadata of every payload reachable from value,
+// which is usually a generated proto message such as Payloads, Memo or Failure.
+func MetadataSize(value interface{}) int {
+	return metadataSize(reflect.ValueOf(value))
+}
+
+func metadataSize(v reflect.Value) int {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return 0
+		}
+		if v.Type() == payloadType {
+			p := v.Interface().(*commonpb.Payload)
+			return (&commonpb.Payload{Metadata: p.GetMetadata()}).Size()
+		}
+		return metadataSize(v.Elem())
+	case reflect.Interface:
+		if v.IsNil() {
+			return 0
+		}
+		return metadataSize(v.Elem())
+	case reflect.Struct:
+		size := 0
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).PkgPath != "" {
+				continue
+			}
+			size += metadataSize(v.Field(i))
+		}
+		return size
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return 0
+		}
+		size := 0
+		for i := 0; i < v.Len(); i++ {
+			size += metadataSize(v.Index(i))
+		}
+		return size
+	case reflect.Map:
+		size := 0
+		iter := v.MapRange()
+		for iter.Next() {
+			size += metadataSize(iter.Value())
+		}
+		return size
+	default:
+		return 0
+	}
+}
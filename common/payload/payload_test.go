@@ -28,6 +28,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	commonpb "go.temporal.io/api/common/v1"
+	failurepb "go.temporal.io/api/failure/v1"
 )
 
 type testStruct struct {
@@ -71,3 +73,31 @@ func TestToString(t *testing.T) {
 	result = ToString(nil)
 	assert.Equal("", result)
 }
+
+func TestMetadataSize(t *testing.T) {
+	assert := assert.New(t)
+
+	p := EncodeString("str")
+	pMetadataSize := (&commonpb.Payload{Metadata: p.GetMetadata()}).Size()
+	assert.True(pMetadataSize > 0)
+
+	assert.Equal(0, MetadataSize(nil))
+	assert.Equal(0, MetadataSize((*commonpb.Payloads)(nil)))
+	assert.Equal(pMetadataSize, MetadataSize(p))
+	assert.Equal(2*pMetadataSize, MetadataSize(&commonpb.Payloads{Payloads: []*commonpb.Payload{p, p}}))
+	assert.Equal(pMetadataSize, MetadataSize(&commonpb.Memo{Fields: map[string]*commonpb.Payload{"key": p}}))
+
+	failure := &failurepb.Failure{
+		Message: "failure",
+		FailureInfo: &failurepb.Failure_ApplicationFailureInfo{ApplicationFailureInfo: &failurepb.ApplicationFailureInfo{
+			Details: &commonpb.Payloads{Payloads: []*commonpb.Payload{p}},
+		}},
+		Cause: &failurepb.Failure{
+			Message: "cause",
+			FailureInfo: &failurepb.Failure_TimeoutFailureInfo{TimeoutFailureInfo: &failurepb.TimeoutFailureInfo{
+				LastHeartbeatDetails: &commonpb.Payloads{Payloads: []*commonpb.Payload{p}},
+			}},
+		},
+	}
+	assert.Equal(2*pMetadataSize, MetadataSize(failure))
+}
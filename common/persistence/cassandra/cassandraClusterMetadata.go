@@ -30,7 +30,6 @@ import (
 	"time"
 
 	"github.com/pborman/uuid"
-	"go.temporal.io/api/serviceerror"
 
 	"go.temporal.io/server/common/log"
 	p "go.temporal.io/server/common/persistence"
@@ -132,10 +131,8 @@ func (m *cassandraClusterMetadata) SaveClusterMetadata(request *p.InternalSaveCl
 	if err != nil {
 		return false, gocql.ConvertError("SaveClusterMetadata", err)
 	}
-	if !applied {
-		return false, serviceerror.NewInternal("SaveClusterMetadata operation encountered concurrent write.")
-	}
-	return true, nil
+	// not applied if cluster metadata was updated since the version was read
+	return applied, nil
 }
 
 func (m *cassandraClusterMetadata) GetClusterMembers(request *p.GetClusterMembersRequest) (*p.GetClusterMembersResponse, error) {
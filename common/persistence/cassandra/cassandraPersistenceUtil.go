@@ -556,6 +556,7 @@ func createTransferTasks(
 		targetWorkflowID := p.TransferTaskTransferTargetWorkflowID
 		targetRunID := ""
 		targetChildWorkflowOnly := false
+		var traceContext map[string]string
 
 		switch task.GetType() {
 		case enumsspb.TASK_TYPE_TRANSFER_ACTIVITY_TASK:
@@ -567,6 +568,7 @@ func createTransferTasks(
 			targetNamespaceID = task.(*p.WorkflowTask).NamespaceID
 			taskQueue = task.(*p.WorkflowTask).TaskQueue
 			scheduleID = task.(*p.WorkflowTask).ScheduleID
+			traceContext = task.(*p.WorkflowTask).TraceContext
 
 		case enumsspb.TASK_TYPE_TRANSFER_CANCEL_EXECUTION:
 			targetNamespaceID = task.(*p.CancelExecutionTask).TargetNamespaceID
@@ -611,6 +613,7 @@ func createTransferTasks(
 			Version:                 task.GetVersion(),
 			TaskId:                  task.GetTaskID(),
 			VisibilityTime:          timestamp.TimePtr(task.GetVisibilityTime()),
+			TraceContext:            traceContext,
 		}
 
 		dataBlob, err := serialization.TransferTaskInfoToBlob(transferTaskInfo)
@@ -717,6 +720,7 @@ func createVisibilityTasks(
 			TaskType:       task.GetType(),
 			Version:        task.GetVersion(),
 			VisibilityTime: timestamp.TimePtr(task.GetVisibilityTime()),
+			Backfill:       p.IsBackfillVisibilityTask(task),
 		})
 		if err != nil {
 			return err
@@ -101,6 +101,11 @@ type (
 	storeType int
 )
 
+const (
+	// PersistenceThrottleLimiterName is the limiter name reported to the throttle tracker
+	PersistenceThrottleLimiterName = "persistence_qps"
+)
+
 const (
 	storeTypeHistory storeType = iota + 1
 	storeTypeTask
@@ -127,11 +132,13 @@ var storeTypes = []storeType{
 // also contains config for individual datastores themselves.
 //
 // The objects returned by this factory enforce ratelimit and maxconns according to
-// given configuration. In addition, all objects will emit metrics automatically
+// given configuration. Requests rejected by the ratelimit are reported to the throttle
+// tracker if one is given. In addition, all objects will emit metrics automatically
 func NewFactory(
 	cfg *config.Persistence,
 	r resolver.ServiceResolver,
 	persistenceMaxQPS dynamicconfig.IntPropertyFn,
+	throttleTracker quotas.ThrottleTracker,
 	abstractDataStoreFactory AbstractDataStoreFactory,
 	clusterName string,
 	metricsClient metrics.Client,
@@ -144,7 +151,7 @@ func NewFactory(
 		logger:                   logger,
 		clusterName:              clusterName,
 	}
-	limiters := buildRateLimiters(cfg, persistenceMaxQPS)
+	limiters := buildRateLimiters(cfg, persistenceMaxQPS, throttleTracker)
 	factory.init(clusterName, limiters, r)
 	return factory
 }
@@ -320,14 +327,19 @@ func (f *factoryImpl) init(
 func buildRateLimiters(
 	cfg *config.Persistence,
 	maxQPS dynamicconfig.IntPropertyFn,
+	throttleTracker quotas.ThrottleTracker,
 ) map[string]quotas.RateLimiter {
 
 	result := make(map[string]quotas.RateLimiter, len(cfg.DataStores))
 	for dsName := range cfg.DataStores {
 		if maxQPS != nil && maxQPS() > 0 {
-			result[dsName] = quotas.NewDefaultOutgoingDynamicRateLimiter(
+			var rateLimiter quotas.RateLimiter = quotas.NewDefaultOutgoingDynamicRateLimiter(
 				func() float64 { return float64(maxQPS()) },
 			)
+			if throttleTracker != nil {
+				rateLimiter = quotas.NewThrottleTrackingRateLimiter(rateLimiter, throttleTracker, PersistenceThrottleLimiterName)
+			}
+			result[dsName] = rateLimiter
 		}
 	}
 	return result
@@ -230,6 +230,8 @@ type (
 		TaskQueue           string
 		ScheduleID          int64
 		Version             int64
+		// TraceContext is the trace context of the request which scheduled the workflow task
+		TraceContext map[string]string
 	}
 
 	// ResetWorkflowTask identifies a transfer task to reset workflow
@@ -355,6 +357,7 @@ type (
 		VisibilityTimestamp time.Time
 		TaskID              int64
 		Version             int64
+		Backfill            bool
 	}
 
 	// UpsertExecutionVisibilityTask identifies a visibility task for upsert workflow execution search attributes.
@@ -363,7 +366,8 @@ type (
 		TaskID              int64
 		// this version is not used by task processing for validation,
 		// instead, the version is used by elastic search
-		Version int64
+		Version  int64
+		Backfill bool
 	}
 
 	// CloseExecutionVisibilityTask identifies a visibility task for close workflow execution.
@@ -371,6 +375,7 @@ type (
 		VisibilityTimestamp time.Time
 		TaskID              int64
 		Version             int64
+		Backfill            bool
 	}
 
 	// DeleteExecutionVisibilityTask identifies a visibility task for deletion of execution.
@@ -378,6 +383,7 @@ type (
 		VisibilityTimestamp time.Time
 		TaskID              int64
 		Version             int64
+		Backfill            bool
 	}
 
 	// SyncActivityTask is the replication task created for shipping activity info to other clusters
@@ -1111,6 +1117,30 @@ type (
 	TrimHistoryBranchResponse struct {
 	}
 
+	// CompactHistoryBranchRequest is used to merge consecutive history nodes of a branch into larger nodes
+	CompactHistoryBranchRequest struct {
+		// The shard to compact history branch data
+		ShardID int32
+		// branch to be compacted, only nodes owned by the branch itself (not its ancestors) will be compacted
+		BranchToken []byte
+		// only compact history nodes before MaxEventID. Exclusive.
+		MaxEventID int64
+		// node IDs which must remain the first node ID of a (compacted) history node
+		PinnedNodeIDs []int64
+		// max number of events of a compacted history node
+		MaxEventCount int
+		// max size in bytes of a compacted history node
+		MaxSize int
+	}
+
+	// CompactHistoryBranchResponse is the response to CompactHistoryBranchRequest
+	CompactHistoryBranchResponse struct {
+		// number of history nodes of the branch before compaction
+		NodeCountBefore int
+		// number of history nodes of the branch after compaction
+		NodeCountAfter int
+	}
+
 	// GetHistoryTreeRequest is used to retrieve branch info of a history tree
 	GetHistoryTreeRequest struct {
 		// A UUID of a tree
@@ -1328,6 +1358,8 @@ type (
 		DeleteHistoryBranch(request *DeleteHistoryBranchRequest) error
 		// TrimHistoryBranch validate & trim a history branch
 		TrimHistoryBranch(request *TrimHistoryBranchRequest) (*TrimHistoryBranchResponse, error)
+		// CompactHistoryBranch merges consecutive history nodes of a branch into larger nodes
+		CompactHistoryBranch(request *CompactHistoryBranchRequest) (*CompactHistoryBranchResponse, error)
 		// GetHistoryTree returns all branch information of a tree
 		GetHistoryTree(request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error)
 		// GetAllHistoryTreeBranches returns all branches of all trees
@@ -1356,6 +1388,9 @@ type (
 		UpsertClusterMembership(request *UpsertClusterMembershipRequest) error
 		PruneClusterMembership(request *PruneClusterMembershipRequest) error
 		GetClusterMetadata() (*GetClusterMetadataResponse, error)
+		// SaveClusterMetadata saves the cluster metadata if it is still at the version of the request,
+		// which is the version returned by GetClusterMetadata or 0 to create it. Returns false without
+		// an error if it was updated concurrently or immutable fields were changed.
 		SaveClusterMetadata(request *SaveClusterMetadataRequest) (bool, error)
 	}
 )
@@ -1922,6 +1957,36 @@ func (a *HistoryReplicationTask) SetVisibilityTime(timestamp time.Time) {
 	a.VisibilityTimestamp = timestamp
 }
 
+// IsBackfillVisibilityTask returns true if the task is a visibility task generated for a backfill
+func IsBackfillVisibilityTask(task Task) bool {
+	switch task := task.(type) {
+	case *StartExecutionVisibilityTask:
+		return task.Backfill
+	case *UpsertExecutionVisibilityTask:
+		return task.Backfill
+	case *CloseExecutionVisibilityTask:
+		return task.Backfill
+	case *DeleteExecutionVisibilityTask:
+		return task.Backfill
+	default:
+		return false
+	}
+}
+
+// MarkBackfillVisibilityTask marks the visibility task as generated for a backfill
+func MarkBackfillVisibilityTask(task Task) {
+	switch task := task.(type) {
+	case *StartExecutionVisibilityTask:
+		task.Backfill = true
+	case *UpsertExecutionVisibilityTask:
+		task.Backfill = true
+	case *CloseExecutionVisibilityTask:
+		task.Backfill = true
+	case *DeleteExecutionVisibilityTask:
+		task.Backfill = true
+	}
+}
+
 // GetType returns the type of the upsert search attributes transfer task
 func (t *StartExecutionVisibilityTask) GetType() enumsspb.TaskType {
 	return enumsspb.TASK_TYPE_VISIBILITY_START_EXECUTION
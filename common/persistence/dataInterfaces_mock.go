@@ -1028,6 +1028,21 @@ func (mr *MockHistoryManagerMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockHistoryManager)(nil).Close))
 }
 
+// CompactHistoryBranch mocks base method.
+func (m *MockHistoryManager) CompactHistoryBranch(request *CompactHistoryBranchRequest) (*CompactHistoryBranchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompactHistoryBranch", request)
+	ret0, _ := ret[0].(*CompactHistoryBranchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompactHistoryBranch indicates an expected call of CompactHistoryBranch.
+func (mr *MockHistoryManagerMockRecorder) CompactHistoryBranch(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompactHistoryBranch", reflect.TypeOf((*MockHistoryManager)(nil).CompactHistoryBranch), request)
+}
+
 // DeleteHistoryBranch mocks base method.
 func (m *MockHistoryManager) DeleteHistoryBranch(request *DeleteHistoryBranchRequest) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,109 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+)
+
+var (
+	errClusterMetadataConcurrentUpdate = serviceerror.NewUnavailable("Cluster metadata was updated concurrently, please retry.")
+)
+
+type (
+	// DynamicConfigManager stores dynamic config values in cluster metadata.
+	DynamicConfigManager struct {
+		timeSource             clock.TimeSource
+		clusterMetadataManager ClusterMetadataManager
+	}
+)
+
+// NewDynamicConfigManager creates a new dynamic config manager.
+func NewDynamicConfigManager(
+	timeSource clock.TimeSource,
+	clusterMetadataManager ClusterMetadataManager,
+) *DynamicConfigManager {
+
+	return &DynamicConfigManager{
+		timeSource:             timeSource,
+		clusterMetadataManager: clusterMetadataManager,
+	}
+}
+
+// GetDynamicConfig returns all dynamic config values stored in cluster metadata by dynamic config key.
+func (m *DynamicConfigManager) GetDynamicConfig() (map[string]*persistencespb.DynamicConfigValues, error) {
+	clusterMetadata, err := m.clusterMetadataManager.GetClusterMetadata()
+	if err != nil {
+		if _, isNotFoundErr := err.(*serviceerror.NotFound); isNotFoundErr {
+			// cluster metadata was never persisted and dynamic config is not defined.
+			return map[string]*persistencespb.DynamicConfigValues{}, nil
+		}
+		return nil, err
+	}
+	if clusterMetadata.DynamicConfig == nil {
+		return map[string]*persistencespb.DynamicConfigValues{}, nil
+	}
+	return clusterMetadata.DynamicConfig, nil
+}
+
+// SetDynamicConfig replaces values of the dynamic config key. Empty values remove the key.
+func (m *DynamicConfigManager) SetDynamicConfig(
+	name string,
+	values []*persistencespb.DynamicConfigValue,
+) error {
+
+	clusterMetadataResponse, err := m.clusterMetadataManager.GetClusterMetadata()
+	if err != nil {
+		return err
+	}
+
+	clusterMetadata := clusterMetadataResponse.ClusterMetadata
+	if len(values) == 0 {
+		delete(clusterMetadata.DynamicConfig, name)
+	} else {
+		if clusterMetadata.DynamicConfig == nil {
+			clusterMetadata.DynamicConfig = make(map[string]*persistencespb.DynamicConfigValues)
+		}
+		lastUpdateTime := m.timeSource.Now().UTC()
+		clusterMetadata.DynamicConfig[name] = &persistencespb.DynamicConfigValues{
+			Values:         values,
+			LastUpdateTime: &lastUpdateTime,
+		}
+	}
+	applied, err := m.clusterMetadataManager.SaveClusterMetadata(&SaveClusterMetadataRequest{
+		ClusterMetadata: clusterMetadata,
+		Version:         clusterMetadataResponse.Version,
+	})
+	if err != nil {
+		return err
+	}
+	if !applied {
+		return errClusterMetadataConcurrentUpdate
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+type (
+	dynamicConfigManagerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller *gomock.Controller
+
+		timeSource                 *clock.EventTimeSource
+		mockClusterMetadataManager *MockClusterMetadataManager
+		manager                    *DynamicConfigManager
+	}
+)
+
+func TestDynamicConfigManagerSuite(t *testing.T) {
+	suite.Run(t, &dynamicConfigManagerSuite{})
+}
+
+func (s *dynamicConfigManagerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+
+	s.timeSource = clock.NewEventTimeSource()
+	s.timeSource.Update(time.Date(2021, 9, 1, 0, 0, 0, 0, time.UTC))
+	s.mockClusterMetadataManager = NewMockClusterMetadataManager(s.controller)
+	s.manager = NewDynamicConfigManager(s.timeSource, s.mockClusterMetadataManager)
+}
+
+func (s *dynamicConfigManagerSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *dynamicConfigManagerSuite) TestGetDynamicConfig_NotFound() {
+	s.mockClusterMetadataManager.EXPECT().GetClusterMetadata().Return(nil, serviceerror.NewNotFound("not found"))
+	dynamicConfig, err := s.manager.GetDynamicConfig()
+	s.NoError(err)
+	s.Empty(dynamicConfig)
+}
+
+func (s *dynamicConfigManagerSuite) TestSetDynamicConfig() {
+	values := []*persistencespb.DynamicConfigValue{{Value: "true"}}
+	s.mockClusterMetadataManager.EXPECT().GetClusterMetadata().Return(&GetClusterMetadataResponse{
+		ClusterMetadata: persistencespb.ClusterMetadata{
+			DynamicConfig: map[string]*persistencespb.DynamicConfigValues{
+				"key1": {Values: []*persistencespb.DynamicConfigValue{{Value: "1"}}},
+			},
+		},
+		Version: 1,
+	}, nil)
+	s.mockClusterMetadataManager.EXPECT().SaveClusterMetadata(&SaveClusterMetadataRequest{
+		ClusterMetadata: persistencespb.ClusterMetadata{
+			DynamicConfig: map[string]*persistencespb.DynamicConfigValues{
+				"key1": {Values: []*persistencespb.DynamicConfigValue{{Value: "1"}}},
+				"key2": {Values: values, LastUpdateTime: timestamp.TimePtr(s.timeSource.Now())},
+			},
+		},
+		Version: 1,
+	}).Return(true, nil)
+
+	s.NoError(s.manager.SetDynamicConfig("key2", values))
+}
+
+func (s *dynamicConfigManagerSuite) TestSetDynamicConfig_Remove() {
+	s.mockClusterMetadataManager.EXPECT().GetClusterMetadata().Return(&GetClusterMetadataResponse{
+		ClusterMetadata: persistencespb.ClusterMetadata{
+			DynamicConfig: map[string]*persistencespb.DynamicConfigValues{
+				"key1": {Values: []*persistencespb.DynamicConfigValue{{Value: "1"}}},
+			},
+		},
+		Version: 1,
+	}, nil)
+	s.mockClusterMetadataManager.EXPECT().SaveClusterMetadata(&SaveClusterMetadataRequest{
+		ClusterMetadata: persistencespb.ClusterMetadata{
+			DynamicConfig: map[string]*persistencespb.DynamicConfigValues{},
+		},
+		Version: 1,
+	}).Return(true, nil)
+
+	s.NoError(s.manager.SetDynamicConfig("key1", nil))
+}
+
+func (s *dynamicConfigManagerSuite) TestSetDynamicConfig_ConcurrentUpdate() {
+	s.mockClusterMetadataManager.EXPECT().GetClusterMetadata().Return(&GetClusterMetadataResponse{Version: 1}, nil)
+	s.mockClusterMetadataManager.EXPECT().SaveClusterMetadata(gomock.Any()).Return(false, nil)
+
+	err := s.manager.SetDynamicConfig("key1", []*persistencespb.DynamicConfigValue{{Value: "1"}})
+	s.Equal(errClusterMetadataConcurrentUpdate, err)
+}
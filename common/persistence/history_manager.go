@@ -59,6 +59,8 @@ const (
 
 	// TrimHistoryBranch will only dump metadata, relatively cheap
 	trimHistoryBranchPageSize = 1000
+
+	compactHistoryBranchPageSize = 1000
 )
 
 var _ HistoryManager = (*historyV2ManagerImpl)(nil)
@@ -337,6 +339,111 @@ func (m *historyV2ManagerImpl) TrimHistoryBranch(
 	return &TrimHistoryBranchResponse{}, nil
 }
 
+// CompactHistoryBranch merges consecutive history nodes of a branch into larger nodes
+// A compacted node inherits the node ID & prev transaction ID of the first merged node,
+// and the transaction ID of the last merged node, so the node chain remains valid and the
+// merged nodes are superseded even before they are deleted.
+// NOTE: caller must guarantee that no history node is appended to the branch concurrently.
+func (m *historyV2ManagerImpl) CompactHistoryBranch(
+	request *CompactHistoryBranchRequest,
+) (*CompactHistoryBranchResponse, error) {
+
+	shardID := request.ShardID
+	branch, err := serialization.HistoryBranchFromBlob(request.BranchToken, enumspb.ENCODING_TYPE_PROTO3.String())
+	if err != nil {
+		return nil, err
+	}
+	beginNodeID := GetBeginNodeID(branch)
+
+	maxSize := request.MaxSize
+	if sizeLimit := m.transactionSizeLimit(); maxSize <= 0 || maxSize > sizeLimit {
+		maxSize = sizeLimit
+	}
+
+	pinnedNodeIDs := make(map[int64]struct{}, len(request.PinnedNodeIDs))
+	for _, nodeID := range request.PinnedNodeIDs {
+		pinnedNodeIDs[nodeID] = struct{}{}
+	}
+	// other branches forked from this branch read its nodes up to the fork point,
+	// so fork points must remain node boundaries
+	historyTreeResp, err := m.GetHistoryTree(&GetHistoryTreeRequest{
+		TreeID:      branch.TreeId,
+		ShardID:     &shardID,
+		BranchToken: request.BranchToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, br := range historyTreeResp.Branches {
+		for _, ancestor := range br.Ancestors {
+			if ancestor.GetBranchId() == branch.BranchId {
+				pinnedNodeIDs[ancestor.GetEndNodeId()] = struct{}{}
+			}
+		}
+	}
+
+	var batches []historyNodeBatch
+	var pageToken []byte
+	for doContinue := true; doContinue; doContinue = len(pageToken) > 0 {
+		nodes, token, err := m.readHistoryNodesAndFilter(&ReadHistoryBranchRequest{
+			ShardID:       shardID,
+			BranchToken:   request.BranchToken,
+			MinEventID:    common.FirstEventID,
+			MaxEventID:    request.MaxEventID,
+			PageSize:      compactHistoryBranchPageSize,
+			NextPageToken: pageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, node := range nodes {
+			// ancestors' nodes can be shared by other branches, leave them untouched
+			if node.NodeID < beginNodeID {
+				continue
+			}
+			events, err := m.historySerializer.DeserializeEvents(node.Events)
+			if err != nil {
+				return nil, err
+			}
+			if len(events) == 0 {
+				return nil, serviceerror.NewDataLoss("corrupted history event batch, empty events")
+			}
+			batches = append(batches, historyNodeBatch{
+				node:   node,
+				events: events,
+			})
+		}
+
+		pageToken, err = m.serializeToken(token)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	nodeCountAfter := 0
+	for _, run := range splitHistoryNodesForCompaction(batches, pinnedNodeIDs, request.MaxEventCount, maxSize) {
+		if len(run) == 1 {
+			nodeCountAfter++
+			continue
+		}
+		compacted, err := m.compactHistoryNodes(shardID, branch, run)
+		if err != nil {
+			return nil, err
+		}
+		if compacted {
+			nodeCountAfter++
+		} else {
+			nodeCountAfter += len(run)
+		}
+	}
+
+	return &CompactHistoryBranchResponse{
+		NodeCountBefore: len(batches),
+		NodeCountAfter:  nodeCountAfter,
+	}, nil
+}
+
 // GetHistoryTree returns all branch information of a tree
 func (m *historyV2ManagerImpl) GetHistoryTree(
 	request *GetHistoryTreeRequest,
@@ -533,6 +640,52 @@ func (m *historyV2ManagerImpl) GetAllHistoryTreeBranches(
 	}, nil
 }
 
+func (m *historyV2ManagerImpl) compactHistoryNodes(
+	shardID int32,
+	branch *persistencespb.HistoryBranch,
+	batches []historyNodeBatch,
+) (bool, error) {
+
+	var events []*historypb.HistoryEvent
+	for _, batch := range batches {
+		events = append(events, batch.events...)
+	}
+	blob, err := m.historySerializer.SerializeEvents(events, enumspb.ENCODING_TYPE_PROTO3)
+	if err != nil {
+		return false, err
+	}
+	if len(blob.Data) > m.transactionSizeLimit() {
+		return false, nil
+	}
+
+	firstNode := batches[0].node
+	lastNode := batches[len(batches)-1].node
+	if err := m.persistence.AppendHistoryNodes(&InternalAppendHistoryNodesRequest{
+		BranchInfo: branch,
+		Node: InternalHistoryNode{
+			NodeID:            firstNode.NodeID,
+			Events:            blob,
+			PrevTransactionID: firstNode.PrevTransactionID,
+			TransactionID:     lastNode.TransactionID,
+		},
+		ShardID: shardID,
+	}); err != nil {
+		return false, err
+	}
+
+	for _, batch := range batches {
+		if err := m.persistence.DeleteHistoryNodes(&InternalDeleteHistoryNodesRequest{
+			ShardID:       shardID,
+			BranchInfo:    branch,
+			NodeID:        batch.node.NodeID,
+			TransactionID: batch.node.TransactionID,
+		}); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
 func (m *historyV2ManagerImpl) readRawHistoryBranch(
 	shardID int32,
 	treeID string,
@@ -594,6 +747,28 @@ func (m *historyV2ManagerImpl) readRawHistoryBranchAndFilter(
 	request *ReadHistoryBranchRequest,
 ) ([]*commonpb.DataBlob, *historyV2PagingToken, int, error) {
 
+	nodes, token, err := m.readHistoryNodesAndFilter(request)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	var dataBlobs []*commonpb.DataBlob
+	dataSize := 0
+	if len(nodes) > 0 {
+		dataBlobs = make([]*commonpb.DataBlob, len(nodes))
+		for index, node := range nodes {
+			dataBlobs[index] = node.Events
+			dataSize += len(node.Events.Data)
+		}
+	}
+
+	return dataBlobs, token, dataSize, nil
+}
+
+func (m *historyV2ManagerImpl) readHistoryNodesAndFilter(
+	request *ReadHistoryBranchRequest,
+) ([]InternalHistoryNode, *historyV2PagingToken, error) {
+
 	shardID := request.ShardID
 	branchToken := request.BranchToken
 	minNodeID := request.MinEventID
@@ -601,7 +776,7 @@ func (m *historyV2ManagerImpl) readRawHistoryBranchAndFilter(
 
 	branch, err := serialization.HistoryBranchFromBlob(branchToken, enumspb.ENCODING_TYPE_PROTO3.String())
 	if err != nil {
-		return nil, nil, 0, err
+		return nil, nil, err
 	}
 	treeID := branch.TreeId
 	branchID := branch.BranchId
@@ -623,7 +798,7 @@ func (m *historyV2ManagerImpl) readRawHistoryBranchAndFilter(
 		request.MinEventID-1,
 	)
 	if err != nil {
-		return nil, nil, 0, err
+		return nil, nil, err
 	}
 
 	nodes, token, err := m.readRawHistoryBranch(
@@ -637,10 +812,10 @@ func (m *historyV2ManagerImpl) readRawHistoryBranchAndFilter(
 		false,
 	)
 	if err != nil {
-		return nil, nil, 0, err
+		return nil, nil, err
 	}
 	if len(nodes) == 0 && len(request.NextPageToken) == 0 {
-		return nil, nil, 0, serviceerror.NewNotFound("Workflow execution history not found.")
+		return nil, nil, serviceerror.NewNotFound("Workflow execution history not found.")
 	}
 
 	nodes, err = m.filterHistoryNodes(
@@ -649,23 +824,16 @@ func (m *historyV2ManagerImpl) readRawHistoryBranchAndFilter(
 		nodes,
 	)
 	if err != nil {
-		return nil, nil, 0, err
+		return nil, nil, err
 	}
 
-	var dataBlobs []*commonpb.DataBlob
-	dataSize := 0
 	if len(nodes) > 0 {
-		dataBlobs = make([]*commonpb.DataBlob, len(nodes))
-		for index, node := range nodes {
-			dataBlobs[index] = node.Events
-			dataSize += len(node.Events.Data)
-		}
 		lastNode := nodes[len(nodes)-1]
 		token.LastNodeID = lastNode.NodeID
 		token.LastTransactionID = lastNode.TransactionID
 	}
 
-	return dataBlobs, token, dataSize, nil
+	return nodes, token, nil
 }
 
 func (m *historyV2ManagerImpl) readHistoryBranch(
@@ -747,6 +915,11 @@ func (m *historyV2ManagerImpl) filterHistoryNodes(
 		if node.TransactionID < lastTransactionID {
 			continue
 		}
+		// event batches with larger node ID & same transaction ID
+		//  -> batch is superseded by a compacted batch, see CompactHistoryBranch
+		if node.TransactionID == lastTransactionID && node.NodeID > lastNodeID {
+			continue
+		}
 
 		switch {
 		case node.NodeID < lastNodeID:
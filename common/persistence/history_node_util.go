@@ -28,6 +28,7 @@ import (
 	"fmt"
 	"sort"
 
+	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
 
 	persistencespb "go.temporal.io/server/api/persistence/v1"
@@ -42,6 +43,11 @@ type (
 		transactionID     int64
 		prevTransactionID int64
 	}
+
+	historyNodeBatch struct {
+		node   InternalHistoryNode
+		events []*historypb.HistoryEvent
+	}
 )
 
 func validateNodeChainAndTrim(
@@ -153,3 +159,43 @@ func trimNodes(
 	}
 	return nodesToTrim
 }
+
+// splitHistoryNodesForCompaction groups consecutive history nodes into runs, each run can be merged into one node
+// a new run is started at pinned node IDs, upon version change, or when limits are reached
+func splitHistoryNodesForCompaction(
+	batches []historyNodeBatch,
+	pinnedNodeIDs map[int64]struct{},
+	maxEventCount int,
+	maxSize int,
+) [][]historyNodeBatch {
+	var runs [][]historyNodeBatch
+	var run []historyNodeBatch
+	runEventCount := 0
+	runSize := 0
+	for _, batch := range batches {
+		eventCount := len(batch.events)
+		size := len(batch.node.Events.Data)
+		if len(run) > 0 {
+			_, pinned := pinnedNodeIDs[batch.node.NodeID]
+			lastBatch := run[len(run)-1]
+			lastEvent := lastBatch.events[len(lastBatch.events)-1]
+			if pinned ||
+				batch.events[0].GetVersion() != run[0].events[0].GetVersion() ||
+				batch.events[0].GetEventId() != lastEvent.GetEventId()+1 ||
+				runEventCount+eventCount > maxEventCount ||
+				runSize+size > maxSize {
+				runs = append(runs, run)
+				run = nil
+				runEventCount = 0
+				runSize = 0
+			}
+		}
+		run = append(run, batch)
+		runEventCount += eventCount
+		runSize += size
+	}
+	if len(run) > 0 {
+		runs = append(runs, run)
+	}
+	return runs
+}
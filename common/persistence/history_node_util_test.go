@@ -31,6 +31,8 @@ import (
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	historypb "go.temporal.io/api/history/v1"
 
 	"go.temporal.io/server/common"
 
@@ -170,6 +172,81 @@ func (s *historyNodeMetadataSuite) TestTrimNodes() {
 	s.Equal([]historyNodeMetadata{node4Trim1, node4Trim0, node1Trim1, node1Trim0}, nodesToTrim)
 }
 
+func (s *historyNodeMetadataSuite) TestSplitHistoryNodesForCompaction() {
+	batches := []historyNodeBatch{
+		s.newHistoryNodeBatch(1, 1, 100),
+		s.newHistoryNodeBatch(2, 1, 100),
+		s.newHistoryNodeBatch(3, 1, 100),
+		// pinned
+		s.newHistoryNodeBatch(4, 1, 100),
+		s.newHistoryNodeBatch(5, 1, 100),
+		// version change
+		s.newHistoryNodeBatch(6, 1, 200),
+		s.newHistoryNodeBatch(7, 1, 200),
+		s.newHistoryNodeBatch(8, 1, 200),
+		// event count limit reached
+		s.newHistoryNodeBatch(9, 1, 200),
+	}
+	pinnedNodeIDs := map[int64]struct{}{4: {}}
+
+	runs := splitHistoryNodesForCompaction(batches, pinnedNodeIDs, 3, 1024)
+	s.Equal([][]historyNodeBatch{
+		batches[0:3],
+		batches[3:5],
+		batches[5:8],
+		batches[8:9],
+	}, runs)
+
+	// size limit reached
+	runs = splitHistoryNodesForCompaction(batches[0:3], nil, 100, 2*len(batches[0].node.Events.Data))
+	s.Equal([][]historyNodeBatch{
+		batches[0:2],
+		batches[2:3],
+	}, runs)
+}
+
+func (s *historyNodeMetadataSuite) TestFilterHistoryNodes_Compacted() {
+	m := &historyV2ManagerImpl{}
+	nodes := []InternalHistoryNode{
+		// compacted node inherits transaction ID of the last merged node
+		{NodeID: 1, TransactionID: 30, PrevTransactionID: 0},
+		{NodeID: 1, TransactionID: 10, PrevTransactionID: 0},
+		{NodeID: 2, TransactionID: 20, PrevTransactionID: 10},
+		{NodeID: 3, TransactionID: 30, PrevTransactionID: 20},
+		{NodeID: 4, TransactionID: 40, PrevTransactionID: 30},
+	}
+
+	result, err := m.filterHistoryNodes(defaultLastNodeID, defaultLastTransactionID, nodes)
+	s.NoError(err)
+	s.Equal([]InternalHistoryNode{nodes[0], nodes[4]}, result)
+
+	// merged nodes not yet deleted on the next page
+	result, err = m.filterHistoryNodes(1, 30, nodes[2:])
+	s.NoError(err)
+	s.Equal([]InternalHistoryNode{nodes[4]}, result)
+}
+
+func (s *historyNodeMetadataSuite) newHistoryNodeBatch(
+	nodeID int64,
+	eventCount int,
+	version int64,
+) historyNodeBatch {
+	events := make([]*historypb.HistoryEvent, eventCount)
+	for i := range events {
+		events[i] = &historypb.HistoryEvent{
+			EventId: nodeID + int64(i),
+			Version: version,
+		}
+	}
+	return historyNodeBatch{
+		node: InternalHistoryNode{
+			NodeID: nodeID,
+			Events: &commonpb.DataBlob{Data: make([]byte, 64*eventCount)},
+		},
+		events: events,
+	}
+}
+
 func (s *historyNodeMetadataSuite) newRandomHistoryNodeMetadata(
 	branch *persistencespb.HistoryBranch,
 	nodeID int64,
@@ -0,0 +1,101 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+)
+
+type (
+	// MaintenanceInfoManager stores the planned maintenance announcement in cluster metadata.
+	MaintenanceInfoManager struct {
+		timeSource             clock.TimeSource
+		clusterMetadataManager ClusterMetadataManager
+	}
+)
+
+// NewMaintenanceInfoManager creates a new maintenance info manager.
+func NewMaintenanceInfoManager(
+	timeSource clock.TimeSource,
+	clusterMetadataManager ClusterMetadataManager,
+) *MaintenanceInfoManager {
+
+	return &MaintenanceInfoManager{
+		timeSource:             timeSource,
+		clusterMetadataManager: clusterMetadataManager,
+	}
+}
+
+// GetMaintenanceInfo returns the maintenance info stored in cluster metadata or nil if none is set.
+func (m *MaintenanceInfoManager) GetMaintenanceInfo() (*persistencespb.MaintenanceInfo, error) {
+	clusterMetadata, err := m.clusterMetadataManager.GetClusterMetadata()
+	if err != nil {
+		if _, isNotFoundErr := err.(*serviceerror.NotFound); isNotFoundErr {
+			// cluster metadata was never persisted and maintenance info is not set.
+			return nil, nil
+		}
+		return nil, err
+	}
+	return clusterMetadata.MaintenanceInfo, nil
+}
+
+// SetMaintenanceInfo replaces the maintenance info stored in cluster metadata. Empty message clears it.
+func (m *MaintenanceInfoManager) SetMaintenanceInfo(
+	message string,
+	severity enumspb.Severity,
+) error {
+
+	clusterMetadataResponse, err := m.clusterMetadataManager.GetClusterMetadata()
+	if err != nil {
+		return err
+	}
+
+	clusterMetadata := clusterMetadataResponse.ClusterMetadata
+	if message == "" {
+		clusterMetadata.MaintenanceInfo = nil
+	} else {
+		lastUpdateTime := m.timeSource.Now().UTC()
+		clusterMetadata.MaintenanceInfo = &persistencespb.MaintenanceInfo{
+			Message:        message,
+			Severity:       severity,
+			LastUpdateTime: &lastUpdateTime,
+		}
+	}
+	applied, err := m.clusterMetadataManager.SaveClusterMetadata(&SaveClusterMetadataRequest{
+		ClusterMetadata: clusterMetadata,
+		Version:         clusterMetadataResponse.Version,
+	})
+	if err != nil {
+		return err
+	}
+	if !applied {
+		return errClusterMetadataConcurrentUpdate
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+type (
+	maintenanceInfoManagerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller *gomock.Controller
+
+		timeSource                 *clock.EventTimeSource
+		mockClusterMetadataManager *MockClusterMetadataManager
+		manager                    *MaintenanceInfoManager
+	}
+)
+
+func TestMaintenanceInfoManagerSuite(t *testing.T) {
+	suite.Run(t, &maintenanceInfoManagerSuite{})
+}
+
+func (s *maintenanceInfoManagerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+
+	s.timeSource = clock.NewEventTimeSource()
+	s.timeSource.Update(time.Date(2021, 9, 1, 0, 0, 0, 0, time.UTC))
+	s.mockClusterMetadataManager = NewMockClusterMetadataManager(s.controller)
+	s.manager = NewMaintenanceInfoManager(s.timeSource, s.mockClusterMetadataManager)
+}
+
+func (s *maintenanceInfoManagerSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *maintenanceInfoManagerSuite) TestGetMaintenanceInfo_NotFound() {
+	s.mockClusterMetadataManager.EXPECT().GetClusterMetadata().Return(nil, serviceerror.NewNotFound("not found"))
+	maintenanceInfo, err := s.manager.GetMaintenanceInfo()
+	s.NoError(err)
+	s.Nil(maintenanceInfo)
+}
+
+func (s *maintenanceInfoManagerSuite) TestSetMaintenanceInfo() {
+	s.mockClusterMetadataManager.EXPECT().GetClusterMetadata().Return(&GetClusterMetadataResponse{
+		ClusterMetadata: persistencespb.ClusterMetadata{ClusterName: "active"},
+		Version:         1,
+	}, nil)
+	s.mockClusterMetadataManager.EXPECT().SaveClusterMetadata(&SaveClusterMetadataRequest{
+		ClusterMetadata: persistencespb.ClusterMetadata{
+			ClusterName: "active",
+			MaintenanceInfo: &persistencespb.MaintenanceInfo{
+				Message:        "database upgrade",
+				Severity:       enumspb.SEVERITY_HIGH,
+				LastUpdateTime: timestamp.TimePtr(s.timeSource.Now()),
+			},
+		},
+		Version: 1,
+	}).Return(true, nil)
+
+	s.NoError(s.manager.SetMaintenanceInfo("database upgrade", enumspb.SEVERITY_HIGH))
+}
+
+func (s *maintenanceInfoManagerSuite) TestSetMaintenanceInfo_Clear() {
+	s.mockClusterMetadataManager.EXPECT().GetClusterMetadata().Return(&GetClusterMetadataResponse{
+		ClusterMetadata: persistencespb.ClusterMetadata{
+			ClusterName:     "active",
+			MaintenanceInfo: &persistencespb.MaintenanceInfo{Message: "database upgrade"},
+		},
+		Version: 1,
+	}, nil)
+	s.mockClusterMetadataManager.EXPECT().SaveClusterMetadata(&SaveClusterMetadataRequest{
+		ClusterMetadata: persistencespb.ClusterMetadata{ClusterName: "active"},
+		Version:         1,
+	}).Return(true, nil)
+
+	s.NoError(s.manager.SetMaintenanceInfo("", enumspb.SEVERITY_UNSPECIFIED))
+}
+
+func (s *maintenanceInfoManagerSuite) TestSetMaintenanceInfo_ConcurrentUpdate() {
+	s.mockClusterMetadataManager.EXPECT().GetClusterMetadata().Return(&GetClusterMetadataResponse{Version: 1}, nil)
+	s.mockClusterMetadataManager.EXPECT().SaveClusterMetadata(gomock.Any()).Return(false, nil)
+
+	err := s.manager.SetMaintenanceInfo("database upgrade", enumspb.SEVERITY_LOW)
+	s.Equal(errClusterMetadataConcurrentUpdate, err)
+}
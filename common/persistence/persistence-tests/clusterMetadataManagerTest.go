@@ -350,4 +350,16 @@ func (s *ClusterMetadataManagerSuite) TestInitImmutableMetadataReadWrite() {
 	s.Nil(err)
 	s.NotNil(getResp)
 	s.Equal("1.0", getResp.ClusterMetadata.VersionInfo.Current.Version)
+
+	// Case 6 - Update with stale version
+	// Should not be applied as the cluster metadata was updated since the version was read
+	staleResp, err := s.ClusterMetadataManager.SaveClusterMetadata(&p.SaveClusterMetadataRequest{
+		ClusterMetadata: getResp.ClusterMetadata,
+		Version:         getResp.Version - 1,
+	})
+	s.Nil(err)
+	s.False(staleResp)
+	staleGetResp, err := s.ClusterMetadataManager.GetClusterMetadata()
+	s.Nil(err)
+	s.Equal(getResp.Version, staleGetResp.Version)
 }
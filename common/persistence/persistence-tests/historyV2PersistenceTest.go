@@ -358,6 +358,60 @@ func (s *HistoryV2PersistenceSuite) TestReadBranchByPagination() {
 	s.Equal(0, len(branches))
 }
 
+// TestCompactBranch test
+func (s *HistoryV2PersistenceSuite) TestCompactBranch() {
+	treeID := uuid.NewRandom().String()
+	bi, err := s.newHistoryBranch(treeID)
+	s.Nil(err)
+
+	err = s.appendNewBranchAndFirstNode(bi, s.genRandomEvents([]int64{1, 2, 3}, 0), 1, "branchInfo")
+	s.Nil(err)
+	err = s.appendOneByOne(bi, s.genRandomEvents([]int64{4, 5, 6, 7}, 0), 2)
+	s.Nil(err)
+	err = s.appendNewNode(bi, s.genRandomEvents([]int64{8}, 1), 6)
+	s.Nil(err)
+	historyW := s.read(bi, 1, 9)
+
+	resp, err := s.HistoryV2Mgr.CompactHistoryBranch(&p.CompactHistoryBranchRequest{
+		ShardID:       s.ShardInfo.GetShardId(),
+		BranchToken:   bi,
+		MaxEventID:    9,
+		PinnedNodeIDs: []int64{6},
+		MaxEventCount: 100,
+		MaxSize:       1024 * 1024,
+	})
+	s.Nil(err)
+	// [1, 2, 3] [4] [5] | [6] [7] | [8] -> [1, 2, 3, 4, 5] [6, 7] [8]
+	s.Equal(6, resp.NodeCountBefore)
+	s.Equal(3, resp.NodeCountAfter)
+
+	historyR := s.read(bi, 1, 9)
+	s.Equal(len(historyW), len(historyR))
+	for i := range historyW {
+		s.Equal(historyW[i].GetEventId(), historyR[i].GetEventId())
+		s.Equal(historyW[i].GetVersion(), historyR[i].GetVersion())
+	}
+	historyR = s.read(bi, 6, 9)
+	s.Equal(3, len(historyR))
+	s.Equal(int64(6), historyR[0].GetEventId())
+
+	// compaction is idempotent
+	resp, err = s.HistoryV2Mgr.CompactHistoryBranch(&p.CompactHistoryBranchRequest{
+		ShardID:       s.ShardInfo.GetShardId(),
+		BranchToken:   bi,
+		MaxEventID:    9,
+		PinnedNodeIDs: []int64{6},
+		MaxEventCount: 100,
+		MaxSize:       1024 * 1024,
+	})
+	s.Nil(err)
+	s.Equal(3, resp.NodeCountBefore)
+	s.Equal(3, resp.NodeCountAfter)
+
+	err = s.deleteHistoryBranch(bi)
+	s.Nil(err)
+}
+
 // TestConcurrentlyCreateAndAppendBranches test
 func (s *HistoryV2PersistenceSuite) TestConcurrentlyCreateAndAppendBranches() {
 	treeID := uuid.NewRandom().String()
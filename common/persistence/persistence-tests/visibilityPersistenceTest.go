@@ -25,6 +25,7 @@
 package persistencetests
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/pborman/uuid"
@@ -635,6 +636,78 @@ func (s *VisibilityPersistenceSuite) TestFilteringByStatus() {
 	s.assertClosedExecutionEquals(closeReq, resp.Executions[0])
 }
 
+// TestFilteringByTypeAndStatus test
+func (s *VisibilityPersistenceSuite) TestFilteringByTypeAndStatus() {
+	testNamespaceUUID := uuid.New()
+	startTime := time.Now()
+
+	// Close 3 executions with different type and status combinations
+	var closeReqs []*visibility.RecordWorkflowExecutionClosedRequest
+	for i, filter := range []struct {
+		workflowTypeName string
+		status           enumspb.WorkflowExecutionStatus
+	}{
+		{"visibility-workflow", enumspb.WORKFLOW_EXECUTION_STATUS_FAILED},
+		{"visibility-workflow", enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED},
+		{"visibility-workflow-2", enumspb.WORKFLOW_EXECUTION_STATUS_FAILED},
+	} {
+		workflowExecution := commonpb.WorkflowExecution{
+			WorkflowId: fmt.Sprintf("visibility-filtering-test%d", i),
+			RunId:      uuid.New(),
+		}
+		err0 := s.VisibilityMgr.RecordWorkflowExecutionStarted(&visibility.RecordWorkflowExecutionStartedRequest{
+			VisibilityRequestBase: &visibility.VisibilityRequestBase{
+				NamespaceID:      testNamespaceUUID,
+				Execution:        workflowExecution,
+				WorkflowTypeName: filter.workflowTypeName,
+				StartTime:        startTime,
+			},
+		})
+		s.Nil(err0)
+
+		closeReq := &visibility.RecordWorkflowExecutionClosedRequest{
+			VisibilityRequestBase: &visibility.VisibilityRequestBase{
+				NamespaceID:      testNamespaceUUID,
+				Execution:        workflowExecution,
+				WorkflowTypeName: filter.workflowTypeName,
+				StartTime:        startTime,
+				Status:           filter.status,
+			},
+			CloseTime:     time.Now(),
+			HistoryLength: 3,
+		}
+		err1 := s.VisibilityMgr.RecordWorkflowExecutionClosed(closeReq)
+		s.Nil(err1)
+		closeReqs = append(closeReqs, closeReq)
+	}
+
+	// List closed with filtering
+	resp, err2 := s.VisibilityMgr.ListClosedWorkflowExecutionsByTypeAndStatus(&visibility.ListClosedWorkflowExecutionsByTypeAndStatusRequest{
+		ListWorkflowExecutionsRequest: visibility.ListWorkflowExecutionsRequest{
+			NamespaceID:       testNamespaceUUID,
+			PageSize:          3,
+			EarliestStartTime: startTime,
+			LatestStartTime:   time.Now(),
+		},
+		WorkflowTypeName: "visibility-workflow",
+		Status:           enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+	})
+	s.Nil(err2)
+	s.Equal(1, len(resp.Executions))
+	s.assertClosedExecutionEquals(closeReqs[0], resp.Executions[0])
+
+	// Count closed with filtering
+	countResp, err3 := s.VisibilityMgr.CountClosedWorkflowExecutionsByTypeAndStatus(&visibility.CountClosedWorkflowExecutionsByTypeAndStatusRequest{
+		NamespaceID:       testNamespaceUUID,
+		EarliestCloseTime: startTime,
+		LatestCloseTime:   time.Now(),
+		WorkflowTypeName:  "visibility-workflow",
+		Status:            enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+	})
+	s.Nil(err3)
+	s.Equal(int64(1), countResp.Count)
+}
+
 // TestDelete test
 func (s *VisibilityPersistenceSuite) TestDelete() {
 	if s.VisibilityMgr.GetName() == "cassandra" {
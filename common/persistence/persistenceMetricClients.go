@@ -1011,6 +1011,18 @@ func (p *historyV2PersistenceClient) TrimHistoryBranch(request *TrimHistoryBranc
 	return resp, err
 }
 
+// CompactHistoryBranch compacts a branch
+func (p *historyV2PersistenceClient) CompactHistoryBranch(request *CompactHistoryBranchRequest) (*CompactHistoryBranchResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceCompactHistoryBranchScope, metrics.PersistenceRequests)
+	sw := p.metricClient.StartTimer(metrics.PersistenceCompactHistoryBranchScope, metrics.PersistenceLatency)
+	resp, err := p.persistence.CompactHistoryBranch(request)
+	sw.Stop()
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceCompactHistoryBranchScope, err)
+	}
+	return resp, err
+}
+
 func (p *historyV2PersistenceClient) GetAllHistoryTreeBranches(request *GetAllHistoryTreeBranchesRequest) (*GetAllHistoryTreeBranchesResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetAllHistoryTreeBranchesScope, metrics.PersistenceRequests)
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetAllHistoryTreeBranchesScope, metrics.PersistenceLatency)
@@ -680,6 +680,15 @@ func (p *historyV2RateLimitedPersistenceClient) TrimHistoryBranch(request *TrimH
 	return resp, err
 }
 
+// CompactHistoryBranch compacts a branch
+func (p *historyV2RateLimitedPersistenceClient) CompactHistoryBranch(request *CompactHistoryBranchRequest) (*CompactHistoryBranchResponse, error) {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	resp, err := p.persistence.CompactHistoryBranch(request)
+	return resp, err
+}
+
 // GetHistoryTree returns all branch information of a tree
 func (p *historyV2RateLimitedPersistenceClient) GetHistoryTree(request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error) {
 	if ok := p.rateLimiter.Allow(); !ok {
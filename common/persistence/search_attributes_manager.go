@@ -31,8 +31,11 @@ import (
 
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
 	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/searchattribute"
 )
 
@@ -45,6 +48,8 @@ type (
 	SearchAttributesManager struct {
 		timeSource             clock.TimeSource
 		clusterMetadataManager ClusterMetadataManager
+		// replicationQueue is nil if search attributes are not replicated to other clusters
+		replicationQueue NamespaceReplicationQueue
 
 		cacheUpdateMutex sync.Mutex
 		cache            atomic.Value
@@ -60,9 +65,12 @@ type (
 
 var _ searchattribute.Manager = (*SearchAttributesManager)(nil)
 
+// NewSearchAttributesManager creates a new search attributes manager. If replicationQueue is not nil,
+// saved search attributes are published to it for replication to other clusters.
 func NewSearchAttributesManager(
 	timeSource clock.TimeSource,
 	clusterMetadataManager ClusterMetadataManager,
+	replicationQueue NamespaceReplicationQueue,
 ) *SearchAttributesManager {
 
 	var saCache atomic.Value
@@ -76,6 +84,7 @@ func NewSearchAttributesManager(
 		timeSource:             timeSource,
 		cache:                  saCache,
 		clusterMetadataManager: clusterMetadataManager,
+		replicationQueue:       replicationQueue,
 	}
 }
 
@@ -140,29 +149,83 @@ func (m *SearchAttributesManager) refreshCache(saCache searchAttributesCache, no
 	return saCache, nil
 }
 
-// SaveSearchAttributes saves search attributes to cluster metadata.
+// SaveSearchAttributes saves search attributes to cluster metadata and replicates them to other clusters.
 // indexName can be an empty string when Elasticsearch is not configured.
 func (m *SearchAttributesManager) SaveSearchAttributes(
 	indexName string,
 	newCustomSearchAttributes map[string]enumspb.IndexedValueType,
 ) error {
 
+	lastUpdateTime := m.timeSource.Now().UTC()
+	if _, err := m.saveSearchAttributes(indexName, newCustomSearchAttributes, lastUpdateTime, false); err != nil {
+		return err
+	}
+
+	if m.replicationQueue == nil {
+		return nil
+	}
+	return m.replicationQueue.Publish(&replicationspb.ReplicationTask{
+		TaskType: enumsspb.REPLICATION_TASK_TYPE_SEARCH_ATTRIBUTES_TASK,
+		Attributes: &replicationspb.ReplicationTask_SearchAttributesTaskAttributes{
+			SearchAttributesTaskAttributes: &replicationspb.SearchAttributesTaskAttributes{
+				IndexName:              indexName,
+				CustomSearchAttributes: newCustomSearchAttributes,
+				LastUpdateTime:         &lastUpdateTime,
+			},
+		},
+	})
+}
+
+// ApplyReplicatedSearchAttributes saves search attributes replicated from another cluster.
+// Conflicts are resolved by update time: replicated search attributes are ignored
+// if the search attributes of the index were updated at the same time or later.
+func (m *SearchAttributesManager) ApplyReplicatedSearchAttributes(
+	indexName string,
+	customSearchAttributes map[string]enumspb.IndexedValueType,
+	lastUpdateTime time.Time,
+) (bool, error) {
+
+	return m.saveSearchAttributes(indexName, customSearchAttributes, lastUpdateTime, true)
+}
+
+func (m *SearchAttributesManager) saveSearchAttributes(
+	indexName string,
+	newCustomSearchAttributes map[string]enumspb.IndexedValueType,
+	lastUpdateTime time.Time,
+	onlyIfNewer bool,
+) (bool, error) {
+
 	clusterMetadataResponse, err := m.clusterMetadataManager.GetClusterMetadata()
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	clusterMetadata := clusterMetadataResponse.ClusterMetadata
+	if onlyIfNewer {
+		current := clusterMetadata.IndexSearchAttributes[indexName]
+		if current != nil && !timestamp.TimeValue(current.LastUpdateTime).Before(lastUpdateTime) {
+			return false, nil
+		}
+	}
 	if clusterMetadata.IndexSearchAttributes == nil {
 		clusterMetadata.IndexSearchAttributes = map[string]*persistencespb.IndexSearchAttributes{indexName: nil}
 	}
-	clusterMetadata.IndexSearchAttributes[indexName] = &persistencespb.IndexSearchAttributes{CustomSearchAttributes: newCustomSearchAttributes}
-	_, err = m.clusterMetadataManager.SaveClusterMetadata(&SaveClusterMetadataRequest{
+	clusterMetadata.IndexSearchAttributes[indexName] = &persistencespb.IndexSearchAttributes{
+		CustomSearchAttributes: newCustomSearchAttributes,
+		LastUpdateTime:         &lastUpdateTime,
+	}
+	applied, err := m.clusterMetadataManager.SaveClusterMetadata(&SaveClusterMetadataRequest{
 		ClusterMetadata: clusterMetadata,
 		Version:         clusterMetadataResponse.Version,
 	})
-	// Flush local cache, even if there was an error, which is most likely version mismatch (=stale cache).
+	// Flush local cache, even if the update was not applied, which is most likely version mismatch (=stale cache).
 	m.cache.Store(searchAttributesCache{})
 
-	return err
+	if err != nil {
+		return false, err
+	}
+	if !applied {
+		return false, errClusterMetadataConcurrentUpdate
+	}
+	return true, nil
 }
@@ -35,9 +35,12 @@ import (
 	"github.com/stretchr/testify/suite"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/primitives/timestamp"
 )
 
 type (
@@ -73,7 +76,7 @@ func (s *searchAttributesManagerSuite) SetupTest() {
 	s.logger = log.NewTestLogger()
 	s.timeSource = clock.NewEventTimeSource()
 	s.mockClusterMetadataManager = NewMockClusterMetadataManager(s.controller)
-	s.manager = NewSearchAttributesManager(s.timeSource, s.mockClusterMetadataManager)
+	s.manager = NewSearchAttributesManager(s.timeSource, s.mockClusterMetadataManager, nil)
 }
 
 func (s *searchAttributesManagerSuite) TearDownTest() {
@@ -196,10 +199,12 @@ func (s *searchAttributesManagerSuite) TestSaveSearchAttributes_UpdateIndex() {
 				"index-name": {
 					CustomSearchAttributes: map[string]enumspb.IndexedValueType{
 						"OrderId": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
-					}}},
+					},
+					LastUpdateTime: timestamp.TimePtr(s.timeSource.Now()),
+				}},
 		},
 		Version: 1,
-	}).Return(false, nil)
+	}).Return(true, nil)
 
 	err := s.manager.SaveSearchAttributes("index-name", map[string]enumspb.IndexedValueType{
 		"OrderId": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
@@ -228,10 +233,12 @@ func (s *searchAttributesManagerSuite) TestSaveSearchAttributes_NewIndex() {
 				"index-name": {
 					CustomSearchAttributes: map[string]enumspb.IndexedValueType{
 						"OrderId": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
-					}}},
+					},
+					LastUpdateTime: timestamp.TimePtr(s.timeSource.Now()),
+				}},
 		},
 		Version: 1,
-	}).Return(false, nil)
+	}).Return(true, nil)
 
 	err := s.manager.SaveSearchAttributes("index-name", map[string]enumspb.IndexedValueType{
 		"OrderId": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
@@ -261,13 +268,127 @@ func (s *searchAttributesManagerSuite) TestSaveSearchAttributesCache_EmptyIndex(
 				"": {
 					CustomSearchAttributes: map[string]enumspb.IndexedValueType{
 						"OrderId": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
-					}}},
+					},
+					LastUpdateTime: timestamp.TimePtr(s.timeSource.Now()),
+				}},
 		},
 		Version: 1,
-	}).Return(false, nil)
+	}).Return(true, nil)
 
 	err := s.manager.SaveSearchAttributes("", map[string]enumspb.IndexedValueType{
 		"OrderId": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
 	})
 	s.NoError(err)
 }
+
+func (s *searchAttributesManagerSuite) TestSaveSearchAttributes_ConcurrentUpdate() {
+	s.mockClusterMetadataManager.EXPECT().GetClusterMetadata().Return(&GetClusterMetadataResponse{
+		Version: 1,
+	}, nil)
+	s.mockClusterMetadataManager.EXPECT().SaveClusterMetadata(gomock.Any()).Return(false, nil)
+
+	err := s.manager.SaveSearchAttributes("index-name", map[string]enumspb.IndexedValueType{
+		"OrderId": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+	})
+	s.Equal(errClusterMetadataConcurrentUpdate, err)
+}
+
+func (s *searchAttributesManagerSuite) TestSaveSearchAttributes_Replicate() {
+	mockReplicationQueue := NewMockNamespaceReplicationQueue(s.controller)
+	s.manager = NewSearchAttributesManager(s.timeSource, s.mockClusterMetadataManager, mockReplicationQueue)
+	s.timeSource.Update(time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC))
+	lastUpdateTime := s.timeSource.Now()
+
+	s.mockClusterMetadataManager.EXPECT().GetClusterMetadata().Return(&GetClusterMetadataResponse{
+		ClusterMetadata: persistencespb.ClusterMetadata{},
+		Version:         1,
+	}, nil)
+	s.mockClusterMetadataManager.EXPECT().SaveClusterMetadata(&SaveClusterMetadataRequest{
+		ClusterMetadata: persistencespb.ClusterMetadata{
+			IndexSearchAttributes: map[string]*persistencespb.IndexSearchAttributes{
+				"index-name": {
+					CustomSearchAttributes: map[string]enumspb.IndexedValueType{
+						"OrderId": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+					},
+					LastUpdateTime: &lastUpdateTime,
+				}},
+		},
+		Version: 1,
+	}).Return(true, nil)
+	mockReplicationQueue.EXPECT().Publish(&replicationspb.ReplicationTask{
+		TaskType: enumsspb.REPLICATION_TASK_TYPE_SEARCH_ATTRIBUTES_TASK,
+		Attributes: &replicationspb.ReplicationTask_SearchAttributesTaskAttributes{
+			SearchAttributesTaskAttributes: &replicationspb.SearchAttributesTaskAttributes{
+				IndexName: "index-name",
+				CustomSearchAttributes: map[string]enumspb.IndexedValueType{
+					"OrderId": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+				},
+				LastUpdateTime: &lastUpdateTime,
+			},
+		},
+	}).Return(nil)
+
+	err := s.manager.SaveSearchAttributes("index-name", map[string]enumspb.IndexedValueType{
+		"OrderId": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+	})
+	s.NoError(err)
+}
+
+func (s *searchAttributesManagerSuite) TestApplyReplicatedSearchAttributes_Newer() {
+	localUpdateTime := time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC)
+	replicatedUpdateTime := localUpdateTime.Add(time.Minute)
+
+	s.mockClusterMetadataManager.EXPECT().GetClusterMetadata().Return(&GetClusterMetadataResponse{
+		ClusterMetadata: persistencespb.ClusterMetadata{
+			IndexSearchAttributes: map[string]*persistencespb.IndexSearchAttributes{
+				"index-name": {
+					CustomSearchAttributes: map[string]enumspb.IndexedValueType{
+						"OrderIdOld": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+					},
+					LastUpdateTime: &localUpdateTime,
+				}},
+		},
+		Version: 1,
+	}, nil)
+	s.mockClusterMetadataManager.EXPECT().SaveClusterMetadata(&SaveClusterMetadataRequest{
+		ClusterMetadata: persistencespb.ClusterMetadata{
+			IndexSearchAttributes: map[string]*persistencespb.IndexSearchAttributes{
+				"index-name": {
+					CustomSearchAttributes: map[string]enumspb.IndexedValueType{
+						"OrderId": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+					},
+					LastUpdateTime: &replicatedUpdateTime,
+				}},
+		},
+		Version: 1,
+	}).Return(true, nil)
+
+	applied, err := s.manager.ApplyReplicatedSearchAttributes("index-name", map[string]enumspb.IndexedValueType{
+		"OrderId": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+	}, replicatedUpdateTime)
+	s.NoError(err)
+	s.True(applied)
+}
+
+func (s *searchAttributesManagerSuite) TestApplyReplicatedSearchAttributes_Stale() {
+	localUpdateTime := time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	s.mockClusterMetadataManager.EXPECT().GetClusterMetadata().Return(&GetClusterMetadataResponse{
+		ClusterMetadata: persistencespb.ClusterMetadata{
+			IndexSearchAttributes: map[string]*persistencespb.IndexSearchAttributes{
+				"index-name": {
+					CustomSearchAttributes: map[string]enumspb.IndexedValueType{
+						"OrderId": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+					},
+					LastUpdateTime: &localUpdateTime,
+				}},
+		},
+		Version: 1,
+	}, nil)
+
+	applied, err := s.manager.ApplyReplicatedSearchAttributes("index-name", map[string]enumspb.IndexedValueType{
+		"OrderIdOld": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+	}, localUpdateTime)
+	s.NoError(err)
+	s.False(applied)
+}
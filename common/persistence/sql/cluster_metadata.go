@@ -61,6 +61,7 @@ func (s *sqlClusterMetadataManager) GetClusterMetadata() (*p.InternalGetClusterM
 func (s *sqlClusterMetadataManager) SaveClusterMetadata(request *p.InternalSaveClusterMetadataRequest) (bool, error) {
 	ctx, cancel := newExecutionContext()
 	defer cancel()
+	applied := true
 	err := s.txExecute(ctx, "SaveClusterMetadata", func(tx sqlplugin.Tx) error {
 		oldClusterMetadata, err := tx.WriteLockGetClusterMetadata(ctx)
 		var lastVersion int64
@@ -72,8 +73,9 @@ func (s *sqlClusterMetadataManager) SaveClusterMetadata(request *p.InternalSaveC
 			lastVersion = oldClusterMetadata.Version
 		}
 		if request.Version != lastVersion {
-			return serviceerror.NewInternal(fmt.Sprintf("SaveClusterMetadata encountered version mismatch, expected %v but got %v.",
-				request.Version, oldClusterMetadata.Version))
+			// cluster metadata was updated since the version was read
+			applied = false
+			return nil
 		}
 		_, err = tx.SaveClusterMetadata(ctx, &sqlplugin.ClusterMetadataRow{
 			Data:         request.ClusterMetadata.Data,
@@ -89,7 +91,7 @@ func (s *sqlClusterMetadataManager) SaveClusterMetadata(request *p.InternalSaveC
 	if err != nil {
 		return false, serviceerror.NewInternal(err.Error())
 	}
-	return true, nil
+	return applied, nil
 }
 
 func (s *sqlClusterMetadataManager) GetClusterMembers(request *p.GetClusterMembersRequest) (*p.GetClusterMembersResponse, error) {
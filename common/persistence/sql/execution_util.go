@@ -792,6 +792,7 @@ func createTransferTasks(
 			info.TargetNamespaceId = task.(*p.WorkflowTask).NamespaceID
 			info.TaskQueue = task.(*p.WorkflowTask).TaskQueue
 			info.ScheduleId = task.(*p.WorkflowTask).ScheduleID
+			info.TraceContext = task.(*p.WorkflowTask).TraceContext
 
 		case enumsspb.TASK_TYPE_TRANSFER_CANCEL_EXECUTION:
 			info.TargetNamespaceId = task.(*p.CancelExecutionTask).TargetNamespaceID
@@ -1036,6 +1037,7 @@ func createVisibilityTasks(
 			TaskType:       task.GetType(),
 			Version:        task.GetVersion(),
 			VisibilityTime: timestamp.TimePtr(task.GetVisibilityTime().UTC()),
+			Backfill:       p.IsBackfillVisibilityTask(task),
 		}
 
 		switch task.GetType() {
@@ -81,12 +81,24 @@ const (
 
 	templateGetClosedWorkflowExecutionsByStatus = templateClosedSelect + `AND status = ?` + templateConditionsClosedWorkflows
 
+	templateGetClosedWorkflowExecutionsByTypeAndStatus = templateClosedSelect + `AND workflow_type_name = ? AND status = ?` + templateConditionsClosedWorkflows
+
+	templateCountClosedWorkflowExecutionsByTypeAndStatus = `SELECT COUNT(*) FROM executions_visibility
+		 WHERE status != 1
+		 AND workflow_type_name = ?
+		 AND status = ?
+		 AND namespace_id = ?
+		 AND close_time >= ?
+		 AND close_time <= ?`
+
 	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, execution_time, memo, encoding, close_time, workflow_type_name, status, history_length 
 		 FROM executions_visibility
 		 WHERE namespace_id = ? AND status != 1
 		 AND run_id = ?`
 
 	templateDeleteWorkflowExecution = "DELETE FROM executions_visibility WHERE namespace_id = ? AND run_id = ?"
+
+	templateUpdateWorkflowExecutionMemo = "UPDATE executions_visibility SET memo = ?, encoding = ? WHERE namespace_id = ? AND run_id = ? AND status = 1"
 )
 
 var errCloseParams = errors.New("missing one of {CloseTime, HistoryLength} params")
@@ -142,6 +154,20 @@ func (mdb *db) ReplaceIntoVisibility(
 	}
 }
 
+// UpdateVisibilityMemo updates memo of the open workflow row in visibility table
+func (mdb *db) UpdateVisibilityMemo(
+	ctx context.Context,
+	row *sqlplugin.VisibilityRow,
+) (sql.Result, error) {
+	return mdb.conn.ExecContext(ctx,
+		templateUpdateWorkflowExecutionMemo,
+		row.Memo,
+		row.Encoding,
+		row.NamespaceID,
+		row.RunID,
+	)
+}
+
 // DeleteFromVisibility deletes a row from visibility table if it exist
 func (mdb *db) DeleteFromVisibility(
 	ctx context.Context,
@@ -198,6 +224,22 @@ func (mdb *db) SelectFromVisibility(
 			*filter.MaxTime,
 			*filter.PageSize,
 		)
+	case filter.MinTime != nil && filter.MaxTime != nil &&
+		filter.WorkflowTypeName != nil && filter.RunID != nil && filter.PageSize != nil &&
+		filter.Status != 0 && filter.Status != 1: // 0 is UNSPECIFIED, 1 is RUNNING
+		err = mdb.conn.SelectContext(ctx,
+			&rows,
+			templateGetClosedWorkflowExecutionsByTypeAndStatus,
+			*filter.WorkflowTypeName,
+			filter.Status,
+			filter.NamespaceID,
+			*filter.MinTime,
+			*filter.MaxTime,
+			*filter.RunID,
+			*filter.MaxTime,
+			*filter.MaxTime,
+			*filter.PageSize,
+		)
 	case filter.MinTime != nil && filter.MaxTime != nil &&
 		filter.WorkflowTypeName != nil && filter.RunID != nil && filter.PageSize != nil:
 		qry := templateGetOpenWorkflowExecutionsByType
@@ -264,3 +306,25 @@ func (mdb *db) SelectFromVisibility(
 	}
 	return rows, nil
 }
+
+// CountFromVisibility counts closed workflow rows in visibility table
+func (mdb *db) CountFromVisibility(
+	ctx context.Context,
+	filter sqlplugin.VisibilitySelectFilter,
+) (int64, error) {
+	if filter.MinTime == nil || filter.MaxTime == nil || filter.WorkflowTypeName == nil ||
+		filter.Status == 0 || filter.Status == 1 { // 0 is UNSPECIFIED, 1 is RUNNING
+		return 0, fmt.Errorf("invalid count filter")
+	}
+	var count int64
+	err := mdb.conn.GetContext(ctx,
+		&count,
+		templateCountClosedWorkflowExecutionsByTypeAndStatus,
+		*filter.WorkflowTypeName,
+		filter.Status,
+		filter.NamespaceID,
+		mdb.converter.ToMySQLDateTime(*filter.MinTime),
+		mdb.converter.ToMySQLDateTime(*filter.MaxTime),
+	)
+	return count, err
+}
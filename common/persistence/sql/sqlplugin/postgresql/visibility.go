@@ -83,6 +83,13 @@ const (
          ORDER BY close_time DESC, run_id
          LIMIT $8`
 
+	templateConditionsClosedWorkflow3 = ` AND namespace_id = $3
+		 AND close_time >= $4
+		 AND close_time <= $5
+ 		 AND ((run_id > $6 and close_time = $7) OR (close_time < $8))
+         ORDER BY close_time DESC, run_id
+         LIMIT $9`
+
 	templateOpenFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, status, memo, encoding`
 	templateOpenSelect     = `SELECT ` + templateOpenFieldNames + ` FROM executions_visibility WHERE status = 1 `
 
@@ -103,12 +110,24 @@ const (
 
 	templateGetClosedWorkflowExecutionsByStatus = templateClosedSelect + `AND status = $1` + templateConditionsClosedWorkflow2
 
+	templateGetClosedWorkflowExecutionsByTypeAndStatus = templateClosedSelect + `AND workflow_type_name = $1 AND status = $2` + templateConditionsClosedWorkflow3
+
+	templateCountClosedWorkflowExecutionsByTypeAndStatus = `SELECT COUNT(*) FROM executions_visibility
+		 WHERE status != 1
+		 AND workflow_type_name = $1
+		 AND status = $2
+		 AND namespace_id = $3
+		 AND close_time >= $4
+		 AND close_time <= $5`
+
 	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, execution_time, memo, encoding, close_time, workflow_type_name, status, history_length 
 		 FROM executions_visibility
 		 WHERE namespace_id = $1 AND status != 1
 		 AND run_id = $2`
 
 	templateDeleteWorkflowExecution = "DELETE FROM executions_visibility WHERE namespace_id = $1 AND run_id = $2"
+
+	templateUpdateWorkflowExecutionMemo = "UPDATE executions_visibility SET memo = $1, encoding = $2 WHERE namespace_id = $3 AND run_id = $4 AND status = 1"
 )
 
 var errCloseParams = errors.New("missing one of {closeTime, historyLength} params")
@@ -162,6 +181,20 @@ func (pdb *db) ReplaceIntoVisibility(
 	}
 }
 
+// UpdateVisibilityMemo updates memo of the open workflow row in visibility table
+func (pdb *db) UpdateVisibilityMemo(
+	ctx context.Context,
+	row *sqlplugin.VisibilityRow,
+) (sql.Result, error) {
+	return pdb.conn.ExecContext(ctx,
+		templateUpdateWorkflowExecutionMemo,
+		row.Memo,
+		row.Encoding,
+		row.NamespaceID,
+		row.RunID,
+	)
+}
+
 // DeleteFromVisibility deletes a row from visibility table if it exist
 func (pdb *db) DeleteFromVisibility(
 	ctx context.Context,
@@ -217,6 +250,21 @@ func (pdb *db) SelectFromVisibility(
 			*filter.MaxTime,
 			*filter.MaxTime,
 			*filter.PageSize)
+	case filter.MinTime != nil && filter.MaxTime != nil &&
+		filter.WorkflowTypeName != nil && filter.RunID != nil && filter.PageSize != nil &&
+		filter.Status != 0 && filter.Status != 1: // 0 is UNSPECIFIED, 1 is RUNNING
+		err = pdb.conn.SelectContext(ctx,
+			&rows,
+			templateGetClosedWorkflowExecutionsByTypeAndStatus,
+			*filter.WorkflowTypeName,
+			filter.Status,
+			filter.NamespaceID,
+			*filter.MinTime,
+			*filter.MaxTime,
+			*filter.RunID,
+			*filter.MaxTime,
+			*filter.MaxTime,
+			*filter.PageSize)
 	case filter.MinTime != nil && filter.MaxTime != nil &&
 		filter.WorkflowTypeName != nil && filter.RunID != nil && filter.PageSize != nil:
 		qry := templateGetOpenWorkflowExecutionsByType
@@ -283,3 +331,25 @@ func (pdb *db) SelectFromVisibility(
 	}
 	return rows, nil
 }
+
+// CountFromVisibility counts closed workflow rows in visibility table
+func (pdb *db) CountFromVisibility(
+	ctx context.Context,
+	filter sqlplugin.VisibilitySelectFilter,
+) (int64, error) {
+	if filter.MinTime == nil || filter.MaxTime == nil || filter.WorkflowTypeName == nil ||
+		filter.Status == 0 || filter.Status == 1 { // 0 is UNSPECIFIED, 1 is RUNNING
+		return 0, fmt.Errorf("invalid count filter")
+	}
+	var count int64
+	err := pdb.conn.GetContext(ctx,
+		&count,
+		templateCountClosedWorkflowExecutionsByTypeAndStatus,
+		*filter.WorkflowTypeName,
+		filter.Status,
+		filter.NamespaceID,
+		pdb.converter.ToPostgreSQLDateTime(*filter.MinTime),
+		pdb.converter.ToPostgreSQLDateTime(*filter.MaxTime),
+	)
+	return count, err
+}
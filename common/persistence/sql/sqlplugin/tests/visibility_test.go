@@ -293,6 +293,108 @@ func (s *visibilitySuite) TestReplaceSelect_Exists() {
 	s.Equal([]sqlplugin.VisibilityRow{visibility}, rows)
 }
 
+func (s *visibilitySuite) TestInsertUpdateMemoSelect() {
+	pageSize := 1
+
+	namespaceID := primitives.NewUUID()
+	runID := primitives.NewUUID()
+	workflowTypeName := shuffle.String(testVisibilityWorkflowTypeName)
+	workflowID := shuffle.String(testVisibilityWorkflowID)
+	startTime := s.now()
+	executionTime := startTime.Add(time.Second)
+	status := int32(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)
+	closeTime := (*time.Time)(nil)
+	historyLength := (*int64)(nil)
+
+	visibility := s.newRandomVisibilityRow(
+		namespaceID,
+		runID,
+		workflowTypeName,
+		workflowID,
+		startTime,
+		executionTime,
+		status,
+		closeTime,
+		historyLength,
+	)
+	result, err := s.store.InsertIntoVisibility(newVisibilityContext(), &visibility)
+	s.NoError(err)
+	rowsAffected, err := result.RowsAffected()
+	s.NoError(err)
+	s.Equal(1, int(rowsAffected))
+
+	visibility.Memo = shuffle.Bytes(testVisibilityData)
+	result, err = s.store.UpdateVisibilityMemo(newVisibilityContext(), &visibility)
+	s.NoError(err)
+	rowsAffected, err = result.RowsAffected()
+	s.NoError(err)
+	s.Equal(1, int(rowsAffected))
+
+	selectFilter := sqlplugin.VisibilitySelectFilter{
+		NamespaceID: namespaceID.String(),
+		WorkflowID:  convert.StringPtr(workflowID),
+		RunID:       convert.StringPtr(""),
+		MinTime:     timestamp.TimePtr(startTime),
+		MaxTime:     timestamp.TimePtr(startTime),
+		Status:      int32(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING),
+		PageSize:    convert.IntPtr(pageSize),
+	}
+	rows, err := s.store.SelectFromVisibility(newVisibilityContext(), selectFilter)
+	s.NoError(err)
+	for index := range rows {
+		rows[index].NamespaceID = namespaceID.String()
+	}
+	s.Equal([]sqlplugin.VisibilityRow{visibility}, rows)
+}
+
+func (s *visibilitySuite) TestReplaceUpdateMemoSelect() {
+	namespaceID := primitives.NewUUID()
+	runID := primitives.NewUUID()
+	workflowTypeName := shuffle.String(testVisibilityWorkflowTypeName)
+	workflowID := shuffle.String(testVisibilityWorkflowID)
+	startTime := s.now()
+	executionTime := startTime.Add(time.Second)
+	status := int32(enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED)
+	closeTime := executionTime.Add(time.Second)
+	historyLength := rand.Int63()
+
+	visibility := s.newRandomVisibilityRow(
+		namespaceID,
+		runID,
+		workflowTypeName,
+		workflowID,
+		startTime,
+		executionTime,
+		status,
+		timestamp.TimePtr(closeTime),
+		convert.Int64Ptr(historyLength),
+	)
+	result, err := s.store.ReplaceIntoVisibility(newVisibilityContext(), &visibility)
+	s.NoError(err)
+	rowsAffected, err := result.RowsAffected()
+	s.NoError(err)
+	s.Equal(1, int(rowsAffected))
+
+	update := visibility
+	update.Memo = shuffle.Bytes(testVisibilityData)
+	result, err = s.store.UpdateVisibilityMemo(newVisibilityContext(), &update)
+	s.NoError(err)
+	rowsAffected, err = result.RowsAffected()
+	s.NoError(err)
+	s.Equal(0, int(rowsAffected))
+
+	selectFilter := sqlplugin.VisibilitySelectFilter{
+		NamespaceID: namespaceID.String(),
+		RunID:       convert.StringPtr(runID.String()),
+	}
+	rows, err := s.store.SelectFromVisibility(newVisibilityContext(), selectFilter)
+	s.NoError(err)
+	for index := range rows {
+		rows[index].NamespaceID = namespaceID.String()
+	}
+	s.Equal([]sqlplugin.VisibilityRow{visibility}, rows)
+}
+
 func (s *visibilitySuite) TestDeleteSelect() {
 	namespaceID := primitives.NewUUID()
 	runID := primitives.NewUUID()
@@ -70,6 +70,9 @@ type (
 		InsertIntoVisibility(ctx context.Context, row *VisibilityRow) (sql.Result, error)
 		// ReplaceIntoVisibility deletes old row (if it exist) and inserts new row into visibility table
 		ReplaceIntoVisibility(ctx context.Context, row *VisibilityRow) (sql.Result, error)
+		// UpdateVisibilityMemo updates memo of the open workflow row in visibility table.
+		// Closed workflow rows are not changed by this API
+		UpdateVisibilityMemo(ctx context.Context, row *VisibilityRow) (sql.Result, error)
 		// SelectFromVisibility returns one or more rows from visibility table
 		// Required filter params:
 		// - getClosedWorkflowExecution - retrieves single row - {namespaceID, runID, closed=true}
@@ -78,7 +81,12 @@ type (
 		//     - namespaceID, minStartTime, maxStartTime, runID and pageSize where some or all of these may come from previous page token
 		//   - OPTIONALLY specify one of following params
 		//     - workflowID, workflowTypeName, status (along with closed=true)
+		//     - workflowTypeName and status (along with closed=true)
 		SelectFromVisibility(ctx context.Context, filter VisibilitySelectFilter) ([]VisibilityRow, error)
+		// CountFromVisibility returns number of closed workflow rows in visibility table
+		// Required filter params:
+		// - namespaceID, minCloseTime, maxCloseTime, workflowTypeName and status
+		CountFromVisibility(ctx context.Context, filter VisibilitySelectFilter) (int64, error)
 		DeleteFromVisibility(ctx context.Context, filter VisibilityDeleteFilter) (sql.Result, error)
 	}
 )
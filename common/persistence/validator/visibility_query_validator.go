@@ -182,6 +182,15 @@ func (qv *VisibilityQueryValidator) validateComparisonExpr(expr sqlparser.Expr,
 	if !searchAttributes.IsDefined(colNameStr) {
 		return fmt.Errorf("invalid search attribute: %s", colNameStr)
 	}
+	if saType, _ := searchAttributes.GetType(colNameStr); saType == searchattribute.IndexedValueTypeKeywordList {
+		// Elasticsearch matches multi-valued fields if any of the values matches,
+		// therefore "=" and "IN" have contains semantic for KeywordList.
+		switch comparisonExpr.Operator {
+		case sqlparser.EqualStr, sqlparser.NotEqualStr, sqlparser.InStr, sqlparser.NotInStr:
+		default:
+			return fmt.Errorf("operator '%s' not allowed for search attribute %s of type %s", comparisonExpr.Operator, colNameStr, saType)
+		}
+	}
 	return nil
 }
 
@@ -199,6 +208,9 @@ func (qv *VisibilityQueryValidator) validateRangeExpr(expr sqlparser.Expr, index
 	if !searchAttributes.IsDefined(colNameStr) {
 		return fmt.Errorf("invalid search attribute: %s", colNameStr)
 	}
+	if saType, _ := searchAttributes.GetType(colNameStr); saType == searchattribute.IndexedValueTypeKeywordList {
+		return fmt.Errorf("range condition not allowed for search attribute %s of type %s", colNameStr, saType)
+	}
 	return nil
 }
 
@@ -216,6 +228,9 @@ func (qv *VisibilityQueryValidator) validateOrderByExpr(orderBy sqlparser.OrderB
 		if !searchAttributes.IsDefined(colNameStr) {
 			return fmt.Errorf("invalid order by attribute: %s", colNameStr)
 		}
+		if saType, _ := searchAttributes.GetType(colNameStr); saType == searchattribute.IndexedValueTypeKeywordList {
+			return fmt.Errorf("order by not allowed for search attribute %s of type %s", colNameStr, saType)
+		}
 	}
 	return nil
 }
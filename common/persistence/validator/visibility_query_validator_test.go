@@ -138,6 +138,24 @@ func (s *queryValidatorSuite) TestValidateListRequestForQuery() {
 	listRequest.Query = query
 	s.Equal("invalid order by expression", qv.ValidateListRequestForQuery(listRequest, "index-name").Error())
 
+	// KeywordList supports only equality and in
+	query = "CustomKeywordListField = 'val1' and CustomKeywordListField in ('val2', 'val3')"
+	listRequest.Query = query
+	s.Nil(qv.ValidateListRequestForQuery(listRequest, "index-name"))
+	s.Equal(query, listRequest.GetQuery())
+
+	query = "CustomKeywordListField > 'val1'"
+	listRequest.Query = query
+	s.Equal("operator '>' not allowed for search attribute CustomKeywordListField of type KeywordList", qv.ValidateListRequestForQuery(listRequest, "index-name").Error())
+
+	query = "CustomKeywordListField between 'val1' and 'val2'"
+	listRequest.Query = query
+	s.Equal("range condition not allowed for search attribute CustomKeywordListField of type KeywordList", qv.ValidateListRequestForQuery(listRequest, "index-name").Error())
+
+	query = "order by CustomKeywordListField"
+	listRequest.Query = query
+	s.Equal("order by not allowed for search attribute CustomKeywordListField of type KeywordList", qv.ValidateListRequestForQuery(listRequest, "index-name").Error())
+
 	// security SQL injection
 	query = "WorkflowId = 'wid'; SELECT * FROM important_table;"
 	listRequest.Query = query
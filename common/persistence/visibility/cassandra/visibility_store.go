@@ -44,6 +44,10 @@ const (
 
 	// ref: https://docs.datastax.com/en/dse-trblshoot/doc/troubleshooting/recoveringTtlYear2038Problem.html
 	maxCassandraTTL = int64(315360000) // Cassandra max support time is 2038-01-19T03:14:06+00:00. Updated this to 10 years to support until year 2028
+
+	// minWorkflowDuration is the minimum distance between the timestamps of queries which
+	// create and delete a row in `open_executions` table.
+	minWorkflowDuration = time.Second
 )
 
 const (
@@ -57,6 +61,13 @@ const (
 		`AND start_time = ? ` +
 		`AND run_id = ?`
 
+	templateUpdateWorkflowExecutionStartedMemo = `UPDATE open_executions ` +
+		`SET memo = ?, encoding = ? ` +
+		`WHERE namespace_id = ? ` +
+		`AND namespace_partition = ? ` +
+		`AND start_time = ? ` +
+		`AND run_id = ?`
+
 	templateCreateWorkflowExecutionClosedWithTTL = `INSERT INTO closed_executions (` +
 		`namespace_id, namespace_partition, workflow_id, run_id, start_time, execution_time, close_time, workflow_type_name, status, history_length, memo, encoding, task_queue) ` +
 		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) using TTL ?`
@@ -118,6 +129,27 @@ const (
 		`AND close_time >= ? ` +
 		`AND close_time <= ? ` +
 		`AND status = ? `
+
+	// Filtering by both indexed columns is limited to single partition, so ALLOW FILTERING is safe here.
+	templateGetClosedWorkflowExecutionsByTypeAndStatus = `SELECT workflow_id, run_id, start_time, execution_time, close_time, workflow_type_name, status, history_length, memo, encoding, task_queue ` +
+		`FROM closed_executions ` +
+		`WHERE namespace_id = ? ` +
+		`AND namespace_partition = ? ` +
+		`AND close_time >= ? ` +
+		`AND close_time <= ? ` +
+		`AND workflow_type_name = ? ` +
+		`AND status = ? ` +
+		`ALLOW FILTERING`
+
+	templateCountClosedWorkflowExecutionsByTypeAndStatus = `SELECT COUNT(*) ` +
+		`FROM closed_executions ` +
+		`WHERE namespace_id = ? ` +
+		`AND namespace_partition = ? ` +
+		`AND close_time >= ? ` +
+		`AND close_time <= ? ` +
+		`AND workflow_type_name = ? ` +
+		`AND status = ? ` +
+		`ALLOW FILTERING`
 )
 
 type (
@@ -239,7 +271,6 @@ func (v *visibilityStore) RecordWorkflowExecutionClosed(request *visibility.Inte
 	// In this case, use (StartTime + minWorkflowDuration) for delete operation to guarantee that it is greater than StartTime
 	// and won't be ignored.
 
-	const minWorkflowDuration = time.Second
 	var batchTimestamp time.Time
 	if request.CloseTime.Sub(request.StartTime) < minWorkflowDuration {
 		batchTimestamp = request.StartTime.Add(minWorkflowDuration)
@@ -252,9 +283,40 @@ func (v *visibilityStore) RecordWorkflowExecutionClosed(request *visibility.Inte
 	return gocql.ConvertError("RecordWorkflowExecutionClosed", err)
 }
 
-func (v *visibilityStore) UpsertWorkflowExecution(_ *visibility.InternalUpsertWorkflowExecutionRequest) error {
-	// Not OperationNotSupportedErr!
-	return nil
+func (v *visibilityStore) UpsertWorkflowExecution(request *visibility.InternalUpsertWorkflowExecutionRequest) error {
+	// Only memo of open workflows is updated. Search attributes are not supported by standard visibility,
+	// but it is not OperationNotSupportedErr!
+	if request.Status != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING || request.Memo == nil {
+		return nil
+	}
+
+	query := v.session.Query(templateUpdateWorkflowExecutionStartedMemo,
+		request.Memo.Data,
+		request.Memo.EncodingType.String(),
+		request.NamespaceID,
+		namespacePartition,
+		persistence.UnixMilliseconds(request.StartTime),
+		request.RunID,
+	)
+	query = query.WithTimestamp(persistence.UnixMilliseconds(upsertTimestamp(request.StartTime, request.StateTransitionCount)))
+	err := query.Exec()
+	return gocql.ConvertError("UpsertWorkflowExecution", err)
+}
+
+// upsertTimestamp returns timestamp for update query in `open_executions` table.
+// It must be greater than StartTime (used by RecordWorkflowExecutionStarted) for update to be applied,
+// and less than StartTime + minWorkflowDuration (minimum used by RecordWorkflowExecutionClosed)
+// for delete to win and not leave row with only memo columns behind. State transition count is used
+// to make later upserts win over earlier ones independently of the time when visibility task is processed.
+func upsertTimestamp(startTime time.Time, stateTransitionCount int64) time.Time {
+	offset := time.Duration(stateTransitionCount) * time.Millisecond
+	if offset < time.Millisecond {
+		offset = time.Millisecond
+	}
+	if offset >= minWorkflowDuration {
+		offset = minWorkflowDuration - time.Millisecond
+	}
+	return startTime.Add(offset)
 }
 
 func (v *visibilityStore) ListOpenWorkflowExecutions(
@@ -459,6 +521,55 @@ func (v *visibilityStore) ListClosedWorkflowExecutionsByStatus(
 	return response, nil
 }
 
+func (v *visibilityStore) ListClosedWorkflowExecutionsByTypeAndStatus(
+	request *visibility.ListClosedWorkflowExecutionsByTypeAndStatusRequest) (*visibility.InternalListWorkflowExecutionsResponse, error) {
+	query := v.session.
+		Query(templateGetClosedWorkflowExecutionsByTypeAndStatus,
+			request.NamespaceID,
+			namespacePartition,
+			persistence.UnixMilliseconds(request.EarliestStartTime),
+			persistence.UnixMilliseconds(request.LatestStartTime),
+			request.WorkflowTypeName,
+			request.Status).
+		Consistency(v.lowConslevel)
+	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
+
+	response := &visibility.InternalListWorkflowExecutionsResponse{}
+	response.Executions = make([]*visibility.VisibilityWorkflowExecutionInfo, 0, request.PageSize)
+	wfexecution, has := readClosedWorkflowExecutionRecord(iter)
+	for has {
+		response.Executions = append(response.Executions, wfexecution)
+		wfexecution, has = readClosedWorkflowExecutionRecord(iter)
+	}
+
+	nextPageToken := iter.PageState()
+	response.NextPageToken = make([]byte, len(nextPageToken))
+	copy(response.NextPageToken, nextPageToken)
+	if err := iter.Close(); err != nil {
+		return nil, gocql.ConvertError("ListClosedWorkflowExecutionsByTypeAndStatus", err)
+	}
+	return response, nil
+}
+
+func (v *visibilityStore) CountClosedWorkflowExecutionsByTypeAndStatus(
+	request *visibility.CountClosedWorkflowExecutionsByTypeAndStatusRequest) (*visibility.CountWorkflowExecutionsResponse, error) {
+	query := v.session.
+		Query(templateCountClosedWorkflowExecutionsByTypeAndStatus,
+			request.NamespaceID,
+			namespacePartition,
+			persistence.UnixMilliseconds(request.EarliestCloseTime),
+			persistence.UnixMilliseconds(request.LatestCloseTime),
+			request.WorkflowTypeName,
+			request.Status).
+		Consistency(v.lowConslevel)
+
+	var count int64
+	if err := query.Scan(&count); err != nil {
+		return nil, gocql.ConvertError("CountClosedWorkflowExecutionsByTypeAndStatus", err)
+	}
+	return &visibility.CountWorkflowExecutionsResponse{Count: count}, nil
+}
+
 // DeleteWorkflowExecution is a no-op since deletes are auto-handled by cassandra TTLs
 func (v *visibilityStore) DeleteWorkflowExecution(_ *visibility.VisibilityDeleteWorkflowExecutionRequest) error {
 	return nil
@@ -38,7 +38,9 @@ import (
 	"go.temporal.io/server/common/config"
 )
 
-func NewAwsHttpClient(config config.ESAWSRequestSigningConfig) (*http.Client, error) {
+// NewAwsHttpClient returns HTTP client which signs requests to AWS Elasticsearch using httpClient to send them.
+// If httpClient is nil then http.DefaultClient is used.
+func NewAwsHttpClient(config config.ESAWSRequestSigningConfig, httpClient *http.Client) (*http.Client, error) {
 	if !config.Enabled {
 		return nil, nil
 	}
@@ -75,5 +77,8 @@ func NewAwsHttpClient(config config.ESAWSRequestSigningConfig) (*http.Client, er
 		return nil, fmt.Errorf("unknown AWS credential provider specified: %+v. Accepted options are 'static', 'environment' or 'session'", config.CredentialProvider)
 	}
 
-	return elasticaws.NewV4SigningClient(awsCredentials, config.Region), nil
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return elasticaws.NewV4SigningClientWithHTTPClient(awsCredentials, config.Region, httpClient), nil
 }
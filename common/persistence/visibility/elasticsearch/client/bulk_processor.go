@@ -43,6 +43,18 @@ type (
 	BulkProcessor interface {
 		Stop() error
 		Add(request *BulkableRequest)
+		// Flush commits all pending requests and waits for the commit to complete.
+		Flush() error
+		Stats() BulkProcessorStats
+	}
+
+	// BulkProcessorStats is a snapshot of bulk processor counters
+	BulkProcessorStats struct {
+		Flushed   int64 // number of times pending requests were flushed
+		Committed int64 // number of committed bulks
+		Succeeded int64 // number of requests reported by Elasticsearch as successful
+		Failed    int64 // number of requests reported by Elasticsearch as failed
+		Queued    int64 // number of requests waiting in workers to be committed
 	}
 
 	// BulkProcessorParameters holds all required and optional parameters for executing bulk service
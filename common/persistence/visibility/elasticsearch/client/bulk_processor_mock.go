@@ -69,6 +69,34 @@ func (mr *MockBulkProcessorMockRecorder) Add(request interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockBulkProcessor)(nil).Add), request)
 }
 
+// Flush mocks base method.
+func (m *MockBulkProcessor) Flush() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Flush")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Flush indicates an expected call of Flush.
+func (mr *MockBulkProcessorMockRecorder) Flush() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Flush", reflect.TypeOf((*MockBulkProcessor)(nil).Flush))
+}
+
+// Stats mocks base method.
+func (m *MockBulkProcessor) Stats() BulkProcessorStats {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stats")
+	ret0, _ := ret[0].(BulkProcessorStats)
+	return ret0
+}
+
+// Stats indicates an expected call of Stats.
+func (mr *MockBulkProcessorMockRecorder) Stats() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stats", reflect.TypeOf((*MockBulkProcessor)(nil).Stats))
+}
+
 // Stop mocks base method.
 func (m *MockBulkProcessor) Stop() error {
 	m.ctrl.T.Helper()
@@ -53,6 +53,24 @@ func (p *bulkProcessorV6) Stop() error {
 	return errS
 }
 
+func (p *bulkProcessorV6) Flush() error {
+	return p.esBulkProcessor.Flush()
+}
+
+func (p *bulkProcessorV6) Stats() BulkProcessorStats {
+	esStats := p.esBulkProcessor.Stats()
+	stats := BulkProcessorStats{
+		Flushed:   esStats.Flushed,
+		Committed: esStats.Committed,
+		Succeeded: esStats.Succeeded,
+		Failed:    esStats.Failed,
+	}
+	for _, worker := range esStats.Workers {
+		stats.Queued += worker.Queued
+	}
+	return stats
+}
+
 func (p *bulkProcessorV6) Add(request *BulkableRequest) {
 	switch request.RequestType {
 	case BulkableRequestTypeIndex:
@@ -52,6 +52,24 @@ func (p *bulkProcessorV7) Stop() error {
 	return errS
 }
 
+func (p *bulkProcessorV7) Flush() error {
+	return p.esBulkProcessor.Flush()
+}
+
+func (p *bulkProcessorV7) Stats() BulkProcessorStats {
+	esStats := p.esBulkProcessor.Stats()
+	stats := BulkProcessorStats{
+		Flushed:   esStats.Flushed,
+		Committed: esStats.Committed,
+		Succeeded: esStats.Succeeded,
+		Failed:    esStats.Failed,
+	}
+	for _, worker := range esStats.Workers {
+		stats.Queued += worker.Queued
+	}
+	return stats
+}
+
 func (p *bulkProcessorV7) Add(request *BulkableRequest) {
 	switch request.RequestType {
 	case BulkableRequestTypeIndex:
@@ -37,6 +37,7 @@ import (
 
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/searchattribute"
 )
 
 type (
@@ -52,14 +53,13 @@ var _ Client = (*clientV6)(nil)
 func newClientV6(config *config.Elasticsearch, httpClient *http.Client, logger log.Logger) (*clientV6, error) {
 	options := []elastic6.ClientOptionFunc{
 		elastic6.SetURL(config.URL.String()),
-		elastic6.SetSniff(false),
-		elastic6.SetBasicAuth(config.Username, config.Password),
+		elastic6.SetSniff(config.EnableSniff),
 
 		// Disable health check so we don't block client creation (and thus temporal server startup)
 		// if the ES instance happens to be down.
 		elastic6.SetHealthcheck(false),
 
-		elastic6.SetRetrier(elastic6.NewBackoffRetrier(elastic6.NewExponentialBackoff(128*time.Millisecond, 513*time.Millisecond))),
+		elastic6.SetRetrier(newRetrier(config.Retry)),
 
 		// critical to ensure decode of int64 won't lose precision
 		elastic6.SetDecoder(&elastic6.NumberDecoder{}),
@@ -67,7 +67,14 @@ func newClientV6(config *config.Elasticsearch, httpClient *http.Client, logger l
 
 	options = append(options, getLoggerOptionsV6(config.LogLevel, logger)...)
 
-	if httpClient != nil {
+	if useBasicAuth(config) {
+		options = append(options, elastic6.SetBasicAuth(config.Username, config.Password))
+	}
+	if headers := authHeaders(config); headers != nil {
+		options = append(options, elastic6.SetHeaders(headers))
+	}
+
+	if httpClient = withRequestTimeout(config, httpClient); httpClient != nil {
 		options = append(options, elastic6.SetHttpClient(httpClient))
 	}
 
@@ -152,6 +159,7 @@ func (c *clientV6) RunBulkProcessor(ctx context.Context, p *BulkProcessorParamet
 		BulkSize(p.BulkSize).
 		FlushInterval(p.FlushInterval).
 		Backoff(p.Backoff).
+		Stats(true).
 		Before(convertV7BeforeFuncToV6(p.BeforeFunc)).
 		After(convertV7AfterFuncToV6(p.AfterFunc)).
 		Do(ctx)
@@ -615,7 +623,7 @@ func buildMappingBodyV6(mapping map[string]enumspb.IndexedValueType) map[string]
 		switch fieldType {
 		case enumspb.INDEXED_VALUE_TYPE_STRING:
 			typeMap = map[string]interface{}{"type": "text"}
-		case enumspb.INDEXED_VALUE_TYPE_KEYWORD:
+		case enumspb.INDEXED_VALUE_TYPE_KEYWORD, searchattribute.IndexedValueTypeKeywordList:
 			typeMap = map[string]interface{}{"type": "keyword"}
 		case enumspb.INDEXED_VALUE_TYPE_INT:
 			typeMap = map[string]interface{}{"type": "long"}
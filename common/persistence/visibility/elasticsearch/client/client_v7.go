@@ -34,6 +34,7 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/searchattribute"
 )
 
 type (
@@ -50,14 +51,14 @@ var _ ClientV7 = (*clientV7)(nil)
 func newClientV7(config *config.Elasticsearch, httpClient *http.Client, logger log.Logger) (*clientV7, error) {
 	options := []elastic.ClientOptionFunc{
 		elastic.SetURL(config.URL.String()),
-		elastic.SetSniff(false),
-		elastic.SetBasicAuth(config.Username, config.Password),
+		elastic.SetSniff(config.EnableSniff),
 
 		// Disable health check so we don't block client creation (and thus temporal server startup)
 		// if the ES instance happens to be down.
 		elastic.SetHealthcheck(false),
 
-		elastic.SetRetrier(elastic.NewBackoffRetrier(elastic.NewExponentialBackoff(128*time.Millisecond, 513*time.Millisecond))),
+		elastic.SetRetrier(newRetrier(config.Retry)),
+		elastic.SetRetryStatusCodes(retryStatusCodes(config.Retry)...),
 
 		// critical to ensure decode of int64 won't lose precision
 		elastic.SetDecoder(&elastic.NumberDecoder{}),
@@ -65,7 +66,14 @@ func newClientV7(config *config.Elasticsearch, httpClient *http.Client, logger l
 
 	options = append(options, getLoggerOptions(config.LogLevel, logger)...)
 
-	if httpClient != nil {
+	if useBasicAuth(config) {
+		options = append(options, elastic.SetBasicAuth(config.Username, config.Password))
+	}
+	if headers := authHeaders(config); headers != nil {
+		options = append(options, elastic.SetHeaders(headers))
+	}
+
+	if httpClient = withRequestTimeout(config, httpClient); httpClient != nil {
 		options = append(options, elastic.SetHttpClient(httpClient))
 	}
 
@@ -154,6 +162,7 @@ func (c *clientV7) RunBulkProcessor(ctx context.Context, p *BulkProcessorParamet
 		BulkSize(p.BulkSize).
 		FlushInterval(p.FlushInterval).
 		Backoff(p.Backoff).
+		Stats(true).
 		Before(p.BeforeFunc).
 		After(p.AfterFunc).
 		Do(ctx)
@@ -281,7 +290,7 @@ func buildMappingBody(mapping map[string]enumspb.IndexedValueType) map[string]in
 		switch fieldType {
 		case enumspb.INDEXED_VALUE_TYPE_STRING:
 			typeMap = map[string]interface{}{"type": "text"}
-		case enumspb.INDEXED_VALUE_TYPE_KEYWORD:
+		case enumspb.INDEXED_VALUE_TYPE_KEYWORD, searchattribute.IndexedValueTypeKeywordList:
 			typeMap = map[string]interface{}{"type": "keyword"}
 		case enumspb.INDEXED_VALUE_TYPE_INT:
 			typeMap = map[string]interface{}{"type": "long"}
@@ -0,0 +1,150 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/config"
+)
+
+const (
+	defaultRetryMaxRetries      = 3
+	defaultRetryInitialInterval = 128 * time.Millisecond
+	defaultRetryMaxInterval     = 2 * time.Second
+)
+
+var (
+	defaultRetryStatusCodes = []int{http.StatusTooManyRequests, http.StatusServiceUnavailable}
+)
+
+type (
+	// retrier implements both elastic.Retrier and elastic6.Retrier.
+	// It retries failed requests with exponential backoff and jitter, so clients don't retry in lockstep when ES is degraded.
+	retrier struct {
+		policy backoff.RetryPolicy
+	}
+)
+
+func newRetrier(cfg config.ESRetryConfig) *retrier {
+	if cfg.MaxRetries < 0 {
+		return &retrier{}
+	}
+
+	maxRetries := cfg.MaxRetries
+	if maxRetries == 0 {
+		maxRetries = defaultRetryMaxRetries
+	}
+	initialInterval := cfg.InitialInterval
+	if initialInterval == 0 {
+		initialInterval = defaultRetryInitialInterval
+	}
+	maxInterval := cfg.MaxInterval
+	if maxInterval == 0 {
+		maxInterval = defaultRetryMaxInterval
+	}
+
+	policy := backoff.NewExponentialRetryPolicy(initialInterval)
+	policy.SetMaximumInterval(maxInterval)
+	policy.SetMaximumAttempts(maxRetries)
+	policy.SetExpirationInterval(backoff.NoInterval)
+	return &retrier{policy: policy}
+}
+
+// Retry is called by ES client when request fails with connection error or retryable status code.
+// retry is 1 for the first retry.
+func (r *retrier) Retry(ctx context.Context, retry int, _ *http.Request, _ *http.Response, _ error) (time.Duration, bool, error) {
+	if r.policy == nil || ctx.Err() != nil {
+		return 0, false, nil
+	}
+	wait := r.policy.ComputeNextDelay(0, retry)
+	if wait < 0 {
+		return 0, false, nil
+	}
+	return wait, true, nil
+}
+
+func retryStatusCodes(cfg config.ESRetryConfig) []int {
+	if len(cfg.StatusCodes) == 0 {
+		return defaultRetryStatusCodes
+	}
+	return cfg.StatusCodes
+}
+
+// NewHttpClient returns HTTP client with connection pool configured from ES config
+// or nil if defaults should be used.
+func NewHttpClient(cfg *config.Elasticsearch) *http.Client {
+	if cfg.MaxConnsPerHost == 0 && cfg.MaxIdleConnsPerHost == 0 {
+		return nil
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxConnsPerHost = cfg.MaxConnsPerHost
+	transport.MaxIdleConnsPerHost = cfg.MaxIdleConnsPerHost
+	return &http.Client{Transport: transport}
+}
+
+// withRequestTimeout returns HTTP client to be used by ES client with per request timeout applied.
+// Provided client is copied and not modified.
+func withRequestTimeout(cfg *config.Elasticsearch, httpClient *http.Client) *http.Client {
+	if httpClient == nil {
+		httpClient = NewHttpClient(cfg)
+	}
+	if cfg.RequestTimeout == 0 {
+		return httpClient
+	}
+	if httpClient == nil {
+		return &http.Client{Timeout: cfg.RequestTimeout}
+	}
+
+	clientWithTimeout := *httpClient
+	clientWithTimeout.Timeout = cfg.RequestTimeout
+	return &clientWithTimeout
+}
+
+func useBasicAuth(cfg *config.Elasticsearch) bool {
+	return cfg.AuthType != config.ESAuthTypeAPIKey && cfg.AuthType != config.ESAuthTypeBearer
+}
+
+// authHeaders returns headers which are added to every request for API key and bearer token authentication.
+func authHeaders(cfg *config.Elasticsearch) http.Header {
+	switch cfg.AuthType {
+	case config.ESAuthTypeAPIKey:
+		apiKey := cfg.APIKey
+		if strings.Contains(apiKey, ":") {
+			apiKey = base64.StdEncoding.EncodeToString([]byte(apiKey))
+		}
+		return http.Header{"Authorization": []string{"ApiKey " + apiKey}}
+	case config.ESAuthTypeBearer:
+		return http.Header{"Authorization": []string{"Bearer " + cfg.Token}}
+	default:
+		return nil
+	}
+}
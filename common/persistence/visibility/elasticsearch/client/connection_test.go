@@ -0,0 +1,112 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.temporal.io/server/common/config"
+)
+
+func Test_Retrier(t *testing.T) {
+	assert := assert.New(t)
+
+	r := newRetrier(config.ESRetryConfig{
+		MaxRetries:      2,
+		InitialInterval: 100 * time.Millisecond,
+		MaxInterval:     150 * time.Millisecond,
+	})
+
+	wait, ok, err := r.Retry(context.Background(), 1, nil, nil, nil)
+	assert.NoError(err)
+	assert.True(ok)
+	assert.True(wait >= 80*time.Millisecond && wait <= 100*time.Millisecond)
+
+	wait, ok, err = r.Retry(context.Background(), 2, nil, nil, nil)
+	assert.NoError(err)
+	assert.True(ok)
+	assert.True(wait >= 120*time.Millisecond && wait <= 150*time.Millisecond)
+
+	_, ok, err = r.Retry(context.Background(), 3, nil, nil, nil)
+	assert.NoError(err)
+	assert.False(ok)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, ok, err = r.Retry(ctx, 1, nil, nil, nil)
+	assert.NoError(err)
+	assert.False(ok)
+}
+
+func Test_Retrier_Disabled(t *testing.T) {
+	r := newRetrier(config.ESRetryConfig{MaxRetries: -1})
+	_, ok, err := r.Retry(context.Background(), 1, nil, nil, nil)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func Test_RetryStatusCodes(t *testing.T) {
+	assert.Equal(t, []int{http.StatusTooManyRequests, http.StatusServiceUnavailable}, retryStatusCodes(config.ESRetryConfig{}))
+	assert.Equal(t, []int{http.StatusBadGateway}, retryStatusCodes(config.ESRetryConfig{StatusCodes: []int{http.StatusBadGateway}}))
+}
+
+func Test_WithRequestTimeout(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Nil(withRequestTimeout(&config.Elasticsearch{}, nil))
+
+	httpClient := withRequestTimeout(&config.Elasticsearch{MaxConnsPerHost: 10, RequestTimeout: time.Second}, nil)
+	assert.Equal(time.Second, httpClient.Timeout)
+	assert.Equal(10, httpClient.Transport.(*http.Transport).MaxConnsPerHost)
+
+	providedClient := &http.Client{}
+	httpClient = withRequestTimeout(&config.Elasticsearch{RequestTimeout: time.Second}, providedClient)
+	assert.Equal(time.Second, httpClient.Timeout)
+	assert.Equal(time.Duration(0), providedClient.Timeout)
+}
+
+func Test_AuthHeaders(t *testing.T) {
+	assert := assert.New(t)
+
+	cfg := &config.Elasticsearch{Username: "user", Password: "pass"}
+	assert.True(useBasicAuth(cfg))
+	assert.Nil(authHeaders(cfg))
+
+	cfg = &config.Elasticsearch{AuthType: config.ESAuthTypeAPIKey, APIKey: "id:key"}
+	assert.False(useBasicAuth(cfg))
+	assert.Equal("ApiKey aWQ6a2V5", authHeaders(cfg).Get("Authorization"))
+
+	cfg = &config.Elasticsearch{AuthType: config.ESAuthTypeAPIKey, APIKey: "aWQ6a2V5"}
+	assert.Equal("ApiKey aWQ6a2V5", authHeaders(cfg).Get("Authorization"))
+
+	cfg = &config.Elasticsearch{AuthType: config.ESAuthTypeBearer, Token: "token"}
+	assert.False(useBasicAuth(cfg))
+	assert.Equal("Bearer token", authHeaders(cfg).Get("Authorization"))
+}
@@ -0,0 +1,165 @@
+// The MIT License
+//
+// Copyright (c) 2021 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package elasticsearch
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	esclient "go.temporal.io/server/common/persistence/visibility/elasticsearch/client"
+)
+
+const (
+	defaultPointInTimeKeepAlive = time.Minute
+	pointInTimeCloseTimeout     = 5 * time.Second
+)
+
+type (
+	// pointInTimeReaper keeps track of the point in times opened by ScanWorkflowExecutions and closes the ones
+	// which were not used for longer than the keep alive interval, i.e. the page tokens referencing them expired
+	// because the client abandoned the scan.
+	pointInTimeReaper struct {
+		status        int32
+		esClient      esclient.ClientV7
+		keepAlive     dynamicconfig.DurationPropertyFn
+		timeSource    clock.TimeSource
+		metricsClient metrics.Client
+		logger        log.Logger
+		shutdownCh    chan struct{}
+
+		sync.Mutex
+		// point in time ID -> last time a page was read with it
+		lastUsed map[string]time.Time
+	}
+)
+
+func newPointInTimeReaper(
+	esClient esclient.ClientV7,
+	keepAlive dynamicconfig.DurationPropertyFn,
+	timeSource clock.TimeSource,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) *pointInTimeReaper {
+	if keepAlive == nil {
+		keepAlive = dynamicconfig.GetDurationPropertyFn(defaultPointInTimeKeepAlive)
+	}
+	return &pointInTimeReaper{
+		status:        common.DaemonStatusInitialized,
+		esClient:      esClient,
+		keepAlive:     keepAlive,
+		timeSource:    timeSource,
+		metricsClient: metricsClient,
+		logger:        logger,
+		shutdownCh:    make(chan struct{}),
+		lastUsed:      make(map[string]time.Time),
+	}
+}
+
+func (r *pointInTimeReaper) Start() {
+	if !atomic.CompareAndSwapInt32(&r.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	go r.reapLoop()
+}
+
+func (r *pointInTimeReaper) Stop() {
+	if !atomic.CompareAndSwapInt32(&r.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	close(r.shutdownCh)
+}
+
+// keepAliveInterval returns the keep alive interval in the Elasticsearch time units format.
+func (r *pointInTimeReaper) keepAliveInterval() string {
+	keepAlive := r.keepAlive()
+	if keepAlive < time.Second {
+		keepAlive = time.Second
+	}
+	return fmt.Sprintf("%ds", int64(keepAlive/time.Second))
+}
+
+// used records that a page was read with the point in time, which replaces the previous one if its ID changed.
+func (r *pointInTimeReaper) used(id string, previousID string) {
+	r.Lock()
+	defer r.Unlock()
+	if previousID != id {
+		delete(r.lastUsed, previousID)
+	}
+	r.lastUsed[id] = r.timeSource.Now()
+}
+
+// closed stops tracking a point in time closed after the last page.
+func (r *pointInTimeReaper) closed(id string) {
+	r.Lock()
+	defer r.Unlock()
+	delete(r.lastUsed, id)
+}
+
+func (r *pointInTimeReaper) reapLoop() {
+	timer := time.NewTimer(r.keepAlive())
+	defer timer.Stop()
+	for {
+		select {
+		case <-r.shutdownCh:
+			return
+		case <-timer.C:
+			r.reap()
+			timer.Reset(r.keepAlive())
+		}
+	}
+}
+
+// reap closes the point in times which expired and reports how many are still open.
+func (r *pointInTimeReaper) reap() {
+	expireBefore := r.timeSource.Now().Add(-r.keepAlive())
+	var expired []string
+	r.Lock()
+	for id, lastUsed := range r.lastUsed {
+		if lastUsed.Before(expireBefore) {
+			expired = append(expired, id)
+			delete(r.lastUsed, id)
+		}
+	}
+	open := len(r.lastUsed)
+	r.Unlock()
+
+	for _, id := range expired {
+		ctx, cancel := context.WithTimeout(context.Background(), pointInTimeCloseTimeout)
+		// Point in time might be already expired in Elasticsearch, which is not an error.
+		if _, err := r.esClient.ClosePointInTime(ctx, id); err != nil {
+			r.logger.Warn("Unable to close expired point in time.", tag.Error(err))
+		}
+		cancel()
+	}
+	r.metricsClient.AddCounter(metrics.ElasticsearchVisibility, metrics.ElasticsearchPointInTimeReapedCount, int64(len(expired)))
+	r.metricsClient.UpdateGauge(metrics.ElasticsearchVisibility, metrics.ElasticsearchOpenPointInTimeCount, float64(open))
+}
@@ -0,0 +1,76 @@
+// The MIT License
+//
+// Copyright (c) 2021 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package elasticsearch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence/visibility/elasticsearch/client"
+)
+
+func TestPointInTimeReaper_Reap(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	mockESClientV7 := client.NewMockClientV7(controller)
+	mockMetricsClient := metrics.NewMockClient(controller)
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	reaper := newPointInTimeReaper(mockESClientV7, dynamicconfig.GetDurationPropertyFn(time.Minute), timeSource, mockMetricsClient, log.NewNoopLogger())
+
+	reaper.used("abandoned", "")
+	reaper.used("finished", "")
+	reaper.used("scanning", "")
+	timeSource.Update(timeSource.Now().Add(2 * time.Minute))
+	reaper.closed("finished")
+	// ID of point in time changed on the next page.
+	reaper.used("scanning-next", "scanning")
+
+	mockESClientV7.EXPECT().ClosePointInTime(gomock.Any(), "abandoned").Return(true, nil)
+	mockMetricsClient.EXPECT().AddCounter(metrics.ElasticsearchVisibility, metrics.ElasticsearchPointInTimeReapedCount, int64(1))
+	mockMetricsClient.EXPECT().UpdateGauge(metrics.ElasticsearchVisibility, metrics.ElasticsearchOpenPointInTimeCount, float64(1))
+	reaper.reap()
+	require.Equal(t, []string{"scanning-next"}, mapKeys(reaper.lastUsed))
+}
+
+func TestPointInTimeReaper_KeepAliveInterval(t *testing.T) {
+	reaper := newPointInTimeReaper(nil, nil, clock.NewRealTimeSource(), metrics.NewNoopMetricsClient(), log.NewNoopLogger())
+	require.Equal(t, "60s", reaper.keepAliveInterval())
+
+	reaper.keepAlive = dynamicconfig.GetDurationPropertyFn(90 * time.Second)
+	require.Equal(t, "90s", reaper.keepAliveInterval())
+}
+
+func mapKeys(m map[string]time.Time) []string {
+	var keys []string
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
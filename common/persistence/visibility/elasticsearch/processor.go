@@ -29,7 +29,9 @@ package elasticsearch
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -52,18 +54,48 @@ type (
 
 		// Add request to bulk processor.
 		Add(request *esclient.BulkableRequest, visibilityTaskKey string) <-chan bool
+		// AddBackfill adds backfill request to bulk processor. Backfill requests are committed
+		// by separate workers, so a large backfill doesn't delay visibility updates of live workflows.
+		AddBackfill(request *esclient.BulkableRequest, visibilityTaskKey string) <-chan bool
+		// Flush commits all pending requests of bulk processors and waits for the commit to complete.
+		Flush() error
+		// Stats returns a snapshot of processor state to debug visibility indexing stalls.
+		Stats() ProcessorStats
 	}
 
 	// processorImpl implements Processor, it's an agent of elastic.BulkProcessor
 	processorImpl struct {
-		status                  int32
-		bulkProcessor           esclient.BulkProcessor
-		bulkProcessorParameters *esclient.BulkProcessorParameters
-		client                  esclient.Client
-		mapToAckChan            collection.ConcurrentTxMap // used to map ES request to ack channel
-		logger                  log.Logger
-		metricsClient           metrics.Client
-		indexerConcurrency      uint32
+		status                          int32
+		bulkProcessor                   esclient.BulkProcessor
+		bulkProcessorParameters         *esclient.BulkProcessorParameters
+		backfillBulkProcessor           esclient.BulkProcessor
+		backfillBulkProcessorParameters *esclient.BulkProcessorParameters // nil if backfill requests share bulk processor with live requests
+		client                          esclient.Client
+		mapToAckChan                    collection.ConcurrentTxMap // used to map ES request to ack channel
+		logger                          log.Logger
+		metricsClient                   metrics.Client
+		indexerConcurrency              uint32
+		shutdownDrainTimeout            dynamicconfig.DurationPropertyFn
+		inFlightBulks                   int64 // number of bulks being committed, accessed atomically
+
+		lastCommitErrorLock sync.Mutex
+		lastCommitError     error
+		lastCommitErrorTime time.Time
+	}
+
+	// ProcessorStats is a snapshot of processor state
+	ProcessorStats struct {
+		BulkProcessors      []BulkProcessorStats
+		InFlightBulks       int64     // number of bulks being committed
+		PendingAcks         int       // number of requests waiting for ack
+		LastCommitError     string    // last error returned by bulk commit after retries, empty if none
+		LastCommitErrorTime time.Time // zero if there was no commit error
+	}
+
+	// BulkProcessorStats contains the stats of a bulk processor used by processor
+	BulkProcessorStats struct {
+		esclient.BulkProcessorStats
+		Name string
 	}
 
 	// ProcessorConfig contains all configs for processor
@@ -73,6 +105,10 @@ type (
 		ESProcessorBulkActions   dynamicconfig.IntPropertyFn // max number of requests in bulk
 		ESProcessorBulkSize      dynamicconfig.IntPropertyFn // max total size of bytes in bulk
 		ESProcessorFlushInterval dynamicconfig.DurationPropertyFn
+		// number of workers committing backfill requests, 0 or nil to commit them together with live requests
+		ESProcessorBackfillWorkers dynamicconfig.IntPropertyFn
+		// max time to wait on Stop for pending requests to be acked, nil to use default
+		ESProcessorShutdownDrainTimeout dynamicconfig.DurationPropertyFn
 	}
 
 	ackChan struct { // value of processorImpl.mapToAckChan
@@ -84,11 +120,16 @@ type (
 
 var _ Processor = (*processorImpl)(nil)
 
+var errProcessorNotStarted = errors.New("elasticsearch processor is not started")
+
 const (
 	// retry configs for es bulk processor
 	esProcessorInitialRetryInterval = 200 * time.Millisecond
 	esProcessorMaxRetryInterval     = 20 * time.Second
 	visibilityProcessorName         = "visibility-processor"
+	backfillProcessorName           = "visibility-backfill-processor"
+
+	defaultShutdownDrainTimeout = 10 * time.Second
 )
 
 // NewProcessor create new processorImpl
@@ -114,8 +155,20 @@ func NewProcessor(
 			Backoff:       elastic.NewExponentialBackoff(esProcessorInitialRetryInterval, esProcessorMaxRetryInterval),
 		},
 	}
+	p.shutdownDrainTimeout = cfg.ESProcessorShutdownDrainTimeout
+	if p.shutdownDrainTimeout == nil {
+		p.shutdownDrainTimeout = dynamicconfig.GetDurationPropertyFn(defaultShutdownDrainTimeout)
+	}
 	p.bulkProcessorParameters.AfterFunc = p.bulkAfterAction
 	p.bulkProcessorParameters.BeforeFunc = p.bulkBeforeAction
+
+	if cfg.ESProcessorBackfillWorkers != nil && cfg.ESProcessorBackfillWorkers() > 0 {
+		backfillParameters := *p.bulkProcessorParameters
+		backfillParameters.Name = backfillProcessorName
+		backfillParameters.NumOfWorkers = cfg.ESProcessorBackfillWorkers()
+		backfillParameters.Backoff = elastic.NewExponentialBackoff(esProcessorInitialRetryInterval, esProcessorMaxRetryInterval)
+		p.backfillBulkProcessorParameters = &backfillParameters
+	}
 	return p
 }
 
@@ -134,6 +187,14 @@ func (p *processorImpl) Start() {
 	if err != nil {
 		p.logger.Fatal("Unable to start Elasticsearch processor.", tag.LifeCycleStartFailed, tag.Error(err))
 	}
+
+	p.backfillBulkProcessor = p.bulkProcessor
+	if p.backfillBulkProcessorParameters != nil {
+		p.backfillBulkProcessor, err = p.client.RunBulkProcessor(context.Background(), p.backfillBulkProcessorParameters)
+		if err != nil {
+			p.logger.Fatal("Unable to start Elasticsearch backfill processor.", tag.LifeCycleStartFailed, tag.Error(err))
+		}
+	}
 }
 
 func (p *processorImpl) Stop() {
@@ -145,12 +206,64 @@ func (p *processorImpl) Stop() {
 		return
 	}
 
-	err := p.bulkProcessor.Stop()
-	if err != nil {
-		p.logger.Fatal("Unable to stop Elasticsearch processor.", tag.LifeCycleStopFailed, tag.Error(err))
+	drainStartTime := time.Now().UTC()
+	drained := make(chan struct{})
+	bulkProcessor := p.bulkProcessor
+	backfillBulkProcessor := p.backfillBulkProcessor
+	go func() {
+		defer close(drained)
+		// Stopping bulk processor flushes and commits all pending requests.
+		if backfillBulkProcessor != bulkProcessor {
+			if err := backfillBulkProcessor.Stop(); err != nil {
+				p.logger.Fatal("Unable to stop Elasticsearch backfill processor.", tag.LifeCycleStopFailed, tag.Error(err))
+			}
+		}
+		if err := bulkProcessor.Stop(); err != nil {
+			p.logger.Fatal("Unable to stop Elasticsearch processor.", tag.LifeCycleStopFailed, tag.Error(err))
+		}
+	}()
+
+	drainTimer := time.NewTimer(p.shutdownDrainTimeout())
+	defer drainTimer.Stop()
+
+	select {
+	case <-drained:
+		// Requests which are still pending after bulk processor is stopped won't be acked anymore.
+		p.nackPendingRequests()
+		p.metricsClient.RecordTimer(metrics.ElasticsearchBulkProcessor, metrics.ElasticsearchBulkProcessorDrainLatency, time.Now().UTC().Sub(drainStartTime))
+		p.mapToAckChan = nil
+		p.bulkProcessor = nil
+		p.backfillBulkProcessor = nil
+	case <-drainTimer.C:
+		// Bulk processor is still committing requests in background and might ack some of them concurrently.
+		// Ack channel map is kept to let it finish, sendToAckChan guarantees every request is acked or nacked only once.
+		p.logger.Warn("Timed out while draining Elasticsearch processor. Pending requests are nacked.", tag.Timeout(p.shutdownDrainTimeout().String()))
+		p.metricsClient.IncCounter(metrics.ElasticsearchBulkProcessor, metrics.ElasticsearchBulkProcessorDrainTimeouts)
+		p.nackPendingRequests()
+		p.metricsClient.RecordTimer(metrics.ElasticsearchBulkProcessor, metrics.ElasticsearchBulkProcessorDrainLatency, time.Now().UTC().Sub(drainStartTime))
+	}
+}
+
+// nackPendingRequests nacks all requests which are not acked yet, so corresponding visibility tasks
+// are retried instead of waiting for ack timeout.
+func (p *processorImpl) nackPendingRequests() {
+	var pendingKeys []string
+	it := p.mapToAckChan.Iter()
+	for entry := range it.Entries() {
+		pendingKeys = append(pendingKeys, entry.Key.(string))
+	}
+	it.Close()
+
+	nacked := 0
+	for _, visibilityTaskKey := range pendingKeys {
+		if p.sendToAckChan(visibilityTaskKey, false) {
+			nacked++
+		}
+	}
+	if nacked > 0 {
+		p.logger.Warn("Elasticsearch processor nacked pending requests on shutdown.", tag.Counter(nacked))
+		p.metricsClient.AddCounter(metrics.ElasticsearchBulkProcessor, metrics.ElasticsearchBulkProcessorDrainNacks, int64(nacked))
 	}
-	p.mapToAckChan = nil
-	p.bulkProcessor = nil
 }
 
 func (p *processorImpl) hashFn(key interface{}) uint32 {
@@ -165,6 +278,62 @@ func (p *processorImpl) hashFn(key interface{}) uint32 {
 
 // Add request to the bulk and return ack channel which will receive ack signal when request is processed.
 func (p *processorImpl) Add(request *esclient.BulkableRequest, visibilityTaskKey string) <-chan bool {
+	return p.add(p.bulkProcessor, request, visibilityTaskKey)
+}
+
+// AddBackfill adds backfill request to the bulk and return ack channel which will receive ack signal when request is processed.
+func (p *processorImpl) AddBackfill(request *esclient.BulkableRequest, visibilityTaskKey string) <-chan bool {
+	p.metricsClient.IncCounter(metrics.ElasticsearchBulkProcessor, metrics.ElasticsearchBulkProcessorBackfills)
+	return p.add(p.backfillBulkProcessor, request, visibilityTaskKey)
+}
+
+// Flush commits all pending requests of bulk processors and waits for the commit to complete.
+func (p *processorImpl) Flush() error {
+	if atomic.LoadInt32(&p.status) != common.DaemonStatusStarted {
+		return errProcessorNotStarted
+	}
+
+	if p.backfillBulkProcessor != p.bulkProcessor {
+		if err := p.backfillBulkProcessor.Flush(); err != nil {
+			return err
+		}
+	}
+	return p.bulkProcessor.Flush()
+}
+
+// Stats returns a snapshot of processor state.
+func (p *processorImpl) Stats() ProcessorStats {
+	stats := ProcessorStats{
+		InFlightBulks: atomic.LoadInt64(&p.inFlightBulks),
+	}
+	if atomic.LoadInt32(&p.status) == common.DaemonStatusStarted {
+		stats.PendingAcks = p.mapToAckChan.Len()
+		stats.BulkProcessors = append(stats.BulkProcessors, BulkProcessorStats{
+			Name:               p.bulkProcessorParameters.Name,
+			BulkProcessorStats: p.bulkProcessor.Stats(),
+		})
+		if p.backfillBulkProcessor != p.bulkProcessor {
+			stats.BulkProcessors = append(stats.BulkProcessors, BulkProcessorStats{
+				Name:               p.backfillBulkProcessorParameters.Name,
+				BulkProcessorStats: p.backfillBulkProcessor.Stats(),
+			})
+		}
+	}
+
+	p.lastCommitErrorLock.Lock()
+	defer p.lastCommitErrorLock.Unlock()
+	if p.lastCommitError != nil {
+		stats.LastCommitError = p.lastCommitError.Error()
+		stats.LastCommitErrorTime = p.lastCommitErrorTime
+	}
+	return stats
+}
+
+func (p *processorImpl) add(
+	bulkProcessor esclient.BulkProcessor,
+	request *esclient.BulkableRequest,
+	visibilityTaskKey string,
+) <-chan bool {
 	ackCh := newAckChan()
 	retCh := ackCh.ackChInternal
 	_, isDup, _ := p.mapToAckChan.PutOrDo(visibilityTaskKey, ackCh, func(key interface{}, value interface{}) error {
@@ -186,13 +355,14 @@ func (p *processorImpl) Add(request *esclient.BulkableRequest, visibilityTaskKey
 		return nil
 	})
 	if !isDup {
-		p.bulkProcessor.Add(request)
+		bulkProcessor.Add(request)
 	}
 	return retCh
 }
 
 // bulkBeforeAction is triggered before bulk processor commit
 func (p *processorImpl) bulkBeforeAction(_ int64, requests []elastic.BulkableRequest) {
+	atomic.AddInt64(&p.inFlightBulks, 1)
 	p.metricsClient.AddCounter(metrics.ElasticsearchBulkProcessor, metrics.ElasticsearchBulkProcessorRequests, int64(len(requests)))
 	p.metricsClient.RecordDistribution(metrics.ElasticsearchBulkProcessor, metrics.ElasticsearchBulkProcessorBulkSize, len(requests))
 
@@ -214,7 +384,13 @@ func (p *processorImpl) bulkBeforeAction(_ int64, requests []elastic.BulkableReq
 
 // bulkAfterAction is triggered after bulk processor commit
 func (p *processorImpl) bulkAfterAction(_ int64, requests []elastic.BulkableRequest, response *elastic.BulkResponse, err error) {
+	atomic.AddInt64(&p.inFlightBulks, -1)
 	if err != nil {
+		p.lastCommitErrorLock.Lock()
+		p.lastCommitError = err
+		p.lastCommitErrorTime = time.Now().UTC()
+		p.lastCommitErrorLock.Unlock()
+
 		// This happens after configured retry, which means something bad happens on cluster or index
 		// When cluster back to live, processor will re-commit those failure requests
 
@@ -295,9 +471,10 @@ func (p *processorImpl) buildResponseIndex(response *elastic.BulkResponse) map[s
 	return result
 }
 
-func (p *processorImpl) sendToAckChan(visibilityTaskKey string, ack bool) {
+// sendToAckChan sends ack signal to request ack channel and returns false if request is already acked.
+func (p *processorImpl) sendToAckChan(visibilityTaskKey string, ack bool) bool {
 	// Use RemoveIf here to prevent race condition with de-dup logic in Add method.
-	_ = p.mapToAckChan.RemoveIf(visibilityTaskKey, func(key interface{}, value interface{}) bool {
+	return p.mapToAckChan.RemoveIf(visibilityTaskKey, func(key interface{}, value interface{}) bool {
 		ackCh, ok := value.(*ackChan)
 		if !ok {
 			p.logger.Fatal(fmt.Sprintf("mapToAckChan has item of a wrong type %T (%T expected).", value, &ackChan{}), tag.ESKey(visibilityTaskKey))
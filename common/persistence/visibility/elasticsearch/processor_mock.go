@@ -72,6 +72,34 @@ func (mr *MockProcessorMockRecorder) Add(request, visibilityTaskKey interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockProcessor)(nil).Add), request, visibilityTaskKey)
 }
 
+// AddBackfill mocks base method.
+func (m *MockProcessor) AddBackfill(request *client.BulkableRequest, visibilityTaskKey string) <-chan bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBackfill", request, visibilityTaskKey)
+	ret0, _ := ret[0].(<-chan bool)
+	return ret0
+}
+
+// AddBackfill indicates an expected call of AddBackfill.
+func (mr *MockProcessorMockRecorder) AddBackfill(request, visibilityTaskKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBackfill", reflect.TypeOf((*MockProcessor)(nil).AddBackfill), request, visibilityTaskKey)
+}
+
+// Flush mocks base method.
+func (m *MockProcessor) Flush() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Flush")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Flush indicates an expected call of Flush.
+func (mr *MockProcessorMockRecorder) Flush() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Flush", reflect.TypeOf((*MockProcessor)(nil).Flush))
+}
+
 // Start mocks base method.
 func (m *MockProcessor) Start() {
 	m.ctrl.T.Helper()
@@ -84,6 +112,20 @@ func (mr *MockProcessorMockRecorder) Start() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockProcessor)(nil).Start))
 }
 
+// Stats mocks base method.
+func (m *MockProcessor) Stats() ProcessorStats {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stats")
+	ret0, _ := ret[0].(ProcessorStats)
+	return ret0
+}
+
+// Stats indicates an expected call of Stats.
+func (mr *MockProcessorMockRecorder) Stats() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stats", reflect.TypeOf((*MockProcessor)(nil).Stats))
+}
+
 // Stop mocks base method.
 func (m *MockProcessor) Stop() {
 	m.ctrl.T.Helper()
@@ -38,6 +38,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	esclient "go.temporal.io/server/common/persistence/visibility/elasticsearch/client"
 
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/collection"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
@@ -124,11 +125,151 @@ func (s *processorSuite) TestNewESProcessorAndStartStop() {
 	s.NotNil(p.mapToAckChan)
 	s.NotNil(p.bulkProcessor)
 
+	s.mockMetricClient.EXPECT().RecordTimer(metrics.ElasticsearchBulkProcessor, metrics.ElasticsearchBulkProcessorDrainLatency, gomock.Any())
 	p.Stop()
 	s.Nil(p.mapToAckChan)
 	s.Nil(p.bulkProcessor)
 }
 
+func (s *processorSuite) TestNewESProcessorAndStartStop_Backfill() {
+	config := &ProcessorConfig{
+		IndexerConcurrency:         dynamicconfig.GetIntPropertyFn(32),
+		ESProcessorNumOfWorkers:    dynamicconfig.GetIntPropertyFn(4),
+		ESProcessorBulkActions:     dynamicconfig.GetIntPropertyFn(10),
+		ESProcessorBulkSize:        dynamicconfig.GetIntPropertyFn(2 << 20),
+		ESProcessorFlushInterval:   dynamicconfig.GetDurationPropertyFn(1 * time.Minute),
+		ESProcessorBackfillWorkers: dynamicconfig.GetIntPropertyFn(1),
+	}
+
+	p := NewProcessor(config, s.mockESClient, s.esProcessor.logger, s.mockMetricClient)
+
+	var names []string
+	s.mockESClient.EXPECT().RunBulkProcessor(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, input *esclient.BulkProcessorParameters) (esclient.BulkProcessor, error) {
+			names = append(names, input.Name)
+			if input.Name == backfillProcessorName {
+				s.Equal(config.ESProcessorBackfillWorkers(), input.NumOfWorkers)
+			} else {
+				s.Equal(config.ESProcessorNumOfWorkers(), input.NumOfWorkers)
+			}
+			s.Equal(config.ESProcessorBulkActions(), input.BulkActions)
+			s.NotNil(input.AfterFunc)
+			s.NotNil(input.BeforeFunc)
+
+			bulkProcessor := esclient.NewMockBulkProcessor(s.controller)
+			bulkProcessor.EXPECT().Stop()
+			return bulkProcessor, nil
+		}).
+		Times(2)
+
+	p.Start()
+	s.Equal([]string{visibilityProcessorName, backfillProcessorName}, names)
+	s.NotNil(p.bulkProcessor)
+	s.NotNil(p.backfillBulkProcessor)
+	s.NotSame(p.bulkProcessor, p.backfillBulkProcessor)
+
+	s.mockMetricClient.EXPECT().RecordTimer(metrics.ElasticsearchBulkProcessor, metrics.ElasticsearchBulkProcessorDrainLatency, gomock.Any())
+	p.Stop()
+	s.Nil(p.bulkProcessor)
+	s.Nil(p.backfillBulkProcessor)
+}
+
+func (s *processorSuite) TestStop_NackPendingRequests() {
+	request := &esclient.BulkableRequest{}
+	s.esProcessor.status = common.DaemonStatusStarted
+	s.esProcessor.backfillBulkProcessor = s.mockBulkProcessor
+
+	s.mockBulkProcessor.EXPECT().Add(request).Times(2)
+	ackedCh := s.esProcessor.Add(request, "acked-key")
+	pendingCh := s.esProcessor.Add(request, "pending-key")
+
+	s.mockBulkProcessor.EXPECT().Stop().DoAndReturn(func() error {
+		// Only one request is committed while bulk processor is flushed.
+		s.esProcessor.sendToAckChan("acked-key", true)
+		return nil
+	})
+	s.mockMetricClient.EXPECT().RecordTimer(metrics.ElasticsearchBulkProcessor, metrics.ElasticsearchBulkProcessorRequestLatency, gomock.Any()).Times(2)
+	s.mockMetricClient.EXPECT().AddCounter(metrics.ElasticsearchBulkProcessor, metrics.ElasticsearchBulkProcessorDrainNacks, int64(1))
+	s.mockMetricClient.EXPECT().RecordTimer(metrics.ElasticsearchBulkProcessor, metrics.ElasticsearchBulkProcessorDrainLatency, gomock.Any())
+
+	s.esProcessor.Stop()
+	s.True(<-ackedCh)
+	s.False(<-pendingCh)
+	s.Nil(s.esProcessor.mapToAckChan)
+	s.Nil(s.esProcessor.bulkProcessor)
+}
+
+func (s *processorSuite) TestStop_DrainTimeout() {
+	request := &esclient.BulkableRequest{}
+	s.esProcessor.status = common.DaemonStatusStarted
+	s.esProcessor.backfillBulkProcessor = s.mockBulkProcessor
+	s.esProcessor.shutdownDrainTimeout = dynamicconfig.GetDurationPropertyFn(10 * time.Millisecond)
+
+	s.mockBulkProcessor.EXPECT().Add(request)
+	ackCh := s.esProcessor.Add(request, "test-key")
+
+	unblockStop := make(chan struct{})
+	stopped := make(chan struct{})
+	s.mockBulkProcessor.EXPECT().Stop().DoAndReturn(func() error {
+		defer close(stopped)
+		<-unblockStop
+		// Request is committed after it was nacked by drain.
+		s.esProcessor.sendToAckChan("test-key", true)
+		return nil
+	})
+	s.mockMetricClient.EXPECT().IncCounter(metrics.ElasticsearchBulkProcessor, metrics.ElasticsearchBulkProcessorDrainTimeouts)
+	s.mockMetricClient.EXPECT().RecordTimer(metrics.ElasticsearchBulkProcessor, metrics.ElasticsearchBulkProcessorRequestLatency, gomock.Any())
+	s.mockMetricClient.EXPECT().AddCounter(metrics.ElasticsearchBulkProcessor, metrics.ElasticsearchBulkProcessorDrainNacks, int64(1))
+	s.mockMetricClient.EXPECT().RecordTimer(metrics.ElasticsearchBulkProcessor, metrics.ElasticsearchBulkProcessorDrainLatency, gomock.Any())
+
+	s.esProcessor.Stop()
+	s.False(<-ackCh)
+	s.NotNil(s.esProcessor.mapToAckChan)
+
+	close(unblockStop)
+	<-stopped
+	s.Equal(0, s.esProcessor.mapToAckChan.Len())
+	select {
+	case <-ackCh:
+		s.Fail("request must be acked only once")
+	default:
+	}
+}
+
+func (s *processorSuite) TestFlush() {
+	s.Error(s.esProcessor.Flush())
+
+	s.esProcessor.status = common.DaemonStatusStarted
+	backfillBulkProcessor := esclient.NewMockBulkProcessor(s.controller)
+	s.esProcessor.backfillBulkProcessor = backfillBulkProcessor
+	backfillBulkProcessor.EXPECT().Flush().Return(nil)
+	s.mockBulkProcessor.EXPECT().Flush().Return(nil)
+	s.NoError(s.esProcessor.Flush())
+}
+
+func (s *processorSuite) TestStats() {
+	s.esProcessor.status = common.DaemonStatusStarted
+	s.esProcessor.backfillBulkProcessor = s.mockBulkProcessor
+
+	request := &esclient.BulkableRequest{}
+	s.mockBulkProcessor.EXPECT().Add(request)
+	s.esProcessor.Add(request, "test-key")
+	s.mockMetricClient.EXPECT().AddCounter(metrics.ElasticsearchBulkProcessor, metrics.ElasticsearchBulkProcessorRequests, int64(0))
+	s.mockMetricClient.EXPECT().RecordDistribution(metrics.ElasticsearchBulkProcessor, metrics.ElasticsearchBulkProcessorBulkSize, 0)
+	s.esProcessor.bulkBeforeAction(0, nil)
+
+	s.mockBulkProcessor.EXPECT().Stats().Return(esclient.BulkProcessorStats{Committed: 2, Queued: 3})
+	stats := s.esProcessor.Stats()
+	s.Equal([]BulkProcessorStats{{
+		Name:               visibilityProcessorName,
+		BulkProcessorStats: esclient.BulkProcessorStats{Committed: 2, Queued: 3},
+	}}, stats.BulkProcessors)
+	s.Equal(int64(1), stats.InFlightBulks)
+	s.Equal(1, stats.PendingAcks)
+	s.Empty(stats.LastCommitError)
+	s.True(stats.LastCommitErrorTime.IsZero())
+}
+
 func (s *processorSuite) TestAdd() {
 	request := &esclient.BulkableRequest{}
 	visibilityTaskKey := "test-key"
@@ -162,6 +303,21 @@ func (s *processorSuite) TestAdd() {
 	}
 }
 
+func (s *processorSuite) TestAddBackfill() {
+	request := &esclient.BulkableRequest{}
+	backfillRequest := &esclient.BulkableRequest{}
+	backfillBulkProcessor := esclient.NewMockBulkProcessor(s.controller)
+	s.esProcessor.backfillBulkProcessor = backfillBulkProcessor
+
+	s.mockMetricClient.EXPECT().IncCounter(metrics.ElasticsearchBulkProcessor, metrics.ElasticsearchBulkProcessorBackfills)
+	backfillBulkProcessor.EXPECT().Add(backfillRequest)
+	s.mockBulkProcessor.EXPECT().Add(request)
+
+	s.esProcessor.AddBackfill(backfillRequest, "backfill-key")
+	s.esProcessor.Add(request, "live-key")
+	s.Equal(2, s.esProcessor.mapToAckChan.Len())
+}
+
 func (s *processorSuite) TestAdd_ConcurrentAdd() {
 	request := &esclient.BulkableRequest{}
 	docsCount := 1000
@@ -334,6 +490,10 @@ func (s *processorSuite) TestBulkAfterAction_Error() {
 
 	s.mockMetricClient.EXPECT().IncCounter(metrics.ElasticsearchBulkProcessor, metrics.ElasticsearchBulkProcessorFailures)
 	s.esProcessor.bulkAfterAction(0, requests, response, errors.New("some error"))
+
+	stats := s.esProcessor.Stats()
+	s.Equal("some error", stats.LastCommitError)
+	s.False(stats.LastCommitErrorTime.IsZero())
 }
 
 func (s *processorSuite) TestBulkBeforeAction() {
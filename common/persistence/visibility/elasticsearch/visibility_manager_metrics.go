@@ -194,6 +194,34 @@ func (m *visibilityManagerMetrics) ListClosedWorkflowExecutionsByStatus(request
 	return response, err
 }
 
+func (m *visibilityManagerMetrics) ListClosedWorkflowExecutionsByTypeAndStatus(request *visibility.ListClosedWorkflowExecutionsByTypeAndStatusRequest) (*visibility.ListWorkflowExecutionsResponse, error) {
+	m.metricClient.IncCounter(metrics.ElasticsearchListClosedWorkflowExecutionsByTypeAndStatusScope, metrics.ElasticsearchRequests)
+
+	sw := m.metricClient.StartTimer(metrics.ElasticsearchListClosedWorkflowExecutionsByTypeAndStatusScope, metrics.ElasticsearchLatency)
+	response, err := m.persistence.ListClosedWorkflowExecutionsByTypeAndStatus(request)
+	sw.Stop()
+
+	if err != nil {
+		m.updateErrorMetric(metrics.ElasticsearchListClosedWorkflowExecutionsByTypeAndStatusScope, err)
+	}
+
+	return response, err
+}
+
+func (m *visibilityManagerMetrics) CountClosedWorkflowExecutionsByTypeAndStatus(request *visibility.CountClosedWorkflowExecutionsByTypeAndStatusRequest) (*visibility.CountWorkflowExecutionsResponse, error) {
+	m.metricClient.IncCounter(metrics.ElasticsearchCountClosedWorkflowExecutionsByTypeAndStatusScope, metrics.ElasticsearchRequests)
+
+	sw := m.metricClient.StartTimer(metrics.ElasticsearchCountClosedWorkflowExecutionsByTypeAndStatusScope, metrics.ElasticsearchLatency)
+	response, err := m.persistence.CountClosedWorkflowExecutionsByTypeAndStatus(request)
+	sw.Stop()
+
+	if err != nil {
+		m.updateErrorMetric(metrics.ElasticsearchCountClosedWorkflowExecutionsByTypeAndStatusScope, err)
+	}
+
+	return response, err
+}
+
 func (m *visibilityManagerMetrics) ListWorkflowExecutions(request *visibility.ListWorkflowExecutionsRequestV2) (*visibility.ListWorkflowExecutionsResponse, error) {
 	m.metricClient.IncCounter(metrics.ElasticsearchListWorkflowExecutionsScope, metrics.ElasticsearchRequests)
 
@@ -48,6 +48,7 @@ import (
 	esclient "go.temporal.io/server/common/persistence/visibility/elasticsearch/client"
 
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -59,8 +60,7 @@ import (
 const (
 	persistenceName = "elasticsearch"
 
-	delimiter                    = "~"
-	pointInTimeKeepAliveInterval = "1m"
+	delimiter = "~"
 )
 
 type (
@@ -72,6 +72,8 @@ type (
 		config                   *config.VisibilityConfig
 		metricsClient            metrics.Client
 		processor                Processor
+		// pointInTimeReaper is nil if Elasticsearch doesn't support point in time.
+		pointInTimeReaper *pointInTimeReaper
 	}
 
 	visibilityPageToken struct {
@@ -90,6 +92,9 @@ var _ visibility.VisibilityStore = (*visibilityStore)(nil)
 var (
 	ErrInvalidDuration         = errors.New("invalid duration format")
 	errUnexpectedJSONFieldType = errors.New("unexpected JSON field type")
+	errUnknownField            = errors.New("unknown field")
+	errMissingField            = errors.New("field is missing")
+	errPointInTimeExpired      = serviceerror.NewInvalidArgument("Point in time of the page token expired, restart the scan without page token.")
 )
 
 // NewVisibilityStore create a visibility store connecting to ElasticSearch
@@ -103,18 +108,29 @@ func NewVisibilityStore(
 	metricsClient metrics.Client,
 ) *visibilityStore {
 
+	logger = log.With(logger, tag.ComponentESVisibilityManager)
+	var reaper *pointInTimeReaper
+	if esClientV7, ok := esClient.(client.ClientV7); ok {
+		reaper = newPointInTimeReaper(esClientV7, cfg.ESPointInTimeKeepAlive, clock.NewRealTimeSource(), metricsClient, logger)
+		reaper.Start()
+	}
+
 	return &visibilityStore{
 		esClient:                 esClient,
 		index:                    index,
 		searchAttributesProvider: searchAttributesProvider,
 		processor:                processor,
-		logger:                   log.With(logger, tag.ComponentESVisibilityManager),
+		logger:                   logger,
 		config:                   cfg,
 		metricsClient:            metricsClient,
+		pointInTimeReaper:        reaper,
 	}
 }
 
 func (s *visibilityStore) Close() {
+	if s.pointInTimeReaper != nil {
+		s.pointInTimeReaper.Stop()
+	}
 	// TODO (alex): visibilityStore shouldn't Stop processor. Processor should be stopped where it is created.
 	if s.processor != nil {
 		s.processor.Stop()
@@ -137,7 +153,7 @@ func (s *visibilityStore) RecordWorkflowExecutionClosed(request *visibility.Inte
 	doc := s.generateESDoc(request.InternalVisibilityRequestBase, visibilityTaskKey)
 
 	doc[searchattribute.CloseTime] = request.CloseTime
-	doc[searchattribute.ExecutionDuration] = request.CloseTime.Sub(request.ExecutionTime).Nanoseconds()
+	doc[searchattribute.ExecutionDuration] = executionDuration(request.StartTime, request.CloseTime).Nanoseconds()
 	doc[searchattribute.HistoryLength] = request.HistoryLength
 	doc[searchattribute.StateTransitionCount] = request.StateTransitionCount
 
@@ -161,7 +177,7 @@ func (s *visibilityStore) DeleteWorkflowExecution(request *visibility.Visibility
 		RequestType: esclient.BulkableRequestTypeDelete,
 	}
 
-	return s.addBulkRequestAndWait(bulkDeleteRequest, docID)
+	return s.addBulkRequestAndWait(bulkDeleteRequest, docID, request.Backfill)
 }
 
 func getDocID(workflowID string, runID string) string {
@@ -185,13 +201,18 @@ func (s *visibilityStore) addBulkIndexRequestAndWait(
 		Doc:         esDoc,
 	}
 
-	return s.addBulkRequestAndWait(bulkIndexRequest, visibilityTaskKey)
+	return s.addBulkRequestAndWait(bulkIndexRequest, visibilityTaskKey, request.Backfill)
 }
 
-func (s *visibilityStore) addBulkRequestAndWait(bulkRequest *esclient.BulkableRequest, visibilityTaskKey string) error {
+func (s *visibilityStore) addBulkRequestAndWait(bulkRequest *esclient.BulkableRequest, visibilityTaskKey string, backfill bool) error {
 	s.checkProcessor()
 
-	ackCh := s.processor.Add(bulkRequest, visibilityTaskKey)
+	var ackCh <-chan bool
+	if backfill {
+		ackCh = s.processor.AddBackfill(bulkRequest, visibilityTaskKey)
+	} else {
+		ackCh = s.processor.Add(bulkRequest, visibilityTaskKey)
+	}
 	ackTimeoutTimer := time.NewTimer(s.config.ESProcessorAckTimeout())
 	defer ackTimeoutTimer.Stop()
 
@@ -365,6 +386,55 @@ func (s *visibilityStore) ListClosedWorkflowExecutionsByStatus(
 	return s.getListWorkflowExecutionsResponse(searchResult, request.PageSize, isRecordValid)
 }
 
+func (s *visibilityStore) ListClosedWorkflowExecutionsByTypeAndStatus(
+	request *visibility.ListClosedWorkflowExecutionsByTypeAndStatusRequest) (*visibility.InternalListWorkflowExecutionsResponse, error) {
+
+	token, err := s.getNextPageToken(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	query := elastic.NewBoolQuery().
+		Filter(elastic.NewTermQuery(searchattribute.WorkflowType, request.WorkflowTypeName)).
+		Filter(elastic.NewTermQuery(searchattribute.ExecutionStatus, request.Status.String()))
+	searchResult, err := s.getSearchResult(&request.ListWorkflowExecutionsRequest, token, query, false)
+	if err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("ListClosedWorkflowExecutionsByTypeAndStatus failed. Error: %s", detailedErrorMessage(err)))
+	}
+
+	isRecordValid := func(rec *visibility.VisibilityWorkflowExecutionInfo) bool {
+		return !rec.CloseTime.Before(request.EarliestStartTime) && !rec.CloseTime.After(request.LatestStartTime)
+	}
+
+	return s.getListWorkflowExecutionsResponse(searchResult, request.PageSize, isRecordValid)
+}
+
+func (s *visibilityStore) CountClosedWorkflowExecutionsByTypeAndStatus(
+	request *visibility.CountClosedWorkflowExecutionsByTypeAndStatusRequest) (*visibility.CountWorkflowExecutionsResponse, error) {
+
+	query := elastic.NewBoolQuery().
+		Filter(elastic.NewTermQuery(searchattribute.NamespaceID, request.NamespaceID)).
+		Filter(elastic.NewTermQuery(searchattribute.WorkflowType, request.WorkflowTypeName)).
+		Filter(elastic.NewTermQuery(searchattribute.ExecutionStatus, request.Status.String())).
+		Filter(elastic.NewRangeQuery(searchattribute.CloseTime).Gte(request.EarliestCloseTime).Lte(request.LatestCloseTime))
+	querySource, err := query.Source()
+	if err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("CountClosedWorkflowExecutionsByTypeAndStatus failed. Error: %v", err))
+	}
+	queryDSL, err := json.Marshal(map[string]interface{}{"query": querySource})
+	if err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("CountClosedWorkflowExecutionsByTypeAndStatus failed. Error: %v", err))
+	}
+
+	ctx := context.Background()
+	count, err := s.esClient.Count(ctx, s.index, string(queryDSL))
+	if err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("CountClosedWorkflowExecutionsByTypeAndStatus failed. Error: %s", detailedErrorMessage(err)))
+	}
+
+	return &visibility.CountWorkflowExecutionsResponse{Count: count}, nil
+}
+
 func (s *visibilityStore) ListWorkflowExecutions(
 	request *visibility.ListWorkflowExecutionsRequestV2) (*visibility.InternalListWorkflowExecutionsResponse, error) {
 
@@ -407,10 +477,12 @@ func (s *visibilityStore) ScanWorkflowExecutions(
 
 		// First call doesn't have PointInTimeID.
 		if token.PointInTimeID == "" {
-			token.PointInTimeID, err = esClient.OpenPointInTime(ctx, s.index, pointInTimeKeepAliveInterval)
+			token.PointInTimeID, err = esClient.OpenPointInTime(ctx, s.index, s.pointInTimeReaper.keepAliveInterval())
 			if err != nil {
 				return nil, serviceerror.NewInternal(fmt.Sprintf("Unable to create point in time: %s", detailedErrorMessage(err)))
 			}
+			// Closed by the reaper if the scan fails or is abandoned before the last page.
+			s.pointInTimeReaper.used(token.PointInTimeID, "")
 		}
 
 		var queryDSL string
@@ -421,15 +493,23 @@ func (s *visibilityStore) ScanWorkflowExecutions(
 
 		searchResult, err := esClient.SearchWithDSLWithPIT(ctx, queryDSL)
 		if err != nil {
+			if elastic.IsNotFound(err) {
+				s.pointInTimeReaper.closed(token.PointInTimeID)
+				return nil, errPointInTimeExpired
+			}
 			return nil, serviceerror.NewInternal(fmt.Sprintf("ScanWorkflowExecutions failed. Error: %s", detailedErrorMessage(err)))
 		}
 
 		if len(searchResult.Hits.Hits) < request.PageSize {
 			// It is the last page, close PIT.
+			s.pointInTimeReaper.closed(token.PointInTimeID)
 			_, err = esClient.ClosePointInTime(ctx, token.PointInTimeID)
 			if err != nil {
 				return nil, serviceerror.NewInternal(fmt.Sprintf("Unable to close point in time: %s", detailedErrorMessage(err)))
 			}
+		} else if searchResult.PitId != "" {
+			// Elasticsearch might return a new ID of the point in time for the next page.
+			s.pointInTimeReaper.used(searchResult.PitId, token.PointInTimeID)
 		}
 		return s.getListWorkflowExecutionsResponse(searchResult, request.PageSize, nil)
 	case client.ClientV6:
@@ -556,8 +636,8 @@ func (s *visibilityStore) getESQueryDSL(request *visibility.ListWorkflowExecutio
 		dsl.Set(dslFieldSearchAfter, fastjson.MustParse(valueOfSearchAfter))
 	}
 
-	if token.PointInTimeID != "" {
-		dsl.Set("pit", fastjson.MustParse(fmt.Sprintf(`{"id":"%s", "keep_alive":"%s"}`, token.PointInTimeID, pointInTimeKeepAliveInterval)))
+	if token.PointInTimeID != "" && s.pointInTimeReaper != nil {
+		dsl.Set("pit", fastjson.MustParse(fmt.Sprintf(`{"id":"%s", "keep_alive":"%s"}`, token.PointInTimeID, s.pointInTimeReaper.keepAliveInterval())))
 	}
 
 	return dsl.String(), nil
@@ -807,7 +887,10 @@ func (s *visibilityStore) getScanWorkflowExecutionsResponse(searchHits *elastic.
 	response := &visibility.InternalListWorkflowExecutionsResponse{}
 	response.Executions = make([]*visibility.VisibilityWorkflowExecutionInfo, len(searchHits.Hits))
 	for i := 0; i < len(searchHits.Hits); i++ {
-		response.Executions[i] = s.parseESDoc(searchHits.Hits[i], typeMap)
+		response.Executions[i], err = s.parseESDoc(searchHits.Hits[i], typeMap)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if len(searchHits.Hits) == pageSize && !isLastPage {
@@ -839,7 +922,10 @@ func (s *visibilityStore) getListWorkflowExecutionsResponse(
 	}
 	var lastHitSort []interface{}
 	for _, hit := range searchResult.Hits.Hits {
-		workflowExecutionInfo := s.parseESDoc(hit, typeMap)
+		workflowExecutionInfo, err := s.parseESDoc(hit, typeMap)
+		if err != nil {
+			return nil, err
+		}
 		// ES6 uses "date" data type not "date_nanos". It truncates dates using milliseconds and might return extra rows.
 		// For example: 2021-06-12T00:21:43.159739259Z fits 2021-06-12T00:21:43.158Z...2021-06-12T00:21:43.159Z range lte/gte query.
 		// Therefore these records needs to be filtered out on the client side to support nanos precision.
@@ -917,10 +1003,33 @@ func (s *visibilityStore) generateESDoc(request *visibility.InternalVisibilityRe
 	return doc
 }
 
-func (s *visibilityStore) parseESDoc(hit *elastic.SearchHit, saTypeMap searchattribute.NameTypeMap) *visibility.VisibilityWorkflowExecutionInfo {
+// executionDuration returns time between workflow start and close.
+// CloseTime can be slightly before StartTime when mutable state is replicated across clusters
+// (see Cassandra visibility store), such durations are indexed as 0.
+func executionDuration(startTime time.Time, closeTime time.Time) time.Duration {
+	duration := closeTime.Sub(startTime)
+	if duration < 0 {
+		return 0
+	}
+	return duration
+}
+
+// parseESDoc converts Elasticsearch document to visibility record. In strict mode, document with any field
+// which can't be parsed or is unknown is rejected. Otherwise, such fields are skipped and reported in record annotations.
+func (s *visibilityStore) parseESDoc(hit *elastic.SearchHit, saTypeMap searchattribute.NameTypeMap) (*visibility.VisibilityWorkflowExecutionInfo, error) {
+	strictMode := s.config.ESParseDocStrictMode != nil && s.config.ESParseDocStrictMode()
+	record := &visibility.VisibilityWorkflowExecutionInfo{}
+	var firstParseErr error
+	reportParseError := func(fieldName string, err error, metric int) {
+		s.metricsClient.Scope(metrics.ElasticsearchVisibility, metrics.SearchAttributeTag(fieldName)).IncCounter(metric)
+		record.Annotations = append(record.Annotations, fmt.Sprintf("%s: %v", fieldName, err))
+		if firstParseErr == nil {
+			firstParseErr = fmt.Errorf("field %s: %w", fieldName, err)
+		}
+	}
 	logParseError := func(fieldName string, fieldValue interface{}, err error, docID string) {
 		s.logger.Error("Unable to parse Elasticsearch document field.", tag.Name(fieldName), tag.Value(fieldValue), tag.Error(err), tag.ESDocID(docID))
-		s.metricsClient.IncCounter(metrics.ElasticsearchVisibility, metrics.ElasticsearchInvalidSearchAttributeCount)
+		reportParseError(fieldName, err, metrics.ElasticsearchInvalidSearchAttributeCount)
 	}
 
 	var sourceMap map[string]interface{}
@@ -929,13 +1038,22 @@ func (s *visibilityStore) parseESDoc(hit *elastic.SearchHit, saTypeMap searchatt
 	d.UseNumber()
 	if err := d.Decode(&sourceMap); err != nil {
 		s.logger.Error("Unable to JSON unmarshal Elasticsearch SearchHit.Source.", tag.Error(err), tag.ESDocID(hit.Id))
-		return nil
+		s.metricsClient.IncCounter(metrics.ElasticsearchVisibility, metrics.ElasticsearchInvalidDocumentCount)
+		if strictMode {
+			return nil, serviceerror.NewInternal(fmt.Sprintf("Unable to parse Elasticsearch document %s: %v", hit.Id, err))
+		}
+		// Document ID is the only source of workflow execution identity left.
+		if delimiterIndex := strings.LastIndex(hit.Id, delimiter); delimiterIndex >= 0 {
+			record.WorkflowID = hit.Id[:delimiterIndex]
+			record.RunID = hit.Id[delimiterIndex+len(delimiter):]
+		}
+		record.Annotations = append(record.Annotations, fmt.Sprintf("document: %v", err))
+		return record, nil
 	}
 
 	var isValidType bool
 	var memo []byte
 	var memoEncoding string
-	record := &visibility.VisibilityWorkflowExecutionInfo{}
 	for fieldName, fieldValue := range sourceMap {
 		switch fieldName {
 		case searchattribute.NamespaceID,
@@ -950,7 +1068,7 @@ func (s *visibilityStore) parseESDoc(hit *elastic.SearchHit, saTypeMap searchatt
 			}
 			var err error
 			if memo, err = base64.StdEncoding.DecodeString(memoStr); err != nil {
-				logParseError(fieldName, memoStr[:10], err, hit.Id)
+				logParseError(fieldName, nil, err, hit.Id)
 			}
 			continue
 		case searchattribute.MemoEncoding:
@@ -962,9 +1080,12 @@ func (s *visibilityStore) parseESDoc(hit *elastic.SearchHit, saTypeMap searchatt
 
 		fieldType, err := saTypeMap.GetType(fieldName)
 		if err != nil {
-			// Silently ignore ErrInvalidName because it indicates unknown field in Elasticsearch document.
-			if !errors.Is(err, searchattribute.ErrInvalidName) {
+			// ErrInvalidName indicates unknown field in Elasticsearch document (i.e. mapping drift) and is not logged.
+			if errors.Is(err, searchattribute.ErrInvalidName) {
+				reportParseError(fieldName, errUnknownField, metrics.ElasticsearchUnknownFieldCount)
+			} else {
 				s.logger.Error("Unable to get type for Elasticsearch document field.", tag.Name(fieldName), tag.Error(err), tag.ESDocID(hit.Id))
+				reportParseError(fieldName, err, metrics.ElasticsearchInvalidSearchAttributeCount)
 			}
 			continue
 		}
@@ -1009,10 +1130,13 @@ func (s *visibilityStore) parseESDoc(hit *elastic.SearchHit, saTypeMap searchatt
 		record.Memo = persistence.NewDataBlob(memo, memoEncoding)
 	} else if memo != nil {
 		s.logger.Error("Field is missing in Elasticsearch document.", tag.Name(searchattribute.MemoEncoding), tag.ESDocID(hit.Id))
-		s.metricsClient.IncCounter(metrics.ElasticsearchVisibility, metrics.ElasticsearchInvalidSearchAttributeCount)
+		reportParseError(searchattribute.MemoEncoding, errMissingField, metrics.ElasticsearchInvalidSearchAttributeCount)
 	}
 
-	return record
+	if strictMode && firstParseErr != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("Unable to parse Elasticsearch document %s: %v", hit.Id, firstParseErr))
+	}
+	return record, nil
 }
 
 // finishParseJSONValue finishes JSON parsing after json.Decode.
@@ -1024,6 +1148,10 @@ func (s *visibilityStore) parseESDoc(hit *elastic.SearchHit, saTypeMap searchatt
 //     map[string]interface{}, for JSON objects (should never be a case)
 //     nil for JSON null
 func finishParseJSONValue(val interface{}, t enumspb.IndexedValueType) (interface{}, error) {
+	if t == searchattribute.IndexedValueTypeKeywordList {
+		return finishParseJSONKeywordList(val)
+	}
+
 	// Custom search attributes support array of particular type.
 	if arrayValue, isArray := val.([]interface{}); isArray {
 		retArray := make([]interface{}, len(arrayValue))
@@ -1064,6 +1192,27 @@ func finishParseJSONValue(val interface{}, t enumspb.IndexedValueType) (interfac
 	panic(fmt.Sprintf("Unknown field type: %v", t))
 }
 
+// finishParseJSONKeywordList always returns []string because Elasticsearch returns single element array
+// as it was indexed, which might be just a string.
+func finishParseJSONKeywordList(val interface{}) ([]string, error) {
+	switch typedVal := val.(type) {
+	case string:
+		return []string{typedVal}, nil
+	case []interface{}:
+		result := make([]string, len(typedVal))
+		for i, item := range typedVal {
+			stringVal, isString := item.(string)
+			if !isString {
+				return nil, fmt.Errorf("%w: expected string got %T", errUnexpectedJSONFieldType, item)
+			}
+			result[i] = stringVal
+		}
+		return result, nil
+	default:
+		return nil, fmt.Errorf("%w: expected array of strings got %T", errUnexpectedJSONFieldType, val)
+	}
+}
+
 func checkPageSize(request *visibility.ListWorkflowExecutionsRequestV2) {
 	if request.PageSize == 0 {
 		request.PageSize = 1000
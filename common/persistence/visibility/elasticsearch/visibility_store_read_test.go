@@ -301,6 +301,61 @@ func (s *ESVisibilitySuite) TestListClosedWorkflowExecutionsByStatus() {
 	s.True(strings.Contains(err.Error(), "ListClosedWorkflowExecutionsByStatus failed"))
 }
 
+func (s *ESVisibilitySuite) TestListClosedWorkflowExecutionsByTypeAndStatus() {
+	s.mockESClient.EXPECT().Search(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, input *esclient.SearchParameters) (*elastic.SearchResult, error) {
+			source, _ := input.Query.Source()
+			s.True(strings.Contains(fmt.Sprintf("%v", source), filterByType))
+			s.True(strings.Contains(fmt.Sprintf("%v", source), filterByExecutionStatus))
+			return testSearchResult, nil
+		})
+
+	testRequest := createTestRequest()
+	request := &visibility.ListClosedWorkflowExecutionsByTypeAndStatusRequest{
+		ListWorkflowExecutionsRequest: *testRequest,
+		WorkflowTypeName:              testWorkflowType,
+		Status:                        testStatus,
+	}
+	_, err := s.visibilityStore.ListClosedWorkflowExecutionsByTypeAndStatus(request)
+	s.NoError(err)
+
+	s.mockESClient.EXPECT().Search(gomock.Any(), gomock.Any()).Return(nil, errTestESSearch)
+	_, err = s.visibilityStore.ListClosedWorkflowExecutionsByTypeAndStatus(request)
+	s.Error(err)
+	_, ok := err.(*serviceerror.Internal)
+	s.True(ok)
+	s.True(strings.Contains(err.Error(), "ListClosedWorkflowExecutionsByTypeAndStatus failed"))
+}
+
+func (s *ESVisibilitySuite) TestCountClosedWorkflowExecutionsByTypeAndStatus() {
+	s.mockESClient.EXPECT().Count(gomock.Any(), testIndex, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, index, input string) (int64, error) {
+			s.True(strings.Contains(input, fmt.Sprintf(`{"term":{"WorkflowType":"%s"}}`, testWorkflowType)))
+			s.True(strings.Contains(input, fmt.Sprintf(`{"term":{"ExecutionStatus":"%s"}}`, testStatus.String())))
+			s.True(strings.Contains(input, `"range":{"CloseTime"`))
+			return int64(2), nil
+		})
+
+	request := &visibility.CountClosedWorkflowExecutionsByTypeAndStatusRequest{
+		NamespaceID:       testNamespaceID,
+		Namespace:         testNamespace,
+		EarliestCloseTime: testEarliestTime,
+		LatestCloseTime:   testLatestTime,
+		WorkflowTypeName:  testWorkflowType,
+		Status:            testStatus,
+	}
+	resp, err := s.visibilityStore.CountClosedWorkflowExecutionsByTypeAndStatus(request)
+	s.NoError(err)
+	s.Equal(int64(2), resp.Count)
+
+	s.mockESClient.EXPECT().Count(gomock.Any(), testIndex, gomock.Any()).Return(int64(0), errTestESSearch)
+	_, err = s.visibilityStore.CountClosedWorkflowExecutionsByTypeAndStatus(request)
+	s.Error(err)
+	_, ok := err.(*serviceerror.Internal)
+	s.True(ok)
+	s.True(strings.Contains(err.Error(), "CountClosedWorkflowExecutionsByTypeAndStatus failed"))
+}
+
 func (s *ESVisibilitySuite) TestGetNextPageToken() {
 	token, err := s.visibilityStore.getNextPageToken([]byte{})
 	s.NoError(err)
@@ -507,7 +562,8 @@ func (s *ESVisibilitySuite) TestParseESDoc() {
           "WorkflowType": "TestWorkflowExecute"}`),
 	}
 	// test for open
-	info := s.visibilityStore.parseESDoc(searchHit, searchattribute.TestNameTypeMap)
+	info, err := s.visibilityStore.parseESDoc(searchHit, searchattribute.TestNameTypeMap)
+	s.NoError(err)
 	s.NotNil(info)
 	s.Equal("6bfbc1e5-6ce4-4e22-bbfb-e0faa9a7a604-1-2256", info.WorkflowID)
 	s.Equal("e481009e-14b3-45ae-91af-dce6e2a88365", info.RunID)
@@ -532,7 +588,8 @@ func (s *ESVisibilitySuite) TestParseESDoc() {
           "WorkflowId": "6bfbc1e5-6ce4-4e22-bbfb-e0faa9a7a604-1-2256",
           "WorkflowType": "TestWorkflowExecute"}`),
 	}
-	info = s.visibilityStore.parseESDoc(searchHit, searchattribute.TestNameTypeMap)
+	info, err = s.visibilityStore.parseESDoc(searchHit, searchattribute.TestNameTypeMap)
+	s.NoError(err)
 	s.NotNil(info)
 	s.Equal("6bfbc1e5-6ce4-4e22-bbfb-e0faa9a7a604-1-2256", info.WorkflowID)
 	s.Equal("e481009e-14b3-45ae-91af-dce6e2a88365", info.RunID)
@@ -550,12 +607,58 @@ func (s *ESVisibilitySuite) TestParseESDoc() {
 
 	// test for error case
 	searchHit = &elastic.SearchHit{
+		Id:     "wid~e481009e-14b3-45ae-91af-dce6e2a88365",
 		Source: []byte(`corrupted data`),
 	}
-	info = s.visibilityStore.parseESDoc(searchHit, searchattribute.TestNameTypeMap)
+	s.mockMetricsClient.EXPECT().IncCounter(metrics.ElasticsearchVisibility, metrics.ElasticsearchInvalidDocumentCount)
+	info, err = s.visibilityStore.parseESDoc(searchHit, searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	s.Equal("wid", info.WorkflowID)
+	s.Equal("e481009e-14b3-45ae-91af-dce6e2a88365", info.RunID)
+	s.Len(info.Annotations, 1)
+}
+
+func (s *ESVisibilitySuite) TestParseESDoc_StrictMode() {
+	s.visibilityStore.config.ESParseDocStrictMode = dynamicconfig.GetBoolPropertyFn(true)
+
+	searchHit := &elastic.SearchHit{
+		Id:     "wid~e481009e-14b3-45ae-91af-dce6e2a88365",
+		Source: []byte(`{"WorkflowId": "wid", "CustomIntField": "not int"}`),
+	}
+	s.mockMetricsClient.EXPECT().Scope(metrics.ElasticsearchVisibility, metrics.SearchAttributeTag("CustomIntField")).Return(metrics.NoopScope(metrics.Frontend))
+	info, err := s.visibilityStore.parseESDoc(searchHit, searchattribute.TestNameTypeMap)
+	s.Error(err)
+	s.IsType(&serviceerror.Internal{}, err)
+	s.Nil(info)
+
+	searchHit.Source = []byte(`{"WorkflowId": "wid", "UnknownField": "random"}`)
+	s.mockMetricsClient.EXPECT().Scope(metrics.ElasticsearchVisibility, metrics.SearchAttributeTag("UnknownField")).Return(metrics.NoopScope(metrics.Frontend))
+	info, err = s.visibilityStore.parseESDoc(searchHit, searchattribute.TestNameTypeMap)
+	s.Error(err)
+	s.Nil(info)
+
+	searchHit.Source = []byte(`corrupted data`)
+	s.mockMetricsClient.EXPECT().IncCounter(metrics.ElasticsearchVisibility, metrics.ElasticsearchInvalidDocumentCount)
+	info, err = s.visibilityStore.parseESDoc(searchHit, searchattribute.TestNameTypeMap)
+	s.Error(err)
 	s.Nil(info)
 }
 
+func (s *ESVisibilitySuite) TestParseESDoc_LenientMode_PartialDocument() {
+	searchHit := &elastic.SearchHit{
+		Source: []byte(`{"WorkflowId": "wid", "CustomIntField": "not int", "CustomBoolField": true}`),
+	}
+	s.mockMetricsClient.EXPECT().Scope(metrics.ElasticsearchVisibility, metrics.SearchAttributeTag("CustomIntField")).Return(metrics.NoopScope(metrics.Frontend))
+	info, err := s.visibilityStore.parseESDoc(searchHit, searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	s.Equal("wid", info.WorkflowID)
+	s.Equal(true, info.SearchAttributes["CustomBoolField"])
+	_, ok := info.SearchAttributes["CustomIntField"]
+	s.False(ok)
+	s.Len(info.Annotations, 1)
+	s.Contains(info.Annotations[0], "CustomIntField")
+}
+
 func (s *ESVisibilitySuite) TestParseESDoc_SearchAttributes() {
 	searchHit := &elastic.SearchHit{
 		Source: []byte(`{"TemporalChangeVersion": ["ver1", "ver2"],
@@ -565,10 +668,13 @@ func (s *ESVisibilitySuite) TestParseESDoc_SearchAttributes() {
           "CustomDoubleField": [1234.1234,5678.5678],
           "CustomIntField": [111,222],
           "CustomBoolField": true,
+          "CustomKeywordListField": "single",
           "UnknownField": "random"}`),
 	}
 	// test for open
-	info := s.visibilityStore.parseESDoc(searchHit, searchattribute.TestNameTypeMap)
+	s.mockMetricsClient.EXPECT().Scope(metrics.ElasticsearchVisibility, metrics.SearchAttributeTag("UnknownField")).Return(metrics.NoopScope(metrics.Frontend))
+	info, err := s.visibilityStore.parseESDoc(searchHit, searchattribute.TestNameTypeMap)
+	s.NoError(err)
 	s.NotNil(info)
 	s.Equal([]interface{}{"ver1", "ver2"}, info.SearchAttributes["TemporalChangeVersion"])
 
@@ -588,8 +694,11 @@ func (s *ESVisibilitySuite) TestParseESDoc_SearchAttributes() {
 
 	s.Equal([]interface{}{int64(111), int64(222)}, info.SearchAttributes["CustomIntField"])
 
+	s.Equal([]string{"single"}, info.SearchAttributes["CustomKeywordListField"])
+
 	_, ok := info.SearchAttributes["UnknownField"]
 	s.False(ok)
+	s.Equal([]string{"UnknownField: unknown field"}, info.Annotations)
 }
 
 // nolint
@@ -931,6 +1040,22 @@ func (s *ESVisibilitySuite) TestScanWorkflowExecutionsV7() {
 	s.True(strings.Contains(err.Error(), "ScanWorkflowExecutions failed"))
 }
 
+func (s *ESVisibilitySuite) TestScanWorkflowExecutionsV7_PointInTimeExpired() {
+	token := &visibilityPageToken{PointInTimeID: "pitID"}
+	tokenBytes, err := s.visibilityStore.serializePageToken(token)
+	s.NoError(err)
+	request := &visibility.ListWorkflowExecutionsRequestV2{
+		NamespaceID:   testNamespaceID,
+		Namespace:     testNamespace,
+		PageSize:      1,
+		NextPageToken: tokenBytes,
+	}
+
+	s.mockESClientV7.EXPECT().SearchWithDSLWithPIT(gomock.Any(), gomock.Any()).Return(nil, &elastic.Error{Status: 404})
+	_, err = s.visibilityStore.ScanWorkflowExecutions(request)
+	s.Equal(errPointInTimeExpired, err)
+}
+
 func (s *ESVisibilitySuite) TestCountWorkflowExecutions() {
 	s.mockESClient.EXPECT().Count(gomock.Any(), testIndex, gomock.Any()).DoAndReturn(
 		func(ctx context.Context, index, input string) (int64, error) {
@@ -152,7 +152,7 @@ func (s *ESVisibilitySuite) TestRecordWorkflowExecutionClosed() {
 				},
 			},
 		},
-		CloseTime:     time.Unix(0, 1978).UTC(),
+		CloseTime:     time.Date(2020, 8, 2, 3, 32, 3, 4, time.UTC),
 		HistoryLength: int64(20),
 	}
 
@@ -173,6 +173,7 @@ func (s *ESVisibilitySuite) TestRecordWorkflowExecutionClosed() {
 			s.EqualValues(request.CloseTime, body[searchattribute.CloseTime])
 			s.EqualValues(request.Status.String(), body[searchattribute.ExecutionStatus])
 			s.EqualValues(request.HistoryLength, body[searchattribute.HistoryLength])
+			s.EqualValues((2*time.Hour + 30*time.Minute).Nanoseconds(), body[searchattribute.ExecutionDuration])
 
 			s.Equal(esclient.BulkableRequestTypeIndex, bulkRequest.RequestType)
 			s.EqualValues(request.TaskID, bulkRequest.Version)
@@ -206,6 +207,7 @@ func (s *ESVisibilitySuite) TestRecordWorkflowExecutionClosed_EmptyRequest() {
 			s.False(ok)
 			_, ok = body[searchattribute.MemoEncoding]
 			s.False(ok)
+			s.EqualValues(0, body[searchattribute.ExecutionDuration])
 
 			s.Equal(esclient.BulkableRequestTypeIndex, bulkRequest.RequestType)
 			s.EqualValues(request.TaskID, bulkRequest.Version)
@@ -221,6 +223,13 @@ func (s *ESVisibilitySuite) TestRecordWorkflowExecutionClosed_EmptyRequest() {
 	s.NoError(err)
 }
 
+func (s *ESVisibilitySuite) TestExecutionDuration() {
+	startTime := time.Date(2020, 8, 2, 1, 2, 3, 4, time.UTC)
+	s.Equal(time.Hour, executionDuration(startTime, startTime.Add(time.Hour)))
+	s.Equal(time.Duration(0), executionDuration(startTime, startTime))
+	s.Equal(time.Duration(0), executionDuration(startTime, startTime.Add(-time.Millisecond)))
+}
+
 func (s *ESVisibilitySuite) TestDeleteExecution() {
 	// test non-empty request fields match
 	request := &visibility.VisibilityDeleteWorkflowExecutionRequest{
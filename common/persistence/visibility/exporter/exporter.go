@@ -0,0 +1,235 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package exporter
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+)
+
+const (
+	defaultBufferSize    = 10000
+	defaultBatchSize     = 100
+	defaultFlushInterval = time.Second
+
+	publishRetryInitialInterval    = 100 * time.Millisecond
+	publishRetryMaxInterval        = 10 * time.Second
+	publishRetryExpirationInterval = time.Minute
+	shutdownPublishTimeout         = 5 * time.Second
+)
+
+type (
+	// Exporter asynchronously exports visibility records to the sink
+	Exporter interface {
+		common.Daemon
+		// Export adds record to the export buffer, record is dropped if buffer is full
+		Export(record *Record)
+	}
+
+	exporterImpl struct {
+		status        int32
+		sink          Sink
+		batchSize     int
+		flushInterval time.Duration
+		retryPolicy   backoff.RetryPolicy
+		metricsScope  metrics.Scope
+		logger        log.Logger
+
+		recordsCh  chan *Record
+		ctx        context.Context
+		cancel     context.CancelFunc
+		shutdownWG sync.WaitGroup
+	}
+)
+
+var _ Exporter = (*exporterImpl)(nil)
+
+// NewExporter creates visibility exporter from config
+func NewExporter(
+	cfg *config.VisibilityExporter,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) Exporter {
+	return newExporter(cfg, NewKafkaRESTSink(cfg.Kafka), metricsClient, logger)
+}
+
+func newExporter(
+	cfg *config.VisibilityExporter,
+	sink Sink,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) *exporterImpl {
+	bufferSize := cfg.BufferSize
+	if bufferSize <= 0 {
+		bufferSize = defaultBufferSize
+	}
+	batchSize := cfg.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+	flushInterval := cfg.FlushInterval
+	if flushInterval <= 0 {
+		flushInterval = defaultFlushInterval
+	}
+
+	retryPolicy := backoff.NewExponentialRetryPolicy(publishRetryInitialInterval)
+	retryPolicy.SetMaximumInterval(publishRetryMaxInterval)
+	retryPolicy.SetExpirationInterval(publishRetryExpirationInterval)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &exporterImpl{
+		status:        common.DaemonStatusInitialized,
+		sink:          sink,
+		batchSize:     batchSize,
+		flushInterval: flushInterval,
+		retryPolicy:   retryPolicy,
+		metricsScope:  metricsClient.Scope(metrics.VisibilityExporterScope),
+		logger:        logger,
+		recordsCh:     make(chan *Record, bufferSize),
+		ctx:           ctx,
+		cancel:        cancel,
+	}
+}
+
+func (e *exporterImpl) Start() {
+	if !atomic.CompareAndSwapInt32(&e.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+
+	e.shutdownWG.Add(1)
+	go e.publishLoop()
+	e.logger.Info("Visibility exporter started.")
+}
+
+func (e *exporterImpl) Stop() {
+	if !atomic.CompareAndSwapInt32(&e.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+
+	e.cancel()
+	if success := common.AwaitWaitGroup(&e.shutdownWG, 2*shutdownPublishTimeout); !success {
+		e.logger.Warn("Visibility exporter timed out on shutdown.")
+	}
+	e.logger.Info("Visibility exporter stopped.")
+}
+
+func (e *exporterImpl) Export(record *Record) {
+	select {
+	case e.recordsCh <- record:
+	default:
+		e.metricsScope.IncCounter(metrics.VisibilityExporterDropped)
+	}
+}
+
+func (e *exporterImpl) publishLoop() {
+	defer e.shutdownWG.Done()
+
+	ticker := time.NewTicker(e.flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*Record, 0, e.batchSize)
+	for {
+		select {
+		case <-e.ctx.Done():
+			e.drain(batch)
+			return
+		case record := <-e.recordsCh:
+			batch = append(batch, record)
+			if len(batch) >= e.batchSize {
+				e.publish(e.ctx, batch)
+				batch = make([]*Record, 0, e.batchSize)
+			}
+		case <-ticker.C:
+			if len(batch) > 0 {
+				e.publish(e.ctx, batch)
+				batch = make([]*Record, 0, e.batchSize)
+			}
+		}
+	}
+}
+
+// drain publishes buffered records once without retries
+func (e *exporterImpl) drain(batch []*Record) {
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownPublishTimeout)
+	defer cancel()
+
+	for {
+		select {
+		case record := <-e.recordsCh:
+			batch = append(batch, record)
+			if len(batch) < e.batchSize {
+				continue
+			}
+		default:
+		}
+
+		if len(batch) == 0 {
+			return
+		}
+		if err := e.publishOnce(ctx, batch); err != nil {
+			e.metricsScope.AddCounter(metrics.VisibilityExporterDropped, int64(len(batch)))
+		}
+		if ctx.Err() != nil {
+			e.metricsScope.AddCounter(metrics.VisibilityExporterDropped, int64(len(e.recordsCh)))
+			return
+		}
+		batch = make([]*Record, 0, e.batchSize)
+	}
+}
+
+func (e *exporterImpl) publish(ctx context.Context, batch []*Record) {
+	op := func() error {
+		return e.publishOnce(ctx, batch)
+	}
+	isRetryable := func(err error) bool {
+		_, nonRetryable := err.(*NonRetryableError)
+		return !nonRetryable && ctx.Err() == nil
+	}
+	if err := backoff.Retry(op, e.retryPolicy, isRetryable); err != nil {
+		e.metricsScope.AddCounter(metrics.VisibilityExporterDropped, int64(len(batch)))
+		e.logger.Error("Unable to export visibility records.", tag.Number(int64(len(batch))), tag.Error(err))
+	}
+}
+
+func (e *exporterImpl) publishOnce(ctx context.Context, batch []*Record) error {
+	sw := e.metricsScope.StartTimer(metrics.VisibilityExporterLatency)
+	defer sw.Stop()
+
+	if err := e.sink.Publish(ctx, batch); err != nil {
+		e.metricsScope.IncCounter(metrics.VisibilityExporterFailures)
+		return err
+	}
+	e.metricsScope.AddCounter(metrics.VisibilityExporterRecords, int64(len(batch)))
+	return nil
+}
@@ -0,0 +1,132 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package exporter
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+)
+
+type (
+	exporterSuite struct {
+		suite.Suite
+
+		controller *gomock.Controller
+		mockSink   *MockSink
+	}
+)
+
+func TestExporterSuite(t *testing.T) {
+	suite.Run(t, new(exporterSuite))
+}
+
+func (s *exporterSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.mockSink = NewMockSink(s.controller)
+}
+
+func (s *exporterSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *exporterSuite) newExporter(cfg *config.VisibilityExporter) *exporterImpl {
+	e := newExporter(cfg, s.mockSink, metrics.NewNoopMetricsClient(), log.NewNoopLogger())
+	retryPolicy := backoff.NewExponentialRetryPolicy(time.Millisecond)
+	retryPolicy.SetMaximumAttempts(2)
+	e.retryPolicy = retryPolicy
+	return e
+}
+
+func (s *exporterSuite) TestExport_BatchSize() {
+	e := s.newExporter(&config.VisibilityExporter{BatchSize: 2, FlushInterval: time.Hour})
+	published := make(chan []*Record, 2)
+	s.mockSink.EXPECT().Publish(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, records []*Record) error {
+		published <- records
+		return nil
+	}).Times(2)
+
+	e.Start()
+	for i := 0; i < 3; i++ {
+		e.Export(&Record{TaskID: int64(i)})
+	}
+	s.Equal([]*Record{{TaskID: 0}, {TaskID: 1}}, <-published)
+
+	// remaining records are published on shutdown
+	e.Stop()
+	s.Equal([]*Record{{TaskID: 2}}, <-published)
+}
+
+func (s *exporterSuite) TestExport_FlushInterval() {
+	e := s.newExporter(&config.VisibilityExporter{BatchSize: 100, FlushInterval: 10 * time.Millisecond})
+	published := make(chan []*Record, 1)
+	s.mockSink.EXPECT().Publish(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, records []*Record) error {
+		published <- records
+		return nil
+	})
+
+	e.Start()
+	defer e.Stop()
+	e.Export(&Record{TaskID: 1})
+	select {
+	case records := <-published:
+		s.Equal([]*Record{{TaskID: 1}}, records)
+	case <-time.After(time.Second):
+		s.Fail("records were not published on flush interval")
+	}
+}
+
+func (s *exporterSuite) TestPublish_Retry() {
+	e := s.newExporter(&config.VisibilityExporter{})
+	batch := []*Record{{TaskID: 1}}
+
+	gomock.InOrder(
+		s.mockSink.EXPECT().Publish(gomock.Any(), batch).Return(errors.New("transient error")),
+		s.mockSink.EXPECT().Publish(gomock.Any(), batch).Return(nil),
+	)
+	e.publish(context.Background(), batch)
+
+	s.mockSink.EXPECT().Publish(gomock.Any(), batch).Return(&NonRetryableError{Message: "bad request"})
+	e.publish(context.Background(), batch)
+
+	s.mockSink.EXPECT().Publish(gomock.Any(), batch).Return(errors.New("transient error")).Times(3)
+	e.publish(context.Background(), batch)
+}
+
+func (s *exporterSuite) TestExport_BufferFull() {
+	e := s.newExporter(&config.VisibilityExporter{BufferSize: 1})
+	e.Export(&Record{TaskID: 1})
+	e.Export(&Record{TaskID: 2})
+	s.Len(e.recordsCh, 1)
+}
@@ -0,0 +1,104 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package exporter
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/config"
+)
+
+type (
+	kafkaRESTSinkSuite struct {
+		suite.Suite
+	}
+)
+
+func TestKafkaRESTSinkSuite(t *testing.T) {
+	suite.Run(t, new(kafkaRESTSinkSuite))
+}
+
+func (s *kafkaRESTSinkSuite) TestPublish() {
+	var request kafkaRESTProduceRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.Equal(http.MethodPost, r.Method)
+		s.Equal("/topics/visibility", r.URL.Path)
+		s.Equal(kafkaRESTContentType, r.Header.Get("Content-Type"))
+		s.Equal("Bearer token", r.Header.Get("Authorization"))
+		s.NoError(json.NewDecoder(r.Body).Decode(&request))
+		_, _ = w.Write([]byte(`{"offsets":[{"partition":0,"offset":1},{"partition":1,"offset":1}]}`))
+	}))
+	defer server.Close()
+
+	sink := NewKafkaRESTSink(&config.KafkaREST{
+		URL:     server.URL + "/",
+		Topic:   "visibility",
+		Headers: map[string]string{"Authorization": "Bearer token"},
+	})
+	records := []*Record{
+		{Type: RecordTypeStarted, WorkflowID: "wid1", RunID: "rid1"},
+		{Type: RecordTypeClosed, WorkflowID: "wid2", RunID: "rid2"},
+	}
+	s.NoError(sink.Publish(context.Background(), records))
+	s.Len(request.Records, 2)
+	s.Equal("wid1", request.Records[0].Key)
+	s.Equal(*records[0], *request.Records[0].Value)
+	s.Equal("wid2", request.Records[1].Key)
+	s.Equal(*records[1], *request.Records[1].Value)
+}
+
+func (s *kafkaRESTSinkSuite) TestPublish_Errors() {
+	testCases := []struct {
+		statusCode   int
+		response     string
+		nonRetryable bool
+	}{
+		{statusCode: http.StatusOK, response: `{"offsets":[{"partition":0,"offset":1},{"error_code":50003,"error":"timeout"}]}`},
+		{statusCode: http.StatusServiceUnavailable, response: `{}`},
+		{statusCode: http.StatusTooManyRequests, response: `{}`},
+		{statusCode: http.StatusNotFound, response: `{"error_code":40401}`, nonRetryable: true},
+	}
+
+	for _, tc := range testCases {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(tc.statusCode)
+			_, _ = w.Write([]byte(tc.response))
+		}))
+
+		sink := NewKafkaRESTSink(&config.KafkaREST{URL: server.URL, Topic: "visibility"})
+		err := sink.Publish(context.Background(), []*Record{{WorkflowID: "wid"}})
+		server.Close()
+
+		s.Error(err, tc.statusCode)
+		_, nonRetryable := err.(*NonRetryableError)
+		s.Equal(tc.nonRetryable, nonRetryable, tc.statusCode)
+	}
+}
@@ -0,0 +1,125 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package exporter
+
+import (
+	"encoding/json"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/sdk/converter"
+
+	"go.temporal.io/server/common/persistence/visibility"
+)
+
+const (
+	// RecordTypeStarted is the type of record exported when workflow execution is started
+	RecordTypeStarted RecordType = "started"
+	// RecordTypeClosed is the type of record exported when workflow execution is closed
+	RecordTypeClosed RecordType = "closed"
+	// RecordTypeUpserted is the type of record exported when search attributes of workflow execution are upserted
+	RecordTypeUpserted RecordType = "upserted"
+	// RecordTypeDeleted is the type of record exported when workflow execution is deleted from visibility
+	RecordTypeDeleted RecordType = "deleted"
+)
+
+type (
+	// RecordType is the type of visibility change which is exported
+	RecordType string
+
+	// Record is the exported visibility record of workflow execution
+	Record struct {
+		Type                 RecordType                 `json:"type"`
+		NamespaceID          string                     `json:"namespaceId"`
+		Namespace            string                     `json:"namespace"`
+		WorkflowID           string                     `json:"workflowId"`
+		RunID                string                     `json:"runId"`
+		WorkflowType         string                     `json:"workflowType"`
+		TaskQueue            string                     `json:"taskQueue"`
+		Status               string                     `json:"status"`
+		StartTime            time.Time                  `json:"startTime"`
+		ExecutionTime        time.Time                  `json:"executionTime"`
+		CloseTime            *time.Time                 `json:"closeTime,omitempty"`
+		HistoryLength        int64                      `json:"historyLength,omitempty"`
+		StateTransitionCount int64                      `json:"stateTransitionCount"`
+		Memo                 map[string]json.RawMessage `json:"memo,omitempty"`
+		SearchAttributes     map[string]json.RawMessage `json:"searchAttributes,omitempty"`
+		// TaskID increases with every change of workflow execution, it can be used to order and deduplicate records
+		TaskID int64 `json:"taskId"`
+	}
+)
+
+func newRecord(recordType RecordType, request *visibility.VisibilityRequestBase) *Record {
+	return &Record{
+		Type:                 recordType,
+		NamespaceID:          request.NamespaceID,
+		Namespace:            request.Namespace,
+		WorkflowID:           request.Execution.GetWorkflowId(),
+		RunID:                request.Execution.GetRunId(),
+		WorkflowType:         request.WorkflowTypeName,
+		TaskQueue:            request.TaskQueue,
+		Status:               request.Status.String(),
+		StartTime:            request.StartTime,
+		ExecutionTime:        request.ExecutionTime,
+		StateTransitionCount: request.StateTransitionCount,
+		Memo:                 jsonPayloads(request.Memo.GetFields()),
+		SearchAttributes:     jsonPayloads(request.SearchAttributes.GetIndexedFields()),
+		TaskID:               request.TaskID,
+	}
+}
+
+func newClosedRecord(request *visibility.RecordWorkflowExecutionClosedRequest) *Record {
+	record := newRecord(RecordTypeClosed, request.VisibilityRequestBase)
+	closeTime := request.CloseTime
+	record.CloseTime = &closeTime
+	record.HistoryLength = request.HistoryLength
+	return record
+}
+
+func newDeletedRecord(request *visibility.VisibilityDeleteWorkflowExecutionRequest) *Record {
+	return &Record{
+		Type:        RecordTypeDeleted,
+		NamespaceID: request.NamespaceID,
+		WorkflowID:  request.WorkflowID,
+		RunID:       request.RunID,
+		TaskID:      request.TaskID,
+	}
+}
+
+// jsonPayloads returns JSON encoded payloads as is, payloads with other encodings are not exported.
+func jsonPayloads(payloads map[string]*commonpb.Payload) map[string]json.RawMessage {
+	if len(payloads) == 0 {
+		return nil
+	}
+
+	result := make(map[string]json.RawMessage, len(payloads))
+	for key, p := range payloads {
+		if string(p.GetMetadata()[converter.MetadataEncoding]) != converter.MetadataEncodingJSON || !json.Valid(p.GetData()) {
+			continue
+		}
+		result[key] = p.GetData()
+	}
+	return result
+}
@@ -0,0 +1,92 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package exporter
+
+import (
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/persistence/visibility"
+)
+
+type (
+	visibilitySink struct {
+		exporter Exporter
+		enabled  dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	}
+)
+
+var _ visibility.Sink = (*visibilitySink)(nil)
+
+// NewVisibilitySink creates a visibility sink which exports visibility records with exporter.
+// Backfill records are not exported.
+func NewVisibilitySink(
+	exporter Exporter,
+	enabled dynamicconfig.BoolPropertyFnWithNamespaceFilter,
+) visibility.Sink {
+	return &visibilitySink{
+		exporter: exporter,
+		enabled:  enabled,
+	}
+}
+
+func (s *visibilitySink) Close() {
+	s.exporter.Stop()
+}
+
+func (s *visibilitySink) GetName() string {
+	return "visibilitySinkExporter"
+}
+
+func (s *visibilitySink) RecordWorkflowExecutionStarted(request *visibility.RecordWorkflowExecutionStartedRequest) error {
+	if s.shouldExport(request.VisibilityRequestBase) {
+		s.exporter.Export(newRecord(RecordTypeStarted, request.VisibilityRequestBase))
+	}
+	return nil
+}
+
+func (s *visibilitySink) RecordWorkflowExecutionClosed(request *visibility.RecordWorkflowExecutionClosedRequest) error {
+	if s.shouldExport(request.VisibilityRequestBase) {
+		s.exporter.Export(newClosedRecord(request))
+	}
+	return nil
+}
+
+func (s *visibilitySink) UpsertWorkflowExecution(request *visibility.UpsertWorkflowExecutionRequest) error {
+	if s.shouldExport(request.VisibilityRequestBase) {
+		s.exporter.Export(newRecord(RecordTypeUpserted, request.VisibilityRequestBase))
+	}
+	return nil
+}
+
+func (s *visibilitySink) DeleteWorkflowExecution(request *visibility.VisibilityDeleteWorkflowExecutionRequest) error {
+	// namespace name is not known for delete requests, default value is used.
+	if !request.Backfill && s.enabled("") {
+		s.exporter.Export(newDeletedRecord(request))
+	}
+	return nil
+}
+
+func (s *visibilitySink) shouldExport(request *visibility.VisibilityRequestBase) bool {
+	return !request.Backfill && s.enabled(request.Namespace)
+}
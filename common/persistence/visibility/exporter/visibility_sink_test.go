@@ -0,0 +1,131 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package exporter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/persistence/visibility"
+)
+
+type (
+	visibilitySinkSuite struct {
+		suite.Suite
+
+		exporter *recordingExporter
+		sink     visibility.Sink
+	}
+
+	recordingExporter struct {
+		records []*Record
+	}
+)
+
+func TestVisibilitySinkSuite(t *testing.T) {
+	suite.Run(t, new(visibilitySinkSuite))
+}
+
+func (s *visibilitySinkSuite) SetupTest() {
+	s.exporter = &recordingExporter{}
+	s.sink = NewVisibilitySink(s.exporter, func(namespace string) bool {
+		return namespace != "disabled-namespace"
+	})
+}
+
+func (s *visibilitySinkSuite) TestRecordWorkflowExecutionClosed() {
+	memo, err := payload.Encode("memo value")
+	s.NoError(err)
+	request := &visibility.RecordWorkflowExecutionClosedRequest{
+		VisibilityRequestBase: &visibility.VisibilityRequestBase{
+			NamespaceID:      "namespace-id",
+			Namespace:        "namespace",
+			Execution:        commonpb.WorkflowExecution{WorkflowId: "wid", RunId: "rid"},
+			WorkflowTypeName: "workflow-type",
+			Status:           enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+			Memo:             &commonpb.Memo{Fields: map[string]*commonpb.Payload{"key": memo}},
+			TaskID:           100,
+		},
+		CloseTime:     time.Now().UTC(),
+		HistoryLength: 10,
+	}
+	s.NoError(s.sink.RecordWorkflowExecutionClosed(request))
+
+	s.Len(s.exporter.records, 1)
+	record := s.exporter.records[0]
+	s.Equal(RecordTypeClosed, record.Type)
+	s.Equal("wid", record.WorkflowID)
+	s.Equal("rid", record.RunID)
+	s.Equal("Completed", record.Status)
+	s.Equal(request.CloseTime, *record.CloseTime)
+	s.Equal(int64(10), record.HistoryLength)
+	s.Equal(int64(100), record.TaskID)
+	s.Equal(`"memo value"`, string(record.Memo["key"]))
+}
+
+func (s *visibilitySinkSuite) TestDeleteWorkflowExecution() {
+	s.NoError(s.sink.DeleteWorkflowExecution(&visibility.VisibilityDeleteWorkflowExecutionRequest{
+		NamespaceID: "namespace-id",
+		WorkflowID:  "wid",
+		RunID:       "rid",
+		TaskID:      100,
+	}))
+
+	s.Len(s.exporter.records, 1)
+	record := s.exporter.records[0]
+	s.Equal(RecordTypeDeleted, record.Type)
+	s.Equal("namespace-id", record.NamespaceID)
+	s.Equal("wid", record.WorkflowID)
+	s.Equal("rid", record.RunID)
+	s.Equal(int64(100), record.TaskID)
+}
+
+func (s *visibilitySinkSuite) TestNotExported() {
+	// export disabled for namespace
+	s.NoError(s.sink.UpsertWorkflowExecution(&visibility.UpsertWorkflowExecutionRequest{
+		VisibilityRequestBase: &visibility.VisibilityRequestBase{Namespace: "disabled-namespace"},
+	}))
+
+	// backfill
+	s.NoError(s.sink.RecordWorkflowExecutionStarted(&visibility.RecordWorkflowExecutionStartedRequest{
+		VisibilityRequestBase: &visibility.VisibilityRequestBase{Namespace: "namespace", Backfill: true},
+	}))
+	s.NoError(s.sink.DeleteWorkflowExecution(&visibility.VisibilityDeleteWorkflowExecutionRequest{Backfill: true}))
+
+	s.Empty(s.exporter.records)
+}
+
+func (e *recordingExporter) Start() {}
+
+func (e *recordingExporter) Stop() {}
+
+func (e *recordingExporter) Export(record *Record) {
+	e.records = append(e.records, record)
+}
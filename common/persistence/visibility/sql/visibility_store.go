@@ -138,10 +138,23 @@ func (s *visibilityStore) RecordWorkflowExecutionClosed(request *visibility.Inte
 }
 
 func (s *visibilityStore) UpsertWorkflowExecution(
-	_ *visibility.InternalUpsertWorkflowExecutionRequest,
+	request *visibility.InternalUpsertWorkflowExecutionRequest,
 ) error {
-	// Not OperationNotSupportedErr!
-	return nil
+	// Only memo of open workflows is updated. Search attributes are not supported by standard visibility,
+	// but it is not OperationNotSupportedErr!
+	if request.Status != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING || request.Memo == nil {
+		return nil
+	}
+
+	ctx, cancel := newVisibilityContext()
+	defer cancel()
+	_, err := s.sqlStore.Db.UpdateVisibilityMemo(ctx, &sqlplugin.VisibilityRow{
+		NamespaceID: request.NamespaceID,
+		RunID:       request.RunID,
+		Memo:        request.Memo.Data,
+		Encoding:    request.Memo.EncodingType.String(),
+	})
+	return err
 }
 
 func (s *visibilityStore) ListOpenWorkflowExecutions(
@@ -300,6 +313,47 @@ func (s *visibilityStore) ListClosedWorkflowExecutionsByStatus(
 		})
 }
 
+func (s *visibilityStore) ListClosedWorkflowExecutionsByTypeAndStatus(
+	request *visibility.ListClosedWorkflowExecutionsByTypeAndStatusRequest,
+) (*visibility.InternalListWorkflowExecutionsResponse, error) {
+	ctx, cancel := newVisibilityContext()
+	defer cancel()
+	return s.listWorkflowExecutions("ListClosedWorkflowExecutionsByTypeAndStatus",
+		request.NextPageToken,
+		request.EarliestStartTime,
+		request.LatestStartTime,
+		true,
+		func(readLevel *visibilityPageToken) ([]sqlplugin.VisibilityRow, error) {
+			return s.sqlStore.Db.SelectFromVisibility(ctx, sqlplugin.VisibilitySelectFilter{
+				NamespaceID:      request.NamespaceID,
+				MinTime:          &request.EarliestStartTime,
+				MaxTime:          &readLevel.Time,
+				RunID:            &readLevel.RunID,
+				WorkflowTypeName: &request.WorkflowTypeName,
+				Status:           int32(request.Status),
+				PageSize:         &request.PageSize,
+			})
+		})
+}
+
+func (s *visibilityStore) CountClosedWorkflowExecutionsByTypeAndStatus(
+	request *visibility.CountClosedWorkflowExecutionsByTypeAndStatusRequest,
+) (*visibility.CountWorkflowExecutionsResponse, error) {
+	ctx, cancel := newVisibilityContext()
+	defer cancel()
+	count, err := s.sqlStore.Db.CountFromVisibility(ctx, sqlplugin.VisibilitySelectFilter{
+		NamespaceID:      request.NamespaceID,
+		MinTime:          &request.EarliestCloseTime,
+		MaxTime:          &request.LatestCloseTime,
+		WorkflowTypeName: &request.WorkflowTypeName,
+		Status:           int32(request.Status),
+	})
+	if err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("CountClosedWorkflowExecutionsByTypeAndStatus operation failed. Error: %v", err))
+	}
+	return &visibility.CountWorkflowExecutionsResponse{Count: count}, nil
+}
+
 func (s *visibilityStore) DeleteWorkflowExecution(
 	request *visibility.VisibilityDeleteWorkflowExecutionRequest,
 ) error {
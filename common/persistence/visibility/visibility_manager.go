@@ -51,6 +51,7 @@ type (
 		Memo                 *commonpb.Memo
 		TaskQueue            string
 		SearchAttributes     *commonpb.SearchAttributes
+		Backfill             bool // not persisted, backfill requests are indexed with lower priority
 	}
 
 	// RecordWorkflowExecutionStartedRequest is used to add a record of a newly started execution
@@ -136,12 +137,32 @@ type (
 		Status enumspb.WorkflowExecutionStatus
 	}
 
+	// ListClosedWorkflowExecutionsByTypeAndStatusRequest is used to list executions that
+	// have specific type and close status
+	ListClosedWorkflowExecutionsByTypeAndStatusRequest struct {
+		ListWorkflowExecutionsRequest
+		WorkflowTypeName string
+		Status           enumspb.WorkflowExecutionStatus
+	}
+
+	// CountClosedWorkflowExecutionsByTypeAndStatusRequest is used to count executions that
+	// have specific type and close status
+	CountClosedWorkflowExecutionsByTypeAndStatusRequest struct {
+		NamespaceID       string
+		Namespace         string // namespace name is not persisted, but used as config filter key
+		EarliestCloseTime time.Time
+		LatestCloseTime   time.Time
+		WorkflowTypeName  string
+		Status            enumspb.WorkflowExecutionStatus
+	}
+
 	// VisibilityDeleteWorkflowExecutionRequest contains the request params for DeleteWorkflowExecution call
 	VisibilityDeleteWorkflowExecutionRequest struct {
 		NamespaceID string
 		RunID       string
 		WorkflowID  string
 		TaskID      int64
+		Backfill    bool // backfill requests are indexed with lower priority
 	}
 
 	// VisibilityManager is used to manage the visibility store
@@ -158,6 +179,8 @@ type (
 		ListOpenWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error)
 		ListClosedWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error)
 		ListClosedWorkflowExecutionsByStatus(request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error)
+		ListClosedWorkflowExecutionsByTypeAndStatus(request *ListClosedWorkflowExecutionsByTypeAndStatusRequest) (*ListWorkflowExecutionsResponse, error)
+		CountClosedWorkflowExecutionsByTypeAndStatus(request *CountClosedWorkflowExecutionsByTypeAndStatusRequest) (*CountWorkflowExecutionsResponse, error)
 		DeleteWorkflowExecution(request *VisibilityDeleteWorkflowExecutionRequest) error
 		ListWorkflowExecutions(request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error)
 		ScanWorkflowExecutions(request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error)
@@ -35,6 +35,7 @@ import (
 
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/searchattribute"
 )
 
@@ -48,8 +49,12 @@ type (
 	}
 )
 
-// MemoEncoding is default encoding for visibility memo.
-const MemoEncoding = enumspb.ENCODING_TYPE_PROTO3
+const (
+	// MemoEncoding is default encoding for visibility memo.
+	MemoEncoding = enumspb.ENCODING_TYPE_PROTO3
+	// AnnotationsMemoKey is the memo field which holds errors of partially parsed visibility records.
+	AnnotationsMemoKey = "TemporalVisibilityAnnotations"
+)
 
 var _ VisibilityManager = (*visibilityManagerImpl)(nil)
 
@@ -110,6 +115,7 @@ func (v *visibilityManagerImpl) newInternalVisibilityRequestBase(request *Visibi
 		TaskQueue:        request.TaskQueue,
 		Memo:             v.serializeMemo(request.Memo, request.NamespaceID, request.Execution.GetWorkflowId(), request.Execution.GetRunId()),
 		SearchAttributes: request.SearchAttributes,
+		Backfill:         request.Backfill,
 	}
 }
 
@@ -169,6 +175,18 @@ func (v *visibilityManagerImpl) ListClosedWorkflowExecutionsByStatus(request *Li
 	return v.convertInternalListResponse(internalResp), nil
 }
 
+func (v *visibilityManagerImpl) ListClosedWorkflowExecutionsByTypeAndStatus(request *ListClosedWorkflowExecutionsByTypeAndStatusRequest) (*ListWorkflowExecutionsResponse, error) {
+	internalResp, err := v.store.ListClosedWorkflowExecutionsByTypeAndStatus(request)
+	if err != nil {
+		return nil, err
+	}
+	return v.convertInternalListResponse(internalResp), nil
+}
+
+func (v *visibilityManagerImpl) CountClosedWorkflowExecutionsByTypeAndStatus(request *CountClosedWorkflowExecutionsByTypeAndStatusRequest) (*CountWorkflowExecutionsResponse, error) {
+	return v.store.CountClosedWorkflowExecutionsByTypeAndStatus(request)
+}
+
 func (v *visibilityManagerImpl) DeleteWorkflowExecution(request *VisibilityDeleteWorkflowExecutionRequest) error {
 	return v.store.DeleteWorkflowExecution(request)
 }
@@ -227,6 +245,23 @@ func (v *visibilityManagerImpl) convertVisibilityWorkflowExecutionInfo(execution
 			tag.WorkflowRunID(execution.RunID),
 			tag.Error(err))
 	}
+	if len(execution.Annotations) > 0 {
+		annotationsPayload, err := payload.Encode(execution.Annotations)
+		if err != nil {
+			v.logger.Error("failed to encode annotations",
+				tag.WorkflowID(execution.WorkflowID),
+				tag.WorkflowRunID(execution.RunID),
+				tag.Error(err))
+		} else {
+			if memo == nil {
+				memo = &commonpb.Memo{}
+			}
+			if memo.Fields == nil {
+				memo.Fields = make(map[string]*commonpb.Payload)
+			}
+			memo.Fields[AnnotationsMemoKey] = annotationsPayload
+		}
+	}
 
 	convertedExecution := &workflowpb.WorkflowExecutionInfo{
 		Execution: &commonpb.WorkflowExecution{
@@ -195,6 +195,34 @@ func (p *visibilityPersistenceClient) ListClosedWorkflowExecutionsByStatus(reque
 	return response, err
 }
 
+func (p *visibilityPersistenceClient) ListClosedWorkflowExecutionsByTypeAndStatus(request *ListClosedWorkflowExecutionsByTypeAndStatusRequest) (*ListWorkflowExecutionsResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceListClosedWorkflowExecutionsByTypeAndStatusScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceListClosedWorkflowExecutionsByTypeAndStatusScope, metrics.PersistenceLatency)
+	response, err := p.persistence.ListClosedWorkflowExecutionsByTypeAndStatus(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceListClosedWorkflowExecutionsByTypeAndStatusScope, err)
+	}
+
+	return response, err
+}
+
+func (p *visibilityPersistenceClient) CountClosedWorkflowExecutionsByTypeAndStatus(request *CountClosedWorkflowExecutionsByTypeAndStatusRequest) (*CountWorkflowExecutionsResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceCountClosedWorkflowExecutionsByTypeAndStatusScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceCountClosedWorkflowExecutionsByTypeAndStatusScope, metrics.PersistenceLatency)
+	response, err := p.persistence.CountClosedWorkflowExecutionsByTypeAndStatus(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceCountClosedWorkflowExecutionsByTypeAndStatusScope, err)
+	}
+
+	return response, err
+}
+
 func (p *visibilityPersistenceClient) DeleteWorkflowExecution(request *VisibilityDeleteWorkflowExecutionRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceVisibilityDeleteWorkflowExecutionScope, metrics.PersistenceRequests)
 
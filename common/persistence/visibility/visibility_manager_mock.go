@@ -69,6 +69,21 @@ func (mr *MockVisibilityManagerMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockVisibilityManager)(nil).Close))
 }
 
+// CountClosedWorkflowExecutionsByTypeAndStatus mocks base method.
+func (m *MockVisibilityManager) CountClosedWorkflowExecutionsByTypeAndStatus(request *CountClosedWorkflowExecutionsByTypeAndStatusRequest) (*CountWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountClosedWorkflowExecutionsByTypeAndStatus", request)
+	ret0, _ := ret[0].(*CountWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountClosedWorkflowExecutionsByTypeAndStatus indicates an expected call of CountClosedWorkflowExecutionsByTypeAndStatus.
+func (mr *MockVisibilityManagerMockRecorder) CountClosedWorkflowExecutionsByTypeAndStatus(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountClosedWorkflowExecutionsByTypeAndStatus", reflect.TypeOf((*MockVisibilityManager)(nil).CountClosedWorkflowExecutionsByTypeAndStatus), request)
+}
+
 // CountWorkflowExecutions mocks base method.
 func (m *MockVisibilityManager) CountWorkflowExecutions(request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -157,6 +172,21 @@ func (mr *MockVisibilityManagerMockRecorder) ListClosedWorkflowExecutionsByType(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClosedWorkflowExecutionsByType", reflect.TypeOf((*MockVisibilityManager)(nil).ListClosedWorkflowExecutionsByType), request)
 }
 
+// ListClosedWorkflowExecutionsByTypeAndStatus mocks base method.
+func (m *MockVisibilityManager) ListClosedWorkflowExecutionsByTypeAndStatus(request *ListClosedWorkflowExecutionsByTypeAndStatusRequest) (*ListWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClosedWorkflowExecutionsByTypeAndStatus", request)
+	ret0, _ := ret[0].(*ListWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClosedWorkflowExecutionsByTypeAndStatus indicates an expected call of ListClosedWorkflowExecutionsByTypeAndStatus.
+func (mr *MockVisibilityManagerMockRecorder) ListClosedWorkflowExecutionsByTypeAndStatus(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClosedWorkflowExecutionsByTypeAndStatus", reflect.TypeOf((*MockVisibilityManager)(nil).ListClosedWorkflowExecutionsByTypeAndStatus), request)
+}
+
 // ListClosedWorkflowExecutionsByWorkflowID mocks base method.
 func (m *MockVisibilityManager) ListClosedWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -143,6 +143,24 @@ func (p *visibilityRateLimitedPersistenceClient) ListClosedWorkflowExecutionsByS
 	return response, err
 }
 
+func (p *visibilityRateLimitedPersistenceClient) ListClosedWorkflowExecutionsByTypeAndStatus(request *ListClosedWorkflowExecutionsByTypeAndStatusRequest) (*ListWorkflowExecutionsResponse, error) {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return nil, persistence.ErrPersistenceLimitExceeded
+	}
+
+	response, err := p.persistence.ListClosedWorkflowExecutionsByTypeAndStatus(request)
+	return response, err
+}
+
+func (p *visibilityRateLimitedPersistenceClient) CountClosedWorkflowExecutionsByTypeAndStatus(request *CountClosedWorkflowExecutionsByTypeAndStatusRequest) (*CountWorkflowExecutionsResponse, error) {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return nil, persistence.ErrPersistenceLimitExceeded
+	}
+
+	response, err := p.persistence.CountClosedWorkflowExecutionsByTypeAndStatus(request)
+	return response, err
+}
+
 func (p *visibilityRateLimitedPersistenceClient) DeleteWorkflowExecution(request *VisibilityDeleteWorkflowExecutionRequest) error {
 	if ok := p.rateLimiter.Allow(); !ok {
 		return persistence.ErrPersistenceLimitExceeded
@@ -219,6 +219,20 @@ func (p *visibilitySamplingClient) ListClosedWorkflowExecutionsByStatus(request
 	return p.persistence.ListClosedWorkflowExecutionsByStatus(request)
 }
 
+func (p *visibilitySamplingClient) ListClosedWorkflowExecutionsByTypeAndStatus(request *ListClosedWorkflowExecutionsByTypeAndStatusRequest) (*ListWorkflowExecutionsResponse, error) {
+	namespace := request.Namespace
+
+	if ok := p.rateLimitersForList.Allow(namespace); !ok {
+		return nil, persistence.ErrPersistenceLimitExceededForList
+	}
+
+	return p.persistence.ListClosedWorkflowExecutionsByTypeAndStatus(request)
+}
+
+func (p *visibilitySamplingClient) CountClosedWorkflowExecutionsByTypeAndStatus(request *CountClosedWorkflowExecutionsByTypeAndStatusRequest) (*CountWorkflowExecutionsResponse, error) {
+	return p.persistence.CountClosedWorkflowExecutionsByTypeAndStatus(request)
+}
+
 func (p *visibilitySamplingClient) DeleteWorkflowExecution(request *VisibilityDeleteWorkflowExecutionRequest) error {
 	return p.persistence.DeleteWorkflowExecution(request)
 }
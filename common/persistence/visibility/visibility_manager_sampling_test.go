@@ -280,3 +280,25 @@ func (s *VisibilitySamplingSuite) TestListClosedWorkflowExecutionsByStatus() {
 	s.True(ok)
 	s.Equal(listErrMsg, errDetail.Message)
 }
+
+func (s *VisibilitySamplingSuite) TestListClosedWorkflowExecutionsByTypeAndStatus() {
+	req := ListWorkflowExecutionsRequest{
+		NamespaceID: testNamespaceUUID,
+		Namespace:   testNamespace,
+	}
+	request := &ListClosedWorkflowExecutionsByTypeAndStatusRequest{
+		ListWorkflowExecutionsRequest: req,
+		WorkflowTypeName:              testWorkflowTypeName,
+		Status:                        enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+	}
+	s.persistence.EXPECT().ListClosedWorkflowExecutionsByTypeAndStatus(request).Return(nil, nil)
+	_, err := s.client.ListClosedWorkflowExecutionsByTypeAndStatus(request)
+	s.NoError(err)
+
+	// no remaining tokens
+	_, err = s.client.ListClosedWorkflowExecutionsByTypeAndStatus(request)
+	s.Error(err)
+	errDetail, ok := err.(*serviceerror.ResourceExhausted)
+	s.True(ok)
+	s.Equal(listErrMsg, errDetail.Message)
+}
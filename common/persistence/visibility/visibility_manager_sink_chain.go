@@ -0,0 +1,295 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibility
+
+import (
+	"fmt"
+	"strings"
+
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+)
+
+type (
+	visibilityManagerSinkChain struct {
+		visibilityManager          VisibilityManager
+		esVisibilityManager        VisibilityManager
+		sinks                      map[string]Sink
+		enableReadVisibilityFromES dynamicconfig.BoolPropertyFnWithNamespaceFilter
+		advancedVisWritingMode     dynamicconfig.StringPropertyFn
+		sinkNames                  dynamicconfig.StringPropertyFnWithNamespaceFilter
+		bestEffortSinkNames        dynamicconfig.StringPropertyFnWithNamespaceFilter
+		metricsClient              metrics.Client
+		logger                     log.Logger
+	}
+)
+
+var _ VisibilityManager = (*visibilityManagerSinkChain)(nil)
+
+// NewVisibilityManagerWrapper create a visibility manager that operate on DB or ElasticSearch based on dynamic config.
+func NewVisibilityManagerWrapper(
+	visibilityManager VisibilityManager,
+	esVisibilityManager VisibilityManager,
+	enableReadVisibilityFromES dynamicconfig.BoolPropertyFnWithNamespaceFilter,
+	advancedVisWritingMode dynamicconfig.StringPropertyFn,
+) VisibilityManager {
+	return NewVisibilityManagerSinkChain(
+		visibilityManager,
+		esVisibilityManager,
+		nil,
+		enableReadVisibilityFromES,
+		advancedVisWritingMode,
+		dynamicconfig.GetStringPropertyFnWithNamespaceFilter(""),
+		dynamicconfig.GetStringPropertyFnWithNamespaceFilter(""),
+		metrics.NewNoopMetricsClient(),
+		log.NewNoopLogger(),
+	)
+}
+
+// NewVisibilityManagerSinkChain create a visibility manager that writes visibility records to the chain of sinks
+// configured per namespace, and reads from DB or ElasticSearch based on dynamic config.
+// DB and ElasticSearch visibility managers are registered as SinkDB and SinkES sinks, other sinks are passed in extraSinks.
+// Sinks are written in configured order, write fails on first sink failure unless the sink is configured as best effort.
+func NewVisibilityManagerSinkChain(
+	visibilityManager VisibilityManager,
+	esVisibilityManager VisibilityManager,
+	extraSinks map[string]Sink,
+	enableReadVisibilityFromES dynamicconfig.BoolPropertyFnWithNamespaceFilter,
+	advancedVisWritingMode dynamicconfig.StringPropertyFn,
+	sinkNames dynamicconfig.StringPropertyFnWithNamespaceFilter,
+	bestEffortSinkNames dynamicconfig.StringPropertyFnWithNamespaceFilter,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) VisibilityManager {
+	sinks := map[string]Sink{
+		SinkNoop: NewNoopSink(),
+	}
+	if visibilityManager != nil {
+		sinks[SinkDB] = visibilityManager
+	}
+	if esVisibilityManager != nil {
+		sinks[SinkES] = esVisibilityManager
+	}
+	for name, sink := range extraSinks {
+		sinks[name] = sink
+	}
+
+	return &visibilityManagerSinkChain{
+		visibilityManager:          visibilityManager,
+		esVisibilityManager:        esVisibilityManager,
+		sinks:                      sinks,
+		enableReadVisibilityFromES: enableReadVisibilityFromES,
+		advancedVisWritingMode:     advancedVisWritingMode,
+		sinkNames:                  sinkNames,
+		bestEffortSinkNames:        bestEffortSinkNames,
+		metricsClient:              metricsClient,
+		logger:                     logger,
+	}
+}
+
+func (v *visibilityManagerSinkChain) Close() {
+	for _, sink := range v.sinks {
+		sink.Close()
+	}
+}
+
+func (v *visibilityManagerSinkChain) GetName() string {
+	return "visibilityManagerSinkChain"
+}
+
+func (v *visibilityManagerSinkChain) RecordWorkflowExecutionStarted(request *RecordWorkflowExecutionStartedRequest) error {
+	return v.write(request.Namespace, func(sink Sink) error {
+		return sink.RecordWorkflowExecutionStarted(request)
+	})
+}
+
+func (v *visibilityManagerSinkChain) RecordWorkflowExecutionClosed(request *RecordWorkflowExecutionClosedRequest) error {
+	return v.write(request.Namespace, func(sink Sink) error {
+		return sink.RecordWorkflowExecutionClosed(request)
+	})
+}
+
+func (v *visibilityManagerSinkChain) UpsertWorkflowExecution(request *UpsertWorkflowExecutionRequest) error {
+	// no op on SQL/Cassandra persistence.
+	return v.write(request.Namespace, func(sink Sink) error {
+		return sink.UpsertWorkflowExecution(request)
+	})
+}
+
+func (v *visibilityManagerSinkChain) ListOpenWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	manager := v.chooseVisibilityManagerForNamespace(request.Namespace)
+	return manager.ListOpenWorkflowExecutions(request)
+}
+
+func (v *visibilityManagerSinkChain) ListClosedWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	manager := v.chooseVisibilityManagerForNamespace(request.Namespace)
+	return manager.ListClosedWorkflowExecutions(request)
+}
+
+func (v *visibilityManagerSinkChain) ListOpenWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+	manager := v.chooseVisibilityManagerForNamespace(request.Namespace)
+	return manager.ListOpenWorkflowExecutionsByType(request)
+}
+
+func (v *visibilityManagerSinkChain) ListClosedWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+	manager := v.chooseVisibilityManagerForNamespace(request.Namespace)
+	return manager.ListClosedWorkflowExecutionsByType(request)
+}
+
+func (v *visibilityManagerSinkChain) ListOpenWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+	manager := v.chooseVisibilityManagerForNamespace(request.Namespace)
+	return manager.ListOpenWorkflowExecutionsByWorkflowID(request)
+}
+
+func (v *visibilityManagerSinkChain) ListClosedWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+	manager := v.chooseVisibilityManagerForNamespace(request.Namespace)
+	return manager.ListClosedWorkflowExecutionsByWorkflowID(request)
+}
+
+func (v *visibilityManagerSinkChain) ListClosedWorkflowExecutionsByStatus(request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error) {
+	manager := v.chooseVisibilityManagerForNamespace(request.Namespace)
+	return manager.ListClosedWorkflowExecutionsByStatus(request)
+}
+
+func (v *visibilityManagerSinkChain) ListClosedWorkflowExecutionsByTypeAndStatus(request *ListClosedWorkflowExecutionsByTypeAndStatusRequest) (*ListWorkflowExecutionsResponse, error) {
+	manager := v.chooseVisibilityManagerForNamespace(request.Namespace)
+	return manager.ListClosedWorkflowExecutionsByTypeAndStatus(request)
+}
+
+func (v *visibilityManagerSinkChain) CountClosedWorkflowExecutionsByTypeAndStatus(request *CountClosedWorkflowExecutionsByTypeAndStatusRequest) (*CountWorkflowExecutionsResponse, error) {
+	manager := v.chooseVisibilityManagerForNamespace(request.Namespace)
+	return manager.CountClosedWorkflowExecutionsByTypeAndStatus(request)
+}
+
+func (v *visibilityManagerSinkChain) DeleteWorkflowExecution(request *VisibilityDeleteWorkflowExecutionRequest) error {
+	// namespace name is not known for delete requests, so sinks are derived from writing mode
+	return v.write("", func(sink Sink) error {
+		return sink.DeleteWorkflowExecution(request)
+	})
+}
+
+func (v *visibilityManagerSinkChain) ListWorkflowExecutions(request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error) {
+	manager := v.chooseVisibilityManagerForNamespace(request.Namespace)
+	return manager.ListWorkflowExecutions(request)
+}
+
+func (v *visibilityManagerSinkChain) ScanWorkflowExecutions(request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error) {
+	manager := v.chooseVisibilityManagerForNamespace(request.Namespace)
+	return manager.ScanWorkflowExecutions(request)
+}
+
+func (v *visibilityManagerSinkChain) CountWorkflowExecutions(request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
+	manager := v.chooseVisibilityManagerForNamespace(request.Namespace)
+	return manager.CountWorkflowExecutions(request)
+}
+
+func (v *visibilityManagerSinkChain) chooseVisibilityManagerForNamespace(namespace string) VisibilityManager {
+	var visibilityMgr VisibilityManager
+	if v.enableReadVisibilityFromES(namespace) && v.esVisibilityManager != nil {
+		visibilityMgr = v.esVisibilityManager
+	} else {
+		visibilityMgr = v.visibilityManager
+	}
+	return visibilityMgr
+}
+
+func (v *visibilityManagerSinkChain) write(namespace string, op func(sink Sink) error) error {
+	sinkNames, err := v.sinkNamesForNamespace(namespace)
+	if err != nil {
+		return err
+	}
+	bestEffortSinkNames := splitSinkNames(v.bestEffortSinkNames(namespace))
+
+	for _, sinkName := range sinkNames {
+		sink, ok := v.sinks[sinkName]
+		if !ok {
+			return serviceerror.NewInternal(fmt.Sprintf("Visibility sink %q is not configured", sinkName))
+		}
+
+		scope := v.metricsClient.Scope(metrics.VisibilitySinkScope, metrics.SinkTag(sinkName))
+		scope.IncCounter(metrics.VisibilitySinkRequests)
+		sw := scope.StartTimer(metrics.VisibilitySinkLatency)
+		err := op(sink)
+		sw.Stop()
+		if err == nil {
+			continue
+		}
+
+		scope.IncCounter(metrics.VisibilitySinkFailures)
+		if containsSinkName(bestEffortSinkNames, sinkName) {
+			v.logger.Warn("Failed to write to best effort visibility sink.",
+				tag.Value(sinkName), tag.WorkflowNamespace(namespace), tag.Error(err))
+			continue
+		}
+		return err
+	}
+	return nil
+}
+
+func (v *visibilityManagerSinkChain) sinkNamesForNamespace(namespace string) ([]string, error) {
+	if sinkNames := splitSinkNames(v.sinkNames(namespace)); len(sinkNames) > 0 {
+		return sinkNames, nil
+	}
+
+	var sinkNames []string
+	switch v.advancedVisWritingMode() {
+	case common.AdvancedVisibilityWritingModeOff:
+		sinkNames = []string{SinkDB}
+	case common.AdvancedVisibilityWritingModeOn:
+		sinkNames = []string{SinkES}
+	case common.AdvancedVisibilityWritingModeDual:
+		sinkNames = []string{SinkES, SinkDB}
+	default:
+		return nil, serviceerror.NewInternal(fmt.Sprintf("Unknown advanced visibility writing mode: %s", v.advancedVisWritingMode()))
+	}
+	if _, ok := v.sinks[SinkKafka]; ok {
+		sinkNames = append(sinkNames, SinkKafka)
+	}
+	return sinkNames, nil
+}
+
+func splitSinkNames(value string) []string {
+	var sinkNames []string
+	for _, sinkName := range strings.Split(value, ",") {
+		if sinkName = strings.TrimSpace(sinkName); sinkName != "" {
+			sinkNames = append(sinkNames, sinkName)
+		}
+	}
+	return sinkNames
+}
+
+func containsSinkName(sinkNames []string, sinkName string) bool {
+	for _, name := range sinkNames {
+		if name == sinkName {
+			return true
+		}
+	}
+	return false
+}
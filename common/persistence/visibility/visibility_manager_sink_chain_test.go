@@ -0,0 +1,155 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibility
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+)
+
+type VisibilitySinkChainSuite struct {
+	*require.Assertions // override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test, not merely log an error
+	suite.Suite
+	controller *gomock.Controller
+
+	dbVisibilityManager *MockVisibilityManager
+	esVisibilityManager *MockVisibilityManager
+	kafkaSink           *MockVisibilityManager
+
+	writingMode     string
+	sinks           map[string]string
+	bestEffortSinks string
+	client          VisibilityManager
+}
+
+func TestVisibilitySinkChainSuite(t *testing.T) {
+	suite.Run(t, new(VisibilitySinkChainSuite))
+}
+
+func (s *VisibilitySinkChainSuite) SetupTest() {
+	s.Assertions = require.New(s.T()) // Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+
+	s.controller = gomock.NewController(s.T())
+	s.dbVisibilityManager = NewMockVisibilityManager(s.controller)
+	s.esVisibilityManager = NewMockVisibilityManager(s.controller)
+	s.kafkaSink = NewMockVisibilityManager(s.controller)
+
+	s.writingMode = common.AdvancedVisibilityWritingModeDual
+	s.sinks = make(map[string]string)
+	s.bestEffortSinks = SinkKafka
+	s.client = NewVisibilityManagerSinkChain(
+		s.dbVisibilityManager,
+		s.esVisibilityManager,
+		map[string]Sink{SinkKafka: s.kafkaSink},
+		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
+		func(...dynamicconfig.FilterOption) string { return s.writingMode },
+		func(namespace string) string { return s.sinks[namespace] },
+		func(namespace string) string { return s.bestEffortSinks },
+		metrics.NewNoopMetricsClient(),
+		log.NewNoopLogger(),
+	)
+}
+
+func (s *VisibilitySinkChainSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *VisibilitySinkChainSuite) TestWritingModeSinks() {
+	request := &UpsertWorkflowExecutionRequest{
+		VisibilityRequestBase: &VisibilityRequestBase{Namespace: testNamespace},
+	}
+
+	gomock.InOrder(
+		s.esVisibilityManager.EXPECT().UpsertWorkflowExecution(request).Return(nil),
+		s.dbVisibilityManager.EXPECT().UpsertWorkflowExecution(request).Return(nil),
+		s.kafkaSink.EXPECT().UpsertWorkflowExecution(request).Return(nil),
+	)
+	s.NoError(s.client.UpsertWorkflowExecution(request))
+
+	s.writingMode = common.AdvancedVisibilityWritingModeOff
+	gomock.InOrder(
+		s.dbVisibilityManager.EXPECT().UpsertWorkflowExecution(request).Return(nil),
+		s.kafkaSink.EXPECT().UpsertWorkflowExecution(request).Return(nil),
+	)
+	s.NoError(s.client.UpsertWorkflowExecution(request))
+
+	s.writingMode = "unknown"
+	s.IsType(&serviceerror.Internal{}, s.client.UpsertWorkflowExecution(request))
+}
+
+func (s *VisibilitySinkChainSuite) TestNamespaceSinks() {
+	s.sinks[testNamespace] = " kafka, es "
+	request := &RecordWorkflowExecutionStartedRequest{
+		VisibilityRequestBase: &VisibilityRequestBase{Namespace: testNamespace},
+	}
+	gomock.InOrder(
+		s.kafkaSink.EXPECT().RecordWorkflowExecutionStarted(request).Return(nil),
+		s.esVisibilityManager.EXPECT().RecordWorkflowExecutionStarted(request).Return(nil),
+	)
+	s.NoError(s.client.RecordWorkflowExecutionStarted(request))
+
+	s.sinks[testNamespace] = SinkNoop
+	s.NoError(s.client.RecordWorkflowExecutionStarted(request))
+
+	s.sinks[testNamespace] = "unknown"
+	s.IsType(&serviceerror.Internal{}, s.client.RecordWorkflowExecutionStarted(request))
+}
+
+func (s *VisibilitySinkChainSuite) TestSinkFailure() {
+	request := &RecordWorkflowExecutionClosedRequest{
+		VisibilityRequestBase: &VisibilityRequestBase{Namespace: testNamespace},
+	}
+	s.sinks[testNamespace] = "kafka,db"
+
+	// best effort sink failure is ignored
+	gomock.InOrder(
+		s.kafkaSink.EXPECT().RecordWorkflowExecutionClosed(request).Return(errors.New("kafka error")),
+		s.dbVisibilityManager.EXPECT().RecordWorkflowExecutionClosed(request).Return(nil),
+	)
+	s.NoError(s.client.RecordWorkflowExecutionClosed(request))
+
+	// other sink failure stops the chain
+	s.sinks[testNamespace] = "db,kafka"
+	dbErr := errors.New("db error")
+	s.dbVisibilityManager.EXPECT().RecordWorkflowExecutionClosed(request).Return(dbErr)
+	s.Equal(dbErr, s.client.RecordWorkflowExecutionClosed(request))
+}
+
+func (s *VisibilitySinkChainSuite) TestClose() {
+	s.dbVisibilityManager.EXPECT().Close()
+	s.esVisibilityManager.EXPECT().Close()
+	s.kafkaSink.EXPECT().Close()
+	s.client.Close()
+}
@@ -0,0 +1,79 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibility
+
+import (
+	"go.temporal.io/server/common/persistence"
+)
+
+const (
+	// SinkES writes visibility records to advanced visibility store (Elasticsearch)
+	SinkES = "es"
+	// SinkDB writes visibility records to standard visibility store (SQL or Cassandra)
+	SinkDB = "db"
+	// SinkKafka exports visibility records to Kafka
+	SinkKafka = "kafka"
+	// SinkNoop drops visibility records
+	SinkNoop = "noop"
+)
+
+type (
+	// Sink is the destination visibility records are written to. Every VisibilityManager is a Sink.
+	Sink interface {
+		persistence.Closeable
+		RecordWorkflowExecutionStarted(request *RecordWorkflowExecutionStartedRequest) error
+		RecordWorkflowExecutionClosed(request *RecordWorkflowExecutionClosedRequest) error
+		UpsertWorkflowExecution(request *UpsertWorkflowExecutionRequest) error
+		DeleteWorkflowExecution(request *VisibilityDeleteWorkflowExecutionRequest) error
+	}
+
+	noopSink struct{}
+)
+
+var _ Sink = (VisibilityManager)(nil)
+var _ Sink = (*noopSink)(nil)
+
+// NewNoopSink creates a sink which drops all visibility records
+func NewNoopSink() Sink {
+	return &noopSink{}
+}
+
+func (s *noopSink) Close() {}
+
+func (s *noopSink) RecordWorkflowExecutionStarted(_ *RecordWorkflowExecutionStartedRequest) error {
+	return nil
+}
+
+func (s *noopSink) RecordWorkflowExecutionClosed(_ *RecordWorkflowExecutionClosedRequest) error {
+	return nil
+}
+
+func (s *noopSink) UpsertWorkflowExecution(_ *UpsertWorkflowExecutionRequest) error {
+	return nil
+}
+
+func (s *noopSink) DeleteWorkflowExecution(_ *VisibilityDeleteWorkflowExecutionRequest) error {
+	return nil
+}
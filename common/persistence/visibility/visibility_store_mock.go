@@ -69,6 +69,21 @@ func (mr *MockVisibilityStoreMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockVisibilityStore)(nil).Close))
 }
 
+// CountClosedWorkflowExecutionsByTypeAndStatus mocks base method.
+func (m *MockVisibilityStore) CountClosedWorkflowExecutionsByTypeAndStatus(request *CountClosedWorkflowExecutionsByTypeAndStatusRequest) (*CountWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountClosedWorkflowExecutionsByTypeAndStatus", request)
+	ret0, _ := ret[0].(*CountWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountClosedWorkflowExecutionsByTypeAndStatus indicates an expected call of CountClosedWorkflowExecutionsByTypeAndStatus.
+func (mr *MockVisibilityStoreMockRecorder) CountClosedWorkflowExecutionsByTypeAndStatus(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountClosedWorkflowExecutionsByTypeAndStatus", reflect.TypeOf((*MockVisibilityStore)(nil).CountClosedWorkflowExecutionsByTypeAndStatus), request)
+}
+
 // CountWorkflowExecutions mocks base method.
 func (m *MockVisibilityStore) CountWorkflowExecutions(request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -157,6 +172,21 @@ func (mr *MockVisibilityStoreMockRecorder) ListClosedWorkflowExecutionsByType(re
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClosedWorkflowExecutionsByType", reflect.TypeOf((*MockVisibilityStore)(nil).ListClosedWorkflowExecutionsByType), request)
 }
 
+// ListClosedWorkflowExecutionsByTypeAndStatus mocks base method.
+func (m *MockVisibilityStore) ListClosedWorkflowExecutionsByTypeAndStatus(request *ListClosedWorkflowExecutionsByTypeAndStatusRequest) (*InternalListWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClosedWorkflowExecutionsByTypeAndStatus", request)
+	ret0, _ := ret[0].(*InternalListWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClosedWorkflowExecutionsByTypeAndStatus indicates an expected call of ListClosedWorkflowExecutionsByTypeAndStatus.
+func (mr *MockVisibilityStoreMockRecorder) ListClosedWorkflowExecutionsByTypeAndStatus(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClosedWorkflowExecutionsByTypeAndStatus", reflect.TypeOf((*MockVisibilityStore)(nil).ListClosedWorkflowExecutionsByTypeAndStatus), request)
+}
+
 // ListClosedWorkflowExecutionsByWorkflowID mocks base method.
 func (m *MockVisibilityStore) ListClosedWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*InternalListWorkflowExecutionsResponse, error) {
 	m.ctrl.T.Helper()
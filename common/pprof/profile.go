@@ -0,0 +1,105 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package pprof
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"runtime/pprof"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+)
+
+const (
+	// ProfileCPU is the name of the CPU profile, all other profiles are the ones of runtime/pprof
+	ProfileCPU = "cpu"
+
+	// DefaultCPUProfileDuration is the sampling duration of CPU profiles if none is requested
+	DefaultCPUProfileDuration = 10 * time.Second
+	// MaxCPUProfileDuration is the longest sampling duration of CPU profiles
+	MaxCPUProfileDuration = 30 * time.Second
+)
+
+var (
+	// ErrCPUProfileInProgress is returned if a CPU profile is requested while another one is being captured
+	ErrCPUProfileInProgress = serviceerror.NewResourceExhausted("cpu profile is already being captured")
+)
+
+// ValidateProfile returns an error if the profile is not a known one or the duration is out of bounds
+func ValidateProfile(name string, duration time.Duration) error {
+	if name != ProfileCPU && pprof.Lookup(name) == nil {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf("unknown profile %q, valid profiles are: %v", name, ProfileNames()))
+	}
+	if duration < 0 || duration > MaxCPUProfileDuration {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf("profile duration must be between 0 and %v", MaxCPUProfileDuration))
+	}
+	return nil
+}
+
+// ProfileNames returns the names of the profiles which can be captured
+func ProfileNames() []string {
+	names := []string{ProfileCPU}
+	for _, p := range pprof.Profiles() {
+		names = append(names, p.Name())
+	}
+	return names
+}
+
+// CaptureProfile returns the named profile of this process in the gzipped protobuf format read by `go tool pprof`.
+// CPU profiles are sampled for the given duration, or DefaultCPUProfileDuration if it is zero, all other profiles
+// are snapshots taken immediately.
+func CaptureProfile(ctx context.Context, name string, duration time.Duration) ([]byte, error) {
+	if err := ValidateProfile(name, duration); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if name != ProfileCPU {
+		if err := pprof.Lookup(name).WriteTo(&buf, 0); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+
+	if duration == 0 {
+		duration = DefaultCPUProfileDuration
+	}
+	if err := pprof.StartCPUProfile(&buf); err != nil {
+		// only one CPU profile can be captured by a process at a time
+		return nil, ErrCPUProfileInProgress
+	}
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		pprof.StopCPUProfile()
+		return buf.Bytes(), nil
+	case <-ctx.Done():
+		pprof.StopCPUProfile()
+		return nil, ctx.Err()
+	}
+}
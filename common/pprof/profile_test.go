@@ -0,0 +1,81 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package pprof
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateProfile(t *testing.T) {
+	require.NoError(t, ValidateProfile(ProfileCPU, time.Second))
+	require.NoError(t, ValidateProfile("heap", 0))
+	require.NoError(t, ValidateProfile("goroutine", 0))
+	require.Error(t, ValidateProfile("unknown", 0))
+	require.Error(t, ValidateProfile(ProfileCPU, -time.Second))
+	require.Error(t, ValidateProfile(ProfileCPU, MaxCPUProfileDuration+time.Second))
+}
+
+func TestCaptureProfile(t *testing.T) {
+	for _, name := range []string{ProfileCPU, "heap", "goroutine"} {
+		profile, err := CaptureProfile(context.Background(), name, 10*time.Millisecond)
+		require.NoError(t, err, name)
+		requireGzipped(t, profile)
+	}
+}
+
+func TestCaptureProfile_CPUProfileInProgress(t *testing.T) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = CaptureProfile(context.Background(), ProfileCPU, 200*time.Millisecond)
+	}()
+
+	require.Eventually(t, func() bool {
+		_, err := CaptureProfile(context.Background(), ProfileCPU, time.Millisecond)
+		return err == ErrCPUProfileInProgress
+	}, time.Second, 5*time.Millisecond)
+	<-done
+}
+
+func TestCaptureProfile_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := CaptureProfile(ctx, ProfileCPU, time.Second)
+	require.Equal(t, context.Canceled, err)
+}
+
+func requireGzipped(t *testing.T, profile []byte) {
+	r, err := gzip.NewReader(bytes.NewReader(profile))
+	require.NoError(t, err)
+	_, err = ioutil.ReadAll(r)
+	require.NoError(t, err)
+}
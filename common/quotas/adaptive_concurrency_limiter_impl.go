@@ -0,0 +1,167 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quotas
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	// concurrencyLimitSmoothing is the weight of the new limit estimate in the limit
+	concurrencyLimitSmoothing = 0.2
+	// concurrencyLimitBackoffRatio is applied to the limit when request is dropped due to overload
+	concurrencyLimitBackoffRatio = 0.9
+	// concurrencyLimitMinGradient limits how fast the limit decreases in case of latency spike
+	concurrencyLimitMinGradient = 0.5
+	// longRTTWindow and shortRTTWindow are numbers of samples in long (baseline) and short (current) latency averages
+	longRTTWindow  = 600
+	shortRTTWindow = 10
+)
+
+type (
+	// AdaptiveConcurrencyLimiterImpl is a gradient based concurrency limiter.
+	// It compares short term request latency with the long term baseline latency
+	// and reduces the limit when latency climbs (i.e. downstream persistence degrades),
+	// and grows the limit back when latency recovers.
+	AdaptiveConcurrencyLimiterImpl struct {
+		minLimitFn LimitFn
+		maxLimitFn LimitFn
+
+		sync.Mutex
+		limit    float64
+		inFlight int
+		longRTT  float64
+		shortRTT float64
+	}
+)
+
+var _ ConcurrencyLimiter = (*AdaptiveConcurrencyLimiterImpl)(nil)
+
+// NewAdaptiveConcurrencyLimiter returns a new adaptive concurrency limiter which starts at max limit
+func NewAdaptiveConcurrencyLimiter(
+	minLimitFn LimitFn,
+	maxLimitFn LimitFn,
+) *AdaptiveConcurrencyLimiterImpl {
+	return &AdaptiveConcurrencyLimiterImpl{
+		minLimitFn: minLimitFn,
+		maxLimitFn: maxLimitFn,
+		limit:      float64(maxLimitFn()),
+	}
+}
+
+func (l *AdaptiveConcurrencyLimiterImpl) TryAcquire() (func(latency time.Duration, dropped bool), bool) {
+	l.Lock()
+	defer l.Unlock()
+
+	l.limit = l.clampLimit(l.limit)
+	if l.inFlight >= int(l.limit) {
+		return nil, false
+	}
+	l.inFlight++
+
+	var once sync.Once
+	return func(latency time.Duration, dropped bool) {
+		once.Do(func() { l.release(latency, dropped) })
+	}, true
+}
+
+func (l *AdaptiveConcurrencyLimiterImpl) Limit() int {
+	l.Lock()
+	defer l.Unlock()
+
+	return int(l.limit)
+}
+
+func (l *AdaptiveConcurrencyLimiterImpl) InFlight() int {
+	l.Lock()
+	defer l.Unlock()
+
+	return l.inFlight
+}
+
+func (l *AdaptiveConcurrencyLimiterImpl) release(
+	latency time.Duration,
+	dropped bool,
+) {
+	l.Lock()
+	defer l.Unlock()
+
+	inFlight := l.inFlight
+	l.inFlight--
+
+	if dropped {
+		l.limit = l.clampLimit(l.limit * concurrencyLimitBackoffRatio)
+		return
+	}
+
+	rtt := float64(latency)
+	if rtt <= 0 {
+		return
+	}
+	if l.longRTT == 0 {
+		l.longRTT = rtt
+		l.shortRTT = rtt
+		return
+	}
+	l.shortRTT = ewma(l.shortRTT, rtt, shortRTTWindow)
+	l.longRTT = ewma(l.longRTT, rtt, longRTTWindow)
+
+	// If the baseline is much higher than the current latency, the latency has recovered
+	// after a long degradation, decay the baseline faster to not overestimate it.
+	if l.longRTT/l.shortRTT > 2 {
+		l.longRTT *= 0.95
+	}
+
+	// Don't grow the limit if it is not utilized, otherwise the limit grows unbounded while the service is idle.
+	if float64(inFlight)*2 < l.limit {
+		return
+	}
+
+	gradient := math.Max(concurrencyLimitMinGradient, math.Min(1.0, l.longRTT/l.shortRTT))
+	newLimit := l.limit*gradient + math.Sqrt(l.limit)
+	l.limit = l.clampLimit(l.limit*(1-concurrencyLimitSmoothing) + newLimit*concurrencyLimitSmoothing)
+}
+
+func (l *AdaptiveConcurrencyLimiterImpl) clampLimit(
+	limit float64,
+) float64 {
+	minLimit := float64(l.minLimitFn())
+	maxLimit := float64(l.maxLimitFn())
+	if minLimit > maxLimit {
+		minLimit = maxLimit
+	}
+	return math.Max(minLimit, math.Min(maxLimit, limit))
+}
+
+func ewma(
+	average float64,
+	sample float64,
+	window int,
+) float64 {
+	factor := 2 / float64(window+1)
+	return average*(1-factor) + sample*factor
+}
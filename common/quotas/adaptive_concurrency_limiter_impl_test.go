@@ -0,0 +1,148 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quotas
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	adaptiveConcurrencyLimiterSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		minLimit int
+		maxLimit int
+		limiter  *AdaptiveConcurrencyLimiterImpl
+	}
+)
+
+func TestAdaptiveConcurrencyLimiterSuite(t *testing.T) {
+	s := new(adaptiveConcurrencyLimiterSuite)
+	suite.Run(t, s)
+}
+
+func (s *adaptiveConcurrencyLimiterSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.minLimit = 10
+	s.maxLimit = 100
+	s.limiter = NewAdaptiveConcurrencyLimiter(
+		func() int { return s.minLimit },
+		func() int { return s.maxLimit },
+	)
+}
+
+func (s *adaptiveConcurrencyLimiterSuite) TestTryAcquire() {
+	s.maxLimit = 2
+	release1, ok := s.limiter.TryAcquire()
+	s.True(ok)
+	_, ok = s.limiter.TryAcquire()
+	s.True(ok)
+	_, ok = s.limiter.TryAcquire()
+	s.False(ok)
+	s.Equal(2, s.limiter.InFlight())
+
+	release1(time.Millisecond, false)
+	// release is idempotent
+	release1(time.Millisecond, false)
+	s.Equal(1, s.limiter.InFlight())
+
+	_, ok = s.limiter.TryAcquire()
+	s.True(ok)
+}
+
+func (s *adaptiveConcurrencyLimiterSuite) TestLatencyIncrease_ShedsLoad() {
+	releases := s.saturate(nil, 1000, 10*time.Millisecond)
+	s.Equal(s.maxLimit, s.limiter.Limit())
+
+	releases = s.saturate(releases, 50, 100*time.Millisecond)
+	degradedLimit := s.limiter.Limit()
+	s.Less(degradedLimit, s.maxLimit)
+	s.GreaterOrEqual(degradedLimit, s.minLimit)
+	_, ok := s.limiter.TryAcquire()
+	s.False(ok)
+
+	s.saturate(releases, 1000, 10*time.Millisecond)
+	s.Greater(s.limiter.Limit(), degradedLimit)
+}
+
+func (s *adaptiveConcurrencyLimiterSuite) TestDropped_BacksOff() {
+	release, ok := s.limiter.TryAcquire()
+	s.True(ok)
+	release(time.Millisecond, true)
+	s.Equal(90, s.limiter.Limit())
+
+	for i := 0; i < 100; i++ {
+		release, ok = s.limiter.TryAcquire()
+		s.True(ok)
+		release(time.Millisecond, true)
+	}
+	s.Equal(s.minLimit, s.limiter.Limit())
+}
+
+func (s *adaptiveConcurrencyLimiterSuite) TestDynamicLimits() {
+	s.maxLimit = 5
+	_, ok := s.limiter.TryAcquire()
+	s.True(ok)
+	s.Equal(5, s.limiter.Limit())
+
+	s.minLimit = 50
+	s.maxLimit = 200
+	_, ok = s.limiter.TryAcquire()
+	s.True(ok)
+	s.Equal(50, s.limiter.Limit())
+}
+
+// saturate keeps the limiter at its limit and releases requests one by one with given latency
+func (s *adaptiveConcurrencyLimiterSuite) saturate(
+	releases []func(time.Duration, bool),
+	count int,
+	latency time.Duration,
+) []func(time.Duration, bool) {
+	for i := 0; i < count; i++ {
+		for {
+			release, ok := s.limiter.TryAcquire()
+			if !ok {
+				break
+			}
+			releases = append(releases, release)
+		}
+		releases[0](latency, false)
+		releases = releases[1:]
+	}
+	for {
+		release, ok := s.limiter.TryAcquire()
+		if !ok {
+			break
+		}
+		releases = append(releases, release)
+	}
+	return releases
+}
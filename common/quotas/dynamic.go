@@ -35,6 +35,9 @@ type (
 	// BurstFn returns a int as the RPS
 	BurstFn func() int
 
+	// RequestRateFn returns a float64 as the RPS enforced for the request
+	RequestRateFn func(req Request) float64
+
 	// DynamicRateImpl stores the dynamic rate per second for rate limiter
 	DynamicRateImpl struct {
 		rate *atomic.Float64
@@ -0,0 +1,186 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quotas
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultIsolationGroup is the isolation group of namespaces which are not assigned to a group
+	DefaultIsolationGroup = "default"
+
+	// isolationGroupRecheckInterval bounds how long Acquire waits before checking the budget again,
+	// budgets are dynamic config and raising a budget doesn't release any slot
+	isolationGroupRecheckInterval = time.Second
+)
+
+type (
+	// IsolationGroupFn returns the isolation group of a namespace
+	IsolationGroupFn func(namespace string) string
+
+	// IsolationGroupBudgetsFn returns the concurrency budget of isolation groups keyed by group
+	IsolationGroupBudgetsFn func() map[string]interface{}
+
+	// IsolationGroupLimiter limits the number of tasks of each isolation group processed concurrently,
+	// so that namespaces of one group can't consume all task processing slots of a host.
+	IsolationGroupLimiter interface {
+		// Group returns the isolation group of namespace
+		Group(namespace string) string
+
+		// TryAcquire attempts to admit a task of the group. If the task is admitted,
+		// release must be called once the task is processed.
+		TryAcquire(group string) (release func(), ok bool)
+
+		// Acquire blocks until a task of the group is admitted or ctx is done.
+		Acquire(ctx context.Context, group string) (release func(), err error)
+
+		// InFlight returns the number of admitted tasks of the group which are not released yet
+		InFlight(group string) int
+	}
+
+	IsolationGroupLimiterImpl struct {
+		groupFn   IsolationGroupFn
+		budgetsFn IsolationGroupBudgetsFn
+
+		sync.Mutex
+		inFlight map[string]int
+		// releaseCh is closed and replaced each time a slot is released to wake up waiters
+		releaseCh chan struct{}
+	}
+)
+
+var _ IsolationGroupLimiter = (*IsolationGroupLimiterImpl)(nil)
+
+// NewIsolationGroupLimiter returns a new isolation group limiter. Groups without a positive budget are not limited.
+func NewIsolationGroupLimiter(
+	groupFn IsolationGroupFn,
+	budgetsFn IsolationGroupBudgetsFn,
+) *IsolationGroupLimiterImpl {
+	return &IsolationGroupLimiterImpl{
+		groupFn:   groupFn,
+		budgetsFn: budgetsFn,
+		inFlight:  make(map[string]int),
+		releaseCh: make(chan struct{}),
+	}
+}
+
+func (l *IsolationGroupLimiterImpl) Group(
+	namespace string,
+) string {
+	if group := l.groupFn(namespace); group != "" {
+		return group
+	}
+	return DefaultIsolationGroup
+}
+
+func (l *IsolationGroupLimiterImpl) TryAcquire(
+	group string,
+) (func(), bool) {
+	release, ok, _ := l.tryAcquire(group)
+	return release, ok
+}
+
+func (l *IsolationGroupLimiterImpl) Acquire(
+	ctx context.Context,
+	group string,
+) (func(), error) {
+	timer := time.NewTimer(isolationGroupRecheckInterval)
+	defer timer.Stop()
+
+	for {
+		release, ok, releaseCh := l.tryAcquire(group)
+		if ok {
+			return release, nil
+		}
+
+		select {
+		case <-releaseCh:
+		case <-timer.C:
+			timer.Reset(isolationGroupRecheckInterval)
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+func (l *IsolationGroupLimiterImpl) InFlight(
+	group string,
+) int {
+	l.Lock()
+	defer l.Unlock()
+
+	return l.inFlight[group]
+}
+
+func (l *IsolationGroupLimiterImpl) tryAcquire(
+	group string,
+) (func(), bool, <-chan struct{}) {
+	budget := l.budget(group)
+
+	l.Lock()
+	defer l.Unlock()
+
+	if budget > 0 && l.inFlight[group] >= budget {
+		return nil, false, l.releaseCh
+	}
+	l.inFlight[group]++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { l.release(group) })
+	}, true, nil
+}
+
+func (l *IsolationGroupLimiterImpl) release(
+	group string,
+) {
+	l.Lock()
+	defer l.Unlock()
+
+	l.inFlight[group]--
+	if l.inFlight[group] <= 0 {
+		delete(l.inFlight, group)
+	}
+	close(l.releaseCh)
+	l.releaseCh = make(chan struct{})
+}
+
+// budget returns the concurrency budget of the group, non-positive budget means the group is not limited
+func (l *IsolationGroupLimiterImpl) budget(
+	group string,
+) int {
+	switch budget := l.budgetsFn()[group].(type) {
+	case int:
+		return budget
+	case float64:
+		// budgets set through JSON are decoded as float64
+		return int(budget)
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,136 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quotas
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	isolationGroupLimiterSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		budgets map[string]interface{}
+		limiter *IsolationGroupLimiterImpl
+	}
+)
+
+func TestIsolationGroupLimiterSuite(t *testing.T) {
+	s := new(isolationGroupLimiterSuite)
+	suite.Run(t, s)
+}
+
+func (s *isolationGroupLimiterSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.budgets = map[string]interface{}{
+		"batch": 2,
+		// budgets set through JSON are float64
+		"reporting": float64(1),
+	}
+	s.limiter = NewIsolationGroupLimiter(
+		func(namespace string) string {
+			if namespace == "batch-namespace" {
+				return "batch"
+			}
+			return ""
+		},
+		func() map[string]interface{} { return s.budgets },
+	)
+}
+
+func (s *isolationGroupLimiterSuite) TestGroup() {
+	s.Equal("batch", s.limiter.Group("batch-namespace"))
+	s.Equal(DefaultIsolationGroup, s.limiter.Group("other-namespace"))
+}
+
+func (s *isolationGroupLimiterSuite) TestTryAcquire() {
+	release1, ok := s.limiter.TryAcquire("batch")
+	s.True(ok)
+	_, ok = s.limiter.TryAcquire("batch")
+	s.True(ok)
+	_, ok = s.limiter.TryAcquire("batch")
+	s.False(ok)
+	s.Equal(2, s.limiter.InFlight("batch"))
+
+	// other groups are not affected by the exhausted budget
+	_, ok = s.limiter.TryAcquire("reporting")
+	s.True(ok)
+	_, ok = s.limiter.TryAcquire("reporting")
+	s.False(ok)
+	for i := 0; i < 10; i++ {
+		_, ok = s.limiter.TryAcquire(DefaultIsolationGroup)
+		s.True(ok)
+	}
+
+	release1()
+	// release is idempotent
+	release1()
+	s.Equal(1, s.limiter.InFlight("batch"))
+	_, ok = s.limiter.TryAcquire("batch")
+	s.True(ok)
+}
+
+func (s *isolationGroupLimiterSuite) TestAcquire() {
+	release, err := s.limiter.Acquire(context.Background(), "reporting")
+	s.NoError(err)
+
+	acquiredCh := make(chan struct{})
+	go func() {
+		defer close(acquiredCh)
+		release, err := s.limiter.Acquire(context.Background(), "reporting")
+		s.NoError(err)
+		release()
+	}()
+
+	select {
+	case <-acquiredCh:
+		s.Fail("task must wait for a slot of its group")
+	case <-time.After(50 * time.Millisecond):
+	}
+	release()
+	select {
+	case <-acquiredCh:
+	case <-time.After(time.Second):
+		s.Fail("task must be admitted once a slot is released")
+	}
+}
+
+func (s *isolationGroupLimiterSuite) TestAcquire_Canceled() {
+	_, ok := s.limiter.TryAcquire("reporting")
+	s.True(ok)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := s.limiter.Acquire(ctx, "reporting")
+	s.Equal(context.Canceled, err)
+	s.Equal(1, s.limiter.InFlight("reporting"))
+}
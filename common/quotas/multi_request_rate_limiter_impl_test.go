@@ -0,0 +1,111 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quotas
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	multiRequestRateLimiterSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller        *gomock.Controller
+		firstRateLimiter  *MockRequestRateLimiter
+		secondRateLimiter *MockRequestRateLimiter
+		firstReservation  *MockReservation
+		secondReservation *MockReservation
+
+		rateLimiter *MultiRequestRateLimiterImpl
+	}
+)
+
+func TestMultiRequestRateLimiterSuite(t *testing.T) {
+	s := new(multiRequestRateLimiterSuite)
+	suite.Run(t, s)
+}
+
+func (s *multiRequestRateLimiterSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.firstRateLimiter = NewMockRequestRateLimiter(s.controller)
+	s.secondRateLimiter = NewMockRequestRateLimiter(s.controller)
+	s.firstReservation = NewMockReservation(s.controller)
+	s.secondReservation = NewMockReservation(s.controller)
+
+	s.rateLimiter = NewMultiRequestRateLimiter(s.firstRateLimiter, s.secondRateLimiter)
+}
+
+func (s *multiRequestRateLimiterSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *multiRequestRateLimiterSuite) TestAllow_AllAllowed() {
+	now := time.Now()
+	request := NewRequest("StartWorkflowExecution", 1, "namespace")
+
+	s.firstRateLimiter.EXPECT().Reserve(now, request).Return(s.firstReservation)
+	s.firstReservation.EXPECT().OK().Return(true).AnyTimes()
+	s.firstReservation.EXPECT().DelayFrom(now).Return(time.Duration(0))
+	s.secondRateLimiter.EXPECT().Reserve(now, request).Return(s.secondReservation)
+	s.secondReservation.EXPECT().OK().Return(true).AnyTimes()
+	s.secondReservation.EXPECT().DelayFrom(now).Return(time.Duration(0))
+
+	s.True(s.rateLimiter.Allow(now, request))
+}
+
+func (s *multiRequestRateLimiterSuite) TestAllow_SecondThrottled() {
+	now := time.Now()
+	request := NewRequest("StartWorkflowExecution", 1, "namespace")
+
+	s.firstRateLimiter.EXPECT().Reserve(now, request).Return(s.firstReservation)
+	s.firstReservation.EXPECT().OK().Return(true).AnyTimes()
+	s.firstReservation.EXPECT().DelayFrom(now).Return(time.Duration(0))
+	s.firstReservation.EXPECT().CancelAt(now)
+	s.secondRateLimiter.EXPECT().Reserve(now, request).Return(s.secondReservation)
+	s.secondReservation.EXPECT().OK().Return(true).AnyTimes()
+	s.secondReservation.EXPECT().DelayFrom(now).Return(time.Second)
+	s.secondReservation.EXPECT().CancelAt(now)
+
+	s.False(s.rateLimiter.Allow(now, request))
+}
+
+func (s *multiRequestRateLimiterSuite) TestReserve_FirstNotOK() {
+	now := time.Now()
+	request := NewRequest("StartWorkflowExecution", 1, "namespace")
+
+	s.firstRateLimiter.EXPECT().Reserve(now, request).Return(s.firstReservation)
+	s.firstReservation.EXPECT().OK().Return(false).AnyTimes()
+
+	reservation := s.rateLimiter.Reserve(now, request)
+	s.False(reservation.OK())
+}
@@ -0,0 +1,207 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quotas
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	clusterspb "go.temporal.io/server/api/cluster/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+//go:generate mockgen -copyright_file ../../LICENSE -package $GOPACKAGE -source $GOFILE -destination throttle_tracker_mock.go
+
+const (
+	// DefaultThrottleTrackerWindow is how long a caller is reported as throttled
+	// after its last rejected request
+	DefaultThrottleTrackerWindow = time.Minute
+
+	maxThrottledCallers = 1000
+)
+
+type (
+	// ThrottledCaller describes a caller which was recently rejected by a rate limiter
+	ThrottledCaller struct {
+		Limiter            string
+		Namespace          string
+		Identity           string
+		Rate               float64
+		ThrottledCount     int64
+		FirstThrottledTime time.Time
+		LastThrottledTime  time.Time
+	}
+
+	// ThrottleTracker keeps track of callers currently being throttled by rate limiters
+	ThrottleTracker interface {
+		// RecordThrottle records that a request from the given caller was rejected
+		// by the named limiter while it was enforcing the given rate
+		RecordThrottle(limiter string, namespace string, identity string, rate float64)
+
+		// ThrottledCallers returns the callers throttled within the tracking window,
+		// limited to the given namespace if it is not empty
+		ThrottledCallers(namespace string) []ThrottledCaller
+	}
+
+	// ThrottleTrackerImpl is a ThrottleTracker which forgets callers that have not
+	// been throttled within the tracking window
+	ThrottleTrackerImpl struct {
+		timeSource clock.TimeSource
+		window     time.Duration
+
+		sync.Mutex
+		callers map[throttledCallerKey]*ThrottledCaller
+	}
+
+	throttledCallerKey struct {
+		limiter   string
+		namespace string
+		identity  string
+	}
+)
+
+var _ ThrottleTracker = (*ThrottleTrackerImpl)(nil)
+
+// NewThrottleTracker returns a new throttle tracker
+func NewThrottleTracker(
+	timeSource clock.TimeSource,
+	window time.Duration,
+) *ThrottleTrackerImpl {
+	return &ThrottleTrackerImpl{
+		timeSource: timeSource,
+		window:     window,
+
+		callers: make(map[throttledCallerKey]*ThrottledCaller),
+	}
+}
+
+// RecordThrottle records that a request from the given caller was rejected
+// by the named limiter while it was enforcing the given rate
+func (t *ThrottleTrackerImpl) RecordThrottle(
+	limiter string,
+	namespace string,
+	identity string,
+	rate float64,
+) {
+	now := t.timeSource.Now()
+	key := throttledCallerKey{
+		limiter:   limiter,
+		namespace: namespace,
+		identity:  identity,
+	}
+
+	t.Lock()
+	defer t.Unlock()
+
+	caller, ok := t.callers[key]
+	if ok && now.Sub(caller.LastThrottledTime) > t.window {
+		delete(t.callers, key)
+		ok = false
+	}
+	if !ok {
+		if len(t.callers) >= maxThrottledCallers {
+			t.removeExpiredLocked(now)
+		}
+		if len(t.callers) >= maxThrottledCallers {
+			// identities are supplied by callers, do not let them grow the map without bound
+			return
+		}
+		caller = &ThrottledCaller{
+			Limiter:            limiter,
+			Namespace:          namespace,
+			Identity:           identity,
+			FirstThrottledTime: now,
+		}
+		t.callers[key] = caller
+	}
+	caller.Rate = rate
+	caller.ThrottledCount++
+	caller.LastThrottledTime = now
+}
+
+// ThrottledCallers returns the callers throttled within the tracking window,
+// limited to the given namespace if it is not empty
+func (t *ThrottleTrackerImpl) ThrottledCallers(
+	namespace string,
+) []ThrottledCaller {
+	now := t.timeSource.Now()
+
+	t.Lock()
+	t.removeExpiredLocked(now)
+	result := make([]ThrottledCaller, 0, len(t.callers))
+	for _, caller := range t.callers {
+		if namespace != "" && caller.Namespace != namespace {
+			continue
+		}
+		result = append(result, *caller)
+	}
+	t.Unlock()
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Limiter != result[j].Limiter {
+			return result[i].Limiter < result[j].Limiter
+		}
+		if result[i].Namespace != result[j].Namespace {
+			return result[i].Namespace < result[j].Namespace
+		}
+		return result[i].Identity < result[j].Identity
+	})
+	return result
+}
+
+func (t *ThrottleTrackerImpl) removeExpiredLocked(
+	now time.Time,
+) {
+	for key, caller := range t.callers {
+		if now.Sub(caller.LastThrottledTime) > t.window {
+			delete(t.callers, key)
+		}
+	}
+}
+
+// ThrottledCallersToProto converts throttled callers tracked by a host of the given service to proto
+func ThrottledCallersToProto(
+	callers []ThrottledCaller,
+	hostAddress string,
+	service string,
+) []*clusterspb.ThrottledCaller {
+	result := make([]*clusterspb.ThrottledCaller, 0, len(callers))
+	for _, caller := range callers {
+		result = append(result, &clusterspb.ThrottledCaller{
+			HostAddress:        hostAddress,
+			Service:            service,
+			Limiter:            caller.Limiter,
+			Namespace:          caller.Namespace,
+			Identity:           caller.Identity,
+			Rate:               caller.Rate,
+			ThrottledCount:     caller.ThrottledCount,
+			FirstThrottledTime: timestamp.TimePtr(caller.FirstThrottledTime),
+			LastThrottledTime:  timestamp.TimePtr(caller.LastThrottledTime),
+		})
+	}
+	return result
+}
@@ -0,0 +1,84 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: throttle_tracker.go
+
+// Package quotas is a generated GoMock package.
+package quotas
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockThrottleTracker is a mock of ThrottleTracker interface.
+type MockThrottleTracker struct {
+	ctrl     *gomock.Controller
+	recorder *MockThrottleTrackerMockRecorder
+}
+
+// MockThrottleTrackerMockRecorder is the mock recorder for MockThrottleTracker.
+type MockThrottleTrackerMockRecorder struct {
+	mock *MockThrottleTracker
+}
+
+// NewMockThrottleTracker creates a new mock instance.
+func NewMockThrottleTracker(ctrl *gomock.Controller) *MockThrottleTracker {
+	mock := &MockThrottleTracker{ctrl: ctrl}
+	mock.recorder = &MockThrottleTrackerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockThrottleTracker) EXPECT() *MockThrottleTrackerMockRecorder {
+	return m.recorder
+}
+
+// RecordThrottle mocks base method.
+func (m *MockThrottleTracker) RecordThrottle(limiter, namespace, identity string, rate float64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordThrottle", limiter, namespace, identity, rate)
+}
+
+// RecordThrottle indicates an expected call of RecordThrottle.
+func (mr *MockThrottleTrackerMockRecorder) RecordThrottle(limiter, namespace, identity, rate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordThrottle", reflect.TypeOf((*MockThrottleTracker)(nil).RecordThrottle), limiter, namespace, identity, rate)
+}
+
+// ThrottledCallers mocks base method.
+func (m *MockThrottleTracker) ThrottledCallers(namespace string) []ThrottledCaller {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ThrottledCallers", namespace)
+	ret0, _ := ret[0].([]ThrottledCaller)
+	return ret0
+}
+
+// ThrottledCallers indicates an expected call of ThrottledCallers.
+func (mr *MockThrottleTrackerMockRecorder) ThrottledCallers(namespace interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ThrottledCallers", reflect.TypeOf((*MockThrottleTracker)(nil).ThrottledCallers), namespace)
+}
@@ -0,0 +1,136 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quotas
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/clock"
+)
+
+type (
+	throttleTrackerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		timeSource *clock.EventTimeSource
+		tracker    *ThrottleTrackerImpl
+	}
+)
+
+func TestThrottleTrackerSuite(t *testing.T) {
+	s := new(throttleTrackerSuite)
+	suite.Run(t, s)
+}
+
+func (s *throttleTrackerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.timeSource = clock.NewEventTimeSource().Update(time.Unix(0, 0))
+	s.tracker = NewThrottleTracker(s.timeSource, time.Minute)
+}
+
+func (s *throttleTrackerSuite) TestRecordThrottle() {
+	start := s.timeSource.Now()
+	s.tracker.RecordThrottle("namespace_rps", "ns-1", "worker-1", 10)
+	s.timeSource.Update(start.Add(time.Second))
+	s.tracker.RecordThrottle("namespace_rps", "ns-1", "worker-1", 20)
+	s.tracker.RecordThrottle("namespace_rps", "ns-2", "", 5)
+	s.tracker.RecordThrottle("persistence", "", "", 100)
+
+	s.Equal([]ThrottledCaller{
+		{
+			Limiter:            "namespace_rps",
+			Namespace:          "ns-1",
+			Identity:           "worker-1",
+			Rate:               20,
+			ThrottledCount:     2,
+			FirstThrottledTime: start,
+			LastThrottledTime:  start.Add(time.Second),
+		},
+		{
+			Limiter:            "namespace_rps",
+			Namespace:          "ns-2",
+			Rate:               5,
+			ThrottledCount:     1,
+			FirstThrottledTime: start.Add(time.Second),
+			LastThrottledTime:  start.Add(time.Second),
+		},
+		{
+			Limiter:            "persistence",
+			Rate:               100,
+			ThrottledCount:     1,
+			FirstThrottledTime: start.Add(time.Second),
+			LastThrottledTime:  start.Add(time.Second),
+		},
+	}, s.tracker.ThrottledCallers(""))
+
+	callers := s.tracker.ThrottledCallers("ns-2")
+	s.Len(callers, 1)
+	s.Equal("ns-2", callers[0].Namespace)
+}
+
+func (s *throttleTrackerSuite) TestThrottledCallers_Expired() {
+	start := s.timeSource.Now()
+	s.tracker.RecordThrottle("namespace_rps", "ns-1", "", 10)
+	s.timeSource.Update(start.Add(30 * time.Second))
+	s.tracker.RecordThrottle("namespace_rps", "ns-2", "", 10)
+
+	s.timeSource.Update(start.Add(61 * time.Second))
+	callers := s.tracker.ThrottledCallers("")
+	s.Len(callers, 1)
+	s.Equal("ns-2", callers[0].Namespace)
+
+	s.timeSource.Update(start.Add(2 * time.Minute))
+	s.tracker.RecordThrottle("namespace_rps", "ns-2", "", 10)
+	callers = s.tracker.ThrottledCallers("")
+	s.Len(callers, 1)
+	s.Equal(int64(1), callers[0].ThrottledCount)
+	s.Equal(start.Add(2*time.Minute), callers[0].FirstThrottledTime)
+}
+
+func (s *throttleTrackerSuite) TestRecordThrottle_MaxCallers() {
+	for i := 0; i < maxThrottledCallers+10; i++ {
+		s.tracker.RecordThrottle("namespace_rps", "ns", strconv.Itoa(i), 10)
+	}
+	s.Len(s.tracker.ThrottledCallers(""), maxThrottledCallers)
+}
+
+func (s *throttleTrackerSuite) TestThrottleTrackingRateLimiter() {
+	rateLimiter := NewThrottleTrackingRateLimiter(NewRateLimiter(1, 1), s.tracker, "persistence")
+	s.True(rateLimiter.Allow())
+	s.False(rateLimiter.Allow())
+
+	callers := s.tracker.ThrottledCallers("")
+	s.Len(callers, 1)
+	s.Equal("persistence", callers[0].Limiter)
+	s.Equal(float64(1), callers[0].Rate)
+	s.Equal(int64(1), callers[0].ThrottledCount)
+}
@@ -0,0 +1,110 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quotas
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	// ThrottleTrackingRateLimiterImpl is a rate limiter which reports rejected
+	// requests to a throttle tracker
+	ThrottleTrackingRateLimiterImpl struct {
+		rateLimiter RateLimiter
+		tracker     ThrottleTracker
+		name        string
+	}
+)
+
+var _ RateLimiter = (*ThrottleTrackingRateLimiterImpl)(nil)
+
+// NewThrottleTrackingRateLimiter returns a rate limiter which reports requests
+// rejected by the given rate limiter to the throttle tracker under the given name
+func NewThrottleTrackingRateLimiter(
+	rateLimiter RateLimiter,
+	tracker ThrottleTracker,
+	name string,
+) *ThrottleTrackingRateLimiterImpl {
+	return &ThrottleTrackingRateLimiterImpl{
+		rateLimiter: rateLimiter,
+		tracker:     tracker,
+		name:        name,
+	}
+}
+
+// Allow immediately returns with true or false indicating if a rate limit
+// token is available or not
+func (r *ThrottleTrackingRateLimiterImpl) Allow() bool {
+	if !r.rateLimiter.Allow() {
+		r.recordThrottle()
+		return false
+	}
+	return true
+}
+
+// AllowN immediately returns with true or false indicating if n rate limit
+// token is available or not
+func (r *ThrottleTrackingRateLimiterImpl) AllowN(now time.Time, numToken int) bool {
+	if !r.rateLimiter.AllowN(now, numToken) {
+		r.recordThrottle()
+		return false
+	}
+	return true
+}
+
+// Reserve reserves a rate limit token
+func (r *ThrottleTrackingRateLimiterImpl) Reserve() Reservation {
+	return r.rateLimiter.Reserve()
+}
+
+// ReserveN reserves n rate limit token
+func (r *ThrottleTrackingRateLimiterImpl) ReserveN(now time.Time, numToken int) Reservation {
+	return r.rateLimiter.ReserveN(now, numToken)
+}
+
+// Wait waits up till deadline for a rate limit token
+func (r *ThrottleTrackingRateLimiterImpl) Wait(ctx context.Context) error {
+	return r.rateLimiter.Wait(ctx)
+}
+
+// WaitN waits up till deadline for n rate limit token
+func (r *ThrottleTrackingRateLimiterImpl) WaitN(ctx context.Context, numToken int) error {
+	return r.rateLimiter.WaitN(ctx, numToken)
+}
+
+// Rate returns the rate per second for this rate limiter
+func (r *ThrottleTrackingRateLimiterImpl) Rate() float64 {
+	return r.rateLimiter.Rate()
+}
+
+// Burst returns the burst for this rate limiter
+func (r *ThrottleTrackingRateLimiterImpl) Burst() int {
+	return r.rateLimiter.Burst()
+}
+
+func (r *ThrottleTrackingRateLimiterImpl) recordThrottle() {
+	r.tracker.RecordThrottle(r.name, "", "", r.rateLimiter.Rate())
+}
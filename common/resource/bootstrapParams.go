@@ -72,8 +72,11 @@ type (
 		ArchiverProvider             provider.ArchiverProvider
 		Authorizer                   authorization.Authorizer
 		ClaimMapper                  authorization.ClaimMapper
+		PayloadRedactor              authorization.PayloadRedactor
 		PersistenceServiceResolver   resolver.ServiceResolver
 		AudienceGetter               authorization.JWTAudienceMapper
+		AuditLogConfig               *config.AuditLog
+		PageTokenConfig              config.PageToken
 	}
 
 	// MembershipMonitorFactory provides a bootstrapped membership monitor
@@ -49,6 +49,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
+	"go.temporal.io/server/common/quotas"
 )
 
 type (
@@ -73,6 +74,7 @@ type (
 		GetPayloadSerializer() serialization.Serializer
 		GetMetricsClient() metrics.Client
 		GetArchiverProvider() provider.ArchiverProvider
+		GetThrottleTracker() quotas.ThrottleTracker
 
 		// membership infos
 
@@ -60,6 +60,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
+	"go.temporal.io/server/common/quotas"
 )
 
 type (
@@ -95,6 +96,7 @@ type (
 		metricsClient     metrics.Client
 		archivalMetadata  archiver.ArchivalMetadata
 		archiverProvider  provider.ArchiverProvider
+		throttleTracker   quotas.ThrottleTracker
 
 		// membership infos
 
@@ -132,8 +134,10 @@ type (
 		ringpopChannel *tchannel.Channel
 
 		// internal vars
-		runtimeMetricsReporter *metrics.RuntimeMetricsReporter
-		rpcFactory             common.RPCFactory
+		runtimeMetricsReporter   *metrics.RuntimeMetricsReporter
+		rpcFactory               common.RPCFactory
+		dynamicConfigClient      dynamicconfig.Client
+		unsubscribeDynamicConfig func()
 	}
 )
 
@@ -163,6 +167,8 @@ func New(
 
 	ringpopChannel := params.RPCFactory.GetRingpopChannel()
 
+	throttleTracker := quotas.NewThrottleTracker(clock.NewRealTimeSource(), quotas.DefaultThrottleTrackerWindow)
+
 	persistenceBean, err := persistenceClient.NewBeanFromFactory(persistenceClient.NewFactory(
 		&params.PersistenceConfig,
 		params.PersistenceServiceResolver,
@@ -179,6 +185,7 @@ func New(
 			}
 			return persistenceMaxQPS()
 		},
+		throttleTracker,
 		params.AbstractDatastoreFactory,
 		params.ClusterMetadataConfig.CurrentClusterName,
 		params.MetricsClient,
@@ -246,9 +253,13 @@ func New(
 		return nil, err
 	}
 
-	saProvider := persistence.NewSearchAttributesManager(clock.NewRealTimeSource(), persistenceBean.GetClusterMetadataManager())
+	saProvider := persistence.NewSearchAttributesManager(clock.NewRealTimeSource(), persistenceBean.GetClusterMetadataManager(), nil)
 
-	saManager := persistence.NewSearchAttributesManager(clock.NewRealTimeSource(), persistenceBean.GetClusterMetadataManager())
+	var saReplicationQueue persistence.NamespaceReplicationQueue
+	if clusterMetadata.IsGlobalNamespaceEnabled() {
+		saReplicationQueue = persistenceBean.GetNamespaceReplicationQueue()
+	}
+	saManager := persistence.NewSearchAttributesManager(clock.NewRealTimeSource(), persistenceBean.GetClusterMetadataManager(), saReplicationQueue)
 
 	visibilityMgr, err := visibilityManagerInitializer(
 		persistenceBean,
@@ -332,6 +343,7 @@ func New(
 		metricsClient:     params.MetricsClient,
 		archivalMetadata:  params.ArchivalMetadata,
 		archiverProvider:  params.ArchiverProvider,
+		throttleTracker:   throttleTracker,
 
 		// membership infos
 
@@ -375,7 +387,8 @@ func New(
 			logger,
 			params.InstanceID,
 		),
-		rpcFactory: params.RPCFactory,
+		rpcFactory:          params.RPCFactory,
+		dynamicConfigClient: params.DynamicConfigClient,
 	}
 	return impl, nil
 }
@@ -396,6 +409,7 @@ func (h *Impl) Start() {
 
 	h.membershipMonitor.Start()
 	h.namespaceCache.Start()
+	h.unsubscribeDynamicConfig = h.dynamicConfigClient.Subscribe(h.emitDynamicConfigChangeMetrics)
 
 	hostInfo, err := h.membershipMonitor.WhoAmI()
 	if err != nil {
@@ -420,6 +434,7 @@ func (h *Impl) Stop() {
 		return
 	}
 
+	h.unsubscribeDynamicConfig()
 	h.namespaceCache.Stop()
 	h.membershipMonitor.Stop()
 	h.ringpopChannel.Close()
@@ -430,6 +445,13 @@ func (h *Impl) Stop() {
 	}
 }
 
+func (h *Impl) emitDynamicConfigChangeMetrics(changedKeys []dynamicconfig.Key) {
+	for _, key := range changedKeys {
+		h.metricsClient.Scope(metrics.DynamicConfigScope, metrics.DynamicConfigKeyTag(key.String())).
+			IncCounter(metrics.DynamicConfigChangeCount)
+	}
+}
+
 // GetServiceName return service name
 func (h *Impl) GetServiceName() string {
 	return h.serviceName
@@ -482,6 +504,11 @@ func (h *Impl) GetArchiverProvider() provider.ArchiverProvider {
 	return h.archiverProvider
 }
 
+// GetThrottleTracker return throttle tracker
+func (h *Impl) GetThrottleTracker() quotas.ThrottleTracker {
+	return h.throttleTracker
+}
+
 // membership infos
 
 // GetMembershipMonitor return the membership monitor
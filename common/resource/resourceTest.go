@@ -54,6 +54,7 @@ import (
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/visibility"
 	esclient "go.temporal.io/server/common/persistence/visibility/elasticsearch/client"
+	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/common/searchattribute"
 )
 
@@ -73,6 +74,7 @@ type (
 		MetricsClient     metrics.Client
 		ArchivalMetadata  *archiver.MockArchivalMetadata
 		ArchiverProvider  *provider.MockArchiverProvider
+		ThrottleTracker   *quotas.MockThrottleTracker
 
 		// membership infos
 
@@ -183,6 +185,7 @@ func NewTest(
 		PayloadSerializer: serialization.NewSerializer(),
 		MetricsClient:     metrics.NewClient(scope, serviceMetricsIndex),
 		ArchivalMetadata:  archiver.NewMockArchivalMetadata(controller),
+		ThrottleTracker:   quotas.NewMockThrottleTracker(controller),
 		ArchiverProvider:  provider.NewMockArchiverProvider(controller),
 
 		// membership infos
@@ -291,6 +294,11 @@ func (s *Test) GetArchiverProvider() provider.ArchiverProvider {
 	return s.ArchiverProvider
 }
 
+// GetThrottleTracker for testing
+func (s *Test) GetThrottleTracker() quotas.ThrottleTracker {
+	return s.ThrottleTracker
+}
+
 // membership infos
 
 // GetMembershipMonitor for testing
@@ -39,6 +39,7 @@ type (
 		GetGRPCListener() net.Listener
 		GetRingpopChannel() *tchannel.Channel
 		CreateFrontendGRPCConnection(hostName string) *grpc.ClientConn
+		CreateRemoteFrontendGRPCConnection(rpcAddress string) *grpc.ClientConn
 		CreateInternodeGRPCConnection(hostName string) *grpc.ClientConn
 	}
 )
@@ -0,0 +1,128 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"os"
+	"time"
+
+	"go.temporal.io/server/common/config"
+)
+
+type (
+	// fileWatcher detects modifications of a set of files by comparing their size and modification time.
+	// Stat is followed through symlinks, so atomic symlink swaps (e.g. Kubernetes secret volume updates)
+	// are detected as well.
+	fileWatcher struct {
+		files  []string
+		states map[string]fileState
+	}
+
+	fileState struct {
+		exists  bool
+		size    int64
+		modTime time.Time
+	}
+)
+
+func newFileWatcher(files []string) *fileWatcher {
+
+	w := &fileWatcher{
+		files:  files,
+		states: make(map[string]fileState, len(files)),
+	}
+	for _, file := range files {
+		w.states[file] = statFile(file)
+	}
+	return w
+}
+
+// changed returns true if any of the watched files was created, removed or modified since the last call
+func (w *fileWatcher) changed() bool {
+
+	changed := false
+	for _, file := range w.files {
+		state := statFile(file)
+		if state != w.states[file] {
+			w.states[file] = state
+			changed = true
+		}
+	}
+	return changed
+}
+
+func statFile(file string) fileState {
+
+	info, err := os.Stat(file)
+	if err != nil {
+		return fileState{}
+	}
+	return fileState{
+		exists:  true,
+		size:    info.Size(),
+		modTime: info.ModTime(),
+	}
+}
+
+// tlsFiles returns the distinct certificate, key and CA files referenced by the TLS settings
+func tlsFiles(settings *config.RootTLS) []string {
+
+	var files []string
+	seen := make(map[string]struct{})
+	add := func(items ...string) {
+		for _, item := range items {
+			if item == "" {
+				continue
+			}
+			if _, ok := seen[item]; ok {
+				continue
+			}
+			seen[item] = struct{}{}
+			files = append(files, item)
+		}
+	}
+	addServer := func(server *config.ServerTLS) {
+		add(server.CertFile, server.KeyFile)
+		add(server.ClientCAFiles...)
+	}
+	addGroup := func(group *config.GroupTLS) {
+		addServer(&group.Server)
+		add(group.Client.RootCAFiles...)
+		for _, override := range group.PerHostOverrides {
+			override := override
+			addServer(&override)
+		}
+	}
+
+	addGroup(&settings.Internode)
+	addGroup(&settings.Frontend)
+	add(settings.SystemWorker.CertFile, settings.SystemWorker.KeyFile)
+	add(settings.SystemWorker.Client.RootCAFiles...)
+	for _, remote := range settings.RemoteClusters {
+		remote := remote
+		addGroup(&remote)
+	}
+	return files
+}
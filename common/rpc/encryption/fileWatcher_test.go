@@ -0,0 +1,88 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.temporal.io/server/common/config"
+)
+
+func TestFileWatcher(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "fileWatcher")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	certFile := filepath.Join(dir, "cert.pem")
+	missingFile := filepath.Join(dir, "missing.pem")
+	assert.NoError(ioutil.WriteFile(certFile, []byte("cert"), 0600))
+
+	watcher := newFileWatcher([]string{certFile, missingFile})
+	assert.False(watcher.changed())
+
+	assert.NoError(ioutil.WriteFile(certFile, []byte("rotated cert"), 0600))
+	assert.True(watcher.changed())
+	assert.False(watcher.changed())
+
+	assert.NoError(ioutil.WriteFile(missingFile, []byte("ca"), 0600))
+	assert.True(watcher.changed())
+	assert.False(watcher.changed())
+
+	assert.NoError(os.Remove(certFile))
+	assert.True(watcher.changed())
+	assert.False(watcher.changed())
+}
+
+func TestTLSFiles(t *testing.T) {
+	assert := assert.New(t)
+
+	settings := &config.RootTLS{
+		Internode: config.GroupTLS{
+			Server: config.ServerTLS{CertFile: "internode.pem", KeyFile: "internode.key", ClientCAFiles: []string{"ca.pem"}},
+			Client: config.ClientTLS{RootCAFiles: []string{"ca.pem"}},
+		},
+		Frontend: config.GroupTLS{
+			Server: config.ServerTLS{CertData: "data", KeyData: "data"},
+			PerHostOverrides: map[string]config.ServerTLS{
+				"host": {CertFile: "host.pem", KeyFile: "host.key"},
+			},
+		},
+		SystemWorker: config.WorkerTLS{CertFile: "worker.pem", KeyFile: "worker.key"},
+		RemoteClusters: map[string]config.GroupTLS{
+			"remote": {Client: config.ClientTLS{RootCAFiles: []string{"remote-ca.pem"}}},
+		},
+	}
+
+	assert.ElementsMatch([]string{
+		"internode.pem", "internode.key", "ca.pem", "host.pem", "host.key", "worker.pem", "worker.key", "remote-ca.pem",
+	}, tlsFiles(settings))
+}
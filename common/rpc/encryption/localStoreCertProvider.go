@@ -44,6 +44,12 @@ import (
 
 var _ CertProvider = (*localStoreCertProvider)(nil)
 var _ CertExpirationChecker = (*localStoreCertProvider)(nil)
+var _ certReloader = (*localStoreCertProvider)(nil)
+
+// certReloader is implemented by cert providers that can reload certificates on demand
+type certReloader interface {
+	reloadCerts()
+}
 
 type certCache struct {
 	serverCert          *tls.Certificate
@@ -530,28 +536,36 @@ func (s *localStoreCertProvider) refreshCerts() {
 	for {
 		select {
 		case <-s.stop:
-			break
+			return
 		case <-s.ticker.C:
 		}
 
-		newCerts, err := s.loadCerts()
-		if err != nil {
-			s.logger.Error("failed to load certificates", tag.Error(err))
-			continue
-		}
+		s.reloadCerts()
+	}
+}
 
-		s.RLock()
-		currentCerts := s.certs
-		s.RUnlock()
-		if currentCerts.isEqual(newCerts) {
-			continue
-		}
+func (s *localStoreCertProvider) reloadCerts() {
 
-		s.logger.Info("loaded new TLS certificates")
-		s.Lock()
-		s.certs = newCerts
-		s.Unlock()
+	if !s.isTLSEnabled() {
+		return
+	}
+	newCerts, err := s.loadCerts()
+	if err != nil {
+		s.logger.Error("failed to load certificates", tag.Error(err))
+		return
+	}
+
+	s.RLock()
+	currentCerts := s.certs
+	s.RUnlock()
+	if currentCerts.isEqual(newCerts) {
+		return
 	}
+
+	s.logger.Info("loaded new TLS certificates")
+	s.Lock()
+	s.certs = newCerts
+	s.Unlock()
 }
 
 func (s *localStoreCertProvider) isTLSEnabled() bool {
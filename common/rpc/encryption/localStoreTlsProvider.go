@@ -27,7 +27,9 @@ package encryption
 import (
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -40,8 +42,12 @@ import (
 )
 
 const (
-	metricCertsExpired  = "certificates_expired"
-	metricCertsExpiring = "certificates_expiring"
+	metricCertsExpired          = "certificates_expired"
+	metricCertsExpiring         = "certificates_expiring"
+	metricCertsTimeToExpiration = "certificates_time_to_expiration_seconds"
+
+	// certExpirationHorizon is wide enough for GetExpiringCerts to return every loaded certificate
+	certExpirationHorizon = 100 * 365 * 24 * time.Hour
 )
 
 type CertProviderFactory func(
@@ -63,15 +69,26 @@ type localStoreTlsProvider struct {
 
 	frontendPerHostCertProviderMap *localStorePerHostCertProviderMap
 
+	remoteClusterClients map[string]*remoteClusterClientTLS
+
 	cachedInternodeServerConfig *tls.Config
 	cachedInternodeClientConfig *tls.Config
 	cachedFrontendServerConfig  *tls.Config
 	cachedFrontendClientConfig  *tls.Config
 
-	ticker *time.Ticker
-	logger log.Logger
-	stop   chan bool
-	scope  tally.Scope
+	ticker      *time.Ticker
+	watchTicker *time.Ticker
+	watcher     *fileWatcher
+	logger      log.Logger
+	stop        chan bool
+	scope       tally.Scope
+}
+
+// remoteClusterClientTLS holds TLS settings and cached client config for connections to a single remote cluster host
+type remoteClusterClientTLS struct {
+	settings     config.GroupTLS
+	certProvider CertProvider
+	cachedConfig *tls.Config
 }
 
 var _ TLSConfigProvider = (*localStoreTlsProvider)(nil)
@@ -96,6 +113,8 @@ func NewLocalStoreTlsProvider(tlsConfig *config.RootTLS, scope tally.Scope, logg
 		workerCertProvider:          workerProvider,
 		frontendPerHostCertProviderMap: newLocalStorePerHostCertProviderMap(
 			tlsConfig.Frontend.PerHostOverrides, certProviderFactory, tlsConfig.RefreshInterval, logger),
+		remoteClusterClients: newRemoteClusterClients(
+			tlsConfig.RemoteClusters, certProviderFactory, tlsConfig.RefreshInterval, logger),
 		RWMutex:  sync.RWMutex{},
 		settings: tlsConfig,
 		scope:    scope,
@@ -105,15 +124,39 @@ func NewLocalStoreTlsProvider(tlsConfig *config.RootTLS, scope tally.Scope, logg
 	return provider, nil
 }
 
+func newRemoteClusterClients(
+	remoteClusters map[string]config.GroupTLS,
+	certProviderFactory CertProviderFactory,
+	refreshInterval time.Duration,
+	logger log.Logger,
+) map[string]*remoteClusterClientTLS {
+
+	clients := make(map[string]*remoteClusterClientTLS, len(remoteClusters))
+	for host, settings := range remoteClusters {
+		client := &remoteClusterClientTLS{settings: settings}
+		client.certProvider = certProviderFactory(&client.settings, nil, nil, refreshInterval, logger)
+		clients[strings.ToLower(host)] = client
+	}
+	return clients
+}
+
 func (s *localStoreTlsProvider) initialize() {
 
 	period := s.settings.ExpirationChecks.CheckInterval
-	if period != 0 {
+	watchInterval := s.settings.WatchInterval
+	if period != 0 || watchInterval != 0 {
 		s.stop = make(chan bool)
+	}
+	if period != 0 {
 		s.ticker = time.NewTicker(period)
 		s.checkCertExpiration() // perform initial check to emit metrics and logs right away
 		go s.timerCallback()
 	}
+	if watchInterval != 0 {
+		s.watcher = newFileWatcher(tlsFiles(s.settings))
+		s.watchTicker = time.NewTicker(watchInterval)
+		go s.watchCallback()
+	}
 }
 
 func (s *localStoreTlsProvider) Close() {
@@ -121,6 +164,9 @@ func (s *localStoreTlsProvider) Close() {
 	if s.ticker != nil {
 		s.ticker.Stop()
 	}
+	if s.watchTicker != nil {
+		s.watchTicker.Stop()
+	}
 	if s.stop != nil {
 		s.stop <- true
 		close(s.stop)
@@ -158,6 +204,25 @@ func (s *localStoreTlsProvider) GetFrontendClientConfig() (*tls.Config, error) {
 	)
 }
 
+// GetRemoteClusterClientConfig returns client TLS config for connecting to a remote cluster on the given host name.
+// Falls back to frontend client config if no settings are provided for the host.
+func (s *localStoreTlsProvider) GetRemoteClusterClientConfig(hostName string) (*tls.Config, error) {
+
+	remote, ok := s.remoteClusterClients[strings.ToLower(hostName)]
+	if !ok {
+		return s.GetFrontendClientConfig()
+	}
+	client := &remote.settings.Client
+	return s.getOrCreateConfig(
+		&remote.cachedConfig,
+		func() (*tls.Config, error) {
+			return newClientTLSConfig(remote.certProvider, client.ServerName,
+				remote.settings.IsEnabled(), false, !client.DisableHostVerification)
+		},
+		true,
+	)
+}
+
 func (s *localStoreTlsProvider) GetFrontendServerConfig() (*tls.Config, error) {
 	return s.getOrCreateConfig(
 		&s.cachedFrontendServerConfig,
@@ -190,6 +255,10 @@ func (s *localStoreTlsProvider) GetExpiringCerts(timeWindow time.Duration,
 	err = appendError(err, checkError)
 	checkError = checkExpiration(s.frontendPerHostCertProviderMap, timeWindow, expiring, expired)
 	err = appendError(err, checkError)
+	for _, remote := range s.remoteClusterClients {
+		checkError = checkExpiration(remote.certProvider, timeWindow, expiring, expired)
+		err = appendError(err, checkError)
+	}
 
 	return expiring, expired, err
 }
@@ -349,19 +418,56 @@ func newClientTLSConfig(clientProvider CertProvider, serverName string, isAuthRe
 		}
 	}
 
-	return auth.NewDynamicTLSClientConfig(
+	tlsConfig := auth.NewDynamicTLSClientConfig(
 		getCert,
 		serverCa,
 		serverName,
 		enableHostVerification,
-	), nil
+	)
+	if enableHostVerification && serverCa != nil && serverName != "" {
+		// RootCAs are fixed once the config is created, verify against the current CAs
+		// of the provider instead so that rotated CAs are picked up by new connections
+		tlsConfig.InsecureSkipVerify = true
+		tlsConfig.VerifyConnection = func(state tls.ConnectionState) error {
+			return verifyServerCertificate(state, serverName, func() (*x509.CertPool, error) {
+				return clientProvider.FetchServerRootCAsForClient(isWorker)
+			})
+		}
+	}
+	return tlsConfig, nil
+}
+
+func verifyServerCertificate(
+	state tls.ConnectionState,
+	serverName string,
+	fetchRootCAs func() (*x509.CertPool, error),
+) error {
+
+	if len(state.PeerCertificates) == 0 {
+		return errors.New("server did not present a certificate")
+	}
+	rootCAs, err := fetchRootCAs()
+	if err != nil {
+		return fmt.Errorf("failed to load client ca: %v", err)
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range state.PeerCertificates[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err = state.PeerCertificates[0].Verify(x509.VerifyOptions{
+		Roots:         rootCAs,
+		DNSName:       serverName,
+		Intermediates: intermediates,
+	})
+	return err
 }
 
 func (s *localStoreTlsProvider) timerCallback() {
 	for {
 		select {
 		case <-s.stop:
-			break
+			return
 		case <-s.ticker.C:
 		}
 
@@ -369,6 +475,66 @@ func (s *localStoreTlsProvider) timerCallback() {
 	}
 }
 
+func (s *localStoreTlsProvider) watchCallback() {
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-s.watchTicker.C:
+		}
+
+		if s.watcher.changed() {
+			s.logger.Info("detected modified TLS certificate files, reloading certificates")
+			s.reloadCerts()
+			s.updateTimeToExpiration()
+		}
+	}
+}
+
+// reloadCerts makes cert providers reload certificates and CAs, connections established
+// afterwards pick the new ones up since server and client configs fetch them on every handshake
+func (s *localStoreTlsProvider) reloadCerts() {
+
+	providers := []CertProvider{s.internodeCertProvider, s.frontendCertProvider, s.workerCertProvider}
+	for _, provider := range s.frontendPerHostCertProviderMap.certProviderCache {
+		providers = append(providers, provider)
+	}
+	for _, remote := range s.remoteClusterClients {
+		providers = append(providers, remote.certProvider)
+	}
+
+	for _, provider := range providers {
+		if reloader, ok := provider.(certReloader); ok {
+			reloader.reloadCerts()
+		}
+	}
+}
+
+// updateTimeToExpiration emits the time remaining until the earliest expiration of any loaded certificate
+func (s *localStoreTlsProvider) updateTimeToExpiration() {
+
+	if s.scope == nil {
+		return
+	}
+	expiring, expired, err := s.GetExpiringCerts(certExpirationHorizon)
+	if err != nil {
+		s.logger.Error(fmt.Sprintf("error while checking for certificate expiration: %v", err))
+		return
+	}
+
+	var earliest time.Time
+	for _, certs := range []CertExpirationMap{expiring, expired} {
+		for _, cert := range certs {
+			if earliest.IsZero() || cert.Expiration.Before(earliest) {
+				earliest = cert.Expiration
+			}
+		}
+	}
+	if !earliest.IsZero() {
+		s.scope.Gauge(metricCertsTimeToExpiration).Update(time.Until(earliest).Seconds())
+	}
+}
+
 func (s *localStoreTlsProvider) checkCertExpiration() {
 
 	defer func() {
@@ -376,6 +542,8 @@ func (s *localStoreTlsProvider) checkCertExpiration() {
 		log.CapturePanic(s.logger, &retError)
 	}()
 
+	s.updateTimeToExpiration()
+
 	var errorTime time.Time
 	if s.settings.ExpirationChecks.ErrorWindow != 0 {
 		errorTime = time.Now().UTC().Add(s.settings.ExpirationChecks.ErrorWindow)
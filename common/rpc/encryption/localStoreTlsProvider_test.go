@@ -0,0 +1,152 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+)
+
+func TestGetRemoteClusterClientConfig(t *testing.T) {
+	assert := assert.New(t)
+
+	tlsConfig := &config.RootTLS{
+		RemoteClusters: map[string]config.GroupTLS{
+			"Remote.Example.com": {
+				Client: config.ClientTLS{
+					ServerName: "remote-frontend",
+				},
+			},
+		},
+	}
+	provider, err := NewLocalStoreTlsProvider(tlsConfig, nil, log.NewNoopLogger(), NewLocalStoreCertProvider)
+	assert.NoError(err)
+
+	remoteConfig, err := provider.GetRemoteClusterClientConfig("remote.example.com")
+	assert.NoError(err)
+	assert.NotNil(remoteConfig)
+	assert.Equal("remote-frontend", remoteConfig.ServerName)
+	assert.Nil(remoteConfig.GetClientCertificate)
+
+	cachedConfig, err := provider.GetRemoteClusterClientConfig("remote.example.com")
+	assert.NoError(err)
+	assert.Same(remoteConfig, cachedConfig)
+
+	// unknown host falls back to frontend client config, which is disabled here
+	otherConfig, err := provider.GetRemoteClusterClientConfig("other.example.com")
+	assert.NoError(err)
+	assert.Nil(otherConfig)
+}
+
+func TestReloadCerts(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "reloadCerts")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	certFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+	cert, err := GenerateSelfSignedUseEverywhereX509("localhost", 2048)
+	assert.NoError(err)
+	writeCertFiles(t, cert, certFile, keyFile)
+
+	tlsConfig := &config.RootTLS{
+		Frontend: config.GroupTLS{
+			Server: config.ServerTLS{CertFile: certFile, KeyFile: keyFile},
+		},
+	}
+	tlsProvider, err := NewLocalStoreTlsProvider(tlsConfig, nil, log.NewNoopLogger(), NewLocalStoreCertProvider)
+	assert.NoError(err)
+	provider := tlsProvider.(*localStoreTlsProvider)
+
+	serverCert, err := provider.frontendCertProvider.FetchServerCertificate()
+	assert.NoError(err)
+	assert.Equal(cert.Certificate, serverCert.Certificate)
+
+	rotatedCert, err := GenerateSelfSignedUseEverywhereX509("localhost", 2048)
+	assert.NoError(err)
+	writeCertFiles(t, rotatedCert, certFile, keyFile)
+	provider.reloadCerts()
+
+	serverCert, err = provider.frontendCertProvider.FetchServerCertificate()
+	assert.NoError(err)
+	assert.Equal(rotatedCert.Certificate, serverCert.Certificate)
+}
+
+func TestVerifyServerCertificate(t *testing.T) {
+	assert := assert.New(t)
+
+	ca, err := GenerateSelfSignedX509CA("test-ca", nil, 2048)
+	assert.NoError(err)
+	otherCA, err := GenerateSelfSignedX509CA("other-ca", nil, 2048)
+	assert.NoError(err)
+	serverCert, _, err := GenerateServerX509UsingCA("localhost", ca)
+	assert.NoError(err)
+
+	leaf, err := x509.ParseCertificate(serverCert.Certificate[0])
+	assert.NoError(err)
+	state := tls.ConnectionState{PeerCertificates: []*x509.Certificate{leaf}}
+	rootCAs := x509.NewCertPool()
+	fetchRootCAs := func() (*x509.CertPool, error) { return rootCAs, nil }
+
+	assert.Error(verifyServerCertificate(state, "localhost", fetchRootCAs))
+
+	// CAs are fetched on every verification, so rotated CAs are trusted right away
+	caCert, err := x509.ParseCertificate(ca.Certificate[0])
+	assert.NoError(err)
+	rootCAs.AddCert(caCert)
+	assert.NoError(verifyServerCertificate(state, "localhost", fetchRootCAs))
+	assert.Error(verifyServerCertificate(state, "other.example.com", fetchRootCAs))
+
+	otherCACert, err := x509.ParseCertificate(otherCA.Certificate[0])
+	assert.NoError(err)
+	rootCAs = x509.NewCertPool()
+	rootCAs.AddCert(otherCACert)
+	assert.Error(verifyServerCertificate(state, "localhost", fetchRootCAs))
+
+	assert.Error(verifyServerCertificate(tls.ConnectionState{}, "localhost", fetchRootCAs))
+}
+
+func writeCertFiles(t *testing.T, cert *tls.Certificate, certFile string, keyFile string) {
+	certBytes := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Certificate[0]})
+	keyBytes := pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(cert.PrivateKey.(*rsa.PrivateKey)),
+	})
+	assert.NoError(t, ioutil.WriteFile(certFile, certBytes, 0600))
+	assert.NoError(t, ioutil.WriteFile(keyFile, keyBytes, 0600))
+}
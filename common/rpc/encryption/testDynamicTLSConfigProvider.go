@@ -68,6 +68,10 @@ func (t *TestDynamicTLSConfigProvider) GetFrontendClientConfig() (*tls.Config, e
 	return newClientTLSConfig(t.WorkerCertProvider, t.settings.Frontend.Client.ServerName, true, false, true)
 }
 
+func (t *TestDynamicTLSConfigProvider) GetRemoteClusterClientConfig(hostName string) (*tls.Config, error) {
+	return t.GetFrontendClientConfig()
+}
+
 func (t *TestDynamicTLSConfigProvider) GetExpiringCerts(timeWindow time.Duration) (expiring CertExpirationMap, expired CertExpirationMap, err error) {
 	panic("implement me")
 }
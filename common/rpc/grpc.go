@@ -27,6 +27,7 @@ package rpc
 import (
 	"context"
 	"crypto/tls"
+	"io"
 	"time"
 
 	"github.com/gogo/status"
@@ -35,6 +36,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
+	"go.temporal.io/server/common/tracing"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/backoff"
 	"google.golang.org/grpc/credentials"
@@ -82,10 +84,15 @@ func Dial(hostName string, tlsConfig *tls.Config, logger log.Logger) (*grpc.Clie
 		grpcSecureOpt,
 		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxInternodeRecvPayloadSize)),
 		grpc.WithChainUnaryInterceptor(
+			tracing.NewClientTracingInterceptor(),
 			versionHeadersInterceptor,
 			metrics.NewClientMetricsTrailerPropagatorInterceptor(logger),
 			errorInterceptor,
 		),
+		grpc.WithChainStreamInterceptor(
+			versionHeadersStreamInterceptor,
+			errorStreamInterceptor,
+		),
 		grpc.WithDefaultServiceConfig(DefaultServiceConfig),
 		grpc.WithDisableServiceConfig(),
 		grpc.WithConnectParams(cp),
@@ -131,3 +138,61 @@ func ServiceErrorInterceptor(
 	resp, err := handler(ctx, req)
 	return resp, serviceerror.ToStatus(err).Err()
 }
+
+// ServiceErrorStreamInterceptor converts service errors returned by stream handlers to gRPC status errors.
+func ServiceErrorStreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	_ *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	return serviceerror.ToStatus(handler(srv, ss)).Err()
+}
+
+func errorStreamInterceptor(
+	ctx context.Context,
+	desc *grpc.StreamDesc,
+	cc *grpc.ClientConn,
+	method string,
+	streamer grpc.Streamer,
+	opts ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	stream, err := streamer(ctx, desc, cc, method, opts...)
+	if err != nil {
+		return nil, serviceerrors.FromStatus(status.Convert(err))
+	}
+	return &errorClientStream{ClientStream: stream}, nil
+}
+
+func versionHeadersStreamInterceptor(
+	ctx context.Context,
+	desc *grpc.StreamDesc,
+	cc *grpc.ClientConn,
+	method string,
+	streamer grpc.Streamer,
+	opts ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	ctx = headers.PropagateVersions(ctx)
+	return streamer(ctx, desc, cc, method, opts...)
+}
+
+// errorClientStream converts errors received on client stream to service errors.
+// io.EOF is returned as is because it signals normal end of the stream.
+type errorClientStream struct {
+	grpc.ClientStream
+}
+
+func (s *errorClientStream) SendMsg(m interface{}) error {
+	return convertStreamError(s.ClientStream.SendMsg(m))
+}
+
+func (s *errorClientStream) RecvMsg(m interface{}) error {
+	return convertStreamError(s.ClientStream.RecvMsg(m))
+}
+
+func convertStreamError(err error) error {
+	if err == nil || err == io.EOF {
+		return err
+	}
+	return serviceerrors.FromStatus(status.Convert(err))
+}
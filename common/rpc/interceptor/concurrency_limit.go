@@ -0,0 +1,107 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	"google.golang.org/grpc"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/quotas"
+)
+
+var (
+	ErrConcurrencyLimitServerBusy = serviceerror.NewResourceExhausted("service concurrency limit exceeded")
+)
+
+type (
+	// ConcurrencyLimitInterceptor sheds requests exceeding the adaptive concurrency limit.
+	// Unlike RPS limits, the concurrency limit reacts to downstream (i.e. persistence) degradation:
+	// when request latency climbs the limit decreases and excessive requests are rejected early.
+	ConcurrencyLimitInterceptor struct {
+		limiter      quotas.ConcurrencyLimiter
+		enabledFn    func() bool
+		excludedAPIs map[string]struct{}
+		logger       log.Logger
+	}
+)
+
+var _ grpc.UnaryServerInterceptor = (*ConcurrencyLimitInterceptor)(nil).Intercept
+
+func NewConcurrencyLimitInterceptor(
+	limiter quotas.ConcurrencyLimiter,
+	enabledFn func() bool,
+	excludedAPIs map[string]struct{},
+	logger log.Logger,
+) *ConcurrencyLimitInterceptor {
+	return &ConcurrencyLimitInterceptor{
+		limiter:      limiter,
+		enabledFn:    enabledFn,
+		excludedAPIs: excludedAPIs,
+		logger:       logger,
+	}
+}
+
+func (ci *ConcurrencyLimitInterceptor) Intercept(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if !ci.enabledFn() {
+		return handler(ctx, req)
+	}
+
+	// long poll latency doesn't reflect service load
+	_, methodName := splitMethodName(info.FullMethod)
+	if _, ok := ci.excludedAPIs[methodName]; ok {
+		return handler(ctx, req)
+	}
+
+	release, ok := ci.limiter.TryAcquire()
+	if !ok {
+		scope := MetricsScope(ctx, ci.logger)
+		scope.IncCounter(metrics.ServiceErrConcurrencyLimitedCounter)
+		scope.UpdateGauge(metrics.ServiceConcurrencyLimit, float64(ci.limiter.Limit()))
+		return nil, ErrConcurrencyLimitServerBusy
+	}
+
+	startTime := time.Now().UTC()
+	resp, err := handler(ctx, req)
+	release(time.Since(startTime), isOverloadError(err))
+	return resp, err
+}
+
+func isOverloadError(err error) bool {
+	switch err.(type) {
+	case *serviceerror.ResourceExhausted, *serviceerror.DeadlineExceeded:
+		return true
+	}
+	return err == context.DeadlineExceeded
+}
@@ -32,11 +32,16 @@ import (
 	"google.golang.org/grpc"
 
 	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/quotas"
 )
 
 const (
 	NamespaceRateLimitDefaultToken = 1
+
+	// NamespaceRateLimitThrottleLimiterName is the limiter name reported to the throttle tracker
+	NamespaceRateLimitThrottleLimiterName = "namespace_rps"
 )
 
 var (
@@ -45,9 +50,12 @@ var (
 
 type (
 	NamespaceRateLimitInterceptor struct {
-		namespaceCache cache.NamespaceCache
-		rateLimiter    quotas.RequestRateLimiter
-		tokens         map[string]int
+		namespaceCache  cache.NamespaceCache
+		rateLimiter     quotas.RequestRateLimiter
+		rateFn          quotas.RequestRateFn
+		tokens          map[string]int
+		throttleTracker quotas.ThrottleTracker
+		logger          log.Logger
 	}
 )
 
@@ -56,12 +64,18 @@ var _ grpc.UnaryServerInterceptor = (*NamespaceRateLimitInterceptor)(nil).Interc
 func NewNamespaceRateLimitInterceptor(
 	namespaceCache cache.NamespaceCache,
 	rateLimiter quotas.RequestRateLimiter,
+	rateFn quotas.RequestRateFn,
 	tokens map[string]int,
+	throttleTracker quotas.ThrottleTracker,
+	logger log.Logger,
 ) *NamespaceRateLimitInterceptor {
 	return &NamespaceRateLimitInterceptor{
-		namespaceCache: namespaceCache,
-		rateLimiter:    rateLimiter,
-		tokens:         tokens,
+		namespaceCache:  namespaceCache,
+		rateLimiter:     rateLimiter,
+		rateFn:          rateFn,
+		tokens:          tokens,
+		throttleTracker: throttleTracker,
+		logger:          logger,
 	}
 }
 
@@ -78,11 +92,19 @@ func (ni *NamespaceRateLimitInterceptor) Intercept(
 	}
 
 	namespace := GetNamespace(ni.namespaceCache, req)
-	if !ni.rateLimiter.Allow(time.Now().UTC(), quotas.NewRequest(
+	request := quotas.NewRequest(
 		methodName,
 		token,
 		namespace,
-	)) {
+	)
+	if !ni.rateLimiter.Allow(time.Now().UTC(), request) {
+		MetricsScope(ctx, ni.logger).IncCounter(metrics.ServiceErrNamespaceRateLimitedCounter)
+		ni.throttleTracker.RecordThrottle(
+			NamespaceRateLimitThrottleLimiterName,
+			namespace,
+			GetIdentity(req),
+			ni.rateFn(request),
+		)
 		return nil, ErrNamespaceRateLimitServerBusy
 	}
 	return handler(ctx, req)
@@ -0,0 +1,77 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+	"fmt"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"google.golang.org/grpc"
+
+	"go.temporal.io/server/common/cache"
+)
+
+type (
+	// NamespaceStateInterceptor rejects requests which would create new work in a deleted namespace,
+	// data of deleted namespace is being cleaned up asynchronously.
+	NamespaceStateInterceptor struct {
+		namespaceCache cache.NamespaceCache
+		rejectedAPIs   map[string]struct{}
+	}
+)
+
+var _ grpc.UnaryServerInterceptor = (*NamespaceStateInterceptor)(nil).Intercept
+
+func NewNamespaceStateInterceptor(
+	namespaceCache cache.NamespaceCache,
+	rejectedAPIs map[string]struct{},
+) *NamespaceStateInterceptor {
+	return &NamespaceStateInterceptor{
+		namespaceCache: namespaceCache,
+		rejectedAPIs:   rejectedAPIs,
+	}
+}
+
+func (ni *NamespaceStateInterceptor) Intercept(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	_, methodName := splitMethodName(info.FullMethod)
+	if _, ok := ni.rejectedAPIs[methodName]; ok {
+		namespace := GetNamespace(ni.namespaceCache, req)
+		if namespace != "" {
+			namespaceEntry, err := ni.namespaceCache.GetNamespace(namespace)
+			if err == nil && namespaceEntry.GetInfo().GetState() == enumspb.NAMESPACE_STATE_DELETED {
+				return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Namespace %v is deleted.", namespace))
+			}
+		}
+	}
+
+	return handler(ctx, req)
+}
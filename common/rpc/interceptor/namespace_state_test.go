@@ -0,0 +1,76 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/cluster"
+)
+
+func TestNamespaceStateInterceptor(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	namespaceCache := cache.NewMockNamespaceCache(controller)
+	ni := NewNamespaceStateInterceptor(
+		namespaceCache,
+		map[string]struct{}{"StartWorkflowExecution": {}},
+	)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return req, nil }
+	startInfo := &grpc.UnaryServerInfo{FullMethod: "/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution"}
+	describeInfo := &grpc.UnaryServerInfo{FullMethod: "/temporal.api.workflowservice.v1.WorkflowService/DescribeWorkflowExecution"}
+
+	newEntry := func(state enumspb.NamespaceState) *cache.NamespaceCacheEntry {
+		return cache.NewLocalNamespaceCacheEntryForTest(
+			&persistencespb.NamespaceInfo{Name: "test-namespace", State: state},
+			&persistencespb.NamespaceConfig{},
+			cluster.TestCurrentClusterName,
+			nil,
+		)
+	}
+
+	namespaceCache.EXPECT().GetNamespace("test-namespace").Return(newEntry(enumspb.NAMESPACE_STATE_REGISTERED), nil)
+	_, err := ni.Intercept(context.Background(), &workflowservice.StartWorkflowExecutionRequest{Namespace: "test-namespace"}, startInfo, handler)
+	require.NoError(t, err)
+
+	namespaceCache.EXPECT().GetNamespace("test-namespace").Return(newEntry(enumspb.NAMESPACE_STATE_DELETED), nil)
+	_, err = ni.Intercept(context.Background(), &workflowservice.StartWorkflowExecutionRequest{Namespace: "test-namespace"}, startInfo, handler)
+	require.IsType(t, &serviceerror.InvalidArgument{}, err)
+
+	// read APIs are still allowed on deleted namespace
+	_, err = ni.Intercept(context.Background(), &workflowservice.DescribeWorkflowExecutionRequest{Namespace: "test-namespace"}, describeInfo, handler)
+	require.NoError(t, err)
+}
@@ -52,18 +52,24 @@ var (
 	}
 
 	matchingAPIExcluded = map[string]struct{}{
+		"GetHostProfile":          {},
 		"ListTaskQueuePartitions": {},
 	}
 
 	historyAPIExcluded = map[string]struct{}{
-		"CloseShard":                {},
-		"GetDLQMessages":            {},
-		"GetDLQReplicationMessages": {},
-		"GetReplicationMessages":    {},
-		"MergeDLQMessages":          {},
-		"PurgeDLQMessages":          {},
-		"RemoveTask":                {},
-		"SyncShardStatus":           {},
+		"CloseShard":                  {},
+		"DescribeVisibilityProcessor": {},
+		"GetDLQMessages":              {},
+		"GetDLQReplicationMessages":   {},
+		"GetReplicationMessages":      {},
+		"GetReplicationStatus":        {},
+		"GetHostProfile":              {},
+		"GetShardStats":               {},
+		"ListThrottledCallers":        {},
+		"MergeDLQMessages":            {},
+		"PurgeDLQMessages":            {},
+		"RemoveTask":                  {},
+		"SyncShardStatus":             {},
 	}
 )
 
@@ -31,11 +31,15 @@ import (
 	"go.temporal.io/api/serviceerror"
 	"google.golang.org/grpc"
 
+	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/quotas"
 )
 
 const (
 	RateLimitDefaultToken = 1
+
+	// RateLimitThrottleLimiterName is the limiter name reported to the throttle tracker
+	RateLimitThrottleLimiterName = "service_rps"
 )
 
 var (
@@ -44,20 +48,29 @@ var (
 
 type (
 	RateLimitInterceptor struct {
-		rateLimiter quotas.RequestRateLimiter
-		tokens      map[string]int
+		namespaceCache  cache.NamespaceCache
+		rateLimiter     quotas.RequestRateLimiter
+		rateFn          quotas.RequestRateFn
+		tokens          map[string]int
+		throttleTracker quotas.ThrottleTracker
 	}
 )
 
 var _ grpc.UnaryServerInterceptor = (*RateLimitInterceptor)(nil).Intercept
 
 func NewRateLimitInterceptor(
+	namespaceCache cache.NamespaceCache,
 	rateLimiter quotas.RequestRateLimiter,
+	rateFn quotas.RequestRateFn,
 	tokens map[string]int,
+	throttleTracker quotas.ThrottleTracker,
 ) *RateLimitInterceptor {
 	return &RateLimitInterceptor{
-		rateLimiter: rateLimiter,
-		tokens:      tokens,
+		namespaceCache:  namespaceCache,
+		rateLimiter:     rateLimiter,
+		rateFn:          rateFn,
+		tokens:          tokens,
+		throttleTracker: throttleTracker,
 	}
 }
 
@@ -73,11 +86,18 @@ func (i *RateLimitInterceptor) Intercept(
 		token = RateLimitDefaultToken
 	}
 
-	if !i.rateLimiter.Allow(time.Now().UTC(), quotas.NewRequest(
+	request := quotas.NewRequest(
 		methodName,
 		token,
 		"", // this interceptor layer does not throttle based on caller
-	)) {
+	)
+	if !i.rateLimiter.Allow(time.Now().UTC(), request) {
+		i.throttleTracker.RecordThrottle(
+			RateLimitThrottleLimiterName,
+			GetNamespace(i.namespaceCache, req),
+			GetIdentity(req),
+			i.rateFn(request),
+		)
 		return nil, RateLimitServerBusy
 	}
 	return handler(ctx, req)
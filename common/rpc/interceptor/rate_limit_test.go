@@ -0,0 +1,104 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/quotas"
+)
+
+type (
+	rateLimitInterceptorSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller      *gomock.Controller
+		rateLimiter     *quotas.MockRequestRateLimiter
+		throttleTracker *quotas.MockThrottleTracker
+		interceptor     *RateLimitInterceptor
+	}
+)
+
+func TestRateLimitInterceptorSuite(t *testing.T) {
+	s := new(rateLimitInterceptorSuite)
+	suite.Run(t, s)
+}
+
+func (s *rateLimitInterceptorSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.rateLimiter = quotas.NewMockRequestRateLimiter(s.controller)
+	s.throttleTracker = quotas.NewMockThrottleTracker(s.controller)
+	s.interceptor = NewRateLimitInterceptor(
+		cache.NewMockNamespaceCache(s.controller),
+		s.rateLimiter,
+		func(quotas.Request) float64 { return 100 },
+		map[string]int{},
+		s.throttleTracker,
+	)
+}
+
+func (s *rateLimitInterceptorSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *rateLimitInterceptorSuite) TestIntercept_Allowed() {
+	s.rateLimiter.EXPECT().Allow(gomock.Any(), gomock.Any()).Return(true)
+
+	resp, err := s.interceptor.Intercept(
+		context.Background(),
+		&workflowservice.PollWorkflowTaskQueueRequest{Namespace: "test-namespace", Identity: "worker"},
+		&grpc.UnaryServerInfo{FullMethod: "/temporal.api.workflowservice.v1.WorkflowService/PollWorkflowTaskQueue"},
+		func(ctx context.Context, req interface{}) (interface{}, error) { return "response", nil },
+	)
+	s.NoError(err)
+	s.Equal("response", resp)
+}
+
+func (s *rateLimitInterceptorSuite) TestIntercept_Throttled() {
+	s.rateLimiter.EXPECT().Allow(gomock.Any(), gomock.Any()).Return(false)
+	s.throttleTracker.EXPECT().RecordThrottle(RateLimitThrottleLimiterName, "test-namespace", "worker", float64(100))
+
+	_, err := s.interceptor.Intercept(
+		context.Background(),
+		&workflowservice.PollWorkflowTaskQueueRequest{Namespace: "test-namespace", Identity: "worker"},
+		&grpc.UnaryServerInfo{FullMethod: "/temporal.api.workflowservice.v1.WorkflowService/PollWorkflowTaskQueue"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			s.Fail("throttled request should not be handled")
+			return nil, nil
+		},
+	)
+	s.Equal(RateLimitServerBusy, err)
+}
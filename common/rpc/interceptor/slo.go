@@ -0,0 +1,72 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/slo"
+)
+
+type (
+	// SLOInterceptor feeds the outcome and latency of every request into the SLO tracker.
+	SLOInterceptor struct {
+		namespaceCache cache.NamespaceCache
+		tracker        *slo.Tracker
+	}
+)
+
+var _ grpc.UnaryServerInterceptor = (*SLOInterceptor)(nil).Intercept
+
+func NewSLOInterceptor(
+	namespaceCache cache.NamespaceCache,
+	tracker *slo.Tracker,
+) *SLOInterceptor {
+	return &SLOInterceptor{
+		namespaceCache: namespaceCache,
+		tracker:        tracker,
+	}
+}
+
+func (si *SLOInterceptor) Intercept(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if !si.tracker.Enabled() {
+		return handler(ctx, req)
+	}
+
+	startTime := time.Now().UTC()
+	resp, err := handler(ctx, req)
+	_, methodName := splitMethodName(info.FullMethod)
+	si.tracker.Record(methodName, GetNamespace(si.namespaceCache, req), time.Since(startTime), err)
+	return resp, err
+}
@@ -105,7 +105,9 @@ func (ti *TelemetryInterceptor) Intercept(
 	timerNoUserLatency := metricsScope.StartTimer(metrics.ServiceLatencyNoUserLatency)
 	defer timerNoUserLatency.Stop()
 
+	startTime := time.Now().UTC()
 	resp, err := handler(ctx, req)
+	metricsScope.RecordHistogramDuration(metrics.ServiceLatencyHistogram, time.Since(startTime))
 
 	if val, ok := metrics.ContextCounterGet(ctx, metrics.HistoryWorkflowExecutionCacheLatency); ok {
 		timerNoUserLatency.Subtract(time.Duration(val))
@@ -114,6 +114,14 @@ func (d *RPCFactory) GetInternodeClientTlsConfig() (*tls.Config, error) {
 	return nil, nil
 }
 
+func (d *RPCFactory) GetRemoteClusterClientTlsConfig(hostName string) (*tls.Config, error) {
+	if d.tlsFactory != nil {
+		return d.tlsFactory.GetRemoteClusterClientConfig(hostName)
+	}
+
+	return nil, nil
+}
+
 // GetGRPCListener returns cached dispatcher for gRPC inbound or creates one
 func (d *RPCFactory) GetGRPCListener() net.Listener {
 	if d.grpcListener != nil {
@@ -207,6 +215,24 @@ func (d *RPCFactory) CreateFrontendGRPCConnection(hostName string) *grpc.ClientC
 	return d.dial(hostName, tlsClientConfig)
 }
 
+// CreateRemoteFrontendGRPCConnection creates connection for gRPC calls to the frontend of a remote cluster
+func (d *RPCFactory) CreateRemoteFrontendGRPCConnection(rpcAddress string) *grpc.ClientConn {
+	var tlsClientConfig *tls.Config
+	if d.tlsFactory != nil {
+		hostName, _, err := net.SplitHostPort(rpcAddress)
+		if err != nil {
+			// address without port, use it as is
+			hostName = rpcAddress
+		}
+		tlsClientConfig, err = d.tlsFactory.GetRemoteClusterClientConfig(hostName)
+		if err != nil {
+			d.logger.Fatal("Failed to create tls config for grpc connection", tag.Error(err))
+		}
+	}
+
+	return d.dial(rpcAddress, tlsClientConfig)
+}
+
 // CreateInternodeGRPCConnection creates connection for gRPC calls
 func (d *RPCFactory) CreateInternodeGRPCConnection(hostName string) *grpc.ClientConn {
 	var tlsClientConfig *tls.Config
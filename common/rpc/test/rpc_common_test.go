@@ -28,6 +28,7 @@ import (
 	"context"
 	"crypto/tls"
 	"math/rand"
+	"net"
 	"strings"
 
 	"google.golang.org/grpc/credentials"
@@ -53,6 +54,7 @@ type ServerUsageType int32
 const (
 	Frontend ServerUsageType = iota
 	Internode
+	RemoteCluster
 )
 
 const (
@@ -166,7 +168,11 @@ func dialHelloAndGetTLSInfo(
 	logger := log.NewNoopLogger()
 	var cfg *tls.Config
 	var err error
-	if serverType == Internode {
+	if clientFactory.serverUsage == RemoteCluster {
+		host, _, splitErr := net.SplitHostPort(hostport)
+		s.NoError(splitErr)
+		cfg, err = clientFactory.GetRemoteClusterClientTlsConfig(host)
+	} else if serverType == Internode {
 		cfg, err = clientFactory.GetInternodeClientTlsConfig()
 	} else {
 		cfg, err = clientFactory.GetFrontendClientTlsConfig()
@@ -68,6 +68,8 @@ type localStoreRPCSuite struct {
 	internodeDynamicTLSFactory              *TestFactory
 	internodeMutualTLSRPCRefreshFactory     *TestFactory
 	frontendMutualTLSRPCRefreshFactory      *TestFactory
+	remoteClusterMutualTLSRPCFactory        *TestFactory
+	remoteClusterNoCertRPCFactory           *TestFactory
 
 	internodeCertDir        string
 	frontendCertDir         string
@@ -340,6 +342,44 @@ func (s *localStoreRPCSuite) setupFrontend() {
 	s.internodeDynamicTLSFactory = i(dynamicServerTLSFactory)
 
 	s.frontendMutualTLSRPCRefreshFactory = f(frontendMutualTLSRefreshFactory)
+
+	// frontend settings trust a different CA, so a successful connection means per-cluster settings were used
+	remoteClusterMutualTLS := config.RootTLS{
+		Frontend: s.frontendConfigAltRootCAOnly,
+		RemoteClusters: map[string]config.GroupTLS{
+			localhostIPv4: {
+				Server: config.ServerTLS{
+					CertFile: s.frontendClientChain.CertPubFile,
+					KeyFile:  s.frontendClientChain.CertKeyFile,
+				},
+				Client: config.ClientTLS{
+					RootCAFiles: []string{s.frontendChain.CaPubFile},
+				},
+			},
+		},
+	}
+	remoteClusterNoCert := config.RootTLS{
+		RemoteClusters: map[string]config.GroupTLS{
+			localhostIPv4: {
+				Client: config.ClientTLS{
+					RootCAFiles: []string{s.frontendChain.CaPubFile},
+				},
+			},
+		},
+	}
+
+	provider, err = encryption.NewTLSConfigProviderFromConfig(remoteClusterMutualTLS, nil, s.logger, nil)
+	s.NoError(err)
+	remoteClusterMutualTLSFactory := rpc.NewFactory(rpcTestCfgDefault, "tester", s.logger, provider)
+	s.NotNil(remoteClusterMutualTLSFactory)
+
+	provider, err = encryption.NewTLSConfigProviderFromConfig(remoteClusterNoCert, nil, s.logger, nil)
+	s.NoError(err)
+	remoteClusterNoCertFactory := rpc.NewFactory(rpcTestCfgDefault, "tester", s.logger, provider)
+	s.NotNil(remoteClusterNoCertFactory)
+
+	s.remoteClusterMutualTLSRPCFactory = r(remoteClusterMutualTLSFactory)
+	s.remoteClusterNoCertRPCFactory = r(remoteClusterNoCertFactory)
 }
 
 func (s *localStoreRPCSuite) setupInternode() {
@@ -505,6 +545,10 @@ func i(r *rpc.RPCFactory) *TestFactory {
 	return &TestFactory{serverUsage: Internode, RPCFactory: r}
 }
 
+func r(r *rpc.RPCFactory) *TestFactory {
+	return &TestFactory{serverUsage: RemoteCluster, RPCFactory: r}
+}
+
 func convertFileToBase64(file string) string {
 	fileBytes, err := ioutil.ReadFile(file)
 	if err != nil {
@@ -550,6 +594,14 @@ func (s *localStoreRPCSuite) TestMutualTLSSystemWorker() {
 	runHelloWorldTest(s.Suite, localhostIPv4, s.frontendSystemWorkerMutualTLSRPCFactory, s.frontendSystemWorkerMutualTLSRPCFactory, true)
 }
 
+func (s *localStoreRPCSuite) TestRemoteClusterMutualTLS() {
+	runHelloWorldTest(s.Suite, localhostIPv4, s.frontendSystemWorkerMutualTLSRPCFactory, s.remoteClusterMutualTLSRPCFactory, true)
+}
+
+func (s *localStoreRPCSuite) TestRemoteClusterMutualTLSButClientNoCert() {
+	runHelloWorldTest(s.Suite, localhostIPv4, s.frontendSystemWorkerMutualTLSRPCFactory, s.remoteClusterNoCertRPCFactory, false)
+}
+
 func (s *localStoreRPCSuite) TestDynamicServerTLSFrontend() {
 	s.testDynamicServerTLS(localhostIPv4, true)
 }
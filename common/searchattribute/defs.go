@@ -44,8 +44,11 @@ const (
 
 	TemporalChangeVersion = "TemporalChangeVersion"
 	BinaryChecksums       = "BinaryChecksums"
+	BuildIds              = "BuildIds"
 	BatcherNamespace      = "BatcherNamespace"
 	BatcherUser           = "BatcherUser"
+	ResetRunID            = "ResetRunId"
+	OriginalRunID         = "OriginalRunId"
 
 	MemoEncoding      = "MemoEncoding"
 	Memo              = "Memo"
@@ -72,8 +75,11 @@ var (
 	predefined = map[string]enumspb.IndexedValueType{
 		TemporalChangeVersion: enumspb.INDEXED_VALUE_TYPE_KEYWORD,
 		BinaryChecksums:       enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		BuildIds:              enumspb.INDEXED_VALUE_TYPE_KEYWORD,
 		BatcherNamespace:      enumspb.INDEXED_VALUE_TYPE_KEYWORD,
 		BatcherUser:           enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		ResetRunID:            enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		OriginalRunID:         enumspb.INDEXED_VALUE_TYPE_KEYWORD,
 	}
 
 	// reserved are internal field names that can't be used as search attribute names.
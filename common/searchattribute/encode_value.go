@@ -66,6 +66,11 @@ func DecodeValue(value *commonpb.Payload, t enumspb.IndexedValueType) (interface
 			return listVal, err
 		}
 		return val, nil
+	case IndexedValueTypeKeywordList:
+		// Unlike other types, only list of values is valid for KeywordList.
+		var val []string
+		err := payload.Decode(value, &val)
+		return val, err
 	case enumspb.INDEXED_VALUE_TYPE_INT:
 		var val int64
 		if err := payload.Decode(value, &val); err != nil {
@@ -126,3 +126,19 @@ func Test_EncodeValue(t *testing.T) {
 	assert.Equal(`"qwe"`, string(encodedPayload.GetData()))
 	assert.Equal("String", string(encodedPayload.Metadata["type"]))
 }
+
+func Test_DecodeValue_KeywordList(t *testing.T) {
+	assert := assert.New(t)
+
+	encodedPayload, err := EncodeValue([]string{"val1", "val2"}, IndexedValueTypeKeywordList)
+	assert.NoError(err)
+	assert.Equal("KeywordList", string(encodedPayload.Metadata["type"]))
+	decodedValue, err := DecodeValue(encodedPayload, enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED)
+	assert.NoError(err)
+	assert.Equal([]string{"val1", "val2"}, decodedValue)
+
+	// Single value is not valid for KeywordList.
+	decodedValue, err = DecodeValue(payload.EncodeString("val1"), IndexedValueTypeKeywordList)
+	assert.Error(err)
+	assert.Nil(decodedValue)
+}
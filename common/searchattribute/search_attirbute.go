@@ -29,6 +29,7 @@ package searchattribute
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
@@ -36,6 +37,11 @@ import (
 
 const (
 	MetadataType = "type"
+
+	// IndexedValueTypeKeywordList is the type of search attributes holding list of keywords.
+	// It is not part of the API enum yet and is registered in enum name maps by this package.
+	IndexedValueTypeKeywordList     enumspb.IndexedValueType = 7
+	indexedValueTypeKeywordListName                          = "KeywordList"
 )
 
 type (
@@ -46,6 +52,7 @@ type (
 	Manager interface {
 		Provider
 		SaveSearchAttributes(indexName string, newCustomSearchAttributes map[string]enumspb.IndexedValueType) error
+		ApplyReplicatedSearchAttributes(indexName string, customSearchAttributes map[string]enumspb.IndexedValueType, lastUpdateTime time.Time) (bool, error)
 	}
 )
 
@@ -54,6 +61,11 @@ var (
 	ErrInvalidType = errors.New("invalid search attribute type")
 )
 
+func init() {
+	enumspb.IndexedValueType_name[int32(IndexedValueTypeKeywordList)] = indexedValueTypeKeywordListName
+	enumspb.IndexedValueType_value[indexedValueTypeKeywordListName] = int32(IndexedValueTypeKeywordList)
+}
+
 // ApplyTypeMap set type for all valid search attributes which don't have it.
 // It doesn't do any validation and just skip invalid or already set search attributes.
 func ApplyTypeMap(searchAttributes *commonpb.SearchAttributes, typeMap NameTypeMap) {
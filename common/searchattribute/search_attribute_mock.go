@@ -30,6 +30,7 @@ package searchattribute
 
 import (
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	v1 "go.temporal.io/api/enums/v1"
@@ -96,6 +97,21 @@ func (m *MockManager) EXPECT() *MockManagerMockRecorder {
 	return m.recorder
 }
 
+// ApplyReplicatedSearchAttributes mocks base method.
+func (m *MockManager) ApplyReplicatedSearchAttributes(indexName string, customSearchAttributes map[string]v1.IndexedValueType, lastUpdateTime time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyReplicatedSearchAttributes", indexName, customSearchAttributes, lastUpdateTime)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyReplicatedSearchAttributes indicates an expected call of ApplyReplicatedSearchAttributes.
+func (mr *MockManagerMockRecorder) ApplyReplicatedSearchAttributes(indexName, customSearchAttributes, lastUpdateTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyReplicatedSearchAttributes", reflect.TypeOf((*MockManager)(nil).ApplyReplicatedSearchAttributes), indexName, customSearchAttributes, lastUpdateTime)
+}
+
 // GetSearchAttributes mocks base method.
 func (m *MockManager) GetSearchAttributes(indexName string, forceRefreshCache bool) (NameTypeMap, error) {
 	m.ctrl.T.Helper()
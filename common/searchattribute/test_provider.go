@@ -41,6 +41,8 @@ var (
 			"CustomDatetimeField": enumspb.INDEXED_VALUE_TYPE_DATETIME,
 			"CustomDoubleField":   enumspb.INDEXED_VALUE_TYPE_DOUBLE,
 			"CustomBoolField":     enumspb.INDEXED_VALUE_TYPE_BOOL,
+
+			"CustomKeywordListField": IndexedValueTypeKeywordList,
 		},
 	}
 )
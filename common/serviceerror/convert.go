@@ -51,6 +51,11 @@ func FromStatus(st *status.Status) error {
 		case *errordetails.TaskAlreadyStartedFailure:
 			return newTaskAlreadyStarted(st)
 		}
+	case codes.Unavailable:
+		switch errDetails.(type) {
+		case *errordetails.StickyWorkerUnavailableFailure:
+			return newStickyWorkerUnavailable(st)
+		}
 	case codes.Aborted:
 		switch errDetails := errDetails.(type) {
 		case *errordetails.ShardOwnershipLostFailure:
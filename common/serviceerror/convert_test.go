@@ -48,3 +48,15 @@ func TestFromToStatus(t *testing.T) {
 	assert.Equal(t, err.Message, solErr.Message)
 	assert.Equal(t, err.OwnerHost, solErr.OwnerHost)
 }
+
+func TestFromToStatus_StickyWorkerUnavailable(t *testing.T) {
+	err := NewStickyWorkerUnavailable()
+
+	st := serviceerror.ToStatus(err)
+	err1 := FromStatus(st)
+	var swuErr *StickyWorkerUnavailable
+	if !errors.As(err1, &swuErr) {
+		assert.Fail(t, "Returned error is not of type *StickyWorkerUnavailable")
+	}
+	assert.Equal(t, err.Error(), swuErr.Message)
+}
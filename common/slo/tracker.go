@@ -0,0 +1,333 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package slo
+
+import (
+	"sync"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/metrics"
+)
+
+const (
+	// ShortWindow is the rolling window used for fast burn alerting
+	ShortWindow = 5 * time.Minute
+	// LongWindow is the rolling window used for slow burn alerting, it is also the retention of tracked SLIs
+	LongWindow = time.Hour
+
+	bucketSize     = time.Minute
+	numBuckets     = int(LongWindow / bucketSize)
+	emitInterval   = 10 * time.Second
+	shortWindowTag = "5m"
+	longWindowTag  = "1h"
+)
+
+type (
+	// Config is the dynamic config of SLO tracking
+	Config struct {
+		Enabled                dynamicconfig.BoolPropertyFn
+		AvailabilityTarget     dynamicconfig.FloatPropertyFnWithNamespaceFilter
+		LatencyTarget          dynamicconfig.DurationPropertyFnWithNamespaceFilter
+		LatencyTargetOverrides dynamicconfig.MapPropertyFn
+		LatencyObjective       dynamicconfig.FloatPropertyFnWithNamespaceFilter
+	}
+
+	// Tracker computes rolling availability and latency SLIs per API and namespace
+	// and periodically emits them together with the error budget burn rates,
+	// i.e. the observed error ratio divided by the error ratio allowed by the target.
+	// A burn rate of 1 means the error budget is consumed exactly over the SLO period.
+	Tracker struct {
+		config          *Config
+		metricsClient   metrics.Client
+		scopes          map[string]int
+		latencyExcluded map[string]struct{}
+		timeSource      clock.TimeSource
+
+		sync.RWMutex
+		slis map[sliKey]*rollingSLI
+
+		shutdownChan chan struct{}
+		startOnce    sync.Once
+		stopOnce     sync.Once
+	}
+
+	sliKey struct {
+		api       string
+		namespace string
+	}
+
+	rollingSLI struct {
+		sync.Mutex
+		buckets [numBuckets]bucket
+	}
+
+	bucket struct {
+		index    int64
+		requests int64
+		failures int64
+		// requests not failed by the server exceeding the latency target
+		slow int64
+	}
+
+	sliSnapshot struct {
+		requests int64
+		failures int64
+		slow     int64
+	}
+)
+
+// NewTracker creates a new SLO tracker, scopes maps API names to the metrics scopes SLIs are emitted with,
+// latencyExcludedAPIs (i.e. long polls) only contribute to availability SLIs
+func NewTracker(
+	config *Config,
+	metricsClient metrics.Client,
+	scopes map[string]int,
+	latencyExcludedAPIs map[string]struct{},
+	timeSource clock.TimeSource,
+) *Tracker {
+	return &Tracker{
+		config:          config,
+		metricsClient:   metricsClient,
+		scopes:          scopes,
+		latencyExcluded: latencyExcludedAPIs,
+		timeSource:      timeSource,
+		slis:            make(map[sliKey]*rollingSLI),
+		shutdownChan:    make(chan struct{}),
+	}
+}
+
+// Start starts the periodic emission of SLI metrics
+func (t *Tracker) Start() {
+	t.startOnce.Do(func() {
+		go t.emitLoop()
+	})
+}
+
+// Stop stops the periodic emission of SLI metrics
+func (t *Tracker) Stop() {
+	t.stopOnce.Do(func() {
+		close(t.shutdownChan)
+	})
+}
+
+// Enabled returns whether SLO tracking is enabled
+func (t *Tracker) Enabled() bool {
+	return t.config.Enabled()
+}
+
+// Record records the outcome of a single request
+func (t *Tracker) Record(
+	api string,
+	namespace string,
+	latency time.Duration,
+	err error,
+) {
+	if _, ok := t.scopes[api]; !ok {
+		return
+	}
+
+	failed := IsServerFailure(err)
+	slow := false
+	if _, ok := t.latencyExcluded[api]; !ok && !failed {
+		slow = latency > t.latencyTarget(api, namespace)
+	}
+
+	index := t.timeSource.Now().UnixNano() / int64(bucketSize)
+	t.getOrCreateSLI(sliKey{api: api, namespace: namespace}).record(index, failed, slow)
+}
+
+func (t *Tracker) getOrCreateSLI(key sliKey) *rollingSLI {
+	t.RLock()
+	sli, ok := t.slis[key]
+	t.RUnlock()
+	if ok {
+		return sli
+	}
+
+	t.Lock()
+	defer t.Unlock()
+	if sli, ok := t.slis[key]; ok {
+		return sli
+	}
+	sli = &rollingSLI{}
+	t.slis[key] = sli
+	return sli
+}
+
+func (t *Tracker) latencyTarget(api string, namespace string) time.Duration {
+	if override, ok := t.config.LatencyTargetOverrides()[api]; ok {
+		if value, ok := override.(string); ok {
+			if target, err := time.ParseDuration(value); err == nil {
+				return target
+			}
+		}
+	}
+	return t.config.LatencyTarget(namespace)
+}
+
+func (t *Tracker) emitLoop() {
+	ticker := time.NewTicker(emitInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-t.shutdownChan:
+			return
+		case <-ticker.C:
+			if t.config.Enabled() {
+				t.emit()
+			}
+		}
+	}
+}
+
+func (t *Tracker) emit() {
+	index := t.timeSource.Now().UnixNano() / int64(bucketSize)
+
+	t.Lock()
+	slis := make(map[sliKey]*rollingSLI, len(t.slis))
+	for key, sli := range t.slis {
+		if sli.snapshot(index, numBuckets).requests == 0 {
+			// nothing left in the long window, stop tracking
+			delete(t.slis, key)
+			continue
+		}
+		slis[key] = sli
+	}
+	t.Unlock()
+
+	for key, sli := range slis {
+		t.emitWindow(key, shortWindowTag, sli.snapshot(index, int(ShortWindow/bucketSize)))
+		t.emitWindow(key, longWindowTag, sli.snapshot(index, numBuckets))
+	}
+}
+
+func (t *Tracker) emitWindow(
+	key sliKey,
+	window string,
+	snapshot sliSnapshot,
+) {
+	if snapshot.requests == 0 {
+		return
+	}
+
+	scope := t.metricsClient.Scope(t.scopes[key.api]).Tagged(
+		metrics.NamespaceTag(key.namespace),
+		metrics.SLOWindowTag(window),
+	)
+
+	errorRatio := float64(snapshot.failures) / float64(snapshot.requests)
+	scope.UpdateGauge(metrics.ServiceSLOAvailability, 1-errorRatio)
+	if burnRate, ok := burnRate(errorRatio, t.config.AvailabilityTarget(key.namespace)); ok {
+		scope.UpdateGauge(metrics.ServiceSLOAvailabilityBurnRate, burnRate)
+	}
+
+	if _, ok := t.latencyExcluded[key.api]; ok {
+		return
+	}
+	succeeded := snapshot.requests - snapshot.failures
+	if succeeded == 0 {
+		return
+	}
+	slowRatio := float64(snapshot.slow) / float64(succeeded)
+	scope.UpdateGauge(metrics.ServiceSLOLatency, 1-slowRatio)
+	if burnRate, ok := burnRate(slowRatio, t.config.LatencyObjective(key.namespace)); ok {
+		scope.UpdateGauge(metrics.ServiceSLOLatencyBurnRate, burnRate)
+	}
+}
+
+func (s *rollingSLI) record(
+	index int64,
+	failed bool,
+	slow bool,
+) {
+	s.Lock()
+	defer s.Unlock()
+
+	b := &s.buckets[index%int64(numBuckets)]
+	if b.index != index {
+		*b = bucket{index: index}
+	}
+	b.requests++
+	if failed {
+		b.failures++
+	}
+	if slow {
+		b.slow++
+	}
+}
+
+// snapshot aggregates the latest numBuckets buckets up to and including the given bucket index
+func (s *rollingSLI) snapshot(
+	index int64,
+	window int,
+) sliSnapshot {
+	s.Lock()
+	defer s.Unlock()
+
+	var result sliSnapshot
+	for _, b := range s.buckets {
+		if b.index > index || b.index <= index-int64(window) {
+			continue
+		}
+		result.requests += b.requests
+		result.failures += b.failures
+		result.slow += b.slow
+	}
+	return result
+}
+
+func burnRate(errorRatio float64, target float64) (float64, bool) {
+	if target <= 0 || target >= 1 {
+		return 0, false
+	}
+	return errorRatio / (1 - target), true
+}
+
+// IsServerFailure returns whether the request outcome counts against the availability SLO,
+// errors caused by the caller (e.g. invalid arguments, not found, throttling, cancellation) do not.
+func IsServerFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	if common.IsContextCanceledErr(err) {
+		return false
+	}
+	if common.IsContextDeadlineExceededErr(err) {
+		return true
+	}
+
+	switch err.(type) {
+	case *serviceerror.Internal, *serviceerror.DataLoss, *serviceerror.Unavailable:
+		return true
+	case serviceerror.ServiceError:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,169 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package slo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/metrics"
+)
+
+type (
+	trackerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		timeSource *clock.EventTimeSource
+		scope      tally.TestScope
+		tracker    *Tracker
+	}
+)
+
+const (
+	testAPI         = "StartWorkflowExecution"
+	testLongPollAPI = "PollWorkflowTaskQueue"
+	testNamespace   = "test-namespace"
+)
+
+func TestTrackerSuite(t *testing.T) {
+	s := new(trackerSuite)
+	suite.Run(t, s)
+}
+
+func (s *trackerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.timeSource = clock.NewEventTimeSource().Update(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+	s.scope = tally.NewTestScope("test", nil)
+	s.tracker = NewTracker(
+		&Config{
+			Enabled:            dynamicconfig.GetBoolPropertyFn(true),
+			AvailabilityTarget: func(namespace string) float64 { return 0.99 },
+			LatencyTarget:      dynamicconfig.GetDurationPropertyFnFilteredByNamespace(time.Second),
+			LatencyTargetOverrides: dynamicconfig.GetMapPropertyFn(map[string]interface{}{
+				testAPI: "100ms",
+			}),
+			LatencyObjective: func(namespace string) float64 { return 0.9 },
+		},
+		metrics.NewClient(s.scope, metrics.Frontend),
+		map[string]int{
+			testAPI:         metrics.FrontendStartWorkflowExecutionScope,
+			testLongPollAPI: metrics.FrontendPollWorkflowTaskQueueScope,
+		},
+		map[string]struct{}{testLongPollAPI: {}},
+		s.timeSource,
+	)
+}
+
+func (s *trackerSuite) TestEmit_AvailabilityAndLatency() {
+	for i := 0; i < 96; i++ {
+		s.tracker.Record(testAPI, testNamespace, 10*time.Millisecond, nil)
+	}
+	s.tracker.Record(testAPI, testNamespace, 10*time.Millisecond, serviceerror.NewInvalidArgument("invalid"))
+	s.tracker.Record(testAPI, testNamespace, time.Second, nil)
+	s.tracker.Record(testAPI, testNamespace, 10*time.Millisecond, serviceerror.NewInternal("internal"))
+	s.tracker.Record(testAPI, testNamespace, 10*time.Millisecond, serviceerror.NewUnavailable("unavailable"))
+
+	s.tracker.emit()
+
+	s.InDelta(0.98, s.gauge(testAPI, shortWindowTag, "service_slo_availability"), 1e-9)
+	s.InDelta(2, s.gauge(testAPI, shortWindowTag, "service_slo_availability_burn_rate"), 1e-9)
+	s.InDelta(1-1.0/98, s.gauge(testAPI, shortWindowTag, "service_slo_latency"), 1e-9)
+	s.InDelta(1.0/98/0.1, s.gauge(testAPI, shortWindowTag, "service_slo_latency_burn_rate"), 1e-9)
+	s.InDelta(0.98, s.gauge(testAPI, longWindowTag, "service_slo_availability"), 1e-9)
+}
+
+func (s *trackerSuite) TestEmit_ShortWindowExpires() {
+	s.tracker.Record(testAPI, testNamespace, 10*time.Millisecond, serviceerror.NewInternal("internal"))
+	s.timeSource.Update(s.timeSource.Now().Add(ShortWindow))
+	s.tracker.Record(testAPI, testNamespace, 10*time.Millisecond, nil)
+
+	s.tracker.emit()
+
+	s.InDelta(1, s.gauge(testAPI, shortWindowTag, "service_slo_availability"), 1e-9)
+	s.InDelta(0.5, s.gauge(testAPI, longWindowTag, "service_slo_availability"), 1e-9)
+}
+
+func (s *trackerSuite) TestEmit_LongWindowExpires() {
+	s.tracker.Record(testAPI, testNamespace, 10*time.Millisecond, nil)
+	s.timeSource.Update(s.timeSource.Now().Add(LongWindow))
+
+	s.tracker.emit()
+
+	s.Empty(s.tracker.slis)
+	s.Empty(s.scope.Snapshot().Gauges())
+}
+
+func (s *trackerSuite) TestEmit_LongPollExcludedFromLatency() {
+	s.tracker.Record(testLongPollAPI, testNamespace, time.Minute, nil)
+
+	s.tracker.emit()
+
+	s.InDelta(1, s.gauge(testLongPollAPI, shortWindowTag, "service_slo_availability"), 1e-9)
+	for _, gauge := range s.scope.Snapshot().Gauges() {
+		s.NotEqual("test.service_slo_latency", gauge.Name())
+	}
+}
+
+func (s *trackerSuite) TestRecord_UnknownAPI() {
+	s.tracker.Record("UnknownAPI", testNamespace, time.Millisecond, nil)
+	s.Empty(s.tracker.slis)
+}
+
+func (s *trackerSuite) TestIsServerFailure() {
+	s.False(IsServerFailure(nil))
+	s.False(IsServerFailure(context.Canceled))
+	s.False(IsServerFailure(serviceerror.NewInvalidArgument("")))
+	s.False(IsServerFailure(serviceerror.NewNotFound("")))
+	s.False(IsServerFailure(serviceerror.NewResourceExhausted("")))
+	s.True(IsServerFailure(context.DeadlineExceeded))
+	s.True(IsServerFailure(serviceerror.NewInternal("")))
+	s.True(IsServerFailure(serviceerror.NewUnavailable("")))
+	s.True(IsServerFailure(errors.New("uncategorized")))
+}
+
+func (s *trackerSuite) gauge(api string, window string, name string) float64 {
+	for _, gauge := range s.scope.Snapshot().Gauges() {
+		tags := gauge.Tags()
+		if gauge.Name() == "test."+name &&
+			tags[metrics.OperationTagName] == api &&
+			tags["namespace"] == testNamespace &&
+			tags[metrics.SLOWindowTagName] == window {
+			return gauge.Value()
+		}
+	}
+	s.FailNow("gauge not found", "%v %v %v", api, window, name)
+	return 0
+}
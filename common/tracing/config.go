@@ -0,0 +1,49 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tracing
+
+import (
+	"time"
+)
+
+type (
+	// Config contains the config items for exporting traces to an
+	// OpenTelemetry collector over OTLP/HTTP.
+	Config struct {
+		// Endpoint is the host and port of the OTLP/HTTP receiver of the collector.
+		Endpoint string `yaml:"endpoint" validate:"nonzero"`
+		// URLPath is the path spans are posted to. Defaults to "/v1/traces".
+		URLPath string `yaml:"urlPath"`
+		// Insecure disables TLS for the connection to the collector.
+		Insecure bool `yaml:"insecure"`
+		// Headers are sent with every export request, e.g. for authentication.
+		Headers map[string]string `yaml:"headers"`
+		// Timeout is the timeout of a single export. Defaults to 10 seconds.
+		Timeout time.Duration `yaml:"timeout"`
+		// BatchTimeout is the maximum delay before finished spans are exported.
+		// Defaults to 5 seconds.
+		BatchTimeout time.Duration `yaml:"batchTimeout"`
+	}
+)
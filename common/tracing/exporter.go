@@ -0,0 +1,241 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/label"
+	export "go.opentelemetry.io/otel/sdk/export/trace"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+const (
+	// DefaultURLPath is the default path of the OTLP/HTTP traces receiver.
+	DefaultURLPath = "/v1/traces"
+	// DefaultTimeout is the default timeout of a single export request.
+	DefaultTimeout = 10 * time.Second
+)
+
+type (
+	// Options contains the options of the OTLP span exporter.
+	Options struct {
+		// Endpoint is the host and port of the OTLP/HTTP receiver of the collector.
+		Endpoint string
+		// URLPath is the path spans are posted to. Defaults to DefaultURLPath.
+		URLPath string
+		// Insecure disables TLS.
+		Insecure bool
+		// Headers are sent with every export request.
+		Headers map[string]string
+		// Timeout is the timeout of a single export request. Defaults to DefaultTimeout.
+		Timeout time.Duration
+	}
+
+	// Exporter exports spans to an OpenTelemetry collector using OTLP/HTTP with JSON encoding.
+	Exporter struct {
+		client  *http.Client
+		url     string
+		headers map[string]string
+	}
+)
+
+var _ export.SpanExporter = (*Exporter)(nil)
+
+// NewExporter creates a new OTLP span exporter.
+func NewExporter(options Options) *Exporter {
+	scheme := "https"
+	if options.Insecure {
+		scheme = "http"
+	}
+	urlPath := options.URLPath
+	if urlPath == "" {
+		urlPath = DefaultURLPath
+	}
+	timeout := options.Timeout
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+	return &Exporter{
+		client:  &http.Client{Timeout: timeout},
+		url:     fmt.Sprintf("%s://%s%s", scheme, options.Endpoint, urlPath),
+		headers: options.Headers,
+	}
+}
+
+// ExportSpans posts the spans to the collector.
+func (e *Exporter) ExportSpans(ctx context.Context, spans []*export.SpanData) error {
+	if len(spans) == 0 {
+		return nil
+	}
+
+	body, err := json.Marshal(transform(spans))
+	if err != nil {
+		return err
+	}
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	httpRequest.Header.Set("Content-Type", "application/json")
+	for k, v := range e.headers {
+		httpRequest.Header.Set(k, v)
+	}
+
+	response, err := e.client.Do(httpRequest)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = response.Body.Close() }()
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		message, _ := ioutil.ReadAll(io.LimitReader(response.Body, 1024))
+		return fmt.Errorf("OTLP export to %s failed with status %s: %s", e.url, response.Status, message)
+	}
+	return nil
+}
+
+// Shutdown is a no-op, the exporter does not buffer spans.
+func (e *Exporter) Shutdown(_ context.Context) error {
+	return nil
+}
+
+func transform(spans []*export.SpanData) *exportTraceServiceRequest {
+	request := &exportTraceServiceRequest{}
+	resources := make(map[label.Distinct]*resourceSpans)
+	libraries := make(map[label.Distinct]map[instrumentation.Library]*instrumentationLibrarySpans)
+
+	for _, span := range spans {
+		var key label.Distinct
+		if span.Resource != nil {
+			key = span.Resource.Equivalent()
+		}
+		rs, ok := resources[key]
+		if !ok {
+			rs = &resourceSpans{}
+			if span.Resource != nil {
+				rs.Resource.Attributes = toKeyValues(span.Resource.Attributes())
+			}
+			resources[key] = rs
+			libraries[key] = make(map[instrumentation.Library]*instrumentationLibrarySpans)
+			request.ResourceSpans = append(request.ResourceSpans, rs)
+		}
+
+		ils, ok := libraries[key][span.InstrumentationLibrary]
+		if !ok {
+			ils = &instrumentationLibrarySpans{InstrumentationLibrary: instrumentationLibrary{
+				Name:    span.InstrumentationLibrary.Name,
+				Version: span.InstrumentationLibrary.Version,
+			}}
+			libraries[key][span.InstrumentationLibrary] = ils
+			rs.InstrumentationLibrarySpans = append(rs.InstrumentationLibrarySpans, ils)
+		}
+		ils.Spans = append(ils.Spans, toSpan(span))
+	}
+	return request
+}
+
+func toSpan(span *export.SpanData) *spanData {
+	result := &spanData{
+		TraceID:           span.SpanContext.TraceID.String(),
+		SpanID:            span.SpanContext.SpanID.String(),
+		Name:              span.Name,
+		Kind:              int(span.SpanKind),
+		StartTimeUnixNano: uint64s(span.StartTime.UnixNano()),
+		EndTimeUnixNano:   uint64s(span.EndTime.UnixNano()),
+		Attributes:        toKeyValues(span.Attributes),
+		Status:            toStatus(span.StatusCode, span.StatusMessage),
+	}
+	if span.ParentSpanID.IsValid() {
+		result.ParentSpanID = span.ParentSpanID.String()
+	}
+	for _, event := range span.MessageEvents {
+		result.Events = append(result.Events, spanEvent{
+			TimeUnixNano: uint64s(event.Time.UnixNano()),
+			Name:         event.Name,
+			Attributes:   toKeyValues(event.Attributes),
+		})
+	}
+	for _, link := range span.Links {
+		result.Links = append(result.Links, spanLink{
+			TraceID:    link.TraceID.String(),
+			SpanID:     link.SpanID.String(),
+			Attributes: toKeyValues(link.Attributes),
+		})
+	}
+	return result
+}
+
+func toStatus(code codes.Code, message string) spanStatus {
+	switch code {
+	case codes.Ok:
+		return spanStatus{Code: statusCodeOk}
+	case codes.Error:
+		return spanStatus{Code: statusCodeError, Message: message}
+	default:
+		return spanStatus{}
+	}
+}
+
+func toKeyValues(kvs []label.KeyValue) []keyValue {
+	var result []keyValue
+	for _, kv := range kvs {
+		result = append(result, keyValue{Key: string(kv.Key), Value: toAnyValue(kv.Value)})
+	}
+	return result
+}
+
+func toAnyValue(value label.Value) anyValue {
+	switch value.Type() {
+	case label.BOOL:
+		v := value.AsBool()
+		return anyValue{BoolValue: &v}
+	case label.INT32:
+		v := int64s(value.AsInt32())
+		return anyValue{IntValue: &v}
+	case label.INT64:
+		v := int64s(value.AsInt64())
+		return anyValue{IntValue: &v}
+	case label.UINT32:
+		v := int64s(value.AsUint32())
+		return anyValue{IntValue: &v}
+	case label.FLOAT32:
+		v := float64(value.AsFloat32())
+		return anyValue{DoubleValue: &v}
+	case label.FLOAT64:
+		v := value.AsFloat64()
+		return anyValue{DoubleValue: &v}
+	default:
+		v := value.Emit()
+		return anyValue{StringValue: &v}
+	}
+}
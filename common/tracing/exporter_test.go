@@ -0,0 +1,134 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel/label"
+	export "go.opentelemetry.io/otel/sdk/export/trace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type exporterSuite struct {
+	suite.Suite
+	server   *httptest.Server
+	requests []*exportTraceServiceRequest
+	headers  []http.Header
+	status   int
+}
+
+func TestExporterSuite(t *testing.T) {
+	suite.Run(t, new(exporterSuite))
+}
+
+func (s *exporterSuite) SetupTest() {
+	s.requests = nil
+	s.headers = nil
+	s.status = http.StatusOK
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.Equal(DefaultURLPath, r.URL.Path)
+		s.Equal("application/json", r.Header.Get("Content-Type"))
+		request := &exportTraceServiceRequest{}
+		s.NoError(json.NewDecoder(r.Body).Decode(request))
+		s.requests = append(s.requests, request)
+		s.headers = append(s.headers, r.Header)
+		w.WriteHeader(s.status)
+	}))
+}
+
+func (s *exporterSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *exporterSuite) newExporter() *Exporter {
+	return NewExporter(Options{
+		Endpoint: strings.TrimPrefix(s.server.URL, "http://"),
+		Insecure: true,
+		Headers:  map[string]string{"authorization": "Bearer token"},
+	})
+}
+
+func (s *exporterSuite) TestExportSpans() {
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithConfig(sdktrace.Config{DefaultSampler: sdktrace.AlwaysSample()}),
+		sdktrace.WithSyncer(s.newExporter()),
+		sdktrace.WithResource(resource.NewWithAttributes(label.String("service.name", "temporal"))),
+	)
+	tracer := provider.Tracer(instrumentationName)
+	ctx, parent := tracer.Start(context.Background(), "parent", trace.WithSpanKind(trace.SpanKindServer))
+	_, child := tracer.Start(ctx, "child", trace.WithAttributes(label.Int64("attempt", 2)))
+	EndSpan(child, errors.New("some error"))
+	parent.End()
+
+	s.Len(s.requests, 2)
+	s.Equal("Bearer token", s.headers[0].Get("authorization"))
+	rs := s.requests[0].ResourceSpans
+	s.Len(rs, 1)
+	s.Equal("service.name", rs[0].Resource.Attributes[0].Key)
+	s.Equal("temporal", *rs[0].Resource.Attributes[0].Value.StringValue)
+	s.Equal(instrumentationName, rs[0].InstrumentationLibrarySpans[0].InstrumentationLibrary.Name)
+
+	childSpan := rs[0].InstrumentationLibrarySpans[0].Spans[0]
+	parentSpan := s.requests[1].ResourceSpans[0].InstrumentationLibrarySpans[0].Spans[0]
+	s.Equal("child", childSpan.Name)
+	s.Equal(parent.SpanContext().TraceID.String(), childSpan.TraceID)
+	s.Equal(child.SpanContext().SpanID.String(), childSpan.SpanID)
+	s.Equal(parentSpan.SpanID, childSpan.ParentSpanID)
+	s.Equal(int(trace.SpanKindInternal), childSpan.Kind)
+	s.Equal("attempt", childSpan.Attributes[0].Key)
+	s.Equal(int64s(2), *childSpan.Attributes[0].Value.IntValue)
+	s.Equal(statusCodeError, childSpan.Status.Code)
+	s.Equal("some error", childSpan.Status.Message)
+	s.Len(childSpan.Events, 1)
+	s.True(childSpan.EndTimeUnixNano >= childSpan.StartTimeUnixNano)
+
+	s.Equal("parent", parentSpan.Name)
+	s.Empty(parentSpan.ParentSpanID)
+	s.Equal(int(trace.SpanKindServer), parentSpan.Kind)
+	s.Equal(0, parentSpan.Status.Code)
+}
+
+func (s *exporterSuite) TestExportSpans_Failure() {
+	s.status = http.StatusBadRequest
+	err := s.newExporter().ExportSpans(context.Background(), []*export.SpanData{{Name: "span"}})
+	s.Error(err)
+	s.Contains(err.Error(), "400")
+	s.Len(s.requests, 1)
+}
+
+func (s *exporterSuite) TestExportSpans_Empty() {
+	s.NoError(s.newExporter().ExportSpans(context.Background(), nil))
+	s.Empty(s.requests)
+}
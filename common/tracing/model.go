@@ -0,0 +1,137 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tracing
+
+import (
+	"strconv"
+)
+
+// JSON representation of the OTLP ExportTraceServiceRequest, following the
+// proto3 JSON mapping of opentelemetry-proto with the exception of trace and
+// span ids, which OTLP/HTTP encodes as hex strings.
+type (
+	exportTraceServiceRequest struct {
+		ResourceSpans []*resourceSpans `json:"resourceSpans"`
+	}
+
+	resourceSpans struct {
+		Resource                    resourceData                   `json:"resource"`
+		InstrumentationLibrarySpans []*instrumentationLibrarySpans `json:"instrumentationLibrarySpans"`
+	}
+
+	resourceData struct {
+		Attributes []keyValue `json:"attributes,omitempty"`
+	}
+
+	instrumentationLibrarySpans struct {
+		InstrumentationLibrary instrumentationLibrary `json:"instrumentationLibrary"`
+		Spans                  []*spanData            `json:"spans"`
+	}
+
+	instrumentationLibrary struct {
+		Name    string `json:"name,omitempty"`
+		Version string `json:"version,omitempty"`
+	}
+
+	spanData struct {
+		TraceID           string      `json:"traceId"`
+		SpanID            string      `json:"spanId"`
+		ParentSpanID      string      `json:"parentSpanId,omitempty"`
+		Name              string      `json:"name"`
+		Kind              int         `json:"kind"`
+		StartTimeUnixNano uint64s     `json:"startTimeUnixNano"`
+		EndTimeUnixNano   uint64s     `json:"endTimeUnixNano"`
+		Attributes        []keyValue  `json:"attributes,omitempty"`
+		Events            []spanEvent `json:"events,omitempty"`
+		Links             []spanLink  `json:"links,omitempty"`
+		Status            spanStatus  `json:"status"`
+	}
+
+	spanEvent struct {
+		TimeUnixNano uint64s    `json:"timeUnixNano"`
+		Name         string     `json:"name"`
+		Attributes   []keyValue `json:"attributes,omitempty"`
+	}
+
+	spanLink struct {
+		TraceID    string     `json:"traceId"`
+		SpanID     string     `json:"spanId"`
+		Attributes []keyValue `json:"attributes,omitempty"`
+	}
+
+	spanStatus struct {
+		Code    int    `json:"code"`
+		Message string `json:"message,omitempty"`
+	}
+
+	keyValue struct {
+		Key   string   `json:"key"`
+		Value anyValue `json:"value"`
+	}
+
+	anyValue struct {
+		StringValue *string  `json:"stringValue,omitempty"`
+		BoolValue   *bool    `json:"boolValue,omitempty"`
+		IntValue    *int64s  `json:"intValue,omitempty"`
+		DoubleValue *float64 `json:"doubleValue,omitempty"`
+	}
+
+	// int64s and uint64s are encoded as JSON strings as required by the proto3 JSON mapping
+	int64s  int64
+	uint64s uint64
+)
+
+const (
+	// statusCodeOk and statusCodeError are STATUS_CODE_OK and STATUS_CODE_ERROR
+	statusCodeOk    = 1
+	statusCodeError = 2
+)
+
+func (v int64s) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(strconv.FormatInt(int64(v), 10))), nil
+}
+
+func (v *int64s) UnmarshalJSON(data []byte) error {
+	parsed, err := strconv.ParseInt(unquote(data), 10, 64)
+	*v = int64s(parsed)
+	return err
+}
+
+func (v uint64s) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(strconv.FormatUint(uint64(v), 10))), nil
+}
+
+func (v *uint64s) UnmarshalJSON(data []byte) error {
+	parsed, err := strconv.ParseUint(unquote(data), 10, 64)
+	*v = uint64s(parsed)
+	return err
+}
+
+func unquote(data []byte) string {
+	if s, err := strconv.Unquote(string(data)); err == nil {
+		return s
+	}
+	return string(data)
+}
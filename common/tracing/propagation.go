@@ -0,0 +1,94 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type (
+	// mapCarrier adapts a trace context map, as persisted with tasks and task tokens, to a TextMapCarrier
+	mapCarrier map[string]string
+)
+
+// propagator is the W3C trace context propagator, it is used for gRPC metadata as well as for
+// the trace context persisted with tasks so both stay compatible with callers using other SDKs.
+var propagator = propagation.TraceContext{}
+
+var _ propagation.TextMapCarrier = mapCarrier(nil)
+
+// InjectTraceContext returns the trace context of the current span of ctx, or nil if ctx
+// does not carry a span which is being traced.
+func InjectTraceContext(ctx context.Context) map[string]string {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return nil
+	}
+	carrier := make(mapCarrier)
+	propagator.Inject(ctx, carrier)
+	return carrier
+}
+
+// StartSpanFromTraceContext starts a span continuing the trace described by traceContext.
+// The current span of ctx, if any, is linked to the new span instead of becoming its parent.
+// If traceContext is empty the span is a child of the current span of ctx.
+func StartSpanFromTraceContext(
+	ctx context.Context,
+	traceContext map[string]string,
+	name string,
+	opts ...trace.SpanOption,
+) (context.Context, trace.Span) {
+	if len(traceContext) == 0 {
+		return Tracer().Start(ctx, name, opts...)
+	}
+
+	if current := trace.SpanContextFromContext(ctx); current.IsValid() {
+		opts = append(opts, trace.WithLinks(trace.Link{SpanContext: current}))
+	}
+	// clear the current span so the remote span extracted from traceContext becomes the parent
+	ctx = trace.ContextWithSpan(ctx, nil)
+	ctx = propagator.Extract(ctx, mapCarrier(traceContext))
+	return Tracer().Start(ctx, name, opts...)
+}
+
+// EndSpan records err, if any, on span and ends it.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+func (c mapCarrier) Get(key string) string {
+	return c[key]
+}
+
+func (c mapCarrier) Set(key string, value string) {
+	c[key] = value
+}
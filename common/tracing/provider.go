@@ -0,0 +1,107 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tracing
+
+import (
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/label"
+	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+
+	"go.temporal.io/server/common/dynamicconfig"
+)
+
+const (
+	instrumentationName = "go.temporal.io/server"
+	serviceName         = "temporal"
+
+	defaultBatchTimeout = 5 * time.Second
+
+	// DefaultSampleRatio is the default ratio of traces started by the server which are sampled
+	DefaultSampleRatio = 0.01
+)
+
+type (
+	// ratioSampler samples the given ratio of traces, the ratio is read from dynamic config on every decision
+	ratioSampler struct {
+		ratio dynamicconfig.FloatPropertyFn
+	}
+)
+
+var _ sdktrace.Sampler = (*ratioSampler)(nil)
+
+// Tracer returns the tracer used to instrument the server. Spans are not recorded
+// unless a tracer provider has been installed with InitTracerProvider.
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// InitTracerProvider creates a tracer provider which exports spans to the collector
+// configured in config and installs it as the process-wide tracer provider, together
+// with the W3C trace context propagator. The provider must be shut down on server stop
+// to flush buffered spans.
+func InitTracerProvider(config *Config, sampleRatio dynamicconfig.FloatPropertyFn) (*sdktrace.TracerProvider, error) {
+	if config.Endpoint == "" {
+		return nil, errors.New("tracing endpoint is required")
+	}
+	batchTimeout := config.BatchTimeout
+	if batchTimeout == 0 {
+		batchTimeout = defaultBatchTimeout
+	}
+
+	exporter := NewExporter(Options{
+		Endpoint: config.Endpoint,
+		URLPath:  config.URLPath,
+		Insecure: config.Insecure,
+		Headers:  config.Headers,
+		Timeout:  config.Timeout,
+	})
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithConfig(sdktrace.Config{DefaultSampler: NewSampler(sampleRatio)}),
+		sdktrace.WithBatcher(exporter, sdktrace.WithBatchTimeout(batchTimeout)),
+		sdktrace.WithResource(sdkresource.NewWithAttributes(label.String("service.name", serviceName))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagator)
+	return provider, nil
+}
+
+// NewSampler returns a sampler which follows the sampling decision of the parent span and
+// samples the ratio of root spans returned by the given dynamic config property.
+func NewSampler(ratio dynamicconfig.FloatPropertyFn) sdktrace.Sampler {
+	return sdktrace.ParentBased(&ratioSampler{ratio: ratio})
+}
+
+func (s *ratioSampler) ShouldSample(parameters sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	return sdktrace.TraceIDRatioBased(s.ratio()).ShouldSample(parameters)
+}
+
+func (s *ratioSampler) Description() string {
+	return "DynamicTraceIDRatioBased"
+}
@@ -0,0 +1,181 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	export "go.opentelemetry.io/otel/sdk/export/trace"
+	"go.opentelemetry.io/otel/sdk/export/trace/tracetest"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"go.temporal.io/server/common/dynamicconfig"
+)
+
+type tracingSuite struct {
+	suite.Suite
+	exporter         *tracetest.InMemoryExporter
+	previousProvider trace.TracerProvider
+}
+
+func TestTracingSuite(t *testing.T) {
+	suite.Run(t, new(tracingSuite))
+}
+
+func (s *tracingSuite) SetupTest() {
+	s.exporter = tracetest.NewInMemoryExporter()
+	s.previousProvider = otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(
+		sdktrace.WithConfig(sdktrace.Config{DefaultSampler: sdktrace.AlwaysSample()}),
+		sdktrace.WithSyncer(s.exporter),
+	))
+}
+
+func (s *tracingSuite) TearDownTest() {
+	otel.SetTracerProvider(s.previousProvider)
+}
+
+func (s *tracingSuite) TestInjectTraceContext_NoSpan() {
+	s.Nil(InjectTraceContext(context.Background()))
+}
+
+func (s *tracingSuite) TestStartSpanFromTraceContext() {
+	ctx, producer := Tracer().Start(context.Background(), "producer")
+	traceContext := InjectTraceContext(ctx)
+	producer.End()
+	s.Contains(traceContext, "traceparent")
+
+	// the span continues the trace of traceContext and links the unrelated current span
+	ctx, current := Tracer().Start(context.Background(), "current")
+	_, consumer := StartSpanFromTraceContext(ctx, traceContext, "consumer")
+	EndSpan(consumer, errors.New("some error"))
+	current.End()
+
+	spans := s.spansByName()
+	s.Equal(spans["producer"].SpanContext.TraceID, spans["consumer"].SpanContext.TraceID)
+	s.Equal(spans["producer"].SpanContext.SpanID, spans["consumer"].ParentSpanID)
+	s.True(spans["consumer"].HasRemoteParent)
+	s.Len(spans["consumer"].Links, 1)
+	s.Equal(spans["current"].SpanContext.SpanID, spans["consumer"].Links[0].SpanID)
+	s.Equal(codes.Error, spans["consumer"].StatusCode)
+	s.Equal("some error", spans["consumer"].StatusMessage)
+}
+
+func (s *tracingSuite) TestStartSpanFromTraceContext_Empty() {
+	ctx, parent := Tracer().Start(context.Background(), "parent")
+	_, child := StartSpanFromTraceContext(ctx, nil, "child")
+	EndSpan(child, nil)
+	parent.End()
+
+	spans := s.spansByName()
+	s.Equal(spans["parent"].SpanContext.SpanID, spans["child"].ParentSpanID)
+	s.Empty(spans["child"].Links)
+	s.Equal(codes.Unset, spans["child"].StatusCode)
+}
+
+func (s *tracingSuite) TestGRPCInterceptors() {
+	clientInterceptor := NewClientTracingInterceptor()
+	serverInterceptor := NewServerTracingInterceptor()
+	method := "/temporal.server.api.historyservice.v1.HistoryService/StartWorkflowExecution"
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "some-header", "some-value")
+	var outgoing metadata.MD
+	err := clientInterceptor(ctx, method, nil, nil, nil,
+		func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+			outgoing, _ = metadata.FromOutgoingContext(ctx)
+			return nil
+		},
+	)
+	s.NoError(err)
+	s.Equal([]string{"some-value"}, outgoing.Get("some-header"))
+	s.Len(outgoing.Get("traceparent"), 1)
+
+	serverCtx := metadata.NewIncomingContext(context.Background(), outgoing)
+	_, err = serverInterceptor(serverCtx, nil, &grpc.UnaryServerInfo{FullMethod: method},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, errors.New("some error")
+		},
+	)
+	s.Error(err)
+
+	spans := s.exporter.GetSpans()
+	s.Len(spans, 2)
+	client, server := spans[0], spans[1]
+	s.Equal(trace.SpanKindClient, client.SpanKind)
+	s.Equal(trace.SpanKindServer, server.SpanKind)
+	s.Equal(client.SpanContext.TraceID, server.SpanContext.TraceID)
+	s.Equal(client.SpanContext.SpanID, server.ParentSpanID)
+	s.Equal(codes.Error, server.StatusCode)
+	s.Contains(server.Attributes, rpcAttributes(method)[2])
+}
+
+func (s *tracingSuite) TestRPCAttributes() {
+	attributes := rpcAttributes("/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution")
+	s.Len(attributes, 3)
+	s.Equal("grpc", attributes[0].Value.AsString())
+	s.Equal("temporal.api.workflowservice.v1.WorkflowService", attributes[1].Value.AsString())
+	s.Equal("StartWorkflowExecution", attributes[2].Value.AsString())
+
+	s.Len(rpcAttributes("invalid"), 1)
+}
+
+func (s *tracingSuite) TestSampler() {
+	ratio := 0.0
+	sampler := NewSampler(func(opts ...dynamicconfig.FilterOption) float64 { return ratio })
+	root := sdktrace.SamplingParameters{TraceID: trace.TraceID{0x01}, Name: "root"}
+
+	s.Equal(sdktrace.Drop, sampler.ShouldSample(root).Decision)
+	ratio = 1
+	s.Equal(sdktrace.RecordAndSample, sampler.ShouldSample(root).Decision)
+
+	// children follow the decision of their parent regardless of the ratio
+	sampledParent := sdktrace.SamplingParameters{
+		TraceID: trace.TraceID{0x01},
+		ParentContext: trace.SpanContext{
+			TraceID:    trace.TraceID{0x01},
+			SpanID:     trace.SpanID{0x01},
+			TraceFlags: trace.FlagsSampled,
+		},
+		HasRemoteParent: true,
+	}
+	ratio = 0
+	s.Equal(sdktrace.RecordAndSample, sampler.ShouldSample(sampledParent).Decision)
+}
+
+func (s *tracingSuite) spansByName() map[string]*export.SpanData {
+	spans := make(map[string]*export.SpanData)
+	for _, span := range s.exporter.GetSpans() {
+		spans[span.Name] = span
+	}
+	return spans
+}
@@ -53,6 +53,7 @@ import (
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/primitives/timestamp"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
 )
@@ -108,13 +109,13 @@ const (
 	FailureReasonHeartbeatExceedsLimit = "Heartbeat details exceed size limit."
 	// FailureReasonSizeExceedsLimit is reason to fail workflow when history size or count exceed limit
 	FailureReasonSizeExceedsLimit = "Workflow history size / count exceeds limit."
+	// FailureReasonStateTransitionCountExceedsLimit is reason to terminate workflow when state transition count exceeds limit
+	FailureReasonStateTransitionCountExceedsLimit = "Workflow state transition count exceeds limit."
 	// FailureReasonTransactionSizeExceedsLimit is the failureReason for when transaction cannot be committed because it exceeds size limit
 	FailureReasonTransactionSizeExceedsLimit = "Transaction size exceeds limit."
 )
 
 var (
-	// ErrBlobSizeExceedsLimit is error for event blob size exceeds limit
-	ErrBlobSizeExceedsLimit = serviceerror.NewInvalidArgument("Blob data size exceeds limit.")
 	// ErrContextTimeoutTooShort is error for setting a very short context timeout when calling a long poll API
 	ErrContextTimeoutTooShort = serviceerror.NewInvalidArgument("Context timeout is too short.")
 	// ErrContextTimeoutNotSet is error for not setting a context timeout when calling a long poll API
@@ -519,12 +520,14 @@ func FromConfigToDefaultRetrySettings(options map[string]interface{}) DefaultRet
 	return defaultSettings
 }
 
-// CreateHistoryStartWorkflowRequest create a start workflow request for history
+// CreateHistoryStartWorkflowRequest create a start workflow request for history.
+// A positive startDelay postpones the first workflow task, the workflow execution timeout starts after the delay.
 func CreateHistoryStartWorkflowRequest(
 	namespaceID string,
 	startRequest *workflowservice.StartWorkflowExecutionRequest,
 	parentExecutionInfo *workflowspb.ParentExecutionInfo,
 	now time.Time,
+	startDelay time.Duration,
 ) *historyservice.StartWorkflowExecutionRequest {
 	histRequest := &historyservice.StartWorkflowExecutionRequest{
 		NamespaceId:              namespaceID,
@@ -534,6 +537,10 @@ func CreateHistoryStartWorkflowRequest(
 		ParentExecutionInfo:      parentExecutionInfo,
 		FirstWorkflowTaskBackoff: backoff.GetBackoffForNextScheduleNonNegative(startRequest.GetCronSchedule(), now, now),
 	}
+	if startDelay > 0 {
+		histRequest.FirstWorkflowTaskBackoff = &startDelay
+		now = now.Add(startDelay)
+	}
 
 	if timestamp.DurationValue(startRequest.GetWorkflowExecutionTimeout()) > 0 {
 		deadline := now.Add(timestamp.DurationValue(startRequest.GetWorkflowExecutionTimeout()))
@@ -548,11 +555,13 @@ func CreateHistoryStartWorkflowRequest(
 }
 
 // CheckEventBlobSizeLimit checks if a blob data exceeds limits. It logs a warning if it exceeds warnLimit,
-// and return ErrBlobSizeExceedsLimit if it exceeds errorLimit.
+// and return an InvalidArgument error if it exceeds errorLimit. With BlobSizeAccountingModeData the limits
+// apply to the blob size excluding payload metadata, otherwise they apply to the total encoded size.
 func CheckEventBlobSizeLimit(
-	actualSize int,
+	blob proto.Sizer,
 	warnLimit int,
 	errorLimit int,
+	accountingMode string,
 	namespaceID string,
 	workflowID string,
 	runID string,
@@ -561,7 +570,17 @@ func CheckEventBlobSizeLimit(
 	blobSizeViolationOperationTag tag.ZapTag,
 ) error {
 
-	scope.RecordDistribution(metrics.EventBlobSize, actualSize)
+	totalSize := blob.Size()
+	dataSize := totalSize - payload.MetadataSize(blob)
+	scope.RecordDistribution(metrics.EventBlobSize, totalSize)
+	scope.RecordDistribution(metrics.EventBlobDataSize, dataSize)
+
+	actualSize := totalSize
+	if accountingMode == BlobSizeAccountingModeData {
+		actualSize = dataSize
+	} else {
+		accountingMode = BlobSizeAccountingModeTotal
+	}
 
 	if actualSize > warnLimit {
 		if logger != nil {
@@ -569,12 +588,19 @@ func CheckEventBlobSizeLimit(
 				tag.WorkflowNamespaceID(namespaceID),
 				tag.WorkflowID(workflowID),
 				tag.WorkflowRunID(runID),
-				tag.WorkflowSize(int64(actualSize)),
+				tag.WorkflowSize(int64(totalSize)),
+				tag.BlobDataSize(int64(dataSize)),
 				blobSizeViolationOperationTag)
 		}
 
 		if actualSize > errorLimit {
-			return ErrBlobSizeExceedsLimit
+			return serviceerror.NewInvalidArgument(fmt.Sprintf(
+				"Blob data size exceeds limit. Total size: %d bytes, data size: %d bytes, limit: %d bytes (%s).",
+				totalSize,
+				dataSize,
+				errorLimit,
+				accountingMode,
+			))
 		}
 	}
 	return nil
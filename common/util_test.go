@@ -27,6 +27,7 @@ package common
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -36,6 +37,9 @@ import (
 	"go.temporal.io/api/serviceerror"
 
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/primitives/timestamp"
 )
 
@@ -231,6 +235,32 @@ func Test_FromConfigToRetryPolicy(t *testing.T) {
 	assert.Equal(t, int32(5), defaultSettings.MaximumAttempts)
 }
 
+func TestCheckEventBlobSizeLimit(t *testing.T) {
+	p := payload.EncodeBytes(make([]byte, 100))
+	p.Metadata["extra"] = make([]byte, 100)
+	blob := &commonpb.Payloads{Payloads: []*commonpb.Payload{p}}
+	totalSize := blob.Size()
+	dataSize := totalSize - payload.MetadataSize(blob)
+	require.True(t, dataSize < 150 && totalSize > 200)
+
+	check := func(accountingMode string) error {
+		return CheckEventBlobSizeLimit(blob, 150, 150, accountingMode, "namespace-id", "workflow-id", "run-id", metrics.NoopScope(metrics.Frontend), nil, tag.BlobSizeViolationOperation("test"))
+	}
+
+	err := check(BlobSizeAccountingModeTotal)
+	require.Error(t, err)
+	var invalidArgument *serviceerror.InvalidArgument
+	require.True(t, errors.As(err, &invalidArgument))
+	assert.Contains(t, err.Error(), fmt.Sprintf("Total size: %d bytes, data size: %d bytes, limit: 150 bytes (total)", totalSize, dataSize))
+
+	// unknown modes fall back to total size accounting
+	err = check("")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "(total)")
+
+	assert.NoError(t, check(BlobSizeAccountingModeData))
+}
+
 func TestIsContextDeadlineExceededErr(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
 	defer cancel()
@@ -60,6 +60,7 @@ require (
 	github.com/xwb1989/sqlparser v0.0.0-20180606152119-120387863bf2
 	go.opentelemetry.io/otel v0.15.0
 	go.opentelemetry.io/otel/exporters/metric/prometheus v0.15.0
+	go.opentelemetry.io/otel/sdk v0.15.0
 	go.temporal.io/api v1.4.1-0.20210622200201-edd2d5680749
 	go.temporal.io/sdk v1.8.0
 	go.temporal.io/version v0.0.0-20201015012359-4d3bb966d193
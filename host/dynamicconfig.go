@@ -155,6 +155,10 @@ func (d *dynamicClient) GetDurationValue(
 	return d.client.GetDurationValue(name, filters, defaultValue)
 }
 
+func (d *dynamicClient) Subscribe(callback dynamicconfig.ChangeCallback) func() {
+	return d.client.Subscribe(callback)
+}
+
 func (d *dynamicClient) OverrideValue(name dynamicconfig.Key, value interface{}) {
 	d.Lock()
 	defer d.Unlock()
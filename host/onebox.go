@@ -387,6 +387,7 @@ func (c *temporalImpl) startFrontend(hosts map[string][]string, startWG *sync.Wa
 	params.ESConfig = c.esConfig
 	params.ESClient = c.esClient
 	params.Authorizer = authorization.NewNoopAuthorizer()
+	params.PayloadRedactor = authorization.NewNoopPayloadRedactor()
 
 	var err error
 	params.PersistenceConfig, err = copyPersistenceConfig(c.persistenceConfig)
@@ -778,6 +779,10 @@ func (c *rpcFactoryImpl) CreateFrontendGRPCConnection(hostName string) *grpc.Cli
 	return c.CreateGRPCConnection(hostName)
 }
 
+func (c *rpcFactoryImpl) CreateRemoteFrontendGRPCConnection(rpcAddress string) *grpc.ClientConn {
+	return c.CreateGRPCConnection(rpcAddress)
+}
+
 func (c *rpcFactoryImpl) CreateInternodeGRPCConnection(hostName string) *grpc.ClientConn {
 	return c.CreateGRPCConnection(hostName)
 }
@@ -0,0 +1,32 @@
+#!/bin/bash
+
+set -eu -o pipefail
+
+# Adds ResetRunId and OriginalRunId search attributes to the existing visibility index.
+# New indices get these fields from the index template.
+
+# Prerequisites:
+#   - curl
+
+# Input parameters.
+ES_SCHEME="${ES_SCHEME:-http}"
+ES_SERVER="${ES_SERVER:-127.0.0.1}"
+ES_PORT="${ES_PORT:-9200}"
+ES_USER="${ES_USER:-}"
+ES_PWD="${ES_PWD:-}"
+ES_VERSION="${ES_VERSION:-v7}"
+ES_VIS_INDEX_V1="${ES_VIS_INDEX_V1:-temporal_visibility_v1_dev}"
+
+ES_ENDPOINT="${ES_SCHEME}://${ES_SERVER}:${ES_PORT}"
+DIR_NAME="$(dirname "$(realpath "${BASH_SOURCE[0]}")")"
+
+DOC_TYPE=""
+if [ "${ES_VERSION}" != "v7" ]; then
+    DOC_TYPE="/_doc"
+fi
+
+echo "=== Step 1. Update index template. ==="
+curl --silent --fail --user "${ES_USER}":"${ES_PWD}" -X PUT "${ES_ENDPOINT}/_template/temporal_visibility_v1_template" -H "Content-Type: application/json" --data-binary "@${DIR_NAME}/index_template_${ES_VERSION}.json" --write-out "\n"
+
+echo "=== Step 2. Add reset lineage fields to the index ${ES_VIS_INDEX_V1}. ==="
+curl --silent --fail --user "${ES_USER}":"${ES_PWD}" -X PUT "${ES_ENDPOINT}/${ES_VIS_INDEX_V1}${DOC_TYPE}/_mapping" -H "Content-Type: application/json" --data-binary '{"properties":{"ResetRunId":{"type":"keyword"},"OriginalRunId":{"type":"keyword"}}}' --write-out "\n"
@@ -28,43 +28,71 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"sort"
+	"strings"
 	"sync/atomic"
+	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/pborman/uuid"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	filterpb "go.temporal.io/api/filter/v1"
+	historypb "go.temporal.io/api/history/v1"
+	replicationpb "go.temporal.io/api/replication/v1"
 	"go.temporal.io/api/serviceerror"
 	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/sdk/client"
 	"go.temporal.io/server/api/adminservice/v1"
 	clusterspb "go.temporal.io/server/api/cluster/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
+	schedpb "go.temporal.io/server/api/schedule/v1"
 	tokenspb "go.temporal.io/server/api/token/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/enums"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/versionhistory"
+	"go.temporal.io/server/common/persistence/visibility"
 	esclient "go.temporal.io/server/common/persistence/visibility/elasticsearch/client"
+	"go.temporal.io/server/common/pprof"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/common/xdc"
 	"go.temporal.io/server/service/worker/addsearchattributes"
+	"go.temporal.io/server/service/worker/batcher"
+	"go.temporal.io/server/service/worker/deletenamespace"
+	"go.temporal.io/server/service/worker/scheduler"
 )
 
 const (
 	getNamespaceReplicationMessageBatchSize = 100
 	defaultLastMessageID                    = -1
+
+	namespaceHandoverDrainPollInterval = time.Second
+
+	// maintenanceMessageMaxLength limits the maintenance message because it is sent in every response header.
+	maintenanceMessageMaxLength = 1024
 )
 
 type (
@@ -78,7 +106,16 @@ type (
 		ESClient              esclient.Client
 		config                *Config
 		namespaceDLQHandler   namespace.DLQMessageHandler
+		namespaceHandler      namespace.Handler
+		workflowHandler       *WorkflowHandler
 		eventSerializer       serialization.Serializer
+		tokenSerializer       common.TaskTokenSerializer
+		dynamicConfigManager  *persistence.DynamicConfigManager
+		maintenanceManager    *persistence.MaintenanceInfoManager
+		checkHostHealth       hostHealthCheckFn
+
+		// namespaceHandoverPropagationDelay is the time for all hosts to observe namespace handover state
+		namespaceHandoverPropagationDelay time.Duration
 	}
 )
 
@@ -94,10 +131,12 @@ func NewAdminHandler(
 	resource resource.Resource,
 	params *resource.BootstrapParams,
 	config *Config,
+	workflowHandler *WorkflowHandler,
 ) *AdminHandler {
 
 	namespaceReplicationTaskExecutor := namespace.NewReplicationTaskExecutor(
 		resource.GetMetadataManager(),
+		resource.GetSearchAttributesManager(),
 		resource.GetLogger(),
 	)
 	return &AdminHandler{
@@ -110,9 +149,24 @@ func NewAdminHandler(
 			resource.GetNamespaceReplicationQueue(),
 			resource.GetLogger(),
 		),
-		eventSerializer: serialization.NewSerializer(),
-		ESConfig:        params.ESConfig,
-		ESClient:        params.ESClient,
+		namespaceHandler: namespace.NewHandler(
+			config.MaxBadBinaries,
+			resource.GetLogger(),
+			resource.GetMetadataManager(),
+			resource.GetClusterMetadata(),
+			namespace.NewNamespaceReplicator(resource.GetNamespaceReplicationQueue(), resource.GetLogger()),
+			resource.GetArchivalMetadata(),
+			resource.GetArchiverProvider(),
+		),
+		workflowHandler:                   workflowHandler,
+		eventSerializer:                   serialization.NewSerializer(),
+		tokenSerializer:                   common.NewProtoTaskTokenSerializer(),
+		dynamicConfigManager:              persistence.NewDynamicConfigManager(resource.GetTimeSource(), resource.GetClusterMetadataManager()),
+		maintenanceManager:                persistence.NewMaintenanceInfoManager(resource.GetTimeSource(), resource.GetClusterMetadataManager()),
+		ESConfig:                          params.ESConfig,
+		ESClient:                          params.ESClient,
+		checkHostHealth:                   newGRPCHostHealthCheck(params.RPCFactory),
+		namespaceHandoverPropagationDelay: 2 * cache.NamespaceCacheRefreshInterval,
 	}
 }
 
@@ -362,6 +416,7 @@ func (adh *AdminHandler) DescribeMutableState(ctx context.Context, request *admi
 		HistoryAddr:          historyAddr,
 		DatabaseMutableState: historyResponse.GetDatabaseMutableState(),
 		CacheMutableState:    historyResponse.GetCacheMutableState(),
+		PendingActivities:    historyResponse.GetPendingActivities(),
 	}, err
 }
 
@@ -398,6 +453,382 @@ func (adh *AdminHandler) CloseShard(ctx context.Context, request *adminservice.C
 	return &adminservice.CloseShardResponse{}, err
 }
 
+// RebalanceShards evicts history shards from the hosts which hold them although the membership ring assigns them
+// to another host. Moves off the hosts owning the most shards are done first.
+func (adh *AdminHandler) RebalanceShards(ctx context.Context, request *adminservice.RebalanceShardsRequest) (_ *adminservice.RebalanceShardsResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope, sw := adh.startRequestProfile(metrics.AdminRebalanceShardsScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetMaxMoves() < 0 {
+		return nil, adh.error(errInvalidMaxMoves, scope)
+	}
+
+	ownedShards := make(map[string][]int32)
+	for _, host := range adh.GetHistoryServiceResolver().Members() {
+		resp, err := adh.GetHistoryClient().DescribeHistoryHost(ctx, &historyservice.DescribeHistoryHostRequest{
+			HostAddress: host.GetAddress(),
+		})
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+		ownedShards[host.GetAddress()] = resp.GetShardIds()
+	}
+
+	moves, err := planShardMoves(ownedShards, func(shardID int32) (string, error) {
+		host, err := adh.GetHistoryServiceResolver().Lookup(convert.Int32ToString(shardID))
+		if err != nil {
+			return "", err
+		}
+		return host.GetAddress(), nil
+	}, int(request.GetMaxMoves()))
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if request.GetDryRun() {
+		return &adminservice.RebalanceShardsResponse{Moves: moves}, nil
+	}
+
+	for _, move := range moves {
+		if _, err := adh.GetHistoryClient().CloseShard(ctx, &historyservice.CloseShardRequest{
+			ShardId:     move.GetShardId(),
+			HostAddress: move.GetSourceHost(),
+		}); err != nil {
+			// keep evicting the remaining shards, failed moves are reported back to the caller
+			adh.GetLogger().Warn("Failed to evict shard from history host.", tag.ShardID(move.GetShardId()), tag.Address(move.GetSourceHost()), tag.Error(err))
+			move.Error = err.Error()
+			continue
+		}
+		move.Evicted = true
+	}
+	return &adminservice.RebalanceShardsResponse{Moves: moves}, nil
+}
+
+// planShardMoves returns the shards owned by a host other than the one the membership ring assigns them to,
+// ordered by the number of shards owned by their current host in descending order.
+func planShardMoves(
+	ownedShards map[string][]int32,
+	lookup func(shardID int32) (string, error),
+	maxMoves int,
+) ([]*adminservice.ShardMove, error) {
+	var moves []*adminservice.ShardMove
+	for host, shardIDs := range ownedShards {
+		for _, shardID := range shardIDs {
+			target, err := lookup(shardID)
+			if err != nil {
+				return nil, err
+			}
+			if target == host {
+				continue
+			}
+			moves = append(moves, &adminservice.ShardMove{
+				ShardId:          shardID,
+				SourceHost:       host,
+				TargetHost:       target,
+				SourceHostShards: int32(len(shardIDs)),
+			})
+		}
+	}
+
+	sort.Slice(moves, func(i, j int) bool {
+		if moves[i].SourceHostShards != moves[j].SourceHostShards {
+			return moves[i].SourceHostShards > moves[j].SourceHostShards
+		}
+		if moves[i].SourceHost != moves[j].SourceHost {
+			return moves[i].SourceHost < moves[j].SourceHost
+		}
+		return moves[i].ShardId < moves[j].ShardId
+	})
+	if maxMoves > 0 && len(moves) > maxMoves {
+		moves = moves[:maxMoves]
+	}
+	return moves, nil
+}
+
+func isServiceMember(resolver membership.ServiceResolver, address string) bool {
+	for _, member := range resolver.Members() {
+		if member.GetAddress() == address {
+			return true
+		}
+	}
+	return false
+}
+
+// GetShardStats returns the stats of every history shard, collected from all history hosts
+func (adh *AdminHandler) GetShardStats(ctx context.Context, request *adminservice.GetShardStatsRequest) (_ *adminservice.GetShardStatsResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope, sw := adh.startRequestProfile(metrics.AdminGetShardStatsScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	var shards []*clusterspb.ShardStats
+	var unreachableHosts []string
+	for _, host := range adh.GetHistoryServiceResolver().Members() {
+		resp, err := adh.GetHistoryClient().GetShardStats(ctx, &historyservice.GetShardStatsRequest{
+			HostAddress: host.GetAddress(),
+		})
+		if err != nil {
+			// a single unhealthy host should not hide the stats of the shards owned by the rest of the cluster
+			adh.GetLogger().Warn("Failed to get shard stats of history host.", tag.Address(host.GetAddress()), tag.Error(err))
+			unreachableHosts = append(unreachableHosts, host.GetAddress())
+			continue
+		}
+		shards = append(shards, resp.GetShards()...)
+	}
+	sort.Slice(shards, func(i, j int) bool {
+		return shards[i].GetShardId() < shards[j].GetShardId()
+	})
+	var maxVisibilityLag time.Duration
+	for _, shard := range shards {
+		if lag := timestamp.DurationValue(shard.GetVisibilityLag()); lag > maxVisibilityLag {
+			maxVisibilityLag = lag
+		}
+	}
+	return &adminservice.GetShardStatsResponse{
+		Shards:           shards,
+		UnreachableHosts: unreachableHosts,
+		MaxVisibilityLag: &maxVisibilityLag,
+	}, nil
+}
+
+// DeepHealthCheck verifies persistence, Elasticsearch, membership and gRPC reachability of the cluster hosts
+func (adh *AdminHandler) DeepHealthCheck(ctx context.Context, request *adminservice.DeepHealthCheckRequest) (_ *adminservice.DeepHealthCheckResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope, sw := adh.startRequestProfile(metrics.AdminDeepHealthCheckScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	components := runHealthProbes(ctx, deepHealthCheckProbeTimeout, adh.healthProbes())
+	healthy := true
+	for _, component := range components {
+		if !component.GetHealthy() {
+			healthy = false
+			adh.GetLogger().Warn("Deep health check failed.", tag.Name(component.GetName()), tag.Value(component.GetMessage()))
+		}
+	}
+	return &adminservice.DeepHealthCheckResponse{
+		Healthy:    healthy,
+		Components: components,
+	}, nil
+}
+
+// DescribeClusterMetadata returns the persisted failover version increment, cluster list and search attributes metadata
+func (adh *AdminHandler) DescribeClusterMetadata(ctx context.Context, request *adminservice.DescribeClusterMetadataRequest) (_ *adminservice.DescribeClusterMetadataResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope, sw := adh.startRequestProfile(metrics.AdminDescribeClusterMetadataScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	resp, err := adh.GetClusterMetadataManager().GetClusterMetadata()
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.DescribeClusterMetadataResponse{
+		Version:                  resp.Version,
+		ClusterName:              resp.ClusterName,
+		FailoverVersionIncrement: resp.FailoverVersionIncrement,
+		ClusterInformation:       resp.ClusterInformation,
+		IndexSearchAttributes:    resp.IndexSearchAttributes,
+	}, nil
+}
+
+// UpdateClusterMetadata updates the failover version increment, cluster list and search attributes metadata
+// if the cluster metadata was not updated since the version of the request
+func (adh *AdminHandler) UpdateClusterMetadata(ctx context.Context, request *adminservice.UpdateClusterMetadataRequest) (_ *adminservice.UpdateClusterMetadataResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope, sw := adh.startRequestProfile(metrics.AdminUpdateClusterMetadataScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetVersion() == 0 {
+		return nil, adh.error(errClusterMetadataVersionNotSet, scope)
+	}
+	if request.GetFailoverVersionIncrement() < 0 {
+		return nil, adh.error(errInvalidFailoverVersionIncrement, scope)
+	}
+
+	resp, err := adh.GetClusterMetadataManager().GetClusterMetadata()
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if resp.Version != request.GetVersion() {
+		return nil, adh.error(serviceerror.NewInvalidArgument(fmt.Sprintf(errClusterMetadataVersionMismatchMessage, request.GetVersion(), resp.Version)), scope)
+	}
+
+	clusterMetadata := resp.ClusterMetadata
+	if err := adh.updateClusterInformation(&clusterMetadata, request); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	indexName := request.GetIndexName()
+	if indexName == "" {
+		indexName = adh.ESConfig.GetVisibilityIndex()
+	}
+	searchAttributesUpdated := len(request.GetUpsertSearchAttributes()) > 0 || len(request.GetRemoveSearchAttributes()) > 0
+	if searchAttributesUpdated {
+		if err := adh.updateSearchAttributes(&clusterMetadata, indexName, request); err != nil {
+			return nil, adh.error(err, scope)
+		}
+	}
+
+	applied, err := adh.GetClusterMetadataManager().SaveClusterMetadata(&persistence.SaveClusterMetadataRequest{
+		ClusterMetadata: clusterMetadata,
+		Version:         resp.Version,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if !applied {
+		current, err := adh.GetClusterMetadataManager().GetClusterMetadata()
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+		return nil, adh.error(serviceerror.NewInvalidArgument(fmt.Sprintf(errClusterMetadataVersionMismatchMessage, request.GetVersion(), current.Version)), scope)
+	}
+
+	if searchAttributesUpdated {
+		// Other hosts pick up the search attributes when their cache is refreshed.
+		if _, err := adh.GetSearchAttributesProvider().GetSearchAttributes(indexName, true); err != nil {
+			adh.GetLogger().Warn("Unable to refresh search attributes cache.", tag.Error(err))
+		}
+	}
+	return &adminservice.UpdateClusterMetadataResponse{
+		Version: resp.Version + 1,
+	}, nil
+}
+
+// DescribeVisibilityProcessor returns the state of the Elasticsearch bulk processors of history hosts and optionally flushes them
+func (adh *AdminHandler) DescribeVisibilityProcessor(ctx context.Context, request *adminservice.DescribeVisibilityProcessorRequest) (_ *adminservice.DescribeVisibilityProcessorResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope, sw := adh.startRequestProfile(metrics.AdminDescribeVisibilityProcessorScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	if address := request.GetHostAddress(); address != "" {
+		if !isServiceMember(adh.GetHistoryServiceResolver(), address) {
+			return nil, adh.error(serviceerror.NewInvalidArgument(fmt.Sprintf(errHostNotHistoryMessage, address)), scope)
+		}
+		resp, err := adh.GetHistoryClient().DescribeVisibilityProcessor(ctx, &historyservice.DescribeVisibilityProcessorRequest{
+			HostAddress: address,
+			Flush:       request.GetFlush(),
+		})
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+		return &adminservice.DescribeVisibilityProcessorResponse{
+			Processors: []*clusterspb.VisibilityProcessorStatus{resp.GetStatus()},
+		}, nil
+	}
+
+	var processors []*clusterspb.VisibilityProcessorStatus
+	var unreachableHosts []string
+	for _, host := range adh.GetHistoryServiceResolver().Members() {
+		resp, err := adh.GetHistoryClient().DescribeVisibilityProcessor(ctx, &historyservice.DescribeVisibilityProcessorRequest{
+			HostAddress: host.GetAddress(),
+			Flush:       request.GetFlush(),
+		})
+		if err != nil {
+			// a single unhealthy host should not hide the processors of the rest of the cluster
+			adh.GetLogger().Warn("Failed to describe visibility processor of history host.", tag.Address(host.GetAddress()), tag.Error(err))
+			unreachableHosts = append(unreachableHosts, host.GetAddress())
+			continue
+		}
+		processors = append(processors, resp.GetStatus())
+	}
+	sort.Slice(processors, func(i, j int) bool {
+		return processors[i].GetHostAddress() < processors[j].GetHostAddress()
+	})
+	return &adminservice.DescribeVisibilityProcessorResponse{
+		Processors:       processors,
+		UnreachableHosts: unreachableHosts,
+	}, nil
+}
+
+// GetHostProfile captures a pprof profile of a frontend, history or matching host
+func (adh *AdminHandler) GetHostProfile(ctx context.Context, request *adminservice.GetHostProfileRequest) (_ *adminservice.GetHostProfileResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope, sw := adh.startRequestProfile(metrics.AdminGetHostProfileScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	duration := timestamp.DurationValue(request.GetDuration())
+	if err := pprof.ValidateProfile(request.GetProfile(), duration); err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	address := request.GetHostAddress()
+	if address == "" {
+		address = adh.GetHostInfo().GetAddress()
+	}
+
+	var service string
+	var profile []byte
+	var err error
+	switch {
+	case address == adh.GetHostInfo().GetAddress():
+		service = common.FrontendServiceName
+		profile, err = pprof.CaptureProfile(ctx, request.GetProfile(), duration)
+	case isServiceMember(adh.GetHistoryServiceResolver(), address):
+		service = common.HistoryServiceName
+		var resp *historyservice.GetHostProfileResponse
+		resp, err = adh.GetHistoryClient().GetHostProfile(ctx, &historyservice.GetHostProfileRequest{
+			HostAddress: address,
+			Profile:     request.GetProfile(),
+			Duration:    request.GetDuration(),
+		})
+		profile = resp.GetProfile()
+	case isServiceMember(adh.GetMatchingServiceResolver(), address):
+		service = common.MatchingServiceName
+		var resp *matchingservice.GetHostProfileResponse
+		resp, err = adh.GetMatchingClient().GetHostProfile(ctx, &matchingservice.GetHostProfileRequest{
+			HostAddress: address,
+			Profile:     request.GetProfile(),
+			Duration:    request.GetDuration(),
+		})
+		profile = resp.GetProfile()
+	case isServiceMember(adh.GetFrontendServiceResolver(), address):
+		// frontends do not call each other, the profile has to be requested from that frontend itself
+		return nil, adh.error(serviceerror.NewInvalidArgument(fmt.Sprintf(errHostIsOtherFrontendMessage, address)), scope)
+	default:
+		return nil, adh.error(serviceerror.NewInvalidArgument(fmt.Sprintf(errHostNotFoundMessage, address)), scope)
+	}
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	adh.GetLogger().Info("Captured profile.", tag.Address(address), tag.Service(service), tag.Value(request.GetProfile()))
+	return &adminservice.GetHostProfileResponse{
+		Profile:     profile,
+		HostAddress: address,
+		Service:     service,
+	}, nil
+}
+
 // DescribeHistoryHost returns information about the internal states of a history host
 func (adh *AdminHandler) DescribeHistoryHost(ctx context.Context, request *adminservice.DescribeHistoryHostRequest) (_ *adminservice.DescribeHistoryHostResponse, retError error) {
 	defer log.CapturePanic(adh.GetLogger(), &retError)
@@ -583,69 +1014,270 @@ func (adh *AdminHandler) GetWorkflowExecutionRawHistoryV2(ctx context.Context, r
 	return result, nil
 }
 
-// DescribeCluster return information about temporal deployment
-func (adh *AdminHandler) DescribeCluster(ctx context.Context, _ *adminservice.DescribeClusterRequest) (_ *adminservice.DescribeClusterResponse, retError error) {
+// GetWorkflowExecutionHistoryReverse - retrieves the history of workflow execution, most recent events first
+func (adh *AdminHandler) GetWorkflowExecutionHistoryReverse(ctx context.Context, request *adminservice.GetWorkflowExecutionHistoryReverseRequest) (_ *adminservice.GetWorkflowExecutionHistoryReverseResponse, retError error) {
 	defer log.CapturePanic(adh.GetLogger(), &retError)
 
-	scope, sw := adh.startRequestProfile(metrics.AdminGetWorkflowExecutionRawHistoryV2Scope)
+	scope, sw := adh.startRequestProfile(metrics.AdminGetWorkflowExecutionHistoryReverseScope)
 	defer sw.Stop()
 
-	membershipInfo := &clusterspb.MembershipInfo{}
-	if monitor := adh.GetMembershipMonitor(); monitor != nil {
-		currentHost, err := monitor.WhoAmI()
+	if err := adh.validateGetWorkflowExecutionHistoryReverseRequest(
+		request,
+	); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	namespaceID, err := adh.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	scope = scope.Tagged(metrics.NamespaceTag(request.GetNamespace()))
+
+	execution := request.Execution
+	var pageToken *tokenspb.HistoryReverseContinuation
+	if request.NextPageToken == nil {
+		response, err := adh.GetHistoryClient().GetMutableState(ctx, &historyservice.GetMutableStateRequest{
+			NamespaceId: namespaceID,
+			Execution:   execution,
+		})
 		if err != nil {
 			return nil, adh.error(err, scope)
 		}
 
-		membershipInfo.CurrentHost = &clusterspb.HostInfo{
-			Identity: currentHost.Identity(),
+		pageToken = &tokenspb.HistoryReverseContinuation{
+			Namespace:   request.GetNamespace(),
+			WorkflowId:  execution.GetWorkflowId(),
+			RunId:       response.GetExecution().GetRunId(),
+			NextEventId: response.GetNextEventId(),
+			BranchToken: response.GetCurrentBranchToken(),
 		}
-
-		members, err := monitor.GetReachableMembers()
+	} else {
+		pageToken, err = deserializeHistoryReverseToken(request.NextPageToken)
 		if err != nil {
+			return nil, adh.error(errInvalidPaginationToken, scope)
+		}
+		if err := validateHistoryReversePaginationToken(
+			request,
+			pageToken,
+		); err != nil {
 			return nil, adh.error(err, scope)
 		}
+	}
 
-		membershipInfo.ReachableMembers = members
+	eventTypes := make(map[enumspb.EventType]struct{}, len(request.GetEventTypes()))
+	for _, eventType := range request.GetEventTypes() {
+		eventTypes[eventType] = struct{}{}
+	}
 
-		var rings []*clusterspb.RingInfo
-		for _, role := range []string{common.FrontendServiceName, common.HistoryServiceName, common.MatchingServiceName, common.WorkerServiceName} {
-			resolver, err := monitor.GetResolver(role)
-			if err != nil {
-				return nil, adh.error(err, scope)
-			}
+	shardID := common.WorkflowIDToHistoryShard(
+		namespaceID,
+		execution.GetWorkflowId(),
+		adh.numberOfHistoryShards,
+	)
+	pageSize := int(request.GetMaximumPageSize())
+	// a selective filter can make the page fill slowly, so the scan is bounded and
+	// the page returned early, possibly empty, with a token to resume the scan
+	maxScannedEvents := adh.config.HistoryReverseMaxScannedEvents(request.GetNamespace())
+	scannedEvents := 0
+	historyEvents := make([]*historypb.HistoryEvent, 0, pageSize)
+	nextEventID := pageToken.GetNextEventId()
+	for nextEventID > common.FirstEventID && len(historyEvents) < pageSize && scannedEvents < maxScannedEvents {
+		events, err := adh.readHistoryEventsBefore(
+			shardID,
+			pageToken.GetBranchToken(),
+			nextEventID,
+			pageSize,
+		)
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
 
-			var servers []*clusterspb.HostInfo
-			for _, server := range resolver.Members() {
-				servers = append(servers, &clusterspb.HostInfo{
-					Identity: server.Identity(),
-				})
+		// events are read in ascending order, so walk them backwards until the page is full
+		for i := len(events) - 1; i >= 0 && len(historyEvents) < pageSize; i-- {
+			event := events[i]
+			nextEventID = event.GetEventId()
+			scannedEvents++
+			if _, ok := eventTypes[event.GetEventType()]; ok || len(eventTypes) == 0 {
+				historyEvents = append(historyEvents, event)
 			}
+		}
+	}
 
-			rings = append(rings, &clusterspb.RingInfo{
-				Role:        role,
-				MemberCount: int32(resolver.MemberCount()),
-				Members:     servers,
-			})
+	result := &adminservice.GetWorkflowExecutionHistoryReverseResponse{
+		History: &historypb.History{Events: historyEvents},
+	}
+	if nextEventID > common.FirstEventID {
+		pageToken.NextEventId = nextEventID
+		result.NextPageToken, err = serializeHistoryReverseToken(pageToken)
+		if err != nil {
+			return nil, adh.error(err, scope)
 		}
-		membershipInfo.Rings = rings
 	}
 
-	return &adminservice.DescribeClusterResponse{
-		SupportedClients: headers.SupportedClients,
-		ServerVersion:    headers.ServerVersion,
-		MembershipInfo:   membershipInfo,
-	}, nil
+	return result, nil
 }
 
-// GetReplicationMessages returns new replication tasks since the read level provided in the token.
-func (adh *AdminHandler) GetReplicationMessages(ctx context.Context, request *adminservice.GetReplicationMessagesRequest) (_ *adminservice.GetReplicationMessagesResponse, retError error) {
+// StreamWorkflowExecutionHistory streams history events of a workflow execution as they are appended,
+// the stream ends once all events of the closed workflow execution are sent.
+func (adh *AdminHandler) StreamWorkflowExecutionHistory(
+	request *adminservice.StreamWorkflowExecutionHistoryRequest,
+	server adminservice.AdminService_StreamWorkflowExecutionHistoryServer,
+) (retError error) {
 	defer log.CapturePanic(adh.GetLogger(), &retError)
 
-	scope, sw := adh.startRequestProfile(metrics.AdminGetReplicationMessagesScope)
+	scope, sw := adh.startRequestProfile(metrics.AdminStreamWorkflowExecutionHistoryScope)
 	defer sw.Stop()
 
-	if request == nil {
+	if err := adh.validateStreamWorkflowExecutionHistoryRequest(request); err != nil {
+		return adh.error(err, scope)
+	}
+	namespaceID, err := adh.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	scope = scope.Tagged(metrics.NamespaceTag(request.GetNamespace()))
+
+	pageSize := adh.config.HistoryMaxPageSize(request.GetNamespace())
+	if request.GetMaximumPageSize() > 0 {
+		pageSize = common.MinInt(pageSize, int(request.GetMaximumPageSize()))
+	}
+	// the rate limit is per stream, on top of it a slow receiver blocks Send once the gRPC flow control window is full
+	var rateLimiter quotas.RateLimiter
+	if eventsPerSecond := adh.config.HistoryStreamEventsPerSecond(request.GetNamespace()); eventsPerSecond > 0 {
+		rateLimiter = quotas.NewRateLimiter(float64(eventsPerSecond), common.MaxInt(eventsPerSecond, pageSize))
+	}
+
+	ctx := server.Context()
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: request.Execution.GetWorkflowId(),
+		RunId:      request.Execution.GetRunId(),
+	}
+	shardID := common.WorkflowIDToHistoryShard(
+		namespaceID,
+		execution.GetWorkflowId(),
+		adh.numberOfHistoryShards,
+	)
+	nextEventID := common.MaxInt64(request.GetFirstEventId(), common.FirstEventID)
+	// history is read in whole batches and the batch holding the first requested event can start
+	// before it, later reads start right after the last event sent which is always a batch boundary
+	readFromEventID := common.FirstEventID
+	var branchToken []byte
+	for {
+		response, err := adh.GetHistoryClient().PollMutableState(ctx, &historyservice.PollMutableStateRequest{
+			NamespaceId:         namespaceID,
+			Execution:           execution,
+			ExpectedNextEventId: nextEventID,
+			CurrentBranchToken:  branchToken,
+		})
+		if err != nil {
+			return adh.error(err, scope)
+		}
+		// pin the run, a stream of the current run does not follow the workflow into the next run
+		execution.RunId = response.GetExecution().GetRunId()
+		branchToken = response.GetCurrentBranchToken()
+		isWorkflowRunning := response.GetWorkflowStatus() == enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING
+
+		if nextEventID < response.GetNextEventId() {
+			if err := adh.readHistoryEventsBetween(
+				shardID,
+				branchToken,
+				readFromEventID,
+				nextEventID,
+				response.GetNextEventId(),
+				pageSize,
+				func(events []*historypb.HistoryEvent) error {
+					if rateLimiter != nil {
+						if err := rateLimiter.WaitN(ctx, len(events)); err != nil {
+							return err
+						}
+					}
+					return server.Send(&adminservice.StreamWorkflowExecutionHistoryResponse{
+						History:           &historypb.History{Events: events},
+						NextEventId:       events[len(events)-1].GetEventId() + 1,
+						IsWorkflowRunning: isWorkflowRunning,
+					})
+				},
+			); err != nil {
+				return adh.error(err, scope)
+			}
+			nextEventID = response.GetNextEventId()
+			readFromEventID = nextEventID
+		}
+
+		if !isWorkflowRunning {
+			return nil
+		}
+	}
+}
+
+// DescribeCluster return information about temporal deployment
+func (adh *AdminHandler) DescribeCluster(ctx context.Context, _ *adminservice.DescribeClusterRequest) (_ *adminservice.DescribeClusterResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope, sw := adh.startRequestProfile(metrics.AdminGetWorkflowExecutionRawHistoryV2Scope)
+	defer sw.Stop()
+
+	membershipInfo := &clusterspb.MembershipInfo{}
+	if monitor := adh.GetMembershipMonitor(); monitor != nil {
+		currentHost, err := monitor.WhoAmI()
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+
+		membershipInfo.CurrentHost = &clusterspb.HostInfo{
+			Identity: currentHost.Identity(),
+		}
+
+		members, err := monitor.GetReachableMembers()
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+
+		membershipInfo.ReachableMembers = members
+
+		var rings []*clusterspb.RingInfo
+		for _, role := range []string{common.FrontendServiceName, common.HistoryServiceName, common.MatchingServiceName, common.WorkerServiceName} {
+			resolver, err := monitor.GetResolver(role)
+			if err != nil {
+				return nil, adh.error(err, scope)
+			}
+
+			var servers []*clusterspb.HostInfo
+			for _, server := range resolver.Members() {
+				servers = append(servers, &clusterspb.HostInfo{
+					Identity: server.Identity(),
+				})
+			}
+
+			rings = append(rings, &clusterspb.RingInfo{
+				Role:        role,
+				MemberCount: int32(resolver.MemberCount()),
+				Members:     servers,
+			})
+		}
+		membershipInfo.Rings = rings
+	}
+
+	maintenanceInfo, err := adh.maintenanceManager.GetMaintenanceInfo()
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	return &adminservice.DescribeClusterResponse{
+		SupportedClients: headers.SupportedClients,
+		ServerVersion:    headers.ServerVersion,
+		MembershipInfo:   membershipInfo,
+		MaintenanceInfo:  maintenanceInfo,
+	}, nil
+}
+
+// GetReplicationMessages returns new replication tasks since the read level provided in the token.
+func (adh *AdminHandler) GetReplicationMessages(ctx context.Context, request *adminservice.GetReplicationMessagesRequest) (_ *adminservice.GetReplicationMessagesResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope, sw := adh.startRequestProfile(metrics.AdminGetReplicationMessagesScope)
+	defer sw.Stop()
+
+	if request == nil {
 		return nil, adh.error(errRequestNotSet, scope)
 	}
 	if request.GetClusterName() == "" {
@@ -662,6 +1294,73 @@ func (adh *AdminHandler) GetReplicationMessages(ctx context.Context, request *ad
 	return &adminservice.GetReplicationMessagesResponse{ShardMessages: resp.GetShardMessages()}, nil
 }
 
+// StreamReplicationMessages proxies replication stream of a shard between remote cluster and history host owning the shard.
+func (adh *AdminHandler) StreamReplicationMessages(server adminservice.AdminService_StreamReplicationMessagesServer) (retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope, sw := adh.startRequestProfile(metrics.AdminStreamReplicationMessagesScope)
+	defer sw.Stop()
+
+	shardID, ok := headers.GetReplicationShardID(server.Context())
+	if !ok {
+		return adh.error(errShardIDNotSet, scope)
+	}
+
+	ctx, cancel := context.WithCancel(server.Context())
+	defer cancel()
+	historyStream, err := adh.GetHistoryClient().StreamReplicationMessages(headers.SetReplicationShardID(ctx, shardID))
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	// first error from either direction terminates the stream, cancel unblocks the other direction
+	errCh := make(chan error, 2)
+	go func() {
+		for {
+			request, err := server.Recv()
+			if err == io.EOF {
+				_ = historyStream.CloseSend()
+				return
+			}
+			if err != nil {
+				errCh <- err
+				return
+			}
+			if err := historyStream.Send(&historyservice.StreamReplicationMessagesRequest{
+				Token:       request.GetToken(),
+				ClusterName: request.GetClusterName(),
+			}); err != nil {
+				errCh <- err
+				return
+			}
+		}
+	}()
+	go func() {
+		for {
+			response, err := historyStream.Recv()
+			if err == io.EOF {
+				errCh <- nil
+				return
+			}
+			if err != nil {
+				errCh <- err
+				return
+			}
+			if err := server.Send(&adminservice.StreamReplicationMessagesResponse{
+				Messages: response.GetMessages(),
+			}); err != nil {
+				errCh <- err
+				return
+			}
+		}
+	}()
+
+	if err := <-errCh; err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
 // GetNamespaceReplicationMessages returns new namespace replication tasks since last retrieved task ID.
 func (adh *AdminHandler) GetNamespaceReplicationMessages(ctx context.Context, request *adminservice.GetNamespaceReplicationMessagesRequest) (_ *adminservice.GetNamespaceReplicationMessagesResponse, retError error) {
 	defer log.CapturePanic(adh.GetLogger(), &retError)
@@ -1030,126 +1729,1830 @@ func (adh *AdminHandler) ResendReplicationTasks(
 	return &adminservice.ResendReplicationTasksResponse{}, nil
 }
 
-func (adh *AdminHandler) validateGetWorkflowExecutionRawHistoryV2Request(
-	request *adminservice.GetWorkflowExecutionRawHistoryV2Request,
-) error {
+// ExecuteMultiOperation starts the workflow if it is not running and delivers the signals to it atomically
+func (adh *AdminHandler) ExecuteMultiOperation(
+	ctx context.Context,
+	request *adminservice.ExecuteMultiOperationRequest,
+) (_ *adminservice.ExecuteMultiOperationResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
 
-	execution := request.Execution
-	if execution.GetWorkflowId() == "" {
-		return errWorkflowIDNotSet
-	}
-	// TODO currently, this API is only going to be used by re-send history events
-	// to remote cluster if kafka is lossy again, in the future, this API can be used
-	// by CLI and client, then empty runID (meaning the current workflow) should be allowed
-	if execution.GetRunId() == "" || uuid.Parse(execution.GetRunId()) == nil {
-		return errInvalidRunID
-	}
+	scope, sw := adh.startRequestProfile(metrics.AdminExecuteMultiOperationScope)
+	defer sw.Stop()
 
-	pageSize := int(request.GetMaximumPageSize())
-	if pageSize <= 0 {
-		return errInvalidPageSize
+	if err := adh.validateExecuteMultiOperationRequest(request); err != nil {
+		return nil, adh.error(err, scope)
 	}
 
-	if request.GetStartEventId() == common.EmptyEventID &&
-		request.GetStartEventVersion() == common.EmptyVersion &&
-		request.GetEndEventId() == common.EmptyEventID &&
-		request.GetEndEventVersion() == common.EmptyVersion {
-		return errInvalidEventQueryRange
+	// defaults are set on a copy to leave the caller's request untouched
+	startRequest := proto.Clone(request.GetStartRequest()).(*workflowservice.StartWorkflowExecutionRequest)
+	startRequest.Namespace = request.GetNamespace()
+	for _, signalRequest := range request.GetSignalRequests() {
+		if err := adh.workflowHandler.validateSignalWithStartWorkflowExecutionRequest(
+			newSignalWithStartRequest(startRequest, signalRequest),
+		); err != nil {
+			return nil, adh.error(err, scope)
+		}
 	}
+	enums.SetDefaultWorkflowIdReusePolicy(&startRequest.WorkflowIdReusePolicy)
 
-	if (request.GetStartEventId() != common.EmptyEventID && request.GetStartEventVersion() == common.EmptyVersion) ||
-		(request.GetStartEventId() == common.EmptyEventID && request.GetStartEventVersion() != common.EmptyVersion) {
-		return errInvalidStartEventCombination
+	namespaceID, err := adh.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
 	}
+	scope = scope.Tagged(metrics.NamespaceTag(request.GetNamespace()))
 
-	if (request.GetEndEventId() != common.EmptyEventID && request.GetEndEventVersion() == common.EmptyVersion) ||
-		(request.GetEndEventId() == common.EmptyEventID && request.GetEndEventVersion() != common.EmptyVersion) {
-		return errInvalidEndEventCombination
+	resp, err := adh.GetHistoryClient().ExecuteMultiOperation(ctx, &historyservice.ExecuteMultiOperationRequest{
+		NamespaceId:    namespaceID,
+		StartRequest:   startRequest,
+		SignalRequests: request.GetSignalRequests(),
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
 	}
-	return nil
+	return &adminservice.ExecuteMultiOperationResponse{
+		RunId:   resp.GetRunId(),
+		Started: resp.GetStarted(),
+	}, nil
 }
 
-func (adh *AdminHandler) setRequestDefaultValueAndGetTargetVersionHistory(
-	request *adminservice.GetWorkflowExecutionRawHistoryV2Request,
-	versionHistories *historyspb.VersionHistories,
-) (*historyspb.VersionHistory, error) {
+// ListNamespaceFailoverHistory returns the most recent failovers of the namespace
+func (adh *AdminHandler) ListNamespaceFailoverHistory(
+	ctx context.Context,
+	request *adminservice.ListNamespaceFailoverHistoryRequest,
+) (_ *adminservice.ListNamespaceFailoverHistoryResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
 
-	targetBranch, err := versionhistory.GetCurrentVersionHistory(versionHistories)
-	if err != nil {
-		return nil, err
+	scope, sw := adh.startRequestProfile(metrics.AdminListNamespaceFailoverHistoryScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
 	}
-	firstItem, err := versionhistory.GetFirstVersionHistoryItem(targetBranch)
-	if err != nil {
-		return nil, err
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
 	}
-	lastItem, err := versionhistory.GetLastVersionHistoryItem(targetBranch)
+
+	resp, err := adh.GetMetadataManager().GetNamespace(&persistence.GetNamespaceRequest{Name: request.GetNamespace()})
 	if err != nil {
-		return nil, err
+		return nil, adh.error(err, scope)
 	}
+	return &adminservice.ListNamespaceFailoverHistoryResponse{
+		FailoverHistory: resp.Namespace.GetFailoverHistory(),
+	}, nil
+}
 
-	if request.GetStartEventId() == common.EmptyVersion || request.GetStartEventVersion() == common.EmptyVersion {
-		// If start event is not set, get the events from the first event
-		// As the API is exclusive-exclusive, use first event id - 1 here
-		request.StartEventId = common.FirstEventID - 1
-		request.StartEventVersion = firstItem.GetVersion()
+// HandoverNamespace gracefully fails over namespace to target cluster
+func (adh *AdminHandler) HandoverNamespace(
+	ctx context.Context,
+	request *adminservice.HandoverNamespaceRequest,
+) (_ *adminservice.HandoverNamespaceResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope, sw := adh.startRequestProfile(metrics.AdminHandoverNamespaceScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
 	}
-	if request.GetEndEventId() == common.EmptyEventID || request.GetEndEventVersion() == common.EmptyVersion {
-		// If end event is not set, get the events until the end event
-		// As the API is exclusive-exclusive, use end event id + 1 here
-		request.EndEventId = lastItem.GetEventId() + 1
-		request.EndEventVersion = lastItem.GetVersion()
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
 	}
-
-	if request.GetStartEventId() < 0 {
-		return nil, errInvalidFirstNextEventCombination
+	if request.GetTargetCluster() == "" {
+		return nil, adh.error(errClusterNameNotSet, scope)
+	}
+	drainTimeout := timestamp.DurationValue(request.GetDrainTimeout())
+	if drainTimeout <= 0 {
+		drainTimeout = adh.config.NamespaceHandoverDrainTimeout()
 	}
 
-	// get branch based on the end event if end event is defined in the request
-	if request.GetEndEventId() == lastItem.GetEventId()+1 &&
-		request.GetEndEventVersion() == lastItem.GetVersion() {
-		// this is a special case, target branch remains the same
-	} else {
-		endItem := versionhistory.NewVersionHistoryItem(request.GetEndEventId(), request.GetEndEventVersion())
-		idx, err := versionhistory.FindFirstVersionHistoryIndexByVersionHistoryItem(versionHistories, endItem)
-		if err != nil {
-			return nil, err
-		}
-
-		targetBranch, err = versionhistory.GetVersionHistory(versionHistories, idx)
-		if err != nil {
-			return nil, err
-		}
+	if err := adh.namespaceHandler.StartNamespaceHandover(ctx, request.GetNamespace(), request.GetTargetCluster()); err != nil {
+		return nil, adh.error(err, scope)
 	}
 
-	startItem := versionhistory.NewVersionHistoryItem(request.GetStartEventId(), request.GetStartEventVersion())
-	// If the request start event is defined. The start event may be on a different branch as current branch.
-	// We need to find the LCA of the start event and the current branch.
-	if request.GetStartEventId() == common.FirstEventID-1 &&
-		request.GetStartEventVersion() == firstItem.GetVersion() {
-		// this is a special case, start event is on the same branch as target branch
-	} else {
-		if !versionhistory.ContainsVersionHistoryItem(targetBranch, startItem) {
-			idx, err := versionhistory.FindFirstVersionHistoryIndexByVersionHistoryItem(versionHistories, startItem)
-			if err != nil {
-				return nil, err
-			}
-			startBranch, err := versionhistory.GetVersionHistory(versionHistories, idx)
-			if err != nil {
-				return nil, err
-			}
-			startItem, err = versionhistory.FindLCAVersionHistoryItem(targetBranch, startBranch)
-			if err != nil {
-				return nil, err
-			}
-			request.StartEventId = startItem.GetEventId()
-			request.StartEventVersion = startItem.GetVersion()
+	err := adh.drainNamespaceReplication(ctx, request.GetTargetCluster(), drainTimeout)
+	var resp *workflowservice.UpdateNamespaceResponse
+	if err == nil {
+		resp, err = adh.namespaceHandler.UpdateNamespace(ctx, &workflowservice.UpdateNamespaceRequest{
+			Namespace: request.GetNamespace(),
+			ReplicationConfig: &replicationpb.NamespaceReplicationConfig{
+				ActiveClusterName: request.GetTargetCluster(),
+			},
+		})
+	}
+	if err != nil {
+		// namespace must not stay read-only if handover can't be completed
+		if abortErr := adh.namespaceHandler.AbortNamespaceHandover(context.Background(), request.GetNamespace()); abortErr != nil {
+			adh.GetLogger().Error("Failed to abort namespace handover.",
+				tag.WorkflowNamespace(request.GetNamespace()),
+				tag.Error(abortErr),
+			)
 		}
+		return nil, adh.error(err, scope)
 	}
 
-	return targetBranch, nil
+	return &adminservice.HandoverNamespaceResponse{
+		FailoverVersion: resp.GetFailoverVersion(),
+	}, nil
+}
+
+// DeleteNamespace marks namespace as deleted and starts the job which deletes its data in the current cluster.
+// For a global namespace it must be called in the master cluster first and then in each remote cluster
+// once the deletion is replicated.
+func (adh *AdminHandler) DeleteNamespace(
+	ctx context.Context,
+	request *adminservice.DeleteNamespaceRequest,
+) (_ *adminservice.DeleteNamespaceResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope, sw := adh.startRequestProfile(metrics.AdminDeleteNamespaceScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
+	}
+
+	namespaceID, err := adh.namespaceHandler.DeleteNamespace(ctx, request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	adh.GetLogger().Info("Starting delete namespace job.",
+		tag.WorkflowNamespace(request.GetNamespace()),
+		tag.WorkflowNamespaceID(namespaceID),
+		tag.NewStringTag("identity", request.GetIdentity()))
+	// job which is already running is returned
+	run, err := adh.GetSDKClient().ExecuteWorkflow(
+		ctx,
+		client.StartWorkflowOptions{
+			ID:                    deletenamespace.WorkflowID(request.GetNamespace()),
+			TaskQueue:             deletenamespace.TaskQueueName,
+			WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+		},
+		deletenamespace.WorkflowName,
+		deletenamespace.WorkflowParams{
+			Namespace:   request.GetNamespace(),
+			NamespaceID: namespaceID,
+		},
+	)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	return &adminservice.DeleteNamespaceResponse{
+		NamespaceId: namespaceID,
+		WorkflowId:  run.GetID(),
+		RunId:       run.GetRunID(),
+	}, nil
+}
+
+// DescribeNamespaceQuotas returns the quotas stored in namespace metadata
+func (adh *AdminHandler) DescribeNamespaceQuotas(
+	ctx context.Context,
+	request *adminservice.DescribeNamespaceQuotasRequest,
+) (_ *adminservice.DescribeNamespaceQuotasResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope, sw := adh.startRequestProfile(metrics.AdminDescribeNamespaceQuotasScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
+	}
+
+	quotas, err := adh.namespaceHandler.DescribeNamespaceQuotas(ctx, request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.DescribeNamespaceQuotasResponse{Quotas: quotas}, nil
+}
+
+// UpdateNamespaceQuotas replaces the quotas stored in namespace metadata
+func (adh *AdminHandler) UpdateNamespaceQuotas(
+	ctx context.Context,
+	request *adminservice.UpdateNamespaceQuotasRequest,
+) (_ *adminservice.UpdateNamespaceQuotasResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope, sw := adh.startRequestProfile(metrics.AdminUpdateNamespaceQuotasScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
+	}
+	if request.GetQuotas() == nil {
+		return nil, adh.error(errNamespaceQuotasNotSet, scope)
+	}
+
+	if err := adh.namespaceHandler.UpdateNamespaceQuotas(ctx, request.GetNamespace(), request.GetQuotas()); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.UpdateNamespaceQuotasResponse{Quotas: request.GetQuotas()}, nil
+}
+
+// RecordWorkflowTaskHeartbeat is called by application worker while processing a long running workflow task
+// (e.g. replaying a large history) to extend the workflow task start to close timeout.
+func (adh *AdminHandler) RecordWorkflowTaskHeartbeat(
+	ctx context.Context,
+	request *adminservice.RecordWorkflowTaskHeartbeatRequest,
+) (_ *adminservice.RecordWorkflowTaskHeartbeatResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope, sw := adh.startRequestProfile(metrics.AdminRecordWorkflowTaskHeartbeatScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
+	}
+	if request.TaskToken == nil {
+		return nil, adh.error(errTaskTokenNotSet, scope)
+	}
+	if len(request.GetIdentity()) > adh.config.MaxIDLengthLimit() {
+		return nil, adh.error(errIdentityTooLong, scope)
+	}
+
+	taskToken, err := adh.tokenSerializer.Deserialize(request.TaskToken)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	namespaceID, err := adh.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if taskToken.GetNamespaceId() != namespaceID {
+		return nil, adh.error(errTokenNamespaceMismatch, scope)
+	}
+
+	_, err = adh.GetHistoryClient().RecordWorkflowTaskHeartbeat(ctx, &historyservice.RecordWorkflowTaskHeartbeatRequest{
+		NamespaceId: namespaceID,
+		TaskToken:   request.TaskToken,
+		Identity:    request.GetIdentity(),
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.RecordWorkflowTaskHeartbeatResponse{}, nil
+}
+
+// GetReplicationLag returns replication progress, lag and DLQ depth of all history shards towards remote clusters.
+func (adh *AdminHandler) GetReplicationLag(
+	ctx context.Context,
+	request *adminservice.GetReplicationLagRequest,
+) (_ *adminservice.GetReplicationLagResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope, sw := adh.startRequestProfile(metrics.AdminGetReplicationLagScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	statusRequest := &historyservice.GetReplicationStatusRequest{
+		ShardIds:        make([]int32, 0, adh.numberOfHistoryShards),
+		RemoteClusters:  request.GetRemoteClusters(),
+		IncludeDlqDepth: true,
+	}
+	for shardID := int32(1); shardID <= adh.numberOfHistoryShards; shardID++ {
+		statusRequest.ShardIds = append(statusRequest.ShardIds, shardID)
+	}
+	statusResponse, err := adh.GetHistoryClient().GetReplicationStatus(ctx, statusRequest)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp := &adminservice.GetReplicationLagResponse{
+		Shards: make([]*adminservice.ShardReplicationLag, 0, len(statusResponse.GetShards())),
+	}
+	for _, shard := range statusResponse.GetShards() {
+		shardLag := &adminservice.ShardReplicationLag{
+			ShardId:              shard.GetShardId(),
+			MaxReplicationTaskId: shard.GetMaxReplicationTaskId(),
+			RemoteClusters:       make(map[string]*adminservice.ClusterReplicationLag, len(shard.GetRemoteClusters())),
+		}
+		for clusterName, clusterStatus := range shard.GetRemoteClusters() {
+			shardLag.RemoteClusters[clusterName] = &adminservice.ClusterReplicationLag{
+				AckedTaskId:    clusterStatus.GetAckedTaskId(),
+				ReplicationLag: clusterStatus.GetReplicationLag(),
+				DlqDepth:       clusterStatus.GetDlqDepth(),
+			}
+		}
+		resp.Shards = append(resp.Shards, shardLag)
+	}
+	// history hosts respond in arbitrary order
+	sort.Slice(resp.Shards, func(i, j int) bool {
+		return resp.Shards[i].GetShardId() < resp.Shards[j].GetShardId()
+	})
+	return resp, nil
+}
+
+// CompactWorkflowHistory rewrites the history event batches of a workflow into fewer, larger batches
+func (adh *AdminHandler) CompactWorkflowHistory(
+	ctx context.Context,
+	request *adminservice.CompactWorkflowHistoryRequest,
+) (_ *adminservice.CompactWorkflowHistoryResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminCompactWorkflowHistoryScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	namespaceEntry, err := adh.GetNamespaceCache().GetNamespace(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err := adh.GetHistoryClient().CompactWorkflowHistory(ctx, &historyservice.CompactWorkflowHistoryRequest{
+		NamespaceId: namespaceEntry.GetInfo().Id,
+		Request:     request,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.CompactWorkflowHistoryResponse{
+		BatchCountBefore: resp.GetBatchCountBefore(),
+		BatchCountAfter:  resp.GetBatchCountAfter(),
+	}, nil
+}
+
+// ListStaleWorkflowExecutions lists open workflow executions of a namespace started longer than the given duration ago.
+// Visibility store reads issued by this API are subject to the same per namespace list QPS limit as the list APIs of the frontend.
+func (adh *AdminHandler) ListStaleWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.ListStaleWorkflowExecutionsRequest,
+) (_ *adminservice.ListStaleWorkflowExecutionsResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminListStaleWorkflowExecutionsScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	namespaceName := request.GetNamespace()
+	if namespaceName == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
+	}
+	olderThan := timestamp.DurationValue(request.GetOlderThan())
+	if olderThan <= 0 {
+		return nil, adh.error(errOlderThanNotSet, scope)
+	}
+	namespaceID, err := adh.GetNamespaceCache().GetNamespaceID(namespaceName)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	maxPageSize := int32(adh.config.VisibilityMaxPageSize(namespaceName))
+	pageSize := request.GetMaximumPageSize()
+	if pageSize <= 0 || pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	resp, err := adh.GetVisibilityManager().ListOpenWorkflowExecutions(&visibility.ListWorkflowExecutionsRequest{
+		NamespaceID:       namespaceID,
+		Namespace:         namespaceName,
+		PageSize:          int(pageSize),
+		NextPageToken:     request.GetNextPageToken(),
+		EarliestStartTime: minTime,
+		LatestStartTime:   time.Now().UTC().Add(-olderThan),
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.ListStaleWorkflowExecutionsResponse{
+		Executions:    resp.Executions,
+		NextPageToken: resp.NextPageToken,
+	}, nil
+}
+
+// GetDynamicConfig returns dynamic config values of a key stored in the database.
+func (adh *AdminHandler) GetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigRequest,
+) (_ *adminservice.GetDynamicConfigResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminGetDynamicConfigScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetName() == "" {
+		return nil, adh.error(errDynamicConfigNameNotSet, scope)
+	}
+
+	dynamicConfig, err := adh.dynamicConfigManager.GetDynamicConfig()
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.GetDynamicConfigResponse{
+		Values: dynamicConfig[request.GetName()],
+	}, nil
+}
+
+// SetDynamicConfig replaces dynamic config values of a key stored in the database.
+func (adh *AdminHandler) SetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.SetDynamicConfigRequest,
+) (_ *adminservice.SetDynamicConfigResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminSetDynamicConfigScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetName() == "" {
+		return nil, adh.error(errDynamicConfigNameNotSet, scope)
+	}
+	for _, value := range request.GetValues() {
+		if err := dynamicconfig.ValidateStoredValue(request.GetName(), value); err != nil {
+			return nil, adh.error(serviceerror.NewInvalidArgument(fmt.Sprintf(errInvalidDynamicConfigValueMessage, err)), scope)
+		}
+	}
+
+	if err := adh.dynamicConfigManager.SetDynamicConfig(request.GetName(), request.GetValues()); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.SetDynamicConfigResponse{}, nil
+}
+
+// ListDynamicConfig returns all dynamic config values stored in the database.
+func (adh *AdminHandler) ListDynamicConfig(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigRequest,
+) (_ *adminservice.ListDynamicConfigResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminListDynamicConfigScope)
+	defer sw.Stop()
+
+	dynamicConfig, err := adh.dynamicConfigManager.GetDynamicConfig()
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.ListDynamicConfigResponse{
+		DynamicConfig: dynamicConfig,
+	}, nil
+}
+
+// ListThrottledCallers returns the namespaces and identities currently being throttled by
+// the rate limiters of this frontend host and of all history hosts.
+func (adh *AdminHandler) ListThrottledCallers(
+	ctx context.Context,
+	request *adminservice.ListThrottledCallersRequest,
+) (_ *adminservice.ListThrottledCallersResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	_, sw := adh.startRequestProfile(metrics.AdminListThrottledCallersScope)
+	defer sw.Stop()
+
+	callers := quotas.ThrottledCallersToProto(
+		adh.GetThrottleTracker().ThrottledCallers(request.GetNamespace()),
+		adh.GetHostInfo().GetAddress(),
+		common.FrontendServiceName,
+	)
+	for _, host := range adh.GetHistoryServiceResolver().Members() {
+		resp, err := adh.GetHistoryClient().ListThrottledCallers(ctx, &historyservice.ListThrottledCallersRequest{
+			HostAddress: host.GetAddress(),
+			Namespace:   request.GetNamespace(),
+		})
+		if err != nil {
+			// a single unhealthy host should not hide the throttling state of the rest of the cluster
+			adh.GetLogger().Warn("Failed to list throttled callers of history host.", tag.Address(host.GetAddress()), tag.Error(err))
+			continue
+		}
+		callers = append(callers, resp.Callers...)
+	}
+	return &adminservice.ListThrottledCallersResponse{
+		Callers: callers,
+	}, nil
+}
+
+// DescribeTaskQueuePartitions returns pollers, approximate backlog, read and ack levels of every partition of a task queue
+func (adh *AdminHandler) DescribeTaskQueuePartitions(
+	ctx context.Context,
+	request *adminservice.DescribeTaskQueuePartitionsRequest,
+) (_ *adminservice.DescribeTaskQueuePartitionsResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminDescribeTaskQueuePartitionsScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
+	}
+	if request.GetTaskQueue() == "" {
+		return nil, adh.error(errTaskQueueNotSet, scope)
+	}
+	namespaceID, err := adh.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	taskQueueType := request.GetTaskQueueType()
+	if taskQueueType == enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+		taskQueueType = enumspb.TASK_QUEUE_TYPE_WORKFLOW
+	}
+
+	resp, err := adh.GetMatchingClient().DescribeTaskQueuePartitions(ctx, &matchingservice.DescribeTaskQueuePartitionsRequest{
+		NamespaceId:   namespaceID,
+		TaskQueue:     request.GetTaskQueue(),
+		TaskQueueType: taskQueueType,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.DescribeTaskQueuePartitionsResponse{
+		Partitions: resp.GetPartitions(),
+	}, nil
+}
+
+// SetMaintenanceInfo sets the planned maintenance message returned to clients in response headers.
+func (adh *AdminHandler) SetMaintenanceInfo(
+	ctx context.Context,
+	request *adminservice.SetMaintenanceInfoRequest,
+) (_ *adminservice.SetMaintenanceInfoResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminSetMaintenanceInfoScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if len(request.GetMessage()) > maintenanceMessageMaxLength {
+		return nil, adh.error(errMaintenanceMessageTooLong, scope)
+	}
+	for _, r := range request.GetMessage() {
+		// Maintenance message is sent as a header value which only allows printable ASCII characters.
+		if r < 0x20 || r > 0x7E {
+			return nil, adh.error(errMaintenanceMessageNotPrintable, scope)
+		}
+	}
+
+	severity := request.GetSeverity()
+	if severity == enumspb.SEVERITY_UNSPECIFIED {
+		severity = enumspb.SEVERITY_LOW
+	}
+	if err := adh.maintenanceManager.SetMaintenanceInfo(request.GetMessage(), severity); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.SetMaintenanceInfoResponse{}, nil
+}
+
+// UpdateWorkerBuildIdCompatibility updates the compatible worker build id sets which workflow tasks of a task queue are routed to.
+func (adh *AdminHandler) UpdateWorkerBuildIdCompatibility(
+	ctx context.Context,
+	request *adminservice.UpdateWorkerBuildIdCompatibilityRequest,
+) (_ *adminservice.UpdateWorkerBuildIdCompatibilityResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminUpdateWorkerBuildIdCompatibilityScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
+	}
+	if request.GetTaskQueue() == "" {
+		return nil, adh.error(errTaskQueueNotSet, scope)
+	}
+	if request.GetUpdate().GetOperation() == nil {
+		return nil, adh.error(errBuildIDCompatibilityUpdateNotSet, scope)
+	}
+	namespaceID, err := adh.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err := adh.GetMatchingClient().UpdateWorkerBuildIdCompatibility(ctx, &matchingservice.UpdateWorkerBuildIdCompatibilityRequest{
+		NamespaceId: namespaceID,
+		TaskQueue:   request.GetTaskQueue(),
+		Update:      request.GetUpdate(),
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.UpdateWorkerBuildIdCompatibilityResponse{
+		VersioningData: resp.GetVersioningData(),
+	}, nil
+}
+
+// GetWorkerBuildIdCompatibility returns the compatible worker build id sets of a task queue.
+func (adh *AdminHandler) GetWorkerBuildIdCompatibility(
+	ctx context.Context,
+	request *adminservice.GetWorkerBuildIdCompatibilityRequest,
+) (_ *adminservice.GetWorkerBuildIdCompatibilityResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminGetWorkerBuildIdCompatibilityScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
+	}
+	if request.GetTaskQueue() == "" {
+		return nil, adh.error(errTaskQueueNotSet, scope)
+	}
+	namespaceID, err := adh.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err := adh.GetMatchingClient().GetWorkerBuildIdCompatibility(ctx, &matchingservice.GetWorkerBuildIdCompatibilityRequest{
+		NamespaceId: namespaceID,
+		TaskQueue:   request.GetTaskQueue(),
+		MaxSets:     request.GetMaxSets(),
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.GetWorkerBuildIdCompatibilityResponse{
+		VersioningData: resp.GetVersioningData(),
+	}, nil
+}
+
+// ShutdownWorker evicts the sticky task queue of a worker which shuts down, so workflows which are sticky
+// to the worker are dispatched to other workers right away instead of after the sticky schedule to start timeout.
+func (adh *AdminHandler) ShutdownWorker(
+	ctx context.Context,
+	request *adminservice.ShutdownWorkerRequest,
+) (_ *adminservice.ShutdownWorkerResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminShutdownWorkerScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
+	}
+	if request.GetStickyTaskQueue() == "" {
+		return nil, adh.error(errStickyTaskQueueNotSet, scope)
+	}
+	namespaceID, err := adh.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	adh.GetLogger().Info("Evicting sticky task queue of worker shutting down.",
+		tag.WorkflowNamespace(request.GetNamespace()),
+		tag.WorkflowTaskQueueName(request.GetStickyTaskQueue()),
+		tag.NewStringTag("worker-identity", request.GetIdentity()),
+		tag.NewStringTag("reason", request.GetReason()))
+	if _, err := adh.GetMatchingClient().EvictStickyTaskQueue(ctx, &matchingservice.EvictStickyTaskQueueRequest{
+		NamespaceId: namespaceID,
+		TaskQueue:   request.GetStickyTaskQueue(),
+	}); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.ShutdownWorkerResponse{}, nil
+}
+
+// ImportWorkflowExecution creates a workflow execution from its history and mutable state in another cluster
+func (adh *AdminHandler) ImportWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ImportWorkflowExecutionRequest,
+) (_ *adminservice.ImportWorkflowExecutionResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminImportWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
+	}
+	execution := request.GetExecution()
+	if err := validateExecution(execution); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if execution.GetRunId() == "" {
+		return nil, adh.error(errInvalidRunID, scope)
+	}
+	if len(request.GetHistoryBatches()) == 0 {
+		return nil, adh.error(errHistoryBatchesNotSet, scope)
+	}
+	mutableState := request.GetMutableState()
+	if mutableState == nil {
+		return nil, adh.error(errMutableStateNotSet, scope)
+	}
+	if mutableState.GetExecutionInfo().GetWorkflowId() != execution.GetWorkflowId() ||
+		mutableState.GetExecutionState().GetRunId() != execution.GetRunId() {
+		return nil, adh.error(errMutableStateExecutionMismatch, scope)
+	}
+	versionHistories := mutableState.GetExecutionInfo().GetVersionHistories()
+	if versionHistories == nil {
+		return nil, adh.error(errInvalidVersionHistories, scope)
+	}
+	versionHistory, err := versionhistory.GetCurrentVersionHistory(versionHistories)
+	if err != nil || versionhistory.IsEmptyVersionHistory(versionHistory) {
+		return nil, adh.error(errInvalidVersionHistories, scope)
+	}
+	if err := adh.validateImportVersionHistory(versionHistory); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	lastEventID, err := adh.validateImportHistoryBatches(request.GetHistoryBatches(), mutableState, versionHistory)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	namespaceID, err := adh.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	// the batches are applied the same way as replicated events, which creates the execution on the
+	// first batch and deduplicates batches which were already imported by a previous request
+	for _, historyBatch := range request.GetHistoryBatches() {
+		if _, err := adh.GetHistoryClient().ReplicateEventsV2(ctx, &historyservice.ReplicateEventsV2Request{
+			NamespaceId:         namespaceID,
+			WorkflowExecution:   execution,
+			VersionHistoryItems: versionHistory.GetItems(),
+			Events:              historyBatch,
+		}); err != nil {
+			return nil, adh.error(err, scope)
+		}
+	}
+	if lastEventID != mutableState.GetNextEventId()-1 {
+		// more batches follow in another request
+		return &adminservice.ImportWorkflowExecutionResponse{}, nil
+	}
+
+	// activity attempts, heartbeats and retry failures are not written to history until the activity
+	// completes, they are synced from the snapshot the same way as replicated activities
+	for _, activityInfo := range mutableState.GetActivityInfos() {
+		var startedTime *time.Time
+		if activityInfo.GetStartedId() != common.EmptyEventID {
+			startedTime = activityInfo.GetStartedTime()
+		}
+		if _, err := adh.GetHistoryClient().SyncActivity(ctx, &historyservice.SyncActivityRequest{
+			NamespaceId:        namespaceID,
+			WorkflowId:         execution.GetWorkflowId(),
+			RunId:              execution.GetRunId(),
+			Version:            activityInfo.GetVersion(),
+			ScheduledId:        activityInfo.GetScheduleId(),
+			ScheduledTime:      activityInfo.GetScheduledTime(),
+			StartedId:          activityInfo.GetStartedId(),
+			StartedTime:        startedTime,
+			LastHeartbeatTime:  activityInfo.GetLastHeartbeatUpdateTime(),
+			Details:            activityInfo.GetLastHeartbeatDetails(),
+			Attempt:            activityInfo.GetAttempt(),
+			LastFailure:        activityInfo.GetRetryLastFailure(),
+			LastWorkerIdentity: activityInfo.GetRetryLastWorkerIdentity(),
+			VersionHistory:     versionHistory,
+		}); err != nil {
+			return nil, adh.error(err, scope)
+		}
+	}
+
+	resp, err := adh.GetHistoryClient().DescribeMutableState(ctx, &historyservice.DescribeMutableStateRequest{
+		NamespaceId: namespaceID,
+		Execution:   execution,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	imported := resp.GetDatabaseMutableState()
+	if imported.GetNextEventId() != mutableState.GetNextEventId() ||
+		imported.GetExecutionState().GetStatus() != mutableState.GetExecutionState().GetStatus() {
+		return nil, adh.error(serviceerror.NewInternal(fmt.Sprintf(
+			"Imported mutable state doesn't match the snapshot, next event ID %v and status %v instead of %v and %v.",
+			imported.GetNextEventId(),
+			imported.GetExecutionState().GetStatus(),
+			mutableState.GetNextEventId(),
+			mutableState.GetExecutionState().GetStatus(),
+		)), scope)
+	}
+	return &adminservice.ImportWorkflowExecutionResponse{MutableState: imported}, nil
+}
+
+// validateImportHistoryBatches makes sure that the imported batches contain consecutive events of the current
+// version history of the mutable state snapshot, and returns the ID of the last imported event.
+func (adh *AdminHandler) validateImportHistoryBatches(
+	historyBatches []*commonpb.DataBlob,
+	mutableState *persistencespb.WorkflowMutableState,
+	versionHistory *historyspb.VersionHistory,
+) (int64, error) {
+	lastEventID := common.EmptyEventID
+	for _, historyBatch := range historyBatches {
+		events, err := adh.eventSerializer.DeserializeEvents(historyBatch)
+		if err != nil {
+			return 0, serviceerror.NewInvalidArgument(fmt.Sprintf("Unable to deserialize history batch: %v.", err))
+		}
+		if len(events) == 0 {
+			return 0, serviceerror.NewInvalidArgument("History batch doesn't contain any events.")
+		}
+		for _, event := range events {
+			if lastEventID != common.EmptyEventID && event.GetEventId() != lastEventID+1 {
+				return 0, serviceerror.NewInvalidArgument(fmt.Sprintf(
+					"History batches are not consecutive, event %v follows event %v.", event.GetEventId(), lastEventID))
+			}
+			if event.GetEventId() >= mutableState.GetNextEventId() {
+				return 0, serviceerror.NewInvalidArgument(fmt.Sprintf(
+					"Event %v is after the last event %v of the mutable state.", event.GetEventId(), mutableState.GetNextEventId()-1))
+			}
+			version, err := versionhistory.GetVersionHistoryEventVersion(versionHistory, event.GetEventId())
+			if err != nil || version != event.GetVersion() {
+				return 0, serviceerror.NewInvalidArgument(fmt.Sprintf(
+					"Event %v with version %v is not on the current version history branch of the mutable state.", event.GetEventId(), event.GetVersion()))
+			}
+			lastEventID = event.GetEventId()
+		}
+	}
+	return lastEventID, nil
+}
+
+// validateImportVersionHistory makes sure that all failover versions of the imported history map to a cluster
+// known to the current cluster, events with other versions can't be applied with the replication semantics.
+func (adh *AdminHandler) validateImportVersionHistory(versionHistory *historyspb.VersionHistory) error {
+	clusterMetadata := adh.GetClusterMetadata()
+	for _, item := range versionHistory.GetItems() {
+		if item.GetVersion() == common.EmptyVersion {
+			continue
+		}
+		known := false
+		for _, clusterInfo := range clusterMetadata.GetAllClusterInfo() {
+			if clusterMetadata.IsVersionFromSameCluster(item.GetVersion(), clusterInfo.InitialFailoverVersion) {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return serviceerror.NewInvalidArgument(fmt.Sprintf("Failover version %v of the imported history does not belong to a known cluster.", item.GetVersion()))
+		}
+	}
+	return nil
+}
+
+// CreateSchedule creates a schedule. Each schedule is run by a scheduler workflow in the system namespace.
+func (adh *AdminHandler) CreateSchedule(
+	ctx context.Context,
+	request *adminservice.CreateScheduleRequest,
+) (_ *adminservice.CreateScheduleResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminCreateScheduleScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	namespaceID, err := adh.validateScheduleRequest(request.GetNamespace(), request.GetScheduleId())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if err := validateSchedule(request.GetSchedule()); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if err := validateSchedulePatch(request.GetInitialPatch()); err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	adh.GetLogger().Info("Creating schedule.",
+		tag.WorkflowNamespace(request.GetNamespace()),
+		tag.NewStringTag("schedule-id", request.GetScheduleId()),
+		tag.NewStringTag("identity", request.GetIdentity()))
+	workflowID := scheduler.WorkflowID(namespaceID, request.GetScheduleId())
+	_, err = adh.GetSDKClient().ExecuteWorkflow(
+		ctx,
+		client.StartWorkflowOptions{
+			ID:                                       workflowID,
+			TaskQueue:                                scheduler.TaskQueueName,
+			WorkflowIDReusePolicy:                    enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+			WorkflowExecutionErrorWhenAlreadyStarted: true,
+		},
+		scheduler.WorkflowName,
+		&schedpb.StartScheduleArgs{
+			Schedule: request.GetSchedule(),
+			State: &schedpb.InternalState{
+				Namespace:   request.GetNamespace(),
+				NamespaceId: namespaceID,
+				ScheduleId:  request.GetScheduleId(),
+			},
+		},
+	)
+	if err != nil {
+		if _, ok := err.(*serviceerror.WorkflowExecutionAlreadyStarted); ok {
+			return nil, adh.error(errScheduleAlreadyExists, scope)
+		}
+		return nil, adh.error(err, scope)
+	}
+	if request.GetInitialPatch() != nil {
+		if err := adh.GetSDKClient().SignalWorkflow(ctx, workflowID, "", scheduler.SignalNamePatch, request.GetInitialPatch()); err != nil {
+			return nil, adh.error(translateScheduleError(err), scope)
+		}
+	}
+	return &adminservice.CreateScheduleResponse{}, nil
+}
+
+// DescribeSchedule returns the schedule and its current state
+func (adh *AdminHandler) DescribeSchedule(
+	ctx context.Context,
+	request *adminservice.DescribeScheduleRequest,
+) (_ *adminservice.DescribeScheduleResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminDescribeScheduleScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	namespaceID, err := adh.validateScheduleRequest(request.GetNamespace(), request.GetScheduleId())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err := adh.GetSDKClient().QueryWorkflowWithOptions(ctx, &client.QueryWorkflowWithOptionsRequest{
+		WorkflowID: scheduler.WorkflowID(namespaceID, request.GetScheduleId()),
+		QueryType:  scheduler.QueryNameDescribe,
+		// closed scheduler workflows belong to deleted schedules
+		QueryRejectCondition: enumspb.QUERY_REJECT_CONDITION_NOT_OPEN,
+	})
+	if err != nil {
+		return nil, adh.error(translateScheduleError(err), scope)
+	}
+	if resp.QueryRejected != nil {
+		return nil, adh.error(errScheduleNotFound, scope)
+	}
+	var description schedpb.DescribeResponse
+	if err := resp.QueryResult.Get(&description); err != nil {
+		return nil, adh.error(serviceerror.NewInternal(err.Error()), scope)
+	}
+	return &adminservice.DescribeScheduleResponse{
+		Schedule:      description.GetSchedule(),
+		Info:          description.GetInfo(),
+		ConflictToken: description.GetConflictToken(),
+	}, nil
+}
+
+// UpdateSchedule replaces the schedule, the state of the schedule, e.g. its recent actions, is kept
+func (adh *AdminHandler) UpdateSchedule(
+	ctx context.Context,
+	request *adminservice.UpdateScheduleRequest,
+) (_ *adminservice.UpdateScheduleResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminUpdateScheduleScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	namespaceID, err := adh.validateScheduleRequest(request.GetNamespace(), request.GetScheduleId())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if err := validateSchedule(request.GetSchedule()); err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	if err := adh.GetSDKClient().SignalWorkflow(
+		ctx,
+		scheduler.WorkflowID(namespaceID, request.GetScheduleId()),
+		"",
+		scheduler.SignalNameUpdate,
+		&schedpb.FullUpdateRequest{
+			Schedule:      request.GetSchedule(),
+			ConflictToken: request.GetConflictToken(),
+		},
+	); err != nil {
+		return nil, adh.error(translateScheduleError(err), scope)
+	}
+	return &adminservice.UpdateScheduleResponse{}, nil
+}
+
+// PatchSchedule triggers, backfills, pauses or unpauses the schedule
+func (adh *AdminHandler) PatchSchedule(
+	ctx context.Context,
+	request *adminservice.PatchScheduleRequest,
+) (_ *adminservice.PatchScheduleResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminPatchScheduleScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	namespaceID, err := adh.validateScheduleRequest(request.GetNamespace(), request.GetScheduleId())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if request.GetPatch() == nil {
+		return nil, adh.error(errSchedulePatchNotSet, scope)
+	}
+	if err := validateSchedulePatch(request.GetPatch()); err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	if err := adh.GetSDKClient().SignalWorkflow(
+		ctx,
+		scheduler.WorkflowID(namespaceID, request.GetScheduleId()),
+		"",
+		scheduler.SignalNamePatch,
+		request.GetPatch(),
+	); err != nil {
+		return nil, adh.error(translateScheduleError(err), scope)
+	}
+	return &adminservice.PatchScheduleResponse{}, nil
+}
+
+// DeleteSchedule deletes the schedule by terminating its scheduler workflow
+func (adh *AdminHandler) DeleteSchedule(
+	ctx context.Context,
+	request *adminservice.DeleteScheduleRequest,
+) (_ *adminservice.DeleteScheduleResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminDeleteScheduleScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	namespaceID, err := adh.validateScheduleRequest(request.GetNamespace(), request.GetScheduleId())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	adh.GetLogger().Info("Deleting schedule.",
+		tag.WorkflowNamespace(request.GetNamespace()),
+		tag.NewStringTag("schedule-id", request.GetScheduleId()),
+		tag.NewStringTag("identity", request.GetIdentity()))
+	if err := adh.GetSDKClient().TerminateWorkflow(
+		ctx,
+		scheduler.WorkflowID(namespaceID, request.GetScheduleId()),
+		"",
+		"schedule deleted",
+	); err != nil {
+		return nil, adh.error(translateScheduleError(err), scope)
+	}
+	return &adminservice.DeleteScheduleResponse{}, nil
+}
+
+// ListSchedules lists the schedules of a namespace. Scheduler workflows of all namespaces are paged through
+// together, so a page may contain fewer schedules than the page size even if there are more.
+func (adh *AdminHandler) ListSchedules(
+	ctx context.Context,
+	request *adminservice.ListSchedulesRequest,
+) (_ *adminservice.ListSchedulesResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminListSchedulesScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
+	}
+	namespaceID, err := adh.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err := adh.GetSDKClient().ListOpenWorkflow(ctx, &workflowservice.ListOpenWorkflowExecutionsRequest{
+		Namespace:       common.SystemLocalNamespace,
+		MaximumPageSize: request.GetMaximumPageSize(),
+		NextPageToken:   request.GetNextPageToken(),
+		Filters: &workflowservice.ListOpenWorkflowExecutionsRequest_TypeFilter{
+			TypeFilter: &filterpb.WorkflowTypeFilter{Name: scheduler.WorkflowName},
+		},
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	prefix := scheduler.WorkflowID(namespaceID, "")
+	var scheduleIDs []string
+	for _, execution := range resp.GetExecutions() {
+		if workflowID := execution.GetExecution().GetWorkflowId(); strings.HasPrefix(workflowID, prefix) {
+			scheduleIDs = append(scheduleIDs, strings.TrimPrefix(workflowID, prefix))
+		}
+	}
+	return &adminservice.ListSchedulesResponse{
+		ScheduleIds:   scheduleIDs,
+		NextPageToken: resp.GetNextPageToken(),
+	}, nil
+}
+
+// StartResetBadBinaryJob starts a batch job which resets the executions that ran a bad binary of the namespace
+func (adh *AdminHandler) StartResetBadBinaryJob(
+	ctx context.Context,
+	request *adminservice.StartResetBadBinaryJobRequest,
+) (_ *adminservice.StartResetBadBinaryJobResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminStartResetBadBinaryJobScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
+	}
+	if request.GetBinaryChecksum() == "" {
+		return nil, adh.error(errBinaryChecksumNotSet, scope)
+	}
+	if request.GetReason() == "" {
+		return nil, adh.error(errReasonNotSet, scope)
+	}
+	namespaceEntry, err := adh.GetNamespaceCache().GetNamespace(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if _, ok := namespaceEntry.GetConfig().GetBadBinaries().GetBinaries()[request.GetBinaryChecksum()]; !ok {
+		return nil, adh.error(errBinaryChecksumNotBad, scope)
+	}
+
+	query := fmt.Sprintf("%s = '%s'", searchattribute.BinaryChecksums, request.GetBinaryChecksum())
+	if !request.GetIncludeClosed() {
+		query += fmt.Sprintf(" AND %s = '%s'", searchattribute.ExecutionStatus, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)
+	}
+	countResp, err := adh.GetSDKClient().CountWorkflow(ctx, &workflowservice.CountWorkflowExecutionsRequest{
+		Namespace: request.GetNamespace(),
+		Query:     query,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	adh.GetLogger().Info("Starting reset bad binary job.",
+		tag.WorkflowNamespace(request.GetNamespace()),
+		tag.NewStringTag("binary-checksum", request.GetBinaryChecksum()),
+		tag.Counter(int(countResp.GetCount())),
+		tag.NewStringTag("identity", request.GetIdentity()))
+	run, err := adh.GetSDKClient().ExecuteWorkflow(
+		ctx,
+		client.StartWorkflowOptions{
+			ID:                                       batcher.ResetBadBinaryJobID(request.GetNamespace(), request.GetBinaryChecksum()),
+			TaskQueue:                                batcher.BatcherTaskQueueName,
+			WorkflowIDReusePolicy:                    enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+			WorkflowExecutionErrorWhenAlreadyStarted: true,
+			Memo: map[string]interface{}{
+				"Reason": request.GetReason(),
+			},
+			SearchAttributes: map[string]interface{}{
+				searchattribute.BatcherNamespace: request.GetNamespace(),
+				searchattribute.BatcherUser:      request.GetIdentity(),
+			},
+		},
+		batcher.BatchWFTypeName,
+		batcher.BatchParams{
+			Namespace: request.GetNamespace(),
+			Query:     query,
+			Reason:    request.GetReason(),
+			BatchType: batcher.BatchTypeResetBadBinary,
+			ResetBadBinaryParams: batcher.ResetBadBinaryParams{
+				BinaryChecksum:   request.GetBinaryChecksum(),
+				ResetReapplyType: request.GetResetReapplyType(),
+			},
+			RPS: int(request.GetRps()),
+		},
+	)
+	if err != nil {
+		if _, ok := err.(*serviceerror.WorkflowExecutionAlreadyStarted); ok {
+			return nil, adh.error(errResetBadBinaryJobAlreadyRunning, scope)
+		}
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.StartResetBadBinaryJobResponse{
+		JobId:          run.GetID(),
+		EstimatedCount: countResp.GetCount(),
+	}, nil
+}
+
+// GetResetPoints returns the auto-reset points of a workflow execution
+func (adh *AdminHandler) GetResetPoints(
+	ctx context.Context,
+	request *adminservice.GetResetPointsRequest,
+) (_ *adminservice.GetResetPointsResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminGetResetPointsScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	namespaceEntry, err := adh.GetNamespaceCache().GetNamespace(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err := adh.GetHistoryClient().DescribeWorkflowExecution(ctx, &historyservice.DescribeWorkflowExecutionRequest{
+		NamespaceId: namespaceEntry.GetInfo().Id,
+		Request: &workflowservice.DescribeWorkflowExecutionRequest{
+			Namespace: request.GetNamespace(),
+			Execution: request.Execution,
+		},
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	now := adh.GetTimeSource().Now()
+	badBinaries := namespaceEntry.GetConfig().GetBadBinaries().GetBinaries()
+	points := resp.GetWorkflowExecutionInfo().GetAutoResetPoints().GetPoints()
+	resetPoints := make([]*adminservice.ResetPoint, 0, len(points))
+	for _, point := range points {
+		expireTime := timestamp.TimeValue(point.GetExpireTime())
+		_, badBinary := badBinaries[point.GetBinaryChecksum()]
+		resetPoints = append(resetPoints, &adminservice.ResetPoint{
+			BuildId:                      point.GetBinaryChecksum(),
+			RunId:                        point.GetRunId(),
+			FirstWorkflowTaskCompletedId: point.GetFirstWorkflowTaskCompletedId(),
+			CreateTime:                   point.GetCreateTime(),
+			ExpireTime:                   point.GetExpireTime(),
+			Resettable:                   point.GetResettable() && (expireTime.IsZero() || !now.After(expireTime)),
+			BadBinary:                    badBinary,
+		})
+	}
+	return &adminservice.GetResetPointsResponse{
+		ResetPoints: resetPoints,
+	}, nil
+}
+
+// drainNamespaceReplication waits until all replication tasks generated by history shards
+// before namespace entered handover state are acknowledged by target cluster.
+func (adh *AdminHandler) drainNamespaceReplication(
+	ctx context.Context,
+	targetCluster string,
+	timeout time.Duration,
+) error {
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	errDrainTimeout := serviceerror.NewDeadlineExceeded(
+		fmt.Sprintf("Replication to cluster %v is not drained within %v.", targetCluster, timeout),
+	)
+
+	// no replication tasks are generated for the namespace once all hosts observe handover state
+	select {
+	case <-time.After(adh.namespaceHandoverPropagationDelay):
+	case <-ctx.Done():
+		return errDrainTimeout
+	}
+
+	pendingShards := make(map[int32]struct{}, adh.numberOfHistoryShards)
+	for shardID := int32(1); shardID <= adh.numberOfHistoryShards; shardID++ {
+		pendingShards[shardID] = struct{}{}
+	}
+	drainLevels := make(map[int32]int64, adh.numberOfHistoryShards)
+
+	ticker := time.NewTicker(namespaceHandoverDrainPollInterval)
+	defer ticker.Stop()
+	for {
+		request := &historyservice.GetReplicationStatusRequest{
+			ShardIds:       make([]int32, 0, len(pendingShards)),
+			RemoteClusters: []string{targetCluster},
+		}
+		for shardID := range pendingShards {
+			request.ShardIds = append(request.ShardIds, shardID)
+		}
+
+		resp, err := adh.GetHistoryClient().GetReplicationStatus(ctx, request)
+		if err != nil {
+			adh.GetLogger().Warn("Failed to get replication status.", tag.Error(err))
+		}
+		for _, shard := range resp.GetShards() {
+			shardID := shard.GetShardId()
+			// shards which were not reported yet record their level at the time of first report
+			if _, ok := drainLevels[shardID]; !ok {
+				drainLevels[shardID] = shard.GetMaxReplicationTaskId()
+			}
+			if shard.GetRemoteClusters()[targetCluster].GetAckedTaskId() >= drainLevels[shardID] {
+				delete(pendingShards, shardID)
+			}
+		}
+		if len(pendingShards) == 0 {
+			return nil
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return errDrainTimeout
+		}
+	}
+}
+
+func (adh *AdminHandler) validateGetWorkflowExecutionRawHistoryV2Request(
+	request *adminservice.GetWorkflowExecutionRawHistoryV2Request,
+) error {
+
+	execution := request.Execution
+	if execution.GetWorkflowId() == "" {
+		return errWorkflowIDNotSet
+	}
+	// TODO currently, this API is only going to be used by re-send history events
+	// to remote cluster if kafka is lossy again, in the future, this API can be used
+	// by CLI and client, then empty runID (meaning the current workflow) should be allowed
+	if execution.GetRunId() == "" || uuid.Parse(execution.GetRunId()) == nil {
+		return errInvalidRunID
+	}
+
+	pageSize := int(request.GetMaximumPageSize())
+	if pageSize <= 0 {
+		return errInvalidPageSize
+	}
+
+	if request.GetStartEventId() == common.EmptyEventID &&
+		request.GetStartEventVersion() == common.EmptyVersion &&
+		request.GetEndEventId() == common.EmptyEventID &&
+		request.GetEndEventVersion() == common.EmptyVersion {
+		return errInvalidEventQueryRange
+	}
+
+	if (request.GetStartEventId() != common.EmptyEventID && request.GetStartEventVersion() == common.EmptyVersion) ||
+		(request.GetStartEventId() == common.EmptyEventID && request.GetStartEventVersion() != common.EmptyVersion) {
+		return errInvalidStartEventCombination
+	}
+
+	if (request.GetEndEventId() != common.EmptyEventID && request.GetEndEventVersion() == common.EmptyVersion) ||
+		(request.GetEndEventId() == common.EmptyEventID && request.GetEndEventVersion() != common.EmptyVersion) {
+		return errInvalidEndEventCombination
+	}
+	return nil
+}
+
+func (adh *AdminHandler) validateGetWorkflowExecutionHistoryReverseRequest(
+	request *adminservice.GetWorkflowExecutionHistoryReverseRequest,
+) error {
+
+	if request == nil {
+		return errRequestNotSet
+	}
+
+	execution := request.Execution
+	if execution.GetWorkflowId() == "" {
+		return errWorkflowIDNotSet
+	}
+	// empty runID means the current run of the workflow
+	if execution.GetRunId() != "" && uuid.Parse(execution.GetRunId()) == nil {
+		return errInvalidRunID
+	}
+
+	pageSize := int(request.GetMaximumPageSize())
+	if pageSize <= 0 {
+		return errInvalidPageSize
+	}
+	return nil
+}
+
+func (adh *AdminHandler) validateStreamWorkflowExecutionHistoryRequest(
+	request *adminservice.StreamWorkflowExecutionHistoryRequest,
+) error {
+
+	if request == nil {
+		return errRequestNotSet
+	}
+
+	execution := request.Execution
+	if execution.GetWorkflowId() == "" {
+		return errWorkflowIDNotSet
+	}
+	// empty runID means the current run of the workflow
+	if execution.GetRunId() != "" && uuid.Parse(execution.GetRunId()) == nil {
+		return errInvalidRunID
+	}
+
+	if request.GetMaximumPageSize() < 0 {
+		return errInvalidPageSize
+	}
+	return nil
+}
+
+// readHistoryEventsBetween reads the history batches from readFromEventID up to maxEventID and hands
+// the events with ID not less than minEventID to consume in slices of at most pageSize events.
+func (adh *AdminHandler) readHistoryEventsBetween(
+	shardID int32,
+	branchToken []byte,
+	readFromEventID int64,
+	minEventID int64,
+	maxEventID int64,
+	pageSize int,
+	consume func([]*historypb.HistoryEvent) error,
+) error {
+
+	var events []*historypb.HistoryEvent
+	var token []byte
+	for {
+		response, err := adh.GetHistoryManager().ReadRawHistoryBranch(&persistence.ReadHistoryBranchRequest{
+			BranchToken:   branchToken,
+			MinEventID:    readFromEventID,
+			MaxEventID:    maxEventID,
+			PageSize:      pageSize,
+			NextPageToken: token,
+			ShardID:       shardID,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, blob := range response.HistoryEventBlobs {
+			batch, err := adh.eventSerializer.DeserializeEvents(blob)
+			if err != nil {
+				return err
+			}
+			for _, event := range batch {
+				if event.GetEventId() >= minEventID && event.GetEventId() < maxEventID {
+					events = append(events, event)
+				}
+			}
+		}
+		for len(events) >= pageSize {
+			if err := consume(events[:pageSize]); err != nil {
+				return err
+			}
+			events = events[pageSize:]
+		}
+
+		if len(response.NextPageToken) == 0 {
+			break
+		}
+		token = response.NextPageToken
+	}
+
+	if len(events) > 0 {
+		return consume(events)
+	}
+	return nil
+}
+
+// readHistoryEventsBefore returns the contiguous events of the trailing history batches
+// which end right before maxEventID, events with ID not less than maxEventID are dropped.
+func (adh *AdminHandler) readHistoryEventsBefore(
+	shardID int32,
+	branchToken []byte,
+	maxEventID int64,
+	pageSize int,
+) ([]*historypb.HistoryEvent, error) {
+
+	// history nodes are keyed by the first event ID of the batch, so the batch containing
+	// maxEventID-1 can start before the window, in which case the window is widened
+	minEventID := maxEventID
+	for minEventID > common.FirstEventID {
+		minEventID -= int64(pageSize)
+		if minEventID < common.FirstEventID {
+			minEventID = common.FirstEventID
+		}
+
+		var events []*historypb.HistoryEvent
+		var token []byte
+		for {
+			response, err := adh.GetHistoryManager().ReadRawHistoryBranch(&persistence.ReadHistoryBranchRequest{
+				BranchToken:   branchToken,
+				MinEventID:    minEventID,
+				MaxEventID:    maxEventID,
+				PageSize:      pageSize,
+				NextPageToken: token,
+				ShardID:       shardID,
+			})
+			if _, ok := err.(*serviceerror.NotFound); ok {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			for _, blob := range response.HistoryEventBlobs {
+				batch, err := adh.eventSerializer.DeserializeEvents(blob)
+				if err != nil {
+					return nil, err
+				}
+				for _, event := range batch {
+					if event.GetEventId() < maxEventID {
+						events = append(events, event)
+					}
+				}
+			}
+			if len(response.NextPageToken) == 0 {
+				break
+			}
+			token = response.NextPageToken
+		}
+		if len(events) == 0 {
+			continue
+		}
+
+		for i, event := range events {
+			if i > 0 && event.GetEventId() != events[i-1].GetEventId()+1 {
+				return nil, serviceerror.NewDataLoss("corrupted history event batch, eventID is not contiguous")
+			}
+		}
+		if events[len(events)-1].GetEventId() != maxEventID-1 {
+			return nil, serviceerror.NewDataLoss("corrupted history event batch, eventID is not contiguous")
+		}
+		return events, nil
+	}
+	return nil, serviceerror.NewNotFound("Workflow execution history not found.")
+}
+
+func (adh *AdminHandler) validateExecuteMultiOperationRequest(
+	request *adminservice.ExecuteMultiOperationRequest,
+) error {
+
+	if request == nil {
+		return errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return errNamespaceNotSet
+	}
+
+	startRequest := request.GetStartRequest()
+	if startRequest == nil {
+		return errStartRequestNotSet
+	}
+	if startRequest.GetNamespace() != "" && startRequest.GetNamespace() != request.GetNamespace() {
+		return errStartRequestNamespaceMismatch
+	}
+	if startRequest.GetWorkflowId() == "" {
+		return errWorkflowIDNotSet
+	}
+	if startRequest.GetRequestId() == "" {
+		return errRequestIDNotSet
+	}
+
+	if len(request.GetSignalRequests()) == 0 {
+		return errSignalRequestsNotSet
+	}
+	for _, signalRequest := range request.GetSignalRequests() {
+		if signalRequest.GetSignalName() == "" {
+			return errSignalNameNotSet
+		}
+		if (signalRequest.GetNamespace() != "" && signalRequest.GetNamespace() != request.GetNamespace()) ||
+			(signalRequest.GetWorkflowExecution().GetWorkflowId() != "" && signalRequest.GetWorkflowExecution().GetWorkflowId() != startRequest.GetWorkflowId()) ||
+			signalRequest.GetWorkflowExecution().GetRunId() != "" {
+			return errSignalRequestExecutionMismatch
+		}
+	}
+	return nil
+}
+
+// newSignalWithStartRequest combines the start request with the signal request, sharing the task queue and
+// retry policy of the start request so that their defaults are set on it by the validation
+func newSignalWithStartRequest(
+	startRequest *workflowservice.StartWorkflowExecutionRequest,
+	signalRequest *workflowservice.SignalWorkflowExecutionRequest,
+) *workflowservice.SignalWithStartWorkflowExecutionRequest {
+	return &workflowservice.SignalWithStartWorkflowExecutionRequest{
+		Namespace:                startRequest.GetNamespace(),
+		WorkflowId:               startRequest.GetWorkflowId(),
+		WorkflowType:             startRequest.GetWorkflowType(),
+		TaskQueue:                startRequest.GetTaskQueue(),
+		Input:                    startRequest.GetInput(),
+		WorkflowExecutionTimeout: startRequest.GetWorkflowExecutionTimeout(),
+		WorkflowRunTimeout:       startRequest.GetWorkflowRunTimeout(),
+		WorkflowTaskTimeout:      startRequest.GetWorkflowTaskTimeout(),
+		Identity:                 startRequest.GetIdentity(),
+		RequestId:                startRequest.GetRequestId(),
+		WorkflowIdReusePolicy:    startRequest.GetWorkflowIdReusePolicy(),
+		SignalName:               signalRequest.GetSignalName(),
+		SignalInput:              signalRequest.GetInput(),
+		RetryPolicy:              startRequest.GetRetryPolicy(),
+		CronSchedule:             startRequest.GetCronSchedule(),
+		Memo:                     startRequest.GetMemo(),
+		SearchAttributes:         startRequest.GetSearchAttributes(),
+		Header:                   startRequest.GetHeader(),
+	}
+}
+
+func (adh *AdminHandler) setRequestDefaultValueAndGetTargetVersionHistory(
+	request *adminservice.GetWorkflowExecutionRawHistoryV2Request,
+	versionHistories *historyspb.VersionHistories,
+) (*historyspb.VersionHistory, error) {
+
+	targetBranch, err := versionhistory.GetCurrentVersionHistory(versionHistories)
+	if err != nil {
+		return nil, err
+	}
+	firstItem, err := versionhistory.GetFirstVersionHistoryItem(targetBranch)
+	if err != nil {
+		return nil, err
+	}
+	lastItem, err := versionhistory.GetLastVersionHistoryItem(targetBranch)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.GetStartEventId() == common.EmptyVersion || request.GetStartEventVersion() == common.EmptyVersion {
+		// If start event is not set, get the events from the first event
+		// As the API is exclusive-exclusive, use first event id - 1 here
+		request.StartEventId = common.FirstEventID - 1
+		request.StartEventVersion = firstItem.GetVersion()
+	}
+	if request.GetEndEventId() == common.EmptyEventID || request.GetEndEventVersion() == common.EmptyVersion {
+		// If end event is not set, get the events until the end event
+		// As the API is exclusive-exclusive, use end event id + 1 here
+		request.EndEventId = lastItem.GetEventId() + 1
+		request.EndEventVersion = lastItem.GetVersion()
+	}
+
+	if request.GetStartEventId() < 0 {
+		return nil, errInvalidFirstNextEventCombination
+	}
+
+	// get branch based on the end event if end event is defined in the request
+	if request.GetEndEventId() == lastItem.GetEventId()+1 &&
+		request.GetEndEventVersion() == lastItem.GetVersion() {
+		// this is a special case, target branch remains the same
+	} else {
+		endItem := versionhistory.NewVersionHistoryItem(request.GetEndEventId(), request.GetEndEventVersion())
+		idx, err := versionhistory.FindFirstVersionHistoryIndexByVersionHistoryItem(versionHistories, endItem)
+		if err != nil {
+			return nil, err
+		}
+
+		targetBranch, err = versionhistory.GetVersionHistory(versionHistories, idx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	startItem := versionhistory.NewVersionHistoryItem(request.GetStartEventId(), request.GetStartEventVersion())
+	// If the request start event is defined. The start event may be on a different branch as current branch.
+	// We need to find the LCA of the start event and the current branch.
+	if request.GetStartEventId() == common.FirstEventID-1 &&
+		request.GetStartEventVersion() == firstItem.GetVersion() {
+		// this is a special case, start event is on the same branch as target branch
+	} else {
+		if !versionhistory.ContainsVersionHistoryItem(targetBranch, startItem) {
+			idx, err := versionhistory.FindFirstVersionHistoryIndexByVersionHistoryItem(versionHistories, startItem)
+			if err != nil {
+				return nil, err
+			}
+			startBranch, err := versionhistory.GetVersionHistory(versionHistories, idx)
+			if err != nil {
+				return nil, err
+			}
+			startItem, err = versionhistory.FindLCAVersionHistoryItem(targetBranch, startBranch)
+			if err != nil {
+				return nil, err
+			}
+			request.StartEventId = startItem.GetEventId()
+			request.StartEventVersion = startItem.GetVersion()
+		}
+	}
+
+	return targetBranch, nil
+}
+
+// startRequestProfile initiates recording of request metrics
+func (adh *AdminHandler) validateScheduleRequest(namespace string, scheduleID string) (string, error) {
+	if namespace == "" {
+		return "", errNamespaceNotSet
+	}
+	if scheduleID == "" {
+		return "", errScheduleIDNotSet
+	}
+	if len(scheduleID) > adh.config.MaxIDLengthLimit() {
+		return "", errScheduleIDTooLong
+	}
+	return adh.GetNamespaceCache().GetNamespaceID(namespace)
+}
+
+func validateSchedule(schedule *schedpb.Schedule) error {
+	if schedule == nil {
+		return errScheduleNotSet
+	}
+	if schedule.GetAction().GetWorkflowType().GetName() == "" {
+		return errScheduleWorkflowTypeNotSet
+	}
+	if schedule.GetAction().GetTaskQueue().GetName() == "" {
+		return errScheduleTaskQueueNotSet
+	}
+	if err := scheduler.ValidateSpec(schedule.GetSpec()); err != nil {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf(errInvalidScheduleSpecMessage, err))
+	}
+	return nil
+}
+
+func validateSchedulePatch(patch *schedpb.SchedulePatch) error {
+	for _, backfill := range patch.GetBackfillRequest() {
+		if backfill.GetStartTime() == nil || backfill.GetEndTime() == nil || backfill.GetEndTime().Before(*backfill.GetStartTime()) {
+			return errInvalidBackfillRange
+		}
+	}
+	return nil
+}
+
+// translateScheduleError hides the scheduler workflow behind the schedule in errors returned to the caller.
+func translateScheduleError(err error) error {
+	if _, ok := err.(*serviceerror.NotFound); ok {
+		return errScheduleNotFound
+	}
+	return err
+}
+
+// updateClusterInformation applies the failover version increment and cluster changes of the request to the cluster
+// metadata and validates the result the same way as the cluster metadata is validated at startup.
+func (adh *AdminHandler) updateClusterInformation(
+	clusterMetadata *persistencespb.ClusterMetadata,
+	request *adminservice.UpdateClusterMetadataRequest,
+) error {
+	if request.GetFailoverVersionIncrement() != 0 {
+		clusterMetadata.FailoverVersionIncrement = request.GetFailoverVersionIncrement()
+	}
+	if clusterMetadata.FailoverVersionIncrement <= 0 {
+		return errInvalidFailoverVersionIncrement
+	}
+
+	clusters := make(map[string]*persistencespb.ClusterInformation, len(clusterMetadata.ClusterInformation))
+	for name, info := range clusterMetadata.ClusterInformation {
+		clusters[name] = info
+	}
+	for name, info := range request.GetUpsertClusters() {
+		if name == "" {
+			return errClusterNameNotSet
+		}
+		clusters[name] = info
+	}
+	currentClusterName := adh.GetClusterMetadata().GetCurrentClusterName()
+	masterClusterName := adh.GetClusterMetadata().GetMasterClusterName()
+	for _, name := range request.GetRemoveClusters() {
+		if _, ok := clusters[name]; !ok {
+			return serviceerror.NewInvalidArgument(fmt.Sprintf(errClusterDoesntExistMessage, name))
+		}
+		if name == currentClusterName || name == masterClusterName {
+			return serviceerror.NewInvalidArgument(fmt.Sprintf(errUnableToRemoveClusterMessage, name))
+		}
+		delete(clusters, name)
+	}
+
+	versionToClusterName := make(map[int64]string, len(clusters))
+	for name, info := range clusters {
+		initialFailoverVersion := info.GetInitialFailoverVersion()
+		if initialFailoverVersion <= 0 || initialFailoverVersion >= clusterMetadata.FailoverVersionIncrement {
+			return serviceerror.NewInvalidArgument(fmt.Sprintf(errInvalidInitialFailoverVersionMessage, initialFailoverVersion, name, clusterMetadata.FailoverVersionIncrement))
+		}
+		if other, ok := versionToClusterName[initialFailoverVersion]; ok {
+			return serviceerror.NewInvalidArgument(fmt.Sprintf(errDuplicateInitialFailoverVersionMessage, other, name, initialFailoverVersion))
+		}
+		versionToClusterName[initialFailoverVersion] = name
+		if info.GetEnabled() && info.GetRpcAddress() == "" {
+			return serviceerror.NewInvalidArgument(fmt.Sprintf(errClusterRPCAddressNotSetMessage, name))
+		}
+	}
+	clusterMetadata.ClusterInformation = clusters
+	return nil
+}
+
+// updateSearchAttributes applies the search attributes changes of the request to the cluster metadata.
+func (adh *AdminHandler) updateSearchAttributes(
+	clusterMetadata *persistencespb.ClusterMetadata,
+	indexName string,
+	request *adminservice.UpdateClusterMetadataRequest,
+) error {
+	customSearchAttributes := map[string]enumspb.IndexedValueType{}
+	if current, ok := clusterMetadata.IndexSearchAttributes[indexName]; ok {
+		for saName, saType := range current.GetCustomSearchAttributes() {
+			customSearchAttributes[saName] = saType
+		}
+	}
+	for saName, saType := range request.GetUpsertSearchAttributes() {
+		if searchattribute.IsReserved(saName) {
+			return serviceerror.NewInvalidArgument(fmt.Sprintf(errSearchAttributeIsReservedMessage, saName))
+		}
+		if _, ok := enumspb.IndexedValueType_name[int32(saType)]; !ok || saType == enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED {
+			return serviceerror.NewInvalidArgument(fmt.Sprintf(errUnknownSearchAttributeTypeMessage, saType))
+		}
+		customSearchAttributes[saName] = saType
+	}
+	for _, saName := range request.GetRemoveSearchAttributes() {
+		if _, ok := customSearchAttributes[saName]; !ok {
+			return serviceerror.NewInvalidArgument(fmt.Sprintf(errSearchAttributeDoesntExistMessage, saName))
+		}
+		delete(customSearchAttributes, saName)
+	}
+
+	if clusterMetadata.IndexSearchAttributes == nil {
+		clusterMetadata.IndexSearchAttributes = map[string]*persistencespb.IndexSearchAttributes{}
+	}
+	lastUpdateTime := adh.GetTimeSource().Now().UTC()
+	clusterMetadata.IndexSearchAttributes[indexName] = &persistencespb.IndexSearchAttributes{
+		CustomSearchAttributes: customSearchAttributes,
+		LastUpdateTime:         &lastUpdateTime,
+	}
+	return nil
 }
 
-// startRequestProfile initiates recording of request metrics
 func (adh *AdminHandler) startRequestProfile(scope int) (metrics.Scope, metrics.Stopwatch) {
 	metricsScope := adh.GetMetricsClient().Scope(scope)
 	sw := metricsScope.StartTimer(metrics.ServiceLatency)
@@ -28,7 +28,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/pborman/uuid"
@@ -37,21 +40,52 @@ import (
 	"github.com/stretchr/testify/suite"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	failurepb "go.temporal.io/api/failure/v1"
+	historypb "go.temporal.io/api/history/v1"
+	namespacepb "go.temporal.io/api/namespace/v1"
+	querypb "go.temporal.io/api/query/v1"
+	replicationpb "go.temporal.io/api/replication/v1"
 	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/client"
 	sdkmocks "go.temporal.io/sdk/mocks"
 	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/adminservicemock/v1"
+	clusterspb "go.temporal.io/server/api/cluster/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
+	schedpb "go.temporal.io/server/api/schedule/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	tokenspb "go.temporal.io/server/api/token/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/pagetoken"
+	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/versionhistory"
+	"go.temporal.io/server/common/persistence/visibility"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/searchattribute"
+	serviceerrors "go.temporal.io/server/common/serviceerror"
+	"go.temporal.io/server/service/worker/batcher"
+	"go.temporal.io/server/service/worker/deletenamespace"
+	"go.temporal.io/server/service/worker/scheduler"
+	"google.golang.org/grpc/metadata"
 )
 
 type (
@@ -97,7 +131,7 @@ func (s *adminHandlerSuite) SetupTest() {
 		ESClient: s.mockResource.ESClient,
 	}
 	config := &Config{}
-	s.handler = NewAdminHandler(s.mockResource, params, config)
+	s.handler = NewAdminHandler(s.mockResource, params, config, nil)
 	s.handler.Start()
 }
 
@@ -254,6 +288,244 @@ func (s *adminHandlerSuite) Test_GetWorkflowExecutionRawHistoryV2_SameStartIDAnd
 	s.NoError(err)
 }
 
+func (s *adminHandlerSuite) Test_GetWorkflowExecutionHistoryReverse_FailedOnInvalidSize() {
+	ctx := context.Background()
+	_, err := s.handler.GetWorkflowExecutionHistoryReverse(ctx,
+		&adminservice.GetWorkflowExecutionHistoryReverseRequest{
+			Namespace: s.namespace,
+			Execution: &commonpb.WorkflowExecution{
+				WorkflowId: "workflowID",
+			},
+			MaximumPageSize: 0,
+		})
+	s.Equal(errInvalidPageSize, err)
+}
+
+func (s *adminHandlerSuite) Test_GetWorkflowExecutionHistoryReverse() {
+	ctx := context.Background()
+	s.handler.config.HistoryReverseMaxScannedEvents = dynamicconfig.GetIntPropertyFilteredByNamespace(100)
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+	branchToken := []byte{1}
+	runID := uuid.New()
+	s.mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), gomock.Any()).Return(&historyservice.GetMutableStateResponse{
+		Execution:          &commonpb.WorkflowExecution{WorkflowId: "workflowID", RunId: runID},
+		NextEventId:        7,
+		CurrentBranchToken: branchToken,
+	}, nil).Times(3)
+
+	// batches start at event 1, 3 and 4
+	serializer := serialization.NewSerializer()
+	batches := [][]enumspb.EventType{
+		{enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED, enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED},
+		{enumspb.EVENT_TYPE_WORKFLOW_TASK_STARTED},
+		{enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED, enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED, enumspb.EVENT_TYPE_ACTIVITY_TASK_STARTED},
+	}
+	eventID := common.FirstEventID
+	var nodes []int64
+	var blobs []*commonpb.DataBlob
+	for _, batch := range batches {
+		var events []*historypb.HistoryEvent
+		for _, eventType := range batch {
+			events = append(events, &historypb.HistoryEvent{EventId: eventID, EventType: eventType})
+			eventID++
+		}
+		blob, err := serializer.SerializeEvents(events, enumspb.ENCODING_TYPE_PROTO3)
+		s.NoError(err)
+		nodes = append(nodes, events[0].GetEventId())
+		blobs = append(blobs, blob)
+	}
+	s.mockHistoryMgr.EXPECT().ReadRawHistoryBranch(gomock.Any()).DoAndReturn(
+		func(request *persistence.ReadHistoryBranchRequest) (*persistence.ReadRawHistoryBranchResponse, error) {
+			s.Equal(branchToken, request.BranchToken)
+			response := &persistence.ReadRawHistoryBranchResponse{}
+			for i, nodeID := range nodes {
+				if nodeID >= request.MinEventID && nodeID < request.MaxEventID {
+					response.HistoryEventBlobs = append(response.HistoryEventBlobs, blobs[i])
+				}
+			}
+			if len(response.HistoryEventBlobs) == 0 {
+				return nil, serviceerror.NewNotFound("Workflow execution history not found.")
+			}
+			return response, nil
+		}).AnyTimes()
+
+	var eventIDs []int64
+	var token []byte
+	for {
+		resp, err := s.handler.GetWorkflowExecutionHistoryReverse(ctx,
+			&adminservice.GetWorkflowExecutionHistoryReverseRequest{
+				Namespace: s.namespace,
+				Execution: &commonpb.WorkflowExecution{
+					WorkflowId: "workflowID",
+				},
+				MaximumPageSize: 2,
+				NextPageToken:   token,
+			})
+		s.NoError(err)
+		s.True(len(resp.History.Events) <= 2)
+		for _, event := range resp.History.Events {
+			eventIDs = append(eventIDs, event.GetEventId())
+		}
+		token = resp.NextPageToken
+		if len(token) == 0 {
+			break
+		}
+	}
+	s.Equal([]int64{6, 5, 4, 3, 2, 1}, eventIDs)
+
+	resp, err := s.handler.GetWorkflowExecutionHistoryReverse(ctx,
+		&adminservice.GetWorkflowExecutionHistoryReverseRequest{
+			Namespace: s.namespace,
+			Execution: &commonpb.WorkflowExecution{
+				WorkflowId: "workflowID",
+				RunId:      runID,
+			},
+			MaximumPageSize: 10,
+			NextPageToken:   nil,
+			EventTypes:      []enumspb.EventType{enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED, enumspb.EVENT_TYPE_ACTIVITY_TASK_STARTED},
+		})
+	s.NoError(err)
+	s.Nil(resp.NextPageToken)
+	s.Len(resp.History.Events, 2)
+	s.Equal(int64(6), resp.History.Events[0].GetEventId())
+	s.Equal(int64(2), resp.History.Events[1].GetEventId())
+
+	// the scan stops after the max scanned events and the page is returned empty with a token to resume
+	s.handler.config.HistoryReverseMaxScannedEvents = dynamicconfig.GetIntPropertyFilteredByNamespace(1)
+	var pages [][]*historypb.HistoryEvent
+	token = nil
+	for {
+		resp, err := s.handler.GetWorkflowExecutionHistoryReverse(ctx,
+			&adminservice.GetWorkflowExecutionHistoryReverseRequest{
+				Namespace: s.namespace,
+				Execution: &commonpb.WorkflowExecution{
+					WorkflowId: "workflowID",
+					RunId:      runID,
+				},
+				MaximumPageSize: 2,
+				NextPageToken:   token,
+				EventTypes:      []enumspb.EventType{enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED},
+			})
+		s.NoError(err)
+		pages = append(pages, resp.History.Events)
+		token = resp.NextPageToken
+		if len(token) == 0 {
+			break
+		}
+	}
+	s.True(len(pages) > 1)
+	s.Empty(pages[0])
+	s.Len(pages[len(pages)-1], 1)
+	s.Equal(int64(1), pages[len(pages)-1][0].GetEventId())
+}
+
+func (s *adminHandlerSuite) Test_StreamWorkflowExecutionHistory() {
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+	s.handler.config.HistoryMaxPageSize = dynamicconfig.GetIntPropertyFilteredByNamespace(100)
+	s.handler.config.HistoryStreamEventsPerSecond = dynamicconfig.GetIntPropertyFilteredByNamespace(0)
+	branchToken := []byte{1}
+	runID := uuid.New()
+
+	// batches start at event 1, 3 and 4, the first two are appended before the stream starts
+	serializer := serialization.NewSerializer()
+	batches := [][]enumspb.EventType{
+		{enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED, enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED},
+		{enumspb.EVENT_TYPE_WORKFLOW_TASK_STARTED},
+		{enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED, enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED, enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED},
+	}
+	eventID := common.FirstEventID
+	var nodes []int64
+	var blobs []*commonpb.DataBlob
+	for _, batch := range batches {
+		var events []*historypb.HistoryEvent
+		for _, eventType := range batch {
+			events = append(events, &historypb.HistoryEvent{EventId: eventID, EventType: eventType})
+			eventID++
+		}
+		blob, err := serializer.SerializeEvents(events, enumspb.ENCODING_TYPE_PROTO3)
+		s.NoError(err)
+		nodes = append(nodes, events[0].GetEventId())
+		blobs = append(blobs, blob)
+	}
+	s.mockHistoryMgr.EXPECT().ReadRawHistoryBranch(gomock.Any()).DoAndReturn(
+		func(request *persistence.ReadHistoryBranchRequest) (*persistence.ReadRawHistoryBranchResponse, error) {
+			s.Equal(branchToken, request.BranchToken)
+			response := &persistence.ReadRawHistoryBranchResponse{}
+			for i, nodeID := range nodes {
+				if nodeID >= request.MinEventID && nodeID < request.MaxEventID {
+					response.HistoryEventBlobs = append(response.HistoryEventBlobs, blobs[i])
+				}
+			}
+			return response, nil
+		}).AnyTimes()
+
+	gomock.InOrder(
+		s.mockHistoryClient.EXPECT().PollMutableState(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, request *historyservice.PollMutableStateRequest, _ ...interface{}) (*historyservice.PollMutableStateResponse, error) {
+				s.Equal(int64(2), request.GetExpectedNextEventId())
+				s.Empty(request.Execution.GetRunId())
+				return &historyservice.PollMutableStateResponse{
+					Execution:          &commonpb.WorkflowExecution{WorkflowId: "workflowID", RunId: runID},
+					NextEventId:        4,
+					CurrentBranchToken: branchToken,
+					WorkflowStatus:     enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+				}, nil
+			}),
+		s.mockHistoryClient.EXPECT().PollMutableState(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, request *historyservice.PollMutableStateRequest, _ ...interface{}) (*historyservice.PollMutableStateResponse, error) {
+				s.Equal(int64(4), request.GetExpectedNextEventId())
+				s.Equal(runID, request.Execution.GetRunId())
+				s.Equal(branchToken, request.GetCurrentBranchToken())
+				return &historyservice.PollMutableStateResponse{
+					Execution:          &commonpb.WorkflowExecution{WorkflowId: "workflowID", RunId: runID},
+					NextEventId:        7,
+					CurrentBranchToken: branchToken,
+					WorkflowStatus:     enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+				}, nil
+			}),
+	)
+
+	var responses []*adminservice.StreamWorkflowExecutionHistoryResponse
+	server := adminservicemock.NewMockAdminService_StreamWorkflowExecutionHistoryServer(s.controller)
+	server.EXPECT().Context().Return(context.Background()).AnyTimes()
+	server.EXPECT().Send(gomock.Any()).DoAndReturn(func(response *adminservice.StreamWorkflowExecutionHistoryResponse) error {
+		responses = append(responses, response)
+		return nil
+	}).AnyTimes()
+
+	err := s.handler.StreamWorkflowExecutionHistory(&adminservice.StreamWorkflowExecutionHistoryRequest{
+		Namespace: s.namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: "workflowID",
+		},
+		FirstEventId:    2,
+		MaximumPageSize: 2,
+	}, server)
+	s.NoError(err)
+
+	var eventIDs [][]int64
+	for _, response := range responses {
+		var ids []int64
+		for _, event := range response.History.Events {
+			ids = append(ids, event.GetEventId())
+		}
+		eventIDs = append(eventIDs, ids)
+		s.Equal(ids[len(ids)-1]+1, response.GetNextEventId())
+	}
+	s.Equal([][]int64{{2, 3}, {4, 5}, {6}}, eventIDs)
+	s.True(responses[0].GetIsWorkflowRunning())
+	s.False(responses[2].GetIsWorkflowRunning())
+}
+
+func (s *adminHandlerSuite) Test_StreamWorkflowExecutionHistory_FailedOnInvalidWorkflowID() {
+	server := adminservicemock.NewMockAdminService_StreamWorkflowExecutionHistoryServer(s.controller)
+	err := s.handler.StreamWorkflowExecutionHistory(&adminservice.StreamWorkflowExecutionHistoryRequest{
+		Namespace: s.namespace,
+		Execution: &commonpb.WorkflowExecution{},
+	}, server)
+	s.Equal(errWorkflowIDNotSet, err)
+}
+
 func (s *adminHandlerSuite) Test_SetRequestDefaultValueAndGetTargetVersionHistory_DefinedStartAndEnd() {
 	inputStartEventID := int64(1)
 	inputStartVersion := int64(10)
@@ -682,3 +954,1626 @@ func (s *adminHandlerSuite) Test_RemoveSearchAttributes() {
 	s.NoError(err)
 	s.NotNil(resp)
 }
+
+func (s *adminHandlerSuite) Test_ExecuteMultiOperation() {
+	handler := s.handler
+	handler.config.MaxIDLengthLimit = dynamicconfig.GetIntPropertyFn(1000)
+	handler.config.QuarantinedWorkflowIDs = dynamicconfig.GetMapPropertyFnWithNamespaceFilter(map[string]interface{}{"quarantined": "hot signal producer"})
+	handler.config.DefaultWorkflowRetryPolicy = dynamicconfig.GetMapPropertyFnWithNamespaceFilter(map[string]interface{}{})
+	handler.workflowHandler = NewWorkflowHandler(s.mockResource, handler.config, nil, pagetoken.NewNoopCodec()).(*WorkflowHandler)
+
+	newRequest := func(workflowID string) *adminservice.ExecuteMultiOperationRequest {
+		return &adminservice.ExecuteMultiOperationRequest{
+			Namespace: s.namespace,
+			StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+				WorkflowId:   workflowID,
+				WorkflowType: &commonpb.WorkflowType{Name: "workflow-type"},
+				TaskQueue:    &taskqueuepb.TaskQueue{Name: "task-queue"},
+				RequestId:    uuid.New(),
+				RetryPolicy:  &commonpb.RetryPolicy{},
+			},
+			SignalRequests: []*workflowservice.SignalWorkflowExecutionRequest{{SignalName: "signal"}},
+		}
+	}
+
+	request := newRequest("workflow-id")
+	request.StartRequest.TaskQueue = nil
+	_, err := handler.ExecuteMultiOperation(context.Background(), request)
+	s.Equal(errTaskQueueNotSet, err)
+
+	request = newRequest("workflow-id")
+	request.SignalRequests[0].SignalName = strings.Repeat("s", 1001)
+	_, err = handler.ExecuteMultiOperation(context.Background(), request)
+	s.Equal(errSignalNameTooLong, err)
+
+	request = newRequest("workflow-id")
+	request.StartRequest.CronSchedule = "invalid"
+	_, err = handler.ExecuteMultiOperation(context.Background(), request)
+	s.Error(err)
+
+	_, err = handler.ExecuteMultiOperation(context.Background(), newRequest("quarantined"))
+	s.IsType(&serviceerrors.WorkflowQuarantined{}, err)
+
+	// defaults are set on the request sent to history but not on the caller's request
+	request = newRequest("workflow-id")
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockHistoryClient.EXPECT().ExecuteMultiOperation(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, historyRequest *historyservice.ExecuteMultiOperationRequest, _ ...interface{}) (*historyservice.ExecuteMultiOperationResponse, error) {
+			s.Equal(s.namespaceID, historyRequest.GetNamespaceId())
+			s.Equal(s.namespace, historyRequest.GetStartRequest().GetNamespace())
+			s.Equal(enumspb.TASK_QUEUE_KIND_NORMAL, historyRequest.GetStartRequest().GetTaskQueue().GetKind())
+			s.Equal(enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE, historyRequest.GetStartRequest().GetWorkflowIdReusePolicy())
+			s.NotZero(historyRequest.GetStartRequest().GetRetryPolicy().GetBackoffCoefficient())
+			return &historyservice.ExecuteMultiOperationResponse{RunId: "run-id", Started: true}, nil
+		})
+	resp, err := handler.ExecuteMultiOperation(context.Background(), request)
+	s.NoError(err)
+	s.Equal("run-id", resp.GetRunId())
+	s.True(resp.GetStarted())
+	s.Empty(request.StartRequest.GetNamespace())
+	s.Equal(enumspb.TASK_QUEUE_KIND_UNSPECIFIED, request.StartRequest.GetTaskQueue().GetKind())
+	s.Equal(enumspb.WORKFLOW_ID_REUSE_POLICY_UNSPECIFIED, request.StartRequest.GetWorkflowIdReusePolicy())
+	s.Zero(request.StartRequest.GetRetryPolicy().GetBackoffCoefficient())
+}
+
+func (s *adminHandlerSuite) Test_ListNamespaceFailoverHistory() {
+	handler := s.handler
+
+	_, err := handler.ListNamespaceFailoverHistory(context.Background(), &adminservice.ListNamespaceFailoverHistoryRequest{})
+	s.Equal(errNamespaceNotSet, err)
+
+	failoverTime := time.Now().UTC()
+	failoverHistory := []*persistencespb.NamespaceFailoverEvent{
+		{
+			FailoverTime:    &failoverTime,
+			FromCluster:     "cluster-a",
+			ToCluster:       "cluster-b",
+			FailoverVersion: 2,
+			Identity:        "operator",
+		},
+	}
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(&persistence.GetNamespaceRequest{Name: s.namespace}).Return(&persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info:            &persistencespb.NamespaceInfo{Id: s.namespaceID, Name: s.namespace},
+			FailoverHistory: failoverHistory,
+		},
+	}, nil)
+	resp, err := handler.ListNamespaceFailoverHistory(context.Background(), &adminservice.ListNamespaceFailoverHistoryRequest{
+		Namespace: s.namespace,
+	})
+	s.NoError(err)
+	s.Equal(failoverHistory, resp.GetFailoverHistory())
+}
+
+func (s *adminHandlerSuite) Test_HandoverNamespace() {
+	handler := s.handler
+	handler.namespaceHandoverPropagationDelay = 0
+	mockNamespaceHandler := namespace.NewMockHandler(s.controller)
+	handler.namespaceHandler = mockNamespaceHandler
+	drainTimeout := 10 * time.Second
+
+	mockNamespaceHandler.EXPECT().StartNamespaceHandover(gomock.Any(), s.namespace, "standby").Return(nil)
+	statusRequest := &historyservice.GetReplicationStatusRequest{
+		ShardIds:       []int32{1},
+		RemoteClusters: []string{"standby"},
+	}
+	s.mockHistoryClient.EXPECT().GetReplicationStatus(gomock.Any(), statusRequest).Return(&historyservice.GetReplicationStatusResponse{
+		Shards: []*historyservice.ShardReplicationStatus{{
+			ShardId:              1,
+			MaxReplicationTaskId: 100,
+			RemoteClusters: map[string]*historyservice.ShardReplicationStatusPerCluster{
+				"standby": {AckedTaskId: 90},
+			},
+		}},
+	}, nil)
+	// tasks generated after drain started must not block the handover
+	s.mockHistoryClient.EXPECT().GetReplicationStatus(gomock.Any(), statusRequest).Return(&historyservice.GetReplicationStatusResponse{
+		Shards: []*historyservice.ShardReplicationStatus{{
+			ShardId:              1,
+			MaxReplicationTaskId: 120,
+			RemoteClusters: map[string]*historyservice.ShardReplicationStatusPerCluster{
+				"standby": {AckedTaskId: 100},
+			},
+		}},
+	}, nil)
+	mockNamespaceHandler.EXPECT().UpdateNamespace(gomock.Any(), &workflowservice.UpdateNamespaceRequest{
+		Namespace: s.namespace,
+		ReplicationConfig: &replicationpb.NamespaceReplicationConfig{
+			ActiveClusterName: "standby",
+		},
+	}).Return(&workflowservice.UpdateNamespaceResponse{FailoverVersion: 12}, nil)
+
+	resp, err := handler.HandoverNamespace(context.Background(), &adminservice.HandoverNamespaceRequest{
+		Namespace:     s.namespace,
+		TargetCluster: "standby",
+		DrainTimeout:  &drainTimeout,
+	})
+	s.NoError(err)
+	s.Equal(int64(12), resp.GetFailoverVersion())
+}
+
+func (s *adminHandlerSuite) Test_HandoverNamespace_DrainTimeout() {
+	handler := s.handler
+	handler.namespaceHandoverPropagationDelay = 0
+	mockNamespaceHandler := namespace.NewMockHandler(s.controller)
+	handler.namespaceHandler = mockNamespaceHandler
+	drainTimeout := 10 * time.Millisecond
+
+	_, err := handler.HandoverNamespace(context.Background(), &adminservice.HandoverNamespaceRequest{
+		Namespace: s.namespace,
+	})
+	s.Equal(errClusterNameNotSet, err)
+
+	mockNamespaceHandler.EXPECT().StartNamespaceHandover(gomock.Any(), s.namespace, "standby").Return(nil)
+	s.mockHistoryClient.EXPECT().GetReplicationStatus(gomock.Any(), gomock.Any()).Return(&historyservice.GetReplicationStatusResponse{
+		Shards: []*historyservice.ShardReplicationStatus{{
+			ShardId:              1,
+			MaxReplicationTaskId: 100,
+			RemoteClusters: map[string]*historyservice.ShardReplicationStatusPerCluster{
+				"standby": {AckedTaskId: 90},
+			},
+		}},
+	}, nil).AnyTimes()
+	mockNamespaceHandler.EXPECT().AbortNamespaceHandover(gomock.Any(), s.namespace).Return(nil)
+
+	_, err = handler.HandoverNamespace(context.Background(), &adminservice.HandoverNamespaceRequest{
+		Namespace:     s.namespace,
+		TargetCluster: "standby",
+		DrainTimeout:  &drainTimeout,
+	})
+	s.IsType(&serviceerror.DeadlineExceeded{}, err)
+}
+
+func (s *adminHandlerSuite) Test_DeleteNamespace() {
+	handler := s.handler
+	mockNamespaceHandler := namespace.NewMockHandler(s.controller)
+	handler.namespaceHandler = mockNamespaceHandler
+
+	_, err := handler.DeleteNamespace(context.Background(), &adminservice.DeleteNamespaceRequest{})
+	s.Equal(errNamespaceNotSet, err)
+
+	mockNamespaceHandler.EXPECT().DeleteNamespace(gomock.Any(), s.namespace).Return(s.namespaceID, nil)
+	mockRun := &sdkmocks.WorkflowRun{}
+	mockRun.On("GetID").Return(deletenamespace.WorkflowID(s.namespace))
+	mockRun.On("GetRunID").Return("run-id")
+	s.mockResource.SDKClient.On("ExecuteWorkflow", mock.Anything, mock.Anything, deletenamespace.WorkflowName, mock.Anything).Return(
+		func(_ context.Context, options client.StartWorkflowOptions, _ interface{}, args ...interface{}) client.WorkflowRun {
+			s.Equal(deletenamespace.WorkflowID(s.namespace), options.ID)
+			s.Equal(deletenamespace.TaskQueueName, options.TaskQueue)
+			s.Equal(deletenamespace.WorkflowParams{Namespace: s.namespace, NamespaceID: s.namespaceID}, args[0])
+			return mockRun
+		}, nil).Once()
+
+	resp, err := handler.DeleteNamespace(context.Background(), &adminservice.DeleteNamespaceRequest{
+		Namespace: s.namespace,
+		Identity:  "operator",
+	})
+	s.NoError(err)
+	s.Equal(s.namespaceID, resp.GetNamespaceId())
+	s.Equal(deletenamespace.WorkflowID(s.namespace), resp.GetWorkflowId())
+	s.Equal("run-id", resp.GetRunId())
+}
+
+func (s *adminHandlerSuite) Test_NamespaceQuotas() {
+	handler := s.handler
+	mockNamespaceHandler := namespace.NewMockHandler(s.controller)
+	handler.namespaceHandler = mockNamespaceHandler
+	quotas := &persistencespb.NamespaceQuotas{MaxStartWorkflowRps: 10, MaxPendingWorkflows: 1000}
+
+	_, err := handler.UpdateNamespaceQuotas(context.Background(), &adminservice.UpdateNamespaceQuotasRequest{Namespace: s.namespace})
+	s.Equal(errNamespaceQuotasNotSet, err)
+
+	mockNamespaceHandler.EXPECT().UpdateNamespaceQuotas(gomock.Any(), s.namespace, quotas).Return(nil)
+	updateResp, err := handler.UpdateNamespaceQuotas(context.Background(), &adminservice.UpdateNamespaceQuotasRequest{
+		Namespace: s.namespace,
+		Quotas:    quotas,
+	})
+	s.NoError(err)
+	s.Equal(quotas, updateResp.GetQuotas())
+
+	mockNamespaceHandler.EXPECT().DescribeNamespaceQuotas(gomock.Any(), s.namespace).Return(quotas, nil)
+	describeResp, err := handler.DescribeNamespaceQuotas(context.Background(), &adminservice.DescribeNamespaceQuotasRequest{Namespace: s.namespace})
+	s.NoError(err)
+	s.Equal(quotas, describeResp.GetQuotas())
+}
+
+func (s *adminHandlerSuite) Test_GetReplicationLag() {
+	handler := s.handler
+	handler.numberOfHistoryShards = 2
+	lag := time.Minute
+
+	s.mockHistoryClient.EXPECT().GetReplicationStatus(gomock.Any(), &historyservice.GetReplicationStatusRequest{
+		ShardIds:        []int32{1, 2},
+		RemoteClusters:  []string{"standby"},
+		IncludeDlqDepth: true,
+	}).Return(&historyservice.GetReplicationStatusResponse{
+		Shards: []*historyservice.ShardReplicationStatus{
+			{
+				ShardId:              2,
+				MaxReplicationTaskId: 200,
+				RemoteClusters: map[string]*historyservice.ShardReplicationStatusPerCluster{
+					"standby": {AckedTaskId: 150},
+				},
+			},
+			{
+				ShardId:              1,
+				MaxReplicationTaskId: 100,
+				RemoteClusters: map[string]*historyservice.ShardReplicationStatusPerCluster{
+					"standby": {AckedTaskId: 90, ReplicationLag: &lag, DlqDepth: 3},
+				},
+			},
+		},
+	}, nil)
+
+	resp, err := handler.GetReplicationLag(context.Background(), &adminservice.GetReplicationLagRequest{
+		RemoteClusters: []string{"standby"},
+	})
+	s.NoError(err)
+	s.Equal([]*adminservice.ShardReplicationLag{
+		{
+			ShardId:              1,
+			MaxReplicationTaskId: 100,
+			RemoteClusters: map[string]*adminservice.ClusterReplicationLag{
+				"standby": {AckedTaskId: 90, ReplicationLag: &lag, DlqDepth: 3},
+			},
+		},
+		{
+			ShardId:              2,
+			MaxReplicationTaskId: 200,
+			RemoteClusters: map[string]*adminservice.ClusterReplicationLag{
+				"standby": {AckedTaskId: 150},
+			},
+		},
+	}, resp.GetShards())
+}
+
+func (s *adminHandlerSuite) Test_RecordWorkflowTaskHeartbeat() {
+	handler := s.handler
+	handler.config.MaxIDLengthLimit = dynamicconfig.GetIntPropertyFn(1000)
+	taskToken, err := common.NewProtoTaskTokenSerializer().Serialize(&tokenspb.Task{
+		NamespaceId:     s.namespaceID,
+		WorkflowId:      "some random workflow ID",
+		RunId:           uuid.New(),
+		ScheduleId:      2,
+		ScheduleAttempt: 1,
+	})
+	s.NoError(err)
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockHistoryClient.EXPECT().RecordWorkflowTaskHeartbeat(gomock.Any(), &historyservice.RecordWorkflowTaskHeartbeatRequest{
+		NamespaceId: s.namespaceID,
+		TaskToken:   taskToken,
+		Identity:    "worker",
+	}).Return(&historyservice.RecordWorkflowTaskHeartbeatResponse{}, nil)
+
+	_, err = handler.RecordWorkflowTaskHeartbeat(context.Background(), &adminservice.RecordWorkflowTaskHeartbeatRequest{
+		Namespace: s.namespace,
+		TaskToken: taskToken,
+		Identity:  "worker",
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) Test_RecordWorkflowTaskHeartbeat_NamespaceMismatch() {
+	handler := s.handler
+	handler.config.MaxIDLengthLimit = dynamicconfig.GetIntPropertyFn(1000)
+	taskToken, err := common.NewProtoTaskTokenSerializer().Serialize(&tokenspb.Task{
+		NamespaceId: uuid.New(),
+		WorkflowId:  "some random workflow ID",
+		RunId:       uuid.New(),
+		ScheduleId:  2,
+	})
+	s.NoError(err)
+
+	_, err = handler.RecordWorkflowTaskHeartbeat(context.Background(), &adminservice.RecordWorkflowTaskHeartbeatRequest{
+		Namespace: s.namespace,
+	})
+	s.Equal(errTaskTokenNotSet, err)
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	_, err = handler.RecordWorkflowTaskHeartbeat(context.Background(), &adminservice.RecordWorkflowTaskHeartbeatRequest{
+		Namespace: s.namespace,
+		TaskToken: taskToken,
+	})
+	s.Equal(errTokenNamespaceMismatch, err)
+}
+
+func (s *adminHandlerSuite) Test_StreamReplicationMessages_ShardIDNotSet() {
+	server := adminservicemock.NewMockAdminService_StreamReplicationMessagesServer(s.controller)
+	server.EXPECT().Context().Return(context.Background()).AnyTimes()
+
+	err := s.handler.StreamReplicationMessages(server)
+	s.Equal(errShardIDNotSet, err)
+}
+
+func (s *adminHandlerSuite) Test_StreamReplicationMessages() {
+	shardID := int32(5)
+	token := &replicationspb.ReplicationToken{
+		ShardId:                shardID,
+		LastProcessedMessageId: 10,
+		LastRetrievedMessageId: 20,
+	}
+	messages := &replicationspb.ReplicationMessages{
+		LastRetrievedMessageId: 30,
+	}
+
+	md, _ := metadata.FromOutgoingContext(headers.SetReplicationShardID(context.Background(), shardID))
+	server := adminservicemock.NewMockAdminService_StreamReplicationMessagesServer(s.controller)
+	server.EXPECT().Context().Return(metadata.NewIncomingContext(context.Background(), md)).AnyTimes()
+	historyStream := historyservicemock.NewMockHistoryService_StreamReplicationMessagesClient(s.controller)
+	s.mockHistoryClient.EXPECT().StreamReplicationMessages(gomock.Any()).DoAndReturn(
+		func(ctx context.Context, _ ...interface{}) (historyservice.HistoryService_StreamReplicationMessagesClient, error) {
+			routedShardID, ok := headers.GetOutgoingReplicationShardID(ctx)
+			s.True(ok)
+			s.Equal(shardID, routedShardID)
+			return historyStream, nil
+		},
+	)
+
+	// history responds after request is forwarded and closes stream after proxy half closes it
+	requestSent := make(chan struct{})
+	sendClosed := make(chan struct{})
+	gomock.InOrder(
+		server.EXPECT().Recv().Return(&adminservice.StreamReplicationMessagesRequest{
+			Token:       token,
+			ClusterName: "remote",
+		}, nil),
+		server.EXPECT().Recv().Return(nil, io.EOF),
+	)
+	historyStream.EXPECT().Send(&historyservice.StreamReplicationMessagesRequest{
+		Token:       token,
+		ClusterName: "remote",
+	}).DoAndReturn(func(_ *historyservice.StreamReplicationMessagesRequest) error {
+		close(requestSent)
+		return nil
+	})
+	historyStream.EXPECT().CloseSend().DoAndReturn(func() error {
+		close(sendClosed)
+		return nil
+	})
+	gomock.InOrder(
+		historyStream.EXPECT().Recv().DoAndReturn(func() (*historyservice.StreamReplicationMessagesResponse, error) {
+			<-requestSent
+			return &historyservice.StreamReplicationMessagesResponse{Messages: messages}, nil
+		}),
+		historyStream.EXPECT().Recv().DoAndReturn(func() (*historyservice.StreamReplicationMessagesResponse, error) {
+			<-sendClosed
+			return nil, io.EOF
+		}),
+	)
+	server.EXPECT().Send(&adminservice.StreamReplicationMessagesResponse{Messages: messages}).Return(nil)
+
+	err := s.handler.StreamReplicationMessages(server)
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) Test_ListStaleWorkflowExecutions() {
+	handler := s.handler
+	handler.config.VisibilityMaxPageSize = dynamicconfig.GetIntPropertyFilteredByNamespace(100)
+	executions := []*workflowpb.WorkflowExecutionInfo{
+		{
+			Execution: &commonpb.WorkflowExecution{WorkflowId: "some random workflow ID", RunId: uuid.New()},
+		},
+	}
+
+	_, err := handler.ListStaleWorkflowExecutions(context.Background(), &adminservice.ListStaleWorkflowExecutionsRequest{
+		Namespace: s.namespace,
+	})
+	s.Equal(errOlderThanNotSet, err)
+
+	now := time.Now().UTC()
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockResource.VisibilityMgr.EXPECT().ListOpenWorkflowExecutions(gomock.Any()).DoAndReturn(
+		func(request *visibility.ListWorkflowExecutionsRequest) (*visibility.ListWorkflowExecutionsResponse, error) {
+			s.Equal(s.namespaceID, request.NamespaceID)
+			s.Equal(s.namespace, request.Namespace)
+			s.Equal(100, request.PageSize)
+			s.Equal([]byte("token"), request.NextPageToken)
+			s.Equal(minTime, request.EarliestStartTime)
+			s.WithinDuration(now.Add(-time.Hour), request.LatestStartTime, time.Minute)
+			return &visibility.ListWorkflowExecutionsResponse{
+				Executions:    executions,
+				NextPageToken: []byte("next token"),
+			}, nil
+		},
+	)
+
+	resp, err := handler.ListStaleWorkflowExecutions(context.Background(), &adminservice.ListStaleWorkflowExecutionsRequest{
+		Namespace:       s.namespace,
+		OlderThan:       timestamp.DurationPtr(time.Hour),
+		MaximumPageSize: 1000,
+		NextPageToken:   []byte("token"),
+	})
+	s.NoError(err)
+	s.Equal(executions, resp.Executions)
+	s.Equal([]byte("next token"), resp.NextPageToken)
+}
+
+func (s *adminHandlerSuite) Test_DescribeTaskQueuePartitions() {
+	partitions := []*taskqueuespb.TaskQueuePartitionStatus{
+		{
+			Partition:        "test-task-queue",
+			OwnerHostName:    "matching_host",
+			TaskQueueStatus:  &taskqueuepb.TaskQueueStatus{BacklogCountHint: 10},
+			TaskReaderStatus: &taskqueuespb.TaskReaderStatus{BufferedTaskCount: 5},
+		},
+	}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockResource.MatchingClient.EXPECT().DescribeTaskQueuePartitions(gomock.Any(), &matchingservice.DescribeTaskQueuePartitionsRequest{
+		NamespaceId:   s.namespaceID,
+		TaskQueue:     "test-task-queue",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+	}).Return(&matchingservice.DescribeTaskQueuePartitionsResponse{Partitions: partitions}, nil)
+
+	resp, err := s.handler.DescribeTaskQueuePartitions(context.Background(), &adminservice.DescribeTaskQueuePartitionsRequest{
+		Namespace: s.namespace,
+		TaskQueue: "test-task-queue",
+	})
+	s.NoError(err)
+	s.Equal(partitions, resp.GetPartitions())
+}
+
+func (s *adminHandlerSuite) Test_DescribeTaskQueuePartitions_InvalidRequest() {
+	_, err := s.handler.DescribeTaskQueuePartitions(context.Background(), nil)
+	s.Equal(errRequestNotSet, err)
+
+	_, err = s.handler.DescribeTaskQueuePartitions(context.Background(), &adminservice.DescribeTaskQueuePartitionsRequest{
+		TaskQueue: "test-task-queue",
+	})
+	s.Equal(errNamespaceNotSet, err)
+
+	_, err = s.handler.DescribeTaskQueuePartitions(context.Background(), &adminservice.DescribeTaskQueuePartitionsRequest{
+		Namespace: s.namespace,
+	})
+	s.Equal(errTaskQueueNotSet, err)
+}
+
+func (s *adminHandlerSuite) Test_UpdateWorkerBuildIdCompatibility() {
+	update := &taskqueuespb.BuildIdCompatibilityUpdate{
+		Operation: &taskqueuespb.BuildIdCompatibilityUpdate_AddNewBuildIdInNewDefaultSet{AddNewBuildIdInNewDefaultSet: "2.0"},
+	}
+	versioningData := &persistencespb.VersioningData{
+		VersionSets: []*persistencespb.CompatibleVersionSet{{BuildIds: []string{"1.0"}}, {BuildIds: []string{"2.0"}}},
+	}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockResource.MatchingClient.EXPECT().UpdateWorkerBuildIdCompatibility(gomock.Any(), &matchingservice.UpdateWorkerBuildIdCompatibilityRequest{
+		NamespaceId: s.namespaceID,
+		TaskQueue:   "test-task-queue",
+		Update:      update,
+	}).Return(&matchingservice.UpdateWorkerBuildIdCompatibilityResponse{VersioningData: versioningData}, nil)
+
+	resp, err := s.handler.UpdateWorkerBuildIdCompatibility(context.Background(), &adminservice.UpdateWorkerBuildIdCompatibilityRequest{
+		Namespace: s.namespace,
+		TaskQueue: "test-task-queue",
+		Update:    update,
+	})
+	s.NoError(err)
+	s.Equal(versioningData, resp.GetVersioningData())
+}
+
+func (s *adminHandlerSuite) Test_UpdateWorkerBuildIdCompatibility_InvalidRequest() {
+	_, err := s.handler.UpdateWorkerBuildIdCompatibility(context.Background(), nil)
+	s.Equal(errRequestNotSet, err)
+
+	_, err = s.handler.UpdateWorkerBuildIdCompatibility(context.Background(), &adminservice.UpdateWorkerBuildIdCompatibilityRequest{
+		Namespace: s.namespace,
+		TaskQueue: "test-task-queue",
+	})
+	s.Equal(errBuildIDCompatibilityUpdateNotSet, err)
+}
+
+func (s *adminHandlerSuite) Test_GetWorkerBuildIdCompatibility() {
+	versioningData := &persistencespb.VersioningData{
+		VersionSets: []*persistencespb.CompatibleVersionSet{{BuildIds: []string{"1.0", "1.1"}}},
+	}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockResource.MatchingClient.EXPECT().GetWorkerBuildIdCompatibility(gomock.Any(), &matchingservice.GetWorkerBuildIdCompatibilityRequest{
+		NamespaceId: s.namespaceID,
+		TaskQueue:   "test-task-queue",
+		MaxSets:     1,
+	}).Return(&matchingservice.GetWorkerBuildIdCompatibilityResponse{VersioningData: versioningData}, nil)
+
+	resp, err := s.handler.GetWorkerBuildIdCompatibility(context.Background(), &adminservice.GetWorkerBuildIdCompatibilityRequest{
+		Namespace: s.namespace,
+		TaskQueue: "test-task-queue",
+		MaxSets:   1,
+	})
+	s.NoError(err)
+	s.Equal(versioningData, resp.GetVersioningData())
+}
+
+func (s *adminHandlerSuite) Test_ShutdownWorker() {
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockResource.MatchingClient.EXPECT().EvictStickyTaskQueue(gomock.Any(), &matchingservice.EvictStickyTaskQueueRequest{
+		NamespaceId: s.namespaceID,
+		TaskQueue:   "test-sticky-task-queue",
+	}).Return(&matchingservice.EvictStickyTaskQueueResponse{}, nil)
+
+	_, err := s.handler.ShutdownWorker(context.Background(), &adminservice.ShutdownWorkerRequest{
+		Namespace:       s.namespace,
+		StickyTaskQueue: "test-sticky-task-queue",
+		Identity:        "worker",
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) Test_ShutdownWorker_InvalidRequest() {
+	_, err := s.handler.ShutdownWorker(context.Background(), nil)
+	s.Equal(errRequestNotSet, err)
+
+	_, err = s.handler.ShutdownWorker(context.Background(), &adminservice.ShutdownWorkerRequest{
+		StickyTaskQueue: "test-sticky-task-queue",
+	})
+	s.Equal(errNamespaceNotSet, err)
+
+	_, err = s.handler.ShutdownWorker(context.Background(), &adminservice.ShutdownWorkerRequest{
+		Namespace: s.namespace,
+	})
+	s.Equal(errStickyTaskQueueNotSet, err)
+}
+
+func (s *adminHandlerSuite) newImportHistoryBatch(version int64, eventIDs ...int64) *commonpb.DataBlob {
+	var events []*historypb.HistoryEvent
+	for _, eventID := range eventIDs {
+		events = append(events, &historypb.HistoryEvent{EventId: eventID, Version: version})
+	}
+	blob, err := serialization.NewSerializer().SerializeEvents(events, enumspb.ENCODING_TYPE_PROTO3)
+	s.NoError(err)
+	return blob
+}
+
+func (s *adminHandlerSuite) newImportMutableState(
+	execution *commonpb.WorkflowExecution,
+	versionHistory *historyspb.VersionHistory,
+) *persistencespb.WorkflowMutableState {
+	lastItem, err := versionhistory.GetLastVersionHistoryItem(versionHistory)
+	s.NoError(err)
+	return &persistencespb.WorkflowMutableState{
+		ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+			WorkflowId:       execution.GetWorkflowId(),
+			VersionHistories: versionhistory.NewVersionHistories(versionHistory),
+		},
+		ExecutionState: &persistencespb.WorkflowExecutionState{
+			RunId:  execution.GetRunId(),
+			Status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		},
+		NextEventId: lastItem.GetEventId() + 1,
+	}
+}
+
+func (s *adminHandlerSuite) Test_ImportWorkflowExecution() {
+	execution := &commonpb.WorkflowExecution{WorkflowId: "some random workflow ID", RunId: uuid.New()}
+	versionHistory := versionhistory.NewVersionHistory([]byte{}, []*historyspb.VersionHistoryItem{
+		versionhistory.NewVersionHistoryItem(3, 1),
+		versionhistory.NewVersionHistoryItem(5, 11),
+	})
+	historyBatches := []*commonpb.DataBlob{
+		s.newImportHistoryBatch(1, 1, 2),
+		s.newImportHistoryBatch(1, 3),
+		s.newImportHistoryBatch(11, 4, 5),
+	}
+	scheduledTime := time.Now().UTC()
+	heartbeatTime := scheduledTime.Add(time.Minute)
+	activityInfo := &persistencespb.ActivityInfo{
+		Version:                 11,
+		ScheduleId:              5,
+		ScheduledTime:           &scheduledTime,
+		StartedId:               common.EmptyEventID,
+		Attempt:                 3,
+		LastHeartbeatDetails:    payloads.EncodeString("progress"),
+		LastHeartbeatUpdateTime: &heartbeatTime,
+		RetryLastFailure:        &failurepb.Failure{Message: "attempt 2 failed"},
+		RetryLastWorkerIdentity: "worker",
+	}
+	mutableState := s.newImportMutableState(execution, versionHistory)
+	mutableState.ActivityInfos = map[int64]*persistencespb.ActivityInfo{5: activityInfo}
+
+	s.mockResource.ClusterMetadata.EXPECT().GetAllClusterInfo().Return(map[string]config.ClusterInformation{
+		"active": {InitialFailoverVersion: 1},
+	}).AnyTimes()
+	s.mockResource.ClusterMetadata.EXPECT().IsVersionFromSameCluster(int64(1), int64(1)).Return(true).Times(2)
+	s.mockResource.ClusterMetadata.EXPECT().IsVersionFromSameCluster(int64(11), int64(1)).Return(true).Times(2)
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).Times(2)
+	var calls []*gomock.Call
+	for _, historyBatch := range historyBatches {
+		calls = append(calls, s.mockHistoryClient.EXPECT().ReplicateEventsV2(gomock.Any(), &historyservice.ReplicateEventsV2Request{
+			NamespaceId:         s.namespaceID,
+			WorkflowExecution:   execution,
+			VersionHistoryItems: versionHistory.GetItems(),
+			Events:              historyBatch,
+		}).Return(&historyservice.ReplicateEventsV2Response{}, nil))
+	}
+	calls = append(calls, s.mockHistoryClient.EXPECT().SyncActivity(gomock.Any(), &historyservice.SyncActivityRequest{
+		NamespaceId:        s.namespaceID,
+		WorkflowId:         execution.GetWorkflowId(),
+		RunId:              execution.GetRunId(),
+		Version:            11,
+		ScheduledId:        5,
+		ScheduledTime:      &scheduledTime,
+		StartedId:          common.EmptyEventID,
+		LastHeartbeatTime:  &heartbeatTime,
+		Details:            activityInfo.GetLastHeartbeatDetails(),
+		Attempt:            3,
+		LastFailure:        activityInfo.GetRetryLastFailure(),
+		LastWorkerIdentity: "worker",
+		VersionHistory:     versionHistory,
+	}).Return(&historyservice.SyncActivityResponse{}, nil))
+	importedMutableState := s.newImportMutableState(execution, versionHistory)
+	importedMutableState.ActivityInfos = map[int64]*persistencespb.ActivityInfo{5: activityInfo}
+	calls = append(calls, s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), &historyservice.DescribeMutableStateRequest{
+		NamespaceId: s.namespaceID,
+		Execution:   execution,
+	}).Return(&historyservice.DescribeMutableStateResponse{DatabaseMutableState: importedMutableState}, nil))
+	gomock.InOrder(calls...)
+
+	// the activity state is only synced by the request which imports the last event of the snapshot
+	resp, err := s.handler.ImportWorkflowExecution(context.Background(), &adminservice.ImportWorkflowExecutionRequest{
+		Namespace:      s.namespace,
+		Execution:      execution,
+		HistoryBatches: historyBatches[:2],
+		MutableState:   mutableState,
+	})
+	s.NoError(err)
+	s.Nil(resp.GetMutableState())
+
+	resp, err = s.handler.ImportWorkflowExecution(context.Background(), &adminservice.ImportWorkflowExecutionRequest{
+		Namespace:      s.namespace,
+		Execution:      execution,
+		HistoryBatches: historyBatches[2:],
+		MutableState:   mutableState,
+	})
+	s.NoError(err)
+	s.Equal(int64(6), resp.GetMutableState().GetNextEventId())
+	s.Equal(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, resp.GetMutableState().GetExecutionState().GetStatus())
+	importedActivityInfo := resp.GetMutableState().GetActivityInfos()[5]
+	s.Equal(int32(3), importedActivityInfo.GetAttempt())
+	s.Equal(activityInfo.GetLastHeartbeatDetails(), importedActivityInfo.GetLastHeartbeatDetails())
+}
+
+func (s *adminHandlerSuite) Test_ImportWorkflowExecution_MutableStateMismatch() {
+	execution := &commonpb.WorkflowExecution{WorkflowId: "some random workflow ID", RunId: uuid.New()}
+	versionHistory := versionhistory.NewVersionHistory([]byte{}, []*historyspb.VersionHistoryItem{
+		versionhistory.NewVersionHistoryItem(2, 1),
+	})
+	mutableState := s.newImportMutableState(execution, versionHistory)
+
+	s.mockResource.ClusterMetadata.EXPECT().GetAllClusterInfo().Return(map[string]config.ClusterInformation{
+		"active": {InitialFailoverVersion: 1},
+	})
+	s.mockResource.ClusterMetadata.EXPECT().IsVersionFromSameCluster(int64(1), int64(1)).Return(true)
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockHistoryClient.EXPECT().ReplicateEventsV2(gomock.Any(), gomock.Any()).Return(&historyservice.ReplicateEventsV2Response{}, nil)
+	importedMutableState := s.newImportMutableState(execution, versionHistory)
+	importedMutableState.ExecutionState.Status = enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), gomock.Any()).Return(
+		&historyservice.DescribeMutableStateResponse{DatabaseMutableState: importedMutableState}, nil)
+
+	_, err := s.handler.ImportWorkflowExecution(context.Background(), &adminservice.ImportWorkflowExecutionRequest{
+		Namespace:      s.namespace,
+		Execution:      execution,
+		HistoryBatches: []*commonpb.DataBlob{s.newImportHistoryBatch(1, 1, 2)},
+		MutableState:   mutableState,
+	})
+	s.IsType(&serviceerror.Internal{}, err)
+}
+
+func (s *adminHandlerSuite) Test_ImportWorkflowExecution_InvalidRequest() {
+	execution := &commonpb.WorkflowExecution{WorkflowId: "some random workflow ID", RunId: uuid.New()}
+	versionHistory := versionhistory.NewVersionHistory([]byte{}, []*historyspb.VersionHistoryItem{
+		versionhistory.NewVersionHistoryItem(3, 1),
+	})
+	newRequest := func() *adminservice.ImportWorkflowExecutionRequest {
+		return &adminservice.ImportWorkflowExecutionRequest{
+			Namespace:      s.namespace,
+			Execution:      &commonpb.WorkflowExecution{WorkflowId: execution.GetWorkflowId(), RunId: execution.GetRunId()},
+			HistoryBatches: []*commonpb.DataBlob{s.newImportHistoryBatch(1, 1, 2)},
+			MutableState:   s.newImportMutableState(execution, versionhistory.CopyVersionHistory(versionHistory)),
+		}
+	}
+
+	_, err := s.handler.ImportWorkflowExecution(context.Background(), nil)
+	s.Equal(errRequestNotSet, err)
+
+	request := newRequest()
+	request.Namespace = ""
+	_, err = s.handler.ImportWorkflowExecution(context.Background(), request)
+	s.Equal(errNamespaceNotSet, err)
+
+	request = newRequest()
+	request.Execution.RunId = ""
+	_, err = s.handler.ImportWorkflowExecution(context.Background(), request)
+	s.Equal(errInvalidRunID, err)
+
+	request = newRequest()
+	request.HistoryBatches = nil
+	_, err = s.handler.ImportWorkflowExecution(context.Background(), request)
+	s.Equal(errHistoryBatchesNotSet, err)
+
+	request = newRequest()
+	request.MutableState = nil
+	_, err = s.handler.ImportWorkflowExecution(context.Background(), request)
+	s.Equal(errMutableStateNotSet, err)
+
+	request = newRequest()
+	request.MutableState.ExecutionState.RunId = uuid.New()
+	_, err = s.handler.ImportWorkflowExecution(context.Background(), request)
+	s.Equal(errMutableStateExecutionMismatch, err)
+
+	request = newRequest()
+	request.MutableState.ExecutionInfo.VersionHistories = nil
+	_, err = s.handler.ImportWorkflowExecution(context.Background(), request)
+	s.Equal(errInvalidVersionHistories, err)
+
+	s.mockResource.ClusterMetadata.EXPECT().GetAllClusterInfo().Return(map[string]config.ClusterInformation{
+		"active": {InitialFailoverVersion: 1},
+	}).AnyTimes()
+	s.mockResource.ClusterMetadata.EXPECT().IsVersionFromSameCluster(int64(1), int64(1)).Return(true).AnyTimes()
+
+	// batches must be consecutive
+	request = newRequest()
+	request.HistoryBatches = []*commonpb.DataBlob{s.newImportHistoryBatch(1, 1), s.newImportHistoryBatch(1, 3)}
+	_, err = s.handler.ImportWorkflowExecution(context.Background(), request)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	// batches must not go past the last event of the snapshot
+	request = newRequest()
+	request.HistoryBatches = []*commonpb.DataBlob{s.newImportHistoryBatch(1, 1, 2, 3, 4)}
+	_, err = s.handler.ImportWorkflowExecution(context.Background(), request)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	// events must be on the current version history branch of the snapshot
+	request = newRequest()
+	request.HistoryBatches = []*commonpb.DataBlob{s.newImportHistoryBatch(11, 1, 2)}
+	_, err = s.handler.ImportWorkflowExecution(context.Background(), request)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	// the failover version 2 doesn't belong to any cluster known to the current cluster
+	s.mockResource.ClusterMetadata.EXPECT().IsVersionFromSameCluster(int64(2), int64(1)).Return(false)
+	request = newRequest()
+	request.MutableState.ExecutionInfo.VersionHistories = versionhistory.NewVersionHistories(
+		versionhistory.NewVersionHistory([]byte{}, []*historyspb.VersionHistoryItem{versionhistory.NewVersionHistoryItem(3, 2)}),
+	)
+	_, err = s.handler.ImportWorkflowExecution(context.Background(), request)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
+func (s *adminHandlerSuite) Test_SetDynamicConfig() {
+	values := []*persistencespb.DynamicConfigValue{
+		{Value: "100"},
+		{Value: "10", Constraints: &persistencespb.DynamicConfigConstraints{Namespace: s.namespace}},
+	}
+	s.mockResource.ClusterMetadataMgr.EXPECT().GetClusterMetadata().Return(&persistence.GetClusterMetadataResponse{
+		ClusterMetadata: persistencespb.ClusterMetadata{ClusterName: "active"},
+		Version:         1,
+	}, nil)
+	s.mockResource.ClusterMetadataMgr.EXPECT().SaveClusterMetadata(gomock.Any()).DoAndReturn(
+		func(request *persistence.SaveClusterMetadataRequest) (bool, error) {
+			s.EqualValues(1, request.Version)
+			s.Equal(values, request.ClusterMetadata.DynamicConfig["frontend.namespaceRPS"].GetValues())
+			return true, nil
+		})
+
+	_, err := s.handler.SetDynamicConfig(context.Background(), &adminservice.SetDynamicConfigRequest{
+		Name:   "frontend.namespaceRPS",
+		Values: values,
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) Test_SetDynamicConfig_InvalidRequest() {
+	_, err := s.handler.SetDynamicConfig(context.Background(), &adminservice.SetDynamicConfigRequest{})
+	s.Equal(errDynamicConfigNameNotSet, err)
+
+	_, err = s.handler.SetDynamicConfig(context.Background(), &adminservice.SetDynamicConfigRequest{
+		Name:   "frontend.nonExistingKey",
+		Values: []*persistencespb.DynamicConfigValue{{Value: "100"}},
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	_, err = s.handler.SetDynamicConfig(context.Background(), &adminservice.SetDynamicConfigRequest{
+		Name:   "frontend.rps",
+		Values: []*persistencespb.DynamicConfigValue{{Value: "not json"}},
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	_, err = s.handler.SetDynamicConfig(context.Background(), &adminservice.SetDynamicConfigRequest{
+		Name:   "frontend.rps",
+		Values: []*persistencespb.DynamicConfigValue{{Value: `"100"`}},
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	_, err = s.handler.SetDynamicConfig(context.Background(), &adminservice.SetDynamicConfigRequest{
+		Name: "frontend.rps",
+		Values: []*persistencespb.DynamicConfigValue{
+			{Value: "100", Constraints: &persistencespb.DynamicConfigConstraints{Namespace: s.namespace}},
+		},
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
+func (s *adminHandlerSuite) Test_SetMaintenanceInfo() {
+	s.mockResource.ClusterMetadataMgr.EXPECT().GetClusterMetadata().Return(&persistence.GetClusterMetadataResponse{
+		ClusterMetadata: persistencespb.ClusterMetadata{ClusterName: "active"},
+		Version:         1,
+	}, nil)
+	s.mockResource.ClusterMetadataMgr.EXPECT().SaveClusterMetadata(gomock.Any()).DoAndReturn(
+		func(request *persistence.SaveClusterMetadataRequest) (bool, error) {
+			s.EqualValues(1, request.Version)
+			s.Equal("database upgrade", request.ClusterMetadata.MaintenanceInfo.GetMessage())
+			s.Equal(enumspb.SEVERITY_LOW, request.ClusterMetadata.MaintenanceInfo.GetSeverity())
+			return true, nil
+		})
+
+	_, err := s.handler.SetMaintenanceInfo(context.Background(), &adminservice.SetMaintenanceInfoRequest{
+		Message: "database upgrade",
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) Test_SetMaintenanceInfo_InvalidRequest() {
+	_, err := s.handler.SetMaintenanceInfo(context.Background(), nil)
+	s.Equal(errRequestNotSet, err)
+
+	_, err = s.handler.SetMaintenanceInfo(context.Background(), &adminservice.SetMaintenanceInfoRequest{
+		Message: strings.Repeat("a", maintenanceMessageMaxLength+1),
+	})
+	s.Equal(errMaintenanceMessageTooLong, err)
+
+	_, err = s.handler.SetMaintenanceInfo(context.Background(), &adminservice.SetMaintenanceInfoRequest{
+		Message: "database upgrade\nat midnight",
+	})
+	s.Equal(errMaintenanceMessageNotPrintable, err)
+}
+
+func (s *adminHandlerSuite) testClusterMetadataResponse(version int64) *persistence.GetClusterMetadataResponse {
+	return &persistence.GetClusterMetadataResponse{
+		ClusterMetadata: persistencespb.ClusterMetadata{
+			ClusterName:              "active",
+			FailoverVersionIncrement: 10,
+			ClusterInformation: map[string]*persistencespb.ClusterInformation{
+				"active":  {Enabled: true, InitialFailoverVersion: 1, RpcAddress: "active:7233"},
+				"standby": {Enabled: true, InitialFailoverVersion: 2, RpcAddress: "standby:7233"},
+			},
+		},
+		Version: version,
+	}
+}
+
+func (s *adminHandlerSuite) Test_DescribeClusterMetadata() {
+	s.mockResource.ClusterMetadataMgr.EXPECT().GetClusterMetadata().Return(s.testClusterMetadataResponse(3), nil)
+
+	resp, err := s.handler.DescribeClusterMetadata(context.Background(), &adminservice.DescribeClusterMetadataRequest{})
+	s.NoError(err)
+	s.EqualValues(3, resp.Version)
+	s.Equal("active", resp.ClusterName)
+	s.EqualValues(10, resp.FailoverVersionIncrement)
+	s.Len(resp.ClusterInformation, 2)
+}
+
+func (s *adminHandlerSuite) Test_UpdateClusterMetadata() {
+	s.mockResource.ClusterMetadataMgr.EXPECT().GetClusterMetadata().Return(s.testClusterMetadataResponse(3), nil)
+	s.mockResource.ClusterMetadata.EXPECT().GetCurrentClusterName().Return("active").AnyTimes()
+	s.mockResource.ClusterMetadata.EXPECT().GetMasterClusterName().Return("active").AnyTimes()
+	s.mockResource.ClusterMetadataMgr.EXPECT().SaveClusterMetadata(gomock.Any()).DoAndReturn(
+		func(request *persistence.SaveClusterMetadataRequest) (bool, error) {
+			s.EqualValues(3, request.Version)
+			s.EqualValues(100, request.ClusterMetadata.FailoverVersionIncrement)
+			s.Equal(map[string]*persistencespb.ClusterInformation{
+				"active": {Enabled: true, InitialFailoverVersion: 1, RpcAddress: "active:7233"},
+				"third":  {Enabled: true, InitialFailoverVersion: 12, RpcAddress: "third:7233"},
+			}, request.ClusterMetadata.ClusterInformation)
+			s.Equal(map[string]enumspb.IndexedValueType{
+				"CustomKeywordField": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+			}, request.ClusterMetadata.IndexSearchAttributes[""].GetCustomSearchAttributes())
+			return true, nil
+		})
+	s.mockResource.SearchAttributesProvider.EXPECT().GetSearchAttributes("", true).Return(searchattribute.TestNameTypeMap, nil)
+
+	resp, err := s.handler.UpdateClusterMetadata(context.Background(), &adminservice.UpdateClusterMetadataRequest{
+		Version:                  3,
+		FailoverVersionIncrement: 100,
+		UpsertClusters: map[string]*persistencespb.ClusterInformation{
+			"third": {Enabled: true, InitialFailoverVersion: 12, RpcAddress: "third:7233"},
+		},
+		RemoveClusters: []string{"standby"},
+		UpsertSearchAttributes: map[string]enumspb.IndexedValueType{
+			"CustomKeywordField": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		},
+	})
+	s.NoError(err)
+	s.EqualValues(4, resp.Version)
+}
+
+func (s *adminHandlerSuite) Test_UpdateClusterMetadata_ConcurrentUpdate() {
+	s.mockResource.ClusterMetadataMgr.EXPECT().GetClusterMetadata().Return(s.testClusterMetadataResponse(4), nil)
+	_, err := s.handler.UpdateClusterMetadata(context.Background(), &adminservice.UpdateClusterMetadataRequest{
+		Version:                  3,
+		FailoverVersionIncrement: 100,
+	})
+	s.Equal(serviceerror.NewInvalidArgument(fmt.Sprintf(errClusterMetadataVersionMismatchMessage, 3, 4)), err)
+
+	// Updated between read and write.
+	s.mockResource.ClusterMetadataMgr.EXPECT().GetClusterMetadata().Return(s.testClusterMetadataResponse(4), nil)
+	s.mockResource.ClusterMetadata.EXPECT().GetCurrentClusterName().Return("active")
+	s.mockResource.ClusterMetadata.EXPECT().GetMasterClusterName().Return("active")
+	s.mockResource.ClusterMetadataMgr.EXPECT().SaveClusterMetadata(gomock.Any()).Return(false, nil)
+	s.mockResource.ClusterMetadataMgr.EXPECT().GetClusterMetadata().Return(s.testClusterMetadataResponse(5), nil)
+	_, err = s.handler.UpdateClusterMetadata(context.Background(), &adminservice.UpdateClusterMetadataRequest{
+		Version:                  4,
+		FailoverVersionIncrement: 100,
+	})
+	s.Equal(serviceerror.NewInvalidArgument(fmt.Sprintf(errClusterMetadataVersionMismatchMessage, 4, 5)), err)
+}
+
+func (s *adminHandlerSuite) Test_UpdateClusterMetadata_InvalidRequest() {
+	_, err := s.handler.UpdateClusterMetadata(context.Background(), &adminservice.UpdateClusterMetadataRequest{
+		FailoverVersionIncrement: 100,
+	})
+	s.Equal(errClusterMetadataVersionNotSet, err)
+
+	s.mockResource.ClusterMetadataMgr.EXPECT().GetClusterMetadata().Return(s.testClusterMetadataResponse(3), nil).AnyTimes()
+	s.mockResource.ClusterMetadata.EXPECT().GetCurrentClusterName().Return("active").AnyTimes()
+	s.mockResource.ClusterMetadata.EXPECT().GetMasterClusterName().Return("active").AnyTimes()
+
+	_, err = s.handler.UpdateClusterMetadata(context.Background(), &adminservice.UpdateClusterMetadataRequest{
+		Version:        3,
+		RemoveClusters: []string{"active"},
+	})
+	s.Equal(serviceerror.NewInvalidArgument(fmt.Sprintf(errUnableToRemoveClusterMessage, "active")), err)
+
+	_, err = s.handler.UpdateClusterMetadata(context.Background(), &adminservice.UpdateClusterMetadataRequest{
+		Version:                  3,
+		FailoverVersionIncrement: 2,
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	_, err = s.handler.UpdateClusterMetadata(context.Background(), &adminservice.UpdateClusterMetadataRequest{
+		Version: 3,
+		UpsertClusters: map[string]*persistencespb.ClusterInformation{
+			"third": {Enabled: true, InitialFailoverVersion: 2, RpcAddress: "third:7233"},
+		},
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	_, err = s.handler.UpdateClusterMetadata(context.Background(), &adminservice.UpdateClusterMetadataRequest{
+		Version: 3,
+		UpsertSearchAttributes: map[string]enumspb.IndexedValueType{
+			"WorkflowId": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		},
+	})
+	s.Equal(serviceerror.NewInvalidArgument(fmt.Sprintf(errSearchAttributeIsReservedMessage, "WorkflowId")), err)
+}
+
+func (s *adminHandlerSuite) Test_GetDynamicConfig() {
+	values := &persistencespb.DynamicConfigValues{
+		Values: []*persistencespb.DynamicConfigValue{{Value: "100"}},
+	}
+	s.mockResource.ClusterMetadataMgr.EXPECT().GetClusterMetadata().Return(&persistence.GetClusterMetadataResponse{
+		ClusterMetadata: persistencespb.ClusterMetadata{
+			DynamicConfig: map[string]*persistencespb.DynamicConfigValues{"frontend.rps": values},
+		},
+	}, nil).Times(2)
+
+	resp, err := s.handler.GetDynamicConfig(context.Background(), &adminservice.GetDynamicConfigRequest{Name: "frontend.rps"})
+	s.NoError(err)
+	s.Equal(values, resp.Values)
+
+	resp, err = s.handler.GetDynamicConfig(context.Background(), &adminservice.GetDynamicConfigRequest{Name: "frontend.keepAliveMinTime"})
+	s.NoError(err)
+	s.Nil(resp.Values)
+}
+
+func (s *adminHandlerSuite) Test_ListThrottledCallers() {
+	now := time.Now().UTC()
+	historyHost := membership.NewHostInfo("history_host", nil)
+	historyCaller := &clusterspb.ThrottledCaller{
+		HostAddress:    historyHost.GetAddress(),
+		Service:        common.HistoryServiceName,
+		Limiter:        "persistence_qps",
+		Rate:           100,
+		ThrottledCount: 3,
+	}
+	s.mockResource.ThrottleTracker.EXPECT().ThrottledCallers(s.namespace).Return([]quotas.ThrottledCaller{
+		{
+			Limiter:            "namespace_rps",
+			Namespace:          s.namespace,
+			Identity:           "worker",
+			Rate:               10,
+			ThrottledCount:     5,
+			FirstThrottledTime: now,
+			LastThrottledTime:  now,
+		},
+	})
+	s.mockResource.HistoryServiceResolver.EXPECT().Members().Return([]*membership.HostInfo{
+		historyHost,
+		membership.NewHostInfo("unhealthy_history_host", nil),
+	})
+	s.mockHistoryClient.EXPECT().ListThrottledCallers(gomock.Any(), &historyservice.ListThrottledCallersRequest{
+		HostAddress: "history_host",
+		Namespace:   s.namespace,
+	}).Return(&historyservice.ListThrottledCallersResponse{
+		Callers: []*clusterspb.ThrottledCaller{historyCaller},
+	}, nil)
+	s.mockHistoryClient.EXPECT().ListThrottledCallers(gomock.Any(), &historyservice.ListThrottledCallersRequest{
+		HostAddress: "unhealthy_history_host",
+		Namespace:   s.namespace,
+	}).Return(nil, serviceerror.NewUnavailable("host is unavailable"))
+
+	resp, err := s.handler.ListThrottledCallers(context.Background(), &adminservice.ListThrottledCallersRequest{
+		Namespace: s.namespace,
+	})
+	s.NoError(err)
+	s.Equal([]*clusterspb.ThrottledCaller{
+		{
+			HostAddress:        s.mockResource.GetHostInfo().GetAddress(),
+			Service:            common.FrontendServiceName,
+			Limiter:            "namespace_rps",
+			Namespace:          s.namespace,
+			Identity:           "worker",
+			Rate:               10,
+			ThrottledCount:     5,
+			FirstThrottledTime: &now,
+			LastThrottledTime:  &now,
+		},
+		historyCaller,
+	}, resp.Callers)
+}
+
+func (s *adminHandlerSuite) Test_GetShardStats() {
+	hostA := membership.NewHostInfo("host_a", nil)
+	hostB := membership.NewHostInfo("host_b", nil)
+	s.mockResource.HistoryServiceResolver.EXPECT().Members().Return([]*membership.HostInfo{hostA, hostB})
+	s.mockHistoryClient.EXPECT().GetShardStats(gomock.Any(), &historyservice.GetShardStatsRequest{
+		HostAddress: "host_a",
+	}).Return(&historyservice.GetShardStatsResponse{
+		Shards: []*clusterspb.ShardStats{
+			{ShardId: 3, OwnerHost: "host_a", TransferBacklog: 10, VisibilityLag: timestamp.DurationPtr(time.Second)},
+			{ShardId: 1, OwnerHost: "host_a", TransferBacklog: 5, VisibilityLag: timestamp.DurationPtr(time.Minute)},
+		},
+	}, nil)
+	s.mockHistoryClient.EXPECT().GetShardStats(gomock.Any(), &historyservice.GetShardStatsRequest{
+		HostAddress: "host_b",
+	}).Return(nil, serviceerror.NewUnavailable("host is unavailable"))
+
+	resp, err := s.handler.GetShardStats(context.Background(), &adminservice.GetShardStatsRequest{})
+	s.NoError(err)
+	s.Equal([]*clusterspb.ShardStats{
+		{ShardId: 1, OwnerHost: "host_a", TransferBacklog: 5, VisibilityLag: timestamp.DurationPtr(time.Minute)},
+		{ShardId: 3, OwnerHost: "host_a", TransferBacklog: 10, VisibilityLag: timestamp.DurationPtr(time.Second)},
+	}, resp.Shards)
+	s.Equal([]string{"host_b"}, resp.UnreachableHosts)
+	s.Equal(time.Minute, timestamp.DurationValue(resp.MaxVisibilityLag))
+}
+
+func (s *adminHandlerSuite) Test_DeepHealthCheck() {
+	frontendHost := s.mockResource.GetHostInfo()
+	historyHost := membership.NewHostInfo("history_host", nil)
+	matchingHost := membership.NewHostInfo("matching_host", nil)
+	s.mockResource.FrontendServiceResolver.EXPECT().Members().Return([]*membership.HostInfo{frontendHost}).AnyTimes()
+	s.mockResource.HistoryServiceResolver.EXPECT().Members().Return([]*membership.HostInfo{historyHost}).AnyTimes()
+	s.mockResource.MatchingServiceResolver.EXPECT().Members().Return([]*membership.HostInfo{matchingHost}).AnyTimes()
+	s.mockResource.ClusterMetadataMgr.EXPECT().GetClusterMetadata().Return(&persistence.GetClusterMetadataResponse{}, nil).Times(2)
+	s.mockResource.TaskMgr.EXPECT().LeaseTaskQueue(&persistence.LeaseTaskQueueRequest{
+		NamespaceID:   common.SystemNamespaceID,
+		TaskQueue:     deepHealthCheckTaskQueue,
+		TaskType:      enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		TaskQueueKind: enumspb.TASK_QUEUE_KIND_NORMAL,
+	}).Return(&persistence.LeaseTaskQueueResponse{}, nil).Times(2)
+	s.mockResource.ESClient.EXPECT().GetMapping(gomock.Any(), "").Return(map[string]string{}, nil).Times(2)
+	s.mockHistoryClient.EXPECT().DescribeHistoryHost(gomock.Any(), &historyservice.DescribeHistoryHostRequest{
+		HostAddress: "history_host",
+	}).Return(&historyservice.DescribeHistoryHostResponse{ShardIds: []int32{1}}, nil).Times(2)
+
+	s.handler.checkHostHealth = func(_ context.Context, _ string, _ string) error {
+		return nil
+	}
+	resp, err := s.handler.DeepHealthCheck(context.Background(), &adminservice.DeepHealthCheckRequest{})
+	s.NoError(err)
+	s.True(resp.Healthy)
+	var names []string
+	for _, component := range resp.Components {
+		s.True(component.Healthy, component.Name)
+		names = append(names, component.Name)
+	}
+	s.Equal([]string{"persistence", "elasticsearch", "membership", "frontend", "history", "matching"}, names)
+
+	s.handler.checkHostHealth = func(_ context.Context, service string, _ string) error {
+		if service == common.MatchingServiceName {
+			return errors.New("connection refused")
+		}
+		return nil
+	}
+	resp, err = s.handler.DeepHealthCheck(context.Background(), &adminservice.DeepHealthCheckRequest{})
+	s.NoError(err)
+	s.False(resp.Healthy)
+	s.False(resp.Components[5].Healthy)
+	s.Equal("1 of 1 hosts are unhealthy: matching_host (connection refused)", resp.Components[5].Message)
+}
+
+func (s *adminHandlerSuite) Test_DeepHealthCheck_InconsistentShardOwnership() {
+	s.mockResource.FrontendServiceResolver.EXPECT().Members().Return([]*membership.HostInfo{s.mockResource.GetHostInfo()}).AnyTimes()
+	s.mockResource.HistoryServiceResolver.EXPECT().Members().Return([]*membership.HostInfo{
+		membership.NewHostInfo("host_a", nil),
+		membership.NewHostInfo("host_b", nil),
+	}).AnyTimes()
+	s.mockResource.MatchingServiceResolver.EXPECT().Members().Return([]*membership.HostInfo{membership.NewHostInfo("matching_host", nil)}).AnyTimes()
+	s.mockHistoryClient.EXPECT().DescribeHistoryHost(gomock.Any(), gomock.Any()).Return(&historyservice.DescribeHistoryHostResponse{ShardIds: []int32{1}}, nil).Times(2)
+
+	message, err := s.handler.probeMembership(context.Background())
+	s.Empty(message)
+	s.EqualError(err, "history shard ownership is inconsistent: shard 1 is owned by host_a, host_b")
+}
+
+func (s *adminHandlerSuite) Test_GetHostProfile() {
+	historyHost := membership.NewHostInfo("history_host", nil)
+	matchingHost := membership.NewHostInfo("matching_host", nil)
+	frontendHost := membership.NewHostInfo("frontend_host", nil)
+	s.mockResource.HistoryServiceResolver.EXPECT().Members().Return([]*membership.HostInfo{historyHost}).AnyTimes()
+	s.mockResource.MatchingServiceResolver.EXPECT().Members().Return([]*membership.HostInfo{matchingHost}).AnyTimes()
+	s.mockResource.FrontendServiceResolver.EXPECT().Members().Return([]*membership.HostInfo{frontendHost}).AnyTimes()
+
+	// this host
+	resp, err := s.handler.GetHostProfile(context.Background(), &adminservice.GetHostProfileRequest{Profile: "goroutine"})
+	s.NoError(err)
+	s.NotEmpty(resp.Profile)
+	s.Equal(s.mockResource.GetHostInfo().GetAddress(), resp.HostAddress)
+	s.Equal(common.FrontendServiceName, resp.Service)
+
+	s.mockHistoryClient.EXPECT().GetHostProfile(gomock.Any(), &historyservice.GetHostProfileRequest{
+		HostAddress: "history_host",
+		Profile:     "heap",
+	}).Return(&historyservice.GetHostProfileResponse{Profile: []byte("history")}, nil)
+	resp, err = s.handler.GetHostProfile(context.Background(), &adminservice.GetHostProfileRequest{HostAddress: "history_host", Profile: "heap"})
+	s.NoError(err)
+	s.Equal(&adminservice.GetHostProfileResponse{Profile: []byte("history"), HostAddress: "history_host", Service: common.HistoryServiceName}, resp)
+
+	s.mockResource.MatchingClient.EXPECT().GetHostProfile(gomock.Any(), &matchingservice.GetHostProfileRequest{
+		HostAddress: "matching_host",
+		Profile:     "cpu",
+		Duration:    timestamp.DurationPtr(time.Second),
+	}).Return(&matchingservice.GetHostProfileResponse{Profile: []byte("matching")}, nil)
+	resp, err = s.handler.GetHostProfile(context.Background(), &adminservice.GetHostProfileRequest{HostAddress: "matching_host", Profile: "cpu", Duration: timestamp.DurationPtr(time.Second)})
+	s.NoError(err)
+	s.Equal(&adminservice.GetHostProfileResponse{Profile: []byte("matching"), HostAddress: "matching_host", Service: common.MatchingServiceName}, resp)
+
+	_, err = s.handler.GetHostProfile(context.Background(), &adminservice.GetHostProfileRequest{HostAddress: "frontend_host", Profile: "heap"})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+	_, err = s.handler.GetHostProfile(context.Background(), &adminservice.GetHostProfileRequest{HostAddress: "unknown_host", Profile: "heap"})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+	_, err = s.handler.GetHostProfile(context.Background(), &adminservice.GetHostProfileRequest{HostAddress: "history_host", Profile: "unknown"})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
+func (s *adminHandlerSuite) Test_DescribeVisibilityProcessor() {
+	hostA := membership.NewHostInfo("host_a", nil)
+	hostB := membership.NewHostInfo("host_b", nil)
+	hostC := membership.NewHostInfo("host_c", nil)
+	s.mockResource.HistoryServiceResolver.EXPECT().Members().Return([]*membership.HostInfo{hostB, hostA, hostC}).AnyTimes()
+	s.mockHistoryClient.EXPECT().DescribeVisibilityProcessor(gomock.Any(), &historyservice.DescribeVisibilityProcessorRequest{
+		HostAddress: "host_a",
+		Flush:       true,
+	}).Return(&historyservice.DescribeVisibilityProcessorResponse{
+		Status: &clusterspb.VisibilityProcessorStatus{HostAddress: "host_a", PendingAcks: 1},
+	}, nil).Times(2)
+	s.mockHistoryClient.EXPECT().DescribeVisibilityProcessor(gomock.Any(), &historyservice.DescribeVisibilityProcessorRequest{
+		HostAddress: "host_b",
+		Flush:       true,
+	}).Return(&historyservice.DescribeVisibilityProcessorResponse{
+		Status: &clusterspb.VisibilityProcessorStatus{HostAddress: "host_b", InFlightBulks: 2},
+	}, nil)
+	s.mockHistoryClient.EXPECT().DescribeVisibilityProcessor(gomock.Any(), &historyservice.DescribeVisibilityProcessorRequest{
+		HostAddress: "host_c",
+		Flush:       true,
+	}).Return(nil, serviceerror.NewUnavailable("host is unavailable"))
+
+	resp, err := s.handler.DescribeVisibilityProcessor(context.Background(), &adminservice.DescribeVisibilityProcessorRequest{Flush: true})
+	s.NoError(err)
+	s.Equal([]*clusterspb.VisibilityProcessorStatus{
+		{HostAddress: "host_a", PendingAcks: 1},
+		{HostAddress: "host_b", InFlightBulks: 2},
+	}, resp.Processors)
+	s.Equal([]string{"host_c"}, resp.UnreachableHosts)
+
+	resp, err = s.handler.DescribeVisibilityProcessor(context.Background(), &adminservice.DescribeVisibilityProcessorRequest{HostAddress: "host_a", Flush: true})
+	s.NoError(err)
+	s.Equal([]*clusterspb.VisibilityProcessorStatus{{HostAddress: "host_a", PendingAcks: 1}}, resp.Processors)
+
+	_, err = s.handler.DescribeVisibilityProcessor(context.Background(), &adminservice.DescribeVisibilityProcessorRequest{HostAddress: "unknown_host"})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
+func (s *adminHandlerSuite) Test_RebalanceShards() {
+	hostA := membership.NewHostInfo("host_a", nil)
+	hostB := membership.NewHostInfo("host_b", nil)
+	ringOwners := map[string]*membership.HostInfo{"1": hostA, "2": hostB, "3": hostB, "4": hostB}
+
+	s.mockResource.HistoryServiceResolver.EXPECT().Members().Return([]*membership.HostInfo{hostA, hostB}).Times(2)
+	s.mockResource.HistoryServiceResolver.EXPECT().Lookup(gomock.Any()).DoAndReturn(func(key string) (*membership.HostInfo, error) {
+		return ringOwners[key], nil
+	}).AnyTimes()
+	s.mockHistoryClient.EXPECT().DescribeHistoryHost(gomock.Any(), &historyservice.DescribeHistoryHostRequest{
+		HostAddress: "host_a",
+	}).Return(&historyservice.DescribeHistoryHostResponse{ShardIds: []int32{1, 2, 3}}, nil).Times(2)
+	s.mockHistoryClient.EXPECT().DescribeHistoryHost(gomock.Any(), &historyservice.DescribeHistoryHostRequest{
+		HostAddress: "host_b",
+	}).Return(&historyservice.DescribeHistoryHostResponse{ShardIds: []int32{4}}, nil).Times(2)
+
+	expectedMoves := []*adminservice.ShardMove{
+		{ShardId: 2, SourceHost: "host_a", TargetHost: "host_b", SourceHostShards: 3},
+		{ShardId: 3, SourceHost: "host_a", TargetHost: "host_b", SourceHostShards: 3},
+	}
+	resp, err := s.handler.RebalanceShards(context.Background(), &adminservice.RebalanceShardsRequest{DryRun: true})
+	s.NoError(err)
+	s.Equal(expectedMoves, resp.Moves)
+
+	s.mockHistoryClient.EXPECT().CloseShard(gomock.Any(), &historyservice.CloseShardRequest{
+		ShardId:     2,
+		HostAddress: "host_a",
+	}).Return(&historyservice.CloseShardResponse{}, nil)
+	resp, err = s.handler.RebalanceShards(context.Background(), &adminservice.RebalanceShardsRequest{MaxMoves: 1})
+	s.NoError(err)
+	s.Equal([]*adminservice.ShardMove{
+		{ShardId: 2, SourceHost: "host_a", TargetHost: "host_b", SourceHostShards: 3, Evicted: true},
+	}, resp.Moves)
+
+	_, err = s.handler.RebalanceShards(context.Background(), &adminservice.RebalanceShardsRequest{MaxMoves: -1})
+	s.Equal(errInvalidMaxMoves, err)
+}
+
+func (s *adminHandlerSuite) newTestSchedule() *schedpb.Schedule {
+	return &schedpb.Schedule{
+		Spec: &schedpb.ScheduleSpec{
+			Calendar: []*schedpb.CalendarSpec{{Hour: "9", DayOfWeek: "1-5"}},
+		},
+		Action: &schedpb.ScheduleAction{
+			WorkflowType: &commonpb.WorkflowType{Name: "some-workflow"},
+			TaskQueue:    &taskqueuepb.TaskQueue{Name: "some-task-queue"},
+		},
+	}
+}
+
+func (s *adminHandlerSuite) Test_CreateSchedule() {
+	handler := s.handler
+	handler.config.MaxIDLengthLimit = dynamicconfig.GetIntPropertyFn(1000)
+	ctx := context.Background()
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+
+	invalidSpec := s.newTestSchedule()
+	invalidSpec.Spec.Calendar[0].Hour = "25"
+	noTaskQueue := s.newTestSchedule()
+	noTaskQueue.Action.TaskQueue = nil
+	testCases := []struct {
+		Name     string
+		Request  *adminservice.CreateScheduleRequest
+		Expected error
+	}{
+		{
+			Name:     "nil request",
+			Request:  nil,
+			Expected: errRequestNotSet,
+		},
+		{
+			Name:     "no schedule id",
+			Request:  &adminservice.CreateScheduleRequest{Namespace: s.namespace, Schedule: s.newTestSchedule()},
+			Expected: errScheduleIDNotSet,
+		},
+		{
+			Name:     "no schedule",
+			Request:  &adminservice.CreateScheduleRequest{Namespace: s.namespace, ScheduleId: "schedule"},
+			Expected: errScheduleNotSet,
+		},
+		{
+			Name:     "no task queue",
+			Request:  &adminservice.CreateScheduleRequest{Namespace: s.namespace, ScheduleId: "schedule", Schedule: noTaskQueue},
+			Expected: errScheduleTaskQueueNotSet,
+		},
+	}
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			resp, err := handler.CreateSchedule(ctx, testCase.Request)
+			s.Equal(testCase.Expected, err)
+			s.Nil(resp)
+		})
+	}
+
+	resp, err := handler.CreateSchedule(ctx, &adminservice.CreateScheduleRequest{Namespace: s.namespace, ScheduleId: "schedule", Schedule: invalidSpec})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+	s.Contains(err.Error(), "Invalid schedule spec")
+	s.Nil(resp)
+
+	workflowID := scheduler.WorkflowID(s.namespaceID, "schedule")
+	s.mockResource.SDKClient.On("ExecuteWorkflow", mock.Anything, mock.Anything, scheduler.WorkflowName, mock.Anything).Return(
+		nil, serviceerror.NewWorkflowExecutionAlreadyStarted("already started", "", "")).Once()
+	resp, err = handler.CreateSchedule(ctx, &adminservice.CreateScheduleRequest{Namespace: s.namespace, ScheduleId: "schedule", Schedule: s.newTestSchedule()})
+	s.Equal(errScheduleAlreadyExists, err)
+	s.Nil(resp)
+
+	patch := &schedpb.SchedulePatch{TriggerImmediately: &schedpb.TriggerImmediatelyRequest{}}
+	s.mockResource.SDKClient.On("ExecuteWorkflow", mock.Anything, mock.Anything, scheduler.WorkflowName, mock.Anything).Return(
+		func(_ context.Context, options client.StartWorkflowOptions, _ interface{}, args ...interface{}) client.WorkflowRun {
+			s.Equal(workflowID, options.ID)
+			s.Equal(scheduler.TaskQueueName, options.TaskQueue)
+			s.True(options.WorkflowExecutionErrorWhenAlreadyStarted)
+			s.Len(args, 1)
+			startArgs := args[0].(*schedpb.StartScheduleArgs)
+			s.Equal(s.newTestSchedule(), startArgs.Schedule)
+			s.Equal(s.namespaceID, startArgs.State.NamespaceId)
+			s.Equal("schedule", startArgs.State.ScheduleId)
+			return &sdkmocks.WorkflowRun{}
+		}, nil).Once()
+	s.mockResource.SDKClient.On("SignalWorkflow", mock.Anything, workflowID, "", scheduler.SignalNamePatch, patch).Return(nil).Once()
+	resp, err = handler.CreateSchedule(ctx, &adminservice.CreateScheduleRequest{
+		Namespace:    s.namespace,
+		ScheduleId:   "schedule",
+		Schedule:     s.newTestSchedule(),
+		InitialPatch: patch,
+	})
+	s.NoError(err)
+	s.NotNil(resp)
+	s.mockResource.SDKClient.AssertExpectations(s.T())
+}
+
+func (s *adminHandlerSuite) Test_DescribeSchedule() {
+	handler := s.handler
+	handler.config.MaxIDLengthLimit = dynamicconfig.GetIntPropertyFn(1000)
+	ctx := context.Background()
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+	request := &adminservice.DescribeScheduleRequest{Namespace: s.namespace, ScheduleId: "schedule"}
+
+	s.mockResource.SDKClient.On("QueryWorkflowWithOptions", mock.Anything, mock.Anything).Return(
+		&client.QueryWorkflowWithOptionsResponse{QueryRejected: &querypb.QueryRejected{}}, nil).Once()
+	resp, err := handler.DescribeSchedule(ctx, request)
+	s.Equal(errScheduleNotFound, err)
+	s.Nil(resp)
+
+	schedule := s.newTestSchedule()
+	queryResult := &sdkmocks.Value{}
+	queryResult.On("Get", mock.Anything).Return(func(valuePtr interface{}) error {
+		*valuePtr.(*schedpb.DescribeResponse) = schedpb.DescribeResponse{
+			Schedule:      schedule,
+			Info:          &schedpb.ScheduleInfo{ActionCount: 3},
+			ConflictToken: 2,
+		}
+		return nil
+	})
+	s.mockResource.SDKClient.On("QueryWorkflowWithOptions", mock.Anything, mock.Anything).Return(
+		func(_ context.Context, queryRequest *client.QueryWorkflowWithOptionsRequest) *client.QueryWorkflowWithOptionsResponse {
+			s.Equal(scheduler.WorkflowID(s.namespaceID, "schedule"), queryRequest.WorkflowID)
+			s.Equal(scheduler.QueryNameDescribe, queryRequest.QueryType)
+			s.Equal(enumspb.QUERY_REJECT_CONDITION_NOT_OPEN, queryRequest.QueryRejectCondition)
+			return &client.QueryWorkflowWithOptionsResponse{QueryResult: queryResult}
+		}, nil).Once()
+	resp, err = handler.DescribeSchedule(ctx, request)
+	s.NoError(err)
+	s.Equal(&adminservice.DescribeScheduleResponse{
+		Schedule:      schedule,
+		Info:          &schedpb.ScheduleInfo{ActionCount: 3},
+		ConflictToken: 2,
+	}, resp)
+}
+
+func (s *adminHandlerSuite) Test_PatchSchedule() {
+	handler := s.handler
+	handler.config.MaxIDLengthLimit = dynamicconfig.GetIntPropertyFn(1000)
+	ctx := context.Background()
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+
+	now := time.Now().UTC()
+	resp, err := handler.PatchSchedule(ctx, &adminservice.PatchScheduleRequest{
+		Namespace:  s.namespace,
+		ScheduleId: "schedule",
+		Patch: &schedpb.SchedulePatch{
+			BackfillRequest: []*schedpb.BackfillRequest{{StartTime: &now, EndTime: timestamp.TimePtr(now.Add(-time.Hour))}},
+		},
+	})
+	s.Equal(errInvalidBackfillRange, err)
+	s.Nil(resp)
+
+	patch := &schedpb.SchedulePatch{Pause: "paused for maintenance"}
+	s.mockResource.SDKClient.On("SignalWorkflow", mock.Anything, scheduler.WorkflowID(s.namespaceID, "schedule"), "", scheduler.SignalNamePatch, patch).Return(
+		serviceerror.NewNotFound("workflow execution already completed")).Once()
+	resp, err = handler.PatchSchedule(ctx, &adminservice.PatchScheduleRequest{Namespace: s.namespace, ScheduleId: "schedule", Patch: patch})
+	s.Equal(errScheduleNotFound, err)
+	s.Nil(resp)
+}
+
+func (s *adminHandlerSuite) Test_ListSchedules() {
+	ctx := context.Background()
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+
+	s.mockResource.SDKClient.On("ListOpenWorkflow", mock.Anything, mock.Anything).Return(
+		func(_ context.Context, request *workflowservice.ListOpenWorkflowExecutionsRequest) *workflowservice.ListOpenWorkflowExecutionsResponse {
+			s.Equal(common.SystemLocalNamespace, request.GetNamespace())
+			s.Equal(scheduler.WorkflowName, request.GetTypeFilter().GetName())
+			return &workflowservice.ListOpenWorkflowExecutionsResponse{
+				Executions: []*workflowpb.WorkflowExecutionInfo{
+					{Execution: &commonpb.WorkflowExecution{WorkflowId: scheduler.WorkflowID(s.namespaceID, "schedule-1")}},
+					{Execution: &commonpb.WorkflowExecution{WorkflowId: scheduler.WorkflowID("other-namespace-id", "schedule-2")}},
+					{Execution: &commonpb.WorkflowExecution{WorkflowId: scheduler.WorkflowID(s.namespaceID, "schedule-3")}},
+				},
+				NextPageToken: []byte("next-page"),
+			}
+		}, nil).Once()
+	resp, err := s.handler.ListSchedules(ctx, &adminservice.ListSchedulesRequest{Namespace: s.namespace})
+	s.NoError(err)
+	s.Equal([]string{"schedule-1", "schedule-3"}, resp.GetScheduleIds())
+	s.Equal([]byte("next-page"), resp.GetNextPageToken())
+}
+
+func (s *adminHandlerSuite) Test_StartResetBadBinaryJob() {
+	ctx := context.Background()
+	namespaceEntry := cache.NewLocalNamespaceCacheEntryForTest(
+		&persistencespb.NamespaceInfo{Id: s.namespaceID, Name: s.namespace},
+		&persistencespb.NamespaceConfig{
+			BadBinaries: &namespacepb.BadBinaries{
+				Binaries: map[string]*namespacepb.BadBinaryInfo{"bad-checksum": {Reason: "bug"}},
+			},
+		},
+		"",
+		nil)
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(namespaceEntry, nil).AnyTimes()
+
+	testCases := []struct {
+		Name     string
+		Request  *adminservice.StartResetBadBinaryJobRequest
+		Expected error
+	}{
+		{
+			Name:     "nil request",
+			Request:  nil,
+			Expected: errRequestNotSet,
+		},
+		{
+			Name:     "no binary checksum",
+			Request:  &adminservice.StartResetBadBinaryJobRequest{Namespace: s.namespace, Reason: "reason"},
+			Expected: errBinaryChecksumNotSet,
+		},
+		{
+			Name:     "no reason",
+			Request:  &adminservice.StartResetBadBinaryJobRequest{Namespace: s.namespace, BinaryChecksum: "bad-checksum"},
+			Expected: errReasonNotSet,
+		},
+		{
+			Name:     "not a bad binary",
+			Request:  &adminservice.StartResetBadBinaryJobRequest{Namespace: s.namespace, BinaryChecksum: "good-checksum", Reason: "reason"},
+			Expected: errBinaryChecksumNotBad,
+		},
+	}
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			resp, err := s.handler.StartResetBadBinaryJob(ctx, testCase.Request)
+			s.Equal(testCase.Expected, err)
+			s.Nil(resp)
+		})
+	}
+
+	query := "BinaryChecksums = 'bad-checksum' AND ExecutionStatus = 'Running'"
+	jobID := batcher.ResetBadBinaryJobID(s.namespace, "bad-checksum")
+	s.mockResource.SDKClient.On("CountWorkflow", mock.Anything, &workflowservice.CountWorkflowExecutionsRequest{
+		Namespace: s.namespace,
+		Query:     query,
+	}).Return(&workflowservice.CountWorkflowExecutionsResponse{Count: 3}, nil).Twice()
+	s.mockResource.SDKClient.On("ExecuteWorkflow", mock.Anything, mock.Anything, batcher.BatchWFTypeName, mock.Anything).Return(
+		nil, serviceerror.NewWorkflowExecutionAlreadyStarted("already started", "", "")).Once()
+	request := &adminservice.StartResetBadBinaryJobRequest{
+		Namespace:        s.namespace,
+		BinaryChecksum:   "bad-checksum",
+		Reason:           "reason",
+		Identity:         "operator",
+		ResetReapplyType: enumspb.RESET_REAPPLY_TYPE_NONE,
+	}
+	resp, err := s.handler.StartResetBadBinaryJob(ctx, request)
+	s.Equal(errResetBadBinaryJobAlreadyRunning, err)
+	s.Nil(resp)
+
+	mockRun := &sdkmocks.WorkflowRun{}
+	mockRun.On("GetID").Return(jobID)
+	s.mockResource.SDKClient.On("ExecuteWorkflow", mock.Anything, mock.Anything, batcher.BatchWFTypeName, mock.Anything).Return(
+		func(_ context.Context, options client.StartWorkflowOptions, _ interface{}, args ...interface{}) client.WorkflowRun {
+			s.Equal(jobID, options.ID)
+			s.Equal(batcher.BatcherTaskQueueName, options.TaskQueue)
+			s.True(options.WorkflowExecutionErrorWhenAlreadyStarted)
+			s.Equal(s.namespace, options.SearchAttributes[searchattribute.BatcherNamespace])
+			s.Equal("operator", options.SearchAttributes[searchattribute.BatcherUser])
+			s.Equal(batcher.BatchParams{
+				Namespace: s.namespace,
+				Query:     query,
+				Reason:    "reason",
+				BatchType: batcher.BatchTypeResetBadBinary,
+				ResetBadBinaryParams: batcher.ResetBadBinaryParams{
+					BinaryChecksum:   "bad-checksum",
+					ResetReapplyType: enumspb.RESET_REAPPLY_TYPE_NONE,
+				},
+			}, args[0])
+			return mockRun
+		}, nil).Once()
+	resp, err = s.handler.StartResetBadBinaryJob(ctx, request)
+	s.NoError(err)
+	s.Equal(jobID, resp.GetJobId())
+	s.Equal(int64(3), resp.GetEstimatedCount())
+	s.mockResource.SDKClient.AssertExpectations(s.T())
+}
+
+func (s *adminHandlerSuite) Test_GetResetPoints() {
+	ctx := context.Background()
+	execution := &commonpb.WorkflowExecution{WorkflowId: "workflowID", RunId: uuid.New()}
+	resp, err := s.handler.GetResetPoints(ctx, &adminservice.GetResetPointsRequest{Namespace: s.namespace})
+	s.Equal(errExecutionNotSet, err)
+	s.Nil(resp)
+
+	namespaceEntry := cache.NewLocalNamespaceCacheEntryForTest(
+		&persistencespb.NamespaceInfo{Id: s.namespaceID, Name: s.namespace},
+		&persistencespb.NamespaceConfig{
+			BadBinaries: &namespacepb.BadBinaries{
+				Binaries: map[string]*namespacepb.BadBinaryInfo{"build-2": {Reason: "bug"}},
+			},
+		},
+		"",
+		nil)
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(namespaceEntry, nil)
+	now := time.Now().UTC()
+	s.mockHistoryClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), &historyservice.DescribeWorkflowExecutionRequest{
+		NamespaceId: s.namespaceID,
+		Request: &workflowservice.DescribeWorkflowExecutionRequest{
+			Namespace: s.namespace,
+			Execution: execution,
+		},
+	}).Return(&historyservice.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+			AutoResetPoints: &workflowpb.ResetPoints{
+				Points: []*workflowpb.ResetPointInfo{
+					{
+						BinaryChecksum:               "build-1",
+						RunId:                        "run-1",
+						FirstWorkflowTaskCompletedId: 4,
+						CreateTime:                   timestamp.TimePtr(now.Add(-2 * time.Hour)),
+						ExpireTime:                   timestamp.TimePtr(now.Add(-time.Hour)),
+						Resettable:                   true,
+					},
+					{
+						BinaryChecksum:               "build-2",
+						RunId:                        execution.GetRunId(),
+						FirstWorkflowTaskCompletedId: 10,
+						CreateTime:                   timestamp.TimePtr(now.Add(-time.Minute)),
+						Resettable:                   true,
+					},
+					{
+						BinaryChecksum:               "build-3",
+						RunId:                        execution.GetRunId(),
+						FirstWorkflowTaskCompletedId: 20,
+						CreateTime:                   timestamp.TimePtr(now),
+						Resettable:                   false,
+					},
+				},
+			},
+		},
+	}, nil)
+
+	resp, err = s.handler.GetResetPoints(ctx, &adminservice.GetResetPointsRequest{Namespace: s.namespace, Execution: execution})
+	s.NoError(err)
+	s.Equal([]*adminservice.ResetPoint{
+		{
+			BuildId:                      "build-1",
+			RunId:                        "run-1",
+			FirstWorkflowTaskCompletedId: 4,
+			CreateTime:                   timestamp.TimePtr(now.Add(-2 * time.Hour)),
+			ExpireTime:                   timestamp.TimePtr(now.Add(-time.Hour)),
+			Resettable:                   false,
+		},
+		{
+			BuildId:                      "build-2",
+			RunId:                        execution.GetRunId(),
+			FirstWorkflowTaskCompletedId: 10,
+			CreateTime:                   timestamp.TimePtr(now.Add(-time.Minute)),
+			Resettable:                   true,
+			BadBinary:                    true,
+		},
+		{
+			BuildId:                      "build-3",
+			RunId:                        execution.GetRunId(),
+			FirstWorkflowTaskCompletedId: 20,
+			CreateTime:                   timestamp.TimePtr(now),
+			Resettable:                   false,
+		},
+	}, resp.GetResetPoints())
+}
@@ -25,9 +25,24 @@
 package configs
 
 import (
+	"time"
+
 	"go.temporal.io/server/common/quotas"
 )
 
+const (
+	// APIGroupWrite is the namespace rate limit group of APIs mutating workflows and namespaces
+	APIGroupWrite = "write"
+	// APIGroupRead is the namespace rate limit group of APIs reading workflow and namespace state
+	APIGroupRead = "read"
+	// APIGroupVisibility is the namespace rate limit group of visibility APIs
+	APIGroupVisibility = "visibility"
+	// APIGroupLongPoll is the namespace rate limit group of task queue long poll APIs
+	APIGroupLongPoll = "longPoll"
+
+	apiGroupRateLimiterRefreshInterval = time.Minute
+)
+
 var (
 	ExecutionAPICountLimitOverride = map[string]int{
 		"PollActivityTaskQueue": 1,
@@ -103,8 +118,152 @@ var (
 	OtherAPIPriorities = map[int]struct{}{
 		0: {},
 	}
+
+	APIToNamespaceAPIGroup = map[string]string{
+		"StartWorkflowExecution":           APIGroupWrite,
+		"SignalWithStartWorkflowExecution": APIGroupWrite,
+		"SignalWorkflowExecution":          APIGroupWrite,
+		"RequestCancelWorkflowExecution":   APIGroupWrite,
+		"TerminateWorkflowExecution":       APIGroupWrite,
+		"ResetWorkflowExecution":           APIGroupWrite,
+		"RecordActivityTaskHeartbeat":      APIGroupWrite,
+		"RecordActivityTaskHeartbeatById":  APIGroupWrite,
+		"RespondActivityTaskCanceled":      APIGroupWrite,
+		"RespondActivityTaskCanceledById":  APIGroupWrite,
+		"RespondActivityTaskFailed":        APIGroupWrite,
+		"RespondActivityTaskFailedById":    APIGroupWrite,
+		"RespondActivityTaskCompleted":     APIGroupWrite,
+		"RespondActivityTaskCompletedById": APIGroupWrite,
+		"RespondWorkflowTaskCompleted":     APIGroupWrite,
+		"RespondWorkflowTaskFailed":        APIGroupWrite,
+		"RespondQueryTaskCompleted":        APIGroupWrite,
+		"ResetStickyTaskQueue":             APIGroupWrite,
+		"RegisterNamespace":                APIGroupWrite,
+		"UpdateNamespace":                  APIGroupWrite,
+		"DeprecateNamespace":               APIGroupWrite,
+
+		"GetWorkflowExecutionHistory": APIGroupRead,
+		"DescribeWorkflowExecution":   APIGroupRead,
+		"QueryWorkflow":               APIGroupRead,
+		"DescribeTaskQueue":           APIGroupRead,
+		"ListTaskQueuePartitions":     APIGroupRead,
+		"GetClusterInfo":              APIGroupRead,
+		"GetSearchAttributes":         APIGroupRead,
+		"DescribeNamespace":           APIGroupRead,
+		"ListNamespaces":              APIGroupRead,
+
+		"CountWorkflowExecutions":        APIGroupVisibility,
+		"ScanWorkflowExecutions":         APIGroupVisibility,
+		"ListOpenWorkflowExecutions":     APIGroupVisibility,
+		"ListClosedWorkflowExecutions":   APIGroupVisibility,
+		"ListWorkflowExecutions":         APIGroupVisibility,
+		"ListArchivedWorkflowExecutions": APIGroupVisibility,
+
+		"PollWorkflowTaskQueue": APIGroupLongPoll,
+		"PollActivityTaskQueue": APIGroupLongPoll,
+	}
+
+	// ConcurrencyLimitExcludedAPIs are long poll APIs which are not subject to the adaptive concurrency limit,
+	// their latency depends on workers and doesn't reflect service load.
+	ConcurrencyLimitExcludedAPIs = map[string]struct{}{
+		"PollWorkflowTaskQueue":       {},
+		"PollActivityTaskQueue":       {},
+		"GetWorkflowExecutionHistory": {},
+		"QueryWorkflow":               {},
+	}
+
+	// StartWorkflowAPIs are APIs which start new workflows, they share the start workflow quota of namespace.
+	StartWorkflowAPIs = map[string]struct{}{
+		"StartWorkflowExecution":           {},
+		"SignalWithStartWorkflowExecution": {},
+	}
+
+	// DeletedNamespaceRejectedAPIs are APIs which create new work and are rejected once namespace is deleted.
+	DeletedNamespaceRejectedAPIs = map[string]struct{}{
+		"StartWorkflowExecution":           {},
+		"SignalWithStartWorkflowExecution": {},
+		"SignalWorkflowExecution":          {},
+		"ResetWorkflowExecution":           {},
+		"PollWorkflowTaskQueue":            {},
+		"PollActivityTaskQueue":            {},
+	}
+
+	NamespaceAPIGroups = []string{
+		APIGroupWrite,
+		APIGroupRead,
+		APIGroupVisibility,
+		APIGroupLongPoll,
+	}
 )
 
+// NewNamespaceRequestToRateLimiter creates the rate limiter of a single namespace. A request
+// is allowed only if both the namespace wide limit and the limit of its API group allow it,
+// so a burst of calls in one group cannot consume the whole namespace quota. Start workflow
+// APIs are additionally limited by the start workflow rate.
+func NewNamespaceRequestToRateLimiter(
+	rateFn quotas.RateFn,
+	apiGroupRateFn func(apiGroup string) float64,
+	startWorkflowRateFn quotas.RateFn,
+	burstRatioFn func() float64,
+) quotas.RequestRateLimiter {
+	return quotas.NewMultiRequestRateLimiter(
+		NewRequestToRateLimiter(rateFn),
+		NewAPIGroupRateLimiter(apiGroupRateFn, burstRatioFn),
+		NewStartWorkflowRateLimiter(startWorkflowRateFn, burstRatioFn),
+	)
+}
+
+// NewStartWorkflowRateLimiter creates a rate limiter with a single token bucket shared by the start workflow APIs
+func NewStartWorkflowRateLimiter(
+	rateFn quotas.RateFn,
+	burstRatioFn func() float64,
+) quotas.RequestRateLimiter {
+	rateLimiter := quotas.NewPriorityRateLimiter(
+		map[string]int{},
+		map[int]quotas.RateLimiter{
+			0: quotas.NewDynamicRateLimiter(
+				rateFn,
+				func() int { return int(burstRatioFn() * rateFn()) },
+				apiGroupRateLimiterRefreshInterval,
+			),
+		},
+	)
+
+	mapping := make(map[string]quotas.RequestRateLimiter, len(StartWorkflowAPIs))
+	for api := range StartWorkflowAPIs {
+		mapping[api] = rateLimiter
+	}
+	return quotas.NewRoutingRateLimiter(mapping)
+}
+
+// NewAPIGroupRateLimiter creates a rate limiter with an independent token bucket for each API group
+func NewAPIGroupRateLimiter(
+	apiGroupRateFn func(apiGroup string) float64,
+	burstRatioFn func() float64,
+) quotas.RequestRateLimiter {
+	groupRateLimiters := make(map[string]quotas.RequestRateLimiter, len(NamespaceAPIGroups))
+	for _, apiGroup := range NamespaceAPIGroups {
+		apiGroup := apiGroup
+		rateFn := func() float64 { return apiGroupRateFn(apiGroup) }
+		groupRateLimiters[apiGroup] = quotas.NewPriorityRateLimiter(
+			map[string]int{},
+			map[int]quotas.RateLimiter{
+				0: quotas.NewDynamicRateLimiter(
+					rateFn,
+					func() int { return int(burstRatioFn() * rateFn()) },
+					apiGroupRateLimiterRefreshInterval,
+				),
+			},
+		)
+	}
+
+	mapping := make(map[string]quotas.RequestRateLimiter, len(APIToNamespaceAPIGroup))
+	for api, apiGroup := range APIToNamespaceAPIGroup {
+		mapping[api] = groupRateLimiters[apiGroup]
+	}
+	return quotas.NewRoutingRateLimiter(mapping)
+}
+
 func NewRequestToRateLimiter(
 	rateFn quotas.RateFn,
 ) quotas.RequestRateLimiter {
@@ -27,10 +27,13 @@ package configs
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"go.temporal.io/api/workflowservice/v1"
+
+	"go.temporal.io/server/common/quotas"
 )
 
 type (
@@ -172,6 +175,54 @@ func (s *quotasSuite) TestOtherAPIs() {
 	s.Equal(apiToPriority, OtherAPIToPriority)
 }
 
+func (s *quotasSuite) TestNamespaceAPIGroups() {
+	var service workflowservice.WorkflowServiceServer
+	t := reflect.TypeOf(&service).Elem()
+	expectedAPIs := make(map[string]struct{}, t.NumMethod())
+	for i := 0; i < t.NumMethod(); i++ {
+		expectedAPIs[t.Method(i).Name] = struct{}{}
+	}
+
+	actualAPIs := make(map[string]struct{})
+	for api, apiGroup := range APIToNamespaceAPIGroup {
+		actualAPIs[api] = struct{}{}
+		s.Contains(NamespaceAPIGroups, apiGroup)
+	}
+	s.Equal(expectedAPIs, actualAPIs)
+}
+
+func (s *quotasSuite) TestAPIGroupRateLimiter() {
+	rateLimiter := NewAPIGroupRateLimiter(
+		func(apiGroup string) float64 {
+			if apiGroup == APIGroupVisibility {
+				return 1
+			}
+			return 100
+		},
+		func() float64 { return 1 },
+	)
+
+	now := time.Now()
+	s.True(rateLimiter.Allow(now, quotas.NewRequest("ListWorkflowExecutions", 1, "")))
+	s.False(rateLimiter.Allow(now, quotas.NewRequest("CountWorkflowExecutions", 1, "")))
+	// other groups are not affected by visibility calls being throttled
+	s.True(rateLimiter.Allow(now, quotas.NewRequest("StartWorkflowExecution", 1, "")))
+	s.True(rateLimiter.Allow(now, quotas.NewRequest("DescribeWorkflowExecution", 1, "")))
+}
+
+func (s *quotasSuite) TestStartWorkflowRateLimiter() {
+	rateLimiter := NewStartWorkflowRateLimiter(
+		func() float64 { return 1 },
+		func() float64 { return 1 },
+	)
+
+	now := time.Now()
+	s.True(rateLimiter.Allow(now, quotas.NewRequest("StartWorkflowExecution", 1, "")))
+	s.False(rateLimiter.Allow(now, quotas.NewRequest("SignalWithStartWorkflowExecution", 1, "")))
+	// other APIs are not limited
+	s.True(rateLimiter.Allow(now, quotas.NewRequest("SignalWorkflowExecution", 1, "")))
+}
+
 func (s *quotasSuite) TestAllAPIs() {
 	var service workflowservice.WorkflowServiceServer
 	t := reflect.TypeOf(&service).Elem()
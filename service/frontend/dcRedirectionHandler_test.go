@@ -42,6 +42,7 @@ import (
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/pagetoken"
 	"go.temporal.io/server/common/resource"
 )
 
@@ -107,7 +108,7 @@ func (s *dcRedirectionHandlerSuite) SetupTest() {
 
 	s.config = NewConfig(dynamicconfig.NewCollection(dynamicconfig.NewNoopClient(), s.mockResource.GetLogger()), 0, "", false)
 
-	frontendHandlerGRPC := NewWorkflowHandler(s.mockResource, s.config, nil)
+	frontendHandlerGRPC := NewWorkflowHandler(s.mockResource, s.config, nil, pagetoken.NewNoopCodec())
 
 	s.mockFrontendHandler = workflowservicemock.NewMockWorkflowServiceServer(s.controller)
 	s.handler = NewDCRedirectionHandler(frontendHandlerGRPC, config.DCRedirectionPolicy{})
@@ -0,0 +1,247 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/persistence"
+)
+
+const (
+	// deepHealthCheckProbeTimeout bounds each probe, so a hanging dependency is reported instead of hanging the check
+	deepHealthCheckProbeTimeout = 5 * time.Second
+	// deepHealthCheckTaskQueue is leased to verify that persistence accepts writes, it never has tasks
+	deepHealthCheckTaskQueue = "temporal-sys-deep-health-check"
+
+	historyHealthCheckServiceName  = "temporal.api.workflowservice.v1.HistoryService"
+	matchingHealthCheckServiceName = "temporal.api.workflowservice.v1.MatchingService"
+)
+
+type (
+	// healthProbe checks a component and returns a summary of what was checked
+	healthProbe struct {
+		name  string
+		check func(ctx context.Context) (string, error)
+	}
+
+	// hostHealthCheckFn checks the gRPC health endpoint of a frontend, history or matching host
+	hostHealthCheckFn func(ctx context.Context, service string, address string) error
+)
+
+func newGRPCHostHealthCheck(rpcFactory common.RPCFactory) hostHealthCheckFn {
+	return func(ctx context.Context, service string, address string) error {
+		var conn *grpc.ClientConn
+		var healthServiceName string
+		switch service {
+		case common.FrontendServiceName:
+			conn = rpcFactory.CreateFrontendGRPCConnection(address)
+			healthServiceName = serviceName
+		case common.HistoryServiceName:
+			conn = rpcFactory.CreateInternodeGRPCConnection(address)
+			healthServiceName = historyHealthCheckServiceName
+		case common.MatchingServiceName:
+			conn = rpcFactory.CreateInternodeGRPCConnection(address)
+			healthServiceName = matchingHealthCheckServiceName
+		default:
+			return fmt.Errorf("unknown service %v", service)
+		}
+		defer func() { _ = conn.Close() }()
+
+		resp, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{Service: healthServiceName})
+		if err != nil {
+			return err
+		}
+		if resp.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+			return fmt.Errorf("status %v", resp.GetStatus())
+		}
+		return nil
+	}
+}
+
+// runHealthProbes runs the probes concurrently and returns their results in the order of the probes
+func runHealthProbes(ctx context.Context, timeout time.Duration, probes []healthProbe) []*adminservice.ComponentHealth {
+	components := make([]*adminservice.ComponentHealth, len(probes))
+	var wg sync.WaitGroup
+	wg.Add(len(probes))
+	for i, probe := range probes {
+		go func(i int, probe healthProbe) {
+			defer wg.Done()
+
+			probeCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			start := time.Now()
+			message, err := probe.check(probeCtx)
+			latency := time.Since(start)
+
+			component := &adminservice.ComponentHealth{
+				Name:    probe.name,
+				Healthy: err == nil,
+				Message: message,
+				Latency: &latency,
+			}
+			if err != nil {
+				component.Message = err.Error()
+			}
+			components[i] = component
+		}(i, probe)
+	}
+	wg.Wait()
+	return components
+}
+
+func (adh *AdminHandler) healthProbes() []healthProbe {
+	probes := []healthProbe{
+		{name: "persistence", check: adh.probePersistence},
+	}
+	if adh.ESClient != nil {
+		probes = append(probes, healthProbe{name: "elasticsearch", check: adh.probeElasticsearch})
+	}
+	probes = append(probes,
+		healthProbe{name: "membership", check: adh.probeMembership},
+		healthProbe{name: common.FrontendServiceName, check: adh.probeServiceHosts(common.FrontendServiceName)},
+		healthProbe{name: common.HistoryServiceName, check: adh.probeServiceHosts(common.HistoryServiceName)},
+		healthProbe{name: common.MatchingServiceName, check: adh.probeServiceHosts(common.MatchingServiceName)},
+	)
+	return probes
+}
+
+// probePersistence reads the cluster metadata and leases the health check task queue, which writes its range id
+func (adh *AdminHandler) probePersistence(_ context.Context) (string, error) {
+	if _, err := adh.GetClusterMetadataManager().GetClusterMetadata(); err != nil {
+		return "", fmt.Errorf("unable to read cluster metadata: %v", err)
+	}
+	if _, err := adh.GetTaskManager().LeaseTaskQueue(&persistence.LeaseTaskQueueRequest{
+		NamespaceID:   common.SystemNamespaceID,
+		TaskQueue:     deepHealthCheckTaskQueue,
+		TaskType:      enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		TaskQueueKind: enumspb.TASK_QUEUE_KIND_NORMAL,
+	}); err != nil {
+		return "", fmt.Errorf("unable to write task queue: %v", err)
+	}
+	return "read cluster metadata and wrote task queue", nil
+}
+
+func (adh *AdminHandler) probeElasticsearch(ctx context.Context) (string, error) {
+	index := adh.ESConfig.GetVisibilityIndex()
+	if _, err := adh.ESClient.GetMapping(ctx, index); err != nil {
+		return "", fmt.Errorf("unable to read mapping of visibility index %v: %v", index, err)
+	}
+	return fmt.Sprintf("read mapping of visibility index %v", index), nil
+}
+
+// probeMembership checks that every service has members, this host is in the frontend ring,
+// and every history shard is owned by exactly one history host
+func (adh *AdminHandler) probeMembership(ctx context.Context) (string, error) {
+	for _, service := range []string{common.FrontendServiceName, common.HistoryServiceName, common.MatchingServiceName} {
+		if len(adh.serviceResolver(service).Members()) == 0 {
+			return "", fmt.Errorf("no %v hosts in membership ring", service)
+		}
+	}
+	if !isServiceMember(adh.GetFrontendServiceResolver(), adh.GetHostInfo().GetAddress()) {
+		return "", fmt.Errorf("this host %v is not in the frontend membership ring", adh.GetHostInfo().GetAddress())
+	}
+
+	owners := make(map[int32][]string)
+	for _, host := range adh.GetHistoryServiceResolver().Members() {
+		resp, err := adh.GetHistoryClient().DescribeHistoryHost(ctx, &historyservice.DescribeHistoryHostRequest{
+			HostAddress: host.GetAddress(),
+		})
+		if err != nil {
+			return "", fmt.Errorf("unable to describe history host %v: %v", host.GetAddress(), err)
+		}
+		for _, shardID := range resp.GetShardIds() {
+			owners[shardID] = append(owners[shardID], host.GetAddress())
+		}
+	}
+	var problems []string
+	for shardID := int32(1); shardID <= adh.numberOfHistoryShards; shardID++ {
+		switch hosts := owners[shardID]; len(hosts) {
+		case 1:
+		case 0:
+			problems = append(problems, fmt.Sprintf("shard %v is not owned by any host", shardID))
+		default:
+			sort.Strings(hosts)
+			problems = append(problems, fmt.Sprintf("shard %v is owned by %v", shardID, strings.Join(hosts, ", ")))
+		}
+	}
+	if len(problems) > 0 {
+		return "", fmt.Errorf("history shard ownership is inconsistent: %v", strings.Join(problems, "; "))
+	}
+	return fmt.Sprintf("%v history shards are owned by exactly one host", adh.numberOfHistoryShards), nil
+}
+
+// probeServiceHosts checks the gRPC health endpoint of every host of the service
+func (adh *AdminHandler) probeServiceHosts(service string) func(ctx context.Context) (string, error) {
+	return func(ctx context.Context) (string, error) {
+		members := adh.serviceResolver(service).Members()
+
+		var lock sync.Mutex
+		var unreachable []string
+		var wg sync.WaitGroup
+		wg.Add(len(members))
+		for _, member := range members {
+			go func(address string) {
+				defer wg.Done()
+				if err := adh.checkHostHealth(ctx, service, address); err != nil {
+					lock.Lock()
+					unreachable = append(unreachable, fmt.Sprintf("%v (%v)", address, err))
+					lock.Unlock()
+				}
+			}(member.GetAddress())
+		}
+		wg.Wait()
+
+		if len(unreachable) > 0 {
+			sort.Strings(unreachable)
+			return "", fmt.Errorf("%v of %v hosts are unhealthy: %v", len(unreachable), len(members), strings.Join(unreachable, ", "))
+		}
+		return fmt.Sprintf("%v hosts are serving", len(members)), nil
+	}
+}
+
+func (adh *AdminHandler) serviceResolver(service string) membership.ServiceResolver {
+	switch service {
+	case common.HistoryServiceName:
+		return adh.GetHistoryServiceResolver()
+	case common.MatchingServiceName:
+		return adh.GetMatchingServiceResolver()
+	default:
+		return adh.GetFrontendServiceResolver()
+	}
+}
@@ -46,8 +46,11 @@ var (
 	errInvalidWorkflowExecutionTimeoutSeconds             = serviceerror.NewInvalidArgument("An invalid WorkflowExecutionTimeoutSeconds is set on request.")
 	errInvalidWorkflowRunTimeoutSeconds                   = serviceerror.NewInvalidArgument("An invalid WorkflowRunTimeoutSeconds is set on request.")
 	errInvalidWorkflowTaskTimeoutSeconds                  = serviceerror.NewInvalidArgument("An invalid WorkflowTaskTimeoutSeconds is set on request.")
+	errInvalidStartDelay                                  = serviceerror.NewInvalidArgument("An invalid start delay is set on request.")
+	errCronAndStartDelaySet                               = serviceerror.NewInvalidArgument("CronSchedule and start delay may not be used together.")
 	errQueryDisallowedForNamespace                        = serviceerror.NewInvalidArgument("Namespace is not allowed to query, please contact temporal team to re-enable queries.")
 	errClusterNameNotSet                                  = serviceerror.NewInvalidArgument("Cluster name is not set.")
+	errShardIDNotSet                                      = serviceerror.NewInvalidArgument("Shard ID is not set.")
 	errEmptyReplicationInfo                               = serviceerror.NewInvalidArgument("Replication task info is not set.")
 	errHistoryNotFound                                    = serviceerror.NewInvalidArgument("Requested workflow history not found, may have passed retention period.")
 	errNamespaceTooLong                                   = serviceerror.NewInvalidArgument("Namespace length exceeds limit.")
@@ -73,10 +76,42 @@ var (
 	errFailureMustHaveApplicationFailureInfo              = serviceerror.NewInvalidArgument("Failure must have ApplicationFailureInfo.")
 	errStatusFilterMustBeNotRunning                       = serviceerror.NewInvalidArgument("StatusFilter must be specified and must be not Running.")
 	errTokenNamespaceMismatch                             = serviceerror.NewInvalidArgument("Operation requested with a token from a different namespace.")
+	errStartRequestNotSet                                 = serviceerror.NewInvalidArgument("StartRequest is not set on request.")
+	errStartRequestNamespaceMismatch                      = serviceerror.NewInvalidArgument("StartRequest targets a different namespace than the request.")
+	errSignalRequestsNotSet                               = serviceerror.NewInvalidArgument("SignalRequests are not set on request.")
+	errSignalRequestExecutionMismatch                     = serviceerror.NewInvalidArgument("SignalRequest targets a different workflow execution than StartRequest.")
+	errOlderThanNotSet                                    = serviceerror.NewInvalidArgument("OlderThan must be set to a positive duration.")
+	errDynamicConfigNameNotSet                            = serviceerror.NewInvalidArgument("Dynamic config key name is not set on request.")
+	errMaintenanceMessageTooLong                          = serviceerror.NewInvalidArgument("Maintenance message exceeds length limit.")
+	errMaintenanceMessageNotPrintable                     = serviceerror.NewInvalidArgument("Maintenance message must contain only printable ASCII characters.")
+	errBuildIDCompatibilityUpdateNotSet                   = serviceerror.NewInvalidArgument("Build id compatibility update is not set on request.")
+	errStickyTaskQueueNotSet                              = serviceerror.NewInvalidArgument("StickyTaskQueue is not set on request.")
+	errHistoryBatchesNotSet                               = serviceerror.NewInvalidArgument("HistoryBatches are not set on request.")
+	errMutableStateNotSet                                 = serviceerror.NewInvalidArgument("MutableState is not set on request.")
+	errMutableStateExecutionMismatch                      = serviceerror.NewInvalidArgument("MutableState belongs to a different workflow execution than the request.")
+	errScheduleIDNotSet                                   = serviceerror.NewInvalidArgument("ScheduleId is not set on request.")
+	errScheduleIDTooLong                                  = serviceerror.NewInvalidArgument("ScheduleId length exceeds limit.")
+	errScheduleNotSet                                     = serviceerror.NewInvalidArgument("Schedule is not set on request.")
+	errScheduleWorkflowTypeNotSet                         = serviceerror.NewInvalidArgument("WorkflowType is not set on schedule action.")
+	errScheduleTaskQueueNotSet                            = serviceerror.NewInvalidArgument("TaskQueue is not set on schedule action.")
+	errSchedulePatchNotSet                                = serviceerror.NewInvalidArgument("Patch is not set on request.")
+	errInvalidBackfillRange                               = serviceerror.NewInvalidArgument("Backfill must have a start time which is not after its end time.")
+	errScheduleAlreadyExists                              = serviceerror.NewInvalidArgument("Schedule already exists.")
+	errScheduleNotFound                                   = serviceerror.NewNotFound("Schedule not found.")
+	errBinaryChecksumNotSet                               = serviceerror.NewInvalidArgument("BinaryChecksum is not set on request.")
+	errReasonNotSet                                       = serviceerror.NewInvalidArgument("Reason is not set on request.")
+	errBinaryChecksumNotBad                               = serviceerror.NewInvalidArgument("BinaryChecksum is not a bad binary of the namespace.")
+	errResetBadBinaryJobAlreadyRunning                    = serviceerror.NewInvalidArgument("Reset bad binary job is already running for the binary checksum.")
+	errInvalidMaxMoves                                    = serviceerror.NewInvalidArgument("MaxMoves must not be negative.")
 	errShuttingDown                                       = serviceerror.NewInternal("Shutting down")
+	errClusterMetadataVersionNotSet                       = serviceerror.NewInvalidArgument("Version is not set on request.")
+	errInvalidFailoverVersionIncrement                    = serviceerror.NewInvalidArgument("FailoverVersionIncrement must be positive.")
+	errNamespaceQuotasNotSet                              = serviceerror.NewInvalidArgument("Quotas are not set on request.")
 
 	errPageSizeTooBigMessage = "PageSize is larger than allowed %d."
 
+	errInvalidDynamicConfigValueMessage = "Invalid dynamic config value: %v."
+
 	errSearchAttributeIsReservedMessage               = "Search attribute %s is reserved by system."
 	errSearchAttributeAlreadyExistsMessage            = "Search attribute %s already exists."
 	errSearchAttributeDoesntExistMessage              = "Search attribute %s doesn't exist."
@@ -86,6 +121,16 @@ var (
 	errUnableToSaveSearchAttributesMessage            = "Unable to save search attributes: %v."
 	errUnableToStartWorkflowMessage                   = "Unable to start %s workflow: %v."
 	errWorkflowReturnedErrorMessage                   = "Workflow %s returned an error: %v."
+	errInvalidScheduleSpecMessage                     = "Invalid schedule spec: %v."
+	errHostNotFoundMessage                            = "Host %s is not a frontend, history or matching member of the cluster."
+	errHostIsOtherFrontendMessage                     = "Host %s is another frontend, send the request to that frontend instead."
+	errHostNotHistoryMessage                          = "Host %s is not a history member of the cluster."
+	errClusterMetadataVersionMismatchMessage          = "Cluster metadata was updated since version %d, current version is %d. Describe the cluster metadata and retry."
+	errClusterDoesntExistMessage                      = "Cluster %s doesn't exist."
+	errUnableToRemoveClusterMessage                   = "Unable to remove cluster %s, it is the current or master cluster."
+	errInvalidInitialFailoverVersionMessage           = "Initial failover version %d of cluster %s must be positive and smaller than failover version increment %d."
+	errDuplicateInitialFailoverVersionMessage         = "Clusters %s and %s have the same initial failover version %d."
+	errClusterRPCAddressNotSetMessage                 = "Cluster %s is enabled but its rpc address is not set."
 
 	errNoPermission = serviceerror.NewPermissionDenied("No permission to do this operation.", "")
 )
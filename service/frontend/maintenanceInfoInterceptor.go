@@ -0,0 +1,133 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence"
+)
+
+const workflowServiceMethodPrefix = "/temporal.api.workflowservice.v1.WorkflowService/"
+
+var _ grpc.UnaryServerInterceptor = (*MaintenanceInfoInterceptor)(nil).Intercept
+
+// MaintenanceInfoInterceptor returns the planned maintenance announced for the cluster
+// in the response headers of workflow service calls.
+type MaintenanceInfoInterceptor struct {
+	manager         *persistence.MaintenanceInfoManager
+	refreshInterval dynamicconfig.DurationPropertyFn
+	logger          log.Logger
+	maintenanceInfo atomic.Value // *persistencespb.MaintenanceInfo
+	shutdownChan    chan struct{}
+	startOnce       sync.Once
+	stopOnce        sync.Once
+}
+
+func NewMaintenanceInfoInterceptor(
+	manager *persistence.MaintenanceInfoManager,
+	refreshInterval dynamicconfig.DurationPropertyFn,
+	logger log.Logger,
+) *MaintenanceInfoInterceptor {
+	mi := &MaintenanceInfoInterceptor{
+		manager:         manager,
+		refreshInterval: refreshInterval,
+		logger:          logger,
+		shutdownChan:    make(chan struct{}),
+	}
+	mi.maintenanceInfo.Store((*persistencespb.MaintenanceInfo)(nil))
+	return mi
+}
+
+func (mi *MaintenanceInfoInterceptor) Start() {
+	mi.startOnce.Do(func() {
+		mi.refresh()
+		go mi.refreshLoop()
+	})
+}
+
+func (mi *MaintenanceInfoInterceptor) Stop() {
+	mi.stopOnce.Do(func() {
+		close(mi.shutdownChan)
+	})
+}
+
+func (mi *MaintenanceInfoInterceptor) Intercept(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+
+	if strings.HasPrefix(info.FullMethod, workflowServiceMethodPrefix) {
+		if maintenanceInfo := mi.getMaintenanceInfo(); maintenanceInfo != nil {
+			// Error is returned only if headers were already sent, which can't happen before the handler is called.
+			_ = grpc.SetHeader(ctx, metadata.Pairs(
+				headers.MaintenanceMessageHeaderName, maintenanceInfo.GetMessage(),
+				headers.MaintenanceSeverityHeaderName, maintenanceInfo.GetSeverity().String(),
+			))
+		}
+	}
+	return handler(ctx, req)
+}
+
+func (mi *MaintenanceInfoInterceptor) getMaintenanceInfo() *persistencespb.MaintenanceInfo {
+	return mi.maintenanceInfo.Load().(*persistencespb.MaintenanceInfo)
+}
+
+func (mi *MaintenanceInfoInterceptor) refreshLoop() {
+	timer := time.NewTimer(mi.refreshInterval())
+	defer timer.Stop()
+	for {
+		select {
+		case <-mi.shutdownChan:
+			return
+		case <-timer.C:
+			mi.refresh()
+			timer.Reset(mi.refreshInterval())
+		}
+	}
+}
+
+func (mi *MaintenanceInfoInterceptor) refresh() {
+	maintenanceInfo, err := mi.manager.GetMaintenanceInfo()
+	if err != nil {
+		mi.logger.Warn("Failed to load maintenance info.", tag.Error(err))
+		return
+	}
+	mi.maintenanceInfo.Store(maintenanceInfo)
+}
@@ -0,0 +1,91 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+)
+
+type testServerTransportStream struct {
+	grpc.ServerTransportStream
+	header metadata.MD
+}
+
+func (s *testServerTransportStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func TestMaintenanceInfoInterceptor(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	clusterMetadataManager := persistence.NewMockClusterMetadataManager(controller)
+	clusterMetadataManager.EXPECT().GetClusterMetadata().Return(&persistence.GetClusterMetadataResponse{
+		ClusterMetadata: persistencespb.ClusterMetadata{
+			MaintenanceInfo: &persistencespb.MaintenanceInfo{
+				Message:  "database upgrade",
+				Severity: enumspb.SEVERITY_HIGH,
+			},
+		},
+	}, nil)
+
+	interceptor := NewMaintenanceInfoInterceptor(
+		persistence.NewMaintenanceInfoManager(clock.NewRealTimeSource(), clusterMetadataManager),
+		dynamicconfig.GetDurationPropertyFn(time.Minute),
+		log.NewNoopLogger(),
+	)
+	interceptor.refresh()
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil }
+
+	stream := &testServerTransportStream{}
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+	_, err := interceptor.Intercept(ctx, nil, &grpc.UnaryServerInfo{FullMethod: workflowServiceMethodPrefix + "StartWorkflowExecution"}, handler)
+	require.NoError(t, err)
+	require.Equal(t, []string{"database upgrade"}, stream.header.Get(headers.MaintenanceMessageHeaderName))
+	require.Equal(t, []string{"High"}, stream.header.Get(headers.MaintenanceSeverityHeaderName))
+
+	stream = &testServerTransportStream{}
+	ctx = grpc.NewContextWithServerTransportStream(context.Background(), stream)
+	_, err = interceptor.Intercept(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeCluster"}, handler)
+	require.NoError(t, err)
+	require.Empty(t, stream.header)
+}
@@ -32,8 +32,12 @@ import (
 
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/server/api/adminservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/authorization/audit"
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
@@ -41,6 +45,7 @@ import (
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/pagetoken"
 	"go.temporal.io/server/common/persistence"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
 	"go.temporal.io/server/common/persistence/visibility"
@@ -51,6 +56,8 @@ import (
 	"go.temporal.io/server/common/rpc"
 	"go.temporal.io/server/common/rpc/interceptor"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/slo"
+	"go.temporal.io/server/common/tracing"
 	"go.temporal.io/server/service/frontend/configs"
 	"google.golang.org/grpc"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
@@ -70,24 +77,52 @@ type Config struct {
 	EnableReadVisibilityFromES   dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	ESVisibilityListMaxQPS       dynamicconfig.IntPropertyFnWithNamespaceFilter
 	ESIndexMaxResultWindow       dynamicconfig.IntPropertyFn
+	ESPointInTimeKeepAlive       dynamicconfig.DurationPropertyFn
+	ESParseDocStrictMode         dynamicconfig.BoolPropertyFn
 	HistoryMaxPageSize           dynamicconfig.IntPropertyFnWithNamespaceFilter
 	RPS                          dynamicconfig.IntPropertyFn
 	MaxNamespaceRPSPerInstance   dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MaxNamespaceCountPerInstance dynamicconfig.IntPropertyFnWithNamespaceFilter
 	GlobalNamespaceRPS           dynamicconfig.IntPropertyFnWithNamespaceFilter
+	NamespaceWriteRPS            dynamicconfig.IntPropertyFnWithNamespaceFilter
+	NamespaceReadRPS             dynamicconfig.IntPropertyFnWithNamespaceFilter
+	NamespaceVisibilityRPS       dynamicconfig.IntPropertyFnWithNamespaceFilter
+	NamespaceLongPollRPS         dynamicconfig.IntPropertyFnWithNamespaceFilter
+	NamespaceBurstRatio          dynamicconfig.FloatPropertyFnWithNamespaceFilter
+	AdaptiveConcurrencyEnabled   dynamicconfig.BoolPropertyFn
+	AdaptiveConcurrencyMinLimit  dynamicconfig.IntPropertyFn
+	AdaptiveConcurrencyMaxLimit  dynamicconfig.IntPropertyFn
 	MaxIDLengthLimit             dynamicconfig.IntPropertyFn
 	EnableClientVersionCheck     dynamicconfig.BoolPropertyFn
 	DisallowQuery                dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	AuthorizationLogOnly         dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	AuditLogSamplingRate         dynamicconfig.FloatPropertyFnWithNamespaceFilter
 	ShutdownDrainDuration        dynamicconfig.DurationPropertyFn
+	MaintenanceRefreshInterval   dynamicconfig.DurationPropertyFn
+
+	// SLO tracking settings
+	SLOTrackingEnabled        dynamicconfig.BoolPropertyFn
+	SLOAvailabilityTarget     dynamicconfig.FloatPropertyFnWithNamespaceFilter
+	SLOLatencyTarget          dynamicconfig.DurationPropertyFnWithNamespaceFilter
+	SLOLatencyTargetOverrides dynamicconfig.MapPropertyFn
+	SLOLatencyObjective       dynamicconfig.FloatPropertyFnWithNamespaceFilter
+
+	// NamespaceHandoverDrainTimeout is the default time to wait for replication to drain during namespace handover
+	NamespaceHandoverDrainTimeout dynamicconfig.DurationPropertyFn
 
 	MaxBadBinaries dynamicconfig.IntPropertyFnWithNamespaceFilter
 
 	// security protection settings
 	DisableListVisibilityByFilter dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	QuarantinedWorkflowIDs        dynamicconfig.MapPropertyFnWithNamespaceFilter
+
+	// degraded mode settings
+	VisibilityFallbackToPersistence dynamicconfig.BoolPropertyFnWithNamespaceFilter
 
 	// size limit system protection
-	BlobSizeLimitError dynamicconfig.IntPropertyFnWithNamespaceFilter
-	BlobSizeLimitWarn  dynamicconfig.IntPropertyFnWithNamespaceFilter
+	BlobSizeLimitError     dynamicconfig.IntPropertyFnWithNamespaceFilter
+	BlobSizeLimitWarn      dynamicconfig.IntPropertyFnWithNamespaceFilter
+	BlobSizeAccountingMode dynamicconfig.StringPropertyFnWithNamespaceFilter
 
 	ThrottledLogRPS dynamicconfig.IntPropertyFn
 
@@ -131,6 +166,11 @@ type Config struct {
 	KeepAliveTime dynamicconfig.DurationPropertyFn
 	// Wait for the ping ack before assuming the connection is dead.
 	KeepAliveTimeout dynamicconfig.DurationPropertyFn
+
+	// HistoryStreamEventsPerSecond is the rate limit of events sent on a single history stream
+	HistoryStreamEventsPerSecond dynamicconfig.IntPropertyFnWithNamespaceFilter
+	// HistoryReverseMaxScannedEvents is the max number of events scanned by a single reverse history call
+	HistoryReverseMaxScannedEvents dynamicconfig.IntPropertyFnWithNamespaceFilter
 }
 
 // NewConfig returns new service config with default values
@@ -146,18 +186,38 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int32, esIndexName
 		EnableReadVisibilityFromES:             dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnableReadVisibilityFromES, enableReadFromES),
 		ESVisibilityListMaxQPS:                 dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendESVisibilityListMaxQPS, 10),
 		ESIndexMaxResultWindow:                 dc.GetIntProperty(dynamicconfig.FrontendESIndexMaxResultWindow, 10000),
+		ESPointInTimeKeepAlive:                 dc.GetDurationProperty(dynamicconfig.FrontendESPointInTimeKeepAlive, time.Minute),
+		ESParseDocStrictMode:                   dc.GetBoolProperty(dynamicconfig.FrontendESParseDocStrictMode, false),
 		HistoryMaxPageSize:                     dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendHistoryMaxPageSize, common.GetHistoryMaxPageSize),
 		RPS:                                    dc.GetIntProperty(dynamicconfig.FrontendRPS, 2400),
 		MaxNamespaceRPSPerInstance:             dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendMaxNamespaceRPSPerInstance, 2400),
 		MaxNamespaceCountPerInstance:           dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendMaxNamespaceCountPerInstance, 1200),
 		GlobalNamespaceRPS:                     dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendGlobalNamespaceRPS, 0),
+		NamespaceWriteRPS:                      dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendNamespaceWriteRPS, 0),
+		NamespaceReadRPS:                       dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendNamespaceReadRPS, 0),
+		NamespaceVisibilityRPS:                 dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendNamespaceVisibilityRPS, 0),
+		NamespaceLongPollRPS:                   dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendNamespaceLongPollRPS, 0),
+		NamespaceBurstRatio:                    dc.GetFloatPropertyFilteredByNamespace(dynamicconfig.FrontendNamespaceBurstRatio, 2),
+		AdaptiveConcurrencyEnabled:             dc.GetBoolProperty(dynamicconfig.FrontendAdaptiveConcurrencyEnabled, false),
+		AdaptiveConcurrencyMinLimit:            dc.GetIntProperty(dynamicconfig.FrontendAdaptiveConcurrencyMinLimit, 20),
+		AdaptiveConcurrencyMaxLimit:            dc.GetIntProperty(dynamicconfig.FrontendAdaptiveConcurrencyMaxLimit, 1000),
 		MaxIDLengthLimit:                       dc.GetIntProperty(dynamicconfig.MaxIDLengthLimit, 1000),
 		MaxBadBinaries:                         dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendMaxBadBinaries, namespace.MaxBadBinaries),
 		DisableListVisibilityByFilter:          dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.DisableListVisibilityByFilter, false),
+		VisibilityFallbackToPersistence:        dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.VisibilityFallbackToPersistence, false),
+		QuarantinedWorkflowIDs:                 dc.GetMapPropertyFnWithNamespaceFilter(dynamicconfig.QuarantinedWorkflowIDs, map[string]interface{}{}),
 		BlobSizeLimitError:                     dc.GetIntPropertyFilteredByNamespace(dynamicconfig.BlobSizeLimitError, 2*1024*1024),
 		BlobSizeLimitWarn:                      dc.GetIntPropertyFilteredByNamespace(dynamicconfig.BlobSizeLimitWarn, 256*1024),
+		BlobSizeAccountingMode:                 dc.GetStringPropertyFnWithNamespaceFilter(dynamicconfig.BlobSizeAccountingMode, common.BlobSizeAccountingModeTotal),
 		ThrottledLogRPS:                        dc.GetIntProperty(dynamicconfig.FrontendThrottledLogRPS, 20),
 		ShutdownDrainDuration:                  dc.GetDurationProperty(dynamicconfig.FrontendShutdownDrainDuration, 0),
+		MaintenanceRefreshInterval:             dc.GetDurationProperty(dynamicconfig.FrontendMaintenanceRefreshInterval, 10*time.Second),
+		SLOTrackingEnabled:                     dc.GetBoolProperty(dynamicconfig.FrontendSLOTrackingEnabled, false),
+		SLOAvailabilityTarget:                  dc.GetFloatPropertyFilteredByNamespace(dynamicconfig.FrontendSLOAvailabilityTarget, 0.999),
+		SLOLatencyTarget:                       dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.FrontendSLOLatencyTarget, time.Second),
+		SLOLatencyTargetOverrides:              dc.GetMapProperty(dynamicconfig.FrontendSLOLatencyTargetOverrides, map[string]interface{}{}),
+		SLOLatencyObjective:                    dc.GetFloatPropertyFilteredByNamespace(dynamicconfig.FrontendSLOLatencyObjective, 0.99),
+		NamespaceHandoverDrainTimeout:          dc.GetDurationProperty(dynamicconfig.FrontendNamespaceHandoverDrainTimeout, 5*time.Minute),
 		EnableNamespaceNotActiveAutoForwarding: dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnableNamespaceNotActiveAutoForwarding, true),
 		EnableClientVersionCheck:               dc.GetBoolProperty(dynamicconfig.EnableClientVersionCheck, true),
 		SearchAttributesNumberOfKeysLimit:      dc.GetIntPropertyFilteredByNamespace(dynamicconfig.SearchAttributesNumberOfKeysLimit, 100),
@@ -165,6 +225,8 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int32, esIndexName
 		SearchAttributesTotalSizeLimit:         dc.GetIntPropertyFilteredByNamespace(dynamicconfig.SearchAttributesTotalSizeLimit, 40*1024),
 		VisibilityArchivalQueryMaxPageSize:     dc.GetIntProperty(dynamicconfig.VisibilityArchivalQueryMaxPageSize, 10000),
 		DisallowQuery:                          dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.DisallowQuery, false),
+		AuthorizationLogOnly:                   dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.FrontendAuthorizationLogOnly, false),
+		AuditLogSamplingRate:                   dc.GetFloatPropertyFilteredByNamespace(dynamicconfig.FrontendAuditLogSamplingRate, 1.0),
 		SendRawWorkflowHistory:                 dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.SendRawWorkflowHistory, false),
 		DefaultWorkflowRetryPolicy:             dc.GetMapPropertyFnWithNamespaceFilter(dynamicconfig.DefaultWorkflowRetryPolicy, common.GetDefaultRetryPolicyConfigOptions()),
 		DefaultWorkflowTaskTimeout:             dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.DefaultWorkflowTaskTimeout, common.DefaultWorkflowTaskTimeout),
@@ -177,6 +239,8 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int32, esIndexName
 		KeepAliveMaxConnectionAgeGrace:         dc.GetDurationProperty(dynamicconfig.KeepAliveMaxConnectionAgeGrace, 70*time.Second),
 		KeepAliveTime:                          dc.GetDurationProperty(dynamicconfig.KeepAliveTime, 1*time.Minute),
 		KeepAliveTimeout:                       dc.GetDurationProperty(dynamicconfig.KeepAliveTimeout, 10*time.Second),
+		HistoryStreamEventsPerSecond:           dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendHistoryStreamEventsPerSecond, 1000),
+		HistoryReverseMaxScannedEvents:         dc.GetIntPropertyFilteredByNamespace(dynamicconfig.HistoryReverseMaxScannedEvents, 10000),
 	}
 }
 
@@ -187,10 +251,13 @@ type Service struct {
 	status int32
 	config *Config
 
-	handler        Handler
-	adminHandler   *AdminHandler
-	versionChecker *VersionChecker
-	server         *grpc.Server
+	handler                    Handler
+	adminHandler               *AdminHandler
+	versionChecker             *VersionChecker
+	sloTracker                 *slo.Tracker
+	auditLogger                audit.Logger
+	maintenanceInfoInterceptor *MaintenanceInfoInterceptor
+	server                     *grpc.Server
 
 	serverMetricsReporter metrics.Reporter
 	sdkMetricsReporter    metrics.Reporter
@@ -233,8 +300,10 @@ func NewService(
 		if params.ESConfig != nil {
 			visibilityIndexName := params.ESConfig.GetVisibilityIndex()
 			visibilityConfigForES := &config.VisibilityConfig{
-				MaxQPS:               serviceConfig.PersistenceMaxQPS,
-				VisibilityListMaxQPS: serviceConfig.ESVisibilityListMaxQPS,
+				MaxQPS:                 serviceConfig.PersistenceMaxQPS,
+				VisibilityListMaxQPS:   serviceConfig.ESVisibilityListMaxQPS,
+				ESPointInTimeKeepAlive: serviceConfig.ESPointInTimeKeepAlive,
+				ESParseDocStrictMode:   serviceConfig.ESParseDocStrictMode,
 			}
 			visibilityFromES = elasticsearch.NewVisibilityManager(visibilityIndexName, params.ESClient, visibilityConfigForES,
 				searchAttributesProvider, nil, params.MetricsClient, logger)
@@ -271,36 +340,124 @@ func NewService(
 		metrics.FrontendAPIMetricsScopes(),
 		serviceResource.GetLogger(),
 	)
+	serviceRPSFn := func() float64 { return float64(serviceConfig.RPS()) }
 	rateLimiterInterceptor := interceptor.NewRateLimitInterceptor(
-		configs.NewRequestToRateLimiter(func() float64 { return float64(serviceConfig.RPS()) }),
+		serviceResource.GetNamespaceCache(),
+		configs.NewRequestToRateLimiter(serviceRPSFn),
+		func(quotas.Request) float64 { return serviceRPSFn() },
 		map[string]int{},
+		serviceResource.GetThrottleTracker(),
 	)
+	namespaceRPSFn := func(namespace string) quotas.RateFn {
+		return func() float64 {
+			return namespaceRPS(
+				serviceConfig,
+				serviceResource.GetFrontendServiceResolver(),
+				serviceResource.GetNamespaceCache(),
+				namespace,
+			)
+		}
+	}
 	namespaceRateLimiterInterceptor := interceptor.NewNamespaceRateLimitInterceptor(
 		serviceResource.GetNamespaceCache(),
 		quotas.NewNamespaceRateLimiter(
 			func(req quotas.Request) quotas.RequestRateLimiter {
-				return configs.NewRequestToRateLimiter(func() float64 {
-					return namespaceRPS(
-						serviceConfig,
-						serviceResource.GetFrontendServiceResolver(),
-						req.Caller,
-					)
-				})
+				rateFn := namespaceRPSFn(req.Caller)
+				return configs.NewNamespaceRequestToRateLimiter(
+					rateFn,
+					func(apiGroup string) float64 {
+						return namespaceAPIGroupRPS(serviceConfig, req.Caller, apiGroup, rateFn)
+					},
+					func() float64 {
+						return namespaceStartWorkflowRPS(
+							serviceResource.GetFrontendServiceResolver(),
+							serviceResource.GetNamespaceCache(),
+							req.Caller,
+							rateFn,
+						)
+					},
+					func() float64 { return serviceConfig.NamespaceBurstRatio(req.Caller) },
+				)
 			},
 		),
+		func(req quotas.Request) float64 {
+			rateFn := namespaceRPSFn(req.Caller)
+			apiGroup := configs.APIToNamespaceAPIGroup[req.API]
+			rps := math.Min(rateFn(), namespaceAPIGroupRPS(serviceConfig, req.Caller, apiGroup, rateFn))
+			if _, ok := configs.StartWorkflowAPIs[req.API]; ok {
+				rps = math.Min(rps, namespaceStartWorkflowRPS(
+					serviceResource.GetFrontendServiceResolver(),
+					serviceResource.GetNamespaceCache(),
+					req.Caller,
+					rateFn,
+				))
+			}
+			return rps
+		},
 		map[string]int{},
+		serviceResource.GetThrottleTracker(),
+		serviceResource.GetLogger(),
+	)
+	concurrencyLimiterInterceptor := interceptor.NewConcurrencyLimitInterceptor(
+		quotas.NewAdaptiveConcurrencyLimiter(
+			func() int { return serviceConfig.AdaptiveConcurrencyMinLimit() },
+			func() int { return serviceConfig.AdaptiveConcurrencyMaxLimit() },
+		),
+		func() bool { return serviceConfig.AdaptiveConcurrencyEnabled() },
+		configs.ConcurrencyLimitExcludedAPIs,
+		serviceResource.GetLogger(),
 	)
 	namespaceCountLimiterInterceptor := interceptor.NewNamespaceCountLimitInterceptor(
 		serviceResource.GetNamespaceCache(),
 		serviceConfig.MaxNamespaceCountPerInstance,
 		configs.ExecutionAPICountLimitOverride,
 	)
+	namespaceStateInterceptor := interceptor.NewNamespaceStateInterceptor(
+		serviceResource.GetNamespaceCache(),
+		configs.DeletedNamespaceRejectedAPIs,
+	)
+
+	sloTracker := slo.NewTracker(
+		&slo.Config{
+			Enabled:                serviceConfig.SLOTrackingEnabled,
+			AvailabilityTarget:     serviceConfig.SLOAvailabilityTarget,
+			LatencyTarget:          serviceConfig.SLOLatencyTarget,
+			LatencyTargetOverrides: serviceConfig.SLOLatencyTargetOverrides,
+			LatencyObjective:       serviceConfig.SLOLatencyObjective,
+		},
+		serviceResource.GetMetricsClient(),
+		metrics.FrontendAPIMetricsScopes(),
+		configs.ConcurrencyLimitExcludedAPIs,
+		clock.NewRealTimeSource(),
+	)
+	sloInterceptor := interceptor.NewSLOInterceptor(
+		serviceResource.GetNamespaceCache(),
+		sloTracker,
+	)
+
+	auditLogger, err := audit.NewLogger(
+		params.AuditLogConfig,
+		serviceConfig.AuditLogSamplingRate,
+		serviceResource.GetMetricsClient(),
+		params.Logger,
+	)
+	if err != nil {
+		return nil, err
+	}
 
 	namespaceLogger := params.NamespaceLogger
 	namespaceLogInterceptor := interceptor.NewNamespaceLogInterceptor(
 		serviceResource.GetNamespaceCache(),
 		namespaceLogger)
 
+	maintenanceInfoInterceptor := NewMaintenanceInfoInterceptor(
+		persistence.NewMaintenanceInfoManager(clock.NewRealTimeSource(), serviceResource.GetClusterMetadataManager()),
+		serviceConfig.MaintenanceRefreshInterval,
+		params.Logger,
+	)
+
+	payloadRedactionInterceptor := authorization.NewPayloadRedactionInterceptor(params.PayloadRedactor)
+
 	kep := keepalive.EnforcementPolicy{
 		MinTime:             serviceConfig.KeepAliveMinTime(),
 		PermitWithoutStream: serviceConfig.KeepAlivePermitWithoutStream(),
@@ -322,12 +479,17 @@ func NewService(
 		grpc.KeepaliveParams(kp),
 		grpc.KeepaliveEnforcementPolicy(kep),
 		grpc.ChainUnaryInterceptor(
+			tracing.NewServerTracingInterceptor(),
 			namespaceLogInterceptor.Intercept,
+			maintenanceInfoInterceptor.Intercept,
 			rpc.ServiceErrorInterceptor,
 			metricsInterceptor.Intercept,
+			sloInterceptor.Intercept,
 			rateLimiterInterceptor.Intercept,
+			concurrencyLimiterInterceptor.Intercept,
 			namespaceRateLimiterInterceptor.Intercept,
 			namespaceCountLimiterInterceptor.Intercept,
+			namespaceStateInterceptor.Intercept,
 			metrics.NewServerMetricsContextInjectorInterceptor(),
 			authorization.NewAuthorizationInterceptor(
 				params.ClaimMapper,
@@ -335,21 +497,45 @@ func NewService(
 				serviceResource.GetMetricsClient(),
 				params.Logger,
 				params.AudienceGetter,
+				serviceConfig.AuthorizationLogOnly,
+				auditLogger,
 			),
+			payloadRedactionInterceptor.Intercept,
+		),
+		grpc.ChainStreamInterceptor(
+			rpc.ServiceErrorStreamInterceptor,
+			authorization.NewAuthorizationStreamInterceptor(
+				params.ClaimMapper,
+				params.Authorizer,
+				serviceResource.GetMetricsClient(),
+				params.Logger,
+				params.AudienceGetter,
+				serviceConfig.AuthorizationLogOnly,
+				auditLogger,
+			),
+			payloadRedactionInterceptor.StreamIntercept,
 		),
 	)
 
-	wfHandler := NewWorkflowHandler(serviceResource, serviceConfig, namespaceReplicationQueue)
+	pageTokenCodec, err := pagetoken.NewCodec(params.PageTokenConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	wfHandler := NewWorkflowHandler(serviceResource, serviceConfig, namespaceReplicationQueue, pageTokenCodec)
 	handler := NewDCRedirectionHandler(wfHandler, params.DCRedirectionPolicy)
 
 	return &Service{
-		Resource:       serviceResource,
-		status:         common.DaemonStatusInitialized,
-		config:         serviceConfig,
-		server:         grpc.NewServer(grpcServerOptions...),
-		handler:        handler,
-		adminHandler:   NewAdminHandler(serviceResource, params, serviceConfig),
-		versionChecker: NewVersionChecker(serviceConfig, params.MetricsClient, serviceResource.GetClusterMetadataManager()),
+		Resource:                   serviceResource,
+		status:                     common.DaemonStatusInitialized,
+		config:                     serviceConfig,
+		server:                     grpc.NewServer(grpcServerOptions...),
+		handler:                    handler,
+		adminHandler:               NewAdminHandler(serviceResource, params, serviceConfig, wfHandler.(*WorkflowHandler)),
+		versionChecker:             NewVersionChecker(serviceConfig, params.MetricsClient, serviceResource.GetClusterMetadataManager()),
+		sloTracker:                 sloTracker,
+		auditLogger:                auditLogger,
+		maintenanceInfoInterceptor: maintenanceInfoInterceptor,
 	}, nil
 }
 
@@ -373,6 +559,9 @@ func (s *Service) Start() {
 	s.Resource.Start()
 	s.adminHandler.Start()
 	s.versionChecker.Start()
+	s.sloTracker.Start()
+	s.auditLogger.Start()
+	s.maintenanceInfoInterceptor.Start()
 
 	listener := s.GetGRPCListener()
 	logger.Info("Starting to serve on frontend listener")
@@ -407,12 +596,15 @@ func (s *Service) Stop() {
 
 	s.adminHandler.Stop()
 	s.versionChecker.Stop()
+	s.sloTracker.Stop()
+	s.maintenanceInfoInterceptor.Stop()
 
 	logger.Info("ShutdownHandler: Draining traffic")
 	time.Sleep(requestDrainTime)
 
 	// TODO: Change this to GracefulStop when integration tests are refactored.
 	s.server.Stop()
+	s.auditLogger.Stop()
 	s.Resource.Stop()
 
 	if s.serverMetricsReporter != nil {
@@ -426,11 +618,18 @@ func (s *Service) Stop() {
 	logger.Info("frontend stopped")
 }
 
+// namespaceRPS returns the per host rate limit of the namespace, the max actions per second quota
+// of the namespace takes precedence over dynamic config and is evenly split across frontend hosts
 func namespaceRPS(
 	config *Config,
 	frontendResolver membership.ServiceResolver,
+	namespaceCache cache.NamespaceCache,
 	namespace string,
 ) float64 {
+	if quota := namespaceQuotas(namespaceCache, namespace).GetMaxActionsPerSecond(); quota > 0 {
+		return float64(quota) / float64(numFrontendHosts(frontendResolver))
+	}
+
 	hostRPS := float64(config.MaxNamespaceRPSPerInstance(namespace))
 	globalRPS := float64(config.GlobalNamespaceRPS(namespace))
 	hosts := float64(numFrontendHosts(frontendResolver))
@@ -439,6 +638,60 @@ func namespaceRPS(
 	return rps
 }
 
+// namespaceAPIGroupRPS returns the rate limit of an API group of the namespace,
+// falling back to the namespace rate limit if the group has no limit configured
+func namespaceAPIGroupRPS(
+	config *Config,
+	namespace string,
+	apiGroup string,
+	namespaceRPSFn quotas.RateFn,
+) float64 {
+	var groupRPS int
+	switch apiGroup {
+	case configs.APIGroupWrite:
+		groupRPS = config.NamespaceWriteRPS(namespace)
+	case configs.APIGroupRead:
+		groupRPS = config.NamespaceReadRPS(namespace)
+	case configs.APIGroupVisibility:
+		groupRPS = config.NamespaceVisibilityRPS(namespace)
+	case configs.APIGroupLongPoll:
+		groupRPS = config.NamespaceLongPollRPS(namespace)
+	}
+	if groupRPS <= 0 {
+		return namespaceRPSFn()
+	}
+	return float64(groupRPS)
+}
+
+// namespaceStartWorkflowRPS returns the per host rate limit of start workflow APIs of the namespace,
+// falling back to the namespace rate limit if the namespace has no start workflow quota
+func namespaceStartWorkflowRPS(
+	frontendResolver membership.ServiceResolver,
+	namespaceCache cache.NamespaceCache,
+	namespace string,
+	namespaceRPSFn quotas.RateFn,
+) float64 {
+	if quota := namespaceQuotas(namespaceCache, namespace).GetMaxStartWorkflowRps(); quota > 0 {
+		return float64(quota) / float64(numFrontendHosts(frontendResolver))
+	}
+	return namespaceRPSFn()
+}
+
+// namespaceQuotas returns the quotas of the namespace, or nil if the namespace is not found
+func namespaceQuotas(
+	namespaceCache cache.NamespaceCache,
+	namespace string,
+) *persistencespb.NamespaceQuotas {
+	if namespaceCache == nil || namespace == "" {
+		return nil
+	}
+	entry, err := namespaceCache.GetNamespace(namespace)
+	if err != nil {
+		return nil
+	}
+	return entry.GetConfig().GetQuotas()
+}
+
 func numFrontendHosts(
 	frontendResolver membership.ServiceResolver,
 ) int {
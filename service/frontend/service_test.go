@@ -0,0 +1,69 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/membership"
+)
+
+func TestNamespaceRPS_Quotas(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	config := NewConfig(dynamicconfig.NewCollection(dynamicconfig.NewNoopClient(), log.NewNoopLogger()), 0, "", false)
+	frontendResolver := membership.NewMockServiceResolver(controller)
+	frontendResolver.EXPECT().MemberCount().Return(4).AnyTimes()
+	namespaceCache := cache.NewMockNamespaceCache(controller)
+	namespaceConfig := &persistencespb.NamespaceConfig{}
+	namespaceCache.EXPECT().GetNamespace("test-namespace").Return(cache.NewLocalNamespaceCacheEntryForTest(
+		&persistencespb.NamespaceInfo{Name: "test-namespace"},
+		namespaceConfig,
+		cluster.TestCurrentClusterName,
+		nil,
+	), nil).AnyTimes()
+	namespaceRPSFn := func() float64 { return namespaceRPS(config, frontendResolver, namespaceCache, "test-namespace") }
+
+	// without quotas the dynamic config limits are used
+	require.Equal(t, float64(config.MaxNamespaceRPSPerInstance("test-namespace")), namespaceRPSFn())
+	require.Equal(t, namespaceRPSFn(), namespaceStartWorkflowRPS(frontendResolver, namespaceCache, "test-namespace", namespaceRPSFn))
+
+	// quotas are cluster wide and split evenly across frontend hosts
+	namespaceConfig.Quotas = &persistencespb.NamespaceQuotas{
+		MaxStartWorkflowRps: 40,
+		MaxActionsPerSecond: 400,
+	}
+	require.Equal(t, float64(100), namespaceRPSFn())
+	require.Equal(t, float64(10), namespaceStartWorkflowRPS(frontendResolver, namespaceCache, "test-namespace", namespaceRPSFn))
+}
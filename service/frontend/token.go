@@ -94,3 +94,31 @@ func deserializeHistoryToken(bytes []byte) (*tokenspb.HistoryContinuation, error
 	err := token.Unmarshal(bytes)
 	return token, err
 }
+
+func validateHistoryReversePaginationToken(
+	request *adminservice.GetWorkflowExecutionHistoryReverseRequest,
+	token *tokenspb.HistoryReverseContinuation,
+) error {
+
+	execution := request.Execution
+	if request.GetNamespace() != token.GetNamespace() ||
+		execution.GetWorkflowId() != token.GetWorkflowId() ||
+		(execution.GetRunId() != "" && execution.GetRunId() != token.GetRunId()) {
+		return errInvalidPaginationToken
+	}
+	return nil
+}
+
+func serializeHistoryReverseToken(token *tokenspb.HistoryReverseContinuation) ([]byte, error) {
+	if token == nil {
+		return nil, nil
+	}
+
+	return token.Marshal()
+}
+
+func deserializeHistoryReverseToken(bytes []byte) (*tokenspb.HistoryReverseContinuation, error) {
+	token := &tokenspb.HistoryReverseContinuation{}
+	err := token.Unmarshal(bytes)
+	return token, err
+}
@@ -37,6 +37,7 @@ import (
 	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/server/common/persistence/visibility"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
@@ -57,12 +58,14 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/pagetoken"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/validator"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/rpc/interceptor"
 	"go.temporal.io/server/common/searchattribute"
+	serviceerrors "go.temporal.io/server/common/serviceerror"
 )
 
 const (
@@ -76,6 +79,16 @@ const (
 	serviceName = "temporal.api.workflowservice.v1.WorkflowService"
 )
 
+// APIs which issue page tokens, tokens are only accepted by the API which issued them
+const (
+	pageTokenAPIGetWorkflowExecutionHistory    = "GetWorkflowExecutionHistory"
+	pageTokenAPIListOpenWorkflowExecutions     = "ListOpenWorkflowExecutions"
+	pageTokenAPIListClosedWorkflowExecutions   = "ListClosedWorkflowExecutions"
+	pageTokenAPIListWorkflowExecutions         = "ListWorkflowExecutions"
+	pageTokenAPIListArchivedWorkflowExecutions = "ListArchivedWorkflowExecutions"
+	pageTokenAPIScanWorkflowExecutions         = "ScanWorkflowExecutions"
+)
+
 var _ Handler = (*WorkflowHandler)(nil)
 
 var (
@@ -96,6 +109,7 @@ type (
 		namespaceHandler                namespace.Handler
 		visibilityQueryValidator        *validator.VisibilityQueryValidator
 		getDefaultWorkflowRetrySettings dynamicconfig.MapPropertyFnWithNamespaceFilter
+		pageTokenCodec                  pagetoken.Codec
 	}
 
 	// HealthStatus is an enum that refers to the rpc handler health status
@@ -111,6 +125,7 @@ func NewWorkflowHandler(
 	resource resource.Resource,
 	config *Config,
 	namespaceReplicationQueue persistence.NamespaceReplicationQueue,
+	pageTokenCodec pagetoken.Codec,
 ) Handler {
 
 	handler := &WorkflowHandler{
@@ -131,6 +146,7 @@ func NewWorkflowHandler(
 		),
 		visibilityQueryValidator:        validator.NewQueryValidator(resource.GetSearchAttributesProvider()),
 		getDefaultWorkflowRetrySettings: config.DefaultWorkflowRetryPolicy,
+		pageTokenCodec:                  pageTokenCodec,
 	}
 
 	return handler
@@ -385,6 +401,14 @@ func (wh *WorkflowHandler) StartWorkflowExecution(ctx context.Context, request *
 		return nil, err
 	}
 
+	startDelay, err := headers.GetStartDelay(ctx)
+	if err != nil || startDelay < 0 {
+		return nil, errInvalidStartDelay
+	}
+	if startDelay > 0 && request.GetCronSchedule() != "" {
+		return nil, errCronAndStartDelaySet
+	}
+
 	wh.GetLogger().Debug(
 		"Received StartWorkflowExecution",
 		tag.WorkflowID(request.GetWorkflowId()))
@@ -422,7 +446,7 @@ func (wh *WorkflowHandler) StartWorkflowExecution(ctx context.Context, request *
 	}
 
 	wh.GetLogger().Debug("Start workflow execution request namespaceID", tag.WorkflowNamespaceID(namespaceID))
-	resp, err := wh.GetHistoryClient().StartWorkflowExecution(ctx, common.CreateHistoryStartWorkflowRequest(namespaceID, request, nil, time.Now().UTC()))
+	resp, err := wh.GetHistoryClient().StartWorkflowExecution(ctx, common.CreateHistoryStartWorkflowRequest(namespaceID, request, nil, time.Now().UTC(), startDelay))
 
 	if err != nil {
 		return nil, err
@@ -466,6 +490,11 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(ctx context.Context, requ
 		return nil, err
 	}
 
+	nextPageToken, err := wh.pageTokenCodec.Decode(namespaceID, pageTokenAPIGetWorkflowExecutionHistory, request.GetNextPageToken())
+	if err != nil {
+		return nil, err
+	}
+
 	// force limit page size if exceed
 	if request.GetMaximumPageSize() > common.GetHistoryMaxPageSize {
 		wh.GetThrottledLogger().Warn("GetHistory page size is larger than threshold",
@@ -479,7 +508,7 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(ctx context.Context, requ
 		enableArchivalRead := wh.GetArchivalMetadata().GetHistoryConfig().ReadEnabled()
 		historyArchived := wh.historyArchived(ctx, request, namespaceID)
 		if enableArchivalRead && historyArchived {
-			return wh.getArchivedHistory(ctx, request, namespaceID)
+			return wh.getArchivedHistory(ctx, request, namespaceID, nextPageToken)
 		}
 	}
 
@@ -529,8 +558,8 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(ctx context.Context, requ
 
 	// process the token for paging
 	queryNextEventID := common.EndEventID
-	if request.NextPageToken != nil {
-		continuationToken, err = deserializeHistoryToken(request.NextPageToken)
+	if nextPageToken != nil {
+		continuationToken, err = deserializeHistoryToken(nextPageToken)
 		if err != nil {
 			return nil, errInvalidNextPageToken
 		}
@@ -691,6 +720,10 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(ctx context.Context, requ
 	if err != nil {
 		return nil, err
 	}
+	nextToken, err = wh.pageTokenCodec.Encode(namespaceID, pageTokenAPIGetWorkflowExecutionHistory, nextToken)
+	if err != nil {
+		return nil, err
+	}
 	return &workflowservice.GetWorkflowExecutionHistoryResponse{
 		History:       history,
 		RawHistory:    historyBlob,
@@ -932,11 +965,13 @@ func (wh *WorkflowHandler) RespondWorkflowTaskFailed(
 
 	sizeLimitError := wh.config.BlobSizeLimitError(namespaceEntry.GetInfo().Name)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespaceEntry.GetInfo().Name)
+	sizeAccountingMode := wh.config.BlobSizeAccountingMode(namespaceEntry.GetInfo().Name)
 
 	if err := common.CheckEventBlobSizeLimit(
-		request.GetFailure().Size(),
+		request.GetFailure(),
 		sizeLimitWarn,
 		sizeLimitError,
+		sizeAccountingMode,
 		namespaceId,
 		taskToken.GetWorkflowId(),
 		taskToken.GetRunId(),
@@ -1112,11 +1147,13 @@ func (wh *WorkflowHandler) RecordActivityTaskHeartbeat(ctx context.Context, requ
 
 	sizeLimitError := wh.config.BlobSizeLimitError(namespaceEntry.GetInfo().Name)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespaceEntry.GetInfo().Name)
+	sizeAccountingMode := wh.config.BlobSizeAccountingMode(namespaceEntry.GetInfo().Name)
 
 	if err := common.CheckEventBlobSizeLimit(
-		request.GetDetails().Size(),
+		request.GetDetails(),
 		sizeLimitWarn,
 		sizeLimitError,
+		sizeAccountingMode,
 		namespaceId,
 		taskToken.GetWorkflowId(),
 		taskToken.GetRunId(),
@@ -1210,11 +1247,13 @@ func (wh *WorkflowHandler) RecordActivityTaskHeartbeatById(ctx context.Context,
 
 	sizeLimitError := wh.config.BlobSizeLimitError(namespaceEntry.GetInfo().Name)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespaceEntry.GetInfo().Name)
+	sizeAccountingMode := wh.config.BlobSizeAccountingMode(namespaceEntry.GetInfo().Name)
 
 	if err := common.CheckEventBlobSizeLimit(
-		request.GetDetails().Size(),
+		request.GetDetails(),
 		sizeLimitWarn,
 		sizeLimitError,
+		sizeAccountingMode,
 		namespaceID,
 		taskToken.GetWorkflowId(),
 		taskToken.GetRunId(),
@@ -1306,11 +1345,13 @@ func (wh *WorkflowHandler) RespondActivityTaskCompleted(
 
 	sizeLimitError := wh.config.BlobSizeLimitError(namespaceEntry.GetInfo().Name)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespaceEntry.GetInfo().Name)
+	sizeAccountingMode := wh.config.BlobSizeAccountingMode(namespaceEntry.GetInfo().Name)
 
 	if err := common.CheckEventBlobSizeLimit(
-		request.GetResult().Size(),
+		request.GetResult(),
 		sizeLimitWarn,
 		sizeLimitError,
+		sizeAccountingMode,
 		namespaceId,
 		taskToken.GetWorkflowId(),
 		taskToken.GetRunId(),
@@ -1406,11 +1447,13 @@ func (wh *WorkflowHandler) RespondActivityTaskCompletedById(ctx context.Context,
 
 	sizeLimitError := wh.config.BlobSizeLimitError(namespaceEntry.GetInfo().Name)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespaceEntry.GetInfo().Name)
+	sizeAccountingMode := wh.config.BlobSizeAccountingMode(namespaceEntry.GetInfo().Name)
 
 	if err := common.CheckEventBlobSizeLimit(
-		request.GetResult().Size(),
+		request.GetResult(),
 		sizeLimitWarn,
 		sizeLimitError,
+		sizeAccountingMode,
 		namespaceID,
 		taskToken.GetWorkflowId(),
 		runID,
@@ -1507,11 +1550,13 @@ func (wh *WorkflowHandler) RespondActivityTaskFailed(
 
 	sizeLimitError := wh.config.BlobSizeLimitError(namespaceEntry.GetInfo().Name)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespaceEntry.GetInfo().Name)
+	sizeAccountingMode := wh.config.BlobSizeAccountingMode(namespaceEntry.GetInfo().Name)
 
 	if err := common.CheckEventBlobSizeLimit(
-		request.GetFailure().Size(),
+		request.GetFailure(),
 		sizeLimitWarn,
 		sizeLimitError,
+		sizeAccountingMode,
 		namespaceID,
 		taskToken.GetWorkflowId(),
 		taskToken.GetRunId(),
@@ -1595,11 +1640,13 @@ func (wh *WorkflowHandler) RespondActivityTaskFailedById(ctx context.Context, re
 
 	sizeLimitError := wh.config.BlobSizeLimitError(namespaceEntry.GetInfo().Name)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespaceEntry.GetInfo().Name)
+	sizeAccountingMode := wh.config.BlobSizeAccountingMode(namespaceEntry.GetInfo().Name)
 
 	if err := common.CheckEventBlobSizeLimit(
-		request.GetFailure().Size(),
+		request.GetFailure(),
 		sizeLimitWarn,
 		sizeLimitError,
+		sizeAccountingMode,
 		namespaceID,
 		taskToken.GetWorkflowId(),
 		runID,
@@ -1679,11 +1726,13 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceled(ctx context.Context, requ
 
 	sizeLimitError := wh.config.BlobSizeLimitError(namespaceEntry.GetInfo().Name)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespaceEntry.GetInfo().Name)
+	sizeAccountingMode := wh.config.BlobSizeAccountingMode(namespaceEntry.GetInfo().Name)
 
 	if err := common.CheckEventBlobSizeLimit(
-		request.GetDetails().Size(),
+		request.GetDetails(),
 		sizeLimitWarn,
 		sizeLimitError,
+		sizeAccountingMode,
 		namespaceID,
 		taskToken.GetWorkflowId(),
 		taskToken.GetRunId(),
@@ -1778,11 +1827,13 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceledById(ctx context.Context,
 
 	sizeLimitError := wh.config.BlobSizeLimitError(namespaceEntry.GetInfo().Name)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespaceEntry.GetInfo().Name)
+	sizeAccountingMode := wh.config.BlobSizeAccountingMode(namespaceEntry.GetInfo().Name)
 
 	if err := common.CheckEventBlobSizeLimit(
-		request.GetDetails().Size(),
+		request.GetDetails(),
 		sizeLimitWarn,
 		sizeLimitError,
+		sizeAccountingMode,
 		namespaceID,
 		taskToken.GetWorkflowId(),
 		runID,
@@ -1894,6 +1945,10 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(ctx context.Context, request
 		return nil, err
 	}
 
+	if wh.isWorkflowQuarantined(request.GetNamespace(), request.WorkflowExecution.GetWorkflowId()) {
+		return nil, serviceerrors.NewWorkflowQuarantined(request.WorkflowExecution.GetWorkflowId())
+	}
+
 	if request.GetSignalName() == "" {
 		return nil, errSignalNameTooLong
 	}
@@ -1913,10 +1968,12 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(ctx context.Context, request
 
 	sizeLimitError := wh.config.BlobSizeLimitError(request.GetNamespace())
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(request.GetNamespace())
+	sizeAccountingMode := wh.config.BlobSizeAccountingMode(request.GetNamespace())
 	if err := common.CheckEventBlobSizeLimit(
-		request.GetInput().Size(),
+		request.GetInput(),
 		sizeLimitWarn,
 		sizeLimitError,
+		sizeAccountingMode,
 		namespaceID,
 		request.GetWorkflowExecution().GetWorkflowId(),
 		request.GetWorkflowExecution().GetRunId(),
@@ -1954,66 +2011,13 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 		return nil, err
 	}
 
-	if request == nil {
-		return nil, errRequestNotSet
-	}
-
-	namespace := request.GetNamespace()
-	if namespace == "" {
-		return nil, errNamespaceNotSet
-	}
-
-	if len(namespace) > wh.config.MaxIDLengthLimit() {
-		return nil, errNamespaceTooLong
-	}
-
-	if request.GetWorkflowId() == "" {
-		return nil, errWorkflowIDNotSet
-	}
-
-	if len(request.GetWorkflowId()) > wh.config.MaxIDLengthLimit() {
-		return nil, errWorkflowIDTooLong
-	}
-
-	if request.GetSignalName() == "" {
-		return nil, errSignalNameNotSet
-	}
-
-	if len(request.GetSignalName()) > wh.config.MaxIDLengthLimit() {
-		return nil, errSignalNameTooLong
-	}
-
-	if request.WorkflowType == nil || request.WorkflowType.GetName() == "" {
-		return nil, errWorkflowTypeNotSet
-	}
-
-	if len(request.WorkflowType.GetName()) > wh.config.MaxIDLengthLimit() {
-		return nil, errWorkflowTypeTooLong
-	}
-
-	if err := wh.validateTaskQueue(request.TaskQueue); err != nil {
-		return nil, err
-	}
-
-	if len(request.GetRequestId()) > wh.config.MaxIDLengthLimit() {
-		return nil, errRequestIDTooLong
-	}
-
-	if err := wh.validateSignalWithStartWorkflowTimeouts(request); err != nil {
-		return nil, err
-	}
-
-	if err := wh.validateRetryPolicy(request.GetNamespace(), request.RetryPolicy); err != nil {
-		return nil, err
-	}
-
-	if err := backoff.ValidateSchedule(request.GetCronSchedule()); err != nil {
+	if err := wh.validateSignalWithStartWorkflowExecutionRequest(request); err != nil {
 		return nil, err
 	}
 
 	enums.SetDefaultWorkflowIdReusePolicy(&request.WorkflowIdReusePolicy)
 
-	namespaceID, err := wh.GetNamespaceCache().GetNamespaceID(namespace)
+	namespaceID, err := wh.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
 	if err != nil {
 		return nil, err
 	}
@@ -2183,11 +2187,16 @@ func (wh *WorkflowHandler) ListOpenWorkflowExecutions(ctx context.Context, reque
 		return nil, err
 	}
 
+	nextPageToken, err := wh.pageTokenCodec.Decode(namespaceID, pageTokenAPIListOpenWorkflowExecutions, request.GetNextPageToken())
+	if err != nil {
+		return nil, err
+	}
+
 	baseReq := visibility.ListWorkflowExecutionsRequest{
 		NamespaceID:       namespaceID,
 		Namespace:         namespace,
 		PageSize:          int(request.GetMaximumPageSize()),
-		NextPageToken:     request.NextPageToken,
+		NextPageToken:     nextPageToken,
 		EarliestStartTime: timestamp.TimeValue(request.StartTimeFilter.GetEarliestTime()),
 		LatestStartTime:   timestamp.TimeValue(request.StartTimeFilter.GetLatestTime()),
 	}
@@ -2202,6 +2211,12 @@ func (wh *WorkflowHandler) ListOpenWorkflowExecutions(ctx context.Context, reque
 					ListWorkflowExecutionsRequest: baseReq,
 					WorkflowID:                    request.GetExecutionFilter().GetWorkflowId(),
 				})
+			if err != nil && wh.shouldFallbackToPersistence(namespace, nextPageToken, err) {
+				wh.GetLogger().Warn("Visibility store unavailable, listing open workflow from persistence",
+					tag.WorkflowNamespace(namespace), tag.Error(err))
+				wh.metricsScope(ctx).IncCounter(metrics.VisibilityPersistenceFallbackCount)
+				persistenceResp, err = wh.listOpenWorkflowExecutionFromPersistence(ctx, namespaceID, request, baseReq)
+			}
 		}
 		wh.GetLogger().Debug("List open workflow with filter",
 			tag.WorkflowNamespace(request.GetNamespace()), tag.WorkflowListWorkflowFilterByID)
@@ -2224,9 +2239,63 @@ func (wh *WorkflowHandler) ListOpenWorkflowExecutions(ctx context.Context, reque
 		return nil, err
 	}
 
+	nextPageToken, err = wh.pageTokenCodec.Encode(namespaceID, pageTokenAPIListOpenWorkflowExecutions, persistenceResp.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
 	return &workflowservice.ListOpenWorkflowExecutionsResponse{
 		Executions:    persistenceResp.Executions,
-		NextPageToken: persistenceResp.NextPageToken,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// shouldFallbackToPersistence returns true if list open workflow by execution filter should be served
+// from persistence because of a visibility store outage. Only the first page can be served that way.
+func (wh *WorkflowHandler) shouldFallbackToPersistence(namespace string, nextPageToken []byte, err error) bool {
+	if !wh.config.VisibilityFallbackToPersistence(namespace) || len(nextPageToken) != 0 {
+		return false
+	}
+	switch err.(type) {
+	case *serviceerror.Internal, *serviceerror.Unavailable:
+		return true
+	}
+	return false
+}
+
+// listOpenWorkflowExecutionFromPersistence looks up the execution matching the execution filter
+// (current run if run id is not specified) in persistence and returns it if it is running.
+func (wh *WorkflowHandler) listOpenWorkflowExecutionFromPersistence(
+	ctx context.Context,
+	namespaceID string,
+	request *workflowservice.ListOpenWorkflowExecutionsRequest,
+	baseReq visibility.ListWorkflowExecutionsRequest,
+) (*visibility.ListWorkflowExecutionsResponse, error) {
+	response, err := wh.GetHistoryClient().DescribeWorkflowExecution(ctx, &historyservice.DescribeWorkflowExecutionRequest{
+		NamespaceId: namespaceID,
+		Request: &workflowservice.DescribeWorkflowExecutionRequest{
+			Namespace: request.GetNamespace(),
+			Execution: &commonpb.WorkflowExecution{
+				WorkflowId: request.GetExecutionFilter().GetWorkflowId(),
+				RunId:      request.GetExecutionFilter().GetRunId(),
+			},
+		},
+	})
+	if err != nil {
+		if _, ok := err.(*serviceerror.NotFound); ok {
+			return &visibility.ListWorkflowExecutionsResponse{}, nil
+		}
+		return nil, err
+	}
+
+	executionInfo := response.GetWorkflowExecutionInfo()
+	startTime := timestamp.TimeValue(executionInfo.GetStartTime())
+	if executionInfo.GetStatus() != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING ||
+		startTime.Before(baseReq.EarliestStartTime) || startTime.After(baseReq.LatestStartTime) {
+		return &visibility.ListWorkflowExecutionsResponse{}, nil
+	}
+	return &visibility.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{executionInfo},
 	}, nil
 }
 
@@ -2280,11 +2349,16 @@ func (wh *WorkflowHandler) ListClosedWorkflowExecutions(ctx context.Context, req
 		return nil, err
 	}
 
+	nextPageToken, err := wh.pageTokenCodec.Decode(namespaceID, pageTokenAPIListClosedWorkflowExecutions, request.GetNextPageToken())
+	if err != nil {
+		return nil, err
+	}
+
 	baseReq := visibility.ListWorkflowExecutionsRequest{
 		NamespaceID:       namespaceID,
 		Namespace:         namespace,
 		PageSize:          int(request.GetMaximumPageSize()),
-		NextPageToken:     request.NextPageToken,
+		NextPageToken:     nextPageToken,
 		EarliestStartTime: timestamp.TimeValue(request.StartTimeFilter.GetEarliestTime()),
 		LatestStartTime:   timestamp.TimeValue(request.StartTimeFilter.GetLatestTime()),
 	}
@@ -2336,9 +2410,14 @@ func (wh *WorkflowHandler) ListClosedWorkflowExecutions(ctx context.Context, req
 		return nil, err
 	}
 
+	nextPageToken, err = wh.pageTokenCodec.Encode(namespaceID, pageTokenAPIListClosedWorkflowExecutions, persistenceResp.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
 	return &workflowservice.ListClosedWorkflowExecutionsResponse{
 		Executions:    persistenceResp.Executions,
-		NextPageToken: persistenceResp.NextPageToken,
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
@@ -2380,11 +2459,16 @@ func (wh *WorkflowHandler) ListWorkflowExecutions(ctx context.Context, request *
 		return nil, err
 	}
 
+	nextPageToken, err := wh.pageTokenCodec.Decode(namespaceID, pageTokenAPIListWorkflowExecutions, request.GetNextPageToken())
+	if err != nil {
+		return nil, err
+	}
+
 	req := &visibility.ListWorkflowExecutionsRequestV2{
 		NamespaceID:   namespaceID,
 		Namespace:     namespace,
 		PageSize:      int(request.GetPageSize()),
-		NextPageToken: request.NextPageToken,
+		NextPageToken: nextPageToken,
 		Query:         request.GetQuery(),
 	}
 	persistenceResp, err := wh.GetVisibilityManager().ListWorkflowExecutions(req)
@@ -2392,9 +2476,14 @@ func (wh *WorkflowHandler) ListWorkflowExecutions(ctx context.Context, request *
 		return nil, err
 	}
 
+	nextPageToken, err = wh.pageTokenCodec.Encode(namespaceID, pageTokenAPIListWorkflowExecutions, persistenceResp.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
 	return &workflowservice.ListWorkflowExecutionsResponse{
 		Executions:    persistenceResp.Executions,
-		NextPageToken: persistenceResp.NextPageToken,
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
@@ -2440,6 +2529,11 @@ func (wh *WorkflowHandler) ListArchivedWorkflowExecutions(ctx context.Context, r
 		return nil, err
 	}
 
+	nextPageToken, err := wh.pageTokenCodec.Decode(entry.GetInfo().Id, pageTokenAPIListArchivedWorkflowExecutions, request.GetNextPageToken())
+	if err != nil {
+		return nil, err
+	}
+
 	if entry.GetConfig().VisibilityArchivalState != enumspb.ARCHIVAL_STATE_ENABLED {
 		return nil, errNamespaceIsNotConfiguredForVisibilityArchival
 	}
@@ -2457,7 +2551,7 @@ func (wh *WorkflowHandler) ListArchivedWorkflowExecutions(ctx context.Context, r
 	archiverRequest := &archiver.QueryVisibilityRequest{
 		NamespaceID:   entry.GetInfo().Id,
 		PageSize:      int(request.GetPageSize()),
-		NextPageToken: request.NextPageToken,
+		NextPageToken: nextPageToken,
 		Query:         request.GetQuery(),
 	}
 
@@ -2482,9 +2576,14 @@ func (wh *WorkflowHandler) ListArchivedWorkflowExecutions(ctx context.Context, r
 		}
 	}
 
+	nextPageToken, err = wh.pageTokenCodec.Encode(entry.GetInfo().Id, pageTokenAPIListArchivedWorkflowExecutions, archiverResponse.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
 	return &workflowservice.ListArchivedWorkflowExecutionsResponse{
 		Executions:    archiverResponse.Executions,
-		NextPageToken: archiverResponse.NextPageToken,
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
@@ -2526,11 +2625,16 @@ func (wh *WorkflowHandler) ScanWorkflowExecutions(ctx context.Context, request *
 		return nil, err
 	}
 
+	nextPageToken, err := wh.pageTokenCodec.Decode(namespaceID, pageTokenAPIScanWorkflowExecutions, request.GetNextPageToken())
+	if err != nil {
+		return nil, err
+	}
+
 	req := &visibility.ListWorkflowExecutionsRequestV2{
 		NamespaceID:   namespaceID,
 		Namespace:     namespace,
 		PageSize:      int(request.GetPageSize()),
-		NextPageToken: request.NextPageToken,
+		NextPageToken: nextPageToken,
 		Query:         request.GetQuery(),
 	}
 	persistenceResp, err := wh.GetVisibilityManager().ScanWorkflowExecutions(req)
@@ -2538,9 +2642,14 @@ func (wh *WorkflowHandler) ScanWorkflowExecutions(ctx context.Context, request *
 		return nil, err
 	}
 
+	nextPageToken, err = wh.pageTokenCodec.Encode(namespaceID, pageTokenAPIScanWorkflowExecutions, persistenceResp.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
 	resp := &workflowservice.ScanWorkflowExecutionsResponse{
 		Executions:    persistenceResp.Executions,
-		NextPageToken: persistenceResp.NextPageToken,
+		NextPageToken: nextPageToken,
 	}
 	return resp, nil
 }
@@ -2659,11 +2768,13 @@ func (wh *WorkflowHandler) RespondQueryTaskCompleted(
 
 	sizeLimitError := wh.config.BlobSizeLimitError(namespaceEntry.GetInfo().Name)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespaceEntry.GetInfo().Name)
+	sizeAccountingMode := wh.config.BlobSizeAccountingMode(namespaceEntry.GetInfo().Name)
 
 	if err := common.CheckEventBlobSizeLimit(
-		request.GetQueryResult().Size(),
+		request.GetQueryResult(),
 		sizeLimitWarn,
 		sizeLimitError,
+		sizeAccountingMode,
 		queryTaskToken.GetNamespaceId(),
 		"",
 		"",
@@ -2765,6 +2876,10 @@ func (wh *WorkflowHandler) QueryWorkflow(ctx context.Context, request *workflows
 		return nil, err
 	}
 
+	if wh.isWorkflowQuarantined(request.GetNamespace(), request.Execution.GetWorkflowId()) {
+		return nil, serviceerrors.NewWorkflowQuarantined(request.Execution.GetWorkflowId())
+	}
+
 	if request.Query == nil {
 		return nil, errQueryNotSet
 	}
@@ -2782,11 +2897,13 @@ func (wh *WorkflowHandler) QueryWorkflow(ctx context.Context, request *workflows
 
 	sizeLimitError := wh.config.BlobSizeLimitError(request.GetNamespace())
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(request.GetNamespace())
+	sizeAccountingMode := wh.config.BlobSizeAccountingMode(request.GetNamespace())
 
 	if err := common.CheckEventBlobSizeLimit(
-		request.GetQuery().GetQueryArgs().Size(),
+		request.GetQuery().GetQueryArgs(),
 		sizeLimitWarn,
 		sizeLimitError,
+		sizeAccountingMode,
 		namespaceID,
 		request.GetExecution().GetWorkflowId(),
 		request.GetExecution().GetRunId(),
@@ -3167,6 +3284,13 @@ func (wh *WorkflowHandler) validateExecution(w *commonpb.WorkflowExecution) erro
 	return nil
 }
 
+// isWorkflowQuarantined returns true if signals and queries to the workflow are rejected
+// because it is listed in the quarantined workflow IDs of the namespace
+func (wh *WorkflowHandler) isWorkflowQuarantined(namespace string, workflowID string) bool {
+	_, ok := wh.config.QuarantinedWorkflowIDs(namespace)[workflowID]
+	return ok
+}
+
 func (wh *WorkflowHandler) createPollWorkflowTaskQueueResponse(
 	ctx context.Context,
 	namespaceID string,
@@ -3235,6 +3359,10 @@ func (wh *WorkflowHandler) createPollWorkflowTaskQueueResponse(
 			if err != nil {
 				return nil, err
 			}
+			continuation, err = wh.pageTokenCodec.Encode(namespaceID, pageTokenAPIGetWorkflowExecutionHistory, continuation)
+			if err != nil {
+				return nil, err
+			}
 		}
 	}
 
@@ -3343,6 +3471,7 @@ func (wh *WorkflowHandler) getArchivedHistory(
 	ctx context.Context,
 	request *workflowservice.GetWorkflowExecutionHistoryRequest,
 	namespaceID string,
+	nextPageToken []byte,
 ) (*workflowservice.GetWorkflowExecutionHistoryResponse, error) {
 	entry, err := wh.GetNamespaceCache().GetNamespaceByID(namespaceID)
 	if err != nil {
@@ -3371,20 +3500,25 @@ func (wh *WorkflowHandler) getArchivedHistory(
 		NamespaceID:   namespaceID,
 		WorkflowID:    request.GetExecution().GetWorkflowId(),
 		RunID:         request.GetExecution().GetRunId(),
-		NextPageToken: request.GetNextPageToken(),
+		NextPageToken: nextPageToken,
 		PageSize:      int(request.GetMaximumPageSize()),
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	nextPageToken, err = wh.pageTokenCodec.Encode(namespaceID, pageTokenAPIGetWorkflowExecutionHistory, resp.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
 	history := &historypb.History{}
 	for _, batch := range resp.HistoryBatches {
 		history.Events = append(history.Events, batch.Events...)
 	}
 	return &workflowservice.GetWorkflowExecutionHistoryResponse{
 		History:       history,
-		NextPageToken: resp.NextPageToken,
+		NextPageToken: nextPageToken,
 		Archived:      true,
 	}, nil
 }
@@ -3470,6 +3604,75 @@ func (wh *WorkflowHandler) validateStartWorkflowTimeouts(
 	return nil
 }
 
+// validateSignalWithStartWorkflowExecutionRequest validates the request, setting the default task queue kind
+// and retry policy on it
+func (wh *WorkflowHandler) validateSignalWithStartWorkflowExecutionRequest(
+	request *workflowservice.SignalWithStartWorkflowExecutionRequest,
+) error {
+	if request == nil {
+		return errRequestNotSet
+	}
+
+	namespace := request.GetNamespace()
+	if namespace == "" {
+		return errNamespaceNotSet
+	}
+
+	if len(namespace) > wh.config.MaxIDLengthLimit() {
+		return errNamespaceTooLong
+	}
+
+	if request.GetWorkflowId() == "" {
+		return errWorkflowIDNotSet
+	}
+
+	if len(request.GetWorkflowId()) > wh.config.MaxIDLengthLimit() {
+		return errWorkflowIDTooLong
+	}
+
+	if wh.isWorkflowQuarantined(namespace, request.GetWorkflowId()) {
+		return serviceerrors.NewWorkflowQuarantined(request.GetWorkflowId())
+	}
+
+	if request.GetSignalName() == "" {
+		return errSignalNameNotSet
+	}
+
+	if len(request.GetSignalName()) > wh.config.MaxIDLengthLimit() {
+		return errSignalNameTooLong
+	}
+
+	if request.WorkflowType == nil || request.WorkflowType.GetName() == "" {
+		return errWorkflowTypeNotSet
+	}
+
+	if len(request.WorkflowType.GetName()) > wh.config.MaxIDLengthLimit() {
+		return errWorkflowTypeTooLong
+	}
+
+	if err := wh.validateTaskQueue(request.TaskQueue); err != nil {
+		return err
+	}
+
+	if len(request.GetRequestId()) > wh.config.MaxIDLengthLimit() {
+		return errRequestIDTooLong
+	}
+
+	if err := wh.validateSignalWithStartWorkflowTimeouts(request); err != nil {
+		return err
+	}
+
+	if err := wh.validateRetryPolicy(request.GetNamespace(), request.RetryPolicy); err != nil {
+		return err
+	}
+
+	if err := backoff.ValidateSchedule(request.GetCronSchedule()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (wh *WorkflowHandler) validateSignalWithStartWorkflowTimeouts(
 	request *workflowservice.SignalWithStartWorkflowExecutionRequest,
 ) error {
@@ -26,6 +26,7 @@ package frontend
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"testing"
 	"time"
@@ -39,14 +40,21 @@ import (
 	filterpb "go.temporal.io/api/filter/v1"
 	historypb "go.temporal.io/api/history/v1"
 	namespacepb "go.temporal.io/api/namespace/v1"
+	querypb "go.temporal.io/api/query/v1"
 	replicationpb "go.temporal.io/api/replication/v1"
 	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/searchattribute"
 
+	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
 	"go.temporal.io/server/api/matchingservicemock/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
@@ -56,13 +64,17 @@ import (
 	"go.temporal.io/server/common/archiver/provider"
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/config"
 	dc "go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/pagetoken"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/resource"
+	serviceerrors "go.temporal.io/server/common/serviceerror"
 )
 
 const (
@@ -97,6 +109,7 @@ type (
 		mockVisibilityArchiver *archiver.MockVisibilityArchiver
 
 		tokenSerializer common.TaskTokenSerializer
+		pageTokenCodec  pagetoken.Codec
 
 		testNamespace   string
 		testNamespaceID string
@@ -140,6 +153,7 @@ func (s *workflowHandlerSuite) SetupTest() {
 	s.mockVisibilityArchiver = archiver.NewMockVisibilityArchiver(s.controller)
 
 	s.tokenSerializer = common.NewProtoTaskTokenSerializer()
+	s.pageTokenCodec = pagetoken.NewNoopCodec()
 
 	mockMonitor := s.mockResource.MembershipMonitor
 	mockMonitor.EXPECT().GetMemberCount(common.FrontendServiceName).Return(5, nil).AnyTimes()
@@ -150,7 +164,7 @@ func (s *workflowHandlerSuite) TearDownTest() {
 }
 
 func (s *workflowHandlerSuite) getWorkflowHandler(config *Config) *WorkflowHandler {
-	return NewWorkflowHandler(s.mockResource, config, s.mockProducer).(*WorkflowHandler)
+	return NewWorkflowHandler(s.mockResource, config, s.mockProducer, s.pageTokenCodec).(*WorkflowHandler)
 }
 
 func (s *workflowHandlerSuite) TestDisableListVisibilityByFilter() {
@@ -217,6 +231,102 @@ func (s *workflowHandlerSuite) TestDisableListVisibilityByFilter() {
 	s.Equal(errNoPermission, err)
 }
 
+func (s *workflowHandlerSuite) TestListOpenWorkflowExecutions_VisibilityFallbackToPersistence() {
+	testNamespace := "test-namespace"
+	namespaceID := uuid.New()
+	config := s.newConfig()
+	config.VisibilityFallbackToPersistence = dc.GetBoolPropertyFnFilteredByNamespace(true)
+
+	wh := s.getWorkflowHandler(config)
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(testNamespace).Return(namespaceID, nil).AnyTimes()
+
+	listRequest := &workflowservice.ListOpenWorkflowExecutionsRequest{
+		Namespace: testNamespace,
+		StartTimeFilter: &filterpb.StartTimeFilter{
+			EarliestTime: timestamp.TimePtr(time.Time{}),
+			LatestTime:   timestamp.TimePtr(time.Now().UTC()),
+		},
+		Filters: &workflowservice.ListOpenWorkflowExecutionsRequest_ExecutionFilter{ExecutionFilter: &filterpb.WorkflowExecutionFilter{
+			WorkflowId: "wid",
+			RunId:      "rid",
+		}},
+	}
+	executionInfo := &workflowpb.WorkflowExecutionInfo{
+		Execution: &commonpb.WorkflowExecution{WorkflowId: "wid", RunId: "rid"},
+		StartTime: timestamp.TimePtr(time.Now().UTC().Add(-time.Hour)),
+		Status:    enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+	}
+
+	visibilityErr := serviceerror.NewInternal("ListOpenWorkflowExecutionsByWorkflowID failed")
+	s.mockVisibilityMgr.EXPECT().ListOpenWorkflowExecutionsByWorkflowID(gomock.Any()).Return(nil, visibilityErr).Times(3)
+	s.mockHistoryClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), &historyservice.DescribeWorkflowExecutionRequest{
+		NamespaceId: namespaceID,
+		Request: &workflowservice.DescribeWorkflowExecutionRequest{
+			Namespace: testNamespace,
+			Execution: &commonpb.WorkflowExecution{WorkflowId: "wid", RunId: "rid"},
+		},
+	}).Return(&historyservice.DescribeWorkflowExecutionResponse{WorkflowExecutionInfo: executionInfo}, nil)
+
+	resp, err := wh.ListOpenWorkflowExecutions(context.Background(), listRequest)
+	s.NoError(err)
+	s.Equal([]*workflowpb.WorkflowExecutionInfo{executionInfo}, resp.Executions)
+	s.Nil(resp.NextPageToken)
+
+	// closed executions are not listed
+	closedExecutionInfo := *executionInfo
+	closedExecutionInfo.Status = enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED
+	s.mockHistoryClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+		Return(&historyservice.DescribeWorkflowExecutionResponse{WorkflowExecutionInfo: &closedExecutionInfo}, nil)
+	resp, err = wh.ListOpenWorkflowExecutions(context.Background(), listRequest)
+	s.NoError(err)
+	s.Empty(resp.Executions)
+
+	// only the first page can be served from persistence
+	listRequest.NextPageToken = []byte("token")
+	_, err = wh.ListOpenWorkflowExecutions(context.Background(), listRequest)
+	s.Equal(visibilityErr, err)
+}
+
+func (s *workflowHandlerSuite) TestQuarantinedWorkflow() {
+	testNamespace := "test-namespace"
+	config := s.newConfig()
+	config.QuarantinedWorkflowIDs = dc.GetMapPropertyFnWithNamespaceFilter(map[string]interface{}{"wid": "hot signal producer"})
+
+	wh := s.getWorkflowHandler(config)
+
+	_, err := wh.SignalWorkflowExecution(context.Background(), &workflowservice.SignalWorkflowExecutionRequest{
+		Namespace:         testNamespace,
+		WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: "wid"},
+		SignalName:        "signal",
+	})
+	s.IsType(&serviceerrors.WorkflowQuarantined{}, err)
+
+	_, err = wh.SignalWithStartWorkflowExecution(context.Background(), &workflowservice.SignalWithStartWorkflowExecutionRequest{
+		Namespace:    testNamespace,
+		WorkflowId:   "wid",
+		SignalName:   "signal",
+		WorkflowType: &commonpb.WorkflowType{Name: "workflow-type"},
+		TaskQueue:    &taskqueuepb.TaskQueue{Name: "task-queue"},
+	})
+	s.IsType(&serviceerrors.WorkflowQuarantined{}, err)
+
+	_, err = wh.QueryWorkflow(context.Background(), &workflowservice.QueryWorkflowRequest{
+		Namespace: testNamespace,
+		Execution: &commonpb.WorkflowExecution{WorkflowId: "wid"},
+		Query:     &querypb.WorkflowQuery{QueryType: "query"},
+	})
+	s.IsType(&serviceerrors.WorkflowQuarantined{}, err)
+	s.Equal(codes.FailedPrecondition, serviceerror.ToStatus(err).Code())
+
+	// other workflows are not affected
+	_, err = wh.QueryWorkflow(context.Background(), &workflowservice.QueryWorkflowRequest{
+		Namespace: testNamespace,
+		Execution: &commonpb.WorkflowExecution{WorkflowId: "other-wid"},
+	})
+	s.Equal(errQueryNotSet, err)
+}
+
 func (s *workflowHandlerSuite) TestPollForTask_Failed_ContextTimeoutTooShort() {
 	config := s.newConfig()
 	wh := s.getWorkflowHandler(config)
@@ -501,6 +611,50 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_EnsureNilRetryPolicyNo
 	s.Nil(startWorkflowExecutionRequest.RetryPolicy)
 }
 
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_StartDelay() {
+	config := s.newConfig()
+	config.RPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+
+	startWorkflowExecutionRequest := &workflowservice.StartWorkflowExecutionRequest{
+		Namespace:  "test-namespace",
+		WorkflowId: "workflow-id",
+		WorkflowType: &commonpb.WorkflowType{
+			Name: "workflow-type",
+		},
+		TaskQueue: &taskqueuepb.TaskQueue{
+			Name: "task-queue",
+		},
+		WorkflowExecutionTimeout: timestamp.DurationPtr(time.Hour),
+		RequestId:                uuid.New(),
+	}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID("test-namespace").Return(uuid.New(), nil)
+	s.mockHistoryClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.StartWorkflowExecutionRequest, _ ...grpc.CallOption) (*historyservice.StartWorkflowExecutionResponse, error) {
+			s.Equal(90*time.Minute, timestamp.DurationValue(request.GetFirstWorkflowTaskBackoff()))
+			// the execution timeout starts after the start delay
+			s.True(timestamp.TimeValue(request.GetWorkflowExecutionExpirationTime()).After(time.Now().Add(2 * time.Hour)))
+			return &historyservice.StartWorkflowExecutionResponse{RunId: uuid.New()}, nil
+		})
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(headers.StartDelayHeaderName, "90m"))
+	_, err := wh.StartWorkflowExecution(ctx, startWorkflowExecutionRequest)
+	s.NoError(err)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(headers.StartDelayHeaderName, "-1m"))
+	_, err = wh.StartWorkflowExecution(ctx, startWorkflowExecutionRequest)
+	s.Equal(errInvalidStartDelay, err)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(headers.StartDelayHeaderName, "soon"))
+	_, err = wh.StartWorkflowExecution(ctx, startWorkflowExecutionRequest)
+	s.Equal(errInvalidStartDelay, err)
+
+	startWorkflowExecutionRequest.CronSchedule = "@every 1h"
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(headers.StartDelayHeaderName, "90m"))
+	_, err = wh.StartWorkflowExecution(ctx, startWorkflowExecutionRequest)
+	s.Equal(errCronAndStartDelaySet, err)
+}
+
 func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_InvalidTaskTimeout() {
 	config := s.newConfig()
 	config.RPS = dc.GetIntPropertyFn(10)
@@ -958,7 +1112,7 @@ func (s *workflowHandlerSuite) TestGetArchivedHistory_Failure_NamespaceCacheEntr
 
 	wh := s.getWorkflowHandler(s.newConfig())
 
-	resp, err := wh.getArchivedHistory(context.Background(), getHistoryRequest(nil), s.testNamespaceID)
+	resp, err := wh.getArchivedHistory(context.Background(), getHistoryRequest(nil), s.testNamespaceID, nil)
 	s.Nil(resp)
 	s.Error(err)
 }
@@ -978,7 +1132,7 @@ func (s *workflowHandlerSuite) TestGetArchivedHistory_Failure_ArchivalURIEmpty()
 
 	wh := s.getWorkflowHandler(s.newConfig())
 
-	resp, err := wh.getArchivedHistory(context.Background(), getHistoryRequest(nil), s.testNamespaceID)
+	resp, err := wh.getArchivedHistory(context.Background(), getHistoryRequest(nil), s.testNamespaceID, nil)
 	s.Nil(resp)
 	s.Error(err)
 }
@@ -998,7 +1152,7 @@ func (s *workflowHandlerSuite) TestGetArchivedHistory_Failure_InvalidURI() {
 
 	wh := s.getWorkflowHandler(s.newConfig())
 
-	resp, err := wh.getArchivedHistory(context.Background(), getHistoryRequest(nil), s.testNamespaceID)
+	resp, err := wh.getArchivedHistory(context.Background(), getHistoryRequest(nil), s.testNamespaceID, nil)
 	s.Nil(resp)
 	s.Error(err)
 }
@@ -1041,7 +1195,7 @@ func (s *workflowHandlerSuite) TestGetArchivedHistory_Success_GetFirstPage() {
 
 	wh := s.getWorkflowHandler(s.newConfig())
 
-	resp, err := wh.getArchivedHistory(context.Background(), getHistoryRequest(nil), s.testNamespaceID)
+	resp, err := wh.getArchivedHistory(context.Background(), getHistoryRequest(nil), s.testNamespaceID, nil)
 	s.NoError(err)
 	s.NotNil(resp)
 	s.NotNil(resp.History)
@@ -1243,6 +1397,64 @@ func (s *workflowHandlerSuite) TestListWorkflowExecutions() {
 	s.NotNil(err)
 }
 
+func (s *workflowHandlerSuite) TestListWorkflowExecutions_SignedPageToken() {
+	var err error
+	s.pageTokenCodec, err = pagetoken.NewCodec(config.PageToken{SigningKey: base64.StdEncoding.EncodeToString([]byte("test-signing-key"))})
+	s.NoError(err)
+	cfg := s.newConfig()
+	wh := s.getWorkflowHandler(cfg)
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.testNamespace).Return(s.testNamespaceID, nil).AnyTimes()
+	s.mockNamespaceCache.EXPECT().GetNamespaceID("other-namespace").Return(uuid.New(), nil).AnyTimes()
+	s.mockSearchAttributesProvider.EXPECT().GetSearchAttributes(gomock.Any(), false).Return(searchattribute.TestNameTypeMap, nil).AnyTimes()
+	s.mockVisibilityMgr.EXPECT().ListWorkflowExecutions(gomock.Any()).DoAndReturn(
+		func(request *visibility.ListWorkflowExecutionsRequestV2) (*visibility.ListWorkflowExecutionsResponse, error) {
+			if request.NextPageToken == nil {
+				return &visibility.ListWorkflowExecutionsResponse{NextPageToken: []byte(`{"ScrollID":"first"}`)}, nil
+			}
+			s.Equal([]byte(`{"ScrollID":"first"}`), request.NextPageToken)
+			return &visibility.ListWorkflowExecutionsResponse{}, nil
+		}).Times(2)
+
+	listRequest := &workflowservice.ListWorkflowExecutionsRequest{
+		Namespace: s.testNamespace,
+		PageSize:  int32(cfg.ESIndexMaxResultWindow()),
+		Query:     "WorkflowId = 'wid'",
+	}
+	ctx := context.Background()
+
+	resp, err := wh.ListWorkflowExecutions(ctx, listRequest)
+	s.NoError(err)
+	s.NotEqual([]byte(`{"ScrollID":"first"}`), resp.NextPageToken)
+
+	firstPageToken := resp.NextPageToken
+
+	// the token is bound to the namespace and API which issued it
+	_, err = wh.ListWorkflowExecutions(ctx, &workflowservice.ListWorkflowExecutionsRequest{
+		Namespace:     "other-namespace",
+		PageSize:      listRequest.PageSize,
+		Query:         listRequest.Query,
+		NextPageToken: firstPageToken,
+	})
+	s.Equal(pagetoken.ErrInvalidPageToken, err)
+	_, err = wh.ScanWorkflowExecutions(ctx, &workflowservice.ScanWorkflowExecutionsRequest{
+		Namespace:     s.testNamespace,
+		PageSize:      listRequest.PageSize,
+		Query:         listRequest.Query,
+		NextPageToken: firstPageToken,
+	})
+	s.Equal(pagetoken.ErrInvalidPageToken, err)
+
+	listRequest.NextPageToken = firstPageToken
+	resp, err = wh.ListWorkflowExecutions(ctx, listRequest)
+	s.NoError(err)
+	s.Empty(resp.NextPageToken)
+
+	listRequest.NextPageToken = []byte(`{"ScrollID":"forged"}`)
+	_, err = wh.ListWorkflowExecutions(ctx, listRequest)
+	s.Equal(pagetoken.ErrInvalidPageToken, err)
+}
+
 func (s *workflowHandlerSuite) TestScanWorkflowExecutions() {
 	config := s.newConfig()
 	wh := s.getWorkflowHandler(config)
@@ -29,6 +29,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/pborman/uuid"
 	commandpb "go.temporal.io/api/command/v1"
 	commonpb "go.temporal.io/api/common/v1"
@@ -63,8 +64,9 @@ type (
 	}
 
 	workflowSizeChecker struct {
-		blobSizeLimitWarn  int
-		blobSizeLimitError int
+		blobSizeLimitWarn      int
+		blobSizeLimitError     int
+		blobSizeAccountingMode string
 
 		memoSizeLimitWarn  int
 		memoSizeLimitError int
@@ -75,6 +77,9 @@ type (
 		historyCountLimitWarn  int
 		historyCountLimitError int
 
+		mutableStateSizeLimitWarn  int
+		mutableStateSizeLimitError int
+
 		completedID               int64
 		mutableState              workflow.MutableState
 		searchAttributesValidator *searchattribute.Validator
@@ -107,12 +112,15 @@ func newCommandAttrValidator(
 func newWorkflowSizeChecker(
 	blobSizeLimitWarn int,
 	blobSizeLimitError int,
+	blobSizeAccountingMode string,
 	memoSizeLimitWarn int,
 	memoSizeLimitError int,
 	historySizeLimitWarn int,
 	historySizeLimitError int,
 	historyCountLimitWarn int,
 	historyCountLimitError int,
+	mutableStateSizeLimitWarn int,
+	mutableStateSizeLimitError int,
 	completedID int64,
 	mutableState workflow.MutableState,
 	searchAttributesValidator *searchattribute.Validator,
@@ -121,35 +129,94 @@ func newWorkflowSizeChecker(
 	logger log.Logger,
 ) *workflowSizeChecker {
 	return &workflowSizeChecker{
-		blobSizeLimitWarn:         blobSizeLimitWarn,
-		blobSizeLimitError:        blobSizeLimitError,
-		memoSizeLimitWarn:         memoSizeLimitWarn,
-		memoSizeLimitError:        memoSizeLimitError,
-		historySizeLimitWarn:      historySizeLimitWarn,
-		historySizeLimitError:     historySizeLimitError,
-		historyCountLimitWarn:     historyCountLimitWarn,
-		historyCountLimitError:    historyCountLimitError,
-		completedID:               completedID,
-		mutableState:              mutableState,
-		searchAttributesValidator: searchAttributesValidator,
-		executionStats:            executionStats,
-		metricsScope:              metricsScope,
-		logger:                    logger,
+		blobSizeLimitWarn:          blobSizeLimitWarn,
+		blobSizeLimitError:         blobSizeLimitError,
+		blobSizeAccountingMode:     blobSizeAccountingMode,
+		memoSizeLimitWarn:          memoSizeLimitWarn,
+		memoSizeLimitError:         memoSizeLimitError,
+		historySizeLimitWarn:       historySizeLimitWarn,
+		historySizeLimitError:      historySizeLimitError,
+		historyCountLimitWarn:      historyCountLimitWarn,
+		historyCountLimitError:     historyCountLimitError,
+		mutableStateSizeLimitWarn:  mutableStateSizeLimitWarn,
+		mutableStateSizeLimitError: mutableStateSizeLimitError,
+		completedID:                completedID,
+		mutableState:               mutableState,
+		searchAttributesValidator:  searchAttributesValidator,
+		executionStats:             executionStats,
+		metricsScope:               metricsScope,
+		logger:                     logger,
+	}
+}
+
+// checkWorkflowSizeLimits rejects a command which would grow the workflow once its mutable state
+// has outgrown the error limit, or when the events of the command would push the history past the error limit.
+// Rejected commands fail the workflow task, the workflow can still complete or continue as new.
+func (c *workflowSizeChecker) checkWorkflowSizeLimits(
+	commandTypeTag metrics.Tag,
+	commandSize int,
+) error {
+
+	executionInfo := c.mutableState.GetExecutionInfo()
+	executionState := c.mutableState.GetExecutionState()
+	metricsScope := c.metricsScope.Tagged(commandTypeTag)
+
+	mutableStateSize := c.mutableState.GetApproximatePersistedSize()
+	if mutableStateSize > c.mutableStateSizeLimitError {
+		c.logger.Warn("Mutable state size exceeds error limit, failing command.",
+			tag.WorkflowNamespaceID(executionInfo.NamespaceId),
+			tag.WorkflowID(executionInfo.WorkflowId),
+			tag.WorkflowRunID(executionState.RunId),
+			tag.WorkflowMutableStateSize(mutableStateSize),
+		)
+		metricsScope.IncCounter(metrics.MutableStateSizeLimitExceededCount)
+		return serviceerror.NewInvalidArgument(fmt.Sprintf(
+			"workflow mutable state size %d exceeds limit %d, let pending activities, timers, child workflows and signals finish or continue as new",
+			mutableStateSize,
+			c.mutableStateSizeLimitError,
+		))
+	}
+	if mutableStateSize > c.mutableStateSizeLimitWarn {
+		c.logger.Warn("Mutable state size exceeds warn limit.",
+			tag.WorkflowNamespaceID(executionInfo.NamespaceId),
+			tag.WorkflowID(executionInfo.WorkflowId),
+			tag.WorkflowRunID(executionState.RunId),
+			tag.WorkflowMutableStateSize(mutableStateSize),
+		)
+		metricsScope.IncCounter(metrics.MutableStateSizeLimitWarnCount)
+	}
+
+	historySize := int(c.executionStats.GetHistorySize())
+	if historySize+commandSize > c.historySizeLimitError {
+		c.logger.Warn("History size would exceed error limit, failing command.",
+			tag.WorkflowNamespaceID(executionInfo.NamespaceId),
+			tag.WorkflowID(executionInfo.WorkflowId),
+			tag.WorkflowRunID(executionState.RunId),
+			tag.WorkflowHistorySize(historySize),
+		)
+		metricsScope.IncCounter(metrics.HistorySizeLimitExceededCount)
+		return serviceerror.NewInvalidArgument(fmt.Sprintf(
+			"workflow history size %d would exceed limit %d, continue as new to start with an empty history",
+			historySize,
+			c.historySizeLimitError,
+		))
 	}
+	return nil
 }
 
 func (c *workflowSizeChecker) failWorkflowIfPayloadSizeExceedsLimit(
 	commandTypeTag metrics.Tag,
-	payloadSize int,
+	payload proto.Sizer,
 	message string,
 ) (bool, error) {
 
 	executionInfo := c.mutableState.GetExecutionInfo()
 	executionState := c.mutableState.GetExecutionState()
 	err := common.CheckEventBlobSizeLimit(
-		payloadSize,
+		payload,
 		c.blobSizeLimitWarn,
 		c.blobSizeLimitError,
+		c.blobSizeAccountingMode,
 		executionInfo.NamespaceId,
 		executionInfo.WorkflowId,
 		executionState.RunId,
@@ -174,16 +241,17 @@ func (c *workflowSizeChecker) failWorkflowIfPayloadSizeExceedsLimit(
 
 func (c *workflowSizeChecker) failWorkflowIfMemoSizeExceedsLimit(
 	commandTypeTag metrics.Tag,
-	memoSize int,
+	memo proto.Sizer,
 	message string,
 ) (bool, error) {
 
 	executionInfo := c.mutableState.GetExecutionInfo()
 	executionState := c.mutableState.GetExecutionState()
 	err := common.CheckEventBlobSizeLimit(
-		memoSize,
+		memo,
 		c.memoSizeLimitWarn,
 		c.memoSizeLimitError,
+		c.blobSizeAccountingMode,
 		executionInfo.NamespaceId,
 		executionInfo.WorkflowId,
 		executionState.RunId,
@@ -32,6 +32,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
 	commandpb "go.temporal.io/api/command/v1"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
@@ -44,11 +45,13 @@ import (
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/tests"
+	"go.temporal.io/server/service/history/workflow"
 )
 
 type (
@@ -657,3 +660,63 @@ func (s *commandAttrValidatorSuite) TestValidateActivityRetryPolicy() {
 		})
 	}
 }
+
+func TestWorkflowSizeChecker_CheckWorkflowSizeLimits(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	mutableState := workflow.NewMockMutableState(controller)
+	mutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{
+		NamespaceId: tests.NamespaceID,
+		WorkflowId:  tests.WorkflowID,
+	}).AnyTimes()
+	mutableState.EXPECT().GetExecutionState().Return(&persistencespb.WorkflowExecutionState{
+		RunId: tests.RunID,
+	}).AnyTimes()
+
+	testScope := tally.NewTestScope("test", nil)
+	newChecker := func(mutableStateSize int, historySize int64) *workflowSizeChecker {
+		mutableState.EXPECT().GetApproximatePersistedSize().Return(mutableStateSize)
+		return newWorkflowSizeChecker(
+			0, 0, "",
+			0, 0,
+			500, 1000,
+			0, 0,
+			100, 200,
+			4,
+			mutableState,
+			nil,
+			&persistencespb.ExecutionStats{HistorySize: historySize},
+			metrics.NewClient(testScope, metrics.History).Scope(metrics.HistoryRespondWorkflowTaskCompletedScope),
+			log.NewNoopLogger(),
+		)
+	}
+	commandTypeTag := metrics.CommandTypeTag(enumspb.COMMAND_TYPE_SCHEDULE_ACTIVITY_TASK.String())
+
+	require.NoError(t, newChecker(100, 900).checkWorkflowSizeLimits(commandTypeTag, 100))
+
+	err := newChecker(201, 0).checkWorkflowSizeLimits(commandTypeTag, 10)
+	require.IsType(t, &serviceerror.InvalidArgument{}, err)
+	require.Contains(t, err.Error(), "mutable state size 201 exceeds limit 200")
+
+	err = newChecker(100, 900).checkWorkflowSizeLimits(commandTypeTag, 101)
+	require.IsType(t, &serviceerror.InvalidArgument{}, err)
+	require.Contains(t, err.Error(), "history size 900 would exceed limit 1000")
+
+	// a mutable state above the warn limit is only reported
+	require.NoError(t, newChecker(150, 0).checkWorkflowSizeLimits(commandTypeTag, 10))
+
+	counters := testScope.Snapshot().Counters()
+	counterValue := func(name string) int64 {
+		var total int64
+		for _, counter := range counters {
+			if counter.Name() == "test."+name {
+				total += counter.Value()
+			}
+		}
+		return total
+	}
+	require.Equal(t, int64(1), counterValue("mutable_state_size_limit_exceeded"))
+	require.Equal(t, int64(1), counterValue("mutable_state_size_limit_warn"))
+	require.Equal(t, int64(1), counterValue("history_size_limit_exceeded"))
+}
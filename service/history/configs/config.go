@@ -31,6 +31,7 @@ import (
 
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/task"
 )
 
@@ -43,6 +44,9 @@ type Config struct {
 	EnableDBRecordVersion dynamicconfig.BoolPropertyFn
 
 	RPS                           dynamicconfig.IntPropertyFn
+	AdaptiveConcurrencyEnabled    dynamicconfig.BoolPropertyFn
+	AdaptiveConcurrencyMinLimit   dynamicconfig.IntPropertyFn
+	AdaptiveConcurrencyMaxLimit   dynamicconfig.IntPropertyFn
 	MaxIDLengthLimit              dynamicconfig.IntPropertyFn
 	PersistenceMaxQPS             dynamicconfig.IntPropertyFn
 	PersistenceGlobalMaxQPS       dynamicconfig.IntPropertyFn
@@ -50,10 +54,14 @@ type Config struct {
 	VisibilityOpenMaxQPS          dynamicconfig.IntPropertyFnWithNamespaceFilter
 	VisibilityClosedMaxQPS        dynamicconfig.IntPropertyFnWithNamespaceFilter
 	AdvancedVisibilityWritingMode dynamicconfig.StringPropertyFn
+	EnableVisibilityExport        dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	VisibilitySinks               dynamicconfig.StringPropertyFnWithNamespaceFilter
+	VisibilityBestEffortSinks     dynamicconfig.StringPropertyFnWithNamespaceFilter
 	EmitShardDiffLog              dynamicconfig.BoolPropertyFn
 	MaxAutoResetPoints            dynamicconfig.IntPropertyFnWithNamespaceFilter
 	ThrottledLogRPS               dynamicconfig.IntPropertyFn
 	EnableStickyQuery             dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	StickyQueryDispatchDeadline   dynamicconfig.DurationPropertyFnWithNamespaceFilter
 	ShutdownDrainDuration         dynamicconfig.DurationPropertyFn
 
 	// HistoryCache settings
@@ -62,6 +70,9 @@ type Config struct {
 	HistoryCacheMaxSize     dynamicconfig.IntPropertyFn
 	HistoryCacheTTL         dynamicconfig.DurationPropertyFn
 
+	// HistoryCacheLoadDeduplication deduplicates concurrent reads of the current run of the same workflow
+	HistoryCacheLoadDeduplication dynamicconfig.BoolPropertyFn
+
 	// EventsCache settings
 	// Change of these configs require shard restart
 	EventsCacheInitialSize dynamicconfig.IntPropertyFn
@@ -69,9 +80,11 @@ type Config struct {
 	EventsCacheTTL         dynamicconfig.DurationPropertyFn
 
 	// ShardController settings
-	RangeSizeBits           uint
-	AcquireShardInterval    dynamicconfig.DurationPropertyFn
-	AcquireShardConcurrency dynamicconfig.IntPropertyFn
+	RangeSizeBits               uint
+	AcquireShardInterval        dynamicconfig.DurationPropertyFn
+	AcquireShardConcurrency     dynamicconfig.IntPropertyFn
+	ShardRejoinGracePeriod      dynamicconfig.DurationPropertyFn
+	ShardHibernationIdleTimeout dynamicconfig.DurationPropertyFn
 
 	// the artificial delay added to standby cluster's view of active cluster's time
 	StandbyClusterDelay                  dynamicconfig.DurationPropertyFn
@@ -80,14 +93,21 @@ type Config struct {
 
 	// Task process settings
 	TaskProcessRPS                 dynamicconfig.IntPropertyFnWithNamespaceFilter
+	TaskNamespaceTier              dynamicconfig.StringPropertyFnWithNamespaceFilter
 	EnablePriorityTaskProcessor    dynamicconfig.BoolPropertyFn
 	TaskSchedulerType              dynamicconfig.IntPropertyFn
 	TaskSchedulerWorkerCount       dynamicconfig.IntPropertyFn
 	TaskSchedulerQueueSize         dynamicconfig.IntPropertyFn
 	TaskSchedulerRoundRobinWeights dynamicconfig.MapPropertyFn
+	NamespaceIsolationGroup        dynamicconfig.StringPropertyFnWithNamespaceFilter
+	IsolationGroupBudget           dynamicconfig.MapPropertyFn
+
+	// EnableTaskWorkerPoolAutoscaling enables autoscaling of the timer, transfer and visibility processor worker pools
+	EnableTaskWorkerPoolAutoscaling dynamicconfig.BoolPropertyFn
 
 	// TimerQueueProcessor settings
 	TimerTaskBatchSize                                dynamicconfig.IntPropertyFn
+	TimerTaskMinWorkerCount                           dynamicconfig.IntPropertyFn
 	TimerTaskWorkerCount                              dynamicconfig.IntPropertyFn
 	TimerTaskMaxRetryCount                            dynamicconfig.IntPropertyFn
 	TimerProcessorCompleteTimerFailureRetryCount      dynamicconfig.IntPropertyFn
@@ -108,6 +128,7 @@ type Config struct {
 
 	// TransferQueueProcessor settings
 	TransferTaskBatchSize                                dynamicconfig.IntPropertyFn
+	TransferTaskMinWorkerCount                           dynamicconfig.IntPropertyFn
 	TransferTaskWorkerCount                              dynamicconfig.IntPropertyFn
 	TransferTaskMaxRetryCount                            dynamicconfig.IntPropertyFn
 	TransferProcessorCompleteTransferFailureRetryCount   dynamicconfig.IntPropertyFn
@@ -143,6 +164,10 @@ type Config struct {
 	MaximumBufferedEventsBatch dynamicconfig.IntPropertyFn
 	MaximumSignalsPerExecution dynamicconfig.IntPropertyFnWithNamespaceFilter
 
+	// HistoryCompaction settings
+	HistoryCompactionMaxBatchEventCount dynamicconfig.IntPropertyFnWithNamespaceFilter
+	HistoryCompactionMaxBatchSize       dynamicconfig.IntPropertyFnWithNamespaceFilter
+
 	// ShardUpdateMinInterval the minimal time interval which the shard info can be updated
 	ShardUpdateMinInterval dynamicconfig.DurationPropertyFn
 	// ShardSyncMinInterval the minimal time interval which the shard info should be sync to remote
@@ -165,6 +190,10 @@ type Config struct {
 	// total number of parentClosePolicy system workflows
 	NumParentClosePolicySystemWorkflows dynamicconfig.IntPropertyFn
 
+	// Completion callback settings
+	CallbackAllowedHosts       dynamicconfig.StringPropertyFnWithNamespaceFilter
+	NumCallbackSystemWorkflows dynamicconfig.IntPropertyFn
+
 	// Archival settings
 	NumArchiveSystemWorkflows dynamicconfig.IntPropertyFn
 	ArchiveRequestRPS         dynamicconfig.IntPropertyFn
@@ -172,6 +201,7 @@ type Config struct {
 	// Size limit related settings
 	BlobSizeLimitError     dynamicconfig.IntPropertyFnWithNamespaceFilter
 	BlobSizeLimitWarn      dynamicconfig.IntPropertyFnWithNamespaceFilter
+	BlobSizeAccountingMode dynamicconfig.StringPropertyFnWithNamespaceFilter
 	MemoSizeLimitError     dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MemoSizeLimitWarn      dynamicconfig.IntPropertyFnWithNamespaceFilter
 	HistorySizeLimitError  dynamicconfig.IntPropertyFnWithNamespaceFilter
@@ -179,6 +209,21 @@ type Config struct {
 	HistoryCountLimitError dynamicconfig.IntPropertyFnWithNamespaceFilter
 	HistoryCountLimitWarn  dynamicconfig.IntPropertyFnWithNamespaceFilter
 
+	MutableStateSizeLimitError dynamicconfig.IntPropertyFnWithNamespaceFilter
+	MutableStateSizeLimitWarn  dynamicconfig.IntPropertyFnWithNamespaceFilter
+
+	StateTransitionCountLimitError dynamicconfig.IntPropertyFnWithNamespaceFilter
+	StateTransitionCountLimitWarn  dynamicconfig.IntPropertyFnWithNamespaceFilter
+
+	NumPendingActivitiesLimitError      dynamicconfig.IntPropertyFnWithNamespaceFilter
+	NumPendingChildExecutionsLimitError dynamicconfig.IntPropertyFnWithNamespaceFilter
+	NumPendingSignalsLimitError         dynamicconfig.IntPropertyFnWithNamespaceFilter
+
+	// OpenWorkflowCountLimit caps the number of concurrently open workflow executions of a namespace,
+	// the count is read from visibility and cached for OpenWorkflowCountRefreshInterval
+	OpenWorkflowCountLimit           dynamicconfig.IntPropertyFnWithNamespaceFilter
+	OpenWorkflowCountRefreshInterval dynamicconfig.DurationPropertyFn
+
 	// DefaultActivityRetryOptions specifies the out-of-box retry policy if
 	// none is configured on the Activity by the user.
 	DefaultActivityRetryPolicy dynamicconfig.MapPropertyFnWithNamespaceFilter
@@ -212,6 +257,8 @@ type Config struct {
 	ReplicationTaskProcessorCleanupJitterCoefficient     dynamicconfig.FloatPropertyFnWithShardIDFilter
 	ReplicationTaskProcessorHostQPS                      dynamicconfig.FloatPropertyFn
 	ReplicationTaskProcessorShardQPS                     dynamicconfig.FloatPropertyFn
+	ReplicationTaskProcessorEnableStreaming              dynamicconfig.BoolPropertyFn
+	ReplicationStreamHeartbeatInterval                   dynamicconfig.DurationPropertyFn
 
 	// The following are used by consistent query
 	MaxBufferedQueryCount dynamicconfig.IntPropertyFn
@@ -220,6 +267,10 @@ type Config struct {
 	MutableStateChecksumGenProbability    dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MutableStateChecksumVerifyProbability dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MutableStateChecksumInvalidateBefore  dynamicconfig.FloatPropertyFn
+	MutableStateIntegrityCheckEnabled     dynamicconfig.BoolPropertyFnWithNamespaceFilter
+
+	// EnableWorkflowAuditLog enables the workflow audit stream, the structured log records of workflow lifecycle transitions
+	EnableWorkflowAuditLog dynamicconfig.BoolPropertyFnWithNamespaceFilter
 
 	// Crocess DC Replication configuration
 	ReplicationEventsFromCurrentCluster    dynamicconfig.BoolPropertyFnWithNamespaceFilter
@@ -231,6 +282,7 @@ type Config struct {
 	// ===== Visibility related =====
 	// VisibilityQueueProcessor settings
 	VisibilityTaskBatchSize                                dynamicconfig.IntPropertyFn
+	VisibilityTaskMinWorkerCount                           dynamicconfig.IntPropertyFn
 	VisibilityTaskWorkerCount                              dynamicconfig.IntPropertyFn
 	VisibilityTaskMaxRetryCount                            dynamicconfig.IntPropertyFn
 	VisibilityProcessorCompleteTaskFailureRetryCount       dynamicconfig.IntPropertyFn
@@ -257,6 +309,8 @@ type Config struct {
 	ESProcessorBulkSize               dynamicconfig.IntPropertyFn // max total size of bytes in bulk
 	ESProcessorFlushInterval          dynamicconfig.DurationPropertyFn
 	ESProcessorAckTimeout             dynamicconfig.DurationPropertyFn
+	ESProcessorBackfillWorkers        dynamicconfig.IntPropertyFn
+	ESProcessorShutdownDrainTimeout   dynamicconfig.DurationPropertyFn
 
 	EnableCrossNamespaceCommands dynamicconfig.BoolPropertyFn
 }
@@ -275,6 +329,9 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int32, isAdvancedVis
 		EnableDBRecordVersion: dc.GetBoolProperty(dynamicconfig.EnableDBRecordVersion, true),
 
 		RPS:                                  dc.GetIntProperty(dynamicconfig.HistoryRPS, 3000),
+		AdaptiveConcurrencyEnabled:           dc.GetBoolProperty(dynamicconfig.HistoryAdaptiveConcurrencyEnabled, false),
+		AdaptiveConcurrencyMinLimit:          dc.GetIntProperty(dynamicconfig.HistoryAdaptiveConcurrencyMinLimit, 20),
+		AdaptiveConcurrencyMaxLimit:          dc.GetIntProperty(dynamicconfig.HistoryAdaptiveConcurrencyMaxLimit, 1000),
 		MaxIDLengthLimit:                     dc.GetIntProperty(dynamicconfig.MaxIDLengthLimit, 1000),
 		PersistenceMaxQPS:                    dc.GetIntProperty(dynamicconfig.HistoryPersistenceMaxQPS, 9000),
 		PersistenceGlobalMaxQPS:              dc.GetIntProperty(dynamicconfig.HistoryPersistenceGlobalMaxQPS, 0),
@@ -285,29 +342,41 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int32, isAdvancedVis
 		MaxAutoResetPoints:                   dc.GetIntPropertyFilteredByNamespace(dynamicconfig.HistoryMaxAutoResetPoints, DefaultHistoryMaxAutoResetPoints),
 		DefaultWorkflowTaskTimeout:           dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.DefaultWorkflowTaskTimeout, common.DefaultWorkflowTaskTimeout),
 		AdvancedVisibilityWritingMode:        dc.GetStringProperty(dynamicconfig.AdvancedVisibilityWritingMode, common.GetDefaultAdvancedVisibilityWritingMode(isAdvancedVisConfigExist)),
+		EnableVisibilityExport:               dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnableVisibilityExport, true),
+		VisibilitySinks:                      dc.GetStringPropertyFnWithNamespaceFilter(dynamicconfig.VisibilitySinks, ""),
+		VisibilityBestEffortSinks:            dc.GetStringPropertyFnWithNamespaceFilter(dynamicconfig.VisibilityBestEffortSinks, visibility.SinkKafka),
 		EmitShardDiffLog:                     dc.GetBoolProperty(dynamicconfig.EmitShardDiffLog, false),
 		HistoryCacheInitialSize:              dc.GetIntProperty(dynamicconfig.HistoryCacheInitialSize, 128),
 		HistoryCacheMaxSize:                  dc.GetIntProperty(dynamicconfig.HistoryCacheMaxSize, 512),
 		HistoryCacheTTL:                      dc.GetDurationProperty(dynamicconfig.HistoryCacheTTL, time.Hour),
+		HistoryCacheLoadDeduplication:        dc.GetBoolProperty(dynamicconfig.HistoryCacheLoadDeduplication, true),
 		EventsCacheInitialSize:               dc.GetIntProperty(dynamicconfig.EventsCacheInitialSize, 128),
 		EventsCacheMaxSize:                   dc.GetIntProperty(dynamicconfig.EventsCacheMaxSize, 512),
 		EventsCacheTTL:                       dc.GetDurationProperty(dynamicconfig.EventsCacheTTL, time.Hour),
 		RangeSizeBits:                        20, // 20 bits for sequencer, 2^20 sequence number for any range
 		AcquireShardInterval:                 dc.GetDurationProperty(dynamicconfig.AcquireShardInterval, time.Minute),
 		AcquireShardConcurrency:              dc.GetIntProperty(dynamicconfig.AcquireShardConcurrency, 10),
+		ShardRejoinGracePeriod:               dc.GetDurationProperty(dynamicconfig.ShardRejoinGracePeriod, 0),
+		ShardHibernationIdleTimeout:          dc.GetDurationProperty(dynamicconfig.ShardHibernationIdleTimeout, 0),
 		StandbyClusterDelay:                  dc.GetDurationProperty(dynamicconfig.StandbyClusterDelay, 5*time.Minute),
 		StandbyTaskMissingEventsResendDelay:  dc.GetDurationProperty(dynamicconfig.StandbyTaskMissingEventsResendDelay, 10*time.Minute),
 		StandbyTaskMissingEventsDiscardDelay: dc.GetDurationProperty(dynamicconfig.StandbyTaskMissingEventsDiscardDelay, 15*time.Minute),
 
-		TaskProcessRPS: dc.GetIntPropertyFilteredByNamespace(dynamicconfig.TaskProcessRPS, 1000),
+		TaskProcessRPS:    dc.GetIntPropertyFilteredByNamespace(dynamicconfig.TaskProcessRPS, 1000),
+		TaskNamespaceTier: dc.GetStringPropertyFnWithNamespaceFilter(dynamicconfig.TaskNamespaceTier, TaskNamespaceTierDefault),
 
 		EnablePriorityTaskProcessor:    dc.GetBoolProperty(dynamicconfig.EnablePriorityTaskProcessor, false),
 		TaskSchedulerType:              dc.GetIntProperty(dynamicconfig.TaskSchedulerType, int(task.SchedulerTypeWRR)),
 		TaskSchedulerWorkerCount:       dc.GetIntProperty(dynamicconfig.TaskSchedulerWorkerCount, 20),
 		TaskSchedulerQueueSize:         dc.GetIntProperty(dynamicconfig.TaskSchedulerQueueSize, 2000),
-		TaskSchedulerRoundRobinWeights: dc.GetMapProperty(dynamicconfig.TaskSchedulerRoundRobinWeights, ConvertWeightsToDynamicConfigValue(DefaultTaskPriorityWeight)),
+		TaskSchedulerRoundRobinWeights: WithTaskNamespaceTierWeights(dc.GetMapProperty(dynamicconfig.TaskSchedulerRoundRobinWeights, ConvertWeightsToDynamicConfigValue(DefaultTaskPriorityWeight))),
+		NamespaceIsolationGroup:        dc.GetStringPropertyFnWithNamespaceFilter(dynamicconfig.NamespaceIsolationGroup, ""),
+		IsolationGroupBudget:           dc.GetMapProperty(dynamicconfig.HistoryIsolationGroupBudget, map[string]interface{}{}),
+
+		EnableTaskWorkerPoolAutoscaling: dc.GetBoolProperty(dynamicconfig.EnableTaskWorkerPoolAutoscaling, false),
 
 		TimerTaskBatchSize:                                dc.GetIntProperty(dynamicconfig.TimerTaskBatchSize, 100),
+		TimerTaskMinWorkerCount:                           dc.GetIntProperty(dynamicconfig.TimerTaskMinWorkerCount, 5),
 		TimerTaskWorkerCount:                              dc.GetIntProperty(dynamicconfig.TimerTaskWorkerCount, 10),
 		TimerTaskMaxRetryCount:                            dc.GetIntProperty(dynamicconfig.TimerTaskMaxRetryCount, 100),
 		TimerProcessorCompleteTimerFailureRetryCount:      dc.GetIntProperty(dynamicconfig.TimerProcessorCompleteTimerFailureRetryCount, 10),
@@ -329,6 +398,7 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int32, isAdvancedVis
 		TransferTaskBatchSize:                                dc.GetIntProperty(dynamicconfig.TransferTaskBatchSize, 100),
 		TransferProcessorFailoverMaxPollRPS:                  dc.GetIntProperty(dynamicconfig.TransferProcessorFailoverMaxPollRPS, 1),
 		TransferProcessorMaxPollRPS:                          dc.GetIntProperty(dynamicconfig.TransferProcessorMaxPollRPS, 20),
+		TransferTaskMinWorkerCount:                           dc.GetIntProperty(dynamicconfig.TransferTaskMinWorkerCount, 5),
 		TransferTaskWorkerCount:                              dc.GetIntProperty(dynamicconfig.TransferTaskWorkerCount, 10),
 		TransferTaskMaxRetryCount:                            dc.GetIntProperty(dynamicconfig.TransferTaskMaxRetryCount, 100),
 		TransferProcessorCompleteTransferFailureRetryCount:   dc.GetIntProperty(dynamicconfig.TransferProcessorCompleteTransferFailureRetryCount, 10),
@@ -365,6 +435,9 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int32, isAdvancedVis
 		ShardSyncMinInterval:            dc.GetDurationProperty(dynamicconfig.ShardSyncMinInterval, 5*time.Minute),
 		ShardSyncTimerJitterCoefficient: dc.GetFloat64Property(dynamicconfig.TransferProcessorMaxPollIntervalJitterCoefficient, 0.15),
 
+		HistoryCompactionMaxBatchEventCount: dc.GetIntPropertyFilteredByNamespace(dynamicconfig.HistoryCompactionMaxBatchEventCount, 1000),
+		HistoryCompactionMaxBatchSize:       dc.GetIntPropertyFilteredByNamespace(dynamicconfig.HistoryCompactionMaxBatchSize, 512*1024),
+
 		// history client: client/history/client.go set the client timeout 30s
 		// TODO: Return this value to the client: go.temporal.io/server/issues/294
 		LongPollExpirationInterval:          dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.HistoryLongPollExpirationInterval, time.Second*20),
@@ -374,11 +447,15 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int32, isAdvancedVis
 		EnableParentClosePolicyWorker:       dc.GetBoolProperty(dynamicconfig.EnableParentClosePolicyWorker, true),
 		ParentClosePolicyThreshold:          dc.GetIntPropertyFilteredByNamespace(dynamicconfig.ParentClosePolicyThreshold, 10),
 
+		CallbackAllowedHosts:       dc.GetStringPropertyFnWithNamespaceFilter(dynamicconfig.CallbackAllowedHosts, ""),
+		NumCallbackSystemWorkflows: dc.GetIntProperty(dynamicconfig.NumCallbackSystemWorkflows, 10),
+
 		NumArchiveSystemWorkflows: dc.GetIntProperty(dynamicconfig.NumArchiveSystemWorkflows, 1000),
 		ArchiveRequestRPS:         dc.GetIntProperty(dynamicconfig.ArchiveRequestRPS, 300), // should be much smaller than frontend RPS
 
 		BlobSizeLimitError:     dc.GetIntPropertyFilteredByNamespace(dynamicconfig.BlobSizeLimitError, 2*1024*1024),
 		BlobSizeLimitWarn:      dc.GetIntPropertyFilteredByNamespace(dynamicconfig.BlobSizeLimitWarn, 512*1024),
+		BlobSizeAccountingMode: dc.GetStringPropertyFnWithNamespaceFilter(dynamicconfig.BlobSizeAccountingMode, common.BlobSizeAccountingModeTotal),
 		MemoSizeLimitError:     dc.GetIntPropertyFilteredByNamespace(dynamicconfig.MemoSizeLimitError, 2*1024*1024),
 		MemoSizeLimitWarn:      dc.GetIntPropertyFilteredByNamespace(dynamicconfig.MemoSizeLimitWarn, 2*1024),
 		HistorySizeLimitError:  dc.GetIntPropertyFilteredByNamespace(dynamicconfig.HistorySizeLimitError, 50*1024*1024),
@@ -386,8 +463,22 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int32, isAdvancedVis
 		HistoryCountLimitError: dc.GetIntPropertyFilteredByNamespace(dynamicconfig.HistoryCountLimitError, 50*1024),
 		HistoryCountLimitWarn:  dc.GetIntPropertyFilteredByNamespace(dynamicconfig.HistoryCountLimitWarn, 10*1024),
 
-		ThrottledLogRPS:   dc.GetIntProperty(dynamicconfig.HistoryThrottledLogRPS, 4),
-		EnableStickyQuery: dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnableStickyQuery, true),
+		MutableStateSizeLimitError: dc.GetIntPropertyFilteredByNamespace(dynamicconfig.MutableStateSizeLimitError, 8*1024*1024),
+		MutableStateSizeLimitWarn:  dc.GetIntPropertyFilteredByNamespace(dynamicconfig.MutableStateSizeLimitWarn, 1*1024*1024),
+
+		StateTransitionCountLimitError: dc.GetIntPropertyFilteredByNamespace(dynamicconfig.StateTransitionCountLimitError, 0),
+		StateTransitionCountLimitWarn:  dc.GetIntPropertyFilteredByNamespace(dynamicconfig.StateTransitionCountLimitWarn, 0),
+
+		NumPendingActivitiesLimitError:      dc.GetIntPropertyFilteredByNamespace(dynamicconfig.NumPendingActivitiesLimitError, 2000),
+		NumPendingChildExecutionsLimitError: dc.GetIntPropertyFilteredByNamespace(dynamicconfig.NumPendingChildExecutionsLimitError, 2000),
+		NumPendingSignalsLimitError:         dc.GetIntPropertyFilteredByNamespace(dynamicconfig.NumPendingSignalsLimitError, 2000),
+
+		OpenWorkflowCountLimit:           dc.GetIntPropertyFilteredByNamespace(dynamicconfig.OpenWorkflowCountLimit, 0),
+		OpenWorkflowCountRefreshInterval: dc.GetDurationProperty(dynamicconfig.OpenWorkflowCountRefreshInterval, 10*time.Second),
+
+		ThrottledLogRPS:             dc.GetIntProperty(dynamicconfig.HistoryThrottledLogRPS, 4),
+		EnableStickyQuery:           dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnableStickyQuery, true),
+		StickyQueryDispatchDeadline: dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.StickyQueryDispatchDeadline, 2*time.Second),
 
 		DefaultActivityRetryPolicy:   dc.GetMapPropertyFnWithNamespaceFilter(dynamicconfig.DefaultActivityRetryPolicy, common.GetDefaultRetryPolicyConfigOptions()),
 		DefaultWorkflowRetryPolicy:   dc.GetMapPropertyFnWithNamespaceFilter(dynamicconfig.DefaultWorkflowRetryPolicy, common.GetDefaultRetryPolicyConfigOptions()),
@@ -407,11 +498,15 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int32, isAdvancedVis
 		ReplicationTaskProcessorNoTaskRetryWait:              dc.GetDurationPropertyFilteredByShardID(dynamicconfig.ReplicationTaskProcessorNoTaskInitialWait, 2*time.Second),
 		ReplicationTaskProcessorCleanupInterval:              dc.GetDurationPropertyFilteredByShardID(dynamicconfig.ReplicationTaskProcessorCleanupInterval, 1*time.Minute),
 		ReplicationTaskProcessorCleanupJitterCoefficient:     dc.GetFloat64PropertyFilteredByShardID(dynamicconfig.ReplicationTaskProcessorCleanupJitterCoefficient, 0.15),
+		ReplicationTaskProcessorEnableStreaming:              dc.GetBoolProperty(dynamicconfig.ReplicationTaskProcessorEnableStreaming, false),
+		ReplicationStreamHeartbeatInterval:                   dc.GetDurationProperty(dynamicconfig.ReplicationStreamHeartbeatInterval, 10*time.Second),
 
 		MaxBufferedQueryCount:                 dc.GetIntProperty(dynamicconfig.MaxBufferedQueryCount, 1),
 		MutableStateChecksumGenProbability:    dc.GetIntPropertyFilteredByNamespace(dynamicconfig.MutableStateChecksumGenProbability, 0),
 		MutableStateChecksumVerifyProbability: dc.GetIntPropertyFilteredByNamespace(dynamicconfig.MutableStateChecksumVerifyProbability, 0),
 		MutableStateChecksumInvalidateBefore:  dc.GetFloat64Property(dynamicconfig.MutableStateChecksumInvalidateBefore, 0),
+		MutableStateIntegrityCheckEnabled:     dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.MutableStateIntegrityCheckEnabled, false),
+		EnableWorkflowAuditLog:                dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnableWorkflowAuditLog, false),
 
 		ReplicationEventsFromCurrentCluster:    dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.ReplicationEventsFromCurrentCluster, false),
 		StandbyTaskReReplicationContextTimeout: dc.GetDurationPropertyFilteredByNamespaceID(dynamicconfig.StandbyTaskReReplicationContextTimeout, 3*time.Minute),
@@ -423,6 +518,7 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int32, isAdvancedVis
 		VisibilityTaskBatchSize:                                dc.GetIntProperty(dynamicconfig.VisibilityTaskBatchSize, 100),
 		VisibilityProcessorFailoverMaxPollRPS:                  dc.GetIntProperty(dynamicconfig.VisibilityProcessorFailoverMaxPollRPS, 1),
 		VisibilityProcessorMaxPollRPS:                          dc.GetIntProperty(dynamicconfig.VisibilityProcessorMaxPollRPS, 20),
+		VisibilityTaskMinWorkerCount:                           dc.GetIntProperty(dynamicconfig.VisibilityTaskMinWorkerCount, 5),
 		VisibilityTaskWorkerCount:                              dc.GetIntProperty(dynamicconfig.VisibilityTaskWorkerCount, 10),
 		VisibilityTaskMaxRetryCount:                            dc.GetIntProperty(dynamicconfig.VisibilityTaskMaxRetryCount, 100),
 		VisibilityProcessorCompleteTaskFailureRetryCount:       dc.GetIntProperty(dynamicconfig.VisibilityProcessorCompleteTaskFailureRetryCount, 10),
@@ -452,6 +548,10 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int32, isAdvancedVis
 		// Although, under small load it would never be the case and bulk processor will flush every this interval.
 		ESProcessorFlushInterval: dc.GetDurationProperty(dynamicconfig.WorkerESProcessorFlushInterval, 200*time.Millisecond),
 		ESProcessorAckTimeout:    dc.GetDurationProperty(dynamicconfig.WorkerESProcessorAckTimeout, 1*time.Minute),
+		// Backfill requests (i.e. generated by task refresh) are committed by separate workers to not delay live visibility updates.
+		ESProcessorBackfillWorkers: dc.GetIntProperty(dynamicconfig.WorkerESProcessorBackfillWorkers, 1),
+		// Should be less than ESProcessorAckTimeout, so nacked visibility tasks are retried sooner.
+		ESProcessorShutdownDrainTimeout: dc.GetDurationProperty(dynamicconfig.WorkerESProcessorShutdownDrainTimeout, 10*time.Second),
 
 		EnableCrossNamespaceCommands: dc.GetBoolProperty(dynamicconfig.EnableCrossNamespaceCommands, true),
 	}
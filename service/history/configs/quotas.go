@@ -29,43 +29,63 @@ import (
 )
 
 var (
+	// ConcurrencyLimitExcludedAPIs are long poll APIs which are not subject to the adaptive concurrency limit,
+	// their latency depends on workflow progress and doesn't reflect service load.
+	ConcurrencyLimitExcludedAPIs = map[string]struct{}{
+		"GetMutableState":        {},
+		"PollMutableState":       {},
+		"QueryWorkflow":          {},
+		"GetReplicationMessages": {},
+	}
+
 	APIToPriority = map[string]int{
-		"CloseShard":                       0,
-		"DescribeHistoryHost":              0,
-		"DescribeMutableState":             0,
-		"DescribeWorkflowExecution":        0,
-		"GetDLQMessages":                   0,
-		"GetDLQReplicationMessages":        0,
-		"GetMutableState":                  0,
-		"GetReplicationMessages":           0,
-		"MergeDLQMessages":                 0,
-		"PollMutableState":                 0,
-		"PurgeDLQMessages":                 0,
-		"QueryWorkflow":                    0,
-		"ReapplyEvents":                    0,
-		"RecordActivityTaskHeartbeat":      0,
-		"RecordActivityTaskStarted":        0,
-		"RecordChildExecutionCompleted":    0,
-		"RecordWorkflowTaskStarted":        0,
-		"RefreshWorkflowTasks":             0,
-		"RemoveSignalMutableState":         0,
-		"RemoveTask":                       0,
-		"ReplicateEventsV2":                0,
-		"RequestCancelWorkflowExecution":   0,
-		"ResetStickyTaskQueue":             0,
-		"ResetWorkflowExecution":           0,
-		"RespondActivityTaskCanceled":      0,
-		"RespondActivityTaskCompleted":     0,
-		"RespondActivityTaskFailed":        0,
-		"RespondWorkflowTaskCompleted":     0,
-		"RespondWorkflowTaskFailed":        0,
-		"ScheduleWorkflowTask":             0,
-		"SignalWithStartWorkflowExecution": 0,
-		"SignalWorkflowExecution":          0,
-		"StartWorkflowExecution":           0,
-		"SyncActivity":                     0,
-		"SyncShardStatus":                  0,
-		"TerminateWorkflowExecution":       0,
+		"CloseShard":                          0,
+		"CompactWorkflowHistory":              0,
+		"DeleteWorkflowExecution":             0,
+		"DescribeHistoryHost":                 0,
+		"DescribeMutableState":                0,
+		"DescribeVisibilityProcessor":         0,
+		"DescribeWorkflowExecution":           0,
+		"ExecuteMultiOperation":               0,
+		"GenerateLastHistoryReplicationTasks": 0,
+		"GetDLQMessages":                      0,
+		"GetDLQReplicationMessages":           0,
+		"GetHostProfile":                      0,
+		"GetMutableState":                     0,
+		"GetReplicationMessages":              0,
+		"GetReplicationStatus":                0,
+		"GetShardStats":                       0,
+		"ListThrottledCallers":                0,
+		"MergeDLQMessages":                    0,
+		"PollMutableState":                    0,
+		"PurgeDLQMessages":                    0,
+		"QueryWorkflow":                       0,
+		"ReapplyEvents":                       0,
+		"RecordActivityTaskHeartbeat":         0,
+		"RecordActivityTaskStarted":           0,
+		"RecordChildExecutionCompleted":       0,
+		"RecordWorkflowTaskHeartbeat":         0,
+		"RecordWorkflowTaskStarted":           0,
+		"RefreshWorkflowTasks":                0,
+		"RemoveSignalMutableState":            0,
+		"RemoveTask":                          0,
+		"ReplicateEventsV2":                   0,
+		"RequestCancelWorkflowExecution":      0,
+		"ResetStickyTaskQueue":                0,
+		"ResetWorkflowExecution":              0,
+		"RespondActivityTaskCanceled":         0,
+		"RespondActivityTaskCompleted":        0,
+		"RespondActivityTaskFailed":           0,
+		"RespondWorkflowTaskCompleted":        0,
+		"RespondWorkflowTaskFailed":           0,
+		"ScheduleWorkflowTask":                0,
+		"SignalWithStartWorkflowExecution":    0,
+		"SignalWorkflowExecution":             0,
+		"StartWorkflowExecution":              0,
+		"StreamReplicationMessages":           0,
+		"SyncActivity":                        0,
+		"SyncShardStatus":                     0,
+		"TerminateWorkflowExecution":          0,
 	}
 
 	APIPriorities = map[int]struct{}{
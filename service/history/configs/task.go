@@ -24,7 +24,11 @@
 
 package configs
 
-import "strconv"
+import (
+	"strconv"
+
+	"go.temporal.io/server/common/dynamicconfig"
+)
 
 const (
 	numBitsPerLevel = 3
@@ -42,10 +46,25 @@ const (
 	TaskLowPrioritySubclass
 )
 
+const (
+	// TaskNamespaceTierCritical is the tier for latency critical namespaces
+	TaskNamespaceTierCritical = "critical"
+	// TaskNamespaceTierDefault is the tier used when no tier is configured for a namespace
+	TaskNamespaceTierDefault = "default"
+	// TaskNamespaceTierBatch is the tier for throughput oriented namespaces
+	TaskNamespaceTierBatch = "batch"
+)
+
 var DefaultTaskPriorityWeight = map[int]int{
+	GetTaskPriority(TaskHighPriorityClass, TaskHighPrioritySubclass):       400,
 	GetTaskPriority(TaskHighPriorityClass, TaskDefaultPrioritySubclass):    200,
+	GetTaskPriority(TaskHighPriorityClass, TaskLowPrioritySubclass):        40,
+	GetTaskPriority(TaskDefaultPriorityClass, TaskHighPrioritySubclass):    200,
 	GetTaskPriority(TaskDefaultPriorityClass, TaskDefaultPrioritySubclass): 100,
+	GetTaskPriority(TaskDefaultPriorityClass, TaskLowPrioritySubclass):     20,
+	GetTaskPriority(TaskLowPriorityClass, TaskHighPrioritySubclass):        100,
 	GetTaskPriority(TaskLowPriorityClass, TaskDefaultPrioritySubclass):     50,
+	GetTaskPriority(TaskLowPriorityClass, TaskLowPrioritySubclass):         10,
 }
 
 func ConvertWeightsToDynamicConfigValue(
@@ -58,8 +77,73 @@ func ConvertWeightsToDynamicConfigValue(
 	return weightsForDC
 }
 
+// WithTaskNamespaceTierWeights maps legacy weights, which only have a weight per priority class, to the default
+// namespace tier. Weights of the critical and batch tiers which are not configured are derived from the weight of
+// the default tier in the same class with the ratios of DefaultTaskPriorityWeight.
+func WithTaskNamespaceTierWeights(
+	weights dynamicconfig.MapPropertyFn,
+) dynamicconfig.MapPropertyFn {
+	return func(opts ...dynamicconfig.FilterOption) map[string]interface{} {
+		return convertLegacyTaskPriorityWeights(weights(opts...))
+	}
+}
+
+func convertLegacyTaskPriorityWeights(
+	weightsFromDC map[string]interface{},
+) map[string]interface{} {
+	weights := make(map[int]int, len(weightsFromDC))
+	for key, value := range weightsFromDC {
+		priority, err := strconv.Atoi(key)
+		weight, ok := value.(int)
+		if err != nil || !ok {
+			// invalid weights are reported by the task scheduler
+			return weightsFromDC
+		}
+		weights[priority] = weight
+	}
+
+	converted := make(map[string]interface{}, len(DefaultTaskPriorityWeight))
+	for key, value := range weightsFromDC {
+		converted[key] = value
+	}
+	for _, class := range []int{TaskHighPriorityClass, TaskDefaultPriorityClass, TaskLowPriorityClass} {
+		defaultPriority := GetTaskPriority(class, TaskDefaultPrioritySubclass)
+		defaultWeight, ok := weights[defaultPriority]
+		if !ok {
+			continue
+		}
+		for _, subClass := range []int{TaskHighPrioritySubclass, TaskLowPrioritySubclass} {
+			priority := GetTaskPriority(class, subClass)
+			if _, ok := weights[priority]; ok {
+				continue
+			}
+			weight := defaultWeight * DefaultTaskPriorityWeight[priority] / DefaultTaskPriorityWeight[defaultPriority]
+			if weight < 1 {
+				weight = 1
+			}
+			converted[strconv.Itoa(priority)] = weight
+		}
+	}
+	return converted
+}
+
 func GetTaskPriority(
 	class, subClass int,
 ) int {
 	return class | subClass
 }
+
+// GetTaskPrioritySubclass returns the task priority subclass for the given namespace tier,
+// unknown tiers are treated as the default tier
+func GetTaskPrioritySubclass(
+	namespaceTier string,
+) int {
+	switch namespaceTier {
+	case TaskNamespaceTierCritical:
+		return TaskHighPrioritySubclass
+	case TaskNamespaceTierBatch:
+		return TaskLowPrioritySubclass
+	default:
+		return TaskDefaultPrioritySubclass
+	}
+}
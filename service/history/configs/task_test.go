@@ -0,0 +1,94 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package configs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/dynamicconfig"
+)
+
+type (
+	taskSuite struct {
+		suite.Suite
+		*require.Assertions
+	}
+)
+
+func TestTaskSuite(t *testing.T) {
+	s := new(taskSuite)
+	suite.Run(t, s)
+}
+
+func (s *taskSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *taskSuite) TestWithTaskNamespaceTierWeights_Default() {
+	weights := WithTaskNamespaceTierWeights(dynamicconfig.GetMapPropertyFn(ConvertWeightsToDynamicConfigValue(DefaultTaskPriorityWeight)))
+	s.Equal(ConvertWeightsToDynamicConfigValue(DefaultTaskPriorityWeight), weights())
+}
+
+func (s *taskSuite) TestWithTaskNamespaceTierWeights_Legacy() {
+	weights := WithTaskNamespaceTierWeights(dynamicconfig.GetMapPropertyFn(map[string]interface{}{
+		"1":  300,
+		"9":  30,
+		"17": 2,
+	}))
+	s.Equal(map[string]interface{}{
+		"0":  600,
+		"1":  300,
+		"2":  60,
+		"8":  60,
+		"9":  30,
+		"10": 6,
+		"16": 4,
+		"17": 2,
+		"18": 1,
+	}, weights())
+}
+
+func (s *taskSuite) TestWithTaskNamespaceTierWeights_PartialOverride() {
+	weights := WithTaskNamespaceTierWeights(dynamicconfig.GetMapPropertyFn(map[string]interface{}{
+		"0":  1000,
+		"1":  300,
+		"9":  30,
+		"17": 2,
+	}))
+	s.Equal(1000, weights()["0"])
+	s.Equal(60, weights()["2"])
+}
+
+func (s *taskSuite) TestWithTaskNamespaceTierWeights_Invalid() {
+	invalid := map[string]interface{}{
+		"1":       300,
+		"unknown": 30,
+	}
+	weights := WithTaskNamespaceTierWeights(dynamicconfig.GetMapPropertyFn(invalid))
+	s.Equal(invalid, weights())
+}
@@ -70,8 +70,20 @@ var (
 	ErrEmptyHistoryRawEventBatch = serviceerror.NewInvalidArgument("encounter empty history batch")
 	// ErrSizeExceedsLimit is error indicating workflow execution has exceeded system defined limit
 	ErrSizeExceedsLimit = serviceerror.NewResourceExhausted(common.FailureReasonSizeExceedsLimit)
+	// ErrStateTransitionCountExceedsLimit is error indicating workflow execution has exceeded its state transition budget
+	ErrStateTransitionCountExceedsLimit = serviceerror.NewResourceExhausted(common.FailureReasonStateTransitionCountExceedsLimit)
+	// ErrOpenWorkflowCountExceedsLimit is error indicating namespace has reached its limit of concurrently open workflow executions
+	ErrOpenWorkflowCountExceedsLimit = serviceerror.NewResourceExhausted("namespace exceeded limit of concurrently open workflow executions")
 	// ErrUnknownCluster is error indicating unknown cluster
 	ErrUnknownCluster = serviceerror.NewInvalidArgument("unknown cluster")
+	// ErrWorkflowTaskHeartbeatTimeout is error indicating workflow task cannot be extended by heartbeat any further
+	ErrWorkflowTaskHeartbeatTimeout = serviceerror.NewInvalidArgument("workflow task heartbeat timeout exceeded")
+	// ErrNamespaceNotReplicated is error indicating namespace is not replicated to any remote cluster
+	ErrNamespaceNotReplicated = serviceerror.NewInvalidArgument("namespace is not replicated to any remote cluster")
+	// ErrCompactReplicatedHistory is error indicating history of replicated namespace cannot be compacted
+	ErrCompactReplicatedHistory = serviceerror.NewInvalidArgument("history of namespace replicated to remote clusters cannot be compacted")
+	// ErrDeleteRunningWorkflow is error indicating a running workflow execution cannot be deleted
+	ErrDeleteRunningWorkflow = serviceerror.NewInvalidArgument("running workflow execution cannot be deleted, it has to be closed first")
 
 	// FailedWorkflowStatuses is a set of failed workflow close states, used for start workflow policy
 	// for start workflow execution API
@@ -27,6 +27,7 @@ package history
 import (
 	"context"
 	"fmt"
+	"io"
 	"sync"
 	"sync/atomic"
 
@@ -41,19 +42,26 @@ import (
 	"go.temporal.io/api/serviceerror"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
+	clusterspb "go.temporal.io/server/api/cluster/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	namespacespb "go.temporal.io/server/api/namespace/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
 	tokenspb "go.temporal.io/server/api/token/v1"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/elasticsearch"
+	"go.temporal.io/server/common/pprof"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/common/resource"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
 	"go.temporal.io/server/common/task"
+	"go.temporal.io/server/common/tracing"
 )
 
 type (
@@ -70,6 +78,9 @@ type (
 		eventNotifier           events.Notifier
 		replicationTaskFetchers ReplicationTaskFetchers
 		queueTaskProcessor      queueTaskProcessor
+		openWorkflowCounter     *openWorkflowCounter
+		isolationGroupLimiter   quotas.IsolationGroupLimiter
+		esProcessor             elasticsearch.Processor // nil if advanced visibility is not configured
 	}
 )
 
@@ -81,31 +92,36 @@ var (
 	_ shard.EngineFactory                 = (*Handler)(nil)
 	_ historyservice.HistoryServiceServer = (*Handler)(nil)
 
-	errNamespaceNotSet         = serviceerror.NewInvalidArgument("Namespace not set on request.")
-	errWorkflowExecutionNotSet = serviceerror.NewInvalidArgument("WorkflowExecution not set on request.")
-	errTaskQueueNotSet         = serviceerror.NewInvalidArgument("Task queue not set.")
-	errWorkflowIDNotSet        = serviceerror.NewInvalidArgument("WorkflowId is not set on request.")
-	errRunIDNotValid           = serviceerror.NewInvalidArgument("RunId is not valid UUID.")
-	errSourceClusterNotSet     = serviceerror.NewInvalidArgument("Source Cluster not set on request.")
-	errShardIDNotSet           = serviceerror.NewInvalidArgument("ShardId not set on request.")
-	errTimestampNotSet         = serviceerror.NewInvalidArgument("Timestamp not set on request.")
-	errInvalidTaskType         = serviceerror.NewInvalidArgument("Invalid task type")
+	errNamespaceNotSet                = serviceerror.NewInvalidArgument("Namespace not set on request.")
+	errWorkflowExecutionNotSet        = serviceerror.NewInvalidArgument("WorkflowExecution not set on request.")
+	errTaskQueueNotSet                = serviceerror.NewInvalidArgument("Task queue not set.")
+	errWorkflowIDNotSet               = serviceerror.NewInvalidArgument("WorkflowId is not set on request.")
+	errRunIDNotValid                  = serviceerror.NewInvalidArgument("RunId is not valid UUID.")
+	errSourceClusterNotSet            = serviceerror.NewInvalidArgument("Source Cluster not set on request.")
+	errShardIDNotSet                  = serviceerror.NewInvalidArgument("ShardId not set on request.")
+	errReplicationStreamShardMismatch = serviceerror.NewInvalidArgument("ShardId of replication token does not match replication stream.")
+	errTimestampNotSet                = serviceerror.NewInvalidArgument("Timestamp not set on request.")
+	errInvalidTaskType                = serviceerror.NewInvalidArgument("Invalid task type")
 
 	errDeserializeTaskTokenMessage = "Error to deserialize task token. Error: %v."
 
 	errShuttingDown = serviceerror.NewInternal("Shutting down")
+
+	errVisibilityProcessorNotConfigured = serviceerror.NewInvalidArgument("Elasticsearch visibility processor is not configured on this host.")
 )
 
 // NewHandler creates a thrift handler for the history service
 func NewHandler(
 	resource resource.Resource,
 	config *configs.Config,
+	esProcessor elasticsearch.Processor,
 ) *Handler {
 	handler := &Handler{
 		Resource:        resource,
 		status:          common.DaemonStatusInitialized,
 		config:          config,
 		tokenSerializer: common.NewProtoTaskTokenSerializer(),
+		esProcessor:     esProcessor,
 	}
 
 	// prevent us from trying to serve requests before shard controller is started and ready
@@ -180,6 +196,15 @@ func (h *Handler) Start() {
 		h,
 		h.config,
 	)
+	h.openWorkflowCounter = newOpenWorkflowCounter(
+		h.GetVisibilityManager(),
+		h.GetTimeSource(),
+		h.config.OpenWorkflowCountRefreshInterval,
+	)
+	h.isolationGroupLimiter = quotas.NewIsolationGroupLimiter(
+		func(namespace string) string { return h.config.NamespaceIsolationGroup(namespace) },
+		func() map[string]interface{} { return h.config.IsolationGroupBudget() },
+	)
 	h.eventNotifier = events.NewNotifier(h.GetTimeSource(), h.GetMetricsClient(), h.config.GetShardID)
 	// events notifier must starts before controller
 	h.eventNotifier.Start()
@@ -225,6 +250,8 @@ func (h *Handler) CreateEngine(
 		h.replicationTaskFetchers,
 		h.GetMatchingRawClient(),
 		h.queueTaskProcessor,
+		h.openWorkflowCounter,
+		h.isolationGroupLimiter,
 	)
 }
 
@@ -271,10 +298,11 @@ func (h *Handler) RecordActivityTaskHeartbeat(ctx context.Context, request *hist
 	}
 	workflowID := taskToken.GetWorkflowId()
 
-	engine, err1 := h.controller.GetEngine(namespaceID, workflowID)
+	engine, release, err1 := h.controller.AcquireEngine(namespaceID, workflowID)
 	if err1 != nil {
 		return nil, h.convertError(err1)
 	}
+	defer release()
 
 	response, err2 := engine.RecordActivityTaskHeartbeat(ctx, request)
 	if err2 != nil {
@@ -296,10 +324,11 @@ func (h *Handler) RecordActivityTaskStarted(ctx context.Context, request *histor
 		return nil, h.convertError(errNamespaceNotSet)
 	}
 
-	engine, err1 := h.controller.GetEngine(namespaceID, workflowID)
+	engine, release, err1 := h.controller.AcquireEngine(namespaceID, workflowID)
 	if err1 != nil {
 		return nil, h.convertError(err1)
 	}
+	defer release()
 
 	response, err2 := engine.RecordActivityTaskStarted(ctx, request)
 	if err2 != nil {
@@ -325,7 +354,7 @@ func (h *Handler) RecordWorkflowTaskStarted(ctx context.Context, request *histor
 		return nil, h.convertError(errTaskQueueNotSet)
 	}
 
-	engine, err1 := h.controller.GetEngine(namespaceID, workflowID)
+	engine, release, err1 := h.controller.AcquireEngine(namespaceID, workflowID)
 	if err1 != nil {
 		h.GetLogger().Error("RecordWorkflowTaskStarted failed.",
 			tag.Error(err1),
@@ -335,6 +364,7 @@ func (h *Handler) RecordWorkflowTaskStarted(ctx context.Context, request *histor
 		)
 		return nil, h.convertError(err1)
 	}
+	defer release()
 
 	response, err2 := engine.RecordWorkflowTaskStarted(ctx, request)
 	if err2 != nil {
@@ -366,10 +396,11 @@ func (h *Handler) RespondActivityTaskCompleted(ctx context.Context, request *his
 	}
 	workflowID := taskToken.GetWorkflowId()
 
-	engine, err1 := h.controller.GetEngine(namespaceID, workflowID)
+	engine, release, err1 := h.controller.AcquireEngine(namespaceID, workflowID)
 	if err1 != nil {
 		return nil, h.convertError(err1)
 	}
+	defer release()
 
 	err2 := engine.RespondActivityTaskCompleted(ctx, request)
 	if err2 != nil {
@@ -401,10 +432,11 @@ func (h *Handler) RespondActivityTaskFailed(ctx context.Context, request *histor
 	}
 	workflowID := taskToken.GetWorkflowId()
 
-	engine, err1 := h.controller.GetEngine(namespaceID, workflowID)
+	engine, release, err1 := h.controller.AcquireEngine(namespaceID, workflowID)
 	if err1 != nil {
 		return nil, h.convertError(err1)
 	}
+	defer release()
 
 	err2 := engine.RespondActivityTaskFailed(ctx, request)
 	if err2 != nil {
@@ -436,10 +468,11 @@ func (h *Handler) RespondActivityTaskCanceled(ctx context.Context, request *hist
 	}
 	workflowID := taskToken.GetWorkflowId()
 
-	engine, err1 := h.controller.GetEngine(namespaceID, workflowID)
+	engine, release, err1 := h.controller.AcquireEngine(namespaceID, workflowID)
 	if err1 != nil {
 		return nil, h.convertError(err1)
 	}
+	defer release()
 
 	err2 := engine.RespondActivityTaskCanceled(ctx, request)
 	if err2 != nil {
@@ -477,12 +510,16 @@ func (h *Handler) RespondWorkflowTaskCompleted(ctx context.Context, request *his
 	}
 	workflowID := token.GetWorkflowId()
 
-	engine, err1 := h.controller.GetEngine(namespaceID, workflowID)
+	engine, release, err1 := h.controller.AcquireEngine(namespaceID, workflowID)
 	if err1 != nil {
 		return nil, h.convertError(err1)
 	}
+	defer release()
 
+	// continue the trace of the dispatch of the workflow task to the worker
+	ctx, span := tracing.StartSpanFromTraceContext(ctx, token.GetTraceContext(), "CompleteWorkflowTask")
 	response, err2 := engine.RespondWorkflowTaskCompleted(ctx, request)
+	tracing.EndSpan(span, err2)
 	if err2 != nil {
 		return nil, h.convertError(err2)
 	}
@@ -518,10 +555,11 @@ func (h *Handler) RespondWorkflowTaskFailed(ctx context.Context, request *histor
 	}
 	workflowID := token.GetWorkflowId()
 
-	engine, err1 := h.controller.GetEngine(namespaceID, workflowID)
+	engine, release, err1 := h.controller.AcquireEngine(namespaceID, workflowID)
 	if err1 != nil {
 		return nil, h.convertError(err1)
 	}
+	defer release()
 
 	err2 := engine.RespondWorkflowTaskFailed(ctx, request)
 	if err2 != nil {
@@ -531,6 +569,47 @@ func (h *Handler) RespondWorkflowTaskFailed(ctx context.Context, request *histor
 	return &historyservice.RespondWorkflowTaskFailedResponse{}, nil
 }
 
+// RecordWorkflowTaskHeartbeat - extends the start to close timeout of a started workflow task
+func (h *Handler) RecordWorkflowTaskHeartbeat(ctx context.Context, request *historyservice.RecordWorkflowTaskHeartbeatRequest) (_ *historyservice.RecordWorkflowTaskHeartbeatResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	namespaceID := request.GetNamespaceId()
+	if namespaceID == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+
+	token, err0 := h.tokenSerializer.Deserialize(request.TaskToken)
+	if err0 != nil {
+		return nil, h.convertError(serviceerror.NewInvalidArgument(fmt.Sprintf(errDeserializeTaskTokenMessage, err0)))
+	}
+
+	h.GetLogger().Debug("RecordWorkflowTaskHeartbeat",
+		tag.WorkflowNamespaceID(token.GetNamespaceId()),
+		tag.WorkflowID(token.GetWorkflowId()),
+		tag.WorkflowRunID(token.GetRunId()),
+		tag.WorkflowScheduleID(token.GetScheduleId()))
+
+	err0 = validateTaskToken(token)
+	if err0 != nil {
+		return nil, h.convertError(err0)
+	}
+	workflowID := token.GetWorkflowId()
+
+	engine, release, err1 := h.controller.AcquireEngine(namespaceID, workflowID)
+	if err1 != nil {
+		return nil, h.convertError(err1)
+	}
+	defer release()
+
+	err2 := engine.RecordWorkflowTaskHeartbeat(ctx, request)
+	if err2 != nil {
+		return nil, h.convertError(err2)
+	}
+
+	return &historyservice.RecordWorkflowTaskHeartbeatResponse{}, nil
+}
+
 // StartWorkflowExecution - creates a new workflow execution
 func (h *Handler) StartWorkflowExecution(ctx context.Context, request *historyservice.StartWorkflowExecutionRequest) (_ *historyservice.StartWorkflowExecutionResponse, retError error) {
 	defer log.CapturePanic(h.GetLogger(), &retError)
@@ -543,10 +622,11 @@ func (h *Handler) StartWorkflowExecution(ctx context.Context, request *historyse
 
 	startRequest := request.StartRequest
 	workflowID := startRequest.GetWorkflowId()
-	engine, err1 := h.controller.GetEngine(namespaceID, workflowID)
+	engine, release, err1 := h.controller.AcquireEngine(namespaceID, workflowID)
 	if err1 != nil {
 		return nil, h.convertError(err1)
 	}
+	defer release()
 
 	response, err2 := engine.StartWorkflowExecution(ctx, request)
 	if err2 != nil {
@@ -585,6 +665,93 @@ func (h *Handler) DescribeHistoryHost(_ context.Context, _ *historyservice.Descr
 	return resp, nil
 }
 
+// ListThrottledCallers returns the callers currently being throttled by the rate limiters of this host
+func (h *Handler) ListThrottledCallers(_ context.Context, request *historyservice.ListThrottledCallersRequest) (_ *historyservice.ListThrottledCallersResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	return &historyservice.ListThrottledCallersResponse{
+		Callers: quotas.ThrottledCallersToProto(
+			h.GetThrottleTracker().ThrottledCallers(request.GetNamespace()),
+			h.GetHostInfo().GetAddress(),
+			common.HistoryServiceName,
+		),
+	}, nil
+}
+
+// GetShardStats returns the stats of the shards owned by this host
+func (h *Handler) GetShardStats(_ context.Context, _ *historyservice.GetShardStatsRequest) (_ *historyservice.GetShardStatsResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	return &historyservice.GetShardStatsResponse{
+		Shards: h.controller.ShardStats(),
+	}, nil
+}
+
+// GetHostProfile captures a pprof profile of this host
+func (h *Handler) GetHostProfile(ctx context.Context, request *historyservice.GetHostProfileRequest) (_ *historyservice.GetHostProfileResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+
+	scope := h.GetMetricsClient().Scope(metrics.HistoryGetHostProfileScope)
+	scope.IncCounter(metrics.ServiceRequests)
+	sw := scope.StartTimer(metrics.ServiceLatency)
+	defer sw.Stop()
+
+	h.GetLogger().Info("Capturing profile.", tag.Value(request.GetProfile()))
+	profile, err := pprof.CaptureProfile(ctx, request.GetProfile(), timestamp.DurationValue(request.GetDuration()))
+	if err != nil {
+		scope.IncCounter(metrics.ServiceFailures)
+		return nil, err
+	}
+	return &historyservice.GetHostProfileResponse{Profile: profile}, nil
+}
+
+// DescribeVisibilityProcessor returns the state of the Elasticsearch bulk processor of this host and optionally flushes it
+func (h *Handler) DescribeVisibilityProcessor(_ context.Context, request *historyservice.DescribeVisibilityProcessorRequest) (_ *historyservice.DescribeVisibilityProcessorResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+
+	scope := h.GetMetricsClient().Scope(metrics.HistoryDescribeVisibilityProcessorScope)
+	scope.IncCounter(metrics.ServiceRequests)
+	sw := scope.StartTimer(metrics.ServiceLatency)
+	defer sw.Stop()
+
+	if h.esProcessor == nil {
+		scope.IncCounter(metrics.ServiceFailures)
+		return nil, errVisibilityProcessorNotConfigured
+	}
+	if request.GetFlush() {
+		h.GetLogger().Info("Flushing Elasticsearch processor.")
+		if err := h.esProcessor.Flush(); err != nil {
+			scope.IncCounter(metrics.ServiceFailures)
+			return nil, serviceerror.NewUnavailable(err.Error())
+		}
+	}
+
+	stats := h.esProcessor.Stats()
+	status := &clusterspb.VisibilityProcessorStatus{
+		HostAddress:     h.GetHostInfo().GetAddress(),
+		BulkProcessors:  make([]*clusterspb.BulkProcessorStats, 0, len(stats.BulkProcessors)),
+		InFlightBulks:   stats.InFlightBulks,
+		PendingAcks:     int64(stats.PendingAcks),
+		LastCommitError: stats.LastCommitError,
+	}
+	if !stats.LastCommitErrorTime.IsZero() {
+		status.LastCommitErrorTime = timestamp.TimePtr(stats.LastCommitErrorTime)
+	}
+	for _, bulkProcessorStats := range stats.BulkProcessors {
+		status.BulkProcessors = append(status.BulkProcessors, &clusterspb.BulkProcessorStats{
+			Name:      bulkProcessorStats.Name,
+			Queued:    bulkProcessorStats.Queued,
+			Flushed:   bulkProcessorStats.Flushed,
+			Committed: bulkProcessorStats.Committed,
+			Succeeded: bulkProcessorStats.Succeeded,
+			Failed:    bulkProcessorStats.Failed,
+		})
+	}
+	return &historyservice.DescribeVisibilityProcessorResponse{Status: status}, nil
+}
+
 // RemoveTask returns information about the internal states of a history host
 func (h *Handler) RemoveTask(_ context.Context, request *historyservice.RemoveTaskRequest) (_ *historyservice.RemoveTaskResponse, retError error) {
 	executionMgr, err := h.GetExecutionManager(request.GetShardId())
@@ -636,10 +803,11 @@ func (h *Handler) DescribeMutableState(ctx context.Context, request *historyserv
 
 	workflowExecution := request.Execution
 	workflowID := workflowExecution.GetWorkflowId()
-	engine, err1 := h.controller.GetEngine(namespaceID, workflowID)
+	engine, release, err1 := h.controller.AcquireEngine(namespaceID, workflowID)
 	if err1 != nil {
 		return nil, h.convertError(err1)
 	}
+	defer release()
 
 	resp, err2 := engine.DescribeMutableState(ctx, request)
 	if err2 != nil {
@@ -660,10 +828,11 @@ func (h *Handler) GetMutableState(ctx context.Context, request *historyservice.G
 
 	workflowExecution := request.Execution
 	workflowID := workflowExecution.GetWorkflowId()
-	engine, err1 := h.controller.GetEngine(namespaceID, workflowID)
+	engine, release, err1 := h.controller.AcquireEngine(namespaceID, workflowID)
 	if err1 != nil {
 		return nil, h.convertError(err1)
 	}
+	defer release()
 
 	resp, err2 := engine.GetMutableState(ctx, request)
 	if err2 != nil {
@@ -684,10 +853,11 @@ func (h *Handler) PollMutableState(ctx context.Context, request *historyservice.
 
 	workflowExecution := request.Execution
 	workflowID := workflowExecution.GetWorkflowId()
-	engine, err1 := h.controller.GetEngine(namespaceID, workflowID)
+	engine, release, err1 := h.controller.AcquireEngine(namespaceID, workflowID)
 	if err1 != nil {
 		return nil, h.convertError(err1)
 	}
+	defer release()
 
 	resp, err2 := engine.PollMutableState(ctx, request)
 	if err2 != nil {
@@ -708,10 +878,11 @@ func (h *Handler) DescribeWorkflowExecution(ctx context.Context, request *histor
 
 	workflowExecution := request.Request.Execution
 	workflowID := workflowExecution.GetWorkflowId()
-	engine, err1 := h.controller.GetEngine(namespaceID, workflowID)
+	engine, release, err1 := h.controller.AcquireEngine(namespaceID, workflowID)
 	if err1 != nil {
 		return nil, h.convertError(err1)
 	}
+	defer release()
 
 	resp, err2 := engine.DescribeWorkflowExecution(ctx, request)
 	if err2 != nil {
@@ -742,10 +913,11 @@ func (h *Handler) RequestCancelWorkflowExecution(ctx context.Context, request *h
 		tag.WorkflowRunID(cancelRequest.WorkflowExecution.GetRunId()))
 
 	workflowID := cancelRequest.WorkflowExecution.GetWorkflowId()
-	engine, err1 := h.controller.GetEngine(namespaceID, workflowID)
+	engine, release, err1 := h.controller.AcquireEngine(namespaceID, workflowID)
 	if err1 != nil {
 		return nil, h.convertError(err1)
 	}
+	defer release()
 
 	err2 := engine.RequestCancelWorkflowExecution(ctx, request)
 	if err2 != nil {
@@ -772,10 +944,11 @@ func (h *Handler) SignalWorkflowExecution(ctx context.Context, request *historys
 
 	workflowExecution := request.SignalRequest.WorkflowExecution
 	workflowID := workflowExecution.GetWorkflowId()
-	engine, err1 := h.controller.GetEngine(namespaceID, workflowID)
+	engine, release, err1 := h.controller.AcquireEngine(namespaceID, workflowID)
 	if err1 != nil {
 		return nil, h.convertError(err1)
 	}
+	defer release()
 
 	err2 := engine.SignalWorkflowExecution(ctx, request)
 	if err2 != nil {
@@ -805,10 +978,11 @@ func (h *Handler) SignalWithStartWorkflowExecution(ctx context.Context, request
 
 	signalWithStartRequest := request.SignalWithStartRequest
 	workflowID := signalWithStartRequest.GetWorkflowId()
-	engine, err1 := h.controller.GetEngine(namespaceID, workflowID)
+	engine, release, err1 := h.controller.AcquireEngine(namespaceID, workflowID)
 	if err1 != nil {
 		return nil, h.convertError(err1)
 	}
+	defer release()
 
 	for {
 		resp, err2 := engine.SignalWithStartWorkflowExecution(ctx, request)
@@ -844,6 +1018,54 @@ func (h *Handler) SignalWithStartWorkflowExecution(ctx context.Context, request
 	}
 }
 
+// ExecuteMultiOperation is used to start a workflow execution if it is not running and deliver all the signals
+// to it in the same transaction as the start, or to the current run if the workflow is already running
+func (h *Handler) ExecuteMultiOperation(ctx context.Context, request *historyservice.ExecuteMultiOperationRequest) (_ *historyservice.ExecuteMultiOperationResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := request.GetNamespaceId()
+	if namespaceID == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+
+	workflowID := request.GetStartRequest().GetWorkflowId()
+	engine, release, err1 := h.controller.AcquireEngine(namespaceID, workflowID)
+	if err1 != nil {
+		return nil, h.convertError(err1)
+	}
+	defer release()
+
+	for {
+		resp, err2 := engine.ExecuteMultiOperation(ctx, request)
+		if err2 == nil {
+			return resp, nil
+		}
+
+		// same as SignalWithStart, simultaneous requests racing to start the workflow are retried
+		_, isExecutionAlreadyStartedErr := err2.(*persistence.WorkflowExecutionAlreadyStartedError)
+		_, isWorkflowConditionFailedErr := err2.(*persistence.CurrentWorkflowConditionFailedError)
+
+		isContextDone := false
+		select {
+		case <-ctx.Done():
+			isContextDone = true
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				err2 = ctxErr
+			}
+		default:
+		}
+
+		if (!isExecutionAlreadyStartedErr && !isWorkflowConditionFailedErr) || isContextDone {
+			return nil, h.convertError(err2)
+		}
+	}
+}
+
 // RemoveSignalMutableState is used to remove a signal request ID that was previously recorded.  This is currently
 // used to clean execution info when signal workflow task finished.
 func (h *Handler) RemoveSignalMutableState(ctx context.Context, request *historyservice.RemoveSignalMutableStateRequest) (_ *historyservice.RemoveSignalMutableStateResponse, retError error) {
@@ -861,10 +1083,11 @@ func (h *Handler) RemoveSignalMutableState(ctx context.Context, request *history
 
 	workflowExecution := request.WorkflowExecution
 	workflowID := workflowExecution.GetWorkflowId()
-	engine, err1 := h.controller.GetEngine(namespaceID, workflowID)
+	engine, release, err1 := h.controller.AcquireEngine(namespaceID, workflowID)
 	if err1 != nil {
 		return nil, h.convertError(err1)
 	}
+	defer release()
 
 	err2 := engine.RemoveSignalMutableState(ctx, request)
 	if err2 != nil {
@@ -891,10 +1114,11 @@ func (h *Handler) TerminateWorkflowExecution(ctx context.Context, request *histo
 
 	workflowExecution := request.TerminateRequest.WorkflowExecution
 	workflowID := workflowExecution.GetWorkflowId()
-	engine, err1 := h.controller.GetEngine(namespaceID, workflowID)
+	engine, release, err1 := h.controller.AcquireEngine(namespaceID, workflowID)
 	if err1 != nil {
 		return nil, h.convertError(err1)
 	}
+	defer release()
 
 	err2 := engine.TerminateWorkflowExecution(ctx, request)
 	if err2 != nil {
@@ -921,10 +1145,11 @@ func (h *Handler) ResetWorkflowExecution(ctx context.Context, request *historyse
 
 	workflowExecution := request.ResetRequest.WorkflowExecution
 	workflowID := workflowExecution.GetWorkflowId()
-	engine, err1 := h.controller.GetEngine(namespaceID, workflowID)
+	engine, release, err1 := h.controller.AcquireEngine(namespaceID, workflowID)
 	if err1 != nil {
 		return nil, h.convertError(err1)
 	}
+	defer release()
 
 	resp, err2 := engine.ResetWorkflowExecution(ctx, request)
 	if err2 != nil {
@@ -949,10 +1174,11 @@ func (h *Handler) QueryWorkflow(ctx context.Context, request *historyservice.Que
 	}
 
 	workflowID := request.GetRequest().GetExecution().GetWorkflowId()
-	engine, err1 := h.controller.GetEngine(namespaceID, workflowID)
+	engine, release, err1 := h.controller.AcquireEngine(namespaceID, workflowID)
 	if err1 != nil {
 		return nil, h.convertError(err1)
 	}
+	defer release()
 
 	resp, err2 := engine.QueryWorkflow(ctx, request)
 	if err2 != nil {
@@ -985,10 +1211,11 @@ func (h *Handler) ScheduleWorkflowTask(ctx context.Context, request *historyserv
 
 	workflowExecution := request.WorkflowExecution
 	workflowID := workflowExecution.GetWorkflowId()
-	engine, err1 := h.controller.GetEngine(namespaceID, workflowID)
+	engine, release, err1 := h.controller.AcquireEngine(namespaceID, workflowID)
 	if err1 != nil {
 		return nil, h.convertError(err1)
 	}
+	defer release()
 
 	err2 := engine.ScheduleWorkflowTask(ctx, request)
 	if err2 != nil {
@@ -1019,10 +1246,11 @@ func (h *Handler) RecordChildExecutionCompleted(ctx context.Context, request *hi
 
 	workflowExecution := request.WorkflowExecution
 	workflowID := workflowExecution.GetWorkflowId()
-	engine, err1 := h.controller.GetEngine(namespaceID, workflowID)
+	engine, release, err1 := h.controller.AcquireEngine(namespaceID, workflowID)
 	if err1 != nil {
 		return nil, h.convertError(err1)
 	}
+	defer release()
 
 	err2 := engine.RecordChildExecutionCompleted(ctx, request)
 	if err2 != nil {
@@ -1051,10 +1279,11 @@ func (h *Handler) ResetStickyTaskQueue(ctx context.Context, request *historyserv
 	}
 
 	workflowID := request.Execution.GetWorkflowId()
-	engine, err := h.controller.GetEngine(namespaceID, workflowID)
+	engine, release, err := h.controller.AcquireEngine(namespaceID, workflowID)
 	if err != nil {
 		return nil, h.convertError(err)
 	}
+	defer release()
 
 	resp, err := engine.ResetStickyTaskQueue(ctx, request)
 	if err != nil {
@@ -1080,10 +1309,11 @@ func (h *Handler) ReplicateEventsV2(ctx context.Context, request *historyservice
 
 	workflowExecution := request.WorkflowExecution
 	workflowID := workflowExecution.GetWorkflowId()
-	engine, err1 := h.controller.GetEngine(namespaceID, workflowID)
+	engine, release, err1 := h.controller.AcquireEngine(namespaceID, workflowID)
 	if err1 != nil {
 		return nil, h.convertError(err1)
 	}
+	defer release()
 
 	err2 := engine.ReplicateEventsV2(ctx, request)
 	if err2 != nil {
@@ -1115,10 +1345,11 @@ func (h *Handler) SyncShardStatus(ctx context.Context, request *historyservice.S
 	}
 
 	// shard ID is already provided in the request
-	engine, err := h.controller.GetEngineForShard(request.GetShardId())
+	engine, release, err := h.controller.AcquireEngineForShard(request.GetShardId())
 	if err != nil {
 		return nil, h.convertError(err)
 	}
+	defer release()
 
 	err = engine.SyncShardStatus(ctx, request)
 	if err != nil {
@@ -1151,10 +1382,11 @@ func (h *Handler) SyncActivity(ctx context.Context, request *historyservice.Sync
 	}
 
 	workflowID := request.GetWorkflowId()
-	engine, err := h.controller.GetEngine(namespaceID, workflowID)
+	engine, release, err := h.controller.AcquireEngine(namespaceID, workflowID)
 	if err != nil {
 		return nil, h.convertError(err)
 	}
+	defer release()
 
 	err = engine.SyncActivity(ctx, request)
 	if err != nil {
@@ -1181,11 +1413,12 @@ func (h *Handler) GetReplicationMessages(ctx context.Context, request *historyse
 		go func(token *replicationspb.ReplicationToken) {
 			defer wg.Done()
 
-			engine, err := h.controller.GetEngineForShard(token.GetShardId())
+			engine, release, err := h.controller.AcquireEngineForShard(token.GetShardId())
 			if err != nil {
 				h.GetLogger().Warn("History engine not found for shard", tag.Error(err))
 				return
 			}
+			defer release()
 			tasks, err := engine.GetReplicationMessages(
 				ctx,
 				request.GetClusterName(),
@@ -1216,6 +1449,64 @@ func (h *Handler) GetReplicationMessages(ctx context.Context, request *historyse
 	return &historyservice.GetReplicationMessagesResponse{ShardMessages: messagesByShard}, nil
 }
 
+// StreamReplicationMessages pushes replication tasks of a shard to remote cluster as soon as they are generated.
+// Remote cluster acks processed tasks and grants credit for the next batch by sending a new request on the stream.
+func (h *Handler) StreamReplicationMessages(server historyservice.HistoryService_StreamReplicationMessagesServer) (retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return errShuttingDown
+	}
+
+	scope := h.GetMetricsClient().Scope(metrics.HistoryStreamReplicationMessagesScope)
+	scope.IncCounter(metrics.ServiceRequests)
+	defer func() {
+		if retError != nil {
+			scope.IncCounter(metrics.ServiceFailures)
+		}
+	}()
+
+	shardID, ok := headers.GetReplicationShardID(server.Context())
+	if !ok {
+		return errShardIDNotSet
+	}
+
+	for {
+		request, err := server.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if request.GetToken().GetShardId() != shardID {
+			return errReplicationStreamShardMismatch
+		}
+
+		// engine is resolved for every batch so that stream fails as soon as shard is moved to another host
+		engine, release, err := h.controller.AcquireEngineForShard(shardID)
+		if err != nil {
+			return h.convertError(err)
+		}
+		messages, err := engine.PollReplicationMessages(
+			server.Context(),
+			request.GetClusterName(),
+			request.GetToken().GetLastProcessedMessageId(),
+			request.GetToken().GetLastRetrievedMessageId(),
+		)
+		release()
+		if err != nil {
+			return h.convertError(err)
+		}
+		if err := server.Send(&historyservice.StreamReplicationMessagesResponse{
+			Messages: messages,
+		}); err != nil {
+			return err
+		}
+	}
+}
+
 // GetDLQReplicationMessages is called by remote peers to get replicated messages for DLQ merging
 func (h *Handler) GetDLQReplicationMessages(ctx context.Context, request *historyservice.GetDLQReplicationMessagesRequest) (_ *historyservice.GetDLQReplicationMessagesResponse, retError error) {
 	defer log.CapturePanic(h.GetLogger(), &retError)
@@ -1247,7 +1538,7 @@ func (h *Handler) GetDLQReplicationMessages(ctx context.Context, request *histor
 			return
 		}
 
-		engine, err := h.controller.GetEngine(
+		engine, release, err := h.controller.AcquireEngine(
 			taskInfos[0].GetNamespaceId(),
 			taskInfos[0].GetWorkflowId(),
 		)
@@ -1255,6 +1546,7 @@ func (h *Handler) GetDLQReplicationMessages(ctx context.Context, request *histor
 			h.GetLogger().Warn("History engine not found for workflow ID.", tag.Error(err))
 			return
 		}
+		defer release()
 
 		tasks, err := engine.GetDLQReplicationMessages(
 			ctx,
@@ -1296,10 +1588,11 @@ func (h *Handler) ReapplyEvents(ctx context.Context, request *historyservice.Rea
 
 	namespaceID := request.GetNamespaceId()
 	workflowID := request.GetRequest().GetWorkflowExecution().GetWorkflowId()
-	engine, err := h.controller.GetEngine(namespaceID, workflowID)
+	engine, release, err := h.controller.AcquireEngine(namespaceID, workflowID)
 	if err != nil {
 		return nil, h.convertError(err)
 	}
+	defer release()
 	// deserialize history event object
 	historyEvents, err := h.GetPayloadSerializer().DeserializeEvents(&commonpb.DataBlob{
 		EncodingType: enumspb.ENCODING_TYPE_PROTO3,
@@ -1330,11 +1623,12 @@ func (h *Handler) GetDLQMessages(ctx context.Context, request *historyservice.Ge
 		return nil, errShuttingDown
 	}
 
-	engine, err := h.controller.GetEngineForShard(request.GetShardId())
+	engine, release, err := h.controller.AcquireEngineForShard(request.GetShardId())
 	if err != nil {
 		err = h.convertError(err)
 		return nil, err
 	}
+	defer release()
 
 	resp, err := engine.GetDLQMessages(ctx, request)
 	if err != nil {
@@ -1353,11 +1647,12 @@ func (h *Handler) PurgeDLQMessages(ctx context.Context, request *historyservice.
 		return nil, errShuttingDown
 	}
 
-	engine, err := h.controller.GetEngineForShard(request.GetShardId())
+	engine, release, err := h.controller.AcquireEngineForShard(request.GetShardId())
 	if err != nil {
 		err = h.convertError(err)
 		return nil, err
 	}
+	defer release()
 
 	err = engine.PurgeDLQMessages(ctx, request)
 	if err != nil {
@@ -1375,11 +1670,12 @@ func (h *Handler) MergeDLQMessages(ctx context.Context, request *historyservice.
 		return nil, errShuttingDown
 	}
 
-	engine, err := h.controller.GetEngineForShard(request.GetShardId())
+	engine, release, err := h.controller.AcquireEngineForShard(request.GetShardId())
 	if err != nil {
 		err = h.convertError(err)
 		return nil, err
 	}
+	defer release()
 
 	resp, err := engine.MergeDLQMessages(ctx, request)
 	if err != nil {
@@ -1401,11 +1697,12 @@ func (h *Handler) RefreshWorkflowTasks(ctx context.Context, request *historyserv
 	namespaceID := request.GetNamespaceId()
 	execution := request.GetRequest().GetExecution()
 	workflowID := execution.GetWorkflowId()
-	engine, err := h.controller.GetEngine(namespaceID, workflowID)
+	engine, release, err := h.controller.AcquireEngine(namespaceID, workflowID)
 	if err != nil {
 		err = h.convertError(err)
 		return nil, err
 	}
+	defer release()
 
 	err = engine.RefreshWorkflowTasks(
 		ctx,
@@ -1424,6 +1721,140 @@ func (h *Handler) RefreshWorkflowTasks(ctx context.Context, request *historyserv
 	return &historyservice.RefreshWorkflowTasksResponse{}, nil
 }
 
+// GenerateLastHistoryReplicationTasks is called by the force replication worker to replicate a workflow to remote clusters
+func (h *Handler) GenerateLastHistoryReplicationTasks(ctx context.Context, request *historyservice.GenerateLastHistoryReplicationTasksRequest) (_ *historyservice.GenerateLastHistoryReplicationTasksResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := request.GetNamespaceId()
+	if namespaceID == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+
+	execution := request.GetExecution()
+	engine, release, err := h.controller.AcquireEngine(namespaceID, execution.GetWorkflowId())
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	defer release()
+
+	err = engine.GenerateLastHistoryReplicationTasks(
+		ctx,
+		namespaceID,
+		commonpb.WorkflowExecution{
+			WorkflowId: execution.GetWorkflowId(),
+			RunId:      execution.GetRunId(),
+		},
+	)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	return &historyservice.GenerateLastHistoryReplicationTasksResponse{}, nil
+}
+
+// CompactWorkflowHistory is called by frontend to rewrite history event batches of a workflow into larger batches
+func (h *Handler) CompactWorkflowHistory(ctx context.Context, request *historyservice.CompactWorkflowHistoryRequest) (_ *historyservice.CompactWorkflowHistoryResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := request.GetNamespaceId()
+	if namespaceID == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+
+	execution := request.GetRequest().GetExecution()
+	engine, release, err := h.controller.AcquireEngine(namespaceID, execution.GetWorkflowId())
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	defer release()
+
+	resp, err := engine.CompactWorkflowHistory(
+		ctx,
+		namespaceID,
+		commonpb.WorkflowExecution{
+			WorkflowId: execution.GetWorkflowId(),
+			RunId:      execution.GetRunId(),
+		},
+	)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	return resp, nil
+}
+
+// DeleteWorkflowExecution is called by the worker service to delete a workflow execution of a deleted namespace
+func (h *Handler) DeleteWorkflowExecution(ctx context.Context, request *historyservice.DeleteWorkflowExecutionRequest) (_ *historyservice.DeleteWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := request.GetNamespaceId()
+	if namespaceID == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+
+	execution := request.GetWorkflowExecution()
+	engine, release, err := h.controller.AcquireEngine(namespaceID, execution.GetWorkflowId())
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	defer release()
+
+	err = engine.DeleteWorkflowExecution(
+		ctx,
+		namespaceID,
+		commonpb.WorkflowExecution{
+			WorkflowId: execution.GetWorkflowId(),
+			RunId:      execution.GetRunId(),
+		},
+	)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	return &historyservice.DeleteWorkflowExecutionResponse{}, nil
+}
+
+// GetReplicationStatus is called by frontend to report replication progress of shards owned by this host
+func (h *Handler) GetReplicationStatus(ctx context.Context, request *historyservice.GetReplicationStatusRequest) (_ *historyservice.GetReplicationStatusResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	resp := &historyservice.GetReplicationStatusResponse{}
+	for _, shardID := range request.GetShardIds() {
+		engine, release, err := h.controller.AcquireEngineForShard(shardID)
+		if err != nil {
+			h.GetLogger().Warn("History engine not found for shard", tag.ShardID(shardID), tag.Error(err))
+			continue
+		}
+		status, err := engine.GetReplicationStatus(ctx, request.GetRemoteClusters(), request.GetIncludeDlqDepth())
+		release()
+		if err != nil {
+			return nil, h.convertError(err)
+		}
+		resp.Shards = append(resp.Shards, status)
+	}
+	return resp, nil
+}
+
 // convertError is a helper method to convert ShardOwnershipLostError from persistence layer returned by various
 // HistoryEngine API calls to ShardOwnershipLost error return by HistoryService for client to be redirected to the
 // correct shard.
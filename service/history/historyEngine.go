@@ -28,6 +28,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"sort"
 	"sync/atomic"
 	"time"
 
@@ -63,9 +64,11 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/common/rpc/interceptor"
 	"go.temporal.io/server/common/searchattribute"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
+	"go.temporal.io/server/common/tracing"
 	"go.temporal.io/server/common/xdc"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/consts"
@@ -79,6 +82,8 @@ import (
 const (
 	conditionalRetryCount                     = 5
 	activityCancellationMsgActivityNotStarted = "ACTIVITY_ID_NOT_STARTED"
+	replicationDLQDepthPageSize               = 1000
+	historyCompactionReadPageSize             = 1000
 )
 
 type (
@@ -114,6 +119,8 @@ type (
 		rawMatchingClient         matchingservice.MatchingServiceClient
 		replicationDLQHandler     replicationDLQHandler
 		searchAttributesValidator *searchattribute.Validator
+		openWorkflowCounter       *openWorkflowCounter
+		isolationGroupLimiter     quotas.IsolationGroupLimiter
 	}
 )
 
@@ -129,6 +136,8 @@ func NewEngineWithShardContext(
 	replicationTaskFetchers ReplicationTaskFetchers,
 	rawMatchingClient matchingservice.MatchingServiceClient,
 	queueTaskProcessor queueTaskProcessor,
+	openWorkflowCounter *openWorkflowCounter,
+	isolationGroupLimiter quotas.IsolationGroupLimiter,
 ) *historyEngineImpl {
 	currentClusterName := shard.GetService().GetClusterMetadata().GetCurrentClusterName()
 
@@ -159,10 +168,12 @@ func NewEngineWithShardContext(
 			shard.GetConfig().ArchiveRequestRPS,
 			shard.GetService().GetArchiverProvider(),
 		),
-		publicClient:       publicClient,
-		matchingClient:     matching,
-		rawMatchingClient:  rawMatchingClient,
-		queueTaskProcessor: queueTaskProcessor,
+		publicClient:          publicClient,
+		matchingClient:        matching,
+		rawMatchingClient:     rawMatchingClient,
+		queueTaskProcessor:    queueTaskProcessor,
+		openWorkflowCounter:   openWorkflowCounter,
+		isolationGroupLimiter: isolationGroupLimiter,
 	}
 
 	historyEngImpl.txProcessor = newTransferQueueProcessor(shard, historyEngImpl, visibilityMgr, matching, historyClient, queueTaskProcessor, logger)
@@ -447,6 +458,24 @@ func (e *historyEngineImpl) generateFirstWorkflowTask(
 	return nil
 }
 
+// attachTraceContext attaches the trace context of the start request to the workflow task of a new
+// workflow, so the trace of the request continues with the dispatch of the first workflow task.
+func attachTraceContext(
+	ctx context.Context,
+	newWorkflow *persistence.WorkflowSnapshot,
+) {
+
+	traceContext := tracing.InjectTraceContext(ctx)
+	if traceContext == nil {
+		return
+	}
+	for _, task := range newWorkflow.TransferTasks {
+		if workflowTask, ok := task.(*persistence.WorkflowTask); ok {
+			workflowTask.TraceContext = traceContext
+		}
+	}
+}
+
 // StartWorkflowExecution starts a workflow execution
 func (e *historyEngineImpl) StartWorkflowExecution(
 	ctx context.Context,
@@ -466,6 +495,12 @@ func (e *historyEngineImpl) StartWorkflowExecution(
 	if err != nil {
 		return nil, err
 	}
+	// child workflows are started by transfer tasks of their parent, rejecting them would only make the task retry
+	if startRequest.ParentExecutionInfo == nil {
+		if err := e.checkOpenWorkflowCountLimit(ctx, namespaceEntry); err != nil {
+			return nil, err
+		}
+	}
 
 	workflowID := request.GetWorkflowId()
 	// grab the current context as a Lock, nothing more
@@ -519,6 +554,7 @@ func (e *historyEngineImpl) StartWorkflowExecution(
 	if len(newWorkflowEventsSeq) != 1 {
 		return nil, serviceerror.NewInternal("unable to create 1st event batch")
 	}
+	attachTraceContext(ctx, newWorkflow)
 
 	historySize, err := weContext.PersistWorkflowEvents(newWorkflowEventsSeq[0])
 	if err != nil {
@@ -585,6 +621,7 @@ func (e *historyEngineImpl) StartWorkflowExecution(
 	if err != nil {
 		return nil, err
 	}
+	e.openWorkflowCounter.increment(namespaceID)
 	return &historyservice.StartWorkflowExecutionResponse{
 		RunId: execution.GetRunId(),
 	}, nil
@@ -861,6 +898,10 @@ func (e *historyEngineImpl) queryDirectlyThroughMatching(
 			QueryRequest: queryRequest,
 			TaskQueue:    msResp.GetStickyTaskQueue(),
 		}
+		// fail fast when the sticky worker is gone instead of waiting for the whole sticky timeout
+		if dispatchDeadline := e.config.StickyQueryDispatchDeadline(queryRequest.GetNamespace()); dispatchDeadline > 0 {
+			stickyMatchingRequest.StickyDispatchTimeout = timestamp.DurationPtr(dispatchDeadline)
+		}
 
 		// using a clean new context in case customer provide a context which has
 		// a really short deadline, causing we clear the stickiness
@@ -877,7 +918,9 @@ func (e *historyEngineImpl) queryDirectlyThroughMatching(
 					QueryRejected: matchingResp.GetQueryRejected(),
 				}}, nil
 		}
-		if !common.IsContextDeadlineExceededErr(err) && !common.IsContextCanceledErr(err) {
+		if _, ok := err.(*serviceerrors.StickyWorkerUnavailable); ok {
+			scope.IncCounter(metrics.DirectQueryDispatchStickyMissCount)
+		} else if !common.IsContextDeadlineExceededErr(err) && !common.IsContextCanceledErr(err) {
 			e.logger.Error("query directly though matching on sticky failed, will not attempt query on non-sticky",
 				tag.WorkflowNamespace(queryRequest.GetNamespace()),
 				tag.WorkflowID(queryRequest.Execution.GetWorkflowId()),
@@ -926,9 +969,10 @@ func (e *historyEngineImpl) queryDirectlyThroughMatching(
 		tag.WorkflowNextEventID(msResp.GetNextEventId()))
 
 	nonStickyMatchingRequest := &matchingservice.QueryWorkflowRequest{
-		NamespaceId:  namespaceID,
-		QueryRequest: queryRequest,
-		TaskQueue:    msResp.TaskQueue,
+		NamespaceId:   namespaceID,
+		QueryRequest:  queryRequest,
+		TaskQueue:     msResp.TaskQueue,
+		WorkerBuildId: msResp.GetWorkerBuildId(),
 	}
 
 	nonStickyStopWatch := scope.StartTimer(metrics.DirectQueryDispatchNonStickyLatency)
@@ -1005,6 +1049,7 @@ func (e *historyEngineImpl) getMutableState(
 		VersionHistories: versionhistory.CopyVersionHistories(
 			mutableState.GetExecutionInfo().GetVersionHistories(),
 		),
+		WorkerBuildId: workflow.GetLastWorkerBuildID(executionInfo),
 	}, nil
 }
 
@@ -1048,6 +1093,12 @@ func (e *historyEngineImpl) DescribeMutableState(
 	}
 
 	response.DatabaseMutableState = mutableState.CloneToProto()
+	for _, ai := range mutableState.GetPendingActivityInfos() {
+		response.PendingActivities = append(response.PendingActivities, workflow.GetPendingActivityRetryInfo(ai))
+	}
+	sort.Slice(response.PendingActivities, func(i, j int) bool {
+		return response.PendingActivities[i].ScheduleId < response.PendingActivities[j].ScheduleId
+	})
 	return response, nil
 }
 
@@ -1356,6 +1407,14 @@ func (e *historyEngineImpl) RespondWorkflowTaskFailed(
 	return e.workflowTaskHandler.handleWorkflowTaskFailed(ctx, req)
 }
 
+// RecordWorkflowTaskHeartbeat extends the start to close timeout of a started workflow task
+func (e *historyEngineImpl) RecordWorkflowTaskHeartbeat(
+	ctx context.Context,
+	req *historyservice.RecordWorkflowTaskHeartbeatRequest,
+) error {
+	return e.workflowTaskHandler.handleWorkflowTaskHeartbeat(ctx, req)
+}
+
 // RespondActivityTaskCompleted completes an activity task.
 func (e *historyEngineImpl) RespondActivityTaskCompleted(
 	ctx context.Context,
@@ -1850,11 +1909,99 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 		return nil, err
 	}
 	namespaceID := namespaceEntry.GetInfo().Id
-	namespace := namespaceEntry.GetInfo().Name
 
 	sRequest := signalWithStartRequest.SignalWithStartRequest
+	runID, _, err := e.signalWithStartWorkflowExecution(
+		ctx,
+		namespaceEntry,
+		e.getStartRequest(namespaceID, sRequest),
+		[]*workflowservice.SignalWorkflowExecutionRequest{{
+			SignalName: sRequest.GetSignalName(),
+			Input:      sRequest.GetSignalInput(),
+			Identity:   sRequest.GetIdentity(),
+		}},
+		metrics.HistorySignalWithStartWorkflowExecutionScope,
+		"SignalWithStartWorkflowExecution",
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &historyservice.SignalWithStartWorkflowExecutionResponse{RunId: runID}, nil
+}
+
+// ExecuteMultiOperation starts the workflow if it is not running and delivers all signals
+// to the workflow as part of the same transaction.
+func (e *historyEngineImpl) ExecuteMultiOperation(
+	ctx context.Context,
+	multiOperationRequest *historyservice.ExecuteMultiOperationRequest,
+) (_ *historyservice.ExecuteMultiOperationResponse, retError error) {
+
+	namespaceEntry, err := e.getActiveNamespaceEntry(multiOperationRequest.GetNamespaceId())
+	if err != nil {
+		return nil, err
+	}
+	namespaceID := namespaceEntry.GetInfo().Id
+
+	startRequest := common.CreateHistoryStartWorkflowRequest(
+		namespaceID,
+		multiOperationRequest.GetStartRequest(),
+		nil,
+		e.shard.GetTimeSource().Now(),
+		0,
+	)
+	runID, started, err := e.signalWithStartWorkflowExecution(
+		ctx,
+		namespaceEntry,
+		startRequest,
+		multiOperationRequest.GetSignalRequests(),
+		metrics.HistoryExecuteMultiOperationScope,
+		"ExecuteMultiOperation",
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &historyservice.ExecuteMultiOperationResponse{
+		RunId:   runID,
+		Started: started,
+	}, nil
+}
+
+// signalWithStartWorkflowExecution delivers the signals to the current run of the workflow,
+// starting a new run first if the workflow is not running, and returns the ID of the run
+// the signals were delivered to along with whether that run was started by this call.
+func (e *historyEngineImpl) signalWithStartWorkflowExecution(
+	ctx context.Context,
+	namespaceEntry *cache.NamespaceCacheEntry,
+	startRequest *historyservice.StartWorkflowExecutionRequest,
+	signalRequests []*workflowservice.SignalWorkflowExecutionRequest,
+	scope int,
+	operation string,
+) (_ string, _ bool, retError error) {
+
+	namespaceID := namespaceEntry.GetInfo().Id
+	namespace := namespaceEntry.GetInfo().Name
+
+	request := startRequest.StartRequest
 	execution := commonpb.WorkflowExecution{
-		WorkflowId: sRequest.WorkflowId,
+		WorkflowId: request.GetWorkflowId(),
+	}
+
+	// signals are size checked for both the running and the newly started workflow
+	for _, signalRequest := range signalRequests {
+		if err := common.CheckEventBlobSizeLimit(
+			signalRequest.GetInput(),
+			e.config.BlobSizeLimitWarn(namespace),
+			e.config.BlobSizeLimitError(namespace),
+			e.config.BlobSizeAccountingMode(namespace),
+			namespaceID,
+			request.GetWorkflowId(),
+			"",
+			e.metricsScope(ctx).Tagged(metrics.CommandTypeTag(enumspb.COMMAND_TYPE_UNSPECIFIED.String())),
+			e.throttledLogger,
+			tag.BlobSizeViolationOperation(operation),
+		); err != nil {
+			return "", false, err
+		}
 	}
 
 	var prevMutableState workflow.MutableState
@@ -1877,7 +2024,7 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 				if _, ok := err1.(*serviceerror.NotFound); ok {
 					break
 				}
-				return nil, err1
+				return "", false, err1
 			}
 			// workflow exist but not running, will restart workflow then signal
 			if !mutableState.IsWorkflowExecutionRunning() {
@@ -1887,26 +2034,28 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 
 			executionInfo := mutableState.GetExecutionInfo()
 			maxAllowedSignals := e.config.MaximumSignalsPerExecution(namespace)
-			if maxAllowedSignals > 0 && int(executionInfo.SignalCount) >= maxAllowedSignals {
+			if maxAllowedSignals > 0 && int(executionInfo.SignalCount)+len(signalRequests) > maxAllowedSignals {
 				e.logger.Info("Execution limit reached for maximum signals", tag.WorkflowSignalCount(executionInfo.SignalCount),
 					tag.WorkflowID(execution.GetWorkflowId()),
 					tag.WorkflowRunID(execution.GetRunId()),
 					tag.WorkflowNamespaceID(namespaceID))
-				return nil, consts.ErrSignalsLimitExceeded
+				return "", false, consts.ErrSignalsLimitExceeded
 			}
 
-			if _, err := mutableState.AddWorkflowExecutionSignaled(
-				sRequest.GetSignalName(),
-				sRequest.GetSignalInput(),
-				sRequest.GetIdentity()); err != nil {
-				return nil, serviceerror.NewInternal("Unable to signal workflow execution.")
+			for _, signalRequest := range signalRequests {
+				if _, err := mutableState.AddWorkflowExecutionSignaled(
+					signalRequest.GetSignalName(),
+					signalRequest.GetInput(),
+					signalRequest.GetIdentity()); err != nil {
+					return "", false, serviceerror.NewInternal("Unable to signal workflow execution.")
+				}
 			}
 
 			// Create a transfer task to schedule a workflow task
 			if !mutableState.HasPendingWorkflowTask() {
 				_, err := mutableState.AddWorkflowTaskScheduledEvent(false)
 				if err != nil {
-					return nil, serviceerror.NewInternal("Failed to add workflow task scheduled event.")
+					return "", false, serviceerror.NewInternal("Failed to add workflow task scheduled event.")
 				}
 			}
 
@@ -1916,41 +2065,33 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 				if err == consts.ErrConflict {
 					continue Just_Signal_Loop
 				}
-				return nil, err
+				return "", false, err
 			}
-			return &historyservice.SignalWithStartWorkflowExecutionResponse{RunId: context.GetExecution().RunId}, nil
+			return context.GetExecution().RunId, false, nil
 		} // end for Just_Signal_Loop
 		if attempt == conditionalRetryCount+1 {
-			return nil, consts.ErrMaxAttemptsExceeded
+			return "", false, consts.ErrMaxAttemptsExceeded
 		}
 	} else {
 		if _, ok := err0.(*serviceerror.NotFound); !ok {
-			return nil, err0
+			return "", false, err0
 		}
 		// workflow not exist, will create workflow then signal
 	}
 
 	// Start workflow and signal
-	startRequest := e.getStartRequest(namespaceID, sRequest)
-	request := startRequest.StartRequest
-	e.overrideStartWorkflowExecutionRequest(request, metrics.HistorySignalWithStartWorkflowExecutionScope)
-	err = e.validateStartWorkflowExecutionRequest(ctx, request, namespace, "SignalWithStartWorkflowExecution")
+	e.overrideStartWorkflowExecutionRequest(request, scope)
+	err := e.validateStartWorkflowExecutionRequest(ctx, request, namespace, operation)
 	if err != nil {
-		return nil, err
+		return "", false, err
 	}
 
-	if err := common.CheckEventBlobSizeLimit(
-		sRequest.GetSignalInput().Size(),
-		e.config.BlobSizeLimitWarn(namespace),
-		e.config.BlobSizeLimitError(namespace),
-		namespaceID,
-		sRequest.GetWorkflowId(),
-		"",
-		e.metricsScope(ctx).Tagged(metrics.CommandTypeTag(enumspb.COMMAND_TYPE_UNSPECIFIED.String())),
-		e.throttledLogger,
-		tag.BlobSizeViolationOperation("SignalWithStartWorkflowExecution"),
-	); err != nil {
-		return nil, err
+	maxAllowedSignals := e.config.MaximumSignalsPerExecution(namespace)
+	if maxAllowedSignals > 0 && len(signalRequests) > maxAllowedSignals {
+		return "", false, consts.ErrSignalsLimitExceeded
+	}
+	if err := e.checkOpenWorkflowCountLimit(ctx, namespaceEntry); err != nil {
+		return "", false, err
 	}
 
 	workflowID := request.GetWorkflowId()
@@ -1961,7 +2102,7 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 		workflowID,
 	)
 	if err != nil {
-		return nil, err
+		return "", false, err
 	}
 	defer func() { currentRelease(retError) }()
 
@@ -1973,16 +2114,16 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 	clusterMetadata := e.shard.GetService().GetClusterMetadata()
 	mutableState, err := e.createMutableState(namespaceEntry, execution.GetRunId())
 	if err != nil {
-		return nil, err
+		return "", false, err
 	}
 
 	if prevMutableState != nil {
 		prevLastWriteVersion, err := prevMutableState.GetLastWriteVersion()
 		if err != nil {
-			return nil, err
+			return "", false, err
 		}
 		if prevLastWriteVersion > mutableState.GetCurrentVersion() {
-			return nil, serviceerror.NewNamespaceNotActive(
+			return "", false, serviceerror.NewNamespaceNotActive(
 				namespace,
 				clusterMetadata.GetCurrentClusterName(),
 				clusterMetadata.ClusterNameForFailoverVersion(prevLastWriteVersion),
@@ -1991,7 +2132,7 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 
 		err = e.applyWorkflowIDReusePolicyForSigWithStart(prevMutableState.GetExecutionState(), namespaceID, execution, request.WorkflowIdReusePolicy)
 		if err != nil {
-			return nil, err
+			return "", false, err
 		}
 	}
 
@@ -2001,15 +2142,17 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 		startRequest,
 	)
 	if err != nil {
-		return nil, serviceerror.NewInternal("Failed to add workflow execution started event.")
+		return "", false, serviceerror.NewInternal("Failed to add workflow execution started event.")
 	}
 
-	// Add signal event
-	if _, err := mutableState.AddWorkflowExecutionSignaled(
-		sRequest.GetSignalName(),
-		sRequest.GetSignalInput(),
-		sRequest.GetIdentity()); err != nil {
-		return nil, serviceerror.NewInternal("Failed to add workflow execution signaled event.")
+	// Add signal events
+	for _, signalRequest := range signalRequests {
+		if _, err := mutableState.AddWorkflowExecutionSignaled(
+			signalRequest.GetSignalName(),
+			signalRequest.GetInput(),
+			signalRequest.GetIdentity()); err != nil {
+			return "", false, serviceerror.NewInternal("Failed to add workflow execution signaled event.")
+		}
 	}
 
 	if err = e.generateFirstWorkflowTask(
@@ -2017,7 +2160,7 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 		startRequest.ParentExecutionInfo,
 		startEvent,
 	); err != nil {
-		return nil, err
+		return "", false, err
 	}
 
 	context = workflow.NewContext(namespaceID, execution, e.shard, e.logger)
@@ -2028,15 +2171,16 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 		workflow.TransactionPolicyActive,
 	)
 	if err != nil {
-		return nil, err
+		return "", false, err
 	}
 	if len(newWorkflowEventsSeq) != 1 {
-		return nil, serviceerror.NewInternal("unable to create 1st event batch")
+		return "", false, serviceerror.NewInternal("unable to create 1st event batch")
 	}
+	attachTraceContext(ctx, newWorkflow)
 
 	historySize, err := context.PersistWorkflowEvents(newWorkflowEventsSeq[0])
 	if err != nil {
-		return nil, err
+		return "", false, err
 	}
 
 	createMode := persistence.CreateWorkflowModeBrandNew
@@ -2047,7 +2191,7 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 		prevRunID = prevMutableState.GetExecutionState().GetRunId()
 		prevLastWriteVersion, err = prevMutableState.GetLastWriteVersion()
 		if err != nil {
-			return nil, err
+			return "", false, err
 		}
 	}
 	err = context.CreateWorkflowExecution(
@@ -2062,20 +2206,17 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 
 	if t, ok := err.(*persistence.WorkflowExecutionAlreadyStartedError); ok {
 		if t.StartRequestID == request.GetRequestId() {
-			return &historyservice.SignalWithStartWorkflowExecutionResponse{
-				RunId: t.RunID,
-			}, nil
+			return t.RunID, true, nil
 			// delete history is expected here because duplicate start request will create history with different rid
 		}
-		return nil, err
+		return "", false, err
 	}
 
 	if err != nil {
-		return nil, err
+		return "", false, err
 	}
-	return &historyservice.SignalWithStartWorkflowExecutionResponse{
-		RunId: execution.RunId,
-	}, nil
+	e.openWorkflowCounter.increment(namespaceID)
+	return execution.RunId, true, nil
 }
 
 // RemoveSignalMutableState remove the signal request id in signal_requested for deduplicate
@@ -2575,6 +2716,39 @@ func (e *historyEngineImpl) NotifyNewVisibilityTasks(
 	}
 }
 
+// checkOpenWorkflowCountLimit rejects starting a new workflow execution once the namespace
+// has reached its limit of concurrently open executions. The limit is best effort: the count
+// comes from visibility and is cached, and starts are allowed when the count is unavailable.
+// The max pending workflows quota of the namespace takes precedence over dynamic config.
+func (e *historyEngineImpl) checkOpenWorkflowCountLimit(
+	ctx context.Context,
+	namespaceEntry *cache.NamespaceCacheEntry,
+) error {
+
+	namespace := namespaceEntry.GetInfo().Name
+	limit := namespaceEntry.GetConfig().GetQuotas().GetMaxPendingWorkflows()
+	if limit <= 0 {
+		limit = int64(e.config.OpenWorkflowCountLimit(namespace))
+	}
+	if limit <= 0 {
+		return nil
+	}
+
+	count, err := e.openWorkflowCounter.getCount(namespaceEntry.GetInfo().Id, namespace)
+	if err != nil {
+		e.throttledLogger.Warn("Unable to get open workflow count, skipping limit check.",
+			tag.WorkflowNamespace(namespace),
+			tag.Error(err),
+		)
+		return nil
+	}
+	if count >= limit {
+		e.metricsScope(ctx).IncCounter(metrics.OpenWorkflowCountLimitExceededCount)
+		return consts.ErrOpenWorkflowCountExceedsLimit
+	}
+	return nil
+}
+
 func (e *historyEngineImpl) validateStartWorkflowExecutionRequest(
 	ctx context.Context,
 	request *workflowservice.StartWorkflowExecutionRequest,
@@ -2585,6 +2759,7 @@ func (e *historyEngineImpl) validateStartWorkflowExecutionRequest(
 	maxIDLengthLimit := e.config.MaxIDLengthLimit()
 	blobSizeLimitError := e.config.BlobSizeLimitError(namespace)
 	blobSizeLimitWarn := e.config.BlobSizeLimitWarn(namespace)
+	blobSizeAccountingMode := e.config.BlobSizeAccountingMode(namespace)
 	memoSizeLimitError := e.config.MemoSizeLimitError(namespace)
 	memoSizeLimitWarn := e.config.MemoSizeLimitWarn(namespace)
 
@@ -2631,9 +2806,10 @@ func (e *historyEngineImpl) validateStartWorkflowExecutionRequest(
 	}
 
 	if err := common.CheckEventBlobSizeLimit(
-		request.GetInput().Size(),
+		request.GetInput(),
 		blobSizeLimitWarn,
 		blobSizeLimitError,
+		blobSizeAccountingMode,
 		namespace,
 		request.GetWorkflowId(),
 		"",
@@ -2645,9 +2821,10 @@ func (e *historyEngineImpl) validateStartWorkflowExecutionRequest(
 	}
 
 	if err := common.CheckEventBlobSizeLimit(
-		request.GetMemo().Size(),
+		request.GetMemo(),
 		memoSizeLimitWarn,
 		memoSizeLimitError,
+		blobSizeAccountingMode,
 		namespace,
 		request.GetWorkflowId(),
 		"",
@@ -2775,7 +2952,7 @@ func (e *historyEngineImpl) getStartRequest(
 		Header:                   request.GetHeader(),
 	}
 
-	return common.CreateHistoryStartWorkflowRequest(namespaceID, req, nil, e.shard.GetTimeSource().Now())
+	return common.CreateHistoryStartWorkflowRequest(namespaceID, req, nil, e.shard.GetTimeSource().Now(), 0)
 }
 
 // for startWorkflowExecution & signalWithStart to handle workflow reuse policy
@@ -2860,6 +3037,32 @@ func (e *historyEngineImpl) GetReplicationMessages(
 	queryMessageID int64,
 ) (*replicationspb.ReplicationMessages, error) {
 
+	e.updateClusterReplicationLevel(pollingCluster, ackMessageID)
+	return e.paginateReplicationMessages(ctx, pollingCluster, queryMessageID)
+}
+
+// PollReplicationMessages is the stream counterpart of GetReplicationMessages: it acks processed messages
+// and waits until new replication tasks are generated or the stream heartbeat interval elapses.
+func (e *historyEngineImpl) PollReplicationMessages(
+	ctx context.Context,
+	pollingCluster string,
+	ackMessageID int64,
+	queryMessageID int64,
+) (*replicationspb.ReplicationMessages, error) {
+
+	e.updateClusterReplicationLevel(pollingCluster, ackMessageID)
+	e.replicatorProcessor.waitForNewTasks(ctx, queryMessageID, e.config.ReplicationStreamHeartbeatInterval())
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return e.paginateReplicationMessages(ctx, pollingCluster, queryMessageID)
+}
+
+func (e *historyEngineImpl) updateClusterReplicationLevel(
+	pollingCluster string,
+	ackMessageID int64,
+) {
+
 	if ackMessageID != persistence.EmptyQueueMessageID {
 		if err := e.shard.UpdateClusterReplicationLevel(
 			pollingCluster,
@@ -2868,6 +3071,13 @@ func (e *historyEngineImpl) GetReplicationMessages(
 			e.logger.Error("error updating replication level for shard", tag.Error(err), tag.OperationFailed)
 		}
 	}
+}
+
+func (e *historyEngineImpl) paginateReplicationMessages(
+	ctx context.Context,
+	pollingCluster string,
+	queryMessageID int64,
+) (*replicationspb.ReplicationMessages, error) {
 
 	replicationMessages, err := e.replicatorProcessor.paginateTasks(
 		ctx,
@@ -2900,6 +3110,73 @@ func (e *historyEngineImpl) GetDLQReplicationMessages(
 	return tasks, nil
 }
 
+func (e *historyEngineImpl) GetReplicationStatus(
+	ctx context.Context,
+	remoteClusters []string,
+	includeDLQDepth bool,
+) (*historyservice.ShardReplicationStatus, error) {
+
+	if len(remoteClusters) == 0 {
+		for clusterName, info := range e.clusterMetadata.GetAllClusterInfo() {
+			if clusterName != e.currentClusterName && info.Enabled {
+				remoteClusters = append(remoteClusters, clusterName)
+			}
+		}
+	}
+
+	status := &historyservice.ShardReplicationStatus{
+		ShardId:              e.shard.GetShardID(),
+		MaxReplicationTaskId: e.shard.GetTransferMaxReadLevel(),
+		RemoteClusters:       make(map[string]*historyservice.ShardReplicationStatusPerCluster, len(remoteClusters)),
+	}
+	now := e.shard.GetCurrentTime(e.currentClusterName)
+	for _, clusterName := range remoteClusters {
+		clusterStatus := &historyservice.ShardReplicationStatusPerCluster{
+			AckedTaskId: e.shard.GetClusterReplicationLevel(clusterName),
+		}
+		if remoteTime := e.shard.GetCurrentTime(clusterName); !remoteTime.IsZero() {
+			lag := now.Sub(remoteTime)
+			clusterStatus.ReplicationLag = &lag
+		}
+		if includeDLQDepth {
+			depth, err := e.getReplicationDLQDepth(clusterName)
+			if err != nil {
+				return nil, err
+			}
+			clusterStatus.DlqDepth = depth
+		}
+		status.RemoteClusters[clusterName] = clusterStatus
+	}
+	return status, nil
+}
+
+// getReplicationDLQDepth counts replication tasks from source cluster which are in the shard DLQ.
+func (e *historyEngineImpl) getReplicationDLQDepth(
+	sourceCluster string,
+) (int64, error) {
+
+	var depth int64
+	request := &persistence.GetReplicationTasksFromDLQRequest{
+		SourceClusterName: sourceCluster,
+		GetReplicationTasksRequest: persistence.GetReplicationTasksRequest{
+			MinTaskID: e.shard.GetReplicatorDLQAckLevel(sourceCluster),
+			MaxTaskID: common.EndMessageID,
+			BatchSize: replicationDLQDepthPageSize,
+		},
+	}
+	for {
+		resp, err := e.shard.GetExecutionManager().GetReplicationTasksFromDLQ(request)
+		if err != nil {
+			return 0, err
+		}
+		depth += int64(len(resp.Tasks))
+		if len(resp.NextPageToken) == 0 {
+			return depth, nil
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+}
+
 func (e *historyEngineImpl) ReapplyEvents(
 	ctx context.Context,
 	namespaceUUID string,
@@ -3165,6 +3442,276 @@ func (e *historyEngineImpl) RefreshWorkflowTasks(
 	return nil
 }
 
+func (e *historyEngineImpl) GenerateLastHistoryReplicationTasks(
+	ctx context.Context,
+	namespaceUUID string,
+	execution commonpb.WorkflowExecution,
+) (retError error) {
+
+	namespaceEntry, err := e.getActiveNamespaceEntry(namespaceUUID)
+	if err != nil {
+		return err
+	}
+	if namespaceEntry.GetReplicationPolicy() != cache.ReplicationPolicyMultiCluster {
+		return consts.ErrNamespaceNotReplicated
+	}
+	namespaceID := namespaceEntry.GetInfo().Id
+
+	context, release, err := e.historyCache.GetOrCreateWorkflowExecution(
+		ctx,
+		namespaceID,
+		execution,
+		workflow.CallerTypeAPI,
+	)
+	if err != nil {
+		return err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := context.LoadWorkflowExecution()
+	if err != nil {
+		return err
+	}
+
+	now := e.shard.GetTimeSource().Now()
+	task, err := mutableState.GenerateLastHistoryReplicationTasks(now)
+	if err != nil {
+		return err
+	}
+
+	return e.shard.AddTasks(&persistence.AddTasksRequest{
+		// RangeID is set by shard
+
+		NamespaceID: namespaceID,
+		WorkflowID:  execution.GetWorkflowId(),
+		RunID:       mutableState.GetExecutionState().GetRunId(),
+
+		ReplicationTasks: []persistence.Task{task},
+	})
+}
+
+func (e *historyEngineImpl) CompactWorkflowHistory(
+	ctx context.Context,
+	namespaceUUID string,
+	execution commonpb.WorkflowExecution,
+) (_ *historyservice.CompactWorkflowHistoryResponse, retError error) {
+
+	namespaceEntry, err := e.getActiveNamespaceEntry(namespaceUUID)
+	if err != nil {
+		return nil, err
+	}
+	// remote clusters may request history from any event ID, which are not guaranteed to remain batch boundaries
+	if namespaceEntry.GetReplicationPolicy() == cache.ReplicationPolicyMultiCluster {
+		return nil, consts.ErrCompactReplicatedHistory
+	}
+	namespaceID := namespaceEntry.GetInfo().Id
+	namespace := namespaceEntry.GetInfo().Name
+
+	// workflow lock is held during compaction so no history events can be appended concurrently
+	context, release, err := e.historyCache.GetOrCreateWorkflowExecution(
+		ctx,
+		namespaceID,
+		execution,
+		workflow.CallerTypeAPI,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := context.LoadWorkflowExecution()
+	if err != nil {
+		return nil, err
+	}
+
+	branchToken, err := mutableState.GetCurrentBranchToken()
+	if err != nil {
+		return nil, err
+	}
+	nextEventID := mutableState.GetNextEventID()
+	pinnedEventIDs, err := e.getHistoryCompactionPinnedEventIDs(mutableState, branchToken)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := e.shard.GetHistoryManager().CompactHistoryBranch(&persistence.CompactHistoryBranchRequest{
+		ShardID:       e.shard.GetShardID(),
+		BranchToken:   branchToken,
+		MaxEventID:    nextEventID,
+		PinnedNodeIDs: pinnedEventIDs,
+		MaxEventCount: e.config.HistoryCompactionMaxBatchEventCount(namespace),
+		MaxSize:       e.config.HistoryCompactionMaxBatchSize(namespace),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	e.logger.Info("Compacted workflow history.",
+		tag.WorkflowNamespaceID(namespaceID),
+		tag.WorkflowID(execution.GetWorkflowId()),
+		tag.WorkflowRunID(mutableState.GetExecutionState().GetRunId()),
+		tag.Counter(resp.NodeCountBefore-resp.NodeCountAfter),
+	)
+	return &historyservice.CompactWorkflowHistoryResponse{
+		BatchCountBefore: int32(resp.NodeCountBefore),
+		BatchCountAfter:  int32(resp.NodeCountAfter),
+	}, nil
+}
+
+// getHistoryCompactionPinnedEventIDs returns IDs of the first events of batches which must not be merged
+// with preceding batches, since history is read starting from those events.
+func (e *historyEngineImpl) getHistoryCompactionPinnedEventIDs(
+	mutableState workflow.MutableState,
+	branchToken []byte,
+) ([]int64, error) {
+
+	executionInfo := mutableState.GetExecutionInfo()
+	// event batch IDs referenced by mutable state, used to load events
+	pinnedEventIDs := []int64{
+		common.FirstEventID,
+		executionInfo.LastFirstEventId,
+	}
+	if executionInfo.CompletionEventBatchId != common.EmptyEventID {
+		pinnedEventIDs = append(pinnedEventIDs, executionInfo.CompletionEventBatchId)
+	}
+	// sticky workflow task only contains events after previous started event
+	if previousStartedEventID := mutableState.GetPreviousStartedEventID(); previousStartedEventID != common.EmptyEventID {
+		pinnedEventIDs = append(pinnedEventIDs, previousStartedEventID+1)
+	}
+	for _, ai := range mutableState.GetPendingActivityInfos() {
+		pinnedEventIDs = append(pinnedEventIDs, ai.ScheduledEventBatchId)
+	}
+	for _, ci := range mutableState.GetPendingChildExecutionInfos() {
+		pinnedEventIDs = append(pinnedEventIDs, ci.InitiatedEventBatchId)
+	}
+	for _, rci := range mutableState.GetPendingRequestCancelExternalInfos() {
+		pinnedEventIDs = append(pinnedEventIDs, rci.InitiatedEventBatchId)
+	}
+	for _, si := range mutableState.GetPendingSignalExternalInfos() {
+		pinnedEventIDs = append(pinnedEventIDs, si.InitiatedEventBatchId)
+	}
+
+	// workflow can be reset to any completed / failed / timed out workflow task,
+	// which forks history right before the batch of that event
+	var pageToken []byte
+	for doContinue := true; doContinue; doContinue = len(pageToken) > 0 {
+		resp, err := e.shard.GetHistoryManager().ReadHistoryBranchByBatch(&persistence.ReadHistoryBranchRequest{
+			ShardID:       e.shard.GetShardID(),
+			BranchToken:   branchToken,
+			MinEventID:    common.FirstEventID,
+			MaxEventID:    mutableState.GetNextEventID(),
+			PageSize:      historyCompactionReadPageSize,
+			NextPageToken: pageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, batch := range resp.History {
+			switch batch.Events[0].GetEventType() {
+			case enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED,
+				enumspb.EVENT_TYPE_WORKFLOW_TASK_FAILED,
+				enumspb.EVENT_TYPE_WORKFLOW_TASK_TIMED_OUT:
+				pinnedEventIDs = append(pinnedEventIDs, batch.Events[0].GetEventId())
+			}
+		}
+		pageToken = resp.NextPageToken
+	}
+	return pinnedEventIDs, nil
+}
+
+// DeleteWorkflowExecution deletes visibility record, mutable state and history of a workflow execution
+// regardless of its state, it is used to reclaim resources of deleted namespaces.
+func (e *historyEngineImpl) DeleteWorkflowExecution(
+	ctx context.Context,
+	namespaceUUID string,
+	execution commonpb.WorkflowExecution,
+) (retError error) {
+
+	namespaceID, err := validateNamespaceUUID(namespaceUUID)
+	if err != nil {
+		return err
+	}
+	// namespace doesn't need to be active, resources are reclaimed in all clusters
+	if _, err := e.shard.GetNamespaceCache().GetNamespaceByID(namespaceID); err != nil {
+		return err
+	}
+
+	weContext, release, err := e.historyCache.GetOrCreateWorkflowExecution(
+		ctx,
+		namespaceID,
+		execution,
+		workflow.CallerTypeAPI,
+	)
+	if err != nil {
+		return err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := weContext.LoadWorkflowExecution()
+	if err != nil {
+		return err
+	}
+	// running workflows can only be closed by the cluster where namespace is active,
+	// in other clusters they are closed by replication before they can be deleted
+	if mutableState.IsWorkflowExecutionRunning() {
+		return consts.ErrDeleteRunningWorkflow
+	}
+	workflowID := execution.GetWorkflowId()
+	runID := mutableState.GetExecutionState().GetRunId()
+	lastWriteVersion, err := mutableState.GetLastWriteVersion()
+	if err != nil {
+		return err
+	}
+
+	// visibility record is deleted first, so that a retried deletion can still find the execution
+	// through visibility if one of the following steps fails
+	if err := e.shard.AddTasks(&persistence.AddTasksRequest{
+		// RangeID is set by shard
+		NamespaceID: namespaceID,
+		WorkflowID:  workflowID,
+		RunID:       runID,
+		VisibilityTasks: []persistence.Task{&persistence.DeleteExecutionVisibilityTask{
+			// TaskID is set by shard
+			VisibilityTimestamp: e.shard.GetTimeSource().Now(),
+			Version:             lastWriteVersion,
+		}},
+	}); err != nil {
+		return err
+	}
+	if err := e.shard.GetExecutionManager().DeleteCurrentWorkflowExecution(&persistence.DeleteCurrentWorkflowExecutionRequest{
+		NamespaceID: namespaceID,
+		WorkflowID:  workflowID,
+		RunID:       runID,
+	}); err != nil {
+		return err
+	}
+	if err := e.shard.GetExecutionManager().DeleteWorkflowExecution(&persistence.DeleteWorkflowExecutionRequest{
+		NamespaceID: namespaceID,
+		WorkflowID:  workflowID,
+		RunID:       runID,
+	}); err != nil {
+		return err
+	}
+	// branches forked by resets are deleted as well
+	for _, versionHistory := range mutableState.GetExecutionInfo().GetVersionHistories().GetHistories() {
+		if err := e.shard.GetHistoryManager().DeleteHistoryBranch(&persistence.DeleteHistoryBranchRequest{
+			BranchToken: versionHistory.GetBranchToken(),
+			ShardID:     e.shard.GetShardID(),
+		}); err != nil {
+			return err
+		}
+	}
+
+	// force accesses of mutable state to read database, which doesn't have the execution anymore
+	weContext.Clear()
+	e.logger.Info("Deleted workflow execution.",
+		tag.WorkflowNamespaceID(namespaceID),
+		tag.WorkflowID(workflowID),
+		tag.WorkflowRunID(runID),
+	)
+	return nil
+}
+
 func (e *historyEngineImpl) loadWorkflowOnce(
 	ctx context.Context,
 	namespaceID string,
@@ -46,6 +46,7 @@ import (
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/common/primitives/timestamp"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
 	"go.temporal.io/server/service/history/configs"
@@ -60,11 +61,13 @@ import (
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility"
 )
 
 type (
@@ -141,20 +144,21 @@ func (s *engine2Suite) SetupTest() {
 
 	historyCache := workflow.NewCache(s.mockShard)
 	h := &historyEngineImpl{
-		currentClusterName: s.mockShard.GetClusterMetadata().GetCurrentClusterName(),
-		shard:              s.mockShard,
-		clusterMetadata:    s.mockClusterMetadata,
-		executionManager:   s.mockExecutionMgr,
-		historyCache:       historyCache,
-		logger:             s.logger,
-		throttledLogger:    s.logger,
-		metricsClient:      metrics.NewClient(tally.NoopScope, metrics.History),
-		tokenSerializer:    common.NewProtoTaskTokenSerializer(),
-		config:             s.config,
-		timeSource:         s.mockShard.GetTimeSource(),
-		eventNotifier:      events.NewNotifier(clock.NewRealTimeSource(), metrics.NewClient(tally.NoopScope, metrics.History), func(string, string) int32 { return 1 }),
-		txProcessor:        s.mockTxProcessor,
-		timerProcessor:     s.mockTimerProcessor,
+		currentClusterName:  s.mockShard.GetClusterMetadata().GetCurrentClusterName(),
+		shard:               s.mockShard,
+		clusterMetadata:     s.mockClusterMetadata,
+		executionManager:    s.mockExecutionMgr,
+		historyCache:        historyCache,
+		logger:              s.logger,
+		throttledLogger:     s.logger,
+		metricsClient:       metrics.NewClient(tally.NoopScope, metrics.History),
+		tokenSerializer:     common.NewProtoTaskTokenSerializer(),
+		config:              s.config,
+		timeSource:          s.mockShard.GetTimeSource(),
+		eventNotifier:       events.NewNotifier(clock.NewRealTimeSource(), metrics.NewClient(tally.NoopScope, metrics.History), func(string, string) int32 { return 1 }),
+		txProcessor:         s.mockTxProcessor,
+		timerProcessor:      s.mockTimerProcessor,
+		openWorkflowCounter: newOpenWorkflowCounter(s.mockShard.Resource.VisibilityMgr, s.mockShard.GetTimeSource(), s.config.OpenWorkflowCountRefreshInterval),
 	}
 	s.mockShard.SetEngine(h)
 	h.workflowTaskHandler = newWorkflowTaskHandlerCallback(h)
@@ -886,6 +890,147 @@ func (s *engine2Suite) TestStartWorkflowExecution_BrandNew() {
 	s.NotNil(resp.RunId)
 }
 
+func (s *engine2Suite) TestStartWorkflowExecution_StartDelay() {
+	namespaceID := tests.NamespaceID
+	startDelay := time.Hour
+
+	s.mockHistoryMgr.EXPECT().AppendHistoryNodes(gomock.Any()).Return(&persistence.AppendHistoryNodesResponse{Size: 0}, nil)
+	var createRequest *persistence.CreateWorkflowExecutionRequest
+	s.mockExecutionMgr.EXPECT().CreateWorkflowExecution(gomock.Any()).DoAndReturn(
+		func(request *persistence.CreateWorkflowExecutionRequest) (*persistence.CreateWorkflowExecutionResponse, error) {
+			createRequest = request
+			return &persistence.CreateWorkflowExecutionResponse{}, nil
+		})
+
+	resp, err := s.historyEngine.StartWorkflowExecution(context.Background(), &historyservice.StartWorkflowExecutionRequest{
+		Attempt:                  1,
+		NamespaceId:              namespaceID,
+		FirstWorkflowTaskBackoff: &startDelay,
+		StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+			Namespace:                namespaceID,
+			WorkflowId:               "workflowID",
+			WorkflowType:             &commonpb.WorkflowType{Name: "workflowType"},
+			TaskQueue:                &taskqueuepb.TaskQueue{Name: "testTaskQueue"},
+			WorkflowExecutionTimeout: timestamp.DurationPtr(20 * time.Second),
+			WorkflowRunTimeout:       timestamp.DurationPtr(1 * time.Second),
+			WorkflowTaskTimeout:      timestamp.DurationPtr(2 * time.Second),
+			Identity:                 "testIdentity",
+			RequestId:                uuid.New(),
+		},
+	})
+	s.NoError(err)
+	s.NotEmpty(resp.RunId)
+
+	// the first workflow task is scheduled by a backoff timer instead of a transfer task
+	snapshot := createRequest.NewWorkflowSnapshot
+	s.Equal(common.EmptyEventID, snapshot.ExecutionInfo.WorkflowTaskScheduleId)
+	var backoffTimers []*persistence.WorkflowBackoffTimerTask
+	for _, task := range snapshot.TimerTasks {
+		if backoffTimer, ok := task.(*persistence.WorkflowBackoffTimerTask); ok {
+			backoffTimers = append(backoffTimers, backoffTimer)
+		}
+	}
+	s.Len(backoffTimers, 1)
+	s.Equal(enumsspb.WORKFLOW_BACKOFF_TYPE_DELAY_START, backoffTimers[0].WorkflowBackoffType)
+	s.Equal(startDelay, backoffTimers[0].VisibilityTimestamp.Sub(timestamp.TimeValue(snapshot.ExecutionInfo.StartTime)))
+}
+
+func (s *engine2Suite) TestStartWorkflowExecution_OpenWorkflowCountLimit() {
+	namespaceID := tests.NamespaceID
+	s.config.OpenWorkflowCountLimit = dynamicconfig.GetIntPropertyFilteredByNamespace(2)
+	newStartRequest := func(workflowID string) *historyservice.StartWorkflowExecutionRequest {
+		return &historyservice.StartWorkflowExecutionRequest{
+			Attempt:     1,
+			NamespaceId: namespaceID,
+			StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+				Namespace:                namespaceID,
+				WorkflowId:               workflowID,
+				WorkflowType:             &commonpb.WorkflowType{Name: "workflowType"},
+				TaskQueue:                &taskqueuepb.TaskQueue{Name: "testTaskQueue"},
+				WorkflowExecutionTimeout: timestamp.DurationPtr(20 * time.Second),
+				WorkflowRunTimeout:       timestamp.DurationPtr(1 * time.Second),
+				WorkflowTaskTimeout:      timestamp.DurationPtr(2 * time.Second),
+				Identity:                 "testIdentity",
+				RequestId:                uuid.New(),
+			},
+		}
+	}
+
+	// the count is loaded once and then maintained locally until the next refresh
+	s.mockShard.Resource.VisibilityMgr.EXPECT().CountWorkflowExecutions(&visibility.CountWorkflowExecutionsRequest{
+		NamespaceID: namespaceID,
+		Query:       openWorkflowCountQuery,
+	}).Return(&visibility.CountWorkflowExecutionsResponse{Count: 1}, nil)
+	s.mockHistoryMgr.EXPECT().AppendHistoryNodes(gomock.Any()).Return(&persistence.AppendHistoryNodesResponse{Size: 0}, nil)
+	s.mockExecutionMgr.EXPECT().CreateWorkflowExecution(gomock.Any()).Return(&persistence.CreateWorkflowExecutionResponse{}, nil)
+
+	resp, err := s.historyEngine.StartWorkflowExecution(context.Background(), newStartRequest("workflowID1"))
+	s.NoError(err)
+	s.NotEmpty(resp.RunId)
+
+	_, err = s.historyEngine.StartWorkflowExecution(context.Background(), newStartRequest("workflowID2"))
+	s.Equal(consts.ErrOpenWorkflowCountExceedsLimit, err)
+
+	// child workflows are not subject to the limit
+	childRequest := newStartRequest("workflowID3")
+	childRequest.ParentExecutionInfo = &workflowspb.ParentExecutionInfo{
+		NamespaceId: namespaceID,
+		Namespace:   tests.Namespace,
+		Execution:   &commonpb.WorkflowExecution{WorkflowId: "parentID", RunId: uuid.New()},
+		InitiatedId: 5,
+	}
+	s.mockHistoryMgr.EXPECT().AppendHistoryNodes(gomock.Any()).Return(&persistence.AppendHistoryNodesResponse{Size: 0}, nil)
+	s.mockExecutionMgr.EXPECT().CreateWorkflowExecution(gomock.Any()).Return(&persistence.CreateWorkflowExecutionResponse{}, nil)
+	_, err = s.historyEngine.StartWorkflowExecution(context.Background(), childRequest)
+	s.NoError(err)
+}
+
+func (s *engine2Suite) TestStartWorkflowExecution_OpenWorkflowCountLimit_CountUnavailable() {
+	namespaceID := tests.NamespaceID
+	s.config.OpenWorkflowCountLimit = dynamicconfig.GetIntPropertyFilteredByNamespace(1)
+
+	s.mockShard.Resource.VisibilityMgr.EXPECT().CountWorkflowExecutions(gomock.Any()).Return(nil, visibility.OperationNotSupportedErr)
+	s.mockHistoryMgr.EXPECT().AppendHistoryNodes(gomock.Any()).Return(&persistence.AppendHistoryNodesResponse{Size: 0}, nil)
+	s.mockExecutionMgr.EXPECT().CreateWorkflowExecution(gomock.Any()).Return(&persistence.CreateWorkflowExecutionResponse{}, nil)
+
+	resp, err := s.historyEngine.StartWorkflowExecution(context.Background(), &historyservice.StartWorkflowExecutionRequest{
+		Attempt:     1,
+		NamespaceId: namespaceID,
+		StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+			Namespace:                namespaceID,
+			WorkflowId:               "workflowID",
+			WorkflowType:             &commonpb.WorkflowType{Name: "workflowType"},
+			TaskQueue:                &taskqueuepb.TaskQueue{Name: "testTaskQueue"},
+			WorkflowExecutionTimeout: timestamp.DurationPtr(20 * time.Second),
+			WorkflowRunTimeout:       timestamp.DurationPtr(1 * time.Second),
+			WorkflowTaskTimeout:      timestamp.DurationPtr(2 * time.Second),
+			Identity:                 "testIdentity",
+			RequestId:                uuid.New(),
+		},
+	})
+	s.NoError(err)
+	s.NotEmpty(resp.RunId)
+}
+
+func (s *engine2Suite) TestCheckOpenWorkflowCountLimit_NamespaceQuota() {
+	s.config.OpenWorkflowCountLimit = dynamicconfig.GetIntPropertyFilteredByNamespace(10)
+	namespaceEntry := cache.NewLocalNamespaceCacheEntryForTest(
+		&persistencespb.NamespaceInfo{Id: tests.NamespaceID, Name: tests.Namespace},
+		&persistencespb.NamespaceConfig{Quotas: &persistencespb.NamespaceQuotas{MaxPendingWorkflows: 2}},
+		cluster.TestCurrentClusterName,
+		nil,
+	)
+
+	s.mockShard.Resource.VisibilityMgr.EXPECT().CountWorkflowExecutions(gomock.Any()).Return(&visibility.CountWorkflowExecutionsResponse{Count: 2}, nil)
+	err := s.historyEngine.checkOpenWorkflowCountLimit(context.Background(), namespaceEntry)
+	s.Equal(consts.ErrOpenWorkflowCountExceedsLimit, err)
+
+	// dynamic config is used if the quota is not set
+	namespaceEntry.GetConfig().Quotas.MaxPendingWorkflows = 0
+	err = s.historyEngine.checkOpenWorkflowCountLimit(context.Background(), namespaceEntry)
+	s.NoError(err)
+}
+
 func (s *engine2Suite) TestStartWorkflowExecution_StillRunning_Dedup() {
 	namespaceID := tests.NamespaceID
 	workflowID := "workflowID"
@@ -1195,6 +1340,106 @@ func (s *engine2Suite) TestSignalWithStartWorkflowExecution_WorkflowNotExist() {
 	s.NotNil(resp.GetRunId())
 }
 
+func (s *engine2Suite) TestExecuteMultiOperation_WorkflowNotExist() {
+	namespaceID := tests.NamespaceID
+	workflowID := "wId"
+	identity := "testIdentity"
+	request := &historyservice.ExecuteMultiOperationRequest{
+		NamespaceId: namespaceID,
+		StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+			Namespace:                namespaceID,
+			WorkflowId:               workflowID,
+			WorkflowType:             &commonpb.WorkflowType{Name: "workflowType"},
+			TaskQueue:                &taskqueuepb.TaskQueue{Name: "testTaskQueue"},
+			WorkflowExecutionTimeout: timestamp.DurationPtr(1 * time.Second),
+			WorkflowTaskTimeout:      timestamp.DurationPtr(2 * time.Second),
+			Identity:                 identity,
+			RequestId:                uuid.New(),
+		},
+		SignalRequests: []*workflowservice.SignalWorkflowExecutionRequest{
+			{SignalName: "signal 1", Input: payloads.EncodeString("input 1"), Identity: identity},
+			{SignalName: "signal 2", Input: payloads.EncodeString("input 2"), Identity: identity},
+		},
+	}
+
+	notExistErr := serviceerror.NewNotFound("Workflow not exist")
+
+	s.mockExecutionMgr.EXPECT().GetCurrentExecution(gomock.Any()).Return(nil, notExistErr)
+	s.mockHistoryMgr.EXPECT().AppendHistoryNodes(gomock.Any()).Return(&persistence.AppendHistoryNodesResponse{Size: 0}, nil)
+	s.mockExecutionMgr.EXPECT().CreateWorkflowExecution(gomock.Any()).DoAndReturn(
+		func(request *persistence.CreateWorkflowExecutionRequest) (*persistence.CreateWorkflowExecutionResponse, error) {
+			s.Equal(int64(2), request.NewWorkflowSnapshot.ExecutionInfo.SignalCount)
+			return &persistence.CreateWorkflowExecutionResponse{}, nil
+		})
+
+	resp, err := s.historyEngine.ExecuteMultiOperation(context.Background(), request)
+	s.Nil(err)
+	s.NotEmpty(resp.GetRunId())
+	s.True(resp.GetStarted())
+}
+
+func (s *engine2Suite) TestExecuteMultiOperation_WorkflowRunning() {
+	namespaceID := tests.NamespaceID
+	workflowID := "wId"
+	runID := tests.RunID
+	identity := "testIdentity"
+	request := &historyservice.ExecuteMultiOperationRequest{
+		NamespaceId: namespaceID,
+		StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+			Namespace:  namespaceID,
+			WorkflowId: workflowID,
+			Identity:   identity,
+			RequestId:  uuid.New(),
+		},
+		SignalRequests: []*workflowservice.SignalWorkflowExecutionRequest{
+			{SignalName: "signal 1", Input: payloads.EncodeString("input 1"), Identity: identity},
+			{SignalName: "signal 2", Input: payloads.EncodeString("input 2"), Identity: identity},
+		},
+	}
+
+	msBuilder := workflow.TestLocalMutableState(s.historyEngine.shard, s.mockEventsCache,
+		log.NewTestLogger(), runID)
+	ms := workflow.TestCloneToProto(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	gceResponse := &persistence.GetCurrentExecutionResponse{RunID: runID}
+
+	s.mockExecutionMgr.EXPECT().GetCurrentExecution(gomock.Any()).Return(gceResponse, nil)
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any()).Return(gwmsResponse, nil)
+	s.mockHistoryMgr.EXPECT().AppendHistoryNodes(gomock.Any()).Return(&persistence.AppendHistoryNodesResponse{Size: 0}, nil)
+	s.mockExecutionMgr.EXPECT().UpdateWorkflowExecution(gomock.Any()).DoAndReturn(
+		func(request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error) {
+			s.Equal(int64(2), request.UpdateWorkflowMutation.ExecutionInfo.SignalCount)
+			return &persistence.UpdateWorkflowExecutionResponse{
+				MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{},
+			}, nil
+		})
+
+	resp, err := s.historyEngine.ExecuteMultiOperation(context.Background(), request)
+	s.Nil(err)
+	s.Equal(runID, resp.GetRunId())
+	s.False(resp.GetStarted())
+}
+
+func (s *engine2Suite) TestExecuteMultiOperation_SignalTooLarge() {
+	s.config.BlobSizeLimitWarn = dynamicconfig.GetIntPropertyFilteredByNamespace(1)
+	s.config.BlobSizeLimitError = dynamicconfig.GetIntPropertyFilteredByNamespace(2)
+	request := &historyservice.ExecuteMultiOperationRequest{
+		NamespaceId: tests.NamespaceID,
+		StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+			Namespace:  tests.NamespaceID,
+			WorkflowId: "wId",
+			RequestId:  uuid.New(),
+		},
+		SignalRequests: []*workflowservice.SignalWorkflowExecutionRequest{
+			{SignalName: "signal", Input: payloads.EncodeString("input exceeding the blob size limit")},
+		},
+	}
+
+	// signal is rejected before the workflow is loaded, whether it is running or not
+	_, err := s.historyEngine.ExecuteMultiOperation(context.Background(), request)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
 func (s *engine2Suite) TestSignalWithStartWorkflowExecution_WorkflowNotRunning() {
 	we := commonpb.WorkflowExecution{
 		WorkflowId: "wId",
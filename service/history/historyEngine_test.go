@@ -48,6 +48,7 @@ import (
 	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
@@ -62,6 +63,7 @@ import (
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/failure"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/payload"
@@ -69,6 +71,7 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/primitives/timestamp"
+	serviceerrors "go.temporal.io/server/common/serviceerror"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/events"
@@ -174,20 +177,21 @@ func (s *engineSuite) SetupTest() {
 
 	historyCache := workflow.NewCache(s.mockShard)
 	h := &historyEngineImpl{
-		currentClusterName: s.mockShard.GetClusterMetadata().GetCurrentClusterName(),
-		shard:              s.mockShard,
-		clusterMetadata:    s.mockClusterMetadata,
-		executionManager:   s.mockExecutionMgr,
-		historyCache:       historyCache,
-		logger:             s.mockShard.GetLogger(),
-		metricsClient:      s.mockShard.GetMetricsClient(),
-		tokenSerializer:    common.NewProtoTaskTokenSerializer(),
-		eventNotifier:      eventNitifier,
-		config:             s.config,
-		txProcessor:        s.mockTxProcessor,
-		timerProcessor:     s.mockTimerProcessor,
-		eventsReapplier:    s.mockEventsReapplier,
-		workflowResetter:   s.mockWorkflowResetter,
+		currentClusterName:  s.mockShard.GetClusterMetadata().GetCurrentClusterName(),
+		shard:               s.mockShard,
+		clusterMetadata:     s.mockClusterMetadata,
+		executionManager:    s.mockExecutionMgr,
+		historyCache:        historyCache,
+		logger:              s.mockShard.GetLogger(),
+		metricsClient:       s.mockShard.GetMetricsClient(),
+		tokenSerializer:     common.NewProtoTaskTokenSerializer(),
+		eventNotifier:       eventNitifier,
+		config:              s.config,
+		txProcessor:         s.mockTxProcessor,
+		timerProcessor:      s.mockTimerProcessor,
+		eventsReapplier:     s.mockEventsReapplier,
+		workflowResetter:    s.mockWorkflowResetter,
+		openWorkflowCounter: newOpenWorkflowCounter(s.mockShard.Resource.VisibilityMgr, s.mockShard.GetTimeSource(), s.config.OpenWorkflowCountRefreshInterval),
 	}
 	s.mockShard.SetEngine(h)
 	h.workflowTaskHandler = newWorkflowTaskHandlerCallback(h)
@@ -550,6 +554,56 @@ func (s *engineSuite) TestQueryWorkflow_DirectlyThroughMatching() {
 	s.Equal([]byte{1, 2, 3}, queryResult)
 }
 
+func (s *engineSuite) TestQueryWorkflow_DirectlyThroughMatching_StickyWorkerUnavailable() {
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: "TestQueryWorkflow_DirectlyThroughMatching_StickyWorkerUnavailable",
+		RunId:      tests.RunID,
+	}
+	taskqueue := "testTaskQueue"
+	stickyTaskQueue := "testStickyTaskQueue"
+	identity := "testIdentity"
+
+	msBuilder := workflow.TestLocalMutableState(s.mockHistoryEngine.shard, s.eventsCache, log.NewTestLogger(), execution.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, execution, "wType", taskqueue, payloads.EncodeString("input"), 100*time.Second, 50*time.Second, 200*time.Second, identity)
+	di := addWorkflowTaskScheduledEvent(msBuilder)
+	startedEvent := addWorkflowTaskStartedEvent(msBuilder, di.ScheduleID, taskqueue, identity)
+	addWorkflowTaskCompletedEvent(msBuilder, di.ScheduleID, startedEvent.EventId, identity)
+	executionInfo := msBuilder.GetExecutionInfo()
+	executionInfo.StickyTaskQueue = stickyTaskQueue
+	executionInfo.StickyScheduleToStartTimeout = timestamp.DurationPtr(10 * time.Second)
+
+	ms := workflow.TestCloneToProto(msBuilder)
+	gweResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any()).Return(gweResponse, nil)
+	gomock.InOrder(
+		s.mockMatchingClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, request *matchingservice.QueryWorkflowRequest, _ ...grpc.CallOption) (*matchingservice.QueryWorkflowResponse, error) {
+				s.Equal(stickyTaskQueue, request.TaskQueue.GetName())
+				s.Equal(s.config.StickyQueryDispatchDeadline(tests.Namespace), timestamp.DurationValue(request.StickyDispatchTimeout))
+				return nil, serviceerrors.NewStickyWorkerUnavailable()
+			}),
+		s.mockMatchingClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, request *matchingservice.QueryWorkflowRequest, _ ...grpc.CallOption) (*matchingservice.QueryWorkflowResponse, error) {
+				s.Equal(taskqueue, request.TaskQueue.GetName())
+				s.Nil(request.StickyDispatchTimeout)
+				return &matchingservice.QueryWorkflowResponse{QueryResult: payloads.EncodeBytes([]byte{1, 2, 3})}, nil
+			}),
+	)
+	s.mockHistoryEngine.matchingClient = s.mockMatchingClient
+	s.mockHistoryEngine.rawMatchingClient = s.mockMatchingClient
+	request := &historyservice.QueryWorkflowRequest{
+		NamespaceId: tests.NamespaceID,
+		Request: &workflowservice.QueryWorkflowRequest{
+			Namespace: tests.Namespace,
+			Execution: &execution,
+			Query:     &querypb.WorkflowQuery{},
+		},
+	}
+	resp, err := s.mockHistoryEngine.QueryWorkflow(context.Background(), request)
+	s.NoError(err)
+	s.NotNil(resp.GetResponse().QueryResult)
+}
+
 func (s *engineSuite) TestQueryWorkflow_WorkflowTaskDispatch_Timeout() {
 	execution := commonpb.WorkflowExecution{
 		WorkflowId: "TestQueryWorkflow_WorkflowTaskDispatch_Timeout",
@@ -1600,6 +1654,74 @@ func (s *engineSuite) TestRespondWorkflowTaskCompletedBadBinary() {
 	s.True(updatedWorkflowMutation.ExecutionInfo.WorkflowTaskScheduleId != common.EmptyEventID)
 }
 
+func (s *engineSuite) TestRespondWorkflowTaskCompleted_PendingActivitiesLimitExceeded() {
+	we := commonpb.WorkflowExecution{
+		WorkflowId: tests.WorkflowID,
+		RunId:      tests.RunID,
+	}
+	tl := "testTaskQueue"
+	tt := &tokenspb.Task{
+		ScheduleAttempt: 1,
+		WorkflowId:      tests.WorkflowID,
+		RunId:           tests.RunID,
+		ScheduleId:      2,
+	}
+	taskToken, _ := tt.Marshal()
+	identity := "testIdentity"
+	s.config.NumPendingActivitiesLimitError = dynamicconfig.GetIntPropertyFilteredByNamespace(1)
+
+	msBuilder := workflow.TestLocalMutableState(s.mockHistoryEngine.shard, s.eventsCache,
+		log.NewTestLogger(), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, payloads.EncodeString("input"), 100*time.Second, 90*time.Second, 200*time.Second, identity)
+	di := addWorkflowTaskScheduledEvent(msBuilder)
+	addWorkflowTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+
+	var commands []*commandpb.Command
+	for _, activityID := range []string{"activity1", "activity2"} {
+		commands = append(commands, &commandpb.Command{
+			CommandType: enumspb.COMMAND_TYPE_SCHEDULE_ACTIVITY_TASK,
+			Attributes: &commandpb.Command_ScheduleActivityTaskCommandAttributes{ScheduleActivityTaskCommandAttributes: &commandpb.ScheduleActivityTaskCommandAttributes{
+				ActivityId:             activityID,
+				ActivityType:           &commonpb.ActivityType{Name: "activity_type1"},
+				TaskQueue:              &taskqueuepb.TaskQueue{Name: tl},
+				ScheduleToCloseTimeout: timestamp.DurationPtr(100 * time.Second),
+				ScheduleToStartTimeout: timestamp.DurationPtr(10 * time.Second),
+				StartToCloseTimeout:    timestamp.DurationPtr(50 * time.Second),
+			}},
+		})
+	}
+
+	gwmsResponse1 := &persistence.GetWorkflowExecutionResponse{State: workflow.TestCloneToProto(msBuilder)}
+	gwmsResponse2 := &persistence.GetWorkflowExecutionResponse{State: workflow.TestCloneToProto(msBuilder)}
+
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any()).Return(gwmsResponse1, nil)
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any()).Return(gwmsResponse2, nil)
+	s.mockHistoryMgr.EXPECT().AppendHistoryNodes(gomock.Any()).Return(&persistence.AppendHistoryNodesResponse{Size: 0}, nil)
+	var updatedWorkflowMutation persistence.WorkflowMutation
+	s.mockExecutionMgr.EXPECT().UpdateWorkflowExecution(gomock.Any()).DoAndReturn(func(request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error) {
+		updatedWorkflowMutation = request.UpdateWorkflowMutation
+		return &persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil
+	})
+
+	_, err := s.mockHistoryEngine.RespondWorkflowTaskCompleted(context.Background(), &historyservice.RespondWorkflowTaskCompletedRequest{
+		NamespaceId: tests.NamespaceID,
+		CompleteRequest: &workflowservice.RespondWorkflowTaskCompletedRequest{
+			TaskToken: taskToken,
+			Commands:  commands,
+			Identity:  identity,
+		},
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+	s.Contains(err.Error(), enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_SCHEDULE_ACTIVITY_ATTRIBUTES.String())
+	s.Contains(err.Error(), workflow.ErrPendingActivitiesLimitExceeded.Error())
+
+	// the workflow task is failed and none of its activities are scheduled
+	s.NotNil(updatedWorkflowMutation)
+	s.Equal(int64(5), updatedWorkflowMutation.NextEventID)
+	s.Empty(updatedWorkflowMutation.UpsertActivityInfos)
+	s.Equal(enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, updatedWorkflowMutation.ExecutionState.State)
+}
+
 func (s *engineSuite) TestRespondWorkflowTaskCompletedSingleActivityScheduledWorkflowTask() {
 
 	we := commonpb.WorkflowExecution{
@@ -1759,6 +1881,125 @@ func (s *engineSuite) TestRespondWorkflowTaskCompleted_WorkflowTaskHeartbeatNotT
 	s.Nil(err)
 }
 
+func (s *engineSuite) TestRecordWorkflowTaskHeartbeat_Success() {
+
+	we := commonpb.WorkflowExecution{
+		WorkflowId: tests.WorkflowID,
+		RunId:      tests.RunID,
+	}
+	tl := "testTaskQueue"
+	tt := &tokenspb.Task{
+		ScheduleAttempt: 1,
+		WorkflowId:      we.WorkflowId,
+		RunId:           we.RunId,
+		ScheduleId:      2,
+	}
+	taskToken, _ := tt.Marshal()
+	identity := "testIdentity"
+
+	msBuilder := workflow.TestLocalMutableState(s.mockHistoryEngine.shard, s.eventsCache,
+		log.NewTestLogger(), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, payloads.EncodeString("input"), 100*time.Second, 50*time.Second, 200*time.Second, identity)
+	di := addWorkflowTaskScheduledEvent(msBuilder)
+	addWorkflowTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+
+	ms := workflow.TestCloneToProto(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	var updateRequest *persistence.UpdateWorkflowExecutionRequest
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any()).Return(gwmsResponse, nil)
+	s.mockExecutionMgr.EXPECT().UpdateWorkflowExecution(gomock.Any()).DoAndReturn(
+		func(request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error) {
+			updateRequest = request
+			return &persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil
+		},
+	)
+
+	err := s.mockHistoryEngine.RecordWorkflowTaskHeartbeat(context.Background(), &historyservice.RecordWorkflowTaskHeartbeatRequest{
+		NamespaceId: tests.NamespaceID,
+		TaskToken:   taskToken,
+		Identity:    identity,
+	})
+	s.NoError(err)
+
+	heartbeatTime := updateRequest.UpdateWorkflowMutation.ExecutionInfo.WorkflowTaskLastHeartbeatTime
+	s.NotNil(heartbeatTime)
+	s.Len(updateRequest.UpdateWorkflowMutation.TimerTasks, 1)
+	timerTask, ok := updateRequest.UpdateWorkflowMutation.TimerTasks[0].(*persistence.WorkflowTaskTimeoutTask)
+	s.True(ok)
+	s.Equal(enumspb.TIMEOUT_TYPE_START_TO_CLOSE, timerTask.TimeoutType)
+	s.Equal(heartbeatTime.Add(200*time.Second), timerTask.VisibilityTimestamp)
+}
+
+func (s *engineSuite) TestRecordWorkflowTaskHeartbeat_HeartbeatTimeout() {
+
+	we := commonpb.WorkflowExecution{
+		WorkflowId: tests.WorkflowID,
+		RunId:      tests.RunID,
+	}
+	tl := "testTaskQueue"
+	tt := &tokenspb.Task{
+		ScheduleAttempt: 1,
+		WorkflowId:      we.WorkflowId,
+		RunId:           we.RunId,
+		ScheduleId:      2,
+	}
+	taskToken, _ := tt.Marshal()
+	identity := "testIdentity"
+
+	msBuilder := workflow.TestLocalMutableState(s.mockHistoryEngine.shard, s.eventsCache,
+		log.NewTestLogger(), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, payloads.EncodeString("input"), 100*time.Second, 50*time.Second, 200*time.Second, identity)
+	di := addWorkflowTaskScheduledEvent(msBuilder)
+	addWorkflowTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	msBuilder.GetExecutionInfo().WorkflowTaskStartedTime = timestamp.TimePtr(time.Now().UTC().Add(-time.Hour))
+
+	ms := workflow.TestCloneToProto(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any()).Return(gwmsResponse, nil)
+
+	err := s.mockHistoryEngine.RecordWorkflowTaskHeartbeat(context.Background(), &historyservice.RecordWorkflowTaskHeartbeatRequest{
+		NamespaceId: tests.NamespaceID,
+		TaskToken:   taskToken,
+		Identity:    identity,
+	})
+	s.Equal(consts.ErrWorkflowTaskHeartbeatTimeout, err)
+}
+
+func (s *engineSuite) TestRecordWorkflowTaskHeartbeat_WorkflowTaskNotStarted() {
+
+	we := commonpb.WorkflowExecution{
+		WorkflowId: tests.WorkflowID,
+		RunId:      tests.RunID,
+	}
+	tl := "testTaskQueue"
+	tt := &tokenspb.Task{
+		ScheduleAttempt: 1,
+		WorkflowId:      we.WorkflowId,
+		RunId:           we.RunId,
+		ScheduleId:      2,
+	}
+	taskToken, _ := tt.Marshal()
+	identity := "testIdentity"
+
+	msBuilder := workflow.TestLocalMutableState(s.mockHistoryEngine.shard, s.eventsCache,
+		log.NewTestLogger(), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, payloads.EncodeString("input"), 100*time.Second, 50*time.Second, 200*time.Second, identity)
+	addWorkflowTaskScheduledEvent(msBuilder)
+
+	ms := workflow.TestCloneToProto(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any()).Return(gwmsResponse, nil)
+
+	err := s.mockHistoryEngine.RecordWorkflowTaskHeartbeat(context.Background(), &historyservice.RecordWorkflowTaskHeartbeatRequest{
+		NamespaceId: tests.NamespaceID,
+		TaskToken:   taskToken,
+		Identity:    identity,
+	})
+	s.IsType(&serviceerror.NotFound{}, err)
+}
 func (s *engineSuite) TestRespondWorkflowTaskCompleted_WorkflowTaskHeartbeatNotTimeout_ZeroOrignalScheduledTime() {
 
 	we := commonpb.WorkflowExecution{
@@ -5210,3 +5451,148 @@ func addFailWorkflowEvent(
 	)
 	return event
 }
+
+func (s *engineSuite) TestGetReplicationStatus() {
+	status, err := s.mockHistoryEngine.GetReplicationStatus(context.Background(), []string{cluster.TestAlternativeClusterName}, false)
+	s.NoError(err)
+	s.Equal(s.mockShard.GetShardID(), status.GetShardId())
+	s.Equal(s.mockShard.GetTransferMaxReadLevel(), status.GetMaxReplicationTaskId())
+	s.Len(status.GetRemoteClusters(), 1)
+	s.Equal(int64(persistence.EmptyQueueMessageID), status.GetRemoteClusters()[cluster.TestAlternativeClusterName].GetAckedTaskId())
+
+	// single cluster setup has no remote clusters
+	status, err = s.mockHistoryEngine.GetReplicationStatus(context.Background(), nil, false)
+	s.NoError(err)
+	s.Empty(status.GetRemoteClusters())
+}
+
+func (s *engineSuite) TestGenerateLastHistoryReplicationTasks_NamespaceNotReplicated() {
+	err := s.mockHistoryEngine.GenerateLastHistoryReplicationTasks(
+		context.Background(),
+		tests.NamespaceID,
+		commonpb.WorkflowExecution{
+			WorkflowId: tests.WorkflowID,
+			RunId:      tests.RunID,
+		},
+	)
+	s.Equal(consts.ErrNamespaceNotReplicated, err)
+}
+
+func (s *engineSuite) TestCompactWorkflowHistory() {
+	we := commonpb.WorkflowExecution{
+		WorkflowId: tests.WorkflowID,
+		RunId:      tests.RunID,
+	}
+	tl := "testTaskQueue"
+	identity := "testIdentity"
+
+	msBuilder := workflow.TestLocalMutableState(s.mockHistoryEngine.shard, s.eventsCache,
+		log.NewTestLogger(), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, payloads.EncodeString("input"), 100*time.Second, 100*time.Second, 100*time.Second, identity)
+	di := addWorkflowTaskScheduledEvent(msBuilder)
+	addWorkflowTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	ms := workflow.TestCloneToProto(msBuilder)
+	ms.ExecutionInfo.LastFirstEventId = 3
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{State: ms}, nil)
+
+	s.mockHistoryMgr.EXPECT().ReadHistoryBranchByBatch(gomock.Any()).Return(&persistence.ReadHistoryBranchByBatchResponse{
+		History: []*historypb.History{
+			{Events: []*historypb.HistoryEvent{
+				{EventId: 1, EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED},
+			}},
+			{Events: []*historypb.HistoryEvent{
+				{EventId: 2, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED},
+			}},
+			{Events: []*historypb.HistoryEvent{
+				{EventId: 3, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_STARTED},
+			}},
+		},
+	}, nil)
+	s.mockHistoryMgr.EXPECT().CompactHistoryBranch(gomock.Any()).DoAndReturn(func(request *persistence.CompactHistoryBranchRequest) (*persistence.CompactHistoryBranchResponse, error) {
+		s.Equal(msBuilder.GetNextEventID(), request.MaxEventID)
+		s.Contains(request.PinnedNodeIDs, common.FirstEventID)
+		s.Contains(request.PinnedNodeIDs, int64(2))
+		s.Contains(request.PinnedNodeIDs, int64(3))
+		return &persistence.CompactHistoryBranchResponse{NodeCountBefore: 3, NodeCountAfter: 3}, nil
+	})
+
+	resp, err := s.mockHistoryEngine.CompactWorkflowHistory(context.Background(), tests.NamespaceID, we)
+	s.NoError(err)
+	s.Equal(int32(3), resp.GetBatchCountBefore())
+	s.Equal(int32(3), resp.GetBatchCountAfter())
+}
+
+func (s *engineSuite) TestDeleteWorkflowExecution() {
+	we := commonpb.WorkflowExecution{
+		WorkflowId: tests.WorkflowID,
+		RunId:      tests.RunID,
+	}
+	tl := "testTaskQueue"
+	identity := "testIdentity"
+
+	msBuilder := workflow.TestLocalMutableState(s.mockHistoryEngine.shard, s.eventsCache,
+		log.NewTestLogger(), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, payloads.EncodeString("input"), 100*time.Second, 100*time.Second, 100*time.Second, identity)
+	ms := workflow.TestCloneToProto(msBuilder)
+	ms.ExecutionState.State = enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED
+	ms.ExecutionState.Status = enumspb.WORKFLOW_EXECUTION_STATUS_TERMINATED
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{State: ms}, nil)
+
+	gomock.InOrder(
+		s.mockExecutionMgr.EXPECT().AddTasks(gomock.Any()).DoAndReturn(func(request *persistence.AddTasksRequest) error {
+			s.Len(request.VisibilityTasks, 1)
+			s.IsType(&persistence.DeleteExecutionVisibilityTask{}, request.VisibilityTasks[0])
+			return nil
+		}),
+		s.mockExecutionMgr.EXPECT().DeleteCurrentWorkflowExecution(&persistence.DeleteCurrentWorkflowExecutionRequest{
+			NamespaceID: tests.NamespaceID,
+			WorkflowID:  tests.WorkflowID,
+			RunID:       tests.RunID,
+		}).Return(nil),
+		s.mockExecutionMgr.EXPECT().DeleteWorkflowExecution(&persistence.DeleteWorkflowExecutionRequest{
+			NamespaceID: tests.NamespaceID,
+			WorkflowID:  tests.WorkflowID,
+			RunID:       tests.RunID,
+		}).Return(nil),
+		s.mockHistoryMgr.EXPECT().DeleteHistoryBranch(gomock.Any()).Return(nil),
+	)
+
+	err := s.mockHistoryEngine.DeleteWorkflowExecution(context.Background(), tests.NamespaceID, we)
+	s.NoError(err)
+}
+
+func (s *engineSuite) TestDeleteWorkflowExecution_Running() {
+	we := commonpb.WorkflowExecution{
+		WorkflowId: tests.WorkflowID,
+		RunId:      tests.RunID,
+	}
+
+	msBuilder := workflow.TestLocalMutableState(s.mockHistoryEngine.shard, s.eventsCache,
+		log.NewTestLogger(), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", "testTaskQueue", payloads.EncodeString("input"), 100*time.Second, 100*time.Second, 100*time.Second, "testIdentity")
+	ms := workflow.TestCloneToProto(msBuilder)
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{State: ms}, nil)
+
+	// nothing is deleted, e.g. in a standby cluster where the workflow couldn't be terminated
+	err := s.mockHistoryEngine.DeleteWorkflowExecution(context.Background(), tests.NamespaceID, we)
+	s.Equal(consts.ErrDeleteRunningWorkflow, err)
+}
+
+func (s *engineSuite) TestGetReplicationStatus_LagAndDLQDepth() {
+	remoteTime := s.mockShard.GetCurrentTime(s.mockShard.GetClusterMetadata().GetCurrentClusterName()).Add(-time.Minute)
+	s.mockShard.SetCurrentTime(cluster.TestAlternativeClusterName, remoteTime)
+	s.mockExecutionMgr.EXPECT().GetReplicationTasksFromDLQ(gomock.Any()).Return(&persistence.GetReplicationTasksFromDLQResponse{
+		Tasks:         []*persistencespb.ReplicationTaskInfo{{TaskId: 1}, {TaskId: 2}},
+		NextPageToken: []byte{1},
+	}, nil)
+	s.mockExecutionMgr.EXPECT().GetReplicationTasksFromDLQ(gomock.Any()).Return(&persistence.GetReplicationTasksFromDLQResponse{
+		Tasks: []*persistencespb.ReplicationTaskInfo{{TaskId: 3}},
+	}, nil)
+
+	status, err := s.mockHistoryEngine.GetReplicationStatus(context.Background(), []string{cluster.TestAlternativeClusterName}, true)
+	s.NoError(err)
+	clusterStatus := status.GetRemoteClusters()[cluster.TestAlternativeClusterName]
+	s.NotNil(clusterStatus.GetReplicationLag())
+	s.True(*clusterStatus.GetReplicationLag() >= time.Minute)
+	s.Equal(int64(3), clusterStatus.GetDlqDepth())
+}
@@ -125,6 +125,7 @@ type (
 	pushWorkflowTaskToMatchingInfo struct {
 		workflowTaskScheduleToStartTimeout int64
 		taskqueue                          taskqueuepb.TaskQueue
+		workerBuildID                      string
 	}
 )
 
@@ -150,11 +151,13 @@ func newPushActivityToMatchingInfo(
 func newPushWorkflowTaskToMatchingInfo(
 	workflowTaskScheduleToStartTimeout int64,
 	taskqueue taskqueuepb.TaskQueue,
+	workerBuildID string,
 ) *pushWorkflowTaskToMatchingInfo {
 
 	return &pushWorkflowTaskToMatchingInfo{
 		workflowTaskScheduleToStartTimeout: workflowTaskScheduleToStartTimeout,
 		taskqueue:                          taskqueue,
+		workerBuildID:                      workerBuildID,
 	}
 }
 
@@ -0,0 +1,113 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/persistence/visibility"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+type (
+	// openWorkflowCounter keeps an approximate number of open workflow executions per namespace.
+	// Counts are loaded from visibility and shared by all shards owned by the host, so visibility
+	// is queried at most once per namespace per refresh interval.
+	openWorkflowCounter struct {
+		visibilityMgr   visibility.VisibilityManager
+		timeSource      clock.TimeSource
+		refreshInterval dynamicconfig.DurationPropertyFn
+
+		sync.Mutex
+		counts map[string]*openWorkflowCount
+	}
+
+	openWorkflowCount struct {
+		count       int64
+		refreshTime time.Time
+	}
+)
+
+var openWorkflowCountQuery = fmt.Sprintf("%s = '%s'", searchattribute.ExecutionStatus, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)
+
+func newOpenWorkflowCounter(
+	visibilityMgr visibility.VisibilityManager,
+	timeSource clock.TimeSource,
+	refreshInterval dynamicconfig.DurationPropertyFn,
+) *openWorkflowCounter {
+	return &openWorkflowCounter{
+		visibilityMgr:   visibilityMgr,
+		timeSource:      timeSource,
+		refreshInterval: refreshInterval,
+		counts:          make(map[string]*openWorkflowCount),
+	}
+}
+
+// getCount returns the number of open workflow executions in the namespace.
+// Executions started through this host since the last refresh are included even if they are not visible yet.
+func (c *openWorkflowCounter) getCount(namespaceID string, namespace string) (int64, error) {
+	now := c.timeSource.Now()
+
+	c.Lock()
+	entry, ok := c.counts[namespaceID]
+	if ok && now.Sub(entry.refreshTime) < c.refreshInterval() {
+		count := entry.count
+		c.Unlock()
+		return count, nil
+	}
+	c.Unlock()
+
+	resp, err := c.visibilityMgr.CountWorkflowExecutions(&visibility.CountWorkflowExecutionsRequest{
+		NamespaceID: namespaceID,
+		Namespace:   namespace,
+		Query:       openWorkflowCountQuery,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	c.Lock()
+	defer c.Unlock()
+	c.counts[namespaceID] = &openWorkflowCount{
+		count:       resp.Count,
+		refreshTime: now,
+	}
+	return resp.Count, nil
+}
+
+// increment accounts for a workflow execution started through this host until the next refresh.
+func (c *openWorkflowCounter) increment(namespaceID string) {
+	c.Lock()
+	defer c.Unlock()
+	if entry, ok := c.counts[namespaceID]; ok {
+		entry.count++
+	}
+}
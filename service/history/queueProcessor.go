@@ -48,7 +48,8 @@ type (
 	// QueueProcessorOptions is options passed to queue processor implementation
 	QueueProcessorOptions struct {
 		BatchSize                           dynamicconfig.IntPropertyFn
-		WorkerCount                         dynamicconfig.IntPropertyFn
+		MinWorkerCount                      dynamicconfig.IntPropertyFn
+		MaxWorkerCount                      dynamicconfig.IntPropertyFn
 		MaxPollRPS                          dynamicconfig.IntPropertyFn
 		MaxPollInterval                     dynamicconfig.DurationPropertyFn
 		MaxPollIntervalJitterCoefficient    dynamicconfig.FloatPropertyFn
@@ -103,18 +104,22 @@ func newQueueProcessorBase(
 	queueAckMgr queueAckMgr,
 	redispatchQueue collection.Queue,
 	historyCache *workflow.Cache,
+	isolationGroupLimiter quotas.IsolationGroupLimiter,
 	queueTaskInitializer queueTaskInitializer,
 	logger log.Logger,
 	metricsScope metrics.Scope,
 ) *queueProcessorBase {
 
 	var taskProcessor *taskProcessor
+	// isolation groups are only enforced by taskProcessor, not by the priority task processor
 	if !options.EnablePriorityTaskProcessor() {
 		taskProcessorOptions := taskProcessorOptions{
-			queueSize:   options.BatchSize(),
-			workerCount: options.WorkerCount(),
+			queueSize:      options.BatchSize(),
+			minWorkerCount: options.MinWorkerCount,
+			maxWorkerCount: options.MaxWorkerCount,
+			metricScope:    options.MetricScope,
 		}
-		taskProcessor = newTaskProcessor(taskProcessorOptions, shard, historyCache, logger)
+		taskProcessor = newTaskProcessor(taskProcessorOptions, shard, historyCache, isolationGroupLimiter, logger)
 	}
 
 	p := &queueProcessorBase{
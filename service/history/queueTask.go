@@ -310,6 +310,12 @@ func (t *queueTaskBase) HandleErr(
 		return err
 	}
 
+	// this is a transient error, task is retried until namespace handover is completed
+	if err == cache.ErrNamespaceHandover {
+		t.scope.IncCounter(metrics.TaskNamespaceHandoverCounter)
+		return err
+	}
+
 	if err == consts.ErrTaskDiscarded {
 		t.scope.IncCounter(metrics.TaskDiscarded)
 		err = nil
@@ -0,0 +1,139 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"io"
+
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
+	"go.temporal.io/server/common/headers"
+)
+
+type (
+	// replicationStreamClient receives replication tasks of a shard pushed by source cluster over a gRPC stream.
+	// Every fetch acks processed tasks and grants source cluster credit for exactly one batch, so source cluster
+	// never sends more than receiver is ready to apply.
+	replicationStreamClient struct {
+		shardID        int32
+		currentCluster string
+		sourcePeer     adminservice.AdminServiceClient
+
+		ctx    context.Context
+		cancel context.CancelFunc
+
+		stream       adminservice.AdminService_StreamReplicationMessagesClient
+		streamCancel context.CancelFunc
+	}
+)
+
+var (
+	errReplicationStreamClosed = serviceerror.NewUnavailable("Replication stream is closed by source cluster.")
+)
+
+func newReplicationStreamClient(
+	shardID int32,
+	currentCluster string,
+	sourcePeer adminservice.AdminServiceClient,
+) *replicationStreamClient {
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &replicationStreamClient{
+		shardID:        shardID,
+		currentCluster: currentCluster,
+		sourcePeer:     sourcePeer,
+		ctx:            ctx,
+		cancel:         cancel,
+	}
+}
+
+// fetch sends replication token to source cluster and blocks until next batch of replication tasks is pushed.
+// Stream is opened lazily and closed on any error, next fetch reopens it.
+func (c *replicationStreamClient) fetch(
+	token *replicationspb.ReplicationToken,
+) (*replicationspb.ReplicationMessages, error) {
+
+	if c.stream == nil {
+		if err := c.open(); err != nil {
+			return nil, err
+		}
+	}
+
+	err := c.stream.Send(&adminservice.StreamReplicationMessagesRequest{
+		Token:       token,
+		ClusterName: c.currentCluster,
+	})
+	if err == io.EOF {
+		// send returns io.EOF when stream is broken, actual error is returned by receive
+		_, err = c.stream.Recv()
+	}
+	if err != nil {
+		c.close()
+		return nil, c.convertError(err)
+	}
+
+	response, err := c.stream.Recv()
+	if err != nil {
+		c.close()
+		return nil, c.convertError(err)
+	}
+	return response.GetMessages(), nil
+}
+
+// close closes current stream if any. It must be called from the same goroutine as fetch.
+func (c *replicationStreamClient) close() {
+	if c.streamCancel != nil {
+		c.streamCancel()
+	}
+	c.stream = nil
+	c.streamCancel = nil
+}
+
+// stop unblocks pending fetch and prevents opening new streams. It is safe to call from any goroutine.
+func (c *replicationStreamClient) stop() {
+	c.cancel()
+}
+
+func (c *replicationStreamClient) open() error {
+	ctx, cancel := context.WithCancel(c.ctx)
+	stream, err := c.sourcePeer.StreamReplicationMessages(headers.SetReplicationShardID(ctx, c.shardID))
+	if err != nil {
+		cancel()
+		return err
+	}
+	c.stream = stream
+	c.streamCancel = cancel
+	return nil
+}
+
+func (c *replicationStreamClient) convertError(err error) error {
+	if err == io.EOF {
+		return errReplicationStreamClosed
+	}
+	return err
+}
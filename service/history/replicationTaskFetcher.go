@@ -64,6 +64,7 @@ type (
 		GetSourceCluster() string
 		GetRequestChan() chan<- *replicationTaskRequest
 		GetRateLimiter() quotas.RateLimiter
+		GetSourcePeer() adminservice.AdminServiceClient
 	}
 
 	// ReplicationTaskFetchersImpl is a group of fetchers, one per source DC.
@@ -268,6 +269,11 @@ func (f *ReplicationTaskFetcherImpl) GetRateLimiter() quotas.RateLimiter {
 	return f.rateLimiter
 }
 
+// GetSourcePeer returns the admin client of the source cluster
+func (f *ReplicationTaskFetcherImpl) GetSourcePeer() adminservice.AdminServiceClient {
+	return f.remotePeer
+}
+
 func newReplicationTaskFetcherWorker(
 	logger log.Logger,
 	sourceCluster string,
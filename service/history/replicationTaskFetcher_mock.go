@@ -32,6 +32,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	v1 "go.temporal.io/server/api/adminservice/v1"
 	quotas "go.temporal.io/server/common/quotas"
 )
 
@@ -161,6 +162,20 @@ func (mr *MockReplicationTaskFetcherMockRecorder) GetSourceCluster() *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSourceCluster", reflect.TypeOf((*MockReplicationTaskFetcher)(nil).GetSourceCluster))
 }
 
+// GetSourcePeer mocks base method.
+func (m *MockReplicationTaskFetcher) GetSourcePeer() v1.AdminServiceClient {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSourcePeer")
+	ret0, _ := ret[0].(v1.AdminServiceClient)
+	return ret0
+}
+
+// GetSourcePeer indicates an expected call of GetSourcePeer.
+func (mr *MockReplicationTaskFetcherMockRecorder) GetSourcePeer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSourcePeer", reflect.TypeOf((*MockReplicationTaskFetcher)(nil).GetSourcePeer))
+}
+
 // Start mocks base method.
 func (m *MockReplicationTaskFetcher) Start() {
 	m.ctrl.T.Helper()
@@ -94,6 +94,10 @@ type (
 		requestChan   chan<- *replicationTaskRequest
 		syncShardChan chan *replicationspb.SyncShardStatus
 		shutdownChan  chan struct{}
+
+		replicationStream *replicationStreamClient
+		// set when source cluster does not support replication stream, polling is used instead
+		streamUnsupported bool
 	}
 
 	// ReplicationTaskProcessor is responsible for processing replication tasks for a shard.
@@ -129,8 +133,9 @@ func NewReplicationTaskProcessor(
 	dlqRetryPolicy.SetMaximumAttempts(config.ReplicationTaskProcessorErrorRetryMaxAttempts(shardID))
 	dlqRetryPolicy.SetExpirationInterval(config.ReplicationTaskProcessorErrorRetryExpiration(shardID))
 
+	currentCluster := shard.GetClusterMetadata().GetCurrentClusterName()
 	return &ReplicationTaskProcessorImpl{
-		currentCluster:          shard.GetClusterMetadata().GetCurrentClusterName(),
+		currentCluster:          currentCluster,
 		sourceCluster:           replicationTaskFetcher.GetSourceCluster(),
 		status:                  common.DaemonStatusInitialized,
 		shard:                   shard,
@@ -153,6 +158,11 @@ func NewReplicationTaskProcessor(
 		minTxAckedTaskID:     persistence.EmptyQueueMessageID,
 		maxRxProcessedTaskID: persistence.EmptyQueueMessageID,
 		maxRxReceivedTaskID:  persistence.EmptyQueueMessageID,
+		replicationStream: newReplicationStreamClient(
+			shardID,
+			currentCluster,
+			replicationTaskFetcher.GetSourcePeer(),
+		),
 	}
 }
 
@@ -182,6 +192,7 @@ func (p *ReplicationTaskProcessorImpl) Stop() {
 	}
 
 	close(p.shutdownChan)
+	p.replicationStream.stop()
 
 	p.logger.Info("ReplicationTaskProcessor shutting down.")
 }
@@ -420,45 +431,94 @@ func (p *ReplicationTaskProcessorImpl) convertTaskToDLQTask(
 }
 
 func (p *ReplicationTaskProcessorImpl) paginationFn(_ []byte) ([]interface{}, []byte, error) {
+	token := &replicationspb.ReplicationToken{
+		ShardId:                p.shard.GetShardID(),
+		LastProcessedMessageId: p.maxRxProcessedTaskID,
+		LastRetrievedMessageId: p.maxRxReceivedTaskID,
+	}
+
+	var resp *replicationspb.ReplicationMessages
+	streaming := p.isStreamingEnabled()
+	if streaming {
+		var err error
+		resp, err = p.streamReplicationMessages(token)
+		if err != nil {
+			return nil, nil, err
+		}
+	} else {
+		p.replicationStream.close()
+		resp = p.pollReplicationMessages(token)
+	}
+	if resp == nil {
+		return nil, nil, nil
+	}
+
+	select {
+	case p.syncShardChan <- resp.GetSyncShardStatus():
+
+	default:
+		// channel full, it is ok to drop the sync shard status
+		// since sync shard status are periodically updated
+	}
+
+	var tasks []interface{}
+	for _, task := range resp.GetReplicationTasks() {
+		tasks = append(tasks, task)
+	}
+	p.maxRxReceivedTaskID = resp.GetLastRetrievedMessageId()
+	if resp.GetHasMore() || streaming {
+		// source cluster holds stream request until new tasks are available, no need to wait here
+		p.rxTaskBackoff = time.Duration(0)
+	} else {
+		p.rxTaskBackoff = p.config.ReplicationTaskProcessorNoTaskRetryWait(p.shard.GetShardID())
+	}
+	return tasks, nil, nil
+}
+
+// pollReplicationMessages fetches replication messages through replication task fetcher which aggregates
+// requests of all shards on the host. It returns nil if fetcher failed or processor is shutting down.
+func (p *ReplicationTaskProcessorImpl) pollReplicationMessages(
+	token *replicationspb.ReplicationToken,
+) *replicationspb.ReplicationMessages {
+
 	respChan := make(chan *replicationspb.ReplicationMessages, 1)
 	p.requestChan <- &replicationTaskRequest{
-		token: &replicationspb.ReplicationToken{
-			ShardId:                p.shard.GetShardID(),
-			LastProcessedMessageId: p.maxRxProcessedTaskID,
-			LastRetrievedMessageId: p.maxRxReceivedTaskID,
-		},
+		token:    token,
 		respChan: respChan,
 	}
 
 	select {
 	case resp, ok := <-respChan:
 		if !ok {
-			return nil, nil, nil
+			return nil
 		}
+		return resp
 
-		select {
-		case p.syncShardChan <- resp.GetSyncShardStatus():
+	case <-p.shutdownChan:
+		return nil
+	}
+}
 
-		default:
-			// channel full, it is ok to drop the sync shard status
-			// since sync shard status are periodically updated
-		}
+func (p *ReplicationTaskProcessorImpl) streamReplicationMessages(
+	token *replicationspb.ReplicationToken,
+) (*replicationspb.ReplicationMessages, error) {
 
-		var tasks []interface{}
-		for _, task := range resp.GetReplicationTasks() {
-			tasks = append(tasks, task)
+	resp, err := p.replicationStream.fetch(token)
+	if err != nil {
+		if p.isStopped() {
+			return nil, nil
 		}
-		p.maxRxReceivedTaskID = resp.GetLastRetrievedMessageId()
-		if resp.GetHasMore() {
-			p.rxTaskBackoff = time.Duration(0)
-		} else {
-			p.rxTaskBackoff = p.config.ReplicationTaskProcessorNoTaskRetryWait(p.shard.GetShardID())
+		if _, ok := err.(*serviceerror.Unimplemented); ok {
+			p.logger.Warn("Source cluster does not support replication stream, falling back to polling.", tag.Error(err))
+			p.streamUnsupported = true
 		}
-		return tasks, nil, nil
-
-	case <-p.shutdownChan:
-		return nil, nil, nil
+		return nil, err
 	}
+	return resp, nil
+}
+
+func (p *ReplicationTaskProcessorImpl) isStreamingEnabled() bool {
+	return p.config.ReplicationTaskProcessorEnableStreaming() && !p.streamUnsupported
 }
 
 func (p *ReplicationTaskProcessorImpl) cleanupReplicationTasks() error {
@@ -25,6 +25,7 @@
 package history
 
 import (
+	"context"
 	"math/rand"
 	"testing"
 	"time"
@@ -38,6 +39,8 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/api/adminservicemock/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
@@ -54,6 +57,8 @@ import (
 	"go.temporal.io/server/client"
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/resource"
@@ -135,6 +140,7 @@ func (s *replicationTaskProcessorSuite) SetupTest() {
 	s.mockReplicationTaskFetcher.EXPECT().GetSourceCluster().Return(cluster.TestAlternativeClusterName).AnyTimes()
 	s.mockReplicationTaskFetcher.EXPECT().GetRequestChan().Return(s.requestChan).AnyTimes()
 	s.mockReplicationTaskFetcher.EXPECT().GetRateLimiter().Return(rateLimiter).AnyTimes()
+	s.mockReplicationTaskFetcher.EXPECT().GetSourcePeer().Return(s.mockAdminClient).AnyTimes()
 	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
 	s.mockClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestAllClusterInfo).AnyTimes()
 
@@ -556,3 +562,109 @@ func (s *replicationTaskProcessorSuite) TestPaginationFn_Error() {
 		s.Equal(rxTaskBackoff, s.replicationTaskProcessor.rxTaskBackoff)
 	}
 }
+
+func (s *replicationTaskProcessorSuite) TestPaginationFn_Stream_Success() {
+	s.config.ReplicationTaskProcessorEnableStreaming = dynamicconfig.GetBoolPropertyFn(true)
+
+	syncShardTask := &replicationspb.SyncShardStatus{
+		StatusTime: timestamp.TimeNowPtrUtc(),
+	}
+	task := &replicationspb.ReplicationTask{
+		SourceTaskId: 123,
+		TaskType:     enumsspb.REPLICATION_TASK_TYPE_SYNC_ACTIVITY_TASK,
+	}
+	lastRetrievedMessageID := int64(246)
+
+	maxRxProcessedTaskID := rand.Int63()
+	maxRxReceivedTaskID := rand.Int63()
+	s.replicationTaskProcessor.maxRxProcessedTaskID = maxRxProcessedTaskID
+	s.replicationTaskProcessor.maxRxReceivedTaskID = maxRxReceivedTaskID
+	s.replicationTaskProcessor.rxTaskBackoff = time.Duration(rand.Int63())
+
+	stream := adminservicemock.NewMockAdminService_StreamReplicationMessagesClient(s.controller)
+	s.mockAdminClient.EXPECT().StreamReplicationMessages(gomock.Any()).DoAndReturn(
+		func(ctx context.Context, _ ...interface{}) (adminservice.AdminService_StreamReplicationMessagesClient, error) {
+			shardID, ok := headers.GetOutgoingReplicationShardID(ctx)
+			s.True(ok)
+			s.Equal(s.mockShard.GetShardID(), shardID)
+			return stream, nil
+		},
+	).Times(1)
+	stream.EXPECT().Send(&adminservice.StreamReplicationMessagesRequest{
+		Token: &replicationspb.ReplicationToken{
+			ShardId:                s.mockShard.GetShardID(),
+			LastProcessedMessageId: maxRxProcessedTaskID,
+			LastRetrievedMessageId: maxRxReceivedTaskID,
+		},
+		ClusterName: cluster.TestCurrentClusterName,
+	}).Return(nil)
+	stream.EXPECT().Recv().Return(&adminservice.StreamReplicationMessagesResponse{
+		Messages: &replicationspb.ReplicationMessages{
+			SyncShardStatus:        syncShardTask,
+			ReplicationTasks:       []*replicationspb.ReplicationTask{task},
+			LastRetrievedMessageId: lastRetrievedMessageID,
+			HasMore:                false,
+		},
+	}, nil)
+
+	tasks, _, err := s.replicationTaskProcessor.paginationFn(nil)
+	s.NoError(err)
+	s.Equal(1, len(tasks))
+	s.Equal(task, tasks[0].(*replicationspb.ReplicationTask))
+	s.Equal(syncShardTask, <-s.replicationTaskProcessor.syncShardChan)
+	s.Equal(lastRetrievedMessageID, s.replicationTaskProcessor.maxRxReceivedTaskID)
+	s.Equal(time.Duration(0), s.replicationTaskProcessor.rxTaskBackoff)
+
+	// stream is reused for next batch
+	stream.EXPECT().Send(gomock.Any()).Return(nil)
+	stream.EXPECT().Recv().Return(&adminservice.StreamReplicationMessagesResponse{
+		Messages: &replicationspb.ReplicationMessages{
+			LastRetrievedMessageId: lastRetrievedMessageID,
+		},
+	}, nil)
+	tasks, _, err = s.replicationTaskProcessor.paginationFn(nil)
+	s.NoError(err)
+	s.Empty(tasks)
+}
+
+func (s *replicationTaskProcessorSuite) TestPaginationFn_Stream_Error() {
+	s.config.ReplicationTaskProcessorEnableStreaming = dynamicconfig.GetBoolPropertyFn(true)
+
+	stream := adminservicemock.NewMockAdminService_StreamReplicationMessagesClient(s.controller)
+	s.mockAdminClient.EXPECT().StreamReplicationMessages(gomock.Any()).Return(stream, nil).Times(2)
+	stream.EXPECT().Send(gomock.Any()).Return(nil).Times(2)
+	stream.EXPECT().Recv().Return(nil, serviceerror.NewUnavailable("stream broken"))
+
+	_, _, err := s.replicationTaskProcessor.paginationFn(nil)
+	s.Error(err)
+	s.False(s.replicationTaskProcessor.streamUnsupported)
+
+	// broken stream is reopened
+	stream.EXPECT().Recv().Return(&adminservice.StreamReplicationMessagesResponse{
+		Messages: &replicationspb.ReplicationMessages{},
+	}, nil)
+	_, _, err = s.replicationTaskProcessor.paginationFn(nil)
+	s.NoError(err)
+}
+
+func (s *replicationTaskProcessorSuite) TestPaginationFn_Stream_Unimplemented() {
+	s.config.ReplicationTaskProcessorEnableStreaming = dynamicconfig.GetBoolPropertyFn(true)
+
+	stream := adminservicemock.NewMockAdminService_StreamReplicationMessagesClient(s.controller)
+	s.mockAdminClient.EXPECT().StreamReplicationMessages(gomock.Any()).Return(stream, nil).Times(1)
+	stream.EXPECT().Send(gomock.Any()).Return(nil)
+	stream.EXPECT().Recv().Return(nil, serviceerror.NewUnimplemented("method StreamReplicationMessages not implemented"))
+
+	_, _, err := s.replicationTaskProcessor.paginationFn(nil)
+	s.Error(err)
+	s.True(s.replicationTaskProcessor.streamUnsupported)
+
+	// falls back to polling
+	go func() {
+		request := <-s.requestChan
+		request.respChan <- &replicationspb.ReplicationMessages{}
+		close(request.respChan)
+	}()
+	_, _, err = s.replicationTaskProcessor.paginationFn(nil)
+	s.NoError(err)
+}
@@ -68,6 +68,8 @@ type (
 		// largest replication task ID generated
 		maxTaskID       *int64
 		sanityCheckTime time.Time
+		// closed and replaced every time new replication tasks are generated
+		newTaskCh chan struct{}
 	}
 )
 
@@ -104,6 +106,7 @@ func newReplicatorQueueProcessor(
 
 		maxTaskID:       nil,
 		sanityCheckTime: time.Time{},
+		newTaskCh:       make(chan struct{}),
 	}
 }
 
@@ -126,6 +129,34 @@ func (p *replicatorQueueProcessorImpl) NotifyNewTasks(
 	if p.maxTaskID == nil || *p.maxTaskID < maxTaskID {
 		p.maxTaskID = &maxTaskID
 	}
+	close(p.newTaskCh)
+	p.newTaskCh = make(chan struct{})
+}
+
+// waitForNewTasks blocks until there may be replication tasks after lastRetrievedTaskID,
+// context is done or timeout is reached. It relies on in memory max task ID only so waiting does not touch DB.
+func (p *replicatorQueueProcessorImpl) waitForNewTasks(
+	ctx context.Context,
+	lastRetrievedTaskID int64,
+	timeout time.Duration,
+) {
+
+	p.Lock()
+	if p.maxTaskID == nil || *p.maxTaskID > lastRetrievedTaskID {
+		p.Unlock()
+		return
+	}
+	newTaskCh := p.newTaskCh
+	p.Unlock()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-newTaskCh:
+	case <-timer.C:
+	case <-ctx.Done():
+	}
 }
 
 func (p *replicatorQueueProcessorImpl) paginateTasks(
@@ -148,6 +148,53 @@ func (s *replicatorQueueProcessorSuite) TestNotifyNewTasks_Initialized() {
 	s.Equal(*s.replicatorQueueProcessor.maxTaskID, int64(234))
 }
 
+func (s *replicatorQueueProcessorSuite) TestWaitForNewTasks_NotInitialized() {
+	s.replicatorQueueProcessor.maxTaskID = nil
+
+	startTime := time.Now()
+	s.replicatorQueueProcessor.waitForNewTasks(context.Background(), 123, time.Minute)
+	s.True(time.Since(startTime) < time.Minute)
+}
+
+func (s *replicatorQueueProcessorSuite) TestWaitForNewTasks_PendingTasks() {
+	s.replicatorQueueProcessor.maxTaskID = convert.Int64Ptr(234)
+
+	startTime := time.Now()
+	s.replicatorQueueProcessor.waitForNewTasks(context.Background(), 123, time.Minute)
+	s.True(time.Since(startTime) < time.Minute)
+}
+
+func (s *replicatorQueueProcessorSuite) TestWaitForNewTasks_Notified() {
+	s.replicatorQueueProcessor.maxTaskID = convert.Int64Ptr(123)
+
+	doneCh := make(chan struct{})
+	go func() {
+		s.replicatorQueueProcessor.waitForNewTasks(context.Background(), 123, time.Minute)
+		close(doneCh)
+	}()
+
+	select {
+	case <-doneCh:
+		s.Fail("should wait for new tasks")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	s.replicatorQueueProcessor.NotifyNewTasks([]persistence.Task{
+		&persistence.HistoryReplicationTask{TaskID: 234},
+	})
+	select {
+	case <-doneCh:
+	case <-time.After(time.Minute):
+		s.Fail("should be notified about new tasks")
+	}
+}
+
+func (s *replicatorQueueProcessorSuite) TestWaitForNewTasks_Timeout() {
+	s.replicatorQueueProcessor.maxTaskID = convert.Int64Ptr(123)
+
+	s.replicatorQueueProcessor.waitForNewTasks(context.Background(), 123, 10*time.Millisecond)
+}
+
 func (s *replicatorQueueProcessorSuite) TestTaskIDRange_NotInitialized() {
 	s.replicatorQueueProcessor.sanityCheckTime = time.Time{}
 	expectMaxTaskID := s.mockShard.GetTransferMaxReadLevel()
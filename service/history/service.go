@@ -41,10 +41,13 @@ import (
 	"go.temporal.io/server/common/persistence/visibility"
 	visibilityclient "go.temporal.io/server/common/persistence/visibility/client"
 	"go.temporal.io/server/common/persistence/visibility/elasticsearch"
+	"go.temporal.io/server/common/persistence/visibility/exporter"
+	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/rpc"
 	"go.temporal.io/server/common/rpc/interceptor"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/tracing"
 	"go.temporal.io/server/service/history/configs"
 	"google.golang.org/grpc"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
@@ -80,6 +83,7 @@ func NewService(
 		EnableSampling:         serviceConfig.EnableVisibilitySampling,
 	}
 
+	var esProcessor elasticsearch.Processor
 	visibilityManagerInitializer := func(
 		persistenceBean persistenceClient.Bean,
 		searchAttributesProvider searchattribute.Provider,
@@ -102,14 +106,16 @@ func NewService(
 			visibilityIndexName := params.ESConfig.GetVisibilityIndex()
 
 			esProcessorConfig := &elasticsearch.ProcessorConfig{
-				IndexerConcurrency:       serviceConfig.IndexerConcurrency,
-				ESProcessorNumOfWorkers:  serviceConfig.ESProcessorNumOfWorkers,
-				ESProcessorBulkActions:   serviceConfig.ESProcessorBulkActions,
-				ESProcessorBulkSize:      serviceConfig.ESProcessorBulkSize,
-				ESProcessorFlushInterval: serviceConfig.ESProcessorFlushInterval,
+				IndexerConcurrency:              serviceConfig.IndexerConcurrency,
+				ESProcessorNumOfWorkers:         serviceConfig.ESProcessorNumOfWorkers,
+				ESProcessorBulkActions:          serviceConfig.ESProcessorBulkActions,
+				ESProcessorBulkSize:             serviceConfig.ESProcessorBulkSize,
+				ESProcessorFlushInterval:        serviceConfig.ESProcessorFlushInterval,
+				ESProcessorBackfillWorkers:      serviceConfig.ESProcessorBackfillWorkers,
+				ESProcessorShutdownDrainTimeout: serviceConfig.ESProcessorShutdownDrainTimeout,
 			}
 
-			esProcessor := elasticsearch.NewProcessor(esProcessorConfig, params.ESClient, logger, params.MetricsClient)
+			esProcessor = elasticsearch.NewProcessor(esProcessorConfig, params.ESClient, logger, params.MetricsClient)
 			esProcessor.Start()
 
 			visibilityConfigForES := &config.VisibilityConfig{
@@ -117,12 +123,24 @@ func NewService(
 			}
 			visibilityFromES = elasticsearch.NewVisibilityManager(visibilityIndexName, params.ESClient, visibilityConfigForES, searchAttributesProvider, esProcessor, params.MetricsClient, logger)
 		}
-		return visibility.NewVisibilityManagerWrapper(
+		extraSinks := make(map[string]visibility.Sink)
+		if params.PersistenceConfig.VisibilityExporter != nil {
+			visibilityExporter := exporter.NewExporter(params.PersistenceConfig.VisibilityExporter, params.MetricsClient, logger)
+			visibilityExporter.Start()
+			extraSinks[visibility.SinkKafka] = exporter.NewVisibilitySink(visibilityExporter, serviceConfig.EnableVisibilityExport)
+		}
+		visibilityManager := visibility.NewVisibilityManagerSinkChain(
 			visibilityFromDB,
 			visibilityFromES,
+			extraSinks,
 			dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false), // history visibility never read
 			serviceConfig.AdvancedVisibilityWritingMode,
-		), nil
+			serviceConfig.VisibilitySinks,
+			serviceConfig.VisibilityBestEffortSinks,
+			params.MetricsClient,
+			logger,
+		)
+		return visibilityManager, nil
 	}
 
 	serviceResource, err := resource.New(
@@ -143,9 +161,22 @@ func NewService(
 		metrics.HistoryAPIMetricsScopes(),
 		logger,
 	)
+	serviceRPSFn := func() float64 { return float64(serviceConfig.RPS()) }
 	rateLimiterInterceptor := interceptor.NewRateLimitInterceptor(
-		configs.NewPriorityRateLimiter(func() float64 { return float64(serviceConfig.RPS()) }),
+		serviceResource.GetNamespaceCache(),
+		configs.NewPriorityRateLimiter(serviceRPSFn),
+		func(quotas.Request) float64 { return serviceRPSFn() },
 		map[string]int{},
+		serviceResource.GetThrottleTracker(),
+	)
+	concurrencyLimiterInterceptor := interceptor.NewConcurrencyLimitInterceptor(
+		quotas.NewAdaptiveConcurrencyLimiter(
+			func() int { return serviceConfig.AdaptiveConcurrencyMinLimit() },
+			func() int { return serviceConfig.AdaptiveConcurrencyMaxLimit() },
+		),
+		func() bool { return serviceConfig.AdaptiveConcurrencyEnabled() },
+		configs.ConcurrencyLimitExcludedAPIs,
+		logger,
 	)
 
 	grpcServerOptions, err := params.RPCFactory.GetInternodeGRPCServerOptions()
@@ -155,11 +186,16 @@ func NewService(
 	grpcServerOptions = append(
 		grpcServerOptions,
 		grpc.ChainUnaryInterceptor(
+			tracing.NewServerTracingInterceptor(),
 			rpc.ServiceErrorInterceptor,
 			metrics.NewServerMetricsContextInjectorInterceptor(),
 			metrics.NewServerMetricsTrailerPropagatorInterceptor(logger),
 			metricsInterceptor.Intercept,
 			rateLimiterInterceptor.Intercept,
+			concurrencyLimiterInterceptor.Intercept,
+		),
+		grpc.ChainStreamInterceptor(
+			rpc.ServiceErrorStreamInterceptor,
 		),
 	)
 
@@ -167,7 +203,7 @@ func NewService(
 		Resource: serviceResource,
 		status:   common.DaemonStatusInitialized,
 		server:   grpc.NewServer(grpcServerOptions...),
-		handler:  NewHandler(serviceResource, serviceConfig),
+		handler:  NewHandler(serviceResource, serviceConfig, esProcessor),
 		config:   serviceConfig,
 	}, nil
 }
@@ -79,6 +79,8 @@ type (
 
 		GetVisibilityAckLevel() int64
 		UpdateVisibilityAckLevel(ackLevel int64) error
+		GetVisibilityLag() time.Duration
+		UpdateVisibilityLag(lag time.Duration)
 
 		GetReplicatorAckLevel() int64
 		UpdateReplicatorAckLevel(ackLevel int64) error
@@ -26,6 +26,7 @@ package shard
 
 import (
 	"errors"
+	"math"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -33,6 +34,7 @@ import (
 	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/api/serviceerror"
 
+	clusterspb "go.temporal.io/server/api/cluster/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
@@ -82,6 +84,15 @@ type (
 
 		// true if previous owner was different from the acquirer's identity.
 		previousShardOwnerWasDifferent bool
+
+		// lock holders, accessed atomically: writeLocked is 1 while the lock is held for writing,
+		// readers is the number of read lock holders
+		writeLocked int32
+		readers     int32
+		// number of lock acquisitions which found the lock held, accessed atomically
+		lockContentionCount int64
+		// lag in nanoseconds of the last workflow close recorded to visibility, accessed atomically
+		visibilityLag int64
 	}
 )
 
@@ -96,6 +107,8 @@ const (
 	historySizeLogThreshold  = 10 * 1024 * 1024
 )
 
+var timerMaxTimestamp = time.Unix(0, math.MaxInt64).UTC()
+
 func (s *ContextImpl) GetShardID() int32 {
 	return s.shardID
 }
@@ -198,6 +211,14 @@ func (s *ContextImpl) UpdateVisibilityAckLevel(ackLevel int64) error {
 	return s.updateShardInfoLocked()
 }
 
+func (s *ContextImpl) GetVisibilityLag() time.Duration {
+	return time.Duration(atomic.LoadInt64(&s.visibilityLag))
+}
+
+func (s *ContextImpl) UpdateVisibilityLag(lag time.Duration) {
+	atomic.StoreInt64(&s.visibilityLag, int64(lag))
+}
+
 func (s *ContextImpl) GetReplicatorAckLevel() int64 {
 	s.RLock()
 	defer s.RUnlock()
@@ -672,6 +693,105 @@ func (s *ContextImpl) getRangeID() int64 {
 	return s.shardInfo.GetRangeId()
 }
 
+// verifyOwnership checks that range ID of the shard in persistence is still the one held by this context,
+// i.e. shard was not acquired by another host. Shard is closed if ownership is lost.
+func (s *ContextImpl) verifyOwnership() (bool, error) {
+	s.RLock()
+	rangeID := s.getRangeID()
+	s.RUnlock()
+
+	resp, err := s.GetShardManager().GetShard(&persistence.GetShardRequest{
+		ShardID: s.shardID,
+	})
+	if err != nil {
+		return false, err
+	}
+	if resp.ShardInfo.GetRangeId() == rangeID {
+		return true, nil
+	}
+
+	s.logger.Info("Shard ownership lost",
+		tag.ShardRangeID(resp.ShardInfo.GetRangeId()),
+		tag.PreviousShardRangeID(rangeID),
+	)
+	s.Lock()
+	defer s.Unlock()
+	s.closeShard()
+	return false, nil
+}
+
+// nextTaskTime returns the time at which the earliest pending task of the shard becomes due, or the zero time
+// if the shard has no pending tasks. Pending transfer and visibility tasks are due immediately.
+func (s *ContextImpl) nextTaskTime() (time.Time, error) {
+	now := s.GetTimeSource().Now()
+	maxReadLevel := s.GetTransferMaxReadLevel()
+
+	transferResp, err := s.executionManager.GetTransferTasks(&persistence.GetTransferTasksRequest{
+		ReadLevel:    s.GetTransferAckLevel(),
+		MaxReadLevel: maxReadLevel,
+		BatchSize:    1,
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+	if len(transferResp.Tasks) > 0 {
+		return now, nil
+	}
+
+	visibilityResp, err := s.executionManager.GetVisibilityTasks(&persistence.GetVisibilityTasksRequest{
+		ReadLevel:    s.GetVisibilityAckLevel(),
+		MaxReadLevel: maxReadLevel,
+		BatchSize:    1,
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+	if len(visibilityResp.Tasks) > 0 {
+		return now, nil
+	}
+
+	timerResp, err := s.executionManager.GetTimerIndexTasks(&persistence.GetTimerIndexTasksRequest{
+		MinTimestamp: s.GetTimerAckLevel(),
+		MaxTimestamp: timerMaxTimestamp,
+		BatchSize:    1,
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+	if len(timerResp.Timers) > 0 {
+		return timestamp.TimeValue(timerResp.Timers[0].GetVisibilityTime()), nil
+	}
+	return time.Time{}, nil
+}
+
+// stats returns the ack levels, task backlog and lock contention of the shard
+func (s *ContextImpl) stats() *clusterspb.ShardStats {
+	// lock contention count is read before taking the lock, so that reading it does not count itself
+	lockContentionCount := atomic.LoadInt64(&s.lockContentionCount)
+
+	s.RLock()
+	defer s.RUnlock()
+
+	currentCluster := s.GetClusterMetadata().GetCurrentClusterName()
+	timerMaxReadLevel := s.timerMaxReadLevelMap[currentCluster]
+	lastUpdated := s.lastUpdated
+	return &clusterspb.ShardStats{
+		ShardId:              s.shardID,
+		OwnerHost:            s.GetHostInfo().GetAddress(),
+		RangeId:              s.getRangeID(),
+		TransferAckLevel:     s.shardInfo.TransferAckLevel,
+		TransferMaxReadLevel: s.transferMaxReadLevel,
+		TransferBacklog:      s.transferMaxReadLevel - s.shardInfo.TransferAckLevel,
+		TimerAckLevel:        timestamp.TimePtr(timestamp.TimeValue(s.shardInfo.TimerAckLevelTime)),
+		TimerMaxReadLevel:    &timerMaxReadLevel,
+		VisibilityAckLevel:   s.shardInfo.VisibilityAckLevel,
+		ReplicationAckLevel:  s.shardInfo.ReplicationAckLevel,
+		LastUpdatedTime:      &lastUpdated,
+		LockContentionCount:  lockContentionCount,
+		VisibilityLag:        timestamp.DurationPtr(s.GetVisibilityLag()),
+	}
+}
+
 func (s *ContextImpl) isStopped() bool {
 	return atomic.LoadInt32(&s.status) == common.DaemonStatusStopped
 }
@@ -1009,7 +1129,11 @@ func (s *ContextImpl) Lock() {
 	sw := s.metricsClient.StartTimer(scope, metrics.LockLatency)
 	defer sw.Stop()
 
+	if atomic.LoadInt32(&s.writeLocked) != 0 || atomic.LoadInt32(&s.readers) != 0 {
+		atomic.AddInt64(&s.lockContentionCount, 1)
+	}
 	s.rwLock.Lock()
+	atomic.StoreInt32(&s.writeLocked, 1)
 }
 
 func (s *ContextImpl) RLock() {
@@ -1018,21 +1142,27 @@ func (s *ContextImpl) RLock() {
 	sw := s.metricsClient.StartTimer(scope, metrics.LockLatency)
 	defer sw.Stop()
 
+	if atomic.LoadInt32(&s.writeLocked) != 0 {
+		atomic.AddInt64(&s.lockContentionCount, 1)
+	}
 	s.rwLock.RLock()
+	atomic.AddInt32(&s.readers, 1)
 }
 
 func (s *ContextImpl) Unlock() {
+	atomic.StoreInt32(&s.writeLocked, 0)
 	s.rwLock.Unlock()
 }
 
 func (s *ContextImpl) RUnlock() {
+	atomic.AddInt32(&s.readers, -1)
 	s.rwLock.RUnlock()
 }
 
 func acquireShard(
 	shardItem *historyShardsItem,
 	closeCallback func(int32, *historyShardsItem),
-) (Context, error) {
+) (*ContextImpl, error) {
 
 	var shardInfo *persistence.ShardInfoWithFailover
 
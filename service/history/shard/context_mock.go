@@ -576,6 +576,20 @@ func (mr *MockContextMockRecorder) GetVisibilityAckLevel() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVisibilityAckLevel", reflect.TypeOf((*MockContext)(nil).GetVisibilityAckLevel))
 }
 
+// GetVisibilityLag mocks base method.
+func (m *MockContext) GetVisibilityLag() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVisibilityLag")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// GetVisibilityLag indicates an expected call of GetVisibilityLag.
+func (mr *MockContextMockRecorder) GetVisibilityLag() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVisibilityLag", reflect.TypeOf((*MockContext)(nil).GetVisibilityLag))
+}
+
 // PreviousShardOwnerWasDifferent mocks base method.
 func (m *MockContext) PreviousShardOwnerWasDifferent() bool {
 	m.ctrl.T.Helper()
@@ -782,6 +796,18 @@ func (mr *MockContextMockRecorder) UpdateVisibilityAckLevel(ackLevel interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVisibilityAckLevel", reflect.TypeOf((*MockContext)(nil).UpdateVisibilityAckLevel), ackLevel)
 }
 
+// UpdateVisibilityLag mocks base method.
+func (m *MockContext) UpdateVisibilityLag(lag time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UpdateVisibilityLag", lag)
+}
+
+// UpdateVisibilityLag indicates an expected call of UpdateVisibilityLag.
+func (mr *MockContextMockRecorder) UpdateVisibilityLag(lag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVisibilityLag", reflect.TypeOf((*MockContext)(nil).UpdateVisibilityLag), lag)
+}
+
 // UpdateWorkflowExecution mocks base method.
 func (m *MockContext) UpdateWorkflowExecution(request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
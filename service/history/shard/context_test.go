@@ -25,7 +25,9 @@
 package shard
 
 import (
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -139,3 +141,35 @@ func (s *contextSuite) TestAddTasks_Success() {
 	err := s.shardContext.AddTasks(addTasksRequest)
 	s.NoError(err)
 }
+
+func (s *contextSuite) TestStats() {
+	shardContext := s.shardContext.(*ContextTest)
+	shardContext.transferMaxReadLevel = 120
+	shardContext.shardInfo.TransferAckLevel = 100
+	shardContext.shardInfo.VisibilityAckLevel = 110
+	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName)
+
+	// hold the read lock until the writer found it held
+	shardContext.RLock()
+	locked := make(chan struct{})
+	go func() {
+		shardContext.Lock()
+		shardContext.Unlock()
+		close(locked)
+	}()
+	s.Eventually(func() bool {
+		return atomic.LoadInt64(&shardContext.lockContentionCount) == 1
+	}, time.Second, time.Millisecond)
+	shardContext.RUnlock()
+	<-locked
+
+	stats := shardContext.stats()
+	s.Equal(int32(0), stats.GetShardId())
+	s.Equal(shardContext.GetHostInfo().GetAddress(), stats.GetOwnerHost())
+	s.Equal(int64(1), stats.GetRangeId())
+	s.Equal(int64(100), stats.GetTransferAckLevel())
+	s.Equal(int64(120), stats.GetTransferMaxReadLevel())
+	s.Equal(int64(20), stats.GetTransferBacklog())
+	s.Equal(int64(110), stats.GetVisibilityAckLevel())
+	s.Equal(int64(1), stats.GetLockContentionCount())
+}
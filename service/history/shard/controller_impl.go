@@ -25,7 +25,9 @@
 package shard
 
 import (
+	"errors"
 	"fmt"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -33,6 +35,7 @@ import (
 	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/service/history/configs"
 
+	clusterspb "go.temporal.io/server/api/cluster/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -47,6 +50,11 @@ const (
 	shardControllerMembershipUpdateListenerName = "ShardController"
 )
 
+var (
+	errShardAcquisitionDeferred = errors.New("shard acquisition is deferred until membership is stable")
+	errShardNotAcquired         = errors.New("shard is not acquired")
+)
+
 type (
 	ControllerImpl struct {
 		resource.Resource
@@ -62,8 +70,17 @@ type (
 		config             *configs.Config
 		metricsScope       metrics.Scope
 
+		// acquisition of new shards is deferred until this time (unix nano) after hosts are removed from membership
+		acquireDeferredUntil int64
+		// last time (unix nano) hosts were removed from membership
+		hostsRemovedTime int64
+
 		sync.RWMutex
 		historyShards map[int32]*historyShardsItem
+		// shards which were assigned to this host, but acquisition was deferred
+		deferredShards map[int32]struct{}
+		// shards released due to inactivity, mapped to the time their next task becomes due (zero if none)
+		hibernatedShards map[int32]time.Time
 	}
 
 	historyShardsItemStatus int
@@ -77,9 +94,15 @@ type (
 		throttledLogger log.Logger
 		engineFactory   EngineFactory
 
+		// last time (unix nano) a request was routed to this shard
+		lastActiveTime int64
+		// number of in-flight calls using the engine, the shard is not hibernated while it is in use
+		inFlight int32
+
 		sync.RWMutex
-		status historyShardsItemStatus
-		engine Engine
+		status       historyShardsItemStatus
+		engine       Engine
+		shardContext *ContextImpl
 	}
 )
 
@@ -101,6 +124,8 @@ func NewController(
 		membershipUpdateCh: make(chan *membership.ChangedEvent, 10),
 		engineFactory:      factory,
 		historyShards:      make(map[int32]*historyShardsItem),
+		deferredShards:     make(map[int32]struct{}),
+		hibernatedShards:   make(map[int32]time.Time),
 		shutdownCh:         make(chan struct{}),
 		logger:             log.With(resource.GetLogger(), tag.ComponentShardController, tag.Address(hostIdentity)),
 		throttledLogger:    log.With(resource.GetThrottledLogger(), tag.ComponentShardController, tag.Address(hostIdentity)),
@@ -123,6 +148,7 @@ func newHistoryShardsItem(
 		status:          historyShardsItemStatusInitialized,
 		engineFactory:   factory,
 		config:          config,
+		lastActiveTime:  time.Now().UnixNano(),
 		logger:          log.With(resource.GetLogger(), tag.ShardID(shardID), tag.Address(hostIdentity)),
 		throttledLogger: log.With(resource.GetThrottledLogger(), tag.ShardID(shardID), tag.Address(hostIdentity)),
 	}
@@ -193,9 +219,63 @@ func (c *ControllerImpl) GetEngineForShard(shardID int32) (Engine, error) {
 	if err != nil {
 		return nil, err
 	}
+	atomic.StoreInt64(&item.lastActiveTime, time.Now().UnixNano())
 	return item.getOrCreateEngine(c.shardClosedCallback)
 }
 
+// AcquireEngine returns the engine of the shard owning the workflow, see AcquireEngineForShard
+func (c *ControllerImpl) AcquireEngine(namespaceID, workflowID string) (Engine, func(), error) {
+	shardID := c.config.GetShardID(namespaceID, workflowID)
+	return c.AcquireEngineForShard(shardID)
+}
+
+// AcquireEngineForShard returns the engine of the shard and a release function which must be called once
+// the caller is done with the engine. The shard is not hibernated before all acquired engines are released.
+func (c *ControllerImpl) AcquireEngineForShard(shardID int32) (Engine, func(), error) {
+	sw := c.metricsScope.StartTimer(metrics.GetEngineForShardLatency)
+	defer sw.Stop()
+
+	for {
+		item, err := c.getOrCreateHistoryShardItem(shardID)
+		if err != nil {
+			return nil, nil, err
+		}
+		atomic.AddInt32(&item.inFlight, 1)
+		release := func() { atomic.AddInt32(&item.inFlight, -1) }
+
+		// the shard may have been hibernated before the item was marked as in use
+		c.RLock()
+		current := c.historyShards[shardID]
+		c.RUnlock()
+		if current != item {
+			release()
+			continue
+		}
+
+		atomic.StoreInt64(&item.lastActiveTime, time.Now().UnixNano())
+		engine, err := item.getOrCreateEngine(c.shardClosedCallback)
+		if err != nil {
+			release()
+			return nil, nil, err
+		}
+		var once sync.Once
+		return engine, func() { once.Do(release) }, nil
+	}
+}
+
+// acquireShard acquires the shard if it is not hibernated, without counting it as shard activity
+func (c *ControllerImpl) acquireShard(shardID int32) error {
+	if c.isHibernated(shardID) {
+		return nil
+	}
+	item, err := c.getOrCreateHistoryShardItem(shardID)
+	if err != nil {
+		return err
+	}
+	_, err = item.getOrCreateEngine(c.shardClosedCallback)
+	return err
+}
+
 func (c *ControllerImpl) RemoveEngineForShard(shardID int32, shardItem *historyShardsItem) {
 	sw := c.metricsScope.StartTimer(metrics.RemoveEngineForShardLatency)
 	defer sw.Stop()
@@ -249,6 +329,12 @@ func (c *ControllerImpl) getOrCreateHistoryShardItem(shardID int32) (*historySha
 	}
 
 	if info.Identity() == c.GetHostInfo().Identity() {
+		if c.isAcquisitionDeferred() {
+			// host which owned the shard might rejoin membership soon, do not steal the shard yet
+			c.deferredShards[shardID] = struct{}{}
+			c.metricsScope.IncCounter(metrics.ShardAcquisitionDeferredCounter)
+			return nil, errShardAcquisitionDeferred
+		}
 		shardItem, err := newHistoryShardsItem(
 			c.Resource,
 			shardID,
@@ -260,6 +346,10 @@ func (c *ControllerImpl) getOrCreateHistoryShardItem(shardID int32) (*historySha
 		}
 		c.historyShards[shardID] = shardItem
 		c.metricsScope.IncCounter(metrics.ShardItemCreatedCounter)
+		if _, ok := c.hibernatedShards[shardID]; ok {
+			delete(c.hibernatedShards, shardID)
+			c.metricsScope.IncCounter(metrics.ShardReactivatedCounter)
+		}
 
 		shardItem.logger.Info("", tag.LifeCycleStarted, tag.ComponentShardItem)
 		return shardItem, nil
@@ -306,6 +396,8 @@ func (c *ControllerImpl) shardManagementPump() {
 	acquireTicker := time.NewTicker(c.config.AcquireShardInterval())
 	defer acquireTicker.Stop()
 
+	var rejoinGraceTimerCh <-chan time.Time
+	var wakeupTimerCh <-chan time.Time
 	for {
 
 		select {
@@ -313,6 +405,14 @@ func (c *ControllerImpl) shardManagementPump() {
 			c.doShutdown()
 			return
 		case <-acquireTicker.C:
+			c.hibernateIdleShards()
+			c.acquireShards()
+			wakeupTimerCh = c.newWakeupTimer()
+		case <-wakeupTimerCh:
+			c.acquireShards()
+			wakeupTimerCh = c.newWakeupTimer()
+		case <-rejoinGraceTimerCh:
+			rejoinGraceTimerCh = nil
 			c.acquireShards()
 		case changedEvent := <-c.membershipUpdateCh:
 			c.metricsScope.IncCounter(metrics.MembershipChangedCounter)
@@ -321,16 +421,172 @@ func (c *ControllerImpl) shardManagementPump() {
 				tag.NumberProcessed(len(changedEvent.HostsAdded)),
 				tag.NumberDeleted(len(changedEvent.HostsRemoved)),
 				tag.Number(int64(len(changedEvent.HostsUpdated))))
+			if gracePeriod := c.handleMembershipChange(changedEvent); gracePeriod > 0 {
+				rejoinGraceTimerCh = time.After(gracePeriod)
+			}
 			c.acquireShards()
 		}
 	}
 }
 
+// handleMembershipChange defers acquisition of shards reassigned to this host when hosts leave the ring,
+// since they may rejoin shortly after transient network partition. When hosts rejoin, ownership of shards held
+// by this host is verified against persistence, because this host may have been the one which was partitioned.
+// Returns grace period after which deferred shards should be acquired.
+func (c *ControllerImpl) handleMembershipChange(changedEvent *membership.ChangedEvent) time.Duration {
+	gracePeriod := c.config.ShardRejoinGracePeriod()
+	if gracePeriod <= 0 {
+		return 0
+	}
+
+	now := time.Now()
+	if len(changedEvent.HostsAdded) > 0 && now.Sub(time.Unix(0, atomic.LoadInt64(&c.hostsRemovedTime))) <= gracePeriod {
+		c.verifyShardOwnership()
+	}
+	if len(changedEvent.HostsRemoved) > 0 {
+		atomic.StoreInt64(&c.hostsRemovedTime, now.UnixNano())
+		atomic.StoreInt64(&c.acquireDeferredUntil, now.Add(gracePeriod).UnixNano())
+		return gracePeriod
+	}
+	return 0
+}
+
+func (c *ControllerImpl) isAcquisitionDeferred() bool {
+	return time.Now().UnixNano() < atomic.LoadInt64(&c.acquireDeferredUntil)
+}
+
+// verifyShardOwnership closes shards which were acquired by other hosts while this host was out of the ring,
+// shards which are still owned by this host are kept without being reacquired.
+func (c *ControllerImpl) verifyShardOwnership() {
+	c.RLock()
+	items := make([]*historyShardsItem, 0, len(c.historyShards))
+	for _, item := range c.historyShards {
+		items = append(items, item)
+	}
+	c.RUnlock()
+
+	for _, item := range items {
+		if c.isShuttingDown() {
+			return
+		}
+		owned, err := item.verifyOwnership()
+		if err != nil {
+			c.logger.Error("Unable to verify shard ownership", tag.Error(err), tag.OperationFailed, tag.ShardID(item.shardID))
+			continue
+		}
+		if owned {
+			c.metricsScope.IncCounter(metrics.ShardOwnershipVerifiedCounter)
+		} else {
+			c.metricsScope.IncCounter(metrics.ShardOwnershipLostOnRejoinCounter)
+		}
+	}
+}
+
+// hibernateIdleShards releases shards which didn't receive any request within the hibernation idle timeout and
+// have no pending tasks. Hibernated shards are acquired again on the next request, or when their next timer is due.
+// Shards are not hibernated when remote clusters are enabled, as their replication processors pull and
+// serve replication tasks regardless of requests.
+func (c *ControllerImpl) hibernateIdleShards() {
+	idleTimeout := c.config.ShardHibernationIdleTimeout()
+	if idleTimeout <= 0 || c.hasRemoteClusters() {
+		return
+	}
+
+	c.RLock()
+	items := make([]*historyShardsItem, 0, len(c.historyShards))
+	for _, item := range c.historyShards {
+		items = append(items, item)
+	}
+	c.RUnlock()
+
+	for _, item := range items {
+		if c.isShuttingDown() {
+			return
+		}
+		if !item.isIdle(idleTimeout) || item.isInUse() {
+			continue
+		}
+		nextTaskTime, err := item.nextTaskTime()
+		if err != nil {
+			if err != errShardNotAcquired {
+				c.logger.Error("Unable to get next task time of idle shard", tag.Error(err), tag.OperationFailed, tag.ShardID(item.shardID))
+			}
+			continue
+		}
+		if !nextTaskTime.IsZero() && !nextTaskTime.After(time.Now()) {
+			// shard has tasks to process
+			continue
+		}
+
+		c.Lock()
+		if c.historyShards[item.shardID] != item || !item.isIdle(idleTimeout) || item.isInUse() {
+			c.Unlock()
+			continue
+		}
+		delete(c.historyShards, item.shardID)
+		c.hibernatedShards[item.shardID] = nextTaskTime
+		c.Unlock()
+
+		item.stopEngine()
+		c.metricsScope.IncCounter(metrics.ShardHibernatedCounter)
+		item.logger.Info("Shard hibernated", tag.ComponentShardItem, tag.Timestamp(nextTaskTime))
+	}
+}
+
+func (c *ControllerImpl) hasRemoteClusters() bool {
+	currentClusterName := c.GetClusterMetadata().GetCurrentClusterName()
+	for clusterName, info := range c.GetClusterMetadata().GetAllClusterInfo() {
+		if info.Enabled && clusterName != currentClusterName {
+			return true
+		}
+	}
+	return false
+}
+
+// isHibernated returns true if the shard is hibernated and none of its tasks is due yet
+func (c *ControllerImpl) isHibernated(shardID int32) bool {
+	c.RLock()
+	defer c.RUnlock()
+	nextTaskTime, ok := c.hibernatedShards[shardID]
+	return ok && (nextTaskTime.IsZero() || time.Now().Before(nextTaskTime))
+}
+
+// newWakeupTimer returns a timer channel firing when the earliest task of hibernated shards becomes due,
+// or nil if hibernated shards don't have any pending task
+func (c *ControllerImpl) newWakeupTimer() <-chan time.Time {
+	c.RLock()
+	defer c.RUnlock()
+	var wakeupTime time.Time
+	for _, nextTaskTime := range c.hibernatedShards {
+		if !nextTaskTime.IsZero() && (wakeupTime.IsZero() || nextTaskTime.Before(wakeupTime)) {
+			wakeupTime = nextTaskTime
+		}
+	}
+	if wakeupTime.IsZero() {
+		return nil
+	}
+	return time.After(time.Until(wakeupTime))
+}
+
+// takeDeferredShards returns shards which acquisition was deferred, once acquisition is not deferred anymore
+func (c *ControllerImpl) takeDeferredShards() map[int32]struct{} {
+	if c.isAcquisitionDeferred() {
+		return nil
+	}
+
+	c.Lock()
+	defer c.Unlock()
+	deferredShards := c.deferredShards
+	c.deferredShards = make(map[int32]struct{})
+	return deferredShards
+}
+
 func (c *ControllerImpl) acquireShards() {
 	c.metricsScope.IncCounter(metrics.AcquireShardsCounter)
 	sw := c.metricsScope.StartTimer(metrics.AcquireShardsLatency)
 	defer sw.Stop()
 
+	deferredShards := c.takeDeferredShards()
 	concurrency := common.MaxInt(c.config.AcquireShardConcurrency(), 1)
 	shardActionCh := make(chan int32, concurrency)
 	var wg sync.WaitGroup
@@ -348,11 +604,17 @@ func (c *ControllerImpl) acquireShards() {
 					c.logger.Error("Error looking up host for shardID", tag.Error(err), tag.OperationFailed, tag.ShardID(shardID))
 				} else {
 					if info.Identity() == c.GetHostInfo().Identity() {
-						_, err1 := c.GetEngineForShard(shardID)
-						if err1 != nil {
+						err1 := c.acquireShard(shardID)
+						if err1 != nil && err1 != errShardAcquisitionDeferred {
 							c.metricsScope.IncCounter(metrics.GetEngineForShardErrorCounter)
 							c.logger.Error("Unable to create history shard engine", tag.Error(err1), tag.OperationFailed, tag.ShardID(shardID))
 						}
+					} else {
+						c.removeHibernatedShard(shardID)
+						if _, ok := deferredShards[shardID]; ok {
+							// previous owner rejoined the ring within grace period
+							c.metricsScope.IncCounter(metrics.ShardMovementAvoidedCounter)
+						}
 					}
 				}
 			}
@@ -370,6 +632,14 @@ func (c *ControllerImpl) acquireShards() {
 	wg.Wait()
 
 	c.metricsScope.UpdateGauge(metrics.NumShardsGauge, float64(c.NumShards()))
+	c.metricsScope.UpdateGauge(metrics.NumHibernatedShardsGauge, float64(c.NumHibernatedShards()))
+}
+
+// removeHibernatedShard forgets a hibernated shard which is no longer owned by this host
+func (c *ControllerImpl) removeHibernatedShard(shardID int32) {
+	c.Lock()
+	defer c.Unlock()
+	delete(c.hibernatedShards, shardID)
 }
 
 func (c *ControllerImpl) doShutdown() {
@@ -390,6 +660,12 @@ func (c *ControllerImpl) NumShards() int {
 	return nShards
 }
 
+func (c *ControllerImpl) NumHibernatedShards() int {
+	c.RLock()
+	defer c.RUnlock()
+	return len(c.hibernatedShards)
+}
+
 func (c *ControllerImpl) ShardIDs() []int32 {
 	c.RLock()
 	ids := []int32{}
@@ -401,6 +677,27 @@ func (c *ControllerImpl) ShardIDs() []int32 {
 	return ids
 }
 
+// ShardStats returns the stats of the shards acquired by this host
+func (c *ControllerImpl) ShardStats() []*clusterspb.ShardStats {
+	c.RLock()
+	items := make([]*historyShardsItem, 0, len(c.historyShards))
+	for _, item := range c.historyShards {
+		items = append(items, item)
+	}
+	c.RUnlock()
+
+	stats := make([]*clusterspb.ShardStats, 0, len(items))
+	for _, item := range items {
+		if shardStats := item.stats(); shardStats != nil {
+			stats = append(stats, shardStats)
+		}
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].ShardId < stats[j].ShardId
+	})
+	return stats
+}
+
 func (i *historyShardsItem) getOrCreateEngine(
 	closeCallback func(int32, *historyShardsItem),
 ) (Engine, error) {
@@ -416,7 +713,7 @@ func (i *historyShardsItem) getOrCreateEngine(
 	switch i.status {
 	case historyShardsItemStatusInitialized:
 		i.logger.Info("", tag.LifeCycleStarting, tag.ComponentShardEngine)
-		context, err := acquireShard(i, closeCallback)
+		shardContext, err := acquireShard(i, closeCallback)
 		if err != nil {
 			// invalidate the shardItem so that the same shardItem won't be
 			// used to create another shardContext
@@ -424,11 +721,12 @@ func (i *historyShardsItem) getOrCreateEngine(
 			i.status = historyShardsItemStatusStopped
 			return nil, err
 		}
-		if context.PreviousShardOwnerWasDifferent() {
+		if shardContext.PreviousShardOwnerWasDifferent() {
 			i.GetMetricsClient().RecordTimer(metrics.ShardInfoScope, metrics.ShardItemAcquisitionLatency,
-				context.GetCurrentTime(i.GetClusterMetadata().GetCurrentClusterName()).Sub(context.GetLastUpdatedTime()))
+				shardContext.GetCurrentTime(i.GetClusterMetadata().GetCurrentClusterName()).Sub(shardContext.GetLastUpdatedTime()))
 		}
-		i.engine = i.engineFactory.CreateEngine(context)
+		i.shardContext = shardContext
+		i.engine = i.engineFactory.CreateEngine(shardContext)
 		i.engine.Start()
 		i.logger.Info("", tag.LifeCycleStarted, tag.ComponentShardEngine)
 		i.status = historyShardsItemStatusStarted
@@ -453,6 +751,7 @@ func (i *historyShardsItem) stopEngine() {
 		i.logger.Info("", tag.LifeCycleStopping, tag.ComponentShardEngine)
 		i.engine.Stop()
 		i.engine = nil
+		i.shardContext = nil
 		i.logger.Info("", tag.LifeCycleStopped, tag.ComponentShardEngine)
 		i.status = historyShardsItemStatusStopped
 	case historyShardsItemStatusStopped:
@@ -462,6 +761,55 @@ func (i *historyShardsItem) stopEngine() {
 	}
 }
 
+// verifyOwnership returns false if shard was acquired by another host since this item acquired it
+func (i *historyShardsItem) verifyOwnership() (bool, error) {
+	i.RLock()
+	shardContext := i.shardContext
+	i.RUnlock()
+
+	if shardContext == nil {
+		// shard is not acquired (yet) or already stopped
+		return true, nil
+	}
+	return shardContext.verifyOwnership()
+}
+
+// isIdle returns true if no request was routed to the shard within the idle timeout
+func (i *historyShardsItem) isIdle(idleTimeout time.Duration) bool {
+	return time.Since(time.Unix(0, atomic.LoadInt64(&i.lastActiveTime))) >= idleTimeout
+}
+
+// isInUse returns true if an acquired engine of the shard is not released yet
+func (i *historyShardsItem) isInUse() bool {
+	return atomic.LoadInt32(&i.inFlight) > 0
+}
+
+// nextTaskTime returns the time at which the earliest pending task of the shard becomes due, zero if none
+func (i *historyShardsItem) nextTaskTime() (time.Time, error) {
+	i.RLock()
+	shardContext := i.shardContext
+	i.RUnlock()
+
+	if shardContext == nil {
+		// shard is not acquired (yet) or already stopped
+		return time.Time{}, errShardNotAcquired
+	}
+	return shardContext.nextTaskTime()
+}
+
+// stats returns the stats of the shard, nil if the shard is not acquired
+func (i *historyShardsItem) stats() *clusterspb.ShardStats {
+	i.RLock()
+	shardContext := i.shardContext
+	i.RUnlock()
+
+	if shardContext == nil {
+		// shard is not acquired (yet) or already stopped
+		return nil
+	}
+	return shardContext.stats()
+}
+
 func (i *historyShardsItem) isValid() bool {
 	i.RLock()
 	defer i.RUnlock()
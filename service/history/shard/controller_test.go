@@ -28,6 +28,7 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -564,6 +565,185 @@ func (s *controllerSuite) TestShardControllerClosed() {
 	workerWG.Wait()
 }
 
+func (s *controllerSuite) TestAcquireShardDeferredAfterHostsRemoved() {
+	numShards := int32(2)
+	s.config.NumberOfShards = numShards
+	s.config.ShardRejoinGracePeriod = dynamicconfig.GetDurationPropertyFn(time.Minute)
+
+	otherHostInfo := membership.NewHostInfo("other-host", nil)
+	gracePeriod := s.shardController.handleMembershipChange(&membership.ChangedEvent{
+		HostsRemoved: []*membership.HostInfo{otherHostInfo},
+	})
+	s.Equal(time.Minute, gracePeriod)
+
+	// shards of the removed host are assigned to this host, but not acquired during grace period
+	s.mockServiceResolver.EXPECT().Lookup(convert.Int32ToString(1)).Return(s.hostInfo, nil).Times(3)
+	s.mockServiceResolver.EXPECT().Lookup(convert.Int32ToString(2)).Return(s.hostInfo, nil).Times(2)
+	_, err := s.shardController.GetEngineForShard(1)
+	s.Equal(errShardAcquisitionDeferred, err)
+	s.shardController.acquireShards()
+	s.Equal(0, s.shardController.NumShards())
+	s.Len(s.shardController.deferredShards, 2)
+
+	// removed host rejoined and got shard 1 back, shard 2 is moved to this host after grace period
+	atomic.StoreInt64(&s.shardController.acquireDeferredUntil, 0)
+	s.mockServiceResolver.EXPECT().Lookup(convert.Int32ToString(1)).Return(otherHostInfo, nil)
+	s.setupMocksForAcquireShard(2, s.mockHistoryEngine, 5, 6)
+	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestSingleDCClusterInfo).AnyTimes()
+	s.shardController.acquireShards()
+	s.Equal([]int32{2}, s.shardController.ShardIDs())
+	s.Empty(s.shardController.deferredShards)
+}
+
+func (s *controllerSuite) TestVerifyShardOwnershipOnRejoin() {
+	numShards := int32(2)
+	s.config.NumberOfShards = numShards
+	s.config.ShardRejoinGracePeriod = dynamicconfig.GetDurationPropertyFn(time.Minute)
+
+	historyEngines := make(map[int32]*MockEngine)
+	for shardID := int32(1); shardID <= numShards; shardID++ {
+		mockEngine := NewMockEngine(s.controller)
+		historyEngines[shardID] = mockEngine
+		s.setupMocksForAcquireShard(shardID, mockEngine, 5, 6)
+	}
+	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestSingleDCClusterInfo).AnyTimes()
+	s.shardController.acquireShards()
+	s.Equal(2, s.shardController.NumShards())
+
+	// this host is partitioned, so other hosts are removed from its ring
+	otherHostInfo := membership.NewHostInfo("other-host", nil)
+	s.shardController.handleMembershipChange(&membership.ChangedEvent{
+		HostsRemoved: []*membership.HostInfo{otherHostInfo},
+	})
+
+	// shard 1 is still owned by this host, shard 2 was acquired by other host during partition
+	s.mockShardManager.EXPECT().GetShard(&persistence.GetShardRequest{ShardID: 1}).Return(
+		&persistence.GetShardResponse{ShardInfo: &persistencespb.ShardInfo{ShardId: 1, RangeId: 6}}, nil)
+	s.mockShardManager.EXPECT().GetShard(&persistence.GetShardRequest{ShardID: 2}).Return(
+		&persistence.GetShardResponse{ShardInfo: &persistencespb.ShardInfo{ShardId: 2, RangeId: 7}}, nil)
+	historyEngines[2].EXPECT().Stop()
+	gracePeriod := s.shardController.handleMembershipChange(&membership.ChangedEvent{
+		HostsAdded: []*membership.HostInfo{otherHostInfo},
+	})
+	s.Zero(gracePeriod)
+	s.Eventually(func() bool {
+		return s.shardController.NumShards() == 1
+	}, time.Second, 10*time.Millisecond)
+	s.Equal([]int32{1}, s.shardController.ShardIDs())
+}
+
+func (s *controllerSuite) TestHibernateIdleShards() {
+	numShards := int32(2)
+	s.config.NumberOfShards = numShards
+	s.config.ShardHibernationIdleTimeout = dynamicconfig.GetDurationPropertyFn(time.Minute)
+
+	historyEngines := make(map[int32]*MockEngine)
+	for shardID := int32(1); shardID <= numShards; shardID++ {
+		mockEngine := NewMockEngine(s.controller)
+		historyEngines[shardID] = mockEngine
+		s.setupMocksForAcquireShard(shardID, mockEngine, 5, 6)
+	}
+	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestSingleDCClusterInfo).AnyTimes()
+	s.shardController.acquireShards()
+	s.Equal(2, s.shardController.NumShards())
+
+	// shard 1 didn't receive requests for a while and its only timer fires in an hour
+	atomic.StoreInt64(&s.shardController.historyShards[1].lastActiveTime, time.Now().Add(-2*time.Minute).UnixNano())
+	wakeupTime := time.Now().UTC().Add(time.Hour)
+	s.mockResource.ExecutionMgr.EXPECT().GetTransferTasks(gomock.Any()).Return(&persistence.GetTransferTasksResponse{}, nil)
+	s.mockResource.ExecutionMgr.EXPECT().GetVisibilityTasks(gomock.Any()).Return(&persistence.GetVisibilityTasksResponse{}, nil)
+	s.mockResource.ExecutionMgr.EXPECT().GetTimerIndexTasks(gomock.Any()).Return(&persistence.GetTimerIndexTasksResponse{
+		Timers: []*persistencespb.TimerTaskInfo{{VisibilityTime: &wakeupTime}},
+	}, nil)
+	historyEngines[1].EXPECT().Stop()
+	s.shardController.hibernateIdleShards()
+	s.Equal([]int32{2}, s.shardController.ShardIDs())
+	s.Equal(1, s.shardController.NumHibernatedShards())
+	s.NotNil(s.shardController.newWakeupTimer())
+
+	// hibernated shard is not acquired until its timer is due
+	s.mockServiceResolver.EXPECT().Lookup(convert.Int32ToString(1)).Return(s.hostInfo, nil)
+	s.mockServiceResolver.EXPECT().Lookup(convert.Int32ToString(2)).Return(s.hostInfo, nil).Times(2)
+	s.shardController.acquireShards()
+	s.Equal(1, s.shardController.NumShards())
+
+	s.shardController.Lock()
+	s.shardController.hibernatedShards[1] = time.Now().Add(-time.Second)
+	s.shardController.Unlock()
+	s.setupMocksForAcquireShard(1, NewMockEngine(s.controller), 6, 7)
+	s.shardController.acquireShards()
+	s.Equal(2, s.shardController.NumShards())
+	s.Zero(s.shardController.NumHibernatedShards())
+	s.Nil(s.shardController.newWakeupTimer())
+}
+
+func (s *controllerSuite) TestHibernateIdleShards_PendingTasks() {
+	s.config.NumberOfShards = 1
+	s.config.ShardHibernationIdleTimeout = dynamicconfig.GetDurationPropertyFn(time.Minute)
+
+	s.setupMocksForAcquireShard(1, s.mockHistoryEngine, 5, 6)
+	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestSingleDCClusterInfo).AnyTimes()
+	s.shardController.acquireShards()
+
+	atomic.StoreInt64(&s.shardController.historyShards[1].lastActiveTime, time.Now().Add(-2*time.Minute).UnixNano())
+	s.mockResource.ExecutionMgr.EXPECT().GetTransferTasks(gomock.Any()).Return(&persistence.GetTransferTasksResponse{
+		Tasks: []*persistencespb.TransferTaskInfo{{TaskId: 211}},
+	}, nil)
+	s.shardController.hibernateIdleShards()
+	s.Equal(1, s.shardController.NumShards())
+	s.Zero(s.shardController.NumHibernatedShards())
+}
+
+func (s *controllerSuite) TestHibernateIdleShards_RemoteClusters() {
+	s.config.NumberOfShards = 1
+	s.config.ShardHibernationIdleTimeout = dynamicconfig.GetDurationPropertyFn(time.Minute)
+
+	s.setupMocksForAcquireShard(1, s.mockHistoryEngine, 5, 6)
+	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestAllClusterInfo).AnyTimes()
+	s.shardController.acquireShards()
+
+	// replication processors of the shard must keep running
+	atomic.StoreInt64(&s.shardController.historyShards[1].lastActiveTime, time.Now().Add(-2*time.Minute).UnixNano())
+	s.shardController.hibernateIdleShards()
+	s.Equal(1, s.shardController.NumShards())
+	s.Zero(s.shardController.NumHibernatedShards())
+}
+
+func (s *controllerSuite) TestHibernateIdleShards_InUse() {
+	s.config.NumberOfShards = 1
+	s.config.ShardHibernationIdleTimeout = dynamicconfig.GetDurationPropertyFn(time.Minute)
+
+	s.setupMocksForAcquireShard(1, s.mockHistoryEngine, 5, 6)
+	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestSingleDCClusterInfo).AnyTimes()
+	s.shardController.acquireShards()
+
+	engine, release, err := s.shardController.AcquireEngineForShard(1)
+	s.NoError(err)
+	s.Equal(s.mockHistoryEngine, engine)
+
+	atomic.StoreInt64(&s.shardController.historyShards[1].lastActiveTime, time.Now().Add(-2*time.Minute).UnixNano())
+	s.shardController.hibernateIdleShards()
+	s.Equal(1, s.shardController.NumShards())
+	s.Zero(s.shardController.NumHibernatedShards())
+
+	// engine is stopped once the in-flight call released it
+	release()
+	release()
+	s.mockResource.ExecutionMgr.EXPECT().GetTransferTasks(gomock.Any()).Return(&persistence.GetTransferTasksResponse{}, nil)
+	s.mockResource.ExecutionMgr.EXPECT().GetVisibilityTasks(gomock.Any()).Return(&persistence.GetVisibilityTasksResponse{}, nil)
+	s.mockResource.ExecutionMgr.EXPECT().GetTimerIndexTasks(gomock.Any()).Return(&persistence.GetTimerIndexTasksResponse{}, nil)
+	s.mockHistoryEngine.EXPECT().Stop()
+	s.shardController.hibernateIdleShards()
+	s.Zero(s.shardController.NumShards())
+	s.Equal(1, s.shardController.NumHibernatedShards())
+}
+
 func (s *controllerSuite) setupMocksForAcquireShard(shardID int32, mockEngine *MockEngine, currentRangeID,
 	newRangeID int64) {
 
@@ -64,6 +64,35 @@ func (m *MockEngine) EXPECT() *MockEngineMockRecorder {
 	return m.recorder
 }
 
+// CompactWorkflowHistory mocks base method.
+func (m *MockEngine) CompactWorkflowHistory(ctx context.Context, namespaceUUID string, execution common.WorkflowExecution) (*historyservice.CompactWorkflowHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompactWorkflowHistory", ctx, namespaceUUID, execution)
+	ret0, _ := ret[0].(*historyservice.CompactWorkflowHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompactWorkflowHistory indicates an expected call of CompactWorkflowHistory.
+func (mr *MockEngineMockRecorder) CompactWorkflowHistory(ctx, namespaceUUID, execution interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompactWorkflowHistory", reflect.TypeOf((*MockEngine)(nil).CompactWorkflowHistory), ctx, namespaceUUID, execution)
+}
+
+// DeleteWorkflowExecution mocks base method.
+func (m *MockEngine) DeleteWorkflowExecution(ctx context.Context, namespaceUUID string, execution common.WorkflowExecution) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkflowExecution", ctx, namespaceUUID, execution)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkflowExecution indicates an expected call of DeleteWorkflowExecution.
+func (mr *MockEngineMockRecorder) DeleteWorkflowExecution(ctx, namespaceUUID, execution interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).DeleteWorkflowExecution), ctx, namespaceUUID, execution)
+}
+
 // DescribeMutableState mocks base method.
 func (m *MockEngine) DescribeMutableState(ctx context.Context, request *historyservice.DescribeMutableStateRequest) (*historyservice.DescribeMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -94,6 +123,35 @@ func (mr *MockEngineMockRecorder) DescribeWorkflowExecution(ctx, request interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).DescribeWorkflowExecution), ctx, request)
 }
 
+// ExecuteMultiOperation mocks base method.
+func (m *MockEngine) ExecuteMultiOperation(ctx context.Context, request *historyservice.ExecuteMultiOperationRequest) (*historyservice.ExecuteMultiOperationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteMultiOperation", ctx, request)
+	ret0, _ := ret[0].(*historyservice.ExecuteMultiOperationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteMultiOperation indicates an expected call of ExecuteMultiOperation.
+func (mr *MockEngineMockRecorder) ExecuteMultiOperation(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteMultiOperation", reflect.TypeOf((*MockEngine)(nil).ExecuteMultiOperation), ctx, request)
+}
+
+// GenerateLastHistoryReplicationTasks mocks base method.
+func (m *MockEngine) GenerateLastHistoryReplicationTasks(ctx context.Context, namespaceUUID string, execution common.WorkflowExecution) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateLastHistoryReplicationTasks", ctx, namespaceUUID, execution)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GenerateLastHistoryReplicationTasks indicates an expected call of GenerateLastHistoryReplicationTasks.
+func (mr *MockEngineMockRecorder) GenerateLastHistoryReplicationTasks(ctx, namespaceUUID, execution interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateLastHistoryReplicationTasks", reflect.TypeOf((*MockEngine)(nil).GenerateLastHistoryReplicationTasks), ctx, namespaceUUID, execution)
+}
+
 // GetDLQMessages mocks base method.
 func (m *MockEngine) GetDLQMessages(ctx context.Context, messagesRequest *historyservice.GetDLQMessagesRequest) (*historyservice.GetDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -154,6 +212,21 @@ func (mr *MockEngineMockRecorder) GetReplicationMessages(ctx, pollingCluster, ac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationMessages", reflect.TypeOf((*MockEngine)(nil).GetReplicationMessages), ctx, pollingCluster, ackMessageID, queryMessageID)
 }
 
+// GetReplicationStatus mocks base method.
+func (m *MockEngine) GetReplicationStatus(ctx context.Context, remoteClusters []string, includeDLQDepth bool) (*historyservice.ShardReplicationStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplicationStatus", ctx, remoteClusters, includeDLQDepth)
+	ret0, _ := ret[0].(*historyservice.ShardReplicationStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationStatus indicates an expected call of GetReplicationStatus.
+func (mr *MockEngineMockRecorder) GetReplicationStatus(ctx, remoteClusters, includeDLQDepth interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationStatus", reflect.TypeOf((*MockEngine)(nil).GetReplicationStatus), ctx, remoteClusters, includeDLQDepth)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockEngine) MergeDLQMessages(ctx context.Context, messagesRequest *historyservice.MergeDLQMessagesRequest) (*historyservice.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -244,6 +317,21 @@ func (mr *MockEngineMockRecorder) PollMutableState(ctx, request interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PollMutableState", reflect.TypeOf((*MockEngine)(nil).PollMutableState), ctx, request)
 }
 
+// PollReplicationMessages mocks base method.
+func (m *MockEngine) PollReplicationMessages(ctx context.Context, pollingCluster string, ackMessageID, queryMessageID int64) (*repication.ReplicationMessages, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PollReplicationMessages", ctx, pollingCluster, ackMessageID, queryMessageID)
+	ret0, _ := ret[0].(*repication.ReplicationMessages)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PollReplicationMessages indicates an expected call of PollReplicationMessages.
+func (mr *MockEngineMockRecorder) PollReplicationMessages(ctx, pollingCluster, ackMessageID, queryMessageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PollReplicationMessages", reflect.TypeOf((*MockEngine)(nil).PollReplicationMessages), ctx, pollingCluster, ackMessageID, queryMessageID)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockEngine) PurgeDLQMessages(ctx context.Context, messagesRequest *historyservice.PurgeDLQMessagesRequest) error {
 	m.ctrl.T.Helper()
@@ -331,6 +419,20 @@ func (mr *MockEngineMockRecorder) RecordChildExecutionCompleted(ctx, request int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordChildExecutionCompleted", reflect.TypeOf((*MockEngine)(nil).RecordChildExecutionCompleted), ctx, request)
 }
 
+// RecordWorkflowTaskHeartbeat mocks base method.
+func (m *MockEngine) RecordWorkflowTaskHeartbeat(ctx context.Context, request *historyservice.RecordWorkflowTaskHeartbeatRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordWorkflowTaskHeartbeat", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordWorkflowTaskHeartbeat indicates an expected call of RecordWorkflowTaskHeartbeat.
+func (mr *MockEngineMockRecorder) RecordWorkflowTaskHeartbeat(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordWorkflowTaskHeartbeat", reflect.TypeOf((*MockEngine)(nil).RecordWorkflowTaskHeartbeat), ctx, request)
+}
+
 // RecordWorkflowTaskStarted mocks base method.
 func (m *MockEngine) RecordWorkflowTaskStarted(ctx context.Context, request *historyservice.RecordWorkflowTaskStartedRequest) (*historyservice.RecordWorkflowTaskStartedResponse, error) {
 	m.ctrl.T.Helper()
@@ -85,6 +85,11 @@ func (t *taskAllocatorImpl) verifyActiveTask(taskNamespaceID string, task interf
 		t.logger.Debug("Namespace is not active, skip task.", tag.WorkflowNamespaceID(taskNamespaceID), tag.Value(task))
 		return false, nil
 	}
+	if namespaceEntry.IsNamespaceHandover() {
+		// task must be retried until handover is completed and namespace becomes standby
+		t.logger.Debug("Namespace is in handover state, retry task.", tag.WorkflowNamespaceID(taskNamespaceID), tag.Value(task))
+		return false, cache.ErrNamespaceHandover
+	}
 	t.logger.Debug("Namespace is active, process task.", tag.WorkflowNamespaceID(taskNamespaceID), tag.Value(task))
 	return true, nil
 }
@@ -89,13 +89,15 @@ func (a *taskPriorityAssignerImpl) Assign(
 		return err
 	}
 
+	subClass := configs.GetTaskPrioritySubclass(a.config.TaskNamespaceTier(namespace))
+
 	if !active {
-		task.SetPriority(configs.GetTaskPriority(configs.TaskLowPriorityClass, configs.TaskDefaultPrioritySubclass))
+		task.SetPriority(configs.GetTaskPriority(configs.TaskLowPriorityClass, subClass))
 		return nil
 	}
 
 	if !a.getRateLimiter(namespace).Allow() {
-		task.SetPriority(configs.GetTaskPriority(configs.TaskDefaultPriorityClass, configs.TaskDefaultPrioritySubclass))
+		task.SetPriority(configs.GetTaskPriority(configs.TaskDefaultPriorityClass, subClass))
 		taggedScope := a.scope.Tagged(metrics.NamespaceTag(namespace))
 		if task.GetQueueType() == transferQueueType {
 			taggedScope.IncCounter(metrics.TransferTaskThrottledCounter)
@@ -105,7 +107,7 @@ func (a *taskPriorityAssignerImpl) Assign(
 		return nil
 	}
 
-	task.SetPriority(configs.GetTaskPriority(configs.TaskHighPriorityClass, configs.TaskDefaultPrioritySubclass))
+	task.SetPriority(configs.GetTaskPriority(configs.TaskHighPriorityClass, subClass))
 	return nil
 }
 
@@ -189,6 +189,47 @@ func (s *taskPriorityAssignerSuite) TestAssign_TimerTask() {
 	s.NoError(err)
 }
 
+func (s *taskPriorityAssignerSuite) TestAssign_NamespaceTier() {
+	testCases := []struct {
+		tier             string
+		expectedSubclass int
+	}{
+		{
+			tier:             configs.TaskNamespaceTierCritical,
+			expectedSubclass: configs.TaskHighPrioritySubclass,
+		},
+		{
+			tier:             configs.TaskNamespaceTierDefault,
+			expectedSubclass: configs.TaskDefaultPrioritySubclass,
+		},
+		{
+			tier:             configs.TaskNamespaceTierBatch,
+			expectedSubclass: configs.TaskLowPrioritySubclass,
+		},
+		{
+			tier:             "unknown-tier",
+			expectedSubclass: configs.TaskDefaultPrioritySubclass,
+		},
+	}
+
+	for _, tc := range testCases {
+		tier := tc.tier
+		s.priorityAssigner.config.TaskNamespaceTier = func(namespace string) string {
+			s.Equal(tests.Namespace, namespace)
+			return tier
+		}
+		s.mockNamespaceCache.EXPECT().GetNamespaceByID(tests.NamespaceID).Return(tests.GlobalNamespaceEntry, nil)
+
+		mockTask := NewMockqueueTask(s.controller)
+		mockTask.EXPECT().GetQueueType().Return(transferQueueType).AnyTimes()
+		mockTask.EXPECT().GetNamespaceId().Return(tests.NamespaceID)
+		mockTask.EXPECT().SetPriority(configs.GetTaskPriority(configs.TaskHighPriorityClass, tc.expectedSubclass))
+
+		err := s.priorityAssigner.Assign(mockTask)
+		s.NoError(err)
+	}
+}
+
 func (s *taskPriorityAssignerSuite) TestAssign_ThrottledTask() {
 	s.mockNamespaceCache.EXPECT().GetNamespaceByID(tests.NamespaceID).Return(tests.GlobalNamespaceEntry, nil).AnyTimes()
 
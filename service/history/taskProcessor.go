@@ -25,6 +25,8 @@
 package history
 
 import (
+	"context"
+	"math"
 	"sync"
 	"time"
 
@@ -36,11 +38,14 @@ import (
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/collection"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/shard"
@@ -49,12 +54,23 @@ import (
 
 const (
 	taskTimeout = time.Second * 10
+
+	// workerPoolResizeInterval is the interval at which the worker pool size is adjusted
+	workerPoolResizeInterval = 5 * time.Second
+	// workerPoolLatencyBackoffRatio is the ratio of short term to baseline task latency
+	// above which persistence is considered degraded and the worker pool shrinks
+	workerPoolLatencyBackoffRatio = 2.0
+	// longLatencyWindow and shortLatencyWindow are numbers of samples in long (baseline) and short (current) latency averages
+	longLatencyWindow  = 600
+	shortLatencyWindow = 10
 )
 
 type (
 	taskProcessorOptions struct {
-		queueSize   int
-		workerCount int
+		queueSize      int
+		minWorkerCount dynamicconfig.IntPropertyFn
+		maxWorkerCount dynamicconfig.IntPropertyFn
+		metricScope    int
 	}
 
 	taskInfo struct {
@@ -69,6 +85,16 @@ type (
 		// used by 2DC task life cycle
 		// TODO remove when NDC task life cycle is implemented
 		shouldProcessTask bool
+
+		// releases the slot of the isolation group of the task, nil if no slot is held
+		releaseIsolationGroup func()
+	}
+
+	// throttledTasks is an unbounded queue of tasks of an isolation group, so that
+	// workers never block when parking tasks of a group which has no slot available
+	throttledTasks struct {
+		tasks    collection.Queue
+		notifyCh chan struct{}
 	}
 
 	taskProcessor struct {
@@ -82,11 +108,29 @@ type (
 		timeSource    clock.TimeSource
 		retryPolicy   backoff.RetryPolicy
 		workerWG      sync.WaitGroup
-
-		// worker coroutines notification
+		metricScope   int
+		cancelCtx     context.Context
+		cancelFn      context.CancelFunc
+
+		// nil if tasks are not limited by isolation group
+		isolationGroupLimiter quotas.IsolationGroupLimiter
+		throttledTasksLock    sync.Mutex
+		// tasks waiting for a slot of their isolation group, keyed by group
+		throttledTasks map[string]*throttledTasks
+		// throttled tasks which acquired a slot of their isolation group, handed off only to a free worker
+		admittedTasksCh chan *taskInfo
+
+		minWorkerCount dynamicconfig.IntPropertyFn
+		maxWorkerCount dynamicconfig.IntPropertyFn
+		workersLock    sync.Mutex
+		// worker coroutines notification, one per running worker
 		workerNotificationChans []chan struct{}
-		// duplicate numOfWorker from config.TimerTaskWorkerCount for dynamic config works correctly
-		numOfWorker int
+		// closed to stop the corresponding worker, one per running worker
+		workerStopChans []chan struct{}
+
+		latencyLock  sync.Mutex
+		longLatency  float64
+		shortLatency float64
 	}
 )
 
@@ -109,42 +153,48 @@ func newTaskProcessor(
 	options taskProcessorOptions,
 	shard shard.Context,
 	historyCache *workflow.Cache,
+	isolationGroupLimiter quotas.IsolationGroupLimiter,
 	logger log.Logger,
 ) *taskProcessor {
 
-	workerNotificationChans := []chan struct{}{}
-	for index := 0; index < options.workerCount; index++ {
-		workerNotificationChans = append(workerNotificationChans, make(chan struct{}, 1))
-	}
-
+	cancelCtx, cancelFn := context.WithCancel(context.Background())
 	base := &taskProcessor{
-		shard:                   shard,
-		cache:                   historyCache,
-		shutdownCh:              make(chan struct{}),
-		tasksCh:                 make(chan *taskInfo, options.queueSize),
-		config:                  shard.GetConfig(),
-		logger:                  logger,
-		metricsClient:           shard.GetMetricsClient(),
-		timeSource:              shard.GetTimeSource(),
-		workerNotificationChans: workerNotificationChans,
-		retryPolicy:             common.CreatePersistanceRetryPolicy(),
-		numOfWorker:             options.workerCount,
+		shard:                 shard,
+		cache:                 historyCache,
+		shutdownCh:            make(chan struct{}),
+		tasksCh:               make(chan *taskInfo, options.queueSize),
+		config:                shard.GetConfig(),
+		logger:                logger,
+		metricsClient:         shard.GetMetricsClient(),
+		timeSource:            shard.GetTimeSource(),
+		retryPolicy:           common.CreatePersistanceRetryPolicy(),
+		minWorkerCount:        options.minWorkerCount,
+		maxWorkerCount:        options.maxWorkerCount,
+		metricScope:           options.metricScope,
+		cancelCtx:             cancelCtx,
+		cancelFn:              cancelFn,
+		isolationGroupLimiter: isolationGroupLimiter,
+		throttledTasks:        make(map[string]*throttledTasks),
+		admittedTasksCh:       make(chan *taskInfo),
 	}
 
 	return base
 }
 
 func (t *taskProcessor) start() {
-	for i := 0; i < t.numOfWorker; i++ {
-		t.workerWG.Add(1)
-		notificationChan := t.workerNotificationChans[i]
-		go t.taskWorker(notificationChan)
-	}
+	t.workersLock.Lock()
+	t.setWorkerCountLocked(t.clampWorkerCount(0))
+	t.workersLock.Unlock()
+
+	t.workerWG.Add(1)
+	go t.workerPoolResizeLoop()
+
 	t.logger.Info("Task processor started.")
 }
 
 func (t *taskProcessor) stop() {
 	close(t.shutdownCh)
+	t.cancelFn()
 	if success := common.AwaitWaitGroup(&t.workerWG, time.Minute); !success {
 		t.logger.Warn("Task processor timed out on shutdown.")
 	}
@@ -153,6 +203,7 @@ func (t *taskProcessor) stop() {
 
 func (t *taskProcessor) taskWorker(
 	notificationChan chan struct{},
+	stopCh <-chan struct{},
 ) {
 	defer t.workerWG.Done()
 
@@ -160,16 +211,241 @@ func (t *taskProcessor) taskWorker(
 		select {
 		case <-t.shutdownCh:
 			return
+		case <-stopCh:
+			return
 		case task, ok := <-t.tasksCh:
 			if !ok {
 				return
 			}
+			if !t.admitTask(task) {
+				continue
+			}
+			t.processTaskAndAck(notificationChan, task)
+			task.releaseIsolationGroup()
+		case task := <-t.admittedTasksCh:
 			t.processTaskAndAck(notificationChan, task)
+			task.releaseIsolationGroup()
+		}
+	}
+}
+
+// admitTask acquires a slot of the isolation group of the task. If the group has no slot available,
+// the task is parked for the dispatcher of the group so that the worker can process tasks of other groups.
+func (t *taskProcessor) admitTask(
+	task *taskInfo,
+) bool {
+	if t.isolationGroupLimiter == nil {
+		task.releaseIsolationGroup = func() {}
+		return true
+	}
+
+	group := t.isolationGroupLimiter.Group(t.getNamespaceNameByID(task.task.GetNamespaceId()))
+	release, ok := t.isolationGroupLimiter.TryAcquire(group)
+	if ok {
+		task.releaseIsolationGroup = release
+		return true
+	}
+
+	t.metricsClient.Scope(t.metricScope, metrics.IsolationGroupTag(group)).IncCounter(metrics.TaskIsolationGroupThrottledCounter)
+	throttled := t.getOrCreateThrottledTasks(group)
+	throttled.tasks.Add(task)
+	select {
+	case throttled.notifyCh <- struct{}{}:
+	default:
+	}
+	return false
+}
+
+func (t *taskProcessor) getOrCreateThrottledTasks(
+	group string,
+) *throttledTasks {
+	t.throttledTasksLock.Lock()
+	defer t.throttledTasksLock.Unlock()
+
+	throttled, ok := t.throttledTasks[group]
+	if !ok {
+		throttled = &throttledTasks{
+			tasks:    collection.NewConcurrentQueue(),
+			notifyCh: make(chan struct{}, 1),
+		}
+		t.throttledTasks[group] = throttled
+		t.workerWG.Add(1)
+		go t.dispatchThrottledTasks(group, throttled)
+	}
+	return throttled
+}
+
+// dispatchThrottledTasks hands off tasks of the isolation group to free workers in order, once a slot of the group is acquired
+func (t *taskProcessor) dispatchThrottledTasks(
+	group string,
+	throttled *throttledTasks,
+) {
+	defer t.workerWG.Done()
+
+	for {
+		select {
+		case <-t.shutdownCh:
+			return
+		default:
+		}
+
+		item := throttled.tasks.Remove()
+		if item == nil {
+			select {
+			case <-t.shutdownCh:
+				return
+			case <-throttled.notifyCh:
+				continue
+			}
+		}
+
+		task := item.(*taskInfo)
+		release, err := t.isolationGroupLimiter.Acquire(t.cancelCtx, group)
+		if err != nil {
+			// shutting down
+			return
+		}
+		task.releaseIsolationGroup = release
+		select {
+		case t.admittedTasksCh <- task:
+		case <-t.shutdownCh:
+			release()
+			return
+		}
+	}
+}
+
+func (t *taskProcessor) workerPoolResizeLoop() {
+	defer t.workerWG.Done()
+
+	ticker := time.NewTicker(workerPoolResizeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-t.shutdownCh:
+			return
+		case <-ticker.C:
+			t.resizeWorkerPool()
 		}
 	}
 }
 
+// resizeWorkerPool adjusts the number of workers to the task backlog. The pool grows while tasks are backlogged
+// and shrinks when workers are idle, or when task latency climbs (i.e. persistence degrades) as more workers
+// would only add load to persistence. Unless autoscaling is enabled, the pool has max worker count workers.
+func (t *taskProcessor) resizeWorkerPool() {
+	t.workersLock.Lock()
+	defer t.workersLock.Unlock()
+
+	scope := t.metricsClient.Scope(t.metricScope)
+	current := len(t.workerStopChans)
+	target := t.targetWorkerCount(current, len(t.tasksCh))
+	if target > current {
+		scope.IncCounter(metrics.TaskWorkerPoolScaleUpCounter)
+	} else if target < current {
+		scope.IncCounter(metrics.TaskWorkerPoolScaleDownCounter)
+	}
+	t.setWorkerCountLocked(target)
+	scope.UpdateGauge(metrics.TaskWorkerPoolSize, float64(target))
+}
+
+func (t *taskProcessor) targetWorkerCount(
+	current int,
+	backlog int,
+) int {
+	shortLatency, longLatency := t.getTaskLatency()
+
+	target := current
+	switch {
+	case longLatency > 0 && shortLatency > longLatency*workerPoolLatencyBackoffRatio:
+		t.metricsClient.IncCounter(t.metricScope, metrics.TaskWorkerPoolLatencyBackoffCounter)
+		target = current - int(math.Max(1, float64(current)/4))
+	case backlog > current:
+		target = current + int(math.Max(1, float64(current)/2))
+	case backlog == 0:
+		target = current - 1
+	}
+	return t.clampWorkerCount(target)
+}
+
+func (t *taskProcessor) clampWorkerCount(
+	count int,
+) int {
+	maxCount := t.maxWorkerCount()
+	if !t.config.EnableTaskWorkerPoolAutoscaling() {
+		return maxCount
+	}
+	minCount := t.minWorkerCount()
+	if minCount > maxCount {
+		minCount = maxCount
+	}
+	if count < minCount {
+		return minCount
+	}
+	if count > maxCount {
+		return maxCount
+	}
+	return count
+}
+
+func (t *taskProcessor) setWorkerCountLocked(
+	count int,
+) {
+	for len(t.workerStopChans) < count {
+		notificationChan := make(chan struct{}, 1)
+		stopCh := make(chan struct{})
+		t.workerNotificationChans = append(t.workerNotificationChans, notificationChan)
+		t.workerStopChans = append(t.workerStopChans, stopCh)
+		t.workerWG.Add(1)
+		go t.taskWorker(notificationChan, stopCh)
+	}
+	for len(t.workerStopChans) > count {
+		last := len(t.workerStopChans) - 1
+		// the worker stops after the task it is processing
+		close(t.workerStopChans[last])
+		t.workerNotificationChans = t.workerNotificationChans[:last]
+		t.workerStopChans = t.workerStopChans[:last]
+	}
+}
+
+func (t *taskProcessor) workerCount() int {
+	t.workersLock.Lock()
+	defer t.workersLock.Unlock()
+
+	return len(t.workerStopChans)
+}
+
+func (t *taskProcessor) recordTaskLatency(
+	latency time.Duration,
+) {
+	t.latencyLock.Lock()
+	defer t.latencyLock.Unlock()
+
+	sample := float64(latency)
+	if sample <= 0 {
+		return
+	}
+	if t.longLatency == 0 {
+		t.longLatency = sample
+		t.shortLatency = sample
+		return
+	}
+	t.shortLatency = ewma(t.shortLatency, sample, shortLatencyWindow)
+	t.longLatency = ewma(t.longLatency, sample, longLatencyWindow)
+}
+
+func (t *taskProcessor) getTaskLatency() (float64, float64) {
+	t.latencyLock.Lock()
+	defer t.latencyLock.Unlock()
+
+	return t.shortLatency, t.longLatency
+}
+
 func (t *taskProcessor) retryTasks() {
+	t.workersLock.Lock()
+	defer t.workersLock.Unlock()
+
 	for _, workerNotificationChan := range t.workerNotificationChans {
 		select {
 		case workerNotificationChan <- struct{}{}:
@@ -264,8 +540,10 @@ func (t *taskProcessor) processTaskOnce(
 	scopeIdx, err := task.processor.process(task)
 	scope := t.metricsClient.Scope(scopeIdx).Tagged(t.getNamespaceTagByID(task.task.GetNamespaceId()))
 	if task.shouldProcessTask {
+		latency := time.Since(startTime)
 		scope.IncCounter(metrics.TaskRequests)
-		scope.RecordTimer(metrics.TaskProcessingLatency, time.Since(startTime))
+		scope.RecordTimer(metrics.TaskProcessingLatency, latency)
+		t.recordTaskLatency(latency)
 	}
 
 	return scope, err
@@ -347,6 +625,15 @@ func (t *taskProcessor) ackTaskOnce(
 	}
 }
 
+func (t *taskProcessor) getNamespaceNameByID(namespaceID string) string {
+	namespace, err := t.shard.GetNamespaceCache().GetNamespaceName(namespaceID)
+	if err != nil {
+		// tasks of unknown namespaces are in the default isolation group
+		return ""
+	}
+	return namespace
+}
+
 func (t *taskProcessor) getNamespaceTagByID(namespaceID string) metrics.Tag {
 	namespace, err := t.shard.GetNamespaceCache().GetNamespaceName(namespaceID)
 	if err != nil {
@@ -355,3 +642,12 @@ func (t *taskProcessor) getNamespaceTagByID(namespaceID string) metrics.Tag {
 	}
 	return metrics.NamespaceTag(namespace)
 }
+
+func ewma(
+	average float64,
+	sample float64,
+	window int,
+) float64 {
+	factor := 2 / float64(window+1)
+	return average*(1-factor) + sample*factor
+}
@@ -36,11 +36,14 @@ import (
 	"go.temporal.io/api/serviceerror"
 
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/tests"
@@ -106,10 +109,11 @@ func (s *taskProcessorSuite) SetupTest() {
 		metricsClient: s.mockShard.GetMetricsClient(),
 	}
 	options := taskProcessorOptions{
-		queueSize:   s.mockShard.GetConfig().TimerTaskBatchSize() * s.mockShard.GetConfig().TimerTaskWorkerCount(),
-		workerCount: s.mockShard.GetConfig().TimerTaskWorkerCount(),
+		queueSize:      s.mockShard.GetConfig().TimerTaskBatchSize() * s.mockShard.GetConfig().TimerTaskWorkerCount(),
+		minWorkerCount: dynamicconfig.GetIntPropertyFn(2),
+		maxWorkerCount: dynamicconfig.GetIntPropertyFn(8),
 	}
-	s.taskProcessor = newTaskProcessor(options, s.mockShard, h.historyCache, s.logger)
+	s.taskProcessor = newTaskProcessor(options, s.mockShard, h.historyCache, nil, s.logger)
 }
 
 func (s *taskProcessorSuite) TearDownTest() {
@@ -200,6 +204,207 @@ func (s *taskProcessorSuite) TestProcessTaskAndAck_NamespaceTrue_ProcessErrNoErr
 	)
 }
 
+func (s *taskProcessorSuite) TestAdmitTask_IsolationGroup() {
+	limiter := quotas.NewIsolationGroupLimiter(
+		func(namespace string) string { return "batch" },
+		func() map[string]interface{} { return map[string]interface{}{"batch": 1} },
+	)
+	s.taskProcessor.isolationGroupLimiter = limiter
+	defer s.taskProcessor.stop()
+	s.mockShard.Resource.NamespaceCache.EXPECT().GetNamespaceName(tests.NamespaceID).Return(tests.Namespace, nil).AnyTimes()
+
+	task1 := newTaskInfo(s.mockProcessor, &persistencespb.TimerTaskInfo{NamespaceId: tests.NamespaceID}, s.logger)
+	s.True(s.taskProcessor.admitTask(task1))
+	s.Equal(1, limiter.InFlight("batch"))
+
+	// the budget of the group is exhausted, the task is dispatched again once a slot is released
+	task2 := newTaskInfo(s.mockProcessor, &persistencespb.TimerTaskInfo{NamespaceId: tests.NamespaceID}, s.logger)
+	s.False(s.taskProcessor.admitTask(task2))
+	select {
+	case <-s.taskProcessor.admittedTasksCh:
+		s.Fail("throttled task must not be dispatched before a slot is released")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	task1.releaseIsolationGroup()
+	select {
+	case task := <-s.taskProcessor.admittedTasksCh:
+		s.Equal(task2, task)
+		s.Equal(1, limiter.InFlight("batch"))
+		task.releaseIsolationGroup()
+	case <-time.After(time.Second):
+		s.Fail("throttled task must be dispatched once a slot is released")
+	}
+	s.Equal(0, limiter.InFlight("batch"))
+}
+
+func (s *taskProcessorSuite) TestIsolationGroup_BacklogExceedsQueueSize() {
+	limiter := quotas.NewIsolationGroupLimiter(
+		func(namespace string) string {
+			if namespace == tests.Namespace {
+				return "batch"
+			}
+			return "online"
+		},
+		func() map[string]interface{} { return map[string]interface{}{"batch": 1, "online": 1} },
+	)
+	options := taskProcessorOptions{
+		queueSize:      2,
+		minWorkerCount: dynamicconfig.GetIntPropertyFn(2),
+		maxWorkerCount: dynamicconfig.GetIntPropertyFn(2),
+	}
+	processor := newTaskProcessor(options, s.mockShard, nil, limiter, s.logger)
+	processor.start()
+	defer processor.stop()
+
+	var taskFilter taskFilter = func(task queueTaskInfo) (bool, error) {
+		return true, nil
+	}
+	completedCh := make(chan *taskInfo, 100)
+	s.mockProcessor.EXPECT().getTaskFilter().Return(taskFilter).AnyTimes()
+	s.mockProcessor.EXPECT().process(gomock.Any()).Return(s.scopeIdx, nil).AnyTimes()
+	s.mockProcessor.EXPECT().complete(gomock.Any()).Do(func(task *taskInfo) { completedCh <- task }).AnyTimes()
+	s.mockShard.Resource.NamespaceCache.EXPECT().GetNamespaceName(tests.NamespaceID).Return(tests.Namespace, nil).AnyTimes()
+	s.mockShard.Resource.NamespaceCache.EXPECT().GetNamespaceName(tests.TargetNamespaceID).Return(tests.TargetNamespace, nil).AnyTimes()
+
+	// the batch group has no slot available, its tasks pile up beyond the size of the task queue
+	release, ok := limiter.TryAcquire("batch")
+	s.True(ok)
+	numBatchTasks := 5 * cap(processor.tasksCh)
+	for i := 0; i < numBatchTasks; i++ {
+		task := newTaskInfo(s.mockProcessor, &persistencespb.TimerTaskInfo{NamespaceId: tests.NamespaceID}, s.logger)
+		s.True(processor.addTask(task))
+	}
+
+	// tasks of other groups are still processed
+	onlineTask := newTaskInfo(s.mockProcessor, &persistencespb.TimerTaskInfo{NamespaceId: tests.TargetNamespaceID}, s.logger)
+	s.True(processor.addTask(onlineTask))
+	select {
+	case task := <-completedCh:
+		s.Equal(onlineTask, task)
+	case <-time.After(time.Second):
+		s.Fail("task of other group must not be blocked by throttled tasks")
+	}
+
+	// throttled tasks are processed once the slot is released
+	release()
+	for i := 0; i < numBatchTasks; i++ {
+		select {
+		case task := <-completedCh:
+			s.Equal(tests.NamespaceID, task.task.GetNamespaceId())
+		case <-time.After(time.Second):
+			s.FailNow("throttled task must be processed once a slot is released")
+		}
+	}
+	s.Eventually(func() bool { return limiter.InFlight("batch") == 0 }, time.Second, 10*time.Millisecond)
+}
+
+func (s *taskProcessorSuite) TestIsolationGroup_StopWhileThrottled() {
+	limiter := quotas.NewIsolationGroupLimiter(
+		func(namespace string) string { return "batch" },
+		func() map[string]interface{} { return map[string]interface{}{"batch": 1} },
+	)
+	options := taskProcessorOptions{
+		queueSize:      2,
+		minWorkerCount: dynamicconfig.GetIntPropertyFn(2),
+		maxWorkerCount: dynamicconfig.GetIntPropertyFn(2),
+	}
+	processor := newTaskProcessor(options, s.mockShard, nil, limiter, s.logger)
+	processor.start()
+
+	// tasks are never processed, the slot of the group is held until the processor is stopped
+	s.mockProcessor.EXPECT().complete(gomock.Any()).Times(0)
+	s.mockShard.Resource.NamespaceCache.EXPECT().GetNamespaceName(tests.NamespaceID).Return(tests.Namespace, nil).AnyTimes()
+	release, ok := limiter.TryAcquire("batch")
+	s.True(ok)
+	for i := 0; i < 5; i++ {
+		task := newTaskInfo(s.mockProcessor, &persistencespb.TimerTaskInfo{NamespaceId: tests.NamespaceID}, s.logger)
+		s.True(processor.addTask(task))
+	}
+	s.Eventually(func() bool {
+		processor.throttledTasksLock.Lock()
+		defer processor.throttledTasksLock.Unlock()
+		throttled, ok := processor.throttledTasks["batch"]
+		return ok && throttled.tasks.Len() > 0
+	}, time.Second, 10*time.Millisecond)
+
+	stoppedCh := make(chan struct{})
+	go func() {
+		processor.stop()
+		close(stoppedCh)
+	}()
+	select {
+	case <-stoppedCh:
+	case <-time.After(5 * time.Second):
+		s.FailNow("processor must stop while tasks are throttled")
+	}
+	// the dispatcher of the group is stopped too, so no task is dispatched once the slot is released
+	s.True(common.AwaitWaitGroup(&processor.workerWG, 100*time.Millisecond))
+	release()
+	select {
+	case <-processor.admittedTasksCh:
+		s.Fail("throttled task must not be dispatched after the processor is stopped")
+	case <-time.After(50 * time.Millisecond):
+	}
+	s.Equal(0, limiter.InFlight("batch"))
+}
+
+func (s *taskProcessorSuite) TestTargetWorkerCount() {
+	s.taskProcessor.config.EnableTaskWorkerPoolAutoscaling = dynamicconfig.GetBoolPropertyFn(true)
+
+	// grows up to max while tasks are backlogged
+	s.Equal(2, s.taskProcessor.targetWorkerCount(0, 0))
+	s.Equal(3, s.taskProcessor.targetWorkerCount(2, 5))
+	s.Equal(6, s.taskProcessor.targetWorkerCount(4, 5))
+	s.Equal(6, s.taskProcessor.targetWorkerCount(6, 5))
+	s.Equal(8, s.taskProcessor.targetWorkerCount(7, 100))
+	s.Equal(8, s.taskProcessor.targetWorkerCount(10, 100))
+
+	// shrinks down to min while idle
+	s.Equal(3, s.taskProcessor.targetWorkerCount(4, 0))
+	s.Equal(2, s.taskProcessor.targetWorkerCount(2, 0))
+
+	// shrinks when latency climbs, regardless of backlog
+	for i := 0; i < 100; i++ {
+		s.taskProcessor.recordTaskLatency(10 * time.Millisecond)
+	}
+	s.Equal(8, s.taskProcessor.targetWorkerCount(8, 100))
+	for i := 0; i < 10; i++ {
+		s.taskProcessor.recordTaskLatency(100 * time.Millisecond)
+	}
+	s.Equal(6, s.taskProcessor.targetWorkerCount(8, 100))
+	s.Equal(2, s.taskProcessor.targetWorkerCount(2, 100))
+}
+
+func (s *taskProcessorSuite) TestTargetWorkerCount_AutoscalingDisabled() {
+	s.Equal(8, s.taskProcessor.targetWorkerCount(0, 0))
+	s.Equal(8, s.taskProcessor.targetWorkerCount(2, 100))
+	s.Equal(8, s.taskProcessor.targetWorkerCount(8, 0))
+}
+
+func (s *taskProcessorSuite) TestSetWorkerCount() {
+	s.taskProcessor.workersLock.Lock()
+	s.taskProcessor.setWorkerCountLocked(5)
+	s.taskProcessor.workersLock.Unlock()
+	s.Equal(5, s.taskProcessor.workerCount())
+	s.Len(s.taskProcessor.workerNotificationChans, 5)
+
+	s.taskProcessor.workersLock.Lock()
+	stopCh := s.taskProcessor.workerStopChans[4]
+	s.taskProcessor.setWorkerCountLocked(4)
+	s.taskProcessor.workersLock.Unlock()
+	s.Equal(4, s.taskProcessor.workerCount())
+	s.Len(s.taskProcessor.workerNotificationChans, 4)
+	select {
+	case <-stopCh:
+	default:
+		s.Fail("removed worker should be stopped")
+	}
+
+	close(s.taskProcessor.shutdownCh)
+	s.True(common.AwaitWaitGroup(&s.taskProcessor.workerWG, time.Second))
+}
+
 func (s *taskProcessorSuite) TestHandleTaskError_EntityNotExists() {
 	err := serviceerror.NewNotFound("")
 
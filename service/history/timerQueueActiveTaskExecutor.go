@@ -320,6 +320,14 @@ func (t *timerQueueActiveTaskExecutor) executeWorkflowTaskTimeoutTask(
 	scheduleWorkflowTask := false
 	switch task.TimeoutType {
 	case enumspb.TIMEOUT_TYPE_START_TO_CLOSE:
+		if workflowTask.LastHeartbeatTime != nil {
+			heartbeatExpiry := workflowTask.LastHeartbeatTime.Add(timestamp.DurationValue(workflowTask.WorkflowTaskTimeout))
+			if timestamp.TimeValue(task.GetVisibilityTime()).Before(heartbeatExpiry) {
+				// workflowTask has been heartbeated, a newer timer is generated
+				return nil
+			}
+		}
+
 		t.emitTimeoutMetricScopeWithNamespaceTag(
 			mutableState.GetExecutionInfo().NamespaceId,
 			metrics.TimerActiveTaskWorkflowTaskTimeoutScope,
@@ -384,6 +392,8 @@ func (t *timerQueueActiveTaskExecutor) executeWorkflowBackoffTimerTask(
 		t.metricsClient.IncCounter(metrics.TimerActiveTaskWorkflowBackoffTimerScope, metrics.WorkflowRetryBackoffTimerCount)
 	} else if task.WorkflowBackoffType == enumsspb.WORKFLOW_BACKOFF_TYPE_CRON {
 		t.metricsClient.IncCounter(metrics.TimerActiveTaskWorkflowBackoffTimerScope, metrics.WorkflowCronBackoffTimerCount)
+	} else if task.WorkflowBackoffType == enumsspb.WORKFLOW_BACKOFF_TYPE_DELAY_START {
+		t.metricsClient.IncCounter(metrics.TimerActiveTaskWorkflowBackoffTimerScope, metrics.WorkflowDelayStartBackoffTimerCount)
 	}
 
 	if mutableState.HasProcessedOrPendingWorkflowTask() {
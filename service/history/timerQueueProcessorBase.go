@@ -109,12 +109,15 @@ func newTimerQueueProcessorBase(
 	config := shard.GetConfig()
 
 	var taskProcessor *taskProcessor
+	// isolation groups are only enforced by taskProcessor, not by the priority task processor
 	if !config.TimerProcessorEnablePriorityTaskProcessor() {
 		options := taskProcessorOptions{
-			workerCount: config.TimerTaskWorkerCount(),
-			queueSize:   config.TimerTaskWorkerCount() * config.TimerTaskBatchSize(),
+			minWorkerCount: config.TimerTaskMinWorkerCount,
+			maxWorkerCount: config.TimerTaskWorkerCount,
+			queueSize:      config.TimerTaskWorkerCount() * config.TimerTaskBatchSize(),
+			metricScope:    scope,
 		}
-		taskProcessor = newTaskProcessor(options, shard, historyService.historyCache, logger)
+		taskProcessor = newTaskProcessor(options, shard, historyService.historyCache, historyService.isolationGroupLimiter, logger)
 	}
 
 	base := &timerQueueProcessorBase{
@@ -68,7 +68,8 @@ func newTransferQueueActiveProcessor(
 	config := shard.GetConfig()
 	options := &QueueProcessorOptions{
 		BatchSize:                           config.TransferTaskBatchSize,
-		WorkerCount:                         config.TransferTaskWorkerCount,
+		MinWorkerCount:                      config.TransferTaskMinWorkerCount,
+		MaxWorkerCount:                      config.TransferTaskWorkerCount,
 		MaxPollRPS:                          config.TransferProcessorMaxPollRPS,
 		MaxPollInterval:                     config.TransferProcessorMaxPollInterval,
 		MaxPollIntervalJitterCoefficient:    config.TransferProcessorMaxPollIntervalJitterCoefficient,
@@ -160,6 +161,7 @@ func newTransferQueueActiveProcessor(
 		queueAckMgr,
 		redispatchQueue,
 		historyService.historyCache,
+		historyService.isolationGroupLimiter,
 		transferQueueTaskInitializer,
 		logger,
 		shard.GetMetricsClient().Scope(metrics.TransferActiveQueueProcessorScope),
@@ -188,7 +190,8 @@ func newTransferQueueFailoverProcessor(
 	config := shard.GetConfig()
 	options := &QueueProcessorOptions{
 		BatchSize:                           config.TransferTaskBatchSize,
-		WorkerCount:                         config.TransferTaskWorkerCount,
+		MinWorkerCount:                      config.TransferTaskMinWorkerCount,
+		MaxWorkerCount:                      config.TransferTaskWorkerCount,
 		MaxPollRPS:                          config.TransferProcessorFailoverMaxPollRPS,
 		MaxPollInterval:                     config.TransferProcessorMaxPollInterval,
 		MaxPollIntervalJitterCoefficient:    config.TransferProcessorMaxPollIntervalJitterCoefficient,
@@ -296,6 +299,7 @@ func newTransferQueueFailoverProcessor(
 		queueAckMgr,
 		redispatchQueue,
 		historyService.historyCache,
+		historyService.isolationGroupLimiter,
 		transferQueueTaskInitializer,
 		logger,
 		shard.GetMetricsClient().Scope(metrics.TransferActiveQueueProcessorScope),
@@ -51,10 +51,12 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/primitives/timestamp"
+	serviceerrors "go.temporal.io/server/common/serviceerror"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/workflow"
+	"go.temporal.io/server/service/worker/callback"
 	"go.temporal.io/server/service/worker/parentclosepolicy"
 )
 
@@ -64,6 +66,7 @@ type (
 
 		historyClient           historyservice.HistoryServiceClient
 		parentClosePolicyClient parentclosepolicy.Client
+		callbackClient          callback.Client
 	}
 )
 
@@ -89,6 +92,12 @@ func newTransferQueueActiveTaskExecutor(
 			historyService.publicClient,
 			config.NumParentClosePolicySystemWorkflows(),
 		),
+		callbackClient: callback.NewClient(
+			shard.GetMetricsClient(),
+			shard.GetLogger(),
+			historyService.publicClient,
+			config.NumCallbackSystemWorkflows(),
+		),
 	}
 }
 
@@ -233,11 +242,26 @@ func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
 		taskScheduleToStartTimeoutSeconds = int64(workflowRunTimeout.Round(time.Second).Seconds())
 	}
 
+	workerBuildID := workflow.GetLastWorkerBuildID(executionInfo)
+	normalTaskQueue := &taskqueuepb.TaskQueue{
+		Name: executionInfo.TaskQueue,
+		Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+	}
+
 	// NOTE: do not access anything related mutable state after this lock release
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
-	return t.pushWorkflowTask(task, taskQueue, timestamp.DurationFromSeconds(taskScheduleToStartTimeoutSeconds))
+	scheduleToStartTimeout := timestamp.DurationFromSeconds(taskScheduleToStartTimeoutSeconds)
+	err = t.pushWorkflowTask(task, taskQueue, scheduleToStartTimeout, workerBuildID)
+	if _, ok := err.(*serviceerrors.StickyWorkerUnavailable); ok && taskQueue.GetKind() == enumspb.TASK_QUEUE_KIND_STICKY {
+		// the sticky worker is gone, dispatch to the normal task queue right away instead of
+		// waiting for the sticky schedule to start timeout; the timer is a no-op once the
+		// workflow task is started
+		t.metricsClient.IncCounter(metrics.TransferActiveTaskWorkflowTaskScope, metrics.StickyWorkflowTaskFallbackCount)
+		return t.pushWorkflowTask(task, normalTaskQueue, scheduleToStartTimeout, workerBuildID)
+	}
+	return err
 }
 
 func (t *transferQueueActiveTaskExecutor) processCloseExecution(
@@ -352,6 +376,17 @@ func (t *transferQueueActiveTaskExecutor) processCloseExecution(
 		}
 	}
 
+	if err := t.processCompletionCallback(
+		task,
+		namespace,
+		workflowTypeName,
+		workflowStatus,
+		workflowCloseTime,
+		visibilityMemo,
+	); err != nil {
+		return err
+	}
+
 	return t.processParentClosePolicy(task.GetNamespaceId(), namespace, children)
 }
 
@@ -1192,6 +1227,7 @@ func (t *transferQueueActiveTaskExecutor) startWorkflowWithRetry(
 			InitiatedId: task.GetScheduleId(),
 		},
 		t.shard.GetTimeSource().Now(),
+		0,
 	)
 
 	ctx, cancel := context.WithTimeout(context.Background(), transferActiveTaskDefaultTimeout)
@@ -1286,6 +1322,51 @@ func (t *transferQueueActiveTaskExecutor) resetWorkflow(
 	}
 }
 
+func (t *transferQueueActiveTaskExecutor) processCompletionCallback(
+	task *persistencespb.TransferTaskInfo,
+	namespace string,
+	workflowTypeName string,
+	workflowStatus enumspb.WorkflowExecutionStatus,
+	workflowCloseTime time.Time,
+	memo *commonpb.Memo,
+) error {
+
+	// callback is invoked when the whole chain of runs is closed
+	if workflowStatus == enumspb.WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW {
+		return nil
+	}
+
+	spec, err := callback.GetSpec(memo.GetFields())
+	if err == nil && spec != nil {
+		err = spec.Validate(t.config.CallbackAllowedHosts(namespace))
+	}
+	if err != nil {
+		// callback is invalid or not allowed for the namespace, retrying task won't help
+		t.metricsClient.IncCounter(metrics.TransferActiveTaskCloseExecutionScope, metrics.CallbackRejected)
+		t.logger.Warn("Workflow completion callback rejected.",
+			tag.WorkflowNamespace(namespace),
+			tag.WorkflowID(task.GetWorkflowId()),
+			tag.WorkflowRunID(task.GetRunId()),
+			tag.Error(err),
+		)
+		return nil
+	}
+	if spec == nil {
+		return nil
+	}
+
+	return t.callbackClient.SendCallbackRequest(callback.Request{
+		Namespace:    namespace,
+		NamespaceID:  task.GetNamespaceId(),
+		WorkflowID:   task.GetWorkflowId(),
+		RunID:        task.GetRunId(),
+		WorkflowType: workflowTypeName,
+		Status:       workflowStatus,
+		CloseTime:    workflowCloseTime,
+		Spec:         *spec,
+	})
+}
+
 func (t *transferQueueActiveTaskExecutor) processParentClosePolicy(
 	namespaceID string,
 	namespace string,
@@ -69,12 +69,14 @@ import (
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/primitives/timestamp"
+	serviceerrors "go.temporal.io/server/common/serviceerror"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/events"
 	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/tests"
 	"go.temporal.io/server/service/history/workflow"
 	warchiver "go.temporal.io/server/service/worker/archiver"
+	"go.temporal.io/server/service/worker/callback"
 	"go.temporal.io/server/service/worker/parentclosepolicy"
 )
 
@@ -101,6 +103,7 @@ type (
 		mockArchivalMetadata        *archiver.MockArchivalMetadata
 		mockArchiverProvider        *provider.MockArchiverProvider
 		mockParentClosePolicyClient *parentclosepolicy.MockClient
+		mockCallbackClient          *callback.MockClient
 
 		logger                          log.Logger
 		namespaceID                     string
@@ -180,6 +183,7 @@ func (s *transferQueueActiveTaskExecutorSuite) SetupTest() {
 	s.mockShard.Resource.TimeSource = s.timeSource
 
 	s.mockParentClosePolicyClient = parentclosepolicy.NewMockClient(s.controller)
+	s.mockCallbackClient = callback.NewMockClient(s.controller)
 	s.mockArchivalClient = warchiver.NewMockClient(s.controller)
 	s.mockMatchingClient = s.mockShard.Resource.MatchingClient
 	s.mockHistoryClient = s.mockShard.Resource.HistoryClient
@@ -231,6 +235,7 @@ func (s *transferQueueActiveTaskExecutorSuite) SetupTest() {
 		config,
 	).(*transferQueueActiveTaskExecutor)
 	s.transferQueueActiveTaskExecutor.parentClosePolicyClient = s.mockParentClosePolicyClient
+	s.transferQueueActiveTaskExecutor.callbackClient = s.mockCallbackClient
 }
 
 func (s *transferQueueActiveTaskExecutorSuite) TearDownTest() {
@@ -522,6 +527,76 @@ func (s *transferQueueActiveTaskExecutorSuite) TestProcessWorkflowTask_Sticky_No
 	s.Nil(err)
 }
 
+func (s *transferQueueActiveTaskExecutorSuite) TestProcessWorkflowTask_Sticky_WorkerUnavailable() {
+
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: "some random workflow ID",
+		RunId:      uuid.New(),
+	}
+	workflowType := "some random workflow type"
+	taskQueueName := "some random task queue"
+	stickyTaskQueueName := "some random sticky task queue"
+	stickyTaskQueueTimeout := timestamp.DurationFromSeconds(233)
+
+	mutableState := workflow.TestGlobalMutableState(s.mockShard, s.mockShard.GetEventsCache(), s.logger, s.version, execution.GetRunId())
+	_, err := mutableState.AddWorkflowExecutionStartedEvent(
+		execution,
+		&historyservice.StartWorkflowExecutionRequest{
+			Attempt:     1,
+			NamespaceId: s.namespaceID,
+			StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+				WorkflowType:             &commonpb.WorkflowType{Name: workflowType},
+				TaskQueue:                &taskqueuepb.TaskQueue{Name: taskQueueName},
+				WorkflowExecutionTimeout: timestamp.DurationPtr(2 * time.Second),
+				WorkflowTaskTimeout:      timestamp.DurationPtr(1 * time.Second),
+			},
+		},
+	)
+	s.Nil(err)
+
+	di := addWorkflowTaskScheduledEvent(mutableState)
+	event := addWorkflowTaskStartedEvent(mutableState, di.ScheduleID, taskQueueName, uuid.New())
+	di.StartedID = event.GetEventId()
+	event = addWorkflowTaskCompletedEvent(mutableState, di.ScheduleID, di.StartedID, "some random identity")
+	s.NotNil(event)
+	// set the sticky taskqueue attr
+	executionInfo := mutableState.GetExecutionInfo()
+	executionInfo.StickyTaskQueue = stickyTaskQueueName
+	executionInfo.StickyScheduleToStartTimeout = stickyTaskQueueTimeout
+
+	// make another round of workflow task
+	taskID := int64(59)
+	di = addWorkflowTaskScheduledEvent(mutableState)
+
+	transferTask := &persistencespb.TransferTaskInfo{
+		Version:     s.version,
+		NamespaceId: s.namespaceID,
+		WorkflowId:  execution.GetWorkflowId(),
+		RunId:       execution.GetRunId(),
+		TaskId:      taskID,
+		TaskQueue:   stickyTaskQueueName,
+		TaskType:    enumsspb.TASK_TYPE_TRANSFER_WORKFLOW_TASK,
+		ScheduleId:  di.ScheduleID,
+	}
+
+	stickyRequest := s.createAddWorkflowTaskRequest(transferTask, mutableState)
+	normalRequest := s.createAddWorkflowTaskRequest(transferTask, mutableState)
+	normalRequest.TaskQueue = &taskqueuepb.TaskQueue{
+		Name: taskQueueName,
+		Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+	}
+
+	persistenceMutableState := s.createPersistenceMutableState(mutableState, di.ScheduleID, di.Version)
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	gomock.InOrder(
+		s.mockMatchingClient.EXPECT().AddWorkflowTask(gomock.Any(), stickyRequest, gomock.Any()).Return(nil, serviceerrors.NewStickyWorkerUnavailable()),
+		s.mockMatchingClient.EXPECT().AddWorkflowTask(gomock.Any(), normalRequest, gomock.Any()).Return(&matchingservice.AddWorkflowTaskResponse{}, nil),
+	)
+
+	err = s.transferQueueActiveTaskExecutor.execute(transferTask, true)
+	s.Nil(err)
+}
+
 func (s *transferQueueActiveTaskExecutorSuite) TestProcessWorkflowTask_WorkflowTaskNotSticky_MutableStateSticky() {
 
 	execution := commonpb.WorkflowExecution{
@@ -761,6 +836,86 @@ func (s *transferQueueActiveTaskExecutorSuite) TestProcessCloseExecution_NoParen
 	s.Nil(err)
 }
 
+func (s *transferQueueActiveTaskExecutorSuite) TestProcessCloseExecution_CompletionCallback() {
+
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: "some random workflow ID",
+		RunId:      uuid.New(),
+	}
+	workflowType := "some random workflow type"
+	taskQueueName := "some random task queue"
+	spec := callback.Spec{
+		URL:     "https://hooks.example.com/workflow-closed",
+		Headers: map[string]string{"X-Workflow-Id": "{{.WorkflowID}}"},
+	}
+	specPayload, err := payload.Encode(spec)
+	s.NoError(err)
+
+	mutableState := workflow.TestGlobalMutableState(s.mockShard, s.mockShard.GetEventsCache(), s.logger, s.version, execution.GetRunId())
+	_, err = mutableState.AddWorkflowExecutionStartedEvent(
+		execution,
+		&historyservice.StartWorkflowExecutionRequest{
+			Attempt:     1,
+			NamespaceId: s.namespaceID,
+			StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+				WorkflowType:             &commonpb.WorkflowType{Name: workflowType},
+				TaskQueue:                &taskqueuepb.TaskQueue{Name: taskQueueName},
+				WorkflowExecutionTimeout: timestamp.DurationPtr(2 * time.Second),
+				WorkflowTaskTimeout:      timestamp.DurationPtr(1 * time.Second),
+				Memo:                     &commonpb.Memo{Fields: map[string]*commonpb.Payload{callback.MemoKey: specPayload}},
+			},
+		},
+	)
+	s.Nil(err)
+
+	di := addWorkflowTaskScheduledEvent(mutableState)
+	event := addWorkflowTaskStartedEvent(mutableState, di.ScheduleID, taskQueueName, uuid.New())
+	di.StartedID = event.GetEventId()
+	event = addWorkflowTaskCompletedEvent(mutableState, di.ScheduleID, di.StartedID, "some random identity")
+
+	taskID := int64(59)
+	event = addCompleteWorkflowEvent(mutableState, event.GetEventId(), nil)
+
+	transferTask := &persistencespb.TransferTaskInfo{
+		Version:     s.version,
+		NamespaceId: s.namespaceID,
+		WorkflowId:  execution.GetWorkflowId(),
+		RunId:       execution.GetRunId(),
+		TaskId:      taskID,
+		TaskQueue:   taskQueueName,
+		TaskType:    enumsspb.TASK_TYPE_TRANSFER_CLOSE_EXECUTION,
+		ScheduleId:  event.GetEventId(),
+	}
+
+	s.transferQueueActiveTaskExecutor.config.CallbackAllowedHosts = func(namespace string) string { return "*.example.com" }
+	persistenceMutableState := s.createPersistenceMutableState(mutableState, event.GetEventId(), event.GetVersion())
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockArchivalMetadata.EXPECT().GetVisibilityConfig().Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "random URI"))
+	s.mockArchivalClient.EXPECT().Archive(gomock.Any(), gomock.Any()).Return(nil, nil)
+	s.mockSearchAttributesProvider.EXPECT().GetSearchAttributes(gomock.Any(), false)
+	s.mockCallbackClient.EXPECT().SendCallbackRequest(gomock.Any()).DoAndReturn(func(request callback.Request) error {
+		s.Equal(s.namespace, request.Namespace)
+		s.Equal(execution.GetWorkflowId(), request.WorkflowID)
+		s.Equal(execution.GetRunId(), request.RunID)
+		s.Equal(workflowType, request.WorkflowType)
+		s.Equal(enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, request.Status)
+		s.Equal(spec, request.Spec)
+		return nil
+	})
+
+	err = s.transferQueueActiveTaskExecutor.execute(transferTask, true)
+	s.Nil(err)
+
+	// callbacks to hosts which are not allowed are dropped
+	s.transferQueueActiveTaskExecutor.config.CallbackAllowedHosts = func(namespace string) string { return "" }
+	s.mockArchivalMetadata.EXPECT().GetVisibilityConfig().Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "random URI"))
+	s.mockArchivalClient.EXPECT().Archive(gomock.Any(), gomock.Any()).Return(nil, nil)
+	s.mockSearchAttributesProvider.EXPECT().GetSearchAttributes(gomock.Any(), false)
+
+	err = s.transferQueueActiveTaskExecutor.execute(transferTask, true)
+	s.Nil(err)
+}
+
 func (s *transferQueueActiveTaskExecutorSuite) TestProcessCloseExecution_NoParent_HasFewChildren() {
 
 	execution := commonpb.WorkflowExecution{
@@ -68,7 +68,8 @@ func newTransferQueueStandbyProcessor(
 	config := shard.GetConfig()
 	options := &QueueProcessorOptions{
 		BatchSize:                           config.TransferTaskBatchSize,
-		WorkerCount:                         config.TransferTaskWorkerCount,
+		MinWorkerCount:                      config.TransferTaskMinWorkerCount,
+		MaxWorkerCount:                      config.TransferTaskWorkerCount,
 		MaxPollRPS:                          config.TransferProcessorMaxPollRPS,
 		MaxPollInterval:                     config.TransferProcessorMaxPollInterval,
 		MaxPollIntervalJitterCoefficient:    config.TransferProcessorMaxPollIntervalJitterCoefficient,
@@ -162,6 +163,7 @@ func newTransferQueueStandbyProcessor(
 		queueAckMgr,
 		redispatchQueue,
 		historyService.historyCache,
+		historyService.isolationGroupLimiter,
 		transferQueueTaskInitializer,
 		logger,
 		shard.GetMetricsClient().Scope(metrics.TransferStandbyQueueProcessorScope),
@@ -199,6 +199,7 @@ func (t *transferQueueStandbyTaskExecutor) processWorkflowTask(
 			return newPushWorkflowTaskToMatchingInfo(
 				taskScheduleToStartTimeoutSeconds,
 				*taskQueue,
+				workflow.GetLastWorkerBuildID(executionInfo),
 			), nil
 		}
 
@@ -476,6 +477,7 @@ func (t *transferQueueStandbyTaskExecutor) pushWorkflowTask(
 		task.(*persistencespb.TransferTaskInfo),
 		&pushwtInfo.taskqueue,
 		timestamp.DurationFromSeconds(timeout),
+		pushwtInfo.workerBuildID,
 	)
 }
 
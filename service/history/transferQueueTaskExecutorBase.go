@@ -28,6 +28,7 @@ import (
 	"context"
 	"time"
 
+	"go.opentelemetry.io/otel/trace"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
@@ -42,6 +43,7 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/tracing"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/workflow"
@@ -130,6 +132,7 @@ func (t *transferQueueTaskExecutorBase) pushWorkflowTask(
 	task *persistencespb.TransferTaskInfo,
 	taskqueue *taskqueuepb.TaskQueue,
 	workflowTaskScheduleToStartTimeout *time.Duration,
+	workerBuildID string,
 ) error {
 
 	ctx, cancel := context.WithTimeout(context.Background(), transferActiveTaskDefaultTimeout)
@@ -139,6 +142,13 @@ func (t *transferQueueTaskExecutorBase) pushWorkflowTask(
 		t.logger.Fatal("Cannot process non workflow task", tag.TaskType(task.GetTaskType()))
 	}
 
+	// continue the trace of the request which scheduled the workflow task
+	ctx, span := tracing.StartSpanFromTraceContext(
+		ctx,
+		task.GetTraceContext(),
+		"TransferWorkflowTask",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
 	_, err := t.matchingClient.AddWorkflowTask(ctx, &m.AddWorkflowTaskRequest{
 		NamespaceId: task.GetNamespaceId(),
 		Execution: &commonpb.WorkflowExecution{
@@ -148,7 +158,9 @@ func (t *transferQueueTaskExecutorBase) pushWorkflowTask(
 		TaskQueue:              taskqueue,
 		ScheduleId:             task.GetScheduleId(),
 		ScheduleToStartTimeout: workflowTaskScheduleToStartTimeout,
+		WorkerBuildId:          workerBuildID,
 	})
+	tracing.EndSpan(span, err)
 	return err
 }
 
@@ -97,7 +97,8 @@ func newVisibilityQueueProcessor(
 
 	options := &QueueProcessorOptions{
 		BatchSize:                           config.VisibilityTaskBatchSize,
-		WorkerCount:                         config.VisibilityTaskWorkerCount,
+		MinWorkerCount:                      config.VisibilityTaskMinWorkerCount,
+		MaxWorkerCount:                      config.VisibilityTaskWorkerCount,
 		MaxPollRPS:                          config.VisibilityProcessorMaxPollRPS,
 		MaxPollInterval:                     config.VisibilityProcessorMaxPollInterval,
 		MaxPollIntervalJitterCoefficient:    config.VisibilityProcessorMaxPollIntervalJitterCoefficient,
@@ -191,6 +192,7 @@ func newVisibilityQueueProcessor(
 		queueAckMgr,
 		redispatchQueue,
 		historyService.historyCache,
+		historyService.isolationGroupLimiter,
 		visibilityQueueTaskInitializer,
 		logger,
 		shard.GetMetricsClient().Scope(metrics.VisibilityQueueProcessorScope),
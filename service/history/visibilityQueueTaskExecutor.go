@@ -182,7 +182,7 @@ func (t *visibilityQueueTaskExecutor) processStartOrUpsertExecution(
 	release(nil)
 
 	if isStartExecution {
-		return t.recordStartExecution(
+		err = t.recordStartExecution(
 			task.GetNamespaceId(),
 			task.GetWorkflowId(),
 			task.GetRunId(),
@@ -191,13 +191,18 @@ func (t *visibilityQueueTaskExecutor) processStartOrUpsertExecution(
 			workflowExecutionTime,
 			stateTransitionCount,
 			task.GetTaskId(),
+			task.GetBackfill(),
 			executionStatus,
 			taskQueue,
 			visibilityMemo,
 			searchAttr,
 		)
+		if err == nil {
+			t.emitVisibleLatency(metrics.VisibilityTaskStartExecutionScope, task)
+		}
+		return err
 	}
-	return t.upsertExecution(
+	err = t.upsertExecution(
 		task.GetNamespaceId(),
 		task.GetWorkflowId(),
 		task.GetRunId(),
@@ -206,11 +211,16 @@ func (t *visibilityQueueTaskExecutor) processStartOrUpsertExecution(
 		workflowExecutionTime,
 		stateTransitionCount,
 		task.GetTaskId(),
+		task.GetBackfill(),
 		executionStatus,
 		taskQueue,
 		visibilityMemo,
 		searchAttr,
 	)
+	if err == nil {
+		t.emitVisibleLatency(metrics.VisibilityTaskUpsertExecutionScope, task)
+	}
+	return err
 }
 func (t *visibilityQueueTaskExecutor) recordStartExecution(
 	namespaceID string,
@@ -221,6 +231,7 @@ func (t *visibilityQueueTaskExecutor) recordStartExecution(
 	executionTime time.Time,
 	stateTransitionCount int64,
 	taskID int64,
+	backfill bool,
 	status enumspb.WorkflowExecutionStatus,
 	taskQueue string,
 	visibilityMemo *commonpb.Memo,
@@ -249,6 +260,7 @@ func (t *visibilityQueueTaskExecutor) recordStartExecution(
 			StartTime:            startTime,
 			ExecutionTime:        executionTime,
 			StateTransitionCount: stateTransitionCount, TaskID: taskID,
+			Backfill:         backfill,
 			Status:           status,
 			ShardID:          t.shard.GetShardID(),
 			Memo:             visibilityMemo,
@@ -268,6 +280,7 @@ func (t *visibilityQueueTaskExecutor) upsertExecution(
 	executionTime time.Time,
 	stateTransitionCount int64,
 	taskID int64,
+	backfill bool,
 	status enumspb.WorkflowExecutionStatus,
 	taskQueue string,
 	visibilityMemo *commonpb.Memo,
@@ -291,6 +304,7 @@ func (t *visibilityQueueTaskExecutor) upsertExecution(
 			StartTime:            startTime,
 			ExecutionTime:        executionTime,
 			StateTransitionCount: stateTransitionCount, TaskID: taskID,
+			Backfill:         backfill,
 			ShardID:          t.shard.GetShardID(),
 			Status:           status,
 			Memo:             visibilityMemo,
@@ -363,7 +377,7 @@ func (t *visibilityQueueTaskExecutor) processCloseExecution(
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
-	return t.recordCloseExecution(
+	err = t.recordCloseExecution(
 		task.GetNamespaceId(),
 		task.GetWorkflowId(),
 		task.GetRunId(),
@@ -375,10 +389,16 @@ func (t *visibilityQueueTaskExecutor) processCloseExecution(
 		stateTransitionCount,
 		workflowHistoryLength,
 		task.GetTaskId(),
+		task.GetBackfill(),
 		visibilityMemo,
 		taskQueue,
 		searchAttr,
 	)
+	if err == nil {
+		t.emitVisibleLatency(metrics.VisibilityTaskCloseExecutionScope, task)
+		t.emitCloseLag(task, wfCloseTime)
+	}
+	return err
 }
 
 func (t *visibilityQueueTaskExecutor) recordCloseExecution(
@@ -393,6 +413,7 @@ func (t *visibilityQueueTaskExecutor) recordCloseExecution(
 	stateTransitionCount int64,
 	historyLength int64,
 	taskID int64,
+	backfill bool,
 	visibilityMemo *commonpb.Memo,
 	taskQueue string,
 	searchAttributes *commonpb.SearchAttributes,
@@ -426,6 +447,7 @@ func (t *visibilityQueueTaskExecutor) recordCloseExecution(
 				ExecutionTime:        executionTime,
 				StateTransitionCount: stateTransitionCount, Status: status,
 				TaskID:           taskID,
+				Backfill:         backfill,
 				ShardID:          t.shard.GetShardID(),
 				Memo:             visibilityMemo,
 				TaskQueue:        taskQueue,
@@ -449,6 +471,7 @@ func (t *visibilityQueueTaskExecutor) processDeleteExecution(
 			WorkflowID:  task.GetWorkflowId(),
 			RunID:       task.GetRunId(),
 			TaskID:      task.GetTaskId(),
+			Backfill:    task.GetBackfill(),
 		}
 		// TODO: expose GetVisibilityManager method on shardContext interface
 		return t.shard.GetService().GetVisibilityManager().DeleteWorkflowExecution(request) // delete from db
@@ -456,6 +479,45 @@ func (t *visibilityQueueTaskExecutor) processDeleteExecution(
 	return backoff.Retry(op, workflow.PersistenceOperationRetryPolicy, common.IsPersistenceTransientError)
 }
 
+// emitVisibleLatency records the time from the generation of the visibility task to the workflow record
+// being written to the visibility store, i.e. acked by the bulk processor for Elasticsearch, so that
+// the time until a workflow shows up in list results can be tracked per namespace.
+// Backfill tasks are delayed on purpose and are not recorded.
+func (t *visibilityQueueTaskExecutor) emitVisibleLatency(
+	scope int,
+	task *persistencespb.VisibilityTaskInfo,
+) {
+	if task.GetBackfill() || task.GetVisibilityTime() == nil {
+		return
+	}
+	namespaceEntry, err := t.shard.GetNamespaceCache().GetNamespaceByID(task.GetNamespaceId())
+	if err != nil {
+		return
+	}
+	t.metricsClient.Scope(scope, metrics.NamespaceTag(namespaceEntry.GetInfo().Name)).
+		RecordTimer(metrics.VisibilityTaskVisibleLatency, time.Since(timestamp.TimeValue(task.GetVisibilityTime())))
+}
+
+// emitCloseLag records the time from the workflow close event to the closed workflow record
+// becoming searchable per namespace, and keeps the last lag on the shard to be reported by shard stats.
+// Backfill tasks are delayed on purpose and are not recorded.
+func (t *visibilityQueueTaskExecutor) emitCloseLag(
+	task *persistencespb.VisibilityTaskInfo,
+	closeTime time.Time,
+) {
+	if task.GetBackfill() {
+		return
+	}
+	namespaceEntry, err := t.shard.GetNamespaceCache().GetNamespaceByID(task.GetNamespaceId())
+	if err != nil {
+		return
+	}
+	lag := time.Since(closeTime)
+	t.shard.UpdateVisibilityLag(lag)
+	t.metricsClient.Scope(metrics.VisibilityTaskCloseExecutionScope, metrics.NamespaceTag(namespaceEntry.GetInfo().Name)).
+		RecordTimer(metrics.VisibilityCloseLag, lag)
+}
+
 func getWorkflowMemo(
 	memoFields map[string]*commonpb.Payload,
 ) *commonpb.Memo {
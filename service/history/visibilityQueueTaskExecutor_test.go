@@ -234,8 +234,20 @@ func (s *visibilityQueueTaskExecutorSuite) TestProcessCloseExecution() {
 	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
 	s.mockVisibilityMgr.EXPECT().RecordWorkflowExecutionClosed(gomock.Any()).Return(nil)
 
+	scope := tally.NewTestScope("test", nil)
+	s.visibilityQueueTaskExecutor.metricsClient = metrics.NewClient(scope, metrics.History)
 	err = s.visibilityQueueTaskExecutor.execute(visibilityTask, true)
 	s.Nil(err)
+
+	var lags []time.Duration
+	for _, timer := range scope.Snapshot().Timers() {
+		if timer.Name() == "test.visibility_close_lag" && timer.Tags()["namespace"] == s.namespace {
+			lags = append(lags, timer.Values()...)
+		}
+	}
+	s.Len(lags, 1)
+	s.True(lags[0] > 0)
+	s.True(s.mockShard.GetVisibilityLag() >= lags[0])
 }
 
 func (s *visibilityQueueTaskExecutorSuite) TestProcessRecordWorkflowStartedTask() {
@@ -288,6 +300,64 @@ func (s *visibilityQueueTaskExecutorSuite) TestProcessRecordWorkflowStartedTask(
 	s.Nil(err)
 }
 
+func (s *visibilityQueueTaskExecutorSuite) TestProcessRecordWorkflowStartedTask_VisibleLatency() {
+
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: "some random workflow ID",
+		RunId:      uuid.New(),
+	}
+	workflowType := "some random workflow type"
+	taskQueueName := "some random task queue"
+
+	mutableState := workflow.TestGlobalMutableState(s.mockShard, s.mockShard.GetEventsCache(), s.logger, s.version, execution.GetRunId())
+
+	_, err := mutableState.AddWorkflowExecutionStartedEvent(
+		execution,
+		&historyservice.StartWorkflowExecutionRequest{
+			Attempt:     1,
+			NamespaceId: s.namespaceID,
+			StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+				WorkflowType:             &commonpb.WorkflowType{Name: workflowType},
+				TaskQueue:                &taskqueuepb.TaskQueue{Name: taskQueueName},
+				WorkflowExecutionTimeout: timestamp.DurationPtr(2 * time.Second),
+				WorkflowTaskTimeout:      timestamp.DurationPtr(1 * time.Second),
+			},
+		},
+	)
+	s.Nil(err)
+
+	taskID := int64(59)
+	di := addWorkflowTaskScheduledEvent(mutableState)
+
+	visibiltyTask := &persistencespb.VisibilityTaskInfo{
+		Version:        s.version,
+		NamespaceId:    s.namespaceID,
+		WorkflowId:     execution.GetWorkflowId(),
+		RunId:          execution.GetRunId(),
+		TaskId:         taskID,
+		TaskType:       enumsspb.TASK_TYPE_VISIBILITY_START_EXECUTION,
+		VisibilityTime: timestamp.TimePtr(time.Now().UTC().Add(-time.Second)),
+	}
+
+	scope := tally.NewTestScope("test", nil)
+	s.visibilityQueueTaskExecutor.metricsClient = metrics.NewClient(scope, metrics.History)
+	persistenceMutableState := s.createPersistenceMutableState(mutableState, di.ScheduleID, di.Version)
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockVisibilityMgr.EXPECT().RecordWorkflowExecutionStarted(gomock.Any()).Return(nil)
+
+	err = s.visibilityQueueTaskExecutor.execute(visibiltyTask, true)
+	s.Nil(err)
+
+	var latencies []time.Duration
+	for _, timer := range scope.Snapshot().Timers() {
+		if timer.Name() == "test.visibility_task_visible_latency" && timer.Tags()["namespace"] == s.namespace {
+			latencies = append(latencies, timer.Values()...)
+		}
+	}
+	s.Len(latencies, 1)
+	s.True(latencies[0] >= time.Second)
+}
+
 func (s *visibilityQueueTaskExecutorSuite) TestProcessUpsertWorkflowSearchAttributes() {
 
 	execution := commonpb.WorkflowExecution{
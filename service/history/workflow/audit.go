@@ -0,0 +1,97 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package workflow
+
+import (
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+// emitWorkflowStartedAuditRecord emits the audit record of a started workflow run
+func emitWorkflowStartedAuditRecord(
+	logger log.Logger,
+	enabled bool,
+	mutableState MutableState,
+) {
+
+	if !auditEnabled(enabled, mutableState) {
+		return
+	}
+	emitWorkflowAuditRecord(logger, mutableState, enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED)
+}
+
+// emitWorkflowClosedAuditRecord emits the audit record of a closed workflow run
+func emitWorkflowClosedAuditRecord(
+	logger log.Logger,
+	enabled bool,
+	mutableState MutableState,
+	completionEvent *historypb.HistoryEvent,
+) {
+
+	if !auditEnabled(enabled, mutableState) {
+		return
+	}
+
+	var tags []tag.Tag
+	if attr := completionEvent.GetWorkflowExecutionContinuedAsNewEventAttributes(); attr != nil {
+		tags = append(tags, tag.WorkflowNewRunID(attr.GetNewExecutionRunId()))
+	}
+	emitWorkflowAuditRecord(logger, mutableState, completionEvent.GetEventType(), tags...)
+}
+
+// auditEnabled returns true if the workflow audit stream is enabled for the namespace of the workflow.
+// Records are only emitted by the cluster where the namespace is active, so that a lifecycle
+// transition is not logged again when it is replicated to standby clusters.
+func auditEnabled(
+	enabled bool,
+	mutableState MutableState,
+) bool {
+
+	return enabled && mutableState.GetNamespaceEntry().IsNamespaceActive()
+}
+
+func emitWorkflowAuditRecord(
+	logger log.Logger,
+	mutableState MutableState,
+	eventType enumspb.EventType,
+	tags ...tag.Tag,
+) {
+
+	executionInfo := mutableState.GetExecutionInfo()
+	tags = append([]tag.Tag{
+		tag.ComponentWorkflowAudit,
+		tag.WorkflowLifecycleEvent(eventType),
+		tag.WorkflowNamespace(mutableState.GetNamespaceEntry().GetInfo().Name),
+		tag.WorkflowNamespaceID(executionInfo.NamespaceId),
+		tag.WorkflowID(executionInfo.WorkflowId),
+		tag.WorkflowRunID(mutableState.GetExecutionState().GetRunId()),
+		tag.WorkflowType(executionInfo.WorkflowTypeName),
+		tag.WorkflowTaskQueueName(executionInfo.TaskQueue),
+	}, tags...)
+	logger.Info("Workflow lifecycle transition.", tags...)
+}
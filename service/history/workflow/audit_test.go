@@ -0,0 +1,151 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package workflow
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+type (
+	auditSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller       *gomock.Controller
+		mockLogger       *log.MockLogger
+		mockMutableState *MockMutableState
+	}
+)
+
+func TestAuditSuite(t *testing.T) {
+	s := new(auditSuite)
+	suite.Run(t, s)
+}
+
+func (s *auditSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.mockLogger = log.NewMockLogger(s.controller)
+	s.mockMutableState = NewMockMutableState(s.controller)
+	s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{
+		NamespaceId:      "namespace-id",
+		WorkflowId:       "workflow-id",
+		WorkflowTypeName: "workflow-type",
+		TaskQueue:        "task-queue",
+	}).AnyTimes()
+	s.mockMutableState.EXPECT().GetExecutionState().Return(&persistencespb.WorkflowExecutionState{
+		RunId: "run-id",
+	}).AnyTimes()
+}
+
+func (s *auditSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *auditSuite) TestStarted() {
+	s.mockMutableState.EXPECT().GetNamespaceEntry().Return(s.namespaceEntry(cluster.TestCurrentClusterName)).AnyTimes()
+
+	var tags []tag.Tag
+	s.mockLogger.EXPECT().Info("Workflow lifecycle transition.", gomock.Any()).Do(func(_ string, t ...tag.Tag) {
+		tags = t
+	})
+	emitWorkflowStartedAuditRecord(s.mockLogger, true, s.mockMutableState)
+
+	fields := tagValues(tags)
+	s.Equal("WorkflowExecutionStarted", fields["wf-lifecycle-event"])
+	s.Equal("namespace", fields["wf-namespace"])
+	s.Equal("namespace-id", fields["wf-namespace-id"])
+	s.Equal("workflow-id", fields["wf-id"])
+	s.Equal("run-id", fields["wf-run-id"])
+	s.Equal("workflow-type", fields["wf-type"])
+	s.Equal("task-queue", fields["wf-task-queue-name"])
+}
+
+func (s *auditSuite) TestContinuedAsNew() {
+	s.mockMutableState.EXPECT().GetNamespaceEntry().Return(s.namespaceEntry(cluster.TestCurrentClusterName)).AnyTimes()
+
+	var tags []tag.Tag
+	s.mockLogger.EXPECT().Info("Workflow lifecycle transition.", gomock.Any()).Do(func(_ string, t ...tag.Tag) {
+		tags = t
+	})
+	emitWorkflowClosedAuditRecord(s.mockLogger, true, s.mockMutableState, &historypb.HistoryEvent{
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW,
+		Attributes: &historypb.HistoryEvent_WorkflowExecutionContinuedAsNewEventAttributes{
+			WorkflowExecutionContinuedAsNewEventAttributes: &historypb.WorkflowExecutionContinuedAsNewEventAttributes{
+				NewExecutionRunId: "new-run-id",
+			},
+		},
+	})
+
+	fields := tagValues(tags)
+	s.Equal("WorkflowExecutionContinuedAsNew", fields["wf-lifecycle-event"])
+	s.Equal("new-run-id", fields["wf-new-run-id"])
+}
+
+func (s *auditSuite) TestDisabled() {
+	emitWorkflowStartedAuditRecord(s.mockLogger, false, s.mockMutableState)
+}
+
+func (s *auditSuite) TestStandby() {
+	s.mockMutableState.EXPECT().GetNamespaceEntry().Return(s.namespaceEntry(cluster.TestAlternativeClusterName)).AnyTimes()
+
+	emitWorkflowClosedAuditRecord(s.mockLogger, true, s.mockMutableState, &historypb.HistoryEvent{
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED,
+	})
+}
+
+func (s *auditSuite) namespaceEntry(activeClusterName string) *cache.NamespaceCacheEntry {
+	return cache.NewGlobalNamespaceCacheEntryForTest(
+		&persistencespb.NamespaceInfo{Id: "namespace-id", Name: "namespace"},
+		&persistencespb.NamespaceConfig{},
+		&persistencespb.NamespaceReplicationConfig{
+			ActiveClusterName: activeClusterName,
+			Clusters:          []string{cluster.TestCurrentClusterName, cluster.TestAlternativeClusterName},
+		},
+		1,
+		cluster.NewTestClusterMetadata(cluster.NewTestClusterMetadataConfig(true, true)),
+	)
+}
+
+func tagValues(tags []tag.Tag) map[string]interface{} {
+	values := make(map[string]interface{}, len(tags))
+	for _, t := range tags {
+		values[t.Key()] = t.Value()
+	}
+	return values
+}
@@ -55,6 +55,7 @@ type (
 		logger           log.Logger
 		metricsClient    metrics.Client
 		config           *configs.Config
+		loadGroup        *currentExecutionLoadGroup
 	}
 )
 
@@ -79,6 +80,7 @@ func NewCache(shard shard.Context) *Cache {
 		logger:           log.With(shard.GetLogger(), tag.ComponentHistoryCache),
 		metricsClient:    shard.GetMetricsClient(),
 		config:           config,
+		loadGroup:        newCurrentExecutionLoadGroup(),
 	}
 }
 
@@ -215,10 +217,7 @@ func (c *Cache) validateWorkflowExecutionInfo(
 
 	// RunID is not provided, lets try to retrieve the RunID for current active execution
 	if execution.GetRunId() == "" {
-		response, err := c.getCurrentExecutionWithRetry(&persistence.GetCurrentExecutionRequest{
-			NamespaceID: namespaceID,
-			WorkflowID:  execution.GetWorkflowId(),
-		})
+		response, err := c.getCurrentExecution(namespaceID, execution.GetWorkflowId())
 
 		if err != nil {
 			return err
@@ -231,6 +230,29 @@ func (c *Cache) validateWorkflowExecutionInfo(
 	return nil
 }
 
+// getCurrentExecution reads the current run of the workflow, concurrent reads of the same workflow are deduplicated
+func (c *Cache) getCurrentExecution(
+	namespaceID string,
+	workflowID string,
+) (*persistence.GetCurrentExecutionResponse, error) {
+
+	loadFn := func() (*persistence.GetCurrentExecutionResponse, error) {
+		return c.getCurrentExecutionWithRetry(&persistence.GetCurrentExecutionRequest{
+			NamespaceID: namespaceID,
+			WorkflowID:  workflowID,
+		})
+	}
+	if !c.config.HistoryCacheLoadDeduplication() {
+		return loadFn()
+	}
+
+	response, deduplicated, err := c.loadGroup.load(namespaceID, workflowID, loadFn)
+	if deduplicated {
+		c.metricsClient.IncCounter(metrics.HistoryCacheGetOrCreateScope, metrics.CurrentExecutionLoadDeduplicated)
+	}
+	return response, err
+}
+
 func (c *Cache) getCurrentExecutionWithRetry(
 	request *persistence.GetCurrentExecutionRequest,
 ) (*persistence.GetCurrentExecutionResponse, error) {
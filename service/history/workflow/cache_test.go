@@ -282,3 +282,47 @@ func (s *historyCacheSuite) TestHistoryCacheConcurrentAccess() {
 	s.Nil(context.(*ContextImpl).MutableState)
 	release(nil)
 }
+
+func (s *historyCacheSuite) TestHistoryCacheConcurrentCurrentExecutionLoad() {
+	namespaceID := "test_namespace_id"
+	workflowID := "wf-cache-test-current-execution"
+	runID := uuid.New()
+	s.cache = NewCache(s.mockShard)
+
+	coroutineCount := 10
+	loadStarted := make(chan struct{})
+	unblockLoad := make(chan struct{})
+	s.mockShard.Resource.ExecutionMgr.EXPECT().GetCurrentExecution(&persistence.GetCurrentExecutionRequest{
+		NamespaceID: namespaceID,
+		WorkflowID:  workflowID,
+	}).DoAndReturn(func(_ *persistence.GetCurrentExecutionRequest) (*persistence.GetCurrentExecutionResponse, error) {
+		close(loadStarted)
+		<-unblockLoad
+		return &persistence.GetCurrentExecutionResponse{RunID: runID}, nil
+	}).Times(1)
+
+	waitGroup := &sync.WaitGroup{}
+	testFn := func() {
+		defer waitGroup.Done()
+		context, release, err := s.cache.GetOrCreateWorkflowExecution(
+			ctx.Background(),
+			namespaceID,
+			commonpb.WorkflowExecution{WorkflowId: workflowID},
+			CallerTypeAPI,
+		)
+		s.NoError(err)
+		s.Equal(runID, context.GetExecution().GetRunId())
+		release(nil)
+	}
+
+	waitGroup.Add(1)
+	go testFn()
+	<-loadStarted
+	for i := 1; i < coroutineCount; i++ {
+		waitGroup.Add(1)
+		go testFn()
+	}
+	waitCurrentExecutionLoadWaiters(s.cache.loadGroup, namespaceID, workflowID, coroutineCount-1)
+	close(unblockLoad)
+	waitGroup.Wait()
+}
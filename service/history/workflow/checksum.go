@@ -27,10 +27,13 @@ package workflow
 import (
 	"fmt"
 
+	enumspb "go.temporal.io/api/enums/v1"
 	checksumspb "go.temporal.io/server/api/checksum/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/checksum"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/versionhistory"
 )
 
 const (
@@ -57,6 +60,43 @@ func verifyMutableStateChecksum(
 	return checksum.Verify(payload, csum)
 }
 
+// verifyMutableStateBranchToken checks that all version histories point to branches of
+// the same history tree and that the current one ends right before the next event ID.
+func verifyMutableStateBranchToken(
+	ms MutableState,
+) error {
+	versionHistories := ms.GetExecutionInfo().GetVersionHistories()
+	if versionHistories == nil {
+		return nil
+	}
+
+	var treeID string
+	for index, versionHistory := range versionHistories.Histories {
+		branch, err := serialization.HistoryBranchFromBlob(versionHistory.GetBranchToken(), enumspb.ENCODING_TYPE_PROTO3.String())
+		if err != nil {
+			return fmt.Errorf("invalid branch token of version history %v: %v", index, err)
+		}
+		if index == 0 {
+			treeID = branch.GetTreeId()
+		} else if branch.GetTreeId() != treeID {
+			return fmt.Errorf("version history %v has tree ID %v, expected %v", index, branch.GetTreeId(), treeID)
+		}
+	}
+
+	currentVersionHistory, err := versionhistory.GetCurrentVersionHistory(versionHistories)
+	if err != nil {
+		return err
+	}
+	lastItem, err := versionhistory.GetLastVersionHistoryItem(currentVersionHistory)
+	if err != nil {
+		return err
+	}
+	if lastItem.GetEventId() != ms.GetNextEventID()-1 {
+		return fmt.Errorf("current version history ends at event ID %v, next event ID is %v", lastItem.GetEventId(), ms.GetNextEventID())
+	}
+	return nil
+}
+
 func newMutableStateChecksumPayload(ms MutableState) *checksumspb.MutableStateChecksumPayload {
 	executionInfo := ms.GetExecutionInfo()
 	executionState := ms.GetExecutionState()
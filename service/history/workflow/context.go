@@ -447,6 +447,7 @@ func (c *ContextImpl) CreateWorkflowExecution(
 
 	NotifyWorkflowSnapshotTasks(c.engine, newWorkflow)
 	emitStateTransitionCount(c.metricsClient, newMutableState)
+	emitWorkflowStartedAuditRecord(c.shard.GetLogger(), c.config.EnableWorkflowAuditLog(c.GetNamespace()), newMutableState)
 
 	return nil
 }
@@ -563,11 +564,19 @@ func (c *ContextImpl) UpdateWorkflowExecutionAsActive(
 	now time.Time,
 ) error {
 
-	// We only perform this check on active cluster for the namespace
+	// We only perform these checks on active cluster for the namespace
 	forceTerminate, err := c.enforceSizeCheck()
 	if err != nil {
 		return err
 	}
+	forceTerminateErr := consts.ErrSizeExceedsLimit
+	if !forceTerminate {
+		forceTerminate, err = c.enforceStateTransitionCountCheck()
+		if err != nil {
+			return err
+		}
+		forceTerminateErr = consts.ErrStateTransitionCountExceedsLimit
+	}
 
 	if err := c.UpdateWorkflowExecutionWithNew(
 		now,
@@ -584,7 +593,7 @@ func (c *ContextImpl) UpdateWorkflowExecutionAsActive(
 		// Returns ResourceExhausted error back to caller after workflow execution is forced terminated
 		// Retrying the operation will give appropriate semantics operation should expect in the case of workflow
 		// execution being closed.
-		return consts.ErrSizeExceedsLimit
+		return forceTerminateErr
 	}
 
 	return nil
@@ -730,8 +739,20 @@ func (c *ContextImpl) UpdateWorkflowExecutionWithNew(
 		if event, err := c.MutableState.GetCompletionEvent(); err == nil {
 			taskQueue := currentWorkflow.ExecutionInfo.TaskQueue
 			emitWorkflowCompletionStats(c.metricsClient, namespace, taskQueue, event)
+			emitWorkflowCloseStats(
+				c.metricsClient,
+				namespace,
+				c.MutableState.GetExecutionInfo(),
+				int(c.GetHistorySize()),
+				int(c.MutableState.GetNextEventID()-1),
+				event,
+			)
+			emitWorkflowClosedAuditRecord(c.shard.GetLogger(), c.config.EnableWorkflowAuditLog(namespace), c.MutableState, event)
 		}
 	}
+	if newMutableState != nil {
+		emitWorkflowStartedAuditRecord(c.shard.GetLogger(), c.config.EnableWorkflowAuditLog(namespace), newMutableState)
+	}
 
 	return nil
 }
@@ -920,25 +941,7 @@ func (c *ContextImpl) enforceSizeCheck() (bool, error) {
 			tag.WorkflowHistorySize(historySize),
 			tag.WorkflowEventCount(historyCount))
 
-		// Discard pending changes in MutableState so we can apply terminate state transition
-		c.Clear()
-
-		// Reload mutable state
-		mutableState, err := c.LoadWorkflowExecution()
-		if err != nil {
-			return false, err
-		}
-
-		// Terminate workflow is written as a separate batch and might result in more than one event as we close the
-		// outstanding workflow task before terminating the workflow
-		eventBatchFirstEventID := mutableState.GetNextEventID()
-		if err := TerminateWorkflow(
-			mutableState,
-			eventBatchFirstEventID,
-			common.FailureReasonSizeExceedsLimit,
-			nil,
-			consts.IdentityHistoryService,
-		); err != nil {
+		if err := c.forceTerminateWorkflow(common.FailureReasonSizeExceedsLimit); err != nil {
 			return false, err
 		}
 
@@ -958,6 +961,74 @@ func (c *ContextImpl) enforceSizeCheck() (bool, error) {
 	return false, nil
 }
 
+// Returns true if execution is forced terminated
+func (c *ContextImpl) enforceStateTransitionCountCheck() (bool, error) {
+	stateTransitionCountLimitWarn := int64(c.config.StateTransitionCountLimitWarn(c.GetNamespace()))
+	stateTransitionCountLimitError := int64(c.config.StateTransitionCountLimitError(c.GetNamespace()))
+	if stateTransitionCountLimitWarn <= 0 && stateTransitionCountLimitError <= 0 {
+		return false, nil
+	}
+
+	// state transition count does not include the transition which is about to be persisted
+	stateTransitionCount := c.MutableState.GetExecutionInfo().StateTransitionCount
+	metricsScope := c.metricsClient.Scope(metrics.WorkflowContextScope, metrics.NamespaceTag(c.GetNamespace()))
+
+	// Hard terminate workflow if still running and exhausted its state transition budget
+	if stateTransitionCountLimitError > 0 && stateTransitionCount >= stateTransitionCountLimitError &&
+		c.MutableState.IsWorkflowExecutionRunning() {
+		c.logger.Error("state transition count exceeds error limit.",
+			tag.WorkflowNamespaceID(c.namespaceID),
+			tag.WorkflowID(c.workflowExecution.GetWorkflowId()),
+			tag.WorkflowRunID(c.workflowExecution.GetRunId()),
+			tag.WorkflowStateTransitionCount(stateTransitionCount))
+		metricsScope.IncCounter(metrics.StateTransitionCountLimitExceededCount)
+
+		if err := c.forceTerminateWorkflow(common.FailureReasonStateTransitionCountExceedsLimit); err != nil {
+			return false, err
+		}
+
+		// Return true to caller to indicate workflow state is overwritten to force terminate execution on update
+		return true, nil
+	}
+
+	// only warn once, when the warn limit is crossed
+	if stateTransitionCountLimitWarn > 0 && stateTransitionCount == stateTransitionCountLimitWarn {
+		c.logger.Warn("state transition count exceeds warn limit.",
+			tag.WorkflowNamespaceID(c.namespaceID),
+			tag.WorkflowID(c.workflowExecution.GetWorkflowId()),
+			tag.WorkflowRunID(c.workflowExecution.GetRunId()),
+			tag.WorkflowStateTransitionCount(stateTransitionCount))
+		metricsScope.IncCounter(metrics.StateTransitionCountLimitWarnCount)
+	}
+
+	return false, nil
+}
+
+// forceTerminateWorkflow discards pending changes and terminates the workflow with the given reason
+func (c *ContextImpl) forceTerminateWorkflow(
+	reason string,
+) error {
+	// Discard pending changes in MutableState so we can apply terminate state transition
+	c.Clear()
+
+	// Reload mutable state
+	mutableState, err := c.LoadWorkflowExecution()
+	if err != nil {
+		return err
+	}
+
+	// Terminate workflow is written as a separate batch and might result in more than one event as we close the
+	// outstanding workflow task before terminating the workflow
+	eventBatchFirstEventID := mutableState.GetNextEventID()
+	return TerminateWorkflow(
+		mutableState,
+		eventBatchFirstEventID,
+		reason,
+		nil,
+		consts.IdentityHistoryService,
+	)
+}
+
 func emitStateTransitionCount(
 	metricsClient metrics.Client,
 	mutableState MutableState,
@@ -0,0 +1,169 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package workflow
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/service/history/configs"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tests"
+)
+
+type (
+	contextSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller       *gomock.Controller
+		mockShard        *shard.ContextTest
+		mockMutableState *MockMutableState
+		mockConfig       *configs.Config
+		testScope        tally.TestScope
+
+		workflowContext *ContextImpl
+	}
+)
+
+func TestContextSuite(t *testing.T) {
+	s := new(contextSuite)
+	suite.Run(t, s)
+}
+
+func (s *contextSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.mockMutableState = NewMockMutableState(s.controller)
+
+	s.mockConfig = tests.NewDynamicConfig()
+	s.mockShard = shard.NewTestContext(
+		s.controller,
+		&persistence.ShardInfoWithFailover{
+			ShardInfo: &persistencespb.ShardInfo{
+				ShardId: 1,
+				RangeId: 1,
+			}},
+		s.mockConfig,
+	)
+	s.mockShard.Resource.NamespaceCache.EXPECT().GetNamespaceByID(tests.NamespaceID).Return(tests.LocalNamespaceEntry, nil).AnyTimes()
+	s.testScope = s.mockShard.Resource.MetricsScope.(tally.TestScope)
+
+	s.workflowContext = NewContext(
+		tests.NamespaceID,
+		commonpb.WorkflowExecution{
+			WorkflowId: tests.WorkflowID,
+			RunId:      tests.RunID,
+		},
+		s.mockShard,
+		s.mockShard.GetLogger(),
+	)
+	s.workflowContext.MutableState = s.mockMutableState
+}
+
+func (s *contextSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *contextSuite) setStateTransitionCountLimits(warn int, error int) {
+	s.mockConfig.StateTransitionCountLimitWarn = func(namespace string) int { return warn }
+	s.mockConfig.StateTransitionCountLimitError = func(namespace string) int { return error }
+}
+
+func (s *contextSuite) expectStateTransitionCount(count int64) {
+	s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{
+		StateTransitionCount: count,
+	}).AnyTimes()
+}
+
+func (s *contextSuite) counterValue(name string) int64 {
+	counter, ok := s.testScope.Snapshot().Counters()["test."+name+"+namespace="+tests.Namespace+",operation=WorkflowContext"]
+	if !ok {
+		return 0
+	}
+	return counter.Value()
+}
+
+func (s *contextSuite) TestEnforceStateTransitionCountCheck_Disabled() {
+	s.setStateTransitionCountLimits(0, 0)
+
+	forceTerminate, err := s.workflowContext.enforceStateTransitionCountCheck()
+	s.NoError(err)
+	s.False(forceTerminate)
+}
+
+func (s *contextSuite) TestEnforceStateTransitionCountCheck_BelowLimit() {
+	s.setStateTransitionCountLimits(50, 100)
+	s.expectStateTransitionCount(10)
+
+	forceTerminate, err := s.workflowContext.enforceStateTransitionCountCheck()
+	s.NoError(err)
+	s.False(forceTerminate)
+	s.Zero(s.counterValue("state_transition_count_limit_warn"))
+}
+
+func (s *contextSuite) TestEnforceStateTransitionCountCheck_Warn() {
+	s.setStateTransitionCountLimits(50, 100)
+	s.expectStateTransitionCount(50)
+
+	forceTerminate, err := s.workflowContext.enforceStateTransitionCountCheck()
+	s.NoError(err)
+	s.False(forceTerminate)
+	s.Equal(int64(1), s.counterValue("state_transition_count_limit_warn"))
+}
+
+func (s *contextSuite) TestEnforceStateTransitionCountCheck_ExceedsLimit_WorkflowClosed() {
+	s.setStateTransitionCountLimits(0, 100)
+	s.expectStateTransitionCount(100)
+	s.mockMutableState.EXPECT().IsWorkflowExecutionRunning().Return(false)
+
+	forceTerminate, err := s.workflowContext.enforceStateTransitionCountCheck()
+	s.NoError(err)
+	s.False(forceTerminate)
+	s.Zero(s.counterValue("state_transition_count_limit_exceeded"))
+}
+
+func (s *contextSuite) TestEnforceStateTransitionCountCheck_ExceedsLimit() {
+	s.setStateTransitionCountLimits(0, 100)
+	s.expectStateTransitionCount(100)
+	s.mockMutableState.EXPECT().IsWorkflowExecutionRunning().Return(true)
+	// pending changes are discarded and mutable state is reloaded before terminating the workflow
+	s.mockShard.Resource.ExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any()).Return(nil, serviceerror.NewNotFound("not found"))
+
+	forceTerminate, err := s.workflowContext.enforceStateTransitionCountCheck()
+	s.IsType(&serviceerror.NotFound{}, err)
+	s.False(forceTerminate)
+	s.Nil(s.workflowContext.MutableState)
+	s.Equal(int64(1), s.counterValue("state_transition_count_limit_exceeded"))
+}
@@ -0,0 +1,97 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package workflow
+
+import (
+	"sync"
+
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/persistence"
+)
+
+type (
+	// currentExecutionLoadGroup deduplicates concurrent reads of the current run of the same workflow
+	// from persistence, so only one of the callers reads the current run and the others wait for its result.
+	// Reads of mutable state don't need it, they are serialized by the lock of the cached workflow context.
+	currentExecutionLoadGroup struct {
+		sync.Mutex
+		calls map[definition.WorkflowIdentifier]*currentExecutionLoadCall
+	}
+
+	currentExecutionLoadCall struct {
+		wg       sync.WaitGroup
+		response *persistence.GetCurrentExecutionResponse
+		err      error
+		waiters  int
+	}
+)
+
+func newCurrentExecutionLoadGroup() *currentExecutionLoadGroup {
+	return &currentExecutionLoadGroup{
+		calls: make(map[definition.WorkflowIdentifier]*currentExecutionLoadCall),
+	}
+}
+
+// load calls loadFn unless a load of the current run of the workflow is in flight, in which case it waits for
+// and returns the result of that load. Returned deduplicated is true if the caller didn't call loadFn.
+func (g *currentExecutionLoadGroup) load(
+	namespaceID string,
+	workflowID string,
+	loadFn func() (*persistence.GetCurrentExecutionResponse, error),
+) (_ *persistence.GetCurrentExecutionResponse, deduplicated bool, _ error) {
+
+	key := definition.NewWorkflowIdentifier(namespaceID, workflowID, "")
+	g.Lock()
+	if call, ok := g.calls[key]; ok {
+		call.waiters++
+		g.Unlock()
+		call.wg.Wait()
+		return copyCurrentExecutionResponse(call.response), true, call.err
+	}
+	call := &currentExecutionLoadCall{}
+	call.wg.Add(1)
+	g.calls[key] = call
+	g.Unlock()
+
+	call.response, call.err = loadFn()
+
+	g.Lock()
+	// no caller can join the load once it is removed from the group
+	delete(g.calls, key)
+	g.Unlock()
+	call.wg.Done()
+
+	return copyCurrentExecutionResponse(call.response), false, call.err
+}
+
+func copyCurrentExecutionResponse(
+	response *persistence.GetCurrentExecutionResponse,
+) *persistence.GetCurrentExecutionResponse {
+	if response == nil {
+		return nil
+	}
+	responseCopy := *response
+	return &responseCopy
+}
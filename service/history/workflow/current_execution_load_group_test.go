@@ -0,0 +1,123 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package workflow
+
+import (
+	"errors"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/persistence"
+)
+
+func TestCurrentExecutionLoadGroup_Deduplicated(t *testing.T) {
+	group := newCurrentExecutionLoadGroup()
+	response := &persistence.GetCurrentExecutionResponse{RunID: "run-id", LastWriteVersion: 10}
+
+	const callers = 10
+	var loads int32
+	var deduplicatedLoads int32
+	loadStarted := make(chan struct{})
+	unblockLoad := make(chan struct{})
+	loadFn := func() (*persistence.GetCurrentExecutionResponse, error) {
+		atomic.AddInt32(&loads, 1)
+		close(loadStarted)
+		<-unblockLoad
+		return response, nil
+	}
+
+	var wg sync.WaitGroup
+	responses := make([]*persistence.GetCurrentExecutionResponse, callers)
+	load := func(i int) {
+		defer wg.Done()
+		resp, deduplicated, err := group.load("namespace-id", "workflow-id", loadFn)
+		require.NoError(t, err)
+		if deduplicated {
+			atomic.AddInt32(&deduplicatedLoads, 1)
+		}
+		responses[i] = resp
+	}
+
+	wg.Add(1)
+	go load(0)
+	<-loadStarted
+	wg.Add(callers - 1)
+	for i := 1; i < callers; i++ {
+		go load(i)
+	}
+	waitCurrentExecutionLoadWaiters(group, "namespace-id", "workflow-id", callers-1)
+	close(unblockLoad)
+	wg.Wait()
+
+	require.Equal(t, int32(1), atomic.LoadInt32(&loads))
+	require.Equal(t, int32(callers-1), atomic.LoadInt32(&deduplicatedLoads))
+	for _, resp := range responses {
+		require.Equal(t, response, resp)
+		// every caller gets its own copy of the response
+		require.True(t, response != resp)
+	}
+	require.Empty(t, group.calls)
+}
+
+func TestCurrentExecutionLoadGroup_NotShared(t *testing.T) {
+	group := newCurrentExecutionLoadGroup()
+	response := &persistence.GetCurrentExecutionResponse{RunID: "run-id"}
+
+	resp, deduplicated, err := group.load("namespace-id", "workflow-id", func() (*persistence.GetCurrentExecutionResponse, error) {
+		return response, nil
+	})
+	require.NoError(t, err)
+	require.False(t, deduplicated)
+	require.Equal(t, response, resp)
+
+	loadErr := errors.New("load failed")
+	resp, deduplicated, err = group.load("namespace-id", "workflow-id", func() (*persistence.GetCurrentExecutionResponse, error) {
+		return nil, loadErr
+	})
+	require.Equal(t, loadErr, err)
+	require.False(t, deduplicated)
+	require.Nil(t, resp)
+	require.Empty(t, group.calls)
+}
+
+// waitCurrentExecutionLoadWaiters waits until the given number of callers joined the load in flight
+func waitCurrentExecutionLoadWaiters(group *currentExecutionLoadGroup, namespaceID string, workflowID string, waiters int) {
+	key := definition.NewWorkflowIdentifier(namespaceID, workflowID, "")
+	for {
+		group.Lock()
+		call, ok := group.calls[key]
+		joined := ok && call.waiters == waiters
+		group.Unlock()
+		if joined {
+			return
+		}
+		runtime.Gosched()
+	}
+}
@@ -28,8 +28,10 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
 )
 
 func emitWorkflowHistoryStats(
@@ -135,3 +137,23 @@ func emitWorkflowCompletionStats(
 		scope.IncCounter(metrics.WorkflowTerminateCount)
 	}
 }
+
+func emitWorkflowCloseStats(
+	metricsClient metrics.Client,
+	namespace string,
+	executionInfo *persistencespb.WorkflowExecutionInfo,
+	historySize int,
+	historyCount int,
+	event *historypb.HistoryEvent,
+) {
+	scope := metricsClient.Scope(metrics.WorkflowCloseStatsScope, metrics.NamespaceTag(namespace))
+
+	if startTime := executionInfo.GetStartTime(); startTime != nil && event.GetEventTime() != nil {
+		scope.RecordTimer(metrics.WorkflowExecutionDuration, timestamp.TimeValue(event.GetEventTime()).Sub(*startTime))
+	}
+	scope.RecordDistribution(metrics.WorkflowCloseHistorySize, historySize)
+	scope.RecordDistribution(metrics.WorkflowCloseHistoryCount, historyCount)
+	scope.RecordDistribution(metrics.WorkflowCloseStateTransitionCount, int(executionInfo.GetStateTransitionCount()))
+	// close event carries result, failure or details payloads depending on how workflow was closed
+	scope.RecordDistribution(metrics.WorkflowClosePayloadSize, event.Size())
+}
@@ -0,0 +1,139 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package workflow
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+func Test_EmitWorkflowCloseStats(t *testing.T) {
+	testScope := tally.NewTestScope("test", nil)
+	metricsClient := metrics.NewClient(testScope, metrics.History)
+	startTime := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	closeEvents := []*historypb.HistoryEvent{
+		{
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED,
+			EventTime: timestamp.TimePtr(startTime.Add(time.Minute)),
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionCompletedEventAttributes{
+				WorkflowExecutionCompletedEventAttributes: &historypb.WorkflowExecutionCompletedEventAttributes{
+					Result: payloads.EncodeString("result"),
+				},
+			},
+		},
+		{
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_FAILED,
+			EventTime: timestamp.TimePtr(startTime.Add(2 * time.Minute)),
+		},
+		{
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_TIMED_OUT,
+			EventTime: timestamp.TimePtr(startTime.Add(3 * time.Minute)),
+		},
+		{
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_TERMINATED,
+			EventTime: timestamp.TimePtr(startTime.Add(4 * time.Minute)),
+		},
+	}
+	for _, event := range closeEvents {
+		executionInfo := &persistencespb.WorkflowExecutionInfo{StartTime: &startTime, StateTransitionCount: 7}
+		emitWorkflowCompletionStats(metricsClient, "test-namespace", "test-task-queue", event)
+		emitWorkflowCloseStats(metricsClient, "test-namespace", executionInfo, 1024, 10, event)
+	}
+	// stats of other namespaces are kept apart
+	emitWorkflowCloseStats(
+		metricsClient,
+		"other-namespace",
+		&persistencespb.WorkflowExecutionInfo{StartTime: &startTime},
+		1024,
+		10,
+		closeEvents[0],
+	)
+
+	snapshot := testScope.Snapshot()
+	counterValue := func(name string) int64 {
+		var total int64
+		for _, counter := range snapshot.Counters() {
+			if counter.Name() == "test."+name && counter.Tags()["namespace"] == "test-namespace" {
+				total += counter.Value()
+			}
+		}
+		return total
+	}
+	assert.Equal(t, int64(1), counterValue("workflow_success"))
+	assert.Equal(t, int64(1), counterValue("workflow_failed"))
+	assert.Equal(t, int64(1), counterValue("workflow_timeout"))
+	assert.Equal(t, int64(1), counterValue("workflow_terminate"))
+	assert.Equal(t, int64(0), counterValue("workflow_cancel"))
+
+	timerValues := func(name string) []time.Duration {
+		var values []time.Duration
+		for _, timer := range snapshot.Timers() {
+			if timer.Name() == "test."+name &&
+				timer.Tags()["namespace"] == "test-namespace" &&
+				timer.Tags()["operation"] == "CloseStats" {
+				values = append(values, timer.Values()...)
+			}
+		}
+		sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+		return values
+	}
+	assert.Equal(
+		t,
+		[]time.Duration{time.Minute, 2 * time.Minute, 3 * time.Minute, 4 * time.Minute},
+		timerValues("workflow_execution_duration"),
+	)
+	for _, name := range []string{
+		"workflow_close_history_size",
+		"workflow_close_history_count",
+		"workflow_close_state_transition_count",
+		"workflow_close_payload_size",
+	} {
+		assert.Len(t, timerValues(name), len(closeEvents), name)
+	}
+
+	// the close event with a result payload is the largest one
+	payloadSizes := timerValues("workflow_close_payload_size")
+	require.Len(t, payloadSizes, len(closeEvents))
+	var completedPayloadSize time.Duration
+	for _, timer := range snapshot.Timers() {
+		if timer.Name() == "test.workflow_close_payload_size" && timer.Tags()["namespace"] == "other-namespace" {
+			completedPayloadSize = timer.Values()[0]
+		}
+	}
+	assert.Equal(t, payloadSizes[len(payloadSizes)-1], completedPayloadSize)
+}
@@ -78,6 +78,9 @@ type (
 		// In this case, OriginalScheduledTime won't change. Then when current time - OriginalScheduledTime exceeds
 		// some threshold, server can interrupt the heartbeat by enforcing to timeout the workflow task.
 		OriginalScheduledTime *time.Time
+		// LastHeartbeatTime is the last time the worker heartbeated the started workflow task through
+		// RecordWorkflowTaskHeartbeat. When set, the start to close timeout is measured from this time.
+		LastHeartbeatTime *time.Time
 	}
 
 	MutableState interface {
@@ -153,6 +156,7 @@ type (
 		GetLastWriteVersion() (int64, error)
 		GetNextEventID() int64
 		GetPreviousStartedEventID() int64
+		GetApproximatePersistedSize() int
 		GetPendingActivityInfos() map[int64]*persistencespb.ActivityInfo
 		GetPendingTimerInfos() map[string]*persistencespb.TimerInfo
 		GetPendingChildExecutionInfos() map[int64]*persistencespb.ChildExecutionInfo
@@ -228,6 +232,7 @@ type (
 		UpdateActivityWithTimerHeartbeat(*persistencespb.ActivityInfo, time.Time) error
 		UpdateActivityProgress(ai *persistencespb.ActivityInfo, request *workflowservice.RecordActivityTaskHeartbeatRequest)
 		UpdateWorkflowTask(*WorkflowTaskInfo)
+		RecordWorkflowTaskHeartbeat(workflowTask *WorkflowTaskInfo, heartbeatTime time.Time) error
 		UpdateUserTimer(*persistencespb.TimerInfo) error
 		UpdateCurrentVersion(version int64, forceUpdate bool) error
 		UpdateWorkflowStateStatus(state enumsspb.WorkflowExecutionState, status enumspb.WorkflowExecutionStatus) error
@@ -235,6 +240,7 @@ type (
 		AddTransferTasks(transferTasks ...persistence.Task)
 		AddTimerTasks(timerTasks ...persistence.Task)
 		AddVisibilityTasks(visibilityTasks ...persistence.Task)
+		GenerateLastHistoryReplicationTasks(now time.Time) (persistence.Task, error)
 		SetUpdateCondition(int64, int64)
 		GetUpdateCondition() (int64, int64)
 
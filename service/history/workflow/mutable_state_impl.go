@@ -53,6 +53,7 @@ import (
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/enums"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -96,6 +97,12 @@ var (
 	ErrMissingActivityScheduledEvent = serviceerror.NewInternal("unable to get activity scheduled event")
 	// ErrMissingChildWorkflowInitiatedEvent indicates missing child workflow initiated event
 	ErrMissingChildWorkflowInitiatedEvent = serviceerror.NewInternal("unable to get child workflow initiated event")
+	// ErrPendingActivitiesLimitExceeded indicates the workflow cannot schedule more activities until some of the pending ones finish
+	ErrPendingActivitiesLimitExceeded = serviceerror.NewInvalidArgument("the number of pending activities has reached the per workflow limit, wait for some of them to finish before scheduling more")
+	// ErrPendingChildExecutionsLimitExceeded indicates the workflow cannot start more child workflows until some of the pending ones finish
+	ErrPendingChildExecutionsLimitExceeded = serviceerror.NewInvalidArgument("the number of pending child workflows has reached the per workflow limit, wait for some of them to finish before starting more")
+	// ErrPendingSignalsLimitExceeded indicates the workflow cannot signal more external workflows until some of the pending signals are delivered
+	ErrPendingSignalsLimitExceeded = serviceerror.NewInvalidArgument("the number of pending signals to external workflows has reached the per workflow limit, wait for some of them to be delivered before sending more")
 )
 
 type (
@@ -343,18 +350,30 @@ func newMutableStateBuilderFromDB(
 	mutableState.dbRecordVersion = dbRecordVersion
 	mutableState.checksum = dbRecord.Checksum
 
+	shouldVerifyChecksum := mutableState.shouldVerifyChecksum()
 	if len(dbRecord.Checksum.GetValue()) > 0 {
 		switch {
 		case mutableState.shouldInvalidateCheckum():
 			mutableState.checksum = nil
 			mutableState.metricsClient.IncCounter(metrics.WorkflowContextScope, metrics.MutableStateChecksumInvalidated)
-		case mutableState.shouldVerifyChecksum():
+		case shouldVerifyChecksum:
 			if err := verifyMutableStateChecksum(mutableState, dbRecord.Checksum); err != nil {
-				// we ignore checksum verification errors for now until this
-				// feature is tested and/or we have mechanisms in place to deal
-				// with these types of errors
+				// verification errors are only surfaced when integrity check is enabled,
+				// so the check can be turned off if it misbehaves
 				mutableState.metricsClient.IncCounter(metrics.WorkflowContextScope, metrics.MutableStateChecksumMismatch)
 				mutableState.logError("mutable state checksum mismatch", tag.Error(err))
+				if mutableState.shouldFailOnIntegrityError() {
+					return nil, serviceerror.NewDataLoss(fmt.Sprintf("mutable state checksum mismatch: %v", err))
+				}
+			}
+		}
+	}
+	if shouldVerifyChecksum {
+		if err := verifyMutableStateBranchToken(mutableState); err != nil {
+			mutableState.metricsClient.IncCounter(metrics.WorkflowContextScope, metrics.MutableStateBranchTokenMismatch)
+			mutableState.logError("mutable state branch token mismatch", tag.Error(err))
+			if mutableState.shouldFailOnIntegrityError() {
+				return nil, serviceerror.NewDataLoss(fmt.Sprintf("mutable state branch token mismatch: %v", err))
 			}
 		}
 	}
@@ -1077,6 +1096,34 @@ func (e *MutableStateImpl) GetWorkflowTaskInfo(
 	return e.workflowTaskManager.GetWorkflowTaskInfo(scheduleEventID)
 }
 
+// GetApproximatePersistedSize returns the approximate size of the mutable state record in persistence,
+// including the changes of the ongoing transaction.
+func (e *MutableStateImpl) GetApproximatePersistedSize() int {
+	size := e.executionInfo.Size() + e.executionState.Size()
+	for _, ai := range e.pendingActivityInfoIDs {
+		size += ai.Size()
+	}
+	for _, ti := range e.pendingTimerInfoIDs {
+		size += ti.Size()
+	}
+	for _, ci := range e.pendingChildExecutionInfoIDs {
+		size += ci.Size()
+	}
+	for _, rci := range e.pendingRequestCancelInfoIDs {
+		size += rci.Size()
+	}
+	for _, si := range e.pendingSignalInfoIDs {
+		size += si.Size()
+	}
+	for requestID := range e.pendingSignalRequestedIDs {
+		size += len(requestID)
+	}
+	for _, event := range e.bufferEventsInDB {
+		size += event.Size()
+	}
+	return size
+}
+
 func (e *MutableStateImpl) GetPendingActivityInfos() map[int64]*persistencespb.ActivityInfo {
 	return e.pendingActivityInfoIDs
 }
@@ -1128,6 +1175,18 @@ func (e *MutableStateImpl) UpdateWorkflowTask(
 	e.workflowTaskManager.UpdateWorkflowTask(workflowTask)
 }
 
+// RecordWorkflowTaskHeartbeat records a heartbeat for a started workflow task.
+func (e *MutableStateImpl) RecordWorkflowTaskHeartbeat(
+	workflowTask *WorkflowTaskInfo,
+	heartbeatTime time.Time,
+) error {
+	opTag := tag.WorkflowActionWorkflowTaskStarted
+	if err := e.checkMutability(opTag); err != nil {
+		return err
+	}
+	return e.workflowTaskManager.RecordWorkflowTaskHeartbeat(workflowTask, heartbeatTime)
+}
+
 // DeleteWorkflowTask deletes a workflow task.
 func (e *MutableStateImpl) DeleteWorkflowTask() {
 	e.workflowTaskManager.DeleteWorkflowTask()
@@ -1567,6 +1626,7 @@ func (e *MutableStateImpl) CreateTransientWorkflowTaskEvents(
 }
 
 // add BinaryCheckSum for the first workflowTaskCompletedID for auto-reset
+// and record it as the build ID of the worker which completed the most recent workflow task
 func (e *MutableStateImpl) addBinaryCheckSumIfNotExists(
 	event *historypb.HistoryEvent,
 	maxResetPoints int,
@@ -1575,6 +1635,30 @@ func (e *MutableStateImpl) addBinaryCheckSumIfNotExists(
 	if len(binChecksum) == 0 {
 		return nil
 	}
+
+	resetPointAdded, err := e.addResetPointIfNotExists(event, binChecksum, maxResetPoints)
+	if err != nil {
+		return err
+	}
+	buildIDsUpdated, err := e.updateBuildIDs(binChecksum, maxResetPoints)
+	if err != nil {
+		return err
+	}
+
+	if !resetPointAdded && !buildIDsUpdated {
+		return nil
+	}
+	if e.shard.GetConfig().AdvancedVisibilityWritingMode() != common.AdvancedVisibilityWritingModeOff {
+		return e.taskGenerator.GenerateWorkflowSearchAttrTasks(timestamp.TimeValue(event.GetEventTime()))
+	}
+	return nil
+}
+
+func (e *MutableStateImpl) addResetPointIfNotExists(
+	event *historypb.HistoryEvent,
+	binChecksum string,
+	maxResetPoints int,
+) (bool, error) {
 	exeInfo := e.executionInfo
 	var currResetPoints []*workflowpb.ResetPointInfo
 	if exeInfo.AutoResetPoints != nil && exeInfo.AutoResetPoints.Points != nil {
@@ -1590,7 +1674,7 @@ func (e *MutableStateImpl) addBinaryCheckSumIfNotExists(
 		recentBinaryChecksums = append(recentBinaryChecksums, rp.GetBinaryChecksum())
 		if rp.GetBinaryChecksum() == binChecksum {
 			// this checksum already exists
-			return nil
+			return false, nil
 		}
 	}
 
@@ -1620,16 +1704,53 @@ func (e *MutableStateImpl) addBinaryCheckSumIfNotExists(
 	}
 	checksumsPayload, err := searchattribute.EncodeValue(recentBinaryChecksums, enumspb.INDEXED_VALUE_TYPE_KEYWORD)
 	if err != nil {
-		return err
+		return false, err
 	}
 	if exeInfo.SearchAttributes == nil {
 		exeInfo.SearchAttributes = make(map[string]*commonpb.Payload, 1)
 	}
 	exeInfo.SearchAttributes[searchattribute.BinaryChecksums] = checksumsPayload
-	if e.shard.GetConfig().AdvancedVisibilityWritingMode() != common.AdvancedVisibilityWritingModeOff {
-		return e.taskGenerator.GenerateWorkflowSearchAttrTasks(timestamp.TimeValue(event.GetEventTime()))
+	return true, nil
+}
+
+// updateBuildIDs moves buildID to the end of BuildIds search attribute,
+// so the last element is always the build ID of the worker which completed the most recent workflow task.
+func (e *MutableStateImpl) updateBuildIDs(
+	buildID string,
+	maxBuildIDs int,
+) (bool, error) {
+	exeInfo := e.executionInfo
+
+	buildIDs, err := getBuildIDs(exeInfo)
+	if err != nil {
+		return false, err
 	}
-	return nil
+
+	if len(buildIDs) > 0 && buildIDs[len(buildIDs)-1] == buildID {
+		return false, nil
+	}
+
+	recentBuildIDs := make([]string, 0, len(buildIDs)+1)
+	for _, id := range buildIDs {
+		if id != buildID {
+			recentBuildIDs = append(recentBuildIDs, id)
+		}
+	}
+	recentBuildIDs = append(recentBuildIDs, buildID)
+	if len(recentBuildIDs) > maxBuildIDs {
+		// If exceeding the max limit, drop the oldest ones.
+		recentBuildIDs = recentBuildIDs[len(recentBuildIDs)-maxBuildIDs:]
+	}
+
+	buildIDsPayload, err := searchattribute.EncodeValue(recentBuildIDs, enumspb.INDEXED_VALUE_TYPE_KEYWORD)
+	if err != nil {
+		return false, err
+	}
+	if exeInfo.SearchAttributes == nil {
+		exeInfo.SearchAttributes = make(map[string]*commonpb.Payload, 1)
+	}
+	exeInfo.SearchAttributes[searchattribute.BuildIds] = buildIDsPayload
+	return true, nil
 }
 
 // TODO: we will release the restriction when reset API allow those pending
@@ -1735,6 +1856,14 @@ func (e *MutableStateImpl) AddActivityTaskScheduledEvent(
 	if err := e.checkMutability(opTag); err != nil {
 		return nil, nil, err
 	}
+	if err := e.checkPendingLimit(
+		len(e.pendingActivityInfoIDs),
+		e.config.NumPendingActivitiesLimitError,
+		ErrPendingActivitiesLimitExceeded,
+		opTag,
+	); err != nil {
+		return nil, nil, err
+	}
 
 	_, ok := e.GetActivityByActivityID(command.GetActivityId())
 	if ok {
@@ -2542,6 +2671,14 @@ func (e *MutableStateImpl) AddSignalExternalWorkflowExecutionInitiatedEvent(
 	if err := e.checkMutability(opTag); err != nil {
 		return nil, nil, err
 	}
+	if err := e.checkPendingLimit(
+		len(e.pendingSignalInfoIDs),
+		e.config.NumPendingSignalsLimitError,
+		ErrPendingSignalsLimitExceeded,
+		opTag,
+	); err != nil {
+		return nil, nil, err
+	}
 
 	event := e.hBuilder.AddSignalExternalWorkflowExecutionInitiatedEvent(workflowTaskCompletedEventID, command)
 	si, err := e.ReplicateSignalExternalWorkflowExecutionInitiatedEvent(workflowTaskCompletedEventID, event, signalRequestID)
@@ -3085,6 +3222,14 @@ func (e *MutableStateImpl) AddStartChildWorkflowExecutionInitiatedEvent(
 	if err := e.checkMutability(opTag); err != nil {
 		return nil, nil, err
 	}
+	if err := e.checkPendingLimit(
+		len(e.pendingChildExecutionInfoIDs),
+		e.config.NumPendingChildExecutionsLimitError,
+		ErrPendingChildExecutionsLimitExceeded,
+		opTag,
+	); err != nil {
+		return nil, nil, err
+	}
 
 	event := e.hBuilder.AddStartChildWorkflowExecutionInitiatedEvent(workflowTaskCompletedEventID, command)
 	// Write the event to cache only on active cluster
@@ -3518,6 +3663,7 @@ func (e *MutableStateImpl) RetryActivity(
 	ai.TimerTaskStatus = TimerTaskStatusNone
 	ai.RetryLastWorkerIdentity = ai.StartedIdentity
 	ai.RetryLastFailure = failure
+	ai.LastAttemptCompleteTime = timestamp.TimePtr(now)
 
 	if err := e.taskGenerator.GenerateActivityRetryTasks(
 		ai.ScheduleId,
@@ -3561,6 +3707,15 @@ func (e *MutableStateImpl) AddVisibilityTasks(
 	e.InsertVisibilityTasks = append(e.InsertVisibilityTasks, visibilityTasks...)
 }
 
+// GenerateLastHistoryReplicationTasks returns a replication task for the last history event batch,
+// the task is not added to the mutable state.
+func (e *MutableStateImpl) GenerateLastHistoryReplicationTasks(
+	now time.Time,
+) (persistence.Task, error) {
+
+	return e.taskGenerator.GenerateLastHistoryReplicationTasks(now)
+}
+
 func (e *MutableStateImpl) SetUpdateCondition(
 	nextEventIDInDB int64,
 	dbRecordVersion int64,
@@ -4330,6 +4485,28 @@ func (e *MutableStateImpl) checkMutability(
 	return nil
 }
 
+// checkPendingLimit prevents adding another pending entity of a kind once the workflow holds
+// as many of them as the namespace allows, so that mutable state cannot grow without bound.
+func (e *MutableStateImpl) checkPendingLimit(
+	pendingCount int,
+	limit dynamicconfig.IntPropertyFnWithNamespaceFilter,
+	limitErr error,
+	actionTag tag.ZapTag,
+) error {
+
+	maxPending := limit(e.GetNamespaceEntry().GetInfo().Name)
+	if maxPending <= 0 || pendingCount < maxPending {
+		return nil
+	}
+	e.logWarn(
+		"workflow execution exceeded pending limit",
+		tag.Counter(pendingCount),
+		tag.NewInt("limit", maxPending),
+		actionTag,
+	)
+	return limitErr
+}
+
 func (e *MutableStateImpl) generateChecksum() *persistencespb.Checksum {
 	if !e.shouldGenerateChecksum() {
 		return nil
@@ -4356,6 +4533,13 @@ func (e *MutableStateImpl) shouldVerifyChecksum() bool {
 	return rand.Intn(100) < e.config.MutableStateChecksumVerifyProbability(e.namespaceEntry.GetInfo().Name)
 }
 
+func (e *MutableStateImpl) shouldFailOnIntegrityError() bool {
+	if e.namespaceEntry == nil {
+		return false
+	}
+	return e.config.MutableStateIntegrityCheckEnabled(e.namespaceEntry.GetInfo().Name)
+}
+
 func (e *MutableStateImpl) shouldInvalidateCheckum() bool {
 	invalidateBeforeEpochSecs := int64(e.config.MutableStateChecksumInvalidateBefore())
 	if invalidateBeforeEpochSecs > 0 {
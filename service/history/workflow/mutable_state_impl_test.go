@@ -33,9 +33,11 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-go/tally"
+	commandpb "go.temporal.io/api/command/v1"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
@@ -52,6 +54,7 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/events"
 	"go.temporal.io/server/service/history/shard"
@@ -321,6 +324,75 @@ func (s *mutableStateSuite) TestChecksumShouldInvalidate() {
 	s.False(s.mutableState.shouldInvalidateCheckum())
 }
 
+func (s *mutableStateSuite) TestIntegrityCheck() {
+	mismatchFunc := func() int64 {
+		counter := s.testScope.Snapshot().Counters()["test.mutable_state_branch_token_mismatch+namespace=all,operation=WorkflowContext"]
+		if counter != nil {
+			return counter.Value()
+		}
+		return 0
+	}
+
+	branchToken, err := persistence.NewHistoryBranchToken(uuid.New())
+	s.NoError(err)
+	dbState := s.buildWorkflowMutableState()
+	dbState.ExecutionInfo.VersionHistories = versionhistory.NewVersionHistories(versionhistory.NewVersionHistory(
+		branchToken,
+		[]*historyspb.VersionHistoryItem{versionhistory.NewVersionHistoryItem(dbState.NextEventId-1, 300)},
+	))
+
+	mismatches := mismatchFunc()
+	s.mutableState, err = newMutableStateBuilderFromDB(s.mockShard, s.mockEventsCache, s.logger, tests.LocalNamespaceEntry, dbState, 123)
+	s.NoError(err)
+	s.Equal(mismatches, mismatchFunc())
+
+	// current version history does not cover all events, only reported when integrity check is disabled
+	dbState.ExecutionInfo.VersionHistories.Histories[0].Items[0].EventId = dbState.NextEventId - 2
+	_, err = newMutableStateBuilderFromDB(s.mockShard, s.mockEventsCache, s.logger, tests.LocalNamespaceEntry, dbState, 123)
+	s.NoError(err)
+	s.Equal(mismatches+1, mismatchFunc())
+
+	s.mockConfig.MutableStateIntegrityCheckEnabled = func(namespace string) bool { return true }
+	_, err = newMutableStateBuilderFromDB(s.mockShard, s.mockEventsCache, s.logger, tests.LocalNamespaceEntry, dbState, 123)
+	s.IsType(&serviceerror.DataLoss{}, err)
+	s.Equal(mismatches+2, mismatchFunc())
+
+	// checksum mismatch fails the load as well
+	dbState.ExecutionInfo.VersionHistories.Histories[0].Items[0].EventId = dbState.NextEventId - 1
+	dbState.Checksum, err = generateMutableStateChecksum(s.mutableState)
+	s.NoError(err)
+	_, err = newMutableStateBuilderFromDB(s.mockShard, s.mockEventsCache, s.logger, tests.LocalNamespaceEntry, dbState, 123)
+	s.NoError(err)
+	dbState.Checksum.Value[0]++
+	_, err = newMutableStateBuilderFromDB(s.mockShard, s.mockEventsCache, s.logger, tests.LocalNamespaceEntry, dbState, 123)
+	s.IsType(&serviceerror.DataLoss{}, err)
+}
+
+func (s *mutableStateSuite) TestGenerateLastHistoryReplicationTasks() {
+	branchToken, err := persistence.NewHistoryBranchToken(uuid.New())
+	s.NoError(err)
+	dbState := s.buildWorkflowMutableState()
+	dbState.ExecutionInfo.LastFirstEventId = dbState.NextEventId - 2
+	dbState.ExecutionInfo.VersionHistories = versionhistory.NewVersionHistories(versionhistory.NewVersionHistory(
+		branchToken,
+		[]*historyspb.VersionHistoryItem{versionhistory.NewVersionHistoryItem(dbState.NextEventId-1, 300)},
+	))
+	s.mutableState, err = newMutableStateBuilderFromDB(s.mockShard, s.mockEventsCache, s.logger, tests.LocalNamespaceEntry, dbState, 123)
+	s.NoError(err)
+
+	now := time.Now().UTC()
+	task, err := s.mutableState.GenerateLastHistoryReplicationTasks(now)
+	s.NoError(err)
+	s.Equal(&persistence.HistoryReplicationTask{
+		VisibilityTimestamp: now,
+		FirstEventID:        dbState.NextEventId - 2,
+		NextEventID:         dbState.NextEventId,
+		Version:             300,
+		BranchToken:         branchToken,
+	}, task)
+	s.Empty(s.mutableState.InsertReplicationTasks)
+}
+
 func (s *mutableStateSuite) TestMergeMapOfPayload() {
 	var currentMap map[string]*commonpb.Payload
 	var newMap map[string]*commonpb.Payload
@@ -336,6 +408,45 @@ func (s *mutableStateSuite) TestMergeMapOfPayload() {
 	s.Equal(2, len(resultMap))
 }
 
+func (s *mutableStateSuite) TestUpdateBuildIDs() {
+	getBuildIDs := func() []string {
+		decoded, err := searchattribute.DecodeValue(s.mutableState.executionInfo.SearchAttributes[searchattribute.BuildIds], enumspb.INDEXED_VALUE_TYPE_KEYWORD)
+		s.NoError(err)
+		return decoded.([]string)
+	}
+
+	s.Equal("", GetLastWorkerBuildID(s.mutableState.executionInfo))
+
+	updated, err := s.mutableState.updateBuildIDs("build-1", 3)
+	s.NoError(err)
+	s.True(updated)
+	s.Equal([]string{"build-1"}, getBuildIDs())
+
+	updated, err = s.mutableState.updateBuildIDs("build-1", 3)
+	s.NoError(err)
+	s.False(updated)
+
+	updated, err = s.mutableState.updateBuildIDs("build-2", 3)
+	s.NoError(err)
+	s.True(updated)
+	s.Equal([]string{"build-1", "build-2"}, getBuildIDs())
+
+	// Rolling back to previous build moves it to the end of the list.
+	updated, err = s.mutableState.updateBuildIDs("build-1", 3)
+	s.NoError(err)
+	s.True(updated)
+	s.Equal([]string{"build-2", "build-1"}, getBuildIDs())
+	s.Equal("build-1", GetLastWorkerBuildID(s.mutableState.executionInfo))
+
+	updated, err = s.mutableState.updateBuildIDs("build-3", 3)
+	s.NoError(err)
+	s.True(updated)
+	updated, err = s.mutableState.updateBuildIDs("build-4", 3)
+	s.NoError(err)
+	s.True(updated)
+	s.Equal([]string{"build-1", "build-3", "build-4"}, getBuildIDs())
+}
+
 func (s *mutableStateSuite) TestEventReapplied() {
 	runID := uuid.New()
 	eventID := int64(1)
@@ -348,6 +459,39 @@ func (s *mutableStateSuite) TestEventReapplied() {
 	s.True(isReapplied)
 }
 
+func (s *mutableStateSuite) TestPendingLimits() {
+	dbState := s.buildWorkflowMutableState()
+	var err error
+	s.mutableState, err = newMutableStateBuilderFromDB(s.mockShard, s.mockEventsCache, s.logger, tests.LocalNamespaceEntry, dbState, 123)
+	s.NoError(err)
+	s.Len(s.mutableState.GetPendingActivityInfos(), 1)
+	s.Len(s.mutableState.GetPendingChildExecutionInfos(), 1)
+	s.Len(s.mutableState.GetPendingSignalExternalInfos(), 1)
+
+	s.mockConfig.NumPendingActivitiesLimitError = dynamicconfig.GetIntPropertyFilteredByNamespace(1)
+	s.mockConfig.NumPendingChildExecutionsLimitError = dynamicconfig.GetIntPropertyFilteredByNamespace(1)
+	s.mockConfig.NumPendingSignalsLimitError = dynamicconfig.GetIntPropertyFilteredByNamespace(1)
+	nextEventID := s.mutableState.GetNextEventID()
+
+	_, _, err = s.mutableState.AddActivityTaskScheduledEvent(nextEventID-1, &commandpb.ScheduleActivityTaskCommandAttributes{
+		ActivityId: "new-activity",
+	})
+	s.Equal(ErrPendingActivitiesLimitExceeded, err)
+
+	_, _, err = s.mutableState.AddStartChildWorkflowExecutionInitiatedEvent(nextEventID-1, uuid.New(), &commandpb.StartChildWorkflowExecutionCommandAttributes{
+		WorkflowId: "new-child",
+	})
+	s.Equal(ErrPendingChildExecutionsLimitExceeded, err)
+
+	_, _, err = s.mutableState.AddSignalExternalWorkflowExecutionInitiatedEvent(nextEventID-1, uuid.New(), &commandpb.SignalExternalWorkflowExecutionCommandAttributes{
+		SignalName: "new-signal",
+	})
+	s.Equal(ErrPendingSignalsLimitExceeded, err)
+
+	// rejected commands must not leave any event behind
+	s.Equal(nextEventID, s.mutableState.GetNextEventID())
+}
+
 func (s *mutableStateSuite) TestTransientWorkflowTaskSchedule_CurrentVersionChanged() {
 	version := int64(2000)
 	runID := uuid.New()
@@ -906,6 +906,21 @@ func (mr *MockMutableStateMockRecorder) FlushBufferedEvents() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FlushBufferedEvents", reflect.TypeOf((*MockMutableState)(nil).FlushBufferedEvents))
 }
 
+// GenerateLastHistoryReplicationTasks mocks base method.
+func (m *MockMutableState) GenerateLastHistoryReplicationTasks(now time.Time) (persistence.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateLastHistoryReplicationTasks", now)
+	ret0, _ := ret[0].(persistence.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateLastHistoryReplicationTasks indicates an expected call of GenerateLastHistoryReplicationTasks.
+func (mr *MockMutableStateMockRecorder) GenerateLastHistoryReplicationTasks(now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateLastHistoryReplicationTasks", reflect.TypeOf((*MockMutableState)(nil).GenerateLastHistoryReplicationTasks), now)
+}
+
 // GetActivityByActivityID mocks base method.
 func (m *MockMutableState) GetActivityByActivityID(arg0 string) (*v18.ActivityInfo, bool) {
 	m.ctrl.T.Helper()
@@ -967,6 +982,20 @@ func (mr *MockMutableStateMockRecorder) GetActivityScheduledEvent(arg0 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityScheduledEvent", reflect.TypeOf((*MockMutableState)(nil).GetActivityScheduledEvent), arg0)
 }
 
+// GetApproximatePersistedSize mocks base method.
+func (m *MockMutableState) GetApproximatePersistedSize() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApproximatePersistedSize")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// GetApproximatePersistedSize indicates an expected call of GetApproximatePersistedSize.
+func (mr *MockMutableStateMockRecorder) GetApproximatePersistedSize() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApproximatePersistedSize", reflect.TypeOf((*MockMutableState)(nil).GetApproximatePersistedSize))
+}
+
 // GetChildExecutionInfo mocks base method.
 func (m *MockMutableState) GetChildExecutionInfo(arg0 int64) (*v18.ChildExecutionInfo, bool) {
 	m.ctrl.T.Helper()
@@ -1588,6 +1617,20 @@ func (mr *MockMutableStateMockRecorder) IsWorkflowExecutionRunning() *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorkflowExecutionRunning", reflect.TypeOf((*MockMutableState)(nil).IsWorkflowExecutionRunning))
 }
 
+// RecordWorkflowTaskHeartbeat mocks base method.
+func (m *MockMutableState) RecordWorkflowTaskHeartbeat(workflowTask *WorkflowTaskInfo, heartbeatTime time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordWorkflowTaskHeartbeat", workflowTask, heartbeatTime)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordWorkflowTaskHeartbeat indicates an expected call of RecordWorkflowTaskHeartbeat.
+func (mr *MockMutableStateMockRecorder) RecordWorkflowTaskHeartbeat(workflowTask, heartbeatTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordWorkflowTaskHeartbeat", reflect.TypeOf((*MockMutableState)(nil).RecordWorkflowTaskHeartbeat), workflowTask, heartbeatTime)
+}
+
 // ReplicateActivityInfo mocks base method.
 func (m *MockMutableState) ReplicateActivityInfo(arg0 *v17.SyncActivityRequest, arg1 bool) error {
 	m.ctrl.T.Helper()
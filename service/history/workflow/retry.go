@@ -31,6 +31,9 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	failurepb "go.temporal.io/api/failure/v1"
 
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	workflowspb "go.temporal.io/server/api/workflow/v1"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/primitives/timestamp"
 )
@@ -131,3 +134,44 @@ func isRetryable(failure *failurepb.Failure, nonRetryableTypes []string) bool {
 	}
 	return true
 }
+
+// GetPendingActivityRetryInfo returns the heartbeat and retry state of a pending activity,
+// including when the next attempt is scheduled if the activity is backing off.
+func GetPendingActivityRetryInfo(ai *persistencespb.ActivityInfo) *workflowspb.PendingActivityRetryInfo {
+	info := &workflowspb.PendingActivityRetryInfo{
+		ActivityId:       ai.ActivityId,
+		ScheduleId:       ai.ScheduleId,
+		Attempt:          ai.Attempt,
+		HeartbeatTimeout: ai.HeartbeatTimeout,
+	}
+	if info.Attempt < 1 {
+		info.Attempt = 1
+	}
+	if !timestamp.TimeValue(ai.LastHeartbeatUpdateTime).IsZero() {
+		info.LastHeartbeatTime = ai.LastHeartbeatUpdateTime
+		info.HeartbeatDetails = ai.LastHeartbeatDetails
+	}
+	if ai.StartedId != common.EmptyEventID {
+		info.LastStartedTime = ai.StartedTime
+	}
+	if !ai.HasRetryPolicy {
+		return info
+	}
+
+	info.MaximumAttempts = ai.RetryMaximumAttempts
+	info.LastFailure = ai.RetryLastFailure
+	info.LastWorkerIdentity = ai.RetryLastWorkerIdentity
+	if !timestamp.TimeValue(ai.RetryExpirationTime).IsZero() {
+		info.ExpirationTime = ai.RetryExpirationTime
+	}
+	lastAttemptCompleteTime := timestamp.TimeValue(ai.LastAttemptCompleteTime)
+	if !lastAttemptCompleteTime.IsZero() {
+		info.LastAttemptCompleteTime = ai.LastAttemptCompleteTime
+		// the activity is backing off until it gets scheduled for the next attempt
+		if ai.StartedId == common.EmptyEventID && ai.ScheduledTime != nil {
+			info.NextAttemptScheduleTime = ai.ScheduledTime
+			info.CurrentRetryInterval = timestamp.DurationPtr(ai.ScheduledTime.Sub(lastAttemptCompleteTime))
+		}
+	}
+	return info
+}
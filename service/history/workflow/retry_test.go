@@ -33,6 +33,7 @@ import (
 	failurepb "go.temporal.io/api/failure/v1"
 
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/failure"
@@ -373,3 +374,40 @@ func Test_NextRetry(t *testing.T) {
 	a.Equal(enumspb.RETRY_STATE_IN_PROGRESS, retryState)
 	ai.Attempt++
 }
+
+func Test_GetPendingActivityRetryInfo(t *testing.T) {
+	a := assert.New(t)
+
+	now := time.Now().UTC()
+	ai := &persistencespb.ActivityInfo{
+		ActivityId:              "activity-id",
+		ScheduleId:              5,
+		StartedId:               6,
+		StartedTime:             timestamp.TimePtr(now.Add(-time.Minute)),
+		Attempt:                 1,
+		HasRetryPolicy:          true,
+		RetryMaximumAttempts:    3,
+		LastHeartbeatUpdateTime: timestamp.TimePtr(now.Add(-time.Second)),
+	}
+	info := GetPendingActivityRetryInfo(ai)
+	a.Equal("activity-id", info.ActivityId)
+	a.Equal(int32(1), info.Attempt)
+	a.Equal(int32(3), info.MaximumAttempts)
+	a.Equal(ai.StartedTime, info.LastStartedTime)
+	a.Equal(ai.LastHeartbeatUpdateTime, info.LastHeartbeatTime)
+	a.Nil(info.NextAttemptScheduleTime)
+	a.Nil(info.CurrentRetryInterval)
+
+	// activity failed and is backing off before the second attempt
+	ai.Attempt = 2
+	ai.StartedId = common.EmptyEventID
+	ai.StartedTime = timestamp.TimePtr(time.Time{})
+	ai.LastAttemptCompleteTime = timestamp.TimePtr(now)
+	ai.ScheduledTime = timestamp.TimePtr(now.Add(10 * time.Second))
+	info = GetPendingActivityRetryInfo(ai)
+	a.Equal(int32(2), info.Attempt)
+	a.Nil(info.LastStartedTime)
+	a.Equal(ai.LastAttemptCompleteTime, info.LastAttemptCompleteTime)
+	a.Equal(ai.ScheduledTime, info.NextAttemptScheduleTime)
+	a.Equal(10*time.Second, timestamp.DurationValue(info.CurrentRetryInterval))
+}
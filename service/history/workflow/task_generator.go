@@ -39,6 +39,7 @@ import (
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/primitives/timestamp"
 )
 
@@ -92,6 +93,9 @@ type (
 		GenerateWorkflowResetTasks(
 			now time.Time,
 		) error
+		GenerateLastHistoryReplicationTasks(
+			now time.Time,
+		) (persistence.Task, error)
 
 		// these 2 APIs should only be called when mutable state transaction is being closed
 
@@ -208,6 +212,9 @@ func (r *TaskGeneratorImpl) GenerateDelayedWorkflowTasks(
 		workflowBackoffType = enumsspb.WORKFLOW_BACKOFF_TYPE_RETRY
 	case enumspb.CONTINUE_AS_NEW_INITIATOR_CRON_SCHEDULE, enumspb.CONTINUE_AS_NEW_INITIATOR_WORKFLOW:
 		workflowBackoffType = enumsspb.WORKFLOW_BACKOFF_TYPE_CRON
+	case enumspb.CONTINUE_AS_NEW_INITIATOR_UNSPECIFIED:
+		// first run of a workflow which was started with a start delay
+		workflowBackoffType = enumsspb.WORKFLOW_BACKOFF_TYPE_DELAY_START
 	default:
 		return serviceerror.NewInternal(fmt.Sprintf("unknown initiator: %v", startAttr.GetInitiator()))
 	}
@@ -289,6 +296,10 @@ func (r *TaskGeneratorImpl) GenerateStartWorkflowTaskTasks(
 	}
 
 	startedTime := timestamp.TimeValue(workflowTask.StartedTime)
+	if workflowTask.LastHeartbeatTime != nil {
+		// heartbeat extends the start to close timeout
+		startedTime = timestamp.TimeValue(workflowTask.LastHeartbeatTime)
+	}
 	workflowTaskTimeout := timestamp.DurationValue(workflowTask.WorkflowTaskTimeout)
 
 	r.mutableState.AddTimerTasks(&persistence.WorkflowTaskTimeoutTask{
@@ -496,6 +507,30 @@ func (r *TaskGeneratorImpl) GenerateWorkflowResetTasks(
 	return nil
 }
 
+func (r *TaskGeneratorImpl) GenerateLastHistoryReplicationTasks(
+	now time.Time,
+) (persistence.Task, error) {
+
+	executionInfo := r.mutableState.GetExecutionInfo()
+	currentVersionHistory, err := versionhistory.GetCurrentVersionHistory(executionInfo.GetVersionHistories())
+	if err != nil {
+		return nil, err
+	}
+	lastItem, err := versionhistory.GetLastVersionHistoryItem(currentVersionHistory)
+	if err != nil {
+		return nil, err
+	}
+
+	return &persistence.HistoryReplicationTask{
+		// TaskID is set by shard
+		VisibilityTimestamp: now,
+		FirstEventID:        executionInfo.LastFirstEventId,
+		NextEventID:         lastItem.GetEventId() + 1,
+		Version:             lastItem.GetVersion(),
+		BranchToken:         currentVersionHistory.GetBranchToken(),
+	}, nil
+}
+
 func (r *TaskGeneratorImpl) GenerateActivityTimerTasks(
 	now time.Time,
 ) error {
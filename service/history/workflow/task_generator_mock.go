@@ -34,6 +34,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	history "go.temporal.io/api/history/v1"
+	persistence "go.temporal.io/server/common/persistence"
 )
 
 // MockTaskGenerator is a mock of TaskGenerator interface.
@@ -129,6 +130,21 @@ func (mr *MockTaskGeneratorMockRecorder) GenerateDelayedWorkflowTasks(now, start
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateDelayedWorkflowTasks", reflect.TypeOf((*MockTaskGenerator)(nil).GenerateDelayedWorkflowTasks), now, startEvent)
 }
 
+// GenerateLastHistoryReplicationTasks mocks base method.
+func (m *MockTaskGenerator) GenerateLastHistoryReplicationTasks(now time.Time) (persistence.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateLastHistoryReplicationTasks", now)
+	ret0, _ := ret[0].(persistence.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateLastHistoryReplicationTasks indicates an expected call of GenerateLastHistoryReplicationTasks.
+func (mr *MockTaskGeneratorMockRecorder) GenerateLastHistoryReplicationTasks(now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateLastHistoryReplicationTasks", reflect.TypeOf((*MockTaskGenerator)(nil).GenerateLastHistoryReplicationTasks), now)
+}
+
 // GenerateRecordWorkflowStartedTasks mocks base method.
 func (m *MockTaskGenerator) GenerateRecordWorkflowStartedTasks(now time.Time, startEvent *history.HistoryEvent) error {
 	m.ctrl.T.Helper()
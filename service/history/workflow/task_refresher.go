@@ -36,6 +36,7 @@ import (
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/events"
@@ -77,7 +78,7 @@ func (r *TaskRefresherImpl) RefreshTasks(
 	taskGenerator := NewTaskGenerator(
 		r.namespaceCache,
 		r.logger,
-		mutableState,
+		&backfillMutableState{MutableState: mutableState},
 	)
 
 	if err := r.refreshTasksForWorkflowStart(
@@ -499,3 +500,18 @@ func (r *TaskRefresherImpl) getTimeSource(
 	timeSource.Update(now)
 	return timeSource
 }
+
+// backfillMutableState marks visibility tasks generated during task refresh as backfill,
+// so they are indexed with lower priority than visibility updates of live workflows
+type backfillMutableState struct {
+	MutableState
+}
+
+func (s *backfillMutableState) AddVisibilityTasks(
+	visibilityTasks ...persistence.Task,
+) {
+	for _, task := range visibilityTasks {
+		persistence.MarkBackfillVisibilityTask(task)
+	}
+	s.MutableState.AddVisibilityTasks(visibilityTasks...)
+}
@@ -32,9 +32,11 @@ import (
 	"go.temporal.io/api/serviceerror"
 	workflowpb "go.temporal.io/api/workflow/v1"
 
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/service/history/consts"
 )
 
@@ -179,3 +181,35 @@ func FindAutoResetPoint(
 	}
 	return "", nil
 }
+
+// GetLastWorkerBuildID returns the build ID of the worker which completed the most recent workflow task,
+// empty if no workflow task was completed yet
+func GetLastWorkerBuildID(
+	executionInfo *persistencespb.WorkflowExecutionInfo,
+) string {
+	buildIDs, err := getBuildIDs(executionInfo)
+	if err != nil || len(buildIDs) == 0 {
+		return ""
+	}
+	return buildIDs[len(buildIDs)-1]
+}
+
+func getBuildIDs(
+	executionInfo *persistencespb.WorkflowExecutionInfo,
+) ([]string, error) {
+	buildIDsPayload, ok := executionInfo.GetSearchAttributes()[searchattribute.BuildIds]
+	if !ok {
+		return nil, nil
+	}
+	decodedBuildIDs, err := searchattribute.DecodeValue(buildIDsPayload, enumspb.INDEXED_VALUE_TYPE_KEYWORD)
+	if err != nil {
+		return nil, err
+	}
+	switch v := decodedBuildIDs.(type) {
+	case []string:
+		return v, nil
+	case string:
+		return []string{v}, nil
+	}
+	return nil, nil
+}
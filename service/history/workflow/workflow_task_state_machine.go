@@ -596,6 +596,20 @@ func (m *workflowTaskStateMachine) DeleteWorkflowTask() {
 	m.UpdateWorkflowTask(resetWorkflowTaskInfo)
 }
 
+// RecordWorkflowTaskHeartbeat records a heartbeat for a started workflow task and
+// generates a new start to close timer measured from the heartbeat time.
+func (m *workflowTaskStateMachine) RecordWorkflowTaskHeartbeat(
+	workflowTask *WorkflowTaskInfo,
+	heartbeatTime time.Time,
+) error {
+	workflowTask.LastHeartbeatTime = &heartbeatTime
+	m.UpdateWorkflowTask(workflowTask)
+	return m.ms.taskGenerator.GenerateStartWorkflowTaskTasks(
+		heartbeatTime,
+		workflowTask.ScheduleID,
+	)
+}
+
 // UpdateWorkflowTask updates a workflow task.
 func (m *workflowTaskStateMachine) UpdateWorkflowTask(
 	workflowTask *WorkflowTaskInfo,
@@ -609,6 +623,7 @@ func (m *workflowTaskStateMachine) UpdateWorkflowTask(
 	m.ms.executionInfo.WorkflowTaskStartedTime = workflowTask.StartedTime
 	m.ms.executionInfo.WorkflowTaskScheduledTime = workflowTask.ScheduledTime
 	m.ms.executionInfo.WorkflowTaskOriginalScheduledTime = workflowTask.OriginalScheduledTime
+	m.ms.executionInfo.WorkflowTaskLastHeartbeatTime = workflowTask.LastHeartbeatTime
 
 	// NOTE: do not update taskqueue in execution info
 
@@ -724,6 +739,7 @@ func (m *workflowTaskStateMachine) getWorkflowTaskInfo() *WorkflowTaskInfo {
 		ScheduledTime:         m.ms.executionInfo.WorkflowTaskScheduledTime,
 		TaskQueue:             taskQueue,
 		OriginalScheduledTime: m.ms.executionInfo.WorkflowTaskOriginalScheduledTime,
+		LastHeartbeatTime:     m.ms.executionInfo.WorkflowTaskLastHeartbeatTime,
 	}
 }
 
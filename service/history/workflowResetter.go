@@ -47,6 +47,7 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/workflow"
@@ -304,6 +305,13 @@ func (r *workflowResetterImpl) prepareResetWorkflow(
 		return nil, err
 	}
 
+	if err := r.addResetSearchAttributes(
+		resetMutableState,
+		baseRunID,
+	); err != nil {
+		return nil, err
+	}
+
 	return resetWorkflow, nil
 }
 
@@ -546,6 +554,40 @@ func (r *workflowResetterImpl) failInflightActivity(
 	return nil
 }
 
+// addResetSearchAttributes records reset lineage in search attributes of the reset workflow:
+// ResetRunId is the run which was reset and OriginalRunId is the run which started the reset chain.
+func (r *workflowResetterImpl) addResetSearchAttributes(
+	resetMutableState workflow.MutableState,
+	baseRunID string,
+) error {
+
+	startEvent, err := resetMutableState.GetStartEvent()
+	if err != nil {
+		return err
+	}
+	originalRunID := startEvent.GetWorkflowExecutionStartedEventAttributes().GetOriginalExecutionRunId()
+	if originalRunID == "" {
+		originalRunID = baseRunID
+	}
+
+	resetRunIDPayload, err := searchattribute.EncodeValue(baseRunID, enumspb.INDEXED_VALUE_TYPE_KEYWORD)
+	if err != nil {
+		return err
+	}
+	originalRunIDPayload, err := searchattribute.EncodeValue(originalRunID, enumspb.INDEXED_VALUE_TYPE_KEYWORD)
+	if err != nil {
+		return err
+	}
+
+	executionInfo := resetMutableState.GetExecutionInfo()
+	if executionInfo.SearchAttributes == nil {
+		executionInfo.SearchAttributes = make(map[string]*commonpb.Payload, 2)
+	}
+	executionInfo.SearchAttributes[searchattribute.ResetRunID] = resetRunIDPayload
+	executionInfo.SearchAttributes[searchattribute.OriginalRunID] = originalRunIDPayload
+	return nil
+}
+
 func (r *workflowResetterImpl) forkAndGenerateBranchToken(
 	namespaceID string,
 	workflowID string,
@@ -48,8 +48,10 @@ import (
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/failure"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/service/history/shard"
 )
 
@@ -478,6 +480,31 @@ func (s *workflowResetterSuite) TestFailInflightActivity() {
 	s.NoError(err)
 }
 
+func (s *workflowResetterSuite) TestAddResetSearchAttributes() {
+	originalRunID := uuid.New()
+	executionInfo := &persistencespb.WorkflowExecutionInfo{}
+
+	mutableState := workflow.NewMockMutableState(s.controller)
+	mutableState.EXPECT().GetExecutionInfo().Return(executionInfo).AnyTimes()
+	mutableState.EXPECT().GetStartEvent().Return(&historypb.HistoryEvent{
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+		Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{
+			WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+				OriginalExecutionRunId: originalRunID,
+			},
+		},
+	}, nil)
+
+	err := s.workflowResetter.addResetSearchAttributes(mutableState, s.baseRunID)
+	s.NoError(err)
+
+	var resetRunID, recordedOriginalRunID string
+	s.NoError(payload.Decode(executionInfo.SearchAttributes[searchattribute.ResetRunID], &resetRunID))
+	s.NoError(payload.Decode(executionInfo.SearchAttributes[searchattribute.OriginalRunID], &recordedOriginalRunID))
+	s.Equal(s.baseRunID, resetRunID)
+	s.Equal(originalRunID, recordedOriginalRunID)
+}
+
 func (s *workflowResetterSuite) TestGenerateBranchToken() {
 	baseBranchToken := []byte("some random base branch token")
 	baseNodeID := int64(1234)
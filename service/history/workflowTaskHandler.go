@@ -212,9 +212,17 @@ func (handler *workflowTaskHandlerImpl) handleCommandScheduleActivity(
 		return err
 	}
 
+	if err := handler.validateWorkflowSize(
+		enumspb.COMMAND_TYPE_SCHEDULE_ACTIVITY_TASK,
+		attr.Size(),
+		enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_SCHEDULE_ACTIVITY_ATTRIBUTES,
+	); err != nil || handler.stopProcessing {
+		return err
+	}
+
 	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfPayloadSizeExceedsLimit(
 		metrics.CommandTypeTag(enumspb.COMMAND_TYPE_SCHEDULE_ACTIVITY_TASK.String()),
-		attr.GetInput().Size(),
+		attr.GetInput(),
 		"ScheduleActivityTaskCommandAttributes.Input exceeds size limit.",
 	)
 	if err != nil || failWorkflow {
@@ -226,6 +234,9 @@ func (handler *workflowTaskHandlerImpl) handleCommandScheduleActivity(
 
 	_, _, err = handler.mutableState.AddActivityTaskScheduledEvent(handler.workflowTaskCompletedID, attr)
 	if err != nil {
+		if err == workflow.ErrPendingActivitiesLimitExceeded {
+			return handler.failCommand(enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_SCHEDULE_ACTIVITY_ATTRIBUTES, err)
+		}
 		if _, ok := err.(*serviceerror.InvalidArgument); ok {
 			return handler.failCommand(enumspb.WORKFLOW_TASK_FAILED_CAUSE_SCHEDULE_ACTIVITY_DUPLICATE_ID, err)
 		}
@@ -305,6 +316,14 @@ func (handler *workflowTaskHandlerImpl) handleCommandStartTimer(
 		return err
 	}
 
+	if err := handler.validateWorkflowSize(
+		enumspb.COMMAND_TYPE_START_TIMER,
+		attr.Size(),
+		enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_START_TIMER_ATTRIBUTES,
+	); err != nil || handler.stopProcessing {
+		return err
+	}
+
 	_, _, err := handler.mutableState.AddTimerStartedEvent(handler.workflowTaskCompletedID, attr)
 	if err != nil {
 		if _, ok := err.(*serviceerror.InvalidArgument); ok {
@@ -339,7 +358,7 @@ func (handler *workflowTaskHandlerImpl) handleCommandCompleteWorkflow(
 
 	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfPayloadSizeExceedsLimit(
 		metrics.CommandTypeTag(enumspb.COMMAND_TYPE_COMPLETE_WORKFLOW_EXECUTION.String()),
-		attr.GetResult().Size(),
+		attr.GetResult(),
 		"CompleteWorkflowExecutionCommandAttributes.Result exceeds size limit.",
 	)
 	if err != nil || failWorkflow {
@@ -414,7 +433,7 @@ func (handler *workflowTaskHandlerImpl) handleCommandFailWorkflow(
 
 	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfPayloadSizeExceedsLimit(
 		metrics.CommandTypeTag(enumspb.COMMAND_TYPE_FAIL_WORKFLOW_EXECUTION.String()),
-		attr.GetFailure().Size(),
+		attr.GetFailure(),
 		"FailWorkflowExecutionCommandAttributes.Failure exceeds size limit.",
 	)
 	if err != nil || failWorkflow {
@@ -584,6 +603,14 @@ func (handler *workflowTaskHandlerImpl) handleCommandRequestCancelExternalWorkfl
 		return err
 	}
 
+	if err := handler.validateWorkflowSize(
+		enumspb.COMMAND_TYPE_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION,
+		attr.Size(),
+		enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_ATTRIBUTES,
+	); err != nil || handler.stopProcessing {
+		return err
+	}
+
 	cancelRequestID := uuid.New()
 	_, _, err := handler.mutableState.AddRequestCancelExternalWorkflowExecutionInitiatedEvent(
 		handler.workflowTaskCompletedID, cancelRequestID, attr,
@@ -610,9 +637,17 @@ func (handler *workflowTaskHandlerImpl) handleCommandRecordMarker(
 		return err
 	}
 
+	if err := handler.validateWorkflowSize(
+		enumspb.COMMAND_TYPE_RECORD_MARKER,
+		attr.Size(),
+		enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_RECORD_MARKER_ATTRIBUTES,
+	); err != nil || handler.stopProcessing {
+		return err
+	}
+
 	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfPayloadSizeExceedsLimit(
 		metrics.CommandTypeTag(enumspb.COMMAND_TYPE_RECORD_MARKER.String()),
-		common.GetPayloadsMapSize(attr.GetDetails()),
+		payloadsMap(attr.GetDetails()),
 		"RecordMarkerCommandAttributes.Details exceeds size limit.",
 	)
 	if err != nil || failWorkflow {
@@ -655,7 +690,7 @@ func (handler *workflowTaskHandlerImpl) handleCommandContinueAsNewWorkflow(
 
 	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfPayloadSizeExceedsLimit(
 		metrics.CommandTypeTag(enumspb.COMMAND_TYPE_CONTINUE_AS_NEW_WORKFLOW_EXECUTION.String()),
-		attr.GetInput().Size(),
+		attr.GetInput(),
 		"ContinueAsNewWorkflowExecutionCommandAttributes. Input exceeds size limit.",
 	)
 	if err != nil || failWorkflow {
@@ -665,7 +700,7 @@ func (handler *workflowTaskHandlerImpl) handleCommandContinueAsNewWorkflow(
 
 	failWorkflow, err = handler.sizeLimitChecker.failWorkflowIfMemoSizeExceedsLimit(
 		metrics.CommandTypeTag(enumspb.COMMAND_TYPE_CONTINUE_AS_NEW_WORKFLOW_EXECUTION.String()),
-		attr.GetMemo().Size(),
+		attr.GetMemo(),
 		"ContinueAsNewWorkflowExecutionCommandAttributes. Memo exceeds size limit.",
 	)
 	if err != nil || failWorkflow {
@@ -764,9 +799,17 @@ func (handler *workflowTaskHandlerImpl) handleCommandStartChildWorkflow(
 		return err
 	}
 
+	if err := handler.validateWorkflowSize(
+		enumspb.COMMAND_TYPE_START_CHILD_WORKFLOW_EXECUTION,
+		attr.Size(),
+		enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_START_CHILD_EXECUTION_ATTRIBUTES,
+	); err != nil || handler.stopProcessing {
+		return err
+	}
+
 	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfPayloadSizeExceedsLimit(
 		metrics.CommandTypeTag(enumspb.COMMAND_TYPE_START_CHILD_WORKFLOW_EXECUTION.String()),
-		attr.GetInput().Size(),
+		attr.GetInput(),
 		"StartChildWorkflowExecutionCommandAttributes. Input exceeds size limit.",
 	)
 	if err != nil || failWorkflow {
@@ -776,7 +819,7 @@ func (handler *workflowTaskHandlerImpl) handleCommandStartChildWorkflow(
 
 	failWorkflow, err = handler.sizeLimitChecker.failWorkflowIfMemoSizeExceedsLimit(
 		metrics.CommandTypeTag(enumspb.COMMAND_TYPE_START_CHILD_WORKFLOW_EXECUTION.String()),
-		attr.GetMemo().Size(),
+		attr.GetMemo(),
 		"StartChildWorkflowExecutionCommandAttributes. Memo exceeds size limit.",
 	)
 	if err != nil || failWorkflow {
@@ -807,6 +850,9 @@ func (handler *workflowTaskHandlerImpl) handleCommandStartChildWorkflow(
 	_, _, err = handler.mutableState.AddStartChildWorkflowExecutionInitiatedEvent(
 		handler.workflowTaskCompletedID, requestID, attr,
 	)
+	if err == workflow.ErrPendingChildExecutionsLimitExceeded {
+		return handler.failCommand(enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_START_CHILD_EXECUTION_ATTRIBUTES, err)
+	}
 	if err == nil {
 		// Keep track of all child initiated commands in this workflow task to validate request cancel commands
 		handler.initiatedChildExecutionsInBatch[attr.GetWorkflowId()] = struct{}{}
@@ -847,9 +893,17 @@ func (handler *workflowTaskHandlerImpl) handleCommandSignalExternalWorkflow(
 		return err
 	}
 
+	if err := handler.validateWorkflowSize(
+		enumspb.COMMAND_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION,
+		attr.Size(),
+		enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_SIGNAL_WORKFLOW_EXECUTION_ATTRIBUTES,
+	); err != nil || handler.stopProcessing {
+		return err
+	}
+
 	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfPayloadSizeExceedsLimit(
 		metrics.CommandTypeTag(enumspb.COMMAND_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION.String()),
-		attr.GetInput().Size(),
+		attr.GetInput(),
 		"SignalExternalWorkflowExecutionCommandAttributes.Input exceeds size limit.",
 	)
 	if err != nil || failWorkflow {
@@ -861,6 +915,9 @@ func (handler *workflowTaskHandlerImpl) handleCommandSignalExternalWorkflow(
 	_, _, err = handler.mutableState.AddSignalExternalWorkflowExecutionInitiatedEvent(
 		handler.workflowTaskCompletedID, signalRequestID, attr,
 	)
+	if err == workflow.ErrPendingSignalsLimitExceeded {
+		return handler.failCommand(enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_SIGNAL_WORKFLOW_EXECUTION_ATTRIBUTES, err)
+	}
 	return err
 }
 
@@ -896,10 +953,18 @@ func (handler *workflowTaskHandlerImpl) handleCommandUpsertWorkflowSearchAttribu
 		return err
 	}
 
+	if err := handler.validateWorkflowSize(
+		enumspb.COMMAND_TYPE_UPSERT_WORKFLOW_SEARCH_ATTRIBUTES,
+		attr.Size(),
+		enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_SEARCH_ATTRIBUTES,
+	); err != nil || handler.stopProcessing {
+		return err
+	}
+
 	// blob size limit check
 	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfPayloadSizeExceedsLimit(
 		metrics.CommandTypeTag(enumspb.COMMAND_TYPE_UPSERT_WORKFLOW_SEARCH_ATTRIBUTES.String()),
-		searchAttributesSize(attr.GetSearchAttributes().GetIndexedFields()),
+		searchAttributesBlob{fields: attr.GetSearchAttributes().GetIndexedFields()},
 		"UpsertWorkflowSearchAttributesCommandAttributes exceeds size limit.",
 	)
 	if err != nil || failWorkflow {
@@ -923,6 +988,23 @@ func (handler *workflowTaskHandlerImpl) handleCommandUpsertWorkflowSearchAttribu
 	return err
 }
 
+// payloadsMap adapts marker details to the blob size limit check.
+type payloadsMap map[string]*commonpb.Payloads
+
+func (m payloadsMap) Size() int {
+	return common.GetPayloadsMapSize(m)
+}
+
+// searchAttributesBlob adapts search attributes to the blob size limit check. Only keys and data are
+// counted, fields are unexported so that payload metadata is not subtracted a second time.
+type searchAttributesBlob struct {
+	fields map[string]*commonpb.Payload
+}
+
+func (b searchAttributesBlob) Size() int {
+	return searchAttributesSize(b.fields)
+}
+
 func searchAttributesSize(fields map[string]*commonpb.Payload) int {
 	result := 0
 
@@ -987,6 +1069,23 @@ func (handler *workflowTaskHandlerImpl) validateCommandAttr(
 	return nil
 }
 
+func (handler *workflowTaskHandlerImpl) validateWorkflowSize(
+	commandType enumspb.CommandType,
+	commandSize int,
+	failedCause enumspb.WorkflowTaskFailedCause,
+) error {
+
+	return handler.validateCommandAttr(
+		func() error {
+			return handler.sizeLimitChecker.checkWorkflowSizeLimits(
+				metrics.CommandTypeTag(commandType.String()),
+				commandSize,
+			)
+		},
+		failedCause,
+	)
+}
+
 func (handler *workflowTaskHandlerImpl) failCommand(
 	failedCause enumspb.WorkflowTaskFailedCause,
 	causeErr error,
@@ -61,6 +61,8 @@ type (
 			*historyservice.RecordWorkflowTaskStartedRequest) (*historyservice.RecordWorkflowTaskStartedResponse, error)
 		handleWorkflowTaskFailed(context.Context,
 			*historyservice.RespondWorkflowTaskFailedRequest) error
+		handleWorkflowTaskHeartbeat(context.Context,
+			*historyservice.RecordWorkflowTaskHeartbeatRequest) error
 		handleWorkflowTaskCompleted(context.Context,
 			*historyservice.RespondWorkflowTaskCompletedRequest) (*historyservice.RespondWorkflowTaskCompletedResponse, error)
 		// TODO also include the handle of workflow task timeout here
@@ -291,6 +293,65 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskFailed(
 		})
 }
 
+func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskHeartbeat(
+	ctx context.Context,
+	req *historyservice.RecordWorkflowTaskHeartbeatRequest,
+) (retError error) {
+
+	namespaceEntry, err := handler.historyEngine.getActiveNamespaceEntry(req.GetNamespaceId())
+	if err != nil {
+		return err
+	}
+	namespaceID := namespaceEntry.GetInfo().Id
+	namespace := namespaceEntry.GetInfo().Name
+
+	token, err := handler.tokenSerializer.Deserialize(req.TaskToken)
+	if err != nil {
+		return consts.ErrDeserializingToken
+	}
+
+	workflowExecution := commonpb.WorkflowExecution{
+		WorkflowId: token.GetWorkflowId(),
+		RunId:      token.GetRunId(),
+	}
+
+	return handler.historyEngine.updateWorkflowExecution(
+		ctx,
+		namespaceID,
+		workflowExecution,
+		func(context workflow.Context, mutableState workflow.MutableState) (*updateWorkflowAction, error) {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, consts.ErrWorkflowCompleted
+			}
+
+			scheduleID := token.GetScheduleId()
+			workflowTask, isRunning := mutableState.GetWorkflowTaskInfo(scheduleID)
+			if !isRunning || workflowTask.Attempt != token.ScheduleAttempt || workflowTask.StartedID == common.EmptyEventID {
+				return nil, serviceerror.NewNotFound("Workflow task not found.")
+			}
+
+			scope := handler.metricsClient.Scope(metrics.HistoryRecordWorkflowTaskHeartbeatScope, metrics.NamespaceTag(namespace))
+			now := handler.timeSource.Now()
+			startedTime := timestamp.TimeValue(workflowTask.StartedTime)
+			// heartbeat can only extend a workflow task up to the heartbeat timeout, after which the
+			// start to close timer is allowed to fire so a stuck worker cannot hold the task forever
+			if now.After(startedTime.Add(handler.config.WorkflowTaskHeartbeatTimeout(namespace))) {
+				scope.IncCounter(metrics.WorkflowTaskHeartbeatTimeoutCounter)
+				return nil, consts.ErrWorkflowTaskHeartbeatTimeout
+			}
+
+			if err := mutableState.RecordWorkflowTaskHeartbeat(workflowTask, now); err != nil {
+				return nil, err
+			}
+			scope.IncCounter(metrics.WorkflowTaskExtendedCounter)
+			scope.RecordTimer(metrics.WorkflowTaskExtendedRunningTime, now.Sub(startedTime))
+			return &updateWorkflowAction{
+				noop:               false,
+				createWorkflowTask: false,
+			}, nil
+		})
+}
+
 func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskCompleted(
 	ctx context.Context,
 	req *historyservice.RespondWorkflowTaskCompletedRequest,
@@ -419,12 +480,15 @@ Update_History_Loop:
 			workflowSizeChecker := newWorkflowSizeChecker(
 				handler.config.BlobSizeLimitWarn(namespace),
 				handler.config.BlobSizeLimitError(namespace),
+				handler.config.BlobSizeAccountingMode(namespace),
 				handler.config.MemoSizeLimitWarn(namespace),
 				handler.config.MemoSizeLimitError(namespace),
 				handler.config.HistorySizeLimitWarn(namespace),
 				handler.config.HistorySizeLimitError(namespace),
 				handler.config.HistoryCountLimitWarn(namespace),
 				handler.config.HistoryCountLimitError(namespace),
+				handler.config.MutableStateSizeLimitWarn(namespace),
+				handler.config.MutableStateSizeLimitError(namespace),
 				completedEvent.GetEventId(),
 				msBuilder,
 				handler.historyEngine.searchAttributesValidator,
@@ -682,13 +746,15 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleBufferedQueries(msBuilder
 
 	sizeLimitError := handler.config.BlobSizeLimitError(namespace)
 	sizeLimitWarn := handler.config.BlobSizeLimitWarn(namespace)
+	sizeAccountingMode := handler.config.BlobSizeAccountingMode(namespace)
 
 	// Complete or fail all queries we have results for
 	for id, result := range queryResults {
 		if err := common.CheckEventBlobSizeLimit(
-			result.GetAnswer().Size(),
+			result.GetAnswer(),
 			sizeLimitWarn,
 			sizeLimitError,
+			sizeAccountingMode,
 			namespaceID,
 			workflowID,
 			runID,
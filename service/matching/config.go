@@ -38,6 +38,7 @@ type (
 		PersistenceMaxQPS       dynamicconfig.IntPropertyFn
 		PersistenceGlobalMaxQPS dynamicconfig.IntPropertyFn
 		SyncMatchWaitDuration   dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
+		SyncMatchPollerWait     dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
 		RPS                     dynamicconfig.IntPropertyFn
 		ShutdownDrainDuration   dynamicconfig.DurationPropertyFn
 
@@ -55,6 +56,14 @@ type (
 		ForwarderMaxRatePerSecond    dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
 		ForwarderMaxChildrenPerNode  dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
 		ResilientSyncMatch           dynamicconfig.BoolPropertyFn
+		UserDataRefreshInterval      dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
+		VersionCompatibleSetLimit    dynamicconfig.IntPropertyFnWithNamespaceFilter
+		VersionBuildIdLimit          dynamicconfig.IntPropertyFnWithNamespaceFilter
+		EvictStickyQueueOnPollCancel dynamicconfig.BoolPropertyFnWithNamespaceFilter
+		StickyQueueEvictionTTL       dynamicconfig.DurationPropertyFn
+		MaxTaskQueueDispatchRate     dynamicconfig.FloatPropertyFnWithTaskQueueInfoFilters
+		NamespaceIsolationGroup      dynamicconfig.StringPropertyFnWithNamespaceFilter
+		IsolationGroupBudget         dynamicconfig.MapPropertyFn
 
 		// Time to hold a poll request before returning an empty response if there are no tasks
 		LongPollExpirationInterval dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
@@ -81,6 +90,8 @@ type (
 	taskQueueConfig struct {
 		forwarderConfig
 		SyncMatchWaitDuration func() time.Duration
+		// Time to wait for a local poller before writing task to the backlog
+		SyncMatchPollerWait func() time.Duration
 		// Time to hold a poll request before returning an empty response if there are no tasks
 		LongPollExpirationInterval func() time.Duration
 		RangeSize                  int64
@@ -100,10 +111,15 @@ type (
 		AdminNamespaceToPartitionDispatchRate func() float64
 		// partition qps = AdminNamespaceTaskQueueToPartitionDispatchRate(namespace, task_queue)
 		AdminNamespaceTaskQueueToPartitionDispatchRate func() float64
+		// task queue qps = MaxTaskQueueDispatchRate(namespace, task_queue), zero means no limit
+		MaxTaskQueueDispatchRate func() float64
 
 		// ResilientSyncMatch enables or disables sync-matching while
 		// persistence is unavailable
 		ResilientSyncMatch func() bool
+
+		// Interval at which the user data is fetched from the root partition
+		UserDataRefreshInterval func() time.Duration
 	}
 )
 
@@ -113,6 +129,7 @@ func NewConfig(dc *dynamicconfig.Collection) *Config {
 		PersistenceMaxQPS:               dc.GetIntProperty(dynamicconfig.MatchingPersistenceMaxQPS, 3000),
 		PersistenceGlobalMaxQPS:         dc.GetIntProperty(dynamicconfig.MatchingPersistenceGlobalMaxQPS, 0),
 		SyncMatchWaitDuration:           dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingSyncMatchWaitDuration, 200*time.Millisecond),
+		SyncMatchPollerWait:             dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingSyncMatchPollerWait, 0),
 		RPS:                             dc.GetIntProperty(dynamicconfig.MatchingRPS, 1200),
 		RangeSize:                       100000,
 		GetTasksBatchSize:               dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingGetTasksBatchSize, 1000),
@@ -133,6 +150,14 @@ func NewConfig(dc *dynamicconfig.Collection) *Config {
 		ForwarderMaxChildrenPerNode:     dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingForwarderMaxChildrenPerNode, 20),
 		ResilientSyncMatch:              dc.GetBoolProperty(dynamicconfig.ResilientSyncMatch, false),
 		ShutdownDrainDuration:           dc.GetDurationProperty(dynamicconfig.MatchingShutdownDrainDuration, 0),
+		UserDataRefreshInterval:         dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingUserDataRefreshInterval, time.Minute),
+		VersionCompatibleSetLimit:       dc.GetIntPropertyFilteredByNamespace(dynamicconfig.MatchingVersionCompatibleSetLimit, 10),
+		VersionBuildIdLimit:             dc.GetIntPropertyFilteredByNamespace(dynamicconfig.MatchingVersionBuildIdLimit, 100),
+		EvictStickyQueueOnPollCancel:    dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.MatchingEvictStickyQueueOnPollCancel, true),
+		StickyQueueEvictionTTL:          dc.GetDurationProperty(dynamicconfig.MatchingStickyQueueEvictionTTL, time.Hour),
+		MaxTaskQueueDispatchRate:        dc.GetFloatPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingMaxTaskQueueDispatchRate, 0),
+		NamespaceIsolationGroup:         dc.GetStringPropertyFnWithNamespaceFilter(dynamicconfig.NamespaceIsolationGroup, ""),
+		IsolationGroupBudget:            dc.GetMapProperty(dynamicconfig.MatchingIsolationGroupBudget, map[string]interface{}{}),
 
 		AdminNamespaceToPartitionDispatchRate:          dc.GetFloatPropertyFilteredByNamespace(dynamicconfig.AdminMatchingNamespaceToPartitionDispatchRate, 10000),
 		AdminNamespaceTaskqueueToPartitionDispatchRate: dc.GetFloatPropertyFilteredByTaskQueueInfo(dynamicconfig.AdminMatchingNamespaceTaskqueueToPartitionDispatchRate, 1000),
@@ -176,6 +201,9 @@ func newTaskQueueConfig(id *taskQueueID, config *Config, namespaceCache cache.Na
 		SyncMatchWaitDuration: func() time.Duration {
 			return config.SyncMatchWaitDuration(namespace, taskQueueName, taskType)
 		},
+		SyncMatchPollerWait: func() time.Duration {
+			return config.SyncMatchPollerWait(namespace, taskQueueName, taskType)
+		},
 		LongPollExpirationInterval: func() time.Duration {
 			return config.LongPollExpirationInterval(namespace, taskQueueName, taskType)
 		},
@@ -196,6 +224,12 @@ func newTaskQueueConfig(id *taskQueueID, config *Config, namespaceCache cache.Na
 		AdminNamespaceTaskQueueToPartitionDispatchRate: func() float64 {
 			return config.AdminNamespaceTaskqueueToPartitionDispatchRate(namespace, taskQueueName, taskType)
 		},
+		MaxTaskQueueDispatchRate: func() float64 {
+			return config.MaxTaskQueueDispatchRate(namespace, taskQueueName, taskType)
+		},
+		UserDataRefreshInterval: func() time.Duration {
+			return config.UserDataRefreshInterval(namespace, taskQueueName, taskType)
+		},
 		forwarderConfig: forwarderConfig{
 			ForwarderMaxOutstandingPolls: func() int {
 				return config.ForwarderMaxOutstandingPolls(namespace, taskQueueName, taskType)
@@ -30,15 +30,22 @@ import (
 
 var (
 	APIToPriority = map[string]int{
-		"AddActivityTask":           0,
-		"AddWorkflowTask":           0,
-		"CancelOutstandingPoll":     0,
-		"DescribeTaskQueue":         0,
-		"ListTaskQueuePartitions":   0,
-		"PollActivityTaskQueue":     0,
-		"PollWorkflowTaskQueue":     0,
-		"QueryWorkflow":             0,
-		"RespondQueryTaskCompleted": 0,
+		"AddActivityTask":                  0,
+		"AddWorkflowTask":                  0,
+		"CancelOutstandingPoll":            0,
+		"DescribeTaskQueue":                0,
+		"DescribeTaskQueuePartitions":      0,
+		"EvictStickyTaskQueue":             0,
+		"GetHostProfile":                   0,
+		"GetTaskQueueUserData":             0,
+		"GetWorkerBuildIdCompatibility":    0,
+		"ListTaskQueuePartitions":          0,
+		"PollActivityTaskQueue":            0,
+		"PollWorkflowTaskQueue":            0,
+		"QueryWorkflow":                    0,
+		"RespondQueryTaskCompleted":        0,
+		"UpdateTaskQueueUserData":          0,
+		"UpdateWorkerBuildIdCompatibility": 0,
 	}
 
 	APIPriorities = map[int]struct{}{
@@ -45,6 +45,7 @@ type (
 		taskType      enumspb.TaskQueueType
 		rangeID       int64
 		ackLevel      int64
+		userData      *persistencespb.TaskQueueUserData
 		store         persistence.TaskManager
 		logger        log.Logger
 	}
@@ -99,6 +100,7 @@ func (db *taskQueueDB) RenewLease() (taskQueueState, error) {
 	}
 	db.ackLevel = resp.TaskQueueInfo.Data.AckLevel
 	db.rangeID = resp.TaskQueueInfo.RangeID
+	db.userData = resp.TaskQueueInfo.Data.UserData
 	return taskQueueState{rangeID: db.rangeID, ackLevel: db.ackLevel}, nil
 }
 
@@ -113,6 +115,7 @@ func (db *taskQueueDB) UpdateState(ackLevel int64) error {
 			TaskType:    db.taskType,
 			AckLevel:    ackLevel,
 			Kind:        db.taskQueueKind,
+			UserData:    db.userData,
 		},
 		RangeID: db.rangeID,
 	})
@@ -122,6 +125,34 @@ func (db *taskQueueDB) UpdateState(ackLevel int64) error {
 	return err
 }
 
+// UserData returns the current persistence view of the task queue user data
+func (db *taskQueueDB) UserData() *persistencespb.TaskQueueUserData {
+	db.Lock()
+	defer db.Unlock()
+	return db.userData
+}
+
+// UpdateUserData replaces the task queue user data with the given value
+func (db *taskQueueDB) UpdateUserData(userData *persistencespb.TaskQueueUserData) error {
+	db.Lock()
+	defer db.Unlock()
+	_, err := db.store.UpdateTaskQueue(&persistence.UpdateTaskQueueRequest{
+		TaskQueueInfo: &persistencespb.TaskQueueInfo{
+			NamespaceId: db.namespaceID,
+			Name:        db.taskQueueName,
+			TaskType:    db.taskType,
+			AckLevel:    db.ackLevel,
+			Kind:        db.taskQueueKind,
+			UserData:    userData,
+		},
+		RangeID: db.rangeID,
+	})
+	if err == nil {
+		db.userData = userData
+	}
+	return err
+}
+
 // CreateTasks creates a batch of given tasks for this task queue
 func (db *taskQueueDB) CreateTasks(tasks []*persistencespb.AllocatedTaskInfo) (*persistence.CreateTasksResponse, error) {
 	db.Lock()
@@ -135,6 +166,7 @@ func (db *taskQueueDB) CreateTasks(tasks []*persistencespb.AllocatedTaskInfo) (*
 					TaskType:    db.taskType,
 					AckLevel:    db.ackLevel,
 					Kind:        db.taskQueueKind,
+					UserData:    db.userData,
 				},
 				RangeID: db.rangeID,
 			},
@@ -36,6 +36,7 @@ import (
 	"go.temporal.io/api/workflowservice/v1"
 
 	"go.temporal.io/server/api/matchingservice/v1"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/quotas"
 )
@@ -48,6 +49,7 @@ type (
 		taskQueueID   *taskQueueID
 		taskQueueKind enumspb.TaskQueueKind
 		client        matchingservice.MatchingServiceClient
+		scope         func() metrics.Scope
 
 		// token channels that vend tokens necessary to make
 		// API calls exposed by forwarder. Tokens are used
@@ -97,10 +99,12 @@ func newForwarder(
 	taskQueueID *taskQueueID,
 	kind enumspb.TaskQueueKind,
 	client matchingservice.MatchingServiceClient,
+	scopeFunc func() metrics.Scope,
 ) *Forwarder {
 	fwdr := &Forwarder{
 		cfg:                   cfg,
 		client:                client,
+		scope:                 scopeFunc,
 		taskQueueID:           taskQueueID,
 		taskQueueKind:         kind,
 		outstandingTasksLimit: int32(cfg.ForwarderMaxOutstandingTasks()),
@@ -125,10 +129,16 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 		return errNoParent
 	}
 
+	scope := fwdr.scope()
+	scope.IncCounter(metrics.ForwardTaskCallsPerTaskQueue)
 	if !fwdr.limiter.Allow() {
+		scope.IncCounter(metrics.ForwardTaskErrorsPerTaskQueue)
 		return errForwarderSlowDown
 	}
 
+	sw := scope.StartTimer(metrics.ForwardTaskLatencyPerTaskQueue)
+	defer sw.Stop()
+
 	var err error
 
 	var expirationDuration time.Duration
@@ -174,6 +184,9 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 		return errInvalidTaskQueueType
 	}
 
+	if err != nil {
+		scope.IncCounter(metrics.ForwardTaskErrorsPerTaskQueue)
+	}
 	return fwdr.handleErr(err)
 }
 
@@ -192,6 +205,11 @@ func (fwdr *Forwarder) ForwardQueryTask(
 		return nil, errNoParent
 	}
 
+	scope := fwdr.scope()
+	scope.IncCounter(metrics.ForwardQueryCallsPerTaskQueue)
+	sw := scope.StartTimer(metrics.ForwardQueryLatencyPerTaskQueue)
+	defer sw.Stop()
+
 	resp, err := fwdr.client.QueryWorkflow(ctx, &matchingservice.QueryWorkflowRequest{
 		NamespaceId: task.query.request.GetNamespaceId(),
 		TaskQueue: &taskqueuepb.TaskQueue{
@@ -201,6 +219,9 @@ func (fwdr *Forwarder) ForwardQueryTask(
 		QueryRequest:    task.query.request.QueryRequest,
 		ForwardedSource: fwdr.taskQueueID.name,
 	})
+	if err != nil {
+		scope.IncCounter(metrics.ForwardQueryErrorsPerTaskQueue)
+	}
 
 	return resp, fwdr.handleErr(err)
 }
@@ -216,6 +237,11 @@ func (fwdr *Forwarder) ForwardPoll(ctx context.Context) (*internalTask, error) {
 		return nil, errNoParent
 	}
 
+	scope := fwdr.scope()
+	scope.IncCounter(metrics.ForwardPollCallsPerTaskQueue)
+	sw := scope.StartTimer(metrics.ForwardPollLatencyPerTaskQueue)
+	defer sw.Stop()
+
 	pollerID, _ := ctx.Value(pollerIDKey).(string)
 	identity, _ := ctx.Value(identityKey).(string)
 
@@ -234,6 +260,7 @@ func (fwdr *Forwarder) ForwardPoll(ctx context.Context) (*internalTask, error) {
 			ForwardedSource: fwdr.taskQueueID.name,
 		})
 		if err != nil {
+			scope.IncCounter(metrics.ForwardPollErrorsPerTaskQueue)
 			return nil, fwdr.handleErr(err)
 		}
 		return newInternalStartedTask(&startedTaskInfo{workflowTaskInfo: resp}), nil
@@ -251,6 +278,7 @@ func (fwdr *Forwarder) ForwardPoll(ctx context.Context) (*internalTask, error) {
 			ForwardedSource: fwdr.taskQueueID.name,
 		})
 		if err != nil {
+			scope.IncCounter(metrics.ForwardPollErrorsPerTaskQueue)
 			return nil, fwdr.handleErr(err)
 		}
 		return newInternalStartedTask(&startedTaskInfo{activityTaskInfo: resp}), nil
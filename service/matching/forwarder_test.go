@@ -34,7 +34,9 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
 	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
@@ -42,6 +44,7 @@ import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/metrics"
 )
 
 type ForwarderTestSuite struct {
@@ -67,7 +70,7 @@ func (t *ForwarderTestSuite) SetupTest() {
 		ForwarderMaxOutstandingTasks: func() int { return 1 },
 	}
 	t.taskQueue = newTestTaskQueueID("fwdr", "tl0", enumspb.TASK_QUEUE_TYPE_WORKFLOW)
-	t.fwdr = newForwarder(t.cfg, t.taskQueue, enumspb.TASK_QUEUE_KIND_NORMAL, t.client)
+	t.fwdr = newForwarder(t.cfg, t.taskQueue, enumspb.TASK_QUEUE_KIND_NORMAL, t.client, func() metrics.Scope { return metrics.NoopScope(metrics.Matching) })
 }
 
 func (t *ForwarderTestSuite) TearDownTest() {
@@ -110,6 +113,39 @@ func (t *ForwarderTestSuite) TestForwardWorkflowTask() {
 	t.Equal(t.taskQueue.name, request.GetForwardedSource())
 }
 
+func (t *ForwarderTestSuite) TestForwardMetrics() {
+	t.usingTaskqueuePartition(enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	testScope := tally.NewTestScope("test", nil)
+	scope := metrics.NewClient(testScope, metrics.Matching).Scope(metrics.MatchingTaskQueueMgrScope)
+	t.fwdr.scope = func() metrics.Scope { return scope }
+
+	t.client.EXPECT().AddWorkflowTask(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&matchingservice.AddWorkflowTaskResponse{}, nil)
+	task := newInternalTask(randomTaskInfo(), nil, enumsspb.TASK_SOURCE_HISTORY, "", false)
+	t.NoError(t.fwdr.ForwardTask(context.Background(), task))
+
+	t.client.EXPECT().PollWorkflowTaskQueue(gomock.Any(), gomock.Any()).
+		Return(nil, serviceerror.NewInternal("poll failed"))
+	_, err := t.fwdr.ForwardPoll(context.Background())
+	t.Error(err)
+
+	counters := make(map[string]int64)
+	for _, counter := range testScope.Snapshot().Counters() {
+		counters[counter.Name()] += counter.Value()
+	}
+	t.EqualValues(1, counters["test.forward_task_calls_per_tl"])
+	t.EqualValues(0, counters["test.forward_task_errors_per_tl"])
+	t.EqualValues(1, counters["test.forward_poll_calls_per_tl"])
+	t.EqualValues(1, counters["test.forward_poll_errors_per_tl"])
+
+	timers := make(map[string]int)
+	for _, timer := range testScope.Snapshot().Timers() {
+		timers[timer.Name()] += len(timer.Values())
+	}
+	t.Equal(1, timers["test.forward_task_latency_per_tl"])
+	t.Equal(1, timers["test.forward_poll_latency_per_tl"])
+}
+
 func (t *ForwarderTestSuite) TestForwardActivityTask() {
 	t.usingTaskqueuePartition(enumspb.TASK_QUEUE_TYPE_ACTIVITY)
 
@@ -29,6 +29,7 @@ import (
 	"sync"
 	"time"
 
+	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
@@ -36,7 +37,10 @@ import (
 	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/pprof"
+	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/resource"
 )
 
@@ -338,6 +342,129 @@ func (h *Handler) ListTaskQueuePartitions(
 	return response, err
 }
 
+// DescribeTaskQueuePartitions returns pollers, backlog and task reader status of every partition of a task queue
+func (h *Handler) DescribeTaskQueuePartitions(
+	ctx context.Context,
+	request *matchingservice.DescribeTaskQueuePartitionsRequest,
+) (_ *matchingservice.DescribeTaskQueuePartitionsResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	hCtx := h.newHandlerContext(
+		ctx,
+		request.GetNamespaceId(),
+		&taskqueuepb.TaskQueue{Name: request.GetTaskQueue()},
+		metrics.MatchingDescribeTaskQueuePartitionsScope,
+	)
+
+	response, err := h.engine.DescribeTaskQueuePartitions(hCtx, request)
+	return response, err
+}
+
+// GetTaskQueueUserData returns the user data of a task queue
+func (h *Handler) GetTaskQueueUserData(
+	ctx context.Context,
+	request *matchingservice.GetTaskQueueUserDataRequest,
+) (_ *matchingservice.GetTaskQueueUserDataResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	hCtx := h.newHandlerContext(
+		ctx,
+		request.GetNamespaceId(),
+		&taskqueuepb.TaskQueue{Name: request.GetTaskQueue()},
+		metrics.MatchingGetTaskQueueUserDataScope,
+	)
+
+	response, err := h.engine.GetTaskQueueUserData(hCtx, request)
+	return response, err
+}
+
+// UpdateTaskQueueUserData replaces the user data of a task queue
+func (h *Handler) UpdateTaskQueueUserData(
+	ctx context.Context,
+	request *matchingservice.UpdateTaskQueueUserDataRequest,
+) (_ *matchingservice.UpdateTaskQueueUserDataResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	hCtx := h.newHandlerContext(
+		ctx,
+		request.GetNamespaceId(),
+		&taskqueuepb.TaskQueue{Name: request.GetTaskQueue()},
+		metrics.MatchingUpdateTaskQueueUserDataScope,
+	)
+
+	response, err := h.engine.UpdateTaskQueueUserData(hCtx, request)
+	return response, err
+}
+
+// UpdateWorkerBuildIdCompatibility updates the compatible worker build id sets of a task queue
+func (h *Handler) UpdateWorkerBuildIdCompatibility(
+	ctx context.Context,
+	request *matchingservice.UpdateWorkerBuildIdCompatibilityRequest,
+) (_ *matchingservice.UpdateWorkerBuildIdCompatibilityResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	hCtx := h.newHandlerContext(
+		ctx,
+		request.GetNamespaceId(),
+		&taskqueuepb.TaskQueue{Name: request.GetTaskQueue()},
+		metrics.MatchingUpdateWorkerBuildIdCompatibilityScope,
+	)
+
+	response, err := h.engine.UpdateWorkerBuildIdCompatibility(hCtx, request)
+	return response, err
+}
+
+// GetWorkerBuildIdCompatibility returns the compatible worker build id sets of a task queue
+func (h *Handler) GetWorkerBuildIdCompatibility(
+	ctx context.Context,
+	request *matchingservice.GetWorkerBuildIdCompatibilityRequest,
+) (_ *matchingservice.GetWorkerBuildIdCompatibilityResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	hCtx := h.newHandlerContext(
+		ctx,
+		request.GetNamespaceId(),
+		&taskqueuepb.TaskQueue{Name: request.GetTaskQueue()},
+		metrics.MatchingGetWorkerBuildIdCompatibilityScope,
+	)
+
+	response, err := h.engine.GetWorkerBuildIdCompatibility(hCtx, request)
+	return response, err
+}
+
+// EvictStickyTaskQueue evicts the sticky task queue of a worker which shuts down
+func (h *Handler) EvictStickyTaskQueue(
+	ctx context.Context,
+	request *matchingservice.EvictStickyTaskQueueRequest,
+) (_ *matchingservice.EvictStickyTaskQueueResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	hCtx := h.newHandlerContext(
+		ctx,
+		request.GetNamespaceId(),
+		&taskqueuepb.TaskQueue{Name: request.GetTaskQueue(), Kind: enumspb.TASK_QUEUE_KIND_STICKY},
+		metrics.MatchingEvictStickyTaskQueueScope,
+	)
+
+	response, err := h.engine.EvictStickyTaskQueue(hCtx, request)
+	return response, err
+}
+
+// GetHostProfile captures a pprof profile of this host
+func (h *Handler) GetHostProfile(
+	ctx context.Context,
+	request *matchingservice.GetHostProfileRequest,
+) (_ *matchingservice.GetHostProfileResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+
+	scope := h.metricsClient.Scope(metrics.MatchingGetHostProfileScope)
+	scope.IncCounter(metrics.ServiceRequests)
+	sw := scope.StartTimer(metrics.ServiceLatency)
+	defer sw.Stop()
+
+	h.logger.Info("Capturing profile.", tag.Value(request.GetProfile()))
+	profile, err := pprof.CaptureProfile(ctx, request.GetProfile(), timestamp.DurationValue(request.GetDuration()))
+	if err != nil {
+		scope.IncCounter(metrics.ServiceFailures)
+		return nil, err
+	}
+	return &matchingservice.GetHostProfileResponse{Profile: profile}, nil
+}
+
 func (h *Handler) namespaceName(id string) string {
 	entry, err := h.GetNamespaceCache().GetNamespaceByID(id)
 	if err != nil {
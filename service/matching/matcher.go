@@ -26,6 +26,7 @@ package matching
 
 import (
 	"context"
+	"sync/atomic"
 	"time"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
@@ -48,6 +49,11 @@ type TaskMatcher struct {
 	// not active in a cluster
 	queryTaskC chan *internalTask
 
+	// number of pollers waiting for a task (not only query task), accessed atomically
+	numPollers int32
+	// notified when a poller starts waiting for a task
+	pollerC chan struct{}
+
 	// dynamicRate is the dynamic rate for rate limiter
 	dynamicRate quotas.DynamicRate
 	// dynamicBurst is the dynamic burst for rate limiter
@@ -83,6 +89,11 @@ func newTaskMatcher(config *taskQueueConfig, fwdr *Forwarder, scopeFunc func() m
 		quotas.NewDefaultOutgoingDynamicRateLimiter(
 			config.AdminNamespaceToPartitionDispatchRate,
 		),
+		quotas.NewDynamicRateLimiter(
+			func() float64 { return maxPartitionDispatchRate(config) },
+			func() int { return maxPartitionDispatchBurst(config) },
+			defaultTaskDispatchRPSTTL,
+		),
 	})
 	return &TaskMatcher{
 		config:        config,
@@ -93,6 +104,7 @@ func newTaskMatcher(config *taskQueueConfig, fwdr *Forwarder, scopeFunc func() m
 		fwdr:          fwdr,
 		taskC:         make(chan *internalTask),
 		queryTaskC:    make(chan *internalTask),
+		pollerC:       make(chan struct{}, 1),
 		numPartitions: config.NumReadPartitions,
 	}
 }
@@ -122,6 +134,11 @@ func newTaskMatcher(config *taskQueueConfig, fwdr *Forwarder, scopeFunc func() m
 // trying to match with a poller. The caller is expected to set the
 // correct context timeout.
 //
+// Sync match poller wait:
+// When SyncMatchPollerWait is configured and no local poller is available,
+// this method will block up to that duration waiting for a local poller,
+// so the caller doesn't have to write the task to the db backlog.
+//
 // returns error when:
 //  - ratelimit is exceeded (does not apply to query task)
 //  - context deadline is exceeded
@@ -162,12 +179,37 @@ func (tm *TaskMatcher) Offer(ctx context.Context, task *internalTask) (bool, err
 				// to match with a poller until ctx timeout
 				return tm.offerOrTimeout(ctx, task)
 			}
+			if wait := tm.config.SyncMatchPollerWait(); wait > 0 && !task.isForwarded() {
+				// wait for a local poller to avoid writing the task to the backlog
+				return tm.offerOrWait(ctx, task, wait)
+			}
 		}
 
 		return false, nil
 	}
 }
 
+// offerOrWait blocks trying to match the task with a local poller until wait duration
+// or context timeout. Once the task is matched, it waits for the poller response
+// regardless of the wait duration.
+func (tm *TaskMatcher) offerOrWait(ctx context.Context, task *internalTask, wait time.Duration) (bool, error) {
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case tm.taskC <- task: // poller picked up the task
+		if task.responseC != nil {
+			err := <-task.responseC
+			return true, err
+		}
+		return false, nil
+	case <-timer.C:
+		return false, nil
+	case <-ctx.Done():
+		return false, nil
+	}
+}
+
 func (tm *TaskMatcher) offerOrTimeout(ctx context.Context, task *internalTask) (bool, error) {
 	select {
 	case tm.taskC <- task: // poller picked up the task
@@ -250,7 +292,6 @@ forLoop:
 			err := tm.fwdr.ForwardTask(childCtx, task)
 			token.release()
 			if err != nil {
-				tm.scope().IncCounter(metrics.ForwardTaskErrorsPerTaskQueue)
 				// forwarder returns error only when the call is rate limited. To
 				// avoid a busy loop on such rate limiting events, we only attempt to make
 				// the next forwarded call after this childCtx expires. Till then, we block
@@ -315,15 +356,56 @@ func (tm *TaskMatcher) UpdateRatelimit(rps *float64) {
 	tm.dynamicBurst.Store(burst)
 }
 
+// maxPartitionDispatchRate returns the share of a partition in the max dispatch rate of the task queue
+// set by the operator, the rate is divided equally across all partitions like the rate set by pollers
+func maxPartitionDispatchRate(config *taskQueueConfig) float64 {
+	rate := config.MaxTaskQueueDispatchRate()
+	if rate <= 0 {
+		return defaultTaskDispatchRPS
+	}
+	nPartitions := float64(config.NumReadPartitions())
+	if nPartitions > 1 {
+		rate = rate / nPartitions
+	}
+	return rate
+}
+
+func maxPartitionDispatchBurst(config *taskQueueConfig) int {
+	burst := int(maxPartitionDispatchRate(config))
+	if minTaskThrottlingBurstSize := config.MinTaskThrottlingBurstSize(); burst < minTaskThrottlingBurstSize {
+		burst = minTaskThrottlingBurstSize
+	}
+	return burst
+}
+
 // Rate returns the current rate at which tasks are dispatched
 func (tm *TaskMatcher) Rate() float64 {
 	return tm.rateLimiter.Rate()
 }
 
+// WaitForPoller blocks until a poller is waiting for a task or context is done
+func (tm *TaskMatcher) WaitForPoller(ctx context.Context) error {
+	for atomic.LoadInt32(&tm.numPollers) == 0 {
+		select {
+		case <-tm.pollerC:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
 func (tm *TaskMatcher) poll(ctx context.Context, queryOnly bool) (*internalTask, error) {
 	taskC, queryTaskC := tm.taskC, tm.queryTaskC
 	if queryOnly {
 		taskC = nil
+	} else {
+		atomic.AddInt32(&tm.numPollers, 1)
+		defer atomic.AddInt32(&tm.numPollers, -1)
+		select {
+		case tm.pollerC <- struct{}{}:
+		default:
+		}
 	}
 
 	// We want to effectively do a prioritized select, but Go select is random
@@ -77,7 +77,7 @@ func (t *MatcherTestSuite) SetupTest() {
 		ForwarderMaxChildrenPerNode:  func() int { return 20 },
 	}
 	t.cfg = tlCfg
-	t.fwdr = newForwarder(&t.cfg.forwarderConfig, t.taskQueue, enumspb.TASK_QUEUE_KIND_NORMAL, t.client)
+	t.fwdr = newForwarder(&t.cfg.forwarderConfig, t.taskQueue, enumspb.TASK_QUEUE_KIND_NORMAL, t.client, func() metrics.Scope { return metrics.NoopScope(metrics.Matching) })
 	t.matcher = newTaskMatcher(tlCfg, t.fwdr, func() metrics.Scope { return metrics.NoopScope(metrics.Matching) })
 
 	rootTaskQueue := newTestTaskQueueID(t.taskQueue.namespaceID, t.taskQueue.Parent(20), enumspb.TASK_QUEUE_TYPE_WORKFLOW)
@@ -117,6 +117,42 @@ func (t *MatcherTestSuite) TestLocalSyncMatch() {
 	t.True(syncMatch)
 }
 
+func (t *MatcherTestSuite) TestSyncMatchPollerWait() {
+	t.rootMatcher.config.SyncMatchPollerWait = func() time.Duration { return time.Second }
+
+	pollStarted := make(chan struct{})
+	go func() {
+		<-pollStarted
+		// poller arrives after the task is offered
+		time.Sleep(50 * time.Millisecond)
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		task, err := t.rootMatcher.Poll(ctx)
+		cancel()
+		if err == nil {
+			task.finish(nil)
+		}
+	}()
+
+	task := newInternalTask(randomTaskInfo(), nil, enumsspb.TASK_SOURCE_HISTORY, "", true)
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	close(pollStarted)
+	syncMatch, err := t.rootMatcher.Offer(ctx, task)
+	cancel()
+	t.NoError(err)
+	t.True(syncMatch)
+}
+
+func (t *MatcherTestSuite) TestSyncMatchPollerWait_Timeout() {
+	t.rootMatcher.config.SyncMatchPollerWait = func() time.Duration { return 10 * time.Millisecond }
+
+	task := newInternalTask(randomTaskInfo(), nil, enumsspb.TASK_SOURCE_HISTORY, "", true)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	syncMatch, err := t.rootMatcher.Offer(ctx, task)
+	cancel()
+	t.NoError(err)
+	t.False(syncMatch)
+}
+
 func (t *MatcherTestSuite) TestRemoteSyncMatch() {
 	t.testRemoteSyncMatch(enumsspb.TASK_SOURCE_HISTORY)
 }
@@ -474,6 +510,28 @@ func (t *MatcherTestSuite) TestRemotePollForQuery() {
 	t.True(task.isStarted())
 }
 
+func (t *MatcherTestSuite) TestMaxTaskQueueDispatchRate() {
+	// not limited by default
+	t.Equal(defaultTaskDispatchRPS, maxPartitionDispatchRate(t.cfg))
+
+	// the rate of the task queue is divided across its partitions
+	t.cfg.MaxTaskQueueDispatchRate = func() float64 { return 40 }
+	t.cfg.NumReadPartitions = func() int { return 4 }
+	t.Equal(float64(10), maxPartitionDispatchRate(t.cfg))
+	t.Equal(10, maxPartitionDispatchBurst(t.cfg))
+	matcher := newTaskMatcher(t.cfg, t.fwdr, func() metrics.Scope { return metrics.NoopScope(metrics.Matching) })
+	t.Equal(float64(10), matcher.Rate())
+
+	// the rate set by the operator applies even if pollers ask for more
+	pollerRate := float64(100)
+	matcher.UpdateRatelimit(&pollerRate)
+	t.Equal(float64(10), matcher.Rate())
+
+	t.cfg.MaxTaskQueueDispatchRate = func() float64 { return 2 }
+	t.Equal(0.5, maxPartitionDispatchRate(t.cfg))
+	t.Equal(1, maxPartitionDispatchBurst(t.cfg))
+}
+
 func (t *MatcherTestSuite) newNamespaceCache() cache.NamespaceCache {
 	entry := cache.NewLocalNamespaceCacheEntryForTest(
 		&persistencespb.NamespaceInfo{Name: "test-namespace"},
@@ -35,6 +35,7 @@ import (
 	"time"
 
 	"github.com/pborman/uuid"
+	"go.opentelemetry.io/otel/trace"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
@@ -43,6 +44,7 @@ import (
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	tokenspb "go.temporal.io/server/api/token/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
@@ -53,7 +55,9 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/quotas"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
+	"go.temporal.io/server/common/tracing"
 )
 
 // Implements matching.Engine
@@ -86,9 +90,17 @@ type (
 		lockableQueryTaskMap lockableQueryTaskMap
 		namespaceCache       cache.NamespaceCache
 		keyResolver          membership.ServiceResolver
+		// sticky task queues of workers which shut down, see evictStickyTaskQueue
+		evictedStickyQueues cache.Cache
+		// limits backlog dispatch of each isolation group, nil if dispatch is not limited
+		isolationGroupLimiter quotas.IsolationGroupLimiter
 	}
 )
 
+const (
+	evictedStickyQueuesCacheSize = 10000
+)
+
 var (
 	// EmptyPollWorkflowTaskQueueResponse is the response when there are no workflow tasks to hand out
 	emptyPollWorkflowTaskQueueResponse = &matchingservice.PollWorkflowTaskQueueResponse{}
@@ -131,9 +143,18 @@ func NewEngine(taskManager persistence.TaskManager,
 		lockableQueryTaskMap: lockableQueryTaskMap{queryTaskMap: make(map[string]chan *queryResult)},
 		namespaceCache:       namespaceCache,
 		keyResolver:          resolver,
+		evictedStickyQueues:  newEvictedStickyQueuesCache(config),
+		isolationGroupLimiter: quotas.NewIsolationGroupLimiter(
+			func(namespace string) string { return config.NamespaceIsolationGroup(namespace) },
+			func() map[string]interface{} { return config.IsolationGroupBudget() },
+		),
 	}
 }
 
+func newEvictedStickyQueuesCache(config *Config) cache.Cache {
+	return cache.New(evictedStickyQueuesCacheSize, &cache.Options{TTL: config.StickyQueueEvictionTTL()})
+}
+
 func (e *matchingEngineImpl) Start() {
 	if !atomic.CompareAndSwapInt32(
 		&e.status,
@@ -247,6 +268,15 @@ func (e *matchingEngineImpl) AddWorkflowTask(
 	if err != nil {
 		return false, err
 	}
+	if e.isStickyTaskQueueEvicted(taskQueue, taskQueueKind) {
+		// let history fall back to the normal task queue right away
+		hCtx.scope.IncCounter(metrics.StickyTaskQueueEvictedRejectCounter)
+		return false, serviceerrors.NewStickyWorkerUnavailable()
+	}
+	taskQueue, err = e.redirectToVersionSet(taskQueue, taskQueueKind, addRequest.GetWorkerBuildId(), lookupVersionSetForTask)
+	if err != nil {
+		return false, err
+	}
 
 	tlMgr, err := e.getTaskQueueManager(taskQueue, taskQueueKind)
 	if err != nil {
@@ -263,12 +293,13 @@ func (e *matchingEngineImpl) AddWorkflowTask(
 		expirationTime = timestamp.TimePtr(now.Add(expirationDuration))
 	}
 	taskInfo := &persistencespb.TaskInfo{
-		NamespaceId: namespaceID,
-		RunId:       addRequest.Execution.GetRunId(),
-		WorkflowId:  addRequest.Execution.GetWorkflowId(),
-		ScheduleId:  addRequest.GetScheduleId(),
-		ExpiryTime:  expirationTime,
-		CreateTime:  now,
+		NamespaceId:  namespaceID,
+		RunId:        addRequest.Execution.GetRunId(),
+		WorkflowId:   addRequest.Execution.GetWorkflowId(),
+		ScheduleId:   addRequest.GetScheduleId(),
+		ExpiryTime:   expirationTime,
+		CreateTime:   now,
+		TraceContext: tracing.InjectTraceContext(hCtx.Context),
 	}
 
 	return tlMgr.AddTask(hCtx.Context, addTaskParams{
@@ -356,6 +387,14 @@ pollLoop:
 			return nil, err
 		}
 		taskQueueKind := request.TaskQueue.GetKind()
+		if e.isStickyTaskQueueEvicted(taskQueue, taskQueueKind) {
+			// the worker is polling again, e.g. after its poll was cancelled for other reasons than shutdown
+			e.evictedStickyQueues.Delete(*taskQueue)
+		}
+		taskQueue, err = e.redirectToVersionSet(taskQueue, taskQueueKind, request.GetBinaryChecksum(), lookupVersionSetForPoll)
+		if err != nil {
+			return nil, err
+		}
 		task, err := e.getTask(pollerCtx, taskQueue, nil, taskQueueKind)
 		if err != nil {
 			// TODO: Is empty poll the best reply for errPumpClosed?
@@ -401,10 +440,18 @@ pollLoop:
 				StartedEventId:             common.EmptyEventID,
 				Attempt:                    1,
 			}
-			return e.createPollWorkflowTaskQueueResponse(task, resp, hCtx.scope), nil
+			return e.createPollWorkflowTaskQueueResponse(task, resp, nil, hCtx.scope), nil
 		}
 
-		resp, err := e.recordWorkflowTaskStarted(hCtx.Context, request, task)
+		// continue the trace of the request which added the workflow task
+		ctx, span := tracing.StartSpanFromTraceContext(
+			hCtx.Context,
+			task.event.Data.GetTraceContext(),
+			"DispatchWorkflowTask",
+			trace.WithSpanKind(trace.SpanKindConsumer),
+		)
+		resp, err := e.recordWorkflowTaskStarted(ctx, request, task)
+		tracing.EndSpan(span, err)
 		if err != nil {
 			switch err.(type) {
 			case *serviceerror.NotFound, *serviceerrors.TaskAlreadyStarted:
@@ -418,7 +465,7 @@ pollLoop:
 			continue pollLoop
 		}
 		task.finish(nil)
-		return e.createPollWorkflowTaskQueueResponse(task, resp, hCtx.scope), nil
+		return e.createPollWorkflowTaskQueueResponse(task, resp, tracing.InjectTraceContext(ctx), hCtx.scope), nil
 	}
 }
 
@@ -506,13 +553,33 @@ func (e *matchingEngineImpl) QueryWorkflow(
 	if err != nil {
 		return nil, err
 	}
+	if e.isStickyTaskQueueEvicted(taskQueue, taskQueueKind) {
+		hCtx.scope.IncCounter(metrics.StickyTaskQueueEvictedRejectCounter)
+		return nil, serviceerrors.NewStickyWorkerUnavailable()
+	}
+	taskQueue, err = e.redirectToVersionSet(taskQueue, taskQueueKind, queryRequest.GetWorkerBuildId(), lookupVersionSetForTask)
+	if err != nil {
+		return nil, err
+	}
 
 	tlMgr, err := e.getTaskQueueManager(taskQueue, taskQueueKind)
 	if err != nil {
 		return nil, err
 	}
 	taskID := uuid.New()
-	resp, err := tlMgr.DispatchQueryTask(hCtx.Context, taskID, queryRequest)
+	dispatchCtx := hCtx.Context
+	dispatchTimeout := timestamp.DurationValue(queryRequest.GetStickyDispatchTimeout())
+	if taskQueueKind == enumspb.TASK_QUEUE_KIND_STICKY && dispatchTimeout > 0 {
+		var cancel context.CancelFunc
+		dispatchCtx, cancel = context.WithTimeout(hCtx.Context, dispatchTimeout)
+		defer cancel()
+	}
+	resp, err := tlMgr.DispatchQueryTask(dispatchCtx, taskID, queryRequest)
+	if err != nil && dispatchCtx.Err() == context.DeadlineExceeded && hCtx.Err() == nil {
+		// sticky worker did not pick up the query task in time, let caller fall back to the normal task queue
+		hCtx.scope.IncCounter(metrics.StickyQueryDispatchTimeoutPerTaskQueueCounter)
+		return nil, serviceerrors.NewStickyWorkerUnavailable()
+	}
 
 	// if get response or error it means that query task was handled by forwarding to another matching host
 	// this remote host's result can be returned directly
@@ -586,9 +653,28 @@ func (e *matchingEngineImpl) CancelOutstandingPoll(
 	}
 
 	tlMgr.CancelPoller(pollerID)
+	// workers cancel their polls on shutdown, the sticky task queue of the worker won't be polled anymore
+	if taskQueueKind == enumspb.TASK_QUEUE_KIND_STICKY && taskQueueType == enumspb.TASK_QUEUE_TYPE_WORKFLOW {
+		namespace, err := e.namespaceCache.GetNamespaceName(namespaceID)
+		if err == nil && e.config.EvictStickyQueueOnPollCancel(namespace) {
+			e.evictStickyTaskQueue(hCtx.scope, taskQueue)
+		}
+	}
 	return nil
 }
 
+func (e *matchingEngineImpl) EvictStickyTaskQueue(
+	hCtx *handlerContext,
+	request *matchingservice.EvictStickyTaskQueueRequest,
+) (*matchingservice.EvictStickyTaskQueueResponse, error) {
+	taskQueue, err := newTaskQueueID(request.GetNamespaceId(), request.GetTaskQueue(), enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	if err != nil {
+		return nil, err
+	}
+	e.evictStickyTaskQueue(hCtx.scope, taskQueue)
+	return &matchingservice.EvictStickyTaskQueueResponse{}, nil
+}
+
 func (e *matchingEngineImpl) DescribeTaskQueue(
 	hCtx *handlerContext,
 	request *matchingservice.DescribeTaskQueueRequest,
@@ -628,6 +714,211 @@ func (e *matchingEngineImpl) ListTaskQueuePartitions(
 	return &resp, nil
 }
 
+// DescribeTaskQueuePartitions describes every read and write partition of a task queue. Partitions are described
+// by their owners, so the response reflects the in-memory state of each partition.
+func (e *matchingEngineImpl) DescribeTaskQueuePartitions(
+	hCtx *handlerContext,
+	request *matchingservice.DescribeTaskQueuePartitionsRequest,
+) (*matchingservice.DescribeTaskQueuePartitionsResponse, error) {
+	namespaceID := request.GetNamespaceId()
+	taskQueueType := request.GetTaskQueueType()
+	taskQueue, err := newTaskQueueID(namespaceID, request.GetTaskQueue(), taskQueueType)
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(err.Error())
+	}
+	if !taskQueue.IsRoot() {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("%v is a task queue partition, use the task queue name", taskQueue.name))
+	}
+	namespace, err := e.namespaceCache.GetNamespaceName(namespaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	numPartitions := common.MaxInt(
+		e.config.NumTaskqueueReadPartitions(namespace, taskQueue.name, taskQueueType),
+		e.config.NumTaskqueueWritePartitions(namespace, taskQueue.name, taskQueueType),
+	)
+	numPartitions = common.MaxInt(1, numPartitions)
+	degree := common.MaxInt(1, e.config.ForwarderMaxChildrenPerNode(namespace, taskQueue.name, taskQueueType))
+
+	partitions := make([]*taskqueuespb.TaskQueuePartitionStatus, 0, numPartitions)
+	for i := 0; i < numPartitions; i++ {
+		partition := taskQueue.mkName(i)
+		partitionID, err := newTaskQueueID(namespaceID, partition, taskQueueType)
+		if err != nil {
+			return nil, err
+		}
+		host, err := e.getHostInfo(partition)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := e.matchingClient.DescribeTaskQueue(hCtx, &matchingservice.DescribeTaskQueueRequest{
+			NamespaceId: namespaceID,
+			DescRequest: &workflowservice.DescribeTaskQueueRequest{
+				Namespace: namespace,
+				TaskQueue: &taskqueuepb.TaskQueue{
+					Name: partition,
+					Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+				},
+				TaskQueueType:          taskQueueType,
+				IncludeTaskQueueStatus: true,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		partitions = append(partitions, &taskqueuespb.TaskQueuePartitionStatus{
+			Partition:        partition,
+			OwnerHostName:    host,
+			Pollers:          resp.GetPollers(),
+			TaskQueueStatus:  resp.GetTaskQueueStatus(),
+			TaskReaderStatus: resp.GetTaskReaderStatus(),
+			BacklogStatus:    resp.GetBacklogStatus(),
+			ParentPartition:  partitionID.Parent(degree),
+		})
+	}
+	return &matchingservice.DescribeTaskQueuePartitionsResponse{Partitions: partitions}, nil
+}
+
+func (e *matchingEngineImpl) GetTaskQueueUserData(
+	hCtx *handlerContext,
+	request *matchingservice.GetTaskQueueUserDataRequest,
+) (*matchingservice.GetTaskQueueUserDataResponse, error) {
+	tlMgr, err := e.getUserDataTaskQueueManager(request.GetNamespaceId(), request.GetTaskQueue())
+	if err != nil {
+		return nil, err
+	}
+	userData := tlMgr.GetUserData()
+	if userData.GetVersion() <= request.GetLastKnownUserDataVersion() {
+		return &matchingservice.GetTaskQueueUserDataResponse{}, nil
+	}
+	return &matchingservice.GetTaskQueueUserDataResponse{UserData: userData}, nil
+}
+
+func (e *matchingEngineImpl) UpdateTaskQueueUserData(
+	hCtx *handlerContext,
+	request *matchingservice.UpdateTaskQueueUserDataRequest,
+) (*matchingservice.UpdateTaskQueueUserDataResponse, error) {
+	tlMgr, err := e.getUserDataTaskQueueManager(request.GetNamespaceId(), request.GetTaskQueue())
+	if err != nil {
+		return nil, err
+	}
+	userData, err := tlMgr.UpdateUserData(func(current *persistencespb.TaskQueueUserData) (*persistencespb.TaskQueueUserData, error) {
+		// versioning data is only updated through UpdateWorkerBuildIdCompatibility
+		return &persistencespb.TaskQueueUserData{
+			VersioningRules:   request.GetUserData().GetVersioningRules(),
+			MaxTasksPerSecond: request.GetUserData().GetMaxTasksPerSecond(),
+			Metadata:          request.GetUserData().GetMetadata(),
+			VersioningData:    current.GetVersioningData(),
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &matchingservice.UpdateTaskQueueUserDataResponse{UserData: userData}, nil
+}
+
+func (e *matchingEngineImpl) UpdateWorkerBuildIdCompatibility(
+	hCtx *handlerContext,
+	request *matchingservice.UpdateWorkerBuildIdCompatibilityRequest,
+) (*matchingservice.UpdateWorkerBuildIdCompatibilityResponse, error) {
+	tlMgr, err := e.getUserDataTaskQueueManager(request.GetNamespaceId(), request.GetTaskQueue())
+	if err != nil {
+		return nil, err
+	}
+	namespace, err := e.namespaceCache.GetNamespaceName(request.GetNamespaceId())
+	if err != nil {
+		return nil, err
+	}
+	userData, err := tlMgr.UpdateUserData(func(current *persistencespb.TaskQueueUserData) (*persistencespb.TaskQueueUserData, error) {
+		versioningData, err := updateVersioningData(
+			current.GetVersioningData(),
+			request.GetUpdate(),
+			e.config.VersionCompatibleSetLimit(namespace),
+			e.config.VersionBuildIdLimit(namespace),
+		)
+		if err != nil {
+			return nil, err
+		}
+		return &persistencespb.TaskQueueUserData{
+			VersioningRules:   current.GetVersioningRules(),
+			MaxTasksPerSecond: current.GetMaxTasksPerSecond(),
+			Metadata:          current.GetMetadata(),
+			VersioningData:    versioningData,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &matchingservice.UpdateWorkerBuildIdCompatibilityResponse{VersioningData: userData.GetVersioningData()}, nil
+}
+
+func (e *matchingEngineImpl) GetWorkerBuildIdCompatibility(
+	hCtx *handlerContext,
+	request *matchingservice.GetWorkerBuildIdCompatibilityRequest,
+) (*matchingservice.GetWorkerBuildIdCompatibilityResponse, error) {
+	tlMgr, err := e.getUserDataTaskQueueManager(request.GetNamespaceId(), request.GetTaskQueue())
+	if err != nil {
+		return nil, err
+	}
+	versioningData := limitVersionSets(tlMgr.GetUserData().GetVersioningData(), int(request.GetMaxSets()))
+	return &matchingservice.GetWorkerBuildIdCompatibilityResponse{VersioningData: versioningData}, nil
+}
+
+// getUserDataTaskQueueManager returns the manager of the root partition of the workflow task queue,
+// which owns the user data of all partitions of the task queue
+func (e *matchingEngineImpl) getUserDataTaskQueueManager(namespaceID string, taskQueueName string) (taskQueueManager, error) {
+	name, err := newTaskQueueName(taskQueueName)
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(err.Error())
+	}
+	taskQueue, err := newTaskQueueID(namespaceID, name.GetRoot(), enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	if err != nil {
+		return nil, err
+	}
+	return e.getTaskQueueManager(taskQueue, enumspb.TASK_QUEUE_KIND_NORMAL)
+}
+
+// evictStickyTaskQueue makes the sticky task queue of a worker which shuts down reject workflow tasks and queries
+// until it is polled again, so they fall back to the normal task queue instead of waiting for the sticky schedule
+// to start timeout. The task queue is unloaded to return its outstanding polls
+func (e *matchingEngineImpl) evictStickyTaskQueue(scope metrics.Scope, taskQueue *taskQueueID) {
+	e.evictedStickyQueues.Put(*taskQueue, struct{}{})
+	e.unloadTaskQueue(taskQueue)
+	scope.IncCounter(metrics.StickyTaskQueueEvictedCounter)
+}
+
+func (e *matchingEngineImpl) isStickyTaskQueueEvicted(taskQueue *taskQueueID, taskQueueKind enumspb.TaskQueueKind) bool {
+	return taskQueueKind == enumspb.TASK_QUEUE_KIND_STICKY && e.evictedStickyQueues.Get(*taskQueue) != nil
+}
+
+// redirectToVersionSet returns the versioned task queue which serves workflow tasks of the given worker build id,
+// according to the versioning data of the task queue. Sticky task queues and task queues which are already
+// versioned, e.g. when the request was forwarded by a child partition, are never redirected
+func (e *matchingEngineImpl) redirectToVersionSet(
+	taskQueue *taskQueueID,
+	taskQueueKind enumspb.TaskQueueKind,
+	buildID string,
+	lookupVersionSet func(data *persistencespb.VersioningData, buildID string) string,
+) (*taskQueueID, error) {
+	if taskQueueKind == enumspb.TASK_QUEUE_KIND_STICKY || taskQueue.versionSet != "" {
+		return taskQueue, nil
+	}
+	tlMgr, err := e.getTaskQueueManager(taskQueue, taskQueueKind)
+	if err != nil {
+		return nil, err
+	}
+	versionSet := lookupVersionSet(tlMgr.GetUserData().GetVersioningData(), buildID)
+	if versionSet == "" {
+		return taskQueue, nil
+	}
+	return &taskQueueID{
+		qualifiedTaskQueueName: taskQueue.WithVersionSet(versionSet),
+		namespaceID:            taskQueue.namespaceID,
+		taskType:               taskQueue.taskType,
+	}, nil
+}
+
 func (e *matchingEngineImpl) listTaskQueuePartitions(request *matchingservice.ListTaskQueuePartitionsRequest, taskQueueType enumspb.TaskQueueType) ([]*taskqueuepb.TaskQueuePartitionMetadata, error) {
 	partitions, err := e.getAllPartitions(
 		request.GetNamespace(),
@@ -721,6 +1012,7 @@ func (e *matchingEngineImpl) unloadTaskQueue(id *taskQueueID) {
 func (e *matchingEngineImpl) createPollWorkflowTaskQueueResponse(
 	task *internalTask,
 	historyResponse *historyservice.RecordWorkflowTaskStartedResponse,
+	traceContext map[string]string,
 	scope metrics.Scope,
 ) *matchingservice.PollWorkflowTaskQueueResponse {
 
@@ -741,6 +1033,7 @@ func (e *matchingEngineImpl) createPollWorkflowTaskQueueResponse(
 			RunId:           task.event.Data.GetRunId(),
 			ScheduleId:      historyResponse.GetScheduledEventId(),
 			ScheduleAttempt: historyResponse.GetAttempt(),
+			TraceContext:    traceContext,
 		}
 		serializedToken, _ = e.tokenSerializer.Serialize(taskToken)
 		if task.responseC == nil {
@@ -41,5 +41,11 @@ type (
 		CancelOutstandingPoll(hCtx *handlerContext, request *matchingservice.CancelOutstandingPollRequest) error
 		DescribeTaskQueue(hCtx *handlerContext, request *matchingservice.DescribeTaskQueueRequest) (*matchingservice.DescribeTaskQueueResponse, error)
 		ListTaskQueuePartitions(hCtx *handlerContext, request *matchingservice.ListTaskQueuePartitionsRequest) (*matchingservice.ListTaskQueuePartitionsResponse, error)
+		DescribeTaskQueuePartitions(hCtx *handlerContext, request *matchingservice.DescribeTaskQueuePartitionsRequest) (*matchingservice.DescribeTaskQueuePartitionsResponse, error)
+		GetTaskQueueUserData(hCtx *handlerContext, request *matchingservice.GetTaskQueueUserDataRequest) (*matchingservice.GetTaskQueueUserDataResponse, error)
+		UpdateTaskQueueUserData(hCtx *handlerContext, request *matchingservice.UpdateTaskQueueUserDataRequest) (*matchingservice.UpdateTaskQueueUserDataResponse, error)
+		UpdateWorkerBuildIdCompatibility(hCtx *handlerContext, request *matchingservice.UpdateWorkerBuildIdCompatibilityRequest) (*matchingservice.UpdateWorkerBuildIdCompatibilityResponse, error)
+		GetWorkerBuildIdCompatibility(hCtx *handlerContext, request *matchingservice.GetWorkerBuildIdCompatibilityRequest) (*matchingservice.GetWorkerBuildIdCompatibilityResponse, error)
+		EvictStickyTaskQueue(hCtx *handlerContext, request *matchingservice.EvictStickyTaskQueueRequest) (*matchingservice.EvictStickyTaskQueueResponse, error)
 	}
 )
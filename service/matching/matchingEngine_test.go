@@ -50,6 +50,7 @@ import (
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
+	"go.temporal.io/server/api/matchingservicemock/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	tokenspb "go.temporal.io/server/api/token/v1"
 	"go.temporal.io/server/common"
@@ -57,6 +58,7 @@ import (
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
@@ -71,6 +73,7 @@ type (
 		suite.Suite
 		controller         *gomock.Controller
 		mockHistoryClient  *historyservicemock.MockHistoryServiceClient
+		mockMatchingClient *matchingservicemock.MockMatchingServiceClient
 		mockNamespaceCache *cache.MockNamespaceCache
 
 		matchingEngine *matchingEngineImpl
@@ -103,6 +106,9 @@ func (s *matchingEngineSuite) SetupTest() {
 	defer s.Unlock()
 	s.controller = gomock.NewController(s.T())
 	s.mockHistoryClient = historyservicemock.NewMockHistoryServiceClient(s.controller)
+	s.mockMatchingClient = matchingservicemock.NewMockMatchingServiceClient(s.controller)
+	s.mockMatchingClient.EXPECT().GetTaskQueueUserData(gomock.Any(), gomock.Any()).
+		Return(&matchingservice.GetTaskQueueUserDataResponse{}, nil).AnyTimes()
 	s.taskManager = newTestTaskManager(s.logger)
 	s.mockNamespaceCache = cache.NewMockNamespaceCache(s.controller)
 	s.mockNamespaceCache.EXPECT().GetNamespaceByID(gomock.Any()).Return(cache.CreateNamespaceCacheEntry(matchingTestNamespace), nil).AnyTimes()
@@ -127,22 +133,25 @@ func (s *matchingEngineSuite) TearDownTest() {
 func (s *matchingEngineSuite) newMatchingEngine(
 	config *Config, taskMgr persistence.TaskManager,
 ) *matchingEngineImpl {
-	return newMatchingEngine(config, taskMgr, s.mockHistoryClient, s.logger, s.mockNamespaceCache)
+	return newMatchingEngine(config, taskMgr, s.mockHistoryClient, s.mockMatchingClient, s.logger, s.mockNamespaceCache)
 }
 
 func newMatchingEngine(
 	config *Config, taskMgr persistence.TaskManager, mockHistoryClient historyservice.HistoryServiceClient,
-	logger log.Logger, mockNamespaceCache cache.NamespaceCache,
+	mockMatchingClient matchingservice.MatchingServiceClient, logger log.Logger, mockNamespaceCache cache.NamespaceCache,
 ) *matchingEngineImpl {
 	return &matchingEngineImpl{
 		taskManager:     taskMgr,
 		historyService:  mockHistoryClient,
+		matchingClient:  mockMatchingClient,
 		taskQueues:      make(map[taskQueueID]taskQueueManager),
 		logger:          logger,
 		metricsClient:   metrics.NewClient(tally.NoopScope, metrics.Matching),
 		tokenSerializer: common.NewProtoTaskTokenSerializer(),
 		config:          config,
 		namespaceCache:  mockNamespaceCache,
+
+		evictedStickyQueues: newEvictedStickyQueuesCache(config),
 	}
 }
 
@@ -1442,6 +1451,287 @@ func (s *matchingEngineSuite) TestAddTaskAfterStartFailure() {
 	s.EqualValues(0, s.taskManager.getTaskCount(tlID))
 }
 
+func (s *matchingEngineSuite) TestTaskQueueUserData() {
+	namespaceID := uuid.NewRandom().String()
+	tl := "makeToast"
+
+	resp, err := s.matchingEngine.GetTaskQueueUserData(s.handlerContext, &matchingservice.GetTaskQueueUserDataRequest{
+		NamespaceId: namespaceID,
+		TaskQueue:   tl,
+	})
+	s.NoError(err)
+	s.Nil(resp.UserData)
+
+	updateResp, err := s.matchingEngine.UpdateTaskQueueUserData(s.handlerContext, &matchingservice.UpdateTaskQueueUserDataRequest{
+		NamespaceId: namespaceID,
+		TaskQueue:   tl,
+		UserData: &persistencespb.TaskQueueUserData{
+			Version:         100,
+			VersioningRules: []*persistencespb.TaskQueueVersioningRule{{BuildId: "v1", Percentage: 100}},
+		},
+	})
+	s.NoError(err)
+	s.EqualValues(1, updateResp.UserData.Version)
+	s.Equal("v1", updateResp.UserData.VersioningRules[0].BuildId)
+
+	// partitions and the activity task queue resolve to the root partition of the workflow task queue
+	resp, err = s.matchingEngine.GetTaskQueueUserData(s.handlerContext, &matchingservice.GetTaskQueueUserDataRequest{
+		NamespaceId: namespaceID,
+		TaskQueue:   taskQueuePartitionPrefix + tl + "/1",
+	})
+	s.NoError(err)
+	s.Equal(updateResp.UserData, resp.UserData)
+
+	resp, err = s.matchingEngine.GetTaskQueueUserData(s.handlerContext, &matchingservice.GetTaskQueueUserDataRequest{
+		NamespaceId:              namespaceID,
+		TaskQueue:                tl,
+		LastKnownUserDataVersion: 1,
+	})
+	s.NoError(err)
+	s.Nil(resp.UserData)
+
+	// user data survives reloading the task queue
+	rootID := newTestTaskQueueID(namespaceID, tl, enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	s.matchingEngine.unloadTaskQueue(rootID)
+	updateResp, err = s.matchingEngine.UpdateTaskQueueUserData(s.handlerContext, &matchingservice.UpdateTaskQueueUserDataRequest{
+		NamespaceId: namespaceID,
+		TaskQueue:   tl,
+		UserData:    &persistencespb.TaskQueueUserData{MaxTasksPerSecond: 10},
+	})
+	s.NoError(err)
+	s.EqualValues(2, updateResp.UserData.Version)
+	s.Empty(updateResp.UserData.VersioningRules)
+}
+
+func (s *matchingEngineSuite) TestWorkerBuildIdCompatibility() {
+	s.mockNamespaceCache.EXPECT().GetNamespaceName(gomock.Any()).Return(matchingTestNamespace, nil).AnyTimes()
+	namespaceID := uuid.NewRandom().String()
+	tl := "makeToast"
+
+	for _, buildID := range []string{"1.0", "2.0"} {
+		_, err := s.matchingEngine.UpdateWorkerBuildIdCompatibility(s.handlerContext, &matchingservice.UpdateWorkerBuildIdCompatibilityRequest{
+			NamespaceId: namespaceID,
+			TaskQueue:   tl,
+			Update:      mkNewDefaultSetUpdate(buildID),
+		})
+		s.NoError(err)
+	}
+	updateResp, err := s.matchingEngine.UpdateWorkerBuildIdCompatibility(s.handlerContext, &matchingservice.UpdateWorkerBuildIdCompatibilityRequest{
+		NamespaceId: namespaceID,
+		TaskQueue:   tl,
+		Update:      mkNewCompatibleUpdate("1.1", "1.0", false),
+	})
+	s.NoError(err)
+	s.Equal(mkVersioningData([]string{"1.0", "1.1"}, []string{"2.0"}), updateResp.VersioningData)
+
+	getResp, err := s.matchingEngine.GetWorkerBuildIdCompatibility(s.handlerContext, &matchingservice.GetWorkerBuildIdCompatibilityRequest{
+		NamespaceId: namespaceID,
+		TaskQueue:   tl,
+		MaxSets:     1,
+	})
+	s.NoError(err)
+	s.Equal(mkVersioningData([]string{"2.0"}), getResp.VersioningData)
+
+	// updates of the other user data keep the versioning data
+	userDataResp, err := s.matchingEngine.UpdateTaskQueueUserData(s.handlerContext, &matchingservice.UpdateTaskQueueUserDataRequest{
+		NamespaceId: namespaceID,
+		TaskQueue:   tl,
+		UserData:    &persistencespb.TaskQueueUserData{MaxTasksPerSecond: 10},
+	})
+	s.NoError(err)
+	s.Equal(updateResp.VersioningData, userDataResp.UserData.VersioningData)
+
+	// new workflows go to the default set, unknown build ids to the unversioned task queue
+	for _, buildID := range []string{"", "1.1", "0.9"} {
+		_, err := s.matchingEngine.AddWorkflowTask(s.handlerContext, &matchingservice.AddWorkflowTaskRequest{
+			NamespaceId:            namespaceID,
+			Execution:              &commonpb.WorkflowExecution{RunId: uuid.NewRandom().String(), WorkflowId: "workflow1"},
+			ScheduleId:             2,
+			TaskQueue:              &taskqueuepb.TaskQueue{Name: tl},
+			ScheduleToStartTimeout: timestamp.DurationFromSeconds(100),
+			WorkerBuildId:          buildID,
+		})
+		s.NoError(err)
+	}
+	rootID := newTestTaskQueueID(namespaceID, tl, enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	set1ID := &taskQueueID{
+		qualifiedTaskQueueName: rootID.WithVersionSet(versionSetID(updateResp.VersioningData.VersionSets[0])),
+		namespaceID:            namespaceID,
+		taskType:               enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+	}
+	set2ID := &taskQueueID{
+		qualifiedTaskQueueName: rootID.WithVersionSet(versionSetID(updateResp.VersioningData.VersionSets[1])),
+		namespaceID:            namespaceID,
+		taskType:               enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+	}
+	s.EqualValues(1, s.taskManager.getTaskCount(rootID))
+	s.EqualValues(1, s.taskManager.getTaskCount(set1ID))
+	s.EqualValues(1, s.taskManager.getTaskCount(set2ID))
+
+	// pollers are routed by their build id, versioned and sticky task queues are never redirected
+	redirected, err := s.matchingEngine.redirectToVersionSet(rootID, enumspb.TASK_QUEUE_KIND_NORMAL, "2.0", lookupVersionSetForPoll)
+	s.NoError(err)
+	s.Equal(set2ID, redirected)
+	redirected, err = s.matchingEngine.redirectToVersionSet(rootID, enumspb.TASK_QUEUE_KIND_NORMAL, "", lookupVersionSetForPoll)
+	s.NoError(err)
+	s.Equal(rootID, redirected)
+	redirected, err = s.matchingEngine.redirectToVersionSet(set1ID, enumspb.TASK_QUEUE_KIND_NORMAL, "2.0", lookupVersionSetForPoll)
+	s.NoError(err)
+	s.Equal(set1ID, redirected)
+	redirected, err = s.matchingEngine.redirectToVersionSet(rootID, enumspb.TASK_QUEUE_KIND_STICKY, "2.0", lookupVersionSetForPoll)
+	s.NoError(err)
+	s.Equal(rootID, redirected)
+}
+
+func (s *matchingEngineSuite) TestQueryWorkflow_StickyDispatchTimeout() {
+	namespaceID := uuid.NewRandom().String()
+	stickyTaskQueue := &taskqueuepb.TaskQueue{Name: "makeToastSticky", Kind: enumspb.TASK_QUEUE_KIND_STICKY}
+
+	// nobody polls the sticky task queue, dispatch gives up after the sticky dispatch timeout
+	resp, err := s.matchingEngine.QueryWorkflow(s.handlerContext, &matchingservice.QueryWorkflowRequest{
+		NamespaceId:           namespaceID,
+		TaskQueue:             stickyTaskQueue,
+		QueryRequest:          &workflowservice.QueryWorkflowRequest{},
+		StickyDispatchTimeout: timestamp.DurationPtr(50 * time.Millisecond),
+	})
+	s.Nil(resp)
+	s.IsType(&serviceerrors.StickyWorkerUnavailable{}, err)
+
+	// without a dispatch timeout the caller context applies
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	hCtx := newHandlerContext(
+		ctx,
+		matchingTestNamespace,
+		stickyTaskQueue,
+		metrics.NewClient(tally.NoopScope, metrics.Matching),
+		metrics.MatchingQueryWorkflowScope,
+		log.NewNoopLogger(),
+	)
+	_, err = s.matchingEngine.QueryWorkflow(hCtx, &matchingservice.QueryWorkflowRequest{
+		NamespaceId:  namespaceID,
+		TaskQueue:    stickyTaskQueue,
+		QueryRequest: &workflowservice.QueryWorkflowRequest{},
+	})
+	s.Equal(context.DeadlineExceeded, err)
+}
+
+func (s *matchingEngineSuite) TestEvictStickyTaskQueue() {
+	namespaceID := uuid.NewRandom().String()
+	stickyTaskQueue := &taskqueuepb.TaskQueue{Name: "makeToastSticky", Kind: enumspb.TASK_QUEUE_KIND_STICKY}
+	execution := &commonpb.WorkflowExecution{RunId: uuid.NewRandom().String(), WorkflowId: "workflow1"}
+
+	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskQueueInfo(10 * time.Millisecond)
+	s.mockNamespaceCache.EXPECT().GetNamespaceName(namespaceID).Return(matchingTestNamespace, nil).AnyTimes()
+
+	_, err := s.matchingEngine.EvictStickyTaskQueue(s.handlerContext, &matchingservice.EvictStickyTaskQueueRequest{
+		NamespaceId: namespaceID,
+		TaskQueue:   stickyTaskQueue.GetName(),
+	})
+	s.NoError(err)
+
+	// dispatch to an evicted sticky task queue is rejected right away
+	_, err = s.matchingEngine.AddWorkflowTask(s.handlerContext, &matchingservice.AddWorkflowTaskRequest{
+		NamespaceId:            namespaceID,
+		Execution:              execution,
+		ScheduleId:             1,
+		TaskQueue:              stickyTaskQueue,
+		ScheduleToStartTimeout: timestamp.DurationFromSeconds(1),
+	})
+	s.IsType(&serviceerrors.StickyWorkerUnavailable{}, err)
+	_, err = s.matchingEngine.QueryWorkflow(s.handlerContext, &matchingservice.QueryWorkflowRequest{
+		NamespaceId:  namespaceID,
+		TaskQueue:    stickyTaskQueue,
+		QueryRequest: &workflowservice.QueryWorkflowRequest{},
+	})
+	s.IsType(&serviceerrors.StickyWorkerUnavailable{}, err)
+
+	// a poll on the sticky task queue brings it back
+	resp, err := s.matchingEngine.PollWorkflowTaskQueue(s.handlerContext, &matchingservice.PollWorkflowTaskQueueRequest{
+		NamespaceId: namespaceID,
+		PollRequest: &workflowservice.PollWorkflowTaskQueueRequest{
+			TaskQueue: stickyTaskQueue,
+			Identity:  "selfDrivingToaster",
+		},
+	})
+	s.NoError(err)
+	s.Equal(emptyPollWorkflowTaskQueueResponse, resp)
+	taskQueue := newTestTaskQueueID(namespaceID, stickyTaskQueue.GetName(), enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	s.False(s.matchingEngine.isStickyTaskQueueEvicted(taskQueue, enumspb.TASK_QUEUE_KIND_STICKY))
+
+	// canceling the outstanding poll of a shutting down worker evicts the sticky task queue
+	err = s.matchingEngine.CancelOutstandingPoll(s.handlerContext, &matchingservice.CancelOutstandingPollRequest{
+		NamespaceId:   namespaceID,
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+		TaskQueue:     stickyTaskQueue,
+		PollerId:      "pollerID",
+	})
+	s.NoError(err)
+	s.True(s.matchingEngine.isStickyTaskQueueEvicted(taskQueue, enumspb.TASK_QUEUE_KIND_STICKY))
+
+	s.matchingEngine.config.EvictStickyQueueOnPollCancel = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false)
+	s.matchingEngine.evictedStickyQueues.Delete(*taskQueue)
+	err = s.matchingEngine.CancelOutstandingPoll(s.handlerContext, &matchingservice.CancelOutstandingPollRequest{
+		NamespaceId:   namespaceID,
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+		TaskQueue:     stickyTaskQueue,
+		PollerId:      "pollerID",
+	})
+	s.NoError(err)
+	s.False(s.matchingEngine.isStickyTaskQueueEvicted(taskQueue, enumspb.TASK_QUEUE_KIND_STICKY))
+}
+
+func (s *matchingEngineSuite) TestDescribeTaskQueuePartitions() {
+	namespaceID := uuid.NewRandom().String()
+	taskQueueName := "makeToast"
+	taskQueueType := enumspb.TASK_QUEUE_TYPE_ACTIVITY
+
+	s.matchingEngine.config.NumTaskqueueReadPartitions = dynamicconfig.GetIntPropertyFilteredByTaskQueueInfo(3)
+	s.matchingEngine.config.NumTaskqueueWritePartitions = dynamicconfig.GetIntPropertyFilteredByTaskQueueInfo(2)
+	s.matchingEngine.config.ForwarderMaxChildrenPerNode = dynamicconfig.GetIntPropertyFilteredByTaskQueueInfo(1)
+	s.mockNamespaceCache.EXPECT().GetNamespaceName(namespaceID).Return(matchingTestNamespace, nil)
+	mockResolver := membership.NewMockServiceResolver(s.controller)
+	mockResolver.EXPECT().Lookup(gomock.Any()).Return(membership.NewHostInfo("matching-host:7235", nil), nil).Times(3)
+	s.matchingEngine.keyResolver = mockResolver
+	s.mockMatchingClient.EXPECT().DescribeTaskQueue(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *matchingservice.DescribeTaskQueueRequest, _ ...interface{}) (*matchingservice.DescribeTaskQueueResponse, error) {
+			s.Equal(namespaceID, request.GetNamespaceId())
+			s.Equal(taskQueueType, request.GetDescRequest().GetTaskQueueType())
+			s.True(request.GetDescRequest().GetIncludeTaskQueueStatus())
+			return s.matchingEngine.DescribeTaskQueue(s.handlerContext, request)
+		}).Times(3)
+
+	resp, err := s.matchingEngine.DescribeTaskQueuePartitions(s.handlerContext, &matchingservice.DescribeTaskQueuePartitionsRequest{
+		NamespaceId:   namespaceID,
+		TaskQueue:     taskQueueName,
+		TaskQueueType: taskQueueType,
+	})
+	s.NoError(err)
+	s.Len(resp.GetPartitions(), 3)
+	for i, partition := range resp.GetPartitions() {
+		if i == 0 {
+			s.Equal(taskQueueName, partition.GetPartition())
+			s.Empty(partition.GetParentPartition())
+		} else {
+			s.Equal(fmt.Sprintf("%v%v/%v", taskQueuePartitionPrefix, taskQueueName, i), partition.GetPartition())
+			// with one child per node the partitions form a chain
+			s.Equal(resp.GetPartitions()[i-1].GetPartition(), partition.GetParentPartition())
+		}
+		s.Equal("matching-host:7235", partition.GetOwnerHostName())
+		s.NotNil(partition.GetTaskQueueStatus())
+		s.NotNil(partition.GetTaskReaderStatus())
+		s.Zero(partition.GetTaskReaderStatus().GetBufferedTaskCount())
+		s.NotNil(partition.GetBacklogStatus())
+	}
+
+	_, err = s.matchingEngine.DescribeTaskQueuePartitions(s.handlerContext, &matchingservice.DescribeTaskQueuePartitionsRequest{
+		NamespaceId:   namespaceID,
+		TaskQueue:     resp.GetPartitions()[1].GetPartition(),
+		TaskQueueType: taskQueueType,
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
 func (s *matchingEngineSuite) TestTaskQueueManagerGetTaskBatch() {
 	runID := uuid.NewRandom().String()
 	workflowID := "workflow1"
@@ -1748,6 +2038,7 @@ type testTaskQueueManager struct {
 	sync.Mutex
 	rangeID         int64
 	ackLevel        int64
+	userData        *persistencespb.TaskQueueUserData
 	createTaskCount int
 	tasks           *treemap.Map
 }
@@ -1793,6 +2084,7 @@ func (m *testTaskManager) LeaseTaskQueue(request *persistence.LeaseTaskQueueRequ
 				Name:        request.TaskQueue,
 				TaskType:    request.TaskType,
 				Kind:        request.TaskQueueKind,
+				UserData:    tlm.userData,
 			},
 			RangeID: tlm.rangeID,
 		},
@@ -1813,6 +2105,7 @@ func (m *testTaskManager) UpdateTaskQueue(request *persistence.UpdateTaskQueueRe
 		}
 	}
 	tlm.ackLevel = tli.AckLevel
+	tlm.userData = tli.UserData
 	return &persistence.UpdateTaskQueueResponse{}, nil
 }
 
@@ -0,0 +1,96 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"sync"
+	"time"
+
+	"go.temporal.io/server/common/clock"
+)
+
+type (
+	// rateCounter approximates the per second rate of events over a sliding window
+	// made of one second buckets.
+	rateCounter struct {
+		timeSource clock.TimeSource
+
+		sync.Mutex
+		buckets    []int64
+		lastSecond int64 // unix second of the most recent bucket
+	}
+)
+
+func newRateCounter(
+	timeSource clock.TimeSource,
+	window time.Duration,
+) *rateCounter {
+	size := int(window / time.Second)
+	if size < 1 {
+		size = 1
+	}
+	return &rateCounter{
+		timeSource: timeSource,
+		buckets:    make([]int64, size),
+		lastSecond: timeSource.Now().Unix(),
+	}
+}
+
+func (r *rateCounter) record(count int64) {
+	r.Lock()
+	defer r.Unlock()
+	second := r.advance()
+	r.buckets[second%int64(len(r.buckets))] += count
+}
+
+func (r *rateCounter) rate() float64 {
+	r.Lock()
+	defer r.Unlock()
+	r.advance()
+	var sum int64
+	for _, count := range r.buckets {
+		sum += count
+	}
+	return float64(sum) / float64(len(r.buckets))
+}
+
+// advance clears the buckets of the seconds elapsed since the last event and returns the current second.
+func (r *rateCounter) advance() int64 {
+	second := r.timeSource.Now().Unix()
+	elapsed := second - r.lastSecond
+	if elapsed >= int64(len(r.buckets)) {
+		for i := range r.buckets {
+			r.buckets[i] = 0
+		}
+	} else {
+		for s := r.lastSecond + 1; s <= second; s++ {
+			r.buckets[s%int64(len(r.buckets))] = 0
+		}
+	}
+	if second > r.lastSecond {
+		r.lastSecond = second
+	}
+	return r.lastSecond
+}
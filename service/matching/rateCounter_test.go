@@ -0,0 +1,81 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/clock"
+)
+
+type (
+	rateCounterSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		timeSource *clock.EventTimeSource
+	}
+)
+
+func TestRateCounterSuite(t *testing.T) {
+	s := new(rateCounterSuite)
+	suite.Run(t, s)
+}
+
+func (s *rateCounterSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.timeSource = clock.NewEventTimeSource()
+	s.timeSource.Update(time.Unix(1000, 0))
+}
+
+func (s *rateCounterSuite) TestRate() {
+	counter := newRateCounter(s.timeSource, 10*time.Second)
+	s.Zero(counter.rate())
+
+	counter.record(10)
+	s.timeSource.Update(time.Unix(1001, 0))
+	counter.record(20)
+	s.Equal(3.0, counter.rate())
+
+	// the first bucket leaves the window
+	s.timeSource.Update(time.Unix(1010, 0))
+	s.Equal(2.0, counter.rate())
+
+	s.timeSource.Update(time.Unix(1011, 0))
+	s.Zero(counter.rate())
+}
+
+func (s *rateCounterSuite) TestRate_WindowElapsed() {
+	counter := newRateCounter(s.timeSource, 10*time.Second)
+	counter.record(100)
+	s.timeSource.Update(time.Unix(1100, 0))
+	s.Zero(counter.rate())
+	counter.record(50)
+	s.Equal(5.0, counter.rate())
+}
@@ -39,10 +39,12 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
+	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/rpc"
 	"go.temporal.io/server/common/rpc/interceptor"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/tracing"
 	"go.temporal.io/server/service/matching/configs"
 )
 
@@ -88,9 +90,13 @@ func NewService(
 		metrics.MatchingAPIMetricsScopes(),
 		logger,
 	)
+	serviceRPSFn := func() float64 { return float64(serviceConfig.RPS()) }
 	rateLimiterInterceptor := interceptor.NewRateLimitInterceptor(
-		configs.NewPriorityRateLimiter(func() float64 { return float64(serviceConfig.RPS()) }),
+		serviceResource.GetNamespaceCache(),
+		configs.NewPriorityRateLimiter(serviceRPSFn),
+		func(quotas.Request) float64 { return serviceRPSFn() },
 		map[string]int{},
+		serviceResource.GetThrottleTracker(),
 	)
 
 	grpcServerOptions, err := params.RPCFactory.GetInternodeGRPCServerOptions()
@@ -101,6 +107,7 @@ func NewService(
 	grpcServerOptions = append(
 		grpcServerOptions,
 		grpc.ChainUnaryInterceptor(
+			tracing.NewServerTracingInterceptor(),
 			rpc.ServiceErrorInterceptor,
 			metrics.NewServerMetricsContextInjectorInterceptor(),
 			metrics.NewServerMetricsTrailerPropagatorInterceptor(logger),
@@ -43,6 +43,7 @@ import (
 	"go.temporal.io/server/common/clock"
 
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/cache"
@@ -50,6 +51,7 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
 )
 
 const (
@@ -59,6 +61,9 @@ const (
 
 	// Fake Task ID to wrap a task for syncmatch
 	syncMatchTaskId = -137
+
+	// Window over which task add and dispatch rates are computed
+	taskQueueRateWindow = time.Minute
 )
 
 type (
@@ -97,6 +102,14 @@ type (
 		GetAllPollerInfo() []*taskqueuepb.PollerInfo
 		// DescribeTaskQueue returns information about the target task queue
 		DescribeTaskQueue(includeTaskQueueStatus bool) *matchingservice.DescribeTaskQueueResponse
+		// GetUserData returns the user data of the task queue, nil if the task queue has no user data.
+		// The user data is owned by the root partition of the workflow task queue, all other
+		// partitions periodically fetch it from there
+		GetUserData() *persistencespb.TaskQueueUserData
+		// UpdateUserData replaces the user data of the task queue with the result of the update function, which is
+		// called with the current user data, and increments its version. Concurrent updates are serialized.
+		// It is only supported by the root partition of the workflow task queue
+		UpdateUserData(updateFn func(*persistencespb.TaskQueueUserData) (*persistencespb.TaskQueueUserData, error)) (*persistencespb.TaskQueueUserData, error)
 		String() string
 	}
 
@@ -112,6 +125,8 @@ type (
 		taskWriter       *taskWriter
 		taskReader       *taskReader // reads tasks from db and async matches it with poller
 		liveness         *liveness
+		addRate          *rateCounter // rate of tasks added by history, excluding forwarded tasks
+		dispatchRate     *rateCounter // rate of tasks dispatched to pollers, excluding queries
 		taskGC           *taskGC
 		taskAckManager   ackManager   // tracks ackLevel for delivered messages
 		matcher          *TaskMatcher // for matching a task producer with a poller
@@ -129,6 +144,10 @@ type (
 		// prevent tasks being dispatched to zombie pollers.
 		outstandingPollsLock sync.Mutex
 		outstandingPollsMap  map[string]context.CancelFunc
+		// userDataLock serializes updates of the user data owned by this task queue
+		userDataLock sync.Mutex
+		// userData is the user data fetched from the root partition, if this task queue doesn't own it
+		userData atomic.Value
 
 		shutdownCh chan struct{} // Delivers stop to the pump that populates taskBuffer
 	}
@@ -140,6 +159,8 @@ var _ taskQueueManager = (*taskQueueManagerImpl)(nil)
 
 var errRemoteSyncMatchFailed = serviceerror.NewCanceled("remote sync match failed")
 
+var errUserDataNotOwned = serviceerror.NewInvalidArgument("task queue user data can only be updated in the root partition of the workflow task queue")
+
 func withIDBlockAllocator(ibl idBlockAllocator) taskQueueManagerOpt {
 	return func(tqm *taskQueueManagerImpl) {
 		tqm.idAlloc = ibl
@@ -183,6 +204,7 @@ func newTaskQueueManager(
 	}
 
 	tlMgr.namespaceValue.Store("")
+	tlMgr.userData.Store((*persistencespb.TaskQueueUserData)(nil))
 	if tlMgr.metricScope() == nil { // namespace name lookup failed
 		// metric scope to use when namespace lookup fails
 		tlMgr.metricScopeValue.Store(newPerTaskQueueScope(
@@ -204,12 +226,14 @@ func newTaskQueueManager(
 		tlMgr.taskAckManager.setAckLevel(state.ackLevel)
 	}
 	tlMgr.liveness = newLiveness(clock.NewRealTimeSource(), taskQueueConfig.IdleTaskqueueCheckInterval(), tlMgr.Stop)
+	tlMgr.addRate = newRateCounter(clock.NewRealTimeSource(), taskQueueRateWindow)
+	tlMgr.dispatchRate = newRateCounter(clock.NewRealTimeSource(), taskQueueRateWindow)
 	tlMgr.taskWriter = newTaskWriter(tlMgr, idblock)
 	tlMgr.taskReader = newTaskReader(tlMgr)
 
 	var fwdr *Forwarder
 	if tlMgr.isFowardingAllowed(taskQueue, taskQueueKind) {
-		fwdr = newForwarder(&taskQueueConfig.forwarderConfig, taskQueue, taskQueueKind, e.matchingClient)
+		fwdr = newForwarder(&taskQueueConfig.forwarderConfig, taskQueue, taskQueueKind, e.matchingClient, tlMgr.metricScope)
 	}
 	tlMgr.matcher = newTaskMatcher(taskQueueConfig, fwdr, tlMgr.metricScope)
 	return tlMgr, nil
@@ -237,6 +261,9 @@ func (c *taskQueueManagerImpl) Start() {
 	c.liveness.Start()
 	c.taskWriter.Start()
 	c.taskReader.Start()
+	if !c.ownsUserData() && c.taskQueueKind != enumspb.TASK_QUEUE_KIND_STICKY && c.taskQueueID.versionSet == "" {
+		go c.fetchUserDataPump()
+	}
 }
 
 // Stop pump that fills up taskBuffer from persistence.
@@ -285,11 +312,13 @@ func (c *taskQueueManagerImpl) AddTask(
 		if namespaceEntry.GetNamespaceNotActiveErr() != nil {
 			r, err := c.taskWriter.appendTask(params.execution, td)
 			syncMatch = false
+			c.metricScope().IncCounter(metrics.BacklogWritePerTaskQueueCounter)
 			return r, err
 		}
 
 		syncMatch, err = c.trySyncMatch(ctx, params)
 		if syncMatch {
+			c.metricScope().IncCounter(metrics.SyncMatchPerTaskQueueCounter)
 			return &persistence.CreateTasksResponse{}, err
 		}
 
@@ -299,9 +328,13 @@ func (c *taskQueueManagerImpl) AddTask(
 			return &persistence.CreateTasksResponse{}, errRemoteSyncMatchFailed
 		}
 
+		c.metricScope().IncCounter(metrics.BacklogWritePerTaskQueueCounter)
 		return c.taskWriter.appendTask(params.execution, params.taskInfo)
 	})
 	if err == nil {
+		if params.forwardedFrom == "" {
+			c.addRate.record(1)
+		}
 		c.taskReader.Signal()
 	}
 	return syncMatch, err
@@ -364,6 +397,9 @@ func (c *taskQueueManagerImpl) GetTask(
 		return nil, err
 	}
 
+	if !task.isQuery() && !task.isStarted() {
+		c.dispatchRate.record(1)
+	}
 	task.namespace = c.namespace()
 	task.backlogCountHint = c.taskAckManager.getBacklogCountHint()
 	return task, nil
@@ -406,8 +442,8 @@ func (c *taskQueueManagerImpl) CancelPoller(pollerID string) {
 }
 
 // DescribeTaskQueue returns information about the target taskqueue, right now this API returns the
-// pollers which polled this taskqueue in last few minutes and status of taskqueue's ackManager
-// (readLevel, ackLevel, backlogCountHint and taskIDBlock).
+// pollers which polled this taskqueue in last few minutes, status of taskqueue's ackManager
+// (readLevel, ackLevel, backlogCountHint and taskIDBlock) and status of its taskReader.
 func (c *taskQueueManagerImpl) DescribeTaskQueue(includeTaskQueueStatus bool) *matchingservice.DescribeTaskQueueResponse {
 	response := &matchingservice.DescribeTaskQueueResponse{Pollers: c.GetAllPollerInfo()}
 	if !includeTaskQueueStatus {
@@ -425,10 +461,55 @@ func (c *taskQueueManagerImpl) DescribeTaskQueue(includeTaskQueueStatus bool) *m
 			EndId:   taskIDBlock.end,
 		},
 	}
+	response.TaskReaderStatus = c.taskReader.describe()
+	response.BacklogStatus = &taskqueuespb.TaskQueueBacklogStatus{
+		BacklogAge:       timestamp.DurationPtr(c.taskReader.backlogAge()),
+		TaskAddRate:      c.addRate.rate(),
+		TaskDispatchRate: c.dispatchRate.rate(),
+	}
 
 	return response
 }
 
+func (c *taskQueueManagerImpl) GetUserData() *persistencespb.TaskQueueUserData {
+	if c.ownsUserData() {
+		return c.db.UserData()
+	}
+	return c.userData.Load().(*persistencespb.TaskQueueUserData)
+}
+
+func (c *taskQueueManagerImpl) UpdateUserData(
+	updateFn func(*persistencespb.TaskQueueUserData) (*persistencespb.TaskQueueUserData, error),
+) (*persistencespb.TaskQueueUserData, error) {
+	if !c.ownsUserData() {
+		return nil, errUserDataNotOwned
+	}
+
+	c.userDataLock.Lock()
+	defer c.userDataLock.Unlock()
+
+	currentUserData := c.db.UserData()
+	userData, err := updateFn(currentUserData)
+	if err != nil {
+		return nil, err
+	}
+	newUserData := &persistencespb.TaskQueueUserData{
+		Version:           currentUserData.GetVersion() + 1,
+		LastUpdateTime:    timestamp.TimeNowPtrUtc(),
+		VersioningRules:   userData.GetVersioningRules(),
+		MaxTasksPerSecond: userData.GetMaxTasksPerSecond(),
+		Metadata:          userData.GetMetadata(),
+		VersioningData:    userData.GetVersioningData(),
+	}
+	_, err = c.executeWithRetry(func() (interface{}, error) {
+		return nil, c.db.UpdateUserData(newUserData)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newUserData, nil
+}
+
 func (c *taskQueueManagerImpl) String() string {
 	buf := new(bytes.Buffer)
 	if c.taskQueueID.taskType == enumspb.TASK_QUEUE_TYPE_ACTIVITY {
@@ -590,6 +671,47 @@ func (c *taskQueueManagerImpl) newChildContext(
 	return context.WithTimeout(parent, timeout)
 }
 
+// ownsUserData returns true if this task queue persists the user data of all partitions of the task queue
+func (c *taskQueueManagerImpl) ownsUserData() bool {
+	return c.taskQueueID.IsRoot() &&
+		c.taskQueueID.versionSet == "" &&
+		c.taskQueueID.taskType == enumspb.TASK_QUEUE_TYPE_WORKFLOW &&
+		c.taskQueueKind != enumspb.TASK_QUEUE_KIND_STICKY
+}
+
+// fetchUserDataPump periodically fetches the user data from the root partition of the workflow task queue
+func (c *taskQueueManagerImpl) fetchUserDataPump() {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-c.shutdownCh:
+			return
+		case <-timer.C:
+			if err := c.fetchUserData(); err != nil {
+				c.logger.Warn("Failed to fetch task queue user data from root partition", tag.Error(err))
+			}
+			timer.Reset(backoff.JitDuration(c.config.UserDataRefreshInterval(), 0.1))
+		}
+	}
+}
+
+func (c *taskQueueManagerImpl) fetchUserData() error {
+	resp, err := c.engine.matchingClient.GetTaskQueueUserData(context.Background(), &matchingservice.GetTaskQueueUserDataRequest{
+		NamespaceId:              c.taskQueueID.namespaceID,
+		TaskQueue:                c.taskQueueID.GetRoot(),
+		LastKnownUserDataVersion: c.GetUserData().GetVersion(),
+	})
+	if err != nil {
+		return err
+	}
+	if resp.GetUserData() != nil {
+		c.userData.Store(resp.GetUserData())
+	}
+	return nil
+}
+
 func (c *taskQueueManagerImpl) isFowardingAllowed(taskQueue *taskQueueID, kind enumspb.TaskQueueKind) bool {
 	return !taskQueue.IsRoot() && kind != enumspb.TASK_QUEUE_KIND_STICKY
 }
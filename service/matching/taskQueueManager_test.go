@@ -40,6 +40,8 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	"go.temporal.io/server/api/matchingservicemock/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cache"
@@ -47,6 +49,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/quotas"
 )
 
 var rpsInf = math.Inf(1)
@@ -98,6 +101,78 @@ func TestDeliverBufferTasks_NoPollers(t *testing.T) {
 	wg.Wait()
 }
 
+func TestDeliverBufferTasks_IsolationGroupBudget(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := mustCreateTestTaskQueueManager(t, controller)
+	limiter := quotas.NewIsolationGroupLimiter(
+		func(namespace string) string { return "batch" },
+		func() map[string]interface{} { return map[string]interface{}{"batch": 1} },
+	)
+	tlm.engine.isolationGroupLimiter = limiter
+	// another task queue of the group is dispatching
+	release, ok := limiter.TryAcquire("batch")
+	require.True(t, ok)
+
+	tlm.taskReader.taskBuffer <- &persistencespb.AllocatedTaskInfo{}
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		tlm.taskReader.dispatchBufferedTasks()
+		wg.Done()
+	}()
+	time.Sleep(100 * time.Millisecond)
+	require.Equal(t, 1, limiter.InFlight("batch"))
+
+	// the task is dispatched once the other task queue releases its slot
+	release()
+	require.Eventually(t, func() bool { return limiter.InFlight("batch") == 1 }, time.Second, 10*time.Millisecond)
+
+	tlm.taskReader.cancelFunc()
+	wg.Wait()
+	require.Equal(t, 0, limiter.InFlight("batch"))
+}
+
+func TestDeliverBufferTasks_IsolationGroupReleasedWithoutPollers(t *testing.T) {
+	defer func(wait time.Duration) { taskReaderIsolationGroupDispatchWait = wait }(taskReaderIsolationGroupDispatchWait)
+	taskReaderIsolationGroupDispatchWait = 50 * time.Millisecond
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := mustCreateTestTaskQueueManager(t, controller)
+	limiter := quotas.NewIsolationGroupLimiter(
+		func(namespace string) string { return "batch" },
+		func() map[string]interface{} { return map[string]interface{}{"batch": 1} },
+	)
+	tlm.engine.isolationGroupLimiter = limiter
+
+	tlm.taskReader.taskBuffer <- &persistencespb.AllocatedTaskInfo{}
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		tlm.taskReader.dispatchBufferedTasks()
+		wg.Done()
+	}()
+
+	// the task queue has no pollers, so it doesn't hold the slot of the group
+	time.Sleep(200 * time.Millisecond)
+	require.Equal(t, 0, limiter.InFlight("batch"))
+
+	// the task is dispatched once a poller arrives
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	task, err := tlm.matcher.Poll(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, task)
+
+	close(tlm.taskReader.shutdownChan)
+	tlm.taskReader.cancelFunc()
+	wg.Wait()
+	require.Equal(t, 0, limiter.InFlight("batch"))
+}
+
 func TestReadLevelForAllExpiredTasksInBatch(t *testing.T) {
 	controller := gomock.NewController(t)
 	defer controller.Finish()
@@ -301,8 +376,11 @@ func createTestTaskQueueManagerWithConfig(
 	tm := newTestTaskManager(logger)
 	mockNamespaceCache := cache.NewMockNamespaceCache(controller)
 	mockNamespaceCache.EXPECT().GetNamespaceByID(gomock.Any()).Return(cache.CreateNamespaceCacheEntry("namespace"), nil).AnyTimes()
+	mockMatchingClient := matchingservicemock.NewMockMatchingServiceClient(controller)
+	mockMatchingClient.EXPECT().GetTaskQueueUserData(gomock.Any(), gomock.Any()).
+		Return(&matchingservice.GetTaskQueueUserDataResponse{}, nil).AnyTimes()
 	me := newMatchingEngine(
-		cfg, tm, nil, logger, mockNamespaceCache,
+		cfg, tm, nil, mockMatchingClient, logger, mockNamespaceCache,
 	)
 	tl := "tq"
 	dID := "deadbeef-0000-4567-890a-bcdef0123456"
@@ -349,6 +427,7 @@ func TestDescribeTaskQueue(t *testing.T) {
 	descResp := tlm.DescribeTaskQueue(includeTaskStatus)
 	require.Equal(t, 0, len(descResp.GetPollers()))
 	require.Nil(t, descResp.GetTaskQueueStatus())
+	require.Nil(t, descResp.GetBacklogStatus())
 
 	includeTaskStatus = true
 	taskQueueStatus := tlm.DescribeTaskQueue(includeTaskStatus).GetTaskQueueStatus()
@@ -382,6 +461,21 @@ func TestDescribeTaskQueue(t *testing.T) {
 	require.NotNil(t, taskQueueStatus)
 	require.Equal(t, taskCount, taskQueueStatus.GetAckLevel())
 	require.Zero(t, taskQueueStatus.GetBacklogCountHint())
+
+	backlogStatus := descResp.GetBacklogStatus()
+	require.NotNil(t, backlogStatus)
+	require.Zero(t, timestamp.DurationValue(backlogStatus.GetBacklogAge()))
+	require.Zero(t, backlogStatus.GetTaskAddRate())
+	require.Zero(t, backlogStatus.GetTaskDispatchRate())
+
+	// Simulate a backlog task waiting for a poller and some traffic
+	atomic.StoreInt64(&tlm.taskReader.backlogHeadCreateTime, time.Now().UTC().Add(-time.Minute).UnixNano())
+	tlm.addRate.record(120)
+	tlm.dispatchRate.record(60)
+	backlogStatus = tlm.DescribeTaskQueue(includeTaskStatus).GetBacklogStatus()
+	require.True(t, timestamp.DurationValue(backlogStatus.GetBacklogAge()) >= time.Minute)
+	require.Equal(t, 2.0, backlogStatus.GetTaskAddRate())
+	require.Equal(t, 1.0, backlogStatus.GetTaskDispatchRate())
 }
 
 func tlMgrStartWithoutNotifyEvent(tlm *taskQueueManagerImpl) {
@@ -425,3 +519,32 @@ func TestCheckIdleTaskQueue(t *testing.T) {
 	tlm.Stop()
 	require.Equal(t, common.DaemonStatusStopped, atomic.LoadInt32(&tlm.status))
 }
+
+func TestFetchUserDataFromRootPartition(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	userData := &persistencespb.TaskQueueUserData{Version: 3, Metadata: map[string]string{"team": "payments"}}
+	tqm := mustCreateTestTaskQueueManager(t, controller)
+	mockMatchingClient := matchingservicemock.NewMockMatchingServiceClient(controller)
+	mockMatchingClient.EXPECT().GetTaskQueueUserData(gomock.Any(), &matchingservice.GetTaskQueueUserDataRequest{
+		NamespaceId: tqm.taskQueueID.namespaceID,
+		TaskQueue:   tqm.taskQueueID.GetRoot(),
+	}).Return(&matchingservice.GetTaskQueueUserDataResponse{UserData: userData}, nil)
+	mockMatchingClient.EXPECT().GetTaskQueueUserData(gomock.Any(), &matchingservice.GetTaskQueueUserDataRequest{
+		NamespaceId:              tqm.taskQueueID.namespaceID,
+		TaskQueue:                tqm.taskQueueID.GetRoot(),
+		LastKnownUserDataVersion: 3,
+	}).Return(&matchingservice.GetTaskQueueUserDataResponse{}, nil)
+	tqm.engine.matchingClient = mockMatchingClient
+
+	require.NoError(t, tqm.fetchUserData())
+	require.Equal(t, userData, tqm.GetUserData())
+	require.NoError(t, tqm.fetchUserData())
+	require.Equal(t, userData, tqm.GetUserData())
+
+	_, err := tqm.UpdateUserData(func(*persistencespb.TaskQueueUserData) (*persistencespb.TaskQueueUserData, error) {
+		return &persistencespb.TaskQueueUserData{}, nil
+	})
+	require.Equal(t, errUserDataNotOwned, err)
+}
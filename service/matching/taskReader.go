@@ -31,11 +31,13 @@ import (
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/service/worker/scanner/taskqueue"
 )
 
@@ -43,6 +45,15 @@ const (
 	taskReaderOfferThrottleWait = time.Second
 )
 
+var (
+	// taskReaderIsolationGroupDispatchWait is how long a backlog task holds a slot of its isolation group
+	// waiting to be handed off to a poller
+	taskReaderIsolationGroupDispatchWait = time.Second
+	// taskReaderIsolationGroupPollerWait is how long to wait for a local poller before trying to dispatch
+	// the task again, which can also forward it to the parent partition
+	taskReaderIsolationGroupPollerWait = 5 * time.Second
+)
+
 type (
 	taskReader struct {
 		status     int32
@@ -57,6 +68,9 @@ type (
 		cancelCtx    context.Context
 		cancelFunc   context.CancelFunc
 		shutdownChan chan struct{}
+		lastReadTime int64 // unix nanos of the last read from persistence, accessed atomically
+		// unix nanos of the create time of the backlog task being dispatched, 0 if none, accessed atomically
+		backlogHeadCreateTime int64
 	}
 )
 
@@ -119,20 +133,12 @@ dispatchLoop:
 			if !ok { // Task queue getTasks pump is shutdown
 				break dispatchLoop
 			}
+			if createTime := taskInfo.Data.GetCreateTime(); createTime != nil {
+				atomic.StoreInt64(&tr.backlogHeadCreateTime, createTime.UnixNano())
+			}
 			task := newInternalTask(taskInfo, tr.tlMgr.completeTask, enumsspb.TASK_SOURCE_DB_BACKLOG, "", false)
-			for {
-				err := tr.tlMgr.DispatchTask(tr.cancelCtx, task)
-				if err == nil {
-					break
-				}
-				if err == context.Canceled {
-					tr.tlMgr.logger.Info("Taskqueue manager context is cancelled, shutting down")
-					break dispatchLoop
-				}
-				// this should never happen unless there is a bug - don't drop the task
-				tr.scope().IncCounter(metrics.BufferThrottlePerTaskQueueCounter)
-				tr.logger().Error("taskReader: unexpected error dispatching task", tag.Error(err))
-				time.Sleep(taskReaderOfferThrottleWait)
+			if !tr.dispatchTask(task) {
+				break dispatchLoop
 			}
 
 		case <-tr.shutdownChan:
@@ -141,6 +147,72 @@ dispatchLoop:
 	}
 }
 
+// dispatchTask dispatches a backlog task to a poller, it returns false if the task queue manager is shutting down.
+// The task is handed off while holding a slot of the isolation group of the namespace. If no poller picks up
+// the task in time, the slot is released until a poller arrives, so that a task queue without pollers
+// doesn't starve other task queues of the group.
+func (tr *taskReader) dispatchTask(task *internalTask) bool {
+	if tr.tlMgr.engine.isolationGroupLimiter == nil {
+		return tr.mustDispatchTask(tr.cancelCtx, task)
+	}
+
+	for {
+		release, err := tr.acquireIsolationGroup()
+		if err != nil {
+			tr.tlMgr.logger.Info("Taskqueue manager context is cancelled, shutting down")
+			return false
+		}
+		// the dispatch context must not have a deadline, see TaskMatcher.MustOffer
+		ctx, cancel := context.WithCancel(tr.cancelCtx)
+		timer := time.AfterFunc(taskReaderIsolationGroupDispatchWait, cancel)
+		dispatched := tr.mustDispatchTask(ctx, task)
+		timer.Stop()
+		cancel()
+		release()
+		if dispatched {
+			return true
+		}
+		if tr.cancelCtx.Err() != nil {
+			return false
+		}
+
+		waitCtx, waitCancel := context.WithTimeout(tr.cancelCtx, taskReaderIsolationGroupPollerWait)
+		_ = tr.tlMgr.matcher.WaitForPoller(waitCtx)
+		waitCancel()
+	}
+}
+
+// mustDispatchTask retries dispatching the task until it is dispatched or the context is cancelled
+func (tr *taskReader) mustDispatchTask(ctx context.Context, task *internalTask) bool {
+	for {
+		err := tr.tlMgr.DispatchTask(ctx, task)
+		if err == nil {
+			atomic.StoreInt64(&tr.backlogHeadCreateTime, 0)
+			return true
+		}
+		if ctx.Err() != nil {
+			if tr.cancelCtx.Err() != nil {
+				tr.tlMgr.logger.Info("Taskqueue manager context is cancelled, shutting down")
+			}
+			return false
+		}
+		// this should never happen unless there is a bug - don't drop the task
+		tr.scope().IncCounter(metrics.BufferThrottlePerTaskQueueCounter)
+		tr.logger().Error("taskReader: unexpected error dispatching task", tag.Error(err))
+		time.Sleep(taskReaderOfferThrottleWait)
+	}
+}
+
+func (tr *taskReader) acquireIsolationGroup() (func(), error) {
+	limiter := tr.tlMgr.engine.isolationGroupLimiter
+	group := limiter.Group(tr.tlMgr.namespace())
+	if release, ok := limiter.TryAcquire(group); ok {
+		return release, nil
+	}
+	tr.scope().IncCounter(metrics.IsolationGroupThrottlePerTaskQueueCounter)
+	return limiter.Acquire(tr.cancelCtx, group)
+}
+
 func (tr *taskReader) getTasksPump() {
 	updateAckTimer := time.NewTimer(tr.tlMgr.config.UpdateAckInterval())
 	defer updateAckTimer.Stop()
@@ -158,6 +230,7 @@ Loop:
 				// TODO: Should we ever stop retrying on db errors?
 				continue Loop
 			}
+			atomic.StoreInt64(&tr.lastReadTime, time.Now().UTC().UnixNano())
 
 			if len(tasks) == 0 {
 				tr.tlMgr.taskAckManager.setReadLevel(readLevel)
@@ -186,6 +259,7 @@ Loop:
 				}
 				// keep going as saving ack is not critical
 			}
+			tr.emitBacklogMetrics()
 			tr.Signal() // periodically signal pump to check persistence for tasks
 			updateAckTimer = time.NewTimer(tr.tlMgr.config.UpdateAckInterval())
 		}
@@ -267,6 +341,37 @@ func (tr *taskReader) isTaskAddedRecently(lastAddTime time.Time) bool {
 	return time.Now().UTC().Sub(lastAddTime) <= tr.tlMgr.config.MaxTaskqueueIdleTime()
 }
 
+// describe returns the number of buffered backlog tasks and the time of the last read from persistence.
+func (tr *taskReader) describe() *taskqueuespb.TaskReaderStatus {
+	status := &taskqueuespb.TaskReaderStatus{
+		BufferedTaskCount: int64(len(tr.taskBuffer)),
+	}
+	if lastReadTime := atomic.LoadInt64(&tr.lastReadTime); lastReadTime != 0 {
+		status.LastReadTime = timestamp.TimePtr(time.Unix(0, lastReadTime).UTC())
+	}
+	return status
+}
+
+// backlogAge returns the time since the oldest backlog task still waiting for a poller was created.
+func (tr *taskReader) backlogAge() time.Duration {
+	createTime := atomic.LoadInt64(&tr.backlogHeadCreateTime)
+	if createTime == 0 {
+		return 0
+	}
+	age := time.Now().UTC().Sub(time.Unix(0, createTime))
+	if age < 0 {
+		return 0
+	}
+	return age
+}
+
+func (tr *taskReader) emitBacklogMetrics() {
+	scope := tr.scope()
+	scope.UpdateGauge(metrics.TaskBacklogAgePerTaskQueueGauge, tr.backlogAge().Seconds())
+	scope.UpdateGauge(metrics.TaskAddRatePerTaskQueueGauge, tr.tlMgr.addRate.rate())
+	scope.UpdateGauge(metrics.TaskDispatchRatePerTaskQueueGauge, tr.tlMgr.dispatchRate.rate())
+}
+
 func (tr *taskReader) logger() log.Logger {
 	return tr.tlMgr.logger
 }
@@ -42,15 +42,18 @@ type (
 	}
 	// qualifiedTaskQueueName refers to the fully qualified task queue name
 	qualifiedTaskQueueName struct {
-		name      string // internal name of the tasks list
-		baseName  string // original name of the task queue as specified by user
-		partition int    // partitionID of task queue
+		name       string // internal name of the tasks list
+		baseName   string // original name of the task queue as specified by user
+		partition  int    // partitionID of task queue
+		versionSet string // ID of the compatible worker build id set, empty for unversioned task queues
 	}
 )
 
 const (
 	// taskQueuePartitionPrefix is the required naming prefix for any task queue partition other than partition 0
 	taskQueuePartitionPrefix = "/_sys/"
+	// taskQueueVersionSetDelimiter separates the version set ID from the partition ID in versioned task queue names
+	taskQueueVersionSetDelimiter = ":"
 )
 
 // newTaskQueueName returns a fully qualified task queue name.
@@ -63,7 +66,13 @@ const (
 //     /_sys/[original-name]/[partitionID]
 //
 // The name of the root partition is always the same as the user specified name. Rest of
-// the partitions follow the naming convention above. In addition, the task queues partitions
+// the partitions follow the naming convention above. Workflow tasks routed to a set of
+// compatible worker build ids are stored in versioned task queues, all partitions of which
+// (including the root) have an internal name of the form
+//
+//     /_sys/[original-name]/[versionSetID]:[partitionID]
+//
+// The versioned task queues form their own partition tree. In addition, the task queues partitions
 // logically form a N-ary tree where N is configurable dynamically. The tree formation is an
 // optimization to allow for partitioned task queues to dispatch tasks with low latency when
 // throughput is low - See https://go.temporal.io/server/issues/2098
@@ -104,12 +113,23 @@ func (tn *qualifiedTaskQueueName) Parent(degree int) string {
 }
 
 func (tn *qualifiedTaskQueueName) mkName(partition int) string {
+	if tn.versionSet != "" {
+		return fmt.Sprintf("%v%v/%v%v%v", taskQueuePartitionPrefix, tn.baseName, tn.versionSet, taskQueueVersionSetDelimiter, partition)
+	}
 	if partition == 0 {
 		return tn.baseName
 	}
 	return fmt.Sprintf("%v%v/%v", taskQueuePartitionPrefix, tn.baseName, partition)
 }
 
+// WithVersionSet returns the name of the same partition of the task queue for the given version set
+func (tn *qualifiedTaskQueueName) WithVersionSet(versionSet string) qualifiedTaskQueueName {
+	versioned := *tn
+	versioned.versionSet = versionSet
+	versioned.name = versioned.mkName(versioned.partition)
+	return versioned
+}
+
 func (tn *qualifiedTaskQueueName) init() error {
 	if !strings.HasPrefix(tn.name, taskQueuePartitionPrefix) {
 		return nil
@@ -120,8 +140,20 @@ func (tn *qualifiedTaskQueueName) init() error {
 		return fmt.Errorf("invalid partitioned task queue name %v", tn.name)
 	}
 
-	p, err := strconv.Atoi(tn.name[suffixOff+1:])
-	if err != nil || p <= 0 {
+	suffix := tn.name[suffixOff+1:]
+	minPartition := 1
+	if delimOff := strings.LastIndex(suffix, taskQueueVersionSetDelimiter); delimOff >= 0 {
+		tn.versionSet = suffix[:delimOff]
+		if tn.versionSet == "" {
+			return fmt.Errorf("invalid versioned task queue name %v", tn.name)
+		}
+		suffix = suffix[delimOff+1:]
+		// root partition of a versioned task queue is also prefixed
+		minPartition = 0
+	}
+
+	p, err := strconv.Atoi(suffix)
+	if err != nil || p < minPartition {
 		return fmt.Errorf("invalid partitioned task queue name %v", tn.name)
 	}
 
@@ -102,6 +102,43 @@ func TestTaskQueueParentName(t *testing.T) {
 	}
 }
 
+func TestVersionedTaskQueueNames(t *testing.T) {
+	testCases := []struct {
+		input      string
+		baseName   string
+		versionSet string
+		partition  int
+	}{
+		{"/_sys/list0/abc:0", "list0", "abc", 0},
+		{"/_sys/list0/abc:3", "list0", "abc", 3},
+		{"/_sys//list0//abc:41", "/list0/", "abc", 41},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			tn, err := newTaskQueueName(tc.input)
+			require.NoError(t, err)
+			require.Equal(t, tc.partition, tn.partition)
+			require.Equal(t, tc.partition == 0, tn.IsRoot())
+			require.Equal(t, tc.baseName, tn.GetRoot())
+			require.Equal(t, tc.versionSet, tn.versionSet)
+			require.Equal(t, tc.input, tn.name)
+		})
+	}
+
+	tn, err := newTaskQueueName("/_sys/list0/5")
+	require.NoError(t, err)
+	versioned := tn.WithVersionSet("abc")
+	require.Equal(t, "/_sys/list0/abc:5", versioned.name)
+	require.Equal(t, "/_sys/list0/abc:1", versioned.Parent(3))
+	require.Equal(t, "/_sys/list0/abc:0", versioned.Parent(5))
+
+	tn, err = newTaskQueueName("list0")
+	require.NoError(t, err)
+	require.Equal(t, "/_sys/list0/abc:0", tn.WithVersionSet("abc").name)
+	require.Equal(t, "list0", tn.name)
+}
+
 func TestInvalidTaskqueueNames(t *testing.T) {
 	inputs := []string{
 		"/_sys/",
@@ -111,6 +148,9 @@ func TestInvalidTaskqueueNames(t *testing.T) {
 		"/_sys/list0",
 		"/_sys/list0/0",
 		"/_sys/list0/-1",
+		"/_sys/list0/:1",
+		"/_sys/list0/abc:",
+		"/_sys/list0/abc:-1",
 	}
 	for _, name := range inputs {
 		t.Run(name, func(t *testing.T) {
@@ -0,0 +1,202 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"fmt"
+
+	"github.com/dgryski/go-farm"
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+)
+
+const (
+	maxBuildIDLength = 255
+)
+
+var (
+	errBuildIDCompatibilityUpdateNotSet = serviceerror.NewInvalidArgument("Build id compatibility update operation is not set.")
+	errBuildIDNotSet                    = serviceerror.NewInvalidArgument("Build id is not set.")
+	errBuildIDTooLong                   = serviceerror.NewInvalidArgument(fmt.Sprintf("Build id exceeds length limit of %v.", maxBuildIDLength))
+)
+
+// updateVersioningData returns a copy of the versioning data with the update applied
+func updateVersioningData(
+	data *persistencespb.VersioningData,
+	update *taskqueuespb.BuildIdCompatibilityUpdate,
+	maxSets int,
+	maxBuildIDs int,
+) (*persistencespb.VersioningData, error) {
+	sets := make([]*persistencespb.CompatibleVersionSet, 0, len(data.GetVersionSets())+1)
+	for _, set := range data.GetVersionSets() {
+		sets = append(sets, &persistencespb.CompatibleVersionSet{
+			BuildIds: append([]string(nil), set.GetBuildIds()...),
+		})
+	}
+
+	switch op := update.GetOperation().(type) {
+	case *taskqueuespb.BuildIdCompatibilityUpdate_AddNewBuildIdInNewDefaultSet:
+		if err := validateNewBuildID(sets, op.AddNewBuildIdInNewDefaultSet); err != nil {
+			return nil, err
+		}
+		sets = append(sets, &persistencespb.CompatibleVersionSet{BuildIds: []string{op.AddNewBuildIdInNewDefaultSet}})
+
+	case *taskqueuespb.BuildIdCompatibilityUpdate_AddNewCompatibleBuildId_:
+		addOp := op.AddNewCompatibleBuildId
+		if err := validateNewBuildID(sets, addOp.GetNewBuildId()); err != nil {
+			return nil, err
+		}
+		setIdx, err := findExistingVersionSet(sets, addOp.GetExistingCompatibleBuildId())
+		if err != nil {
+			return nil, err
+		}
+		sets[setIdx].BuildIds = append(sets[setIdx].BuildIds, addOp.GetNewBuildId())
+		if addOp.GetMakeSetDefault() {
+			sets = moveVersionSetToEnd(sets, setIdx)
+		}
+
+	case *taskqueuespb.BuildIdCompatibilityUpdate_PromoteSetByBuildId:
+		setIdx, err := findExistingVersionSet(sets, op.PromoteSetByBuildId)
+		if err != nil {
+			return nil, err
+		}
+		sets = moveVersionSetToEnd(sets, setIdx)
+
+	case *taskqueuespb.BuildIdCompatibilityUpdate_PromoteBuildIdWithinSet:
+		setIdx, err := findExistingVersionSet(sets, op.PromoteBuildIdWithinSet)
+		if err != nil {
+			return nil, err
+		}
+		buildIDs := make([]string, 0, len(sets[setIdx].BuildIds))
+		for _, buildID := range sets[setIdx].BuildIds {
+			if buildID != op.PromoteBuildIdWithinSet {
+				buildIDs = append(buildIDs, buildID)
+			}
+		}
+		sets[setIdx].BuildIds = append(buildIDs, op.PromoteBuildIdWithinSet)
+
+	default:
+		return nil, errBuildIDCompatibilityUpdateNotSet
+	}
+
+	if len(sets) > maxSets {
+		return nil, serviceerror.NewInvalidArgument(
+			fmt.Sprintf("Update exceeds limit of %v compatible build id sets per task queue.", maxSets))
+	}
+	numBuildIDs := 0
+	for _, set := range sets {
+		numBuildIDs += len(set.BuildIds)
+	}
+	if numBuildIDs > maxBuildIDs {
+		return nil, serviceerror.NewInvalidArgument(
+			fmt.Sprintf("Update exceeds limit of %v build ids per task queue.", maxBuildIDs))
+	}
+	return &persistencespb.VersioningData{VersionSets: sets}, nil
+}
+
+// limitVersionSets returns the versioning data with the given number of sets with highest precedence, 0 means no limit
+func limitVersionSets(data *persistencespb.VersioningData, maxSets int) *persistencespb.VersioningData {
+	sets := data.GetVersionSets()
+	if maxSets <= 0 || len(sets) <= maxSets {
+		return data
+	}
+	return &persistencespb.VersioningData{VersionSets: sets[len(sets)-maxSets:]}
+}
+
+// lookupVersionSetForTask returns the ID of the version set which a workflow task is routed to, given the build id
+// of the worker which completed the most recent workflow task of the workflow. New workflows are routed to the default
+// set. Empty ID means the unversioned task queue, which is used when the task queue has no versioning data, or when
+// the workflow was processed by a worker which build id is not part of any set.
+func lookupVersionSetForTask(data *persistencespb.VersioningData, buildID string) string {
+	sets := data.GetVersionSets()
+	if len(sets) == 0 {
+		return ""
+	}
+	if buildID == "" {
+		return versionSetID(sets[len(sets)-1])
+	}
+	if setIdx := findVersionSet(sets, buildID); setIdx >= 0 {
+		return versionSetID(sets[setIdx])
+	}
+	return ""
+}
+
+// lookupVersionSetForPoll returns the ID of the version set which a worker with the given build id polls.
+// Empty ID means the unversioned task queue, which is polled by workers without build id or with an unknown one.
+func lookupVersionSetForPoll(data *persistencespb.VersioningData, buildID string) string {
+	if buildID == "" {
+		return ""
+	}
+	sets := data.GetVersionSets()
+	if setIdx := findVersionSet(sets, buildID); setIdx >= 0 {
+		return versionSetID(sets[setIdx])
+	}
+	return ""
+}
+
+// versionSetID derives the ID of a set from its first build id, so it doesn't change when build ids are added
+func versionSetID(set *persistencespb.CompatibleVersionSet) string {
+	return fmt.Sprintf("%x", farm.Fingerprint64([]byte(set.GetBuildIds()[0])))
+}
+
+func findVersionSet(sets []*persistencespb.CompatibleVersionSet, buildID string) int {
+	for setIdx, set := range sets {
+		for _, id := range set.GetBuildIds() {
+			if id == buildID {
+				return setIdx
+			}
+		}
+	}
+	return -1
+}
+
+func findExistingVersionSet(sets []*persistencespb.CompatibleVersionSet, buildID string) (int, error) {
+	setIdx := findVersionSet(sets, buildID)
+	if setIdx < 0 {
+		return -1, serviceerror.NewNotFound(fmt.Sprintf("Build id %v not found.", buildID))
+	}
+	return setIdx, nil
+}
+
+func validateNewBuildID(sets []*persistencespb.CompatibleVersionSet, buildID string) error {
+	if buildID == "" {
+		return errBuildIDNotSet
+	}
+	if len(buildID) > maxBuildIDLength {
+		return errBuildIDTooLong
+	}
+	if findVersionSet(sets, buildID) >= 0 {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf("Build id %v already exists.", buildID))
+	}
+	return nil
+}
+
+func moveVersionSetToEnd(sets []*persistencespb.CompatibleVersionSet, setIdx int) []*persistencespb.CompatibleVersionSet {
+	set := sets[setIdx]
+	sets = append(sets[:setIdx], sets[setIdx+1:]...)
+	return append(sets, set)
+}
@@ -0,0 +1,203 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+)
+
+type (
+	versioningSuite struct {
+		suite.Suite
+		*require.Assertions
+	}
+)
+
+func TestVersioningSuite(t *testing.T) {
+	s := new(versioningSuite)
+	suite.Run(t, s)
+}
+
+func (s *versioningSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *versioningSuite) TestUpdateVersioningData() {
+	data, err := updateVersioningData(nil, mkNewDefaultSetUpdate("1.0"), 10, 100)
+	s.NoError(err)
+	s.Equal(mkVersioningData([]string{"1.0"}), data)
+
+	data, err = updateVersioningData(data, mkNewDefaultSetUpdate("2.0"), 10, 100)
+	s.NoError(err)
+	s.Equal(mkVersioningData([]string{"1.0"}, []string{"2.0"}), data)
+
+	data, err = updateVersioningData(data, mkNewCompatibleUpdate("1.1", "1.0", false), 10, 100)
+	s.NoError(err)
+	s.Equal(mkVersioningData([]string{"1.0", "1.1"}, []string{"2.0"}), data)
+
+	data, err = updateVersioningData(data, mkNewCompatibleUpdate("1.2", "1.1", true), 10, 100)
+	s.NoError(err)
+	s.Equal(mkVersioningData([]string{"2.0"}, []string{"1.0", "1.1", "1.2"}), data)
+
+	data, err = updateVersioningData(data, mkPromoteSetUpdate("2.0"), 10, 100)
+	s.NoError(err)
+	s.Equal(mkVersioningData([]string{"1.0", "1.1", "1.2"}, []string{"2.0"}), data)
+
+	data, err = updateVersioningData(data, mkPromoteBuildIDUpdate("1.1"), 10, 100)
+	s.NoError(err)
+	s.Equal(mkVersioningData([]string{"1.0", "1.2", "1.1"}, []string{"2.0"}), data)
+}
+
+func (s *versioningSuite) TestUpdateVersioningData_DoesNotModifyInput() {
+	data := mkVersioningData([]string{"1.0"}, []string{"2.0"})
+	_, err := updateVersioningData(data, mkNewCompatibleUpdate("1.1", "1.0", true), 10, 100)
+	s.NoError(err)
+	s.Equal(mkVersioningData([]string{"1.0"}, []string{"2.0"}), data)
+}
+
+func (s *versioningSuite) TestUpdateVersioningData_InvalidUpdate() {
+	data := mkVersioningData([]string{"1.0"}, []string{"2.0"})
+
+	_, err := updateVersioningData(data, &taskqueuespb.BuildIdCompatibilityUpdate{}, 10, 100)
+	s.Equal(errBuildIDCompatibilityUpdateNotSet, err)
+
+	_, err = updateVersioningData(data, mkNewDefaultSetUpdate(""), 10, 100)
+	s.Equal(errBuildIDNotSet, err)
+
+	_, err = updateVersioningData(data, mkNewDefaultSetUpdate(strings.Repeat("x", maxBuildIDLength+1)), 10, 100)
+	s.Equal(errBuildIDTooLong, err)
+
+	_, err = updateVersioningData(data, mkNewDefaultSetUpdate("1.0"), 10, 100)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	_, err = updateVersioningData(data, mkNewCompatibleUpdate("1.1", "3.0", false), 10, 100)
+	s.IsType(&serviceerror.NotFound{}, err)
+
+	_, err = updateVersioningData(data, mkPromoteSetUpdate("3.0"), 10, 100)
+	s.IsType(&serviceerror.NotFound{}, err)
+
+	_, err = updateVersioningData(data, mkPromoteBuildIDUpdate("3.0"), 10, 100)
+	s.IsType(&serviceerror.NotFound{}, err)
+}
+
+func (s *versioningSuite) TestUpdateVersioningData_Limits() {
+	data := mkVersioningData([]string{"1.0", "1.1"}, []string{"2.0"})
+
+	_, err := updateVersioningData(data, mkNewDefaultSetUpdate("3.0"), 2, 100)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	_, err = updateVersioningData(data, mkNewCompatibleUpdate("2.1", "2.0", false), 10, 3)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	_, err = updateVersioningData(data, mkPromoteSetUpdate("1.0"), 2, 3)
+	s.NoError(err)
+}
+
+func (s *versioningSuite) TestLimitVersionSets() {
+	data := mkVersioningData([]string{"1.0"}, []string{"2.0"}, []string{"3.0"})
+	s.Equal(data, limitVersionSets(data, 0))
+	s.Equal(data, limitVersionSets(data, 3))
+	s.Equal(mkVersioningData([]string{"2.0"}, []string{"3.0"}), limitVersionSets(data, 2))
+}
+
+func (s *versioningSuite) TestLookupVersionSet() {
+	s.Equal("", lookupVersionSetForTask(nil, ""))
+	s.Equal("", lookupVersionSetForTask(nil, "1.0"))
+	s.Equal("", lookupVersionSetForPoll(nil, "1.0"))
+
+	data := mkVersioningData([]string{"1.0", "1.1"}, []string{"2.0"})
+	set1 := versionSetID(data.VersionSets[0])
+	set2 := versionSetID(data.VersionSets[1])
+	s.NotEqual(set1, set2)
+
+	// new workflows go to the default set
+	s.Equal(set2, lookupVersionSetForTask(data, ""))
+	s.Equal(set1, lookupVersionSetForTask(data, "1.1"))
+	s.Equal(set2, lookupVersionSetForTask(data, "2.0"))
+	s.Equal("", lookupVersionSetForTask(data, "0.9"))
+
+	s.Equal("", lookupVersionSetForPoll(data, ""))
+	s.Equal(set1, lookupVersionSetForPoll(data, "1.0"))
+	s.Equal(set2, lookupVersionSetForPoll(data, "2.0"))
+	s.Equal("", lookupVersionSetForPoll(data, "0.9"))
+
+	// the set ID is stable when build ids are added and sets are reordered
+	data, err := updateVersioningData(data, mkNewCompatibleUpdate("1.2", "1.0", true), 10, 100)
+	s.NoError(err)
+	s.Equal(set1, lookupVersionSetForTask(data, ""))
+	s.Equal(set1, lookupVersionSetForPoll(data, "1.2"))
+}
+
+func mkVersioningData(sets ...[]string) *persistencespb.VersioningData {
+	data := &persistencespb.VersioningData{}
+	for _, buildIDs := range sets {
+		data.VersionSets = append(data.VersionSets, &persistencespb.CompatibleVersionSet{BuildIds: buildIDs})
+	}
+	return data
+}
+
+func mkNewDefaultSetUpdate(buildID string) *taskqueuespb.BuildIdCompatibilityUpdate {
+	return &taskqueuespb.BuildIdCompatibilityUpdate{
+		Operation: &taskqueuespb.BuildIdCompatibilityUpdate_AddNewBuildIdInNewDefaultSet{
+			AddNewBuildIdInNewDefaultSet: buildID,
+		},
+	}
+}
+
+func mkNewCompatibleUpdate(buildID string, existingBuildID string, makeSetDefault bool) *taskqueuespb.BuildIdCompatibilityUpdate {
+	return &taskqueuespb.BuildIdCompatibilityUpdate{
+		Operation: &taskqueuespb.BuildIdCompatibilityUpdate_AddNewCompatibleBuildId_{
+			AddNewCompatibleBuildId: &taskqueuespb.BuildIdCompatibilityUpdate_AddNewCompatibleBuildId{
+				NewBuildId:                buildID,
+				ExistingCompatibleBuildId: existingBuildID,
+				MakeSetDefault:            makeSetDefault,
+			},
+		},
+	}
+}
+
+func mkPromoteSetUpdate(buildID string) *taskqueuespb.BuildIdCompatibilityUpdate {
+	return &taskqueuespb.BuildIdCompatibilityUpdate{
+		Operation: &taskqueuespb.BuildIdCompatibilityUpdate_PromoteSetByBuildId{
+			PromoteSetByBuildId: buildID,
+		},
+	}
+}
+
+func mkPromoteBuildIDUpdate(buildID string) *taskqueuespb.BuildIdCompatibilityUpdate {
+	return &taskqueuespb.BuildIdCompatibilityUpdate{
+		Operation: &taskqueuespb.BuildIdCompatibilityUpdate_PromoteBuildIdWithinSet{
+			PromoteBuildIdWithinSet: buildID,
+		},
+	}
+}
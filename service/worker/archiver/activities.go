@@ -27,6 +27,7 @@ package archiver
 import (
 	"context"
 
+	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/sdk/activity"
 	"go.temporal.io/sdk/temporal"
 
@@ -42,12 +43,18 @@ import (
 
 const (
 	uploadHistoryActivityFnName     = "uploadHistoryActivity"
+	verifyHistoryActivityFnName     = "verifyHistoryActivity"
 	deleteHistoryActivityFnName     = "deleteHistoryActivity"
 	archiveVisibilityActivityFnName = "archiveVisibilityActivity"
+
+	verifyHistoryBlobSize = 2 * 1024 * 1024 // 2MB
+	verifyHistoryPageSize = 250
 )
 
 var (
 	errUploadNonRetryable            = temporal.NewNonRetryableApplicationError("upload non-retryable error", "", nil)
+	errVerifyNonRetryable            = temporal.NewNonRetryableApplicationError("verify non-retryable error", "", nil)
+	errVerifyMismatch                = temporal.NewNonRetryableApplicationError("archived history mismatch", "", nil)
 	errDeleteNonRetryable            = temporal.NewNonRetryableApplicationError("delete non-retryable error", "", nil)
 	errArchiveVisibilityNonRetryable = temporal.NewNonRetryableApplicationError("archive visibility non-retryable error", "", nil)
 )
@@ -97,6 +104,96 @@ func uploadHistoryActivity(ctx context.Context, request ArchiveRequest) (err err
 	return err
 }
 
+// verifyHistoryActivity re-reads archived history and compares its event count and checksum
+// with the history which is about to be deleted.
+func verifyHistoryActivity(ctx context.Context, request ArchiveRequest) (err error) {
+	container := ctx.Value(bootstrapContainerKey).(*BootstrapContainer)
+	scope := container.MetricsClient.Scope(metrics.ArchiverVerifyHistoryActivityScope, metrics.NamespaceTag(request.Namespace))
+	sw := scope.StartTimer(metrics.ServiceLatency)
+	defer func() {
+		sw.Stop()
+		if err != nil {
+			if err.Error() == errVerifyNonRetryable.Error() {
+				scope.IncCounter(metrics.ArchiverNonRetryableErrorCount)
+			}
+			if err.Error() != errVerifyMismatch.Error() {
+				err = temporal.NewNonRetryableApplicationError(err.Error(), "", nil)
+			}
+		}
+	}()
+	logger := tagLoggerWithHistoryRequest(tagLoggerWithActivityInfo(container.Logger, activity.GetInfo(ctx)), &request)
+	URI, err := carchiver.NewURI(request.HistoryURI)
+	if err != nil {
+		logger.Error("failed to get history archival uri", tag.ArchivalURI(request.HistoryURI), tag.Error(err))
+		return errVerifyNonRetryable
+	}
+	historyArchiver, err := container.ArchiverProvider.GetHistoryArchiver(URI.Scheme(), common.WorkerServiceName)
+	if err != nil {
+		logger.Error("failed to get history archiver", tag.Error(err))
+		return errVerifyNonRetryable
+	}
+
+	var expected historyDigest
+	historyIterator := carchiver.NewHistoryIterator(&carchiver.ArchiveHistoryRequest{
+		ShardID:              request.ShardID,
+		NamespaceID:          request.NamespaceID,
+		Namespace:            request.Namespace,
+		WorkflowID:           request.WorkflowID,
+		RunID:                request.RunID,
+		BranchToken:          request.BranchToken,
+		NextEventID:          request.NextEventID,
+		CloseFailoverVersion: request.CloseFailoverVersion,
+	}, container.HistoryV2Manager, verifyHistoryBlobSize)
+	for historyIterator.HasNext() {
+		historyBlob, err := historyIterator.Next()
+		if err != nil {
+			logger.Error("failed to read history", tag.Error(err))
+			return err
+		}
+		if err := expected.add(historyBlob.Body); err != nil {
+			return err
+		}
+		activity.RecordHeartbeat(ctx)
+	}
+
+	var archived historyDigest
+	getRequest := &carchiver.GetHistoryRequest{
+		NamespaceID:          request.NamespaceID,
+		WorkflowID:           request.WorkflowID,
+		RunID:                request.RunID,
+		CloseFailoverVersion: &request.CloseFailoverVersion,
+		PageSize:             verifyHistoryPageSize,
+	}
+	for {
+		resp, err := historyArchiver.Get(ctx, URI, getRequest)
+		if _, ok := err.(*serviceerror.NotFound); ok {
+			logger.Error("archived history not found", tag.Error(err))
+			return errVerifyMismatch
+		}
+		if err != nil {
+			logger.Error("failed to read archived history", tag.Error(err))
+			return err
+		}
+		if err := archived.add(resp.HistoryBatches); err != nil {
+			return err
+		}
+		activity.RecordHeartbeat(ctx)
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		getRequest.NextPageToken = resp.NextPageToken
+	}
+
+	if expected != archived {
+		logger.Error("archived history does not match history",
+			tag.NewInt64("expected-event-count", expected.eventCount),
+			tag.NewInt64("archived-event-count", archived.eventCount),
+		)
+		return errVerifyMismatch
+	}
+	return nil
+}
+
 func deleteHistoryActivity(ctx context.Context, request ArchiveRequest) (err error) {
 	container := ctx.Value(bootstrapContainerKey).(*BootstrapContainer)
 	scope := container.MetricsClient.Scope(metrics.ArchiverDeleteHistoryActivityScope, metrics.NamespaceTag(request.Namespace))
@@ -31,6 +31,8 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/sdk/activity"
 	"go.temporal.io/sdk/testsuite"
 	"go.temporal.io/sdk/worker"
@@ -244,6 +246,75 @@ func (s *activitiesSuite) TestUploadHistory_Success() {
 	s.NoError(err)
 }
 
+func (s *activitiesSuite) TestVerifyHistory_Fail_InvalidURI() {
+	s.metricsClient.EXPECT().Scope(
+		metrics.ArchiverVerifyHistoryActivityScope, []metrics.Tag{metrics.NamespaceTag(testNamespace)},
+	).Return(s.metricsScope)
+	s.metricsScope.EXPECT().IncCounter(metrics.ArchiverNonRetryableErrorCount)
+	container := &BootstrapContainer{
+		Logger:        s.logger,
+		MetricsClient: s.metricsClient,
+	}
+	env := s.NewTestActivityEnvironment()
+	s.registerWorkflows(env)
+	env.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), bootstrapContainerKey, container),
+	})
+	request := ArchiveRequest{
+		NamespaceID:          testNamespaceID,
+		Namespace:            testNamespace,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+		HistoryURI:           "some invalid URI without scheme",
+	}
+	_, err := env.ExecuteActivity(verifyHistoryActivity, request)
+	s.Equal(errVerifyNonRetryable.Error(), errors.Unwrap(err).Error())
+}
+
+func (s *activitiesSuite) TestVerifyHistory_Fail_ArchivedHistoryNotFound() {
+	s.metricsClient.EXPECT().Scope(
+		metrics.ArchiverVerifyHistoryActivityScope, []metrics.Tag{metrics.NamespaceTag(testNamespace)},
+	).Return(s.metricsScope)
+	s.mockHistoryMgr.EXPECT().ReadHistoryBranchByBatch(gomock.Any()).DoAndReturn(
+		func(request *persistence.ReadHistoryBranchRequest) (*persistence.ReadHistoryBranchByBatchResponse, error) {
+			if request.MinEventID != common.FirstEventID {
+				return nil, serviceerror.NewNotFound("history not found")
+			}
+			return &persistence.ReadHistoryBranchByBatchResponse{
+				History: []*historypb.History{{Events: []*historypb.HistoryEvent{{EventId: common.FirstEventID}}}},
+			}, nil
+		},
+	).AnyTimes()
+	s.historyArchiver.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound("archived history not found"))
+	s.archiverProvider.EXPECT().GetHistoryArchiver(gomock.Any(), common.WorkerServiceName).Return(s.historyArchiver, nil)
+	container := &BootstrapContainer{
+		Logger:           s.logger,
+		MetricsClient:    s.metricsClient,
+		HistoryV2Manager: s.mockHistoryMgr,
+		ArchiverProvider: s.archiverProvider,
+	}
+	env := s.NewTestActivityEnvironment()
+	s.registerWorkflows(env)
+	env.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), bootstrapContainerKey, container),
+	})
+	request := ArchiveRequest{
+		NamespaceID:          testNamespaceID,
+		Namespace:            testNamespace,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+		HistoryURI:           testArchivalURI,
+	}
+	_, err := env.ExecuteActivity(verifyHistoryActivity, request)
+	s.True(isVerifyMismatch(err))
+}
+
 func (s *activitiesSuite) TestDeleteHistoryActivity_Fail_DeleteFromV2NonRetryableError() {
 	s.metricsClient.EXPECT().Scope(metrics.ArchiverDeleteHistoryActivityScope, []metrics.Tag{metrics.NamespaceTag(testNamespace)}).Return(s.metricsScope)
 	s.metricsScope.EXPECT().IncCounter(metrics.ArchiverNonRetryableErrorCount)
@@ -399,6 +470,7 @@ func (s *activitiesSuite) TestArchiveVisibilityActivity_Success() {
 
 func (s *activitiesSuite) registerWorkflows(env *testsuite.TestActivityEnvironment) {
 	env.RegisterActivityWithOptions(uploadHistoryActivity, activity.RegisterOptions{Name: uploadHistoryActivityFnName})
+	env.RegisterActivityWithOptions(verifyHistoryActivity, activity.RegisterOptions{Name: verifyHistoryActivityFnName})
 	env.RegisterActivityWithOptions(deleteHistoryActivity, activity.RegisterOptions{Name: deleteHistoryActivityFnName})
 	env.RegisterActivityWithOptions(archiveVisibilityActivity, activity.RegisterOptions{Name: archiveVisibilityActivityFnName})
 }
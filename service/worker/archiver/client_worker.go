@@ -71,6 +71,7 @@ type (
 		ArchiverConcurrency           dynamicconfig.IntPropertyFn
 		ArchivalsPerIteration         dynamicconfig.IntPropertyFn
 		TimeLimitPerArchivalIteration dynamicconfig.DurationPropertyFn
+		BlobIntegrityCheckProbability dynamicconfig.FloatPropertyFn
 	}
 
 	contextKey int
@@ -110,6 +111,7 @@ func NewClientWorker(container *BootstrapContainer) ClientWorker {
 
 	clientWorker.worker.RegisterWorkflowWithOptions(archivalWorkflow, workflow.RegisterOptions{Name: archivalWorkflowFnName})
 	clientWorker.worker.RegisterActivityWithOptions(uploadHistoryActivity, activity.RegisterOptions{Name: uploadHistoryActivityFnName})
+	clientWorker.worker.RegisterActivityWithOptions(verifyHistoryActivity, activity.RegisterOptions{Name: verifyHistoryActivityFnName})
 	clientWorker.worker.RegisterActivityWithOptions(deleteHistoryActivity, activity.RegisterOptions{Name: deleteHistoryActivityFnName})
 	clientWorker.worker.RegisterActivityWithOptions(archiveVisibilityActivity, activity.RegisterOptions{Name: archiveVisibilityActivityFnName})
 
@@ -27,6 +27,7 @@
 package archiver
 
 import (
+	"errors"
 	"time"
 
 	"go.temporal.io/sdk/temporal"
@@ -45,12 +46,13 @@ type (
 	}
 
 	handler struct {
-		ctx           workflow.Context
-		logger        log.Logger
-		metricsClient metrics.Client
-		concurrency   int
-		requestCh     workflow.Channel
-		resultCh      workflow.Channel
+		ctx                           workflow.Context
+		logger                        log.Logger
+		metricsClient                 metrics.Client
+		concurrency                   int
+		blobIntegrityCheckProbability float64
+		requestCh                     workflow.Channel
+		resultCh                      workflow.Channel
 	}
 )
 
@@ -60,15 +62,17 @@ func NewHandler(
 	logger log.Logger,
 	metricsClient metrics.Client,
 	concurrency int,
+	blobIntegrityCheckProbability float64,
 	requestCh workflow.Channel,
 ) Handler {
 	return &handler{
-		ctx:           ctx,
-		logger:        logger,
-		metricsClient: metricsClient,
-		concurrency:   concurrency,
-		requestCh:     requestCh,
-		resultCh:      workflow.NewChannel(ctx),
+		ctx:                           ctx,
+		logger:                        logger,
+		metricsClient:                 metricsClient,
+		concurrency:                   concurrency,
+		blobIntegrityCheckProbability: blobIntegrityCheckProbability,
+		requestCh:                     requestCh,
+		resultCh:                      workflow.NewChannel(ctx),
 	}
 }
 
@@ -154,6 +158,26 @@ func (h *handler) handleHistoryRequest(ctx workflow.Context, request *ArchiveReq
 	}
 	uploadSW.Stop()
 
+	if err == nil && shouldCheckBlobIntegrity(request, h.blobIntegrityCheckProbability) {
+		verifySW := h.metricsClient.StartTimer(metrics.ArchiverScope, metrics.ArchiverVerifyWithRetriesLatency)
+		err = workflow.ExecuteActivity(actCtx, verifyHistoryActivityFnName, *request).Get(actCtx, nil)
+		verifySW.Stop()
+		switch {
+		case err == nil:
+			h.metricsClient.IncCounter(metrics.ArchiverScope, metrics.ArchiverVerifySuccessCount)
+		case isVerifyMismatch(err):
+			// archived history can not be trusted, keep primary history so it can be archived again or inspected
+			logger.Error("archived history does not match history, history is not deleted")
+			h.metricsClient.IncCounter(metrics.ArchiverScope, metrics.ArchiverVerifyMismatchCount)
+			h.metricsClient.IncCounter(metrics.ArchiverScope, metrics.ArchiverDeleteSkippedCount)
+			sw.Stop()
+			return
+		default:
+			logger.Error("failed to verify archived history, will move on to deleting history", tag.Error(err))
+			h.metricsClient.IncCounter(metrics.ArchiverScope, metrics.ArchiverVerifyFailedAllRetriesCount)
+		}
+	}
+
 	lao := workflow.LocalActivityOptions{
 		ScheduleToCloseTimeout: 5 * time.Minute,
 		StartToCloseTimeout:    time.Minute,
@@ -197,3 +221,8 @@ func (h *handler) handleVisibilityRequest(ctx workflow.Context, request *Archive
 	}
 	sw.Stop()
 }
+
+func isVerifyMismatch(err error) bool {
+	var appErr *temporal.ApplicationError
+	return errors.As(err, &appErr) && appErr.Error() == errVerifyMismatch.Error()
+}
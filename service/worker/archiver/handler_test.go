@@ -61,10 +61,12 @@ func TestHandlerSuite(t *testing.T) {
 
 func (s *handlerSuite) registerWorkflows(env *testsuite.TestWorkflowEnvironment) {
 	env.RegisterWorkflow(handleHistoryRequestWorkflow)
+	env.RegisterWorkflow(handleHistoryRequestWithIntegrityCheckWorkflow)
 	env.RegisterWorkflow(handleVisibilityRequestWorkflow)
 	env.RegisterWorkflow(startAndFinishArchiverWorkflow)
 
 	env.RegisterActivityWithOptions(uploadHistoryActivity, activity.RegisterOptions{Name: uploadHistoryActivityFnName})
+	env.RegisterActivityWithOptions(verifyHistoryActivity, activity.RegisterOptions{Name: verifyHistoryActivityFnName})
 	env.RegisterActivityWithOptions(deleteHistoryActivity, activity.RegisterOptions{Name: deleteHistoryActivityFnName})
 	env.RegisterActivityWithOptions(archiveVisibilityActivity, activity.RegisterOptions{Name: archiveVisibilityActivityFnName})
 }
@@ -167,6 +169,58 @@ func (s *handlerSuite) TestHandleHistoryRequest_DeleteFailsThenSucceeds() {
 	s.NoError(env.GetWorkflowError())
 }
 
+func (s *handlerSuite) TestHandleHistoryRequest_VerifySuccess() {
+	handlerTestMetrics.EXPECT().IncCounter(metrics.ArchiverScope, metrics.ArchiverUploadSuccessCount)
+	handlerTestMetrics.EXPECT().IncCounter(metrics.ArchiverScope, metrics.ArchiverVerifySuccessCount)
+	handlerTestMetrics.EXPECT().IncCounter(metrics.ArchiverScope, metrics.ArchiverDeleteSuccessCount)
+
+	env := s.NewTestWorkflowEnvironment()
+	s.registerWorkflows(env)
+	env.OnActivity(uploadHistoryActivityFnName, mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity(verifyHistoryActivityFnName, mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity(deleteHistoryActivityFnName, mock.Anything, mock.Anything).Return(nil)
+	env.ExecuteWorkflow(handleHistoryRequestWithIntegrityCheckWorkflow, ArchiveRequest{})
+
+	env.AssertExpectations(s.T())
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+}
+
+func (s *handlerSuite) TestHandleHistoryRequest_VerifyMismatch_SkipDelete() {
+	handlerTestMetrics.EXPECT().IncCounter(metrics.ArchiverScope, metrics.ArchiverUploadSuccessCount)
+	handlerTestMetrics.EXPECT().IncCounter(metrics.ArchiverScope, metrics.ArchiverVerifyMismatchCount)
+	handlerTestMetrics.EXPECT().IncCounter(metrics.ArchiverScope, metrics.ArchiverDeleteSkippedCount)
+	handlerTestLogger.EXPECT().Error(gomock.Any(), gomock.Any())
+
+	env := s.NewTestWorkflowEnvironment()
+	s.registerWorkflows(env)
+	env.OnActivity(uploadHistoryActivityFnName, mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity(verifyHistoryActivityFnName, mock.Anything, mock.Anything).Return(errVerifyMismatch)
+	env.ExecuteWorkflow(handleHistoryRequestWithIntegrityCheckWorkflow, ArchiveRequest{})
+
+	env.AssertExpectations(s.T())
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+}
+
+func (s *handlerSuite) TestHandleHistoryRequest_VerifyFails_StillDelete() {
+	handlerTestMetrics.EXPECT().IncCounter(metrics.ArchiverScope, metrics.ArchiverUploadSuccessCount)
+	handlerTestMetrics.EXPECT().IncCounter(metrics.ArchiverScope, metrics.ArchiverVerifyFailedAllRetriesCount)
+	handlerTestMetrics.EXPECT().IncCounter(metrics.ArchiverScope, metrics.ArchiverDeleteSuccessCount)
+	handlerTestLogger.EXPECT().Error(gomock.Any(), gomock.Any())
+
+	env := s.NewTestWorkflowEnvironment()
+	s.registerWorkflows(env)
+	env.OnActivity(uploadHistoryActivityFnName, mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity(verifyHistoryActivityFnName, mock.Anything, mock.Anything).Return(errVerifyNonRetryable)
+	env.OnActivity(deleteHistoryActivityFnName, mock.Anything, mock.Anything).Return(nil)
+	env.ExecuteWorkflow(handleHistoryRequestWithIntegrityCheckWorkflow, ArchiveRequest{})
+
+	env.AssertExpectations(s.T())
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+}
+
 func (s *handlerSuite) TestHandleVisibilityRequest_Fail() {
 	handlerTestMetrics.EXPECT().IncCounter(metrics.ArchiverScope, metrics.ArchiverHandleVisibilityFailedAllRetiresCount)
 	handlerTestLogger.EXPECT().Error(gomock.Any(), gomock.Any())
@@ -218,20 +272,26 @@ func (s *handlerSuite) TestRunArchiver() {
 }
 
 func handleHistoryRequestWorkflow(ctx workflow.Context, request ArchiveRequest) error {
-	handler := NewHandler(ctx, handlerTestLogger, handlerTestMetrics, 0, nil).(*handler)
+	handler := NewHandler(ctx, handlerTestLogger, handlerTestMetrics, 0, 0, nil).(*handler)
+	handler.handleHistoryRequest(ctx, &request)
+	return nil
+}
+
+func handleHistoryRequestWithIntegrityCheckWorkflow(ctx workflow.Context, request ArchiveRequest) error {
+	handler := NewHandler(ctx, handlerTestLogger, handlerTestMetrics, 0, 1, nil).(*handler)
 	handler.handleHistoryRequest(ctx, &request)
 	return nil
 }
 
 func handleVisibilityRequestWorkflow(ctx workflow.Context, request ArchiveRequest) error {
-	handler := NewHandler(ctx, handlerTestLogger, handlerTestMetrics, 0, nil).(*handler)
+	handler := NewHandler(ctx, handlerTestLogger, handlerTestMetrics, 0, 0, nil).(*handler)
 	handler.handleVisibilityRequest(ctx, &request)
 	return nil
 }
 
 func startAndFinishArchiverWorkflow(ctx workflow.Context, concurrency int, numRequests int) error {
 	requestCh := workflow.NewBufferedChannel(ctx, numRequests)
-	handler := NewHandler(ctx, handlerTestLogger, handlerTestMetrics, concurrency, requestCh)
+	handler := NewHandler(ctx, handlerTestLogger, handlerTestMetrics, concurrency, 0, requestCh)
 	handler.Start()
 	sentHashes := make([]uint64, numRequests, numRequests)
 	workflow.Go(ctx, func(ctx workflow.Context) {
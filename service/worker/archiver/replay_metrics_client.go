@@ -93,6 +93,22 @@ func (r *replayMetricsClient) RecordDistribution(scope int, timer int, d int) {
 	r.client.RecordDistribution(scope, timer, d)
 }
 
+// RecordHistogramDuration records a duration to the histogram for the given metric name
+func (r *replayMetricsClient) RecordHistogramDuration(scope int, histogram int, d time.Duration) {
+	if workflow.IsReplaying(r.ctx) {
+		return
+	}
+	r.client.RecordHistogramDuration(scope, histogram, d)
+}
+
+// RecordHistogramValue records a value to the histogram for the given metric name
+func (r *replayMetricsClient) RecordHistogramValue(scope int, histogram int, value float64) {
+	if workflow.IsReplaying(r.ctx) {
+		return
+	}
+	r.client.RecordHistogramValue(scope, histogram, value)
+}
+
 // UpdateGauge reports Gauge type absolute value metric
 func (r *replayMetricsClient) UpdateGauge(scope int, gauge int, value float64) {
 	if workflow.IsReplaying(r.ctx) {
@@ -159,6 +175,22 @@ func (r *replayMetricsScope) RecordDistribution(timer int, d int) {
 	r.scope.RecordDistribution(timer, d)
 }
 
+// RecordHistogramDuration records a duration to the histogram for the given metric name
+func (r *replayMetricsScope) RecordHistogramDuration(histogram int, d time.Duration) {
+	if workflow.IsReplaying(r.ctx) {
+		return
+	}
+	r.scope.RecordHistogramDuration(histogram, d)
+}
+
+// RecordHistogramValue records a value to the histogram for the given metric name
+func (r *replayMetricsScope) RecordHistogramValue(histogram int, value float64) {
+	if workflow.IsReplaying(r.ctx) {
+		return
+	}
+	r.scope.RecordHistogramValue(histogram, value)
+}
+
 // UpdateGauge reports Gauge type absolute value metric
 func (r *replayMetricsScope) UpdateGauge(gauge int, value float64) {
 	if workflow.IsReplaying(r.ctx) {
@@ -30,12 +30,25 @@ import (
 	"time"
 
 	"github.com/dgryski/go-farm"
+	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/sdk/activity"
 
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 )
 
+const (
+	blobIntegrityCheckBuckets = 10000
+)
+
+type (
+	// historyDigest is event count and order sensitive checksum of history events
+	historyDigest struct {
+		eventCount int64
+		checksum   uint64
+	}
+)
+
 // MaxArchivalIterationTimeout returns the max allowed timeout for a single iteration of archival workflow
 func MaxArchivalIterationTimeout() time.Duration {
 	return workflowRunTimeout / 2
@@ -49,6 +62,28 @@ func hash(i interface{}) uint64 {
 	return farm.Fingerprint64(b.Bytes())
 }
 
+// shouldCheckBlobIntegrity samples requests by their hash, so the decision is deterministic for archival workflow
+func shouldCheckBlobIntegrity(request *ArchiveRequest, probability float64) bool {
+	if probability <= 0 {
+		return false
+	}
+	return float64(hash(*request)%blobIntegrityCheckBuckets) < probability*blobIntegrityCheckBuckets
+}
+
+func (d *historyDigest) add(batches []*historypb.History) error {
+	for _, batch := range batches {
+		for _, event := range batch.Events {
+			data, err := event.Marshal()
+			if err != nil {
+				return err
+			}
+			d.eventCount++
+			d.checksum = farm.Hash64WithSeed(data, d.checksum)
+		}
+	}
+	return nil
+}
+
 func hashesEqual(a []uint64, b []uint64) bool {
 	if len(a) != len(b) {
 		return false
@@ -35,9 +35,10 @@ import (
 )
 
 type dynamicConfigResult struct {
-	ArchiverConcurrency   int
-	ArchivalsPerIteration int
-	TimelimitPerIteration time.Duration
+	ArchiverConcurrency           int
+	ArchivalsPerIteration         int
+	TimelimitPerIteration         time.Duration
+	BlobIntegrityCheckProbability float64
 }
 
 func archivalWorkflow(ctx workflow.Context, carryover []ArchiveRequest) error {
@@ -76,14 +77,15 @@ func archivalWorkflowHelper(
 				timeLimit = maxTimeLimit
 			}
 			return dynamicConfigResult{
-				ArchiverConcurrency:   config.ArchiverConcurrency(),
-				ArchivalsPerIteration: config.ArchivalsPerIteration(),
-				TimelimitPerIteration: timeLimit,
+				ArchiverConcurrency:           config.ArchiverConcurrency(),
+				ArchivalsPerIteration:         config.ArchivalsPerIteration(),
+				TimelimitPerIteration:         timeLimit,
+				BlobIntegrityCheckProbability: config.BlobIntegrityCheckProbability(),
 			}
 		}).Get(&dcResult)
 	requestCh := workflow.NewBufferedChannel(ctx, dcResult.ArchivalsPerIteration)
 	if handler == nil {
-		handler = NewHandler(ctx, logger, metricsClient, dcResult.ArchiverConcurrency, requestCh)
+		handler = NewHandler(ctx, logger, metricsClient, dcResult.ArchiverConcurrency, dcResult.BlobIntegrityCheckProbability, requestCh)
 	}
 	handlerSW := metricsClient.StartTimer(metrics.ArchiverArchivalWorkflowScope, metrics.ArchiverHandleAllRequestsLatency)
 	handler.Start()
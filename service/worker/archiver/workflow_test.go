@@ -80,6 +80,7 @@ func (s *workflowSuite) SetupTest() {
 		ArchiverConcurrency:           dynamicconfig.GetIntPropertyFn(0),
 		ArchivalsPerIteration:         dynamicconfig.GetIntPropertyFn(0),
 		TimeLimitPerArchivalIteration: dynamicconfig.GetDurationPropertyFn(MaxArchivalIterationTimeout()),
+		BlobIntegrityCheckProbability: dynamicconfig.GetFloatPropertyFn(0),
 	}
 }
 
@@ -165,6 +166,7 @@ func (s *workflowSuite) TestReplayArchiveHistoryWorkflow() {
 		ArchiverConcurrency:           dynamicconfig.GetIntPropertyFn(50),
 		ArchivalsPerIteration:         dynamicconfig.GetIntPropertyFn(1000),
 		TimeLimitPerArchivalIteration: dynamicconfig.GetDurationPropertyFn(MaxArchivalIterationTimeout()),
+		BlobIntegrityCheckProbability: dynamicconfig.GetFloatPropertyFn(0),
 	}
 
 	replayer := worker.NewWorkflowReplayer()
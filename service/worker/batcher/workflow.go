@@ -31,6 +31,8 @@ import (
 
 	"github.com/google/uuid"
 	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	namespacepb "go.temporal.io/api/namespace/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/sdk/activity"
@@ -38,10 +40,12 @@ import (
 	"go.temporal.io/sdk/workflow"
 	"golang.org/x/time/rate"
 
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
+	historyworkflow "go.temporal.io/server/service/history/workflow"
 )
 
 const (
@@ -72,6 +76,9 @@ const (
 	BatchTypeCancel = "cancel"
 	// BatchTypeSignal is batch type for signaling workflows
 	BatchTypeSignal = "signal"
+	// BatchTypeResetBadBinary is the batch type for resetting workflows which ran a bad binary.
+	// It is started through the admin API and therefore not part of AllBatchTypes.
+	BatchTypeResetBadBinary = "reset_bad_binary"
 )
 
 // AllBatchTypes is the batch types we supported
@@ -100,6 +107,12 @@ type (
 		Input      *commonpb.Payloads
 	}
 
+	// ResetBadBinaryParams is the parameters for resetting workflows which ran a bad binary
+	ResetBadBinaryParams struct {
+		BinaryChecksum   string
+		ResetReapplyType enumspb.ResetReapplyType
+	}
+
 	// BatchParams is the parameters for batch operation workflow
 	BatchParams struct {
 		// Target namespace to execute batch operation
@@ -108,7 +121,7 @@ type (
 		Query string
 		// Reason for the operation
 		Reason string
-		// Supporting: signal,cancel,terminate
+		// Supporting: signal,cancel,terminate,reset_bad_binary
 		BatchType string
 
 		// Below are all optional
@@ -118,6 +131,8 @@ type (
 		CancelParams CancelParams
 		// SignalParams is params only for BatchTypeSignal
 		SignalParams SignalParams
+		// ResetBadBinaryParams is params only for BatchTypeResetBadBinary
+		ResetBadBinaryParams ResetBadBinaryParams
 		// RPS of processing. Default to DefaultRPS
 		// TODO we will implement smarter way than this static rate limiter: https://go.temporal.io/server/issues/2138
 		RPS int
@@ -181,6 +196,12 @@ func BatchWorkflow(ctx workflow.Context, batchParams BatchParams) (HeartBeatDeta
 	return result, err
 }
 
+// ResetBadBinaryJobID returns the workflow id of the batch job resetting the workflows which ran the bad binary.
+// Only one job runs for a binary at a time.
+func ResetBadBinaryJobID(namespace string, binaryChecksum string) string {
+	return fmt.Sprintf("%s-%s-%s", BatchTypeResetBadBinary, namespace, binaryChecksum)
+}
+
 func validateParams(params BatchParams) error {
 	if params.BatchType == "" ||
 		params.Reason == "" ||
@@ -194,6 +215,11 @@ func validateParams(params BatchParams) error {
 			return fmt.Errorf("must provide signal name")
 		}
 		return nil
+	case BatchTypeResetBadBinary:
+		if params.ResetBadBinaryParams.BinaryChecksum == "" {
+			return fmt.Errorf("must provide binary checksum")
+		}
+		return nil
 	case BatchTypeCancel, BatchTypeTerminate:
 		return nil
 	default:
@@ -388,6 +414,11 @@ func startTaskProcessor(
 						})
 						return err
 					})
+			case BatchTypeResetBadBinary:
+				err = processTask(ctx, limiter, task, batchParams, client, convert.BoolPtr(false),
+					func(workflowID, runID string) error {
+						return resetBadBinary(ctx, batchParams, client, workflowID, runID, requestID)
+					})
 			}
 			if err != nil {
 				batcher.metricsClient.IncCounter(metrics.BatcherScope, metrics.BatcherProcessorFailures)
@@ -467,6 +498,59 @@ func processTask(
 	return nil
 }
 
+// resetBadBinary resets the workflow to the point before the bad binary completed its first workflow task.
+// Workflows which are not the current run or have no resettable point for the binary are skipped.
+func resetBadBinary(
+	ctx context.Context,
+	batchParams BatchParams,
+	client workflowservice.WorkflowServiceClient,
+	workflowID string,
+	runID string,
+	requestID string,
+) error {
+	resp, err := client.DescribeWorkflowExecution(ctx, &workflowservice.DescribeWorkflowExecutionRequest{
+		Namespace: batchParams.Namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	// the activity logger is tagged with the batch workflow, so the reset workflow is tagged as reset base
+	logger := log.With(getActivityLogger(ctx), tag.NewStringTag("reset-wf-id", workflowID), tag.WorkflowResetBaseRunID(runID))
+	// resetting a previous run would terminate the current run
+	if resp.WorkflowExecutionInfo.GetExecution().GetRunId() != runID {
+		logger.Info("Skip resetting workflow which is not the current run")
+		return nil
+	}
+
+	badBinaries := &namespacepb.BadBinaries{
+		Binaries: map[string]*namespacepb.BadBinaryInfo{
+			batchParams.ResetBadBinaryParams.BinaryChecksum: {},
+		},
+	}
+	_, resetPoint := historyworkflow.FindAutoResetPoint(clock.NewRealTimeSource(), badBinaries, resp.WorkflowExecutionInfo.GetAutoResetPoints())
+	if resetPoint == nil {
+		logger.Info("Skip resetting workflow without resettable point for bad binary")
+		return nil
+	}
+
+	_, err = client.ResetWorkflowExecution(ctx, &workflowservice.ResetWorkflowExecutionRequest{
+		Namespace: batchParams.Namespace,
+		WorkflowExecution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      runID,
+		},
+		Reason:                    batchParams.Reason,
+		WorkflowTaskFinishEventId: resetPoint.GetFirstWorkflowTaskCompletedId(),
+		RequestId:                 requestID,
+		ResetReapplyType:          batchParams.ResetBadBinaryParams.ResetReapplyType,
+	})
+	return err
+}
+
 func isDone(ctx context.Context) bool {
 	select {
 	case <-ctx.Done():
@@ -0,0 +1,155 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package callback
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sdkclient "go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+)
+
+const (
+	// dlqWFTypeName is the workflow type of the completion callback DLQ
+	dlqWFTypeName       = "temporal-sys-completion-callback-dlq-workflow"
+	dlqWorkflowIDPrefix = "completion-callback-dlq"
+	dlqAddChannelName   = "CompletionCallbackDLQAddChannelName"
+	// DLQRedriveSignalName is the signal which redelivers all callbacks in DLQ
+	DLQRedriveSignalName = "redrive"
+	// DLQQueryType is the query type which returns callbacks in DLQ
+	DLQQueryType = "list"
+	// dlqMaxSize is the max number of callbacks kept in DLQ, the oldest callbacks are dropped
+	dlqMaxSize = 1000
+	// dlqMaxSignals is the number of signals after which DLQ workflow continues as new
+	dlqMaxSignals = 1000
+)
+
+type (
+	// DLQEntry is the completion callback which failed to be delivered
+	DLQEntry struct {
+		Request    Request
+		Error      string
+		FailedTime time.Time
+	}
+)
+
+var (
+	redriveActivityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    2 * deliveryTimeout,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 1,
+		},
+	}
+)
+
+// DLQWorkflowID returns workflow ID of the completion callback DLQ of the namespace.
+// DLQ workflow runs in the system namespace.
+func DLQWorkflowID(namespace string) string {
+	return fmt.Sprintf("%v-%v", dlqWorkflowIDPrefix, namespace)
+}
+
+// DLQWorkflow keeps completion callbacks which failed to be delivered. Callbacks can be listed
+// with DLQQueryType query and redelivered with DLQRedriveSignalName signal.
+func DLQWorkflow(ctx workflow.Context, entries []DLQEntry) error {
+	if err := workflow.SetQueryHandler(ctx, DLQQueryType, func() ([]DLQEntry, error) {
+		return entries, nil
+	}); err != nil {
+		return err
+	}
+
+	addCh := workflow.GetSignalChannel(ctx, dlqAddChannelName)
+	redriveCh := workflow.GetSignalChannel(ctx, DLQRedriveSignalName)
+	add := func(entry DLQEntry) {
+		entries = append(entries, entry)
+		if len(entries) > dlqMaxSize {
+			entries = entries[len(entries)-dlqMaxSize:]
+		}
+	}
+
+	selector := workflow.NewSelector(ctx)
+	selector.AddReceive(addCh, func(c workflow.ReceiveChannel, more bool) {
+		var entry DLQEntry
+		c.Receive(ctx, &entry)
+		add(entry)
+	})
+	selector.AddReceive(redriveCh, func(c workflow.ReceiveChannel, more bool) {
+		c.Receive(ctx, nil)
+		entries = redrive(ctx, entries)
+	})
+	for signals := 0; signals < dlqMaxSignals; signals++ {
+		selector.Select(ctx)
+	}
+
+	for {
+		var entry DLQEntry
+		if !addCh.ReceiveAsync(&entry) {
+			break
+		}
+		add(entry)
+	}
+	return workflow.NewContinueAsNewError(ctx, dlqWFTypeName, entries)
+}
+
+func redrive(ctx workflow.Context, entries []DLQEntry) []DLQEntry {
+	var failed []DLQEntry
+	opt := workflow.WithActivityOptions(ctx, redriveActivityOptions)
+	for _, entry := range entries {
+		if err := workflow.ExecuteActivity(opt, deliveryActivityName, entry.Request).Get(ctx, nil); err != nil {
+			entry.Error = err.Error()
+			entry.FailedTime = workflow.Now(ctx)
+			failed = append(failed, entry)
+		}
+	}
+	return failed
+}
+
+// DLQActivity is activity for sending completion callback to DLQ
+func DLQActivity(ctx context.Context, entry DLQEntry) error {
+	processor := ctx.Value(processorContextKey).(*Processor)
+	workflowID := DLQWorkflowID(entry.Request.Namespace)
+	workflowOptions := sdkclient.StartWorkflowOptions{
+		ID:                  workflowID,
+		TaskQueue:           processorTaskQueueName,
+		WorkflowTaskTimeout: time.Minute,
+	}
+	_, err := processor.svcClient.SignalWithStartWorkflow(ctx, workflowID, dlqAddChannelName, entry, workflowOptions, dlqWFTypeName, []DLQEntry(nil))
+	if err != nil {
+		processor.logger.Error("failed to send completion callback to DLQ",
+			tag.WorkflowNamespace(entry.Request.Namespace),
+			tag.WorkflowID(entry.Request.WorkflowID),
+			tag.WorkflowRunID(entry.Request.RunID),
+			tag.Error(err))
+		return err
+	}
+	processor.metricsClient.IncCounter(metrics.CallbackProcessorScope, metrics.CallbackProcessorDLQ)
+	return nil
+}
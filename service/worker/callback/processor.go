@@ -0,0 +1,90 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package callback
+
+import (
+	"context"
+	"net/http"
+
+	"go.temporal.io/sdk/activity"
+	sdkclient "go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+)
+
+type (
+	// BootstrapParams contains the set of params needed to bootstrap
+	// the sub-system
+	BootstrapParams struct {
+		// ServiceClient is an instance of temporal service client
+		ServiceClient sdkclient.Client
+		// MetricsClient is an instance of metrics object for emitting stats
+		MetricsClient metrics.Client
+		Logger        log.Logger
+		// AllowedHosts returns comma separated list of hosts callbacks of the namespace are allowed to be sent to
+		AllowedHosts dynamicconfig.StringPropertyFnWithNamespaceFilter
+	}
+
+	// Processor is the background sub-system that delivers completion callbacks
+	Processor struct {
+		svcClient     sdkclient.Client
+		metricsClient metrics.Client
+		logger        log.Logger
+		allowedHosts  dynamicconfig.StringPropertyFnWithNamespaceFilter
+		httpClient    *http.Client
+	}
+)
+
+// New returns a new instance as daemon
+func New(params *BootstrapParams) *Processor {
+	return &Processor{
+		svcClient:     params.ServiceClient,
+		metricsClient: params.MetricsClient,
+		logger:        log.With(params.Logger, tag.ComponentCallbackProcessor),
+		allowedHosts:  params.AllowedHosts,
+		httpClient:    &http.Client{Timeout: deliveryTimeout},
+	}
+}
+
+// Start starts the processor
+func (s *Processor) Start() error {
+	ctx := context.WithValue(context.Background(), processorContextKey, s)
+	workerOpts := worker.Options{
+		BackgroundActivityContext: ctx,
+	}
+	processorWorker := worker.New(s.svcClient, processorTaskQueueName, workerOpts)
+
+	processorWorker.RegisterWorkflowWithOptions(ProcessorWorkflow, workflow.RegisterOptions{Name: processorWFTypeName})
+	processorWorker.RegisterWorkflowWithOptions(DLQWorkflow, workflow.RegisterOptions{Name: dlqWFTypeName})
+	processorWorker.RegisterActivityWithOptions(DeliveryActivity, activity.RegisterOptions{Name: deliveryActivityName})
+	processorWorker.RegisterActivityWithOptions(DLQActivity, activity.RegisterOptions{Name: dlqActivityName})
+
+	return processorWorker.Start()
+}
@@ -0,0 +1,145 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package callback
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"text/template"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/payload"
+)
+
+const (
+	// MemoKey is the memo key of the completion callback spec. Workflow registers a callback
+	// by setting JSON encoded Spec under this key in the memo when it is started.
+	MemoKey = "TemporalCompletionCallback"
+)
+
+type (
+	// Spec defines the HTTP callback which is invoked when workflow is closed
+	Spec struct {
+		// URL is the callback URL, it is invoked with POST request with JSON encoded Notification body
+		URL string `json:"url"`
+		// Headers are request headers, values are text/template templates executed with Notification
+		Headers map[string]string `json:"headers,omitempty"`
+		// RetryPolicy overrides default retry policy of callback delivery
+		RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
+	}
+
+	// RetryPolicy defines how callback delivery is retried
+	RetryPolicy struct {
+		// InitialInterval is the interval of the first retry, i.e. "10s"
+		InitialInterval string `json:"initialInterval,omitempty"`
+		// MaximumInterval is the maximum retry interval, i.e. "5m"
+		MaximumInterval string `json:"maximumInterval,omitempty"`
+		// MaximumAttempts is the maximum number of delivery attempts before callback is sent to DLQ
+		MaximumAttempts int32 `json:"maximumAttempts,omitempty"`
+	}
+)
+
+// GetSpec returns callback spec from workflow memo, or nil if workflow didn't register callback
+func GetSpec(memo map[string]*commonpb.Payload) (*Spec, error) {
+	specPayload, ok := memo[MemoKey]
+	if !ok {
+		return nil, nil
+	}
+
+	var spec Spec
+	if err := payload.Decode(specPayload, &spec); err != nil {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("unable to decode completion callback: %v", err))
+	}
+	return &spec, nil
+}
+
+// Validate validates callback spec against allowed hosts of the namespace
+func (s *Spec) Validate(allowedHosts string) error {
+	callbackURL, err := url.Parse(s.URL)
+	if err != nil {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf("invalid completion callback URL: %v", err))
+	}
+	if callbackURL.Scheme != "http" && callbackURL.Scheme != "https" {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf("unsupported completion callback URL scheme: %v", callbackURL.Scheme))
+	}
+	if !IsHostAllowed(callbackURL.Hostname(), allowedHosts) {
+		return serviceerror.NewPermissionDenied(fmt.Sprintf("completion callback host %v is not allowed", callbackURL.Hostname()), "")
+	}
+	for name, value := range s.Headers {
+		if _, err := template.New(name).Parse(value); err != nil {
+			return serviceerror.NewInvalidArgument(fmt.Sprintf("invalid completion callback header %v: %v", name, err))
+		}
+	}
+	if s.RetryPolicy != nil {
+		if _, err := s.RetryPolicy.intervals(); err != nil {
+			return serviceerror.NewInvalidArgument(fmt.Sprintf("invalid completion callback retry policy: %v", err))
+		}
+	}
+	return nil
+}
+
+// IsHostAllowed checks host against comma separated list of allowed hosts.
+// "*" allows any host and "*.example.com" allows any subdomain of example.com.
+func IsHostAllowed(host string, allowedHosts string) bool {
+	host = strings.ToLower(host)
+	for _, allowed := range strings.Split(allowedHosts, ",") {
+		allowed = strings.ToLower(strings.TrimSpace(allowed))
+		switch {
+		case allowed == "":
+			continue
+		case allowed == "*":
+			return true
+		case strings.HasPrefix(allowed, "*."):
+			if strings.HasSuffix(host, allowed[1:]) {
+				return true
+			}
+		case host == allowed:
+			return true
+		}
+	}
+	return false
+}
+
+func (p *RetryPolicy) intervals() ([2]time.Duration, error) {
+	var result [2]time.Duration
+	for i, interval := range []string{p.InitialInterval, p.MaximumInterval} {
+		if interval == "" {
+			continue
+		}
+		d, err := time.ParseDuration(interval)
+		if err != nil {
+			return result, err
+		}
+		if d < 0 {
+			return result, fmt.Errorf("negative interval %v", interval)
+		}
+		result[i] = d
+	}
+	return result, nil
+}
@@ -0,0 +1,95 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package callback
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/payload"
+)
+
+type (
+	specSuite struct {
+		suite.Suite
+	}
+)
+
+func TestSpecSuite(t *testing.T) {
+	suite.Run(t, new(specSuite))
+}
+
+func (s *specSuite) TestGetSpec() {
+	spec, err := GetSpec(nil)
+	s.NoError(err)
+	s.Nil(spec)
+
+	expected := Spec{
+		URL:         "https://hooks.example.com/closed",
+		Headers:     map[string]string{"Authorization": "Bearer token"},
+		RetryPolicy: &RetryPolicy{InitialInterval: "1s", MaximumAttempts: 3},
+	}
+	specPayload, err := payload.Encode(expected)
+	s.NoError(err)
+	spec, err = GetSpec(map[string]*commonpb.Payload{MemoKey: specPayload})
+	s.NoError(err)
+	s.Equal(expected, *spec)
+
+	invalidPayload, err := payload.Encode("not a spec")
+	s.NoError(err)
+	_, err = GetSpec(map[string]*commonpb.Payload{MemoKey: invalidPayload})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
+func (s *specSuite) TestIsHostAllowed() {
+	s.False(IsHostAllowed("example.com", ""))
+	s.True(IsHostAllowed("example.com", "*"))
+	s.True(IsHostAllowed("example.com", "foo.com, Example.com"))
+	s.False(IsHostAllowed("hooks.example.com", "example.com"))
+	s.True(IsHostAllowed("hooks.example.com", "*.example.com"))
+	s.False(IsHostAllowed("example.com", "*.example.com"))
+	s.False(IsHostAllowed("hooksexample.com", "*.example.com"))
+}
+
+func (s *specSuite) TestValidate() {
+	spec := &Spec{URL: "https://hooks.example.com/closed"}
+	s.NoError(spec.Validate("*.example.com"))
+	s.IsType(&serviceerror.PermissionDenied{}, spec.Validate("other.com"))
+
+	spec = &Spec{URL: "ftp://hooks.example.com/closed"}
+	s.IsType(&serviceerror.InvalidArgument{}, spec.Validate("*"))
+
+	spec = &Spec{URL: "https://hooks.example.com", Headers: map[string]string{"X-Run-Id": "{{.RunID"}}
+	s.IsType(&serviceerror.InvalidArgument{}, spec.Validate("*"))
+
+	spec = &Spec{URL: "https://hooks.example.com", RetryPolicy: &RetryPolicy{MaximumInterval: "5 minutes"}}
+	s.IsType(&serviceerror.InvalidArgument{}, spec.Validate("*"))
+
+	spec = &Spec{URL: "https://hooks.example.com", RetryPolicy: &RetryPolicy{InitialInterval: "-1s"}}
+	s.IsType(&serviceerror.InvalidArgument{}, spec.Validate("*"))
+}
@@ -0,0 +1,249 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package callback
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"text/template"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+)
+
+const (
+	processorContextKey = "processorContext"
+	// processorTaskQueueName is the taskqueue name
+	processorTaskQueueName = "temporal-sys-processor-completion-callback"
+	// processorWFTypeName is the workflow type
+	processorWFTypeName   = "temporal-sys-completion-callback-workflow"
+	deliveryActivityName  = "temporal-sys-completion-callback-delivery-activity"
+	dlqActivityName       = "temporal-sys-completion-callback-dlq-activity"
+	processorChannelName  = "CompletionCallbackProcessorChannelName"
+	deliveryTimeout       = 30 * time.Second
+	maxResponseBodyLength = 64 * 1024
+	nonRetryableErrorType = "CompletionCallbackRejected"
+)
+
+type (
+	// Request defines the request for completion callback delivery
+	Request struct {
+		Namespace    string
+		NamespaceID  string
+		WorkflowID   string
+		RunID        string
+		WorkflowType string
+		Status       enumspb.WorkflowExecutionStatus
+		CloseTime    time.Time
+		Spec         Spec
+	}
+
+	// Notification is the body of callback request
+	Notification struct {
+		Namespace    string    `json:"namespace"`
+		WorkflowID   string    `json:"workflowId"`
+		RunID        string    `json:"runId"`
+		WorkflowType string    `json:"workflowType"`
+		Status       string    `json:"status"`
+		CloseTime    time.Time `json:"closeTime"`
+	}
+)
+
+var (
+	defaultRetryPolicy = temporal.RetryPolicy{
+		InitialInterval:    10 * time.Second,
+		BackoffCoefficient: 2,
+		MaximumInterval:    5 * time.Minute,
+		MaximumAttempts:    10,
+	}
+
+	dlqRetryPolicy = temporal.RetryPolicy{
+		InitialInterval:    10 * time.Second,
+		BackoffCoefficient: 1.7,
+		MaximumInterval:    5 * time.Minute,
+	}
+
+	dlqActivityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+		RetryPolicy:            &dlqRetryPolicy,
+	}
+)
+
+// ProcessorWorkflow is the workflow that delivers completion callbacks
+func ProcessorWorkflow(ctx workflow.Context) error {
+	requestCh := workflow.GetSignalChannel(ctx, processorChannelName)
+	wg := workflow.NewWaitGroup(ctx)
+	for {
+		received := false
+		for {
+			var request Request
+			if !requestCh.ReceiveAsync(&request) {
+				break
+			}
+			received = true
+
+			wg.Add(1)
+			workflow.Go(ctx, func(ctx workflow.Context) {
+				defer wg.Done()
+				deliverOrSendToDLQ(ctx, request)
+			})
+		}
+		if !received {
+			// no more request
+			return nil
+		}
+		wg.Wait(ctx)
+	}
+}
+
+func deliverOrSendToDLQ(ctx workflow.Context, request Request) {
+	opt := workflow.WithActivityOptions(ctx, deliveryActivityOptions(request.Spec.RetryPolicy))
+	err := workflow.ExecuteActivity(opt, deliveryActivityName, request).Get(ctx, nil)
+	if err == nil {
+		return
+	}
+
+	entry := DLQEntry{
+		Request:    request,
+		Error:      err.Error(),
+		FailedTime: workflow.Now(ctx),
+	}
+	opt = workflow.WithActivityOptions(ctx, dlqActivityOptions)
+	_ = workflow.ExecuteActivity(opt, dlqActivityName, entry).Get(ctx, nil)
+}
+
+func deliveryActivityOptions(policy *RetryPolicy) workflow.ActivityOptions {
+	retryPolicy := defaultRetryPolicy
+	retryPolicy.NonRetryableErrorTypes = []string{nonRetryableErrorType}
+	if policy != nil {
+		// policy is validated before request is sent
+		intervals, _ := policy.intervals()
+		if intervals[0] > 0 {
+			retryPolicy.InitialInterval = intervals[0]
+		}
+		if intervals[1] > 0 {
+			retryPolicy.MaximumInterval = intervals[1]
+		}
+		if policy.MaximumAttempts > 0 {
+			retryPolicy.MaximumAttempts = policy.MaximumAttempts
+		}
+	}
+	return workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    2 * deliveryTimeout,
+		RetryPolicy:            &retryPolicy,
+	}
+}
+
+// DeliveryActivity is activity for delivering completion callback
+func DeliveryActivity(ctx context.Context, request Request) error {
+	processor := ctx.Value(processorContextKey).(*Processor)
+	var err error
+	// allowed hosts could be changed since callback was accepted
+	if validateErr := request.Spec.Validate(processor.allowedHosts(request.Namespace)); validateErr != nil {
+		err = temporal.NewNonRetryableApplicationError(validateErr.Error(), nonRetryableErrorType, nil)
+	} else {
+		err = deliver(ctx, processor.httpClient, request)
+	}
+	if err != nil {
+		processor.metricsClient.IncCounter(metrics.CallbackProcessorScope, metrics.CallbackProcessorFailures)
+		processor.logger.Warn("failed to deliver completion callback",
+			tag.WorkflowNamespace(request.Namespace),
+			tag.WorkflowID(request.WorkflowID),
+			tag.WorkflowRunID(request.RunID),
+			tag.Attempt(activity.GetInfo(ctx).Attempt),
+			tag.Error(err))
+		return err
+	}
+	processor.metricsClient.IncCounter(metrics.CallbackProcessorScope, metrics.CallbackProcessorSuccess)
+	return nil
+}
+
+func deliver(ctx context.Context, httpClient *http.Client, request Request) error {
+	notification := Notification{
+		Namespace:    request.Namespace,
+		WorkflowID:   request.WorkflowID,
+		RunID:        request.RunID,
+		WorkflowType: request.WorkflowType,
+		Status:       request.Status.String(),
+		CloseTime:    request.CloseTime,
+	}
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return temporal.NewNonRetryableApplicationError(err.Error(), nonRetryableErrorType, nil)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, request.Spec.URL, bytes.NewReader(body))
+	if err != nil {
+		return temporal.NewNonRetryableApplicationError(err.Error(), nonRetryableErrorType, nil)
+	}
+	httpRequest.Header.Set("Content-Type", "application/json")
+	for name, value := range request.Spec.Headers {
+		headerTemplate, err := template.New(name).Parse(value)
+		if err != nil {
+			return temporal.NewNonRetryableApplicationError(err.Error(), nonRetryableErrorType, nil)
+		}
+		var header strings.Builder
+		if err := headerTemplate.Execute(&header, notification); err != nil {
+			return temporal.NewNonRetryableApplicationError(err.Error(), nonRetryableErrorType, nil)
+		}
+		httpRequest.Header.Set(name, header.String())
+	}
+
+	resp, err := httpClient.Do(httpRequest)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(ioutil.Discard, io.LimitReader(resp.Body, maxResponseBodyLength))
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode == http.StatusRequestTimeout,
+		resp.StatusCode == http.StatusTooManyRequests,
+		resp.StatusCode >= 500:
+		return fmt.Errorf("completion callback failed with status %v", resp.StatusCode)
+	default:
+		return temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("completion callback rejected with status %v", resp.StatusCode),
+			nonRetryableErrorType,
+			nil,
+		)
+	}
+}
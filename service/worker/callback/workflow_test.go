@@ -0,0 +1,133 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package callback
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/temporal"
+)
+
+type (
+	workflowSuite struct {
+		suite.Suite
+	}
+)
+
+func TestWorkflowSuite(t *testing.T) {
+	suite.Run(t, new(workflowSuite))
+}
+
+func (s *workflowSuite) TestDeliver() {
+	var notification Notification
+	var header string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.Equal(http.MethodPost, r.Method)
+		s.NoError(json.NewDecoder(r.Body).Decode(&notification))
+		header = r.Header.Get("X-Workflow")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	request := s.newRequest(server.URL)
+	request.Spec.Headers = map[string]string{"X-Workflow": "{{.WorkflowID}}/{{.RunID}}"}
+	s.NoError(deliver(context.Background(), server.Client(), request))
+	s.Equal("some random workflow ID/some random run ID", header)
+	s.Equal(request.Namespace, notification.Namespace)
+	s.Equal(request.WorkflowType, notification.WorkflowType)
+	s.Equal("Completed", notification.Status)
+	s.True(request.CloseTime.Equal(notification.CloseTime))
+}
+
+func (s *workflowSuite) TestDeliver_StatusCode() {
+	testCases := []struct {
+		statusCode   int
+		retryable    bool
+		nonRetryable bool
+	}{
+		{statusCode: http.StatusOK},
+		{statusCode: http.StatusRequestTimeout, retryable: true},
+		{statusCode: http.StatusTooManyRequests, retryable: true},
+		{statusCode: http.StatusServiceUnavailable, retryable: true},
+		{statusCode: http.StatusBadRequest, nonRetryable: true},
+		{statusCode: http.StatusNotFound, nonRetryable: true},
+	}
+
+	for _, tc := range testCases {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(tc.statusCode)
+		}))
+
+		err := deliver(context.Background(), server.Client(), s.newRequest(server.URL))
+		server.Close()
+
+		switch {
+		case tc.retryable:
+			s.Error(err, tc.statusCode)
+			var appErr *temporal.ApplicationError
+			s.False(errors.As(err, &appErr), tc.statusCode)
+		case tc.nonRetryable:
+			var appErr *temporal.ApplicationError
+			s.True(errors.As(err, &appErr), tc.statusCode)
+			s.True(appErr.NonRetryable())
+			s.Equal(nonRetryableErrorType, appErr.Type())
+		default:
+			s.NoError(err, tc.statusCode)
+		}
+	}
+}
+
+func (s *workflowSuite) TestDeliveryActivityOptions() {
+	options := deliveryActivityOptions(nil)
+	s.Equal(defaultRetryPolicy.InitialInterval, options.RetryPolicy.InitialInterval)
+	s.Equal(defaultRetryPolicy.MaximumAttempts, options.RetryPolicy.MaximumAttempts)
+	s.Equal([]string{nonRetryableErrorType}, options.RetryPolicy.NonRetryableErrorTypes)
+
+	options = deliveryActivityOptions(&RetryPolicy{MaximumInterval: "1m", MaximumAttempts: 3})
+	s.Equal(defaultRetryPolicy.InitialInterval, options.RetryPolicy.InitialInterval)
+	s.Equal(time.Minute, options.RetryPolicy.MaximumInterval)
+	s.Equal(int32(3), options.RetryPolicy.MaximumAttempts)
+}
+
+func (s *workflowSuite) newRequest(url string) Request {
+	return Request{
+		Namespace:    "some random namespace",
+		NamespaceID:  "some random namespace ID",
+		WorkflowID:   "some random workflow ID",
+		RunID:        "some random run ID",
+		WorkflowType: "some random workflow type",
+		Status:       enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		CloseTime:    time.Now().UTC(),
+		Spec:         Spec{URL: url},
+	}
+}
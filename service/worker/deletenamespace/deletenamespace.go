@@ -0,0 +1,99 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package deletenamespace
+
+import (
+	"go.temporal.io/api/workflowservice/v1"
+	sdkclient "go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+)
+
+type (
+	// deleteNamespace is the background sub-system that executes workflows to delete
+	// data of deleted namespaces.
+	deleteNamespace struct {
+		sdkClient                 sdkclient.Client
+		frontendClient            workflowservice.WorkflowServiceClient
+		historyClient             historyservice.HistoryServiceClient
+		metadataManager           persistence.MetadataManager
+		taskManager               persistence.TaskManager
+		taskQueueListingSupported bool
+		metricsClient             metrics.Client
+		logger                    log.Logger
+	}
+)
+
+// New returns a new instance of deleteNamespace. Task queues are only deleted if
+// taskQueueListingSupported is set, i.e. the persistence store can list task queues.
+func New(
+	sdkClient sdkclient.Client,
+	frontendClient workflowservice.WorkflowServiceClient,
+	historyClient historyservice.HistoryServiceClient,
+	metadataManager persistence.MetadataManager,
+	taskManager persistence.TaskManager,
+	taskQueueListingSupported bool,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) *deleteNamespace {
+	return &deleteNamespace{
+		sdkClient:                 sdkClient,
+		frontendClient:            frontendClient,
+		historyClient:             historyClient,
+		metadataManager:           metadataManager,
+		taskManager:               taskManager,
+		taskQueueListingSupported: taskQueueListingSupported,
+		metricsClient:             metricsClient,
+		logger:                    log.With(logger, tag.ComponentDeleteNamespace),
+	}
+}
+
+// Start service.
+func (s *deleteNamespace) Start() error {
+	workerOpts := worker.Options{}
+
+	wrk := worker.New(s.sdkClient, TaskQueueName, workerOpts)
+
+	a := newActivities(
+		s.frontendClient,
+		s.historyClient,
+		s.metadataManager,
+		s.taskManager,
+		s.taskQueueListingSupported,
+		s.metricsClient,
+		s.logger,
+	)
+
+	wrk.RegisterWorkflowWithOptions(DeleteNamespaceWorkflow, workflow.RegisterOptions{Name: WorkflowName})
+	wrk.RegisterActivity(a)
+
+	return wrk.Start()
+}
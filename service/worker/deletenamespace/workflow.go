@@ -0,0 +1,476 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package deletenamespace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	"golang.org/x/time/rate"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+)
+
+const (
+	// TaskQueueName is the task queue name.
+	TaskQueueName = "temporal-sys-delete-namespace-task-queue"
+	// WorkflowName is the workflow name.
+	WorkflowName = "temporal-sys-delete-namespace-workflow"
+
+	// DefaultRPS is the default number of workflows deleted per second.
+	DefaultRPS = 10
+	// DefaultPageSize is the default page size used to list workflows and task queues.
+	DefaultPageSize = 1000
+	// DefaultActivityHeartBeatTimeout is the default value for ActivityHeartBeatTimeout.
+	DefaultActivityHeartBeatTimeout = 30 * time.Second
+
+	// infiniteDuration is a long duration(20 yrs) used for the activity which deletes all data of namespace.
+	infiniteDuration = 20 * 365 * 24 * time.Hour
+	// terminateReason is the reason recorded for workflows terminated because their namespace is deleted.
+	terminateReason = "namespace is deleted"
+)
+
+const (
+	// PhaseOpen is the phase terminating and deleting open workflows.
+	PhaseOpen = "open"
+	// PhaseClosed is the phase deleting closed workflows.
+	PhaseClosed = "closed"
+	// PhaseTaskQueues is the phase deleting task queues.
+	PhaseTaskQueues = "task-queues"
+	// PhaseNamespace is the phase deleting namespace record.
+	PhaseNamespace = "namespace"
+	// PhaseDone indicates all data of namespace is deleted.
+	PhaseDone = "done"
+)
+
+type (
+	// WorkflowParams is the parameters for delete namespace workflow.
+	WorkflowParams struct {
+		// Namespace to delete, it must have been marked as deleted.
+		Namespace   string
+		NamespaceID string
+		// Number of workflows deleted per second. Default to DefaultRPS.
+		RPS int
+		// Page size used to list workflows and task queues. Default to DefaultPageSize.
+		PageSize int
+		// Timeout for activity heartbeat. Default to DefaultActivityHeartBeatTimeout.
+		ActivityHeartBeatTimeout time.Duration
+	}
+
+	// Progress is the progress of namespace deletion, it is recorded as heartbeat details
+	// of the deletion activity and returned as the workflow result.
+	Progress struct {
+		// Phase is one of PhaseOpen, PhaseClosed, PhaseTaskQueues, PhaseNamespace and PhaseDone.
+		Phase       string
+		PageToken   []byte
+		CurrentPage int
+		// Number of workflows deleted in the current pass over open and closed workflows. Workflows are
+		// listed again until a pass deletes nothing, as visibility records are deleted asynchronously.
+		PassDeletedCount int
+		// Number of open workflows which were terminated before deletion.
+		TerminatedCount int
+		DeletedCount    int
+		// Number of listed workflows which were not found anymore.
+		SkippedCount          int
+		DeletedTaskQueueCount int
+		// Archival URIs of the namespace. Archived histories and visibility records, including those of
+		// workflows terminated by the deletion, are not deleted and have to be removed from the archival
+		// stores separately.
+		HistoryArchivalURI    string
+		VisibilityArchivalURI string
+	}
+
+	activities struct {
+		frontendClient            workflowservice.WorkflowServiceClient
+		historyClient             historyservice.HistoryServiceClient
+		metadataManager           persistence.MetadataManager
+		taskManager               persistence.TaskManager
+		taskQueueListingSupported bool
+		metricsClient             metrics.Client
+		logger                    log.Logger
+	}
+)
+
+var (
+	deleteNamespaceActivityRetryPolicy = temporal.RetryPolicy{
+		InitialInterval:    10 * time.Second,
+		BackoffCoefficient: 1.7,
+		MaximumInterval:    5 * time.Minute,
+	}
+
+	ErrInvalidParams           = errors.New("invalid delete namespace parameters")
+	ErrNamespaceNotDeleted     = errors.New("namespace is not marked as deleted")
+	ErrUnableToExecuteActivity = errors.New("unable to execute activity")
+	ErrNamespaceNotActive      = errors.New("namespace is not active in this cluster")
+)
+
+// WorkflowID returns the id of the delete namespace workflow of a namespace.
+func WorkflowID(namespace string) string {
+	return WorkflowName + "-" + namespace
+}
+
+func newActivities(
+	frontendClient workflowservice.WorkflowServiceClient,
+	historyClient historyservice.HistoryServiceClient,
+	metadataManager persistence.MetadataManager,
+	taskManager persistence.TaskManager,
+	taskQueueListingSupported bool,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) *activities {
+	return &activities{
+		frontendClient:            frontendClient,
+		historyClient:             historyClient,
+		metadataManager:           metadataManager,
+		taskManager:               taskManager,
+		taskQueueListingSupported: taskQueueListingSupported,
+		metricsClient:             metricsClient,
+		logger:                    logger,
+	}
+}
+
+// DeleteNamespaceWorkflow is the workflow that deletes workflows, task queues and finally the record
+// of a namespace which was marked as deleted.
+func DeleteNamespaceWorkflow(ctx workflow.Context, params WorkflowParams) (Progress, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Workflow started.", "wf-type", WorkflowName, "namespace", params.Namespace)
+
+	if err := validateParams(params); err != nil {
+		return Progress{}, err
+	}
+	params = setDefaultParams(params)
+
+	var a *activities
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		ScheduleToStartTimeout: 5 * time.Minute,
+		StartToCloseTimeout:    infiniteDuration,
+		HeartbeatTimeout:       params.ActivityHeartBeatTimeout,
+		RetryPolicy:            &deleteNamespaceActivityRetryPolicy,
+	})
+	var progress Progress
+	err := workflow.ExecuteActivity(ctx, a.DeleteNamespaceActivity, params).Get(ctx, &progress)
+	if err != nil {
+		return Progress{}, fmt.Errorf("%w: DeleteNamespaceActivity: %v", ErrUnableToExecuteActivity, err)
+	}
+
+	logger.Info("Workflow finished successfully.", "wf-type", WorkflowName, "deleted", progress.DeletedCount, "skipped", progress.SkippedCount)
+	return progress, nil
+}
+
+func validateParams(params WorkflowParams) error {
+	if params.Namespace == "" || params.NamespaceID == "" {
+		return fmt.Errorf("%w: namespace is not set", ErrInvalidParams)
+	}
+	if params.RPS < 0 || params.PageSize < 0 || params.ActivityHeartBeatTimeout < 0 {
+		return fmt.Errorf("%w: negative value is not allowed", ErrInvalidParams)
+	}
+	return nil
+}
+
+func setDefaultParams(params WorkflowParams) WorkflowParams {
+	if params.RPS == 0 {
+		params.RPS = DefaultRPS
+	}
+	if params.PageSize == 0 {
+		params.PageSize = DefaultPageSize
+	}
+	if params.ActivityHeartBeatTimeout == 0 {
+		params.ActivityHeartBeatTimeout = DefaultActivityHeartBeatTimeout
+	}
+	return params
+}
+
+// DeleteNamespaceActivity deletes all data of a deleted namespace phase by phase. Open workflows are terminated
+// before they are deleted. Progress is recorded at page boundaries, so a retried activity processes at most
+// one page again. Data in archival stores is not deleted, the archival URIs are reported in the progress instead.
+func (a *activities) DeleteNamespaceActivity(ctx context.Context, params WorkflowParams) (Progress, error) {
+	logger := log.With(a.logger, tag.WorkflowNamespace(params.Namespace), tag.WorkflowNamespaceID(params.NamespaceID))
+	scope := a.metricsClient.Scope(metrics.DeleteNamespaceScope, metrics.NamespaceTag(params.Namespace))
+
+	progress := Progress{Phase: PhaseOpen}
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &progress); err != nil {
+			scope.IncCounter(metrics.DeleteNamespaceFailures)
+			logger.Error("Unable to recover progress from last heartbeat, start over from beginning.", tag.Error(err))
+			progress = Progress{Phase: PhaseOpen}
+		}
+	}
+
+	// the namespace record is read from database, namespace cache might not have the deleted state yet
+	resp, err := a.metadataManager.GetNamespace(&persistence.GetNamespaceRequest{ID: params.NamespaceID})
+	switch err.(type) {
+	case nil:
+		if resp.Namespace.Info.State != enumspb.NAMESPACE_STATE_DELETED {
+			return Progress{}, temporal.NewNonRetryableApplicationError(fmt.Sprintf("%v: %v", ErrNamespaceNotDeleted, params.Namespace), "", nil)
+		}
+		progress.HistoryArchivalURI = resp.Namespace.Config.GetHistoryArchivalUri()
+		progress.VisibilityArchivalURI = resp.Namespace.Config.GetVisibilityArchivalUri()
+		if progress.HistoryArchivalURI != "" || progress.VisibilityArchivalURI != "" {
+			logger.Warn("Archived data of namespace is not deleted.",
+				tag.NewStringTag("history-archival-uri", progress.HistoryArchivalURI),
+				tag.NewStringTag("visibility-archival-uri", progress.VisibilityArchivalURI),
+			)
+		}
+	case *serviceerror.NotFound:
+		// namespace record is deleted last, nothing is left to delete
+		progress.Phase = PhaseDone
+	default:
+		return Progress{}, err
+	}
+
+	rateLimiter := rate.NewLimiter(rate.Limit(params.RPS), params.RPS)
+	for progress.Phase != PhaseDone {
+		var err error
+		switch progress.Phase {
+		case PhaseOpen, PhaseClosed:
+			err = a.deleteWorkflows(ctx, params, &progress, rateLimiter, scope)
+		case PhaseTaskQueues:
+			err = a.deleteTaskQueues(params, &progress, scope)
+		case PhaseNamespace:
+			err = a.metadataManager.DeleteNamespace(&persistence.DeleteNamespaceRequest{ID: params.NamespaceID})
+			if err == nil {
+				progress.Phase = PhaseDone
+			}
+		default:
+			err = temporal.NewNonRetryableApplicationError(fmt.Sprintf("unknown delete namespace phase: %v", progress.Phase), "", nil)
+		}
+		if err != nil {
+			scope.IncCounter(metrics.DeleteNamespaceFailures)
+			logger.Error("Unable to delete namespace data.", tag.Value(progress.Phase), tag.Error(err))
+			return Progress{}, err
+		}
+		activity.RecordHeartbeat(ctx, progress)
+	}
+
+	logger.Info("Delete namespace finished.", tag.Counter(progress.DeletedCount))
+	return progress, nil
+}
+
+// deleteWorkflows processes one page of open or closed workflows.
+func (a *activities) deleteWorkflows(
+	ctx context.Context,
+	params WorkflowParams,
+	progress *Progress,
+	rateLimiter *rate.Limiter,
+	scope metrics.Scope,
+) error {
+	executions, nextPageToken, err := a.listWorkflows(ctx, params, *progress)
+	if err != nil {
+		return err
+	}
+
+	for _, execution := range executions {
+		if err := rateLimiter.Wait(ctx); err != nil {
+			return err
+		}
+		if progress.Phase == PhaseOpen {
+			terminated, err := a.terminateWorkflow(ctx, params, execution)
+			if err != nil {
+				return err
+			}
+			if terminated {
+				progress.TerminatedCount++
+				scope.IncCounter(metrics.DeleteNamespaceWorkflowsTerminatedCount)
+			}
+		}
+		_, err := a.historyClient.DeleteWorkflowExecution(ctx, &historyservice.DeleteWorkflowExecutionRequest{
+			NamespaceId:       params.NamespaceID,
+			WorkflowExecution: execution,
+		})
+		switch err.(type) {
+		case nil:
+			progress.DeletedCount++
+			progress.PassDeletedCount++
+			scope.IncCounter(metrics.DeleteNamespaceWorkflowsDeletedCount)
+		case *serviceerror.NotFound:
+			progress.SkippedCount++
+			scope.IncCounter(metrics.DeleteNamespaceWorkflowsSkippedCount)
+		default:
+			return err
+		}
+		activity.RecordHeartbeat(ctx, *progress)
+	}
+
+	progress.CurrentPage++
+	progress.PageToken = nextPageToken
+	if len(nextPageToken) == 0 {
+		progress.Phase = nextPhase(*progress, a.taskQueueListingSupported)
+		if progress.Phase == PhaseOpen {
+			progress.PassDeletedCount = 0
+		}
+	}
+	return nil
+}
+
+// terminateWorkflow returns whether the workflow was terminated. Workflows can't be terminated in clusters
+// where namespace is not active, the activity fails and is retried until they are closed by replication.
+func (a *activities) terminateWorkflow(
+	ctx context.Context,
+	params WorkflowParams,
+	execution *commonpb.WorkflowExecution,
+) (bool, error) {
+	_, err := a.historyClient.TerminateWorkflowExecution(ctx, &historyservice.TerminateWorkflowExecutionRequest{
+		NamespaceId: params.NamespaceID,
+		TerminateRequest: &workflowservice.TerminateWorkflowExecutionRequest{
+			Namespace:         params.Namespace,
+			WorkflowExecution: execution,
+			Reason:            terminateReason,
+			Identity:          WorkflowName,
+		},
+	})
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case *serviceerror.NotFound:
+		return false, nil
+	case *serviceerror.NamespaceNotActive:
+		return false, fmt.Errorf("%w: workflow %v is still running", ErrNamespaceNotActive, execution.GetWorkflowId())
+	default:
+		return false, err
+	}
+}
+
+func (a *activities) listWorkflows(ctx context.Context, params WorkflowParams, progress Progress) ([]*commonpb.WorkflowExecution, []byte, error) {
+	var executions []*commonpb.WorkflowExecution
+	switch progress.Phase {
+	case PhaseOpen:
+		resp, err := a.frontendClient.ListOpenWorkflowExecutions(ctx, &workflowservice.ListOpenWorkflowExecutionsRequest{
+			Namespace:       params.Namespace,
+			MaximumPageSize: int32(params.PageSize),
+			NextPageToken:   progress.PageToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, info := range resp.GetExecutions() {
+			executions = append(executions, info.GetExecution())
+		}
+		return executions, resp.GetNextPageToken(), nil
+	default:
+		resp, err := a.frontendClient.ListClosedWorkflowExecutions(ctx, &workflowservice.ListClosedWorkflowExecutionsRequest{
+			Namespace:       params.Namespace,
+			MaximumPageSize: int32(params.PageSize),
+			NextPageToken:   progress.PageToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, info := range resp.GetExecutions() {
+			executions = append(executions, info.GetExecution())
+		}
+		return executions, resp.GetNextPageToken(), nil
+	}
+}
+
+// deleteTaskQueues processes one page of task queues, task queues of other namespaces are skipped.
+// Tasks are deleted before the task queue itself.
+func (a *activities) deleteTaskQueues(params WorkflowParams, progress *Progress, scope metrics.Scope) error {
+	resp, err := a.taskManager.ListTaskQueue(&persistence.ListTaskQueueRequest{
+		PageSize:  params.PageSize,
+		PageToken: progress.PageToken,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, item := range resp.Items {
+		if item.Data.GetNamespaceId() != params.NamespaceID {
+			continue
+		}
+		key := &persistence.TaskQueueKey{
+			NamespaceID: item.Data.GetNamespaceId(),
+			Name:        item.Data.GetName(),
+			TaskType:    item.Data.GetTaskType(),
+		}
+		for {
+			n, err := a.taskManager.CompleteTasksLessThan(&persistence.CompleteTasksLessThanRequest{
+				NamespaceID:   key.NamespaceID,
+				TaskQueueName: key.Name,
+				TaskType:      key.TaskType,
+				TaskID:        math.MaxInt64,
+				Limit:         params.PageSize,
+			})
+			if err != nil {
+				return err
+			}
+			if n < params.PageSize {
+				break
+			}
+		}
+		err := a.taskManager.DeleteTaskQueue(&persistence.DeleteTaskQueueRequest{
+			TaskQueue: key,
+			RangeID:   item.RangeID,
+		})
+		switch err.(type) {
+		case nil:
+			progress.DeletedTaskQueueCount++
+			scope.IncCounter(metrics.DeleteNamespaceTaskQueuesDeletedCount)
+		case *persistence.ConditionFailedError:
+			// task queue is still owned by matching, it is deleted by task queue scavenger once idle
+		default:
+			return err
+		}
+	}
+
+	progress.CurrentPage++
+	progress.PageToken = resp.NextPageToken
+	if len(resp.NextPageToken) == 0 {
+		progress.Phase = PhaseNamespace
+	}
+	return nil
+}
+
+func nextPhase(progress Progress, taskQueueListingSupported bool) string {
+	switch progress.Phase {
+	case PhaseOpen:
+		return PhaseClosed
+	case PhaseClosed:
+		if progress.PassDeletedCount > 0 {
+			return PhaseOpen
+		}
+		if taskQueueListingSupported {
+			return PhaseTaskQueues
+		}
+		return PhaseNamespace
+	case PhaseTaskQueues:
+		return PhaseNamespace
+	default:
+		return PhaseDone
+	}
+}
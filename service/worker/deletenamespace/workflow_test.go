@@ -0,0 +1,279 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package deletenamespace
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/api/workflowservicemock/v1"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+)
+
+const (
+	testNamespace   = "test-namespace"
+	testNamespaceID = "test-namespace-id"
+)
+
+type (
+	workflowSuite struct {
+		suite.Suite
+		testsuite.WorkflowTestSuite
+
+		controller          *gomock.Controller
+		mockFrontendClient  *workflowservicemock.MockWorkflowServiceClient
+		mockHistoryClient   *historyservicemock.MockHistoryServiceClient
+		mockMetadataManager *persistence.MockMetadataManager
+		mockTaskManager     *persistence.MockTaskManager
+
+		activities *activities
+	}
+)
+
+func TestWorkflowSuite(t *testing.T) {
+	suite.Run(t, new(workflowSuite))
+}
+
+func (s *workflowSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.mockFrontendClient = workflowservicemock.NewMockWorkflowServiceClient(s.controller)
+	s.mockHistoryClient = historyservicemock.NewMockHistoryServiceClient(s.controller)
+	s.mockMetadataManager = persistence.NewMockMetadataManager(s.controller)
+	s.mockTaskManager = persistence.NewMockTaskManager(s.controller)
+
+	s.activities = newActivities(
+		s.mockFrontendClient,
+		s.mockHistoryClient,
+		s.mockMetadataManager,
+		s.mockTaskManager,
+		true,
+		metrics.NewNoopMetricsClient(),
+		log.NewNoopLogger(),
+	)
+}
+
+func (s *workflowSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *workflowSuite) TestDeleteNamespaceWorkflow() {
+	env := s.NewTestWorkflowEnvironment()
+	env.RegisterActivity(s.activities)
+	expectedProgress := Progress{Phase: PhaseDone, CurrentPage: 3, DeletedCount: 10}
+	env.OnActivity(s.activities.DeleteNamespaceActivity, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, params WorkflowParams) (Progress, error) {
+			s.Equal(testNamespace, params.Namespace)
+			s.Equal(testNamespaceID, params.NamespaceID)
+			s.Equal(DefaultRPS, params.RPS)
+			s.Equal(DefaultPageSize, params.PageSize)
+			return expectedProgress, nil
+		})
+
+	env.ExecuteWorkflow(DeleteNamespaceWorkflow, WorkflowParams{Namespace: testNamespace, NamespaceID: testNamespaceID})
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	var progress Progress
+	s.NoError(env.GetWorkflowResult(&progress))
+	s.Equal(expectedProgress, progress)
+}
+
+func (s *workflowSuite) TestDeleteNamespaceWorkflow_InvalidParams() {
+	env := s.NewTestWorkflowEnvironment()
+	env.RegisterActivity(s.activities)
+
+	env.ExecuteWorkflow(DeleteNamespaceWorkflow, WorkflowParams{Namespace: testNamespace})
+	s.True(env.IsWorkflowCompleted())
+	s.Error(env.GetWorkflowError())
+}
+
+func (s *workflowSuite) TestDeleteNamespaceActivity() {
+	s.mockMetadataManager.EXPECT().GetNamespace(&persistence.GetNamespaceRequest{ID: testNamespaceID}).Return(s.newGetNamespaceResponse(enumspb.NAMESPACE_STATE_DELETED), nil)
+
+	openExecution := &commonpb.WorkflowExecution{WorkflowId: "workflow-1", RunId: "run-1"}
+	closedExecution := &commonpb.WorkflowExecution{WorkflowId: "workflow-2", RunId: "run-2"}
+	gomock.InOrder(
+		// first pass deletes both workflows
+		s.mockFrontendClient.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&workflowservice.ListOpenWorkflowExecutionsResponse{
+			Executions: []*workflowpb.WorkflowExecutionInfo{{Execution: openExecution}},
+		}, nil),
+		s.mockFrontendClient.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&workflowservice.ListClosedWorkflowExecutionsResponse{
+			Executions: []*workflowpb.WorkflowExecutionInfo{{Execution: closedExecution}},
+		}, nil),
+		// second pass only finds the visibility record of a deleted workflow
+		s.mockFrontendClient.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&workflowservice.ListOpenWorkflowExecutionsResponse{}, nil),
+		s.mockFrontendClient.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&workflowservice.ListClosedWorkflowExecutionsResponse{
+			Executions: []*workflowpb.WorkflowExecutionInfo{{Execution: closedExecution}},
+		}, nil),
+	)
+	s.mockHistoryClient.EXPECT().TerminateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.TerminateWorkflowExecutionRequest, _ ...interface{}) (*historyservice.TerminateWorkflowExecutionResponse, error) {
+			s.Equal(testNamespaceID, request.GetNamespaceId())
+			s.Equal(openExecution, request.GetTerminateRequest().GetWorkflowExecution())
+			return &historyservice.TerminateWorkflowExecutionResponse{}, nil
+		})
+	gomock.InOrder(
+		s.mockHistoryClient.EXPECT().DeleteWorkflowExecution(gomock.Any(), &historyservice.DeleteWorkflowExecutionRequest{
+			NamespaceId:       testNamespaceID,
+			WorkflowExecution: openExecution,
+		}).Return(&historyservice.DeleteWorkflowExecutionResponse{}, nil),
+		s.mockHistoryClient.EXPECT().DeleteWorkflowExecution(gomock.Any(), &historyservice.DeleteWorkflowExecutionRequest{
+			NamespaceId:       testNamespaceID,
+			WorkflowExecution: closedExecution,
+		}).Return(&historyservice.DeleteWorkflowExecutionResponse{}, nil),
+		s.mockHistoryClient.EXPECT().DeleteWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound("workflow not found")),
+	)
+
+	s.mockTaskManager.EXPECT().ListTaskQueue(gomock.Any()).Return(&persistence.ListTaskQueueResponse{
+		Items: []*persistence.PersistedTaskQueueInfo{
+			{Data: &persistencespb.TaskQueueInfo{NamespaceId: testNamespaceID, Name: "task-queue", TaskType: enumspb.TASK_QUEUE_TYPE_WORKFLOW}, RangeID: 5},
+			{Data: &persistencespb.TaskQueueInfo{NamespaceId: "other-namespace-id", Name: "task-queue", TaskType: enumspb.TASK_QUEUE_TYPE_WORKFLOW}, RangeID: 7},
+		},
+	}, nil)
+	s.mockTaskManager.EXPECT().CompleteTasksLessThan(&persistence.CompleteTasksLessThanRequest{
+		NamespaceID:   testNamespaceID,
+		TaskQueueName: "task-queue",
+		TaskType:      enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+		TaskID:        math.MaxInt64,
+		Limit:         DefaultPageSize,
+	}).Return(0, nil)
+	s.mockTaskManager.EXPECT().DeleteTaskQueue(&persistence.DeleteTaskQueueRequest{
+		TaskQueue: &persistence.TaskQueueKey{NamespaceID: testNamespaceID, Name: "task-queue", TaskType: enumspb.TASK_QUEUE_TYPE_WORKFLOW},
+		RangeID:   5,
+	}).Return(nil)
+	s.mockMetadataManager.EXPECT().DeleteNamespace(&persistence.DeleteNamespaceRequest{ID: testNamespaceID}).Return(nil)
+
+	env := s.NewTestActivityEnvironment()
+	env.RegisterActivity(s.activities)
+	val, err := env.ExecuteActivity(s.activities.DeleteNamespaceActivity, s.newParams())
+	s.NoError(err)
+	var progress Progress
+	s.NoError(val.Get(&progress))
+	s.Equal(Progress{
+		Phase:                 PhaseDone,
+		CurrentPage:           5,
+		TerminatedCount:       1,
+		DeletedCount:          2,
+		SkippedCount:          1,
+		DeletedTaskQueueCount: 1,
+	}, progress)
+}
+
+func (s *workflowSuite) TestDeleteNamespaceActivity_NamespaceNotActive() {
+	s.mockMetadataManager.EXPECT().GetNamespace(gomock.Any()).Return(s.newGetNamespaceResponse(enumspb.NAMESPACE_STATE_DELETED), nil)
+
+	openExecution := &commonpb.WorkflowExecution{WorkflowId: "workflow-1", RunId: "run-1"}
+	s.mockFrontendClient.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&workflowservice.ListOpenWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{{Execution: openExecution}},
+	}, nil)
+	s.mockHistoryClient.EXPECT().TerminateWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNamespaceNotActive(testNamespace, "standby", "active"))
+	// running workflow is not deleted in standby cluster
+	s.mockHistoryClient.EXPECT().DeleteWorkflowExecution(gomock.Any(), gomock.Any()).Times(0)
+
+	env := s.NewTestActivityEnvironment()
+	env.RegisterActivity(s.activities)
+	_, err := env.ExecuteActivity(s.activities.DeleteNamespaceActivity, s.newParams())
+	var appErr *temporal.ApplicationError
+	s.True(errors.As(err, &appErr))
+	s.False(appErr.NonRetryable())
+	s.Contains(appErr.Error(), ErrNamespaceNotActive.Error())
+}
+
+func (s *workflowSuite) TestDeleteNamespaceActivity_ArchivalURIs() {
+	resp := s.newGetNamespaceResponse(enumspb.NAMESPACE_STATE_DELETED)
+	resp.Namespace.Config = &persistencespb.NamespaceConfig{
+		HistoryArchivalState:    enumspb.ARCHIVAL_STATE_ENABLED,
+		HistoryArchivalUri:      "file:///tmp/history",
+		VisibilityArchivalState: enumspb.ARCHIVAL_STATE_DISABLED,
+		VisibilityArchivalUri:   "file:///tmp/visibility",
+	}
+	s.mockMetadataManager.EXPECT().GetNamespace(gomock.Any()).Return(resp, nil)
+	s.mockMetadataManager.EXPECT().DeleteNamespace(&persistence.DeleteNamespaceRequest{ID: testNamespaceID}).Return(nil)
+
+	env := s.NewTestActivityEnvironment()
+	env.RegisterActivity(s.activities)
+	env.SetHeartbeatDetails(Progress{Phase: PhaseNamespace})
+	val, err := env.ExecuteActivity(s.activities.DeleteNamespaceActivity, s.newParams())
+	s.NoError(err)
+	var progress Progress
+	s.NoError(val.Get(&progress))
+	s.Equal(PhaseDone, progress.Phase)
+	s.Equal("file:///tmp/history", progress.HistoryArchivalURI)
+	s.Equal("file:///tmp/visibility", progress.VisibilityArchivalURI)
+}
+
+func (s *workflowSuite) TestDeleteNamespaceActivity_NamespaceNotDeleted() {
+	s.mockMetadataManager.EXPECT().GetNamespace(gomock.Any()).Return(s.newGetNamespaceResponse(enumspb.NAMESPACE_STATE_REGISTERED), nil)
+
+	env := s.NewTestActivityEnvironment()
+	env.RegisterActivity(s.activities)
+	_, err := env.ExecuteActivity(s.activities.DeleteNamespaceActivity, s.newParams())
+	var appErr *temporal.ApplicationError
+	s.True(errors.As(err, &appErr))
+	s.True(appErr.NonRetryable())
+}
+
+func (s *workflowSuite) TestDeleteNamespaceActivity_NamespaceRecordDeleted() {
+	s.mockMetadataManager.EXPECT().GetNamespace(gomock.Any()).Return(nil, serviceerror.NewNotFound("namespace not found"))
+
+	env := s.NewTestActivityEnvironment()
+	env.RegisterActivity(s.activities)
+	val, err := env.ExecuteActivity(s.activities.DeleteNamespaceActivity, s.newParams())
+	s.NoError(err)
+	var progress Progress
+	s.NoError(val.Get(&progress))
+	s.Equal(PhaseDone, progress.Phase)
+}
+
+func (s *workflowSuite) newParams() WorkflowParams {
+	params := setDefaultParams(WorkflowParams{Namespace: testNamespace, NamespaceID: testNamespaceID})
+	params.RPS = 1000
+	return params
+}
+
+func (s *workflowSuite) newGetNamespaceResponse(state enumspb.NamespaceState) *persistence.GetNamespaceResponse {
+	return &persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{Id: testNamespaceID, Name: testNamespace, State: state},
+		},
+	}
+}
@@ -0,0 +1,326 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package forcereplication
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	filterpb "go.temporal.io/api/filter/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	"golang.org/x/time/rate"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+)
+
+const (
+	// TaskQueueName is the task queue name.
+	TaskQueueName = "temporal-sys-force-replication-task-queue"
+	// WorkflowName is the workflow name.
+	WorkflowName = "temporal-sys-force-replication-workflow"
+
+	// DefaultRPS is the default number of workflows replicated per second.
+	DefaultRPS = 10
+	// DefaultPageSize is the default page size used to list workflows.
+	DefaultPageSize = 1000
+	// DefaultClosedWorkflowWindow is the default window of close time of closed workflows to replicate.
+	DefaultClosedWorkflowWindow = 7 * 24 * time.Hour
+	// DefaultActivityHeartBeatTimeout is the default value for ActivityHeartBeatTimeout.
+	DefaultActivityHeartBeatTimeout = 30 * time.Second
+
+	// infiniteDuration is a long duration(20 yrs) used for the activity which replicates all workflows.
+	infiniteDuration = 20 * 365 * 24 * time.Hour
+)
+
+const (
+	// PhaseOpen is the phase replicating open workflows.
+	PhaseOpen = "open"
+	// PhaseClosed is the phase replicating recently closed workflows.
+	PhaseClosed = "closed"
+	// PhaseDone indicates all workflows are replicated.
+	PhaseDone = "done"
+)
+
+type (
+	// WorkflowParams is the parameters for force replication workflow.
+	WorkflowParams struct {
+		// Namespace to replicate, it must be a global namespace with more than one cluster.
+		Namespace string
+		// Closed workflows are replicated if they closed within this window before the job started.
+		// Default to DefaultClosedWorkflowWindow.
+		ClosedWorkflowWindow time.Duration
+		// Number of workflows replicated per second. Default to DefaultRPS.
+		RPS int
+		// Page size used to list workflows. Default to DefaultPageSize.
+		PageSize int
+		// Timeout for activity heartbeat. Default to DefaultActivityHeartBeatTimeout.
+		ActivityHeartBeatTimeout time.Duration
+		// ScanStartTime is set by the workflow when it starts. Workflows started after this time
+		// are replicated as part of normal replication and therefore are not listed.
+		ScanStartTime time.Time
+	}
+
+	// Progress is the progress of force replication, it is recorded as heartbeat details
+	// of the replication activity and returned as the workflow result.
+	Progress struct {
+		// Phase is one of PhaseOpen, PhaseClosed and PhaseDone.
+		Phase       string
+		PageToken   []byte
+		CurrentPage int
+		// Number of workflows replication tasks were generated for.
+		ReplicatedCount int
+		// Number of listed workflows which were not found anymore, usually because of retention.
+		SkippedCount int
+	}
+
+	activities struct {
+		frontendClient workflowservice.WorkflowServiceClient
+		historyClient  historyservice.HistoryServiceClient
+		namespaceCache cache.NamespaceCache
+		metricsClient  metrics.Client
+		logger         log.Logger
+	}
+)
+
+var (
+	forceReplicationActivityRetryPolicy = temporal.RetryPolicy{
+		InitialInterval:    10 * time.Second,
+		BackoffCoefficient: 1.7,
+		MaximumInterval:    5 * time.Minute,
+	}
+
+	ErrInvalidParams           = errors.New("invalid force replication parameters")
+	ErrNamespaceNotReplicated  = errors.New("namespace is not replicated to any remote cluster")
+	ErrUnableToExecuteActivity = errors.New("unable to execute activity")
+)
+
+func newActivities(
+	frontendClient workflowservice.WorkflowServiceClient,
+	historyClient historyservice.HistoryServiceClient,
+	namespaceCache cache.NamespaceCache,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) *activities {
+	return &activities{
+		frontendClient: frontendClient,
+		historyClient:  historyClient,
+		namespaceCache: namespaceCache,
+		metricsClient:  metricsClient,
+		logger:         logger,
+	}
+}
+
+// ForceReplicationWorkflow is the workflow that generates replication tasks for open and recently closed
+// workflows of a namespace, so that a newly connected cluster is hydrated with the existing workflows.
+func ForceReplicationWorkflow(ctx workflow.Context, params WorkflowParams) (Progress, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Workflow started.", "wf-type", WorkflowName, "namespace", params.Namespace)
+
+	if err := validateParams(params); err != nil {
+		return Progress{}, err
+	}
+	params = setDefaultParams(params)
+	if params.ScanStartTime.IsZero() {
+		params.ScanStartTime = workflow.Now(ctx)
+	}
+
+	var a *activities
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		ScheduleToStartTimeout: 5 * time.Minute,
+		StartToCloseTimeout:    infiniteDuration,
+		HeartbeatTimeout:       params.ActivityHeartBeatTimeout,
+		RetryPolicy:            &forceReplicationActivityRetryPolicy,
+	})
+	var progress Progress
+	err := workflow.ExecuteActivity(ctx, a.ForceReplicationActivity, params).Get(ctx, &progress)
+	if err != nil {
+		return Progress{}, fmt.Errorf("%w: ForceReplicationActivity: %v", ErrUnableToExecuteActivity, err)
+	}
+
+	logger.Info("Workflow finished successfully.", "wf-type", WorkflowName, "replicated", progress.ReplicatedCount, "skipped", progress.SkippedCount)
+	return progress, nil
+}
+
+func validateParams(params WorkflowParams) error {
+	if params.Namespace == "" {
+		return fmt.Errorf("%w: namespace is not set", ErrInvalidParams)
+	}
+	if params.ClosedWorkflowWindow < 0 || params.RPS < 0 || params.PageSize < 0 || params.ActivityHeartBeatTimeout < 0 {
+		return fmt.Errorf("%w: negative value is not allowed", ErrInvalidParams)
+	}
+	return nil
+}
+
+func setDefaultParams(params WorkflowParams) WorkflowParams {
+	if params.ClosedWorkflowWindow == 0 {
+		params.ClosedWorkflowWindow = DefaultClosedWorkflowWindow
+	}
+	if params.RPS == 0 {
+		params.RPS = DefaultRPS
+	}
+	if params.PageSize == 0 {
+		params.PageSize = DefaultPageSize
+	}
+	if params.ActivityHeartBeatTimeout == 0 {
+		params.ActivityHeartBeatTimeout = DefaultActivityHeartBeatTimeout
+	}
+	return params
+}
+
+// ForceReplicationActivity lists open and recently closed workflows page by page and generates a replication
+// task for the last history event batch of each of them. Progress is recorded at page boundaries, so a retried
+// activity replicates at most one page again.
+func (a *activities) ForceReplicationActivity(ctx context.Context, params WorkflowParams) (Progress, error) {
+	logger := log.With(a.logger, tag.WorkflowNamespace(params.Namespace))
+	scope := a.metricsClient.Scope(metrics.ForceReplicationScope, metrics.NamespaceTag(params.Namespace))
+
+	namespaceEntry, err := a.namespaceCache.GetNamespace(params.Namespace)
+	if err != nil {
+		return Progress{}, err
+	}
+	if namespaceEntry.GetReplicationPolicy() != cache.ReplicationPolicyMultiCluster {
+		return Progress{}, temporal.NewNonRetryableApplicationError(fmt.Sprintf("%v: %v", ErrNamespaceNotReplicated, params.Namespace), "", nil)
+	}
+	namespaceID := namespaceEntry.GetInfo().Id
+
+	progress := Progress{Phase: PhaseOpen}
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &progress); err != nil {
+			scope.IncCounter(metrics.ForceReplicationFailures)
+			logger.Error("Unable to recover progress from last heartbeat, start over from beginning.", tag.Error(err))
+			progress = Progress{Phase: PhaseOpen}
+		}
+	}
+
+	rateLimiter := rate.NewLimiter(rate.Limit(params.RPS), params.RPS)
+	for progress.Phase != PhaseDone {
+		executions, nextPageToken, err := a.listWorkflows(ctx, params, progress)
+		if err != nil {
+			scope.IncCounter(metrics.ForceReplicationFailures)
+			logger.Error("Unable to list workflows.", tag.Value(progress.Phase), tag.Error(err))
+			return Progress{}, err
+		}
+
+		replicatedCount := 0
+		skippedCount := 0
+		for _, execution := range executions {
+			if err := rateLimiter.Wait(ctx); err != nil {
+				return Progress{}, err
+			}
+			_, err := a.historyClient.GenerateLastHistoryReplicationTasks(ctx, &historyservice.GenerateLastHistoryReplicationTasksRequest{
+				NamespaceId: namespaceID,
+				Execution:   execution,
+			})
+			switch err.(type) {
+			case nil:
+				replicatedCount++
+				scope.IncCounter(metrics.ForceReplicationWorkflowsReplicatedCount)
+			case *serviceerror.NotFound:
+				skippedCount++
+				scope.IncCounter(metrics.ForceReplicationWorkflowsSkippedCount)
+			default:
+				scope.IncCounter(metrics.ForceReplicationFailures)
+				logger.Error("Unable to generate replication tasks.", tag.WorkflowID(execution.GetWorkflowId()), tag.WorkflowRunID(execution.GetRunId()), tag.Error(err))
+				return Progress{}, err
+			}
+			activity.RecordHeartbeat(ctx, progress)
+		}
+
+		progress.CurrentPage++
+		progress.ReplicatedCount += replicatedCount
+		progress.SkippedCount += skippedCount
+		progress.PageToken = nextPageToken
+		if len(nextPageToken) == 0 {
+			progress.Phase = nextPhase(progress.Phase)
+		}
+		activity.RecordHeartbeat(ctx, progress)
+	}
+
+	logger.Info("Force replication finished.", tag.Counter(progress.ReplicatedCount))
+	return progress, nil
+}
+
+func (a *activities) listWorkflows(ctx context.Context, params WorkflowParams, progress Progress) ([]*commonpb.WorkflowExecution, []byte, error) {
+	var executions []*commonpb.WorkflowExecution
+	switch progress.Phase {
+	case PhaseOpen:
+		resp, err := a.frontendClient.ListOpenWorkflowExecutions(ctx, &workflowservice.ListOpenWorkflowExecutionsRequest{
+			Namespace:       params.Namespace,
+			MaximumPageSize: int32(params.PageSize),
+			NextPageToken:   progress.PageToken,
+			StartTimeFilter: &filterpb.StartTimeFilter{
+				LatestTime: &params.ScanStartTime,
+			},
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, info := range resp.GetExecutions() {
+			executions = append(executions, info.GetExecution())
+		}
+		return executions, resp.GetNextPageToken(), nil
+	case PhaseClosed:
+		// Filter is applied to close time, there is no upper bound so that workflows closed while
+		// open workflows were being replicated are included.
+		earliestCloseTime := params.ScanStartTime.Add(-params.ClosedWorkflowWindow)
+		resp, err := a.frontendClient.ListClosedWorkflowExecutions(ctx, &workflowservice.ListClosedWorkflowExecutionsRequest{
+			Namespace:       params.Namespace,
+			MaximumPageSize: int32(params.PageSize),
+			NextPageToken:   progress.PageToken,
+			StartTimeFilter: &filterpb.StartTimeFilter{
+				EarliestTime: &earliestCloseTime,
+			},
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, info := range resp.GetExecutions() {
+			executions = append(executions, info.GetExecution())
+		}
+		return executions, resp.GetNextPageToken(), nil
+	default:
+		return nil, nil, temporal.NewNonRetryableApplicationError(fmt.Sprintf("unknown force replication phase: %v", progress.Phase), "", nil)
+	}
+}
+
+func nextPhase(phase string) string {
+	switch phase {
+	case PhaseOpen:
+		return PhaseClosed
+	default:
+		return PhaseDone
+	}
+}
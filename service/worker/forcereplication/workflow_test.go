@@ -0,0 +1,230 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package forcereplication
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/api/workflowservicemock/v1"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+)
+
+const (
+	testNamespace   = "test-namespace"
+	testNamespaceID = "test-namespace-id"
+)
+
+type (
+	workflowSuite struct {
+		suite.Suite
+		testsuite.WorkflowTestSuite
+
+		controller         *gomock.Controller
+		mockFrontendClient *workflowservicemock.MockWorkflowServiceClient
+		mockHistoryClient  *historyservicemock.MockHistoryServiceClient
+		mockNamespaceCache *cache.MockNamespaceCache
+
+		activities *activities
+	}
+)
+
+func TestWorkflowSuite(t *testing.T) {
+	suite.Run(t, new(workflowSuite))
+}
+
+func (s *workflowSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.mockFrontendClient = workflowservicemock.NewMockWorkflowServiceClient(s.controller)
+	s.mockHistoryClient = historyservicemock.NewMockHistoryServiceClient(s.controller)
+	s.mockNamespaceCache = cache.NewMockNamespaceCache(s.controller)
+
+	s.activities = newActivities(
+		s.mockFrontendClient,
+		s.mockHistoryClient,
+		s.mockNamespaceCache,
+		metrics.NewNoopMetricsClient(),
+		log.NewNoopLogger(),
+	)
+}
+
+func (s *workflowSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *workflowSuite) TestForceReplicationWorkflow() {
+	env := s.NewTestWorkflowEnvironment()
+	env.RegisterActivity(s.activities)
+	expectedProgress := Progress{Phase: PhaseDone, CurrentPage: 2, ReplicatedCount: 10}
+	env.OnActivity(s.activities.ForceReplicationActivity, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, params WorkflowParams) (Progress, error) {
+			s.Equal(testNamespace, params.Namespace)
+			s.Equal(DefaultClosedWorkflowWindow, params.ClosedWorkflowWindow)
+			s.Equal(DefaultRPS, params.RPS)
+			s.Equal(DefaultPageSize, params.PageSize)
+			s.False(params.ScanStartTime.IsZero())
+			return expectedProgress, nil
+		})
+
+	env.ExecuteWorkflow(ForceReplicationWorkflow, WorkflowParams{Namespace: testNamespace})
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	var progress Progress
+	s.NoError(env.GetWorkflowResult(&progress))
+	s.Equal(expectedProgress, progress)
+}
+
+func (s *workflowSuite) TestForceReplicationWorkflow_InvalidParams() {
+	env := s.NewTestWorkflowEnvironment()
+	env.RegisterActivity(s.activities)
+
+	env.ExecuteWorkflow(ForceReplicationWorkflow, WorkflowParams{})
+	s.True(env.IsWorkflowCompleted())
+	s.Error(env.GetWorkflowError())
+}
+
+func (s *workflowSuite) TestForceReplicationActivity() {
+	s.mockNamespaceCache.EXPECT().GetNamespace(testNamespace).Return(s.newNamespaceEntry(true), nil)
+
+	executions := []*commonpb.WorkflowExecution{
+		{WorkflowId: "workflow-1", RunId: "run-1"},
+		{WorkflowId: "workflow-2", RunId: "run-2"},
+		{WorkflowId: "workflow-3", RunId: "run-3"},
+		{WorkflowId: "workflow-4", RunId: "run-4"},
+	}
+	pageToken := []byte("next-page")
+	gomock.InOrder(
+		s.mockFrontendClient.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, request *workflowservice.ListOpenWorkflowExecutionsRequest, _ ...interface{}) (*workflowservice.ListOpenWorkflowExecutionsResponse, error) {
+				s.Equal(testNamespace, request.GetNamespace())
+				s.Empty(request.GetNextPageToken())
+				s.NotNil(request.GetStartTimeFilter().GetLatestTime())
+				return &workflowservice.ListOpenWorkflowExecutionsResponse{
+					Executions:    []*workflowpb.WorkflowExecutionInfo{{Execution: executions[0]}, {Execution: executions[1]}},
+					NextPageToken: pageToken,
+				}, nil
+			}),
+		s.mockFrontendClient.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, request *workflowservice.ListOpenWorkflowExecutionsRequest, _ ...interface{}) (*workflowservice.ListOpenWorkflowExecutionsResponse, error) {
+				s.Equal(pageToken, request.GetNextPageToken())
+				return &workflowservice.ListOpenWorkflowExecutionsResponse{
+					Executions: []*workflowpb.WorkflowExecutionInfo{{Execution: executions[2]}},
+				}, nil
+			}),
+		s.mockFrontendClient.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, request *workflowservice.ListClosedWorkflowExecutionsRequest, _ ...interface{}) (*workflowservice.ListClosedWorkflowExecutionsResponse, error) {
+				s.Empty(request.GetNextPageToken())
+				s.NotNil(request.GetStartTimeFilter().GetEarliestTime())
+				s.Nil(request.GetStartTimeFilter().GetLatestTime())
+				return &workflowservice.ListClosedWorkflowExecutionsResponse{
+					Executions: []*workflowpb.WorkflowExecutionInfo{{Execution: executions[3]}},
+				}, nil
+			}),
+	)
+	for _, execution := range executions[:3] {
+		s.mockHistoryClient.EXPECT().GenerateLastHistoryReplicationTasks(gomock.Any(), &historyservice.GenerateLastHistoryReplicationTasksRequest{
+			NamespaceId: testNamespaceID,
+			Execution:   execution,
+		}).Return(&historyservice.GenerateLastHistoryReplicationTasksResponse{}, nil)
+	}
+	s.mockHistoryClient.EXPECT().GenerateLastHistoryReplicationTasks(gomock.Any(), &historyservice.GenerateLastHistoryReplicationTasksRequest{
+		NamespaceId: testNamespaceID,
+		Execution:   executions[3],
+	}).Return(nil, serviceerror.NewNotFound("workflow not found"))
+
+	env := s.NewTestActivityEnvironment()
+	env.RegisterActivity(s.activities)
+	val, err := env.ExecuteActivity(s.activities.ForceReplicationActivity, s.newParams())
+	s.NoError(err)
+	var progress Progress
+	s.NoError(val.Get(&progress))
+	s.Equal(Progress{Phase: PhaseDone, CurrentPage: 3, ReplicatedCount: 3, SkippedCount: 1}, progress)
+}
+
+func (s *workflowSuite) TestForceReplicationActivity_NamespaceNotReplicated() {
+	s.mockNamespaceCache.EXPECT().GetNamespace(testNamespace).Return(s.newNamespaceEntry(false), nil)
+
+	env := s.NewTestActivityEnvironment()
+	env.RegisterActivity(s.activities)
+	_, err := env.ExecuteActivity(s.activities.ForceReplicationActivity, s.newParams())
+	var appErr *temporal.ApplicationError
+	s.True(errors.As(err, &appErr))
+	s.True(appErr.NonRetryable())
+}
+
+func (s *workflowSuite) TestForceReplicationActivity_GenerateReplicationTasksError() {
+	s.mockNamespaceCache.EXPECT().GetNamespace(testNamespace).Return(s.newNamespaceEntry(true), nil)
+	s.mockFrontendClient.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&workflowservice.ListOpenWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{{Execution: &commonpb.WorkflowExecution{WorkflowId: "workflow-1", RunId: "run-1"}}},
+	}, nil)
+	s.mockHistoryClient.EXPECT().GenerateLastHistoryReplicationTasks(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewUnavailable("history unavailable"))
+
+	env := s.NewTestActivityEnvironment()
+	env.RegisterActivity(s.activities)
+	_, err := env.ExecuteActivity(s.activities.ForceReplicationActivity, s.newParams())
+	s.Error(err)
+}
+
+func (s *workflowSuite) newParams() WorkflowParams {
+	params := setDefaultParams(WorkflowParams{Namespace: testNamespace})
+	params.RPS = 1000
+	params.ScanStartTime = time.Now().UTC()
+	return params
+}
+
+func (s *workflowSuite) newNamespaceEntry(replicated bool) *cache.NamespaceCacheEntry {
+	clusters := []string{cluster.TestCurrentClusterName}
+	if replicated {
+		clusters = append(clusters, cluster.TestAlternativeClusterName)
+	}
+	return cache.NewGlobalNamespaceCacheEntryForTest(
+		&persistencespb.NamespaceInfo{Id: testNamespaceID, Name: testNamespace},
+		&persistencespb.NamespaceConfig{},
+		&persistencespb.NamespaceReplicationConfig{
+			ActiveClusterName: cluster.TestCurrentClusterName,
+			Clusters:          clusters,
+		},
+		0,
+		nil,
+	)
+}
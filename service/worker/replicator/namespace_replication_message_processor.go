@@ -31,6 +31,7 @@ import (
 	"go.temporal.io/api/serviceerror"
 
 	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
@@ -189,16 +190,26 @@ func (p *namespaceReplicationMessageProcessor) putNamespaceReplicationTaskToDLQ(
 	task *replicationspb.ReplicationTask,
 ) error {
 
-	namespaceAttribute := task.GetNamespaceTaskAttributes()
-	if namespaceAttribute == nil {
-		return &serviceerror.Internal{
-			Message: "Namespace replication task does not set namespace task attribute",
+	switch task.GetTaskType() {
+	case enumsspb.REPLICATION_TASK_TYPE_SEARCH_ATTRIBUTES_TASK:
+		if task.GetSearchAttributesTaskAttributes() == nil {
+			return &serviceerror.Internal{
+				Message: "Search attributes replication task does not set search attributes task attribute",
+			}
 		}
+		p.metricsClient.IncCounter(metrics.NamespaceReplicationTaskScope, metrics.NamespaceReplicationEnqueueDLQCount)
+	default:
+		namespaceAttribute := task.GetNamespaceTaskAttributes()
+		if namespaceAttribute == nil {
+			return &serviceerror.Internal{
+				Message: "Namespace replication task does not set namespace task attribute",
+			}
+		}
+		p.metricsClient.Scope(
+			metrics.NamespaceReplicationTaskScope,
+			metrics.NamespaceTag(namespaceAttribute.GetInfo().GetName()),
+		).IncCounter(metrics.NamespaceReplicationEnqueueDLQCount)
 	}
-	p.metricsClient.Scope(
-		metrics.NamespaceReplicationTaskScope,
-		metrics.NamespaceTag(namespaceAttribute.GetInfo().GetName()),
-	).IncCounter(metrics.NamespaceReplicationEnqueueDLQCount)
 	return p.namespaceReplicationQueue.PublishToDLQ(task)
 }
 
@@ -209,6 +220,9 @@ func (p *namespaceReplicationMessageProcessor) handleNamespaceReplicationTask(
 	sw := p.metricsClient.StartTimer(metrics.NamespaceReplicationTaskScope, metrics.ReplicatorLatency)
 	defer sw.Stop()
 
+	if task.GetTaskType() == enumsspb.REPLICATION_TASK_TYPE_SEARCH_ATTRIBUTES_TASK {
+		return p.taskExecutor.ExecuteSearchAttributesTask(task.GetSearchAttributesTaskAttributes())
+	}
 	return p.taskExecutor.Execute(task.GetNamespaceTaskAttributes())
 }
 
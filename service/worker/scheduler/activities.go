@@ -0,0 +1,182 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/temporal"
+
+	schedpb "go.temporal.io/server/api/schedule/v1"
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+)
+
+const (
+	// identity is the identity of the requests made by schedules.
+	identity = "temporal-sys-scheduler"
+)
+
+type (
+	activities struct {
+		frontendClient workflowservice.WorkflowServiceClient
+		namespaceCache cache.NamespaceCache
+		metricsClient  metrics.Client
+		logger         log.Logger
+	}
+)
+
+func newActivities(
+	frontendClient workflowservice.WorkflowServiceClient,
+	namespaceCache cache.NamespaceCache,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) *activities {
+	return &activities{
+		frontendClient: frontendClient,
+		namespaceCache: namespaceCache,
+		metricsClient:  metricsClient,
+		logger:         logger,
+	}
+}
+
+// StartWorkflow takes the action of a schedule.
+func (a *activities) StartWorkflow(ctx context.Context, request *schedpb.StartWorkflowRequest) (*schedpb.StartWorkflowResponse, error) {
+	namespace, err := a.namespaceCache.GetNamespaceName(request.NamespaceId)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	scope := a.metricsClient.Scope(metrics.SchedulerScope, metrics.NamespaceTag(namespace))
+
+	action := request.Action
+	response, err := a.frontendClient.StartWorkflowExecution(ctx, &workflowservice.StartWorkflowExecutionRequest{
+		Namespace:                namespace,
+		WorkflowId:               request.WorkflowId,
+		WorkflowType:             action.GetWorkflowType(),
+		TaskQueue:                action.GetTaskQueue(),
+		Input:                    action.GetInput(),
+		WorkflowExecutionTimeout: action.GetWorkflowExecutionTimeout(),
+		WorkflowRunTimeout:       action.GetWorkflowRunTimeout(),
+		WorkflowTaskTimeout:      action.GetWorkflowTaskTimeout(),
+		Identity:                 identity,
+		RequestId:                request.RequestId,
+		WorkflowIdReusePolicy:    enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+		RetryPolicy:              action.GetRetryPolicy(),
+		Memo:                     action.GetMemo(),
+		SearchAttributes:         action.GetSearchAttributes(),
+	})
+	if err != nil {
+		scope.IncCounter(metrics.ScheduleActionErrors)
+		a.logger.Error("Unable to start scheduled workflow.", tag.WorkflowNamespace(namespace), tag.WorkflowID(request.WorkflowId), tag.Error(err))
+		return nil, translateError(err)
+	}
+	scope.IncCounter(metrics.ScheduleActionSuccess)
+	return &schedpb.StartWorkflowResponse{RunId: response.GetRunId()}, nil
+}
+
+// RefreshRunning splits the given workflows into running and closed ones. The current run of each
+// workflow id is checked, so workflows which continued as new are still running.
+func (a *activities) RefreshRunning(ctx context.Context, request *schedpb.RefreshRunningRequest) (*schedpb.RefreshRunningResponse, error) {
+	namespace, err := a.namespaceCache.GetNamespaceName(request.NamespaceId)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	response := &schedpb.RefreshRunningResponse{}
+	for _, execution := range request.Executions {
+		describeResponse, err := a.frontendClient.DescribeWorkflowExecution(ctx, &workflowservice.DescribeWorkflowExecutionRequest{
+			Namespace: namespace,
+			Execution: &commonpb.WorkflowExecution{WorkflowId: execution.GetWorkflowId()},
+		})
+		switch err.(type) {
+		case nil:
+			status := describeResponse.GetWorkflowExecutionInfo().GetStatus()
+			if status == enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+				response.Running = append(response.Running, execution)
+				continue
+			}
+			response.Closed = append(response.Closed, execution)
+			response.ClosedStatuses = append(response.ClosedStatuses, status)
+		case *serviceerror.NotFound:
+			response.Closed = append(response.Closed, execution)
+			response.ClosedStatuses = append(response.ClosedStatuses, enumspb.WORKFLOW_EXECUTION_STATUS_UNSPECIFIED)
+		default:
+			return nil, err
+		}
+	}
+	return response, nil
+}
+
+// StopWorkflows cancels or terminates the given workflows, workflows which are already closed are ignored.
+func (a *activities) StopWorkflows(ctx context.Context, request *schedpb.StopWorkflowsRequest) error {
+	namespace, err := a.namespaceCache.GetNamespaceName(request.NamespaceId)
+	if err != nil {
+		return translateError(err)
+	}
+
+	for _, execution := range request.Executions {
+		current := &commonpb.WorkflowExecution{WorkflowId: execution.GetWorkflowId()}
+		if request.Terminate {
+			_, err = a.frontendClient.TerminateWorkflowExecution(ctx, &workflowservice.TerminateWorkflowExecutionRequest{
+				Namespace:         namespace,
+				WorkflowExecution: current,
+				Reason:            request.Reason,
+				Identity:          identity,
+			})
+		} else {
+			_, err = a.frontendClient.RequestCancelWorkflowExecution(ctx, &workflowservice.RequestCancelWorkflowExecutionRequest{
+				Namespace:         namespace,
+				WorkflowExecution: current,
+				Identity:          identity,
+			})
+		}
+		switch err.(type) {
+		case nil, *serviceerror.NotFound, *serviceerror.CancellationAlreadyRequested:
+		default:
+			return err
+		}
+	}
+	return nil
+}
+
+// translateError makes errors which won't go away by retrying non-retryable.
+func translateError(err error) error {
+	switch err.(type) {
+	case *serviceerror.InvalidArgument,
+		*serviceerror.NotFound,
+		*serviceerror.NamespaceNotActive,
+		*serviceerror.PermissionDenied,
+		*serviceerror.WorkflowExecutionAlreadyStarted:
+		return temporal.NewNonRetryableApplicationError(err.Error(), "", err)
+	default:
+		return err
+	}
+}
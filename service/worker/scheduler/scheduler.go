@@ -0,0 +1,84 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"go.temporal.io/api/workflowservice/v1"
+	sdkclient "go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+)
+
+type (
+	// schedulerService is the background sub-system that runs the workflows of schedules.
+	schedulerService struct {
+		sdkClient      sdkclient.Client
+		frontendClient workflowservice.WorkflowServiceClient
+		namespaceCache cache.NamespaceCache
+		metricsClient  metrics.Client
+		logger         log.Logger
+	}
+)
+
+// New returns a new instance of the scheduler service.
+func New(
+	sdkClient sdkclient.Client,
+	frontendClient workflowservice.WorkflowServiceClient,
+	namespaceCache cache.NamespaceCache,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) *schedulerService {
+	return &schedulerService{
+		sdkClient:      sdkClient,
+		frontendClient: frontendClient,
+		namespaceCache: namespaceCache,
+		metricsClient:  metricsClient,
+		logger:         log.With(logger, tag.ComponentScheduler),
+	}
+}
+
+// Start service.
+func (s *schedulerService) Start() error {
+	workerOpts := worker.Options{}
+
+	wrk := worker.New(s.sdkClient, TaskQueueName, workerOpts)
+
+	a := newActivities(
+		s.frontendClient,
+		s.namespaceCache,
+		s.metricsClient,
+		s.logger,
+	)
+
+	wrk.RegisterWorkflowWithOptions(SchedulerWorkflow, workflow.RegisterOptions{Name: WorkflowName})
+	wrk.RegisterActivity(a)
+
+	return wrk.Start()
+}
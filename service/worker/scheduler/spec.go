@@ -0,0 +1,215 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron"
+
+	schedpb "go.temporal.io/server/api/schedule/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+const (
+	// maxExcludedMatches limits the number of consecutive excluded times skipped when
+	// looking for the next action time.
+	maxExcludedMatches = 1000
+)
+
+type (
+	// compiledSpec is a ScheduleSpec with parsed calendars, it computes the action times of a schedule.
+	compiledSpec struct {
+		spec      *schedpb.ScheduleSpec
+		calendars []cron.Schedule
+		excludes  []cron.Schedule
+	}
+)
+
+var (
+	calendarParser = cron.NewParser(cron.Second | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+
+	errInvalidInterval = errors.New("interval must be at least one second")
+	errNegativePhase   = errors.New("interval phase must not be negative")
+	errNegativeJitter  = errors.New("jitter must not be negative")
+	errInvalidTimeSpan = errors.New("end time must not be before start time")
+)
+
+// ValidateSpec returns an error if the spec can't be used by a schedule.
+func ValidateSpec(spec *schedpb.ScheduleSpec) error {
+	_, err := newCompiledSpec(spec)
+	return err
+}
+
+func newCompiledSpec(spec *schedpb.ScheduleSpec) (*compiledSpec, error) {
+	if spec == nil {
+		spec = &schedpb.ScheduleSpec{}
+	}
+	calendars, err := parseCalendars(spec.GetCalendar())
+	if err != nil {
+		return nil, err
+	}
+	excludes, err := parseCalendars(spec.GetExcludeCalendar())
+	if err != nil {
+		return nil, err
+	}
+	for _, interval := range spec.GetInterval() {
+		if interval.GetInterval() == nil || *interval.GetInterval() < time.Second {
+			return nil, errInvalidInterval
+		}
+		if interval.GetPhase() != nil && *interval.GetPhase() < 0 {
+			return nil, errNegativePhase
+		}
+	}
+	if spec.GetJitter() != nil && *spec.GetJitter() < 0 {
+		return nil, errNegativeJitter
+	}
+	if spec.GetStartTime() != nil && spec.GetEndTime() != nil && spec.GetEndTime().Before(*spec.GetStartTime()) {
+		return nil, errInvalidTimeSpan
+	}
+	return &compiledSpec{
+		spec:      spec,
+		calendars: calendars,
+		excludes:  excludes,
+	}, nil
+}
+
+func parseCalendars(calendars []*schedpb.CalendarSpec) ([]cron.Schedule, error) {
+	var schedules []cron.Schedule
+	for _, calendar := range calendars {
+		fields := []string{
+			defaultField(calendar.GetSecond(), "0"),
+			defaultField(calendar.GetMinute(), "0"),
+			defaultField(calendar.GetHour(), "0"),
+			defaultField(calendar.GetDayOfMonth(), "*"),
+			defaultField(calendar.GetMonth(), "*"),
+			defaultField(calendar.GetDayOfWeek(), "*"),
+		}
+		schedule, err := calendarParser.Parse(strings.Join(fields, " "))
+		if err != nil {
+			return nil, fmt.Errorf("invalid calendar %v: %w", calendar, err)
+		}
+		schedules = append(schedules, schedule)
+	}
+	return schedules, nil
+}
+
+func defaultField(value string, defaultValue string) string {
+	if value = strings.TrimSpace(value); value == "" {
+		return defaultValue
+	}
+	return value
+}
+
+// getNextTime returns the nominal time of the first action whose actual time, i.e. the nominal
+// time plus jitter, is after the given time, and that actual time. Both are zero if the schedule
+// takes no more actions.
+func (cs *compiledSpec) getNextTime(after time.Time) (nominal time.Time, next time.Time) {
+	if startTime := cs.spec.GetStartTime(); startTime != nil && after.Before(*startTime) {
+		after = startTime.Add(-time.Nanosecond)
+	}
+	for i := 0; ; i++ {
+		if i >= maxExcludedMatches {
+			return time.Time{}, time.Time{}
+		}
+		nominal = cs.rawNextTime(after)
+		if nominal.IsZero() {
+			return time.Time{}, time.Time{}
+		}
+		if endTime := cs.spec.GetEndTime(); endTime != nil && nominal.After(*endTime) {
+			return time.Time{}, time.Time{}
+		}
+		if !cs.excluded(nominal) {
+			break
+		}
+		after = nominal
+	}
+
+	next = nominal
+	if jitter := timestamp.DurationValue(cs.spec.GetJitter()); jitter > 0 {
+		// Jitter is capped by the time to the following nominal time, so actions stay in order
+		// and the nominal time after an actual time is the nominal time of the next action.
+		if following := cs.rawNextTime(nominal); !following.IsZero() && following.Sub(nominal) < jitter {
+			jitter = following.Sub(nominal)
+		}
+		next = nominal.Add(time.Duration(jitterFraction(nominal) * float64(jitter)))
+	}
+	return nominal, next
+}
+
+// rawNextTime returns the earliest time after the given time matched by any calendar or interval,
+// without taking exclusions, start time and end time into account.
+func (cs *compiledSpec) rawNextTime(after time.Time) time.Time {
+	var next time.Time
+	after = after.UTC()
+	for _, calendar := range cs.calendars {
+		if t := calendar.Next(after); !t.IsZero() && (next.IsZero() || t.Before(next)) {
+			next = t
+		}
+	}
+	for _, interval := range cs.spec.GetInterval() {
+		if t := nextIntervalTime(interval, after); next.IsZero() || t.Before(next) {
+			next = t
+		}
+	}
+	return next
+}
+
+func (cs *compiledSpec) excluded(t time.Time) bool {
+	t = t.UTC()
+	if t.Nanosecond() != 0 {
+		// Calendars only match whole seconds.
+		return false
+	}
+	for _, exclude := range cs.excludes {
+		if exclude.Next(t.Add(-time.Second)).Equal(t) {
+			return true
+		}
+	}
+	return false
+}
+
+func nextIntervalTime(interval *schedpb.IntervalSpec, after time.Time) time.Time {
+	length := int64(*interval.GetInterval())
+	phase := int64(timestamp.DurationValue(interval.GetPhase())) % length
+	offset := after.UnixNano() - phase
+	n := offset / length
+	if offset < 0 && offset%length != 0 {
+		n--
+	}
+	return time.Unix(0, (n+1)*length+phase).UTC()
+}
+
+// jitterFraction returns a number in [0, 1) derived from the nominal time, so that the jitter of
+// an action is the same whenever it is computed.
+func jitterFraction(nominal time.Time) float64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(nominal.UTC().Format(time.RFC3339Nano)))
+	return float64(h.Sum64()>>11) / float64(1<<53)
+}
@@ -0,0 +1,163 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	schedpb "go.temporal.io/server/api/schedule/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+type (
+	specSuite struct {
+		suite.Suite
+	}
+)
+
+var (
+	testStartTime = time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func TestSpecSuite(t *testing.T) {
+	suite.Run(t, new(specSuite))
+}
+
+func (s *specSuite) nextTimes(spec *schedpb.ScheduleSpec, after time.Time, n int) []time.Time {
+	cspec, err := newCompiledSpec(spec)
+	s.NoError(err)
+	var times []time.Time
+	for len(times) < n {
+		_, next := cspec.getNextTime(after)
+		if next.IsZero() {
+			break
+		}
+		times = append(times, next)
+		after = next
+	}
+	return times
+}
+
+func (s *specSuite) TestCalendar() {
+	spec := &schedpb.ScheduleSpec{
+		Calendar: []*schedpb.CalendarSpec{
+			{Hour: "9", DayOfWeek: "1-5"},
+			{Minute: "30", Hour: "12", DayOfMonth: "1"},
+		},
+	}
+	// 2021-07-01 is a Thursday.
+	s.Equal([]time.Time{
+		testStartTime.Add(9 * time.Hour),
+		testStartTime.Add(12*time.Hour + 30*time.Minute),
+		testStartTime.Add(24*time.Hour + 9*time.Hour),
+		testStartTime.Add(4*24*time.Hour + 9*time.Hour),
+	}, s.nextTimes(spec, testStartTime, 4))
+}
+
+func (s *specSuite) TestInterval() {
+	spec := &schedpb.ScheduleSpec{
+		Interval: []*schedpb.IntervalSpec{
+			{Interval: timestamp.DurationPtr(90 * time.Minute), Phase: timestamp.DurationPtr(15 * time.Minute)},
+		},
+	}
+	// Intervals are aligned to the unix epoch, testStartTime is a multiple of 90 minutes since the epoch.
+	s.Equal([]time.Time{
+		testStartTime.Add(15 * time.Minute),
+		testStartTime.Add(105 * time.Minute),
+		testStartTime.Add(195 * time.Minute),
+	}, s.nextTimes(spec, testStartTime, 3))
+}
+
+func (s *specSuite) TestExcludeCalendar() {
+	spec := &schedpb.ScheduleSpec{
+		Interval: []*schedpb.IntervalSpec{
+			{Interval: timestamp.DurationPtr(time.Hour)},
+		},
+		ExcludeCalendar: []*schedpb.CalendarSpec{
+			{Hour: "1-2"},
+		},
+	}
+	s.Equal([]time.Time{
+		testStartTime.Add(3 * time.Hour),
+		testStartTime.Add(4 * time.Hour),
+	}, s.nextTimes(spec, testStartTime, 2))
+}
+
+func (s *specSuite) TestStartAndEndTime() {
+	spec := &schedpb.ScheduleSpec{
+		Interval: []*schedpb.IntervalSpec{
+			{Interval: timestamp.DurationPtr(time.Hour)},
+		},
+		StartTime: timestamp.TimePtr(testStartTime.Add(2 * time.Hour)),
+		EndTime:   timestamp.TimePtr(testStartTime.Add(4 * time.Hour)),
+	}
+	s.Equal([]time.Time{
+		testStartTime.Add(2 * time.Hour),
+		testStartTime.Add(3 * time.Hour),
+		testStartTime.Add(4 * time.Hour),
+	}, s.nextTimes(spec, testStartTime, 10))
+}
+
+func (s *specSuite) TestJitter() {
+	spec := &schedpb.ScheduleSpec{
+		Interval: []*schedpb.IntervalSpec{
+			{Interval: timestamp.DurationPtr(time.Hour)},
+		},
+		Jitter: timestamp.DurationPtr(2 * time.Hour),
+	}
+	cspec, err := newCompiledSpec(spec)
+	s.NoError(err)
+
+	after := testStartTime
+	for i := 1; i <= 10; i++ {
+		nominal, next := cspec.getNextTime(after)
+		s.Equal(testStartTime.Add(time.Duration(i)*time.Hour), nominal)
+		// Jitter is capped by the interval.
+		s.False(next.Before(nominal))
+		s.True(next.Before(nominal.Add(time.Hour)))
+
+		nominalAgain, nextAgain := cspec.getNextTime(after)
+		s.Equal(nominal, nominalAgain)
+		s.Equal(next, nextAgain)
+		after = next
+	}
+}
+
+func (s *specSuite) TestInvalidSpec() {
+	for _, spec := range []*schedpb.ScheduleSpec{
+		{Calendar: []*schedpb.CalendarSpec{{Hour: "25"}}},
+		{ExcludeCalendar: []*schedpb.CalendarSpec{{DayOfWeek: "foo"}}},
+		{Interval: []*schedpb.IntervalSpec{{Interval: timestamp.DurationPtr(time.Millisecond)}}},
+		{Interval: []*schedpb.IntervalSpec{{Interval: timestamp.DurationPtr(time.Hour), Phase: timestamp.DurationPtr(-time.Minute)}}},
+		{Jitter: timestamp.DurationPtr(-time.Minute)},
+		{StartTime: timestamp.TimePtr(testStartTime), EndTime: timestamp.TimePtr(testStartTime.Add(-time.Hour))},
+	} {
+		s.Error(ValidateSpec(spec), spec.String())
+	}
+	s.NoError(ValidateSpec(nil))
+}
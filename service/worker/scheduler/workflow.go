@@ -0,0 +1,514 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pborman/uuid"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	sdklog "go.temporal.io/sdk/log"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	schedpb "go.temporal.io/server/api/schedule/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+const (
+	// TaskQueueName is the task queue name.
+	TaskQueueName = "temporal-sys-scheduler-task-queue"
+	// WorkflowName is the workflow name.
+	WorkflowName = "temporal-sys-scheduler-workflow"
+	// WorkflowIDPrefix is the prefix of the ids of scheduler workflows.
+	WorkflowIDPrefix = "temporal-sys-scheduler:"
+
+	// SignalNameUpdate is the name of the signal which replaces the schedule, its payload is a FullUpdateRequest.
+	SignalNameUpdate = "update"
+	// SignalNamePatch is the name of the signal which patches the schedule, its payload is a SchedulePatch.
+	SignalNamePatch = "patch"
+	// QueryNameDescribe is the name of the query which returns a DescribeResponse.
+	QueryNameDescribe = "describe"
+
+	// DefaultCatchupWindow is the default catchup window of schedules.
+	DefaultCatchupWindow = time.Minute
+
+	// refreshInterval is how often the workflows started by a schedule are checked while a
+	// buffered action waits for them to close.
+	refreshInterval = time.Minute
+	// maxBufferedStarts limits the number of buffered actions, further actions are skipped.
+	maxBufferedStarts = 1000
+	maxRecentActions  = 10
+	// maxFutureActionTimes is the number of future action times returned by the describe query.
+	maxFutureActionTimes = 10
+	// iterationsBeforeContinueAsNew bounds the history size of scheduler workflows.
+	iterationsBeforeContinueAsNew = 500
+)
+
+type (
+	scheduler struct {
+		*schedpb.StartScheduleArgs
+
+		ctx    workflow.Context
+		a      *activities
+		logger sdklog.Logger
+		cspec  *compiledSpec
+	}
+)
+
+var (
+	activityRetryPolicy = temporal.RetryPolicy{
+		InitialInterval:    time.Second,
+		BackoffCoefficient: 2,
+		MaximumInterval:    time.Minute,
+	}
+	activityOptions = workflow.ActivityOptions{
+		ScheduleToCloseTimeout: time.Hour,
+		StartToCloseTimeout:    time.Minute,
+		RetryPolicy:            &activityRetryPolicy,
+	}
+)
+
+// WorkflowID returns the id of the scheduler workflow of a schedule.
+func WorkflowID(namespaceID string, scheduleID string) string {
+	return WorkflowIDPrefix + namespaceID + ":" + scheduleID
+}
+
+// SchedulerWorkflow is the workflow which runs a schedule. It sleeps until the next action time of
+// the schedule, takes the action, i.e. starts a workflow, according to the overlap policy and
+// repeats. The schedule is changed by signals and described by a query.
+func SchedulerWorkflow(ctx workflow.Context, args *schedpb.StartScheduleArgs) error {
+	s := &scheduler{
+		StartScheduleArgs: args,
+		ctx:               ctx,
+		logger:            sdklog.With(workflow.GetLogger(ctx), "schedule-id", args.GetState().GetScheduleId()),
+	}
+	return s.run()
+}
+
+func (s *scheduler) run() error {
+	s.ensureFields()
+	s.compileSpec()
+
+	if err := workflow.SetQueryHandler(s.ctx, QueryNameDescribe, s.handleDescribeQuery); err != nil {
+		return err
+	}
+
+	if s.State.LastProcessedTime == nil {
+		now := s.now()
+		s.State.LastProcessedTime = &now
+		s.Info.CreateTime = &now
+	}
+
+	updateCh := workflow.GetSignalChannel(s.ctx, SignalNameUpdate)
+	patchCh := workflow.GetSignalChannel(s.ctx, SignalNamePatch)
+
+	for i := 0; i < iterationsBeforeContinueAsNew; i++ {
+		now := s.now()
+		nextWakeup := s.processTimeRange(*s.State.LastProcessedTime, now, false, enumsspb.SCHEDULE_OVERLAP_POLICY_UNSPECIFIED)
+		s.State.LastProcessedTime = &now
+		s.processBuffer()
+		s.sleep(nextWakeup, updateCh, patchCh)
+		if err := s.ctx.Err(); err != nil {
+			return err
+		}
+	}
+
+	// Signals received after the last iteration are applied before continuing as new, so they are not lost.
+	for s.processSignal(updateCh, patchCh) {
+	}
+	return workflow.NewContinueAsNewError(s.ctx, WorkflowName, s.StartScheduleArgs)
+}
+
+func (s *scheduler) ensureFields() {
+	if s.Schedule == nil {
+		s.Schedule = &schedpb.Schedule{}
+	}
+	if s.Schedule.Spec == nil {
+		s.Schedule.Spec = &schedpb.ScheduleSpec{}
+	}
+	if s.Schedule.Action == nil {
+		s.Schedule.Action = &schedpb.ScheduleAction{}
+	}
+	if s.Schedule.Policies == nil {
+		s.Schedule.Policies = &schedpb.SchedulePolicies{}
+	}
+	if s.Schedule.State == nil {
+		s.Schedule.State = &schedpb.ScheduleState{}
+	}
+	if s.Info == nil {
+		s.Info = &schedpb.ScheduleInfo{}
+	}
+	if s.State == nil {
+		s.State = &schedpb.InternalState{}
+	}
+}
+
+func (s *scheduler) compileSpec() {
+	cspec, err := newCompiledSpec(s.Schedule.Spec)
+	if err != nil {
+		s.logger.Error("Invalid schedule spec.", "error", err)
+		s.Info.InvalidScheduleError = err.Error()
+		s.cspec = nil
+		return
+	}
+	s.Info.InvalidScheduleError = ""
+	s.cspec = cspec
+}
+
+func (s *scheduler) now() time.Time {
+	return workflow.Now(s.ctx).UTC()
+}
+
+// processTimeRange buffers the actions with actual times in (start, end]. Scheduled actions are
+// dropped while the schedule is paused and counted as missed if they are older than the catchup
+// window, manual ones, i.e. backfills, are always buffered. It returns the time of the next action
+// after end, zero if there is none.
+func (s *scheduler) processTimeRange(
+	start time.Time,
+	end time.Time,
+	manual bool,
+	overlapPolicy enumsspb.ScheduleOverlapPolicy,
+) time.Time {
+	if s.cspec == nil {
+		return time.Time{}
+	}
+	catchupWindow := s.catchupWindow()
+	for t := start; ; {
+		nominal, next := s.cspec.getNextTime(t)
+		if next.IsZero() || next.After(end) {
+			return next
+		}
+		t = next
+		if !manual {
+			if s.Schedule.State.Paused {
+				continue
+			}
+			if end.Sub(next) > catchupWindow {
+				s.Info.MissedCatchupWindow++
+				continue
+			}
+		}
+		s.addStart(nominal, next, overlapPolicy, manual)
+	}
+}
+
+func (s *scheduler) catchupWindow() time.Duration {
+	if catchupWindow := timestamp.DurationValue(s.Schedule.Policies.CatchupWindow); catchupWindow > 0 {
+		return catchupWindow
+	}
+	return DefaultCatchupWindow
+}
+
+func (s *scheduler) addStart(
+	nominal time.Time,
+	actual time.Time,
+	overlapPolicy enumsspb.ScheduleOverlapPolicy,
+	manual bool,
+) {
+	if len(s.State.BufferedStarts) >= maxBufferedStarts {
+		s.Info.OverlapSkipped++
+		return
+	}
+	s.State.BufferedStarts = append(s.State.BufferedStarts, &schedpb.BufferedStart{
+		NominalTime:   timestamp.TimePtr(nominal),
+		ActualTime:    timestamp.TimePtr(actual),
+		OverlapPolicy: overlapPolicy,
+		Manual:        manual,
+	})
+}
+
+func (s *scheduler) resolveOverlapPolicy(overlapPolicy enumsspb.ScheduleOverlapPolicy) enumsspb.ScheduleOverlapPolicy {
+	if overlapPolicy == enumsspb.SCHEDULE_OVERLAP_POLICY_UNSPECIFIED {
+		overlapPolicy = s.Schedule.Policies.OverlapPolicy
+	}
+	if overlapPolicy == enumsspb.SCHEDULE_OVERLAP_POLICY_UNSPECIFIED {
+		overlapPolicy = enumsspb.SCHEDULE_OVERLAP_POLICY_SKIP
+	}
+	return overlapPolicy
+}
+
+// processBuffer takes the buffered actions which are allowed by their overlap policy and keeps
+// the ones which wait for running workflows to close.
+func (s *scheduler) processBuffer() {
+	if len(s.State.BufferedStarts) == 0 {
+		return
+	}
+	if len(s.Info.RunningWorkflows) > 0 {
+		s.refreshRunning()
+	}
+
+	var waiting []*schedpb.BufferedStart
+	for _, start := range s.State.BufferedStarts {
+		if s.Schedule.State.Paused && !start.Manual {
+			continue
+		}
+		overlapPolicy := s.resolveOverlapPolicy(start.OverlapPolicy)
+		if overlapPolicy == enumsspb.SCHEDULE_OVERLAP_POLICY_ALLOW_ALL || (len(waiting) == 0 && len(s.Info.RunningWorkflows) == 0) {
+			s.startWorkflow(start)
+			continue
+		}
+		switch overlapPolicy {
+		case enumsspb.SCHEDULE_OVERLAP_POLICY_BUFFER_ALL:
+			waiting = append(waiting, start)
+		case enumsspb.SCHEDULE_OVERLAP_POLICY_BUFFER_ONE, enumsspb.SCHEDULE_OVERLAP_POLICY_CANCEL_OTHER:
+			if len(waiting) > 0 {
+				s.Info.OverlapSkipped++
+				continue
+			}
+			if overlapPolicy == enumsspb.SCHEDULE_OVERLAP_POLICY_CANCEL_OTHER {
+				s.stopRunning(false)
+			}
+			waiting = append(waiting, start)
+		case enumsspb.SCHEDULE_OVERLAP_POLICY_TERMINATE_OTHER:
+			if len(waiting) > 0 {
+				s.Info.OverlapSkipped++
+				continue
+			}
+			if s.stopRunning(true) {
+				s.Info.RunningWorkflows = nil
+				s.startWorkflow(start)
+				continue
+			}
+			waiting = append(waiting, start)
+		default:
+			s.Info.OverlapSkipped++
+		}
+	}
+	s.State.BufferedStarts = waiting
+}
+
+func (s *scheduler) startWorkflow(start *schedpb.BufferedStart) {
+	if !start.Manual && s.Schedule.State.LimitedActions {
+		if s.Schedule.State.RemainingActions <= 0 {
+			return
+		}
+		s.Schedule.State.RemainingActions--
+	}
+
+	var requestID string
+	if err := workflow.SideEffect(s.ctx, func(workflow.Context) interface{} {
+		return uuid.New()
+	}).Get(&requestID); err != nil {
+		s.logger.Error("Unable to generate request id.", "error", err)
+		return
+	}
+	workflowID := s.actionWorkflowID(*start.NominalTime)
+	request := &schedpb.StartWorkflowRequest{
+		NamespaceId: s.State.NamespaceId,
+		RequestId:   requestID,
+		WorkflowId:  workflowID,
+		Action:      s.Schedule.Action,
+	}
+	var response schedpb.StartWorkflowResponse
+	ctx := workflow.WithActivityOptions(s.ctx, activityOptions)
+	if err := workflow.ExecuteActivity(ctx, s.a.StartWorkflow, request).Get(ctx, &response); err != nil {
+		s.logger.Error("Unable to start workflow.", "workflow-id", workflowID, "error", err)
+		return
+	}
+
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: workflowID,
+		RunId:      response.RunId,
+	}
+	s.Info.ActionCount++
+	s.Info.RunningWorkflows = append(s.Info.RunningWorkflows, execution)
+	s.Info.RecentActions = append(s.Info.RecentActions, &schedpb.ScheduleActionResult{
+		ScheduleTime:        start.ActualTime,
+		ActualTime:          timestamp.TimePtr(s.now()),
+		StartWorkflowResult: execution,
+	})
+	if extra := len(s.Info.RecentActions) - maxRecentActions; extra > 0 {
+		s.Info.RecentActions = s.Info.RecentActions[extra:]
+	}
+}
+
+func (s *scheduler) actionWorkflowID(nominal time.Time) string {
+	prefix := s.Schedule.Action.WorkflowId
+	if prefix == "" {
+		prefix = s.State.ScheduleId
+	}
+	return prefix + "-" + nominal.UTC().Format(time.RFC3339)
+}
+
+// refreshRunning drops the closed workflows from the running workflows of the schedule and pauses
+// the schedule if one of them failed and the schedule should be paused on failure.
+func (s *scheduler) refreshRunning() {
+	request := &schedpb.RefreshRunningRequest{
+		NamespaceId: s.State.NamespaceId,
+		Executions:  s.Info.RunningWorkflows,
+	}
+	var response schedpb.RefreshRunningResponse
+	ctx := workflow.WithActivityOptions(s.ctx, activityOptions)
+	if err := workflow.ExecuteActivity(ctx, s.a.RefreshRunning, request).Get(ctx, &response); err != nil {
+		s.logger.Error("Unable to refresh running workflows.", "error", err)
+		return
+	}
+	s.Info.RunningWorkflows = response.Running
+	if !s.Schedule.Policies.PauseOnFailure {
+		return
+	}
+	for i, status := range response.ClosedStatuses {
+		if status == enumspb.WORKFLOW_EXECUTION_STATUS_FAILED || status == enumspb.WORKFLOW_EXECUTION_STATUS_TIMED_OUT {
+			s.Schedule.State.Paused = true
+			s.Schedule.State.Notes = fmt.Sprintf("Paused because workflow %v closed with status %v.", response.Closed[i].GetWorkflowId(), status)
+			s.logger.Info("Schedule paused because of workflow failure.", "workflow-id", response.Closed[i].GetWorkflowId())
+		}
+	}
+}
+
+// stopRunning cancels or terminates the running workflows of the schedule and reports whether it succeeded.
+func (s *scheduler) stopRunning(terminate bool) bool {
+	request := &schedpb.StopWorkflowsRequest{
+		NamespaceId: s.State.NamespaceId,
+		Executions:  s.Info.RunningWorkflows,
+		Terminate:   terminate,
+		Reason:      "overlap policy of schedule " + s.State.ScheduleId,
+	}
+	ctx := workflow.WithActivityOptions(s.ctx, activityOptions)
+	if err := workflow.ExecuteActivity(ctx, s.a.StopWorkflows, request).Get(ctx, nil); err != nil {
+		s.logger.Error("Unable to stop running workflows.", "error", err)
+		return false
+	}
+	return true
+}
+
+// sleep blocks until the next action time, a signal or, if a buffered action waits for running
+// workflows to close, the next refresh of the running workflows.
+func (s *scheduler) sleep(nextWakeup time.Time, updateCh workflow.ReceiveChannel, patchCh workflow.ReceiveChannel) {
+	if len(s.Info.RunningWorkflows) > 0 && (len(s.State.BufferedStarts) > 0 || s.Schedule.Policies.PauseOnFailure) {
+		if refreshTime := s.now().Add(refreshInterval); nextWakeup.IsZero() || refreshTime.Before(nextWakeup) {
+			nextWakeup = refreshTime
+		}
+	}
+
+	selector := workflow.NewSelector(s.ctx)
+	selector.AddReceive(updateCh, func(c workflow.ReceiveChannel, _ bool) {
+		var request schedpb.FullUpdateRequest
+		c.Receive(s.ctx, &request)
+		s.processUpdate(&request)
+	})
+	selector.AddReceive(patchCh, func(c workflow.ReceiveChannel, _ bool) {
+		var patch schedpb.SchedulePatch
+		c.Receive(s.ctx, &patch)
+		s.processPatch(&patch)
+	})
+	if !nextWakeup.IsZero() {
+		ctx, cancel := workflow.WithCancel(s.ctx)
+		defer cancel()
+		selector.AddFuture(workflow.NewTimer(ctx, nextWakeup.Sub(s.now())), func(workflow.Future) {})
+	}
+	selector.Select(s.ctx)
+	for selector.HasPending() {
+		selector.Select(s.ctx)
+	}
+}
+
+// processSignal applies one pending signal without blocking and reports whether there was one.
+func (s *scheduler) processSignal(updateCh workflow.ReceiveChannel, patchCh workflow.ReceiveChannel) bool {
+	var request schedpb.FullUpdateRequest
+	if updateCh.ReceiveAsync(&request) {
+		s.processUpdate(&request)
+		return true
+	}
+	var patch schedpb.SchedulePatch
+	if patchCh.ReceiveAsync(&patch) {
+		s.processPatch(&patch)
+		return true
+	}
+	return false
+}
+
+func (s *scheduler) processUpdate(request *schedpb.FullUpdateRequest) {
+	if request.ConflictToken != 0 && request.ConflictToken != s.State.ConflictToken {
+		s.logger.Warn("Schedule update dropped because of conflict token mismatch.", "conflict-token", request.ConflictToken)
+		return
+	}
+	now := s.now()
+	s.Schedule = request.Schedule
+	s.ensureFields()
+	s.compileSpec()
+	s.Info.UpdateTime = &now
+	// The new spec applies from now on, actions between the last processed time and now were
+	// already taken according to the old one.
+	s.State.LastProcessedTime = &now
+	s.State.ConflictToken++
+}
+
+func (s *scheduler) processPatch(patch *schedpb.SchedulePatch) {
+	now := s.now()
+	if trigger := patch.TriggerImmediately; trigger != nil {
+		s.addStart(now, now, trigger.OverlapPolicy, true)
+	}
+	for _, backfill := range patch.BackfillRequest {
+		s.processTimeRange(
+			timestamp.TimeValue(backfill.StartTime).Add(-time.Nanosecond),
+			timestamp.TimeValue(backfill.EndTime),
+			true,
+			backfill.OverlapPolicy,
+		)
+	}
+	if patch.Pause != "" {
+		s.Schedule.State.Paused = true
+		s.Schedule.State.Notes = patch.Pause
+	}
+	if patch.Unpause != "" {
+		s.Schedule.State.Paused = false
+		s.Schedule.State.Notes = patch.Unpause
+	}
+}
+
+func (s *scheduler) handleDescribeQuery() (*schedpb.DescribeResponse, error) {
+	info := *s.Info
+	info.FutureActionTimes = s.getFutureActionTimes(maxFutureActionTimes)
+	return &schedpb.DescribeResponse{
+		Schedule:      s.Schedule,
+		Info:          &info,
+		ConflictToken: s.State.ConflictToken,
+	}, nil
+}
+
+func (s *scheduler) getFutureActionTimes(n int) []*time.Time {
+	if s.cspec == nil || s.Schedule.State.Paused {
+		return nil
+	}
+	if s.Schedule.State.LimitedActions && int64(n) > s.Schedule.State.RemainingActions {
+		n = int(s.Schedule.State.RemainingActions)
+	}
+	var times []*time.Time
+	t := timestamp.TimeValue(s.State.LastProcessedTime)
+	for len(times) < n {
+		_, next := s.cspec.getNextTime(t)
+		if next.IsZero() {
+			break
+		}
+		times = append(times, timestamp.TimePtr(next))
+		t = next
+	}
+	return times
+}
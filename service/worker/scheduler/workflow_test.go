@@ -0,0 +1,310 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/api/workflowservicemock/v1"
+	"go.temporal.io/sdk/testsuite"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	schedpb "go.temporal.io/server/api/schedule/v1"
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+const (
+	testNamespace   = "test-namespace"
+	testNamespaceID = "test-namespace-id"
+	testScheduleID  = "test-schedule"
+)
+
+type (
+	workflowSuite struct {
+		suite.Suite
+		testsuite.WorkflowTestSuite
+
+		controller         *gomock.Controller
+		mockFrontendClient *workflowservicemock.MockWorkflowServiceClient
+		mockNamespaceCache *cache.MockNamespaceCache
+
+		activities *activities
+		env        *testsuite.TestWorkflowEnvironment
+		// Workflow ids of the started workflows and whether they are still running.
+		started []string
+		running map[string]bool
+	}
+)
+
+func TestWorkflowSuite(t *testing.T) {
+	suite.Run(t, new(workflowSuite))
+}
+
+func (s *workflowSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.mockFrontendClient = workflowservicemock.NewMockWorkflowServiceClient(s.controller)
+	s.mockNamespaceCache = cache.NewMockNamespaceCache(s.controller)
+
+	s.activities = newActivities(
+		s.mockFrontendClient,
+		s.mockNamespaceCache,
+		metrics.NewNoopMetricsClient(),
+		log.NewNoopLogger(),
+	)
+
+	s.started = nil
+	s.running = make(map[string]bool)
+	s.env = s.NewTestWorkflowEnvironment()
+	s.env.SetStartTime(testStartTime)
+	s.env.RegisterActivity(s.activities)
+	s.env.OnActivity(s.activities.StartWorkflow, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, request *schedpb.StartWorkflowRequest) (*schedpb.StartWorkflowResponse, error) {
+			s.Equal(testNamespaceID, request.NamespaceId)
+			s.NotEmpty(request.RequestId)
+			s.started = append(s.started, request.WorkflowId)
+			s.running[request.WorkflowId] = true
+			return &schedpb.StartWorkflowResponse{RunId: "run-" + request.WorkflowId}, nil
+		})
+	s.env.OnActivity(s.activities.RefreshRunning, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, request *schedpb.RefreshRunningRequest) (*schedpb.RefreshRunningResponse, error) {
+			response := &schedpb.RefreshRunningResponse{}
+			for _, execution := range request.Executions {
+				if s.running[execution.WorkflowId] {
+					response.Running = append(response.Running, execution)
+				} else {
+					response.Closed = append(response.Closed, execution)
+					response.ClosedStatuses = append(response.ClosedStatuses, enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED)
+				}
+			}
+			return response, nil
+		})
+}
+
+func (s *workflowSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *workflowSuite) newArgs(overlapPolicy enumsspb.ScheduleOverlapPolicy) *schedpb.StartScheduleArgs {
+	return &schedpb.StartScheduleArgs{
+		Schedule: &schedpb.Schedule{
+			Spec: &schedpb.ScheduleSpec{
+				Interval: []*schedpb.IntervalSpec{{Interval: timestamp.DurationPtr(time.Hour)}},
+			},
+			Action: &schedpb.ScheduleAction{
+				WorkflowId:   "wf",
+				WorkflowType: &commonpb.WorkflowType{Name: "test-workflow"},
+			},
+			Policies: &schedpb.SchedulePolicies{OverlapPolicy: overlapPolicy},
+		},
+		State: &schedpb.InternalState{
+			Namespace:   testNamespace,
+			NamespaceId: testNamespaceID,
+			ScheduleId:  testScheduleID,
+		},
+	}
+}
+
+// at runs f at the given offset from testStartTime.
+func (s *workflowSuite) at(offset time.Duration, f func()) {
+	s.env.RegisterDelayedCallback(f, offset)
+}
+
+func (s *workflowSuite) describe() *schedpb.DescribeResponse {
+	value, err := s.env.QueryWorkflow(QueryNameDescribe)
+	s.NoError(err)
+	var response schedpb.DescribeResponse
+	s.NoError(value.Get(&response))
+	return &response
+}
+
+func (s *workflowSuite) run(args *schedpb.StartScheduleArgs, end time.Duration) {
+	s.at(end, s.env.CancelWorkflow)
+	s.env.ExecuteWorkflow(SchedulerWorkflow, args)
+	s.True(s.env.IsWorkflowCompleted())
+}
+
+func (s *workflowSuite) TestScheduledActions() {
+	s.at(150*time.Minute, func() {
+		response := s.describe()
+		s.Equal(int64(2), response.Info.ActionCount)
+		s.Equal(testStartTime, *response.Info.CreateTime)
+		s.Len(response.Info.RecentActions, 2)
+		s.Equal(testStartTime.Add(2*time.Hour), *response.Info.RecentActions[1].ScheduleTime)
+		s.Equal("wf-2021-07-01T02:00:00Z", response.Info.RecentActions[1].StartWorkflowResult.WorkflowId)
+		s.Len(response.Info.FutureActionTimes, maxFutureActionTimes)
+		s.Equal(testStartTime.Add(3*time.Hour), *response.Info.FutureActionTimes[0])
+	})
+	s.at(130*time.Minute, func() { s.running = make(map[string]bool) })
+	s.at(70*time.Minute, func() { s.running = make(map[string]bool) })
+
+	s.run(s.newArgs(enumsspb.SCHEDULE_OVERLAP_POLICY_UNSPECIFIED), 200*time.Minute)
+	s.Equal([]string{"wf-2021-07-01T01:00:00Z", "wf-2021-07-01T02:00:00Z", "wf-2021-07-01T03:00:00Z"}, s.started)
+}
+
+func (s *workflowSuite) TestOverlapSkip() {
+	s.at(150*time.Minute, func() {
+		response := s.describe()
+		s.Equal(int64(1), response.Info.ActionCount)
+		s.Equal(int64(1), response.Info.OverlapSkipped)
+		s.Len(response.Info.RunningWorkflows, 1)
+	})
+
+	s.run(s.newArgs(enumsspb.SCHEDULE_OVERLAP_POLICY_SKIP), 170*time.Minute)
+	s.Equal([]string{"wf-2021-07-01T01:00:00Z"}, s.started)
+}
+
+func (s *workflowSuite) TestOverlapBufferOne() {
+	// Actions at 2h and 3h wait for the first workflow, the one at 3h is skipped since one is buffered already.
+	s.at(200*time.Minute, func() { s.running = make(map[string]bool) })
+	s.at(230*time.Minute, func() {
+		response := s.describe()
+		s.Equal(int64(2), response.Info.ActionCount)
+		s.Equal(int64(1), response.Info.OverlapSkipped)
+	})
+
+	s.run(s.newArgs(enumsspb.SCHEDULE_OVERLAP_POLICY_BUFFER_ONE), 230*time.Minute)
+	s.Equal([]string{"wf-2021-07-01T01:00:00Z", "wf-2021-07-01T02:00:00Z"}, s.started)
+}
+
+func (s *workflowSuite) TestOverlapTerminateOther() {
+	s.env.OnActivity(s.activities.StopWorkflows, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, request *schedpb.StopWorkflowsRequest) error {
+			s.True(request.Terminate)
+			for _, execution := range request.Executions {
+				delete(s.running, execution.WorkflowId)
+			}
+			return nil
+		}).Times(2)
+
+	s.run(s.newArgs(enumsspb.SCHEDULE_OVERLAP_POLICY_TERMINATE_OTHER), 190*time.Minute)
+	s.Equal([]string{"wf-2021-07-01T01:00:00Z", "wf-2021-07-01T02:00:00Z", "wf-2021-07-01T03:00:00Z"}, s.started)
+}
+
+func (s *workflowSuite) TestPauseAndTrigger() {
+	s.at(30*time.Minute, func() {
+		s.env.SignalWorkflow(SignalNamePatch, &schedpb.SchedulePatch{Pause: "maintenance"})
+	})
+	s.at(90*time.Minute, func() {
+		s.env.SignalWorkflow(SignalNamePatch, &schedpb.SchedulePatch{
+			TriggerImmediately: &schedpb.TriggerImmediatelyRequest{},
+		})
+	})
+	s.at(150*time.Minute, func() {
+		response := s.describe()
+		s.True(response.Schedule.State.Paused)
+		s.Equal("maintenance", response.Schedule.State.Notes)
+		s.Equal(int64(1), response.Info.ActionCount)
+		s.Empty(response.Info.FutureActionTimes)
+		s.env.SignalWorkflow(SignalNamePatch, &schedpb.SchedulePatch{Unpause: "done"})
+	})
+
+	s.run(s.newArgs(enumsspb.SCHEDULE_OVERLAP_POLICY_ALLOW_ALL), 190*time.Minute)
+	s.Equal([]string{"wf-2021-07-01T01:30:00Z", "wf-2021-07-01T03:00:00Z"}, s.started)
+}
+
+func (s *workflowSuite) TestBackfill() {
+	s.at(30*time.Minute, func() {
+		s.env.SignalWorkflow(SignalNamePatch, &schedpb.SchedulePatch{
+			BackfillRequest: []*schedpb.BackfillRequest{{
+				StartTime:     timestamp.TimePtr(testStartTime.Add(-3 * time.Hour)),
+				EndTime:       timestamp.TimePtr(testStartTime.Add(-time.Hour)),
+				OverlapPolicy: enumsspb.SCHEDULE_OVERLAP_POLICY_ALLOW_ALL,
+			}},
+		})
+	})
+
+	s.run(s.newArgs(enumsspb.SCHEDULE_OVERLAP_POLICY_SKIP), 50*time.Minute)
+	s.Equal([]string{"wf-2021-06-30T21:00:00Z", "wf-2021-06-30T22:00:00Z", "wf-2021-06-30T23:00:00Z"}, s.started)
+}
+
+func (s *workflowSuite) TestUpdate() {
+	s.at(30*time.Minute, func() {
+		response := s.describe()
+		s.Equal(int64(0), response.ConflictToken)
+
+		schedule := response.Schedule
+		schedule.Spec = &schedpb.ScheduleSpec{
+			Calendar: []*schedpb.CalendarSpec{{Minute: "45"}},
+		}
+		// The update with a stale conflict token is dropped.
+		s.env.SignalWorkflow(SignalNameUpdate, &schedpb.FullUpdateRequest{Schedule: &schedpb.Schedule{}, ConflictToken: 5})
+		s.env.SignalWorkflow(SignalNameUpdate, &schedpb.FullUpdateRequest{Schedule: schedule})
+	})
+	s.at(40*time.Minute, func() {
+		response := s.describe()
+		s.Equal(int64(1), response.ConflictToken)
+		s.Equal(testStartTime.Add(30*time.Minute), *response.Info.UpdateTime)
+		s.Equal(testStartTime.Add(45*time.Minute), *response.Info.FutureActionTimes[0])
+	})
+
+	s.run(s.newArgs(enumsspb.SCHEDULE_OVERLAP_POLICY_ALLOW_ALL), 50*time.Minute)
+	s.Equal([]string{"wf-2021-07-01T00:45:00Z"}, s.started)
+}
+
+func (s *workflowSuite) TestRefreshRunningActivity() {
+	s.mockNamespaceCache.EXPECT().GetNamespaceName(testNamespaceID).Return(testNamespace, nil)
+	s.mockFrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *workflowservice.DescribeWorkflowExecutionRequest, _ ...interface{}) (*workflowservice.DescribeWorkflowExecutionResponse, error) {
+			s.Equal(testNamespace, request.Namespace)
+			s.Empty(request.Execution.RunId)
+			switch request.Execution.WorkflowId {
+			case "running":
+				return &workflowservice.DescribeWorkflowExecutionResponse{
+					WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{Status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING},
+				}, nil
+			case "failed":
+				return &workflowservice.DescribeWorkflowExecutionResponse{
+					WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{Status: enumspb.WORKFLOW_EXECUTION_STATUS_FAILED},
+				}, nil
+			default:
+				return nil, serviceerror.NewNotFound("workflow not found")
+			}
+		}).Times(3)
+
+	running := &commonpb.WorkflowExecution{WorkflowId: "running", RunId: "run-1"}
+	failed := &commonpb.WorkflowExecution{WorkflowId: "failed", RunId: "run-2"}
+	deleted := &commonpb.WorkflowExecution{WorkflowId: "deleted", RunId: "run-3"}
+	response, err := s.activities.RefreshRunning(context.Background(), &schedpb.RefreshRunningRequest{
+		NamespaceId: testNamespaceID,
+		Executions:  []*commonpb.WorkflowExecution{running, failed, deleted},
+	})
+	s.NoError(err)
+	s.Equal([]*commonpb.WorkflowExecution{running}, response.Running)
+	s.Equal([]*commonpb.WorkflowExecution{failed, deleted}, response.Closed)
+	s.Equal([]enumspb.WorkflowExecutionStatus{enumspb.WORKFLOW_EXECUTION_STATUS_FAILED, enumspb.WORKFLOW_EXECUTION_STATUS_UNSPECIFIED}, response.ClosedStatuses)
+}
@@ -0,0 +1,345 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package searchattributemetrics
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/rpc"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+const (
+	// serviceResolverKey is used to pick single worker which emits search attribute metrics.
+	serviceResolverKey = "search-attribute-metrics"
+
+	refreshJitterCoefficient = 0.2
+	requestTimeout           = 10 * time.Second
+
+	openWorkflowsQuery = "ExecutionStatus = 'Running'"
+)
+
+type (
+	// Config contains the configuration of search attribute metrics exporter
+	Config struct {
+		RefreshInterval dynamicconfig.DurationPropertyFn
+		GroupBy         dynamicconfig.StringPropertyFnWithNamespaceFilter
+		Values          dynamicconfig.StringPropertyFnWithNamespaceFilter
+		TopN            dynamicconfig.IntPropertyFnWithNamespaceFilter
+		SampleSize      dynamicconfig.IntPropertyFnWithNamespaceFilter
+	}
+
+	// Exporter periodically counts workflows of every namespace grouped by the configured search attribute
+	// and emits the counts as gauges. Counts of open workflows are emitted, except when workflows are grouped
+	// by ExecutionStatus, in which case counts of workflows in every status are emitted.
+	Exporter struct {
+		status          int32
+		config          *Config
+		frontendClient  workflowservice.WorkflowServiceClient
+		namespaceCache  cache.NamespaceCache
+		serviceResolver membership.ServiceResolver
+		hostInfo        *membership.HostInfo
+		metricsClient   metrics.Client
+		logger          log.Logger
+		stopC           chan struct{}
+
+		// emitted holds search attribute values gauges were emitted for, by namespace name
+		emitted map[string]map[group]struct{}
+	}
+
+	group struct {
+		searchAttribute string
+		value           string
+	}
+)
+
+var _ common.Daemon = (*Exporter)(nil)
+
+// New creates a new search attribute metrics exporter
+func New(
+	config *Config,
+	frontendClient workflowservice.WorkflowServiceClient,
+	namespaceCache cache.NamespaceCache,
+	serviceResolver membership.ServiceResolver,
+	hostInfo *membership.HostInfo,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) *Exporter {
+	return &Exporter{
+		status:          common.DaemonStatusInitialized,
+		config:          config,
+		frontendClient:  frontendClient,
+		namespaceCache:  namespaceCache,
+		serviceResolver: serviceResolver,
+		hostInfo:        hostInfo,
+		metricsClient:   metricsClient,
+		logger:          log.With(logger, tag.ComponentSearchAttributeMetrics),
+		stopC:           make(chan struct{}),
+		emitted:         make(map[string]map[group]struct{}),
+	}
+}
+
+// Start starts the exporter
+func (e *Exporter) Start() {
+	if !atomic.CompareAndSwapInt32(&e.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+
+	go e.refreshLoop()
+	e.logger.Info("", tag.LifeCycleStarted)
+}
+
+// Stop stops the exporter
+func (e *Exporter) Stop() {
+	if !atomic.CompareAndSwapInt32(&e.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+
+	close(e.stopC)
+	e.logger.Info("", tag.LifeCycleStopped)
+}
+
+func (e *Exporter) refreshLoop() {
+	timer := time.NewTimer(e.refreshInterval())
+	for {
+		select {
+		case <-timer.C:
+			e.refresh()
+			timer.Reset(e.refreshInterval())
+		case <-e.stopC:
+			timer.Stop()
+			return
+		}
+	}
+}
+
+func (e *Exporter) refreshInterval() time.Duration {
+	return backoff.JitDuration(e.config.RefreshInterval(), refreshJitterCoefficient)
+}
+
+func (e *Exporter) refresh() {
+	// Only one worker emits the gauges, so they are not reported by several hosts at the same time.
+	info, err := e.serviceResolver.Lookup(serviceResolverKey)
+	if err != nil {
+		e.logger.Info("Failed to lookup host info. Skip current run", tag.Error(err))
+		return
+	}
+	if info.Identity() != e.hostInfo.Identity() {
+		e.resetAll()
+		return
+	}
+
+	namespaces := make(map[string]struct{})
+	for _, entry := range e.namespaceCache.GetAllNamespace() {
+		if entry.GetInfo().GetState() == enumspb.NAMESPACE_STATE_DELETED {
+			continue
+		}
+
+		namespace := entry.GetInfo().GetName()
+		namespaces[namespace] = struct{}{}
+		if err := e.refreshNamespace(namespace); err != nil {
+			e.metricsClient.Scope(metrics.SearchAttributeMetricsScope, metrics.NamespaceTag(namespace)).
+				IncCounter(metrics.SearchAttributeMetricsFailuresCount)
+			e.logger.Warn("Failed to refresh search attribute metrics.", tag.WorkflowNamespace(namespace), tag.Error(err))
+		}
+	}
+
+	for namespace := range e.emitted {
+		if _, ok := namespaces[namespace]; !ok {
+			e.reset(namespace, nil)
+		}
+	}
+}
+
+func (e *Exporter) refreshNamespace(namespace string) error {
+	searchAttribute := strings.TrimSpace(e.config.GroupBy(namespace))
+	if searchAttribute == "" {
+		e.reset(namespace, nil)
+		return nil
+	}
+
+	values, err := e.groupValues(namespace, searchAttribute)
+	if err != nil {
+		return err
+	}
+
+	counted := make(map[group]struct{}, len(values))
+	for _, value := range values {
+		count, err := e.count(namespace, searchAttribute, value)
+		if err != nil {
+			return err
+		}
+
+		g := group{searchAttribute: searchAttribute, value: value}
+		e.updateGauge(namespace, g, float64(count))
+		counted[g] = struct{}{}
+		if e.emitted[namespace] == nil {
+			e.emitted[namespace] = make(map[group]struct{})
+		}
+		e.emitted[namespace][g] = struct{}{}
+	}
+
+	e.reset(namespace, counted)
+	return nil
+}
+
+// groupValues returns configured search attribute values, all statuses for ExecutionStatus,
+// or top values of sampled open workflows otherwise.
+func (e *Exporter) groupValues(namespace string, searchAttribute string) ([]string, error) {
+	var values []string
+	for _, value := range strings.Split(e.config.Values(namespace), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	if len(values) > 0 {
+		return values, nil
+	}
+
+	if searchAttribute == searchattribute.ExecutionStatus {
+		for status := range enumspb.WorkflowExecutionStatus_name {
+			if status != int32(enumspb.WORKFLOW_EXECUTION_STATUS_UNSPECIFIED) {
+				values = append(values, enumspb.WorkflowExecutionStatus(status).String())
+			}
+		}
+		sort.Strings(values)
+		return values, nil
+	}
+
+	ctx, cancel := rpc.NewContextWithTimeoutAndHeaders(requestTimeout)
+	defer cancel()
+	resp, err := e.frontendClient.ListWorkflowExecutions(ctx, &workflowservice.ListWorkflowExecutionsRequest{
+		Namespace: namespace,
+		PageSize:  int32(e.config.SampleSize(namespace)),
+		Query:     openWorkflowsQuery,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	occurrences := make(map[string]int)
+	for _, execution := range resp.GetExecutions() {
+		if value, ok := searchAttributeValue(execution, searchAttribute); ok {
+			occurrences[value]++
+		}
+	}
+	for value := range occurrences {
+		values = append(values, value)
+	}
+	sort.Slice(values, func(i, j int) bool {
+		if occurrences[values[i]] != occurrences[values[j]] {
+			return occurrences[values[i]] > occurrences[values[j]]
+		}
+		return values[i] < values[j]
+	})
+	if topN := e.config.TopN(namespace); len(values) > topN {
+		values = values[:topN]
+	}
+	return values, nil
+}
+
+func (e *Exporter) count(namespace string, searchAttribute string, value string) (int64, error) {
+	query := fmt.Sprintf("%s = '%s'", searchAttribute, value)
+	if searchAttribute != searchattribute.ExecutionStatus {
+		query = fmt.Sprintf("%s AND %s", openWorkflowsQuery, query)
+	}
+
+	ctx, cancel := rpc.NewContextWithTimeoutAndHeaders(requestTimeout)
+	defer cancel()
+	resp, err := e.frontendClient.CountWorkflowExecutions(ctx, &workflowservice.CountWorkflowExecutionsRequest{
+		Namespace: namespace,
+		Query:     query,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.GetCount(), nil
+}
+
+// reset zeroes gauges emitted for the namespace which are not in keep, so stale values are not reported.
+func (e *Exporter) reset(namespace string, keep map[group]struct{}) {
+	for g := range e.emitted[namespace] {
+		if _, ok := keep[g]; ok {
+			continue
+		}
+		e.updateGauge(namespace, g, 0)
+		delete(e.emitted[namespace], g)
+	}
+	if len(e.emitted[namespace]) == 0 {
+		delete(e.emitted, namespace)
+	}
+}
+
+func (e *Exporter) resetAll() {
+	for namespace := range e.emitted {
+		e.reset(namespace, nil)
+	}
+}
+
+func (e *Exporter) updateGauge(namespace string, g group, value float64) {
+	e.metricsClient.Scope(
+		metrics.SearchAttributeMetricsScope,
+		metrics.NamespaceTag(namespace),
+		metrics.SearchAttributeTag(g.searchAttribute),
+		metrics.SearchAttributeValueTag(g.value),
+	).UpdateGauge(metrics.SearchAttributeWorkflowCount, value)
+}
+
+// searchAttributeValue returns string value of search attribute of workflow execution.
+func searchAttributeValue(execution *workflowpb.WorkflowExecutionInfo, searchAttribute string) (string, bool) {
+	switch searchAttribute {
+	case searchattribute.WorkflowType:
+		return execution.GetType().GetName(), true
+	case searchattribute.TaskQueue:
+		return execution.GetTaskQueue(), true
+	}
+
+	p, ok := execution.GetSearchAttributes().GetIndexedFields()[searchAttribute]
+	if !ok {
+		return "", false
+	}
+	var value string
+	if err := payload.Decode(p, &value); err != nil || strings.Contains(value, "'") {
+		return "", false
+	}
+	return value, true
+}
@@ -0,0 +1,212 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package searchattributemetrics
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/api/workflowservicemock/v1"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+type (
+	exporterSuite struct {
+		*require.Assertions
+		suite.Suite
+
+		controller         *gomock.Controller
+		mockFrontendClient *workflowservicemock.MockWorkflowServiceClient
+		mockNamespaceCache *cache.MockNamespaceCache
+		mockResolver       *membership.MockServiceResolver
+		scope              tally.TestScope
+
+		groupBy  string
+		values   string
+		hostInfo *membership.HostInfo
+		exporter *Exporter
+	}
+)
+
+const (
+	testNamespace = "test-namespace"
+	testGauge     = "search_attribute_workflow_count"
+)
+
+func TestExporterSuite(t *testing.T) {
+	suite.Run(t, new(exporterSuite))
+}
+
+func (s *exporterSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.mockFrontendClient = workflowservicemock.NewMockWorkflowServiceClient(s.controller)
+	s.mockNamespaceCache = cache.NewMockNamespaceCache(s.controller)
+	s.mockResolver = membership.NewMockServiceResolver(s.controller)
+	s.scope = tally.NewTestScope("", nil)
+	s.hostInfo = membership.NewHostInfo("127.0.0.1:7239", nil)
+
+	s.groupBy = searchattribute.WorkflowType
+	s.values = ""
+	s.exporter = New(
+		&Config{
+			GroupBy:    func(namespace string) string { return s.groupBy },
+			Values:     func(namespace string) string { return s.values },
+			TopN:       dynamicconfig.GetIntPropertyFilteredByNamespace(2),
+			SampleSize: dynamicconfig.GetIntPropertyFilteredByNamespace(100),
+		},
+		s.mockFrontendClient,
+		s.mockNamespaceCache,
+		s.mockResolver,
+		s.hostInfo,
+		metrics.NewClient(s.scope, metrics.Worker),
+		log.NewNoopLogger(),
+	)
+
+	s.mockNamespaceCache.EXPECT().GetAllNamespace().Return(map[string]*cache.NamespaceCacheEntry{
+		"test-namespace-id": cache.NewLocalNamespaceCacheEntryForTest(
+			&persistencespb.NamespaceInfo{Id: "test-namespace-id", Name: testNamespace},
+			&persistencespb.NamespaceConfig{},
+			"active",
+			nil,
+		),
+	}).AnyTimes()
+}
+
+func (s *exporterSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *exporterSuite) TestRefresh_TopValues() {
+	s.mockResolver.EXPECT().Lookup(serviceResolverKey).Return(s.hostInfo, nil)
+	s.mockFrontendClient.EXPECT().ListWorkflowExecutions(gomock.Any(), &workflowservice.ListWorkflowExecutionsRequest{
+		Namespace: testNamespace,
+		PageSize:  100,
+		Query:     openWorkflowsQuery,
+	}).Return(&workflowservice.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{
+			{Type: &commonpb.WorkflowType{Name: "type-a"}},
+			{Type: &commonpb.WorkflowType{Name: "type-b"}},
+			{Type: &commonpb.WorkflowType{Name: "type-b"}},
+			{Type: &commonpb.WorkflowType{Name: "type-c"}},
+		},
+	}, nil)
+	s.expectCount("ExecutionStatus = 'Running' AND WorkflowType = 'type-b'", 20)
+	s.expectCount("ExecutionStatus = 'Running' AND WorkflowType = 'type-a'", 10)
+
+	s.exporter.refresh()
+	s.Equal(map[string]float64{"type-a": 10, "type-b": 20}, s.gauges(searchattribute.WorkflowType))
+
+	// value dropped out of top values is reset
+	s.values = "type-c"
+	s.mockResolver.EXPECT().Lookup(serviceResolverKey).Return(s.hostInfo, nil)
+	s.expectCount("ExecutionStatus = 'Running' AND WorkflowType = 'type-c'", 5)
+
+	s.exporter.refresh()
+	s.Equal(map[string]float64{"type-a": 0, "type-b": 0, "type-c": 5}, s.gauges(searchattribute.WorkflowType))
+}
+
+func (s *exporterSuite) TestRefresh_ExecutionStatus() {
+	s.groupBy = searchattribute.ExecutionStatus
+	s.mockResolver.EXPECT().Lookup(serviceResolverKey).Return(s.hostInfo, nil)
+	for status := range enumspb.WorkflowExecutionStatus_name {
+		if status != int32(enumspb.WORKFLOW_EXECUTION_STATUS_UNSPECIFIED) {
+			s.expectCount("ExecutionStatus = '"+enumspb.WorkflowExecutionStatus(status).String()+"'", int64(status))
+		}
+	}
+
+	s.exporter.refresh()
+	gauges := s.gauges(searchattribute.ExecutionStatus)
+	s.Len(gauges, len(enumspb.WorkflowExecutionStatus_name)-1)
+	s.Equal(float64(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING), gauges["Running"])
+}
+
+func (s *exporterSuite) TestRefresh_NotOwner() {
+	s.values = "type-a"
+	s.mockResolver.EXPECT().Lookup(serviceResolverKey).Return(s.hostInfo, nil)
+	s.expectCount("ExecutionStatus = 'Running' AND WorkflowType = 'type-a'", 10)
+	s.exporter.refresh()
+
+	s.mockResolver.EXPECT().Lookup(serviceResolverKey).Return(membership.NewHostInfo("127.0.0.2:7239", nil), nil)
+	s.exporter.refresh()
+	s.Equal(map[string]float64{"type-a": 0}, s.gauges(searchattribute.WorkflowType))
+	s.Empty(s.exporter.emitted)
+}
+
+func (s *exporterSuite) TestSearchAttributeValue() {
+	keyword, err := payload.Encode("value")
+	s.NoError(err)
+	execution := &workflowpb.WorkflowExecutionInfo{
+		TaskQueue: "task-queue",
+		SearchAttributes: &commonpb.SearchAttributes{
+			IndexedFields: map[string]*commonpb.Payload{"CustomKeywordField": keyword},
+		},
+	}
+
+	value, ok := searchAttributeValue(execution, searchattribute.TaskQueue)
+	s.True(ok)
+	s.Equal("task-queue", value)
+	value, ok = searchAttributeValue(execution, "CustomKeywordField")
+	s.True(ok)
+	s.Equal("value", value)
+	_, ok = searchAttributeValue(execution, "CustomIntField")
+	s.False(ok)
+}
+
+func (s *exporterSuite) expectCount(query string, count int64) {
+	s.mockFrontendClient.EXPECT().CountWorkflowExecutions(gomock.Any(), &workflowservice.CountWorkflowExecutionsRequest{
+		Namespace: testNamespace,
+		Query:     query,
+	}).Return(&workflowservice.CountWorkflowExecutionsResponse{Count: count}, nil)
+}
+
+func (s *exporterSuite) gauges(searchAttribute string) map[string]float64 {
+	result := make(map[string]float64)
+	for _, gauge := range s.scope.Snapshot().Gauges() {
+		tags := gauge.Tags()
+		if gauge.Name() != testGauge || tags[metrics.SearchAttributeTagName] != searchAttribute {
+			continue
+		}
+		s.Equal(testNamespace, tags["namespace"])
+		result[tags[metrics.SearchAttributeValueTagName]] = gauge.Value()
+	}
+	return result
+}
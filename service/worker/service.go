@@ -31,7 +31,7 @@ import (
 	"go.temporal.io/api/serviceerror"
 	sdkclient "go.temporal.io/sdk/client"
 	"go.temporal.io/server/common"
-	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -45,9 +45,14 @@ import (
 	"go.temporal.io/server/service/worker/addsearchattributes"
 	"go.temporal.io/server/service/worker/archiver"
 	"go.temporal.io/server/service/worker/batcher"
+	"go.temporal.io/server/service/worker/callback"
+	"go.temporal.io/server/service/worker/deletenamespace"
+	"go.temporal.io/server/service/worker/forcereplication"
 	"go.temporal.io/server/service/worker/parentclosepolicy"
 	"go.temporal.io/server/service/worker/replicator"
 	"go.temporal.io/server/service/worker/scanner"
+	"go.temporal.io/server/service/worker/scheduler"
+	"go.temporal.io/server/service/worker/searchattributemetrics"
 )
 
 type (
@@ -70,10 +75,15 @@ type (
 		ArchiverConfig                *archiver.Config
 		ScannerCfg                    *scanner.Config
 		BatcherCfg                    *batcher.Config
+		SearchAttributeMetricsCfg     *searchattributemetrics.Config
 		ThrottledLogRPS               dynamicconfig.IntPropertyFn
 		PersistenceGlobalMaxQPS       dynamicconfig.IntPropertyFn
 		EnableBatcher                 dynamicconfig.BoolPropertyFn
 		EnableParentClosePolicyWorker dynamicconfig.BoolPropertyFn
+		EnableCallbackWorker          dynamicconfig.BoolPropertyFn
+		EnableSchedulerWorker         dynamicconfig.BoolPropertyFn
+		CallbackAllowedHosts          dynamicconfig.StringPropertyFnWithNamespaceFilter
+		EnableSearchAttributeMetrics  dynamicconfig.BoolPropertyFn
 	}
 )
 
@@ -132,6 +142,7 @@ func NewConfig(params *resource.BootstrapParams) *Config {
 			ArchiverConcurrency:           dc.GetIntProperty(dynamicconfig.WorkerArchiverConcurrency, 50),
 			ArchivalsPerIteration:         dc.GetIntProperty(dynamicconfig.WorkerArchivalsPerIteration, 1000),
 			TimeLimitPerArchivalIteration: dc.GetDurationProperty(dynamicconfig.WorkerTimeLimitPerArchivalIteration, archiver.MaxArchivalIterationTimeout()),
+			BlobIntegrityCheckProbability: dc.GetFloat64Property(dynamicconfig.WorkerBlobIntegrityCheckProbability, 0),
 		},
 		ScannerCfg: &scanner.Config{
 			PersistenceMaxQPS:        dc.GetIntProperty(dynamicconfig.ScannerPersistenceMaxQPS, 100),
@@ -140,9 +151,20 @@ func NewConfig(params *resource.BootstrapParams) *Config {
 			HistoryScannerEnabled:    dc.GetBoolProperty(dynamicconfig.HistoryScannerEnabled, true),
 			ExecutionsScannerEnabled: dc.GetBoolProperty(dynamicconfig.ExecutionsScannerEnabled, false),
 		},
+		SearchAttributeMetricsCfg: &searchattributemetrics.Config{
+			RefreshInterval: dc.GetDurationProperty(dynamicconfig.SearchAttributeMetricsRefreshInterval, time.Minute),
+			GroupBy:         dc.GetStringPropertyFnWithNamespaceFilter(dynamicconfig.SearchAttributeMetricsGroupBy, searchattribute.ExecutionStatus),
+			Values:          dc.GetStringPropertyFnWithNamespaceFilter(dynamicconfig.SearchAttributeMetricsValues, ""),
+			TopN:            dc.GetIntPropertyFilteredByNamespace(dynamicconfig.SearchAttributeMetricsTopN, 10),
+			SampleSize:      dc.GetIntPropertyFilteredByNamespace(dynamicconfig.SearchAttributeMetricsSampleSize, 1000),
+		},
 		BatcherCfg:                    &batcher.Config{},
 		EnableBatcher:                 dc.GetBoolProperty(dynamicconfig.EnableBatcher, true),
 		EnableParentClosePolicyWorker: dc.GetBoolProperty(dynamicconfig.EnableParentClosePolicyWorker, true),
+		EnableCallbackWorker:          dc.GetBoolProperty(dynamicconfig.EnableCallbackWorker, true),
+		EnableSchedulerWorker:         dc.GetBoolProperty(dynamicconfig.EnableSchedulerWorker, true),
+		CallbackAllowedHosts:          dc.GetStringPropertyFnWithNamespaceFilter(dynamicconfig.CallbackAllowedHosts, ""),
+		EnableSearchAttributeMetrics:  dc.GetBoolProperty(dynamicconfig.EnableSearchAttributeMetrics, false),
 		ThrottledLogRPS:               dc.GetIntProperty(dynamicconfig.WorkerThrottledLogRPS, 20),
 		PersistenceGlobalMaxQPS:       dc.GetIntProperty(dynamicconfig.WorkerPersistenceGlobalMaxQPS, 0),
 	}
@@ -165,6 +187,7 @@ func (s *Service) Start() {
 
 	if s.GetClusterMetadata().IsGlobalNamespaceEnabled() {
 		s.startReplicator()
+		s.startForceReplication()
 	}
 	if s.GetArchivalMetadata().GetHistoryConfig().ClusterConfiguredForArchival() {
 		s.startArchiver()
@@ -175,8 +198,18 @@ func (s *Service) Start() {
 	if s.config.EnableParentClosePolicyWorker() {
 		s.startParentClosePolicyProcessor()
 	}
+	if s.config.EnableCallbackWorker() {
+		s.startCallbackProcessor()
+	}
+	if s.config.EnableSchedulerWorker() {
+		s.startScheduler()
+	}
+	if s.config.EnableSearchAttributeMetrics() {
+		s.startSearchAttributeMetrics()
+	}
 
 	s.startAddSearchAttributes()
+	s.startDeleteNamespace()
 
 	logger.Info("worker started", tag.ComponentWorker)
 	<-s.stopC
@@ -209,6 +242,32 @@ func (s *Service) startParentClosePolicyProcessor() {
 	}
 }
 
+func (s *Service) startCallbackProcessor() {
+	params := &callback.BootstrapParams{
+		ServiceClient: s.sdkClient,
+		MetricsClient: s.GetMetricsClient(),
+		Logger:        s.GetLogger(),
+		AllowedHosts:  s.config.CallbackAllowedHosts,
+	}
+	processor := callback.New(params)
+	if err := processor.Start(); err != nil {
+		s.GetLogger().Fatal("error starting callback processor", tag.Error(err))
+	}
+}
+
+func (s *Service) startSearchAttributeMetrics() {
+	exporter := searchattributemetrics.New(
+		s.config.SearchAttributeMetricsCfg,
+		s.GetFrontendClient(),
+		s.GetNamespaceCache(),
+		s.GetWorkerServiceResolver(),
+		s.GetHostInfo(),
+		s.GetMetricsClient(),
+		s.GetLogger(),
+	)
+	exporter.Start()
+}
+
 func (s *Service) startBatcher() {
 	params := &batcher.BootstrapParams{
 		Config:        *s.config.BatcherCfg,
@@ -226,7 +285,7 @@ func (s *Service) startAddSearchAttributes() {
 	addSearchAttributesService := addsearchattributes.New(
 		s.sdkClient,
 		s.esClient,
-		persistence.NewSearchAttributesManager(clock.NewRealTimeSource(), s.GetClusterMetadataManager()),
+		s.GetSearchAttributesManager(),
 		s.GetMetricsClient(),
 		s.GetLogger(),
 	)
@@ -235,6 +294,49 @@ func (s *Service) startAddSearchAttributes() {
 	}
 }
 
+func (s *Service) startForceReplication() {
+	forceReplicationService := forcereplication.New(
+		s.sdkClient,
+		s.GetFrontendClient(),
+		s.GetHistoryClient(),
+		s.GetNamespaceCache(),
+		s.GetMetricsClient(),
+		s.GetLogger(),
+	)
+	if err := forceReplicationService.Start(); err != nil {
+		s.GetLogger().Fatal("error starting force replication service", tag.Error(err))
+	}
+}
+
+func (s *Service) startDeleteNamespace() {
+	deleteNamespaceService := deletenamespace.New(
+		s.sdkClient,
+		s.GetFrontendClient(),
+		s.GetHistoryClient(),
+		s.GetMetadataManager(),
+		s.GetTaskManager(),
+		s.config.ScannerCfg.Persistence.DefaultStoreType() == config.StoreTypeSQL,
+		s.GetMetricsClient(),
+		s.GetLogger(),
+	)
+	if err := deleteNamespaceService.Start(); err != nil {
+		s.GetLogger().Fatal("error starting delete namespace service", tag.Error(err))
+	}
+}
+
+func (s *Service) startScheduler() {
+	schedulerService := scheduler.New(
+		s.sdkClient,
+		s.GetFrontendClient(),
+		s.GetNamespaceCache(),
+		s.GetMetricsClient(),
+		s.GetLogger(),
+	)
+	if err := schedulerService.Start(); err != nil {
+		s.GetLogger().Fatal("error starting scheduler service", tag.Error(err))
+	}
+}
+
 func (s *Service) startScanner() {
 	params := &scanner.BootstrapParams{
 		Config: *s.config.ScannerCfg,
@@ -247,6 +349,7 @@ func (s *Service) startScanner() {
 func (s *Service) startReplicator() {
 	namespaceReplicationTaskExecutor := namespace.NewReplicationTaskExecutor(
 		s.GetMetadataManager(),
+		s.GetSearchAttributesManager(),
 		s.GetLogger(),
 	)
 	msgReplicator := replicator.NewReplicator(
@@ -25,6 +25,7 @@
 package temporal
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sync"
@@ -32,12 +33,14 @@ import (
 
 	"github.com/pborman/uuid"
 	"github.com/uber-go/tally"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	sdkclient "go.temporal.io/sdk/client"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/provider"
 	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
@@ -55,6 +58,7 @@ import (
 	"go.temporal.io/server/common/ringpop"
 	"go.temporal.io/server/common/rpc"
 	"go.temporal.io/server/common/rpc/encryption"
+	"go.temporal.io/server/common/tracing"
 	"go.temporal.io/server/service/frontend"
 	"go.temporal.io/server/service/history"
 	"go.temporal.io/server/service/matching"
@@ -63,6 +67,8 @@ import (
 
 const (
 	mismatchLogMessage = "Supplied configuration key/value mismatches persisted cluster metadata. Continuing with the persisted value as this value cannot be changed once initialized."
+	// Values that can be changed after initialization are updated with the UpdateClusterMetadata admin API.
+	updatableMismatchLogMessage = "Supplied configuration key/value mismatches persisted cluster metadata. Continuing with the persisted value, use the admin API to change it."
 )
 
 type (
@@ -76,6 +82,9 @@ type (
 		namespaceLogger   log.Logger
 		serverReporter    metrics.Reporter
 		sdkReporter       metrics.Reporter
+		tracerProvider    *sdktrace.TracerProvider
+		// dynamicConfigMetadataManager is used to read dynamic config values stored in the database
+		dynamicConfigMetadataManager persistence.ClusterMetadataManager
 	}
 )
 
@@ -122,12 +131,19 @@ func (s *Server) Start() error {
 	}
 
 	if s.so.dynamicConfigClient == nil {
-		s.so.dynamicConfigClient, err = dynamicconfig.NewFileBasedClient(&s.so.config.DynamicConfigClient, s.logger, s.stoppedCh)
-		if err != nil {
-			s.logger.Info("Error creating file based dynamic config client, use no-op config client instead.", tag.Error(err))
+		if s.so.config.DynamicConfigClient.Filepath == "" {
+			s.logger.Warn("Dynamic config file is not configured, use default values.")
 			s.so.dynamicConfigClient = dynamicconfig.NewNoopClient()
+		} else {
+			s.so.dynamicConfigClient, err = dynamicconfig.NewFileBasedClient(&s.so.config.DynamicConfigClient, s.logger, s.stoppedCh)
+			if err != nil {
+				return fmt.Errorf("unable to create dynamic config client: %w", err)
+			}
 		}
 	}
+	if err = s.initPersistenceDynamicConfigClient(); err != nil {
+		return err
+	}
 	dc := dynamicconfig.NewCollection(s.so.dynamicConfigClient, s.logger)
 
 	advancedVisibilityWritingMode := dc.GetStringProperty(dynamicconfig.AdvancedVisibilityWritingMode, common.GetDefaultAdvancedVisibilityWritingMode(s.so.config.Persistence.IsAdvancedVisibilityConfigExist()))()
@@ -177,6 +193,16 @@ func (s *Server) Start() error {
 		}
 	}
 
+	if s.so.config.Global.Tracing != nil {
+		s.tracerProvider, err = tracing.InitTracerProvider(
+			s.so.config.Global.Tracing,
+			dc.GetFloat64Property(dynamicconfig.TracingSampleRatio, tracing.DefaultSampleRatio),
+		)
+		if err != nil {
+			return fmt.Errorf("unable to initialize tracing: %w", err)
+		}
+	}
+
 	if s.so.tlsConfigProvider == nil {
 		s.so.tlsConfigProvider, err = encryption.NewTLSConfigProviderFromConfig(
 			s.so.config.Global.TLS, globalMetricsScope, s.logger, nil)
@@ -260,6 +286,45 @@ func (s *Server) Stop() {
 	if s.serverReporter != nil {
 		s.serverReporter.Stop(s.logger)
 	}
+
+	if s.tracerProvider != nil {
+		if err := s.tracerProvider.Shutdown(context.Background()); err != nil {
+			s.logger.Error("Unable to flush traces.", tag.Error(err))
+		}
+	}
+
+	if s.dynamicConfigMetadataManager != nil {
+		s.dynamicConfigMetadataManager.Close()
+	}
+}
+
+// initPersistenceDynamicConfigClient layers dynamic config values stored in the database
+// on top of the configured dynamic config client.
+func (s *Server) initPersistenceDynamicConfigClient() error {
+	factory := persistenceClient.NewFactory(
+		&s.so.config.Persistence,
+		s.so.persistenceServiceResolver,
+		nil,
+		nil,
+		s.so.customDataStoreFactory,
+		s.so.config.ClusterMetadata.CurrentClusterName,
+		nil,
+		s.logger,
+	)
+	clusterMetadataManager, err := factory.NewClusterMetadataManager()
+	if err != nil {
+		return fmt.Errorf("error initializing cluster metadata manager for dynamic config: %w", err)
+	}
+	s.dynamicConfigMetadataManager = clusterMetadataManager
+
+	s.so.dynamicConfigClient = dynamicconfig.NewPersistenceBasedClient(
+		persistence.NewDynamicConfigManager(clock.NewRealTimeSource(), clusterMetadataManager),
+		s.so.dynamicConfigClient,
+		s.so.config.DynamicConfigClient.PollInterval,
+		s.logger,
+		s.stoppedCh,
+	)
+	return nil
 }
 
 // Populates parameters for a service
@@ -377,7 +442,14 @@ func (s *Server) newBootstrapParams(
 	} else {
 		params.ClaimMapper = authorization.NewNoopClaimMapper()
 	}
+	if s.so.payloadRedactor != nil {
+		params.PayloadRedactor = s.so.payloadRedactor
+	} else {
+		params.PayloadRedactor = authorization.NewNoopPayloadRedactor()
+	}
 	params.AudienceGetter = s.so.audienceGetter
+	params.AuditLogConfig = s.so.config.Global.Authorization.Audit
+	params.PageTokenConfig = s.so.config.Global.PageToken
 
 	params.PersistenceServiceResolver = s.so.persistenceServiceResolver
 
@@ -401,7 +473,12 @@ func (s *Server) getESConfigClient(advancedVisibilityWritingMode string) (*confi
 
 	if s.so.elasticseachHttpClient == nil {
 		var err error
-		s.so.elasticseachHttpClient, err = client.NewAwsHttpClient(advancedVisibilityStore.ElasticSearch.AWSRequestSigning)
+		awsRequestSigning := advancedVisibilityStore.ElasticSearch.AWSRequestSigning
+		awsRequestSigning.Enabled = advancedVisibilityStore.ElasticSearch.AWSRequestSigningEnabled()
+		s.so.elasticseachHttpClient, err = client.NewAwsHttpClient(
+			awsRequestSigning,
+			client.NewHttpClient(advancedVisibilityStore.ElasticSearch),
+		)
 		if err != nil {
 			return nil, nil, fmt.Errorf("unable to create AWS HTTP client for Elasticsearch: %w", err)
 		}
@@ -442,6 +519,7 @@ func updateClusterMetadataConfig(
 		&cfg.Persistence,
 		persistenceServiceResolver,
 		nil,
+		nil,
 		customDataStoreFactory,
 		cfg.ClusterMetadata.CurrentClusterName,
 		nil,
@@ -457,9 +535,11 @@ func updateClusterMetadataConfig(
 	applied, err := clusterMetadataManager.SaveClusterMetadata(
 		&persistence.SaveClusterMetadataRequest{
 			ClusterMetadata: persistencespb.ClusterMetadata{
-				HistoryShardCount: cfg.Persistence.NumHistoryShards,
-				ClusterName:       cfg.ClusterMetadata.CurrentClusterName,
-				ClusterId:         uuid.New(),
+				HistoryShardCount:        cfg.Persistence.NumHistoryShards,
+				ClusterName:              cfg.ClusterMetadata.CurrentClusterName,
+				ClusterId:                uuid.New(),
+				FailoverVersionIncrement: cfg.ClusterMetadata.FailoverVersionIncrement,
+				ClusterInformation:       clusterInformationToPersistence(cfg.ClusterMetadata.ClusterInformation),
 			}})
 	if err != nil {
 		logger.Warn("Failed to save cluster metadata.", tag.Error(err))
@@ -492,9 +572,82 @@ func updateClusterMetadataConfig(
 		cfg.Persistence.NumHistoryShards = persistedShardCount
 	}
 
+	if resp.FailoverVersionIncrement == 0 {
+		// Cluster metadata was saved before the cluster list was persisted, backfill it from the config.
+		// From now on, it is changed with the admin API and the persisted values take precedence.
+		clusterMetadata := resp.ClusterMetadata
+		clusterMetadata.FailoverVersionIncrement = cfg.ClusterMetadata.FailoverVersionIncrement
+		clusterMetadata.ClusterInformation = clusterInformationToPersistence(cfg.ClusterMetadata.ClusterInformation)
+		applied, err := clusterMetadataManager.SaveClusterMetadata(&persistence.SaveClusterMetadataRequest{
+			ClusterMetadata: clusterMetadata,
+			Version:         resp.Version,
+		})
+		if err != nil {
+			logger.Warn("Failed to backfill cluster information of cluster metadata.", tag.Error(err))
+		} else if applied {
+			logger.Info("Successfully backfilled cluster information of cluster metadata.")
+		}
+		return nil
+	}
+
+	if cfg.ClusterMetadata.FailoverVersionIncrement != resp.FailoverVersionIncrement {
+		logger.Error(
+			updatableMismatchLogMessage,
+			tag.Key("clusterMetadata.failoverVersionIncrement"),
+			tag.IgnoredValue(cfg.ClusterMetadata.FailoverVersionIncrement),
+			tag.Value(resp.FailoverVersionIncrement))
+		cfg.ClusterMetadata.FailoverVersionIncrement = resp.FailoverVersionIncrement
+	}
+
+	persistedClusterInformation := clusterInformationFromPersistence(resp.ClusterInformation)
+	if !clusterInformationEqual(cfg.ClusterMetadata.ClusterInformation, persistedClusterInformation) {
+		logger.Error(
+			updatableMismatchLogMessage,
+			tag.Key("clusterMetadata.clusterInformation"),
+			tag.IgnoredValue(cfg.ClusterMetadata.ClusterInformation),
+			tag.Value(persistedClusterInformation))
+		cfg.ClusterMetadata.ClusterInformation = persistedClusterInformation
+	}
+
 	return nil
 }
 
+func clusterInformationToPersistence(clusters map[string]config.ClusterInformation) map[string]*persistencespb.ClusterInformation {
+	result := make(map[string]*persistencespb.ClusterInformation, len(clusters))
+	for name, info := range clusters {
+		result[name] = &persistencespb.ClusterInformation{
+			Enabled:                info.Enabled,
+			InitialFailoverVersion: info.InitialFailoverVersion,
+			RpcAddress:             info.RPCAddress,
+		}
+	}
+	return result
+}
+
+func clusterInformationEqual(a map[string]config.ClusterInformation, b map[string]config.ClusterInformation) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for name, info := range a {
+		if other, ok := b[name]; !ok || other != info {
+			return false
+		}
+	}
+	return true
+}
+
+func clusterInformationFromPersistence(clusters map[string]*persistencespb.ClusterInformation) map[string]config.ClusterInformation {
+	result := make(map[string]config.ClusterInformation, len(clusters))
+	for name, info := range clusters {
+		result[name] = config.ClusterInformation{
+			Enabled:                info.GetEnabled(),
+			InitialFailoverVersion: info.GetInitialFailoverVersion(),
+			RPCAddress:             info.GetRpcAddress(),
+		}
+	}
+	return result
+}
+
 func initSystemNamespaces(
 	cfg *config.Persistence,
 	currentClusterName string,
@@ -506,6 +659,7 @@ func initSystemNamespaces(
 		cfg,
 		persistenceServiceResolver,
 		nil,
+		nil,
 		customDataStoreFactory,
 		currentClusterName,
 		nil,
@@ -103,6 +103,13 @@ func WithClaimMapper(claimMapper func(cfg *config.Config) authorization.ClaimMap
 	})
 }
 
+// Configures redaction of payloads returned to callers based on their claims
+func WithPayloadRedactor(payloadRedactor authorization.PayloadRedactor) ServerOption {
+	return newApplyFuncContainer(func(s *serverOptions) {
+		s.payloadRedactor = payloadRedactor
+	})
+}
+
 // Configures JWT audience getter for authorization
 func WithAudienceGetter(audienceGetter func(cfg *config.Config) authorization.JWTAudienceMapper) ServerOption {
 	return newApplyFuncContainer(func(s *serverOptions) {
@@ -55,6 +55,7 @@ type (
 		authorizer                 authorization.Authorizer
 		tlsConfigProvider          encryption.TLSConfigProvider
 		claimMapper                authorization.ClaimMapper
+		payloadRedactor            authorization.PayloadRedactor
 		audienceGetter             authorization.JWTAudienceMapper
 		metricsReporter            interface{}
 		persistenceServiceResolver resolver.ServiceResolver
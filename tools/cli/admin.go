@@ -26,9 +26,13 @@ package cli
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/urfave/cli"
 	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/service/worker/forcereplication"
 )
 
 func newAdminWorkflowCommands() []cli.Command {
@@ -96,6 +100,102 @@ func newAdminWorkflowCommands() []cli.Command {
 				AdminRefreshWorkflowTasks(c)
 			},
 		},
+		{
+			Name:    "compact_history",
+			Aliases: []string{"ch"},
+			Usage:   "Rewrites history event batches of a workflow into fewer, larger batches",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowId",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunId",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminCompactWorkflowHistory(c)
+			},
+		},
+		{
+			Name:    "export",
+			Aliases: []string{"exp"},
+			Usage:   "Export raw history and mutable state of a workflow execution to a file which can be imported into another cluster",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowId",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunId, current run is exported if not set",
+				},
+				cli.StringFlag{
+					Name:  FlagOutputFilenameWithAlias,
+					Usage: "Write the exported execution to this file",
+				},
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Usage: "Page size of each raw history request to the server",
+					Value: 100,
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminExportWorkflow(c)
+			},
+		},
+		{
+			Name:    "import",
+			Aliases: []string{"imp"},
+			Usage:   "Import a workflow execution exported from another cluster",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagInputFileWithAlias,
+					Usage: "File written by the export command",
+				},
+				cli.IntFlag{
+					Name:  FlagBatchSizeWithAlias,
+					Usage: "Maximum number of history batches sent in a single import request",
+					Value: 100,
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminImportWorkflow(c)
+			},
+		},
+		{
+			Name:    "list_stale",
+			Aliases: []string{"ls"},
+			Usage:   "List open workflow executions started longer than given duration ago as CSV",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagOlderThan,
+					Usage: "List executions started longer than this duration ago, e.g. 720h or 30d (bare number is treated as days)",
+				},
+				cli.BoolFlag{
+					Name:  FlagAllWithAlias,
+					Usage: "List stale executions of all namespaces instead of the namespace given by global option",
+				},
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Usage: "Page size of each request to the server",
+					Value: 100,
+				},
+				cli.IntFlag{
+					Name:  FlagRPS,
+					Usage: "Maximum number of requests per second sent to the server",
+					Value: 10,
+				},
+				cli.StringFlag{
+					Name:  FlagOutputFilenameWithAlias,
+					Usage: "Write CSV report to this file instead of stdout",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminListStaleWorkflows(c)
+			},
+		},
 		{
 			Name:    "delete",
 			Aliases: []string{"del"},
@@ -210,6 +310,19 @@ func newAdminShardManagementCommands() []cli.Command {
 						"time range (N<duration>), where 0 < N < 1000000 and duration (full-notation/short-notation) can be second/s, " +
 						"minute/m, hour/h, day/d, week/w, month/M or year/y. For example, '15minute' or '15m' implies last 15 minutes.",
 				},
+				cli.BoolFlag{
+					Name:  FlagFollowWithAlias,
+					Usage: "Keep polling the shard and print newly created tasks until interrupted",
+				},
+				cli.IntFlag{
+					Name:  FlagFollowInterval,
+					Value: 1,
+					Usage: "Poll interval in seconds when following tasks",
+				},
+				cli.Int64Flag{
+					Name:  FlagFollowFrom,
+					Usage: "Print tasks with task ID greater than this value when following tasks, defaults to the ack level of the shard. Not supported for timer tasks, which start from min_visibility_ts if set",
+				},
 			),
 			Action: func(c *cli.Context) {
 				AdminListTasks(c)
@@ -229,6 +342,51 @@ func newAdminShardManagementCommands() []cli.Command {
 				AdminShardManagement(c)
 			},
 		},
+		{
+			Name:    "stats",
+			Aliases: []string{"st"},
+			Usage:   "Show owner host, ack levels, task backlog, lock contention and visibility lag of every shard",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagSortBy,
+					Value: shardStatsSortByShardID,
+					Usage: fmt.Sprintf("Sort shards by: %v", strings.Join(shardStatsSortKeys, ", ")),
+				},
+				cli.IntFlag{
+					Name:  FlagTop,
+					Usage: "Only show this number of shards, after sorting",
+				},
+				cli.BoolFlag{
+					Name:  FlagPrintJSONWithAlias,
+					Usage: "Print in raw json format",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminGetShardStats(c)
+			},
+		},
+		{
+			Name:    "rebalance",
+			Aliases: []string{"rb"},
+			Usage:   "evict shards from history hosts which hold them although the membership ring assigns them to another host",
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:  FlagDryRun,
+					Usage: "Only print the planned moves without evicting any shard",
+				},
+				cli.IntFlag{
+					Name:  FlagMaxMoves,
+					Usage: "Maximum number of shards to move, all misplaced shards are moved if not set",
+				},
+				cli.BoolFlag{
+					Name:  FlagPrintJSONWithAlias,
+					Usage: "Print in raw json format",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminRebalanceShards(c)
+			},
+		},
 		{
 			Name:    "remove_task",
 			Aliases: []string{"rmtk"},
@@ -353,6 +511,39 @@ func newAdminHistoryHostCommands() []cli.Command {
 	}
 }
 
+func newAdminHostCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "profile",
+			Aliases: []string{"prof"},
+			Usage:   "Capture a pprof profile of a frontend, history or matching host",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagAddress,
+					Usage: "Host address(IP:PORT), the frontend tctl is connected to if not set",
+				},
+				cli.StringFlag{
+					Name:  FlagProfile,
+					Value: "cpu",
+					Usage: "Profile: cpu, goroutine, heap, allocs, threadcreate, block or mutex",
+				},
+				cli.IntFlag{
+					Name:  FlagDuration,
+					Value: 10,
+					Usage: "Time in seconds to sample the cpu profile, at most 30",
+				},
+				cli.StringFlag{
+					Name:  FlagOutputFilenameWithAlias,
+					Usage: "Profile file to write, <profile>.pb.gz if not set",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminGetHostProfile(c)
+			},
+		},
+	}
+}
+
 func newAdminNamespaceCommands() []cli.Command {
 	return []cli.Command{
 		{
@@ -407,6 +598,48 @@ func newAdminNamespaceCommands() []cli.Command {
 				AdminGetNamespaceIDOrName(c)
 			},
 		},
+		{
+			Name:  "delete",
+			Usage: "Delete namespace and all of its data except archived data, it must be run in the master cluster first and then in each remote cluster of a global namespace",
+			Action: func(c *cli.Context) {
+				AdminDeleteNamespace(c)
+			},
+		},
+		{
+			Name:  "delete-status",
+			Usage: "Show progress of the job deleting data of a namespace in the current cluster",
+			Action: func(c *cli.Context) {
+				AdminDescribeNamespaceDeletion(c)
+			},
+		},
+		{
+			Name:  "quotas",
+			Usage: "Describe quotas of namespace",
+			Action: func(c *cli.Context) {
+				AdminDescribeNamespaceQuotas(c)
+			},
+		},
+		{
+			Name:  "update-quotas",
+			Usage: "Update quotas of namespace, they take precedence over dynamic config limits and 0 removes a quota",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagMaxStartWorkflowRPS,
+					Usage: "Maximum number of workflows started per second in the cluster",
+				},
+				cli.Int64Flag{
+					Name:  FlagMaxPendingWorkflows,
+					Usage: "Maximum number of concurrently open workflow executions",
+				},
+				cli.IntFlag{
+					Name:  FlagMaxActionsPerSecond,
+					Usage: "Maximum number of API requests per second in the cluster",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminUpdateNamespaceQuotas(c)
+			},
+		},
 	}
 }
 
@@ -490,6 +723,28 @@ func newAdminElasticSearchCommands() []cli.Command {
 				GenerateReport(c)
 			},
 		},
+		{
+			Name:    "processor",
+			Aliases: []string{"proc"},
+			Usage:   "Show queue depth, in-flight bulks, pending acks and last commit error of the Elasticsearch bulk processors of history hosts",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagAddress,
+					Usage: "History host address(IP:PORT), all history hosts if not set",
+				},
+				cli.BoolFlag{
+					Name:  FlagFlush,
+					Usage: "Commit pending requests before showing the processors",
+				},
+				cli.BoolFlag{
+					Name:  FlagPrintJSONWithAlias,
+					Usage: "Print in raw json format",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDescribeVisibilityProcessor(c)
+			},
+		},
 	}
 }
 
@@ -509,11 +764,33 @@ func newAdminTaskQueueCommands() []cli.Command {
 					Value: "workflow",
 					Usage: "Optional TaskQueue type [workflow|activity]",
 				},
+				cli.BoolFlag{
+					Name:  FlagPartitions,
+					Usage: "Describe every partition of the task queue, including backlog and task reader status",
+				},
 			},
 			Action: func(c *cli.Context) {
 				AdminDescribeTaskQueue(c)
 			},
 		},
+		{
+			Name:  "describe-partitions",
+			Usage: "Describe the forwarding tree of task queue partitions and the hosts owning them",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTaskQueueWithAlias,
+					Usage: "TaskQueue name",
+				},
+				cli.StringFlag{
+					Name:  FlagTaskQueueTypeWithAlias,
+					Value: "workflow",
+					Usage: "Optional TaskQueue type [workflow|activity]",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDescribeTaskQueuePartitionTree(c)
+			},
+		},
 		{
 			Name:  "list_tasks",
 			Usage: "List tasks of a task queue",
@@ -545,6 +822,126 @@ func newAdminTaskQueueCommands() []cli.Command {
 				AdminListTaskQueueTasks(c)
 			},
 		},
+		{
+			Name:  "update-build-ids",
+			Usage: "Update the compatible worker build id sets which workflow tasks of task queue are routed to",
+			Subcommands: []cli.Command{
+				{
+					Name:  "add-new-default",
+					Usage: "Add a build id in a new compatible set, which becomes the default set for new workflows",
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  FlagTaskQueueWithAlias,
+							Usage: "TaskQueue name",
+						},
+						cli.StringFlag{
+							Name:  FlagBuildID,
+							Usage: "Build id to add",
+						},
+					},
+					Action: func(c *cli.Context) {
+						AdminAddNewDefaultBuildID(c)
+					},
+				},
+				{
+					Name:  "add-compatible",
+					Usage: "Add a build id to the set of an existing compatible build id",
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  FlagTaskQueueWithAlias,
+							Usage: "TaskQueue name",
+						},
+						cli.StringFlag{
+							Name:  FlagBuildID,
+							Usage: "Build id to add",
+						},
+						cli.StringFlag{
+							Name:  FlagExistingCompatibleBuildID,
+							Usage: "Build id which the new build id is compatible with",
+						},
+						cli.BoolFlag{
+							Name:  FlagSetDefault,
+							Usage: "Make the set the default set for new workflows",
+						},
+					},
+					Action: func(c *cli.Context) {
+						AdminAddCompatibleBuildID(c)
+					},
+				},
+				{
+					Name:  "promote-set",
+					Usage: "Make the set of a build id the default set for new workflows",
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  FlagTaskQueueWithAlias,
+							Usage: "TaskQueue name",
+						},
+						cli.StringFlag{
+							Name:  FlagBuildID,
+							Usage: "Build id of the set to promote",
+						},
+					},
+					Action: func(c *cli.Context) {
+						AdminPromoteBuildIDSet(c)
+					},
+				},
+				{
+					Name:  "promote-id",
+					Usage: "Make a build id the default build id of its set",
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  FlagTaskQueueWithAlias,
+							Usage: "TaskQueue name",
+						},
+						cli.StringFlag{
+							Name:  FlagBuildID,
+							Usage: "Build id to promote",
+						},
+					},
+					Action: func(c *cli.Context) {
+						AdminPromoteBuildIDWithinSet(c)
+					},
+				},
+			},
+		},
+		{
+			Name:  "get-build-ids",
+			Usage: "Show the compatible worker build id sets of task queue",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTaskQueueWithAlias,
+					Usage: "TaskQueue name",
+				},
+				cli.IntFlag{
+					Name:  FlagMaxSets,
+					Usage: "Optional maximum number of sets to show, starting from the default set",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminGetBuildIDCompatibility(c)
+			},
+		},
+		{
+			Name:  "shutdown-worker",
+			Usage: "Evict the sticky task queue of a shutting down worker, its workflow tasks go to the normal task queue",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTaskQueueWithAlias,
+					Usage: "Sticky task queue name of the worker",
+				},
+				cli.StringFlag{
+					Name:  FlagIdentity,
+					Usage: "Optional identity of the worker",
+				},
+				cli.StringFlag{
+					Name:  FlagReason,
+					Usage: "Optional reason of the shutdown",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminShutdownWorker(c)
+			},
+		},
 	}
 }
 
@@ -632,6 +1029,302 @@ func newAdminClusterCommands() []cli.Command {
 				AdminClusterMetadata(c)
 			},
 		},
+		{
+			Name:    "describe-metadata",
+			Aliases: []string{"dm"},
+			Usage:   "Show persisted failover version increment, clusters and search attributes metadata along with the cluster metadata version",
+			Action: func(c *cli.Context) {
+				AdminDescribeClusterMetadata(c)
+			},
+		},
+		{
+			Name:    "update-metadata",
+			Aliases: []string{"um"},
+			Usage:   "Update persisted failover version increment, clusters and search attributes metadata in a single write, changes to clusters take effect when services are restarted",
+			Flags: []cli.Flag{
+				cli.Int64Flag{
+					Name:  FlagClusterMetadataVersion,
+					Usage: "Cluster metadata version shown by describe-metadata, the update is rejected if the cluster metadata was updated since. The current version if not provided",
+				},
+				cli.Int64Flag{
+					Name:  FlagFailoverVersionIncrement,
+					Usage: "New failover version increment",
+				},
+				cli.StringFlag{
+					Name:  FlagCluster,
+					Usage: "Name of the cluster to add or replace",
+				},
+				cli.Int64Flag{
+					Name:  FlagInitialFailoverVersion,
+					Usage: "Initial failover version of the cluster",
+				},
+				cli.StringFlag{
+					Name:  FlagRPCAddress,
+					Usage: "RPC address (host:port) of the cluster",
+				},
+				cli.BoolFlag{
+					Name:  FlagClusterDisabled,
+					Usage: "Add the cluster as disabled",
+				},
+				cli.StringSliceFlag{
+					Name:  FlagRemoveCluster,
+					Usage: "Name of the cluster to remove (multiple values are supported)",
+				},
+				cli.StringFlag{
+					Name:   FlagIndex,
+					Usage:  "Elasticsearch index name (optional)",
+					Hidden: true, // don't show it for now
+				},
+				cli.StringSliceFlag{
+					Name:  FlagNameWithAlias,
+					Usage: "Name of the search attribute to add or replace (multiple values are supported)",
+				},
+				cli.StringSliceFlag{
+					Name:  FlagTypeWithAlias,
+					Usage: fmt.Sprintf("Search attribute type: %v (multiple values are supported)", allowedEnumValues(enumspb.IndexedValueType_name)),
+				},
+				cli.StringSliceFlag{
+					Name:  FlagRemoveSearchAttribute,
+					Usage: "Name of the search attribute to remove, Elasticsearch index schema is not modified (multiple values are supported)",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminUpdateClusterMetadata(c)
+			},
+		},
+		{
+			Name:    "replication-lag",
+			Aliases: []string{"rl"},
+			Usage:   "Show replication progress, lag and DLQ depth of history shards per remote cluster",
+			Flags: []cli.Flag{
+				cli.StringSliceFlag{
+					Name:  FlagCluster,
+					Usage: "Remote cluster to report (multiple values are supported), all remote clusters if not provided",
+				},
+				cli.BoolFlag{
+					Name:  FlagPrintJSONWithAlias,
+					Usage: "Print in raw json format",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminGetReplicationLag(c)
+			},
+		},
+		{
+			Name:    "health",
+			Aliases: []string{"hc"},
+			Usage:   "Deep health check of persistence, Elasticsearch, membership and every frontend, history and matching host, exits with a non-zero code if any component is unhealthy",
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:  FlagPrintJSONWithAlias,
+					Usage: "Print in raw json format",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDeepHealthCheck(c)
+			},
+		},
+		{
+			Name:    "throttled",
+			Aliases: []string{"th"},
+			Usage:   "Show namespaces and identities currently throttled by frontend, history and persistence rate limiters",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagNamespace,
+					Usage: "Only show callers of this namespace",
+				},
+				cli.BoolFlag{
+					Name:  FlagPrintJSONWithAlias,
+					Usage: "Print in raw json format",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminListThrottledCallers(c)
+			},
+		},
+		{
+			Name:    "maintenance",
+			Aliases: []string{"mt"},
+			Usage:   "Announce planned maintenance to clients, the frontend returns the message in response headers",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagMaintenanceMessage,
+					Usage: "Maintenance message shown to users",
+				},
+				cli.StringFlag{
+					Name:  FlagMaintenanceSeverity,
+					Value: "low",
+					Usage: "Severity of the maintenance: high, medium or low",
+				},
+				cli.BoolFlag{
+					Name:  FlagMaintenanceClear,
+					Usage: "Clear the maintenance message",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminSetMaintenanceInfo(c)
+			},
+		},
+		{
+			Name:    "upgrade-preflight",
+			Aliases: []string{"up"},
+			Usage:   "Run checks before upgrading the cluster to the version of this tctl: server version, schema versions, dynamic config keys, search attributes, DLQ depth, and replication lag",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTargetVersion,
+					Value: headers.ServerVersion,
+					Usage: "Server version the cluster is upgraded to",
+				},
+				cli.StringFlag{
+					Name:  FlagServiceConfigDirWithAlias,
+					Usage: "Service configuration dir, schema and dynamic config checks are skipped if not provided",
+				},
+				cli.StringFlag{
+					Name:  FlagServiceEnvWithAlias,
+					Usage: "Optional service env for loading service configuration",
+				},
+				cli.StringFlag{
+					Name:  FlagServiceZoneWithAlias,
+					Usage: "Optional service zone for loading service configuration",
+				},
+				cli.StringFlag{
+					Name:  FlagMaxReplicationLag,
+					Value: "1m",
+					Usage: "Maximum acceptable replication lag to any remote cluster",
+				},
+				cli.Int64Flag{
+					Name:  FlagMaxDLQDepth,
+					Value: 0,
+					Usage: "Maximum acceptable number of replication tasks in DLQ",
+				},
+				cli.BoolFlag{
+					Name:  FlagPrintJSONWithAlias,
+					Usage: "Print in raw json format",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminUpgradePreflight(c)
+			},
+		},
+		{
+			Name:    "force-replicate",
+			Aliases: []string{"fr"},
+			Usage:   "Start a job replicating open and recently closed workflows of a global namespace to remote clusters",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagClosedWorkflowWindow,
+					Value: "7d",
+					Usage: "Closed workflows are replicated if they closed within this window, e.g. 7d or 36h",
+				},
+				cli.IntFlag{
+					Name:  FlagRPS,
+					Value: forcereplication.DefaultRPS,
+					Usage: "Number of workflows replicated per second",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminStartForceReplication(c)
+			},
+		},
+		{
+			Name:    "force-replicate-status",
+			Aliases: []string{"frs"},
+			Usage:   "Show progress of the force replication job of a namespace",
+			Action: func(c *cli.Context) {
+				AdminDescribeForceReplication(c)
+			},
+		},
+	}
+}
+
+func newAdminDynamicConfigCommands() []cli.Command {
+	constraintFlags := []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagDynamicConfigNamespace,
+			Usage: "Namespace constraint of the value (optional)",
+		},
+		cli.StringFlag{
+			Name:  FlagNamespaceID,
+			Usage: "Namespace id constraint of the value (optional)",
+		},
+		cli.StringFlag{
+			Name:  FlagTaskQueueWithAlias,
+			Usage: "Task queue name constraint of the value (optional)",
+		},
+		cli.StringFlag{
+			Name:  FlagTaskQueueTypeWithAlias,
+			Usage: "Task queue type constraint of the value [workflow|activity] (optional)",
+		},
+		cli.IntFlag{
+			Name:  FlagShardIDWithAlias,
+			Usage: "Shard id constraint of the value (optional)",
+		},
+	}
+
+	return []cli.Command{
+		{
+			Name:    "get",
+			Aliases: []string{"g"},
+			Usage:   "Show dynamic config values of a key stored in the database",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagNameWithAlias,
+					Usage: "Dynamic config key name",
+				},
+				cli.BoolFlag{
+					Name:  FlagPrintJSONWithAlias,
+					Usage: "Print in raw json format",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminGetDynamicConfig(c)
+			},
+		},
+		{
+			Name:    "set",
+			Aliases: []string{"s"},
+			Usage:   "Set a dynamic config value in the database, it replaces the value of the key with the same constraints",
+			Flags: append([]cli.Flag{
+				cli.StringFlag{
+					Name:  FlagNameWithAlias,
+					Usage: "Dynamic config key name",
+				},
+				cli.StringFlag{
+					Name:  FlagDynamicConfigValue,
+					Usage: "JSON encoded value, e.g. 10, true, \"10s\" or {\"key\": \"value\"}",
+				},
+				cli.BoolFlag{
+					Name:  FlagDynamicConfigRemove,
+					Usage: "Remove the value of the key with the given constraints instead",
+				},
+			}, constraintFlags...),
+			Action: func(c *cli.Context) {
+				AdminSetDynamicConfig(c)
+			},
+		},
+		{
+			Name:    "list",
+			Aliases: []string{"l"},
+			Usage:   "List all dynamic config values stored in the database",
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:  FlagPrintJSONWithAlias,
+					Usage: "Print in raw json format",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminListDynamicConfig(c)
+			},
+		},
+		{
+			Name:      "validate",
+			Aliases:   []string{"v"},
+			Usage:     "Validate keys, value types and constraints of a dynamic config file",
+			ArgsUsage: "file",
+			Action: func(c *cli.Context) {
+				AdminValidateDynamicConfig(c)
+			},
+		},
 	}
 }
 
@@ -864,3 +1557,191 @@ func newDecodeCommands() []cli.Command {
 		},
 	}
 }
+
+func newAdminScheduleCommands() []cli.Command {
+	scheduleIDFlag := cli.StringFlag{
+		Name:  FlagScheduleIDWithAlias,
+		Usage: "Schedule Id",
+	}
+	overlapPolicyFlag := cli.StringFlag{
+		Name:  FlagOverlapPolicy,
+		Usage: "Overlap policy, overrides the one of the schedule: skip, buffer_one, buffer_all, cancel_other, terminate_other or allow_all",
+	}
+	scheduleFlags := []cli.Flag{
+		scheduleIDFlag,
+		cli.StringSliceFlag{
+			Name: FlagCalendar,
+			Usage: "Calendar spec in UTC, e.g. \"30 9 * * 1-5\" for 9:30 on weekdays. Has the fields of a cron schedule " +
+				"with an optional leading second field. Pass each calendar as a separate flag",
+		},
+		cli.StringSliceFlag{
+			Name:  FlagInterval,
+			Usage: "Interval spec, e.g. 1h or 1h/15m for every hour at 15 minutes past. Pass each interval as a separate flag",
+		},
+		cli.StringFlag{
+			Name:  FlagJitter,
+			Usage: "Optional random delay of each action up to the given duration, e.g. 5m",
+		},
+		cli.StringFlag{
+			Name:  FlagOverlapPolicy,
+			Usage: "What to do if a workflow started by the schedule is still running: skip (default), buffer_one, buffer_all, cancel_other, terminate_other or allow_all",
+		},
+		cli.StringFlag{
+			Name:  FlagCatchupWindow,
+			Usage: "Actions missed by more than the window, e.g. because of an outage, are skipped. Defaults to 1m",
+		},
+		cli.BoolFlag{
+			Name:  FlagPauseOnFailure,
+			Usage: "Pause the schedule when a workflow started by it fails or times out",
+		},
+		cli.IntFlag{
+			Name:  FlagRemainingActions,
+			Usage: "Optional number of scheduled actions after which the schedule stops taking actions",
+		},
+		cli.BoolFlag{
+			Name:  FlagPaused,
+			Usage: "Create the schedule paused",
+		},
+		cli.StringFlag{
+			Name:  FlagNotes,
+			Usage: "Notes of the schedule",
+		},
+		cli.StringFlag{
+			Name:  FlagWorkflowIDWithAlias,
+			Usage: "Prefix of the workflow ids, the time of the action is appended. Defaults to the schedule id",
+		},
+		cli.StringFlag{
+			Name:  FlagWorkflowTypeWithAlias,
+			Usage: "WorkflowTypeName",
+		},
+		cli.StringFlag{
+			Name:  FlagTaskQueueWithAlias,
+			Usage: "TaskQueue",
+		},
+		cli.IntFlag{
+			Name:  FlagExecutionTimeout,
+			Usage: "Execution start to close timeout in seconds",
+		},
+		cli.IntFlag{
+			Name:  FlagWorkflowTaskTimeoutWithAlias,
+			Value: defaultWorkflowTaskTimeoutInSeconds,
+			Usage: "Workflow task start to close timeout in seconds",
+		},
+		cli.StringSliceFlag{
+			Name: FlagInputWithAlias,
+			Usage: "Optional input for the workflow in JSON format. If there are multiple parameters, pass each as a separate input flag. " +
+				"Pass \"null\" for null values",
+		},
+		cli.StringFlag{
+			Name: FlagInputFileWithAlias,
+			Usage: "Optional input for the workflow from JSON file. If there are multiple JSON, concatenate them and separate by space or newline. " +
+				"Input from file will be overwrite by input from command line",
+		},
+	}
+
+	return []cli.Command{
+		{
+			Name:  "create",
+			Usage: "Create a schedule which starts a workflow at the times of its calendars and intervals",
+			Flags: scheduleFlags,
+			Action: func(c *cli.Context) {
+				AdminCreateSchedule(c)
+			},
+		},
+		{
+			Name:  "update",
+			Usage: "Replace a schedule, takes the same flags as create",
+			Flags: scheduleFlags,
+			Action: func(c *cli.Context) {
+				AdminUpdateSchedule(c)
+			},
+		},
+		{
+			Name:    "describe",
+			Aliases: []string{"d"},
+			Usage:   "Describe a schedule and its recent and upcoming actions",
+			Flags: []cli.Flag{
+				scheduleIDFlag,
+			},
+			Action: func(c *cli.Context) {
+				AdminDescribeSchedule(c)
+			},
+		},
+		{
+			Name:    "list",
+			Aliases: []string{"l"},
+			Usage:   "List the schedules of a namespace",
+			Action: func(c *cli.Context) {
+				AdminListSchedules(c)
+			},
+		},
+		{
+			Name:  "pause",
+			Usage: "Pause a schedule, scheduled actions are skipped while it is paused",
+			Flags: []cli.Flag{
+				scheduleIDFlag,
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Reason for pausing, stored as the notes of the schedule",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminPauseSchedule(c, true)
+			},
+		},
+		{
+			Name:  "unpause",
+			Usage: "Unpause a schedule",
+			Flags: []cli.Flag{
+				scheduleIDFlag,
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Reason for unpausing, stored as the notes of the schedule",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminPauseSchedule(c, false)
+			},
+		},
+		{
+			Name:  "trigger",
+			Usage: "Take the action of a schedule right away",
+			Flags: []cli.Flag{
+				scheduleIDFlag,
+				overlapPolicyFlag,
+			},
+			Action: func(c *cli.Context) {
+				AdminTriggerSchedule(c)
+			},
+		},
+		{
+			Name:  "backfill",
+			Usage: "Take the actions the schedule would have taken in a time range",
+			Flags: []cli.Flag{
+				scheduleIDFlag,
+				cli.StringFlag{
+					Name:  FlagEarliestTimeWithAlias,
+					Usage: "Start of the time range, supported formats are '2006-01-02T15:04:05+07:00', raw UnixNano and time range (N<duration>)",
+				},
+				cli.StringFlag{
+					Name:  FlagLatestTimeWithAlias,
+					Usage: "End of the time range, same formats as the start",
+				},
+				overlapPolicyFlag,
+			},
+			Action: func(c *cli.Context) {
+				AdminBackfillSchedule(c)
+			},
+		},
+		{
+			Name:  "delete",
+			Usage: "Delete a schedule, workflows started by it are not affected",
+			Flags: []cli.Flag{
+				scheduleIDFlag,
+			},
+			Action: func(c *cli.Context) {
+				AdminDeleteSchedule(c)
+			},
+		},
+	}
+}
@@ -25,10 +25,23 @@
 package cli
 
 import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+
+	"github.com/olekukonko/tablewriter"
 	"github.com/urfave/cli"
+	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	sdkclient "go.temporal.io/sdk/client"
 
 	"go.temporal.io/server/api/adminservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/service/worker/forcereplication"
 )
 
 // AdminDescribeCluster is used to dump information about the cluster
@@ -57,3 +70,308 @@ func AdminClusterMetadata(c *cli.Context) {
 	}
 	prettyPrintJSONObject(info)
 }
+
+// AdminGetReplicationLag is used to report replication lag of history shards towards remote clusters
+func AdminGetReplicationLag(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := adminClient.GetReplicationLag(ctx, &adminservice.GetReplicationLagRequest{
+		RemoteClusters: c.StringSlice(FlagCluster),
+	})
+	if err != nil {
+		ErrorAndExit("Operation GetReplicationLag failed.", err)
+	}
+
+	if c.Bool(FlagPrintJSON) {
+		prettyPrintJSONObject(response)
+		return
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(true)
+	table.SetColumnSeparator("|")
+	header := []string{"Shard", "Remote Cluster", "Max Task Id", "Acked Task Id", "Lag", "DLQ Depth"}
+	headerColor := []tablewriter.Colors{tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue}
+	table.SetHeader(header)
+	table.SetHeaderColor(headerColor...)
+	for _, shard := range response.GetShards() {
+		clusterNames := make([]string, 0, len(shard.GetRemoteClusters()))
+		for clusterName := range shard.GetRemoteClusters() {
+			clusterNames = append(clusterNames, clusterName)
+		}
+		sort.Strings(clusterNames)
+		for _, clusterName := range clusterNames {
+			clusterLag := shard.GetRemoteClusters()[clusterName]
+			lag := "unknown"
+			if clusterLag.GetReplicationLag() != nil {
+				lag = timestamp.DurationValue(clusterLag.GetReplicationLag()).String()
+			}
+			table.Append([]string{
+				strconv.Itoa(int(shard.GetShardId())),
+				clusterName,
+				strconv.FormatInt(shard.GetMaxReplicationTaskId(), 10),
+				strconv.FormatInt(clusterLag.GetAckedTaskId(), 10),
+				lag,
+				strconv.FormatInt(clusterLag.GetDlqDepth(), 10),
+			})
+		}
+	}
+	table.Render()
+}
+
+// AdminListThrottledCallers is used to show callers currently throttled by the rate limiters of the cluster
+func AdminListThrottledCallers(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := adminClient.ListThrottledCallers(ctx, &adminservice.ListThrottledCallersRequest{
+		Namespace: c.String(FlagNamespace),
+	})
+	if err != nil {
+		ErrorAndExit("Operation ListThrottledCallers failed.", err)
+	}
+
+	if c.Bool(FlagPrintJSON) {
+		prettyPrintJSONObject(response)
+		return
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(true)
+	table.SetColumnSeparator("|")
+	header := []string{"Service", "Host", "Limiter", "Namespace", "Identity", "Rate", "Throttled", "First Throttled", "Last Throttled"}
+	headerColor := []tablewriter.Colors{tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue}
+	table.SetHeader(header)
+	table.SetHeaderColor(headerColor...)
+	for _, caller := range response.GetCallers() {
+		table.Append([]string{
+			caller.GetService(),
+			caller.GetHostAddress(),
+			caller.GetLimiter(),
+			caller.GetNamespace(),
+			caller.GetIdentity(),
+			strconv.FormatFloat(caller.GetRate(), 'f', -1, 64),
+			strconv.FormatInt(caller.GetThrottledCount(), 10),
+			formatTime(timestamp.TimeValue(caller.GetFirstThrottledTime()), false),
+			formatTime(timestamp.TimeValue(caller.GetLastThrottledTime()), false),
+		})
+	}
+	table.Render()
+}
+
+// AdminDeepHealthCheck shows the health of every component of the cluster
+func AdminDeepHealthCheck(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := adminClient.DeepHealthCheck(ctx, &adminservice.DeepHealthCheckRequest{})
+	if err != nil {
+		ErrorAndExit("Operation DeepHealthCheck failed.", err)
+	}
+
+	if c.Bool(FlagPrintJSON) {
+		prettyPrintJSONObject(response)
+	} else {
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetBorder(true)
+		table.SetColumnSeparator("|")
+		table.SetAutoWrapText(false)
+		header := []string{"Component", "Status", "Latency", "Details"}
+		headerColor := []tablewriter.Colors{tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue}
+		table.SetHeader(header)
+		table.SetHeaderColor(headerColor...)
+		for _, component := range response.GetComponents() {
+			status := colorGreen("HEALTHY")
+			if !component.GetHealthy() {
+				status = colorRed("UNHEALTHY")
+			}
+			table.Append([]string{
+				component.GetName(),
+				status,
+				timestamp.DurationValue(component.GetLatency()).String(),
+				component.GetMessage(),
+			})
+		}
+		table.Render()
+	}
+
+	if !response.GetHealthy() {
+		ErrorAndExit("Cluster is unhealthy.", nil)
+	}
+}
+
+// AdminDescribeClusterMetadata shows the persisted cluster metadata which can be changed with AdminUpdateClusterMetadata
+func AdminDescribeClusterMetadata(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := adminClient.DescribeClusterMetadata(ctx, &adminservice.DescribeClusterMetadataRequest{})
+	if err != nil {
+		ErrorAndExit("Operation DescribeClusterMetadata failed.", err)
+	}
+	prettyPrintJSONObject(response)
+}
+
+// AdminUpdateClusterMetadata updates the persisted failover version increment, clusters and search attributes metadata
+func AdminUpdateClusterMetadata(c *cli.Context) {
+	request := &adminservice.UpdateClusterMetadataRequest{
+		Version:                  c.Int64(FlagClusterMetadataVersion),
+		FailoverVersionIncrement: c.Int64(FlagFailoverVersionIncrement),
+		IndexName:                c.String(FlagIndex),
+	}
+	if c.IsSet(FlagRemoveCluster) {
+		request.RemoveClusters = c.StringSlice(FlagRemoveCluster)
+	}
+	if c.IsSet(FlagRemoveSearchAttribute) {
+		request.RemoveSearchAttributes = c.StringSlice(FlagRemoveSearchAttribute)
+	}
+	if c.IsSet(FlagCluster) {
+		request.UpsertClusters = map[string]*persistencespb.ClusterInformation{
+			c.String(FlagCluster): {
+				Enabled:                !c.Bool(FlagClusterDisabled),
+				InitialFailoverVersion: getRequiredInt64Option(c, FlagInitialFailoverVersion),
+				RpcAddress:             getRequiredOption(c, FlagRPCAddress),
+			},
+		}
+	}
+	names := c.StringSlice(FlagName)
+	typeStrs := c.StringSlice(FlagType)
+	if len(names) != len(typeStrs) {
+		InvalidArgumentAndExit("Number of names and types options should be the same.", nil)
+	}
+	if len(names) > 0 {
+		request.UpsertSearchAttributes = make(map[string]enumspb.IndexedValueType, len(names))
+		for i, name := range names {
+			typeInt, err := stringToEnum(typeStrs[i], enumspb.IndexedValueType_value)
+			if err != nil {
+				InvalidArgumentAndExit(fmt.Sprintf("Unable to parse search attribute type: %s", typeStrs[i]), err)
+			}
+			request.UpsertSearchAttributes[name] = enumspb.IndexedValueType(typeInt)
+		}
+	}
+	if request.FailoverVersionIncrement == 0 && len(request.UpsertClusters) == 0 && len(request.RemoveClusters) == 0 &&
+		len(request.UpsertSearchAttributes) == 0 && len(request.RemoveSearchAttributes) == 0 {
+		InvalidArgumentAndExit("Nothing to update.", nil)
+	}
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	if request.Version == 0 {
+		metadata, err := adminClient.DescribeClusterMetadata(ctx, &adminservice.DescribeClusterMetadataRequest{})
+		if err != nil {
+			ErrorAndExit("Operation DescribeClusterMetadata failed.", err)
+		}
+		request.Version = metadata.GetVersion()
+	}
+
+	response, err := adminClient.UpdateClusterMetadata(ctx, request)
+	if err != nil {
+		ErrorAndExit("Operation UpdateClusterMetadata failed.", err)
+	}
+	fmt.Printf("Cluster metadata updated to version %d.\n", response.GetVersion())
+}
+
+// AdminSetMaintenanceInfo sets or clears the planned maintenance announced to clients of the cluster
+func AdminSetMaintenanceInfo(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	request := &adminservice.SetMaintenanceInfoRequest{}
+	if !c.Bool(FlagMaintenanceClear) {
+		request.Message = getRequiredOption(c, FlagMaintenanceMessage)
+		severity, err := stringToEnum(c.String(FlagMaintenanceSeverity), enumspb.Severity_value)
+		if err != nil {
+			InvalidArgumentAndExit("Failed to parse maintenance severity.", err)
+		}
+		request.Severity = enumspb.Severity(severity)
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	if _, err := adminClient.SetMaintenanceInfo(ctx, request); err != nil {
+		ErrorAndExit("Operation SetMaintenanceInfo failed.", err)
+	}
+	if request.Message == "" {
+		fmt.Println("Maintenance message is cleared.")
+	} else {
+		fmt.Println("Maintenance message is set.")
+	}
+}
+
+// AdminStartForceReplication starts a job replicating existing workflows of a namespace to remote clusters
+func AdminStartForceReplication(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	closedWorkflowWindow, err := timestamp.ParseDurationDefaultDays(c.String(FlagClosedWorkflowWindow))
+	if err != nil {
+		InvalidArgumentAndExit("Option closed_window format is invalid.", err)
+	}
+
+	client := cFactory.SDKClient(c, common.SystemLocalNamespace)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	options := sdkclient.StartWorkflowOptions{
+		ID:        forceReplicationWorkflowID(namespace),
+		TaskQueue: forcereplication.TaskQueueName,
+	}
+	params := forcereplication.WorkflowParams{
+		Namespace:            namespace,
+		ClosedWorkflowWindow: closedWorkflowWindow,
+		RPS:                  c.Int(FlagRPS),
+	}
+	wf, err := client.ExecuteWorkflow(ctx, options, forcereplication.WorkflowName, params)
+	if err != nil {
+		ErrorAndExit("Failed to start force replication job", err)
+	}
+	output := map[string]interface{}{
+		"msg":   "force replication job is started",
+		"jobId": wf.GetID(),
+		"runId": wf.GetRunID(),
+	}
+	prettyPrintJSONObject(output)
+}
+
+// AdminDescribeForceReplication shows progress of the force replication job of a namespace
+func AdminDescribeForceReplication(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+
+	client := cFactory.SDKClient(c, common.SystemLocalNamespace)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	wf, err := client.DescribeWorkflowExecution(ctx, forceReplicationWorkflowID(namespace), "")
+	if err != nil {
+		ErrorAndExit("Failed to describe force replication job", err)
+	}
+
+	output := map[string]interface{}{}
+	switch wf.WorkflowExecutionInfo.GetStatus() {
+	case enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING:
+		output["msg"] = "force replication job is running"
+		if len(wf.PendingActivities) > 0 && wf.PendingActivities[0].HeartbeatDetails != nil {
+			var progress forcereplication.Progress
+			if err := payloads.Decode(wf.PendingActivities[0].HeartbeatDetails, &progress); err != nil {
+				ErrorAndExit("Failed to describe force replication job", err)
+			}
+			output["progress"] = progress
+		}
+	case enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED:
+		output["msg"] = "force replication job is finished successfully"
+		var progress forcereplication.Progress
+		execution := wf.WorkflowExecutionInfo.GetExecution()
+		if err := client.GetWorkflow(ctx, execution.GetWorkflowId(), execution.GetRunId()).Get(ctx, &progress); err != nil {
+			ErrorAndExit("Failed to get force replication job result", err)
+		}
+		output["progress"] = progress
+	default:
+		output["msg"] = "force replication job stopped status: " + wf.WorkflowExecutionInfo.GetStatus().String()
+	}
+	prettyPrintJSONObject(output)
+}
+
+func forceReplicationWorkflowID(namespace string) string {
+	return forcereplication.WorkflowName + "-" + namespace
+}
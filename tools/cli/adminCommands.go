@@ -26,30 +26,40 @@ package cli
 
 import (
 	"context"
+	"encoding/csv"
 	"fmt"
 	"io/ioutil"
 	"math"
+	"os"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/fatih/color"
+	"github.com/olekukonko/tablewriter"
 	"github.com/olivere/elastic/v7"
 	"github.com/urfave/cli"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/server/api/adminservice/v1"
 	esclient "go.temporal.io/server/common/persistence/visibility/elasticsearch/client"
 	"go.temporal.io/server/common/searchattribute"
 
+	clusterspb "go.temporal.io/server/api/cluster/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/auth"
 	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/collection"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/cassandra"
 	"go.temporal.io/server/common/persistence/nosql/nosqlplugin/cassandra/gocql"
@@ -57,10 +67,15 @@ import (
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/common/resolver"
+	"go.temporal.io/server/service/worker/deletenamespace"
 )
 
-const maxEventID = 9999
+const (
+	maxEventID            = 9999
+	defaultFollowInterval = time.Second
+)
 
 // AdminShowWorkflow shows history
 func AdminShowWorkflow(c *cli.Context) {
@@ -90,7 +105,7 @@ func AdminShowWorkflow(c *cli.Context) {
 			history = append(history, node.Events)
 		}
 	} else {
-		ErrorAndExit("need to specify TreeId/BranchId/ShardId", nil)
+		InvalidArgumentAndExit("need to specify TreeId/BranchId/ShardId", nil)
 	}
 
 	if len(history) == 0 {
@@ -140,6 +155,13 @@ func AdminDescribeWorkflow(c *cli.Context) {
 		fmt.Println(colorGreen("Database mutable state:"))
 		prettyPrintJSONObject(resp.GetDatabaseMutableState())
 
+		if len(resp.GetPendingActivities()) > 0 {
+			fmt.Println(colorGreen("Pending activities:"))
+			for _, pa := range resp.GetPendingActivities() {
+				prettyPrintJSONObject(pa)
+			}
+		}
+
 		fmt.Println(colorGreen("Current branch token:"))
 		versionHistories := resp.GetDatabaseMutableState().GetExecutionInfo().GetVersionHistories()
 		// if VersionHistories is set, then all branch infos are stored in VersionHistories
@@ -212,6 +234,127 @@ func AdminListNamespaces(c *cli.Context) {
 	paginate(c, paginationFunc)
 }
 
+// AdminDeleteNamespace marks a namespace as deleted and starts the job deleting its data in the current cluster
+func AdminDeleteNamespace(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+
+	promptMsg := color.RedString(fmt.Sprintf(
+		"You are about to delete namespace %s and all of its workflows. This can't be undone. Continue? Y/N", namespace))
+	prompt(promptMsg, c.GlobalBool(FlagAutoConfirm))
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.DeleteNamespace(ctx, &adminservice.DeleteNamespaceRequest{
+		Namespace: namespace,
+		Identity:  getCliIdentity(),
+	})
+	if err != nil {
+		ErrorAndExit("Operation DeleteNamespace failed.", err)
+	}
+	output := map[string]interface{}{
+		"msg":         "namespace is deleted, its data is being deleted by delete namespace job",
+		"namespaceId": resp.GetNamespaceId(),
+		"jobId":       resp.GetWorkflowId(),
+		"runId":       resp.GetRunId(),
+	}
+	prettyPrintJSONObject(output)
+}
+
+// AdminDescribeNamespaceDeletion shows progress of the job deleting data of a namespace
+func AdminDescribeNamespaceDeletion(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+
+	client := cFactory.SDKClient(c, common.SystemLocalNamespace)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	wf, err := client.DescribeWorkflowExecution(ctx, deletenamespace.WorkflowID(namespace), "")
+	if err != nil {
+		ErrorAndExit("Failed to describe delete namespace job", err)
+	}
+
+	output := map[string]interface{}{}
+	switch wf.WorkflowExecutionInfo.GetStatus() {
+	case enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING:
+		output["msg"] = "delete namespace job is running"
+		if len(wf.PendingActivities) > 0 && wf.PendingActivities[0].HeartbeatDetails != nil {
+			var progress deletenamespace.Progress
+			if err := payloads.Decode(wf.PendingActivities[0].HeartbeatDetails, &progress); err != nil {
+				ErrorAndExit("Failed to describe delete namespace job", err)
+			}
+			output["progress"] = progress
+		}
+	case enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED:
+		output["msg"] = "delete namespace job is finished successfully"
+		var progress deletenamespace.Progress
+		execution := wf.WorkflowExecutionInfo.GetExecution()
+		if err := client.GetWorkflow(ctx, execution.GetWorkflowId(), execution.GetRunId()).Get(ctx, &progress); err != nil {
+			ErrorAndExit("Failed to get delete namespace job result", err)
+		}
+		output["progress"] = progress
+	default:
+		output["msg"] = "delete namespace job stopped status: " + wf.WorkflowExecutionInfo.GetStatus().String()
+	}
+	prettyPrintJSONObject(output)
+}
+
+// AdminDescribeNamespaceQuotas shows quotas of a namespace
+func AdminDescribeNamespaceQuotas(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.DescribeNamespaceQuotas(ctx, &adminservice.DescribeNamespaceQuotasRequest{
+		Namespace: namespace,
+	})
+	if err != nil {
+		ErrorAndExit("Operation DescribeNamespaceQuotas failed.", err)
+	}
+	prettyPrintJSONObject(resp.GetQuotas())
+}
+
+// AdminUpdateNamespaceQuotas updates the quotas of a namespace which are set by flags, other quotas are unchanged
+func AdminUpdateNamespaceQuotas(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	if !c.IsSet(FlagMaxStartWorkflowRPS) && !c.IsSet(FlagMaxPendingWorkflows) && !c.IsSet(FlagMaxActionsPerSecond) {
+		InvalidArgumentAndExit("At least one quota flag must be set.", nil)
+	}
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	describeResp, err := adminClient.DescribeNamespaceQuotas(ctx, &adminservice.DescribeNamespaceQuotasRequest{
+		Namespace: namespace,
+	})
+	if err != nil {
+		ErrorAndExit("Operation DescribeNamespaceQuotas failed.", err)
+	}
+
+	quotas := describeResp.GetQuotas()
+	if quotas == nil {
+		quotas = &persistencespb.NamespaceQuotas{}
+	}
+	if c.IsSet(FlagMaxStartWorkflowRPS) {
+		quotas.MaxStartWorkflowRps = int32(c.Int(FlagMaxStartWorkflowRPS))
+	}
+	if c.IsSet(FlagMaxPendingWorkflows) {
+		quotas.MaxPendingWorkflows = c.Int64(FlagMaxPendingWorkflows)
+	}
+	if c.IsSet(FlagMaxActionsPerSecond) {
+		quotas.MaxActionsPerSecond = int32(c.Int(FlagMaxActionsPerSecond))
+	}
+
+	updateResp, err := adminClient.UpdateNamespaceQuotas(ctx, &adminservice.UpdateNamespaceQuotasRequest{
+		Namespace: namespace,
+		Quotas:    quotas,
+	})
+	if err != nil {
+		ErrorAndExit("Operation UpdateNamespaceQuotas failed.", err)
+	}
+	prettyPrintJSONObject(updateResp.GetQuotas())
+}
+
 // AdminDeleteWorkflow delete a workflow execution from Cassandra and visibility document from Elasticsearch.
 func AdminDeleteWorkflow(c *cli.Context) {
 	resp := describeMutableState(c)
@@ -372,7 +515,7 @@ func AdminGetNamespaceIDOrName(c *cli.Context) {
 	namespaceID := c.String(FlagNamespaceID)
 	namespace := c.String(FlagNamespace)
 	if len(namespaceID) == 0 && len(namespace) == 0 {
-		ErrorAndExit("Need either namespace or namespaceId", nil)
+		InvalidArgumentAndExit("Need either namespace or namespaceId", nil)
 	}
 
 	session := connectToCassandra(c)
@@ -418,7 +561,7 @@ func AdminGetShardID(c *cli.Context) {
 	numberOfShards := int32(c.Int(FlagNumberOfShards))
 
 	if numberOfShards <= 0 {
-		ErrorAndExit("numberOfShards is required", nil)
+		InvalidArgumentAndExit("numberOfShards is required", nil)
 		return
 	}
 	shardID := common.WorkflowIDToHistoryShard(namespaceID, wid, numberOfShards)
@@ -431,11 +574,11 @@ func AdminDescribeTask(c *cli.Context) {
 	tid := getRequiredIntOption(c, FlagTaskID)
 	categoryInt, err := stringToEnum(c.String(FlagTaskType), enumsspb.TaskCategory_value)
 	if err != nil {
-		ErrorAndExit("Failed to parse Task Type", err)
+		InvalidArgumentAndExit("Failed to parse Task Type", err)
 	}
 	category := enumsspb.TaskCategory(categoryInt)
 	if category == enumsspb.TASK_CATEGORY_UNSPECIFIED {
-		ErrorAndExit(fmt.Sprintf("Task type %s is currently not supported", category), nil)
+		InvalidArgumentAndExit(fmt.Sprintf("Task type %s is currently not supported", category), nil)
 	}
 
 	pFactory := CreatePersistenceFactory(c)
@@ -483,11 +626,11 @@ func AdminListTasks(c *cli.Context) {
 	sid := int32(getRequiredIntOption(c, FlagShardID))
 	categoryInt, err := stringToEnum(c.String(FlagTaskType), enumsspb.TaskCategory_value)
 	if err != nil {
-		ErrorAndExit("Failed to parse Task Type", err)
+		InvalidArgumentAndExit("Failed to parse Task Type", err)
 	}
 	category := enumsspb.TaskCategory(categoryInt)
 	if category == enumsspb.TASK_CATEGORY_UNSPECIFIED {
-		ErrorAndExit(fmt.Sprintf("Task type %s is currently not supported", category), nil)
+		InvalidArgumentAndExit(fmt.Sprintf("Task type %s is currently not supported", category), nil)
 	}
 
 	pFactory := CreatePersistenceFactory(c)
@@ -496,6 +639,11 @@ func AdminListTasks(c *cli.Context) {
 		ErrorAndExit("Failed to initialize execution manager", err)
 	}
 
+	if c.Bool(FlagFollow) {
+		followTasks(c, sid, category, executionManager)
+		return
+	}
+
 	if category == enumsspb.TASK_CATEGORY_TRANSFER {
 		req := &persistence.GetTransferTasksRequest{}
 
@@ -574,6 +722,251 @@ func AdminListTasks(c *cli.Context) {
 	}
 }
 
+// followTasks polls the shard and prints new tasks until interrupted. Unless a starting task ID is given,
+// it starts from the ack level of the shard, so pending tasks and tasks created afterwards are printed.
+func followTasks(c *cli.Context, sid int32, category enumsspb.TaskCategory, executionManager persistence.ExecutionManager) {
+	interval := time.Duration(c.Int(FlagFollowInterval)) * time.Second
+	if interval <= 0 {
+		interval = defaultFollowInterval
+	}
+	batchSize := c.Int(FlagPageSize)
+	if batchSize <= 0 {
+		batchSize = defaultPageSize
+	}
+	isTableView := !c.Bool(FlagPrintJSON)
+
+	if c.IsSet(FlagFollowFrom) && category == enumsspb.TASK_CATEGORY_TIMER {
+		InvalidArgumentAndExit(fmt.Sprintf("Option %s is not supported for timer tasks, use %s instead.", FlagFollowFrom, FlagMinVisibilityTimestamp), nil)
+	}
+	cursor, err := getFollowStartCursor(c, category, func() (*persistencespb.ShardInfo, error) {
+		pFactory := CreatePersistenceFactory(c)
+		shardManager, err := pFactory.NewShardManager()
+		if err != nil {
+			return nil, err
+		}
+		resp, err := shardManager.GetShard(&persistence.GetShardRequest{ShardID: sid})
+		if err != nil {
+			return nil, err
+		}
+		return resp.ShardInfo, nil
+	})
+	if err != nil {
+		ErrorAndExit("Failed to get shard ack level", err)
+	}
+
+	follower := newTaskFollower(category, executionManager, batchSize, cursor)
+	for {
+		tasks, err := follower.poll(time.Now().UTC())
+		if err != nil {
+			ErrorAndExit("Failed to list tasks", err)
+		}
+		if len(tasks) > 0 {
+			if isTableView {
+				printTable(tasks)
+			} else {
+				prettyPrintJSONObject(tasks)
+			}
+		}
+		time.Sleep(interval)
+	}
+}
+
+// taskCursor is the position of a task in its queue, timer tasks are ordered by visibility
+// timestamp first and task ID second, the other tasks only by task ID.
+type taskCursor struct {
+	VisibilityTimestamp time.Time
+	TaskID              int64
+}
+
+func (c taskCursor) less(other taskCursor) bool {
+	if !c.VisibilityTimestamp.Equal(other.VisibilityTimestamp) {
+		return c.VisibilityTimestamp.Before(other.VisibilityTimestamp)
+	}
+	return c.TaskID < other.TaskID
+}
+
+// taskFollower returns the tasks of a queue which it didn't return before. Tasks are not committed in
+// task ID order, so each poll reads again from the cursor of the poll before the previous one and skips
+// the tasks returned already. The cursor of timer tasks never passes the time of the previous poll, as
+// new timer tasks can be created with any visibility timestamp after it.
+type taskFollower struct {
+	category         enumsspb.TaskCategory
+	executionManager persistence.ExecutionManager
+	batchSize        int
+
+	cursor     taskCursor
+	nextCursor taskCursor
+	lastPoll   time.Time
+	returned   map[taskCursor]struct{}
+}
+
+func newTaskFollower(
+	category enumsspb.TaskCategory,
+	executionManager persistence.ExecutionManager,
+	batchSize int,
+	cursor taskCursor,
+) *taskFollower {
+	return &taskFollower{
+		category:         category,
+		executionManager: executionManager,
+		batchSize:        batchSize,
+		cursor:           cursor,
+		nextCursor:       cursor,
+		returned:         make(map[taskCursor]struct{}),
+	}
+}
+
+func (f *taskFollower) poll(now time.Time) ([]interface{}, error) {
+	tasks, err := f.listTasks()
+	if err != nil {
+		return nil, err
+	}
+
+	var newTasks []interface{}
+	maxCursor := f.nextCursor
+	for _, task := range tasks {
+		key := f.taskCursor(task)
+		if !f.cursor.less(key) {
+			continue
+		}
+		if _, ok := f.returned[key]; !ok {
+			f.returned[key] = struct{}{}
+			newTasks = append(newTasks, task)
+		}
+		if maxCursor.less(key) && (f.category != enumsspb.TASK_CATEGORY_TIMER || key.VisibilityTimestamp.Before(f.lastPoll)) {
+			maxCursor = key
+		}
+	}
+
+	f.cursor, f.nextCursor = f.nextCursor, maxCursor
+	f.lastPoll = now
+	for key := range f.returned {
+		if !f.cursor.less(key) {
+			delete(f.returned, key)
+		}
+	}
+	return newTasks, nil
+}
+
+func (f *taskFollower) taskCursor(task interface{}) taskCursor {
+	if timer, ok := task.(*persistencespb.TimerTaskInfo); ok {
+		return taskCursor{VisibilityTimestamp: timestamp.TimeValue(timer.GetVisibilityTime()), TaskID: timer.GetTaskId()}
+	}
+	return taskCursor{TaskID: task.(interface{ GetTaskId() int64 }).GetTaskId()}
+}
+
+// listTasks returns the tasks after the cursor
+func (f *taskFollower) listTasks() ([]interface{}, error) {
+	var paginationFunc collection.PaginationFn
+	switch f.category {
+	case enumsspb.TASK_CATEGORY_TRANSFER:
+		req := &persistence.GetTransferTasksRequest{ReadLevel: f.cursor.TaskID, MaxReadLevel: math.MaxInt64, BatchSize: f.batchSize}
+		paginationFunc = func(paginationToken []byte) ([]interface{}, []byte, error) {
+			req.NextPageToken = paginationToken
+			response, err := f.executionManager.GetTransferTasks(req)
+			if err != nil {
+				return nil, nil, err
+			}
+			var items []interface{}
+			for _, task := range response.Tasks {
+				items = append(items, task)
+			}
+			return items, response.NextPageToken, nil
+		}
+	case enumsspb.TASK_CATEGORY_VISIBILITY:
+		req := &persistence.GetVisibilityTasksRequest{ReadLevel: f.cursor.TaskID, MaxReadLevel: math.MaxInt64, BatchSize: f.batchSize}
+		paginationFunc = func(paginationToken []byte) ([]interface{}, []byte, error) {
+			req.NextPageToken = paginationToken
+			response, err := f.executionManager.GetVisibilityTasks(req)
+			if err != nil {
+				return nil, nil, err
+			}
+			var items []interface{}
+			for _, task := range response.Tasks {
+				items = append(items, task)
+			}
+			return items, response.NextPageToken, nil
+		}
+	case enumsspb.TASK_CATEGORY_TIMER:
+		req := &persistence.GetTimerIndexTasksRequest{MinTimestamp: f.cursor.VisibilityTimestamp, MaxTimestamp: time.Unix(0, math.MaxInt64).UTC(), BatchSize: f.batchSize}
+		paginationFunc = func(paginationToken []byte) ([]interface{}, []byte, error) {
+			req.NextPageToken = paginationToken
+			response, err := f.executionManager.GetTimerIndexTasks(req)
+			if err != nil {
+				return nil, nil, err
+			}
+			var items []interface{}
+			for _, task := range response.Timers {
+				items = append(items, task)
+			}
+			return items, response.NextPageToken, nil
+		}
+	case enumsspb.TASK_CATEGORY_REPLICATION:
+		req := &persistence.GetReplicationTasksRequest{MinTaskID: f.cursor.TaskID, MaxTaskID: math.MaxInt64, BatchSize: f.batchSize}
+		paginationFunc = func(paginationToken []byte) ([]interface{}, []byte, error) {
+			req.NextPageToken = paginationToken
+			response, err := f.executionManager.GetReplicationTasks(req)
+			if err != nil {
+				return nil, nil, err
+			}
+			var items []interface{}
+			for _, task := range response.Tasks {
+				items = append(items, task)
+			}
+			return items, response.NextPageToken, nil
+		}
+	default:
+		return nil, fmt.Errorf("Unrecognized task type, task_type=%v", f.category)
+	}
+
+	var tasks []interface{}
+	iter := collection.NewPagingIterator(paginationFunc)
+	for iter.HasNext() {
+		task, err := iter.Next()
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
+// getFollowStartCursor returns the cursor after which tasks are followed, it is the task ID given by
+// the from option, the min visibility timestamp of timer tasks or the ack level of the shard
+func getFollowStartCursor(
+	c *cli.Context,
+	category enumsspb.TaskCategory,
+	getShardInfo func() (*persistencespb.ShardInfo, error),
+) (taskCursor, error) {
+	if c.IsSet(FlagFollowFrom) && category != enumsspb.TASK_CATEGORY_TIMER {
+		return taskCursor{TaskID: c.Int64(FlagFollowFrom)}, nil
+	}
+	if c.IsSet(FlagMinVisibilityTimestamp) && category == enumsspb.TASK_CATEGORY_TIMER {
+		return taskCursor{VisibilityTimestamp: parseTime(c.String(FlagMinVisibilityTimestamp), time.Time{}, time.Now().UTC())}, nil
+	}
+	shardInfo, err := getShardInfo()
+	if err != nil {
+		return taskCursor{}, err
+	}
+	return getTaskAckLevel(shardInfo, category)
+}
+
+// getTaskAckLevel returns the position of the queue up to which all tasks are processed
+func getTaskAckLevel(shardInfo *persistencespb.ShardInfo, category enumsspb.TaskCategory) (taskCursor, error) {
+	switch category {
+	case enumsspb.TASK_CATEGORY_TRANSFER:
+		return taskCursor{TaskID: shardInfo.GetTransferAckLevel()}, nil
+	case enumsspb.TASK_CATEGORY_VISIBILITY:
+		return taskCursor{TaskID: shardInfo.GetVisibilityAckLevel()}, nil
+	case enumsspb.TASK_CATEGORY_TIMER:
+		return taskCursor{VisibilityTimestamp: timestamp.TimeValue(shardInfo.GetTimerAckLevelTime())}, nil
+	case enumsspb.TASK_CATEGORY_REPLICATION:
+		return taskCursor{TaskID: shardInfo.GetReplicationAckLevel()}, nil
+	default:
+		return taskCursor{}, fmt.Errorf("Unrecognized task type, task_type=%v", category)
+	}
+}
+
 // AdminRemoveTask describes history host
 func AdminRemoveTask(c *cli.Context) {
 	adminClient := cFactory.AdminClient(c)
@@ -581,11 +974,11 @@ func AdminRemoveTask(c *cli.Context) {
 	taskID := getRequiredInt64Option(c, FlagTaskID)
 	categoryInt, err := stringToEnum(c.String(FlagTaskType), enumsspb.TaskCategory_value)
 	if err != nil {
-		ErrorAndExit("Failed to parse Task Type", err)
+		InvalidArgumentAndExit("Failed to parse Task Type", err)
 	}
 	category := enumsspb.TaskCategory(categoryInt)
 	if category == enumsspb.TASK_CATEGORY_UNSPECIFIED {
-		ErrorAndExit(fmt.Sprintf("Task type %s is currently not supported", category), nil)
+		InvalidArgumentAndExit(fmt.Sprintf("Task type %s is currently not supported", category), nil)
 	}
 	var visibilityTimestamp int64
 	if category == enumsspb.TASK_CATEGORY_TIMER {
@@ -641,6 +1034,189 @@ func AdminShardManagement(c *cli.Context) {
 	}
 }
 
+const (
+	shardStatsSortByShardID         = "shard_id"
+	shardStatsSortByTransferBacklog = "transfer_backlog"
+	shardStatsSortByTimerLag        = "timer_lag"
+	shardStatsSortByLockContention  = "lock_contention"
+	shardStatsSortByVisibilityLag   = "visibility_lag"
+)
+
+var shardStatsSortKeys = []string{
+	shardStatsSortByShardID,
+	shardStatsSortByTransferBacklog,
+	shardStatsSortByTimerLag,
+	shardStatsSortByLockContention,
+	shardStatsSortByVisibilityLag,
+}
+
+// AdminGetShardStats shows owner host, ack levels, task backlog, lock contention and visibility lag of every shard
+func AdminGetShardStats(c *cli.Context) {
+	sortBy := c.String(FlagSortBy)
+	var less func(a, b *clusterspb.ShardStats) bool
+	switch sortBy {
+	case shardStatsSortByShardID:
+		less = func(a, b *clusterspb.ShardStats) bool { return a.GetShardId() < b.GetShardId() }
+	case shardStatsSortByTransferBacklog:
+		less = func(a, b *clusterspb.ShardStats) bool { return a.GetTransferBacklog() > b.GetTransferBacklog() }
+	case shardStatsSortByTimerLag:
+		less = func(a, b *clusterspb.ShardStats) bool { return shardTimerLag(a) > shardTimerLag(b) }
+	case shardStatsSortByLockContention:
+		less = func(a, b *clusterspb.ShardStats) bool { return a.GetLockContentionCount() > b.GetLockContentionCount() }
+	case shardStatsSortByVisibilityLag:
+		less = func(a, b *clusterspb.ShardStats) bool {
+			return timestamp.DurationValue(a.GetVisibilityLag()) > timestamp.DurationValue(b.GetVisibilityLag())
+		}
+	default:
+		InvalidArgumentAndExit(fmt.Sprintf("Invalid sort key %q, valid keys are: %v", sortBy, strings.Join(shardStatsSortKeys, ", ")), nil)
+	}
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := adminClient.GetShardStats(ctx, &adminservice.GetShardStatsRequest{})
+	if err != nil {
+		ErrorAndExit("Operation GetShardStats failed.", err)
+	}
+
+	shards := response.GetShards()
+	sort.SliceStable(shards, func(i, j int) bool { return less(shards[i], shards[j]) })
+	if top := c.Int(FlagTop); top > 0 && len(shards) > top {
+		shards = shards[:top]
+	}
+	response.Shards = shards
+
+	if c.Bool(FlagPrintJSON) {
+		prettyPrintJSONObject(response)
+		return
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(true)
+	table.SetColumnSeparator("|")
+	header := []string{"Shard Id", "Owner Host", "Range Id", "Transfer Ack Level", "Transfer Backlog", "Timer Ack Level", "Timer Lag",
+		"Visibility Ack Level", "Visibility Lag", "Replication Ack Level", "Lock Contention", "Last Updated"}
+	headerColor := make([]tablewriter.Colors, len(header))
+	for i := range headerColor {
+		headerColor[i] = tableHeaderBlue
+	}
+	table.SetHeader(header)
+	table.SetHeaderColor(headerColor...)
+	for _, shard := range shards {
+		table.Append([]string{
+			strconv.Itoa(int(shard.GetShardId())),
+			shard.GetOwnerHost(),
+			strconv.FormatInt(shard.GetRangeId(), 10),
+			strconv.FormatInt(shard.GetTransferAckLevel(), 10),
+			strconv.FormatInt(shard.GetTransferBacklog(), 10),
+			formatTime(timestamp.TimeValue(shard.GetTimerAckLevel()), false),
+			shardTimerLag(shard).String(),
+			strconv.FormatInt(shard.GetVisibilityAckLevel(), 10),
+			timestamp.DurationValue(shard.GetVisibilityLag()).String(),
+			strconv.FormatInt(shard.GetReplicationAckLevel(), 10),
+			strconv.FormatInt(shard.GetLockContentionCount(), 10),
+			formatTime(timestamp.TimeValue(shard.GetLastUpdatedTime()), false),
+		})
+	}
+	table.Render()
+
+	fmt.Printf("Max visibility lag: %v\n", timestamp.DurationValue(response.GetMaxVisibilityLag()))
+	if len(response.GetUnreachableHosts()) > 0 {
+		fmt.Printf("Shards of unreachable history hosts are missing: %v\n", strings.Join(response.GetUnreachableHosts(), ", "))
+	}
+}
+
+// shardTimerLag returns how far the timer ack level of the shard is behind its timer max read level
+func shardTimerLag(shard *clusterspb.ShardStats) time.Duration {
+	if shard.GetTimerAckLevel() == nil || shard.GetTimerMaxReadLevel() == nil {
+		return 0
+	}
+	lag := shard.GetTimerMaxReadLevel().Sub(*shard.GetTimerAckLevel())
+	if lag < 0 {
+		return 0
+	}
+	return lag
+}
+
+// AdminGetHostProfile captures a pprof profile of a frontend, history or matching host and writes it to a file
+func AdminGetHostProfile(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	profile := c.String(FlagProfile)
+	duration := time.Duration(c.Int(FlagDuration)) * time.Second
+	outputFileName := c.String(FlagOutputFilename)
+	if outputFileName == "" {
+		outputFileName = profile + ".pb.gz"
+	}
+
+	// cpu profiles are sampled for the requested duration before the response is returned
+	ctx, cancel := newContextWithTimeout(c, duration+defaultContextTimeout)
+	defer cancel()
+	response, err := adminClient.GetHostProfile(ctx, &adminservice.GetHostProfileRequest{
+		HostAddress: c.String(FlagAddress),
+		Profile:     profile,
+		Duration:    &duration,
+	})
+	if err != nil {
+		ErrorAndExit("Unable to capture profile.", err)
+	}
+
+	if err := ioutil.WriteFile(outputFileName, response.GetProfile(), 0666); err != nil {
+		ErrorAndExit("Unable to write profile file.", err)
+	}
+	fmt.Printf("Captured %s profile of %s host %s, inspect it with: go tool pprof %s\n",
+		profile, response.GetService(), response.GetHostAddress(), outputFileName)
+}
+
+// AdminRebalanceShards evicts shards from history hosts which hold them although the membership ring assigns them to another host
+func AdminRebalanceShards(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := adminClient.RebalanceShards(ctx, &adminservice.RebalanceShardsRequest{
+		DryRun:   c.Bool(FlagDryRun),
+		MaxMoves: int32(c.Int(FlagMaxMoves)),
+	})
+	if err != nil {
+		ErrorAndExit("Operation RebalanceShards failed.", err)
+	}
+
+	if c.Bool(FlagPrintJSON) {
+		prettyPrintJSONObject(response)
+		return
+	}
+	if len(response.GetMoves()) == 0 {
+		fmt.Println("All shards are owned by the hosts the membership ring assigns them to.")
+		return
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(true)
+	table.SetColumnSeparator("|")
+	header := []string{"Shard Id", "Source Host", "Source Host Shards", "Target Host", "Status"}
+	headerColor := []tablewriter.Colors{tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue}
+	table.SetHeader(header)
+	table.SetHeaderColor(headerColor...)
+	for _, move := range response.GetMoves() {
+		status := "planned"
+		switch {
+		case move.GetEvicted():
+			status = "evicted"
+		case move.GetError() != "":
+			status = "failed: " + move.GetError()
+		}
+		table.Append([]string{
+			strconv.Itoa(int(move.GetShardId())),
+			move.GetSourceHost(),
+			strconv.Itoa(int(move.GetSourceHostShards())),
+			move.GetTargetHost(),
+			status,
+		})
+	}
+	table.Render()
+}
+
 // AdminListGossipMembers outputs a list of gossip members
 func AdminListGossipMembers(c *cli.Context) {
 	roleFlag := c.String(FlagClusterMembershipRole)
@@ -718,7 +1294,7 @@ func AdminDescribeHistoryHost(c *cli.Context) {
 		flagsCount++
 	}
 	if flagsCount != 1 {
-		ErrorAndExit("must provide one and only one: shard id or namespace & workflow id or host address", nil)
+		InvalidArgumentAndExit("must provide one and only one: shard id or namespace & workflow id or host address", nil)
 		return
 	}
 
@@ -770,3 +1346,239 @@ func AdminRefreshWorkflowTasks(c *cli.Context) {
 		fmt.Println("Refresh workflow task succeeded.")
 	}
 }
+
+// AdminCompactWorkflowHistory rewrites history event batches of a workflow into fewer, larger batches
+func AdminCompactWorkflowHistory(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+
+	ctx, cancel := newContextForLongPoll(c)
+	defer cancel()
+
+	resp, err := adminClient.CompactWorkflowHistory(ctx, &adminservice.CompactWorkflowHistoryRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+	})
+	if err != nil {
+		ErrorAndExit("Compact workflow history failed", err)
+	}
+	fmt.Printf("Compact workflow history succeeded, history event batches: %d -> %d.\n", resp.GetBatchCountBefore(), resp.GetBatchCountAfter())
+}
+
+// AdminExportWorkflow writes raw history and mutable state of a workflow execution to a file
+func AdminExportWorkflow(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	outputFileName := getRequiredOption(c, FlagOutputFilename)
+	pageSize := int32(c.Int(FlagPageSize))
+	msResp := describeMutableState(c)
+	mutableState := msResp.GetDatabaseMutableState()
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: mutableState.GetExecutionInfo().GetWorkflowId(),
+		RunId:      mutableState.GetExecutionState().GetRunId(),
+	}
+
+	// history is read up to the last event of the exported mutable state, so that events
+	// appended after the mutable state was loaded are not part of the export
+	versionHistory, err := versionhistory.GetCurrentVersionHistory(mutableState.GetExecutionInfo().GetVersionHistories())
+	if err != nil {
+		ErrorAndExit("Unable to get current version history", err)
+	}
+	lastItem, err := versionhistory.GetLastVersionHistoryItem(versionHistory)
+	if err != nil {
+		ErrorAndExit("Unable to get last version history item", err)
+	}
+
+	request := &adminservice.ImportWorkflowExecutionRequest{
+		Namespace:    getRequiredGlobalOption(c, FlagNamespace),
+		Execution:    execution,
+		MutableState: mutableState,
+	}
+	var token []byte
+	for {
+		ctx, cancel := newContext(c)
+		resp, err := adminClient.GetWorkflowExecutionRawHistoryV2(ctx, &adminservice.GetWorkflowExecutionRawHistoryV2Request{
+			Namespace:       request.GetNamespace(),
+			Execution:       execution,
+			EndEventId:      lastItem.GetEventId() + 1,
+			EndEventVersion: lastItem.GetVersion(),
+			MaximumPageSize: pageSize,
+			NextPageToken:   token,
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit("Get workflow raw history failed", err)
+		}
+		request.HistoryBatches = append(request.HistoryBatches, resp.GetHistoryBatches()...)
+		token = resp.GetNextPageToken()
+		if len(token) == 0 {
+			break
+		}
+	}
+
+	data, err := codec.NewJSONPBIndentEncoder("  ").Encode(request)
+	if err != nil {
+		ErrorAndExit("Failed to serialize exported workflow execution", err)
+	}
+	if err := ioutil.WriteFile(outputFileName, data, 0666); err != nil {
+		ErrorAndExit("Failed to write export file", err)
+	}
+	fmt.Printf("Exported workflow execution %s/%s with %d history batches to %s.\n",
+		execution.GetWorkflowId(), execution.GetRunId(), len(request.GetHistoryBatches()), outputFileName)
+}
+
+// AdminImportWorkflow creates a workflow execution from a file written by AdminExportWorkflow.
+// The execution is imported into the namespace given by global option.
+func AdminImportWorkflow(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	inputFileName := getRequiredOption(c, FlagInputFile)
+	batchSize := c.Int(FlagBatchSize)
+	if batchSize <= 0 {
+		InvalidArgumentAndExit(fmt.Sprintf("Option %s must be positive.", FlagBatchSize), nil)
+	}
+
+	// This is only executed from the CLI by an admin user
+	// #nosec
+	data, err := ioutil.ReadFile(inputFileName)
+	if err != nil {
+		ErrorAndExit("Failed to read import file", err)
+	}
+	exported := &adminservice.ImportWorkflowExecutionRequest{}
+	if err := codec.NewJSONPBEncoder().Decode(data, exported); err != nil {
+		ErrorAndExit("Failed to deserialize exported workflow execution", err)
+	}
+	if exported.GetMutableState() == nil {
+		InvalidArgumentAndExit("Import file doesn't contain the mutable state of the workflow execution, export it again.", nil)
+	}
+
+	// consecutive batches are imported with separate requests, each of them carries the mutable state snapshot
+	// which the server validates the batches against. Batches which were already imported are deduplicated by
+	// the server so a failed import can simply be rerun.
+	historyBatches := exported.GetHistoryBatches()
+	var mutableState *persistencespb.WorkflowMutableState
+	for start := 0; start < len(historyBatches); start += batchSize {
+		end := start + batchSize
+		if end > len(historyBatches) {
+			end = len(historyBatches)
+		}
+		ctx, cancel := newContext(c)
+		resp, err := adminClient.ImportWorkflowExecution(ctx, &adminservice.ImportWorkflowExecutionRequest{
+			Namespace:      namespace,
+			Execution:      exported.GetExecution(),
+			HistoryBatches: historyBatches[start:end],
+			MutableState:   exported.GetMutableState(),
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit("Import workflow execution failed", err)
+		}
+		mutableState = resp.GetMutableState()
+	}
+	if mutableState == nil {
+		ErrorAndExit("Import workflow execution failed", fmt.Errorf("history of the import file ends before the last event of its mutable state"))
+	}
+	fmt.Printf("Imported workflow execution %s/%s with %d history batches, next event ID %d, status %s.\n",
+		exported.GetExecution().GetWorkflowId(), exported.GetExecution().GetRunId(), len(historyBatches),
+		mutableState.GetNextEventId(), mutableState.GetExecutionState().GetStatus())
+}
+
+// AdminListStaleWorkflows writes a CSV report of open workflow executions started longer than given duration ago
+func AdminListStaleWorkflows(c *cli.Context) {
+	olderThan, err := timestamp.ParseDurationDefaultDays(getRequiredOption(c, FlagOlderThan))
+	if err != nil || olderThan <= 0 {
+		InvalidArgumentAndExit(fmt.Sprintf("Option %s format is invalid.", FlagOlderThan), err)
+	}
+	pageSize := int32(c.Int(FlagPageSize))
+	rps := c.Int(FlagRPS)
+	limiter := quotas.NewDefaultOutgoingDynamicRateLimiter(
+		func() float64 { return float64(rps) },
+	)
+
+	var namespaces []string
+	if c.Bool(FlagAll) {
+		namespaces = listAllNamespaceNames(c, limiter)
+	} else {
+		namespaces = []string{getRequiredGlobalOption(c, FlagNamespace)}
+	}
+
+	output := os.Stdout
+	if c.IsSet(FlagOutputFilename) {
+		// This is only executed from the CLI by an admin user
+		// #nosec
+		output, err = os.Create(c.String(FlagOutputFilename))
+		if err != nil {
+			ErrorAndExit("Failed to create output file", err)
+		}
+		defer output.Close()
+	}
+	writer := csv.NewWriter(output)
+	_ = writer.Write([]string{"Namespace", "WorkflowId", "RunId", "WorkflowType", "TaskQueue", "StartTime", "Age"})
+
+	adminClient := cFactory.AdminClient(c)
+	now := time.Now().UTC()
+	for _, namespace := range namespaces {
+		var token []byte
+		for more := true; more; more = len(token) > 0 {
+			_ = limiter.Wait(context.Background())
+			ctx, cancel := newContext(c)
+			resp, err := adminClient.ListStaleWorkflowExecutions(ctx, &adminservice.ListStaleWorkflowExecutionsRequest{
+				Namespace:       namespace,
+				OlderThan:       &olderThan,
+				MaximumPageSize: pageSize,
+				NextPageToken:   token,
+			})
+			cancel()
+			if err != nil {
+				ErrorAndExit(fmt.Sprintf("List stale workflow executions of namespace %s failed", namespace), err)
+			}
+			for _, execution := range resp.GetExecutions() {
+				startTime := timestamp.TimeValue(execution.GetStartTime())
+				_ = writer.Write([]string{
+					namespace,
+					execution.GetExecution().GetWorkflowId(),
+					execution.GetExecution().GetRunId(),
+					execution.GetType().GetName(),
+					execution.GetTaskQueue(),
+					startTime.Format(time.RFC3339),
+					now.Sub(startTime).Round(time.Second).String(),
+				})
+			}
+			token = resp.GetNextPageToken()
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		ErrorAndExit("Failed to write CSV report", err)
+	}
+}
+
+func listAllNamespaceNames(c *cli.Context, limiter quotas.RateLimiter) []string {
+	frontendClient := cFactory.FrontendClient(c)
+	var names []string
+	var token []byte
+	for more := true; more; more = len(token) > 0 {
+		_ = limiter.Wait(context.Background())
+		ctx, cancel := newContext(c)
+		resp, err := frontendClient.ListNamespaces(ctx, &workflowservice.ListNamespacesRequest{
+			PageSize:      200,
+			NextPageToken: token,
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit("Error when list namespaces info", err)
+		}
+		for _, ns := range resp.GetNamespaces() {
+			names = append(names, ns.GetNamespaceInfo().GetName())
+		}
+		token = resp.GetNextPageToken()
+	}
+	return names
+}
@@ -0,0 +1,164 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+func newFollowTestContext(t *testing.T, args ...string) *cli.Context {
+	set := flag.NewFlagSet("test", 0)
+	set.Int64(FlagFollowFrom, 0, "")
+	set.String(FlagMinVisibilityTimestamp, "", "")
+	require.NoError(t, set.Parse(args))
+	return cli.NewContext(nil, set, nil)
+}
+
+func TestGetFollowStartCursor(t *testing.T) {
+	timerAckLevel := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	shardInfo := &persistencespb.ShardInfo{
+		TransferAckLevel:    10,
+		VisibilityAckLevel:  20,
+		ReplicationAckLevel: 30,
+		TimerAckLevelTime:   &timerAckLevel,
+	}
+	getShardInfo := func() (*persistencespb.ShardInfo, error) { return shardInfo, nil }
+
+	tests := []struct {
+		args     []string
+		category enumsspb.TaskCategory
+		expected taskCursor
+	}{
+		{nil, enumsspb.TASK_CATEGORY_TRANSFER, taskCursor{TaskID: 10}},
+		{nil, enumsspb.TASK_CATEGORY_VISIBILITY, taskCursor{TaskID: 20}},
+		{nil, enumsspb.TASK_CATEGORY_REPLICATION, taskCursor{TaskID: 30}},
+		{nil, enumsspb.TASK_CATEGORY_TIMER, taskCursor{VisibilityTimestamp: timerAckLevel}},
+		{[]string{"--" + FlagFollowFrom, "5"}, enumsspb.TASK_CATEGORY_TRANSFER, taskCursor{TaskID: 5}},
+		{[]string{"--" + FlagFollowFrom, "5"}, enumsspb.TASK_CATEGORY_REPLICATION, taskCursor{TaskID: 5}},
+		{
+			[]string{"--" + FlagMinVisibilityTimestamp, "2021-07-01T00:00:00Z"},
+			enumsspb.TASK_CATEGORY_TIMER,
+			taskCursor{VisibilityTimestamp: time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+	for _, tt := range tests {
+		cursor, err := getFollowStartCursor(newFollowTestContext(t, tt.args...), tt.category, getShardInfo)
+		assert.NoError(t, err)
+		assert.True(t, tt.expected.VisibilityTimestamp.Equal(cursor.VisibilityTimestamp), "%v %v", tt.category, tt.args)
+		assert.Equal(t, tt.expected.TaskID, cursor.TaskID, "%v %v", tt.category, tt.args)
+	}
+}
+
+func TestTaskFollower_Transfer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	executionManager := persistence.NewMockExecutionManager(ctrl)
+
+	newTasks := func(taskIDs ...int64) *persistence.GetTransferTasksResponse {
+		resp := &persistence.GetTransferTasksResponse{}
+		for _, taskID := range taskIDs {
+			resp.Tasks = append(resp.Tasks, &persistencespb.TransferTaskInfo{TaskId: taskID})
+		}
+		return resp
+	}
+	expectRead := func(readLevel int64, resp *persistence.GetTransferTasksResponse) *gomock.Call {
+		return executionManager.EXPECT().GetTransferTasks(gomock.Any()).DoAndReturn(
+			func(request *persistence.GetTransferTasksRequest) (*persistence.GetTransferTasksResponse, error) {
+				assert.Equal(t, readLevel, request.ReadLevel)
+				return resp, nil
+			})
+	}
+	gomock.InOrder(
+		expectRead(10, newTasks(12, 14)),
+		// task 13 is committed after task 14
+		expectRead(10, newTasks(12, 13, 14)),
+		expectRead(14, newTasks(15)),
+		expectRead(14, newTasks(15)),
+	)
+
+	follower := newTaskFollower(enumsspb.TASK_CATEGORY_TRANSFER, executionManager, 100, taskCursor{TaskID: 10})
+	var polled [][]int64
+	for i := 0; i < 4; i++ {
+		tasks, err := follower.poll(time.Now().UTC())
+		require.NoError(t, err)
+		var taskIDs []int64
+		for _, task := range tasks {
+			taskIDs = append(taskIDs, task.(*persistencespb.TransferTaskInfo).GetTaskId())
+		}
+		polled = append(polled, taskIDs)
+	}
+	assert.Equal(t, [][]int64{{12, 14}, {13}, {15}, nil}, polled)
+}
+
+func TestTaskFollower_Timer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	executionManager := persistence.NewMockExecutionManager(ctrl)
+
+	start := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	dueTimer := &persistencespb.TimerTaskInfo{TaskId: 2, VisibilityTime: timestamp.TimePtr(start.Add(time.Second))}
+	futureTimer := &persistencespb.TimerTaskInfo{TaskId: 1, VisibilityTime: timestamp.TimePtr(start.Add(time.Hour))}
+	newTimer := &persistencespb.TimerTaskInfo{TaskId: 3, VisibilityTime: timestamp.TimePtr(start.Add(time.Minute))}
+	expectRead := func(minTimestamp time.Time, timers ...*persistencespb.TimerTaskInfo) *gomock.Call {
+		return executionManager.EXPECT().GetTimerIndexTasks(gomock.Any()).DoAndReturn(
+			func(request *persistence.GetTimerIndexTasksRequest) (*persistence.GetTimerIndexTasksResponse, error) {
+				assert.True(t, minTimestamp.Equal(request.MinTimestamp), "%v != %v", minTimestamp, request.MinTimestamp)
+				return &persistence.GetTimerIndexTasksResponse{Timers: timers}, nil
+			})
+	}
+	gomock.InOrder(
+		expectRead(start, dueTimer, futureTimer),
+		expectRead(start, dueTimer, futureTimer),
+		// the cursor doesn't pass the future timer, so the timer created after it with an earlier timestamp is returned
+		expectRead(start, dueTimer, newTimer, futureTimer),
+		expectRead(start.Add(time.Second), newTimer, futureTimer),
+	)
+
+	follower := newTaskFollower(enumsspb.TASK_CATEGORY_TIMER, executionManager, 100, taskCursor{VisibilityTimestamp: start})
+	tasks, err := follower.poll(start.Add(2 * time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{dueTimer, futureTimer}, tasks)
+	tasks, err = follower.poll(start.Add(3 * time.Second))
+	require.NoError(t, err)
+	assert.Empty(t, tasks)
+	tasks, err = follower.poll(start.Add(4 * time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{newTimer}, tasks)
+	tasks, err = follower.poll(start.Add(5 * time.Second))
+	require.NoError(t, err)
+	assert.Empty(t, tasks)
+}
@@ -892,7 +892,7 @@ func includeShardInProgressReport(report *ShardScanReport, progressReport *Progr
 
 func getRateLimiter(startRPS int, targetRPS int) quotas.RateLimiter {
 	if startRPS >= targetRPS {
-		ErrorAndExit("startRPS is greater than target RPS", nil)
+		InvalidArgumentAndExit("startRPS is greater than target RPS", nil)
 	}
 	return quotas.NewDefaultOutgoingDynamicRateLimiter(
 		func() float64 { return float64(targetRPS) },
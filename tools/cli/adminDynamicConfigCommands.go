@@ -0,0 +1,206 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.uber.org/multierr"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+// AdminGetDynamicConfig shows dynamic config values of a key stored in the database
+func AdminGetDynamicConfig(c *cli.Context) {
+	name := getRequiredOption(c, FlagName)
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := adminClient.GetDynamicConfig(ctx, &adminservice.GetDynamicConfigRequest{
+		Name: name,
+	})
+	if err != nil {
+		ErrorAndExit("Unable to get dynamic config.", err)
+	}
+
+	if c.Bool(FlagPrintJSON) {
+		prettyPrintJSONObject(response)
+		return
+	}
+	printDynamicConfig(map[string]*persistencespb.DynamicConfigValues{name: response.GetValues()})
+}
+
+// AdminSetDynamicConfig sets or removes a dynamic config value stored in the database
+func AdminSetDynamicConfig(c *cli.Context) {
+	name := getRequiredOption(c, FlagName)
+	remove := c.Bool(FlagDynamicConfigRemove)
+	var value string
+	if !remove {
+		value = getRequiredOption(c, FlagDynamicConfigValue)
+	}
+	constraints := &persistencespb.DynamicConfigConstraints{
+		Namespace:     c.String(FlagDynamicConfigNamespace),
+		NamespaceId:   c.String(FlagNamespaceID),
+		TaskQueueName: c.String(FlagTaskQueue),
+		ShardId:       int32(c.Int(FlagShardID)),
+	}
+	if c.IsSet(FlagTaskQueueType) {
+		constraints.TaskType = strToTaskQueueType(c.String(FlagTaskQueueType))
+	}
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := adminClient.GetDynamicConfig(ctx, &adminservice.GetDynamicConfigRequest{
+		Name: name,
+	})
+	if err != nil {
+		ErrorAndExit("Unable to get dynamic config.", err)
+	}
+
+	// values with other constraints are kept
+	var values []*persistencespb.DynamicConfigValue
+	for _, v := range response.GetValues().GetValues() {
+		if formatDynamicConfigConstraints(v.GetConstraints()) != formatDynamicConfigConstraints(constraints) {
+			values = append(values, v)
+		}
+	}
+	if !remove {
+		values = append(values, &persistencespb.DynamicConfigValue{
+			Value:       value,
+			Constraints: constraints,
+		})
+	}
+
+	_, err = adminClient.SetDynamicConfig(ctx, &adminservice.SetDynamicConfigRequest{
+		Name:   name,
+		Values: values,
+	})
+	if err != nil {
+		ErrorAndExit("Unable to set dynamic config.", err)
+	}
+	if remove {
+		prettyPrintJSONObject("Dynamic config value removed.")
+		return
+	}
+	prettyPrintJSONObject("Dynamic config value set.")
+}
+
+// AdminListDynamicConfig lists all dynamic config values stored in the database
+func AdminListDynamicConfig(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := adminClient.ListDynamicConfig(ctx, &adminservice.ListDynamicConfigRequest{})
+	if err != nil {
+		ErrorAndExit("Unable to list dynamic config.", err)
+	}
+
+	if c.Bool(FlagPrintJSON) {
+		prettyPrintJSONObject(response)
+		return
+	}
+	printDynamicConfig(response.GetDynamicConfig())
+}
+
+// AdminValidateDynamicConfig validates a dynamic config file against the keys known by this version
+func AdminValidateDynamicConfig(c *cli.Context) {
+	filepath := getRequiredArg(c, "file")
+	err := dynamicconfig.ValidateFile(filepath)
+	if err != nil {
+		for _, e := range multierr.Errors(err) {
+			fmt.Println(e)
+		}
+		ErrorAndExit(fmt.Sprintf("Dynamic config file %s is invalid.", filepath), nil)
+	}
+	warnings, err := dynamicconfig.DeprecatedKeys(filepath)
+	if err != nil {
+		ErrorAndExit(fmt.Sprintf("Unable to read dynamic config file %s.", filepath), err)
+	}
+	for _, warning := range warnings {
+		fmt.Println("WARNING: " + warning)
+	}
+	fmt.Printf("Dynamic config file %s is valid.\n", filepath)
+}
+
+func printDynamicConfig(dynamicConfig map[string]*persistencespb.DynamicConfigValues) {
+	names := make([]string, 0, len(dynamicConfig))
+	for name := range dynamicConfig {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(true)
+	table.SetColumnSeparator("|")
+	header := []string{"Name", "Constraints", "Value", "Last Update Time"}
+	headerColor := []tablewriter.Colors{tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue}
+	table.SetHeader(header)
+	table.SetHeaderColor(headerColor...)
+	for _, name := range names {
+		values := dynamicConfig[name]
+		for _, v := range values.GetValues() {
+			table.Append([]string{
+				name,
+				formatDynamicConfigConstraints(v.GetConstraints()),
+				v.GetValue(),
+				timestamp.TimeValue(values.GetLastUpdateTime()).String(),
+			})
+		}
+	}
+	table.Render()
+}
+
+func formatDynamicConfigConstraints(constraints *persistencespb.DynamicConfigConstraints) string {
+	var result []string
+	if constraints.GetNamespace() != "" {
+		result = append(result, "namespace="+constraints.GetNamespace())
+	}
+	if constraints.GetNamespaceId() != "" {
+		result = append(result, "namespaceID="+constraints.GetNamespaceId())
+	}
+	if constraints.GetTaskQueueName() != "" {
+		result = append(result, "taskQueueName="+constraints.GetTaskQueueName())
+	}
+	if constraints.GetTaskType() != enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+		result = append(result, "taskType="+constraints.GetTaskType().String())
+	}
+	if constraints.GetShardId() != 0 {
+		result = append(result, "shardID="+strconv.Itoa(int(constraints.GetShardId())))
+	}
+	return strings.Join(result, ", ")
+}
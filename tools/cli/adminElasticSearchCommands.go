@@ -38,6 +38,7 @@ import (
 
 	"github.com/olekukonko/tablewriter"
 	"github.com/urfave/cli"
+	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/common/config"
 	dc "go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
@@ -46,6 +47,7 @@ import (
 	"go.temporal.io/server/common/persistence/visibility/elasticsearch"
 	esclient "go.temporal.io/server/common/persistence/visibility/elasticsearch/client"
 	"go.temporal.io/server/common/persistence/visibility/elasticsearch/esql"
+	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/common/searchattribute"
 	"go.uber.org/atomic"
@@ -238,6 +240,60 @@ func AdminDelete(c *cli.Context) {
 	}
 }
 
+// AdminDescribeVisibilityProcessor shows the state of the Elasticsearch bulk processors of history hosts
+func AdminDescribeVisibilityProcessor(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := adminClient.DescribeVisibilityProcessor(ctx, &adminservice.DescribeVisibilityProcessorRequest{
+		HostAddress: c.String(FlagAddress),
+		Flush:       c.Bool(FlagFlush),
+	})
+	if err != nil {
+		ErrorAndExit("Operation DescribeVisibilityProcessor failed.", err)
+	}
+
+	if c.Bool(FlagPrintJSON) {
+		prettyPrintJSONObject(response)
+		return
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(true)
+	table.SetColumnSeparator("|")
+	header := []string{"Host", "Bulk Processor", "Queued", "Committed", "Succeeded", "Failed", "In-flight Bulks", "Pending Acks", "Last Commit Error"}
+	headerColor := make([]tablewriter.Colors, len(header))
+	for i := range headerColor {
+		headerColor[i] = tableHeaderBlue
+	}
+	table.SetHeader(header)
+	table.SetHeaderColor(headerColor...)
+	for _, processor := range response.GetProcessors() {
+		lastCommitError := processor.GetLastCommitError()
+		if lastCommitError != "" {
+			lastCommitError = fmt.Sprintf("%s: %s", formatTime(timestamp.TimeValue(processor.GetLastCommitErrorTime()), false), lastCommitError)
+		}
+		for _, bulkProcessor := range processor.GetBulkProcessors() {
+			table.Append([]string{
+				processor.GetHostAddress(),
+				bulkProcessor.GetName(),
+				strconv.FormatInt(bulkProcessor.GetQueued(), 10),
+				strconv.FormatInt(bulkProcessor.GetCommitted(), 10),
+				strconv.FormatInt(bulkProcessor.GetSucceeded(), 10),
+				strconv.FormatInt(bulkProcessor.GetFailed(), 10),
+				strconv.FormatInt(processor.GetInFlightBulks(), 10),
+				strconv.FormatInt(processor.GetPendingAcks(), 10),
+				lastCommitError,
+			})
+		}
+	}
+	table.Render()
+
+	if len(response.GetUnreachableHosts()) > 0 {
+		fmt.Printf("Processors of unreachable history hosts are missing: %v\n", strings.Join(response.GetUnreachableHosts(), ", "))
+	}
+}
+
 func parseIndexerMessage(fileName string) (messages []*visibility.RecordWorkflowExecutionClosedRequest, err error) {
 	file, err := os.Open(fileName)
 	if err != nil {
@@ -332,7 +388,7 @@ func GenerateReport(c *cli.Context) {
 	var buckets []interface{}
 	err = json.Unmarshal(resp.Aggregations["groupby"], &groupby)
 	if err != nil {
-		ErrorAndExit("Fail to parse groupby", err)
+		InvalidArgumentAndExit("Fail to parse groupby", err)
 	}
 	buckets = groupby["buckets"].([]interface{})
 	if len(buckets) == 0 {
@@ -427,7 +483,7 @@ func GenerateReport(c *cli.Context) {
 	case "csv", "CSV":
 		generateCSVReport(reportFilePath, headers, tableData)
 	default:
-		ErrorAndExit(fmt.Sprintf(`Report format %v not supported.`, reportFormat), nil)
+		InvalidArgumentAndExit(fmt.Sprintf(`Report format %v not supported.`, reportFormat), nil)
 	}
 }
 
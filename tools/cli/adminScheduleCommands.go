@@ -0,0 +1,326 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/urfave/cli"
+	commonpb "go.temporal.io/api/common/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	schedpb "go.temporal.io/server/api/schedule/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+// AdminCreateSchedule creates a schedule
+func AdminCreateSchedule(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+	schedule := scheduleFromFlags(c)
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err := adminClient.CreateSchedule(ctx, &adminservice.CreateScheduleRequest{
+		Namespace:  namespace,
+		ScheduleId: scheduleID,
+		Schedule:   schedule,
+		Identity:   getCliIdentity(),
+	})
+	if err != nil {
+		ErrorAndExit("Unable to create schedule.", err)
+	}
+	fmt.Printf("Schedule %s created.\n", scheduleID)
+}
+
+// AdminUpdateSchedule replaces a schedule
+func AdminUpdateSchedule(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+	schedule := scheduleFromFlags(c)
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err := adminClient.UpdateSchedule(ctx, &adminservice.UpdateScheduleRequest{
+		Namespace:  namespace,
+		ScheduleId: scheduleID,
+		Schedule:   schedule,
+		Identity:   getCliIdentity(),
+	})
+	if err != nil {
+		ErrorAndExit("Unable to update schedule.", err)
+	}
+	fmt.Printf("Schedule %s updated.\n", scheduleID)
+}
+
+// AdminDescribeSchedule describes a schedule
+func AdminDescribeSchedule(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := adminClient.DescribeSchedule(ctx, &adminservice.DescribeScheduleRequest{
+		Namespace:  namespace,
+		ScheduleId: scheduleID,
+	})
+	if err != nil {
+		ErrorAndExit("Unable to describe schedule.", err)
+	}
+	prettyPrintJSONObject(response)
+}
+
+// AdminListSchedules lists the schedules of a namespace
+func AdminListSchedules(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	var nextPageToken []byte
+	for {
+		response, err := adminClient.ListSchedules(ctx, &adminservice.ListSchedulesRequest{
+			Namespace:     namespace,
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			ErrorAndExit("Unable to list schedules.", err)
+		}
+		for _, scheduleID := range response.GetScheduleIds() {
+			fmt.Println(scheduleID)
+		}
+		nextPageToken = response.GetNextPageToken()
+		if len(nextPageToken) == 0 {
+			return
+		}
+	}
+}
+
+// AdminPauseSchedule pauses or unpauses a schedule
+func AdminPauseSchedule(c *cli.Context, pause bool) {
+	notes := c.String(FlagReason)
+	patch := &schedpb.SchedulePatch{}
+	if pause {
+		if notes == "" {
+			notes = "Paused via tctl."
+		}
+		patch.Pause = notes
+	} else {
+		if notes == "" {
+			notes = "Unpaused via tctl."
+		}
+		patch.Unpause = notes
+	}
+	patchSchedule(c, patch)
+	if pause {
+		fmt.Println("Schedule paused.")
+	} else {
+		fmt.Println("Schedule unpaused.")
+	}
+}
+
+// AdminTriggerSchedule takes the action of a schedule right away
+func AdminTriggerSchedule(c *cli.Context) {
+	patchSchedule(c, &schedpb.SchedulePatch{
+		TriggerImmediately: &schedpb.TriggerImmediatelyRequest{
+			OverlapPolicy: overlapPolicyFromFlag(c),
+		},
+	})
+	fmt.Println("Schedule triggered.")
+}
+
+// AdminBackfillSchedule takes the actions a schedule would have taken in a time range
+func AdminBackfillSchedule(c *cli.Context) {
+	now := time.Now().UTC()
+	startTime := parseTime(getRequiredOption(c, FlagEarliestTime), time.Time{}, now)
+	endTime := parseTime(getRequiredOption(c, FlagLatestTime), time.Time{}, now)
+	patchSchedule(c, &schedpb.SchedulePatch{
+		BackfillRequest: []*schedpb.BackfillRequest{{
+			StartTime:     &startTime,
+			EndTime:       &endTime,
+			OverlapPolicy: overlapPolicyFromFlag(c),
+		}},
+	})
+	fmt.Println("Backfill requested.")
+}
+
+// AdminDeleteSchedule deletes a schedule
+func AdminDeleteSchedule(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err := adminClient.DeleteSchedule(ctx, &adminservice.DeleteScheduleRequest{
+		Namespace:  namespace,
+		ScheduleId: scheduleID,
+		Identity:   getCliIdentity(),
+	})
+	if err != nil {
+		ErrorAndExit("Unable to delete schedule.", err)
+	}
+	fmt.Printf("Schedule %s deleted.\n", scheduleID)
+}
+
+func patchSchedule(c *cli.Context, patch *schedpb.SchedulePatch) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err := adminClient.PatchSchedule(ctx, &adminservice.PatchScheduleRequest{
+		Namespace:  namespace,
+		ScheduleId: scheduleID,
+		Patch:      patch,
+		Identity:   getCliIdentity(),
+	})
+	if err != nil {
+		ErrorAndExit("Unable to patch schedule.", err)
+	}
+}
+
+func scheduleFromFlags(c *cli.Context) *schedpb.Schedule {
+	spec := &schedpb.ScheduleSpec{}
+	for _, calendar := range c.StringSlice(FlagCalendar) {
+		spec.Calendar = append(spec.Calendar, parseCalendar(calendar))
+	}
+	for _, interval := range c.StringSlice(FlagInterval) {
+		spec.Interval = append(spec.Interval, parseInterval(interval))
+	}
+	if c.IsSet(FlagJitter) {
+		spec.Jitter = timestamp.DurationPtr(parseDurationFlag(c, FlagJitter))
+	}
+	if len(spec.Calendar) == 0 && len(spec.Interval) == 0 {
+		InvalidArgumentAndExit(fmt.Sprintf("At least one %s or %s is required.", FlagCalendar, FlagInterval), nil)
+	}
+
+	policies := &schedpb.SchedulePolicies{
+		OverlapPolicy:  overlapPolicyFromFlag(c),
+		PauseOnFailure: c.Bool(FlagPauseOnFailure),
+	}
+	if c.IsSet(FlagCatchupWindow) {
+		policies.CatchupWindow = timestamp.DurationPtr(parseDurationFlag(c, FlagCatchupWindow))
+	}
+
+	state := &schedpb.ScheduleState{
+		Notes:  c.String(FlagNotes),
+		Paused: c.Bool(FlagPaused),
+	}
+	if c.IsSet(FlagRemainingActions) {
+		state.LimitedActions = true
+		state.RemainingActions = int64(c.Int(FlagRemainingActions))
+	}
+
+	action := &schedpb.ScheduleAction{
+		WorkflowId:          c.String(FlagWorkflowID),
+		WorkflowType:        &commonpb.WorkflowType{Name: getRequiredOption(c, FlagWorkflowType)},
+		TaskQueue:           &taskqueuepb.TaskQueue{Name: getRequiredOption(c, FlagTaskQueue)},
+		Input:               processJSONInput(c),
+		WorkflowTaskTimeout: timestamp.DurationPtr(time.Duration(c.Int(FlagWorkflowTaskTimeout)) * time.Second),
+	}
+	if c.IsSet(FlagExecutionTimeout) {
+		action.WorkflowExecutionTimeout = timestamp.DurationPtr(time.Duration(c.Int(FlagExecutionTimeout)) * time.Second)
+	}
+
+	return &schedpb.Schedule{
+		Spec:     spec,
+		Action:   action,
+		Policies: policies,
+		State:    state,
+	}
+}
+
+// parseCalendar parses a calendar in cron format with an optional leading second field.
+func parseCalendar(calendar string) *schedpb.CalendarSpec {
+	fields := strings.Fields(calendar)
+	switch len(fields) {
+	case 5:
+		fields = append([]string{"0"}, fields...)
+	case 6:
+	default:
+		InvalidArgumentAndExit(fmt.Sprintf("Invalid %s %q, it must have 5 or 6 fields.", FlagCalendar, calendar), nil)
+	}
+	return &schedpb.CalendarSpec{
+		Second:     fields[0],
+		Minute:     fields[1],
+		Hour:       fields[2],
+		DayOfMonth: fields[3],
+		Month:      fields[4],
+		DayOfWeek:  fields[5],
+	}
+}
+
+// parseInterval parses an interval in the format <interval>[/<phase>].
+func parseInterval(interval string) *schedpb.IntervalSpec {
+	parts := strings.SplitN(interval, "/", 2)
+	length, err := time.ParseDuration(parts[0])
+	if err != nil {
+		InvalidArgumentAndExit(fmt.Sprintf("Invalid %s %q.", FlagInterval, interval), err)
+	}
+	spec := &schedpb.IntervalSpec{Interval: timestamp.DurationPtr(length)}
+	if len(parts) == 2 {
+		phase, err := time.ParseDuration(parts[1])
+		if err != nil {
+			InvalidArgumentAndExit(fmt.Sprintf("Invalid %s %q.", FlagInterval, interval), err)
+		}
+		spec.Phase = timestamp.DurationPtr(phase)
+	}
+	return spec
+}
+
+func parseDurationFlag(c *cli.Context, flag string) time.Duration {
+	d, err := time.ParseDuration(c.String(flag))
+	if err != nil {
+		InvalidArgumentAndExit(fmt.Sprintf("Invalid %s.", flag), err)
+	}
+	return d
+}
+
+func overlapPolicyFromFlag(c *cli.Context) enumsspb.ScheduleOverlapPolicy {
+	if !c.IsSet(FlagOverlapPolicy) {
+		return enumsspb.SCHEDULE_OVERLAP_POLICY_UNSPECIFIED
+	}
+	// enum values are named in camel case, e.g. BufferOne for buffer_one
+	var name string
+	for _, word := range strings.Split(strings.ToLower(c.String(FlagOverlapPolicy)), "_") {
+		if word != "" {
+			name += strings.ToUpper(word[:1]) + word[1:]
+		}
+	}
+	value, ok := enumsspb.ScheduleOverlapPolicy_value[name]
+	if !ok || value == int32(enumsspb.SCHEDULE_OVERLAP_POLICY_UNSPECIFIED) {
+		InvalidArgumentAndExit(fmt.Sprintf("Invalid %s %q.", FlagOverlapPolicy, c.String(FlagOverlapPolicy)), nil)
+	}
+	return enumsspb.ScheduleOverlapPolicy(value)
+}
@@ -27,6 +27,8 @@ package cli
 import (
 	"fmt"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/olekukonko/tablewriter"
 	"github.com/urfave/cli"
@@ -34,6 +36,10 @@ import (
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/api/workflowservice/v1"
 
+	"go.temporal.io/server/api/adminservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+
 	"go.temporal.io/server/common/convert"
 
 	"go.temporal.io/server/common/persistence"
@@ -45,14 +51,12 @@ func AdminDescribeTaskQueue(c *cli.Context) {
 	frontendClient := cFactory.FrontendClient(c)
 	namespace := getRequiredGlobalOption(c, FlagNamespace)
 	taskQueue := getRequiredOption(c, FlagTaskQueue)
-	tlTypeInt, err := stringToEnum(c.String(FlagTaskQueueType), enumspb.TaskQueueType_value)
-	if err != nil {
-		ErrorAndExit("Failed to parse TaskQueue Type", err)
-	}
-	tlType := enumspb.TaskQueueType(tlTypeInt)
-	if tlType == enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
-		ErrorAndExit("TaskQueue type Unspecified is currently not supported", nil)
+	tlType := getTaskQueueType(c)
+	if c.Bool(FlagPartitions) {
+		describeTaskQueuePartitions(c, namespace, taskQueue, tlType)
+		return
 	}
+
 	ctx, cancel := newContext(c)
 	defer cancel()
 	request := &workflowservice.DescribeTaskQueueRequest{
@@ -84,6 +88,243 @@ func AdminDescribeTaskQueue(c *cli.Context) {
 	printPollerInfo(pollers, tlType)
 }
 
+// AdminDescribeTaskQueuePartitionTree displays the forwarding tree of task queue partitions with their owner hosts.
+func AdminDescribeTaskQueuePartitionTree(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	taskQueue := getRequiredOption(c, FlagTaskQueue)
+	tlType := getTaskQueueType(c)
+	partitions := getTaskQueuePartitions(c, namespace, taskQueue, tlType)
+
+	children := make(map[string][]*taskqueuespb.TaskQueuePartitionStatus)
+	known := make(map[string]bool, len(partitions))
+	for _, partition := range partitions {
+		known[partition.GetPartition()] = true
+	}
+	var roots []*taskqueuespb.TaskQueuePartitionStatus
+	for _, partition := range partitions {
+		parent := partition.GetParentPartition()
+		if parent == "" || !known[parent] {
+			roots = append(roots, partition)
+			continue
+		}
+		children[parent] = append(children[parent], partition)
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetAutoWrapText(false)
+	header := []string{"Partition", "Host", "Backlog", "Add Rate", "Dispatch Rate", "Pollers"}
+	table.SetHeader(header)
+	table.SetHeaderLine(false)
+	headerColor := make([]tablewriter.Colors, len(header))
+	for i := range headerColor {
+		headerColor[i] = tableHeaderBlue
+	}
+	table.SetHeaderColor(headerColor...)
+	var appendPartition func(partition *taskqueuespb.TaskQueuePartitionStatus, depth int)
+	appendPartition = func(partition *taskqueuespb.TaskQueuePartitionStatus, depth int) {
+		name := partition.GetPartition()
+		if depth > 0 {
+			name = strings.Repeat("  ", depth-1) + "└─ " + name
+		}
+		table.Append([]string{
+			name,
+			partition.GetOwnerHostName(),
+			convert.Int64ToString(partition.GetTaskQueueStatus().GetBacklogCountHint()),
+			fmt.Sprintf("%.2f", partition.GetBacklogStatus().GetTaskAddRate()),
+			fmt.Sprintf("%.2f", partition.GetBacklogStatus().GetTaskDispatchRate()),
+			convert.IntToString(len(partition.GetPollers())),
+		})
+		for _, child := range children[partition.GetPartition()] {
+			appendPartition(child, depth+1)
+		}
+	}
+	for _, root := range roots {
+		appendPartition(root, 0)
+	}
+	table.Render()
+}
+
+func getTaskQueueType(c *cli.Context) enumspb.TaskQueueType {
+	tlTypeInt, err := stringToEnum(c.String(FlagTaskQueueType), enumspb.TaskQueueType_value)
+	if err != nil {
+		InvalidArgumentAndExit("Failed to parse TaskQueue Type", err)
+	}
+	tlType := enumspb.TaskQueueType(tlTypeInt)
+	if tlType == enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+		InvalidArgumentAndExit("TaskQueue type Unspecified is currently not supported", nil)
+	}
+	return tlType
+}
+
+func getTaskQueuePartitions(
+	c *cli.Context,
+	namespace string,
+	taskQueue string,
+	tlType enumspb.TaskQueueType,
+) []*taskqueuespb.TaskQueuePartitionStatus {
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := adminClient.DescribeTaskQueuePartitions(ctx, &adminservice.DescribeTaskQueuePartitionsRequest{
+		Namespace:     namespace,
+		TaskQueue:     taskQueue,
+		TaskQueueType: tlType,
+	})
+	if err != nil {
+		ErrorAndExit("Operation DescribeTaskQueuePartitions failed.", err)
+	}
+
+	partitions := response.GetPartitions()
+	if len(partitions) == 0 {
+		ErrorAndExit(colorMagenta("No partitions for taskqueue: "+taskQueue), nil)
+	}
+	return partitions
+}
+
+func describeTaskQueuePartitions(c *cli.Context, namespace string, taskQueue string, tlType enumspb.TaskQueueType) {
+	partitions := getTaskQueuePartitions(c, namespace, taskQueue, tlType)
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	header := []string{"Partition", "Host", "Read Level", "Ack Level", "Backlog", "Buffered Tasks", "Last Read Time", "Backlog Age", "Add Rate", "Dispatch Rate", "Pollers"}
+	table.SetHeader(header)
+	table.SetHeaderLine(false)
+	headerColor := make([]tablewriter.Colors, len(header))
+	for i := range headerColor {
+		headerColor[i] = tableHeaderBlue
+	}
+	table.SetHeaderColor(headerColor...)
+	for _, partition := range partitions {
+		status := partition.GetTaskQueueStatus()
+		readerStatus := partition.GetTaskReaderStatus()
+		backlogStatus := partition.GetBacklogStatus()
+		lastReadTime := ""
+		if readerStatus.GetLastReadTime() != nil {
+			lastReadTime = formatTime(timestamp.TimeValue(readerStatus.GetLastReadTime()), false)
+		}
+		table.Append([]string{
+			partition.GetPartition(),
+			partition.GetOwnerHostName(),
+			convert.Int64ToString(status.GetReadLevel()),
+			convert.Int64ToString(status.GetAckLevel()),
+			convert.Int64ToString(status.GetBacklogCountHint()),
+			convert.Int64ToString(readerStatus.GetBufferedTaskCount()),
+			lastReadTime,
+			timestamp.DurationValue(backlogStatus.GetBacklogAge()).Round(time.Second).String(),
+			fmt.Sprintf("%.2f", backlogStatus.GetTaskAddRate()),
+			fmt.Sprintf("%.2f", backlogStatus.GetTaskDispatchRate()),
+			convert.IntToString(len(partition.GetPollers())),
+		})
+	}
+	table.Render()
+}
+
+// AdminAddNewDefaultBuildID adds a build id in a new compatible set, which becomes the default set of task queue.
+func AdminAddNewDefaultBuildID(c *cli.Context) {
+	updateBuildIDCompatibility(c, &taskqueuespb.BuildIdCompatibilityUpdate{
+		Operation: &taskqueuespb.BuildIdCompatibilityUpdate_AddNewBuildIdInNewDefaultSet{
+			AddNewBuildIdInNewDefaultSet: getRequiredOption(c, FlagBuildID),
+		},
+	})
+}
+
+// AdminAddCompatibleBuildID adds a build id to the set of an existing compatible build id.
+func AdminAddCompatibleBuildID(c *cli.Context) {
+	updateBuildIDCompatibility(c, &taskqueuespb.BuildIdCompatibilityUpdate{
+		Operation: &taskqueuespb.BuildIdCompatibilityUpdate_AddNewCompatibleBuildId_{
+			AddNewCompatibleBuildId: &taskqueuespb.BuildIdCompatibilityUpdate_AddNewCompatibleBuildId{
+				NewBuildId:                getRequiredOption(c, FlagBuildID),
+				ExistingCompatibleBuildId: getRequiredOption(c, FlagExistingCompatibleBuildID),
+				MakeSetDefault:            c.Bool(FlagSetDefault),
+			},
+		},
+	})
+}
+
+// AdminPromoteBuildIDSet makes the set of a build id the default set of task queue.
+func AdminPromoteBuildIDSet(c *cli.Context) {
+	updateBuildIDCompatibility(c, &taskqueuespb.BuildIdCompatibilityUpdate{
+		Operation: &taskqueuespb.BuildIdCompatibilityUpdate_PromoteSetByBuildId{
+			PromoteSetByBuildId: getRequiredOption(c, FlagBuildID),
+		},
+	})
+}
+
+// AdminPromoteBuildIDWithinSet makes a build id the default build id of its set.
+func AdminPromoteBuildIDWithinSet(c *cli.Context) {
+	updateBuildIDCompatibility(c, &taskqueuespb.BuildIdCompatibilityUpdate{
+		Operation: &taskqueuespb.BuildIdCompatibilityUpdate_PromoteBuildIdWithinSet{
+			PromoteBuildIdWithinSet: getRequiredOption(c, FlagBuildID),
+		},
+	})
+}
+
+func updateBuildIDCompatibility(c *cli.Context, update *taskqueuespb.BuildIdCompatibilityUpdate) {
+	adminClient := cFactory.AdminClient(c)
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	taskQueue := getRequiredOption(c, FlagTaskQueue)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := adminClient.UpdateWorkerBuildIdCompatibility(ctx, &adminservice.UpdateWorkerBuildIdCompatibilityRequest{
+		Namespace: namespace,
+		TaskQueue: taskQueue,
+		Update:    update,
+	})
+	if err != nil {
+		ErrorAndExit("Operation UpdateWorkerBuildIdCompatibility failed.", err)
+	}
+	printVersionSets(response.GetVersioningData())
+}
+
+// AdminGetBuildIDCompatibility displays the compatible worker build id sets of task queue.
+func AdminGetBuildIDCompatibility(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	taskQueue := getRequiredOption(c, FlagTaskQueue)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := adminClient.GetWorkerBuildIdCompatibility(ctx, &adminservice.GetWorkerBuildIdCompatibilityRequest{
+		Namespace: namespace,
+		TaskQueue: taskQueue,
+		MaxSets:   int32(c.Int(FlagMaxSets)),
+	})
+	if err != nil {
+		ErrorAndExit("Operation GetWorkerBuildIdCompatibility failed.", err)
+	}
+	if len(response.GetVersioningData().GetVersionSets()) == 0 {
+		ErrorAndExit(colorMagenta("No worker build ids for taskqueue: "+taskQueue), nil)
+	}
+	printVersionSets(response.GetVersioningData())
+}
+
+func printVersionSets(versioningData *persistencespb.VersioningData) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Default Build Id", "Compatible Build Ids", "Default Set"})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	sets := versioningData.GetVersionSets()
+	// the last set has the highest precedence, show it first
+	for i := len(sets) - 1; i >= 0; i-- {
+		buildIDs := sets[i].GetBuildIds()
+		if len(buildIDs) == 0 {
+			continue
+		}
+		table.Append([]string{
+			buildIDs[len(buildIDs)-1],
+			strings.Join(buildIDs[:len(buildIDs)-1], ", "),
+			fmt.Sprintf("%v", i == len(sets)-1),
+		})
+	}
+	table.Render()
+}
+
 func printTaskQueueStatus(taskQueueStatus *taskqueuepb.TaskQueueStatus) {
 	taskIDBlock := taskQueueStatus.GetTaskIdBlock()
 
@@ -124,11 +365,11 @@ func AdminListTaskQueueTasks(c *cli.Context) {
 	tlName := getRequiredOption(c, FlagTaskQueue)
 	tlTypeInt, err := stringToEnum(c.String(FlagTaskQueueType), enumspb.TaskQueueType_value)
 	if err != nil {
-		ErrorAndExit("Failed to parse TaskQueue Type", err)
+		InvalidArgumentAndExit("Failed to parse TaskQueue Type", err)
 	}
 	tlType := enumspb.TaskQueueType(tlTypeInt)
 	if tlType == enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
-		ErrorAndExit("TaskQueue type Unspecified is currently not supported", nil)
+		InvalidArgumentAndExit("TaskQueue type Unspecified is currently not supported", nil)
 	}
 	minReadLvl := getRequiredInt64Option(c, FlagMinReadLevel)
 	maxReadLvl := getRequiredInt64Option(c, FlagMaxReadLevel)
@@ -174,3 +415,28 @@ func AdminListTaskQueueTasks(c *cli.Context) {
 
 	paginate(c, paginationFunc)
 }
+
+// AdminShutdownWorker evicts the sticky task queue of a worker which is shutting down,
+// so that its workflow tasks are dispatched to the normal task queue right away.
+func AdminShutdownWorker(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	stickyTaskQueue := getRequiredOption(c, FlagTaskQueue)
+	identity := c.String(FlagIdentity)
+	if identity == "" {
+		identity = getCliIdentity()
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err := adminClient.ShutdownWorker(ctx, &adminservice.ShutdownWorkerRequest{
+		Namespace:       namespace,
+		StickyTaskQueue: stickyTaskQueue,
+		Identity:        identity,
+		Reason:          c.String(FlagReason),
+	})
+	if err != nil {
+		ErrorAndExit("Operation ShutdownWorker failed.", err)
+	}
+	fmt.Printf("Sticky task queue %s evicted.\n", stickyTaskQueue)
+}
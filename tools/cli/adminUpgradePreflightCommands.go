@@ -0,0 +1,347 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/blang/semver/v4"
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/persistence/cassandra"
+	"go.temporal.io/server/common/persistence/sql"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/resolver"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+const (
+	preflightPass = "PASS"
+	preflightWarn = "WARN"
+	preflightFail = "FAIL"
+	preflightSkip = "SKIP"
+)
+
+type (
+	preflightResult struct {
+		Check   string `json:"check"`
+		Status  string `json:"status"`
+		Details string `json:"details"`
+	}
+)
+
+// esTypes lists Elasticsearch field types compatible with search attribute types.
+var esTypes = map[enumspb.IndexedValueType][]string{
+	enumspb.INDEXED_VALUE_TYPE_STRING:   {"text"},
+	enumspb.INDEXED_VALUE_TYPE_KEYWORD:  {"keyword"},
+	enumspb.INDEXED_VALUE_TYPE_INT:      {"long", "integer"},
+	enumspb.INDEXED_VALUE_TYPE_DOUBLE:   {"scaled_float", "double", "float"},
+	enumspb.INDEXED_VALUE_TYPE_BOOL:     {"boolean"},
+	enumspb.INDEXED_VALUE_TYPE_DATETIME: {"date_nanos", "date"},
+
+	searchattribute.IndexedValueTypeKeywordList: {"keyword"},
+}
+
+// AdminUpgradePreflight runs checks which must pass before the cluster is upgraded to the target server version
+func AdminUpgradePreflight(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+	maxReplicationLag, err := time.ParseDuration(c.String(FlagMaxReplicationLag))
+	if err != nil {
+		InvalidArgumentAndExit(fmt.Sprintf("Invalid %s.", FlagMaxReplicationLag), err)
+	}
+
+	var serviceConfig *config.Config
+	if c.String(FlagServiceConfigDir) != "" {
+		serviceConfig = loadConfig(c)
+	}
+
+	results := []preflightResult{
+		preflightServerVersion(c, adminClient),
+		preflightSchemaVersion(serviceConfig),
+		preflightDynamicConfig(serviceConfig),
+		preflightSearchAttributes(c, adminClient),
+	}
+	results = append(results, preflightReplication(c, adminClient, maxReplicationLag, c.Int64(FlagMaxDLQDepth))...)
+
+	if c.Bool(FlagPrintJSON) {
+		prettyPrintJSONObject(results)
+	} else {
+		printPreflightResults(results)
+	}
+
+	failed := 0
+	for _, result := range results {
+		if result.Status == preflightFail {
+			failed++
+		}
+	}
+	if failed > 0 {
+		ErrorAndExit(fmt.Sprintf("Upgrade preflight failed: %d of %d checks did not pass.", failed, len(results)), nil)
+	}
+}
+
+func preflightServerVersion(c *cli.Context, adminClient adminservice.AdminServiceClient) preflightResult {
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.DescribeCluster(ctx, &adminservice.DescribeClusterRequest{})
+	if err != nil {
+		return preflightResult{Check: "Server version", Status: preflightFail, Details: fmt.Sprintf("unable to describe cluster: %v", err)}
+	}
+	return checkServerVersion(resp.GetServerVersion(), c.String(FlagTargetVersion))
+}
+
+func checkServerVersion(currentVersion string, targetVersion string) preflightResult {
+	result := preflightResult{Check: "Server version"}
+	current, err := semver.ParseTolerant(currentVersion)
+	if err != nil {
+		result.Status = preflightFail
+		result.Details = fmt.Sprintf("unable to parse running server version %q: %v", currentVersion, err)
+		return result
+	}
+	target, err := semver.ParseTolerant(targetVersion)
+	if err != nil {
+		result.Status = preflightFail
+		result.Details = fmt.Sprintf("unable to parse target server version %q: %v", targetVersion, err)
+		return result
+	}
+
+	switch {
+	case target.LT(current):
+		result.Status = preflightFail
+		result.Details = fmt.Sprintf("target version %s is older than running version %s", target, current)
+	case target.Major != current.Major:
+		result.Status = preflightFail
+		result.Details = fmt.Sprintf("upgrade from %s to %s changes major version", current, target)
+	case target.Minor > current.Minor+1:
+		result.Status = preflightFail
+		result.Details = fmt.Sprintf("upgrade from %s to %s skips minor versions, upgrade one minor version at a time", current, target)
+	default:
+		result.Status = preflightPass
+		result.Details = fmt.Sprintf("%s -> %s", current, target)
+	}
+	return result
+}
+
+func preflightSchemaVersion(serviceConfig *config.Config) preflightResult {
+	result := preflightResult{Check: "Schema version"}
+	if serviceConfig == nil {
+		result.Status = preflightSkip
+		result.Details = fmt.Sprintf("%s is not provided", FlagServiceConfigDir)
+		return result
+	}
+
+	checkVisibility := serviceConfig.Persistence.VisibilityStore != ""
+	if err := cassandra.VerifyCompatibleVersion(serviceConfig.Persistence, resolver.NewNoopResolver(), checkVisibility); err != nil {
+		result.Status = preflightFail
+		result.Details = fmt.Sprintf("cassandra schema must be upgraded first: %v", err)
+		return result
+	}
+	if err := sql.VerifyCompatibleVersion(serviceConfig.Persistence, resolver.NewNoopResolver(), checkVisibility); err != nil {
+		result.Status = preflightFail
+		result.Details = fmt.Sprintf("sql schema must be upgraded first: %v", err)
+		return result
+	}
+	result.Status = preflightPass
+	result.Details = "schema versions are compatible with the target version"
+	return result
+}
+
+func preflightDynamicConfig(serviceConfig *config.Config) preflightResult {
+	result := preflightResult{Check: "Dynamic config"}
+	if serviceConfig == nil {
+		result.Status = preflightSkip
+		result.Details = fmt.Sprintf("%s is not provided", FlagServiceConfigDir)
+		return result
+	}
+	if serviceConfig.DynamicConfigClient.Filepath == "" {
+		result.Status = preflightSkip
+		result.Details = "dynamic config file is not configured"
+		return result
+	}
+
+	if err := dynamicconfig.ValidateFile(serviceConfig.DynamicConfigClient.Filepath); err != nil {
+		result.Status = preflightFail
+		result.Details = fmt.Sprintf("dynamic config file is rejected by the target version: %v", err)
+		return result
+	}
+	result.Status = preflightPass
+	result.Details = "all keys and values are supported by the target version"
+	return result
+}
+
+func preflightSearchAttributes(c *cli.Context, adminClient adminservice.AdminServiceClient) preflightResult {
+	resp, err := getSearchAttributes(c, adminClient)
+	if err != nil {
+		return preflightResult{Check: "Search attributes", Status: preflightFail, Details: fmt.Sprintf("unable to get search attributes: %v", err)}
+	}
+	return checkSearchAttributes(resp)
+}
+
+func checkSearchAttributes(resp *adminservice.GetSearchAttributesResponse) preflightResult {
+	result := preflightResult{Check: "Search attributes"}
+	if len(resp.GetMapping()) == 0 {
+		result.Status = preflightSkip
+		result.Details = "Elasticsearch is not configured"
+		return result
+	}
+
+	// System search attributes are taken from the target version, custom ones from the running cluster.
+	expected := searchattribute.NameTypeMap{}.System()
+	for saName, saType := range resp.GetCustomAttributes() {
+		expected[saName] = saType
+	}
+	names := make([]string, 0, len(expected))
+	for saName := range expected {
+		names = append(names, saName)
+	}
+	sort.Strings(names)
+
+	var problems []string
+	for _, saName := range names {
+		saType := expected[saName]
+		esType, ok := resp.GetMapping()[saName]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("%s is missing in index mapping", saName))
+			continue
+		}
+		if !isCompatibleESType(saType, esType) {
+			problems = append(problems, fmt.Sprintf("%s has type %s in index mapping, expected %s", saName, esType, saType))
+		}
+	}
+	if len(problems) > 0 {
+		result.Status = preflightFail
+		result.Details = strings.Join(problems, "; ")
+		return result
+	}
+	result.Status = preflightPass
+	result.Details = fmt.Sprintf("%d search attributes match index mapping", len(names))
+	return result
+}
+
+func isCompatibleESType(saType enumspb.IndexedValueType, esType string) bool {
+	for _, t := range esTypes[saType] {
+		if t == esType {
+			return true
+		}
+	}
+	return false
+}
+
+func preflightReplication(c *cli.Context, adminClient adminservice.AdminServiceClient, maxLag time.Duration, maxDLQDepth int64) []preflightResult {
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.GetReplicationLag(ctx, &adminservice.GetReplicationLagRequest{})
+	if err != nil {
+		details := fmt.Sprintf("unable to get replication lag: %v", err)
+		return []preflightResult{
+			{Check: "DLQ depth", Status: preflightFail, Details: details},
+			{Check: "Replication lag", Status: preflightFail, Details: details},
+		}
+	}
+	return checkReplication(resp, maxLag, maxDLQDepth)
+}
+
+func checkReplication(resp *adminservice.GetReplicationLagResponse, maxLag time.Duration, maxDLQDepth int64) []preflightResult {
+	dlqResult := preflightResult{Check: "DLQ depth"}
+	lagResult := preflightResult{Check: "Replication lag"}
+
+	var dlqDepth int64
+	var lag time.Duration
+	var unknownLag int
+	remoteClusters := make(map[string]struct{})
+	for _, shard := range resp.GetShards() {
+		for clusterName, clusterLag := range shard.GetRemoteClusters() {
+			remoteClusters[clusterName] = struct{}{}
+			dlqDepth += clusterLag.GetDlqDepth()
+			if clusterLag.GetReplicationLag() == nil {
+				unknownLag++
+				continue
+			}
+			if shardLag := timestamp.DurationValue(clusterLag.GetReplicationLag()); shardLag > lag {
+				lag = shardLag
+			}
+		}
+	}
+
+	if len(remoteClusters) == 0 {
+		dlqResult.Status = preflightSkip
+		dlqResult.Details = "no remote clusters"
+		lagResult.Status = preflightSkip
+		lagResult.Details = "no remote clusters"
+		return []preflightResult{dlqResult, lagResult}
+	}
+
+	dlqResult.Details = fmt.Sprintf("%d replication tasks in DLQ (max %d)", dlqDepth, maxDLQDepth)
+	if dlqDepth > maxDLQDepth {
+		dlqResult.Status = preflightFail
+	} else {
+		dlqResult.Status = preflightPass
+	}
+
+	lagResult.Details = fmt.Sprintf("max lag %v (max %v)", lag, maxLag)
+	switch {
+	case lag > maxLag:
+		lagResult.Status = preflightFail
+	case unknownLag > 0:
+		lagResult.Status = preflightWarn
+		lagResult.Details += fmt.Sprintf(", lag is unknown for %d shards", unknownLag)
+	default:
+		lagResult.Status = preflightPass
+	}
+	return []preflightResult{dlqResult, lagResult}
+}
+
+func printPreflightResults(results []preflightResult) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(true)
+	table.SetColumnSeparator("|")
+	table.SetAutoWrapText(false)
+	header := []string{"Check", "Status", "Details"}
+	headerColor := []tablewriter.Colors{tableHeaderBlue, tableHeaderBlue, tableHeaderBlue}
+	table.SetHeader(header)
+	table.SetHeaderColor(headerColor...)
+	for _, result := range results {
+		status := result.Status
+		switch status {
+		case preflightPass:
+			status = colorGreen(status)
+		case preflightFail:
+			status = colorRed(status)
+		case preflightWarn:
+			status = colorMagenta(status)
+		}
+		table.Append([]string{result.Check, status, result.Details})
+	}
+	table.Render()
+}
@@ -0,0 +1,127 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+func TestCheckServerVersion(t *testing.T) {
+	tests := []struct {
+		current  string
+		target   string
+		expected string
+	}{
+		{"1.11.2", "1.12.0", preflightPass},
+		{"1.12.0", "1.12.1", preflightPass},
+		{"1.12.0", "1.12.0", preflightPass},
+		{"1.10.5", "1.12.0", preflightFail},
+		{"1.12.0", "1.11.4", preflightFail},
+		{"1.12.0", "2.0.0", preflightFail},
+		{"unknown", "1.12.0", preflightFail},
+	}
+	for _, tt := range tests {
+		result := checkServerVersion(tt.current, tt.target)
+		assert.Equal(t, tt.expected, result.Status, "%s -> %s: %s", tt.current, tt.target, result.Details)
+	}
+}
+
+func TestCheckSearchAttributes(t *testing.T) {
+	mapping := make(map[string]string)
+	for saName, saType := range (searchattribute.NameTypeMap{}).System() {
+		mapping[saName] = esTypes[saType][0]
+	}
+	mapping["CustomDatetimeField"] = "date"
+
+	result := checkSearchAttributes(&adminservice.GetSearchAttributesResponse{
+		CustomAttributes: map[string]enumspb.IndexedValueType{
+			"CustomDatetimeField": enumspb.INDEXED_VALUE_TYPE_DATETIME,
+		},
+		Mapping: mapping,
+	})
+	assert.Equal(t, preflightPass, result.Status, result.Details)
+
+	delete(mapping, searchattribute.BuildIds)
+	mapping["CustomDatetimeField"] = "keyword"
+	result = checkSearchAttributes(&adminservice.GetSearchAttributesResponse{
+		CustomAttributes: map[string]enumspb.IndexedValueType{
+			"CustomDatetimeField": enumspb.INDEXED_VALUE_TYPE_DATETIME,
+		},
+		Mapping: mapping,
+	})
+	assert.Equal(t, preflightFail, result.Status)
+	assert.Contains(t, result.Details, "BuildIds is missing")
+	assert.Contains(t, result.Details, "CustomDatetimeField has type keyword")
+
+	result = checkSearchAttributes(&adminservice.GetSearchAttributesResponse{})
+	assert.Equal(t, preflightSkip, result.Status)
+}
+
+func TestCheckReplication(t *testing.T) {
+	results := checkReplication(&adminservice.GetReplicationLagResponse{}, time.Minute, 0)
+	assert.Equal(t, preflightSkip, results[0].Status)
+	assert.Equal(t, preflightSkip, results[1].Status)
+
+	resp := &adminservice.GetReplicationLagResponse{
+		Shards: []*adminservice.ShardReplicationLag{
+			{
+				ShardId: 1,
+				RemoteClusters: map[string]*adminservice.ClusterReplicationLag{
+					"standby": {ReplicationLag: timestamp.DurationPtr(10 * time.Second)},
+				},
+			},
+			{
+				ShardId: 2,
+				RemoteClusters: map[string]*adminservice.ClusterReplicationLag{
+					"standby": {ReplicationLag: timestamp.DurationPtr(30 * time.Second), DlqDepth: 3},
+				},
+			},
+		},
+	}
+	results = checkReplication(resp, time.Minute, 5)
+	assert.Equal(t, preflightPass, results[0].Status, results[0].Details)
+	assert.Equal(t, preflightPass, results[1].Status, results[1].Details)
+
+	results = checkReplication(resp, 20*time.Second, 0)
+	assert.Equal(t, preflightFail, results[0].Status, results[0].Details)
+	assert.Equal(t, preflightFail, results[1].Status, results[1].Details)
+
+	resp.Shards[0].RemoteClusters["standby"].ReplicationLag = nil
+	results = checkReplication(resp, time.Minute, 5)
+	assert.Equal(t, preflightWarn, results[1].Status, results[1].Details)
+}
+
+func TestPreflightWithoutServiceConfig(t *testing.T) {
+	assert.Equal(t, preflightSkip, preflightSchemaVersion(nil).Status)
+	assert.Equal(t, preflightSkip, preflightDynamicConfig(nil).Status)
+}
@@ -44,7 +44,7 @@ func AdminAddSearchAttributes(c *cli.Context) {
 	typeStrs := getRequiredStringSliceOption(c, FlagType)
 
 	if len(names) != len(typeStrs) {
-		ErrorAndExit("Number of names and types options should be the same.", nil)
+		InvalidArgumentAndExit("Number of names and types options should be the same.", nil)
 	}
 
 	adminClient := cFactory.AdminClient(c)
@@ -57,7 +57,7 @@ func AdminAddSearchAttributes(c *cli.Context) {
 	for i := 0; i < len(typeStrs); i++ {
 		typeInt, err := stringToEnum(typeStrs[i], enumspb.IndexedValueType_value)
 		if err != nil {
-			ErrorAndExit(fmt.Sprintf("Unable to parse search attribute type: %s", typeStrs[i]), err)
+			InvalidArgumentAndExit(fmt.Sprintf("Unable to parse search attribute type: %s", typeStrs[i]), err)
 		}
 		existingSearchAttributeType, searchAttributeExists := existingSearchAttributes.CustomAttributes[names[i]]
 		if !searchAttributeExists {
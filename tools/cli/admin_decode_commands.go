@@ -82,12 +82,12 @@ func AdminDecodeProto(c *cli.Context) {
 	}
 
 	if protoData == nil {
-		ErrorAndExit("No data flag is specified", nil)
+		InvalidArgumentAndExit("No data flag is specified", nil)
 	}
 
 	messageType := proto.MessageType(protoType)
 	if messageType == nil {
-		ErrorAndExit(fmt.Sprintf("Unable to find %s type", protoType), nil)
+		InvalidArgumentAndExit(fmt.Sprintf("Unable to find %s type", protoType), nil)
 		return
 	}
 	message := reflect.New(messageType.Elem()).Interface().(proto.Message)
@@ -116,7 +116,7 @@ func AdminDecodeBase64(c *cli.Context) {
 	}
 
 	if base64Data == "" {
-		ErrorAndExit("No data flag is specified", nil)
+		InvalidArgumentAndExit("No data flag is specified", nil)
 	}
 
 	data, err := base64.StdEncoding.DecodeString(base64Data)
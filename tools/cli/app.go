@@ -108,6 +108,18 @@ func NewCliApp() *cli.App {
 			Usage:  "data converter plugin executable name",
 			EnvVar: "TEMPORAL_CLI_PLUGIN_DATA_CONVERTER",
 		},
+		cli.StringFlag{
+			Name:   FlagContext,
+			Value:  "",
+			Usage:  "name of tctl context to use instead of the current one",
+			EnvVar: "TEMPORAL_CLI_CONTEXT",
+		},
+		cli.StringFlag{
+			Name:   FlagErrorFormat,
+			Value:  errorFormatText,
+			Usage:  "format of error output: text or json",
+			EnvVar: "TEMPORAL_CLI_ERROR_FORMAT",
+		},
 	}
 	app.Commands = []cli.Command{
 		{
@@ -162,6 +174,11 @@ func NewCliApp() *cli.App {
 					Usage:       "Run admin operation on history host",
 					Subcommands: newAdminHistoryHostCommands(),
 				},
+				{
+					Name:        "host",
+					Usage:       "Run admin operation on frontend, history or matching host",
+					Subcommands: newAdminHostCommands(),
+				},
 				{
 					Name:        "namespace",
 					Aliases:     []string{"d"},
@@ -191,6 +208,18 @@ func NewCliApp() *cli.App {
 					Usage:       "Run admin operation on cluster",
 					Subcommands: newAdminClusterCommands(),
 				},
+				{
+					Name:        "config",
+					Aliases:     []string{"cfg"},
+					Usage:       "Run admin operation on dynamic config stored in the database",
+					Subcommands: newAdminDynamicConfigCommands(),
+				},
+				{
+					Name:        "schedule",
+					Aliases:     []string{"sched"},
+					Usage:       "Run admin operation on schedules",
+					Subcommands: newAdminScheduleCommands(),
+				},
 				{
 					Name:        "dlq",
 					Aliases:     []string{"dlq"},
@@ -222,8 +251,33 @@ func NewCliApp() *cli.App {
 			Usage:       "Operate Custom Data Converter",
 			Subcommands: newDataConverterCommands(),
 		},
+		{
+			Name:        "config",
+			Usage:       "Manage tctl contexts",
+			Subcommands: newConfigCommands(),
+		},
+		{
+			Name:  "login",
+			Usage: "Log in to the OpenID Connect provider of the current context",
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:  FlagBrowser,
+					Usage: "use browser based authorization code flow instead of device flow",
+				},
+			},
+			Action: func(c *cli.Context) {
+				Login(c)
+			},
+		},
+		{
+			Name:  "logout",
+			Usage: "Remove cached token of the current context",
+			Action: func(c *cli.Context) {
+				Logout(c)
+			},
+		},
 	}
-	app.Before = loadPlugins
+	app.Before = before
 	app.After = stopPlugins
 
 	// set builder if not customized
@@ -233,6 +287,16 @@ func NewCliApp() *cli.App {
 	return app
 }
 
+func before(c *cli.Context) error {
+	if err := setErrorFormat(c.String(FlagErrorFormat)); err != nil {
+		InvalidArgumentAndExit("invalid error format", err)
+	}
+	if err := applyCLIContext(c); err != nil {
+		ErrorAndExit("unable to apply tctl context", err)
+	}
+	return loadPlugins(c)
+}
+
 func loadPlugins(c *cli.Context) error {
 	dcPlugin := c.String(FlagDataConverterPlugin)
 	if dcPlugin != "" {
@@ -25,6 +25,11 @@
 package cli
 
 import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -45,14 +50,25 @@ import (
 	"go.temporal.io/api/workflowservicemock/v1"
 	sdkclient "go.temporal.io/sdk/client"
 	sdkmocks "go.temporal.io/sdk/mocks"
+	"google.golang.org/grpc"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
 	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/api/adminservicemock/v1"
+	clusterspb "go.temporal.io/server/api/cluster/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	schedpb "go.temporal.io/server/api/schedule/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common/codec"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/service/worker/deletenamespace"
+	"go.temporal.io/server/service/worker/forcereplication"
+	"go.temporal.io/server/tools/cli/cliconfig"
+	"go.temporal.io/server/tools/cli/headersprovider"
 )
 
 type cliAppSuite struct {
@@ -159,7 +175,7 @@ func (s *cliAppSuite) TestNamespaceRegister_NamespaceExist() {
 func (s *cliAppSuite) TestNamespaceRegister_Failed() {
 	s.frontendClient.EXPECT().RegisterNamespace(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewInvalidArgument("faked error"))
 	errorCode := s.RunErrorExitCode([]string{"", "--ns", cliTestNamespace, "namespace", "register", "--global_namespace", "true"})
-	s.Equal(1, errorCode)
+	s.Equal(ExitCodeInvalidArgument, errorCode)
 }
 
 var describeNamespaceResponseServer = &workflowservice.DescribeNamespaceResponse{
@@ -199,13 +215,13 @@ func (s *cliAppSuite) TestNamespaceUpdate_NamespaceNotExist() {
 	s.frontendClient.EXPECT().DescribeNamespace(gomock.Any(), gomock.Any()).Return(resp, nil)
 	s.frontendClient.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound(""))
 	errorCode := s.RunErrorExitCode([]string{"", "--ns", cliTestNamespace, "namespace", "update"})
-	s.Equal(1, errorCode)
+	s.Equal(ExitCodeNotFound, errorCode)
 }
 
 func (s *cliAppSuite) TestNamespaceUpdate_ActiveClusterFlagNotSet_NamespaceNotExist() {
 	s.frontendClient.EXPECT().DescribeNamespace(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound(""))
 	errorCode := s.RunErrorExitCode([]string{"", "--ns", cliTestNamespace, "namespace", "update"})
-	s.Equal(1, errorCode)
+	s.Equal(ExitCodeNotFound, errorCode)
 }
 
 func (s *cliAppSuite) TestNamespaceUpdate_Failed() {
@@ -213,7 +229,7 @@ func (s *cliAppSuite) TestNamespaceUpdate_Failed() {
 	s.frontendClient.EXPECT().DescribeNamespace(gomock.Any(), gomock.Any()).Return(resp, nil)
 	s.frontendClient.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewInvalidArgument("faked error"))
 	errorCode := s.RunErrorExitCode([]string{"", "--ns", cliTestNamespace, "namespace", "update"})
-	s.Equal(1, errorCode)
+	s.Equal(ExitCodeInvalidArgument, errorCode)
 }
 
 func (s *cliAppSuite) TestNamespaceDescribe() {
@@ -234,14 +250,109 @@ func (s *cliAppSuite) TestNamespaceDescribe_NamespaceNotExist() {
 	resp := describeNamespaceResponseServer
 	s.frontendClient.EXPECT().DescribeNamespace(gomock.Any(), gomock.Any()).Return(resp, serviceerror.NewNotFound(""))
 	errorCode := s.RunErrorExitCode([]string{"", "--ns", cliTestNamespace, "namespace", "describe"})
-	s.Equal(1, errorCode)
+	s.Equal(ExitCodeNotFound, errorCode)
+}
+
+func (s *cliAppSuite) TestNamespaceDescribe_NamespaceNotExist_JSONOutput() {
+	defer func() { errorFormat = errorFormatText }()
+	resp := describeNamespaceResponseServer
+	s.frontendClient.EXPECT().DescribeNamespace(gomock.Any(), gomock.Any()).Return(resp, serviceerror.NewNotFound(""))
+	errorCode := s.RunErrorExitCode([]string{"", "--ns", cliTestNamespace, "--error_format", "json", "namespace", "describe"})
+	s.Equal(ExitCodeNotFound, errorCode)
+	s.Equal(errorFormatJSON, errorFormat)
 }
 
 func (s *cliAppSuite) TestNamespaceDescribe_Failed() {
 	resp := describeNamespaceResponseServer
 	s.frontendClient.EXPECT().DescribeNamespace(gomock.Any(), gomock.Any()).Return(resp, serviceerror.NewInvalidArgument("faked error"))
 	errorCode := s.RunErrorExitCode([]string{"", "--ns", cliTestNamespace, "namespace", "describe"})
-	s.Equal(1, errorCode)
+	s.Equal(ExitCodeInvalidArgument, errorCode)
+}
+
+func (s *cliAppSuite) TestNamespaceDescribe_InvalidOptions() {
+	resp := describeNamespaceResponseServer
+	s.frontendClient.EXPECT().DescribeNamespace(gomock.Any(), gomock.Any()).Return(resp, nil).AnyTimes()
+	errorCode := s.RunErrorExitCode([]string{"", "--ns", cliTestNamespace, "namespace", "describe", "--namespace_id", uuid.New()})
+	s.Equal(ExitCodeInvalidArgument, errorCode)
+}
+
+func (s *cliAppSuite) TestNamespaceExportImport() {
+	exportFile, err := ioutil.TempFile("", "namespace_export_*.json")
+	s.NoError(err)
+	defer os.Remove(exportFile.Name())
+	_ = exportFile.Close()
+
+	retention := 3 * time.Hour * 24
+	badBinaries := &namespacepb.BadBinaries{
+		Binaries: map[string]*namespacepb.BadBinaryInfo{
+			"checksum": {Reason: "broken", Operator: "tester"},
+		},
+	}
+	s.frontendClient.EXPECT().DescribeNamespace(gomock.Any(), &workflowservice.DescribeNamespaceRequest{
+		Namespace: cliTestNamespace,
+	}).Return(&workflowservice.DescribeNamespaceResponse{
+		NamespaceInfo: &namespacepb.NamespaceInfo{
+			Name:        cliTestNamespace,
+			Description: "a test namespace",
+			OwnerEmail:  "test@uber.com",
+			Data:        map[string]string{"key": "value"},
+		},
+		Config: &namespacepb.NamespaceConfig{
+			WorkflowExecutionRetentionTtl: &retention,
+			HistoryArchivalState:          enumspb.ARCHIVAL_STATE_ENABLED,
+			HistoryArchivalUri:            "file:///tmp/history",
+			BadBinaries:                   badBinaries,
+		},
+		IsGlobalNamespace: true,
+	}, nil)
+	s.serverAdminClient.EXPECT().GetSearchAttributes(gomock.Any(), gomock.Any()).Return(&adminservice.GetSearchAttributesResponse{
+		CustomAttributes: map[string]enumspb.IndexedValueType{
+			"CustomKeywordField": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+			"CustomIntField":     enumspb.INDEXED_VALUE_TYPE_INT,
+		},
+	}, nil)
+	err = s.app.Run([]string{"", "--ns", cliTestNamespace, "namespace", "export", "--of", exportFile.Name()})
+	s.Nil(err)
+
+	s.serverAdminClient.EXPECT().GetSearchAttributes(gomock.Any(), gomock.Any()).Return(&adminservice.GetSearchAttributesResponse{
+		CustomAttributes: map[string]enumspb.IndexedValueType{
+			"CustomKeywordField": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		},
+	}, nil)
+	s.serverAdminClient.EXPECT().AddSearchAttributes(gomock.Any(), &adminservice.AddSearchAttributesRequest{
+		SearchAttributes: map[string]enumspb.IndexedValueType{
+			"CustomIntField": enumspb.INDEXED_VALUE_TYPE_INT,
+		},
+	}).Return(&adminservice.AddSearchAttributesResponse{}, nil)
+	s.frontendClient.EXPECT().DescribeNamespace(gomock.Any(), &workflowservice.DescribeNamespaceRequest{
+		Namespace: "target-namespace",
+	}).Return(nil, serviceerror.NewNotFound(""))
+	s.frontendClient.EXPECT().RegisterNamespace(gomock.Any(), &workflowservice.RegisterNamespaceRequest{
+		Namespace:                        "target-namespace",
+		Description:                      "a test namespace",
+		OwnerEmail:                       "test@uber.com",
+		Data:                             map[string]string{"key": "value"},
+		WorkflowExecutionRetentionPeriod: &retention,
+		HistoryArchivalState:             enumspb.ARCHIVAL_STATE_ENABLED,
+		HistoryArchivalUri:               "file:///tmp/history",
+		IsGlobalNamespace:                true,
+	}).Return(&workflowservice.RegisterNamespaceResponse{}, nil)
+	s.frontendClient.EXPECT().UpdateNamespace(gomock.Any(), &workflowservice.UpdateNamespaceRequest{
+		Namespace: "target-namespace",
+		UpdateInfo: &namespacepb.UpdateNamespaceInfo{
+			Description: "a test namespace",
+			OwnerEmail:  "test@uber.com",
+			Data:        map[string]string{"key": "value"},
+		},
+		Config: &namespacepb.NamespaceConfig{
+			WorkflowExecutionRetentionTtl: &retention,
+			HistoryArchivalState:          enumspb.ARCHIVAL_STATE_ENABLED,
+			HistoryArchivalUri:            "file:///tmp/history",
+			BadBinaries:                   badBinaries,
+		},
+	}).Return(&workflowservice.UpdateNamespaceResponse{}, nil)
+	err = s.app.Run([]string{"", "--ns", "target-namespace", "namespace", "import", "--if", exportFile.Name()})
+	s.Nil(err)
 }
 
 var (
@@ -286,11 +397,21 @@ func (s *cliAppSuite) TestStartWorkflow() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestStartWorkflow_StartDelay() {
+	s.sdkClient.On("ExecuteWorkflow", mock.MatchedBy(func(ctx context.Context) bool {
+		headers, err := (&sdkHeadersProvider{}).GetHeaders(ctx)
+		return err == nil && headers["start-delay"] == "1h30m0s"
+	}), mock.Anything, mock.Anything, mock.Anything).Return(workflowRun(), nil).Once()
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "workflow", "start", "-tq", "testTaskQueue", "-wt", "testWorkflowType", "-et", "60", "-w", "wid", "--start_delay", "90m"})
+	s.Nil(err)
+	s.sdkClient.AssertExpectations(s.T())
+}
+
 func (s *cliAppSuite) TestStartWorkflow_Failed() {
 	s.sdkClient.On("ExecuteWorkflow", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(workflowRun(), serviceerror.NewInvalidArgument("faked error"))
 	// start with wid
 	errorCode := s.RunErrorExitCode([]string{"", "--ns", cliTestNamespace, "workflow", "start", "-tq", "testTaskQueue", "-wt", "testWorkflowType", "-et", "60", "-w", "wid"})
-	s.Equal(1, errorCode)
+	s.Equal(ExitCodeInvalidArgument, errorCode)
 }
 
 func (s *cliAppSuite) TestRunWorkflow() {
@@ -316,7 +437,7 @@ func (s *cliAppSuite) TestRunWorkflow_Failed() {
 
 	// start with wid
 	errorCode := s.RunErrorExitCode([]string{"", "--ns", cliTestNamespace, "workflow", "run", "-tq", "testTaskQueue", "-wt", "testWorkflowType", "-et", "60", "-w", "wid"})
-	s.Equal(1, errorCode)
+	s.Equal(ExitCodeInvalidArgument, errorCode)
 	s.sdkClient.AssertExpectations(s.T())
 }
 
@@ -331,7 +452,7 @@ func (s *cliAppSuite) TestTerminateWorkflow() {
 func (s *cliAppSuite) TestTerminateWorkflow_Failed() {
 	s.sdkClient.On("TerminateWorkflow", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(serviceerror.NewInvalidArgument("faked error")).Once()
 	errorCode := s.RunErrorExitCode([]string{"", "--ns", cliTestNamespace, "workflow", "terminate", "-w", "wid"})
-	s.Equal(1, errorCode)
+	s.Equal(ExitCodeInvalidArgument, errorCode)
 	s.sdkClient.AssertExpectations(s.T())
 }
 
@@ -346,7 +467,7 @@ func (s *cliAppSuite) TestCancelWorkflow_Failed() {
 	s.sdkClient.On("CancelWorkflow", mock.Anything, mock.Anything, mock.Anything).Return(serviceerror.NewInvalidArgument("faked error")).Once()
 	// s.frontendClient.EXPECT().RequestCancelWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewInvalidArgument("faked error"))
 	errorCode := s.RunErrorExitCode([]string{"", "--ns", cliTestNamespace, "workflow", "cancel", "-w", "wid"})
-	s.Equal(1, errorCode)
+	s.Equal(ExitCodeInvalidArgument, errorCode)
 	s.sdkClient.AssertExpectations(s.T())
 }
 
@@ -359,7 +480,7 @@ func (s *cliAppSuite) TestSignalWorkflow() {
 func (s *cliAppSuite) TestSignalWorkflow_Failed() {
 	s.frontendClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewInvalidArgument("faked error"))
 	errorCode := s.RunErrorExitCode([]string{"", "--ns", cliTestNamespace, "workflow", "signal", "-w", "wid", "-n", "signal-name"})
-	s.Equal(1, errorCode)
+	s.Equal(ExitCodeInvalidArgument, errorCode)
 }
 
 func (s *cliAppSuite) TestQueryWorkflow() {
@@ -386,7 +507,7 @@ func (s *cliAppSuite) TestQueryWorkflow_Failed() {
 	}
 	s.frontendClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).Return(resp, serviceerror.NewInvalidArgument("faked error"))
 	errorCode := s.RunErrorExitCode([]string{"", "--ns", cliTestNamespace, "workflow", "query", "-w", "wid", "-qt", "query-type-test"})
-	s.Equal(1, errorCode)
+	s.Equal(ExitCodeInvalidArgument, errorCode)
 }
 
 var (
@@ -542,7 +663,7 @@ func (s *cliAppSuite) TestAdminDescribeMutableState() {
 func (s *cliAppSuite) TestAdminDescribeWorkflow_Failed() {
 	s.serverAdminClient.EXPECT().DescribeMutableState(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewInvalidArgument("faked error"))
 	errorCode := s.RunErrorExitCode([]string{"", "--ns", cliTestNamespace, "admin", "wf", "describe", "-w", "test-wf-id"})
-	s.Equal(1, errorCode)
+	s.Equal(ExitCodeInvalidArgument, errorCode)
 }
 
 func (s *cliAppSuite) TestAdminAddSearchAttributes() {
@@ -582,6 +703,606 @@ func (s *cliAppSuite) TestAdminGetSearchAttributes() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestAdminGetReplicationLag() {
+	lag := 5 * time.Second
+	request := &adminservice.GetReplicationLagRequest{
+		RemoteClusters: []string{"standby"},
+	}
+	response := &adminservice.GetReplicationLagResponse{
+		Shards: []*adminservice.ShardReplicationLag{{
+			ShardId:              1,
+			MaxReplicationTaskId: 100,
+			RemoteClusters: map[string]*adminservice.ClusterReplicationLag{
+				"standby": {AckedTaskId: 90, ReplicationLag: &lag, DlqDepth: 2},
+			},
+		}},
+	}
+	s.serverAdminClient.EXPECT().GetReplicationLag(gomock.Any(), request).Return(response, nil).Times(2)
+
+	err := s.app.Run([]string{"", "admin", "cl", "replication-lag", "--cluster", "standby"})
+	s.Nil(err)
+	err = s.app.Run([]string{"", "admin", "cl", "rl", "--cluster", "standby", "--pjson"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminListThrottledCallers() {
+	now := time.Now().UTC()
+	response := &adminservice.ListThrottledCallersResponse{
+		Callers: []*clusterspb.ThrottledCaller{{
+			HostAddress:        "127.0.0.1:7233",
+			Service:            "frontend",
+			Limiter:            "namespace_rps",
+			Namespace:          cliTestNamespace,
+			Identity:           "worker",
+			Rate:               10,
+			ThrottledCount:     5,
+			FirstThrottledTime: &now,
+			LastThrottledTime:  &now,
+		}},
+	}
+	s.serverAdminClient.EXPECT().ListThrottledCallers(gomock.Any(), &adminservice.ListThrottledCallersRequest{}).Return(response, nil)
+	s.serverAdminClient.EXPECT().ListThrottledCallers(gomock.Any(), &adminservice.ListThrottledCallersRequest{
+		Namespace: cliTestNamespace,
+	}).Return(response, nil)
+
+	err := s.app.Run([]string{"", "admin", "cl", "throttled"})
+	s.Nil(err)
+	err = s.app.Run([]string{"", "admin", "cl", "th", "--namespace", cliTestNamespace, "--pjson"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminSetMaintenanceInfo() {
+	s.serverAdminClient.EXPECT().SetMaintenanceInfo(gomock.Any(), &adminservice.SetMaintenanceInfoRequest{
+		Message:  "database upgrade",
+		Severity: enumspb.SEVERITY_HIGH,
+	}).Return(&adminservice.SetMaintenanceInfoResponse{}, nil)
+	s.serverAdminClient.EXPECT().SetMaintenanceInfo(gomock.Any(), &adminservice.SetMaintenanceInfoRequest{}).
+		Return(&adminservice.SetMaintenanceInfoResponse{}, nil)
+
+	err := s.app.Run([]string{"", "admin", "cl", "maintenance", "--message", "database upgrade", "--severity", "high"})
+	s.Nil(err)
+	err = s.app.Run([]string{"", "admin", "cl", "mt", "--clear"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminDescribeTaskQueuePartitions() {
+	response := &adminservice.DescribeTaskQueuePartitionsResponse{
+		Partitions: []*taskqueuespb.TaskQueuePartitionStatus{{
+			Partition:     "test-taskqueue",
+			OwnerHostName: "matching-host:7235",
+			Pollers:       describeTaskQueueResponse.Pollers,
+			TaskQueueStatus: &taskqueuepb.TaskQueueStatus{
+				BacklogCountHint: 3,
+				ReadLevel:        10,
+				AckLevel:         7,
+			},
+			TaskReaderStatus: &taskqueuespb.TaskReaderStatus{
+				BufferedTaskCount: 3,
+				LastReadTime:      timestamp.TimePtr(time.Now().UTC()),
+			},
+			BacklogStatus: &taskqueuespb.TaskQueueBacklogStatus{
+				BacklogAge:       timestamp.DurationPtr(90 * time.Second),
+				TaskAddRate:      2.5,
+				TaskDispatchRate: 1.5,
+			},
+		}},
+	}
+	s.serverAdminClient.EXPECT().DescribeTaskQueuePartitions(gomock.Any(), &adminservice.DescribeTaskQueuePartitionsRequest{
+		Namespace:     cliTestNamespace,
+		TaskQueue:     "test-taskqueue",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+	}).Return(response, nil)
+
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "admin", "tq", "desc", "--tq", "test-taskqueue", "--tqt", "activity", "--partitions"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminDescribeTaskQueuePartitionTree() {
+	response := &adminservice.DescribeTaskQueuePartitionsResponse{
+		Partitions: []*taskqueuespb.TaskQueuePartitionStatus{
+			{
+				Partition:       "test-taskqueue",
+				OwnerHostName:   "matching-host-1:7235",
+				Pollers:         describeTaskQueueResponse.Pollers,
+				TaskQueueStatus: &taskqueuepb.TaskQueueStatus{BacklogCountHint: 3},
+				BacklogStatus:   &taskqueuespb.TaskQueueBacklogStatus{TaskAddRate: 2.5, TaskDispatchRate: 1.5},
+			},
+			{
+				Partition:       "/_sys/test-taskqueue/1",
+				OwnerHostName:   "matching-host-2:7235",
+				ParentPartition: "test-taskqueue",
+			},
+			{
+				Partition:       "/_sys/test-taskqueue/2",
+				OwnerHostName:   "matching-host-1:7235",
+				ParentPartition: "/_sys/test-taskqueue/1",
+			},
+		},
+	}
+	s.serverAdminClient.EXPECT().DescribeTaskQueuePartitions(gomock.Any(), &adminservice.DescribeTaskQueuePartitionsRequest{
+		Namespace:     cliTestNamespace,
+		TaskQueue:     "test-taskqueue",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+	}).Return(response, nil)
+
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "admin", "tq", "describe-partitions", "--tq", "test-taskqueue"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminUpdateBuildIDs() {
+	versioningData := &persistencespb.VersioningData{
+		VersionSets: []*persistencespb.CompatibleVersionSet{{BuildIds: []string{"1.0", "1.1"}}, {BuildIds: []string{"2.0"}}},
+	}
+	s.serverAdminClient.EXPECT().UpdateWorkerBuildIdCompatibility(gomock.Any(), &adminservice.UpdateWorkerBuildIdCompatibilityRequest{
+		Namespace: cliTestNamespace,
+		TaskQueue: "test-taskqueue",
+		Update: &taskqueuespb.BuildIdCompatibilityUpdate{
+			Operation: &taskqueuespb.BuildIdCompatibilityUpdate_AddNewBuildIdInNewDefaultSet{AddNewBuildIdInNewDefaultSet: "2.0"},
+		},
+	}).Return(&adminservice.UpdateWorkerBuildIdCompatibilityResponse{VersioningData: versioningData}, nil)
+	s.serverAdminClient.EXPECT().UpdateWorkerBuildIdCompatibility(gomock.Any(), &adminservice.UpdateWorkerBuildIdCompatibilityRequest{
+		Namespace: cliTestNamespace,
+		TaskQueue: "test-taskqueue",
+		Update: &taskqueuespb.BuildIdCompatibilityUpdate{
+			Operation: &taskqueuespb.BuildIdCompatibilityUpdate_AddNewCompatibleBuildId_{
+				AddNewCompatibleBuildId: &taskqueuespb.BuildIdCompatibilityUpdate_AddNewCompatibleBuildId{
+					NewBuildId:                "1.1",
+					ExistingCompatibleBuildId: "1.0",
+					MakeSetDefault:            true,
+				},
+			},
+		},
+	}).Return(&adminservice.UpdateWorkerBuildIdCompatibilityResponse{VersioningData: versioningData}, nil)
+
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "admin", "tq", "update-build-ids", "add-new-default", "--tq", "test-taskqueue", "--build_id", "2.0"})
+	s.Nil(err)
+	err = s.app.Run([]string{"", "--ns", cliTestNamespace, "admin", "tq", "update-build-ids", "add-compatible", "--tq", "test-taskqueue",
+		"--build_id", "1.1", "--existing_compatible_build_id", "1.0", "--set_default"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminGetBuildIDs() {
+	s.serverAdminClient.EXPECT().GetWorkerBuildIdCompatibility(gomock.Any(), &adminservice.GetWorkerBuildIdCompatibilityRequest{
+		Namespace: cliTestNamespace,
+		TaskQueue: "test-taskqueue",
+		MaxSets:   2,
+	}).Return(&adminservice.GetWorkerBuildIdCompatibilityResponse{
+		VersioningData: &persistencespb.VersioningData{
+			VersionSets: []*persistencespb.CompatibleVersionSet{{BuildIds: []string{"1.0", "1.1"}}, {BuildIds: []string{"2.0"}}},
+		},
+	}, nil)
+
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "admin", "tq", "get-build-ids", "--tq", "test-taskqueue", "--max_sets", "2"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminShutdownWorker() {
+	s.serverAdminClient.EXPECT().ShutdownWorker(gomock.Any(), &adminservice.ShutdownWorkerRequest{
+		Namespace:       cliTestNamespace,
+		StickyTaskQueue: "test-sticky-taskqueue",
+		Identity:        "test-worker",
+		Reason:          "deploy",
+	}).Return(&adminservice.ShutdownWorkerResponse{}, nil)
+
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "admin", "tq", "shutdown-worker", "--tq", "test-sticky-taskqueue", "--identity", "test-worker", "--reason", "deploy"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminGetShardStats() {
+	now := time.Now().UTC()
+	response := &adminservice.GetShardStatsResponse{
+		Shards: []*clusterspb.ShardStats{
+			{ShardId: 1, OwnerHost: "host_a", TransferBacklog: 5, TimerAckLevel: &now, TimerMaxReadLevel: &now, LastUpdatedTime: &now},
+			{ShardId: 2, OwnerHost: "host_b", TransferBacklog: 10, LockContentionCount: 3},
+		},
+		UnreachableHosts: []string{"host_c"},
+		MaxVisibilityLag: timestamp.DurationPtr(time.Second),
+	}
+	s.serverAdminClient.EXPECT().GetShardStats(gomock.Any(), &adminservice.GetShardStatsRequest{}).Return(response, nil).Times(3)
+
+	err := s.app.Run([]string{"", "admin", "shard", "stats"})
+	s.Nil(err)
+	err = s.app.Run([]string{"", "admin", "shard", "st", "--sort_by", "transfer_backlog", "--top", "1", "--pjson"})
+	s.Nil(err)
+	err = s.app.Run([]string{"", "admin", "shard", "st", "--sort_by", "visibility_lag"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminDeepHealthCheck() {
+	latency := time.Millisecond
+	s.serverAdminClient.EXPECT().DeepHealthCheck(gomock.Any(), &adminservice.DeepHealthCheckRequest{}).Return(&adminservice.DeepHealthCheckResponse{
+		Healthy: true,
+		Components: []*adminservice.ComponentHealth{
+			{Name: "persistence", Healthy: true, Message: "read cluster metadata and wrote task queue", Latency: &latency},
+		},
+	}, nil)
+	s.serverAdminClient.EXPECT().DeepHealthCheck(gomock.Any(), &adminservice.DeepHealthCheckRequest{}).Return(&adminservice.DeepHealthCheckResponse{
+		Healthy: false,
+		Components: []*adminservice.ComponentHealth{
+			{Name: "matching", Healthy: false, Message: "1 of 1 hosts are unhealthy", Latency: &latency},
+		},
+	}, nil)
+
+	s.Equal(0, s.RunErrorExitCode([]string{"", "admin", "cluster", "health"}))
+	s.Equal(1, s.RunErrorExitCode([]string{"", "admin", "cluster", "hc", "--pjson"}))
+}
+
+func (s *cliAppSuite) TestAdminUpdateClusterMetadata() {
+	s.serverAdminClient.EXPECT().UpdateClusterMetadata(gomock.Any(), &adminservice.UpdateClusterMetadataRequest{
+		Version: 3,
+		UpsertClusters: map[string]*persistencespb.ClusterInformation{
+			"standby": {Enabled: true, InitialFailoverVersion: 2, RpcAddress: "standby:7233"},
+		},
+		UpsertSearchAttributes: map[string]enumspb.IndexedValueType{
+			"CustomKeywordField": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		},
+	}).Return(&adminservice.UpdateClusterMetadataResponse{Version: 4}, nil)
+	s.Equal(0, s.RunErrorExitCode([]string{"", "admin", "cluster", "update-metadata", "--version", "3",
+		"--cluster", "standby", "--initial_failover_version", "2", "--rpc_address", "standby:7233",
+		"--name", "CustomKeywordField", "--type", "Keyword"}))
+
+	// Without version, the current version is read before the update.
+	s.serverAdminClient.EXPECT().DescribeClusterMetadata(gomock.Any(), &adminservice.DescribeClusterMetadataRequest{}).Return(&adminservice.DescribeClusterMetadataResponse{
+		Version: 4,
+	}, nil)
+	s.serverAdminClient.EXPECT().UpdateClusterMetadata(gomock.Any(), &adminservice.UpdateClusterMetadataRequest{
+		Version:        4,
+		RemoveClusters: []string{"standby"},
+	}).Return(nil, serviceerror.NewInvalidArgument("Cluster metadata was updated since version 4, current version is 5."))
+	s.Equal(ExitCodeInvalidArgument, s.RunErrorExitCode([]string{"", "admin", "cluster", "um", "--remove_cluster", "standby"}))
+}
+
+func (s *cliAppSuite) TestAdminGetHostProfile() {
+	outputFile, err := ioutil.TempFile("", "profile")
+	s.NoError(err)
+	defer func() { s.NoError(os.Remove(outputFile.Name())) }()
+	s.NoError(outputFile.Close())
+
+	duration := 5 * time.Second
+	s.serverAdminClient.EXPECT().GetHostProfile(gomock.Any(), &adminservice.GetHostProfileRequest{
+		HostAddress: "1.2.3.4:7234",
+		Profile:     "cpu",
+		Duration:    &duration,
+	}).Return(&adminservice.GetHostProfileResponse{
+		Profile:     []byte("profile"),
+		HostAddress: "1.2.3.4:7234",
+		Service:     "history",
+	}, nil)
+
+	err = s.app.Run([]string{"", "admin", "host", "profile", "--address", "1.2.3.4:7234", "--duration", "5", "--output_filename", outputFile.Name()})
+	s.Nil(err)
+	profile, err := ioutil.ReadFile(outputFile.Name())
+	s.NoError(err)
+	s.Equal([]byte("profile"), profile)
+}
+
+func (s *cliAppSuite) TestAdminDescribeVisibilityProcessor() {
+	now := time.Now().UTC()
+	response := &adminservice.DescribeVisibilityProcessorResponse{
+		Processors: []*clusterspb.VisibilityProcessorStatus{
+			{
+				HostAddress: "host_a",
+				BulkProcessors: []*clusterspb.BulkProcessorStats{
+					{Name: "visibility-processor", Queued: 3, Committed: 10, Succeeded: 100},
+					{Name: "visibility-backfill-processor", Failed: 1},
+				},
+				InFlightBulks:       1,
+				PendingAcks:         4,
+				LastCommitError:     "no available connection",
+				LastCommitErrorTime: &now,
+			},
+		},
+		UnreachableHosts: []string{"host_b"},
+	}
+	s.serverAdminClient.EXPECT().DescribeVisibilityProcessor(gomock.Any(), &adminservice.DescribeVisibilityProcessorRequest{}).Return(response, nil)
+	s.serverAdminClient.EXPECT().DescribeVisibilityProcessor(gomock.Any(), &adminservice.DescribeVisibilityProcessorRequest{
+		HostAddress: "host_a",
+		Flush:       true,
+	}).Return(response, nil)
+
+	err := s.app.Run([]string{"", "admin", "es", "processor"})
+	s.Nil(err)
+	err = s.app.Run([]string{"", "admin", "es", "proc", "--address", "host_a", "--flush", "--pjson"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminRebalanceShards() {
+	response := &adminservice.RebalanceShardsResponse{
+		Moves: []*adminservice.ShardMove{
+			{ShardId: 2, SourceHost: "host_a", TargetHost: "host_b", SourceHostShards: 3},
+		},
+	}
+	s.serverAdminClient.EXPECT().RebalanceShards(gomock.Any(), &adminservice.RebalanceShardsRequest{
+		DryRun: true,
+	}).Return(response, nil)
+	s.serverAdminClient.EXPECT().RebalanceShards(gomock.Any(), &adminservice.RebalanceShardsRequest{
+		MaxMoves: 1,
+	}).Return(response, nil)
+
+	err := s.app.Run([]string{"", "admin", "shard", "rebalance", "--dry_run"})
+	s.Nil(err)
+	err = s.app.Run([]string{"", "admin", "shard", "rb", "--max_moves", "1", "--pjson"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminSetDynamicConfig() {
+	defaultValue := &persistencespb.DynamicConfigValue{
+		Value:       "100",
+		Constraints: &persistencespb.DynamicConfigConstraints{},
+	}
+	s.serverAdminClient.EXPECT().GetDynamicConfig(gomock.Any(), &adminservice.GetDynamicConfigRequest{
+		Name: "frontend.rps",
+	}).Return(&adminservice.GetDynamicConfigResponse{
+		Values: &persistencespb.DynamicConfigValues{
+			Values: []*persistencespb.DynamicConfigValue{
+				defaultValue,
+				{Value: "10", Constraints: &persistencespb.DynamicConfigConstraints{Namespace: cliTestNamespace}},
+			},
+		},
+	}, nil)
+	s.serverAdminClient.EXPECT().SetDynamicConfig(gomock.Any(), &adminservice.SetDynamicConfigRequest{
+		Name: "frontend.rps",
+		Values: []*persistencespb.DynamicConfigValue{
+			defaultValue,
+			{Value: "20", Constraints: &persistencespb.DynamicConfigConstraints{Namespace: cliTestNamespace}},
+		},
+	}).Return(&adminservice.SetDynamicConfigResponse{}, nil)
+	err := s.app.Run([]string{"", "admin", "config", "set", "--name", "frontend.rps", "--value", "20", "--constraint_namespace", cliTestNamespace})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminSetDynamicConfig_Remove() {
+	s.serverAdminClient.EXPECT().GetDynamicConfig(gomock.Any(), &adminservice.GetDynamicConfigRequest{
+		Name: "frontend.rps",
+	}).Return(&adminservice.GetDynamicConfigResponse{
+		Values: &persistencespb.DynamicConfigValues{
+			Values: []*persistencespb.DynamicConfigValue{{Value: "100"}},
+		},
+	}, nil)
+	s.serverAdminClient.EXPECT().SetDynamicConfig(gomock.Any(), &adminservice.SetDynamicConfigRequest{
+		Name: "frontend.rps",
+	}).Return(&adminservice.SetDynamicConfigResponse{}, nil)
+	err := s.app.Run([]string{"", "admin", "cfg", "s", "--name", "frontend.rps", "--remove"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminListDynamicConfig() {
+	s.serverAdminClient.EXPECT().ListDynamicConfig(gomock.Any(), &adminservice.ListDynamicConfigRequest{}).Return(&adminservice.ListDynamicConfigResponse{
+		DynamicConfig: map[string]*persistencespb.DynamicConfigValues{
+			"frontend.rps": {
+				Values: []*persistencespb.DynamicConfigValue{{Value: "100"}},
+			},
+		},
+	}, nil).Times(2)
+	err := s.app.Run([]string{"", "admin", "config", "list"})
+	s.Nil(err)
+	err = s.app.Run([]string{"", "admin", "config", "list", "--pjson"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminValidateDynamicConfig() {
+	err := s.app.Run([]string{"", "admin", "config", "validate", "../../config/dynamicconfig/development.yaml"})
+	s.Nil(err)
+
+	file, err := ioutil.TempFile("", "dynamicconfig")
+	s.NoError(err)
+	defer func() { s.NoError(os.Remove(file.Name())) }()
+	_, err = file.WriteString(`
+frontend.rps:
+  - value: "1200"
+frontend.misspeledKey:
+  - value: true
+`)
+	s.NoError(err)
+	s.NoError(file.Close())
+	s.Equal(1, s.RunErrorExitCode([]string{"", "admin", "config", "v", file.Name()}))
+	s.Equal(1, s.RunErrorExitCode([]string{"", "admin", "config", "validate"}))
+}
+
+func (s *cliAppSuite) TestAdminCompactWorkflowHistory() {
+	s.serverAdminClient.EXPECT().CompactWorkflowHistory(gomock.Any(), &adminservice.CompactWorkflowHistoryRequest{
+		Namespace: cliTestNamespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: "wid",
+		},
+	}).Return(&adminservice.CompactWorkflowHistoryResponse{BatchCountBefore: 1000, BatchCountAfter: 10}, nil)
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "admin", "wf", "compact_history", "-w", "wid"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminExportImportWorkflow() {
+	exportFile, err := ioutil.TempFile("", "workflow_export_*.json")
+	s.NoError(err)
+	defer os.Remove(exportFile.Name())
+	_ = exportFile.Close()
+
+	execution := &commonpb.WorkflowExecution{WorkflowId: "wid", RunId: "rid"}
+	versionHistory := versionhistory.NewVersionHistory(
+		[]byte("branch-token"),
+		[]*historyspb.VersionHistoryItem{versionhistory.NewVersionHistoryItem(5, 1)},
+	)
+	mutableState := &persistencespb.WorkflowMutableState{
+		ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+			WorkflowId:       "wid",
+			VersionHistories: versionhistory.NewVersionHistories(versionHistory),
+		},
+		ExecutionState: &persistencespb.WorkflowExecutionState{RunId: "rid"},
+	}
+	batches := []*commonpb.DataBlob{
+		{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte("batch-1")},
+		{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte("batch-2")},
+		{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte("batch-3")},
+	}
+
+	s.serverAdminClient.EXPECT().DescribeMutableState(gomock.Any(), &adminservice.DescribeMutableStateRequest{
+		Namespace: cliTestNamespace,
+		Execution: &commonpb.WorkflowExecution{WorkflowId: "wid"},
+	}).Return(&adminservice.DescribeMutableStateResponse{DatabaseMutableState: mutableState}, nil)
+	s.serverAdminClient.EXPECT().GetWorkflowExecutionRawHistoryV2(gomock.Any(), &adminservice.GetWorkflowExecutionRawHistoryV2Request{
+		Namespace:       cliTestNamespace,
+		Execution:       execution,
+		EndEventId:      6,
+		EndEventVersion: 1,
+		MaximumPageSize: 2,
+	}).Return(&adminservice.GetWorkflowExecutionRawHistoryV2Response{
+		HistoryBatches: batches[:2],
+		NextPageToken:  []byte("token"),
+	}, nil)
+	s.serverAdminClient.EXPECT().GetWorkflowExecutionRawHistoryV2(gomock.Any(), &adminservice.GetWorkflowExecutionRawHistoryV2Request{
+		Namespace:       cliTestNamespace,
+		Execution:       execution,
+		EndEventId:      6,
+		EndEventVersion: 1,
+		MaximumPageSize: 2,
+		NextPageToken:   []byte("token"),
+	}).Return(&adminservice.GetWorkflowExecutionRawHistoryV2Response{
+		HistoryBatches: batches[2:],
+	}, nil)
+	err = s.app.Run([]string{"", "--ns", cliTestNamespace, "admin", "wf", "export", "-w", "wid", "--of", exportFile.Name(), "--ps", "2"})
+	s.Nil(err)
+
+	s.serverAdminClient.EXPECT().ImportWorkflowExecution(gomock.Any(), &adminservice.ImportWorkflowExecutionRequest{
+		Namespace:      "target-namespace",
+		Execution:      execution,
+		HistoryBatches: batches[:2],
+		MutableState:   mutableState,
+	}).Return(&adminservice.ImportWorkflowExecutionResponse{}, nil)
+	s.serverAdminClient.EXPECT().ImportWorkflowExecution(gomock.Any(), &adminservice.ImportWorkflowExecutionRequest{
+		Namespace:      "target-namespace",
+		Execution:      execution,
+		HistoryBatches: batches[2:],
+		MutableState:   mutableState,
+	}).Return(&adminservice.ImportWorkflowExecutionResponse{MutableState: mutableState}, nil)
+	errorCode := s.RunErrorExitCode([]string{"", "--ns", "target-namespace", "admin", "wf", "import", "--if", exportFile.Name(), "--bs", "2"})
+	s.Equal(0, errorCode)
+}
+
+func (s *cliAppSuite) TestAdminImportWorkflow_MutableStateNotImported() {
+	importFile, err := ioutil.TempFile("", "workflow_export_*.json")
+	s.NoError(err)
+	defer os.Remove(importFile.Name())
+
+	// the import fails if the history ends before the last event of the mutable state snapshot
+	data, err := codec.NewJSONPBEncoder().Encode(&adminservice.ImportWorkflowExecutionRequest{
+		Execution:      &commonpb.WorkflowExecution{WorkflowId: "wid", RunId: "rid"},
+		HistoryBatches: []*commonpb.DataBlob{{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte("batch-1")}},
+		MutableState:   &persistencespb.WorkflowMutableState{NextEventId: 10},
+	})
+	s.NoError(err)
+	_, err = importFile.Write(data)
+	s.NoError(err)
+	_ = importFile.Close()
+
+	s.serverAdminClient.EXPECT().ImportWorkflowExecution(gomock.Any(), gomock.Any()).Return(&adminservice.ImportWorkflowExecutionResponse{}, nil)
+	errorCode := s.RunErrorExitCode([]string{"", "--ns", "target-namespace", "admin", "wf", "import", "--if", importFile.Name()})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestAdminListStaleWorkflows() {
+	outputFile, err := ioutil.TempFile("", "stale_workflows_*.csv")
+	s.NoError(err)
+	defer os.Remove(outputFile.Name())
+	_ = outputFile.Close()
+
+	olderThan := 30 * 24 * time.Hour
+	startTime := time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)
+	s.serverAdminClient.EXPECT().ListStaleWorkflowExecutions(gomock.Any(), &adminservice.ListStaleWorkflowExecutionsRequest{
+		Namespace:       cliTestNamespace,
+		OlderThan:       &olderThan,
+		MaximumPageSize: 100,
+	}).Return(&adminservice.ListStaleWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{
+			{
+				Execution: &commonpb.WorkflowExecution{WorkflowId: "wid, with comma", RunId: "rid"},
+				Type:      &commonpb.WorkflowType{Name: "wtype"},
+				StartTime: &startTime,
+				TaskQueue: "tq",
+			},
+		},
+		NextPageToken: []byte("token"),
+	}, nil)
+	s.serverAdminClient.EXPECT().ListStaleWorkflowExecutions(gomock.Any(), &adminservice.ListStaleWorkflowExecutionsRequest{
+		Namespace:       cliTestNamespace,
+		OlderThan:       &olderThan,
+		MaximumPageSize: 100,
+		NextPageToken:   []byte("token"),
+	}).Return(&adminservice.ListStaleWorkflowExecutionsResponse{}, nil)
+
+	err = s.app.Run([]string{"", "--ns", cliTestNamespace, "admin", "wf", "list_stale", "--older_than", "30", "--of", outputFile.Name()})
+	s.Nil(err)
+
+	content, err := ioutil.ReadFile(outputFile.Name())
+	s.NoError(err)
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	s.Len(lines, 2)
+	s.Equal("Namespace,WorkflowId,RunId,WorkflowType,TaskQueue,StartTime,Age", lines[0])
+	s.True(strings.HasPrefix(lines[1], cliTestNamespace+`,"wid, with comma",rid,wtype,tq,2021-01-02T03:04:05Z,`))
+}
+
+func (s *cliAppSuite) TestAdminListStaleWorkflows_AllNamespaces() {
+	olderThan := 2 * time.Hour
+	s.frontendClient.EXPECT().ListNamespaces(gomock.Any(), gomock.Any()).Return(&workflowservice.ListNamespacesResponse{
+		Namespaces: []*workflowservice.DescribeNamespaceResponse{
+			{NamespaceInfo: &namespacepb.NamespaceInfo{Name: "ns1"}},
+			{NamespaceInfo: &namespacepb.NamespaceInfo{Name: "ns2"}},
+		},
+	}, nil)
+	for _, ns := range []string{"ns1", "ns2"} {
+		s.serverAdminClient.EXPECT().ListStaleWorkflowExecutions(gomock.Any(), &adminservice.ListStaleWorkflowExecutionsRequest{
+			Namespace:       ns,
+			OlderThan:       &olderThan,
+			MaximumPageSize: 10,
+		}).Return(&adminservice.ListStaleWorkflowExecutionsResponse{}, nil)
+	}
+
+	err := s.app.Run([]string{"", "admin", "wf", "list_stale", "--older_than", "2h", "--all", "--ps", "10"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminStartForceReplication() {
+	s.sdkClient.On("ExecuteWorkflow", mock.Anything, mock.Anything, forcereplication.WorkflowName, mock.Anything).Return(workflowRun(), nil).Run(func(args mock.Arguments) {
+		options := args.Get(1).(sdkclient.StartWorkflowOptions)
+		s.Equal(forcereplication.TaskQueueName, options.TaskQueue)
+		s.Equal(forcereplication.WorkflowName+"-"+cliTestNamespace, options.ID)
+		params := args.Get(3).(forcereplication.WorkflowParams)
+		s.Equal(cliTestNamespace, params.Namespace)
+		s.Equal(36*time.Hour, params.ClosedWorkflowWindow)
+		s.Equal(5, params.RPS)
+	}).Once()
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "admin", "cl", "force-replicate", "--closed_window", "36h", "--rps", "5"})
+	s.Nil(err)
+	s.sdkClient.AssertExpectations(s.T())
+}
+
+func (s *cliAppSuite) TestAdminDeleteNamespace() {
+	s.serverAdminClient.EXPECT().DeleteNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *adminservice.DeleteNamespaceRequest, _ ...interface{}) (*adminservice.DeleteNamespaceResponse, error) {
+			s.Equal(cliTestNamespace, request.GetNamespace())
+			return &adminservice.DeleteNamespaceResponse{
+				NamespaceId: "namespace-id",
+				WorkflowId:  deletenamespace.WorkflowID(cliTestNamespace),
+				RunId:       "run-id",
+			}, nil
+		})
+	err := s.app.Run([]string{"", "--auto_confirm", "--ns", cliTestNamespace, "admin", "namespace", "delete"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminUpdateNamespaceQuotas() {
+	s.serverAdminClient.EXPECT().DescribeNamespaceQuotas(gomock.Any(), gomock.Any()).Return(&adminservice.DescribeNamespaceQuotasResponse{
+		Quotas: &persistencespb.NamespaceQuotas{MaxStartWorkflowRps: 10, MaxPendingWorkflows: 100},
+	}, nil)
+	s.serverAdminClient.EXPECT().UpdateNamespaceQuotas(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *adminservice.UpdateNamespaceQuotasRequest, _ ...interface{}) (*adminservice.UpdateNamespaceQuotasResponse, error) {
+			s.Equal(cliTestNamespace, request.GetNamespace())
+			// quotas which are not set by flags are unchanged
+			s.Equal(&persistencespb.NamespaceQuotas{MaxStartWorkflowRps: 10, MaxPendingWorkflows: 0, MaxActionsPerSecond: 50}, request.GetQuotas())
+			return &adminservice.UpdateNamespaceQuotasResponse{Quotas: request.GetQuotas()}, nil
+		})
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "admin", "namespace", "update-quotas", "--max_pending_workflows", "0", "--max_actions_per_second", "50"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestDescribeTaskQueue() {
 	s.sdkClient.On("DescribeTaskQueue", mock.Anything, mock.Anything, mock.Anything).Return(describeTaskQueueResponse, nil).Once()
 	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "taskqueue", "describe", "-tq", "test-taskQueue"})
@@ -734,6 +1455,80 @@ func (s *cliAppSuite) TestGetSearchAttributes() {
 	s.sdkClient.AssertExpectations(s.T())
 }
 
+func (s *cliAppSuite) TestAdminUpgradePreflight() {
+	s.serverAdminClient.EXPECT().DescribeCluster(gomock.Any(), gomock.Any()).Return(&adminservice.DescribeClusterResponse{ServerVersion: "1.11.3"}, nil)
+	s.serverAdminClient.EXPECT().GetSearchAttributes(gomock.Any(), gomock.Any()).Return(&adminservice.GetSearchAttributesResponse{}, nil)
+	s.serverAdminClient.EXPECT().GetReplicationLag(gomock.Any(), gomock.Any()).Return(&adminservice.GetReplicationLagResponse{}, nil)
+	errorCode := s.RunErrorExitCode([]string{"", "admin", "cluster", "upgrade-preflight", "--target_version", "1.12.0"})
+	s.Equal(0, errorCode)
+
+	s.serverAdminClient.EXPECT().DescribeCluster(gomock.Any(), gomock.Any()).Return(&adminservice.DescribeClusterResponse{ServerVersion: "1.12.0"}, nil)
+	s.serverAdminClient.EXPECT().GetSearchAttributes(gomock.Any(), gomock.Any()).Return(&adminservice.GetSearchAttributesResponse{}, nil)
+	s.serverAdminClient.EXPECT().GetReplicationLag(gomock.Any(), gomock.Any()).Return(&adminservice.GetReplicationLagResponse{
+		Shards: []*adminservice.ShardReplicationLag{{
+			ShardId:        1,
+			RemoteClusters: map[string]*adminservice.ClusterReplicationLag{"standby": {DlqDepth: 1}},
+		}},
+	}, nil)
+	errorCode = s.RunErrorExitCode([]string{"", "admin", "cluster", "upgrade-preflight", "--target_version", "1.12.0"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestConfigContexts() {
+	dir, err := ioutil.TempDir("", "tctl-config")
+	s.NoError(err)
+	defer func() { s.NoError(os.RemoveAll(dir)) }()
+	configPath := filepath.Join(dir, "tctl.yaml")
+	s.NoError(os.Setenv(cliconfig.EnvConfigFile, configPath))
+	defer func() { s.NoError(os.Unsetenv(cliconfig.EnvConfigFile)) }()
+	defer headersprovider.SetCurrent(nil)
+
+	err = s.app.Run([]string{"", "config", "set-context", "prod", "--address", "prod:7233", "--namespace", cliTestNamespace,
+		"--tls_ca_path", "/etc/ca.pem", "--oidc_issuer_url", "https://issuer", "--oidc_client_id", "tctl"})
+	s.NoError(err)
+	err = s.app.Run([]string{"", "config", "set-context", "dev", "--address", "localhost:7233"})
+	s.NoError(err)
+	err = s.app.Run([]string{"", "config", "set-context", "prod", "--address", "prod2:7233", "--header", "x-team=payments", "--header", "X-Env=prod"})
+	s.NoError(err)
+	err = s.app.Run([]string{"", "config", "set-context", "prod", "--header", "x-env="})
+	s.NoError(err)
+
+	cfg, err := cliconfig.Load(configPath)
+	s.NoError(err)
+	s.Equal("prod", cfg.CurrentContext)
+	s.Equal(&cliconfig.Context{
+		Address:   "prod2:7233",
+		Namespace: cliTestNamespace,
+		TLS:       &cliconfig.TLS{CaPath: "/etc/ca.pem"},
+		Headers:   map[string]string{"x-team": "payments"},
+		OIDC:      &cliconfig.OIDC{IssuerURL: "https://issuer", ClientID: "tctl"},
+	}, cfg.Contexts["prod"])
+
+	s.sdkClient.On("GetSearchAttributes", mock.Anything).Return(&workflowservice.GetSearchAttributesResponse{}, nil).Once()
+	err = s.app.Run([]string{"", "cluster", "get-search-attributes"})
+	s.NoError(err)
+	s.NotNil(headersprovider.GetCurrent())
+	s.sdkClient.AssertExpectations(s.T())
+
+	err = s.app.Run([]string{"", "config", "set-context", "dev", "--header", "x-team=payments"})
+	s.NoError(err)
+	s.sdkClient.On("GetSearchAttributes", mock.Anything).Return(&workflowservice.GetSearchAttributesResponse{}, nil).Once()
+	err = s.app.Run([]string{"", "--context", "dev", "cluster", "get-search-attributes"})
+	s.NoError(err)
+	headers, err := headersprovider.GetCurrent().GetHeaders(context.Background())
+	s.NoError(err)
+	s.Equal(map[string]string{"x-team": "payments"}, headers)
+
+	err = s.app.Run([]string{"", "config", "use-context", "dev"})
+	s.NoError(err)
+	err = s.app.Run([]string{"", "config", "delete-context", "prod"})
+	s.NoError(err)
+	cfg, err = cliconfig.Load(configPath)
+	s.NoError(err)
+	s.Equal("dev", cfg.CurrentContext)
+	s.Equal([]string{"dev"}, cfg.ContextNames())
+}
+
 func historyEventIterator() sdkclient.HistoryEventIterator {
 	iteratorMock := &sdkmocks.HistoryEventIterator{}
 
@@ -779,3 +1574,149 @@ func workflowRun() sdkclient.WorkflowRun {
 
 	return workflowRunMock
 }
+
+func (s *cliAppSuite) TestAdminCreateSchedule() {
+	s.serverAdminClient.EXPECT().CreateSchedule(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *adminservice.CreateScheduleRequest, _ ...grpc.CallOption) (*adminservice.CreateScheduleResponse, error) {
+			s.Equal(cliTestNamespace, request.GetNamespace())
+			s.Equal("test-schedule", request.GetScheduleId())
+			schedule := request.GetSchedule()
+			s.Equal([]*schedpb.CalendarSpec{
+				{Second: "0", Minute: "30", Hour: "9", DayOfMonth: "*", Month: "*", DayOfWeek: "1-5"},
+				{Second: "15", Minute: "0", Hour: "12", DayOfMonth: "1", Month: "*", DayOfWeek: "*"},
+			}, schedule.GetSpec().GetCalendar())
+			s.Equal([]*schedpb.IntervalSpec{
+				{Interval: timestamp.DurationPtr(time.Hour), Phase: timestamp.DurationPtr(15 * time.Minute)},
+			}, schedule.GetSpec().GetInterval())
+			s.Equal(5*time.Minute, timestamp.DurationValue(schedule.GetSpec().GetJitter()))
+			s.Equal(enumsspb.SCHEDULE_OVERLAP_POLICY_BUFFER_ONE, schedule.GetPolicies().GetOverlapPolicy())
+			s.True(schedule.GetState().GetLimitedActions())
+			s.Equal(int64(10), schedule.GetState().GetRemainingActions())
+			s.Equal("test-workflow", schedule.GetAction().GetWorkflowType().GetName())
+			s.Equal("test-taskqueue", schedule.GetAction().GetTaskQueue().GetName())
+			return &adminservice.CreateScheduleResponse{}, nil
+		})
+
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "admin", "schedule", "create", "--sid", "test-schedule",
+		"--calendar", "30 9 * * 1-5", "--calendar", "15 0 12 1 * *", "--interval", "1h/15m", "--jitter", "5m",
+		"--overlap_policy", "buffer_one", "--remaining_actions", "10", "--wt", "test-workflow", "--tq", "test-taskqueue"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminBackfillSchedule() {
+	s.serverAdminClient.EXPECT().PatchSchedule(gomock.Any(), &adminservice.PatchScheduleRequest{
+		Namespace:  cliTestNamespace,
+		ScheduleId: "test-schedule",
+		Patch: &schedpb.SchedulePatch{
+			BackfillRequest: []*schedpb.BackfillRequest{{
+				StartTime:     timestamp.TimePtr(time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC)),
+				EndTime:       timestamp.TimePtr(time.Date(2021, 7, 2, 0, 0, 0, 0, time.UTC)),
+				OverlapPolicy: enumsspb.SCHEDULE_OVERLAP_POLICY_ALLOW_ALL,
+			}},
+		},
+		Identity: getCliIdentity(),
+	}).Return(&adminservice.PatchScheduleResponse{}, nil)
+
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "admin", "schedule", "backfill", "--sid", "test-schedule",
+		"--earliest_time", "2021-07-01T00:00:00Z", "--latest_time", "2021-07-02T00:00:00Z", "--overlap_policy", "allow_all"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminPauseSchedule() {
+	s.serverAdminClient.EXPECT().PatchSchedule(gomock.Any(), &adminservice.PatchScheduleRequest{
+		Namespace:  cliTestNamespace,
+		ScheduleId: "test-schedule",
+		Patch:      &schedpb.SchedulePatch{Pause: "maintenance"},
+		Identity:   getCliIdentity(),
+	}).Return(&adminservice.PatchScheduleResponse{}, nil)
+
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "admin", "schedule", "pause", "--sid", "test-schedule", "--reason", "maintenance"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestBatchResetBadBinary() {
+	s.serverAdminClient.EXPECT().StartResetBadBinaryJob(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, request *adminservice.StartResetBadBinaryJobRequest, _ ...grpc.CallOption) (*adminservice.StartResetBadBinaryJobResponse, error) {
+			s.Equal(cliTestNamespace, request.GetNamespace())
+			s.Equal("bad-checksum", request.GetBinaryChecksum())
+			s.Equal("bug", request.GetReason())
+			s.Equal(enumspb.RESET_REAPPLY_TYPE_NONE, request.GetResetReapplyType())
+			s.True(request.GetIncludeClosed())
+			s.Equal(int32(10), request.GetRps())
+			return &adminservice.StartResetBadBinaryJobResponse{JobId: "job", EstimatedCount: 5}, nil
+		})
+
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "batch", "reset_bad_binary", "--reset_bad_binary_checksum", "bad-checksum",
+		"--reason", "bug", "--reset_reapply_type", "None", "--include_closed", "--rps", "10"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestShowResetPoints() {
+	s.serverAdminClient.EXPECT().GetResetPoints(gomock.Any(), &adminservice.GetResetPointsRequest{
+		Namespace: cliTestNamespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: "wid",
+			RunId:      "rid",
+		},
+	}).Return(&adminservice.GetResetPointsResponse{
+		ResetPoints: []*adminservice.ResetPoint{
+			{
+				BuildId:                      "build-1",
+				RunId:                        "rid",
+				FirstWorkflowTaskCompletedId: 4,
+				CreateTime:                   timestamp.TimePtr(time.Now().UTC()),
+				Resettable:                   true,
+			},
+		},
+	}, nil).Times(2)
+
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "workflow", "show-reset-points", "-w", "wid", "-r", "rid"})
+	s.Nil(err)
+	err = s.app.Run([]string{"", "--ns", cliTestNamespace, "workflow", "show-reset-points", "-w", "wid", "-r", "rid", "--print_json"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestTraceWorkflow() {
+	describeResp := &workflowservice.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+			Execution: &commonpb.WorkflowExecution{WorkflowId: "wid", RunId: "rid"},
+			Type:      &commonpb.WorkflowType{Name: "TestWorkflow"},
+			Status:    enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+			StartTime: timestamp.TimePtr(time.Now().UTC()),
+			CloseTime: timestamp.TimePtr(time.Now().UTC()),
+		},
+	}
+	s.sdkClient.On("DescribeWorkflowExecution", mock.Anything, "wid", "rid").Return(describeResp, nil).Once()
+	s.sdkClient.On("GetWorkflowHistory", mock.Anything, "wid", "rid", mock.Anything, mock.Anything).Return(historyEventIterator()).Once()
+	s.sdkClient.On("GetWorkflowHistory", mock.Anything, "wid", "rid", mock.Anything, mock.Anything).Return(historyEventIterator()).Once()
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "workflow", "trace", "-w", "wid", "-r", "rid"})
+	s.Nil(err)
+	s.sdkClient.AssertExpectations(s.T())
+}
+
+func (s *cliAppSuite) TestRenderTraceTree() {
+	root := &traceNode{
+		execution:    &commonpb.WorkflowExecution{WorkflowId: "parent", RunId: "run-1"},
+		workflowType: "Parent",
+		status:       enumspb.WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW,
+		children: []*traceNode{
+			{
+				execution:    &commonpb.WorkflowExecution{WorkflowId: "child", RunId: "run-2"},
+				workflowType: "Child",
+				status:       enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+			},
+		},
+		continuedAs: &traceNode{
+			execution:    &commonpb.WorkflowExecution{WorkflowId: "parent", RunId: "run-3"},
+			workflowType: "Parent",
+			status:       enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		},
+	}
+
+	lines := renderTraceTree(root)
+	s.Len(lines, 3)
+	s.Contains(lines[0], "Parent (wid: parent, rid: run-1)")
+	s.True(strings.HasPrefix(lines[1], "├── Child (wid: child, rid: run-2)"))
+	s.True(strings.HasPrefix(lines[2], "└── continued as new: Parent (wid: parent, rid: run-3)"))
+	s.True(root.isRunning())
+}
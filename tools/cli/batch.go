@@ -43,6 +43,15 @@ func newBatchCommands() []cli.Command {
 					Name:  FlagJobIDWithAlias,
 					Usage: "Batch Job Id",
 				},
+				cli.BoolFlag{
+					Name:  FlagFollowWithAlias,
+					Usage: "Keep polling the batch job and print its progress until it is finished",
+				},
+				cli.IntFlag{
+					Name:  FlagFollowInterval,
+					Value: 5,
+					Usage: "Poll interval in seconds when following the batch job",
+				},
 			},
 			Action: func(c *cli.Context) {
 				DescribeBatchJob(c)
@@ -119,5 +128,46 @@ func newBatchCommands() []cli.Command {
 				StartBatchJob(c)
 			},
 		},
+		{
+			Name:  "reset_bad_binary",
+			Usage: "Start a batch job resetting the workflows which ran a bad binary of the namespace",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagResetBadBinaryChecksum,
+					Usage: "Binary checksum, must be one of the bad binaries of the namespace",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Reason to run this batch job",
+				},
+				// below are optional
+				cli.StringFlag{
+					Name: FlagResetReapplyType,
+					Usage: "Whether to reapply events after the reset point. Support one of these: " +
+						strings.Join(mapKeysToArray(resetReapplyTypesMap), ",") + ". Default to: Signal",
+				},
+				cli.BoolFlag{
+					Name:  FlagIncludeClosed,
+					Usage: "Also reset closed workflows, by default only running workflows are reset",
+				},
+				cli.IntFlag{
+					Name:  FlagRPS,
+					Value: batcher.DefaultRPS,
+					Usage: "RPS of processing",
+				},
+				cli.BoolFlag{
+					Name:  FlagFollowWithAlias,
+					Usage: "Keep polling the batch job and print its progress until it is finished",
+				},
+				cli.IntFlag{
+					Name:  FlagFollowInterval,
+					Value: 5,
+					Usage: "Poll interval in seconds when following the batch job",
+				},
+			},
+			Action: func(c *cli.Context) {
+				StartResetBadBinaryJob(c)
+			},
+		},
 	}
 }
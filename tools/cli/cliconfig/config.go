@@ -0,0 +1,215 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cliconfig
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// EnvConfigFile overrides location of the tctl config file.
+	EnvConfigFile = "TEMPORAL_CLI_CONFIG"
+
+	configDirName  = ".config/temporal"
+	configFileName = "tctl.yaml"
+)
+
+type (
+	// Config is the content of the tctl config file.
+	Config struct {
+		CurrentContext string              `yaml:"currentContext,omitempty"`
+		Contexts       map[string]*Context `yaml:"contexts,omitempty"`
+
+		path string
+	}
+
+	// Context is a named set of connection settings.
+	Context struct {
+		Address   string            `yaml:"address,omitempty"`
+		Namespace string            `yaml:"namespace,omitempty"`
+		TLS       *TLS              `yaml:"tls,omitempty"`
+		Headers   map[string]string `yaml:"headers,omitempty"`
+		OIDC      *OIDC             `yaml:"oidc,omitempty"`
+	}
+
+	// TLS describes TLS settings of a context.
+	TLS struct {
+		CertPath                string `yaml:"certPath,omitempty"`
+		KeyPath                 string `yaml:"keyPath,omitempty"`
+		CaPath                  string `yaml:"caPath,omitempty"`
+		DisableHostVerification bool   `yaml:"disableHostVerification,omitempty"`
+		ServerName              string `yaml:"serverName,omitempty"`
+	}
+
+	// OIDC describes OpenID Connect provider used to obtain access tokens for a context.
+	OIDC struct {
+		IssuerURL string   `yaml:"issuerUrl"`
+		ClientID  string   `yaml:"clientId"`
+		Scopes    []string `yaml:"scopes,omitempty"`
+		Audience  string   `yaml:"audience,omitempty"`
+	}
+)
+
+var (
+	// ErrContextNotFound is returned when a context with requested name doesn't exist.
+	ErrContextNotFound = errors.New("context not found")
+)
+
+// DefaultDir returns directory where tctl keeps its config file and token cache.
+func DefaultDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, filepath.FromSlash(configDirName)), nil
+}
+
+// DefaultPath returns location of the tctl config file.
+func DefaultPath() (string, error) {
+	if path := os.Getenv(EnvConfigFile); path != "" {
+		return path, nil
+	}
+	dir, err := DefaultDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, configFileName), nil
+}
+
+// Load reads config from path. Missing file results in an empty config.
+func Load(path string) (*Config, error) {
+	cfg := &Config{
+		Contexts: make(map[string]*Context),
+		path:     path,
+	}
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return cfg, nil
+		}
+		return nil, err
+	}
+	if err := yaml.Unmarshal(content, cfg); err != nil {
+		return nil, fmt.Errorf("unable to parse config file %s: %w", path, err)
+	}
+	if cfg.Contexts == nil {
+		cfg.Contexts = make(map[string]*Context)
+	}
+	return cfg, nil
+}
+
+// Save writes config back to the file it was loaded from.
+func (c *Config) Save() error {
+	content, err := yaml.Marshal(c)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0700); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(c.path, content, 0600)
+}
+
+// Path returns location of the config file.
+func (c *Config) Path() string {
+	return c.path
+}
+
+// GetContext returns context by name. Empty name means current context.
+func (c *Config) GetContext(name string) (*Context, error) {
+	if name == "" {
+		name = c.CurrentContext
+	}
+	if name == "" {
+		return nil, nil
+	}
+	ctx, ok := c.Contexts[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrContextNotFound, name)
+	}
+	return ctx, nil
+}
+
+// SetContext creates or replaces context with the given name.
+func (c *Config) SetContext(name string, ctx *Context) error {
+	if name == "" {
+		return errors.New("context name is empty")
+	}
+	if ctx.OIDC != nil {
+		if err := ctx.OIDC.validate(); err != nil {
+			return err
+		}
+	}
+	c.Contexts[name] = ctx
+	return nil
+}
+
+// UseContext makes context with the given name current.
+func (c *Config) UseContext(name string) error {
+	if _, ok := c.Contexts[name]; !ok {
+		return fmt.Errorf("%w: %s", ErrContextNotFound, name)
+	}
+	c.CurrentContext = name
+	return nil
+}
+
+// DeleteContext removes context with the given name.
+func (c *Config) DeleteContext(name string) error {
+	if _, ok := c.Contexts[name]; !ok {
+		return fmt.Errorf("%w: %s", ErrContextNotFound, name)
+	}
+	delete(c.Contexts, name)
+	if c.CurrentContext == name {
+		c.CurrentContext = ""
+	}
+	return nil
+}
+
+// ContextNames returns sorted names of all contexts.
+func (c *Config) ContextNames() []string {
+	names := make([]string, 0, len(c.Contexts))
+	for name := range c.Contexts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (o *OIDC) validate() error {
+	if o.IssuerURL == "" {
+		return errors.New("OIDC issuer URL is empty")
+	}
+	if o.ClientID == "" {
+		return errors.New("OIDC client ID is empty")
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cliconfig
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type configSuite struct {
+	suite.Suite
+	dir string
+}
+
+func TestConfigSuite(t *testing.T) {
+	s := &configSuite{}
+	suite.Run(t, s)
+}
+
+func (s *configSuite) SetupTest() {
+	dir, err := ioutil.TempDir("", "tctl-config")
+	s.NoError(err)
+	s.dir = dir
+}
+
+func (s *configSuite) TearDownTest() {
+	s.NoError(os.RemoveAll(s.dir))
+}
+
+func (s *configSuite) TestLoad_MissingFile() {
+	cfg, err := Load(filepath.Join(s.dir, "missing.yaml"))
+	s.NoError(err)
+	s.Empty(cfg.Contexts)
+
+	cliCtx, err := cfg.GetContext("")
+	s.NoError(err)
+	s.Nil(cliCtx)
+}
+
+func (s *configSuite) TestSaveAndLoad() {
+	path := filepath.Join(s.dir, "nested", "tctl.yaml")
+	cfg, err := Load(path)
+	s.NoError(err)
+
+	s.NoError(cfg.SetContext("prod", &Context{
+		Address:   "prod:7233",
+		Namespace: "orders",
+		TLS:       &TLS{CaPath: "/etc/ca.pem", ServerName: "frontend.prod"},
+		Headers:   map[string]string{"x-team": "payments"},
+		OIDC:      &OIDC{IssuerURL: "https://issuer", ClientID: "tctl", Scopes: []string{"openid"}},
+	}))
+	s.NoError(cfg.SetContext("dev", &Context{Address: "localhost:7233"}))
+	s.NoError(cfg.UseContext("prod"))
+	s.NoError(cfg.Save())
+
+	info, err := os.Stat(path)
+	s.NoError(err)
+	s.Equal(os.FileMode(0600), info.Mode().Perm())
+
+	loaded, err := Load(path)
+	s.NoError(err)
+	s.Equal("prod", loaded.CurrentContext)
+	s.Equal([]string{"dev", "prod"}, loaded.ContextNames())
+	cliCtx, err := loaded.GetContext("")
+	s.NoError(err)
+	s.Equal(cfg.Contexts["prod"], cliCtx)
+}
+
+func (s *configSuite) TestSetContext_InvalidOIDC() {
+	cfg, err := Load(filepath.Join(s.dir, "tctl.yaml"))
+	s.NoError(err)
+
+	s.Error(cfg.SetContext("prod", &Context{OIDC: &OIDC{IssuerURL: "https://issuer"}}))
+	s.Error(cfg.SetContext("prod", &Context{OIDC: &OIDC{ClientID: "tctl"}}))
+	s.Error(cfg.SetContext("", &Context{}))
+	s.Empty(cfg.Contexts)
+}
+
+func (s *configSuite) TestUseAndDeleteContext() {
+	cfg, err := Load(filepath.Join(s.dir, "tctl.yaml"))
+	s.NoError(err)
+
+	err = cfg.UseContext("prod")
+	s.True(errors.Is(err, ErrContextNotFound))
+	_, err = cfg.GetContext("prod")
+	s.True(errors.Is(err, ErrContextNotFound))
+
+	s.NoError(cfg.SetContext("prod", &Context{Address: "prod:7233"}))
+	s.NoError(cfg.UseContext("prod"))
+	s.Equal("prod", cfg.CurrentContext)
+
+	s.NoError(cfg.DeleteContext("prod"))
+	s.Empty(cfg.CurrentContext)
+	s.True(errors.Is(cfg.DeleteContext("prod"), ErrContextNotFound))
+}
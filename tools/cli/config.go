@@ -0,0 +1,120 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import "github.com/urfave/cli"
+
+func newConfigCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:      "set-context",
+			Usage:     "Create or update a context, only provided settings are changed",
+			ArgsUsage: "context_name",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagAddress,
+					Usage: "host:port for Temporal frontend service",
+				},
+				cli.StringFlag{
+					Name:  FlagNamespace,
+					Usage: "Temporal workflow namespace",
+				},
+				cli.StringFlag{
+					Name:  FlagTLSCertPath,
+					Usage: "path to x509 certificate",
+				},
+				cli.StringFlag{
+					Name:  FlagTLSKeyPath,
+					Usage: "path to private key",
+				},
+				cli.StringFlag{
+					Name:  FlagTLSCaPath,
+					Usage: "path to server CA certificate",
+				},
+				cli.BoolFlag{
+					Name:  FlagTLSDisableHostVerification,
+					Usage: "disable tls host name verification (tls must be enabled)",
+				},
+				cli.StringFlag{
+					Name:  FlagTLSServerName,
+					Usage: "override for target server name",
+				},
+				cli.StringSliceFlag{
+					Name:  FlagHeader,
+					Usage: "gRPC header sent with every request in key=value format, empty value removes the header (multiple values are supported)",
+				},
+				cli.StringFlag{
+					Name:  FlagOIDCIssuerURL,
+					Usage: "URL of OpenID Connect provider used by login",
+				},
+				cli.StringFlag{
+					Name:  FlagOIDCClientID,
+					Usage: "OAuth 2.0 client ID registered for tctl",
+				},
+				cli.StringSliceFlag{
+					Name:  FlagOIDCScopes,
+					Usage: "scopes to request during login (default: openid, offline_access)",
+				},
+				cli.StringFlag{
+					Name:  FlagOIDCAudience,
+					Usage: "audience of requested access token",
+				},
+			},
+			Action: func(c *cli.Context) {
+				SetContext(c)
+			},
+		},
+		{
+			Name:      "use-context",
+			Usage:     "Set the current context",
+			ArgsUsage: "context_name",
+			Action: func(c *cli.Context) {
+				UseContext(c)
+			},
+		},
+		{
+			Name:  "current-context",
+			Usage: "Show the current context",
+			Action: func(c *cli.Context) {
+				CurrentContext(c)
+			},
+		},
+		{
+			Name:  "get-contexts",
+			Usage: "List all contexts",
+			Action: func(c *cli.Context) {
+				GetContexts(c)
+			},
+		},
+		{
+			Name:      "delete-context",
+			Usage:     "Delete a context and its cached token",
+			ArgsUsage: "context_name",
+			Action: func(c *cli.Context) {
+				DeleteContext(c)
+			},
+		},
+	}
+}
@@ -0,0 +1,357 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli"
+	"golang.org/x/oauth2"
+
+	"go.temporal.io/server/tools/cli/cliconfig"
+	"go.temporal.io/server/tools/cli/headersprovider"
+	"go.temporal.io/server/tools/cli/oidc"
+	"go.temporal.io/server/tools/cli/plugin"
+)
+
+const (
+	loginTimeout = 5 * time.Minute
+)
+
+// SetContext creates or updates a named context.
+func SetContext(c *cli.Context) {
+	name := getRequiredArg(c, "context_name")
+	cfg := loadCLIConfig()
+
+	cliCtx, ok := cfg.Contexts[name]
+	if !ok {
+		cliCtx = &cliconfig.Context{}
+	}
+	if c.IsSet(FlagAddress) {
+		cliCtx.Address = c.String(FlagAddress)
+	}
+	if c.IsSet(FlagNamespace) {
+		cliCtx.Namespace = c.String(FlagNamespace)
+	}
+
+	if c.IsSet(FlagTLSCertPath) || c.IsSet(FlagTLSKeyPath) || c.IsSet(FlagTLSCaPath) ||
+		c.IsSet(FlagTLSDisableHostVerification) || c.IsSet(FlagTLSServerName) {
+		if cliCtx.TLS == nil {
+			cliCtx.TLS = &cliconfig.TLS{}
+		}
+		if c.IsSet(FlagTLSCertPath) {
+			cliCtx.TLS.CertPath = c.String(FlagTLSCertPath)
+		}
+		if c.IsSet(FlagTLSKeyPath) {
+			cliCtx.TLS.KeyPath = c.String(FlagTLSKeyPath)
+		}
+		if c.IsSet(FlagTLSCaPath) {
+			cliCtx.TLS.CaPath = c.String(FlagTLSCaPath)
+		}
+		if c.IsSet(FlagTLSDisableHostVerification) {
+			cliCtx.TLS.DisableHostVerification = c.Bool(FlagTLSDisableHostVerification)
+		}
+		if c.IsSet(FlagTLSServerName) {
+			cliCtx.TLS.ServerName = c.String(FlagTLSServerName)
+		}
+	}
+
+	for _, header := range c.StringSlice(FlagHeader) {
+		parts := strings.SplitN(header, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			InvalidArgumentAndExit(fmt.Sprintf("Invalid header %q, expected key=value.", header), nil)
+		}
+		if cliCtx.Headers == nil {
+			cliCtx.Headers = make(map[string]string)
+		}
+		key := strings.ToLower(parts[0])
+		if parts[1] == "" {
+			delete(cliCtx.Headers, key)
+		} else {
+			cliCtx.Headers[key] = parts[1]
+		}
+	}
+	if len(cliCtx.Headers) == 0 {
+		cliCtx.Headers = nil
+	}
+
+	if c.IsSet(FlagOIDCIssuerURL) || c.IsSet(FlagOIDCClientID) || c.IsSet(FlagOIDCScopes) || c.IsSet(FlagOIDCAudience) {
+		if cliCtx.OIDC == nil {
+			cliCtx.OIDC = &cliconfig.OIDC{}
+		}
+		if c.IsSet(FlagOIDCIssuerURL) {
+			cliCtx.OIDC.IssuerURL = c.String(FlagOIDCIssuerURL)
+		}
+		if c.IsSet(FlagOIDCClientID) {
+			cliCtx.OIDC.ClientID = c.String(FlagOIDCClientID)
+		}
+		if c.IsSet(FlagOIDCScopes) {
+			cliCtx.OIDC.Scopes = c.StringSlice(FlagOIDCScopes)
+		}
+		if c.IsSet(FlagOIDCAudience) {
+			cliCtx.OIDC.Audience = c.String(FlagOIDCAudience)
+		}
+	}
+
+	if err := cfg.SetContext(name, cliCtx); err != nil {
+		ErrorAndExit(fmt.Sprintf("Unable to set context %s.", name), err)
+	}
+	if cfg.CurrentContext == "" {
+		cfg.CurrentContext = name
+	}
+	saveCLIConfig(cfg)
+	fmt.Printf("Context %s is saved.\n", name)
+}
+
+// UseContext sets the current context.
+func UseContext(c *cli.Context) {
+	name := getRequiredArg(c, "context_name")
+	cfg := loadCLIConfig()
+	if err := cfg.UseContext(name); err != nil {
+		ErrorAndExit(fmt.Sprintf("Unable to use context %s.", name), err)
+	}
+	saveCLIConfig(cfg)
+	fmt.Printf("Switched to context %s.\n", name)
+}
+
+// CurrentContext prints the current context.
+func CurrentContext(c *cli.Context) {
+	cfg := loadCLIConfig()
+	if cfg.CurrentContext == "" {
+		ErrorAndExit("Current context is not set.", nil)
+	}
+	fmt.Println(cfg.CurrentContext)
+}
+
+// GetContexts lists all contexts.
+func GetContexts(c *cli.Context) {
+	cfg := loadCLIConfig()
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Current", "Name", "Address", "Namespace", "TLS", "Headers", "OIDC"})
+	table.SetHeaderLine(false)
+	for _, name := range cfg.ContextNames() {
+		cliCtx := cfg.Contexts[name]
+		current := ""
+		if name == cfg.CurrentContext {
+			current = "*"
+		}
+		oidcIssuer := ""
+		if cliCtx.OIDC != nil {
+			oidcIssuer = cliCtx.OIDC.IssuerURL
+		}
+		table.Append([]string{current, name, cliCtx.Address, cliCtx.Namespace, strconv.FormatBool(cliCtx.TLS != nil), strconv.Itoa(len(cliCtx.Headers)), oidcIssuer})
+	}
+	table.Render()
+}
+
+// DeleteContext deletes a context and its cached token.
+func DeleteContext(c *cli.Context) {
+	name := getRequiredArg(c, "context_name")
+	cfg := loadCLIConfig()
+	if err := cfg.DeleteContext(name); err != nil {
+		ErrorAndExit(fmt.Sprintf("Unable to delete context %s.", name), err)
+	}
+	saveCLIConfig(cfg)
+	if err := newTokenStore(cfg).Delete(name); err != nil {
+		ErrorAndExit(fmt.Sprintf("Unable to delete cached token of context %s.", name), err)
+	}
+	fmt.Printf("Context %s is deleted.\n", name)
+}
+
+// Login obtains a token from OpenID Connect provider of the context and caches it locally.
+func Login(c *cli.Context) {
+	cfg := loadCLIConfig()
+	name, cliCtx := getOIDCContext(c, cfg)
+
+	ctx, cancel := context.WithTimeout(context.Background(), loginTimeout)
+	defer cancel()
+	client, err := oidc.NewClient(ctx, cliCtx.OIDC)
+	if err != nil {
+		ErrorAndExit("Unable to connect to OpenID Connect provider.", err)
+	}
+
+	var token *oauth2.Token
+	if c.Bool(FlagBrowser) {
+		token, err = client.BrowserLogin(ctx, os.Stdout)
+	} else {
+		token, err = client.DeviceLogin(ctx, os.Stdout)
+	}
+	if err != nil {
+		ErrorAndExit("Login failed.", err)
+	}
+
+	if err := newTokenStore(cfg).Save(name, token); err != nil {
+		ErrorAndExit("Unable to cache token.", err)
+	}
+	fmt.Printf("Logged in to context %s.\n", name)
+}
+
+// Logout removes cached token of the context.
+func Logout(c *cli.Context) {
+	cfg := loadCLIConfig()
+	name, _ := getOIDCContext(c, cfg)
+	if err := newTokenStore(cfg).Delete(name); err != nil {
+		ErrorAndExit("Unable to delete cached token.", err)
+	}
+	fmt.Printf("Logged out of context %s.\n", name)
+}
+
+// applyCLIContext uses settings of the selected context as values of global flags which were not set explicitly.
+func applyCLIContext(c *cli.Context) error {
+	path, err := cliconfig.DefaultPath()
+	if err != nil {
+		return err
+	}
+	cfg, err := cliconfig.Load(path)
+	if err != nil {
+		return err
+	}
+	name := c.GlobalString(FlagContext)
+	if name == "" {
+		name = cfg.CurrentContext
+	}
+	cliCtx, err := cfg.GetContext(name)
+	if err != nil || cliCtx == nil {
+		return err
+	}
+
+	flagValues := map[string]string{
+		FlagAddress:   cliCtx.Address,
+		FlagNamespace: cliCtx.Namespace,
+	}
+	if cliCtx.TLS != nil {
+		flagValues[FlagTLSCertPath] = cliCtx.TLS.CertPath
+		flagValues[FlagTLSKeyPath] = cliCtx.TLS.KeyPath
+		flagValues[FlagTLSCaPath] = cliCtx.TLS.CaPath
+		flagValues[FlagTLSServerName] = cliCtx.TLS.ServerName
+		if cliCtx.TLS.DisableHostVerification {
+			flagValues[FlagTLSDisableHostVerification] = strconv.FormatBool(true)
+		}
+	}
+	for flagName, value := range flagValues {
+		if value == "" || c.GlobalIsSet(flagName) {
+			continue
+		}
+		if err := c.GlobalSet(flagName, value); err != nil {
+			return err
+		}
+	}
+
+	var headersProvider plugin.HeadersProvider
+	if cliCtx.OIDC != nil {
+		headersProvider = oidc.NewHeadersProvider(cliCtx.OIDC, name, newTokenStore(cfg))
+	}
+	if len(cliCtx.Headers) > 0 {
+		headersProvider = &contextHeadersProvider{
+			headers: cliCtx.Headers,
+			next:    headersProvider,
+		}
+	}
+	if headersProvider != nil {
+		headersprovider.SetCurrent(headersProvider)
+	}
+	return nil
+}
+
+// contextHeadersProvider adds static headers of a context to headers returned by the next provider.
+type contextHeadersProvider struct {
+	headers map[string]string
+	next    plugin.HeadersProvider
+}
+
+func (p *contextHeadersProvider) GetHeaders(ctx context.Context) (map[string]string, error) {
+	headers := make(map[string]string, len(p.headers))
+	for k, v := range p.headers {
+		headers[k] = v
+	}
+	if p.next == nil {
+		return headers, nil
+	}
+	nextHeaders, err := p.next.GetHeaders(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range nextHeaders {
+		headers[k] = v
+	}
+	return headers, nil
+}
+
+func getOIDCContext(c *cli.Context, cfg *cliconfig.Config) (string, *cliconfig.Context) {
+	name := c.GlobalString(FlagContext)
+	if name == "" {
+		name = cfg.CurrentContext
+	}
+	if name == "" {
+		ErrorAndExit("Context is not selected, use 'tctl config use-context' or --context.", nil)
+	}
+	cliCtx, err := cfg.GetContext(name)
+	if err != nil {
+		ErrorAndExit(fmt.Sprintf("Unable to get context %s.", name), err)
+	}
+	if cliCtx.OIDC == nil {
+		ErrorAndExit(fmt.Sprintf("Context %s doesn't have OpenID Connect settings.", name), nil)
+	}
+	return name, cliCtx
+}
+
+func loadCLIConfig() *cliconfig.Config {
+	path, err := cliconfig.DefaultPath()
+	if err != nil {
+		ErrorAndExit("Unable to locate tctl config file.", err)
+	}
+	cfg, err := cliconfig.Load(path)
+	if err != nil {
+		ErrorAndExit("Unable to load tctl config file.", err)
+	}
+	return cfg
+}
+
+func saveCLIConfig(cfg *cliconfig.Config) {
+	if err := cfg.Save(); err != nil {
+		ErrorAndExit("Unable to save tctl config file.", err)
+	}
+}
+
+func newTokenStore(cfg *cliconfig.Config) *oidc.TokenStore {
+	return oidc.NewTokenStore(filepath.Dir(cfg.Path()))
+}
+
+func getRequiredArg(c *cli.Context, argName string) string {
+	if !c.Args().Present() {
+		InvalidArgumentAndExit(fmt.Sprintf("Argument %s is required", argName), nil)
+	}
+	return c.Args().First()
+}
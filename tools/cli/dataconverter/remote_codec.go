@@ -0,0 +1,201 @@
+// The MIT License
+//
+// Copyright (c) 2021 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dataconverter
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/sdk/converter"
+
+	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/tools/cli/plugin"
+)
+
+const (
+	codecEncodePath = "/encode"
+	codecDecodePath = "/decode"
+	codecTimeout    = 10 * time.Second
+
+	// NamespaceHeaderName is sent to the codec service so it can pick the keys of the namespace
+	NamespaceHeaderName = "X-Namespace"
+)
+
+type (
+	// remoteCodecDataConverter round-trips payloads through a remote codec HTTP service before handing
+	// them to the parent data converter, so payloads encoded (e.g. encrypted) by the codec can be displayed.
+	// The codec service accepts POST requests on /encode and /decode with JSON encoded Payloads in the body.
+	remoteCodecDataConverter struct {
+		parent          converter.DataConverter
+		endpoint        string
+		namespace       string
+		headersProvider plugin.HeadersProvider
+		httpClient      *http.Client
+		encoder         *codec.JSONPBEncoder
+	}
+)
+
+var _ converter.DataConverter = (*remoteCodecDataConverter)(nil)
+
+// NewRemoteCodecDataConverter creates a data converter which encodes and decodes payloads with the codec
+// service at endpoint, headers provider is optional and used to authenticate calls to the codec service
+func NewRemoteCodecDataConverter(
+	parent converter.DataConverter,
+	endpoint string,
+	namespace string,
+	headersProvider plugin.HeadersProvider,
+) converter.DataConverter {
+	return &remoteCodecDataConverter{
+		parent:          parent,
+		endpoint:        strings.TrimSuffix(endpoint, "/"),
+		namespace:       namespace,
+		headersProvider: headersProvider,
+		httpClient:      &http.Client{Timeout: codecTimeout},
+		encoder:         codec.NewJSONPBEncoder(),
+	}
+}
+
+func (dc *remoteCodecDataConverter) ToPayload(value interface{}) (*commonpb.Payload, error) {
+	payload, err := dc.parent.ToPayload(value)
+	if payload == nil || err != nil {
+		return payload, err
+	}
+	encoded, err := dc.call(codecEncodePath, &commonpb.Payloads{Payloads: []*commonpb.Payload{payload}})
+	if err != nil {
+		return nil, err
+	}
+	return encoded.Payloads[0], nil
+}
+
+func (dc *remoteCodecDataConverter) ToPayloads(values ...interface{}) (*commonpb.Payloads, error) {
+	payloads, err := dc.parent.ToPayloads(values...)
+	if payloads == nil || err != nil {
+		return payloads, err
+	}
+	return dc.call(codecEncodePath, payloads)
+}
+
+func (dc *remoteCodecDataConverter) FromPayload(payload *commonpb.Payload, valuePtr interface{}) error {
+	decoded, err := dc.decodePayload(payload)
+	if err != nil {
+		return err
+	}
+	return dc.parent.FromPayload(decoded, valuePtr)
+}
+
+func (dc *remoteCodecDataConverter) FromPayloads(payloads *commonpb.Payloads, valuePtrs ...interface{}) error {
+	decoded, err := dc.decodePayloads(payloads)
+	if err != nil {
+		return err
+	}
+	return dc.parent.FromPayloads(decoded, valuePtrs...)
+}
+
+func (dc *remoteCodecDataConverter) ToString(payload *commonpb.Payload) string {
+	decoded, err := dc.decodePayload(payload)
+	if err != nil {
+		return err.Error()
+	}
+	return dc.parent.ToString(decoded)
+}
+
+func (dc *remoteCodecDataConverter) ToStrings(payloads *commonpb.Payloads) []string {
+	decoded, err := dc.decodePayloads(payloads)
+	if err != nil {
+		return []string{err.Error()}
+	}
+	return dc.parent.ToStrings(decoded)
+}
+
+func (dc *remoteCodecDataConverter) decodePayload(payload *commonpb.Payload) (*commonpb.Payload, error) {
+	if payload == nil {
+		return nil, nil
+	}
+	decoded, err := dc.call(codecDecodePath, &commonpb.Payloads{Payloads: []*commonpb.Payload{payload}})
+	if err != nil {
+		return nil, err
+	}
+	return decoded.Payloads[0], nil
+}
+
+func (dc *remoteCodecDataConverter) decodePayloads(payloads *commonpb.Payloads) (*commonpb.Payloads, error) {
+	if len(payloads.GetPayloads()) == 0 {
+		return payloads, nil
+	}
+	return dc.call(codecDecodePath, payloads)
+}
+
+func (dc *remoteCodecDataConverter) call(path string, payloads *commonpb.Payloads) (*commonpb.Payloads, error) {
+	body, err := dc.encoder.Encode(payloads)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode payloads for codec: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), codecTimeout)
+	defer cancel()
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, dc.endpoint+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if dc.namespace != "" {
+		request.Header.Set(NamespaceHeaderName, dc.namespace)
+	}
+	if dc.headersProvider != nil {
+		headers, err := dc.headersProvider.GetHeaders(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get headers for codec: %w", err)
+		}
+		for name, value := range headers {
+			request.Header.Set(name, value)
+		}
+	}
+
+	response, err := dc.httpClient.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("codec request failed: %w", err)
+	}
+	defer func() { _ = response.Body.Close() }()
+	responseBody, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read codec response: %w", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("codec returned status %d: %s", response.StatusCode, strings.TrimSpace(string(responseBody)))
+	}
+
+	result := &commonpb.Payloads{}
+	if err := dc.encoder.Decode(responseBody, result); err != nil {
+		return nil, fmt.Errorf("unable to decode payloads from codec: %w", err)
+	}
+	if len(result.Payloads) != len(payloads.Payloads) {
+		return nil, fmt.Errorf("codec returned %d payloads, expected %d", len(result.Payloads), len(payloads.Payloads))
+	}
+	return result, nil
+}
@@ -0,0 +1,104 @@
+// The MIT License
+//
+// Copyright (c) 2021 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dataconverter
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/sdk/converter"
+
+	"go.temporal.io/server/common/codec"
+)
+
+type staticHeadersProvider map[string]string
+
+func (p staticHeadersProvider) GetHeaders(_ context.Context) (map[string]string, error) {
+	return p, nil
+}
+
+// newReversingCodecServer returns a codec server which reverses payload data on both encode and decode
+func newReversingCodecServer(t *testing.T) *httptest.Server {
+	encoder := codec.NewJSONPBEncoder()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "test-namespace", r.Header.Get(NamespaceHeaderName))
+		require.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		if r.URL.Path != codecDecodePath && r.URL.Path != codecEncodePath {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		payloads := &commonpb.Payloads{}
+		require.NoError(t, encoder.Decode(body, payloads))
+		for _, p := range payloads.Payloads {
+			for i, j := 0, len(p.Data)-1; i < j; i, j = i+1, j-1 {
+				p.Data[i], p.Data[j] = p.Data[j], p.Data[i]
+			}
+		}
+		response, err := encoder.Encode(payloads)
+		require.NoError(t, err)
+		_, _ = w.Write(response)
+	}))
+}
+
+func TestRemoteCodecDataConverter(t *testing.T) {
+	server := newReversingCodecServer(t)
+	defer server.Close()
+
+	dc := NewRemoteCodecDataConverter(
+		converter.GetDefaultDataConverter(),
+		server.URL+"/",
+		"test-namespace",
+		staticHeadersProvider{"Authorization": "Bearer token"},
+	)
+
+	encoded, err := dc.ToPayloads("value", 1)
+	require.NoError(t, err)
+	require.Equal(t, `"eulav"`, string(encoded.Payloads[0].Data))
+	require.Equal(t, []string{`"value"`, "1"}, dc.ToStrings(encoded))
+
+	var value string
+	require.NoError(t, dc.FromPayload(encoded.Payloads[0], &value))
+	require.Equal(t, "value", value)
+}
+
+func TestRemoteCodecDataConverter_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte("no access\n"))
+	}))
+	defer server.Close()
+
+	dc := NewRemoteCodecDataConverter(converter.GetDefaultDataConverter(), server.URL, "", nil)
+	payload, err := converter.GetDefaultDataConverter().ToPayload("value")
+	require.NoError(t, err)
+	require.Equal(t, "codec returned status 403: no access", dc.ToString(payload))
+}
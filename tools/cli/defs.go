@@ -60,10 +60,11 @@ const (
 	defaultContextTimeoutForListArchivedWorkflow = 3 * time.Minute
 	defaultContextTimeoutForVisibility           = 10 * time.Second
 
-	defaultWorkflowTaskTimeoutInSeconds = 10
-	defaultPageSizeForList              = 500
-	defaultPageSizeForScan              = 2000
-	defaultWorkflowIDReusePolicy        = enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE
+	defaultWorkflowTaskTimeoutInSeconds           = 10
+	defaultNamespaceHandoverDrainTimeoutInSeconds = 300
+	defaultPageSizeForList                        = 500
+	defaultPageSizeForScan                        = 2000
+	defaultWorkflowIDReusePolicy                  = enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE
 
 	workflowStatusNotSet = -1
 	showErrorStackEnv    = `TEMPORAL_CLI_SHOW_STACKS`
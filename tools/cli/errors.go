@@ -0,0 +1,142 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"runtime/debug"
+
+	"go.temporal.io/api/serviceerror"
+	"google.golang.org/grpc/codes"
+)
+
+// Exit codes of tctl commands. Automation can rely on these values to branch on failures.
+const (
+	// ExitCodeError is returned on any failure not covered by more specific exit codes
+	ExitCodeError = 1
+	// ExitCodeInvalidArgument is returned when command line options or arguments are invalid,
+	// or server rejected the request as invalid
+	ExitCodeInvalidArgument = 2
+	// ExitCodeNotFound is returned when namespace, workflow or other requested entity does not exist
+	ExitCodeNotFound = 3
+	// ExitCodeUnavailable is returned when server is unavailable or the request timed out
+	ExitCodeUnavailable = 4
+)
+
+const (
+	errorFormatText = "text"
+	errorFormatJSON = "json"
+)
+
+type (
+	// errorOutput is the error printed when output format is json
+	errorOutput struct {
+		Code     string `json:"code"`
+		ExitCode int    `json:"exitCode"`
+		Message  string `json:"message"`
+		Details  string `json:"details,omitempty"`
+	}
+)
+
+// errorFormat is set from the global error format flag before any command runs
+var errorFormat = errorFormatText
+
+func setErrorFormat(format string) error {
+	switch format {
+	case "", errorFormatText:
+		errorFormat = errorFormatText
+	case errorFormatJSON:
+		errorFormat = errorFormatJSON
+	default:
+		return fmt.Errorf("unknown error format %q, supported formats are %q and %q", format, errorFormatText, errorFormatJSON)
+	}
+	return nil
+}
+
+func printError(msg string, err error, code codes.Code) {
+	if errorFormat == errorFormatJSON {
+		printErrorJSON(msg, err, code)
+		return
+	}
+
+	if err != nil {
+		fmt.Printf("%s %s\n%s %+v\n", colorRed("Error:"), msg, colorMagenta("Error Details:"), err)
+		if os.Getenv(showErrorStackEnv) != `` {
+			fmt.Printf("Stack trace:\n")
+			debug.PrintStack()
+		} else {
+			fmt.Printf("('export %s=1' to see stack traces)\n", showErrorStackEnv)
+		}
+	} else {
+		fmt.Printf("%s %s\n", colorRed("Error:"), msg)
+	}
+}
+
+func printErrorJSON(msg string, err error, code codes.Code) {
+	output := errorOutput{
+		Code:     code.String(),
+		ExitCode: codeToExitCode(code),
+		Message:  msg,
+	}
+	if err != nil {
+		output.Details = err.Error()
+	}
+	b, _ := json.Marshal(output)
+	fmt.Println(string(b))
+}
+
+// errorCode returns gRPC code of the first error in the chain which is a service error,
+// gRPC status error or context error.
+func errorCode(err error) codes.Code {
+	if err == nil {
+		return codes.Unknown
+	}
+	for e := err; e != nil; e = errors.Unwrap(e) {
+		if code := serviceerror.ToStatus(e).Code(); code != codes.Unknown {
+			return code
+		}
+	}
+	return codes.Unknown
+}
+
+func exitCode(err error) int {
+	return codeToExitCode(errorCode(err))
+}
+
+func codeToExitCode(code codes.Code) int {
+	switch code {
+	case codes.InvalidArgument:
+		return ExitCodeInvalidArgument
+	case codes.NotFound:
+		return ExitCodeNotFound
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return ExitCodeUnavailable
+	default:
+		return ExitCodeError
+	}
+}
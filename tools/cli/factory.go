@@ -31,6 +31,7 @@ import (
 	"errors"
 	"io/ioutil"
 	"net"
+	"time"
 
 	"github.com/urfave/cli"
 	"go.temporal.io/api/workflowservice/v1"
@@ -42,6 +43,7 @@ import (
 
 	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/common/auth"
+	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/tools/cli/headersprovider"
@@ -104,7 +106,7 @@ func (b *clientFactory) SDKClient(c *cli.Context, namespace string) sdkclient.Cl
 			DisableHealthCheck: true,
 			TLS:                tlsConfig,
 		},
-		HeadersProvider: headersprovider.GetCurrent(),
+		HeadersProvider: &sdkHeadersProvider{base: headersprovider.GetCurrent()},
 	})
 	if err != nil {
 		b.logger.Fatal("Failed to create SDK client", tag.Error(err))
@@ -133,6 +135,37 @@ func headersProviderInterceptor(headersProvider plugin.HeadersProvider) grpc.Una
 	}
 }
 
+type startDelayContextKey struct{}
+
+// withStartDelay returns a context which makes the SDK client send the start delay header.
+// The SDK replaces the outgoing metadata of the context, so the header is added by sdkHeadersProvider.
+func withStartDelay(ctx context.Context, startDelay time.Duration) context.Context {
+	return context.WithValue(ctx, startDelayContextKey{}, startDelay)
+}
+
+// sdkHeadersProvider adds the headers of CLI options, which have no field in SDK requests,
+// to the headers of the configured headers provider.
+type sdkHeadersProvider struct {
+	base plugin.HeadersProvider
+}
+
+func (p *sdkHeadersProvider) GetHeaders(ctx context.Context) (map[string]string, error) {
+	result := make(map[string]string)
+	if p.base != nil {
+		baseHeaders, err := p.base.GetHeaders(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range baseHeaders {
+			result[k] = v
+		}
+	}
+	if startDelay, ok := ctx.Value(startDelayContextKey{}).(time.Duration); ok {
+		result[headers.StartDelayHeaderName] = startDelay.String()
+	}
+	return result, nil
+}
+
 func (b *clientFactory) createGRPCConnection(c *cli.Context) (*grpc.ClientConn, error) {
 	hostPort := c.GlobalString(FlagAddress)
 	if hostPort == "" {
@@ -61,6 +61,7 @@ const (
 	FlagWorkflowIDReusePolicy                 = "workflowidreusepolicy"
 	FlagWorkflowIDReusePolicyAlias            = FlagWorkflowIDReusePolicy + ", wrp"
 	FlagCronSchedule                          = "cron"
+	FlagStartDelay                            = "start_delay"
 	FlagWorkflowType                          = "workflow_type"
 	FlagWorkflowTypeWithAlias                 = FlagWorkflowType + ", wt"
 	FlagWorkflowStatus                        = "status"
@@ -143,6 +144,7 @@ const (
 	FlagOutputFilename                        = "output_filename"
 	FlagOutputFilenameWithAlias               = FlagOutputFilename + ", of"
 	FlagOutputFormat                          = "output"
+	FlagErrorFormat                           = "error_format"
 	FlagQueryType                             = "query_type"
 	FlagQueryTypeWithAlias                    = FlagQueryType + ", qt"
 	FlagQueryRejectCondition                  = "query_reject_condition"
@@ -151,6 +153,8 @@ const (
 	FlagShowDetailWithAlias                   = FlagShowDetail + ", sd"
 	FlagActiveClusterName                     = "active_cluster"
 	FlagActiveClusterNameWithAlias            = FlagActiveClusterName + ", ac"
+	FlagDrainTimeout                          = "drain_timeout"
+	FlagDrainTimeoutWithAlias                 = FlagDrainTimeout + ", dt"
 	FlagClusters                              = "clusters"
 	FlagClustersWithAlias                     = FlagClusters + ", cl"
 	FlagClusterMembershipRole                 = "role"
@@ -199,11 +203,25 @@ const (
 	FlagTaskVisibilityTimestamp               = "task_timestamp"
 	FlagMinVisibilityTimestamp                = "min_visibility_ts"
 	FlagMaxVisibilityTimestamp                = "max_visibility_ts"
+	FlagFollow                                = "follow"
+	FlagFollowWithAlias                       = FlagFollow + ", f"
+	FlagFollowInterval                        = "follow_interval"
+	FlagFollowFrom                            = "from"
+	FlagDepth                                 = "depth"
+	FlagMaxMoves                              = "max_moves"
+	FlagSortBy                                = "sort_by"
+	FlagTop                                   = "top"
+	FlagProfile                               = "profile"
+	FlagDuration                              = "duration"
+	FlagFlush                                 = "flush"
 	FlagStartingRPS                           = "starting_rps"
 	FlagRPS                                   = "rps"
 	FlagJobID                                 = "job_id"
 	FlagJobIDWithAlias                        = FlagJobID + ", jid"
 	FlagYes                                   = "yes"
+	FlagIncludeClosed                         = "include_closed"
+	FlagClosedWorkflowWindow                  = "closed_window"
+	FlagOlderThan                             = "older_than"
 	FlagServiceConfigDir                      = "service_config_dir"
 	FlagServiceConfigDirWithAlias             = FlagServiceConfigDir + ", scd"
 	FlagServiceEnv                            = "service_env"
@@ -230,6 +248,8 @@ const (
 	FlagDataConverterPlugin                   = "data_converter_plugin"
 	FlagDataConverterPluginWithAlias          = FlagDataConverterPlugin + ", dcp"
 	FlagWebURL                                = "web_ui_url"
+	FlagCodecEndpoint                         = "codec_endpoint"
+	FlagCodecEndpointWithAlias                = FlagCodecEndpoint + ", codec-endpoint"
 	FlagHeadersProviderPlugin                 = "headers_provider_plugin"
 	FlagHeadersProviderPluginWithAlias        = FlagHeadersProviderPlugin + ", hpp"
 	FlagHeadersProviderPluginOptions          = "headers_provider_plugin_options"
@@ -246,6 +266,57 @@ const (
 	FlagBase64File = "base64_file"
 
 	FlagSkipSchemaUpdate = "skip-schema-update"
+
+	FlagTargetVersion     = "target_version"
+	FlagMaxReplicationLag = "max_replication_lag"
+	FlagMaxDLQDepth       = "max_dlq_depth"
+
+	FlagContext       = "context"
+	FlagHeader        = "header"
+	FlagBrowser       = "browser"
+	FlagOIDCIssuerURL = "oidc_issuer_url"
+	FlagOIDCClientID  = "oidc_client_id"
+	FlagOIDCScopes    = "oidc_scopes"
+	FlagOIDCAudience  = "oidc_audience"
+
+	FlagDynamicConfigValue     = "value"
+	FlagDynamicConfigNamespace = "constraint_namespace"
+	FlagDynamicConfigRemove    = "remove"
+
+	FlagPartitions = "partitions"
+
+	FlagMaintenanceMessage  = "message"
+	FlagMaintenanceSeverity = "severity"
+	FlagMaintenanceClear    = "clear"
+
+	FlagClusterMetadataVersion   = "version"
+	FlagFailoverVersionIncrement = "failover_version_increment"
+	FlagInitialFailoverVersion   = "initial_failover_version"
+	FlagRPCAddress               = "rpc_address"
+	FlagClusterDisabled          = "disabled"
+	FlagRemoveCluster            = "remove_cluster"
+	FlagRemoveSearchAttribute    = "remove_search_attribute"
+
+	FlagBuildID                   = "build_id"
+	FlagExistingCompatibleBuildID = "existing_compatible_build_id"
+	FlagSetDefault                = "set_default"
+	FlagMaxSets                   = "max_sets"
+
+	FlagScheduleID          = "schedule_id"
+	FlagScheduleIDWithAlias = FlagScheduleID + ", sid"
+	FlagCalendar            = "calendar"
+	FlagInterval            = "interval"
+	FlagJitter              = "jitter"
+	FlagOverlapPolicy       = "overlap_policy"
+	FlagCatchupWindow       = "catchup_window"
+	FlagPauseOnFailure      = "pause_on_failure"
+	FlagRemainingActions    = "remaining_actions"
+	FlagNotes               = "notes"
+	FlagPaused              = "paused"
+
+	FlagMaxStartWorkflowRPS = "max_start_rps"
+	FlagMaxPendingWorkflows = "max_pending_workflows"
+	FlagMaxActionsPerSecond = "max_actions_per_second"
 )
 
 var flagsForExecution = []cli.Flag{
@@ -271,6 +342,12 @@ var flagsForPagination = []cli.Flag{
 	},
 }
 
+var codecEndpointFlag = cli.StringFlag{
+	Name:   FlagCodecEndpointWithAlias,
+	Usage:  "Remote codec HTTP endpoint used to decode payloads before they are displayed",
+	EnvVar: "TEMPORAL_CLI_CODEC_ENDPOINT",
+}
+
 func getFlagsForShow() []cli.Flag {
 	return append(flagsForExecution, getFlagsForShowID()...)
 }
@@ -310,6 +387,7 @@ func getFlagsForShowID() []cli.Flag {
 			Name:  FlagResetPointsOnly,
 			Usage: "Only show events that are eligible for reset",
 		},
+		codecEndpointFlag,
 	}
 }
 
@@ -347,6 +425,10 @@ func getFlagsForStart() []cli.Flag {
 				"\t│ │ │ │ │ \n" +
 				"\t* * * * *",
 		},
+		cli.StringFlag{
+			Name:  FlagStartDelay,
+			Usage: "Optional delay before the first workflow task is scheduled, for example 1h30m. Can't be used with cron",
+		},
 		cli.StringFlag{
 			Name: FlagWorkflowIDReusePolicyAlias,
 			Usage: "Configure if the same workflow Id is allowed for use in new workflow execution. " +
@@ -551,6 +633,7 @@ func getFlagsForQuery() []cli.Flag {
 			Name:  FlagQueryRejectConditionWithAlias,
 			Usage: "Optional flag to reject queries based on workflow state. Valid values are \"not_open\" and \"not_completed_cleanly\"",
 		},
+		codecEndpointFlag,
 	}
 }
 
@@ -596,6 +679,7 @@ func getFlagsForObserveID() []cli.Flag {
 			Name:  FlagMaxFieldLengthWithAlias,
 			Usage: "Optional maximum length for each attribute field when show details",
 		},
+		codecEndpointFlag,
 	}
 }
 
@@ -104,5 +104,38 @@ func newNamespaceCommands() []cli.Command {
 				newNamespaceCLI(c, false).ListNamespaces(c)
 			},
 		},
+		{
+			Name:    "failover-history",
+			Aliases: []string{"fh"},
+			Usage:   "List the most recent failovers of existing workflow namespace",
+			Flags:   failoverHistoryFlags,
+			Action: func(c *cli.Context) {
+				newNamespaceCLI(c, false).FailoverHistory(c)
+			},
+		},
+		{
+			Name:  "handover",
+			Usage: "Gracefully failover global namespace: namespace becomes read-only until replication to the new active cluster is drained",
+			Flags: handoverNamespaceFlags,
+			Action: func(c *cli.Context) {
+				newNamespaceCLI(c, false).HandoverNamespace(c)
+			},
+		},
+		{
+			Name:  "export",
+			Usage: "Export namespace configuration, bad binaries and custom search attributes to a file",
+			Flags: exportNamespaceFlags,
+			Action: func(c *cli.Context) {
+				newNamespaceCLI(c, false).ExportNamespace(c)
+			},
+		},
+		{
+			Name:  "import",
+			Usage: "Register or update namespace from a file written by the export command",
+			Flags: importNamespaceFlags,
+			Action: func(c *cli.Context) {
+				newNamespaceCLI(c, false).ImportNamespace(c)
+			},
+		},
 	}
 }
@@ -28,8 +28,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/olekukonko/tablewriter"
 	"github.com/urfave/cli"
@@ -39,6 +41,9 @@ import (
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/workflowservice/v1"
 
+	"go.temporal.io/server/api/adminservice/v1"
+	clispb "go.temporal.io/server/api/cli/v1"
+	"go.temporal.io/server/common/codec"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/primitives/timestamp"
 )
@@ -85,7 +90,7 @@ func (d *namespaceCLIImpl) RegisterNamespace(c *cli.Context) {
 	if c.IsSet(FlagRetention) {
 		retention, err = timestamp.ParseDurationDefaultDays(c.String(FlagRetention))
 		if err != nil {
-			ErrorAndExit(fmt.Sprintf("Option %s format is invalid.", FlagRetention), err)
+			InvalidArgumentAndExit(fmt.Sprintf("Option %s format is invalid.", FlagRetention), err)
 		}
 	}
 
@@ -93,7 +98,7 @@ func (d *namespaceCLIImpl) RegisterNamespace(c *cli.Context) {
 	if c.IsSet(FlagIsGlobalNamespace) {
 		isGlobalNamespace, err = strconv.ParseBool(c.String(FlagIsGlobalNamespace))
 		if err != nil {
-			ErrorAndExit(fmt.Sprintf("Option %s format is invalid.", FlagIsGlobalNamespace), err)
+			InvalidArgumentAndExit(fmt.Sprintf("Option %s format is invalid.", FlagIsGlobalNamespace), err)
 		}
 	}
 
@@ -102,7 +107,7 @@ func (d *namespaceCLIImpl) RegisterNamespace(c *cli.Context) {
 		namespaceDataStr := getRequiredOption(c, FlagNamespaceData)
 		namespaceData, err = parseNamespaceDataKVs(namespaceDataStr)
 		if err != nil {
-			ErrorAndExit(fmt.Sprintf("Option %s format is invalid.", FlagNamespaceData), err)
+			InvalidArgumentAndExit(fmt.Sprintf("Option %s format is invalid.", FlagNamespaceData), err)
 		}
 	}
 	if len(requiredNamespaceDataKeys) > 0 {
@@ -206,13 +211,13 @@ func (d *namespaceCLIImpl) UpdateNamespace(c *cli.Context) {
 			namespaceDataStr := c.String(FlagNamespaceData)
 			namespaceData, err = parseNamespaceDataKVs(namespaceDataStr)
 			if err != nil {
-				ErrorAndExit("Namespace data format is invalid.", err)
+				InvalidArgumentAndExit("Namespace data format is invalid.", err)
 			}
 		}
 		if c.IsSet(FlagRetention) {
 			retention, err = timestamp.ParseDurationDefaultDays(c.String(FlagRetention))
 			if err != nil {
-				ErrorAndExit(fmt.Sprintf("Option %s format is invalid.", FlagRetention), err)
+				InvalidArgumentAndExit(fmt.Sprintf("Option %s format is invalid.", FlagRetention), err)
 			}
 		}
 		if c.IsSet(FlagClusters) {
@@ -230,7 +235,7 @@ func (d *namespaceCLIImpl) UpdateNamespace(c *cli.Context) {
 		var binBinaries *namespacepb.BadBinaries
 		if c.IsSet(FlagAddBadBinary) {
 			if !c.IsSet(FlagReason) {
-				ErrorAndExit("Must provide a reason.", nil)
+				InvalidArgumentAndExit("Must provide a reason.", nil)
 			}
 			binChecksum := c.String(FlagAddBadBinary)
 			reason := c.String(FlagReason)
@@ -293,10 +298,10 @@ func (d *namespaceCLIImpl) DescribeNamespace(c *cli.Context) {
 	namespaceID := c.String(FlagNamespaceID)
 
 	if namespaceID == "" && namespace == "" {
-		ErrorAndExit("At least namespace_id or namespace must be provided.", nil)
+		InvalidArgumentAndExit("At least namespace_id or namespace must be provided.", nil)
 	}
 	if c.GlobalIsSet(FlagNamespace) && namespaceID != "" {
-		ErrorAndExit("Only one of namespace_id or namespace must be provided.", nil)
+		InvalidArgumentAndExit("Only one of namespace_id or namespace must be provided.", nil)
 	}
 	if namespaceID != "" {
 		namespace = ""
@@ -371,6 +376,215 @@ func (d *namespaceCLIImpl) ListNamespaces(c *cli.Context) {
 	}
 }
 
+// FailoverHistory lists the most recent failovers of a namespace
+func (d *namespaceCLIImpl) FailoverHistory(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.ListNamespaceFailoverHistory(ctx, &adminservice.ListNamespaceFailoverHistoryRequest{
+		Namespace: namespace,
+	})
+	if err != nil {
+		ErrorAndExit("Operation ListNamespaceFailoverHistory failed.", err)
+	}
+
+	if c.Bool(FlagPrintJSON) {
+		prettyPrintJSONObject(resp)
+		return
+	}
+	if len(resp.GetFailoverHistory()) == 0 {
+		fmt.Printf("Namespace %s has no failovers.\n", namespace)
+		return
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(true)
+	table.SetColumnSeparator("|")
+	header := []string{"Failover Time", "From Cluster", "To Cluster", "Failover Version", "Identity"}
+	headerColor := []tablewriter.Colors{tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue}
+	table.SetHeader(header)
+	table.SetHeaderColor(headerColor...)
+	for _, event := range resp.GetFailoverHistory() {
+		table.Append([]string{
+			formatTime(timestamp.TimeValue(event.GetFailoverTime()), false),
+			event.GetFromCluster(),
+			event.GetToCluster(),
+			strconv.FormatInt(event.GetFailoverVersion(), 10),
+			event.GetIdentity(),
+		})
+	}
+	table.Render()
+}
+
+// HandoverNamespace fails over a namespace after draining replication to the new active cluster
+func (d *namespaceCLIImpl) HandoverNamespace(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	activeCluster := getRequiredOption(c, FlagActiveClusterName)
+	drainTimeout := time.Duration(c.Int(FlagDrainTimeout)) * time.Second
+
+	adminClient := cFactory.AdminClient(c)
+	// besides drain, handover waits for all hosts to observe handover state
+	ctx, cancel := newContextWithTimeout(c, drainTimeout+time.Minute)
+	defer cancel()
+	fmt.Printf("Handover namespace %s to cluster %s, waiting for replication to drain...\n", namespace, activeCluster)
+	resp, err := adminClient.HandoverNamespace(ctx, &adminservice.HandoverNamespaceRequest{
+		Namespace:     namespace,
+		TargetCluster: activeCluster,
+		DrainTimeout:  &drainTimeout,
+	})
+	if err != nil {
+		ErrorAndExit("Operation HandoverNamespace failed.", err)
+	}
+	fmt.Printf("Namespace %s is active in cluster %s, failover version %d.\n", namespace, activeCluster, resp.GetFailoverVersion())
+}
+
+// ExportNamespace writes namespace configuration, bad binaries and custom search attributes to a file
+func (d *namespaceCLIImpl) ExportNamespace(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	outputFileName := getRequiredOption(c, FlagOutputFilename)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := d.describeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{
+		Namespace: namespace,
+	})
+	if err != nil {
+		if _, ok := err.(*serviceerror.NotFound); !ok {
+			ErrorAndExit("Operation DescribeNamespace failed.", err)
+		}
+		ErrorAndExit(fmt.Sprintf("Namespace %s does not exist.", namespace), err)
+	}
+	searchAttributes, err := getSearchAttributes(c, cFactory.AdminClient(c))
+	if err != nil {
+		ErrorAndExit("Unable to get search attributes.", err)
+	}
+
+	data, err := codec.NewJSONPBIndentEncoder("  ").Encode(&clispb.NamespaceExport{
+		Info:              resp.GetNamespaceInfo(),
+		Config:            resp.GetConfig(),
+		IsGlobalNamespace: resp.GetIsGlobalNamespace(),
+		SearchAttributes:  searchAttributes.GetCustomAttributes(),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to serialize exported namespace.", err)
+	}
+	if err := ioutil.WriteFile(outputFileName, data, 0666); err != nil {
+		ErrorAndExit("Failed to write export file.", err)
+	}
+	fmt.Printf("Namespace %s successfully exported to %s.\n", namespace, outputFileName)
+}
+
+// ImportNamespace registers or updates the namespace given by global option from a file written by ExportNamespace.
+// Custom search attributes which don't exist yet are added, bad binaries are merged with existing ones.
+func (d *namespaceCLIImpl) ImportNamespace(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	inputFileName := getRequiredOption(c, FlagInputFile)
+
+	// This is only executed from the CLI by an admin user
+	// #nosec
+	data, err := ioutil.ReadFile(inputFileName)
+	if err != nil {
+		ErrorAndExit("Failed to read import file.", err)
+	}
+	exported := &clispb.NamespaceExport{}
+	if err := codec.NewJSONPBEncoder().Decode(data, exported); err != nil {
+		ErrorAndExit("Failed to deserialize exported namespace.", err)
+	}
+
+	d.importSearchAttributes(c, exported.GetSearchAttributes())
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err = d.describeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{
+		Namespace: namespace,
+	})
+	switch err.(type) {
+	case nil:
+	case *serviceerror.NotFound:
+		info := exported.GetInfo()
+		config := exported.GetConfig()
+		err = d.registerNamespace(ctx, &workflowservice.RegisterNamespaceRequest{
+			Namespace:                        namespace,
+			Description:                      info.GetDescription(),
+			OwnerEmail:                       info.GetOwnerEmail(),
+			Data:                             info.GetData(),
+			WorkflowExecutionRetentionPeriod: config.GetWorkflowExecutionRetentionTtl(),
+			HistoryArchivalState:             config.GetHistoryArchivalState(),
+			HistoryArchivalUri:               config.GetHistoryArchivalUri(),
+			VisibilityArchivalState:          config.GetVisibilityArchivalState(),
+			VisibilityArchivalUri:            config.GetVisibilityArchivalUri(),
+			IsGlobalNamespace:                exported.GetIsGlobalNamespace(),
+		})
+		if err != nil {
+			ErrorAndExit("Register namespace operation failed.", err)
+		}
+		fmt.Printf("Namespace %s successfully registered.\n", namespace)
+	default:
+		ErrorAndExit("Operation DescribeNamespace failed.", err)
+	}
+
+	// bad binaries can only be set by update, which also applies the configuration to an existing namespace
+	err = d.updateNamespace(ctx, &workflowservice.UpdateNamespaceRequest{
+		Namespace: namespace,
+		UpdateInfo: &namespacepb.UpdateNamespaceInfo{
+			Description: exported.GetInfo().GetDescription(),
+			OwnerEmail:  exported.GetInfo().GetOwnerEmail(),
+			Data:        exported.GetInfo().GetData(),
+		},
+		Config: &namespacepb.NamespaceConfig{
+			WorkflowExecutionRetentionTtl: exported.GetConfig().GetWorkflowExecutionRetentionTtl(),
+			HistoryArchivalState:          exported.GetConfig().GetHistoryArchivalState(),
+			HistoryArchivalUri:            exported.GetConfig().GetHistoryArchivalUri(),
+			VisibilityArchivalState:       exported.GetConfig().GetVisibilityArchivalState(),
+			VisibilityArchivalUri:         exported.GetConfig().GetVisibilityArchivalUri(),
+			BadBinaries:                   exported.GetConfig().GetBadBinaries(),
+		},
+	})
+	if err != nil {
+		ErrorAndExit("Operation UpdateNamespace failed.", err)
+	}
+	fmt.Printf("Namespace %s successfully imported.\n", namespace)
+}
+
+func (d *namespaceCLIImpl) importSearchAttributes(c *cli.Context, searchAttributes map[string]enumspb.IndexedValueType) {
+	if len(searchAttributes) == 0 {
+		return
+	}
+
+	adminClient := cFactory.AdminClient(c)
+	existingSearchAttributes, err := getSearchAttributes(c, adminClient)
+	if err != nil {
+		ErrorAndExit("Unable to get existing search attributes.", err)
+	}
+	missingSearchAttributes := make(map[string]enumspb.IndexedValueType)
+	for name, valueType := range searchAttributes {
+		existingType, ok := existingSearchAttributes.GetCustomAttributes()[name]
+		if !ok {
+			missingSearchAttributes[name] = valueType
+			continue
+		}
+		if existingType != valueType {
+			ErrorAndExit(fmt.Sprintf("Search attribute %s already exists and has different type %s.", name, existingType), nil)
+		}
+	}
+	if len(missingSearchAttributes) == 0 {
+		return
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err = adminClient.AddSearchAttributes(ctx, &adminservice.AddSearchAttributesRequest{
+		SearchAttributes: missingSearchAttributes,
+		IndexName:        c.String(FlagIndex),
+	})
+	if err != nil {
+		ErrorAndExit("Unable to add search attributes.", err)
+	}
+	fmt.Printf("Added %d custom search attributes.\n", len(missingSearchAttributes))
+}
+
 func (d *namespaceCLIImpl) getAllNamespaces(c *cli.Context) []*workflowservice.DescribeNamespaceResponse {
 	var res []*workflowservice.DescribeNamespaceResponse
 	pagesize := int32(200)
@@ -463,7 +677,7 @@ func archivalState(c *cli.Context, stateFlagName string) enumspb.ArchivalState {
 		case "enabled":
 			return enumspb.ARCHIVAL_STATE_ENABLED
 		default:
-			ErrorAndExit(fmt.Sprintf("Option %s format is invalid.", stateFlagName), errors.New("invalid state, valid values are \"disabled\" and \"enabled\""))
+			InvalidArgumentAndExit(fmt.Sprintf("Option %s format is invalid.", stateFlagName), errors.New("invalid state, valid values are \"disabled\" and \"enabled\""))
 		}
 	}
 	return enumspb.ARCHIVAL_STATE_UNSPECIFIED
@@ -169,6 +169,47 @@ var (
 
 	listNamespacesFlags = []cli.Flag{}
 
+	failoverHistoryFlags = []cli.Flag{
+		cli.BoolFlag{
+			Name:  FlagPrintJSONWithAlias,
+			Usage: "Print in raw json format",
+		},
+	}
+
+	handoverNamespaceFlags = []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagActiveClusterNameWithAlias,
+			Usage: "Cluster which becomes active once replication to it is drained",
+		},
+		cli.IntFlag{
+			Name:  FlagDrainTimeoutWithAlias,
+			Value: defaultNamespaceHandoverDrainTimeoutInSeconds,
+			Usage: "Time in seconds to wait for replication to drain before aborting the handover",
+		},
+	}
+
+	exportNamespaceFlags = []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagOutputFilenameWithAlias,
+			Usage: "Write the exported namespace to this file",
+		},
+		cli.StringFlag{
+			Name:  FlagIndex,
+			Usage: "Elasticsearch index name of the namespace (optional)",
+		},
+	}
+
+	importNamespaceFlags = []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagInputFileWithAlias,
+			Usage: "File written by the export command",
+		},
+		cli.StringFlag{
+			Name:  FlagIndex,
+			Usage: "Elasticsearch index name of the namespace (optional)",
+		},
+	}
+
 	adminNamespaceCommonFlags = []cli.Flag{
 		cli.StringFlag{
 			Name:  FlagServiceConfigDirWithAlias,
@@ -285,6 +326,7 @@ func initializePersistenceFactory(
 		&pConfig,
 		resolver.NewNoopResolver(),
 		dynamicconfig.GetIntPropertyFn(dependencyMaxQPS),
+		nil,
 		nil, // TODO propagate abstract datastore factory from the CLI.
 		"",
 		metricsClient,
@@ -403,7 +445,7 @@ func getZone(c *cli.Context) string {
 func getConfigDir(c *cli.Context) string {
 	dirPath := c.String(FlagServiceConfigDir)
 	if len(dirPath) == 0 {
-		ErrorAndExit("Must provide service configuration dir path.", nil)
+		InvalidArgumentAndExit("Must provide service configuration dir path.", nil)
 	}
 	return dirPath
 }
@@ -0,0 +1,161 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os/exec"
+	"runtime"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	callbackPath = "/callback"
+)
+
+type (
+	authorizationResult struct {
+		code string
+		err  error
+	}
+)
+
+// BrowserLogin performs OAuth 2.0 authorization code grant with PKCE (RFC 7636)
+// using a local HTTP server on the loopback interface as redirect target.
+func (c *Client) BrowserLogin(ctx context.Context, out io.Writer) (*oauth2.Token, error) {
+	if c.metadata.AuthorizationEndpoint == "" {
+		return nil, errors.New("OpenID provider doesn't support authorization code flow, use device login instead")
+	}
+
+	verifier, err := randomString()
+	if err != nil {
+		return nil, err
+	}
+	state, err := randomString()
+	if err != nil {
+		return nil, err
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("unable to start local callback server: %w", err)
+	}
+	conf := c.oauth2Config(fmt.Sprintf("http://%s%s", listener.Addr().String(), callbackPath))
+
+	resultCh := make(chan authorizationResult, 1)
+	mux := http.NewServeMux()
+	mux.HandleFunc(callbackPath, func(w http.ResponseWriter, r *http.Request) {
+		result := parseCallback(r, state)
+		if result.err != nil {
+			http.Error(w, result.err.Error(), http.StatusBadRequest)
+		} else {
+			_, _ = io.WriteString(w, "Login successful, you can close this window and return to tctl.\n")
+		}
+		select {
+		case resultCh <- result:
+		default:
+		}
+	})
+	server := &http.Server{Handler: mux}
+	go func() { _ = server.Serve(listener) }()
+	defer func() { _ = server.Close() }()
+
+	authOpts := []oauth2.AuthCodeOption{
+		oauth2.SetAuthURLParam("code_challenge", codeChallenge(verifier)),
+		oauth2.SetAuthURLParam("code_challenge_method", "S256"),
+	}
+	if c.cfg.Audience != "" {
+		authOpts = append(authOpts, oauth2.SetAuthURLParam("audience", c.cfg.Audience))
+	}
+	authURL := conf.AuthCodeURL(state, authOpts...)
+	_, _ = fmt.Fprintf(out, "Opening browser for login. If it doesn't open, visit:\n%s\n", authURL)
+	_ = openBrowser(authURL)
+
+	var result authorizationResult
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("browser login wasn't completed in time: %w", ctx.Err())
+	case result = <-resultCh:
+	}
+	if result.err != nil {
+		return nil, result.err
+	}
+
+	token, err := conf.Exchange(c.withHTTPClient(ctx), result.code, oauth2.SetAuthURLParam("code_verifier", verifier))
+	if err != nil {
+		return nil, fmt.Errorf("unable to exchange authorization code: %w", err)
+	}
+	return token, nil
+}
+
+func parseCallback(r *http.Request, state string) authorizationResult {
+	query := r.URL.Query()
+	if errCode := query.Get("error"); errCode != "" {
+		return authorizationResult{err: fmt.Errorf("authorization failed: %s %s", errCode, query.Get("error_description"))}
+	}
+	if query.Get("state") != state {
+		return authorizationResult{err: errors.New("authorization failed: state mismatch")}
+	}
+	code := query.Get("code")
+	if code == "" {
+		return authorizationResult{err: errors.New("authorization failed: authorization code is missing")}
+	}
+	return authorizationResult{code: code}
+}
+
+func randomString() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func codeChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func openBrowser(url string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+	return cmd.Start()
+}
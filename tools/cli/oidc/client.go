@@ -0,0 +1,138 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"go.temporal.io/server/tools/cli/cliconfig"
+)
+
+const (
+	discoveryPath      = "/.well-known/openid-configuration"
+	httpRequestTimeout = 30 * time.Second
+)
+
+var (
+	defaultScopes = []string{"openid", "offline_access"}
+)
+
+type (
+	// Client performs OpenID Connect login flows against a single provider.
+	Client struct {
+		cfg        *cliconfig.OIDC
+		httpClient *http.Client
+		metadata   *providerMetadata
+	}
+
+	// providerMetadata is a subset of OpenID provider configuration which tctl needs.
+	providerMetadata struct {
+		Issuer                      string `json:"issuer"`
+		AuthorizationEndpoint       string `json:"authorization_endpoint"`
+		TokenEndpoint               string `json:"token_endpoint"`
+		DeviceAuthorizationEndpoint string `json:"device_authorization_endpoint"`
+	}
+)
+
+// NewClient discovers OpenID provider configuration and creates a new Client.
+func NewClient(ctx context.Context, cfg *cliconfig.OIDC) (*Client, error) {
+	httpClient := &http.Client{Timeout: httpRequestTimeout}
+	metadata, err := discover(ctx, httpClient, cfg.IssuerURL)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{
+		cfg:        cfg,
+		httpClient: httpClient,
+		metadata:   metadata,
+	}, nil
+}
+
+// TokenSource returns token source which refreshes token using refresh token when it expires.
+func (c *Client) TokenSource(ctx context.Context, token *oauth2.Token) oauth2.TokenSource {
+	return c.oauth2Config("").TokenSource(c.withHTTPClient(ctx), token)
+}
+
+func (c *Client) oauth2Config(redirectURL string) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID: c.cfg.ClientID,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:   c.metadata.AuthorizationEndpoint,
+			TokenURL:  c.metadata.TokenEndpoint,
+			AuthStyle: oauth2.AuthStyleInParams,
+		},
+		RedirectURL: redirectURL,
+		Scopes:      c.scopes(),
+	}
+}
+
+func (c *Client) scopes() []string {
+	if len(c.cfg.Scopes) > 0 {
+		return c.cfg.Scopes
+	}
+	return defaultScopes
+}
+
+func (c *Client) withHTTPClient(ctx context.Context) context.Context {
+	return context.WithValue(ctx, oauth2.HTTPClient, c.httpClient)
+}
+
+func discover(ctx context.Context, httpClient *http.Client, issuerURL string) (*providerMetadata, error) {
+	discoveryURL := strings.TrimSuffix(issuerURL, "/") + discoveryPath
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, discoveryURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch OpenID provider configuration: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to fetch OpenID provider configuration from %s: %s", discoveryURL, resp.Status)
+	}
+
+	var metadata providerMetadata
+	if err := json.Unmarshal(body, &metadata); err != nil {
+		return nil, fmt.Errorf("unable to parse OpenID provider configuration: %w", err)
+	}
+	if metadata.TokenEndpoint == "" {
+		return nil, fmt.Errorf("OpenID provider configuration from %s doesn't have token endpoint", discoveryURL)
+	}
+	return &metadata, nil
+}
@@ -0,0 +1,178 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	deviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+	defaultPollInterval = 5 * time.Second
+	slowDownIncrement   = 5 * time.Second
+
+	errAuthorizationPending = "authorization_pending"
+	errSlowDown             = "slow_down"
+)
+
+type (
+	deviceAuthorizationResponse struct {
+		DeviceCode              string `json:"device_code"`
+		UserCode                string `json:"user_code"`
+		VerificationURI         string `json:"verification_uri"`
+		VerificationURIComplete string `json:"verification_uri_complete"`
+		ExpiresIn               int64  `json:"expires_in"`
+		Interval                int64  `json:"interval"`
+	}
+
+	tokenResponse struct {
+		AccessToken      string `json:"access_token"`
+		TokenType        string `json:"token_type"`
+		RefreshToken     string `json:"refresh_token"`
+		ExpiresIn        int64  `json:"expires_in"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+)
+
+// DeviceLogin performs OAuth 2.0 device authorization grant (RFC 8628).
+// Instructions for the user are written to out.
+func (c *Client) DeviceLogin(ctx context.Context, out io.Writer) (*oauth2.Token, error) {
+	if c.metadata.DeviceAuthorizationEndpoint == "" {
+		return nil, errors.New("OpenID provider doesn't support device authorization, use browser login instead")
+	}
+
+	params := url.Values{
+		"client_id": {c.cfg.ClientID},
+		"scope":     {strings.Join(c.scopes(), " ")},
+	}
+	if c.cfg.Audience != "" {
+		params.Set("audience", c.cfg.Audience)
+	}
+	var deviceAuth deviceAuthorizationResponse
+	if _, err := c.postForm(ctx, c.metadata.DeviceAuthorizationEndpoint, params, &deviceAuth); err != nil {
+		return nil, fmt.Errorf("unable to start device authorization: %w", err)
+	}
+
+	if deviceAuth.VerificationURIComplete != "" {
+		_, _ = fmt.Fprintf(out, "Open %s in a browser and confirm code %s.\n", deviceAuth.VerificationURIComplete, deviceAuth.UserCode)
+	} else {
+		_, _ = fmt.Fprintf(out, "Open %s in a browser and enter code %s.\n", deviceAuth.VerificationURI, deviceAuth.UserCode)
+	}
+
+	if deviceAuth.ExpiresIn > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(deviceAuth.ExpiresIn)*time.Second)
+		defer cancel()
+	}
+	interval := defaultPollInterval
+	if deviceAuth.Interval > 0 {
+		interval = time.Duration(deviceAuth.Interval) * time.Second
+	}
+
+	tokenParams := url.Values{
+		"grant_type":  {deviceCodeGrantType},
+		"device_code": {deviceAuth.DeviceCode},
+		"client_id":   {c.cfg.ClientID},
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("device authorization wasn't completed in time: %w", ctx.Err())
+		case <-time.After(interval):
+		}
+
+		var tokenResp tokenResponse
+		statusCode, err := c.postForm(ctx, c.metadata.TokenEndpoint, tokenParams, &tokenResp)
+		if err != nil && statusCode != http.StatusBadRequest && statusCode != http.StatusUnauthorized {
+			return nil, err
+		}
+		switch tokenResp.Error {
+		case "":
+			if err != nil {
+				return nil, err
+			}
+			return tokenResp.toToken(), nil
+		case errAuthorizationPending:
+		case errSlowDown:
+			interval += slowDownIncrement
+		default:
+			return nil, fmt.Errorf("device authorization failed: %s %s", tokenResp.Error, tokenResp.ErrorDescription)
+		}
+	}
+}
+
+// postForm posts params to endpoint and decodes JSON response into result.
+// Error responses are decoded too, so caller can inspect OAuth 2.0 error code.
+func (c *Client) postForm(ctx context.Context, endpoint string, params url.Values, result interface{}) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(params.Encode()))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return resp.StatusCode, err
+	}
+	if jsonErr := json.Unmarshal(body, result); jsonErr != nil {
+		return resp.StatusCode, fmt.Errorf("unable to parse response from %s (%s): %w", endpoint, resp.Status, jsonErr)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return resp.StatusCode, fmt.Errorf("request to %s failed: %s", endpoint, resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+func (r *tokenResponse) toToken() *oauth2.Token {
+	token := &oauth2.Token{
+		AccessToken:  r.AccessToken,
+		TokenType:    r.TokenType,
+		RefreshToken: r.RefreshToken,
+	}
+	if r.ExpiresIn > 0 {
+		token.Expiry = time.Now().UTC().Add(time.Duration(r.ExpiresIn) * time.Second)
+	}
+	return token
+}
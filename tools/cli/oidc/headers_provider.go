@@ -0,0 +1,100 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package oidc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"golang.org/x/oauth2"
+
+	"go.temporal.io/server/tools/cli/cliconfig"
+)
+
+const (
+	authorizationHeader = "authorization"
+)
+
+type (
+	// HeadersProvider attaches cached access token of a context to outgoing calls.
+	// Expired token is refreshed and written back to the cache.
+	HeadersProvider struct {
+		cfg         *cliconfig.OIDC
+		contextName string
+		store       *TokenStore
+
+		sync.Mutex
+		token *oauth2.Token
+	}
+)
+
+// NewHeadersProvider creates a HeadersProvider for the named context.
+func NewHeadersProvider(cfg *cliconfig.OIDC, contextName string, store *TokenStore) *HeadersProvider {
+	return &HeadersProvider{
+		cfg:         cfg,
+		contextName: contextName,
+		store:       store,
+	}
+}
+
+// GetHeaders returns authorization header with a valid access token.
+func (p *HeadersProvider) GetHeaders(ctx context.Context) (map[string]string, error) {
+	p.Lock()
+	defer p.Unlock()
+
+	if p.token == nil {
+		token, err := p.store.Load(p.contextName)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load cached token: %w", err)
+		}
+		if token == nil {
+			return nil, fmt.Errorf("not logged in to context %q, run 'tctl login' first", p.contextName)
+		}
+		p.token = token
+	}
+
+	if !p.token.Valid() {
+		if p.token.RefreshToken == "" {
+			return nil, fmt.Errorf("token for context %q is expired, run 'tctl login' again", p.contextName)
+		}
+		client, err := NewClient(ctx, p.cfg)
+		if err != nil {
+			return nil, err
+		}
+		token, err := client.TokenSource(ctx, p.token).Token()
+		if err != nil {
+			return nil, fmt.Errorf("unable to refresh token for context %q, run 'tctl login' again: %w", p.contextName, err)
+		}
+		if err := p.store.Save(p.contextName, token); err != nil {
+			return nil, fmt.Errorf("unable to cache refreshed token: %w", err)
+		}
+		p.token = token
+	}
+
+	return map[string]string{
+		authorizationHeader: fmt.Sprintf("%s %s", p.token.Type(), p.token.AccessToken),
+	}, nil
+}
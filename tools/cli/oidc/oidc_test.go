@@ -0,0 +1,211 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package oidc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/oauth2"
+
+	"go.temporal.io/server/tools/cli/cliconfig"
+)
+
+type oidcSuite struct {
+	suite.Suite
+
+	server      *httptest.Server
+	cfg         *cliconfig.OIDC
+	dir         string
+	tokenPolls  int32
+	lastRefresh atomic.Value
+}
+
+func TestOIDCSuite(t *testing.T) {
+	s := &oidcSuite{}
+	suite.Run(t, s)
+}
+
+func (s *oidcSuite) SetupTest() {
+	atomic.StoreInt32(&s.tokenPolls, 0)
+	s.lastRefresh = atomic.Value{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(discoveryPath, func(w http.ResponseWriter, r *http.Request) {
+		s.writeJSON(w, http.StatusOK, providerMetadata{
+			Issuer:                      s.server.URL,
+			AuthorizationEndpoint:       s.server.URL + "/authorize",
+			TokenEndpoint:               s.server.URL + "/token",
+			DeviceAuthorizationEndpoint: s.server.URL + "/device",
+		})
+	})
+	mux.HandleFunc("/device", func(w http.ResponseWriter, r *http.Request) {
+		s.NoError(r.ParseForm())
+		s.Equal("tctl", r.PostForm.Get("client_id"))
+		s.Equal("openid offline_access", r.PostForm.Get("scope"))
+		s.Equal("temporal", r.PostForm.Get("audience"))
+		s.writeJSON(w, http.StatusOK, deviceAuthorizationResponse{
+			DeviceCode:      "device-code",
+			UserCode:        "ABCD-EFGH",
+			VerificationURI: s.server.URL + "/activate",
+			ExpiresIn:       60,
+			Interval:        1,
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		s.NoError(r.ParseForm())
+		switch r.PostForm.Get("grant_type") {
+		case deviceCodeGrantType:
+			s.Equal("device-code", r.PostForm.Get("device_code"))
+			if atomic.AddInt32(&s.tokenPolls, 1) == 1 {
+				s.writeJSON(w, http.StatusBadRequest, tokenResponse{Error: errAuthorizationPending})
+				return
+			}
+			s.writeJSON(w, http.StatusOK, tokenResponse{AccessToken: "access-1", TokenType: "Bearer", RefreshToken: "refresh-1", ExpiresIn: 3600})
+		case "refresh_token":
+			s.lastRefresh.Store(r.PostForm.Get("refresh_token"))
+			s.writeJSON(w, http.StatusOK, tokenResponse{AccessToken: "access-2", TokenType: "Bearer", RefreshToken: "refresh-2", ExpiresIn: 3600})
+		default:
+			s.writeJSON(w, http.StatusBadRequest, tokenResponse{Error: "unsupported_grant_type"})
+		}
+	})
+	s.server = httptest.NewServer(mux)
+
+	s.cfg = &cliconfig.OIDC{
+		IssuerURL: s.server.URL,
+		ClientID:  "tctl",
+		Audience:  "temporal",
+	}
+
+	dir, err := ioutil.TempDir("", "tctl-oidc")
+	s.NoError(err)
+	s.dir = dir
+}
+
+func (s *oidcSuite) TearDownTest() {
+	s.server.Close()
+	s.NoError(os.RemoveAll(s.dir))
+}
+
+func (s *oidcSuite) TestDeviceLogin() {
+	client, err := NewClient(context.Background(), s.cfg)
+	s.NoError(err)
+
+	out := &bytes.Buffer{}
+	token, err := client.DeviceLogin(context.Background(), out)
+	s.NoError(err)
+	s.Equal("access-1", token.AccessToken)
+	s.Equal("refresh-1", token.RefreshToken)
+	s.True(token.Valid())
+	s.Equal(int32(2), atomic.LoadInt32(&s.tokenPolls))
+	s.Contains(out.String(), "ABCD-EFGH")
+}
+
+func (s *oidcSuite) TestTokenStore() {
+	store := NewTokenStore(s.dir)
+
+	token, err := store.Load("prod")
+	s.NoError(err)
+	s.Nil(token)
+
+	s.NoError(store.Save("prod", &oauth2.Token{AccessToken: "access", TokenType: "Bearer"}))
+	token, err = store.Load("prod")
+	s.NoError(err)
+	s.Equal("access", token.AccessToken)
+
+	s.NoError(store.Delete("prod"))
+	s.NoError(store.Delete("prod"))
+	token, err = store.Load("prod")
+	s.NoError(err)
+	s.Nil(token)
+}
+
+func (s *oidcSuite) TestHeadersProvider_NotLoggedIn() {
+	provider := NewHeadersProvider(s.cfg, "prod", NewTokenStore(s.dir))
+	_, err := provider.GetHeaders(context.Background())
+	s.Error(err)
+}
+
+func (s *oidcSuite) TestHeadersProvider_ValidToken() {
+	store := NewTokenStore(s.dir)
+	s.NoError(store.Save("prod", &oauth2.Token{AccessToken: "access-1", TokenType: "Bearer", Expiry: time.Now().Add(time.Hour)}))
+
+	provider := NewHeadersProvider(s.cfg, "prod", store)
+	headers, err := provider.GetHeaders(context.Background())
+	s.NoError(err)
+	s.Equal(map[string]string{"authorization": "Bearer access-1"}, headers)
+	s.Nil(s.lastRefresh.Load())
+}
+
+func (s *oidcSuite) TestHeadersProvider_RefreshExpiredToken() {
+	store := NewTokenStore(s.dir)
+	s.NoError(store.Save("prod", &oauth2.Token{AccessToken: "access-1", TokenType: "Bearer", RefreshToken: "refresh-1", Expiry: time.Now().Add(-time.Minute)}))
+
+	provider := NewHeadersProvider(s.cfg, "prod", store)
+	headers, err := provider.GetHeaders(context.Background())
+	s.NoError(err)
+	s.Equal(map[string]string{"authorization": "Bearer access-2"}, headers)
+	s.Equal("refresh-1", s.lastRefresh.Load())
+
+	cached, err := store.Load("prod")
+	s.NoError(err)
+	s.Equal("access-2", cached.AccessToken)
+	s.Equal("refresh-2", cached.RefreshToken)
+}
+
+func (s *oidcSuite) TestHeadersProvider_ExpiredTokenWithoutRefreshToken() {
+	store := NewTokenStore(s.dir)
+	s.NoError(store.Save("prod", &oauth2.Token{AccessToken: "access-1", Expiry: time.Now().Add(-time.Minute)}))
+
+	provider := NewHeadersProvider(s.cfg, "prod", store)
+	_, err := provider.GetHeaders(context.Background())
+	s.Error(err)
+}
+
+func (s *oidcSuite) TestParseCallback() {
+	req := httptest.NewRequest(http.MethodGet, "/callback?state=state&code=code", nil)
+	s.Equal(authorizationResult{code: "code"}, parseCallback(req, "state"))
+
+	req = httptest.NewRequest(http.MethodGet, "/callback?state=other&code=code", nil)
+	s.Error(parseCallback(req, "state").err)
+
+	req = httptest.NewRequest(http.MethodGet, "/callback?error=access_denied", nil)
+	s.Error(parseCallback(req, "state").err)
+}
+
+func (s *oidcSuite) writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	s.NoError(json.NewEncoder(w).Encode(v))
+}
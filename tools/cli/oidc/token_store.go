@@ -0,0 +1,94 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package oidc
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	tokensDirName = "tokens"
+)
+
+type (
+	// TokenStore caches tokens on local disk, one file per context.
+	TokenStore struct {
+		dir string
+	}
+)
+
+// NewTokenStore creates a TokenStore which keeps tokens in tokens subdirectory of dir.
+func NewTokenStore(dir string) *TokenStore {
+	return &TokenStore{
+		dir: filepath.Join(dir, tokensDirName),
+	}
+}
+
+// Load returns cached token for the context or nil if there is no token.
+func (s *TokenStore) Load(contextName string) (*oauth2.Token, error) {
+	content, err := ioutil.ReadFile(s.path(contextName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var token oauth2.Token
+	if err := json.Unmarshal(content, &token); err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// Save caches token for the context. The file is readable by the current user only.
+func (s *TokenStore) Save(contextName string, token *oauth2.Token) error {
+	content, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(s.dir, 0700); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(s.path(contextName), content, 0600)
+}
+
+// Delete removes cached token for the context.
+func (s *TokenStore) Delete(contextName string) error {
+	err := os.Remove(s.path(contextName))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (s *TokenStore) path(contextName string) string {
+	return filepath.Join(s.dir, url.PathEscape(contextName)+".json")
+}
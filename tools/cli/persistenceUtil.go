@@ -65,6 +65,7 @@ func CreatePersistenceFactory(c *cli.Context) persistenceClient.Factory {
 		&persistence,
 		resolver.NewNoopResolver(),
 		GetQPS,
+		nil,
 		params.AbstractDatastoreFactory,
 		c.String(FlagTargetCluster),
 		nil, // MetricsClient
@@ -35,7 +35,6 @@ import (
 	"os"
 	"reflect"
 	"regexp"
-	"runtime/debug"
 	"strconv"
 	"strings"
 	"time"
@@ -48,6 +47,7 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 	sdkclient "go.temporal.io/sdk/client"
+	"google.golang.org/grpc/codes"
 
 	"go.temporal.io/server/common/codec"
 	"go.temporal.io/server/common/collection"
@@ -386,24 +386,16 @@ func mapKeysToArray(m map[string]interface{}) []string {
 	return out
 }
 
-func printError(msg string, err error) {
-	if err != nil {
-		fmt.Printf("%s %s\n%s %+v\n", colorRed("Error:"), msg, colorMagenta("Error Details:"), err)
-		if os.Getenv(showErrorStackEnv) != `` {
-			fmt.Printf("Stack trace:\n")
-			debug.PrintStack()
-		} else {
-			fmt.Printf("('export %s=1' to see stack traces)\n", showErrorStackEnv)
-		}
-	} else {
-		fmt.Printf("%s %s\n", colorRed("Error:"), msg)
-	}
-}
-
 // ErrorAndExit print easy to understand error msg first then error detail in a new line
 func ErrorAndExit(msg string, err error) {
-	printError(msg, err)
-	osExit(1)
+	printError(msg, err, errorCode(err))
+	osExit(exitCode(err))
+}
+
+// InvalidArgumentAndExit is ErrorAndExit for invalid command line options or arguments, it exits with ExitCodeInvalidArgument
+func InvalidArgumentAndExit(msg string, err error) {
+	printError(msg, err, codes.InvalidArgument)
+	osExit(ExitCodeInvalidArgument)
 }
 
 func getSDKClient(c *cli.Context) sdkclient.Client {
@@ -414,7 +406,7 @@ func getSDKClient(c *cli.Context) sdkclient.Client {
 func getRequiredOption(c *cli.Context, optionName string) string {
 	value := c.String(optionName)
 	if len(value) == 0 {
-		ErrorAndExit(fmt.Sprintf("Option %s is required", optionName), nil)
+		InvalidArgumentAndExit(fmt.Sprintf("Option %s is required", optionName), nil)
 	}
 	return value
 }
@@ -422,21 +414,21 @@ func getRequiredOption(c *cli.Context, optionName string) string {
 func getRequiredStringSliceOption(c *cli.Context, optionName string) []string {
 	value := c.StringSlice(optionName)
 	if len(value) == 0 {
-		ErrorAndExit(fmt.Sprintf("Option %s is required", optionName), nil)
+		InvalidArgumentAndExit(fmt.Sprintf("Option %s is required", optionName), nil)
 	}
 	return value
 }
 
 func getRequiredInt64Option(c *cli.Context, optionName string) int64 {
 	if !c.IsSet(optionName) {
-		ErrorAndExit(fmt.Sprintf("Option %s is required", optionName), nil)
+		InvalidArgumentAndExit(fmt.Sprintf("Option %s is required", optionName), nil)
 	}
 	return c.Int64(optionName)
 }
 
 func getRequiredIntOption(c *cli.Context, optionName string) int {
 	if !c.IsSet(optionName) {
-		ErrorAndExit(fmt.Sprintf("Option %s is required", optionName), nil)
+		InvalidArgumentAndExit(fmt.Sprintf("Option %s is required", optionName), nil)
 	}
 	return c.Int(optionName)
 }
@@ -444,7 +436,7 @@ func getRequiredIntOption(c *cli.Context, optionName string) int {
 func getRequiredGlobalOption(c *cli.Context, optionName string) string {
 	value := c.GlobalString(optionName)
 	if len(value) == 0 {
-		ErrorAndExit(fmt.Sprintf("Global option %s is required", optionName), nil)
+		InvalidArgumentAndExit(fmt.Sprintf("Global option %s is required", optionName), nil)
 	}
 	return value
 }
@@ -479,7 +471,7 @@ func parseTime(timeStr string, defaultValue time.Time, now time.Time) time.Time
 	// treat as time range format
 	parsedTime, err = parseTimeRange(timeStr, now)
 	if err != nil {
-		ErrorAndExit(fmt.Sprintf("Cannot parse time '%s', use UTC format '2006-01-02T15:04:05', "+
+		InvalidArgumentAndExit(fmt.Sprintf("Cannot parse time '%s', use UTC format '2006-01-02T15:04:05', "+
 			"time range or raw UnixNano directly. See help for more details.", timeStr), err)
 	}
 	return parsedTime
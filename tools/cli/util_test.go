@@ -25,10 +25,16 @@
 package cli
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/serviceerror"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
 )
 
 func (s *utilSuite) SetupTest() {
@@ -89,3 +95,34 @@ func (s *utilSuite) TestStringToEnum_MapEmptyEnum() {
 	s.Error(err)
 	s.Equal(result, int32(0))
 }
+
+func (s *utilSuite) TestExitCode() {
+	testCases := []struct {
+		err      error
+		expected int
+	}{
+		{nil, ExitCodeError},
+		{errors.New("some error"), ExitCodeError},
+		{serviceerror.NewInternal("internal"), ExitCodeError},
+		{serviceerror.NewInvalidArgument("invalid"), ExitCodeInvalidArgument},
+		{serviceerror.NewNotFound("not found"), ExitCodeNotFound},
+		{serviceerror.NewUnavailable("unavailable"), ExitCodeUnavailable},
+		{context.DeadlineExceeded, ExitCodeUnavailable},
+		{grpcstatus.Error(codes.Unavailable, "connection refused"), ExitCodeUnavailable},
+		{fmt.Errorf("wrapped: %w", serviceerror.NewNotFound("not found")), ExitCodeNotFound},
+	}
+
+	for _, tc := range testCases {
+		s.Equal(tc.expected, exitCode(tc.err), "%v", tc.err)
+	}
+}
+
+func (s *utilSuite) TestSetErrorFormat() {
+	defer func() { errorFormat = errorFormatText }()
+
+	s.NoError(setErrorFormat(errorFormatJSON))
+	s.Equal(errorFormatJSON, errorFormat)
+	s.NoError(setErrorFormat(""))
+	s.Equal(errorFormatText, errorFormat)
+	s.Error(setErrorFormat("yaml"))
+}
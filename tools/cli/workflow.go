@@ -224,6 +224,42 @@ func newWorkflowCommands() []cli.Command {
 				ObserveHistoryWithID(c)
 			},
 		},
+		{
+			Name:  "trace",
+			Usage: "show the execution tree of a workflow, including its child workflows and continue-as-new runs",
+			Flags: append(flagsForExecution,
+				cli.IntFlag{
+					Name:  FlagDepth,
+					Value: -1,
+					Usage: "Number of levels of child workflows to expand, -1 means unlimited",
+				},
+				cli.BoolFlag{
+					Name:  FlagFollowWithAlias,
+					Usage: "Keep refreshing the tree until every execution in it is closed",
+				},
+				cli.IntFlag{
+					Name:  FlagFollowInterval,
+					Value: 1,
+					Usage: "Refresh interval in seconds when following the tree",
+				},
+			),
+			Action: func(c *cli.Context) {
+				TraceWorkflow(c)
+			},
+		},
+		{
+			Name:  "show-reset-points",
+			Usage: "show the auto-reset points of the workflow execution, i.e. the first workflow task completed by each build id",
+			Flags: append(flagsForExecution,
+				cli.BoolFlag{
+					Name:  FlagPrintJSONWithAlias,
+					Usage: "Print in raw json format",
+				},
+			),
+			Action: func(c *cli.Context) {
+				ShowResetPoints(c)
+			},
+		},
 		{
 			Name:    "reset",
 			Aliases: []string{"rs"},
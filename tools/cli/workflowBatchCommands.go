@@ -29,12 +29,14 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/urfave/cli"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	sdkclient "go.temporal.io/sdk/client"
 
+	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
@@ -63,7 +65,32 @@ func TerminateBatchJob(c *cli.Context) {
 // DescribeBatchJob describe the status of the batch job
 func DescribeBatchJob(c *cli.Context) {
 	jobID := getRequiredOption(c, FlagJobID)
+	if c.Bool(FlagFollow) {
+		followBatchJob(c, jobID)
+		return
+	}
+	output, _ := describeBatchJob(c, jobID)
+	prettyPrintJSONObject(output)
+}
+
+// followBatchJob prints the progress of the batch job until it is finished
+func followBatchJob(c *cli.Context, jobID string) {
+	interval := time.Duration(c.Int(FlagFollowInterval)) * time.Second
+	if interval <= 0 {
+		interval = defaultFollowInterval
+	}
+	for {
+		output, running := describeBatchJob(c, jobID)
+		prettyPrintJSONObject(output)
+		if !running {
+			return
+		}
+		time.Sleep(interval)
+	}
+}
 
+// describeBatchJob returns the status and progress of the batch job and whether it is still running
+func describeBatchJob(c *cli.Context, jobID string) (map[string]interface{}, bool) {
 	client := cFactory.SDKClient(c, common.SystemLocalNamespace)
 	tcCtx, cancel := newContext(c)
 	defer cancel()
@@ -73,7 +100,8 @@ func DescribeBatchJob(c *cli.Context) {
 	}
 
 	output := map[string]interface{}{}
-	if wf.WorkflowExecutionInfo.GetStatus() != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+	running := wf.WorkflowExecutionInfo.GetStatus() == enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING
+	if !running {
 		if wf.WorkflowExecutionInfo.GetStatus() != enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED {
 			output["msg"] = "batch job stopped status: " + wf.WorkflowExecutionInfo.GetStatus().String()
 		} else {
@@ -91,7 +119,7 @@ func DescribeBatchJob(c *cli.Context) {
 			output["progress"] = hbd
 		}
 	}
-	prettyPrintJSONObject(output)
+	return output, running
 }
 
 // ListBatchJobs list the started batch jobs
@@ -149,7 +177,7 @@ func StartBatchJob(c *cli.Context) {
 	reason := getRequiredOption(c, FlagReason)
 	batchType := getRequiredOption(c, FlagBatchType)
 	if !validateBatchType(batchType) {
-		ErrorAndExit("batchType is not valid, supported:"+strings.Join(batcher.AllBatchTypes, ","), nil)
+		InvalidArgumentAndExit("batchType is not valid, supported:"+strings.Join(batcher.AllBatchTypes, ","), nil)
 	}
 	operator := getCurrentUserFromEnv()
 	var sigName, sigVal string
@@ -227,6 +255,43 @@ func StartBatchJob(c *cli.Context) {
 	prettyPrintJSONObject(output)
 }
 
+// StartResetBadBinaryJob starts a batch job resetting the workflows which ran a bad binary
+func StartResetBadBinaryJob(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	binaryChecksum := getRequiredOption(c, FlagResetBadBinaryChecksum)
+	reason := getRequiredOption(c, FlagReason)
+	resetReapplyType, ok := resetReapplyTypesMap[c.String(FlagResetReapplyType)]
+	if !ok {
+		InvalidArgumentAndExit(fmt.Sprintf("must specify valid reset reapply type: %v", strings.Join(mapKeysToArray(resetReapplyTypesMap), ", ")), nil)
+	}
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.StartResetBadBinaryJob(ctx, &adminservice.StartResetBadBinaryJobRequest{
+		Namespace:        namespace,
+		BinaryChecksum:   binaryChecksum,
+		Reason:           reason,
+		Identity:         getCurrentUserFromEnv(),
+		IncludeClosed:    c.Bool(FlagIncludeClosed),
+		ResetReapplyType: resetReapplyType.(enumspb.ResetReapplyType),
+		Rps:              int32(c.Int(FlagRPS)),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to start reset bad binary job", err)
+	}
+	output := map[string]interface{}{
+		"msg":            "batch job is started",
+		"jobId":          resp.GetJobId(),
+		"estimatedCount": resp.GetEstimatedCount(),
+	}
+	prettyPrintJSONObject(output)
+
+	if c.Bool(FlagFollow) {
+		followBatchJob(c, resp.GetJobId())
+	}
+}
+
 func validateBatchType(bt string) bool {
 	for _, b := range batcher.AllBatchTypes {
 		if b == bt {
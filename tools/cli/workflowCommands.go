@@ -57,6 +57,7 @@ import (
 	"go.temporal.io/sdk/client"
 	sdkclient "go.temporal.io/sdk/client"
 
+	"go.temporal.io/server/api/adminservice/v1"
 	clispb "go.temporal.io/server/api/cli/v1"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/codec"
@@ -67,6 +68,7 @@ import (
 	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/service/history/workflow"
 	"go.temporal.io/server/tools/cli/dataconverter"
+	"go.temporal.io/server/tools/cli/headersprovider"
 	"go.temporal.io/server/tools/cli/stringify"
 )
 
@@ -80,7 +82,7 @@ func ShowHistory(c *cli.Context) {
 // ShowHistoryWithWID shows the history of given workflow with workflow_id
 func ShowHistoryWithWID(c *cli.Context) {
 	if !c.Args().Present() {
-		ErrorAndExit("Argument workflow_id is required.", nil)
+		InvalidArgumentAndExit("Argument workflow_id is required.", nil)
 	}
 	wid := c.Args().First()
 	rid := ""
@@ -91,6 +93,7 @@ func ShowHistoryWithWID(c *cli.Context) {
 }
 
 func showHistoryHelper(c *cli.Context, wid, rid string) {
+	applyCodecEndpoint(c)
 	sdkClient := getSDKClient(c)
 
 	printDateTime := c.Bool(FlagPrintDateTime)
@@ -199,7 +202,7 @@ func startWorkflowHelper(c *cli.Context, shouldPrintProgress bool) {
 	if c.IsSet(FlagWorkflowIDReusePolicy) {
 		reusePolicyInt, err := stringToEnum(c.String(FlagWorkflowIDReusePolicy), enumspb.WorkflowIdReusePolicy_value)
 		if err != nil {
-			ErrorAndExit("Failed to parse Reuse Policy", err)
+			InvalidArgumentAndExit("Failed to parse Reuse Policy", err)
 		}
 		reusePolicy = enumspb.WorkflowIdReusePolicy(reusePolicyInt)
 	}
@@ -215,6 +218,13 @@ func startWorkflowHelper(c *cli.Context, shouldPrintProgress bool) {
 	if c.IsSet(FlagCronSchedule) {
 		wo.CronSchedule = c.String(FlagCronSchedule)
 	}
+	var startDelay time.Duration
+	if c.IsSet(FlagStartDelay) {
+		var err error
+		if startDelay, err = time.ParseDuration(c.String(FlagStartDelay)); err != nil {
+			InvalidArgumentAndExit(fmt.Sprintf("Invalid %s.", FlagStartDelay), err)
+		}
+	}
 
 	wo.Memo = unmarshalMemoFromCLI(c)
 	wo.SearchAttributes = unmarshalSearchAttrFromCLI(c)
@@ -222,6 +232,9 @@ func startWorkflowHelper(c *cli.Context, shouldPrintProgress bool) {
 	startFn := func() {
 		tcCtx, cancel := newContext(c)
 		defer cancel()
+		if startDelay > 0 {
+			tcCtx = withStartDelay(tcCtx, startDelay)
+		}
 		resp, err := sdkClient.ExecuteWorkflow(tcCtx, wo, workflowType, inputs...)
 
 		if err != nil {
@@ -234,6 +247,9 @@ func startWorkflowHelper(c *cli.Context, shouldPrintProgress bool) {
 	runFn := func() {
 		tcCtx, cancel := newContextForLongPoll(c)
 		defer cancel()
+		if startDelay > 0 {
+			tcCtx = withStartDelay(tcCtx, startDelay)
+		}
 		resp, err := sdkClient.ExecuteWorkflow(tcCtx, wo, workflowType, inputs...)
 
 		if err != nil {
@@ -319,7 +335,7 @@ func unmarshalSearchAttrFromCLI(c *cli.Context) map[string]interface{} {
 	}
 
 	if len(searchAttrKeys) != len(rawSearchAttrVals) {
-		ErrorAndExit(fmt.Sprintf("Uneven number of search attributes keys (%d): %v and values(%d): %v.", len(searchAttrKeys), searchAttrKeys, len(rawSearchAttrVals), rawSearchAttrVals), nil)
+		InvalidArgumentAndExit(fmt.Sprintf("Uneven number of search attributes keys (%d): %v and values(%d): %v.", len(searchAttrKeys), searchAttrKeys, len(rawSearchAttrVals), rawSearchAttrVals), nil)
 	}
 
 	fields := make(map[string]interface{}, len(searchAttrKeys))
@@ -327,7 +343,7 @@ func unmarshalSearchAttrFromCLI(c *cli.Context) map[string]interface{} {
 	for i, v := range rawSearchAttrVals {
 		var j interface{}
 		if err := json.Unmarshal([]byte(v), &j); err != nil {
-			ErrorAndExit("Search attribute JSON parse error.", err)
+			InvalidArgumentAndExit("Search attribute JSON parse error.", err)
 		}
 		fields[searchAttrKeys[i]] = j
 	}
@@ -360,10 +376,10 @@ func unmarshalMemoFromCLI(c *cli.Context) map[string]interface{} {
 		memoValues = append(memoValues, sc.Value())
 	}
 	if err := sc.Error(); err != nil {
-		ErrorAndExit("Memo JSON parse error.", err)
+		InvalidArgumentAndExit("Memo JSON parse error.", err)
 	}
 	if len(memoKeys) != len(memoValues) {
-		ErrorAndExit("Number of memo keys and values are not equal.", nil)
+		InvalidArgumentAndExit("Number of memo keys and values are not equal.", nil)
 	}
 
 	fields := make(map[string]interface{}, len(memoKeys))
@@ -404,6 +420,7 @@ func getPrintableSearchAttr(searchAttr *commonpb.SearchAttributes) string {
 
 // helper function to print workflow progress with time refresh every second
 func printWorkflowProgress(c *cli.Context, wid, rid string) {
+	applyCodecEndpoint(c)
 	fmt.Println(colorMagenta("Progress:"))
 
 	sdkClient := getSDKClient(c)
@@ -543,6 +560,7 @@ func QueryWorkflowUsingStackTrace(c *cli.Context) {
 }
 
 func queryWorkflowHelper(c *cli.Context, queryType string) {
+	applyCodecEndpoint(c)
 	serviceClient := cFactory.FrontendClient(c)
 
 	namespace := getRequiredGlobalOption(c, FlagNamespace)
@@ -573,7 +591,7 @@ func queryWorkflowHelper(c *cli.Context, queryType string) {
 		case "not_completed_cleanly":
 			rejectCondition = enumspb.QUERY_REJECT_CONDITION_NOT_COMPLETED_CLEANLY
 		default:
-			ErrorAndExit(fmt.Sprintf("invalid reject condition %v, valid values are \"not_open\" and \"not_completed_cleanly\"", c.String(FlagQueryRejectCondition)), nil)
+			InvalidArgumentAndExit(fmt.Sprintf("invalid reject condition %v, valid values are \"not_open\" and \"not_completed_cleanly\"", c.String(FlagQueryRejectCondition)), nil)
 		}
 		queryRequest.QueryRejectCondition = rejectCondition
 	}
@@ -586,11 +604,31 @@ func queryWorkflowHelper(c *cli.Context, queryType string) {
 	if queryResponse.QueryRejected != nil {
 		fmt.Printf("Query was rejected, workflow has status: %v\n", queryResponse.QueryRejected.GetStatus())
 	} else {
-		queryResult := payloads.ToString(queryResponse.QueryResult)
+		queryResult := payloadsToString(queryResponse.QueryResult)
 		fmt.Printf("Query result:\n%v\n", queryResult)
 	}
 }
 
+// applyCodecEndpoint makes the current data converter decode payloads with the codec service
+// passed to the command, so payloads encoded by the codec are displayed as plain values
+func applyCodecEndpoint(c *cli.Context) {
+	endpoint := c.String(FlagCodecEndpoint)
+	if endpoint == "" {
+		return
+	}
+	dataconverter.SetCurrent(dataconverter.NewRemoteCodecDataConverter(
+		dataconverter.GetCurrent(),
+		endpoint,
+		c.GlobalString(FlagNamespace),
+		headersprovider.GetCurrent(),
+	))
+}
+
+// payloadsToString formats payloads with the current data converter
+func payloadsToString(ps *commonpb.Payloads) string {
+	return fmt.Sprintf("[%s]", strings.Join(dataconverter.GetCurrent().ToStrings(ps), ", "))
+}
+
 // ListWorkflow list workflow executions based on filters
 func ListWorkflow(c *cli.Context) {
 	more := c.Bool(FlagMore)
@@ -606,7 +644,7 @@ func ListWorkflow(c *cli.Context) {
 			printListResults(results, printJSON, false)
 			fmt.Println("]")
 		} else {
-			ErrorAndExit("Not support printJSON in more mode", nil)
+			InvalidArgumentAndExit("Not support printJSON in more mode", nil)
 		}
 		return
 	}
@@ -843,7 +881,7 @@ func DescribeWorkflow(c *cli.Context) {
 // DescribeWorkflowWithID show information about the specified workflow execution
 func DescribeWorkflowWithID(c *cli.Context) {
 	if !c.Args().Present() {
-		ErrorAndExit("Argument workflow_id is required.", nil)
+		InvalidArgumentAndExit("Argument workflow_id is required.", nil)
 	}
 	wid := c.Args().First()
 	rid := ""
@@ -909,6 +947,56 @@ func printAutoResetPoints(resp *workflowservice.DescribeWorkflowExecutionRespons
 	table.Render()
 }
 
+// ShowResetPoints shows the auto-reset points of a workflow execution
+func ShowResetPoints(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.GetResetPoints(ctx, &adminservice.GetResetPointsRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+	})
+	if err != nil {
+		ErrorAndExit("Get reset points failed", err)
+	}
+
+	if c.Bool(FlagPrintJSON) {
+		prettyPrintJSONObject(resp)
+		return
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(true)
+	table.SetColumnSeparator("|")
+	header := []string{"Build Id", "RunId", "EventId", "Create Time", "Expire Time", "Resettable", "Bad Binary"}
+	headerColor := []tablewriter.Colors{tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue}
+	table.SetHeader(header)
+	table.SetHeaderColor(headerColor...)
+	for _, pt := range resp.GetResetPoints() {
+		expireTime := ""
+		if pt.GetExpireTime() != nil {
+			expireTime = formatTime(timestamp.TimeValue(pt.GetExpireTime()), false)
+		}
+		table.Append([]string{
+			pt.GetBuildId(),
+			pt.GetRunId(),
+			convert.Int64ToString(pt.GetFirstWorkflowTaskCompletedId()),
+			formatTime(timestamp.TimeValue(pt.GetCreateTime()), false),
+			expireTime,
+			fmt.Sprintf("%t", pt.GetResettable()),
+			fmt.Sprintf("%t", pt.GetBadBinary()),
+		})
+	}
+	table.Render()
+}
+
 func convertDescribeWorkflowExecutionResponse(resp *workflowservice.DescribeWorkflowExecutionResponse) *clispb.DescribeWorkflowExecutionResponse {
 
 	info := resp.GetWorkflowExecutionInfo()
@@ -1114,7 +1202,7 @@ func printRunStatus(event *historypb.HistoryEvent) {
 	switch event.GetEventType() {
 	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED:
 		fmt.Printf("  Status: %s\n", colorGreen("COMPLETED"))
-		result := payloads.ToString(event.GetWorkflowExecutionCompletedEventAttributes().GetResult())
+		result := payloadsToString(event.GetWorkflowExecutionCompletedEventAttributes().GetResult())
 		fmt.Printf("  Output: %s\n", result)
 	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_FAILED:
 		fmt.Printf("  Status: %s\n", colorRed("FAILED"))
@@ -1124,7 +1212,7 @@ func printRunStatus(event *historypb.HistoryEvent) {
 		fmt.Printf("  Retry status: %s\n", event.GetWorkflowExecutionTimedOutEventAttributes().GetRetryState())
 	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CANCELED:
 		fmt.Printf("  Status: %s\n", colorRed("CANCELED"))
-		details := payloads.ToString(event.GetWorkflowExecutionCanceledEventAttributes().GetDetails())
+		details := payloadsToString(event.GetWorkflowExecutionCanceledEventAttributes().GetDetails())
 		fmt.Printf("  Detail: %s\n", details)
 	}
 }
@@ -1383,14 +1471,14 @@ func ResetWorkflow(c *cli.Context) {
 	resetType := c.String(FlagResetType)
 	extraForResetType, ok := resetTypesMap[resetType]
 	if !ok && eventID <= 0 {
-		ErrorAndExit(fmt.Sprintf("must specify either valid event_id or reset_type (one of %s)", strings.Join(mapKeysToArray(resetTypesMap), ", ")), nil)
+		InvalidArgumentAndExit(fmt.Sprintf("must specify either valid event_id or reset_type (one of %s)", strings.Join(mapKeysToArray(resetTypesMap), ", ")), nil)
 	}
 	if ok && len(extraForResetType.(string)) > 0 {
 		getRequiredOption(c, extraForResetType.(string))
 	}
 	resetReapplyType := c.String(FlagResetReapplyType)
 	if _, ok := resetReapplyTypesMap[resetReapplyType]; !ok {
-		ErrorAndExit(fmt.Sprintf("must specify valid reset reapply type: %v", strings.Join(mapKeysToArray(resetReapplyTypesMap), ", ")), nil)
+		InvalidArgumentAndExit(fmt.Sprintf("must specify valid reset reapply type: %v", strings.Join(mapKeysToArray(resetReapplyTypesMap), ", ")), nil)
 	}
 
 	ctx, cancel := newContext(c)
@@ -1476,7 +1564,7 @@ func ResetInBatch(c *cli.Context) {
 
 	extraForResetType, ok := resetTypesMap[resetType]
 	if !ok {
-		ErrorAndExit("Not supported reset type", nil)
+		InvalidArgumentAndExit("Not supported reset type", nil)
 	} else if len(extraForResetType.(string)) > 0 {
 		getRequiredOption(c, extraForResetType.(string))
 	}
@@ -1491,7 +1579,7 @@ func ResetInBatch(c *cli.Context) {
 	}
 
 	if inFileName == "" && query == "" {
-		ErrorAndExit("Must provide input file or list query to get target workflows to reset", nil)
+		InvalidArgumentAndExit("Must provide input file or list query to get target workflows to reset", nil)
 	}
 
 	wg := &sync.WaitGroup{}
@@ -1921,7 +2009,7 @@ func CompleteActivity(c *cli.Context) {
 	rid := getRequiredOption(c, FlagRunID)
 	activityID := getRequiredOption(c, FlagActivityID)
 	if len(activityID) == 0 {
-		ErrorAndExit("Invalid activityId", fmt.Errorf("activityId cannot be empty"))
+		InvalidArgumentAndExit("Invalid activityId", fmt.Errorf("activityId cannot be empty"))
 	}
 	result := getRequiredOption(c, FlagResult)
 	identity := getRequiredOption(c, FlagIdentity)
@@ -1951,7 +2039,7 @@ func FailActivity(c *cli.Context) {
 	rid := getRequiredOption(c, FlagRunID)
 	activityID := getRequiredOption(c, FlagActivityID)
 	if len(activityID) == 0 {
-		ErrorAndExit("Invalid activityId", fmt.Errorf("activityId cannot be empty"))
+		InvalidArgumentAndExit("Invalid activityId", fmt.Errorf("activityId cannot be empty"))
 	}
 	reason := getRequiredOption(c, FlagReason)
 	detail := getRequiredOption(c, FlagDetail)
@@ -1985,7 +2073,7 @@ func FailActivity(c *cli.Context) {
 // ObserveHistoryWithID show the process of running workflow
 func ObserveHistoryWithID(c *cli.Context) {
 	if !c.Args().Present() {
-		ErrorAndExit("Argument workflow_id is required.", nil)
+		InvalidArgumentAndExit("Argument workflow_id is required.", nil)
 	}
 	wid := c.Args().First()
 	rid := ""
@@ -0,0 +1,304 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/urfave/cli"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	filterpb "go.temporal.io/api/filter/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	sdkclient "go.temporal.io/sdk/client"
+
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+type (
+	// traceNode is a single workflow run in the execution tree
+	traceNode struct {
+		execution    *commonpb.WorkflowExecution
+		workflowType string
+		status       enumspb.WorkflowExecutionStatus
+		startTime    *time.Time
+		closeTime    *time.Time
+		children     []*traceNode
+		// continuedAs is the run started when this run continued as new
+		continuedAs *traceNode
+		err         error
+	}
+
+	// tracer walks the execution tree and keeps closed runs across refreshes,
+	// since neither their status nor their children can change anymore
+	tracer struct {
+		c        *cli.Context
+		client   sdkclient.Client
+		maxDepth int
+		closed   map[string]*traceNode
+	}
+)
+
+// TraceWorkflow prints the execution tree of a workflow with the status of every run in it
+func TraceWorkflow(c *cli.Context) {
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+
+	t := &tracer{
+		c:        c,
+		client:   getSDKClient(c),
+		maxDepth: c.Int(FlagDepth),
+		closed:   make(map[string]*traceNode),
+	}
+	root := t.firstRun(wid, rid)
+
+	if !c.Bool(FlagFollow) {
+		fmt.Println(strings.Join(renderTraceTree(t.trace(root, 0)), "\n"))
+		return
+	}
+
+	interval := time.Duration(c.Int(FlagFollowInterval)) * time.Second
+	if interval <= 0 {
+		interval = defaultFollowInterval
+	}
+	printed := 0
+	for {
+		node := t.trace(root, 0)
+		lines := renderTraceTree(node)
+		if printed > 0 {
+			// move the cursor back to the first line of the previous tree and clear everything below it
+			fmt.Printf("\033[%dA\033[J", printed)
+		}
+		fmt.Println(strings.Join(lines, "\n"))
+		printed = len(lines)
+		if !node.isRunning() {
+			return
+		}
+		time.Sleep(interval)
+	}
+}
+
+// firstRun walks back the continue-as-new chain of the given run so that the tree starts from the first run
+func (t *tracer) firstRun(wid, rid string) *commonpb.WorkflowExecution {
+	ctx, cancel := newContext(t.c)
+	defer cancel()
+	if rid == "" {
+		resp, err := t.client.DescribeWorkflowExecution(ctx, wid, "")
+		if err != nil {
+			ErrorAndExit("Failed to describe workflow execution", err)
+		}
+		rid = resp.GetWorkflowExecutionInfo().GetExecution().GetRunId()
+	}
+
+	execution := &commonpb.WorkflowExecution{WorkflowId: wid, RunId: rid}
+	for {
+		iter := t.client.GetWorkflowHistory(ctx, execution.GetWorkflowId(), execution.GetRunId(), false, enumspb.HISTORY_EVENT_FILTER_TYPE_ALL_EVENT)
+		if !iter.HasNext() {
+			return execution
+		}
+		event, err := iter.Next()
+		if err != nil {
+			ErrorAndExit("Failed to get workflow history", err)
+		}
+		attributes := event.GetWorkflowExecutionStartedEventAttributes()
+		if attributes == nil {
+			return execution
+		}
+		if attributes.GetContinuedExecutionRunId() == "" {
+			return execution
+		}
+		execution = &commonpb.WorkflowExecution{WorkflowId: wid, RunId: attributes.GetContinuedExecutionRunId()}
+	}
+}
+
+// trace builds the subtree of the given run, expanding child workflows up to the configured depth
+func (t *tracer) trace(execution *commonpb.WorkflowExecution, depth int) *traceNode {
+	if node, ok := t.closed[execution.GetRunId()]; ok {
+		return node
+	}
+
+	node := t.describe(execution)
+	if node.err != nil {
+		return node
+	}
+
+	ctx, cancel := newContext(t.c)
+	defer cancel()
+	history, err := GetHistory(ctx, t.client, execution.GetWorkflowId(), execution.GetRunId())
+	if err != nil {
+		node.err = err
+		return node
+	}
+	expandChildren := t.maxDepth < 0 || depth < t.maxDepth
+	for _, event := range history.GetEvents() {
+		switch event.GetEventType() {
+		case enumspb.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_STARTED:
+			if expandChildren {
+				child := event.GetChildWorkflowExecutionStartedEventAttributes().GetWorkflowExecution()
+				node.children = append(node.children, t.trace(child, depth+1))
+			}
+		case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW:
+			next := &commonpb.WorkflowExecution{
+				WorkflowId: execution.GetWorkflowId(),
+				RunId:      event.GetWorkflowExecutionContinuedAsNewEventAttributes().GetNewExecutionRunId(),
+			}
+			node.continuedAs = t.trace(next, depth)
+		}
+	}
+
+	if node.status != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+		t.closed[execution.GetRunId()] = node
+	}
+	return node
+}
+
+// describe returns the status of the given run, falling back to visibility records
+// when the run itself is gone, e.g. after its retention period
+func (t *tracer) describe(execution *commonpb.WorkflowExecution) *traceNode {
+	node := &traceNode{execution: execution}
+
+	ctx, cancel := newContext(t.c)
+	defer cancel()
+	resp, err := t.client.DescribeWorkflowExecution(ctx, execution.GetWorkflowId(), execution.GetRunId())
+	if err == nil {
+		info := resp.GetWorkflowExecutionInfo()
+		node.workflowType = info.GetType().GetName()
+		node.status = info.GetStatus()
+		node.startTime = info.GetStartTime()
+		node.closeTime = info.GetCloseTime()
+		return node
+	}
+	if _, ok := err.(*serviceerror.NotFound); !ok {
+		node.err = err
+		return node
+	}
+
+	visibilityCtx, visibilityCancel := newContextForVisibility(t.c)
+	defer visibilityCancel()
+	var nextPageToken []byte
+	for {
+		listResp, listErr := t.client.ListClosedWorkflow(visibilityCtx, &workflowservice.ListClosedWorkflowExecutionsRequest{
+			NextPageToken: nextPageToken,
+			StartTimeFilter: &filterpb.StartTimeFilter{
+				EarliestTime: timestamp.TimePtr(time.Unix(0, 0).UTC()),
+				LatestTime:   timestamp.TimePtr(time.Now().UTC()),
+			},
+			Filters: &workflowservice.ListClosedWorkflowExecutionsRequest_ExecutionFilter{
+				ExecutionFilter: &filterpb.WorkflowExecutionFilter{WorkflowId: execution.GetWorkflowId()},
+			},
+		})
+		if listErr != nil {
+			node.err = listErr
+			return node
+		}
+		for _, info := range listResp.GetExecutions() {
+			if info.GetExecution().GetRunId() == execution.GetRunId() {
+				node.workflowType = info.GetType().GetName()
+				node.status = info.GetStatus()
+				node.startTime = info.GetStartTime()
+				node.closeTime = info.GetCloseTime()
+				return node
+			}
+		}
+		nextPageToken = listResp.GetNextPageToken()
+		if len(nextPageToken) == 0 {
+			node.err = err
+			return node
+		}
+	}
+}
+
+// isRunning returns whether any run in the subtree is still running
+func (n *traceNode) isRunning() bool {
+	if n.err == nil && n.status == enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+		return true
+	}
+	for _, child := range n.children {
+		if child.isRunning() {
+			return true
+		}
+	}
+	return n.continuedAs != nil && n.continuedAs.isRunning()
+}
+
+// renderTraceTree returns the lines of the execution tree rooted at the given run
+func renderTraceTree(root *traceNode) []string {
+	lines := []string{traceNodeToString(root, "")}
+	return renderTraceChildren(root, "", lines)
+}
+
+func renderTraceChildren(node *traceNode, prefix string, lines []string) []string {
+	type entry struct {
+		node  *traceNode
+		label string
+	}
+	var entries []entry
+	for _, child := range node.children {
+		entries = append(entries, entry{node: child})
+	}
+	// the run started by continue-as-new is shown last, as it outlives every child of this run
+	if node.continuedAs != nil {
+		entries = append(entries, entry{node: node.continuedAs, label: "continued as new: "})
+	}
+
+	for i, e := range entries {
+		connector, indent := "├── ", "│   "
+		if i == len(entries)-1 {
+			connector, indent = "└── ", "    "
+		}
+		lines = append(lines, prefix+connector+traceNodeToString(e.node, e.label))
+		lines = renderTraceChildren(e.node, prefix+indent, lines)
+	}
+	return lines
+}
+
+func traceNodeToString(node *traceNode, label string) string {
+	execution := fmt.Sprintf("%s%s (wid: %s, rid: %s)", label, node.workflowType, node.execution.GetWorkflowId(), node.execution.GetRunId())
+	if node.err != nil {
+		return fmt.Sprintf("%s %s", execution, colorRed(node.err.Error()))
+	}
+
+	var status string
+	switch node.status {
+	case enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING:
+		status = colorMagenta(node.status.String())
+	case enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, enumspb.WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW:
+		status = colorGreen(node.status.String())
+	default:
+		status = colorRed(node.status.String())
+	}
+
+	var elapsed string
+	switch {
+	case node.startTime != nil && node.closeTime != nil:
+		elapsed = fmt.Sprintf(" [%v]", node.closeTime.Sub(*node.startTime).Round(time.Millisecond))
+	case node.startTime != nil:
+		elapsed = fmt.Sprintf(" [started %s]", formatTime(*node.startTime, false))
+	}
+	return fmt.Sprintf("%s %s%s", execution, status, elapsed)
+}